@@ -0,0 +1,147 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/thunder-id/thunderid/internal/entitytype"
+	flowmgt "github.com/thunder-id/thunderid/internal/flow/mgt"
+	"github.com/thunder-id/thunderid/internal/inboundclient"
+	"github.com/thunder-id/thunderid/internal/system/cachewarming"
+	serverconst "github.com/thunder-id/thunderid/internal/system/constants"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	sysutils "github.com/thunder-id/thunderid/internal/system/utils"
+)
+
+// registerCacheWarmers registers the warmers of the resource types that can be warmed on startup. Each
+// warmer loads its resources through the cache-backed lookups of the owning service.
+func registerCacheWarmers(warmingService cachewarming.CacheWarmingServiceInterface,
+	entityTypeService entitytype.EntityTypeServiceInterface,
+	inboundClientService inboundclient.InboundClientServiceInterface,
+	flowMgtService flowmgt.FlowMgtServiceInterface) {
+	warmingService.RegisterWarmer(cachewarming.ResourceTypeEntityTypes, newEntityTypeWarmer(entityTypeService))
+	warmingService.RegisterWarmer(cachewarming.ResourceTypeApplications,
+		newApplicationWarmer(inboundClientService))
+	warmingService.RegisterWarmer(cachewarming.ResourceTypeFlows, newFlowWarmer(flowMgtService))
+}
+
+// newEntityTypeWarmer creates a warmer of the user and agent types. Keys are the category and the ID of
+// the entity type joined by a colon.
+func newEntityTypeWarmer(entityTypeService entitytype.EntityTypeServiceInterface) cachewarming.Warmer {
+	return cachewarming.FuncWarmer{
+		ListFunc: func(ctx context.Context) ([]string, error) {
+			keys := make([]string, 0)
+			for _, category := range []entitytype.TypeCategory{
+				entitytype.TypeCategoryUser, entitytype.TypeCategoryAgent,
+			} {
+				types, svcErr := sysutils.CollectAllPages(serverconst.MaxPageSize,
+					func(limit, offset int) ([]entitytype.EntityTypeListItem, int, *serviceerror.ServiceError) {
+						page, svcErr := entityTypeService.GetEntityTypeList(ctx, category, limit, offset, false)
+						if svcErr != nil {
+							return nil, 0, svcErr
+						}
+						return page.Types, page.TotalResults, nil
+					})
+				if svcErr != nil {
+					return nil, serviceErrorToError(svcErr)
+				}
+				for _, item := range types {
+					keys = append(keys, string(category)+":"+item.ID)
+				}
+			}
+			return keys, nil
+		},
+		LoadFunc: func(ctx context.Context, key string) error {
+			category, id, found := strings.Cut(key, ":")
+			if !found {
+				return fmt.Errorf("invalid entity type key %q", key)
+			}
+			_, svcErr := entityTypeService.GetEntityType(ctx, entitytype.TypeCategory(category), id, false)
+			return serviceErrorToError(svcErr)
+		},
+	}
+}
+
+// newApplicationWarmer creates a warmer of the inbound clients and OAuth profiles of applications. Keys
+// are the entity IDs of the applications.
+func newApplicationWarmer(inboundClientService inboundclient.InboundClientServiceInterface) cachewarming.Warmer {
+	return cachewarming.FuncWarmer{
+		ListFunc: func(ctx context.Context) ([]string, error) {
+			clients, err := inboundClientService.GetInboundClientList(ctx)
+			if err != nil {
+				return nil, err
+			}
+			keys := make([]string, 0, len(clients))
+			for _, client := range clients {
+				keys = append(keys, client.ID)
+			}
+			return keys, nil
+		},
+		LoadFunc: func(ctx context.Context, key string) error {
+			if _, err := inboundClientService.GetInboundClientByEntityID(ctx, key); err != nil {
+				return err
+			}
+			_, err := inboundClientService.GetOAuthProfileByEntityID(ctx, key)
+			return err
+		},
+	}
+}
+
+// newFlowWarmer creates a warmer of the flow definitions and their compiled graphs. Keys are the flow IDs.
+func newFlowWarmer(flowMgtService flowmgt.FlowMgtServiceInterface) cachewarming.Warmer {
+	return cachewarming.FuncWarmer{
+		ListFunc: func(ctx context.Context) ([]string, error) {
+			flows, svcErr := sysutils.CollectAllPages(serverconst.MaxPageSize,
+				func(limit, offset int) ([]flowmgt.BasicFlowDefinition, int, *serviceerror.ServiceError) {
+					page, svcErr := flowMgtService.ListFlows(ctx, limit, offset, "")
+					if svcErr != nil {
+						return nil, 0, svcErr
+					}
+					return page.Flows, page.TotalResults, nil
+				})
+			if svcErr != nil {
+				return nil, serviceErrorToError(svcErr)
+			}
+			keys := make([]string, 0, len(flows))
+			for _, flow := range flows {
+				keys = append(keys, flow.ID)
+			}
+			return keys, nil
+		},
+		LoadFunc: func(ctx context.Context, key string) error {
+			if _, svcErr := flowMgtService.GetFlow(ctx, key); svcErr != nil {
+				return serviceErrorToError(svcErr)
+			}
+			_, svcErr := flowMgtService.GetGraph(ctx, key)
+			return serviceErrorToError(svcErr)
+		},
+	}
+}
+
+// serviceErrorToError converts a service error to an error, keeping a nil service error nil.
+func serviceErrorToError(svcErr *serviceerror.ServiceError) error {
+	if svcErr == nil {
+		return nil
+	}
+	return errors.New(svcErr.ErrorDescription.DefaultValue)
+}
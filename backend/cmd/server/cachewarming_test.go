@@ -0,0 +1,96 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package main
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/thunder-id/thunderid/internal/entitytype"
+	flowmgt "github.com/thunder-id/thunderid/internal/flow/mgt"
+	inboundmodel "github.com/thunder-id/thunderid/internal/inboundclient/model"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/tests/mocks/entitytypemock"
+	"github.com/thunder-id/thunderid/tests/mocks/flow/flowmgtmock"
+	"github.com/thunder-id/thunderid/tests/mocks/inboundclientmock"
+)
+
+func TestEntityTypeWarmer(t *testing.T) {
+	ctx := context.Background()
+	entityTypeService := entitytypemock.NewEntityTypeServiceInterfaceMock(t)
+	entityTypeService.On("GetEntityTypeList", ctx, entitytype.TypeCategoryUser, mock.Anything, 0, false).
+		Return(&entitytype.EntityTypeListResponse{TotalResults: 1,
+			Types: []entitytype.EntityTypeListItem{{ID: "u1"}}}, nil)
+	entityTypeService.On("GetEntityTypeList", ctx, entitytype.TypeCategoryAgent, mock.Anything, 0, false).
+		Return(&entitytype.EntityTypeListResponse{TotalResults: 1,
+			Types: []entitytype.EntityTypeListItem{{ID: "a1"}}}, nil)
+	entityTypeService.On("GetEntityType", ctx, entitytype.TypeCategoryAgent, "a1", false).
+		Return(&entitytype.EntityType{ID: "a1"}, nil)
+	warmer := newEntityTypeWarmer(entityTypeService)
+
+	keys, err := warmer.ListKeys(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"user:u1", "agent:a1"}, keys)
+
+	assert.NoError(t, warmer.Load(ctx, "agent:a1"))
+	assert.Error(t, warmer.Load(ctx, "malformed"))
+}
+
+func TestApplicationWarmer(t *testing.T) {
+	ctx := context.Background()
+	inboundClientService := inboundclientmock.NewInboundClientServiceInterfaceMock(t)
+	inboundClientService.On("GetInboundClientList", ctx).
+		Return([]inboundmodel.InboundClient{{ID: "app-1"}, {ID: "app-2"}}, nil)
+	inboundClientService.On("GetInboundClientByEntityID", ctx, "app-1").
+		Return(&inboundmodel.InboundClient{ID: "app-1"}, nil)
+	inboundClientService.On("GetOAuthProfileByEntityID", ctx, "app-1").Return(&inboundmodel.OAuthProfile{}, nil)
+	inboundClientService.On("GetInboundClientByEntityID", ctx, "app-2").Return(nil, errors.New("db error"))
+	warmer := newApplicationWarmer(inboundClientService)
+
+	keys, err := warmer.ListKeys(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"app-1", "app-2"}, keys)
+
+	assert.NoError(t, warmer.Load(ctx, "app-1"))
+	assert.Error(t, warmer.Load(ctx, "app-2"))
+}
+
+func TestFlowWarmer(t *testing.T) {
+	ctx := context.Background()
+	flowMgtService := flowmgtmock.NewFlowMgtServiceInterfaceMock(t)
+	flowMgtService.On("ListFlows", ctx, mock.Anything, 0, mock.Anything).
+		Return(&flowmgt.FlowListResponse{TotalResults: 1, Flows: []flowmgt.BasicFlowDefinition{{ID: "flow-1"}}},
+			nil)
+	flowMgtService.On("GetFlow", ctx, "flow-1").Return(&flowmgt.CompleteFlowDefinition{ID: "flow-1"}, nil)
+	flowMgtService.On("GetGraph", ctx, "flow-1").Return(nil, nil)
+	flowMgtService.On("GetFlow", ctx, "missing").Return(nil, &serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType, ErrorDescription: serviceerror.InternalServerError.ErrorDescription})
+	warmer := newFlowWarmer(flowMgtService)
+
+	keys, err := warmer.ListKeys(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"flow-1"}, keys)
+
+	assert.NoError(t, warmer.Load(ctx, "flow-1"))
+	assert.Error(t, warmer.Load(ctx, "missing"))
+}
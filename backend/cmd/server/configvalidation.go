@@ -0,0 +1,136 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+
+	flowcommon "github.com/thunder-id/thunderid/internal/flow/common"
+	flowmgt "github.com/thunder-id/thunderid/internal/flow/mgt"
+	"github.com/thunder-id/thunderid/internal/system/cache"
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/configvalidation"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/internal/system/security"
+)
+
+// flowLookup looks up the flows referenced from the configuration through the flow management service.
+type flowLookup struct {
+	flowMgtService flowmgt.FlowMgtServiceInterface
+}
+
+// newFlowLookup creates a flow lookup backed by the flow management service.
+func newFlowLookup(flowMgtService flowmgt.FlowMgtServiceInterface) configvalidation.FlowLookup {
+	return &flowLookup{flowMgtService: flowMgtService}
+}
+
+// FlowExists reports whether a flow with the given handle and flow type exists.
+func (l *flowLookup) FlowExists(ctx context.Context, handle string, flowType string) (bool, error) {
+	_, svcErr := l.flowMgtService.GetFlowByHandle(ctx, handle, flowcommon.FlowType(flowType))
+	if svcErr == nil {
+		return true, nil
+	}
+	if svcErr.Type == serviceerror.ClientErrorType {
+		return false, nil
+	}
+	return false, errors.New(svcErr.ErrorDescription.DefaultValue)
+}
+
+// enforceConfigValidation logs the findings of a configuration validation and stops the server when the
+// validation failed.
+func enforceConfigValidation(logger *log.Logger, report *configvalidation.Report) {
+	logConfigValidationFindings(logger, report)
+	if report.Errors > 0 {
+		logger.Fatal("Configuration validation failed", log.Int("errors", report.Errors))
+	}
+	if !report.Valid {
+		logger.Fatal("Configuration validation reported warnings in strict mode",
+			log.Int("warnings", report.Warnings))
+	}
+}
+
+// logConfigValidationFindings logs the findings of a configuration validation.
+func logConfigValidationFindings(logger *log.Logger, report *configvalidation.Report) {
+	for _, finding := range report.Findings {
+		fields := []log.Field{
+			log.String("check", finding.Check),
+			log.String("field", finding.Field),
+			log.String("remediation", finding.Remediation),
+		}
+		if finding.Severity == configvalidation.SeverityError {
+			logger.Error("Configuration error: "+finding.Message, fields...)
+		} else {
+			logger.Warn("Configuration warning: "+finding.Message, fields...)
+		}
+	}
+}
+
+// runConfigValidation validates the configuration, writes the report as JSON, and returns the process
+// exit code.
+func runConfigValidation(w io.Writer, serverHome string) int {
+	report := validateConfiguration(serverHome)
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(report); err != nil {
+		log.GetLogger().Error("Failed to write the configuration validation report", log.Error(err))
+		return 2
+	}
+	if !report.Valid {
+		return 1
+	}
+	return 0
+}
+
+// validateConfiguration runs all configuration validation checks. The services are initialized without
+// serving requests to validate the flow references.
+func validateConfiguration(serverHome string) *configvalidation.Report {
+	cfg, err := loadThunderConfigurations(serverHome)
+	if err == nil {
+		err = config.InitializeServerRuntime(serverHome, cfg)
+	}
+	if err != nil {
+		report := configvalidation.NewReport(false)
+		report.Add(configvalidation.Finding{
+			Check:       configvalidation.CheckConfig,
+			Severity:    configvalidation.SeverityError,
+			Message:     err.Error(),
+			Remediation: "Correct repository/conf/deployment.yaml and run the validation again.",
+		})
+		return report
+	}
+
+	ctx := context.Background()
+	report := configvalidation.Validate(ctx, cfg)
+	if report.Errors > 0 {
+		// The services cannot be initialized until the errors are corrected.
+		return report
+	}
+
+	security.InitSystemPermissions(cfg.Resource.SystemResourceServer.Handle)
+	registerServices(http.NewServeMux(), cache.Initialize())
+
+	report.Merge(configvalidation.ValidateFlowReferences(ctx, cfg, newFlowLookup(flowMgtSvc)))
+	return report
+}
@@ -0,0 +1,117 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"flag"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	flowcommon "github.com/thunder-id/thunderid/internal/flow/common"
+	flowmgt "github.com/thunder-id/thunderid/internal/flow/mgt"
+	"github.com/thunder-id/thunderid/internal/system/configvalidation"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/tests/mocks/flow/flowmgtmock"
+)
+
+func TestFlowLookup_FlowExists(t *testing.T) {
+	flowMgtService := flowmgtmock.NewFlowMgtServiceInterfaceMock(t)
+	flowMgtService.On("GetFlowByHandle", context.Background(), "basic", flowcommon.FlowTypeAuthentication).
+		Return(&flowmgt.CompleteFlowDefinition{ID: "flow-1"}, nil)
+	flowMgtService.On("GetFlowByHandle", context.Background(), "missing", flowcommon.FlowTypeAuthentication).
+		Return(nil, &serviceerror.ServiceError{Type: serviceerror.ClientErrorType})
+	flowMgtService.On("GetFlowByHandle", context.Background(), "broken", flowcommon.FlowTypeAuthentication).
+		Return(nil, &serviceerror.InternalServerError)
+	lookup := newFlowLookup(flowMgtService)
+
+	exists, err := lookup.FlowExists(context.Background(), "basic", configvalidation.FlowTypeAuthentication)
+	assert.NoError(t, err)
+	assert.True(t, exists)
+
+	exists, err = lookup.FlowExists(context.Background(), "missing", configvalidation.FlowTypeAuthentication)
+	assert.NoError(t, err)
+	assert.False(t, exists)
+
+	_, err = lookup.FlowExists(context.Background(), "broken", configvalidation.FlowTypeAuthentication)
+	assert.Error(t, err)
+}
+
+func TestRunConfigValidation_ReportsConfigLoadFailure(t *testing.T) {
+	var output bytes.Buffer
+
+	exitCode := runConfigValidation(&output, t.TempDir())
+
+	assert.Equal(t, 1, exitCode)
+	var report configvalidation.Report
+	require.NoError(t, json.Unmarshal(output.Bytes(), &report))
+	assert.False(t, report.Valid)
+	assert.Equal(t, 1, report.Errors)
+	require.Len(t, report.Findings, 1)
+	assert.Equal(t, configvalidation.CheckConfig, report.Findings[0].Check)
+	assert.NotEmpty(t, report.Findings[0].Message)
+}
+
+func TestEnforceConfigValidation_AllowsWarningsOutsideStrictMode(t *testing.T) {
+	report := configvalidation.NewReport(false)
+	report.Add(configvalidation.Finding{Check: configvalidation.CheckIssuer,
+		Severity: configvalidation.SeverityWarning, Message: "the issuer does not use HTTPS"})
+
+	assert.NotPanics(t, func() {
+		enforceConfigValidation(log.GetLogger(), report)
+	})
+}
+
+func TestEnforceConfigValidation_ExitsInStrictModeOnWarnings(t *testing.T) {
+	if os.Getenv("TEST_ENFORCE_CONFIG_VALIDATION_EXIT") == "1" {
+		report := configvalidation.NewReport(true)
+		report.Add(configvalidation.Finding{Check: configvalidation.CheckIssuer,
+			Severity: configvalidation.SeverityWarning, Message: "the issuer does not use HTTPS"})
+		enforceConfigValidation(log.GetLogger(), report)
+		return
+	}
+
+	runExitHelper(t, "TEST_ENFORCE_CONFIG_VALIDATION_EXIT", "TestEnforceConfigValidation_ExitsInStrictModeOnWarnings")
+}
+
+func TestGetThunderHome_ParsesValidateConfigFlag(t *testing.T) {
+	origArgs := os.Args
+	origCommandLine := flag.CommandLine
+	t.Cleanup(func() {
+		os.Args = origArgs
+		flag.CommandLine = origCommandLine
+		validateConfigOnly = false
+		log.SetOutput(os.Stdout)
+	})
+
+	tmpDir := t.TempDir()
+	flag.CommandLine = flag.NewFlagSet(os.Args[0], flag.ExitOnError)
+	os.Args = []string{origArgs[0], "-serverHome", tmpDir, "-validate-config"}
+
+	got := getThunderHome(log.GetLogger())
+
+	assert.Equal(t, tmpDir, got)
+	assert.True(t, validateConfigOnly)
+}
@@ -0,0 +1,49 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package main
+
+import (
+	"context"
+	"errors"
+
+	"github.com/thunder-id/thunderid/internal/entityprovider"
+	"github.com/thunder-id/thunderid/internal/system/security"
+)
+
+// errLegacyUserNotActive is returned when the entity a legacy token maps to is not an active user.
+var errLegacyUserNotActive = errors.New("legacy token does not map to an active user")
+
+// newLegacyUserResolver creates a resolver that maps the users of tokens issued by legacy issuers to the
+// active user holding the attribute value.
+func newLegacyUserResolver(entityProvider entityprovider.EntityProviderInterface) security.LegacyUserResolverFunc {
+	return func(_ context.Context, attribute, value string) (string, string, error) {
+		entityID, providerErr := entityProvider.IdentifyEntity(map[string]interface{}{attribute: value})
+		if providerErr != nil {
+			return "", "", providerErr
+		}
+		entity, providerErr := entityProvider.GetEntity(*entityID)
+		if providerErr != nil {
+			return "", "", providerErr
+		}
+		if entity.Category != entityprovider.EntityCategoryUser || entity.State != entityprovider.EntityStateActive {
+			return "", "", errLegacyUserNotActive
+		}
+		return entity.ID, entity.OUID, nil
+	}
+}
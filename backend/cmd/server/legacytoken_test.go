@@ -0,0 +1,57 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package main
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/thunder-id/thunderid/internal/entityprovider"
+	"github.com/thunder-id/thunderid/tests/mocks/entityprovidermock"
+)
+
+func TestLegacyUserResolver(t *testing.T) {
+	entityProvider := entityprovidermock.NewEntityProviderInterfaceMock(t)
+	userID := "user-1"
+	appID := "app-1"
+	entityProvider.On("IdentifyEntity", map[string]interface{}{"email": "alice@example.com"}).Return(&userID, nil)
+	entityProvider.On("IdentifyEntity", map[string]interface{}{"email": "app@example.com"}).Return(&appID, nil)
+	entityProvider.On("IdentifyEntity", map[string]interface{}{"email": "mallory@example.com"}).
+		Return(nil, entityprovider.NewEntityProviderError(entityprovider.ErrorCodeEntityNotFound, "not found", ""))
+	entityProvider.On("GetEntity", userID).Return(&entityprovider.Entity{
+		ID: userID, OUID: "ou-1", Category: entityprovider.EntityCategoryUser, State: entityprovider.EntityStateActive,
+	}, nil)
+	entityProvider.On("GetEntity", appID).Return(&entityprovider.Entity{
+		ID: appID, Category: entityprovider.EntityCategoryApp, State: entityprovider.EntityStateActive,
+	}, nil)
+	resolver := newLegacyUserResolver(entityProvider)
+
+	resolvedID, ouID, err := resolver(context.Background(), "email", "alice@example.com")
+	assert.NoError(t, err)
+	assert.Equal(t, userID, resolvedID)
+	assert.Equal(t, "ou-1", ouID)
+
+	_, _, err = resolver(context.Background(), "email", "app@example.com")
+	assert.ErrorIs(t, err, errLegacyUserNotActive)
+
+	_, _, err = resolver(context.Background(), "email", "mallory@example.com")
+	assert.Error(t, err)
+}
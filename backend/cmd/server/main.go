@@ -33,37 +33,53 @@ import (
 	"syscall"
 	"time"
 
+	"github.com/thunder-id/thunderid/internal/flow/flowexec"
 	"github.com/thunder-id/thunderid/internal/system/cache"
 	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/configvalidation"
 	"github.com/thunder-id/thunderid/internal/system/constants"
 	"github.com/thunder-id/thunderid/internal/system/cors"
 	"github.com/thunder-id/thunderid/internal/system/database/provider"
+	healthcheckservice "github.com/thunder-id/thunderid/internal/system/healthcheck/service"
 	"github.com/thunder-id/thunderid/internal/system/jose/jwt"
 	"github.com/thunder-id/thunderid/internal/system/kmprovider/defaultkm/pkiservice"
 	"github.com/thunder-id/thunderid/internal/system/log"
 	"github.com/thunder-id/thunderid/internal/system/middleware"
+	"github.com/thunder-id/thunderid/internal/system/proxy"
 	"github.com/thunder-id/thunderid/internal/system/security"
+	"github.com/thunder-id/thunderid/internal/tenant"
 )
 
-// shutdownTimeout defines the timeout duration for graceful shutdown.
-const shutdownTimeout = 5 * time.Second
+// defaultDrainTimeout is the time to wait for in-flight requests during shutdown when no drain timeout
+// is configured.
+const defaultDrainTimeout = 30 * time.Second
 
 var (
 	netListen = net.Listen
 	tlsListen = tls.Listen
 )
 
+// validateConfigOnly is set by the validate-config command line flag. When set, the server validates the
+// configuration, prints the findings, and exits without serving requests.
+var validateConfigOnly bool
+
 func main() {
 	startupStartedAt := time.Now()
 	logger := log.GetLogger()
 
 	serverHome := getThunderHome(logger)
+	if validateConfigOnly {
+		os.Exit(runConfigValidation(os.Stdout, serverHome))
+	}
 
 	cfg := initThunderConfigurations(logger, serverHome)
 	if cfg == nil {
 		logger.Fatal("Failed to initialize configurations")
 	}
 
+	// Validate the configuration before initializing the services that depend on it.
+	enforceConfigValidation(logger, configvalidation.Validate(context.Background(), cfg))
+
 	// Install the CORS allowed-origins matcher used by the HTTP middleware.
 	// Compilation errors are already surfaced by config validation; this call
 	// rebuilds the rules and installs them as the cors package singleton.
@@ -86,6 +102,10 @@ func main() {
 	// Register the services.
 	jwtService := registerServices(mux, cacheManager)
 
+	// Validate the flows referenced from the configuration now that the flow service is available.
+	enforceConfigValidation(logger, configvalidation.ValidateFlowReferences(context.Background(), cfg,
+		newFlowLookup(flowMgtSvc)))
+
 	// Register static file handlers for frontend applications.
 	registerStaticFileHandlers(logger, mux, serverHome)
 
@@ -95,13 +115,22 @@ func main() {
 
 	// Create the HTTP server.
 	server := createHTTPServer(logger, cfg, mux, jwtService)
+	registerShutdownHooks(server, flowExecSvc)
 	var ln net.Listener
+	var tlsConfig *tls.Config
 	if cfg.Server.HTTPOnly {
 		logger.Info("TLS is not enabled, starting server without TLS")
-		ln = createListener(logger, server)
 	} else {
-		tlsConfig := loadCertConfig(logger, cfg, serverHome)
+		tlsConfig = loadCertConfig(logger, cfg, serverHome)
+	}
+	switch {
+	case cfg.Server.Proxy.ProxyProtocol:
+		logger.Info("PROXY protocol is enabled for connections from trusted proxies")
+		ln = createProxyProtocolListener(logger, server, cfg.Server.Proxy.TrustedProxies, tlsConfig)
+	case tlsConfig != nil:
 		ln = createTLSListener(logger, server, tlsConfig)
+	default:
+		ln = createListener(logger, server)
 	}
 
 	serverURL := config.GetServerURL(&cfg.Server)
@@ -129,8 +158,15 @@ func getThunderHome(logger *log.Logger) string {
 	// Parse project directory from command line arguments.
 	projectHome := ""
 	projectHomeFlag := flag.String("serverHome", "", "Path to ThunderID home directory")
+	flag.BoolVar(&validateConfigOnly, "validate-config", false,
+		"Validate the configuration, print the findings as JSON, and exit")
 	flag.Parse()
 
+	// Keep the standard output for the validation findings.
+	if validateConfigOnly {
+		log.SetOutput(os.Stderr)
+	}
+
 	if *projectHomeFlag != "" {
 		logger.Info("Using serverHome from command line argument", log.String("serverHome", *projectHomeFlag))
 		projectHome = *projectHomeFlag
@@ -149,9 +185,7 @@ func getThunderHome(logger *log.Logger) string {
 // initThunderConfigurations initializes the configurations.
 func initThunderConfigurations(logger *log.Logger, serverHome string) *config.Config {
 	// Load the configurations.
-	configFilePath := path.Join(serverHome, "repository/conf/deployment.yaml")
-	defaultConfigPath := path.Join(serverHome, "repository/resources/conf/default.json")
-	cfg, err := config.LoadConfig(configFilePath, defaultConfigPath, serverHome)
+	cfg, err := loadThunderConfigurations(serverHome)
 	if err != nil {
 		logger.Fatal("Failed to load configurations", log.Error(err))
 	}
@@ -164,6 +198,13 @@ func initThunderConfigurations(logger *log.Logger, serverHome string) *config.Co
 	return cfg
 }
 
+// loadThunderConfigurations loads the deployment configuration merged with the default configuration.
+func loadThunderConfigurations(serverHome string) (*config.Config, error) {
+	configFilePath := path.Join(serverHome, "repository/conf/deployment.yaml")
+	defaultConfigPath := path.Join(serverHome, "repository/resources/conf/default.json")
+	return config.LoadConfig(configFilePath, defaultConfigPath, serverHome)
+}
+
 // loadCertConfig loads the certificate configuration and extracts the Key ID (kid).
 func loadCertConfig(logger *log.Logger, cfg *config.Config, serverHome string) *tls.Config {
 	// Build full paths for certificate and key files
@@ -181,12 +222,33 @@ func loadCertConfig(logger *log.Logger, cfg *config.Config, serverHome string) *
 // createHTTPServer creates and configures an HTTP server with common settings.
 func createHTTPServer(logger *log.Logger, cfg *config.Config, mux *http.ServeMux,
 	jwtService jwt.JWTServiceInterface) *http.Server {
-	securityMiddleware := createSecurityMiddleware(logger, mux, jwtService)
+	var routeHandler http.Handler = mux
+	if cfg.Tenant.Enabled {
+		routeHandler = tenant.ClaimMiddleware(tenantSvc, routeHandler)
+	}
+	securityMiddleware := createSecurityMiddleware(logger, routeHandler, jwtService,
+		cfg.Server.SecurityConfig.LegacyIssuers)
+
+	resolver, err := proxy.NewResolver(cfg.Server.Proxy.TrustedProxies, cfg.Server.Proxy.ClientCertHeader)
+	if err != nil {
+		logger.Fatal("Failed to initialize proxy configuration", log.Error(err))
+	}
 
 	// Build the middleware chain with proper execution order.
-	// Request flow: CorrelationID (outermost) -> AccessLog -> Security -> Route Handler (innermost)
+	// Request flow: CorrelationID (outermost) -> ClientInfo -> AccessLog -> ProblemDetails ->
+	// AdaptiveConcurrency -> RequestTimeout -> TenantResolution -> YAMLContent -> RequestLimit -> Security ->
+	// TenantClaim -> Route Handler (innermost)
 	// Note: Middlewares are wrapped in reverse order - the last added will execute first.
-	handler := log.AccessLogHandler(logger, securityMiddleware)
+	handler := middleware.RequestLimitMiddleware(cfg.Server.RequestLimits, securityMiddleware)
+	handler = middleware.YAMLContentMiddleware(cfg.Server.RequestLimits, handler)
+	if cfg.Tenant.Enabled {
+		handler = tenant.ResolutionMiddleware(tenantSvc, handler)
+	}
+	handler = middleware.RequestTimeoutMiddleware(cfg.Server.RequestTimeouts, handler)
+	handler = middleware.AdaptiveConcurrencyMiddleware(cfg.Server.AdaptiveConcurrency, handler)
+	handler = middleware.ProblemDetailsMiddleware(cfg.Server.ErrorFormat, handler)
+	handler = log.AccessLogHandler(logger, handler)
+	handler = middleware.ClientInfoMiddleware(resolver, handler)
 	handler = middleware.CorrelationIDMiddleware(handler)
 
 	// Build the server address using hostname and port from the configurations.
@@ -198,6 +260,7 @@ func createHTTPServer(logger *log.Logger, cfg *config.Config, mux *http.ServeMux
 		ReadHeaderTimeout: 10 * time.Second, // Mitigate Slowloris attacks
 		WriteTimeout:      10 * time.Second,
 		IdleTimeout:       120 * time.Second,
+		ConnContext:       proxy.ConnContext,
 	}
 
 	return server
@@ -221,13 +284,29 @@ func createTLSListener(logger *log.Logger, server *http.Server, tlsConfig *tls.C
 	return ln
 }
 
-func createSecurityMiddleware(logger *log.Logger, mux *http.ServeMux,
-	jwtService jwt.JWTServiceInterface) http.Handler {
-	middlewareFunc, err := security.Initialize(jwtService)
+// createProxyProtocolListener creates a listener that accepts PROXY protocol headers from trusted proxies.
+// TLS, when configured, is layered on top so that the header is read before the TLS handshake.
+func createProxyProtocolListener(logger *log.Logger, server *http.Server, trustedProxies []string,
+	tlsConfig *tls.Config) net.Listener {
+	trusted, err := proxy.ParseTrustedProxies(trustedProxies)
+	if err != nil {
+		logger.Fatal("Failed to parse trusted proxies", log.Error(err))
+	}
+
+	ln := proxy.NewListener(createListener(logger, server), trusted)
+	if tlsConfig != nil {
+		ln = tls.NewListener(ln, tlsConfig)
+	}
+	return ln
+}
+
+func createSecurityMiddleware(logger *log.Logger, next http.Handler,
+	jwtService jwt.JWTServiceInterface, legacyIssuers []config.LegacyIssuerConfig) http.Handler {
+	middlewareFunc, err := security.Initialize(jwtService, legacyIssuers, legacyUserResolver)
 	if err != nil {
 		logger.Fatal("Failed to initialize security middleware", log.Error(err))
 	}
-	return middlewareFunc(mux)
+	return middlewareFunc(next)
 }
 
 // gracefulShutdown handles the graceful shutdown of all components.
@@ -236,17 +315,19 @@ func gracefulShutdown(
 	server *http.Server,
 	cacheManager cache.CacheManagerInterface,
 ) {
-	ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
-	defer cancel()
-
-	// Shutdown HTTP server
-	if err := server.Shutdown(ctx); err != nil {
-		logger.Error("Error during server shutdown", log.Error(err))
-	} else {
-		logger.Debug("HTTP server shutdown completed")
+	shutdownCfg := config.GetServerRuntime().Config.Server.Shutdown
+	drainTimeout := time.Duration(shutdownCfg.DrainTimeout) * time.Second
+	if drainTimeout == 0 {
+		drainTimeout = defaultDrainTimeout
 	}
 
-	// Shutdown services
+	// Flow executions are persisted to the runtime store after every step, so once the in-flight
+	// requests are drained another node can continue them. The execution state streams are closed by the
+	// shutdown hooks, and their clients reconnect to another node that resumes them from the store.
+	drainHTTPServer(logger, server, healthSvc, time.Duration(shutdownCfg.DrainDelay)*time.Second, drainTimeout)
+
+	// Shutdown services. This writes the buffered client usage and waits for the observability subscribers,
+	// such as the audit log and webhooks, to process the events still queued for them.
 	unregisterServices()
 
 	// Close database connections
@@ -265,6 +346,45 @@ func gracefulShutdown(
 	logger.Info("Server shutdown completed")
 }
 
+// registerShutdownHooks registers the functions that end long-lived requests when the server shuts down, so
+// that the drain does not wait on them until the drain timeout.
+func registerShutdownHooks(server *http.Server, flowExecService flowexec.FlowExecServiceInterface) {
+	if flowExecService != nil {
+		server.RegisterOnShutdown(flowExecService.CloseExecutionStateStreams)
+	}
+}
+
+// drainHTTPServer stops the HTTP server without dropping in-flight requests. The readiness check reports
+// the server as draining and keep-alives are disabled for the drain delay so that load balancers and
+// clients move to other nodes. The server then stops accepting connections and waits up to the drain
+// timeout for in-flight requests, after which the remaining connections are closed. The shutdown hooks of the
+// server run once it stops accepting connections.
+func drainHTTPServer(logger *log.Logger, server *http.Server,
+	healthCheckSvc healthcheckservice.HealthCheckServiceInterface, drainDelay, drainTimeout time.Duration) {
+	if healthCheckSvc != nil {
+		healthCheckSvc.MarkDraining()
+	}
+	server.SetKeepAlivesEnabled(false)
+
+	if drainDelay > 0 {
+		logger.Info("Waiting before draining in-flight requests", log.String("drainDelay", drainDelay.String()))
+		time.Sleep(drainDelay)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), drainTimeout)
+	defer cancel()
+
+	if err := server.Shutdown(ctx); err != nil {
+		logger.Error("In-flight requests did not complete before the drain timeout, closing connections",
+			log.String("drainTimeout", drainTimeout.String()), log.Error(err))
+		if err := server.Close(); err != nil {
+			logger.Error("Error closing server connections", log.Error(err))
+		}
+	} else {
+		logger.Debug("HTTP server shutdown completed")
+	}
+}
+
 // registerStaticFileHandlers registers static file handlers for frontend applications.
 func registerStaticFileHandlers(logger *log.Logger, mux *http.ServeMux, serverHome string) {
 	// Serve gate application from /gate
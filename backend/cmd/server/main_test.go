@@ -39,12 +39,17 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 
 	"github.com/thunder-id/thunderid/internal/system/config"
 	"github.com/thunder-id/thunderid/internal/system/constants"
 	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/tests/mocks/flow/flowexecmock"
+	"github.com/thunder-id/thunderid/tests/mocks/healthcheck/servicemock"
 	"github.com/thunder-id/thunderid/tests/mocks/jose/jwtmock"
+	"github.com/thunder-id/thunderid/tests/mocks/oauth/oauth2/clientusagemock"
+	"github.com/thunder-id/thunderid/tests/mocks/observability/observabilitymock"
 )
 
 // CreateSecurityMiddlewareTestSuite defines the test suite for createSecurityMiddleware function
@@ -134,7 +139,7 @@ func (suite *CreateSecurityMiddlewareTestSuite) TestCreateSecurityMiddleware_Wit
 			}
 
 			// Execute
-			handler := createSecurityMiddleware(suite.logger, suite.mux, suite.mockJWTService)
+			handler := createSecurityMiddleware(suite.logger, suite.mux, suite.mockJWTService, nil)
 
 			// Assert - handler is always returned now, regardless of skip security flag
 			assert.NotNil(suite.T(), handler, "Handler should always be non-nil")
@@ -148,9 +153,9 @@ func (suite *CreateSecurityMiddlewareTestSuite) TestCreateSecurityMiddleware_Wit
 // TestCreateSecurityMiddleware_MultipleInvocations tests that multiple calls work correctly
 func (suite *CreateSecurityMiddlewareTestSuite) TestCreateSecurityMiddleware_MultipleInvocations() {
 	// Execute multiple times
-	handler1 := createSecurityMiddleware(suite.logger, suite.mux, suite.mockJWTService)
-	handler2 := createSecurityMiddleware(suite.logger, suite.mux, suite.mockJWTService)
-	handler3 := createSecurityMiddleware(suite.logger, suite.mux, suite.mockJWTService)
+	handler1 := createSecurityMiddleware(suite.logger, suite.mux, suite.mockJWTService, nil)
+	handler2 := createSecurityMiddleware(suite.logger, suite.mux, suite.mockJWTService, nil)
+	handler3 := createSecurityMiddleware(suite.logger, suite.mux, suite.mockJWTService, nil)
 
 	// Assert - each call should return a new handler instance
 	assert.NotNil(suite.T(), handler1)
@@ -161,17 +166,17 @@ func (suite *CreateSecurityMiddlewareTestSuite) TestCreateSecurityMiddleware_Mul
 // TestCreateSecurityMiddleware_RuntimeToggle tests toggling security at runtime by changing environment variable
 func (suite *CreateSecurityMiddlewareTestSuite) TestCreateSecurityMiddleware_RuntimeToggle() {
 	// First call with security enabled
-	handler1 := createSecurityMiddleware(suite.logger, suite.mux, suite.mockJWTService)
+	handler1 := createSecurityMiddleware(suite.logger, suite.mux, suite.mockJWTService, nil)
 	assert.NotNil(suite.T(), handler1, "First handler should not be nil")
 
 	// Disable security
 	_ = os.Setenv("SKIP_SECURITY", "true")
-	handler2 := createSecurityMiddleware(suite.logger, suite.mux, suite.mockJWTService)
+	handler2 := createSecurityMiddleware(suite.logger, suite.mux, suite.mockJWTService, nil)
 	assert.NotNil(suite.T(), handler2, "Second handler should not be nil (skipSecurity is handled internally)")
 
 	// Re-enable security
 	_ = os.Unsetenv("SKIP_SECURITY")
-	handler3 := createSecurityMiddleware(suite.logger, suite.mux, suite.mockJWTService)
+	handler3 := createSecurityMiddleware(suite.logger, suite.mux, suite.mockJWTService, nil)
 	assert.NotNil(suite.T(), handler3, "Third handler should not be nil after re-enabling security")
 }
 
@@ -204,6 +209,140 @@ func TestCreateHTTPServer_WithHTTPOnly(t *testing.T) {
 	assert.NotZero(t, server.IdleTimeout)
 }
 
+// startDrainTestServer serves the handler on a loopback listener and returns the server and its URL.
+func startDrainTestServer(t *testing.T, handler http.Handler) (*http.Server, string) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to listen: %v", err)
+	}
+	server := &http.Server{Handler: handler, ReadHeaderTimeout: time.Second}
+	go func() {
+		_ = server.Serve(ln)
+	}()
+	return server, "http://" + ln.Addr().String()
+}
+
+func TestDrainHTTPServer_CompletesInFlightRequests(t *testing.T) {
+	started := make(chan struct{})
+	release := make(chan struct{})
+	server, url := startDrainTestServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		close(started)
+		<-release
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	healthSvc := servicemock.NewHealthCheckServiceInterfaceMock(t)
+	healthSvc.On("MarkDraining").Once()
+
+	statusCodes := make(chan int, 1)
+	go func() {
+		resp, err := http.Get(url)
+		if err != nil {
+			statusCodes <- 0
+			return
+		}
+		_ = resp.Body.Close()
+		statusCodes <- resp.StatusCode
+	}()
+	<-started
+
+	drained := make(chan struct{})
+	go func() {
+		drainHTTPServer(log.GetLogger(), server, healthSvc, 0, 5*time.Second)
+		close(drained)
+	}()
+
+	select {
+	case <-drained:
+		t.Fatal("server drained before the in-flight request completed")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	close(release)
+	assert.Equal(t, http.StatusOK, <-statusCodes)
+	<-drained
+
+	_, err := http.Get(url)
+	assert.Error(t, err)
+}
+
+func TestDrainHTTPServer_ClosesConnectionsAfterDrainTimeout(t *testing.T) {
+	started := make(chan struct{})
+	release := make(chan struct{})
+	defer close(release)
+	server, url := startDrainTestServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		close(started)
+		<-release
+	}))
+
+	requestErrs := make(chan error, 1)
+	go func() {
+		resp, err := http.Get(url)
+		if err == nil {
+			_ = resp.Body.Close()
+		}
+		requestErrs <- err
+	}()
+	<-started
+
+	drainHTTPServer(log.GetLogger(), server, nil, 0, 50*time.Millisecond)
+
+	assert.Error(t, <-requestErrs)
+}
+
+func TestDrainHTTPServer_ClosesExecutionStateStreams(t *testing.T) {
+	started := make(chan struct{})
+	closed := make(chan struct{})
+	server, url := startDrainTestServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		w.(http.Flusher).Flush()
+		close(started)
+		<-closed
+	}))
+	flowExecSvc := flowexecmock.NewFlowExecServiceInterfaceMock(t)
+	flowExecSvc.On("CloseExecutionStateStreams").Run(func(mock.Arguments) { close(closed) }).Once()
+	registerShutdownHooks(server, flowExecSvc)
+
+	statusCodes := make(chan int, 1)
+	go func() {
+		resp, err := http.Get(url)
+		if err != nil {
+			statusCodes <- 0
+			return
+		}
+		_, _ = io.Copy(io.Discard, resp.Body)
+		_ = resp.Body.Close()
+		statusCodes <- resp.StatusCode
+	}()
+	<-started
+
+	drained := make(chan struct{})
+	go func() {
+		drainHTTPServer(log.GetLogger(), server, nil, 0, 5*time.Second)
+		close(drained)
+	}()
+
+	select {
+	case <-drained:
+	case <-time.After(2 * time.Second):
+		t.Fatal("server did not drain before the drain timeout")
+	}
+	assert.Equal(t, http.StatusOK, <-statusCodes)
+}
+
+func TestUnregisterServices_FlushesAsyncWriters(t *testing.T) {
+	usageSvc := clientusagemock.NewClientUsageServiceInterfaceMock(t)
+	usageSvc.On("Flush", mock.Anything).Once()
+	obsSvc := observabilitymock.NewObservabilityServiceInterfaceMock(t)
+	obsSvc.On("Shutdown").Once()
+
+	prevUsageSvc, prevObsSvc := clientUsageSvc, observabilitySvc
+	clientUsageSvc, observabilitySvc = usageSvc, obsSvc
+	defer func() { clientUsageSvc, observabilitySvc = prevUsageSvc, prevObsSvc }()
+
+	unregisterServices()
+}
+
 func TestCreateListener_Success(t *testing.T) {
 	logger := log.GetLogger()
 	server := &http.Server{
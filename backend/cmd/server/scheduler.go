@@ -0,0 +1,42 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package main
+
+import (
+	"context"
+	"errors"
+
+	"github.com/thunder-id/thunderid/internal/system/scheduler"
+	"github.com/thunder-id/thunderid/internal/tenant"
+)
+
+// newTenantLister creates a lister that returns the IDs of the tenants the scheduled jobs run in.
+func newTenantLister(tenantService tenant.TenantServiceInterface) scheduler.TenantListerFunc {
+	return func(ctx context.Context) ([]string, error) {
+		tenants, svcErr := tenantService.GetTenantList(ctx)
+		if svcErr != nil {
+			return nil, errors.New(svcErr.ErrorDescription.DefaultValue)
+		}
+		tenantIDs := make([]string, 0, len(tenants))
+		for _, t := range tenants {
+			tenantIDs = append(tenantIDs, t.ID)
+		}
+		return tenantIDs, nil
+	}
+}
@@ -0,0 +1,51 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package main
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/tenant"
+	"github.com/thunder-id/thunderid/tests/mocks/tenantmock"
+)
+
+func TestTenantLister(t *testing.T) {
+	tenantService := tenantmock.NewTenantServiceInterfaceMock(t)
+	tenantService.On("GetTenantList", context.Background()).
+		Return([]tenant.Tenant{{ID: "tenant-1"}, {ID: "tenant-2"}}, nil).Once()
+
+	tenantIDs, err := newTenantLister(tenantService)(context.Background())
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"tenant-1", "tenant-2"}, tenantIDs)
+}
+
+func TestTenantLister_ReturnsServiceError(t *testing.T) {
+	tenantService := tenantmock.NewTenantServiceInterfaceMock(t)
+	tenantService.On("GetTenantList", context.Background()).
+		Return(nil, &serviceerror.InternalServerError).Once()
+
+	_, err := newTenantLister(tenantService)(context.Background())
+
+	assert.Error(t, err)
+}
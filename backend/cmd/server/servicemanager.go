@@ -20,13 +20,21 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"strings"
+	"time"
 
+	"github.com/thunder-id/thunderid/internal/accessreview"
+	"github.com/thunder-id/thunderid/internal/accountdeletion"
+	"github.com/thunder-id/thunderid/internal/accountprotection"
+	"github.com/thunder-id/thunderid/internal/adminnotification"
 	"github.com/thunder-id/thunderid/internal/agent"
 	"github.com/thunder-id/thunderid/internal/application"
+	"github.com/thunder-id/thunderid/internal/appuser"
 	"github.com/thunder-id/thunderid/internal/attributecache"
+	"github.com/thunder-id/thunderid/internal/audit"
 	"github.com/thunder-id/thunderid/internal/authn"
 	authnAssert "github.com/thunder-id/thunderid/internal/authn/assert"
 	authncm "github.com/thunder-id/thunderid/internal/authn/common"
@@ -40,14 +48,23 @@ import (
 	"github.com/thunder-id/thunderid/internal/authn/passkey"
 	authnprovidermgr "github.com/thunder-id/thunderid/internal/authnprovider/manager"
 	"github.com/thunder-id/thunderid/internal/authz"
+	"github.com/thunder-id/thunderid/internal/breakglass"
 	"github.com/thunder-id/thunderid/internal/cert"
+	"github.com/thunder-id/thunderid/internal/changerequest"
+	"github.com/thunder-id/thunderid/internal/configdrift"
 	"github.com/thunder-id/thunderid/internal/consent"
+	"github.com/thunder-id/thunderid/internal/denylist"
 	layoutmgt "github.com/thunder-id/thunderid/internal/design/layout/mgt"
 	"github.com/thunder-id/thunderid/internal/design/resolve"
 	thememgt "github.com/thunder-id/thunderid/internal/design/theme/mgt"
+	"github.com/thunder-id/thunderid/internal/domainrouting"
+	"github.com/thunder-id/thunderid/internal/emailchange"
+	"github.com/thunder-id/thunderid/internal/enrollmentsession"
 	"github.com/thunder-id/thunderid/internal/entity"
 	"github.com/thunder-id/thunderid/internal/entityprovider"
 	"github.com/thunder-id/thunderid/internal/entitytype"
+	"github.com/thunder-id/thunderid/internal/enumeration"
+	"github.com/thunder-id/thunderid/internal/featureflag"
 	flowcore "github.com/thunder-id/thunderid/internal/flow/core"
 	"github.com/thunder-id/thunderid/internal/flow/executor"
 	"github.com/thunder-id/thunderid/internal/flow/flowexec"
@@ -56,16 +73,32 @@ import (
 	"github.com/thunder-id/thunderid/internal/group"
 	"github.com/thunder-id/thunderid/internal/idp"
 	"github.com/thunder-id/thunderid/internal/inboundclient"
+	"github.com/thunder-id/thunderid/internal/integrity"
 	"github.com/thunder-id/thunderid/internal/notification"
 	"github.com/thunder-id/thunderid/internal/oauth"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/clientusage"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/credentialcheck"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/granthandlers"
 	"github.com/thunder-id/thunderid/internal/ou"
+	"github.com/thunder-id/thunderid/internal/oudeletion"
+	"github.com/thunder-id/thunderid/internal/ouprovisioning"
+	"github.com/thunder-id/thunderid/internal/passwordpolicy"
+	"github.com/thunder-id/thunderid/internal/quota"
+	"github.com/thunder-id/thunderid/internal/reencryption"
+	"github.com/thunder-id/thunderid/internal/reindex"
+	"github.com/thunder-id/thunderid/internal/relationship"
 	"github.com/thunder-id/thunderid/internal/resource"
 	"github.com/thunder-id/thunderid/internal/role"
+	"github.com/thunder-id/thunderid/internal/scim"
+	"github.com/thunder-id/thunderid/internal/securitynotification"
+	"github.com/thunder-id/thunderid/internal/sharedsignals"
 	"github.com/thunder-id/thunderid/internal/system/cache"
+	"github.com/thunder-id/thunderid/internal/system/cachewarming"
 	"github.com/thunder-id/thunderid/internal/system/config"
 	"github.com/thunder-id/thunderid/internal/system/cryptolab/hash"
 	dbprovider "github.com/thunder-id/thunderid/internal/system/database/provider"
 	declarativeresource "github.com/thunder-id/thunderid/internal/system/declarative_resource"
+	"github.com/thunder-id/thunderid/internal/system/distlock"
 	"github.com/thunder-id/thunderid/internal/system/email"
 	"github.com/thunder-id/thunderid/internal/system/export"
 	healthcheckservice "github.com/thunder-id/thunderid/internal/system/healthcheck/service"
@@ -77,16 +110,55 @@ import (
 	"github.com/thunder-id/thunderid/internal/system/kmprovider/defaultkm/pkiservice"
 	"github.com/thunder-id/thunderid/internal/system/log"
 	"github.com/thunder-id/thunderid/internal/system/mcp"
+	"github.com/thunder-id/thunderid/internal/system/objectstore"
 	"github.com/thunder-id/thunderid/internal/system/observability"
+	"github.com/thunder-id/thunderid/internal/system/scheduler"
+	"github.com/thunder-id/thunderid/internal/system/security"
 	"github.com/thunder-id/thunderid/internal/system/services"
 	"github.com/thunder-id/thunderid/internal/system/sysauthz"
 	"github.com/thunder-id/thunderid/internal/system/template"
+	"github.com/thunder-id/thunderid/internal/tenant"
+	"github.com/thunder-id/thunderid/internal/trusteddevice"
 	"github.com/thunder-id/thunderid/internal/user"
+	"github.com/thunder-id/thunderid/internal/userprovisioning"
+	"github.com/thunder-id/thunderid/internal/userpurge"
+	"github.com/thunder-id/thunderid/internal/verificationpurge"
+	"github.com/thunder-id/thunderid/internal/webhook"
+	"github.com/thunder-id/thunderid/internal/wso2migration"
 )
 
 // observabilitySvc is the observability service instance. This is used for graceful shutdown.
 var observabilitySvc observability.ObservabilityServiceInterface
 
+// tenantSvc is the tenant service instance. This is used by the tenant resolution middlewares.
+var tenantSvc tenant.TenantServiceInterface
+
+// flowMgtSvc is the flow management service instance. This is used by the configuration validation.
+var flowMgtSvc flowmgt.FlowMgtServiceInterface
+
+// flowExecSvc is the flow execution service instance. This is used to close the execution state streams
+// during graceful shutdown.
+var flowExecSvc flowexec.FlowExecServiceInterface
+
+// healthSvc is the health check service instance. This is used to report readiness as draining during
+// graceful shutdown.
+var healthSvc healthcheckservice.HealthCheckServiceInterface
+
+// clientUsageSvc is the client usage service instance. This is used to write the buffered client usage
+// during graceful shutdown.
+var clientUsageSvc clientusage.ClientUsageServiceInterface
+
+// credentialCheckSvc is the credential check service instance. This is used to stop the pruning of its
+// rate limiter during graceful shutdown.
+var credentialCheckSvc credentialcheck.CredentialCheckServiceInterface
+
+// jobScheduler runs the scheduled background jobs. This is used to stop the jobs during graceful shutdown.
+var jobScheduler scheduler.SchedulerInterface
+
+// legacyUserResolver maps the users of tokens issued by legacy issuers. This is used by the security
+// middleware.
+var legacyUserResolver security.LegacyUserResolverFunc
+
 // registerServices registers all the services with the provided HTTP multiplexer.
 func registerServices(mux *http.ServeMux, cacheManager cache.CacheManagerInterface) jwt.JWTServiceInterface {
 	logger := log.GetLogger()
@@ -110,6 +182,14 @@ func registerServices(mux *http.ServeMux, cacheManager cache.CacheManagerInterfa
 
 	observabilitySvc = observability.Initialize()
 
+	lockManager, err := distlock.Initialize()
+	if err != nil {
+		logger.Fatal("Failed to initialize distributed lock manager", log.Error(err))
+	}
+
+	// Initialize feature flag service
+	_ = featureflag.Initialize(mux)
+
 	// List to collect exporters from each package
 	var exporters []declarativeresource.ResourceExporter
 
@@ -121,7 +201,7 @@ func registerServices(mux *http.ServeMux, cacheManager cache.CacheManagerInterfa
 	// Add to exporters list (must be done after initializing list)
 	exporters = append(exporters, i18nExporter)
 
-	ouAuthzService, err := sysauthz.Initialize()
+	ouAuthzService, err := sysauthz.Initialize(config.GetServerRuntime().Config.SystemAuthorization, observabilitySvc)
 	if err != nil {
 		logger.Fatal("Failed to initialize system authorization service", log.Error(err))
 	}
@@ -137,6 +217,12 @@ func registerServices(mux *http.ServeMux, cacheManager cache.CacheManagerInterfa
 	// would arise if sysauthz were to directly import the ou package.
 	ouAuthzService.SetOUHierarchyResolver(ouHierarchyResolver)
 
+	// Initialize the relationship service, which injects itself into the authz service as the provider
+	// of relationship checks when the relationships feature is enabled.
+	if _, err := relationship.Initialize(mux, ouAuthzService, observabilitySvc); err != nil {
+		logger.Fatal("Failed to initialize RelationshipService", log.Error(err))
+	}
+
 	hashCfg, err := buildHashConfig()
 	if err != nil {
 		logger.Fatal("Failed to build HashService config", log.Error(err))
@@ -157,25 +243,52 @@ func registerServices(mux *http.ServeMux, cacheManager cache.CacheManagerInterfa
 	}
 	exporters = append(exporters, entityTypeExporter)
 
+	// Initialize deny list service
+	denyListService, err := denylist.Initialize(mux)
+	if err != nil {
+		logger.Fatal("Failed to initialize DenyListService", log.Error(err))
+	}
+
+	// Initialize quota service
+	quotaService, err := quota.Initialize(mux, ouService, observabilitySvc)
+	if err != nil {
+		logger.Fatal("Failed to initialize QuotaService", log.Error(err))
+	}
+
 	// Initialize entity service
-	entityService, err := entity.Initialize(cacheManager, hashService, entityTypeService, ouService)
+	entityService, err := entity.Initialize(cacheManager, hashService, entityTypeService, ouService, denyListService,
+		quotaService)
 	if err != nil {
 		logger.Fatal("Failed to initialize EntityService", log.Error(err))
 	}
 
 	// Initialize entity provider
 	entityProvider := entityprovider.InitializeEntityProvider(entityService)
+	legacyUserResolver = newLegacyUserResolver(entityProvider)
+
+	objectStore, err := objectstore.Initialize()
+	if err != nil {
+		logger.Fatal("Failed to initialize object store", log.Error(err))
+	}
 
 	userService, ouUserResolver, userExporter, err := user.Initialize(
-		mux, entityService, ouService, entityTypeService, ouAuthzService,
+		mux, entityService, ouService, entityTypeService, ouAuthzService, objectStore, observabilitySvc,
 	)
 	if err != nil {
 		logger.Fatal("Failed to initialize UserService", log.Error(err))
 	}
 	exporters = append(exporters, userExporter)
 
+	passwordPolicyService, err := passwordpolicy.Initialize(
+		mux, ouService, entityTypeService, entityService, denyListService, hashService,
+	)
+	if err != nil {
+		logger.Fatal("Failed to initialize PasswordPolicyService", log.Error(err))
+	}
+	userService.SetPasswordPolicyService(passwordPolicyService)
+
 	groupService, ouGroupResolver, groupExporter, err := group.Initialize(
-		mux, dbprovider.GetDBProvider(), ouService, entityService, entityTypeService, ouAuthzService,
+		mux, dbprovider.GetDBProvider(), ouService, entityService, entityTypeService, ouAuthzService, quotaService,
 	)
 	if err != nil {
 		logger.Fatal("Failed to initialize GroupService", log.Error(err))
@@ -191,8 +304,13 @@ func registerServices(mux *http.ServeMux, cacheManager cache.CacheManagerInterfa
 		logger.Fatal("Failed to initialize Resource Service", log.Error(err))
 	}
 	exporters = append(exporters, resourceExporter)
+	changeRequestService, err := changerequest.Initialize(mux, ouAuthzService, observabilitySvc)
+	if err != nil {
+		logger.Fatal("Failed to initialize ChangeRequestService", log.Error(err))
+	}
 	roleService, roleAssignmentService, roleExporter, err := role.Initialize(
-		mux, entityService, groupService, ouService, resourceService, entityTypeService,
+		mux, entityService, groupService, ouService, resourceService, entityTypeService, changeRequestService,
+		observabilitySvc,
 	)
 	if err != nil {
 		logger.Fatal("Failed to initialize RoleService", log.Error(err))
@@ -200,12 +318,43 @@ func registerServices(mux *http.ServeMux, cacheManager cache.CacheManagerInterfa
 	exporters = append(exporters, roleExporter)
 	authZService := authz.Initialize(roleService)
 
+	tenantSvc, err = tenant.Initialize(mux, cacheManager, ouService, resourceService, roleService)
+	if err != nil {
+		logger.Fatal("Failed to initialize TenantService", log.Error(err))
+	}
+
+	// Scheduled jobs run in the deployment root and in every tenant.
+	jobScheduler = scheduler.Initialize(lockManager, newTenantLister(tenantSvc))
+
+	_ = oudeletion.Initialize(mux, ouService, userService, groupService, roleAssignmentService, ouAuthzService)
+	_ = ouprovisioning.Initialize(mux, ouService)
+	_ = integrity.Initialize(mux, ouService, entityService, groupService, lockManager)
+	_ = verificationpurge.Initialize(entityService, jobScheduler)
+	_ = userpurge.Initialize(userService, jobScheduler)
+	if _, err := accessreview.Initialize(
+		mux, roleService, roleAssignmentService, lockManager, observabilitySvc,
+	); err != nil {
+		logger.Fatal("Failed to initialize AccessReviewService", log.Error(err))
+	}
+	_ = adminnotification.Initialize(mux, roleService, jobScheduler, observabilitySvc)
+	_ = webhook.Initialize(mux, jobScheduler, observabilitySvc)
+	_ = audit.Initialize(mux, jobScheduler, observabilitySvc)
+	_ = sharedsignals.Initialize(mux, jwtService, jobScheduler, observabilitySvc)
+	_ = reencryption.Initialize(mux, configCryptoSvc)
+	_ = reindex.Initialize(mux, entityService)
+	appUserService := appuser.Initialize(mux, entityService)
+	userService.SetAppUserService(appUserService)
+	trustedDeviceService := trusteddevice.Initialize(mux, jwtService, entityProvider, ouAuthzService)
+	breakGlassService := breakglass.Initialize(mux, entityProvider, observabilitySvc)
+
 	idpService, idpExporter, err := idp.Initialize(cacheManager, mux)
 	if err != nil {
 		logger.Fatal("Failed to initialize IDPService", log.Error(err))
 	}
 	exporters = append(exporters, idpExporter)
 
+	domainRoutingService := domainrouting.Initialize(mux, ouService, idpService)
+
 	templateService, err := template.Initialize()
 	if err != nil {
 		logger.Fatal("Failed to initialize template service", log.Error(err))
@@ -251,8 +400,10 @@ func registerServices(mux *http.ServeMux, cacheManager cache.CacheManagerInterfa
 	authAssertGen := authnAssert.Initialize()
 	consentEnforcer := authnConsent.Initialize(consentService, jwtService)
 
+	enumerationService := enumeration.Initialize(mux)
 	authn.Initialize(mux, mcpServer, idpService, jwtService, authnProvider, authAssertGen, passkeyService,
-		otpCoreService, magicLinkService, oauthAuthnService, oidcAuthnService, googleAuthnService, githubAuthnService)
+		otpCoreService, magicLinkService, oauthAuthnService, oidcAuthnService, googleAuthnService, githubAuthnService,
+		enumerationService)
 
 	attributeCacheService := attributecache.Initialize()
 
@@ -265,17 +416,53 @@ func registerServices(mux *http.ServeMux, cacheManager cache.CacheManagerInterfa
 			"EmailExecutor will be registered but will not send emails.", log.Error(err))
 		emailClient = nil
 	}
+
+	// Initialize security notifications and let the user service notify users of credential changes.
+	securityNotifier := securitynotification.Initialize(entityProvider, templateService, emailClient, notifSenderSvc)
+	userService.SetSecurityNotifier(securityNotifier)
+
+	// Initialize account protection and let the user service guard high-risk self-service changes.
+	accountProtection := accountprotection.Initialize(mux, entityService, jwtService, securityNotifier)
+	if accountProtection != nil {
+		userService.SetAccountProtectionService(accountProtection)
+	}
+
+	// Initialize enrollment sessions that hand authenticator enrollment URIs to the client as QR code content.
+	_ = enrollmentsession.Initialize(mux, runtimeCryptoSvc)
+
+	// Initialize email change confirmation and let the user service hold self-service email changes.
+	if emailChangeService := emailchange.Initialize(mux, entityService, ouAuthzService, templateService,
+		emailClient, accountProtection); emailChangeService != nil {
+		userService.SetEmailChangeService(emailChangeService)
+	}
+
+	// Initialize the deletion of accounts requested by their users and the purge of due deletions.
+	_ = accountdeletion.Initialize(mux, entityService, ouAuthzService, templateService, emailClient, jobScheduler,
+		observabilitySvc)
+
+	if _, err := userprovisioning.Initialize(mux, dbprovider.GetDBProvider(), userService, groupService,
+		roleAssignmentService, templateService, emailClient); err != nil {
+		logger.Fatal("Failed to initialize UserProvisioningService", log.Error(err))
+	}
+
+	// Initialize the SCIM 2.0 endpoints that provision users and groups from enterprise identity providers.
+	if _, err := scim.Initialize(mux, userService, groupService, entityTypeService); err != nil {
+		logger.Fatal("Failed to initialize SCIMService", log.Error(err))
+	}
+
 	execRegistry := executor.Initialize(flowFactory, ouService, idpService, notifSenderSvc, jwtService, authAssertGen,
 		consentEnforcer, authnProvider, otpCoreService, passkeyService, magicLinkService, authZService,
 		entityTypeService, groupService, roleService, roleAssignmentService, entityProvider,
 		attributeCacheService, emailClient, templateService, oauthAuthnService, oidcAuthnService,
-		githubAuthnService, googleAuthnService)
+		githubAuthnService, googleAuthnService, trustedDeviceService, domainRoutingService, breakGlassService,
+		securityNotifier, accountProtection, enumerationService, appUserService)
 
 	flowMgtService, flowMgtExporter, err := flowmgt.Initialize(
-		mux, mcpServer, cacheManager, flowFactory, execRegistry, graphCache)
+		mux, mcpServer, cacheManager, flowFactory, execRegistry, graphCache, quotaService, observabilitySvc)
 	if err != nil {
 		logger.Fatal("Failed to initialize FlowMgtService", log.Error(err))
 	}
+	flowMgtSvc = flowMgtService
 	exporters = append(exporters, flowMgtExporter)
 	certservice, err := cert.Initialize(cacheManager, dbprovider.GetDBProvider())
 	if err != nil {
@@ -295,16 +482,26 @@ func registerServices(mux *http.ServeMux, cacheManager cache.CacheManagerInterfa
 	}
 	exporters = append(exporters, layoutExporter)
 
+	// Custom grant types must be registered before applications that allow them are validated.
+	if err := granthandlers.LoadCustomGrantPlugins(); err != nil {
+		logger.Fatal("Failed to load custom grant plugins", log.Error(err))
+	}
+
 	inboundClientService, err := inboundclient.Initialize(
 		cacheManager, certservice, entityProvider,
 		themeMgtService, layoutMgtService, flowMgtService, entityTypeService, consentService)
 	if err != nil {
 		logger.Fatal("Failed to initialize InboundClientService", log.Error(err))
 	}
+	appUserService.SetInboundClientService(inboundClientService)
+
+	// Initialize client usage tracking, which records the use of OAuth clients and reports inactive ones.
+	clientUsageSvc = clientusage.Initialize(entityProvider, observabilitySvc, lockManager)
 
 	// TODO: Remove entityService dependency after finalizing declarative resource loading pattern
 	applicationService, applicationExporter, err := application.Initialize(
-		mux, mcpServer, entityProvider, entityService, inboundClientService, ouService, i18nService)
+		mux, mcpServer, entityProvider, entityService, inboundClientService, ouService, i18nService,
+		clientUsageSvc)
 	if err != nil {
 		logger.Fatal("Failed to initialize ApplicationService", log.Error(err))
 	}
@@ -318,7 +515,8 @@ func registerServices(mux *http.ServeMux, cacheManager cache.CacheManagerInterfa
 	designResolveService := resolve.Initialize(mux, themeMgtService, layoutMgtService, applicationService)
 
 	// Initialize flow metadata service
-	_ = flowmeta.Initialize(mux, inboundClientService, entityProvider, ouService, designResolveService, i18nService)
+	flowMetaService := flowmeta.Initialize(mux, inboundClientService, entityProvider, ouService,
+		designResolveService, i18nService)
 
 	// Initialize export service with collected exporters
 	_ = export.Initialize(mux, exporters)
@@ -341,47 +539,107 @@ func registerServices(mux *http.ServeMux, cacheManager cache.CacheManagerInterfa
 		i18nService,
 	)
 
+	// Initialize the migration of resources exported from WSO2 Identity Server.
+	_ = wso2migration.Initialize(mux, applicationService, userService, entityService, entityTypeService,
+		roleService, ouService)
+
 	flowExecService, err := flowexec.Initialize(mux, flowMgtService, inboundClientService, entityProvider,
-		execRegistry, observabilitySvc, runtimeCryptoSvc)
+		execRegistry, observabilitySvc, runtimeCryptoSvc, trustedDeviceService, flowMetaService, securityNotifier)
 	if err != nil {
 		logger.Fatal("Failed to initialize flow execution service", log.Error(err))
 	}
+	flowExecSvc = flowExecService
 
 	// Initialize OAuth services.
-	err = oauth.Initialize(mux, applicationService, inboundClientService, authnProvider, jwtService, jweService,
-		flowExecService, observabilitySvc, runtimeCryptoSvc, ouService, attributeCacheService, authZService,
-		entityProvider, resourceService, i18nService, idpService)
+	credentialCheckSvc, err = oauth.Initialize(mux, applicationService, inboundClientService, authnProvider,
+		jwtService, jweService, flowExecService, observabilitySvc, runtimeCryptoSvc, ouService, attributeCacheService,
+		authZService, entityProvider, resourceService, i18nService, idpService, ouAuthzService, clientUsageSvc,
+		roleService, cacheManager, enumerationService)
 	if err != nil {
 		logger.Fatal("Failed to initialize OAuth services", log.Error(err))
 	}
 
+	_ = configdrift.Initialize(mux, inboundClientService, observabilitySvc, lockManager)
+
 	// Register the health service.
-	healthSvc := healthcheckservice.Initialize(dbprovider.GetDBProvider(), dbprovider.GetRedisProvider())
+	healthSvc = healthcheckservice.Initialize(dbprovider.GetDBProvider(), dbprovider.GetRedisProvider())
 	services.NewHealthCheckService(mux, healthSvc)
 
+	// Warm the caches in the background. The readiness check reports the node as warming until warming
+	// finishes when the readiness gate is enabled.
+	cacheWarmingSvc := cachewarming.Initialize()
+	registerCacheWarmers(cacheWarmingSvc, entityTypeService, inboundClientService, flowMgtService)
+	cacheWarmingSvc.Start(security.WithRuntimeContext(context.Background()), healthSvc)
+
 	return jwtService
 }
 
 // unregisterServices unregisters all services that require cleanup during shutdown.
 func unregisterServices() {
+	if jobScheduler != nil {
+		jobScheduler.Stop()
+	}
+	if credentialCheckSvc != nil {
+		credentialCheckSvc.Stop()
+	}
+	if clientUsageSvc != nil {
+		clientUsageSvc.Flush(context.Background())
+	}
 	observabilitySvc.Shutdown()
 }
 
 // buildHashConfig constructs a hash.HashConfig from the server configuration.
 func buildHashConfig() (hash.HashConfig, error) {
 	cfg := config.GetServerRuntime().Config.Crypto.PasswordHashing
+	var hashCfg hash.HashConfig
 	alg := hash.CredAlgorithm(strings.ToUpper(cfg.Algorithm))
 	switch alg {
 	case "", hash.SHA256:
-		return hash.HashConfig{Algorithm: hash.SHA256, SaltSize: cfg.SHA256.SaltSize}, nil
+		hashCfg = hash.HashConfig{Algorithm: hash.SHA256, SaltSize: cfg.SHA256.SaltSize}
 	case hash.PBKDF2:
-		return hash.HashConfig{Algorithm: alg, SaltSize: cfg.PBKDF2.SaltSize,
-			Iterations: cfg.PBKDF2.Iterations, KeySize: cfg.PBKDF2.KeySize}, nil
+		hashCfg = hash.HashConfig{Algorithm: alg, SaltSize: cfg.PBKDF2.SaltSize,
+			Iterations: cfg.PBKDF2.Iterations, KeySize: cfg.PBKDF2.KeySize}
 	case hash.ARGON2ID:
-		return hash.HashConfig{Algorithm: alg, SaltSize: cfg.Argon2ID.SaltSize,
+		hashCfg = hash.HashConfig{Algorithm: alg, SaltSize: cfg.Argon2ID.SaltSize,
 			Iterations: cfg.Argon2ID.Iterations, Memory: cfg.Argon2ID.Memory,
-			Parallelism: cfg.Argon2ID.Parallelism, KeySize: cfg.Argon2ID.KeySize}, nil
+			Parallelism: cfg.Argon2ID.Parallelism, KeySize: cfg.Argon2ID.KeySize}
 	default:
 		return hash.HashConfig{}, fmt.Errorf("unrecognized password hashing algorithm %q", cfg.Algorithm)
 	}
+
+	legacyFormats, err := buildLegacyHashFormats(cfg.Legacy.Formats)
+	if err != nil {
+		return hash.HashConfig{}, err
+	}
+	hashCfg.LegacyFormats = legacyFormats
+	hashCfg.UpgradeOnVerify = cfg.Legacy.UpgradeOnLogin
+	return hashCfg, nil
+}
+
+// buildLegacyHashFormats converts the configured legacy password formats into their deprecation schedules.
+func buildLegacyHashFormats(formats []config.LegacyPasswordFormatConfig) ([]hash.LegacyFormatConfig, error) {
+	legacyFormats := make([]hash.LegacyFormatConfig, 0, len(formats))
+	for _, format := range formats {
+		legacyFormat := hash.LegacyFormatConfig{Algorithm: hash.CredAlgorithm(strings.ToUpper(format.Algorithm))}
+		if format.SunsetDate != "" {
+			sunset, err := time.Parse(time.DateOnly, format.SunsetDate)
+			if err != nil {
+				if sunset, err = time.Parse(time.RFC3339, format.SunsetDate); err != nil {
+					return nil, fmt.Errorf("invalid sunset date %q for legacy password format %s",
+						format.SunsetDate, format.Algorithm)
+				}
+			}
+			legacyFormat.SunsetDate = sunset
+		}
+		switch strings.ToLower(format.Enforcement) {
+		case "", "warn":
+		case "block":
+			legacyFormat.BlockAfterSunset = true
+		default:
+			return nil, fmt.Errorf("unrecognized enforcement %q for legacy password format %s",
+				format.Enforcement, format.Algorithm)
+		}
+		legacyFormats = append(legacyFormats, legacyFormat)
+	}
+	return legacyFormats, nil
 }
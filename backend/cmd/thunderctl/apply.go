@@ -0,0 +1,242 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/tabwriter"
+)
+
+const importPath = "/import"
+
+// importRequest is the body of the import endpoint.
+type importRequest struct {
+	Content   string                 `json:"content"`
+	Variables map[string]interface{} `json:"variables,omitempty"`
+	DryRun    bool                   `json:"dryRun,omitempty"`
+	Options   *importOptions         `json:"options,omitempty"`
+}
+
+// importOptions controls the import behavior on the server.
+type importOptions struct {
+	ContinueOnError *bool `json:"continueOnError,omitempty"`
+}
+
+// importResponse is the response of the import endpoint.
+type importResponse struct {
+	Summary *importSummary  `json:"summary"`
+	Results []importOutcome `json:"results"`
+}
+
+// importSummary summarizes an import.
+type importSummary struct {
+	TotalDocuments int `json:"totalDocuments"`
+	Imported       int `json:"imported"`
+	Failed         int `json:"failed"`
+}
+
+// importOutcome is the outcome of a single imported document.
+type importOutcome struct {
+	ResourceType string `json:"resourceType"`
+	ResourceID   string `json:"resourceId,omitempty"`
+	ResourceName string `json:"resourceName,omitempty"`
+	Operation    string `json:"operation,omitempty"`
+	Status       string `json:"status"`
+	Code         string `json:"code,omitempty"`
+	Message      string `json:"message,omitempty"`
+}
+
+// fileOutcome pairs an import response with the file it was produced for.
+type fileOutcome struct {
+	File     string          `json:"file"`
+	Response *importResponse `json:"response"`
+}
+
+// runApply imports declarative resource files through the import endpoint.
+func (c *cli) runApply(args []string) error {
+	opts := &globalOptions{}
+	fs := c.newFlagSet("apply")
+	opts.register(fs)
+	var files, vars stringList
+	fs.Var(&files, "f", "declarative resource file, or a directory of .yaml and .yml files (repeatable)")
+	fs.Var(&vars, "var", "template variable as KEY=VALUE (repeatable)")
+	dryRun := fs.Bool("dry-run", false, "validate the files without changing the server")
+	failFast := fs.Bool("fail-fast", false, "stop a file at the first failing document")
+	positional, err := parseArgs(fs, args)
+	if err != nil {
+		return err
+	}
+	files = append(files, positional...)
+	if len(files) == 0 {
+		_, _ = fmt.Fprintln(c.stderr, "Usage: thunderctl apply -f <file|dir> [-f ...] [--var KEY=VALUE] [--dry-run]")
+		return errUsage
+	}
+	format, err := parseOutputFormat(opts.output)
+	if err != nil {
+		return err
+	}
+	variables, err := parseVariables(vars)
+	if err != nil {
+		return err
+	}
+	paths, err := expandFiles(files)
+	if err != nil {
+		return err
+	}
+
+	conn, err := c.resolveConnection(opts)
+	if err != nil {
+		return err
+	}
+	client := newAPIClient(conn)
+
+	outcomes := make([]fileOutcome, 0, len(paths))
+	failed := 0
+	for _, path := range paths {
+		content, err := os.ReadFile(path) //nolint:gosec // The path is provided by the operator.
+		if err != nil {
+			return fmt.Errorf("failed to read %s: %w", path, err)
+		}
+		req := importRequest{Content: string(content), Variables: variables, DryRun: *dryRun}
+		if *failFast {
+			continueOnError := false
+			req.Options = &importOptions{ContinueOnError: &continueOnError}
+		}
+		resp := &importResponse{}
+		if err := client.do(http.MethodPost, importPath, nil, req, resp); err != nil {
+			return fmt.Errorf("failed to apply %s: %w", path, err)
+		}
+		for _, result := range resp.Results {
+			if result.Status != "success" {
+				failed++
+			}
+		}
+		outcomes = append(outcomes, fileOutcome{File: path, Response: resp})
+	}
+
+	if format != outputTable {
+		if err := printDocument(c.stdout, format, toDocument(outcomes)); err != nil {
+			return err
+		}
+	} else if err := printImportTable(c, outcomes, *dryRun); err != nil {
+		return err
+	}
+	if failed > 0 {
+		return fmt.Errorf("%d document(s) failed to apply", failed)
+	}
+	return nil
+}
+
+// printImportTable prints one row per imported document followed by a summary line.
+func printImportTable(c *cli, outcomes []fileOutcome, dryRun bool) error {
+	tw := tabwriter.NewWriter(c.stdout, 0, 4, 2, ' ', 0)
+	_, _ = fmt.Fprintln(tw, "FILE\tTYPE\tNAME\tID\tOPERATION\tSTATUS\tMESSAGE")
+	imported := 0
+	for _, outcome := range outcomes {
+		for _, r := range outcome.Response.Results {
+			message := r.Message
+			if r.Code != "" {
+				message = r.Code + ": " + message
+			}
+			_, _ = fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\t%s\t%s\n", outcome.File, r.ResourceType,
+				r.ResourceName, r.ResourceID, r.Operation, r.Status, message)
+		}
+		if outcome.Response.Summary != nil {
+			imported += outcome.Response.Summary.Imported
+		}
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+	if dryRun {
+		_, _ = fmt.Fprintf(c.stdout, "Dry run: %d document(s) validated, nothing was changed.\n", imported)
+		return nil
+	}
+	_, _ = fmt.Fprintf(c.stdout, "%d document(s) applied.\n", imported)
+	return nil
+}
+
+// parseVariables converts KEY=VALUE flags into import template variables.
+func parseVariables(vars []string) (map[string]interface{}, error) {
+	if len(vars) == 0 {
+		return nil, nil
+	}
+	variables := make(map[string]interface{}, len(vars))
+	for _, v := range vars {
+		key, value, ok := strings.Cut(v, "=")
+		if !ok || key == "" {
+			return nil, fmt.Errorf("invalid variable %q: use KEY=VALUE", v)
+		}
+		variables[key] = value
+	}
+	return variables, nil
+}
+
+// expandFiles replaces directories with the YAML files they contain, in lexical order.
+func expandFiles(files []string) ([]string, error) {
+	paths := make([]string, 0, len(files))
+	for _, file := range files {
+		info, err := os.Stat(file)
+		if err != nil {
+			return nil, err
+		}
+		if !info.IsDir() {
+			paths = append(paths, file)
+			continue
+		}
+		entries, err := os.ReadDir(file)
+		if err != nil {
+			return nil, err
+		}
+		found := make([]string, 0, len(entries))
+		for _, entry := range entries {
+			ext := strings.ToLower(filepath.Ext(entry.Name()))
+			if !entry.IsDir() && (ext == ".yaml" || ext == ".yml") {
+				found = append(found, filepath.Join(file, entry.Name()))
+			}
+		}
+		if len(found) == 0 {
+			return nil, errors.New("no .yaml or .yml files found in " + file)
+		}
+		sort.Strings(found)
+		paths = append(paths, found...)
+	}
+	return paths, nil
+}
+
+// toDocument converts typed outcomes into a generic document so that the YAML output uses the JSON field
+// names.
+func toDocument(outcomes []fileOutcome) interface{} {
+	data, err := json.Marshal(outcomes)
+	if err != nil {
+		return outcomes
+	}
+	var doc interface{}
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return outcomes
+	}
+	return doc
+}
@@ -0,0 +1,81 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package main
+
+import (
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const importSuccessResponse = `{"summary":{"totalDocuments":1,"imported":1,"failed":0},` +
+	`"results":[{"resourceType":"application","resourceId":"app1","resourceName":"Portal",` +
+	`"operation":"create","status":"success"}]}`
+
+func TestApply_Directory(t *testing.T) {
+	server, requests := newRecordingServer(t, http.StatusOK, importSuccessResponse)
+	c, stdout, _ := newTestCLI(t)
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "b.yml"), []byte("kind: b"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "a.yaml"), []byte("kind: a"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "notes.txt"), []byte("ignored"), 0o600))
+
+	err := c.execute([]string{"apply", "-f", dir, "--var", "ENV=prod", "--dry-run", "--fail-fast",
+		"--server", server.URL})
+
+	require.NoError(t, err)
+	require.Len(t, *requests, 2)
+	assert.Equal(t, "/import", (*requests)[0].path)
+	assert.Equal(t, "kind: a", (*requests)[0].body["content"])
+	assert.Equal(t, "kind: b", (*requests)[1].body["content"])
+	assert.Equal(t, map[string]interface{}{"ENV": "prod"}, (*requests)[0].body["variables"])
+	assert.Equal(t, true, (*requests)[0].body["dryRun"])
+	assert.Equal(t, map[string]interface{}{"continueOnError": false}, (*requests)[0].body["options"])
+	assert.Regexp(t, `a\.yaml\s+application\s+Portal\s+app1\s+create\s+success`, stdout.String())
+	assert.Contains(t, stdout.String(), "Dry run: 2 document(s) validated, nothing was changed.")
+}
+
+func TestApply_ReportsFailedDocuments(t *testing.T) {
+	server, _ := newRecordingServer(t, http.StatusOK, `{"summary":{"totalDocuments":1,"imported":0,"failed":1},`+
+		`"results":[{"resourceType":"role","resourceName":"admin","status":"failed","code":"ROL-1001",`+
+		`"message":"Invalid role"}]}`)
+	c, stdout, _ := newTestCLI(t)
+	file := filepath.Join(t.TempDir(), "role.yaml")
+	require.NoError(t, os.WriteFile(file, []byte("kind: role"), 0o600))
+
+	err := c.execute([]string{"apply", file, "--server", server.URL, "-o", "json"})
+
+	assert.EqualError(t, err, "1 document(s) failed to apply")
+	assert.Contains(t, stdout.String(), `"code": "ROL-1001"`)
+	assert.Contains(t, stdout.String(), `"file": "`+file+`"`)
+}
+
+func TestApply_InvalidArguments(t *testing.T) {
+	c, _, _ := newTestCLI(t)
+
+	assert.ErrorIs(t, c.execute([]string{"apply"}), errUsage)
+	assert.EqualError(t, c.execute([]string{"apply", "-f", "x.yaml", "--var", "NOVALUE"}),
+		`invalid variable "NOVALUE": use KEY=VALUE`)
+	assert.Error(t, c.execute([]string{"apply", "-f", filepath.Join(t.TempDir(), "missing.yaml")}))
+	assert.ErrorContains(t, c.execute([]string{"apply", "-f", t.TempDir()}), "no .yaml or .yml files found")
+}
@@ -0,0 +1,181 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package main
+
+import (
+	"bytes"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	requestTimeout = 30 * time.Second
+	tokenPath      = "/oauth2/token"
+	systemScope    = "system"
+)
+
+// apiClient sends requests to the management API of a server.
+type apiClient struct {
+	conn       *connection
+	httpClient *http.Client
+}
+
+// apiError is the error body returned by the management API.
+type apiError struct {
+	Code        string     `json:"code"`
+	Message     i18nString `json:"message"`
+	Description i18nString `json:"description"`
+}
+
+// i18nString is a translatable message returned by the management API.
+type i18nString struct {
+	Key          string `json:"key"`
+	DefaultValue string `json:"defaultValue"`
+}
+
+// newAPIClient creates a client for the resolved connection.
+func newAPIClient(conn *connection) *apiClient {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if conn.insecure {
+		transport.TLSClientConfig = &tls.Config{InsecureSkipVerify: true} //nolint:gosec // Opt-in with --insecure.
+	}
+	return &apiClient{
+		conn:       conn,
+		httpClient: &http.Client{Transport: transport, Timeout: requestTimeout},
+	}
+}
+
+// do sends a request and decodes the JSON response into out when out is not nil.
+func (a *apiClient) do(method, path string, query url.Values, body, out interface{}) error {
+	token, err := a.accessToken()
+	if err != nil {
+		return err
+	}
+
+	var reader io.Reader
+	if body != nil {
+		payload, err := json.Marshal(body)
+		if err != nil {
+			return fmt.Errorf("failed to encode the request: %w", err)
+		}
+		reader = bytes.NewReader(payload)
+	}
+
+	endpoint := strings.TrimSuffix(a.conn.server, "/") + path
+	if len(query) > 0 {
+		endpoint += "?" + query.Encode()
+	}
+	req, err := http.NewRequest(method, endpoint, reader)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	resp, err := a.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("request to %s failed: %w", a.conn.server, err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("failed to read the response: %w", err)
+	}
+	if resp.StatusCode >= http.StatusBadRequest {
+		return decodeAPIError(resp.StatusCode, data)
+	}
+	if out == nil || len(data) == 0 {
+		return nil
+	}
+	if err := json.Unmarshal(data, out); err != nil {
+		return fmt.Errorf("failed to decode the response: %w", err)
+	}
+	return nil
+}
+
+// accessToken returns the configured token, or obtains one with the client credentials grant.
+func (a *apiClient) accessToken() (string, error) {
+	if a.conn.token != "" || a.conn.clientID == "" {
+		return a.conn.token, nil
+	}
+
+	form := url.Values{}
+	form.Set("grant_type", "client_credentials")
+	form.Set("scope", systemScope)
+	req, err := http.NewRequest(http.MethodPost, strings.TrimSuffix(a.conn.server, "/")+tokenPath,
+		strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	req.SetBasicAuth(url.QueryEscape(a.conn.clientID), url.QueryEscape(a.conn.clientSecret))
+
+	resp, err := a.httpClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("token request to %s failed: %w", a.conn.server, err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	var tokenResp struct {
+		AccessToken      string `json:"access_token"`
+		Error            string `json:"error"`
+		ErrorDescription string `json:"error_description"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&tokenResp); err != nil {
+		return "", fmt.Errorf("failed to decode the token response: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK || tokenResp.AccessToken == "" {
+		if tokenResp.Error == "" {
+			return "", fmt.Errorf("token request failed with status %d", resp.StatusCode)
+		}
+		return "", fmt.Errorf("token request failed: %s: %s", tokenResp.Error, tokenResp.ErrorDescription)
+	}
+
+	// Reuse the token for the remaining requests of this invocation.
+	a.conn.token = tokenResp.AccessToken
+	return a.conn.token, nil
+}
+
+// decodeAPIError converts an error response into an error, falling back to the status when the body is not
+// an API error.
+func decodeAPIError(status int, data []byte) error {
+	var apiErr apiError
+	if err := json.Unmarshal(data, &apiErr); err != nil || apiErr.Code == "" {
+		return fmt.Errorf("server returned %d %s", status, http.StatusText(status))
+	}
+	msg := apiErr.Code + ": " + apiErr.Message.DefaultValue
+	if apiErr.Description.DefaultValue != "" {
+		msg += ": " + apiErr.Description.DefaultValue
+	}
+	return errors.New(msg)
+}
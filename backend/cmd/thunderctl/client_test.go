@@ -0,0 +1,111 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAPIClient_SendsBearerTokenAndBody(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/roles", r.URL.Path)
+		assert.Equal(t, "5", r.URL.Query().Get("limit"))
+		assert.Equal(t, "Bearer abc", r.Header.Get("Authorization"))
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		var body map[string]interface{}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, "admin", body["name"])
+		_, _ = w.Write([]byte(`{"id":"r1"}`))
+	}))
+	defer server.Close()
+	client := newAPIClient(&connection{server: server.URL + "/", token: "abc"})
+
+	var out map[string]interface{}
+	err := client.do(http.MethodPost, "/roles", url.Values{"limit": {"5"}}, map[string]string{"name": "admin"}, &out)
+
+	require.NoError(t, err)
+	assert.Equal(t, "r1", out["id"])
+}
+
+func TestAPIClient_ObtainsTokenWithClientCredentials(t *testing.T) {
+	tokenRequests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == tokenPath {
+			tokenRequests++
+			clientID, secret, ok := r.BasicAuth()
+			assert.True(t, ok)
+			assert.Equal(t, "cli", clientID)
+			assert.Equal(t, "secret", secret)
+			assert.NoError(t, r.ParseForm())
+			assert.Equal(t, "client_credentials", r.PostForm.Get("grant_type"))
+			assert.Equal(t, systemScope, r.PostForm.Get("scope"))
+			_, _ = w.Write([]byte(`{"access_token":"issued","token_type":"Bearer"}`))
+			return
+		}
+		assert.Equal(t, "Bearer issued", r.Header.Get("Authorization"))
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+	client := newAPIClient(&connection{server: server.URL, clientID: "cli", clientSecret: "secret"})
+
+	require.NoError(t, client.do(http.MethodDelete, "/roles/r1", nil, nil, nil))
+	require.NoError(t, client.do(http.MethodDelete, "/roles/r2", nil, nil, nil))
+	assert.Equal(t, 1, tokenRequests)
+}
+
+func TestAPIClient_TokenRequestFailure(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		_, _ = w.Write([]byte(`{"error":"invalid_client","error_description":"Client authentication failed"}`))
+	}))
+	defer server.Close()
+	client := newAPIClient(&connection{server: server.URL, clientID: "cli", clientSecret: "wrong"})
+
+	err := client.do(http.MethodGet, "/roles", nil, nil, nil)
+
+	assert.EqualError(t, err, "token request failed: invalid_client: Client authentication failed")
+}
+
+func TestAPIClient_FormatsAPIErrors(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"code":"ROL-1003","message":{"key":"k","defaultValue":"Role not found"},` +
+			`"description":{"key":"d","defaultValue":"The role does not exist"}}`))
+	}))
+	defer server.Close()
+	client := newAPIClient(&connection{server: server.URL, token: "abc"})
+
+	err := client.do(http.MethodGet, "/roles/missing", nil, nil, nil)
+
+	assert.EqualError(t, err, "ROL-1003: Role not found: The role does not exist")
+}
+
+func TestDecodeAPIError_NonAPIBody(t *testing.T) {
+	err := decodeAPIError(http.StatusBadGateway, []byte("<html>bad gateway</html>"))
+
+	assert.EqualError(t, err, "server returned 502 Bad Gateway")
+}
@@ -0,0 +1,328 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"text/tabwriter"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Environment variables that override the connection settings of the selected context.
+const (
+	envConfig       = "THUNDERCTL_CONFIG"
+	envServer       = "THUNDERCTL_SERVER"
+	envToken        = "THUNDERCTL_TOKEN"
+	envClientSecret = "THUNDERCTL_CLIENT_SECRET"
+)
+
+// contextConfig holds the connection settings of a named context.
+type contextConfig struct {
+	Server       string `yaml:"server"`
+	Token        string `yaml:"token,omitempty"`
+	ClientID     string `yaml:"client_id,omitempty"`
+	ClientSecret string `yaml:"client_secret,omitempty"`
+	Insecure     bool   `yaml:"insecure,omitempty"`
+}
+
+// cliConfig is the configuration file of thunderctl.
+type cliConfig struct {
+	CurrentContext string                    `yaml:"current_context"`
+	Contexts       map[string]*contextConfig `yaml:"contexts"`
+}
+
+// connection holds the resolved settings used to reach the server.
+type connection struct {
+	server       string
+	token        string
+	clientID     string
+	clientSecret string
+	insecure     bool
+}
+
+// defaultConfigPath returns the path of the configuration file, honoring THUNDERCTL_CONFIG.
+func defaultConfigPath() string {
+	if path := os.Getenv(envConfig); path != "" {
+		return path
+	}
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		dir = "."
+	}
+	return filepath.Join(dir, "thunderctl", "config.yaml")
+}
+
+// loadConfig reads the configuration file. A missing file yields an empty configuration.
+func (c *cli) loadConfig() (*cliConfig, error) {
+	cfg := &cliConfig{Contexts: map[string]*contextConfig{}}
+	data, err := os.ReadFile(c.configPath)
+	if errors.Is(err, os.ErrNotExist) {
+		return cfg, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", c.configPath, err)
+	}
+	if err := yaml.Unmarshal(data, cfg); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", c.configPath, err)
+	}
+	if cfg.Contexts == nil {
+		cfg.Contexts = map[string]*contextConfig{}
+	}
+	return cfg, nil
+}
+
+// saveConfig writes the configuration file readable by the current user only, as it holds credentials.
+func (c *cli) saveConfig(cfg *cliConfig) error {
+	data, err := yaml.Marshal(cfg)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(c.configPath), 0o700); err != nil {
+		return fmt.Errorf("failed to create the configuration directory: %w", err)
+	}
+	if err := os.WriteFile(c.configPath, data, 0o600); err != nil {
+		return fmt.Errorf("failed to write %s: %w", c.configPath, err)
+	}
+	return nil
+}
+
+// resolveConnection combines the selected context, the environment and the global flags, in increasing order
+// of precedence.
+func (c *cli) resolveConnection(opts *globalOptions) (*connection, error) {
+	cfg, err := c.loadConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	conn := &connection{}
+	name := opts.context
+	if name == "" {
+		name = cfg.CurrentContext
+	}
+	if name != "" {
+		ctx, ok := cfg.Contexts[name]
+		if !ok {
+			return nil, fmt.Errorf("context %q does not exist", name)
+		}
+		conn.server, conn.token, conn.insecure = ctx.Server, ctx.Token, ctx.Insecure
+		conn.clientID, conn.clientSecret = ctx.ClientID, ctx.ClientSecret
+	}
+
+	if server := os.Getenv(envServer); server != "" {
+		conn.server = server
+	}
+	if token := os.Getenv(envToken); token != "" {
+		conn.token = token
+	}
+	if secret := os.Getenv(envClientSecret); secret != "" {
+		conn.clientSecret = secret
+	}
+	if opts.server != "" {
+		conn.server = opts.server
+	}
+	if opts.token != "" {
+		conn.token = opts.token
+	}
+	if opts.insecure {
+		conn.insecure = true
+	}
+
+	if conn.server == "" {
+		return nil, errors.New("no server configured: run 'thunderctl context set' or pass --server")
+	}
+	return conn, nil
+}
+
+// runContext handles the context command.
+func (c *cli) runContext(args []string) error {
+	if len(args) == 0 {
+		_, _ = fmt.Fprintln(c.stderr, "Usage: thunderctl context set|use|list|current|delete [arguments]")
+		return errUsage
+	}
+
+	switch args[0] {
+	case "set":
+		return c.runContextSet(args[1:])
+	case "use":
+		return c.runContextUse(args[1:])
+	case "list":
+		return c.runContextList()
+	case "current":
+		return c.runContextCurrent()
+	case "delete":
+		return c.runContextDelete(args[1:])
+	}
+	_, _ = fmt.Fprintf(c.stderr, "Unknown context subcommand %q.\n", args[0])
+	return errUsage
+}
+
+// runContextSet creates a context or updates the settings given on the command line.
+func (c *cli) runContextSet(args []string) error {
+	fs := c.newFlagSet("context set")
+	server := fs.String("server", "", "server URL, e.g. https://localhost:8090")
+	token := fs.String("token", "", "access token with the system scope")
+	clientID := fs.String("client-id", "", "client ID used to obtain tokens with the client credentials grant")
+	clientSecret := fs.String("client-secret", "", "client secret used with --client-id")
+	insecure := fs.Bool("insecure", false, "skip TLS certificate verification")
+	use := fs.Bool("use", false, "make the context the current context")
+	positional, err := parseArgs(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) != 1 {
+		_, _ = fmt.Fprintln(c.stderr, "Usage: thunderctl context set <name> [flags]")
+		return errUsage
+	}
+	name := positional[0]
+
+	cfg, err := c.loadConfig()
+	if err != nil {
+		return err
+	}
+	ctx, ok := cfg.Contexts[name]
+	if !ok {
+		ctx = &contextConfig{}
+		cfg.Contexts[name] = ctx
+	}
+	fs.Visit(func(f *flag.Flag) {
+		switch f.Name {
+		case "server":
+			ctx.Server = *server
+		case "token":
+			ctx.Token = *token
+		case "client-id":
+			ctx.ClientID = *clientID
+		case "client-secret":
+			ctx.ClientSecret = *clientSecret
+		case "insecure":
+			ctx.Insecure = *insecure
+		}
+	})
+	if ctx.Server == "" {
+		return errors.New("a new context requires --server")
+	}
+	if *use || cfg.CurrentContext == "" {
+		cfg.CurrentContext = name
+	}
+	if err := c.saveConfig(cfg); err != nil {
+		return err
+	}
+	_, _ = fmt.Fprintf(c.stdout, "Context %q saved.\n", name)
+	return nil
+}
+
+// runContextUse switches the current context.
+func (c *cli) runContextUse(args []string) error {
+	if len(args) != 1 {
+		_, _ = fmt.Fprintln(c.stderr, "Usage: thunderctl context use <name>")
+		return errUsage
+	}
+	cfg, err := c.loadConfig()
+	if err != nil {
+		return err
+	}
+	if _, ok := cfg.Contexts[args[0]]; !ok {
+		return fmt.Errorf("context %q does not exist", args[0])
+	}
+	cfg.CurrentContext = args[0]
+	if err := c.saveConfig(cfg); err != nil {
+		return err
+	}
+	_, _ = fmt.Fprintf(c.stdout, "Switched to context %q.\n", args[0])
+	return nil
+}
+
+// runContextList prints the contexts without their credentials.
+func (c *cli) runContextList() error {
+	cfg, err := c.loadConfig()
+	if err != nil {
+		return err
+	}
+	names := make([]string, 0, len(cfg.Contexts))
+	for name := range cfg.Contexts {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	w := tabwriter.NewWriter(c.stdout, 0, 4, 2, ' ', 0)
+	_, _ = fmt.Fprintln(w, "CURRENT\tNAME\tSERVER\tAUTH")
+	for _, name := range names {
+		ctx := cfg.Contexts[name]
+		current := ""
+		if name == cfg.CurrentContext {
+			current = "*"
+		}
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", current, name, ctx.Server, ctx.authMethod())
+	}
+	return w.Flush()
+}
+
+// runContextCurrent prints the name of the current context.
+func (c *cli) runContextCurrent() error {
+	cfg, err := c.loadConfig()
+	if err != nil {
+		return err
+	}
+	if cfg.CurrentContext == "" {
+		return errors.New("no current context is set")
+	}
+	_, _ = fmt.Fprintln(c.stdout, cfg.CurrentContext)
+	return nil
+}
+
+// runContextDelete removes a context. Deleting the current context leaves no context selected.
+func (c *cli) runContextDelete(args []string) error {
+	if len(args) != 1 {
+		_, _ = fmt.Fprintln(c.stderr, "Usage: thunderctl context delete <name>")
+		return errUsage
+	}
+	cfg, err := c.loadConfig()
+	if err != nil {
+		return err
+	}
+	if _, ok := cfg.Contexts[args[0]]; !ok {
+		return fmt.Errorf("context %q does not exist", args[0])
+	}
+	delete(cfg.Contexts, args[0])
+	if cfg.CurrentContext == args[0] {
+		cfg.CurrentContext = ""
+	}
+	if err := c.saveConfig(cfg); err != nil {
+		return err
+	}
+	_, _ = fmt.Fprintf(c.stdout, "Context %q deleted.\n", args[0])
+	return nil
+}
+
+// authMethod describes how the context authenticates, for listing.
+func (ctx *contextConfig) authMethod() string {
+	switch {
+	case ctx.ClientID != "":
+		return "client-credentials"
+	case ctx.Token != "":
+		return "token"
+	}
+	return "none"
+}
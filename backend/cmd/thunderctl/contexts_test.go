@@ -0,0 +1,138 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package main
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestContextSet_CreatesAndSelectsFirstContext(t *testing.T) {
+	c, stdout, _ := newTestCLI(t)
+
+	require.NoError(t, c.execute([]string{"context", "set", "local", "--server", "https://localhost:8090",
+		"--client-id", "cli", "--client-secret", "secret"}))
+
+	assert.Contains(t, stdout.String(), `Context "local" saved.`)
+	cfg, err := c.loadConfig()
+	require.NoError(t, err)
+	assert.Equal(t, "local", cfg.CurrentContext)
+	assert.Equal(t, &contextConfig{Server: "https://localhost:8090", ClientID: "cli", ClientSecret: "secret"},
+		cfg.Contexts["local"])
+
+	info, err := os.Stat(c.configPath)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+}
+
+func TestContextSet_UpdatesOnlyGivenSettings(t *testing.T) {
+	c, _, _ := newTestCLI(t)
+	require.NoError(t, c.execute([]string{"context", "set", "local", "--server", "https://a", "--token", "t1"}))
+	require.NoError(t, c.execute([]string{"context", "set", "prod", "--server", "https://b"}))
+
+	require.NoError(t, c.execute([]string{"context", "set", "local", "--token", "t2"}))
+
+	cfg, err := c.loadConfig()
+	require.NoError(t, err)
+	assert.Equal(t, "local", cfg.CurrentContext)
+	assert.Equal(t, "https://a", cfg.Contexts["local"].Server)
+	assert.Equal(t, "t2", cfg.Contexts["local"].Token)
+}
+
+func TestContextSet_RequiresServer(t *testing.T) {
+	c, _, _ := newTestCLI(t)
+
+	err := c.execute([]string{"context", "set", "local", "--token", "t"})
+
+	assert.EqualError(t, err, "a new context requires --server")
+}
+
+func TestContextUseListCurrentDelete(t *testing.T) {
+	c, stdout, _ := newTestCLI(t)
+	require.NoError(t, c.execute([]string{"context", "set", "local", "--server", "https://a"}))
+	require.NoError(t, c.execute([]string{"context", "set", "prod", "--server", "https://b", "--token", "t"}))
+
+	require.NoError(t, c.execute([]string{"context", "use", "prod"}))
+	stdout.Reset()
+	require.NoError(t, c.execute([]string{"context", "current"}))
+	assert.Equal(t, "prod\n", stdout.String())
+
+	stdout.Reset()
+	require.NoError(t, c.execute([]string{"context", "list"}))
+	assert.Contains(t, stdout.String(), "CURRENT")
+	assert.Regexp(t, `\*\s+prod\s+https://b\s+token`, stdout.String())
+	assert.Regexp(t, `local\s+https://a\s+none`, stdout.String())
+	assert.NotContains(t, stdout.String(), " t\n")
+
+	require.NoError(t, c.execute([]string{"context", "delete", "prod"}))
+	assert.EqualError(t, c.execute([]string{"context", "current"}), "no current context is set")
+	assert.EqualError(t, c.execute([]string{"context", "use", "prod"}), `context "prod" does not exist`)
+}
+
+func TestContext_InvalidUsage(t *testing.T) {
+	c, _, stderr := newTestCLI(t)
+
+	assert.ErrorIs(t, c.execute([]string{"context"}), errUsage)
+	assert.ErrorIs(t, c.execute([]string{"context", "rename"}), errUsage)
+	assert.ErrorIs(t, c.execute([]string{"context", "use"}), errUsage)
+	assert.Contains(t, stderr.String(), `Unknown context subcommand "rename"`)
+}
+
+func TestResolveConnection_Precedence(t *testing.T) {
+	c, _, _ := newTestCLI(t)
+	require.NoError(t, c.execute([]string{"context", "set", "local", "--server", "https://a", "--token", "ctx"}))
+	require.NoError(t, c.execute([]string{"context", "set", "prod", "--server", "https://b", "--insecure"}))
+
+	conn, err := c.resolveConnection(&globalOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, &connection{server: "https://a", token: "ctx"}, conn)
+
+	conn, err = c.resolveConnection(&globalOptions{context: "prod"})
+	require.NoError(t, err)
+	assert.Equal(t, &connection{server: "https://b", insecure: true}, conn)
+
+	t.Setenv(envServer, "https://env")
+	t.Setenv(envToken, "env-token")
+	conn, err = c.resolveConnection(&globalOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, "https://env", conn.server)
+	assert.Equal(t, "env-token", conn.token)
+
+	conn, err = c.resolveConnection(&globalOptions{server: "https://flag", token: "flag-token"})
+	require.NoError(t, err)
+	assert.Equal(t, "https://flag", conn.server)
+	assert.Equal(t, "flag-token", conn.token)
+}
+
+func TestResolveConnection_Errors(t *testing.T) {
+	c, _, _ := newTestCLI(t)
+
+	_, err := c.resolveConnection(&globalOptions{})
+	assert.ErrorContains(t, err, "no server configured")
+
+	_, err = c.resolveConnection(&globalOptions{context: "missing"})
+	assert.EqualError(t, err, `context "missing" does not exist`)
+
+	require.NoError(t, os.WriteFile(c.configPath, []byte("contexts: ["), 0o600))
+	_, err = c.resolveConnection(&globalOptions{})
+	assert.ErrorContains(t, err, "failed to parse")
+}
@@ -0,0 +1,181 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package main is the entry point of thunderctl, the command line tool that administers a server through its
+// management API.
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+// version is the version of thunderctl. It is set at build time.
+var version = "dev"
+
+const usage = `thunderctl administers a server through its management API.
+
+Usage:
+  thunderctl <command> [arguments] [flags]
+
+Resource commands:
+  users|applications|flows|ous|roles list              List resources
+  users|applications|flows|ous|roles get <id>          Show a resource
+  users|applications|flows|ous|roles create -f <file>  Create a resource from a JSON or YAML file
+  users|applications|flows|ous|roles update <id> -f <file>
+                                                       Replace a resource with a JSON or YAML file
+  users|applications|flows|ous|roles delete <id>       Delete a resource
+
+Other commands:
+  apply -f <file|dir>    Import declarative resource files
+  context <subcommand>   Manage connection contexts (set, use, list, current, delete)
+  version                Print the version of thunderctl
+
+Global flags:
+  --context <name>       Context to use instead of the current context
+  --server <url>         Server URL, overriding the context
+  --token <token>        Access token, overriding the context
+  --insecure             Skip TLS certificate verification
+  -o, --output <format>  Output format: table, json or yaml (default table)
+
+Run 'thunderctl <command> --help' for the flags of a command.
+`
+
+// errUsage is returned when a command is invoked with invalid arguments. The usage has been printed.
+var errUsage = errors.New("invalid usage")
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdout, os.Stderr))
+}
+
+// run executes the command line and returns the process exit code.
+func run(args []string, stdout, stderr io.Writer) int {
+	c := newCLI(stdout, stderr)
+	if err := c.execute(args); err != nil {
+		if !errors.Is(err, errUsage) && !errors.Is(err, flag.ErrHelp) {
+			_, _ = fmt.Fprintln(stderr, "Error:", err)
+		}
+		if errors.Is(err, flag.ErrHelp) {
+			return 0
+		}
+		return 1
+	}
+	return 0
+}
+
+// cli holds the state shared by the commands.
+type cli struct {
+	stdout     io.Writer
+	stderr     io.Writer
+	configPath string
+}
+
+// newCLI creates a cli writing to the given streams.
+func newCLI(stdout, stderr io.Writer) *cli {
+	return &cli{stdout: stdout, stderr: stderr, configPath: defaultConfigPath()}
+}
+
+// execute dispatches the command line to the matching command.
+func (c *cli) execute(args []string) error {
+	if len(args) == 0 {
+		_, _ = fmt.Fprint(c.stderr, usage)
+		return errUsage
+	}
+
+	command, rest := args[0], args[1:]
+	switch command {
+	case "-h", "--help", "help":
+		_, _ = fmt.Fprint(c.stdout, usage)
+		return nil
+	case "version":
+		_, _ = fmt.Fprintln(c.stdout, "thunderctl", version)
+		return nil
+	case "context":
+		return c.runContext(rest)
+	case "apply":
+		return c.runApply(rest)
+	}
+
+	if res, ok := lookupResource(command); ok {
+		return c.runResource(res, rest)
+	}
+	_, _ = fmt.Fprintf(c.stderr, "Unknown command %q.\n\n%s", command, usage)
+	return errUsage
+}
+
+// globalOptions holds the flags accepted by every command that talks to the server.
+type globalOptions struct {
+	context  string
+	server   string
+	token    string
+	insecure bool
+	output   string
+}
+
+// register adds the global flags to a flag set.
+func (o *globalOptions) register(fs *flag.FlagSet) {
+	fs.StringVar(&o.context, "context", "", "context to use instead of the current context")
+	fs.StringVar(&o.server, "server", "", "server URL, overriding the context")
+	fs.StringVar(&o.token, "token", "", "access token, overriding the context")
+	fs.BoolVar(&o.insecure, "insecure", false, "skip TLS certificate verification")
+	fs.StringVar(&o.output, "output", string(outputTable), "output format: table, json or yaml")
+	fs.StringVar(&o.output, "o", string(outputTable), "output format (shorthand)")
+}
+
+// newFlagSet creates a flag set that reports errors through the cli instead of exiting.
+func (c *cli) newFlagSet(name string) *flag.FlagSet {
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	fs.SetOutput(c.stderr)
+	return fs
+}
+
+// parseArgs parses flags placed anywhere among the arguments and returns the positional arguments.
+func parseArgs(fs *flag.FlagSet, args []string) ([]string, error) {
+	positional := make([]string, 0)
+	for {
+		if err := fs.Parse(args); err != nil {
+			return nil, err
+		}
+		rest := fs.Args()
+		if len(rest) == 0 {
+			return positional, nil
+		}
+		// The flag package consumes the "--" terminator; everything after it is positional.
+		if consumed := len(args) - len(rest); consumed > 0 && args[consumed-1] == "--" {
+			return append(positional, rest...), nil
+		}
+		positional = append(positional, rest[0])
+		args = rest[1:]
+	}
+}
+
+// stringList is a repeatable string flag.
+type stringList []string
+
+func (l *stringList) String() string {
+	return strings.Join(*l, ",")
+}
+
+func (l *stringList) Set(value string) error {
+	*l = append(*l, value)
+	return nil
+}
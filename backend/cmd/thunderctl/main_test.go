@@ -0,0 +1,97 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package main
+
+import (
+	"bytes"
+	"flag"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestCLI returns a cli with captured output and a configuration file in a temporary directory.
+func newTestCLI(t *testing.T) (*cli, *bytes.Buffer, *bytes.Buffer) {
+	t.Helper()
+	t.Setenv(envServer, "")
+	t.Setenv(envToken, "")
+	t.Setenv(envClientSecret, "")
+	stdout, stderr := &bytes.Buffer{}, &bytes.Buffer{}
+	return &cli{stdout: stdout, stderr: stderr, configPath: filepath.Join(t.TempDir(), "config.yaml")}, stdout, stderr
+}
+
+func TestRun_Help(t *testing.T) {
+	var stdout, stderr bytes.Buffer
+
+	assert.Equal(t, 0, run([]string{"--help"}, &stdout, &stderr))
+	assert.Contains(t, stdout.String(), "Resource commands:")
+}
+
+func TestRun_NoArguments(t *testing.T) {
+	var stdout, stderr bytes.Buffer
+
+	assert.Equal(t, 1, run(nil, &stdout, &stderr))
+	assert.Contains(t, stderr.String(), "Usage:")
+	assert.NotContains(t, stderr.String(), "Error:")
+}
+
+func TestRun_UnknownCommand(t *testing.T) {
+	var stdout, stderr bytes.Buffer
+
+	assert.Equal(t, 1, run([]string{"widgets"}, &stdout, &stderr))
+	assert.Contains(t, stderr.String(), `Unknown command "widgets"`)
+}
+
+func TestRun_ReportsErrors(t *testing.T) {
+	t.Setenv(envConfig, filepath.Join(t.TempDir(), "config.yaml"))
+	t.Setenv(envServer, "")
+	var stdout, stderr bytes.Buffer
+
+	assert.Equal(t, 1, run([]string{"users", "list"}, &stdout, &stderr))
+	assert.Contains(t, stderr.String(), "Error: no server configured")
+}
+
+func TestExecute_Version(t *testing.T) {
+	c, stdout, _ := newTestCLI(t)
+
+	require.NoError(t, c.execute([]string{"version"}))
+	assert.Equal(t, "thunderctl dev\n", stdout.String())
+}
+
+func TestParseArgs_InterspersedFlags(t *testing.T) {
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	limit := fs.Int("limit", 0, "")
+
+	positional, err := parseArgs(fs, []string{"a", "--limit", "5", "b", "--", "-c"})
+
+	require.NoError(t, err)
+	assert.Equal(t, 5, *limit)
+	assert.Equal(t, []string{"a", "b", "-c"}, positional)
+}
+
+func TestParseArgs_UnknownFlag(t *testing.T) {
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	fs.SetOutput(&bytes.Buffer{})
+
+	_, err := parseArgs(fs, []string{"--unknown"})
+
+	assert.Error(t, err)
+}
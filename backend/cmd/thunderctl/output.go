@@ -0,0 +1,117 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	"gopkg.in/yaml.v3"
+)
+
+// outputFormat is the format used to print server responses.
+type outputFormat string
+
+const (
+	outputTable outputFormat = "table"
+	outputJSON  outputFormat = "json"
+	outputYAML  outputFormat = "yaml"
+)
+
+// column is a table column whose value is read from a dot separated path of a resource.
+type column struct {
+	header string
+	path   string
+}
+
+// parseOutputFormat validates the value of the --output flag.
+func parseOutputFormat(value string) (outputFormat, error) {
+	switch format := outputFormat(strings.ToLower(value)); format {
+	case outputTable, outputJSON, outputYAML:
+		return format, nil
+	}
+	return "", fmt.Errorf("unsupported output format %q: use table, json or yaml", value)
+}
+
+// printDocument prints a decoded JSON document in the JSON or YAML format.
+func printDocument(w io.Writer, format outputFormat, doc interface{}) error {
+	if format == outputYAML {
+		enc := yaml.NewEncoder(w)
+		enc.SetIndent(2)
+		if err := enc.Encode(doc); err != nil {
+			return err
+		}
+		return enc.Close()
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(doc)
+}
+
+// printTable prints one row per item with the given columns.
+func printTable(w io.Writer, columns []column, items []interface{}) error {
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	headers := make([]string, len(columns))
+	for i, col := range columns {
+		headers[i] = col.header
+	}
+	_, _ = fmt.Fprintln(tw, strings.Join(headers, "\t"))
+
+	for _, item := range items {
+		values := make([]string, len(columns))
+		for i, col := range columns {
+			values[i] = formatValue(lookupPath(item, col.path))
+		}
+		_, _ = fmt.Fprintln(tw, strings.Join(values, "\t"))
+	}
+	return tw.Flush()
+}
+
+// lookupPath returns the value at a dot separated path of a decoded JSON document.
+func lookupPath(doc interface{}, path string) interface{} {
+	current := doc
+	for _, key := range strings.Split(path, ".") {
+		obj, ok := current.(map[string]interface{})
+		if !ok {
+			return nil
+		}
+		current = obj[key]
+	}
+	return current
+}
+
+// formatValue renders a decoded JSON value for a table cell.
+func formatValue(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case float64, bool:
+		return fmt.Sprint(v)
+	}
+	data, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprint(value)
+	}
+	return string(data)
+}
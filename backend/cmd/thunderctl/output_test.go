@@ -0,0 +1,63 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package main
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseOutputFormat(t *testing.T) {
+	for _, value := range []string{"table", "json", "YAML"} {
+		_, err := parseOutputFormat(value)
+		assert.NoError(t, err, value)
+	}
+
+	_, err := parseOutputFormat("xml")
+	assert.EqualError(t, err, `unsupported output format "xml": use table, json or yaml`)
+}
+
+func TestPrintTable(t *testing.T) {
+	var out bytes.Buffer
+	items := []interface{}{
+		map[string]interface{}{
+			"id": "1", "meta": map[string]interface{}{"count": float64(3)}, "tags": []interface{}{"a"},
+		},
+		map[string]interface{}{"id": "2"},
+	}
+
+	require.NoError(t, printTable(&out, []column{{"ID", "id"}, {"COUNT", "meta.count"}, {"TAGS", "tags"}}, items))
+
+	assert.Equal(t, "ID  COUNT  TAGS\n1   3      [\"a\"]\n2          \n", out.String())
+}
+
+func TestPrintDocument(t *testing.T) {
+	doc := map[string]interface{}{"id": "1", "enabled": true}
+
+	var jsonOut bytes.Buffer
+	require.NoError(t, printDocument(&jsonOut, outputJSON, doc))
+	assert.JSONEq(t, `{"id":"1","enabled":true}`, jsonOut.String())
+
+	var yamlOut bytes.Buffer
+	require.NoError(t, printDocument(&yamlOut, outputYAML, doc))
+	assert.Equal(t, "enabled: true\nid: \"1\"\n", yamlOut.String())
+}
@@ -0,0 +1,227 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package main
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+
+	"gopkg.in/yaml.v3"
+)
+
+// resource describes a collection of the management API.
+type resource struct {
+	name    string
+	aliases []string
+	path    string
+	listKey string
+	filter  bool
+	columns []column
+}
+
+// resources lists the collections supported by the resource commands.
+var resources = []resource{
+	{
+		name:    "users",
+		aliases: []string{"user"},
+		path:    "/users",
+		listKey: "users",
+		filter:  true,
+		columns: []column{{"ID", "id"}, {"TYPE", "type"}, {"OU", "ouId"}, {"DISPLAY", "display"}},
+	},
+	{
+		name:    "applications",
+		aliases: []string{"application", "apps", "app"},
+		path:    "/applications",
+		listKey: "applications",
+		columns: []column{{"ID", "id"}, {"NAME", "name"}, {"CLIENT ID", "clientId"}},
+	},
+	{
+		name:    "flows",
+		aliases: []string{"flow"},
+		path:    "/flows",
+		listKey: "flows",
+		columns: []column{{"ID", "id"}, {"HANDLE", "handle"}, {"NAME", "name"}, {"TYPE", "flowType"}},
+	},
+	{
+		name:    "ous",
+		aliases: []string{"ou", "organization-units"},
+		path:    "/organization-units",
+		listKey: "organizationUnits",
+		columns: []column{{"ID", "id"}, {"HANDLE", "handle"}, {"NAME", "name"}},
+	},
+	{
+		name:    "roles",
+		aliases: []string{"role"},
+		path:    "/roles",
+		listKey: "roles",
+		columns: []column{{"ID", "id"}, {"NAME", "name"}, {"OU", "ouId"}},
+	},
+}
+
+// lookupResource returns the resource matching a command name or alias.
+func lookupResource(name string) (resource, bool) {
+	for _, res := range resources {
+		if res.name == name {
+			return res, true
+		}
+		for _, alias := range res.aliases {
+			if alias == name {
+				return res, true
+			}
+		}
+	}
+	return resource{}, false
+}
+
+// runResource handles the list, get, create, update and delete commands of a resource.
+func (c *cli) runResource(res resource, args []string) error {
+	if len(args) == 0 {
+		_, _ = fmt.Fprintf(c.stderr, "Usage: thunderctl %s list|get|create|update|delete [arguments]\n", res.name)
+		return errUsage
+	}
+
+	opts := &globalOptions{}
+	fs := c.newFlagSet(res.name + " " + args[0])
+	opts.register(fs)
+	var limit, offset int
+	var filter, file string
+	switch args[0] {
+	case "list":
+		fs.IntVar(&limit, "limit", 0, "maximum number of results")
+		fs.IntVar(&offset, "offset", 0, "number of results to skip")
+		if res.filter {
+			fs.StringVar(&filter, "filter", "", "filter expression, e.g. 'username eq \"alice\"'")
+		}
+	case "create", "update":
+		fs.StringVar(&file, "f", "", "JSON or YAML file describing the resource")
+	case "get", "delete":
+	default:
+		_, _ = fmt.Fprintf(c.stderr, "Unknown %s subcommand %q.\n", res.name, args[0])
+		return errUsage
+	}
+	positional, err := parseArgs(fs, args[1:])
+	if err != nil {
+		return err
+	}
+	format, err := parseOutputFormat(opts.output)
+	if err != nil {
+		return err
+	}
+
+	wantArgs := map[string]int{"list": 0, "create": 0, "get": 1, "update": 1, "delete": 1}[args[0]]
+	if len(positional) != wantArgs {
+		if wantArgs == 0 {
+			return fmt.Errorf("%s %s takes no arguments", res.name, args[0])
+		}
+		return fmt.Errorf("%s %s requires the resource ID", res.name, args[0])
+	}
+	if (args[0] == "create" || args[0] == "update") && file == "" {
+		return fmt.Errorf("%s %s requires -f <file>", res.name, args[0])
+	}
+
+	conn, err := c.resolveConnection(opts)
+	if err != nil {
+		return err
+	}
+	client := newAPIClient(conn)
+
+	switch args[0] {
+	case "list":
+		query := url.Values{}
+		if limit > 0 {
+			query.Set("limit", strconv.Itoa(limit))
+		}
+		if offset > 0 {
+			query.Set("offset", strconv.Itoa(offset))
+		}
+		if filter != "" {
+			query.Set("filter", filter)
+		}
+		var out map[string]interface{}
+		if err := client.do(http.MethodGet, res.path, query, nil, &out); err != nil {
+			return err
+		}
+		if format != outputTable {
+			return printDocument(c.stdout, format, out)
+		}
+		items, _ := out[res.listKey].([]interface{})
+		return printTable(c.stdout, res.columns, items)
+	case "get":
+		var out interface{}
+		if err := client.do(http.MethodGet, res.itemPath(positional[0]), nil, nil, &out); err != nil {
+			return err
+		}
+		return c.printResource(res, format, out)
+	case "create", "update":
+		body, err := readResourceFile(file)
+		if err != nil {
+			return err
+		}
+		method, path := http.MethodPost, res.path
+		if args[0] == "update" {
+			method, path = http.MethodPut, res.itemPath(positional[0])
+		}
+		var out interface{}
+		if err := client.do(method, path, nil, body, &out); err != nil {
+			return err
+		}
+		return c.printResource(res, format, out)
+	default:
+		if err := client.do(http.MethodDelete, res.itemPath(positional[0]), nil, nil, nil); err != nil {
+			return err
+		}
+		_, _ = fmt.Fprintf(c.stdout, "Deleted %s %s.\n", res.name, positional[0])
+		return nil
+	}
+}
+
+// itemPath returns the path of a single resource.
+func (r resource) itemPath(id string) string {
+	return r.path + "/" + url.PathEscape(id)
+}
+
+// printResource prints a single resource, as a one row table in the table format.
+func (c *cli) printResource(res resource, format outputFormat, doc interface{}) error {
+	if format != outputTable {
+		return printDocument(c.stdout, format, doc)
+	}
+	return printTable(c.stdout, res.columns, []interface{}{doc})
+}
+
+// readResourceFile reads a JSON or YAML file into a value that can be sent as JSON. YAML is a superset of
+// JSON, so both formats are decoded by the YAML decoder.
+func readResourceFile(path string) (interface{}, error) {
+	data, err := os.ReadFile(path) //nolint:gosec // The path is provided by the operator.
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", path, err)
+	}
+	var doc interface{}
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+	if _, ok := doc.(map[string]interface{}); !ok {
+		return nil, errors.New(path + " must contain a single object")
+	}
+	return doc, nil
+}
@@ -0,0 +1,158 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// recordedRequest captures a request received by the test server.
+type recordedRequest struct {
+	method string
+	path   string
+	query  string
+	body   map[string]interface{}
+}
+
+// newRecordingServer starts a server that records requests and replies with the given status and body.
+func newRecordingServer(t *testing.T, status int, response string) (*httptest.Server, *[]recordedRequest) {
+	t.Helper()
+	requests := &[]recordedRequest{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		rec := recordedRequest{method: r.Method, path: r.URL.Path, query: r.URL.RawQuery}
+		if r.ContentLength > 0 {
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&rec.body))
+		}
+		*requests = append(*requests, rec)
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(response))
+	}))
+	t.Cleanup(server.Close)
+	return server, requests
+}
+
+func TestLookupResource(t *testing.T) {
+	res, ok := lookupResource("apps")
+	assert.True(t, ok)
+	assert.Equal(t, "applications", res.name)
+
+	res, ok = lookupResource("ou")
+	assert.True(t, ok)
+	assert.Equal(t, "/organization-units", res.path)
+
+	_, ok = lookupResource("widgets")
+	assert.False(t, ok)
+}
+
+func TestResourceList_Table(t *testing.T) {
+	server, requests := newRecordingServer(t, http.StatusOK,
+		`{"totalResults":1,"count":1,"users":[{"id":"u1","type":"customer","ouId":"ou1"}]}`)
+	c, stdout, _ := newTestCLI(t)
+
+	err := c.execute([]string{"users", "list", "--server", server.URL, "--limit", "10", "--offset", "5",
+		"--filter", `username eq "alice"`})
+
+	require.NoError(t, err)
+	require.Len(t, *requests, 1)
+	assert.Equal(t, "/users", (*requests)[0].path)
+	assert.Equal(t, "filter=username+eq+%22alice%22&limit=10&offset=5", (*requests)[0].query)
+	assert.Regexp(t, `ID\s+TYPE\s+OU\s+DISPLAY\nu1\s+customer\s+ou1`, stdout.String())
+}
+
+func TestResourceList_JSON(t *testing.T) {
+	server, _ := newRecordingServer(t, http.StatusOK, `{"totalResults":0,"roles":[]}`)
+	c, stdout, _ := newTestCLI(t)
+
+	require.NoError(t, c.execute([]string{"roles", "list", "--server", server.URL, "-o", "json"}))
+
+	assert.JSONEq(t, `{"totalResults":0,"roles":[]}`, stdout.String())
+}
+
+func TestResourceGet_YAML(t *testing.T) {
+	server, requests := newRecordingServer(t, http.StatusOK, `{"id":"f 1","handle":"basic"}`)
+	c, stdout, _ := newTestCLI(t)
+
+	require.NoError(t, c.execute([]string{"flows", "get", "f 1", "--server", server.URL, "-o", "yaml"}))
+
+	assert.Equal(t, "/flows/f 1", (*requests)[0].path)
+	assert.Equal(t, "handle: basic\nid: f 1\n", stdout.String())
+}
+
+func TestResourceCreateAndUpdate_FromYAMLFile(t *testing.T) {
+	server, requests := newRecordingServer(t, http.StatusOK, `{"id":"app1","name":"Portal"}`)
+	c, stdout, _ := newTestCLI(t)
+	file := filepath.Join(t.TempDir(), "app.yaml")
+	require.NoError(t, os.WriteFile(file, []byte("name: Portal\ninboundAuthConfig:\n  - type: oauth2\n"), 0o600))
+
+	require.NoError(t, c.execute([]string{"applications", "create", "-f", file, "--server", server.URL}))
+	require.NoError(t, c.execute([]string{"applications", "update", "app1", "-f", file, "--server", server.URL}))
+
+	require.Len(t, *requests, 2)
+	assert.Equal(t, "POST", (*requests)[0].method)
+	assert.Equal(t, "/applications", (*requests)[0].path)
+	assert.Equal(t, "PUT", (*requests)[1].method)
+	assert.Equal(t, "/applications/app1", (*requests)[1].path)
+	assert.Equal(t, map[string]interface{}{
+		"name":              "Portal",
+		"inboundAuthConfig": []interface{}{map[string]interface{}{"type": "oauth2"}},
+	}, (*requests)[0].body)
+	assert.Regexp(t, `app1\s+Portal`, stdout.String())
+}
+
+func TestResourceDelete(t *testing.T) {
+	server, requests := newRecordingServer(t, http.StatusNoContent, "")
+	c, stdout, _ := newTestCLI(t)
+
+	require.NoError(t, c.execute([]string{"ous", "delete", "ou1", "--server", server.URL}))
+
+	assert.Equal(t, "DELETE", (*requests)[0].method)
+	assert.Equal(t, "/organization-units/ou1", (*requests)[0].path)
+	assert.Equal(t, "Deleted ous ou1.\n", stdout.String())
+}
+
+func TestResource_InvalidArguments(t *testing.T) {
+	c, _, _ := newTestCLI(t)
+
+	assert.ErrorIs(t, c.execute([]string{"roles"}), errUsage)
+	assert.ErrorIs(t, c.execute([]string{"roles", "rename"}), errUsage)
+	assert.EqualError(t, c.execute([]string{"roles", "get"}), "roles get requires the resource ID")
+	assert.EqualError(t, c.execute([]string{"roles", "list", "extra"}), "roles list takes no arguments")
+	assert.EqualError(t, c.execute([]string{"roles", "create"}), "roles create requires -f <file>")
+	assert.ErrorContains(t, c.execute([]string{"roles", "list", "-o", "xml"}), "unsupported output format")
+}
+
+func TestReadResourceFile_Errors(t *testing.T) {
+	dir := t.TempDir()
+	list := filepath.Join(dir, "list.yaml")
+	require.NoError(t, os.WriteFile(list, []byte("- a\n- b\n"), 0o600))
+
+	_, err := readResourceFile(filepath.Join(dir, "missing.yaml"))
+	assert.ErrorContains(t, err, "failed to read")
+
+	_, err = readResourceFile(list)
+	assert.ErrorContains(t, err, "must contain a single object")
+}
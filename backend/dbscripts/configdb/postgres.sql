@@ -143,6 +143,261 @@ CREATE INDEX idx_idp_name_deployment ON "IDP" (DEPLOYMENT_ID, NAME);
 -- GIN index for JSONB containment queries on IDP properties (e.g. issuer lookup via @>)
 CREATE INDEX idx_idp_properties ON "IDP" USING GIN ("PROPERTIES" jsonb_path_ops);
 
+-- Table to store tenants (organizations) for organization-level multi-tenancy.
+CREATE TABLE "TENANT" (
+    DEPLOYMENT_ID VARCHAR(255) NOT NULL,
+    ID VARCHAR(36) PRIMARY KEY,
+    HANDLE VARCHAR(100) NOT NULL,
+    NAME VARCHAR(255) NOT NULL,
+    DESCRIPTION VARCHAR(500),
+    HOSTNAME VARCHAR(255),
+    CREATED_AT TIMESTAMPTZ DEFAULT NOW(),
+    UPDATED_AT TIMESTAMPTZ DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX idx_tenant_handle_deployment ON "TENANT" (HANDLE, DEPLOYMENT_ID);
+CREATE INDEX idx_tenant_hostname_deployment ON "TENANT" (HOSTNAME, DEPLOYMENT_ID);
+
+-- Table to store email domain routes used for home realm discovery.
+CREATE TABLE "DOMAIN_ROUTE" (
+    DEPLOYMENT_ID VARCHAR(255) NOT NULL,
+    ID VARCHAR(36) PRIMARY KEY,
+    DOMAIN VARCHAR(255) NOT NULL,
+    OU_ID VARCHAR(36),
+    IDP_ID VARCHAR(36),
+    FLOW_BRANCH VARCHAR(100),
+    STATUS VARCHAR(30) NOT NULL,
+    VERIFICATION_TOKEN VARCHAR(64) NOT NULL,
+    VERIFIED_AT TIMESTAMPTZ,
+    CREATED_AT TIMESTAMPTZ DEFAULT NOW(),
+    UPDATED_AT TIMESTAMPTZ DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX idx_domain_route_domain_deployment ON "DOMAIN_ROUTE" (DOMAIN, DEPLOYMENT_ID);
+
+-- Table to store break-glass accounts used for time-boxed emergency access.
+CREATE TABLE "BREAK_GLASS_ACCOUNT" (
+    DEPLOYMENT_ID VARCHAR(255) NOT NULL,
+    ID VARCHAR(36) PRIMARY KEY,
+    USER_ID VARCHAR(36) NOT NULL,
+    DESCRIPTION VARCHAR(500),
+    STATUS VARCHAR(30) NOT NULL,
+    ACTIVATION_REASON VARCHAR(500),
+    ACTIVATION_PERIOD BIGINT,
+    REQUESTED_BY VARCHAR(255),
+    REQUESTED_AT TIMESTAMPTZ,
+    APPROVED_BY VARCHAR(255),
+    ACTIVATED_AT TIMESTAMPTZ,
+    EXPIRES_AT TIMESTAMPTZ,
+    CREATED_AT TIMESTAMPTZ DEFAULT NOW(),
+    UPDATED_AT TIMESTAMPTZ DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX idx_break_glass_account_user_deployment ON "BREAK_GLASS_ACCOUNT" (USER_ID, DEPLOYMENT_ID);
+
+-- Table to store change requests of sensitive operations awaiting a second approver.
+CREATE TABLE "CHANGE_REQUEST" (
+    DEPLOYMENT_ID VARCHAR(255) NOT NULL,
+    ID VARCHAR(36) PRIMARY KEY,
+    OPERATION VARCHAR(100) NOT NULL,
+    RESOURCE_ID VARCHAR(255) NOT NULL,
+    PAYLOAD TEXT NOT NULL,
+    STATUS VARCHAR(30) NOT NULL,
+    REQUESTED_BY VARCHAR(255) NOT NULL,
+    REQUESTED_AT TIMESTAMPTZ NOT NULL,
+    EXPIRES_AT TIMESTAMPTZ NOT NULL,
+    DECIDED_BY VARCHAR(255),
+    DECIDED_AT TIMESTAMPTZ,
+    CREATED_AT TIMESTAMPTZ DEFAULT NOW(),
+    UPDATED_AT TIMESTAMPTZ DEFAULT NOW()
+);
+
+CREATE INDEX idx_change_request_deployment_requested_at ON "CHANGE_REQUEST" (DEPLOYMENT_ID, REQUESTED_AT);
+
+-- Table to store access review campaigns of role assignments.
+CREATE TABLE "ACCESS_REVIEW_CAMPAIGN" (
+    DEPLOYMENT_ID VARCHAR(255) NOT NULL,
+    ID VARCHAR(36) PRIMARY KEY,
+    NAME VARCHAR(255) NOT NULL,
+    DESCRIPTION TEXT,
+    TARGETS TEXT NOT NULL,
+    REVIEWERS TEXT NOT NULL,
+    AUTO_REVOKE CHAR(1) NOT NULL DEFAULT '0',
+    STATUS VARCHAR(30) NOT NULL,
+    CREATED_BY VARCHAR(255) NOT NULL,
+    DEADLINE TIMESTAMPTZ NOT NULL,
+    COMPLETED_AT TIMESTAMPTZ,
+    CREATED_AT TIMESTAMPTZ DEFAULT NOW(),
+    UPDATED_AT TIMESTAMPTZ DEFAULT NOW()
+);
+
+CREATE INDEX idx_access_review_campaign_deployment_status ON "ACCESS_REVIEW_CAMPAIGN" (DEPLOYMENT_ID, STATUS);
+
+-- Table to store the role assignments snapshotted by an access review campaign and their review decisions.
+CREATE TABLE "ACCESS_REVIEW_ITEM" (
+    DEPLOYMENT_ID VARCHAR(255) NOT NULL,
+    ID VARCHAR(36) PRIMARY KEY,
+    CAMPAIGN_ID VARCHAR(36) NOT NULL,
+    ROLE_ID VARCHAR(36) NOT NULL,
+    ROLE_NAME VARCHAR(255) NOT NULL,
+    ASSIGNEE_ID VARCHAR(36) NOT NULL,
+    ASSIGNEE_TYPE VARCHAR(30) NOT NULL,
+    ASSIGNEE_DISPLAY VARCHAR(255),
+    REVIEWER VARCHAR(255) NOT NULL,
+    DECISION VARCHAR(30) NOT NULL,
+    COMMENT TEXT,
+    DECIDED_BY VARCHAR(255),
+    DECIDED_AT TIMESTAMPTZ,
+    OUTCOME VARCHAR(30),
+    CREATED_AT TIMESTAMPTZ DEFAULT NOW(),
+    UPDATED_AT TIMESTAMPTZ DEFAULT NOW(),
+    FOREIGN KEY (CAMPAIGN_ID) REFERENCES "ACCESS_REVIEW_CAMPAIGN" (ID) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_access_review_item_campaign ON "ACCESS_REVIEW_ITEM" (DEPLOYMENT_ID, CAMPAIGN_ID);
+CREATE INDEX idx_access_review_item_reviewer ON "ACCESS_REVIEW_ITEM" (DEPLOYMENT_ID, REVIEWER);
+
+-- Table to store the operational notifications delivered to the admin roles.
+CREATE TABLE "ADMIN_NOTIFICATION" (
+    DEPLOYMENT_ID VARCHAR(255) NOT NULL,
+    ID VARCHAR(36) PRIMARY KEY,
+    EVENT_TYPE VARCHAR(100) NOT NULL,
+    SEVERITY VARCHAR(20) NOT NULL,
+    TITLE VARCHAR(255) NOT NULL,
+    MESSAGE TEXT NOT NULL,
+    DETAILS TEXT,
+    RECIPIENT_ROLE VARCHAR(255) NOT NULL,
+    SOURCE_KEY VARCHAR(512) NOT NULL,
+    IS_READ CHAR(1) NOT NULL DEFAULT '0',
+    READ_BY VARCHAR(255),
+    READ_AT TIMESTAMPTZ,
+    CREATED_AT TIMESTAMPTZ DEFAULT NOW()
+);
+
+CREATE INDEX idx_admin_notification_role_created_at ON "ADMIN_NOTIFICATION" (DEPLOYMENT_ID, RECIPIENT_ROLE, CREATED_AT);
+CREATE INDEX idx_admin_notification_created_at ON "ADMIN_NOTIFICATION" (DEPLOYMENT_ID, CREATED_AT);
+
+-- Table to store the events queued for webhook subscriptions until they are delivered and retained.
+CREATE TABLE "WEBHOOK_EVENT" (
+    DEPLOYMENT_ID VARCHAR(255) NOT NULL,
+    ID VARCHAR(36) PRIMARY KEY,
+    SUBSCRIPTION_ID VARCHAR(255) NOT NULL,
+    EVENT_TYPE VARCHAR(100) NOT NULL,
+    PAYLOAD TEXT NOT NULL,
+    STATUS VARCHAR(20) NOT NULL,
+    ATTEMPTS INTEGER NOT NULL DEFAULT 0,
+    LAST_ERROR TEXT,
+    LAST_ATTEMPT_AT TIMESTAMPTZ,
+    NEXT_ATTEMPT_AT TIMESTAMPTZ,
+    DELIVERED_AT TIMESTAMPTZ,
+    CREATED_AT TIMESTAMPTZ DEFAULT NOW()
+);
+
+CREATE INDEX idx_webhook_event_subscription_status ON "WEBHOOK_EVENT" (DEPLOYMENT_ID, SUBSCRIPTION_ID, STATUS, CREATED_AT);
+
+-- Table to store the audit log of administrative and runtime events.
+CREATE TABLE "AUDIT_EVENT" (
+    DEPLOYMENT_ID VARCHAR(255) NOT NULL,
+    ID VARCHAR(36) PRIMARY KEY,
+    EVENT_TYPE VARCHAR(100) NOT NULL,
+    COMPONENT VARCHAR(100) NOT NULL,
+    OUTCOME VARCHAR(20) NOT NULL,
+    ACTOR_ID VARCHAR(255),
+    TARGET_TYPE VARCHAR(50),
+    TARGET_ID VARCHAR(255),
+    OU_ID VARCHAR(36),
+    TRACE_ID VARCHAR(255),
+    DETAILS TEXT,
+    CREATED_AT TIMESTAMPTZ DEFAULT NOW()
+);
+
+CREATE INDEX idx_audit_event_created_at ON "AUDIT_EVENT" (DEPLOYMENT_ID, CREATED_AT);
+CREATE INDEX idx_audit_event_target ON "AUDIT_EVENT" (DEPLOYMENT_ID, TARGET_TYPE, TARGET_ID);
+CREATE INDEX idx_audit_event_actor ON "AUDIT_EVENT" (DEPLOYMENT_ID, ACTOR_ID);
+
+-- Table to store the Security Event Tokens awaiting delivery to, or acknowledgement by, their receivers.
+CREATE TABLE "SECURITY_EVENT" (
+    DEPLOYMENT_ID VARCHAR(255) NOT NULL,
+    ID VARCHAR(36) PRIMARY KEY,
+    RECEIVER_ID VARCHAR(255) NOT NULL,
+    EVENT_TYPE VARCHAR(255) NOT NULL,
+    TOKEN TEXT NOT NULL,
+    ATTEMPTS INTEGER NOT NULL DEFAULT 0,
+    CREATED_AT TIMESTAMPTZ DEFAULT NOW()
+);
+
+CREATE INDEX idx_security_event_receiver ON "SECURITY_EVENT" (DEPLOYMENT_ID, RECEIVER_ID, CREATED_AT);
+
+-- Table to store relationship tuples granting a subject a relation on an object.
+CREATE TABLE "RELATIONSHIP_TUPLE" (
+    DEPLOYMENT_ID VARCHAR(255) NOT NULL,
+    OBJECT_TYPE VARCHAR(100) NOT NULL,
+    OBJECT_ID VARCHAR(255) NOT NULL,
+    RELATION VARCHAR(100) NOT NULL,
+    SUBJECT_TYPE VARCHAR(100) NOT NULL,
+    SUBJECT_ID VARCHAR(255) NOT NULL,
+    SUBJECT_RELATION VARCHAR(100) NOT NULL DEFAULT '',
+    CREATED_AT TIMESTAMPTZ DEFAULT NOW(),
+    PRIMARY KEY (DEPLOYMENT_ID, OBJECT_TYPE, OBJECT_ID, RELATION, SUBJECT_TYPE, SUBJECT_ID, SUBJECT_RELATION)
+);
+
+CREATE INDEX idx_relationship_tuple_subject ON "RELATIONSHIP_TUPLE" (DEPLOYMENT_ID, SUBJECT_TYPE, SUBJECT_ID);
+
+-- Table to store deny list entries that block usernames and passwords deployment wide.
+CREATE TABLE "DENY_LIST_ENTRY" (
+    DEPLOYMENT_ID VARCHAR(255) NOT NULL,
+    ID VARCHAR(36) PRIMARY KEY,
+    LIST_TYPE VARCHAR(30) NOT NULL,
+    VALUE VARCHAR(255) NOT NULL,
+    MATCH_TYPE VARCHAR(30) NOT NULL,
+    CASE_SENSITIVE BOOLEAN DEFAULT FALSE NOT NULL,
+    CREATED_AT TIMESTAMPTZ DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX idx_deny_list_entry_value_deployment
+    ON "DENY_LIST_ENTRY" (LIST_TYPE, MATCH_TYPE, CASE_SENSITIVE, VALUE, DEPLOYMENT_ID);
+
+-- Table to store the resource quotas of organization units. An empty OU_ID holds the quotas of the tenant.
+CREATE TABLE "RESOURCE_QUOTA" (
+    DEPLOYMENT_ID VARCHAR(255) NOT NULL,
+    OU_ID VARCHAR(36) NOT NULL,
+    RESOURCE_TYPE VARCHAR(30) NOT NULL,
+    QUOTA_LIMIT INTEGER NOT NULL,
+    CREATED_AT TIMESTAMPTZ DEFAULT NOW(),
+    PRIMARY KEY (OU_ID, RESOURCE_TYPE, DEPLOYMENT_ID)
+);
+
+-- Table to store the password policies of the tenant and of organization units.
+CREATE TABLE "PASSWORD_POLICY" (
+    DEPLOYMENT_ID VARCHAR(255) NOT NULL,
+    OU_ID VARCHAR(36) NOT NULL,
+    POLICY JSONB NOT NULL,
+    UPDATED_AT TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (OU_ID, DEPLOYMENT_ID)
+);
+
+-- Table to store the approved baseline of the security relevant settings checked for configuration drift.
+CREATE TABLE "CONFIG_DRIFT_BASELINE" (
+    DEPLOYMENT_ID VARCHAR(255) PRIMARY KEY,
+    SETTINGS JSONB NOT NULL,
+    CREATED_BY VARCHAR(255),
+    NOTIFIED_DIGEST VARCHAR(64),
+    CREATED_AT TIMESTAMPTZ NOT NULL
+);
+
+-- Table to store feature flags for the progressive rollout of backend features.
+CREATE TABLE "FEATURE_FLAG" (
+    DEPLOYMENT_ID VARCHAR(255) NOT NULL,
+    FLAG_KEY VARCHAR(100) NOT NULL,
+    DESCRIPTION VARCHAR(500),
+    ENABLED BOOLEAN DEFAULT FALSE NOT NULL,
+    DEFAULT_VALUE BOOLEAN DEFAULT FALSE NOT NULL,
+    RULES JSONB NOT NULL,
+    CREATED_AT TIMESTAMPTZ NOT NULL,
+    UPDATED_AT TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (FLAG_KEY, DEPLOYMENT_ID)
+);
+
 -- Table to store notification senders.
 CREATE TABLE "NOTIFICATION_SENDER" (
     DEPLOYMENT_ID VARCHAR(255) NOT NULL,
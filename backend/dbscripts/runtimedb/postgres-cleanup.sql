@@ -56,5 +56,15 @@ BEGIN
     DELETE FROM "WEBAUTHN_SESSION"      WHERE EXPIRY_TIME < v_now;
     DELETE FROM "ATTRIBUTE_CACHE"       WHERE EXPIRY_TIME < v_now;
     DELETE FROM "PAR_REQUEST"           WHERE EXPIRY_TIME < v_now;
+    DELETE FROM "OAUTH_TOKEN"           WHERE EXPIRY_TIME < v_now;
+    DELETE FROM "OU_DELETION_JOB"       WHERE EXPIRY_TIME < v_now;
+    DELETE FROM "TRUSTED_DEVICE"        WHERE EXPIRY_TIME < v_now;
+    DELETE FROM "REENCRYPTION_JOB"      WHERE EXPIRY_TIME < v_now;
+    DELETE FROM "OAUTH_PROTOCOL_TRACE"  WHERE EXPIRY_TIME < v_now;
+    DELETE FROM "PENDING_EMAIL_CHANGE"  WHERE EXPIRY_TIME < v_now;
+    DELETE FROM "ACCOUNT_CHANGE_HOLD"   WHERE EXPIRY_TIME < v_now;
+    DELETE FROM "ENROLLMENT_SESSION"    WHERE EXPIRY_TIME < v_now;
+    DELETE FROM "SECURITY_NOTIFICATION_DEVICE" WHERE EXPIRY_TIME < v_now;
+    DELETE FROM "REINDEX_JOB"           WHERE EXPIRY_TIME < v_now;
 END;
 $$;
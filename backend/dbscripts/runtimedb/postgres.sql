@@ -78,3 +78,214 @@ CREATE TABLE "PAR_REQUEST" (
 
 -- Index for expiry time on PAR_REQUEST (supports cleanup and expiry checks)
 CREATE INDEX idx_par_request_expiry_time ON "PAR_REQUEST" (EXPIRY_TIME);
+
+-- Table to store short-lived OAuth artifacts (authorization codes, device codes, PAR request URIs,
+-- refresh tokens) when the database token store is selected
+CREATE TABLE "OAUTH_TOKEN" (
+    TOKEN_KEY VARCHAR(255) NOT NULL,
+    ARTIFACT_TYPE VARCHAR(50) NOT NULL,
+    DEPLOYMENT_ID VARCHAR(255) NOT NULL,
+    TOKEN_DATA TEXT NOT NULL,
+    EXPIRY_TIME TIMESTAMP NOT NULL,
+    CREATED_AT TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (TOKEN_KEY, ARTIFACT_TYPE, DEPLOYMENT_ID)
+);
+
+-- Index for expiry time on OAUTH_TOKEN (supports cleanup and expiry checks)
+CREATE INDEX idx_oauth_token_expiry_time ON "OAUTH_TOKEN" (EXPIRY_TIME);
+
+-- Table to track asynchronous organization unit deletion jobs and their progress
+CREATE TABLE "OU_DELETION_JOB" (
+    ID VARCHAR(36) NOT NULL,
+    DEPLOYMENT_ID VARCHAR(255) NOT NULL,
+    OU_ID VARCHAR(36) NOT NULL,
+    STATUS VARCHAR(20) NOT NULL,
+    PROGRESS TEXT NOT NULL,
+    FAILURE_REASON VARCHAR(1024),
+    CREATED_AT TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UPDATED_AT TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    EXPIRY_TIME TIMESTAMP NOT NULL,
+    PRIMARY KEY (ID, DEPLOYMENT_ID)
+);
+
+-- Index for looking up the active deletion job of an organization unit
+CREATE INDEX idx_ou_deletion_job_ou_id ON "OU_DELETION_JOB" (OU_ID, DEPLOYMENT_ID);
+
+-- Index for expiry time on OU_DELETION_JOB (supports cleanup and expiry checks)
+CREATE INDEX idx_ou_deletion_job_expiry_time ON "OU_DELETION_JOB" (EXPIRY_TIME);
+
+-- Table to store jobs that re-encrypt stored secrets after an encryption key rotation
+CREATE TABLE "REENCRYPTION_JOB" (
+    ID VARCHAR(36) NOT NULL,
+    DEPLOYMENT_ID VARCHAR(255) NOT NULL,
+    STATUS VARCHAR(20) NOT NULL,
+    PROGRESS TEXT NOT NULL,
+    FAILURE_REASON VARCHAR(1024),
+    CREATED_AT TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UPDATED_AT TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    EXPIRY_TIME TIMESTAMP NOT NULL,
+    PRIMARY KEY (ID, DEPLOYMENT_ID)
+);
+
+-- Index for looking up the active re-encryption job
+CREATE INDEX idx_reencryption_job_status ON "REENCRYPTION_JOB" (STATUS, DEPLOYMENT_ID);
+
+-- Index for expiry time on REENCRYPTION_JOB (supports cleanup and expiry checks)
+CREATE INDEX idx_reencryption_job_expiry_time ON "REENCRYPTION_JOB" (EXPIRY_TIME);
+
+-- Table to store jobs that reindex existing users after the indexed attributes change
+CREATE TABLE "REINDEX_JOB" (
+    ID VARCHAR(36) NOT NULL,
+    DEPLOYMENT_ID VARCHAR(255) NOT NULL,
+    STATUS VARCHAR(20) NOT NULL,
+    INDEXED_ATTRIBUTES TEXT NOT NULL,
+    PROGRESS TEXT NOT NULL,
+    FAILURE_REASON VARCHAR(1024),
+    CREATED_AT TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UPDATED_AT TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    EXPIRY_TIME TIMESTAMP NOT NULL,
+    PRIMARY KEY (ID, DEPLOYMENT_ID)
+);
+
+-- Index for looking up the active reindex job
+CREATE INDEX idx_reindex_job_status ON "REINDEX_JOB" (STATUS, DEPLOYMENT_ID);
+
+-- Index for expiry time on REINDEX_JOB (supports cleanup and expiry checks)
+CREATE INDEX idx_reindex_job_expiry_time ON "REINDEX_JOB" (EXPIRY_TIME);
+
+-- Table to store trusted devices that can skip multi-factor authentication
+CREATE TABLE "TRUSTED_DEVICE" (
+    ID VARCHAR(36) NOT NULL,
+    DEPLOYMENT_ID VARCHAR(255) NOT NULL,
+    USER_ID VARCHAR(36) NOT NULL,
+    NAME VARCHAR(255),
+    FINGERPRINT_HASH VARCHAR(64) NOT NULL,
+    CREATED_AT TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    LAST_USED_AT TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    EXPIRY_TIME TIMESTAMP NOT NULL,
+    PRIMARY KEY (ID, DEPLOYMENT_ID)
+);
+
+-- Index for listing the trusted devices of a user
+CREATE INDEX idx_trusted_device_user_id ON "TRUSTED_DEVICE" (USER_ID, DEPLOYMENT_ID);
+
+-- Index for expiry time on TRUSTED_DEVICE (supports cleanup and expiry checks)
+CREATE INDEX idx_trusted_device_expiry_time ON "TRUSTED_DEVICE" (EXPIRY_TIME);
+
+-- Table to store email address changes awaiting confirmation
+CREATE TABLE "PENDING_EMAIL_CHANGE" (
+    USER_ID VARCHAR(36) NOT NULL,
+    DEPLOYMENT_ID VARCHAR(255) NOT NULL,
+    NEW_EMAIL VARCHAR(320) NOT NULL,
+    NEW_TOKEN_HASH VARCHAR(64) NOT NULL,
+    OLD_TOKEN_HASH VARCHAR(64),
+    NEW_CONFIRMED_AT TIMESTAMP,
+    OLD_CONFIRMED_AT TIMESTAMP,
+    CREATED_AT TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    EXPIRY_TIME TIMESTAMP NOT NULL,
+    PRIMARY KEY (USER_ID, DEPLOYMENT_ID)
+);
+
+-- Indexes for looking up a pending email change by confirmation token
+CREATE INDEX idx_pending_email_change_new_token ON "PENDING_EMAIL_CHANGE" (NEW_TOKEN_HASH, DEPLOYMENT_ID);
+CREATE INDEX idx_pending_email_change_old_token ON "PENDING_EMAIL_CHANGE" (OLD_TOKEN_HASH, DEPLOYMENT_ID);
+
+-- Index for expiry time on PENDING_EMAIL_CHANGE (supports cleanup and expiry checks)
+CREATE INDEX idx_pending_email_change_expiry_time ON "PENDING_EMAIL_CHANGE" (EXPIRY_TIME);
+
+-- Table to store the deletions of accounts requested by their users, pending until their grace period ends
+CREATE TABLE "PENDING_ACCOUNT_DELETION" (
+    USER_ID VARCHAR(36) NOT NULL,
+    DEPLOYMENT_ID VARCHAR(255) NOT NULL,
+    TOKEN_HASH VARCHAR(64) NOT NULL,
+    PREVIOUS_STATE VARCHAR(50),
+    CREATED_AT TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    DELETION_TIME TIMESTAMP NOT NULL,
+    PRIMARY KEY (USER_ID, DEPLOYMENT_ID)
+);
+
+-- Index for looking up a pending account deletion by cancellation token
+CREATE INDEX idx_pending_account_deletion_token ON "PENDING_ACCOUNT_DELETION" (TOKEN_HASH, DEPLOYMENT_ID);
+
+-- Index for deletion time on PENDING_ACCOUNT_DELETION (supports the scheduled purge)
+CREATE INDEX idx_pending_account_deletion_time ON "PENDING_ACCOUNT_DELETION" (DELETION_TIME);
+
+-- Table to hold the high-risk account changes of users after a password reset or a reverted email change
+CREATE TABLE "ACCOUNT_CHANGE_HOLD" (
+    USER_ID VARCHAR(36) NOT NULL,
+    DEPLOYMENT_ID VARCHAR(255) NOT NULL,
+    CREATED_AT TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    EXPIRY_TIME TIMESTAMP NOT NULL,
+    PRIMARY KEY (USER_ID, DEPLOYMENT_ID)
+);
+
+-- Index for expiry time on ACCOUNT_CHANGE_HOLD (supports cleanup and expiry checks)
+CREATE INDEX idx_account_change_hold_expiry_time ON "ACCOUNT_CHANGE_HOLD" (EXPIRY_TIME);
+
+-- Table to store the enrollment sessions that hold authenticator enrollment URIs for QR codes
+CREATE TABLE "ENROLLMENT_SESSION" (
+    TOKEN_HASH VARCHAR(64) NOT NULL,
+    DEPLOYMENT_ID VARCHAR(255) NOT NULL,
+    USER_ID VARCHAR(36) NOT NULL,
+    ENROLLMENT_TYPE VARCHAR(20) NOT NULL,
+    PAYLOAD TEXT NOT NULL,
+    CREATED_AT TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    EXPIRY_TIME TIMESTAMP NOT NULL,
+    PRIMARY KEY (TOKEN_HASH, DEPLOYMENT_ID)
+);
+
+-- Index for expiry time on ENROLLMENT_SESSION (supports cleanup and expiry checks)
+CREATE INDEX idx_enrollment_session_expiry_time ON "ENROLLMENT_SESSION" (EXPIRY_TIME);
+
+-- Table to store redacted OAuth protocol messages captured for troubleshooting
+CREATE TABLE "OAUTH_PROTOCOL_TRACE" (
+    ID VARCHAR(36) NOT NULL,
+    DEPLOYMENT_ID VARCHAR(255) NOT NULL,
+    CLIENT_ID VARCHAR(255) NOT NULL,
+    MESSAGE_TYPE VARCHAR(50) NOT NULL,
+    TRACE_DATA JSONB NOT NULL,
+    CREATED_AT TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    EXPIRY_TIME TIMESTAMP NOT NULL,
+    PRIMARY KEY (ID, DEPLOYMENT_ID)
+);
+
+-- Index for listing the protocol traces of a client
+CREATE INDEX idx_oauth_protocol_trace_client_id ON "OAUTH_PROTOCOL_TRACE" (CLIENT_ID, DEPLOYMENT_ID);
+
+-- Index for expiry time on OAUTH_PROTOCOL_TRACE (supports cleanup and expiry checks)
+CREATE INDEX idx_oauth_protocol_trace_expiry_time ON "OAUTH_PROTOCOL_TRACE" (EXPIRY_TIME);
+
+-- Table to store when the OAuth client of each application was last used
+CREATE TABLE "OAUTH_CLIENT_USAGE" (
+    APP_ID VARCHAR(36) NOT NULL,
+    DEPLOYMENT_ID VARCHAR(255) NOT NULL,
+    LAST_TOKEN_ISSUED_AT TIMESTAMP,
+    LAST_AUTHORIZED_AT TIMESTAMP,
+    TRACKED_SINCE TIMESTAMP NOT NULL,
+    PRIMARY KEY (APP_ID, DEPLOYMENT_ID)
+);
+
+-- Table to store the leases of distributed locks that coordinate work across cluster nodes. Rows are kept
+-- after a lock is released so that fencing tokens keep increasing.
+CREATE TABLE "DISTRIBUTED_LOCK" (
+    LOCK_NAME VARCHAR(255) NOT NULL,
+    DEPLOYMENT_ID VARCHAR(255) NOT NULL,
+    OWNER_ID VARCHAR(255) NOT NULL,
+    FENCING_TOKEN BIGINT NOT NULL,
+    LEASE_EXPIRY_TIME TIMESTAMP NOT NULL,
+    PRIMARY KEY (LOCK_NAME, DEPLOYMENT_ID)
+);
+
+-- Table to store the devices users signed in from, so that sign-ins from a known device do not trigger a
+-- security notification
+CREATE TABLE "SECURITY_NOTIFICATION_DEVICE" (
+    USER_ID VARCHAR(36) NOT NULL,
+    DEPLOYMENT_ID VARCHAR(255) NOT NULL,
+    FINGERPRINT_HASH VARCHAR(64) NOT NULL,
+    LAST_SEEN_AT TIMESTAMP NOT NULL,
+    EXPIRY_TIME TIMESTAMP NOT NULL,
+    PRIMARY KEY (USER_ID, FINGERPRINT_HASH, DEPLOYMENT_ID)
+);
+
+-- Index for expiry time on SECURITY_NOTIFICATION_DEVICE (supports cleanup and expiry checks)
+CREATE INDEX idx_security_notification_device_expiry_time ON "SECURITY_NOTIFICATION_DEVICE" (EXPIRY_TIME);
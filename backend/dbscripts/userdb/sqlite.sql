@@ -78,3 +78,32 @@ CREATE TABLE "ENTITY_IDENTIFIER" (
 
 -- Index for fast identifier lookups (primary use case for authentication)
 CREATE INDEX idx_entity_identifier_lookup ON "ENTITY_IDENTIFIER" (NAME, VALUE);
+
+-- Table to store the applications users are associated with, for applications that isolate their users
+CREATE TABLE "APPLICATION_USER" (
+    DEPLOYMENT_ID   VARCHAR(255) NOT NULL,
+    APPLICATION_ID  VARCHAR(36)  NOT NULL,
+    USER_ID         VARCHAR(36)  NOT NULL,
+    SOURCE          VARCHAR(20)  NOT NULL,
+    CREATED_AT      TEXT NOT NULL,
+    PRIMARY KEY (APPLICATION_ID, USER_ID, DEPLOYMENT_ID),
+    FOREIGN KEY (USER_ID) REFERENCES "ENTITY" (ID) ON DELETE CASCADE
+);
+
+-- Index for listing the applications a user is associated with
+CREATE INDEX idx_application_user_user ON "APPLICATION_USER" (DEPLOYMENT_ID, USER_ID);
+
+-- Table to store the previous password hashes of users, used to prevent the reuse of passwords
+CREATE TABLE "PASSWORD_HISTORY" (
+    DEPLOYMENT_ID   VARCHAR(255) NOT NULL,
+    ID              VARCHAR(36)  NOT NULL,
+    ENTITY_ID       VARCHAR(36)  NOT NULL,
+    CREDENTIAL_TYPE VARCHAR(255) NOT NULL,
+    CREDENTIAL      TEXT         NOT NULL,
+    CREATED_AT      TEXT NOT NULL,
+    PRIMARY KEY (ID, DEPLOYMENT_ID),
+    FOREIGN KEY (ENTITY_ID) REFERENCES "ENTITY" (ID) ON DELETE CASCADE
+);
+
+-- Index for listing the password history of a user
+CREATE INDEX idx_password_history_entity ON "PASSWORD_HISTORY" (DEPLOYMENT_ID, ENTITY_ID, CREDENTIAL_TYPE);
@@ -0,0 +1,34 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package accessreview
+
+import "time"
+
+const (
+	// loggerComponentName is the component name used in access review logs.
+	loggerComponentName = "AccessReviewService"
+
+	// scheduledCompletionLockName is the distributed lock held while overdue campaigns are completed.
+	scheduledCompletionLockName = "access-review-scheduled-completion"
+	// scheduledCompletionJobName names the scheduled completion in the events of its failures.
+	scheduledCompletionJobName = "access-review-scheduled-completion"
+
+	// defaultDuration is the time from creation to the deadline of a campaign when none is configured.
+	defaultDuration = 14 * 24 * time.Hour
+)
@@ -0,0 +1,231 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package accessreview
+
+import (
+	"errors"
+
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/i18n/core"
+)
+
+// ErrCampaignNotFound is returned when the campaign is not found in the system.
+var ErrCampaignNotFound = errors.New("access review campaign not found")
+
+// ErrCampaignNotActive is returned when a campaign that is no longer active is completed.
+var ErrCampaignNotActive = errors.New("access review campaign is not active")
+
+// Client errors for access review operations.
+var (
+	// ErrorCampaignNotFound is the error returned when the campaign is not found.
+	ErrorCampaignNotFound = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "ARV-1001",
+		Error: core.I18nMessage{
+			Key:          "error.accessreviewservice.campaign_not_found",
+			DefaultValue: "Access review campaign not found",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.accessreviewservice.campaign_not_found_description",
+			DefaultValue: "The requested access review campaign could not be found",
+		},
+	}
+	// ErrorInvalidRequestFormat is the error returned when the request body is malformed.
+	ErrorInvalidRequestFormat = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "ARV-1002",
+		Error: core.I18nMessage{
+			Key:          "error.accessreviewservice.invalid_request_format",
+			DefaultValue: "Invalid request format",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.accessreviewservice.invalid_request_format_description",
+			DefaultValue: "The request body is malformed or contains invalid data",
+		},
+	}
+	// ErrorMissingCampaignName is the error returned when the campaign name is missing.
+	ErrorMissingCampaignName = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "ARV-1003",
+		Error: core.I18nMessage{
+			Key:          "error.accessreviewservice.missing_campaign_name",
+			DefaultValue: "Missing campaign name",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.accessreviewservice.missing_campaign_name_description",
+			DefaultValue: "The name of the campaign must be provided",
+		},
+	}
+	// ErrorMissingTargets is the error returned when the campaign targets no roles or organization units.
+	ErrorMissingTargets = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "ARV-1004",
+		Error: core.I18nMessage{
+			Key:          "error.accessreviewservice.missing_targets",
+			DefaultValue: "Missing campaign targets",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.accessreviewservice.missing_targets_description",
+			DefaultValue: "The campaign must target at least one role or organization unit",
+		},
+	}
+	// ErrorMissingReviewers is the error returned when the campaign has no reviewers.
+	ErrorMissingReviewers = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "ARV-1005",
+		Error: core.I18nMessage{
+			Key:          "error.accessreviewservice.missing_reviewers",
+			DefaultValue: "Missing reviewers",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.accessreviewservice.missing_reviewers_description",
+			DefaultValue: "The campaign must have at least one reviewer",
+		},
+	}
+	// ErrorInvalidDeadline is the error returned when the deadline is not in the future.
+	ErrorInvalidDeadline = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "ARV-1006",
+		Error: core.I18nMessage{
+			Key:          "error.accessreviewservice.invalid_deadline",
+			DefaultValue: "Invalid deadline",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.accessreviewservice.invalid_deadline_description",
+			DefaultValue: "The deadline of the campaign must be in the future",
+		},
+	}
+	// ErrorTargetRoleNotFound is the error returned when a targeted role does not exist.
+	ErrorTargetRoleNotFound = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "ARV-1007",
+		Error: core.I18nMessage{
+			Key:          "error.accessreviewservice.target_role_not_found",
+			DefaultValue: "Role not found",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.accessreviewservice.target_role_not_found_description",
+			DefaultValue: "One or more of the targeted roles could not be found",
+		},
+	}
+	// ErrorNoAssignmentsToReview is the error returned when the targeted roles have no assignments.
+	ErrorNoAssignmentsToReview = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "ARV-1008",
+		Error: core.I18nMessage{
+			Key:          "error.accessreviewservice.no_assignments_to_review",
+			DefaultValue: "No assignments to review",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.accessreviewservice.no_assignments_to_review_description",
+			DefaultValue: "The targeted roles have no assignments to review",
+		},
+	}
+	// ErrorNoEligibleReviewer is the error returned when an assignment can only be reviewed by its own holder.
+	ErrorNoEligibleReviewer = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "ARV-1009",
+		Error: core.I18nMessage{
+			Key:          "error.accessreviewservice.no_eligible_reviewer",
+			DefaultValue: "No eligible reviewer",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.accessreviewservice.no_eligible_reviewer_description",
+			DefaultValue: "An assignment cannot be reviewed by its own holder. Add another reviewer",
+		},
+	}
+	// ErrorCampaignNotActive is the error returned when the campaign is no longer active.
+	ErrorCampaignNotActive = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "ARV-1010",
+		Error: core.I18nMessage{
+			Key:          "error.accessreviewservice.campaign_not_active",
+			DefaultValue: "Campaign not active",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.accessreviewservice.campaign_not_active_description",
+			DefaultValue: "The access review campaign has already been completed",
+		},
+	}
+	// ErrorReviewItemNotFound is the error returned when a review item is not part of the campaign.
+	ErrorReviewItemNotFound = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "ARV-1011",
+		Error: core.I18nMessage{
+			Key:          "error.accessreviewservice.review_item_not_found",
+			DefaultValue: "Review item not found",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.accessreviewservice.review_item_not_found_description",
+			DefaultValue: "One or more review items could not be found in the campaign",
+		},
+	}
+	// ErrorInvalidDecision is the error returned when the decision is not keep or revoke.
+	ErrorInvalidDecision = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "ARV-1012",
+		Error: core.I18nMessage{
+			Key:          "error.accessreviewservice.invalid_decision",
+			DefaultValue: "Invalid decision",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.accessreviewservice.invalid_decision_description",
+			DefaultValue: "The decision must be KEEP or REVOKE",
+		},
+	}
+	// ErrorNotAssignedReviewer is the error returned when the caller is not the reviewer of an item.
+	ErrorNotAssignedReviewer = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "ARV-1013",
+		Error: core.I18nMessage{
+			Key:          "error.accessreviewservice.not_assigned_reviewer",
+			DefaultValue: "Not the assigned reviewer",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.accessreviewservice.not_assigned_reviewer_description",
+			DefaultValue: "One or more review items are assigned to another reviewer",
+		},
+	}
+	// ErrorInvalidStatusFilter is the error returned when the status filter is not a known status.
+	ErrorInvalidStatusFilter = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "ARV-1014",
+		Error: core.I18nMessage{
+			Key:          "error.accessreviewservice.invalid_status_filter",
+			DefaultValue: "Invalid status filter",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.accessreviewservice.invalid_status_filter_description",
+			DefaultValue: "The status filter must be one of ACTIVE or COMPLETED",
+		},
+	}
+	// ErrorAuthenticationFailed is the error returned when the caller could not be identified.
+	ErrorAuthenticationFailed = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "ARV-1015",
+		Error: core.I18nMessage{
+			Key:          "error.accessreviewservice.authentication_failed",
+			DefaultValue: "Authentication failed",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.accessreviewservice.authentication_failed_description",
+			DefaultValue: "The caller could not be identified",
+		},
+	}
+)
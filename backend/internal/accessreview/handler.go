@@ -0,0 +1,147 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package accessreview
+
+import (
+	"net/http"
+
+	sysutils "github.com/thunder-id/thunderid/internal/system/utils"
+)
+
+// accessReviewHandler is the handler for access review operations.
+type accessReviewHandler struct {
+	accessReviewService AccessReviewServiceInterface
+}
+
+// newAccessReviewHandler creates a new instance of accessReviewHandler.
+func newAccessReviewHandler(accessReviewService AccessReviewServiceInterface) *accessReviewHandler {
+	return &accessReviewHandler{
+		accessReviewService: accessReviewService,
+	}
+}
+
+// HandleCampaignPostRequest handles the create campaign request.
+func (h *accessReviewHandler) HandleCampaignPostRequest(w http.ResponseWriter, r *http.Request) {
+	request, err := sysutils.DecodeJSONBody[CreateCampaignRequest](r)
+	if err != nil {
+		sysutils.WriteServiceErrorResponse(w, &ErrorInvalidRequestFormat, clientErrorStatusCodes)
+		return
+	}
+
+	campaign, svcErr := h.accessReviewService.CreateCampaign(r.Context(), *request)
+	if svcErr != nil {
+		sysutils.WriteServiceErrorResponse(w, svcErr, clientErrorStatusCodes)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(w, http.StatusCreated, campaign)
+}
+
+// HandleCampaignListRequest handles the list campaigns request.
+func (h *accessReviewHandler) HandleCampaignListRequest(w http.ResponseWriter, r *http.Request) {
+	status := CampaignStatus(sysutils.SanitizeString(r.URL.Query().Get("status")))
+	campaigns, svcErr := h.accessReviewService.GetCampaignList(r.Context(), status)
+	if svcErr != nil {
+		sysutils.WriteServiceErrorResponse(w, svcErr, clientErrorStatusCodes)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(w, http.StatusOK, campaigns)
+}
+
+// HandleCampaignGetRequest handles the get campaign request.
+func (h *accessReviewHandler) HandleCampaignGetRequest(w http.ResponseWriter, r *http.Request) {
+	campaign, svcErr := h.accessReviewService.GetCampaign(r.Context(), r.PathValue("id"))
+	if svcErr != nil {
+		sysutils.WriteServiceErrorResponse(w, svcErr, clientErrorStatusCodes)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(w, http.StatusOK, campaign)
+}
+
+// HandleReviewItemListRequest handles the list review items of a campaign request.
+func (h *accessReviewHandler) HandleReviewItemListRequest(w http.ResponseWriter, r *http.Request) {
+	items, svcErr := h.accessReviewService.GetReviewItems(r.Context(), r.PathValue("id"))
+	if svcErr != nil {
+		sysutils.WriteServiceErrorResponse(w, svcErr, clientErrorStatusCodes)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(w, http.StatusOK, items)
+}
+
+// HandleAssignedReviewItemListRequest handles the request to list the review items assigned to the caller.
+func (h *accessReviewHandler) HandleAssignedReviewItemListRequest(w http.ResponseWriter, r *http.Request) {
+	items, svcErr := h.accessReviewService.GetAssignedReviewItems(r.Context())
+	if svcErr != nil {
+		sysutils.WriteServiceErrorResponse(w, svcErr, clientErrorStatusCodes)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(w, http.StatusOK, items)
+}
+
+// HandleDecisionsPostRequest handles the request to record review decisions.
+func (h *accessReviewHandler) HandleDecisionsPostRequest(w http.ResponseWriter, r *http.Request) {
+	request, err := sysutils.DecodeJSONBody[DecisionsRequest](r)
+	if err != nil {
+		sysutils.WriteServiceErrorResponse(w, &ErrorInvalidRequestFormat, clientErrorStatusCodes)
+		return
+	}
+
+	items, svcErr := h.accessReviewService.RecordDecisions(r.Context(), r.PathValue("id"), request.Decisions)
+	if svcErr != nil {
+		sysutils.WriteServiceErrorResponse(w, svcErr, clientErrorStatusCodes)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(w, http.StatusOK, items)
+}
+
+// HandleCampaignCompleteRequest handles the request to complete a campaign.
+func (h *accessReviewHandler) HandleCampaignCompleteRequest(w http.ResponseWriter, r *http.Request) {
+	report, svcErr := h.accessReviewService.CompleteCampaign(r.Context(), r.PathValue("id"))
+	if svcErr != nil {
+		sysutils.WriteServiceErrorResponse(w, svcErr, clientErrorStatusCodes)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(w, http.StatusOK, report)
+}
+
+// HandleCampaignReportRequest handles the get campaign report request.
+func (h *accessReviewHandler) HandleCampaignReportRequest(w http.ResponseWriter, r *http.Request) {
+	report, svcErr := h.accessReviewService.GetCampaignReport(r.Context(), r.PathValue("id"))
+	if svcErr != nil {
+		sysutils.WriteServiceErrorResponse(w, svcErr, clientErrorStatusCodes)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(w, http.StatusOK, report)
+}
+
+// clientErrorStatusCodes maps the client errors of the handler to HTTP status codes. Other client errors are
+// returned with 400 Bad Request.
+var clientErrorStatusCodes = map[string]int{
+	ErrorCampaignNotFound.Code:     http.StatusNotFound,
+	ErrorCampaignNotActive.Code:    http.StatusConflict,
+	ErrorAuthenticationFailed.Code: http.StatusUnauthorized,
+	ErrorNotAssignedReviewer.Code:  http.StatusForbidden,
+}
@@ -0,0 +1,140 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package accessreview
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/system/error/apierror"
+)
+
+type HandlerTestSuite struct {
+	suite.Suite
+	mockService *AccessReviewServiceInterfaceMock
+	handler     *accessReviewHandler
+}
+
+func TestHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(HandlerTestSuite))
+}
+
+func (s *HandlerTestSuite) SetupTest() {
+	s.mockService = NewAccessReviewServiceInterfaceMock(s.T())
+	s.handler = newAccessReviewHandler(s.mockService)
+}
+
+func (s *HandlerTestSuite) TestHandleCampaignPostRequest() {
+	s.mockService.On("CreateCampaign", mock.Anything, mock.MatchedBy(func(request CreateCampaignRequest) bool {
+		return request.Name == "review" && len(request.RoleIDs) == 1 && len(request.Reviewers) == 1
+	})).Return(&Campaign{ID: testCampaignID, Status: CampaignStatusActive}, nil)
+
+	req := httptest.NewRequest(http.MethodPost, "/access-reviews",
+		strings.NewReader(`{"name":"review","roleIds":["role-1"],"reviewers":["reviewer-1"]}`))
+	rr := httptest.NewRecorder()
+	s.handler.HandleCampaignPostRequest(rr, req)
+
+	s.Equal(http.StatusCreated, rr.Code)
+	var body Campaign
+	s.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &body))
+	s.Equal(testCampaignID, body.ID)
+}
+
+func (s *HandlerTestSuite) TestHandleCampaignPostRequest_InvalidBody() {
+	req := httptest.NewRequest(http.MethodPost, "/access-reviews", strings.NewReader(`{`))
+	rr := httptest.NewRecorder()
+	s.handler.HandleCampaignPostRequest(rr, req)
+
+	s.Equal(http.StatusBadRequest, rr.Code)
+	var body apierror.ErrorResponse
+	s.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &body))
+	s.Equal(ErrorInvalidRequestFormat.Code, body.Code)
+}
+
+func (s *HandlerTestSuite) TestHandleCampaignListRequest() {
+	s.mockService.On("GetCampaignList", mock.Anything, CampaignStatusActive).
+		Return(&CampaignListResponse{TotalResults: 1, Campaigns: []Campaign{{ID: testCampaignID}}}, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/access-reviews?status=ACTIVE", nil)
+	rr := httptest.NewRecorder()
+	s.handler.HandleCampaignListRequest(rr, req)
+
+	s.Equal(http.StatusOK, rr.Code)
+	var body CampaignListResponse
+	s.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &body))
+	s.Equal(1, body.TotalResults)
+}
+
+func (s *HandlerTestSuite) TestHandleCampaignGetRequest_NotFound() {
+	s.mockService.On("GetCampaign", mock.Anything, testCampaignID).Return(nil, &ErrorCampaignNotFound)
+
+	req := httptest.NewRequest(http.MethodGet, "/access-reviews/"+testCampaignID, nil)
+	req.SetPathValue("id", testCampaignID)
+	rr := httptest.NewRecorder()
+	s.handler.HandleCampaignGetRequest(rr, req)
+
+	s.Equal(http.StatusNotFound, rr.Code)
+}
+
+func (s *HandlerTestSuite) TestHandleDecisionsPostRequest_NotAssignedReviewer() {
+	s.mockService.On("RecordDecisions", mock.Anything, testCampaignID,
+		[]DecisionRequest{{ItemID: "item-1", Decision: DecisionKeep}}).Return(nil, &ErrorNotAssignedReviewer)
+
+	req := httptest.NewRequest(http.MethodPost, "/access-reviews/"+testCampaignID+"/decisions",
+		strings.NewReader(`{"decisions":[{"itemId":"item-1","decision":"KEEP"}]}`))
+	req.SetPathValue("id", testCampaignID)
+	rr := httptest.NewRecorder()
+	s.handler.HandleDecisionsPostRequest(rr, req)
+
+	s.Equal(http.StatusForbidden, rr.Code)
+}
+
+func (s *HandlerTestSuite) TestHandleCampaignCompleteRequest_NotActive() {
+	s.mockService.On("CompleteCampaign", mock.Anything, testCampaignID).Return(nil, &ErrorCampaignNotActive)
+
+	req := httptest.NewRequest(http.MethodPost, "/access-reviews/"+testCampaignID+"/complete", nil)
+	req.SetPathValue("id", testCampaignID)
+	rr := httptest.NewRecorder()
+	s.handler.HandleCampaignCompleteRequest(rr, req)
+
+	s.Equal(http.StatusConflict, rr.Code)
+}
+
+func (s *HandlerTestSuite) TestHandleCampaignReportRequest() {
+	s.mockService.On("GetCampaignReport", mock.Anything, testCampaignID).Return(&CampaignReport{
+		Campaign: Campaign{ID: testCampaignID},
+		Summary:  ReportSummary{Total: 2, Kept: 1, Revoked: 1},
+	}, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/access-reviews/"+testCampaignID+"/report", nil)
+	req.SetPathValue("id", testCampaignID)
+	rr := httptest.NewRecorder()
+	s.handler.HandleCampaignReportRequest(rr, req)
+
+	s.Equal(http.StatusOK, rr.Code)
+	var body CampaignReport
+	s.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &body))
+	s.Equal(ReportSummary{Total: 2, Kept: 1, Revoked: 1}, body.Summary)
+}
@@ -0,0 +1,126 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package accessreview
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/role"
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/distlock"
+	"github.com/thunder-id/thunderid/internal/system/middleware"
+	"github.com/thunder-id/thunderid/internal/system/observability"
+)
+
+// Initialize initializes the access review service, registers its routes and starts the scheduled
+// completion of overdue campaigns.
+func Initialize(
+	mux *http.ServeMux,
+	roleService role.RoleServiceInterface,
+	roleAssignmentService role.RoleAssignmentServiceInterface,
+	lockManager distlock.LockManagerInterface,
+	observabilitySvc observability.ObservabilityServiceInterface,
+) (AccessReviewServiceInterface, error) {
+	store, transactioner, err := newAccessReviewStore()
+	if err != nil {
+		return nil, err
+	}
+
+	accessReviewConfig := config.GetServerRuntime().Config.AccessReview
+	accessReviewService := newAccessReviewService(store, transactioner, roleService, roleAssignmentService,
+		observabilitySvc, accessReviewConfig.AutoRevoke,
+		time.Duration(accessReviewConfig.DefaultDuration)*time.Second)
+
+	accessReviewHandler := newAccessReviewHandler(accessReviewService)
+	registerRoutes(mux, accessReviewHandler)
+
+	startScheduledCompletion(accessReviewService, lockManager, observabilitySvc,
+		time.Duration(accessReviewConfig.ProcessInterval)*time.Second)
+
+	return accessReviewService, nil
+}
+
+// registerRoutes registers the routes for access review operations.
+func registerRoutes(mux *http.ServeMux, accessReviewHandler *accessReviewHandler) {
+	opts1 := middleware.CORSOptions{
+		AllowedMethods:   []string{"GET", "POST"},
+		AllowedHeaders:   middleware.DefaultAllowedHeaders,
+		AllowCredentials: true,
+		MaxAge:           600,
+	}
+	mux.HandleFunc(middleware.WithCORS("POST /access-reviews",
+		accessReviewHandler.HandleCampaignPostRequest, opts1))
+	mux.HandleFunc(middleware.WithCORS("GET /access-reviews",
+		accessReviewHandler.HandleCampaignListRequest, opts1))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS /access-reviews",
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, opts1))
+
+	opts2 := middleware.CORSOptions{
+		AllowedMethods:   []string{"GET"},
+		AllowedHeaders:   middleware.DefaultAllowedHeaders,
+		AllowCredentials: true,
+		MaxAge:           600,
+	}
+	mux.HandleFunc(middleware.WithCORS("GET /access-reviews/my-items",
+		accessReviewHandler.HandleAssignedReviewItemListRequest, opts2))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS /access-reviews/my-items",
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, opts2))
+	mux.HandleFunc(middleware.WithCORS("GET /access-reviews/{id}",
+		accessReviewHandler.HandleCampaignGetRequest, opts2))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS /access-reviews/{id}",
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, opts2))
+	mux.HandleFunc(middleware.WithCORS("GET /access-reviews/{id}/items",
+		accessReviewHandler.HandleReviewItemListRequest, opts2))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS /access-reviews/{id}/items",
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, opts2))
+	mux.HandleFunc(middleware.WithCORS("GET /access-reviews/{id}/report",
+		accessReviewHandler.HandleCampaignReportRequest, opts2))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS /access-reviews/{id}/report",
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, opts2))
+
+	opts3 := middleware.CORSOptions{
+		AllowedMethods:   []string{"POST"},
+		AllowedHeaders:   middleware.DefaultAllowedHeaders,
+		AllowCredentials: true,
+		MaxAge:           600,
+	}
+	mux.HandleFunc(middleware.WithCORS("POST /access-reviews/{id}/decisions",
+		accessReviewHandler.HandleDecisionsPostRequest, opts3))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS /access-reviews/{id}/decisions",
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, opts3))
+	mux.HandleFunc(middleware.WithCORS("POST /access-reviews/{id}/complete",
+		accessReviewHandler.HandleCampaignCompleteRequest, opts3))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS /access-reviews/{id}/complete",
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, opts3))
+}
@@ -0,0 +1,156 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package accessreview provides access review campaigns, in which reviewers attest whether the holders of
+// roles still need them. A campaign snapshots the assignments of the roles it targets when it is created
+// and assigns each of them to a reviewer, who records a keep or revoke decision. When the campaign is
+// completed, either on request or once its deadline passes, revoke decisions are applied and, when the
+// campaign auto revokes, assignments left unreviewed are revoked as well.
+package accessreview
+
+import (
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/role"
+)
+
+// CampaignStatus represents the state of an access review campaign.
+type CampaignStatus string
+
+const (
+	// CampaignStatusActive indicates the campaign is collecting review decisions.
+	CampaignStatusActive CampaignStatus = "ACTIVE"
+	// CampaignStatusCompleted indicates the decisions of the campaign have been applied.
+	CampaignStatusCompleted CampaignStatus = "COMPLETED"
+)
+
+// Decision represents the decision of a reviewer on a role assignment.
+type Decision string
+
+const (
+	// DecisionPending indicates the assignment has not been reviewed yet.
+	DecisionPending Decision = "PENDING"
+	// DecisionKeep indicates the reviewer attested that the assignment is still needed.
+	DecisionKeep Decision = "KEEP"
+	// DecisionRevoke indicates the reviewer decided that the assignment must be revoked.
+	DecisionRevoke Decision = "REVOKE"
+)
+
+// Outcome represents what happened to a role assignment when its campaign was completed.
+type Outcome string
+
+const (
+	// OutcomeKept indicates the assignment was kept as decided by the reviewer.
+	OutcomeKept Outcome = "KEPT"
+	// OutcomeRevoked indicates the assignment was revoked as decided by the reviewer.
+	OutcomeRevoked Outcome = "REVOKED"
+	// OutcomeAutoRevoked indicates the assignment was revoked because it was not reviewed before the deadline.
+	OutcomeAutoRevoked Outcome = "AUTO_REVOKED"
+	// OutcomeUnreviewed indicates the assignment was not reviewed and was kept since the campaign does not
+	// auto revoke.
+	OutcomeUnreviewed Outcome = "UNREVIEWED"
+	// OutcomeRevokeFailed indicates the assignment was to be revoked but could not be.
+	OutcomeRevokeFailed Outcome = "REVOKE_FAILED"
+)
+
+// Campaign represents an access review campaign.
+type Campaign struct {
+	ID          string         `json:"id"`
+	Name        string         `json:"name"`
+	Description string         `json:"description,omitempty"`
+	RoleIDs     []string       `json:"roleIds,omitempty"`
+	OUIDs       []string       `json:"ouIds,omitempty"`
+	Reviewers   []string       `json:"reviewers"`
+	AutoRevoke  bool           `json:"autoRevoke"`
+	Status      CampaignStatus `json:"status"`
+	CreatedBy   string         `json:"createdBy"`
+	CreatedAt   time.Time      `json:"createdAt"`
+	Deadline    time.Time      `json:"deadline"`
+	CompletedAt *time.Time     `json:"completedAt,omitempty"`
+}
+
+// ReviewItem represents a role assignment snapshotted by a campaign together with its review.
+type ReviewItem struct {
+	ID              string            `json:"id"`
+	CampaignID      string            `json:"campaignId"`
+	RoleID          string            `json:"roleId"`
+	RoleName        string            `json:"roleName"`
+	AssigneeID      string            `json:"assigneeId"`
+	AssigneeType    role.AssigneeType `json:"assigneeType"`
+	AssigneeDisplay string            `json:"assigneeDisplay,omitempty"`
+	Reviewer        string            `json:"reviewer"`
+	Decision        Decision          `json:"decision"`
+	Comment         string            `json:"comment,omitempty"`
+	DecidedBy       string            `json:"decidedBy,omitempty"`
+	DecidedAt       *time.Time        `json:"decidedAt,omitempty"`
+	Outcome         Outcome           `json:"outcome,omitempty"`
+}
+
+// CreateCampaignRequest represents the request to create an access review campaign. A campaign targets
+// the given roles and every role that belongs to the given organization units.
+type CreateCampaignRequest struct {
+	Name        string     `json:"name"`
+	Description string     `json:"description,omitempty"`
+	RoleIDs     []string   `json:"roleIds,omitempty"`
+	OUIDs       []string   `json:"ouIds,omitempty"`
+	Reviewers   []string   `json:"reviewers"`
+	Deadline    *time.Time `json:"deadline,omitempty"`
+	AutoRevoke  *bool      `json:"autoRevoke,omitempty"`
+}
+
+// DecisionRequest represents the decision of a reviewer on a review item.
+type DecisionRequest struct {
+	ItemID   string   `json:"itemId"`
+	Decision Decision `json:"decision"`
+	Comment  string   `json:"comment,omitempty"`
+}
+
+// DecisionsRequest represents the request to record review decisions.
+type DecisionsRequest struct {
+	Decisions []DecisionRequest `json:"decisions"`
+}
+
+// CampaignListResponse represents the response for listing access review campaigns.
+type CampaignListResponse struct {
+	TotalResults int        `json:"totalResults"`
+	Campaigns    []Campaign `json:"campaigns"`
+}
+
+// ReviewItemListResponse represents the response for listing review items.
+type ReviewItemListResponse struct {
+	TotalResults int          `json:"totalResults"`
+	Items        []ReviewItem `json:"items"`
+}
+
+// ReportSummary counts the review items of a campaign by their decision and outcome.
+type ReportSummary struct {
+	Total       int `json:"total"`
+	Pending     int `json:"pending"`
+	Kept        int `json:"kept"`
+	Revoked     int `json:"revoked"`
+	AutoRevoked int `json:"autoRevoked"`
+	Unreviewed  int `json:"unreviewed"`
+	Failed      int `json:"failed"`
+}
+
+// CampaignReport represents the report of an access review campaign.
+type CampaignReport struct {
+	Campaign Campaign      `json:"campaign"`
+	Summary  ReportSummary `json:"summary"`
+	Items    []ReviewItem  `json:"items"`
+}
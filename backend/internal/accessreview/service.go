@@ -0,0 +1,700 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package accessreview
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/role"
+	serverconst "github.com/thunder-id/thunderid/internal/system/constants"
+	sysContext "github.com/thunder-id/thunderid/internal/system/context"
+	"github.com/thunder-id/thunderid/internal/system/distlock"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/internal/system/observability"
+	"github.com/thunder-id/thunderid/internal/system/observability/event"
+	"github.com/thunder-id/thunderid/internal/system/security"
+	"github.com/thunder-id/thunderid/internal/system/transaction"
+	"github.com/thunder-id/thunderid/internal/system/utils"
+)
+
+// AccessReviewServiceInterface defines the interface for the access review service.
+type AccessReviewServiceInterface interface {
+	CreateCampaign(ctx context.Context, request CreateCampaignRequest) (*Campaign, *serviceerror.ServiceError)
+	GetCampaignList(ctx context.Context, status CampaignStatus) (*CampaignListResponse, *serviceerror.ServiceError)
+	GetCampaign(ctx context.Context, id string) (*Campaign, *serviceerror.ServiceError)
+	GetReviewItems(ctx context.Context, campaignID string) (*ReviewItemListResponse, *serviceerror.ServiceError)
+	GetAssignedReviewItems(ctx context.Context) (*ReviewItemListResponse, *serviceerror.ServiceError)
+	RecordDecisions(ctx context.Context, campaignID string,
+		decisions []DecisionRequest) (*ReviewItemListResponse, *serviceerror.ServiceError)
+	CompleteCampaign(ctx context.Context, id string) (*CampaignReport, *serviceerror.ServiceError)
+	GetCampaignReport(ctx context.Context, id string) (*CampaignReport, *serviceerror.ServiceError)
+	CompleteOverdueCampaigns(ctx context.Context) (int, error)
+}
+
+// accessReviewService is the default implementation of the AccessReviewServiceInterface.
+type accessReviewService struct {
+	store                 accessReviewStoreInterface
+	transactioner         transaction.Transactioner
+	roleService           role.RoleServiceInterface
+	roleAssignmentService role.RoleAssignmentServiceInterface
+	observabilitySvc      observability.ObservabilityServiceInterface
+	autoRevoke            bool
+	duration              time.Duration
+	now                   func() time.Time
+	logger                *log.Logger
+}
+
+// newAccessReviewService creates a new instance of accessReviewService. Campaigns created without a
+// deadline run for the given duration, and auto revoke unreviewed assignments unless stated otherwise
+// when autoRevoke is set.
+func newAccessReviewService(
+	store accessReviewStoreInterface,
+	transactioner transaction.Transactioner,
+	roleService role.RoleServiceInterface,
+	roleAssignmentService role.RoleAssignmentServiceInterface,
+	observabilitySvc observability.ObservabilityServiceInterface,
+	autoRevoke bool,
+	duration time.Duration,
+) AccessReviewServiceInterface {
+	if duration <= 0 {
+		duration = defaultDuration
+	}
+
+	return &accessReviewService{
+		store:                 store,
+		transactioner:         transactioner,
+		roleService:           roleService,
+		roleAssignmentService: roleAssignmentService,
+		observabilitySvc:      observabilitySvc,
+		autoRevoke:            autoRevoke,
+		duration:              duration,
+		now:                   time.Now,
+		logger:                log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName)),
+	}
+}
+
+// CreateCampaign creates a campaign that reviews the current assignments of the targeted roles. The
+// assignments are snapshotted at creation and spread across the reviewers, so that later changes to the
+// roles do not alter the scope of the campaign. No reviewer is asked to review their own assignment.
+func (s *accessReviewService) CreateCampaign(
+	ctx context.Context, request CreateCampaignRequest) (*Campaign, *serviceerror.ServiceError) {
+	creator := security.GetSubject(ctx)
+	if creator == "" {
+		return nil, &ErrorAuthenticationFailed
+	}
+
+	name := strings.TrimSpace(request.Name)
+	if name == "" {
+		return nil, &ErrorMissingCampaignName
+	}
+	roleIDs := normalizeList(request.RoleIDs)
+	ouIDs := normalizeList(request.OUIDs)
+	if len(roleIDs) == 0 && len(ouIDs) == 0 {
+		return nil, &ErrorMissingTargets
+	}
+	reviewers := normalizeList(request.Reviewers)
+	if len(reviewers) == 0 {
+		return nil, &ErrorMissingReviewers
+	}
+
+	createdAt := s.now().UTC()
+	deadline := createdAt.Add(s.duration)
+	if request.Deadline != nil {
+		if !request.Deadline.After(createdAt) {
+			return nil, &ErrorInvalidDeadline
+		}
+		deadline = request.Deadline.UTC()
+	}
+	autoRevoke := s.autoRevoke
+	if request.AutoRevoke != nil {
+		autoRevoke = *request.AutoRevoke
+	}
+
+	roles, svcErr := s.resolveTargetRoles(ctx, roleIDs, ouIDs)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+
+	id, err := utils.GenerateUUIDv7()
+	if err != nil {
+		s.logger.Error("Failed to generate ID for access review campaign", log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+	items, svcErr := s.snapshotAssignments(ctx, id, roles, reviewers)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+
+	campaign := Campaign{
+		ID:          id,
+		Name:        name,
+		Description: strings.TrimSpace(request.Description),
+		RoleIDs:     roleIDs,
+		OUIDs:       ouIDs,
+		Reviewers:   reviewers,
+		AutoRevoke:  autoRevoke,
+		Status:      CampaignStatusActive,
+		CreatedBy:   creator,
+		CreatedAt:   createdAt,
+		Deadline:    deadline,
+	}
+	if err := s.transactioner.Transact(ctx, func(txCtx context.Context) error {
+		if err := s.store.CreateCampaign(txCtx, campaign); err != nil {
+			return err
+		}
+		return s.store.CreateReviewItems(txCtx, items)
+	}); err != nil {
+		s.logger.Error("Failed to create access review campaign", log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+
+	s.publishCampaignEvent(ctx, event.EventTypeAccessReviewCreated, &campaign, 0)
+	return &campaign, nil
+}
+
+// GetCampaignList retrieves the campaigns, optionally filtered by their status.
+func (s *accessReviewService) GetCampaignList(ctx context.Context,
+	status CampaignStatus) (*CampaignListResponse, *serviceerror.ServiceError) {
+	if status != "" && status != CampaignStatusActive && status != CampaignStatusCompleted {
+		return nil, &ErrorInvalidStatusFilter
+	}
+
+	campaigns, err := s.store.GetCampaignList(ctx, status)
+	if err != nil {
+		s.logger.Error("Failed to get access review campaign list", log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+	return &CampaignListResponse{
+		TotalResults: len(campaigns),
+		Campaigns:    campaigns,
+	}, nil
+}
+
+// GetCampaign retrieves a campaign by its ID.
+func (s *accessReviewService) GetCampaign(ctx context.Context, id string) (*Campaign, *serviceerror.ServiceError) {
+	return s.getCampaign(ctx, id)
+}
+
+// GetReviewItems retrieves the review items of a campaign.
+func (s *accessReviewService) GetReviewItems(
+	ctx context.Context, campaignID string) (*ReviewItemListResponse, *serviceerror.ServiceError) {
+	if _, svcErr := s.getCampaign(ctx, campaignID); svcErr != nil {
+		return nil, svcErr
+	}
+
+	items, svcErr := s.getReviewItems(ctx, campaignID)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	return &ReviewItemListResponse{TotalResults: len(items), Items: items}, nil
+}
+
+// GetAssignedReviewItems retrieves the review items assigned to the caller in the active campaigns.
+func (s *accessReviewService) GetAssignedReviewItems(
+	ctx context.Context) (*ReviewItemListResponse, *serviceerror.ServiceError) {
+	reviewer := security.GetSubject(ctx)
+	if reviewer == "" {
+		return nil, &ErrorAuthenticationFailed
+	}
+
+	items, err := s.store.GetActiveReviewItemsByReviewer(ctx, reviewer)
+	if err != nil {
+		s.logger.Error("Failed to get assigned review items", log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+	return &ReviewItemListResponse{TotalResults: len(items), Items: items}, nil
+}
+
+// RecordDecisions records the decisions of the caller on review items of an active campaign. Only the
+// reviewer an item is assigned to can decide on it, and a decision can be revised until the campaign is
+// completed. The decisions are recorded together, so that none is recorded when any of them is invalid.
+func (s *accessReviewService) RecordDecisions(ctx context.Context, campaignID string,
+	decisions []DecisionRequest) (*ReviewItemListResponse, *serviceerror.ServiceError) {
+	reviewer := security.GetSubject(ctx)
+	if reviewer == "" {
+		return nil, &ErrorAuthenticationFailed
+	}
+	if len(decisions) == 0 {
+		return nil, &ErrorInvalidRequestFormat
+	}
+
+	campaign, svcErr := s.getCampaign(ctx, campaignID)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	decidedAt := s.now().UTC()
+	if campaign.Status != CampaignStatusActive || !decidedAt.Before(campaign.Deadline) {
+		return nil, &ErrorCampaignNotActive
+	}
+
+	items, svcErr := s.getReviewItems(ctx, campaignID)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	itemsByID := make(map[string]*ReviewItem, len(items))
+	for i := range items {
+		itemsByID[items[i].ID] = &items[i]
+	}
+
+	decided := make([]ReviewItem, 0, len(decisions))
+	for _, decision := range decisions {
+		item, ok := itemsByID[decision.ItemID]
+		if !ok {
+			return nil, &ErrorReviewItemNotFound
+		}
+		if decision.Decision != DecisionKeep && decision.Decision != DecisionRevoke {
+			return nil, &ErrorInvalidDecision
+		}
+		if item.Reviewer != reviewer {
+			return nil, &ErrorNotAssignedReviewer
+		}
+		item.Decision = decision.Decision
+		item.Comment = strings.TrimSpace(decision.Comment)
+		item.DecidedBy = reviewer
+		item.DecidedAt = &decidedAt
+		decided = append(decided, *item)
+	}
+
+	if err := s.transactioner.Transact(ctx, func(txCtx context.Context) error {
+		for _, item := range decided {
+			if err := s.store.UpdateReviewDecision(txCtx, item); err != nil {
+				return err
+			}
+		}
+		return nil
+	}); err != nil {
+		s.logger.Error("Failed to record review decisions", log.Error(err), log.String("campaignID", campaignID))
+		return nil, &serviceerror.InternalServerError
+	}
+
+	return &ReviewItemListResponse{TotalResults: len(decided), Items: decided}, nil
+}
+
+// CompleteCampaign completes an active campaign and applies its decisions. Assignments the reviewers
+// decided to revoke are removed from their roles; assignments left unreviewed are removed as well when
+// the campaign auto revokes. The campaign is marked completed before the decisions are applied, so that
+// they are applied once even when the campaign is completed concurrently.
+func (s *accessReviewService) CompleteCampaign(
+	ctx context.Context, id string) (*CampaignReport, *serviceerror.ServiceError) {
+	campaign, svcErr := s.getCampaign(ctx, id)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	if campaign.Status != CampaignStatusActive {
+		return nil, &ErrorCampaignNotActive
+	}
+	items, svcErr := s.getReviewItems(ctx, id)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+
+	completedAt := s.now().UTC()
+	if err := s.store.CompleteCampaign(ctx, id, completedAt); err != nil {
+		if errors.Is(err, ErrCampaignNotActive) {
+			return nil, &ErrorCampaignNotActive
+		}
+		s.logger.Error("Failed to complete access review campaign", log.Error(err), log.String("campaignID", id))
+		return nil, &serviceerror.InternalServerError
+	}
+	campaign.Status = CampaignStatusCompleted
+	campaign.CompletedAt = &completedAt
+
+	revoked := 0
+	for i := range items {
+		items[i].Outcome = s.applyDecision(ctx, campaign, &items[i])
+		if items[i].Outcome == OutcomeRevoked || items[i].Outcome == OutcomeAutoRevoked {
+			revoked++
+		}
+		if err := s.store.UpdateReviewOutcome(ctx, items[i].ID, items[i].Outcome, completedAt); err != nil {
+			s.logger.Error("Failed to record the outcome of a review item", log.Error(err),
+				log.String("campaignID", id), log.String("itemID", items[i].ID))
+		}
+	}
+
+	s.publishCampaignEvent(ctx, event.EventTypeAccessReviewCompleted, campaign, revoked)
+	return buildReport(campaign, items), nil
+}
+
+// GetCampaignReport retrieves the report of a campaign. The report of an active campaign reflects the
+// decisions recorded so far.
+func (s *accessReviewService) GetCampaignReport(
+	ctx context.Context, id string) (*CampaignReport, *serviceerror.ServiceError) {
+	campaign, svcErr := s.getCampaign(ctx, id)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	items, svcErr := s.getReviewItems(ctx, id)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	return buildReport(campaign, items), nil
+}
+
+// CompleteOverdueCampaigns completes the active campaigns whose deadline has passed and returns the
+// number of campaigns completed.
+func (s *accessReviewService) CompleteOverdueCampaigns(ctx context.Context) (int, error) {
+	campaigns, err := s.store.GetCampaignList(ctx, CampaignStatusActive)
+	if err != nil {
+		return 0, fmt.Errorf("failed to list active access review campaigns: %w", err)
+	}
+
+	now := s.now()
+	completed := 0
+	for _, campaign := range campaigns {
+		if now.Before(campaign.Deadline) {
+			continue
+		}
+		if _, svcErr := s.CompleteCampaign(ctx, campaign.ID); svcErr != nil {
+			if svcErr.Code == ErrorCampaignNotActive.Code {
+				continue
+			}
+			return completed, fmt.Errorf("failed to complete access review campaign %s: %s", campaign.ID,
+				svcErr.Code)
+		}
+		completed++
+	}
+	return completed, nil
+}
+
+// resolveTargetRoles returns the roles with the given IDs and the roles that belong to the given
+// organization units, each once.
+func (s *accessReviewService) resolveTargetRoles(
+	ctx context.Context, roleIDs, ouIDs []string) ([]role.Role, *serviceerror.ServiceError) {
+	roles := make([]role.Role, 0, len(roleIDs))
+	seen := make(map[string]bool, len(roleIDs))
+	for _, roleID := range roleIDs {
+		targetRole, svcErr := s.roleService.GetRoleWithPermissions(ctx, roleID)
+		if svcErr != nil {
+			if svcErr.Code == role.ErrorRoleNotFound.Code {
+				return nil, &ErrorTargetRoleNotFound
+			}
+			s.logger.Error("Failed to get targeted role", log.String("roleID", roleID),
+				log.Any("error", svcErr))
+			return nil, &serviceerror.InternalServerError
+		}
+		seen[targetRole.ID] = true
+		roles = append(roles, role.Role{ID: targetRole.ID, Name: targetRole.Name, OUID: targetRole.OUID})
+	}
+	if len(ouIDs) == 0 {
+		return roles, nil
+	}
+
+	allRoles, svcErr := utils.CollectAllPages(serverconst.MaxPageSize,
+		func(limit, offset int) ([]role.Role, int, *serviceerror.ServiceError) {
+			list, svcErr := s.roleService.GetRoleList(ctx, limit, offset)
+			if svcErr != nil {
+				return nil, 0, svcErr
+			}
+			return list.Roles, list.TotalResults, nil
+		})
+	if svcErr != nil {
+		s.logger.Error("Failed to list roles", log.Any("error", svcErr))
+		return nil, &serviceerror.InternalServerError
+	}
+	targetOUs := make(map[string]bool, len(ouIDs))
+	for _, ouID := range ouIDs {
+		targetOUs[ouID] = true
+	}
+	for _, candidate := range allRoles {
+		if targetOUs[candidate.OUID] && !seen[candidate.ID] {
+			seen[candidate.ID] = true
+			roles = append(roles, candidate)
+		}
+	}
+	return roles, nil
+}
+
+// snapshotAssignments builds the pending review items of the current assignments of the roles. The items
+// are assigned to the reviewers in turn, skipping a reviewer that holds the assignment under review.
+func (s *accessReviewService) snapshotAssignments(ctx context.Context, campaignID string, roles []role.Role,
+	reviewers []string) ([]ReviewItem, *serviceerror.ServiceError) {
+	items := make([]ReviewItem, 0)
+	for _, targetRole := range roles {
+		assignments, svcErr := utils.CollectAllPages(serverconst.MaxPageSize,
+			func(limit, offset int) ([]role.RoleAssignmentWithDisplay, int, *serviceerror.ServiceError) {
+				list, svcErr := s.roleAssignmentService.GetRoleAssignments(ctx, targetRole.ID, limit, offset, true)
+				if svcErr != nil {
+					return nil, 0, svcErr
+				}
+				return list.Assignments, list.TotalResults, nil
+			})
+		if svcErr != nil {
+			s.logger.Error("Failed to get role assignments", log.String("roleID", targetRole.ID),
+				log.Any("error", svcErr))
+			return nil, &serviceerror.InternalServerError
+		}
+
+		for _, assignment := range assignments {
+			reviewer := pickReviewer(reviewers, len(items), assignment.ID)
+			if reviewer == "" {
+				return nil, &ErrorNoEligibleReviewer
+			}
+			id, err := utils.GenerateUUIDv7()
+			if err != nil {
+				s.logger.Error("Failed to generate ID for review item", log.Error(err))
+				return nil, &serviceerror.InternalServerError
+			}
+			items = append(items, ReviewItem{
+				ID:              id,
+				CampaignID:      campaignID,
+				RoleID:          targetRole.ID,
+				RoleName:        targetRole.Name,
+				AssigneeID:      assignment.ID,
+				AssigneeType:    assignment.Type,
+				AssigneeDisplay: assignment.Display,
+				Reviewer:        reviewer,
+				Decision:        DecisionPending,
+			})
+		}
+	}
+	if len(items) == 0 {
+		return nil, &ErrorNoAssignmentsToReview
+	}
+	return items, nil
+}
+
+// applyDecision applies the decision on a review item of a completed campaign and returns its outcome.
+func (s *accessReviewService) applyDecision(ctx context.Context, campaign *Campaign, item *ReviewItem) Outcome {
+	switch {
+	case item.Decision == DecisionKeep:
+		return OutcomeKept
+	case item.Decision == DecisionRevoke:
+		return s.revokeAssignment(ctx, campaign, item, OutcomeRevoked)
+	case campaign.AutoRevoke:
+		return s.revokeAssignment(ctx, campaign, item, OutcomeAutoRevoked)
+	default:
+		return OutcomeUnreviewed
+	}
+}
+
+// revokeAssignment removes the assignment of a review item from its role and returns the outcome of the
+// revocation. An assignment that no longer exists counts as revoked.
+func (s *accessReviewService) revokeAssignment(
+	ctx context.Context, campaign *Campaign, item *ReviewItem, outcome Outcome) Outcome {
+	svcErr := s.roleAssignmentService.RemoveAssignments(ctx, item.RoleID,
+		[]role.RoleAssignment{{ID: item.AssigneeID, Type: item.AssigneeType}})
+	if svcErr != nil && svcErr.Code != role.ErrorRoleNotFound.Code &&
+		svcErr.Code != role.ErrorInvalidAssignmentID.Code {
+		s.logger.Error("Failed to revoke role assignment", log.String("campaignID", campaign.ID),
+			log.String("roleID", item.RoleID), log.MaskedString("assigneeID", item.AssigneeID),
+			log.Any("error", svcErr))
+		s.publishRevocationEvent(ctx, campaign, item, event.StatusFailure)
+		return OutcomeRevokeFailed
+	}
+
+	s.publishRevocationEvent(ctx, campaign, item, event.StatusSuccess)
+	return outcome
+}
+
+// getCampaign retrieves a campaign by its ID.
+func (s *accessReviewService) getCampaign(ctx context.Context, id string) (*Campaign, *serviceerror.ServiceError) {
+	if strings.TrimSpace(id) == "" {
+		return nil, &ErrorCampaignNotFound
+	}
+
+	campaign, err := s.store.GetCampaign(ctx, id)
+	if err != nil {
+		if errors.Is(err, ErrCampaignNotFound) {
+			return nil, &ErrorCampaignNotFound
+		}
+		s.logger.Error("Failed to get access review campaign", log.Error(err), log.String("campaignID", id))
+		return nil, &serviceerror.InternalServerError
+	}
+	return campaign, nil
+}
+
+// getReviewItems retrieves the review items of a campaign.
+func (s *accessReviewService) getReviewItems(
+	ctx context.Context, campaignID string) ([]ReviewItem, *serviceerror.ServiceError) {
+	items, err := s.store.GetReviewItems(ctx, campaignID)
+	if err != nil {
+		s.logger.Error("Failed to get review items", log.Error(err), log.String("campaignID", campaignID))
+		return nil, &serviceerror.InternalServerError
+	}
+	return items, nil
+}
+
+// publishCampaignEvent records a campaign event in the audit trail.
+func (s *accessReviewService) publishCampaignEvent(
+	ctx context.Context, eventType event.EventType, campaign *Campaign, revoked int) {
+	if s.observabilitySvc == nil || !s.observabilitySvc.IsEnabled() {
+		return
+	}
+
+	evt := event.NewEvent(sysContext.GetTraceID(ctx), string(eventType), event.ComponentAccessReview).
+		WithTenantID(sysContext.GetTenantID(ctx)).
+		WithStatus(event.StatusSuccess).
+		WithData(event.DataKey.CampaignID, campaign.ID)
+	if campaign.Status == CampaignStatusCompleted {
+		evt.WithData(event.DataKey.RevokedCount, revoked)
+	}
+	if actorID := security.GetSubject(ctx); actorID != "" {
+		evt.WithData(event.DataKey.ActorID, actorID)
+	}
+	s.observabilitySvc.PublishEvent(evt)
+}
+
+// publishRevocationEvent records the revocation of a role assignment in the audit trail.
+func (s *accessReviewService) publishRevocationEvent(
+	ctx context.Context, campaign *Campaign, item *ReviewItem, status string) {
+	if s.observabilitySvc == nil || !s.observabilitySvc.IsEnabled() {
+		return
+	}
+
+	evt := event.NewEvent(sysContext.GetTraceID(ctx), string(event.EventTypeAccessReviewAssignmentRevoked),
+		event.ComponentAccessReview).
+		WithTenantID(sysContext.GetTenantID(ctx)).
+		WithStatus(status).
+		WithData(event.DataKey.CampaignID, campaign.ID).
+		WithData(event.DataKey.ResourceID, item.RoleID).
+		WithData(event.DataKey.AssigneeID, item.AssigneeID)
+	if actorID := security.GetSubject(ctx); actorID != "" {
+		evt.WithData(event.DataKey.ActorID, actorID)
+	}
+	s.observabilitySvc.PublishEvent(evt)
+}
+
+// buildReport builds the report of a campaign from its review items. Items of an active campaign are
+// counted by their decision and items of a completed campaign by their outcome.
+func buildReport(campaign *Campaign, items []ReviewItem) *CampaignReport {
+	summary := ReportSummary{Total: len(items)}
+	for _, item := range items {
+		switch item.Outcome {
+		case OutcomeKept:
+			summary.Kept++
+		case OutcomeRevoked:
+			summary.Revoked++
+		case OutcomeAutoRevoked:
+			summary.AutoRevoked++
+		case OutcomeUnreviewed:
+			summary.Unreviewed++
+		case OutcomeRevokeFailed:
+			summary.Failed++
+		default:
+			switch item.Decision {
+			case DecisionKeep:
+				summary.Kept++
+			case DecisionRevoke:
+				summary.Revoked++
+			default:
+				summary.Pending++
+			}
+		}
+	}
+	return &CampaignReport{Campaign: *campaign, Summary: summary, Items: items}
+}
+
+// pickReviewer returns the reviewer of the index-th review item, taking the reviewers in turn and
+// skipping the assignee, or an empty string when the assignee is the only reviewer.
+func pickReviewer(reviewers []string, index int, assigneeID string) string {
+	for i := range reviewers {
+		reviewer := reviewers[(index+i)%len(reviewers)]
+		if reviewer != assigneeID {
+			return reviewer
+		}
+	}
+	return ""
+}
+
+// normalizeList trims the values of a list and drops empty and duplicate values.
+func normalizeList(values []string) []string {
+	normalized := make([]string, 0, len(values))
+	seen := make(map[string]bool, len(values))
+	for _, value := range values {
+		value = strings.TrimSpace(value)
+		if value == "" || seen[value] {
+			continue
+		}
+		seen[value] = true
+		normalized = append(normalized, value)
+	}
+	return normalized
+}
+
+// startScheduledCompletion completes the overdue campaigns at every interval. Each run holds a distributed
+// lock so that only one node of the deployment completes campaigns at a time.
+func startScheduledCompletion(
+	service AccessReviewServiceInterface, lockManager distlock.LockManagerInterface,
+	observabilitySvc observability.ObservabilityServiceInterface, interval time.Duration,
+) {
+	if interval <= 0 {
+		return
+	}
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for range ticker.C {
+			runScheduledCompletion(security.WithRuntimeContext(context.Background()), service, lockManager,
+				observabilitySvc)
+		}
+	}()
+}
+
+// runScheduledCompletion completes the overdue campaigns once and logs the outcome. A failed run is
+// published as a scheduled job failure. The run is skipped when another node holds the completion lock.
+func runScheduledCompletion(
+	ctx context.Context, service AccessReviewServiceInterface, lockManager distlock.LockManagerInterface,
+	observabilitySvc observability.ObservabilityServiceInterface,
+) {
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName))
+
+	ran, err := lockManager.TryWithLock(ctx, scheduledCompletionLockName,
+		func(ctx context.Context, _ int64) error {
+			completed, err := service.CompleteOverdueCampaigns(ctx)
+			if err != nil {
+				logger.Error("Scheduled completion of access review campaigns failed", log.Error(err))
+				publishJobFailedEvent(ctx, observabilitySvc, err)
+			}
+			if completed > 0 {
+				logger.Info("Completed overdue access review campaigns", log.Int("count", completed))
+			}
+			return nil
+		})
+	if err != nil {
+		logger.Error("Failed to run scheduled completion of access review campaigns under the lock",
+			log.Error(err))
+		return
+	}
+	if !ran {
+		logger.Debug("Skipped scheduled completion of access review campaigns as another node is running it")
+	}
+}
+
+// publishJobFailedEvent publishes the failure of the scheduled completion as an operational event.
+func publishJobFailedEvent(
+	ctx context.Context, observabilitySvc observability.ObservabilityServiceInterface, jobErr error) {
+	if observabilitySvc == nil || !observabilitySvc.IsEnabled() {
+		return
+	}
+
+	evt := event.NewEvent(sysContext.GetTraceID(ctx), string(event.EventTypeScheduledJobFailed),
+		event.ComponentAccessReview).
+		WithTenantID(sysContext.GetTenantID(ctx)).
+		WithStatus(event.StatusFailure).
+		WithData(event.DataKey.JobName, scheduledCompletionJobName).
+		WithData(event.DataKey.Error, jobErr.Error())
+	observabilitySvc.PublishEvent(evt)
+}
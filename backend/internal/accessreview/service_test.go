@@ -0,0 +1,500 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package accessreview
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/role"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/observability/event"
+	"github.com/thunder-id/thunderid/internal/system/security"
+	"github.com/thunder-id/thunderid/internal/system/transaction"
+	"github.com/thunder-id/thunderid/tests/mocks/distlockmock"
+	"github.com/thunder-id/thunderid/tests/mocks/observability/observabilitymock"
+	"github.com/thunder-id/thunderid/tests/mocks/rolemock"
+)
+
+const (
+	testCampaignID = "campaign-1"
+	testCreator    = "admin-1"
+	testReviewer1  = "reviewer-1"
+	testReviewer2  = "reviewer-2"
+	testRoleID     = "role-1"
+	testOUID       = "ou-1"
+)
+
+type AccessReviewServiceTestSuite struct {
+	suite.Suite
+	mockStore                 *accessReviewStoreInterfaceMock
+	mockRoleService           *rolemock.RoleServiceInterfaceMock
+	mockRoleAssignmentService *rolemock.RoleAssignmentServiceInterfaceMock
+	mockObservability         *observabilitymock.ObservabilityServiceInterfaceMock
+	service                   *accessReviewService
+	events                    []*event.Event
+	now                       time.Time
+}
+
+func TestAccessReviewServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(AccessReviewServiceTestSuite))
+}
+
+func (suite *AccessReviewServiceTestSuite) SetupTest() {
+	suite.mockStore = newAccessReviewStoreInterfaceMock(suite.T())
+	suite.mockRoleService = rolemock.NewRoleServiceInterfaceMock(suite.T())
+	suite.mockRoleAssignmentService = rolemock.NewRoleAssignmentServiceInterfaceMock(suite.T())
+	suite.mockObservability = observabilitymock.NewObservabilityServiceInterfaceMock(suite.T())
+	suite.service = newAccessReviewService(suite.mockStore, transaction.NewNoOpTransactioner(),
+		suite.mockRoleService, suite.mockRoleAssignmentService, suite.mockObservability, false,
+		24*time.Hour).(*accessReviewService)
+	suite.now = time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	suite.service.now = func() time.Time { return suite.now }
+
+	suite.events = nil
+	suite.mockObservability.On("IsEnabled").Return(true).Maybe()
+	suite.mockObservability.On("PublishEvent", mock.Anything).Run(func(args mock.Arguments) {
+		suite.events = append(suite.events, args.Get(0).(*event.Event))
+	}).Maybe()
+}
+
+func (suite *AccessReviewServiceTestSuite) contextFor(subject string) context.Context {
+	return security.WithSecurityContextTest(context.Background(),
+		security.NewSecurityContextForTest(subject, "", "", nil, nil))
+}
+
+func (suite *AccessReviewServiceTestSuite) activeCampaign(autoRevoke bool) *Campaign {
+	return &Campaign{
+		ID:         testCampaignID,
+		Name:       "Quarterly review",
+		RoleIDs:    []string{testRoleID},
+		Reviewers:  []string{testReviewer1, testReviewer2},
+		AutoRevoke: autoRevoke,
+		Status:     CampaignStatusActive,
+		CreatedBy:  testCreator,
+		CreatedAt:  suite.now.Add(-time.Hour),
+		Deadline:   suite.now.Add(time.Hour),
+	}
+}
+
+func (suite *AccessReviewServiceTestSuite) reviewItem(id, assigneeID, reviewer string, decision Decision) ReviewItem {
+	return ReviewItem{
+		ID:           id,
+		CampaignID:   testCampaignID,
+		RoleID:       testRoleID,
+		RoleName:     "auditors",
+		AssigneeID:   assigneeID,
+		AssigneeType: role.AssigneeTypeUser,
+		Reviewer:     reviewer,
+		Decision:     decision,
+	}
+}
+
+func (suite *AccessReviewServiceTestSuite) eventTypes() []string {
+	types := make([]string, 0, len(suite.events))
+	for _, evt := range suite.events {
+		types = append(types, evt.Type)
+	}
+	return types
+}
+
+func (suite *AccessReviewServiceTestSuite) TestCreateCampaign_SnapshotsAssignments() {
+	suite.mockRoleService.On("GetRoleWithPermissions", mock.Anything, testRoleID).
+		Return(&role.RoleWithPermissions{ID: testRoleID, Name: "auditors", OUID: "ou-2"}, nil).Once()
+	suite.mockRoleService.On("GetRoleList", mock.Anything, 100, 0).Return(&role.RoleList{
+		TotalResults: 3,
+		Roles: []role.Role{
+			{ID: testRoleID, Name: "auditors", OUID: testOUID},
+			{ID: "role-2", Name: "operators", OUID: testOUID},
+			{ID: "role-3", Name: "others", OUID: "ou-3"},
+		},
+	}, nil).Once()
+	suite.mockRoleAssignmentService.On("GetRoleAssignments", mock.Anything, testRoleID, 100, 0, true).
+		Return(&role.AssignmentList{TotalResults: 1, Assignments: []role.RoleAssignmentWithDisplay{
+			{ID: "user-1", Type: role.AssigneeTypeUser, Display: "alice"},
+		}}, nil).Once()
+	suite.mockRoleAssignmentService.On("GetRoleAssignments", mock.Anything, "role-2", 100, 0, true).
+		Return(&role.AssignmentList{TotalResults: 1, Assignments: []role.RoleAssignmentWithDisplay{
+			{ID: testReviewer2, Type: role.AssigneeTypeUser},
+		}}, nil).Once()
+	suite.mockStore.On("CreateCampaign", mock.Anything, mock.MatchedBy(func(c Campaign) bool {
+		return c.Status == CampaignStatusActive && c.CreatedBy == testCreator && !c.AutoRevoke &&
+			c.Deadline.Equal(suite.now.Add(24*time.Hour)) && len(c.Reviewers) == 2
+	})).Return(nil).Once()
+	suite.mockStore.On("CreateReviewItems", mock.Anything, mock.MatchedBy(func(items []ReviewItem) bool {
+		return len(items) == 2 &&
+			items[0].RoleID == testRoleID && items[0].AssigneeDisplay == "alice" &&
+			items[0].Reviewer == testReviewer1 && items[0].Decision == DecisionPending &&
+			items[1].RoleID == "role-2" && items[1].Reviewer == testReviewer1
+	})).Return(nil).Once()
+
+	campaign, svcErr := suite.service.CreateCampaign(suite.contextFor(testCreator), CreateCampaignRequest{
+		Name:      " Quarterly review ",
+		RoleIDs:   []string{testRoleID},
+		OUIDs:     []string{testOUID},
+		Reviewers: []string{testReviewer1, testReviewer2, " " + testReviewer1 + " "},
+	})
+
+	suite.Nil(svcErr)
+	suite.Equal("Quarterly review", campaign.Name)
+	suite.Equal([]string{testReviewer1, testReviewer2}, campaign.Reviewers)
+	suite.Equal([]string{string(event.EventTypeAccessReviewCreated)}, suite.eventTypes())
+}
+
+func (suite *AccessReviewServiceTestSuite) TestCreateCampaign_ValidationErrors() {
+	past := suite.now.Add(-time.Minute)
+	testCases := []struct {
+		name    string
+		ctx     context.Context
+		request CreateCampaignRequest
+		want    serviceerror.ServiceError
+	}{
+		{
+			name:    "Unauthenticated",
+			ctx:     context.Background(),
+			request: CreateCampaignRequest{Name: "review"},
+			want:    ErrorAuthenticationFailed,
+		},
+		{
+			name:    "MissingName",
+			ctx:     suite.contextFor(testCreator),
+			request: CreateCampaignRequest{Name: " ", RoleIDs: []string{testRoleID}},
+			want:    ErrorMissingCampaignName,
+		},
+		{
+			name:    "MissingTargets",
+			ctx:     suite.contextFor(testCreator),
+			request: CreateCampaignRequest{Name: "review", Reviewers: []string{testReviewer1}},
+			want:    ErrorMissingTargets,
+		},
+		{
+			name:    "MissingReviewers",
+			ctx:     suite.contextFor(testCreator),
+			request: CreateCampaignRequest{Name: "review", RoleIDs: []string{testRoleID}, Reviewers: []string{" "}},
+			want:    ErrorMissingReviewers,
+		},
+		{
+			name: "DeadlineInPast",
+			ctx:  suite.contextFor(testCreator),
+			request: CreateCampaignRequest{Name: "review", RoleIDs: []string{testRoleID},
+				Reviewers: []string{testReviewer1}, Deadline: &past},
+			want: ErrorInvalidDeadline,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			campaign, svcErr := suite.service.CreateCampaign(tc.ctx, tc.request)
+
+			suite.Nil(campaign)
+			suite.Equal(tc.want.Code, svcErr.Code)
+		})
+	}
+}
+
+func (suite *AccessReviewServiceTestSuite) TestCreateCampaign_RoleNotFound() {
+	suite.mockRoleService.On("GetRoleWithPermissions", mock.Anything, testRoleID).
+		Return(nil, &role.ErrorRoleNotFound).Once()
+
+	campaign, svcErr := suite.service.CreateCampaign(suite.contextFor(testCreator), CreateCampaignRequest{
+		Name: "review", RoleIDs: []string{testRoleID}, Reviewers: []string{testReviewer1},
+	})
+
+	suite.Nil(campaign)
+	suite.Equal(ErrorTargetRoleNotFound.Code, svcErr.Code)
+}
+
+func (suite *AccessReviewServiceTestSuite) TestCreateCampaign_NoAssignments() {
+	suite.mockRoleService.On("GetRoleWithPermissions", mock.Anything, testRoleID).
+		Return(&role.RoleWithPermissions{ID: testRoleID, Name: "auditors"}, nil).Once()
+	suite.mockRoleAssignmentService.On("GetRoleAssignments", mock.Anything, testRoleID, 100, 0, true).
+		Return(&role.AssignmentList{}, nil).Once()
+
+	campaign, svcErr := suite.service.CreateCampaign(suite.contextFor(testCreator), CreateCampaignRequest{
+		Name: "review", RoleIDs: []string{testRoleID}, Reviewers: []string{testReviewer1},
+	})
+
+	suite.Nil(campaign)
+	suite.Equal(ErrorNoAssignmentsToReview.Code, svcErr.Code)
+}
+
+func (suite *AccessReviewServiceTestSuite) TestCreateCampaign_NoEligibleReviewer() {
+	suite.mockRoleService.On("GetRoleWithPermissions", mock.Anything, testRoleID).
+		Return(&role.RoleWithPermissions{ID: testRoleID, Name: "auditors"}, nil).Once()
+	suite.mockRoleAssignmentService.On("GetRoleAssignments", mock.Anything, testRoleID, 100, 0, true).
+		Return(&role.AssignmentList{TotalResults: 1, Assignments: []role.RoleAssignmentWithDisplay{
+			{ID: testReviewer1, Type: role.AssigneeTypeUser},
+		}}, nil).Once()
+
+	campaign, svcErr := suite.service.CreateCampaign(suite.contextFor(testCreator), CreateCampaignRequest{
+		Name: "review", RoleIDs: []string{testRoleID}, Reviewers: []string{testReviewer1},
+	})
+
+	suite.Nil(campaign)
+	suite.Equal(ErrorNoEligibleReviewer.Code, svcErr.Code)
+}
+
+func (suite *AccessReviewServiceTestSuite) TestGetCampaignList_InvalidStatus() {
+	list, svcErr := suite.service.GetCampaignList(context.Background(), "UNKNOWN")
+
+	suite.Nil(list)
+	suite.Equal(ErrorInvalidStatusFilter.Code, svcErr.Code)
+}
+
+func (suite *AccessReviewServiceTestSuite) TestGetCampaign_NotFound() {
+	suite.mockStore.On("GetCampaign", mock.Anything, testCampaignID).Return(nil, ErrCampaignNotFound).Once()
+
+	campaign, svcErr := suite.service.GetCampaign(context.Background(), testCampaignID)
+
+	suite.Nil(campaign)
+	suite.Equal(ErrorCampaignNotFound.Code, svcErr.Code)
+}
+
+func (suite *AccessReviewServiceTestSuite) TestGetAssignedReviewItems() {
+	suite.mockStore.On("GetActiveReviewItemsByReviewer", mock.Anything, testReviewer1).
+		Return([]ReviewItem{suite.reviewItem("item-1", "user-1", testReviewer1, DecisionPending)}, nil).Once()
+
+	list, svcErr := suite.service.GetAssignedReviewItems(suite.contextFor(testReviewer1))
+
+	suite.Nil(svcErr)
+	suite.Equal(1, list.TotalResults)
+}
+
+func (suite *AccessReviewServiceTestSuite) TestRecordDecisions_Success() {
+	suite.mockStore.On("GetCampaign", mock.Anything, testCampaignID).Return(suite.activeCampaign(false), nil).Once()
+	suite.mockStore.On("GetReviewItems", mock.Anything, testCampaignID).Return([]ReviewItem{
+		suite.reviewItem("item-1", "user-1", testReviewer1, DecisionPending),
+		suite.reviewItem("item-2", "user-2", testReviewer1, DecisionKeep),
+	}, nil).Once()
+	suite.mockStore.On("UpdateReviewDecision", mock.Anything, mock.MatchedBy(func(item ReviewItem) bool {
+		return item.ID == "item-1" && item.Decision == DecisionRevoke && item.Comment == "left the team" &&
+			item.DecidedBy == testReviewer1 && item.DecidedAt.Equal(suite.now)
+	})).Return(nil).Once()
+	suite.mockStore.On("UpdateReviewDecision", mock.Anything, mock.MatchedBy(func(item ReviewItem) bool {
+		return item.ID == "item-2" && item.Decision == DecisionRevoke
+	})).Return(nil).Once()
+
+	list, svcErr := suite.service.RecordDecisions(suite.contextFor(testReviewer1), testCampaignID, []DecisionRequest{
+		{ItemID: "item-1", Decision: DecisionRevoke, Comment: " left the team "},
+		{ItemID: "item-2", Decision: DecisionRevoke},
+	})
+
+	suite.Nil(svcErr)
+	suite.Equal(2, list.TotalResults)
+}
+
+func (suite *AccessReviewServiceTestSuite) TestRecordDecisions_Rejected() {
+	testCases := []struct {
+		name     string
+		reviewer string
+		decision DecisionRequest
+		want     serviceerror.ServiceError
+	}{
+		{"UnknownItem", testReviewer1, DecisionRequest{ItemID: "item-9", Decision: DecisionKeep},
+			ErrorReviewItemNotFound},
+		{"InvalidDecision", testReviewer1, DecisionRequest{ItemID: "item-1", Decision: DecisionPending},
+			ErrorInvalidDecision},
+		{"NotAssignedReviewer", testReviewer2, DecisionRequest{ItemID: "item-1", Decision: DecisionKeep},
+			ErrorNotAssignedReviewer},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.mockStore.On("GetCampaign", mock.Anything, testCampaignID).
+				Return(suite.activeCampaign(false), nil).Once()
+			suite.mockStore.On("GetReviewItems", mock.Anything, testCampaignID).Return([]ReviewItem{
+				suite.reviewItem("item-1", "user-1", testReviewer1, DecisionPending),
+			}, nil).Once()
+
+			list, svcErr := suite.service.RecordDecisions(suite.contextFor(tc.reviewer), testCampaignID,
+				[]DecisionRequest{tc.decision})
+
+			suite.Nil(list)
+			suite.Equal(tc.want.Code, svcErr.Code)
+		})
+	}
+	suite.mockStore.AssertNotCalled(suite.T(), "UpdateReviewDecision", mock.Anything, mock.Anything)
+}
+
+func (suite *AccessReviewServiceTestSuite) TestRecordDecisions_DeadlinePassed() {
+	campaign := suite.activeCampaign(false)
+	campaign.Deadline = suite.now
+	suite.mockStore.On("GetCampaign", mock.Anything, testCampaignID).Return(campaign, nil).Once()
+
+	list, svcErr := suite.service.RecordDecisions(suite.contextFor(testReviewer1), testCampaignID,
+		[]DecisionRequest{{ItemID: "item-1", Decision: DecisionKeep}})
+
+	suite.Nil(list)
+	suite.Equal(ErrorCampaignNotActive.Code, svcErr.Code)
+}
+
+func (suite *AccessReviewServiceTestSuite) TestCompleteCampaign_AppliesDecisions() {
+	suite.mockStore.On("GetCampaign", mock.Anything, testCampaignID).Return(suite.activeCampaign(true), nil).Once()
+	suite.mockStore.On("GetReviewItems", mock.Anything, testCampaignID).Return([]ReviewItem{
+		suite.reviewItem("item-1", "user-1", testReviewer1, DecisionKeep),
+		suite.reviewItem("item-2", "user-2", testReviewer1, DecisionRevoke),
+		suite.reviewItem("item-3", "user-3", testReviewer2, DecisionPending),
+		suite.reviewItem("item-4", "user-4", testReviewer2, DecisionRevoke),
+	}, nil).Once()
+	suite.mockStore.On("CompleteCampaign", mock.Anything, testCampaignID, suite.now).Return(nil).Once()
+	suite.mockRoleAssignmentService.On("RemoveAssignments", mock.Anything, testRoleID,
+		[]role.RoleAssignment{{ID: "user-2", Type: role.AssigneeTypeUser}}).Return(nil).Once()
+	suite.mockRoleAssignmentService.On("RemoveAssignments", mock.Anything, testRoleID,
+		[]role.RoleAssignment{{ID: "user-3", Type: role.AssigneeTypeUser}}).Return(&role.ErrorInvalidAssignmentID).Once()
+	suite.mockRoleAssignmentService.On("RemoveAssignments", mock.Anything, testRoleID,
+		[]role.RoleAssignment{{ID: "user-4", Type: role.AssigneeTypeUser}}).
+		Return(&serviceerror.InternalServerError).Once()
+	suite.mockStore.On("UpdateReviewOutcome", mock.Anything, "item-1", OutcomeKept, suite.now).Return(nil).Once()
+	suite.mockStore.On("UpdateReviewOutcome", mock.Anything, "item-2", OutcomeRevoked, suite.now).Return(nil).Once()
+	suite.mockStore.On("UpdateReviewOutcome", mock.Anything, "item-3", OutcomeAutoRevoked, suite.now).
+		Return(nil).Once()
+	suite.mockStore.On("UpdateReviewOutcome", mock.Anything, "item-4", OutcomeRevokeFailed, suite.now).
+		Return(nil).Once()
+
+	report, svcErr := suite.service.CompleteCampaign(suite.contextFor(testCreator), testCampaignID)
+
+	suite.Nil(svcErr)
+	suite.Equal(CampaignStatusCompleted, report.Campaign.Status)
+	suite.Equal(ReportSummary{Total: 4, Kept: 1, Revoked: 1, AutoRevoked: 1, Failed: 1}, report.Summary)
+	suite.Equal([]string{
+		string(event.EventTypeAccessReviewAssignmentRevoked),
+		string(event.EventTypeAccessReviewAssignmentRevoked),
+		string(event.EventTypeAccessReviewAssignmentRevoked),
+		string(event.EventTypeAccessReviewCompleted),
+	}, suite.eventTypes())
+	suite.Equal(event.StatusFailure, suite.events[2].Status)
+	suite.Equal(2, suite.events[3].Data[event.DataKey.RevokedCount])
+}
+
+func (suite *AccessReviewServiceTestSuite) TestCompleteCampaign_KeepsUnreviewedWithoutAutoRevoke() {
+	suite.mockStore.On("GetCampaign", mock.Anything, testCampaignID).Return(suite.activeCampaign(false), nil).Once()
+	suite.mockStore.On("GetReviewItems", mock.Anything, testCampaignID).Return([]ReviewItem{
+		suite.reviewItem("item-1", "user-1", testReviewer1, DecisionPending),
+	}, nil).Once()
+	suite.mockStore.On("CompleteCampaign", mock.Anything, testCampaignID, suite.now).Return(nil).Once()
+	suite.mockStore.On("UpdateReviewOutcome", mock.Anything, "item-1", OutcomeUnreviewed, suite.now).
+		Return(nil).Once()
+
+	report, svcErr := suite.service.CompleteCampaign(suite.contextFor(testCreator), testCampaignID)
+
+	suite.Nil(svcErr)
+	suite.Equal(ReportSummary{Total: 1, Unreviewed: 1}, report.Summary)
+	suite.mockRoleAssignmentService.AssertNotCalled(suite.T(), "RemoveAssignments", mock.Anything,
+		mock.Anything, mock.Anything)
+}
+
+func (suite *AccessReviewServiceTestSuite) TestCompleteCampaign_CompletedConcurrently() {
+	suite.mockStore.On("GetCampaign", mock.Anything, testCampaignID).Return(suite.activeCampaign(true), nil).Once()
+	suite.mockStore.On("GetReviewItems", mock.Anything, testCampaignID).Return([]ReviewItem{
+		suite.reviewItem("item-1", "user-1", testReviewer1, DecisionRevoke),
+	}, nil).Once()
+	suite.mockStore.On("CompleteCampaign", mock.Anything, testCampaignID, suite.now).
+		Return(ErrCampaignNotActive).Once()
+
+	report, svcErr := suite.service.CompleteCampaign(suite.contextFor(testCreator), testCampaignID)
+
+	suite.Nil(report)
+	suite.Equal(ErrorCampaignNotActive.Code, svcErr.Code)
+	suite.mockRoleAssignmentService.AssertNotCalled(suite.T(), "RemoveAssignments", mock.Anything,
+		mock.Anything, mock.Anything)
+}
+
+func (suite *AccessReviewServiceTestSuite) TestGetCampaignReport_CountsDecisionsOfActiveCampaign() {
+	suite.mockStore.On("GetCampaign", mock.Anything, testCampaignID).Return(suite.activeCampaign(false), nil).Once()
+	suite.mockStore.On("GetReviewItems", mock.Anything, testCampaignID).Return([]ReviewItem{
+		suite.reviewItem("item-1", "user-1", testReviewer1, DecisionKeep),
+		suite.reviewItem("item-2", "user-2", testReviewer1, DecisionPending),
+	}, nil).Once()
+
+	report, svcErr := suite.service.GetCampaignReport(context.Background(), testCampaignID)
+
+	suite.Nil(svcErr)
+	suite.Equal(ReportSummary{Total: 2, Kept: 1, Pending: 1}, report.Summary)
+}
+
+func (suite *AccessReviewServiceTestSuite) TestCompleteOverdueCampaigns() {
+	overdue := suite.activeCampaign(false)
+	overdue.Deadline = suite.now.Add(-time.Minute)
+	running := suite.activeCampaign(false)
+	running.ID = "campaign-2"
+	suite.mockStore.On("GetCampaignList", mock.Anything, CampaignStatusActive).
+		Return([]Campaign{*overdue, *running}, nil).Once()
+	suite.mockStore.On("GetCampaign", mock.Anything, testCampaignID).Return(overdue, nil).Once()
+	suite.mockStore.On("GetReviewItems", mock.Anything, testCampaignID).Return([]ReviewItem{}, nil).Once()
+	suite.mockStore.On("CompleteCampaign", mock.Anything, testCampaignID, suite.now).Return(nil).Once()
+
+	completed, err := suite.service.CompleteOverdueCampaigns(context.Background())
+
+	suite.NoError(err)
+	suite.Equal(1, completed)
+}
+
+func (suite *AccessReviewServiceTestSuite) TestCompleteOverdueCampaigns_ListError() {
+	suite.mockStore.On("GetCampaignList", mock.Anything, CampaignStatusActive).
+		Return(nil, errors.New("db down")).Once()
+
+	completed, err := suite.service.CompleteOverdueCampaigns(context.Background())
+
+	suite.Error(err)
+	suite.Zero(completed)
+}
+
+func (suite *AccessReviewServiceTestSuite) TestRunScheduledCompletion() {
+	mockService := NewAccessReviewServiceInterfaceMock(suite.T())
+	mockService.On("CompleteOverdueCampaigns", mock.Anything).Return(1, nil).Once()
+	mockLockManager := distlockmock.NewLockManagerInterfaceMock(suite.T())
+	mockLockManager.EXPECT().TryWithLock(mock.Anything, scheduledCompletionLockName, mock.Anything).
+		RunAndReturn(func(ctx context.Context, _ string, fn func(context.Context, int64) error) (bool, error) {
+			return true, fn(ctx, 1)
+		}).Once()
+
+	runScheduledCompletion(context.Background(), mockService, mockLockManager, suite.mockObservability)
+}
+
+func (suite *AccessReviewServiceTestSuite) TestRunScheduledCompletion_SkippedWhenLockHeld() {
+	mockService := NewAccessReviewServiceInterfaceMock(suite.T())
+	mockLockManager := distlockmock.NewLockManagerInterfaceMock(suite.T())
+	mockLockManager.On("TryWithLock", mock.Anything, scheduledCompletionLockName, mock.Anything).
+		Return(false, nil).Once()
+
+	runScheduledCompletion(context.Background(), mockService, mockLockManager, suite.mockObservability)
+
+	mockService.AssertNotCalled(suite.T(), "CompleteOverdueCampaigns", mock.Anything)
+}
+
+func (suite *AccessReviewServiceTestSuite) TestRunScheduledCompletion_PublishesJobFailure() {
+	mockService := NewAccessReviewServiceInterfaceMock(suite.T())
+	mockService.On("CompleteOverdueCampaigns", mock.Anything).Return(0, errors.New("db down")).Once()
+	mockLockManager := distlockmock.NewLockManagerInterfaceMock(suite.T())
+	mockLockManager.EXPECT().TryWithLock(mock.Anything, scheduledCompletionLockName, mock.Anything).
+		RunAndReturn(func(ctx context.Context, _ string, fn func(context.Context, int64) error) (bool, error) {
+			return true, fn(ctx, 1)
+		}).Once()
+
+	runScheduledCompletion(context.Background(), mockService, mockLockManager, suite.mockObservability)
+
+	suite.Equal([]string{string(event.EventTypeScheduledJobFailed)}, suite.eventTypes())
+	suite.Equal(scheduledCompletionJobName, suite.events[0].Data[event.DataKey.JobName])
+	suite.Equal("db down", suite.events[0].Data[event.DataKey.Error])
+}
@@ -0,0 +1,390 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package accessreview
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/role"
+	"github.com/thunder-id/thunderid/internal/system/config"
+	sysContext "github.com/thunder-id/thunderid/internal/system/context"
+	"github.com/thunder-id/thunderid/internal/system/database/provider"
+	dbutils "github.com/thunder-id/thunderid/internal/system/database/utils"
+	"github.com/thunder-id/thunderid/internal/system/transaction"
+	"github.com/thunder-id/thunderid/internal/system/utils"
+)
+
+var getDBProvider = provider.GetDBProvider
+
+// campaignTargets is the stored form of the roles and organization units a campaign targets.
+type campaignTargets struct {
+	RoleIDs []string `json:"roleIds,omitempty"`
+	OUIDs   []string `json:"ouIds,omitempty"`
+}
+
+// accessReviewStoreInterface defines the interface for access review store operations.
+type accessReviewStoreInterface interface {
+	CreateCampaign(ctx context.Context, campaign Campaign) error
+	CreateReviewItems(ctx context.Context, items []ReviewItem) error
+	GetCampaignList(ctx context.Context, status CampaignStatus) ([]Campaign, error)
+	GetCampaign(ctx context.Context, id string) (*Campaign, error)
+	GetReviewItems(ctx context.Context, campaignID string) ([]ReviewItem, error)
+	GetActiveReviewItemsByReviewer(ctx context.Context, reviewer string) ([]ReviewItem, error)
+	UpdateReviewDecision(ctx context.Context, item ReviewItem) error
+	UpdateReviewOutcome(ctx context.Context, itemID string, outcome Outcome, updatedAt time.Time) error
+	CompleteCampaign(ctx context.Context, id string, completedAt time.Time) error
+}
+
+// accessReviewStore is the default implementation of accessReviewStoreInterface.
+type accessReviewStore struct {
+	dbProvider   provider.DBProviderInterface
+	deploymentID string
+}
+
+// newAccessReviewStore creates a new instance of accessReviewStore along with the transactioner of the
+// configuration database.
+func newAccessReviewStore() (accessReviewStoreInterface, transaction.Transactioner, error) {
+	dbProvider := getDBProvider()
+	client, err := dbProvider.GetConfigDBClient()
+	if err != nil {
+		return nil, nil, err
+	}
+	transactioner, err := client.GetTransactioner()
+	if err != nil {
+		return nil, nil, err
+	}
+	return &accessReviewStore{
+		dbProvider:   dbProvider,
+		deploymentID: config.GetServerRuntime().Config.Server.Identifier,
+	}, transactioner, nil
+}
+
+// CreateCampaign persists a new campaign.
+func (s *accessReviewStore) CreateCampaign(ctx context.Context, campaign Campaign) error {
+	dbClient, err := s.dbProvider.GetConfigDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	targets, err := json.Marshal(campaignTargets{RoleIDs: campaign.RoleIDs, OUIDs: campaign.OUIDs})
+	if err != nil {
+		return fmt.Errorf("failed to marshal campaign targets: %w", err)
+	}
+	reviewers, err := json.Marshal(campaign.Reviewers)
+	if err != nil {
+		return fmt.Errorf("failed to marshal campaign reviewers: %w", err)
+	}
+
+	if _, err := dbClient.ExecuteContext(ctx, queryCreateCampaign, campaign.ID, campaign.Name,
+		toNullableString(campaign.Description), string(targets), string(reviewers),
+		utils.BoolToNumString(campaign.AutoRevoke), string(campaign.Status), campaign.CreatedBy,
+		campaign.CreatedAt, campaign.Deadline, toNullableTime(campaign.CompletedAt),
+		sysContext.ScopeDeploymentID(ctx, s.deploymentID)); err != nil {
+		return fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	return nil
+}
+
+// CreateReviewItems persists the review items of a campaign.
+func (s *accessReviewStore) CreateReviewItems(ctx context.Context, items []ReviewItem) error {
+	dbClient, err := s.dbProvider.GetConfigDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
+	for _, item := range items {
+		if _, err := dbClient.ExecuteContext(ctx, queryCreateReviewItem, item.ID, item.CampaignID, item.RoleID,
+			item.RoleName, item.AssigneeID, string(item.AssigneeType), toNullableString(item.AssigneeDisplay),
+			item.Reviewer, string(item.Decision), toNullableString(item.Comment), toNullableString(item.DecidedBy),
+			toNullableTime(item.DecidedAt), toNullableString(string(item.Outcome)), deploymentID); err != nil {
+			return fmt.Errorf("failed to execute query: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// GetCampaignList retrieves the campaigns in the given status, or all campaigns when the status is empty,
+// latest first.
+func (s *accessReviewStore) GetCampaignList(ctx context.Context, status CampaignStatus) ([]Campaign, error) {
+	dbClient, err := s.dbProvider.GetConfigDBClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
+	var results []map[string]interface{}
+	if status == "" {
+		results, err = dbClient.QueryContext(ctx, queryGetCampaignList, deploymentID)
+	} else {
+		results, err = dbClient.QueryContext(ctx, queryGetCampaignListByStatus, string(status), deploymentID)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	campaigns := make([]Campaign, 0, len(results))
+	for _, row := range results {
+		campaign, err := buildCampaignFromResultRow(row)
+		if err != nil {
+			return nil, fmt.Errorf("failed to build campaign from result row: %w", err)
+		}
+		campaigns = append(campaigns, *campaign)
+	}
+
+	return campaigns, nil
+}
+
+// GetCampaign retrieves a campaign by its ID.
+func (s *accessReviewStore) GetCampaign(ctx context.Context, id string) (*Campaign, error) {
+	dbClient, err := s.dbProvider.GetConfigDBClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	results, err := dbClient.QueryContext(ctx, queryGetCampaignByID, id,
+		sysContext.ScopeDeploymentID(ctx, s.deploymentID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+	if len(results) == 0 {
+		return nil, ErrCampaignNotFound
+	}
+	if len(results) != 1 {
+		return nil, fmt.Errorf("unexpected number of results: %d", len(results))
+	}
+
+	return buildCampaignFromResultRow(results[0])
+}
+
+// GetReviewItems retrieves the review items of a campaign.
+func (s *accessReviewStore) GetReviewItems(ctx context.Context, campaignID string) ([]ReviewItem, error) {
+	dbClient, err := s.dbProvider.GetConfigDBClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	results, err := dbClient.QueryContext(ctx, queryGetReviewItems, campaignID,
+		sysContext.ScopeDeploymentID(ctx, s.deploymentID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	return buildReviewItemsFromResultRows(results)
+}
+
+// GetActiveReviewItemsByReviewer retrieves the review items assigned to a reviewer in the active campaigns.
+func (s *accessReviewStore) GetActiveReviewItemsByReviewer(ctx context.Context, reviewer string) (
+	[]ReviewItem, error) {
+	dbClient, err := s.dbProvider.GetConfigDBClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	results, err := dbClient.QueryContext(ctx, queryGetActiveReviewItemsByReviewer, reviewer,
+		sysContext.ScopeDeploymentID(ctx, s.deploymentID), string(CampaignStatusActive))
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	return buildReviewItemsFromResultRows(results)
+}
+
+// UpdateReviewDecision records the decision, the comment, the decider and the decision time of a review item.
+func (s *accessReviewStore) UpdateReviewDecision(ctx context.Context, item ReviewItem) error {
+	dbClient, err := s.dbProvider.GetConfigDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	if _, err := dbClient.ExecuteContext(ctx, queryUpdateReviewDecision, item.ID, string(item.Decision),
+		toNullableString(item.Comment), toNullableString(item.DecidedBy), toNullableTime(item.DecidedAt),
+		sysContext.ScopeDeploymentID(ctx, s.deploymentID)); err != nil {
+		return fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	return nil
+}
+
+// UpdateReviewOutcome records the outcome of a review item.
+func (s *accessReviewStore) UpdateReviewOutcome(ctx context.Context, itemID string, outcome Outcome,
+	updatedAt time.Time) error {
+	dbClient, err := s.dbProvider.GetConfigDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	if _, err := dbClient.ExecuteContext(ctx, queryUpdateReviewOutcome, itemID, string(outcome), updatedAt,
+		sysContext.ScopeDeploymentID(ctx, s.deploymentID)); err != nil {
+		return fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	return nil
+}
+
+// CompleteCampaign marks a campaign as completed. The campaign is completed only while it is active, so
+// that its decisions are applied once.
+func (s *accessReviewStore) CompleteCampaign(ctx context.Context, id string, completedAt time.Time) error {
+	dbClient, err := s.dbProvider.GetConfigDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	rowsAffected, err := dbClient.ExecuteContext(ctx, queryCompleteCampaign, id, string(CampaignStatusCompleted),
+		completedAt, string(CampaignStatusActive), sysContext.ScopeDeploymentID(ctx, s.deploymentID))
+	if err != nil {
+		return fmt.Errorf("failed to execute query: %w", err)
+	}
+	if rowsAffected == 0 {
+		return ErrCampaignNotActive
+	}
+
+	return nil
+}
+
+// buildCampaignFromResultRow constructs a Campaign from a database result row.
+func buildCampaignFromResultRow(row map[string]interface{}) (*Campaign, error) {
+	id, ok := row["id"].(string)
+	if !ok {
+		return nil, fmt.Errorf("failed to parse id as string")
+	}
+	name, ok := row["name"].(string)
+	if !ok {
+		return nil, fmt.Errorf("failed to parse name as string")
+	}
+	status, ok := row["status"].(string)
+	if !ok {
+		return nil, fmt.Errorf("failed to parse status as string")
+	}
+
+	campaign := &Campaign{
+		ID:     id,
+		Name:   name,
+		Status: CampaignStatus(status),
+	}
+	campaign.Description, _ = row["description"].(string)
+	campaign.CreatedBy, _ = row["created_by"].(string)
+	if autoRevoke := dbutils.ParseStringOrBytes(row["auto_revoke"]); autoRevoke != "" {
+		campaign.AutoRevoke = utils.NumStringToBool(autoRevoke)
+	}
+
+	var targets campaignTargets
+	if err := json.Unmarshal([]byte(dbutils.ParseStringOrBytes(row["targets"])), &targets); err != nil {
+		return nil, fmt.Errorf("failed to parse targets: %w", err)
+	}
+	campaign.RoleIDs = targets.RoleIDs
+	campaign.OUIDs = targets.OUIDs
+	if err := json.Unmarshal([]byte(dbutils.ParseStringOrBytes(row["reviewers"])), &campaign.Reviewers); err != nil {
+		return nil, fmt.Errorf("failed to parse reviewers: %w", err)
+	}
+
+	var err error
+	if campaign.CreatedAt, err = dbutils.ParseTimeField(row["created_at"], "created_at"); err != nil {
+		return nil, err
+	}
+	if campaign.Deadline, err = dbutils.ParseTimeField(row["deadline"], "deadline"); err != nil {
+		return nil, err
+	}
+	if row["completed_at"] != nil {
+		completedAt, err := dbutils.ParseTimeField(row["completed_at"], "completed_at")
+		if err != nil {
+			return nil, err
+		}
+		campaign.CompletedAt = &completedAt
+	}
+
+	return campaign, nil
+}
+
+// buildReviewItemsFromResultRows constructs review items from database result rows.
+func buildReviewItemsFromResultRows(results []map[string]interface{}) ([]ReviewItem, error) {
+	items := make([]ReviewItem, 0, len(results))
+	for _, row := range results {
+		item, err := buildReviewItemFromResultRow(row)
+		if err != nil {
+			return nil, fmt.Errorf("failed to build review item from result row: %w", err)
+		}
+		items = append(items, *item)
+	}
+	return items, nil
+}
+
+// buildReviewItemFromResultRow constructs a ReviewItem from a database result row.
+func buildReviewItemFromResultRow(row map[string]interface{}) (*ReviewItem, error) {
+	id, ok := row["id"].(string)
+	if !ok {
+		return nil, fmt.Errorf("failed to parse id as string")
+	}
+	campaignID, ok := row["campaign_id"].(string)
+	if !ok {
+		return nil, fmt.Errorf("failed to parse campaign_id as string")
+	}
+	decision, ok := row["decision"].(string)
+	if !ok {
+		return nil, fmt.Errorf("failed to parse decision as string")
+	}
+
+	item := &ReviewItem{
+		ID:         id,
+		CampaignID: campaignID,
+		Decision:   Decision(decision),
+	}
+	item.RoleID, _ = row["role_id"].(string)
+	item.RoleName, _ = row["role_name"].(string)
+	item.AssigneeID, _ = row["assignee_id"].(string)
+	assigneeType, _ := row["assignee_type"].(string)
+	item.AssigneeType = role.AssigneeType(assigneeType)
+	item.AssigneeDisplay, _ = row["assignee_display"].(string)
+	item.Reviewer, _ = row["reviewer"].(string)
+	item.Comment = dbutils.ParseStringOrBytes(row["comment"])
+	item.DecidedBy, _ = row["decided_by"].(string)
+	outcome, _ := row["outcome"].(string)
+	item.Outcome = Outcome(outcome)
+
+	if row["decided_at"] != nil {
+		decidedAt, err := dbutils.ParseTimeField(row["decided_at"], "decided_at")
+		if err != nil {
+			return nil, err
+		}
+		item.DecidedAt = &decidedAt
+	}
+
+	return item, nil
+}
+
+// toNullableString maps an empty string to a SQL NULL.
+func toNullableString(value string) interface{} {
+	if value == "" {
+		return nil
+	}
+	return value
+}
+
+// toNullableTime maps a nil time to a SQL NULL.
+func toNullableTime(value *time.Time) interface{} {
+	if value == nil {
+		return nil
+	}
+	return *value
+}
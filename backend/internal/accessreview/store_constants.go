@@ -0,0 +1,92 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package accessreview
+
+import "github.com/thunder-id/thunderid/internal/system/database/model"
+
+const campaignColumns = `ID, NAME, DESCRIPTION, TARGETS, REVIEWERS, AUTO_REVOKE, STATUS, CREATED_BY, CREATED_AT, ` +
+	`DEADLINE, COMPLETED_AT`
+
+const reviewItemColumns = `ID, CAMPAIGN_ID, ROLE_ID, ROLE_NAME, ASSIGNEE_ID, ASSIGNEE_TYPE, ASSIGNEE_DISPLAY, ` +
+	`REVIEWER, DECISION, COMMENT, DECIDED_BY, DECIDED_AT, OUTCOME`
+
+var (
+	// queryCreateCampaign is the query to create a new access review campaign.
+	queryCreateCampaign = model.DBQuery{
+		ID: "ARV-ACCESS_REVIEW_MGT-01",
+		Query: `INSERT INTO "ACCESS_REVIEW_CAMPAIGN" (` + campaignColumns + `, DEPLOYMENT_ID) ` +
+			`VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)`,
+	}
+	// queryCreateReviewItem is the query to create a new review item of a campaign.
+	queryCreateReviewItem = model.DBQuery{
+		ID: "ARV-ACCESS_REVIEW_MGT-02",
+		Query: `INSERT INTO "ACCESS_REVIEW_ITEM" (` + reviewItemColumns + `, DEPLOYMENT_ID) ` +
+			`VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)`,
+	}
+	// queryGetCampaignByID is the query to get a campaign by its ID.
+	queryGetCampaignByID = model.DBQuery{
+		ID: "ARV-ACCESS_REVIEW_MGT-03",
+		Query: `SELECT ` + campaignColumns + ` FROM "ACCESS_REVIEW_CAMPAIGN" ` +
+			`WHERE ID = $1 AND DEPLOYMENT_ID = $2`,
+	}
+	// queryGetCampaignList is the query to get the list of campaigns, latest first.
+	queryGetCampaignList = model.DBQuery{
+		ID: "ARV-ACCESS_REVIEW_MGT-04",
+		Query: `SELECT ` + campaignColumns + ` FROM "ACCESS_REVIEW_CAMPAIGN" WHERE DEPLOYMENT_ID = $1 ` +
+			`ORDER BY CREATED_AT DESC`,
+	}
+	// queryGetCampaignListByStatus is the query to get the list of campaigns in a status, latest first.
+	queryGetCampaignListByStatus = model.DBQuery{
+		ID: "ARV-ACCESS_REVIEW_MGT-05",
+		Query: `SELECT ` + campaignColumns + ` FROM "ACCESS_REVIEW_CAMPAIGN" WHERE STATUS = $1 ` +
+			`AND DEPLOYMENT_ID = $2 ORDER BY CREATED_AT DESC`,
+	}
+	// queryGetReviewItems is the query to get the review items of a campaign.
+	queryGetReviewItems = model.DBQuery{
+		ID: "ARV-ACCESS_REVIEW_MGT-06",
+		Query: `SELECT ` + reviewItemColumns + ` FROM "ACCESS_REVIEW_ITEM" WHERE CAMPAIGN_ID = $1 ` +
+			`AND DEPLOYMENT_ID = $2 ORDER BY ROLE_NAME, ASSIGNEE_ID`,
+	}
+	// queryGetActiveReviewItemsByReviewer is the query to get the review items assigned to a reviewer in
+	// the active campaigns.
+	queryGetActiveReviewItemsByReviewer = model.DBQuery{
+		ID: "ARV-ACCESS_REVIEW_MGT-07",
+		Query: `SELECT ` + reviewItemColumns + ` FROM "ACCESS_REVIEW_ITEM" WHERE REVIEWER = $1 ` +
+			`AND DEPLOYMENT_ID = $2 AND CAMPAIGN_ID IN (SELECT ID FROM "ACCESS_REVIEW_CAMPAIGN" ` +
+			`WHERE STATUS = $3 AND DEPLOYMENT_ID = $2) ORDER BY CAMPAIGN_ID, ROLE_NAME, ASSIGNEE_ID`,
+	}
+	// queryUpdateReviewDecision is the query to record the decision on a review item.
+	queryUpdateReviewDecision = model.DBQuery{
+		ID: "ARV-ACCESS_REVIEW_MGT-08",
+		Query: `UPDATE "ACCESS_REVIEW_ITEM" SET DECISION = $2, COMMENT = $3, DECIDED_BY = $4, DECIDED_AT = $5, ` +
+			`UPDATED_AT = $5 WHERE ID = $1 AND DEPLOYMENT_ID = $6`,
+	}
+	// queryUpdateReviewOutcome is the query to record the outcome of a review item.
+	queryUpdateReviewOutcome = model.DBQuery{
+		ID: "ARV-ACCESS_REVIEW_MGT-09",
+		Query: `UPDATE "ACCESS_REVIEW_ITEM" SET OUTCOME = $2, UPDATED_AT = $3 ` +
+			`WHERE ID = $1 AND DEPLOYMENT_ID = $4`,
+	}
+	// queryCompleteCampaign is the query to complete a campaign that is still active.
+	queryCompleteCampaign = model.DBQuery{
+		ID: "ARV-ACCESS_REVIEW_MGT-10",
+		Query: `UPDATE "ACCESS_REVIEW_CAMPAIGN" SET STATUS = $2, COMPLETED_AT = $3, UPDATED_AT = $3 ` +
+			`WHERE ID = $1 AND STATUS = $4 AND DEPLOYMENT_ID = $5`,
+	}
+)
@@ -0,0 +1,59 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package accountdeletion
+
+import "time"
+
+const (
+	// loggerComponentName is the component name used in account deletion logs.
+	loggerComponentName = "AccountDeletionService"
+	// handlerLoggerComponentName is the component name used in account deletion handler logs.
+	handlerLoggerComponentName = "AccountDeletionHandler"
+
+	// defaultGracePeriod is the default number of seconds after a request at which the account is deleted.
+	defaultGracePeriod = int64(30 * 24 * 60 * 60)
+	// defaultCancellationPath is the path of the cancellation page, relative to the gate client login page.
+	defaultCancellationPath = "account-deletion/cancel"
+	// cancellationTokenParam is the query parameter of the cancellation link holding the cancellation token.
+	cancellationTokenParam = "token"
+
+	// templateDataKeyCancellationLink is the template data key holding the cancellation link.
+	templateDataKeyCancellationLink = "cancellationLink"
+	// templateDataKeyDeletionTime is the template data key holding the time the account is deleted at.
+	templateDataKeyDeletionTime = "deletionTime"
+)
+
+// scheduledPurgeLockName is the distributed lock held while a scheduled purge runs.
+const scheduledPurgeLockName = "account-deletion-scheduled-purge"
+
+// purgeBatchSize is the number of due account deletions read from the store and completed at a time.
+const purgeBatchSize = 100
+
+// defaultPurgeInterval is the interval between scheduled purges when no interval is configured.
+const defaultPurgeInterval = time.Hour
+
+// Reasons recorded in the audit events of account deletions.
+const (
+	// cancelReasonUser is recorded when the user cancels the deletion from their account.
+	cancelReasonUser = "cancelled_by_user"
+	// cancelReasonLink is recorded when the deletion is cancelled with the link sent by email.
+	cancelReasonLink = "cancelled_by_link"
+	// completeReasonGracePeriodEnded is recorded when the account is deleted after its grace period.
+	completeReasonGracePeriodEnded = "grace_period_ended"
+)
@@ -0,0 +1,167 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package accountdeletion
+
+import (
+	"errors"
+
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/i18n/core"
+)
+
+// ErrPendingAccountDeletionNotFound is returned when the pending account deletion is not found in the store.
+var ErrPendingAccountDeletionNotFound = errors.New("pending account deletion not found")
+
+// Client errors for account deletion operations.
+var (
+	// ErrorMissingUserID is the error returned when the user ID is missing.
+	ErrorMissingUserID = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "ADL-1001",
+		Error: core.I18nMessage{
+			Key:          "error.accountdeletionservice.missing_user_id",
+			DefaultValue: "Missing user ID",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.accountdeletionservice.missing_user_id_description",
+			DefaultValue: "The user ID must be provided",
+		},
+	}
+	// ErrorUserNotFound is the error returned when the user is not found.
+	ErrorUserNotFound = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "ADL-1002",
+		Error: core.I18nMessage{
+			Key:          "error.accountdeletionservice.user_not_found",
+			DefaultValue: "User not found",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.accountdeletionservice.user_not_found_description",
+			DefaultValue: "The user could not be found",
+		},
+	}
+	// ErrorPendingDeletionNotFound is the error returned when the user has no pending account deletion.
+	ErrorPendingDeletionNotFound = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "ADL-1003",
+		Error: core.I18nMessage{
+			Key:          "error.accountdeletionservice.pending_deletion_not_found",
+			DefaultValue: "Pending account deletion not found",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.accountdeletionservice.pending_deletion_not_found_description",
+			DefaultValue: "The user has no pending account deletion",
+		},
+	}
+	// ErrorDeletionAlreadyRequested is the error returned when the deletion of the account is already pending.
+	ErrorDeletionAlreadyRequested = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "ADL-1004",
+		Error: core.I18nMessage{
+			Key:          "error.accountdeletionservice.deletion_already_requested",
+			DefaultValue: "Account deletion already requested",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.accountdeletionservice.deletion_already_requested_description",
+			DefaultValue: "The deletion of the account is already scheduled",
+		},
+	}
+	// ErrorAccountNotDeletable is the error returned when the account cannot be deleted by its user.
+	ErrorAccountNotDeletable = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "ADL-1005",
+		Error: core.I18nMessage{
+			Key:          "error.accountdeletionservice.account_not_deletable",
+			DefaultValue: "Account cannot be deleted",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.accountdeletionservice.account_not_deletable_description",
+			DefaultValue: "The account is managed declaratively and cannot be deleted",
+		},
+	}
+	// ErrorMissingEmail is the error returned when the user has no email address to send the cancellation link to.
+	ErrorMissingEmail = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "ADL-1006",
+		Error: core.I18nMessage{
+			Key:          "error.accountdeletionservice.missing_email",
+			DefaultValue: "Missing email address",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.accountdeletionservice.missing_email_description",
+			DefaultValue: "The user has no email address to send the cancellation link to",
+		},
+	}
+	// ErrorInvalidCancellationToken is the error returned when a cancellation token is unknown or expired.
+	ErrorInvalidCancellationToken = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "ADL-1007",
+		Error: core.I18nMessage{
+			Key:          "error.accountdeletionservice.invalid_cancellation_token",
+			DefaultValue: "Invalid cancellation token",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.accountdeletionservice.invalid_cancellation_token_description",
+			DefaultValue: "The cancellation token is invalid or the deletion can no longer be cancelled",
+		},
+	}
+	// ErrorAuthenticationFailed is the error returned when the caller is not authenticated.
+	ErrorAuthenticationFailed = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "ADL-1008",
+		Error: core.I18nMessage{
+			Key:          "error.accountdeletionservice.authentication_failed",
+			DefaultValue: "Authentication failed",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.accountdeletionservice.authentication_failed_description",
+			DefaultValue: "The caller could not be identified",
+		},
+	}
+	// ErrorInvalidRequestFormat is the error returned when the request body is malformed.
+	ErrorInvalidRequestFormat = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "ADL-1009",
+		Error: core.I18nMessage{
+			Key:          "error.accountdeletionservice.invalid_request_format",
+			DefaultValue: "Invalid request format",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.accountdeletionservice.invalid_request_format_description",
+			DefaultValue: "The request body is malformed or contains invalid data",
+		},
+	}
+)
+
+// Server errors for account deletion operations.
+var (
+	// ErrorCancellationNotSent is the error returned when the cancellation link could not be sent.
+	ErrorCancellationNotSent = serviceerror.ServiceError{
+		Type: serviceerror.ServerErrorType,
+		Code: "ADL-5001",
+		Error: core.I18nMessage{
+			Key:          "error.accountdeletionservice.cancellation_not_sent",
+			DefaultValue: "Cancellation link not sent",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.accountdeletionservice.cancellation_not_sent_description",
+			DefaultValue: "The account deletion could not be scheduled as the cancellation link was not sent",
+		},
+	}
+)
@@ -0,0 +1,137 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package accountdeletion
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/internal/system/security"
+	sysutils "github.com/thunder-id/thunderid/internal/system/utils"
+)
+
+// accountDeletionHandler is the handler for account deletion operations.
+type accountDeletionHandler struct {
+	accountDeletionService AccountDeletionServiceInterface
+}
+
+// newAccountDeletionHandler creates a new instance of accountDeletionHandler.
+func newAccountDeletionHandler(accountDeletionService AccountDeletionServiceInterface) *accountDeletionHandler {
+	return &accountDeletionHandler{
+		accountDeletionService: accountDeletionService,
+	}
+}
+
+// HandleSelfRequestDeletion handles the request of the authenticated user to delete their own account.
+func (h *accountDeletionHandler) HandleSelfRequestDeletion(w http.ResponseWriter, r *http.Request) {
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, handlerLoggerComponentName))
+
+	userID, ok := getSelfUserID(w, r)
+	if !ok {
+		return
+	}
+
+	deletion, svcErr := h.accountDeletionService.RequestDeletion(r.Context(), userID)
+	if svcErr != nil {
+		sysutils.WriteServiceErrorResponse(w, svcErr, clientErrorStatusCodes)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(w, http.StatusAccepted, deletion)
+	logger.Debug("Account deletion request response sent", log.MaskedString(log.LoggerKeyUserID, userID))
+}
+
+// HandleSelfGetRequest handles the request to retrieve the pending account deletion of the authenticated
+// user.
+func (h *accountDeletionHandler) HandleSelfGetRequest(w http.ResponseWriter, r *http.Request) {
+	userID, ok := getSelfUserID(w, r)
+	if !ok {
+		return
+	}
+
+	deletion, svcErr := h.accountDeletionService.GetPendingDeletion(r.Context(), userID)
+	if svcErr != nil {
+		sysutils.WriteServiceErrorResponse(w, svcErr, clientErrorStatusCodes)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(w, http.StatusOK, deletion)
+}
+
+// HandleSelfCancelRequest handles the request to cancel the pending account deletion of the authenticated
+// user.
+func (h *accountDeletionHandler) HandleSelfCancelRequest(w http.ResponseWriter, r *http.Request) {
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, handlerLoggerComponentName))
+
+	userID, ok := getSelfUserID(w, r)
+	if !ok {
+		return
+	}
+
+	if svcErr := h.accountDeletionService.CancelDeletion(r.Context(), userID); svcErr != nil {
+		sysutils.WriteServiceErrorResponse(w, svcErr, clientErrorStatusCodes)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+	logger.Debug("Account deletion cancel response sent", log.MaskedString(log.LoggerKeyUserID, userID))
+}
+
+// HandleCancelRequest handles the request to cancel a pending account deletion with a cancellation token.
+func (h *accountDeletionHandler) HandleCancelRequest(w http.ResponseWriter, r *http.Request) {
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, handlerLoggerComponentName))
+
+	request, err := sysutils.DecodeJSONBody[CancellationRequest](r)
+	if err != nil {
+		sysutils.WriteServiceErrorResponse(w, &ErrorInvalidRequestFormat, clientErrorStatusCodes)
+		return
+	}
+
+	if svcErr := h.accountDeletionService.CancelDeletionByToken(
+		r.Context(), strings.TrimSpace(request.Token)); svcErr != nil {
+		sysutils.WriteServiceErrorResponse(w, svcErr, clientErrorStatusCodes)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+	logger.Debug("Account deletion cancellation response sent")
+}
+
+// getSelfUserID returns the ID of the authenticated user, writing an error response if it is missing.
+func getSelfUserID(w http.ResponseWriter, r *http.Request) (string, bool) {
+	userID := security.GetSubject(r.Context())
+	if strings.TrimSpace(userID) == "" {
+		sysutils.WriteServiceErrorResponse(w, &ErrorAuthenticationFailed, clientErrorStatusCodes)
+		return "", false
+	}
+	return userID, true
+}
+
+// clientErrorStatusCodes maps the client errors of the handler to HTTP status codes. Other client errors are
+// returned with 400 Bad Request.
+var clientErrorStatusCodes = map[string]int{
+	ErrorUserNotFound.Code:              http.StatusNotFound,
+	ErrorPendingDeletionNotFound.Code:   http.StatusNotFound,
+	ErrorDeletionAlreadyRequested.Code:  http.StatusConflict,
+	ErrorAuthenticationFailed.Code:      http.StatusUnauthorized,
+	ErrorAccountNotDeletable.Code:       http.StatusForbidden,
+	serviceerror.ErrorUnauthorized.Code: http.StatusForbidden,
+}
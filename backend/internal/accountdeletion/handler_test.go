@@ -0,0 +1,139 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package accountdeletion
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/system/error/apierror"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/security"
+)
+
+type HandlerTestSuite struct {
+	suite.Suite
+	mockService *AccountDeletionServiceInterfaceMock
+	handler     *accountDeletionHandler
+}
+
+func TestHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(HandlerTestSuite))
+}
+
+func (s *HandlerTestSuite) SetupTest() {
+	s.mockService = NewAccountDeletionServiceInterfaceMock(s.T())
+	s.handler = newAccountDeletionHandler(s.mockService)
+}
+
+func (s *HandlerTestSuite) newSelfRequest(method, target string) *http.Request {
+	req := httptest.NewRequest(method, target, nil)
+	authCtx := security.NewSecurityContextForTest(testUserID, "", "", nil, nil)
+	return req.WithContext(security.WithSecurityContextTest(req.Context(), authCtx))
+}
+
+func (s *HandlerTestSuite) TestHandleSelfRequestDeletion() {
+	s.mockService.On("RequestDeletion", mock.Anything, testUserID).Return(&PendingAccountDeletion{
+		UserID: testUserID, TokenHash: "token-hash", PreviousState: "ACTIVE", LoginDisabled: true,
+	}, nil).Once()
+
+	rr := httptest.NewRecorder()
+	s.handler.HandleSelfRequestDeletion(rr, s.newSelfRequest(http.MethodPost, "/users/me/delete-request"))
+
+	s.Equal(http.StatusAccepted, rr.Code)
+	s.NotContains(rr.Body.String(), "token-hash")
+	s.NotContains(rr.Body.String(), "ACTIVE")
+	var body PendingAccountDeletion
+	s.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &body))
+	s.True(body.LoginDisabled)
+}
+
+func (s *HandlerTestSuite) TestHandleSelfRequestDeletion_AlreadyRequested() {
+	s.mockService.On("RequestDeletion", mock.Anything, testUserID).
+		Return(nil, &ErrorDeletionAlreadyRequested).Once()
+
+	rr := httptest.NewRecorder()
+	s.handler.HandleSelfRequestDeletion(rr, s.newSelfRequest(http.MethodPost, "/users/me/delete-request"))
+
+	s.Equal(http.StatusConflict, rr.Code)
+	var body apierror.ErrorResponse
+	s.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &body))
+	s.Equal(ErrorDeletionAlreadyRequested.Code, body.Code)
+}
+
+func (s *HandlerTestSuite) TestHandleSelfRequestDeletion_Unauthenticated() {
+	rr := httptest.NewRecorder()
+	s.handler.HandleSelfRequestDeletion(rr, httptest.NewRequest(http.MethodPost, "/users/me/delete-request", nil))
+
+	s.Equal(http.StatusUnauthorized, rr.Code)
+}
+
+func (s *HandlerTestSuite) TestHandleSelfGetRequest_NotFound() {
+	s.mockService.On("GetPendingDeletion", mock.Anything, testUserID).
+		Return(nil, &ErrorPendingDeletionNotFound).Once()
+
+	rr := httptest.NewRecorder()
+	s.handler.HandleSelfGetRequest(rr, s.newSelfRequest(http.MethodGet, "/users/me/delete-request"))
+
+	s.Equal(http.StatusNotFound, rr.Code)
+}
+
+func (s *HandlerTestSuite) TestHandleSelfCancelRequest() {
+	s.mockService.On("CancelDeletion", mock.Anything, testUserID).Return(nil).Once()
+
+	rr := httptest.NewRecorder()
+	s.handler.HandleSelfCancelRequest(rr, s.newSelfRequest(http.MethodDelete, "/users/me/delete-request"))
+
+	s.Equal(http.StatusNoContent, rr.Code)
+}
+
+func (s *HandlerTestSuite) TestHandleCancelRequest() {
+	s.mockService.On("CancelDeletionByToken", mock.Anything, testToken).Return(nil).Once()
+
+	rr := httptest.NewRecorder()
+	s.handler.HandleCancelRequest(rr, httptest.NewRequest(http.MethodPost, "/account-deletion/cancel",
+		strings.NewReader(`{"token":" `+testToken+` "}`)))
+
+	s.Equal(http.StatusNoContent, rr.Code)
+}
+
+func (s *HandlerTestSuite) TestHandleCancelRequest_InvalidBody() {
+	rr := httptest.NewRecorder()
+	s.handler.HandleCancelRequest(rr, httptest.NewRequest(http.MethodPost, "/account-deletion/cancel",
+		strings.NewReader(`{`)))
+
+	s.Equal(http.StatusBadRequest, rr.Code)
+}
+
+func (s *HandlerTestSuite) TestHandleCancelRequest_ServerError() {
+	s.mockService.On("CancelDeletionByToken", mock.Anything, testToken).
+		Return(&serviceerror.InternalServerError).Once()
+
+	rr := httptest.NewRecorder()
+	s.handler.HandleCancelRequest(rr, httptest.NewRequest(http.MethodPost, "/account-deletion/cancel",
+		strings.NewReader(`{"token":"`+testToken+`"}`)))
+
+	s.Equal(http.StatusInternalServerError, rr.Code)
+}
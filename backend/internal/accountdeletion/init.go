@@ -0,0 +1,118 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package accountdeletion
+
+import (
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/entity"
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/email"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/internal/system/middleware"
+	"github.com/thunder-id/thunderid/internal/system/observability"
+	"github.com/thunder-id/thunderid/internal/system/scheduler"
+	"github.com/thunder-id/thunderid/internal/system/sysauthz"
+	"github.com/thunder-id/thunderid/internal/system/template"
+)
+
+// Initialize initializes the account deletion service, registers its routes and starts the scheduled
+// purges of accounts whose grace period has ended. It returns nil when account deletion is disabled. The
+// email client may be nil when email is not configured.
+func Initialize(
+	mux *http.ServeMux,
+	entityService entity.EntityServiceInterface,
+	authzService sysauthz.SystemAuthorizationServiceInterface,
+	templateService template.TemplateServiceInterface,
+	emailClient email.EmailClientInterface,
+	jobScheduler scheduler.SchedulerInterface,
+	observabilitySvc observability.ObservabilityServiceInterface,
+) AccountDeletionServiceInterface {
+	runtime := config.GetServerRuntime()
+	deletionConfig := runtime.Config.AccountDeletion
+	if !deletionConfig.Enabled {
+		return nil
+	}
+	if emailClient == nil {
+		log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName)).Warn(
+			"Email is not configured, account deletions cannot be requested until it is")
+	}
+
+	accountDeletionService := newAccountDeletionService(newAccountDeletionStore(), entityService, authzService,
+		templateService, emailClient, observabilitySvc, deletionConfig.EmailAttribute, deletionConfig.GracePeriod,
+		deletionConfig.DisableLogin, getCancellationURL(runtime))
+
+	accountDeletionHandler := newAccountDeletionHandler(accountDeletionService)
+	registerRoutes(mux, accountDeletionHandler)
+
+	interval := time.Duration(deletionConfig.PurgeInterval) * time.Second
+	if interval <= 0 {
+		interval = defaultPurgeInterval
+	}
+	jobScheduler.Schedule(scheduledPurgeLockName, interval, newScheduledPurge(accountDeletionService))
+
+	return accountDeletionService
+}
+
+// getCancellationURL returns the configured cancellation page URL, or the account deletion cancellation
+// page of the gate client when none is configured.
+func getCancellationURL(runtime *config.ServerRuntime) *url.URL {
+	if configured := runtime.Config.AccountDeletion.CancellationURL; configured != "" {
+		// The URL is validated when the configuration is loaded.
+		if parsed, err := url.Parse(configured); err == nil {
+			return parsed
+		}
+	}
+	return runtime.GateClientLoginURL.ResolveReference(&url.URL{Path: defaultCancellationPath})
+}
+
+// registerRoutes registers the routes for account deletion operations.
+func registerRoutes(mux *http.ServeMux, accountDeletionHandler *accountDeletionHandler) {
+	opts1 := middleware.CORSOptions{
+		AllowedMethods:   []string{"GET", "POST", "DELETE"},
+		AllowedHeaders:   middleware.DefaultAllowedHeaders,
+		AllowCredentials: true,
+		MaxAge:           600,
+	}
+	mux.HandleFunc(middleware.WithCORS("POST /users/me/delete-request",
+		accountDeletionHandler.HandleSelfRequestDeletion, opts1))
+	mux.HandleFunc(middleware.WithCORS("GET /users/me/delete-request",
+		accountDeletionHandler.HandleSelfGetRequest, opts1))
+	mux.HandleFunc(middleware.WithCORS("DELETE /users/me/delete-request",
+		accountDeletionHandler.HandleSelfCancelRequest, opts1))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS /users/me/delete-request",
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, opts1))
+
+	opts2 := middleware.CORSOptions{
+		AllowedMethods:   []string{"POST"},
+		AllowedHeaders:   middleware.DefaultAllowedHeaders,
+		AllowCredentials: true,
+		MaxAge:           600,
+	}
+	mux.HandleFunc(middleware.WithCORS("POST /account-deletion/cancel",
+		accountDeletionHandler.HandleCancelRequest, opts2))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS /account-deletion/cancel",
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, opts2))
+}
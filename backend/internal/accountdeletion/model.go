@@ -0,0 +1,38 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package accountdeletion lets users request the deletion of their own account. The account is deleted
+// once a grace period ends, and the deletion can be cancelled until then.
+package accountdeletion
+
+import "time"
+
+// PendingAccountDeletion represents the deletion of an account awaiting the end of its grace period.
+type PendingAccountDeletion struct {
+	UserID        string    `json:"userId"`
+	TokenHash     string    `json:"-"`
+	PreviousState string    `json:"-"`
+	LoginDisabled bool      `json:"loginDisabled"`
+	CreatedAt     time.Time `json:"createdAt"`
+	DeletionTime  time.Time `json:"deletionTime"`
+}
+
+// CancellationRequest represents the request to cancel a pending account deletion with a cancellation token.
+type CancellationRequest struct {
+	Token string `json:"token"`
+}
@@ -0,0 +1,467 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package accountdeletion
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"html"
+	"net/url"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/entity"
+	sysContext "github.com/thunder-id/thunderid/internal/system/context"
+	"github.com/thunder-id/thunderid/internal/system/cryptolab"
+	"github.com/thunder-id/thunderid/internal/system/email"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/internal/system/observability"
+	"github.com/thunder-id/thunderid/internal/system/observability/event"
+	"github.com/thunder-id/thunderid/internal/system/scheduler"
+	"github.com/thunder-id/thunderid/internal/system/security"
+	"github.com/thunder-id/thunderid/internal/system/sysauthz"
+	"github.com/thunder-id/thunderid/internal/system/template"
+)
+
+// AccountDeletionServiceInterface defines the interface for the account deletion service.
+type AccountDeletionServiceInterface interface {
+	RequestDeletion(ctx context.Context, userID string) (*PendingAccountDeletion, *serviceerror.ServiceError)
+	GetPendingDeletion(ctx context.Context, userID string) (*PendingAccountDeletion, *serviceerror.ServiceError)
+	CancelDeletion(ctx context.Context, userID string) *serviceerror.ServiceError
+	CancelDeletionByToken(ctx context.Context, token string) *serviceerror.ServiceError
+	PurgeDue(ctx context.Context) (int, error)
+}
+
+// accountDeletionService is the default implementation of the AccountDeletionServiceInterface.
+type accountDeletionService struct {
+	store            accountDeletionStoreInterface
+	entityService    entity.EntityServiceInterface
+	authzService     sysauthz.SystemAuthorizationServiceInterface
+	templateService  template.TemplateServiceInterface
+	emailClient      email.EmailClientInterface
+	observabilitySvc observability.ObservabilityServiceInterface
+	emailAttribute   string
+	gracePeriod      int64
+	disableLogin     bool
+	cancellationURL  *url.URL
+	now              func() time.Time
+	logger           *log.Logger
+}
+
+// newAccountDeletionService creates a new instance of accountDeletionService. The email client may be nil
+// when email is not configured, in which case account deletions cannot be requested. The observability
+// service may be nil, in which case account deletions are not audited.
+func newAccountDeletionService(
+	store accountDeletionStoreInterface,
+	entityService entity.EntityServiceInterface,
+	authzService sysauthz.SystemAuthorizationServiceInterface,
+	templateService template.TemplateServiceInterface,
+	emailClient email.EmailClientInterface,
+	observabilitySvc observability.ObservabilityServiceInterface,
+	emailAttribute string,
+	gracePeriod int64,
+	disableLogin bool,
+	cancellationURL *url.URL,
+) AccountDeletionServiceInterface {
+	if gracePeriod <= 0 {
+		gracePeriod = defaultGracePeriod
+	}
+
+	return &accountDeletionService{
+		store:            store,
+		entityService:    entityService,
+		authzService:     authzService,
+		templateService:  templateService,
+		emailClient:      emailClient,
+		observabilitySvc: observabilitySvc,
+		emailAttribute:   emailAttribute,
+		gracePeriod:      gracePeriod,
+		disableLogin:     disableLogin,
+		cancellationURL:  cancellationURL,
+		now:              time.Now,
+		logger:           log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName)),
+	}
+}
+
+// RequestDeletion schedules the deletion of the account of a user at the end of the grace period and sends
+// the cancellation link to the email address of the user. When configured, the user cannot sign in while
+// the deletion is pending. The caller is responsible for passing the ID of the authenticated user, as users
+// can only request the deletion of their own account.
+func (s *accountDeletionService) RequestDeletion(
+	ctx context.Context, userID string,
+) (*PendingAccountDeletion, *serviceerror.ServiceError) {
+	user, svcErr := s.getUser(ctx, userID)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	if isDeclarative, err := s.entityService.IsEntityDeclarative(ctx, userID); err != nil {
+		s.logger.Error("Failed to check whether the user is declarative",
+			log.MaskedString(log.LoggerKeyUserID, userID), log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	} else if isDeclarative {
+		return nil, &ErrorAccountNotDeletable
+	}
+
+	recipient, svcErr := s.getEmail(user)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	if s.emailClient == nil {
+		s.logger.Error("Email is not configured, cannot send the account deletion cancellation link")
+		return nil, &ErrorCancellationNotSent
+	}
+
+	if _, err := s.store.GetPendingDeletion(ctx, userID); err == nil {
+		return nil, &ErrorDeletionAlreadyRequested
+	} else if !errors.Is(err, ErrPendingAccountDeletionNotFound) {
+		s.logger.Error("Failed to retrieve pending account deletion", log.MaskedString(log.LoggerKeyUserID, userID),
+			log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+
+	token, err := cryptolab.GenerateSecureToken()
+	if err != nil {
+		s.logger.Error("Failed to generate account deletion cancellation token", log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+	now := s.now().UTC()
+	deletion := PendingAccountDeletion{
+		UserID:       userID,
+		TokenHash:    cryptolab.HashToken(token),
+		CreatedAt:    now,
+		DeletionTime: now.Add(time.Duration(s.gracePeriod) * time.Second),
+	}
+	if s.disableLogin {
+		deletion.PreviousState = string(user.State)
+		if deletion.PreviousState == "" {
+			deletion.PreviousState = string(entity.EntityStateActive)
+		}
+		deletion.LoginDisabled = true
+	}
+
+	if err := s.store.CreatePendingDeletion(ctx, deletion); err != nil {
+		s.logger.Error("Failed to store pending account deletion", log.MaskedString(log.LoggerKeyUserID, userID),
+			log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+
+	if deletion.LoginDisabled {
+		user.State = entity.EntityStatePendingDeletion
+		if _, err := s.entityService.UpdateEntity(ctx, userID, user); err != nil {
+			s.logger.Error("Failed to disable sign-in of the user pending deletion",
+				log.MaskedString(log.LoggerKeyUserID, userID), log.Error(err))
+			s.discardDeletion(ctx, userID)
+			return nil, &serviceerror.InternalServerError
+		}
+	}
+
+	if svcErr := s.sendCancellationLink(ctx, recipient, token, deletion); svcErr != nil {
+		// A deletion the user was not told how to cancel must not go ahead.
+		if err := s.restoreLogin(ctx, &deletion); err != nil {
+			s.logger.Error("Failed to restore sign-in of the user", log.MaskedString(log.LoggerKeyUserID, userID),
+				log.Error(err))
+		}
+		s.discardDeletion(ctx, userID)
+		return nil, svcErr
+	}
+
+	s.publishEvent(ctx, event.EventTypeAccountDeletionRequested, &deletion, "")
+	s.logger.Debug("Scheduled account deletion", log.MaskedString(log.LoggerKeyUserID, userID))
+	return &deletion, nil
+}
+
+// sendCancellationLink renders the account deletion email template with the cancellation link of the token
+// and sends it to the given address.
+func (s *accountDeletionService) sendCancellationLink(ctx context.Context, recipient, token string,
+	deletion PendingAccountDeletion) *serviceerror.ServiceError {
+	link := *s.cancellationURL
+	query := link.Query()
+	query.Set(cancellationTokenParam, token)
+	link.RawQuery = query.Encode()
+
+	rendered, svcErr := s.templateService.Render(ctx, template.ScenarioAccountDeletion, template.TemplateTypeEmail,
+		template.TemplateData{
+			templateDataKeyCancellationLink: html.EscapeString(link.String()),
+			templateDataKeyDeletionTime:     deletion.DeletionTime.Format(time.RFC1123),
+		})
+	if svcErr != nil {
+		s.logger.Error("Failed to render the account deletion email", log.String("error", svcErr.Code))
+		return &ErrorCancellationNotSent
+	}
+
+	if err := s.emailClient.Send(email.EmailData{
+		To:      []string{recipient},
+		Subject: rendered.Subject,
+		Body:    rendered.Body,
+		IsHTML:  rendered.IsHTML,
+	}); err != nil {
+		s.logger.Error("Failed to send the account deletion email", log.Error(err))
+		return &ErrorCancellationNotSent
+	}
+	return nil
+}
+
+// GetPendingDeletion retrieves the pending account deletion of a user.
+func (s *accountDeletionService) GetPendingDeletion(
+	ctx context.Context, userID string,
+) (*PendingAccountDeletion, *serviceerror.ServiceError) {
+	if userID == "" {
+		return nil, &ErrorMissingUserID
+	}
+
+	deletion, err := s.store.GetPendingDeletion(ctx, userID)
+	if err != nil {
+		if errors.Is(err, ErrPendingAccountDeletionNotFound) {
+			return nil, &ErrorPendingDeletionNotFound
+		}
+		s.logger.Error("Failed to retrieve pending account deletion", log.MaskedString(log.LoggerKeyUserID, userID),
+			log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+	return deletion, nil
+}
+
+// CancelDeletion cancels the pending account deletion of a user and restores the sign-in of the user.
+func (s *accountDeletionService) CancelDeletion(ctx context.Context, userID string) *serviceerror.ServiceError {
+	deletion, svcErr := s.GetPendingDeletion(ctx, userID)
+	if svcErr != nil {
+		return svcErr
+	}
+	return s.cancel(ctx, deletion, cancelReasonUser)
+}
+
+// CancelDeletionByToken cancels the pending account deletion the cancellation token was sent for.
+// Possession of the token is the proof of ownership of the account, so the caller needs no authorization,
+// which lets users whose sign-in is disabled cancel the deletion.
+func (s *accountDeletionService) CancelDeletionByToken(ctx context.Context, token string) *serviceerror.ServiceError {
+	if token == "" {
+		return &ErrorInvalidCancellationToken
+	}
+
+	deletion, err := s.store.GetPendingDeletionByTokenHash(ctx, cryptolab.HashToken(token))
+	if err != nil {
+		if errors.Is(err, ErrPendingAccountDeletionNotFound) {
+			return &ErrorInvalidCancellationToken
+		}
+		s.logger.Error("Failed to retrieve pending account deletion", log.Error(err))
+		return &serviceerror.InternalServerError
+	}
+	return s.cancel(ctx, deletion, cancelReasonLink)
+}
+
+// cancel removes a pending account deletion whose grace period has not ended and restores the sign-in of
+// the user.
+func (s *accountDeletionService) cancel(
+	ctx context.Context, deletion *PendingAccountDeletion, reason string,
+) *serviceerror.ServiceError {
+	if !s.now().UTC().Before(deletion.DeletionTime) {
+		s.logger.Debug("Grace period of the account deletion has ended",
+			log.MaskedString(log.LoggerKeyUserID, deletion.UserID))
+		if reason == cancelReasonLink {
+			return &ErrorInvalidCancellationToken
+		}
+		return &ErrorPendingDeletionNotFound
+	}
+
+	// Removing the deletion first stops a concurrent cancellation from restoring the sign-in twice.
+	deleted, err := s.store.DeletePendingDeletion(ctx, deletion.UserID)
+	if err != nil {
+		s.logger.Error("Failed to cancel pending account deletion",
+			log.MaskedString(log.LoggerKeyUserID, deletion.UserID), log.Error(err))
+		return &serviceerror.InternalServerError
+	}
+	if !deleted {
+		if reason == cancelReasonLink {
+			return &ErrorInvalidCancellationToken
+		}
+		return &ErrorPendingDeletionNotFound
+	}
+
+	if err := s.restoreLogin(ctx, deletion); err != nil {
+		s.logger.Error("Failed to restore sign-in of the user after cancelling the account deletion",
+			log.MaskedString(log.LoggerKeyUserID, deletion.UserID), log.Error(err))
+		return &serviceerror.InternalServerError
+	}
+
+	s.publishEvent(ctx, event.EventTypeAccountDeletionCancelled, deletion, reason)
+	s.logger.Debug("Cancelled pending account deletion", log.MaskedString(log.LoggerKeyUserID, deletion.UserID))
+	return nil
+}
+
+// restoreLogin returns a user whose sign-in was disabled by the deletion to the state the user was in
+// before. Users whose state was changed meanwhile are left as they are.
+func (s *accountDeletionService) restoreLogin(ctx context.Context, deletion *PendingAccountDeletion) error {
+	if deletion.PreviousState == "" {
+		return nil
+	}
+
+	user, err := s.entityService.GetEntity(ctx, deletion.UserID)
+	if err != nil {
+		if errors.Is(err, entity.ErrEntityNotFound) {
+			return nil
+		}
+		return err
+	}
+	if user.State != entity.EntityStatePendingDeletion {
+		return nil
+	}
+
+	user.State = entity.EntityState(deletion.PreviousState)
+	_, err = s.entityService.UpdateEntity(ctx, deletion.UserID, user)
+	return err
+}
+
+// PurgeDue deletes the accounts whose grace period has ended and returns the number of accounts deleted.
+func (s *accountDeletionService) PurgeDue(ctx context.Context) (int, error) {
+	dueBy := s.now().UTC()
+
+	purged := 0
+	for {
+		deletions, err := s.store.ListDueDeletions(ctx, dueBy, purgeBatchSize)
+		if err != nil {
+			return purged, fmt.Errorf("failed to list due account deletions: %w", err)
+		}
+
+		for i := range deletions {
+			deleted, err := s.completeDeletion(ctx, &deletions[i])
+			if err != nil {
+				return purged, err
+			}
+			if deleted {
+				purged++
+			}
+		}
+
+		if len(deletions) < purgeBatchSize {
+			return purged, nil
+		}
+	}
+}
+
+// completeDeletion deletes the account of a due deletion and removes the deletion. It reports whether the
+// account was deleted, as accounts already deleted by an administrator are only removed from the store.
+func (s *accountDeletionService) completeDeletion(
+	ctx context.Context, deletion *PendingAccountDeletion,
+) (bool, error) {
+	deleted := true
+	if err := s.entityService.DeleteEntity(ctx, deletion.UserID); err != nil {
+		if !errors.Is(err, entity.ErrEntityNotFound) {
+			return false, fmt.Errorf("failed to delete account pending deletion: %w", err)
+		}
+		deleted = false
+	}
+	if deleted {
+		s.authzService.DeleteResourceRelationships(ctx, security.ResourceTypeUser, deletion.UserID)
+	}
+
+	if _, err := s.store.DeletePendingDeletion(ctx, deletion.UserID); err != nil {
+		return deleted, fmt.Errorf("failed to remove completed account deletion: %w", err)
+	}
+
+	if deleted {
+		s.publishEvent(ctx, event.EventTypeAccountDeletionCompleted, deletion, completeReasonGracePeriodEnded)
+		s.logger.Debug("Deleted account after its grace period", log.MaskedString(log.LoggerKeyUserID, deletion.UserID))
+	}
+	return deleted, nil
+}
+
+// getUser retrieves the user with the given ID, treating entities of other categories as not found.
+func (s *accountDeletionService) getUser(
+	ctx context.Context, userID string,
+) (*entity.Entity, *serviceerror.ServiceError) {
+	if userID == "" {
+		return nil, &ErrorMissingUserID
+	}
+
+	user, err := s.entityService.GetEntity(ctx, userID)
+	if err != nil {
+		if errors.Is(err, entity.ErrEntityNotFound) {
+			return nil, &ErrorUserNotFound
+		}
+		s.logger.Error("Failed to retrieve user", log.MaskedString(log.LoggerKeyUserID, userID), log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+	if user.Category != entity.EntityCategoryUser {
+		return nil, &ErrorUserNotFound
+	}
+	return user, nil
+}
+
+// getEmail returns the email address of a user the cancellation link is sent to.
+func (s *accountDeletionService) getEmail(user *entity.Entity) (string, *serviceerror.ServiceError) {
+	attributes := map[string]interface{}{}
+	if len(user.Attributes) > 0 {
+		if err := json.Unmarshal(user.Attributes, &attributes); err != nil {
+			s.logger.Error("Failed to parse user attributes", log.MaskedString(log.LoggerKeyUserID, user.ID),
+				log.Error(err))
+			return "", &serviceerror.InternalServerError
+		}
+	}
+	recipient, _ := attributes[s.emailAttribute].(string)
+	if recipient == "" {
+		return "", &ErrorMissingEmail
+	}
+	return recipient, nil
+}
+
+// discardDeletion removes a pending account deletion that could not be scheduled.
+func (s *accountDeletionService) discardDeletion(ctx context.Context, userID string) {
+	if _, err := s.store.DeletePendingDeletion(ctx, userID); err != nil {
+		s.logger.Warn("Failed to remove pending account deletion", log.MaskedString(log.LoggerKeyUserID, userID),
+			log.Error(err))
+	}
+}
+
+// publishEvent records a step of an account deletion in the audit trail.
+func (s *accountDeletionService) publishEvent(
+	ctx context.Context, eventType event.EventType, deletion *PendingAccountDeletion, reason string) {
+	if s.observabilitySvc == nil || !s.observabilitySvc.IsEnabled() {
+		return
+	}
+
+	evt := event.NewEvent(sysContext.GetTraceID(ctx), string(eventType), event.ComponentAccountDeletion).
+		WithTenantID(sysContext.GetTenantID(ctx)).
+		WithStatus(event.StatusSuccess).
+		WithData(event.DataKey.UserID, deletion.UserID).
+		WithData(event.DataKey.DeletionTime, deletion.DeletionTime.Format(time.RFC3339))
+	if reason != "" {
+		evt.WithData(event.DataKey.Reason, reason)
+	}
+	if deletion.LoginDisabled {
+		evt.WithData(event.DataKey.LoginDisabled, true)
+	}
+	if actorID := security.GetSubject(ctx); actorID != "" {
+		evt.WithData(event.DataKey.ActorID, actorID)
+	}
+	s.observabilitySvc.PublishEvent(evt)
+}
+
+// newScheduledPurge returns the scheduled job that deletes the accounts whose grace period has ended.
+func newScheduledPurge(service AccountDeletionServiceInterface) scheduler.JobFunc {
+	return func(ctx context.Context) error {
+		purged, err := service.PurgeDue(ctx)
+		if purged > 0 {
+			log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName)).Info(
+				"Deleted accounts whose deletion grace period has ended", log.Int("count", purged))
+		}
+		return err
+	}
+}
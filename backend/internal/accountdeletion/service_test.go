@@ -0,0 +1,367 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package accountdeletion
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/entity"
+	sysContext "github.com/thunder-id/thunderid/internal/system/context"
+	"github.com/thunder-id/thunderid/internal/system/cryptolab"
+	"github.com/thunder-id/thunderid/internal/system/email"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/observability/event"
+	"github.com/thunder-id/thunderid/internal/system/security"
+	"github.com/thunder-id/thunderid/internal/system/template"
+	"github.com/thunder-id/thunderid/tests/mocks/emailmock"
+	"github.com/thunder-id/thunderid/tests/mocks/entitymock"
+	"github.com/thunder-id/thunderid/tests/mocks/observability/observabilitymock"
+	"github.com/thunder-id/thunderid/tests/mocks/sysauthzmock"
+	"github.com/thunder-id/thunderid/tests/mocks/templatemock"
+)
+
+const (
+	testUserID = "user-1"
+	testEmail  = "user@example.com"
+	testToken  = "cancellation-token"
+)
+
+type AccountDeletionServiceTestSuite struct {
+	suite.Suite
+	mockStore         *accountDeletionStoreInterfaceMock
+	mockEntity        *entitymock.EntityServiceInterfaceMock
+	mockAuthz         *sysauthzmock.SystemAuthorizationServiceInterfaceMock
+	mockTemplate      *templatemock.TemplateServiceInterfaceMock
+	mockEmail         *emailmock.EmailClientInterfaceMock
+	mockObservability *observabilitymock.ObservabilityServiceInterfaceMock
+	service           *accountDeletionService
+	now               time.Time
+	events            []*event.Event
+}
+
+func TestAccountDeletionServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(AccountDeletionServiceTestSuite))
+}
+
+func (suite *AccountDeletionServiceTestSuite) SetupTest() {
+	suite.mockStore = newAccountDeletionStoreInterfaceMock(suite.T())
+	suite.mockEntity = entitymock.NewEntityServiceInterfaceMock(suite.T())
+	suite.mockAuthz = sysauthzmock.NewSystemAuthorizationServiceInterfaceMock(suite.T())
+	suite.mockTemplate = templatemock.NewTemplateServiceInterfaceMock(suite.T())
+	suite.mockEmail = emailmock.NewEmailClientInterfaceMock(suite.T())
+	suite.mockObservability = observabilitymock.NewObservabilityServiceInterfaceMock(suite.T())
+	suite.now = time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	suite.service = suite.newService(true)
+
+	suite.events = nil
+	suite.mockObservability.On("IsEnabled").Return(true).Maybe()
+	suite.mockObservability.On("PublishEvent", mock.Anything).Run(func(args mock.Arguments) {
+		suite.events = append(suite.events, args.Get(0).(*event.Event))
+	}).Maybe()
+}
+
+func (suite *AccountDeletionServiceTestSuite) newService(disableLogin bool) *accountDeletionService {
+	cancellationURL, _ := url.Parse("https://gate.test/gate/account-deletion/cancel")
+	service := newAccountDeletionService(suite.mockStore, suite.mockEntity, suite.mockAuthz, suite.mockTemplate,
+		suite.mockEmail, suite.mockObservability, "email", 3600, disableLogin,
+		cancellationURL).(*accountDeletionService)
+	service.now = func() time.Time { return suite.now }
+	return service
+}
+
+func (suite *AccountDeletionServiceTestSuite) contextFor(subject string) context.Context {
+	return security.WithSecurityContextTest(context.Background(),
+		security.NewSecurityContextForTest(subject, "", "", nil, nil))
+}
+
+func (suite *AccountDeletionServiceTestSuite) user(state entity.EntityState) *entity.Entity {
+	return &entity.Entity{
+		ID:         testUserID,
+		Category:   entity.EntityCategoryUser,
+		State:      state,
+		Attributes: []byte(`{"email":"` + testEmail + `"}`),
+	}
+}
+
+func (suite *AccountDeletionServiceTestSuite) expectDeletableUser(user *entity.Entity) {
+	suite.mockEntity.On("GetEntity", mock.Anything, testUserID).Return(user, nil).Once()
+	suite.mockEntity.On("IsEntityDeclarative", mock.Anything, testUserID).Return(false, nil).Once()
+	suite.mockStore.On("GetPendingDeletion", mock.Anything, testUserID).
+		Return(nil, ErrPendingAccountDeletionNotFound).Once()
+}
+
+func (suite *AccountDeletionServiceTestSuite) expectCancellationSent() *string {
+	var link string
+	suite.mockTemplate.On("Render", mock.Anything, template.ScenarioAccountDeletion, template.TemplateTypeEmail,
+		mock.Anything).Run(func(args mock.Arguments) {
+		link = args.Get(3).(template.TemplateData)[templateDataKeyCancellationLink]
+	}).Return(&template.RenderedTemplate{Subject: "subject", Body: "body", IsHTML: true}, nil).Once()
+	suite.mockEmail.On("Send", mock.MatchedBy(func(data email.EmailData) bool {
+		return len(data.To) == 1 && data.To[0] == testEmail
+	})).Return(nil).Once()
+	return &link
+}
+
+// tokenOf extracts the cancellation token from an HTML escaped cancellation link.
+func tokenOf(link string) string {
+	parsed, _ := url.Parse(strings.ReplaceAll(link, "&amp;", "&"))
+	return parsed.Query().Get(cancellationTokenParam)
+}
+
+func (suite *AccountDeletionServiceTestSuite) pendingDeletion(loginDisabled bool) *PendingAccountDeletion {
+	deletion := &PendingAccountDeletion{
+		UserID:       testUserID,
+		TokenHash:    cryptolab.HashToken(testToken),
+		CreatedAt:    suite.now.Add(-time.Minute),
+		DeletionTime: suite.now.Add(time.Hour),
+	}
+	if loginDisabled {
+		deletion.PreviousState = string(entity.EntityStateActive)
+		deletion.LoginDisabled = true
+	}
+	return deletion
+}
+
+func (suite *AccountDeletionServiceTestSuite) TestRequestDeletion_DisablesLogin() {
+	suite.expectDeletableUser(suite.user(entity.EntityStateActive))
+	var stored PendingAccountDeletion
+	suite.mockStore.On("CreatePendingDeletion", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		stored = args.Get(1).(PendingAccountDeletion)
+	}).Return(nil).Once()
+	suite.mockEntity.On("UpdateEntity", mock.Anything, testUserID, mock.MatchedBy(func(e *entity.Entity) bool {
+		return e.State == entity.EntityStatePendingDeletion
+	})).Return(nil, nil).Once()
+	link := suite.expectCancellationSent()
+
+	deletion, svcErr := suite.service.RequestDeletion(suite.contextFor(testUserID), testUserID)
+
+	suite.Nil(svcErr)
+	suite.True(deletion.LoginDisabled)
+	suite.Equal(suite.now.Add(time.Hour), deletion.DeletionTime)
+	suite.Equal(string(entity.EntityStateActive), stored.PreviousState)
+	suite.Equal(cryptolab.HashToken(tokenOf(*link)), stored.TokenHash)
+	suite.True(strings.HasPrefix(*link, "https://gate.test/gate/account-deletion/cancel?token="))
+	suite.Require().Len(suite.events, 1)
+	suite.Equal(string(event.EventTypeAccountDeletionRequested), suite.events[0].Type)
+	suite.Equal(testUserID, suite.events[0].Data[event.DataKey.ActorID])
+	suite.Equal(true, suite.events[0].Data[event.DataKey.LoginDisabled])
+}
+
+func (suite *AccountDeletionServiceTestSuite) TestRequestDeletion_KeepsLoginWhenNotConfigured() {
+	suite.service = suite.newService(false)
+	suite.expectDeletableUser(suite.user(entity.EntityStateActive))
+	suite.mockStore.On("CreatePendingDeletion", mock.Anything, mock.MatchedBy(func(d PendingAccountDeletion) bool {
+		return d.PreviousState == "" && !d.LoginDisabled
+	})).Return(nil).Once()
+	suite.expectCancellationSent()
+
+	deletion, svcErr := suite.service.RequestDeletion(context.Background(), testUserID)
+
+	suite.Nil(svcErr)
+	suite.False(deletion.LoginDisabled)
+	suite.mockEntity.AssertNotCalled(suite.T(), "UpdateEntity", mock.Anything, mock.Anything, mock.Anything)
+	suite.Require().Len(suite.events, 1)
+	suite.NotContains(suite.events[0].Data, event.DataKey.LoginDisabled)
+}
+
+func (suite *AccountDeletionServiceTestSuite) TestRequestDeletion_AlreadyRequested() {
+	suite.mockEntity.On("GetEntity", mock.Anything, testUserID).Return(suite.user(entity.EntityStateActive), nil).Once()
+	suite.mockEntity.On("IsEntityDeclarative", mock.Anything, testUserID).Return(false, nil).Once()
+	suite.mockStore.On("GetPendingDeletion", mock.Anything, testUserID).Return(suite.pendingDeletion(false), nil).Once()
+
+	_, svcErr := suite.service.RequestDeletion(context.Background(), testUserID)
+
+	suite.Equal(&ErrorDeletionAlreadyRequested, svcErr)
+}
+
+func (suite *AccountDeletionServiceTestSuite) TestRequestDeletion_DeclarativeUser() {
+	suite.mockEntity.On("GetEntity", mock.Anything, testUserID).Return(suite.user(entity.EntityStateActive), nil).Once()
+	suite.mockEntity.On("IsEntityDeclarative", mock.Anything, testUserID).Return(true, nil).Once()
+
+	_, svcErr := suite.service.RequestDeletion(context.Background(), testUserID)
+
+	suite.Equal(&ErrorAccountNotDeletable, svcErr)
+}
+
+func (suite *AccountDeletionServiceTestSuite) TestRequestDeletion_MissingEmail() {
+	user := suite.user(entity.EntityStateActive)
+	user.Attributes = []byte(`{"username":"user"}`)
+	suite.mockEntity.On("GetEntity", mock.Anything, testUserID).Return(user, nil).Once()
+	suite.mockEntity.On("IsEntityDeclarative", mock.Anything, testUserID).Return(false, nil).Once()
+
+	_, svcErr := suite.service.RequestDeletion(context.Background(), testUserID)
+
+	suite.Equal(&ErrorMissingEmail, svcErr)
+}
+
+func (suite *AccountDeletionServiceTestSuite) TestRequestDeletion_UserNotFound() {
+	suite.mockEntity.On("GetEntity", mock.Anything, testUserID).Return(nil, entity.ErrEntityNotFound).Once()
+
+	_, svcErr := suite.service.RequestDeletion(context.Background(), testUserID)
+
+	suite.Equal(&ErrorUserNotFound, svcErr)
+}
+
+func (suite *AccountDeletionServiceTestSuite) TestRequestDeletion_SendFailureRestoresLogin() {
+	suite.expectDeletableUser(suite.user(entity.EntityStateActive))
+	suite.mockStore.On("CreatePendingDeletion", mock.Anything, mock.Anything).Return(nil).Once()
+	suite.mockEntity.On("UpdateEntity", mock.Anything, testUserID, mock.MatchedBy(func(e *entity.Entity) bool {
+		return e.State == entity.EntityStatePendingDeletion
+	})).Return(nil, nil).Once()
+	suite.mockTemplate.On("Render", mock.Anything, template.ScenarioAccountDeletion, template.TemplateTypeEmail,
+		mock.Anything).Return(&template.RenderedTemplate{Subject: "subject", Body: "body"}, nil).Once()
+	suite.mockEmail.On("Send", mock.Anything).Return(errors.New("smtp down")).Once()
+	suite.mockEntity.On("GetEntity", mock.Anything, testUserID).
+		Return(suite.user(entity.EntityStatePendingDeletion), nil).Once()
+	suite.mockEntity.On("UpdateEntity", mock.Anything, testUserID, mock.MatchedBy(func(e *entity.Entity) bool {
+		return e.State == entity.EntityStateActive
+	})).Return(nil, nil).Once()
+	suite.mockStore.On("DeletePendingDeletion", mock.Anything, testUserID).Return(true, nil).Once()
+
+	_, svcErr := suite.service.RequestDeletion(context.Background(), testUserID)
+
+	suite.Equal(&ErrorCancellationNotSent, svcErr)
+	suite.Empty(suite.events)
+}
+
+func (suite *AccountDeletionServiceTestSuite) TestGetPendingDeletion_NotFound() {
+	suite.mockStore.On("GetPendingDeletion", mock.Anything, testUserID).
+		Return(nil, ErrPendingAccountDeletionNotFound).Once()
+
+	_, svcErr := suite.service.GetPendingDeletion(context.Background(), testUserID)
+
+	suite.Equal(&ErrorPendingDeletionNotFound, svcErr)
+}
+
+func (suite *AccountDeletionServiceTestSuite) TestCancelDeletionByToken_RestoresLogin() {
+	suite.mockStore.On("GetPendingDeletionByTokenHash", mock.Anything, cryptolab.HashToken(testToken)).
+		Return(suite.pendingDeletion(true), nil).Once()
+	suite.mockStore.On("DeletePendingDeletion", mock.Anything, testUserID).Return(true, nil).Once()
+	suite.mockEntity.On("GetEntity", mock.Anything, testUserID).
+		Return(suite.user(entity.EntityStatePendingDeletion), nil).Once()
+	suite.mockEntity.On("UpdateEntity", mock.Anything, testUserID, mock.MatchedBy(func(e *entity.Entity) bool {
+		return e.State == entity.EntityStateActive
+	})).Return(nil, nil).Once()
+
+	svcErr := suite.service.CancelDeletionByToken(context.Background(), testToken)
+
+	suite.Nil(svcErr)
+	suite.Require().Len(suite.events, 1)
+	suite.Equal(string(event.EventTypeAccountDeletionCancelled), suite.events[0].Type)
+	suite.Equal(cancelReasonLink, suite.events[0].Data[event.DataKey.Reason])
+}
+
+func (suite *AccountDeletionServiceTestSuite) TestCancelDeletionByToken_GracePeriodEnded() {
+	deletion := suite.pendingDeletion(true)
+	deletion.DeletionTime = suite.now
+	suite.mockStore.On("GetPendingDeletionByTokenHash", mock.Anything, cryptolab.HashToken(testToken)).
+		Return(deletion, nil).Once()
+
+	svcErr := suite.service.CancelDeletionByToken(context.Background(), testToken)
+
+	suite.Equal(&ErrorInvalidCancellationToken, svcErr)
+	suite.mockStore.AssertNotCalled(suite.T(), "DeletePendingDeletion", mock.Anything, mock.Anything)
+}
+
+func (suite *AccountDeletionServiceTestSuite) TestCancelDeletionByToken_UnknownToken() {
+	suite.mockStore.On("GetPendingDeletionByTokenHash", mock.Anything, mock.Anything).
+		Return(nil, ErrPendingAccountDeletionNotFound).Once()
+
+	svcErr := suite.service.CancelDeletionByToken(context.Background(), "unknown")
+
+	suite.Equal(&ErrorInvalidCancellationToken, svcErr)
+}
+
+func (suite *AccountDeletionServiceTestSuite) TestCancelDeletion_LeavesChangedStateAlone() {
+	suite.mockStore.On("GetPendingDeletion", mock.Anything, testUserID).Return(suite.pendingDeletion(true), nil).Once()
+	suite.mockStore.On("DeletePendingDeletion", mock.Anything, testUserID).Return(true, nil).Once()
+	suite.mockEntity.On("GetEntity", mock.Anything, testUserID).
+		Return(suite.user(entity.EntityStatePendingVerification), nil).Once()
+
+	svcErr := suite.service.CancelDeletion(context.Background(), testUserID)
+
+	suite.Nil(svcErr)
+	suite.mockEntity.AssertNotCalled(suite.T(), "UpdateEntity", mock.Anything, mock.Anything, mock.Anything)
+	suite.Require().Len(suite.events, 1)
+	suite.Equal(cancelReasonUser, suite.events[0].Data[event.DataKey.Reason])
+}
+
+func (suite *AccountDeletionServiceTestSuite) TestPurgeDue() {
+	due := []PendingAccountDeletion{
+		{UserID: testUserID, DeletionTime: suite.now.Add(-time.Minute)},
+		{UserID: "user-2", DeletionTime: suite.now.Add(-time.Minute)},
+	}
+	suite.mockStore.On("ListDueDeletions", mock.Anything, suite.now, purgeBatchSize).Return(due, nil).Once()
+	suite.mockEntity.On("DeleteEntity", mock.Anything, testUserID).Return(nil).Once()
+	suite.mockEntity.On("DeleteEntity", mock.Anything, "user-2").Return(entity.ErrEntityNotFound).Once()
+	suite.mockAuthz.On("DeleteResourceRelationships", mock.Anything, security.ResourceTypeUser, testUserID).Once()
+	suite.mockStore.On("DeletePendingDeletion", mock.Anything, testUserID).Return(true, nil).Once()
+	suite.mockStore.On("DeletePendingDeletion", mock.Anything, "user-2").Return(true, nil).Once()
+
+	purged, err := suite.service.PurgeDue(context.Background())
+
+	suite.NoError(err)
+	suite.Equal(1, purged)
+	suite.Require().Len(suite.events, 1)
+	suite.Equal(string(event.EventTypeAccountDeletionCompleted), suite.events[0].Type)
+	suite.Equal(testUserID, suite.events[0].Data[event.DataKey.UserID])
+}
+
+func (suite *AccountDeletionServiceTestSuite) TestPurgeDue_DeleteFailure() {
+	due := []PendingAccountDeletion{{UserID: testUserID, DeletionTime: suite.now.Add(-time.Minute)}}
+	suite.mockStore.On("ListDueDeletions", mock.Anything, suite.now, purgeBatchSize).Return(due, nil).Once()
+	suite.mockEntity.On("DeleteEntity", mock.Anything, testUserID).Return(errors.New("db down")).Once()
+
+	purged, err := suite.service.PurgeDue(context.Background())
+
+	suite.Error(err)
+	suite.Equal(0, purged)
+	suite.mockStore.AssertNotCalled(suite.T(), "DeletePendingDeletion", mock.Anything, mock.Anything)
+}
+
+func (suite *AccountDeletionServiceTestSuite) TestRequestDeletion_NoEmailClient() {
+	cancellationURL, _ := url.Parse("https://gate.test/gate/account-deletion/cancel")
+	service := newAccountDeletionService(suite.mockStore, suite.mockEntity, suite.mockAuthz, suite.mockTemplate,
+		nil, nil, "email", 3600, true, cancellationURL)
+	suite.mockEntity.On("GetEntity", mock.Anything, testUserID).Return(suite.user(entity.EntityStateActive), nil).Once()
+	suite.mockEntity.On("IsEntityDeclarative", mock.Anything, testUserID).Return(false, nil).Once()
+
+	_, svcErr := service.RequestDeletion(context.Background(), testUserID)
+
+	suite.Equal(&ErrorCancellationNotSent, svcErr)
+	suite.Equal(serviceerror.ServerErrorType, svcErr.Type)
+}
+
+func (suite *AccountDeletionServiceTestSuite) TestScheduledPurge_PurgesInTheContextPartition() {
+	ctx := sysContext.WithTenantID(context.Background(), "tenant-1")
+	mockService := NewAccountDeletionServiceInterfaceMock(suite.T())
+	mockService.On("PurgeDue", ctx).Return(2, nil).Once()
+
+	err := newScheduledPurge(mockService)(ctx)
+
+	suite.NoError(err)
+}
@@ -0,0 +1,191 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package accountdeletion
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+	sysContext "github.com/thunder-id/thunderid/internal/system/context"
+	"github.com/thunder-id/thunderid/internal/system/database/provider"
+	dbutils "github.com/thunder-id/thunderid/internal/system/database/utils"
+)
+
+var getDBProvider = provider.GetDBProvider
+
+// accountDeletionStoreInterface defines the interface for pending account deletion store operations.
+type accountDeletionStoreInterface interface {
+	CreatePendingDeletion(ctx context.Context, deletion PendingAccountDeletion) error
+	GetPendingDeletion(ctx context.Context, userID string) (*PendingAccountDeletion, error)
+	GetPendingDeletionByTokenHash(ctx context.Context, tokenHash string) (*PendingAccountDeletion, error)
+	ListDueDeletions(ctx context.Context, dueBy time.Time, limit int) ([]PendingAccountDeletion, error)
+	DeletePendingDeletion(ctx context.Context, userID string) (bool, error)
+}
+
+// accountDeletionStore is the runtime database backed implementation of accountDeletionStoreInterface.
+type accountDeletionStore struct {
+	dbProvider   provider.DBProviderInterface
+	deploymentID string
+}
+
+// newAccountDeletionStore creates a new instance of accountDeletionStore.
+func newAccountDeletionStore() accountDeletionStoreInterface {
+	return &accountDeletionStore{
+		dbProvider:   getDBProvider(),
+		deploymentID: config.GetServerRuntime().Config.Server.Identifier,
+	}
+}
+
+// CreatePendingDeletion persists a new pending account deletion.
+func (s *accountDeletionStore) CreatePendingDeletion(ctx context.Context, deletion PendingAccountDeletion) error {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
+	dbClient, err := s.dbProvider.GetRuntimeDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	var previousState interface{}
+	if deletion.PreviousState != "" {
+		previousState = deletion.PreviousState
+	}
+	if _, err := dbClient.ExecuteContext(ctx, queryCreatePendingAccountDeletion, deletion.UserID,
+		deletion.TokenHash, previousState, deletion.CreatedAt, deletion.DeletionTime, deploymentID); err != nil {
+		return fmt.Errorf("failed to execute query: %w", err)
+	}
+	return nil
+}
+
+// GetPendingDeletion retrieves the pending account deletion of a user.
+func (s *accountDeletionStore) GetPendingDeletion(
+	ctx context.Context, userID string,
+) (*PendingAccountDeletion, error) {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
+	dbClient, err := s.dbProvider.GetRuntimeDBClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	results, err := dbClient.QueryContext(ctx, queryGetPendingAccountDeletion, userID, deploymentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+	if len(results) == 0 {
+		return nil, ErrPendingAccountDeletionNotFound
+	}
+
+	return buildPendingAccountDeletionFromResultRow(results[0])
+}
+
+// GetPendingDeletionByTokenHash retrieves the pending account deletion holding the given cancellation
+// token hash.
+func (s *accountDeletionStore) GetPendingDeletionByTokenHash(
+	ctx context.Context, tokenHash string,
+) (*PendingAccountDeletion, error) {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
+	dbClient, err := s.dbProvider.GetRuntimeDBClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	results, err := dbClient.QueryContext(ctx, queryGetPendingAccountDeletionByTokenHash, tokenHash, deploymentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+	if len(results) == 0 {
+		return nil, ErrPendingAccountDeletionNotFound
+	}
+
+	return buildPendingAccountDeletionFromResultRow(results[0])
+}
+
+// ListDueDeletions lists up to limit pending account deletions that are due by the given time, earliest
+// first.
+func (s *accountDeletionStore) ListDueDeletions(
+	ctx context.Context, dueBy time.Time, limit int,
+) ([]PendingAccountDeletion, error) {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
+	dbClient, err := s.dbProvider.GetRuntimeDBClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	results, err := dbClient.QueryContext(ctx, queryListDueAccountDeletions, dueBy, deploymentID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	deletions := make([]PendingAccountDeletion, 0, len(results))
+	for _, row := range results {
+		deletion, err := buildPendingAccountDeletionFromResultRow(row)
+		if err != nil {
+			return nil, err
+		}
+		deletions = append(deletions, *deletion)
+	}
+	return deletions, nil
+}
+
+// DeletePendingDeletion deletes the pending account deletion of a user. It reports whether a deletion was
+// removed.
+func (s *accountDeletionStore) DeletePendingDeletion(ctx context.Context, userID string) (bool, error) {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
+	dbClient, err := s.dbProvider.GetRuntimeDBClient()
+	if err != nil {
+		return false, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	rowsAffected, err := dbClient.ExecuteContext(ctx, queryDeletePendingAccountDeletion, userID, deploymentID)
+	if err != nil {
+		return false, fmt.Errorf("failed to execute query: %w", err)
+	}
+	return rowsAffected > 0, nil
+}
+
+// buildPendingAccountDeletionFromResultRow constructs a PendingAccountDeletion from a database result row.
+func buildPendingAccountDeletionFromResultRow(row map[string]interface{}) (*PendingAccountDeletion, error) {
+	userID, ok := row["user_id"].(string)
+	if !ok {
+		return nil, fmt.Errorf("failed to parse user_id as string")
+	}
+	tokenHash, ok := row["token_hash"].(string)
+	if !ok {
+		return nil, fmt.Errorf("failed to parse token_hash as string")
+	}
+	previousState, _ := row["previous_state"].(string)
+
+	createdAt, err := dbutils.ParseTimeField(row["created_at"], "created_at")
+	if err != nil {
+		return nil, err
+	}
+	deletionTime, err := dbutils.ParseTimeField(row["deletion_time"], "deletion_time")
+	if err != nil {
+		return nil, err
+	}
+
+	return &PendingAccountDeletion{
+		UserID:        userID,
+		TokenHash:     tokenHash,
+		PreviousState: previousState,
+		LoginDisabled: previousState != "",
+		CreatedAt:     createdAt,
+		DeletionTime:  deletionTime,
+	}, nil
+}
@@ -0,0 +1,59 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package accountdeletion
+
+import dbmodel "github.com/thunder-id/thunderid/internal/system/database/model"
+
+var (
+	// queryCreatePendingAccountDeletion inserts a new pending account deletion.
+	queryCreatePendingAccountDeletion = dbmodel.DBQuery{
+		ID: "ADQ-ACCOUNT_DELETION-01",
+		Query: `INSERT INTO "PENDING_ACCOUNT_DELETION" (USER_ID, TOKEN_HASH, PREVIOUS_STATE, CREATED_AT, ` +
+			`DELETION_TIME, DEPLOYMENT_ID) VALUES ($1, $2, $3, $4, $5, $6)`,
+	}
+
+	// queryGetPendingAccountDeletion retrieves the pending account deletion of a user.
+	queryGetPendingAccountDeletion = dbmodel.DBQuery{
+		ID: "ADQ-ACCOUNT_DELETION-02",
+		Query: `SELECT USER_ID, TOKEN_HASH, PREVIOUS_STATE, CREATED_AT, DELETION_TIME ` +
+			`FROM "PENDING_ACCOUNT_DELETION" WHERE USER_ID = $1 AND DEPLOYMENT_ID = $2`,
+	}
+
+	// queryGetPendingAccountDeletionByTokenHash retrieves the pending account deletion a cancellation token
+	// belongs to.
+	queryGetPendingAccountDeletionByTokenHash = dbmodel.DBQuery{
+		ID: "ADQ-ACCOUNT_DELETION-03",
+		Query: `SELECT USER_ID, TOKEN_HASH, PREVIOUS_STATE, CREATED_AT, DELETION_TIME ` +
+			`FROM "PENDING_ACCOUNT_DELETION" WHERE TOKEN_HASH = $1 AND DEPLOYMENT_ID = $2`,
+	}
+
+	// queryListDueAccountDeletions lists the pending account deletions whose grace period has ended.
+	queryListDueAccountDeletions = dbmodel.DBQuery{
+		ID: "ADQ-ACCOUNT_DELETION-04",
+		Query: `SELECT USER_ID, TOKEN_HASH, PREVIOUS_STATE, CREATED_AT, DELETION_TIME ` +
+			`FROM "PENDING_ACCOUNT_DELETION" WHERE DELETION_TIME <= $1 AND DEPLOYMENT_ID = $2 ` +
+			`ORDER BY DELETION_TIME LIMIT $3`,
+	}
+
+	// queryDeletePendingAccountDeletion deletes the pending account deletion of a user.
+	queryDeletePendingAccountDeletion = dbmodel.DBQuery{
+		ID:    "ADQ-ACCOUNT_DELETION-05",
+		Query: `DELETE FROM "PENDING_ACCOUNT_DELETION" WHERE USER_ID = $1 AND DEPLOYMENT_ID = $2`,
+	}
+)
@@ -0,0 +1,56 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package accountprotection
+
+const (
+	// loggerComponentName is the component name used in account protection logs.
+	loggerComponentName = "AccountProtectionService"
+	// handlerLoggerComponentName is the component name used in account protection handler logs.
+	handlerLoggerComponentName = "AccountProtectionHandler"
+
+	// defaultRevertPath is the path of the revert page, relative to the gate client login page.
+	defaultRevertPath = "account-protection/revert"
+	// revertTokenParam is the query parameter of the revert link holding the revert token.
+	revertTokenParam = "token"
+	// revertTokenAudience is the audience of revert tokens, which keeps other tokens from being used to
+	// revert a change.
+	revertTokenAudience = "account-protection-revert"
+
+	// claimPreviousEmail is the revert token claim holding the email address the change replaced.
+	claimPreviousEmail = "previous_email"
+	// claimNewEmail is the revert token claim holding the email address the change applied.
+	claimNewEmail = "new_email"
+
+	// templateDataKeyNewEmail is the template data key holding the new email address.
+	templateDataKeyNewEmail = "newEmail"
+	// templateDataKeyRevertLink is the template data key holding the revert link.
+	templateDataKeyRevertLink = "revertLink"
+	// templateDataKeyExpiryTime is the template data key holding the expiry time of the revert link.
+	templateDataKeyExpiryTime = "expiryTime"
+)
+
+// ChangeType represents a high-risk change of a user account.
+type ChangeType string
+
+const (
+	// ChangeTypeCredential is a change of the password of the user.
+	ChangeTypeCredential ChangeType = "credential"
+	// ChangeTypeEmail is a change of the email address of the user.
+	ChangeTypeEmail ChangeType = "email"
+)
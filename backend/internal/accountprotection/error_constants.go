@@ -0,0 +1,106 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package accountprotection
+
+import (
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/i18n/core"
+)
+
+// Client errors for account protection operations.
+var (
+	// ErrorStepUpRequired is the error returned when the user has not authenticated recently enough to make the change.
+	ErrorStepUpRequired = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "ACP-1001",
+		Error: core.I18nMessage{
+			Key:          "error.accountprotectionservice.step_up_required",
+			DefaultValue: "Re-authentication required",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.accountprotectionservice.step_up_required_description",
+			DefaultValue: "Sign in again to change your password or email address",
+		},
+	}
+	// ErrorChangeOnHold is the error returned when the change is held after a password reset.
+	ErrorChangeOnHold = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "ACP-1002",
+		Error: core.I18nMessage{
+			Key:          "error.accountprotectionservice.change_on_hold",
+			DefaultValue: "Change on hold",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.accountprotectionservice.change_on_hold_description",
+			DefaultValue: "Password and email address changes are on hold for a while after a password reset",
+		},
+	}
+	// ErrorInvalidRevertToken is the error returned when a revert token is invalid or expired.
+	ErrorInvalidRevertToken = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "ACP-1003",
+		Error: core.I18nMessage{
+			Key:          "error.accountprotectionservice.invalid_revert_token",
+			DefaultValue: "Invalid revert token",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.accountprotectionservice.invalid_revert_token_description",
+			DefaultValue: "The revert token is invalid or has expired",
+		},
+	}
+	// ErrorChangeNotRevertible is the error returned when the email address was changed again after the change to revert.
+	ErrorChangeNotRevertible = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "ACP-1004",
+		Error: core.I18nMessage{
+			Key:          "error.accountprotectionservice.change_not_revertible",
+			DefaultValue: "Change cannot be reverted",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.accountprotectionservice.change_not_revertible_description",
+			DefaultValue: "The email address of the user no longer matches the change to revert",
+		},
+	}
+	// ErrorEmailAlreadyInUse is the error returned when the previous email address is taken by another user.
+	ErrorEmailAlreadyInUse = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "ACP-1005",
+		Error: core.I18nMessage{
+			Key:          "error.accountprotectionservice.email_already_in_use",
+			DefaultValue: "Email address already in use",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.accountprotectionservice.email_already_in_use_description",
+			DefaultValue: "The previous email address is now used by another user",
+		},
+	}
+	// ErrorInvalidRequestFormat is the error returned when the request body is malformed.
+	ErrorInvalidRequestFormat = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "ACP-1006",
+		Error: core.I18nMessage{
+			Key:          "error.accountprotectionservice.invalid_request_format",
+			DefaultValue: "Invalid request format",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.accountprotectionservice.invalid_request_format_description",
+			DefaultValue: "The request body is malformed or contains invalid data",
+		},
+	}
+)
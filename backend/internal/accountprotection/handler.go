@@ -0,0 +1,68 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package accountprotection
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/thunder-id/thunderid/internal/system/log"
+	sysutils "github.com/thunder-id/thunderid/internal/system/utils"
+)
+
+// accountProtectionHandler is the handler for account protection operations.
+type accountProtectionHandler struct {
+	accountProtectionService AccountProtectionServiceInterface
+}
+
+// newAccountProtectionHandler creates a new instance of accountProtectionHandler.
+func newAccountProtectionHandler(accountProtectionService AccountProtectionServiceInterface) *accountProtectionHandler {
+	return &accountProtectionHandler{
+		accountProtectionService: accountProtectionService,
+	}
+}
+
+// HandleRevertRequest handles the request to revert an email address change with a revert token.
+func (h *accountProtectionHandler) HandleRevertRequest(w http.ResponseWriter, r *http.Request) {
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, handlerLoggerComponentName))
+
+	request, err := sysutils.DecodeJSONBody[RevertRequest](r)
+	if err != nil {
+		sysutils.WriteServiceErrorResponse(w, &ErrorInvalidRequestFormat, clientErrorStatusCodes)
+		return
+	}
+
+	if svcErr := h.accountProtectionService.RevertEmailChange(r.Context(),
+		strings.TrimSpace(request.Token)); svcErr != nil {
+		sysutils.WriteServiceErrorResponse(w, svcErr, clientErrorStatusCodes)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+	logger.Debug("Email change revert response sent")
+}
+
+// clientErrorStatusCodes maps the client errors of the handler to HTTP status codes. Other client errors are
+// returned with 400 Bad Request.
+var clientErrorStatusCodes = map[string]int{
+	ErrorChangeNotRevertible.Code: http.StatusConflict,
+	ErrorEmailAlreadyInUse.Code:   http.StatusConflict,
+	ErrorChangeOnHold.Code:        http.StatusConflict,
+	ErrorStepUpRequired.Code:      http.StatusForbidden,
+}
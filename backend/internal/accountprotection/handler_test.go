@@ -0,0 +1,91 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package accountprotection
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/system/error/apierror"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+)
+
+type HandlerTestSuite struct {
+	suite.Suite
+	mockService *AccountProtectionServiceInterfaceMock
+	handler     *accountProtectionHandler
+}
+
+func TestHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(HandlerTestSuite))
+}
+
+func (s *HandlerTestSuite) SetupTest() {
+	s.mockService = NewAccountProtectionServiceInterfaceMock(s.T())
+	s.handler = newAccountProtectionHandler(s.mockService)
+}
+
+func (s *HandlerTestSuite) revert(body string) *httptest.ResponseRecorder {
+	rr := httptest.NewRecorder()
+	s.handler.HandleRevertRequest(rr, httptest.NewRequest(http.MethodPost, "/account-protection/revert",
+		strings.NewReader(body)))
+	return rr
+}
+
+func (s *HandlerTestSuite) TestHandleRevertRequest() {
+	s.mockService.On("RevertEmailChange", mock.Anything, "revert-token").Return(nil).Once()
+
+	rr := s.revert(`{"token":" revert-token "}`)
+
+	s.Equal(http.StatusNoContent, rr.Code)
+}
+
+func (s *HandlerTestSuite) TestHandleRevertRequest_InvalidBody() {
+	rr := s.revert(`{`)
+
+	s.Equal(http.StatusBadRequest, rr.Code)
+	var body apierror.ErrorResponse
+	s.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &body))
+	s.Equal(ErrorInvalidRequestFormat.Code, body.Code)
+}
+
+func (s *HandlerTestSuite) TestHandleRevertRequest_ErrorStatuses() {
+	cases := []struct {
+		err    *serviceerror.ServiceError
+		status int
+	}{
+		{&ErrorInvalidRevertToken, http.StatusBadRequest},
+		{&ErrorChangeNotRevertible, http.StatusConflict},
+		{&ErrorEmailAlreadyInUse, http.StatusConflict},
+		{&serviceerror.InternalServerError, http.StatusInternalServerError},
+	}
+	for _, tc := range cases {
+		s.mockService.On("RevertEmailChange", mock.Anything, "revert-token").Return(tc.err).Once()
+
+		rr := s.revert(`{"token":"revert-token"}`)
+
+		s.Equal(tc.status, rr.Code, tc.err.Code)
+	}
+}
@@ -0,0 +1,88 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package accountprotection
+
+import (
+	"net/http"
+	"net/url"
+
+	"github.com/thunder-id/thunderid/internal/entity"
+	"github.com/thunder-id/thunderid/internal/securitynotification"
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/jose/jwt"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/internal/system/middleware"
+)
+
+// Initialize initializes the account protection service and registers its routes. It returns nil when
+// account protection is disabled. The security notifier may be nil when security notifications are disabled.
+func Initialize(
+	mux *http.ServeMux,
+	entityService entity.EntityServiceInterface,
+	jwtService jwt.JWTServiceInterface,
+	securityNotifier securitynotification.SecurityNotificationServiceInterface,
+) AccountProtectionServiceInterface {
+	runtime := config.GetServerRuntime()
+	protectionConfig := runtime.Config.AccountProtection
+	if !protectionConfig.Enabled {
+		return nil
+	}
+	if securityNotifier == nil {
+		log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName)).Warn(
+			"Security notifications are disabled, users will not be notified of account changes")
+	}
+
+	accountProtectionService := newAccountProtectionService(newAccountProtectionStore(), entityService,
+		jwtService, securityNotifier, protectionConfig.EmailAttribute, protectionConfig.StepUpMaxAge,
+		protectionConfig.ResetCooldown, protectionConfig.RevertWindow, getRevertURL(runtime),
+		runtime.Config.JWT.Issuer)
+
+	accountProtectionHandler := newAccountProtectionHandler(accountProtectionService)
+	registerRoutes(mux, accountProtectionHandler)
+
+	return accountProtectionService
+}
+
+// getRevertURL returns the configured revert page URL, or the email change revert page of the gate client
+// when none is configured.
+func getRevertURL(runtime *config.ServerRuntime) *url.URL {
+	if configured := runtime.Config.AccountProtection.RevertURL; configured != "" {
+		// The URL is validated when the configuration is loaded.
+		if parsed, err := url.Parse(configured); err == nil {
+			return parsed
+		}
+	}
+	return runtime.GateClientLoginURL.ResolveReference(&url.URL{Path: defaultRevertPath})
+}
+
+// registerRoutes registers the routes for account protection operations.
+func registerRoutes(mux *http.ServeMux, accountProtectionHandler *accountProtectionHandler) {
+	opts := middleware.CORSOptions{
+		AllowedMethods:   []string{"POST"},
+		AllowedHeaders:   middleware.DefaultAllowedHeaders,
+		AllowCredentials: true,
+		MaxAge:           600,
+	}
+	mux.HandleFunc(middleware.WithCORS("POST /account-protection/revert",
+		accountProtectionHandler.HandleRevertRequest, opts))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS /account-protection/revert",
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, opts))
+}
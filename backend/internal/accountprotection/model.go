@@ -0,0 +1,36 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package accountprotection protects user accounts against takeover through changes of their password and
+// email address. It requires a recent authentication for such changes, holds them for a while after a
+// password reset, and lets the user revert an email address change from the previous address.
+package accountprotection
+
+import "time"
+
+// ChangeHold represents a hold on the high-risk changes a user can make to their own account.
+type ChangeHold struct {
+	UserID    string
+	CreatedAt time.Time
+	ExpiresAt time.Time
+}
+
+// RevertRequest represents the request to revert an email address change.
+type RevertRequest struct {
+	Token string `json:"token"`
+}
@@ -0,0 +1,304 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package accountprotection
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/url"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/entity"
+	"github.com/thunder-id/thunderid/internal/securitynotification"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/jose/jwt"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/internal/system/security"
+	"github.com/thunder-id/thunderid/internal/system/template"
+)
+
+// claimAuthTime is the access token claim holding the time the user authenticated at.
+const claimAuthTime = "auth_time"
+
+// AccountProtectionServiceInterface defines the interface for the account protection service.
+type AccountProtectionServiceInterface interface {
+	GetEmailAttribute() string
+	CheckChangeAllowed(ctx context.Context, userID string, change ChangeType) *serviceerror.ServiceError
+	RecordPasswordReset(ctx context.Context, userID string)
+	NotifyCredentialChanged(ctx context.Context, userID string)
+	NotifyEmailChanged(ctx context.Context, userID, previousEmail, newEmail string)
+	RevertEmailChange(ctx context.Context, token string) *serviceerror.ServiceError
+}
+
+// accountProtectionService is the default implementation of the AccountProtectionServiceInterface.
+type accountProtectionService struct {
+	store            accountProtectionStoreInterface
+	entityService    entity.EntityServiceInterface
+	jwtService       jwt.JWTServiceInterface
+	securityNotifier securitynotification.SecurityNotificationServiceInterface
+	emailAttribute   string
+	stepUpMaxAge     int64
+	resetCooldown    int64
+	revertWindow     int64
+	revertURL        *url.URL
+	issuer           string
+	now              func() time.Time
+	logger           *log.Logger
+}
+
+// newAccountProtectionService creates a new instance of accountProtectionService. The security notifier may
+// be nil when security notifications are disabled, in which case changes are not notified.
+func newAccountProtectionService(
+	store accountProtectionStoreInterface,
+	entityService entity.EntityServiceInterface,
+	jwtService jwt.JWTServiceInterface,
+	securityNotifier securitynotification.SecurityNotificationServiceInterface,
+	emailAttribute string,
+	stepUpMaxAge, resetCooldown, revertWindow int64,
+	revertURL *url.URL,
+	issuer string,
+) AccountProtectionServiceInterface {
+	return &accountProtectionService{
+		store:            store,
+		entityService:    entityService,
+		jwtService:       jwtService,
+		securityNotifier: securityNotifier,
+		emailAttribute:   emailAttribute,
+		stepUpMaxAge:     stepUpMaxAge,
+		resetCooldown:    resetCooldown,
+		revertWindow:     revertWindow,
+		revertURL:        revertURL,
+		issuer:           issuer,
+		now:              time.Now,
+		logger:           log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName)),
+	}
+}
+
+// GetEmailAttribute returns the user attribute holding the email address.
+func (s *accountProtectionService) GetEmailAttribute() string {
+	return s.emailAttribute
+}
+
+// CheckChangeAllowed checks whether the user may make a high-risk change to their own account. The user must
+// have authenticated recently, and the account must not be held after a password reset. Changes made by
+// anyone other than the user, such as administrators, are not checked.
+func (s *accountProtectionService) CheckChangeAllowed(
+	ctx context.Context, userID string, change ChangeType,
+) *serviceerror.ServiceError {
+	if userID == "" || security.GetSubject(ctx) != userID {
+		return nil
+	}
+	logger := s.logger.With(log.MaskedString(log.LoggerKeyUserID, userID), log.String("change", string(change)))
+	now := s.now().UTC()
+
+	if s.stepUpMaxAge > 0 {
+		authTime, ok := getAuthTime(ctx)
+		if !ok || now.Sub(authTime) > time.Duration(s.stepUpMaxAge)*time.Second {
+			logger.Debug("User has not authenticated recently enough to make the change")
+			return &ErrorStepUpRequired
+		}
+	}
+
+	if s.resetCooldown > 0 {
+		hold, err := s.store.GetChangeHold(ctx, userID)
+		if err != nil && !errors.Is(err, errChangeHoldNotFound) {
+			logger.Error("Failed to retrieve the change hold of the user", log.Error(err))
+			return &serviceerror.InternalServerError
+		}
+		if hold != nil && now.Before(hold.ExpiresAt) {
+			logger.Debug("Change is on hold after a password reset")
+			return &ErrorChangeOnHold
+		}
+	}
+	return nil
+}
+
+// RecordPasswordReset holds the high-risk changes of the user for the cooldown period after a password
+// reset. A failure is logged, so that the reset itself never fails because of it.
+func (s *accountProtectionService) RecordPasswordReset(ctx context.Context, userID string) {
+	if s.resetCooldown <= 0 || userID == "" {
+		return
+	}
+	s.placeHold(ctx, userID)
+}
+
+// placeHold holds the high-risk changes of the user for the cooldown period.
+func (s *accountProtectionService) placeHold(ctx context.Context, userID string) {
+	now := s.now().UTC()
+	if err := s.store.SetChangeHold(ctx, ChangeHold{
+		UserID:    userID,
+		CreatedAt: now,
+		ExpiresAt: now.Add(time.Duration(s.resetCooldown) * time.Second),
+	}); err != nil {
+		s.logger.Error("Failed to hold the changes of the user", log.MaskedString(log.LoggerKeyUserID, userID),
+			log.Error(err))
+		return
+	}
+	s.logger.Debug("Held the changes of the user", log.MaskedString(log.LoggerKeyUserID, userID))
+}
+
+// NotifyCredentialChanged notifies the user over every channel that their password was changed.
+func (s *accountProtectionService) NotifyCredentialChanged(ctx context.Context, userID string) {
+	if s.securityNotifier == nil {
+		return
+	}
+	s.securityNotifier.NotifyAccountChange(ctx, securitynotification.AccountChange{
+		UserID:   userID,
+		Scenario: template.ScenarioCredentialChanged,
+	})
+}
+
+// NotifyEmailChanged notifies the user over every channel that their email address was changed. The
+// previous address also receives a link that reverts the change, when revert links are enabled.
+func (s *accountProtectionService) NotifyEmailChanged(ctx context.Context, userID, previousEmail, newEmail string) {
+	if s.securityNotifier == nil {
+		return
+	}
+
+	change := securitynotification.AccountChange{
+		UserID:                userID,
+		Scenario:              template.ScenarioEmailChanged,
+		PreviousEmail:         previousEmail,
+		PreviousEmailScenario: template.ScenarioEmailChanged,
+		Data:                  template.TemplateData{templateDataKeyNewEmail: newEmail},
+	}
+	if previousEmail != "" && s.revertWindow > 0 {
+		if link, expiresAt, ok := s.buildRevertLink(ctx, userID, previousEmail, newEmail); ok {
+			change.PreviousEmailScenario = template.ScenarioEmailChangeRevert
+			change.Data[templateDataKeyRevertLink] = link
+			change.Data[templateDataKeyExpiryTime] = expiresAt.Format(time.RFC1123)
+		}
+	}
+	s.securityNotifier.NotifyAccountChange(ctx, change)
+}
+
+// buildRevertLink builds the link that reverts the email address change, along with its expiry time.
+func (s *accountProtectionService) buildRevertLink(ctx context.Context, userID, previousEmail,
+	newEmail string) (string, time.Time, bool) {
+	token, iat, svcErr := s.jwtService.GenerateJWT(ctx, userID, s.issuer, s.revertWindow,
+		map[string]interface{}{
+			"aud":              revertTokenAudience,
+			claimPreviousEmail: previousEmail,
+			claimNewEmail:      newEmail,
+		}, jwt.TokenTypeRevertToken, "")
+	if svcErr != nil {
+		s.logger.Warn("Failed to generate the email change revert token",
+			log.MaskedString(log.LoggerKeyUserID, userID), log.String("error", svcErr.Code))
+		return "", time.Time{}, false
+	}
+
+	link := *s.revertURL
+	query := link.Query()
+	query.Set(revertTokenParam, token)
+	link.RawQuery = query.Encode()
+	return link.String(), time.Unix(iat+s.revertWindow, 0).UTC(), true
+}
+
+// RevertEmailChange restores the previous email address of the user from a revert token, as long as the
+// email address of the user is still the one the change applied. The account is then held as after a
+// password reset, as whoever made the change may still be able to sign in.
+// Possession of the token is the proof of ownership of the previous address, so the caller needs no
+// authorization.
+func (s *accountProtectionService) RevertEmailChange(ctx context.Context, token string) *serviceerror.ServiceError {
+	if token == "" {
+		return &ErrorInvalidRevertToken
+	}
+	if svcErr := s.jwtService.VerifyJWT(token, revertTokenAudience, s.issuer); svcErr != nil {
+		s.logger.Debug("Invalid email change revert token", log.String("error", svcErr.Code))
+		return &ErrorInvalidRevertToken
+	}
+	header, payload, err := jwt.DecodeJWT(token)
+	if err != nil || header["typ"] != jwt.TokenTypeRevertToken {
+		return &ErrorInvalidRevertToken
+	}
+	userID, _ := payload["sub"].(string)
+	previousEmail, _ := payload[claimPreviousEmail].(string)
+	newEmail, _ := payload[claimNewEmail].(string)
+	if userID == "" || previousEmail == "" || newEmail == "" {
+		return &ErrorInvalidRevertToken
+	}
+	logger := s.logger.With(log.MaskedString(log.LoggerKeyUserID, userID))
+
+	userEntity, err := s.entityService.GetEntity(ctx, userID)
+	if err != nil {
+		if errors.Is(err, entity.ErrEntityNotFound) {
+			return &ErrorInvalidRevertToken
+		}
+		logger.Error("Failed to retrieve user", log.Error(err))
+		return &serviceerror.InternalServerError
+	}
+	if userEntity.Category != entity.EntityCategoryUser {
+		return &ErrorInvalidRevertToken
+	}
+
+	attributes := map[string]interface{}{}
+	if len(userEntity.Attributes) > 0 {
+		if err := json.Unmarshal(userEntity.Attributes, &attributes); err != nil {
+			logger.Error("Failed to parse user attributes", log.Error(err))
+			return &serviceerror.InternalServerError
+		}
+	}
+	if currentEmail, _ := attributes[s.emailAttribute].(string); currentEmail != newEmail {
+		return &ErrorChangeNotRevertible
+	}
+	attributes[s.emailAttribute] = previousEmail
+	updatedAttributes, err := json.Marshal(attributes)
+	if err != nil {
+		logger.Error("Failed to marshal user attributes", log.Error(err))
+		return &serviceerror.InternalServerError
+	}
+
+	if err := s.entityService.UpdateAttributes(ctx, userID, updatedAttributes); err != nil {
+		if errors.Is(err, entity.ErrAttributeConflict) {
+			return &ErrorEmailAlreadyInUse
+		}
+		logger.Error("Failed to revert email change", log.Error(err))
+		return &serviceerror.InternalServerError
+	}
+
+	if s.resetCooldown > 0 {
+		s.placeHold(ctx, userID)
+	}
+	logger.Info("Reverted email change")
+	return nil
+}
+
+// getAuthTime returns the time the caller authenticated at, from the auth_time claim of the access token.
+func getAuthTime(ctx context.Context) (time.Time, bool) {
+	var seconds int64
+	switch value := security.GetAttribute(ctx, claimAuthTime).(type) {
+	case float64:
+		seconds = int64(value)
+	case int64:
+		seconds = value
+	case json.Number:
+		parsed, err := value.Int64()
+		if err != nil {
+			return time.Time{}, false
+		}
+		seconds = parsed
+	default:
+		return time.Time{}, false
+	}
+	if seconds <= 0 {
+		return time.Time{}, false
+	}
+	return time.Unix(seconds, 0).UTC(), true
+}
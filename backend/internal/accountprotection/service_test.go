@@ -0,0 +1,308 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package accountprotection
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/entity"
+	"github.com/thunder-id/thunderid/internal/securitynotification"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/jose/jwt"
+	"github.com/thunder-id/thunderid/internal/system/security"
+	"github.com/thunder-id/thunderid/internal/system/template"
+	"github.com/thunder-id/thunderid/tests/mocks/entitymock"
+	"github.com/thunder-id/thunderid/tests/mocks/jose/jwtmock"
+	"github.com/thunder-id/thunderid/tests/mocks/securitynotificationmock"
+)
+
+const (
+	testUserID        = "user-1"
+	testPreviousEmail = "old@example.com"
+	testNewEmail      = "new@example.com"
+	testIssuer        = "https://thunder.test"
+)
+
+type AccountProtectionServiceTestSuite struct {
+	suite.Suite
+	mockStore    *accountProtectionStoreInterfaceMock
+	mockEntity   *entitymock.EntityServiceInterfaceMock
+	mockJWT      *jwtmock.JWTServiceInterfaceMock
+	mockNotifier *securitynotificationmock.SecurityNotificationServiceInterfaceMock
+	service      *accountProtectionService
+	now          time.Time
+}
+
+func TestAccountProtectionServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(AccountProtectionServiceTestSuite))
+}
+
+func (suite *AccountProtectionServiceTestSuite) SetupTest() {
+	suite.mockStore = newAccountProtectionStoreInterfaceMock(suite.T())
+	suite.mockEntity = entitymock.NewEntityServiceInterfaceMock(suite.T())
+	suite.mockJWT = jwtmock.NewJWTServiceInterfaceMock(suite.T())
+	suite.mockNotifier = securitynotificationmock.NewSecurityNotificationServiceInterfaceMock(suite.T())
+	suite.now = time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	revertURL, _ := url.Parse("https://gate.test/gate/account-protection/revert")
+	suite.service = newAccountProtectionService(suite.mockStore, suite.mockEntity, suite.mockJWT,
+		suite.mockNotifier, "email", 300, 86400, 604800, revertURL, testIssuer).(*accountProtectionService)
+	suite.service.now = func() time.Time { return suite.now }
+}
+
+// selfContext returns a context of the user authenticated at the given time.
+func (suite *AccountProtectionServiceTestSuite) selfContext(authTime time.Time) context.Context {
+	attributes := map[string]interface{}{}
+	if !authTime.IsZero() {
+		attributes[claimAuthTime] = float64(authTime.Unix())
+	}
+	authCtx := security.NewSecurityContextForTest(testUserID, "", "", nil, attributes)
+	return security.WithSecurityContextTest(context.Background(), authCtx)
+}
+
+// revertToken builds an unsigned token carrying the given header type and claims.
+func revertToken(typ string, claims map[string]interface{}) string {
+	header, _ := json.Marshal(map[string]interface{}{"alg": "RS256", "typ": typ})
+	payload, _ := json.Marshal(claims)
+	return base64.RawURLEncoding.EncodeToString(header) + "." +
+		base64.RawURLEncoding.EncodeToString(payload) + ".signature"
+}
+
+func validRevertToken() string {
+	return revertToken(jwt.TokenTypeRevertToken, map[string]interface{}{
+		"sub": testUserID, claimPreviousEmail: testPreviousEmail, claimNewEmail: testNewEmail,
+	})
+}
+
+func (suite *AccountProtectionServiceTestSuite) expectUser(email string) {
+	suite.mockEntity.On("GetEntity", mock.Anything, testUserID).Return(&entity.Entity{
+		ID:         testUserID,
+		Category:   entity.EntityCategoryUser,
+		Attributes: json.RawMessage(`{"email":"` + email + `","given_name":"Ann"}`),
+	}, nil).Once()
+}
+
+func (suite *AccountProtectionServiceTestSuite) TestCheckChangeAllowed_RecentAuthentication() {
+	suite.mockStore.On("GetChangeHold", mock.Anything, testUserID).Return(nil, errChangeHoldNotFound).Once()
+
+	svcErr := suite.service.CheckChangeAllowed(suite.selfContext(suite.now.Add(-time.Minute)), testUserID,
+		ChangeTypeCredential)
+
+	suite.Nil(svcErr)
+}
+
+func (suite *AccountProtectionServiceTestSuite) TestCheckChangeAllowed_StaleAuthentication() {
+	svcErr := suite.service.CheckChangeAllowed(suite.selfContext(suite.now.Add(-time.Hour)), testUserID,
+		ChangeTypeEmail)
+
+	suite.Equal(&ErrorStepUpRequired, svcErr)
+}
+
+func (suite *AccountProtectionServiceTestSuite) TestCheckChangeAllowed_MissingAuthTime() {
+	svcErr := suite.service.CheckChangeAllowed(suite.selfContext(time.Time{}), testUserID, ChangeTypeEmail)
+
+	suite.Equal(&ErrorStepUpRequired, svcErr)
+}
+
+func (suite *AccountProtectionServiceTestSuite) TestCheckChangeAllowed_OnHold() {
+	suite.mockStore.On("GetChangeHold", mock.Anything, testUserID).Return(&ChangeHold{
+		UserID: testUserID, CreatedAt: suite.now.Add(-time.Hour), ExpiresAt: suite.now.Add(time.Hour),
+	}, nil).Once()
+
+	svcErr := suite.service.CheckChangeAllowed(suite.selfContext(suite.now), testUserID, ChangeTypeEmail)
+
+	suite.Equal(&ErrorChangeOnHold, svcErr)
+}
+
+func (suite *AccountProtectionServiceTestSuite) TestCheckChangeAllowed_HoldExpired() {
+	suite.mockStore.On("GetChangeHold", mock.Anything, testUserID).Return(&ChangeHold{
+		UserID: testUserID, CreatedAt: suite.now.Add(-48 * time.Hour), ExpiresAt: suite.now.Add(-time.Hour),
+	}, nil).Once()
+
+	svcErr := suite.service.CheckChangeAllowed(suite.selfContext(suite.now), testUserID, ChangeTypeEmail)
+
+	suite.Nil(svcErr)
+}
+
+func (suite *AccountProtectionServiceTestSuite) TestCheckChangeAllowed_StoreError() {
+	suite.mockStore.On("GetChangeHold", mock.Anything, testUserID).Return(nil, errors.New("db error")).Once()
+
+	svcErr := suite.service.CheckChangeAllowed(suite.selfContext(suite.now), testUserID, ChangeTypeEmail)
+
+	suite.Equal(&serviceerror.InternalServerError, svcErr)
+}
+
+func (suite *AccountProtectionServiceTestSuite) TestCheckChangeAllowed_SkipsChangesByOthers() {
+	authCtx := security.NewSecurityContextForTest("admin-1", "", "", nil, nil)
+	ctx := security.WithSecurityContextTest(context.Background(), authCtx)
+
+	suite.Nil(suite.service.CheckChangeAllowed(ctx, testUserID, ChangeTypeCredential))
+	suite.Nil(suite.service.CheckChangeAllowed(context.Background(), testUserID, ChangeTypeCredential))
+}
+
+func (suite *AccountProtectionServiceTestSuite) TestRecordPasswordReset() {
+	suite.mockStore.On("SetChangeHold", mock.Anything, ChangeHold{
+		UserID: testUserID, CreatedAt: suite.now, ExpiresAt: suite.now.Add(24 * time.Hour),
+	}).Return(nil).Once()
+
+	suite.service.RecordPasswordReset(context.Background(), testUserID)
+}
+
+func (suite *AccountProtectionServiceTestSuite) TestRecordPasswordReset_NoCooldown() {
+	suite.service.resetCooldown = 0
+
+	suite.service.RecordPasswordReset(context.Background(), testUserID)
+
+	suite.mockStore.AssertNotCalled(suite.T(), "SetChangeHold", mock.Anything, mock.Anything)
+}
+
+func (suite *AccountProtectionServiceTestSuite) TestNotifyCredentialChanged() {
+	suite.mockNotifier.On("NotifyAccountChange", mock.Anything, securitynotification.AccountChange{
+		UserID: testUserID, Scenario: template.ScenarioCredentialChanged,
+	}).Once()
+
+	suite.service.NotifyCredentialChanged(context.Background(), testUserID)
+}
+
+func (suite *AccountProtectionServiceTestSuite) TestNotifyEmailChanged_SendsRevertLink() {
+	var change securitynotification.AccountChange
+	suite.mockJWT.On("GenerateJWT", mock.Anything, testUserID, testIssuer, int64(604800),
+		map[string]interface{}{
+			"aud": revertTokenAudience, claimPreviousEmail: testPreviousEmail, claimNewEmail: testNewEmail,
+		}, jwt.TokenTypeRevertToken, "").Return("revert-token", suite.now.Unix(), nil).Once()
+	suite.mockNotifier.On("NotifyAccountChange", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		change = args.Get(1).(securitynotification.AccountChange)
+	}).Once()
+
+	suite.service.NotifyEmailChanged(context.Background(), testUserID, testPreviousEmail, testNewEmail)
+
+	suite.Equal(template.ScenarioEmailChanged, change.Scenario)
+	suite.Equal(template.ScenarioEmailChangeRevert, change.PreviousEmailScenario)
+	suite.Equal(testPreviousEmail, change.PreviousEmail)
+	suite.Equal(testNewEmail, change.Data[templateDataKeyNewEmail])
+	suite.Equal("https://gate.test/gate/account-protection/revert?token=revert-token",
+		change.Data[templateDataKeyRevertLink])
+	suite.Equal(suite.now.Add(7*24*time.Hour).Format(time.RFC1123), change.Data[templateDataKeyExpiryTime])
+}
+
+func (suite *AccountProtectionServiceTestSuite) TestNotifyEmailChanged_TokenFailureSendsNotice() {
+	var change securitynotification.AccountChange
+	suite.mockJWT.On("GenerateJWT", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything,
+		mock.Anything, mock.Anything).Return("", int64(0), &serviceerror.InternalServerError).Once()
+	suite.mockNotifier.On("NotifyAccountChange", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		change = args.Get(1).(securitynotification.AccountChange)
+	}).Once()
+
+	suite.service.NotifyEmailChanged(context.Background(), testUserID, testPreviousEmail, testNewEmail)
+
+	suite.Equal(template.ScenarioEmailChanged, change.PreviousEmailScenario)
+	suite.NotContains(change.Data, templateDataKeyRevertLink)
+}
+
+func (suite *AccountProtectionServiceTestSuite) TestNotifyEmailChanged_NoNotifier() {
+	suite.service.securityNotifier = nil
+
+	suite.service.NotifyEmailChanged(context.Background(), testUserID, testPreviousEmail, testNewEmail)
+
+	suite.mockJWT.AssertNotCalled(suite.T(), "GenerateJWT")
+}
+
+func (suite *AccountProtectionServiceTestSuite) TestRevertEmailChange() {
+	token := validRevertToken()
+	suite.mockJWT.On("VerifyJWT", token, revertTokenAudience, testIssuer).Return(nil).Once()
+	suite.expectUser(testNewEmail)
+	suite.mockEntity.On("UpdateAttributes", mock.Anything, testUserID, mock.MatchedBy(func(raw json.RawMessage) bool {
+		var attributes map[string]interface{}
+		return json.Unmarshal(raw, &attributes) == nil && attributes["email"] == testPreviousEmail &&
+			attributes["given_name"] == "Ann"
+	})).Return(nil).Once()
+	suite.mockStore.On("SetChangeHold", mock.Anything, mock.MatchedBy(func(hold ChangeHold) bool {
+		return hold.UserID == testUserID && hold.ExpiresAt.Equal(suite.now.Add(24*time.Hour))
+	})).Return(nil).Once()
+
+	suite.Nil(suite.service.RevertEmailChange(context.Background(), token))
+}
+
+func (suite *AccountProtectionServiceTestSuite) TestRevertEmailChange_InvalidSignature() {
+	token := validRevertToken()
+	suite.mockJWT.On("VerifyJWT", token, revertTokenAudience, testIssuer).Return(&serviceerror.ServiceError{
+		Code: "JWT-1001"}).Once()
+
+	suite.Equal(&ErrorInvalidRevertToken, suite.service.RevertEmailChange(context.Background(), token))
+}
+
+func (suite *AccountProtectionServiceTestSuite) TestRevertEmailChange_WrongTokenType() {
+	token := revertToken("JWT", map[string]interface{}{
+		"sub": testUserID, claimPreviousEmail: testPreviousEmail, claimNewEmail: testNewEmail,
+	})
+	suite.mockJWT.On("VerifyJWT", token, revertTokenAudience, testIssuer).Return(nil).Once()
+
+	suite.Equal(&ErrorInvalidRevertToken, suite.service.RevertEmailChange(context.Background(), token))
+}
+
+func (suite *AccountProtectionServiceTestSuite) TestRevertEmailChange_EmptyToken() {
+	suite.Equal(&ErrorInvalidRevertToken, suite.service.RevertEmailChange(context.Background(), ""))
+}
+
+func (suite *AccountProtectionServiceTestSuite) TestRevertEmailChange_EmailChangedSince() {
+	token := validRevertToken()
+	suite.mockJWT.On("VerifyJWT", token, revertTokenAudience, testIssuer).Return(nil).Once()
+	suite.expectUser("other@example.com")
+
+	suite.Equal(&ErrorChangeNotRevertible, suite.service.RevertEmailChange(context.Background(), token))
+}
+
+func (suite *AccountProtectionServiceTestSuite) TestRevertEmailChange_UserNotFound() {
+	token := validRevertToken()
+	suite.mockJWT.On("VerifyJWT", token, revertTokenAudience, testIssuer).Return(nil).Once()
+	suite.mockEntity.On("GetEntity", mock.Anything, testUserID).Return(nil, entity.ErrEntityNotFound).Once()
+
+	suite.Equal(&ErrorInvalidRevertToken, suite.service.RevertEmailChange(context.Background(), token))
+}
+
+func (suite *AccountProtectionServiceTestSuite) TestRevertEmailChange_AddressTaken() {
+	token := validRevertToken()
+	suite.mockJWT.On("VerifyJWT", token, revertTokenAudience, testIssuer).Return(nil).Once()
+	suite.expectUser(testNewEmail)
+	suite.mockEntity.On("UpdateAttributes", mock.Anything, testUserID, mock.Anything).Return(
+		entity.ErrAttributeConflict).Once()
+
+	suite.Equal(&ErrorEmailAlreadyInUse, suite.service.RevertEmailChange(context.Background(), token))
+}
+
+func (suite *AccountProtectionServiceTestSuite) TestGetAuthTime_ClaimTypes() {
+	for _, value := range []interface{}{float64(1700000000), int64(1700000000), json.Number("1700000000")} {
+		authCtx := security.NewSecurityContextForTest(testUserID, "", "", nil,
+			map[string]interface{}{claimAuthTime: value})
+		authTime, ok := getAuthTime(security.WithSecurityContextTest(context.Background(), authCtx))
+		suite.True(ok)
+		suite.Equal(int64(1700000000), authTime.Unix())
+	}
+
+	_, ok := getAuthTime(context.Background())
+	suite.False(ok)
+}
@@ -0,0 +1,111 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package accountprotection
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+	sysContext "github.com/thunder-id/thunderid/internal/system/context"
+	"github.com/thunder-id/thunderid/internal/system/database/provider"
+	dbutils "github.com/thunder-id/thunderid/internal/system/database/utils"
+)
+
+var getDBProvider = provider.GetDBProvider
+
+// errChangeHoldNotFound is returned when the user has no change hold.
+var errChangeHoldNotFound = errors.New("change hold not found")
+
+// accountProtectionStoreInterface defines the interface for change hold store operations.
+type accountProtectionStoreInterface interface {
+	SetChangeHold(ctx context.Context, hold ChangeHold) error
+	GetChangeHold(ctx context.Context, userID string) (*ChangeHold, error)
+}
+
+// accountProtectionStore is the runtime database backed implementation of accountProtectionStoreInterface.
+type accountProtectionStore struct {
+	dbProvider   provider.DBProviderInterface
+	deploymentID string
+}
+
+// newAccountProtectionStore creates a new instance of accountProtectionStore.
+func newAccountProtectionStore() accountProtectionStoreInterface {
+	return &accountProtectionStore{
+		dbProvider:   getDBProvider(),
+		deploymentID: config.GetServerRuntime().Config.Server.Identifier,
+	}
+}
+
+// SetChangeHold persists the change hold of a user, replacing any earlier hold of the user.
+func (s *accountProtectionStore) SetChangeHold(ctx context.Context, hold ChangeHold) error {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
+	dbClient, err := s.dbProvider.GetRuntimeDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	if _, err := dbClient.ExecuteContext(ctx, querySetChangeHold, hold.UserID, hold.CreatedAt, hold.ExpiresAt,
+		deploymentID); err != nil {
+		return fmt.Errorf("failed to execute query: %w", err)
+	}
+	return nil
+}
+
+// GetChangeHold retrieves the change hold of a user. Expired holds are returned as well.
+func (s *accountProtectionStore) GetChangeHold(ctx context.Context, userID string) (*ChangeHold, error) {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
+	dbClient, err := s.dbProvider.GetRuntimeDBClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	results, err := dbClient.QueryContext(ctx, queryGetChangeHold, userID, deploymentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+	if len(results) == 0 {
+		return nil, errChangeHoldNotFound
+	}
+
+	return buildChangeHoldFromResultRow(results[0])
+}
+
+// buildChangeHoldFromResultRow constructs a ChangeHold from a database result row.
+func buildChangeHoldFromResultRow(row map[string]interface{}) (*ChangeHold, error) {
+	userID, ok := row["user_id"].(string)
+	if !ok {
+		return nil, fmt.Errorf("failed to parse user_id as string")
+	}
+	createdAt, err := dbutils.ParseTimeField(row["created_at"], "created_at")
+	if err != nil {
+		return nil, err
+	}
+	expiresAt, err := dbutils.ParseTimeField(row["expiry_time"], "expiry_time")
+	if err != nil {
+		return nil, err
+	}
+
+	return &ChangeHold{
+		UserID:    userID,
+		CreatedAt: createdAt,
+		ExpiresAt: expiresAt,
+	}, nil
+}
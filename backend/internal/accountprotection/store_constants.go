@@ -0,0 +1,38 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package accountprotection
+
+import dbmodel "github.com/thunder-id/thunderid/internal/system/database/model"
+
+var (
+	// querySetChangeHold inserts the change hold of a user or replaces the existing hold.
+	querySetChangeHold = dbmodel.DBQuery{
+		ID: "APQ-CHANGE_HOLD-01",
+		Query: `INSERT INTO "ACCOUNT_CHANGE_HOLD" (USER_ID, CREATED_AT, EXPIRY_TIME, DEPLOYMENT_ID) ` +
+			`VALUES ($1, $2, $3, $4) ON CONFLICT (USER_ID, DEPLOYMENT_ID) ` +
+			`DO UPDATE SET CREATED_AT = EXCLUDED.CREATED_AT, EXPIRY_TIME = EXCLUDED.EXPIRY_TIME`,
+	}
+
+	// queryGetChangeHold retrieves the change hold of a user.
+	queryGetChangeHold = dbmodel.DBQuery{
+		ID: "APQ-CHANGE_HOLD-02",
+		Query: `SELECT USER_ID, CREATED_AT, EXPIRY_TIME FROM "ACCOUNT_CHANGE_HOLD" ` +
+			`WHERE USER_ID = $1 AND DEPLOYMENT_ID = $2`,
+	}
+)
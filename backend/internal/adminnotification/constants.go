@@ -0,0 +1,55 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package adminnotification
+
+import "time"
+
+const (
+	// loggerComponentName is the component name used in admin notification logs.
+	loggerComponentName = "AdminNotificationService"
+
+	// subscriberID identifies the admin notification subscriber on the event bus.
+	subscriberID = "admin-notification"
+
+	// maintenanceLockName is the distributed lock held while expired notifications are deleted and the
+	// server certificate is checked.
+	maintenanceLockName = "admin-notification-maintenance"
+
+	// notificationsPath is the base path of the notification API.
+	notificationsPath = "/admin/notifications"
+
+	// queryParamSeverity is the query parameter filtering notifications by severity.
+	queryParamSeverity = "severity"
+
+	// queryParamStatus is the query parameter filtering notifications by read state.
+	queryParamStatus = "status"
+
+	// statusRead selects the notifications that are read.
+	statusRead = "read"
+
+	// statusUnread selects the notifications that are not read.
+	statusUnread = "unread"
+
+	// rolesClaim is the token claim carrying the names of the roles of the caller.
+	rolesClaim = "roles"
+
+	// criticalCertificateExpiry is the time before the expiry of a certificate from which its notification
+	// is critical.
+	criticalCertificateExpiry = 7 * 24 * time.Hour
+)
@@ -0,0 +1,111 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package adminnotification
+
+import (
+	"errors"
+
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/i18n/core"
+)
+
+// ErrNotificationNotFound is returned when the notification is not found in the system.
+var ErrNotificationNotFound = errors.New("notification not found")
+
+// Client errors for admin notification operations.
+var (
+	// ErrorNotificationNotFound is the error returned when the notification is not found or not visible.
+	ErrorNotificationNotFound = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "ANF-1001",
+		Error: core.I18nMessage{
+			Key:          "error.adminnotificationservice.notification_not_found",
+			DefaultValue: "Notification not found",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.adminnotificationservice.notification_not_found_description",
+			DefaultValue: "The requested notification could not be found",
+		},
+	}
+	// ErrorInvalidSeverity is the error returned when the severity filter is not a known severity.
+	ErrorInvalidSeverity = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "ANF-1002",
+		Error: core.I18nMessage{
+			Key:          "error.adminnotificationservice.invalid_severity",
+			DefaultValue: "Invalid severity filter",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.adminnotificationservice.invalid_severity_description",
+			DefaultValue: "The severity filter must be one of INFO, WARNING or CRITICAL",
+		},
+	}
+	// ErrorInvalidStatusFilter is the error returned when the status filter is not a known read state.
+	ErrorInvalidStatusFilter = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "ANF-1003",
+		Error: core.I18nMessage{
+			Key:          "error.adminnotificationservice.invalid_status_filter",
+			DefaultValue: "Invalid status filter",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.adminnotificationservice.invalid_status_filter_description",
+			DefaultValue: "The status filter must be one of read or unread",
+		},
+	}
+	// ErrorInvalidLimit is the error returned when the limit parameter is invalid.
+	ErrorInvalidLimit = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "ANF-1004",
+		Error: core.I18nMessage{
+			Key:          "error.adminnotificationservice.invalid_limit_parameter",
+			DefaultValue: "Invalid limit parameter",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.adminnotificationservice.invalid_limit_parameter_description",
+			DefaultValue: "The limit parameter must be a positive integer",
+		},
+	}
+	// ErrorInvalidOffset is the error returned when the offset parameter is invalid.
+	ErrorInvalidOffset = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "ANF-1005",
+		Error: core.I18nMessage{
+			Key:          "error.adminnotificationservice.invalid_offset_parameter",
+			DefaultValue: "Invalid offset parameter",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.adminnotificationservice.invalid_offset_parameter_description",
+			DefaultValue: "The offset parameter must be a non-negative integer",
+		},
+	}
+	// ErrorAuthenticationFailed is the error returned when the caller cannot be identified.
+	ErrorAuthenticationFailed = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "ANF-1006",
+		Error: core.I18nMessage{
+			Key:          "error.adminnotificationservice.authentication_failed",
+			DefaultValue: "Authentication failed",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.adminnotificationservice.authentication_failed_description",
+			DefaultValue: "The caller could not be identified",
+		},
+	}
+)
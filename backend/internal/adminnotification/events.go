@@ -0,0 +1,125 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package adminnotification
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/system/observability/event"
+)
+
+// notificationContent is the human readable content of the notification of an operational event.
+type notificationContent struct {
+	severity  Severity
+	title     string
+	message   string
+	sourceKey string
+}
+
+// describeEvent builds the content of the notification of an operational event. It reports false for
+// events that are not operational.
+func describeEvent(evt *event.Event) (notificationContent, bool) {
+	if evt == nil {
+		return notificationContent{}, false
+	}
+
+	switch event.EventType(evt.Type) {
+	case event.EventTypeCertificateExpiring:
+		return describeCertificateExpiring(evt), true
+	case event.EventTypeScheduledJobFailed:
+		jobName := getString(evt, event.DataKey.JobName)
+		message := fmt.Sprintf("The scheduled job %s failed.", jobName)
+		if reason := getString(evt, event.DataKey.Error); reason != "" {
+			message = fmt.Sprintf("The scheduled job %s failed: %s", jobName, reason)
+		}
+		return notificationContent{
+			severity:  SeverityCritical,
+			title:     "Scheduled job failed",
+			message:   message,
+			sourceKey: evt.Type + ":" + jobName,
+		}, true
+	case event.EventTypeQuotaThresholdReached:
+		resourceType := getString(evt, event.DataKey.ResourceType)
+		scope := "the tenant"
+		if ouID := getString(evt, event.DataKey.OUID); ouID != "" {
+			scope = "organization unit " + ouID
+		}
+		threshold := getInt(evt, event.DataKey.Threshold)
+		severity := SeverityWarning
+		if threshold >= 100 {
+			severity = SeverityCritical
+		}
+		return notificationContent{
+			severity: severity,
+			title:    "Quota threshold reached",
+			message: fmt.Sprintf("The %s usage of %s reached %d%% of its quota (%d of %d).", resourceType,
+				scope, threshold, getInt(evt, event.DataKey.Usage), getInt(evt, event.DataKey.Limit)),
+			sourceKey: fmt.Sprintf("%s:%s:%s:%d", evt.Type, getString(evt, event.DataKey.OUID), resourceType,
+				threshold),
+		}, true
+	default:
+		return notificationContent{}, false
+	}
+}
+
+// describeCertificateExpiring builds the content of the notification of an expiring certificate. The
+// notification is critical once the certificate expires within criticalCertificateExpiry.
+func describeCertificateExpiring(evt *event.Event) notificationContent {
+	subject := getString(evt, event.DataKey.CertificateSubject)
+	expiresAt := getString(evt, event.DataKey.ExpiresAt)
+	content := notificationContent{
+		severity:  SeverityWarning,
+		title:     "Certificate expiring",
+		message:   fmt.Sprintf("The certificate %s expires at %s.", subject, expiresAt),
+		sourceKey: evt.Type + ":" + subject + ":" + expiresAt,
+	}
+
+	if expiry, err := time.Parse(time.RFC3339, expiresAt); err == nil {
+		remaining := time.Until(expiry)
+		if remaining <= 0 {
+			content.title = "Certificate expired"
+			content.message = fmt.Sprintf("The certificate %s expired at %s.", subject, expiresAt)
+		}
+		if remaining < criticalCertificateExpiry {
+			content.severity = SeverityCritical
+		}
+	}
+	return content
+}
+
+// getString returns a string value of the event data.
+func getString(evt *event.Event, key string) string {
+	value, _ := evt.Data[key].(string)
+	return value
+}
+
+// getInt returns a numeric value of the event data as an int.
+func getInt(evt *event.Event, key string) int {
+	switch value := evt.Data[key].(type) {
+	case int:
+		return value
+	case int64:
+		return int(value)
+	case float64:
+		return int(value)
+	default:
+		return 0
+	}
+}
@@ -0,0 +1,88 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package adminnotification
+
+import (
+	"net/http"
+
+	sysutils "github.com/thunder-id/thunderid/internal/system/utils"
+)
+
+// adminNotificationHandler is the handler for admin notification operations.
+type adminNotificationHandler struct {
+	notificationService AdminNotificationServiceInterface
+}
+
+// newAdminNotificationHandler creates a new instance of adminNotificationHandler.
+func newAdminNotificationHandler(notificationService AdminNotificationServiceInterface) *adminNotificationHandler {
+	return &adminNotificationHandler{
+		notificationService: notificationService,
+	}
+}
+
+// HandleNotificationListRequest handles the request to list the notifications of the caller.
+func (h *adminNotificationHandler) HandleNotificationListRequest(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	pagination, svcErr := sysutils.ParsePaginationParams(query, &ErrorInvalidLimit, &ErrorInvalidOffset)
+	if svcErr != nil {
+		sysutils.WriteServiceErrorResponse(w, svcErr, clientErrorStatusCodes)
+		return
+	}
+	severity := Severity(sysutils.SanitizeString(query.Get(queryParamSeverity)))
+	status := sysutils.SanitizeString(query.Get(queryParamStatus))
+
+	notifications, svcErr := h.notificationService.ListNotifications(
+		sysutils.WithSkipCount(r.Context(), pagination.SkipCount), severity, status,
+		pagination.Limit, pagination.Offset)
+	if svcErr != nil {
+		sysutils.WriteServiceErrorResponse(w, svcErr, clientErrorStatusCodes)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(w, http.StatusOK, notifications)
+}
+
+// HandleMarkReadRequest handles the request to mark a notification as read.
+func (h *adminNotificationHandler) HandleMarkReadRequest(w http.ResponseWriter, r *http.Request) {
+	notification, svcErr := h.notificationService.MarkRead(r.Context(), r.PathValue("id"))
+	if svcErr != nil {
+		sysutils.WriteServiceErrorResponse(w, svcErr, clientErrorStatusCodes)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(w, http.StatusOK, notification)
+}
+
+// HandleMarkAllReadRequest handles the request to mark every notification of the caller as read.
+func (h *adminNotificationHandler) HandleMarkAllReadRequest(w http.ResponseWriter, r *http.Request) {
+	response, svcErr := h.notificationService.MarkAllRead(r.Context())
+	if svcErr != nil {
+		sysutils.WriteServiceErrorResponse(w, svcErr, clientErrorStatusCodes)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(w, http.StatusOK, response)
+}
+
+// clientErrorStatusCodes maps the client errors of the handler to HTTP status codes. Other client errors are
+// returned with 400 Bad Request.
+var clientErrorStatusCodes = map[string]int{
+	ErrorNotificationNotFound.Code: http.StatusNotFound,
+	ErrorAuthenticationFailed.Code: http.StatusUnauthorized,
+}
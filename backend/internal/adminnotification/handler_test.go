@@ -0,0 +1,124 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package adminnotification
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/system/error/apierror"
+)
+
+type HandlerTestSuite struct {
+	suite.Suite
+	mockService *AdminNotificationServiceInterfaceMock
+	handler     *adminNotificationHandler
+}
+
+func TestHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(HandlerTestSuite))
+}
+
+func (s *HandlerTestSuite) SetupTest() {
+	s.mockService = NewAdminNotificationServiceInterfaceMock(s.T())
+	s.handler = newAdminNotificationHandler(s.mockService)
+}
+
+func (s *HandlerTestSuite) TestHandleNotificationListRequest() {
+	s.mockService.On("ListNotifications", mock.Anything, SeverityWarning, statusUnread, 5, 10).
+		Return(&NotificationListResponse{TotalResults: 1, UnreadCount: 1,
+			Notifications: []Notification{{ID: testNotification}}}, nil)
+
+	req := httptest.NewRequest(http.MethodGet,
+		"/admin/notifications?severity=WARNING&status=unread&limit=5&offset=10", nil)
+	rr := httptest.NewRecorder()
+	s.handler.HandleNotificationListRequest(rr, req)
+
+	s.Equal(http.StatusOK, rr.Code)
+	var body NotificationListResponse
+	s.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &body))
+	s.Equal(1, body.UnreadCount)
+	s.Equal(testNotification, body.Notifications[0].ID)
+}
+
+func (s *HandlerTestSuite) TestHandleNotificationListRequest_InvalidLimit() {
+	req := httptest.NewRequest(http.MethodGet, "/admin/notifications?limit=abc", nil)
+	rr := httptest.NewRecorder()
+	s.handler.HandleNotificationListRequest(rr, req)
+
+	s.Equal(http.StatusBadRequest, rr.Code)
+	var body apierror.ErrorResponse
+	s.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &body))
+	s.Equal(ErrorInvalidLimit.Code, body.Code)
+}
+
+func (s *HandlerTestSuite) TestHandleNotificationListRequest_Unauthenticated() {
+	s.mockService.On("ListNotifications", mock.Anything, Severity(""), "", mock.Anything, 0).
+		Return(nil, &ErrorAuthenticationFailed)
+
+	req := httptest.NewRequest(http.MethodGet, "/admin/notifications", nil)
+	rr := httptest.NewRecorder()
+	s.handler.HandleNotificationListRequest(rr, req)
+
+	s.Equal(http.StatusUnauthorized, rr.Code)
+}
+
+func (s *HandlerTestSuite) TestHandleMarkReadRequest() {
+	s.mockService.On("MarkRead", mock.Anything, testNotification).
+		Return(&Notification{ID: testNotification, Read: true}, nil)
+
+	req := httptest.NewRequest(http.MethodPost, "/admin/notifications/"+testNotification+"/read", nil)
+	req.SetPathValue("id", testNotification)
+	rr := httptest.NewRecorder()
+	s.handler.HandleMarkReadRequest(rr, req)
+
+	s.Equal(http.StatusOK, rr.Code)
+	var body Notification
+	s.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &body))
+	s.True(body.Read)
+}
+
+func (s *HandlerTestSuite) TestHandleMarkReadRequest_NotFound() {
+	s.mockService.On("MarkRead", mock.Anything, "missing").Return(nil, &ErrorNotificationNotFound)
+
+	req := httptest.NewRequest(http.MethodPost, "/admin/notifications/missing/read", nil)
+	req.SetPathValue("id", "missing")
+	rr := httptest.NewRecorder()
+	s.handler.HandleMarkReadRequest(rr, req)
+
+	s.Equal(http.StatusNotFound, rr.Code)
+}
+
+func (s *HandlerTestSuite) TestHandleMarkAllReadRequest() {
+	s.mockService.On("MarkAllRead", mock.Anything).Return(&MarkAllReadResponse{Updated: 3}, nil)
+
+	req := httptest.NewRequest(http.MethodPost, "/admin/notifications/read-all", nil)
+	rr := httptest.NewRecorder()
+	s.handler.HandleMarkAllReadRequest(rr, req)
+
+	s.Equal(http.StatusOK, rr.Code)
+	var body MarkAllReadResponse
+	s.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &body))
+	s.Equal(3, body.Updated)
+}
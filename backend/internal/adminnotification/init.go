@@ -0,0 +1,130 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package adminnotification
+
+import (
+	"context"
+	"net/http"
+	"path"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/role"
+	"github.com/thunder-id/thunderid/internal/system/config"
+	sysContext "github.com/thunder-id/thunderid/internal/system/context"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/internal/system/middleware"
+	"github.com/thunder-id/thunderid/internal/system/observability"
+	"github.com/thunder-id/thunderid/internal/system/scheduler"
+	"github.com/thunder-id/thunderid/internal/system/security"
+)
+
+// Initialize initializes the admin notification service, subscribes it to the operational events of the
+// event bus, registers its routes and starts the scheduled maintenance. The service is not initialized
+// when the feature is disabled.
+func Initialize(
+	mux *http.ServeMux,
+	roleService role.RoleServiceInterface,
+	jobScheduler scheduler.SchedulerInterface,
+	observabilitySvc observability.ObservabilityServiceInterface,
+) AdminNotificationServiceInterface {
+	runtime := config.GetServerRuntime()
+	notificationConfig := runtime.Config.AdminNotification
+	if !notificationConfig.Enabled {
+		return nil
+	}
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName))
+
+	certFile := ""
+	if runtime.Config.TLS.CertFile != "" {
+		certFile = path.Join(runtime.ServerHome, runtime.Config.TLS.CertFile)
+	}
+	notificationService := newAdminNotificationService(newAdminNotificationStore(), roleService,
+		observabilitySvc, notificationConfig.RecipientRoles, notificationConfig.EventRecipientRoles,
+		time.Duration(notificationConfig.Retention)*time.Second, certFile,
+		time.Duration(notificationConfig.CertificateExpiryWarning)*time.Second)
+
+	if publisher := observabilitySvc.GetPublisher(); publisher != nil {
+		publisher.Subscribe(newNotificationSubscriber(notificationService))
+	} else {
+		logger.Warn("Observability is disabled, so operational events are not recorded as notifications")
+	}
+
+	notificationHandler := newAdminNotificationHandler(notificationService)
+	registerRoutes(mux, notificationHandler)
+
+	jobScheduler.Schedule(maintenanceLockName, time.Duration(notificationConfig.CleanupInterval)*time.Second,
+		newScheduledMaintenance(notificationService))
+
+	return notificationService
+}
+
+// registerRoutes registers the routes for admin notification operations.
+func registerRoutes(mux *http.ServeMux, notificationHandler *adminNotificationHandler) {
+	opts1 := middleware.CORSOptions{
+		AllowedMethods:   []string{"GET"},
+		AllowedHeaders:   middleware.DefaultAllowedHeaders,
+		AllowCredentials: true,
+		MaxAge:           600,
+	}
+	mux.HandleFunc(middleware.WithCORS("GET /admin/notifications",
+		notificationHandler.HandleNotificationListRequest, opts1))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS /admin/notifications",
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, opts1))
+
+	opts2 := middleware.CORSOptions{
+		AllowedMethods:   []string{"POST"},
+		AllowedHeaders:   middleware.DefaultAllowedHeaders,
+		AllowCredentials: true,
+		MaxAge:           600,
+	}
+	mux.HandleFunc(middleware.WithCORS("POST /admin/notifications/read-all",
+		notificationHandler.HandleMarkAllReadRequest, opts2))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS /admin/notifications/read-all",
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, opts2))
+	mux.HandleFunc(middleware.WithCORS("POST /admin/notifications/{id}/read",
+		notificationHandler.HandleMarkReadRequest, opts2))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS /admin/notifications/{id}/read",
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, opts2))
+}
+
+// newScheduledMaintenance returns the scheduled job that deletes the expired notifications. The server
+// certificate belongs to the deployment, so its expiry is only checked in the deployment root.
+func newScheduledMaintenance(service AdminNotificationServiceInterface) scheduler.JobFunc {
+	return func(ctx context.Context) error {
+		logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName))
+		ctx = security.WithRuntimeContext(ctx)
+
+		deleted, err := service.DeleteExpiredNotifications(ctx)
+		if deleted > 0 {
+			logger.Debug("Deleted expired notifications", log.Int("count", deleted))
+		}
+		if sysContext.GetTenantID(ctx) == "" {
+			if err := service.CheckServerCertificate(ctx); err != nil {
+				logger.Warn("Failed to check the expiry of the server certificate", log.Error(err))
+			}
+		}
+		return err
+	}
+}
@@ -0,0 +1,80 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package adminnotification provides the in-app notification inbox of administrators. Operational events
+// published on the observability event bus, such as an expiring server certificate, a failed scheduled job
+// or a quota reaching a threshold, are stored as notifications for the roles configured to receive them.
+package adminnotification
+
+import (
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/system/utils"
+)
+
+// Severity represents how urgently a notification needs the attention of administrators.
+type Severity string
+
+const (
+	// SeverityInfo marks a notification that needs no action.
+	SeverityInfo Severity = "INFO"
+	// SeverityWarning marks a notification that needs action soon.
+	SeverityWarning Severity = "WARNING"
+	// SeverityCritical marks a notification that needs immediate action.
+	SeverityCritical Severity = "CRITICAL"
+)
+
+// Notification represents an operational event delivered to the members of a role.
+type Notification struct {
+	ID        string                 `json:"id"`
+	Type      string                 `json:"type"`
+	Severity  Severity               `json:"severity"`
+	Title     string                 `json:"title"`
+	Message   string                 `json:"message"`
+	Details   map[string]interface{} `json:"details,omitempty"`
+	Role      string                 `json:"role"`
+	Read      bool                   `json:"read"`
+	ReadBy    string                 `json:"readBy,omitempty"`
+	ReadAt    *time.Time             `json:"readAt,omitempty"`
+	CreatedAt time.Time              `json:"createdAt"`
+	// SourceKey identifies the condition a notification reports. A condition is reported to a role again
+	// only after its earlier notification is read.
+	SourceKey string `json:"-"`
+}
+
+// NotificationFilter restricts the notifications returned by a list. Empty fields match any value.
+type NotificationFilter struct {
+	Roles    []string
+	Severity Severity
+	Read     *bool
+}
+
+// NotificationListResponse represents the response for listing notifications with pagination.
+type NotificationListResponse struct {
+	TotalResults  int            `json:"totalResults"`
+	StartIndex    int            `json:"startIndex"`
+	Count         int            `json:"count"`
+	UnreadCount   int            `json:"unreadCount"`
+	Notifications []Notification `json:"notifications"`
+	Links         []utils.Link   `json:"links"`
+}
+
+// MarkAllReadResponse represents the outcome of marking every notification of the caller as read.
+type MarkAllReadResponse struct {
+	Updated int `json:"updated"`
+}
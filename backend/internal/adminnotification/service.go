@@ -0,0 +1,351 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package adminnotification
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/role"
+	sysContext "github.com/thunder-id/thunderid/internal/system/context"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/internal/system/observability"
+	"github.com/thunder-id/thunderid/internal/system/observability/event"
+	"github.com/thunder-id/thunderid/internal/system/security"
+	"github.com/thunder-id/thunderid/internal/system/utils"
+)
+
+// AdminNotificationServiceInterface defines the interface for the admin notification service.
+type AdminNotificationServiceInterface interface {
+	ListNotifications(ctx context.Context, severity Severity, status string,
+		limit, offset int) (*NotificationListResponse, *serviceerror.ServiceError)
+	MarkRead(ctx context.Context, id string) (*Notification, *serviceerror.ServiceError)
+	MarkAllRead(ctx context.Context) (*MarkAllReadResponse, *serviceerror.ServiceError)
+	RecordEvent(ctx context.Context, evt *event.Event) error
+	DeleteExpiredNotifications(ctx context.Context) (int, error)
+	CheckServerCertificate(ctx context.Context) error
+}
+
+// adminNotificationService is the default implementation of the AdminNotificationServiceInterface.
+type adminNotificationService struct {
+	store               adminNotificationStoreInterface
+	roleService         role.RoleServiceInterface
+	observabilitySvc    observability.ObservabilityServiceInterface
+	recipientRoles      []string
+	eventRecipientRoles map[string][]string
+	retention           time.Duration
+	certFile            string
+	certExpiryWarning   time.Duration
+	now                 func() time.Time
+	logger              *log.Logger
+}
+
+// newAdminNotificationService creates a new instance of adminNotificationService. Notifications are
+// delivered to the recipient roles unless the event type has its own recipient roles, and are kept for
+// the retention period. Administrators are notified of the expiry of the certificate in certFile from
+// certExpiryWarning before it expires.
+func newAdminNotificationService(
+	store adminNotificationStoreInterface,
+	roleService role.RoleServiceInterface,
+	observabilitySvc observability.ObservabilityServiceInterface,
+	recipientRoles []string,
+	eventRecipientRoles map[string][]string,
+	retention time.Duration,
+	certFile string,
+	certExpiryWarning time.Duration,
+) AdminNotificationServiceInterface {
+	return &adminNotificationService{
+		store:               store,
+		roleService:         roleService,
+		observabilitySvc:    observabilitySvc,
+		recipientRoles:      recipientRoles,
+		eventRecipientRoles: eventRecipientRoles,
+		retention:           retention,
+		certFile:            certFile,
+		certExpiryWarning:   certExpiryWarning,
+		now:                 time.Now,
+		logger:              log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName)),
+	}
+}
+
+// ListNotifications returns a page of the notifications delivered to the roles of the caller, latest
+// first, optionally filtered by severity and read state.
+func (s *adminNotificationService) ListNotifications(ctx context.Context, severity Severity, status string,
+	limit, offset int) (*NotificationListResponse, *serviceerror.ServiceError) {
+	if severity != "" && !isValidSeverity(severity) {
+		return nil, &ErrorInvalidSeverity
+	}
+	var read *bool
+	switch status {
+	case "":
+	case statusRead, statusUnread:
+		isRead := status == statusRead
+		read = &isRead
+	default:
+		return nil, &ErrorInvalidStatusFilter
+	}
+
+	roles, svcErr := s.getCallerRoles(ctx)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	if len(roles) == 0 {
+		return &NotificationListResponse{
+			StartIndex:    offset + 1,
+			Notifications: []Notification{},
+			Links:         []utils.Link{},
+		}, nil
+	}
+
+	filter := NotificationFilter{Roles: roles, Severity: severity, Read: read}
+	totalCount, fetchLimit := utils.TotalCountUnknown, limit+1
+	if !utils.IsCountSkipped(ctx) {
+		var err error
+		totalCount, err = s.store.CountNotifications(ctx, filter)
+		if err != nil {
+			s.logger.Error("Failed to count notifications", log.Error(err))
+			return nil, &serviceerror.InternalServerError
+		}
+		fetchLimit = limit
+	}
+	unread := false
+	unreadCount, err := s.store.CountNotifications(ctx, NotificationFilter{Roles: roles, Read: &unread})
+	if err != nil {
+		s.logger.Error("Failed to count unread notifications", log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+
+	notifications, err := s.store.ListNotifications(ctx, filter, fetchLimit, offset)
+	if err != nil {
+		s.logger.Error("Failed to list notifications", log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+	notifications, hasMore := utils.TrimPage(notifications, limit)
+
+	return &NotificationListResponse{
+		TotalResults:  totalCount,
+		StartIndex:    offset + 1,
+		Count:         len(notifications),
+		UnreadCount:   unreadCount,
+		Notifications: notifications,
+		Links: utils.BuildListPaginationLinks(notificationsPath, limit, offset, totalCount, hasMore,
+			buildFilterQuery(severity, status)),
+	}, nil
+}
+
+// MarkRead marks a notification delivered to a role of the caller as read.
+func (s *adminNotificationService) MarkRead(ctx context.Context, id string) (*Notification,
+	*serviceerror.ServiceError) {
+	roles, svcErr := s.getCallerRoles(ctx)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+
+	notification, err := s.store.GetNotification(ctx, id)
+	if err != nil {
+		if errors.Is(err, ErrNotificationNotFound) {
+			return nil, &ErrorNotificationNotFound
+		}
+		s.logger.Error("Failed to get notification", log.Error(err), log.String("id", id))
+		return nil, &serviceerror.InternalServerError
+	}
+	if !slices.Contains(roles, notification.Role) {
+		return nil, &ErrorNotificationNotFound
+	}
+	if notification.Read {
+		return notification, nil
+	}
+
+	readBy := security.GetSubject(ctx)
+	readAt := s.now().UTC()
+	if err := s.store.MarkRead(ctx, id, readBy, readAt); err != nil {
+		s.logger.Error("Failed to mark notification as read", log.Error(err), log.String("id", id))
+		return nil, &serviceerror.InternalServerError
+	}
+
+	notification.Read = true
+	notification.ReadBy = readBy
+	notification.ReadAt = &readAt
+	return notification, nil
+}
+
+// MarkAllRead marks every unread notification delivered to the roles of the caller as read.
+func (s *adminNotificationService) MarkAllRead(ctx context.Context) (*MarkAllReadResponse,
+	*serviceerror.ServiceError) {
+	roles, svcErr := s.getCallerRoles(ctx)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	if len(roles) == 0 {
+		return &MarkAllReadResponse{}, nil
+	}
+
+	updated, err := s.store.MarkAllRead(ctx, roles, security.GetSubject(ctx), s.now().UTC())
+	if err != nil {
+		s.logger.Error("Failed to mark notifications as read", log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+	return &MarkAllReadResponse{Updated: updated}, nil
+}
+
+// RecordEvent stores an operational event as a notification for each of its recipient roles. A role that
+// has not read an earlier notification of the same condition is not notified again. Events that are not
+// operational are ignored.
+func (s *adminNotificationService) RecordEvent(ctx context.Context, evt *event.Event) error {
+	content, ok := describeEvent(evt)
+	if !ok {
+		return nil
+	}
+
+	createdAt := s.now().UTC()
+	for _, role := range s.getRecipientRoles(evt.Type) {
+		exists, err := s.store.HasUnreadNotification(ctx, role, content.sourceKey)
+		if err != nil {
+			return fmt.Errorf("failed to check unread notifications: %w", err)
+		}
+		if exists {
+			continue
+		}
+
+		id, err := utils.GenerateUUIDv7()
+		if err != nil {
+			return fmt.Errorf("failed to generate notification ID: %w", err)
+		}
+		if err := s.store.CreateNotification(ctx, Notification{
+			ID:        id,
+			Type:      evt.Type,
+			Severity:  content.severity,
+			Title:     content.title,
+			Message:   content.message,
+			Details:   evt.Data,
+			Role:      role,
+			SourceKey: content.sourceKey,
+			CreatedAt: createdAt,
+		}); err != nil {
+			return fmt.Errorf("failed to create notification: %w", err)
+		}
+	}
+	return nil
+}
+
+// DeleteExpiredNotifications deletes the notifications older than the retention period and returns the
+// number of notifications deleted.
+func (s *adminNotificationService) DeleteExpiredNotifications(ctx context.Context) (int, error) {
+	if s.retention <= 0 {
+		return 0, nil
+	}
+	return s.store.DeleteNotificationsBefore(ctx, s.now().UTC().Add(-s.retention))
+}
+
+// CheckServerCertificate publishes an operational event when the server certificate expires within the
+// warning period.
+func (s *adminNotificationService) CheckServerCertificate(ctx context.Context) error {
+	if s.certFile == "" || s.certExpiryWarning <= 0 {
+		return nil
+	}
+
+	certPEM, err := os.ReadFile(s.certFile)
+	if err != nil {
+		return fmt.Errorf("failed to read server certificate: %w", err)
+	}
+	block, _ := pem.Decode(certPEM)
+	if block == nil {
+		return fmt.Errorf("failed to decode server certificate")
+	}
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return fmt.Errorf("failed to parse server certificate: %w", err)
+	}
+
+	if s.now().Add(s.certExpiryWarning).Before(cert.NotAfter) {
+		return nil
+	}
+	if s.observabilitySvc == nil || !s.observabilitySvc.IsEnabled() {
+		return nil
+	}
+	evt := event.NewEvent(sysContext.GetTraceID(ctx), string(event.EventTypeCertificateExpiring),
+		event.ComponentCertificateMonitor).
+		WithTenantID(sysContext.GetTenantID(ctx)).
+		WithStatus(event.StatusSuccess).
+		WithData(event.DataKey.CertificateSubject, cert.Subject.String()).
+		WithData(event.DataKey.ExpiresAt, cert.NotAfter.UTC().Format(time.RFC3339))
+	s.observabilitySvc.PublishEvent(evt)
+	return nil
+}
+
+// getRecipientRoles returns the names of the roles that receive the notifications of an event type.
+func (s *adminNotificationService) getRecipientRoles(eventType string) []string {
+	if roles, ok := s.eventRecipientRoles[eventType]; ok {
+		return roles
+	}
+	return s.recipientRoles
+}
+
+// getCallerRoles returns the names of the roles of the caller, from the roles claim of the token when
+// present and otherwise from the direct role assignments of the caller.
+func (s *adminNotificationService) getCallerRoles(ctx context.Context) ([]string, *serviceerror.ServiceError) {
+	subject := security.GetSubject(ctx)
+	if subject == "" {
+		return nil, &ErrorAuthenticationFailed
+	}
+
+	switch claim := security.GetAttribute(ctx, rolesClaim).(type) {
+	case []string:
+		return claim, nil
+	case []interface{}:
+		roles := make([]string, 0, len(claim))
+		for _, value := range claim {
+			if name, ok := value.(string); ok {
+				roles = append(roles, name)
+			}
+		}
+		return roles, nil
+	}
+
+	roles, svcErr := s.roleService.GetUserRoles(ctx, subject, nil)
+	if svcErr != nil {
+		s.logger.Error("Failed to get roles of the caller", log.String("error", svcErr.Error.DefaultValue))
+		return nil, &serviceerror.InternalServerError
+	}
+	return roles, nil
+}
+
+// isValidSeverity reports whether a severity is one of the known severities.
+func isValidSeverity(severity Severity) bool {
+	return severity == SeverityInfo || severity == SeverityWarning || severity == SeverityCritical
+}
+
+// buildFilterQuery returns the filter query parameters carried by the pagination links.
+func buildFilterQuery(severity Severity, status string) string {
+	query := ""
+	if severity != "" {
+		query += "&" + queryParamSeverity + "=" + string(severity)
+	}
+	if status != "" {
+		query += "&" + queryParamStatus + "=" + status
+	}
+	return query
+}
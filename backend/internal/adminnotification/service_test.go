@@ -0,0 +1,348 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package adminnotification
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	sysContext "github.com/thunder-id/thunderid/internal/system/context"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/observability/event"
+	"github.com/thunder-id/thunderid/internal/system/security"
+	"github.com/thunder-id/thunderid/tests/mocks/observability/observabilitymock"
+	"github.com/thunder-id/thunderid/tests/mocks/rolemock"
+)
+
+const (
+	testAdmin        = "admin-1"
+	testAdminRole    = "Administrator"
+	testAuditorRole  = "Auditor"
+	testNotification = "notification-1"
+)
+
+type AdminNotificationServiceTestSuite struct {
+	suite.Suite
+	mockStore         *adminNotificationStoreInterfaceMock
+	mockRoleService   *rolemock.RoleServiceInterfaceMock
+	mockObservability *observabilitymock.ObservabilityServiceInterfaceMock
+	service           *adminNotificationService
+	events            []*event.Event
+	now               time.Time
+}
+
+func TestAdminNotificationServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(AdminNotificationServiceTestSuite))
+}
+
+func (suite *AdminNotificationServiceTestSuite) SetupTest() {
+	suite.mockStore = newAdminNotificationStoreInterfaceMock(suite.T())
+	suite.mockRoleService = rolemock.NewRoleServiceInterfaceMock(suite.T())
+	suite.mockObservability = observabilitymock.NewObservabilityServiceInterfaceMock(suite.T())
+	suite.service = newAdminNotificationService(suite.mockStore, suite.mockRoleService, suite.mockObservability,
+		[]string{testAdminRole}, map[string][]string{
+			string(event.EventTypeQuotaThresholdReached): {testAdminRole, testAuditorRole},
+		}, 24*time.Hour, "", 30*24*time.Hour).(*adminNotificationService)
+	suite.now = time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	suite.service.now = func() time.Time { return suite.now }
+
+	suite.events = nil
+	suite.mockObservability.On("IsEnabled").Return(true).Maybe()
+	suite.mockObservability.On("PublishEvent", mock.Anything).Run(func(args mock.Arguments) {
+		suite.events = append(suite.events, args.Get(0).(*event.Event))
+	}).Maybe()
+}
+
+func (suite *AdminNotificationServiceTestSuite) contextFor(subject string,
+	attributes map[string]interface{}) context.Context {
+	return security.WithSecurityContextTest(context.Background(),
+		security.NewSecurityContextForTest(subject, "", "", nil, attributes))
+}
+
+func (suite *AdminNotificationServiceTestSuite) adminContext() context.Context {
+	return suite.contextFor(testAdmin, map[string]interface{}{rolesClaim: []interface{}{testAdminRole}})
+}
+
+func (suite *AdminNotificationServiceTestSuite) TestListNotifications() {
+	unread := false
+	suite.mockStore.On("CountNotifications", mock.Anything, NotificationFilter{
+		Roles: []string{testAdminRole}, Severity: SeverityCritical, Read: &unread}).Return(1, nil).Once()
+	suite.mockStore.On("CountNotifications", mock.Anything, NotificationFilter{
+		Roles: []string{testAdminRole}, Read: &unread}).Return(3, nil).Once()
+	suite.mockStore.On("ListNotifications", mock.Anything, NotificationFilter{
+		Roles: []string{testAdminRole}, Severity: SeverityCritical, Read: &unread}, 10, 0).
+		Return([]Notification{{ID: testNotification, Severity: SeverityCritical}}, nil).Once()
+
+	response, svcErr := suite.service.ListNotifications(suite.adminContext(), SeverityCritical, statusUnread,
+		10, 0)
+
+	suite.Nil(svcErr)
+	suite.Equal(1, response.TotalResults)
+	suite.Equal(3, response.UnreadCount)
+	suite.Equal(1, response.Count)
+	suite.Equal(testNotification, response.Notifications[0].ID)
+}
+
+func (suite *AdminNotificationServiceTestSuite) TestListNotifications_RolesFromRoleService() {
+	suite.mockRoleService.On("GetUserRoles", mock.Anything, testAdmin, []string(nil)).
+		Return([]string{testAuditorRole}, nil).Once()
+	suite.mockStore.On("CountNotifications", mock.Anything, mock.MatchedBy(func(filter NotificationFilter) bool {
+		return len(filter.Roles) == 1 && filter.Roles[0] == testAuditorRole
+	})).Return(0, nil).Twice()
+	suite.mockStore.On("ListNotifications", mock.Anything, mock.Anything, 10, 0).
+		Return([]Notification{}, nil).Once()
+
+	response, svcErr := suite.service.ListNotifications(suite.contextFor(testAdmin, nil), "", "", 10, 0)
+
+	suite.Nil(svcErr)
+	suite.Empty(response.Notifications)
+}
+
+func (suite *AdminNotificationServiceTestSuite) TestListNotifications_NoRoles() {
+	response, svcErr := suite.service.ListNotifications(
+		suite.contextFor(testAdmin, map[string]interface{}{rolesClaim: []string{}}), "", "", 10, 0)
+
+	suite.Nil(svcErr)
+	suite.Zero(response.TotalResults)
+	suite.Empty(response.Notifications)
+	suite.mockStore.AssertNotCalled(suite.T(), "ListNotifications", mock.Anything, mock.Anything,
+		mock.Anything, mock.Anything)
+}
+
+func (suite *AdminNotificationServiceTestSuite) TestListNotifications_InvalidFilters() {
+	_, svcErr := suite.service.ListNotifications(suite.adminContext(), "URGENT", "", 10, 0)
+	suite.Equal(ErrorInvalidSeverity.Code, svcErr.Code)
+
+	_, svcErr = suite.service.ListNotifications(suite.adminContext(), "", "archived", 10, 0)
+	suite.Equal(ErrorInvalidStatusFilter.Code, svcErr.Code)
+}
+
+func (suite *AdminNotificationServiceTestSuite) TestListNotifications_Unauthenticated() {
+	_, svcErr := suite.service.ListNotifications(context.Background(), "", "", 10, 0)
+
+	suite.Equal(ErrorAuthenticationFailed.Code, svcErr.Code)
+}
+
+func (suite *AdminNotificationServiceTestSuite) TestListNotifications_StoreError() {
+	suite.mockStore.On("CountNotifications", mock.Anything, mock.Anything).
+		Return(0, errors.New("db down")).Once()
+
+	_, svcErr := suite.service.ListNotifications(suite.adminContext(), "", "", 10, 0)
+
+	suite.Equal(serviceerror.InternalServerError.Code, svcErr.Code)
+}
+
+func (suite *AdminNotificationServiceTestSuite) TestMarkRead() {
+	suite.mockStore.On("GetNotification", mock.Anything, testNotification).
+		Return(&Notification{ID: testNotification, Role: testAdminRole}, nil).Once()
+	suite.mockStore.On("MarkRead", mock.Anything, testNotification, testAdmin, suite.now).Return(nil).Once()
+
+	notification, svcErr := suite.service.MarkRead(suite.adminContext(), testNotification)
+
+	suite.Nil(svcErr)
+	suite.True(notification.Read)
+	suite.Equal(testAdmin, notification.ReadBy)
+	suite.Equal(suite.now, *notification.ReadAt)
+}
+
+func (suite *AdminNotificationServiceTestSuite) TestMarkRead_AlreadyRead() {
+	suite.mockStore.On("GetNotification", mock.Anything, testNotification).
+		Return(&Notification{ID: testNotification, Role: testAdminRole, Read: true}, nil).Once()
+
+	notification, svcErr := suite.service.MarkRead(suite.adminContext(), testNotification)
+
+	suite.Nil(svcErr)
+	suite.True(notification.Read)
+	suite.mockStore.AssertNotCalled(suite.T(), "MarkRead", mock.Anything, mock.Anything, mock.Anything,
+		mock.Anything)
+}
+
+func (suite *AdminNotificationServiceTestSuite) TestMarkRead_OtherRole() {
+	suite.mockStore.On("GetNotification", mock.Anything, testNotification).
+		Return(&Notification{ID: testNotification, Role: testAuditorRole}, nil).Once()
+
+	_, svcErr := suite.service.MarkRead(suite.adminContext(), testNotification)
+
+	suite.Equal(ErrorNotificationNotFound.Code, svcErr.Code)
+}
+
+func (suite *AdminNotificationServiceTestSuite) TestMarkRead_NotFound() {
+	suite.mockStore.On("GetNotification", mock.Anything, testNotification).
+		Return(nil, ErrNotificationNotFound).Once()
+
+	_, svcErr := suite.service.MarkRead(suite.adminContext(), testNotification)
+
+	suite.Equal(ErrorNotificationNotFound.Code, svcErr.Code)
+}
+
+func (suite *AdminNotificationServiceTestSuite) TestMarkAllRead() {
+	suite.mockStore.On("MarkAllRead", mock.Anything, []string{testAdminRole}, testAdmin, suite.now).
+		Return(4, nil).Once()
+
+	response, svcErr := suite.service.MarkAllRead(suite.adminContext())
+
+	suite.Nil(svcErr)
+	suite.Equal(4, response.Updated)
+}
+
+func (suite *AdminNotificationServiceTestSuite) TestRecordEvent_QuotaThreshold() {
+	evt := event.NewEvent("trace-1", string(event.EventTypeQuotaThresholdReached), event.ComponentQuota).
+		WithData(event.DataKey.ResourceType, "users").
+		WithData(event.DataKey.Threshold, 100).
+		WithData(event.DataKey.Usage, 10).
+		WithData(event.DataKey.Limit, 10)
+	sourceKey := string(event.EventTypeQuotaThresholdReached) + "::users:100"
+	suite.mockStore.On("HasUnreadNotification", mock.Anything, testAdminRole, sourceKey).Return(true, nil).Once()
+	suite.mockStore.On("HasUnreadNotification", mock.Anything, testAuditorRole, sourceKey).
+		Return(false, nil).Once()
+	suite.mockStore.On("CreateNotification", mock.Anything, mock.MatchedBy(func(n Notification) bool {
+		return n.Role == testAuditorRole && n.Severity == SeverityCritical && n.SourceKey == sourceKey &&
+			n.Message == "The users usage of the tenant reached 100% of its quota (10 of 10)." &&
+			n.CreatedAt.Equal(suite.now)
+	})).Return(nil).Once()
+
+	suite.NoError(suite.service.RecordEvent(context.Background(), evt))
+}
+
+func (suite *AdminNotificationServiceTestSuite) TestRecordEvent_ScheduledJobFailed() {
+	evt := event.NewEvent("trace-1", string(event.EventTypeScheduledJobFailed), event.ComponentConfigDrift).
+		WithData(event.DataKey.JobName, "cleanup").
+		WithData(event.DataKey.Error, "db down")
+	suite.mockStore.On("HasUnreadNotification", mock.Anything, testAdminRole, mock.Anything).
+		Return(false, nil).Once()
+	suite.mockStore.On("CreateNotification", mock.Anything, mock.MatchedBy(func(n Notification) bool {
+		return n.Role == testAdminRole && n.Severity == SeverityCritical &&
+			n.Message == "The scheduled job cleanup failed: db down"
+	})).Return(nil).Once()
+
+	suite.NoError(suite.service.RecordEvent(context.Background(), evt))
+}
+
+func (suite *AdminNotificationServiceTestSuite) TestRecordEvent_IgnoresOtherEvents() {
+	evt := event.NewEvent("trace-1", string(event.EventTypeChangeRequestCreated), event.ComponentChangeRequest)
+
+	suite.NoError(suite.service.RecordEvent(context.Background(), evt))
+	suite.mockStore.AssertNotCalled(suite.T(), "CreateNotification", mock.Anything, mock.Anything)
+}
+
+func (suite *AdminNotificationServiceTestSuite) TestRecordEvent_StoreError() {
+	evt := event.NewEvent("trace-1", string(event.EventTypeScheduledJobFailed), event.ComponentConfigDrift)
+	suite.mockStore.On("HasUnreadNotification", mock.Anything, testAdminRole, mock.Anything).
+		Return(false, errors.New("db down")).Once()
+
+	suite.Error(suite.service.RecordEvent(context.Background(), evt))
+}
+
+func (suite *AdminNotificationServiceTestSuite) TestDeleteExpiredNotifications() {
+	suite.mockStore.On("DeleteNotificationsBefore", mock.Anything, suite.now.Add(-24*time.Hour)).
+		Return(2, nil).Once()
+
+	deleted, err := suite.service.DeleteExpiredNotifications(context.Background())
+
+	suite.NoError(err)
+	suite.Equal(2, deleted)
+}
+
+func (suite *AdminNotificationServiceTestSuite) TestCheckServerCertificate() {
+	suite.service.certFile = suite.writeCertificate(suite.now.Add(10 * 24 * time.Hour))
+
+	suite.NoError(suite.service.CheckServerCertificate(context.Background()))
+
+	suite.Require().Len(suite.events, 1)
+	suite.Equal(string(event.EventTypeCertificateExpiring), suite.events[0].Type)
+	suite.Equal("CN=thunder.example.com", suite.events[0].Data[event.DataKey.CertificateSubject])
+}
+
+func (suite *AdminNotificationServiceTestSuite) TestCheckServerCertificate_NotExpiring() {
+	suite.service.certFile = suite.writeCertificate(suite.now.Add(90 * 24 * time.Hour))
+
+	suite.NoError(suite.service.CheckServerCertificate(context.Background()))
+
+	suite.Empty(suite.events)
+}
+
+func (suite *AdminNotificationServiceTestSuite) TestCheckServerCertificate_MissingFile() {
+	suite.service.certFile = filepath.Join(suite.T().TempDir(), "missing.cert")
+
+	suite.Error(suite.service.CheckServerCertificate(context.Background()))
+}
+
+func (suite *AdminNotificationServiceTestSuite) TestScheduledMaintenance_ChecksCertificateInRoot() {
+	mockService := NewAdminNotificationServiceInterfaceMock(suite.T())
+	mockService.On("DeleteExpiredNotifications", mock.Anything).Return(1, nil).Once()
+	mockService.On("CheckServerCertificate", mock.Anything).Return(nil).Once()
+
+	suite.NoError(newScheduledMaintenance(mockService)(context.Background()))
+}
+
+func (suite *AdminNotificationServiceTestSuite) TestScheduledMaintenance_SkipsCertificateInTenant() {
+	mockService := NewAdminNotificationServiceInterfaceMock(suite.T())
+	mockService.On("DeleteExpiredNotifications", mock.MatchedBy(func(ctx context.Context) bool {
+		return sysContext.GetTenantID(ctx) == "tenant-1"
+	})).Return(0, nil).Once()
+
+	suite.NoError(newScheduledMaintenance(mockService)(sysContext.WithTenantID(context.Background(), "tenant-1")))
+	mockService.AssertNotCalled(suite.T(), "CheckServerCertificate", mock.Anything)
+}
+
+func (suite *AdminNotificationServiceTestSuite) TestSubscriberRecordsEvent() {
+	mockService := NewAdminNotificationServiceInterfaceMock(suite.T())
+	evt := event.NewEvent("trace-1", string(event.EventTypeScheduledJobFailed), event.ComponentConfigDrift).
+		WithTenantID("tenant-1")
+	mockService.On("RecordEvent", mock.MatchedBy(func(ctx context.Context) bool {
+		return sysContext.GetTenantID(ctx) == "tenant-1"
+	}), evt).Return(nil).Once()
+	sub := newNotificationSubscriber(mockService)
+
+	suite.Equal([]event.EventCategory{event.CategoryOperations}, sub.GetCategories())
+	suite.NoError(sub.OnEvent(evt))
+}
+
+// writeCertificate writes a self-signed certificate expiring at notAfter and returns its path.
+func (suite *AdminNotificationServiceTestSuite) writeCertificate(notAfter time.Time) string {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	suite.Require().NoError(err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "thunder.example.com"},
+		NotBefore:    suite.now.Add(-time.Hour),
+		NotAfter:     notAfter,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	suite.Require().NoError(err)
+
+	certFile := filepath.Join(suite.T().TempDir(), "server.cert")
+	suite.Require().NoError(os.WriteFile(certFile,
+		pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0o600))
+	return certFile
+}
@@ -0,0 +1,294 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package adminnotification
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+	sysContext "github.com/thunder-id/thunderid/internal/system/context"
+	"github.com/thunder-id/thunderid/internal/system/database/provider"
+	dbutils "github.com/thunder-id/thunderid/internal/system/database/utils"
+	"github.com/thunder-id/thunderid/internal/system/utils"
+)
+
+var getDBProvider = provider.GetDBProvider
+
+// adminNotificationStoreInterface defines the interface for admin notification store operations.
+type adminNotificationStoreInterface interface {
+	CreateNotification(ctx context.Context, notification Notification) error
+	HasUnreadNotification(ctx context.Context, role, sourceKey string) (bool, error)
+	GetNotification(ctx context.Context, id string) (*Notification, error)
+	ListNotifications(ctx context.Context, filter NotificationFilter, limit, offset int) ([]Notification, error)
+	CountNotifications(ctx context.Context, filter NotificationFilter) (int, error)
+	MarkRead(ctx context.Context, id, readBy string, readAt time.Time) error
+	MarkAllRead(ctx context.Context, roles []string, readBy string, readAt time.Time) (int, error)
+	DeleteNotificationsBefore(ctx context.Context, before time.Time) (int, error)
+}
+
+// adminNotificationStore is the default implementation of adminNotificationStoreInterface.
+type adminNotificationStore struct {
+	dbProvider   provider.DBProviderInterface
+	deploymentID string
+}
+
+// newAdminNotificationStore creates a new instance of adminNotificationStore.
+func newAdminNotificationStore() adminNotificationStoreInterface {
+	return &adminNotificationStore{
+		dbProvider:   getDBProvider(),
+		deploymentID: config.GetServerRuntime().Config.Server.Identifier,
+	}
+}
+
+// CreateNotification persists a new notification.
+func (s *adminNotificationStore) CreateNotification(ctx context.Context, notification Notification) error {
+	dbClient, err := s.dbProvider.GetConfigDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	var details interface{}
+	if len(notification.Details) > 0 {
+		detailsJSON, err := json.Marshal(notification.Details)
+		if err != nil {
+			return fmt.Errorf("failed to marshal notification details: %w", err)
+		}
+		details = string(detailsJSON)
+	}
+
+	if _, err := dbClient.ExecuteContext(ctx, queryCreateNotification, notification.ID, notification.Type,
+		string(notification.Severity), notification.Title, notification.Message, details, notification.Role,
+		notification.SourceKey, utils.BoolToNumString(notification.Read), toNullableString(notification.ReadBy),
+		toNullableTime(notification.ReadAt), notification.CreatedAt,
+		sysContext.ScopeDeploymentID(ctx, s.deploymentID)); err != nil {
+		return fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	return nil
+}
+
+// HasUnreadNotification reports whether a role has an unread notification reporting the condition of the
+// source key.
+func (s *adminNotificationStore) HasUnreadNotification(ctx context.Context, role, sourceKey string) (bool, error) {
+	dbClient, err := s.dbProvider.GetConfigDBClient()
+	if err != nil {
+		return false, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	results, err := dbClient.QueryContext(ctx, queryCountUnreadBySourceKey, role, sourceKey,
+		sysContext.ScopeDeploymentID(ctx, s.deploymentID))
+	if err != nil {
+		return false, fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	return parseCount(results) > 0, nil
+}
+
+// GetNotification retrieves a notification by its ID.
+func (s *adminNotificationStore) GetNotification(ctx context.Context, id string) (*Notification, error) {
+	dbClient, err := s.dbProvider.GetConfigDBClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	results, err := dbClient.QueryContext(ctx, queryGetNotificationByID, id,
+		sysContext.ScopeDeploymentID(ctx, s.deploymentID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+	if len(results) == 0 {
+		return nil, ErrNotificationNotFound
+	}
+	if len(results) != 1 {
+		return nil, fmt.Errorf("unexpected number of results: %d", len(results))
+	}
+
+	return buildNotificationFromResultRow(results[0])
+}
+
+// ListNotifications retrieves a page of the notifications matching the filter, latest first.
+func (s *adminNotificationStore) ListNotifications(ctx context.Context, filter NotificationFilter,
+	limit, offset int) ([]Notification, error) {
+	dbClient, err := s.dbProvider.GetConfigDBClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	query, args := buildListNotificationsQuery(filter, limit, offset,
+		sysContext.ScopeDeploymentID(ctx, s.deploymentID))
+	results, err := dbClient.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	notifications := make([]Notification, 0, len(results))
+	for _, row := range results {
+		notification, err := buildNotificationFromResultRow(row)
+		if err != nil {
+			return nil, fmt.Errorf("failed to build notification from result row: %w", err)
+		}
+		notifications = append(notifications, *notification)
+	}
+
+	return notifications, nil
+}
+
+// CountNotifications counts the notifications matching the filter.
+func (s *adminNotificationStore) CountNotifications(ctx context.Context, filter NotificationFilter) (int, error) {
+	dbClient, err := s.dbProvider.GetConfigDBClient()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	query, args := buildCountNotificationsQuery(filter, sysContext.ScopeDeploymentID(ctx, s.deploymentID))
+	results, err := dbClient.QueryContext(ctx, query, args...)
+	if err != nil {
+		return 0, fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	return parseCount(results), nil
+}
+
+// MarkRead marks a notification as read. Marking a notification that is already read is a no-op.
+func (s *adminNotificationStore) MarkRead(ctx context.Context, id, readBy string, readAt time.Time) error {
+	dbClient, err := s.dbProvider.GetConfigDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	if _, err := dbClient.ExecuteContext(ctx, queryMarkNotificationRead, id, readBy, readAt,
+		sysContext.ScopeDeploymentID(ctx, s.deploymentID)); err != nil {
+		return fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	return nil
+}
+
+// MarkAllRead marks the unread notifications of the roles as read and returns the number of notifications
+// marked.
+func (s *adminNotificationStore) MarkAllRead(ctx context.Context, roles []string, readBy string,
+	readAt time.Time) (int, error) {
+	dbClient, err := s.dbProvider.GetConfigDBClient()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	query, args := buildMarkAllReadQuery(roles, readBy, readAt, sysContext.ScopeDeploymentID(ctx, s.deploymentID))
+	updated, err := dbClient.ExecuteContext(ctx, query, args...)
+	if err != nil {
+		return 0, fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	return int(updated), nil
+}
+
+// DeleteNotificationsBefore deletes the notifications created before the given time and returns the number
+// of notifications deleted.
+func (s *adminNotificationStore) DeleteNotificationsBefore(ctx context.Context, before time.Time) (int, error) {
+	dbClient, err := s.dbProvider.GetConfigDBClient()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	deleted, err := dbClient.ExecuteContext(ctx, queryDeleteNotificationsBefore, before,
+		sysContext.ScopeDeploymentID(ctx, s.deploymentID))
+	if err != nil {
+		return 0, fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	return int(deleted), nil
+}
+
+// buildNotificationFromResultRow constructs a Notification from a database result row.
+func buildNotificationFromResultRow(row map[string]interface{}) (*Notification, error) {
+	id, ok := row["id"].(string)
+	if !ok {
+		return nil, fmt.Errorf("failed to parse id as string")
+	}
+	eventType, ok := row["event_type"].(string)
+	if !ok {
+		return nil, fmt.Errorf("failed to parse event_type as string")
+	}
+	createdAt, err := dbutils.ParseTimeField(row["created_at"], "created_at")
+	if err != nil {
+		return nil, err
+	}
+
+	notification := &Notification{
+		ID:        id,
+		Type:      eventType,
+		CreatedAt: createdAt,
+	}
+	severity, _ := row["severity"].(string)
+	notification.Severity = Severity(severity)
+	notification.Title, _ = row["title"].(string)
+	notification.Message = dbutils.ParseStringOrBytes(row["message"])
+	notification.Role, _ = row["recipient_role"].(string)
+	notification.SourceKey, _ = row["source_key"].(string)
+	isRead, _ := row["is_read"].(string)
+	notification.Read = utils.NumStringToBool(isRead)
+	notification.ReadBy, _ = row["read_by"].(string)
+
+	if details := dbutils.ParseStringOrBytes(row["details"]); details != "" {
+		if err := json.Unmarshal([]byte(details), &notification.Details); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal notification details: %w", err)
+		}
+	}
+	if row["read_at"] != nil {
+		readAt, err := dbutils.ParseTimeField(row["read_at"], "read_at")
+		if err != nil {
+			return nil, err
+		}
+		notification.ReadAt = &readAt
+	}
+
+	return notification, nil
+}
+
+// parseCount returns the total of a COUNT query result.
+func parseCount(results []map[string]interface{}) int {
+	if len(results) > 0 {
+		switch total := results[0]["total"].(type) {
+		case int64:
+			return int(total)
+		case float64:
+			return int(total)
+		}
+	}
+	return 0
+}
+
+// toNullableString maps an empty string to a SQL NULL.
+func toNullableString(value string) interface{} {
+	if value == "" {
+		return nil
+	}
+	return value
+}
+
+// toNullableTime maps a nil time to a SQL NULL.
+func toNullableTime(value *time.Time) interface{} {
+	if value == nil {
+		return nil
+	}
+	return *value
+}
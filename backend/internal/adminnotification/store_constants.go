@@ -0,0 +1,134 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package adminnotification
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	dbmodel "github.com/thunder-id/thunderid/internal/system/database/model"
+	"github.com/thunder-id/thunderid/internal/system/utils"
+)
+
+const notificationColumns = `ID, EVENT_TYPE, SEVERITY, TITLE, MESSAGE, DETAILS, RECIPIENT_ROLE, SOURCE_KEY, ` +
+	`IS_READ, READ_BY, READ_AT, CREATED_AT`
+
+var (
+	// queryCreateNotification is the query to create a new notification.
+	queryCreateNotification = dbmodel.DBQuery{
+		ID: "ANF-ADMIN_NOTIFICATION_MGT-01",
+		Query: `INSERT INTO "ADMIN_NOTIFICATION" (` + notificationColumns + `, DEPLOYMENT_ID) ` +
+			`VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)`,
+	}
+	// queryCountUnreadBySourceKey is the query to count the unread notifications of a role reporting a
+	// condition.
+	queryCountUnreadBySourceKey = dbmodel.DBQuery{
+		ID: "ANF-ADMIN_NOTIFICATION_MGT-02",
+		Query: `SELECT COUNT(*) AS total FROM "ADMIN_NOTIFICATION" WHERE RECIPIENT_ROLE = $1 ` +
+			`AND SOURCE_KEY = $2 AND IS_READ = '0' AND DEPLOYMENT_ID = $3`,
+	}
+	// queryGetNotificationByID is the query to get a notification by its ID.
+	queryGetNotificationByID = dbmodel.DBQuery{
+		ID: "ANF-ADMIN_NOTIFICATION_MGT-03",
+		Query: `SELECT ` + notificationColumns + ` FROM "ADMIN_NOTIFICATION" ` +
+			`WHERE ID = $1 AND DEPLOYMENT_ID = $2`,
+	}
+	// queryMarkNotificationRead is the query to mark an unread notification as read.
+	queryMarkNotificationRead = dbmodel.DBQuery{
+		ID: "ANF-ADMIN_NOTIFICATION_MGT-04",
+		Query: `UPDATE "ADMIN_NOTIFICATION" SET IS_READ = '1', READ_BY = $2, READ_AT = $3 ` +
+			`WHERE ID = $1 AND IS_READ = '0' AND DEPLOYMENT_ID = $4`,
+	}
+	// queryDeleteNotificationsBefore is the query to delete the notifications created before a time.
+	queryDeleteNotificationsBefore = dbmodel.DBQuery{
+		ID:    "ANF-ADMIN_NOTIFICATION_MGT-05",
+		Query: `DELETE FROM "ADMIN_NOTIFICATION" WHERE CREATED_AT < $1 AND DEPLOYMENT_ID = $2`,
+	}
+)
+
+// buildNotificationFilterClause returns the WHERE clause and args restricting the notifications to the
+// filter and the deployment. Empty filter fields are not part of the clause.
+func buildNotificationFilterClause(filter NotificationFilter, deploymentID string) (string, []interface{}) {
+	conditions := make([]string, 0, 4)
+	args := make([]interface{}, 0, len(filter.Roles)+3)
+
+	placeholders := make([]string, 0, len(filter.Roles))
+	for _, role := range filter.Roles {
+		args = append(args, role)
+		placeholders = append(placeholders, fmt.Sprintf("$%d", len(args)))
+	}
+	conditions = append(conditions, fmt.Sprintf("RECIPIENT_ROLE IN (%s)", strings.Join(placeholders, ", ")))
+
+	if filter.Severity != "" {
+		args = append(args, string(filter.Severity))
+		conditions = append(conditions, fmt.Sprintf("SEVERITY = $%d", len(args)))
+	}
+	if filter.Read != nil {
+		args = append(args, utils.BoolToNumString(*filter.Read))
+		conditions = append(conditions, fmt.Sprintf("IS_READ = $%d", len(args)))
+	}
+	args = append(args, deploymentID)
+	conditions = append(conditions, fmt.Sprintf("DEPLOYMENT_ID = $%d", len(args)))
+
+	return "WHERE " + strings.Join(conditions, " AND "), args
+}
+
+// buildListNotificationsQuery returns the query and args to retrieve a page of the notifications matching
+// the filter, latest first.
+func buildListNotificationsQuery(filter NotificationFilter, limit, offset int,
+	deploymentID string) (dbmodel.DBQuery, []interface{}) {
+	whereClause, args := buildNotificationFilterClause(filter, deploymentID)
+	args = append(args, limit, offset)
+
+	return dbmodel.DBQuery{
+		ID: "ANF-ADMIN_NOTIFICATION_MGT-06",
+		Query: fmt.Sprintf(`SELECT `+notificationColumns+` FROM "ADMIN_NOTIFICATION" %s `+
+			`ORDER BY CREATED_AT DESC, ID DESC LIMIT $%d OFFSET $%d`, whereClause, len(args)-1, len(args)),
+	}, args
+}
+
+// buildCountNotificationsQuery returns the query and args to count the notifications matching the filter.
+func buildCountNotificationsQuery(filter NotificationFilter, deploymentID string) (dbmodel.DBQuery, []interface{}) {
+	whereClause, args := buildNotificationFilterClause(filter, deploymentID)
+
+	return dbmodel.DBQuery{
+		ID:    "ANF-ADMIN_NOTIFICATION_MGT-07",
+		Query: `SELECT COUNT(*) AS total FROM "ADMIN_NOTIFICATION" ` + whereClause,
+	}, args
+}
+
+// buildMarkAllReadQuery returns the query and args to mark the unread notifications of the roles as read.
+func buildMarkAllReadQuery(roles []string, readBy string, readAt time.Time,
+	deploymentID string) (dbmodel.DBQuery, []interface{}) {
+	args := []interface{}{readBy, readAt}
+	placeholders := make([]string, 0, len(roles))
+	for _, role := range roles {
+		args = append(args, role)
+		placeholders = append(placeholders, fmt.Sprintf("$%d", len(args)))
+	}
+	args = append(args, deploymentID)
+
+	return dbmodel.DBQuery{
+		ID: "ANF-ADMIN_NOTIFICATION_MGT-08",
+		Query: fmt.Sprintf(`UPDATE "ADMIN_NOTIFICATION" SET IS_READ = '1', READ_BY = $1, READ_AT = $2 `+
+			`WHERE RECIPIENT_ROLE IN (%s) AND IS_READ = '0' AND DEPLOYMENT_ID = $%d`,
+			strings.Join(placeholders, ", "), len(args)),
+	}, args
+}
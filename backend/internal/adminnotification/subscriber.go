@@ -0,0 +1,79 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package adminnotification
+
+import (
+	"context"
+
+	sysContext "github.com/thunder-id/thunderid/internal/system/context"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/internal/system/observability/event"
+	"github.com/thunder-id/thunderid/internal/system/observability/subscriber"
+)
+
+// notificationSubscriber stores the operational events published on the event bus as notifications.
+type notificationSubscriber struct {
+	service AdminNotificationServiceInterface
+	logger  *log.Logger
+}
+
+var _ subscriber.SubscriberInterface = (*notificationSubscriber)(nil)
+
+// newNotificationSubscriber creates a new instance of notificationSubscriber.
+func newNotificationSubscriber(service AdminNotificationServiceInterface) *notificationSubscriber {
+	return &notificationSubscriber{
+		service: service,
+		logger:  log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName)),
+	}
+}
+
+// GetID returns the unique identifier of the subscriber.
+func (s *notificationSubscriber) GetID() string {
+	return subscriberID
+}
+
+// GetCategories returns the operational category, which the subscriber records notifications for.
+func (s *notificationSubscriber) GetCategories() []event.EventCategory {
+	return []event.EventCategory{event.CategoryOperations}
+}
+
+// OnEvent records an operational event as notifications of the tenant the event occurred in.
+func (s *notificationSubscriber) OnEvent(evt *event.Event) error {
+	ctx := sysContext.WithTenantID(context.Background(), evt.TenantID)
+	if err := s.service.RecordEvent(ctx, evt); err != nil {
+		s.logger.Error("Failed to record notification", log.String("eventType", evt.Type), log.Error(err))
+		return err
+	}
+	return nil
+}
+
+// Close releases no resources.
+func (s *notificationSubscriber) Close() error {
+	return nil
+}
+
+// IsEnabled reports true, since the subscriber is only created when notifications are enabled.
+func (s *notificationSubscriber) IsEnabled() bool {
+	return true
+}
+
+// Initialize needs no setup.
+func (s *notificationSubscriber) Initialize() error {
+	return nil
+}
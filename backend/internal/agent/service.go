@@ -1082,9 +1082,14 @@ func buildOAuthProfile(configs []inboundmodel.InboundAuthConfigWithSecret) *inbo
 		PKCERequired:                       cfg.PKCERequired,
 		PublicClient:                       cfg.PublicClient,
 		RequirePushedAuthorizationRequests: cfg.RequirePushedAuthorizationRequests,
+		DPoPBoundAccessTokens:              cfg.DPoPBoundAccessTokens,
 		Certificate:                        cfg.Certificate,
 		Token:                              cfg.Token,
 		Scopes:                             cfg.Scopes,
+		AllowedScopes:                      cfg.AllowedScopes,
+		RejectDisallowedScopes:             cfg.RejectDisallowedScopes,
+		AllowedScopesByGrantType:           cfg.AllowedScopesByGrantType,
+		Logout:                             cfg.Logout,
 		UserInfo:                           cfg.UserInfo,
 		ScopeClaims:                        cfg.ScopeClaims,
 	}
@@ -1105,9 +1110,14 @@ func oauthProfileToComplete(clientID string, p *inboundmodel.OAuthProfile) *inbo
 		PKCERequired:                       p.PKCERequired,
 		PublicClient:                       p.PublicClient,
 		RequirePushedAuthorizationRequests: p.RequirePushedAuthorizationRequests,
+		DPoPBoundAccessTokens:              p.DPoPBoundAccessTokens,
 		Certificate:                        p.Certificate,
 		Token:                              p.Token,
 		Scopes:                             p.Scopes,
+		AllowedScopes:                      p.AllowedScopes,
+		RejectDisallowedScopes:             p.RejectDisallowedScopes,
+		AllowedScopesByGrantType:           p.AllowedScopesByGrantType,
+		Logout:                             p.Logout,
 		UserInfo:                           p.UserInfo,
 		ScopeClaims:                        p.ScopeClaims,
 	}
@@ -1128,9 +1138,14 @@ func oauthProfileToConfig(clientID string, p *inboundmodel.OAuthProfile) *inboun
 		PKCERequired:                       p.PKCERequired,
 		PublicClient:                       p.PublicClient,
 		RequirePushedAuthorizationRequests: p.RequirePushedAuthorizationRequests,
+		DPoPBoundAccessTokens:              p.DPoPBoundAccessTokens,
 		Certificate:                        p.Certificate,
 		Token:                              p.Token,
 		Scopes:                             p.Scopes,
+		AllowedScopes:                      p.AllowedScopes,
+		RejectDisallowedScopes:             p.RejectDisallowedScopes,
+		AllowedScopesByGrantType:           p.AllowedScopesByGrantType,
+		Logout:                             p.Logout,
 		UserInfo:                           p.UserInfo,
 		ScopeClaims:                        p.ScopeClaims,
 	}
@@ -1273,6 +1288,25 @@ func translateOAuthValidationError(err error) *serviceerror.ServiceError {
 			DefaultValue: "authorization_code grant type requires redirect URIs",
 		})
 
+	// OAuth: logout
+	case errors.Is(err, inboundclient.ErrOAuthInvalidFrontChannelLogoutURI):
+		return serviceerror.CustomServiceError(ErrorInvalidOAuthConfiguration, core.I18nMessage{
+			Key:          "error.agentservice.invalid_frontchannel_logout_uri_description",
+			DefaultValue: "Front-channel logout URI must be an absolute URL without a fragment",
+		})
+	case errors.Is(err, inboundclient.ErrOAuthInvalidBackChannelLogoutURI):
+		return serviceerror.CustomServiceError(ErrorInvalidOAuthConfiguration, core.I18nMessage{
+			Key:          "error.agentservice.invalid_backchannel_logout_uri_description",
+			DefaultValue: "Back-channel logout URI must be a publicly reachable HTTPS URL without a fragment",
+		})
+
+	// OAuth: refresh token lifetime
+	case errors.Is(err, inboundclient.ErrOAuthInvalidRefreshTokenLifetime):
+		return serviceerror.CustomServiceError(ErrorInvalidOAuthConfiguration, core.I18nMessage{
+			Key:          "error.agentservice.invalid_refresh_token_lifetime_description",
+			DefaultValue: "Refresh token validity period and idle timeout must not be negative",
+		})
+
 	// OAuth: grant + response type
 	case errors.Is(err, inboundclient.ErrOAuthInvalidGrantType):
 		return &ErrorInvalidGrantType
@@ -37,3 +37,6 @@ const (
 	propMetadata    = "metadata"
 	propOAuthConfig = "oauth_config"
 )
+
+// queryParamInactiveSince is the query parameter narrowing the application list to inactive applications.
+const queryParamInactiveSince = "inactiveSince"
@@ -180,6 +180,8 @@ func parseToApplicationDTO(data []byte) (*model.ApplicationDTO, error) {
 			Assertion:                 appRequest.Assertion,
 			Certificate:               appRequest.Certificate,
 			AllowedUserTypes:          appRequest.AllowedUserTypes,
+			AllowedAuthMethods:        appRequest.AllowedAuthMethods,
+			UserIsolation:             appRequest.UserIsolation,
 			LoginConsent:              appRequest.LoginConsent,
 		},
 		Template:  appRequest.Template,
@@ -203,17 +205,28 @@ func parseToApplicationDTO(data []byte) (*model.ApplicationDTO, error) {
 					ClientID:                           config.OAuthConfig.ClientID,
 					ClientSecret:                       config.OAuthConfig.ClientSecret,
 					RedirectURIs:                       config.OAuthConfig.RedirectURIs,
+					RedirectURIMatchMode:               config.OAuthConfig.RedirectURIMatchMode,
 					GrantTypes:                         config.OAuthConfig.GrantTypes,
 					ResponseTypes:                      config.OAuthConfig.ResponseTypes,
 					TokenEndpointAuthMethod:            config.OAuthConfig.TokenEndpointAuthMethod,
 					PKCERequired:                       config.OAuthConfig.PKCERequired,
 					PublicClient:                       config.OAuthConfig.PublicClient,
 					RequirePushedAuthorizationRequests: config.OAuthConfig.RequirePushedAuthorizationRequests,
+					DPoPBoundAccessTokens:              config.OAuthConfig.DPoPBoundAccessTokens,
+					AllowCredentialDiscovery:           config.OAuthConfig.AllowCredentialDiscovery,
+					ProtocolTraceEnabled:               config.OAuthConfig.ProtocolTraceEnabled,
+					RequestObjectSigningAlg:            config.OAuthConfig.RequestObjectSigningAlg,
 					Token:                              config.OAuthConfig.Token,
 					Scopes:                             config.OAuthConfig.Scopes,
+					AllowedScopes:                      config.OAuthConfig.AllowedScopes,
+					RejectDisallowedScopes:             config.OAuthConfig.RejectDisallowedScopes,
+					AllowedScopesByGrantType:           config.OAuthConfig.AllowedScopesByGrantType,
+					Logout:                             config.OAuthConfig.Logout,
 					UserInfo:                           config.OAuthConfig.UserInfo,
 					ScopeClaims:                        config.OAuthConfig.ScopeClaims,
 					Certificate:                        config.OAuthConfig.Certificate,
+					ClaimFallbacks:                     config.OAuthConfig.ClaimFallbacks,
+					TokenCustomization:                 config.OAuthConfig.TokenCustomization,
 				},
 			}
 			inboundAuthConfigDTOs = append(inboundAuthConfigDTOs, inboundAuthConfigDTO)
@@ -501,4 +501,111 @@ var (
 			DefaultValue: "The provided recovery flow ID is invalid",
 		},
 	}
+	// ErrorInvalidAllowedAuthMethods is the error returned when the allowed authentication methods are invalid.
+	ErrorInvalidAllowedAuthMethods = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "APP-1037",
+		Error: core.I18nMessage{
+			Key:          "error.applicationservice.invalid_allowed_auth_methods",
+			DefaultValue: "Invalid allowed authentication methods",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key: "error.applicationservice.invalid_allowed_auth_methods_description",
+			DefaultValue: "At least one authentication method must be allowed and federated identity provider " +
+				"IDs must be non-empty and unique",
+		},
+	}
+	// ErrorApplicationQuotaExceeded is the error returned when creating an application would exceed the
+	// application quota.
+	ErrorApplicationQuotaExceeded = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "APP-1038",
+		Error: core.I18nMessage{
+			Key:          "error.applicationservice.quota_exceeded",
+			DefaultValue: "Quota exceeded",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.applicationservice.quota_exceeded_description",
+			DefaultValue: "The application quota of the organization unit or the tenant has been reached",
+		},
+	}
+	// ErrorInvalidLimit is the error returned when the limit parameter is invalid.
+	ErrorInvalidLimit = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "APP-1039",
+		Error: core.I18nMessage{
+			Key:          "error.applicationservice.invalid_limit_parameter",
+			DefaultValue: "Invalid pagination parameter",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.applicationservice.invalid_limit_parameter_description",
+			DefaultValue: "The limit parameter must be between 1 and 100",
+		},
+	}
+	// ErrorInvalidOffset is the error returned when the offset parameter is invalid.
+	ErrorInvalidOffset = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "APP-1040",
+		Error: core.I18nMessage{
+			Key:          "error.applicationservice.invalid_offset_parameter",
+			DefaultValue: "Invalid pagination parameter",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.applicationservice.invalid_offset_parameter_description",
+			DefaultValue: "The offset parameter must be a non-negative integer",
+		},
+	}
+	// ErrorInvalidInactiveSince is the error returned when the inactiveSince parameter is invalid.
+	ErrorInvalidInactiveSince = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "APP-1041",
+		Error: core.I18nMessage{
+			Key:          "error.applicationservice.invalid_inactive_since_parameter",
+			DefaultValue: "Invalid inactiveSince parameter",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.applicationservice.invalid_inactive_since_parameter_description",
+			DefaultValue: "The inactiveSince parameter must be an RFC 3339 timestamp or a date",
+		},
+	}
+	// ErrorInvalidClaimFallbacks is the error returned when a claim fallback chain of the application is invalid.
+	ErrorInvalidClaimFallbacks = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "APP-1042",
+		Error: core.I18nMessage{
+			Key:          "error.applicationservice.invalid_claim_fallbacks",
+			DefaultValue: "Invalid claim fallbacks",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.applicationservice.invalid_claim_fallbacks_description",
+			DefaultValue: "One or more claim fallback chains of the application are invalid",
+		},
+	}
+	// ErrorOAuthConfigNotFound is the error returned when the application has no OAuth configuration.
+	ErrorOAuthConfigNotFound = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "APP-1043",
+		Error: core.I18nMessage{
+			Key:          "error.applicationservice.oauth_config_not_found",
+			DefaultValue: "OAuth configuration not found",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.applicationservice.oauth_config_not_found_description",
+			DefaultValue: "The application does not have an OAuth configuration",
+		},
+	}
+	// ErrorInvalidTokenCustomization is the error returned when a token customization rule of the application is
+	// invalid.
+	ErrorInvalidTokenCustomization = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "APP-1044",
+		Error: core.I18nMessage{
+			Key:          "error.applicationservice.invalid_token_customization",
+			DefaultValue: "Invalid token customization",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.applicationservice.invalid_token_customization_description",
+			DefaultValue: "One or more token customization rules of the application are invalid",
+		},
+	}
 )
@@ -20,6 +20,8 @@ package application
 
 import (
 	"net/http"
+	"net/url"
+	"time"
 
 	inboundmodel "github.com/thunder-id/thunderid/internal/inboundclient/model"
 
@@ -73,6 +75,8 @@ func (ah *applicationHandler) HandleApplicationPostRequest(w http.ResponseWriter
 			Assertion:                 appRequest.Assertion,
 			Certificate:               appRequest.Certificate,
 			AllowedUserTypes:          appRequest.AllowedUserTypes,
+			AllowedAuthMethods:        appRequest.AllowedAuthMethods,
+			UserIsolation:             appRequest.UserIsolation,
 			LoginConsent:              appRequest.LoginConsent,
 		},
 		Template:  appRequest.Template,
@@ -108,6 +112,8 @@ func (ah *applicationHandler) HandleApplicationPostRequest(w http.ResponseWriter
 			Assertion:                 createdAppDTO.Assertion,
 			Certificate:               createdAppDTO.Certificate,
 			AllowedUserTypes:          createdAppDTO.AllowedUserTypes,
+			AllowedAuthMethods:        createdAppDTO.AllowedAuthMethods,
+			UserIsolation:             createdAppDTO.UserIsolation,
 			LoginConsent:              createdAppDTO.LoginConsent,
 		},
 		Template:  createdAppDTO.Template,
@@ -137,21 +143,93 @@ func (ah *applicationHandler) HandleApplicationPostRequest(w http.ResponseWriter
 }
 
 // HandleApplicationListRequest handles the application request.
+// The complete list is returned unless the limit or offset query parameter is given. The inactiveSince
+// query parameter narrows the list to the applications whose OAuth client has not been used since then.
 func (ah *applicationHandler) HandleApplicationListRequest(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
+	query := r.URL.Query()
+	paginated := query.Has(sysutils.QueryParamLimit) || query.Has(sysutils.QueryParamOffset)
+	pagination, svcErr := sysutils.ParsePaginationParams(query, &ErrorInvalidLimit, &ErrorInvalidOffset)
+	if svcErr != nil {
+		ah.handleError(w, r, svcErr)
+		return
+	}
+
+	var inactiveSince time.Time
+	extraQuery := ""
+	if query.Has(queryParamInactiveSince) {
+		value := query.Get(queryParamInactiveSince)
+		if inactiveSince, svcErr = parseInactiveSince(value); svcErr != nil {
+			ah.handleError(w, r, svcErr)
+			return
+		}
+		extraQuery = "&" + queryParamInactiveSince + "=" + url.QueryEscape(value)
+	}
+
 	listResponse, svcErr := ah.service.GetApplicationList(ctx)
 	if svcErr != nil {
 		ah.handleError(w, r, svcErr)
 		return
 	}
 
+	if !inactiveSince.IsZero() {
+		filterInactiveApplications(listResponse, inactiveSince)
+	}
+	if paginated {
+		paginateApplicationList(listResponse, pagination.Limit, pagination.Offset, extraQuery)
+	}
+
 	sysutils.WriteSuccessResponse(w, http.StatusOK, listResponse)
 }
 
+// parseInactiveSince parses the inactiveSince query parameter, given as an RFC 3339 timestamp or a date.
+func parseInactiveSince(value string) (time.Time, *serviceerror.ServiceError) {
+	if inactiveSince, err := time.Parse(time.RFC3339, value); err == nil {
+		return inactiveSince, nil
+	}
+	if inactiveSince, err := time.Parse(time.DateOnly, value); err == nil {
+		return inactiveSince, nil
+	}
+	return time.Time{}, &ErrorInvalidInactiveSince
+}
+
+// filterInactiveApplications narrows the application list to the applications whose OAuth client has not
+// obtained a token or initiated an authorization request since the given time, including the ones never used.
+func filterInactiveApplications(listResponse *model.ApplicationListResponse, inactiveSince time.Time) {
+	inactive := make([]model.BasicApplicationResponse, 0, len(listResponse.Applications))
+	for _, app := range listResponse.Applications {
+		if isUsedSince(app.LastTokenIssuedAt, inactiveSince) || isUsedSince(app.LastAuthorizedAt, inactiveSince) {
+			continue
+		}
+		inactive = append(inactive, app)
+	}
+
+	listResponse.Applications = inactive
+	listResponse.TotalResults = len(inactive)
+	listResponse.Count = len(inactive)
+}
+
+// isUsedSince reports whether a recorded use happened at or after the given time.
+func isUsedSince(usedAt *time.Time, since time.Time) bool {
+	return usedAt != nil && !usedAt.Before(since)
+}
+
+// paginateApplicationList narrows the application list to the requested page and adds pagination links.
+// extraQuery carries the filters of the list into the links.
+func paginateApplicationList(listResponse *model.ApplicationListResponse, limit, offset int, extraQuery string) {
+	total := len(listResponse.Applications)
+	start := min(offset, total)
+	end := min(start+limit, total)
+
+	listResponse.Applications = listResponse.Applications[start:end]
+	listResponse.Count = len(listResponse.Applications)
+	listResponse.StartIndex = offset + 1
+	listResponse.Links = sysutils.BuildPaginationLinks("/applications", limit, offset, total, extraQuery)
+}
+
 // HandleApplicationGetRequest handles the application request.
 func (ah *applicationHandler) HandleApplicationGetRequest(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
-	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, "ApplicationHandler"))
 
 	id := r.PathValue("id")
 	if id == "" {
@@ -170,6 +248,37 @@ func (ah *applicationHandler) HandleApplicationGetRequest(w http.ResponseWriter,
 		return
 	}
 
+	ah.writeApplicationGetResponse(w, appDTO)
+}
+
+// HandleApplicationGetByHandleRequest handles the get application by name request.
+func (ah *applicationHandler) HandleApplicationGetByHandleRequest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	name := r.PathValue("handle")
+	if name == "" {
+		errResp := apierror.ErrorResponse{
+			Code:        ErrorInvalidApplicationName.Code,
+			Message:     ErrorInvalidApplicationName.Error,
+			Description: ErrorInvalidApplicationName.ErrorDescription,
+		}
+		sysutils.WriteErrorResponse(w, http.StatusBadRequest, errResp)
+		return
+	}
+
+	appDTO, svcErr := ah.service.GetApplicationByName(ctx, name)
+	if svcErr != nil {
+		ah.handleError(w, r, svcErr)
+		return
+	}
+
+	ah.writeApplicationGetResponse(w, appDTO)
+}
+
+// writeApplicationGetResponse converts the application to its HTTP representation and writes it.
+func (ah *applicationHandler) writeApplicationGetResponse(w http.ResponseWriter, appDTO *model.Application) {
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, "ApplicationHandler"))
+
 	returnApp := model.ApplicationGetResponse{
 		ID:          appDTO.ID,
 		OUID:        appDTO.OUID,
@@ -186,6 +295,8 @@ func (ah *applicationHandler) HandleApplicationGetRequest(w http.ResponseWriter,
 			Assertion:                 appDTO.Assertion,
 			Certificate:               appDTO.Certificate,
 			AllowedUserTypes:          appDTO.AllowedUserTypes,
+			AllowedAuthMethods:        appDTO.AllowedAuthMethods,
+			UserIsolation:             appDTO.UserIsolation,
 			LoginConsent:              appDTO.LoginConsent,
 		},
 		Template:  appDTO.Template,
@@ -195,6 +306,9 @@ func (ah *applicationHandler) HandleApplicationGetRequest(w http.ResponseWriter,
 		PolicyURI: appDTO.PolicyURI,
 		Contacts:  appDTO.Contacts,
 		Metadata:  appDTO.Metadata,
+
+		LastTokenIssuedAt: appDTO.LastTokenIssuedAt,
+		LastAuthorizedAt:  appDTO.LastAuthorizedAt,
 	}
 
 	// TODO: Need to refactor when supporting other/multiple inbound auth types.
@@ -251,18 +365,29 @@ func (ah *applicationHandler) HandleApplicationGetRequest(w http.ResponseWriter,
 			oAuthAppConfig := inboundmodel.OAuthConfig{
 				ClientID:                           config.OAuthConfig.ClientID,
 				RedirectURIs:                       redirectURIs,
+				RedirectURIMatchMode:               config.OAuthConfig.RedirectURIMatchMode,
 				GrantTypes:                         grantTypes,
 				ResponseTypes:                      responseTypes,
 				TokenEndpointAuthMethod:            config.OAuthConfig.TokenEndpointAuthMethod,
 				PKCERequired:                       config.OAuthConfig.PKCERequired,
 				PublicClient:                       config.OAuthConfig.PublicClient,
 				RequirePushedAuthorizationRequests: config.OAuthConfig.RequirePushedAuthorizationRequests,
+				DPoPBoundAccessTokens:              config.OAuthConfig.DPoPBoundAccessTokens,
+				AllowCredentialDiscovery:           config.OAuthConfig.AllowCredentialDiscovery,
+				ProtocolTraceEnabled:               config.OAuthConfig.ProtocolTraceEnabled,
+				RequestObjectSigningAlg:            config.OAuthConfig.RequestObjectSigningAlg,
 				Token:                              config.OAuthConfig.Token,
 				Scopes:                             config.OAuthConfig.Scopes,
+				AllowedScopes:                      config.OAuthConfig.AllowedScopes,
+				RejectDisallowedScopes:             config.OAuthConfig.RejectDisallowedScopes,
+				AllowedScopesByGrantType:           config.OAuthConfig.AllowedScopesByGrantType,
+				Logout:                             config.OAuthConfig.Logout,
 				UserInfo:                           config.OAuthConfig.UserInfo,
 				ScopeClaims:                        config.OAuthConfig.ScopeClaims,
 				Certificate:                        config.OAuthConfig.Certificate,
 				AcrValues:                          config.OAuthConfig.AcrValues,
+				ClaimFallbacks:                     config.OAuthConfig.ClaimFallbacks,
+				TokenCustomization:                 config.OAuthConfig.TokenCustomization,
 			}
 			returnInboundAuthConfigs = append(returnInboundAuthConfigs, inboundmodel.InboundAuthConfig{
 				Type:        config.Type,
@@ -319,6 +444,8 @@ func (ah *applicationHandler) HandleApplicationPutRequest(w http.ResponseWriter,
 			Assertion:                 appRequest.Assertion,
 			Certificate:               appRequest.Certificate,
 			AllowedUserTypes:          appRequest.AllowedUserTypes,
+			AllowedAuthMethods:        appRequest.AllowedAuthMethods,
+			UserIsolation:             appRequest.UserIsolation,
 			LoginConsent:              appRequest.LoginConsent,
 		},
 		Template:  appRequest.Template,
@@ -354,6 +481,8 @@ func (ah *applicationHandler) HandleApplicationPutRequest(w http.ResponseWriter,
 			Assertion:                 updatedAppDTO.Assertion,
 			Certificate:               updatedAppDTO.Certificate,
 			AllowedUserTypes:          updatedAppDTO.AllowedUserTypes,
+			AllowedAuthMethods:        updatedAppDTO.AllowedAuthMethods,
+			UserIsolation:             updatedAppDTO.UserIsolation,
 			LoginConsent:              updatedAppDTO.LoginConsent,
 		},
 		Template:  updatedAppDTO.Template,
@@ -405,6 +534,92 @@ func (ah *applicationHandler) HandleApplicationDeleteRequest(w http.ResponseWrit
 	sysutils.WriteSuccessResponse(w, http.StatusNoContent, nil)
 }
 
+// HandleAllowedScopesGetRequest handles the request to list the allowed-scope policies of an application.
+func (ah *applicationHandler) HandleAllowedScopesGetRequest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	id := r.PathValue("id")
+	if id == "" {
+		errResp := apierror.ErrorResponse{
+			Code:        ErrorInvalidApplicationID.Code,
+			Message:     ErrorInvalidApplicationID.Error,
+			Description: ErrorInvalidApplicationID.ErrorDescription,
+		}
+		sysutils.WriteErrorResponse(w, http.StatusBadRequest, errResp)
+		return
+	}
+
+	policies, svcErr := ah.service.GetAllowedScopesByGrantType(ctx, id)
+	if svcErr != nil {
+		ah.handleError(w, r, svcErr)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(w, http.StatusOK, model.AllowedScopesResponse{
+		AllowedScopesByGrantType: policies,
+	})
+}
+
+// HandleGrantTypeAllowedScopesPutRequest handles the request to replace the allowed scopes of a grant type.
+func (ah *applicationHandler) HandleGrantTypeAllowedScopesPutRequest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	id := r.PathValue("id")
+	if id == "" {
+		errResp := apierror.ErrorResponse{
+			Code:        ErrorInvalidApplicationID.Code,
+			Message:     ErrorInvalidApplicationID.Error,
+			Description: ErrorInvalidApplicationID.ErrorDescription,
+		}
+		sysutils.WriteErrorResponse(w, http.StatusBadRequest, errResp)
+		return
+	}
+	grantType := r.PathValue("grantType")
+
+	scopesRequest, err := sysutils.DecodeJSONBody[model.GrantTypeAllowedScopesRequest](r)
+	if err != nil {
+		errResp := apierror.ErrorResponse{
+			Code:        ErrorInvalidRequestFormat.Code,
+			Message:     ErrorInvalidRequestFormat.Error,
+			Description: ErrorInvalidRequestFormat.ErrorDescription,
+		}
+		sysutils.WriteErrorResponse(w, http.StatusBadRequest, errResp)
+		return
+	}
+
+	scopes, svcErr := ah.service.UpdateGrantTypeAllowedScopes(ctx, id, grantType, scopesRequest.Scopes)
+	if svcErr != nil {
+		ah.handleError(w, r, svcErr)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(w, http.StatusOK, model.GrantTypeAllowedScopesResponse{
+		GrantType: grantType,
+		Scopes:    scopes,
+	})
+}
+
+// HandleGrantTypeAllowedScopesDeleteRequest handles the request to remove the allowed scopes of a grant type.
+func (ah *applicationHandler) HandleGrantTypeAllowedScopesDeleteRequest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	id := r.PathValue("id")
+	if id == "" {
+		errResp := apierror.ErrorResponse{
+			Code:        ErrorInvalidApplicationID.Code,
+			Message:     ErrorInvalidApplicationID.Error,
+			Description: ErrorInvalidApplicationID.ErrorDescription,
+		}
+		sysutils.WriteErrorResponse(w, http.StatusBadRequest, errResp)
+		return
+	}
+
+	svcErr := ah.service.DeleteGrantTypeAllowedScopes(ctx, id, r.PathValue("grantType"))
+	if svcErr != nil {
+		ah.handleError(w, r, svcErr)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(w, http.StatusNoContent, nil)
+}
+
 // processInboundAuthConfig prepares the response for OAuth app configuration.
 func (ah *applicationHandler) processInboundAuthConfig(logger *log.Logger, appDTO *model.ApplicationDTO,
 	returnApp *model.ApplicationCompleteResponse) bool {
@@ -443,18 +658,29 @@ func (ah *applicationHandler) processInboundAuthConfig(logger *log.Logger, appDT
 				ClientID:                           config.OAuthConfig.ClientID,
 				ClientSecret:                       config.OAuthConfig.ClientSecret,
 				RedirectURIs:                       redirectURIs,
+				RedirectURIMatchMode:               config.OAuthConfig.RedirectURIMatchMode,
 				GrantTypes:                         grantTypes,
 				ResponseTypes:                      responseTypes,
 				TokenEndpointAuthMethod:            config.OAuthConfig.TokenEndpointAuthMethod,
 				PKCERequired:                       config.OAuthConfig.PKCERequired,
 				PublicClient:                       config.OAuthConfig.PublicClient,
 				RequirePushedAuthorizationRequests: config.OAuthConfig.RequirePushedAuthorizationRequests,
+				DPoPBoundAccessTokens:              config.OAuthConfig.DPoPBoundAccessTokens,
+				AllowCredentialDiscovery:           config.OAuthConfig.AllowCredentialDiscovery,
+				ProtocolTraceEnabled:               config.OAuthConfig.ProtocolTraceEnabled,
+				RequestObjectSigningAlg:            config.OAuthConfig.RequestObjectSigningAlg,
 				Token:                              config.OAuthConfig.Token,
 				Scopes:                             config.OAuthConfig.Scopes,
+				AllowedScopes:                      config.OAuthConfig.AllowedScopes,
+				RejectDisallowedScopes:             config.OAuthConfig.RejectDisallowedScopes,
+				AllowedScopesByGrantType:           config.OAuthConfig.AllowedScopesByGrantType,
+				Logout:                             config.OAuthConfig.Logout,
 				UserInfo:                           config.OAuthConfig.UserInfo,
 				ScopeClaims:                        config.OAuthConfig.ScopeClaims,
 				Certificate:                        config.OAuthConfig.Certificate,
 				AcrValues:                          config.OAuthConfig.AcrValues,
+				ClaimFallbacks:                     config.OAuthConfig.ClaimFallbacks,
+				TokenCustomization:                 config.OAuthConfig.TokenCustomization,
 			}
 			returnInboundAuthConfigs = append(returnInboundAuthConfigs, inboundmodel.InboundAuthConfigWithSecret{
 				Type:        config.Type,
@@ -480,9 +706,12 @@ func (ah *applicationHandler) handleError(w http.ResponseWriter, r *http.Request
 
 	statusCode := http.StatusInternalServerError
 	if svcErr.Type == serviceerror.ClientErrorType {
-		if svcErr.Code == ErrorApplicationNotFound.Code {
+		switch svcErr.Code {
+		case ErrorApplicationNotFound.Code, ErrorOAuthConfigNotFound.Code:
 			statusCode = http.StatusNotFound
-		} else {
+		case ErrorApplicationQuotaExceeded.Code:
+			statusCode = http.StatusConflict
+		default:
 			statusCode = http.StatusBadRequest
 		}
 	}
@@ -519,18 +748,29 @@ func (ah *applicationHandler) processInboundAuthConfigFromRequest(
 				ClientID:                           config.OAuthConfig.ClientID,
 				ClientSecret:                       config.OAuthConfig.ClientSecret,
 				RedirectURIs:                       config.OAuthConfig.RedirectURIs,
+				RedirectURIMatchMode:               config.OAuthConfig.RedirectURIMatchMode,
 				GrantTypes:                         config.OAuthConfig.GrantTypes,
 				ResponseTypes:                      config.OAuthConfig.ResponseTypes,
 				TokenEndpointAuthMethod:            config.OAuthConfig.TokenEndpointAuthMethod,
 				PKCERequired:                       config.OAuthConfig.PKCERequired,
 				PublicClient:                       config.OAuthConfig.PublicClient,
 				RequirePushedAuthorizationRequests: config.OAuthConfig.RequirePushedAuthorizationRequests,
+				DPoPBoundAccessTokens:              config.OAuthConfig.DPoPBoundAccessTokens,
+				AllowCredentialDiscovery:           config.OAuthConfig.AllowCredentialDiscovery,
+				ProtocolTraceEnabled:               config.OAuthConfig.ProtocolTraceEnabled,
+				RequestObjectSigningAlg:            config.OAuthConfig.RequestObjectSigningAlg,
 				Token:                              config.OAuthConfig.Token,
 				Scopes:                             config.OAuthConfig.Scopes,
+				AllowedScopes:                      config.OAuthConfig.AllowedScopes,
+				RejectDisallowedScopes:             config.OAuthConfig.RejectDisallowedScopes,
+				AllowedScopesByGrantType:           config.OAuthConfig.AllowedScopesByGrantType,
+				Logout:                             config.OAuthConfig.Logout,
 				UserInfo:                           config.OAuthConfig.UserInfo,
 				ScopeClaims:                        config.OAuthConfig.ScopeClaims,
 				Certificate:                        config.OAuthConfig.Certificate,
 				AcrValues:                          config.OAuthConfig.AcrValues,
+				ClaimFallbacks:                     config.OAuthConfig.ClaimFallbacks,
+				TokenCustomization:                 config.OAuthConfig.TokenCustomization,
 			},
 		}
 		inboundAuthConfigDTOs = append(inboundAuthConfigDTOs, inboundAuthConfigDTO)
@@ -24,6 +24,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -371,6 +372,91 @@ func (suite *HandlerTestSuite) TestHandleApplicationListRequest_Success() {
 	mockService.AssertExpectations(suite.T())
 }
 
+func (suite *HandlerTestSuite) TestHandleApplicationListRequest_Paginated() {
+	mockService := NewApplicationServiceInterfaceMock(suite.T())
+	handler := newApplicationHandler(mockService)
+
+	expectedList := &model.ApplicationListResponse{
+		TotalResults: 3,
+		Count:        3,
+		Applications: []model.BasicApplicationResponse{{ID: "app1"}, {ID: "app2"}, {ID: "app3"}},
+	}
+	mockService.On("GetApplicationList", mock.Anything).Return(expectedList, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/applications?limit=2&offset=1", nil)
+	w := httptest.NewRecorder()
+
+	handler.HandleApplicationListRequest(w, req)
+
+	assert.Equal(suite.T(), http.StatusOK, w.Code)
+	var response model.ApplicationListResponse
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 2, response.StartIndex)
+	assert.Equal(suite.T(), 2, response.Count)
+	assert.Equal(suite.T(), "app2", response.Applications[0].ID)
+	assert.NotEmpty(suite.T(), response.Links)
+}
+
+func (suite *HandlerTestSuite) TestHandleApplicationListRequest_InvalidLimit() {
+	mockService := NewApplicationServiceInterfaceMock(suite.T())
+	handler := newApplicationHandler(mockService)
+
+	req := httptest.NewRequest(http.MethodGet, "/applications?limit=500", nil)
+	w := httptest.NewRecorder()
+
+	handler.HandleApplicationListRequest(w, req)
+
+	assert.Equal(suite.T(), http.StatusBadRequest, w.Code)
+	assert.Contains(suite.T(), w.Body.String(), ErrorInvalidLimit.Code)
+}
+
+func (suite *HandlerTestSuite) TestHandleApplicationListRequest_InactiveSince() {
+	mockService := NewApplicationServiceInterfaceMock(suite.T())
+	handler := newApplicationHandler(mockService)
+
+	recent := time.Date(2026, 5, 1, 0, 0, 0, 0, time.UTC)
+	old := time.Date(2025, 12, 1, 0, 0, 0, 0, time.UTC)
+	expectedList := &model.ApplicationListResponse{
+		TotalResults: 4,
+		Count:        4,
+		Applications: []model.BasicApplicationResponse{
+			{ID: "app-active", LastTokenIssuedAt: &old, LastAuthorizedAt: &recent},
+			{ID: "app-inactive", LastTokenIssuedAt: &old},
+			{ID: "app-never-used"},
+			{ID: "app-recent-token", LastTokenIssuedAt: &recent},
+		},
+	}
+	mockService.On("GetApplicationList", mock.Anything).Return(expectedList, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/applications?inactiveSince=2026-01-01&limit=1", nil)
+	w := httptest.NewRecorder()
+
+	handler.HandleApplicationListRequest(w, req)
+
+	assert.Equal(suite.T(), http.StatusOK, w.Code)
+	var response model.ApplicationListResponse
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 2, response.TotalResults)
+	assert.Equal(suite.T(), 1, response.Count)
+	assert.Equal(suite.T(), "app-inactive", response.Applications[0].ID)
+	assert.Equal(suite.T(), "/applications?offset=1&limit=1&inactiveSince=2026-01-01", response.Links[0].Href)
+}
+
+func (suite *HandlerTestSuite) TestHandleApplicationListRequest_InvalidInactiveSince() {
+	mockService := NewApplicationServiceInterfaceMock(suite.T())
+	handler := newApplicationHandler(mockService)
+
+	req := httptest.NewRequest(http.MethodGet, "/applications?inactiveSince=yesterday", nil)
+	w := httptest.NewRecorder()
+
+	handler.HandleApplicationListRequest(w, req)
+
+	assert.Equal(suite.T(), http.StatusBadRequest, w.Code)
+	assert.Contains(suite.T(), w.Body.String(), ErrorInvalidInactiveSince.Code)
+}
+
 func (suite *HandlerTestSuite) TestHandleApplicationListRequest_WithTemplate() {
 	mockService := NewApplicationServiceInterfaceMock(suite.T())
 	handler := newApplicationHandler(mockService)
@@ -623,6 +709,55 @@ func (suite *HandlerTestSuite) TestHandleApplicationGetRequest_NotFound() {
 	mockService.AssertExpectations(suite.T())
 }
 
+func (suite *HandlerTestSuite) TestHandleApplicationGetByHandleRequest_Success() {
+	mockService := NewApplicationServiceInterfaceMock(suite.T())
+	handler := newApplicationHandler(mockService)
+
+	mockService.On("GetApplicationByName", mock.Anything, "TestApp").
+		Return(&model.Application{ID: "test-app-id", Name: "TestApp"}, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/applications/by-handle/TestApp", nil)
+	req.SetPathValue("handle", "TestApp")
+	w := httptest.NewRecorder()
+
+	handler.HandleApplicationGetByHandleRequest(w, req)
+
+	assert.Equal(suite.T(), http.StatusOK, w.Code)
+
+	var response model.ApplicationGetResponse
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "test-app-id", response.ID)
+	assert.Equal(suite.T(), "TestApp", response.Name)
+}
+
+func (suite *HandlerTestSuite) TestHandleApplicationGetByHandleRequest_NotFound() {
+	mockService := NewApplicationServiceInterfaceMock(suite.T())
+	handler := newApplicationHandler(mockService)
+
+	mockService.On("GetApplicationByName", mock.Anything, "Missing").Return(nil, &ErrorApplicationNotFound)
+
+	req := httptest.NewRequest(http.MethodGet, "/applications/by-handle/Missing", nil)
+	req.SetPathValue("handle", "Missing")
+	w := httptest.NewRecorder()
+
+	handler.HandleApplicationGetByHandleRequest(w, req)
+
+	assert.Equal(suite.T(), http.StatusNotFound, w.Code)
+}
+
+func (suite *HandlerTestSuite) TestHandleApplicationGetByHandleRequest_EmptyHandle() {
+	mockService := NewApplicationServiceInterfaceMock(suite.T())
+	handler := newApplicationHandler(mockService)
+
+	req := httptest.NewRequest(http.MethodGet, "/applications/by-handle/", nil)
+	w := httptest.NewRecorder()
+
+	handler.HandleApplicationGetByHandleRequest(w, req)
+
+	assert.Equal(suite.T(), http.StatusBadRequest, w.Code)
+}
+
 //nolint:dupl // Testing different error scenarios
 func (suite *HandlerTestSuite) TestHandleApplicationGetRequest_ServiceError() {
 	mockService := NewApplicationServiceInterfaceMock(suite.T())
@@ -2374,3 +2509,97 @@ func (suite *HandlerTestSuite) TestHandleApplicationGetRequest_EmptyResponseType
 
 	mockService.AssertExpectations(suite.T())
 }
+
+func (suite *HandlerTestSuite) TestHandleAllowedScopesGetRequest_Success() {
+	mockService := NewApplicationServiceInterfaceMock(suite.T())
+	handler := newApplicationHandler(mockService)
+
+	policies := map[string][]string{"client_credentials": {"read", "write"}}
+	mockService.On("GetAllowedScopesByGrantType", mock.Anything, "test-app-id").Return(policies, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/applications/test-app-id/oauth/allowed-scopes", nil)
+	req.SetPathValue("id", "test-app-id")
+	w := httptest.NewRecorder()
+
+	handler.HandleAllowedScopesGetRequest(w, req)
+
+	assert.Equal(suite.T(), http.StatusOK, w.Code)
+	var resp model.AllowedScopesResponse
+	assert.NoError(suite.T(), json.Unmarshal(w.Body.Bytes(), &resp))
+	assert.Equal(suite.T(), policies, resp.AllowedScopesByGrantType)
+}
+
+func (suite *HandlerTestSuite) TestHandleAllowedScopesGetRequest_OAuthConfigNotFound() {
+	mockService := NewApplicationServiceInterfaceMock(suite.T())
+	handler := newApplicationHandler(mockService)
+
+	mockService.On("GetAllowedScopesByGrantType", mock.Anything, "test-app-id").
+		Return(nil, &ErrorOAuthConfigNotFound)
+
+	req := httptest.NewRequest(http.MethodGet, "/applications/test-app-id/oauth/allowed-scopes", nil)
+	req.SetPathValue("id", "test-app-id")
+	w := httptest.NewRecorder()
+
+	handler.HandleAllowedScopesGetRequest(w, req)
+
+	assert.Equal(suite.T(), http.StatusNotFound, w.Code)
+	var errResp apierror.ErrorResponse
+	assert.NoError(suite.T(), json.Unmarshal(w.Body.Bytes(), &errResp))
+	assert.Equal(suite.T(), ErrorOAuthConfigNotFound.Code, errResp.Code)
+}
+
+func (suite *HandlerTestSuite) TestHandleGrantTypeAllowedScopesPutRequest_Success() {
+	mockService := NewApplicationServiceInterfaceMock(suite.T())
+	handler := newApplicationHandler(mockService)
+
+	mockService.On("UpdateGrantTypeAllowedScopes", mock.Anything, "test-app-id", "client_credentials",
+		[]string{"read"}).Return([]string{"read"}, nil)
+
+	req := httptest.NewRequest(http.MethodPut, "/applications/test-app-id/oauth/allowed-scopes/client_credentials",
+		bytes.NewBufferString(`{"scopes":["read"]}`))
+	req.SetPathValue("id", "test-app-id")
+	req.SetPathValue("grantType", "client_credentials")
+	w := httptest.NewRecorder()
+
+	handler.HandleGrantTypeAllowedScopesPutRequest(w, req)
+
+	assert.Equal(suite.T(), http.StatusOK, w.Code)
+	var resp model.GrantTypeAllowedScopesResponse
+	assert.NoError(suite.T(), json.Unmarshal(w.Body.Bytes(), &resp))
+	assert.Equal(suite.T(), "client_credentials", resp.GrantType)
+	assert.Equal(suite.T(), []string{"read"}, resp.Scopes)
+}
+
+func (suite *HandlerTestSuite) TestHandleGrantTypeAllowedScopesPutRequest_InvalidBody() {
+	mockService := NewApplicationServiceInterfaceMock(suite.T())
+	handler := newApplicationHandler(mockService)
+
+	req := httptest.NewRequest(http.MethodPut, "/applications/test-app-id/oauth/allowed-scopes/client_credentials",
+		bytes.NewBufferString(`{"scopes":`))
+	req.SetPathValue("id", "test-app-id")
+	req.SetPathValue("grantType", "client_credentials")
+	w := httptest.NewRecorder()
+
+	handler.HandleGrantTypeAllowedScopesPutRequest(w, req)
+
+	assert.Equal(suite.T(), http.StatusBadRequest, w.Code)
+	var errResp apierror.ErrorResponse
+	assert.NoError(suite.T(), json.Unmarshal(w.Body.Bytes(), &errResp))
+	assert.Equal(suite.T(), ErrorInvalidRequestFormat.Code, errResp.Code)
+}
+
+func (suite *HandlerTestSuite) TestHandleGrantTypeAllowedScopesDeleteRequest_Success() {
+	mockService := NewApplicationServiceInterfaceMock(suite.T())
+	handler := newApplicationHandler(mockService)
+
+	mockService.On("DeleteGrantTypeAllowedScopes", mock.Anything, "test-app-id", "client_credentials").Return(nil)
+
+	req := httptest.NewRequest(http.MethodDelete, "/applications/test-app-id/oauth/allowed-scopes/client_credentials", nil)
+	req.SetPathValue("id", "test-app-id")
+	req.SetPathValue("grantType", "client_credentials")
+	w := httptest.NewRecorder()
+
+	handler.HandleGrantTypeAllowedScopesDeleteRequest(w, req)
+
+	assert.Equal(suite.T(), http.StatusNoContent, w.Code)
+}
@@ -28,6 +28,7 @@ import (
 	"github.com/thunder-id/thunderid/internal/entity"
 	"github.com/thunder-id/thunderid/internal/entityprovider"
 	"github.com/thunder-id/thunderid/internal/inboundclient"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/clientusage"
 	oupkg "github.com/thunder-id/thunderid/internal/ou"
 	serverconst "github.com/thunder-id/thunderid/internal/system/constants"
 	declarativeresource "github.com/thunder-id/thunderid/internal/system/declarative_resource"
@@ -44,9 +45,10 @@ func Initialize(
 	inboundClient inboundclient.InboundClientServiceInterface,
 	ouService oupkg.OrganizationUnitServiceInterface,
 	i18nService i18nmgt.I18nServiceInterface,
+	clientUsageService clientusage.ClientUsageServiceInterface,
 ) (ApplicationServiceInterface, declarativeresource.ResourceExporter, error) {
 	appService := newApplicationService(
-		inboundClient, entityProvider, ouService, i18nService,
+		inboundClient, entityProvider, ouService, i18nService, clientUsageService,
 	)
 
 	if err := entityService.LoadIndexedAttributes(getAppIndexedAttributes()); err != nil {
@@ -99,10 +101,18 @@ func registerRoutes(mux *http.ServeMux, appHandler *applicationHandler) {
 	}
 	mux.HandleFunc(middleware.WithCORS("GET /applications/{id}",
 		appHandler.HandleApplicationGetRequest, opts2))
+	mux.HandleFunc(middleware.WithCORS("GET /applications/by-handle/{handle}",
+		appHandler.HandleApplicationGetByHandleRequest, opts2))
 	mux.HandleFunc(middleware.WithCORS("PUT /applications/{id}",
 		appHandler.HandleApplicationPutRequest, opts2))
 	mux.HandleFunc(middleware.WithCORS("DELETE /applications/{id}",
 		appHandler.HandleApplicationDeleteRequest, opts2))
+	mux.HandleFunc(middleware.WithCORS("GET /applications/{id}/oauth/allowed-scopes",
+		appHandler.HandleAllowedScopesGetRequest, opts2))
+	mux.HandleFunc(middleware.WithCORS("PUT /applications/{id}/oauth/allowed-scopes/{grantType}",
+		appHandler.HandleGrantTypeAllowedScopesPutRequest, opts2))
+	mux.HandleFunc(middleware.WithCORS("DELETE /applications/{id}/oauth/allowed-scopes/{grantType}",
+		appHandler.HandleGrantTypeAllowedScopesDeleteRequest, opts2))
 	mux.HandleFunc(middleware.WithCORS("OPTIONS /applications/",
 		func(w http.ResponseWriter, r *http.Request) {
 			w.WriteHeader(http.StatusNoContent)
@@ -160,6 +160,7 @@ func (suite *InitTestSuite) TestInitialize_WithDeclarativeResourcesDisabled() {
 		inboundclientmock.NewInboundClientServiceInterfaceMock(suite.T()),
 		nil, // ouService - not needed for this test
 		nil, // i18nService - not needed for this test
+		nil, // clientUsageService - not needed for this test
 	)
 
 	// Assert
@@ -202,6 +203,7 @@ func (suite *InitTestSuite) TestInitialize_WithMCPServer() {
 		inboundclientmock.NewInboundClientServiceInterfaceMock(suite.T()),
 		nil, // ouService - not needed for this test
 		nil, // i18nService - not needed for this test
+		nil, // clientUsageService - not needed for this test
 	)
 
 	// Assert
@@ -598,6 +600,7 @@ func TestInitialize_Standalone(t *testing.T) {
 		inboundclientmock.NewInboundClientServiceInterfaceMock(t),
 		nil, // ouService - not needed for this test
 		nil, // i18nService - not needed for this test
+		nil, // clientUsageService - not needed for this test
 	)
 
 	// Assert
@@ -648,6 +651,7 @@ func TestInitialize_WithDeclarativeResources_Standalone(t *testing.T) {
 		mockInboundClient,
 		nil, // ouService - not needed for this test
 		nil, // i18nService - not needed for this test
+		nil, // clientUsageService - not needed for this test
 	)
 
 	// Assert
@@ -22,7 +22,10 @@
 package model
 
 import (
+	"time"
+
 	inboundmodel "github.com/thunder-id/thunderid/internal/inboundclient/model"
+	sysutils "github.com/thunder-id/thunderid/internal/system/utils"
 )
 
 // ApplicationDTO represents the data transfer object for application service operations.
@@ -79,6 +82,10 @@ type Application struct {
 	inboundmodel.InboundAuthProfile `yaml:",inline"`
 	InboundAuthConfig               []inboundmodel.InboundAuthConfigWithSecret `yaml:"inbound_auth_config,omitempty" json:"inboundAuthConfig,omitempty" jsonschema:"Inbound authentication configuration (OAuth2/OIDC settings)."`
 	Metadata                        map[string]interface{}                     `yaml:"metadata,omitempty" json:"metadata,omitempty" jsonschema:"Generic metadata key-value pairs."`
+
+	// LastTokenIssuedAt and LastAuthorizedAt report the usage of the OAuth client. They are left out of resource exports.
+	LastTokenIssuedAt *time.Time `yaml:"-" json:"lastTokenIssuedAt,omitempty" jsonschema:"Time a token was last issued to the OAuth client."`
+	LastAuthorizedAt  *time.Time `yaml:"-" json:"lastAuthorizedAt,omitempty" jsonschema:"Time the OAuth client last initiated an authorization request."`
 }
 
 // ApplicationProcessedDTO represents the processed data transfer object for application service operations.
@@ -174,6 +181,8 @@ type ApplicationGetResponse struct {
 	inboundmodel.InboundAuthProfile
 	InboundAuthConfig []inboundmodel.InboundAuthConfig `json:"inboundAuthConfig,omitempty"`
 	Metadata          map[string]interface{}           `json:"metadata,omitempty"`
+	LastTokenIssuedAt *time.Time                       `json:"lastTokenIssuedAt,omitempty"`
+	LastAuthorizedAt  *time.Time                       `json:"lastAuthorizedAt,omitempty"`
 }
 
 // BasicApplicationResponse represents a simplified response structure for an application.
@@ -194,11 +203,33 @@ type BasicApplicationResponse struct {
 	LayoutID                  string `json:"layoutId,omitempty" jsonschema:"Layout ID."`
 	Template                  string `json:"template,omitempty" jsonschema:"Application Template."`
 	IsReadOnly                bool   `json:"isReadOnly" jsonschema:"Indicates if the application is read-only (declarative/immutable)."`
+
+	LastTokenIssuedAt *time.Time `json:"lastTokenIssuedAt,omitempty" jsonschema:"Time a token was last issued to the OAuth client."`
+	LastAuthorizedAt  *time.Time `json:"lastAuthorizedAt,omitempty" jsonschema:"Time the OAuth client last initiated an authorization request."`
 }
 
 // ApplicationListResponse represents the response structure for listing applications.
+// StartIndex and Links are only set when the list is requested with limit or offset.
 type ApplicationListResponse struct {
 	TotalResults int                        `json:"totalResults"`
+	StartIndex   int                        `json:"startIndex,omitempty"`
 	Count        int                        `json:"count"`
 	Applications []BasicApplicationResponse `json:"applications"`
+	Links        []sysutils.Link            `json:"links,omitempty"`
+}
+
+// AllowedScopesResponse represents the allowed-scope policies of an application, keyed by grant type.
+type AllowedScopesResponse struct {
+	AllowedScopesByGrantType map[string][]string `json:"allowedScopesByGrantType"`
+}
+
+// GrantTypeAllowedScopesRequest represents the request structure for replacing the allowed scopes of a grant type.
+type GrantTypeAllowedScopesRequest struct {
+	Scopes []string `json:"scopes"`
+}
+
+// GrantTypeAllowedScopesResponse represents the allowed scopes of a single grant type of an application.
+type GrantTypeAllowedScopesResponse struct {
+	GrantType string   `json:"grantType"`
+	Scopes    []string `json:"scopes"`
 }
@@ -22,7 +22,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"maps"
 	"slices"
+	"strings"
 
 	"encoding/json"
 
@@ -31,7 +33,9 @@ import (
 	"github.com/thunder-id/thunderid/internal/entityprovider"
 	"github.com/thunder-id/thunderid/internal/inboundclient"
 	inboundmodel "github.com/thunder-id/thunderid/internal/inboundclient/model"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/clientusage"
 	oauth2const "github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/tokencustomizer"
 	oauthutils "github.com/thunder-id/thunderid/internal/oauth/oauth2/utils"
 	oupkg "github.com/thunder-id/thunderid/internal/ou"
 	"github.com/thunder-id/thunderid/internal/system/config"
@@ -53,10 +57,16 @@ type ApplicationServiceInterface interface {
 	GetOAuthApplication(
 		ctx context.Context, clientID string) (*inboundmodel.OAuthClient, *serviceerror.ServiceError)
 	GetApplication(ctx context.Context, appID string) (*model.Application, *serviceerror.ServiceError)
+	GetApplicationByName(ctx context.Context, name string) (*model.Application, *serviceerror.ServiceError)
 	UpdateApplication(
 		ctx context.Context, appID string, app *model.ApplicationDTO) (
 		*model.ApplicationDTO, *serviceerror.ServiceError)
 	DeleteApplication(ctx context.Context, appID string) *serviceerror.ServiceError
+	GetAllowedScopesByGrantType(
+		ctx context.Context, appID string) (map[string][]string, *serviceerror.ServiceError)
+	UpdateGrantTypeAllowedScopes(
+		ctx context.Context, appID, grantType string, scopes []string) ([]string, *serviceerror.ServiceError)
+	DeleteGrantTypeAllowedScopes(ctx context.Context, appID, grantType string) *serviceerror.ServiceError
 }
 
 // ApplicationService is the default implementation of the ApplicationServiceInterface.
@@ -66,6 +76,7 @@ type applicationService struct {
 	entityProvider       entityprovider.EntityProviderInterface
 	ouService            oupkg.OrganizationUnitServiceInterface
 	i18nService          i18nmgt.I18nServiceInterface
+	clientUsageService   clientusage.ClientUsageServiceInterface
 }
 
 // newApplicationService creates a new instance of ApplicationService.
@@ -74,6 +85,7 @@ func newApplicationService(
 	entityProvider entityprovider.EntityProviderInterface,
 	ouService oupkg.OrganizationUnitServiceInterface,
 	i18nService i18nmgt.I18nServiceInterface,
+	clientUsageService clientusage.ClientUsageServiceInterface,
 ) ApplicationServiceInterface {
 	return &applicationService{
 		logger:               log.GetLogger().With(log.String(log.LoggerKeyComponentName, "ApplicationService")),
@@ -81,6 +93,7 @@ func newApplicationService(
 		entityProvider:       entityProvider,
 		ouService:            ouService,
 		i18nService:          i18nService,
+		clientUsageService:   clientUsageService,
 	}
 }
 
@@ -296,6 +309,7 @@ func (as *applicationService) GetApplicationList(
 		}
 		applicationList = append(applicationList, buildBasicApplicationResponse(*cfg, &entities[i]))
 	}
+	as.enrichApplicationListWithUsage(ctx, applicationList)
 
 	return &model.ApplicationListResponse{
 		TotalResults: totalResults,
@@ -345,7 +359,67 @@ func (as *applicationService) GetApplication(ctx context.Context, appID string)
 		return nil, svcErr
 	}
 
-	return as.enrichApplicationWithCertificate(ctx, buildApplicationResponse(fullApp))
+	app, svcErr := as.enrichApplicationWithCertificate(ctx, buildApplicationResponse(fullApp))
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	as.enrichApplicationWithUsage(ctx, app)
+	return app, nil
+}
+
+// enrichApplicationWithUsage sets the last usage of the OAuth client of the application. Usage is
+// informational, so the application is returned without it when the usage cannot be read.
+func (as *applicationService) enrichApplicationWithUsage(ctx context.Context, app *model.Application) {
+	if as.clientUsageService == nil {
+		return
+	}
+	usage, svcErr := as.clientUsageService.GetUsage(ctx, app.ID)
+	if svcErr != nil || usage == nil {
+		return
+	}
+	app.LastTokenIssuedAt = usage.LastTokenIssuedAt
+	app.LastAuthorizedAt = usage.LastAuthorizedAt
+}
+
+// enrichApplicationListWithUsage sets the last usage of the OAuth clients of the listed applications.
+// Usage is informational, so the list is returned without it when the usage cannot be read.
+func (as *applicationService) enrichApplicationListWithUsage(
+	ctx context.Context, applicationList []model.BasicApplicationResponse) {
+	if as.clientUsageService == nil || len(applicationList) == 0 {
+		return
+	}
+	usages, svcErr := as.clientUsageService.GetUsageList(ctx)
+	if svcErr != nil {
+		return
+	}
+	for i := range applicationList {
+		if usage, ok := usages[applicationList[i].ID]; ok {
+			applicationList[i].LastTokenIssuedAt = usage.LastTokenIssuedAt
+			applicationList[i].LastAuthorizedAt = usage.LastAuthorizedAt
+		}
+	}
+}
+
+// GetApplicationByName get the application for given application name. Application names are unique.
+func (as *applicationService) GetApplicationByName(ctx context.Context, name string) (*model.Application,
+	*serviceerror.ServiceError) {
+	if name == "" {
+		return nil, &ErrorInvalidApplicationName
+	}
+
+	entityID, epErr := as.entityProvider.IdentifyEntity(map[string]interface{}{fieldName: name})
+	if epErr != nil {
+		if epErr.Code == entityprovider.ErrorCodeEntityNotFound {
+			return nil, &ErrorApplicationNotFound
+		}
+		as.logger.Error("Failed to resolve application by name", log.String("name", name), log.Error(epErr))
+		return nil, &serviceerror.InternalServerError
+	}
+	if entityID == nil {
+		return nil, &ErrorApplicationNotFound
+	}
+
+	return as.GetApplication(ctx, *entityID)
 }
 
 // UpdateApplication update the application for given app id.
@@ -540,6 +614,104 @@ func (as *applicationService) DeleteApplication(ctx context.Context, appID strin
 	return as.deleteLocalizedVariants(ctx, appID)
 }
 
+// GetAllowedScopesByGrantType returns the allowed-scope policies of the application, keyed by grant type.
+func (as *applicationService) GetAllowedScopesByGrantType(ctx context.Context, appID string) (
+	map[string][]string, *serviceerror.ServiceError) {
+	oauthApp, svcErr := as.getOAuthConfigForApplication(ctx, appID)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	if oauthApp.AllowedScopesByGrantType == nil {
+		return map[string][]string{}, nil
+	}
+	return oauthApp.AllowedScopesByGrantType, nil
+}
+
+// UpdateGrantTypeAllowedScopes replaces the allowed scopes of the given grant type of the application.
+func (as *applicationService) UpdateGrantTypeAllowedScopes(ctx context.Context, appID, grantType string,
+	scopes []string) ([]string, *serviceerror.ServiceError) {
+	if !oauth2const.GrantType(grantType).IsValid() {
+		return nil, &ErrorInvalidGrantType
+	}
+	oauthApp, svcErr := as.getOAuthConfigForApplication(ctx, appID)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	if as.inboundClientService.IsDeclarative(ctx, appID) {
+		return nil, &ErrorCannotModifyDeclarativeResource
+	}
+
+	policies := maps.Clone(oauthApp.AllowedScopesByGrantType)
+	if policies == nil {
+		policies = make(map[string][]string, 1)
+	}
+	policies[grantType] = scopes
+	if svcErr := as.updateAllowedScopesByGrantType(ctx, appID, policies); svcErr != nil {
+		return nil, svcErr
+	}
+	return scopes, nil
+}
+
+// DeleteGrantTypeAllowedScopes removes the allowed-scope policy of the given grant type of the application.
+// Removing a policy that does not exist is not an error.
+func (as *applicationService) DeleteGrantTypeAllowedScopes(ctx context.Context, appID, grantType string,
+) *serviceerror.ServiceError {
+	if !oauth2const.GrantType(grantType).IsValid() {
+		return &ErrorInvalidGrantType
+	}
+	oauthApp, svcErr := as.getOAuthConfigForApplication(ctx, appID)
+	if svcErr != nil {
+		return svcErr
+	}
+	if _, ok := oauthApp.AllowedScopesByGrantType[grantType]; !ok {
+		return nil
+	}
+	if as.inboundClientService.IsDeclarative(ctx, appID) {
+		return &ErrorCannotModifyDeclarativeResource
+	}
+
+	policies := maps.Clone(oauthApp.AllowedScopesByGrantType)
+	delete(policies, grantType)
+	if len(policies) == 0 {
+		policies = nil
+	}
+	return as.updateAllowedScopesByGrantType(ctx, appID, policies)
+}
+
+// getOAuthConfigForApplication loads the application and returns its OAuth configuration.
+func (as *applicationService) getOAuthConfigForApplication(ctx context.Context, appID string) (
+	*inboundmodel.OAuthClient, *serviceerror.ServiceError) {
+	if appID == "" {
+		return nil, &ErrorInvalidApplicationID
+	}
+	dto, svcErr := as.getApplication(ctx, appID)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	oauthConfig := getOAuthInboundAuthConfigProcessedDTO(dto.InboundAuthConfig)
+	if oauthConfig == nil || oauthConfig.OAuthConfig == nil {
+		return nil, &ErrorOAuthConfigNotFound
+	}
+	return oauthConfig.OAuthConfig, nil
+}
+
+// updateAllowedScopesByGrantType persists the allowed-scope policies of the application.
+func (as *applicationService) updateAllowedScopesByGrantType(ctx context.Context, appID string,
+	policies map[string][]string) *serviceerror.ServiceError {
+	if err := as.inboundClientService.UpdateAllowedScopesByGrantType(ctx, appID, policies); err != nil {
+		if errors.Is(err, inboundclient.ErrInboundClientNotFound) {
+			return &ErrorOAuthConfigNotFound
+		}
+		if svcErr := as.translateInboundClientError(err); svcErr != nil {
+			return svcErr
+		}
+		as.logger.Error("Failed to update allowed scopes of application", log.Error(err),
+			log.String("appID", appID))
+		return &serviceerror.InternalServerError
+	}
+	return nil
+}
+
 // isIdentifierTaken checks if an entity with the given identifier already exists.
 // If excludeID is non-empty, the entity with that ID is excluded from the check
 // (used during declarative loading and updates where the entity already exists).
@@ -606,6 +778,8 @@ func mapEntityProviderError(epErr *entityprovider.EntityProviderError) *servicee
 	switch epErr.Code {
 	case entityprovider.ErrorCodeEntityNotFound:
 		return &ErrorApplicationNotFound
+	case entityprovider.ErrorCodeQuotaExceeded:
+		return &ErrorApplicationQuotaExceeded
 	default:
 		return nil
 	}
@@ -625,6 +799,8 @@ func toInboundClient(dto *model.ApplicationProcessedDTO) inboundmodel.InboundCli
 		Assertion:                 dto.Assertion,
 		LoginConsent:              dto.LoginConsent,
 		AllowedUserTypes:          dto.AllowedUserTypes,
+		AllowedAuthMethods:        dto.AllowedAuthMethods,
+		UserIsolation:             dto.UserIsolation,
 	}
 
 	// Pack remaining fields into Properties.
@@ -675,6 +851,8 @@ func toProcessedDTO(
 			Assertion:                 dao.Assertion,
 			LoginConsent:              dao.LoginConsent,
 			AllowedUserTypes:          dao.AllowedUserTypes,
+			AllowedAuthMethods:        dao.AllowedAuthMethods,
+			UserIsolation:             dao.UserIsolation,
 		},
 	}
 
@@ -774,18 +952,29 @@ func buildOAuthProfileFromProcessed(inboundAuth inboundmodel.InboundAuthConfigPr
 	oa := inboundAuth.OAuthConfig
 	return &inboundmodel.OAuthProfile{
 		RedirectURIs:                       oa.RedirectURIs,
+		RedirectURIMatchMode:               string(oa.RedirectURIMatchMode),
 		GrantTypes:                         sysutils.ConvertToStringSlice(oa.GrantTypes),
 		ResponseTypes:                      sysutils.ConvertToStringSlice(oa.ResponseTypes),
 		TokenEndpointAuthMethod:            string(oa.TokenEndpointAuthMethod),
 		PKCERequired:                       oa.PKCERequired,
 		PublicClient:                       oa.PublicClient,
 		RequirePushedAuthorizationRequests: oa.RequirePushedAuthorizationRequests,
+		DPoPBoundAccessTokens:              oa.DPoPBoundAccessTokens,
+		AllowCredentialDiscovery:           oa.AllowCredentialDiscovery,
+		ProtocolTraceEnabled:               oa.ProtocolTraceEnabled,
+		RequestObjectSigningAlg:            oa.RequestObjectSigningAlg,
 		Scopes:                             oa.Scopes,
+		AllowedScopes:                      oa.AllowedScopes,
+		RejectDisallowedScopes:             oa.RejectDisallowedScopes,
+		AllowedScopesByGrantType:           oa.AllowedScopesByGrantType,
+		Logout:                             oa.Logout,
 		ScopeClaims:                        oa.ScopeClaims,
 		Token:                              oa.Token,
 		UserInfo:                           oa.UserInfo,
 		Certificate:                        oa.Certificate,
 		AcrValues:                          oa.AcrValues,
+		ClaimFallbacks:                     oa.ClaimFallbacks,
+		TokenCustomization:                 oa.TokenCustomization,
 	}
 }
 
@@ -937,10 +1126,32 @@ func (as *applicationService) validateApplicationFields(
 		}
 		isOAuthConfig = true
 	}
+	if svcErr := validateAllowedAuthMethods(app.AllowedAuthMethods); svcErr != nil {
+		return svcErr
+	}
 	as.validateConsentConfig(app)
 	return nil
 }
 
+// validateAllowedAuthMethods validates the allowed authentication methods configuration. A nil
+// configuration leaves the application unrestricted.
+func validateAllowedAuthMethods(methods *inboundmodel.AuthMethodsConfig) *serviceerror.ServiceError {
+	if methods == nil {
+		return nil
+	}
+	if !methods.Local && !methods.Passkey && len(methods.FederatedIDPs) == 0 {
+		return &ErrorInvalidAllowedAuthMethods
+	}
+	seen := make(map[string]bool, len(methods.FederatedIDPs))
+	for _, idpID := range methods.FederatedIDPs {
+		if strings.TrimSpace(idpID) == "" || seen[idpID] {
+			return &ErrorInvalidAllowedAuthMethods
+		}
+		seen[idpID] = true
+	}
+	return nil
+}
+
 // validateConsentConfig validates the consent configuration for the application.
 func (as *applicationService) validateConsentConfig(appDTO *model.ApplicationDTO) {
 	if appDTO.LoginConsent == nil {
@@ -991,6 +1202,12 @@ func validateOAuthParamsForCreateAndUpdate(app *model.ApplicationDTO) (*inboundm
 	if err := validateAcrValues(oauthAppConfig.AcrValues); err != nil {
 		return nil, err
 	}
+	if err := validateClaimFallbacks(oauthAppConfig.ClaimFallbacks); err != nil {
+		return nil, err
+	}
+	if err := validateTokenCustomization(oauthAppConfig.TokenCustomization); err != nil {
+		return nil, err
+	}
 
 	return inboundAuthConfig, nil
 }
@@ -1015,6 +1232,64 @@ func validateAcrValues(acrValues []string) *serviceerror.ServiceError {
 	return nil
 }
 
+// validateClaimFallbacks rejects claim fallback chains with an empty claim name, an unknown or incomplete source,
+// or a gravatar source while gravatar sources are disabled on the server.
+func validateClaimFallbacks(claimFallbacks map[string][]inboundmodel.ClaimFallbackSource) *serviceerror.ServiceError {
+	invalid := func(description string) *serviceerror.ServiceError {
+		return serviceerror.CustomServiceError(ErrorInvalidClaimFallbacks, core.I18nMessage{
+			Key:          "error.applicationservice.invalid_claim_fallbacks_detail",
+			DefaultValue: description,
+		})
+	}
+
+	for claim, sources := range claimFallbacks {
+		if strings.TrimSpace(claim) == "" {
+			return invalid("claim fallbacks must not be configured for an empty claim name")
+		}
+		if len(sources) == 0 {
+			return invalid(fmt.Sprintf("claim %q must have at least one fallback source", claim))
+		}
+		for _, source := range sources {
+			switch source.Type {
+			case inboundmodel.ClaimFallbackSourceAttributes:
+				if len(source.Attributes) == 0 || slices.Contains(source.Attributes, "") {
+					return invalid(fmt.Sprintf("attributes fallback of claim %q must list non-empty attribute paths",
+						claim))
+				}
+			case inboundmodel.ClaimFallbackSourceComputed:
+				if len(source.TemplatePlaceholders()) == 0 {
+					return invalid(fmt.Sprintf("computed fallback of claim %q must have a template with at least "+
+						"one {attribute} placeholder", claim))
+				}
+			case inboundmodel.ClaimFallbackSourceGravatar:
+				if !config.GetServerRuntime().Config.OAuth.ClaimFallback.Gravatar.Enabled {
+					return invalid(fmt.Sprintf("gravatar fallback of claim %q is not allowed as gravatar sources "+
+						"are disabled on the server", claim))
+				}
+			default:
+				return invalid(fmt.Sprintf("fallback source type %q of claim %q is not supported", source.Type, claim))
+			}
+		}
+	}
+	return nil
+}
+
+// validateTokenCustomization rejects token customization rules that are incomplete, set a reserved claim or use
+// an expression the server does not accept.
+func validateTokenCustomization(customization *inboundmodel.TokenCustomization) *serviceerror.ServiceError {
+	if customization == nil {
+		return nil
+	}
+	if err := tokencustomizer.ValidateCustomization(customization,
+		config.GetServerRuntime().Config.OAuth.TokenCustomization); err != nil {
+		return serviceerror.CustomServiceError(ErrorInvalidTokenCustomization, core.I18nMessage{
+			Key:          "error.applicationservice.invalid_token_customization_detail",
+			DefaultValue: err.Error(),
+		})
+	}
+	return nil
+}
+
 // translateInboundClientError maps inbound-client sentinel errors and typed wrappers to
 // application-service errors. Returns nil when the input does not correspond to a known
 // inbound-client error, allowing the caller to log and fall back to InternalServerError.
@@ -1056,6 +1331,16 @@ func (as *applicationService) translateInboundClientError(err error) *serviceerr
 func translateOAuthValidationError(err error) *serviceerror.ServiceError {
 	switch {
 	// OAuth: redirect URI
+	case errors.Is(err, inboundclient.ErrOAuthRedirectURIWildcardHostNotAllowed):
+		return serviceerror.CustomServiceError(ErrorInvalidRedirectURI, core.I18nMessage{
+			Key:          "error.applicationservice.redirect_uri_wildcard_host_not_allowed_description",
+			DefaultValue: "Redirect URIs must not use a wildcard host unless wildcard matching is enabled",
+		})
+	case errors.Is(err, inboundclient.ErrOAuthRedirectURIPatternNotAllowed):
+		return serviceerror.CustomServiceError(ErrorInvalidRedirectURI, core.I18nMessage{
+			Key:          "error.applicationservice.redirect_uri_pattern_not_allowed_description",
+			DefaultValue: "Redirect URIs must not contain wildcards when exact matching is configured",
+		})
 	case errors.Is(err, inboundclient.ErrOAuthInvalidRedirectURI):
 		return &ErrorInvalidRedirectURI
 	case errors.Is(err, inboundclient.ErrOAuthRedirectURIFragmentNotAllowed):
@@ -1068,6 +1353,42 @@ func translateOAuthValidationError(err error) *serviceerror.ServiceError {
 			Key:          "error.applicationservice.auth_code_requires_redirect_uris_description",
 			DefaultValue: "authorization_code grant type requires redirect URIs",
 		})
+	case errors.Is(err, inboundclient.ErrOAuthInvalidRedirectURIMatchMode):
+		return serviceerror.CustomServiceError(ErrorInvalidOAuthConfiguration, core.I18nMessage{
+			Key:          "error.applicationservice.invalid_redirect_uri_match_mode_description",
+			DefaultValue: "Redirect URI match mode must be one of EXACT or PATTERN",
+		})
+
+	// OAuth: logout
+	case errors.Is(err, inboundclient.ErrOAuthInvalidFrontChannelLogoutURI):
+		return serviceerror.CustomServiceError(ErrorInvalidOAuthConfiguration, core.I18nMessage{
+			Key:          "error.applicationservice.invalid_frontchannel_logout_uri_description",
+			DefaultValue: "Front-channel logout URI must be an absolute URL without a fragment",
+		})
+	case errors.Is(err, inboundclient.ErrOAuthInvalidBackChannelLogoutURI):
+		return serviceerror.CustomServiceError(ErrorInvalidOAuthConfiguration, core.I18nMessage{
+			Key:          "error.applicationservice.invalid_backchannel_logout_uri_description",
+			DefaultValue: "Back-channel logout URI must be a publicly reachable HTTPS URL without a fragment",
+		})
+
+	// OAuth: refresh token lifetime
+	case errors.Is(err, inboundclient.ErrOAuthInvalidRefreshTokenLifetime):
+		return serviceerror.CustomServiceError(ErrorInvalidOAuthConfiguration, core.I18nMessage{
+			Key:          "error.applicationservice.invalid_refresh_token_lifetime_description",
+			DefaultValue: "Refresh token validity period and idle timeout must not be negative",
+		})
+
+	// OAuth: request objects
+	case errors.Is(err, inboundclient.ErrOAuthUnsupportedRequestObjectSigningAlg):
+		return serviceerror.CustomServiceError(ErrorInvalidOAuthConfiguration, core.I18nMessage{
+			Key:          "error.applicationservice.unsupported_request_object_signing_alg_description",
+			DefaultValue: "Request object signing algorithm is not supported",
+		})
+	case errors.Is(err, inboundclient.ErrOAuthRequestObjectRequiresCertificate):
+		return serviceerror.CustomServiceError(ErrorInvalidOAuthConfiguration, core.I18nMessage{
+			Key:          "error.applicationservice.request_object_requires_certificate_description",
+			DefaultValue: "A certificate (JWKS or JWKS_URI) is required when a request object signing algorithm is set",
+		})
 
 	// OAuth: grant + response type
 	case errors.Is(err, inboundclient.ErrOAuthInvalidGrantType):
@@ -1100,6 +1421,23 @@ func translateOAuthValidationError(err error) *serviceerror.ServiceError {
 			DefaultValue: "Response types can only be configured with the authorization_code grant type",
 		})
 
+	// OAuth: allowed-scope policies
+	case errors.Is(err, inboundclient.ErrOAuthUnsupportedScopePolicyGrantType):
+		return serviceerror.CustomServiceError(ErrorInvalidOAuthConfiguration, core.I18nMessage{
+			Key:          "error.applicationservice.unsupported_scope_policy_grant_type_description",
+			DefaultValue: "Allowed scopes can only be configured for the client_credentials grant type",
+		})
+	case errors.Is(err, inboundclient.ErrOAuthScopePolicyGrantTypeNotAllowed):
+		return serviceerror.CustomServiceError(ErrorInvalidOAuthConfiguration, core.I18nMessage{
+			Key:          "error.applicationservice.scope_policy_grant_type_not_allowed_description",
+			DefaultValue: "Allowed scopes can only be configured for grant types the application uses",
+		})
+	case errors.Is(err, inboundclient.ErrOAuthInvalidScopePolicy):
+		return serviceerror.CustomServiceError(ErrorInvalidOAuthConfiguration, core.I18nMessage{
+			Key:          "error.applicationservice.invalid_scope_policy_description",
+			DefaultValue: "Allowed scopes of a grant type must be a non-empty list of scope names",
+		})
+
 	// OAuth: token endpoint auth method
 	case errors.Is(err, inboundclient.ErrOAuthInvalidTokenEndpointAuthMethod):
 		return &ErrorInvalidTokenEndpointAuthMethod
@@ -1514,6 +1852,8 @@ func buildApplicationResponse(dto *model.ApplicationProcessedDTO) *model.Applica
 			LayoutID:                  dto.LayoutID,
 			Assertion:                 dto.Assertion,
 			AllowedUserTypes:          dto.AllowedUserTypes,
+			AllowedAuthMethods:        dto.AllowedAuthMethods,
+			UserIsolation:             dto.UserIsolation,
 			LoginConsent:              dto.LoginConsent,
 		},
 		Template:  dto.Template,
@@ -1533,17 +1873,28 @@ func buildApplicationResponse(dto *model.ApplicationProcessedDTO) *model.Applica
 				OAuthConfig: &inboundmodel.OAuthConfigWithSecret{
 					ClientID:                           oauthAppConfig.ClientID,
 					RedirectURIs:                       oauthAppConfig.RedirectURIs,
+					RedirectURIMatchMode:               oauthAppConfig.RedirectURIMatchMode,
 					GrantTypes:                         oauthAppConfig.GrantTypes,
 					ResponseTypes:                      oauthAppConfig.ResponseTypes,
 					TokenEndpointAuthMethod:            oauthAppConfig.TokenEndpointAuthMethod,
 					PKCERequired:                       oauthAppConfig.PKCERequired,
 					PublicClient:                       oauthAppConfig.PublicClient,
 					RequirePushedAuthorizationRequests: oauthAppConfig.RequirePushedAuthorizationRequests,
+					DPoPBoundAccessTokens:              oauthAppConfig.DPoPBoundAccessTokens,
+					AllowCredentialDiscovery:           oauthAppConfig.AllowCredentialDiscovery,
+					ProtocolTraceEnabled:               oauthAppConfig.ProtocolTraceEnabled,
+					RequestObjectSigningAlg:            oauthAppConfig.RequestObjectSigningAlg,
 					Token:                              oauthAppConfig.Token,
 					Scopes:                             oauthAppConfig.Scopes,
+					AllowedScopes:                      oauthAppConfig.AllowedScopes,
+					RejectDisallowedScopes:             oauthAppConfig.RejectDisallowedScopes,
+					AllowedScopesByGrantType:           oauthAppConfig.AllowedScopesByGrantType,
+					Logout:                             oauthAppConfig.Logout,
 					UserInfo:                           oauthAppConfig.UserInfo,
 					ScopeClaims:                        oauthAppConfig.ScopeClaims,
 					AcrValues:                          oauthAppConfig.AcrValues,
+					ClaimFallbacks:                     oauthAppConfig.ClaimFallbacks,
+					TokenCustomization:                 oauthAppConfig.TokenCustomization,
 				},
 			})
 		}
@@ -1615,6 +1966,8 @@ func buildBaseApplicationProcessedDTO(appID string, app *model.ApplicationDTO,
 			LayoutID:                  app.LayoutID,
 			Assertion:                 assertion,
 			AllowedUserTypes:          app.AllowedUserTypes,
+			AllowedAuthMethods:        app.AllowedAuthMethods,
+			UserIsolation:             app.UserIsolation,
 			LoginConsent:              app.LoginConsent,
 		},
 		Template:  app.Template,
@@ -1656,18 +2009,29 @@ func buildOAuthInboundAuthConfigProcessedDTO(
 			ID:                                 appID,
 			ClientID:                           inboundAuthConfig.OAuthConfig.ClientID,
 			RedirectURIs:                       inboundAuthConfig.OAuthConfig.RedirectURIs,
+			RedirectURIMatchMode:               inboundAuthConfig.OAuthConfig.RedirectURIMatchMode,
 			GrantTypes:                         inboundAuthConfig.OAuthConfig.GrantTypes,
 			ResponseTypes:                      inboundAuthConfig.OAuthConfig.ResponseTypes,
 			TokenEndpointAuthMethod:            inboundAuthConfig.OAuthConfig.TokenEndpointAuthMethod,
 			PKCERequired:                       inboundAuthConfig.OAuthConfig.PKCERequired,
 			PublicClient:                       inboundAuthConfig.OAuthConfig.PublicClient,
 			RequirePushedAuthorizationRequests: inboundAuthConfig.OAuthConfig.RequirePushedAuthorizationRequests,
+			DPoPBoundAccessTokens:              inboundAuthConfig.OAuthConfig.DPoPBoundAccessTokens,
+			AllowCredentialDiscovery:           inboundAuthConfig.OAuthConfig.AllowCredentialDiscovery,
+			ProtocolTraceEnabled:               inboundAuthConfig.OAuthConfig.ProtocolTraceEnabled,
+			RequestObjectSigningAlg:            inboundAuthConfig.OAuthConfig.RequestObjectSigningAlg,
 			Token:                              oauthToken,
 			Scopes:                             inboundAuthConfig.OAuthConfig.Scopes,
+			AllowedScopes:                      inboundAuthConfig.OAuthConfig.AllowedScopes,
+			RejectDisallowedScopes:             inboundAuthConfig.OAuthConfig.RejectDisallowedScopes,
+			AllowedScopesByGrantType:           inboundAuthConfig.OAuthConfig.AllowedScopesByGrantType,
+			Logout:                             inboundAuthConfig.OAuthConfig.Logout,
 			UserInfo:                           userInfo,
 			ScopeClaims:                        scopeClaims,
 			Certificate:                        certificate,
 			AcrValues:                          inboundAuthConfig.OAuthConfig.AcrValues,
+			ClaimFallbacks:                     inboundAuthConfig.OAuthConfig.ClaimFallbacks,
+			TokenCustomization:                 inboundAuthConfig.OAuthConfig.TokenCustomization,
 		},
 	}
 }
@@ -1694,6 +2058,8 @@ func buildReturnApplicationDTO(
 			Assertion:                 assertion,
 			Certificate:               app.Certificate,
 			AllowedUserTypes:          app.AllowedUserTypes,
+			AllowedAuthMethods:        app.AllowedAuthMethods,
+			UserIsolation:             app.UserIsolation,
 			LoginConsent:              app.LoginConsent,
 		},
 		Template:  app.Template,
@@ -1715,18 +2081,29 @@ func buildReturnApplicationDTO(
 				ClientID:                           inboundAuthConfig.OAuthConfig.ClientID,
 				ClientSecret:                       inboundAuthConfig.OAuthConfig.ClientSecret,
 				RedirectURIs:                       inboundAuthConfig.OAuthConfig.RedirectURIs,
+				RedirectURIMatchMode:               inboundAuthConfig.OAuthConfig.RedirectURIMatchMode,
 				GrantTypes:                         inboundAuthConfig.OAuthConfig.GrantTypes,
 				ResponseTypes:                      inboundAuthConfig.OAuthConfig.ResponseTypes,
 				TokenEndpointAuthMethod:            inboundAuthConfig.OAuthConfig.TokenEndpointAuthMethod,
 				PKCERequired:                       inboundAuthConfig.OAuthConfig.PKCERequired,
 				PublicClient:                       inboundAuthConfig.OAuthConfig.PublicClient,
 				RequirePushedAuthorizationRequests: inboundAuthConfig.OAuthConfig.RequirePushedAuthorizationRequests,
+				DPoPBoundAccessTokens:              inboundAuthConfig.OAuthConfig.DPoPBoundAccessTokens,
+				AllowCredentialDiscovery:           inboundAuthConfig.OAuthConfig.AllowCredentialDiscovery,
+				ProtocolTraceEnabled:               inboundAuthConfig.OAuthConfig.ProtocolTraceEnabled,
+				RequestObjectSigningAlg:            inboundAuthConfig.OAuthConfig.RequestObjectSigningAlg,
 				Token:                              oauthToken,
 				Scopes:                             inboundAuthConfig.OAuthConfig.Scopes,
+				AllowedScopes:                      inboundAuthConfig.OAuthConfig.AllowedScopes,
+				RejectDisallowedScopes:             inboundAuthConfig.OAuthConfig.RejectDisallowedScopes,
+				AllowedScopesByGrantType:           inboundAuthConfig.OAuthConfig.AllowedScopesByGrantType,
+				Logout:                             inboundAuthConfig.OAuthConfig.Logout,
 				UserInfo:                           userInfo,
 				ScopeClaims:                        scopeClaims,
 				Certificate:                        oauthCert,
 				AcrValues:                          inboundAuthConfig.OAuthConfig.AcrValues,
+				ClaimFallbacks:                     inboundAuthConfig.OAuthConfig.ClaimFallbacks,
+				TokenCustomization:                 inboundAuthConfig.OAuthConfig.TokenCustomization,
 			},
 		}
 		returnApp.InboundAuthConfig = []inboundmodel.InboundAuthConfigWithSecret{returnInboundAuthConfig}
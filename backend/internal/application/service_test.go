@@ -24,6 +24,7 @@ import (
 
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -35,6 +36,7 @@ import (
 	"github.com/thunder-id/thunderid/internal/entityprovider"
 	"github.com/thunder-id/thunderid/internal/inboundclient"
 	inboundmodel "github.com/thunder-id/thunderid/internal/inboundclient/model"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/clientusage"
 	oauth2const "github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
 	"github.com/thunder-id/thunderid/internal/system/config"
 	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
@@ -42,6 +44,7 @@ import (
 	"github.com/thunder-id/thunderid/internal/system/log"
 	"github.com/thunder-id/thunderid/tests/mocks/entityprovidermock"
 	"github.com/thunder-id/thunderid/tests/mocks/inboundclientmock"
+	"github.com/thunder-id/thunderid/tests/mocks/oauth/oauth2/clientusagemock"
 	"github.com/thunder-id/thunderid/tests/mocks/oumock"
 )
 
@@ -389,6 +392,46 @@ func (suite *ServiceTestSuite) TestGetApplication_Success() {
 	assert.Equal(suite.T(), map[string]interface{}{"service_key": "service_val"}, result.Metadata)
 }
 
+func (suite *ServiceTestSuite) TestGetApplication_IncludesClientUsage() {
+	service, mockStore := suite.setupTestService()
+	mockClientUsage := clientusagemock.NewClientUsageServiceInterfaceMock(suite.T())
+	service.clientUsageService = mockClientUsage
+
+	app := &model.ApplicationProcessedDTO{ID: testServiceAppID, Name: "Test App"}
+	mockLoadFullApplication(mockStore, service, app)
+	mockStore.EXPECT().GetCertificate(mock.Anything,
+		cert.CertificateReferenceTypeApplication, testServiceAppID).Return(nil, nil)
+	lastTokenIssuedAt := time.Date(2026, 5, 1, 0, 0, 0, 0, time.UTC)
+	mockClientUsage.On("GetUsage", mock.Anything, testServiceAppID).
+		Return(&clientusage.ClientUsage{AppID: testServiceAppID, LastTokenIssuedAt: &lastTokenIssuedAt}, nil)
+
+	result, svcErr := service.GetApplication(context.Background(), testServiceAppID)
+
+	assert.Nil(suite.T(), svcErr)
+	require.NotNil(suite.T(), result)
+	assert.Equal(suite.T(), &lastTokenIssuedAt, result.LastTokenIssuedAt)
+	assert.Nil(suite.T(), result.LastAuthorizedAt)
+}
+
+func (suite *ServiceTestSuite) TestGetApplication_IgnoresClientUsageError() {
+	service, mockStore := suite.setupTestService()
+	mockClientUsage := clientusagemock.NewClientUsageServiceInterfaceMock(suite.T())
+	service.clientUsageService = mockClientUsage
+
+	app := &model.ApplicationProcessedDTO{ID: testServiceAppID, Name: "Test App"}
+	mockLoadFullApplication(mockStore, service, app)
+	mockStore.EXPECT().GetCertificate(mock.Anything,
+		cert.CertificateReferenceTypeApplication, testServiceAppID).Return(nil, nil)
+	mockClientUsage.On("GetUsage", mock.Anything, testServiceAppID).
+		Return(nil, &serviceerror.InternalServerError)
+
+	result, svcErr := service.GetApplication(context.Background(), testServiceAppID)
+
+	assert.Nil(suite.T(), svcErr)
+	require.NotNil(suite.T(), result)
+	assert.Nil(suite.T(), result.LastTokenIssuedAt)
+}
+
 // TestGetApplication_AgentEntity verifies getApplication rejects an entity that exists but is
 // in the agent category — the application API must not leak agent records.
 func (suite *ServiceTestSuite) TestGetApplication_AgentEntity() {
@@ -408,6 +451,59 @@ func (suite *ServiceTestSuite) TestGetApplication_AgentEntity() {
 	assert.Equal(suite.T(), ErrorApplicationNotFound.Code, svcErr.Code)
 }
 
+func (suite *ServiceTestSuite) TestGetApplicationByName_Success() {
+	service, mockStore := suite.setupTestService()
+
+	app := &model.ApplicationProcessedDTO{ID: testServiceAppID, Name: "Test App"}
+	mockEP := resetIdentifyEntity(service)
+	appID := testServiceAppID
+	mockEP.On("IdentifyEntity", map[string]interface{}{"name": "Test App"}).
+		Return(&appID, (*entityprovider.EntityProviderError)(nil))
+	mockLoadFullApplication(mockStore, service, app)
+	mockStore.EXPECT().GetCertificate(mock.Anything,
+		cert.CertificateReferenceTypeApplication, testServiceAppID).Return(nil, nil)
+
+	result, svcErr := service.GetApplicationByName(context.Background(), "Test App")
+
+	assert.Nil(suite.T(), svcErr)
+	suite.Require().NotNil(result)
+	assert.Equal(suite.T(), testServiceAppID, result.ID)
+}
+
+func (suite *ServiceTestSuite) TestGetApplicationByName_EmptyName() {
+	service, _ := suite.setupTestService()
+
+	result, svcErr := service.GetApplicationByName(context.Background(), "")
+
+	assert.Nil(suite.T(), result)
+	suite.Require().NotNil(svcErr)
+	assert.Equal(suite.T(), ErrorInvalidApplicationName.Code, svcErr.Code)
+}
+
+func (suite *ServiceTestSuite) TestGetApplicationByName_NotFound() {
+	service, _ := suite.setupTestService()
+
+	result, svcErr := service.GetApplicationByName(context.Background(), "Missing App")
+
+	assert.Nil(suite.T(), result)
+	suite.Require().NotNil(svcErr)
+	assert.Equal(suite.T(), ErrorApplicationNotFound.Code, svcErr.Code)
+}
+
+func (suite *ServiceTestSuite) TestGetApplicationByName_ProviderError() {
+	service, _ := suite.setupTestService()
+
+	mockEP := resetIdentifyEntity(service)
+	mockEP.On("IdentifyEntity", map[string]interface{}{"name": "Test App"}).
+		Return((*string)(nil), entityprovider.NewEntityProviderError(entityprovider.ErrorCodeSystemError, "boom", ""))
+
+	result, svcErr := service.GetApplicationByName(context.Background(), "Test App")
+
+	assert.Nil(suite.T(), result)
+	suite.Require().NotNil(svcErr)
+	assert.Equal(suite.T(), serviceerror.InternalServerError.Code, svcErr.Code)
+}
+
 func (suite *ServiceTestSuite) TestGetApplication_WithInboundAuthConfig_Success() {
 	service, mockStore := suite.setupTestService()
 
@@ -495,6 +591,36 @@ func (suite *ServiceTestSuite) TestGetApplicationList_Success() {
 	assert.Len(suite.T(), result.Applications, 2)
 }
 
+func (suite *ServiceTestSuite) TestGetApplicationList_IncludesClientUsage() {
+	service, mockStore := suite.setupTestService()
+	mockClientUsage := clientusagemock.NewClientUsageServiceInterfaceMock(suite.T())
+	service.clientUsageService = mockClientUsage
+
+	entities := []entityprovider.Entity{
+		{ID: "app1", Category: entityprovider.EntityCategoryApp},
+		{ID: "app2", Category: entityprovider.EntityCategoryApp},
+	}
+	resetEntityProviderMethod(service, "GetEntityList").On("GetEntityList", entityprovider.EntityCategoryApp,
+		mock.AnythingOfType("int"), mock.AnythingOfType("int"), mock.Anything).
+		Return(entities, (*entityprovider.EntityProviderError)(nil))
+	resetEntityProviderMethod(service, "GetEntityListCount").
+		On("GetEntityListCount", entityprovider.EntityCategoryApp, mock.Anything).
+		Return(2, (*entityprovider.EntityProviderError)(nil))
+	mockStore.On("GetInboundClientList", mock.Anything).
+		Return([]inboundmodel.InboundClient{{ID: "app1"}, {ID: "app2"}}, nil)
+	lastAuthorizedAt := time.Date(2026, 5, 1, 0, 0, 0, 0, time.UTC)
+	mockClientUsage.On("GetUsageList", mock.Anything).Return(map[string]clientusage.ClientUsage{
+		"app2": {AppID: "app2", LastAuthorizedAt: &lastAuthorizedAt},
+	}, nil)
+
+	result, svcErr := service.GetApplicationList(context.Background())
+
+	assert.Nil(suite.T(), svcErr)
+	require.Len(suite.T(), result.Applications, 2)
+	assert.Nil(suite.T(), result.Applications[0].LastAuthorizedAt)
+	assert.Equal(suite.T(), &lastAuthorizedAt, result.Applications[1].LastAuthorizedAt)
+}
+
 func (suite *ServiceTestSuite) TestGetApplicationList_ListError() {
 	service, _ := suite.setupTestService()
 
@@ -1174,6 +1300,37 @@ func (suite *ServiceTestSuite) TestValidateOAuthParamsForCreateAndUpdate_WithRes
 	assert.Equal(suite.T(), oauth2const.ResponseTypeCode, result.OAuthConfig.ResponseTypes[0])
 }
 
+func (suite *ServiceTestSuite) TestValidateOAuthParamsForCreateAndUpdate_InvalidTokenCustomization() {
+	config.ResetServerRuntime()
+	suite.Require().NoError(config.InitializeServerRuntime("", &config.Config{}))
+	defer config.ResetServerRuntime()
+	app := &model.ApplicationDTO{
+		Name: "Test App",
+		OUID: testOUID,
+		InboundAuthConfig: []inboundmodel.InboundAuthConfigWithSecret{
+			{
+				Type: inboundmodel.OAuthInboundAuthType,
+				OAuthConfig: &inboundmodel.OAuthConfigWithSecret{
+					RedirectURIs: []string{"https://example.com/callback"},
+					GrantTypes:   []oauth2const.GrantType{oauth2const.GrantTypeAuthorizationCode},
+					TokenCustomization: &inboundmodel.TokenCustomization{
+						AccessToken: []inboundmodel.TokenClaimRule{
+							{Claim: "iss", Type: inboundmodel.TokenClaimRuleStatic, Value: "https://evil.example.com"},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	result, svcErr := validateOAuthParamsForCreateAndUpdate(app)
+
+	assert.Nil(suite.T(), result)
+	suite.Require().NotNil(svcErr)
+	assert.Equal(suite.T(), ErrorInvalidTokenCustomization.Code, svcErr.Code)
+	assert.Contains(suite.T(), svcErr.ErrorDescription.DefaultValue, `claim "iss" is reserved`)
+}
+
 func (suite *ServiceTestSuite) TestValidateOAuthParamsForCreateAndUpdate_WithGrantTypeButNoResponseType() {
 	app := &model.ApplicationDTO{
 		Name: "Test App",
@@ -1351,6 +1508,57 @@ func (suite *ServiceTestSuite) TestValidateApplication_InvalidLogoURL() {
 	assert.Equal(suite.T(), &ErrorInvalidLogoURL, svcErr)
 }
 
+func (suite *ServiceTestSuite) TestValidateApplication_InvalidAllowedAuthMethods() {
+	testConfig := &config.Config{}
+	config.ResetServerRuntime()
+	err := config.InitializeServerRuntime("/tmp/test", testConfig)
+	require.NoError(suite.T(), err)
+	defer config.ResetServerRuntime()
+
+	service, _ := suite.setupTestService()
+
+	app := &model.ApplicationDTO{
+		Name: "Test App",
+		OUID: testOUID,
+		InboundAuthProfile: inboundmodel.InboundAuthProfile{
+			AuthFlowID:         "edc013d0-e893-4dc0-990c-3e1d203e005b",
+			AllowedAuthMethods: &inboundmodel.AuthMethodsConfig{},
+		},
+	}
+
+	result, inboundAuth, svcErr := service.ValidateApplication(context.Background(), app)
+
+	assert.Nil(suite.T(), result)
+	assert.Nil(suite.T(), inboundAuth)
+	assert.Equal(suite.T(), &ErrorInvalidAllowedAuthMethods, svcErr)
+}
+
+func (suite *ServiceTestSuite) TestValidateAllowedAuthMethods() {
+	testCases := []struct {
+		name    string
+		methods *inboundmodel.AuthMethodsConfig
+		valid   bool
+	}{
+		{"Unrestricted", nil, true},
+		{"LocalOnly", &inboundmodel.AuthMethodsConfig{Local: true}, true},
+		{"FederatedOnly", &inboundmodel.AuthMethodsConfig{FederatedIDPs: []string{"idp-1", "idp-2"}}, true},
+		{"NothingAllowed", &inboundmodel.AuthMethodsConfig{}, false},
+		{"EmptyIdPID", &inboundmodel.AuthMethodsConfig{Passkey: true, FederatedIDPs: []string{" "}}, false},
+		{"DuplicateIdPID", &inboundmodel.AuthMethodsConfig{FederatedIDPs: []string{"idp-1", "idp-1"}}, false},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			svcErr := validateAllowedAuthMethods(tc.methods)
+			if tc.valid {
+				assert.Nil(suite.T(), svcErr)
+			} else {
+				assert.Equal(suite.T(), &ErrorInvalidAllowedAuthMethods, svcErr)
+			}
+		})
+	}
+}
+
 func (suite *ServiceTestSuite) TestCreateApplication_StoreErrorWithRollback() {
 	suite.runCreateApplicationStoreErrorTest()
 }
@@ -2916,6 +3124,58 @@ func (s *AcrValidationTestSuite) TestIsValidACR_EmptyMapping() {
 	s.False(isValidACR("urn:thunder:acr:password"))
 }
 
+type ClaimFallbackValidationTestSuite struct {
+	suite.Suite
+}
+
+func TestClaimFallbackValidationTestSuite(t *testing.T) {
+	suite.Run(t, new(ClaimFallbackValidationTestSuite))
+}
+
+func (s *ClaimFallbackValidationTestSuite) initRuntime(gravatarEnabled bool) {
+	config.ResetServerRuntime()
+	s.Require().NoError(config.InitializeServerRuntime("", &config.Config{
+		OAuth: config.OAuthConfig{
+			ClaimFallback: config.ClaimFallbackConfig{Gravatar: config.GravatarConfig{Enabled: gravatarEnabled}},
+		},
+	}))
+	s.T().Cleanup(config.ResetServerRuntime)
+}
+
+func (s *ClaimFallbackValidationTestSuite) TestValidateClaimFallbacks_Valid() {
+	s.initRuntime(true)
+
+	svcErr := validateClaimFallbacks(map[string][]inboundmodel.ClaimFallbackSource{
+		"name": {
+			{Type: inboundmodel.ClaimFallbackSourceAttributes, Attributes: []string{"displayName"}},
+			{Type: inboundmodel.ClaimFallbackSourceComputed, Template: "{given_name} {family_name}"},
+		},
+		"picture": {{Type: inboundmodel.ClaimFallbackSourceGravatar}},
+	})
+
+	s.Nil(svcErr)
+	s.Nil(validateClaimFallbacks(nil))
+}
+
+func (s *ClaimFallbackValidationTestSuite) TestValidateClaimFallbacks_Invalid() {
+	s.initRuntime(false)
+
+	cases := map[string]map[string][]inboundmodel.ClaimFallbackSource{
+		"empty claim":    {"": {{Type: inboundmodel.ClaimFallbackSourceAttributes, Attributes: []string{"a"}}}},
+		"empty chain":    {"name": {}},
+		"no attributes":  {"name": {{Type: inboundmodel.ClaimFallbackSourceAttributes}}},
+		"no placeholder": {"name": {{Type: inboundmodel.ClaimFallbackSourceComputed, Template: "Anonymous"}}},
+		"gravatar off":   {"picture": {{Type: inboundmodel.ClaimFallbackSourceGravatar}}},
+		"unknown type":   {"name": {{Type: "random"}}},
+	}
+	for name, claimFallbacks := range cases {
+		svcErr := validateClaimFallbacks(claimFallbacks)
+
+		s.Require().NotNil(svcErr, name)
+		s.Equal(ErrorInvalidClaimFallbacks.Code, svcErr.Code, name)
+	}
+}
+
 func (suite *ServiceTestSuite) TestTranslateOAuthValidationError() {
 	cases := []struct {
 		name        string
@@ -2934,6 +3194,24 @@ func (suite *ServiceTestSuite) TestTranslateOAuthValidationError() {
 			wantCode:    ErrorInvalidRedirectURI.Code,
 			wantDescKey: "error.applicationservice.redirect_uri_fragment_not_allowed_description",
 		},
+		{
+			name:        "RedirectURIWildcardHostNotAllowed",
+			err:         inboundclient.ErrOAuthRedirectURIWildcardHostNotAllowed,
+			wantCode:    ErrorInvalidRedirectURI.Code,
+			wantDescKey: "error.applicationservice.redirect_uri_wildcard_host_not_allowed_description",
+		},
+		{
+			name:        "RedirectURIPatternNotAllowed",
+			err:         inboundclient.ErrOAuthRedirectURIPatternNotAllowed,
+			wantCode:    ErrorInvalidRedirectURI.Code,
+			wantDescKey: "error.applicationservice.redirect_uri_pattern_not_allowed_description",
+		},
+		{
+			name:        "InvalidRedirectURIMatchMode",
+			err:         inboundclient.ErrOAuthInvalidRedirectURIMatchMode,
+			wantCode:    ErrorInvalidOAuthConfiguration.Code,
+			wantDescKey: "error.applicationservice.invalid_redirect_uri_match_mode_description",
+		},
 		{
 			name:        "AuthCodeRequiresRedirectURIs",
 			err:         inboundclient.ErrOAuthAuthCodeRequiresRedirectURIs,
@@ -3033,6 +3311,18 @@ func (suite *ServiceTestSuite) TestTranslateOAuthValidationError() {
 			wantCode:    ErrorInvalidPublicClientConfiguration.Code,
 			wantDescKey: "error.applicationservice.public_client_must_have_pkce_description",
 		},
+		{
+			name:        "UnsupportedRequestObjectSigningAlg",
+			err:         inboundclient.ErrOAuthUnsupportedRequestObjectSigningAlg,
+			wantCode:    ErrorInvalidOAuthConfiguration.Code,
+			wantDescKey: "error.applicationservice.unsupported_request_object_signing_alg_description",
+		},
+		{
+			name:        "RequestObjectRequiresCertificate",
+			err:         inboundclient.ErrOAuthRequestObjectRequiresCertificate,
+			wantCode:    ErrorInvalidOAuthConfiguration.Code,
+			wantDescKey: "error.applicationservice.request_object_requires_certificate_description",
+		},
 	}
 	for _, tc := range cases {
 		suite.Run(tc.name, func() {
@@ -3292,3 +3582,124 @@ func (suite *ServiceTestSuite) TestTranslateConsentSyncError() {
 	}
 	suite.Equal(serviceerror.InternalServerError.Code, translateConsentSyncError(serverErr).Code)
 }
+
+func (suite *ServiceTestSuite) TestMapEntityProviderError_QuotaExceeded() {
+	epErr := entityprovider.NewEntityProviderError(entityprovider.ErrorCodeQuotaExceeded, "Quota exceeded", "")
+
+	suite.Equal(&ErrorApplicationQuotaExceeded, mapEntityProviderError(epErr))
+}
+
+func newScopePolicyTestApp(policies map[string][]string) *model.ApplicationProcessedDTO {
+	return &model.ApplicationProcessedDTO{
+		ID:   testServiceAppID,
+		Name: "Test App",
+		InboundAuthConfig: []inboundmodel.InboundAuthConfigProcessed{
+			{
+				Type: inboundmodel.OAuthInboundAuthType,
+				OAuthConfig: &inboundmodel.OAuthClient{
+					ClientID:                 "client123",
+					GrantTypes:               []oauth2const.GrantType{oauth2const.GrantTypeClientCredentials},
+					AllowedScopesByGrantType: policies,
+				},
+			},
+		},
+	}
+}
+
+func (suite *ServiceTestSuite) TestGetAllowedScopesByGrantType_Success() {
+	service, mockStore := suite.setupTestService()
+	policies := map[string][]string{"client_credentials": {"read"}}
+	mockLoadFullApplication(mockStore, service, newScopePolicyTestApp(policies))
+
+	result, svcErr := service.GetAllowedScopesByGrantType(context.Background(), testServiceAppID)
+
+	assert.Nil(suite.T(), svcErr)
+	assert.Equal(suite.T(), policies, result)
+}
+
+func (suite *ServiceTestSuite) TestGetAllowedScopesByGrantType_NoOAuthConfig() {
+	service, mockStore := suite.setupTestService()
+	mockLoadFullApplication(mockStore, service, &model.ApplicationProcessedDTO{ID: testServiceAppID, Name: "App"})
+
+	result, svcErr := service.GetAllowedScopesByGrantType(context.Background(), testServiceAppID)
+
+	assert.Nil(suite.T(), result)
+	assert.Equal(suite.T(), &ErrorOAuthConfigNotFound, svcErr)
+}
+
+func (suite *ServiceTestSuite) TestUpdateGrantTypeAllowedScopes_Success() {
+	service, mockStore := suite.setupTestService()
+	mockLoadFullApplication(mockStore, service, newScopePolicyTestApp(nil))
+	mockStore.On("IsDeclarative", mock.Anything, testServiceAppID).Return(false)
+	mockStore.On("UpdateAllowedScopesByGrantType", mock.Anything, testServiceAppID,
+		map[string][]string{"client_credentials": {"read", "write"}}).Return(nil)
+
+	result, svcErr := service.UpdateGrantTypeAllowedScopes(context.Background(), testServiceAppID,
+		"client_credentials", []string{"read", "write"})
+
+	assert.Nil(suite.T(), svcErr)
+	assert.Equal(suite.T(), []string{"read", "write"}, result)
+}
+
+func (suite *ServiceTestSuite) TestUpdateGrantTypeAllowedScopes_InvalidGrantType() {
+	service, _ := suite.setupTestService()
+
+	result, svcErr := service.UpdateGrantTypeAllowedScopes(context.Background(), testServiceAppID,
+		"unknown_grant", []string{"read"})
+
+	assert.Nil(suite.T(), result)
+	assert.Equal(suite.T(), &ErrorInvalidGrantType, svcErr)
+}
+
+func (suite *ServiceTestSuite) TestUpdateGrantTypeAllowedScopes_Declarative() {
+	service, mockStore := suite.setupTestService()
+	mockLoadFullApplication(mockStore, service, newScopePolicyTestApp(nil))
+	mockStore.On("IsDeclarative", mock.Anything, testServiceAppID).Return(true)
+
+	result, svcErr := service.UpdateGrantTypeAllowedScopes(context.Background(), testServiceAppID,
+		"client_credentials", []string{"read"})
+
+	assert.Nil(suite.T(), result)
+	assert.Equal(suite.T(), &ErrorCannotModifyDeclarativeResource, svcErr)
+}
+
+func (suite *ServiceTestSuite) TestUpdateGrantTypeAllowedScopes_ValidationError() {
+	service, mockStore := suite.setupTestService()
+	mockLoadFullApplication(mockStore, service, newScopePolicyTestApp(nil))
+	mockStore.On("IsDeclarative", mock.Anything, testServiceAppID).Return(false)
+	mockStore.On("UpdateAllowedScopesByGrantType", mock.Anything, testServiceAppID, mock.Anything).
+		Return(inboundclient.ErrOAuthUnsupportedScopePolicyGrantType)
+
+	result, svcErr := service.UpdateGrantTypeAllowedScopes(context.Background(), testServiceAppID,
+		"authorization_code", []string{"read"})
+
+	assert.Nil(suite.T(), result)
+	require.NotNil(suite.T(), svcErr)
+	assert.Equal(suite.T(), ErrorInvalidOAuthConfiguration.Code, svcErr.Code)
+	assert.Equal(suite.T(), "error.applicationservice.unsupported_scope_policy_grant_type_description",
+		svcErr.ErrorDescription.Key)
+}
+
+func (suite *ServiceTestSuite) TestDeleteGrantTypeAllowedScopes_Success() {
+	service, mockStore := suite.setupTestService()
+	mockLoadFullApplication(mockStore, service,
+		newScopePolicyTestApp(map[string][]string{"client_credentials": {"read"}}))
+	mockStore.On("IsDeclarative", mock.Anything, testServiceAppID).Return(false)
+	mockStore.On("UpdateAllowedScopesByGrantType", mock.Anything, testServiceAppID,
+		map[string][]string(nil)).Return(nil)
+
+	svcErr := service.DeleteGrantTypeAllowedScopes(context.Background(), testServiceAppID, "client_credentials")
+
+	assert.Nil(suite.T(), svcErr)
+}
+
+func (suite *ServiceTestSuite) TestDeleteGrantTypeAllowedScopes_NoPolicy() {
+	service, mockStore := suite.setupTestService()
+	mockLoadFullApplication(mockStore, service, newScopePolicyTestApp(nil))
+
+	svcErr := service.DeleteGrantTypeAllowedScopes(context.Background(), testServiceAppID, "client_credentials")
+
+	assert.Nil(suite.T(), svcErr)
+	mockStore.AssertNotCalled(suite.T(), "UpdateAllowedScopesByGrantType", mock.Anything, mock.Anything,
+		mock.Anything)
+}
@@ -0,0 +1,137 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package appuser
+
+import (
+	"errors"
+
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/i18n/core"
+)
+
+// ErrAppUserNotFound is returned when the user is not associated with the application in the store.
+var ErrAppUserNotFound = errors.New("application user not found")
+
+// Client errors for application user operations.
+var (
+	// ErrorApplicationNotFound is the error returned when the application is not found.
+	ErrorApplicationNotFound = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "APU-1001",
+		Error: core.I18nMessage{
+			Key:          "error.appuserservice.application_not_found",
+			DefaultValue: "Application not found",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.appuserservice.application_not_found_description",
+			DefaultValue: "The requested application could not be found",
+		},
+	}
+	// ErrorUserNotFound is the error returned when the user is not found.
+	ErrorUserNotFound = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "APU-1002",
+		Error: core.I18nMessage{
+			Key:          "error.appuserservice.user_not_found",
+			DefaultValue: "User not found",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.appuserservice.user_not_found_description",
+			DefaultValue: "The requested user could not be found",
+		},
+	}
+	// ErrorAppUserNotFound is the error returned when the user is not associated with the application.
+	ErrorAppUserNotFound = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "APU-1003",
+		Error: core.I18nMessage{
+			Key:          "error.appuserservice.app_user_not_found",
+			DefaultValue: "Application user not found",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.appuserservice.app_user_not_found_description",
+			DefaultValue: "The user is not associated with the application",
+		},
+	}
+	// ErrorAppUserAlreadyExists is the error returned when the user is already associated with the application.
+	ErrorAppUserAlreadyExists = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "APU-1004",
+		Error: core.I18nMessage{
+			Key:          "error.appuserservice.app_user_already_exists",
+			DefaultValue: "Application user already exists",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.appuserservice.app_user_already_exists_description",
+			DefaultValue: "The user is already associated with the application",
+		},
+	}
+	// ErrorDeclarativeUser is the error returned when a declarative user is associated with an application.
+	ErrorDeclarativeUser = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "APU-1005",
+		Error: core.I18nMessage{
+			Key:          "error.appuserservice.declarative_user",
+			DefaultValue: "Declarative user",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.appuserservice.declarative_user_description",
+			DefaultValue: "Declarative users cannot be associated with applications",
+		},
+	}
+	// ErrorInvalidRequestFormat is the error returned when the request body is malformed.
+	ErrorInvalidRequestFormat = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "APU-1006",
+		Error: core.I18nMessage{
+			Key:          "error.appuserservice.invalid_request_format",
+			DefaultValue: "Invalid request format",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.appuserservice.invalid_request_format_description",
+			DefaultValue: "The request body is malformed or the user ID is missing",
+		},
+	}
+	// ErrorInvalidLimit is the error returned when the limit parameter is invalid.
+	ErrorInvalidLimit = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "APU-1007",
+		Error: core.I18nMessage{
+			Key:          "error.appuserservice.invalid_limit_parameter",
+			DefaultValue: "Invalid limit parameter",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.appuserservice.invalid_limit_parameter_description",
+			DefaultValue: "The limit parameter must be between 1 and 100",
+		},
+	}
+	// ErrorInvalidOffset is the error returned when the offset parameter is invalid.
+	ErrorInvalidOffset = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "APU-1008",
+		Error: core.I18nMessage{
+			Key:          "error.appuserservice.invalid_offset_parameter",
+			DefaultValue: "Invalid offset parameter",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.appuserservice.invalid_offset_parameter_description",
+			DefaultValue: "The offset parameter must be a non-negative integer",
+		},
+	}
+)
@@ -0,0 +1,92 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package appuser
+
+import (
+	"net/http"
+
+	sysutils "github.com/thunder-id/thunderid/internal/system/utils"
+)
+
+// appUserHandler is the handler for application user operations.
+type appUserHandler struct {
+	appUserService AppUserServiceInterface
+}
+
+// newAppUserHandler creates a new instance of appUserHandler.
+func newAppUserHandler(appUserService AppUserServiceInterface) *appUserHandler {
+	return &appUserHandler{
+		appUserService: appUserService,
+	}
+}
+
+// HandleAppUserListRequest handles the list application users request.
+func (h *appUserHandler) HandleAppUserListRequest(w http.ResponseWriter, r *http.Request) {
+	pagination, svcErr := sysutils.ParsePaginationParams(r.URL.Query(), &ErrorInvalidLimit, &ErrorInvalidOffset)
+	if svcErr != nil {
+		sysutils.WriteServiceErrorResponse(w, svcErr, clientErrorStatusCodes)
+		return
+	}
+
+	users, svcErr := h.appUserService.GetAppUserList(r.Context(), r.PathValue("id"),
+		pagination.Limit, pagination.Offset)
+	if svcErr != nil {
+		sysutils.WriteServiceErrorResponse(w, svcErr, clientErrorStatusCodes)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(w, http.StatusOK, users)
+}
+
+// HandleAppUserPostRequest handles the add application user request.
+func (h *appUserHandler) HandleAppUserPostRequest(w http.ResponseWriter, r *http.Request) {
+	request, err := sysutils.DecodeJSONBody[AddAppUserRequest](r)
+	if err != nil || request.UserID == "" {
+		sysutils.WriteServiceErrorResponse(w, &ErrorInvalidRequestFormat, clientErrorStatusCodes)
+		return
+	}
+
+	user, svcErr := h.appUserService.AddAppUser(r.Context(), r.PathValue("id"), request.UserID)
+	if svcErr != nil {
+		sysutils.WriteServiceErrorResponse(w, svcErr, clientErrorStatusCodes)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(w, http.StatusCreated, user)
+}
+
+// HandleAppUserDeleteRequest handles the remove application user request.
+func (h *appUserHandler) HandleAppUserDeleteRequest(w http.ResponseWriter, r *http.Request) {
+	if svcErr := h.appUserService.RemoveAppUser(r.Context(), r.PathValue("id"),
+		r.PathValue("userId")); svcErr != nil {
+		sysutils.WriteServiceErrorResponse(w, svcErr, clientErrorStatusCodes)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// clientErrorStatusCodes maps the client errors of the handler to HTTP status codes. Other client errors are
+// returned with 400 Bad Request.
+var clientErrorStatusCodes = map[string]int{
+	ErrorApplicationNotFound.Code:  http.StatusNotFound,
+	ErrorUserNotFound.Code:         http.StatusNotFound,
+	ErrorAppUserNotFound.Code:      http.StatusNotFound,
+	ErrorAppUserAlreadyExists.Code: http.StatusConflict,
+}
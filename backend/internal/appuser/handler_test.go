@@ -0,0 +1,129 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package appuser
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type AppUserHandlerTestSuite struct {
+	suite.Suite
+	mockService *AppUserServiceInterfaceMock
+	handler     *appUserHandler
+}
+
+func TestAppUserHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(AppUserHandlerTestSuite))
+}
+
+func (suite *AppUserHandlerTestSuite) SetupTest() {
+	suite.mockService = NewAppUserServiceInterfaceMock(suite.T())
+	suite.handler = newAppUserHandler(suite.mockService)
+}
+
+func (suite *AppUserHandlerTestSuite) TestHandleAppUserListRequest_Success() {
+	suite.mockService.On("GetAppUserList", mock.Anything, "app-1", 5, 0).
+		Return(&AppUserListResponse{TotalResults: 1, StartIndex: 1, Count: 1,
+			Users: []AppUser{{UserID: "user-1"}}}, nil).Once()
+
+	req := httptest.NewRequest(http.MethodGet, "/admin/applications/app-1/users?limit=5", nil)
+	req.SetPathValue("id", "app-1")
+	rr := httptest.NewRecorder()
+
+	suite.handler.HandleAppUserListRequest(rr, req)
+
+	suite.Equal(http.StatusOK, rr.Code)
+	suite.Contains(rr.Body.String(), `"userId":"user-1"`)
+}
+
+func (suite *AppUserHandlerTestSuite) TestHandleAppUserListRequest_InvalidLimit() {
+	req := httptest.NewRequest(http.MethodGet, "/admin/applications/app-1/users?limit=abc", nil)
+	req.SetPathValue("id", "app-1")
+	rr := httptest.NewRecorder()
+
+	suite.handler.HandleAppUserListRequest(rr, req)
+
+	suite.Equal(http.StatusBadRequest, rr.Code)
+	suite.Contains(rr.Body.String(), ErrorInvalidLimit.Code)
+}
+
+func (suite *AppUserHandlerTestSuite) TestHandleAppUserPostRequest_Success() {
+	suite.mockService.On("AddAppUser", mock.Anything, "app-1", "user-1").
+		Return(&AppUser{UserID: "user-1", Source: AssociationSourceAdmin}, nil).Once()
+
+	req := httptest.NewRequest(http.MethodPost, "/admin/applications/app-1/users",
+		strings.NewReader(`{"userId":"user-1"}`))
+	req.SetPathValue("id", "app-1")
+	rr := httptest.NewRecorder()
+
+	suite.handler.HandleAppUserPostRequest(rr, req)
+
+	suite.Equal(http.StatusCreated, rr.Code)
+	suite.Contains(rr.Body.String(), `"source":"ADMIN"`)
+}
+
+func (suite *AppUserHandlerTestSuite) TestHandleAppUserPostRequest_InvalidBody() {
+	req := httptest.NewRequest(http.MethodPost, "/admin/applications/app-1/users", strings.NewReader(`{}`))
+	req.SetPathValue("id", "app-1")
+	rr := httptest.NewRecorder()
+
+	suite.handler.HandleAppUserPostRequest(rr, req)
+
+	suite.Equal(http.StatusBadRequest, rr.Code)
+	suite.Contains(rr.Body.String(), ErrorInvalidRequestFormat.Code)
+}
+
+func (suite *AppUserHandlerTestSuite) TestHandleAppUserPostRequest_Conflict() {
+	suite.mockService.On("AddAppUser", mock.Anything, "app-1", "user-1").
+		Return(nil, &ErrorAppUserAlreadyExists).Once()
+
+	req := httptest.NewRequest(http.MethodPost, "/admin/applications/app-1/users",
+		strings.NewReader(`{"userId":"user-1"}`))
+	req.SetPathValue("id", "app-1")
+	rr := httptest.NewRecorder()
+
+	suite.handler.HandleAppUserPostRequest(rr, req)
+
+	suite.Equal(http.StatusConflict, rr.Code)
+}
+
+func (suite *AppUserHandlerTestSuite) TestHandleAppUserDeleteRequest() {
+	suite.mockService.On("RemoveAppUser", mock.Anything, "app-1", "user-1").Return(nil).Once()
+	suite.mockService.On("RemoveAppUser", mock.Anything, "app-1", "user-2").Return(&ErrorAppUserNotFound).Once()
+
+	req := httptest.NewRequest(http.MethodDelete, "/admin/applications/app-1/users/user-1", nil)
+	req.SetPathValue("id", "app-1")
+	req.SetPathValue("userId", "user-1")
+	rr := httptest.NewRecorder()
+	suite.handler.HandleAppUserDeleteRequest(rr, req)
+	suite.Equal(http.StatusNoContent, rr.Code)
+
+	req = httptest.NewRequest(http.MethodDelete, "/admin/applications/app-1/users/user-2", nil)
+	req.SetPathValue("id", "app-1")
+	req.SetPathValue("userId", "user-2")
+	rr = httptest.NewRecorder()
+	suite.handler.HandleAppUserDeleteRequest(rr, req)
+	suite.Equal(http.StatusNotFound, rr.Code)
+}
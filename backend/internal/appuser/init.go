@@ -0,0 +1,69 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package appuser
+
+import (
+	"net/http"
+
+	"github.com/thunder-id/thunderid/internal/entity"
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/middleware"
+)
+
+// Initialize initializes the application user service and registers its routes.
+func Initialize(mux *http.ServeMux, entityService entity.EntityServiceInterface) AppUserServiceInterface {
+	enabled := config.GetServerRuntime().Config.User.ApplicationAssociation.Enabled
+	appUserService := newAppUserService(newAppUserStore(), entityService, enabled)
+
+	appUserHandler := newAppUserHandler(appUserService)
+	registerRoutes(mux, appUserHandler)
+
+	return appUserService
+}
+
+// registerRoutes registers the routes for application user operations.
+func registerRoutes(mux *http.ServeMux, appUserHandler *appUserHandler) {
+	opts1 := middleware.CORSOptions{
+		AllowedMethods:   []string{"GET", "POST"},
+		AllowedHeaders:   middleware.DefaultAllowedHeaders,
+		AllowCredentials: true,
+		MaxAge:           600,
+	}
+	mux.HandleFunc(middleware.WithCORS("GET /admin/applications/{id}/users",
+		appUserHandler.HandleAppUserListRequest, opts1))
+	mux.HandleFunc(middleware.WithCORS("POST /admin/applications/{id}/users",
+		appUserHandler.HandleAppUserPostRequest, opts1))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS /admin/applications/{id}/users",
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, opts1))
+
+	opts2 := middleware.CORSOptions{
+		AllowedMethods:   []string{"DELETE"},
+		AllowedHeaders:   middleware.DefaultAllowedHeaders,
+		AllowCredentials: true,
+		MaxAge:           600,
+	}
+	mux.HandleFunc(middleware.WithCORS("DELETE /admin/applications/{id}/users/{userId}",
+		appUserHandler.HandleAppUserDeleteRequest, opts2))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS /admin/applications/{id}/users/{userId}",
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, opts2))
+}
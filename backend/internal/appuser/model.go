@@ -0,0 +1,60 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package appuser manages the associations of users with the applications they registered through or signed
+// in to, which limit the users visible to applications that isolate their users.
+package appuser
+
+import (
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/system/utils"
+)
+
+// AssociationSource identifies how a user became associated with an application.
+type AssociationSource string
+
+const (
+	// AssociationSourceRegistration indicates the user registered through the application.
+	AssociationSourceRegistration AssociationSource = "REGISTRATION"
+	// AssociationSourceLogin indicates the user first signed in to the application.
+	AssociationSourceLogin AssociationSource = "LOGIN"
+	// AssociationSourceAdmin indicates an administrator associated the user with the application.
+	AssociationSourceAdmin AssociationSource = "ADMIN"
+)
+
+// AppUser represents the association of a user with an application.
+type AppUser struct {
+	UserID    string            `json:"userId"`
+	Source    AssociationSource `json:"source"`
+	CreatedAt time.Time         `json:"createdAt"`
+}
+
+// AppUserListResponse represents the response for listing the users associated with an application.
+type AppUserListResponse struct {
+	TotalResults int          `json:"totalResults"`
+	StartIndex   int          `json:"startIndex"`
+	Count        int          `json:"count"`
+	Users        []AppUser    `json:"users"`
+	Links        []utils.Link `json:"links"`
+}
+
+// AddAppUserRequest represents the request to associate a user with an application.
+type AddAppUserRequest struct {
+	UserID string `json:"userId"`
+}
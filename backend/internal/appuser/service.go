@@ -0,0 +1,251 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package appuser
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/entity"
+	inboundmodel "github.com/thunder-id/thunderid/internal/inboundclient/model"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/internal/system/security"
+	"github.com/thunder-id/thunderid/internal/system/utils"
+)
+
+// clientIDAttribute is the attribute of the security context holding the OAuth client ID of the caller.
+const clientIDAttribute = "client_id"
+
+// AppUserServiceInterface defines the interface for the application user service.
+type AppUserServiceInterface interface {
+	IsEnabled() bool
+	RecordAppUser(ctx context.Context, appID, userID string, source AssociationSource) *serviceerror.ServiceError
+	IsAppUser(ctx context.Context, appID, userID string) (bool, *serviceerror.ServiceError)
+	GetCallerApplicationScope(ctx context.Context) (string, *serviceerror.ServiceError)
+	GetAppUserList(ctx context.Context, appID string, limit, offset int) (
+		*AppUserListResponse, *serviceerror.ServiceError)
+	AddAppUser(ctx context.Context, appID, userID string) (*AppUser, *serviceerror.ServiceError)
+	RemoveAppUser(ctx context.Context, appID, userID string) *serviceerror.ServiceError
+	SetInboundClientService(inboundClientService InboundClientReaderInterface)
+}
+
+// InboundClientReaderInterface reads the inbound clients of applications. It is implemented by the inbound
+// client service, which depends on the flow executors that record application users.
+type InboundClientReaderInterface interface {
+	GetInboundClientByEntityID(ctx context.Context, entityID string) (*inboundmodel.InboundClient, error)
+	GetOAuthClientByClientID(ctx context.Context, clientID string) (*inboundmodel.OAuthClient, error)
+}
+
+// appUserService is the default implementation of the AppUserServiceInterface.
+type appUserService struct {
+	store          appUserStoreInterface
+	entityService  entity.EntityServiceInterface
+	inboundClients InboundClientReaderInterface
+	enabled        bool
+}
+
+// newAppUserService creates a new instance of appUserService.
+func newAppUserService(
+	store appUserStoreInterface,
+	entityService entity.EntityServiceInterface,
+	enabled bool,
+) AppUserServiceInterface {
+	return &appUserService{
+		store:         store,
+		entityService: entityService,
+		enabled:       enabled,
+	}
+}
+
+// SetInboundClientService injects the service reading the inbound clients of applications. It is called once
+// at application startup, as the inbound client service is initialized after the flow executors.
+func (s *appUserService) SetInboundClientService(inboundClientService InboundClientReaderInterface) {
+	s.inboundClients = inboundClientService
+}
+
+// IsEnabled reports whether users are associated with applications and scoped to them.
+func (s *appUserService) IsEnabled() bool {
+	return s.enabled
+}
+
+// RecordAppUser associates a user with the application the user registered through or signed in to. It does
+// nothing when application associations are disabled or the user is already associated with the application.
+func (s *appUserService) RecordAppUser(
+	ctx context.Context, appID, userID string, source AssociationSource,
+) *serviceerror.ServiceError {
+	if !s.enabled || appID == "" || userID == "" {
+		return nil
+	}
+
+	user := AppUser{UserID: userID, Source: source, CreatedAt: time.Now().UTC()}
+	if _, err := s.store.AddAppUser(ctx, appID, user); err != nil {
+		logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName))
+		logger.Error("Failed to record application user", log.String("appID", appID),
+			log.MaskedString(log.LoggerKeyUserID, userID), log.Error(err))
+		return &serviceerror.InternalServerError
+	}
+	return nil
+}
+
+// IsAppUser reports whether a user is associated with an application.
+func (s *appUserService) IsAppUser(ctx context.Context, appID, userID string) (bool, *serviceerror.ServiceError) {
+	if _, err := s.store.GetAppUser(ctx, appID, userID); err != nil {
+		if errors.Is(err, ErrAppUserNotFound) {
+			return false, nil
+		}
+		logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName))
+		logger.Error("Failed to retrieve application user", log.String("appID", appID),
+			log.MaskedString(log.LoggerKeyUserID, userID), log.Error(err))
+		return false, &serviceerror.InternalServerError
+	}
+	return true, nil
+}
+
+// GetCallerApplicationScope returns the ID of the application the caller's token was issued to when that
+// application isolates its users, so that the users visible to the caller can be scoped to it. It returns an
+// empty string when the caller is not scoped.
+func (s *appUserService) GetCallerApplicationScope(ctx context.Context) (string, *serviceerror.ServiceError) {
+	if !s.enabled || s.inboundClients == nil {
+		return "", nil
+	}
+	clientID, _ := security.GetAttribute(ctx, clientIDAttribute).(string)
+	if clientID == "" {
+		return "", nil
+	}
+
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName))
+	oauthClient, err := s.inboundClients.GetOAuthClientByClientID(ctx, clientID)
+	if err != nil {
+		logger.Error("Failed to resolve the application of the caller", log.Error(err))
+		return "", &serviceerror.InternalServerError
+	}
+	if oauthClient == nil {
+		return "", nil
+	}
+
+	inboundClient, err := s.inboundClients.GetInboundClientByEntityID(ctx, oauthClient.ID)
+	if err != nil {
+		logger.Error("Failed to retrieve the application of the caller",
+			log.String("appID", oauthClient.ID), log.Error(err))
+		return "", &serviceerror.InternalServerError
+	}
+	if inboundClient == nil || !inboundClient.UserIsolation {
+		return "", nil
+	}
+	return oauthClient.ID, nil
+}
+
+// GetAppUserList lists the users associated with an application.
+func (s *appUserService) GetAppUserList(
+	ctx context.Context, appID string, limit, offset int,
+) (*AppUserListResponse, *serviceerror.ServiceError) {
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName))
+	if svcErr := s.validateEntity(ctx, appID, entity.EntityCategoryApp, &ErrorApplicationNotFound); svcErr != nil {
+		return nil, svcErr
+	}
+
+	totalCount, err := s.store.GetAppUserCount(ctx, appID)
+	if err != nil {
+		logger.Error("Failed to count application users", log.String("appID", appID), log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+	users, err := s.store.GetAppUserList(ctx, appID, limit, offset)
+	if err != nil {
+		logger.Error("Failed to list application users", log.String("appID", appID), log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+
+	return &AppUserListResponse{
+		TotalResults: totalCount,
+		StartIndex:   offset + 1,
+		Count:        len(users),
+		Users:        users,
+		Links:        utils.BuildPaginationLinks("/admin/applications/"+appID+"/users", limit, offset, totalCount, ""),
+	}, nil
+}
+
+// AddAppUser associates a user with an application on behalf of an administrator.
+func (s *appUserService) AddAppUser(
+	ctx context.Context, appID, userID string,
+) (*AppUser, *serviceerror.ServiceError) {
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName))
+	if svcErr := s.validateEntity(ctx, appID, entity.EntityCategoryApp, &ErrorApplicationNotFound); svcErr != nil {
+		return nil, svcErr
+	}
+	if svcErr := s.validateEntity(ctx, userID, entity.EntityCategoryUser, &ErrorUserNotFound); svcErr != nil {
+		return nil, svcErr
+	}
+
+	isDeclarative, err := s.entityService.IsEntityDeclarative(ctx, userID)
+	if err != nil {
+		logger.Error("Failed to check whether the user is declarative",
+			log.MaskedString(log.LoggerKeyUserID, userID), log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+	if isDeclarative {
+		return nil, &ErrorDeclarativeUser
+	}
+
+	user := AppUser{UserID: userID, Source: AssociationSourceAdmin, CreatedAt: time.Now().UTC()}
+	added, err := s.store.AddAppUser(ctx, appID, user)
+	if err != nil {
+		logger.Error("Failed to add application user", log.String("appID", appID),
+			log.MaskedString(log.LoggerKeyUserID, userID), log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+	if !added {
+		return nil, &ErrorAppUserAlreadyExists
+	}
+	return &user, nil
+}
+
+// RemoveAppUser removes the association of a user with an application.
+func (s *appUserService) RemoveAppUser(ctx context.Context, appID, userID string) *serviceerror.ServiceError {
+	removed, err := s.store.DeleteAppUser(ctx, appID, userID)
+	if err != nil {
+		logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName))
+		logger.Error("Failed to remove application user", log.String("appID", appID),
+			log.MaskedString(log.LoggerKeyUserID, userID), log.Error(err))
+		return &serviceerror.InternalServerError
+	}
+	if !removed {
+		return &ErrorAppUserNotFound
+	}
+	return nil
+}
+
+// validateEntity checks that the entity exists and belongs to the category, and returns notFound otherwise.
+func (s *appUserService) validateEntity(ctx context.Context, entityID string, category entity.EntityCategory,
+	notFound *serviceerror.ServiceError) *serviceerror.ServiceError {
+	e, err := s.entityService.GetEntity(ctx, entityID)
+	if err != nil {
+		if errors.Is(err, entity.ErrEntityNotFound) {
+			return notFound
+		}
+		logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName))
+		logger.Error("Failed to retrieve entity", log.String("entityID", entityID), log.Error(err))
+		return &serviceerror.InternalServerError
+	}
+	if e.Category != category {
+		return notFound
+	}
+	return nil
+}
@@ -0,0 +1,228 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package appuser
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/entity"
+	inboundmodel "github.com/thunder-id/thunderid/internal/inboundclient/model"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/security"
+	"github.com/thunder-id/thunderid/tests/mocks/entitymock"
+)
+
+type AppUserServiceTestSuite struct {
+	suite.Suite
+	mockStore          *appUserStoreInterfaceMock
+	mockEntityService  *entitymock.EntityServiceInterfaceMock
+	mockInboundClients *InboundClientReaderInterfaceMock
+	service            *appUserService
+	ctx                context.Context
+}
+
+func TestAppUserServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(AppUserServiceTestSuite))
+}
+
+func (suite *AppUserServiceTestSuite) SetupTest() {
+	suite.mockStore = newAppUserStoreInterfaceMock(suite.T())
+	suite.mockEntityService = entitymock.NewEntityServiceInterfaceMock(suite.T())
+	suite.mockInboundClients = NewInboundClientReaderInterfaceMock(suite.T())
+	suite.service = newAppUserService(suite.mockStore, suite.mockEntityService, true).(*appUserService)
+	suite.service.SetInboundClientService(suite.mockInboundClients)
+	suite.ctx = context.Background()
+}
+
+func (suite *AppUserServiceTestSuite) callerContext(clientID string) context.Context {
+	return security.WithSecurityContextTest(suite.ctx, security.NewSecurityContextForTest(
+		"caller-1", "", "", nil, map[string]interface{}{"client_id": clientID}))
+}
+
+func (suite *AppUserServiceTestSuite) TestRecordAppUser_Success() {
+	suite.mockStore.On("AddAppUser", suite.ctx, "app-1", mock.MatchedBy(func(u AppUser) bool {
+		return u.UserID == "user-1" && u.Source == AssociationSourceRegistration && !u.CreatedAt.IsZero()
+	})).Return(true, nil).Once()
+
+	suite.Nil(suite.service.RecordAppUser(suite.ctx, "app-1", "user-1", AssociationSourceRegistration))
+}
+
+func (suite *AppUserServiceTestSuite) TestRecordAppUser_Disabled() {
+	suite.service.enabled = false
+
+	suite.Nil(suite.service.RecordAppUser(suite.ctx, "app-1", "user-1", AssociationSourceLogin))
+	suite.mockStore.AssertNotCalled(suite.T(), "AddAppUser", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *AppUserServiceTestSuite) TestRecordAppUser_StoreError() {
+	suite.mockStore.On("AddAppUser", suite.ctx, "app-1", mock.Anything).Return(false, errors.New("db error")).Once()
+
+	svcErr := suite.service.RecordAppUser(suite.ctx, "app-1", "user-1", AssociationSourceLogin)
+	suite.Equal(&serviceerror.InternalServerError, svcErr)
+}
+
+func (suite *AppUserServiceTestSuite) TestIsAppUser() {
+	suite.mockStore.On("GetAppUser", suite.ctx, "app-1", "user-1").
+		Return(&AppUser{UserID: "user-1", Source: AssociationSourceLogin}, nil).Once()
+	suite.mockStore.On("GetAppUser", suite.ctx, "app-1", "user-2").Return(nil, ErrAppUserNotFound).Once()
+
+	isAppUser, svcErr := suite.service.IsAppUser(suite.ctx, "app-1", "user-1")
+	suite.Nil(svcErr)
+	suite.True(isAppUser)
+
+	isAppUser, svcErr = suite.service.IsAppUser(suite.ctx, "app-1", "user-2")
+	suite.Nil(svcErr)
+	suite.False(isAppUser)
+}
+
+func (suite *AppUserServiceTestSuite) TestGetCallerApplicationScope_IsolatedApplication() {
+	ctx := suite.callerContext("client-1")
+	suite.mockInboundClients.On("GetOAuthClientByClientID", ctx, "client-1").
+		Return(&inboundmodel.OAuthClient{ID: "app-1", ClientID: "client-1"}, nil).Once()
+	suite.mockInboundClients.On("GetInboundClientByEntityID", ctx, "app-1").
+		Return(&inboundmodel.InboundClient{ID: "app-1", UserIsolation: true}, nil).Once()
+
+	appID, svcErr := suite.service.GetCallerApplicationScope(ctx)
+	suite.Nil(svcErr)
+	suite.Equal("app-1", appID)
+}
+
+func (suite *AppUserServiceTestSuite) TestGetCallerApplicationScope_ApplicationWithoutIsolation() {
+	ctx := suite.callerContext("client-1")
+	suite.mockInboundClients.On("GetOAuthClientByClientID", ctx, "client-1").
+		Return(&inboundmodel.OAuthClient{ID: "app-1", ClientID: "client-1"}, nil).Once()
+	suite.mockInboundClients.On("GetInboundClientByEntityID", ctx, "app-1").
+		Return(&inboundmodel.InboundClient{ID: "app-1"}, nil).Once()
+
+	appID, svcErr := suite.service.GetCallerApplicationScope(ctx)
+	suite.Nil(svcErr)
+	suite.Empty(appID)
+}
+
+func (suite *AppUserServiceTestSuite) TestGetCallerApplicationScope_NoClient() {
+	appID, svcErr := suite.service.GetCallerApplicationScope(suite.ctx)
+	suite.Nil(svcErr)
+	suite.Empty(appID)
+
+	suite.service.enabled = false
+	appID, svcErr = suite.service.GetCallerApplicationScope(suite.callerContext("client-1"))
+	suite.Nil(svcErr)
+	suite.Empty(appID)
+}
+
+func (suite *AppUserServiceTestSuite) TestGetCallerApplicationScope_ResolveError() {
+	ctx := suite.callerContext("client-1")
+	suite.mockInboundClients.On("GetOAuthClientByClientID", ctx, "client-1").
+		Return(nil, errors.New("db error")).Once()
+
+	appID, svcErr := suite.service.GetCallerApplicationScope(ctx)
+	suite.Equal(&serviceerror.InternalServerError, svcErr)
+	suite.Empty(appID)
+}
+
+func (suite *AppUserServiceTestSuite) TestGetAppUserList_Success() {
+	suite.mockEntityService.On("GetEntity", suite.ctx, "app-1").
+		Return(&entity.Entity{ID: "app-1", Category: entity.EntityCategoryApp}, nil).Once()
+	suite.mockStore.On("GetAppUserCount", suite.ctx, "app-1").Return(3, nil).Once()
+	suite.mockStore.On("GetAppUserList", suite.ctx, "app-1", 2, 0).Return([]AppUser{
+		{UserID: "user-1", Source: AssociationSourceRegistration},
+		{UserID: "user-2", Source: AssociationSourceAdmin},
+	}, nil).Once()
+
+	response, svcErr := suite.service.GetAppUserList(suite.ctx, "app-1", 2, 0)
+	suite.Nil(svcErr)
+	suite.Equal(3, response.TotalResults)
+	suite.Equal(1, response.StartIndex)
+	suite.Equal(2, response.Count)
+	suite.Len(response.Users, 2)
+	suite.NotEmpty(response.Links)
+}
+
+func (suite *AppUserServiceTestSuite) TestGetAppUserList_ApplicationNotFound() {
+	suite.mockEntityService.On("GetEntity", suite.ctx, "app-1").Return(nil, entity.ErrEntityNotFound).Once()
+
+	response, svcErr := suite.service.GetAppUserList(suite.ctx, "app-1", 10, 0)
+	suite.Nil(response)
+	suite.Equal(&ErrorApplicationNotFound, svcErr)
+}
+
+func (suite *AppUserServiceTestSuite) TestAddAppUser_Success() {
+	suite.mockEntityService.On("GetEntity", suite.ctx, "app-1").
+		Return(&entity.Entity{ID: "app-1", Category: entity.EntityCategoryApp}, nil).Once()
+	suite.mockEntityService.On("GetEntity", suite.ctx, "user-1").
+		Return(&entity.Entity{ID: "user-1", Category: entity.EntityCategoryUser}, nil).Once()
+	suite.mockEntityService.On("IsEntityDeclarative", suite.ctx, "user-1").Return(false, nil).Once()
+	suite.mockStore.On("AddAppUser", suite.ctx, "app-1", mock.MatchedBy(func(u AppUser) bool {
+		return u.UserID == "user-1" && u.Source == AssociationSourceAdmin
+	})).Return(true, nil).Once()
+
+	user, svcErr := suite.service.AddAppUser(suite.ctx, "app-1", "user-1")
+	suite.Nil(svcErr)
+	suite.Equal("user-1", user.UserID)
+	suite.Equal(AssociationSourceAdmin, user.Source)
+}
+
+func (suite *AppUserServiceTestSuite) TestAddAppUser_UserNotFound() {
+	suite.mockEntityService.On("GetEntity", suite.ctx, "app-1").
+		Return(&entity.Entity{ID: "app-1", Category: entity.EntityCategoryApp}, nil).Once()
+	suite.mockEntityService.On("GetEntity", suite.ctx, "app-2").
+		Return(&entity.Entity{ID: "app-2", Category: entity.EntityCategoryApp}, nil).Once()
+
+	user, svcErr := suite.service.AddAppUser(suite.ctx, "app-1", "app-2")
+	suite.Nil(user)
+	suite.Equal(&ErrorUserNotFound, svcErr)
+}
+
+func (suite *AppUserServiceTestSuite) TestAddAppUser_DeclarativeUser() {
+	suite.mockEntityService.On("GetEntity", suite.ctx, "app-1").
+		Return(&entity.Entity{ID: "app-1", Category: entity.EntityCategoryApp}, nil).Once()
+	suite.mockEntityService.On("GetEntity", suite.ctx, "user-1").
+		Return(&entity.Entity{ID: "user-1", Category: entity.EntityCategoryUser}, nil).Once()
+	suite.mockEntityService.On("IsEntityDeclarative", suite.ctx, "user-1").Return(true, nil).Once()
+
+	user, svcErr := suite.service.AddAppUser(suite.ctx, "app-1", "user-1")
+	suite.Nil(user)
+	suite.Equal(&ErrorDeclarativeUser, svcErr)
+}
+
+func (suite *AppUserServiceTestSuite) TestAddAppUser_AlreadyExists() {
+	suite.mockEntityService.On("GetEntity", suite.ctx, "app-1").
+		Return(&entity.Entity{ID: "app-1", Category: entity.EntityCategoryApp}, nil).Once()
+	suite.mockEntityService.On("GetEntity", suite.ctx, "user-1").
+		Return(&entity.Entity{ID: "user-1", Category: entity.EntityCategoryUser}, nil).Once()
+	suite.mockEntityService.On("IsEntityDeclarative", suite.ctx, "user-1").Return(false, nil).Once()
+	suite.mockStore.On("AddAppUser", suite.ctx, "app-1", mock.Anything).Return(false, nil).Once()
+
+	user, svcErr := suite.service.AddAppUser(suite.ctx, "app-1", "user-1")
+	suite.Nil(user)
+	suite.Equal(&ErrorAppUserAlreadyExists, svcErr)
+}
+
+func (suite *AppUserServiceTestSuite) TestRemoveAppUser() {
+	suite.mockStore.On("DeleteAppUser", suite.ctx, "app-1", "user-1").Return(true, nil).Once()
+	suite.mockStore.On("DeleteAppUser", suite.ctx, "app-1", "user-2").Return(false, nil).Once()
+
+	suite.Nil(suite.service.RemoveAppUser(suite.ctx, "app-1", "user-1"))
+	suite.Equal(&ErrorAppUserNotFound, suite.service.RemoveAppUser(suite.ctx, "app-1", "user-2"))
+}
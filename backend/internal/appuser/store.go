@@ -0,0 +1,172 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package appuser
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+	sysContext "github.com/thunder-id/thunderid/internal/system/context"
+	"github.com/thunder-id/thunderid/internal/system/database/provider"
+	dbutils "github.com/thunder-id/thunderid/internal/system/database/utils"
+)
+
+var getDBProvider = provider.GetDBProvider
+
+// appUserStoreInterface defines the interface for application user association operations.
+type appUserStoreInterface interface {
+	AddAppUser(ctx context.Context, appID string, user AppUser) (bool, error)
+	GetAppUser(ctx context.Context, appID, userID string) (*AppUser, error)
+	GetAppUserCount(ctx context.Context, appID string) (int, error)
+	GetAppUserList(ctx context.Context, appID string, limit, offset int) ([]AppUser, error)
+	DeleteAppUser(ctx context.Context, appID, userID string) (bool, error)
+}
+
+// appUserStore is the database backed implementation of appUserStoreInterface.
+type appUserStore struct {
+	dbProvider   provider.DBProviderInterface
+	deploymentID string
+}
+
+// newAppUserStore creates a new instance of appUserStore.
+func newAppUserStore() appUserStoreInterface {
+	return &appUserStore{
+		dbProvider:   getDBProvider(),
+		deploymentID: config.GetServerRuntime().Config.Server.Identifier,
+	}
+}
+
+// AddAppUser associates a user with an application. It reports whether the association was added; it is not
+// added when the user does not exist or is already associated with the application.
+func (s *appUserStore) AddAppUser(ctx context.Context, appID string, user AppUser) (bool, error) {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
+	dbClient, err := s.dbProvider.GetUserDBClient()
+	if err != nil {
+		return false, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	rowsAffected, err := dbClient.ExecuteContext(ctx, queryAddAppUser, appID, user.UserID, string(user.Source),
+		user.CreatedAt, deploymentID)
+	if err != nil {
+		return false, fmt.Errorf("failed to execute query: %w", err)
+	}
+	return rowsAffected > 0, nil
+}
+
+// GetAppUser retrieves the association of a user with an application.
+func (s *appUserStore) GetAppUser(ctx context.Context, appID, userID string) (*AppUser, error) {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
+	dbClient, err := s.dbProvider.GetUserDBClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	results, err := dbClient.QueryContext(ctx, queryGetAppUser, appID, userID, deploymentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+	if len(results) == 0 {
+		return nil, ErrAppUserNotFound
+	}
+
+	return buildAppUserFromResultRow(results[0])
+}
+
+// GetAppUserCount returns the number of users associated with an application.
+func (s *appUserStore) GetAppUserCount(ctx context.Context, appID string) (int, error) {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
+	dbClient, err := s.dbProvider.GetUserDBClient()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	results, err := dbClient.QueryContext(ctx, queryGetAppUserCount, appID, deploymentID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to execute query: %w", err)
+	}
+	if len(results) == 0 {
+		return 0, nil
+	}
+	if count, ok := results[0]["total"].(int64); ok {
+		return int(count), nil
+	}
+	return 0, fmt.Errorf("unexpected type for total: %T", results[0]["total"])
+}
+
+// GetAppUserList retrieves a page of the users associated with an application, ordered by user ID.
+func (s *appUserStore) GetAppUserList(ctx context.Context, appID string, limit, offset int) ([]AppUser, error) {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
+	dbClient, err := s.dbProvider.GetUserDBClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	results, err := dbClient.QueryContext(ctx, queryGetAppUserList, appID, deploymentID, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	users := make([]AppUser, 0, len(results))
+	for _, row := range results {
+		user, err := buildAppUserFromResultRow(row)
+		if err != nil {
+			return nil, err
+		}
+		users = append(users, *user)
+	}
+	return users, nil
+}
+
+// DeleteAppUser removes the association of a user with an application and reports whether it was removed.
+func (s *appUserStore) DeleteAppUser(ctx context.Context, appID, userID string) (bool, error) {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
+	dbClient, err := s.dbProvider.GetUserDBClient()
+	if err != nil {
+		return false, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	rowsAffected, err := dbClient.ExecuteContext(ctx, queryDeleteAppUser, appID, userID, deploymentID)
+	if err != nil {
+		return false, fmt.Errorf("failed to execute query: %w", err)
+	}
+	return rowsAffected > 0, nil
+}
+
+// buildAppUserFromResultRow constructs an AppUser from a database result row.
+func buildAppUserFromResultRow(row map[string]interface{}) (*AppUser, error) {
+	userID, ok := row["user_id"].(string)
+	if !ok {
+		return nil, fmt.Errorf("failed to parse user_id as string")
+	}
+	source, ok := row["source"].(string)
+	if !ok {
+		return nil, fmt.Errorf("failed to parse source as string")
+	}
+	createdAt, err := dbutils.ParseTimeField(row["created_at"], "created_at")
+	if err != nil {
+		return nil, err
+	}
+
+	return &AppUser{
+		UserID:    userID,
+		Source:    AssociationSource(source),
+		CreatedAt: createdAt,
+	}, nil
+}
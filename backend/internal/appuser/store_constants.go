@@ -0,0 +1,59 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package appuser
+
+import dbmodel "github.com/thunder-id/thunderid/internal/system/database/model"
+
+var (
+	// queryAddAppUser associates a user that still exists with an application unless it is already associated.
+	queryAddAppUser = dbmodel.DBQuery{
+		ID: "APUQ-APP_USER_MGT-01",
+		Query: `INSERT INTO "APPLICATION_USER" (APPLICATION_ID, USER_ID, SOURCE, CREATED_AT, DEPLOYMENT_ID) ` +
+			`SELECT $1, ID, $3, $4, DEPLOYMENT_ID FROM "ENTITY" WHERE ID = $2 AND DEPLOYMENT_ID = $5 ` +
+			`ON CONFLICT (APPLICATION_ID, USER_ID, DEPLOYMENT_ID) DO NOTHING`,
+	}
+
+	// queryGetAppUser retrieves the association of a user with an application.
+	queryGetAppUser = dbmodel.DBQuery{
+		ID: "APUQ-APP_USER_MGT-02",
+		Query: `SELECT USER_ID, SOURCE, CREATED_AT FROM "APPLICATION_USER" ` +
+			`WHERE APPLICATION_ID = $1 AND USER_ID = $2 AND DEPLOYMENT_ID = $3`,
+	}
+
+	// queryGetAppUserCount counts the users associated with an application.
+	queryGetAppUserCount = dbmodel.DBQuery{
+		ID: "APUQ-APP_USER_MGT-03",
+		Query: `SELECT COUNT(*) AS total FROM "APPLICATION_USER" ` +
+			`WHERE APPLICATION_ID = $1 AND DEPLOYMENT_ID = $2`,
+	}
+
+	// queryGetAppUserList retrieves a page of the users associated with an application.
+	queryGetAppUserList = dbmodel.DBQuery{
+		ID: "APUQ-APP_USER_MGT-04",
+		Query: `SELECT USER_ID, SOURCE, CREATED_AT FROM "APPLICATION_USER" ` +
+			`WHERE APPLICATION_ID = $1 AND DEPLOYMENT_ID = $2 ORDER BY USER_ID LIMIT $3 OFFSET $4`,
+	}
+
+	// queryDeleteAppUser removes the association of a user with an application.
+	queryDeleteAppUser = dbmodel.DBQuery{
+		ID: "APUQ-APP_USER_MGT-05",
+		Query: `DELETE FROM "APPLICATION_USER" ` +
+			`WHERE APPLICATION_ID = $1 AND USER_ID = $2 AND DEPLOYMENT_ID = $3`,
+	}
+)
@@ -0,0 +1,148 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package appuser
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/tests/mocks/database/providermock"
+)
+
+const testDeploymentID = "test-deployment-id"
+
+type AppUserStoreTestSuite struct {
+	suite.Suite
+	mockDBProvider *providermock.DBProviderInterfaceMock
+	mockDBClient   *providermock.DBClientInterfaceMock
+	store          *appUserStore
+	ctx            context.Context
+}
+
+func TestAppUserStoreTestSuite(t *testing.T) {
+	suite.Run(t, new(AppUserStoreTestSuite))
+}
+
+func (suite *AppUserStoreTestSuite) SetupTest() {
+	suite.mockDBProvider = providermock.NewDBProviderInterfaceMock(suite.T())
+	suite.mockDBClient = providermock.NewDBClientInterfaceMock(suite.T())
+	suite.store = &appUserStore{
+		dbProvider:   suite.mockDBProvider,
+		deploymentID: testDeploymentID,
+	}
+	suite.ctx = context.Background()
+}
+
+func (suite *AppUserStoreTestSuite) TestAddAppUser() {
+	now := time.Now().UTC()
+	suite.mockDBProvider.On("GetUserDBClient").Return(suite.mockDBClient, nil).Twice()
+	suite.mockDBClient.On("ExecuteContext", suite.ctx, queryAddAppUser, "app-1", "user-1", "REGISTRATION", now,
+		testDeploymentID).Return(int64(1), nil).Once()
+	suite.mockDBClient.On("ExecuteContext", suite.ctx, queryAddAppUser, "app-1", "user-2", "LOGIN", now,
+		testDeploymentID).Return(int64(0), nil).Once()
+
+	added, err := suite.store.AddAppUser(suite.ctx, "app-1",
+		AppUser{UserID: "user-1", Source: AssociationSourceRegistration, CreatedAt: now})
+	suite.NoError(err)
+	suite.True(added)
+
+	added, err = suite.store.AddAppUser(suite.ctx, "app-1",
+		AppUser{UserID: "user-2", Source: AssociationSourceLogin, CreatedAt: now})
+	suite.NoError(err)
+	suite.False(added)
+}
+
+func (suite *AppUserStoreTestSuite) TestGetAppUser_Success() {
+	suite.mockDBProvider.On("GetUserDBClient").Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.On("QueryContext", suite.ctx, queryGetAppUser, "app-1", "user-1", testDeploymentID).
+		Return([]map[string]interface{}{{
+			"user_id":    "user-1",
+			"source":     "LOGIN",
+			"created_at": "2026-01-02 03:04:05.123456",
+		}}, nil).Once()
+
+	user, err := suite.store.GetAppUser(suite.ctx, "app-1", "user-1")
+
+	suite.NoError(err)
+	suite.Equal("user-1", user.UserID)
+	suite.Equal(AssociationSourceLogin, user.Source)
+	suite.Equal(2026, user.CreatedAt.Year())
+}
+
+func (suite *AppUserStoreTestSuite) TestGetAppUser_NotFound() {
+	suite.mockDBProvider.On("GetUserDBClient").Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.On("QueryContext", suite.ctx, queryGetAppUser, "app-1", "user-1", testDeploymentID).
+		Return([]map[string]interface{}{}, nil).Once()
+
+	user, err := suite.store.GetAppUser(suite.ctx, "app-1", "user-1")
+
+	suite.Nil(user)
+	suite.ErrorIs(err, ErrAppUserNotFound)
+}
+
+func (suite *AppUserStoreTestSuite) TestGetAppUserCount() {
+	suite.mockDBProvider.On("GetUserDBClient").Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.On("QueryContext", suite.ctx, queryGetAppUserCount, "app-1", testDeploymentID).
+		Return([]map[string]interface{}{{"total": int64(4)}}, nil).Once()
+
+	count, err := suite.store.GetAppUserCount(suite.ctx, "app-1")
+
+	suite.NoError(err)
+	suite.Equal(4, count)
+}
+
+func (suite *AppUserStoreTestSuite) TestGetAppUserList() {
+	createdAt := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	suite.mockDBProvider.On("GetUserDBClient").Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.On("QueryContext", suite.ctx, queryGetAppUserList, "app-1", testDeploymentID, 10, 0).
+		Return([]map[string]interface{}{
+			{"user_id": "user-1", "source": "REGISTRATION", "created_at": createdAt},
+			{"user_id": "user-2", "source": "ADMIN", "created_at": createdAt},
+		}, nil).Once()
+
+	users, err := suite.store.GetAppUserList(suite.ctx, "app-1", 10, 0)
+
+	suite.NoError(err)
+	suite.Len(users, 2)
+	suite.Equal(AssociationSourceAdmin, users[1].Source)
+	suite.Equal(createdAt, users[0].CreatedAt)
+}
+
+func (suite *AppUserStoreTestSuite) TestDeleteAppUser() {
+	suite.mockDBProvider.On("GetUserDBClient").Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.On("ExecuteContext", suite.ctx, queryDeleteAppUser, "app-1", "user-1", testDeploymentID).
+		Return(int64(1), nil).Once()
+
+	deleted, err := suite.store.DeleteAppUser(suite.ctx, "app-1", "user-1")
+
+	suite.NoError(err)
+	suite.True(deleted)
+}
+
+func (suite *AppUserStoreTestSuite) TestDBClientError() {
+	suite.mockDBProvider.On("GetUserDBClient").Return(nil, errors.New("db error")).Once()
+
+	_, err := suite.store.GetAppUserCount(suite.ctx, "app-1")
+
+	suite.Error(err)
+}
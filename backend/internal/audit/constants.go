@@ -0,0 +1,73 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package audit
+
+const (
+	// loggerComponentName is the component name used in audit logs.
+	loggerComponentName = "AuditService"
+
+	// subscriberID identifies the audit subscriber on the event bus.
+	subscriberID = "audit"
+
+	// cleanupLockName is the distributed lock held while expired audit events are deleted.
+	cleanupLockName = "audit-cleanup"
+
+	// auditEventsPath is the base path of the audit event API.
+	auditEventsPath = "/audit-events"
+
+	// queryParamEventType is the query parameter filtering audit events by event type.
+	queryParamEventType = "eventType"
+
+	// queryParamActorID is the query parameter filtering audit events by actor.
+	queryParamActorID = "actorId"
+
+	// queryParamTargetType is the query parameter filtering audit events by target type.
+	queryParamTargetType = "targetType"
+
+	// queryParamTargetID is the query parameter filtering audit events by target.
+	queryParamTargetID = "targetId"
+
+	// queryParamOUID is the query parameter filtering audit events by organization unit.
+	queryParamOUID = "ouId"
+
+	// queryParamOutcome is the query parameter filtering audit events by outcome.
+	queryParamOutcome = "outcome"
+
+	// queryParamFrom is the query parameter selecting the audit events that occurred at or after a time.
+	queryParamFrom = "from"
+
+	// queryParamTo is the query parameter selecting the audit events that occurred before a time.
+	queryParamTo = "to"
+)
+
+// Target types of the audit events.
+const (
+	// TargetTypeUser identifies a user targeted by an audit event.
+	TargetTypeUser = "user"
+	// TargetTypeRole identifies a role targeted by an audit event.
+	TargetTypeRole = "role"
+	// TargetTypeFlow identifies a flow definition targeted by an audit event.
+	TargetTypeFlow = "flow"
+	// TargetTypeChangeRequest identifies a change request targeted by an audit event.
+	TargetTypeChangeRequest = "change_request"
+	// TargetTypeAccessReview identifies an access review campaign targeted by an audit event.
+	TargetTypeAccessReview = "access_review"
+	// TargetTypeApplication identifies an OAuth client targeted by an audit event.
+	TargetTypeApplication = "application"
+)
@@ -0,0 +1,93 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package audit
+
+import (
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/i18n/core"
+)
+
+// Client errors for audit operations.
+var (
+	// ErrorInvalidOutcome is the error returned when the outcome filter is not a known outcome.
+	ErrorInvalidOutcome = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "AUD-1001",
+		Error: core.I18nMessage{
+			Key:          "error.auditservice.invalid_outcome",
+			DefaultValue: "Invalid outcome filter",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.auditservice.invalid_outcome_description",
+			DefaultValue: "The outcome filter must be one of success, failure, in_progress or pending",
+		},
+	}
+	// ErrorInvalidTimeFilter is the error returned when the from or to filter is not an RFC 3339 time.
+	ErrorInvalidTimeFilter = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "AUD-1002",
+		Error: core.I18nMessage{
+			Key:          "error.auditservice.invalid_time_filter",
+			DefaultValue: "Invalid time filter",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.auditservice.invalid_time_filter_description",
+			DefaultValue: "The from and to filters must be RFC 3339 times",
+		},
+	}
+	// ErrorInvalidTimeRange is the error returned when the from filter is not before the to filter.
+	ErrorInvalidTimeRange = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "AUD-1003",
+		Error: core.I18nMessage{
+			Key:          "error.auditservice.invalid_time_range",
+			DefaultValue: "Invalid time range",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.auditservice.invalid_time_range_description",
+			DefaultValue: "The from filter must be before the to filter",
+		},
+	}
+	// ErrorInvalidLimit is the error returned when the limit parameter is invalid.
+	ErrorInvalidLimit = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "AUD-1004",
+		Error: core.I18nMessage{
+			Key:          "error.auditservice.invalid_limit_parameter",
+			DefaultValue: "Invalid limit parameter",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.auditservice.invalid_limit_parameter_description",
+			DefaultValue: "The limit parameter must be a positive integer",
+		},
+	}
+	// ErrorInvalidOffset is the error returned when the offset parameter is invalid.
+	ErrorInvalidOffset = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "AUD-1005",
+		Error: core.I18nMessage{
+			Key:          "error.auditservice.invalid_offset_parameter",
+			DefaultValue: "Invalid offset parameter",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.auditservice.invalid_offset_parameter_description",
+			DefaultValue: "The offset parameter must be a non-negative integer",
+		},
+	}
+)
@@ -0,0 +1,101 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package audit
+
+import (
+	"github.com/thunder-id/thunderid/internal/system/observability/event"
+)
+
+// targetKeys lists the event data keys identifying the target of an audit event, with the target type
+// each of them identifies. The first key present in the data of an event identifies its target.
+var targetKeys = []struct {
+	dataKey    string
+	targetType string
+}{
+	{dataKey: event.DataKey.UserID, targetType: TargetTypeUser},
+	{dataKey: event.DataKey.RoleID, targetType: TargetTypeRole},
+	{dataKey: event.DataKey.FlowID, targetType: TargetTypeFlow},
+	{dataKey: event.DataKey.ChangeRequestID, targetType: TargetTypeChangeRequest},
+	{dataKey: event.DataKey.CampaignID, targetType: TargetTypeAccessReview},
+	{dataKey: event.DataKey.ClientID, targetType: TargetTypeApplication},
+}
+
+// isAuditedEvent reports whether an event is recorded in the audit log. Every audit event is recorded,
+// along with the outcome of token issuance.
+func isAuditedEvent(evt *event.Event) bool {
+	if evt == nil {
+		return false
+	}
+	switch event.EventType(evt.Type) {
+	case event.EventTypeTokenIssued, event.EventTypeTokenIssuanceFailed:
+		return true
+	}
+	category, err := evt.GetCategory()
+	return err == nil && category == event.CategoryAudit
+}
+
+// buildAuditEvent builds the audit record of an event. The actor, target and organization unit are taken
+// out of the event data, and the rest of the data is kept as the details of the record.
+func buildAuditEvent(evt *event.Event) AuditEvent {
+	details := make(map[string]interface{}, len(evt.Data))
+	for key, value := range evt.Data {
+		details[key] = value
+	}
+
+	auditEvent := AuditEvent{
+		EventType: evt.Type,
+		Component: evt.Component,
+		Outcome:   evt.Status,
+		ActorID:   takeString(details, event.DataKey.ActorID),
+		OUID:      takeString(details, event.DataKey.OUID),
+		TraceID:   evt.TraceID,
+		Timestamp: evt.Timestamp.UTC(),
+	}
+	for _, target := range targetKeys {
+		if targetID := takeString(details, target.dataKey); targetID != "" {
+			auditEvent.TargetType = target.targetType
+			auditEvent.TargetID = targetID
+			break
+		}
+	}
+	if len(details) > 0 {
+		auditEvent.Details = details
+	}
+	return auditEvent
+}
+
+// takeString removes a string value from the data and returns it.
+func takeString(data map[string]interface{}, key string) string {
+	value, ok := data[key].(string)
+	if !ok {
+		return ""
+	}
+	delete(data, key)
+	return value
+}
+
+// isValidOutcome reports whether an outcome is one of the statuses of the events.
+func isValidOutcome(outcome string) bool {
+	switch outcome {
+	case event.StatusSuccess, event.StatusFailure, event.StatusInProgress, event.StatusPending:
+		return true
+	default:
+		return false
+	}
+}
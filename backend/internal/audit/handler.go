@@ -0,0 +1,98 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package audit
+
+import (
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	sysutils "github.com/thunder-id/thunderid/internal/system/utils"
+)
+
+// auditHandler is the handler for audit operations.
+type auditHandler struct {
+	auditService AuditServiceInterface
+}
+
+// newAuditHandler creates a new instance of auditHandler.
+func newAuditHandler(auditService AuditServiceInterface) *auditHandler {
+	return &auditHandler{
+		auditService: auditService,
+	}
+}
+
+// HandleAuditEventListRequest handles the request to list the audit events.
+func (h *auditHandler) HandleAuditEventListRequest(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	pagination, svcErr := sysutils.ParsePaginationParams(query, &ErrorInvalidLimit, &ErrorInvalidOffset)
+	if svcErr != nil {
+		sysutils.WriteServiceErrorResponse(w, svcErr, nil)
+		return
+	}
+	filter, svcErr := parseAuditEventFilter(query)
+	if svcErr != nil {
+		sysutils.WriteServiceErrorResponse(w, svcErr, nil)
+		return
+	}
+
+	auditEvents, svcErr := h.auditService.ListAuditEvents(
+		sysutils.WithSkipCount(r.Context(), pagination.SkipCount), filter, pagination.Limit, pagination.Offset)
+	if svcErr != nil {
+		sysutils.WriteServiceErrorResponse(w, svcErr, nil)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(w, http.StatusOK, auditEvents)
+}
+
+// parseAuditEventFilter builds the audit event filter from the query parameters of the request.
+func parseAuditEventFilter(query url.Values) (AuditEventFilter, *serviceerror.ServiceError) {
+	filter := AuditEventFilter{
+		EventType:  sysutils.SanitizeString(query.Get(queryParamEventType)),
+		ActorID:    sysutils.SanitizeString(query.Get(queryParamActorID)),
+		TargetType: sysutils.SanitizeString(query.Get(queryParamTargetType)),
+		TargetID:   sysutils.SanitizeString(query.Get(queryParamTargetID)),
+		OUID:       sysutils.SanitizeString(query.Get(queryParamOUID)),
+		Outcome:    sysutils.SanitizeString(query.Get(queryParamOutcome)),
+	}
+
+	var svcErr *serviceerror.ServiceError
+	if filter.From, svcErr = parseTimeParam(query.Get(queryParamFrom)); svcErr != nil {
+		return AuditEventFilter{}, svcErr
+	}
+	if filter.To, svcErr = parseTimeParam(query.Get(queryParamTo)); svcErr != nil {
+		return AuditEventFilter{}, svcErr
+	}
+	return filter, nil
+}
+
+// parseTimeParam parses an RFC 3339 time query parameter. An empty parameter is not set.
+func parseTimeParam(value string) (*time.Time, *serviceerror.ServiceError) {
+	if value == "" {
+		return nil, nil
+	}
+	parsed, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, &ErrorInvalidTimeFilter
+	}
+	parsed = parsed.UTC()
+	return &parsed, nil
+}
@@ -0,0 +1,106 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package audit
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/system/error/apierror"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+)
+
+type HandlerTestSuite struct {
+	suite.Suite
+	mockService *AuditServiceInterfaceMock
+	handler     *auditHandler
+}
+
+func TestHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(HandlerTestSuite))
+}
+
+func (s *HandlerTestSuite) SetupTest() {
+	s.mockService = NewAuditServiceInterfaceMock(s.T())
+	s.handler = newAuditHandler(s.mockService)
+}
+
+func (s *HandlerTestSuite) TestHandleAuditEventListRequest() {
+	from := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC)
+	s.mockService.On("ListAuditEvents", mock.Anything, AuditEventFilter{
+		EventType: "USER_CREATED", ActorID: testActor, TargetType: TargetTypeUser, TargetID: testUser,
+		OUID: testOU, Outcome: "success", From: &from, To: &to,
+	}, 5, 10).Return(&AuditEventListResponse{TotalResults: 1, Events: []AuditEvent{{ID: "event-1"}}}, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/audit-events?eventType=USER_CREATED&actorId="+testActor+
+		"&targetType=user&targetId="+testUser+"&ouId="+testOU+"&outcome=success"+
+		"&from=2026-01-01T00:00:00Z&to=2026-01-02T05:30:00%2B05:30&limit=5&offset=10", nil)
+	rr := httptest.NewRecorder()
+	s.handler.HandleAuditEventListRequest(rr, req)
+
+	s.Equal(http.StatusOK, rr.Code)
+	var body AuditEventListResponse
+	s.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &body))
+	s.Equal(1, body.TotalResults)
+	s.Equal("event-1", body.Events[0].ID)
+}
+
+func (s *HandlerTestSuite) TestHandleAuditEventListRequest_InvalidParameters() {
+	testCases := []struct {
+		name     string
+		query    string
+		wantCode string
+	}{
+		{name: "invalid limit", query: "limit=abc", wantCode: ErrorInvalidLimit.Code},
+		{name: "invalid offset", query: "offset=-1", wantCode: ErrorInvalidOffset.Code},
+		{name: "invalid from", query: "from=yesterday", wantCode: ErrorInvalidTimeFilter.Code},
+		{name: "invalid to", query: "to=2026-01-01", wantCode: ErrorInvalidTimeFilter.Code},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			req := httptest.NewRequest(http.MethodGet, "/audit-events?"+tc.query, nil)
+			rr := httptest.NewRecorder()
+			s.handler.HandleAuditEventListRequest(rr, req)
+
+			s.Equal(http.StatusBadRequest, rr.Code)
+			var body apierror.ErrorResponse
+			s.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &body))
+			s.Equal(tc.wantCode, body.Code)
+		})
+	}
+}
+
+func (s *HandlerTestSuite) TestHandleAuditEventListRequest_ServiceError() {
+	s.mockService.On("ListAuditEvents", mock.Anything, AuditEventFilter{}, mock.Anything, 0).
+		Return(nil, &serviceerror.InternalServerError)
+
+	req := httptest.NewRequest(http.MethodGet, "/audit-events", nil)
+	rr := httptest.NewRecorder()
+	s.handler.HandleAuditEventListRequest(rr, req)
+
+	s.Equal(http.StatusInternalServerError, rr.Code)
+}
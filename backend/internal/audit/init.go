@@ -0,0 +1,89 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package audit
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/internal/system/middleware"
+	"github.com/thunder-id/thunderid/internal/system/observability"
+	"github.com/thunder-id/thunderid/internal/system/scheduler"
+	"github.com/thunder-id/thunderid/internal/system/security"
+)
+
+// Initialize initializes the audit service, subscribes it to the audit events of the event bus, registers
+// its routes and starts the scheduled cleanup. The service is not initialized when the audit log is
+// disabled.
+func Initialize(
+	mux *http.ServeMux,
+	jobScheduler scheduler.SchedulerInterface,
+	observabilitySvc observability.ObservabilityServiceInterface,
+) AuditServiceInterface {
+	auditConfig := config.GetServerRuntime().Config.Audit
+	if !auditConfig.Enabled {
+		return nil
+	}
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName))
+
+	auditService := newAuditService(newAuditStore(), time.Duration(auditConfig.Retention)*time.Second)
+
+	if publisher := observabilitySvc.GetPublisher(); publisher != nil {
+		publisher.Subscribe(newAuditSubscriber(auditService))
+	} else {
+		logger.Warn("Observability is disabled, so events are not recorded in the audit log")
+	}
+
+	registerRoutes(mux, newAuditHandler(auditService))
+
+	jobScheduler.Schedule(cleanupLockName, time.Duration(auditConfig.CleanupInterval)*time.Second,
+		newScheduledCleanup(auditService))
+
+	return auditService
+}
+
+// registerRoutes registers the routes for audit operations.
+func registerRoutes(mux *http.ServeMux, auditHandler *auditHandler) {
+	opts := middleware.CORSOptions{
+		AllowedMethods:   []string{"GET"},
+		AllowedHeaders:   middleware.DefaultAllowedHeaders,
+		AllowCredentials: true,
+		MaxAge:           600,
+	}
+	mux.HandleFunc(middleware.WithCORS("GET /audit-events", auditHandler.HandleAuditEventListRequest, opts))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS /audit-events",
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, opts))
+}
+
+// newScheduledCleanup returns the scheduled job that deletes the expired audit events.
+func newScheduledCleanup(service AuditServiceInterface) scheduler.JobFunc {
+	return func(ctx context.Context) error {
+		deleted, err := service.DeleteExpiredAuditEvents(security.WithRuntimeContext(ctx))
+		if deleted > 0 {
+			log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName)).Debug(
+				"Deleted expired audit events", log.Int("count", deleted))
+		}
+		return err
+	}
+}
@@ -0,0 +1,65 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package audit provides the audit log of administrative and runtime events. Audit events published on the
+// observability event bus, such as changes to users, role assignments and flows or the issuance of tokens,
+// are recorded with their actor, target, organization unit and outcome, and can be queried through the
+// audit event API.
+package audit
+
+import (
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/system/utils"
+)
+
+// AuditEvent represents a recorded administrative or runtime event.
+type AuditEvent struct {
+	ID         string                 `json:"id"`
+	EventType  string                 `json:"eventType"`
+	Component  string                 `json:"component"`
+	Outcome    string                 `json:"outcome"`
+	ActorID    string                 `json:"actorId,omitempty"`
+	TargetType string                 `json:"targetType,omitempty"`
+	TargetID   string                 `json:"targetId,omitempty"`
+	OUID       string                 `json:"ouId,omitempty"`
+	TraceID    string                 `json:"traceId,omitempty"`
+	Details    map[string]interface{} `json:"details,omitempty"`
+	Timestamp  time.Time              `json:"timestamp"`
+}
+
+// AuditEventFilter restricts the audit events returned by a list. Empty fields match any value.
+type AuditEventFilter struct {
+	EventType  string
+	ActorID    string
+	TargetType string
+	TargetID   string
+	OUID       string
+	Outcome    string
+	From       *time.Time
+	To         *time.Time
+}
+
+// AuditEventListResponse represents the response for listing audit events with pagination.
+type AuditEventListResponse struct {
+	TotalResults int          `json:"totalResults"`
+	StartIndex   int          `json:"startIndex"`
+	Count        int          `json:"count"`
+	Events       []AuditEvent `json:"events"`
+	Links        []utils.Link `json:"links"`
+}
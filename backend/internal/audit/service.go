@@ -0,0 +1,149 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package audit
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/internal/system/observability/event"
+	"github.com/thunder-id/thunderid/internal/system/utils"
+)
+
+// AuditServiceInterface defines the interface for the audit service.
+type AuditServiceInterface interface {
+	ListAuditEvents(ctx context.Context, filter AuditEventFilter,
+		limit, offset int) (*AuditEventListResponse, *serviceerror.ServiceError)
+	RecordEvent(ctx context.Context, evt *event.Event) error
+	DeleteExpiredAuditEvents(ctx context.Context) (int, error)
+}
+
+// auditService is the default implementation of the AuditServiceInterface.
+type auditService struct {
+	store     auditStoreInterface
+	retention time.Duration
+	now       func() time.Time
+	logger    *log.Logger
+}
+
+// newAuditService creates a new instance of auditService. Audit events are kept for the retention period.
+func newAuditService(store auditStoreInterface, retention time.Duration) AuditServiceInterface {
+	return &auditService{
+		store:     store,
+		retention: retention,
+		now:       time.Now,
+		logger:    log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName)),
+	}
+}
+
+// ListAuditEvents returns a page of the audit events matching the filter, latest first.
+func (s *auditService) ListAuditEvents(ctx context.Context, filter AuditEventFilter,
+	limit, offset int) (*AuditEventListResponse, *serviceerror.ServiceError) {
+	if filter.Outcome != "" && !isValidOutcome(filter.Outcome) {
+		return nil, &ErrorInvalidOutcome
+	}
+	if filter.From != nil && filter.To != nil && !filter.From.Before(*filter.To) {
+		return nil, &ErrorInvalidTimeRange
+	}
+
+	totalCount, fetchLimit := utils.TotalCountUnknown, limit+1
+	if !utils.IsCountSkipped(ctx) {
+		var err error
+		totalCount, err = s.store.CountAuditEvents(ctx, filter)
+		if err != nil {
+			s.logger.Error("Failed to count audit events", log.Error(err))
+			return nil, &serviceerror.InternalServerError
+		}
+		fetchLimit = limit
+	}
+
+	auditEvents, err := s.store.ListAuditEvents(ctx, filter, fetchLimit, offset)
+	if err != nil {
+		s.logger.Error("Failed to list audit events", log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+	auditEvents, hasMore := utils.TrimPage(auditEvents, limit)
+
+	return &AuditEventListResponse{
+		TotalResults: totalCount,
+		StartIndex:   offset + 1,
+		Count:        len(auditEvents),
+		Events:       auditEvents,
+		Links: utils.BuildListPaginationLinks(auditEventsPath, limit, offset, totalCount, hasMore,
+			buildFilterQuery(filter)),
+	}, nil
+}
+
+// RecordEvent stores an event in the audit log. Events that are not audited are ignored.
+func (s *auditService) RecordEvent(ctx context.Context, evt *event.Event) error {
+	if !isAuditedEvent(evt) {
+		return nil
+	}
+
+	auditEvent := buildAuditEvent(evt)
+	id, err := utils.GenerateUUIDv7()
+	if err != nil {
+		return fmt.Errorf("failed to generate audit event ID: %w", err)
+	}
+	auditEvent.ID = id
+	if auditEvent.Timestamp.IsZero() {
+		auditEvent.Timestamp = s.now().UTC()
+	}
+
+	if err := s.store.CreateAuditEvent(ctx, auditEvent); err != nil {
+		return fmt.Errorf("failed to create audit event: %w", err)
+	}
+	return nil
+}
+
+// DeleteExpiredAuditEvents deletes the audit events older than the retention period and returns the number
+// of audit events deleted.
+func (s *auditService) DeleteExpiredAuditEvents(ctx context.Context) (int, error) {
+	if s.retention <= 0 {
+		return 0, nil
+	}
+	return s.store.DeleteAuditEventsBefore(ctx, s.now().UTC().Add(-s.retention))
+}
+
+// buildFilterQuery returns the filter query parameters carried by the pagination links.
+func buildFilterQuery(filter AuditEventFilter) string {
+	query := ""
+	addParam := func(name, value string) {
+		if value != "" {
+			query += "&" + name + "=" + url.QueryEscape(value)
+		}
+	}
+	addParam(queryParamEventType, filter.EventType)
+	addParam(queryParamActorID, filter.ActorID)
+	addParam(queryParamTargetType, filter.TargetType)
+	addParam(queryParamTargetID, filter.TargetID)
+	addParam(queryParamOUID, filter.OUID)
+	addParam(queryParamOutcome, filter.Outcome)
+	if filter.From != nil {
+		addParam(queryParamFrom, filter.From.Format(time.RFC3339Nano))
+	}
+	if filter.To != nil {
+		addParam(queryParamTo, filter.To.Format(time.RFC3339Nano))
+	}
+	return query
+}
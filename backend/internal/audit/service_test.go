@@ -0,0 +1,236 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package audit
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	sysContext "github.com/thunder-id/thunderid/internal/system/context"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/observability/event"
+	"github.com/thunder-id/thunderid/internal/system/utils"
+)
+
+const (
+	testActor = "admin-1"
+	testUser  = "user-1"
+	testOU    = "ou-1"
+)
+
+type AuditServiceTestSuite struct {
+	suite.Suite
+	mockStore *auditStoreInterfaceMock
+	service   *auditService
+	now       time.Time
+}
+
+func TestAuditServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(AuditServiceTestSuite))
+}
+
+func (suite *AuditServiceTestSuite) SetupTest() {
+	suite.mockStore = newAuditStoreInterfaceMock(suite.T())
+	suite.service = newAuditService(suite.mockStore, 24*time.Hour).(*auditService)
+	suite.now = time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	suite.service.now = func() time.Time { return suite.now }
+}
+
+func (suite *AuditServiceTestSuite) TestListAuditEvents() {
+	from := suite.now.Add(-time.Hour)
+	filter := AuditEventFilter{TargetType: TargetTypeUser, TargetID: testUser, Outcome: event.StatusSuccess,
+		From: &from}
+	suite.mockStore.On("CountAuditEvents", mock.Anything, filter).Return(3, nil).Once()
+	suite.mockStore.On("ListAuditEvents", mock.Anything, filter, 2, 0).
+		Return([]AuditEvent{{ID: "event-2"}, {ID: "event-1"}}, nil).Once()
+
+	response, svcErr := suite.service.ListAuditEvents(context.Background(), filter, 2, 0)
+
+	suite.Nil(svcErr)
+	suite.Equal(3, response.TotalResults)
+	suite.Equal(1, response.StartIndex)
+	suite.Equal(2, response.Count)
+	suite.Equal("event-2", response.Events[0].ID)
+	suite.Require().NotEmpty(response.Links)
+	suite.Contains(response.Links[0].Href, "&targetType=user&targetId=user-1&outcome=success"+
+		"&from=2025-12-31T23%3A00%3A00Z")
+}
+
+func (suite *AuditServiceTestSuite) TestListAuditEvents_SkipCount() {
+	suite.mockStore.On("ListAuditEvents", mock.Anything, AuditEventFilter{}, 3, 0).
+		Return([]AuditEvent{{ID: "event-3"}, {ID: "event-2"}, {ID: "event-1"}}, nil).Once()
+
+	response, svcErr := suite.service.ListAuditEvents(utils.WithSkipCount(context.Background(), true),
+		AuditEventFilter{}, 2, 0)
+
+	suite.Nil(svcErr)
+	suite.Equal(utils.TotalCountUnknown, response.TotalResults)
+	suite.Equal(2, response.Count)
+	suite.mockStore.AssertNotCalled(suite.T(), "CountAuditEvents", mock.Anything, mock.Anything)
+}
+
+func (suite *AuditServiceTestSuite) TestListAuditEvents_InvalidFilter() {
+	from := suite.now
+	to := suite.now.Add(-time.Hour)
+	testCases := []struct {
+		name   string
+		filter AuditEventFilter
+		want   *serviceerror.ServiceError
+	}{
+		{name: "unknown outcome", filter: AuditEventFilter{Outcome: "denied"}, want: &ErrorInvalidOutcome},
+		{name: "from after to", filter: AuditEventFilter{From: &from, To: &to}, want: &ErrorInvalidTimeRange},
+		{name: "empty range", filter: AuditEventFilter{From: &from, To: &from}, want: &ErrorInvalidTimeRange},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			response, svcErr := suite.service.ListAuditEvents(context.Background(), tc.filter, 10, 0)
+
+			suite.Nil(response)
+			suite.Equal(tc.want, svcErr)
+		})
+	}
+}
+
+func (suite *AuditServiceTestSuite) TestListAuditEvents_StoreError() {
+	suite.mockStore.On("CountAuditEvents", mock.Anything, AuditEventFilter{}).
+		Return(0, errors.New("db error")).Once()
+
+	response, svcErr := suite.service.ListAuditEvents(context.Background(), AuditEventFilter{}, 10, 0)
+
+	suite.Nil(response)
+	suite.Equal(&serviceerror.InternalServerError, svcErr)
+}
+
+func (suite *AuditServiceTestSuite) TestRecordEvent_UserEvent() {
+	occurredAt := suite.now.Add(-time.Minute)
+	evt := event.NewEvent("trace-1", string(event.EventTypeUserCredentialsUpdated), event.ComponentUserManagement).
+		WithStatus(event.StatusSuccess).
+		WithData(event.DataKey.ActorID, testActor).
+		WithData(event.DataKey.UserID, testUser).
+		WithData(event.DataKey.OUID, testOU).
+		WithData(event.DataKey.CredentialTypes, "password")
+	evt.Timestamp = occurredAt
+
+	var recorded AuditEvent
+	suite.mockStore.On("CreateAuditEvent", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		recorded = args.Get(1).(AuditEvent)
+	}).Return(nil).Once()
+
+	suite.NoError(suite.service.RecordEvent(context.Background(), evt))
+
+	suite.NotEmpty(recorded.ID)
+	suite.Equal(string(event.EventTypeUserCredentialsUpdated), recorded.EventType)
+	suite.Equal(event.ComponentUserManagement, recorded.Component)
+	suite.Equal(event.StatusSuccess, recorded.Outcome)
+	suite.Equal(testActor, recorded.ActorID)
+	suite.Equal(TargetTypeUser, recorded.TargetType)
+	suite.Equal(testUser, recorded.TargetID)
+	suite.Equal(testOU, recorded.OUID)
+	suite.Equal("trace-1", recorded.TraceID)
+	suite.Equal(occurredAt, recorded.Timestamp)
+	suite.Equal(map[string]interface{}{event.DataKey.CredentialTypes: "password"}, recorded.Details)
+}
+
+func (suite *AuditServiceTestSuite) TestRecordEvent_TokenIssuanceFailed() {
+	evt := event.NewEvent("trace-1", string(event.EventTypeTokenIssuanceFailed), event.ComponentAuthHandler).
+		WithStatus(event.StatusFailure).
+		WithData(event.DataKey.ClientID, "client-1").
+		WithData(event.DataKey.GrantType, "client_credentials")
+
+	var recorded AuditEvent
+	suite.mockStore.On("CreateAuditEvent", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		recorded = args.Get(1).(AuditEvent)
+	}).Return(nil).Once()
+
+	suite.NoError(suite.service.RecordEvent(context.Background(), evt))
+
+	suite.Equal(event.StatusFailure, recorded.Outcome)
+	suite.Empty(recorded.ActorID)
+	suite.Equal(TargetTypeApplication, recorded.TargetType)
+	suite.Equal("client-1", recorded.TargetID)
+	suite.Equal("client_credentials", recorded.Details[event.DataKey.GrantType])
+}
+
+func (suite *AuditServiceTestSuite) TestRecordEvent_IgnoresEventsNotAudited() {
+	for _, eventType := range []event.EventType{event.EventTypeTokenIssuanceStarted, event.EventTypeFlowStarted,
+		event.EventTypeQuotaThresholdReached} {
+		suite.NoError(suite.service.RecordEvent(context.Background(),
+			event.NewEvent("trace-1", string(eventType), event.ComponentAuthHandler)))
+	}
+	suite.NoError(suite.service.RecordEvent(context.Background(), nil))
+
+	suite.mockStore.AssertNotCalled(suite.T(), "CreateAuditEvent", mock.Anything, mock.Anything)
+}
+
+func (suite *AuditServiceTestSuite) TestRecordEvent_StoreError() {
+	suite.mockStore.On("CreateAuditEvent", mock.Anything, mock.Anything).Return(errors.New("db error")).Once()
+
+	err := suite.service.RecordEvent(context.Background(),
+		event.NewEvent("trace-1", string(event.EventTypeUserDeleted), event.ComponentUserManagement))
+
+	suite.Error(err)
+}
+
+func (suite *AuditServiceTestSuite) TestDeleteExpiredAuditEvents() {
+	suite.mockStore.On("DeleteAuditEventsBefore", mock.Anything, suite.now.Add(-24*time.Hour)).
+		Return(4, nil).Once()
+
+	deleted, err := suite.service.DeleteExpiredAuditEvents(context.Background())
+
+	suite.NoError(err)
+	suite.Equal(4, deleted)
+}
+
+func (suite *AuditServiceTestSuite) TestDeleteExpiredAuditEvents_NoRetention() {
+	suite.service.retention = 0
+
+	deleted, err := suite.service.DeleteExpiredAuditEvents(context.Background())
+
+	suite.NoError(err)
+	suite.Zero(deleted)
+	suite.mockStore.AssertNotCalled(suite.T(), "DeleteAuditEventsBefore", mock.Anything, mock.Anything)
+}
+
+func (suite *AuditServiceTestSuite) TestSubscriber_RecordsEventInItsTenant() {
+	mockService := NewAuditServiceInterfaceMock(suite.T())
+	evt := event.NewEvent("trace-1", string(event.EventTypeUserCreated), event.ComponentUserManagement).
+		WithTenantID("tenant-1")
+	mockService.On("RecordEvent", mock.MatchedBy(func(ctx context.Context) bool {
+		return sysContext.GetTenantID(ctx) == "tenant-1"
+	}), evt).Return(nil).Once()
+
+	suite.NoError(newAuditSubscriber(mockService).OnEvent(evt))
+}
+
+func (suite *AuditServiceTestSuite) TestScheduledCleanup_DeletesInTheContextPartition() {
+	mockService := NewAuditServiceInterfaceMock(suite.T())
+	mockService.On("DeleteExpiredAuditEvents", mock.MatchedBy(func(ctx context.Context) bool {
+		return sysContext.GetTenantID(ctx) == "tenant-1"
+	})).Return(2, nil).Once()
+
+	err := newScheduledCleanup(mockService)(sysContext.WithTenantID(context.Background(), "tenant-1"))
+
+	suite.NoError(err)
+}
@@ -0,0 +1,200 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+	sysContext "github.com/thunder-id/thunderid/internal/system/context"
+	"github.com/thunder-id/thunderid/internal/system/database/provider"
+	dbutils "github.com/thunder-id/thunderid/internal/system/database/utils"
+)
+
+var getDBProvider = provider.GetDBProvider
+
+// auditStoreInterface defines the interface for audit store operations.
+type auditStoreInterface interface {
+	CreateAuditEvent(ctx context.Context, auditEvent AuditEvent) error
+	ListAuditEvents(ctx context.Context, filter AuditEventFilter, limit, offset int) ([]AuditEvent, error)
+	CountAuditEvents(ctx context.Context, filter AuditEventFilter) (int, error)
+	DeleteAuditEventsBefore(ctx context.Context, before time.Time) (int, error)
+}
+
+// auditStore is the default implementation of auditStoreInterface.
+type auditStore struct {
+	dbProvider   provider.DBProviderInterface
+	deploymentID string
+}
+
+// newAuditStore creates a new instance of auditStore.
+func newAuditStore() auditStoreInterface {
+	return &auditStore{
+		dbProvider:   getDBProvider(),
+		deploymentID: config.GetServerRuntime().Config.Server.Identifier,
+	}
+}
+
+// CreateAuditEvent persists a new audit event.
+func (s *auditStore) CreateAuditEvent(ctx context.Context, auditEvent AuditEvent) error {
+	dbClient, err := s.dbProvider.GetConfigDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	var details interface{}
+	if len(auditEvent.Details) > 0 {
+		detailsJSON, err := json.Marshal(auditEvent.Details)
+		if err != nil {
+			return fmt.Errorf("failed to marshal audit event details: %w", err)
+		}
+		details = string(detailsJSON)
+	}
+
+	if _, err := dbClient.ExecuteContext(ctx, queryCreateAuditEvent, auditEvent.ID, auditEvent.EventType,
+		auditEvent.Component, auditEvent.Outcome, toNullableString(auditEvent.ActorID),
+		toNullableString(auditEvent.TargetType), toNullableString(auditEvent.TargetID),
+		toNullableString(auditEvent.OUID), toNullableString(auditEvent.TraceID), details, auditEvent.Timestamp,
+		sysContext.ScopeDeploymentID(ctx, s.deploymentID)); err != nil {
+		return fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	return nil
+}
+
+// ListAuditEvents retrieves a page of the audit events matching the filter, latest first.
+func (s *auditStore) ListAuditEvents(ctx context.Context, filter AuditEventFilter,
+	limit, offset int) ([]AuditEvent, error) {
+	dbClient, err := s.dbProvider.GetConfigDBClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	query, args := buildListAuditEventsQuery(filter, limit, offset,
+		sysContext.ScopeDeploymentID(ctx, s.deploymentID))
+	results, err := dbClient.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	auditEvents := make([]AuditEvent, 0, len(results))
+	for _, row := range results {
+		auditEvent, err := buildAuditEventFromResultRow(row)
+		if err != nil {
+			return nil, fmt.Errorf("failed to build audit event from result row: %w", err)
+		}
+		auditEvents = append(auditEvents, *auditEvent)
+	}
+
+	return auditEvents, nil
+}
+
+// CountAuditEvents counts the audit events matching the filter.
+func (s *auditStore) CountAuditEvents(ctx context.Context, filter AuditEventFilter) (int, error) {
+	dbClient, err := s.dbProvider.GetConfigDBClient()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	query, args := buildCountAuditEventsQuery(filter, sysContext.ScopeDeploymentID(ctx, s.deploymentID))
+	results, err := dbClient.QueryContext(ctx, query, args...)
+	if err != nil {
+		return 0, fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	return parseCount(results), nil
+}
+
+// DeleteAuditEventsBefore deletes the audit events that occurred before the given time and returns the
+// number of audit events deleted.
+func (s *auditStore) DeleteAuditEventsBefore(ctx context.Context, before time.Time) (int, error) {
+	dbClient, err := s.dbProvider.GetConfigDBClient()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	deleted, err := dbClient.ExecuteContext(ctx, queryDeleteAuditEventsBefore, before,
+		sysContext.ScopeDeploymentID(ctx, s.deploymentID))
+	if err != nil {
+		return 0, fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	return int(deleted), nil
+}
+
+// buildAuditEventFromResultRow constructs an AuditEvent from a database result row.
+func buildAuditEventFromResultRow(row map[string]interface{}) (*AuditEvent, error) {
+	id, ok := row["id"].(string)
+	if !ok {
+		return nil, fmt.Errorf("failed to parse id as string")
+	}
+	eventType, ok := row["event_type"].(string)
+	if !ok {
+		return nil, fmt.Errorf("failed to parse event_type as string")
+	}
+	timestamp, err := dbutils.ParseTimeField(row["created_at"], "created_at")
+	if err != nil {
+		return nil, err
+	}
+
+	auditEvent := &AuditEvent{
+		ID:        id,
+		EventType: eventType,
+		Timestamp: timestamp,
+	}
+	auditEvent.Component, _ = row["component"].(string)
+	auditEvent.Outcome, _ = row["outcome"].(string)
+	auditEvent.ActorID, _ = row["actor_id"].(string)
+	auditEvent.TargetType, _ = row["target_type"].(string)
+	auditEvent.TargetID, _ = row["target_id"].(string)
+	auditEvent.OUID, _ = row["ou_id"].(string)
+	auditEvent.TraceID, _ = row["trace_id"].(string)
+
+	if details := dbutils.ParseStringOrBytes(row["details"]); details != "" {
+		if err := json.Unmarshal([]byte(details), &auditEvent.Details); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal audit event details: %w", err)
+		}
+	}
+
+	return auditEvent, nil
+}
+
+// parseCount returns the total of a COUNT query result.
+func parseCount(results []map[string]interface{}) int {
+	if len(results) > 0 {
+		switch total := results[0]["total"].(type) {
+		case int64:
+			return int(total)
+		case float64:
+			return int(total)
+		}
+	}
+	return 0
+}
+
+// toNullableString maps an empty string to a SQL NULL.
+func toNullableString(value string) interface{} {
+	if value == "" {
+		return nil
+	}
+	return value
+}
@@ -0,0 +1,99 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package audit
+
+import (
+	"fmt"
+	"strings"
+
+	dbmodel "github.com/thunder-id/thunderid/internal/system/database/model"
+)
+
+const auditEventColumns = `ID, EVENT_TYPE, COMPONENT, OUTCOME, ACTOR_ID, TARGET_TYPE, TARGET_ID, OU_ID, TRACE_ID, ` +
+	`DETAILS, CREATED_AT`
+
+var (
+	// queryCreateAuditEvent is the query to create a new audit event.
+	queryCreateAuditEvent = dbmodel.DBQuery{
+		ID: "AUD-AUDIT_MGT-01",
+		Query: `INSERT INTO "AUDIT_EVENT" (` + auditEventColumns + `, DEPLOYMENT_ID) ` +
+			`VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)`,
+	}
+	// queryDeleteAuditEventsBefore is the query to delete the audit events that occurred before a time.
+	queryDeleteAuditEventsBefore = dbmodel.DBQuery{
+		ID:    "AUD-AUDIT_MGT-02",
+		Query: `DELETE FROM "AUDIT_EVENT" WHERE CREATED_AT < $1 AND DEPLOYMENT_ID = $2`,
+	}
+)
+
+// buildAuditEventFilterClause returns the WHERE clause and args restricting the audit events to the filter
+// and the deployment. Empty filter fields are not part of the clause.
+func buildAuditEventFilterClause(filter AuditEventFilter, deploymentID string) (string, []interface{}) {
+	conditions := make([]string, 0, 9)
+	args := make([]interface{}, 0, 9)
+
+	addCondition := func(column string, value string) {
+		if value != "" {
+			args = append(args, value)
+			conditions = append(conditions, fmt.Sprintf("%s = $%d", column, len(args)))
+		}
+	}
+	addCondition("EVENT_TYPE", filter.EventType)
+	addCondition("ACTOR_ID", filter.ActorID)
+	addCondition("TARGET_TYPE", filter.TargetType)
+	addCondition("TARGET_ID", filter.TargetID)
+	addCondition("OU_ID", filter.OUID)
+	addCondition("OUTCOME", filter.Outcome)
+	if filter.From != nil {
+		args = append(args, *filter.From)
+		conditions = append(conditions, fmt.Sprintf("CREATED_AT >= $%d", len(args)))
+	}
+	if filter.To != nil {
+		args = append(args, *filter.To)
+		conditions = append(conditions, fmt.Sprintf("CREATED_AT < $%d", len(args)))
+	}
+	args = append(args, deploymentID)
+	conditions = append(conditions, fmt.Sprintf("DEPLOYMENT_ID = $%d", len(args)))
+
+	return "WHERE " + strings.Join(conditions, " AND "), args
+}
+
+// buildListAuditEventsQuery returns the query and args to retrieve a page of the audit events matching the
+// filter, latest first.
+func buildListAuditEventsQuery(filter AuditEventFilter, limit, offset int,
+	deploymentID string) (dbmodel.DBQuery, []interface{}) {
+	whereClause, args := buildAuditEventFilterClause(filter, deploymentID)
+	args = append(args, limit, offset)
+
+	return dbmodel.DBQuery{
+		ID: "AUD-AUDIT_MGT-03",
+		Query: fmt.Sprintf(`SELECT `+auditEventColumns+` FROM "AUDIT_EVENT" %s `+
+			`ORDER BY CREATED_AT DESC, ID DESC LIMIT $%d OFFSET $%d`, whereClause, len(args)-1, len(args)),
+	}, args
+}
+
+// buildCountAuditEventsQuery returns the query and args to count the audit events matching the filter.
+func buildCountAuditEventsQuery(filter AuditEventFilter, deploymentID string) (dbmodel.DBQuery, []interface{}) {
+	whereClause, args := buildAuditEventFilterClause(filter, deploymentID)
+
+	return dbmodel.DBQuery{
+		ID:    "AUD-AUDIT_MGT-04",
+		Query: `SELECT COUNT(*) AS total FROM "AUDIT_EVENT" ` + whereClause,
+	}, args
+}
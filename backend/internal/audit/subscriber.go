@@ -0,0 +1,80 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package audit
+
+import (
+	"context"
+
+	sysContext "github.com/thunder-id/thunderid/internal/system/context"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/internal/system/observability/event"
+	"github.com/thunder-id/thunderid/internal/system/observability/subscriber"
+)
+
+// auditSubscriber records the audit events published on the event bus in the audit log.
+type auditSubscriber struct {
+	service AuditServiceInterface
+	logger  *log.Logger
+}
+
+var _ subscriber.SubscriberInterface = (*auditSubscriber)(nil)
+
+// newAuditSubscriber creates a new instance of auditSubscriber.
+func newAuditSubscriber(service AuditServiceInterface) *auditSubscriber {
+	return &auditSubscriber{
+		service: service,
+		logger:  log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName)),
+	}
+}
+
+// GetID returns the unique identifier of the subscriber.
+func (s *auditSubscriber) GetID() string {
+	return subscriberID
+}
+
+// GetCategories returns the audit category and the authentication category, which carries the outcome of
+// token issuance.
+func (s *auditSubscriber) GetCategories() []event.EventCategory {
+	return []event.EventCategory{event.CategoryAudit, event.CategoryAuthentication}
+}
+
+// OnEvent records an event in the audit log of the tenant the event occurred in.
+func (s *auditSubscriber) OnEvent(evt *event.Event) error {
+	ctx := sysContext.WithTenantID(context.Background(), evt.TenantID)
+	if err := s.service.RecordEvent(ctx, evt); err != nil {
+		s.logger.Error("Failed to record audit event", log.String("eventType", evt.Type), log.Error(err))
+		return err
+	}
+	return nil
+}
+
+// Close releases no resources.
+func (s *auditSubscriber) Close() error {
+	return nil
+}
+
+// IsEnabled reports true, since the subscriber is only created when the audit log is enabled.
+func (s *auditSubscriber) IsEnabled() bool {
+	return true
+}
+
+// Initialize needs no setup.
+func (s *auditSubscriber) Initialize() error {
+	return nil
+}
@@ -323,7 +323,7 @@ func (suite *GoogleOIDCAuthnServiceTestSuite) TestValidateIDTokenSuccess() {
 			setupMocks: func(idToken string, config *oauth.OAuthClientConfig) {
 				suite.mockOIDCService.On("GetOAuthClientConfig", mock.Anything, testGoogleIDPID).
 					Return(config, nil).Once()
-				suite.mockJWTService.On("VerifyJWTSignatureWithJWKS", idToken, config.OAuthEndpoints.JwksEndpoint).
+				suite.mockJWTService.On("VerifyJWTSignatureWithJWKS", mock.Anything, idToken, config.OAuthEndpoints.JwksEndpoint).
 					Return(nil).Once()
 			},
 		},
@@ -350,7 +350,7 @@ func (suite *GoogleOIDCAuthnServiceTestSuite) TestValidateIDTokenSuccess() {
 			setupMocks: func(idToken string, config *oauth.OAuthClientConfig) {
 				suite.mockOIDCService.On("GetOAuthClientConfig", mock.Anything, testGoogleIDPID).
 					Return(config, nil).Once()
-				suite.mockJWTService.On("VerifyJWTSignatureWithJWKS", idToken, config.OAuthEndpoints.JwksEndpoint).
+				suite.mockJWTService.On("VerifyJWTSignatureWithJWKS", mock.Anything, idToken, config.OAuthEndpoints.JwksEndpoint).
 					Return(nil).Once()
 			},
 		},
@@ -424,7 +424,7 @@ func (suite *GoogleOIDCAuthnServiceTestSuite) TestValidateIDTokenWithFailure() {
 	hostedDomainSetupMocks := func(idToken string, config *oauth.OAuthClientConfig) {
 		suite.mockOIDCService.On("GetOAuthClientConfig", mock.Anything, testGoogleIDPID).
 			Return(config, nil).Once()
-		suite.mockJWTService.On("VerifyJWTSignatureWithJWKS", idToken, config.OAuthEndpoints.JwksEndpoint).
+		suite.mockJWTService.On("VerifyJWTSignatureWithJWKS", mock.Anything, idToken, config.OAuthEndpoints.JwksEndpoint).
 			Return(nil).Once()
 	}
 
@@ -482,7 +482,7 @@ func (suite *GoogleOIDCAuthnServiceTestSuite) TestValidateIDTokenWithFailure() {
 			setupMocks: func(idToken string, config *oauth.OAuthClientConfig) {
 				suite.mockOIDCService.On("GetOAuthClientConfig", mock.Anything, testGoogleIDPID).
 					Return(config, nil).Once()
-				suite.mockJWTService.On("VerifyJWTSignatureWithJWKS", idToken, config.OAuthEndpoints.JwksEndpoint).
+				suite.mockJWTService.On("VerifyJWTSignatureWithJWKS", mock.Anything, idToken, config.OAuthEndpoints.JwksEndpoint).
 					Return(&serviceerror.ServiceError{
 						Type: serviceerror.ServerErrorType,
 						Code: "SIGNATURE_VERIFICATION_FAILED",
@@ -34,6 +34,7 @@ import (
 	"github.com/thunder-id/thunderid/internal/authn/passkey"
 	"github.com/thunder-id/thunderid/internal/authn/reactsdk"
 	authnprovidermgr "github.com/thunder-id/thunderid/internal/authnprovider/manager"
+	"github.com/thunder-id/thunderid/internal/enumeration"
 	"github.com/thunder-id/thunderid/internal/idp"
 	"github.com/thunder-id/thunderid/internal/system/jose/jwt"
 	"github.com/thunder-id/thunderid/internal/system/middleware"
@@ -54,6 +55,7 @@ func Initialize(
 	oidcSvc oidc.OIDCAuthnServiceInterface,
 	googleSvc google.GoogleOIDCAuthnServiceInterface,
 	githubSvc github.GithubOAuthAuthnServiceInterface,
+	enumerationSvc enumeration.EnumerationResistanceServiceInterface,
 ) AuthenticationServiceInterface {
 	common.RegisterAuthenticator(common.AuthenticatorMeta{
 		Name:    common.AuthenticatorCredentials,
@@ -104,7 +106,17 @@ func Initialize(
 		googleSvc,
 		githubSvc,
 		passkeySvc,
+		enumerationSvc,
 	)
+	if enumerationSvc != nil {
+		enumerationSvc.RegisterNormalizedEndpoint(enumeration.NormalizedEndpoint{
+			Method:      http.MethodPost,
+			Path:        "/auth/credentials/authenticate",
+			Description: "Credentials authentication returns 401 for unknown users and wrong credentials alike",
+			Normalizations: []enumeration.Normalization{enumeration.NormalizationResponse,
+				enumeration.NormalizationDummyHash, enumeration.NormalizationTiming},
+		})
+	}
 
 	authnHandler := newAuthenticationHandler(authnService)
 	registerRoutes(mux, authnHandler)
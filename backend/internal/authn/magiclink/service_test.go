@@ -30,10 +30,12 @@ import (
 	"github.com/stretchr/testify/suite"
 
 	"github.com/thunder-id/thunderid/internal/authn/common"
+	"github.com/thunder-id/thunderid/internal/entity"
 	"github.com/thunder-id/thunderid/internal/entityprovider"
 	"github.com/thunder-id/thunderid/internal/system/config"
 	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
 	"github.com/thunder-id/thunderid/internal/system/jose/jwt"
+	"github.com/thunder-id/thunderid/tests/mocks/entitymock"
 	"github.com/thunder-id/thunderid/tests/mocks/entityprovidermock"
 	"github.com/thunder-id/thunderid/tests/mocks/jose/jwtmock"
 )
@@ -283,6 +285,33 @@ func (suite *MagicLinkServiceTestSuite) TestVerifyMagicLinkUserNotFoundOnVerify(
 	suite.Equal(common.ErrorUserNotFound.Code, err.Code)
 }
 
+func (suite *MagicLinkServiceTestSuite) TestVerifyMagicLinkSoftDeletedUser() {
+	const workEmailAttr = "workemail"
+	testWorkEmailJWT := createMagicLinkJWTWithSubject("john@company.lk")
+	suite.mockJWTService.On("VerifyJWT", mock.Anything, tokenAudience, mock.Anything).Return(nil)
+	// The entity service still returns the deleted user, so that the check of the entity provider is covered.
+	entityService := entitymock.NewEntityServiceInterfaceMock(suite.T())
+	entityService.On("IdentifyEntity", mock.Anything, map[string]interface{}{workEmailAttr: "john@company.lk"}).
+		Return(&testUserID, nil).Once()
+	entityService.On("GetEntity", mock.Anything, testUserID).Return(&entity.Entity{
+		ID:       testUserID,
+		Category: entity.EntityCategoryUser,
+		State:    entity.EntityStateDeleted,
+		OUID:     testUserOUID,
+	}, nil).Twice()
+	service := newMagicLinkAuthnService(suite.mockJWTService, entityprovider.InitializeEntityProvider(entityService))
+
+	result, err := service.VerifyMagicLink(context.Background(), testValidJWT, "")
+	suite.Nil(result)
+	suite.NotNil(err)
+	suite.Equal(common.ErrorUserNotFound.Code, err.Code)
+
+	result, err = service.VerifyMagicLink(context.Background(), testWorkEmailJWT, workEmailAttr)
+	suite.Nil(result)
+	suite.NotNil(err)
+	suite.Equal(common.ErrorUserNotFound.Code, err.Code)
+}
+
 func (suite *MagicLinkServiceTestSuite) TestVerifyMagicLinkGetUserError() {
 	suite.mockJWTService.On("VerifyJWT", testValidJWT, tokenAudience, mock.Anything).Return(nil)
 	suite.mockUserService.On("GetEntity", testUserID).Return(nil, &entityprovider.EntityProviderError{
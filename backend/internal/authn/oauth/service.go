@@ -172,7 +172,7 @@ func (s *oAuthAuthnService) ExchangeCodeForToken(ctx context.Context, idpID, cod
 		return nil, &serviceerror.InternalServerError
 	}
 
-	httpReq, svcErr := buildTokenRequest(oAuthClientConfig, code, logger)
+	httpReq, svcErr := buildTokenRequest(ctx, oAuthClientConfig, code, logger)
 	if svcErr != nil {
 		return nil, svcErr
 	}
@@ -19,6 +19,7 @@
 package oauth
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -90,7 +91,7 @@ func parseIDPConfig(idp *idpPkg.IDPDTO) (*OAuthClientConfig, error) {
 }
 
 // buildTokenRequest constructs the HTTP request to exchange the authorization code for tokens.
-func buildTokenRequest(oAuthClientConfig *OAuthClientConfig, code string, logger *log.Logger) (
+func buildTokenRequest(ctx context.Context, oAuthClientConfig *OAuthClientConfig, code string, logger *log.Logger) (
 	*http.Request, *serviceerror.ServiceError) {
 	form := url.Values{}
 	form.Set(oauth2const.RequestParamClientID, oAuthClientConfig.ClientID)
@@ -99,7 +100,7 @@ func buildTokenRequest(oAuthClientConfig *OAuthClientConfig, code string, logger
 	form.Set(oauth2const.RequestParamGrantType, string(oauth2const.GrantTypeAuthorizationCode))
 	form.Set(oauth2const.RequestParamCode, code)
 
-	httpReq, err := http.NewRequest(http.MethodPost, oAuthClientConfig.OAuthEndpoints.TokenEndpoint,
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, oAuthClientConfig.OAuthEndpoints.TokenEndpoint,
 		strings.NewReader(form.Encode()))
 	if err != nil {
 		logger.Error("Failed to create token request", log.Error(err))
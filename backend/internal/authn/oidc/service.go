@@ -148,7 +148,7 @@ func (s *oidcAuthnService) ValidateIDToken(ctx context.Context, idpID, idToken s
 
 	// Validate ID token signature using JWKS endpoint if available
 	if oAuthClientConfig.OAuthEndpoints.JwksEndpoint != "" {
-		err := s.jwtService.VerifyJWTWithJWKS(idToken, oAuthClientConfig.OAuthEndpoints.JwksEndpoint, "", "")
+		err := s.jwtService.VerifyJWTWithJWKS(ctx, idToken, oAuthClientConfig.OAuthEndpoints.JwksEndpoint, "", "")
 		if err != nil {
 			logger.Debug("ID token signature validation failed", log.String("error", err.Error.DefaultValue))
 			return &ErrorInvalidIDTokenSignature
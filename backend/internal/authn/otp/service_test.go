@@ -28,9 +28,12 @@ import (
 	"github.com/stretchr/testify/suite"
 
 	"github.com/thunder-id/thunderid/internal/authn/common"
+	"github.com/thunder-id/thunderid/internal/entity"
 	"github.com/thunder-id/thunderid/internal/entityprovider"
 	notifcommon "github.com/thunder-id/thunderid/internal/notification/common"
+	"github.com/thunder-id/thunderid/internal/system/config"
 	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/tests/mocks/entitymock"
 	"github.com/thunder-id/thunderid/tests/mocks/entityprovidermock"
 	"github.com/thunder-id/thunderid/tests/mocks/notification/notificationmock"
 )
@@ -197,6 +200,34 @@ func (suite *OTPAuthnServiceTestSuite) TestAuthenticateSuccess() {
 	suite.Equal(orgUnit, result.OUID)
 }
 
+func (suite *OTPAuthnServiceTestSuite) TestAuthenticateSoftDeletedUser() {
+	recipient := "+1234567890"
+	userID := "user123"
+	config.ResetServerRuntime()
+	suite.Require().NoError(config.InitializeServerRuntime(suite.T().TempDir(), &config.Config{}))
+	defer config.ResetServerRuntime()
+
+	suite.mockOTPService.On("VerifyOTP", mock.Anything, mock.Anything).Return(&notifcommon.VerifyOTPResultDTO{
+		Status:    notifcommon.OTPVerifyStatusVerified,
+		Recipient: recipient,
+	}, nil)
+	// The entity service still identifies the deleted user, so that the check of the entity provider is covered.
+	entityService := entitymock.NewEntityServiceInterfaceMock(suite.T())
+	entityService.On("IdentifyEntity", mock.Anything, map[string]interface{}{"mobileNumber": recipient}).
+		Return(&userID, nil).Once()
+	entityService.On("GetEntity", mock.Anything, userID).Return(&entity.Entity{
+		ID:       userID,
+		Category: entity.EntityCategoryUser,
+		State:    entity.EntityStateDeleted,
+	}, nil).Once()
+	service := newOTPAuthnService(suite.mockOTPService, entityprovider.InitializeEntityProvider(entityService))
+
+	result, err := service.Authenticate(context.Background(), testSessionToken, "123456")
+	suite.Nil(result)
+	suite.NotNil(err)
+	suite.Equal(common.ErrorUserNotFound.Code, err.Code)
+}
+
 func (suite *OTPAuthnServiceTestSuite) TestAuthenticateWithInvalidInputs() {
 	tests := []struct {
 		name         string
@@ -38,6 +38,7 @@ import (
 	"github.com/thunder-id/thunderid/internal/authn/passkey"
 	authnprovidermgr "github.com/thunder-id/thunderid/internal/authnprovider/manager"
 	"github.com/thunder-id/thunderid/internal/entityprovider"
+	"github.com/thunder-id/thunderid/internal/enumeration"
 	"github.com/thunder-id/thunderid/internal/idp"
 	notifcommon "github.com/thunder-id/thunderid/internal/notification/common"
 	"github.com/thunder-id/thunderid/internal/system/config"
@@ -96,6 +97,7 @@ type authenticationService struct {
 	googleService          google.GoogleOIDCAuthnServiceInterface
 	githubService          github.GithubOAuthAuthnServiceInterface
 	passkeyService         passkey.PasskeyServiceInterface
+	enumerationService     enumeration.EnumerationResistanceServiceInterface
 }
 
 // newAuthenticationService creates a new instance of AuthenticationService.
@@ -111,6 +113,7 @@ func newAuthenticationService(
 	googleAuthnSvc google.GoogleOIDCAuthnServiceInterface,
 	githubAuthnSvc github.GithubOAuthAuthnServiceInterface,
 	passkeySvc passkey.PasskeyServiceInterface,
+	enumerationSvc enumeration.EnumerationResistanceServiceInterface,
 ) AuthenticationServiceInterface {
 	return &authenticationService{
 		idpService:             idpSvc,
@@ -124,6 +127,7 @@ func newAuthenticationService(
 		googleService:          googleAuthnSvc,
 		githubService:          githubAuthnSvc,
 		passkeyService:         passkeySvc,
+		enumerationService:     enumerationSvc,
 	}
 }
 
@@ -138,10 +142,15 @@ func (as *authenticationService) AuthenticateWithCredentials(ctx context.Context
 		return nil, &ErrorEmptyAttributesOrCredentials
 	}
 
+	started := time.Now()
 	newAuthUser, basicResult, svcErr := as.authnProvider.AuthenticateUser(ctx, identifiers, credentials, nil, nil,
 		authnprovidermgr.AuthUser{})
 	if svcErr != nil {
-		return nil, as.mapCredentialsAuthnError(svcErr, logger)
+		authnErr := as.mapCredentialsAuthnError(svcErr, logger)
+		if authnErr.Type == serviceerror.ClientErrorType && as.enumerationService != nil {
+			as.enumerationService.PadFailure(ctx, started)
+		}
+		return nil, authnErr
 	}
 
 	if basicResult == nil {
@@ -542,6 +551,9 @@ func (as *authenticationService) mapCredentialsAuthnError(svcErr *serviceerror.S
 	case authnprovidermgr.ErrorAuthenticationFailed.Code:
 		return &ErrorInvalidCredentials
 	case authnprovidermgr.ErrorUserNotFound.Code:
+		if as.enumerationService != nil && as.enumerationService.IsEnabled() {
+			return &ErrorInvalidCredentials
+		}
 		return &common.ErrorUserNotFound
 	case authnprovidermgr.ErrorInvalidRequest.Code:
 		return &ErrorEmptyAttributesOrCredentials
@@ -48,6 +48,7 @@ import (
 	"github.com/thunder-id/thunderid/tests/mocks/authn/otpmock"
 	"github.com/thunder-id/thunderid/tests/mocks/authn/passkeymock"
 	"github.com/thunder-id/thunderid/tests/mocks/authnprovider/managermock"
+	"github.com/thunder-id/thunderid/tests/mocks/enumerationmock"
 	"github.com/thunder-id/thunderid/tests/mocks/idp/idpmock"
 	"github.com/thunder-id/thunderid/tests/mocks/jose/jwtmock"
 )
@@ -294,6 +295,52 @@ func (suite *AuthenticationServiceTestSuite) TestAuthenticateWithCredentialsServ
 	suite.Equal(ErrorInvalidCredentials.Code, err.Code)
 }
 
+func (suite *AuthenticationServiceTestSuite) TestAuthenticateWithCredentialsUserNotFound() {
+	identifiers := map[string]interface{}{
+		"username": "unknown",
+	}
+	authnCredentials := map[string]interface{}{
+		"password": "testpass",
+	}
+
+	suite.mockAuthnProvider.On("AuthenticateUser", mock.Anything, identifiers,
+		authnCredentials, mock.Anything, mock.Anything, mock.Anything).Return(
+		authnprovidermgr.AuthUser{}, (*authnprovidermgr.AuthnBasicResult)(nil),
+		&authnprovidermgr.ErrorUserNotFound)
+
+	result, err := suite.service.AuthenticateWithCredentials(context.Background(), identifiers,
+		authnCredentials, false, "")
+
+	suite.Nil(result)
+	suite.NotNil(err)
+	suite.Equal(common.ErrorUserNotFound.Code, err.Code)
+}
+
+func (suite *AuthenticationServiceTestSuite) TestAuthenticateWithCredentialsEnumerationResistance() {
+	identifiers := map[string]interface{}{
+		"username": "unknown",
+	}
+	authnCredentials := map[string]interface{}{
+		"password": "testpass",
+	}
+	enumerationService := enumerationmock.NewEnumerationResistanceServiceInterfaceMock(suite.T())
+	enumerationService.On("IsEnabled").Return(true)
+	enumerationService.On("PadFailure", mock.Anything, mock.AnythingOfType("time.Time")).Once()
+	suite.service.enumerationService = enumerationService
+
+	suite.mockAuthnProvider.On("AuthenticateUser", mock.Anything, identifiers,
+		authnCredentials, mock.Anything, mock.Anything, mock.Anything).Return(
+		authnprovidermgr.AuthUser{}, (*authnprovidermgr.AuthnBasicResult)(nil),
+		&authnprovidermgr.ErrorUserNotFound)
+
+	result, err := suite.service.AuthenticateWithCredentials(context.Background(), identifiers,
+		authnCredentials, false, "")
+
+	suite.Nil(result)
+	suite.NotNil(err)
+	suite.Equal(ErrorInvalidCredentials.Code, err.Code)
+}
+
 func (suite *AuthenticationServiceTestSuite) TestAuthenticateWithCredentialsJWTGenerationError() {
 	identifiers := map[string]interface{}{
 		"username": "testuser",
@@ -29,3 +29,18 @@ type GetAuthorizedPermissionsRequest struct {
 type GetAuthorizedPermissionsResponse struct {
 	AuthorizedPermissions []string `json:"authorizedPermissions"`
 }
+
+// GetGrantableScopesRequest represents the request for resolving the scopes granted under an allowed-scope policy.
+type GetGrantableScopesRequest struct {
+	EntityID        string   `json:"entityId,omitempty"`
+	GroupIDs        []string `json:"groupIds,omitempty"`
+	RequestedScopes []string `json:"requestedScopes,omitempty"`
+	AllowedScopes   []string `json:"allowedScopes"`
+}
+
+// GetGrantableScopesResponse represents the scopes granted under an allowed-scope policy. DisallowedScopes
+// lists the requested scopes outside the policy.
+type GetGrantableScopesResponse struct {
+	GrantedScopes    []string `json:"grantedScopes"`
+	DisallowedScopes []string `json:"disallowedScopes"`
+}
@@ -21,6 +21,7 @@ package authz
 
 import (
 	"context"
+	"slices"
 
 	"github.com/thunder-id/thunderid/internal/authz/engine"
 	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
@@ -38,6 +39,13 @@ type AuthorizationServiceInterface interface {
 		ctx context.Context,
 		request GetAuthorizedPermissionsRequest,
 	) (*GetAuthorizedPermissionsResponse, *serviceerror.ServiceError)
+	// GetGrantableScopes resolves the scopes an entity may be granted under an allowed-scope policy:
+	// the requested scopes the policy allows, or every policy scope when none are requested, that the
+	// entity (directly or through groups) is authorized for.
+	GetGrantableScopes(
+		ctx context.Context,
+		request GetGrantableScopesRequest,
+	) (*GetGrantableScopesResponse, *serviceerror.ServiceError)
 }
 
 // authorizationService is the default implementation of AuthorizationServiceInterface.
@@ -100,3 +108,36 @@ func (s *authorizationService) GetAuthorizedPermissions(
 		AuthorizedPermissions: authorizedPerms,
 	}, nil
 }
+
+// GetGrantableScopes resolves the scopes an entity may be granted under an allowed-scope policy.
+func (s *authorizationService) GetGrantableScopes(
+	ctx context.Context,
+	request GetGrantableScopesRequest,
+) (*GetGrantableScopesResponse, *serviceerror.ServiceError) {
+	candidates := request.AllowedScopes
+	disallowed := []string{}
+	if len(request.RequestedScopes) > 0 {
+		candidates = make([]string, 0, len(request.RequestedScopes))
+		for _, scope := range request.RequestedScopes {
+			if slices.Contains(request.AllowedScopes, scope) {
+				candidates = append(candidates, scope)
+			} else {
+				disallowed = append(disallowed, scope)
+			}
+		}
+	}
+
+	authzResp, svcErr := s.GetAuthorizedPermissions(ctx, GetAuthorizedPermissionsRequest{
+		EntityID:             request.EntityID,
+		GroupIDs:             request.GroupIDs,
+		RequestedPermissions: candidates,
+	})
+	if svcErr != nil {
+		return nil, svcErr
+	}
+
+	return &GetGrantableScopesResponse{
+		GrantedScopes:    authzResp.AuthorizedPermissions,
+		DisallowedScopes: disallowed,
+	}, nil
+}
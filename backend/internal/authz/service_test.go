@@ -242,3 +242,55 @@ func (suite *AuthorizationServiceTestSuite) TestGetAuthorizedPermissions_AllPerm
 	suite.NotNil(response)
 	suite.Equal(request.RequestedPermissions, response.AuthorizedPermissions)
 }
+
+func (suite *AuthorizationServiceTestSuite) TestGetGrantableScopes_RequestedScopesWithinPolicy() {
+	request := GetGrantableScopesRequest{
+		EntityID:        "app1",
+		RequestedScopes: []string{"orders:read", "orders:write", "admin"},
+		AllowedScopes:   []string{"orders:read", "orders:write"},
+	}
+
+	suite.mockEngine.On("GetAuthorizedPermissions", mock.Anything, "app1", []string{},
+		[]string{"orders:read", "orders:write"}).
+		Return([]string{"orders:read"}, nil)
+
+	response, err := suite.service.GetGrantableScopes(context.Background(), request)
+
+	suite.Nil(err)
+	suite.Equal([]string{"orders:read"}, response.GrantedScopes)
+	suite.Equal([]string{"admin"}, response.DisallowedScopes)
+}
+
+func (suite *AuthorizationServiceTestSuite) TestGetGrantableScopes_NoRequestedScopesGrantsPolicy() {
+	request := GetGrantableScopesRequest{
+		EntityID:      "app1",
+		GroupIDs:      []string{"group1"},
+		AllowedScopes: []string{"orders:read", "orders:write"},
+	}
+
+	suite.mockEngine.On("GetAuthorizedPermissions", mock.Anything, "app1", []string{"group1"},
+		[]string{"orders:read", "orders:write"}).
+		Return([]string{"orders:read", "orders:write"}, nil)
+
+	response, err := suite.service.GetGrantableScopes(context.Background(), request)
+
+	suite.Nil(err)
+	suite.Equal([]string{"orders:read", "orders:write"}, response.GrantedScopes)
+	suite.Empty(response.DisallowedScopes)
+}
+
+func (suite *AuthorizationServiceTestSuite) TestGetGrantableScopes_EngineError() {
+	request := GetGrantableScopesRequest{
+		EntityID:      "app1",
+		AllowedScopes: []string{"orders:read"},
+	}
+
+	suite.mockEngine.On("GetAuthorizedPermissions", mock.Anything, "app1", []string{}, []string{"orders:read"}).
+		Return(nil, errors.New("engine failure"))
+
+	response, err := suite.service.GetGrantableScopes(context.Background(), request)
+
+	suite.Nil(response)
+	suite.NotNil(err)
+	suite.Equal(serviceerror.InternalServerError.Code, err.Code)
+}
@@ -0,0 +1,39 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package breakglass
+
+import "time"
+
+const (
+	// loggerComponentName is the component name used in break-glass logs.
+	loggerComponentName = "BreakGlassService"
+
+	// defaultMaxActivationPeriod is the default maximum number of seconds an account stays active.
+	defaultMaxActivationPeriod = int64(4 * 60 * 60)
+	// defaultApprovalTimeout is the default number of seconds an activation request can be approved.
+	defaultApprovalTimeout = int64(60 * 60)
+
+	// maxDescriptionLength is the maximum length of the description of an account.
+	maxDescriptionLength = 500
+	// maxReasonLength is the maximum length of the reason of an activation request.
+	maxReasonLength = 500
+
+	// webhookTimeout bounds the time spent delivering a webhook alert.
+	webhookTimeout = 10 * time.Second
+)
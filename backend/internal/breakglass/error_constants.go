@@ -0,0 +1,189 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package breakglass
+
+import (
+	"errors"
+
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/i18n/core"
+)
+
+// ErrAccountNotFound is returned when the break-glass account is not found in the system.
+var ErrAccountNotFound = errors.New("break-glass account not found")
+
+// Client errors for break-glass account operations.
+var (
+	// ErrorAccountNotFound is the error returned when a break-glass account is not found.
+	ErrorAccountNotFound = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "BGA-1001",
+		Error: core.I18nMessage{
+			Key:          "error.breakglassservice.account_not_found",
+			DefaultValue: "Break-glass account not found",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.breakglassservice.account_not_found_description",
+			DefaultValue: "The requested break-glass account could not be found",
+		},
+	}
+	// ErrorInvalidRequestFormat is the error returned when the request body is malformed.
+	ErrorInvalidRequestFormat = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "BGA-1002",
+		Error: core.I18nMessage{
+			Key:          "error.breakglassservice.invalid_request_format",
+			DefaultValue: "Invalid request format",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.breakglassservice.invalid_request_format_description",
+			DefaultValue: "The request body is malformed or contains invalid data",
+		},
+	}
+	// ErrorMissingUserID is the error returned when the user of an account is not provided.
+	ErrorMissingUserID = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "BGA-1003",
+		Error: core.I18nMessage{
+			Key:          "error.breakglassservice.missing_user_id",
+			DefaultValue: "Missing user ID",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.breakglassservice.missing_user_id_description",
+			DefaultValue: "The userId of the break-glass account must be provided",
+		},
+	}
+	// ErrorUserNotFound is the error returned when the user of an account does not exist.
+	ErrorUserNotFound = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "BGA-1004",
+		Error: core.I18nMessage{
+			Key:          "error.breakglassservice.user_not_found",
+			DefaultValue: "User not found",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.breakglassservice.user_not_found_description",
+			DefaultValue: "The user of the break-glass account does not exist",
+		},
+	}
+	// ErrorAccountConflict is the error returned when the user is already a break-glass account.
+	ErrorAccountConflict = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "BGA-1005",
+		Error: core.I18nMessage{
+			Key:          "error.breakglassservice.account_conflict",
+			DefaultValue: "Break-glass account conflict",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.breakglassservice.account_conflict_description",
+			DefaultValue: "The user is already designated as a break-glass account",
+		},
+	}
+	// ErrorInvalidDescription is the error returned when the description of an account is too long.
+	ErrorInvalidDescription = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "BGA-1006",
+		Error: core.I18nMessage{
+			Key:          "error.breakglassservice.invalid_description",
+			DefaultValue: "Invalid description",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.breakglassservice.invalid_description_description",
+			DefaultValue: "The description must not exceed 500 characters",
+		},
+	}
+	// ErrorInvalidReason is the error returned when an activation request has no valid reason.
+	ErrorInvalidReason = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "BGA-1007",
+		Error: core.I18nMessage{
+			Key:          "error.breakglassservice.invalid_reason",
+			DefaultValue: "Invalid activation reason",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.breakglassservice.invalid_reason_description",
+			DefaultValue: "A reason of at most 500 characters must be provided for the activation",
+		},
+	}
+	// ErrorInvalidActivationPeriod is the error returned when the requested activation period is out of range.
+	ErrorInvalidActivationPeriod = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "BGA-1008",
+		Error: core.I18nMessage{
+			Key:          "error.breakglassservice.invalid_activation_period",
+			DefaultValue: "Invalid activation period",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.breakglassservice.invalid_activation_period_description",
+			DefaultValue: "The activation period must be positive and within the configured maximum",
+		},
+	}
+	// ErrorInvalidAccountState is the error returned when an operation conflicts with the state of an account.
+	ErrorInvalidAccountState = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "BGA-1009",
+		Error: core.I18nMessage{
+			Key:          "error.breakglassservice.invalid_account_state",
+			DefaultValue: "Invalid account state",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.breakglassservice.invalid_account_state_description",
+			DefaultValue: "The operation is not allowed in the current state of the break-glass account",
+		},
+	}
+	// ErrorSelfApprovalNotAllowed is the error returned when the requester or the account user approves an activation.
+	ErrorSelfApprovalNotAllowed = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "BGA-1010",
+		Error: core.I18nMessage{
+			Key:          "error.breakglassservice.self_approval_not_allowed",
+			DefaultValue: "Self approval not allowed",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.breakglassservice.self_approval_not_allowed_description",
+			DefaultValue: "The activation must be approved by someone other than the requester and the account user",
+		},
+	}
+	// ErrorAuthenticationFailed is the error returned when the caller cannot be identified.
+	ErrorAuthenticationFailed = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "BGA-1011",
+		Error: core.I18nMessage{
+			Key:          "error.breakglassservice.authentication_failed",
+			DefaultValue: "Authentication failed",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.breakglassservice.authentication_failed_description",
+			DefaultValue: "The caller could not be identified",
+		},
+	}
+	// ErrorAccountNotActive is the error returned when an inactive break-glass account attempts to sign in.
+	ErrorAccountNotActive = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "BGA-1012",
+		Error: core.I18nMessage{
+			Key:          "error.breakglassservice.account_not_active",
+			DefaultValue: "Break-glass account not active",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.breakglassservice.account_not_active_description",
+			DefaultValue: "The break-glass account has no approved and unexpired activation",
+		},
+	}
+)
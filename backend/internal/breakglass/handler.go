@@ -0,0 +1,141 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package breakglass
+
+import (
+	"net/http"
+
+	sysutils "github.com/thunder-id/thunderid/internal/system/utils"
+)
+
+// breakGlassHandler is the handler for break-glass account operations.
+type breakGlassHandler struct {
+	breakGlassService BreakGlassServiceInterface
+}
+
+// newBreakGlassHandler creates a new instance of breakGlassHandler.
+func newBreakGlassHandler(breakGlassService BreakGlassServiceInterface) *breakGlassHandler {
+	return &breakGlassHandler{
+		breakGlassService: breakGlassService,
+	}
+}
+
+// HandleAccountPostRequest handles the create break-glass account request.
+func (h *breakGlassHandler) HandleAccountPostRequest(w http.ResponseWriter, r *http.Request) {
+	request, err := sysutils.DecodeJSONBody[CreateAccountRequest](r)
+	if err != nil {
+		sysutils.WriteServiceErrorResponse(w, &ErrorInvalidRequestFormat, clientErrorStatusCodes)
+		return
+	}
+
+	created, svcErr := h.breakGlassService.CreateAccount(r.Context(), CreateAccountRequest{
+		UserID:      sysutils.SanitizeString(request.UserID),
+		Description: sysutils.SanitizeString(request.Description),
+	})
+	if svcErr != nil {
+		sysutils.WriteServiceErrorResponse(w, svcErr, clientErrorStatusCodes)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(w, http.StatusCreated, created)
+}
+
+// HandleAccountListRequest handles the list break-glass accounts request.
+func (h *breakGlassHandler) HandleAccountListRequest(w http.ResponseWriter, r *http.Request) {
+	accounts, svcErr := h.breakGlassService.GetAccountList(r.Context())
+	if svcErr != nil {
+		sysutils.WriteServiceErrorResponse(w, svcErr, clientErrorStatusCodes)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(w, http.StatusOK, accounts)
+}
+
+// HandleAccountGetRequest handles the get break-glass account request.
+func (h *breakGlassHandler) HandleAccountGetRequest(w http.ResponseWriter, r *http.Request) {
+	account, svcErr := h.breakGlassService.GetAccount(r.Context(), r.PathValue("id"))
+	if svcErr != nil {
+		sysutils.WriteServiceErrorResponse(w, svcErr, clientErrorStatusCodes)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(w, http.StatusOK, account)
+}
+
+// HandleAccountDeleteRequest handles the delete break-glass account request.
+func (h *breakGlassHandler) HandleAccountDeleteRequest(w http.ResponseWriter, r *http.Request) {
+	if svcErr := h.breakGlassService.DeleteAccount(r.Context(), r.PathValue("id")); svcErr != nil {
+		sysutils.WriteServiceErrorResponse(w, svcErr, clientErrorStatusCodes)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(w, http.StatusNoContent, nil)
+}
+
+// HandleActivationPostRequest handles the request to activate a break-glass account.
+func (h *breakGlassHandler) HandleActivationPostRequest(w http.ResponseWriter, r *http.Request) {
+	request, err := sysutils.DecodeJSONBody[ActivationRequest](r)
+	if err != nil {
+		sysutils.WriteServiceErrorResponse(w, &ErrorInvalidRequestFormat, clientErrorStatusCodes)
+		return
+	}
+
+	account, svcErr := h.breakGlassService.RequestActivation(r.Context(), r.PathValue("id"), ActivationRequest{
+		Reason: sysutils.SanitizeString(request.Reason),
+		Period: request.Period,
+	})
+	if svcErr != nil {
+		sysutils.WriteServiceErrorResponse(w, svcErr, clientErrorStatusCodes)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(w, http.StatusAccepted, account)
+}
+
+// HandleActivationApproveRequest handles the request to approve the activation of a break-glass account.
+func (h *breakGlassHandler) HandleActivationApproveRequest(w http.ResponseWriter, r *http.Request) {
+	account, svcErr := h.breakGlassService.ApproveActivation(r.Context(), r.PathValue("id"))
+	if svcErr != nil {
+		sysutils.WriteServiceErrorResponse(w, svcErr, clientErrorStatusCodes)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(w, http.StatusOK, account)
+}
+
+// HandleActivationDeleteRequest handles the request to deactivate a break-glass account.
+func (h *breakGlassHandler) HandleActivationDeleteRequest(w http.ResponseWriter, r *http.Request) {
+	account, svcErr := h.breakGlassService.Deactivate(r.Context(), r.PathValue("id"))
+	if svcErr != nil {
+		sysutils.WriteServiceErrorResponse(w, svcErr, clientErrorStatusCodes)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(w, http.StatusOK, account)
+}
+
+// clientErrorStatusCodes maps the client errors of the handler to HTTP status codes. Other client errors are
+// returned with 400 Bad Request.
+var clientErrorStatusCodes = map[string]int{
+	ErrorAccountNotFound.Code:        http.StatusNotFound,
+	ErrorAccountConflict.Code:        http.StatusConflict,
+	ErrorInvalidAccountState.Code:    http.StatusConflict,
+	ErrorAuthenticationFailed.Code:   http.StatusUnauthorized,
+	ErrorSelfApprovalNotAllowed.Code: http.StatusForbidden,
+}
@@ -0,0 +1,180 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package breakglass
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/system/error/apierror"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+)
+
+type HandlerTestSuite struct {
+	suite.Suite
+	mockService *BreakGlassServiceInterfaceMock
+	handler     *breakGlassHandler
+}
+
+func TestHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(HandlerTestSuite))
+}
+
+func (s *HandlerTestSuite) SetupTest() {
+	s.mockService = NewBreakGlassServiceInterfaceMock(s.T())
+	s.handler = newBreakGlassHandler(s.mockService)
+}
+
+func (s *HandlerTestSuite) TestHandleAccountPostRequest_Success() {
+	s.mockService.On("CreateAccount", mock.Anything, CreateAccountRequest{UserID: testUserID}).
+		Return(&BreakGlassAccount{ID: testAccountID, UserID: testUserID, Status: AccountStatusInactive}, nil)
+
+	req := httptest.NewRequest(http.MethodPost, "/break-glass-accounts",
+		strings.NewReader(`{"userId":"user-1"}`))
+	rr := httptest.NewRecorder()
+	s.handler.HandleAccountPostRequest(rr, req)
+
+	s.Equal(http.StatusCreated, rr.Code)
+	var body BreakGlassAccount
+	s.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &body))
+	s.Equal(testAccountID, body.ID)
+	s.Equal(AccountStatusInactive, body.Status)
+}
+
+func (s *HandlerTestSuite) TestHandleAccountPostRequest_InvalidBody() {
+	req := httptest.NewRequest(http.MethodPost, "/break-glass-accounts", strings.NewReader(`{`))
+	rr := httptest.NewRecorder()
+	s.handler.HandleAccountPostRequest(rr, req)
+
+	s.Equal(http.StatusBadRequest, rr.Code)
+	var errResp apierror.ErrorResponse
+	s.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &errResp))
+	s.Equal(ErrorInvalidRequestFormat.Code, errResp.Code)
+}
+
+func (s *HandlerTestSuite) TestHandleAccountPostRequest_Conflict() {
+	s.mockService.On("CreateAccount", mock.Anything, mock.Anything).Return(nil, &ErrorAccountConflict)
+
+	req := httptest.NewRequest(http.MethodPost, "/break-glass-accounts",
+		strings.NewReader(`{"userId":"user-1"}`))
+	rr := httptest.NewRecorder()
+	s.handler.HandleAccountPostRequest(rr, req)
+
+	s.Equal(http.StatusConflict, rr.Code)
+}
+
+func (s *HandlerTestSuite) TestHandleAccountListRequest() {
+	s.mockService.On("GetAccountList", mock.Anything).Return(&AccountListResponse{
+		TotalResults: 1,
+		Accounts:     []BreakGlassAccount{{ID: testAccountID}},
+	}, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/break-glass-accounts", nil)
+	rr := httptest.NewRecorder()
+	s.handler.HandleAccountListRequest(rr, req)
+
+	s.Equal(http.StatusOK, rr.Code)
+	var body AccountListResponse
+	s.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &body))
+	s.Equal(1, body.TotalResults)
+}
+
+func (s *HandlerTestSuite) TestHandleAccountGetRequest_NotFound() {
+	s.mockService.On("GetAccount", mock.Anything, testAccountID).Return(nil, &ErrorAccountNotFound)
+
+	req := httptest.NewRequest(http.MethodGet, "/break-glass-accounts/"+testAccountID, nil)
+	req.SetPathValue("id", testAccountID)
+	rr := httptest.NewRecorder()
+	s.handler.HandleAccountGetRequest(rr, req)
+
+	s.Equal(http.StatusNotFound, rr.Code)
+}
+
+func (s *HandlerTestSuite) TestHandleAccountDeleteRequest() {
+	s.mockService.On("DeleteAccount", mock.Anything, testAccountID).Return(nil)
+
+	req := httptest.NewRequest(http.MethodDelete, "/break-glass-accounts/"+testAccountID, nil)
+	req.SetPathValue("id", testAccountID)
+	rr := httptest.NewRecorder()
+	s.handler.HandleAccountDeleteRequest(rr, req)
+
+	s.Equal(http.StatusNoContent, rr.Code)
+}
+
+func (s *HandlerTestSuite) TestHandleActivationPostRequest_Accepted() {
+	s.mockService.On("RequestActivation", mock.Anything, testAccountID,
+		ActivationRequest{Reason: "SSO outage", Period: 1800}).
+		Return(&BreakGlassAccount{ID: testAccountID, Status: AccountStatusPendingApproval}, nil)
+
+	req := httptest.NewRequest(http.MethodPost, "/break-glass-accounts/"+testAccountID+"/activation",
+		strings.NewReader(`{"reason":"SSO outage","period":1800}`))
+	req.SetPathValue("id", testAccountID)
+	rr := httptest.NewRecorder()
+	s.handler.HandleActivationPostRequest(rr, req)
+
+	s.Equal(http.StatusAccepted, rr.Code)
+	var body BreakGlassAccount
+	s.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &body))
+	s.Equal(AccountStatusPendingApproval, body.Status)
+}
+
+func (s *HandlerTestSuite) TestHandleActivationApproveRequest_Errors() {
+	testCases := []struct {
+		name     string
+		svcErr   *serviceerror.ServiceError
+		expected int
+	}{
+		{"SelfApproval", &ErrorSelfApprovalNotAllowed, http.StatusForbidden},
+		{"InvalidState", &ErrorInvalidAccountState, http.StatusConflict},
+		{"Unauthenticated", &ErrorAuthenticationFailed, http.StatusUnauthorized},
+		{"ServerError", &serviceerror.InternalServerError, http.StatusInternalServerError},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.mockService.On("ApproveActivation", mock.Anything, testAccountID).Return(nil, tc.svcErr).Once()
+
+			req := httptest.NewRequest(http.MethodPost,
+				"/break-glass-accounts/"+testAccountID+"/activation/approve", nil)
+			req.SetPathValue("id", testAccountID)
+			rr := httptest.NewRecorder()
+			s.handler.HandleActivationApproveRequest(rr, req)
+
+			s.Equal(tc.expected, rr.Code)
+		})
+	}
+}
+
+func (s *HandlerTestSuite) TestHandleActivationDeleteRequest() {
+	s.mockService.On("Deactivate", mock.Anything, testAccountID).
+		Return(&BreakGlassAccount{ID: testAccountID, Status: AccountStatusInactive}, nil)
+
+	req := httptest.NewRequest(http.MethodDelete, "/break-glass-accounts/"+testAccountID+"/activation", nil)
+	req.SetPathValue("id", testAccountID)
+	rr := httptest.NewRecorder()
+	s.handler.HandleActivationDeleteRequest(rr, req)
+
+	s.Equal(http.StatusOK, rr.Code)
+}
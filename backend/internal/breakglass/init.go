@@ -0,0 +1,107 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package breakglass
+
+import (
+	"net/http"
+
+	"github.com/thunder-id/thunderid/internal/entityprovider"
+	"github.com/thunder-id/thunderid/internal/system/config"
+	syshttp "github.com/thunder-id/thunderid/internal/system/http"
+	"github.com/thunder-id/thunderid/internal/system/middleware"
+	"github.com/thunder-id/thunderid/internal/system/observability"
+)
+
+// Initialize initializes the break-glass account service and registers its routes.
+func Initialize(
+	mux *http.ServeMux,
+	entityProvider entityprovider.EntityProviderInterface,
+	observabilitySvc observability.ObservabilityServiceInterface,
+) BreakGlassServiceInterface {
+	breakGlassConfig := config.GetServerRuntime().Config.BreakGlass
+	breakGlassService := newBreakGlassService(newAccountStore(), entityProvider, observabilitySvc,
+		syshttp.NewHTTPClientWithTimeout(webhookTimeout), breakGlassConfig.MaxActivationPeriod,
+		breakGlassConfig.ApprovalTimeout, breakGlassConfig.WebhookURL)
+
+	breakGlassHandler := newBreakGlassHandler(breakGlassService)
+	registerRoutes(mux, breakGlassHandler)
+
+	return breakGlassService
+}
+
+// registerRoutes registers the routes for break-glass account operations.
+func registerRoutes(mux *http.ServeMux, breakGlassHandler *breakGlassHandler) {
+	opts1 := middleware.CORSOptions{
+		AllowedMethods:   []string{"GET", "POST"},
+		AllowedHeaders:   middleware.DefaultAllowedHeaders,
+		AllowCredentials: true,
+		MaxAge:           600,
+	}
+	mux.HandleFunc(middleware.WithCORS("POST /break-glass-accounts",
+		breakGlassHandler.HandleAccountPostRequest, opts1))
+	mux.HandleFunc(middleware.WithCORS("GET /break-glass-accounts",
+		breakGlassHandler.HandleAccountListRequest, opts1))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS /break-glass-accounts",
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, opts1))
+
+	opts2 := middleware.CORSOptions{
+		AllowedMethods:   []string{"GET", "DELETE"},
+		AllowedHeaders:   middleware.DefaultAllowedHeaders,
+		AllowCredentials: true,
+		MaxAge:           600,
+	}
+	mux.HandleFunc(middleware.WithCORS("GET /break-glass-accounts/{id}",
+		breakGlassHandler.HandleAccountGetRequest, opts2))
+	mux.HandleFunc(middleware.WithCORS("DELETE /break-glass-accounts/{id}",
+		breakGlassHandler.HandleAccountDeleteRequest, opts2))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS /break-glass-accounts/{id}",
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, opts2))
+
+	opts3 := middleware.CORSOptions{
+		AllowedMethods:   []string{"POST", "DELETE"},
+		AllowedHeaders:   middleware.DefaultAllowedHeaders,
+		AllowCredentials: true,
+		MaxAge:           600,
+	}
+	mux.HandleFunc(middleware.WithCORS("POST /break-glass-accounts/{id}/activation",
+		breakGlassHandler.HandleActivationPostRequest, opts3))
+	mux.HandleFunc(middleware.WithCORS("DELETE /break-glass-accounts/{id}/activation",
+		breakGlassHandler.HandleActivationDeleteRequest, opts3))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS /break-glass-accounts/{id}/activation",
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, opts3))
+
+	opts4 := middleware.CORSOptions{
+		AllowedMethods:   []string{"POST"},
+		AllowedHeaders:   middleware.DefaultAllowedHeaders,
+		AllowCredentials: true,
+		MaxAge:           600,
+	}
+	mux.HandleFunc(middleware.WithCORS("POST /break-glass-accounts/{id}/activation/approve",
+		breakGlassHandler.HandleActivationApproveRequest, opts4))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS /break-glass-accounts/{id}/activation/approve",
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, opts4))
+}
@@ -0,0 +1,84 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package breakglass provides time-boxed emergency access through break-glass accounts. A break-glass
+// account is a user that can bypass federation and multi-factor authentication while its activation,
+// approved by a second administrator, has not expired.
+package breakglass
+
+import "time"
+
+// AccountStatus represents the activation state of a break-glass account.
+type AccountStatus string
+
+const (
+	// AccountStatusInactive indicates the account cannot be used to sign in.
+	AccountStatusInactive AccountStatus = "INACTIVE"
+	// AccountStatusPendingApproval indicates an activation was requested and awaits a second approver.
+	AccountStatusPendingApproval AccountStatus = "PENDING_APPROVAL"
+	// AccountStatusActive indicates the account can be used to sign in until the activation expires.
+	AccountStatusActive AccountStatus = "ACTIVE"
+)
+
+// BreakGlassAccount represents a user designated for emergency access.
+type BreakGlassAccount struct {
+	ID          string             `json:"id"`
+	UserID      string             `json:"userId"`
+	Description string             `json:"description,omitempty"`
+	Status      AccountStatus      `json:"status"`
+	Activation  *AccountActivation `json:"activation,omitempty"`
+}
+
+// AccountActivation holds the latest activation of a break-glass account.
+type AccountActivation struct {
+	Reason      string     `json:"reason"`
+	Period      int64      `json:"period"`
+	RequestedBy string     `json:"requestedBy"`
+	RequestedAt time.Time  `json:"requestedAt"`
+	ApprovedBy  string     `json:"approvedBy,omitempty"`
+	ActivatedAt *time.Time `json:"activatedAt,omitempty"`
+	ExpiresAt   *time.Time `json:"expiresAt,omitempty"`
+}
+
+// CreateAccountRequest represents the request body for designating a user as a break-glass account.
+type CreateAccountRequest struct {
+	UserID      string `json:"userId"`
+	Description string `json:"description,omitempty"`
+}
+
+// ActivationRequest represents the request body for requesting the activation of a break-glass account.
+type ActivationRequest struct {
+	Reason string `json:"reason"`
+	// Period is the number of seconds the account stays active once approved. Defaults to the maximum
+	// activation period when omitted.
+	Period int64 `json:"period,omitempty"`
+}
+
+// AccountListResponse represents the response for listing break-glass accounts.
+type AccountListResponse struct {
+	TotalResults int                 `json:"totalResults"`
+	Accounts     []BreakGlassAccount `json:"accounts"`
+}
+
+// webhookEvent is the payload posted to the configured webhook on break-glass account activity.
+type webhookEvent struct {
+	Event     string            `json:"event"`
+	ActorID   string            `json:"actorId,omitempty"`
+	Timestamp time.Time         `json:"timestamp"`
+	Account   BreakGlassAccount `json:"account"`
+}
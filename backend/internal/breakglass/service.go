@@ -0,0 +1,432 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package breakglass
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/entityprovider"
+	serverconst "github.com/thunder-id/thunderid/internal/system/constants"
+	sysContext "github.com/thunder-id/thunderid/internal/system/context"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	syshttp "github.com/thunder-id/thunderid/internal/system/http"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/internal/system/observability"
+	"github.com/thunder-id/thunderid/internal/system/observability/event"
+	"github.com/thunder-id/thunderid/internal/system/security"
+	"github.com/thunder-id/thunderid/internal/system/utils"
+)
+
+// BreakGlassServiceInterface defines the interface for the break-glass account service.
+type BreakGlassServiceInterface interface {
+	CreateAccount(ctx context.Context, request CreateAccountRequest) (*BreakGlassAccount, *serviceerror.ServiceError)
+	GetAccountList(ctx context.Context) (*AccountListResponse, *serviceerror.ServiceError)
+	GetAccount(ctx context.Context, id string) (*BreakGlassAccount, *serviceerror.ServiceError)
+	DeleteAccount(ctx context.Context, id string) *serviceerror.ServiceError
+	RequestActivation(ctx context.Context, id string,
+		request ActivationRequest) (*BreakGlassAccount, *serviceerror.ServiceError)
+	ApproveActivation(ctx context.Context, id string) (*BreakGlassAccount, *serviceerror.ServiceError)
+	Deactivate(ctx context.Context, id string) (*BreakGlassAccount, *serviceerror.ServiceError)
+	AuthorizeUse(ctx context.Context, userID string) (bool, *serviceerror.ServiceError)
+}
+
+// breakGlassService is the default implementation of the BreakGlassServiceInterface.
+type breakGlassService struct {
+	store               accountStoreInterface
+	entityProvider      entityprovider.EntityProviderInterface
+	observabilitySvc    observability.ObservabilityServiceInterface
+	httpClient          syshttp.HTTPClientInterface
+	maxActivationPeriod int64
+	approvalTimeout     int64
+	webhookURL          string
+	now                 func() time.Time
+	logger              *log.Logger
+}
+
+// newBreakGlassService creates a new instance of breakGlassService.
+func newBreakGlassService(
+	store accountStoreInterface,
+	entityProvider entityprovider.EntityProviderInterface,
+	observabilitySvc observability.ObservabilityServiceInterface,
+	httpClient syshttp.HTTPClientInterface,
+	maxActivationPeriod int64,
+	approvalTimeout int64,
+	webhookURL string,
+) BreakGlassServiceInterface {
+	if maxActivationPeriod <= 0 {
+		maxActivationPeriod = defaultMaxActivationPeriod
+	}
+	if approvalTimeout <= 0 {
+		approvalTimeout = defaultApprovalTimeout
+	}
+
+	return &breakGlassService{
+		store:               store,
+		entityProvider:      entityProvider,
+		observabilitySvc:    observabilitySvc,
+		httpClient:          httpClient,
+		maxActivationPeriod: maxActivationPeriod,
+		approvalTimeout:     approvalTimeout,
+		webhookURL:          webhookURL,
+		now:                 time.Now,
+		logger:              log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName)),
+	}
+}
+
+// CreateAccount designates an existing user as a break-glass account. The account is created inactive.
+func (s *breakGlassService) CreateAccount(
+	ctx context.Context, request CreateAccountRequest) (*BreakGlassAccount, *serviceerror.ServiceError) {
+	userID := strings.TrimSpace(request.UserID)
+	if userID == "" {
+		return nil, &ErrorMissingUserID
+	}
+	description := strings.TrimSpace(request.Description)
+	if len(description) > maxDescriptionLength {
+		return nil, &ErrorInvalidDescription
+	}
+
+	user, providerErr := s.entityProvider.GetEntity(userID)
+	if providerErr != nil {
+		if providerErr.Code == entityprovider.ErrorCodeEntityNotFound {
+			return nil, &ErrorUserNotFound
+		}
+		s.logger.Error("Failed to retrieve user", log.MaskedString(log.LoggerKeyUserID, userID),
+			log.String("error", providerErr.Error()))
+		return nil, &serviceerror.InternalServerError
+	}
+	if user.Category != entityprovider.EntityCategoryUser {
+		return nil, &ErrorUserNotFound
+	}
+
+	if _, err := s.store.GetAccountByUserID(ctx, userID); err == nil {
+		return nil, &ErrorAccountConflict
+	} else if !errors.Is(err, ErrAccountNotFound) {
+		s.logger.Error("Failed to get break-glass account", log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+
+	id, err := utils.GenerateUUIDv7()
+	if err != nil {
+		s.logger.Error("Failed to generate ID for break-glass account", log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+	account := BreakGlassAccount{
+		ID:          id,
+		UserID:      userID,
+		Description: description,
+		Status:      AccountStatusInactive,
+	}
+	if err := s.store.CreateAccount(ctx, account); err != nil {
+		s.logger.Error("Failed to create break-glass account", log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+
+	s.notify(ctx, event.EventTypeBreakGlassAccountCreated, event.StatusSuccess, &account)
+	return &account, nil
+}
+
+// GetAccountList retrieves all break-glass accounts.
+func (s *breakGlassService) GetAccountList(ctx context.Context) (*AccountListResponse, *serviceerror.ServiceError) {
+	accounts, err := s.store.GetAccountList(ctx)
+	if err != nil {
+		s.logger.Error("Failed to get break-glass account list", log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+
+	for i := range accounts {
+		accounts[i].Status = s.effectiveStatus(&accounts[i])
+	}
+	return &AccountListResponse{
+		TotalResults: len(accounts),
+		Accounts:     accounts,
+	}, nil
+}
+
+// GetAccount retrieves a break-glass account by its ID.
+func (s *breakGlassService) GetAccount(
+	ctx context.Context, id string) (*BreakGlassAccount, *serviceerror.ServiceError) {
+	return s.getAccount(ctx, id)
+}
+
+// DeleteAccount removes the break-glass designation of a user. Deleting an account that does not exist
+// succeeds.
+func (s *breakGlassService) DeleteAccount(ctx context.Context, id string) *serviceerror.ServiceError {
+	account, svcErr := s.getAccount(ctx, id)
+	if svcErr != nil {
+		if svcErr.Code == ErrorAccountNotFound.Code {
+			return nil
+		}
+		return svcErr
+	}
+
+	if err := s.store.DeleteAccount(ctx, id); err != nil {
+		s.logger.Error("Failed to delete break-glass account", log.Error(err), log.String("accountID", id))
+		return &serviceerror.InternalServerError
+	}
+
+	s.notify(ctx, event.EventTypeBreakGlassAccountDeleted, event.StatusSuccess, account)
+	return nil
+}
+
+// RequestActivation requests the activation of an inactive break-glass account. The activation takes
+// effect only after it is approved by a second administrator.
+func (s *breakGlassService) RequestActivation(ctx context.Context, id string,
+	request ActivationRequest) (*BreakGlassAccount, *serviceerror.ServiceError) {
+	requester := security.GetSubject(ctx)
+	if requester == "" {
+		return nil, &ErrorAuthenticationFailed
+	}
+	reason := strings.TrimSpace(request.Reason)
+	if reason == "" || len(reason) > maxReasonLength {
+		return nil, &ErrorInvalidReason
+	}
+	period := request.Period
+	if period == 0 {
+		period = s.maxActivationPeriod
+	}
+	if period < 0 || period > s.maxActivationPeriod {
+		return nil, &ErrorInvalidActivationPeriod
+	}
+
+	account, svcErr := s.getAccount(ctx, id)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	if account.Status != AccountStatusInactive {
+		return nil, &ErrorInvalidAccountState
+	}
+
+	account.Status = AccountStatusPendingApproval
+	account.Activation = &AccountActivation{
+		Reason:      reason,
+		Period:      period,
+		RequestedBy: requester,
+		RequestedAt: s.now().UTC(),
+	}
+	if err := s.store.UpdateAccount(ctx, account); err != nil {
+		s.logger.Error("Failed to request break-glass activation", log.Error(err), log.String("accountID", id))
+		return nil, &serviceerror.InternalServerError
+	}
+
+	s.notify(ctx, event.EventTypeBreakGlassActivationRequested, event.StatusPending, account)
+	return account, nil
+}
+
+// ApproveActivation approves the pending activation of a break-glass account. The approver must be
+// neither the requester of the activation nor the user of the account.
+func (s *breakGlassService) ApproveActivation(
+	ctx context.Context, id string) (*BreakGlassAccount, *serviceerror.ServiceError) {
+	approver := security.GetSubject(ctx)
+	if approver == "" {
+		return nil, &ErrorAuthenticationFailed
+	}
+
+	account, svcErr := s.getAccount(ctx, id)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	if account.Status != AccountStatusPendingApproval {
+		return nil, &ErrorInvalidAccountState
+	}
+	if approver == account.Activation.RequestedBy || approver == account.UserID {
+		return nil, &ErrorSelfApprovalNotAllowed
+	}
+
+	activatedAt := s.now().UTC()
+	expiresAt := activatedAt.Add(time.Duration(account.Activation.Period) * time.Second)
+	account.Status = AccountStatusActive
+	account.Activation.ApprovedBy = approver
+	account.Activation.ActivatedAt = &activatedAt
+	account.Activation.ExpiresAt = &expiresAt
+	if err := s.store.UpdateAccount(ctx, account); err != nil {
+		s.logger.Error("Failed to activate break-glass account", log.Error(err), log.String("accountID", id))
+		return nil, &serviceerror.InternalServerError
+	}
+
+	s.notify(ctx, event.EventTypeBreakGlassActivated, event.StatusSuccess, account)
+	return account, nil
+}
+
+// Deactivate ends the activation of a break-glass account, or withdraws a pending activation request.
+// Deactivating an inactive account has no effect.
+func (s *breakGlassService) Deactivate(
+	ctx context.Context, id string) (*BreakGlassAccount, *serviceerror.ServiceError) {
+	if security.GetSubject(ctx) == "" {
+		return nil, &ErrorAuthenticationFailed
+	}
+
+	account, svcErr := s.getAccount(ctx, id)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	if account.Status == AccountStatusInactive {
+		return account, nil
+	}
+
+	if account.Status == AccountStatusActive {
+		expiresAt := s.now().UTC()
+		account.Activation.ExpiresAt = &expiresAt
+	}
+	account.Status = AccountStatusInactive
+	if err := s.store.UpdateAccount(ctx, account); err != nil {
+		s.logger.Error("Failed to deactivate break-glass account", log.Error(err), log.String("accountID", id))
+		return nil, &serviceerror.InternalServerError
+	}
+
+	s.notify(ctx, event.EventTypeBreakGlassDeactivated, event.StatusSuccess, account)
+	return account, nil
+}
+
+// AuthorizeUse reports whether the user is a break-glass account, and returns ErrorAccountNotActive when
+// the account has no approved and unexpired activation. Every sign-in attempt of a break-glass account
+// is audited and alerted.
+func (s *breakGlassService) AuthorizeUse(ctx context.Context, userID string) (bool, *serviceerror.ServiceError) {
+	account, err := s.store.GetAccountByUserID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, ErrAccountNotFound) {
+			return false, nil
+		}
+		s.logger.Error("Failed to get break-glass account", log.Error(err))
+		return false, &serviceerror.InternalServerError
+	}
+
+	account.Status = s.effectiveStatus(account)
+	if account.Status != AccountStatusActive {
+		s.notify(ctx, event.EventTypeBreakGlassUseDenied, event.StatusFailure, account)
+		return true, &ErrorAccountNotActive
+	}
+
+	s.notify(ctx, event.EventTypeBreakGlassUsed, event.StatusSuccess, account)
+	return true, nil
+}
+
+// getAccount retrieves a break-glass account by its ID with its effective status.
+func (s *breakGlassService) getAccount(
+	ctx context.Context, id string) (*BreakGlassAccount, *serviceerror.ServiceError) {
+	if strings.TrimSpace(id) == "" {
+		return nil, &ErrorAccountNotFound
+	}
+
+	account, err := s.store.GetAccount(ctx, id)
+	if err != nil {
+		if errors.Is(err, ErrAccountNotFound) {
+			return nil, &ErrorAccountNotFound
+		}
+		s.logger.Error("Failed to get break-glass account", log.Error(err), log.String("accountID", id))
+		return nil, &serviceerror.InternalServerError
+	}
+
+	account.Status = s.effectiveStatus(account)
+	return account, nil
+}
+
+// effectiveStatus returns the status of an account taking expiry into account. Activations expire once
+// their period elapses, and activation requests lapse when they are not approved in time.
+func (s *breakGlassService) effectiveStatus(account *BreakGlassAccount) AccountStatus {
+	activation := account.Activation
+	if activation == nil {
+		return AccountStatusInactive
+	}
+
+	now := s.now()
+	switch account.Status {
+	case AccountStatusActive:
+		if activation.ExpiresAt == nil || !now.Before(*activation.ExpiresAt) {
+			return AccountStatusInactive
+		}
+	case AccountStatusPendingApproval:
+		deadline := activation.RequestedAt.Add(time.Duration(s.approvalTimeout) * time.Second)
+		if !now.Before(deadline) {
+			return AccountStatusInactive
+		}
+	}
+	return account.Status
+}
+
+// notify records a break-glass event in the logs and the audit trail, and alerts the configured webhook.
+func (s *breakGlassService) notify(
+	ctx context.Context, eventType event.EventType, status string, account *BreakGlassAccount) {
+	actorID := security.GetSubject(ctx)
+	s.logger.Warn("Break-glass account activity", log.String("event", string(eventType)),
+		log.String("accountID", account.ID), log.MaskedString(log.LoggerKeyUserID, account.UserID),
+		log.String("actorID", actorID))
+
+	if s.observabilitySvc != nil && s.observabilitySvc.IsEnabled() {
+		evt := event.NewEvent(sysContext.GetTraceID(ctx), string(eventType), event.ComponentBreakGlass).
+			WithTenantID(sysContext.GetTenantID(ctx)).
+			WithStatus(status).
+			WithData(event.DataKey.AccountID, account.ID).
+			WithData(event.DataKey.UserID, account.UserID)
+		if actorID != "" {
+			evt.WithData(event.DataKey.ActorID, actorID)
+		}
+		if account.Activation != nil {
+			evt.WithData(event.DataKey.Reason, account.Activation.Reason)
+			if account.Activation.ExpiresAt != nil {
+				evt.WithData(event.DataKey.ExpiresAt, account.Activation.ExpiresAt.Format(time.RFC3339))
+			}
+		}
+		s.observabilitySvc.PublishEvent(evt)
+	}
+
+	s.notifyWebhook(ctx, webhookEvent{
+		Event:     string(eventType),
+		ActorID:   actorID,
+		Timestamp: s.now().UTC(),
+		Account:   *account,
+	})
+}
+
+// notifyWebhook posts a break-glass event to the configured webhook, if any. Delivery failures are
+// logged and do not fail the operation.
+func (s *breakGlassService) notifyWebhook(ctx context.Context, payload webhookEvent) {
+	if s.webhookURL == "" {
+		return
+	}
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		s.logger.Error("Failed to marshal webhook payload", log.Error(err))
+		return
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.webhookURL, bytes.NewReader(body))
+	if err != nil {
+		s.logger.Error("Failed to create webhook request", log.Error(err))
+		return
+	}
+	req.Header.Set(serverconst.ContentTypeHeaderName, serverconst.ContentTypeJSON)
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		s.logger.Warn("Failed to deliver break-glass webhook", log.Error(err))
+		return
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		s.logger.Warn("Break-glass webhook returned an unexpected status", log.Int("status", resp.StatusCode))
+	}
+}
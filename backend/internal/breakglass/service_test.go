@@ -0,0 +1,388 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package breakglass
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/entityprovider"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/observability/event"
+	"github.com/thunder-id/thunderid/internal/system/security"
+	"github.com/thunder-id/thunderid/tests/mocks/entityprovidermock"
+	"github.com/thunder-id/thunderid/tests/mocks/httpmock"
+	"github.com/thunder-id/thunderid/tests/mocks/observability/observabilitymock"
+)
+
+const (
+	testAccountID = "account-1"
+	testUserID    = "user-1"
+	testRequester = "admin-1"
+	testApprover  = "admin-2"
+	testWebhook   = "https://hooks.example.com/break-glass"
+)
+
+type BreakGlassServiceTestSuite struct {
+	suite.Suite
+	mockStore          *accountStoreInterfaceMock
+	mockEntityProvider *entityprovidermock.EntityProviderInterfaceMock
+	mockObservability  *observabilitymock.ObservabilityServiceInterfaceMock
+	mockHTTPClient     *httpmock.HTTPClientInterfaceMock
+	service            *breakGlassService
+	events             []*event.Event
+	now                time.Time
+}
+
+func TestBreakGlassServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(BreakGlassServiceTestSuite))
+}
+
+func (suite *BreakGlassServiceTestSuite) SetupTest() {
+	suite.mockStore = newAccountStoreInterfaceMock(suite.T())
+	suite.mockEntityProvider = entityprovidermock.NewEntityProviderInterfaceMock(suite.T())
+	suite.mockObservability = observabilitymock.NewObservabilityServiceInterfaceMock(suite.T())
+	suite.mockHTTPClient = httpmock.NewHTTPClientInterfaceMock(suite.T())
+	suite.service = newBreakGlassService(suite.mockStore, suite.mockEntityProvider, suite.mockObservability,
+		suite.mockHTTPClient, 3600, 600, "").(*breakGlassService)
+	suite.now = time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	suite.service.now = func() time.Time { return suite.now }
+
+	suite.events = nil
+	suite.mockObservability.On("IsEnabled").Return(true).Maybe()
+	suite.mockObservability.On("PublishEvent", mock.Anything).Run(func(args mock.Arguments) {
+		suite.events = append(suite.events, args.Get(0).(*event.Event))
+	}).Maybe()
+}
+
+func (suite *BreakGlassServiceTestSuite) contextFor(subject string) context.Context {
+	return security.WithSecurityContextTest(context.Background(),
+		security.NewSecurityContextForTest(subject, "", "", nil, nil))
+}
+
+func (suite *BreakGlassServiceTestSuite) pendingAccount() *BreakGlassAccount {
+	return &BreakGlassAccount{
+		ID:     testAccountID,
+		UserID: testUserID,
+		Status: AccountStatusPendingApproval,
+		Activation: &AccountActivation{
+			Reason:      "SSO outage",
+			Period:      1800,
+			RequestedBy: testRequester,
+			RequestedAt: suite.now.Add(-time.Minute),
+		},
+	}
+}
+
+func (suite *BreakGlassServiceTestSuite) activeAccount(expiresAt time.Time) *BreakGlassAccount {
+	account := suite.pendingAccount()
+	activatedAt := expiresAt.Add(-30 * time.Minute)
+	account.Status = AccountStatusActive
+	account.Activation.ApprovedBy = testApprover
+	account.Activation.ActivatedAt = &activatedAt
+	account.Activation.ExpiresAt = &expiresAt
+	return account
+}
+
+func (suite *BreakGlassServiceTestSuite) lastEventType() string {
+	suite.Require().NotEmpty(suite.events)
+	return suite.events[len(suite.events)-1].Type
+}
+
+func (suite *BreakGlassServiceTestSuite) TestCreateAccount_Success() {
+	suite.mockEntityProvider.On("GetEntity", testUserID).
+		Return(&entityprovider.Entity{ID: testUserID, Category: entityprovider.EntityCategoryUser}, nil).Once()
+	suite.mockStore.On("GetAccountByUserID", mock.Anything, testUserID).Return(nil, ErrAccountNotFound).Once()
+	suite.mockStore.On("CreateAccount", mock.Anything, mock.MatchedBy(func(account BreakGlassAccount) bool {
+		return account.ID != "" && account.UserID == testUserID && account.Status == AccountStatusInactive
+	})).Return(nil).Once()
+
+	account, svcErr := suite.service.CreateAccount(suite.contextFor(testRequester),
+		CreateAccountRequest{UserID: " " + testUserID + " ", Description: "Emergency admin"})
+
+	suite.Nil(svcErr)
+	suite.Equal(AccountStatusInactive, account.Status)
+	suite.Equal("Emergency admin", account.Description)
+	suite.Equal(string(event.EventTypeBreakGlassAccountCreated), suite.lastEventType())
+}
+
+func (suite *BreakGlassServiceTestSuite) TestCreateAccount_Errors() {
+	suite.Run("MissingUserID", func() {
+		_, svcErr := suite.service.CreateAccount(context.Background(), CreateAccountRequest{})
+		suite.Equal(ErrorMissingUserID.Code, svcErr.Code)
+	})
+	suite.Run("DescriptionTooLong", func() {
+		_, svcErr := suite.service.CreateAccount(context.Background(),
+			CreateAccountRequest{UserID: testUserID, Description: strings.Repeat("a", maxDescriptionLength+1)})
+		suite.Equal(ErrorInvalidDescription.Code, svcErr.Code)
+	})
+	suite.Run("UserNotFound", func() {
+		suite.mockEntityProvider.On("GetEntity", "missing").Return(nil,
+			entityprovider.NewEntityProviderError(entityprovider.ErrorCodeEntityNotFound, "Entity not found", "")).
+			Once()
+		_, svcErr := suite.service.CreateAccount(context.Background(), CreateAccountRequest{UserID: "missing"})
+		suite.Equal(ErrorUserNotFound.Code, svcErr.Code)
+	})
+	suite.Run("NotAUser", func() {
+		suite.mockEntityProvider.On("GetEntity", "app-1").
+			Return(&entityprovider.Entity{ID: "app-1", Category: entityprovider.EntityCategoryApp}, nil).Once()
+		_, svcErr := suite.service.CreateAccount(context.Background(), CreateAccountRequest{UserID: "app-1"})
+		suite.Equal(ErrorUserNotFound.Code, svcErr.Code)
+	})
+	suite.Run("Conflict", func() {
+		suite.mockEntityProvider.On("GetEntity", testUserID).
+			Return(&entityprovider.Entity{ID: testUserID, Category: entityprovider.EntityCategoryUser}, nil).Once()
+		suite.mockStore.On("GetAccountByUserID", mock.Anything, testUserID).
+			Return(&BreakGlassAccount{ID: testAccountID}, nil).Once()
+		_, svcErr := suite.service.CreateAccount(context.Background(), CreateAccountRequest{UserID: testUserID})
+		suite.Equal(ErrorAccountConflict.Code, svcErr.Code)
+	})
+}
+
+func (suite *BreakGlassServiceTestSuite) TestGetAccountList_ReportsExpiredActivationsAsInactive() {
+	expired := *suite.activeAccount(suite.now.Add(-time.Second))
+	expired.ID = "account-2"
+	lapsed := *suite.pendingAccount()
+	lapsed.ID = "account-3"
+	lapsed.Activation.RequestedAt = suite.now.Add(-11 * time.Minute)
+	suite.mockStore.On("GetAccountList", mock.Anything).Return([]BreakGlassAccount{
+		*suite.activeAccount(suite.now.Add(time.Minute)), expired, lapsed,
+		{ID: "account-4", UserID: "user-4", Status: AccountStatusInactive},
+	}, nil).Once()
+
+	response, svcErr := suite.service.GetAccountList(context.Background())
+
+	suite.Nil(svcErr)
+	suite.Equal(4, response.TotalResults)
+	suite.Equal(AccountStatusActive, response.Accounts[0].Status)
+	suite.Equal(AccountStatusInactive, response.Accounts[1].Status)
+	suite.Equal(AccountStatusInactive, response.Accounts[2].Status)
+	suite.Equal(AccountStatusInactive, response.Accounts[3].Status)
+}
+
+func (suite *BreakGlassServiceTestSuite) TestGetAccount_NotFound() {
+	suite.mockStore.On("GetAccount", mock.Anything, testAccountID).Return(nil, ErrAccountNotFound).Once()
+
+	account, svcErr := suite.service.GetAccount(context.Background(), testAccountID)
+
+	suite.Nil(account)
+	suite.Equal(ErrorAccountNotFound.Code, svcErr.Code)
+}
+
+func (suite *BreakGlassServiceTestSuite) TestDeleteAccount() {
+	suite.mockStore.On("GetAccount", mock.Anything, testAccountID).
+		Return(&BreakGlassAccount{ID: testAccountID, UserID: testUserID, Status: AccountStatusInactive}, nil).Once()
+	suite.mockStore.On("DeleteAccount", mock.Anything, testAccountID).Return(nil).Once()
+
+	suite.Nil(suite.service.DeleteAccount(suite.contextFor(testRequester), testAccountID))
+	suite.Equal(string(event.EventTypeBreakGlassAccountDeleted), suite.lastEventType())
+}
+
+func (suite *BreakGlassServiceTestSuite) TestDeleteAccount_NotFoundIsIgnored() {
+	suite.mockStore.On("GetAccount", mock.Anything, testAccountID).Return(nil, ErrAccountNotFound).Once()
+
+	suite.Nil(suite.service.DeleteAccount(context.Background(), testAccountID))
+}
+
+func (suite *BreakGlassServiceTestSuite) TestRequestActivation_Success() {
+	suite.mockStore.On("GetAccount", mock.Anything, testAccountID).
+		Return(&BreakGlassAccount{ID: testAccountID, UserID: testUserID, Status: AccountStatusInactive}, nil).Once()
+	suite.mockStore.On("UpdateAccount", mock.Anything, mock.Anything).Return(nil).Once()
+
+	account, svcErr := suite.service.RequestActivation(suite.contextFor(testRequester), testAccountID,
+		ActivationRequest{Reason: " SSO outage "})
+
+	suite.Nil(svcErr)
+	suite.Equal(AccountStatusPendingApproval, account.Status)
+	suite.Equal("SSO outage", account.Activation.Reason)
+	suite.Equal(int64(3600), account.Activation.Period)
+	suite.Equal(testRequester, account.Activation.RequestedBy)
+	suite.Equal(suite.now, account.Activation.RequestedAt)
+	suite.Nil(account.Activation.ExpiresAt)
+	suite.Equal(string(event.EventTypeBreakGlassActivationRequested), suite.lastEventType())
+}
+
+func (suite *BreakGlassServiceTestSuite) TestRequestActivation_Errors() {
+	ctx := suite.contextFor(testRequester)
+	testCases := []struct {
+		name     string
+		ctx      context.Context
+		request  ActivationRequest
+		expected serviceerror.ServiceError
+	}{
+		{"Unauthenticated", context.Background(), ActivationRequest{Reason: "x"}, ErrorAuthenticationFailed},
+		{"MissingReason", ctx, ActivationRequest{}, ErrorInvalidReason},
+		{"NegativePeriod", ctx, ActivationRequest{Reason: "x", Period: -1}, ErrorInvalidActivationPeriod},
+		{"PeriodTooLong", ctx, ActivationRequest{Reason: "x", Period: 3601}, ErrorInvalidActivationPeriod},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			account, svcErr := suite.service.RequestActivation(tc.ctx, testAccountID, tc.request)
+
+			suite.Nil(account)
+			suite.Require().NotNil(svcErr)
+			suite.Equal(tc.expected.Code, svcErr.Code)
+		})
+	}
+}
+
+func (suite *BreakGlassServiceTestSuite) TestRequestActivation_AlreadyPending() {
+	suite.mockStore.On("GetAccount", mock.Anything, testAccountID).Return(suite.pendingAccount(), nil).Once()
+
+	account, svcErr := suite.service.RequestActivation(suite.contextFor(testRequester), testAccountID,
+		ActivationRequest{Reason: "SSO outage"})
+
+	suite.Nil(account)
+	suite.Equal(ErrorInvalidAccountState.Code, svcErr.Code)
+}
+
+func (suite *BreakGlassServiceTestSuite) TestApproveActivation_Success() {
+	suite.mockStore.On("GetAccount", mock.Anything, testAccountID).Return(suite.pendingAccount(), nil).Once()
+	suite.mockStore.On("UpdateAccount", mock.Anything, mock.Anything).Return(nil).Once()
+
+	account, svcErr := suite.service.ApproveActivation(suite.contextFor(testApprover), testAccountID)
+
+	suite.Nil(svcErr)
+	suite.Equal(AccountStatusActive, account.Status)
+	suite.Equal(testApprover, account.Activation.ApprovedBy)
+	suite.Equal(suite.now, *account.Activation.ActivatedAt)
+	suite.Equal(suite.now.Add(30*time.Minute), *account.Activation.ExpiresAt)
+	suite.Equal(string(event.EventTypeBreakGlassActivated), suite.lastEventType())
+}
+
+func (suite *BreakGlassServiceTestSuite) TestApproveActivation_RequiresSecondPerson() {
+	for _, approver := range []string{testRequester, testUserID} {
+		suite.Run(approver, func() {
+			suite.mockStore.On("GetAccount", mock.Anything, testAccountID).Return(suite.pendingAccount(), nil).Once()
+
+			account, svcErr := suite.service.ApproveActivation(suite.contextFor(approver), testAccountID)
+
+			suite.Nil(account)
+			suite.Equal(ErrorSelfApprovalNotAllowed.Code, svcErr.Code)
+		})
+	}
+}
+
+func (suite *BreakGlassServiceTestSuite) TestApproveActivation_LapsedRequest() {
+	pending := suite.pendingAccount()
+	pending.Activation.RequestedAt = suite.now.Add(-10 * time.Minute)
+	suite.mockStore.On("GetAccount", mock.Anything, testAccountID).Return(pending, nil).Once()
+
+	account, svcErr := suite.service.ApproveActivation(suite.contextFor(testApprover), testAccountID)
+
+	suite.Nil(account)
+	suite.Equal(ErrorInvalidAccountState.Code, svcErr.Code)
+}
+
+func (suite *BreakGlassServiceTestSuite) TestDeactivate_ActiveAccount() {
+	suite.mockStore.On("GetAccount", mock.Anything, testAccountID).
+		Return(suite.activeAccount(suite.now.Add(time.Minute)), nil).Once()
+	suite.mockStore.On("UpdateAccount", mock.Anything, mock.MatchedBy(func(account *BreakGlassAccount) bool {
+		return account.Status == AccountStatusInactive && account.Activation.ExpiresAt.Equal(suite.now)
+	})).Return(nil).Once()
+
+	account, svcErr := suite.service.Deactivate(suite.contextFor(testRequester), testAccountID)
+
+	suite.Nil(svcErr)
+	suite.Equal(AccountStatusInactive, account.Status)
+	suite.Equal(string(event.EventTypeBreakGlassDeactivated), suite.lastEventType())
+}
+
+func (suite *BreakGlassServiceTestSuite) TestDeactivate_InactiveAccountIsUnchanged() {
+	suite.mockStore.On("GetAccount", mock.Anything, testAccountID).
+		Return(suite.activeAccount(suite.now.Add(-time.Minute)), nil).Once()
+
+	account, svcErr := suite.service.Deactivate(suite.contextFor(testRequester), testAccountID)
+
+	suite.Nil(svcErr)
+	suite.Equal(AccountStatusInactive, account.Status)
+	suite.Empty(suite.events)
+}
+
+func (suite *BreakGlassServiceTestSuite) TestAuthorizeUse_NotBreakGlassAccount() {
+	suite.mockStore.On("GetAccountByUserID", mock.Anything, testUserID).Return(nil, ErrAccountNotFound).Once()
+
+	isBreakGlass, svcErr := suite.service.AuthorizeUse(context.Background(), testUserID)
+
+	suite.Nil(svcErr)
+	suite.False(isBreakGlass)
+	suite.Empty(suite.events)
+}
+
+func (suite *BreakGlassServiceTestSuite) TestAuthorizeUse_ActiveAccountAlertsWebhook() {
+	suite.service.webhookURL = testWebhook
+	suite.mockStore.On("GetAccountByUserID", mock.Anything, testUserID).
+		Return(suite.activeAccount(suite.now.Add(time.Minute)), nil).Once()
+	var payload webhookEvent
+	suite.mockHTTPClient.On("Do", mock.MatchedBy(func(req *http.Request) bool {
+		body, _ := io.ReadAll(req.Body)
+		return req.URL.String() == testWebhook && json.Unmarshal(body, &payload) == nil
+	})).Return(&http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(""))}, nil).Once()
+
+	isBreakGlass, svcErr := suite.service.AuthorizeUse(context.Background(), testUserID)
+
+	suite.Nil(svcErr)
+	suite.True(isBreakGlass)
+	suite.Equal(string(event.EventTypeBreakGlassUsed), suite.lastEventType())
+	suite.Equal(testAccountID, suite.events[0].Data[event.DataKey.AccountID])
+	suite.Equal(string(event.EventTypeBreakGlassUsed), payload.Event)
+	suite.Equal(testUserID, payload.Account.UserID)
+}
+
+func (suite *BreakGlassServiceTestSuite) TestAuthorizeUse_ExpiredAccountIsDenied() {
+	suite.mockStore.On("GetAccountByUserID", mock.Anything, testUserID).
+		Return(suite.activeAccount(suite.now), nil).Once()
+
+	isBreakGlass, svcErr := suite.service.AuthorizeUse(context.Background(), testUserID)
+
+	suite.True(isBreakGlass)
+	suite.Equal(ErrorAccountNotActive.Code, svcErr.Code)
+	suite.Equal(string(event.EventTypeBreakGlassUseDenied), suite.lastEventType())
+}
+
+func (suite *BreakGlassServiceTestSuite) TestAuthorizeUse_WebhookFailureIsIgnored() {
+	suite.service.webhookURL = testWebhook
+	suite.mockStore.On("GetAccountByUserID", mock.Anything, testUserID).
+		Return(suite.activeAccount(suite.now.Add(time.Minute)), nil).Once()
+	suite.mockHTTPClient.On("Do", mock.Anything).Return(nil, errors.New("connection refused")).Once()
+
+	isBreakGlass, svcErr := suite.service.AuthorizeUse(context.Background(), testUserID)
+
+	suite.Nil(svcErr)
+	suite.True(isBreakGlass)
+}
+
+func (suite *BreakGlassServiceTestSuite) TestAuthorizeUse_StoreError() {
+	suite.mockStore.On("GetAccountByUserID", mock.Anything, testUserID).Return(nil, errors.New("db error")).Once()
+
+	isBreakGlass, svcErr := suite.service.AuthorizeUse(context.Background(), testUserID)
+
+	suite.False(isBreakGlass)
+	suite.Equal(serviceerror.InternalServerError.Code, svcErr.Code)
+}
@@ -0,0 +1,260 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package breakglass
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+	sysContext "github.com/thunder-id/thunderid/internal/system/context"
+	dbmodel "github.com/thunder-id/thunderid/internal/system/database/model"
+	"github.com/thunder-id/thunderid/internal/system/database/provider"
+	dbutils "github.com/thunder-id/thunderid/internal/system/database/utils"
+)
+
+var getDBProvider = provider.GetDBProvider
+
+// accountStoreInterface defines the interface for break-glass account store operations.
+type accountStoreInterface interface {
+	CreateAccount(ctx context.Context, account BreakGlassAccount) error
+	GetAccountList(ctx context.Context) ([]BreakGlassAccount, error)
+	GetAccount(ctx context.Context, id string) (*BreakGlassAccount, error)
+	GetAccountByUserID(ctx context.Context, userID string) (*BreakGlassAccount, error)
+	UpdateAccount(ctx context.Context, account *BreakGlassAccount) error
+	DeleteAccount(ctx context.Context, id string) error
+}
+
+// accountStore is the default implementation of accountStoreInterface.
+type accountStore struct {
+	dbProvider   provider.DBProviderInterface
+	deploymentID string
+}
+
+// newAccountStore creates a new instance of accountStore.
+func newAccountStore() accountStoreInterface {
+	return &accountStore{
+		dbProvider:   getDBProvider(),
+		deploymentID: config.GetServerRuntime().Config.Server.Identifier,
+	}
+}
+
+// CreateAccount persists a new break-glass account.
+func (s *accountStore) CreateAccount(ctx context.Context, account BreakGlassAccount) error {
+	dbClient, err := s.dbProvider.GetConfigDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	args := append([]interface{}{account.ID, account.UserID}, accountValues(&account)...)
+	args = append(args, sysContext.ScopeDeploymentID(ctx, s.deploymentID))
+	if _, err := dbClient.ExecuteContext(ctx, queryCreateAccount, args...); err != nil {
+		return fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	return nil
+}
+
+// GetAccountList retrieves all break-glass accounts ordered by user ID.
+func (s *accountStore) GetAccountList(ctx context.Context) ([]BreakGlassAccount, error) {
+	dbClient, err := s.dbProvider.GetConfigDBClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	results, err := dbClient.QueryContext(ctx, queryGetAccountList,
+		sysContext.ScopeDeploymentID(ctx, s.deploymentID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	accounts := make([]BreakGlassAccount, 0, len(results))
+	for _, row := range results {
+		account, err := buildAccountFromResultRow(row)
+		if err != nil {
+			return nil, fmt.Errorf("failed to build break-glass account from result row: %w", err)
+		}
+		accounts = append(accounts, *account)
+	}
+
+	return accounts, nil
+}
+
+// GetAccount retrieves a break-glass account by its ID.
+func (s *accountStore) GetAccount(ctx context.Context, id string) (*BreakGlassAccount, error) {
+	return s.getAccount(ctx, queryGetAccountByID, id)
+}
+
+// GetAccountByUserID retrieves a break-glass account by the ID of its user.
+func (s *accountStore) GetAccountByUserID(ctx context.Context, userID string) (*BreakGlassAccount, error) {
+	return s.getAccount(ctx, queryGetAccountByUserID, userID)
+}
+
+// getAccount retrieves a single break-glass account using the provided query and identifier.
+func (s *accountStore) getAccount(
+	ctx context.Context, query dbmodel.DBQuery, identifier string) (*BreakGlassAccount, error) {
+	dbClient, err := s.dbProvider.GetConfigDBClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	results, err := dbClient.QueryContext(ctx, query, identifier, sysContext.ScopeDeploymentID(ctx, s.deploymentID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+	if len(results) == 0 {
+		return nil, ErrAccountNotFound
+	}
+	if len(results) != 1 {
+		return nil, fmt.Errorf("unexpected number of results: %d", len(results))
+	}
+
+	return buildAccountFromResultRow(results[0])
+}
+
+// UpdateAccount updates the description and the activation of a break-glass account.
+func (s *accountStore) UpdateAccount(ctx context.Context, account *BreakGlassAccount) error {
+	dbClient, err := s.dbProvider.GetConfigDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	args := append([]interface{}{account.ID}, accountValues(account)...)
+	args = append(args, sysContext.ScopeDeploymentID(ctx, s.deploymentID))
+	if _, err := dbClient.ExecuteContext(ctx, queryUpdateAccount, args...); err != nil {
+		return fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteAccount deletes a break-glass account by its ID.
+func (s *accountStore) DeleteAccount(ctx context.Context, id string) error {
+	dbClient, err := s.dbProvider.GetConfigDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	if _, err := dbClient.ExecuteContext(ctx, queryDeleteAccount, id,
+		sysContext.ScopeDeploymentID(ctx, s.deploymentID)); err != nil {
+		return fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	return nil
+}
+
+// accountValues returns the column values of an account from DESCRIPTION to EXPIRES_AT.
+func accountValues(account *BreakGlassAccount) []interface{} {
+	values := []interface{}{toNullableString(account.Description), string(account.Status)}
+	activation := account.Activation
+	if activation == nil {
+		return append(values, nil, nil, nil, nil, nil, nil, nil)
+	}
+	return append(values, activation.Reason, activation.Period, activation.RequestedBy, activation.RequestedAt,
+		toNullableString(activation.ApprovedBy), toNullableTime(activation.ActivatedAt),
+		toNullableTime(activation.ExpiresAt))
+}
+
+// buildAccountFromResultRow constructs a BreakGlassAccount from a database result row.
+func buildAccountFromResultRow(row map[string]interface{}) (*BreakGlassAccount, error) {
+	id, ok := row["id"].(string)
+	if !ok {
+		return nil, fmt.Errorf("failed to parse id as string")
+	}
+	userID, ok := row["user_id"].(string)
+	if !ok {
+		return nil, fmt.Errorf("failed to parse user_id as string")
+	}
+	status, ok := row["status"].(string)
+	if !ok {
+		return nil, fmt.Errorf("failed to parse status as string")
+	}
+	description, _ := row["description"].(string)
+
+	account := &BreakGlassAccount{
+		ID:          id,
+		UserID:      userID,
+		Description: description,
+		Status:      AccountStatus(status),
+	}
+	if row["requested_at"] == nil {
+		return account, nil
+	}
+
+	activation := &AccountActivation{}
+	activation.Reason, _ = row["activation_reason"].(string)
+	activation.RequestedBy, _ = row["requested_by"].(string)
+	activation.ApprovedBy, _ = row["approved_by"].(string)
+	period, err := parseInt64Field(row["activation_period"], "activation_period")
+	if err != nil {
+		return nil, err
+	}
+	activation.Period = period
+	if activation.RequestedAt, err = dbutils.ParseTimeField(row["requested_at"], "requested_at"); err != nil {
+		return nil, err
+	}
+	if row["activated_at"] != nil {
+		activatedAt, err := dbutils.ParseTimeField(row["activated_at"], "activated_at")
+		if err != nil {
+			return nil, err
+		}
+		activation.ActivatedAt = &activatedAt
+	}
+	if row["expires_at"] != nil {
+		expiresAt, err := dbutils.ParseTimeField(row["expires_at"], "expires_at")
+		if err != nil {
+			return nil, err
+		}
+		activation.ExpiresAt = &expiresAt
+	}
+	account.Activation = activation
+
+	return account, nil
+}
+
+// parseInt64Field parses an integer field from the database result.
+func parseInt64Field(field interface{}, fieldName string) (int64, error) {
+	switch v := field.(type) {
+	case int64:
+		return v, nil
+	case int:
+		return int64(v), nil
+	case float64:
+		return int64(v), nil
+	default:
+		return 0, fmt.Errorf("unexpected type for %s", fieldName)
+	}
+}
+
+// toNullableString maps an empty string to a SQL NULL.
+func toNullableString(value string) interface{} {
+	if value == "" {
+		return nil
+	}
+	return value
+}
+
+// toNullableTime maps a nil time to a SQL NULL.
+func toNullableTime(value *time.Time) interface{} {
+	if value == nil {
+		return nil
+	}
+	return *value
+}
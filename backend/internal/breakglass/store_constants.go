@@ -0,0 +1,61 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package breakglass
+
+import "github.com/thunder-id/thunderid/internal/system/database/model"
+
+const accountColumns = `ID, USER_ID, DESCRIPTION, STATUS, ACTIVATION_REASON, ACTIVATION_PERIOD, REQUESTED_BY, ` +
+	`REQUESTED_AT, APPROVED_BY, ACTIVATED_AT, EXPIRES_AT`
+
+var (
+	// queryCreateAccount is the query to create a new break-glass account.
+	queryCreateAccount = model.DBQuery{
+		ID: "BGQ-ACCOUNT_MGT-01",
+		Query: `INSERT INTO "BREAK_GLASS_ACCOUNT" (` + accountColumns + `, DEPLOYMENT_ID) ` +
+			`VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)`,
+	}
+	// queryGetAccountByID is the query to get a break-glass account by its ID.
+	queryGetAccountByID = model.DBQuery{
+		ID:    "BGQ-ACCOUNT_MGT-02",
+		Query: `SELECT ` + accountColumns + ` FROM "BREAK_GLASS_ACCOUNT" WHERE ID = $1 AND DEPLOYMENT_ID = $2`,
+	}
+	// queryGetAccountList is the query to get the list of break-glass accounts.
+	queryGetAccountList = model.DBQuery{
+		ID: "BGQ-ACCOUNT_MGT-03",
+		Query: `SELECT ` + accountColumns + ` FROM "BREAK_GLASS_ACCOUNT" WHERE DEPLOYMENT_ID = $1 ` +
+			`ORDER BY USER_ID`,
+	}
+	// queryGetAccountByUserID is the query to get a break-glass account by the ID of its user.
+	queryGetAccountByUserID = model.DBQuery{
+		ID:    "BGQ-ACCOUNT_MGT-04",
+		Query: `SELECT ` + accountColumns + ` FROM "BREAK_GLASS_ACCOUNT" WHERE USER_ID = $1 AND DEPLOYMENT_ID = $2`,
+	}
+	// queryUpdateAccount is the query to update a break-glass account by its ID.
+	queryUpdateAccount = model.DBQuery{
+		ID: "BGQ-ACCOUNT_MGT-05",
+		Query: `UPDATE "BREAK_GLASS_ACCOUNT" SET DESCRIPTION = $2, STATUS = $3, ACTIVATION_REASON = $4, ` +
+			`ACTIVATION_PERIOD = $5, REQUESTED_BY = $6, REQUESTED_AT = $7, APPROVED_BY = $8, ACTIVATED_AT = $9, ` +
+			`EXPIRES_AT = $10 WHERE ID = $1 AND DEPLOYMENT_ID = $11`,
+	}
+	// queryDeleteAccount is the query to delete a break-glass account by its ID.
+	queryDeleteAccount = model.DBQuery{
+		ID:    "BGQ-ACCOUNT_MGT-06",
+		Query: `DELETE FROM "BREAK_GLASS_ACCOUNT" WHERE ID = $1 AND DEPLOYMENT_ID = $2`,
+	}
+)
@@ -24,6 +24,7 @@ import (
 	"fmt"
 
 	"github.com/thunder-id/thunderid/internal/system/config"
+	sysContext "github.com/thunder-id/thunderid/internal/system/context"
 	dbmodel "github.com/thunder-id/thunderid/internal/system/database/model"
 	dbprovider "github.com/thunder-id/thunderid/internal/system/database/provider"
 )
@@ -55,13 +56,15 @@ func newCertificateStore() certificateStoreInterface {
 
 // GetCertificateByID retrieves a certificate by its ID.
 func (s *certificateStore) GetCertificateByID(ctx context.Context, id string) (*Certificate, error) {
-	return s.getCertificate(ctx, queryGetCertificateByID, id, s.deploymentID)
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
+	return s.getCertificate(ctx, queryGetCertificateByID, id, deploymentID)
 }
 
 // GetCertificateByReference retrieves a certificate by its reference type and ID.
 func (s *certificateStore) GetCertificateByReference(ctx context.Context, refType CertificateReferenceType,
 	refID string) (*Certificate, error) {
-	return s.getCertificate(ctx, queryGetCertificateByReference, refType, refID, s.deploymentID)
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
+	return s.getCertificate(ctx, queryGetCertificateByReference, refType, refID, deploymentID)
 }
 
 // getCertificate retrieves a certificate based on a query and its arguments.
@@ -130,13 +133,14 @@ func (s *certificateStore) buildCertificateFromResultRow(row map[string]interfac
 
 // CreateCertificate creates a new certificate in the database.
 func (s *certificateStore) CreateCertificate(ctx context.Context, cert *Certificate) error {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
 	dbClient, err := s.dbProvider.GetConfigDBClient()
 	if err != nil {
 		return fmt.Errorf("failed to get database client: %w", err)
 	}
 
 	rows, err := dbClient.ExecuteContext(ctx, queryInsertCertificate, cert.ID, cert.RefType, cert.RefID, cert.Type,
-		cert.Value, s.deploymentID)
+		cert.Value, deploymentID)
 	if err != nil {
 		return fmt.Errorf("failed to insert certificate: %w", err)
 	}
@@ -149,15 +153,17 @@ func (s *certificateStore) CreateCertificate(ctx context.Context, cert *Certific
 
 // UpdateCertificateByID updates a certificate by its ID.
 func (s *certificateStore) UpdateCertificateByID(ctx context.Context, existingCert, updatedCert *Certificate) error {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
 	return s.updateCertificate(ctx, queryUpdateCertificateByID, existingCert.ID, updatedCert.Type, updatedCert.Value,
-		s.deploymentID)
+		deploymentID)
 }
 
 // UpdateCertificateByReference updates a certificate by its reference type and ID.
 func (s *certificateStore) UpdateCertificateByReference(ctx context.Context,
 	existingCert, updatedCert *Certificate) error {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
 	return s.updateCertificate(ctx, queryUpdateCertificateByReference, existingCert.RefType, existingCert.RefID,
-		updatedCert.Type, updatedCert.Value, s.deploymentID)
+		updatedCert.Type, updatedCert.Value, deploymentID)
 }
 
 // updateCertificate updates a certificate based on a query and its arguments.
@@ -180,13 +186,15 @@ func (s *certificateStore) updateCertificate(ctx context.Context, query dbmodel.
 
 // DeleteCertificateByID deletes a certificate by its ID.
 func (s *certificateStore) DeleteCertificateByID(ctx context.Context, id string) error {
-	return s.deleteCertificate(ctx, queryDeleteCertificateByID, id, s.deploymentID)
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
+	return s.deleteCertificate(ctx, queryDeleteCertificateByID, id, deploymentID)
 }
 
 // DeleteCertificateByReference deletes a certificate by its reference type and ID.
 func (s *certificateStore) DeleteCertificateByReference(ctx context.Context, refType CertificateReferenceType,
 	refID string) error {
-	return s.deleteCertificate(ctx, queryDeleteCertificateByReference, refType, refID, s.deploymentID)
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
+	return s.deleteCertificate(ctx, queryDeleteCertificateByReference, refType, refID, deploymentID)
 }
 
 // deleteCertificate deletes a certificate based on a query and its arguments.
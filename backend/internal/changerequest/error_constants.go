@@ -0,0 +1,102 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package changerequest
+
+import (
+	"errors"
+
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/i18n/core"
+)
+
+// ErrChangeRequestNotFound is returned when the change request is not found in the system.
+var ErrChangeRequestNotFound = errors.New("change request not found")
+
+// ErrChangeRequestNotPending is returned when a decision is recorded on a change request that is no longer
+// pending.
+var ErrChangeRequestNotPending = errors.New("change request is not pending")
+
+// Client errors for change request operations.
+var (
+	// ErrorChangeRequestNotFound is the error returned when the change request is not found.
+	ErrorChangeRequestNotFound = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "CRQ-1001",
+		Error: core.I18nMessage{
+			Key:          "error.changerequestservice.change_request_not_found",
+			DefaultValue: "Change request not found",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.changerequestservice.change_request_not_found_description",
+			DefaultValue: "The requested change request could not be found",
+		},
+	}
+	// ErrorInvalidStatusFilter is the error returned when the status filter is not a known status.
+	ErrorInvalidStatusFilter = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "CRQ-1002",
+		Error: core.I18nMessage{
+			Key:          "error.changerequestservice.invalid_status_filter",
+			DefaultValue: "Invalid status filter",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.changerequestservice.invalid_status_filter_description",
+			DefaultValue: "The status filter must be one of PENDING, APPROVED, REJECTED, EXPIRED or FAILED",
+		},
+	}
+	// ErrorInvalidChangeRequestState is the error returned when the change request is not pending.
+	ErrorInvalidChangeRequestState = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "CRQ-1003",
+		Error: core.I18nMessage{
+			Key:          "error.changerequestservice.invalid_change_request_state",
+			DefaultValue: "Invalid change request state",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.changerequestservice.invalid_change_request_state_description",
+			DefaultValue: "The change request has already been decided or has expired",
+		},
+	}
+	// ErrorSelfApprovalNotAllowed is the error returned when the requester approves their own change request.
+	ErrorSelfApprovalNotAllowed = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "CRQ-1004",
+		Error: core.I18nMessage{
+			Key:          "error.changerequestservice.self_approval_not_allowed",
+			DefaultValue: "Self approval not allowed",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.changerequestservice.self_approval_not_allowed_description",
+			DefaultValue: "The change request must be approved by someone other than the requester",
+		},
+	}
+	// ErrorAuthenticationFailed is the error returned when the caller could not be identified.
+	ErrorAuthenticationFailed = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "CRQ-1005",
+		Error: core.I18nMessage{
+			Key:          "error.changerequestservice.authentication_failed",
+			DefaultValue: "Authentication failed",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.changerequestservice.authentication_failed_description",
+			DefaultValue: "The caller could not be identified",
+		},
+	}
+)
@@ -0,0 +1,93 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package changerequest
+
+import (
+	"net/http"
+
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	sysutils "github.com/thunder-id/thunderid/internal/system/utils"
+)
+
+// changeRequestHandler is the handler for change request operations.
+type changeRequestHandler struct {
+	changeRequestService ChangeRequestServiceInterface
+}
+
+// newChangeRequestHandler creates a new instance of changeRequestHandler.
+func newChangeRequestHandler(changeRequestService ChangeRequestServiceInterface) *changeRequestHandler {
+	return &changeRequestHandler{
+		changeRequestService: changeRequestService,
+	}
+}
+
+// HandleChangeRequestListRequest handles the list change requests request.
+func (h *changeRequestHandler) HandleChangeRequestListRequest(w http.ResponseWriter, r *http.Request) {
+	status := ChangeRequestStatus(sysutils.SanitizeString(r.URL.Query().Get("status")))
+	changeRequests, svcErr := h.changeRequestService.GetChangeRequestList(r.Context(), status)
+	if svcErr != nil {
+		sysutils.WriteServiceErrorResponse(w, svcErr, clientErrorStatusCodes)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(w, http.StatusOK, changeRequests)
+}
+
+// HandleChangeRequestGetRequest handles the get change request request.
+func (h *changeRequestHandler) HandleChangeRequestGetRequest(w http.ResponseWriter, r *http.Request) {
+	changeRequest, svcErr := h.changeRequestService.GetChangeRequest(r.Context(), r.PathValue("id"))
+	if svcErr != nil {
+		sysutils.WriteServiceErrorResponse(w, svcErr, clientErrorStatusCodes)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(w, http.StatusOK, changeRequest)
+}
+
+// HandleChangeRequestApproveRequest handles the request to approve a change request.
+func (h *changeRequestHandler) HandleChangeRequestApproveRequest(w http.ResponseWriter, r *http.Request) {
+	changeRequest, svcErr := h.changeRequestService.ApproveChangeRequest(r.Context(), r.PathValue("id"))
+	if svcErr != nil {
+		sysutils.WriteServiceErrorResponse(w, svcErr, clientErrorStatusCodes)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(w, http.StatusOK, changeRequest)
+}
+
+// HandleChangeRequestRejectRequest handles the request to reject a change request.
+func (h *changeRequestHandler) HandleChangeRequestRejectRequest(w http.ResponseWriter, r *http.Request) {
+	changeRequest, svcErr := h.changeRequestService.RejectChangeRequest(r.Context(), r.PathValue("id"))
+	if svcErr != nil {
+		sysutils.WriteServiceErrorResponse(w, svcErr, clientErrorStatusCodes)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(w, http.StatusOK, changeRequest)
+}
+
+// clientErrorStatusCodes maps the client errors of the handler to HTTP status codes. Other client errors are
+// returned with 400 Bad Request.
+var clientErrorStatusCodes = map[string]int{
+	ErrorChangeRequestNotFound.Code:     http.StatusNotFound,
+	ErrorInvalidChangeRequestState.Code: http.StatusConflict,
+	ErrorAuthenticationFailed.Code:      http.StatusUnauthorized,
+	ErrorSelfApprovalNotAllowed.Code:    http.StatusForbidden,
+	serviceerror.ErrorUnauthorized.Code: http.StatusForbidden,
+}
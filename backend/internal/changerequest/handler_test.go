@@ -0,0 +1,134 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package changerequest
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/system/error/apierror"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+)
+
+type HandlerTestSuite struct {
+	suite.Suite
+	mockService *ChangeRequestServiceInterfaceMock
+	handler     *changeRequestHandler
+}
+
+func TestHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(HandlerTestSuite))
+}
+
+func (s *HandlerTestSuite) SetupTest() {
+	s.mockService = NewChangeRequestServiceInterfaceMock(s.T())
+	s.handler = newChangeRequestHandler(s.mockService)
+}
+
+func (s *HandlerTestSuite) TestHandleChangeRequestListRequest() {
+	s.mockService.On("GetChangeRequestList", mock.Anything, ChangeRequestStatusPending).
+		Return(&ChangeRequestListResponse{
+			TotalResults:   1,
+			ChangeRequests: []ChangeRequest{{ID: testChangeRequestID}},
+		}, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/change-requests?status=PENDING", nil)
+	rr := httptest.NewRecorder()
+	s.handler.HandleChangeRequestListRequest(rr, req)
+
+	s.Equal(http.StatusOK, rr.Code)
+	var body ChangeRequestListResponse
+	s.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &body))
+	s.Equal(1, body.TotalResults)
+}
+
+func (s *HandlerTestSuite) TestHandleChangeRequestGetRequest_NotFound() {
+	s.mockService.On("GetChangeRequest", mock.Anything, testChangeRequestID).
+		Return(nil, &ErrorChangeRequestNotFound)
+
+	req := httptest.NewRequest(http.MethodGet, "/change-requests/"+testChangeRequestID, nil)
+	req.SetPathValue("id", testChangeRequestID)
+	rr := httptest.NewRecorder()
+	s.handler.HandleChangeRequestGetRequest(rr, req)
+
+	s.Equal(http.StatusNotFound, rr.Code)
+}
+
+func (s *HandlerTestSuite) TestHandleChangeRequestApproveRequest() {
+	s.mockService.On("ApproveChangeRequest", mock.Anything, testChangeRequestID).
+		Return(&ChangeRequest{ID: testChangeRequestID, Status: ChangeRequestStatusApproved}, nil)
+
+	req := httptest.NewRequest(http.MethodPost, "/change-requests/"+testChangeRequestID+"/approve", nil)
+	req.SetPathValue("id", testChangeRequestID)
+	rr := httptest.NewRecorder()
+	s.handler.HandleChangeRequestApproveRequest(rr, req)
+
+	s.Equal(http.StatusOK, rr.Code)
+	var body ChangeRequest
+	s.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &body))
+	s.Equal(ChangeRequestStatusApproved, body.Status)
+}
+
+func (s *HandlerTestSuite) TestHandleChangeRequestApproveRequest_Errors() {
+	testCases := []struct {
+		name           string
+		svcErr         *serviceerror.ServiceError
+		expectedStatus int
+	}{
+		{"SelfApproval", &ErrorSelfApprovalNotAllowed, http.StatusForbidden},
+		{"NotAllowed", &serviceerror.ErrorUnauthorized, http.StatusForbidden},
+		{"NotPending", &ErrorInvalidChangeRequestState, http.StatusConflict},
+		{"Unauthenticated", &ErrorAuthenticationFailed, http.StatusUnauthorized},
+		{"ServerError", &serviceerror.InternalServerError, http.StatusInternalServerError},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			s.mockService.On("ApproveChangeRequest", mock.Anything, testChangeRequestID).Return(nil, tc.svcErr)
+
+			req := httptest.NewRequest(http.MethodPost, "/change-requests/"+testChangeRequestID+"/approve", nil)
+			req.SetPathValue("id", testChangeRequestID)
+			rr := httptest.NewRecorder()
+			s.handler.HandleChangeRequestApproveRequest(rr, req)
+
+			s.Equal(tc.expectedStatus, rr.Code)
+			var errResp apierror.ErrorResponse
+			s.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &errResp))
+			s.Equal(tc.svcErr.Code, errResp.Code)
+		})
+	}
+}
+
+func (s *HandlerTestSuite) TestHandleChangeRequestRejectRequest() {
+	s.mockService.On("RejectChangeRequest", mock.Anything, testChangeRequestID).
+		Return(&ChangeRequest{ID: testChangeRequestID, Status: ChangeRequestStatusRejected}, nil)
+
+	req := httptest.NewRequest(http.MethodPost, "/change-requests/"+testChangeRequestID+"/reject", nil)
+	req.SetPathValue("id", testChangeRequestID)
+	rr := httptest.NewRecorder()
+	s.handler.HandleChangeRequestRejectRequest(rr, req)
+
+	s.Equal(http.StatusOK, rr.Code)
+}
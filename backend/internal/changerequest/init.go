@@ -0,0 +1,90 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package changerequest
+
+import (
+	"net/http"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/middleware"
+	"github.com/thunder-id/thunderid/internal/system/observability"
+	"github.com/thunder-id/thunderid/internal/system/sysauthz"
+)
+
+// Initialize initializes the change request service and registers its routes.
+func Initialize(
+	mux *http.ServeMux,
+	authzService sysauthz.SystemAuthorizationServiceInterface,
+	observabilitySvc observability.ObservabilityServiceInterface,
+) (ChangeRequestServiceInterface, error) {
+	store, transactioner, err := newChangeRequestStore()
+	if err != nil {
+		return nil, err
+	}
+
+	changeApprovalConfig := config.GetServerRuntime().Config.ChangeApproval
+	changeRequestService := newChangeRequestService(store, transactioner, authzService, observabilitySvc,
+		changeApprovalConfig.Operations, changeApprovalConfig.Expiry)
+
+	changeRequestHandler := newChangeRequestHandler(changeRequestService)
+	registerRoutes(mux, changeRequestHandler)
+
+	return changeRequestService, nil
+}
+
+// registerRoutes registers the routes for change request operations.
+func registerRoutes(mux *http.ServeMux, changeRequestHandler *changeRequestHandler) {
+	opts1 := middleware.CORSOptions{
+		AllowedMethods:   []string{"GET"},
+		AllowedHeaders:   middleware.DefaultAllowedHeaders,
+		AllowCredentials: true,
+		MaxAge:           600,
+	}
+	mux.HandleFunc(middleware.WithCORS("GET /change-requests",
+		changeRequestHandler.HandleChangeRequestListRequest, opts1))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS /change-requests",
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, opts1))
+	mux.HandleFunc(middleware.WithCORS("GET /change-requests/{id}",
+		changeRequestHandler.HandleChangeRequestGetRequest, opts1))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS /change-requests/{id}",
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, opts1))
+
+	opts2 := middleware.CORSOptions{
+		AllowedMethods:   []string{"POST"},
+		AllowedHeaders:   middleware.DefaultAllowedHeaders,
+		AllowCredentials: true,
+		MaxAge:           600,
+	}
+	mux.HandleFunc(middleware.WithCORS("POST /change-requests/{id}/approve",
+		changeRequestHandler.HandleChangeRequestApproveRequest, opts2))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS /change-requests/{id}/approve",
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, opts2))
+	mux.HandleFunc(middleware.WithCORS("POST /change-requests/{id}/reject",
+		changeRequestHandler.HandleChangeRequestRejectRequest, opts2))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS /change-requests/{id}/reject",
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, opts2))
+}
@@ -0,0 +1,78 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package changerequest provides the four-eyes approval workflow for sensitive administrative operations.
+// A configured operation is not applied when it is requested. It is recorded as a pending change request
+// and applied only when a second administrator approves it before the request expires.
+package changerequest
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+)
+
+// Operation identifies a sensitive operation that can require approval.
+type Operation string
+
+const (
+	// OperationRoleUpdate identifies the update of a role, including its permissions and assignments.
+	OperationRoleUpdate Operation = "role.update"
+)
+
+// ChangeRequestStatus represents the state of a change request.
+type ChangeRequestStatus string
+
+const (
+	// ChangeRequestStatusPending indicates the change awaits the decision of an approver.
+	ChangeRequestStatusPending ChangeRequestStatus = "PENDING"
+	// ChangeRequestStatusApproved indicates the change was approved and applied.
+	ChangeRequestStatusApproved ChangeRequestStatus = "APPROVED"
+	// ChangeRequestStatusRejected indicates the change was rejected or withdrawn and was not applied.
+	ChangeRequestStatusRejected ChangeRequestStatus = "REJECTED"
+	// ChangeRequestStatusExpired indicates the change was not decided before the request expired.
+	ChangeRequestStatusExpired ChangeRequestStatus = "EXPIRED"
+	// ChangeRequestStatusFailed indicates the change was approved but could not be applied.
+	ChangeRequestStatusFailed ChangeRequestStatus = "FAILED"
+)
+
+// ChangeRequest represents a pending or decided change to a sensitive resource.
+type ChangeRequest struct {
+	ID          string              `json:"id"`
+	Operation   Operation           `json:"operation"`
+	ResourceID  string              `json:"resourceId"`
+	Payload     json.RawMessage     `json:"payload"`
+	Status      ChangeRequestStatus `json:"status"`
+	RequestedBy string              `json:"requestedBy"`
+	RequestedAt time.Time           `json:"requestedAt"`
+	ExpiresAt   time.Time           `json:"expiresAt"`
+	DecidedBy   string              `json:"decidedBy,omitempty"`
+	DecidedAt   *time.Time          `json:"decidedAt,omitempty"`
+}
+
+// ChangeRequestListResponse represents the response for listing change requests.
+type ChangeRequestListResponse struct {
+	TotalResults   int             `json:"totalResults"`
+	ChangeRequests []ChangeRequest `json:"changeRequests"`
+}
+
+// OperationApplier applies an approved change to the resource it targets. It runs within the transaction
+// that records the approval, so the change and its approval are committed or rolled back together.
+type OperationApplier func(ctx context.Context, resourceID string, payload json.RawMessage) *serviceerror.ServiceError
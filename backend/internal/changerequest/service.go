@@ -0,0 +1,377 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package changerequest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"sync"
+	"time"
+
+	sysContext "github.com/thunder-id/thunderid/internal/system/context"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/internal/system/observability"
+	"github.com/thunder-id/thunderid/internal/system/observability/event"
+	"github.com/thunder-id/thunderid/internal/system/security"
+	"github.com/thunder-id/thunderid/internal/system/sysauthz"
+	"github.com/thunder-id/thunderid/internal/system/transaction"
+	"github.com/thunder-id/thunderid/internal/system/utils"
+)
+
+// errApplyFailed aborts the approval transaction when the approved change cannot be applied.
+var errApplyFailed = errors.New("failed to apply the approved change")
+
+// ChangeRequestServiceInterface defines the interface for the change request service.
+type ChangeRequestServiceInterface interface {
+	RegisterOperation(operation Operation, applier OperationApplier)
+	IsApprovalRequired(operation Operation) bool
+	CreateChangeRequest(ctx context.Context, operation Operation, resourceID string,
+		payload interface{}) (*ChangeRequest, *serviceerror.ServiceError)
+	GetChangeRequestList(ctx context.Context,
+		status ChangeRequestStatus) (*ChangeRequestListResponse, *serviceerror.ServiceError)
+	GetChangeRequest(ctx context.Context, id string) (*ChangeRequest, *serviceerror.ServiceError)
+	ApproveChangeRequest(ctx context.Context, id string) (*ChangeRequest, *serviceerror.ServiceError)
+	RejectChangeRequest(ctx context.Context, id string) (*ChangeRequest, *serviceerror.ServiceError)
+}
+
+// changeRequestService is the default implementation of the ChangeRequestServiceInterface.
+type changeRequestService struct {
+	store            changeRequestStoreInterface
+	transactioner    transaction.Transactioner
+	authzService     sysauthz.SystemAuthorizationServiceInterface
+	observabilitySvc observability.ObservabilityServiceInterface
+	operations       map[Operation]bool
+	expiry           int64
+	mu               sync.RWMutex
+	appliers         map[Operation]OperationApplier
+	now              func() time.Time
+	logger           *log.Logger
+}
+
+// newChangeRequestService creates a new instance of changeRequestService. Approval is required for the
+// given operations once an applier is registered for them.
+func newChangeRequestService(
+	store changeRequestStoreInterface,
+	transactioner transaction.Transactioner,
+	authzService sysauthz.SystemAuthorizationServiceInterface,
+	observabilitySvc observability.ObservabilityServiceInterface,
+	operations []string,
+	expiry int64,
+) ChangeRequestServiceInterface {
+	if expiry <= 0 {
+		expiry = defaultExpiry
+	}
+	operationSet := make(map[Operation]bool, len(operations))
+	for _, operation := range operations {
+		operationSet[Operation(strings.TrimSpace(operation))] = true
+	}
+
+	return &changeRequestService{
+		store:            store,
+		transactioner:    transactioner,
+		authzService:     authzService,
+		observabilitySvc: observabilitySvc,
+		operations:       operationSet,
+		expiry:           expiry,
+		appliers:         make(map[Operation]OperationApplier),
+		now:              time.Now,
+		logger:           log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName)),
+	}
+}
+
+// RegisterOperation registers the applier of an operation. The owner of the resource an operation targets
+// registers its applier during initialization.
+func (s *changeRequestService) RegisterOperation(operation Operation, applier OperationApplier) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.appliers[operation] = applier
+}
+
+// IsApprovalRequired reports whether the operation is configured to require approval.
+func (s *changeRequestService) IsApprovalRequired(operation Operation) bool {
+	if !s.operations[operation] {
+		return false
+	}
+	return s.getApplier(operation) != nil
+}
+
+// CreateChangeRequest records a pending change request for the operation on the resource. The payload is
+// handed to the applier of the operation once the change request is approved.
+func (s *changeRequestService) CreateChangeRequest(ctx context.Context, operation Operation, resourceID string,
+	payload interface{}) (*ChangeRequest, *serviceerror.ServiceError) {
+	requester := security.GetSubject(ctx)
+	if requester == "" {
+		return nil, &ErrorAuthenticationFailed
+	}
+	if s.getApplier(operation) == nil {
+		s.logger.Error("No applier registered for operation", log.String("operation", string(operation)))
+		return nil, &serviceerror.InternalServerError
+	}
+
+	payloadJSON, err := json.Marshal(payload)
+	if err != nil {
+		s.logger.Error("Failed to marshal change request payload", log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+	id, err := utils.GenerateUUIDv7()
+	if err != nil {
+		s.logger.Error("Failed to generate ID for change request", log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+
+	requestedAt := s.now().UTC()
+	changeRequest := ChangeRequest{
+		ID:          id,
+		Operation:   operation,
+		ResourceID:  resourceID,
+		Payload:     payloadJSON,
+		Status:      ChangeRequestStatusPending,
+		RequestedBy: requester,
+		RequestedAt: requestedAt,
+		ExpiresAt:   requestedAt.Add(time.Duration(s.expiry) * time.Second),
+	}
+	if err := s.store.CreateChangeRequest(ctx, changeRequest); err != nil {
+		s.logger.Error("Failed to create change request", log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+
+	s.publishEvent(ctx, event.EventTypeChangeRequestCreated, event.StatusPending, &changeRequest)
+	return &changeRequest, nil
+}
+
+// GetChangeRequestList retrieves the change requests, optionally filtered by their status.
+func (s *changeRequestService) GetChangeRequestList(ctx context.Context,
+	status ChangeRequestStatus) (*ChangeRequestListResponse, *serviceerror.ServiceError) {
+	if status != "" && !isValidStatus(status) {
+		return nil, &ErrorInvalidStatusFilter
+	}
+
+	changeRequests, err := s.store.GetChangeRequestList(ctx)
+	if err != nil {
+		s.logger.Error("Failed to get change request list", log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+
+	filtered := make([]ChangeRequest, 0, len(changeRequests))
+	for i := range changeRequests {
+		changeRequests[i].Status = s.effectiveStatus(&changeRequests[i])
+		if status == "" || changeRequests[i].Status == status {
+			filtered = append(filtered, changeRequests[i])
+		}
+	}
+	return &ChangeRequestListResponse{
+		TotalResults:   len(filtered),
+		ChangeRequests: filtered,
+	}, nil
+}
+
+// GetChangeRequest retrieves a change request by its ID.
+func (s *changeRequestService) GetChangeRequest(
+	ctx context.Context, id string) (*ChangeRequest, *serviceerror.ServiceError) {
+	return s.getChangeRequest(ctx, id)
+}
+
+// ApproveChangeRequest approves a pending change request and applies the change. The approver must be
+// allowed to approve change requests and must not be the requester. The change and the approval are
+// committed atomically; when the change cannot be applied, the change request is marked as failed.
+func (s *changeRequestService) ApproveChangeRequest(
+	ctx context.Context, id string) (*ChangeRequest, *serviceerror.ServiceError) {
+	approver := security.GetSubject(ctx)
+	if approver == "" {
+		return nil, &ErrorAuthenticationFailed
+	}
+
+	changeRequest, svcErr := s.getChangeRequest(ctx, id)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	if changeRequest.Status != ChangeRequestStatusPending {
+		return nil, &ErrorInvalidChangeRequestState
+	}
+	if approver == changeRequest.RequestedBy {
+		return nil, &ErrorSelfApprovalNotAllowed
+	}
+	if svcErr := s.checkApprover(ctx, changeRequest); svcErr != nil {
+		return nil, svcErr
+	}
+	applier := s.getApplier(changeRequest.Operation)
+	if applier == nil {
+		s.logger.Error("No applier registered for operation",
+			log.String("operation", string(changeRequest.Operation)), log.String("changeRequestID", id))
+		return nil, &serviceerror.InternalServerError
+	}
+
+	decidedAt := s.now().UTC()
+	changeRequest.Status = ChangeRequestStatusApproved
+	changeRequest.DecidedBy = approver
+	changeRequest.DecidedAt = &decidedAt
+
+	var applyErr *serviceerror.ServiceError
+	err := s.transactioner.Transact(ctx, func(txCtx context.Context) error {
+		if err := s.store.DecideChangeRequest(txCtx, changeRequest); err != nil {
+			return err
+		}
+		if applyErr = applier(txCtx, changeRequest.ResourceID, changeRequest.Payload); applyErr != nil {
+			return errApplyFailed
+		}
+		return nil
+	})
+	if applyErr != nil {
+		changeRequest.Status = ChangeRequestStatusFailed
+		if err := s.store.DecideChangeRequest(ctx, changeRequest); err != nil {
+			s.logger.Error("Failed to mark change request as failed", log.Error(err),
+				log.String("changeRequestID", id))
+		}
+		s.publishEvent(ctx, event.EventTypeChangeRequestFailed, event.StatusFailure, changeRequest)
+		return nil, applyErr
+	}
+	if err != nil {
+		if errors.Is(err, ErrChangeRequestNotPending) {
+			return nil, &ErrorInvalidChangeRequestState
+		}
+		s.logger.Error("Failed to approve change request", log.Error(err), log.String("changeRequestID", id))
+		return nil, &serviceerror.InternalServerError
+	}
+
+	s.publishEvent(ctx, event.EventTypeChangeRequestApproved, event.StatusSuccess, changeRequest)
+	return changeRequest, nil
+}
+
+// RejectChangeRequest rejects a pending change request without applying the change. The requester can
+// withdraw their own change request; anyone else must be allowed to approve change requests.
+func (s *changeRequestService) RejectChangeRequest(
+	ctx context.Context, id string) (*ChangeRequest, *serviceerror.ServiceError) {
+	decider := security.GetSubject(ctx)
+	if decider == "" {
+		return nil, &ErrorAuthenticationFailed
+	}
+
+	changeRequest, svcErr := s.getChangeRequest(ctx, id)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	if changeRequest.Status != ChangeRequestStatusPending {
+		return nil, &ErrorInvalidChangeRequestState
+	}
+	if decider != changeRequest.RequestedBy {
+		if svcErr := s.checkApprover(ctx, changeRequest); svcErr != nil {
+			return nil, svcErr
+		}
+	}
+
+	decidedAt := s.now().UTC()
+	changeRequest.Status = ChangeRequestStatusRejected
+	changeRequest.DecidedBy = decider
+	changeRequest.DecidedAt = &decidedAt
+	if err := s.store.DecideChangeRequest(ctx, changeRequest); err != nil {
+		if errors.Is(err, ErrChangeRequestNotPending) {
+			return nil, &ErrorInvalidChangeRequestState
+		}
+		s.logger.Error("Failed to reject change request", log.Error(err), log.String("changeRequestID", id))
+		return nil, &serviceerror.InternalServerError
+	}
+
+	s.publishEvent(ctx, event.EventTypeChangeRequestRejected, event.StatusSuccess, changeRequest)
+	return changeRequest, nil
+}
+
+// getChangeRequest retrieves a change request by its ID with its effective status.
+func (s *changeRequestService) getChangeRequest(
+	ctx context.Context, id string) (*ChangeRequest, *serviceerror.ServiceError) {
+	if strings.TrimSpace(id) == "" {
+		return nil, &ErrorChangeRequestNotFound
+	}
+
+	changeRequest, err := s.store.GetChangeRequest(ctx, id)
+	if err != nil {
+		if errors.Is(err, ErrChangeRequestNotFound) {
+			return nil, &ErrorChangeRequestNotFound
+		}
+		s.logger.Error("Failed to get change request", log.Error(err), log.String("changeRequestID", id))
+		return nil, &serviceerror.InternalServerError
+	}
+
+	changeRequest.Status = s.effectiveStatus(changeRequest)
+	return changeRequest, nil
+}
+
+// checkApprover verifies that the caller is allowed to decide on change requests.
+func (s *changeRequestService) checkApprover(
+	ctx context.Context, changeRequest *ChangeRequest) *serviceerror.ServiceError {
+	allowed, svcErr := s.authzService.IsActionAllowed(ctx, security.ActionApproveChangeRequest,
+		&sysauthz.ActionContext{ResourceID: changeRequest.ID})
+	if svcErr != nil {
+		s.logger.Error("Failed to check authorization for action",
+			log.String("action", string(security.ActionApproveChangeRequest)), log.Any("error", svcErr))
+		return &serviceerror.InternalServerError
+	}
+	if !allowed {
+		return &serviceerror.ErrorUnauthorized
+	}
+	return nil
+}
+
+// getApplier returns the applier registered for the operation, or nil when none is registered.
+func (s *changeRequestService) getApplier(operation Operation) OperationApplier {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.appliers[operation]
+}
+
+// effectiveStatus returns the status of a change request taking expiry into account. Pending change
+// requests expire once their deadline passes.
+func (s *changeRequestService) effectiveStatus(changeRequest *ChangeRequest) ChangeRequestStatus {
+	if changeRequest.Status == ChangeRequestStatusPending && !s.now().Before(changeRequest.ExpiresAt) {
+		return ChangeRequestStatusExpired
+	}
+	return changeRequest.Status
+}
+
+// publishEvent records a change request event in the audit trail.
+func (s *changeRequestService) publishEvent(
+	ctx context.Context, eventType event.EventType, status string, changeRequest *ChangeRequest) {
+	if s.observabilitySvc == nil || !s.observabilitySvc.IsEnabled() {
+		return
+	}
+
+	evt := event.NewEvent(sysContext.GetTraceID(ctx), string(eventType), event.ComponentChangeRequest).
+		WithTenantID(sysContext.GetTenantID(ctx)).
+		WithStatus(status).
+		WithData(event.DataKey.ChangeRequestID, changeRequest.ID).
+		WithData(event.DataKey.Operation, string(changeRequest.Operation)).
+		WithData(event.DataKey.ResourceID, changeRequest.ResourceID)
+	if actorID := security.GetSubject(ctx); actorID != "" {
+		evt.WithData(event.DataKey.ActorID, actorID)
+	}
+	s.observabilitySvc.PublishEvent(evt)
+}
+
+// isValidStatus reports whether the status is a known change request status.
+func isValidStatus(status ChangeRequestStatus) bool {
+	switch status {
+	case ChangeRequestStatusPending, ChangeRequestStatusApproved, ChangeRequestStatusRejected,
+		ChangeRequestStatusExpired, ChangeRequestStatusFailed:
+		return true
+	default:
+		return false
+	}
+}
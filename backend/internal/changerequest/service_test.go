@@ -0,0 +1,319 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package changerequest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/observability/event"
+	"github.com/thunder-id/thunderid/internal/system/security"
+	"github.com/thunder-id/thunderid/internal/system/transaction"
+	"github.com/thunder-id/thunderid/tests/mocks/observability/observabilitymock"
+	"github.com/thunder-id/thunderid/tests/mocks/sysauthzmock"
+)
+
+const (
+	testChangeRequestID = "change-request-1"
+	testRoleID          = "role-1"
+	testRequester       = "admin-1"
+	testApprover        = "admin-2"
+)
+
+type ChangeRequestServiceTestSuite struct {
+	suite.Suite
+	mockStore         *changeRequestStoreInterfaceMock
+	mockAuthz         *sysauthzmock.SystemAuthorizationServiceInterfaceMock
+	mockObservability *observabilitymock.ObservabilityServiceInterfaceMock
+	service           *changeRequestService
+	applied           []json.RawMessage
+	applyErr          *serviceerror.ServiceError
+	events            []*event.Event
+	now               time.Time
+}
+
+func TestChangeRequestServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(ChangeRequestServiceTestSuite))
+}
+
+func (suite *ChangeRequestServiceTestSuite) SetupTest() {
+	suite.mockStore = newChangeRequestStoreInterfaceMock(suite.T())
+	suite.mockAuthz = sysauthzmock.NewSystemAuthorizationServiceInterfaceMock(suite.T())
+	suite.mockObservability = observabilitymock.NewObservabilityServiceInterfaceMock(suite.T())
+	suite.service = newChangeRequestService(suite.mockStore, transaction.NewNoOpTransactioner(), suite.mockAuthz,
+		suite.mockObservability, []string{string(OperationRoleUpdate)}, 3600).(*changeRequestService)
+	suite.now = time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	suite.service.now = func() time.Time { return suite.now }
+
+	suite.applied = nil
+	suite.applyErr = nil
+	suite.service.RegisterOperation(OperationRoleUpdate,
+		func(_ context.Context, resourceID string, payload json.RawMessage) *serviceerror.ServiceError {
+			suite.Equal(testRoleID, resourceID)
+			suite.applied = append(suite.applied, payload)
+			return suite.applyErr
+		})
+
+	suite.events = nil
+	suite.mockObservability.On("IsEnabled").Return(true).Maybe()
+	suite.mockObservability.On("PublishEvent", mock.Anything).Run(func(args mock.Arguments) {
+		suite.events = append(suite.events, args.Get(0).(*event.Event))
+	}).Maybe()
+}
+
+func (suite *ChangeRequestServiceTestSuite) contextFor(subject string) context.Context {
+	return security.WithSecurityContextTest(context.Background(),
+		security.NewSecurityContextForTest(subject, "", "", nil, nil))
+}
+
+func (suite *ChangeRequestServiceTestSuite) pendingChangeRequest() *ChangeRequest {
+	return &ChangeRequest{
+		ID:          testChangeRequestID,
+		Operation:   OperationRoleUpdate,
+		ResourceID:  testRoleID,
+		Payload:     json.RawMessage(`{"name":"auditors"}`),
+		Status:      ChangeRequestStatusPending,
+		RequestedBy: testRequester,
+		RequestedAt: suite.now.Add(-time.Minute),
+		ExpiresAt:   suite.now.Add(time.Hour),
+	}
+}
+
+func (suite *ChangeRequestServiceTestSuite) allowApprover(allowed bool) {
+	suite.mockAuthz.On("IsActionAllowed", mock.Anything, security.ActionApproveChangeRequest,
+		mock.Anything).Return(allowed, nil).Once()
+}
+
+func (suite *ChangeRequestServiceTestSuite) TestIsApprovalRequired() {
+	suite.True(suite.service.IsApprovalRequired(OperationRoleUpdate))
+
+	unregistered := newChangeRequestService(suite.mockStore, transaction.NewNoOpTransactioner(), suite.mockAuthz,
+		nil, []string{string(OperationRoleUpdate)}, 0)
+	suite.False(unregistered.IsApprovalRequired(OperationRoleUpdate))
+
+	unconfigured := newChangeRequestService(suite.mockStore, transaction.NewNoOpTransactioner(), suite.mockAuthz,
+		nil, nil, 0)
+	unconfigured.RegisterOperation(OperationRoleUpdate, suite.service.getApplier(OperationRoleUpdate))
+	suite.False(unconfigured.IsApprovalRequired(OperationRoleUpdate))
+}
+
+func (suite *ChangeRequestServiceTestSuite) TestCreateChangeRequest_Success() {
+	suite.mockStore.On("CreateChangeRequest", mock.Anything, mock.MatchedBy(func(cr ChangeRequest) bool {
+		return cr.Status == ChangeRequestStatusPending && cr.RequestedBy == testRequester &&
+			cr.ResourceID == testRoleID && string(cr.Payload) == `{"name":"auditors"}` &&
+			cr.ExpiresAt.Equal(suite.now.Add(time.Hour))
+	})).Return(nil).Once()
+
+	changeRequest, svcErr := suite.service.CreateChangeRequest(suite.contextFor(testRequester),
+		OperationRoleUpdate, testRoleID, map[string]string{"name": "auditors"})
+
+	suite.Nil(svcErr)
+	suite.NotEmpty(changeRequest.ID)
+	suite.Equal(ChangeRequestStatusPending, changeRequest.Status)
+	suite.Empty(suite.applied)
+	suite.Require().Len(suite.events, 1)
+	suite.Equal(string(event.EventTypeChangeRequestCreated), suite.events[0].Type)
+}
+
+func (suite *ChangeRequestServiceTestSuite) TestCreateChangeRequest_Unauthenticated() {
+	changeRequest, svcErr := suite.service.CreateChangeRequest(context.Background(),
+		OperationRoleUpdate, testRoleID, nil)
+
+	suite.Nil(changeRequest)
+	suite.Equal(ErrorAuthenticationFailed.Code, svcErr.Code)
+}
+
+func (suite *ChangeRequestServiceTestSuite) TestCreateChangeRequest_UnregisteredOperation() {
+	changeRequest, svcErr := suite.service.CreateChangeRequest(suite.contextFor(testRequester),
+		Operation("group.update"), testRoleID, nil)
+
+	suite.Nil(changeRequest)
+	suite.Equal(serviceerror.InternalServerError.Code, svcErr.Code)
+}
+
+func (suite *ChangeRequestServiceTestSuite) TestGetChangeRequestList_FiltersByEffectiveStatus() {
+	expired := suite.pendingChangeRequest()
+	expired.ID = "change-request-2"
+	expired.ExpiresAt = suite.now
+	suite.mockStore.On("GetChangeRequestList", mock.Anything).
+		Return([]ChangeRequest{*suite.pendingChangeRequest(), *expired}, nil).Once()
+
+	list, svcErr := suite.service.GetChangeRequestList(context.Background(), ChangeRequestStatusExpired)
+
+	suite.Nil(svcErr)
+	suite.Equal(1, list.TotalResults)
+	suite.Equal("change-request-2", list.ChangeRequests[0].ID)
+	suite.Equal(ChangeRequestStatusExpired, list.ChangeRequests[0].Status)
+}
+
+func (suite *ChangeRequestServiceTestSuite) TestGetChangeRequestList_InvalidStatus() {
+	list, svcErr := suite.service.GetChangeRequestList(context.Background(), "UNKNOWN")
+
+	suite.Nil(list)
+	suite.Equal(ErrorInvalidStatusFilter.Code, svcErr.Code)
+}
+
+func (suite *ChangeRequestServiceTestSuite) TestGetChangeRequest_NotFound() {
+	suite.mockStore.On("GetChangeRequest", mock.Anything, testChangeRequestID).
+		Return(nil, ErrChangeRequestNotFound).Once()
+
+	changeRequest, svcErr := suite.service.GetChangeRequest(context.Background(), testChangeRequestID)
+
+	suite.Nil(changeRequest)
+	suite.Equal(ErrorChangeRequestNotFound.Code, svcErr.Code)
+}
+
+func (suite *ChangeRequestServiceTestSuite) TestApproveChangeRequest_AppliesChange() {
+	suite.mockStore.On("GetChangeRequest", mock.Anything, testChangeRequestID).
+		Return(suite.pendingChangeRequest(), nil).Once()
+	suite.allowApprover(true)
+	suite.mockStore.On("DecideChangeRequest", mock.Anything, mock.MatchedBy(func(cr *ChangeRequest) bool {
+		return cr.Status == ChangeRequestStatusApproved && cr.DecidedBy == testApprover
+	})).Return(nil).Once()
+
+	changeRequest, svcErr := suite.service.ApproveChangeRequest(suite.contextFor(testApprover),
+		testChangeRequestID)
+
+	suite.Nil(svcErr)
+	suite.Equal(ChangeRequestStatusApproved, changeRequest.Status)
+	suite.Equal(suite.now, *changeRequest.DecidedAt)
+	suite.Equal([]json.RawMessage{json.RawMessage(`{"name":"auditors"}`)}, suite.applied)
+	suite.Require().Len(suite.events, 1)
+	suite.Equal(string(event.EventTypeChangeRequestApproved), suite.events[0].Type)
+}
+
+func (suite *ChangeRequestServiceTestSuite) TestApproveChangeRequest_SelfApprovalNotAllowed() {
+	suite.mockStore.On("GetChangeRequest", mock.Anything, testChangeRequestID).
+		Return(suite.pendingChangeRequest(), nil).Once()
+
+	changeRequest, svcErr := suite.service.ApproveChangeRequest(suite.contextFor(testRequester),
+		testChangeRequestID)
+
+	suite.Nil(changeRequest)
+	suite.Equal(ErrorSelfApprovalNotAllowed.Code, svcErr.Code)
+	suite.Empty(suite.applied)
+}
+
+func (suite *ChangeRequestServiceTestSuite) TestApproveChangeRequest_ApproverNotAllowed() {
+	suite.mockStore.On("GetChangeRequest", mock.Anything, testChangeRequestID).
+		Return(suite.pendingChangeRequest(), nil).Once()
+	suite.allowApprover(false)
+
+	changeRequest, svcErr := suite.service.ApproveChangeRequest(suite.contextFor(testApprover),
+		testChangeRequestID)
+
+	suite.Nil(changeRequest)
+	suite.Equal(serviceerror.ErrorUnauthorized.Code, svcErr.Code)
+	suite.Empty(suite.applied)
+}
+
+func (suite *ChangeRequestServiceTestSuite) TestApproveChangeRequest_Expired() {
+	expired := suite.pendingChangeRequest()
+	expired.ExpiresAt = suite.now.Add(-time.Second)
+	suite.mockStore.On("GetChangeRequest", mock.Anything, testChangeRequestID).Return(expired, nil).Once()
+
+	changeRequest, svcErr := suite.service.ApproveChangeRequest(suite.contextFor(testApprover),
+		testChangeRequestID)
+
+	suite.Nil(changeRequest)
+	suite.Equal(ErrorInvalidChangeRequestState.Code, svcErr.Code)
+}
+
+func (suite *ChangeRequestServiceTestSuite) TestApproveChangeRequest_AlreadyDecided() {
+	suite.mockStore.On("GetChangeRequest", mock.Anything, testChangeRequestID).
+		Return(suite.pendingChangeRequest(), nil).Once()
+	suite.allowApprover(true)
+	suite.mockStore.On("DecideChangeRequest", mock.Anything, mock.Anything).
+		Return(ErrChangeRequestNotPending).Once()
+
+	changeRequest, svcErr := suite.service.ApproveChangeRequest(suite.contextFor(testApprover),
+		testChangeRequestID)
+
+	suite.Nil(changeRequest)
+	suite.Equal(ErrorInvalidChangeRequestState.Code, svcErr.Code)
+	suite.Empty(suite.applied)
+}
+
+func (suite *ChangeRequestServiceTestSuite) TestApproveChangeRequest_ApplyFailureMarksFailed() {
+	applyErr := &serviceerror.ServiceError{Type: serviceerror.ClientErrorType, Code: "ROL-1001"}
+	suite.applyErr = applyErr
+	suite.mockStore.On("GetChangeRequest", mock.Anything, testChangeRequestID).
+		Return(suite.pendingChangeRequest(), nil).Once()
+	suite.allowApprover(true)
+	var statuses []ChangeRequestStatus
+	suite.mockStore.On("DecideChangeRequest", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		statuses = append(statuses, args.Get(1).(*ChangeRequest).Status)
+	}).Return(nil).Twice()
+
+	changeRequest, svcErr := suite.service.ApproveChangeRequest(suite.contextFor(testApprover),
+		testChangeRequestID)
+
+	suite.Nil(changeRequest)
+	suite.Equal(applyErr, svcErr)
+	suite.Equal([]ChangeRequestStatus{ChangeRequestStatusApproved, ChangeRequestStatusFailed}, statuses)
+	suite.Require().Len(suite.events, 1)
+	suite.Equal(string(event.EventTypeChangeRequestFailed), suite.events[0].Type)
+}
+
+func (suite *ChangeRequestServiceTestSuite) TestRejectChangeRequest_WithdrawnByRequester() {
+	suite.mockStore.On("GetChangeRequest", mock.Anything, testChangeRequestID).
+		Return(suite.pendingChangeRequest(), nil).Once()
+	suite.mockStore.On("DecideChangeRequest", mock.Anything, mock.MatchedBy(func(cr *ChangeRequest) bool {
+		return cr.Status == ChangeRequestStatusRejected && cr.DecidedBy == testRequester
+	})).Return(nil).Once()
+
+	changeRequest, svcErr := suite.service.RejectChangeRequest(suite.contextFor(testRequester),
+		testChangeRequestID)
+
+	suite.Nil(svcErr)
+	suite.Equal(ChangeRequestStatusRejected, changeRequest.Status)
+	suite.Empty(suite.applied)
+}
+
+func (suite *ChangeRequestServiceTestSuite) TestRejectChangeRequest_ApproverNotAllowed() {
+	suite.mockStore.On("GetChangeRequest", mock.Anything, testChangeRequestID).
+		Return(suite.pendingChangeRequest(), nil).Once()
+	suite.allowApprover(false)
+
+	changeRequest, svcErr := suite.service.RejectChangeRequest(suite.contextFor(testApprover),
+		testChangeRequestID)
+
+	suite.Nil(changeRequest)
+	suite.Equal(serviceerror.ErrorUnauthorized.Code, svcErr.Code)
+}
+
+func (suite *ChangeRequestServiceTestSuite) TestRejectChangeRequest_StoreError() {
+	suite.mockStore.On("GetChangeRequest", mock.Anything, testChangeRequestID).
+		Return(suite.pendingChangeRequest(), nil).Once()
+	suite.mockStore.On("DecideChangeRequest", mock.Anything, mock.Anything).
+		Return(errors.New("db down")).Once()
+
+	changeRequest, svcErr := suite.service.RejectChangeRequest(suite.contextFor(testRequester),
+		testChangeRequestID)
+
+	suite.Nil(changeRequest)
+	suite.Equal(serviceerror.InternalServerError.Code, svcErr.Code)
+}
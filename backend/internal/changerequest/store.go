@@ -0,0 +1,220 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package changerequest
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+	sysContext "github.com/thunder-id/thunderid/internal/system/context"
+	"github.com/thunder-id/thunderid/internal/system/database/provider"
+	dbutils "github.com/thunder-id/thunderid/internal/system/database/utils"
+	"github.com/thunder-id/thunderid/internal/system/transaction"
+)
+
+var getDBProvider = provider.GetDBProvider
+
+// changeRequestStoreInterface defines the interface for change request store operations.
+type changeRequestStoreInterface interface {
+	CreateChangeRequest(ctx context.Context, changeRequest ChangeRequest) error
+	GetChangeRequestList(ctx context.Context) ([]ChangeRequest, error)
+	GetChangeRequest(ctx context.Context, id string) (*ChangeRequest, error)
+	DecideChangeRequest(ctx context.Context, changeRequest *ChangeRequest) error
+}
+
+// changeRequestStore is the default implementation of changeRequestStoreInterface.
+type changeRequestStore struct {
+	dbProvider   provider.DBProviderInterface
+	deploymentID string
+}
+
+// newChangeRequestStore creates a new instance of changeRequestStore along with the transactioner of the
+// configuration database, which also holds the resources the change requests target.
+func newChangeRequestStore() (changeRequestStoreInterface, transaction.Transactioner, error) {
+	dbProvider := getDBProvider()
+	client, err := dbProvider.GetConfigDBClient()
+	if err != nil {
+		return nil, nil, err
+	}
+	transactioner, err := client.GetTransactioner()
+	if err != nil {
+		return nil, nil, err
+	}
+	return &changeRequestStore{
+		dbProvider:   dbProvider,
+		deploymentID: config.GetServerRuntime().Config.Server.Identifier,
+	}, transactioner, nil
+}
+
+// CreateChangeRequest persists a new change request.
+func (s *changeRequestStore) CreateChangeRequest(ctx context.Context, changeRequest ChangeRequest) error {
+	dbClient, err := s.dbProvider.GetConfigDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	if _, err := dbClient.ExecuteContext(ctx, queryCreateChangeRequest, changeRequest.ID,
+		string(changeRequest.Operation), changeRequest.ResourceID, string(changeRequest.Payload),
+		string(changeRequest.Status), changeRequest.RequestedBy, changeRequest.RequestedAt,
+		changeRequest.ExpiresAt, toNullableString(changeRequest.DecidedBy), toNullableTime(changeRequest.DecidedAt),
+		sysContext.ScopeDeploymentID(ctx, s.deploymentID)); err != nil {
+		return fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	return nil
+}
+
+// GetChangeRequestList retrieves all change requests, latest first.
+func (s *changeRequestStore) GetChangeRequestList(ctx context.Context) ([]ChangeRequest, error) {
+	dbClient, err := s.dbProvider.GetConfigDBClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	results, err := dbClient.QueryContext(ctx, queryGetChangeRequestList,
+		sysContext.ScopeDeploymentID(ctx, s.deploymentID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	changeRequests := make([]ChangeRequest, 0, len(results))
+	for _, row := range results {
+		changeRequest, err := buildChangeRequestFromResultRow(row)
+		if err != nil {
+			return nil, fmt.Errorf("failed to build change request from result row: %w", err)
+		}
+		changeRequests = append(changeRequests, *changeRequest)
+	}
+
+	return changeRequests, nil
+}
+
+// GetChangeRequest retrieves a change request by its ID.
+func (s *changeRequestStore) GetChangeRequest(ctx context.Context, id string) (*ChangeRequest, error) {
+	dbClient, err := s.dbProvider.GetConfigDBClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	results, err := dbClient.QueryContext(ctx, queryGetChangeRequestByID, id,
+		sysContext.ScopeDeploymentID(ctx, s.deploymentID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+	if len(results) == 0 {
+		return nil, ErrChangeRequestNotFound
+	}
+	if len(results) != 1 {
+		return nil, fmt.Errorf("unexpected number of results: %d", len(results))
+	}
+
+	return buildChangeRequestFromResultRow(results[0])
+}
+
+// DecideChangeRequest records the status, the decider and the decision time of a change request. The
+// decision is recorded only while the change request is pending, so that a change request is decided once.
+func (s *changeRequestStore) DecideChangeRequest(ctx context.Context, changeRequest *ChangeRequest) error {
+	dbClient, err := s.dbProvider.GetConfigDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	rowsAffected, err := dbClient.ExecuteContext(ctx, queryDecideChangeRequest, changeRequest.ID,
+		string(changeRequest.Status), toNullableString(changeRequest.DecidedBy),
+		toNullableTime(changeRequest.DecidedAt), string(ChangeRequestStatusPending),
+		sysContext.ScopeDeploymentID(ctx, s.deploymentID))
+	if err != nil {
+		return fmt.Errorf("failed to execute query: %w", err)
+	}
+	if rowsAffected == 0 {
+		return ErrChangeRequestNotPending
+	}
+
+	return nil
+}
+
+// buildChangeRequestFromResultRow constructs a ChangeRequest from a database result row.
+func buildChangeRequestFromResultRow(row map[string]interface{}) (*ChangeRequest, error) {
+	id, ok := row["id"].(string)
+	if !ok {
+		return nil, fmt.Errorf("failed to parse id as string")
+	}
+	operation, ok := row["operation"].(string)
+	if !ok {
+		return nil, fmt.Errorf("failed to parse operation as string")
+	}
+	status, ok := row["status"].(string)
+	if !ok {
+		return nil, fmt.Errorf("failed to parse status as string")
+	}
+
+	changeRequest := &ChangeRequest{
+		ID:        id,
+		Operation: Operation(operation),
+		Status:    ChangeRequestStatus(status),
+	}
+	changeRequest.ResourceID, _ = row["resource_id"].(string)
+	changeRequest.RequestedBy, _ = row["requested_by"].(string)
+	changeRequest.DecidedBy, _ = row["decided_by"].(string)
+
+	switch payload := row["payload"].(type) {
+	case string:
+		changeRequest.Payload = json.RawMessage(payload)
+	case []byte:
+		changeRequest.Payload = json.RawMessage(payload)
+	default:
+		return nil, fmt.Errorf("failed to parse payload")
+	}
+
+	var err error
+	if changeRequest.RequestedAt, err = dbutils.ParseTimeField(row["requested_at"], "requested_at"); err != nil {
+		return nil, err
+	}
+	if changeRequest.ExpiresAt, err = dbutils.ParseTimeField(row["expires_at"], "expires_at"); err != nil {
+		return nil, err
+	}
+	if row["decided_at"] != nil {
+		decidedAt, err := dbutils.ParseTimeField(row["decided_at"], "decided_at")
+		if err != nil {
+			return nil, err
+		}
+		changeRequest.DecidedAt = &decidedAt
+	}
+
+	return changeRequest, nil
+}
+
+// toNullableString maps an empty string to a SQL NULL.
+func toNullableString(value string) interface{} {
+	if value == "" {
+		return nil
+	}
+	return value
+}
+
+// toNullableTime maps a nil time to a SQL NULL.
+func toNullableTime(value *time.Time) interface{} {
+	if value == nil {
+		return nil
+	}
+	return *value
+}
@@ -0,0 +1,50 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package changerequest
+
+import "github.com/thunder-id/thunderid/internal/system/database/model"
+
+const changeRequestColumns = `ID, OPERATION, RESOURCE_ID, PAYLOAD, STATUS, REQUESTED_BY, REQUESTED_AT, ` +
+	`EXPIRES_AT, DECIDED_BY, DECIDED_AT`
+
+var (
+	// queryCreateChangeRequest is the query to create a new change request.
+	queryCreateChangeRequest = model.DBQuery{
+		ID: "CRQ-CHANGE_REQUEST_MGT-01",
+		Query: `INSERT INTO "CHANGE_REQUEST" (` + changeRequestColumns + `, DEPLOYMENT_ID) ` +
+			`VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)`,
+	}
+	// queryGetChangeRequestByID is the query to get a change request by its ID.
+	queryGetChangeRequestByID = model.DBQuery{
+		ID:    "CRQ-CHANGE_REQUEST_MGT-02",
+		Query: `SELECT ` + changeRequestColumns + ` FROM "CHANGE_REQUEST" WHERE ID = $1 AND DEPLOYMENT_ID = $2`,
+	}
+	// queryGetChangeRequestList is the query to get the list of change requests, latest first.
+	queryGetChangeRequestList = model.DBQuery{
+		ID: "CRQ-CHANGE_REQUEST_MGT-03",
+		Query: `SELECT ` + changeRequestColumns + ` FROM "CHANGE_REQUEST" WHERE DEPLOYMENT_ID = $1 ` +
+			`ORDER BY REQUESTED_AT DESC`,
+	}
+	// queryDecideChangeRequest is the query to record the decision on a change request that is still pending.
+	queryDecideChangeRequest = model.DBQuery{
+		ID: "CRQ-CHANGE_REQUEST_MGT-04",
+		Query: `UPDATE "CHANGE_REQUEST" SET STATUS = $2, DECIDED_BY = $3, DECIDED_AT = $4 ` +
+			`WHERE ID = $1 AND STATUS = $5 AND DEPLOYMENT_ID = $6`,
+	}
+)
@@ -0,0 +1,35 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package configdrift
+
+import "time"
+
+const loggerComponentName = "ConfigDriftService"
+
+// scheduledCheckLockName is the distributed lock held while a scheduled drift check runs.
+const scheduledCheckLockName = "config-drift-scheduled-check"
+
+// webhookTimeout bounds the time spent delivering a webhook alert.
+const webhookTimeout = 10 * time.Second
+
+// Prefixes of the keys of the settings captured in a baseline.
+const (
+	serverSettingPrefix = "server."
+	clientSettingPrefix = "client."
+)
@@ -0,0 +1,76 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package configdrift
+
+import (
+	"net/http"
+
+	sysutils "github.com/thunder-id/thunderid/internal/system/utils"
+)
+
+// configDriftHandler is the handler for configuration drift operations.
+type configDriftHandler struct {
+	configDriftService ConfigDriftServiceInterface
+}
+
+// newConfigDriftHandler creates a new instance of configDriftHandler.
+func newConfigDriftHandler(configDriftService ConfigDriftServiceInterface) *configDriftHandler {
+	return &configDriftHandler{
+		configDriftService: configDriftService,
+	}
+}
+
+// HandleBaselinePostRequest handles the request to approve the current settings as the baseline.
+func (h *configDriftHandler) HandleBaselinePostRequest(w http.ResponseWriter, r *http.Request) {
+	baseline, svcErr := h.configDriftService.CreateBaseline(r.Context())
+	if svcErr != nil {
+		sysutils.WriteServiceErrorResponse(w, svcErr, clientErrorStatusCodes)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(w, http.StatusCreated, baseline)
+}
+
+// HandleBaselineGetRequest handles the request to retrieve the approved baseline.
+func (h *configDriftHandler) HandleBaselineGetRequest(w http.ResponseWriter, r *http.Request) {
+	baseline, svcErr := h.configDriftService.GetBaseline(r.Context())
+	if svcErr != nil {
+		sysutils.WriteServiceErrorResponse(w, svcErr, clientErrorStatusCodes)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(w, http.StatusOK, baseline)
+}
+
+// HandleDriftGetRequest handles the request to compare the current settings to the approved baseline.
+func (h *configDriftHandler) HandleDriftGetRequest(w http.ResponseWriter, r *http.Request) {
+	report, svcErr := h.configDriftService.CheckDrift(r.Context())
+	if svcErr != nil {
+		sysutils.WriteServiceErrorResponse(w, svcErr, clientErrorStatusCodes)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(w, http.StatusOK, report)
+}
+
+// clientErrorStatusCodes maps the client errors of the handler to HTTP status codes. Other client errors are
+// returned with 400 Bad Request.
+var clientErrorStatusCodes = map[string]int{
+	ErrorBaselineNotFound.Code: http.StatusNotFound,
+}
@@ -0,0 +1,101 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package configdrift
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/system/error/apierror"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+)
+
+type HandlerTestSuite struct {
+	suite.Suite
+	mockService *ConfigDriftServiceInterfaceMock
+	handler     *configDriftHandler
+}
+
+func TestHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(HandlerTestSuite))
+}
+
+func (s *HandlerTestSuite) SetupTest() {
+	s.mockService = NewConfigDriftServiceInterfaceMock(s.T())
+	s.handler = newConfigDriftHandler(s.mockService)
+}
+
+func (s *HandlerTestSuite) TestHandleBaselinePostRequest() {
+	s.mockService.On("CreateBaseline", mock.Anything).
+		Return(&Baseline{Settings: map[string]string{"server.jwt.validity_period": "3600"}}, nil)
+
+	req := httptest.NewRequest(http.MethodPost, "/admin/config-drift/baseline", nil)
+	rr := httptest.NewRecorder()
+	s.handler.HandleBaselinePostRequest(rr, req)
+
+	s.Equal(http.StatusCreated, rr.Code)
+	var body Baseline
+	s.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &body))
+	s.Equal("3600", body.Settings["server.jwt.validity_period"])
+}
+
+func (s *HandlerTestSuite) TestHandleBaselineGetRequest_NotFound() {
+	s.mockService.On("GetBaseline", mock.Anything).Return(nil, &ErrorBaselineNotFound)
+
+	req := httptest.NewRequest(http.MethodGet, "/admin/config-drift/baseline", nil)
+	rr := httptest.NewRecorder()
+	s.handler.HandleBaselineGetRequest(rr, req)
+
+	s.Equal(http.StatusNotFound, rr.Code)
+	var body apierror.ErrorResponse
+	s.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &body))
+	s.Equal(ErrorBaselineNotFound.Code, body.Code)
+}
+
+func (s *HandlerTestSuite) TestHandleDriftGetRequest() {
+	s.mockService.On("CheckDrift", mock.Anything).Return(&DriftReport{TotalDrifts: 1, Drifts: []Drift{
+		{Setting: "server.jwt.validity_period", Change: ChangeTypeModified, BaselineValue: "3600",
+			CurrentValue: "86400"},
+	}}, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/admin/config-drift", nil)
+	rr := httptest.NewRecorder()
+	s.handler.HandleDriftGetRequest(rr, req)
+
+	s.Equal(http.StatusOK, rr.Code)
+	var body DriftReport
+	s.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &body))
+	s.Equal(1, body.TotalDrifts)
+	s.Equal(ChangeTypeModified, body.Drifts[0].Change)
+}
+
+func (s *HandlerTestSuite) TestHandleDriftGetRequest_ServerError() {
+	s.mockService.On("CheckDrift", mock.Anything).Return(nil, &serviceerror.InternalServerError)
+
+	req := httptest.NewRequest(http.MethodGet, "/admin/config-drift", nil)
+	rr := httptest.NewRecorder()
+	s.handler.HandleDriftGetRequest(rr, req)
+
+	s.Equal(http.StatusInternalServerError, rr.Code)
+}
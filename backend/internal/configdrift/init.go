@@ -0,0 +1,82 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package configdrift
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/inboundclient"
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/distlock"
+	syshttp "github.com/thunder-id/thunderid/internal/system/http"
+	"github.com/thunder-id/thunderid/internal/system/middleware"
+	"github.com/thunder-id/thunderid/internal/system/observability"
+)
+
+// Initialize initializes the configuration drift service, registers its routes and starts the
+// scheduled drift checks.
+func Initialize(
+	mux *http.ServeMux,
+	inboundClientService inboundclient.InboundClientServiceInterface,
+	observabilitySvc observability.ObservabilityServiceInterface,
+	lockManager distlock.LockManagerInterface,
+) ConfigDriftServiceInterface {
+	driftConfig := config.GetServerRuntime().Config.ConfigDrift
+	configDriftService := newConfigDriftService(newBaselineStore(), inboundClientService, observabilitySvc,
+		syshttp.NewHTTPClientWithTimeout(webhookTimeout), driftConfig.WebhookURL)
+
+	configDriftHandler := newConfigDriftHandler(configDriftService)
+	registerRoutes(mux, configDriftHandler)
+
+	startScheduledChecks(configDriftService, lockManager, time.Duration(driftConfig.CheckInterval)*time.Second)
+
+	return configDriftService
+}
+
+// registerRoutes registers the routes for configuration drift operations.
+func registerRoutes(mux *http.ServeMux, configDriftHandler *configDriftHandler) {
+	opts1 := middleware.CORSOptions{
+		AllowedMethods:   []string{"GET"},
+		AllowedHeaders:   middleware.DefaultAllowedHeaders,
+		AllowCredentials: true,
+		MaxAge:           600,
+	}
+	mux.HandleFunc(middleware.WithCORS("GET /admin/config-drift",
+		configDriftHandler.HandleDriftGetRequest, opts1))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS /admin/config-drift",
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, opts1))
+
+	opts2 := middleware.CORSOptions{
+		AllowedMethods:   []string{"GET", "POST"},
+		AllowedHeaders:   middleware.DefaultAllowedHeaders,
+		AllowCredentials: true,
+		MaxAge:           600,
+	}
+	mux.HandleFunc(middleware.WithCORS("GET /admin/config-drift/baseline",
+		configDriftHandler.HandleBaselineGetRequest, opts2))
+	mux.HandleFunc(middleware.WithCORS("POST /admin/config-drift/baseline",
+		configDriftHandler.HandleBaselinePostRequest, opts2))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS /admin/config-drift/baseline",
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, opts2))
+}
@@ -0,0 +1,70 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package configdrift detects changes to security relevant configuration, such as client authentication
+// methods, token lifetimes and allowed CORS origins, by comparing it to an approved baseline.
+package configdrift
+
+import "time"
+
+// ChangeType identifies how a setting differs from the baseline.
+type ChangeType string
+
+const (
+	// ChangeTypeAdded is a setting that is not part of the baseline, such as a setting of a new client.
+	ChangeTypeAdded ChangeType = "ADDED"
+	// ChangeTypeRemoved is a setting of the baseline that no longer exists, such as a setting of a deleted client.
+	ChangeTypeRemoved ChangeType = "REMOVED"
+	// ChangeTypeModified is a setting whose value differs from the baseline.
+	ChangeTypeModified ChangeType = "MODIFIED"
+)
+
+// Baseline represents an approved snapshot of the security relevant settings of the deployment.
+type Baseline struct {
+	// Settings maps the key of each setting, such as "server.jwt.validity_period" or
+	// "client.<id>.token_endpoint_auth_method", to its value.
+	Settings  map[string]string `json:"settings"`
+	CreatedBy string            `json:"createdBy,omitempty"`
+	CreatedAt time.Time         `json:"createdAt"`
+
+	// notifiedDigest is the digest of the drift last alerted for this baseline.
+	notifiedDigest string
+}
+
+// Drift represents a setting whose current value differs from the baseline.
+type Drift struct {
+	Setting       string     `json:"setting"`
+	Change        ChangeType `json:"change"`
+	BaselineValue string     `json:"baselineValue,omitempty"`
+	CurrentValue  string     `json:"currentValue,omitempty"`
+}
+
+// DriftReport represents the outcome of a drift check.
+type DriftReport struct {
+	BaselineCreatedAt time.Time `json:"baselineCreatedAt"`
+	TotalDrifts       int       `json:"totalDrifts"`
+	Drifts            []Drift   `json:"drifts"`
+	CheckedAt         time.Time `json:"checkedAt"`
+}
+
+// webhookEvent is the payload posted to the configured webhook when a scheduled check finds drift.
+type webhookEvent struct {
+	Event     string      `json:"event"`
+	Timestamp time.Time   `json:"timestamp"`
+	Report    DriftReport `json:"report"`
+}
@@ -0,0 +1,281 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package configdrift
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/inboundclient"
+	serverconst "github.com/thunder-id/thunderid/internal/system/constants"
+	sysContext "github.com/thunder-id/thunderid/internal/system/context"
+	"github.com/thunder-id/thunderid/internal/system/distlock"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	syshttp "github.com/thunder-id/thunderid/internal/system/http"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/internal/system/observability"
+	"github.com/thunder-id/thunderid/internal/system/observability/event"
+	"github.com/thunder-id/thunderid/internal/system/security"
+)
+
+// ConfigDriftServiceInterface defines the interface for the configuration drift service.
+type ConfigDriftServiceInterface interface {
+	CreateBaseline(ctx context.Context) (*Baseline, *serviceerror.ServiceError)
+	GetBaseline(ctx context.Context) (*Baseline, *serviceerror.ServiceError)
+	CheckDrift(ctx context.Context) (*DriftReport, *serviceerror.ServiceError)
+}
+
+// configDriftService is the default implementation of the ConfigDriftServiceInterface.
+type configDriftService struct {
+	store                baselineStoreInterface
+	inboundClientService inboundclient.InboundClientServiceInterface
+	observabilitySvc     observability.ObservabilityServiceInterface
+	httpClient           syshttp.HTTPClientInterface
+	webhookURL           string
+	now                  func() time.Time
+	logger               *log.Logger
+}
+
+// newConfigDriftService creates a new instance of configDriftService.
+func newConfigDriftService(
+	store baselineStoreInterface,
+	inboundClientService inboundclient.InboundClientServiceInterface,
+	observabilitySvc observability.ObservabilityServiceInterface,
+	httpClient syshttp.HTTPClientInterface,
+	webhookURL string,
+) *configDriftService {
+	return &configDriftService{
+		store:                store,
+		inboundClientService: inboundClientService,
+		observabilitySvc:     observabilitySvc,
+		httpClient:           httpClient,
+		webhookURL:           webhookURL,
+		now:                  time.Now,
+		logger:               log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName)),
+	}
+}
+
+// CreateBaseline captures the current security relevant settings and approves them as the baseline,
+// replacing the existing baseline.
+func (s *configDriftService) CreateBaseline(ctx context.Context) (*Baseline, *serviceerror.ServiceError) {
+	settings, err := s.collectSettings(security.WithRuntimeContext(ctx))
+	if err != nil {
+		s.logger.Error("Failed to collect the current settings", log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+
+	baseline := Baseline{
+		Settings:  settings,
+		CreatedBy: security.GetSubject(ctx),
+		CreatedAt: s.now().UTC(),
+	}
+	if err := s.store.SaveBaseline(ctx, baseline); err != nil {
+		s.logger.Error("Failed to save the baseline", log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+
+	s.logger.Info("Approved a new configuration baseline", log.Int("settings", len(settings)),
+		log.String("actorID", baseline.CreatedBy))
+	return &baseline, nil
+}
+
+// GetBaseline retrieves the approved baseline.
+func (s *configDriftService) GetBaseline(ctx context.Context) (*Baseline, *serviceerror.ServiceError) {
+	baseline, err := s.store.GetBaseline(ctx)
+	if err != nil {
+		s.logger.Error("Failed to retrieve the baseline", log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+	if baseline == nil {
+		return nil, &ErrorBaselineNotFound
+	}
+	return baseline, nil
+}
+
+// CheckDrift compares the current security relevant settings to the approved baseline.
+func (s *configDriftService) CheckDrift(ctx context.Context) (*DriftReport, *serviceerror.ServiceError) {
+	baseline, svcErr := s.GetBaseline(ctx)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+
+	report, err := s.compare(ctx, baseline)
+	if err != nil {
+		s.logger.Error("Failed to collect the current settings", log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+	return report, nil
+}
+
+// compare builds the drift report of the current settings against a baseline. The settings of every client
+// are read regardless of the organization unit of the caller, since the baseline covers the deployment.
+func (s *configDriftService) compare(ctx context.Context, baseline *Baseline) (*DriftReport, error) {
+	settings, err := s.collectSettings(security.WithRuntimeContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	drifts := diffSettings(baseline.Settings, settings)
+	return &DriftReport{
+		BaselineCreatedAt: baseline.CreatedAt,
+		TotalDrifts:       len(drifts),
+		Drifts:            drifts,
+		CheckedAt:         s.now().UTC(),
+	}, nil
+}
+
+// checkAndNotify runs a drift check and alerts the drift found, unless the same drift has already been
+// alerted for the baseline. The check is skipped when no baseline has been approved.
+func (s *configDriftService) checkAndNotify(ctx context.Context) {
+	baseline, err := s.store.GetBaseline(ctx)
+	if err != nil {
+		s.logger.Error("Failed to retrieve the baseline", log.Error(err))
+		return
+	}
+	if baseline == nil {
+		s.logger.Debug("Skipped configuration drift check as no baseline has been approved")
+		return
+	}
+
+	report, err := s.compare(ctx, baseline)
+	if err != nil {
+		s.logger.Error("Failed to collect the current settings", log.Error(err))
+		return
+	}
+
+	digest := ""
+	if report.TotalDrifts > 0 {
+		digest = computeDigest(report.Drifts)
+	}
+	if digest == baseline.notifiedDigest {
+		return
+	}
+	if digest != "" {
+		s.notify(ctx, report)
+	}
+	if err := s.store.UpdateNotifiedDigest(ctx, digest); err != nil {
+		s.logger.Error("Failed to record the alerted drift", log.Error(err))
+	}
+}
+
+// notify records the drift found by a check in the logs and the audit trail, and alerts the configured webhook.
+func (s *configDriftService) notify(ctx context.Context, report *DriftReport) {
+	settings := make([]string, 0, len(report.Drifts))
+	for _, drift := range report.Drifts {
+		settings = append(settings, drift.Setting)
+	}
+	s.logger.Warn("Configuration drifted from the approved baseline", log.Int("drifts", report.TotalDrifts),
+		log.Any("settings", settings))
+
+	if s.observabilitySvc != nil && s.observabilitySvc.IsEnabled() {
+		evt := event.NewEvent(sysContext.GetTraceID(ctx), string(event.EventTypeConfigDriftDetected),
+			event.ComponentConfigDrift).
+			WithTenantID(sysContext.GetTenantID(ctx)).
+			WithStatus(event.StatusSuccess).
+			WithData(event.DataKey.Settings, settings)
+		s.observabilitySvc.PublishEvent(evt)
+	}
+
+	s.notifyWebhook(ctx, webhookEvent{
+		Event:     string(event.EventTypeConfigDriftDetected),
+		Timestamp: report.CheckedAt,
+		Report:    *report,
+	})
+}
+
+// notifyWebhook posts a drift alert to the configured webhook, if any. Delivery failures are logged.
+func (s *configDriftService) notifyWebhook(ctx context.Context, payload webhookEvent) {
+	if s.webhookURL == "" {
+		return
+	}
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		s.logger.Error("Failed to marshal webhook payload", log.Error(err))
+		return
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.webhookURL, bytes.NewReader(body))
+	if err != nil {
+		s.logger.Error("Failed to create webhook request", log.Error(err))
+		return
+	}
+	req.Header.Set(serverconst.ContentTypeHeaderName, serverconst.ContentTypeJSON)
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		s.logger.Warn("Failed to deliver configuration drift webhook", log.Error(err))
+		return
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		s.logger.Warn("Configuration drift webhook returned an unexpected status",
+			log.Int("status", resp.StatusCode))
+	}
+}
+
+// computeDigest returns a digest that identifies a set of drifts, so that the same drift is alerted once.
+func computeDigest(drifts []Drift) string {
+	data, _ := json.Marshal(drifts)
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// startScheduledChecks runs a drift check at every interval. Each check holds a distributed lock so that
+// only one node of the deployment checks at a time.
+func startScheduledChecks(
+	service *configDriftService, lockManager distlock.LockManagerInterface, interval time.Duration,
+) {
+	if interval <= 0 {
+		return
+	}
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for range ticker.C {
+			runScheduledCheck(context.Background(), service, lockManager)
+		}
+	}()
+}
+
+// runScheduledCheck runs a single drift check. The check is skipped when another node holds the check lock.
+func runScheduledCheck(
+	ctx context.Context, service *configDriftService, lockManager distlock.LockManagerInterface,
+) {
+	ran, err := lockManager.TryWithLock(ctx, scheduledCheckLockName, func(ctx context.Context, _ int64) error {
+		service.checkAndNotify(ctx)
+		return nil
+	})
+	if err != nil {
+		service.logger.Error("Failed to run scheduled configuration drift check under the check lock",
+			log.Error(err))
+		return
+	}
+	if !ran {
+		service.logger.Debug("Skipped scheduled configuration drift check as another node is running it")
+	}
+}
@@ -0,0 +1,273 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package configdrift
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/inboundclient"
+	inboundmodel "github.com/thunder-id/thunderid/internal/inboundclient/model"
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/observability/event"
+	"github.com/thunder-id/thunderid/internal/system/security"
+	"github.com/thunder-id/thunderid/tests/mocks/httpmock"
+	"github.com/thunder-id/thunderid/tests/mocks/inboundclientmock"
+	"github.com/thunder-id/thunderid/tests/mocks/observability/observabilitymock"
+)
+
+const (
+	testClientID = "app-1"
+	testAdmin    = "admin-1"
+	testWebhook  = "https://hooks.example.com/config-drift"
+)
+
+type ConfigDriftServiceTestSuite struct {
+	suite.Suite
+	mockStore         *baselineStoreInterfaceMock
+	mockInboundClient *inboundclientmock.InboundClientServiceInterfaceMock
+	mockObservability *observabilitymock.ObservabilityServiceInterfaceMock
+	mockHTTPClient    *httpmock.HTTPClientInterfaceMock
+	service           *configDriftService
+	events            []*event.Event
+	now               time.Time
+}
+
+func TestConfigDriftServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(ConfigDriftServiceTestSuite))
+}
+
+func (suite *ConfigDriftServiceTestSuite) SetupTest() {
+	testConfig := &config.Config{}
+	testConfig.JWT.ValidityPeriod = 3600
+	testConfig.OAuth.RefreshToken.ValidityPeriod = 86400
+	_ = config.InitializeServerRuntime("", testConfig)
+
+	suite.mockStore = newBaselineStoreInterfaceMock(suite.T())
+	suite.mockInboundClient = inboundclientmock.NewInboundClientServiceInterfaceMock(suite.T())
+	suite.mockObservability = observabilitymock.NewObservabilityServiceInterfaceMock(suite.T())
+	suite.mockHTTPClient = httpmock.NewHTTPClientInterfaceMock(suite.T())
+	suite.service = newConfigDriftService(suite.mockStore, suite.mockInboundClient, suite.mockObservability,
+		suite.mockHTTPClient, "")
+	suite.now = time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	suite.service.now = func() time.Time { return suite.now }
+
+	suite.events = nil
+	suite.mockObservability.On("IsEnabled").Return(true).Maybe()
+	suite.mockObservability.On("PublishEvent", mock.Anything).Run(func(args mock.Arguments) {
+		suite.events = append(suite.events, args.Get(0).(*event.Event))
+	}).Maybe()
+}
+
+func (suite *ConfigDriftServiceTestSuite) TearDownTest() {
+	config.ResetServerRuntime()
+}
+
+// mockClients sets up a single client whose OAuth profile uses the given token endpoint auth method.
+func (suite *ConfigDriftServiceTestSuite) mockClients(authMethod string) {
+	suite.mockInboundClient.On("GetInboundClientList", mock.Anything).Return([]inboundmodel.InboundClient{
+		{ID: testClientID, AuthFlowID: "flow-1"},
+	}, nil)
+	suite.mockInboundClient.On("GetOAuthProfileByEntityID", mock.Anything, testClientID).
+		Return(&inboundmodel.OAuthProfile{
+			TokenEndpointAuthMethod: authMethod,
+			GrantTypes:              []string{"refresh_token", "authorization_code"},
+			Token: &inboundmodel.OAuthTokenConfig{
+				AccessToken: &inboundmodel.AccessTokenConfig{ValidityPeriod: 600},
+			},
+		}, nil)
+}
+
+// baselineOf returns a baseline of the current settings.
+func (suite *ConfigDriftServiceTestSuite) baselineOf(notifiedDigest string) *Baseline {
+	settings, err := suite.service.collectSettings(context.Background())
+	suite.Require().NoError(err)
+	return &Baseline{Settings: settings, CreatedAt: suite.now.Add(-time.Hour), notifiedDigest: notifiedDigest}
+}
+
+func (suite *ConfigDriftServiceTestSuite) TestCreateBaseline_CapturesServerAndClientSettings() {
+	suite.mockClients("client_secret_basic")
+	var saved Baseline
+	suite.mockStore.On("SaveBaseline", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		saved = args.Get(1).(Baseline)
+	}).Return(nil).Once()
+	ctx := security.WithSecurityContextTest(context.Background(),
+		security.NewSecurityContextForTest(testAdmin, "", "", nil, nil))
+
+	baseline, svcErr := suite.service.CreateBaseline(ctx)
+
+	suite.Nil(svcErr)
+	suite.Equal(testAdmin, baseline.CreatedBy)
+	suite.Equal(suite.now, baseline.CreatedAt)
+	suite.Equal(saved.Settings, baseline.Settings)
+	suite.Equal("3600", baseline.Settings["server.jwt.validity_period"])
+	suite.Equal("86400", baseline.Settings["server.oauth.refresh_token.validity_period"])
+	suite.Equal("client_secret_basic", baseline.Settings["client.app-1.token_endpoint_auth_method"])
+	suite.Equal("authorization_code,refresh_token", baseline.Settings["client.app-1.grant_types"])
+	suite.Equal("600", baseline.Settings["client.app-1.access_token.validity_period"])
+	suite.Equal("flow-1", baseline.Settings["client.app-1.auth_flow_id"])
+}
+
+func (suite *ConfigDriftServiceTestSuite) TestCreateBaseline_ClientWithoutOAuthProfile() {
+	suite.mockInboundClient.On("GetInboundClientList", mock.Anything).Return([]inboundmodel.InboundClient{
+		{ID: testClientID, AuthFlowID: "flow-1"},
+	}, nil).Once()
+	suite.mockInboundClient.On("GetOAuthProfileByEntityID", mock.Anything, testClientID).
+		Return(nil, inboundclient.ErrInboundClientNotFound).Once()
+	suite.mockStore.On("SaveBaseline", mock.Anything, mock.Anything).Return(nil).Once()
+
+	baseline, svcErr := suite.service.CreateBaseline(context.Background())
+
+	suite.Nil(svcErr)
+	suite.Equal("flow-1", baseline.Settings["client.app-1.auth_flow_id"])
+	suite.NotContains(baseline.Settings, "client.app-1.token_endpoint_auth_method")
+}
+
+func (suite *ConfigDriftServiceTestSuite) TestCreateBaseline_ClientListError() {
+	suite.mockInboundClient.On("GetInboundClientList", mock.Anything).Return(nil, errors.New("db error")).Once()
+
+	baseline, svcErr := suite.service.CreateBaseline(context.Background())
+
+	suite.Nil(baseline)
+	suite.Equal(serviceerror.InternalServerError.Code, svcErr.Code)
+}
+
+func (suite *ConfigDriftServiceTestSuite) TestGetBaseline_NotFound() {
+	suite.mockStore.On("GetBaseline", mock.Anything).Return(nil, nil).Once()
+
+	baseline, svcErr := suite.service.GetBaseline(context.Background())
+
+	suite.Nil(baseline)
+	suite.Equal(ErrorBaselineNotFound.Code, svcErr.Code)
+}
+
+func (suite *ConfigDriftServiceTestSuite) TestCheckDrift_ReportsChangedSettings() {
+	suite.mockClients("private_key_jwt")
+	baseline := suite.baselineOf("")
+	baseline.Settings["client.app-1.token_endpoint_auth_method"] = "client_secret_basic"
+	baseline.Settings["client.app-2.auth_flow_id"] = "flow-2"
+	delete(baseline.Settings, "client.app-1.access_token.validity_period")
+	suite.mockStore.On("GetBaseline", mock.Anything).Return(baseline, nil).Once()
+
+	report, svcErr := suite.service.CheckDrift(context.Background())
+
+	suite.Nil(svcErr)
+	suite.Equal(baseline.CreatedAt, report.BaselineCreatedAt)
+	suite.Equal(suite.now, report.CheckedAt)
+	suite.Equal(3, report.TotalDrifts)
+	suite.Equal([]Drift{
+		{Setting: "client.app-1.access_token.validity_period", Change: ChangeTypeAdded, CurrentValue: "600"},
+		{Setting: "client.app-1.token_endpoint_auth_method", Change: ChangeTypeModified,
+			BaselineValue: "client_secret_basic", CurrentValue: "private_key_jwt"},
+		{Setting: "client.app-2.auth_flow_id", Change: ChangeTypeRemoved, BaselineValue: "flow-2"},
+	}, report.Drifts)
+}
+
+func (suite *ConfigDriftServiceTestSuite) TestCheckDrift_NoBaseline() {
+	suite.mockStore.On("GetBaseline", mock.Anything).Return(nil, nil).Once()
+
+	report, svcErr := suite.service.CheckDrift(context.Background())
+
+	suite.Nil(report)
+	suite.Equal(ErrorBaselineNotFound.Code, svcErr.Code)
+}
+
+func (suite *ConfigDriftServiceTestSuite) TestCheckDrift_StoreError() {
+	suite.mockStore.On("GetBaseline", mock.Anything).Return(nil, errors.New("db error")).Once()
+
+	report, svcErr := suite.service.CheckDrift(context.Background())
+
+	suite.Nil(report)
+	suite.Equal(serviceerror.InternalServerError.Code, svcErr.Code)
+}
+
+func (suite *ConfigDriftServiceTestSuite) TestCheckAndNotify_AlertsNewDrift() {
+	suite.service.webhookURL = testWebhook
+	suite.mockClients("private_key_jwt")
+	baseline := suite.baselineOf("")
+	baseline.Settings["client.app-1.token_endpoint_auth_method"] = "client_secret_basic"
+	suite.mockStore.On("GetBaseline", mock.Anything).Return(baseline, nil).Once()
+	var payload webhookEvent
+	suite.mockHTTPClient.On("Do", mock.MatchedBy(func(req *http.Request) bool {
+		body, _ := io.ReadAll(req.Body)
+		return req.URL.String() == testWebhook && json.Unmarshal(body, &payload) == nil
+	})).Return(&http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(""))}, nil).Once()
+	var digest string
+	suite.mockStore.On("UpdateNotifiedDigest", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		digest = args.Get(1).(string)
+	}).Return(nil).Once()
+
+	suite.service.checkAndNotify(context.Background())
+
+	suite.NotEmpty(digest)
+	suite.Require().Len(suite.events, 1)
+	suite.Equal(string(event.EventTypeConfigDriftDetected), suite.events[0].Type)
+	suite.Equal([]string{"client.app-1.token_endpoint_auth_method"}, suite.events[0].Data[event.DataKey.Settings])
+	suite.Equal(string(event.EventTypeConfigDriftDetected), payload.Event)
+	suite.Equal(1, payload.Report.TotalDrifts)
+}
+
+func (suite *ConfigDriftServiceTestSuite) TestCheckAndNotify_SkipsAlertedDrift() {
+	suite.service.webhookURL = testWebhook
+	suite.mockClients("private_key_jwt")
+	baseline := suite.baselineOf("")
+	baseline.Settings["client.app-1.token_endpoint_auth_method"] = "client_secret_basic"
+	baseline.notifiedDigest = computeDigest([]Drift{{Setting: "client.app-1.token_endpoint_auth_method",
+		Change: ChangeTypeModified, BaselineValue: "client_secret_basic", CurrentValue: "private_key_jwt"}})
+	suite.mockStore.On("GetBaseline", mock.Anything).Return(baseline, nil).Once()
+
+	suite.service.checkAndNotify(context.Background())
+
+	suite.Empty(suite.events)
+	suite.mockHTTPClient.AssertNotCalled(suite.T(), "Do", mock.Anything)
+	suite.mockStore.AssertNotCalled(suite.T(), "UpdateNotifiedDigest", mock.Anything, mock.Anything)
+}
+
+func (suite *ConfigDriftServiceTestSuite) TestCheckAndNotify_ClearsDigestWhenDriftIsResolved() {
+	suite.mockClients("client_secret_basic")
+	suite.mockStore.On("GetBaseline", mock.Anything).Return(suite.baselineOf("previous-digest"), nil).Once()
+	suite.mockStore.On("UpdateNotifiedDigest", mock.Anything, "").Return(nil).Once()
+
+	suite.service.checkAndNotify(context.Background())
+
+	suite.Empty(suite.events)
+}
+
+func (suite *ConfigDriftServiceTestSuite) TestCheckAndNotify_NoBaseline() {
+	suite.mockStore.On("GetBaseline", mock.Anything).Return(nil, nil).Once()
+
+	suite.service.checkAndNotify(context.Background())
+
+	suite.mockInboundClient.AssertNotCalled(suite.T(), "GetInboundClientList", mock.Anything)
+}
+
+func (suite *ConfigDriftServiceTestSuite) TestJoinValuesIgnoresOrder() {
+	suite.Equal(joinValues([]string{"b", "a"}), joinValues([]string{"a", "b"}))
+	suite.Equal("", joinValues([]string(nil)))
+}
@@ -0,0 +1,178 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package configdrift
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/thunder-id/thunderid/internal/inboundclient"
+	inboundmodel "github.com/thunder-id/thunderid/internal/inboundclient/model"
+	"github.com/thunder-id/thunderid/internal/system/config"
+)
+
+// serverSettings lists the security relevant settings of the server configuration captured in a baseline.
+var serverSettings = []struct {
+	key   string
+	value func(cfg *config.Config) string
+}{
+	{"cors.allowed_origins", func(cfg *config.Config) string { return joinValues(cfg.CORS.AllowedOrigins.Strings()) }},
+	{"passkey.allowed_origins", func(cfg *config.Config) string { return joinValues(cfg.Passkey.AllowedOrigins) }},
+	{"jwt.issuer", func(cfg *config.Config) string { return cfg.JWT.Issuer }},
+	{"jwt.validity_period", func(cfg *config.Config) string { return formatInt(cfg.JWT.ValidityPeriod) }},
+	{"jwt.leeway", func(cfg *config.Config) string { return formatInt(cfg.JWT.Leeway) }},
+	{"oauth.refresh_token.validity_period", func(cfg *config.Config) string {
+		return formatInt(cfg.OAuth.RefreshToken.ValidityPeriod)
+	}},
+	{"oauth.refresh_token.idle_timeout", func(cfg *config.Config) string {
+		return formatInt(cfg.OAuth.RefreshToken.IdleTimeout)
+	}},
+	{"oauth.refresh_token.renew_on_grant", func(cfg *config.Config) string {
+		return strconv.FormatBool(cfg.OAuth.RefreshToken.RenewOnGrant)
+	}},
+	{"oauth.authorization_code.validity_period", func(cfg *config.Config) string {
+		return formatInt(cfg.OAuth.AuthorizationCode.ValidityPeriod)
+	}},
+	{"oauth.par.require_par", func(cfg *config.Config) string { return strconv.FormatBool(cfg.OAuth.PAR.RequirePAR) }},
+	{"oauth.dcr.insecure", func(cfg *config.Config) string { return strconv.FormatBool(cfg.OAuth.DCR.Insecure) }},
+	{"oauth.allow_wildcard_redirect_uri", func(cfg *config.Config) string {
+		return strconv.FormatBool(cfg.OAuth.AllowWildcardRedirectURI)
+	}},
+	{"flow.default_auth_flow_handle", func(cfg *config.Config) string { return cfg.Flow.DefaultAuthFlowHandle }},
+}
+
+// collectSettings captures the current values of the security relevant settings of the server configuration
+// and of every inbound client.
+func (s *configDriftService) collectSettings(ctx context.Context) (map[string]string, error) {
+	settings := make(map[string]string)
+
+	cfg := &config.GetServerRuntime().Config
+	for _, setting := range serverSettings {
+		settings[serverSettingPrefix+setting.key] = setting.value(cfg)
+	}
+
+	clients, err := s.inboundClientService.GetInboundClientList(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list inbound clients: %w", err)
+	}
+	for _, client := range clients {
+		profile, err := s.inboundClientService.GetOAuthProfileByEntityID(ctx, client.ID)
+		if err != nil && !errors.Is(err, inboundclient.ErrInboundClientNotFound) {
+			return nil, fmt.Errorf("failed to retrieve OAuth profile of client %s: %w", client.ID, err)
+		}
+		addClientSettings(settings, client, profile)
+	}
+	return settings, nil
+}
+
+// addClientSettings adds the security relevant settings of an inbound client and of its OAuth profile, if any.
+func addClientSettings(
+	settings map[string]string, client inboundmodel.InboundClient, profile *inboundmodel.OAuthProfile,
+) {
+	prefix := clientSettingPrefix + client.ID + "."
+
+	settings[prefix+"auth_flow_id"] = client.AuthFlowID
+	if client.AllowedAuthMethods != nil {
+		settings[prefix+"allowed_auth_methods.local"] = strconv.FormatBool(client.AllowedAuthMethods.Local)
+		settings[prefix+"allowed_auth_methods.passkey"] = strconv.FormatBool(client.AllowedAuthMethods.Passkey)
+		settings[prefix+"allowed_auth_methods.federated_idps"] = joinValues(client.AllowedAuthMethods.FederatedIDPs)
+	}
+	if client.UserIsolation {
+		settings[prefix+"user_isolation"] = strconv.FormatBool(client.UserIsolation)
+	}
+	if client.Assertion != nil {
+		settings[prefix+"assertion.validity_period"] = formatInt(client.Assertion.ValidityPeriod)
+	}
+	if profile == nil {
+		return
+	}
+
+	settings[prefix+"token_endpoint_auth_method"] = profile.TokenEndpointAuthMethod
+	settings[prefix+"grant_types"] = joinValues(profile.GrantTypes)
+	settings[prefix+"response_types"] = joinValues(profile.ResponseTypes)
+	settings[prefix+"redirect_uris"] = joinValues(profile.RedirectURIs)
+	settings[prefix+"pkce_required"] = strconv.FormatBool(profile.PKCERequired)
+	settings[prefix+"public_client"] = strconv.FormatBool(profile.PublicClient)
+	settings[prefix+"require_pushed_authorization_requests"] =
+		strconv.FormatBool(profile.RequirePushedAuthorizationRequests)
+	if profile.DPoPBoundAccessTokens {
+		settings[prefix+"dpop_bound_access_tokens"] = strconv.FormatBool(profile.DPoPBoundAccessTokens)
+	}
+	if profile.Token == nil {
+		return
+	}
+	if profile.Token.AccessToken != nil {
+		settings[prefix+"access_token.validity_period"] = formatInt(profile.Token.AccessToken.ValidityPeriod)
+	}
+	if profile.Token.IDToken != nil {
+		settings[prefix+"id_token.validity_period"] = formatInt(profile.Token.IDToken.ValidityPeriod)
+	}
+	if profile.Token.RefreshToken != nil {
+		settings[prefix+"refresh_token.validity_period"] = formatInt(profile.Token.RefreshToken.ValidityPeriod)
+		settings[prefix+"refresh_token.idle_timeout"] = formatInt(profile.Token.RefreshToken.IdleTimeout)
+		if renewOnGrant := profile.Token.RefreshToken.RenewOnGrant; renewOnGrant != nil {
+			settings[prefix+"refresh_token.renew_on_grant"] = strconv.FormatBool(*renewOnGrant)
+		}
+	}
+}
+
+// diffSettings returns the settings whose current values differ from the baseline, ordered by setting.
+func diffSettings(baseline, current map[string]string) []Drift {
+	drifts := []Drift{}
+	for setting, baselineValue := range baseline {
+		currentValue, ok := current[setting]
+		switch {
+		case !ok:
+			drifts = append(drifts, Drift{Setting: setting, Change: ChangeTypeRemoved, BaselineValue: baselineValue})
+		case currentValue != baselineValue:
+			drifts = append(drifts, Drift{Setting: setting, Change: ChangeTypeModified,
+				BaselineValue: baselineValue, CurrentValue: currentValue})
+		}
+	}
+	for setting, currentValue := range current {
+		if _, ok := baseline[setting]; !ok {
+			drifts = append(drifts, Drift{Setting: setting, Change: ChangeTypeAdded, CurrentValue: currentValue})
+		}
+	}
+
+	sort.Slice(drifts, func(i, j int) bool {
+		return drifts[i].Setting < drifts[j].Setting
+	})
+	return drifts
+}
+
+// joinValues renders a list setting as its sorted values separated by commas, so that reordering the
+// list is not reported as drift.
+func joinValues[T ~string](values []T) string {
+	sorted := make([]string, 0, len(values))
+	for _, v := range values {
+		sorted = append(sorted, string(v))
+	}
+	sort.Strings(sorted)
+	return strings.Join(sorted, ",")
+}
+
+// formatInt renders an integer setting.
+func formatInt(value int64) string {
+	return strconv.FormatInt(value, 10)
+}
@@ -0,0 +1,140 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package configdrift
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+	sysContext "github.com/thunder-id/thunderid/internal/system/context"
+	"github.com/thunder-id/thunderid/internal/system/database/provider"
+	dbutils "github.com/thunder-id/thunderid/internal/system/database/utils"
+)
+
+var getDBProvider = provider.GetDBProvider
+
+// baselineStoreInterface defines the interface for baseline store operations.
+type baselineStoreInterface interface {
+	SaveBaseline(ctx context.Context, baseline Baseline) error
+	GetBaseline(ctx context.Context) (*Baseline, error)
+	UpdateNotifiedDigest(ctx context.Context, digest string) error
+}
+
+// baselineStore is the config database backed implementation of baselineStoreInterface. A deployment
+// has at most one baseline.
+type baselineStore struct {
+	dbProvider   provider.DBProviderInterface
+	deploymentID string
+}
+
+// newBaselineStore creates a new instance of baselineStore.
+func newBaselineStore() baselineStoreInterface {
+	return &baselineStore{
+		dbProvider:   getDBProvider(),
+		deploymentID: config.GetServerRuntime().Config.Server.Identifier,
+	}
+}
+
+// SaveBaseline persists the baseline of the deployment, replacing the existing one. The digest of the
+// drift alerted for the previous baseline is cleared.
+func (s *baselineStore) SaveBaseline(ctx context.Context, baseline Baseline) error {
+	dbClient, err := s.dbProvider.GetConfigDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	settings, err := json.Marshal(baseline.Settings)
+	if err != nil {
+		return fmt.Errorf("failed to marshal baseline settings: %w", err)
+	}
+
+	if _, err := dbClient.ExecuteContext(ctx, querySaveBaseline, sysContext.ScopeDeploymentID(ctx, s.deploymentID),
+		string(settings), baseline.CreatedBy, baseline.CreatedAt); err != nil {
+		return fmt.Errorf("failed to execute query: %w", err)
+	}
+	return nil
+}
+
+// GetBaseline retrieves the baseline of the deployment. It returns nil when no baseline exists.
+func (s *baselineStore) GetBaseline(ctx context.Context) (*Baseline, error) {
+	dbClient, err := s.dbProvider.GetConfigDBClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	results, err := dbClient.QueryContext(ctx, queryGetBaseline, sysContext.ScopeDeploymentID(ctx, s.deploymentID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+	if len(results) == 0 {
+		return nil, nil
+	}
+
+	return buildBaselineFromResultRow(results[0])
+}
+
+// UpdateNotifiedDigest records the digest of the drift last alerted for the baseline of the deployment.
+func (s *baselineStore) UpdateNotifiedDigest(ctx context.Context, digest string) error {
+	dbClient, err := s.dbProvider.GetConfigDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	if _, err := dbClient.ExecuteContext(ctx, queryUpdateNotifiedDigest, digest,
+		sysContext.ScopeDeploymentID(ctx, s.deploymentID)); err != nil {
+		return fmt.Errorf("failed to execute query: %w", err)
+	}
+	return nil
+}
+
+// buildBaselineFromResultRow constructs a Baseline from a database result row.
+func buildBaselineFromResultRow(row map[string]interface{}) (*Baseline, error) {
+	var settingsJSON []byte
+	switch v := row["settings"].(type) {
+	case string:
+		settingsJSON = []byte(v)
+	case []byte:
+		settingsJSON = v
+	default:
+		return nil, fmt.Errorf("failed to parse settings")
+	}
+	settings := map[string]string{}
+	if err := json.Unmarshal(settingsJSON, &settings); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal settings: %w", err)
+	}
+
+	createdAt, err := dbutils.ParseTimeField(row["created_at"], "created_at")
+	if err != nil {
+		return nil, err
+	}
+
+	baseline := &Baseline{
+		Settings:  settings,
+		CreatedAt: createdAt,
+	}
+	if createdBy, ok := row["created_by"].(string); ok {
+		baseline.CreatedBy = createdBy
+	}
+	if digest, ok := row["notified_digest"].(string); ok {
+		baseline.notifiedDigest = digest
+	}
+	return baseline, nil
+}
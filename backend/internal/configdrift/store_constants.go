@@ -0,0 +1,44 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package configdrift
+
+import dbmodel "github.com/thunder-id/thunderid/internal/system/database/model"
+
+var (
+	// querySaveBaseline creates the baseline of the deployment or replaces the existing one.
+	querySaveBaseline = dbmodel.DBQuery{
+		ID: "CDQ-DRIFT_MGT-01",
+		Query: `INSERT INTO "CONFIG_DRIFT_BASELINE" (DEPLOYMENT_ID, SETTINGS, CREATED_BY, CREATED_AT) ` +
+			`VALUES ($1, $2, $3, $4) ON CONFLICT (DEPLOYMENT_ID) DO UPDATE SET SETTINGS = EXCLUDED.SETTINGS, ` +
+			`CREATED_BY = EXCLUDED.CREATED_BY, CREATED_AT = EXCLUDED.CREATED_AT, NOTIFIED_DIGEST = NULL`,
+	}
+
+	// queryGetBaseline retrieves the baseline of the deployment.
+	queryGetBaseline = dbmodel.DBQuery{
+		ID: "CDQ-DRIFT_MGT-02",
+		Query: `SELECT SETTINGS, CREATED_BY, NOTIFIED_DIGEST, CREATED_AT FROM "CONFIG_DRIFT_BASELINE" ` +
+			`WHERE DEPLOYMENT_ID = $1`,
+	}
+
+	// queryUpdateNotifiedDigest records the digest of the drift last alerted for the baseline.
+	queryUpdateNotifiedDigest = dbmodel.DBQuery{
+		ID:    "CDQ-DRIFT_MGT-03",
+		Query: `UPDATE "CONFIG_DRIFT_BASELINE" SET NOTIFIED_DIGEST = $1 WHERE DEPLOYMENT_ID = $2`,
+	}
+)
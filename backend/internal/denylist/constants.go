@@ -0,0 +1,57 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package denylist
+
+// ListType identifies the kind of values a deny list blocks.
+type ListType string
+
+// Deny list types.
+const (
+	// ListTypeUsername is the deny list of values that cannot be used as user identifiers.
+	ListTypeUsername ListType = "USERNAME"
+	// ListTypePassword is the deny list of values that cannot be used as passwords.
+	ListTypePassword ListType = "PASSWORD"
+)
+
+// MatchType defines how the value of a deny list entry is compared with a candidate value.
+type MatchType string
+
+// Deny list entry match types.
+const (
+	// MatchTypeExact denies candidate values equal to the entry value.
+	MatchTypeExact MatchType = "EXACT"
+	// MatchTypePattern denies candidate values containing a match of the entry value, which is a
+	// regular expression in RE2 syntax. Patterns can be anchored with '^' and '$' to match whole values.
+	MatchTypePattern MatchType = "PATTERN"
+)
+
+const (
+	loggerComponentName = "DenyListService"
+
+	// maxValueLength is the maximum length of the value of a deny list entry.
+	maxValueLength = 255
+	// maxBulkEntries is the maximum number of entries that can be uploaded in a single bulk request.
+	maxBulkEntries = 1000
+)
+
+// listPathSegments maps the list segment of the deny list API paths to the list type.
+var listPathSegments = map[string]ListType{
+	"usernames": ListTypeUsername,
+	"passwords": ListTypePassword,
+}
@@ -0,0 +1,119 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package denylist
+
+import (
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/i18n/core"
+)
+
+// Client errors for deny list operations.
+var (
+	// ErrorDenyListNotFound is the error returned when the requested deny list does not exist.
+	ErrorDenyListNotFound = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "DNL-1001",
+		Error: core.I18nMessage{
+			Key:          "error.denylistservice.deny_list_not_found",
+			DefaultValue: "Deny list not found",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.denylistservice.deny_list_not_found_description",
+			DefaultValue: "The deny list must be one of 'usernames' or 'passwords'",
+		},
+	}
+	// ErrorInvalidRequestFormat is the error returned when the request body is malformed.
+	ErrorInvalidRequestFormat = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "DNL-1002",
+		Error: core.I18nMessage{
+			Key:          "error.denylistservice.invalid_request_format",
+			DefaultValue: "Invalid request format",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.denylistservice.invalid_request_format_description",
+			DefaultValue: "The request body is malformed or contains invalid data",
+		},
+	}
+	// ErrorInvalidValue is the error returned when the value of an entry is empty or too long.
+	ErrorInvalidValue = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "DNL-1003",
+		Error: core.I18nMessage{
+			Key:          "error.denylistservice.invalid_value",
+			DefaultValue: "Invalid value",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.denylistservice.invalid_value_description",
+			DefaultValue: "The value must not be empty and must be at most 255 characters long",
+		},
+	}
+	// ErrorInvalidMatchType is the error returned when the match type of an entry is not supported.
+	ErrorInvalidMatchType = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "DNL-1004",
+		Error: core.I18nMessage{
+			Key:          "error.denylistservice.invalid_match_type",
+			DefaultValue: "Invalid match type",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.denylistservice.invalid_match_type_description",
+			DefaultValue: "The match type must be one of EXACT or PATTERN",
+		},
+	}
+	// ErrorInvalidPattern is the error returned when the value of a pattern entry is not a valid regular expression.
+	ErrorInvalidPattern = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "DNL-1005",
+		Error: core.I18nMessage{
+			Key:          "error.denylistservice.invalid_pattern",
+			DefaultValue: "Invalid pattern",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.denylistservice.invalid_pattern_description",
+			DefaultValue: "The value of a PATTERN entry must be a valid regular expression",
+		},
+	}
+	// ErrorDenyListEntryConflict is the error returned when the same entry already exists in the deny list.
+	ErrorDenyListEntryConflict = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "DNL-1006",
+		Error: core.I18nMessage{
+			Key:          "error.denylistservice.deny_list_entry_conflict",
+			DefaultValue: "Deny list entry conflict",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.denylistservice.deny_list_entry_conflict_description",
+			DefaultValue: "The same entry already exists in the deny list",
+		},
+	}
+	// ErrorInvalidBulkRequest is the error returned when a bulk upload has no entries or too many entries.
+	ErrorInvalidBulkRequest = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "DNL-1007",
+		Error: core.I18nMessage{
+			Key:          "error.denylistservice.invalid_bulk_request",
+			DefaultValue: "Invalid bulk request",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.denylistservice.invalid_bulk_request_description",
+			DefaultValue: "A bulk upload must contain between 1 and 1000 entries",
+		},
+	}
+)
@@ -0,0 +1,121 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package denylist
+
+import (
+	"net/http"
+
+	sysutils "github.com/thunder-id/thunderid/internal/system/utils"
+)
+
+// denyListHandler is the handler for deny list management operations.
+type denyListHandler struct {
+	denyListService DenyListServiceInterface
+}
+
+// newDenyListHandler creates a new instance of denyListHandler.
+func newDenyListHandler(denyListService DenyListServiceInterface) *denyListHandler {
+	return &denyListHandler{
+		denyListService: denyListService,
+	}
+}
+
+// HandleEntryListRequest handles the list deny list entries request.
+func (h *denyListHandler) HandleEntryListRequest(w http.ResponseWriter, r *http.Request) {
+	listType, ok := listPathSegments[r.PathValue("list")]
+	if !ok {
+		sysutils.WriteServiceErrorResponse(w, &ErrorDenyListNotFound, clientErrorStatusCodes)
+		return
+	}
+
+	entries, svcErr := h.denyListService.GetEntryList(r.Context(), listType)
+	if svcErr != nil {
+		sysutils.WriteServiceErrorResponse(w, svcErr, clientErrorStatusCodes)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(w, http.StatusOK, entries)
+}
+
+// HandleEntryPostRequest handles the create deny list entry request.
+func (h *denyListHandler) HandleEntryPostRequest(w http.ResponseWriter, r *http.Request) {
+	listType, ok := listPathSegments[r.PathValue("list")]
+	if !ok {
+		sysutils.WriteServiceErrorResponse(w, &ErrorDenyListNotFound, clientErrorStatusCodes)
+		return
+	}
+	request, err := sysutils.DecodeJSONBody[DenyListEntryRequest](r)
+	if err != nil {
+		sysutils.WriteServiceErrorResponse(w, &ErrorInvalidRequestFormat, clientErrorStatusCodes)
+		return
+	}
+
+	created, svcErr := h.denyListService.CreateEntry(r.Context(), listType, *request)
+	if svcErr != nil {
+		sysutils.WriteServiceErrorResponse(w, svcErr, clientErrorStatusCodes)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(w, http.StatusCreated, created)
+}
+
+// HandleBulkEntryPostRequest handles the bulk upload deny list entries request.
+func (h *denyListHandler) HandleBulkEntryPostRequest(w http.ResponseWriter, r *http.Request) {
+	listType, ok := listPathSegments[r.PathValue("list")]
+	if !ok {
+		sysutils.WriteServiceErrorResponse(w, &ErrorDenyListNotFound, clientErrorStatusCodes)
+		return
+	}
+	request, err := sysutils.DecodeJSONBody[BulkDenyListEntryRequest](r)
+	if err != nil {
+		sysutils.WriteServiceErrorResponse(w, &ErrorInvalidRequestFormat, clientErrorStatusCodes)
+		return
+	}
+
+	response, svcErr := h.denyListService.CreateEntries(r.Context(), listType, *request)
+	if svcErr != nil {
+		sysutils.WriteServiceErrorResponse(w, svcErr, clientErrorStatusCodes)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(w, http.StatusOK, response)
+}
+
+// HandleEntryDeleteRequest handles the delete deny list entry request.
+func (h *denyListHandler) HandleEntryDeleteRequest(w http.ResponseWriter, r *http.Request) {
+	listType, ok := listPathSegments[r.PathValue("list")]
+	if !ok {
+		sysutils.WriteServiceErrorResponse(w, &ErrorDenyListNotFound, clientErrorStatusCodes)
+		return
+	}
+
+	if svcErr := h.denyListService.DeleteEntry(r.Context(), listType, r.PathValue("id")); svcErr != nil {
+		sysutils.WriteServiceErrorResponse(w, svcErr, clientErrorStatusCodes)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(w, http.StatusNoContent, nil)
+}
+
+// clientErrorStatusCodes maps the client errors of the handler to HTTP status codes. Other client errors are
+// returned with 400 Bad Request.
+var clientErrorStatusCodes = map[string]int{
+	ErrorDenyListNotFound.Code:      http.StatusNotFound,
+	ErrorDenyListEntryConflict.Code: http.StatusConflict,
+}
@@ -0,0 +1,145 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package denylist
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/system/error/apierror"
+)
+
+type HandlerTestSuite struct {
+	suite.Suite
+	mockService *DenyListServiceInterfaceMock
+	handler     *denyListHandler
+}
+
+func TestHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(HandlerTestSuite))
+}
+
+func (s *HandlerTestSuite) SetupTest() {
+	s.mockService = NewDenyListServiceInterfaceMock(s.T())
+	s.handler = newDenyListHandler(s.mockService)
+}
+
+func (s *HandlerTestSuite) newRequest(method, list, body string) *http.Request {
+	req := httptest.NewRequest(method, "/deny-lists/"+list+"/entries", strings.NewReader(body))
+	req.SetPathValue("list", list)
+	return req
+}
+
+func (s *HandlerTestSuite) TestHandleEntryPostRequest_Success() {
+	s.mockService.On("CreateEntry", mock.Anything, ListTypeUsername,
+		DenyListEntryRequest{Value: "admin", MatchType: MatchTypeExact}).
+		Return(&DenyListEntry{ID: "entry-1", ListType: ListTypeUsername, Value: "admin",
+			MatchType: MatchTypeExact}, nil)
+
+	rr := httptest.NewRecorder()
+	s.handler.HandleEntryPostRequest(rr,
+		s.newRequest(http.MethodPost, "usernames", `{"value":"admin","matchType":"EXACT"}`))
+
+	s.Equal(http.StatusCreated, rr.Code)
+	var body map[string]interface{}
+	s.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &body))
+	s.Equal("entry-1", body["id"])
+	s.Equal("admin", body["value"])
+	s.NotContains(body, "listType")
+}
+
+func (s *HandlerTestSuite) TestHandleEntryPostRequest_InvalidBody() {
+	rr := httptest.NewRecorder()
+	s.handler.HandleEntryPostRequest(rr, s.newRequest(http.MethodPost, "passwords", `{`))
+
+	s.Equal(http.StatusBadRequest, rr.Code)
+	var errResp apierror.ErrorResponse
+	s.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &errResp))
+	s.Equal(ErrorInvalidRequestFormat.Code, errResp.Code)
+}
+
+func (s *HandlerTestSuite) TestHandleEntryPostRequest_Conflict() {
+	s.mockService.On("CreateEntry", mock.Anything, ListTypePassword, mock.Anything).
+		Return(nil, &ErrorDenyListEntryConflict)
+
+	rr := httptest.NewRecorder()
+	s.handler.HandleEntryPostRequest(rr, s.newRequest(http.MethodPost, "passwords", `{"value":"acme"}`))
+
+	s.Equal(http.StatusConflict, rr.Code)
+}
+
+func (s *HandlerTestSuite) TestHandleEntryListRequest_UnknownList() {
+	rr := httptest.NewRecorder()
+	s.handler.HandleEntryListRequest(rr, s.newRequest(http.MethodGet, "emails", ""))
+
+	s.Equal(http.StatusNotFound, rr.Code)
+	var errResp apierror.ErrorResponse
+	s.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &errResp))
+	s.Equal(ErrorDenyListNotFound.Code, errResp.Code)
+}
+
+func (s *HandlerTestSuite) TestHandleEntryListRequest_Success() {
+	s.mockService.On("GetEntryList", mock.Anything, ListTypePassword).Return(&DenyListEntryListResponse{
+		TotalResults: 1,
+		Entries:      []DenyListEntry{{ID: "entry-1", Value: "acme", MatchType: MatchTypePattern}},
+	}, nil)
+
+	rr := httptest.NewRecorder()
+	s.handler.HandleEntryListRequest(rr, s.newRequest(http.MethodGet, "passwords", ""))
+
+	s.Equal(http.StatusOK, rr.Code)
+	var body DenyListEntryListResponse
+	s.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &body))
+	s.Equal(1, body.TotalResults)
+	s.Equal(MatchTypePattern, body.Entries[0].MatchType)
+}
+
+func (s *HandlerTestSuite) TestHandleBulkEntryPostRequest_Success() {
+	s.mockService.On("CreateEntries", mock.Anything, ListTypePassword, BulkDenyListEntryRequest{
+		Entries: []DenyListEntryRequest{{Value: "letmein"}, {Value: "acme", MatchType: MatchTypePattern}},
+	}).Return(&BulkDenyListEntryResponse{Created: 1, Skipped: 1,
+		Entries: []DenyListEntry{{ID: "entry-2", Value: "acme", MatchType: MatchTypePattern}}}, nil)
+
+	rr := httptest.NewRecorder()
+	s.handler.HandleBulkEntryPostRequest(rr, s.newRequest(http.MethodPost, "passwords",
+		`{"entries":[{"value":"letmein"},{"value":"acme","matchType":"PATTERN"}]}`))
+
+	s.Equal(http.StatusOK, rr.Code)
+	var body BulkDenyListEntryResponse
+	s.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &body))
+	s.Equal(1, body.Created)
+	s.Equal(1, body.Skipped)
+}
+
+func (s *HandlerTestSuite) TestHandleEntryDeleteRequest() {
+	s.mockService.On("DeleteEntry", mock.Anything, ListTypeUsername, "entry-1").Return(nil)
+
+	req := s.newRequest(http.MethodDelete, "usernames", "")
+	req.SetPathValue("id", "entry-1")
+	rr := httptest.NewRecorder()
+	s.handler.HandleEntryDeleteRequest(rr, req)
+
+	s.Equal(http.StatusNoContent, rr.Code)
+}
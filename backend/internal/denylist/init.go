@@ -0,0 +1,83 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package denylist
+
+import (
+	"net/http"
+
+	"github.com/thunder-id/thunderid/internal/system/middleware"
+)
+
+// Initialize initializes the deny list service and registers its routes.
+func Initialize(mux *http.ServeMux) (DenyListServiceInterface, error) {
+	store, transactioner, err := newDenyListStore()
+	if err != nil {
+		return nil, err
+	}
+	denyListService := newDenyListService(store, transactioner)
+
+	denyListHandler := newDenyListHandler(denyListService)
+	registerRoutes(mux, denyListHandler)
+
+	return denyListService, nil
+}
+
+// registerRoutes registers the routes for deny list management operations.
+func registerRoutes(mux *http.ServeMux, denyListHandler *denyListHandler) {
+	opts1 := middleware.CORSOptions{
+		AllowedMethods:   []string{"GET", "POST"},
+		AllowedHeaders:   middleware.DefaultAllowedHeaders,
+		AllowCredentials: true,
+		MaxAge:           600,
+	}
+	mux.HandleFunc(middleware.WithCORS("GET /deny-lists/{list}/entries",
+		denyListHandler.HandleEntryListRequest, opts1))
+	mux.HandleFunc(middleware.WithCORS("POST /deny-lists/{list}/entries",
+		denyListHandler.HandleEntryPostRequest, opts1))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS /deny-lists/{list}/entries",
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, opts1))
+
+	opts2 := middleware.CORSOptions{
+		AllowedMethods:   []string{"POST"},
+		AllowedHeaders:   middleware.DefaultAllowedHeaders,
+		AllowCredentials: true,
+		MaxAge:           600,
+	}
+	mux.HandleFunc(middleware.WithCORS("POST /deny-lists/{list}/entries/bulk",
+		denyListHandler.HandleBulkEntryPostRequest, opts2))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS /deny-lists/{list}/entries/bulk",
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, opts2))
+
+	opts3 := middleware.CORSOptions{
+		AllowedMethods:   []string{"DELETE"},
+		AllowedHeaders:   middleware.DefaultAllowedHeaders,
+		AllowCredentials: true,
+		MaxAge:           600,
+	}
+	mux.HandleFunc(middleware.WithCORS("DELETE /deny-lists/{list}/entries/{id}",
+		denyListHandler.HandleEntryDeleteRequest, opts3))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS /deny-lists/{list}/entries/{id}",
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, opts3))
+}
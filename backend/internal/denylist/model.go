@@ -0,0 +1,56 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package denylist provides deployment wide deny lists of usernames and passwords that users are not
+// allowed to use.
+package denylist
+
+// DenyListEntry represents a value blocked by a deny list.
+type DenyListEntry struct {
+	ID            string    `json:"id"`
+	ListType      ListType  `json:"-"`
+	Value         string    `json:"value"`
+	MatchType     MatchType `json:"matchType"`
+	CaseSensitive bool      `json:"caseSensitive"`
+}
+
+// DenyListEntryRequest represents the request body for creating a deny list entry.
+type DenyListEntryRequest struct {
+	Value         string    `json:"value"`
+	MatchType     MatchType `json:"matchType,omitempty"`
+	CaseSensitive bool      `json:"caseSensitive,omitempty"`
+}
+
+// DenyListEntryListResponse represents the response for listing the entries of a deny list.
+type DenyListEntryListResponse struct {
+	TotalResults int             `json:"totalResults"`
+	Entries      []DenyListEntry `json:"entries"`
+}
+
+// BulkDenyListEntryRequest represents the request body for uploading entries to a deny list at once.
+type BulkDenyListEntryRequest struct {
+	Entries []DenyListEntryRequest `json:"entries"`
+}
+
+// BulkDenyListEntryResponse represents the response for a bulk upload of deny list entries. Entries
+// that already exist in the deny list are skipped.
+type BulkDenyListEntryResponse struct {
+	Created int             `json:"created"`
+	Skipped int             `json:"skipped"`
+	Entries []DenyListEntry `json:"entries"`
+}
@@ -0,0 +1,306 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package denylist
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/internal/system/transaction"
+	"github.com/thunder-id/thunderid/internal/system/utils"
+)
+
+// DenyListServiceInterface defines the interface for the deny list service.
+type DenyListServiceInterface interface {
+	GetEntryList(ctx context.Context, listType ListType) (*DenyListEntryListResponse, *serviceerror.ServiceError)
+	CreateEntry(ctx context.Context, listType ListType,
+		request DenyListEntryRequest) (*DenyListEntry, *serviceerror.ServiceError)
+	CreateEntries(ctx context.Context, listType ListType,
+		request BulkDenyListEntryRequest) (*BulkDenyListEntryResponse, *serviceerror.ServiceError)
+	DeleteEntry(ctx context.Context, listType ListType, id string) *serviceerror.ServiceError
+	IsDenied(ctx context.Context, listType ListType, value string) (bool, *serviceerror.ServiceError)
+}
+
+// denyListService is the default implementation of the DenyListServiceInterface.
+type denyListService struct {
+	store         denyListStoreInterface
+	transactioner transaction.Transactioner
+	logger        *log.Logger
+}
+
+// newDenyListService creates a new instance of denyListService.
+func newDenyListService(
+	store denyListStoreInterface,
+	transactioner transaction.Transactioner,
+) DenyListServiceInterface {
+	return &denyListService{
+		store:         store,
+		transactioner: transactioner,
+		logger:        log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName)),
+	}
+}
+
+// GetEntryList retrieves the entries of a deny list.
+func (s *denyListService) GetEntryList(
+	ctx context.Context, listType ListType) (*DenyListEntryListResponse, *serviceerror.ServiceError) {
+	entries, svcErr := s.getEntries(ctx, listType)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+
+	return &DenyListEntryListResponse{
+		TotalResults: len(entries),
+		Entries:      entries,
+	}, nil
+}
+
+// CreateEntry adds an entry to a deny list.
+func (s *denyListService) CreateEntry(ctx context.Context, listType ListType,
+	request DenyListEntryRequest) (*DenyListEntry, *serviceerror.ServiceError) {
+	entry, svcErr := buildEntry(listType, request)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	existing, svcErr := s.getEntries(ctx, listType)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	for _, e := range existing {
+		if entryKey(e) == entryKey(*entry) {
+			return nil, &ErrorDenyListEntryConflict
+		}
+	}
+
+	if entry.ID, svcErr = s.generateID(); svcErr != nil {
+		return nil, svcErr
+	}
+	if err := s.store.CreateEntry(ctx, *entry); err != nil {
+		s.logger.Error("Failed to create deny list entry", log.Error(err),
+			log.String("listType", string(listType)))
+		return nil, &serviceerror.InternalServerError
+	}
+
+	s.logger.Debug("Created deny list entry", log.String("entryID", entry.ID),
+		log.String("listType", string(listType)))
+	return entry, nil
+}
+
+// CreateEntries adds the entries of a bulk upload to a deny list in a single transaction. The upload is
+// rejected as a whole if any entry is invalid, while entries that already exist in the deny list or that
+// are repeated within the upload are skipped.
+func (s *denyListService) CreateEntries(ctx context.Context, listType ListType,
+	request BulkDenyListEntryRequest) (*BulkDenyListEntryResponse, *serviceerror.ServiceError) {
+	if !isValidListType(listType) {
+		return nil, &ErrorDenyListNotFound
+	}
+	if len(request.Entries) == 0 || len(request.Entries) > maxBulkEntries {
+		return nil, &ErrorInvalidBulkRequest
+	}
+
+	entries := make([]DenyListEntry, 0, len(request.Entries))
+	for i, entryRequest := range request.Entries {
+		entry, svcErr := buildEntry(listType, entryRequest)
+		if svcErr != nil {
+			bulkErr := *svcErr
+			bulkErr.ErrorDescription.DefaultValue += fmt.Sprintf(": entry %d", i)
+			return nil, &bulkErr
+		}
+		entries = append(entries, *entry)
+	}
+
+	existing, svcErr := s.getEntries(ctx, listType)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	seen := make(map[string]bool, len(existing)+len(entries))
+	for _, e := range existing {
+		seen[entryKey(e)] = true
+	}
+
+	response := &BulkDenyListEntryResponse{Entries: make([]DenyListEntry, 0, len(entries))}
+	for _, entry := range entries {
+		key := entryKey(entry)
+		if seen[key] {
+			response.Skipped++
+			continue
+		}
+		seen[key] = true
+		if entry.ID, svcErr = s.generateID(); svcErr != nil {
+			return nil, svcErr
+		}
+		response.Entries = append(response.Entries, entry)
+	}
+
+	err := s.transactioner.Transact(ctx, func(txCtx context.Context) error {
+		for _, entry := range response.Entries {
+			if err := s.store.CreateEntry(txCtx, entry); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		s.logger.Error("Failed to create deny list entries", log.Error(err),
+			log.String("listType", string(listType)))
+		return nil, &serviceerror.InternalServerError
+	}
+
+	response.Created = len(response.Entries)
+	s.logger.Debug("Uploaded deny list entries", log.String("listType", string(listType)),
+		log.Int("created", response.Created), log.Int("skipped", response.Skipped))
+	return response, nil
+}
+
+// DeleteEntry deletes an entry of a deny list. Deleting an entry that does not exist succeeds.
+func (s *denyListService) DeleteEntry(
+	ctx context.Context, listType ListType, id string) *serviceerror.ServiceError {
+	if !isValidListType(listType) {
+		return &ErrorDenyListNotFound
+	}
+	if strings.TrimSpace(id) == "" {
+		return nil
+	}
+
+	if err := s.store.DeleteEntry(ctx, listType, id); err != nil {
+		s.logger.Error("Failed to delete deny list entry", log.Error(err), log.String("entryID", id))
+		return &serviceerror.InternalServerError
+	}
+	return nil
+}
+
+// IsDenied reports whether a value is blocked by any entry of a deny list.
+func (s *denyListService) IsDenied(
+	ctx context.Context, listType ListType, value string) (bool, *serviceerror.ServiceError) {
+	entries, svcErr := s.getEntries(ctx, listType)
+	if svcErr != nil {
+		return false, svcErr
+	}
+
+	for _, entry := range entries {
+		matched, err := matches(entry, value)
+		if err != nil {
+			s.logger.Warn("Skipping deny list entry with an invalid pattern", log.Error(err),
+				log.String("entryID", entry.ID))
+			continue
+		}
+		if matched {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// getEntries retrieves the entries of a deny list and maps store errors to service errors.
+func (s *denyListService) getEntries(
+	ctx context.Context, listType ListType) ([]DenyListEntry, *serviceerror.ServiceError) {
+	if !isValidListType(listType) {
+		return nil, &ErrorDenyListNotFound
+	}
+
+	entries, err := s.store.GetEntryList(ctx, listType)
+	if err != nil {
+		s.logger.Error("Failed to get deny list entries", log.Error(err),
+			log.String("listType", string(listType)))
+		return nil, &serviceerror.InternalServerError
+	}
+	return entries, nil
+}
+
+// generateID generates the ID of a new deny list entry.
+func (s *denyListService) generateID() (string, *serviceerror.ServiceError) {
+	id, err := utils.GenerateUUIDv7()
+	if err != nil {
+		s.logger.Error("Failed to generate ID for deny list entry", log.Error(err))
+		return "", &serviceerror.InternalServerError
+	}
+	return id, nil
+}
+
+// buildEntry validates an entry request and builds the entry to store. The match type defaults to EXACT,
+// and the values of case insensitive exact entries are stored in lowercase.
+func buildEntry(listType ListType, request DenyListEntryRequest) (*DenyListEntry, *serviceerror.ServiceError) {
+	if !isValidListType(listType) {
+		return nil, &ErrorDenyListNotFound
+	}
+
+	entry := &DenyListEntry{
+		ListType:      listType,
+		Value:         strings.TrimSpace(request.Value),
+		MatchType:     MatchType(strings.ToUpper(strings.TrimSpace(string(request.MatchType)))),
+		CaseSensitive: request.CaseSensitive,
+	}
+	if entry.MatchType == "" {
+		entry.MatchType = MatchTypeExact
+	}
+	if entry.Value == "" || len(entry.Value) > maxValueLength {
+		return nil, &ErrorInvalidValue
+	}
+
+	switch entry.MatchType {
+	case MatchTypeExact:
+		if !entry.CaseSensitive {
+			entry.Value = strings.ToLower(entry.Value)
+		}
+	case MatchTypePattern:
+		if _, err := compilePattern(*entry); err != nil {
+			return nil, &ErrorInvalidPattern
+		}
+	default:
+		return nil, &ErrorInvalidMatchType
+	}
+	return entry, nil
+}
+
+// matches reports whether a value is blocked by a deny list entry.
+func matches(entry DenyListEntry, value string) (bool, error) {
+	if entry.MatchType == MatchTypePattern {
+		pattern, err := compilePattern(entry)
+		if err != nil {
+			return false, err
+		}
+		return pattern.MatchString(value), nil
+	}
+
+	if entry.CaseSensitive {
+		return value == entry.Value, nil
+	}
+	return strings.EqualFold(value, entry.Value), nil
+}
+
+// compilePattern compiles the regular expression of a pattern entry, honoring its case sensitivity.
+func compilePattern(entry DenyListEntry) (*regexp.Regexp, error) {
+	if entry.CaseSensitive {
+		return regexp.Compile(entry.Value)
+	}
+	return regexp.Compile("(?i)" + entry.Value)
+}
+
+// entryKey returns the key that identifies duplicate entries within a deny list.
+func entryKey(entry DenyListEntry) string {
+	return fmt.Sprintf("%s|%t|%s", entry.MatchType, entry.CaseSensitive, entry.Value)
+}
+
+// isValidListType reports whether the list type is a supported deny list.
+func isValidListType(listType ListType) bool {
+	return listType == ListTypeUsername || listType == ListTypePassword
+}
@@ -0,0 +1,204 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package denylist
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/transaction"
+)
+
+type DenyListServiceTestSuite struct {
+	suite.Suite
+	mockStore *denyListStoreInterfaceMock
+	service   DenyListServiceInterface
+}
+
+func TestDenyListServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(DenyListServiceTestSuite))
+}
+
+func (suite *DenyListServiceTestSuite) SetupTest() {
+	suite.mockStore = newDenyListStoreInterfaceMock(suite.T())
+	suite.service = newDenyListService(suite.mockStore, transaction.NewNoOpTransactioner())
+}
+
+func (suite *DenyListServiceTestSuite) TestCreateEntry_DefaultsToCaseInsensitiveExactMatch() {
+	suite.mockStore.On("GetEntryList", mock.Anything, ListTypeUsername).Return([]DenyListEntry{}, nil)
+	suite.mockStore.On("CreateEntry", mock.Anything, mock.MatchedBy(func(entry DenyListEntry) bool {
+		return entry.ID != "" && entry.ListType == ListTypeUsername && entry.Value == "admin" &&
+			entry.MatchType == MatchTypeExact && !entry.CaseSensitive
+	})).Return(nil)
+
+	entry, svcErr := suite.service.CreateEntry(suite.T().Context(), ListTypeUsername,
+		DenyListEntryRequest{Value: " Admin "})
+
+	suite.Nil(svcErr)
+	suite.Equal("admin", entry.Value)
+	suite.Equal(MatchTypeExact, entry.MatchType)
+}
+
+func (suite *DenyListServiceTestSuite) TestCreateEntry_Conflict() {
+	suite.mockStore.On("GetEntryList", mock.Anything, ListTypeUsername).Return([]DenyListEntry{
+		{ID: "entry-1", ListType: ListTypeUsername, Value: "admin", MatchType: MatchTypeExact},
+	}, nil)
+
+	entry, svcErr := suite.service.CreateEntry(suite.T().Context(), ListTypeUsername,
+		DenyListEntryRequest{Value: "ADMIN", MatchType: "exact"})
+
+	suite.Nil(entry)
+	suite.Equal(ErrorDenyListEntryConflict.Code, svcErr.Code)
+	suite.mockStore.AssertNotCalled(suite.T(), "CreateEntry", mock.Anything, mock.Anything)
+}
+
+func (suite *DenyListServiceTestSuite) TestCreateEntry_InvalidRequests() {
+	testCases := []struct {
+		name     string
+		listType ListType
+		request  DenyListEntryRequest
+		expected string
+	}{
+		{"UnknownList", ListType("EMAIL"), DenyListEntryRequest{Value: "admin"}, ErrorDenyListNotFound.Code},
+		{"EmptyValue", ListTypeUsername, DenyListEntryRequest{Value: "  "}, ErrorInvalidValue.Code},
+		{"UnknownMatchType", ListTypeUsername, DenyListEntryRequest{Value: "admin", MatchType: "PREFIX"},
+			ErrorInvalidMatchType.Code},
+		{"InvalidPattern", ListTypePassword, DenyListEntryRequest{Value: "acme(", MatchType: MatchTypePattern},
+			ErrorInvalidPattern.Code},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			entry, svcErr := suite.service.CreateEntry(suite.T().Context(), tc.listType, tc.request)
+
+			suite.Nil(entry)
+			suite.Require().NotNil(svcErr)
+			suite.Equal(tc.expected, svcErr.Code)
+		})
+	}
+}
+
+func (suite *DenyListServiceTestSuite) TestCreateEntries_SkipsDuplicates() {
+	suite.mockStore.On("GetEntryList", mock.Anything, ListTypePassword).Return([]DenyListEntry{
+		{ID: "entry-1", ListType: ListTypePassword, Value: "password", MatchType: MatchTypeExact},
+	}, nil)
+	suite.mockStore.On("CreateEntry", mock.Anything, mock.Anything).Return(nil).Twice()
+
+	response, svcErr := suite.service.CreateEntries(suite.T().Context(), ListTypePassword,
+		BulkDenyListEntryRequest{Entries: []DenyListEntryRequest{
+			{Value: "Password"},
+			{Value: "letmein"},
+			{Value: "acme", MatchType: MatchTypePattern},
+			{Value: "LETMEIN"},
+		}})
+
+	suite.Nil(svcErr)
+	suite.Equal(2, response.Created)
+	suite.Equal(2, response.Skipped)
+	suite.Len(response.Entries, 2)
+}
+
+func (suite *DenyListServiceTestSuite) TestCreateEntries_RejectsInvalidEntry() {
+	response, svcErr := suite.service.CreateEntries(suite.T().Context(), ListTypePassword,
+		BulkDenyListEntryRequest{Entries: []DenyListEntryRequest{
+			{Value: "letmein"},
+			{Value: "[", MatchType: MatchTypePattern},
+		}})
+
+	suite.Nil(response)
+	suite.Equal(ErrorInvalidPattern.Code, svcErr.Code)
+	suite.Contains(svcErr.ErrorDescription.DefaultValue, "entry 1")
+	suite.mockStore.AssertNotCalled(suite.T(), "GetEntryList", mock.Anything, mock.Anything)
+}
+
+func (suite *DenyListServiceTestSuite) TestCreateEntries_RejectsEmptyAndOversizedUploads() {
+	tooMany := BulkDenyListEntryRequest{}
+	for i := 0; i <= maxBulkEntries; i++ {
+		tooMany.Entries = append(tooMany.Entries, DenyListEntryRequest{Value: "value"})
+	}
+
+	for _, request := range []BulkDenyListEntryRequest{{}, tooMany} {
+		response, svcErr := suite.service.CreateEntries(suite.T().Context(), ListTypeUsername, request)
+
+		suite.Nil(response)
+		suite.Equal(ErrorInvalidBulkRequest.Code, svcErr.Code)
+	}
+}
+
+func (suite *DenyListServiceTestSuite) TestCreateEntries_StoreError() {
+	suite.mockStore.On("GetEntryList", mock.Anything, ListTypeUsername).Return([]DenyListEntry{}, nil)
+	suite.mockStore.On("CreateEntry", mock.Anything, mock.Anything).Return(errors.New("db down"))
+
+	response, svcErr := suite.service.CreateEntries(suite.T().Context(), ListTypeUsername,
+		BulkDenyListEntryRequest{Entries: []DenyListEntryRequest{{Value: "root"}}})
+
+	suite.Nil(response)
+	suite.Equal(serviceerror.InternalServerError.Code, svcErr.Code)
+}
+
+func (suite *DenyListServiceTestSuite) TestIsDenied() {
+	suite.mockStore.On("GetEntryList", mock.Anything, ListTypePassword).Return([]DenyListEntry{
+		{ID: "entry-1", Value: "password", MatchType: MatchTypeExact},
+		{ID: "entry-2", Value: "Secret", MatchType: MatchTypeExact, CaseSensitive: true},
+		{ID: "entry-3", Value: "acme", MatchType: MatchTypePattern},
+		{ID: "entry-4", Value: "^Corp[0-9]+$", MatchType: MatchTypePattern, CaseSensitive: true},
+	}, nil)
+
+	testCases := []struct {
+		value  string
+		denied bool
+	}{
+		{"PassWord", true},
+		{"password1", false},
+		{"Secret", true},
+		{"secret", false},
+		{"MyACME2024!", true},
+		{"Corp2024", true},
+		{"corp2024", false},
+		{"xCorp2024", false},
+	}
+
+	for _, tc := range testCases {
+		denied, svcErr := suite.service.IsDenied(suite.T().Context(), ListTypePassword, tc.value)
+
+		suite.Nil(svcErr)
+		suite.Equal(tc.denied, denied, tc.value)
+	}
+}
+
+func (suite *DenyListServiceTestSuite) TestIsDenied_StoreError() {
+	suite.mockStore.On("GetEntryList", mock.Anything, ListTypeUsername).Return(nil, errors.New("db down"))
+
+	denied, svcErr := suite.service.IsDenied(suite.T().Context(), ListTypeUsername, "admin")
+
+	suite.False(denied)
+	suite.Equal(serviceerror.InternalServerError.Code, svcErr.Code)
+}
+
+func (suite *DenyListServiceTestSuite) TestDeleteEntry() {
+	suite.mockStore.On("DeleteEntry", mock.Anything, ListTypeUsername, "entry-1").Return(nil)
+
+	suite.Nil(suite.service.DeleteEntry(suite.T().Context(), ListTypeUsername, "entry-1"))
+	suite.Equal(ErrorDenyListNotFound.Code,
+		suite.service.DeleteEntry(suite.T().Context(), ListType("EMAIL"), "entry-1").Code)
+}
@@ -0,0 +1,163 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package denylist
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+	sysContext "github.com/thunder-id/thunderid/internal/system/context"
+	"github.com/thunder-id/thunderid/internal/system/database/provider"
+	"github.com/thunder-id/thunderid/internal/system/transaction"
+)
+
+var getDBProvider = provider.GetDBProvider
+
+// denyListStoreInterface defines the interface for deny list store operations.
+type denyListStoreInterface interface {
+	CreateEntry(ctx context.Context, entry DenyListEntry) error
+	GetEntryList(ctx context.Context, listType ListType) ([]DenyListEntry, error)
+	DeleteEntry(ctx context.Context, listType ListType, id string) error
+}
+
+// denyListStore is the default implementation of denyListStoreInterface.
+type denyListStore struct {
+	dbProvider   provider.DBProviderInterface
+	deploymentID string
+}
+
+// newDenyListStore creates a new instance of denyListStore.
+func newDenyListStore() (denyListStoreInterface, transaction.Transactioner, error) {
+	dbProvider := getDBProvider()
+	transactioner, err := dbProvider.GetConfigDBTransactioner()
+	if err != nil {
+		return nil, nil, err
+	}
+	return &denyListStore{
+		dbProvider:   dbProvider,
+		deploymentID: config.GetServerRuntime().Config.Server.Identifier,
+	}, transactioner, nil
+}
+
+// CreateEntry persists a new deny list entry.
+func (s *denyListStore) CreateEntry(ctx context.Context, entry DenyListEntry) error {
+	dbClient, err := s.dbProvider.GetConfigDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	_, err = dbClient.ExecuteContext(ctx, queryCreateDenyListEntry, entry.ID, string(entry.ListType), entry.Value,
+		string(entry.MatchType), entry.CaseSensitive, sysContext.ScopeDeploymentID(ctx, s.deploymentID))
+	if err != nil {
+		return fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	return nil
+}
+
+// GetEntryList retrieves the entries of a deny list ordered by value.
+func (s *denyListStore) GetEntryList(ctx context.Context, listType ListType) ([]DenyListEntry, error) {
+	dbClient, err := s.dbProvider.GetConfigDBClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	results, err := dbClient.QueryContext(ctx, queryGetDenyListEntryList, string(listType),
+		sysContext.ScopeDeploymentID(ctx, s.deploymentID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	entries := make([]DenyListEntry, 0, len(results))
+	for _, row := range results {
+		entry, err := buildDenyListEntryFromResultRow(row)
+		if err != nil {
+			return nil, fmt.Errorf("failed to build deny list entry from result row: %w", err)
+		}
+		entries = append(entries, *entry)
+	}
+
+	return entries, nil
+}
+
+// DeleteEntry deletes an entry of a deny list by its ID.
+func (s *denyListStore) DeleteEntry(ctx context.Context, listType ListType, id string) error {
+	dbClient, err := s.dbProvider.GetConfigDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	if _, err := dbClient.ExecuteContext(ctx, queryDeleteDenyListEntry, id, string(listType),
+		sysContext.ScopeDeploymentID(ctx, s.deploymentID)); err != nil {
+		return fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	return nil
+}
+
+// buildDenyListEntryFromResultRow constructs a DenyListEntry from a database result row.
+func buildDenyListEntryFromResultRow(row map[string]interface{}) (*DenyListEntry, error) {
+	id, ok := row["id"].(string)
+	if !ok {
+		return nil, fmt.Errorf("failed to parse id as string")
+	}
+	listType, ok := row["list_type"].(string)
+	if !ok {
+		return nil, fmt.Errorf("failed to parse list_type as string")
+	}
+	value, ok := row["value"].(string)
+	if !ok {
+		return nil, fmt.Errorf("failed to parse value as string")
+	}
+	matchType, ok := row["match_type"].(string)
+	if !ok {
+		return nil, fmt.Errorf("failed to parse match_type as string")
+	}
+	caseSensitive, err := parseBool(row["case_sensitive"], "case_sensitive")
+	if err != nil {
+		return nil, err
+	}
+
+	return &DenyListEntry{
+		ID:            id,
+		ListType:      ListType(listType),
+		Value:         value,
+		MatchType:     MatchType(matchType),
+		CaseSensitive: caseSensitive,
+	}, nil
+}
+
+// parseBool parses a boolean field from the database result. SQLite stores booleans as integers.
+func parseBool(value interface{}, fieldName string) (bool, error) {
+	switch v := value.(type) {
+	case bool:
+		return v, nil
+	case int64:
+		return v != 0, nil
+	case float64:
+		return v != 0, nil
+	case string:
+		return v == "1" || v == "true", nil
+	case []byte:
+		return string(v) == "1" || string(v) == "true", nil
+	default:
+		return false, fmt.Errorf("failed to parse %s as bool", fieldName)
+	}
+}
@@ -0,0 +1,43 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package denylist
+
+import "github.com/thunder-id/thunderid/internal/system/database/model"
+
+const denyListEntryColumns = `ID, LIST_TYPE, VALUE, MATCH_TYPE, CASE_SENSITIVE`
+
+var (
+	// queryCreateDenyListEntry is the query to create a new deny list entry.
+	queryCreateDenyListEntry = model.DBQuery{
+		ID: "DLQ-DENY_LIST_MGT-01",
+		Query: `INSERT INTO "DENY_LIST_ENTRY" (` + denyListEntryColumns + `, DEPLOYMENT_ID) ` +
+			`VALUES ($1, $2, $3, $4, $5, $6)`,
+	}
+	// queryGetDenyListEntryList is the query to get the entries of a deny list.
+	queryGetDenyListEntryList = model.DBQuery{
+		ID: "DLQ-DENY_LIST_MGT-02",
+		Query: `SELECT ` + denyListEntryColumns + ` FROM "DENY_LIST_ENTRY" ` +
+			`WHERE LIST_TYPE = $1 AND DEPLOYMENT_ID = $2 ORDER BY VALUE`,
+	}
+	// queryDeleteDenyListEntry is the query to delete an entry of a deny list by its ID.
+	queryDeleteDenyListEntry = model.DBQuery{
+		ID:    "DLQ-DENY_LIST_MGT-03",
+		Query: `DELETE FROM "DENY_LIST_ENTRY" WHERE ID = $1 AND LIST_TYPE = $2 AND DEPLOYMENT_ID = $3`,
+	}
+)
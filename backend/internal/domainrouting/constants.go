@@ -0,0 +1,44 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package domainrouting
+
+// Domain route statuses.
+const (
+	// RouteStatusPendingVerification indicates that the ownership of the domain is not verified yet.
+	// Pending routes are not used when routing sign-ins.
+	RouteStatusPendingVerification = "PENDING_VERIFICATION"
+	// RouteStatusActive indicates that the ownership of the domain is verified and the route is in use.
+	RouteStatusActive = "ACTIVE"
+)
+
+const (
+	loggerComponentName = "DomainRoutingService"
+
+	// wildcardPrefix is the prefix of a domain pattern that matches every subdomain of a domain.
+	wildcardPrefix = "*."
+	// verificationRecordPrefix is prepended to the domain to build the name of the DNS TXT record
+	// holding the verification value.
+	verificationRecordPrefix = "_thunder-verification."
+	// verificationValuePrefix is prepended to the verification token to build the expected TXT value.
+	verificationValuePrefix = "thunder-verification="
+	// maxDomainLength is the maximum length of a domain name.
+	maxDomainLength = 253
+	// maxFlowBranchLength is the maximum length of a flow branch name.
+	maxFlowBranchLength = 100
+)
@@ -0,0 +1,151 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package domainrouting
+
+import (
+	"errors"
+
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/i18n/core"
+)
+
+// ErrDomainRouteNotFound is returned when the domain route is not found in the system.
+var ErrDomainRouteNotFound = errors.New("domain route not found")
+
+// Client errors for domain routing operations.
+var (
+	// ErrorDomainRouteNotFound is the error returned when a domain route is not found.
+	ErrorDomainRouteNotFound = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "DRT-1001",
+		Error: core.I18nMessage{
+			Key:          "error.domainroutingservice.domain_route_not_found",
+			DefaultValue: "Domain route not found",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.domainroutingservice.domain_route_not_found_description",
+			DefaultValue: "The requested domain route could not be found",
+		},
+	}
+	// ErrorInvalidRequestFormat is the error returned when the request body is malformed.
+	ErrorInvalidRequestFormat = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "DRT-1002",
+		Error: core.I18nMessage{
+			Key:          "error.domainroutingservice.invalid_request_format",
+			DefaultValue: "Invalid request format",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.domainroutingservice.invalid_request_format_description",
+			DefaultValue: "The request body is malformed or contains invalid data",
+		},
+	}
+	// ErrorInvalidDomain is the error returned when the domain of a route is not a valid domain name.
+	ErrorInvalidDomain = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "DRT-1003",
+		Error: core.I18nMessage{
+			Key:          "error.domainroutingservice.invalid_domain",
+			DefaultValue: "Invalid domain",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.domainroutingservice.invalid_domain_description",
+			DefaultValue: "The domain must be a valid domain name, optionally prefixed with '*.' for subdomains",
+		},
+	}
+	// ErrorMissingRouteTarget is the error returned when a route does not define where users are routed to.
+	ErrorMissingRouteTarget = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "DRT-1004",
+		Error: core.I18nMessage{
+			Key:          "error.domainroutingservice.missing_route_target",
+			DefaultValue: "Missing route target",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.domainroutingservice.missing_route_target_description",
+			DefaultValue: "At least one of ouId, idpId or flowBranch must be provided",
+		},
+	}
+	// ErrorInvalidFlowBranch is the error returned when the flow branch of a route is invalid.
+	ErrorInvalidFlowBranch = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "DRT-1005",
+		Error: core.I18nMessage{
+			Key:          "error.domainroutingservice.invalid_flow_branch",
+			DefaultValue: "Invalid flow branch",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.domainroutingservice.invalid_flow_branch_description",
+			DefaultValue: "The flow branch must contain only letters, digits, hyphens and underscores",
+		},
+	}
+	// ErrorDomainRouteConflict is the error returned when a route for the same domain already exists.
+	ErrorDomainRouteConflict = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "DRT-1006",
+		Error: core.I18nMessage{
+			Key:          "error.domainroutingservice.domain_route_conflict",
+			DefaultValue: "Domain route conflict",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.domainroutingservice.domain_route_conflict_description",
+			DefaultValue: "A route for the same domain already exists",
+		},
+	}
+	// ErrorOrganizationUnitNotFound is the error returned when the organization unit of a route does not exist.
+	ErrorOrganizationUnitNotFound = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "DRT-1007",
+		Error: core.I18nMessage{
+			Key:          "error.domainroutingservice.organization_unit_not_found",
+			DefaultValue: "Organization unit not found",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.domainroutingservice.organization_unit_not_found_description",
+			DefaultValue: "The organization unit of the route does not exist",
+		},
+	}
+	// ErrorIdentityProviderNotFound is the error returned when the identity provider of a route does not exist.
+	ErrorIdentityProviderNotFound = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "DRT-1008",
+		Error: core.I18nMessage{
+			Key:          "error.domainroutingservice.identity_provider_not_found",
+			DefaultValue: "Identity provider not found",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.domainroutingservice.identity_provider_not_found_description",
+			DefaultValue: "The identity provider of the route does not exist",
+		},
+	}
+	// ErrorDomainVerificationFailed is the error returned when the DNS TXT record proving domain ownership
+	// is not found.
+	ErrorDomainVerificationFailed = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "DRT-1009",
+		Error: core.I18nMessage{
+			Key:          "error.domainroutingservice.domain_verification_failed",
+			DefaultValue: "Domain verification failed",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.domainroutingservice.domain_verification_failed_description",
+			DefaultValue: "The expected DNS TXT record was not found for the domain",
+		},
+	}
+)
@@ -0,0 +1,132 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package domainrouting
+
+import (
+	"net/http"
+
+	sysutils "github.com/thunder-id/thunderid/internal/system/utils"
+)
+
+// domainRoutingHandler is the handler for domain route management operations.
+type domainRoutingHandler struct {
+	domainRoutingService DomainRoutingServiceInterface
+}
+
+// newDomainRoutingHandler creates a new instance of domainRoutingHandler.
+func newDomainRoutingHandler(domainRoutingService DomainRoutingServiceInterface) *domainRoutingHandler {
+	return &domainRoutingHandler{
+		domainRoutingService: domainRoutingService,
+	}
+}
+
+// HandleRoutePostRequest handles the create domain route request.
+func (h *domainRoutingHandler) HandleRoutePostRequest(w http.ResponseWriter, r *http.Request) {
+	request, err := sysutils.DecodeJSONBody[DomainRouteRequest](r)
+	if err != nil {
+		sysutils.WriteServiceErrorResponse(w, &ErrorInvalidRequestFormat, clientErrorStatusCodes)
+		return
+	}
+
+	created, svcErr := h.domainRoutingService.CreateRoute(r.Context(), sanitizeRouteRequest(*request))
+	if svcErr != nil {
+		sysutils.WriteServiceErrorResponse(w, svcErr, clientErrorStatusCodes)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(w, http.StatusCreated, created)
+}
+
+// HandleRouteListRequest handles the list domain routes request.
+func (h *domainRoutingHandler) HandleRouteListRequest(w http.ResponseWriter, r *http.Request) {
+	routes, svcErr := h.domainRoutingService.GetRouteList(r.Context())
+	if svcErr != nil {
+		sysutils.WriteServiceErrorResponse(w, svcErr, clientErrorStatusCodes)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(w, http.StatusOK, routes)
+}
+
+// HandleRouteGetRequest handles the get domain route request.
+func (h *domainRoutingHandler) HandleRouteGetRequest(w http.ResponseWriter, r *http.Request) {
+	route, svcErr := h.domainRoutingService.GetRoute(r.Context(), r.PathValue("id"))
+	if svcErr != nil {
+		sysutils.WriteServiceErrorResponse(w, svcErr, clientErrorStatusCodes)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(w, http.StatusOK, route)
+}
+
+// HandleRoutePutRequest handles the update domain route request.
+func (h *domainRoutingHandler) HandleRoutePutRequest(w http.ResponseWriter, r *http.Request) {
+	request, err := sysutils.DecodeJSONBody[DomainRouteRequest](r)
+	if err != nil {
+		sysutils.WriteServiceErrorResponse(w, &ErrorInvalidRequestFormat, clientErrorStatusCodes)
+		return
+	}
+
+	updated, svcErr := h.domainRoutingService.UpdateRoute(r.Context(), r.PathValue("id"),
+		sanitizeRouteRequest(*request))
+	if svcErr != nil {
+		sysutils.WriteServiceErrorResponse(w, svcErr, clientErrorStatusCodes)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(w, http.StatusOK, updated)
+}
+
+// HandleRouteDeleteRequest handles the delete domain route request.
+func (h *domainRoutingHandler) HandleRouteDeleteRequest(w http.ResponseWriter, r *http.Request) {
+	if svcErr := h.domainRoutingService.DeleteRoute(r.Context(), r.PathValue("id")); svcErr != nil {
+		sysutils.WriteServiceErrorResponse(w, svcErr, clientErrorStatusCodes)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(w, http.StatusNoContent, nil)
+}
+
+// HandleRouteVerifyRequest handles the verify domain route request.
+func (h *domainRoutingHandler) HandleRouteVerifyRequest(w http.ResponseWriter, r *http.Request) {
+	route, svcErr := h.domainRoutingService.VerifyRoute(r.Context(), r.PathValue("id"))
+	if svcErr != nil {
+		sysutils.WriteServiceErrorResponse(w, svcErr, clientErrorStatusCodes)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(w, http.StatusOK, route)
+}
+
+// sanitizeRouteRequest sanitizes the user supplied fields of a domain route request.
+func sanitizeRouteRequest(request DomainRouteRequest) DomainRouteRequest {
+	return DomainRouteRequest{
+		Domain:     sysutils.SanitizeString(request.Domain),
+		OUID:       sysutils.SanitizeString(request.OUID),
+		IDPID:      sysutils.SanitizeString(request.IDPID),
+		FlowBranch: sysutils.SanitizeString(request.FlowBranch),
+	}
+}
+
+// clientErrorStatusCodes maps the client errors of the handler to HTTP status codes. Other client errors are
+// returned with 400 Bad Request.
+var clientErrorStatusCodes = map[string]int{
+	ErrorDomainRouteNotFound.Code: http.StatusNotFound,
+	ErrorDomainRouteConflict.Code: http.StatusConflict,
+}
@@ -0,0 +1,170 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package domainrouting
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/system/error/apierror"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+)
+
+type HandlerTestSuite struct {
+	suite.Suite
+	mockService *DomainRoutingServiceInterfaceMock
+	handler     *domainRoutingHandler
+}
+
+func TestHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(HandlerTestSuite))
+}
+
+func (s *HandlerTestSuite) SetupTest() {
+	s.mockService = NewDomainRoutingServiceInterfaceMock(s.T())
+	s.handler = newDomainRoutingHandler(s.mockService)
+}
+
+func (s *HandlerTestSuite) TestHandleRoutePostRequest_Success() {
+	s.mockService.On("CreateRoute", mock.Anything, DomainRouteRequest{Domain: "example.com", IDPID: testIDPID}).
+		Return(&DomainRoute{
+			ID:           testRouteID,
+			Domain:       "example.com",
+			IDPID:        testIDPID,
+			Status:       RouteStatusPendingVerification,
+			Verification: &DomainVerification{RecordName: "_thunder-verification.example.com"},
+		}, nil)
+
+	req := httptest.NewRequest(http.MethodPost, "/domain-routes",
+		strings.NewReader(`{"domain":"example.com","idpId":"idp-1"}`))
+	rr := httptest.NewRecorder()
+	s.handler.HandleRoutePostRequest(rr, req)
+
+	s.Equal(http.StatusCreated, rr.Code)
+	var body map[string]interface{}
+	s.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &body))
+	s.Equal(testRouteID, body["id"])
+	s.NotContains(body, "verificationToken")
+	s.Contains(body, "verification")
+}
+
+func (s *HandlerTestSuite) TestHandleRoutePostRequest_InvalidBody() {
+	req := httptest.NewRequest(http.MethodPost, "/domain-routes", strings.NewReader(`{`))
+	rr := httptest.NewRecorder()
+	s.handler.HandleRoutePostRequest(rr, req)
+
+	s.Equal(http.StatusBadRequest, rr.Code)
+	var errResp apierror.ErrorResponse
+	s.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &errResp))
+	s.Equal(ErrorInvalidRequestFormat.Code, errResp.Code)
+}
+
+func (s *HandlerTestSuite) TestHandleRoutePostRequest_Conflict() {
+	s.mockService.On("CreateRoute", mock.Anything, mock.Anything).Return(nil, &ErrorDomainRouteConflict)
+
+	req := httptest.NewRequest(http.MethodPost, "/domain-routes",
+		strings.NewReader(`{"domain":"example.com","flowBranch":"sso"}`))
+	rr := httptest.NewRecorder()
+	s.handler.HandleRoutePostRequest(rr, req)
+
+	s.Equal(http.StatusConflict, rr.Code)
+}
+
+func (s *HandlerTestSuite) TestHandleRouteListRequest() {
+	s.mockService.On("GetRouteList", mock.Anything).Return(&DomainRouteListResponse{
+		TotalResults: 1,
+		Routes:       []DomainRoute{{ID: testRouteID}},
+	}, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/domain-routes", nil)
+	rr := httptest.NewRecorder()
+	s.handler.HandleRouteListRequest(rr, req)
+
+	s.Equal(http.StatusOK, rr.Code)
+	var body DomainRouteListResponse
+	s.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &body))
+	s.Equal(1, body.TotalResults)
+}
+
+func (s *HandlerTestSuite) TestHandleRouteGetRequest_NotFound() {
+	s.mockService.On("GetRoute", mock.Anything, testRouteID).Return(nil, &ErrorDomainRouteNotFound)
+
+	req := httptest.NewRequest(http.MethodGet, "/domain-routes/"+testRouteID, nil)
+	req.SetPathValue("id", testRouteID)
+	rr := httptest.NewRecorder()
+	s.handler.HandleRouteGetRequest(rr, req)
+
+	s.Equal(http.StatusNotFound, rr.Code)
+}
+
+func (s *HandlerTestSuite) TestHandleRoutePutRequest_Success() {
+	s.mockService.On("UpdateRoute", mock.Anything, testRouteID, DomainRouteRequest{
+		Domain: "example.com", FlowBranch: "sso"}).
+		Return(&DomainRoute{ID: testRouteID, Domain: "example.com", FlowBranch: "sso"}, nil)
+
+	req := httptest.NewRequest(http.MethodPut, "/domain-routes/"+testRouteID,
+		strings.NewReader(`{"domain":"example.com","flowBranch":"sso"}`))
+	req.SetPathValue("id", testRouteID)
+	rr := httptest.NewRecorder()
+	s.handler.HandleRoutePutRequest(rr, req)
+
+	s.Equal(http.StatusOK, rr.Code)
+}
+
+func (s *HandlerTestSuite) TestHandleRouteDeleteRequest() {
+	s.mockService.On("DeleteRoute", mock.Anything, testRouteID).Return(nil)
+
+	req := httptest.NewRequest(http.MethodDelete, "/domain-routes/"+testRouteID, nil)
+	req.SetPathValue("id", testRouteID)
+	rr := httptest.NewRecorder()
+	s.handler.HandleRouteDeleteRequest(rr, req)
+
+	s.Equal(http.StatusNoContent, rr.Code)
+}
+
+func (s *HandlerTestSuite) TestHandleRouteVerifyRequest_Failed() {
+	s.mockService.On("VerifyRoute", mock.Anything, testRouteID).Return(nil, &ErrorDomainVerificationFailed)
+
+	req := httptest.NewRequest(http.MethodPost, "/domain-routes/"+testRouteID+"/verify", nil)
+	req.SetPathValue("id", testRouteID)
+	rr := httptest.NewRecorder()
+	s.handler.HandleRouteVerifyRequest(rr, req)
+
+	s.Equal(http.StatusBadRequest, rr.Code)
+	var errResp apierror.ErrorResponse
+	s.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &errResp))
+	s.Equal(ErrorDomainVerificationFailed.Code, errResp.Code)
+}
+
+func (s *HandlerTestSuite) TestHandleRouteVerifyRequest_ServerError() {
+	s.mockService.On("VerifyRoute", mock.Anything, testRouteID).Return(nil, &serviceerror.InternalServerError)
+
+	req := httptest.NewRequest(http.MethodPost, "/domain-routes/"+testRouteID+"/verify", nil)
+	req.SetPathValue("id", testRouteID)
+	rr := httptest.NewRecorder()
+	s.handler.HandleRouteVerifyRequest(rr, req)
+
+	s.Equal(http.StatusInternalServerError, rr.Code)
+}
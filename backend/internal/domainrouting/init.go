@@ -0,0 +1,87 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package domainrouting
+
+import (
+	"net/http"
+
+	"github.com/thunder-id/thunderid/internal/idp"
+	"github.com/thunder-id/thunderid/internal/ou"
+	"github.com/thunder-id/thunderid/internal/system/middleware"
+)
+
+// Initialize initializes the domain routing service and registers its routes.
+func Initialize(
+	mux *http.ServeMux,
+	ouService ou.OrganizationUnitServiceInterface,
+	idpService idp.IDPServiceInterface,
+) DomainRoutingServiceInterface {
+	domainRoutingService := newDomainRoutingService(newDomainRouteStore(), ouService, idpService)
+
+	domainRoutingHandler := newDomainRoutingHandler(domainRoutingService)
+	registerRoutes(mux, domainRoutingHandler)
+
+	return domainRoutingService
+}
+
+// registerRoutes registers the routes for domain route management operations.
+func registerRoutes(mux *http.ServeMux, domainRoutingHandler *domainRoutingHandler) {
+	opts1 := middleware.CORSOptions{
+		AllowedMethods:   []string{"GET", "POST"},
+		AllowedHeaders:   middleware.DefaultAllowedHeaders,
+		AllowCredentials: true,
+		MaxAge:           600,
+	}
+	mux.HandleFunc(middleware.WithCORS("POST /domain-routes", domainRoutingHandler.HandleRoutePostRequest, opts1))
+	mux.HandleFunc(middleware.WithCORS("GET /domain-routes", domainRoutingHandler.HandleRouteListRequest, opts1))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS /domain-routes",
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, opts1))
+
+	opts2 := middleware.CORSOptions{
+		AllowedMethods:   []string{"GET", "PUT", "DELETE"},
+		AllowedHeaders:   middleware.DefaultAllowedHeaders,
+		AllowCredentials: true,
+		MaxAge:           600,
+	}
+	mux.HandleFunc(middleware.WithCORS("GET /domain-routes/{id}",
+		domainRoutingHandler.HandleRouteGetRequest, opts2))
+	mux.HandleFunc(middleware.WithCORS("PUT /domain-routes/{id}",
+		domainRoutingHandler.HandleRoutePutRequest, opts2))
+	mux.HandleFunc(middleware.WithCORS("DELETE /domain-routes/{id}",
+		domainRoutingHandler.HandleRouteDeleteRequest, opts2))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS /domain-routes/{id}",
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, opts2))
+
+	opts3 := middleware.CORSOptions{
+		AllowedMethods:   []string{"POST"},
+		AllowedHeaders:   middleware.DefaultAllowedHeaders,
+		AllowCredentials: true,
+		MaxAge:           600,
+	}
+	mux.HandleFunc(middleware.WithCORS("POST /domain-routes/{id}/verify",
+		domainRoutingHandler.HandleRouteVerifyRequest, opts3))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS /domain-routes/{id}/verify",
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, opts3))
+}
@@ -0,0 +1,57 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package domainrouting provides home realm discovery: routing of sign-ins to an organization unit,
+// a federated identity provider or a flow branch based on the email domain of the user.
+package domainrouting
+
+import "time"
+
+// DomainRoute maps an email domain to the organization unit, identity provider or flow branch that
+// users of the domain are routed to.
+type DomainRoute struct {
+	ID                string              `json:"id"`
+	Domain            string              `json:"domain"`
+	OUID              string              `json:"ouId,omitempty"`
+	IDPID             string              `json:"idpId,omitempty"`
+	FlowBranch        string              `json:"flowBranch,omitempty"`
+	Status            string              `json:"status"`
+	VerificationToken string              `json:"-"`
+	Verification      *DomainVerification `json:"verification,omitempty"`
+	VerifiedAt        *time.Time          `json:"verifiedAt,omitempty"`
+}
+
+// DomainVerification holds the DNS TXT record that proves the ownership of the domain of a route.
+type DomainVerification struct {
+	RecordName  string `json:"recordName"`
+	RecordValue string `json:"recordValue"`
+}
+
+// DomainRouteRequest represents the request body for creating or updating a domain route.
+type DomainRouteRequest struct {
+	Domain     string `json:"domain"`
+	OUID       string `json:"ouId,omitempty"`
+	IDPID      string `json:"idpId,omitempty"`
+	FlowBranch string `json:"flowBranch,omitempty"`
+}
+
+// DomainRouteListResponse represents the response for listing domain routes.
+type DomainRouteListResponse struct {
+	TotalResults int           `json:"totalResults"`
+	Routes       []DomainRoute `json:"routes"`
+}
@@ -0,0 +1,408 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package domainrouting
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"net"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/idp"
+	"github.com/thunder-id/thunderid/internal/ou"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/internal/system/utils"
+)
+
+var (
+	// domainLabelPattern matches a single label of a domain name.
+	domainLabelPattern = regexp.MustCompile(`^[a-z0-9]([a-z0-9-]{0,61}[a-z0-9])?$`)
+	// flowBranchPattern restricts flow branches to values that are safe to compare in flow node conditions.
+	flowBranchPattern = regexp.MustCompile(`^[A-Za-z0-9_-]+$`)
+)
+
+// DomainRoutingServiceInterface defines the interface for the domain routing service.
+type DomainRoutingServiceInterface interface {
+	CreateRoute(ctx context.Context, request DomainRouteRequest) (*DomainRoute, *serviceerror.ServiceError)
+	GetRouteList(ctx context.Context) (*DomainRouteListResponse, *serviceerror.ServiceError)
+	GetRoute(ctx context.Context, id string) (*DomainRoute, *serviceerror.ServiceError)
+	UpdateRoute(ctx context.Context, id string, request DomainRouteRequest) (*DomainRoute, *serviceerror.ServiceError)
+	DeleteRoute(ctx context.Context, id string) *serviceerror.ServiceError
+	VerifyRoute(ctx context.Context, id string) (*DomainRoute, *serviceerror.ServiceError)
+	ResolveRoute(ctx context.Context, identifier string) (*DomainRoute, *serviceerror.ServiceError)
+}
+
+// domainRoutingService is the default implementation of the DomainRoutingServiceInterface.
+type domainRoutingService struct {
+	store      domainRouteStoreInterface
+	ouService  ou.OrganizationUnitServiceInterface
+	idpService idp.IDPServiceInterface
+	lookupTXT  func(ctx context.Context, name string) ([]string, error)
+	now        func() time.Time
+	logger     *log.Logger
+}
+
+// newDomainRoutingService creates a new instance of domainRoutingService.
+func newDomainRoutingService(
+	store domainRouteStoreInterface,
+	ouService ou.OrganizationUnitServiceInterface,
+	idpService idp.IDPServiceInterface,
+) DomainRoutingServiceInterface {
+	return &domainRoutingService{
+		store:      store,
+		ouService:  ouService,
+		idpService: idpService,
+		lookupTXT:  net.DefaultResolver.LookupTXT,
+		now:        time.Now,
+		logger:     log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName)),
+	}
+}
+
+// CreateRoute creates a domain route. The route stays pending until the ownership of the domain is
+// verified through VerifyRoute.
+func (s *domainRoutingService) CreateRoute(
+	ctx context.Context, request DomainRouteRequest) (*DomainRoute, *serviceerror.ServiceError) {
+	route := DomainRoute{
+		Domain:     normalizeDomain(request.Domain),
+		OUID:       strings.TrimSpace(request.OUID),
+		IDPID:      strings.TrimSpace(request.IDPID),
+		FlowBranch: strings.TrimSpace(request.FlowBranch),
+		Status:     RouteStatusPendingVerification,
+	}
+	if svcErr := s.validateRoute(ctx, route); svcErr != nil {
+		return nil, svcErr
+	}
+	if svcErr := s.checkConflict(ctx, route); svcErr != nil {
+		return nil, svcErr
+	}
+
+	id, err := utils.GenerateUUIDv7()
+	if err != nil {
+		s.logger.Error("Failed to generate ID for domain route", log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+	route.ID = id
+	if route.VerificationToken, err = generateVerificationToken(); err != nil {
+		s.logger.Error("Failed to generate domain verification token", log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+
+	if err := s.store.CreateRoute(ctx, route); err != nil {
+		s.logger.Error("Failed to create domain route", log.Error(err), log.String("domain", route.Domain))
+		return nil, &serviceerror.InternalServerError
+	}
+
+	s.logger.Debug("Created domain route", log.String("routeID", route.ID), log.String("domain", route.Domain))
+	return withVerification(route), nil
+}
+
+// GetRouteList retrieves all domain routes.
+func (s *domainRoutingService) GetRouteList(
+	ctx context.Context) (*DomainRouteListResponse, *serviceerror.ServiceError) {
+	routes, err := s.store.GetRouteList(ctx)
+	if err != nil {
+		s.logger.Error("Failed to get domain route list", log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+
+	for i := range routes {
+		routes[i] = *withVerification(routes[i])
+	}
+	return &DomainRouteListResponse{
+		TotalResults: len(routes),
+		Routes:       routes,
+	}, nil
+}
+
+// GetRoute retrieves a domain route by its ID.
+func (s *domainRoutingService) GetRoute(
+	ctx context.Context, id string) (*DomainRoute, *serviceerror.ServiceError) {
+	route, svcErr := s.getRoute(ctx, id)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	return withVerification(*route), nil
+}
+
+// UpdateRoute updates a domain route. Changing the domain of a route resets it to pending, since the
+// ownership of the new domain must be verified again.
+func (s *domainRoutingService) UpdateRoute(
+	ctx context.Context, id string, request DomainRouteRequest) (*DomainRoute, *serviceerror.ServiceError) {
+	existing, svcErr := s.getRoute(ctx, id)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+
+	route := *existing
+	route.Domain = normalizeDomain(request.Domain)
+	route.OUID = strings.TrimSpace(request.OUID)
+	route.IDPID = strings.TrimSpace(request.IDPID)
+	route.FlowBranch = strings.TrimSpace(request.FlowBranch)
+	if svcErr := s.validateRoute(ctx, route); svcErr != nil {
+		return nil, svcErr
+	}
+
+	if route.Domain != existing.Domain {
+		if svcErr := s.checkConflict(ctx, route); svcErr != nil {
+			return nil, svcErr
+		}
+		token, err := generateVerificationToken()
+		if err != nil {
+			s.logger.Error("Failed to generate domain verification token", log.Error(err))
+			return nil, &serviceerror.InternalServerError
+		}
+		route.Status = RouteStatusPendingVerification
+		route.VerificationToken = token
+		route.VerifiedAt = nil
+	}
+
+	if err := s.store.UpdateRoute(ctx, &route); err != nil {
+		s.logger.Error("Failed to update domain route", log.Error(err), log.String("routeID", id))
+		return nil, &serviceerror.InternalServerError
+	}
+
+	return withVerification(route), nil
+}
+
+// DeleteRoute deletes a domain route. Deleting a route that does not exist succeeds.
+func (s *domainRoutingService) DeleteRoute(ctx context.Context, id string) *serviceerror.ServiceError {
+	if strings.TrimSpace(id) == "" {
+		return &ErrorDomainRouteNotFound
+	}
+
+	if err := s.store.DeleteRoute(ctx, id); err != nil {
+		s.logger.Error("Failed to delete domain route", log.Error(err), log.String("routeID", id))
+		return &serviceerror.InternalServerError
+	}
+	return nil
+}
+
+// VerifyRoute verifies the ownership of the domain of a route through its DNS TXT record and activates
+// the route. Routes of a wildcard domain are verified through the record of the parent domain.
+func (s *domainRoutingService) VerifyRoute(
+	ctx context.Context, id string) (*DomainRoute, *serviceerror.ServiceError) {
+	route, svcErr := s.getRoute(ctx, id)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	if route.Status == RouteStatusActive {
+		return withVerification(*route), nil
+	}
+
+	verification := buildVerification(*route)
+	records, err := s.lookupTXT(ctx, verification.RecordName)
+	if err != nil {
+		var dnsErr *net.DNSError
+		if !errors.As(err, &dnsErr) {
+			s.logger.Error("Failed to look up domain verification record", log.Error(err),
+				log.String("routeID", id))
+			return nil, &serviceerror.InternalServerError
+		}
+		s.logger.Debug("Domain verification record lookup failed", log.Error(err), log.String("routeID", id))
+		return nil, &ErrorDomainVerificationFailed
+	}
+
+	verified := false
+	for _, record := range records {
+		if strings.TrimSpace(record) == verification.RecordValue {
+			verified = true
+			break
+		}
+	}
+	if !verified {
+		return nil, &ErrorDomainVerificationFailed
+	}
+
+	verifiedAt := s.now().UTC()
+	route.Status = RouteStatusActive
+	route.VerifiedAt = &verifiedAt
+	if err := s.store.UpdateRoute(ctx, route); err != nil {
+		s.logger.Error("Failed to activate domain route", log.Error(err), log.String("routeID", id))
+		return nil, &serviceerror.InternalServerError
+	}
+
+	s.logger.Debug("Verified domain route", log.String("routeID", id), log.String("domain", route.Domain))
+	return withVerification(*route), nil
+}
+
+// ResolveRoute resolves the active route of an email address or a domain. An exact domain route takes
+// precedence over wildcard routes, and a wildcard route of a closer parent domain takes precedence over
+// one of a more distant parent. Returns nil if no active route matches.
+func (s *domainRoutingService) ResolveRoute(
+	ctx context.Context, identifier string) (*DomainRoute, *serviceerror.ServiceError) {
+	domain := identifier
+	if at := strings.LastIndex(identifier, "@"); at >= 0 {
+		domain = identifier[at+1:]
+	}
+	domain = normalizeDomain(domain)
+	if !isValidDomainName(domain) {
+		return nil, nil
+	}
+
+	for _, candidate := range routeCandidates(domain) {
+		route, err := s.store.GetRouteByDomain(ctx, candidate)
+		if err != nil {
+			if errors.Is(err, ErrDomainRouteNotFound) {
+				continue
+			}
+			s.logger.Error("Failed to get domain route", log.Error(err), log.String("domain", candidate))
+			return nil, &serviceerror.InternalServerError
+		}
+		if route.Status == RouteStatusActive {
+			return route, nil
+		}
+	}
+	return nil, nil
+}
+
+// getRoute retrieves a domain route by its ID and maps store errors to service errors.
+func (s *domainRoutingService) getRoute(
+	ctx context.Context, id string) (*DomainRoute, *serviceerror.ServiceError) {
+	if strings.TrimSpace(id) == "" {
+		return nil, &ErrorDomainRouteNotFound
+	}
+
+	route, err := s.store.GetRoute(ctx, id)
+	if err != nil {
+		if errors.Is(err, ErrDomainRouteNotFound) {
+			return nil, &ErrorDomainRouteNotFound
+		}
+		s.logger.Error("Failed to get domain route", log.Error(err), log.String("routeID", id))
+		return nil, &serviceerror.InternalServerError
+	}
+	return route, nil
+}
+
+// validateRoute validates the domain and the targets of a route.
+func (s *domainRoutingService) validateRoute(
+	ctx context.Context, route DomainRoute) *serviceerror.ServiceError {
+	if !isValidDomainName(strings.TrimPrefix(route.Domain, wildcardPrefix)) {
+		return &ErrorInvalidDomain
+	}
+	if route.OUID == "" && route.IDPID == "" && route.FlowBranch == "" {
+		return &ErrorMissingRouteTarget
+	}
+	if route.FlowBranch != "" &&
+		(len(route.FlowBranch) > maxFlowBranchLength || !flowBranchPattern.MatchString(route.FlowBranch)) {
+		return &ErrorInvalidFlowBranch
+	}
+
+	if route.OUID != "" {
+		exists, svcErr := s.ouService.IsOrganizationUnitExists(ctx, route.OUID)
+		if svcErr != nil {
+			s.logger.Error("Failed to check organization unit existence", log.String("ouID", route.OUID),
+				log.String("code", svcErr.Code))
+			return &serviceerror.InternalServerError
+		}
+		if !exists {
+			return &ErrorOrganizationUnitNotFound
+		}
+	}
+	if route.IDPID != "" {
+		if _, svcErr := s.idpService.GetIdentityProvider(ctx, route.IDPID); svcErr != nil {
+			if svcErr.Code == idp.ErrorIDPNotFound.Code {
+				return &ErrorIdentityProviderNotFound
+			}
+			s.logger.Error("Failed to get identity provider", log.String("idpID", route.IDPID),
+				log.String("code", svcErr.Code))
+			return &serviceerror.InternalServerError
+		}
+	}
+	return nil
+}
+
+// checkConflict checks whether another route already exists for the domain of the route.
+func (s *domainRoutingService) checkConflict(
+	ctx context.Context, route DomainRoute) *serviceerror.ServiceError {
+	existing, err := s.store.GetRouteByDomain(ctx, route.Domain)
+	if err != nil {
+		if errors.Is(err, ErrDomainRouteNotFound) {
+			return nil
+		}
+		s.logger.Error("Failed to get domain route", log.Error(err), log.String("domain", route.Domain))
+		return &serviceerror.InternalServerError
+	}
+	if existing.ID != route.ID {
+		return &ErrorDomainRouteConflict
+	}
+	return nil
+}
+
+// normalizeDomain lowercases a domain and removes surrounding whitespace and a trailing dot.
+func normalizeDomain(domain string) string {
+	return strings.TrimSuffix(strings.ToLower(strings.TrimSpace(domain)), ".")
+}
+
+// isValidDomainName reports whether a normalized domain is a valid domain name with at least two labels.
+func isValidDomainName(domain string) bool {
+	if domain == "" || len(domain) > maxDomainLength {
+		return false
+	}
+	labels := strings.Split(domain, ".")
+	if len(labels) < 2 {
+		return false
+	}
+	for _, label := range labels {
+		if !domainLabelPattern.MatchString(label) {
+			return false
+		}
+	}
+	return true
+}
+
+// routeCandidates returns the route domains that can match a domain, most specific first: the domain
+// itself followed by the wildcard patterns of its parent domains.
+func routeCandidates(domain string) []string {
+	candidates := []string{domain}
+	labels := strings.Split(domain, ".")
+	for i := 1; i < len(labels)-1; i++ {
+		candidates = append(candidates, wildcardPrefix+strings.Join(labels[i:], "."))
+	}
+	return candidates
+}
+
+// buildVerification builds the DNS TXT record that proves the ownership of the domain of a route.
+func buildVerification(route DomainRoute) *DomainVerification {
+	return &DomainVerification{
+		RecordName:  verificationRecordPrefix + strings.TrimPrefix(route.Domain, wildcardPrefix),
+		RecordValue: verificationValuePrefix + route.VerificationToken,
+	}
+}
+
+// withVerification returns the route with the verification record attached while it is pending.
+func withVerification(route DomainRoute) *DomainRoute {
+	if route.Status == RouteStatusPendingVerification {
+		route.Verification = buildVerification(route)
+	}
+	return &route
+}
+
+// generateVerificationToken generates a random token for the domain verification record.
+func generateVerificationToken() (string, error) {
+	buf := make([]byte, 16)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(buf), nil
+}
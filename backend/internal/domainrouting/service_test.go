@@ -0,0 +1,319 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package domainrouting
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/idp"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/tests/mocks/idp/idpmock"
+	"github.com/thunder-id/thunderid/tests/mocks/oumock"
+)
+
+const (
+	testRouteID = "route-1"
+	testOUID    = "ou-1"
+	testIDPID   = "idp-1"
+	testToken   = "0123456789abcdef"
+)
+
+type DomainRoutingServiceTestSuite struct {
+	suite.Suite
+	mockStore  *domainRouteStoreInterfaceMock
+	mockOU     *oumock.OrganizationUnitServiceInterfaceMock
+	mockIDP    *idpmock.IDPServiceInterfaceMock
+	service    *domainRoutingService
+	txtRecords map[string][]string
+	now        time.Time
+}
+
+func TestDomainRoutingServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(DomainRoutingServiceTestSuite))
+}
+
+func (suite *DomainRoutingServiceTestSuite) SetupTest() {
+	suite.mockStore = newDomainRouteStoreInterfaceMock(suite.T())
+	suite.mockOU = oumock.NewOrganizationUnitServiceInterfaceMock(suite.T())
+	suite.mockIDP = idpmock.NewIDPServiceInterfaceMock(suite.T())
+	suite.service = newDomainRoutingService(suite.mockStore, suite.mockOU, suite.mockIDP).(*domainRoutingService)
+	suite.txtRecords = map[string][]string{}
+	suite.service.lookupTXT = func(ctx context.Context, name string) ([]string, error) {
+		if records, ok := suite.txtRecords[name]; ok {
+			return records, nil
+		}
+		return nil, &net.DNSError{Err: "no such host", Name: name, IsNotFound: true}
+	}
+	suite.now = time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	suite.service.now = func() time.Time { return suite.now }
+}
+
+func (suite *DomainRoutingServiceTestSuite) pendingRoute(domain string) *DomainRoute {
+	return &DomainRoute{
+		ID:                testRouteID,
+		Domain:            domain,
+		OUID:              testOUID,
+		Status:            RouteStatusPendingVerification,
+		VerificationToken: testToken,
+	}
+}
+
+func (suite *DomainRoutingServiceTestSuite) TestCreateRoute_Success() {
+	suite.mockOU.On("IsOrganizationUnitExists", mock.Anything, testOUID).Return(true, nil).Once()
+	suite.mockIDP.On("GetIdentityProvider", mock.Anything, testIDPID).Return(&idp.IDPDTO{ID: testIDPID}, nil).Once()
+	suite.mockStore.On("GetRouteByDomain", mock.Anything, "*.example.com").
+		Return(nil, ErrDomainRouteNotFound).Once()
+	suite.mockStore.On("CreateRoute", mock.Anything, mock.MatchedBy(func(route DomainRoute) bool {
+		return route.ID != "" && route.Domain == "*.example.com" && route.OUID == testOUID &&
+			route.IDPID == testIDPID && route.Status == RouteStatusPendingVerification &&
+			len(route.VerificationToken) == 32
+	})).Return(nil).Once()
+
+	route, svcErr := suite.service.CreateRoute(context.Background(), DomainRouteRequest{
+		Domain: " *.Example.COM. ",
+		OUID:   testOUID,
+		IDPID:  testIDPID,
+	})
+
+	suite.Nil(svcErr)
+	suite.Equal("*.example.com", route.Domain)
+	suite.Equal(RouteStatusPendingVerification, route.Status)
+	suite.Require().NotNil(route.Verification)
+	suite.Equal("_thunder-verification.example.com", route.Verification.RecordName)
+	suite.Equal("thunder-verification="+route.VerificationToken, route.Verification.RecordValue)
+}
+
+func (suite *DomainRoutingServiceTestSuite) TestCreateRoute_ValidationErrors() {
+	testCases := []struct {
+		name     string
+		request  DomainRouteRequest
+		expected serviceerror.ServiceError
+	}{
+		{"EmptyDomain", DomainRouteRequest{FlowBranch: "sso"}, ErrorInvalidDomain},
+		{"SingleLabel", DomainRouteRequest{Domain: "localhost", FlowBranch: "sso"}, ErrorInvalidDomain},
+		{"InvalidLabel", DomainRouteRequest{Domain: "-bad.example.com", FlowBranch: "sso"}, ErrorInvalidDomain},
+		{"NestedWildcard", DomainRouteRequest{Domain: "*.*.example.com", FlowBranch: "sso"}, ErrorInvalidDomain},
+		{"WildcardTLD", DomainRouteRequest{Domain: "*.com", FlowBranch: "sso"}, ErrorInvalidDomain},
+		{"MissingTarget", DomainRouteRequest{Domain: "example.com"}, ErrorMissingRouteTarget},
+		{"InvalidBranch", DomainRouteRequest{Domain: "example.com", FlowBranch: "a b"}, ErrorInvalidFlowBranch},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			route, svcErr := suite.service.CreateRoute(context.Background(), tc.request)
+
+			suite.Nil(route)
+			suite.Require().NotNil(svcErr)
+			suite.Equal(tc.expected.Code, svcErr.Code)
+		})
+	}
+}
+
+func (suite *DomainRoutingServiceTestSuite) TestCreateRoute_OUNotFound() {
+	suite.mockOU.On("IsOrganizationUnitExists", mock.Anything, testOUID).Return(false, nil).Once()
+
+	route, svcErr := suite.service.CreateRoute(context.Background(),
+		DomainRouteRequest{Domain: "example.com", OUID: testOUID})
+
+	suite.Nil(route)
+	suite.Equal(ErrorOrganizationUnitNotFound.Code, svcErr.Code)
+}
+
+func (suite *DomainRoutingServiceTestSuite) TestCreateRoute_IDPNotFound() {
+	suite.mockIDP.On("GetIdentityProvider", mock.Anything, testIDPID).Return(nil, &idp.ErrorIDPNotFound).Once()
+
+	route, svcErr := suite.service.CreateRoute(context.Background(),
+		DomainRouteRequest{Domain: "example.com", IDPID: testIDPID})
+
+	suite.Nil(route)
+	suite.Equal(ErrorIdentityProviderNotFound.Code, svcErr.Code)
+}
+
+func (suite *DomainRoutingServiceTestSuite) TestCreateRoute_Conflict() {
+	suite.mockStore.On("GetRouteByDomain", mock.Anything, "example.com").
+		Return(&DomainRoute{ID: "other"}, nil).Once()
+
+	route, svcErr := suite.service.CreateRoute(context.Background(),
+		DomainRouteRequest{Domain: "example.com", FlowBranch: "sso"})
+
+	suite.Nil(route)
+	suite.Equal(ErrorDomainRouteConflict.Code, svcErr.Code)
+}
+
+func (suite *DomainRoutingServiceTestSuite) TestGetRouteList_HidesVerificationOfActiveRoutes() {
+	active := DomainRoute{ID: "route-2", Domain: "active.com", FlowBranch: "sso", Status: RouteStatusActive}
+	suite.mockStore.On("GetRouteList", mock.Anything).
+		Return([]DomainRoute{*suite.pendingRoute("example.com"), active}, nil).Once()
+
+	response, svcErr := suite.service.GetRouteList(context.Background())
+
+	suite.Nil(svcErr)
+	suite.Equal(2, response.TotalResults)
+	suite.NotNil(response.Routes[0].Verification)
+	suite.Nil(response.Routes[1].Verification)
+}
+
+func (suite *DomainRoutingServiceTestSuite) TestGetRoute_NotFound() {
+	suite.mockStore.On("GetRoute", mock.Anything, testRouteID).Return(nil, ErrDomainRouteNotFound).Once()
+
+	route, svcErr := suite.service.GetRoute(context.Background(), testRouteID)
+
+	suite.Nil(route)
+	suite.Equal(ErrorDomainRouteNotFound.Code, svcErr.Code)
+}
+
+func (suite *DomainRoutingServiceTestSuite) TestUpdateRoute_DomainChangeResetsVerification() {
+	existing := suite.pendingRoute("example.com")
+	existing.Status = RouteStatusActive
+	existing.VerifiedAt = &suite.now
+	suite.mockStore.On("GetRoute", mock.Anything, testRouteID).Return(existing, nil).Once()
+	suite.mockStore.On("GetRouteByDomain", mock.Anything, "example.org").
+		Return(nil, ErrDomainRouteNotFound).Once()
+	suite.mockStore.On("UpdateRoute", mock.Anything, mock.MatchedBy(func(route *DomainRoute) bool {
+		return route.Domain == "example.org" && route.Status == RouteStatusPendingVerification &&
+			route.VerifiedAt == nil && route.VerificationToken != testToken
+	})).Return(nil).Once()
+
+	route, svcErr := suite.service.UpdateRoute(context.Background(), testRouteID,
+		DomainRouteRequest{Domain: "example.org", FlowBranch: "sso"})
+
+	suite.Nil(svcErr)
+	suite.Equal(RouteStatusPendingVerification, route.Status)
+	suite.NotNil(route.Verification)
+}
+
+func (suite *DomainRoutingServiceTestSuite) TestUpdateRoute_SameDomainKeepsStatus() {
+	existing := suite.pendingRoute("example.com")
+	existing.Status = RouteStatusActive
+	suite.mockStore.On("GetRoute", mock.Anything, testRouteID).Return(existing, nil).Once()
+	suite.mockStore.On("UpdateRoute", mock.Anything, mock.MatchedBy(func(route *DomainRoute) bool {
+		return route.Status == RouteStatusActive && route.OUID == "" && route.FlowBranch == "sso"
+	})).Return(nil).Once()
+
+	route, svcErr := suite.service.UpdateRoute(context.Background(), testRouteID,
+		DomainRouteRequest{Domain: "example.com", FlowBranch: "sso"})
+
+	suite.Nil(svcErr)
+	suite.Equal(RouteStatusActive, route.Status)
+	suite.Nil(route.Verification)
+}
+
+func (suite *DomainRoutingServiceTestSuite) TestDeleteRoute() {
+	suite.mockStore.On("DeleteRoute", mock.Anything, testRouteID).Return(nil).Once()
+
+	suite.Nil(suite.service.DeleteRoute(context.Background(), testRouteID))
+}
+
+func (suite *DomainRoutingServiceTestSuite) TestDeleteRoute_StoreError() {
+	suite.mockStore.On("DeleteRoute", mock.Anything, testRouteID).Return(errors.New("db error")).Once()
+
+	svcErr := suite.service.DeleteRoute(context.Background(), testRouteID)
+
+	suite.Equal(serviceerror.InternalServerError.Code, svcErr.Code)
+}
+
+func (suite *DomainRoutingServiceTestSuite) TestVerifyRoute_Success() {
+	suite.mockStore.On("GetRoute", mock.Anything, testRouteID).Return(suite.pendingRoute("*.example.com"), nil).Once()
+	suite.mockStore.On("UpdateRoute", mock.Anything, mock.MatchedBy(func(route *DomainRoute) bool {
+		return route.Status == RouteStatusActive && route.VerifiedAt != nil && route.VerifiedAt.Equal(suite.now)
+	})).Return(nil).Once()
+	suite.txtRecords["_thunder-verification.example.com"] = []string{"unrelated", "thunder-verification=" + testToken}
+
+	route, svcErr := suite.service.VerifyRoute(context.Background(), testRouteID)
+
+	suite.Nil(svcErr)
+	suite.Equal(RouteStatusActive, route.Status)
+	suite.Nil(route.Verification)
+}
+
+func (suite *DomainRoutingServiceTestSuite) TestVerifyRoute_RecordMismatch() {
+	suite.mockStore.On("GetRoute", mock.Anything, testRouteID).Return(suite.pendingRoute("example.com"), nil).Once()
+	suite.txtRecords["_thunder-verification.example.com"] = []string{"thunder-verification=other"}
+
+	route, svcErr := suite.service.VerifyRoute(context.Background(), testRouteID)
+
+	suite.Nil(route)
+	suite.Equal(ErrorDomainVerificationFailed.Code, svcErr.Code)
+}
+
+func (suite *DomainRoutingServiceTestSuite) TestVerifyRoute_RecordMissing() {
+	suite.mockStore.On("GetRoute", mock.Anything, testRouteID).Return(suite.pendingRoute("example.com"), nil).Once()
+
+	route, svcErr := suite.service.VerifyRoute(context.Background(), testRouteID)
+
+	suite.Nil(route)
+	suite.Equal(ErrorDomainVerificationFailed.Code, svcErr.Code)
+}
+
+func (suite *DomainRoutingServiceTestSuite) TestResolveRoute_PrefersMostSpecificActiveRoute() {
+	wildcard := DomainRoute{ID: "route-2", Domain: "*.example.com", FlowBranch: "sso", Status: RouteStatusActive}
+	suite.mockStore.On("GetRouteByDomain", mock.Anything, "eng.emea.example.com").
+		Return(nil, ErrDomainRouteNotFound).Once()
+	suite.mockStore.On("GetRouteByDomain", mock.Anything, "*.emea.example.com").
+		Return(suite.pendingRoute("*.emea.example.com"), nil).Once()
+	suite.mockStore.On("GetRouteByDomain", mock.Anything, "*.example.com").Return(&wildcard, nil).Once()
+
+	route, svcErr := suite.service.ResolveRoute(context.Background(), "Alice@Eng.EMEA.example.com")
+
+	suite.Nil(svcErr)
+	suite.Require().NotNil(route)
+	suite.Equal("route-2", route.ID)
+}
+
+func (suite *DomainRoutingServiceTestSuite) TestResolveRoute_ExactMatch() {
+	exact := DomainRoute{ID: testRouteID, Domain: "example.com", OUID: testOUID, Status: RouteStatusActive}
+	suite.mockStore.On("GetRouteByDomain", mock.Anything, "example.com").Return(&exact, nil).Once()
+
+	route, svcErr := suite.service.ResolveRoute(context.Background(), "alice@example.com")
+
+	suite.Nil(svcErr)
+	suite.Equal(testRouteID, route.ID)
+}
+
+func (suite *DomainRoutingServiceTestSuite) TestResolveRoute_NoMatch() {
+	suite.mockStore.On("GetRouteByDomain", mock.Anything, "example.com").Return(nil, ErrDomainRouteNotFound).Once()
+
+	route, svcErr := suite.service.ResolveRoute(context.Background(), "alice@example.com")
+
+	suite.Nil(svcErr)
+	suite.Nil(route)
+}
+
+func (suite *DomainRoutingServiceTestSuite) TestResolveRoute_InvalidIdentifier() {
+	route, svcErr := suite.service.ResolveRoute(context.Background(), "alice")
+
+	suite.Nil(svcErr)
+	suite.Nil(route)
+}
+
+func (suite *DomainRoutingServiceTestSuite) TestResolveRoute_StoreError() {
+	suite.mockStore.On("GetRouteByDomain", mock.Anything, "example.com").Return(nil, errors.New("db error")).Once()
+
+	route, svcErr := suite.service.ResolveRoute(context.Background(), "alice@example.com")
+
+	suite.Nil(route)
+	suite.Equal(serviceerror.InternalServerError.Code, svcErr.Code)
+}
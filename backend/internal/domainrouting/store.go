@@ -0,0 +1,223 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package domainrouting
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+	sysContext "github.com/thunder-id/thunderid/internal/system/context"
+	dbmodel "github.com/thunder-id/thunderid/internal/system/database/model"
+	"github.com/thunder-id/thunderid/internal/system/database/provider"
+	dbutils "github.com/thunder-id/thunderid/internal/system/database/utils"
+)
+
+var getDBProvider = provider.GetDBProvider
+
+// domainRouteStoreInterface defines the interface for domain route store operations.
+type domainRouteStoreInterface interface {
+	CreateRoute(ctx context.Context, route DomainRoute) error
+	GetRouteList(ctx context.Context) ([]DomainRoute, error)
+	GetRoute(ctx context.Context, id string) (*DomainRoute, error)
+	GetRouteByDomain(ctx context.Context, domain string) (*DomainRoute, error)
+	UpdateRoute(ctx context.Context, route *DomainRoute) error
+	DeleteRoute(ctx context.Context, id string) error
+}
+
+// domainRouteStore is the default implementation of domainRouteStoreInterface.
+type domainRouteStore struct {
+	dbProvider   provider.DBProviderInterface
+	deploymentID string
+}
+
+// newDomainRouteStore creates a new instance of domainRouteStore.
+func newDomainRouteStore() domainRouteStoreInterface {
+	return &domainRouteStore{
+		dbProvider:   getDBProvider(),
+		deploymentID: config.GetServerRuntime().Config.Server.Identifier,
+	}
+}
+
+// CreateRoute persists a new domain route.
+func (s *domainRouteStore) CreateRoute(ctx context.Context, route DomainRoute) error {
+	dbClient, err := s.dbProvider.GetConfigDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	_, err = dbClient.ExecuteContext(ctx, queryCreateDomainRoute, route.ID, route.Domain,
+		toNullableString(route.OUID), toNullableString(route.IDPID), toNullableString(route.FlowBranch),
+		route.Status, route.VerificationToken, toNullableTime(route.VerifiedAt),
+		sysContext.ScopeDeploymentID(ctx, s.deploymentID))
+	if err != nil {
+		return fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	return nil
+}
+
+// GetRouteList retrieves all domain routes ordered by domain.
+func (s *domainRouteStore) GetRouteList(ctx context.Context) ([]DomainRoute, error) {
+	dbClient, err := s.dbProvider.GetConfigDBClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	results, err := dbClient.QueryContext(ctx, queryGetDomainRouteList,
+		sysContext.ScopeDeploymentID(ctx, s.deploymentID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	routes := make([]DomainRoute, 0, len(results))
+	for _, row := range results {
+		route, err := buildDomainRouteFromResultRow(row)
+		if err != nil {
+			return nil, fmt.Errorf("failed to build domain route from result row: %w", err)
+		}
+		routes = append(routes, *route)
+	}
+
+	return routes, nil
+}
+
+// GetRoute retrieves a domain route by its ID.
+func (s *domainRouteStore) GetRoute(ctx context.Context, id string) (*DomainRoute, error) {
+	return s.getRoute(ctx, queryGetDomainRouteByID, id)
+}
+
+// GetRouteByDomain retrieves a domain route by its domain.
+func (s *domainRouteStore) GetRouteByDomain(ctx context.Context, domain string) (*DomainRoute, error) {
+	return s.getRoute(ctx, queryGetDomainRouteByDomain, domain)
+}
+
+// getRoute retrieves a single domain route using the provided query and identifier.
+func (s *domainRouteStore) getRoute(
+	ctx context.Context, query dbmodel.DBQuery, identifier string) (*DomainRoute, error) {
+	dbClient, err := s.dbProvider.GetConfigDBClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	results, err := dbClient.QueryContext(ctx, query, identifier, sysContext.ScopeDeploymentID(ctx, s.deploymentID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+	if len(results) == 0 {
+		return nil, ErrDomainRouteNotFound
+	}
+	if len(results) != 1 {
+		return nil, fmt.Errorf("unexpected number of results: %d", len(results))
+	}
+
+	return buildDomainRouteFromResultRow(results[0])
+}
+
+// UpdateRoute updates a domain route.
+func (s *domainRouteStore) UpdateRoute(ctx context.Context, route *DomainRoute) error {
+	dbClient, err := s.dbProvider.GetConfigDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	_, err = dbClient.ExecuteContext(ctx, queryUpdateDomainRoute, route.ID, route.Domain,
+		toNullableString(route.OUID), toNullableString(route.IDPID), toNullableString(route.FlowBranch),
+		route.Status, route.VerificationToken, toNullableTime(route.VerifiedAt),
+		sysContext.ScopeDeploymentID(ctx, s.deploymentID))
+	if err != nil {
+		return fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteRoute deletes a domain route by its ID.
+func (s *domainRouteStore) DeleteRoute(ctx context.Context, id string) error {
+	dbClient, err := s.dbProvider.GetConfigDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	if _, err := dbClient.ExecuteContext(ctx, queryDeleteDomainRoute, id,
+		sysContext.ScopeDeploymentID(ctx, s.deploymentID)); err != nil {
+		return fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	return nil
+}
+
+// buildDomainRouteFromResultRow constructs a DomainRoute from a database result row.
+func buildDomainRouteFromResultRow(row map[string]interface{}) (*DomainRoute, error) {
+	id, ok := row["id"].(string)
+	if !ok {
+		return nil, fmt.Errorf("failed to parse id as string")
+	}
+	domain, ok := row["domain"].(string)
+	if !ok {
+		return nil, fmt.Errorf("failed to parse domain as string")
+	}
+	status, ok := row["status"].(string)
+	if !ok {
+		return nil, fmt.Errorf("failed to parse status as string")
+	}
+	verificationToken, ok := row["verification_token"].(string)
+	if !ok {
+		return nil, fmt.Errorf("failed to parse verification_token as string")
+	}
+	ouID, _ := row["ou_id"].(string)
+	idpID, _ := row["idp_id"].(string)
+	flowBranch, _ := row["flow_branch"].(string)
+
+	route := &DomainRoute{
+		ID:                id,
+		Domain:            domain,
+		OUID:              ouID,
+		IDPID:             idpID,
+		FlowBranch:        flowBranch,
+		Status:            status,
+		VerificationToken: verificationToken,
+	}
+	if row["verified_at"] != nil {
+		verifiedAt, err := dbutils.ParseTimeField(row["verified_at"], "verified_at")
+		if err != nil {
+			return nil, err
+		}
+		route.VerifiedAt = &verifiedAt
+	}
+
+	return route, nil
+}
+
+// toNullableString maps an empty string to a SQL NULL.
+func toNullableString(value string) interface{} {
+	if value == "" {
+		return nil
+	}
+	return value
+}
+
+// toNullableTime maps a nil time to a SQL NULL.
+func toNullableTime(value *time.Time) interface{} {
+	if value == nil {
+		return nil
+	}
+	return *value
+}
@@ -0,0 +1,58 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package domainrouting
+
+import "github.com/thunder-id/thunderid/internal/system/database/model"
+
+const domainRouteColumns = `ID, DOMAIN, OU_ID, IDP_ID, FLOW_BRANCH, STATUS, VERIFICATION_TOKEN, VERIFIED_AT`
+
+var (
+	// queryCreateDomainRoute is the query to create a new domain route.
+	queryCreateDomainRoute = model.DBQuery{
+		ID: "DRQ-ROUTE_MGT-01",
+		Query: `INSERT INTO "DOMAIN_ROUTE" (` + domainRouteColumns + `, DEPLOYMENT_ID) ` +
+			`VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)`,
+	}
+	// queryGetDomainRouteByID is the query to get a domain route by its ID.
+	queryGetDomainRouteByID = model.DBQuery{
+		ID:    "DRQ-ROUTE_MGT-02",
+		Query: `SELECT ` + domainRouteColumns + ` FROM "DOMAIN_ROUTE" WHERE ID = $1 AND DEPLOYMENT_ID = $2`,
+	}
+	// queryGetDomainRouteList is the query to get the list of domain routes.
+	queryGetDomainRouteList = model.DBQuery{
+		ID:    "DRQ-ROUTE_MGT-03",
+		Query: `SELECT ` + domainRouteColumns + ` FROM "DOMAIN_ROUTE" WHERE DEPLOYMENT_ID = $1 ORDER BY DOMAIN`,
+	}
+	// queryGetDomainRouteByDomain is the query to get a domain route by its domain.
+	queryGetDomainRouteByDomain = model.DBQuery{
+		ID:    "DRQ-ROUTE_MGT-04",
+		Query: `SELECT ` + domainRouteColumns + ` FROM "DOMAIN_ROUTE" WHERE DOMAIN = $1 AND DEPLOYMENT_ID = $2`,
+	}
+	// queryUpdateDomainRoute is the query to update a domain route by its ID.
+	queryUpdateDomainRoute = model.DBQuery{
+		ID: "DRQ-ROUTE_MGT-05",
+		Query: `UPDATE "DOMAIN_ROUTE" SET DOMAIN = $2, OU_ID = $3, IDP_ID = $4, FLOW_BRANCH = $5, STATUS = $6, ` +
+			`VERIFICATION_TOKEN = $7, VERIFIED_AT = $8 WHERE ID = $1 AND DEPLOYMENT_ID = $9`,
+	}
+	// queryDeleteDomainRoute is the query to delete a domain route by its ID.
+	queryDeleteDomainRoute = model.DBQuery{
+		ID:    "DRQ-ROUTE_MGT-06",
+		Query: `DELETE FROM "DOMAIN_ROUTE" WHERE ID = $1 AND DEPLOYMENT_ID = $2`,
+	}
+)
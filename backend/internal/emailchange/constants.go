@@ -0,0 +1,50 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package emailchange
+
+const (
+	// loggerComponentName is the component name used in email change logs.
+	loggerComponentName = "EmailChangeService"
+	// handlerLoggerComponentName is the component name used in email change handler logs.
+	handlerLoggerComponentName = "EmailChangeHandler"
+
+	// defaultValidityPeriod is the default number of seconds a pending email change can be confirmed in.
+	defaultValidityPeriod = int64(24 * 60 * 60)
+	// defaultConfirmationPath is the path of the confirmation page, relative to the gate client login page.
+	defaultConfirmationPath = "email-change/confirm"
+	// confirmationTokenParam is the query parameter of the confirmation link holding the confirmation token.
+	confirmationTokenParam = "token"
+
+	// templateDataKeyConfirmationLink is the template data key holding the confirmation link.
+	templateDataKeyConfirmationLink = "confirmationLink"
+	// templateDataKeyNewEmail is the template data key holding the new email address.
+	templateDataKeyNewEmail = "newEmail"
+	// templateDataKeyExpiryTime is the template data key holding the expiry time of the confirmation link.
+	templateDataKeyExpiryTime = "expiryTime"
+)
+
+// ConfirmationStatus represents the status of a pending email change after a confirmation.
+type ConfirmationStatus string
+
+const (
+	// ConfirmationStatusPending indicates that the change still awaits the confirmation of another address.
+	ConfirmationStatusPending ConfirmationStatus = "PENDING"
+	// ConfirmationStatusCompleted indicates that the change is confirmed and the new address is applied.
+	ConfirmationStatusCompleted ConfirmationStatus = "COMPLETED"
+)
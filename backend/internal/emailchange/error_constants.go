@@ -0,0 +1,154 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package emailchange
+
+import (
+	"errors"
+
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/i18n/core"
+)
+
+// ErrPendingEmailChangeNotFound is returned when the pending email change is not found in the store.
+var ErrPendingEmailChangeNotFound = errors.New("pending email change not found")
+
+// Client errors for email change operations.
+var (
+	// ErrorMissingUserID is the error returned when the user ID is missing.
+	ErrorMissingUserID = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "EMC-1001",
+		Error: core.I18nMessage{
+			Key:          "error.emailchangeservice.missing_user_id",
+			DefaultValue: "Missing user ID",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.emailchangeservice.missing_user_id_description",
+			DefaultValue: "The user ID must be provided",
+		},
+	}
+	// ErrorUserNotFound is the error returned when the user is not found.
+	ErrorUserNotFound = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "EMC-1002",
+		Error: core.I18nMessage{
+			Key:          "error.emailchangeservice.user_not_found",
+			DefaultValue: "User not found",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.emailchangeservice.user_not_found_description",
+			DefaultValue: "The user could not be found",
+		},
+	}
+	// ErrorPendingChangeNotFound is the error returned when the user has no pending email change.
+	ErrorPendingChangeNotFound = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "EMC-1003",
+		Error: core.I18nMessage{
+			Key:          "error.emailchangeservice.pending_change_not_found",
+			DefaultValue: "Pending email change not found",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.emailchangeservice.pending_change_not_found_description",
+			DefaultValue: "The user has no pending email change",
+		},
+	}
+	// ErrorInvalidEmail is the error returned when the new email address is not valid.
+	ErrorInvalidEmail = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "EMC-1004",
+		Error: core.I18nMessage{
+			Key:          "error.emailchangeservice.invalid_email",
+			DefaultValue: "Invalid email address",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.emailchangeservice.invalid_email_description",
+			DefaultValue: "The new email address is not valid",
+		},
+	}
+	// ErrorInvalidConfirmationToken is the error returned when a confirmation token is unknown or expired.
+	ErrorInvalidConfirmationToken = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "EMC-1005",
+		Error: core.I18nMessage{
+			Key:          "error.emailchangeservice.invalid_confirmation_token",
+			DefaultValue: "Invalid confirmation token",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.emailchangeservice.invalid_confirmation_token_description",
+			DefaultValue: "The confirmation token is invalid or has expired",
+		},
+	}
+	// ErrorEmailAlreadyInUse is the error returned when the new email address is taken by another user.
+	ErrorEmailAlreadyInUse = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "EMC-1006",
+		Error: core.I18nMessage{
+			Key:          "error.emailchangeservice.email_already_in_use",
+			DefaultValue: "Email address already in use",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.emailchangeservice.email_already_in_use_description",
+			DefaultValue: "The new email address is already used by another user",
+		},
+	}
+	// ErrorAuthenticationFailed is the error returned when the caller is not authenticated.
+	ErrorAuthenticationFailed = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "EMC-1007",
+		Error: core.I18nMessage{
+			Key:          "error.emailchangeservice.authentication_failed",
+			DefaultValue: "Authentication failed",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.emailchangeservice.authentication_failed_description",
+			DefaultValue: "The caller could not be identified",
+		},
+	}
+	// ErrorInvalidRequestFormat is the error returned when the request body is malformed.
+	ErrorInvalidRequestFormat = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "EMC-1008",
+		Error: core.I18nMessage{
+			Key:          "error.emailchangeservice.invalid_request_format",
+			DefaultValue: "Invalid request format",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.emailchangeservice.invalid_request_format_description",
+			DefaultValue: "The request body is malformed or contains invalid data",
+		},
+	}
+)
+
+// Server errors for email change operations.
+var (
+	// ErrorConfirmationNotSent is the error returned when the confirmation email could not be sent.
+	ErrorConfirmationNotSent = serviceerror.ServiceError{
+		Type: serviceerror.ServerErrorType,
+		Code: "EMC-5001",
+		Error: core.I18nMessage{
+			Key:          "error.emailchangeservice.confirmation_not_sent",
+			DefaultValue: "Confirmation not sent",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.emailchangeservice.confirmation_not_sent_description",
+			DefaultValue: "The email address change could not be confirmed as the confirmation email was not sent",
+		},
+	}
+)
@@ -0,0 +1,135 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package emailchange
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/internal/system/security"
+	sysutils "github.com/thunder-id/thunderid/internal/system/utils"
+)
+
+// emailChangeHandler is the handler for email change operations.
+type emailChangeHandler struct {
+	emailChangeService EmailChangeServiceInterface
+}
+
+// newEmailChangeHandler creates a new instance of emailChangeHandler.
+func newEmailChangeHandler(emailChangeService EmailChangeServiceInterface) *emailChangeHandler {
+	return &emailChangeHandler{
+		emailChangeService: emailChangeService,
+	}
+}
+
+// HandleSelfGetRequest handles the request to retrieve the pending email change of the authenticated user.
+func (h *emailChangeHandler) HandleSelfGetRequest(w http.ResponseWriter, r *http.Request) {
+	userID, ok := getSelfUserID(w, r)
+	if !ok {
+		return
+	}
+	h.writePendingChange(w, r, userID)
+}
+
+// HandleSelfCancelRequest handles the request to cancel the pending email change of the authenticated user.
+func (h *emailChangeHandler) HandleSelfCancelRequest(w http.ResponseWriter, r *http.Request) {
+	userID, ok := getSelfUserID(w, r)
+	if !ok {
+		return
+	}
+	h.cancelPendingChange(w, r, userID)
+}
+
+// HandleGetRequest handles the request to retrieve the pending email change of the user given in the
+// userId query parameter.
+func (h *emailChangeHandler) HandleGetRequest(w http.ResponseWriter, r *http.Request) {
+	h.writePendingChange(w, r, sysutils.SanitizeString(r.URL.Query().Get("userId")))
+}
+
+// HandleCancelRequest handles the request to cancel the pending email change of the user given in the
+// userId query parameter.
+func (h *emailChangeHandler) HandleCancelRequest(w http.ResponseWriter, r *http.Request) {
+	h.cancelPendingChange(w, r, sysutils.SanitizeString(r.URL.Query().Get("userId")))
+}
+
+// HandleConfirmRequest handles the request to confirm a pending email change with a confirmation token.
+func (h *emailChangeHandler) HandleConfirmRequest(w http.ResponseWriter, r *http.Request) {
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, handlerLoggerComponentName))
+
+	request, err := sysutils.DecodeJSONBody[ConfirmationRequest](r)
+	if err != nil {
+		sysutils.WriteServiceErrorResponse(w, &ErrorInvalidRequestFormat, clientErrorStatusCodes)
+		return
+	}
+
+	response, svcErr := h.emailChangeService.ConfirmEmailChange(r.Context(), strings.TrimSpace(request.Token))
+	if svcErr != nil {
+		sysutils.WriteServiceErrorResponse(w, svcErr, clientErrorStatusCodes)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(w, http.StatusOK, response)
+	logger.Debug("Email change confirmation response sent", log.String("status", string(response.Status)))
+}
+
+// writePendingChange writes the pending email change of a user to the response.
+func (h *emailChangeHandler) writePendingChange(w http.ResponseWriter, r *http.Request, userID string) {
+	change, svcErr := h.emailChangeService.GetPendingChange(r.Context(), userID)
+	if svcErr != nil {
+		sysutils.WriteServiceErrorResponse(w, svcErr, clientErrorStatusCodes)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(w, http.StatusOK, change)
+}
+
+// cancelPendingChange cancels the pending email change of a user and writes the response.
+func (h *emailChangeHandler) cancelPendingChange(w http.ResponseWriter, r *http.Request, userID string) {
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, handlerLoggerComponentName))
+
+	if svcErr := h.emailChangeService.CancelPendingChange(r.Context(), userID); svcErr != nil {
+		sysutils.WriteServiceErrorResponse(w, svcErr, clientErrorStatusCodes)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+	logger.Debug("Email change cancel response sent", log.MaskedString(log.LoggerKeyUserID, userID))
+}
+
+// getSelfUserID returns the ID of the authenticated user, writing an error response if it is missing.
+func getSelfUserID(w http.ResponseWriter, r *http.Request) (string, bool) {
+	userID := security.GetSubject(r.Context())
+	if strings.TrimSpace(userID) == "" {
+		sysutils.WriteServiceErrorResponse(w, &ErrorAuthenticationFailed, clientErrorStatusCodes)
+		return "", false
+	}
+	return userID, true
+}
+
+// clientErrorStatusCodes maps the client errors of the handler to HTTP status codes. Other client errors are
+// returned with 400 Bad Request.
+var clientErrorStatusCodes = map[string]int{
+	ErrorUserNotFound.Code:              http.StatusNotFound,
+	ErrorPendingChangeNotFound.Code:     http.StatusNotFound,
+	ErrorEmailAlreadyInUse.Code:         http.StatusConflict,
+	ErrorAuthenticationFailed.Code:      http.StatusUnauthorized,
+	serviceerror.ErrorUnauthorized.Code: http.StatusForbidden,
+}
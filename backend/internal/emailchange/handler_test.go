@@ -0,0 +1,142 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package emailchange
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/system/error/apierror"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/security"
+)
+
+type HandlerTestSuite struct {
+	suite.Suite
+	mockService *EmailChangeServiceInterfaceMock
+	handler     *emailChangeHandler
+}
+
+func TestHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(HandlerTestSuite))
+}
+
+func (s *HandlerTestSuite) SetupTest() {
+	s.mockService = NewEmailChangeServiceInterfaceMock(s.T())
+	s.handler = newEmailChangeHandler(s.mockService)
+}
+
+func (s *HandlerTestSuite) newSelfRequest(method, target string) *http.Request {
+	req := httptest.NewRequest(method, target, nil)
+	authCtx := security.NewSecurityContextForTest(testUserID, "", "", nil, nil)
+	return req.WithContext(security.WithSecurityContextTest(req.Context(), authCtx))
+}
+
+func (s *HandlerTestSuite) TestHandleSelfGetRequest() {
+	s.mockService.On("GetPendingChange", mock.Anything, testUserID).Return(&PendingEmailChange{
+		UserID: testUserID, NewEmail: testNewEmail, NewTokenHash: "token-hash",
+	}, nil).Once()
+
+	rr := httptest.NewRecorder()
+	s.handler.HandleSelfGetRequest(rr, s.newSelfRequest(http.MethodGet, "/users/me/email-change"))
+
+	s.Equal(http.StatusOK, rr.Code)
+	s.NotContains(rr.Body.String(), "token-hash")
+	var body PendingEmailChange
+	s.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &body))
+	s.Equal(testNewEmail, body.NewEmail)
+}
+
+func (s *HandlerTestSuite) TestHandleSelfGetRequest_Unauthenticated() {
+	rr := httptest.NewRecorder()
+	s.handler.HandleSelfGetRequest(rr, httptest.NewRequest(http.MethodGet, "/users/me/email-change", nil))
+
+	s.Equal(http.StatusUnauthorized, rr.Code)
+}
+
+func (s *HandlerTestSuite) TestHandleSelfCancelRequest() {
+	s.mockService.On("CancelPendingChange", mock.Anything, testUserID).Return(nil).Once()
+
+	rr := httptest.NewRecorder()
+	s.handler.HandleSelfCancelRequest(rr, s.newSelfRequest(http.MethodDelete, "/users/me/email-change"))
+
+	s.Equal(http.StatusNoContent, rr.Code)
+}
+
+func (s *HandlerTestSuite) TestHandleGetRequest_NotFound() {
+	s.mockService.On("GetPendingChange", mock.Anything, testUserID).
+		Return(nil, &ErrorPendingChangeNotFound).Once()
+
+	rr := httptest.NewRecorder()
+	s.handler.HandleGetRequest(rr, httptest.NewRequest(http.MethodGet, "/email-change?userId="+testUserID, nil))
+
+	s.Equal(http.StatusNotFound, rr.Code)
+	var body apierror.ErrorResponse
+	s.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &body))
+	s.Equal(ErrorPendingChangeNotFound.Code, body.Code)
+}
+
+func (s *HandlerTestSuite) TestHandleCancelRequest_Forbidden() {
+	s.mockService.On("CancelPendingChange", mock.Anything, testUserID).
+		Return(&serviceerror.ErrorUnauthorized).Once()
+
+	rr := httptest.NewRecorder()
+	s.handler.HandleCancelRequest(rr, httptest.NewRequest(http.MethodDelete, "/email-change?userId="+testUserID, nil))
+
+	s.Equal(http.StatusForbidden, rr.Code)
+}
+
+func (s *HandlerTestSuite) TestHandleConfirmRequest() {
+	s.mockService.On("ConfirmEmailChange", mock.Anything, testToken).
+		Return(&ConfirmationResponse{Status: ConfirmationStatusCompleted}, nil).Once()
+
+	rr := httptest.NewRecorder()
+	s.handler.HandleConfirmRequest(rr, httptest.NewRequest(http.MethodPost, "/email-change/confirm",
+		strings.NewReader(`{"token":"`+testToken+`"}`)))
+
+	s.Equal(http.StatusOK, rr.Code)
+	var body ConfirmationResponse
+	s.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &body))
+	s.Equal(ConfirmationStatusCompleted, body.Status)
+}
+
+func (s *HandlerTestSuite) TestHandleConfirmRequest_InvalidBody() {
+	rr := httptest.NewRecorder()
+	s.handler.HandleConfirmRequest(rr, httptest.NewRequest(http.MethodPost, "/email-change/confirm",
+		strings.NewReader("not-json")))
+
+	s.Equal(http.StatusBadRequest, rr.Code)
+}
+
+func (s *HandlerTestSuite) TestHandleConfirmRequest_AddressTaken() {
+	s.mockService.On("ConfirmEmailChange", mock.Anything, testToken).
+		Return(nil, &ErrorEmailAlreadyInUse).Once()
+
+	rr := httptest.NewRecorder()
+	s.handler.HandleConfirmRequest(rr, httptest.NewRequest(http.MethodPost, "/email-change/confirm",
+		strings.NewReader(`{"token":"`+testToken+`"}`)))
+
+	s.Equal(http.StatusConflict, rr.Code)
+}
@@ -0,0 +1,115 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package emailchange
+
+import (
+	"net/http"
+	"net/url"
+
+	"github.com/thunder-id/thunderid/internal/accountprotection"
+	"github.com/thunder-id/thunderid/internal/entity"
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/email"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/internal/system/middleware"
+	"github.com/thunder-id/thunderid/internal/system/sysauthz"
+	"github.com/thunder-id/thunderid/internal/system/template"
+)
+
+// Initialize initializes the email change service and registers its routes. It returns nil when email
+// change confirmation is disabled. The email client and the account protection service may be nil when
+// email is not configured and account protection is disabled respectively.
+func Initialize(
+	mux *http.ServeMux,
+	entityService entity.EntityServiceInterface,
+	authzService sysauthz.SystemAuthorizationServiceInterface,
+	templateService template.TemplateServiceInterface,
+	emailClient email.EmailClientInterface,
+	accountProtection accountprotection.AccountProtectionServiceInterface,
+) EmailChangeServiceInterface {
+	runtime := config.GetServerRuntime()
+	emailChangeConfig := runtime.Config.EmailChange
+	if !emailChangeConfig.Enabled {
+		return nil
+	}
+	if emailClient == nil {
+		log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName)).Warn(
+			"Email is not configured, email address changes cannot be confirmed until it is")
+	}
+
+	emailChangeService := newEmailChangeService(newEmailChangeStore(), entityService, authzService,
+		templateService, emailClient, emailChangeConfig.EmailAttribute, emailChangeConfig.ConfirmOldAddress,
+		emailChangeConfig.ValidityPeriod, getConfirmationURL(runtime), accountProtection)
+
+	emailChangeHandler := newEmailChangeHandler(emailChangeService)
+	registerRoutes(mux, emailChangeHandler)
+
+	return emailChangeService
+}
+
+// getConfirmationURL returns the configured confirmation page URL, or the email change confirmation page of
+// the gate client when none is configured.
+func getConfirmationURL(runtime *config.ServerRuntime) *url.URL {
+	if configured := runtime.Config.EmailChange.ConfirmationURL; configured != "" {
+		// The URL is validated when the configuration is loaded.
+		if parsed, err := url.Parse(configured); err == nil {
+			return parsed
+		}
+	}
+	return runtime.GateClientLoginURL.ResolveReference(&url.URL{Path: defaultConfirmationPath})
+}
+
+// registerRoutes registers the routes for email change operations.
+func registerRoutes(mux *http.ServeMux, emailChangeHandler *emailChangeHandler) {
+	opts1 := middleware.CORSOptions{
+		AllowedMethods:   []string{"GET", "DELETE"},
+		AllowedHeaders:   middleware.DefaultAllowedHeaders,
+		AllowCredentials: true,
+		MaxAge:           600,
+	}
+	mux.HandleFunc(middleware.WithCORS("GET /users/me/email-change",
+		emailChangeHandler.HandleSelfGetRequest, opts1))
+	mux.HandleFunc(middleware.WithCORS("DELETE /users/me/email-change",
+		emailChangeHandler.HandleSelfCancelRequest, opts1))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS /users/me/email-change",
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, opts1))
+	mux.HandleFunc(middleware.WithCORS("GET /email-change",
+		emailChangeHandler.HandleGetRequest, opts1))
+	mux.HandleFunc(middleware.WithCORS("DELETE /email-change",
+		emailChangeHandler.HandleCancelRequest, opts1))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS /email-change",
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, opts1))
+
+	opts2 := middleware.CORSOptions{
+		AllowedMethods:   []string{"POST"},
+		AllowedHeaders:   middleware.DefaultAllowedHeaders,
+		AllowCredentials: true,
+		MaxAge:           600,
+	}
+	mux.HandleFunc(middleware.WithCORS("POST /email-change/confirm",
+		emailChangeHandler.HandleConfirmRequest, opts2))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS /email-change/confirm",
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, opts2))
+}
@@ -0,0 +1,51 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package emailchange provides the confirm-before-apply workflow of email address changes, which keeps a
+// changed email address pending until it is confirmed from the new, and optionally the old, address.
+package emailchange
+
+import "time"
+
+// PendingEmailChange represents an email address change awaiting confirmation.
+type PendingEmailChange struct {
+	UserID                         string     `json:"userId"`
+	NewEmail                       string     `json:"newEmail"`
+	NewTokenHash                   string     `json:"-"`
+	OldTokenHash                   string     `json:"-"`
+	NewAddressConfirmedAt          *time.Time `json:"newAddressConfirmedAt,omitempty"`
+	OldAddressConfirmationRequired bool       `json:"oldAddressConfirmationRequired"`
+	OldAddressConfirmedAt          *time.Time `json:"oldAddressConfirmedAt,omitempty"`
+	CreatedAt                      time.Time  `json:"createdAt"`
+	ExpiresAt                      time.Time  `json:"expiresAt"`
+}
+
+// isConfirmed reports whether the change is confirmed from every address it needs to be confirmed from.
+func (c *PendingEmailChange) isConfirmed() bool {
+	return c.NewAddressConfirmedAt != nil && (!c.OldAddressConfirmationRequired || c.OldAddressConfirmedAt != nil)
+}
+
+// ConfirmationRequest represents the request to confirm a pending email change.
+type ConfirmationRequest struct {
+	Token string `json:"token"`
+}
+
+// ConfirmationResponse represents the result of confirming a pending email change.
+type ConfirmationResponse struct {
+	Status ConfirmationStatus `json:"status"`
+}
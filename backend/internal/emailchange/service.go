@@ -0,0 +1,411 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package emailchange
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"html"
+	"net/mail"
+	"net/url"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/accountprotection"
+	"github.com/thunder-id/thunderid/internal/entity"
+	"github.com/thunder-id/thunderid/internal/system/cryptolab"
+	"github.com/thunder-id/thunderid/internal/system/email"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/internal/system/security"
+	"github.com/thunder-id/thunderid/internal/system/sysauthz"
+	"github.com/thunder-id/thunderid/internal/system/template"
+)
+
+// EmailChangeServiceInterface defines the interface for the email change service.
+type EmailChangeServiceInterface interface {
+	GetEmailAttribute() string
+	RequestEmailChange(ctx context.Context, userID, currentEmail, newEmail string) *serviceerror.ServiceError
+	GetPendingChange(ctx context.Context, userID string) (*PendingEmailChange, *serviceerror.ServiceError)
+	CancelPendingChange(ctx context.Context, userID string) *serviceerror.ServiceError
+	ConfirmEmailChange(ctx context.Context, token string) (*ConfirmationResponse, *serviceerror.ServiceError)
+}
+
+// emailChangeService is the default implementation of the EmailChangeServiceInterface.
+type emailChangeService struct {
+	store             emailChangeStoreInterface
+	entityService     entity.EntityServiceInterface
+	authzService      sysauthz.SystemAuthorizationServiceInterface
+	templateService   template.TemplateServiceInterface
+	emailClient       email.EmailClientInterface
+	emailAttribute    string
+	confirmOldAddress bool
+	validityPeriod    int64
+	confirmationURL   *url.URL
+	accountProtection accountprotection.AccountProtectionServiceInterface
+	now               func() time.Time
+	logger            *log.Logger
+}
+
+// newEmailChangeService creates a new instance of emailChangeService. The email client may be nil when
+// email is not configured, in which case email changes cannot be requested. The account protection service
+// may be nil when account protection is disabled, in which case applied changes are not notified.
+func newEmailChangeService(
+	store emailChangeStoreInterface,
+	entityService entity.EntityServiceInterface,
+	authzService sysauthz.SystemAuthorizationServiceInterface,
+	templateService template.TemplateServiceInterface,
+	emailClient email.EmailClientInterface,
+	emailAttribute string,
+	confirmOldAddress bool,
+	validityPeriod int64,
+	confirmationURL *url.URL,
+	accountProtection accountprotection.AccountProtectionServiceInterface,
+) EmailChangeServiceInterface {
+	if validityPeriod <= 0 {
+		validityPeriod = defaultValidityPeriod
+	}
+
+	return &emailChangeService{
+		store:             store,
+		entityService:     entityService,
+		authzService:      authzService,
+		templateService:   templateService,
+		emailClient:       emailClient,
+		emailAttribute:    emailAttribute,
+		confirmOldAddress: confirmOldAddress,
+		validityPeriod:    validityPeriod,
+		confirmationURL:   confirmationURL,
+		accountProtection: accountProtection,
+		now:               time.Now,
+		logger:            log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName)),
+	}
+}
+
+// GetEmailAttribute returns the user attribute holding the email address.
+func (s *emailChangeService) GetEmailAttribute() string {
+	return s.emailAttribute
+}
+
+// RequestEmailChange records a pending change of the email address of a user and sends the confirmation
+// links. Any earlier pending change of the user is replaced. The caller is responsible for checking that
+// the change is allowed, as this is called while updating the user.
+// When confirmation from the old address is configured and the user has a current address, the change is
+// also confirmed from it.
+func (s *emailChangeService) RequestEmailChange(
+	ctx context.Context, userID, currentEmail, newEmail string,
+) *serviceerror.ServiceError {
+	if userID == "" {
+		return &ErrorMissingUserID
+	}
+	if parsed, err := mail.ParseAddress(newEmail); err != nil || parsed.Address != newEmail {
+		return &ErrorInvalidEmail
+	}
+	if s.emailClient == nil {
+		s.logger.Error("Email is not configured, cannot send the email change confirmation")
+		return &ErrorConfirmationNotSent
+	}
+
+	now := s.now().UTC()
+	change := PendingEmailChange{
+		UserID:    userID,
+		NewEmail:  newEmail,
+		CreatedAt: now,
+		ExpiresAt: now.Add(time.Duration(s.validityPeriod) * time.Second),
+	}
+	newToken, err := cryptolab.GenerateSecureToken()
+	if err != nil {
+		s.logger.Error("Failed to generate email change confirmation token", log.Error(err))
+		return &serviceerror.InternalServerError
+	}
+	change.NewTokenHash = cryptolab.HashToken(newToken)
+
+	var oldToken string
+	if s.confirmOldAddress && currentEmail != "" {
+		if oldToken, err = cryptolab.GenerateSecureToken(); err != nil {
+			s.logger.Error("Failed to generate email change confirmation token", log.Error(err))
+			return &serviceerror.InternalServerError
+		}
+		change.OldTokenHash = cryptolab.HashToken(oldToken)
+		change.OldAddressConfirmationRequired = true
+	}
+
+	if _, err := s.store.DeletePendingChange(ctx, userID); err != nil {
+		s.logger.Error("Failed to remove the earlier pending email change",
+			log.MaskedString(log.LoggerKeyUserID, userID), log.Error(err))
+		return &serviceerror.InternalServerError
+	}
+	if err := s.store.CreatePendingChange(ctx, change); err != nil {
+		s.logger.Error("Failed to store pending email change", log.MaskedString(log.LoggerKeyUserID, userID),
+			log.Error(err))
+		return &serviceerror.InternalServerError
+	}
+
+	svcErr := s.sendConfirmation(ctx, template.ScenarioEmailChange, newEmail, newToken, change)
+	if svcErr == nil && oldToken != "" {
+		svcErr = s.sendConfirmation(ctx, template.ScenarioEmailChangeOldAddress, currentEmail, oldToken, change)
+	}
+	if svcErr != nil {
+		// A change that cannot be confirmed must not linger as pending.
+		if _, err := s.store.DeletePendingChange(ctx, userID); err != nil {
+			s.logger.Warn("Failed to remove unconfirmable pending email change",
+				log.MaskedString(log.LoggerKeyUserID, userID), log.Error(err))
+		}
+		return svcErr
+	}
+
+	s.logger.Debug("Requested email change", log.MaskedString(log.LoggerKeyUserID, userID))
+	return nil
+}
+
+// sendConfirmation renders the email template of the scenario with the confirmation link of the token and
+// sends it to the given address.
+func (s *emailChangeService) sendConfirmation(ctx context.Context, scenario template.ScenarioType,
+	recipient, token string, change PendingEmailChange) *serviceerror.ServiceError {
+	link := *s.confirmationURL
+	query := link.Query()
+	query.Set(confirmationTokenParam, token)
+	link.RawQuery = query.Encode()
+
+	// Email templates are HTML, and the new address is supplied by the user.
+	rendered, svcErr := s.templateService.Render(ctx, scenario, template.TemplateTypeEmail, template.TemplateData{
+		templateDataKeyConfirmationLink: html.EscapeString(link.String()),
+		templateDataKeyNewEmail:         html.EscapeString(change.NewEmail),
+		templateDataKeyExpiryTime:       change.ExpiresAt.Format(time.RFC1123),
+	})
+	if svcErr != nil {
+		s.logger.Error("Failed to render the email change confirmation", log.String("scenario", string(scenario)),
+			log.String("error", svcErr.Code))
+		return &ErrorConfirmationNotSent
+	}
+
+	if err := s.emailClient.Send(email.EmailData{
+		To:      []string{recipient},
+		Subject: rendered.Subject,
+		Body:    rendered.Body,
+		IsHTML:  rendered.IsHTML,
+	}); err != nil {
+		s.logger.Error("Failed to send the email change confirmation", log.String("scenario", string(scenario)),
+			log.Error(err))
+		return &ErrorConfirmationNotSent
+	}
+	return nil
+}
+
+// GetPendingChange retrieves the unexpired pending email change of a user.
+func (s *emailChangeService) GetPendingChange(
+	ctx context.Context, userID string,
+) (*PendingEmailChange, *serviceerror.ServiceError) {
+	if svcErr := s.checkUserAccess(ctx, security.ActionReadUser, userID); svcErr != nil {
+		return nil, svcErr
+	}
+
+	change, err := s.store.GetPendingChange(ctx, userID)
+	if err != nil {
+		if errors.Is(err, ErrPendingEmailChangeNotFound) {
+			return nil, &ErrorPendingChangeNotFound
+		}
+		s.logger.Error("Failed to retrieve pending email change", log.MaskedString(log.LoggerKeyUserID, userID),
+			log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+	if !s.now().UTC().Before(change.ExpiresAt) {
+		return nil, &ErrorPendingChangeNotFound
+	}
+	return change, nil
+}
+
+// CancelPendingChange cancels the pending email change of a user.
+func (s *emailChangeService) CancelPendingChange(ctx context.Context, userID string) *serviceerror.ServiceError {
+	if svcErr := s.checkUserAccess(ctx, security.ActionUpdateUser, userID); svcErr != nil {
+		return svcErr
+	}
+
+	deleted, err := s.store.DeletePendingChange(ctx, userID)
+	if err != nil {
+		s.logger.Error("Failed to cancel pending email change", log.MaskedString(log.LoggerKeyUserID, userID),
+			log.Error(err))
+		return &serviceerror.InternalServerError
+	}
+	if !deleted {
+		return &ErrorPendingChangeNotFound
+	}
+
+	s.logger.Debug("Cancelled pending email change", log.MaskedString(log.LoggerKeyUserID, userID))
+	return nil
+}
+
+// ConfirmEmailChange confirms a pending email change from the address the token was sent to. Once the
+// change is confirmed from every required address, the new address is applied to the user.
+// Possession of the token is the proof of ownership of the address, so the caller needs no authorization.
+func (s *emailChangeService) ConfirmEmailChange(
+	ctx context.Context, token string,
+) (*ConfirmationResponse, *serviceerror.ServiceError) {
+	if token == "" {
+		return nil, &ErrorInvalidConfirmationToken
+	}
+	tokenHash := cryptolab.HashToken(token)
+
+	change, err := s.store.GetPendingChangeByTokenHash(ctx, tokenHash)
+	if err != nil {
+		if errors.Is(err, ErrPendingEmailChangeNotFound) {
+			return nil, &ErrorInvalidConfirmationToken
+		}
+		s.logger.Error("Failed to retrieve pending email change", log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+
+	now := s.now().UTC()
+	if !now.Before(change.ExpiresAt) {
+		s.logger.Debug("Pending email change has expired", log.MaskedString(log.LoggerKeyUserID, change.UserID))
+		return nil, &ErrorInvalidConfirmationToken
+	}
+
+	var confirmed bool
+	if subtle.ConstantTimeCompare([]byte(change.NewTokenHash), []byte(tokenHash)) == 1 {
+		confirmed, err = s.store.ConfirmNewAddress(ctx, change.UserID, tokenHash, now)
+	} else {
+		confirmed, err = s.store.ConfirmOldAddress(ctx, change.UserID, tokenHash, now)
+	}
+	if err != nil {
+		s.logger.Error("Failed to confirm pending email change", log.MaskedString(log.LoggerKeyUserID, change.UserID),
+			log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+	if !confirmed {
+		// The change was replaced or cancelled after it was read.
+		return nil, &ErrorInvalidConfirmationToken
+	}
+
+	// Read the change again so that a confirmation of the other address made meanwhile is taken into account.
+	change, err = s.store.GetPendingChange(ctx, change.UserID)
+	if err != nil {
+		if errors.Is(err, ErrPendingEmailChangeNotFound) {
+			return nil, &ErrorInvalidConfirmationToken
+		}
+		s.logger.Error("Failed to retrieve pending email change", log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+	if !change.isConfirmed() {
+		s.logger.Debug("Pending email change awaits further confirmation",
+			log.MaskedString(log.LoggerKeyUserID, change.UserID))
+		return &ConfirmationResponse{Status: ConfirmationStatusPending}, nil
+	}
+
+	if svcErr := s.applyChange(ctx, change); svcErr != nil {
+		return nil, svcErr
+	}
+	return &ConfirmationResponse{Status: ConfirmationStatusCompleted}, nil
+}
+
+// applyChange applies the new email address of a confirmed change to the user and removes the change.
+func (s *emailChangeService) applyChange(ctx context.Context, change *PendingEmailChange) *serviceerror.ServiceError {
+	userEntity, err := s.entityService.GetEntity(ctx, change.UserID)
+	if err != nil {
+		if errors.Is(err, entity.ErrEntityNotFound) {
+			s.discardChange(ctx, change.UserID)
+			return &ErrorUserNotFound
+		}
+		s.logger.Error("Failed to retrieve user", log.MaskedString(log.LoggerKeyUserID, change.UserID),
+			log.Error(err))
+		return &serviceerror.InternalServerError
+	}
+
+	attributes := map[string]interface{}{}
+	if len(userEntity.Attributes) > 0 {
+		if err := json.Unmarshal(userEntity.Attributes, &attributes); err != nil {
+			s.logger.Error("Failed to parse user attributes", log.MaskedString(log.LoggerKeyUserID, change.UserID),
+				log.Error(err))
+			return &serviceerror.InternalServerError
+		}
+	}
+	previousEmail, _ := attributes[s.emailAttribute].(string)
+	attributes[s.emailAttribute] = change.NewEmail
+	updatedAttributes, err := json.Marshal(attributes)
+	if err != nil {
+		s.logger.Error("Failed to marshal user attributes", log.Error(err))
+		return &serviceerror.InternalServerError
+	}
+
+	if err := s.entityService.UpdateAttributes(ctx, change.UserID, updatedAttributes); err != nil {
+		switch {
+		case errors.Is(err, entity.ErrAttributeConflict):
+			s.discardChange(ctx, change.UserID)
+			return &ErrorEmailAlreadyInUse
+		case errors.Is(err, entity.ErrSchemaValidationFailed):
+			s.discardChange(ctx, change.UserID)
+			return &ErrorInvalidEmail
+		default:
+			s.logger.Error("Failed to apply email change", log.MaskedString(log.LoggerKeyUserID, change.UserID),
+				log.Error(err))
+			return &serviceerror.InternalServerError
+		}
+	}
+
+	s.discardChange(ctx, change.UserID)
+	if s.accountProtection != nil {
+		s.accountProtection.NotifyEmailChanged(ctx, change.UserID, previousEmail, change.NewEmail)
+	}
+	s.logger.Debug("Applied email change", log.MaskedString(log.LoggerKeyUserID, change.UserID))
+	return nil
+}
+
+// discardChange removes a pending email change that was applied or can no longer be applied.
+func (s *emailChangeService) discardChange(ctx context.Context, userID string) {
+	if _, err := s.store.DeletePendingChange(ctx, userID); err != nil {
+		s.logger.Warn("Failed to remove pending email change", log.MaskedString(log.LoggerKeyUserID, userID),
+			log.Error(err))
+	}
+}
+
+// checkUserAccess checks whether the caller is allowed to perform the action on the pending email change
+// of the user.
+func (s *emailChangeService) checkUserAccess(
+	ctx context.Context, action security.Action, userID string,
+) *serviceerror.ServiceError {
+	if userID == "" {
+		return &ErrorMissingUserID
+	}
+
+	user, err := s.entityService.GetEntity(ctx, userID)
+	if err != nil {
+		if errors.Is(err, entity.ErrEntityNotFound) {
+			return &ErrorUserNotFound
+		}
+		s.logger.Error("Failed to retrieve user", log.MaskedString(log.LoggerKeyUserID, userID), log.Error(err))
+		return &serviceerror.InternalServerError
+	}
+	if user.Category != entity.EntityCategoryUser {
+		return &ErrorUserNotFound
+	}
+
+	allowed, svcErr := s.authzService.IsActionAllowed(ctx, action, &sysauthz.ActionContext{
+		ResourceType: security.ResourceTypeUser, OUID: user.OUID, ResourceID: userID,
+	})
+	if svcErr != nil {
+		s.logger.Error("Failed to check authorization for action",
+			log.String("action", string(action)), log.Any("error", svcErr))
+		return &serviceerror.InternalServerError
+	}
+	if !allowed {
+		return &serviceerror.ErrorUnauthorized
+	}
+	return nil
+}
@@ -0,0 +1,409 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package emailchange
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/entity"
+	"github.com/thunder-id/thunderid/internal/system/cryptolab"
+	"github.com/thunder-id/thunderid/internal/system/email"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/security"
+	"github.com/thunder-id/thunderid/internal/system/template"
+	"github.com/thunder-id/thunderid/tests/mocks/accountprotectionmock"
+	"github.com/thunder-id/thunderid/tests/mocks/emailmock"
+	"github.com/thunder-id/thunderid/tests/mocks/entitymock"
+	"github.com/thunder-id/thunderid/tests/mocks/sysauthzmock"
+	"github.com/thunder-id/thunderid/tests/mocks/templatemock"
+)
+
+const (
+	testUserID   = "user-1"
+	testOUID     = "ou-1"
+	testOldEmail = "old@example.com"
+	testNewEmail = "new@example.com"
+	testToken    = "confirmation-token"
+)
+
+type EmailChangeServiceTestSuite struct {
+	suite.Suite
+	mockStore    *emailChangeStoreInterfaceMock
+	mockEntity   *entitymock.EntityServiceInterfaceMock
+	mockAuthz    *sysauthzmock.SystemAuthorizationServiceInterfaceMock
+	mockTemplate *templatemock.TemplateServiceInterfaceMock
+	mockEmail    *emailmock.EmailClientInterfaceMock
+	service      *emailChangeService
+	now          time.Time
+}
+
+func TestEmailChangeServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(EmailChangeServiceTestSuite))
+}
+
+func (suite *EmailChangeServiceTestSuite) SetupTest() {
+	suite.mockStore = newEmailChangeStoreInterfaceMock(suite.T())
+	suite.mockEntity = entitymock.NewEntityServiceInterfaceMock(suite.T())
+	suite.mockAuthz = sysauthzmock.NewSystemAuthorizationServiceInterfaceMock(suite.T())
+	suite.mockTemplate = templatemock.NewTemplateServiceInterfaceMock(suite.T())
+	suite.mockEmail = emailmock.NewEmailClientInterfaceMock(suite.T())
+	suite.service = suite.newService(false)
+	suite.now = time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+}
+
+func (suite *EmailChangeServiceTestSuite) newService(confirmOldAddress bool) *emailChangeService {
+	confirmationURL, _ := url.Parse("https://gate.test/gate/email-change/confirm")
+	service := newEmailChangeService(suite.mockStore, suite.mockEntity, suite.mockAuthz, suite.mockTemplate,
+		suite.mockEmail, "email", confirmOldAddress, 3600, confirmationURL, nil).(*emailChangeService)
+	service.now = func() time.Time { return suite.now }
+	return service
+}
+
+func (suite *EmailChangeServiceTestSuite) expectUserAccess(action security.Action, allowed bool) {
+	suite.mockEntity.On("GetEntity", mock.Anything, testUserID).Return(&entity.Entity{
+		ID: testUserID, Category: entity.EntityCategoryUser, OUID: testOUID,
+	}, nil).Once()
+	suite.mockAuthz.On("IsActionAllowed", mock.Anything, action, mock.Anything).Return(allowed, nil).Once()
+}
+
+func (suite *EmailChangeServiceTestSuite) expectConfirmationSent(scenario template.ScenarioType,
+	recipient string) *string {
+	var link string
+	suite.mockTemplate.On("Render", mock.Anything, scenario, template.TemplateTypeEmail,
+		mock.MatchedBy(func(data template.TemplateData) bool {
+			return data[templateDataKeyNewEmail] == testNewEmail
+		})).Run(func(args mock.Arguments) {
+		link = args.Get(3).(template.TemplateData)[templateDataKeyConfirmationLink]
+	}).Return(&template.RenderedTemplate{Subject: "subject", Body: "body", IsHTML: true}, nil).Once()
+	suite.mockEmail.On("Send", mock.MatchedBy(func(data email.EmailData) bool {
+		return len(data.To) == 1 && data.To[0] == recipient
+	})).Return(nil).Once()
+	return &link
+}
+
+// tokenOf extracts the confirmation token from an HTML escaped confirmation link.
+func tokenOf(link string) string {
+	parsed, _ := url.Parse(strings.ReplaceAll(link, "&amp;", "&"))
+	return parsed.Query().Get(confirmationTokenParam)
+}
+
+func (suite *EmailChangeServiceTestSuite) pendingChange() *PendingEmailChange {
+	return &PendingEmailChange{
+		UserID:       testUserID,
+		NewEmail:     testNewEmail,
+		NewTokenHash: cryptolab.HashToken(testToken),
+		CreatedAt:    suite.now.Add(-time.Minute),
+		ExpiresAt:    suite.now.Add(time.Hour),
+	}
+}
+
+func (suite *EmailChangeServiceTestSuite) TestRequestEmailChange_SendsToNewAddress() {
+	var stored PendingEmailChange
+	suite.mockStore.On("DeletePendingChange", mock.Anything, testUserID).Return(false, nil).Once()
+	suite.mockStore.EXPECT().CreatePendingChange(mock.Anything, mock.Anything).Run(
+		func(_ context.Context, change PendingEmailChange) { stored = change }).Return(nil).Once()
+	link := suite.expectConfirmationSent(template.ScenarioEmailChange, testNewEmail)
+
+	svcErr := suite.service.RequestEmailChange(context.Background(), testUserID, testOldEmail, testNewEmail)
+
+	suite.Nil(svcErr)
+	suite.Equal(testNewEmail, stored.NewEmail)
+	suite.Equal(suite.now.Add(time.Hour), stored.ExpiresAt)
+	suite.Empty(stored.OldTokenHash)
+	suite.True(strings.HasPrefix(*link, "https://gate.test/gate/email-change/confirm?token="))
+	suite.Equal(stored.NewTokenHash, cryptolab.HashToken(tokenOf(*link)))
+}
+
+func (suite *EmailChangeServiceTestSuite) TestRequestEmailChange_ConfirmsOldAddress() {
+	service := suite.newService(true)
+	var stored PendingEmailChange
+	suite.mockStore.On("DeletePendingChange", mock.Anything, testUserID).Return(true, nil).Once()
+	suite.mockStore.EXPECT().CreatePendingChange(mock.Anything, mock.Anything).Run(
+		func(_ context.Context, change PendingEmailChange) { stored = change }).Return(nil).Once()
+	newLink := suite.expectConfirmationSent(template.ScenarioEmailChange, testNewEmail)
+	oldLink := suite.expectConfirmationSent(template.ScenarioEmailChangeOldAddress, testOldEmail)
+
+	svcErr := service.RequestEmailChange(context.Background(), testUserID, testOldEmail, testNewEmail)
+
+	suite.Nil(svcErr)
+	suite.True(stored.OldAddressConfirmationRequired)
+	suite.Equal(stored.NewTokenHash, cryptolab.HashToken(tokenOf(*newLink)))
+	suite.Equal(stored.OldTokenHash, cryptolab.HashToken(tokenOf(*oldLink)))
+	suite.NotEqual(stored.NewTokenHash, stored.OldTokenHash)
+}
+
+func (suite *EmailChangeServiceTestSuite) TestRequestEmailChange_NoOldAddressToConfirm() {
+	service := suite.newService(true)
+	suite.mockStore.On("DeletePendingChange", mock.Anything, testUserID).Return(false, nil).Once()
+	suite.mockStore.On("CreatePendingChange", mock.Anything, mock.MatchedBy(func(change PendingEmailChange) bool {
+		return change.OldTokenHash == "" && !change.OldAddressConfirmationRequired
+	})).Return(nil).Once()
+	suite.expectConfirmationSent(template.ScenarioEmailChange, testNewEmail)
+
+	suite.Nil(service.RequestEmailChange(context.Background(), testUserID, "", testNewEmail))
+}
+
+func (suite *EmailChangeServiceTestSuite) TestRequestEmailChange_InvalidEmail() {
+	for _, newEmail := range []string{"", "not-an-email", "Name <new@example.com>"} {
+		svcErr := suite.service.RequestEmailChange(context.Background(), testUserID, testOldEmail, newEmail)
+
+		suite.Equal(&ErrorInvalidEmail, svcErr, newEmail)
+	}
+}
+
+func (suite *EmailChangeServiceTestSuite) TestRequestEmailChange_EmailNotConfigured() {
+	suite.service.emailClient = nil
+
+	svcErr := suite.service.RequestEmailChange(context.Background(), testUserID, testOldEmail, testNewEmail)
+
+	suite.Equal(&ErrorConfirmationNotSent, svcErr)
+	suite.mockStore.AssertNotCalled(suite.T(), "CreatePendingChange", mock.Anything, mock.Anything)
+}
+
+func (suite *EmailChangeServiceTestSuite) TestRequestEmailChange_SendFailureDiscardsChange() {
+	suite.mockStore.On("DeletePendingChange", mock.Anything, testUserID).Return(true, nil).Twice()
+	suite.mockStore.On("CreatePendingChange", mock.Anything, mock.Anything).Return(nil).Once()
+	suite.mockTemplate.On("Render", mock.Anything, template.ScenarioEmailChange, template.TemplateTypeEmail,
+		mock.Anything).Return(&template.RenderedTemplate{Subject: "subject", Body: "body"}, nil).Once()
+	suite.mockEmail.On("Send", mock.Anything).Return(errors.New("smtp error")).Once()
+
+	svcErr := suite.service.RequestEmailChange(context.Background(), testUserID, testOldEmail, testNewEmail)
+
+	suite.Equal(&ErrorConfirmationNotSent, svcErr)
+}
+
+func (suite *EmailChangeServiceTestSuite) TestRequestEmailChange_EscapesNewEmail() {
+	newEmail := "a&b@example.com"
+	suite.mockStore.On("DeletePendingChange", mock.Anything, testUserID).Return(false, nil).Once()
+	suite.mockStore.On("CreatePendingChange", mock.Anything, mock.Anything).Return(nil).Once()
+	suite.mockTemplate.On("Render", mock.Anything, template.ScenarioEmailChange, template.TemplateTypeEmail,
+		mock.MatchedBy(func(data template.TemplateData) bool {
+			return data[templateDataKeyNewEmail] == "a&amp;b@example.com"
+		})).Return(&template.RenderedTemplate{Subject: "subject", Body: "body"}, nil).Once()
+	suite.mockEmail.On("Send", mock.Anything).Return(nil).Once()
+
+	suite.Nil(suite.service.RequestEmailChange(context.Background(), testUserID, testOldEmail, newEmail))
+}
+
+func (suite *EmailChangeServiceTestSuite) TestGetPendingChange() {
+	suite.expectUserAccess(security.ActionReadUser, true)
+	suite.mockStore.On("GetPendingChange", mock.Anything, testUserID).Return(suite.pendingChange(), nil).Once()
+
+	change, svcErr := suite.service.GetPendingChange(context.Background(), testUserID)
+
+	suite.Nil(svcErr)
+	suite.Equal(testNewEmail, change.NewEmail)
+}
+
+func (suite *EmailChangeServiceTestSuite) TestGetPendingChange_Expired() {
+	change := suite.pendingChange()
+	change.ExpiresAt = suite.now
+	suite.expectUserAccess(security.ActionReadUser, true)
+	suite.mockStore.On("GetPendingChange", mock.Anything, testUserID).Return(change, nil).Once()
+
+	_, svcErr := suite.service.GetPendingChange(context.Background(), testUserID)
+
+	suite.Equal(&ErrorPendingChangeNotFound, svcErr)
+}
+
+func (suite *EmailChangeServiceTestSuite) TestGetPendingChange_Unauthorized() {
+	suite.expectUserAccess(security.ActionReadUser, false)
+
+	_, svcErr := suite.service.GetPendingChange(context.Background(), testUserID)
+
+	suite.Equal(&serviceerror.ErrorUnauthorized, svcErr)
+}
+
+func (suite *EmailChangeServiceTestSuite) TestGetPendingChange_UserNotFound() {
+	suite.mockEntity.On("GetEntity", mock.Anything, testUserID).Return(nil, entity.ErrEntityNotFound).Once()
+
+	_, svcErr := suite.service.GetPendingChange(context.Background(), testUserID)
+
+	suite.Equal(&ErrorUserNotFound, svcErr)
+}
+
+func (suite *EmailChangeServiceTestSuite) TestCancelPendingChange() {
+	suite.expectUserAccess(security.ActionUpdateUser, true)
+	suite.mockStore.On("DeletePendingChange", mock.Anything, testUserID).Return(true, nil).Once()
+
+	suite.Nil(suite.service.CancelPendingChange(context.Background(), testUserID))
+}
+
+func (suite *EmailChangeServiceTestSuite) TestCancelPendingChange_NotFound() {
+	suite.expectUserAccess(security.ActionUpdateUser, true)
+	suite.mockStore.On("DeletePendingChange", mock.Anything, testUserID).Return(false, nil).Once()
+
+	suite.Equal(&ErrorPendingChangeNotFound, suite.service.CancelPendingChange(context.Background(), testUserID))
+}
+
+func (suite *EmailChangeServiceTestSuite) TestConfirmEmailChange_AppliesNewAddress() {
+	tokenHash := cryptolab.HashToken(testToken)
+	confirmed := suite.pendingChange()
+	confirmed.NewAddressConfirmedAt = &suite.now
+	suite.mockStore.On("GetPendingChangeByTokenHash", mock.Anything, tokenHash).
+		Return(suite.pendingChange(), nil).Once()
+	suite.mockStore.On("ConfirmNewAddress", mock.Anything, testUserID, tokenHash, suite.now).Return(true, nil).Once()
+	suite.mockStore.On("GetPendingChange", mock.Anything, testUserID).Return(confirmed, nil).Once()
+	suite.mockEntity.On("GetEntity", mock.Anything, testUserID).Return(&entity.Entity{
+		ID: testUserID, Attributes: json.RawMessage(`{"email":"old@example.com","given_name":"Jo"}`),
+	}, nil).Once()
+	suite.mockEntity.On("UpdateAttributes", mock.Anything, testUserID, mock.MatchedBy(func(attrs json.RawMessage) bool {
+		var parsed map[string]interface{}
+		_ = json.Unmarshal(attrs, &parsed)
+		return parsed["email"] == testNewEmail && parsed["given_name"] == "Jo"
+	})).Return(nil).Once()
+	suite.mockStore.On("DeletePendingChange", mock.Anything, testUserID).Return(true, nil).Once()
+
+	response, svcErr := suite.service.ConfirmEmailChange(context.Background(), testToken)
+
+	suite.Nil(svcErr)
+	suite.Equal(ConfirmationStatusCompleted, response.Status)
+}
+
+func (suite *EmailChangeServiceTestSuite) TestConfirmEmailChange_NotifiesAccountProtection() {
+	protectionMock := accountprotectionmock.NewAccountProtectionServiceInterfaceMock(suite.T())
+	protectionMock.On("NotifyEmailChanged", mock.Anything, testUserID, testOldEmail, testNewEmail).Once()
+	suite.service.accountProtection = protectionMock
+	tokenHash := cryptolab.HashToken(testToken)
+	confirmed := suite.pendingChange()
+	confirmed.NewAddressConfirmedAt = &suite.now
+	suite.mockStore.On("GetPendingChangeByTokenHash", mock.Anything, tokenHash).
+		Return(suite.pendingChange(), nil).Once()
+	suite.mockStore.On("ConfirmNewAddress", mock.Anything, testUserID, tokenHash, suite.now).Return(true, nil).Once()
+	suite.mockStore.On("GetPendingChange", mock.Anything, testUserID).Return(confirmed, nil).Once()
+	suite.mockEntity.On("GetEntity", mock.Anything, testUserID).Return(&entity.Entity{
+		ID: testUserID, Attributes: json.RawMessage(`{"email":"old@example.com"}`),
+	}, nil).Once()
+	suite.mockEntity.On("UpdateAttributes", mock.Anything, testUserID, mock.Anything).Return(nil).Once()
+	suite.mockStore.On("DeletePendingChange", mock.Anything, testUserID).Return(true, nil).Once()
+
+	_, svcErr := suite.service.ConfirmEmailChange(context.Background(), testToken)
+
+	suite.Nil(svcErr)
+}
+
+func (suite *EmailChangeServiceTestSuite) TestConfirmEmailChange_AwaitsOldAddress() {
+	tokenHash := cryptolab.HashToken(testToken)
+	change := suite.pendingChange()
+	change.OldTokenHash = cryptolab.HashToken("old-token")
+	change.OldAddressConfirmationRequired = true
+	confirmed := *change
+	confirmed.NewAddressConfirmedAt = &suite.now
+	suite.mockStore.On("GetPendingChangeByTokenHash", mock.Anything, tokenHash).Return(change, nil).Once()
+	suite.mockStore.On("ConfirmNewAddress", mock.Anything, testUserID, tokenHash, suite.now).Return(true, nil).Once()
+	suite.mockStore.On("GetPendingChange", mock.Anything, testUserID).Return(&confirmed, nil).Once()
+
+	response, svcErr := suite.service.ConfirmEmailChange(context.Background(), testToken)
+
+	suite.Nil(svcErr)
+	suite.Equal(ConfirmationStatusPending, response.Status)
+	suite.mockEntity.AssertNotCalled(suite.T(), "UpdateAttributes", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *EmailChangeServiceTestSuite) TestConfirmEmailChange_OldAddressCompletesChange() {
+	oldTokenHash := cryptolab.HashToken("old-token")
+	change := suite.pendingChange()
+	change.OldTokenHash = oldTokenHash
+	change.OldAddressConfirmationRequired = true
+	change.NewAddressConfirmedAt = &suite.now
+	confirmed := *change
+	confirmed.OldAddressConfirmedAt = &suite.now
+	suite.mockStore.On("GetPendingChangeByTokenHash", mock.Anything, oldTokenHash).Return(change, nil).Once()
+	suite.mockStore.On("ConfirmOldAddress", mock.Anything, testUserID, oldTokenHash, suite.now).
+		Return(true, nil).Once()
+	suite.mockStore.On("GetPendingChange", mock.Anything, testUserID).Return(&confirmed, nil).Once()
+	suite.mockEntity.On("GetEntity", mock.Anything, testUserID).Return(&entity.Entity{ID: testUserID}, nil).Once()
+	suite.mockEntity.On("UpdateAttributes", mock.Anything, testUserID, mock.Anything).Return(nil).Once()
+	suite.mockStore.On("DeletePendingChange", mock.Anything, testUserID).Return(true, nil).Once()
+
+	response, svcErr := suite.service.ConfirmEmailChange(context.Background(), "old-token")
+
+	suite.Nil(svcErr)
+	suite.Equal(ConfirmationStatusCompleted, response.Status)
+}
+
+func (suite *EmailChangeServiceTestSuite) TestConfirmEmailChange_InvalidToken() {
+	suite.mockStore.On("GetPendingChangeByTokenHash", mock.Anything, mock.Anything).
+		Return(nil, ErrPendingEmailChangeNotFound).Once()
+
+	_, svcErr := suite.service.ConfirmEmailChange(context.Background(), "unknown")
+	suite.Equal(&ErrorInvalidConfirmationToken, svcErr)
+
+	_, svcErr = suite.service.ConfirmEmailChange(context.Background(), "")
+	suite.Equal(&ErrorInvalidConfirmationToken, svcErr)
+}
+
+func (suite *EmailChangeServiceTestSuite) TestConfirmEmailChange_Expired() {
+	change := suite.pendingChange()
+	change.ExpiresAt = suite.now.Add(-time.Second)
+	suite.mockStore.On("GetPendingChangeByTokenHash", mock.Anything, mock.Anything).Return(change, nil).Once()
+
+	_, svcErr := suite.service.ConfirmEmailChange(context.Background(), testToken)
+
+	suite.Equal(&ErrorInvalidConfirmationToken, svcErr)
+	suite.mockStore.AssertNotCalled(suite.T(), "ConfirmNewAddress", mock.Anything, mock.Anything, mock.Anything,
+		mock.Anything)
+}
+
+func (suite *EmailChangeServiceTestSuite) TestConfirmEmailChange_ReplacedMeanwhile() {
+	suite.mockStore.On("GetPendingChangeByTokenHash", mock.Anything, mock.Anything).
+		Return(suite.pendingChange(), nil).Once()
+	suite.mockStore.On("ConfirmNewAddress", mock.Anything, testUserID, mock.Anything, suite.now).
+		Return(false, nil).Once()
+
+	_, svcErr := suite.service.ConfirmEmailChange(context.Background(), testToken)
+
+	suite.Equal(&ErrorInvalidConfirmationToken, svcErr)
+}
+
+func (suite *EmailChangeServiceTestSuite) TestConfirmEmailChange_AddressTaken() {
+	confirmed := suite.pendingChange()
+	confirmed.NewAddressConfirmedAt = &suite.now
+	suite.mockStore.On("GetPendingChangeByTokenHash", mock.Anything, mock.Anything).
+		Return(suite.pendingChange(), nil).Once()
+	suite.mockStore.On("ConfirmNewAddress", mock.Anything, testUserID, mock.Anything, suite.now).
+		Return(true, nil).Once()
+	suite.mockStore.On("GetPendingChange", mock.Anything, testUserID).Return(confirmed, nil).Once()
+	suite.mockEntity.On("GetEntity", mock.Anything, testUserID).Return(&entity.Entity{ID: testUserID}, nil).Once()
+	suite.mockEntity.On("UpdateAttributes", mock.Anything, testUserID, mock.Anything).
+		Return(entity.ErrAttributeConflict).Once()
+	suite.mockStore.On("DeletePendingChange", mock.Anything, testUserID).Return(true, nil).Once()
+
+	_, svcErr := suite.service.ConfirmEmailChange(context.Background(), testToken)
+
+	suite.Equal(&ErrorEmailAlreadyInUse, svcErr)
+}
+
+func (suite *EmailChangeServiceTestSuite) TestConfirmEmailChange_StoreError() {
+	suite.mockStore.On("GetPendingChangeByTokenHash", mock.Anything, mock.Anything).
+		Return(nil, errors.New("db error")).Once()
+
+	_, svcErr := suite.service.ConfirmEmailChange(context.Background(), testToken)
+
+	suite.Equal(&serviceerror.InternalServerError, svcErr)
+}
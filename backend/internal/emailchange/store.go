@@ -0,0 +1,223 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package emailchange
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+	sysContext "github.com/thunder-id/thunderid/internal/system/context"
+	dbmodel "github.com/thunder-id/thunderid/internal/system/database/model"
+	"github.com/thunder-id/thunderid/internal/system/database/provider"
+	dbutils "github.com/thunder-id/thunderid/internal/system/database/utils"
+)
+
+var getDBProvider = provider.GetDBProvider
+
+// emailChangeStoreInterface defines the interface for pending email change store operations.
+type emailChangeStoreInterface interface {
+	CreatePendingChange(ctx context.Context, change PendingEmailChange) error
+	GetPendingChange(ctx context.Context, userID string) (*PendingEmailChange, error)
+	GetPendingChangeByTokenHash(ctx context.Context, tokenHash string) (*PendingEmailChange, error)
+	ConfirmNewAddress(ctx context.Context, userID, tokenHash string, confirmedAt time.Time) (bool, error)
+	ConfirmOldAddress(ctx context.Context, userID, tokenHash string, confirmedAt time.Time) (bool, error)
+	DeletePendingChange(ctx context.Context, userID string) (bool, error)
+}
+
+// emailChangeStore is the runtime database backed implementation of emailChangeStoreInterface.
+type emailChangeStore struct {
+	dbProvider   provider.DBProviderInterface
+	deploymentID string
+}
+
+// newEmailChangeStore creates a new instance of emailChangeStore.
+func newEmailChangeStore() emailChangeStoreInterface {
+	return &emailChangeStore{
+		dbProvider:   getDBProvider(),
+		deploymentID: config.GetServerRuntime().Config.Server.Identifier,
+	}
+}
+
+// CreatePendingChange persists a new pending email change.
+func (s *emailChangeStore) CreatePendingChange(ctx context.Context, change PendingEmailChange) error {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
+	dbClient, err := s.dbProvider.GetRuntimeDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	var oldTokenHash interface{}
+	if change.OldTokenHash != "" {
+		oldTokenHash = change.OldTokenHash
+	}
+	if _, err := dbClient.ExecuteContext(ctx, queryCreatePendingEmailChange, change.UserID, change.NewEmail,
+		change.NewTokenHash, oldTokenHash, change.CreatedAt, change.ExpiresAt, deploymentID); err != nil {
+		return fmt.Errorf("failed to execute query: %w", err)
+	}
+	return nil
+}
+
+// GetPendingChange retrieves the pending email change of a user.
+func (s *emailChangeStore) GetPendingChange(ctx context.Context, userID string) (*PendingEmailChange, error) {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
+	dbClient, err := s.dbProvider.GetRuntimeDBClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	results, err := dbClient.QueryContext(ctx, queryGetPendingEmailChange, userID, deploymentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+	if len(results) == 0 {
+		return nil, ErrPendingEmailChangeNotFound
+	}
+
+	return buildPendingEmailChangeFromResultRow(results[0])
+}
+
+// GetPendingChangeByTokenHash retrieves the pending email change holding the given confirmation token hash
+// for either the new or the old address.
+func (s *emailChangeStore) GetPendingChangeByTokenHash(
+	ctx context.Context, tokenHash string,
+) (*PendingEmailChange, error) {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
+	dbClient, err := s.dbProvider.GetRuntimeDBClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	results, err := dbClient.QueryContext(ctx, queryGetPendingEmailChangeByTokenHash, tokenHash, tokenHash,
+		deploymentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+	if len(results) == 0 {
+		return nil, ErrPendingEmailChangeNotFound
+	}
+
+	return buildPendingEmailChangeFromResultRow(results[0])
+}
+
+// ConfirmNewAddress records the confirmation of the pending email change from the new address. It reports
+// whether the pending change still held the token.
+func (s *emailChangeStore) ConfirmNewAddress(
+	ctx context.Context, userID, tokenHash string, confirmedAt time.Time,
+) (bool, error) {
+	return s.confirm(ctx, queryConfirmNewAddress, userID, tokenHash, confirmedAt)
+}
+
+// ConfirmOldAddress records the confirmation of the pending email change from the old address. It reports
+// whether the pending change still held the token.
+func (s *emailChangeStore) ConfirmOldAddress(
+	ctx context.Context, userID, tokenHash string, confirmedAt time.Time,
+) (bool, error) {
+	return s.confirm(ctx, queryConfirmOldAddress, userID, tokenHash, confirmedAt)
+}
+
+// confirm executes a confirmation query and reports whether a pending change was updated.
+func (s *emailChangeStore) confirm(ctx context.Context, query dbmodel.DBQuery, userID, tokenHash string,
+	confirmedAt time.Time) (bool, error) {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
+	dbClient, err := s.dbProvider.GetRuntimeDBClient()
+	if err != nil {
+		return false, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	rowsAffected, err := dbClient.ExecuteContext(ctx, query, confirmedAt, userID, tokenHash, deploymentID)
+	if err != nil {
+		return false, fmt.Errorf("failed to execute query: %w", err)
+	}
+	return rowsAffected > 0, nil
+}
+
+// DeletePendingChange deletes the pending email change of a user. It reports whether a change was deleted.
+func (s *emailChangeStore) DeletePendingChange(ctx context.Context, userID string) (bool, error) {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
+	dbClient, err := s.dbProvider.GetRuntimeDBClient()
+	if err != nil {
+		return false, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	rowsAffected, err := dbClient.ExecuteContext(ctx, queryDeletePendingEmailChange, userID, deploymentID)
+	if err != nil {
+		return false, fmt.Errorf("failed to execute query: %w", err)
+	}
+	return rowsAffected > 0, nil
+}
+
+// buildPendingEmailChangeFromResultRow constructs a PendingEmailChange from a database result row.
+func buildPendingEmailChangeFromResultRow(row map[string]interface{}) (*PendingEmailChange, error) {
+	userID, ok := row["user_id"].(string)
+	if !ok {
+		return nil, fmt.Errorf("failed to parse user_id as string")
+	}
+	newEmail, ok := row["new_email"].(string)
+	if !ok {
+		return nil, fmt.Errorf("failed to parse new_email as string")
+	}
+	newTokenHash, ok := row["new_token_hash"].(string)
+	if !ok {
+		return nil, fmt.Errorf("failed to parse new_token_hash as string")
+	}
+	oldTokenHash, _ := row["old_token_hash"].(string)
+
+	newConfirmedAt, err := parseOptionalTimeField(row["new_confirmed_at"], "new_confirmed_at")
+	if err != nil {
+		return nil, err
+	}
+	oldConfirmedAt, err := parseOptionalTimeField(row["old_confirmed_at"], "old_confirmed_at")
+	if err != nil {
+		return nil, err
+	}
+	createdAt, err := dbutils.ParseTimeField(row["created_at"], "created_at")
+	if err != nil {
+		return nil, err
+	}
+	expiresAt, err := dbutils.ParseTimeField(row["expiry_time"], "expiry_time")
+	if err != nil {
+		return nil, err
+	}
+
+	return &PendingEmailChange{
+		UserID:                         userID,
+		NewEmail:                       newEmail,
+		NewTokenHash:                   newTokenHash,
+		OldTokenHash:                   oldTokenHash,
+		NewAddressConfirmedAt:          newConfirmedAt,
+		OldAddressConfirmationRequired: oldTokenHash != "",
+		OldAddressConfirmedAt:          oldConfirmedAt,
+		CreatedAt:                      createdAt,
+		ExpiresAt:                      expiresAt,
+	}, nil
+}
+
+// parseOptionalTimeField parses a nullable time field from the database result.
+func parseOptionalTimeField(field interface{}, fieldName string) (*time.Time, error) {
+	if field == nil {
+		return nil, nil
+	}
+	parsedTime, err := dbutils.ParseTimeField(field, fieldName)
+	if err != nil {
+		return nil, err
+	}
+	return &parsedTime, nil
+}
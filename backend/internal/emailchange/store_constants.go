@@ -0,0 +1,65 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package emailchange
+
+import dbmodel "github.com/thunder-id/thunderid/internal/system/database/model"
+
+var (
+	// queryCreatePendingEmailChange inserts a new pending email change.
+	queryCreatePendingEmailChange = dbmodel.DBQuery{
+		ID: "EMQ-EMAIL_CHANGE-01",
+		Query: `INSERT INTO "PENDING_EMAIL_CHANGE" (USER_ID, NEW_EMAIL, NEW_TOKEN_HASH, OLD_TOKEN_HASH, ` +
+			`CREATED_AT, EXPIRY_TIME, DEPLOYMENT_ID) VALUES ($1, $2, $3, $4, $5, $6, $7)`,
+	}
+
+	// queryGetPendingEmailChange retrieves the pending email change of a user.
+	queryGetPendingEmailChange = dbmodel.DBQuery{
+		ID: "EMQ-EMAIL_CHANGE-02",
+		Query: `SELECT USER_ID, NEW_EMAIL, NEW_TOKEN_HASH, OLD_TOKEN_HASH, NEW_CONFIRMED_AT, OLD_CONFIRMED_AT, ` +
+			`CREATED_AT, EXPIRY_TIME FROM "PENDING_EMAIL_CHANGE" WHERE USER_ID = $1 AND DEPLOYMENT_ID = $2`,
+	}
+
+	// queryGetPendingEmailChangeByTokenHash retrieves the pending email change a confirmation token belongs to.
+	queryGetPendingEmailChangeByTokenHash = dbmodel.DBQuery{
+		ID: "EMQ-EMAIL_CHANGE-03",
+		Query: `SELECT USER_ID, NEW_EMAIL, NEW_TOKEN_HASH, OLD_TOKEN_HASH, NEW_CONFIRMED_AT, OLD_CONFIRMED_AT, ` +
+			`CREATED_AT, EXPIRY_TIME FROM "PENDING_EMAIL_CHANGE" ` +
+			`WHERE (NEW_TOKEN_HASH = $1 OR OLD_TOKEN_HASH = $2) AND DEPLOYMENT_ID = $3`,
+	}
+
+	// queryConfirmNewAddress records the confirmation of a pending email change from the new address.
+	queryConfirmNewAddress = dbmodel.DBQuery{
+		ID: "EMQ-EMAIL_CHANGE-04",
+		Query: `UPDATE "PENDING_EMAIL_CHANGE" SET NEW_CONFIRMED_AT = $1 ` +
+			`WHERE USER_ID = $2 AND NEW_TOKEN_HASH = $3 AND DEPLOYMENT_ID = $4`,
+	}
+
+	// queryConfirmOldAddress records the confirmation of a pending email change from the old address.
+	queryConfirmOldAddress = dbmodel.DBQuery{
+		ID: "EMQ-EMAIL_CHANGE-05",
+		Query: `UPDATE "PENDING_EMAIL_CHANGE" SET OLD_CONFIRMED_AT = $1 ` +
+			`WHERE USER_ID = $2 AND OLD_TOKEN_HASH = $3 AND DEPLOYMENT_ID = $4`,
+	}
+
+	// queryDeletePendingEmailChange deletes the pending email change of a user.
+	queryDeletePendingEmailChange = dbmodel.DBQuery{
+		ID:    "EMQ-EMAIL_CHANGE-06",
+		Query: `DELETE FROM "PENDING_EMAIL_CHANGE" WHERE USER_ID = $1 AND DEPLOYMENT_ID = $2`,
+	}
+)
@@ -0,0 +1,31 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package enrollmentsession
+
+const (
+	// loggerComponentName is the component name used in enrollment session logs.
+	loggerComponentName = "EnrollmentSessionService"
+	// handlerLoggerComponentName is the component name used in enrollment session handler logs.
+	handlerLoggerComponentName = "EnrollmentSessionHandler"
+
+	// defaultSessionValidity is the number of seconds an enrollment session is valid for when none is configured.
+	defaultSessionValidity = 300
+	// maxPayloadLength is the maximum length of an enrollment payload, which keeps the QR code scannable.
+	maxPayloadLength = 1024
+)
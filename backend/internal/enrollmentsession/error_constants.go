@@ -0,0 +1,80 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package enrollmentsession
+
+import (
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/i18n/core"
+)
+
+// Client errors for enrollment session operations.
+var (
+	// ErrorInvalidSessionToken is the error returned when an enrollment session token is invalid or expired.
+	ErrorInvalidSessionToken = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "ENS-1001",
+		Error: core.I18nMessage{
+			Key:          "error.enrollmentsessionservice.invalid_session_token",
+			DefaultValue: "Invalid enrollment session",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.enrollmentsessionservice.invalid_session_token_description",
+			DefaultValue: "The enrollment session is invalid or has expired",
+		},
+	}
+	// ErrorInvalidEnrollmentType is the error returned when the enrollment type is not supported.
+	ErrorInvalidEnrollmentType = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "ENS-1002",
+		Error: core.I18nMessage{
+			Key:          "error.enrollmentsessionservice.invalid_enrollment_type",
+			DefaultValue: "Invalid enrollment type",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.enrollmentsessionservice.invalid_enrollment_type_description",
+			DefaultValue: "The enrollment type is not supported",
+		},
+	}
+	// ErrorInvalidPayload is the error returned when the enrollment payload is empty or too long.
+	ErrorInvalidPayload = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "ENS-1003",
+		Error: core.I18nMessage{
+			Key:          "error.enrollmentsessionservice.invalid_payload",
+			DefaultValue: "Invalid enrollment payload",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.enrollmentsessionservice.invalid_payload_description",
+			DefaultValue: "The enrollment payload is empty or too long to encode as a QR code",
+		},
+	}
+	// ErrorInvalidRequestFormat is the error returned when the request body is malformed.
+	ErrorInvalidRequestFormat = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "ENS-1004",
+		Error: core.I18nMessage{
+			Key:          "error.enrollmentsessionservice.invalid_request_format",
+			DefaultValue: "Invalid request format",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.enrollmentsessionservice.invalid_request_format_description",
+			DefaultValue: "The request body is malformed or contains invalid data",
+		},
+	}
+)
@@ -0,0 +1,90 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package enrollmentsession
+
+import (
+	"net/http"
+	"strings"
+
+	serverconst "github.com/thunder-id/thunderid/internal/system/constants"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	sysutils "github.com/thunder-id/thunderid/internal/system/utils"
+)
+
+// enrollmentSessionHandler is the handler for enrollment session operations.
+type enrollmentSessionHandler struct {
+	enrollmentSessionService EnrollmentSessionServiceInterface
+}
+
+// newEnrollmentSessionHandler creates a new instance of enrollmentSessionHandler.
+func newEnrollmentSessionHandler(enrollmentSessionService EnrollmentSessionServiceInterface) *enrollmentSessionHandler {
+	return &enrollmentSessionHandler{
+		enrollmentSessionService: enrollmentSessionService,
+	}
+}
+
+// HandleURIRequest handles the request to retrieve the enrollment URI of an enrollment session. The session
+// token is read from the request body rather than the URL, so that it is not recorded in access logs or
+// browser history.
+func (h *enrollmentSessionHandler) HandleURIRequest(w http.ResponseWriter, r *http.Request) {
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, handlerLoggerComponentName))
+
+	request, err := sysutils.DecodeJSONBody[SessionTokenRequest](r)
+	if err != nil {
+		sysutils.WriteServiceErrorResponse(w, &ErrorInvalidRequestFormat, clientErrorStatusCodes)
+		return
+	}
+
+	uri, svcErr := h.enrollmentSessionService.GetEnrollmentURI(r.Context(), strings.TrimSpace(request.Token))
+	if svcErr != nil {
+		sysutils.WriteServiceErrorResponse(w, svcErr, clientErrorStatusCodes)
+		return
+	}
+
+	w.Header().Set(serverconst.CacheControlHeaderName, serverconst.CacheControlNoStore)
+	w.Header().Set(serverconst.PragmaHeaderName, serverconst.PragmaNoCache)
+	sysutils.WriteSuccessResponse(w, http.StatusOK, EnrollmentURIResponse{URI: uri})
+	logger.Debug("Enrollment URI response sent")
+}
+
+// HandleRevokeRequest handles the request to revoke an enrollment session.
+func (h *enrollmentSessionHandler) HandleRevokeRequest(w http.ResponseWriter, r *http.Request) {
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, handlerLoggerComponentName))
+
+	request, err := sysutils.DecodeJSONBody[SessionTokenRequest](r)
+	if err != nil {
+		sysutils.WriteServiceErrorResponse(w, &ErrorInvalidRequestFormat, clientErrorStatusCodes)
+		return
+	}
+
+	if svcErr := h.enrollmentSessionService.RevokeSession(r.Context(),
+		strings.TrimSpace(request.Token)); svcErr != nil {
+		sysutils.WriteServiceErrorResponse(w, svcErr, clientErrorStatusCodes)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+	logger.Debug("Enrollment session revoke response sent")
+}
+
+// clientErrorStatusCodes maps the client errors of the handler to HTTP status codes. Other client errors are
+// returned with 400 Bad Request.
+var clientErrorStatusCodes = map[string]int{
+	ErrorInvalidSessionToken.Code: http.StatusNotFound,
+}
@@ -0,0 +1,118 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package enrollmentsession
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/system/error/apierror"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+)
+
+type HandlerTestSuite struct {
+	suite.Suite
+	mockService *EnrollmentSessionServiceInterfaceMock
+	handler     *enrollmentSessionHandler
+}
+
+func TestHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(HandlerTestSuite))
+}
+
+func (s *HandlerTestSuite) SetupTest() {
+	s.mockService = NewEnrollmentSessionServiceInterfaceMock(s.T())
+	s.handler = newEnrollmentSessionHandler(s.mockService)
+}
+
+func (s *HandlerTestSuite) TestHandleURIRequest() {
+	s.mockService.On("GetEnrollmentURI", mock.Anything, testToken).Return(testPayload, nil)
+	rr := httptest.NewRecorder()
+
+	s.handler.HandleURIRequest(rr, httptest.NewRequest(http.MethodPost, "/enrollment-sessions/uri",
+		strings.NewReader(`{"token":" `+testToken+` "}`)))
+
+	s.Equal(http.StatusOK, rr.Code)
+	s.Equal("application/json", rr.Header().Get("Content-Type"))
+	s.Equal("no-store", rr.Header().Get("Cache-Control"))
+	var resp EnrollmentURIResponse
+	s.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &resp))
+	s.Equal(testPayload, resp.URI)
+}
+
+func (s *HandlerTestSuite) TestHandleURIRequest_InvalidBody() {
+	rr := httptest.NewRecorder()
+
+	s.handler.HandleURIRequest(rr, httptest.NewRequest(http.MethodPost, "/enrollment-sessions/uri",
+		strings.NewReader("{")))
+
+	s.Equal(http.StatusBadRequest, rr.Code)
+	var resp apierror.ErrorResponse
+	s.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &resp))
+	s.Equal(ErrorInvalidRequestFormat.Code, resp.Code)
+}
+
+func (s *HandlerTestSuite) TestHandleURIRequest_ErrorStatuses() {
+	testCases := []struct {
+		name     string
+		svcErr   *serviceerror.ServiceError
+		expected int
+	}{
+		{"InvalidSession", &ErrorInvalidSessionToken, http.StatusNotFound},
+		{"InternalError", &serviceerror.InternalServerError, http.StatusInternalServerError},
+	}
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.mockService.On("GetEnrollmentURI", mock.Anything, testToken).Return("", tc.svcErr).Once()
+			rr := httptest.NewRecorder()
+
+			s.handler.HandleURIRequest(rr, httptest.NewRequest(http.MethodPost, "/enrollment-sessions/uri",
+				strings.NewReader(`{"token":"`+testToken+`"}`)))
+
+			s.Equal(tc.expected, rr.Code)
+			s.NotContains(rr.Body.String(), "otpauth://")
+		})
+	}
+}
+
+func (s *HandlerTestSuite) TestHandleRevokeRequest() {
+	s.mockService.On("RevokeSession", mock.Anything, testToken).Return(nil)
+	rr := httptest.NewRecorder()
+
+	s.handler.HandleRevokeRequest(rr, httptest.NewRequest(http.MethodPost, "/enrollment-sessions/revoke",
+		strings.NewReader(`{"token":"`+testToken+`"}`)))
+
+	s.Equal(http.StatusNoContent, rr.Code)
+}
+
+func (s *HandlerTestSuite) TestHandleRevokeRequest_InvalidSession() {
+	s.mockService.On("RevokeSession", mock.Anything, testToken).Return(&ErrorInvalidSessionToken)
+	rr := httptest.NewRecorder()
+
+	s.handler.HandleRevokeRequest(rr, httptest.NewRequest(http.MethodPost, "/enrollment-sessions/revoke",
+		strings.NewReader(`{"token":"`+testToken+`"}`)))
+
+	s.Equal(http.StatusNotFound, rr.Code)
+}
@@ -0,0 +1,64 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package enrollmentsession
+
+import (
+	"net/http"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/kmprovider"
+	"github.com/thunder-id/thunderid/internal/system/middleware"
+)
+
+// Initialize initializes the enrollment session service and registers its routes.
+func Initialize(mux *http.ServeMux, cryptoSvc kmprovider.RuntimeCryptoProvider) EnrollmentSessionServiceInterface {
+	sessionValidity := config.GetServerRuntime().Config.EnrollmentSession.Validity
+	if sessionValidity == 0 {
+		sessionValidity = defaultSessionValidity
+	}
+
+	enrollmentSessionService := newEnrollmentSessionService(newEnrollmentSessionStore(), cryptoSvc, sessionValidity)
+
+	enrollmentSessionHandler := newEnrollmentSessionHandler(enrollmentSessionService)
+	registerRoutes(mux, enrollmentSessionHandler)
+
+	return enrollmentSessionService
+}
+
+// registerRoutes registers the routes for enrollment session operations.
+func registerRoutes(mux *http.ServeMux, enrollmentSessionHandler *enrollmentSessionHandler) {
+	opts := middleware.CORSOptions{
+		AllowedMethods:   []string{"POST"},
+		AllowedHeaders:   middleware.DefaultAllowedHeaders,
+		AllowCredentials: true,
+		MaxAge:           600,
+	}
+	mux.HandleFunc(middleware.WithCORS("POST /enrollment-sessions/uri",
+		enrollmentSessionHandler.HandleURIRequest, opts))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS /enrollment-sessions/uri",
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, opts))
+	mux.HandleFunc(middleware.WithCORS("POST /enrollment-sessions/revoke",
+		enrollmentSessionHandler.HandleRevokeRequest, opts))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS /enrollment-sessions/revoke",
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, opts))
+}
@@ -0,0 +1,63 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package enrollmentsession provides short-lived enrollment sessions that hand the enrollment URIs of
+// authenticators, such as TOTP otpauth URIs and passkey cross-device links, to the client rendering them as
+// QR codes. URIs are kept encrypted on the server and referenced only by an opaque session token, so that the
+// secrets they carry never appear in URLs.
+package enrollmentsession
+
+import "time"
+
+// EnrollmentType represents the kind of authenticator an enrollment session enrolls.
+type EnrollmentType string
+
+const (
+	// EnrollmentTypeTOTP is the enrollment of a TOTP authenticator app.
+	EnrollmentTypeTOTP EnrollmentType = "totp"
+	// EnrollmentTypePasskey is the cross-device enrollment of a passkey.
+	EnrollmentTypePasskey EnrollmentType = "passkey"
+)
+
+// EnrollmentSession represents an enrollment session as returned to the feature that created it.
+type EnrollmentSession struct {
+	Token     string
+	Type      EnrollmentType
+	ExpiresAt time.Time
+}
+
+// enrollmentSessionRecord represents an enrollment session as persisted in the store. The token is stored
+// hashed and the payload encrypted.
+type enrollmentSessionRecord struct {
+	TokenHash string
+	UserID    string
+	Type      EnrollmentType
+	Payload   string
+	CreatedAt time.Time
+	ExpiresAt time.Time
+}
+
+// EnrollmentURIResponse represents the response carrying the enrollment URI of an enrollment session.
+type EnrollmentURIResponse struct {
+	URI string `json:"uri"`
+}
+
+// SessionTokenRequest represents a request referring to an enrollment session by its token.
+type SessionTokenRequest struct {
+	Token string `json:"token"`
+}
@@ -0,0 +1,175 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package enrollmentsession
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/system/cryptolab"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/kmprovider"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/internal/system/security"
+)
+
+// EnrollmentSessionServiceInterface defines the interface for the enrollment session service.
+type EnrollmentSessionServiceInterface interface {
+	CreateSession(ctx context.Context, userID string, enrollmentType EnrollmentType,
+		payload string) (*EnrollmentSession, *serviceerror.ServiceError)
+	GetEnrollmentURI(ctx context.Context, token string) (string, *serviceerror.ServiceError)
+	RevokeSession(ctx context.Context, token string) *serviceerror.ServiceError
+}
+
+// enrollmentSessionService is the default implementation of the EnrollmentSessionServiceInterface.
+type enrollmentSessionService struct {
+	store           enrollmentSessionStoreInterface
+	cryptoSvc       kmprovider.RuntimeCryptoProvider
+	sessionValidity int64
+	now             func() time.Time
+	logger          *log.Logger
+}
+
+// newEnrollmentSessionService creates a new instance of enrollmentSessionService.
+func newEnrollmentSessionService(
+	store enrollmentSessionStoreInterface,
+	cryptoSvc kmprovider.RuntimeCryptoProvider,
+	sessionValidity int64,
+) EnrollmentSessionServiceInterface {
+	return &enrollmentSessionService{
+		store:           store,
+		cryptoSvc:       cryptoSvc,
+		sessionValidity: sessionValidity,
+		now:             time.Now,
+		logger:          log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName)),
+	}
+}
+
+// CreateSession creates an enrollment session for the user holding the enrollment payload, such as a TOTP
+// provisioning URI or a passkey cross-device link. The payload is stored encrypted and the returned token is
+// the only reference to it, so that the payload never has to be placed in a URL.
+func (s *enrollmentSessionService) CreateSession(ctx context.Context, userID string, enrollmentType EnrollmentType,
+	payload string) (*EnrollmentSession, *serviceerror.ServiceError) {
+	if enrollmentType != EnrollmentTypeTOTP && enrollmentType != EnrollmentTypePasskey {
+		return nil, &ErrorInvalidEnrollmentType
+	}
+	if payload == "" || len(payload) > maxPayloadLength {
+		return nil, &ErrorInvalidPayload
+	}
+	logger := s.logger.With(log.MaskedString(log.LoggerKeyUserID, userID),
+		log.String("enrollmentType", string(enrollmentType)))
+	if userID == "" {
+		logger.Error("Enrollment session requested without a user")
+		return nil, &serviceerror.InternalServerError
+	}
+
+	token, err := cryptolab.GenerateSecureToken()
+	if err != nil {
+		logger.Error("Failed to generate the enrollment session token", log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+	params := cryptolab.AlgorithmParams{Algorithm: cryptolab.AlgorithmAESGCM}
+	encrypted, _, err := s.cryptoSvc.Encrypt(ctx, nil, params, []byte(payload))
+	if err != nil {
+		logger.Error("Failed to encrypt the enrollment payload", log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+
+	now := s.now().UTC()
+	record := enrollmentSessionRecord{
+		TokenHash: cryptolab.HashToken(token),
+		UserID:    userID,
+		Type:      enrollmentType,
+		Payload:   string(encrypted),
+		CreatedAt: now,
+		ExpiresAt: now.Add(time.Duration(s.sessionValidity) * time.Second),
+	}
+	if err := s.store.CreateSession(ctx, record); err != nil {
+		logger.Error("Failed to store the enrollment session", log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+
+	logger.Debug("Enrollment session created")
+	return &EnrollmentSession{
+		Token:     token,
+		Type:      enrollmentType,
+		ExpiresAt: record.ExpiresAt,
+	}, nil
+}
+
+// GetEnrollmentURI returns the enrollment URI held by the enrollment session, such as a TOTP otpauth URI,
+// for the client to render as a QR code. Only the user the session was created for can retrieve it.
+func (s *enrollmentSessionService) GetEnrollmentURI(ctx context.Context, token string) (string,
+	*serviceerror.ServiceError) {
+	record, svcErr := s.getOwnSession(ctx, token)
+	if svcErr != nil {
+		return "", svcErr
+	}
+
+	params := cryptolab.AlgorithmParams{Algorithm: cryptolab.AlgorithmAESGCM}
+	payload, err := s.cryptoSvc.Decrypt(ctx, nil, params, []byte(record.Payload))
+	if err != nil {
+		s.logger.Error("Failed to decrypt the enrollment payload", log.Error(err))
+		return "", &serviceerror.InternalServerError
+	}
+	return string(payload), nil
+}
+
+// RevokeSession deletes the enrollment session, for example once the enrollment completes or is cancelled.
+// Only the user the session was created for can revoke it.
+func (s *enrollmentSessionService) RevokeSession(ctx context.Context, token string) *serviceerror.ServiceError {
+	record, svcErr := s.getOwnSession(ctx, token)
+	if svcErr != nil {
+		return svcErr
+	}
+
+	if err := s.store.DeleteSession(ctx, record.TokenHash); err != nil {
+		s.logger.Error("Failed to delete the enrollment session", log.Error(err))
+		return &serviceerror.InternalServerError
+	}
+	s.logger.Debug("Enrollment session revoked")
+	return nil
+}
+
+// getOwnSession retrieves the unexpired enrollment session of the token, provided it belongs to the
+// authenticated user. Sessions of other users are reported as invalid so that their existence is not revealed.
+func (s *enrollmentSessionService) getOwnSession(ctx context.Context, token string) (*enrollmentSessionRecord,
+	*serviceerror.ServiceError) {
+	if token == "" {
+		return nil, &ErrorInvalidSessionToken
+	}
+
+	record, err := s.store.GetSession(ctx, cryptolab.HashToken(token))
+	if err != nil {
+		if errors.Is(err, errSessionNotFound) {
+			return nil, &ErrorInvalidSessionToken
+		}
+		s.logger.Error("Failed to retrieve the enrollment session", log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+	if !s.now().UTC().Before(record.ExpiresAt) {
+		return nil, &ErrorInvalidSessionToken
+	}
+	if subject := security.GetSubject(ctx); subject == "" || subject != record.UserID {
+		s.logger.Debug("Enrollment session requested by a user other than its owner")
+		return nil, &ErrorInvalidSessionToken
+	}
+	return record, nil
+}
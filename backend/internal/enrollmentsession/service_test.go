@@ -0,0 +1,206 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package enrollmentsession
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/system/cryptolab"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/kmprovider"
+	"github.com/thunder-id/thunderid/internal/system/security"
+	"github.com/thunder-id/thunderid/tests/mocks/crypto/cryptomock"
+)
+
+const (
+	testUserID  = "user-1"
+	testToken   = "session-token"
+	testPayload = "otpauth://totp/Thunder:alice?secret=JBSWY3DPEHPK3PXP&issuer=Thunder"
+)
+
+type EnrollmentSessionServiceTestSuite struct {
+	suite.Suite
+	mockStore  *enrollmentSessionStoreInterfaceMock
+	mockCrypto *cryptomock.RuntimeCryptoProviderMock
+	service    *enrollmentSessionService
+	now        time.Time
+}
+
+func TestEnrollmentSessionServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(EnrollmentSessionServiceTestSuite))
+}
+
+func (suite *EnrollmentSessionServiceTestSuite) SetupTest() {
+	suite.mockStore = newEnrollmentSessionStoreInterfaceMock(suite.T())
+	suite.mockCrypto = cryptomock.NewRuntimeCryptoProviderMock(suite.T())
+	suite.now = time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	suite.service = newEnrollmentSessionService(suite.mockStore, suite.mockCrypto, 300).(*enrollmentSessionService)
+	suite.service.now = func() time.Time { return suite.now }
+}
+
+// userContext returns a context authenticated as the given user.
+func userContext(userID string) context.Context {
+	authCtx := security.NewSecurityContextForTest(userID, "", "", nil, nil)
+	return security.WithSecurityContextTest(context.Background(), authCtx)
+}
+
+// storedSession returns the stored record of the test session expiring at the given time.
+func storedSession(expiresAt time.Time) *enrollmentSessionRecord {
+	return &enrollmentSessionRecord{
+		TokenHash: cryptolab.HashToken(testToken),
+		UserID:    testUserID,
+		Type:      EnrollmentTypeTOTP,
+		Payload:   "encrypted",
+		ExpiresAt: expiresAt,
+	}
+}
+
+func (suite *EnrollmentSessionServiceTestSuite) TestCreateSession() {
+	suite.mockCrypto.On("Encrypt", mock.Anything, (*kmprovider.KeyRef)(nil), mock.Anything, []byte(testPayload)).
+		Return([]byte("encrypted"), nil, nil)
+	var stored enrollmentSessionRecord
+	suite.mockStore.On("CreateSession", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		stored = args.Get(1).(enrollmentSessionRecord)
+	}).Return(nil)
+
+	session, svcErr := suite.service.CreateSession(context.Background(), testUserID, EnrollmentTypeTOTP, testPayload)
+
+	suite.Require().Nil(svcErr)
+	suite.Len(session.Token, 64)
+	suite.Equal(EnrollmentTypeTOTP, session.Type)
+	suite.Equal(suite.now.Add(300*time.Second), session.ExpiresAt)
+	suite.Equal(cryptolab.HashToken(session.Token), stored.TokenHash)
+	suite.Equal(testUserID, stored.UserID)
+	suite.Equal("encrypted", stored.Payload)
+	suite.NotContains(stored.TokenHash, session.Token)
+}
+
+func (suite *EnrollmentSessionServiceTestSuite) TestCreateSession_InvalidInput() {
+	testCases := []struct {
+		name           string
+		enrollmentType EnrollmentType
+		payload        string
+		expected       serviceerror.ServiceError
+	}{
+		{"UnsupportedType", EnrollmentType("sms"), testPayload, ErrorInvalidEnrollmentType},
+		{"EmptyPayload", EnrollmentTypePasskey, "", ErrorInvalidPayload},
+		{"PayloadTooLong", EnrollmentTypePasskey, strings.Repeat("a", maxPayloadLength+1), ErrorInvalidPayload},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			session, svcErr := suite.service.CreateSession(context.Background(), testUserID, tc.enrollmentType,
+				tc.payload)
+
+			suite.Nil(session)
+			suite.Require().NotNil(svcErr)
+			suite.Equal(tc.expected.Code, svcErr.Code)
+		})
+	}
+}
+
+func (suite *EnrollmentSessionServiceTestSuite) TestCreateSession_StoreFailure() {
+	suite.mockCrypto.On("Encrypt", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return([]byte("encrypted"), nil, nil)
+	suite.mockStore.On("CreateSession", mock.Anything, mock.Anything).Return(errors.New("db down"))
+
+	session, svcErr := suite.service.CreateSession(context.Background(), testUserID, EnrollmentTypeTOTP, testPayload)
+
+	suite.Nil(session)
+	suite.Equal(&serviceerror.InternalServerError, svcErr)
+}
+
+func (suite *EnrollmentSessionServiceTestSuite) TestGetEnrollmentURI() {
+	suite.mockStore.On("GetSession", mock.Anything, cryptolab.HashToken(testToken)).
+		Return(storedSession(suite.now.Add(time.Minute)), nil)
+	suite.mockCrypto.On("Decrypt", mock.Anything, mock.Anything, mock.Anything, []byte("encrypted")).
+		Return([]byte(testPayload), nil)
+
+	uri, svcErr := suite.service.GetEnrollmentURI(userContext(testUserID), testToken)
+
+	suite.Nil(svcErr)
+	suite.Equal(testPayload, uri)
+}
+
+func (suite *EnrollmentSessionServiceTestSuite) TestGetEnrollmentURI_InvalidSession() {
+	testCases := []struct {
+		name    string
+		ctx     context.Context
+		token   string
+		session *enrollmentSessionRecord
+		err     error
+	}{
+		{"EmptyToken", userContext(testUserID), "", nil, nil},
+		{"NotFound", userContext(testUserID), testToken, nil, errSessionNotFound},
+		{"Expired", userContext(testUserID), testToken, storedSession(suite.now), nil},
+		{"OtherUser", userContext("user-2"), testToken, storedSession(suite.now.Add(time.Minute)), nil},
+		{"Unauthenticated", context.Background(), testToken, storedSession(suite.now.Add(time.Minute)), nil},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			if tc.token != "" {
+				suite.mockStore.On("GetSession", mock.Anything, cryptolab.HashToken(tc.token)).
+					Return(tc.session, tc.err).Once()
+			}
+
+			uri, svcErr := suite.service.GetEnrollmentURI(tc.ctx, tc.token)
+
+			suite.Empty(uri)
+			suite.Equal(&ErrorInvalidSessionToken, svcErr)
+		})
+	}
+}
+
+func (suite *EnrollmentSessionServiceTestSuite) TestGetEnrollmentURI_DecryptFailure() {
+	suite.mockStore.On("GetSession", mock.Anything, mock.Anything).
+		Return(storedSession(suite.now.Add(time.Minute)), nil)
+	suite.mockCrypto.On("Decrypt", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(nil, errors.New("bad key"))
+
+	uri, svcErr := suite.service.GetEnrollmentURI(userContext(testUserID), testToken)
+
+	suite.Empty(uri)
+	suite.Equal(&serviceerror.InternalServerError, svcErr)
+}
+
+func (suite *EnrollmentSessionServiceTestSuite) TestRevokeSession() {
+	suite.mockStore.On("GetSession", mock.Anything, cryptolab.HashToken(testToken)).
+		Return(storedSession(suite.now.Add(time.Minute)), nil)
+	suite.mockStore.On("DeleteSession", mock.Anything, cryptolab.HashToken(testToken)).Return(nil)
+
+	svcErr := suite.service.RevokeSession(userContext(testUserID), testToken)
+
+	suite.Nil(svcErr)
+}
+
+func (suite *EnrollmentSessionServiceTestSuite) TestRevokeSession_OtherUser() {
+	suite.mockStore.On("GetSession", mock.Anything, mock.Anything).
+		Return(storedSession(suite.now.Add(time.Minute)), nil)
+
+	svcErr := suite.service.RevokeSession(userContext("user-2"), testToken)
+
+	suite.Equal(&ErrorInvalidSessionToken, svcErr)
+	suite.mockStore.AssertNotCalled(suite.T(), "DeleteSession", mock.Anything, mock.Anything)
+}
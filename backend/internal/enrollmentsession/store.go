@@ -0,0 +1,141 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package enrollmentsession
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+	sysContext "github.com/thunder-id/thunderid/internal/system/context"
+	"github.com/thunder-id/thunderid/internal/system/database/provider"
+	dbutils "github.com/thunder-id/thunderid/internal/system/database/utils"
+)
+
+var getDBProvider = provider.GetDBProvider
+
+// errSessionNotFound is returned when no enrollment session matches the token hash.
+var errSessionNotFound = errors.New("enrollment session not found")
+
+// enrollmentSessionStoreInterface defines the interface for enrollment session store operations.
+type enrollmentSessionStoreInterface interface {
+	CreateSession(ctx context.Context, session enrollmentSessionRecord) error
+	GetSession(ctx context.Context, tokenHash string) (*enrollmentSessionRecord, error)
+	DeleteSession(ctx context.Context, tokenHash string) error
+}
+
+// enrollmentSessionStore is the runtime database backed implementation of enrollmentSessionStoreInterface.
+type enrollmentSessionStore struct {
+	dbProvider   provider.DBProviderInterface
+	deploymentID string
+}
+
+// newEnrollmentSessionStore creates a new instance of enrollmentSessionStore.
+func newEnrollmentSessionStore() enrollmentSessionStoreInterface {
+	return &enrollmentSessionStore{
+		dbProvider:   getDBProvider(),
+		deploymentID: config.GetServerRuntime().Config.Server.Identifier,
+	}
+}
+
+// CreateSession persists a new enrollment session.
+func (s *enrollmentSessionStore) CreateSession(ctx context.Context, session enrollmentSessionRecord) error {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
+	dbClient, err := s.dbProvider.GetRuntimeDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	if _, err := dbClient.ExecuteContext(ctx, queryCreateEnrollmentSession, session.TokenHash, session.UserID,
+		string(session.Type), session.Payload, session.CreatedAt, session.ExpiresAt, deploymentID); err != nil {
+		return fmt.Errorf("failed to execute query: %w", err)
+	}
+	return nil
+}
+
+// GetSession retrieves an enrollment session by its token hash. Expired sessions are returned as well.
+func (s *enrollmentSessionStore) GetSession(ctx context.Context, tokenHash string) (*enrollmentSessionRecord, error) {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
+	dbClient, err := s.dbProvider.GetRuntimeDBClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	results, err := dbClient.QueryContext(ctx, queryGetEnrollmentSession, tokenHash, deploymentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+	if len(results) == 0 {
+		return nil, errSessionNotFound
+	}
+
+	return buildSessionFromResultRow(results[0])
+}
+
+// DeleteSession deletes an enrollment session by its token hash.
+func (s *enrollmentSessionStore) DeleteSession(ctx context.Context, tokenHash string) error {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
+	dbClient, err := s.dbProvider.GetRuntimeDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	if _, err := dbClient.ExecuteContext(ctx, queryDeleteEnrollmentSession, tokenHash, deploymentID); err != nil {
+		return fmt.Errorf("failed to execute query: %w", err)
+	}
+	return nil
+}
+
+// buildSessionFromResultRow constructs an enrollmentSessionRecord from a database result row.
+func buildSessionFromResultRow(row map[string]interface{}) (*enrollmentSessionRecord, error) {
+	tokenHash, ok := row["token_hash"].(string)
+	if !ok {
+		return nil, fmt.Errorf("failed to parse token_hash as string")
+	}
+	userID, ok := row["user_id"].(string)
+	if !ok {
+		return nil, fmt.Errorf("failed to parse user_id as string")
+	}
+	enrollmentType, ok := row["enrollment_type"].(string)
+	if !ok {
+		return nil, fmt.Errorf("failed to parse enrollment_type as string")
+	}
+	payload, ok := row["payload"].(string)
+	if !ok {
+		return nil, fmt.Errorf("failed to parse payload as string")
+	}
+	createdAt, err := dbutils.ParseTimeField(row["created_at"], "created_at")
+	if err != nil {
+		return nil, err
+	}
+	expiresAt, err := dbutils.ParseTimeField(row["expiry_time"], "expiry_time")
+	if err != nil {
+		return nil, err
+	}
+
+	return &enrollmentSessionRecord{
+		TokenHash: tokenHash,
+		UserID:    userID,
+		Type:      EnrollmentType(enrollmentType),
+		Payload:   payload,
+		CreatedAt: createdAt,
+		ExpiresAt: expiresAt,
+	}, nil
+}
@@ -0,0 +1,43 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package enrollmentsession
+
+import dbmodel "github.com/thunder-id/thunderid/internal/system/database/model"
+
+var (
+	// queryCreateEnrollmentSession inserts a new enrollment session.
+	queryCreateEnrollmentSession = dbmodel.DBQuery{
+		ID: "ESQ-ENROLLMENT_SESSION-01",
+		Query: `INSERT INTO "ENROLLMENT_SESSION" (TOKEN_HASH, USER_ID, ENROLLMENT_TYPE, PAYLOAD, CREATED_AT, ` +
+			`EXPIRY_TIME, DEPLOYMENT_ID) VALUES ($1, $2, $3, $4, $5, $6, $7)`,
+	}
+
+	// queryGetEnrollmentSession retrieves an enrollment session by its token hash.
+	queryGetEnrollmentSession = dbmodel.DBQuery{
+		ID: "ESQ-ENROLLMENT_SESSION-02",
+		Query: `SELECT TOKEN_HASH, USER_ID, ENROLLMENT_TYPE, PAYLOAD, CREATED_AT, EXPIRY_TIME ` +
+			`FROM "ENROLLMENT_SESSION" WHERE TOKEN_HASH = $1 AND DEPLOYMENT_ID = $2`,
+	}
+
+	// queryDeleteEnrollmentSession deletes an enrollment session by its token hash.
+	queryDeleteEnrollmentSession = dbmodel.DBQuery{
+		ID:    "ESQ-ENROLLMENT_SESSION-03",
+		Query: `DELETE FROM "ENROLLMENT_SESSION" WHERE TOKEN_HASH = $1 AND DEPLOYMENT_ID = $2`,
+	}
+)
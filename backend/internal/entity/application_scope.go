@@ -0,0 +1,39 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package entity
+
+import "context"
+
+// applicationScopeContextKey is the context key of the application entity listings are scoped to.
+type applicationScopeContextKey struct{}
+
+// WithApplicationScope returns a context that limits the entity listings made with it to the entities associated
+// with the given application. An empty application ID leaves the listings unscoped.
+func WithApplicationScope(ctx context.Context, appID string) context.Context {
+	if appID == "" {
+		return ctx
+	}
+	return context.WithValue(ctx, applicationScopeContextKey{}, appID)
+}
+
+// getApplicationScope returns the ID of the application entity listings are scoped to, or an empty string.
+func getApplicationScope(ctx context.Context) string {
+	appID, _ := ctx.Value(applicationScopeContextKey{}).(string)
+	return appID
+}
@@ -0,0 +1,82 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package entity
+
+import (
+	"hash/fnv"
+	"math"
+)
+
+// identifierBloomFilter is a bloom filter of identifier values. It reports for certain that a value was
+// never added, and may report that an absent value was added at the configured false positive rate.
+// It is not safe for concurrent use.
+type identifierBloomFilter struct {
+	bits      []uint64
+	size      uint64
+	hashCount uint64
+}
+
+// newIdentifierBloomFilter creates a bloom filter sized to hold the expected number of entries at the
+// given false positive rate.
+func newIdentifierBloomFilter(expectedEntries int, falsePositiveRate float64) *identifierBloomFilter {
+	n := math.Max(float64(expectedEntries), 1)
+	size := uint64(math.Ceil(-n * math.Log(falsePositiveRate) / (math.Ln2 * math.Ln2)))
+	if size < 64 {
+		size = 64
+	}
+	hashCount := uint64(math.Round(-math.Log(falsePositiveRate) / math.Ln2))
+	if hashCount < 1 {
+		hashCount = 1
+	}
+	return &identifierBloomFilter{
+		bits:      make([]uint64, (size+63)/64),
+		size:      size,
+		hashCount: hashCount,
+	}
+}
+
+// add adds the value to the filter.
+func (f *identifierBloomFilter) add(value string) {
+	h1, h2 := bloomHashes(value)
+	for i := uint64(0); i < f.hashCount; i++ {
+		bit := (h1 + i*h2) % f.size
+		f.bits[bit/64] |= 1 << (bit % 64)
+	}
+}
+
+// mayContain returns false when the value was never added to the filter.
+func (f *identifierBloomFilter) mayContain(value string) bool {
+	h1, h2 := bloomHashes(value)
+	for i := uint64(0); i < f.hashCount; i++ {
+		bit := (h1 + i*h2) % f.size
+		if f.bits[bit/64]&(1<<(bit%64)) == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// bloomHashes returns the two hashes the bit positions of a value are derived from.
+func bloomHashes(value string) (uint64, uint64) {
+	first := fnv.New64a()
+	_, _ = first.Write([]byte(value))
+	second := fnv.New64()
+	_, _ = second.Write([]byte(value))
+	return first.Sum64(), second.Sum64() | 1
+}
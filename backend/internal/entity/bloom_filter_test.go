@@ -0,0 +1,63 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package entity
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIdentifierBloomFilter_ContainsAddedValues(t *testing.T) {
+	filter := newIdentifierBloomFilter(1000, 0.01)
+	for i := 0; i < 1000; i++ {
+		filter.add(fmt.Sprintf("user%d@example.com", i))
+	}
+
+	for i := 0; i < 1000; i++ {
+		assert.True(t, filter.mayContain(fmt.Sprintf("user%d@example.com", i)))
+	}
+}
+
+func TestIdentifierBloomFilter_FalsePositiveRate(t *testing.T) {
+	filter := newIdentifierBloomFilter(1000, 0.01)
+	for i := 0; i < 1000; i++ {
+		filter.add(fmt.Sprintf("user%d@example.com", i))
+	}
+
+	falsePositives := 0
+	for i := 0; i < 10000; i++ {
+		if filter.mayContain(fmt.Sprintf("absent%d@example.com", i)) {
+			falsePositives++
+		}
+	}
+	assert.Less(t, falsePositives, 300)
+}
+
+func TestIdentifierBloomFilter_Sizing(t *testing.T) {
+	filter := newIdentifierBloomFilter(1000, 0.01)
+	assert.Equal(t, uint64(9586), filter.size)
+	assert.Equal(t, uint64(7), filter.hashCount)
+
+	empty := newIdentifierBloomFilter(0, 0.5)
+	assert.Equal(t, uint64(64), empty.size)
+	assert.Equal(t, uint64(1), empty.hashCount)
+	assert.False(t, empty.mayContain("value"))
+}
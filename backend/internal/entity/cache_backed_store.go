@@ -23,7 +23,9 @@ import (
 	"encoding/json"
 
 	"github.com/thunder-id/thunderid/internal/system/cache"
+	"github.com/thunder-id/thunderid/internal/system/cryptolab/hash"
 	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/internal/system/transaction"
 )
 
 // cacheBackedEntityStore wraps an entityStoreInterface with in-memory caching
@@ -145,6 +147,16 @@ func (s *cacheBackedEntityStore) IdentifyEntity(ctx context.Context,
 
 // Pass-through methods.
 
+func (s *cacheBackedEntityStore) IdentifyEntityInScope(ctx context.Context,
+	filters map[string]interface{}, scope identifierScope) (*string, error) {
+	return s.store.IdentifyEntityInScope(ctx, filters, scope)
+}
+
+func (s *cacheBackedEntityStore) CountDuplicateAttributeValues(ctx context.Context,
+	category, entityType, attribute string, perOU bool) (int, error) {
+	return s.store.CountDuplicateAttributeValues(ctx, category, entityType, attribute, perOU)
+}
+
 func (s *cacheBackedEntityStore) SearchEntities(ctx context.Context,
 	filters map[string]interface{}) ([]Entity, error) {
 	return s.store.SearchEntities(ctx, filters)
@@ -201,6 +213,11 @@ func (s *cacheBackedEntityStore) GetTransitiveEntityGroups(ctx context.Context,
 	return s.store.GetTransitiveEntityGroups(ctx, entityID)
 }
 
+func (s *cacheBackedEntityStore) GetCredentialAlgorithmCounts(ctx context.Context,
+	category string) (map[hash.CredAlgorithm]int, error) {
+	return s.store.GetCredentialAlgorithmCounts(ctx, category)
+}
+
 func (s *cacheBackedEntityStore) IsEntityDeclarative(ctx context.Context, id string) (bool, error) {
 	return s.store.IsEntityDeclarative(ctx, id)
 }
@@ -215,14 +232,19 @@ func (s *cacheBackedEntityStore) LoadIndexedAttributes(attributes []string) erro
 
 // --- Cache helpers ---
 
+// cacheEntityByID caches an entity once the transaction it was read or written in commits, so that an
+// entity created or changed in a transaction that rolls back is never served from the cache.
 func (s *cacheBackedEntityStore) cacheEntityByID(ctx context.Context, entity *Entity) {
 	if entity == nil || entity.ID == "" {
 		return
 	}
-	if err := s.entityByIDCache.Set(ctx, cache.CacheKey{Key: entity.ID}, entity); err != nil {
-		s.logger.Error("Failed to cache entity by ID",
-			log.String("entityID", entity.ID), log.Error(err))
-	}
+	cached := *entity
+	transaction.OnCommit(ctx, func() {
+		if err := s.entityByIDCache.Set(ctx, cache.CacheKey{Key: cached.ID}, &cached); err != nil {
+			s.logger.Error("Failed to cache entity by ID",
+				log.String("entityID", cached.ID), log.Error(err))
+		}
+	})
 }
 
 func (s *cacheBackedEntityStore) invalidateEntityByID(ctx context.Context, entityID string) {
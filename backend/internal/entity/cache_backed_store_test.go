@@ -24,11 +24,13 @@ import (
 	"errors"
 	"testing"
 
+	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 
 	"github.com/thunder-id/thunderid/internal/system/cache"
 	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/internal/system/transaction"
 	"github.com/thunder-id/thunderid/tests/mocks/cachemock"
 )
 
@@ -253,6 +255,58 @@ func (s *CacheBackedEntityStoreTestSuite) TestCreateEntity_StoreError_DoesNotCac
 	s.False(ok)
 }
 
+func (s *CacheBackedEntityStoreTestSuite) TestCreateEntity_RolledBack_DoesNotCache() {
+	db, dbMock, err := sqlmock.New()
+	s.Require().NoError(err)
+	defer func() { _ = db.Close() }()
+	dbMock.ExpectBegin()
+	dbMock.ExpectRollback()
+
+	entity := s.makeEntity(testEntityID, "client-1")
+	s.mockStore.On("CreateEntity", mock.Anything, entity, json.RawMessage(nil),
+		json.RawMessage(nil)).Return(nil).Once()
+	s.mockStore.On("GetEntity", mock.Anything, entity.ID).Return(Entity{}, ErrEntityNotFound).Once()
+
+	err = transaction.NewTransactioner(db, "test").Transact(context.Background(),
+		func(txCtx context.Context) error {
+			if err := s.cachedStore.CreateEntity(txCtx, entity, nil, nil); err != nil {
+				return err
+			}
+			return errors.New("later step failed")
+		})
+	s.Error(err)
+	s.NoError(dbMock.ExpectationsWereMet())
+
+	_, err = s.cachedStore.GetEntity(context.Background(), entity.ID)
+	s.ErrorIs(err, ErrEntityNotFound)
+}
+
+func (s *CacheBackedEntityStoreTestSuite) TestCreateEntity_CachesOnCommit() {
+	db, dbMock, err := sqlmock.New()
+	s.Require().NoError(err)
+	defer func() { _ = db.Close() }()
+	dbMock.ExpectBegin()
+	dbMock.ExpectCommit()
+
+	entity := s.makeEntity(testEntityID, "client-1")
+	s.mockStore.On("CreateEntity", mock.Anything, entity, json.RawMessage(nil),
+		json.RawMessage(nil)).Return(nil).Once()
+
+	err = transaction.NewTransactioner(db, "test").Transact(context.Background(),
+		func(txCtx context.Context) error {
+			if err := s.cachedStore.CreateEntity(txCtx, entity, nil, nil); err != nil {
+				return err
+			}
+			_, ok := s.entityByIDCache.Get(txCtx, cache.CacheKey{Key: entity.ID})
+			s.False(ok)
+			return nil
+		})
+	s.NoError(err)
+
+	_, ok := s.entityByIDCache.Get(context.Background(), cache.CacheKey{Key: entity.ID})
+	s.True(ok)
+}
+
 // UpdateEntity tests
 
 func (s *CacheBackedEntityStoreTestSuite) TestUpdateEntity_InvalidatesAndRecachesEntity() {
@@ -24,6 +24,7 @@ import (
 	"errors"
 
 	serverconst "github.com/thunder-id/thunderid/internal/system/constants"
+	"github.com/thunder-id/thunderid/internal/system/cryptolab/hash"
 	declarativeresource "github.com/thunder-id/thunderid/internal/system/declarative_resource"
 )
 
@@ -127,6 +128,59 @@ func (c *entityCompositeStore) IdentifyEntity(ctx context.Context,
 	)
 }
 
+// IdentifyEntityInScope identifies an entity within the scope from either store (DB first, then file fallback).
+func (c *entityCompositeStore) IdentifyEntityInScope(ctx context.Context,
+	filters map[string]interface{}, scope identifierScope) (*string, error) {
+	return declarativeresource.CompositeGetHelper(
+		func() (*string, error) { return c.dbStore.IdentifyEntityInScope(ctx, filters, scope) },
+		func() (*string, error) { return c.fileStore.IdentifyEntityInScope(ctx, filters, scope) },
+		ErrEntityNotFound,
+	)
+}
+
+// CountDuplicateAttributeValues counts duplicate attribute values within each store and the values of
+// declarative entities that are also held by a database entity in the same scope.
+func (c *entityCompositeStore) CountDuplicateAttributeValues(ctx context.Context,
+	category, entityType, attribute string, perOU bool) (int, error) {
+	dbCount, err := c.dbStore.CountDuplicateAttributeValues(ctx, category, entityType, attribute, perOU)
+	if err != nil {
+		return 0, err
+	}
+	fileCount, err := c.fileStore.CountDuplicateAttributeValues(ctx, category, entityType, attribute, perOU)
+	if err != nil {
+		return 0, err
+	}
+
+	fileEntities, err := c.fileStore.GetEntityList(ctx, category, serverconst.MaxCompositeStoreRecords, 0, nil)
+	if err != nil && !errors.Is(err, ErrEntityNotFound) {
+		return 0, err
+	}
+
+	crossCount := 0
+	for _, entity := range fileEntities {
+		if entity.Type != entityType {
+			continue
+		}
+		value, ok := attributeValue(entity, attribute)
+		if !ok {
+			continue
+		}
+		scope := identifierScope{Category: category, Type: entityType}
+		if perOU {
+			scope.OUID = entity.OUID
+		}
+		_, err := c.dbStore.IdentifyEntityInScope(ctx, map[string]interface{}{attribute: value}, scope)
+		switch {
+		case err == nil || errors.Is(err, ErrAmbiguousEntity):
+			crossCount++
+		case !errors.Is(err, ErrEntityNotFound):
+			return 0, err
+		}
+	}
+
+	return dbCount + fileCount + crossCount, nil
+}
+
 // SearchEntities searches for entities matching the given filters from both stores.
 func (c *entityCompositeStore) SearchEntities(ctx context.Context,
 	filters map[string]interface{}) ([]Entity, error) {
@@ -323,6 +377,23 @@ func (c *entityCompositeStore) GetTransitiveEntityGroups(ctx context.Context, en
 	return c.dbStore.GetTransitiveEntityGroups(ctx, entityID)
 }
 
+// GetCredentialAlgorithmCounts sums the credential algorithm counts of both stores.
+func (c *entityCompositeStore) GetCredentialAlgorithmCounts(ctx context.Context,
+	category string) (map[hash.CredAlgorithm]int, error) {
+	counts, err := c.dbStore.GetCredentialAlgorithmCounts(ctx, category)
+	if err != nil {
+		return nil, err
+	}
+	fileCounts, err := c.fileStore.GetCredentialAlgorithmCounts(ctx, category)
+	if err != nil {
+		return nil, err
+	}
+	for algorithm, count := range fileCounts {
+		counts[algorithm] += count
+	}
+	return counts, nil
+}
+
 // IsEntityDeclarative checks if an entity is declarative (exists in file store).
 func (c *entityCompositeStore) IsEntityDeclarative(ctx context.Context, id string) (bool, error) {
 	isDeclarative, err := c.fileStore.IsEntityDeclarative(ctx, id)
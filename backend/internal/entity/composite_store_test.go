@@ -204,6 +204,51 @@ func (s *CompositeStoreTestSuite) TestIdentifyEntity_DBError() {
 	s.Error(err)
 }
 
+func (s *CompositeStoreTestSuite) TestIdentifyEntityInScope_FileFallback() {
+	id := "file-id"
+	filters := map[string]interface{}{"email": "a@b.com"}
+	scope := identifierScope{OUID: "ou1"}
+	s.dbStore.On("IdentifyEntityInScope", mock.Anything, filters, scope).Return((*string)(nil), ErrEntityNotFound)
+	s.fileStore.On("IdentifyEntityInScope", mock.Anything, filters, scope).Return(&id, nil)
+	got, err := s.store.IdentifyEntityInScope(s.ctx, filters, scope)
+	s.NoError(err)
+	s.Equal(&id, got)
+}
+
+func (s *CompositeStoreTestSuite) TestCountDuplicateAttributeValues_IncludesCrossStoreDuplicates() {
+	dbID := "db-id"
+	shared := compEntity("f1", "ou1")
+	shared.Type = "employee"
+	shared.Attributes = json.RawMessage(`{"email":"shared@b.com"}`)
+	unique := compEntity("f2", "ou2")
+	unique.Type = "employee"
+	unique.Attributes = json.RawMessage(`{"email":"unique@b.com"}`)
+	other := compEntity("f3", "ou1")
+	other.Type = "contractor"
+	other.Attributes = json.RawMessage(`{"email":"shared@b.com"}`)
+
+	s.dbStore.On("CountDuplicateAttributeValues", mock.Anything, "user", "employee", "email", true).Return(1, nil)
+	s.fileStore.On("CountDuplicateAttributeValues", mock.Anything, "user", "employee", "email", true).
+		Return(0, nil)
+	s.fileStore.On("GetEntityList", mock.Anything, "user", serverconst.MaxCompositeStoreRecords, 0,
+		map[string]interface{}(nil)).Return([]Entity{shared, unique, other}, nil)
+	s.dbStore.On("IdentifyEntityInScope", mock.Anything, map[string]interface{}{"email": "shared@b.com"},
+		identifierScope{Category: "user", Type: "employee", OUID: "ou1"}).Return(&dbID, nil)
+	s.dbStore.On("IdentifyEntityInScope", mock.Anything, map[string]interface{}{"email": "unique@b.com"},
+		identifierScope{Category: "user", Type: "employee", OUID: "ou2"}).Return((*string)(nil), ErrEntityNotFound)
+
+	count, err := s.store.CountDuplicateAttributeValues(s.ctx, "user", "employee", "email", true)
+	s.NoError(err)
+	s.Equal(2, count)
+}
+
+func (s *CompositeStoreTestSuite) TestCountDuplicateAttributeValues_DBError() {
+	s.dbStore.On("CountDuplicateAttributeValues", mock.Anything, "user", "employee", "email", false).
+		Return(0, s.testErr)
+	_, err := s.store.CountDuplicateAttributeValues(s.ctx, "user", "employee", "email", false)
+	s.Error(err)
+}
+
 func (s *CompositeStoreTestSuite) TestGetEntityListCount_MergesStores() {
 	e1 := compEntity("e1", "ou1")
 	e2 := compEntity("e2", "ou1")
@@ -5,6 +5,7 @@
 package entity
 
 import (
+	"context"
 	"encoding/json"
 
 	mock "github.com/stretchr/testify/mock"
@@ -38,8 +39,8 @@ func (_m *declarativeSystemCredentialHasherMock) EXPECT() *declarativeSystemCred
 }
 
 // hashPlaintextCredentials provides a mock function for the type declarativeSystemCredentialHasherMock
-func (_mock *declarativeSystemCredentialHasherMock) hashPlaintextCredentials(creds json.RawMessage) (json.RawMessage, error) {
-	ret := _mock.Called(creds)
+func (_mock *declarativeSystemCredentialHasherMock) hashPlaintextCredentials(ctx context.Context, creds json.RawMessage) (json.RawMessage, error) {
+	ret := _mock.Called(ctx, creds)
 
 	if len(ret) == 0 {
 		panic("no return value specified for hashPlaintextCredentials")
@@ -47,18 +48,16 @@ func (_mock *declarativeSystemCredentialHasherMock) hashPlaintextCredentials(cre
 
 	var r0 json.RawMessage
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(json.RawMessage) (json.RawMessage, error)); ok {
-		return returnFunc(creds)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, json.RawMessage) (json.RawMessage, error)); ok {
+		return returnFunc(ctx, creds)
 	}
-	if returnFunc, ok := ret.Get(0).(func(json.RawMessage) json.RawMessage); ok {
-		r0 = returnFunc(creds)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, json.RawMessage) json.RawMessage); ok {
+		r0 = returnFunc(ctx, creds)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(json.RawMessage)
-		}
+		r0 = ret.Get(0).(json.RawMessage)
 	}
-	if returnFunc, ok := ret.Get(1).(func(json.RawMessage) error); ok {
-		r1 = returnFunc(creds)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, json.RawMessage) error); ok {
+		r1 = returnFunc(ctx, creds)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -71,19 +70,25 @@ type declarativeSystemCredentialHasherMock_hashPlaintextCredentials_Call struct
 }
 
 // hashPlaintextCredentials is a helper method to define mock.On call
+//   - ctx context.Context
 //   - creds json.RawMessage
-func (_e *declarativeSystemCredentialHasherMock_Expecter) hashPlaintextCredentials(creds interface{}) *declarativeSystemCredentialHasherMock_hashPlaintextCredentials_Call {
-	return &declarativeSystemCredentialHasherMock_hashPlaintextCredentials_Call{Call: _e.mock.On("hashPlaintextCredentials", creds)}
+func (_e *declarativeSystemCredentialHasherMock_Expecter) hashPlaintextCredentials(ctx interface{}, creds interface{}) *declarativeSystemCredentialHasherMock_hashPlaintextCredentials_Call {
+	return &declarativeSystemCredentialHasherMock_hashPlaintextCredentials_Call{Call: _e.mock.On("hashPlaintextCredentials", ctx, creds)}
 }
 
-func (_c *declarativeSystemCredentialHasherMock_hashPlaintextCredentials_Call) Run(run func(creds json.RawMessage)) *declarativeSystemCredentialHasherMock_hashPlaintextCredentials_Call {
+func (_c *declarativeSystemCredentialHasherMock_hashPlaintextCredentials_Call) Run(run func(ctx context.Context, creds json.RawMessage)) *declarativeSystemCredentialHasherMock_hashPlaintextCredentials_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 json.RawMessage
+		var arg0 context.Context
 		if args[0] != nil {
-			arg0 = args[0].(json.RawMessage)
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 json.RawMessage
+		if args[1] != nil {
+			arg1 = args[1].(json.RawMessage)
 		}
 		run(
 			arg0,
+			arg1,
 		)
 	})
 	return _c
@@ -94,7 +99,7 @@ func (_c *declarativeSystemCredentialHasherMock_hashPlaintextCredentials_Call) R
 	return _c
 }
 
-func (_c *declarativeSystemCredentialHasherMock_hashPlaintextCredentials_Call) RunAndReturn(run func(creds json.RawMessage) (json.RawMessage, error)) *declarativeSystemCredentialHasherMock_hashPlaintextCredentials_Call {
+func (_c *declarativeSystemCredentialHasherMock_hashPlaintextCredentials_Call) RunAndReturn(run func(ctx context.Context, creds json.RawMessage) (json.RawMessage, error)) *declarativeSystemCredentialHasherMock_hashPlaintextCredentials_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -19,6 +19,7 @@
 package entity
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 
@@ -27,7 +28,7 @@ import (
 )
 
 type declarativeSystemCredentialHasher interface {
-	hashPlaintextCredentials(creds json.RawMessage) (json.RawMessage, error)
+	hashPlaintextCredentials(ctx context.Context, creds json.RawMessage) (json.RawMessage, error)
 }
 
 // loadDeclarativeResources loads declarative resources for a given configuration
@@ -40,6 +41,16 @@ func loadDeclarativeResources(
 ) error {
 	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, "EntityDeclarativeLoader"))
 
+	// Entities loaded into the file store bypass the identifier filter, so it is rebuilt once they
+	// are loaded.
+	if filterStore, ok := store.(*identifierFilterStore); ok {
+		if err := loadDeclarativeResources(filterStore.store, svc, config); err != nil {
+			return err
+		}
+		filterStore.invalidate()
+		return nil
+	}
+
 	// Extract the file-based store from the store hierarchy.
 	var fileStore *entityFileBasedStore
 	switch s := store.(type) {
@@ -70,7 +81,7 @@ func loadDeclarativeResources(
 				return nil, fmt.Errorf("entity service cannot hash declarative system credentials")
 			}
 
-			systemCredentials, err = hasher.hashPlaintextCredentials(systemCredentials)
+			systemCredentials, err = hasher.hashPlaintextCredentials(context.Background(), systemCredentials)
 			if err != nil {
 				return nil, fmt.Errorf("failed to hash declarative system credentials: %w", err)
 			}
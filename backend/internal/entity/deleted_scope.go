@@ -0,0 +1,54 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package entity
+
+import "context"
+
+// deletedScopeContextKey is the context key marking entity listings that return soft-deleted entities.
+type deletedScopeContextKey struct{}
+
+// WithDeletedEntities returns a context that makes the entity listings made with it return only the entities
+// in the deleted state. Listings made with other contexts leave deleted entities out.
+func WithDeletedEntities(ctx context.Context) context.Context {
+	return context.WithValue(ctx, deletedScopeContextKey{}, true)
+}
+
+// isDeletedScope reports whether entity listings made with the context return deleted entities.
+func isDeletedScope(ctx context.Context) bool {
+	deleted, _ := ctx.Value(deletedScopeContextKey{}).(bool)
+	return deleted
+}
+
+// deletedIdentifiersContextKey is the context key marking identify lookups that also match soft-deleted
+// entities.
+type deletedIdentifiersContextKey struct{}
+
+// withDeletedIdentifiers returns a context that makes the identify lookups made with it also match
+// soft-deleted entities. Uniqueness checks use it so that a deleted entity keeps its unique values until
+// it is purged, and can be restored without conflicting with another entity.
+func withDeletedIdentifiers(ctx context.Context) context.Context {
+	return context.WithValue(ctx, deletedIdentifiersContextKey{}, true)
+}
+
+// includesDeletedIdentifiers reports whether identify lookups made with the context match soft-deleted
+// entities.
+func includesDeletedIdentifiers(ctx context.Context) bool {
+	included, _ := ctx.Value(deletedIdentifiersContextKey{}).(bool)
+	return included
+}
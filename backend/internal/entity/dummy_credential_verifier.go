@@ -0,0 +1,69 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package entity
+
+import (
+	"crypto/rand"
+	"sync"
+
+	"github.com/thunder-id/thunderid/internal/system/cryptolab/hash"
+)
+
+// dummyCredentialSize is the number of random bytes hashed into the dummy credential.
+const dummyCredentialSize = 32
+
+// dummyCredentialVerifier verifies credentials against a credential that belongs to no entity, so that
+// authenticating an unknown entity spends as long hashing as authenticating with a wrong credential.
+type dummyCredentialVerifier struct {
+	hashService hash.HashServiceInterface
+	once        sync.Once
+	credential  *hash.Credential
+}
+
+// newDummyCredentialVerifier creates a new instance of dummyCredentialVerifier.
+func newDummyCredentialVerifier(hashService hash.HashServiceInterface) *dummyCredentialVerifier {
+	return &dummyCredentialVerifier{hashService: hashService}
+}
+
+// verify verifies each string credential against the dummy credential and discards the results. The dummy
+// credential is hashed with the configured algorithm on first use.
+func (v *dummyCredentialVerifier) verify(credentials map[string]interface{}) {
+	v.once.Do(func() {
+		value := make([]byte, dummyCredentialSize)
+		if _, err := rand.Read(value); err != nil {
+			return
+		}
+		credential, err := v.hashService.Generate(value)
+		if err != nil {
+			return
+		}
+		v.credential = &credential
+	})
+	if v.credential == nil {
+		return
+	}
+
+	for _, credValueInterface := range credentials {
+		credValue, ok := credValueInterface.(string)
+		if !ok || credValue == "" {
+			continue
+		}
+		_, _ = v.hashService.Verify([]byte(credValue), *v.credential)
+	}
+}
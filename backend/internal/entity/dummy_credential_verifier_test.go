@@ -0,0 +1,121 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package entity
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/thunder-id/thunderid/internal/system/cryptolab/hash"
+	"github.com/thunder-id/thunderid/internal/system/transaction"
+	"github.com/thunder-id/thunderid/tests/mocks/crypto/hashmock"
+)
+
+func TestDummyCredentialVerifier_VerifiesStringCredentials(t *testing.T) {
+	hashService := hashmock.NewHashServiceInterfaceMock(t)
+	dummy := hash.Credential{Algorithm: hash.PBKDF2, Hash: "dummy-hash"}
+	hashService.On("Generate", mock.Anything).Return(dummy, nil).Once()
+	hashService.On("Verify", []byte("secret"), dummy).Return(false, nil).Twice()
+
+	verifier := newDummyCredentialVerifier(hashService)
+	credentials := map[string]interface{}{
+		"password": "secret",
+		"pin":      "",
+		"otp":      map[string]interface{}{"otp": "123456"},
+	}
+	verifier.verify(credentials)
+	verifier.verify(credentials)
+}
+
+func TestDummyCredentialVerifier_GenerateFailure(t *testing.T) {
+	hashService := hashmock.NewHashServiceInterfaceMock(t)
+	hashService.On("Generate", mock.Anything).Return(hash.Credential{}, errors.New("hash failure")).Once()
+
+	verifier := newDummyCredentialVerifier(hashService)
+	verifier.verify(map[string]interface{}{"password": "secret"})
+	verifier.verify(map[string]interface{}{"password": "secret"})
+
+	hashService.AssertNotCalled(t, "Verify", mock.Anything, mock.Anything)
+}
+
+func TestAuthenticateEntity_VerifiesDummyCredentialForUnknownEntity(t *testing.T) {
+	tests := []struct {
+		name  string
+		setup func(store *entityStoreInterfaceMock)
+		call  func(svc EntityServiceInterface) error
+	}{
+		{
+			name: "entity not found by ID",
+			setup: func(store *entityStoreInterfaceMock) {
+				store.On("GetEntityWithCredentials", mock.Anything, "missing").Return(nil, ErrEntityNotFound)
+			},
+			call: func(svc EntityServiceInterface) error {
+				_, err := svc.AuthenticateEntityByID(context.Background(), "missing",
+					map[string]interface{}{"password": "secret"})
+				return err
+			},
+		},
+		{
+			name: "inactive entity",
+			setup: func(store *entityStoreInterfaceMock) {
+				e := testEntity("inactive-1")
+				e.State = EntityState("SUSPENDED")
+				store.On("GetEntityWithCredentials", mock.Anything, e.ID).
+					Return(&entityWithCredentials{Entity: e, SchemaCredentials: testCredentialsJSON()}, nil)
+			},
+			call: func(svc EntityServiceInterface) error {
+				_, err := svc.AuthenticateEntityByID(context.Background(), "inactive-1",
+					map[string]interface{}{"password": "secret"})
+				return err
+			},
+		},
+		{
+			name: "entity without stored credentials",
+			setup: func(store *entityStoreInterfaceMock) {
+				store.On("GetEntityWithCredentials", mock.Anything, "no-creds").
+					Return(&entityWithCredentials{Entity: testEntity("no-creds")}, nil)
+			},
+			call: func(svc EntityServiceInterface) error {
+				_, err := svc.AuthenticateEntityByID(context.Background(), "no-creds",
+					map[string]interface{}{"password": "secret"})
+				return err
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			store := newEntityStoreInterfaceMock(t)
+			hashService := hashmock.NewHashServiceInterfaceMock(t)
+			dummy := hash.Credential{Algorithm: hash.PBKDF2, Hash: "dummy-hash"}
+			hashService.On("Generate", mock.Anything).Return(dummy, nil).Once()
+			hashService.On("Verify", []byte("secret"), dummy).Return(false, nil).Once()
+			tc.setup(store)
+
+			svc := newEntityService(store, hashService, nil, nil, nil, nil, transaction.NewNoOpTransactioner())
+			svc.(*entityService).dummyVerifier = newDummyCredentialVerifier(hashService)
+
+			assert.Error(t, tc.call(svc))
+		})
+	}
+}
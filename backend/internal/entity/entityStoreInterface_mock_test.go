@@ -9,6 +9,7 @@ import (
 	"encoding/json"
 
 	mock "github.com/stretchr/testify/mock"
+	"github.com/thunder-id/thunderid/internal/system/cryptolab/hash"
 )
 
 // newEntityStoreInterfaceMock creates a new instance of entityStoreInterfaceMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
@@ -38,6 +39,90 @@ func (_m *entityStoreInterfaceMock) EXPECT() *entityStoreInterfaceMock_Expecter
 	return &entityStoreInterfaceMock_Expecter{mock: &_m.Mock}
 }
 
+// CountDuplicateAttributeValues provides a mock function for the type entityStoreInterfaceMock
+func (_mock *entityStoreInterfaceMock) CountDuplicateAttributeValues(ctx context.Context, category string, entityType string, attribute string, perOU bool) (int, error) {
+	ret := _mock.Called(ctx, category, entityType, attribute, perOU)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountDuplicateAttributeValues")
+	}
+
+	var r0 int
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string, bool) (int, error)); ok {
+		return returnFunc(ctx, category, entityType, attribute, perOU)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string, bool) int); ok {
+		r0 = returnFunc(ctx, category, entityType, attribute, perOU)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, string, bool) error); ok {
+		r1 = returnFunc(ctx, category, entityType, attribute, perOU)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// entityStoreInterfaceMock_CountDuplicateAttributeValues_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountDuplicateAttributeValues'
+type entityStoreInterfaceMock_CountDuplicateAttributeValues_Call struct {
+	*mock.Call
+}
+
+// CountDuplicateAttributeValues is a helper method to define mock.On call
+//   - ctx context.Context
+//   - category string
+//   - entityType string
+//   - attribute string
+//   - perOU bool
+func (_e *entityStoreInterfaceMock_Expecter) CountDuplicateAttributeValues(ctx interface{}, category interface{}, entityType interface{}, attribute interface{}, perOU interface{}) *entityStoreInterfaceMock_CountDuplicateAttributeValues_Call {
+	return &entityStoreInterfaceMock_CountDuplicateAttributeValues_Call{Call: _e.mock.On("CountDuplicateAttributeValues", ctx, category, entityType, attribute, perOU)}
+}
+
+func (_c *entityStoreInterfaceMock_CountDuplicateAttributeValues_Call) Run(run func(ctx context.Context, category string, entityType string, attribute string, perOU bool)) *entityStoreInterfaceMock_CountDuplicateAttributeValues_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		var arg4 bool
+		if args[4] != nil {
+			arg4 = args[4].(bool)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+		)
+	})
+	return _c
+}
+
+func (_c *entityStoreInterfaceMock_CountDuplicateAttributeValues_Call) Return(int int, err error) *entityStoreInterfaceMock_CountDuplicateAttributeValues_Call {
+	_c.Call.Return(int, err)
+	return _c
+}
+
+func (_c *entityStoreInterfaceMock_CountDuplicateAttributeValues_Call) RunAndReturn(run func(ctx context.Context, category string, entityType string, attribute string, perOU bool) (int, error)) *entityStoreInterfaceMock_CountDuplicateAttributeValues_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateEntity provides a mock function for the type entityStoreInterfaceMock
 func (_mock *entityStoreInterfaceMock) CreateEntity(ctx context.Context, entity Entity, credentials json.RawMessage, systemCredentials json.RawMessage) error {
 	ret := _mock.Called(ctx, entity, credentials, systemCredentials)
@@ -164,6 +249,74 @@ func (_c *entityStoreInterfaceMock_DeleteEntity_Call) RunAndReturn(run func(ctx
 	return _c
 }
 
+// GetCredentialAlgorithmCounts provides a mock function for the type entityStoreInterfaceMock
+func (_mock *entityStoreInterfaceMock) GetCredentialAlgorithmCounts(ctx context.Context, category string) (map[hash.CredAlgorithm]int, error) {
+	ret := _mock.Called(ctx, category)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCredentialAlgorithmCounts")
+	}
+
+	var r0 map[hash.CredAlgorithm]int
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (map[hash.CredAlgorithm]int, error)); ok {
+		return returnFunc(ctx, category)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) map[hash.CredAlgorithm]int); ok {
+		r0 = returnFunc(ctx, category)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[hash.CredAlgorithm]int)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, category)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// entityStoreInterfaceMock_GetCredentialAlgorithmCounts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCredentialAlgorithmCounts'
+type entityStoreInterfaceMock_GetCredentialAlgorithmCounts_Call struct {
+	*mock.Call
+}
+
+// GetCredentialAlgorithmCounts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - category string
+func (_e *entityStoreInterfaceMock_Expecter) GetCredentialAlgorithmCounts(ctx interface{}, category interface{}) *entityStoreInterfaceMock_GetCredentialAlgorithmCounts_Call {
+	return &entityStoreInterfaceMock_GetCredentialAlgorithmCounts_Call{Call: _e.mock.On("GetCredentialAlgorithmCounts", ctx, category)}
+}
+
+func (_c *entityStoreInterfaceMock_GetCredentialAlgorithmCounts_Call) Run(run func(ctx context.Context, category string)) *entityStoreInterfaceMock_GetCredentialAlgorithmCounts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *entityStoreInterfaceMock_GetCredentialAlgorithmCounts_Call) Return(credAlgorithmToInt map[hash.CredAlgorithm]int, err error) *entityStoreInterfaceMock_GetCredentialAlgorithmCounts_Call {
+	_c.Call.Return(credAlgorithmToInt, err)
+	return _c
+}
+
+func (_c *entityStoreInterfaceMock_GetCredentialAlgorithmCounts_Call) RunAndReturn(run func(ctx context.Context, category string) (map[hash.CredAlgorithm]int, error)) *entityStoreInterfaceMock_GetCredentialAlgorithmCounts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetEntitiesByIDs provides a mock function for the type entityStoreInterfaceMock
 func (_mock *entityStoreInterfaceMock) GetEntitiesByIDs(ctx context.Context, entityIDs []string) ([]Entity, error) {
 	ret := _mock.Called(ctx, entityIDs)
@@ -1022,6 +1175,80 @@ func (_c *entityStoreInterfaceMock_IdentifyEntity_Call) RunAndReturn(run func(ct
 	return _c
 }
 
+// IdentifyEntityInScope provides a mock function for the type entityStoreInterfaceMock
+func (_mock *entityStoreInterfaceMock) IdentifyEntityInScope(ctx context.Context, filters map[string]interface{}, scope identifierScope) (*string, error) {
+	ret := _mock.Called(ctx, filters, scope)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IdentifyEntityInScope")
+	}
+
+	var r0 *string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, map[string]interface{}, identifierScope) (*string, error)); ok {
+		return returnFunc(ctx, filters, scope)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, map[string]interface{}, identifierScope) *string); ok {
+		r0 = returnFunc(ctx, filters, scope)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*string)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, map[string]interface{}, identifierScope) error); ok {
+		r1 = returnFunc(ctx, filters, scope)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// entityStoreInterfaceMock_IdentifyEntityInScope_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IdentifyEntityInScope'
+type entityStoreInterfaceMock_IdentifyEntityInScope_Call struct {
+	*mock.Call
+}
+
+// IdentifyEntityInScope is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filters map[string]interface{}
+//   - scope identifierScope
+func (_e *entityStoreInterfaceMock_Expecter) IdentifyEntityInScope(ctx interface{}, filters interface{}, scope interface{}) *entityStoreInterfaceMock_IdentifyEntityInScope_Call {
+	return &entityStoreInterfaceMock_IdentifyEntityInScope_Call{Call: _e.mock.On("IdentifyEntityInScope", ctx, filters, scope)}
+}
+
+func (_c *entityStoreInterfaceMock_IdentifyEntityInScope_Call) Run(run func(ctx context.Context, filters map[string]interface{}, scope identifierScope)) *entityStoreInterfaceMock_IdentifyEntityInScope_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 map[string]interface{}
+		if args[1] != nil {
+			arg1 = args[1].(map[string]interface{})
+		}
+		var arg2 identifierScope
+		if args[2] != nil {
+			arg2 = args[2].(identifierScope)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *entityStoreInterfaceMock_IdentifyEntityInScope_Call) Return(string *string, err error) *entityStoreInterfaceMock_IdentifyEntityInScope_Call {
+	_c.Call.Return(string, err)
+	return _c
+}
+
+func (_c *entityStoreInterfaceMock_IdentifyEntityInScope_Call) RunAndReturn(run func(ctx context.Context, filters map[string]interface{}, scope identifierScope) (*string, error)) *entityStoreInterfaceMock_IdentifyEntityInScope_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // IsEntityDeclarative provides a mock function for the type entityStoreInterfaceMock
 func (_mock *entityStoreInterfaceMock) IsEntityDeclarative(ctx context.Context, id string) (bool, error) {
 	ret := _mock.Called(ctx, id)
@@ -37,9 +37,22 @@ var (
 	// ErrInvalidCredential is returned when a credential value is invalid.
 	ErrInvalidCredential = errors.New("invalid credential")
 
+	// ErrDeniedIdentifier is returned when a user identifier is blocked by the username deny list.
+	ErrDeniedIdentifier = errors.New("identifier is not allowed")
+
+	// ErrDeniedCredential is returned when a user credential is blocked by the password deny list.
+	ErrDeniedCredential = errors.New("credential is not allowed")
+
 	// ErrAmbiguousEntity is returned when multiple entities match the provided filters.
 	ErrAmbiguousEntity = errors.New("ambiguous entity")
 
+	// ErrCredentialNotFound is returned when the entity does not hold a credential of the requested type.
+	ErrCredentialNotFound = errors.New("credential not found")
+
+	// ErrCredentialPolicyViolation is returned when the credentials of an entity would not satisfy the
+	// credential policy of its entity type.
+	ErrCredentialPolicyViolation = errors.New("credential policy violation")
+
 	// ErrBadAttributesInRequest is returned when the attributes in the request are invalid.
 	ErrBadAttributesInRequest = errors.New("failed to marshal attributes")
 
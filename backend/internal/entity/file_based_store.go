@@ -22,8 +22,10 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"strings"
 
+	"github.com/thunder-id/thunderid/internal/system/cryptolab/hash"
 	declarativeresource "github.com/thunder-id/thunderid/internal/system/declarative_resource"
 	entitystore "github.com/thunder-id/thunderid/internal/system/declarative_resource/entity"
 )
@@ -155,6 +157,52 @@ func (f *entityFileBasedStore) IdentifyEntity(ctx context.Context,
 	return &matches[0], nil
 }
 
+// IdentifyEntityInScope identifies an entity with the given filters within the given scope by linear search.
+func (f *entityFileBasedStore) IdentifyEntityInScope(ctx context.Context,
+	filters map[string]interface{}, scope identifierScope) (*string, error) {
+	resources, err := f.listEntityResources()
+	if err != nil {
+		return nil, err
+	}
+
+	var matches []string
+	for _, resource := range resources {
+		if !resource.Entity.inScope(scope) {
+			continue
+		}
+		combined := mergeJSONObjects(resource.Entity.Attributes, resource.Entity.SystemAttributes)
+		if matchesFilters(combined, filters) {
+			matches = append(matches, resource.Entity.ID)
+		}
+	}
+
+	if len(matches) == 0 {
+		return nil, ErrEntityNotFound
+	}
+	if len(matches) > 1 {
+		return nil, ErrAmbiguousEntity
+	}
+
+	return &matches[0], nil
+}
+
+// CountDuplicateAttributeValues returns the number of attribute values shared by more than one entity of the
+// given type in the file store.
+func (f *entityFileBasedStore) CountDuplicateAttributeValues(ctx context.Context,
+	category, entityType, attribute string, perOU bool) (int, error) {
+	resources, err := f.listEntityResources()
+	if err != nil {
+		return 0, err
+	}
+
+	entities := make([]Entity, 0, len(resources))
+	for _, resource := range resources {
+		entities = append(entities, resource.Entity)
+	}
+
+	return countDuplicateValues(entities, category, entityType, attribute, perOU), nil
+}
+
 // SearchEntities searches for all entities matching the provided filters from the file store.
 func (f *entityFileBasedStore) SearchEntities(ctx context.Context,
 	filters map[string]interface{}) ([]Entity, error) {
@@ -181,6 +229,11 @@ func (f *entityFileBasedStore) SearchEntities(ctx context.Context,
 // GetEntityListCount retrieves the total count of entities from the file store.
 func (f *entityFileBasedStore) GetEntityListCount(ctx context.Context, category string,
 	filters map[string]interface{}) (int, error) {
+	// Declarative entities are never associated with applications nor deleted.
+	if getApplicationScope(ctx) != "" || isDeletedScope(ctx) {
+		return 0, nil
+	}
+
 	resources, err := f.listEntityResources()
 	if err != nil {
 		return 0, err
@@ -202,6 +255,11 @@ func (f *entityFileBasedStore) GetEntityListCount(ctx context.Context, category
 // GetEntityList retrieves entities from the file store with pagination and filtering.
 func (f *entityFileBasedStore) GetEntityList(ctx context.Context, category string,
 	limit, offset int, filters map[string]interface{}) ([]Entity, error) {
+	// Declarative entities are never associated with applications nor deleted.
+	if getApplicationScope(ctx) != "" || isDeletedScope(ctx) {
+		return []Entity{}, nil
+	}
+
 	resources, err := f.listEntityResources()
 	if err != nil {
 		return nil, err
@@ -224,6 +282,11 @@ func (f *entityFileBasedStore) GetEntityList(ctx context.Context, category strin
 // GetEntityListCountByOUIDs retrieves the total count of entities by OU IDs.
 func (f *entityFileBasedStore) GetEntityListCountByOUIDs(ctx context.Context, category string,
 	ouIDs []string, filters map[string]interface{}) (int, error) {
+	// Declarative entities are never associated with applications nor deleted.
+	if getApplicationScope(ctx) != "" || isDeletedScope(ctx) {
+		return 0, nil
+	}
+
 	resources, err := f.listEntityResources()
 	if err != nil {
 		return 0, err
@@ -253,6 +316,11 @@ func (f *entityFileBasedStore) GetEntityListCountByOUIDs(ctx context.Context, ca
 // GetEntityListByOUIDs retrieves entities scoped to OU IDs with pagination and filtering.
 func (f *entityFileBasedStore) GetEntityListByOUIDs(ctx context.Context, category string,
 	ouIDs []string, limit, offset int, filters map[string]interface{}) ([]Entity, error) {
+	// Declarative entities are never associated with applications nor deleted.
+	if getApplicationScope(ctx) != "" || isDeletedScope(ctx) {
+		return []Entity{}, nil
+	}
+
 	resources, err := f.listEntityResources()
 	if err != nil {
 		return nil, err
@@ -366,6 +434,26 @@ func (f *entityFileBasedStore) ValidateEntityIDsInOUs(
 	return outOfScope, nil
 }
 
+// GetCredentialAlgorithmCounts counts the stored credential entries of file-based entities in the category
+// by their storage algorithm.
+func (f *entityFileBasedStore) GetCredentialAlgorithmCounts(ctx context.Context,
+	category string) (map[hash.CredAlgorithm]int, error) {
+	resources, err := f.listEntityResources()
+	if err != nil {
+		return nil, err
+	}
+
+	counts := make(map[hash.CredAlgorithm]int)
+	for _, resource := range resources {
+		if string(resource.Entity.Category) != category {
+			continue
+		}
+		countCredentialAlgorithms(counts, resource.Credentials)
+		countCredentialAlgorithms(counts, resource.SystemCredentials)
+	}
+	return counts, nil
+}
+
 // IsEntityDeclarative checks if an entity exists in the file store (all file entities are declarative).
 func (f *entityFileBasedStore) IsEntityDeclarative(ctx context.Context, id string) (bool, error) {
 	_, err := f.GetEntity(ctx, id)
@@ -455,6 +543,49 @@ func mergeJSONObjects(base, override json.RawMessage) json.RawMessage {
 	return merged
 }
 
+// countDuplicateValues counts the attribute values shared by more than one of the given entities of a type.
+func countDuplicateValues(entities []Entity, category, entityType, attribute string, perOU bool) int {
+	occurrences := make(map[string]int)
+	for _, entity := range entities {
+		if !entity.inScope(identifierScope{Category: category, Type: entityType}) {
+			continue
+		}
+		value, ok := attributeValue(entity, attribute)
+		if !ok {
+			continue
+		}
+		key := value
+		if perOU {
+			key = entity.OUID + "\x00" + value
+		}
+		occurrences[key]++
+	}
+
+	duplicates := 0
+	for _, count := range occurrences {
+		if count > 1 {
+			duplicates++
+		}
+	}
+	return duplicates
+}
+
+// attributeValue returns the string form of an attribute of the entity, if present.
+func attributeValue(entity Entity, attribute string) (string, bool) {
+	if len(entity.Attributes) == 0 {
+		return "", false
+	}
+	var attrsMap map[string]interface{}
+	if err := json.Unmarshal(entity.Attributes, &attrsMap); err != nil {
+		return "", false
+	}
+	value, ok := getNestedValue(attrsMap, attribute)
+	if !ok || value == nil {
+		return "", false
+	}
+	return fmt.Sprintf("%v", value), true
+}
+
 func matchesFilters(attributes json.RawMessage, filters map[string]interface{}) bool {
 	if len(filters) == 0 {
 		return true
@@ -469,6 +600,12 @@ func matchesFilters(attributes json.RawMessage, filters map[string]interface{})
 	}
 
 	for key, expected := range filters {
+		if arrayPath, field, ok := splitArrayElementKey(key); ok {
+			if !containsArrayElement(attrsMap, arrayPath, field, expected) {
+				return false
+			}
+			continue
+		}
 		value, ok := getNestedValue(attrsMap, key)
 		if !ok || !valuesEqual(value, expected) {
 			return false
@@ -478,6 +615,29 @@ func matchesFilters(attributes json.RawMessage, filters map[string]interface{})
 	return true
 }
 
+// containsArrayElement reports whether any object item of the array at arrayPath holds the expected value
+// in the given field.
+func containsArrayElement(attrsMap map[string]interface{}, arrayPath, field string, expected interface{}) bool {
+	value, ok := getNestedValue(attrsMap, arrayPath)
+	if !ok {
+		return false
+	}
+	items, ok := value.([]interface{})
+	if !ok {
+		return false
+	}
+	for _, item := range items {
+		itemMap, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if fieldValue, ok := getNestedValue(itemMap, field); ok && valuesEqual(fieldValue, expected) {
+			return true
+		}
+	}
+	return false
+}
+
 func getNestedValue(data map[string]interface{}, key string) (interface{}, bool) {
 	parts := strings.Split(key, ".")
 	current := interface{}(data)
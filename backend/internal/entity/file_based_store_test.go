@@ -155,6 +155,70 @@ func (s *FileBasedStoreTestSuite) TestIdentifyEntity_MultipleMatches() {
 	s.Error(err)
 }
 
+func (s *FileBasedStoreTestSuite) TestIdentifyEntity_ArrayElementKey() {
+	attrs, _ := json.Marshal(map[string]interface{}{"emails": []interface{}{
+		map[string]interface{}{"value": "home@test.com"},
+		map[string]interface{}{"value": "work@test.com", "primary": true},
+	}})
+	s.seedEntity(Entity{ID: "contacts1", Category: EntityCategoryUser, Attributes: json.RawMessage(attrs)})
+
+	id, err := s.store.IdentifyEntity(s.ctx, map[string]interface{}{"emails[].value": "work@test.com"})
+	s.NoError(err)
+	s.Equal("contacts1", *id)
+
+	_, err = s.store.IdentifyEntity(s.ctx, map[string]interface{}{"emails[].value": "other@test.com"})
+	s.ErrorIs(err, ErrEntityNotFound)
+}
+
+func (s *FileBasedStoreTestSuite) TestIdentifyEntityInScope() {
+	attrs, _ := json.Marshal(map[string]interface{}{"email": "dup@test.com"})
+	s.seedEntity(Entity{ID: "scoped1", Category: EntityCategoryUser, Type: "employee", OUID: "ou1",
+		Attributes: json.RawMessage(attrs)})
+	s.seedEntity(Entity{ID: "scoped2", Category: EntityCategoryUser, Type: "contractor", OUID: "ou2",
+		Attributes: json.RawMessage(attrs)})
+	filters := map[string]interface{}{"email": "dup@test.com"}
+
+	id, err := s.store.IdentifyEntityInScope(s.ctx, filters, identifierScope{OUID: "ou2"})
+	s.NoError(err)
+	s.Equal("scoped2", *id)
+
+	id, err = s.store.IdentifyEntityInScope(s.ctx, filters, identifierScope{Category: "user", Type: "employee"})
+	s.NoError(err)
+	s.Equal("scoped1", *id)
+
+	_, err = s.store.IdentifyEntityInScope(s.ctx, filters, identifierScope{OUID: "ou3"})
+	s.ErrorIs(err, ErrEntityNotFound)
+
+	_, err = s.store.IdentifyEntityInScope(s.ctx, filters, identifierScope{Category: "user"})
+	s.ErrorIs(err, ErrAmbiguousEntity)
+}
+
+func (s *FileBasedStoreTestSuite) TestCountDuplicateAttributeValues() {
+	for _, e := range []struct{ id, ouID, email string }{
+		{"d1", "ou1", "a@test.com"},
+		{"d2", "ou1", "a@test.com"},
+		{"d3", "ou2", "b@test.com"},
+		{"d4", "ou3", "b@test.com"},
+		{"d5", "ou3", "c@test.com"},
+	} {
+		attrs, _ := json.Marshal(map[string]interface{}{"email": e.email})
+		s.seedEntity(Entity{ID: e.id, Category: EntityCategoryUser, Type: "employee", OUID: e.ouID,
+			Attributes: json.RawMessage(attrs)})
+	}
+
+	count, err := s.store.CountDuplicateAttributeValues(s.ctx, "user", "employee", "email", false)
+	s.NoError(err)
+	s.Equal(2, count)
+
+	count, err = s.store.CountDuplicateAttributeValues(s.ctx, "user", "employee", "email", true)
+	s.NoError(err)
+	s.Equal(1, count)
+
+	count, err = s.store.CountDuplicateAttributeValues(s.ctx, "user", "contractor", "email", false)
+	s.NoError(err)
+	s.Equal(0, count)
+}
+
 func (s *FileBasedStoreTestSuite) TestGetEntityListCount_WithCategoryAndFilter() {
 	s.seedEntity(makeTestEntity("u1", "user", "ou1"))
 	s.seedEntity(makeTestEntity("u2", "user", "ou1"))
@@ -212,6 +276,40 @@ func (s *FileBasedStoreTestSuite) TestGetEntityListByOUIDs_WithFilter() {
 	s.Equal("flt1", list[0].ID)
 }
 
+func (s *FileBasedStoreTestSuite) TestGetEntityList_WithApplicationScope() {
+	s.seedEntity(makeTestEntity("scoped1", "user", "ou1"))
+	ctx := WithApplicationScope(s.ctx, "app1")
+
+	count, err := s.store.GetEntityListCount(ctx, "user", nil)
+	s.NoError(err)
+	s.Equal(0, count)
+
+	list, err := s.store.GetEntityList(ctx, "user", 10, 0, nil)
+	s.NoError(err)
+	s.Empty(list)
+
+	list, err = s.store.GetEntityListByOUIDs(ctx, "user", []string{"ou1"}, 10, 0, nil)
+	s.NoError(err)
+	s.Empty(list)
+}
+
+func (s *FileBasedStoreTestSuite) TestGetEntityList_DeletedScope() {
+	s.seedEntity(makeTestEntity("declared1", "user", "ou1"))
+	ctx := WithDeletedEntities(s.ctx)
+
+	count, err := s.store.GetEntityListCount(ctx, "user", nil)
+	s.NoError(err)
+	s.Equal(0, count)
+
+	list, err := s.store.GetEntityList(ctx, "user", 10, 0, nil)
+	s.NoError(err)
+	s.Empty(list)
+
+	count, err = s.store.GetEntityListCountByOUIDs(ctx, "user", []string{"ou1"}, nil)
+	s.NoError(err)
+	s.Equal(0, count)
+}
+
 func (s *FileBasedStoreTestSuite) TestGetGroupCountForEntity() {
 	count, err := s.store.GetGroupCountForEntity(s.ctx, "any-id")
 	s.NoError(err)
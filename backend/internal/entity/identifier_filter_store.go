@@ -0,0 +1,473 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package entity
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+	sysContext "github.com/thunder-id/thunderid/internal/system/context"
+	"github.com/thunder-id/thunderid/internal/system/cryptolab/hash"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	sysutils "github.com/thunder-id/thunderid/internal/system/utils"
+)
+
+const (
+	// identifierFilterPageSize is the number of entities read per page while the filter is rebuilt.
+	identifierFilterPageSize = 500
+	// identifierFilterKeySeparator separates the attribute name from the value in filter entries.
+	identifierFilterKeySeparator = "\x00"
+	// lookupLatencyWeight is the inverse weight of a new lookup in the average lookup latency.
+	lookupLatencyWeight = 8
+)
+
+// identifierFilterCategories are the entity categories whose identifiers are added to the filter.
+var identifierFilterCategories = []EntityCategory{EntityCategoryUser, EntityCategoryApp, EntityCategoryAgent}
+
+// identifierFilterStore wraps an entityStoreInterface with a bloom filter of the indexed attribute
+// values of all entities. Identify lookups on a value the filter does not contain are answered as not
+// found without reaching the wrapped store, and are delayed to take as long as a not found lookup
+// answered by the store so that the response time does not reveal the filter.
+//
+// The filter only grows between rebuilds: values removed by updates and deletes are dropped at the next
+// rebuild and until then only cost a lookup in the wrapped store. The filter covers the deployment
+// scope; lookups in a tenant scope always reach the wrapped store.
+type identifierFilterStore struct {
+	store              entityStoreInterface
+	expectedEntries    int
+	falsePositiveRate  float64
+	maxResponsePadding time.Duration
+
+	mu           sync.RWMutex
+	indexedAttrs map[string]bool
+	filter       *identifierBloomFilter
+	pending      *identifierBloomFilter
+	generation   uint64
+
+	avgLookupNanos atomic.Int64
+	rebuildCh      chan struct{}
+	sleep          func(ctx context.Context, d time.Duration)
+	logger         *log.Logger
+}
+
+// newIdentifierFilterStore creates a filter backed wrapper around the given store. The filter is empty
+// and lookups reach the wrapped store until the first rebuild completes.
+func newIdentifierFilterStore(store entityStoreInterface,
+	filterConfig config.IdentifierFilterConfig) *identifierFilterStore {
+	return &identifierFilterStore{
+		store:              store,
+		expectedEntries:    filterConfig.ExpectedEntries,
+		falsePositiveRate:  filterConfig.FalsePositiveRate,
+		maxResponsePadding: time.Duration(filterConfig.MaxResponsePadding) * time.Millisecond,
+		indexedAttrs:       copyIndexedAttributes(store.GetIndexedAttributes()),
+		rebuildCh:          make(chan struct{}, 1),
+		sleep:              sysutils.SleepContext,
+		logger: log.GetLogger().With(
+			log.String(log.LoggerKeyComponentName, "IdentifierFilterStore")),
+	}
+}
+
+// startRebuilds rebuilds the filter now, at every interval and whenever the filter is invalidated.
+func (s *identifierFilterStore) startRebuilds(interval time.Duration) {
+	s.requestRebuild()
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+			case <-s.rebuildCh:
+			}
+			if err := s.rebuild(context.Background()); err != nil {
+				s.logger.Error("Failed to rebuild the identifier filter", log.Error(err))
+			}
+		}
+	}()
+}
+
+// requestRebuild schedules a rebuild unless one is already scheduled.
+func (s *identifierFilterStore) requestRebuild() {
+	select {
+	case s.rebuildCh <- struct{}{}:
+	default:
+	}
+}
+
+// invalidate drops the filter so that lookups reach the wrapped store, and schedules a rebuild. It is
+// called when entities or indexed attributes change outside of this store.
+func (s *identifierFilterStore) invalidate() {
+	s.mu.Lock()
+	s.invalidateLocked()
+	s.mu.Unlock()
+}
+
+// invalidateLocked drops the filter and schedules a rebuild. The caller must hold the write lock.
+func (s *identifierFilterStore) invalidateLocked() {
+	s.filter = nil
+	s.pending = nil
+	s.generation++
+	s.requestRebuild()
+}
+
+// rebuild builds a new filter from all entities of the wrapped store and replaces the current one.
+// Values added while the rebuild reads the store are added to the new filter as well. The new filter is
+// discarded if the filter is invalidated during the rebuild.
+func (s *identifierFilterStore) rebuild(ctx context.Context) error {
+	next := newIdentifierBloomFilter(s.expectedEntries, s.falsePositiveRate)
+
+	s.mu.Lock()
+	indexedAttrs := s.indexedAttrs
+	s.pending = next
+	generation := s.generation
+	s.mu.Unlock()
+
+	count := 0
+	for _, category := range identifierFilterCategories {
+		for offset := 0; ; offset += identifierFilterPageSize {
+			entities, err := s.store.GetEntityList(ctx, string(category), identifierFilterPageSize, offset, nil)
+			if err != nil {
+				s.mu.Lock()
+				if s.pending == next {
+					s.pending = nil
+				}
+				s.mu.Unlock()
+				return err
+			}
+
+			s.mu.Lock()
+			for i := range entities {
+				addIdentifiers(next, indexedAttrs, entities[i].Attributes, entities[i].SystemAttributes)
+			}
+			s.mu.Unlock()
+			count += len(entities)
+
+			if len(entities) < identifierFilterPageSize {
+				break
+			}
+		}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.generation != generation {
+		s.logger.Debug("Discarded the rebuilt identifier filter as it was invalidated during the rebuild")
+		return nil
+	}
+	s.filter = next
+	s.pending = nil
+	s.logger.Debug("Rebuilt the identifier filter", log.Int("entityCount", count))
+	return nil
+}
+
+func (s *identifierFilterStore) CreateEntity(ctx context.Context, entity Entity,
+	credentials json.RawMessage, systemCredentials json.RawMessage) error {
+	if err := s.store.CreateEntity(ctx, entity, credentials, systemCredentials); err != nil {
+		return err
+	}
+	s.addEntityIdentifiers(ctx, entity.Attributes, entity.SystemAttributes)
+	return nil
+}
+
+func (s *identifierFilterStore) UpdateEntity(ctx context.Context, entity *Entity) error {
+	if err := s.store.UpdateEntity(ctx, entity); err != nil {
+		return err
+	}
+	s.addEntityIdentifiers(ctx, entity.Attributes, entity.SystemAttributes)
+	return nil
+}
+
+func (s *identifierFilterStore) UpdateAttributes(ctx context.Context,
+	entityID string, attributes json.RawMessage) error {
+	if err := s.store.UpdateAttributes(ctx, entityID, attributes); err != nil {
+		return err
+	}
+	s.addEntityIdentifiers(ctx, attributes, nil)
+	return nil
+}
+
+func (s *identifierFilterStore) UpdateSystemAttributes(ctx context.Context,
+	entityID string, attrs json.RawMessage) error {
+	if err := s.store.UpdateSystemAttributes(ctx, entityID, attrs); err != nil {
+		return err
+	}
+	s.addEntityIdentifiers(ctx, nil, attrs)
+	return nil
+}
+
+// DeleteEntity deletes the entity from the wrapped store. Its values stay in the filter until the next
+// rebuild.
+func (s *identifierFilterStore) DeleteEntity(ctx context.Context, id string) error {
+	return s.store.DeleteEntity(ctx, id)
+}
+
+// IdentifyEntity answers lookups on a value absent from the filter as not found, and otherwise
+// identifies the entity from the wrapped store.
+func (s *identifierFilterStore) IdentifyEntity(ctx context.Context,
+	filters map[string]interface{}) (*string, error) {
+	started := time.Now()
+	if s.isAbsent(ctx, filters) {
+		s.padResponse(ctx, started)
+		return nil, ErrEntityNotFound
+	}
+
+	entityID, err := s.store.IdentifyEntity(ctx, filters)
+	if err == ErrEntityNotFound {
+		s.observeLookup(time.Since(started))
+	}
+	return entityID, err
+}
+
+// IdentifyEntityInScope answers lookups on a value absent from the filter as not found, and otherwise
+// identifies the entity within the scope from the wrapped store.
+func (s *identifierFilterStore) IdentifyEntityInScope(ctx context.Context,
+	filters map[string]interface{}, scope identifierScope) (*string, error) {
+	started := time.Now()
+	if s.isAbsent(ctx, filters) {
+		s.padResponse(ctx, started)
+		return nil, ErrEntityNotFound
+	}
+
+	entityID, err := s.store.IdentifyEntityInScope(ctx, filters, scope)
+	if err == ErrEntityNotFound {
+		s.observeLookup(time.Since(started))
+	}
+	return entityID, err
+}
+
+// LoadIndexedAttributes loads the attributes into the wrapped store and rebuilds the filter with them.
+func (s *identifierFilterStore) LoadIndexedAttributes(attributes []string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.store.LoadIndexedAttributes(attributes); err != nil {
+		return err
+	}
+	s.indexedAttrs = copyIndexedAttributes(s.store.GetIndexedAttributes())
+	s.invalidateLocked()
+	return nil
+}
+
+// Pass-through methods.
+
+func (s *identifierFilterStore) CountDuplicateAttributeValues(ctx context.Context,
+	category, entityType, attribute string, perOU bool) (int, error) {
+	return s.store.CountDuplicateAttributeValues(ctx, category, entityType, attribute, perOU)
+}
+
+func (s *identifierFilterStore) GetEntity(ctx context.Context, id string) (Entity, error) {
+	return s.store.GetEntity(ctx, id)
+}
+
+func (s *identifierFilterStore) GetEntityWithCredentials(ctx context.Context,
+	id string) (*entityWithCredentials, error) {
+	return s.store.GetEntityWithCredentials(ctx, id)
+}
+
+func (s *identifierFilterStore) UpdateCredentials(ctx context.Context,
+	entityID string, creds json.RawMessage) error {
+	return s.store.UpdateCredentials(ctx, entityID, creds)
+}
+
+func (s *identifierFilterStore) UpdateSystemCredentials(ctx context.Context,
+	entityID string, creds json.RawMessage) error {
+	return s.store.UpdateSystemCredentials(ctx, entityID, creds)
+}
+
+func (s *identifierFilterStore) SearchEntities(ctx context.Context,
+	filters map[string]interface{}) ([]Entity, error) {
+	return s.store.SearchEntities(ctx, filters)
+}
+
+func (s *identifierFilterStore) GetEntityListCount(ctx context.Context,
+	category string, filters map[string]interface{}) (int, error) {
+	return s.store.GetEntityListCount(ctx, category, filters)
+}
+
+func (s *identifierFilterStore) GetEntityList(ctx context.Context,
+	category string, limit, offset int, filters map[string]interface{}) ([]Entity, error) {
+	return s.store.GetEntityList(ctx, category, limit, offset, filters)
+}
+
+func (s *identifierFilterStore) GetEntityListCountByOUIDs(ctx context.Context,
+	category string, ouIDs []string, filters map[string]interface{}) (int, error) {
+	return s.store.GetEntityListCountByOUIDs(ctx, category, ouIDs, filters)
+}
+
+func (s *identifierFilterStore) GetEntityListByOUIDs(ctx context.Context,
+	category string, ouIDs []string, limit, offset int,
+	filters map[string]interface{}) ([]Entity, error) {
+	return s.store.GetEntityListByOUIDs(ctx, category, ouIDs, limit, offset, filters)
+}
+
+func (s *identifierFilterStore) ValidateEntityIDs(ctx context.Context,
+	entityIDs []string) ([]string, error) {
+	return s.store.ValidateEntityIDs(ctx, entityIDs)
+}
+
+func (s *identifierFilterStore) GetEntitiesByIDs(ctx context.Context,
+	entityIDs []string) ([]Entity, error) {
+	return s.store.GetEntitiesByIDs(ctx, entityIDs)
+}
+
+func (s *identifierFilterStore) ValidateEntityIDsInOUs(ctx context.Context,
+	entityIDs []string, ouIDs []string) ([]string, error) {
+	return s.store.ValidateEntityIDsInOUs(ctx, entityIDs, ouIDs)
+}
+
+func (s *identifierFilterStore) GetGroupCountForEntity(ctx context.Context,
+	entityID string) (int, error) {
+	return s.store.GetGroupCountForEntity(ctx, entityID)
+}
+
+func (s *identifierFilterStore) GetEntityGroups(ctx context.Context,
+	entityID string, limit, offset int) ([]EntityGroup, error) {
+	return s.store.GetEntityGroups(ctx, entityID, limit, offset)
+}
+
+func (s *identifierFilterStore) GetTransitiveEntityGroups(ctx context.Context,
+	entityID string) ([]EntityGroup, error) {
+	return s.store.GetTransitiveEntityGroups(ctx, entityID)
+}
+
+func (s *identifierFilterStore) GetCredentialAlgorithmCounts(ctx context.Context,
+	category string) (map[hash.CredAlgorithm]int, error) {
+	return s.store.GetCredentialAlgorithmCounts(ctx, category)
+}
+
+func (s *identifierFilterStore) IsEntityDeclarative(ctx context.Context, id string) (bool, error) {
+	return s.store.IsEntityDeclarative(ctx, id)
+}
+
+func (s *identifierFilterStore) GetIndexedAttributes() map[string]bool {
+	return s.store.GetIndexedAttributes()
+}
+
+// --- Filter helpers ---
+
+// isAbsent reports whether the filter proves that no entity matches the lookup, which holds when the
+// value of any indexed attribute in the lookup is not in the filter.
+func (s *identifierFilterStore) isAbsent(ctx context.Context, filters map[string]interface{}) bool {
+	if sysContext.GetTenantID(ctx) != "" {
+		return false
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if s.filter == nil {
+		return false
+	}
+
+	for key, value := range filters {
+		strValue, ok := value.(string)
+		if !ok || !s.indexedAttrs[key] {
+			continue
+		}
+		if !s.filter.mayContain(identifierFilterKey(key, strValue)) {
+			return true
+		}
+	}
+	return false
+}
+
+// addEntityIdentifiers adds the indexed attribute values of an entity written in the deployment scope
+// to the filter and to the filter being rebuilt.
+func (s *identifierFilterStore) addEntityIdentifiers(ctx context.Context,
+	attributes json.RawMessage, systemAttributes json.RawMessage) {
+	if sysContext.GetTenantID(ctx) != "" {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, filter := range []*identifierBloomFilter{s.filter, s.pending} {
+		if filter != nil {
+			addIdentifiers(filter, s.indexedAttrs, attributes, systemAttributes)
+		}
+	}
+}
+
+// observeLookup folds the duration of a not found lookup answered by the wrapped store into the
+// average that short-circuited lookups are padded to.
+func (s *identifierFilterStore) observeLookup(d time.Duration) {
+	for {
+		current := s.avgLookupNanos.Load()
+		next := int64(d)
+		if current != 0 {
+			next = current + (int64(d)-current)/lookupLatencyWeight
+		}
+		if s.avgLookupNanos.CompareAndSwap(current, next) {
+			return
+		}
+	}
+}
+
+// padResponse delays a short-circuited lookup until it has taken as long as the average not found
+// lookup answered by the wrapped store, capped at the maximum padding.
+func (s *identifierFilterStore) padResponse(ctx context.Context, started time.Time) {
+	target := time.Duration(s.avgLookupNanos.Load())
+	if target > s.maxResponsePadding {
+		target = s.maxResponsePadding
+	}
+	if wait := target - time.Since(started); wait > 0 {
+		s.sleep(ctx, wait)
+	}
+}
+
+// addIdentifiers adds the values of the indexed attributes in the given attributes to the filter.
+// Attributes that are not a JSON object hold no indexed values and are skipped.
+func addIdentifiers(filter *identifierBloomFilter, indexedAttrs map[string]bool,
+	attributeSets ...json.RawMessage) {
+	for _, attributes := range attributeSets {
+		if len(attributes) == 0 {
+			continue
+		}
+		var attrMap map[string]interface{}
+		if err := json.Unmarshal(attributes, &attrMap); err != nil {
+			continue
+		}
+		for name, value := range attrMap {
+			if !indexedAttrs[name] {
+				continue
+			}
+			if strValue := attrValueToString(value); strValue != "" {
+				filter.add(identifierFilterKey(name, strValue))
+			}
+		}
+	}
+}
+
+// copyIndexedAttributes returns a copy of the indexed attribute set.
+func copyIndexedAttributes(attributes map[string]bool) map[string]bool {
+	copied := make(map[string]bool, len(attributes))
+	for name, indexed := range attributes {
+		copied[name] = indexed
+	}
+	return copied
+}
+
+// identifierFilterKey returns the filter entry of an attribute value.
+func identifierFilterKey(name, value string) string {
+	return strings.Join([]string{name, value}, identifierFilterKeySeparator)
+}
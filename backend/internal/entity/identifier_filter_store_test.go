@@ -0,0 +1,260 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package entity
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+	sysContext "github.com/thunder-id/thunderid/internal/system/context"
+)
+
+// IdentifierFilterStoreTestSuite tests the identifierFilterStore.
+type IdentifierFilterStoreTestSuite struct {
+	suite.Suite
+	mockStore   *entityStoreInterfaceMock
+	filterStore *identifierFilterStore
+	sleeps      []time.Duration
+}
+
+func TestIdentifierFilterStoreTestSuite(t *testing.T) {
+	suite.Run(t, new(IdentifierFilterStoreTestSuite))
+}
+
+func (s *IdentifierFilterStoreTestSuite) SetupTest() {
+	s.mockStore = newEntityStoreInterfaceMock(s.T())
+	s.mockStore.EXPECT().GetIndexedAttributes().Return(map[string]bool{"email": true}).Once()
+	s.filterStore = newIdentifierFilterStore(s.mockStore, config.IdentifierFilterConfig{
+		Enabled:            true,
+		ExpectedEntries:    100,
+		FalsePositiveRate:  0.01,
+		RebuildInterval:    300,
+		MaxResponsePadding: 200,
+	})
+	s.sleeps = nil
+	s.filterStore.sleep = func(_ context.Context, d time.Duration) {
+		s.sleeps = append(s.sleeps, d)
+	}
+}
+
+func (s *IdentifierFilterStoreTestSuite) expectEntities(category EntityCategory, entities []Entity) {
+	s.mockStore.EXPECT().GetEntityList(mock.Anything, string(category), identifierFilterPageSize, 0,
+		map[string]interface{}(nil)).Return(entities, nil).Once()
+}
+
+func (s *IdentifierFilterStoreTestSuite) rebuildWith(entities []Entity) {
+	s.expectEntities(EntityCategoryUser, entities)
+	s.expectEntities(EntityCategoryApp, nil)
+	s.expectEntities(EntityCategoryAgent, nil)
+	s.Require().NoError(s.filterStore.rebuild(context.Background()))
+}
+
+func (s *IdentifierFilterStoreTestSuite) TestIdentifyEntity_ReachesStoreBeforeRebuild() {
+	entityID := "user-1"
+	filters := map[string]interface{}{"email": "alice@example.com"}
+	s.mockStore.EXPECT().IdentifyEntity(mock.Anything, filters).Return(&entityID, nil).Once()
+
+	id, err := s.filterStore.IdentifyEntity(context.Background(), filters)
+
+	s.NoError(err)
+	s.Equal(entityID, *id)
+}
+
+func (s *IdentifierFilterStoreTestSuite) TestIdentifyEntity_ShortCircuitsAbsentValue() {
+	s.rebuildWith([]Entity{{ID: "user-1", Attributes: json.RawMessage(`{"email":"alice@example.com"}`)}})
+	s.filterStore.avgLookupNanos.Store(int64(50 * time.Millisecond))
+
+	id, err := s.filterStore.IdentifyEntity(context.Background(),
+		map[string]interface{}{"email": "mallory@example.com"})
+
+	s.Nil(id)
+	s.ErrorIs(err, ErrEntityNotFound)
+	s.Require().Len(s.sleeps, 1)
+	s.LessOrEqual(s.sleeps[0], 50*time.Millisecond)
+	s.Greater(s.sleeps[0], 40*time.Millisecond)
+	s.mockStore.AssertNotCalled(s.T(), "IdentifyEntity", mock.Anything, mock.Anything)
+}
+
+func (s *IdentifierFilterStoreTestSuite) TestIdentifyEntity_PaddingIsCapped() {
+	s.rebuildWith(nil)
+	s.filterStore.avgLookupNanos.Store(int64(time.Second))
+
+	_, err := s.filterStore.IdentifyEntity(context.Background(),
+		map[string]interface{}{"email": "mallory@example.com"})
+
+	s.ErrorIs(err, ErrEntityNotFound)
+	s.Require().Len(s.sleeps, 1)
+	s.LessOrEqual(s.sleeps[0], 200*time.Millisecond)
+}
+
+func (s *IdentifierFilterStoreTestSuite) TestIdentifyEntity_ReachesStoreForKnownValue() {
+	s.rebuildWith([]Entity{{ID: "user-1", Attributes: json.RawMessage(`{"email":"alice@example.com"}`)}})
+	entityID := "user-1"
+	filters := map[string]interface{}{"email": "alice@example.com"}
+	s.mockStore.EXPECT().IdentifyEntity(mock.Anything, filters).Return(&entityID, nil).Once()
+
+	id, err := s.filterStore.IdentifyEntity(context.Background(), filters)
+
+	s.NoError(err)
+	s.Equal(entityID, *id)
+	s.Empty(s.sleeps)
+}
+
+func (s *IdentifierFilterStoreTestSuite) TestIdentifyEntity_ReachesStoreForNonIndexedOrTenantLookups() {
+	s.rebuildWith(nil)
+	nonIndexed := map[string]interface{}{"nickname": "mallory"}
+	s.mockStore.EXPECT().IdentifyEntity(mock.Anything, nonIndexed).Return(nil, ErrEntityNotFound).Once()
+	indexed := map[string]interface{}{"email": "mallory@example.com"}
+	s.mockStore.EXPECT().IdentifyEntity(mock.Anything, indexed).Return(nil, ErrEntityNotFound).Once()
+
+	_, err := s.filterStore.IdentifyEntity(context.Background(), nonIndexed)
+	s.ErrorIs(err, ErrEntityNotFound)
+	_, err = s.filterStore.IdentifyEntity(sysContext.WithTenantID(context.Background(), "tenant-1"), indexed)
+	s.ErrorIs(err, ErrEntityNotFound)
+
+	s.Empty(s.sleeps)
+	s.Positive(s.filterStore.avgLookupNanos.Load())
+}
+
+func (s *IdentifierFilterStoreTestSuite) TestCreateEntity_AddsIdentifiers() {
+	s.rebuildWith(nil)
+	entity := Entity{ID: "user-2", Attributes: json.RawMessage(`{"email":"bob@example.com"}`)}
+	s.mockStore.EXPECT().CreateEntity(mock.Anything, entity, json.RawMessage(nil), json.RawMessage(nil)).
+		Return(nil).Once()
+	s.Require().NoError(s.filterStore.CreateEntity(context.Background(), entity, nil, nil))
+
+	entityID := "user-2"
+	filters := map[string]interface{}{"email": "bob@example.com"}
+	s.mockStore.EXPECT().IdentifyEntity(mock.Anything, filters).Return(&entityID, nil).Once()
+
+	id, err := s.filterStore.IdentifyEntity(context.Background(), filters)
+
+	s.NoError(err)
+	s.Equal(entityID, *id)
+}
+
+func (s *IdentifierFilterStoreTestSuite) TestCreateEntity_StoreErrorDoesNotAddIdentifiers() {
+	s.rebuildWith(nil)
+	entity := Entity{ID: "user-2", Attributes: json.RawMessage(`{"email":"bob@example.com"}`)}
+	s.mockStore.EXPECT().CreateEntity(mock.Anything, entity, json.RawMessage(nil), json.RawMessage(nil)).
+		Return(errors.New("db error")).Once()
+
+	s.Error(s.filterStore.CreateEntity(context.Background(), entity, nil, nil))
+	s.False(s.filterStore.filter.mayContain(identifierFilterKey("email", "bob@example.com")))
+}
+
+func (s *IdentifierFilterStoreTestSuite) TestUpdateAttributes_AddsIdentifiersToPendingFilter() {
+	s.mockStore.EXPECT().UpdateAttributes(mock.Anything, "user-1", mock.Anything).Return(nil).Once()
+	s.expectEntities(EntityCategoryUser, nil)
+	s.mockStore.EXPECT().GetEntityList(mock.Anything, string(EntityCategoryApp), identifierFilterPageSize, 0,
+		map[string]interface{}(nil)).RunAndReturn(
+		func(ctx context.Context, _ string, _, _ int, _ map[string]interface{}) ([]Entity, error) {
+			s.NoError(s.filterStore.UpdateAttributes(ctx, "user-1",
+				json.RawMessage(`{"email":"carol@example.com"}`)))
+			return nil, nil
+		}).Once()
+	s.expectEntities(EntityCategoryAgent, nil)
+
+	s.Require().NoError(s.filterStore.rebuild(context.Background()))
+
+	s.True(s.filterStore.filter.mayContain(identifierFilterKey("email", "carol@example.com")))
+}
+
+func (s *IdentifierFilterStoreTestSuite) TestRebuild_PagesThroughEntities() {
+	page := make([]Entity, identifierFilterPageSize)
+	for i := range page {
+		page[i] = Entity{Attributes: json.RawMessage(`{"email":"user@example.com"}`)}
+	}
+	s.expectEntities(EntityCategoryUser, page)
+	s.mockStore.EXPECT().GetEntityList(mock.Anything, string(EntityCategoryUser), identifierFilterPageSize,
+		identifierFilterPageSize, map[string]interface{}(nil)).
+		Return([]Entity{{SystemAttributes: json.RawMessage(`{"email":"system@example.com"}`)}}, nil).Once()
+	s.expectEntities(EntityCategoryApp, nil)
+	s.expectEntities(EntityCategoryAgent, nil)
+
+	s.Require().NoError(s.filterStore.rebuild(context.Background()))
+
+	s.True(s.filterStore.filter.mayContain(identifierFilterKey("email", "user@example.com")))
+	s.True(s.filterStore.filter.mayContain(identifierFilterKey("email", "system@example.com")))
+}
+
+func (s *IdentifierFilterStoreTestSuite) TestRebuild_StoreErrorKeepsFilter() {
+	s.rebuildWith([]Entity{{Attributes: json.RawMessage(`{"email":"alice@example.com"}`)}})
+	current := s.filterStore.filter
+	s.mockStore.EXPECT().GetEntityList(mock.Anything, string(EntityCategoryUser), identifierFilterPageSize, 0,
+		map[string]interface{}(nil)).Return(nil, errors.New("db error")).Once()
+
+	s.Error(s.filterStore.rebuild(context.Background()))
+
+	s.Same(current, s.filterStore.filter)
+	s.Nil(s.filterStore.pending)
+}
+
+func (s *IdentifierFilterStoreTestSuite) TestRebuild_DiscardedWhenInvalidated() {
+	s.expectEntities(EntityCategoryUser, nil)
+	s.mockStore.EXPECT().GetEntityList(mock.Anything, string(EntityCategoryApp), identifierFilterPageSize, 0,
+		map[string]interface{}(nil)).RunAndReturn(
+		func(context.Context, string, int, int, map[string]interface{}) ([]Entity, error) {
+			s.filterStore.invalidate()
+			return nil, nil
+		}).Once()
+	s.expectEntities(EntityCategoryAgent, nil)
+
+	s.Require().NoError(s.filterStore.rebuild(context.Background()))
+
+	s.Nil(s.filterStore.filter)
+	s.Len(s.filterStore.rebuildCh, 1)
+}
+
+func (s *IdentifierFilterStoreTestSuite) TestLoadIndexedAttributes_InvalidatesFilter() {
+	s.rebuildWith(nil)
+	s.mockStore.EXPECT().LoadIndexedAttributes([]string{"username"}).Return(nil).Once()
+	s.mockStore.EXPECT().GetIndexedAttributes().Return(map[string]bool{"email": true, "username": true}).Once()
+
+	s.Require().NoError(s.filterStore.LoadIndexedAttributes([]string{"username"}))
+
+	s.Nil(s.filterStore.filter)
+	s.True(s.filterStore.indexedAttrs["username"])
+	s.Len(s.filterStore.rebuildCh, 1)
+}
+
+func (s *IdentifierFilterStoreTestSuite) TestObserveLookup_TracksAverage() {
+	s.filterStore.observeLookup(80 * time.Millisecond)
+	s.Equal(int64(80*time.Millisecond), s.filterStore.avgLookupNanos.Load())
+
+	s.filterStore.observeLookup(160 * time.Millisecond)
+	s.Equal(int64(90*time.Millisecond), s.filterStore.avgLookupNanos.Load())
+}
+
+func (s *IdentifierFilterStoreTestSuite) TestLoadDeclarativeResources_InvalidatesFilter() {
+	s.rebuildWith(nil)
+
+	err := loadDeclarativeResources(s.filterStore, nil, DeclarativeLoaderConfig{Category: EntityCategoryUser})
+
+	s.NoError(err)
+	s.Nil(s.filterStore.filter)
+	s.Len(s.filterStore.rebuildCh, 1)
+}
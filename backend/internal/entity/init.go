@@ -19,9 +19,15 @@
 package entity
 
 import (
+	"context"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/denylist"
 	"github.com/thunder-id/thunderid/internal/entitytype"
 	"github.com/thunder-id/thunderid/internal/ou"
+	"github.com/thunder-id/thunderid/internal/quota"
 	"github.com/thunder-id/thunderid/internal/system/cache"
+	"github.com/thunder-id/thunderid/internal/system/config"
 	"github.com/thunder-id/thunderid/internal/system/cryptolab/hash"
 	"github.com/thunder-id/thunderid/internal/system/transaction"
 )
@@ -35,17 +41,53 @@ func Initialize(
 	hashService hash.HashServiceInterface,
 	entityTypeService entitytype.EntityTypeServiceInterface,
 	ouService ou.OrganizationUnitServiceInterface,
+	denyListService denylist.DenyListServiceInterface,
+	quotaService quota.QuotaServiceInterface,
 ) (EntityServiceInterface, error) {
 	store, transactioner, err := initializeStore(cacheManager)
 	if err != nil {
 		return nil, err
 	}
 
-	svc := newEntityService(store, hashService, entityTypeService, ouService, transactioner)
+	svc := newEntityService(store, hashService, entityTypeService, ouService, denyListService, quotaService,
+		transactioner)
+	if config.GetServerRuntime().Config.EnumerationResistance.Enabled {
+		if entitySvc, ok := svc.(*entityService); ok {
+			entitySvc.dummyVerifier = newDummyCredentialVerifier(hashService)
+		}
+	}
+	if quotaService != nil {
+		quotaService.RegisterUsageCounter(quota.ResourceTypeUsers, newUsageCounter(store, EntityCategoryUser))
+		quotaService.RegisterUsageCounter(quota.ResourceTypeApplications, newUsageCounter(store, EntityCategoryApp))
+	}
+	if entityTypeService != nil {
+		entityTypeService.RegisterDuplicateValueCounter(newDuplicateValueCounter(store))
+	}
 	return svc, nil
 }
 
-// initializeStore always creates a composite store (DB + in-memory file store).
+// newDuplicateValueCounter creates a counter of the attribute values shared by entities of an entity type,
+// used to validate uniqueness scope changes of entity types.
+func newDuplicateValueCounter(store entityStoreInterface) entitytype.DuplicateValueCounter {
+	return entitytype.DuplicateValueCounterFunc(func(ctx context.Context, category entitytype.TypeCategory,
+		entityType, attribute string, perOU bool) (int, error) {
+		return store.CountDuplicateAttributeValues(ctx, string(category), entityType, attribute, perOU)
+	})
+}
+
+// newUsageCounter creates a quota usage counter of the entities of a category. The tenant scope counts
+// every entity of the category.
+func newUsageCounter(store entityStoreInterface, category EntityCategory) quota.UsageCounter {
+	return quota.UsageCounterFunc(func(ctx context.Context, scope string) (int, error) {
+		if scope == quota.TenantScope {
+			return store.GetEntityListCount(ctx, string(category), nil)
+		}
+		return store.GetEntityListCountByOUIDs(ctx, string(category), []string{scope}, nil)
+	})
+}
+
+// initializeStore always creates a composite store (DB + in-memory file store). When the identifier
+// filter is enabled, the composite store is wrapped with it.
 func initializeStore(cacheManager cache.CacheManagerInterface) (
 	entityStoreInterface, transaction.Transactioner, error) {
 	fileStore := newEntityFileBasedStore()
@@ -55,5 +97,13 @@ func initializeStore(cacheManager cache.CacheManagerInterface) (
 	}
 	entityByIDCache := cache.GetCache[*Entity](cacheManager, "EntityByIDCache")
 	cacheBackedEntityStore := newCacheBackedEntityStore(dbStore, entityByIDCache)
-	return newEntityCompositeStore(fileStore, cacheBackedEntityStore), transactioner, nil
+	compositeStore := newEntityCompositeStore(fileStore, cacheBackedEntityStore)
+
+	filterConfig := config.GetServerRuntime().Config.User.IdentifierFilter
+	if !filterConfig.Enabled {
+		return compositeStore, transactioner, nil
+	}
+	filterStore := newIdentifierFilterStore(compositeStore, filterConfig)
+	filterStore.startRebuilds(time.Duration(filterConfig.RebuildInterval) * time.Second)
+	return filterStore, transactioner, nil
 }
@@ -21,6 +21,7 @@ package entity
 
 import (
 	"encoding/json"
+	"time"
 
 	"github.com/thunder-id/thunderid/internal/system/cryptolab/hash"
 )
@@ -48,6 +49,15 @@ type EntityState string
 const (
 	// EntityStateActive represents an active entity.
 	EntityStateActive EntityState = "ACTIVE"
+	// EntityStatePendingVerification represents an entity that is awaiting verification of its email
+	// address before it can be used.
+	EntityStatePendingVerification EntityState = "PENDING_VERIFICATION"
+	// EntityStatePendingDeletion represents an entity whose deletion is scheduled and that cannot sign in
+	// until the deletion is cancelled.
+	EntityStatePendingDeletion EntityState = "PENDING_DELETION"
+	// EntityStateDeleted represents an entity that was soft-deleted and is kept until it is restored or
+	// purged.
+	EntityStateDeleted EntityState = "DELETED"
 )
 
 // String returns the string representation of the entity state.
@@ -106,6 +116,39 @@ type StoredCredential struct {
 	Value             string              `json:"value"`
 }
 
+// CredentialFormatCount reports how many stored credentials use a storage format and how the format is
+// treated during verification.
+type CredentialFormatCount struct {
+	Algorithm  hash.CredAlgorithm
+	Count      int
+	Current    bool
+	Supported  bool
+	SunsetDate time.Time
+	Blocked    bool
+}
+
+// credentialUpgrade identifies a verified credential whose stored format is due for upgrade.
+type credentialUpgrade struct {
+	credentialType string
+	storedValue    string
+	plaintext      string
+}
+
+// identifierScope restricts an identifier lookup to the entities of a category, organization unit or entity
+// type. Empty fields do not restrict the lookup.
+type identifierScope struct {
+	Category string
+	OUID     string
+	Type     string
+}
+
+// inScope reports whether the entity belongs to the given scope.
+func (e Entity) inScope(scope identifierScope) bool {
+	return (scope.Category == "" || string(e.Category) == scope.Category) &&
+		(scope.OUID == "" || e.OUID == scope.OUID) &&
+		(scope.Type == "" || e.Type == scope.Type)
+}
+
 // DeclarativeLoaderConfig configures declarative resource loading for a specific entity category.
 // Consumer packages (e.g., user) provide parser and validator callbacks for type-specific processing.
 type DeclarativeLoaderConfig struct {
@@ -23,10 +23,14 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"maps"
+	"slices"
 	"strings"
 
+	"github.com/thunder-id/thunderid/internal/denylist"
 	"github.com/thunder-id/thunderid/internal/entitytype"
 	"github.com/thunder-id/thunderid/internal/ou"
+	"github.com/thunder-id/thunderid/internal/quota"
 	"github.com/thunder-id/thunderid/internal/system/cryptolab/hash"
 	"github.com/thunder-id/thunderid/internal/system/log"
 	"github.com/thunder-id/thunderid/internal/system/transaction"
@@ -41,6 +45,7 @@ type EntityServiceInterface interface {
 	GetEntity(ctx context.Context, entityID string) (*Entity, error)
 	GetCredentialsByType(ctx context.Context, entityID string,
 		credType string) ([]StoredCredential, error)
+	GetCredentialTypes(ctx context.Context, entityID string) ([]string, error)
 	UpdateEntity(ctx context.Context, entityID string, entity *Entity) (*Entity, error)
 	DeleteEntity(ctx context.Context, entityID string) error
 
@@ -51,6 +56,13 @@ type EntityServiceInterface interface {
 		plaintextUpdates json.RawMessage) error
 	UpdateSystemCredentials(ctx context.Context, entityID string,
 		plaintextUpdates json.RawMessage) error
+	DeleteCredentials(ctx context.Context, entityID string, credentialTypes []string) error
+
+	PrehashCredentials(ctx context.Context, entities []*Entity) (context.Context, error)
+
+	// Credential policy
+	EvaluateCredentialPolicy(ctx context.Context, entityID string) (*entitytype.CredentialPolicyEvaluation, error)
+	GetCredentialFormatCounts(ctx context.Context, category EntityCategory) ([]CredentialFormatCount, error)
 
 	// Identification
 	IdentifyEntity(ctx context.Context, filters map[string]interface{}) (*string, error)
@@ -88,6 +100,7 @@ type EntityServiceInterface interface {
 
 	// Config
 	LoadIndexedAttributes(attributes []string) error
+	GetIndexedAttributes() []string
 }
 
 // entityService is the default implementation of EntityServiceInterface.
@@ -96,7 +109,10 @@ type entityService struct {
 	hashService       hash.HashServiceInterface
 	entityTypeService entitytype.EntityTypeServiceInterface
 	ouService         ou.OrganizationUnitServiceInterface
+	denyListService   denylist.DenyListServiceInterface
+	quotaService      quota.QuotaServiceInterface
 	transactioner     transaction.Transactioner
+	dummyVerifier     *dummyCredentialVerifier
 	logger            *log.Logger
 }
 
@@ -112,6 +128,8 @@ func newEntityService(
 	hashService hash.HashServiceInterface,
 	entityTypeService entitytype.EntityTypeServiceInterface,
 	ouService ou.OrganizationUnitServiceInterface,
+	denyListService denylist.DenyListServiceInterface,
+	quotaService quota.QuotaServiceInterface,
 	transactioner transaction.Transactioner,
 ) EntityServiceInterface {
 	return &entityService{
@@ -119,6 +137,8 @@ func newEntityService(
 		hashService:       hashService,
 		entityTypeService: entityTypeService,
 		ouService:         ouService,
+		denyListService:   denyListService,
+		quotaService:      quotaService,
 		transactioner:     transactioner,
 		logger:            log.GetLogger().With(log.String(log.LoggerKeyComponentName, "EntityService")),
 	}
@@ -142,7 +162,14 @@ func (s *entityService) CreateEntity(ctx context.Context, entity *Entity,
 	s.logger.Debug("Creating entity", log.MaskedString("id", entity.ID))
 
 	// Validate entity attributes and uniqueness via schema.
-	if err := s.validateEntityType(ctx, entity.Category, entity.Type, entity.Attributes, "", false); err != nil {
+	if err := s.validateEntityType(ctx, entity.Category, entity.Type, entity.OUID, entity.Attributes, "",
+		false); err != nil {
+		return nil, err
+	}
+	if err := s.checkDenyLists(ctx, entity.Category, entity.Type, entity.Attributes); err != nil {
+		return nil, err
+	}
+	if err := s.checkQuota(ctx, entity.Category, entity.OUID); err != nil {
 		return nil, err
 	}
 
@@ -153,11 +180,16 @@ func (s *entityService) CreateEntity(ctx context.Context, entity *Entity,
 	}
 
 	// Hash plaintext system credentials.
-	hashedSysCreds, err := s.hashPlaintextCredentials(systemCredentials)
+	hashedSysCreds, err := s.hashPlaintextCredentials(ctx, systemCredentials)
 	if err != nil {
 		return nil, fmt.Errorf("failed to hash system credentials: %w", err)
 	}
 
+	if err := s.checkEnrollableCredentialPolicy(ctx, entity.Category, entity.Type,
+		schemaCredsJSON, hashedSysCreds); err != nil {
+		return nil, err
+	}
+
 	var created Entity
 	err = s.transactioner.Transact(ctx, func(txCtx context.Context) error {
 		if err := s.store.CreateEntity(txCtx, *entity, schemaCredsJSON, hashedSysCreds); err != nil {
@@ -218,6 +250,38 @@ func (s *entityService) GetCredentialsByType(
 	return creds, nil
 }
 
+// GetCredentialTypes returns the sorted credential types for which the entity holds at least one
+// credential, across both schema and system credentials. Credential values are never decoded.
+func (s *entityService) GetCredentialTypes(ctx context.Context, entityID string) ([]string, error) {
+	result, err := s.store.GetEntityWithCredentials(ctx, entityID)
+	if err != nil {
+		return nil, err
+	}
+	return credentialTypesOf(result.SchemaCredentials, result.SystemCredentials)
+}
+
+// credentialTypesOf returns the sorted credential types with at least one credential in the given
+// credential maps.
+func credentialTypesOf(credentials ...json.RawMessage) ([]string, error) {
+	types := make([]string, 0)
+	for _, raw := range credentials {
+		if len(raw) == 0 {
+			continue
+		}
+		var credMap map[string][]json.RawMessage
+		if err := json.Unmarshal(raw, &credMap); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal credentials: %w", err)
+		}
+		for credType, creds := range credMap {
+			if len(creds) > 0 && !slices.Contains(types, credType) {
+				types = append(types, credType)
+			}
+		}
+	}
+	slices.Sort(types)
+	return types, nil
+}
+
 // UpdateEntity updates an entity.
 // Uses a transaction to ensure the entity update and identifier re-sync are atomic.
 func (s *entityService) UpdateEntity(ctx context.Context, entityID string, entity *Entity) (*Entity, error) {
@@ -227,7 +291,11 @@ func (s *entityService) UpdateEntity(ctx context.Context, entityID string, entit
 	s.logger.Debug("Updating entity", log.MaskedString("id", entityID))
 
 	// Validate entity attributes and uniqueness via schema (excludes self for uniqueness).
-	if err := s.validateEntityType(ctx, entity.Category, entity.Type, entity.Attributes, entityID, true); err != nil {
+	if err := s.validateEntityType(ctx, entity.Category, entity.Type, entity.OUID, entity.Attributes, entityID,
+		true); err != nil {
+		return nil, err
+	}
+	if err := s.checkDenyLists(ctx, entity.Category, entity.Type, entity.Attributes); err != nil {
 		return nil, err
 	}
 
@@ -237,6 +305,9 @@ func (s *entityService) UpdateEntity(ctx context.Context, entityID string, entit
 	if err != nil {
 		return nil, fmt.Errorf("failed to extract schema credentials: %w", err)
 	}
+	if err := s.checkTypeChangeCredentialPolicy(ctx, entityID, entity, schemaCredsJSON); err != nil {
+		return nil, err
+	}
 
 	var updated Entity
 	err = s.transactioner.Transact(ctx, func(txCtx context.Context) error {
@@ -295,7 +366,11 @@ func (s *entityService) UpdateAttributes(ctx context.Context, entityID string, a
 	}
 
 	// Validate attribute uniqueness via schema (excludes self, credentials not required for updates).
-	if err := s.validateEntityType(ctx, existing.Category, existing.Type, attributes, entityID, true); err != nil {
+	if err := s.validateEntityType(ctx, existing.Category, existing.Type, existing.OUID, attributes, entityID,
+		true); err != nil {
+		return err
+	}
+	if err := s.checkDenyLists(ctx, existing.Category, existing.Type, attributes); err != nil {
 		return err
 	}
 
@@ -434,6 +509,9 @@ func (s *entityService) AuthenticateEntity(
 
 	entityID, err := s.IdentifyEntity(ctx, identifiers)
 	if err != nil {
+		if errors.Is(err, ErrEntityNotFound) {
+			s.verifyDummyCredentials(credentials)
+		}
 		return nil, err
 	}
 
@@ -457,16 +535,24 @@ func (s *entityService) AuthenticateEntityByID(
 
 	result, err := s.store.GetEntityWithCredentials(ctx, entityID)
 	if err != nil {
+		if errors.Is(err, ErrEntityNotFound) {
+			s.verifyDummyCredentials(credentials)
+		}
 		return nil, err
 	}
 
 	if result.Entity.State != EntityStateActive {
+		s.verifyDummyCredentials(credentials)
 		return nil, ErrEntityNotFound
 	}
 
-	if err := s.verifyCredentials(credentials, result.SchemaCredentials, result.SystemCredentials); err != nil {
+	upgrades, err := s.verifyCredentials(credentials, result.SchemaCredentials, result.SystemCredentials)
+	if err != nil {
 		return nil, err
 	}
+	if len(upgrades) > 0 {
+		s.upgradeCredentials(ctx, entityID, upgrades)
+	}
 
 	return &AuthenticateResult{
 		EntityID:       result.Entity.ID,
@@ -477,14 +563,15 @@ func (s *entityService) AuthenticateEntityByID(
 }
 
 // verifyCredentials verifies provided credentials from both schema and system credentials.
+// It returns the verified credentials whose stored format is due for upgrade.
 func (s *entityService) verifyCredentials(credentials map[string]interface{},
-	schemaCredsJSON, systemCredsJSON json.RawMessage) error {
+	schemaCredsJSON, systemCredsJSON json.RawMessage) ([]credentialUpgrade, error) {
 	// Merge both credential columns for verification.
 	storedCreds := make(map[string][]StoredCredential)
 	if len(schemaCredsJSON) > 0 {
 		var schemaCreds map[string][]StoredCredential
 		if err := json.Unmarshal(schemaCredsJSON, &schemaCreds); err != nil {
-			return fmt.Errorf("failed to unmarshal schema credentials: %w", err)
+			return nil, fmt.Errorf("failed to unmarshal schema credentials: %w", err)
 		}
 		for k, v := range schemaCreds {
 			storedCreds[k] = v
@@ -493,7 +580,7 @@ func (s *entityService) verifyCredentials(credentials map[string]interface{},
 	if len(systemCredsJSON) > 0 {
 		var sysCreds map[string][]StoredCredential
 		if err := json.Unmarshal(systemCredsJSON, &sysCreds); err != nil {
-			return fmt.Errorf("failed to unmarshal system credentials: %w", err)
+			return nil, fmt.Errorf("failed to unmarshal system credentials: %w", err)
 		}
 		for k, v := range sysCreds {
 			storedCreds[k] = v
@@ -501,7 +588,8 @@ func (s *entityService) verifyCredentials(credentials map[string]interface{},
 	}
 
 	if len(storedCreds) == 0 {
-		return ErrAuthenticationFailed
+		s.verifyDummyCredentials(credentials)
+		return nil, ErrAuthenticationFailed
 	}
 
 	// Filter to credentials that have stored entries.
@@ -518,10 +606,12 @@ func (s *entityService) verifyCredentials(credentials map[string]interface{},
 	}
 
 	if len(credentialsToVerify) == 0 {
-		return ErrAuthenticationFailed
+		s.verifyDummyCredentials(credentials)
+		return nil, ErrAuthenticationFailed
 	}
 
 	// Verify each credential against stored values.
+	var upgrades []credentialUpgrade
 	for credType, credValue := range credentialsToVerify {
 		credList := storedCreds[credType]
 		verified := false
@@ -536,17 +626,137 @@ func (s *entityService) verifyCredentials(credentials map[string]interface{},
 				},
 			}
 			ok, verifyErr := s.hashService.Verify([]byte(credValue), ref)
+			if errors.Is(verifyErr, hash.ErrCredentialFormatRetired) {
+				s.logger.Debug("Credential format has passed its sunset date",
+					log.String("credentialType", credType), log.String("algorithm", string(stored.StorageAlgo)))
+			}
 			if verifyErr == nil && ok {
 				verified = true
+				if s.hashService.NeedsUpgrade(ref) {
+					upgrades = append(upgrades, credentialUpgrade{
+						credentialType: credType, storedValue: stored.Value, plaintext: credValue,
+					})
+				}
 				break
 			}
 		}
 		if !verified {
-			return ErrAuthenticationFailed
+			return nil, ErrAuthenticationFailed
 		}
 	}
 
-	return nil
+	return upgrades, nil
+}
+
+// verifyDummyCredentials verifies the credentials against a dummy credential when enumeration resistance is
+// enabled, so that failing to authenticate an unknown entity takes as long as failing with a wrong credential.
+func (s *entityService) verifyDummyCredentials(credentials map[string]interface{}) {
+	if s.dummyVerifier != nil {
+		s.dummyVerifier.verify(credentials)
+	}
+}
+
+// upgradeCredentials re-hashes verified credentials stored in a format that is due for upgrade with the
+// configured algorithm. Declarative entities are immutable and are skipped. Failures are logged and do not
+// affect the authentication result.
+func (s *entityService) upgradeCredentials(ctx context.Context, entityID string, upgrades []credentialUpgrade) {
+	if isDeclarative, err := s.store.IsEntityDeclarative(ctx, entityID); err != nil || isDeclarative {
+		return
+	}
+
+	replacements := make(map[string]map[string]StoredCredential, len(upgrades))
+	for _, upgrade := range upgrades {
+		credHash, err := s.hashService.Generate([]byte(upgrade.plaintext))
+		if err != nil {
+			s.logger.Error("Failed to hash credential for upgrade", log.MaskedString("id", entityID), log.Error(err))
+			return
+		}
+		if replacements[upgrade.credentialType] == nil {
+			replacements[upgrade.credentialType] = make(map[string]StoredCredential)
+		}
+		replacements[upgrade.credentialType][upgrade.storedValue] = newStoredCredential(credHash)
+	}
+
+	err := s.transactioner.Transact(ctx, func(txCtx context.Context) error {
+		existing, err := s.store.GetEntityWithCredentials(txCtx, entityID)
+		if err != nil {
+			return err
+		}
+		schemaCreds, schemaChanged, err := replaceStoredCredentials(existing.SchemaCredentials, replacements)
+		if err != nil {
+			return err
+		}
+		if schemaChanged {
+			if err := s.store.UpdateCredentials(txCtx, entityID, schemaCreds); err != nil {
+				return err
+			}
+		}
+		systemCreds, systemChanged, err := replaceStoredCredentials(existing.SystemCredentials, replacements)
+		if err != nil {
+			return err
+		}
+		if systemChanged {
+			return s.store.UpdateSystemCredentials(txCtx, entityID, systemCreds)
+		}
+		return nil
+	})
+	if err != nil {
+		s.logger.Error("Failed to upgrade credential storage format", log.MaskedString("id", entityID),
+			log.Error(err))
+		return
+	}
+	s.logger.Debug("Upgraded credential storage format", log.MaskedString("id", entityID),
+		log.Int("count", len(upgrades)))
+}
+
+// replaceStoredCredentials replaces stored credential entries, matched by credential type and stored
+// value, and reports whether any entry was replaced.
+func replaceStoredCredentials(credentials json.RawMessage,
+	replacements map[string]map[string]StoredCredential) (json.RawMessage, bool, error) {
+	if len(credentials) == 0 {
+		return credentials, false, nil
+	}
+	var credsMap map[string]json.RawMessage
+	if err := json.Unmarshal(credentials, &credsMap); err != nil {
+		return nil, false, fmt.Errorf("failed to unmarshal credentials: %w", err)
+	}
+
+	changed := false
+	for credType, byValue := range replacements {
+		raw, ok := credsMap[credType]
+		if !ok {
+			continue
+		}
+		var stored []StoredCredential
+		if err := json.Unmarshal(raw, &stored); err != nil {
+			return nil, false, fmt.Errorf("failed to unmarshal credential %q: %w", credType, err)
+		}
+		typeChanged := false
+		for i := range stored {
+			if replacement, ok := byValue[stored[i].Value]; ok {
+				stored[i] = replacement
+				typeChanged = true
+			}
+		}
+		if !typeChanged {
+			continue
+		}
+		updated, err := json.Marshal(stored)
+		if err != nil {
+			return nil, false, fmt.Errorf("failed to marshal credential %q: %w", credType, err)
+		}
+		credsMap[credType] = updated
+		changed = true
+	}
+	if !changed {
+		return credentials, false, nil
+	}
+
+	updated, err := json.Marshal(credsMap)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to marshal credentials: %w", err)
+	}
+	return updated, true, nil
 }
 
 // UpdateCredentials updates schema-defined credentials (e.g., password) by hashing new
@@ -585,9 +795,15 @@ func (s *entityService) UpdateCredentials(ctx context.Context, entityID string,
 	if err := s.validateCredentialKeys(ctx, existing.Category, existing.Type, updates); err != nil {
 		return err
 	}
+	if existing.Category == EntityCategoryUser {
+		if err := s.checkDenyList(ctx, denylist.ListTypePassword, updates,
+			slices.Sorted(maps.Keys(updates)), ErrDeniedCredential); err != nil {
+			return err
+		}
+	}
 
 	// Hash new plaintext values.
-	hashedUpdates, err := s.hashPlaintextCredentials(plaintextUpdates)
+	hashedUpdates, err := s.hashPlaintextCredentials(ctx, plaintextUpdates)
 	if err != nil {
 		return fmt.Errorf("failed to hash credential updates: %w", err)
 	}
@@ -683,7 +899,7 @@ func (s *entityService) UpdateSystemCredentials(ctx context.Context, entityID st
 	}
 
 	// Hash new plaintext values.
-	hashedUpdates, err := s.hashPlaintextCredentials(plaintextUpdates)
+	hashedUpdates, err := s.hashPlaintextCredentials(ctx, plaintextUpdates)
 	if err != nil {
 		return fmt.Errorf("failed to hash credential updates: %w", err)
 	}
@@ -721,6 +937,201 @@ func (s *entityService) UpdateSystemCredentials(ctx context.Context, entityID st
 	})
 }
 
+// DeleteCredentials removes all credentials of the given types from the schema and system credentials
+// of an entity. The removal is rejected when it would break the credential policy of the entity type,
+// such as when the last credential of a required type is removed.
+func (s *entityService) DeleteCredentials(ctx context.Context, entityID string, credentialTypes []string) error {
+	if len(credentialTypes) == 0 {
+		return nil
+	}
+	s.logger.Debug("Deleting entity credentials", log.MaskedString("id", entityID),
+		log.Any("credentialTypes", credentialTypes))
+
+	return s.transactioner.Transact(ctx, func(txCtx context.Context) error {
+		existing, err := s.store.GetEntityWithCredentials(txCtx, entityID)
+		if err != nil {
+			return err
+		}
+		before, err := credentialTypesOf(existing.SchemaCredentials, existing.SystemCredentials)
+		if err != nil {
+			return err
+		}
+
+		schemaCreds, schemaChanged, err := removeCredentialTypes(existing.SchemaCredentials, credentialTypes)
+		if err != nil {
+			return err
+		}
+		systemCreds, systemChanged, err := removeCredentialTypes(existing.SystemCredentials, credentialTypes)
+		if err != nil {
+			return err
+		}
+		if !schemaChanged && !systemChanged {
+			return ErrCredentialNotFound
+		}
+
+		if existing.Entity != nil {
+			policy, err := s.getCredentialPolicy(txCtx, existing.Entity.Category, existing.Entity.Type)
+			if err != nil {
+				return err
+			}
+			after, err := credentialTypesOf(schemaCreds, systemCreds)
+			if err != nil {
+				return err
+			}
+			if policy.Evaluate(after).Regresses(policy.Evaluate(before)) {
+				return fmt.Errorf("%w: removing %v is not allowed by the credential policy",
+					ErrCredentialPolicyViolation, credentialTypes)
+			}
+		}
+
+		if schemaChanged {
+			if err := s.store.UpdateCredentials(txCtx, entityID, schemaCreds); err != nil {
+				return err
+			}
+		}
+		if systemChanged {
+			if err := s.store.UpdateSystemCredentials(txCtx, entityID, systemCreds); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// removeCredentialTypes removes the given credential types from a credential map. It reports whether
+// any of the types was present.
+func removeCredentialTypes(credentials json.RawMessage, credentialTypes []string) (json.RawMessage, bool, error) {
+	if len(credentials) == 0 {
+		return credentials, false, nil
+	}
+	var credMap map[string]json.RawMessage
+	if err := json.Unmarshal(credentials, &credMap); err != nil {
+		return nil, false, fmt.Errorf("failed to unmarshal credentials: %w", err)
+	}
+
+	changed := false
+	for _, credType := range credentialTypes {
+		if _, ok := credMap[credType]; ok {
+			delete(credMap, credType)
+			changed = true
+		}
+	}
+	if !changed {
+		return credentials, false, nil
+	}
+
+	updated, err := json.Marshal(credMap)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to marshal credentials: %w", err)
+	}
+	return updated, true, nil
+}
+
+// EvaluateCredentialPolicy evaluates the credentials held by an entity against the credential policy of
+// its entity type. Entities whose type has no credential policy always satisfy it.
+func (s *entityService) EvaluateCredentialPolicy(
+	ctx context.Context, entityID string,
+) (*entitytype.CredentialPolicyEvaluation, error) {
+	existing, err := s.store.GetEntityWithCredentials(ctx, entityID)
+	if err != nil {
+		return nil, err
+	}
+	if existing.Entity == nil {
+		return nil, ErrEntityNotFound
+	}
+
+	policy, err := s.getCredentialPolicy(ctx, existing.Entity.Category, existing.Entity.Type)
+	if err != nil {
+		return nil, err
+	}
+	held, err := credentialTypesOf(existing.SchemaCredentials, existing.SystemCredentials)
+	if err != nil {
+		return nil, err
+	}
+	return policy.Evaluate(held), nil
+}
+
+// getCredentialPolicy returns the credential policy of the entity type, or nil when the category does
+// not use entity types or the type has no credential policy.
+// GetCredentialFormatCounts counts the stored credentials of entities in the category by storage format,
+// together with how each format is treated by the hash service. Formats are sorted by algorithm.
+func (s *entityService) GetCredentialFormatCounts(ctx context.Context,
+	category EntityCategory) ([]CredentialFormatCount, error) {
+	counts, err := s.store.GetCredentialAlgorithmCounts(ctx, string(category))
+	if err != nil {
+		return nil, err
+	}
+
+	formats := make([]CredentialFormatCount, 0, len(counts))
+	for _, algorithm := range slices.Sorted(maps.Keys(counts)) {
+		policy := s.hashService.GetFormatPolicy(algorithm)
+		formats = append(formats, CredentialFormatCount{
+			Algorithm:  algorithm,
+			Count:      counts[algorithm],
+			Current:    policy.Current,
+			Supported:  policy.Supported,
+			SunsetDate: policy.SunsetDate,
+			Blocked:    policy.Blocked,
+		})
+	}
+	return formats, nil
+}
+
+func (s *entityService) getCredentialPolicy(
+	ctx context.Context, category EntityCategory, entityType string,
+) (*entitytype.CredentialPolicy, error) {
+	if !usesEntityType(category) || s.entityTypeService == nil {
+		return nil, nil
+	}
+
+	policy, svcErr := s.entityTypeService.GetCredentialPolicy(ctx, entitytype.TypeCategory(category), entityType)
+	if svcErr != nil {
+		return nil, fmt.Errorf("failed to get credential policy: %s", svcErr.ErrorDescription.DefaultValue)
+	}
+	return policy, nil
+}
+
+// checkEnrollableCredentialPolicy rejects credentials that cannot satisfy the credential policy of the
+// entity type. System-managed credential types are assumed to be enrolled later.
+func (s *entityService) checkEnrollableCredentialPolicy(ctx context.Context, category EntityCategory,
+	entityType string, schemaCredentials, systemCredentials json.RawMessage) error {
+	policy, err := s.getCredentialPolicy(ctx, category, entityType)
+	if err != nil || policy == nil {
+		return err
+	}
+
+	held, err := credentialTypesOf(schemaCredentials, systemCredentials)
+	if err != nil {
+		return err
+	}
+	if evaluation := policy.EvaluateEnrollable(held); !evaluation.Satisfied {
+		return fmt.Errorf("%w: the credentials do not satisfy the credential policy of entity type %q",
+			ErrCredentialPolicyViolation, entityType)
+	}
+	return nil
+}
+
+// checkTypeChangeCredentialPolicy enforces the credential policy of the new entity type when an update
+// changes the type of an entity. Updates that keep the type only add or replace credentials and cannot
+// violate the policy.
+func (s *entityService) checkTypeChangeCredentialPolicy(ctx context.Context, entityID string, entity *Entity,
+	schemaCredentials json.RawMessage) error {
+	if !usesEntityType(entity.Category) || s.entityTypeService == nil {
+		return nil
+	}
+
+	existing, err := s.store.GetEntityWithCredentials(ctx, entityID)
+	if err != nil {
+		return err
+	}
+	if existing.Entity == nil || existing.Entity.Type == entity.Type {
+		return nil
+	}
+
+	return s.checkEnrollableCredentialPolicy(ctx, entity.Category, entity.Type,
+		mergeCredentialJSON(existing.SchemaCredentials, schemaCredentials), existing.SystemCredentials)
+}
+
 // populateOUHandles resolves OU handles for a slice of entities in-place.
 func (s *entityService) populateOUHandles(ctx context.Context, entities []Entity) {
 	if s.ouService == nil || len(entities) == 0 {
@@ -750,6 +1161,7 @@ func (s *entityService) populateOUHandles(ctx context.Context, entities []Entity
 }
 
 // validateEntityType validates entity attributes and uniqueness against the entity type.
+// ouID is the organization unit the entity belongs to, used for attributes unique per organization unit.
 // excludeEntityID is used to exclude the entity itself from uniqueness
 // checks during updates (empty string for creates). skipCredentialRequired controls whether
 // credential fields are required (false for creates, true for updates).
@@ -757,6 +1169,7 @@ func (s *entityService) validateEntityType(
 	ctx context.Context,
 	category EntityCategory,
 	entityType string,
+	ouID string,
 	attributes json.RawMessage,
 	excludeEntityID string,
 	skipCredentialRequired bool,
@@ -779,8 +1192,18 @@ func (s *entityService) validateEntityType(
 
 	// Validate attribute uniqueness
 	isValid, svcErr = s.entityTypeService.ValidateEntityUniqueness(ctx, schemaCategory, entityType, attributes,
-		func(filters map[string]interface{}) (bool, error) {
-			id, err := s.IdentifyEntity(ctx, filters)
+		func(filters map[string]interface{}, scope entitytype.UniquenessScope) (bool, error) {
+			var id *string
+			var err error
+			switch scope {
+			case entitytype.UniquenessScopeOU:
+				id, err = s.store.IdentifyEntityInScope(ctx, filters, identifierScope{OUID: ouID})
+			case entitytype.UniquenessScopeType:
+				id, err = s.store.IdentifyEntityInScope(ctx, filters,
+					identifierScope{Category: string(category), Type: entityType})
+			default:
+				id, err = s.IdentifyEntity(withDeletedIdentifiers(ctx), filters)
+			}
 			if err != nil {
 				if errors.Is(err, ErrEntityNotFound) {
 					return false, nil // Not found = unique
@@ -806,6 +1229,87 @@ func (s *entityService) validateEntityType(
 	return nil
 }
 
+// checkDenyLists rejects user attributes blocked by the deployment deny lists. The unique attributes of
+// the user type are checked against the username deny list and its credentials against the password
+// deny list.
+func (s *entityService) checkDenyLists(
+	ctx context.Context, category EntityCategory, entityType string, attributes json.RawMessage,
+) error {
+	if category != EntityCategoryUser || s.denyListService == nil || s.entityTypeService == nil ||
+		len(attributes) == 0 {
+		return nil
+	}
+
+	var attrsMap map[string]interface{}
+	if err := json.Unmarshal(attributes, &attrsMap); err != nil {
+		return fmt.Errorf("failed to unmarshal entity attributes: %w", err)
+	}
+
+	identifiers, svcErr := s.entityTypeService.GetUniqueAttributes(ctx, entitytype.TypeCategoryUser, entityType)
+	if svcErr != nil {
+		return fmt.Errorf("failed to get unique attributes from schema: %s", svcErr.ErrorDescription)
+	}
+	slices.Sort(identifiers)
+	if err := s.checkDenyList(ctx, denylist.ListTypeUsername, attrsMap, identifiers,
+		ErrDeniedIdentifier); err != nil {
+		return err
+	}
+
+	credInfos, svcErr := s.entityTypeService.GetAttributes(ctx,
+		entitytype.TypeCategoryUser, entityType, true, false, false)
+	if svcErr != nil {
+		return fmt.Errorf("failed to get credential attributes from schema: %s", svcErr.ErrorDescription)
+	}
+	credentials := make([]string, 0, len(credInfos))
+	for _, info := range credInfos {
+		credentials = append(credentials, info.Attribute)
+	}
+	return s.checkDenyList(ctx, denylist.ListTypePassword, attrsMap, credentials, ErrDeniedCredential)
+}
+
+// checkQuota rejects the creation of a user or an application beyond the quota of its organization unit
+// or tenant. Returns an error wrapping quota.ErrQuotaExceeded when the quota is used up.
+func (s *entityService) checkQuota(ctx context.Context, category EntityCategory, ouID string) error {
+	if s.quotaService == nil {
+		return nil
+	}
+
+	var resourceType quota.ResourceType
+	switch category {
+	case EntityCategoryUser:
+		resourceType = quota.ResourceTypeUsers
+	case EntityCategoryApp:
+		resourceType = quota.ResourceTypeApplications
+	default:
+		return nil
+	}
+	return s.quotaService.CheckQuota(ctx, resourceType, ouID)
+}
+
+// checkDenyList returns deniedErr if the string value of any of the given attributes is blocked by the
+// deny list.
+func (s *entityService) checkDenyList(ctx context.Context, listType denylist.ListType,
+	values map[string]interface{}, attributes []string, deniedErr error) error {
+	if s.denyListService == nil {
+		return nil
+	}
+
+	for _, attribute := range attributes {
+		value, ok := values[attribute].(string)
+		if !ok || value == "" {
+			continue
+		}
+		denied, svcErr := s.denyListService.IsDenied(ctx, listType, value)
+		if svcErr != nil {
+			return fmt.Errorf("failed to check deny list: %s", svcErr.ErrorDescription)
+		}
+		if denied {
+			return fmt.Errorf("%w: %q", deniedErr, attribute)
+		}
+	}
+	return nil
+}
+
 // mergeCredentialJSON merges new credential JSON into existing credential JSON.
 // New credential types replace existing ones; types not in the update are preserved.
 func mergeCredentialJSON(existing, updates json.RawMessage) json.RawMessage {
@@ -889,13 +1393,14 @@ func (s *entityService) extractAndHashSchemaCredentials(ctx context.Context, ent
 		return nil, fmt.Errorf("failed to marshal plaintext credentials: %w", err)
 	}
 
-	return s.hashPlaintextCredentials(plaintextJSON)
+	return s.hashPlaintextCredentials(ctx, plaintextJSON)
 }
 
 // hashPlaintextCredentials processes system credentials JSON, hashing any plaintext values.
 // Values that are already in the stored format (arrays of credential objects) are passed through as-is.
-// This allows declarative resource loaders to pre-hash credentials.
-func (s *entityService) hashPlaintextCredentials(creds json.RawMessage) (json.RawMessage, error) {
+// This allows declarative resource loaders to pre-hash credentials. Plaintext values hashed ahead of time
+// by PrehashCredentials are taken from the context instead of being hashed again.
+func (s *entityService) hashPlaintextCredentials(ctx context.Context, creds json.RawMessage) (json.RawMessage, error) {
 	if len(creds) == 0 {
 		return creds, nil
 	}
@@ -917,21 +1422,15 @@ func (s *entityService) hashPlaintextCredentials(creds json.RawMessage) (json.Ra
 			if v == "" {
 				continue
 			}
-			credHash, err := s.hashService.Generate([]byte(v))
-			if err != nil {
-				return nil, fmt.Errorf("failed to hash credential %q: %w", credType, err)
-			}
-			result[credType] = []StoredCredential{
-				{
-					StorageAlgo: credHash.Algorithm,
-					StorageAlgoParams: hash.CredParameters{
-						Salt:       credHash.Parameters.Salt,
-						Iterations: credHash.Parameters.Iterations,
-						KeySize:    credHash.Parameters.KeySize,
-					},
-					Value: credHash.Hash,
-				},
+			credHash, ok := hash.TakePrecomputedCredential(ctx, []byte(v))
+			if !ok {
+				var err error
+				credHash, err = s.hashService.Generate([]byte(v))
+				if err != nil {
+					return nil, fmt.Errorf("failed to hash credential %q: %w", credType, err)
+				}
 			}
+			result[credType] = []StoredCredential{newStoredCredential(credHash)}
 		default:
 			// Already in stored format (array of credential objects) — pass through.
 			result[credType] = credValue
@@ -941,6 +1440,75 @@ func (s *entityService) hashPlaintextCredentials(creds json.RawMessage) (json.Ra
 	return json.Marshal(result)
 }
 
+// PrehashCredentials hashes the plaintext schema credentials of the given entities with the shared batch
+// hashing pool and returns a context carrying the hashes. Entities created with the returned context store
+// these hashes instead of hashing their credentials one at a time, which keeps bulk imports from holding
+// transactions open while hashing. Entities that cannot be prehashed, and values that fail to hash, are
+// hashed on creation as usual; an error is returned only when ctx is done before the batch completes.
+func (s *entityService) PrehashCredentials(ctx context.Context, entities []*Entity) (context.Context, error) {
+	if s.entityTypeService == nil {
+		return ctx, nil
+	}
+
+	credentialAttrs := make(map[string][]string)
+	var values [][]byte
+	for _, entity := range entities {
+		if entity == nil || !usesEntityType(entity.Category) || len(entity.Attributes) == 0 {
+			continue
+		}
+
+		typeKey := string(entity.Category) + "|" + entity.Type
+		attrs, ok := credentialAttrs[typeKey]
+		if !ok {
+			// Entities of unknown types are left to CreateEntity, which reports the error per entity.
+			credentialInfos, svcErr := s.entityTypeService.GetAttributes(ctx,
+				entitytype.TypeCategory(entity.Category), entity.Type, true, false, false)
+			if svcErr == nil {
+				for _, info := range credentialInfos {
+					attrs = append(attrs, info.Attribute)
+				}
+			}
+			credentialAttrs[typeKey] = attrs
+		}
+		if len(attrs) == 0 {
+			continue
+		}
+
+		var attrsMap map[string]interface{}
+		if err := json.Unmarshal(entity.Attributes, &attrsMap); err != nil {
+			continue
+		}
+		for _, attr := range attrs {
+			if val, ok := attrsMap[attr].(string); ok && val != "" {
+				values = append(values, []byte(val))
+			}
+		}
+	}
+
+	if len(values) == 0 {
+		return ctx, nil
+	}
+
+	results, err := hash.GenerateBatch(ctx, s.hashService, values)
+	if err != nil {
+		return ctx, fmt.Errorf("failed to hash credentials: %w", err)
+	}
+	return hash.WithPrecomputedCredentials(ctx, values, results), nil
+}
+
+// newStoredCredential converts a generated credential hash into its stored form.
+func newStoredCredential(credHash hash.Credential) StoredCredential {
+	return StoredCredential{
+		StorageAlgo: credHash.Algorithm,
+		StorageAlgoParams: hash.CredParameters{
+			Salt:       credHash.Parameters.Salt,
+			Iterations: credHash.Parameters.Iterations,
+			KeySize:    credHash.Parameters.KeySize,
+		},
+		Value: credHash.Hash,
+	}
+}
+
 // IsEntityDeclarative checks if an entity is declarative (immutable).
 func (s *entityService) IsEntityDeclarative(ctx context.Context, entityID string) (bool, error) {
 	return s.store.IsEntityDeclarative(ctx, entityID)
@@ -957,3 +1525,8 @@ func (s *entityService) LoadDeclarativeResources(config DeclarativeLoaderConfig)
 func (s *entityService) LoadIndexedAttributes(attributes []string) error {
 	return s.store.LoadIndexedAttributes(attributes)
 }
+
+// GetIndexedAttributes returns the sorted names of the attributes indexed for all entity categories.
+func (s *entityService) GetIndexedAttributes() []string {
+	return slices.Sorted(maps.Keys(s.store.GetIndexedAttributes()))
+}
@@ -22,14 +22,22 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 
+	"github.com/thunder-id/thunderid/internal/denylist"
+	"github.com/thunder-id/thunderid/internal/entitytype"
+	"github.com/thunder-id/thunderid/internal/quota"
 	"github.com/thunder-id/thunderid/internal/system/cryptolab/hash"
 	"github.com/thunder-id/thunderid/internal/system/transaction"
 	"github.com/thunder-id/thunderid/tests/mocks/crypto/hashmock"
+	"github.com/thunder-id/thunderid/tests/mocks/denylistmock"
+	"github.com/thunder-id/thunderid/tests/mocks/entitytypemock"
+	"github.com/thunder-id/thunderid/tests/mocks/quotamock"
 )
 
 type ServiceTestSuite struct {
@@ -56,7 +64,8 @@ func (s *ServiceTestSuite) SetupTest() {
 			Salt: "testsalt", Iterations: 1, KeySize: 32,
 		},
 	}, nil).Maybe()
-	s.svc = newEntityService(s.store, s.hashService, nil, nil, transaction.NewNoOpTransactioner())
+	s.hashService.On("NeedsUpgrade", mock.Anything).Return(false).Maybe()
+	s.svc = newEntityService(s.store, s.hashService, nil, nil, nil, nil, transaction.NewNoOpTransactioner())
 	s.ctx = context.Background()
 	s.testErr = errors.New("store error")
 }
@@ -267,6 +276,34 @@ func (s *ServiceTestSuite) TestGetCredentialsByType_MalformedSchemaJSON() {
 	s.Error(err)
 }
 
+func (s *ServiceTestSuite) TestGetCredentialTypes_MergesColumns() {
+	e := testEntity("ecreds")
+	schemaCreds := json.RawMessage(`{"password":[{"value":"hashed-pw"}],"pin":[]}`)
+	sysCreds := json.RawMessage(`{"passkey":[{"value":"v1"}],"password":[{"value":"system-pw"}]}`)
+	s.store.On("GetEntityWithCredentials", mock.Anything, e.ID).
+		Return(&entityWithCredentials{Entity: e, SchemaCredentials: schemaCreds, SystemCredentials: sysCreds}, nil)
+	types, err := s.svc.GetCredentialTypes(s.ctx, e.ID)
+	s.NoError(err)
+	s.Equal([]string{"passkey", "password"}, types)
+}
+
+func (s *ServiceTestSuite) TestGetCredentialTypes_NoCredentials() {
+	e := testEntity("ecreds")
+	s.store.On("GetEntityWithCredentials", mock.Anything, e.ID).
+		Return(&entityWithCredentials{Entity: e, SchemaCredentials: nil, SystemCredentials: nil}, nil)
+	types, err := s.svc.GetCredentialTypes(s.ctx, e.ID)
+	s.NoError(err)
+	s.Empty(types)
+}
+
+func (s *ServiceTestSuite) TestGetCredentialTypes_MalformedJSON() {
+	e := testEntity("ecreds")
+	s.store.On("GetEntityWithCredentials", mock.Anything, e.ID).
+		Return(&entityWithCredentials{Entity: e, SchemaCredentials: json.RawMessage(`not json`)}, nil)
+	_, err := s.svc.GetCredentialTypes(s.ctx, e.ID)
+	s.Error(err)
+}
+
 func (s *ServiceTestSuite) TestIdentifyEntity_Delegates() {
 	filters := map[string]interface{}{"email": "x@y.com"}
 	id := "found-id"
@@ -432,6 +469,101 @@ func (s *ServiceTestSuite) TestAuthenticateEntityByID_Success() {
 	s.Equal(e.OUID, result.OUID)
 }
 
+func legacyCredentialsJSON() json.RawMessage {
+	return json.RawMessage(`{"password":[{"value":"$1$saltsalt$qjXMvbEw8oaL.CzflDtaK/","storageAlgo":"MD5_CRYPT"}]}`)
+}
+
+func (s *ServiceTestSuite) newUpgradingService() EntityServiceInterface {
+	s.hashService = hashmock.NewHashServiceInterfaceMock(s.T())
+	s.hashService.On("Verify", []byte("password"), mock.Anything).Return(true, nil)
+	s.hashService.On("NeedsUpgrade", mock.Anything).Return(true)
+	return newEntityService(s.store, s.hashService, nil, nil, nil, nil, transaction.NewNoOpTransactioner())
+}
+
+func (s *ServiceTestSuite) TestAuthenticateEntityByID_UpgradesLegacyCredential() {
+	svc := s.newUpgradingService()
+	s.hashService.On("Generate", []byte("password")).Return(hash.Credential{
+		Algorithm: "PBKDF2", Hash: "upgradedhash", Parameters: hash.CredParameters{Salt: "newsalt"},
+	}, nil)
+	e := testEntity("upgrade-1")
+	s.store.On("GetEntityWithCredentials", mock.Anything, e.ID).
+		Return(&entityWithCredentials{Entity: e, SchemaCredentials: legacyCredentialsJSON()}, nil)
+	s.store.On("IsEntityDeclarative", mock.Anything, e.ID).Return(false, nil)
+	s.store.On("UpdateCredentials", mock.Anything, e.ID, mock.MatchedBy(func(creds json.RawMessage) bool {
+		var stored map[string][]StoredCredential
+		if err := json.Unmarshal(creds, &stored); err != nil || len(stored["password"]) != 1 {
+			return false
+		}
+		return stored["password"][0].StorageAlgo == "PBKDF2" && stored["password"][0].Value == "upgradedhash"
+	})).Return(nil)
+
+	result, err := svc.AuthenticateEntityByID(s.ctx, e.ID, map[string]interface{}{"password": "password"})
+	s.NoError(err)
+	s.Equal(e.ID, result.EntityID)
+}
+
+func (s *ServiceTestSuite) TestAuthenticateEntityByID_UpgradeFailureDoesNotFailLogin() {
+	svc := s.newUpgradingService()
+	s.hashService.On("Generate", []byte("password")).Return(hash.Credential{Algorithm: "PBKDF2"}, nil)
+	e := testEntity("upgrade-2")
+	s.store.On("GetEntityWithCredentials", mock.Anything, e.ID).
+		Return(&entityWithCredentials{Entity: e, SchemaCredentials: legacyCredentialsJSON()}, nil)
+	s.store.On("IsEntityDeclarative", mock.Anything, e.ID).Return(false, nil)
+	s.store.On("UpdateCredentials", mock.Anything, e.ID, mock.Anything).Return(s.testErr)
+
+	result, err := svc.AuthenticateEntityByID(s.ctx, e.ID, map[string]interface{}{"password": "password"})
+	s.NoError(err)
+	s.Equal(e.ID, result.EntityID)
+}
+
+func (s *ServiceTestSuite) TestAuthenticateEntityByID_UpgradeSkippedForDeclarativeEntity() {
+	svc := s.newUpgradingService()
+	e := testEntity("upgrade-3")
+	s.store.On("GetEntityWithCredentials", mock.Anything, e.ID).
+		Return(&entityWithCredentials{Entity: e, SchemaCredentials: legacyCredentialsJSON()}, nil)
+	s.store.On("IsEntityDeclarative", mock.Anything, e.ID).Return(true, nil)
+
+	_, err := svc.AuthenticateEntityByID(s.ctx, e.ID, map[string]interface{}{"password": "password"})
+	s.NoError(err)
+	s.store.AssertNotCalled(s.T(), "UpdateCredentials", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (s *ServiceTestSuite) TestAuthenticateEntityByID_RetiredFormat() {
+	s.hashService.On("Verify", []byte("password"), mock.Anything).Return(false, hash.ErrCredentialFormatRetired)
+	e := testEntity("retired-1")
+	s.store.On("GetEntityWithCredentials", mock.Anything, e.ID).
+		Return(&entityWithCredentials{Entity: e, SchemaCredentials: legacyCredentialsJSON()}, nil)
+
+	_, err := s.svc.AuthenticateEntityByID(s.ctx, e.ID, map[string]interface{}{"password": "password"})
+	s.ErrorIs(err, ErrAuthenticationFailed)
+}
+
+func (s *ServiceTestSuite) TestGetCredentialFormatCounts() {
+	sunset := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	s.store.On("GetCredentialAlgorithmCounts", mock.Anything, string(EntityCategoryUser)).
+		Return(map[hash.CredAlgorithm]int{hash.PBKDF2: 5, hash.BCRYPT: 2}, nil)
+	s.hashService.On("GetFormatPolicy", hash.BCRYPT).Return(hash.FormatPolicy{
+		Algorithm: hash.BCRYPT, Supported: true, SunsetDate: sunset, Blocked: true,
+	})
+	s.hashService.On("GetFormatPolicy", hash.PBKDF2).Return(hash.FormatPolicy{
+		Algorithm: hash.PBKDF2, Current: true, Supported: true,
+	})
+
+	formats, err := s.svc.GetCredentialFormatCounts(s.ctx, EntityCategoryUser)
+	s.NoError(err)
+	s.Equal([]CredentialFormatCount{
+		{Algorithm: hash.BCRYPT, Count: 2, Supported: true, SunsetDate: sunset, Blocked: true},
+		{Algorithm: hash.PBKDF2, Count: 5, Current: true, Supported: true},
+	}, formats)
+}
+
+func (s *ServiceTestSuite) TestGetCredentialFormatCounts_StoreError() {
+	s.store.On("GetCredentialAlgorithmCounts", mock.Anything, string(EntityCategoryUser)).Return(nil, s.testErr)
+
+	_, err := s.svc.GetCredentialFormatCounts(s.ctx, EntityCategoryUser)
+	s.ErrorIs(err, s.testErr)
+}
+
 func (s *ServiceTestSuite) TestAuthenticateEntityByID_EmptyID() {
 	_, err := s.svc.AuthenticateEntityByID(s.ctx, "", map[string]interface{}{"password": "p"})
 	s.ErrorIs(err, ErrEntityNotFound)
@@ -486,3 +618,302 @@ func (s *ServiceTestSuite) TestAuthenticateEntity_DelegatesToByID() {
 	s.NoError(err)
 	s.Equal(id, result.EntityID)
 }
+
+func (s *ServiceTestSuite) newDenyListEnforcingService() (
+	EntityServiceInterface, *entitytypemock.EntityTypeServiceInterfaceMock,
+	*denylistmock.DenyListServiceInterfaceMock) {
+	entityTypeService := entitytypemock.NewEntityTypeServiceInterfaceMock(s.T())
+	denyListService := denylistmock.NewDenyListServiceInterfaceMock(s.T())
+	svc := newEntityService(s.store, s.hashService, entityTypeService, nil, denyListService, nil,
+		transaction.NewNoOpTransactioner())
+	return svc, entityTypeService, denyListService
+}
+
+func (s *ServiceTestSuite) TestCreateEntity_DeniedIdentifier() {
+	svc, entityTypeService, denyListService := s.newDenyListEnforcingService()
+	e := testEntity("denied-1")
+	e.Attributes = json.RawMessage(`{"username":"Admin","password":"s3cret!"}`)
+	entityTypeService.On("ValidateEntity", mock.Anything, entitytype.TypeCategoryUser, "employee",
+		mock.Anything, false).Return(true, nil)
+	entityTypeService.On("ValidateEntityUniqueness", mock.Anything, entitytype.TypeCategoryUser, "employee",
+		mock.Anything, mock.Anything).Return(true, nil)
+	entityTypeService.On("GetUniqueAttributes", mock.Anything, entitytype.TypeCategoryUser, "employee").
+		Return([]string{"username"}, nil)
+	denyListService.On("IsDenied", mock.Anything, denylist.ListTypeUsername, "Admin").Return(true, nil)
+
+	_, err := svc.CreateEntity(s.ctx, e, nil)
+	s.ErrorIs(err, ErrDeniedIdentifier)
+	s.store.AssertNotCalled(s.T(), "CreateEntity", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (s *ServiceTestSuite) TestCreateEntity_DeniedCredential() {
+	svc, entityTypeService, denyListService := s.newDenyListEnforcingService()
+	e := testEntity("denied-2")
+	e.Attributes = json.RawMessage(`{"username":"alice","password":"Acme2026"}`)
+	entityTypeService.On("ValidateEntity", mock.Anything, entitytype.TypeCategoryUser, "employee",
+		mock.Anything, false).Return(true, nil)
+	entityTypeService.On("ValidateEntityUniqueness", mock.Anything, entitytype.TypeCategoryUser, "employee",
+		mock.Anything, mock.Anything).Return(true, nil)
+	entityTypeService.On("GetUniqueAttributes", mock.Anything, entitytype.TypeCategoryUser, "employee").
+		Return([]string{"username"}, nil)
+	entityTypeService.On("GetAttributes", mock.Anything, entitytype.TypeCategoryUser, "employee",
+		true, false, false).Return([]entitytype.AttributeInfo{{Attribute: "password", Credential: true}}, nil)
+	denyListService.On("IsDenied", mock.Anything, denylist.ListTypeUsername, "alice").Return(false, nil)
+	denyListService.On("IsDenied", mock.Anything, denylist.ListTypePassword, "Acme2026").Return(true, nil)
+
+	_, err := svc.CreateEntity(s.ctx, e, nil)
+	s.ErrorIs(err, ErrDeniedCredential)
+}
+
+func (s *ServiceTestSuite) TestCreateEntity_QuotaExceeded() {
+	quotaService := quotamock.NewQuotaServiceInterfaceMock(s.T())
+	svc := newEntityService(s.store, s.hashService, nil, nil, nil, quotaService,
+		transaction.NewNoOpTransactioner())
+	e := testEntity("quota-1")
+	e.Category = EntityCategoryApp
+	quotaService.On("CheckQuota", mock.Anything, quota.ResourceTypeApplications, "ou-1").
+		Return(fmt.Errorf("%w: the applications quota of organization unit ou-1 allows at most 2",
+			quota.ErrQuotaExceeded))
+
+	_, err := svc.CreateEntity(s.ctx, e, nil)
+	s.ErrorIs(err, quota.ErrQuotaExceeded)
+	s.store.AssertNotCalled(s.T(), "CreateEntity", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (s *ServiceTestSuite) TestCreateEntity_AgentNotLimitedByQuota() {
+	quotaService := quotamock.NewQuotaServiceInterfaceMock(s.T())
+	svc := newEntityService(s.store, s.hashService, nil, nil, nil, quotaService,
+		transaction.NewNoOpTransactioner())
+	e := testEntity("quota-2")
+	e.Category = EntityCategoryAgent
+	s.store.On("CreateEntity", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	s.store.On("GetEntity", mock.Anything, e.ID).Return(*e, nil)
+
+	_, err := svc.CreateEntity(s.ctx, e, nil)
+	s.NoError(err)
+	quotaService.AssertNotCalled(s.T(), "CheckQuota", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (s *ServiceTestSuite) TestUsageCounter() {
+	counter := newUsageCounter(s.store, EntityCategoryUser)
+	s.store.On("GetEntityListCount", mock.Anything, "user", mock.Anything).Return(7, nil)
+	s.store.On("GetEntityListCountByOUIDs", mock.Anything, "user", []string{"ou-1"}, mock.Anything).Return(2, nil)
+
+	tenantUsage, err := counter.CountUsage(s.ctx, quota.TenantScope)
+	s.NoError(err)
+	s.Equal(7, tenantUsage)
+	ouUsage, err := counter.CountUsage(s.ctx, "ou-1")
+	s.NoError(err)
+	s.Equal(2, ouUsage)
+}
+
+func (s *ServiceTestSuite) TestDuplicateValueCounter() {
+	counter := newDuplicateValueCounter(s.store)
+	s.store.On("CountDuplicateAttributeValues", mock.Anything, "user", "employee", "email", true).Return(3, nil)
+
+	count, err := counter.CountDuplicateValues(s.ctx, entitytype.TypeCategoryUser, "employee", "email", true)
+	s.NoError(err)
+	s.Equal(3, count)
+}
+
+func (s *ServiceTestSuite) TestCreateEntity_UniquenessScopedLookups() {
+	entityTypeService := entitytypemock.NewEntityTypeServiceInterfaceMock(s.T())
+	svc := newEntityService(s.store, s.hashService, entityTypeService, nil, nil, nil,
+		transaction.NewNoOpTransactioner())
+	e := testEntity("scoped-1")
+	existingID := "other"
+	s.store.On("IdentifyEntityInScope", mock.Anything, map[string]interface{}{"email": "a@example.com"},
+		identifierScope{OUID: "ou-1"}).Return(nil, ErrEntityNotFound).Once()
+	s.store.On("IdentifyEntityInScope", mock.Anything, map[string]interface{}{"staffId": "s-1"},
+		identifierScope{Category: "user", Type: "employee"}).Return(&existingID, nil).Once()
+	// Soft-deleted entities keep their unique values, so global lookups match them.
+	s.store.On("IdentifyEntity", mock.MatchedBy(includesDeletedIdentifiers),
+		map[string]interface{}{"mobile": "0771234567"}).Return(nil, ErrAmbiguousEntity).Once()
+	entityTypeService.On("ValidateEntity", mock.Anything, entitytype.TypeCategoryUser, "employee",
+		mock.Anything, false).Return(true, nil)
+	entityTypeService.On("ValidateEntityUniqueness", mock.Anything, entitytype.TypeCategoryUser, "employee",
+		mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			exists := args.Get(4).(entitytype.ExistsFunc)
+
+			found, err := exists(map[string]interface{}{"email": "a@example.com"}, entitytype.UniquenessScopeOU)
+			s.NoError(err)
+			s.False(found)
+			found, err = exists(map[string]interface{}{"staffId": "s-1"}, entitytype.UniquenessScopeType)
+			s.NoError(err)
+			s.True(found)
+			found, err = exists(map[string]interface{}{"mobile": "0771234567"}, entitytype.UniquenessScopeGlobal)
+			s.NoError(err)
+			s.True(found)
+		}).
+		Return(false, nil)
+
+	_, err := svc.CreateEntity(s.ctx, e, nil)
+	s.ErrorIs(err, ErrAttributeConflict)
+}
+
+func (s *ServiceTestSuite) TestUpdateCredentials_DeniedPassword() {
+	svc, entityTypeService, denyListService := s.newDenyListEnforcingService()
+	e := testEntity("denied-3")
+	s.store.On("GetEntity", mock.Anything, e.ID).Return(*e, nil)
+	entityTypeService.On("GetAttributes", mock.Anything, entitytype.TypeCategoryUser, "employee",
+		true, false, false).Return([]entitytype.AttributeInfo{{Attribute: "password", Credential: true}}, nil)
+	denyListService.On("IsDenied", mock.Anything, denylist.ListTypePassword, "password123").Return(true, nil)
+
+	err := svc.UpdateCredentials(s.ctx, e.ID, json.RawMessage(`{"password":"password123"}`))
+	s.ErrorIs(err, ErrDeniedCredential)
+	s.store.AssertNotCalled(s.T(), "UpdateCredentials", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (s *ServiceTestSuite) newCredentialPolicyService(
+	policy *entitytype.CredentialPolicy,
+) EntityServiceInterface {
+	entityTypeService := entitytypemock.NewEntityTypeServiceInterfaceMock(s.T())
+	entityTypeService.On("GetCredentialPolicy", mock.Anything, entitytype.TypeCategoryUser, "employee").
+		Return(policy, nil).Maybe()
+	entityTypeService.On("ValidateEntity", mock.Anything, entitytype.TypeCategoryUser, "employee",
+		mock.Anything, mock.Anything).Return(true, nil).Maybe()
+	entityTypeService.On("ValidateEntityUniqueness", mock.Anything, entitytype.TypeCategoryUser, "employee",
+		mock.Anything, mock.Anything).Return(true, nil).Maybe()
+	entityTypeService.On("GetAttributes", mock.Anything, entitytype.TypeCategoryUser, "employee",
+		true, false, false).Return([]entitytype.AttributeInfo{
+		{Attribute: "password", Credential: true},
+		{Attribute: "pin", Credential: true},
+	}, nil).Maybe()
+	return newEntityService(s.store, s.hashService, entityTypeService, nil, nil, nil,
+		transaction.NewNoOpTransactioner())
+}
+
+func (s *ServiceTestSuite) TestCreateEntity_CredentialPolicyNotSatisfied() {
+	svc := s.newCredentialPolicyService(&entitytype.CredentialPolicy{Required: []string{"password", "pin"}})
+	e := testEntity("policy-1")
+	e.Attributes = json.RawMessage(`{"username":"alice","password":"s3cret!"}`)
+
+	_, err := svc.CreateEntity(s.ctx, e, nil)
+	s.ErrorIs(err, ErrCredentialPolicyViolation)
+	s.store.AssertNotCalled(s.T(), "CreateEntity", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (s *ServiceTestSuite) TestCreateEntity_CredentialPolicyDefersPasskey() {
+	svc := s.newCredentialPolicyService(&entitytype.CredentialPolicy{Required: []string{"password", "passkey"}})
+	e := testEntity("policy-2")
+	e.Attributes = json.RawMessage(`{"username":"alice","password":"s3cret!"}`)
+	s.store.On("CreateEntity", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	s.store.On("GetEntity", mock.Anything, e.ID).Return(*e, nil)
+
+	_, err := svc.CreateEntity(s.ctx, e, nil)
+	s.NoError(err)
+}
+
+func (s *ServiceTestSuite) TestPrehashCredentials_CreateEntityStoresPrecomputedHashes() {
+	s.hashService = hashmock.NewHashServiceInterfaceMock(s.T())
+	s.hashService.On("Generate", []byte("s3cret!")).Return(hash.Credential{Hash: "hash-1"}, nil).Once()
+	s.hashService.On("Generate", []byte("s3cret!")).Return(hash.Credential{Hash: "hash-2"}, nil).Once()
+	svc := s.newCredentialPolicyService(nil)
+	first := testEntity("prehash-1")
+	first.Attributes = json.RawMessage(`{"username":"alice","password":"s3cret!"}`)
+	second := testEntity("prehash-2")
+	second.Attributes = json.RawMessage(`{"username":"bob","password":"s3cret!"}`)
+
+	ctx, err := svc.PrehashCredentials(s.ctx, []*Entity{first, second, nil})
+	s.Require().NoError(err)
+
+	var stored []string
+	s.store.On("CreateEntity", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			stored = append(stored, string(args.Get(2).(json.RawMessage)))
+		}).Return(nil).Twice()
+	s.store.On("GetEntity", mock.Anything, first.ID).Return(*first, nil)
+	s.store.On("GetEntity", mock.Anything, second.ID).Return(*second, nil)
+
+	_, err = svc.CreateEntity(ctx, first, nil)
+	s.Require().NoError(err)
+	_, err = svc.CreateEntity(ctx, second, nil)
+	s.Require().NoError(err)
+
+	s.hashService.AssertNumberOfCalls(s.T(), "Generate", 2)
+	s.Require().Len(stored, 2)
+	s.Contains(stored[0]+stored[1], `"hash-1"`)
+	s.Contains(stored[0]+stored[1], `"hash-2"`)
+}
+
+func (s *ServiceTestSuite) TestPrehashCredentials_NoCredentials() {
+	svc := s.newCredentialPolicyService(nil)
+
+	ctx, err := svc.PrehashCredentials(s.ctx, []*Entity{testEntity("prehash-3")})
+	s.NoError(err)
+	s.Equal(s.ctx, ctx)
+	s.hashService.AssertNotCalled(s.T(), "Generate", mock.Anything)
+}
+
+func (s *ServiceTestSuite) TestUpdateEntity_TypeChangeCredentialPolicyNotSatisfied() {
+	svc := s.newCredentialPolicyService(&entitytype.CredentialPolicy{Required: []string{"pin"}})
+	existing := testEntity("policy-3")
+	existing.Type = "customer"
+	s.store.On("GetEntityWithCredentials", mock.Anything, existing.ID).Return(&entityWithCredentials{
+		Entity:            existing,
+		SchemaCredentials: json.RawMessage(`{"password":[{"value":"hash"}]}`),
+	}, nil)
+
+	_, err := svc.UpdateEntity(s.ctx, existing.ID, testEntity("policy-3"))
+	s.ErrorIs(err, ErrCredentialPolicyViolation)
+	s.store.AssertNotCalled(s.T(), "UpdateEntity", mock.Anything, mock.Anything)
+}
+
+func (s *ServiceTestSuite) TestDeleteCredentials_Success() {
+	svc := s.newCredentialPolicyService(&entitytype.CredentialPolicy{Alternatives: [][]string{{"password", "passkey"}}})
+	e := testEntity("policy-4")
+	s.store.On("GetEntityWithCredentials", mock.Anything, e.ID).Return(&entityWithCredentials{
+		Entity:            e,
+		SchemaCredentials: json.RawMessage(`{"password":[{"value":"hash"}]}`),
+		SystemCredentials: json.RawMessage(`{"passkey":[{"value":"key"}]}`),
+	}, nil)
+	s.store.On("UpdateSystemCredentials", mock.Anything, e.ID, json.RawMessage(`{}`)).Return(nil)
+
+	s.NoError(svc.DeleteCredentials(s.ctx, e.ID, []string{"passkey"}))
+	s.store.AssertNotCalled(s.T(), "UpdateCredentials", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (s *ServiceTestSuite) TestDeleteCredentials_LastRequiredFactor() {
+	svc := s.newCredentialPolicyService(&entitytype.CredentialPolicy{Alternatives: [][]string{{"password", "passkey"}}})
+	e := testEntity("policy-5")
+	s.store.On("GetEntityWithCredentials", mock.Anything, e.ID).Return(&entityWithCredentials{
+		Entity:            e,
+		SystemCredentials: json.RawMessage(`{"passkey":[{"value":"key"}]}`),
+	}, nil)
+
+	err := svc.DeleteCredentials(s.ctx, e.ID, []string{"passkey"})
+	s.ErrorIs(err, ErrCredentialPolicyViolation)
+	s.store.AssertNotCalled(s.T(), "UpdateSystemCredentials", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (s *ServiceTestSuite) TestDeleteCredentials_NotHeld() {
+	e := testEntity("policy-6")
+	s.store.On("GetEntityWithCredentials", mock.Anything, e.ID).Return(&entityWithCredentials{
+		Entity:            e,
+		SchemaCredentials: json.RawMessage(`{"password":[{"value":"hash"}]}`),
+	}, nil)
+
+	err := s.svc.DeleteCredentials(s.ctx, e.ID, []string{"passkey"})
+	s.ErrorIs(err, ErrCredentialNotFound)
+}
+
+func (s *ServiceTestSuite) TestEvaluateCredentialPolicy() {
+	svc := s.newCredentialPolicyService(&entitytype.CredentialPolicy{Required: []string{"password", "passkey"}})
+	e := testEntity("policy-7")
+	s.store.On("GetEntityWithCredentials", mock.Anything, e.ID).Return(&entityWithCredentials{
+		Entity:            e,
+		SchemaCredentials: json.RawMessage(`{"password":[{"value":"hash"}]}`),
+	}, nil)
+
+	evaluation, err := svc.EvaluateCredentialPolicy(s.ctx, e.ID)
+	s.NoError(err)
+	s.False(evaluation.Satisfied)
+	s.Equal([]string{"passkey"}, evaluation.MissingRequired)
+}
+
+func (s *ServiceTestSuite) TestGetIndexedAttributes_ReturnsSortedNames() {
+	s.store.On("GetIndexedAttributes").Return(map[string]bool{"username": true, "email": true, "clientId": true})
+
+	s.Equal([]string{"clientId", "email", "username"}, s.svc.GetIndexedAttributes())
+}
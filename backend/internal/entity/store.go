@@ -27,6 +27,8 @@ import (
 
 	"github.com/thunder-id/thunderid/internal/system/config"
 	serverconst "github.com/thunder-id/thunderid/internal/system/constants"
+	sysContext "github.com/thunder-id/thunderid/internal/system/context"
+	"github.com/thunder-id/thunderid/internal/system/cryptolab/hash"
 	dbmodel "github.com/thunder-id/thunderid/internal/system/database/model"
 	"github.com/thunder-id/thunderid/internal/system/database/provider"
 	"github.com/thunder-id/thunderid/internal/system/log"
@@ -52,6 +54,8 @@ type entityStoreInterface interface {
 
 	// Query
 	IdentifyEntity(ctx context.Context, filters map[string]interface{}) (*string, error)
+	IdentifyEntityInScope(ctx context.Context, filters map[string]interface{},
+		scope identifierScope) (*string, error)
 	SearchEntities(ctx context.Context, filters map[string]interface{}) ([]Entity, error)
 	GetEntityListCount(ctx context.Context, category string,
 		filters map[string]interface{}) (int, error)
@@ -64,6 +68,9 @@ type entityStoreInterface interface {
 	ValidateEntityIDs(ctx context.Context, entityIDs []string) ([]string, error)
 	GetEntitiesByIDs(ctx context.Context, entityIDs []string) ([]Entity, error)
 	ValidateEntityIDsInOUs(ctx context.Context, entityIDs []string, ouIDs []string) ([]string, error)
+	GetCredentialAlgorithmCounts(ctx context.Context, category string) (map[hash.CredAlgorithm]int, error)
+	CountDuplicateAttributeValues(ctx context.Context, category, entityType, attribute string,
+		perOU bool) (int, error)
 
 	// Groups
 	GetGroupCountForEntity(ctx context.Context, entityID string) (int, error)
@@ -135,6 +142,7 @@ func (es *entityDBStore) LoadIndexedAttributes(attributes []string) error {
 // CreateEntity creates a new entity in the database.
 func (es *entityDBStore) CreateEntity(ctx context.Context, entity Entity,
 	credentials json.RawMessage, systemCredentials json.RawMessage) error {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, es.deploymentID)
 	dbClient, err := es.dbProvider.GetUserDBClient()
 	if err != nil {
 		return fmt.Errorf("failed to get database client: %w", err)
@@ -165,7 +173,7 @@ func (es *entityDBStore) CreateEntity(ctx context.Context, entity Entity,
 		ctx,
 		QueryCreateEntity,
 		entity.ID,
-		es.deploymentID,
+		deploymentID,
 		string(entity.Category),
 		entity.Type,
 		string(entity.State),
@@ -191,12 +199,13 @@ func (es *entityDBStore) CreateEntity(ctx context.Context, entity Entity,
 
 // GetEntity retrieves an entity by ID (without credentials).
 func (es *entityDBStore) GetEntity(ctx context.Context, id string) (Entity, error) {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, es.deploymentID)
 	dbClient, err := es.dbProvider.GetUserDBClient()
 	if err != nil {
 		return Entity{}, fmt.Errorf("failed to get database client: %w", err)
 	}
 
-	results, err := dbClient.QueryContext(ctx, QueryGetEntityByID, id, es.deploymentID)
+	results, err := dbClient.QueryContext(ctx, QueryGetEntityByID, id, deploymentID)
 	if err != nil {
 		return Entity{}, fmt.Errorf("failed to execute query: %w", err)
 	}
@@ -215,12 +224,13 @@ func (es *entityDBStore) GetEntity(ctx context.Context, id string) (Entity, erro
 // GetEntityWithCredentials retrieves an entity with all credential columns.
 func (es *entityDBStore) GetEntityWithCredentials(ctx context.Context, id string) (
 	*entityWithCredentials, error) {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, es.deploymentID)
 	dbClient, err := es.dbProvider.GetUserDBClient()
 	if err != nil {
 		return nil, fmt.Errorf("failed to get database client: %w", err)
 	}
 
-	results, err := dbClient.QueryContext(ctx, QueryGetEntityWithCredentials, id, es.deploymentID)
+	results, err := dbClient.QueryContext(ctx, QueryGetEntityWithCredentials, id, deploymentID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to execute query: %w", err)
 	}
@@ -248,6 +258,7 @@ func (es *entityDBStore) GetEntityWithCredentials(ctx context.Context, id string
 
 // UpdateEntity fully updates an entity including system attributes, and re-syncs all identifiers.
 func (es *entityDBStore) UpdateEntity(ctx context.Context, entity *Entity) error {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, es.deploymentID)
 	dbClient, err := es.dbProvider.GetUserDBClient()
 	if err != nil {
 		return fmt.Errorf("failed to get database client: %w", err)
@@ -267,7 +278,7 @@ func (es *entityDBStore) UpdateEntity(ctx context.Context, entity *Entity) error
 		ctx,
 		QueryUpdateEntity,
 		entity.ID, entity.OUID, entity.Type,
-		string(entity.State), string(attributes), systemAttrs, time.Now().UTC(), es.deploymentID,
+		string(entity.State), string(attributes), systemAttrs, time.Now().UTC(), deploymentID,
 	)
 	if err != nil {
 		return fmt.Errorf("failed to execute update entity query: %w", err)
@@ -285,7 +296,7 @@ func (es *entityDBStore) UpdateEntity(ctx context.Context, entity *Entity) error
 		return fmt.Errorf("failed to reload entity for identifier sync: %w", err)
 	}
 
-	_, err = dbClient.ExecuteContext(ctx, QueryDeleteIdentifiersByEntity, entity.ID, es.deploymentID)
+	_, err = dbClient.ExecuteContext(ctx, QueryDeleteIdentifiersByEntity, entity.ID, deploymentID)
 	if err != nil {
 		return fmt.Errorf("failed to delete identifiers: %w", err)
 	}
@@ -300,13 +311,14 @@ func (es *entityDBStore) UpdateEntity(ctx context.Context, entity *Entity) error
 
 // UpdateAttributes updates only the schema attributes of an entity and re-syncs attribute-sourced identifiers.
 func (es *entityDBStore) UpdateAttributes(ctx context.Context, entityID string, attributes json.RawMessage) error {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, es.deploymentID)
 	dbClient, err := es.dbProvider.GetUserDBClient()
 	if err != nil {
 		return fmt.Errorf("failed to get database client: %w", err)
 	}
 
 	rowsAffected, err := dbClient.ExecuteContext(ctx, QueryUpdateAttributes,
-		entityID, string(attributes), time.Now().UTC(), es.deploymentID)
+		entityID, string(attributes), time.Now().UTC(), deploymentID)
 	if err != nil {
 		return fmt.Errorf("failed to execute update attributes query: %w", err)
 	}
@@ -316,7 +328,7 @@ func (es *entityDBStore) UpdateAttributes(ctx context.Context, entityID string,
 	}
 
 	if _, err = dbClient.ExecuteContext(ctx, QueryDeleteAttributeIdentifiersByEntity,
-		entityID, es.deploymentID); err != nil {
+		entityID, deploymentID); err != nil {
 		return fmt.Errorf("failed to delete attribute identifiers: %w", err)
 	}
 
@@ -330,13 +342,14 @@ func (es *entityDBStore) UpdateAttributes(ctx context.Context, entityID string,
 // UpdateSystemAttributes updates the system attributes of an entity and re-syncs system-sourced identifiers.
 func (es *entityDBStore) UpdateSystemAttributes(ctx context.Context, entityID string,
 	attrs json.RawMessage) error {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, es.deploymentID)
 	dbClient, err := es.dbProvider.GetUserDBClient()
 	if err != nil {
 		return fmt.Errorf("failed to get database client: %w", err)
 	}
 
 	rowsAffected, err := dbClient.ExecuteContext(ctx, QueryUpdateSystemAttributes,
-		entityID, string(attrs), time.Now().UTC(), es.deploymentID)
+		entityID, string(attrs), time.Now().UTC(), deploymentID)
 	if err != nil {
 		return fmt.Errorf("failed to execute query: %w", err)
 	}
@@ -346,7 +359,7 @@ func (es *entityDBStore) UpdateSystemAttributes(ctx context.Context, entityID st
 	}
 
 	if _, err = dbClient.ExecuteContext(ctx, QueryDeleteSystemIdentifiersByEntity,
-		entityID, es.deploymentID); err != nil {
+		entityID, deploymentID); err != nil {
 		return fmt.Errorf("failed to delete system identifiers: %w", err)
 	}
 
@@ -360,13 +373,14 @@ func (es *entityDBStore) UpdateSystemAttributes(ctx context.Context, entityID st
 // UpdateCredentials updates the credentials of an entity.
 func (es *entityDBStore) UpdateCredentials(ctx context.Context, entityID string,
 	creds json.RawMessage) error {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, es.deploymentID)
 	dbClient, err := es.dbProvider.GetUserDBClient()
 	if err != nil {
 		return fmt.Errorf("failed to get database client: %w", err)
 	}
 
 	rowsAffected, err := dbClient.ExecuteContext(ctx, QueryUpdateCredentials,
-		entityID, string(creds), time.Now().UTC(), es.deploymentID)
+		entityID, string(creds), time.Now().UTC(), deploymentID)
 	if err != nil {
 		return fmt.Errorf("failed to execute query: %w", err)
 	}
@@ -381,13 +395,14 @@ func (es *entityDBStore) UpdateCredentials(ctx context.Context, entityID string,
 // UpdateSystemCredentials updates the system credentials of an entity.
 func (es *entityDBStore) UpdateSystemCredentials(ctx context.Context, entityID string,
 	creds json.RawMessage) error {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, es.deploymentID)
 	dbClient, err := es.dbProvider.GetUserDBClient()
 	if err != nil {
 		return fmt.Errorf("failed to get database client: %w", err)
 	}
 
 	rowsAffected, err := dbClient.ExecuteContext(ctx, QueryUpdateSystemCredentials,
-		entityID, string(creds), time.Now().UTC(), es.deploymentID)
+		entityID, string(creds), time.Now().UTC(), deploymentID)
 	if err != nil {
 		return fmt.Errorf("failed to execute query: %w", err)
 	}
@@ -401,12 +416,13 @@ func (es *entityDBStore) UpdateSystemCredentials(ctx context.Context, entityID s
 
 // DeleteEntity deletes an entity and its indexed identifiers from the database.
 func (es *entityDBStore) DeleteEntity(ctx context.Context, id string) error {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, es.deploymentID)
 	dbClient, err := es.dbProvider.GetUserDBClient()
 	if err != nil {
 		return fmt.Errorf("failed to get database client: %w", err)
 	}
 
-	rowsAffected, err := dbClient.ExecuteContext(ctx, QueryDeleteEntity, id, es.deploymentID)
+	rowsAffected, err := dbClient.ExecuteContext(ctx, QueryDeleteEntity, id, deploymentID)
 	if err != nil {
 		return fmt.Errorf("failed to execute query: %w", err)
 	}
@@ -415,7 +431,7 @@ func (es *entityDBStore) DeleteEntity(ctx context.Context, id string) error {
 		return ErrEntityNotFound
 	}
 
-	if _, err = dbClient.ExecuteContext(ctx, QueryDeleteIdentifiersByEntity, id, es.deploymentID); err != nil {
+	if _, err = dbClient.ExecuteContext(ctx, QueryDeleteIdentifiersByEntity, id, deploymentID); err != nil {
 		return fmt.Errorf("failed to delete entity identifiers: %w", err)
 	}
 
@@ -427,7 +443,8 @@ func (es *entityDBStore) DeleteEntity(ctx context.Context, id string) error {
 func (es *entityDBStore) syncAttributeIdentifiers(ctx context.Context, entityID string,
 	attributes json.RawMessage, systemAttributes json.RawMessage,
 	indexedAttrs map[string]bool) error {
-	query, args, err := prepareIdentifierQuery(entityID, attributes, systemAttributes, indexedAttrs, es.deploymentID)
+	deploymentID := sysContext.ScopeDeploymentID(ctx, es.deploymentID)
+	query, args, err := prepareIdentifierQuery(entityID, attributes, systemAttributes, indexedAttrs, deploymentID)
 	if err != nil {
 		return err
 	}
@@ -451,6 +468,8 @@ func (es *entityDBStore) syncAttributeIdentifiers(ctx context.Context, entityID
 // IdentifyEntity identifies an entity with the given filters.
 func (es *entityDBStore) IdentifyEntity(ctx context.Context,
 	filters map[string]interface{}) (*string, error) {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, es.deploymentID)
+	includeDeleted := includesDeletedIdentifiers(ctx)
 	dbClient, err := es.dbProvider.GetUserDBClient()
 	if err != nil {
 		return nil, fmt.Errorf("failed to get database client: %w", err)
@@ -459,7 +478,7 @@ func (es *entityDBStore) IdentifyEntity(ctx context.Context,
 	// Fast path: try indexed identifier store first for all lookups.
 	// This covers both schema-indexed attributes (email, username) and
 	// system identifiers without requiring config.
-	identifyQuery, args, err := buildIdentifyQueryFromIdentifiers(filters, es.deploymentID)
+	identifyQuery, args, err := buildIdentifyQueryFromIdentifiers(filters, includeDeleted, deploymentID)
 	if err == nil {
 		results, qErr := dbClient.QueryContext(ctx, identifyQuery, args...)
 		if qErr == nil && len(results) == 1 {
@@ -486,14 +505,15 @@ func (es *entityDBStore) IdentifyEntity(ctx context.Context,
 
 	if len(indexedFilters) > 0 && len(nonIndexedFilters) > 0 {
 		// Mixed: identifier table for indexed filters + JSON for non-indexed filters.
-		fallbackQuery, fallbackArgs, err = buildIdentifyQueryHybrid(indexedFilters, nonIndexedFilters, es.deploymentID)
+		fallbackQuery, fallbackArgs, err = buildIdentifyQueryHybrid(indexedFilters, nonIndexedFilters,
+			includeDeleted, deploymentID)
 		if err != nil {
 			return nil, fmt.Errorf("failed to build hybrid query: %w", err)
 		}
 	} else {
 		// All-indexed: fast path already tried the identifier table; fall back to JSON search.
 		// All non-indexed: always use JSON search.
-		fallbackQuery, fallbackArgs, err = buildIdentifyQuery(filters, es.deploymentID)
+		fallbackQuery, fallbackArgs, err = buildIdentifyQuery(filters, includeDeleted, deploymentID)
 		if err != nil {
 			return nil, fmt.Errorf("failed to build identify query: %w", err)
 		}
@@ -531,19 +551,93 @@ func (es *entityDBStore) IdentifyEntity(ctx context.Context,
 	return &entityID, nil
 }
 
+// IdentifyEntityInScope identifies an entity with the given filters, restricted to the given scope.
+func (es *entityDBStore) IdentifyEntityInScope(ctx context.Context,
+	filters map[string]interface{}, scope identifierScope) (*string, error) {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, es.deploymentID)
+	dbClient, err := es.dbProvider.GetUserDBClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	allIndexed := true
+	for key := range filters {
+		if !es.indexedAttributes[key] {
+			allIndexed = false
+			break
+		}
+	}
+
+	// Fast path: use the indexed identifier store when every filter is indexed.
+	if allIndexed {
+		identifyQuery, args, err := buildScopedIdentifyQuery(filters, scope, true, deploymentID)
+		if err == nil {
+			results, qErr := dbClient.QueryContext(ctx, identifyQuery, args...)
+			if qErr == nil && len(results) == 1 {
+				if entityID, ok := results[0]["id"].(string); ok {
+					return &entityID, nil
+				}
+			}
+		}
+	}
+
+	identifyQuery, args, err := buildScopedIdentifyQuery(filters, scope, false, deploymentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build scoped identify query: %w", err)
+	}
+
+	results, err := dbClient.QueryContext(ctx, identifyQuery, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	if len(results) == 0 {
+		return nil, ErrEntityNotFound
+	}
+	if len(results) != 1 {
+		return nil, ErrAmbiguousEntity
+	}
+
+	entityID, ok := results[0]["id"].(string)
+	if !ok {
+		return nil, fmt.Errorf("failed to parse id as string")
+	}
+
+	return &entityID, nil
+}
+
+// CountDuplicateAttributeValues returns the number of attribute values shared by more than one entity of the
+// given type. When perOU is set, values are only considered duplicates within the same organization unit.
+func (es *entityDBStore) CountDuplicateAttributeValues(ctx context.Context,
+	category, entityType, attribute string, perOU bool) (int, error) {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, es.deploymentID)
+	dbClient, err := es.dbProvider.GetUserDBClient()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	query, args, err := buildCountDuplicateAttributeValuesQuery(category, entityType, attribute, perOU, deploymentID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to build duplicate count query: %w", err)
+	}
+
+	return executeCountQuery(dbClient, ctx, query, args)
+}
+
 // SearchEntities searches for all entities matching the provided filters.
 // Unlike IdentifyEntity, this returns all matching entities instead of erroring on ambiguity.
 // Results are capped at MaxPageSize (100) entries; matches beyond that limit are not returned.
 // Column-level filters (category, ouId) should be handled at the service layer.
 func (es *entityDBStore) SearchEntities(ctx context.Context,
 	filters map[string]interface{}) ([]Entity, error) {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, es.deploymentID)
 	dbClient, err := es.dbProvider.GetUserDBClient()
 	if err != nil {
 		return nil, fmt.Errorf("failed to get database client: %w", err)
 	}
 
 	searchQuery, args, err := buildEntityListQuery(
-		"", filters, serverconst.MaxPageSize, 0, es.deploymentID)
+		"", filters, "", false, serverconst.MaxPageSize, 0, deploymentID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to build search query: %w", err)
 	}
@@ -568,12 +662,14 @@ func (es *entityDBStore) GetIndexedAttributes() map[string]bool {
 // GetEntityListCount retrieves the total count of entities by category.
 func (es *entityDBStore) GetEntityListCount(ctx context.Context, category string,
 	filters map[string]interface{}) (int, error) {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, es.deploymentID)
 	dbClient, err := es.dbProvider.GetUserDBClient()
 	if err != nil {
 		return 0, fmt.Errorf("failed to get database client: %w", err)
 	}
 
-	countQuery, args, err := buildEntityCountQuery(category, filters, es.deploymentID)
+	countQuery, args, err := buildEntityCountQuery(category, filters, getApplicationScope(ctx), isDeletedScope(ctx),
+		deploymentID)
 	if err != nil {
 		return 0, fmt.Errorf("failed to build count query: %w", err)
 	}
@@ -581,15 +677,63 @@ func (es *entityDBStore) GetEntityListCount(ctx context.Context, category string
 	return executeCountQuery(dbClient, ctx, countQuery, args)
 }
 
+// GetCredentialAlgorithmCounts counts the stored credential entries of entities in the category by their
+// storage algorithm.
+func (es *entityDBStore) GetCredentialAlgorithmCounts(ctx context.Context,
+	category string) (map[hash.CredAlgorithm]int, error) {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, es.deploymentID)
+	dbClient, err := es.dbProvider.GetUserDBClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	results, err := dbClient.QueryContext(ctx, QueryGetCredentialsByCategory, category, deploymentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	counts := make(map[hash.CredAlgorithm]int)
+	for _, row := range results {
+		countCredentialAlgorithms(counts, parseJSONColumn(row, "credentials"))
+		countCredentialAlgorithms(counts, parseJSONColumn(row, "system_credentials"))
+	}
+	return counts, nil
+}
+
+// countCredentialAlgorithms adds the stored credential entries in the credentials JSON to the counts by
+// storage algorithm. Entries that are not hashed credentials, such as passkeys, are skipped.
+func countCredentialAlgorithms(counts map[hash.CredAlgorithm]int, credentials json.RawMessage) {
+	if len(credentials) == 0 {
+		return
+	}
+	var credsMap map[string]json.RawMessage
+	if err := json.Unmarshal(credentials, &credsMap); err != nil {
+		return
+	}
+	for _, raw := range credsMap {
+		var stored []StoredCredential
+		if err := json.Unmarshal(raw, &stored); err != nil {
+			continue
+		}
+		for _, cred := range stored {
+			if cred.StorageAlgo != "" {
+				counts[cred.StorageAlgo]++
+			}
+		}
+	}
+}
+
 // GetEntityList retrieves a list of entities by category.
 func (es *entityDBStore) GetEntityList(ctx context.Context, category string,
 	limit, offset int, filters map[string]interface{}) ([]Entity, error) {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, es.deploymentID)
 	dbClient, err := es.dbProvider.GetUserDBClient()
 	if err != nil {
 		return nil, fmt.Errorf("failed to get database client: %w", err)
 	}
 
-	listQuery, args, err := buildEntityListQuery(category, filters, limit, offset, es.deploymentID)
+	listQuery, args, err := buildEntityListQuery(category, filters, getApplicationScope(ctx), isDeletedScope(ctx),
+		limit, offset, deploymentID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to build list query: %w", err)
 	}
@@ -605,6 +749,7 @@ func (es *entityDBStore) GetEntityList(ctx context.Context, category string,
 // GetEntityListCountByOUIDs retrieves the total count of entities scoped to OU IDs.
 func (es *entityDBStore) GetEntityListCountByOUIDs(ctx context.Context, category string,
 	ouIDs []string, filters map[string]interface{}) (int, error) {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, es.deploymentID)
 	if len(ouIDs) == 0 {
 		return 0, nil
 	}
@@ -613,7 +758,8 @@ func (es *entityDBStore) GetEntityListCountByOUIDs(ctx context.Context, category
 		return 0, fmt.Errorf("failed to get database client: %w", err)
 	}
 
-	countQuery, args, err := buildEntityCountQueryByOUIDs(category, ouIDs, filters, es.deploymentID)
+	countQuery, args, err := buildEntityCountQueryByOUIDs(category, ouIDs, filters, getApplicationScope(ctx),
+		isDeletedScope(ctx), deploymentID)
 	if err != nil {
 		return 0, fmt.Errorf("failed to build count query: %w", err)
 	}
@@ -624,12 +770,14 @@ func (es *entityDBStore) GetEntityListCountByOUIDs(ctx context.Context, category
 // GetEntityListByOUIDs retrieves a list of entities scoped to OU IDs.
 func (es *entityDBStore) GetEntityListByOUIDs(ctx context.Context, category string,
 	ouIDs []string, limit, offset int, filters map[string]interface{}) ([]Entity, error) {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, es.deploymentID)
 	dbClient, err := es.dbProvider.GetUserDBClient()
 	if err != nil {
 		return nil, fmt.Errorf("failed to get database client: %w", err)
 	}
 
-	listQuery, args, err := buildEntityListQueryByOUIDs(category, ouIDs, filters, limit, offset, es.deploymentID)
+	listQuery, args, err := buildEntityListQueryByOUIDs(category, ouIDs, filters, getApplicationScope(ctx),
+		isDeletedScope(ctx), limit, offset, deploymentID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to build list query: %w", err)
 	}
@@ -644,6 +792,7 @@ func (es *entityDBStore) GetEntityListByOUIDs(ctx context.Context, category stri
 
 // ValidateEntityIDs checks if all provided entity IDs exist.
 func (es *entityDBStore) ValidateEntityIDs(ctx context.Context, entityIDs []string) ([]string, error) {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, es.deploymentID)
 	if len(entityIDs) == 0 {
 		return []string{}, nil
 	}
@@ -653,7 +802,7 @@ func (es *entityDBStore) ValidateEntityIDs(ctx context.Context, entityIDs []stri
 		return nil, fmt.Errorf("failed to get database client: %w", err)
 	}
 
-	query, args, err := buildBulkEntityExistsQuery(entityIDs, es.deploymentID)
+	query, args, err := buildBulkEntityExistsQuery(entityIDs, deploymentID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to build bulk entity exists query: %w", err)
 	}
@@ -682,6 +831,7 @@ func (es *entityDBStore) ValidateEntityIDs(ctx context.Context, entityIDs []stri
 
 // GetEntitiesByIDs retrieves entities by a list of IDs.
 func (es *entityDBStore) GetEntitiesByIDs(ctx context.Context, entityIDs []string) ([]Entity, error) {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, es.deploymentID)
 	const batchSize = 100
 
 	if len(entityIDs) == 0 {
@@ -702,7 +852,7 @@ func (es *entityDBStore) GetEntitiesByIDs(ctx context.Context, entityIDs []strin
 		}
 		chunk := entityIDs[start:end]
 
-		query, args, err := buildGetEntitiesByIDsQuery(chunk, es.deploymentID)
+		query, args, err := buildGetEntitiesByIDsQuery(chunk, deploymentID)
 		if err != nil {
 			return nil, fmt.Errorf("failed to build get entities by IDs query: %w", err)
 		}
@@ -726,6 +876,7 @@ func (es *entityDBStore) GetEntitiesByIDs(ctx context.Context, entityIDs []strin
 func (es *entityDBStore) ValidateEntityIDsInOUs(
 	ctx context.Context, entityIDs []string, ouIDs []string,
 ) ([]string, error) {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, es.deploymentID)
 	if len(entityIDs) == 0 {
 		return []string{}, nil
 	}
@@ -738,7 +889,7 @@ func (es *entityDBStore) ValidateEntityIDsInOUs(
 		return nil, fmt.Errorf("failed to get database client: %w", err)
 	}
 
-	query, args, err := buildBulkEntityExistsQueryInOUs(entityIDs, ouIDs, es.deploymentID)
+	query, args, err := buildBulkEntityExistsQueryInOUs(entityIDs, ouIDs, deploymentID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to build query: %w", err)
 	}
@@ -766,12 +917,13 @@ func (es *entityDBStore) ValidateEntityIDsInOUs(
 
 // GetGroupCountForEntity retrieves the total count of groups an entity belongs to.
 func (es *entityDBStore) GetGroupCountForEntity(ctx context.Context, entityID string) (int, error) {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, es.deploymentID)
 	dbClient, err := es.dbProvider.GetUserDBClient()
 	if err != nil {
 		return 0, fmt.Errorf("failed to get database client: %w", err)
 	}
 
-	countResults, err := dbClient.QueryContext(ctx, QueryGetGroupCountForEntity, entityID, es.deploymentID)
+	countResults, err := dbClient.QueryContext(ctx, QueryGetGroupCountForEntity, entityID, deploymentID)
 	if err != nil {
 		return 0, fmt.Errorf("failed to get group count for entity: %w", err)
 	}
@@ -789,13 +941,14 @@ func (es *entityDBStore) GetGroupCountForEntity(ctx context.Context, entityID st
 // GetEntityGroups retrieves groups that an entity belongs to with pagination.
 func (es *entityDBStore) GetEntityGroups(
 	ctx context.Context, entityID string, limit, offset int) ([]EntityGroup, error) {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, es.deploymentID)
 	dbClient, err := es.dbProvider.GetUserDBClient()
 	if err != nil {
 		return nil, fmt.Errorf("failed to get database client: %w", err)
 	}
 
 	results, err := dbClient.QueryContext(ctx, QueryGetGroupsForEntity,
-		entityID, limit, offset, es.deploymentID)
+		entityID, limit, offset, deploymentID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get groups for entity: %w", err)
 	}
@@ -815,13 +968,14 @@ func (es *entityDBStore) GetEntityGroups(
 // GetTransitiveEntityGroups retrieves all groups an entity belongs to, including nested group membership.
 func (es *entityDBStore) GetTransitiveEntityGroups(
 	ctx context.Context, entityID string) ([]EntityGroup, error) {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, es.deploymentID)
 	dbClient, err := es.dbProvider.GetUserDBClient()
 	if err != nil {
 		return nil, fmt.Errorf("failed to get database client: %w", err)
 	}
 
 	results, err := dbClient.QueryContext(ctx, QueryGetTransitiveGroupsForEntity,
-		entityID, es.deploymentID)
+		entityID, deploymentID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get transitive groups for entity: %w", err)
 	}
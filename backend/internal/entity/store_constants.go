@@ -38,23 +38,34 @@ const (
 	MaxIndexedAttributesCount = 20
 )
 
+const (
+	// activeStateClause is the condition leaving soft-deleted entities out of entity listings.
+	activeStateClause = ` AND STATE <> '` + string(EntityStateDeleted) + `'`
+	// deletedStateClause is the condition limiting entity listings to soft-deleted entities.
+	deletedStateClause = ` AND STATE = '` + string(EntityStateDeleted) + `'`
+	// activeEntityStateClause is the condition leaving soft-deleted entities out of identify queries that
+	// alias the entity table as e.
+	activeEntityStateClause = ` AND e.STATE <> '` + string(EntityStateDeleted) + `'`
+)
+
 var (
 	// QueryGetEntityCount is the query to get total count of entities by category.
 	QueryGetEntityCount = model.DBQuery{
-		ID:    "ASQ-ENTITY_MGT-01",
-		Query: `SELECT COUNT(*) as total FROM "ENTITY" WHERE CATEGORY = $1 AND DEPLOYMENT_ID = $2`,
+		ID: "ASQ-ENTITY_MGT-01",
+		Query: `SELECT COUNT(*) as total FROM "ENTITY" WHERE CATEGORY = $1 AND DEPLOYMENT_ID = $2` +
+			activeStateClause,
 	}
 	// QueryGetEntityList is the query to get a list of entities by category.
 	QueryGetEntityList = model.DBQuery{
 		ID: "ASQ-ENTITY_MGT-02",
 		Query: `SELECT ID, OU_ID, CATEGORY, TYPE, STATE, ATTRIBUTES, SYSTEM_ATTRIBUTES FROM "ENTITY" ` +
-			`WHERE CATEGORY = $4 AND DEPLOYMENT_ID = $3 ORDER BY ID LIMIT $1 OFFSET $2`,
+			`WHERE CATEGORY = $4 AND DEPLOYMENT_ID = $3` + activeStateClause + ` ORDER BY ID LIMIT $1 OFFSET $2`,
 	}
 	// QuerySearchEntityList is the query to search entities across all categories.
 	QuerySearchEntityList = model.DBQuery{
 		ID: "ASQ-ENTITY_MGT-03",
 		Query: `SELECT ID, OU_ID, CATEGORY, TYPE, STATE, ATTRIBUTES, SYSTEM_ATTRIBUTES FROM "ENTITY" ` +
-			`WHERE DEPLOYMENT_ID = $3 ORDER BY ID LIMIT $1 OFFSET $2`,
+			`WHERE DEPLOYMENT_ID = $3` + activeStateClause + ` ORDER BY ID LIMIT $1 OFFSET $2`,
 	}
 	// QueryCreateEntity is the query to create a new entity.
 	QueryCreateEntity = model.DBQuery{
@@ -161,6 +172,11 @@ var (
 		ID:    "ASQ-ENTITY_MGT-19",
 		Query: `DELETE FROM "ENTITY_IDENTIFIER" WHERE ENTITY_ID = $1 AND DEPLOYMENT_ID = $2 AND SOURCE = 'system'`,
 	}
+	// QueryGetCredentialsByCategory is the query to get the credential columns of all entities in a category.
+	QueryGetCredentialsByCategory = model.DBQuery{
+		ID:    "ASQ-ENTITY_MGT-30",
+		Query: `SELECT CREDENTIALS, SYSTEM_CREDENTIALS FROM "ENTITY" WHERE CATEGORY = $1 AND DEPLOYMENT_ID = $2`,
+	}
 )
 
 // appendOUIDsINClause appends an "AND OU_ID IN (...)" condition to a query for the given OU IDs.
@@ -202,9 +218,46 @@ func appendOUIDsINClause(
 	}, args
 }
 
+// appendApplicationScopeClause appends a condition limiting a query to the users associated with the given
+// application. The query is returned unchanged when appID is empty.
+func appendApplicationScopeClause(
+	query model.DBQuery, args []interface{}, appID string,
+) (model.DBQuery, []interface{}) {
+	if appID == "" {
+		return query, args
+	}
+
+	scopeClause := ` AND ID IN (SELECT USER_ID FROM "APPLICATION_USER" WHERE APPLICATION_ID = %s)`
+	scopeClausePostgres := fmt.Sprintf(scopeClause, fmt.Sprintf("$%d", len(args)+1))
+	scopeClauseSQLite := fmt.Sprintf(scopeClause, "?")
+
+	return model.DBQuery{
+		ID:            query.ID,
+		Query:         query.Query + scopeClausePostgres,
+		PostgresQuery: query.PostgresQuery + scopeClausePostgres,
+		SQLiteQuery:   query.SQLiteQuery + scopeClauseSQLite,
+	}, append(args, appID)
+}
+
+// appendStateClause appends the condition limiting a query to the soft-deleted entities when deleted is set,
+// or leaving them out otherwise.
+func appendStateClause(query model.DBQuery, deleted bool) model.DBQuery {
+	clause := activeStateClause
+	if deleted {
+		clause = deletedStateClause
+	}
+	return model.DBQuery{
+		ID:            query.ID,
+		Query:         query.Query + clause,
+		PostgresQuery: query.PostgresQuery + clause,
+		SQLiteQuery:   query.SQLiteQuery + clause,
+	}
+}
+
 // buildEntityCountQueryByOUIDs constructs a count query scoped to a list of organization unit IDs.
 func buildEntityCountQueryByOUIDs(
-	category string, ouIDs []string, filters map[string]interface{}, deploymentID string,
+	category string, ouIDs []string, filters map[string]interface{}, appID string, deleted bool,
+	deploymentID string,
 ) (model.DBQuery, []interface{}, error) {
 	queryID := "ASQ-ENTITY_MGT-20"
 	baseQuery := `SELECT COUNT(*) as total FROM "ENTITY" WHERE CATEGORY = $1`
@@ -217,6 +270,8 @@ func buildEntityCountQueryByOUIDs(
 		}
 		args = append(args, filterArgs...)
 		fq, args = appendOUIDsINClause(fq, args, ouIDs)
+		fq = appendStateClause(fq, deleted)
+		fq, args = appendApplicationScopeClause(fq, args, appID)
 		fq, args = utils.AppendDeploymentIDToFilterQuery(fq, args, deploymentID)
 		return fq, args, nil
 	}
@@ -229,13 +284,16 @@ func buildEntityCountQueryByOUIDs(
 	}
 
 	query, args = appendOUIDsINClause(query, args, ouIDs)
+	query = appendStateClause(query, deleted)
+	query, args = appendApplicationScopeClause(query, args, appID)
 	query, args = utils.AppendDeploymentIDToFilterQuery(query, args, deploymentID)
 	return query, args, nil
 }
 
 // buildEntityListQueryByOUIDs constructs a paginated list query scoped to a list of organization unit IDs.
 func buildEntityListQueryByOUIDs(
-	category string, ouIDs []string, filters map[string]interface{}, limit, offset int, deploymentID string,
+	category string, ouIDs []string, filters map[string]interface{}, appID string, deleted bool,
+	limit, offset int, deploymentID string,
 ) (model.DBQuery, []interface{}, error) {
 	queryID := "ASQ-ENTITY_MGT-21"
 	baseQuery := `SELECT ID, OU_ID, CATEGORY, TYPE, STATE, ATTRIBUTES, SYSTEM_ATTRIBUTES ` +
@@ -250,6 +308,8 @@ func buildEntityListQueryByOUIDs(
 		}
 		args = append(args, filterArgs...)
 		fq, args = appendOUIDsINClause(fq, args, ouIDs)
+		fq = appendStateClause(fq, deleted)
+		fq, args = appendApplicationScopeClause(fq, args, appID)
 		fq, args = utils.AppendDeploymentIDToFilterQuery(fq, args, deploymentID)
 		query = fq
 	} else {
@@ -260,6 +320,8 @@ func buildEntityListQueryByOUIDs(
 			SQLiteQuery:   strings.Replace(baseQuery, "$1", "?", 1),
 		}
 		query, args = appendOUIDsINClause(query, args, ouIDs)
+		query = appendStateClause(query, deleted)
+		query, args = appendApplicationScopeClause(query, args, appID)
 		query, args = utils.AppendDeploymentIDToFilterQuery(query, args, deploymentID)
 	}
 
@@ -282,17 +344,44 @@ func buildEntityListQueryByOUIDs(
 	}, args, nil
 }
 
+// arrayElementKeyMarker separates the path of an array attribute from the field of its items in a filter
+// key such as "emails[].value".
+const arrayElementKeyMarker = "[]."
+
+// splitArrayElementKey splits an array element filter key into the path of the array and the field of its
+// items. It returns false when the key is not an array element key.
+func splitArrayElementKey(key string) (arrayPath, field string, ok bool) {
+	return strings.Cut(key, arrayElementKeyMarker)
+}
+
+// validateFilterKey validates an attribute filter key. Both parts of an array element key are validated.
+func validateFilterKey(key string) error {
+	if arrayPath, field, ok := splitArrayElementKey(key); ok {
+		if arrayPath == "" || field == "" {
+			return fmt.Errorf("array element key '%s' must name both an array and a field", key)
+		}
+		if err := utils.ValidateKey(arrayPath); err != nil {
+			return err
+		}
+		return utils.ValidateKey(field)
+	}
+	return utils.ValidateKey(key)
+}
+
 // buildIdentifyQuery constructs a query to identify an entity based on the provided filters.
 // It searches both ATTRIBUTES and SYSTEM_ATTRIBUTES columns so that any entity can be found
-// regardless of which column holds the filter key.
-func buildIdentifyQuery(filters map[string]interface{}, deploymentID string) (model.DBQuery, []interface{}, error) {
+// regardless of which column holds the filter key. Soft-deleted entities are matched only when
+// includeDeleted is set.
+func buildIdentifyQuery(
+	filters map[string]interface{}, includeDeleted bool, deploymentID string,
+) (model.DBQuery, []interface{}, error) {
 	if len(filters) == 0 {
 		return model.DBQuery{}, nil, fmt.Errorf("filters cannot be empty")
 	}
 
 	keys := make([]string, 0, len(filters))
 	for key := range filters {
-		if err := utils.ValidateKey(key); err != nil {
+		if err := validateFilterKey(key); err != nil {
 			return model.DBQuery{}, nil, fmt.Errorf("invalid filter key: %w", err)
 		}
 		keys = append(keys, key)
@@ -310,6 +399,10 @@ func buildIdentifyQuery(filters map[string]interface{}, deploymentID string) (mo
 		args = append(args, filters[key])
 	}
 
+	if !includeDeleted {
+		pgQuery += activeStateClause
+		sqQuery += activeStateClause
+	}
 	pgQuery += fmt.Sprintf(" AND DEPLOYMENT_ID = $%d", len(keys)+1)
 	sqQuery += " AND DEPLOYMENT_ID = ?"
 	args = append(args, deploymentID)
@@ -415,12 +508,13 @@ func buildBulkEntityExistsQueryInOUs(
 
 // buildEntityListQuery constructs a query to get entities with optional filtering.
 func buildEntityListQuery(
-	category string, filters map[string]interface{}, limit, offset int, deploymentID string,
+	category string, filters map[string]interface{}, appID string, deleted bool, limit, offset int,
+	deploymentID string,
 ) (model.DBQuery, []interface{}, error) {
 	baseQuery := `SELECT ID, OU_ID, CATEGORY, TYPE, STATE, ATTRIBUTES, SYSTEM_ATTRIBUTES FROM "ENTITY"`
 	queryID := "ASQ-ENTITY_MGT-25"
 
-	if len(filters) > 0 {
+	if len(filters) > 0 || appID != "" || deleted {
 		var baseWithCategory string
 		var args []interface{}
 		if category != "" {
@@ -435,6 +529,8 @@ func buildEntityListQuery(
 			return model.DBQuery{}, nil, err
 		}
 		args = append(args, fArgs...)
+		fq = appendStateClause(fq, deleted)
+		fq, args = appendApplicationScopeClause(fq, args, appID)
 		fq, args = utils.AppendDeploymentIDToFilterQuery(fq, args, deploymentID)
 
 		postgresQuery, err := buildPaginatedQuery(fq.PostgresQuery, len(args), "$")
@@ -466,12 +562,12 @@ func buildEntityListQuery(
 
 // buildEntityCountQuery constructs a query to count entities with optional filtering.
 func buildEntityCountQuery(
-	category string, filters map[string]interface{}, deploymentID string,
+	category string, filters map[string]interface{}, appID string, deleted bool, deploymentID string,
 ) (model.DBQuery, []interface{}, error) {
 	baseQuery := `SELECT COUNT(*) as total FROM "ENTITY"`
 	queryID := "ASQ-ENTITY_MGT-26"
 
-	if len(filters) > 0 {
+	if len(filters) > 0 || appID != "" || deleted {
 		baseWithCategory := baseQuery + " WHERE CATEGORY = $1"
 		args := []interface{}{category}
 		fq, fArgs, err := buildFilterQueryWithOffset(queryID, baseWithCategory, filters, len(args))
@@ -479,6 +575,8 @@ func buildEntityCountQuery(
 			return model.DBQuery{}, nil, err
 		}
 		args = append(args, fArgs...)
+		fq = appendStateClause(fq, deleted)
+		fq, args = appendApplicationScopeClause(fq, args, appID)
 		fq, args = utils.AppendDeploymentIDToFilterQuery(fq, args, deploymentID)
 		return fq, args, nil
 	}
@@ -487,9 +585,10 @@ func buildEntityCountQuery(
 }
 
 // buildIdentifyQueryFromIdentifiers constructs a query to identify
-// an entity using only indexed identifiers.
+// an entity using only indexed identifiers. Unless includeDeleted is set, the entity table is
+// joined to leave soft-deleted entities out.
 func buildIdentifyQueryFromIdentifiers(
-	filters map[string]interface{}, deploymentID string,
+	filters map[string]interface{}, includeDeleted bool, deploymentID string,
 ) (model.DBQuery, []interface{}, error) {
 	if len(filters) == 0 {
 		return model.DBQuery{}, nil, fmt.Errorf("filters cannot be empty")
@@ -529,9 +628,17 @@ func buildIdentifyQueryFromIdentifiers(
 		paramIndex += 2
 	}
 
-	pgQueryString := pgBase + " WHERE " + strings.Join(pgConditions, " AND ") +
+	stateClause := ""
+	if !includeDeleted {
+		entityJoin := ` INNER JOIN "ENTITY" e ON e.ID = ia1.ENTITY_ID AND e.DEPLOYMENT_ID = ia1.DEPLOYMENT_ID`
+		pgBase += entityJoin
+		sqBase += entityJoin
+		stateClause = activeEntityStateClause
+	}
+
+	pgQueryString := pgBase + " WHERE " + strings.Join(pgConditions, " AND ") + stateClause +
 		fmt.Sprintf(" AND ia1.DEPLOYMENT_ID = $%d", paramIndex)
-	sqQueryString := sqBase + " WHERE " + strings.Join(sqConditions, " AND ") +
+	sqQueryString := sqBase + " WHERE " + strings.Join(sqConditions, " AND ") + stateClause +
 		" AND ia1.DEPLOYMENT_ID = ?"
 	args = append(args, deploymentID)
 
@@ -544,10 +651,11 @@ func buildIdentifyQueryFromIdentifiers(
 }
 
 // buildIdentifyQueryHybrid constructs a query using indexed identifiers
-// for initial filtering, then JSON attributes for remaining filters.
+// for initial filtering, then JSON attributes for remaining filters. Soft-deleted entities are
+// matched only when includeDeleted is set.
 func buildIdentifyQueryHybrid(
 	indexedFilters, nonIndexedFilters map[string]interface{},
-	deploymentID string,
+	includeDeleted bool, deploymentID string,
 ) (model.DBQuery, []interface{}, error) {
 	if len(indexedFilters) == 0 {
 		return model.DBQuery{}, nil, fmt.Errorf("indexed filters cannot be empty for hybrid query")
@@ -595,7 +703,7 @@ func buildIdentifyQueryHybrid(
 
 	nonIndexedKeys := make([]string, 0, len(nonIndexedFilters))
 	for key := range nonIndexedFilters {
-		if err := utils.ValidateKey(key); err != nil {
+		if err := validateFilterKey(key); err != nil {
 			return model.DBQuery{}, nil, fmt.Errorf("invalid non-indexed filter key: %w", err)
 		}
 		nonIndexedKeys = append(nonIndexedKeys, key)
@@ -610,6 +718,10 @@ func buildIdentifyQueryHybrid(
 		paramIndex++
 	}
 
+	if !includeDeleted {
+		postgresQuery += activeEntityStateClause
+		sqliteQuery += activeEntityStateClause
+	}
 	postgresQuery += fmt.Sprintf(" AND e.DEPLOYMENT_ID = $%d", paramIndex)
 	sqliteQuery += " AND e.DEPLOYMENT_ID = ?"
 	args = append(args, deploymentID)
@@ -624,6 +736,118 @@ func buildIdentifyQueryHybrid(
 	return query, args, nil
 }
 
+// buildScopedIdentifyQuery constructs a query that identifies entities by attribute filters within a scope.
+// When useIdentifiers is set, the filters are matched against the indexed identifiers; otherwise they are
+// matched against the attribute columns. Scoped lookups only serve uniqueness checks, so soft-deleted
+// entities are matched and keep their unique values until they are purged.
+func buildScopedIdentifyQuery(
+	filters map[string]interface{}, scope identifierScope, useIdentifiers bool, deploymentID string,
+) (model.DBQuery, []interface{}, error) {
+	if len(filters) == 0 {
+		return model.DBQuery{}, nil, fmt.Errorf("filters cannot be empty")
+	}
+
+	keys := make([]string, 0, len(filters))
+	for key := range filters {
+		if err := validateFilterKey(key); err != nil {
+			return model.DBQuery{}, nil, fmt.Errorf("invalid filter key: %w", err)
+		}
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	pgQuery := `SELECT DISTINCT e.ID AS id FROM "ENTITY" e`
+	sqQuery := pgQuery
+	pgConditions := ""
+	sqConditions := ""
+	args := make([]interface{}, 0, 2*len(keys)+4)
+	paramIndex := 1
+
+	for i, key := range keys {
+		if !useIdentifiers {
+			pg, sq := buildDualColumnConditions("e.", key, paramIndex)
+			pgConditions += pg
+			sqConditions += sq
+			args = append(args, filters[key])
+			paramIndex++
+			continue
+		}
+		alias := fmt.Sprintf("ia%d", i+1)
+		joinClause := fmt.Sprintf(
+			` INNER JOIN "ENTITY_IDENTIFIER" %s ON e.ID = %s.ENTITY_ID AND e.DEPLOYMENT_ID = %s.DEPLOYMENT_ID`,
+			alias, alias, alias)
+		pgQuery += joinClause
+		sqQuery += joinClause
+		pgConditions += fmt.Sprintf(" AND %s.NAME = $%d AND %s.VALUE = $%d", alias, paramIndex, alias, paramIndex+1)
+		sqConditions += fmt.Sprintf(" AND %s.NAME = ? AND %s.VALUE = ?", alias, alias)
+		args = append(args, key, fmt.Sprintf("%v", filters[key]))
+		paramIndex += 2
+	}
+
+	for _, column := range []struct {
+		name  string
+		value string
+	}{
+		{"CATEGORY", scope.Category},
+		{"OU_ID", scope.OUID},
+		{"TYPE", scope.Type},
+	} {
+		if column.value == "" {
+			continue
+		}
+		pgConditions += fmt.Sprintf(" AND e.%s = $%d", column.name, paramIndex)
+		sqConditions += fmt.Sprintf(" AND e.%s = ?", column.name)
+		args = append(args, column.value)
+		paramIndex++
+	}
+
+	pgQuery += " WHERE 1=1" + pgConditions + fmt.Sprintf(" AND e.DEPLOYMENT_ID = $%d", paramIndex)
+	sqQuery += " WHERE 1=1" + sqConditions + " AND e.DEPLOYMENT_ID = ?"
+	args = append(args, deploymentID)
+
+	return model.DBQuery{
+		ID:            "ASQ-ENTITY_MGT-31",
+		Query:         pgQuery,
+		PostgresQuery: pgQuery,
+		SQLiteQuery:   sqQuery,
+	}, args, nil
+}
+
+// buildCountDuplicateAttributeValuesQuery constructs a query that counts the values of an attribute shared by
+// more than one entity of an entity type, either across the type or within each organization unit.
+func buildCountDuplicateAttributeValuesQuery(
+	category, entityType, attribute string, perOU bool, deploymentID string,
+) (model.DBQuery, []interface{}, error) {
+	if err := utils.ValidateKey(attribute); err != nil {
+		return model.DBQuery{}, nil, fmt.Errorf("invalid attribute key: %w", err)
+	}
+
+	pgValue := fmt.Sprintf("%s->>'%s'", AttributesColumn, attribute)
+	if strings.Contains(attribute, ".") {
+		pgValue = fmt.Sprintf("%s#>>'{%s}'", AttributesColumn, strings.Join(strings.Split(attribute, "."), ","))
+	}
+	sqValue := fmt.Sprintf("json_extract(%s, '$.%s')", AttributesColumn, attribute)
+
+	groupBy := ""
+	if perOU {
+		groupBy = "OU_ID, "
+	}
+	build := func(value string, placeholders [3]string) string {
+		return `SELECT COUNT(*) AS total FROM (SELECT ` + value + ` AS ATTRIBUTE_VALUE FROM "ENTITY" ` +
+			`WHERE CATEGORY = ` + placeholders[0] + ` AND TYPE = ` + placeholders[1] +
+			` AND DEPLOYMENT_ID = ` + placeholders[2] + ` AND ` + value + ` IS NOT NULL ` +
+			`GROUP BY ` + groupBy + value + ` HAVING COUNT(*) > 1) duplicates`
+	}
+	pgQuery := build(pgValue, [3]string{"$1", "$2", "$3"})
+
+	return model.DBQuery{
+		ID:            "ASQ-ENTITY_MGT-32",
+		Query:         pgQuery,
+		PostgresQuery: pgQuery,
+		SQLiteQuery:   build(sqValue, [3]string{"?", "?", "?"}),
+	}, []interface{}{category, entityType, deploymentID}, nil
+}
+
 // buildGetEntitiesByIDsQuery constructs a query to fetch entities by a list of IDs.
 func buildGetEntitiesByIDsQuery(entityIDs []string, deploymentID string) (model.DBQuery, []interface{}, error) {
 	return buildEntityINClauseQuery(
@@ -636,9 +860,13 @@ func buildGetEntitiesByIDsQuery(entityIDs []string, deploymentID string) (model.
 
 // buildDualColumnConditions returns AND conditions for both Postgres and SQLite that match a key
 // against both ATTRIBUTES and SYSTEM_ATTRIBUTES using COALESCE (one parameter per key).
+// An array element key is matched against the items of the array in ATTRIBUTES.
 func buildDualColumnConditions(tablePrefix, key string, paramIndex int) (pgCond, sqCond string) {
 	attrCol := tablePrefix + AttributesColumn
 	sysCol := tablePrefix + SystemAttributesColumn
+	if arrayPath, field, ok := splitArrayElementKey(key); ok {
+		return buildArrayElementConditions(attrCol, arrayPath, field, paramIndex)
+	}
 	sqCond = fmt.Sprintf(" AND COALESCE(json_extract(%s, '$.%s'), json_extract(%s, '$.%s')) = ?",
 		sysCol, key, attrCol, key)
 	if strings.Contains(key, ".") {
@@ -653,6 +881,19 @@ func buildDualColumnConditions(tablePrefix, key string, paramIndex int) (pgCond,
 	return
 }
 
+// buildArrayElementConditions returns AND conditions for both Postgres and SQLite that match an entity
+// holding the parameter in the field of any object item of the array at arrayPath in the column.
+func buildArrayElementConditions(column, arrayPath, field string, paramIndex int) (pgCond, sqCond string) {
+	pgArray := fmt.Sprintf("%s#>'{%s}'", column, strings.ReplaceAll(arrayPath, ".", ","))
+	pgCond = fmt.Sprintf(" AND EXISTS (SELECT 1 FROM jsonb_array_elements(CASE WHEN jsonb_typeof(%s) = 'array' "+
+		"THEN %s ELSE '[]'::jsonb END) elem WHERE elem#>>'{%s}' = $%d)",
+		pgArray, pgArray, strings.ReplaceAll(field, ".", ","), paramIndex)
+	sqCond = fmt.Sprintf(" AND EXISTS (SELECT 1 FROM json_each(%s, '$.%s') elem "+
+		"WHERE json_extract(CASE WHEN elem.type = 'object' THEN elem.value END, '$.%s') = ?)",
+		column, arrayPath, field)
+	return
+}
+
 // buildPaginatedQuery constructs a paginated query string with ORDER BY, LIMIT, and OFFSET clauses.
 func buildPaginatedQuery(baseQuery string, paramCount int, placeholder string) (string, error) {
 	switch placeholder {
@@ -47,7 +47,7 @@ func (s *StoreConstantsTestSuite) TestAppendOUIDsINClause_WithOUIDs() {
 }
 
 func (s *StoreConstantsTestSuite) TestBuildEntityCountQueryByOUIDs_NoFilters() {
-	q, args, err := buildEntityCountQueryByOUIDs("user", []string{"ou1"}, nil, testDeploymentID)
+	q, args, err := buildEntityCountQueryByOUIDs("user", []string{"ou1"}, nil, "", false, testDeploymentID)
 	s.NoError(err)
 	s.NotEmpty(q.Query)
 	s.NotEmpty(args)
@@ -55,14 +55,14 @@ func (s *StoreConstantsTestSuite) TestBuildEntityCountQueryByOUIDs_NoFilters() {
 
 func (s *StoreConstantsTestSuite) TestBuildEntityCountQueryByOUIDs_WithFilters() {
 	filters := map[string]interface{}{"email": "a@b.com"}
-	q, args, err := buildEntityCountQueryByOUIDs("user", []string{"ou1"}, filters, testDeploymentID)
+	q, args, err := buildEntityCountQueryByOUIDs("user", []string{"ou1"}, filters, "", false, testDeploymentID)
 	s.NoError(err)
 	s.NotEmpty(q.Query)
 	s.NotEmpty(args)
 }
 
 func (s *StoreConstantsTestSuite) TestBuildEntityListQueryByOUIDs_NoFilters() {
-	q, args, err := buildEntityListQueryByOUIDs("user", []string{"ou1"}, nil, 10, 0, testDeploymentID)
+	q, args, err := buildEntityListQueryByOUIDs("user", []string{"ou1"}, nil, "", false, 10, 0, testDeploymentID)
 	s.NoError(err)
 	s.NotEmpty(q.Query)
 	s.NotEmpty(args)
@@ -70,19 +70,20 @@ func (s *StoreConstantsTestSuite) TestBuildEntityListQueryByOUIDs_NoFilters() {
 
 func (s *StoreConstantsTestSuite) TestBuildEntityListQueryByOUIDs_WithFilters() {
 	filters := map[string]interface{}{"email": "a@b.com"}
-	q, args, err := buildEntityListQueryByOUIDs("user", []string{"ou1"}, filters, 10, 0, testDeploymentID)
+	q, args, err := buildEntityListQueryByOUIDs("user", []string{"ou1"}, filters, "", false, 10, 0,
+		testDeploymentID)
 	s.NoError(err)
 	s.NotEmpty(q.Query)
 	s.NotEmpty(args)
 }
 
 func (s *StoreConstantsTestSuite) TestBuildIdentifyQuery_EmptyFilters() {
-	_, _, err := buildIdentifyQuery(map[string]interface{}{}, testDeploymentID)
+	_, _, err := buildIdentifyQuery(map[string]interface{}{}, false, testDeploymentID)
 	s.Error(err)
 }
 
 func (s *StoreConstantsTestSuite) TestBuildIdentifyQuery_WithFilters() {
-	q, args, err := buildIdentifyQuery(map[string]interface{}{"email": "a@b.com"}, testDeploymentID)
+	q, args, err := buildIdentifyQuery(map[string]interface{}{"email": "a@b.com"}, false, testDeploymentID)
 	s.NoError(err)
 	s.NotEmpty(q.Query)
 	s.NotEmpty(args)
@@ -127,7 +128,7 @@ func (s *StoreConstantsTestSuite) TestBuildBulkEntityExistsQueryInOUs_WithBoth()
 }
 
 func (s *StoreConstantsTestSuite) TestBuildEntityListQuery_NoFilters() {
-	q, args, err := buildEntityListQuery("user", nil, 10, 0, testDeploymentID)
+	q, args, err := buildEntityListQuery("user", nil, "", false, 10, 0, testDeploymentID)
 	s.NoError(err)
 	s.NotEmpty(q.Query)
 	s.NotEmpty(args)
@@ -135,14 +136,14 @@ func (s *StoreConstantsTestSuite) TestBuildEntityListQuery_NoFilters() {
 
 func (s *StoreConstantsTestSuite) TestBuildEntityListQuery_WithFilters() {
 	filters := map[string]interface{}{"email": "a@b.com"}
-	q, args, err := buildEntityListQuery("user", filters, 10, 0, testDeploymentID)
+	q, args, err := buildEntityListQuery("user", filters, "", false, 10, 0, testDeploymentID)
 	s.NoError(err)
 	s.NotEmpty(q.Query)
 	s.NotEmpty(args)
 }
 
 func (s *StoreConstantsTestSuite) TestBuildEntityCountQuery_NoFilters() {
-	q, args, err := buildEntityCountQuery("user", nil, testDeploymentID)
+	q, args, err := buildEntityCountQuery("user", nil, "", false, testDeploymentID)
 	s.NoError(err)
 	s.NotEmpty(q.Query)
 	s.NotEmpty(args)
@@ -150,19 +151,76 @@ func (s *StoreConstantsTestSuite) TestBuildEntityCountQuery_NoFilters() {
 
 func (s *StoreConstantsTestSuite) TestBuildEntityCountQuery_WithFilters() {
 	filters := map[string]interface{}{"email": "a@b.com"}
-	q, args, err := buildEntityCountQuery("user", filters, testDeploymentID)
+	q, args, err := buildEntityCountQuery("user", filters, "", false, testDeploymentID)
 	s.NoError(err)
 	s.NotEmpty(q.Query)
 	s.NotEmpty(args)
 }
 
+func (s *StoreConstantsTestSuite) TestBuildEntityListQuery_WithApplicationScope() {
+	q, args, err := buildEntityListQuery("user", nil, "app1", false, 10, 0, testDeploymentID)
+	s.NoError(err)
+	s.Contains(q.PostgresQuery, `SELECT USER_ID FROM "APPLICATION_USER" WHERE APPLICATION_ID = $2`)
+	s.Contains(q.SQLiteQuery, `SELECT USER_ID FROM "APPLICATION_USER" WHERE APPLICATION_ID = ?`)
+	s.Equal([]interface{}{"user", "app1", testDeploymentID, 10, 0}, args)
+}
+
+func (s *StoreConstantsTestSuite) TestBuildEntityCountQuery_WithApplicationScope() {
+	filters := map[string]interface{}{"email": "a@b.com"}
+	q, args, err := buildEntityCountQuery("user", filters, "app1", false, testDeploymentID)
+	s.NoError(err)
+	s.Contains(q.PostgresQuery, `WHERE APPLICATION_ID = $3`)
+	s.Equal([]interface{}{"user", "a@b.com", "app1", testDeploymentID}, args)
+}
+
+func (s *StoreConstantsTestSuite) TestBuildEntityListQueryByOUIDs_WithApplicationScope() {
+	q, args, err := buildEntityListQueryByOUIDs("user", []string{"ou1"}, nil, "app1", false, 10, 0,
+		testDeploymentID)
+	s.NoError(err)
+	s.Contains(q.PostgresQuery, `OU_ID IN ($2) AND STATE <> 'DELETED' AND ID IN (SELECT USER_ID FROM `+
+		`"APPLICATION_USER" WHERE APPLICATION_ID = $3)`)
+	s.Equal([]interface{}{"user", "ou1", "app1", testDeploymentID, 10, 0}, args)
+}
+
+func (s *StoreConstantsTestSuite) TestBuildEntityListQuery_ExcludesDeletedEntities() {
+	q, _, err := buildEntityListQuery("user", nil, "", false, 10, 0, testDeploymentID)
+	s.NoError(err)
+	s.Contains(q.Query, `STATE <> 'DELETED'`)
+
+	q, _, err = buildEntityCountQuery("user", nil, "", false, testDeploymentID)
+	s.NoError(err)
+	s.Contains(q.Query, `STATE <> 'DELETED'`)
+}
+
+func (s *StoreConstantsTestSuite) TestBuildEntityListQuery_DeletedScope() {
+	q, args, err := buildEntityListQuery("user", nil, "", true, 10, 0, testDeploymentID)
+	s.NoError(err)
+	s.Contains(q.PostgresQuery, `WHERE CATEGORY = $1 AND STATE = 'DELETED'`)
+	s.Contains(q.SQLiteQuery, `WHERE CATEGORY = ? AND STATE = 'DELETED'`)
+	s.Equal([]interface{}{"user", testDeploymentID, 10, 0}, args)
+}
+
+func (s *StoreConstantsTestSuite) TestBuildEntityCountQuery_DeletedScope() {
+	q, args, err := buildEntityCountQuery("user", nil, "", true, testDeploymentID)
+	s.NoError(err)
+	s.Contains(q.PostgresQuery, `WHERE CATEGORY = $1 AND STATE = 'DELETED'`)
+	s.Equal([]interface{}{"user", testDeploymentID}, args)
+}
+
+func (s *StoreConstantsTestSuite) TestBuildEntityListQueryByOUIDs_DeletedScope() {
+	q, args, err := buildEntityListQueryByOUIDs("user", []string{"ou1"}, nil, "", true, 10, 0, testDeploymentID)
+	s.NoError(err)
+	s.Contains(q.PostgresQuery, `OU_ID IN ($2) AND STATE = 'DELETED'`)
+	s.Equal([]interface{}{"user", "ou1", testDeploymentID, 10, 0}, args)
+}
+
 func (s *StoreConstantsTestSuite) TestBuildIdentifyQueryFromIdentifiers_EmptyFilters() {
-	_, _, err := buildIdentifyQueryFromIdentifiers(map[string]interface{}{}, testDeploymentID)
+	_, _, err := buildIdentifyQueryFromIdentifiers(map[string]interface{}{}, false, testDeploymentID)
 	s.Error(err)
 }
 
 func (s *StoreConstantsTestSuite) TestBuildIdentifyQueryFromIdentifiers_SingleFilter() {
-	q, args, err := buildIdentifyQueryFromIdentifiers(map[string]interface{}{"email": "a@b.com"}, testDeploymentID)
+	q, args, err := buildIdentifyQueryFromIdentifiers(map[string]interface{}{"email": "a@b.com"}, false, testDeploymentID)
 	s.NoError(err)
 	s.NotEmpty(q.Query)
 	s.NotEmpty(args)
@@ -170,7 +228,7 @@ func (s *StoreConstantsTestSuite) TestBuildIdentifyQueryFromIdentifiers_SingleFi
 
 func (s *StoreConstantsTestSuite) TestBuildIdentifyQueryFromIdentifiers_MultipleFilters() {
 	filters := map[string]interface{}{"email": "a@b.com", "username": "user1"}
-	q, args, err := buildIdentifyQueryFromIdentifiers(filters, testDeploymentID)
+	q, args, err := buildIdentifyQueryFromIdentifiers(filters, false, testDeploymentID)
 	s.NoError(err)
 	s.NotEmpty(q.Query)
 	s.Contains(q.Query, "INNER JOIN")
@@ -178,14 +236,14 @@ func (s *StoreConstantsTestSuite) TestBuildIdentifyQueryFromIdentifiers_Multiple
 }
 
 func (s *StoreConstantsTestSuite) TestBuildIdentifyQueryHybrid_EmptyIndexedFilters() {
-	_, _, err := buildIdentifyQueryHybrid(map[string]interface{}{}, map[string]interface{}{"k": "v"}, testDeploymentID)
+	_, _, err := buildIdentifyQueryHybrid(map[string]interface{}{}, map[string]interface{}{"k": "v"}, false, testDeploymentID)
 	s.Error(err)
 }
 
 func (s *StoreConstantsTestSuite) TestBuildIdentifyQueryHybrid_Success() {
 	indexed := map[string]interface{}{"email": "a@b.com"}
 	nonIndexed := map[string]interface{}{"username": "user1"}
-	q, args, err := buildIdentifyQueryHybrid(indexed, nonIndexed, testDeploymentID)
+	q, args, err := buildIdentifyQueryHybrid(indexed, nonIndexed, false, testDeploymentID)
 	s.NoError(err)
 	s.NotEmpty(q.Query)
 	s.NotEmpty(args)
@@ -194,12 +252,59 @@ func (s *StoreConstantsTestSuite) TestBuildIdentifyQueryHybrid_Success() {
 func (s *StoreConstantsTestSuite) TestBuildIdentifyQueryHybrid_MultipleIndexed() {
 	indexed := map[string]interface{}{"email": "a@b.com", "phone": "123"}
 	nonIndexed := map[string]interface{}{"username": "user1"}
-	q, args, err := buildIdentifyQueryHybrid(indexed, nonIndexed, testDeploymentID)
+	q, args, err := buildIdentifyQueryHybrid(indexed, nonIndexed, false, testDeploymentID)
 	s.NoError(err)
 	s.NotEmpty(q.Query)
 	s.NotEmpty(args)
 }
 
+func (s *StoreConstantsTestSuite) TestBuildScopedIdentifyQuery_EmptyFilters() {
+	_, _, err := buildScopedIdentifyQuery(map[string]interface{}{}, identifierScope{}, false, testDeploymentID)
+	s.Error(err)
+}
+
+func (s *StoreConstantsTestSuite) TestBuildScopedIdentifyQuery_Identifiers() {
+	q, args, err := buildScopedIdentifyQuery(map[string]interface{}{"email": "a@b.com"},
+		identifierScope{OUID: "ou1"}, true, testDeploymentID)
+	s.NoError(err)
+	s.Contains(q.PostgresQuery, "INNER JOIN \"ENTITY_IDENTIFIER\"")
+	s.Contains(q.PostgresQuery, "e.OU_ID = $3")
+	s.Contains(q.SQLiteQuery, "e.OU_ID = ?")
+	s.Equal([]interface{}{"email", "a@b.com", "ou1", testDeploymentID}, args)
+}
+
+func (s *StoreConstantsTestSuite) TestBuildScopedIdentifyQuery_Attributes() {
+	q, args, err := buildScopedIdentifyQuery(map[string]interface{}{"email": "a@b.com"},
+		identifierScope{Category: "user", Type: "employee"}, false, testDeploymentID)
+	s.NoError(err)
+	s.NotContains(q.PostgresQuery, "ENTITY_IDENTIFIER")
+	s.Contains(q.PostgresQuery, "e.CATEGORY = $2 AND e.TYPE = $3")
+	s.Equal([]interface{}{"a@b.com", "user", "employee", testDeploymentID}, args)
+}
+
+func (s *StoreConstantsTestSuite) TestBuildScopedIdentifyQuery_InvalidKey() {
+	_, _, err := buildScopedIdentifyQuery(map[string]interface{}{"email'--": "a@b.com"},
+		identifierScope{}, false, testDeploymentID)
+	s.Error(err)
+}
+
+func (s *StoreConstantsTestSuite) TestBuildCountDuplicateAttributeValuesQuery() {
+	q, args, err := buildCountDuplicateAttributeValuesQuery("user", "employee", "address.code", true,
+		testDeploymentID)
+	s.NoError(err)
+	s.Contains(q.PostgresQuery, "ATTRIBUTES#>>'{address,code}'")
+	s.Contains(q.PostgresQuery, "GROUP BY OU_ID, ")
+	s.Contains(q.SQLiteQuery, "json_extract(ATTRIBUTES, '$.address.code')")
+	s.Equal([]interface{}{"user", "employee", testDeploymentID}, args)
+
+	q, _, err = buildCountDuplicateAttributeValuesQuery("user", "employee", "email", false, testDeploymentID)
+	s.NoError(err)
+	s.NotContains(q.PostgresQuery, "OU_ID")
+
+	_, _, err = buildCountDuplicateAttributeValuesQuery("user", "employee", "email'--", false, testDeploymentID)
+	s.Error(err)
+}
+
 func (s *StoreConstantsTestSuite) TestBuildGetEntitiesByIDsQuery_Success() {
 	q, args, err := buildGetEntitiesByIDsQuery([]string{"id1", "id2"}, testDeploymentID)
 	s.NoError(err)
@@ -236,7 +341,7 @@ func (s *StoreConstantsTestSuite) TestBuildFilterQueryWithOffset_NoFilters() {
 // ATTRIBUTES and SYSTEM_ATTRIBUTES using COALESCE so that an entity can be found
 // regardless of which column holds the filter key (e.g. clientId in SYSTEM_ATTRIBUTES).
 func (s *StoreConstantsTestSuite) TestBuildIdentifyQuery_COALESCE_PostgresQuery() {
-	q, args, err := buildIdentifyQuery(map[string]interface{}{"clientId": "app123"}, testDeploymentID)
+	q, args, err := buildIdentifyQuery(map[string]interface{}{"clientId": "app123"}, false, testDeploymentID)
 	s.NoError(err)
 	s.Contains(q.PostgresQuery, "COALESCE")
 	s.Contains(q.PostgresQuery, "ATTRIBUTES->>'clientId'")
@@ -249,7 +354,7 @@ func (s *StoreConstantsTestSuite) TestBuildIdentifyQuery_COALESCE_PostgresQuery(
 }
 
 func (s *StoreConstantsTestSuite) TestBuildIdentifyQuery_COALESCE_SQLiteQuery() {
-	q, args, err := buildIdentifyQuery(map[string]interface{}{"clientId": "app123"}, testDeploymentID)
+	q, args, err := buildIdentifyQuery(map[string]interface{}{"clientId": "app123"}, false, testDeploymentID)
 	s.NoError(err)
 	s.Contains(q.SQLiteQuery, "COALESCE")
 	s.Contains(q.SQLiteQuery, "json_extract(ATTRIBUTES, '$.clientId')")
@@ -260,7 +365,7 @@ func (s *StoreConstantsTestSuite) TestBuildIdentifyQuery_COALESCE_SQLiteQuery()
 func (s *StoreConstantsTestSuite) TestBuildIdentifyQuery_MultipleFilters_CorrectParamIndexes() {
 	// keys are sorted: clientId < name, so clientId=$1, name=$2, deploymentID=$3
 	filters := map[string]interface{}{"clientId": "app123", "name": "myapp"}
-	q, args, err := buildIdentifyQuery(filters, testDeploymentID)
+	q, args, err := buildIdentifyQuery(filters, false, testDeploymentID)
 	s.NoError(err)
 	s.Contains(q.PostgresQuery, "$1")
 	s.Contains(q.PostgresQuery, "$2")
@@ -270,7 +375,7 @@ func (s *StoreConstantsTestSuite) TestBuildIdentifyQuery_MultipleFilters_Correct
 }
 
 func (s *StoreConstantsTestSuite) TestBuildIdentifyQuery_NestedKey_UsesPathSyntax() {
-	q, _, err := buildIdentifyQuery(map[string]interface{}{"address.city": "NYC"}, testDeploymentID)
+	q, _, err := buildIdentifyQuery(map[string]interface{}{"address.city": "NYC"}, false, testDeploymentID)
 	s.NoError(err)
 	s.Contains(q.PostgresQuery, "#>>")
 	s.Contains(q.PostgresQuery, "{address,city}")
@@ -278,10 +383,28 @@ func (s *StoreConstantsTestSuite) TestBuildIdentifyQuery_NestedKey_UsesPathSynta
 	s.Contains(q.SQLiteQuery, "$.address.city")
 }
 
+func (s *StoreConstantsTestSuite) TestBuildIdentifyQuery_ArrayElementKey_MatchesItems() {
+	q, args, err := buildIdentifyQuery(map[string]interface{}{"emails[].value": "a@b.com"}, false, testDeploymentID)
+	s.NoError(err)
+	s.Contains(q.PostgresQuery, "jsonb_array_elements(CASE WHEN jsonb_typeof(ATTRIBUTES#>'{emails}') = 'array'")
+	s.Contains(q.PostgresQuery, "elem#>>'{value}' = $1")
+	s.Contains(q.SQLiteQuery, "json_each(ATTRIBUTES, '$.emails') elem")
+	s.Contains(q.SQLiteQuery, "'$.value') = ?")
+	s.Equal([]interface{}{"a@b.com", testDeploymentID}, args)
+}
+
+func (s *StoreConstantsTestSuite) TestBuildScopedIdentifyQuery_InvalidArrayElementKey() {
+	for _, key := range []string{"[].value", "emails[].", "emails'[].value", "emails[].value'--"} {
+		_, _, err := buildScopedIdentifyQuery(map[string]interface{}{key: "a@b.com"},
+			identifierScope{}, false, testDeploymentID)
+		s.Error(err, key)
+	}
+}
+
 func (s *StoreConstantsTestSuite) TestBuildIdentifyQueryHybrid_NonIndexed_UsesCOALESCE() {
 	indexed := map[string]interface{}{"email": "a@b.com"}
 	nonIndexed := map[string]interface{}{"clientId": "app123"}
-	q, _, err := buildIdentifyQueryHybrid(indexed, nonIndexed, testDeploymentID)
+	q, _, err := buildIdentifyQueryHybrid(indexed, nonIndexed, false, testDeploymentID)
 	s.NoError(err)
 	s.Contains(q.PostgresQuery, "COALESCE")
 	s.Contains(q.PostgresQuery, "e.ATTRIBUTES->>'clientId'")
@@ -290,3 +413,43 @@ func (s *StoreConstantsTestSuite) TestBuildIdentifyQueryHybrid_NonIndexed_UsesCO
 	s.Contains(q.SQLiteQuery, "json_extract(e.ATTRIBUTES, '$.clientId')")
 	s.Contains(q.SQLiteQuery, "json_extract(e.SYSTEM_ATTRIBUTES, '$.clientId')")
 }
+
+func (s *StoreConstantsTestSuite) TestBuildIdentifyQueries_LeaveOutDeletedEntities() {
+	filters := map[string]interface{}{"email": "a@b.com"}
+	nonIndexed := map[string]interface{}{"clientId": "app123"}
+
+	q, _, err := buildIdentifyQuery(filters, false, testDeploymentID)
+	s.NoError(err)
+	s.Contains(q.PostgresQuery, "STATE <> 'DELETED'")
+	s.Contains(q.SQLiteQuery, "STATE <> 'DELETED'")
+
+	q, args, err := buildIdentifyQueryFromIdentifiers(filters, false, testDeploymentID)
+	s.NoError(err)
+	s.Contains(q.PostgresQuery, `INNER JOIN "ENTITY" e ON e.ID = ia1.ENTITY_ID`)
+	s.Contains(q.PostgresQuery, "e.STATE <> 'DELETED' AND ia1.DEPLOYMENT_ID = $3")
+	s.Contains(q.SQLiteQuery, "e.STATE <> 'DELETED' AND ia1.DEPLOYMENT_ID = ?")
+	s.Equal([]interface{}{"email", "a@b.com", testDeploymentID}, args)
+
+	q, _, err = buildIdentifyQueryHybrid(filters, nonIndexed, false, testDeploymentID)
+	s.NoError(err)
+	s.Contains(q.PostgresQuery, "e.STATE <> 'DELETED'")
+	s.Contains(q.SQLiteQuery, "e.STATE <> 'DELETED'")
+}
+
+func (s *StoreConstantsTestSuite) TestBuildIdentifyQueries_IncludeDeletedEntities() {
+	filters := map[string]interface{}{"email": "a@b.com"}
+	nonIndexed := map[string]interface{}{"clientId": "app123"}
+
+	q, _, err := buildIdentifyQuery(filters, true, testDeploymentID)
+	s.NoError(err)
+	s.NotContains(q.PostgresQuery, "STATE")
+
+	q, _, err = buildIdentifyQueryFromIdentifiers(filters, true, testDeploymentID)
+	s.NoError(err)
+	s.NotContains(q.PostgresQuery, `"ENTITY" e`)
+	s.NotContains(q.PostgresQuery, "STATE")
+
+	q, _, err = buildIdentifyQueryHybrid(filters, nonIndexed, true, testDeploymentID)
+	s.NoError(err)
+	s.NotContains(q.PostgresQuery, "STATE")
+}
@@ -22,11 +22,13 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 
+	"github.com/thunder-id/thunderid/internal/system/cryptolab/hash"
 	dbmodel "github.com/thunder-id/thunderid/internal/system/database/model"
 	"github.com/thunder-id/thunderid/internal/system/log"
 	"github.com/thunder-id/thunderid/tests/mocks/database/providermock"
@@ -181,6 +183,33 @@ func (s *DBStoreTestSuite) TestGetEntityWithCredentials_Success() {
 	s.NotNil(result.SystemCredentials)
 }
 
+func (s *DBStoreTestSuite) TestGetCredentialAlgorithmCounts_Success() {
+	s.expectClient()
+	s.onQueryAny([]map[string]interface{}{
+		{
+			"credentials": `{"password":[{"storageAlgo":"BCRYPT","value":"a"},` +
+				`{"storageAlgo":"PBKDF2","value":"b"}]}`,
+			"system_credentials": `{"passkey":{"credentialId":"c"}}`,
+		},
+		{
+			"credentials":        `{"password":[{"storageAlgo":"BCRYPT","value":"d"}]}`,
+			"system_credentials": `{"secret":[{"storageAlgo":"SHA256","value":"e"}]}`,
+		},
+	}, nil)
+
+	counts, err := s.store.GetCredentialAlgorithmCounts(s.ctx, "user")
+	s.NoError(err)
+	s.Equal(map[hash.CredAlgorithm]int{hash.BCRYPT: 2, hash.PBKDF2: 1, hash.SHA256: 1}, counts)
+}
+
+func (s *DBStoreTestSuite) TestGetCredentialAlgorithmCounts_QueryError() {
+	s.expectClient()
+	s.onQueryAny(nil, s.testErr)
+
+	_, err := s.store.GetCredentialAlgorithmCounts(s.ctx, "user")
+	s.Error(err)
+}
+
 func (s *DBStoreTestSuite) TestCreateEntity_ProviderError() {
 	s.expectClientError()
 	e := Entity{ID: "e1", Attributes: json.RawMessage(`{}`)}
@@ -445,6 +474,28 @@ func (s *DBStoreTestSuite) TestIdentifyEntity_FastPath_Empty_FallbackSearchesBot
 	s.Equal("app-entity-1", *got)
 }
 
+func (s *DBStoreTestSuite) TestIdentifyEntity_LeavesOutDeletedEntities() {
+	s.expectClient()
+	s.client.On("QueryContext", mock.Anything, mock.MatchedBy(func(q dbmodel.DBQuery) bool {
+		return strings.Contains(q.PostgresQuery, "e.STATE <> 'DELETED'")
+	}), mock.Anything, mock.Anything, mock.Anything).Return([]map[string]interface{}{{"id": "e1"}}, nil).Once()
+
+	got, err := s.store.IdentifyEntity(s.ctx, map[string]interface{}{"email": "a@b.com"})
+	s.NoError(err)
+	s.Equal("e1", *got)
+}
+
+func (s *DBStoreTestSuite) TestIdentifyEntity_WithDeletedIdentifiers() {
+	s.expectClient()
+	s.client.On("QueryContext", mock.Anything, mock.MatchedBy(func(q dbmodel.DBQuery) bool {
+		return !strings.Contains(q.PostgresQuery, "STATE")
+	}), mock.Anything, mock.Anything, mock.Anything).Return([]map[string]interface{}{{"id": "e1"}}, nil).Once()
+
+	got, err := s.store.IdentifyEntity(withDeletedIdentifiers(s.ctx), map[string]interface{}{"email": "a@b.com"})
+	s.NoError(err)
+	s.Equal("e1", *got)
+}
+
 func (s *DBStoreTestSuite) TestGetEntityListCount_ProviderError() {
 	s.expectClientError()
 	_, err := s.store.GetEntityListCount(s.ctx, "user", nil)
@@ -24,6 +24,7 @@ import (
 	"errors"
 
 	"github.com/thunder-id/thunderid/internal/entity"
+	"github.com/thunder-id/thunderid/internal/quota"
 	"github.com/thunder-id/thunderid/internal/system/security"
 )
 
@@ -70,7 +71,8 @@ func (p *defaultEntityProvider) SearchEntities(
 	return result, nil
 }
 
-// GetEntity retrieves an entity by ID.
+// GetEntity retrieves an entity by ID. Soft-deleted entities are reported as not found, so that they cannot
+// sign in through any authenticator until they are restored.
 func (p *defaultEntityProvider) GetEntity(
 	entityID string,
 ) (*Entity, *EntityProviderError) {
@@ -79,9 +81,42 @@ func (p *defaultEntityProvider) GetEntity(
 	if err != nil {
 		return nil, mapEntityError(err)
 	}
+	if result.State == entity.EntityStateDeleted {
+		return nil, mapEntityError(entity.ErrEntityNotFound)
+	}
 	return toProviderEntity(result), nil
 }
 
+// GetCredentialTypes returns the credential types an entity has enrolled.
+func (p *defaultEntityProvider) GetCredentialTypes(
+	entityID string,
+) ([]string, *EntityProviderError) {
+	ctx := security.WithRuntimeContext(context.Background())
+	types, err := p.entitySvc.GetCredentialTypes(ctx, entityID)
+	if err != nil {
+		return nil, mapEntityError(err)
+	}
+	return types, nil
+}
+
+// EvaluateCredentialPolicy evaluates the credentials held by an entity against the credential policy of
+// its entity type.
+func (p *defaultEntityProvider) EvaluateCredentialPolicy(
+	entityID string,
+) (*CredentialPolicyEvaluation, *EntityProviderError) {
+	ctx := security.WithRuntimeContext(context.Background())
+	evaluation, err := p.entitySvc.EvaluateCredentialPolicy(ctx, entityID)
+	if err != nil {
+		return nil, mapEntityError(err)
+	}
+	return &CredentialPolicyEvaluation{
+		Satisfied:               evaluation.Satisfied,
+		MissingRequired:         evaluation.MissingRequired,
+		UnsatisfiedAlternatives: evaluation.UnsatisfiedAlternatives,
+		MissingFactors:          evaluation.MissingFactors,
+	}, nil
+}
+
 // CreateEntity creates a new entity.
 func (p *defaultEntityProvider) CreateEntity(
 	e *Entity, systemCredentials json.RawMessage,
@@ -305,6 +340,14 @@ func mapEntityError(err error) *EntityProviderError {
 		return NewEntityProviderError(ErrorCodeSchemaValidationFailed, "Schema validation failed", err.Error())
 	case errors.Is(err, entity.ErrInvalidCredential):
 		return NewEntityProviderError(ErrorCodeInvalidRequestFormat, "Invalid credential", err.Error())
+	case errors.Is(err, entity.ErrDeniedIdentifier):
+		return NewEntityProviderError(ErrorCodeDeniedIdentifier, "Identifier not allowed", err.Error())
+	case errors.Is(err, entity.ErrDeniedCredential):
+		return NewEntityProviderError(ErrorCodeDeniedCredential, "Credential not allowed", err.Error())
+	case errors.Is(err, entity.ErrCredentialPolicyViolation):
+		return NewEntityProviderError(ErrorCodeCredentialPolicy, "Credential policy not satisfied", err.Error())
+	case errors.Is(err, quota.ErrQuotaExceeded):
+		return NewEntityProviderError(ErrorCodeQuotaExceeded, "Quota exceeded", err.Error())
 	case errors.Is(err, entity.ErrBadAttributesInRequest):
 		return NewEntityProviderError(ErrorCodeInvalidRequestFormat, "Invalid request", err.Error())
 	default:
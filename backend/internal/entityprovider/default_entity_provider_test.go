@@ -21,12 +21,15 @@ package entityprovider
 import (
 	"encoding/json"
 	"errors"
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 
 	"github.com/thunder-id/thunderid/internal/entity"
+	"github.com/thunder-id/thunderid/internal/entitytype"
+	"github.com/thunder-id/thunderid/internal/quota"
 	"github.com/thunder-id/thunderid/tests/mocks/entitymock"
 )
 
@@ -102,6 +105,54 @@ func (suite *DefaultEntityProviderTestSuite) TestGetEntity() {
 	suite.Equal(ErrorCodeEntityNotFound, err.Code)
 }
 
+func (suite *DefaultEntityProviderTestSuite) TestGetEntity_Deleted() {
+	suite.mockService.On("GetEntity", mock.Anything, testEntityID).Return(&entity.Entity{
+		ID:       testEntityID,
+		Category: entity.EntityCategoryUser,
+		State:    entity.EntityStateDeleted,
+	}, nil).Once()
+
+	e, err := suite.provider.GetEntity(testEntityID)
+	suite.Nil(e)
+	suite.NotNil(err)
+	suite.Equal(ErrorCodeEntityNotFound, err.Code)
+}
+
+func (suite *DefaultEntityProviderTestSuite) TestGetCredentialTypes() {
+	suite.mockService.On("GetCredentialTypes", mock.Anything, testEntityID).
+		Return([]string{"passkey", "password"}, nil).Once()
+
+	types, err := suite.provider.GetCredentialTypes(testEntityID)
+	suite.Nil(err)
+	suite.Equal([]string{"passkey", "password"}, types)
+
+	suite.mockService.On("GetCredentialTypes", mock.Anything, testEntityID).
+		Return(nil, entity.ErrEntityNotFound).Once()
+
+	types, err = suite.provider.GetCredentialTypes(testEntityID)
+	suite.Nil(types)
+	suite.NotNil(err)
+	suite.Equal(ErrorCodeEntityNotFound, err.Code)
+}
+
+func (suite *DefaultEntityProviderTestSuite) TestEvaluateCredentialPolicy() {
+	suite.mockService.On("EvaluateCredentialPolicy", mock.Anything, testEntityID).
+		Return(&entitytype.CredentialPolicyEvaluation{MissingRequired: []string{"passkey"}}, nil).Once()
+
+	evaluation, err := suite.provider.EvaluateCredentialPolicy(testEntityID)
+	suite.Nil(err)
+	suite.False(evaluation.Satisfied)
+	suite.Equal([]string{"passkey"}, evaluation.MissingRequired)
+
+	suite.mockService.On("EvaluateCredentialPolicy", mock.Anything, testEntityID).
+		Return(nil, entity.ErrEntityNotFound).Once()
+
+	evaluation, err = suite.provider.EvaluateCredentialPolicy(testEntityID)
+	suite.Nil(evaluation)
+	suite.NotNil(err)
+	suite.Equal(ErrorCodeEntityNotFound, err.Code)
+}
+
 func (suite *DefaultEntityProviderTestSuite) TestCreateEntity() {
 	providerEntity := &Entity{
 		ID:       testEntityID,
@@ -358,6 +409,10 @@ func (suite *DefaultEntityProviderTestSuite) TestMapEntityError() {
 		{"AttributeConflict", entity.ErrAttributeConflict, ErrorCodeAttributeConflict},
 		{"SchemaValidationFailed", entity.ErrSchemaValidationFailed, ErrorCodeSchemaValidationFailed},
 		{"InvalidCredential", entity.ErrInvalidCredential, ErrorCodeInvalidRequestFormat},
+		{"DeniedIdentifier", entity.ErrDeniedIdentifier, ErrorCodeDeniedIdentifier},
+		{"DeniedCredential", entity.ErrDeniedCredential, ErrorCodeDeniedCredential},
+		{"CredentialPolicyViolation", entity.ErrCredentialPolicyViolation, ErrorCodeCredentialPolicy},
+		{"QuotaExceeded", fmt.Errorf("%w: users", quota.ErrQuotaExceeded), ErrorCodeQuotaExceeded},
 		{"BadAttributesInRequest", entity.ErrBadAttributesInRequest, ErrorCodeInvalidRequestFormat},
 		{"Unknown", errors.New("unexpected"), ErrorCodeSystemError},
 	}
@@ -52,6 +52,16 @@ func (p *disabledEntityProvider) GetEntity(
 	return nil, errNotImplemented
 }
 
+func (p *disabledEntityProvider) GetCredentialTypes(
+	_ string) ([]string, *EntityProviderError) {
+	return nil, errNotImplemented
+}
+
+func (p *disabledEntityProvider) EvaluateCredentialPolicy(
+	_ string) (*CredentialPolicyEvaluation, *EntityProviderError) {
+	return nil, errNotImplemented
+}
+
 func (p *disabledEntityProvider) CreateEntity(_ *Entity,
 	_ json.RawMessage) (*Entity, *EntityProviderError) {
 	return nil, errNotImplemented
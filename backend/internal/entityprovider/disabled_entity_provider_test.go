@@ -50,6 +50,18 @@ func (suite *DisabledEntityProviderTestSuite) TestGetEntity() {
 	suite.Equal(errNotImplemented, err)
 }
 
+func (suite *DisabledEntityProviderTestSuite) TestGetCredentialTypes() {
+	types, err := suite.provider.GetCredentialTypes("entity-id")
+	suite.Nil(types)
+	suite.Equal(errNotImplemented, err)
+}
+
+func (suite *DisabledEntityProviderTestSuite) TestEvaluateCredentialPolicy() {
+	evaluation, err := suite.provider.EvaluateCredentialPolicy("entity-id")
+	suite.Nil(evaluation)
+	suite.Equal(errNotImplemented, err)
+}
+
 func (suite *DisabledEntityProviderTestSuite) TestCreateEntity() {
 	e, err := suite.provider.CreateEntity(&Entity{}, json.RawMessage{})
 	suite.Nil(e)
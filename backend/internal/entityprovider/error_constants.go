@@ -32,6 +32,10 @@ const (
 	ErrorCodeNotImplemented         ErrorCode = "EP-0007"
 	ErrorCodeAmbiguousEntity        ErrorCode = "EP-0008"
 	ErrorCodeSchemaValidationFailed ErrorCode = "EP-0009"
+	ErrorCodeDeniedIdentifier       ErrorCode = "EP-0010"
+	ErrorCodeDeniedCredential       ErrorCode = "EP-0011"
+	ErrorCodeQuotaExceeded          ErrorCode = "EP-0012"
+	ErrorCodeCredentialPolicy       ErrorCode = "EP-0013"
 )
 
 // EntityProviderError represents an error returned by the entity provider.
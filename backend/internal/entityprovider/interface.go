@@ -34,6 +34,13 @@ type EntityProviderInterface interface {
 	// GetEntity retrieves an entity by ID. Credentials are never returned.
 	GetEntity(entityID string) (*Entity, *EntityProviderError)
 
+	// GetCredentialTypes returns the credential types an entity has enrolled. Credential values are never returned.
+	GetCredentialTypes(entityID string) ([]string, *EntityProviderError)
+
+	// EvaluateCredentialPolicy evaluates the credentials held by an entity against the credential policy
+	// of its entity type.
+	EvaluateCredentialPolicy(entityID string) (*CredentialPolicyEvaluation, *EntityProviderError)
+
 	// CreateEntity creates a new entity.
 	CreateEntity(entity *Entity,
 		systemCredentials json.RawMessage) (*Entity, *EntityProviderError)
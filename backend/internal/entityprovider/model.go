@@ -46,6 +46,15 @@ type EntityState string
 const (
 	// EntityStateActive represents an active entity.
 	EntityStateActive EntityState = "ACTIVE"
+	// EntityStatePendingVerification represents an entity that is awaiting verification of its email
+	// address before it can be used.
+	EntityStatePendingVerification EntityState = "PENDING_VERIFICATION"
+	// EntityStatePendingDeletion represents an entity whose deletion is scheduled and that cannot sign in
+	// until the deletion is cancelled.
+	EntityStatePendingDeletion EntityState = "PENDING_DELETION"
+	// EntityStateDeleted represents an entity that was soft-deleted and is kept until it is restored or
+	// purged.
+	EntityStateDeleted EntityState = "DELETED"
 )
 
 // String returns the string representation of the entity state.
@@ -65,6 +74,15 @@ type Entity struct {
 	SystemAttributes json.RawMessage `json:"systemAttributes,omitempty"`
 }
 
+// CredentialPolicyEvaluation is the result of evaluating the credentials held by an entity against the
+// credential policy of its entity type.
+type CredentialPolicyEvaluation struct {
+	Satisfied               bool       `json:"satisfied"`
+	MissingRequired         []string   `json:"missingRequired,omitempty"`
+	UnsatisfiedAlternatives [][]string `json:"unsatisfiedAlternatives,omitempty"`
+	MissingFactors          int        `json:"missingFactors,omitempty"`
+}
+
 // EntityGroup represents a group with basic information for entity group membership queries.
 type EntityGroup struct {
 	ID   string `json:"id"`
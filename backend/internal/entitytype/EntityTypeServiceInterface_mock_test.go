@@ -264,8 +264,8 @@ func (_c *EntityTypeServiceInterfaceMock_GetAttributes_Call) Run(run func(ctx co
 	return _c
 }
 
-func (_c *EntityTypeServiceInterfaceMock_GetAttributes_Call) Return(vs []AttributeInfo, serviceError *serviceerror.ServiceError) *EntityTypeServiceInterfaceMock_GetAttributes_Call {
-	_c.Call.Return(vs, serviceError)
+func (_c *EntityTypeServiceInterfaceMock_GetAttributes_Call) Return(attributeInfos []AttributeInfo, serviceError *serviceerror.ServiceError) *EntityTypeServiceInterfaceMock_GetAttributes_Call {
+	_c.Call.Return(attributeInfos, serviceError)
 	return _c
 }
 
@@ -274,6 +274,82 @@ func (_c *EntityTypeServiceInterfaceMock_GetAttributes_Call) RunAndReturn(run fu
 	return _c
 }
 
+// GetCredentialPolicy provides a mock function for the type EntityTypeServiceInterfaceMock
+func (_mock *EntityTypeServiceInterfaceMock) GetCredentialPolicy(ctx context.Context, category TypeCategory, entityType string) (*CredentialPolicy, *serviceerror.ServiceError) {
+	ret := _mock.Called(ctx, category, entityType)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCredentialPolicy")
+	}
+
+	var r0 *CredentialPolicy
+	var r1 *serviceerror.ServiceError
+	if returnFunc, ok := ret.Get(0).(func(context.Context, TypeCategory, string) (*CredentialPolicy, *serviceerror.ServiceError)); ok {
+		return returnFunc(ctx, category, entityType)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, TypeCategory, string) *CredentialPolicy); ok {
+		r0 = returnFunc(ctx, category, entityType)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*CredentialPolicy)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, TypeCategory, string) *serviceerror.ServiceError); ok {
+		r1 = returnFunc(ctx, category, entityType)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*serviceerror.ServiceError)
+		}
+	}
+	return r0, r1
+}
+
+// EntityTypeServiceInterfaceMock_GetCredentialPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCredentialPolicy'
+type EntityTypeServiceInterfaceMock_GetCredentialPolicy_Call struct {
+	*mock.Call
+}
+
+// GetCredentialPolicy is a helper method to define mock.On call
+//   - ctx context.Context
+//   - category TypeCategory
+//   - entityType string
+func (_e *EntityTypeServiceInterfaceMock_Expecter) GetCredentialPolicy(ctx interface{}, category interface{}, entityType interface{}) *EntityTypeServiceInterfaceMock_GetCredentialPolicy_Call {
+	return &EntityTypeServiceInterfaceMock_GetCredentialPolicy_Call{Call: _e.mock.On("GetCredentialPolicy", ctx, category, entityType)}
+}
+
+func (_c *EntityTypeServiceInterfaceMock_GetCredentialPolicy_Call) Run(run func(ctx context.Context, category TypeCategory, entityType string)) *EntityTypeServiceInterfaceMock_GetCredentialPolicy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 TypeCategory
+		if args[1] != nil {
+			arg1 = args[1].(TypeCategory)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *EntityTypeServiceInterfaceMock_GetCredentialPolicy_Call) Return(credentialPolicy *CredentialPolicy, serviceError *serviceerror.ServiceError) *EntityTypeServiceInterfaceMock_GetCredentialPolicy_Call {
+	_c.Call.Return(credentialPolicy, serviceError)
+	return _c
+}
+
+func (_c *EntityTypeServiceInterfaceMock_GetCredentialPolicy_Call) RunAndReturn(run func(ctx context.Context, category TypeCategory, entityType string) (*CredentialPolicy, *serviceerror.ServiceError)) *EntityTypeServiceInterfaceMock_GetCredentialPolicy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetDisplayAttributesByNames provides a mock function for the type EntityTypeServiceInterfaceMock
 func (_mock *EntityTypeServiceInterfaceMock) GetDisplayAttributesByNames(ctx context.Context, category TypeCategory, names []string) (map[string]string, *serviceerror.ServiceError) {
 	ret := _mock.Called(ctx, category, names)
@@ -596,6 +672,234 @@ func (_c *EntityTypeServiceInterfaceMock_GetEntityTypeList_Call) RunAndReturn(ru
 	return _c
 }
 
+// GetFormMetadata provides a mock function for the type EntityTypeServiceInterfaceMock
+func (_mock *EntityTypeServiceInterfaceMock) GetFormMetadata(ctx context.Context, category TypeCategory, schemaID string) (*FormMetadata, *serviceerror.ServiceError) {
+	ret := _mock.Called(ctx, category, schemaID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFormMetadata")
+	}
+
+	var r0 *FormMetadata
+	var r1 *serviceerror.ServiceError
+	if returnFunc, ok := ret.Get(0).(func(context.Context, TypeCategory, string) (*FormMetadata, *serviceerror.ServiceError)); ok {
+		return returnFunc(ctx, category, schemaID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, TypeCategory, string) *FormMetadata); ok {
+		r0 = returnFunc(ctx, category, schemaID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*FormMetadata)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, TypeCategory, string) *serviceerror.ServiceError); ok {
+		r1 = returnFunc(ctx, category, schemaID)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*serviceerror.ServiceError)
+		}
+	}
+	return r0, r1
+}
+
+// EntityTypeServiceInterfaceMock_GetFormMetadata_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFormMetadata'
+type EntityTypeServiceInterfaceMock_GetFormMetadata_Call struct {
+	*mock.Call
+}
+
+// GetFormMetadata is a helper method to define mock.On call
+//   - ctx context.Context
+//   - category TypeCategory
+//   - schemaID string
+func (_e *EntityTypeServiceInterfaceMock_Expecter) GetFormMetadata(ctx interface{}, category interface{}, schemaID interface{}) *EntityTypeServiceInterfaceMock_GetFormMetadata_Call {
+	return &EntityTypeServiceInterfaceMock_GetFormMetadata_Call{Call: _e.mock.On("GetFormMetadata", ctx, category, schemaID)}
+}
+
+func (_c *EntityTypeServiceInterfaceMock_GetFormMetadata_Call) Run(run func(ctx context.Context, category TypeCategory, schemaID string)) *EntityTypeServiceInterfaceMock_GetFormMetadata_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 TypeCategory
+		if args[1] != nil {
+			arg1 = args[1].(TypeCategory)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *EntityTypeServiceInterfaceMock_GetFormMetadata_Call) Return(formMetadata *FormMetadata, serviceError *serviceerror.ServiceError) *EntityTypeServiceInterfaceMock_GetFormMetadata_Call {
+	_c.Call.Return(formMetadata, serviceError)
+	return _c
+}
+
+func (_c *EntityTypeServiceInterfaceMock_GetFormMetadata_Call) RunAndReturn(run func(ctx context.Context, category TypeCategory, schemaID string) (*FormMetadata, *serviceerror.ServiceError)) *EntityTypeServiceInterfaceMock_GetFormMetadata_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPasswordPolicy provides a mock function for the type EntityTypeServiceInterfaceMock
+func (_mock *EntityTypeServiceInterfaceMock) GetPasswordPolicy(ctx context.Context, category TypeCategory, entityType string) (*PasswordPolicy, *serviceerror.ServiceError) {
+	ret := _mock.Called(ctx, category, entityType)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPasswordPolicy")
+	}
+
+	var r0 *PasswordPolicy
+	var r1 *serviceerror.ServiceError
+	if returnFunc, ok := ret.Get(0).(func(context.Context, TypeCategory, string) (*PasswordPolicy, *serviceerror.ServiceError)); ok {
+		return returnFunc(ctx, category, entityType)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, TypeCategory, string) *PasswordPolicy); ok {
+		r0 = returnFunc(ctx, category, entityType)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*PasswordPolicy)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, TypeCategory, string) *serviceerror.ServiceError); ok {
+		r1 = returnFunc(ctx, category, entityType)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*serviceerror.ServiceError)
+		}
+	}
+	return r0, r1
+}
+
+// EntityTypeServiceInterfaceMock_GetPasswordPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPasswordPolicy'
+type EntityTypeServiceInterfaceMock_GetPasswordPolicy_Call struct {
+	*mock.Call
+}
+
+// GetPasswordPolicy is a helper method to define mock.On call
+//   - ctx context.Context
+//   - category TypeCategory
+//   - entityType string
+func (_e *EntityTypeServiceInterfaceMock_Expecter) GetPasswordPolicy(ctx interface{}, category interface{}, entityType interface{}) *EntityTypeServiceInterfaceMock_GetPasswordPolicy_Call {
+	return &EntityTypeServiceInterfaceMock_GetPasswordPolicy_Call{Call: _e.mock.On("GetPasswordPolicy", ctx, category, entityType)}
+}
+
+func (_c *EntityTypeServiceInterfaceMock_GetPasswordPolicy_Call) Run(run func(ctx context.Context, category TypeCategory, entityType string)) *EntityTypeServiceInterfaceMock_GetPasswordPolicy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 TypeCategory
+		if args[1] != nil {
+			arg1 = args[1].(TypeCategory)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *EntityTypeServiceInterfaceMock_GetPasswordPolicy_Call) Return(passwordPolicy *PasswordPolicy, serviceError *serviceerror.ServiceError) *EntityTypeServiceInterfaceMock_GetPasswordPolicy_Call {
+	_c.Call.Return(passwordPolicy, serviceError)
+	return _c
+}
+
+func (_c *EntityTypeServiceInterfaceMock_GetPasswordPolicy_Call) RunAndReturn(run func(ctx context.Context, category TypeCategory, entityType string) (*PasswordPolicy, *serviceerror.ServiceError)) *EntityTypeServiceInterfaceMock_GetPasswordPolicy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSensitiveAttributes provides a mock function for the type EntityTypeServiceInterfaceMock
+func (_mock *EntityTypeServiceInterfaceMock) GetSensitiveAttributes(ctx context.Context, category TypeCategory, entityType string) ([]string, *serviceerror.ServiceError) {
+	ret := _mock.Called(ctx, category, entityType)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSensitiveAttributes")
+	}
+
+	var r0 []string
+	var r1 *serviceerror.ServiceError
+	if returnFunc, ok := ret.Get(0).(func(context.Context, TypeCategory, string) ([]string, *serviceerror.ServiceError)); ok {
+		return returnFunc(ctx, category, entityType)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, TypeCategory, string) []string); ok {
+		r0 = returnFunc(ctx, category, entityType)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, TypeCategory, string) *serviceerror.ServiceError); ok {
+		r1 = returnFunc(ctx, category, entityType)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*serviceerror.ServiceError)
+		}
+	}
+	return r0, r1
+}
+
+// EntityTypeServiceInterfaceMock_GetSensitiveAttributes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSensitiveAttributes'
+type EntityTypeServiceInterfaceMock_GetSensitiveAttributes_Call struct {
+	*mock.Call
+}
+
+// GetSensitiveAttributes is a helper method to define mock.On call
+//   - ctx context.Context
+//   - category TypeCategory
+//   - entityType string
+func (_e *EntityTypeServiceInterfaceMock_Expecter) GetSensitiveAttributes(ctx interface{}, category interface{}, entityType interface{}) *EntityTypeServiceInterfaceMock_GetSensitiveAttributes_Call {
+	return &EntityTypeServiceInterfaceMock_GetSensitiveAttributes_Call{Call: _e.mock.On("GetSensitiveAttributes", ctx, category, entityType)}
+}
+
+func (_c *EntityTypeServiceInterfaceMock_GetSensitiveAttributes_Call) Run(run func(ctx context.Context, category TypeCategory, entityType string)) *EntityTypeServiceInterfaceMock_GetSensitiveAttributes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 TypeCategory
+		if args[1] != nil {
+			arg1 = args[1].(TypeCategory)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *EntityTypeServiceInterfaceMock_GetSensitiveAttributes_Call) Return(strings []string, serviceError *serviceerror.ServiceError) *EntityTypeServiceInterfaceMock_GetSensitiveAttributes_Call {
+	_c.Call.Return(strings, serviceError)
+	return _c
+}
+
+func (_c *EntityTypeServiceInterfaceMock_GetSensitiveAttributes_Call) RunAndReturn(run func(ctx context.Context, category TypeCategory, entityType string) ([]string, *serviceerror.ServiceError)) *EntityTypeServiceInterfaceMock_GetSensitiveAttributes_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetUniqueAttributes provides a mock function for the type EntityTypeServiceInterfaceMock
 func (_mock *EntityTypeServiceInterfaceMock) GetUniqueAttributes(ctx context.Context, category TypeCategory, entityType string) ([]string, *serviceerror.ServiceError) {
 	ret := _mock.Called(ctx, category, entityType)
@@ -672,6 +976,292 @@ func (_c *EntityTypeServiceInterfaceMock_GetUniqueAttributes_Call) RunAndReturn(
 	return _c
 }
 
+// NormalizeEntity provides a mock function for the type EntityTypeServiceInterfaceMock
+func (_mock *EntityTypeServiceInterfaceMock) NormalizeEntity(ctx context.Context, category TypeCategory, entityType string, attributes json.RawMessage) (json.RawMessage, *serviceerror.ServiceError) {
+	ret := _mock.Called(ctx, category, entityType, attributes)
+
+	if len(ret) == 0 {
+		panic("no return value specified for NormalizeEntity")
+	}
+
+	var r0 json.RawMessage
+	var r1 *serviceerror.ServiceError
+	if returnFunc, ok := ret.Get(0).(func(context.Context, TypeCategory, string, json.RawMessage) (json.RawMessage, *serviceerror.ServiceError)); ok {
+		return returnFunc(ctx, category, entityType, attributes)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, TypeCategory, string, json.RawMessage) json.RawMessage); ok {
+		r0 = returnFunc(ctx, category, entityType, attributes)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(json.RawMessage)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, TypeCategory, string, json.RawMessage) *serviceerror.ServiceError); ok {
+		r1 = returnFunc(ctx, category, entityType, attributes)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*serviceerror.ServiceError)
+		}
+	}
+	return r0, r1
+}
+
+// EntityTypeServiceInterfaceMock_NormalizeEntity_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'NormalizeEntity'
+type EntityTypeServiceInterfaceMock_NormalizeEntity_Call struct {
+	*mock.Call
+}
+
+// NormalizeEntity is a helper method to define mock.On call
+//   - ctx context.Context
+//   - category TypeCategory
+//   - entityType string
+//   - attributes json.RawMessage
+func (_e *EntityTypeServiceInterfaceMock_Expecter) NormalizeEntity(ctx interface{}, category interface{}, entityType interface{}, attributes interface{}) *EntityTypeServiceInterfaceMock_NormalizeEntity_Call {
+	return &EntityTypeServiceInterfaceMock_NormalizeEntity_Call{Call: _e.mock.On("NormalizeEntity", ctx, category, entityType, attributes)}
+}
+
+func (_c *EntityTypeServiceInterfaceMock_NormalizeEntity_Call) Run(run func(ctx context.Context, category TypeCategory, entityType string, attributes json.RawMessage)) *EntityTypeServiceInterfaceMock_NormalizeEntity_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 TypeCategory
+		if args[1] != nil {
+			arg1 = args[1].(TypeCategory)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 json.RawMessage
+		if args[3] != nil {
+			arg3 = args[3].(json.RawMessage)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *EntityTypeServiceInterfaceMock_NormalizeEntity_Call) Return(rawMessage json.RawMessage, serviceError *serviceerror.ServiceError) *EntityTypeServiceInterfaceMock_NormalizeEntity_Call {
+	_c.Call.Return(rawMessage, serviceError)
+	return _c
+}
+
+func (_c *EntityTypeServiceInterfaceMock_NormalizeEntity_Call) RunAndReturn(run func(ctx context.Context, category TypeCategory, entityType string, attributes json.RawMessage) (json.RawMessage, *serviceerror.ServiceError)) *EntityTypeServiceInterfaceMock_NormalizeEntity_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NormalizeSamples provides a mock function for the type EntityTypeServiceInterfaceMock
+func (_mock *EntityTypeServiceInterfaceMock) NormalizeSamples(ctx context.Context, category TypeCategory, request SampleNormalizationRequest) (*SampleNormalizationResponse, *serviceerror.ServiceError) {
+	ret := _mock.Called(ctx, category, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for NormalizeSamples")
+	}
+
+	var r0 *SampleNormalizationResponse
+	var r1 *serviceerror.ServiceError
+	if returnFunc, ok := ret.Get(0).(func(context.Context, TypeCategory, SampleNormalizationRequest) (*SampleNormalizationResponse, *serviceerror.ServiceError)); ok {
+		return returnFunc(ctx, category, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, TypeCategory, SampleNormalizationRequest) *SampleNormalizationResponse); ok {
+		r0 = returnFunc(ctx, category, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*SampleNormalizationResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, TypeCategory, SampleNormalizationRequest) *serviceerror.ServiceError); ok {
+		r1 = returnFunc(ctx, category, request)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*serviceerror.ServiceError)
+		}
+	}
+	return r0, r1
+}
+
+// EntityTypeServiceInterfaceMock_NormalizeSamples_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'NormalizeSamples'
+type EntityTypeServiceInterfaceMock_NormalizeSamples_Call struct {
+	*mock.Call
+}
+
+// NormalizeSamples is a helper method to define mock.On call
+//   - ctx context.Context
+//   - category TypeCategory
+//   - request SampleNormalizationRequest
+func (_e *EntityTypeServiceInterfaceMock_Expecter) NormalizeSamples(ctx interface{}, category interface{}, request interface{}) *EntityTypeServiceInterfaceMock_NormalizeSamples_Call {
+	return &EntityTypeServiceInterfaceMock_NormalizeSamples_Call{Call: _e.mock.On("NormalizeSamples", ctx, category, request)}
+}
+
+func (_c *EntityTypeServiceInterfaceMock_NormalizeSamples_Call) Run(run func(ctx context.Context, category TypeCategory, request SampleNormalizationRequest)) *EntityTypeServiceInterfaceMock_NormalizeSamples_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 TypeCategory
+		if args[1] != nil {
+			arg1 = args[1].(TypeCategory)
+		}
+		var arg2 SampleNormalizationRequest
+		if args[2] != nil {
+			arg2 = args[2].(SampleNormalizationRequest)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *EntityTypeServiceInterfaceMock_NormalizeSamples_Call) Return(sampleNormalizationResponse *SampleNormalizationResponse, serviceError *serviceerror.ServiceError) *EntityTypeServiceInterfaceMock_NormalizeSamples_Call {
+	_c.Call.Return(sampleNormalizationResponse, serviceError)
+	return _c
+}
+
+func (_c *EntityTypeServiceInterfaceMock_NormalizeSamples_Call) RunAndReturn(run func(ctx context.Context, category TypeCategory, request SampleNormalizationRequest) (*SampleNormalizationResponse, *serviceerror.ServiceError)) *EntityTypeServiceInterfaceMock_NormalizeSamples_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RegisterDuplicateValueCounter provides a mock function for the type EntityTypeServiceInterfaceMock
+func (_mock *EntityTypeServiceInterfaceMock) RegisterDuplicateValueCounter(counter DuplicateValueCounter) {
+	_mock.Called(counter)
+	return
+}
+
+// EntityTypeServiceInterfaceMock_RegisterDuplicateValueCounter_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RegisterDuplicateValueCounter'
+type EntityTypeServiceInterfaceMock_RegisterDuplicateValueCounter_Call struct {
+	*mock.Call
+}
+
+// RegisterDuplicateValueCounter is a helper method to define mock.On call
+//   - counter DuplicateValueCounter
+func (_e *EntityTypeServiceInterfaceMock_Expecter) RegisterDuplicateValueCounter(counter interface{}) *EntityTypeServiceInterfaceMock_RegisterDuplicateValueCounter_Call {
+	return &EntityTypeServiceInterfaceMock_RegisterDuplicateValueCounter_Call{Call: _e.mock.On("RegisterDuplicateValueCounter", counter)}
+}
+
+func (_c *EntityTypeServiceInterfaceMock_RegisterDuplicateValueCounter_Call) Run(run func(counter DuplicateValueCounter)) *EntityTypeServiceInterfaceMock_RegisterDuplicateValueCounter_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 DuplicateValueCounter
+		if args[0] != nil {
+			arg0 = args[0].(DuplicateValueCounter)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *EntityTypeServiceInterfaceMock_RegisterDuplicateValueCounter_Call) Return() *EntityTypeServiceInterfaceMock_RegisterDuplicateValueCounter_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *EntityTypeServiceInterfaceMock_RegisterDuplicateValueCounter_Call) RunAndReturn(run func(counter DuplicateValueCounter)) *EntityTypeServiceInterfaceMock_RegisterDuplicateValueCounter_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RetainContactVerification provides a mock function for the type EntityTypeServiceInterfaceMock
+func (_mock *EntityTypeServiceInterfaceMock) RetainContactVerification(ctx context.Context, category TypeCategory, entityType string, previous json.RawMessage, next json.RawMessage) (json.RawMessage, *serviceerror.ServiceError) {
+	ret := _mock.Called(ctx, category, entityType, previous, next)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RetainContactVerification")
+	}
+
+	var r0 json.RawMessage
+	var r1 *serviceerror.ServiceError
+	if returnFunc, ok := ret.Get(0).(func(context.Context, TypeCategory, string, json.RawMessage, json.RawMessage) (json.RawMessage, *serviceerror.ServiceError)); ok {
+		return returnFunc(ctx, category, entityType, previous, next)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, TypeCategory, string, json.RawMessage, json.RawMessage) json.RawMessage); ok {
+		r0 = returnFunc(ctx, category, entityType, previous, next)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(json.RawMessage)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, TypeCategory, string, json.RawMessage, json.RawMessage) *serviceerror.ServiceError); ok {
+		r1 = returnFunc(ctx, category, entityType, previous, next)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*serviceerror.ServiceError)
+		}
+	}
+	return r0, r1
+}
+
+// EntityTypeServiceInterfaceMock_RetainContactVerification_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RetainContactVerification'
+type EntityTypeServiceInterfaceMock_RetainContactVerification_Call struct {
+	*mock.Call
+}
+
+// RetainContactVerification is a helper method to define mock.On call
+//   - ctx context.Context
+//   - category TypeCategory
+//   - entityType string
+//   - previous json.RawMessage
+//   - next json.RawMessage
+func (_e *EntityTypeServiceInterfaceMock_Expecter) RetainContactVerification(ctx interface{}, category interface{}, entityType interface{}, previous interface{}, next interface{}) *EntityTypeServiceInterfaceMock_RetainContactVerification_Call {
+	return &EntityTypeServiceInterfaceMock_RetainContactVerification_Call{Call: _e.mock.On("RetainContactVerification", ctx, category, entityType, previous, next)}
+}
+
+func (_c *EntityTypeServiceInterfaceMock_RetainContactVerification_Call) Run(run func(ctx context.Context, category TypeCategory, entityType string, previous json.RawMessage, next json.RawMessage)) *EntityTypeServiceInterfaceMock_RetainContactVerification_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 TypeCategory
+		if args[1] != nil {
+			arg1 = args[1].(TypeCategory)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 json.RawMessage
+		if args[3] != nil {
+			arg3 = args[3].(json.RawMessage)
+		}
+		var arg4 json.RawMessage
+		if args[4] != nil {
+			arg4 = args[4].(json.RawMessage)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+		)
+	})
+	return _c
+}
+
+func (_c *EntityTypeServiceInterfaceMock_RetainContactVerification_Call) Return(rawMessage json.RawMessage, serviceError *serviceerror.ServiceError) *EntityTypeServiceInterfaceMock_RetainContactVerification_Call {
+	_c.Call.Return(rawMessage, serviceError)
+	return _c
+}
+
+func (_c *EntityTypeServiceInterfaceMock_RetainContactVerification_Call) RunAndReturn(run func(ctx context.Context, category TypeCategory, entityType string, previous json.RawMessage, next json.RawMessage) (json.RawMessage, *serviceerror.ServiceError)) *EntityTypeServiceInterfaceMock_RetainContactVerification_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateEntityType provides a mock function for the type EntityTypeServiceInterfaceMock
 func (_mock *EntityTypeServiceInterfaceMock) UpdateEntityType(ctx context.Context, category TypeCategory, schemaID string, request UpdateEntityTypeRequest) (*EntityType, *serviceerror.ServiceError) {
 	ret := _mock.Called(ctx, category, schemaID, request)
@@ -841,7 +1431,7 @@ func (_c *EntityTypeServiceInterfaceMock_ValidateEntity_Call) RunAndReturn(run f
 }
 
 // ValidateEntityUniqueness provides a mock function for the type EntityTypeServiceInterfaceMock
-func (_mock *EntityTypeServiceInterfaceMock) ValidateEntityUniqueness(ctx context.Context, category TypeCategory, entityType string, attributes json.RawMessage, exists func(map[string]interface{}) (bool, error)) (bool, *serviceerror.ServiceError) {
+func (_mock *EntityTypeServiceInterfaceMock) ValidateEntityUniqueness(ctx context.Context, category TypeCategory, entityType string, attributes json.RawMessage, exists ExistsFunc) (bool, *serviceerror.ServiceError) {
 	ret := _mock.Called(ctx, category, entityType, attributes, exists)
 
 	if len(ret) == 0 {
@@ -850,15 +1440,15 @@ func (_mock *EntityTypeServiceInterfaceMock) ValidateEntityUniqueness(ctx contex
 
 	var r0 bool
 	var r1 *serviceerror.ServiceError
-	if returnFunc, ok := ret.Get(0).(func(context.Context, TypeCategory, string, json.RawMessage, func(map[string]interface{}) (bool, error)) (bool, *serviceerror.ServiceError)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, TypeCategory, string, json.RawMessage, ExistsFunc) (bool, *serviceerror.ServiceError)); ok {
 		return returnFunc(ctx, category, entityType, attributes, exists)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, TypeCategory, string, json.RawMessage, func(map[string]interface{}) (bool, error)) bool); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, TypeCategory, string, json.RawMessage, ExistsFunc) bool); ok {
 		r0 = returnFunc(ctx, category, entityType, attributes, exists)
 	} else {
 		r0 = ret.Get(0).(bool)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, TypeCategory, string, json.RawMessage, func(map[string]interface{}) (bool, error)) *serviceerror.ServiceError); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, TypeCategory, string, json.RawMessage, ExistsFunc) *serviceerror.ServiceError); ok {
 		r1 = returnFunc(ctx, category, entityType, attributes, exists)
 	} else {
 		if ret.Get(1) != nil {
@@ -878,12 +1468,12 @@ type EntityTypeServiceInterfaceMock_ValidateEntityUniqueness_Call struct {
 //   - category TypeCategory
 //   - entityType string
 //   - attributes json.RawMessage
-//   - exists func(map[string]interface{}) (bool, error)
+//   - exists ExistsFunc
 func (_e *EntityTypeServiceInterfaceMock_Expecter) ValidateEntityUniqueness(ctx interface{}, category interface{}, entityType interface{}, attributes interface{}, exists interface{}) *EntityTypeServiceInterfaceMock_ValidateEntityUniqueness_Call {
 	return &EntityTypeServiceInterfaceMock_ValidateEntityUniqueness_Call{Call: _e.mock.On("ValidateEntityUniqueness", ctx, category, entityType, attributes, exists)}
 }
 
-func (_c *EntityTypeServiceInterfaceMock_ValidateEntityUniqueness_Call) Run(run func(ctx context.Context, category TypeCategory, entityType string, attributes json.RawMessage, exists func(map[string]interface{}) (bool, error))) *EntityTypeServiceInterfaceMock_ValidateEntityUniqueness_Call {
+func (_c *EntityTypeServiceInterfaceMock_ValidateEntityUniqueness_Call) Run(run func(ctx context.Context, category TypeCategory, entityType string, attributes json.RawMessage, exists ExistsFunc)) *EntityTypeServiceInterfaceMock_ValidateEntityUniqueness_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -901,9 +1491,9 @@ func (_c *EntityTypeServiceInterfaceMock_ValidateEntityUniqueness_Call) Run(run
 		if args[3] != nil {
 			arg3 = args[3].(json.RawMessage)
 		}
-		var arg4 func(map[string]interface{}) (bool, error)
+		var arg4 ExistsFunc
 		if args[4] != nil {
-			arg4 = args[4].(func(map[string]interface{}) (bool, error))
+			arg4 = args[4].(ExistsFunc)
 		}
 		run(
 			arg0,
@@ -921,7 +1511,95 @@ func (_c *EntityTypeServiceInterfaceMock_ValidateEntityUniqueness_Call) Return(b
 	return _c
 }
 
-func (_c *EntityTypeServiceInterfaceMock_ValidateEntityUniqueness_Call) RunAndReturn(run func(ctx context.Context, category TypeCategory, entityType string, attributes json.RawMessage, exists func(map[string]interface{}) (bool, error)) (bool, *serviceerror.ServiceError)) *EntityTypeServiceInterfaceMock_ValidateEntityUniqueness_Call {
+func (_c *EntityTypeServiceInterfaceMock_ValidateEntityUniqueness_Call) RunAndReturn(run func(ctx context.Context, category TypeCategory, entityType string, attributes json.RawMessage, exists ExistsFunc) (bool, *serviceerror.ServiceError)) *EntityTypeServiceInterfaceMock_ValidateEntityUniqueness_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ValidateSamples provides a mock function for the type EntityTypeServiceInterfaceMock
+func (_mock *EntityTypeServiceInterfaceMock) ValidateSamples(ctx context.Context, category TypeCategory, request SampleValidationRequest, exists func(map[string]interface{}) (bool, error), indexedAttributes []string) (*SampleValidationResponse, *serviceerror.ServiceError) {
+	ret := _mock.Called(ctx, category, request, exists, indexedAttributes)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ValidateSamples")
+	}
+
+	var r0 *SampleValidationResponse
+	var r1 *serviceerror.ServiceError
+	if returnFunc, ok := ret.Get(0).(func(context.Context, TypeCategory, SampleValidationRequest, func(map[string]interface{}) (bool, error), []string) (*SampleValidationResponse, *serviceerror.ServiceError)); ok {
+		return returnFunc(ctx, category, request, exists, indexedAttributes)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, TypeCategory, SampleValidationRequest, func(map[string]interface{}) (bool, error), []string) *SampleValidationResponse); ok {
+		r0 = returnFunc(ctx, category, request, exists, indexedAttributes)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*SampleValidationResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, TypeCategory, SampleValidationRequest, func(map[string]interface{}) (bool, error), []string) *serviceerror.ServiceError); ok {
+		r1 = returnFunc(ctx, category, request, exists, indexedAttributes)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*serviceerror.ServiceError)
+		}
+	}
+	return r0, r1
+}
+
+// EntityTypeServiceInterfaceMock_ValidateSamples_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ValidateSamples'
+type EntityTypeServiceInterfaceMock_ValidateSamples_Call struct {
+	*mock.Call
+}
+
+// ValidateSamples is a helper method to define mock.On call
+//   - ctx context.Context
+//   - category TypeCategory
+//   - request SampleValidationRequest
+//   - exists func(map[string]interface{}) (bool, error)
+//   - indexedAttributes []string
+func (_e *EntityTypeServiceInterfaceMock_Expecter) ValidateSamples(ctx interface{}, category interface{}, request interface{}, exists interface{}, indexedAttributes interface{}) *EntityTypeServiceInterfaceMock_ValidateSamples_Call {
+	return &EntityTypeServiceInterfaceMock_ValidateSamples_Call{Call: _e.mock.On("ValidateSamples", ctx, category, request, exists, indexedAttributes)}
+}
+
+func (_c *EntityTypeServiceInterfaceMock_ValidateSamples_Call) Run(run func(ctx context.Context, category TypeCategory, request SampleValidationRequest, exists func(map[string]interface{}) (bool, error), indexedAttributes []string)) *EntityTypeServiceInterfaceMock_ValidateSamples_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 TypeCategory
+		if args[1] != nil {
+			arg1 = args[1].(TypeCategory)
+		}
+		var arg2 SampleValidationRequest
+		if args[2] != nil {
+			arg2 = args[2].(SampleValidationRequest)
+		}
+		var arg3 func(map[string]interface{}) (bool, error)
+		if args[3] != nil {
+			arg3 = args[3].(func(map[string]interface{}) (bool, error))
+		}
+		var arg4 []string
+		if args[4] != nil {
+			arg4 = args[4].([]string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+		)
+	})
+	return _c
+}
+
+func (_c *EntityTypeServiceInterfaceMock_ValidateSamples_Call) Return(sampleValidationResponse *SampleValidationResponse, serviceError *serviceerror.ServiceError) *EntityTypeServiceInterfaceMock_ValidateSamples_Call {
+	_c.Call.Return(sampleValidationResponse, serviceError)
+	return _c
+}
+
+func (_c *EntityTypeServiceInterfaceMock_ValidateSamples_Call) RunAndReturn(run func(ctx context.Context, category TypeCategory, request SampleValidationRequest, exists func(map[string]interface{}) (bool, error), indexedAttributes []string) (*SampleValidationResponse, *serviceerror.ServiceError)) *EntityTypeServiceInterfaceMock_ValidateSamples_Call {
 	_c.Call.Return(run)
 	return _c
 }
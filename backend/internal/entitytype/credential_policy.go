@@ -0,0 +1,137 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package entitytype
+
+import (
+	"slices"
+
+	"github.com/thunder-id/thunderid/internal/entitytype/model"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+)
+
+// systemManagedCredentialTypes lists the credential types that are managed by the system rather than
+// declared in a schema. They are enrolled after an entity is created.
+var systemManagedCredentialTypes = []string{"passkey"}
+
+// IsSystemManagedCredentialType reports whether the credential type is managed by the system rather
+// than declared in a schema.
+func IsSystemManagedCredentialType(credentialType string) bool {
+	return slices.Contains(systemManagedCredentialTypes, credentialType)
+}
+
+// Evaluate evaluates the credential types held by an entity against the policy. A nil policy is always
+// satisfied.
+func (p *CredentialPolicy) Evaluate(held []string) *CredentialPolicyEvaluation {
+	evaluation := &CredentialPolicyEvaluation{Satisfied: true}
+	if p == nil {
+		return evaluation
+	}
+
+	for _, credentialType := range p.Required {
+		if !slices.Contains(held, credentialType) {
+			evaluation.MissingRequired = append(evaluation.MissingRequired, credentialType)
+		}
+	}
+	for _, alternative := range p.Alternatives {
+		if !slices.ContainsFunc(alternative, func(credentialType string) bool {
+			return slices.Contains(held, credentialType)
+		}) {
+			evaluation.UnsatisfiedAlternatives = append(evaluation.UnsatisfiedAlternatives, alternative)
+		}
+	}
+	distinct := make(map[string]struct{}, len(held))
+	for _, credentialType := range held {
+		distinct[credentialType] = struct{}{}
+	}
+	if len(distinct) < p.MinimumFactors {
+		evaluation.MissingFactors = p.MinimumFactors - len(distinct)
+	}
+
+	evaluation.Satisfied = len(evaluation.MissingRequired) == 0 &&
+		len(evaluation.UnsatisfiedAlternatives) == 0 && evaluation.MissingFactors == 0
+	return evaluation
+}
+
+// EvaluateEnrollable evaluates the credential types held by an entity against the policy, assuming that
+// the system-managed credential types are enrolled later. It is used when an entity is created or
+// changes type, as system-managed credentials cannot be provided at that point.
+func (p *CredentialPolicy) EvaluateEnrollable(held []string) *CredentialPolicyEvaluation {
+	enrollable := slices.Clone(held)
+	for _, credentialType := range systemManagedCredentialTypes {
+		if !slices.Contains(enrollable, credentialType) {
+			enrollable = append(enrollable, credentialType)
+		}
+	}
+	return p.Evaluate(enrollable)
+}
+
+// Regresses reports whether the evaluation violates the policy in a way the previous evaluation did not,
+// such as when the last credential of a required type is removed.
+func (e *CredentialPolicyEvaluation) Regresses(previous *CredentialPolicyEvaluation) bool {
+	if previous == nil {
+		return !e.Satisfied
+	}
+	return len(e.MissingRequired) > len(previous.MissingRequired) ||
+		len(e.UnsatisfiedAlternatives) > len(previous.UnsatisfiedAlternatives) ||
+		e.MissingFactors > previous.MissingFactors
+}
+
+// credentialTypes returns the distinct credential types referenced by the policy.
+func (p *CredentialPolicy) credentialTypes() []string {
+	types := slices.Clone(p.Required)
+	for _, alternative := range p.Alternatives {
+		for _, credentialType := range alternative {
+			if !slices.Contains(types, credentialType) {
+				types = append(types, credentialType)
+			}
+		}
+	}
+	return types
+}
+
+// validateCredentialPolicy validates that the credential policy only references credential attributes
+// of the compiled schema or system-managed credential types, and that it can be satisfied.
+func validateCredentialPolicy(compiledSchema *model.Schema, policy *CredentialPolicy) *serviceerror.ServiceError {
+	if policy == nil {
+		return nil
+	}
+	if policy.MinimumFactors < 0 {
+		return &ErrorInvalidCredentialPolicy
+	}
+
+	known := slices.Clone(systemManagedCredentialTypes)
+	for _, attribute := range compiledSchema.GetAttributes(true, false, false) {
+		known = append(known, attribute.Attribute)
+	}
+	for _, alternative := range policy.Alternatives {
+		if len(alternative) == 0 {
+			return &ErrorInvalidCredentialPolicy
+		}
+	}
+	for _, credentialType := range policy.credentialTypes() {
+		if !slices.Contains(known, credentialType) {
+			return &ErrorInvalidCredentialPolicy
+		}
+	}
+	if policy.MinimumFactors > len(known) {
+		return &ErrorInvalidCredentialPolicy
+	}
+
+	return nil
+}
@@ -0,0 +1,124 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package entitytype
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/entitytype/model"
+)
+
+type CredentialPolicyTestSuite struct {
+	suite.Suite
+}
+
+func TestCredentialPolicyTestSuite(t *testing.T) {
+	suite.Run(t, new(CredentialPolicyTestSuite))
+}
+
+func (s *CredentialPolicyTestSuite) TestEvaluate_NilPolicyIsSatisfied() {
+	var policy *CredentialPolicy
+
+	evaluation := policy.Evaluate(nil)
+
+	s.True(evaluation.Satisfied)
+}
+
+func (s *CredentialPolicyTestSuite) TestEvaluate_RequiredCredentials() {
+	policy := &CredentialPolicy{Required: []string{"password", "passkey"}}
+
+	s.True(policy.Evaluate([]string{"passkey", "password"}).Satisfied)
+
+	evaluation := policy.Evaluate([]string{"password"})
+	s.False(evaluation.Satisfied)
+	s.Equal([]string{"passkey"}, evaluation.MissingRequired)
+}
+
+func (s *CredentialPolicyTestSuite) TestEvaluate_Alternatives() {
+	policy := &CredentialPolicy{Alternatives: [][]string{{"password", "passkey"}}}
+
+	s.True(policy.Evaluate([]string{"passkey"}).Satisfied)
+
+	evaluation := policy.Evaluate([]string{"pin"})
+	s.False(evaluation.Satisfied)
+	s.Equal([][]string{{"password", "passkey"}}, evaluation.UnsatisfiedAlternatives)
+}
+
+func (s *CredentialPolicyTestSuite) TestEvaluate_MinimumFactors() {
+	policy := &CredentialPolicy{MinimumFactors: 2}
+
+	s.True(policy.Evaluate([]string{"password", "passkey"}).Satisfied)
+
+	evaluation := policy.Evaluate([]string{"password", "password"})
+	s.False(evaluation.Satisfied)
+	s.Equal(1, evaluation.MissingFactors)
+}
+
+func (s *CredentialPolicyTestSuite) TestEvaluateEnrollable_DefersSystemManagedCredentials() {
+	policy := &CredentialPolicy{Required: []string{"password", "passkey"}, MinimumFactors: 2}
+
+	s.True(policy.EvaluateEnrollable([]string{"password"}).Satisfied)
+
+	evaluation := policy.EvaluateEnrollable(nil)
+	s.False(evaluation.Satisfied)
+	s.Equal([]string{"password"}, evaluation.MissingRequired)
+}
+
+func (s *CredentialPolicyTestSuite) TestRegresses() {
+	policy := &CredentialPolicy{Required: []string{"password"}, Alternatives: [][]string{{"pin", "passkey"}}}
+	before := policy.Evaluate([]string{"password", "passkey"})
+
+	s.False(policy.Evaluate([]string{"password", "passkey"}).Regresses(before))
+	s.True(policy.Evaluate([]string{"passkey"}).Regresses(before))
+	s.True(policy.Evaluate([]string{"password"}).Regresses(before))
+
+	unsatisfied := policy.Evaluate([]string{"pin"})
+	s.False(policy.Evaluate(nil).Regresses(policy.Evaluate([]string{})))
+	s.True(policy.Evaluate(nil).Regresses(unsatisfied))
+}
+
+func (s *CredentialPolicyTestSuite) TestValidateCredentialPolicy() {
+	compiled, err := model.CompileSchema(json.RawMessage(
+		`{"username":{"type":"string"},"password":{"type":"string","credential":true},` +
+			`"pin":{"type":"string","credential":true}}`))
+	s.Require().NoError(err)
+
+	s.Nil(validateCredentialPolicy(compiled, nil))
+	s.Nil(validateCredentialPolicy(compiled, &CredentialPolicy{
+		Required:       []string{"password"},
+		Alternatives:   [][]string{{"pin", "passkey"}},
+		MinimumFactors: 2,
+	}))
+
+	invalid := []*CredentialPolicy{
+		{Required: []string{"username"}},
+		{Alternatives: [][]string{{"otp"}}},
+		{Alternatives: [][]string{{}}},
+		{MinimumFactors: -1},
+		{MinimumFactors: 4},
+	}
+	for _, policy := range invalid {
+		svcErr := validateCredentialPolicy(compiled, policy)
+		s.Require().NotNil(svcErr)
+		s.Equal(ErrorInvalidCredentialPolicy.Code, svcErr.Code)
+	}
+}
@@ -234,6 +234,54 @@ var (
 			DefaultValue: "The default agent type cannot be deleted. Edit the schema instead",
 		},
 	}
+
+	// ErrorInvalidCredentialPolicy is the error returned when the credential policy of an entity type
+	// references unknown credential types or cannot be satisfied.
+	ErrorInvalidCredentialPolicy = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "USRS-1016",
+		Error: core.I18nMessage{
+			Key:          "error.entitytypeservice.invalid_credential_policy",
+			DefaultValue: "Invalid credential policy",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key: "error.entitytypeservice.invalid_credential_policy_description",
+			DefaultValue: "The credential policy must only reference credential attributes of the schema or " +
+				"system-managed credential types and must be satisfiable",
+		},
+	}
+
+	// ErrorUniquenessScopeConflict is the error returned when a schema update tightens the uniqueness scope
+	// of an attribute while existing entities of the type already share values of the attribute.
+	ErrorUniquenessScopeConflict = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "USRS-1017",
+		Error: core.I18nMessage{
+			Key:          "error.entitytypeservice.uniqueness_scope_conflict",
+			DefaultValue: "Uniqueness scope conflict",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key: "error.entitytypeservice.uniqueness_scope_conflict_description",
+			DefaultValue: "Existing entities of the type share values of an attribute within its new uniqueness " +
+				"scope. Resolve the duplicate values before tightening the scope",
+		},
+	}
+
+	// ErrorInvalidPasswordPolicy is the error returned when the password policy of an entity type has
+	// out of range values or references attributes that are not credential attributes of the schema.
+	ErrorInvalidPasswordPolicy = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "USRS-1018",
+		Error: core.I18nMessage{
+			Key:          "error.entitytypeservice.invalid_password_policy",
+			DefaultValue: "Invalid password policy",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key: "error.entitytypeservice.invalid_password_policy_description",
+			DefaultValue: "The password policy must not have negative values or more than 4 character classes " +
+				"and must only reference credential attributes of the schema",
+		},
+	}
 )
 
 // Per-category ServiceError constants — used as the actual returned errors.
@@ -345,6 +393,14 @@ func invalidEntityTypeRequestErr(category TypeCategory, detail string) *servicee
 	return &e
 }
 
+// uniquenessScopeConflictErr returns the uniqueness scope conflict ServiceError with the attribute appended
+// to the description's default value.
+func uniquenessScopeConflictErr(attribute string) *serviceerror.ServiceError {
+	e := ErrorUniquenessScopeConflict
+	e.ErrorDescription.DefaultValue += ": " + attribute
+	return &e
+}
+
 // Error variables for entity type operations.
 var (
 	// ErrEntityTypeNotFound is returned when an entity type is not found in the system.
@@ -19,6 +19,8 @@
 package entitytype
 
 import (
+	"context"
+	"errors"
 	"net/http"
 	"strconv"
 
@@ -26,6 +28,7 @@ import (
 	"github.com/thunder-id/thunderid/internal/system/error/apierror"
 	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
 	"github.com/thunder-id/thunderid/internal/system/i18n/core"
+	"github.com/thunder-id/thunderid/internal/system/jsonstream"
 	"github.com/thunder-id/thunderid/internal/system/log"
 	sysutils "github.com/thunder-id/thunderid/internal/system/utils"
 )
@@ -38,14 +41,17 @@ const entityTypeHandlerLoggerComponentName = "EntityTypeHandler"
 type entityTypeHandler struct {
 	entityTypeService EntityTypeServiceInterface
 	category          TypeCategory
+	jsonLimits        jsonstream.Limits
 }
 
-// newEntityTypeHandler creates a new instance of entityTypeHandler bound to the given category.
+// newEntityTypeHandler creates a new instance of entityTypeHandler bound to the given category. Request
+// bodies are decoded as a stream within the given JSON limits.
 func newEntityTypeHandler(entityTypeService EntityTypeServiceInterface,
-	category TypeCategory) *entityTypeHandler {
+	category TypeCategory, jsonLimits jsonstream.Limits) *entityTypeHandler {
 	return &entityTypeHandler{
 		entityTypeService: entityTypeService,
 		category:          category,
+		jsonLimits:        jsonLimits,
 	}
 }
 
@@ -66,8 +72,13 @@ func (h *entityTypeHandler) HandleEntityTypeListRequest(w http.ResponseWriter, r
 
 	includeDisplay := r.URL.Query().Get(sysutils.QueryParamInclude) == sysutils.IncludeValueDisplay
 
-	entityTypeListResponse, svcErr := h.entityTypeService.GetEntityTypeList(
-		ctx, h.category, limit, offset, includeDisplay)
+	var entityTypeListResponse *EntityTypeListResponse
+	if sysutils.IsListAllRequested(r.URL.Query()) {
+		entityTypeListResponse, svcErr = h.listAllEntityTypes(ctx, includeDisplay)
+	} else {
+		entityTypeListResponse, svcErr = h.entityTypeService.GetEntityTypeList(
+			ctx, h.category, limit, offset, includeDisplay)
+	}
 	if svcErr != nil {
 		handleError(w, svcErr)
 		return
@@ -82,22 +93,38 @@ func (h *entityTypeHandler) HandleEntityTypeListRequest(w http.ResponseWriter, r
 		log.Int("count", entityTypeListResponse.Count))
 }
 
+// listAllEntityTypes collects every entity type of the handler's category, one page at a time.
+func (h *entityTypeHandler) listAllEntityTypes(ctx context.Context, includeDisplay bool) (
+	*EntityTypeListResponse, *serviceerror.ServiceError) {
+	types, svcErr := sysutils.CollectAllPages(serverconst.MaxPageSize,
+		func(limit, offset int) ([]EntityTypeListItem, int, *serviceerror.ServiceError) {
+			page, svcErr := h.entityTypeService.GetEntityTypeList(ctx, h.category, limit, offset, includeDisplay)
+			if svcErr != nil {
+				return nil, 0, svcErr
+			}
+			return page.Types, page.TotalResults, nil
+		})
+	if svcErr != nil {
+		return nil, svcErr
+	}
+
+	return &EntityTypeListResponse{
+		TotalResults: len(types),
+		StartIndex:   1,
+		Count:        len(types),
+		Types:        types,
+		Links:        []Link{},
+	}, nil
+}
+
 // HandleEntityTypePostRequest handles the entity type creation request.
 func (h *entityTypeHandler) HandleEntityTypePostRequest(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, entityTypeHandlerLoggerComponentName))
 
-	createRequest, err := sysutils.DecodeJSONBody[CreateEntityTypeRequest](r)
+	createRequest, err := decodeEntityTypeRequest(r.Body, h.jsonLimits)
 	if err != nil {
-		errResp := apierror.ErrorResponse{
-			Code:    ErrorInvalidRequestFormat.Code,
-			Message: ErrorInvalidRequestFormat.Error,
-			Description: core.I18nMessage{
-				Key:          "error.entitytypeservice.create_schema_request_parse_failed_description",
-				DefaultValue: "Failed to parse request body"},
-		}
-
-		sysutils.WriteErrorResponse(w, http.StatusBadRequest, errResp)
+		handleDecodeError(w, err, "error.entitytypeservice.create_schema_request_parse_failed_description")
 		return
 	}
 
@@ -147,6 +174,28 @@ func (h *entityTypeHandler) HandleEntityTypeGetRequest(w http.ResponseWriter, r
 		log.String("category", string(h.category)), log.String("entityTypeID", schemaID))
 }
 
+// HandleEntityTypeFormMetadataRequest handles the request for the form metadata of an entity type.
+func (h *entityTypeHandler) HandleEntityTypeFormMetadataRequest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, entityTypeHandlerLoggerComponentName))
+
+	schemaID, idValidationFailed := extractAndValidateSchemaID(w, r)
+	if idValidationFailed {
+		return
+	}
+
+	metadata, svcErr := h.entityTypeService.GetFormMetadata(ctx, h.category, schemaID)
+	if svcErr != nil {
+		handleError(w, svcErr)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(w, http.StatusOK, metadata)
+
+	logger.Debug("Successfully retrieved entity type form metadata",
+		log.String("category", string(h.category)), log.String("entityTypeID", schemaID))
+}
+
 // HandleEntityTypePutRequest handles the entity type update request.
 func (h *entityTypeHandler) HandleEntityTypePutRequest(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -228,7 +277,8 @@ func handleError(w http.ResponseWriter, svcErr *serviceerror.ServiceError) {
 		statusCode = http.StatusBadRequest
 		if svcErr.Code == ErrorEntityTypeNotFound.Code {
 			statusCode = http.StatusNotFound
-		} else if svcErr.Code == ErrorEntityTypeNameConflict.Code {
+		} else if svcErr.Code == ErrorEntityTypeNameConflict.Code ||
+			svcErr.Code == ErrorUniquenessScopeConflict.Code {
 			statusCode = http.StatusConflict
 		} else if svcErr.Code == ErrorCannotModifyDeclarativeResource.Code {
 			statusCode = http.StatusForbidden
@@ -250,6 +300,36 @@ func handleError(w http.ResponseWriter, svcErr *serviceerror.ServiceError) {
 	sysutils.WriteErrorResponse(w, statusCode, errResp)
 }
 
+// handleDecodeError writes the error response for a request body that could not be decoded. The
+// description points at the offending value of the body when its location is known.
+func handleDecodeError(w http.ResponseWriter, err error, descriptionKey string) {
+	description := core.I18nMessage{Key: descriptionKey, DefaultValue: "Failed to parse request body"}
+	var streamErr *jsonstream.Error
+	if errors.As(err, &streamErr) {
+		switch streamErr.Kind {
+		case jsonstream.ErrorKindSize:
+			sysutils.WriteErrorResponse(w, http.StatusRequestEntityTooLarge, apierror.ErrRequestBodyTooLarge)
+			return
+		case jsonstream.ErrorKindLimit:
+			errResp := apierror.ErrJSONTooComplex
+			errResp.Description = core.I18nMessage{
+				Key:          apierror.ErrJSONTooComplex.Description.Key,
+				DefaultValue: streamErr.Error(),
+			}
+			sysutils.WriteErrorResponse(w, http.StatusBadRequest, errResp)
+			return
+		}
+		description.DefaultValue = "Failed to parse request body: " + streamErr.Error()
+	}
+
+	errResp := apierror.ErrorResponse{
+		Code:        ErrorInvalidRequestFormat.Code,
+		Message:     ErrorInvalidRequestFormat.Error,
+		Description: description,
+	}
+	sysutils.WriteErrorResponse(w, http.StatusBadRequest, errResp)
+}
+
 // extractAndValidateSchemaID extracts and validates the schema ID from the URL path.
 func extractAndValidateSchemaID(w http.ResponseWriter, r *http.Request) (string, bool) {
 	schemaID := r.PathValue("id")
@@ -269,20 +349,13 @@ func extractAndValidateSchemaID(w http.ResponseWriter, r *http.Request) (string,
 func validateUpdateEntityTypeRequest(
 	w http.ResponseWriter, r *http.Request, h *entityTypeHandler,
 ) (UpdateEntityTypeRequest, bool) {
-	updateRequest, err := sysutils.DecodeJSONBody[UpdateEntityTypeRequest](r)
+	request, err := decodeEntityTypeRequest(r.Body, h.jsonLimits)
 	if err != nil {
-		errResp := apierror.ErrorResponse{
-			Code:    ErrorInvalidRequestFormat.Code,
-			Message: ErrorInvalidRequestFormat.Error,
-			Description: core.I18nMessage{
-				Key:          "error.entitytypeservice.update_schema_request_parse_failed_description",
-				DefaultValue: "Failed to parse request body"},
-		}
-		sysutils.WriteErrorResponse(w, http.StatusBadRequest, errResp)
+		handleDecodeError(w, err, "error.entitytypeservice.update_schema_request_parse_failed_description")
 		return UpdateEntityTypeRequest{}, true
 	}
 
-	sanitizedRequest := h.sanitizeUpdateEntityTypeRequest(*updateRequest)
+	sanitizedRequest := h.sanitizeUpdateEntityTypeRequest(UpdateEntityTypeRequest(*request))
 	return sanitizedRequest, false
 }
 
@@ -24,8 +24,10 @@ import (
 	"github.com/thunder-id/thunderid/internal/consent"
 	oupkg "github.com/thunder-id/thunderid/internal/ou"
 	"github.com/thunder-id/thunderid/internal/system/cache"
+	"github.com/thunder-id/thunderid/internal/system/config"
 	serverconst "github.com/thunder-id/thunderid/internal/system/constants"
 	declarativeresource "github.com/thunder-id/thunderid/internal/system/declarative_resource"
+	"github.com/thunder-id/thunderid/internal/system/jsonstream"
 	"github.com/thunder-id/thunderid/internal/system/middleware"
 	"github.com/thunder-id/thunderid/internal/system/sysauthz"
 	"github.com/thunder-id/thunderid/internal/system/transaction"
@@ -57,8 +59,9 @@ func Initialize(
 		}
 	}
 
-	userTypeHandler := newEntityTypeHandler(entityTypeService, TypeCategoryUser)
-	agentTypeHandler := newEntityTypeHandler(entityTypeService, TypeCategoryAgent)
+	jsonLimits := jsonstream.LimitsFromConfig(config.GetServerRuntime().Config.Server.RequestLimits)
+	userTypeHandler := newEntityTypeHandler(entityTypeService, TypeCategoryUser, jsonLimits)
+	agentTypeHandler := newEntityTypeHandler(entityTypeService, TypeCategoryAgent, jsonLimits)
 	registerUserTypeRoutes(mux, userTypeHandler)
 	registerAgentTypeRoutes(mux, agentTypeHandler)
 
@@ -155,6 +158,19 @@ func registerSchemaRoutes(mux *http.ServeMux, basePath string, h *entityTypeHand
 
 func registerUserTypeRoutes(mux *http.ServeMux, h *entityTypeHandler) {
 	registerSchemaRoutes(mux, "/user-types", h)
+
+	formMetadataOpts := middleware.CORSOptions{
+		AllowedMethods:   []string{"GET"},
+		AllowedHeaders:   middleware.DefaultAllowedHeaders,
+		AllowCredentials: true,
+		MaxAge:           600,
+	}
+	mux.HandleFunc(middleware.WithCORS("GET /user-types/{id}/form-metadata",
+		h.HandleEntityTypeFormMetadataRequest, formMetadataOpts))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS /user-types/{id}/form-metadata",
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, formMetadataOpts))
 }
 
 func registerAgentTypeRoutes(mux *http.ServeMux, h *entityTypeHandler) {
@@ -31,6 +31,7 @@ import (
 	"github.com/thunder-id/thunderid/internal/system/database/provider"
 	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
 	"github.com/thunder-id/thunderid/internal/system/i18n/core"
+	"github.com/thunder-id/thunderid/internal/system/jsonstream"
 	"github.com/thunder-id/thunderid/tests/mocks/consentmock"
 	"github.com/thunder-id/thunderid/tests/mocks/oumock"
 
@@ -771,7 +772,7 @@ func TestRegisterRoutes_AllEndpoints(t *testing.T) {
 	mux := http.NewServeMux()
 	// Create a mock service to avoid nil pointer issues
 	mockService := NewEntityTypeServiceInterfaceMock(t)
-	mockHandler := newEntityTypeHandler(mockService, TypeCategoryUser)
+	mockHandler := newEntityTypeHandler(mockService, TypeCategoryUser, jsonstream.Limits{})
 
 	registerUserTypeRoutes(mux, mockHandler)
 
@@ -19,6 +19,7 @@
 package entitytype
 
 import (
+	"context"
 	"encoding/json"
 )
 
@@ -36,6 +37,23 @@ const (
 // to a single 'default' schema.
 const DefaultAgentTypeName = "default"
 
+// DuplicateValueCounter counts the values of an attribute that are shared by more than one existing entity of
+// an entity type, either across the type or within each organization unit when perOU is set.
+type DuplicateValueCounter interface {
+	CountDuplicateValues(ctx context.Context, category TypeCategory, entityType, attribute string,
+		perOU bool) (int, error)
+}
+
+// DuplicateValueCounterFunc is an adapter to use an ordinary function as a DuplicateValueCounter.
+type DuplicateValueCounterFunc func(ctx context.Context, category TypeCategory, entityType, attribute string,
+	perOU bool) (int, error)
+
+// CountDuplicateValues calls f(ctx, category, entityType, attribute, perOU).
+func (f DuplicateValueCounterFunc) CountDuplicateValues(ctx context.Context, category TypeCategory,
+	entityType, attribute string, perOU bool) (int, error) {
+	return f(ctx, category, entityType, attribute, perOU)
+}
+
 // IsValid reports whether the category is one of the known fixed values.
 func (c TypeCategory) IsValid() bool {
 	return c == TypeCategoryUser || c == TypeCategoryAgent
@@ -48,7 +66,50 @@ func (c TypeCategory) IsValid() bool {
 // SystemAttributes holds system-level metadata for an entity type.
 // Stored as a JSON column for extensibility — new fields can be added without DB migrations.
 type SystemAttributes struct {
-	Display string `json:"display,omitempty" yaml:"display,omitempty"`
+	Display          string            `json:"display,omitempty" yaml:"display,omitempty"`
+	CredentialPolicy *CredentialPolicy `json:"credentialPolicy,omitempty" yaml:"credential_policy,omitempty"`
+	PasswordPolicy   *PasswordPolicy   `json:"passwordPolicy,omitempty" yaml:"password_policy,omitempty"`
+}
+
+// CredentialPolicy defines the combinations of credential types an entity of the type must hold.
+// Credential types are the credential attributes of the schema or system-managed credential types
+// such as passkey.
+type CredentialPolicy struct {
+	// Required lists the credential types every entity must hold.
+	Required []string `json:"required,omitempty" yaml:"required,omitempty"`
+	// Alternatives lists sets of credential types of which every entity must hold at least one.
+	Alternatives [][]string `json:"alternatives,omitempty" yaml:"alternatives,omitempty"`
+	// MinimumFactors is the minimum number of distinct credential types every entity must hold.
+	MinimumFactors int `json:"minimumFactors,omitempty" yaml:"minimum_factors,omitempty"`
+}
+
+// CredentialPolicyEvaluation is the outcome of evaluating the credential types held by an entity
+// against the credential policy of its type.
+type CredentialPolicyEvaluation struct {
+	Satisfied               bool       `json:"satisfied"`
+	MissingRequired         []string   `json:"missingRequired,omitempty"`
+	UnsatisfiedAlternatives [][]string `json:"unsatisfiedAlternatives,omitempty"`
+	MissingFactors          int        `json:"missingFactors,omitempty"`
+}
+
+// PasswordPolicy defines the rules new values of password credentials must follow. A policy of an entity
+// type takes precedence over the policies of organization units.
+type PasswordPolicy struct {
+	// Credentials lists the credential attributes the policy applies to. The policy applies to every
+	// credential attribute when the list is empty.
+	Credentials []string `json:"credentials,omitempty" yaml:"credentials,omitempty"`
+	// MinLength is the minimum number of characters of a password.
+	MinLength int `json:"minLength,omitempty" yaml:"min_length,omitempty"`
+	// MinCharacterClasses is the minimum number of character classes a password must use, out of
+	// lowercase letters, uppercase letters, digits and symbols.
+	MinCharacterClasses int `json:"minCharacterClasses,omitempty" yaml:"min_character_classes,omitempty"`
+	// CheckDictionary rejects passwords that are on the password deny list once their leading and
+	// trailing non-letters are removed, or that contain attribute values of the user.
+	CheckDictionary bool `json:"checkDictionary,omitempty" yaml:"check_dictionary,omitempty"`
+	// CheckBreached rejects passwords found in the breached password service.
+	CheckBreached bool `json:"checkBreached,omitempty" yaml:"check_breached,omitempty"`
+	// HistoryCount is the number of most recent passwords of a user that cannot be reused.
+	HistoryCount int `json:"historyCount,omitempty" yaml:"history_count,omitempty"`
 }
 
 // EntityType represents an entity-type schema definition.
@@ -63,6 +124,20 @@ type EntityType struct {
 	Schema                json.RawMessage   `json:"schema,omitempty" yaml:"schema"`
 }
 
+// FormMetadata describes how UIs render create and edit forms for entities of an entity type. The
+// organization unit is the one the entity type belongs to, and the display attribute is the field
+// that identifies an entity in lists.
+type FormMetadata struct {
+	TypeID                string       `json:"typeId"`
+	TypeName              string       `json:"typeName"`
+	Category              TypeCategory `json:"category"`
+	OUID                  string       `json:"ouId"`
+	OUHandle              string       `json:"ouHandle,omitempty"`
+	AllowSelfRegistration bool         `json:"allowSelfRegistration"`
+	DisplayAttribute      string       `json:"displayAttribute,omitempty"`
+	Fields                []FormField  `json:"fields"`
+}
+
 // EntityTypeListItem represents a simplified entity type for listing operations.
 // Category is internal — see EntityType for the rationale.
 type EntityTypeListItem struct {
@@ -131,3 +206,60 @@ type EntityTypeRequestWithID struct {
 	SystemAttributes      *SystemAttributes `yaml:"system_attributes,omitempty"`
 	Schema                interface{}       `yaml:"schema"`
 }
+
+// SampleValidationRequest represents the request body for validating sample entities against a
+// draft schema before it is saved.
+type SampleValidationRequest struct {
+	OUID    string            `json:"ouId"`
+	Schema  json.RawMessage   `json:"schema"`
+	Samples []json.RawMessage `json:"samples"`
+}
+
+// SampleNormalizationRequest represents the request body for previewing the normalization rules of a
+// draft schema on sample entities before it is saved.
+type SampleNormalizationRequest struct {
+	OUID    string            `json:"ouId"`
+	Schema  json.RawMessage   `json:"schema"`
+	Samples []json.RawMessage `json:"samples"`
+}
+
+// SampleNormalizationResult represents the normalized attributes of a single sample entity.
+type SampleNormalizationResult struct {
+	Index          int                      `json:"index"`
+	Attributes     json.RawMessage          `json:"attributes"`
+	Normalizations []AttributeNormalization `json:"normalizations"`
+}
+
+// SampleNormalizationResponse represents the response for a sample normalization request.
+type SampleNormalizationResponse struct {
+	Results []SampleNormalizationResult `json:"results"`
+}
+
+// UniquenessConflict describes a unique attribute value in a sample that is already taken.
+// Source is either UniquenessConflictSourceExisting or UniquenessConflictSourceSample.
+type UniquenessConflict struct {
+	Attribute string `json:"attribute"`
+	Source    string `json:"source"`
+}
+
+// SampleValidationResult represents the validation outcome of a single sample entity.
+type SampleValidationResult struct {
+	Index               int                  `json:"index"`
+	Valid               bool                 `json:"valid"`
+	Violations          []SchemaViolation    `json:"violations"`
+	UniquenessConflicts []UniquenessConflict `json:"uniquenessConflicts"`
+}
+
+// IndexImpactWarning describes how the draft schema interacts with the configured indexed attributes.
+type IndexImpactWarning struct {
+	Attribute string `json:"attribute"`
+	Code      string `json:"code"`
+	Message   string `json:"message"`
+}
+
+// SampleValidationResponse represents the response for a sample validation request.
+type SampleValidationResponse struct {
+	Valid         bool                     `json:"valid"`
+	Results       []SampleValidationResult `json:"results"`
+	IndexWarnings []IndexImpactWarning     `json:"indexWarnings"`
+}
@@ -28,6 +28,7 @@ import (
 
 type array struct {
 	required    bool
+	sensitive   bool
 	displayName string
 	items       property
 }
@@ -36,6 +37,10 @@ func (p *array) isRequired() bool {
 	return p.required
 }
 
+func (p *array) isSensitive() bool {
+	return p.sensitive
+}
+
 func (p *array) isCredential() bool {
 	return false
 }
@@ -48,6 +53,10 @@ func (p *array) getDisplayName() string {
 	return p.displayName
 }
 
+func (p *array) getValueConstraints() *ValueConstraints {
+	return nil
+}
+
 func (p *array) isUnique() bool {
 	return false
 }
@@ -86,7 +95,7 @@ func (p *array) validateValue(value interface{}, path string, logger *log.Logger
 func (p *array) validateUniqueness(
 	value interface{},
 	path string,
-	exists func(map[string]interface{}) (bool, error),
+	exists ExistsFunc,
 	logger *log.Logger,
 ) (bool, error) {
 	// Arrays are not supported for uniqueness validation
@@ -94,10 +103,15 @@ func (p *array) validateUniqueness(
 }
 
 func compileArrayProperty(propName string, propMap map[string]json.RawMessage) (property, error) {
+	if _, exists := propMap["contact"]; exists {
+		return compileContactListProperty(propMap)
+	}
+
 	allowedFields := map[string]struct{}{
 		"type":        {},
 		"items":       {},
 		"required":    {},
+		"sensitive":   {},
 		"displayName": {},
 	}
 
@@ -115,6 +129,12 @@ func compileArrayProperty(propName string, propMap map[string]json.RawMessage) (
 		}
 	}
 
+	if raw, exists := propMap["sensitive"]; exists {
+		if err := json.Unmarshal(raw, &prop.sensitive); err != nil {
+			return nil, fmt.Errorf("'sensitive' field must be a boolean")
+		}
+	}
+
 	if raw, exists := propMap["displayName"]; exists {
 		if err := json.Unmarshal(raw, &prop.displayName); err != nil {
 			return nil, fmt.Errorf("'displayName' field must be a string")
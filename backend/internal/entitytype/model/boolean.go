@@ -28,6 +28,7 @@ import (
 // boolean represents a boolean property in the entity type schema.
 type boolean struct {
 	required    bool
+	sensitive   bool
 	displayName string
 }
 
@@ -35,6 +36,10 @@ func (p *boolean) isRequired() bool {
 	return p.required
 }
 
+func (p *boolean) isSensitive() bool {
+	return p.sensitive
+}
+
 func (p *boolean) isCredential() bool {
 	return false
 }
@@ -47,6 +52,10 @@ func (p *boolean) getDisplayName() string {
 	return p.displayName
 }
 
+func (p *boolean) getValueConstraints() *ValueConstraints {
+	return nil
+}
+
 func (p *boolean) isUnique() bool {
 	return false
 }
@@ -64,7 +73,7 @@ func (p *boolean) validateValue(value interface{}, path string, logger *log.Logg
 func (p *boolean) validateUniqueness(
 	value interface{},
 	path string,
-	exists func(map[string]interface{}) (bool, error),
+	exists ExistsFunc,
 	logger *log.Logger,
 ) (bool, error) {
 	return true, nil
@@ -74,6 +83,7 @@ func compileBooleanProperty(propMap map[string]json.RawMessage) (property, error
 	allowedFields := map[string]struct{}{
 		"type":        {},
 		"required":    {},
+		"sensitive":   {},
 		"displayName": {},
 	}
 
@@ -91,6 +101,12 @@ func compileBooleanProperty(propMap map[string]json.RawMessage) (property, error
 		}
 	}
 
+	if raw, exists := propMap["sensitive"]; exists {
+		if err := json.Unmarshal(raw, &prop.sensitive); err != nil {
+			return nil, fmt.Errorf("'sensitive' field must be a boolean")
+		}
+	}
+
 	if raw, exists := propMap["displayName"]; exists {
 		if err := json.Unmarshal(raw, &prop.displayName); err != nil {
 			return nil, fmt.Errorf("'displayName' field must be a string")
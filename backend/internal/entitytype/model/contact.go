@@ -0,0 +1,495 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package model
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/thunder-id/thunderid/internal/system/log"
+)
+
+// ContactKind identifies the kind of values held by a multi-valued contact property.
+type ContactKind string
+
+const (
+	// ContactKindEmail marks a list of email addresses.
+	ContactKindEmail ContactKind = "email"
+	// ContactKindPhone marks a list of phone numbers.
+	ContactKindPhone ContactKind = "phone"
+)
+
+// Fields of an item of a multi-valued contact property.
+const (
+	// ContactFieldValue holds the email address or phone number.
+	ContactFieldValue = "value"
+	// ContactFieldType holds a free-form label of the value, such as "work" or "home".
+	ContactFieldType = "type"
+	// ContactFieldPrimary marks the value the user designated as primary.
+	ContactFieldPrimary = "primary"
+	// ContactFieldVerified marks a value whose ownership has been verified.
+	ContactFieldVerified = "verified"
+)
+
+// ArrayElementFilterKey returns the filter key that matches an entity holding the value in the given field
+// of any item of the array at the given dot-notation path, such as "emails[].value".
+func ArrayElementFilterKey(arrayPath, field string) string {
+	return arrayPath + "[]." + field
+}
+
+// ContactValue is an item of a multi-valued contact property.
+type ContactValue struct {
+	Value    string
+	Type     string
+	Primary  bool
+	Verified bool
+}
+
+// ParseContactValues returns the items of a multi-valued contact attribute value. Items that are not
+// objects holding a string value are skipped.
+func ParseContactValues(value interface{}) []ContactValue {
+	items, ok := value.([]interface{})
+	if !ok {
+		return nil
+	}
+
+	values := make([]ContactValue, 0, len(items))
+	for _, item := range items {
+		itemMap, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		contactValue, ok := itemMap[ContactFieldValue].(string)
+		if !ok || contactValue == "" {
+			continue
+		}
+		contactType, _ := itemMap[ContactFieldType].(string)
+		primary, _ := itemMap[ContactFieldPrimary].(bool)
+		verified, _ := itemMap[ContactFieldVerified].(bool)
+		values = append(values, ContactValue{
+			Value: contactValue, Type: contactType, Primary: primary, Verified: verified,
+		})
+	}
+	return values
+}
+
+// SelectContactValue returns the value of a multi-valued contact attribute used for notifications and
+// token claims: the primary value, or else the first verified value, or else the first value. It returns
+// false when there is no value.
+func SelectContactValue(values []ContactValue) (ContactValue, bool) {
+	if len(values) == 0 {
+		return ContactValue{}, false
+	}
+	for _, value := range values {
+		if value.Primary {
+			return value, true
+		}
+	}
+	for _, value := range values {
+		if value.Verified {
+			return value, true
+		}
+	}
+	return values[0], true
+}
+
+// contactList is an array property holding the email addresses or phone numbers of an entity, each with
+// its own primary marker and verification state.
+type contactList struct {
+	kind            ContactKind
+	required        bool
+	sensitive       bool
+	unique          bool
+	uniquenessScope UniquenessScope
+	displayName     string
+	value           *str
+	// primaryAttribute and primaryVerifiedAttribute name the top-level properties that mirror the
+	// selected value and its verification state.
+	primaryAttribute         string
+	primaryVerifiedAttribute string
+}
+
+func (p *contactList) isRequired() bool {
+	return p.required
+}
+
+func (p *contactList) isSensitive() bool {
+	return p.sensitive
+}
+
+func (p *contactList) isCredential() bool {
+	return false
+}
+
+func (p *contactList) isDisplayable() bool {
+	return false
+}
+
+func (p *contactList) getDisplayName() string {
+	return p.displayName
+}
+
+func (p *contactList) getValueConstraints() *ValueConstraints {
+	return nil
+}
+
+// isUnique reports false as the values of a contact list are not identifiers on their own. Uniqueness
+// across entities is checked per value by validateUniqueness.
+func (p *contactList) isUnique() bool {
+	return false
+}
+
+func (p *contactList) validateValue(value interface{}, path string, logger *log.Logger) (bool, error) {
+	items, ok := value.([]interface{})
+	if !ok {
+		logger.Debug("Expected array but got different type",
+			log.String("property", path), log.String("value", fmt.Sprintf("%v", value)))
+		return false, nil
+	}
+
+	if p.required && len(items) == 0 {
+		logger.Debug("Contact property is required but empty", log.String("property", path))
+		return false, nil
+	}
+
+	primaryCount := 0
+	seen := make(map[string]struct{}, len(items))
+	for index, item := range items {
+		itemPath := fmt.Sprintf("%s[%d]", path, index)
+		itemMap, ok := item.(map[string]interface{})
+		if !ok {
+			logger.Debug("Expected contact object but got different type", log.String("property", itemPath))
+			return false, nil
+		}
+
+		for field, fieldValue := range itemMap {
+			switch field {
+			case ContactFieldValue:
+				isValid, err := p.value.validateValue(fieldValue, itemPath+"."+field, logger)
+				if err != nil || !isValid {
+					return false, err
+				}
+			case ContactFieldType:
+				if _, ok := fieldValue.(string); !ok {
+					logger.Debug("Contact type must be a string", log.String("property", itemPath))
+					return false, nil
+				}
+			case ContactFieldPrimary, ContactFieldVerified:
+				flag, ok := fieldValue.(bool)
+				if !ok {
+					logger.Debug("Contact flag must be a boolean", log.String("property", itemPath),
+						log.String("field", field))
+					return false, nil
+				}
+				if field == ContactFieldPrimary && flag {
+					primaryCount++
+				}
+			default:
+				logger.Debug("Unknown contact field", log.String("property", itemPath), log.String("field", field))
+				return false, nil
+			}
+		}
+
+		contactValue, ok := itemMap[ContactFieldValue].(string)
+		if !ok {
+			logger.Debug("Contact value is missing", log.String("property", itemPath))
+			return false, nil
+		}
+		key := p.comparisonKey(contactValue)
+		if _, duplicate := seen[key]; duplicate {
+			logger.Debug("Duplicate contact value", log.String("property", itemPath))
+			return false, nil
+		}
+		seen[key] = struct{}{}
+	}
+
+	if primaryCount > 1 {
+		logger.Debug("More than one contact value is marked as primary", log.String("property", path))
+		return false, nil
+	}
+
+	return true, nil
+}
+
+// validateUniqueness checks each value of the list against the values held by other entities in any item
+// of the same property.
+func (p *contactList) validateUniqueness(
+	value interface{},
+	path string,
+	exists ExistsFunc,
+	logger *log.Logger,
+) (bool, error) {
+	if !p.unique {
+		return true, nil
+	}
+
+	filterKey := ArrayElementFilterKey(path, ContactFieldValue)
+	for _, contactValue := range ParseContactValues(value) {
+		found, err := exists(map[string]interface{}{filterKey: contactValue.Value}, p.uniquenessScope)
+		if err != nil {
+			return false, err
+		}
+		if found {
+			logger.Debug("Contact value is held by another entity", log.String("property", path))
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+// comparisonKey returns the form of a value used to detect duplicates within the list. Email addresses
+// are compared case-insensitively.
+func (p *contactList) comparisonKey(value string) string {
+	if p.kind == ContactKindEmail {
+		return strings.ToLower(value)
+	}
+	return value
+}
+
+// selectInto sets the attributes mirroring the selected value of the list, or removes them when the list
+// is empty. It reports whether any attribute changed.
+func (p *contactList) selectInto(attrs map[string]interface{}, value interface{}) bool {
+	selected, found := SelectContactValue(ParseContactValues(value))
+	changed := false
+	if p.primaryAttribute != "" {
+		changed = setMirroredAttribute(attrs, p.primaryAttribute, selected.Value, found) || changed
+	}
+	if p.primaryVerifiedAttribute != "" {
+		changed = setMirroredAttribute(attrs, p.primaryVerifiedAttribute, selected.Verified, found) || changed
+	}
+	return changed
+}
+
+// setMirroredAttribute sets the attribute to the value when present is set, and removes it otherwise.
+// It reports whether the attribute changed.
+func setMirroredAttribute(attrs map[string]interface{}, name string, value interface{}, present bool) bool {
+	current, exists := attrs[name]
+	if !present {
+		delete(attrs, name)
+		return exists
+	}
+	attrs[name] = value
+	return !exists || current != value
+}
+
+// retainVerification resets the verification state of each value of the list to the state stored for the
+// same value in the previous list, so that a value cannot be marked as verified by its holder.
+func (p *contactList) retainVerification(previous, next interface{}) bool {
+	verified := make(map[string]bool)
+	for _, contactValue := range ParseContactValues(previous) {
+		if contactValue.Verified {
+			verified[p.comparisonKey(contactValue.Value)] = true
+		}
+	}
+
+	items, ok := next.([]interface{})
+	if !ok {
+		return false
+	}
+	changed := false
+	for _, item := range items {
+		itemMap, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		contactValue, _ := itemMap[ContactFieldValue].(string)
+		requested, _ := itemMap[ContactFieldVerified].(bool)
+		retained := verified[p.comparisonKey(contactValue)]
+		if requested == retained {
+			continue
+		}
+		changed = true
+		if retained {
+			itemMap[ContactFieldVerified] = true
+		} else {
+			delete(itemMap, ContactFieldVerified)
+		}
+	}
+	return changed
+}
+
+// SelectContactValues sets the attributes that mirror the selected value of each multi-valued contact
+// property present in the attributes, and reports whether any attribute changed.
+func (cs *Schema) SelectContactValues(attrs map[string]interface{}) bool {
+	changed := false
+	for name, prop := range cs.properties {
+		list, ok := prop.(*contactList)
+		if !ok {
+			continue
+		}
+		value, exists := attrs[name]
+		if !exists {
+			continue
+		}
+		changed = list.selectInto(attrs, value) || changed
+	}
+	return changed
+}
+
+// RetainContactVerification resets the verification state of the values of each multi-valued contact
+// property in next to the state stored for the same values in previous, and selects the mirrored values
+// again. Values that are new or changed become unverified. It reports whether any attribute changed.
+func (cs *Schema) RetainContactVerification(previous, next map[string]interface{}) bool {
+	changed := false
+	for name, prop := range cs.properties {
+		list, ok := prop.(*contactList)
+		if !ok {
+			continue
+		}
+		value, exists := next[name]
+		if !exists {
+			continue
+		}
+		if list.retainVerification(previous[name], value) {
+			changed = true
+			list.selectInto(next, value)
+		}
+	}
+	return changed
+}
+
+// validateContactSelections checks that the attributes mirroring the selected value of each contact list
+// are declared as top-level properties of the matching type and are not mirrored by another list.
+func validateContactSelections(properties map[string]property) error {
+	mirrored := make(map[string]string)
+	for name, prop := range properties {
+		list, ok := prop.(*contactList)
+		if !ok {
+			continue
+		}
+		for _, target := range []struct {
+			field     string
+			attribute string
+			valid     func(property) bool
+			typeName  string
+		}{
+			{"primaryAttribute", list.primaryAttribute, func(p property) bool {
+				s, ok := p.(*str)
+				return ok && !s.credential
+			}, TypeString},
+			{"primaryVerifiedAttribute", list.primaryVerifiedAttribute, func(p property) bool {
+				_, ok := p.(*boolean)
+				return ok
+			}, TypeBoolean},
+		} {
+			if target.attribute == "" {
+				continue
+			}
+			targetProp, exists := properties[target.attribute]
+			if !exists || !target.valid(targetProp) {
+				return fmt.Errorf("invalid property '%s': '%s' must name a %s property", name, target.field,
+					target.typeName)
+			}
+			if other, taken := mirrored[target.attribute]; taken {
+				return fmt.Errorf("invalid property '%s': attribute '%s' is already mirrored by '%s'", name,
+					target.attribute, other)
+			}
+			mirrored[target.attribute] = name
+		}
+	}
+	return nil
+}
+
+// compileContactListProperty compiles an array property declared with a 'contact' kind.
+func compileContactListProperty(propMap map[string]json.RawMessage) (property, error) {
+	allowedFields := map[string]struct{}{
+		"type":                     {},
+		"contact":                  {},
+		"items":                    {},
+		"required":                 {},
+		"sensitive":                {},
+		"unique":                   {},
+		"uniquenessScope":          {},
+		"displayName":              {},
+		"primaryAttribute":         {},
+		"primaryVerifiedAttribute": {},
+	}
+
+	for field := range propMap {
+		if _, ok := allowedFields[field]; !ok {
+			return nil, fmt.Errorf("invalid field '%s' for contact property", field)
+		}
+	}
+
+	prop := &contactList{}
+
+	if err := json.Unmarshal(propMap["contact"], &prop.kind); err != nil {
+		return nil, fmt.Errorf("'contact' field must be a string")
+	}
+	if prop.kind != ContactKindEmail && prop.kind != ContactKindPhone {
+		return nil, fmt.Errorf("'contact' field must be one of 'email' or 'phone'")
+	}
+
+	for field, target := range map[string]*bool{
+		"required":  &prop.required,
+		"sensitive": &prop.sensitive,
+		"unique":    &prop.unique,
+	} {
+		if raw, exists := propMap[field]; exists {
+			if err := json.Unmarshal(raw, target); err != nil {
+				return nil, fmt.Errorf("'%s' field must be a boolean", field)
+			}
+		}
+	}
+
+	scope, err := compileUniquenessScope(propMap, prop.unique)
+	if err != nil {
+		return nil, err
+	}
+	prop.uniquenessScope = scope
+
+	for field, target := range map[string]*string{
+		"displayName":              &prop.displayName,
+		"primaryAttribute":         &prop.primaryAttribute,
+		"primaryVerifiedAttribute": &prop.primaryVerifiedAttribute,
+	} {
+		if raw, exists := propMap[field]; exists {
+			if err := json.Unmarshal(raw, target); err != nil {
+				return nil, fmt.Errorf("'%s' field must be a string", field)
+			}
+		}
+	}
+
+	itemsRaw, exists := propMap["items"]
+	if !exists {
+		return nil, fmt.Errorf("missing required 'items' field for array type")
+	}
+	var itemsMap map[string]json.RawMessage
+	if err := json.Unmarshal(itemsRaw, &itemsMap); err != nil {
+		return nil, fmt.Errorf("invalid 'items' definition: property definition must be an object")
+	}
+	var itemType string
+	if err := json.Unmarshal(itemsMap["type"], &itemType); err != nil || itemType != TypeString {
+		return nil, fmt.Errorf("'items' of a contact property must be of type 'string'")
+	}
+	for _, field := range []string{"required", "unique", "uniquenessScope", "credential"} {
+		if _, exists := itemsMap[field]; exists {
+			return nil, fmt.Errorf("invalid field '%s' for the 'items' of a contact property", field)
+		}
+	}
+	compiledItems, err := compileStringProperty(itemsMap)
+	if err != nil {
+		return nil, fmt.Errorf("invalid 'items' definition: %w", err)
+	}
+	prop.value = compiledItems.(*str)
+
+	return prop, nil
+}
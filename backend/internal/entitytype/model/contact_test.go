@@ -0,0 +1,243 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package model
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/system/log"
+)
+
+const testContactSchema = `{
+	"emails": {
+		"type": "array",
+		"contact": "email",
+		"items": {"type": "string", "pattern": "^[^@]+@[^@]+$", "normalize": [{"type": "lowercase"}]},
+		"unique": true,
+		"uniquenessScope": "ou",
+		"primaryAttribute": "email",
+		"primaryVerifiedAttribute": "emailVerified"
+	},
+	"email": {"type": "string"},
+	"emailVerified": {"type": "boolean"}
+}`
+
+type ContactTestSuite struct {
+	suite.Suite
+	logger *log.Logger
+	schema *Schema
+}
+
+func TestContactTestSuite(t *testing.T) {
+	suite.Run(t, new(ContactTestSuite))
+}
+
+func (s *ContactTestSuite) SetupTest() {
+	s.logger = log.GetLogger()
+	schema, err := CompileSchema(json.RawMessage(testContactSchema))
+	s.Require().NoError(err)
+	s.schema = schema
+}
+
+func (s *ContactTestSuite) TestCompile_Errors() {
+	testCases := []struct {
+		name   string
+		schema string
+	}{
+		{"UnknownKind", `{"emails": {"type": "array", "contact": "fax", "items": {"type": "string"}}}`},
+		{"NonStringItems", `{"emails": {"type": "array", "contact": "email", "items": {"type": "number"}}}`},
+		{"UniqueItems", `{"emails": {"type": "array", "contact": "email",
+			"items": {"type": "string", "unique": true}}}`},
+		{"UnknownField", `{"emails": {"type": "array", "contact": "email", "items": {"type": "string"},
+			"minItems": 1}}`},
+		{"UndeclaredPrimaryAttribute", `{"emails": {"type": "array", "contact": "email",
+			"items": {"type": "string"}, "primaryAttribute": "email"}}`},
+		{"NonBooleanVerifiedAttribute", `{"emails": {"type": "array", "contact": "email",
+			"items": {"type": "string"}, "primaryVerifiedAttribute": "email"}, "email": {"type": "string"}}`},
+		{"SharedPrimaryAttribute", `{"email": {"type": "string"},
+			"emails": {"type": "array", "contact": "email", "items": {"type": "string"},
+				"primaryAttribute": "email"},
+			"others": {"type": "array", "contact": "email", "items": {"type": "string"},
+				"primaryAttribute": "email"}}`},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			_, err := CompileSchema(json.RawMessage(tc.schema))
+			s.Error(err)
+		})
+	}
+}
+
+func (s *ContactTestSuite) TestValidate() {
+	testCases := []struct {
+		name       string
+		attributes string
+		valid      bool
+	}{
+		{"Valid", `{"emails": [{"value": "jo@example.com", "type": "home", "primary": true, "verified": true},
+			{"value": "jo@work.example.com", "type": "work"}]}`, true},
+		{"Empty", `{"emails": []}`, true},
+		{"NotAnArray", `{"emails": "jo@example.com"}`, false},
+		{"NotAnObject", `{"emails": ["jo@example.com"]}`, false},
+		{"MissingValue", `{"emails": [{"type": "work"}]}`, false},
+		{"PatternMismatch", `{"emails": [{"value": "jo"}]}`, false},
+		{"UnknownField", `{"emails": [{"value": "jo@example.com", "label": "home"}]}`, false},
+		{"NonBooleanFlag", `{"emails": [{"value": "jo@example.com", "primary": "yes"}]}`, false},
+		{"TwoPrimaries", `{"emails": [{"value": "jo@example.com", "primary": true},
+			{"value": "jo@work.example.com", "primary": true}]}`, false},
+		{"DuplicateIgnoringCase", `{"emails": [{"value": "jo@example.com"}, {"value": "JO@example.com"}]}`, false},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			valid, err := s.schema.Validate(json.RawMessage(tc.attributes), s.logger, false)
+			s.Require().NoError(err)
+			s.Equal(tc.valid, valid)
+		})
+	}
+}
+
+func (s *ContactTestSuite) TestValidateUniqueness_ChecksEachValue() {
+	var checked []map[string]interface{}
+	exists := func(filters map[string]interface{}, scope UniquenessScope) (bool, error) {
+		s.Equal(UniquenessScopeOU, scope)
+		checked = append(checked, filters)
+		return filters["emails[].value"] == "jo@work.example.com", nil
+	}
+
+	valid, err := s.schema.ValidateUniqueness(map[string]interface{}{
+		"emails": []interface{}{
+			map[string]interface{}{"value": "jo@example.com"},
+			map[string]interface{}{"value": "jo@work.example.com"},
+		},
+	}, exists, s.logger)
+
+	s.Require().NoError(err)
+	s.False(valid)
+	s.Equal([]map[string]interface{}{
+		{"emails[].value": "jo@example.com"},
+		{"emails[].value": "jo@work.example.com"},
+	}, checked)
+}
+
+func (s *ContactTestSuite) TestNormalize_MirrorsSelectedValue() {
+	testCases := []struct {
+		name       string
+		attributes string
+		expected   string
+	}{
+		{
+			"Primary",
+			`{"emails": [{"value": "Jo@Example.com", "verified": true},
+				{"value": "jo@work.example.com", "primary": true}]}`,
+			`{"emails": [{"value": "jo@example.com", "verified": true},
+				{"value": "jo@work.example.com", "primary": true}],
+				"email": "jo@work.example.com", "emailVerified": false}`,
+		},
+		{
+			"FirstVerified",
+			`{"emails": [{"value": "jo@example.com"}, {"value": "jo@work.example.com", "verified": true}]}`,
+			`{"emails": [{"value": "jo@example.com"}, {"value": "jo@work.example.com", "verified": true}],
+				"email": "jo@work.example.com", "emailVerified": true}`,
+		},
+		{
+			"First",
+			`{"emails": [{"value": "jo@example.com"}, {"value": "jo@work.example.com"}]}`,
+			`{"emails": [{"value": "jo@example.com"}, {"value": "jo@work.example.com"}],
+				"email": "jo@example.com", "emailVerified": false}`,
+		},
+		{
+			"EmptyRemovesMirror",
+			`{"emails": [], "email": "jo@example.com", "emailVerified": true}`,
+			`{"emails": []}`,
+		},
+		{
+			"AbsentListKeepsAttributes",
+			`{"email": "jo@example.com"}`,
+			`{"email": "jo@example.com"}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			normalized, err := s.schema.Normalize(json.RawMessage(tc.attributes))
+			s.Require().NoError(err)
+			s.JSONEq(tc.expected, string(normalized))
+		})
+	}
+}
+
+func (s *ContactTestSuite) TestRetainContactVerification() {
+	previous := map[string]interface{}{
+		"emails": []interface{}{
+			map[string]interface{}{"value": "jo@example.com", "verified": true},
+			map[string]interface{}{"value": "jo@old.example.com", "verified": true},
+		},
+	}
+	next := map[string]interface{}{
+		"emails": []interface{}{
+			map[string]interface{}{"value": "jo@example.com"},
+			map[string]interface{}{"value": "jo@work.example.com", "primary": true, "verified": true},
+		},
+		"email":         "jo@work.example.com",
+		"emailVerified": true,
+	}
+
+	s.True(s.schema.RetainContactVerification(previous, next))
+	s.Equal(map[string]interface{}{
+		"emails": []interface{}{
+			map[string]interface{}{"value": "jo@example.com", "verified": true},
+			map[string]interface{}{"value": "jo@work.example.com", "primary": true},
+		},
+		"email":         "jo@work.example.com",
+		"emailVerified": false,
+	}, next)
+
+	s.False(s.schema.RetainContactVerification(previous, next))
+}
+
+func (s *ContactTestSuite) TestSelectContactValue() {
+	_, found := SelectContactValue(nil)
+	s.False(found)
+
+	selected, found := SelectContactValue(ParseContactValues([]interface{}{
+		"jo@example.com",
+		map[string]interface{}{"value": "jo@home.example.com"},
+		map[string]interface{}{"value": "jo@work.example.com", "type": "work", "verified": true},
+	}))
+	s.True(found)
+	s.Equal(ContactValue{Value: "jo@work.example.com", Type: "work", Verified: true}, selected)
+}
+
+func (s *ContactTestSuite) TestBuildFormFields() {
+	fields, err := BuildFormFields(json.RawMessage(`{
+		"emails": {"type": "array", "contact": "email", "unique": true, "items": {"type": "string"}}
+	}`))
+	s.Require().NoError(err)
+	s.Require().Len(fields, 1)
+	s.Equal(WidgetContactList, fields[0].Widget)
+	s.Equal(ContactKindEmail, fields[0].Contact)
+	s.True(fields[0].Unique)
+	s.Require().NotNil(fields[0].Items)
+	s.Equal("emails[].value", fields[0].Items.Path)
+}
@@ -0,0 +1,260 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package model
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+// Widget hints of form fields. UIs pick an input control for a field from its widget.
+const (
+	// WidgetText is a single-line text input.
+	WidgetText = "text"
+	// WidgetPassword is a masked input whose value is never displayed.
+	WidgetPassword = "password"
+	// WidgetNumber is a numeric input.
+	WidgetNumber = "number"
+	// WidgetSelect is a dropdown of the allowed values.
+	WidgetSelect = "select"
+	// WidgetMultiSelect is a dropdown that accepts several of the allowed values.
+	WidgetMultiSelect = "multiselect"
+	// WidgetCheckbox is a checkbox.
+	WidgetCheckbox = "checkbox"
+	// WidgetLocale is a picker of BCP 47 language tags.
+	WidgetLocale = "locale"
+	// WidgetTimezone is a picker of IANA time zone names.
+	WidgetTimezone = "timezone"
+	// WidgetList is a list of values that can be added and removed.
+	WidgetList = "list"
+	// WidgetGroup is a group of nested fields.
+	WidgetGroup = "group"
+	// WidgetContactList is a list of email addresses or phone numbers, one of which can be marked as primary.
+	WidgetContactList = "contactList"
+)
+
+// FormField describes how a UI renders an input for a schema property. Fields are listed in the
+// order their properties are declared in the schema.
+type FormField struct {
+	// Name is the property name, and Path its dot-notation path from the top of the schema.
+	Name  string `json:"name"`
+	Path  string `json:"path"`
+	Label string `json:"label"`
+	Type  string `json:"type"`
+	// Widget is a hint for the input control to render the field with.
+	Widget string `json:"widget"`
+	Format string `json:"format,omitempty"`
+	// Contact is the kind of values of a multi-valued contact field, either "email" or "phone".
+	Contact    ContactKind     `json:"contact,omitempty"`
+	Required   bool            `json:"required"`
+	Sensitive  bool            `json:"sensitive"`
+	Credential bool            `json:"credential"`
+	Unique     bool            `json:"unique"`
+	Validation *FormValidation `json:"validation,omitempty"`
+	// ReadOnlyOnEdit marks fields that cannot be changed in an edit form. Credentials are never
+	// returned with an entity and are changed through the credential update operations instead.
+	ReadOnlyOnEdit bool `json:"readOnlyOnEdit"`
+	// Items describes the items of an array field.
+	Items *FormField `json:"items,omitempty"`
+	// Fields lists the nested fields of an object field.
+	Fields []FormField `json:"fields,omitempty"`
+}
+
+// FormValidation holds the rules a UI checks a field value against before submitting it.
+type FormValidation struct {
+	Pattern string   `json:"pattern,omitempty"`
+	Enum    []string `json:"enum,omitempty"`
+}
+
+// BuildFormFields compiles an entity type schema and returns a form field for each of its
+// properties, in the order the properties are declared in the schema.
+func BuildFormFields(schema json.RawMessage) ([]FormField, error) {
+	compiled, err := CompileSchema(schema)
+	if err != nil {
+		return nil, err
+	}
+	return buildFormFields(schema, compiled.properties, "")
+}
+
+// buildFormFields returns a form field for each of the properties, following the order of the
+// property definitions in rawProperties.
+func buildFormFields(
+	rawProperties json.RawMessage, properties map[string]property, prefix string,
+) ([]FormField, error) {
+	names, err := orderedKeys(rawProperties)
+	if err != nil {
+		return nil, err
+	}
+	var definitions map[string]json.RawMessage
+	if err := json.Unmarshal(rawProperties, &definitions); err != nil {
+		return nil, err
+	}
+
+	fields := make([]FormField, 0, len(names))
+	for _, name := range names {
+		prop, ok := properties[name]
+		if !ok {
+			continue
+		}
+		field, err := buildFormField(name, prefix+name, definitions[name], prop)
+		if err != nil {
+			return nil, fmt.Errorf("invalid property '%s': %w", prefix+name, err)
+		}
+		fields = append(fields, field)
+	}
+	return fields, nil
+}
+
+// buildFormField returns the form field of a property from its compiled form and its definition.
+func buildFormField(name, path string, definition json.RawMessage, prop property) (FormField, error) {
+	field := FormField{
+		Name:           name,
+		Path:           path,
+		Label:          prop.getDisplayName(),
+		Required:       prop.isRequired(),
+		Sensitive:      prop.isSensitive(),
+		Credential:     prop.isCredential(),
+		Unique:         prop.isUnique(),
+		ReadOnlyOnEdit: prop.isCredential(),
+	}
+	if field.Label == "" {
+		field.Label = name
+	}
+	if constraints := prop.getValueConstraints(); constraints != nil {
+		field.Validation = &FormValidation{Pattern: constraints.Pattern, Enum: constraints.Enum}
+	}
+
+	switch p := prop.(type) {
+	case *str:
+		field.Type = TypeString
+		field.Format = p.format
+		switch {
+		case p.credential:
+			field.Widget = WidgetPassword
+		case p.enumValues != nil:
+			field.Widget = WidgetSelect
+		case p.format == FormatLocale:
+			field.Widget = WidgetLocale
+		case p.format == FormatZoneinfo:
+			field.Widget = WidgetTimezone
+		default:
+			field.Widget = WidgetText
+		}
+	case *number:
+		field.Type = TypeNumber
+		switch {
+		case p.credential:
+			field.Widget = WidgetPassword
+		case p.enumValues != nil:
+			field.Widget = WidgetSelect
+		default:
+			field.Widget = WidgetNumber
+		}
+	case *boolean:
+		field.Type = TypeBoolean
+		field.Widget = WidgetCheckbox
+	case *array:
+		field.Type = TypeArray
+		var def struct {
+			Items json.RawMessage `json:"items"`
+		}
+		if err := json.Unmarshal(definition, &def); err != nil {
+			return FormField{}, err
+		}
+		items, err := buildFormField("", path, def.Items, p.items)
+		if err != nil {
+			return FormField{}, err
+		}
+		field.Items = &items
+		if items.Widget == WidgetSelect {
+			field.Widget = WidgetMultiSelect
+		} else {
+			field.Widget = WidgetList
+		}
+	case *contactList:
+		field.Type = TypeArray
+		field.Widget = WidgetContactList
+		field.Contact = p.kind
+		field.Unique = p.unique
+		var def struct {
+			Items json.RawMessage `json:"items"`
+		}
+		if err := json.Unmarshal(definition, &def); err != nil {
+			return FormField{}, err
+		}
+		items, err := buildFormField(ContactFieldValue, ArrayElementFilterKey(path, ContactFieldValue), def.Items,
+			p.value)
+		if err != nil {
+			return FormField{}, err
+		}
+		field.Items = &items
+	case *object:
+		field.Type = TypeObject
+		field.Widget = WidgetGroup
+		var def struct {
+			Properties json.RawMessage `json:"properties"`
+		}
+		if err := json.Unmarshal(definition, &def); err != nil {
+			return FormField{}, err
+		}
+		nested, err := buildFormFields(def.Properties, p.properties, path+".")
+		if err != nil {
+			return FormField{}, err
+		}
+		field.Fields = nested
+	}
+	return field, nil
+}
+
+// orderedKeys returns the keys of a JSON object in the order they appear. A repeated key is
+// returned once, at its first position.
+func orderedKeys(raw json.RawMessage) ([]string, error) {
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	token, err := decoder.Token()
+	if err != nil {
+		return nil, err
+	}
+	if delim, ok := token.(json.Delim); !ok || delim != '{' {
+		return nil, fmt.Errorf("expected a JSON object")
+	}
+
+	var keys []string
+	seen := make(map[string]struct{})
+	for decoder.More() {
+		token, err := decoder.Token()
+		if err != nil {
+			return nil, err
+		}
+		key, ok := token.(string)
+		if !ok {
+			return nil, fmt.Errorf("expected an object key")
+		}
+		var value json.RawMessage
+		if err := decoder.Decode(&value); err != nil {
+			return nil, err
+		}
+		if _, ok := seen[key]; ok {
+			continue
+		}
+		seen[key] = struct{}{}
+		keys = append(keys, key)
+	}
+	return keys, nil
+}
@@ -0,0 +1,118 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package model
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type FormFieldsTestSuite struct {
+	suite.Suite
+}
+
+func TestFormFieldsTestSuite(t *testing.T) {
+	suite.Run(t, new(FormFieldsTestSuite))
+}
+
+func (s *FormFieldsTestSuite) TestBuildFormFields_KeepsDeclarationOrder() {
+	fields, err := BuildFormFields(json.RawMessage(`{
+		"username": {"type": "string", "required": true, "unique": true, "displayName": "Username"},
+		"password": {"type": "string", "required": true, "credential": true},
+		"age": {"type": "number"},
+		"active": {"type": "boolean"}
+	}`))
+	s.Require().NoError(err)
+
+	s.Equal([]FormField{
+		{
+			Name: "username", Path: "username", Label: "Username", Type: TypeString, Widget: WidgetText,
+			Required: true, Unique: true,
+		},
+		{
+			Name: "password", Path: "password", Label: "password", Type: TypeString, Widget: WidgetPassword,
+			Required: true, Credential: true, ReadOnlyOnEdit: true,
+		},
+		{Name: "age", Path: "age", Label: "age", Type: TypeNumber, Widget: WidgetNumber},
+		{Name: "active", Path: "active", Label: "active", Type: TypeBoolean, Widget: WidgetCheckbox},
+	}, fields)
+}
+
+func (s *FormFieldsTestSuite) TestBuildFormFields_Widgets() {
+	fields, err := BuildFormFields(json.RawMessage(`{
+		"country": {"type": "string", "enum": ["LK", "US"]},
+		"phone": {"type": "string", "pattern": "^[0-9]+$", "sensitive": true},
+		"locale": {"type": "string", "format": "locale"},
+		"zoneinfo": {"type": "string", "format": "zoneinfo"},
+		"level": {"type": "number", "enum": [1, 2]},
+		"pin": {"type": "number", "credential": true},
+		"tags": {"type": "array", "items": {"type": "string"}},
+		"roles": {"type": "array", "items": {"type": "string", "enum": ["admin", "member"]}}
+	}`))
+	s.Require().NoError(err)
+
+	widgets := make(map[string]string, len(fields))
+	for _, field := range fields {
+		widgets[field.Name] = field.Widget
+	}
+	s.Equal(map[string]string{
+		"country":  WidgetSelect,
+		"phone":    WidgetText,
+		"locale":   WidgetLocale,
+		"zoneinfo": WidgetTimezone,
+		"level":    WidgetSelect,
+		"pin":      WidgetPassword,
+		"tags":     WidgetList,
+		"roles":    WidgetMultiSelect,
+	}, widgets)
+
+	s.Equal(&FormValidation{Enum: []string{"LK", "US"}}, fields[0].Validation)
+	s.Equal(&FormValidation{Pattern: "^[0-9]+$"}, fields[1].Validation)
+	s.True(fields[1].Sensitive)
+	s.Equal(FormatLocale, fields[2].Format)
+	s.Equal(&FormValidation{Enum: []string{"1", "2"}}, fields[4].Validation)
+	s.Equal(&FormField{Path: "roles", Type: TypeString, Widget: WidgetSelect,
+		Validation: &FormValidation{Enum: []string{"admin", "member"}}}, fields[7].Items)
+}
+
+func (s *FormFieldsTestSuite) TestBuildFormFields_NestedObject() {
+	fields, err := BuildFormFields(json.RawMessage(`{
+		"address": {"type": "object", "displayName": "Address", "properties": {
+			"street": {"type": "string", "required": true},
+			"city": {"type": "string"}
+		}}
+	}`))
+	s.Require().NoError(err)
+
+	s.Require().Len(fields, 1)
+	s.Equal(WidgetGroup, fields[0].Widget)
+	s.Equal([]FormField{
+		{Name: "street", Path: "address.street", Label: "street", Type: TypeString, Widget: WidgetText, Required: true},
+		{Name: "city", Path: "address.city", Label: "city", Type: TypeString, Widget: WidgetText},
+	}, fields[0].Fields)
+}
+
+func (s *FormFieldsTestSuite) TestBuildFormFields_InvalidSchema() {
+	fields, err := BuildFormFields(json.RawMessage(`{"name": {"type": "unknown"}}`))
+
+	s.Error(err)
+	s.Nil(fields)
+}
@@ -0,0 +1,293 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package model
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// Normalization rule types supported in the 'normalize' field of string properties.
+const (
+	// NormalizationTrim removes leading and trailing white space.
+	NormalizationTrim = "trim"
+	// NormalizationLowercase converts the value to lower case.
+	NormalizationLowercase = "lowercase"
+	// NormalizationE164 formats a phone number in the E.164 format.
+	NormalizationE164 = "e164"
+	// NormalizationRegexReplace replaces every match of a regular expression.
+	NormalizationRegexReplace = "regexReplace"
+)
+
+var (
+	e164Pattern        = regexp.MustCompile(`^\+[1-9][0-9]{6,14}$`)
+	countryCodePattern = regexp.MustCompile(`^[1-9][0-9]{0,2}$`)
+)
+
+// normalizationRule is a single compiled rule of a string property's normalization pipeline.
+type normalizationRule struct {
+	ruleType           string
+	pattern            *regexp.Regexp
+	replacement        string
+	defaultCountryCode string
+}
+
+// apply returns the value transformed by the rule.
+func (r normalizationRule) apply(value string) string {
+	switch r.ruleType {
+	case NormalizationTrim:
+		return strings.TrimSpace(value)
+	case NormalizationLowercase:
+		return strings.ToLower(value)
+	case NormalizationE164:
+		return formatE164(value, r.defaultCountryCode)
+	case NormalizationRegexReplace:
+		return r.pattern.ReplaceAllString(value, r.replacement)
+	default:
+		return value
+	}
+}
+
+// formatE164 formats a phone number in the E.164 format. Spaces, dashes, dots and parentheses are
+// removed, and a leading "00" is treated as the international prefix. Numbers without an international
+// prefix are qualified with the default country code after dropping a single leading trunk prefix "0".
+// Values that cannot be formatted are returned unchanged so that schema validation can reject them.
+func formatE164(value, defaultCountryCode string) string {
+	remaining := strings.TrimSpace(value)
+	international := false
+	if strings.HasPrefix(remaining, "+") {
+		international = true
+		remaining = remaining[1:]
+	} else if strings.HasPrefix(remaining, "00") {
+		international = true
+		remaining = remaining[2:]
+	}
+
+	var digits strings.Builder
+	for _, r := range remaining {
+		switch {
+		case r >= '0' && r <= '9':
+			digits.WriteRune(r)
+		case r == ' ' || r == '-' || r == '.' || r == '(' || r == ')':
+			continue
+		default:
+			return value
+		}
+	}
+
+	number := digits.String()
+	if !international {
+		if defaultCountryCode == "" {
+			return value
+		}
+		number = defaultCountryCode + strings.TrimPrefix(number, "0")
+	}
+
+	if !e164Pattern.MatchString("+" + number) {
+		return value
+	}
+	return "+" + number
+}
+
+// compileNormalizationRules compiles the 'normalize' field of a string property.
+func compileNormalizationRules(raw json.RawMessage) ([]normalizationRule, error) {
+	var rulesRaw []map[string]json.RawMessage
+	if err := json.Unmarshal(raw, &rulesRaw); err != nil {
+		return nil, fmt.Errorf("'normalize' field must be an array of rule objects")
+	}
+	if len(rulesRaw) == 0 {
+		return nil, fmt.Errorf("'normalize' array cannot be empty")
+	}
+
+	rules := make([]normalizationRule, 0, len(rulesRaw))
+	for i, ruleRaw := range rulesRaw {
+		rule, err := compileNormalizationRule(ruleRaw)
+		if err != nil {
+			return nil, fmt.Errorf("invalid 'normalize' rule at index %d: %w", i, err)
+		}
+		rules = append(rules, rule)
+	}
+
+	return rules, nil
+}
+
+func compileNormalizationRule(ruleRaw map[string]json.RawMessage) (normalizationRule, error) {
+	rule := normalizationRule{}
+
+	typeRaw, exists := ruleRaw["type"]
+	if !exists {
+		return rule, fmt.Errorf("missing required 'type' field")
+	}
+	if err := json.Unmarshal(typeRaw, &rule.ruleType); err != nil {
+		return rule, fmt.Errorf("'type' field must be a string")
+	}
+
+	allowedFields := map[string]struct{}{"type": {}}
+	switch rule.ruleType {
+	case NormalizationTrim, NormalizationLowercase:
+	case NormalizationE164:
+		allowedFields["defaultCountryCode"] = struct{}{}
+		if raw, exists := ruleRaw["defaultCountryCode"]; exists {
+			if err := json.Unmarshal(raw, &rule.defaultCountryCode); err != nil ||
+				!countryCodePattern.MatchString(rule.defaultCountryCode) {
+				return rule, fmt.Errorf("'defaultCountryCode' field must be a country calling code such as \"1\"")
+			}
+		}
+	case NormalizationRegexReplace:
+		allowedFields["pattern"] = struct{}{}
+		allowedFields["replacement"] = struct{}{}
+		var patternStr string
+		if raw, exists := ruleRaw["pattern"]; exists {
+			if err := json.Unmarshal(raw, &patternStr); err != nil {
+				return rule, fmt.Errorf("'pattern' field must be a string")
+			}
+		}
+		if patternStr == "" {
+			return rule, fmt.Errorf("missing required 'pattern' field")
+		}
+		compiled, err := regexp.Compile(patternStr)
+		if err != nil {
+			return rule, fmt.Errorf("failed to compile regex pattern: %w", err)
+		}
+		rule.pattern = compiled
+		if raw, exists := ruleRaw["replacement"]; exists {
+			if err := json.Unmarshal(raw, &rule.replacement); err != nil {
+				return rule, fmt.Errorf("'replacement' field must be a string")
+			}
+		}
+	default:
+		return rule, fmt.Errorf("invalid type '%s', must be one of: %s, %s, %s, %s", rule.ruleType,
+			NormalizationTrim, NormalizationLowercase, NormalizationE164, NormalizationRegexReplace)
+	}
+
+	for field := range ruleRaw {
+		if _, ok := allowedFields[field]; !ok {
+			return rule, fmt.Errorf("invalid field '%s' for '%s' rule", field, rule.ruleType)
+		}
+	}
+
+	return rule, nil
+}
+
+// Normalization describes an attribute value changed by the normalization rules of its property.
+type Normalization struct {
+	Attribute  string `json:"attribute"`
+	Original   string `json:"original"`
+	Normalized string `json:"normalized"`
+}
+
+// NormalizeAttributes applies the normalization rules of the schema properties to the attributes in
+// place, including nested objects and array items, and returns the changed values sorted by path.
+// Attributes that are not declared in the schema or do not hold a string are left untouched.
+func (cs *Schema) NormalizeAttributes(attrs map[string]interface{}) []Normalization {
+	changes := make([]Normalization, 0)
+	normalizeProperties(cs.properties, attrs, "", &changes)
+
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].Attribute < changes[j].Attribute
+	})
+	return changes
+}
+
+// Normalize applies the normalization rules of the schema to the given attributes and sets the attributes
+// mirroring the selected value of each multi-valued contact property. The attributes are returned as is
+// when no value changes.
+func (cs *Schema) Normalize(attributes json.RawMessage) (json.RawMessage, error) {
+	if len(attributes) == 0 {
+		return attributes, nil
+	}
+
+	var userAttrs map[string]interface{}
+	if err := json.Unmarshal(attributes, &userAttrs); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal user attributes: %w", err)
+	}
+
+	changed := len(cs.NormalizeAttributes(userAttrs)) > 0
+	if cs.SelectContactValues(userAttrs) {
+		changed = true
+	}
+	if !changed {
+		return attributes, nil
+	}
+
+	normalized, err := json.Marshal(userAttrs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal normalized attributes: %w", err)
+	}
+	return normalized, nil
+}
+
+// normalizeProperties normalizes the attributes declared by the given properties.
+func normalizeProperties(
+	properties map[string]property, attrs map[string]interface{}, prefix string, changes *[]Normalization,
+) {
+	for name, prop := range properties {
+		value, exists := attrs[name]
+		if !exists {
+			continue
+		}
+		attrs[name] = normalizeValue(prop, value, prefix+name, changes)
+	}
+}
+
+// normalizeValue returns the value normalized by the rules of the property, recording any change.
+func normalizeValue(prop property, value interface{}, path string, changes *[]Normalization) interface{} {
+	switch p := prop.(type) {
+	case *str:
+		strValue, ok := value.(string)
+		if !ok || len(p.normalize) == 0 {
+			return value
+		}
+		normalized := strValue
+		for _, rule := range p.normalize {
+			normalized = rule.apply(normalized)
+		}
+		if normalized != strValue {
+			*changes = append(*changes, Normalization{Attribute: path, Original: strValue, Normalized: normalized})
+		}
+		return normalized
+	case *object:
+		if nested, ok := value.(map[string]interface{}); ok {
+			normalizeProperties(p.properties, nested, path+".", changes)
+		}
+	case *array:
+		if items, ok := value.([]interface{}); ok {
+			for i, item := range items {
+				items[i] = normalizeValue(p.items, item, fmt.Sprintf("%s[%d]", path, i), changes)
+			}
+		}
+	case *contactList:
+		if items, ok := value.([]interface{}); ok {
+			for i, item := range items {
+				itemMap, ok := item.(map[string]interface{})
+				if !ok {
+					continue
+				}
+				if contactValue, exists := itemMap[ContactFieldValue]; exists {
+					itemMap[ContactFieldValue] = normalizeValue(p.value, contactValue,
+						fmt.Sprintf("%s[%d].%s", path, i, ContactFieldValue), changes)
+				}
+			}
+		}
+	}
+	return value
+}
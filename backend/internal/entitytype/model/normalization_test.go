@@ -0,0 +1,143 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package model
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalizeAppliesRulesInOrder(t *testing.T) {
+	schema, err := CompileSchema(json.RawMessage(`{
+		"email": {"type": "string", "normalize": [{"type": "trim"}, {"type": "lowercase"}]},
+		"mobile": {"type": "string", "normalize": [{"type": "e164", "defaultCountryCode": "44"}]},
+		"username": {"type": "string", "normalize": [
+			{"type": "regexReplace", "pattern": "\\s+", "replacement": "_"}
+		]},
+		"age": {"type": "number"}
+	}`))
+	require.NoError(t, err)
+
+	attrs := map[string]interface{}{
+		"email":    "  Alice@Example.COM ",
+		"mobile":   "07700 900-123",
+		"username": "alice  smith",
+		"age":      float64(30),
+	}
+	changes := schema.NormalizeAttributes(attrs)
+
+	require.Equal(t, map[string]interface{}{
+		"email":    "alice@example.com",
+		"mobile":   "+447700900123",
+		"username": "alice_smith",
+		"age":      float64(30),
+	}, attrs)
+	require.Equal(t, []Normalization{
+		{Attribute: "email", Original: "  Alice@Example.COM ", Normalized: "alice@example.com"},
+		{Attribute: "mobile", Original: "07700 900-123", Normalized: "+447700900123"},
+		{Attribute: "username", Original: "alice  smith", Normalized: "alice_smith"},
+	}, changes)
+}
+
+func TestNormalizeNestedObjectsAndArrays(t *testing.T) {
+	schema, err := CompileSchema(json.RawMessage(`{
+		"contact": {"type": "object", "properties": {
+			"email": {"type": "string", "normalize": [{"type": "lowercase"}]}
+		}},
+		"aliases": {"type": "array", "items": {"type": "string", "normalize": [{"type": "trim"}]}}
+	}`))
+	require.NoError(t, err)
+
+	normalized, err := schema.Normalize(json.RawMessage(
+		`{"contact":{"email":"Bob@Example.com"},"aliases":[" bob ","bobby"]}`))
+
+	require.NoError(t, err)
+	require.JSONEq(t, `{"contact":{"email":"bob@example.com"},"aliases":["bob","bobby"]}`, string(normalized))
+}
+
+func TestNormalizeReturnsAttributesUnchangedWithoutChanges(t *testing.T) {
+	schema, err := CompileSchema(json.RawMessage(`{
+		"email": {"type": "string", "normalize": [{"type": "lowercase"}]}
+	}`))
+	require.NoError(t, err)
+	attributes := json.RawMessage(`{ "email": "carol@example.com", "extra": true }`)
+
+	normalized, err := schema.Normalize(attributes)
+
+	require.NoError(t, err)
+	require.Equal(t, string(attributes), string(normalized))
+}
+
+func TestFormatE164(t *testing.T) {
+	testCases := []struct {
+		name               string
+		value              string
+		defaultCountryCode string
+		expected           string
+	}{
+		{"AlreadyFormatted", "+14155550123", "", "+14155550123"},
+		{"InternationalWithSeparators", "+1 (415) 555-0123", "", "+14155550123"},
+		{"InternationalDialingPrefix", "0094 77 123 4567", "", "+94771234567"},
+		{"NationalWithDefaultCountry", "077 123 4567", "94", "+94771234567"},
+		{"NationalWithoutDefaultCountry", "077 123 4567", "", "077 123 4567"},
+		{"InvalidCharacters", "+1 415 CALL NOW", "", "+1 415 CALL NOW"},
+		{"TooShort", "+1 23", "", "+1 23"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, formatE164(tc.value, tc.defaultCountryCode))
+		})
+	}
+}
+
+func TestCompileNormalizationRulesRejectsInvalidRules(t *testing.T) {
+	testCases := []struct {
+		name     string
+		property string
+		errMsg   string
+	}{
+		{"NotAnArray", `{"type": "string", "normalize": "trim"}`, "must be an array"},
+		{"EmptyArray", `{"type": "string", "normalize": []}`, "cannot be empty"},
+		{"MissingType", `{"type": "string", "normalize": [{}]}`, "missing required 'type' field"},
+		{"UnknownType", `{"type": "string", "normalize": [{"type": "uppercase"}]}`, "invalid type 'uppercase'"},
+		{"UnknownField", `{"type": "string", "normalize": [{"type": "trim", "pattern": "x"}]}`,
+			"invalid field 'pattern'"},
+		{"MissingPattern", `{"type": "string", "normalize": [{"type": "regexReplace"}]}`,
+			"missing required 'pattern' field"},
+		{"InvalidPattern", `{"type": "string", "normalize": [{"type": "regexReplace", "pattern": "("}]}`,
+			"failed to compile regex pattern"},
+		{"InvalidCountryCode", `{"type": "string", "normalize": [{"type": "e164", "defaultCountryCode": "+1"}]}`,
+			"'defaultCountryCode' field"},
+		{"CredentialProperty", `{"type": "string", "credential": true, "normalize": [{"type": "trim"}]}`,
+			"cannot be used with credential properties"},
+		{"NonStringProperty", `{"type": "number", "normalize": [{"type": "trim"}]}`, "invalid field 'normalize'"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := CompileSchema(json.RawMessage(`{"attr": ` + tc.property + `}`))
+
+			require.Error(t, err)
+			require.Contains(t, err.Error(), tc.errMsg)
+		})
+	}
+}
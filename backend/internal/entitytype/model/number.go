@@ -21,16 +21,20 @@ package model
 import (
 	"encoding/json"
 	"fmt"
+	"strconv"
 
 	"github.com/thunder-id/thunderid/internal/system/log"
 )
 
 type number struct {
-	required    bool
-	unique      bool
-	credential  bool
-	displayName string
-	enum        map[float64]struct{}
+	required        bool
+	sensitive       bool
+	unique          bool
+	uniquenessScope UniquenessScope
+	credential      bool
+	displayName     string
+	enum            map[float64]struct{}
+	enumValues      []float64
 }
 
 func (p *number) isUnique() bool {
@@ -41,6 +45,10 @@ func (p *number) isRequired() bool {
 	return p.required
 }
 
+func (p *number) isSensitive() bool {
+	return p.sensitive
+}
+
 func (p *number) isCredential() bool {
 	return p.credential
 }
@@ -53,6 +61,17 @@ func (p *number) getDisplayName() string {
 	return p.displayName
 }
 
+func (p *number) getValueConstraints() *ValueConstraints {
+	if p.enumValues == nil {
+		return nil
+	}
+	enum := make([]string, 0, len(p.enumValues))
+	for _, value := range p.enumValues {
+		enum = append(enum, strconv.FormatFloat(value, 'f', -1, 64))
+	}
+	return &ValueConstraints{Enum: enum}
+}
+
 func (p *number) validateValue(value interface{}, path string, logger *log.Logger) (bool, error) {
 	numberValue, ok := convertToFloat64(value)
 	if !ok {
@@ -75,14 +94,14 @@ func (p *number) validateValue(value interface{}, path string, logger *log.Logge
 func (p *number) validateUniqueness(
 	value interface{},
 	path string,
-	exists func(map[string]interface{}) (bool, error),
+	exists ExistsFunc,
 	logger *log.Logger,
 ) (bool, error) {
 	if !p.unique {
 		return true, nil
 	}
 
-	found, err := exists(map[string]interface{}{path: value})
+	found, err := exists(map[string]interface{}{path: value}, p.uniquenessScope)
 	if err != nil {
 		return false, err
 	}
@@ -92,12 +111,14 @@ func (p *number) validateUniqueness(
 
 func compileNumberProperty(propMap map[string]json.RawMessage) (property, error) {
 	allowedFields := map[string]struct{}{
-		"type":        {},
-		"required":    {},
-		"unique":      {},
-		"credential":  {},
-		"displayName": {},
-		"enum":        {},
+		"type":            {},
+		"required":        {},
+		"sensitive":       {},
+		"unique":          {},
+		"uniquenessScope": {},
+		"credential":      {},
+		"displayName":     {},
+		"enum":            {},
 	}
 
 	for field := range propMap {
@@ -114,12 +135,24 @@ func compileNumberProperty(propMap map[string]json.RawMessage) (property, error)
 		}
 	}
 
+	if raw, exists := propMap["sensitive"]; exists {
+		if err := json.Unmarshal(raw, &prop.sensitive); err != nil {
+			return nil, fmt.Errorf("'sensitive' field must be a boolean")
+		}
+	}
+
 	if raw, exists := propMap["unique"]; exists {
 		if err := json.Unmarshal(raw, &prop.unique); err != nil {
 			return nil, fmt.Errorf("'unique' field must be a boolean")
 		}
 	}
 
+	scope, err := compileUniquenessScope(propMap, prop.unique)
+	if err != nil {
+		return nil, err
+	}
+	prop.uniquenessScope = scope
+
 	if raw, exists := propMap["credential"]; exists {
 		if err := json.Unmarshal(raw, &prop.credential); err != nil {
 			return nil, fmt.Errorf("'credential' field must be a boolean")
@@ -147,6 +180,9 @@ func compileNumberProperty(propMap map[string]json.RawMessage) (property, error)
 			if err := json.Unmarshal(itemRaw, &value); err != nil {
 				return nil, fmt.Errorf("'enum' array item at index %d must be a number to match property type", i)
 			}
+			if _, dup := prop.enum[value]; !dup {
+				prop.enumValues = append(prop.enumValues, value)
+			}
 			prop.enum[value] = struct{}{}
 		}
 	}
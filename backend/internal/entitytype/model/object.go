@@ -27,6 +27,7 @@ import (
 
 type object struct {
 	required    bool
+	sensitive   bool
 	displayName string
 	properties  map[string]property
 }
@@ -35,6 +36,10 @@ func (p *object) isRequired() bool {
 	return p.required
 }
 
+func (p *object) isSensitive() bool {
+	return p.sensitive
+}
+
 func (p *object) isCredential() bool {
 	return false
 }
@@ -47,6 +52,10 @@ func (p *object) getDisplayName() string {
 	return p.displayName
 }
 
+func (p *object) getValueConstraints() *ValueConstraints {
+	return nil
+}
+
 func (p *object) isUnique() bool {
 	return false
 }
@@ -98,7 +107,7 @@ func (p *object) validateValue(value interface{}, path string, logger *log.Logge
 func (p *object) validateUniqueness(
 	value interface{},
 	path string,
-	exists func(map[string]interface{}) (bool, error),
+	exists ExistsFunc,
 	logger *log.Logger,
 ) (bool, error) {
 	valueMap, ok := value.(map[string]interface{})
@@ -132,6 +141,7 @@ func compileObjectProperty(propMap map[string]json.RawMessage) (property, error)
 		"type":        {},
 		"properties":  {},
 		"required":    {},
+		"sensitive":   {},
 		"displayName": {},
 	}
 
@@ -151,6 +161,12 @@ func compileObjectProperty(propMap map[string]json.RawMessage) (property, error)
 		}
 	}
 
+	if raw, exists := propMap["sensitive"]; exists {
+		if err := json.Unmarshal(raw, &prop.sensitive); err != nil {
+			return nil, fmt.Errorf("'sensitive' field must be a boolean")
+		}
+	}
+
 	if raw, exists := propMap["displayName"]; exists {
 		if err := json.Unmarshal(raw, &prop.displayName); err != nil {
 			return nil, fmt.Errorf("'displayName' field must be a string")
@@ -21,6 +21,7 @@ package model
 import (
 	"encoding/json"
 	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/thunder-id/thunderid/internal/system/log"
@@ -42,13 +43,15 @@ const (
 
 type property interface {
 	isRequired() bool
+	isSensitive() bool
 	isCredential() bool
 	isDisplayable() bool
 	isUnique() bool
 	getDisplayName() string
+	getValueConstraints() *ValueConstraints
 	validateValue(value interface{}, path string, logger *log.Logger) (bool, error)
 	validateUniqueness(value interface{}, path string,
-		exists func(map[string]interface{}) (bool, error), logger *log.Logger) (bool, error)
+		exists ExistsFunc, logger *log.Logger) (bool, error)
 }
 
 // Schema represents an entity type schema with a set of properties.
@@ -121,6 +124,14 @@ type AttributeInfo struct {
 	DisplayName string
 	Required    bool
 	Credential  bool
+	Constraints *ValueConstraints
+}
+
+// ValueConstraints holds the rules a property enforces on its values, beyond its type.
+// Pattern uses RE2 syntax. Enum values are rendered as strings in declaration order.
+type ValueConstraints struct {
+	Pattern string
+	Enum    []string
 }
 
 // GetAttributes returns top-level properties filtered by the provided flags.
@@ -144,6 +155,7 @@ func (cs *Schema) GetAttributes(allowCredential, allowNonCredential, requiredOnl
 			DisplayName: prop.getDisplayName(),
 			Required:    prop.isRequired(),
 			Credential:  isCredential,
+			Constraints: prop.getValueConstraints(),
 		})
 	}
 	return result
@@ -161,6 +173,35 @@ func (cs *Schema) GetUniqueAttributes() []string {
 	return fields
 }
 
+// GetUniquenessScopes returns the uniqueness scope of each unique property by its dot-notation path.
+func (cs *Schema) GetUniquenessScopes() map[string]UniquenessScope {
+	scopes := make(map[string]UniquenessScope)
+	collectUniquenessScopes(cs.properties, "", scopes)
+	return scopes
+}
+
+// GetSensitiveAttributes returns the dot-notation paths of properties marked as sensitive.
+// A sensitive object is reported as a whole; otherwise its nested properties are inspected.
+func (cs *Schema) GetSensitiveAttributes() []string {
+	return collectSensitivePaths(cs.properties, "")
+}
+
+// collectSensitivePaths walks the properties and returns the paths of sensitive properties.
+func collectSensitivePaths(properties map[string]property, prefix string) []string {
+	var paths []string
+	for name, prop := range properties {
+		path := prefix + name
+		if prop.isSensitive() {
+			paths = append(paths, path)
+			continue
+		}
+		if obj, ok := prop.(*object); ok {
+			paths = append(paths, collectSensitivePaths(obj.properties, path+".")...)
+		}
+	}
+	return paths
+}
+
 // Validate validates the user attributes against the schema.
 // When skipCredentialRequired is true, missing credential properties do not fail
 // the required check. This is used during updates where credentials are not
@@ -209,10 +250,67 @@ func (cs *Schema) Validate(attributes json.RawMessage, logger *log.Logger, skipC
 	return true, nil
 }
 
+// Violation reasons reported by CollectViolations.
+const (
+	// ViolationRequired indicates that a required attribute is missing.
+	ViolationRequired = "REQUIRED"
+	// ViolationInvalidValue indicates that an attribute value does not satisfy its definition.
+	ViolationInvalidValue = "INVALID_VALUE"
+	// ViolationUndeclared indicates that an attribute is not declared in the schema.
+	ViolationUndeclared = "UNDECLARED"
+)
+
+// Violation describes a single reason why attributes failed schema validation.
+type Violation struct {
+	Attribute string `json:"attribute"`
+	Reason    string `json:"reason"`
+}
+
+// CollectViolations validates the attributes against the schema like Validate, but instead of
+// stopping at the first failure it reports every violation, sorted by attribute name.
+func (cs *Schema) CollectViolations(attributes json.RawMessage, logger *log.Logger) ([]Violation, error) {
+	userAttrs := map[string]interface{}{}
+	if len(attributes) > 0 {
+		if err := json.Unmarshal(attributes, &userAttrs); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal user attributes: %w", err)
+		}
+	}
+
+	violations := make([]Violation, 0)
+	for propName, prop := range cs.properties {
+		value, exists := userAttrs[propName]
+		if !exists {
+			if prop.isRequired() {
+				violations = append(violations, Violation{Attribute: propName, Reason: ViolationRequired})
+			}
+			continue
+		}
+
+		isValid, err := prop.validateValue(value, propName, logger)
+		if err != nil {
+			return nil, err
+		}
+		if !isValid {
+			violations = append(violations, Violation{Attribute: propName, Reason: ViolationInvalidValue})
+		}
+	}
+
+	for key := range userAttrs {
+		if _, declared := cs.properties[key]; !declared {
+			violations = append(violations, Violation{Attribute: key, Reason: ViolationUndeclared})
+		}
+	}
+
+	sort.Slice(violations, func(i, j int) bool {
+		return violations[i].Attribute < violations[j].Attribute
+	})
+	return violations, nil
+}
+
 // ValidateUniqueness checks uniqueness constraints for the schema properties.
 func (cs *Schema) ValidateUniqueness(
 	attrs map[string]interface{},
-	exists func(map[string]interface{}) (bool, error),
+	exists ExistsFunc,
 	logger *log.Logger,
 ) (bool, error) {
 	if len(cs.properties) == 0 {
@@ -277,9 +375,20 @@ func CompileSchema(schema json.RawMessage) (*Schema, error) {
 		compiled.properties[propName] = compiledProp
 	}
 
+	if err := validateContactSelections(compiled.properties); err != nil {
+		return nil, err
+	}
+
 	return compiled, nil
 }
 
+// ValidatePropertyDefinition checks that a single top-level property definition of an entity type schema
+// compiles, so that a schema can be validated property by property as it is read.
+func ValidatePropertyDefinition(propName string, propRaw json.RawMessage) error {
+	_, err := compileProperty(propName, propRaw)
+	return err
+}
+
 func compileProperty(propName string, propRaw json.RawMessage) (property, error) {
 	var propMap map[string]json.RawMessage
 	if err := json.Unmarshal(propRaw, &propMap); err != nil {
@@ -306,6 +306,28 @@ func (s *SchemaValidateTestSuite) TestGetAttributes_NonCredentialRequiredOnly_Re
 	s.False(hasAge, "age is not required and must be excluded")
 }
 
+func (s *SchemaValidateTestSuite) TestGetAttributes_ExposesValueConstraints() {
+	schema, err := CompileSchema(json.RawMessage(`{
+		"password": {"type": "string", "credential": true, "regex": "^.{12,}$"},
+		"tier":     {"type": "string", "enum": ["gold", "silver", "gold", "bronze"]},
+		"level":    {"type": "number", "enum": [3, 1.5]},
+		"nickname": {"type": "string"},
+		"active":   {"type": "boolean"}
+	}`))
+	s.Require().NoError(err)
+
+	constraints := make(map[string]*ValueConstraints)
+	for _, attr := range schema.GetAttributes(true, true, false) {
+		constraints[attr.Attribute] = attr.Constraints
+	}
+
+	s.Equal(&ValueConstraints{Pattern: "^.{12,}$"}, constraints["password"])
+	s.Equal(&ValueConstraints{Enum: []string{"gold", "silver", "bronze"}}, constraints["tier"])
+	s.Equal(&ValueConstraints{Enum: []string{"3", "1.5"}}, constraints["level"])
+	s.Nil(constraints["nickname"])
+	s.Nil(constraints["active"])
+}
+
 func (s *SchemaValidateTestSuite) TestGetAttributes_NonCredentialRequiredOnly_EmptySchema() {
 	schema := &Schema{properties: map[string]property{}}
 
@@ -436,3 +458,97 @@ func (s *SchemaValidateTestSuite) TestGetAttributes_AllAttrs_CredentialFieldSet(
 	s.True(attrMap["password"].Credential, "credential attribute must have Credential=true")
 	s.False(attrMap["email"].Credential, "non-credential attribute must have Credential=false")
 }
+
+func (s *SchemaValidateTestSuite) TestGetSensitiveAttributes() {
+	schema, err := CompileSchema(json.RawMessage(`{
+		"ssn":      {"type": "string", "sensitive": true},
+		"email":    {"type": "string"},
+		"salary":   {"type": "number", "sensitive": true},
+		"verified": {"type": "boolean", "sensitive": true},
+		"tags":     {"type": "array", "items": {"type": "string"}, "sensitive": true},
+		"medical":  {"type": "object", "sensitive": true, "properties": {"bloodType": {"type": "string"}}},
+		"address":  {"type": "object", "properties": {
+			"city": {"type": "string"},
+			"zip":  {"type": "string", "sensitive": true}
+		}}
+	}`))
+	s.Require().NoError(err)
+
+	s.ElementsMatch([]string{"ssn", "salary", "verified", "tags", "medical", "address.zip"},
+		schema.GetSensitiveAttributes())
+}
+
+func (s *SchemaValidateTestSuite) TestStringFormat_LocaleAndZoneinfo() {
+	schema, err := CompileSchema(json.RawMessage(`{
+		"locale": {"type": "string", "format": "locale"},
+		"zoneinfo": {"type": "string", "format": "zoneinfo"}
+	}`))
+	s.Require().NoError(err)
+
+	testCases := []struct {
+		name       string
+		attributes string
+		expected   bool
+	}{
+		{"Valid", `{"locale":"fr-CA","zoneinfo":"America/Toronto"}`, true},
+		{"InvalidLocale", `{"locale":"fr_CA"}`, false},
+		{"InvalidZoneinfo", `{"zoneinfo":"Toronto"}`, false},
+	}
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			ok, err := schema.Validate(json.RawMessage(tc.attributes), s.logger, false)
+			s.Require().NoError(err)
+			s.Equal(tc.expected, ok)
+		})
+	}
+}
+
+func (s *SchemaValidateTestSuite) TestStringFormat_CompileErrors() {
+	_, err := CompileSchema(json.RawMessage(`{"locale": {"type": "string", "format": "date"}}`))
+	s.Require().Error(err)
+
+	_, err = CompileSchema(json.RawMessage(`{"locale": {"type": "string", "format": 1}}`))
+	s.Require().Error(err)
+}
+
+func (s *SchemaValidateTestSuite) TestSensitiveInvalidType_CompileError() {
+	_, err := CompileSchema(json.RawMessage(`{"ssn": {"type": "string", "sensitive": "yes"}}`))
+	s.Error(err)
+	s.Contains(err.Error(), "'sensitive' field must be a boolean")
+}
+
+func (s *SchemaValidateTestSuite) TestCollectViolations_ReportsAllViolationsSorted() {
+	schema, err := CompileSchema(json.RawMessage(`{
+		"email": {"type": "string", "required": true},
+		"age": {"type": "number"},
+		"name": {"type": "string"}
+	}`))
+	s.Require().NoError(err)
+
+	violations, err := schema.CollectViolations(json.RawMessage(`{"age":"old","zip":"123","name":"a"}`), s.logger)
+	s.Require().NoError(err)
+	s.Require().Equal([]Violation{
+		{Attribute: "age", Reason: ViolationInvalidValue},
+		{Attribute: "email", Reason: ViolationRequired},
+		{Attribute: "zip", Reason: ViolationUndeclared},
+	}, violations)
+}
+
+func (s *SchemaValidateTestSuite) TestCollectViolations_ValidAttributes_Empty() {
+	schema, err := CompileSchema(json.RawMessage(`{"email": {"type": "string", "required": true}}`))
+	s.Require().NoError(err)
+
+	violations, err := schema.CollectViolations(json.RawMessage(`{"email":"user@example.com"}`), s.logger)
+	s.Require().NoError(err)
+	s.Require().Empty(violations)
+}
+
+func (s *SchemaValidateTestSuite) TestValidatePropertyDefinition() {
+	s.NoError(ValidatePropertyDefinition("email", json.RawMessage(`{"type":"string","required":true}`)))
+
+	err := ValidatePropertyDefinition("email", json.RawMessage(`{"required":true}`))
+	s.EqualError(err, "missing required 'type' field")
+
+	err = ValidatePropertyDefinition("email", json.RawMessage(`"string"`))
+	s.EqualError(err, "property definition must be an object")
+}
@@ -24,15 +24,29 @@ import (
 	"regexp"
 
 	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/internal/system/utils"
+)
+
+// String formats supported in the 'format' field of string properties.
+const (
+	// FormatLocale requires a canonical BCP 47 language tag, as used by the OIDC "locale" claim.
+	FormatLocale = "locale"
+	// FormatZoneinfo requires an IANA time zone name, as used by the OIDC "zoneinfo" claim.
+	FormatZoneinfo = "zoneinfo"
 )
 
 type str struct {
-	required    bool
-	unique      bool
-	credential  bool
-	displayName string
-	enum        map[string]struct{}
-	pattern     *regexp.Regexp
+	required        bool
+	sensitive       bool
+	unique          bool
+	uniquenessScope UniquenessScope
+	credential      bool
+	displayName     string
+	enum            map[string]struct{}
+	enumValues      []string
+	pattern         *regexp.Regexp
+	format          string
+	normalize       []normalizationRule
 }
 
 func (p *str) isUnique() bool {
@@ -43,6 +57,10 @@ func (p *str) isRequired() bool {
 	return p.required
 }
 
+func (p *str) isSensitive() bool {
+	return p.sensitive
+}
+
 func (p *str) isCredential() bool {
 	return p.credential
 }
@@ -55,6 +73,17 @@ func (p *str) getDisplayName() string {
 	return p.displayName
 }
 
+func (p *str) getValueConstraints() *ValueConstraints {
+	if p.pattern == nil && p.enumValues == nil {
+		return nil
+	}
+	constraints := &ValueConstraints{Enum: p.enumValues}
+	if p.pattern != nil {
+		constraints.Pattern = p.pattern.String()
+	}
+	return constraints
+}
+
 func (p *str) validateValue(value interface{}, path string, logger *log.Logger) (bool, error) {
 	strValue, ok := value.(string)
 	if !ok {
@@ -75,20 +104,26 @@ func (p *str) validateValue(value interface{}, path string, logger *log.Logger)
 		return false, nil
 	}
 
+	if !matchesFormat(p.format, strValue) {
+		logger.Debug("Format mismatch", log.String("property", path), log.String("format", p.format),
+			log.String("value", strValue))
+		return false, nil
+	}
+
 	return true, nil
 }
 
 func (p *str) validateUniqueness(
 	value interface{},
 	path string,
-	exists func(map[string]interface{}) (bool, error),
+	exists ExistsFunc,
 	logger *log.Logger,
 ) (bool, error) {
 	if !p.unique {
 		return true, nil
 	}
 
-	found, err := exists(map[string]interface{}{path: value})
+	found, err := exists(map[string]interface{}{path: value}, p.uniquenessScope)
 	if err != nil {
 		return false, err
 	}
@@ -98,14 +133,18 @@ func (p *str) validateUniqueness(
 
 func compileStringProperty(propMap map[string]json.RawMessage) (property, error) {
 	allowedFields := map[string]struct{}{
-		"type":        {},
-		"required":    {},
-		"unique":      {},
-		"credential":  {},
-		"displayName": {},
-		"enum":        {},
-		"regex":       {},
-		"pattern":     {},
+		"type":            {},
+		"required":        {},
+		"sensitive":       {},
+		"unique":          {},
+		"uniquenessScope": {},
+		"credential":      {},
+		"displayName":     {},
+		"enum":            {},
+		"regex":           {},
+		"pattern":         {},
+		"format":          {},
+		"normalize":       {},
 	}
 
 	for field := range propMap {
@@ -122,12 +161,24 @@ func compileStringProperty(propMap map[string]json.RawMessage) (property, error)
 		}
 	}
 
+	if raw, exists := propMap["sensitive"]; exists {
+		if err := json.Unmarshal(raw, &prop.sensitive); err != nil {
+			return nil, fmt.Errorf("'sensitive' field must be a boolean")
+		}
+	}
+
 	if raw, exists := propMap["unique"]; exists {
 		if err := json.Unmarshal(raw, &prop.unique); err != nil {
 			return nil, fmt.Errorf("'unique' field must be a boolean")
 		}
 	}
 
+	scope, err := compileUniquenessScope(propMap, prop.unique)
+	if err != nil {
+		return nil, err
+	}
+	prop.uniquenessScope = scope
+
 	if raw, exists := propMap["credential"]; exists {
 		if err := json.Unmarshal(raw, &prop.credential); err != nil {
 			return nil, fmt.Errorf("'credential' field must be a boolean")
@@ -155,6 +206,9 @@ func compileStringProperty(propMap map[string]json.RawMessage) (property, error)
 			if err := json.Unmarshal(itemRaw, &value); err != nil {
 				return nil, fmt.Errorf("'enum' array item at index %d must be a string to match property type", i)
 			}
+			if _, dup := prop.enum[value]; !dup {
+				prop.enumValues = append(prop.enumValues, value)
+			}
 			prop.enum[value] = struct{}{}
 		}
 	}
@@ -165,9 +219,41 @@ func compileStringProperty(propMap map[string]json.RawMessage) (property, error)
 	}
 	prop.pattern = pattern
 
+	if raw, exists := propMap["format"]; exists {
+		if err := json.Unmarshal(raw, &prop.format); err != nil {
+			return nil, fmt.Errorf("'format' field must be a string")
+		}
+		if prop.format != FormatLocale && prop.format != FormatZoneinfo {
+			return nil, fmt.Errorf("unsupported 'format' value '%s'", prop.format)
+		}
+	}
+
+	if raw, exists := propMap["normalize"]; exists {
+		if prop.credential {
+			return nil, fmt.Errorf("'normalize' field cannot be used with credential properties")
+		}
+		rules, err := compileNormalizationRules(raw)
+		if err != nil {
+			return nil, err
+		}
+		prop.normalize = rules
+	}
+
 	return prop, nil
 }
 
+// matchesFormat reports whether value has the given string format. An empty format matches any value.
+func matchesFormat(format, value string) bool {
+	switch format {
+	case FormatLocale:
+		return utils.IsValidLocale(value)
+	case FormatZoneinfo:
+		return utils.IsValidZoneinfo(value)
+	default:
+		return true
+	}
+}
+
 func compilePattern(propMap map[string]json.RawMessage) (*regexp.Regexp, error) {
 	var patternStr string
 	if raw, exists := propMap["regex"]; exists {
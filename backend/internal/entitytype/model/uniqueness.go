@@ -0,0 +1,114 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package model
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// UniquenessScope represents the set of entities among which the value of a unique property must be unique.
+type UniquenessScope string
+
+const (
+	// UniquenessScopeGlobal requires the value to be unique across all entities.
+	UniquenessScopeGlobal UniquenessScope = "global"
+	// UniquenessScopeOU requires the value to be unique among the entities of the same organization unit.
+	UniquenessScopeOU UniquenessScope = "ou"
+	// UniquenessScopeType requires the value to be unique among the entities of the same entity type.
+	UniquenessScopeType UniquenessScope = "type"
+)
+
+// ExistsFunc reports whether an entity other than the one being validated holds the filtered attribute
+// values within the uniqueness scope.
+type ExistsFunc func(filters map[string]interface{}, scope UniquenessScope) (bool, error)
+
+// compileUniquenessScope reads the "uniquenessScope" field of a property. The scope defaults to global and
+// may only be set on unique properties.
+func compileUniquenessScope(propMap map[string]json.RawMessage, unique bool) (UniquenessScope, error) {
+	raw, exists := propMap["uniquenessScope"]
+	if !exists {
+		return UniquenessScopeGlobal, nil
+	}
+	if !unique {
+		return "", fmt.Errorf("'uniquenessScope' field requires 'unique' to be true")
+	}
+
+	var scope UniquenessScope
+	if err := json.Unmarshal(raw, &scope); err != nil {
+		return "", fmt.Errorf("'uniquenessScope' field must be a string")
+	}
+	switch scope {
+	case UniquenessScopeGlobal, UniquenessScopeOU, UniquenessScopeType:
+		return scope, nil
+	default:
+		return "", fmt.Errorf("'uniquenessScope' field must be one of 'global', 'ou' or 'type'")
+	}
+}
+
+// getUniquenessScope returns the uniqueness scope of a property, or an empty scope when the property is
+// not unique.
+func getUniquenessScope(prop property) UniquenessScope {
+	if !prop.isUnique() {
+		return ""
+	}
+	switch p := prop.(type) {
+	case *str:
+		return p.uniquenessScope
+	case *number:
+		return p.uniquenessScope
+	default:
+		return UniquenessScopeGlobal
+	}
+}
+
+// collectUniquenessScopes walks the properties and records the uniqueness scope of each unique property
+// by its dot-notation path.
+func collectUniquenessScopes(properties map[string]property, prefix string, scopes map[string]UniquenessScope) {
+	for name, prop := range properties {
+		path := prefix + name
+		if obj, ok := prop.(*object); ok {
+			collectUniquenessScopes(obj.properties, path+".", scopes)
+			continue
+		}
+		if scope := getUniquenessScope(prop); scope != "" {
+			scopes[path] = scope
+		}
+	}
+}
+
+// IsTighterUniquenessScope reports whether moving a property from the previous to the next uniqueness scope
+// can make the existing entities of the entity type conflict with each other. An empty scope means the
+// property is not unique. Moving from the type scope to the OU scope is not tighter, as entities of the same
+// type that are unique within the type are also unique within each organization unit.
+func IsTighterUniquenessScope(previous, next UniquenessScope) bool {
+	return uniquenessScopeRank(next) > uniquenessScopeRank(previous)
+}
+
+// uniquenessScopeRank ranks uniqueness scopes by how many entities of the same entity type they compare.
+func uniquenessScopeRank(scope UniquenessScope) int {
+	switch scope {
+	case UniquenessScopeOU:
+		return 1
+	case UniquenessScopeType, UniquenessScopeGlobal:
+		return 2
+	default:
+		return 0
+	}
+}
@@ -0,0 +1,142 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package model
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/system/log"
+)
+
+type UniquenessScopeTestSuite struct {
+	suite.Suite
+	logger *log.Logger
+}
+
+func TestUniquenessScopeTestSuite(t *testing.T) {
+	suite.Run(t, new(UniquenessScopeTestSuite))
+}
+
+func (s *UniquenessScopeTestSuite) SetupTest() {
+	s.logger = log.GetLogger()
+}
+
+func (s *UniquenessScopeTestSuite) TestCompile_DefaultsToGlobal() {
+	schema, err := CompileSchema(json.RawMessage(`{
+		"email": {"type": "string", "unique": true},
+		"name": {"type": "string"}
+	}`))
+	s.Require().NoError(err)
+
+	s.Equal(map[string]UniquenessScope{"email": UniquenessScopeGlobal}, schema.GetUniquenessScopes())
+}
+
+func (s *UniquenessScopeTestSuite) TestCompile_ScopedProperties() {
+	schema, err := CompileSchema(json.RawMessage(`{
+		"email": {"type": "string", "unique": true, "uniquenessScope": "ou"},
+		"employeeId": {"type": "number", "unique": true, "uniquenessScope": "type"},
+		"address": {"type": "object", "properties": {
+			"code": {"type": "string", "unique": true, "uniquenessScope": "ou"}
+		}}
+	}`))
+	s.Require().NoError(err)
+
+	s.Equal(map[string]UniquenessScope{
+		"email":        UniquenessScopeOU,
+		"employeeId":   UniquenessScopeType,
+		"address.code": UniquenessScopeOU,
+	}, schema.GetUniquenessScopes())
+}
+
+func (s *UniquenessScopeTestSuite) TestCompile_Errors() {
+	testCases := []struct {
+		name   string
+		schema string
+	}{
+		{"NotUnique", `{"email": {"type": "string", "uniquenessScope": "ou"}}`},
+		{"UnknownScope", `{"email": {"type": "string", "unique": true, "uniquenessScope": "tenant"}}`},
+		{"InvalidType", `{"email": {"type": "string", "unique": true, "uniquenessScope": 1}}`},
+		{"UnsupportedPropertyType", `{"active": {"type": "boolean", "uniquenessScope": "ou"}}`},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			_, err := CompileSchema(json.RawMessage(tc.schema))
+			s.Error(err)
+		})
+	}
+}
+
+func (s *UniquenessScopeTestSuite) TestValidateUniqueness_PassesScope() {
+	schema, err := CompileSchema(json.RawMessage(`{
+		"email": {"type": "string", "unique": true, "uniquenessScope": "ou"},
+		"mobile": {"type": "string", "unique": true}
+	}`))
+	s.Require().NoError(err)
+
+	scopes := map[string]UniquenessScope{}
+	ok, err := schema.ValidateUniqueness(
+		map[string]interface{}{"email": "a@example.com", "mobile": "0771234567"},
+		func(filters map[string]interface{}, scope UniquenessScope) (bool, error) {
+			for key := range filters {
+				scopes[key] = scope
+			}
+			return false, nil
+		}, s.logger)
+	s.Require().NoError(err)
+	s.True(ok)
+	s.Equal(map[string]UniquenessScope{"email": UniquenessScopeOU, "mobile": UniquenessScopeGlobal}, scopes)
+}
+
+func (s *UniquenessScopeTestSuite) TestValidateUniqueness_ConflictInScope() {
+	schema, err := CompileSchema(json.RawMessage(`{
+		"email": {"type": "string", "unique": true, "uniquenessScope": "type"}
+	}`))
+	s.Require().NoError(err)
+
+	ok, err := schema.ValidateUniqueness(map[string]interface{}{"email": "a@example.com"},
+		func(map[string]interface{}, UniquenessScope) (bool, error) { return true, nil }, s.logger)
+	s.Require().NoError(err)
+	s.False(ok)
+}
+
+func (s *UniquenessScopeTestSuite) TestIsTighterUniquenessScope() {
+	testCases := []struct {
+		previous UniquenessScope
+		next     UniquenessScope
+		expected bool
+	}{
+		{"", UniquenessScopeOU, true},
+		{"", UniquenessScopeGlobal, true},
+		{UniquenessScopeOU, UniquenessScopeType, true},
+		{UniquenessScopeOU, UniquenessScopeGlobal, true},
+		{UniquenessScopeType, UniquenessScopeOU, false},
+		{UniquenessScopeGlobal, UniquenessScopeOU, false},
+		{UniquenessScopeType, UniquenessScopeGlobal, false},
+		{UniquenessScopeOU, "", false},
+		{UniquenessScopeOU, UniquenessScopeOU, false},
+	}
+
+	for _, tc := range testCases {
+		s.Equal(tc.expected, IsTighterUniquenessScope(tc.previous, tc.next), "%q -> %q", tc.previous, tc.next)
+	}
+}
@@ -0,0 +1,65 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package entitytype
+
+import (
+	"slices"
+
+	"github.com/thunder-id/thunderid/internal/entitytype/model"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+)
+
+// PasswordCharacterClasses is the number of character classes a password can use: lowercase letters,
+// uppercase letters, digits and symbols.
+const PasswordCharacterClasses = 4
+
+// IsValid reports whether the numeric limits of the policy are in range. The credential attributes are
+// not checked, as they depend on the schema the policy is applied to.
+func (p *PasswordPolicy) IsValid() bool {
+	return p.MinLength >= 0 && p.HistoryCount >= 0 && p.MinCharacterClasses >= 0 &&
+		p.MinCharacterClasses <= PasswordCharacterClasses
+}
+
+// AppliesTo reports whether the policy applies to the given credential attribute.
+func (p *PasswordPolicy) AppliesTo(credential string) bool {
+	return len(p.Credentials) == 0 || slices.Contains(p.Credentials, credential)
+}
+
+// validatePasswordPolicy validates that the limits of the password policy are in range and that the
+// policy only references credential attributes of the compiled schema.
+func validatePasswordPolicy(compiledSchema *model.Schema, policy *PasswordPolicy) *serviceerror.ServiceError {
+	if policy == nil {
+		return nil
+	}
+	if !policy.IsValid() {
+		return &ErrorInvalidPasswordPolicy
+	}
+
+	known := make([]string, 0)
+	for _, attribute := range compiledSchema.GetAttributes(true, false, false) {
+		known = append(known, attribute.Attribute)
+	}
+	for _, credential := range policy.Credentials {
+		if !slices.Contains(known, credential) {
+			return &ErrorInvalidPasswordPolicy
+		}
+	}
+
+	return nil
+}
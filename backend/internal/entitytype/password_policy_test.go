@@ -0,0 +1,70 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package entitytype
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/entitytype/model"
+)
+
+type PasswordPolicyTestSuite struct {
+	suite.Suite
+}
+
+func TestPasswordPolicyTestSuite(t *testing.T) {
+	suite.Run(t, new(PasswordPolicyTestSuite))
+}
+
+func (s *PasswordPolicyTestSuite) TestAppliesTo() {
+	s.True((&PasswordPolicy{}).AppliesTo("pin"))
+
+	policy := &PasswordPolicy{Credentials: []string{"password"}}
+	s.True(policy.AppliesTo("password"))
+	s.False(policy.AppliesTo("pin"))
+}
+
+func (s *PasswordPolicyTestSuite) TestValidatePasswordPolicy() {
+	compiled, err := model.CompileSchema(json.RawMessage(
+		`{"username":{"type":"string"},"password":{"type":"string","credential":true}}`))
+	s.Require().NoError(err)
+
+	s.Nil(validatePasswordPolicy(compiled, nil))
+	s.Nil(validatePasswordPolicy(compiled, &PasswordPolicy{
+		Credentials:         []string{"password"},
+		MinLength:           12,
+		MinCharacterClasses: PasswordCharacterClasses,
+		HistoryCount:        5,
+	}))
+
+	invalid := []*PasswordPolicy{
+		{Credentials: []string{"username"}},
+		{MinLength: -1},
+		{MinCharacterClasses: 5},
+		{HistoryCount: -1},
+	}
+	for _, policy := range invalid {
+		svcErr := validatePasswordPolicy(compiled, policy)
+		s.Require().NotNil(svcErr)
+		s.Equal(ErrorInvalidPasswordPolicy.Code, svcErr.Code)
+	}
+}
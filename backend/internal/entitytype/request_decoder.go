@@ -0,0 +1,107 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package entitytype
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+
+	"github.com/thunder-id/thunderid/internal/entitytype/model"
+	"github.com/thunder-id/thunderid/internal/system/jsonstream"
+)
+
+// decodeEntityTypeRequest decodes a create or update entity type request body as a stream. The schema is
+// decoded and validated one property at a time, so that an invalid property definition is rejected at its
+// location without reading the rest of the body.
+func decodeEntityTypeRequest(body io.Reader, limits jsonstream.Limits) (*CreateEntityTypeRequest, error) {
+	decoder := jsonstream.NewDecoder(body, limits)
+	request := &CreateEntityTypeRequest{}
+
+	err := decoder.DecodeObject(func(name string) error {
+		switch name {
+		case "name":
+			return decoder.Decode(&request.Name)
+		case "ouId":
+			return decoder.Decode(&request.OUID)
+		case "allowSelfRegistration":
+			return decoder.Decode(&request.AllowSelfRegistration)
+		case "systemAttributes":
+			return decoder.Decode(&request.SystemAttributes)
+		case "schema":
+			schema, err := decodeEntityTypeSchema(decoder)
+			if err != nil {
+				return err
+			}
+			request.Schema = schema
+			return nil
+		default:
+			return decoder.Decode(nil)
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := decoder.End(); err != nil {
+		return nil, err
+	}
+
+	return request, nil
+}
+
+// decodeEntityTypeSchema decodes the schema of an entity type request, validating each property
+// definition as it is read and rejecting properties that are defined more than once.
+func decodeEntityTypeSchema(decoder *jsonstream.Decoder) (json.RawMessage, error) {
+	var buf bytes.Buffer
+	seen := make(map[string]struct{})
+
+	buf.WriteByte('{')
+	err := decoder.DecodeObject(func(name string) error {
+		if _, exists := seen[name]; exists {
+			return decoder.Fail(jsonstream.ErrorKindInvalid, "property is defined more than once")
+		}
+		seen[name] = struct{}{}
+
+		var definition json.RawMessage
+		if err := decoder.Decode(&definition); err != nil {
+			return err
+		}
+		if err := model.ValidatePropertyDefinition(name, definition); err != nil {
+			return decoder.Fail(jsonstream.ErrorKindInvalid, err.Error())
+		}
+
+		encodedName, err := json.Marshal(name)
+		if err != nil {
+			return err
+		}
+		if len(seen) > 1 {
+			buf.WriteByte(',')
+		}
+		buf.Write(encodedName)
+		buf.WriteByte(':')
+		buf.Write(definition)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	buf.WriteByte('}')
+
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,126 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package entitytype
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/system/error/apierror"
+	"github.com/thunder-id/thunderid/internal/system/jsonstream"
+)
+
+type RequestDecoderTestSuite struct {
+	suite.Suite
+}
+
+func TestRequestDecoderTestSuite(t *testing.T) {
+	suite.Run(t, new(RequestDecoderTestSuite))
+}
+
+func (s *RequestDecoderTestSuite) TestDecodeEntityTypeRequest_Success() {
+	body := `{"name":"customer","ouId":"ou-1","allowSelfRegistration":true,"extra":[1,2],` +
+		`"systemAttributes":{"display":"email"},` +
+		`"schema":{"email":{"type":"string","unique":true},"age":{"type":"number"}}}`
+
+	request, err := decodeEntityTypeRequest(strings.NewReader(body), jsonstream.Limits{MaxDepth: 3})
+
+	s.Require().NoError(err)
+	s.Equal("customer", request.Name)
+	s.Equal("ou-1", request.OUID)
+	s.True(request.AllowSelfRegistration)
+	s.Require().NotNil(request.SystemAttributes)
+	s.Equal("email", request.SystemAttributes.Display)
+	s.JSONEq(`{"email":{"type":"string","unique":true},"age":{"type":"number"}}`, string(request.Schema))
+}
+
+func (s *RequestDecoderTestSuite) TestDecodeEntityTypeRequest_WithoutSchema() {
+	request, err := decodeEntityTypeRequest(strings.NewReader(`{"name":"customer"}`), jsonstream.Limits{})
+
+	s.Require().NoError(err)
+	s.Nil(request.Schema)
+}
+
+func (s *RequestDecoderTestSuite) TestDecodeEntityTypeRequest_Rejections() {
+	testCases := []struct {
+		name    string
+		body    string
+		limits  jsonstream.Limits
+		kind    jsonstream.ErrorKind
+		pointer string
+	}{
+		{"InvalidProperty", `{"schema":{"email":{"type":"string"},"age":{"type":"integer"}}}`,
+			jsonstream.Limits{}, jsonstream.ErrorKindInvalid, "/schema/age"},
+		{"DuplicateProperty", `{"schema":{"email":{"type":"string"},"email":{"type":"number"}}}`,
+			jsonstream.Limits{}, jsonstream.ErrorKindInvalid, "/schema/email"},
+		{"SchemaNotAnObject", `{"schema":[]}`, jsonstream.Limits{}, jsonstream.ErrorKindSyntax, "/schema"},
+		{"TooManyValues", `{"schema":{"a":{"type":"string"},"b":{"type":"string"},"c":{"type":"string"}}}`,
+			jsonstream.Limits{MaxValues: 6}, jsonstream.ErrorKindLimit, "/schema/c"},
+	}
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			_, err := decodeEntityTypeRequest(strings.NewReader(tc.body), tc.limits)
+
+			var streamErr *jsonstream.Error
+			s.Require().True(errors.As(err, &streamErr), "expected a stream error, got %v", err)
+			s.Equal(tc.kind, streamErr.Kind)
+			s.Equal(tc.pointer, streamErr.Pointer)
+		})
+	}
+}
+
+func (s *RequestDecoderTestSuite) TestHandleDecodeError() {
+	testCases := []struct {
+		name        string
+		err         error
+		status      int
+		code        string
+		description string
+	}{
+		{"Invalid", &jsonstream.Error{Kind: jsonstream.ErrorKindInvalid, Pointer: "/schema/age", Reason: "bad type"},
+			http.StatusBadRequest, ErrorInvalidRequestFormat.Code,
+			"Failed to parse request body: bad type at /schema/age"},
+		{"Limit", &jsonstream.Error{Kind: jsonstream.ErrorKindLimit, Pointer: "/schema", Reason: "too deep"},
+			http.StatusBadRequest, apierror.ErrJSONTooComplex.Code, "too deep at /schema"},
+		{"Size", &jsonstream.Error{Kind: jsonstream.ErrorKindSize, Reason: "too large"},
+			http.StatusRequestEntityTooLarge, apierror.ErrRequestBodyTooLarge.Code,
+			apierror.ErrRequestBodyTooLarge.Description.DefaultValue},
+		{"Other", errors.New("read failed"), http.StatusBadRequest, ErrorInvalidRequestFormat.Code,
+			"Failed to parse request body"},
+	}
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			w := httptest.NewRecorder()
+
+			handleDecodeError(w, tc.err, "error.entitytypeservice.create_schema_request_parse_failed_description")
+
+			s.Equal(tc.status, w.Code)
+			var errResp apierror.ErrorResponse
+			s.Require().NoError(json.Unmarshal(w.Body.Bytes(), &errResp))
+			s.Equal(tc.code, errResp.Code)
+			s.Equal(tc.description, errResp.Description.DefaultValue)
+		})
+	}
+}
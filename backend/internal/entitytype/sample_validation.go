@@ -0,0 +1,301 @@
+/*
+ * Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package entitytype
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/thunder-id/thunderid/internal/entitytype/model"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/log"
+)
+
+// MaxSampleValidationCount is the maximum number of sample entities accepted in a single
+// sample validation request.
+const MaxSampleValidationCount = 50
+
+const (
+	// UniquenessConflictSourceExisting indicates that the value is already used by a stored entity.
+	UniquenessConflictSourceExisting = "EXISTING"
+	// UniquenessConflictSourceSample indicates that the value is repeated by an earlier sample.
+	UniquenessConflictSourceSample = "SAMPLE"
+)
+
+const (
+	// IndexWarningUniqueNotIndexed indicates that a unique attribute is not indexed, so uniqueness
+	// checks fall back to scanning stored attributes.
+	IndexWarningUniqueNotIndexed = "UNIQUE_NOT_INDEXED"
+	// IndexWarningIndexedNotDeclared indicates that an indexed attribute is not declared in the schema,
+	// so entities of this type will never populate the index for it.
+	IndexWarningIndexedNotDeclared = "INDEXED_NOT_DECLARED"
+	// IndexWarningIndexedCredential indicates that an indexed attribute is declared as a credential.
+	// Credentials are stored apart from attributes, so they never populate the index.
+	IndexWarningIndexedCredential = "INDEXED_CREDENTIAL"
+)
+
+// SchemaViolation is an alias for model.Violation, exported at the entitytype package level so
+// callers do not need to import the internal model package directly.
+type SchemaViolation = model.Violation
+
+// AttributeNormalization is an alias for model.Normalization, exported at the entitytype package level
+// so callers do not need to import the internal model package directly.
+type AttributeNormalization = model.Normalization
+
+// ValidateSamples validates sample entities against a draft schema without persisting anything.
+// Each sample is checked against the schema, and values of unique attributes are checked against
+// existing entities through the exists callback and against earlier samples in the request.
+// The draft schema is also compared with the given indexed attributes to report index impact.
+func (us *entityTypeService) ValidateSamples(
+	ctx context.Context,
+	category TypeCategory,
+	request SampleValidationRequest,
+	exists func(map[string]interface{}) (bool, error),
+	indexedAttributes []string,
+) (*SampleValidationResponse, *serviceerror.ServiceError) {
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, entityTypeLoggerComponentName))
+
+	if svcErr := validateCategory(category); svcErr != nil {
+		return nil, svcErr
+	}
+
+	if request.OUID == "" {
+		return nil, invalidEntityTypeRequestErr(category, "organization unit id must not be empty")
+	}
+	if len(request.Schema) == 0 {
+		return nil, invalidEntityTypeRequestErr(category, "schema definition must not be empty")
+	}
+	if len(request.Samples) == 0 {
+		return nil, invalidEntityTypeRequestErr(category, "at least one sample must be provided")
+	}
+	if len(request.Samples) > MaxSampleValidationCount {
+		return nil, invalidEntityTypeRequestErr(category,
+			fmt.Sprintf("at most %d samples can be validated at once", MaxSampleValidationCount))
+	}
+
+	if svcErr := us.checkEntityTypeAccess(
+		ctx, category, createActionForCategory(category), request.OUID); svcErr != nil {
+		return nil, svcErr
+	}
+
+	compiledSchema, err := model.CompileSchema(request.Schema)
+	if err != nil {
+		logger.Debug("Sample validation failed: schema compilation error", log.Error(err))
+		return nil, invalidEntityTypeRequestErr(category, err.Error())
+	}
+
+	uniqueAttributes := compiledSchema.GetUniqueAttributes()
+	sort.Strings(uniqueAttributes)
+	seenValues := make(map[string]map[string]struct{}, len(uniqueAttributes))
+
+	response := &SampleValidationResponse{
+		Valid:   true,
+		Results: make([]SampleValidationResult, 0, len(request.Samples)),
+	}
+	for i, sample := range request.Samples {
+		violations, err := compiledSchema.CollectViolations(sample, logger)
+		if err != nil {
+			return nil, invalidEntityTypeRequestErr(category, fmt.Sprintf("sample %d is not a JSON object", i))
+		}
+
+		var attrs map[string]interface{}
+		if err := json.Unmarshal(sample, &attrs); err != nil {
+			return nil, invalidEntityTypeRequestErr(category, fmt.Sprintf("sample %d is not a JSON object", i))
+		}
+
+		conflicts, err := checkSampleUniqueness(attrs, uniqueAttributes, seenValues, exists)
+		if err != nil {
+			return nil, logAndReturnServerError(logger, "Failed during sample uniqueness check", err)
+		}
+
+		result := SampleValidationResult{
+			Index:               i,
+			Valid:               len(violations) == 0 && len(conflicts) == 0,
+			Violations:          violations,
+			UniquenessConflicts: conflicts,
+		}
+		if !result.Valid {
+			response.Valid = false
+		}
+		response.Results = append(response.Results, result)
+	}
+
+	response.IndexWarnings = buildIndexImpactWarnings(compiledSchema, uniqueAttributes, indexedAttributes)
+
+	logger.Debug("Validated sample entities against draft schema", log.String("category", string(category)),
+		log.Int("sampleCount", len(request.Samples)), log.Bool("valid", response.Valid))
+	return response, nil
+}
+
+// NormalizeSamples applies the normalization rules of a draft schema to sample entities without
+// persisting anything, so that rule authors can preview the normalized values.
+func (us *entityTypeService) NormalizeSamples(
+	ctx context.Context, category TypeCategory, request SampleNormalizationRequest,
+) (*SampleNormalizationResponse, *serviceerror.ServiceError) {
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, entityTypeLoggerComponentName))
+
+	if svcErr := validateCategory(category); svcErr != nil {
+		return nil, svcErr
+	}
+
+	if request.OUID == "" {
+		return nil, invalidEntityTypeRequestErr(category, "organization unit id must not be empty")
+	}
+	if len(request.Schema) == 0 {
+		return nil, invalidEntityTypeRequestErr(category, "schema definition must not be empty")
+	}
+	if len(request.Samples) == 0 {
+		return nil, invalidEntityTypeRequestErr(category, "at least one sample must be provided")
+	}
+	if len(request.Samples) > MaxSampleValidationCount {
+		return nil, invalidEntityTypeRequestErr(category,
+			fmt.Sprintf("at most %d samples can be normalized at once", MaxSampleValidationCount))
+	}
+
+	if svcErr := us.checkEntityTypeAccess(
+		ctx, category, createActionForCategory(category), request.OUID); svcErr != nil {
+		return nil, svcErr
+	}
+
+	compiledSchema, err := model.CompileSchema(request.Schema)
+	if err != nil {
+		logger.Debug("Sample normalization failed: schema compilation error", log.Error(err))
+		return nil, invalidEntityTypeRequestErr(category, err.Error())
+	}
+
+	response := &SampleNormalizationResponse{
+		Results: make([]SampleNormalizationResult, 0, len(request.Samples)),
+	}
+	for i, sample := range request.Samples {
+		var attrs map[string]interface{}
+		if err := json.Unmarshal(sample, &attrs); err != nil || attrs == nil {
+			return nil, invalidEntityTypeRequestErr(category, fmt.Sprintf("sample %d is not a JSON object", i))
+		}
+
+		normalizations := compiledSchema.NormalizeAttributes(attrs)
+		normalized, err := json.Marshal(attrs)
+		if err != nil {
+			return nil, logAndReturnServerError(logger, "Failed to marshal normalized sample", err)
+		}
+
+		response.Results = append(response.Results, SampleNormalizationResult{
+			Index:          i,
+			Attributes:     normalized,
+			Normalizations: normalizations,
+		})
+	}
+
+	logger.Debug("Normalized sample entities with draft schema", log.String("category", string(category)),
+		log.Int("sampleCount", len(request.Samples)))
+	return response, nil
+}
+
+// checkSampleUniqueness reports the unique attributes of a sample whose values are already used by a
+// stored entity or by an earlier sample. seenValues is updated with the values of this sample.
+func checkSampleUniqueness(
+	attrs map[string]interface{},
+	uniqueAttributes []string,
+	seenValues map[string]map[string]struct{},
+	exists func(map[string]interface{}) (bool, error),
+) ([]UniquenessConflict, error) {
+	conflicts := make([]UniquenessConflict, 0)
+	for _, attr := range uniqueAttributes {
+		value, ok := attrs[attr]
+		if !ok || value == nil {
+			continue
+		}
+
+		valueKey := fmt.Sprintf("%v", value)
+		if _, seen := seenValues[attr][valueKey]; seen {
+			conflicts = append(conflicts, UniquenessConflict{Attribute: attr, Source: UniquenessConflictSourceSample})
+			continue
+		}
+		if seenValues[attr] == nil {
+			seenValues[attr] = make(map[string]struct{})
+		}
+		seenValues[attr][valueKey] = struct{}{}
+
+		if exists == nil {
+			continue
+		}
+		found, err := exists(map[string]interface{}{attr: value})
+		if err != nil {
+			return nil, err
+		}
+		if found {
+			conflicts = append(conflicts, UniquenessConflict{Attribute: attr, Source: UniquenessConflictSourceExisting})
+		}
+	}
+
+	return conflicts, nil
+}
+
+// buildIndexImpactWarnings compares the draft schema with the configured indexed attributes.
+func buildIndexImpactWarnings(
+	compiledSchema *model.Schema, uniqueAttributes []string, indexedAttributes []string,
+) []IndexImpactWarning {
+	warnings := make([]IndexImpactWarning, 0)
+
+	indexed := make(map[string]bool, len(indexedAttributes))
+	for _, attr := range indexedAttributes {
+		indexed[attr] = true
+	}
+
+	for _, attr := range uniqueAttributes {
+		if !indexed[attr] {
+			warnings = append(warnings, IndexImpactWarning{
+				Attribute: attr,
+				Code:      IndexWarningUniqueNotIndexed,
+				Message:   "Unique attribute is not indexed; uniqueness checks will scan stored attributes",
+			})
+		}
+	}
+
+	credentials := make(map[string]bool)
+	declared := make(map[string]bool)
+	for _, info := range compiledSchema.GetAttributes(true, true, false) {
+		declared[info.Attribute] = true
+		if info.Credential {
+			credentials[info.Attribute] = true
+		}
+	}
+
+	sortedIndexed := append([]string(nil), indexedAttributes...)
+	sort.Strings(sortedIndexed)
+	for _, attr := range sortedIndexed {
+		switch {
+		case credentials[attr]:
+			warnings = append(warnings, IndexImpactWarning{
+				Attribute: attr,
+				Code:      IndexWarningIndexedCredential,
+				Message:   "Indexed attribute is declared as a credential and will never be populated",
+			})
+		case !declared[attr]:
+			warnings = append(warnings, IndexImpactWarning{
+				Attribute: attr,
+				Code:      IndexWarningIndexedNotDeclared,
+				Message:   "Indexed attribute is not declared in the schema",
+			})
+		}
+	}
+
+	return warnings
+}
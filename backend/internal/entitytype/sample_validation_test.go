@@ -0,0 +1,238 @@
+/*
+ * Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package entitytype
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/tests/mocks/sysauthzmock"
+)
+
+const sampleTestSchema = `{
+	"email": {"type": "string", "required": true, "unique": true},
+	"age": {"type": "number"},
+	"password": {"type": "string", "credential": true}
+}`
+
+func newSampleValidationRequest(samples ...string) SampleValidationRequest {
+	request := SampleValidationRequest{
+		OUID:   testOUID1,
+		Schema: json.RawMessage(sampleTestSchema),
+	}
+	for _, sample := range samples {
+		request.Samples = append(request.Samples, json.RawMessage(sample))
+	}
+	return request
+}
+
+func TestValidateSamplesReportsPerSampleResults(t *testing.T) {
+	service := &entityTypeService{authzService: newAllowAllAuthz(t)}
+	request := newSampleValidationRequest(
+		`{"email":"a@example.com","age":30}`,
+		`{"age":"thirty","nickname":"x"}`,
+	)
+
+	response, svcErr := service.ValidateSamples(context.Background(), TypeCategoryUser, request,
+		func(map[string]interface{}) (bool, error) { return false, nil }, []string{"email"})
+
+	require.Nil(t, svcErr)
+	require.False(t, response.Valid)
+	require.Len(t, response.Results, 2)
+
+	require.True(t, response.Results[0].Valid)
+	require.Empty(t, response.Results[0].Violations)
+
+	require.False(t, response.Results[1].Valid)
+	require.Equal(t, 1, response.Results[1].Index)
+	require.Equal(t, []SchemaViolation{
+		{Attribute: "age", Reason: "INVALID_VALUE"},
+		{Attribute: "email", Reason: "REQUIRED"},
+		{Attribute: "nickname", Reason: "UNDECLARED"},
+	}, response.Results[1].Violations)
+	require.Empty(t, response.IndexWarnings)
+}
+
+func TestValidateSamplesReportsUniquenessConflicts(t *testing.T) {
+	service := &entityTypeService{authzService: newAllowAllAuthz(t)}
+	request := newSampleValidationRequest(
+		`{"email":"taken@example.com"}`,
+		`{"email":"new@example.com"}`,
+		`{"email":"new@example.com"}`,
+	)
+
+	response, svcErr := service.ValidateSamples(context.Background(), TypeCategoryUser, request,
+		func(filters map[string]interface{}) (bool, error) {
+			return filters["email"] == "taken@example.com", nil
+		}, []string{"email"})
+
+	require.Nil(t, svcErr)
+	require.False(t, response.Valid)
+	require.Equal(t, []UniquenessConflict{
+		{Attribute: "email", Source: UniquenessConflictSourceExisting},
+	}, response.Results[0].UniquenessConflicts)
+	require.Empty(t, response.Results[1].UniquenessConflicts)
+	require.Equal(t, []UniquenessConflict{
+		{Attribute: "email", Source: UniquenessConflictSourceSample},
+	}, response.Results[2].UniquenessConflicts)
+}
+
+func TestValidateSamplesReturnsServerErrorWhenExistsCheckFails(t *testing.T) {
+	service := &entityTypeService{authzService: newAllowAllAuthz(t)}
+	request := newSampleValidationRequest(`{"email":"a@example.com"}`)
+
+	response, svcErr := service.ValidateSamples(context.Background(), TypeCategoryUser, request,
+		func(map[string]interface{}) (bool, error) { return false, errors.New("db down") }, nil)
+
+	require.Nil(t, response)
+	require.Equal(t, serviceerror.InternalServerError.Code, svcErr.Code)
+}
+
+func TestValidateSamplesReportsIndexImpactWarnings(t *testing.T) {
+	service := &entityTypeService{authzService: newAllowAllAuthz(t)}
+	request := newSampleValidationRequest(`{"email":"a@example.com"}`)
+
+	response, svcErr := service.ValidateSamples(context.Background(), TypeCategoryUser, request,
+		nil, []string{"username", "password"})
+
+	require.Nil(t, svcErr)
+	require.Equal(t, []IndexImpactWarning{
+		{Attribute: "email", Code: IndexWarningUniqueNotIndexed,
+			Message: "Unique attribute is not indexed; uniqueness checks will scan stored attributes"},
+		{Attribute: "password", Code: IndexWarningIndexedCredential,
+			Message: "Indexed attribute is declared as a credential and will never be populated"},
+		{Attribute: "username", Code: IndexWarningIndexedNotDeclared,
+			Message: "Indexed attribute is not declared in the schema"},
+	}, response.IndexWarnings)
+}
+
+func TestValidateSamplesRejectsInvalidRequests(t *testing.T) {
+	tooMany := newSampleValidationRequest()
+	for i := 0; i <= MaxSampleValidationCount; i++ {
+		tooMany.Samples = append(tooMany.Samples, json.RawMessage(`{}`))
+	}
+	invalidSchema := newSampleValidationRequest(`{}`)
+	invalidSchema.Schema = json.RawMessage(`{"email":{"type":"unknown"}}`)
+	missingOU := newSampleValidationRequest(`{}`)
+	missingOU.OUID = ""
+
+	testCases := []struct {
+		name    string
+		request SampleValidationRequest
+		detail  string
+	}{
+		{"MissingOU", missingOU, "organization unit id must not be empty"},
+		{"NoSamples", newSampleValidationRequest(), "at least one sample must be provided"},
+		{"TooManySamples", tooMany, "at most 50 samples can be validated at once"},
+		{"InvalidSchema", invalidSchema, ""},
+		{"NonObjectSample", newSampleValidationRequest(`[1,2]`), "sample 0 is not a JSON object"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			service := &entityTypeService{authzService: newAllowAllAuthz(t)}
+
+			response, svcErr := service.ValidateSamples(context.Background(), TypeCategoryUser, tc.request,
+				nil, nil)
+
+			require.Nil(t, response)
+			require.NotNil(t, svcErr)
+			require.Equal(t, ErrorInvalidUserTypeRequest.Code, svcErr.Code)
+			require.Contains(t, svcErr.ErrorDescription.DefaultValue, tc.detail)
+		})
+	}
+}
+
+func TestValidateSamplesRequiresCreatePermission(t *testing.T) {
+	authzMock := sysauthzmock.NewSystemAuthorizationServiceInterfaceMock(t)
+	authzMock.On("IsActionAllowed", mock.Anything, createActionForCategory(TypeCategoryUser), mock.Anything).
+		Return(false, nil).Once()
+	service := &entityTypeService{authzService: authzMock}
+
+	response, svcErr := service.ValidateSamples(context.Background(), TypeCategoryUser,
+		newSampleValidationRequest(`{}`), nil, nil)
+
+	require.Nil(t, response)
+	require.Equal(t, serviceerror.ErrorUnauthorized.Code, svcErr.Code)
+}
+
+func TestNormalizeSamplesReportsNormalizedValues(t *testing.T) {
+	service := &entityTypeService{authzService: newAllowAllAuthz(t)}
+	request := SampleNormalizationRequest{
+		OUID: testOUID1,
+		Schema: json.RawMessage(`{
+			"email": {"type": "string", "normalize": [{"type": "trim"}, {"type": "lowercase"}]},
+			"mobile": {"type": "string", "normalize": [{"type": "e164", "defaultCountryCode": "1"}]}
+		}`),
+		Samples: []json.RawMessage{
+			json.RawMessage(`{"email":" A@Example.com","mobile":"(415) 555-0123"}`),
+			json.RawMessage(`{"email":"b@example.com"}`),
+		},
+	}
+
+	response, svcErr := service.NormalizeSamples(context.Background(), TypeCategoryUser, request)
+
+	require.Nil(t, svcErr)
+	require.Len(t, response.Results, 2)
+	require.JSONEq(t, `{"email":"a@example.com","mobile":"+14155550123"}`, string(response.Results[0].Attributes))
+	require.Equal(t, []AttributeNormalization{
+		{Attribute: "email", Original: " A@Example.com", Normalized: "a@example.com"},
+		{Attribute: "mobile", Original: "(415) 555-0123", Normalized: "+14155550123"},
+	}, response.Results[0].Normalizations)
+	require.Equal(t, 1, response.Results[1].Index)
+	require.Empty(t, response.Results[1].Normalizations)
+}
+
+func TestNormalizeSamplesRejectsInvalidRequests(t *testing.T) {
+	validSchema := json.RawMessage(`{"email":{"type":"string","normalize":[{"type":"trim"}]}}`)
+	testCases := []struct {
+		name    string
+		request SampleNormalizationRequest
+		detail  string
+	}{
+		{"MissingOU", SampleNormalizationRequest{Schema: validSchema,
+			Samples: []json.RawMessage{json.RawMessage(`{}`)}}, "organization unit id must not be empty"},
+		{"NoSamples", SampleNormalizationRequest{OUID: testOUID1, Schema: validSchema},
+			"at least one sample must be provided"},
+		{"InvalidRule", SampleNormalizationRequest{OUID: testOUID1,
+			Schema:  json.RawMessage(`{"email":{"type":"string","normalize":[{"type":"unknown"}]}}`),
+			Samples: []json.RawMessage{json.RawMessage(`{}`)}}, "invalid type 'unknown'"},
+		{"NonObjectSample", SampleNormalizationRequest{OUID: testOUID1, Schema: validSchema,
+			Samples: []json.RawMessage{json.RawMessage(`"a"`)}}, "sample 0 is not a JSON object"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			service := &entityTypeService{authzService: newAllowAllAuthz(t)}
+
+			response, svcErr := service.NormalizeSamples(context.Background(), TypeCategoryUser, tc.request)
+
+			require.Nil(t, response)
+			require.NotNil(t, svcErr)
+			require.Equal(t, ErrorInvalidUserTypeRequest.Code, svcErr.Code)
+			require.Contains(t, svcErr.ErrorDescription.DefaultValue, tc.detail)
+		})
+	}
+}
@@ -24,6 +24,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"slices"
 
 	"github.com/thunder-id/thunderid/internal/consent"
 	"github.com/thunder-id/thunderid/internal/entitytype/model"
@@ -44,6 +45,27 @@ const entityTypeLoggerComponentName = "EntityTypeService"
 // level so callers do not need to import the internal model package directly.
 type AttributeInfo = model.AttributeInfo
 
+// ValueConstraints is an alias for model.ValueConstraints, exported for the same reason as AttributeInfo.
+type ValueConstraints = model.ValueConstraints
+
+// FormField is an alias for model.FormField, exported for the same reason as AttributeInfo.
+type FormField = model.FormField
+
+// UniquenessScope is an alias for model.UniquenessScope, exported for the same reason as AttributeInfo.
+type UniquenessScope = model.UniquenessScope
+
+// ExistsFunc is an alias for model.ExistsFunc, exported for the same reason as AttributeInfo.
+type ExistsFunc = model.ExistsFunc
+
+const (
+	// UniquenessScopeGlobal requires the value to be unique across all entities.
+	UniquenessScopeGlobal = model.UniquenessScopeGlobal
+	// UniquenessScopeOU requires the value to be unique among the entities of the same organization unit.
+	UniquenessScopeOU = model.UniquenessScopeOU
+	// UniquenessScopeType requires the value to be unique among the entities of the same entity type.
+	UniquenessScopeType = model.UniquenessScopeType
+)
+
 // EntityTypeServiceInterface defines the interface for the entity type service.
 // All methods take a TypeCategory to scope the operation to a specific entity kind
 // (user or agent).
@@ -67,12 +89,18 @@ type EntityTypeServiceInterface interface {
 		ctx context.Context, category TypeCategory, entityType string, attributes json.RawMessage,
 		skipCredentialRequired bool,
 	) (bool, *serviceerror.ServiceError)
+	NormalizeEntity(
+		ctx context.Context, category TypeCategory, entityType string, attributes json.RawMessage,
+	) (json.RawMessage, *serviceerror.ServiceError)
+	RetainContactVerification(
+		ctx context.Context, category TypeCategory, entityType string, previous, next json.RawMessage,
+	) (json.RawMessage, *serviceerror.ServiceError)
 	ValidateEntityUniqueness(
 		ctx context.Context,
 		category TypeCategory,
 		entityType string,
 		attributes json.RawMessage,
-		exists func(map[string]interface{}) (bool, error),
+		exists ExistsFunc,
 	) (bool, *serviceerror.ServiceError)
 	GetAttributes(
 		ctx context.Context, category TypeCategory, entityType string,
@@ -81,9 +109,32 @@ type EntityTypeServiceInterface interface {
 	GetUniqueAttributes(
 		ctx context.Context, category TypeCategory, entityType string,
 	) ([]string, *serviceerror.ServiceError)
+	GetSensitiveAttributes(
+		ctx context.Context, category TypeCategory, entityType string,
+	) ([]string, *serviceerror.ServiceError)
+	GetCredentialPolicy(
+		ctx context.Context, category TypeCategory, entityType string,
+	) (*CredentialPolicy, *serviceerror.ServiceError)
+	GetPasswordPolicy(
+		ctx context.Context, category TypeCategory, entityType string,
+	) (*PasswordPolicy, *serviceerror.ServiceError)
 	GetDisplayAttributesByNames(
 		ctx context.Context, category TypeCategory, names []string,
 	) (map[string]string, *serviceerror.ServiceError)
+	GetFormMetadata(
+		ctx context.Context, category TypeCategory, schemaID string,
+	) (*FormMetadata, *serviceerror.ServiceError)
+	ValidateSamples(
+		ctx context.Context,
+		category TypeCategory,
+		request SampleValidationRequest,
+		exists func(map[string]interface{}) (bool, error),
+		indexedAttributes []string,
+	) (*SampleValidationResponse, *serviceerror.ServiceError)
+	NormalizeSamples(
+		ctx context.Context, category TypeCategory, request SampleNormalizationRequest,
+	) (*SampleNormalizationResponse, *serviceerror.ServiceError)
+	RegisterDuplicateValueCounter(counter DuplicateValueCounter)
 }
 
 // entityTypeService is the default implementation of the EntityTypeServiceInterface.
@@ -93,6 +144,9 @@ type entityTypeService struct {
 	transactioner   transaction.Transactioner
 	authzService    sysauthz.SystemAuthorizationServiceInterface
 	consentService  consent.ConsentServiceInterface
+	// duplicateCounter checks existing entities when a schema update tightens a uniqueness scope. It is
+	// registered by the entity service, which depends on this service.
+	duplicateCounter DuplicateValueCounter
 }
 
 // newEntityTypeService creates a new instance of entityTypeService.
@@ -112,6 +166,12 @@ func newEntityTypeService(
 	}
 }
 
+// RegisterDuplicateValueCounter registers the counter used to check the existing entities of a type when a
+// schema update tightens the uniqueness scope of an attribute.
+func (us *entityTypeService) RegisterDuplicateValueCounter(counter DuplicateValueCounter) {
+	us.duplicateCounter = counter
+}
+
 // GetEntityTypeList lists entity types for the given category with pagination.
 func (us *entityTypeService) GetEntityTypeList(ctx context.Context, category TypeCategory,
 	limit, offset int, includeDisplay bool) (
@@ -434,6 +494,11 @@ func (us *entityTypeService) UpdateEntityType(ctx context.Context, category Type
 		}
 	}
 
+	if svcErr := us.checkUniquenessScopeChanges(ctx, category, existingSchema, request.Schema,
+		logger); svcErr != nil {
+		return nil, svcErr
+	}
+
 	entityType := EntityType{
 		ID:                    schemaID,
 		Category:              category,
@@ -570,13 +635,85 @@ func (us *entityTypeService) ValidateEntity(
 	return true, nil
 }
 
+// NormalizeEntity applies the normalization rules of the schema for the given category and entity type
+// to the entity attributes. It is called before validation so that validation and uniqueness checks see
+// the normalized values.
+func (us *entityTypeService) NormalizeEntity(
+	ctx context.Context, category TypeCategory, entityType string, attributes json.RawMessage,
+) (json.RawMessage, *serviceerror.ServiceError) {
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, entityTypeLoggerComponentName))
+
+	if svcErr := validateCategory(category); svcErr != nil {
+		return nil, svcErr
+	}
+
+	compiledSchema, err := us.getCompiledSchemaForEntityType(ctx, category, entityType, logger)
+	if err != nil {
+		if errors.Is(err, ErrEntityTypeNotFound) {
+			return nil, entityTypeNotFoundErr(category)
+		}
+		return nil, logAndReturnServerError(logger, "Failed to load entity type", err)
+	}
+
+	normalized, err := compiledSchema.Normalize(attributes)
+	if err != nil {
+		return nil, logAndReturnServerError(logger, "Failed to normalize entity attributes", err)
+	}
+
+	return normalized, nil
+}
+
+// RetainContactVerification resets the verification state of the values of the multi-valued contact
+// attributes in next to the state stored for the same values in previous, so that the holder of the entity
+// cannot mark a new or changed value as verified. The attributes are returned as is when no value changes.
+func (us *entityTypeService) RetainContactVerification(
+	ctx context.Context, category TypeCategory, entityType string, previous, next json.RawMessage,
+) (json.RawMessage, *serviceerror.ServiceError) {
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, entityTypeLoggerComponentName))
+
+	if svcErr := validateCategory(category); svcErr != nil {
+		return nil, svcErr
+	}
+	if len(next) == 0 {
+		return next, nil
+	}
+
+	compiledSchema, err := us.getCompiledSchemaForEntityType(ctx, category, entityType, logger)
+	if err != nil {
+		if errors.Is(err, ErrEntityTypeNotFound) {
+			return nil, entityTypeNotFoundErr(category)
+		}
+		return nil, logAndReturnServerError(logger, "Failed to load entity type", err)
+	}
+
+	previousAttrs := map[string]interface{}{}
+	if len(previous) > 0 {
+		if err := json.Unmarshal(previous, &previousAttrs); err != nil {
+			return nil, logAndReturnServerError(logger, "Failed to unmarshal stored entity attributes", err)
+		}
+	}
+	var nextAttrs map[string]interface{}
+	if err := json.Unmarshal(next, &nextAttrs); err != nil {
+		return nil, logAndReturnServerError(logger, "Failed to unmarshal entity attributes", err)
+	}
+
+	if !compiledSchema.RetainContactVerification(previousAttrs, nextAttrs) {
+		return next, nil
+	}
+	retained, err := json.Marshal(nextAttrs)
+	if err != nil {
+		return nil, logAndReturnServerError(logger, "Failed to marshal entity attributes", err)
+	}
+	return retained, nil
+}
+
 // ValidateEntityUniqueness validates the uniqueness constraints of entity attributes.
 func (us *entityTypeService) ValidateEntityUniqueness(
 	ctx context.Context,
 	category TypeCategory,
 	entityType string,
 	attributes json.RawMessage,
-	exists func(map[string]interface{}) (bool, error),
+	exists ExistsFunc,
 ) (bool, *serviceerror.ServiceError) {
 	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, entityTypeLoggerComponentName))
 
@@ -659,6 +796,116 @@ func (us *entityTypeService) GetUniqueAttributes(
 	return compiledSchema.GetUniqueAttributes(), nil
 }
 
+// GetSensitiveAttributes returns the dot-notation paths of schema properties marked as sensitive for a
+// given entity type.
+func (us *entityTypeService) GetSensitiveAttributes(
+	ctx context.Context, category TypeCategory, entityType string,
+) ([]string, *serviceerror.ServiceError) {
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, entityTypeLoggerComponentName))
+
+	if svcErr := validateCategory(category); svcErr != nil {
+		return nil, svcErr
+	}
+
+	compiledSchema, err := us.getCompiledSchemaForEntityType(ctx, category, entityType, logger)
+	if err != nil {
+		if errors.Is(err, ErrEntityTypeNotFound) {
+			return nil, entityTypeNotFoundErr(category)
+		}
+		return nil, logAndReturnServerError(logger, "Failed to load entity type for sensitive attributes", err)
+	}
+
+	return compiledSchema.GetSensitiveAttributes(), nil
+}
+
+// GetFormMetadata returns the metadata UIs need to render create and edit forms for entities of the
+// given entity type.
+func (us *entityTypeService) GetFormMetadata(
+	ctx context.Context, category TypeCategory, schemaID string,
+) (*FormMetadata, *serviceerror.ServiceError) {
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, entityTypeLoggerComponentName))
+
+	entityType, svcErr := us.GetEntityType(ctx, category, schemaID, true)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+
+	fields, err := model.BuildFormFields(entityType.Schema)
+	if err != nil {
+		return nil, logAndReturnServerError(logger, "Failed to build form fields of entity type", err)
+	}
+
+	metadata := &FormMetadata{
+		TypeID:                entityType.ID,
+		TypeName:              entityType.Name,
+		Category:              category,
+		OUID:                  entityType.OUID,
+		OUHandle:              entityType.OUHandle,
+		AllowSelfRegistration: entityType.AllowSelfRegistration,
+		Fields:                fields,
+	}
+	if entityType.SystemAttributes != nil {
+		metadata.DisplayAttribute = entityType.SystemAttributes.Display
+	}
+	return metadata, nil
+}
+
+// GetCredentialPolicy returns the credential policy of a given entity type, or nil when the entity type
+// does not define one.
+func (us *entityTypeService) GetCredentialPolicy(
+	ctx context.Context, category TypeCategory, entityType string,
+) (*CredentialPolicy, *serviceerror.ServiceError) {
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, entityTypeLoggerComponentName))
+
+	if svcErr := validateCategory(category); svcErr != nil {
+		return nil, svcErr
+	}
+	if entityType == "" {
+		return nil, entityTypeNotFoundErr(category)
+	}
+
+	found, err := us.entityTypeStore.GetEntityTypeByName(ctx, category, entityType)
+	if err != nil {
+		if errors.Is(err, ErrEntityTypeNotFound) {
+			return nil, entityTypeNotFoundErr(category)
+		}
+		return nil, logAndReturnServerError(logger, "Failed to load entity type for credential policy", err)
+	}
+	if found.SystemAttributes == nil {
+		return nil, nil
+	}
+
+	return found.SystemAttributes.CredentialPolicy, nil
+}
+
+// GetPasswordPolicy returns the password policy of a given entity type, or nil when the entity type does
+// not define one.
+func (us *entityTypeService) GetPasswordPolicy(
+	ctx context.Context, category TypeCategory, entityType string,
+) (*PasswordPolicy, *serviceerror.ServiceError) {
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, entityTypeLoggerComponentName))
+
+	if svcErr := validateCategory(category); svcErr != nil {
+		return nil, svcErr
+	}
+	if entityType == "" {
+		return nil, entityTypeNotFoundErr(category)
+	}
+
+	found, err := us.entityTypeStore.GetEntityTypeByName(ctx, category, entityType)
+	if err != nil {
+		if errors.Is(err, ErrEntityTypeNotFound) {
+			return nil, entityTypeNotFoundErr(category)
+		}
+		return nil, logAndReturnServerError(logger, "Failed to load entity type for password policy", err)
+	}
+	if found.SystemAttributes == nil {
+		return nil, nil
+	}
+
+	return found.SystemAttributes.PasswordPolicy, nil
+}
+
 // GetDisplayAttributesByNames returns display attributes for multiple entity types by name within a category.
 func (us *entityTypeService) GetDisplayAttributesByNames(
 	ctx context.Context, category TypeCategory, names []string,
@@ -681,6 +928,52 @@ func (us *entityTypeService) GetDisplayAttributesByNames(
 	return result, nil
 }
 
+// checkUniquenessScopeChanges rejects a schema update that tightens the uniqueness scope of an attribute, such
+// as making it unique or widening its scope from the organization unit to the whole type, while existing
+// entities of the type already share values of the attribute within the new scope.
+func (us *entityTypeService) checkUniquenessScopeChanges(ctx context.Context, category TypeCategory,
+	existing EntityType, updatedSchema json.RawMessage, logger *log.Logger) *serviceerror.ServiceError {
+	if us.duplicateCounter == nil {
+		return nil
+	}
+
+	previousSchema, err := model.CompileSchema(existing.Schema)
+	if err != nil {
+		return logAndReturnServerError(logger, "Failed to compile existing entity type schema", err)
+	}
+	nextSchema, err := model.CompileSchema(updatedSchema)
+	if err != nil {
+		return logAndReturnServerError(logger, "Failed to compile updated entity type schema", err)
+	}
+	previousScopes := previousSchema.GetUniquenessScopes()
+	nextScopes := nextSchema.GetUniquenessScopes()
+
+	attributes := make([]string, 0, len(nextScopes))
+	for attribute := range nextScopes {
+		attributes = append(attributes, attribute)
+	}
+	slices.Sort(attributes)
+
+	for _, attribute := range attributes {
+		scope := nextScopes[attribute]
+		if !model.IsTighterUniquenessScope(previousScopes[attribute], scope) {
+			continue
+		}
+		count, err := us.duplicateCounter.CountDuplicateValues(ctx, category, existing.Name, attribute,
+			scope == model.UniquenessScopeOU)
+		if err != nil {
+			return logAndReturnServerError(logger, "Failed to count duplicate attribute values", err)
+		}
+		if count > 0 {
+			logger.Debug("Existing entities conflict with the tightened uniqueness scope",
+				log.String("attribute", attribute), log.String("scope", string(scope)),
+				log.Int("duplicateValues", count))
+			return uniquenessScopeConflictErr(attribute)
+		}
+	}
+	return nil
+}
+
 func (us *entityTypeService) getCompiledSchemaForEntityType(
 	ctx context.Context,
 	category TypeCategory,
@@ -964,7 +1257,13 @@ func validateSystemAttributes(
 		return nil
 	}
 
-	return validateDisplayAttribute(compiledSchema, systemAttrs.Display)
+	if svcErr := validateDisplayAttribute(compiledSchema, systemAttrs.Display); svcErr != nil {
+		return svcErr
+	}
+	if svcErr := validateCredentialPolicy(compiledSchema, systemAttrs.CredentialPolicy); svcErr != nil {
+		return svcErr
+	}
+	return validatePasswordPolicy(compiledSchema, systemAttrs.PasswordPolicy)
 }
 
 // validateDisplayAttribute validates that the display attribute, if provided,
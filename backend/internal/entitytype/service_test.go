@@ -32,6 +32,7 @@ import (
 	"github.com/thunder-id/thunderid/internal/entitytype/model"
 	"github.com/thunder-id/thunderid/internal/system/config"
 	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/log"
 	"github.com/thunder-id/thunderid/internal/system/sysauthz"
 	"github.com/thunder-id/thunderid/tests/mocks/consentmock"
 	"github.com/thunder-id/thunderid/tests/mocks/oumock"
@@ -274,6 +275,95 @@ func TestGetEntityTypeByNameRequiresName(t *testing.T) {
 	require.Equal(t, ErrorInvalidEntityTypeRequest.Code, svcErr.Code)
 }
 
+func TestGetFormMetadataReturnsFields(t *testing.T) {
+	storeMock := newEntityTypeStoreInterfaceMock(t)
+	storeMock.
+		On("GetEntityTypeByID", context.Background(), TypeCategoryUser, "schema-id").
+		Return(EntityType{
+			ID:                    "schema-id",
+			Name:                  "employee",
+			OUID:                  testOUID1,
+			AllowSelfRegistration: true,
+			SystemAttributes:      &SystemAttributes{Display: "email"},
+			Schema: json.RawMessage(
+				`{"email":{"type":"string","required":true},"password":{"type":"string","credential":true}}`),
+		}, nil).
+		Once()
+	ouServiceMock := oumock.NewOrganizationUnitServiceInterfaceMock(t)
+	ouServiceMock.
+		On("GetOrganizationUnitHandlesByIDs", context.Background(), []string{testOUID1}).
+		Return(map[string]string{testOUID1: "root"}, nil).
+		Once()
+
+	service := &entityTypeService{
+		entityTypeStore: storeMock,
+		ouService:       ouServiceMock,
+		transactioner:   &mockTransactioner{},
+	}
+
+	metadata, svcErr := service.GetFormMetadata(context.Background(), TypeCategoryUser, "schema-id")
+
+	require.Nil(t, svcErr)
+	require.NotNil(t, metadata)
+	require.Equal(t, "schema-id", metadata.TypeID)
+	require.Equal(t, "employee", metadata.TypeName)
+	require.Equal(t, TypeCategoryUser, metadata.Category)
+	require.Equal(t, "root", metadata.OUHandle)
+	require.True(t, metadata.AllowSelfRegistration)
+	require.Equal(t, "email", metadata.DisplayAttribute)
+	require.Len(t, metadata.Fields, 2)
+	require.Equal(t, "email", metadata.Fields[0].Name)
+	require.True(t, metadata.Fields[0].Required)
+	require.Equal(t, "password", metadata.Fields[1].Name)
+	require.Equal(t, model.WidgetPassword, metadata.Fields[1].Widget)
+	require.True(t, metadata.Fields[1].ReadOnlyOnEdit)
+}
+
+func TestGetFormMetadataReturnsNotFound(t *testing.T) {
+	storeMock := newEntityTypeStoreInterfaceMock(t)
+	storeMock.
+		On("GetEntityTypeByID", context.Background(), TypeCategoryUser, "schema-id").
+		Return(EntityType{}, ErrEntityTypeNotFound).
+		Once()
+
+	service := &entityTypeService{
+		entityTypeStore: storeMock,
+		transactioner:   &mockTransactioner{},
+	}
+
+	metadata, svcErr := service.GetFormMetadata(context.Background(), TypeCategoryUser, "schema-id")
+
+	require.Nil(t, metadata)
+	require.NotNil(t, svcErr)
+	require.Equal(t, ErrorEntityTypeNotFound.Code, svcErr.Code)
+}
+
+func TestGetFormMetadataReturnsInternalErrorForInvalidSchema(t *testing.T) {
+	storeMock := newEntityTypeStoreInterfaceMock(t)
+	storeMock.
+		On("GetEntityTypeByID", context.Background(), TypeCategoryUser, "schema-id").
+		Return(EntityType{ID: "schema-id", OUID: testOUID1, Schema: json.RawMessage(`{"email":{"type":"bad"}}`)},
+			nil).
+		Once()
+	ouServiceMock := oumock.NewOrganizationUnitServiceInterfaceMock(t)
+	ouServiceMock.
+		On("GetOrganizationUnitHandlesByIDs", context.Background(), []string{testOUID1}).
+		Return(map[string]string{testOUID1: "root"}, nil).
+		Once()
+
+	service := &entityTypeService{
+		entityTypeStore: storeMock,
+		ouService:       ouServiceMock,
+		transactioner:   &mockTransactioner{},
+	}
+
+	metadata, svcErr := service.GetFormMetadata(context.Background(), TypeCategoryUser, "schema-id")
+
+	require.Nil(t, metadata)
+	require.NotNil(t, svcErr)
+	require.Equal(t, serviceerror.InternalServerError, *svcErr)
+}
+
 func TestValidateEntityReturnsTrueWhenValidationPasses(t *testing.T) {
 	storeMock := newEntityTypeStoreInterfaceMock(t)
 	storeMock.
@@ -320,6 +410,78 @@ func TestValidateEntityReturnsInternalErrorWhenSchemaLoadFails(t *testing.T) {
 	require.Equal(t, serviceerror.InternalServerError, *svcErr)
 }
 
+func TestNormalizeEntityAppliesSchemaRules(t *testing.T) {
+	storeMock := newEntityTypeStoreInterfaceMock(t)
+	storeMock.
+		On("GetEntityTypeByName", context.Background(), TypeCategoryUser, "employee").
+		Return(EntityType{
+			Name: "employee",
+			Schema: json.RawMessage(`{"email":{"type":"string","unique":true,` +
+				`"normalize":[{"type":"trim"},{"type":"lowercase"}]}}`),
+		}, nil).
+		Once()
+
+	service := &entityTypeService{
+		entityTypeStore: storeMock,
+		transactioner:   &mockTransactioner{},
+	}
+
+	normalized, svcErr := service.NormalizeEntity(
+		context.Background(), TypeCategoryUser, "employee", json.RawMessage(`{"email":" Employee@Example.com"}`))
+
+	require.Nil(t, svcErr)
+	require.JSONEq(t, `{"email":"employee@example.com"}`, string(normalized))
+}
+
+func TestRetainContactVerificationResetsUnverifiedValues(t *testing.T) {
+	storeMock := newEntityTypeStoreInterfaceMock(t)
+	storeMock.
+		On("GetEntityTypeByName", context.Background(), TypeCategoryUser, "employee").
+		Return(EntityType{
+			Name: "employee",
+			Schema: json.RawMessage(`{"emails":{"type":"array","contact":"email","items":{"type":"string"},` +
+				`"primaryAttribute":"email","primaryVerifiedAttribute":"emailVerified"},` +
+				`"email":{"type":"string"},"emailVerified":{"type":"boolean"}}`),
+		}, nil).
+		Once()
+
+	service := &entityTypeService{
+		entityTypeStore: storeMock,
+		transactioner:   &mockTransactioner{},
+	}
+
+	retained, svcErr := service.RetainContactVerification(context.Background(), TypeCategoryUser, "employee",
+		json.RawMessage(`{"emails":[{"value":"jo@example.com","verified":true}]}`),
+		json.RawMessage(`{"emails":[{"value":"jo@example.com","verified":true},`+
+			`{"value":"jo@work.example.com","primary":true,"verified":true}],`+
+			`"email":"jo@work.example.com","emailVerified":true}`))
+
+	require.Nil(t, svcErr)
+	require.JSONEq(t, `{"emails":[{"value":"jo@example.com","verified":true},`+
+		`{"value":"jo@work.example.com","primary":true}],"email":"jo@work.example.com","emailVerified":false}`,
+		string(retained))
+}
+
+func TestNormalizeEntityReturnsNotFoundForUnknownType(t *testing.T) {
+	storeMock := newEntityTypeStoreInterfaceMock(t)
+	storeMock.
+		On("GetEntityTypeByName", context.Background(), TypeCategoryUser, "unknown").
+		Return(EntityType{}, ErrEntityTypeNotFound).
+		Once()
+
+	service := &entityTypeService{
+		entityTypeStore: storeMock,
+		transactioner:   &mockTransactioner{},
+	}
+
+	normalized, svcErr := service.NormalizeEntity(
+		context.Background(), TypeCategoryUser, "unknown", json.RawMessage(`{}`))
+
+	require.Nil(t, normalized)
+	require.NotNil(t, svcErr)
+	require.Equal(t, ErrorUserTypeNotFound.Code, svcErr.Code)
+}
+
 func TestValidateEntityUniquenessReturnsTrueWhenNoConflicts(t *testing.T) {
 	storeMock := newEntityTypeStoreInterfaceMock(t)
 	storeMock.
@@ -339,7 +501,7 @@ func TestValidateEntityUniquenessReturnsTrueWhenNoConflicts(t *testing.T) {
 		context.Background(), TypeCategoryUser,
 		"employee",
 		json.RawMessage(`{"email":"unique@example.com"}`),
-		func(filters map[string]interface{}) (bool, error) {
+		func(filters map[string]interface{}, _ UniquenessScope) (bool, error) {
 			require.Equal(t, map[string]interface{}{"email": "unique@example.com"}, filters)
 			return false, nil
 		},
@@ -389,7 +551,7 @@ func TestValidateEntityUniquenessReturnsSchemaNotFoundWhenSchemaMissing(t *testi
 		context.Background(), TypeCategoryUser,
 		"employee",
 		json.RawMessage(`{}`),
-		func(map[string]interface{}) (bool, error) { return false, nil },
+		func(map[string]interface{}, UniquenessScope) (bool, error) { return false, nil },
 	)
 
 	require.False(t, ok)
@@ -413,7 +575,7 @@ func TestValidateEntityUniquenessReturnsInternalErrorWhenSchemaLoadFails(t *test
 		context.Background(), TypeCategoryUser,
 		"employee",
 		json.RawMessage(`{}`),
-		func(map[string]interface{}) (bool, error) { return false, nil },
+		func(map[string]interface{}, UniquenessScope) (bool, error) { return false, nil },
 	)
 
 	require.False(t, ok)
@@ -1009,6 +1171,144 @@ func (s *EntityTypeServiceTestSuite) TestGetUniqueAttributes_TestSchemaNotFound_
 	s.Require().Equal(ErrorEntityTypeNotFound.Code, svcErr.Code)
 }
 
+func (s *EntityTypeServiceTestSuite) TestGetSensitiveAttributes_ReturnsSensitivePaths() {
+	storeMock := newEntityTypeStoreInterfaceMock(s.T())
+	storeMock.
+		On("GetEntityTypeByName", context.Background(), TypeCategoryUser, "customer").
+		Return(EntityType{
+			Schema: json.RawMessage(
+				`{"ssn":{"type":"string","sensitive":true},` +
+					`"address":{"type":"object","properties":{"zip":{"type":"string","sensitive":true}}},` +
+					`"given_name":{"type":"string"}}`,
+			),
+		}, nil).
+		Once()
+
+	service := &entityTypeService{
+		entityTypeStore: storeMock,
+		transactioner:   &mockTransactioner{},
+	}
+
+	fields, svcErr := service.GetSensitiveAttributes(context.Background(), TypeCategoryUser, "customer")
+
+	s.Require().Nil(svcErr)
+	sort.Strings(fields)
+	s.Require().Equal([]string{"address.zip", "ssn"}, fields)
+}
+
+func (s *EntityTypeServiceTestSuite) TestGetSensitiveAttributes_SchemaNotFound_ReturnsError() {
+	storeMock := newEntityTypeStoreInterfaceMock(s.T())
+	storeMock.
+		On("GetEntityTypeByName", context.Background(), TypeCategoryUser, "unknown").
+		Return(EntityType{}, ErrEntityTypeNotFound).
+		Once()
+
+	service := &entityTypeService{
+		entityTypeStore: storeMock,
+		transactioner:   &mockTransactioner{},
+	}
+
+	fields, svcErr := service.GetSensitiveAttributes(context.Background(), TypeCategoryUser, "unknown")
+
+	s.Require().Nil(fields)
+	s.Require().NotNil(svcErr)
+	s.Require().Equal(ErrorEntityTypeNotFound.Code, svcErr.Code)
+}
+
+func (s *EntityTypeServiceTestSuite) TestGetCredentialPolicy_ReturnsPolicy() {
+	policy := &CredentialPolicy{Required: []string{"password", "passkey"}}
+	storeMock := newEntityTypeStoreInterfaceMock(s.T())
+	storeMock.
+		On("GetEntityTypeByName", context.Background(), TypeCategoryUser, "customer").
+		Return(EntityType{SystemAttributes: &SystemAttributes{CredentialPolicy: policy}}, nil).
+		Once()
+
+	service := &entityTypeService{
+		entityTypeStore: storeMock,
+		transactioner:   &mockTransactioner{},
+	}
+
+	result, svcErr := service.GetCredentialPolicy(context.Background(), TypeCategoryUser, "customer")
+
+	s.Require().Nil(svcErr)
+	s.Require().Equal(policy, result)
+}
+
+func (s *EntityTypeServiceTestSuite) TestGetCredentialPolicy_NoPolicy_ReturnsNil() {
+	storeMock := newEntityTypeStoreInterfaceMock(s.T())
+	storeMock.
+		On("GetEntityTypeByName", context.Background(), TypeCategoryUser, "customer").
+		Return(EntityType{}, nil).
+		Once()
+
+	service := &entityTypeService{
+		entityTypeStore: storeMock,
+		transactioner:   &mockTransactioner{},
+	}
+
+	result, svcErr := service.GetCredentialPolicy(context.Background(), TypeCategoryUser, "customer")
+
+	s.Require().Nil(svcErr)
+	s.Require().Nil(result)
+}
+
+func (s *EntityTypeServiceTestSuite) TestGetCredentialPolicy_SchemaNotFound_ReturnsError() {
+	storeMock := newEntityTypeStoreInterfaceMock(s.T())
+	storeMock.
+		On("GetEntityTypeByName", context.Background(), TypeCategoryUser, "unknown").
+		Return(EntityType{}, ErrEntityTypeNotFound).
+		Once()
+
+	service := &entityTypeService{
+		entityTypeStore: storeMock,
+		transactioner:   &mockTransactioner{},
+	}
+
+	result, svcErr := service.GetCredentialPolicy(context.Background(), TypeCategoryUser, "unknown")
+
+	s.Require().Nil(result)
+	s.Require().NotNil(svcErr)
+	s.Require().Equal(ErrorEntityTypeNotFound.Code, svcErr.Code)
+}
+
+func (s *EntityTypeServiceTestSuite) TestGetPasswordPolicy_ReturnsPolicy() {
+	policy := &PasswordPolicy{MinLength: 12, HistoryCount: 3}
+	storeMock := newEntityTypeStoreInterfaceMock(s.T())
+	storeMock.
+		On("GetEntityTypeByName", context.Background(), TypeCategoryUser, "customer").
+		Return(EntityType{SystemAttributes: &SystemAttributes{PasswordPolicy: policy}}, nil).
+		Once()
+
+	service := &entityTypeService{
+		entityTypeStore: storeMock,
+		transactioner:   &mockTransactioner{},
+	}
+
+	result, svcErr := service.GetPasswordPolicy(context.Background(), TypeCategoryUser, "customer")
+
+	s.Require().Nil(svcErr)
+	s.Require().Equal(policy, result)
+}
+
+func (s *EntityTypeServiceTestSuite) TestGetPasswordPolicy_SchemaNotFound_ReturnsError() {
+	storeMock := newEntityTypeStoreInterfaceMock(s.T())
+	storeMock.
+		On("GetEntityTypeByName", context.Background(), TypeCategoryUser, "unknown").
+		Return(EntityType{}, ErrEntityTypeNotFound).
+		Once()
+
+	service := &entityTypeService{
+		entityTypeStore: storeMock,
+		transactioner:   &mockTransactioner{},
+	}
+
+	result, svcErr := service.GetPasswordPolicy(context.Background(), TypeCategoryUser, "unknown")
+
+	s.Require().Nil(result)
+	s.Require().NotNil(svcErr)
+	s.Require().Equal(ErrorEntityTypeNotFound.Code, svcErr.Code)
+}
+
 func (s *EntityTypeServiceTestSuite) TestGetUniqueAttributes_TestEmptyUserType_ReturnsError() {
 	storeMock := newEntityTypeStoreInterfaceMock(s.T())
 
@@ -1570,3 +1870,84 @@ func (s *EntityTypeServiceTestSuite) TestGetAttributes_NonCredential_StoreError_
 	s.Require().Equal(serviceerror.InternalServerError, *svcErr)
 	s.Require().Nil(attrs)
 }
+
+func TestCheckUniquenessScopeChangesRejectsTightenedScopeWithDuplicates(t *testing.T) {
+	var calls []string
+	service := &entityTypeService{}
+	service.RegisterDuplicateValueCounter(DuplicateValueCounterFunc(
+		func(_ context.Context, category TypeCategory, entityType, attribute string, perOU bool) (int, error) {
+			require.Equal(t, TypeCategoryUser, category)
+			require.Equal(t, "employee", entityType)
+			calls = append(calls, attribute)
+			if attribute == "mobile" {
+				require.True(t, perOU)
+				return 2, nil
+			}
+			require.False(t, perOU)
+			return 0, nil
+		}))
+
+	existing := EntityType{
+		Name:   "employee",
+		Schema: json.RawMessage(`{"email":{"type":"string","unique":true},"mobile":{"type":"string"}}`),
+	}
+	updated := json.RawMessage(`{"email":{"type":"string","unique":true,"uniquenessScope":"ou"},` +
+		`"mobile":{"type":"string","unique":true,"uniquenessScope":"ou"},` +
+		`"staffId":{"type":"string","unique":true}}`)
+
+	svcErr := service.checkUniquenessScopeChanges(context.Background(), TypeCategoryUser, existing, updated,
+		log.GetLogger())
+
+	require.NotNil(t, svcErr)
+	require.Equal(t, ErrorUniquenessScopeConflict.Code, svcErr.Code)
+	require.Contains(t, svcErr.ErrorDescription.DefaultValue, "mobile")
+	require.Equal(t, []string{"mobile"}, calls)
+}
+
+func TestCheckUniquenessScopeChangesAllowsTightenedScopeWithoutDuplicates(t *testing.T) {
+	service := &entityTypeService{}
+	service.RegisterDuplicateValueCounter(DuplicateValueCounterFunc(
+		func(_ context.Context, _ TypeCategory, _, attribute string, perOU bool) (int, error) {
+			require.Equal(t, "email", attribute)
+			require.False(t, perOU)
+			return 0, nil
+		}))
+
+	existing := EntityType{
+		Name:   "employee",
+		Schema: json.RawMessage(`{"email":{"type":"string","unique":true,"uniquenessScope":"ou"}}`),
+	}
+	updated := json.RawMessage(`{"email":{"type":"string","unique":true,"uniquenessScope":"type"}}`)
+
+	svcErr := service.checkUniquenessScopeChanges(context.Background(), TypeCategoryUser, existing, updated,
+		log.GetLogger())
+
+	require.Nil(t, svcErr)
+}
+
+func TestCheckUniquenessScopeChangesReturnsInternalErrorWhenCountFails(t *testing.T) {
+	service := &entityTypeService{}
+	service.RegisterDuplicateValueCounter(DuplicateValueCounterFunc(
+		func(context.Context, TypeCategory, string, string, bool) (int, error) {
+			return 0, errors.New("db error")
+		}))
+
+	existing := EntityType{Name: "employee", Schema: json.RawMessage(`{"email":{"type":"string"}}`)}
+	updated := json.RawMessage(`{"email":{"type":"string","unique":true}}`)
+
+	svcErr := service.checkUniquenessScopeChanges(context.Background(), TypeCategoryUser, existing, updated,
+		log.GetLogger())
+
+	require.NotNil(t, svcErr)
+	require.Equal(t, serviceerror.InternalServerError.Code, svcErr.Code)
+}
+
+func TestCheckUniquenessScopeChangesSkipsWithoutCounter(t *testing.T) {
+	service := &entityTypeService{}
+
+	existing := EntityType{Name: "employee", Schema: json.RawMessage(`{"email":{"type":"string"}}`)}
+	updated := json.RawMessage(`{"email":{"type":"string","unique":true}}`)
+
+	require.Nil(t, service.checkUniquenessScopeChanges(context.Background(), TypeCategoryUser, existing, updated,
+		log.GetLogger()))
+}
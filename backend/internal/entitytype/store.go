@@ -25,6 +25,7 @@ import (
 	"strings"
 
 	"github.com/thunder-id/thunderid/internal/system/config"
+	sysContext "github.com/thunder-id/thunderid/internal/system/context"
 	"github.com/thunder-id/thunderid/internal/system/database/provider"
 	"github.com/thunder-id/thunderid/internal/system/log"
 	"github.com/thunder-id/thunderid/internal/system/transaction"
@@ -110,12 +111,13 @@ func newEntityTypeStore() (entityTypeStoreInterface, transaction.Transactioner,
 
 // GetEntityTypeListCount retrieves the total count of entity types for the given category.
 func (s *entityTypeStore) GetEntityTypeListCount(ctx context.Context, category TypeCategory) (int, error) {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
 	dbClient, err := s.dbProvider.GetConfigDBClient()
 	if err != nil {
 		return 0, fmt.Errorf("failed to get database client: %w", err)
 	}
 
-	countResults, err := dbClient.QueryContext(ctx, queryGetEntityTypeCount, string(category), s.deploymentID)
+	countResults, err := dbClient.QueryContext(ctx, queryGetEntityTypeCount, string(category), deploymentID)
 	if err != nil {
 		return 0, fmt.Errorf("failed to execute count query: %w", err)
 	}
@@ -135,6 +137,7 @@ func (s *entityTypeStore) GetEntityTypeListCount(ctx context.Context, category T
 // GetEntityTypeList retrieves a paginated list of entity types for the given category.
 func (s *entityTypeStore) GetEntityTypeList(ctx context.Context, category TypeCategory,
 	limit, offset int) ([]EntityTypeListItem, error) {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
 	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, "EntityTypePersistence"))
 
 	dbClient, err := s.dbProvider.GetConfigDBClient()
@@ -142,7 +145,7 @@ func (s *entityTypeStore) GetEntityTypeList(ctx context.Context, category TypeCa
 		return nil, fmt.Errorf("failed to get database client: %w", err)
 	}
 
-	results, err := dbClient.QueryContext(ctx, queryGetEntityTypeList, limit, offset, s.deploymentID, string(category))
+	results, err := dbClient.QueryContext(ctx, queryGetEntityTypeList, limit, offset, deploymentID, string(category))
 	if err != nil {
 		return nil, fmt.Errorf("failed to execute query: %w", err)
 	}
@@ -163,6 +166,7 @@ func (s *entityTypeStore) GetEntityTypeList(ctx context.Context, category TypeCa
 // GetEntityTypeListByOUIDs retrieves a paginated list of entity types filtered by OU IDs and category.
 func (s *entityTypeStore) GetEntityTypeListByOUIDs(ctx context.Context, category TypeCategory,
 	ouIDs []string, limit, offset int) ([]EntityTypeListItem, error) {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
 	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, "EntityTypePersistence"))
 
 	if len(ouIDs) == 0 {
@@ -179,7 +183,7 @@ func (s *entityTypeStore) GetEntityTypeListByOUIDs(ctx context.Context, category
 	for _, id := range ouIDs {
 		args = append(args, id)
 	}
-	args = append(args, string(category), s.deploymentID, limit, offset)
+	args = append(args, string(category), deploymentID, limit, offset)
 
 	results, err := dbClient.QueryContext(ctx, query, args...)
 	if err != nil {
@@ -202,6 +206,7 @@ func (s *entityTypeStore) GetEntityTypeListByOUIDs(ctx context.Context, category
 // GetEntityTypeListCountByOUIDs retrieves the total count of entity types filtered by OU IDs and category.
 func (s *entityTypeStore) GetEntityTypeListCountByOUIDs(ctx context.Context, category TypeCategory,
 	ouIDs []string) (int, error) {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
 	if len(ouIDs) == 0 {
 		return 0, nil
 	}
@@ -216,7 +221,7 @@ func (s *entityTypeStore) GetEntityTypeListCountByOUIDs(ctx context.Context, cat
 	for _, id := range ouIDs {
 		args = append(args, id)
 	}
-	args = append(args, string(category), s.deploymentID)
+	args = append(args, string(category), deploymentID)
 
 	countResults, err := dbClient.QueryContext(ctx, query, args...)
 	if err != nil {
@@ -237,6 +242,7 @@ func (s *entityTypeStore) GetEntityTypeListCountByOUIDs(ctx context.Context, cat
 
 // CreateEntityType creates a new entity type. The schema's Category field must be set.
 func (s *entityTypeStore) CreateEntityType(ctx context.Context, entityType EntityType) error {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
 	dbClient, err := s.dbProvider.GetConfigDBClient()
 	if err != nil {
 		return fmt.Errorf("failed to get database client: %w", err)
@@ -257,7 +263,7 @@ func (s *entityTypeStore) CreateEntityType(ctx context.Context, entityType Entit
 		entityType.AllowSelfRegistration,
 		string(entityType.Schema),
 		sysAttrs,
-		s.deploymentID,
+		deploymentID,
 	)
 	if err != nil {
 		return fmt.Errorf("failed to create entity type: %w", err)
@@ -269,12 +275,13 @@ func (s *entityTypeStore) CreateEntityType(ctx context.Context, entityType Entit
 // GetEntityTypeByID retrieves an entity type by its ID within a category.
 func (s *entityTypeStore) GetEntityTypeByID(ctx context.Context, category TypeCategory,
 	schemaID string) (EntityType, error) {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
 	dbClient, err := s.dbProvider.GetConfigDBClient()
 	if err != nil {
 		return EntityType{}, fmt.Errorf("failed to get database client: %w", err)
 	}
 
-	results, err := dbClient.QueryContext(ctx, queryGetEntityTypeByID, schemaID, s.deploymentID, string(category))
+	results, err := dbClient.QueryContext(ctx, queryGetEntityTypeByID, schemaID, deploymentID, string(category))
 	if err != nil {
 		return EntityType{}, fmt.Errorf("failed to execute query: %w", err)
 	}
@@ -289,12 +296,13 @@ func (s *entityTypeStore) GetEntityTypeByID(ctx context.Context, category TypeCa
 // GetEntityTypeByName retrieves an entity type by its name within a category.
 func (s *entityTypeStore) GetEntityTypeByName(ctx context.Context, category TypeCategory,
 	name string) (EntityType, error) {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
 	dbClient, err := s.dbProvider.GetConfigDBClient()
 	if err != nil {
 		return EntityType{}, fmt.Errorf("failed to get database client: %w", err)
 	}
 
-	results, err := dbClient.QueryContext(ctx, queryGetEntityTypeByName, name, s.deploymentID, string(category))
+	results, err := dbClient.QueryContext(ctx, queryGetEntityTypeByName, name, deploymentID, string(category))
 	if err != nil {
 		return EntityType{}, fmt.Errorf("failed to execute query: %w", err)
 	}
@@ -309,6 +317,7 @@ func (s *entityTypeStore) GetEntityTypeByName(ctx context.Context, category Type
 // UpdateEntityTypeByID updates an entity type by its ID within a category.
 func (s *entityTypeStore) UpdateEntityTypeByID(ctx context.Context, category TypeCategory,
 	schemaID string, entityType EntityType) error {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
 	dbClient, err := s.dbProvider.GetConfigDBClient()
 	if err != nil {
 		return fmt.Errorf("failed to get database client: %w", err)
@@ -328,7 +337,7 @@ func (s *entityTypeStore) UpdateEntityTypeByID(ctx context.Context, category Typ
 		string(entityType.Schema),
 		sysAttrs,
 		schemaID,
-		s.deploymentID,
+		deploymentID,
 		string(category),
 	)
 	if err != nil {
@@ -341,6 +350,7 @@ func (s *entityTypeStore) UpdateEntityTypeByID(ctx context.Context, category Typ
 // DeleteEntityTypeByID deletes an entity type by its ID within a category.
 func (s *entityTypeStore) DeleteEntityTypeByID(ctx context.Context, category TypeCategory,
 	schemaID string) error {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
 	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, "EntityTypePersistence"))
 
 	dbClient, err := s.dbProvider.GetConfigDBClient()
@@ -348,7 +358,7 @@ func (s *entityTypeStore) DeleteEntityTypeByID(ctx context.Context, category Typ
 		return fmt.Errorf("failed to get database client: %w", err)
 	}
 
-	rowsAffected, err := dbClient.ExecuteContext(ctx, queryDeleteEntityTypeByID, schemaID, s.deploymentID,
+	rowsAffected, err := dbClient.ExecuteContext(ctx, queryDeleteEntityTypeByID, schemaID, deploymentID,
 		string(category))
 	if err != nil {
 		return fmt.Errorf("failed to delete entity type: %w", err)
@@ -369,6 +379,7 @@ func (s *entityTypeStore) IsEntityTypeDeclarative(category TypeCategory, schemaI
 // GetDisplayAttributesByNames retrieves display attributes for a list of entity type names within a category.
 func (s *entityTypeStore) GetDisplayAttributesByNames(ctx context.Context, category TypeCategory,
 	names []string) (map[string]string, error) {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
 	if len(names) == 0 {
 		return map[string]string{}, nil
 	}
@@ -385,7 +396,7 @@ func (s *entityTypeStore) GetDisplayAttributesByNames(ctx context.Context, categ
 	for _, name := range names {
 		args = append(args, name)
 	}
-	args = append(args, string(category), s.deploymentID)
+	args = append(args, string(category), deploymentID)
 
 	results, err := dbClient.QueryContext(ctx, query, args...)
 	if err != nil {
@@ -0,0 +1,43 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package enumeration
+
+import (
+	"net/http"
+
+	sysutils "github.com/thunder-id/thunderid/internal/system/utils"
+)
+
+// enumerationResistanceHandler is the handler for enumeration resistance operations.
+type enumerationResistanceHandler struct {
+	enumerationService EnumerationResistanceServiceInterface
+}
+
+// newEnumerationResistanceHandler creates a new instance of enumerationResistanceHandler.
+func newEnumerationResistanceHandler(
+	enumerationService EnumerationResistanceServiceInterface) *enumerationResistanceHandler {
+	return &enumerationResistanceHandler{
+		enumerationService: enumerationService,
+	}
+}
+
+// HandleComplianceReportGetRequest handles the request to retrieve the enumeration resistance compliance report.
+func (h *enumerationResistanceHandler) HandleComplianceReportGetRequest(w http.ResponseWriter, _ *http.Request) {
+	sysutils.WriteSuccessResponse(w, http.StatusOK, h.enumerationService.GetComplianceReport())
+}
@@ -0,0 +1,68 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package enumeration
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type HandlerTestSuite struct {
+	suite.Suite
+	mockService *EnumerationResistanceServiceInterfaceMock
+	handler     *enumerationResistanceHandler
+}
+
+func TestHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(HandlerTestSuite))
+}
+
+func (s *HandlerTestSuite) SetupTest() {
+	s.mockService = NewEnumerationResistanceServiceInterfaceMock(s.T())
+	s.handler = newEnumerationResistanceHandler(s.mockService)
+}
+
+func (s *HandlerTestSuite) TestHandleComplianceReportGetRequest() {
+	s.mockService.On("GetComplianceReport").Return(&ComplianceReport{
+		Enabled:         true,
+		MinResponseTime: 300,
+		MaxJitter:       100,
+		Endpoints: []NormalizedEndpoint{{
+			Method:         http.MethodPost,
+			Path:           "/auth/credentials/authenticate",
+			Normalizations: []Normalization{NormalizationResponse, NormalizationTiming},
+		}},
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/admin/enumeration-resistance/report", nil)
+	rr := httptest.NewRecorder()
+	s.handler.HandleComplianceReportGetRequest(rr, req)
+
+	s.Equal(http.StatusOK, rr.Code)
+	var report ComplianceReport
+	s.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &report))
+	s.True(report.Enabled)
+	s.Require().Len(report.Endpoints, 1)
+	s.Equal("/auth/credentials/authenticate", report.Endpoints[0].Path)
+	s.Equal([]Normalization{NormalizationResponse, NormalizationTiming}, report.Endpoints[0].Normalizations)
+}
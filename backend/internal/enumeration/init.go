@@ -0,0 +1,56 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package enumeration
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/middleware"
+)
+
+// Initialize initializes the enumeration resistance service and registers its routes.
+func Initialize(mux *http.ServeMux) EnumerationResistanceServiceInterface {
+	enumerationConfig := config.GetServerRuntime().Config.EnumerationResistance
+	enumerationService := newEnumerationResistanceService(enumerationConfig.Enabled,
+		time.Duration(enumerationConfig.MinResponseTime)*time.Millisecond,
+		time.Duration(enumerationConfig.MaxJitter)*time.Millisecond)
+
+	enumerationHandler := newEnumerationResistanceHandler(enumerationService)
+	registerRoutes(mux, enumerationHandler)
+
+	return enumerationService
+}
+
+// registerRoutes registers the routes for enumeration resistance operations.
+func registerRoutes(mux *http.ServeMux, enumerationHandler *enumerationResistanceHandler) {
+	opts := middleware.CORSOptions{
+		AllowedMethods:   []string{"GET"},
+		AllowedHeaders:   middleware.DefaultAllowedHeaders,
+		AllowCredentials: true,
+		MaxAge:           600,
+	}
+	mux.HandleFunc(middleware.WithCORS("GET /admin/enumeration-resistance/report",
+		enumerationHandler.HandleComplianceReportGetRequest, opts))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS /admin/enumeration-resistance/report",
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, opts))
+}
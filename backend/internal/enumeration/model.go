@@ -0,0 +1,52 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package enumeration
+
+// Normalization is a measure that hides whether an account exists in the responses of an endpoint.
+type Normalization string
+
+const (
+	// NormalizationResponse is the normalization that returns the same status code and message for unknown
+	// accounts and wrong credentials.
+	NormalizationResponse Normalization = "RESPONSE"
+	// NormalizationDummyHash is the normalization that verifies the credentials of unknown accounts against a
+	// dummy hash, so that they take as long to reject as wrong credentials.
+	NormalizationDummyHash Normalization = "DUMMY_HASH_VERIFICATION"
+	// NormalizationTiming is the normalization that delays failed responses to a minimum response time with
+	// random jitter.
+	NormalizationTiming Normalization = "TIMING"
+)
+
+// NormalizedEndpoint is an endpoint that applies normalizations when enumeration resistance is enabled.
+type NormalizedEndpoint struct {
+	Method         string          `json:"method"`
+	Path           string          `json:"path"`
+	Description    string          `json:"description,omitempty"`
+	Normalizations []Normalization `json:"normalizations"`
+}
+
+// ComplianceReport describes the enumeration resistance settings and the endpoints they apply to.
+type ComplianceReport struct {
+	Enabled bool `json:"enabled"`
+	// MinResponseTime is the number of milliseconds a failed authentication takes at the least.
+	MinResponseTime int64 `json:"minResponseTime"`
+	// MaxJitter is the maximum number of milliseconds of random delay added to a failed authentication.
+	MaxJitter int64                `json:"maxJitter"`
+	Endpoints []NormalizedEndpoint `json:"endpoints"`
+}
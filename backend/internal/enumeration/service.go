@@ -0,0 +1,138 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package enumeration hides whether an account exists from the identify and authenticate endpoints.
+package enumeration
+
+import (
+	"context"
+	"math/rand/v2"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	sysutils "github.com/thunder-id/thunderid/internal/system/utils"
+)
+
+// EnumerationResistanceServiceInterface defines the interface for the enumeration resistance service.
+type EnumerationResistanceServiceInterface interface {
+	IsEnabled() bool
+	PadFailure(ctx context.Context, started time.Time)
+	PadResponse(ctx context.Context, started time.Time)
+	RegisterNormalizedEndpoint(endpoint NormalizedEndpoint)
+	GetComplianceReport() *ComplianceReport
+}
+
+// enumerationResistanceService is the default implementation of EnumerationResistanceServiceInterface.
+type enumerationResistanceService struct {
+	enabled         bool
+	minResponseTime time.Duration
+	maxJitter       time.Duration
+	mu              sync.RWMutex
+	endpoints       []NormalizedEndpoint
+	jitter          func(maxJitter time.Duration) time.Duration
+	sleep           func(ctx context.Context, d time.Duration)
+}
+
+// newEnumerationResistanceService creates a new instance of enumerationResistanceService.
+func newEnumerationResistanceService(enabled bool, minResponseTime,
+	maxJitter time.Duration) EnumerationResistanceServiceInterface {
+	if minResponseTime < 0 {
+		minResponseTime = 0
+	}
+	if maxJitter < 0 {
+		maxJitter = 0
+	}
+	return &enumerationResistanceService{
+		enabled:         enabled,
+		minResponseTime: minResponseTime,
+		maxJitter:       maxJitter,
+		jitter:          randomJitter,
+		sleep:           sysutils.SleepContext,
+	}
+}
+
+// IsEnabled returns whether enumeration resistance is enabled.
+func (s *enumerationResistanceService) IsEnabled() bool {
+	return s.enabled
+}
+
+// PadFailure delays a failed response that started at the given time until it has taken the minimum
+// response time plus a random jitter. It returns immediately when enumeration resistance is disabled.
+func (s *enumerationResistanceService) PadFailure(ctx context.Context, started time.Time) {
+	if !s.enabled {
+		return
+	}
+	s.PadResponse(ctx, started)
+}
+
+// PadResponse delays a response that started at the given time until it has taken the minimum response
+// time plus a random jitter, whether or not enumeration resistance is enabled. It is meant for endpoints
+// whose only purpose is to answer questions about accounts.
+func (s *enumerationResistanceService) PadResponse(ctx context.Context, started time.Time) {
+	target := s.minResponseTime
+	if s.maxJitter > 0 {
+		target += s.jitter(s.maxJitter)
+	}
+	if wait := target - time.Since(started); wait > 0 {
+		s.sleep(ctx, wait)
+	}
+}
+
+// RegisterNormalizedEndpoint records an endpoint that applies normalizations when enumeration resistance
+// is enabled. Registering an endpoint again replaces its normalizations.
+func (s *enumerationResistanceService) RegisterNormalizedEndpoint(endpoint NormalizedEndpoint) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.endpoints = slices.DeleteFunc(s.endpoints, func(existing NormalizedEndpoint) bool {
+		return existing.Method == endpoint.Method && existing.Path == endpoint.Path
+	})
+	s.endpoints = append(s.endpoints, endpoint)
+}
+
+// GetComplianceReport returns the enumeration resistance settings and the normalized endpoints ordered by
+// path and method.
+func (s *enumerationResistanceService) GetComplianceReport() *ComplianceReport {
+	s.mu.RLock()
+	endpoints := slices.Clone(s.endpoints)
+	s.mu.RUnlock()
+
+	slices.SortFunc(endpoints, func(a, b NormalizedEndpoint) int {
+		if c := strings.Compare(a.Path, b.Path); c != 0 {
+			return c
+		}
+		return strings.Compare(a.Method, b.Method)
+	})
+	if endpoints == nil {
+		endpoints = []NormalizedEndpoint{}
+	}
+
+	return &ComplianceReport{
+		Enabled:         s.enabled,
+		MinResponseTime: s.minResponseTime.Milliseconds(),
+		MaxJitter:       s.maxJitter.Milliseconds(),
+		Endpoints:       endpoints,
+	}
+}
+
+// randomJitter returns a random duration between zero and the given maximum.
+func randomJitter(maxJitter time.Duration) time.Duration {
+	return rand.N(maxJitter + 1)
+}
@@ -0,0 +1,144 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package enumeration
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type ServiceTestSuite struct {
+	suite.Suite
+	service *enumerationResistanceService
+	slept   []time.Duration
+}
+
+func TestServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(ServiceTestSuite))
+}
+
+func (s *ServiceTestSuite) SetupTest() {
+	s.slept = nil
+	s.service = newEnumerationResistanceService(true, 300*time.Millisecond,
+		100*time.Millisecond).(*enumerationResistanceService)
+	s.service.jitter = func(maxJitter time.Duration) time.Duration {
+		return maxJitter / 2
+	}
+	s.service.sleep = func(_ context.Context, d time.Duration) {
+		s.slept = append(s.slept, d)
+	}
+}
+
+func (s *ServiceTestSuite) TestNewEnumerationResistanceService_ClampsNegativeDelays() {
+	svc := newEnumerationResistanceService(true, -time.Second, -time.Second).(*enumerationResistanceService)
+
+	s.Equal(time.Duration(0), svc.minResponseTime)
+	s.Equal(time.Duration(0), svc.maxJitter)
+}
+
+func (s *ServiceTestSuite) TestPadFailure_SleepsUntilTargetResponseTime() {
+	s.service.PadFailure(context.Background(), time.Now().Add(-100*time.Millisecond))
+
+	s.Require().Len(s.slept, 1)
+	s.InDelta(float64(250*time.Millisecond), float64(s.slept[0]), float64(20*time.Millisecond))
+}
+
+func (s *ServiceTestSuite) TestPadFailure_DoesNotSleepAfterTargetResponseTime() {
+	s.service.PadFailure(context.Background(), time.Now().Add(-time.Second))
+
+	s.Empty(s.slept)
+}
+
+func (s *ServiceTestSuite) TestPadFailure_Disabled() {
+	s.service.enabled = false
+
+	s.service.PadFailure(context.Background(), time.Now())
+
+	s.False(s.service.IsEnabled())
+	s.Empty(s.slept)
+}
+
+func (s *ServiceTestSuite) TestPadResponse_PadsWhenDisabled() {
+	s.service.enabled = false
+
+	s.service.PadResponse(context.Background(), time.Now().Add(-100*time.Millisecond))
+
+	s.Require().Len(s.slept, 1)
+	s.InDelta(float64(250*time.Millisecond), float64(s.slept[0]), float64(20*time.Millisecond))
+}
+
+func (s *ServiceTestSuite) TestPadFailure_WithoutJitter() {
+	s.service.maxJitter = 0
+	s.service.jitter = func(time.Duration) time.Duration {
+		s.Fail("jitter must not be drawn when the maximum jitter is zero")
+		return 0
+	}
+
+	s.service.PadFailure(context.Background(), time.Now())
+
+	s.Require().Len(s.slept, 1)
+	s.LessOrEqual(s.slept[0], 300*time.Millisecond)
+}
+
+func (s *ServiceTestSuite) TestGetComplianceReport() {
+	s.service.RegisterNormalizedEndpoint(NormalizedEndpoint{
+		Method:         http.MethodPost,
+		Path:           "/flow/execute",
+		Normalizations: []Normalization{NormalizationResponse},
+	})
+	s.service.RegisterNormalizedEndpoint(NormalizedEndpoint{
+		Method:         http.MethodPost,
+		Path:           "/auth/credentials/authenticate",
+		Normalizations: []Normalization{NormalizationResponse},
+	})
+	s.service.RegisterNormalizedEndpoint(NormalizedEndpoint{
+		Method:         http.MethodPost,
+		Path:           "/flow/execute",
+		Normalizations: []Normalization{NormalizationResponse, NormalizationTiming},
+	})
+
+	report := s.service.GetComplianceReport()
+
+	s.True(report.Enabled)
+	s.Equal(int64(300), report.MinResponseTime)
+	s.Equal(int64(100), report.MaxJitter)
+	s.Require().Len(report.Endpoints, 2)
+	s.Equal("/auth/credentials/authenticate", report.Endpoints[0].Path)
+	s.Equal("/flow/execute", report.Endpoints[1].Path)
+	s.Equal([]Normalization{NormalizationResponse, NormalizationTiming}, report.Endpoints[1].Normalizations)
+}
+
+func (s *ServiceTestSuite) TestGetComplianceReport_NoEndpoints() {
+	report := s.service.GetComplianceReport()
+
+	s.NotNil(report.Endpoints)
+	s.Empty(report.Endpoints)
+}
+
+func (s *ServiceTestSuite) TestRandomJitter_WithinBounds() {
+	for range 100 {
+		jitter := randomJitter(10 * time.Millisecond)
+		s.GreaterOrEqual(jitter, time.Duration(0))
+		s.LessOrEqual(jitter, 10*time.Millisecond)
+	}
+}
@@ -0,0 +1,44 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package featureflag
+
+// Evaluation reasons.
+const (
+	// ReasonFlagNotFound indicates that the flag does not exist. Unknown flags evaluate to off.
+	ReasonFlagNotFound = "FLAG_NOT_FOUND"
+	// ReasonDisabled indicates that the flag is switched off for every context.
+	ReasonDisabled = "DISABLED"
+	// ReasonRuleMatch indicates that the value of the first matching targeting rule was served.
+	ReasonRuleMatch = "RULE_MATCH"
+	// ReasonDefault indicates that no targeting rule matched and the default value was served.
+	ReasonDefault = "DEFAULT"
+)
+
+const (
+	loggerComponentName = "FeatureFlagService"
+
+	// maxFlagKeyLength is the maximum length of a flag key.
+	maxFlagKeyLength = 100
+	// maxDescriptionLength is the maximum length of a flag description.
+	maxDescriptionLength = 500
+	// maxRules is the maximum number of targeting rules of a flag.
+	maxRules = 50
+	// percentageBuckets is the number of buckets contexts are hashed into for percentage rollouts.
+	percentageBuckets = 100
+)
@@ -0,0 +1,111 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package featureflag
+
+import (
+	"errors"
+
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/i18n/core"
+)
+
+// ErrFeatureFlagNotFound is returned when the feature flag is not found in the system.
+var ErrFeatureFlagNotFound = errors.New("feature flag not found")
+
+// Client errors for feature flag operations.
+var (
+	// ErrorFeatureFlagNotFound is the error returned when a feature flag is not found.
+	ErrorFeatureFlagNotFound = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "FFL-1001",
+		Error: core.I18nMessage{
+			Key:          "error.featureflagservice.feature_flag_not_found",
+			DefaultValue: "Feature flag not found",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.featureflagservice.feature_flag_not_found_description",
+			DefaultValue: "The requested feature flag could not be found",
+		},
+	}
+	// ErrorInvalidRequestFormat is the error returned when the request body is malformed.
+	ErrorInvalidRequestFormat = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "FFL-1002",
+		Error: core.I18nMessage{
+			Key:          "error.featureflagservice.invalid_request_format",
+			DefaultValue: "Invalid request format",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.featureflagservice.invalid_request_format_description",
+			DefaultValue: "The request body is malformed or contains invalid data",
+		},
+	}
+	// ErrorInvalidFlagKey is the error returned when the key of a flag is invalid.
+	ErrorInvalidFlagKey = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "FFL-1003",
+		Error: core.I18nMessage{
+			Key:          "error.featureflagservice.invalid_flag_key",
+			DefaultValue: "Invalid flag key",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.featureflagservice.invalid_flag_key_description",
+			DefaultValue: "The flag key must contain only lowercase letters, digits, dots, hyphens and underscores",
+		},
+	}
+	// ErrorInvalidDescription is the error returned when the description of a flag is too long.
+	ErrorInvalidDescription = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "FFL-1004",
+		Error: core.I18nMessage{
+			Key:          "error.featureflagservice.invalid_description",
+			DefaultValue: "Invalid description",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.featureflagservice.invalid_description_description",
+			DefaultValue: "The flag description must not exceed 500 characters",
+		},
+	}
+	// ErrorInvalidTargetingRule is the error returned when a targeting rule of a flag is invalid.
+	ErrorInvalidTargetingRule = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "FFL-1005",
+		Error: core.I18nMessage{
+			Key:          "error.featureflagservice.invalid_targeting_rule",
+			DefaultValue: "Invalid targeting rule",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.featureflagservice.invalid_targeting_rule_description",
+			DefaultValue: "A flag can have at most 50 rules, each with a condition and a percentage between 0 and 100",
+		},
+	}
+	// ErrorFeatureFlagConflict is the error returned when a flag with the same key already exists.
+	ErrorFeatureFlagConflict = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "FFL-1006",
+		Error: core.I18nMessage{
+			Key:          "error.featureflagservice.feature_flag_conflict",
+			DefaultValue: "Feature flag conflict",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.featureflagservice.feature_flag_conflict_description",
+			DefaultValue: "A feature flag with the same key already exists",
+		},
+	}
+)
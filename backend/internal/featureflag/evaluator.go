@@ -0,0 +1,65 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package featureflag
+
+import (
+	"hash/fnv"
+	"slices"
+)
+
+// evaluateFlag evaluates a flag for a context.
+func evaluateFlag(flag FeatureFlag, evalCtx EvaluationContext) Evaluation {
+	if !flag.Enabled {
+		return Evaluation{Key: flag.Key, Value: false, Reason: ReasonDisabled}
+	}
+	for i, rule := range flag.Rules {
+		if ruleMatches(flag.Key, rule, evalCtx) {
+			ruleIndex := i
+			return Evaluation{Key: flag.Key, Value: rule.Value, Reason: ReasonRuleMatch, RuleIndex: &ruleIndex}
+		}
+	}
+	return Evaluation{Key: flag.Key, Value: flag.DefaultValue, Reason: ReasonDefault}
+}
+
+// ruleMatches reports whether a context satisfies every condition of a targeting rule.
+func ruleMatches(key string, rule TargetingRule, evalCtx EvaluationContext) bool {
+	if len(rule.DeploymentIDs) > 0 && !slices.Contains(rule.DeploymentIDs, evalCtx.DeploymentID) {
+		return false
+	}
+	if len(rule.TenantIDs) > 0 && !slices.Contains(rule.TenantIDs, evalCtx.TenantID) {
+		return false
+	}
+	if len(rule.OUIDs) > 0 && !slices.Contains(rule.OUIDs, evalCtx.OUID) {
+		return false
+	}
+	if rule.Percentage != nil && rolloutBucket(key, evalCtx) >= *rule.Percentage {
+		return false
+	}
+	return true
+}
+
+// rolloutBucket hashes a context into one of the percentage buckets of a flag. The bucket depends only
+// on the flag key and the deployment, tenant and organization unit of the context, so a context stays
+// in a rollout as its percentage grows, while different flags roll out to different contexts.
+func rolloutBucket(key string, evalCtx EvaluationContext) int {
+	hash := fnv.New32a()
+	_, _ = hash.Write([]byte(key + "\x00" + evalCtx.DeploymentID + "\x00" + evalCtx.TenantID + "\x00" +
+		evalCtx.OUID))
+	return int(hash.Sum32() % percentageBuckets)
+}
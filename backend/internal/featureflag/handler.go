@@ -0,0 +1,162 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package featureflag
+
+import (
+	"net/http"
+
+	sysutils "github.com/thunder-id/thunderid/internal/system/utils"
+)
+
+// featureFlagHandler is the handler for feature flag management operations.
+type featureFlagHandler struct {
+	featureFlagService FeatureFlagServiceInterface
+}
+
+// newFeatureFlagHandler creates a new instance of featureFlagHandler.
+func newFeatureFlagHandler(featureFlagService FeatureFlagServiceInterface) *featureFlagHandler {
+	return &featureFlagHandler{
+		featureFlagService: featureFlagService,
+	}
+}
+
+// HandleFlagPostRequest handles the create feature flag request.
+func (h *featureFlagHandler) HandleFlagPostRequest(w http.ResponseWriter, r *http.Request) {
+	request, err := sysutils.DecodeJSONBody[FeatureFlagRequest](r)
+	if err != nil {
+		sysutils.WriteServiceErrorResponse(w, &ErrorInvalidRequestFormat, clientErrorStatusCodes)
+		return
+	}
+
+	created, svcErr := h.featureFlagService.CreateFlag(r.Context(), sanitizeFlagRequest(*request))
+	if svcErr != nil {
+		sysutils.WriteServiceErrorResponse(w, svcErr, clientErrorStatusCodes)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(w, http.StatusCreated, created)
+}
+
+// HandleFlagListRequest handles the list feature flags request.
+func (h *featureFlagHandler) HandleFlagListRequest(w http.ResponseWriter, r *http.Request) {
+	flags, svcErr := h.featureFlagService.GetFlagList(r.Context())
+	if svcErr != nil {
+		sysutils.WriteServiceErrorResponse(w, svcErr, clientErrorStatusCodes)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(w, http.StatusOK, flags)
+}
+
+// HandleFlagGetRequest handles the get feature flag request.
+func (h *featureFlagHandler) HandleFlagGetRequest(w http.ResponseWriter, r *http.Request) {
+	flag, svcErr := h.featureFlagService.GetFlag(r.Context(), r.PathValue("key"))
+	if svcErr != nil {
+		sysutils.WriteServiceErrorResponse(w, svcErr, clientErrorStatusCodes)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(w, http.StatusOK, flag)
+}
+
+// HandleFlagPutRequest handles the update feature flag request.
+func (h *featureFlagHandler) HandleFlagPutRequest(w http.ResponseWriter, r *http.Request) {
+	request, err := sysutils.DecodeJSONBody[FeatureFlagRequest](r)
+	if err != nil {
+		sysutils.WriteServiceErrorResponse(w, &ErrorInvalidRequestFormat, clientErrorStatusCodes)
+		return
+	}
+
+	updated, svcErr := h.featureFlagService.UpdateFlag(r.Context(), r.PathValue("key"),
+		sanitizeFlagRequest(*request))
+	if svcErr != nil {
+		sysutils.WriteServiceErrorResponse(w, svcErr, clientErrorStatusCodes)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(w, http.StatusOK, updated)
+}
+
+// HandleFlagDeleteRequest handles the delete feature flag request.
+func (h *featureFlagHandler) HandleFlagDeleteRequest(w http.ResponseWriter, r *http.Request) {
+	if svcErr := h.featureFlagService.DeleteFlag(r.Context(), r.PathValue("key")); svcErr != nil {
+		sysutils.WriteServiceErrorResponse(w, svcErr, clientErrorStatusCodes)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(w, http.StatusNoContent, nil)
+}
+
+// HandleEvaluationListRequest handles the request to inspect the effective feature flags of the context
+// given by the deploymentId, tenantId and ouId query parameters.
+func (h *featureFlagHandler) HandleEvaluationListRequest(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	evalCtx := EvaluationContext{
+		DeploymentID: sysutils.SanitizeString(query.Get("deploymentId")),
+		TenantID:     sysutils.SanitizeString(query.Get("tenantId")),
+		OUID:         sysutils.SanitizeString(query.Get("ouId")),
+	}
+
+	evaluations, svcErr := h.featureFlagService.EvaluateAll(r.Context(), evalCtx)
+	if svcErr != nil {
+		sysutils.WriteServiceErrorResponse(w, svcErr, clientErrorStatusCodes)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(w, http.StatusOK, evaluations)
+}
+
+// sanitizeFlagRequest sanitizes the user supplied fields of a feature flag request.
+func sanitizeFlagRequest(request FeatureFlagRequest) FeatureFlagRequest {
+	sanitized := FeatureFlagRequest{
+		Key:          sysutils.SanitizeString(request.Key),
+		Description:  sysutils.SanitizeString(request.Description),
+		Enabled:      request.Enabled,
+		DefaultValue: request.DefaultValue,
+	}
+	for _, rule := range request.Rules {
+		sanitized.Rules = append(sanitized.Rules, TargetingRule{
+			DeploymentIDs: sanitizeStrings(rule.DeploymentIDs),
+			TenantIDs:     sanitizeStrings(rule.TenantIDs),
+			OUIDs:         sanitizeStrings(rule.OUIDs),
+			Percentage:    rule.Percentage,
+			Value:         rule.Value,
+		})
+	}
+	return sanitized
+}
+
+// sanitizeStrings sanitizes each value of a list.
+func sanitizeStrings(values []string) []string {
+	if values == nil {
+		return nil
+	}
+	sanitized := make([]string, 0, len(values))
+	for _, value := range values {
+		sanitized = append(sanitized, sysutils.SanitizeString(value))
+	}
+	return sanitized
+}
+
+// clientErrorStatusCodes maps the client errors of the handler to HTTP status codes. Other client errors are
+// returned with 400 Bad Request.
+var clientErrorStatusCodes = map[string]int{
+	ErrorFeatureFlagNotFound.Code: http.StatusNotFound,
+	ErrorFeatureFlagConflict.Code: http.StatusConflict,
+}
@@ -0,0 +1,151 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package featureflag
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/system/error/apierror"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+)
+
+type HandlerTestSuite struct {
+	suite.Suite
+	mockService *FeatureFlagServiceInterfaceMock
+	handler     *featureFlagHandler
+}
+
+func TestHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(HandlerTestSuite))
+}
+
+func (s *HandlerTestSuite) SetupTest() {
+	s.mockService = NewFeatureFlagServiceInterfaceMock(s.T())
+	s.handler = newFeatureFlagHandler(s.mockService)
+}
+
+func (s *HandlerTestSuite) TestHandleFlagPostRequest_Success() {
+	s.mockService.On("CreateFlag", mock.Anything, FeatureFlagRequest{
+		Key:     testFlagKey,
+		Enabled: true,
+		Rules:   []TargetingRule{{TenantIDs: []string{testTenantID}, Percentage: percentage(25), Value: true}},
+	}).Return(&FeatureFlag{Key: testFlagKey, Enabled: true}, nil)
+
+	req := httptest.NewRequest(http.MethodPost, "/admin/feature-flags", strings.NewReader(
+		`{"key":"token-pipeline.v2","enabled":true,"rules":[{"tenantIds":["tenant-1"],"percentage":25,"value":true}]}`))
+	rr := httptest.NewRecorder()
+	s.handler.HandleFlagPostRequest(rr, req)
+
+	s.Equal(http.StatusCreated, rr.Code)
+	var body FeatureFlag
+	s.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &body))
+	s.Equal(testFlagKey, body.Key)
+}
+
+func (s *HandlerTestSuite) TestHandleFlagPostRequest_InvalidBody() {
+	req := httptest.NewRequest(http.MethodPost, "/admin/feature-flags", strings.NewReader(`{`))
+	rr := httptest.NewRecorder()
+	s.handler.HandleFlagPostRequest(rr, req)
+
+	s.Equal(http.StatusBadRequest, rr.Code)
+	var errResp apierror.ErrorResponse
+	s.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &errResp))
+	s.Equal(ErrorInvalidRequestFormat.Code, errResp.Code)
+}
+
+func (s *HandlerTestSuite) TestHandleFlagPostRequest_Conflict() {
+	s.mockService.On("CreateFlag", mock.Anything, mock.Anything).Return(nil, &ErrorFeatureFlagConflict)
+
+	req := httptest.NewRequest(http.MethodPost, "/admin/feature-flags", strings.NewReader(`{"key":"a"}`))
+	rr := httptest.NewRecorder()
+	s.handler.HandleFlagPostRequest(rr, req)
+
+	s.Equal(http.StatusConflict, rr.Code)
+}
+
+func (s *HandlerTestSuite) TestHandleFlagGetRequest_NotFound() {
+	s.mockService.On("GetFlag", mock.Anything, testFlagKey).Return(nil, &ErrorFeatureFlagNotFound)
+
+	req := httptest.NewRequest(http.MethodGet, "/admin/feature-flags/"+testFlagKey, nil)
+	req.SetPathValue("key", testFlagKey)
+	rr := httptest.NewRecorder()
+	s.handler.HandleFlagGetRequest(rr, req)
+
+	s.Equal(http.StatusNotFound, rr.Code)
+}
+
+func (s *HandlerTestSuite) TestHandleFlagPutRequest_Success() {
+	s.mockService.On("UpdateFlag", mock.Anything, testFlagKey, FeatureFlagRequest{DefaultValue: true}).
+		Return(&FeatureFlag{Key: testFlagKey, DefaultValue: true}, nil)
+
+	req := httptest.NewRequest(http.MethodPut, "/admin/feature-flags/"+testFlagKey,
+		strings.NewReader(`{"defaultValue":true}`))
+	req.SetPathValue("key", testFlagKey)
+	rr := httptest.NewRecorder()
+	s.handler.HandleFlagPutRequest(rr, req)
+
+	s.Equal(http.StatusOK, rr.Code)
+}
+
+func (s *HandlerTestSuite) TestHandleFlagDeleteRequest() {
+	s.mockService.On("DeleteFlag", mock.Anything, testFlagKey).Return(nil)
+
+	req := httptest.NewRequest(http.MethodDelete, "/admin/feature-flags/"+testFlagKey, nil)
+	req.SetPathValue("key", testFlagKey)
+	rr := httptest.NewRecorder()
+	s.handler.HandleFlagDeleteRequest(rr, req)
+
+	s.Equal(http.StatusNoContent, rr.Code)
+}
+
+func (s *HandlerTestSuite) TestHandleEvaluationListRequest() {
+	evalCtx := EvaluationContext{TenantID: testTenantID, OUID: testOUID}
+	s.mockService.On("EvaluateAll", mock.Anything, evalCtx).Return(&EvaluationListResponse{
+		Context:     EvaluationContext{DeploymentID: testDeploymentID, TenantID: testTenantID, OUID: testOUID},
+		Evaluations: []Evaluation{{Key: testFlagKey, Value: true, Reason: ReasonDefault}},
+	}, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/admin/feature-flag-evaluations?tenantId=tenant-1&ouId=ou-1", nil)
+	rr := httptest.NewRecorder()
+	s.handler.HandleEvaluationListRequest(rr, req)
+
+	s.Equal(http.StatusOK, rr.Code)
+	var body EvaluationListResponse
+	s.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &body))
+	s.Equal(testDeploymentID, body.Context.DeploymentID)
+	s.Require().Len(body.Evaluations, 1)
+	s.True(body.Evaluations[0].Value)
+}
+
+func (s *HandlerTestSuite) TestHandleEvaluationListRequest_ServerError() {
+	s.mockService.On("EvaluateAll", mock.Anything, mock.Anything).Return(nil, &serviceerror.InternalServerError)
+
+	req := httptest.NewRequest(http.MethodGet, "/admin/feature-flag-evaluations", nil)
+	rr := httptest.NewRecorder()
+	s.handler.HandleEvaluationListRequest(rr, req)
+
+	s.Equal(http.StatusInternalServerError, rr.Code)
+}
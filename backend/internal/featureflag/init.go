@@ -0,0 +1,84 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package featureflag
+
+import (
+	"net/http"
+
+	"github.com/thunder-id/thunderid/internal/system/middleware"
+)
+
+// Initialize initializes the feature flag service and registers its routes. Services that roll out
+// features progressively check their flags through the returned service.
+func Initialize(mux *http.ServeMux) FeatureFlagServiceInterface {
+	featureFlagService := newFeatureFlagService(newFeatureFlagStore())
+
+	featureFlagHandler := newFeatureFlagHandler(featureFlagService)
+	registerRoutes(mux, featureFlagHandler)
+
+	return featureFlagService
+}
+
+// registerRoutes registers the routes for feature flag management operations.
+func registerRoutes(mux *http.ServeMux, featureFlagHandler *featureFlagHandler) {
+	opts1 := middleware.CORSOptions{
+		AllowedMethods:   []string{"GET", "POST"},
+		AllowedHeaders:   middleware.DefaultAllowedHeaders,
+		AllowCredentials: true,
+		MaxAge:           600,
+	}
+	mux.HandleFunc(middleware.WithCORS("POST /admin/feature-flags",
+		featureFlagHandler.HandleFlagPostRequest, opts1))
+	mux.HandleFunc(middleware.WithCORS("GET /admin/feature-flags",
+		featureFlagHandler.HandleFlagListRequest, opts1))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS /admin/feature-flags",
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, opts1))
+
+	opts2 := middleware.CORSOptions{
+		AllowedMethods:   []string{"GET", "PUT", "DELETE"},
+		AllowedHeaders:   middleware.DefaultAllowedHeaders,
+		AllowCredentials: true,
+		MaxAge:           600,
+	}
+	mux.HandleFunc(middleware.WithCORS("GET /admin/feature-flags/{key}",
+		featureFlagHandler.HandleFlagGetRequest, opts2))
+	mux.HandleFunc(middleware.WithCORS("PUT /admin/feature-flags/{key}",
+		featureFlagHandler.HandleFlagPutRequest, opts2))
+	mux.HandleFunc(middleware.WithCORS("DELETE /admin/feature-flags/{key}",
+		featureFlagHandler.HandleFlagDeleteRequest, opts2))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS /admin/feature-flags/{key}",
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, opts2))
+
+	opts3 := middleware.CORSOptions{
+		AllowedMethods:   []string{"GET"},
+		AllowedHeaders:   middleware.DefaultAllowedHeaders,
+		AllowCredentials: true,
+		MaxAge:           600,
+	}
+	mux.HandleFunc(middleware.WithCORS("GET /admin/feature-flag-evaluations",
+		featureFlagHandler.HandleEvaluationListRequest, opts3))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS /admin/feature-flag-evaluations",
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, opts3))
+}
@@ -0,0 +1,83 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package featureflag provides feature flags for the progressive rollout of backend features to
+// deployments, tenants and organization units.
+package featureflag
+
+import "time"
+
+// FeatureFlag is a switch for a backend feature. A disabled flag is off for every context. An enabled
+// flag serves the value of its first targeting rule that matches the context, or its default value when
+// no rule matches.
+type FeatureFlag struct {
+	Key          string          `json:"key"`
+	Description  string          `json:"description,omitempty"`
+	Enabled      bool            `json:"enabled"`
+	DefaultValue bool            `json:"defaultValue"`
+	Rules        []TargetingRule `json:"rules"`
+	CreatedAt    time.Time       `json:"createdAt"`
+	UpdatedAt    time.Time       `json:"updatedAt"`
+}
+
+// TargetingRule serves a value to the contexts matching all of its conditions. Conditions that are not
+// set match every context.
+type TargetingRule struct {
+	DeploymentIDs []string `json:"deploymentIds,omitempty"`
+	TenantIDs     []string `json:"tenantIds,omitempty"`
+	OUIDs         []string `json:"ouIds,omitempty"`
+	// Percentage limits the rule to a stable share of the contexts, from 0 to 100.
+	Percentage *int `json:"percentage,omitempty"`
+	Value      bool `json:"value"`
+}
+
+// FeatureFlagRequest represents the request body for creating or updating a feature flag.
+type FeatureFlagRequest struct {
+	Key          string          `json:"key"`
+	Description  string          `json:"description,omitempty"`
+	Enabled      bool            `json:"enabled"`
+	DefaultValue bool            `json:"defaultValue"`
+	Rules        []TargetingRule `json:"rules,omitempty"`
+}
+
+// FeatureFlagListResponse represents the response for listing feature flags.
+type FeatureFlagListResponse struct {
+	TotalResults int           `json:"totalResults"`
+	Flags        []FeatureFlag `json:"flags"`
+}
+
+// EvaluationContext identifies the deployment, tenant and organization unit a flag is evaluated for.
+type EvaluationContext struct {
+	DeploymentID string `json:"deploymentId"`
+	TenantID     string `json:"tenantId,omitempty"`
+	OUID         string `json:"ouId,omitempty"`
+}
+
+// Evaluation is the value a flag evaluates to for a context.
+type Evaluation struct {
+	Key       string `json:"key"`
+	Value     bool   `json:"value"`
+	Reason    string `json:"reason"`
+	RuleIndex *int   `json:"ruleIndex,omitempty"`
+}
+
+// EvaluationListResponse represents the response for evaluating all feature flags for a context.
+type EvaluationListResponse struct {
+	Context     EvaluationContext `json:"context"`
+	Evaluations []Evaluation      `json:"evaluations"`
+}
@@ -0,0 +1,308 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package featureflag
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+	sysContext "github.com/thunder-id/thunderid/internal/system/context"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/internal/system/security"
+)
+
+// flagKeyPattern restricts flag keys to values that are safe to reference from code and URLs.
+var flagKeyPattern = regexp.MustCompile(`^[a-z0-9][a-z0-9._-]*$`)
+
+// FeatureFlagServiceInterface defines the interface for the feature flag service.
+type FeatureFlagServiceInterface interface {
+	CreateFlag(ctx context.Context, request FeatureFlagRequest) (*FeatureFlag, *serviceerror.ServiceError)
+	GetFlagList(ctx context.Context) (*FeatureFlagListResponse, *serviceerror.ServiceError)
+	GetFlag(ctx context.Context, key string) (*FeatureFlag, *serviceerror.ServiceError)
+	UpdateFlag(ctx context.Context, key string, request FeatureFlagRequest) (*FeatureFlag, *serviceerror.ServiceError)
+	DeleteFlag(ctx context.Context, key string) *serviceerror.ServiceError
+	Evaluate(ctx context.Context, key string, evalCtx EvaluationContext) (*Evaluation, *serviceerror.ServiceError)
+	EvaluateAll(ctx context.Context, evalCtx EvaluationContext) (*EvaluationListResponse, *serviceerror.ServiceError)
+	IsEnabled(ctx context.Context, key string) bool
+}
+
+// featureFlagService is the default implementation of the FeatureFlagServiceInterface.
+type featureFlagService struct {
+	store        featureFlagStoreInterface
+	deploymentID string
+	now          func() time.Time
+	logger       *log.Logger
+}
+
+// newFeatureFlagService creates a new instance of featureFlagService.
+func newFeatureFlagService(store featureFlagStoreInterface) FeatureFlagServiceInterface {
+	return &featureFlagService{
+		store:        store,
+		deploymentID: config.GetServerRuntime().Config.Server.Identifier,
+		now:          time.Now,
+		logger:       log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName)),
+	}
+}
+
+// CreateFlag creates a feature flag.
+func (s *featureFlagService) CreateFlag(
+	ctx context.Context, request FeatureFlagRequest) (*FeatureFlag, *serviceerror.ServiceError) {
+	key := strings.TrimSpace(request.Key)
+	if len(key) > maxFlagKeyLength || !flagKeyPattern.MatchString(key) {
+		return nil, &ErrorInvalidFlagKey
+	}
+	flag, svcErr := buildFlag(key, request)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+
+	if _, err := s.store.GetFlag(ctx, key); err == nil {
+		return nil, &ErrorFeatureFlagConflict
+	} else if !errors.Is(err, ErrFeatureFlagNotFound) {
+		s.logger.Error("Failed to get feature flag", log.Error(err), log.String("flagKey", key))
+		return nil, &serviceerror.InternalServerError
+	}
+
+	flag.CreatedAt = s.now().UTC()
+	flag.UpdatedAt = flag.CreatedAt
+	if err := s.store.CreateFlag(ctx, *flag); err != nil {
+		s.logger.Error("Failed to create feature flag", log.Error(err), log.String("flagKey", key))
+		return nil, &serviceerror.InternalServerError
+	}
+
+	s.logger.Debug("Created feature flag", log.String("flagKey", key))
+	return flag, nil
+}
+
+// GetFlagList retrieves all feature flags.
+func (s *featureFlagService) GetFlagList(ctx context.Context) (*FeatureFlagListResponse, *serviceerror.ServiceError) {
+	flags, svcErr := s.getFlags(ctx)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	return &FeatureFlagListResponse{
+		TotalResults: len(flags),
+		Flags:        flags,
+	}, nil
+}
+
+// GetFlag retrieves a feature flag by its key.
+func (s *featureFlagService) GetFlag(ctx context.Context, key string) (*FeatureFlag, *serviceerror.ServiceError) {
+	return s.getFlag(ctx, key)
+}
+
+// UpdateFlag replaces the description, state and targeting rules of a feature flag. The key of a flag
+// cannot be changed.
+func (s *featureFlagService) UpdateFlag(
+	ctx context.Context, key string, request FeatureFlagRequest) (*FeatureFlag, *serviceerror.ServiceError) {
+	existing, svcErr := s.getFlag(ctx, key)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	flag, svcErr := buildFlag(existing.Key, request)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+
+	flag.CreatedAt = existing.CreatedAt
+	flag.UpdatedAt = s.now().UTC()
+	if err := s.store.UpdateFlag(ctx, *flag); err != nil {
+		s.logger.Error("Failed to update feature flag", log.Error(err), log.String("flagKey", key))
+		return nil, &serviceerror.InternalServerError
+	}
+
+	s.logger.Debug("Updated feature flag", log.String("flagKey", key))
+	return flag, nil
+}
+
+// DeleteFlag deletes a feature flag. Deleting a flag that does not exist succeeds.
+func (s *featureFlagService) DeleteFlag(ctx context.Context, key string) *serviceerror.ServiceError {
+	if strings.TrimSpace(key) == "" {
+		return &ErrorFeatureFlagNotFound
+	}
+
+	if err := s.store.DeleteFlag(ctx, key); err != nil {
+		s.logger.Error("Failed to delete feature flag", log.Error(err), log.String("flagKey", key))
+		return &serviceerror.InternalServerError
+	}
+	return nil
+}
+
+// Evaluate evaluates a feature flag for a context. The deployment of the context defaults to the
+// deployment of the server.
+func (s *featureFlagService) Evaluate(ctx context.Context, key string,
+	evalCtx EvaluationContext) (*Evaluation, *serviceerror.ServiceError) {
+	flag, svcErr := s.getFlag(ctx, key)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+
+	evaluation := evaluateFlag(*flag, s.withDefaults(evalCtx))
+	return &evaluation, nil
+}
+
+// EvaluateAll evaluates every feature flag for a context. The deployment of the context defaults to the
+// deployment of the server.
+func (s *featureFlagService) EvaluateAll(
+	ctx context.Context, evalCtx EvaluationContext) (*EvaluationListResponse, *serviceerror.ServiceError) {
+	flags, svcErr := s.getFlags(ctx)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+
+	evalCtx = s.withDefaults(evalCtx)
+	evaluations := make([]Evaluation, 0, len(flags))
+	for _, flag := range flags {
+		evaluations = append(evaluations, evaluateFlag(flag, evalCtx))
+	}
+	return &EvaluationListResponse{
+		Context:     evalCtx,
+		Evaluations: evaluations,
+	}, nil
+}
+
+// IsEnabled reports whether a feature flag is on for the tenant and organization unit of the request
+// context. Services use it to guard the features they roll out progressively. Unknown flags and flags
+// that cannot be loaded are off.
+func (s *featureFlagService) IsEnabled(ctx context.Context, key string) bool {
+	flag, err := s.store.GetFlag(ctx, key)
+	if err != nil {
+		if !errors.Is(err, ErrFeatureFlagNotFound) {
+			s.logger.Error("Failed to get feature flag, treating it as off", log.Error(err),
+				log.String("flagKey", key))
+		}
+		return false
+	}
+
+	return evaluateFlag(*flag, EvaluationContext{
+		DeploymentID: s.deploymentID,
+		TenantID:     sysContext.GetTenantID(ctx),
+		OUID:         security.GetOUID(ctx),
+	}).Value
+}
+
+// getFlag retrieves a feature flag by its key and maps store errors to service errors.
+func (s *featureFlagService) getFlag(ctx context.Context, key string) (*FeatureFlag, *serviceerror.ServiceError) {
+	if strings.TrimSpace(key) == "" {
+		return nil, &ErrorFeatureFlagNotFound
+	}
+
+	flag, err := s.store.GetFlag(ctx, key)
+	if err != nil {
+		if errors.Is(err, ErrFeatureFlagNotFound) {
+			return nil, &ErrorFeatureFlagNotFound
+		}
+		s.logger.Error("Failed to get feature flag", log.Error(err), log.String("flagKey", key))
+		return nil, &serviceerror.InternalServerError
+	}
+	return flag, nil
+}
+
+// getFlags retrieves all feature flags and maps store errors to service errors.
+func (s *featureFlagService) getFlags(ctx context.Context) ([]FeatureFlag, *serviceerror.ServiceError) {
+	flags, err := s.store.GetFlagList(ctx)
+	if err != nil {
+		s.logger.Error("Failed to get feature flag list", log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+	return flags, nil
+}
+
+// withDefaults fills in the deployment of a context that does not name one.
+func (s *featureFlagService) withDefaults(evalCtx EvaluationContext) EvaluationContext {
+	if evalCtx.DeploymentID == "" {
+		evalCtx.DeploymentID = s.deploymentID
+	}
+	return evalCtx
+}
+
+// buildFlag validates a feature flag request and builds the flag it describes.
+func buildFlag(key string, request FeatureFlagRequest) (*FeatureFlag, *serviceerror.ServiceError) {
+	description := strings.TrimSpace(request.Description)
+	if utf8.RuneCountInString(description) > maxDescriptionLength {
+		return nil, &ErrorInvalidDescription
+	}
+	if len(request.Rules) > maxRules {
+		return nil, &ErrorInvalidTargetingRule
+	}
+
+	rules := make([]TargetingRule, 0, len(request.Rules))
+	for _, rule := range request.Rules {
+		normalized, ok := normalizeRule(rule)
+		if !ok {
+			return nil, &ErrorInvalidTargetingRule
+		}
+		rules = append(rules, normalized)
+	}
+
+	return &FeatureFlag{
+		Key:          key,
+		Description:  description,
+		Enabled:      request.Enabled,
+		DefaultValue: request.DefaultValue,
+		Rules:        rules,
+	}, nil
+}
+
+// normalizeRule trims the identifiers of a targeting rule and reports whether the rule is valid. A valid
+// rule has at least one condition, no empty identifiers and a percentage between 0 and 100.
+func normalizeRule(rule TargetingRule) (TargetingRule, bool) {
+	var ok bool
+	normalized := TargetingRule{Value: rule.Value, Percentage: rule.Percentage}
+	if normalized.DeploymentIDs, ok = normalizeIDs(rule.DeploymentIDs); !ok {
+		return TargetingRule{}, false
+	}
+	if normalized.TenantIDs, ok = normalizeIDs(rule.TenantIDs); !ok {
+		return TargetingRule{}, false
+	}
+	if normalized.OUIDs, ok = normalizeIDs(rule.OUIDs); !ok {
+		return TargetingRule{}, false
+	}
+
+	if rule.Percentage != nil && (*rule.Percentage < 0 || *rule.Percentage > percentageBuckets) {
+		return TargetingRule{}, false
+	}
+	if len(normalized.DeploymentIDs) == 0 && len(normalized.TenantIDs) == 0 && len(normalized.OUIDs) == 0 &&
+		rule.Percentage == nil {
+		return TargetingRule{}, false
+	}
+	return normalized, true
+}
+
+// normalizeIDs trims the identifiers of a rule condition and reports whether none of them is empty.
+func normalizeIDs(ids []string) ([]string, bool) {
+	if len(ids) == 0 {
+		return nil, true
+	}
+	normalized := make([]string, 0, len(ids))
+	for _, id := range ids {
+		id = strings.TrimSpace(id)
+		if id == "" {
+			return nil, false
+		}
+		normalized = append(normalized, id)
+	}
+	return normalized, true
+}
@@ -0,0 +1,313 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package featureflag
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+	sysContext "github.com/thunder-id/thunderid/internal/system/context"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/security"
+)
+
+const (
+	testDeploymentID = "deployment-1"
+	testFlagKey      = "token-pipeline.v2"
+	testTenantID     = "tenant-1"
+	testOUID         = "ou-1"
+)
+
+type FeatureFlagServiceTestSuite struct {
+	suite.Suite
+	mockStore *featureFlagStoreInterfaceMock
+	service   *featureFlagService
+	now       time.Time
+}
+
+func TestFeatureFlagServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(FeatureFlagServiceTestSuite))
+}
+
+func (suite *FeatureFlagServiceTestSuite) SetupTest() {
+	testConfig := &config.Config{Server: config.ServerConfig{Identifier: testDeploymentID}}
+	_ = config.InitializeServerRuntime("", testConfig)
+
+	suite.mockStore = newFeatureFlagStoreInterfaceMock(suite.T())
+	suite.service = newFeatureFlagService(suite.mockStore).(*featureFlagService)
+	suite.now = time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	suite.service.now = func() time.Time { return suite.now }
+}
+
+func (suite *FeatureFlagServiceTestSuite) TearDownTest() {
+	config.ResetServerRuntime()
+}
+
+func percentage(value int) *int {
+	return &value
+}
+
+func (suite *FeatureFlagServiceTestSuite) TestCreateFlag_Success() {
+	suite.mockStore.On("GetFlag", mock.Anything, testFlagKey).Return(nil, ErrFeatureFlagNotFound).Once()
+	suite.mockStore.On("CreateFlag", mock.Anything, mock.MatchedBy(func(flag FeatureFlag) bool {
+		return flag.Key == testFlagKey && flag.Enabled && len(flag.Rules) == 1 &&
+			flag.Rules[0].TenantIDs[0] == testTenantID && flag.CreatedAt.Equal(suite.now)
+	})).Return(nil).Once()
+
+	flag, svcErr := suite.service.CreateFlag(context.Background(), FeatureFlagRequest{
+		Key:         " " + testFlagKey + " ",
+		Description: "New token pipeline",
+		Enabled:     true,
+		Rules:       []TargetingRule{{TenantIDs: []string{" " + testTenantID}, Value: true}},
+	})
+
+	suite.Nil(svcErr)
+	suite.Equal(testFlagKey, flag.Key)
+	suite.Equal("New token pipeline", flag.Description)
+	suite.Equal(suite.now, flag.UpdatedAt)
+}
+
+func (suite *FeatureFlagServiceTestSuite) TestCreateFlag_ValidationErrors() {
+	testCases := []struct {
+		name     string
+		request  FeatureFlagRequest
+		expected string
+	}{
+		{"EmptyKey", FeatureFlagRequest{}, ErrorInvalidFlagKey.Code},
+		{"UppercaseKey", FeatureFlagRequest{Key: "Token"}, ErrorInvalidFlagKey.Code},
+		{"KeyWithSlash", FeatureFlagRequest{Key: "token/v2"}, ErrorInvalidFlagKey.Code},
+		{"LongKey", FeatureFlagRequest{Key: strings.Repeat("a", maxFlagKeyLength+1)}, ErrorInvalidFlagKey.Code},
+		{"LongDescription", FeatureFlagRequest{Key: testFlagKey,
+			Description: strings.Repeat("a", maxDescriptionLength+1)}, ErrorInvalidDescription.Code},
+		{"RuleWithoutCondition", FeatureFlagRequest{Key: testFlagKey,
+			Rules: []TargetingRule{{Value: true}}}, ErrorInvalidTargetingRule.Code},
+		{"EmptyIdentifier", FeatureFlagRequest{Key: testFlagKey,
+			Rules: []TargetingRule{{OUIDs: []string{" "}}}}, ErrorInvalidTargetingRule.Code},
+		{"NegativePercentage", FeatureFlagRequest{Key: testFlagKey,
+			Rules: []TargetingRule{{Percentage: percentage(-1)}}}, ErrorInvalidTargetingRule.Code},
+		{"PercentageAbove100", FeatureFlagRequest{Key: testFlagKey,
+			Rules: []TargetingRule{{Percentage: percentage(101)}}}, ErrorInvalidTargetingRule.Code},
+		{"TooManyRules", FeatureFlagRequest{Key: testFlagKey,
+			Rules: make([]TargetingRule, maxRules+1)}, ErrorInvalidTargetingRule.Code},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			flag, svcErr := suite.service.CreateFlag(context.Background(), tc.request)
+			suite.Nil(flag)
+			suite.Require().NotNil(svcErr)
+			suite.Equal(tc.expected, svcErr.Code)
+		})
+	}
+}
+
+func (suite *FeatureFlagServiceTestSuite) TestCreateFlag_Conflict() {
+	suite.mockStore.On("GetFlag", mock.Anything, testFlagKey).Return(&FeatureFlag{Key: testFlagKey}, nil).Once()
+
+	flag, svcErr := suite.service.CreateFlag(context.Background(), FeatureFlagRequest{Key: testFlagKey})
+
+	suite.Nil(flag)
+	suite.Equal(&ErrorFeatureFlagConflict, svcErr)
+}
+
+func (suite *FeatureFlagServiceTestSuite) TestCreateFlag_StoreError() {
+	suite.mockStore.On("GetFlag", mock.Anything, testFlagKey).Return(nil, ErrFeatureFlagNotFound).Once()
+	suite.mockStore.On("CreateFlag", mock.Anything, mock.Anything).Return(errors.New("db error")).Once()
+
+	flag, svcErr := suite.service.CreateFlag(context.Background(), FeatureFlagRequest{Key: testFlagKey})
+
+	suite.Nil(flag)
+	suite.Equal(&serviceerror.InternalServerError, svcErr)
+}
+
+func (suite *FeatureFlagServiceTestSuite) TestGetFlag_NotFound() {
+	suite.mockStore.On("GetFlag", mock.Anything, testFlagKey).Return(nil, ErrFeatureFlagNotFound).Once()
+
+	flag, svcErr := suite.service.GetFlag(context.Background(), testFlagKey)
+
+	suite.Nil(flag)
+	suite.Equal(&ErrorFeatureFlagNotFound, svcErr)
+}
+
+func (suite *FeatureFlagServiceTestSuite) TestGetFlagList() {
+	flags := []FeatureFlag{{Key: "a"}, {Key: "b"}}
+	suite.mockStore.On("GetFlagList", mock.Anything).Return(flags, nil).Once()
+
+	response, svcErr := suite.service.GetFlagList(context.Background())
+
+	suite.Nil(svcErr)
+	suite.Equal(2, response.TotalResults)
+	suite.Equal(flags, response.Flags)
+}
+
+func (suite *FeatureFlagServiceTestSuite) TestUpdateFlag_KeepsKeyAndCreationTime() {
+	createdAt := suite.now.Add(-time.Hour)
+	suite.mockStore.On("GetFlag", mock.Anything, testFlagKey).
+		Return(&FeatureFlag{Key: testFlagKey, CreatedAt: createdAt}, nil).Once()
+	suite.mockStore.On("UpdateFlag", mock.Anything, mock.MatchedBy(func(flag FeatureFlag) bool {
+		return flag.Key == testFlagKey && flag.CreatedAt.Equal(createdAt) && flag.UpdatedAt.Equal(suite.now) &&
+			flag.Enabled && flag.DefaultValue
+	})).Return(nil).Once()
+
+	flag, svcErr := suite.service.UpdateFlag(context.Background(), testFlagKey,
+		FeatureFlagRequest{Key: "other", Enabled: true, DefaultValue: true})
+
+	suite.Nil(svcErr)
+	suite.Equal(testFlagKey, flag.Key)
+}
+
+func (suite *FeatureFlagServiceTestSuite) TestUpdateFlag_NotFound() {
+	suite.mockStore.On("GetFlag", mock.Anything, testFlagKey).Return(nil, ErrFeatureFlagNotFound).Once()
+
+	flag, svcErr := suite.service.UpdateFlag(context.Background(), testFlagKey, FeatureFlagRequest{})
+
+	suite.Nil(flag)
+	suite.Equal(&ErrorFeatureFlagNotFound, svcErr)
+}
+
+func (suite *FeatureFlagServiceTestSuite) TestDeleteFlag() {
+	suite.mockStore.On("DeleteFlag", mock.Anything, testFlagKey).Return(nil).Once()
+
+	suite.Nil(suite.service.DeleteFlag(context.Background(), testFlagKey))
+	suite.Equal(&ErrorFeatureFlagNotFound, suite.service.DeleteFlag(context.Background(), " "))
+}
+
+func (suite *FeatureFlagServiceTestSuite) TestEvaluate() {
+	flag := &FeatureFlag{
+		Key:          testFlagKey,
+		Enabled:      true,
+		DefaultValue: false,
+		Rules: []TargetingRule{
+			{OUIDs: []string{"ou-blocked"}, Value: false},
+			{TenantIDs: []string{testTenantID}, Value: true},
+			{DeploymentIDs: []string{"deployment-2"}, Value: true},
+		},
+	}
+	testCases := []struct {
+		name      string
+		evalCtx   EvaluationContext
+		value     bool
+		reason    string
+		ruleIndex *int
+	}{
+		{"FirstMatchingRuleWins", EvaluationContext{TenantID: testTenantID, OUID: "ou-blocked"},
+			false, ReasonRuleMatch, percentage(0)},
+		{"TenantRule", EvaluationContext{TenantID: testTenantID, OUID: testOUID}, true, ReasonRuleMatch,
+			percentage(1)},
+		{"DeploymentRule", EvaluationContext{DeploymentID: "deployment-2"}, true, ReasonRuleMatch, percentage(2)},
+		{"NoMatchingRule", EvaluationContext{TenantID: "tenant-2"}, false, ReasonDefault, nil},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.mockStore.On("GetFlag", mock.Anything, testFlagKey).Return(flag, nil).Once()
+
+			evaluation, svcErr := suite.service.Evaluate(context.Background(), testFlagKey, tc.evalCtx)
+
+			suite.Nil(svcErr)
+			suite.Equal(tc.value, evaluation.Value)
+			suite.Equal(tc.reason, evaluation.Reason)
+			suite.Equal(tc.ruleIndex, evaluation.RuleIndex)
+		})
+	}
+}
+
+func (suite *FeatureFlagServiceTestSuite) TestEvaluate_DisabledFlag() {
+	suite.mockStore.On("GetFlag", mock.Anything, testFlagKey).Return(&FeatureFlag{
+		Key:          testFlagKey,
+		DefaultValue: true,
+		Rules:        []TargetingRule{{TenantIDs: []string{testTenantID}, Value: true}},
+	}, nil).Once()
+
+	evaluation, svcErr := suite.service.Evaluate(context.Background(), testFlagKey,
+		EvaluationContext{TenantID: testTenantID})
+
+	suite.Nil(svcErr)
+	suite.False(evaluation.Value)
+	suite.Equal(ReasonDisabled, evaluation.Reason)
+}
+
+func (suite *FeatureFlagServiceTestSuite) TestEvaluateAll_DefaultsToServerDeployment() {
+	suite.mockStore.On("GetFlagList", mock.Anything).Return([]FeatureFlag{
+		{Key: "a", Enabled: true, Rules: []TargetingRule{{DeploymentIDs: []string{testDeploymentID}, Value: true}}},
+		{Key: "b", Enabled: true, DefaultValue: true},
+	}, nil).Once()
+
+	response, svcErr := suite.service.EvaluateAll(context.Background(), EvaluationContext{TenantID: testTenantID})
+
+	suite.Nil(svcErr)
+	suite.Equal(EvaluationContext{DeploymentID: testDeploymentID, TenantID: testTenantID}, response.Context)
+	suite.Require().Len(response.Evaluations, 2)
+	suite.True(response.Evaluations[0].Value)
+	suite.Equal(ReasonRuleMatch, response.Evaluations[0].Reason)
+	suite.True(response.Evaluations[1].Value)
+	suite.Equal(ReasonDefault, response.Evaluations[1].Reason)
+}
+
+func (suite *FeatureFlagServiceTestSuite) TestIsEnabled_UsesRequestContext() {
+	suite.mockStore.On("GetFlag", mock.Anything, testFlagKey).Return(&FeatureFlag{
+		Key:     testFlagKey,
+		Enabled: true,
+		Rules:   []TargetingRule{{TenantIDs: []string{testTenantID}, OUIDs: []string{testOUID}, Value: true}},
+	}, nil).Twice()
+
+	ctx := sysContext.WithTenantID(context.Background(), testTenantID)
+	suite.False(suite.service.IsEnabled(ctx, testFlagKey))
+
+	ctx = security.WithSecurityContextTest(ctx, security.NewSecurityContextForTest("user-1", testOUID, "", nil, nil))
+	suite.True(suite.service.IsEnabled(ctx, testFlagKey))
+}
+
+func (suite *FeatureFlagServiceTestSuite) TestIsEnabled_FlagUnavailable() {
+	suite.mockStore.On("GetFlag", mock.Anything, "unknown").Return(nil, ErrFeatureFlagNotFound).Once()
+	suite.mockStore.On("GetFlag", mock.Anything, testFlagKey).Return(nil, errors.New("db error")).Once()
+
+	suite.False(suite.service.IsEnabled(context.Background(), "unknown"))
+	suite.False(suite.service.IsEnabled(context.Background(), testFlagKey))
+}
+
+func (suite *FeatureFlagServiceTestSuite) TestPercentageRollout() {
+	rollout := func(value int) FeatureFlag {
+		return FeatureFlag{Key: testFlagKey, Enabled: true,
+			Rules: []TargetingRule{{Percentage: percentage(value), Value: true}}}
+	}
+
+	enabled := 0
+	for i := 0; i < 1000; i++ {
+		evalCtx := EvaluationContext{DeploymentID: testDeploymentID, TenantID: testTenantID, OUID: fmt.Sprintf("ou-%d", i)}
+		bucket := rolloutBucket(testFlagKey, evalCtx)
+		suite.Equal(bucket, rolloutBucket(testFlagKey, evalCtx))
+
+		suite.False(evaluateFlag(rollout(0), evalCtx).Value)
+		suite.True(evaluateFlag(rollout(100), evalCtx).Value)
+		if evaluateFlag(rollout(30), evalCtx).Value {
+			enabled++
+			suite.True(evaluateFlag(rollout(60), evalCtx).Value)
+		}
+	}
+	suite.InDelta(300, enabled, 60)
+}
@@ -0,0 +1,218 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package featureflag
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/database/provider"
+	dbutils "github.com/thunder-id/thunderid/internal/system/database/utils"
+)
+
+var getDBProvider = provider.GetDBProvider
+
+// featureFlagStoreInterface defines the interface for feature flag store operations.
+type featureFlagStoreInterface interface {
+	CreateFlag(ctx context.Context, flag FeatureFlag) error
+	GetFlagList(ctx context.Context) ([]FeatureFlag, error)
+	GetFlag(ctx context.Context, key string) (*FeatureFlag, error)
+	UpdateFlag(ctx context.Context, flag FeatureFlag) error
+	DeleteFlag(ctx context.Context, key string) error
+}
+
+// featureFlagStore is the default implementation of featureFlagStoreInterface. Flags belong to the
+// deployment rather than to a tenant, since their targeting rules select the tenants they apply to.
+type featureFlagStore struct {
+	dbProvider   provider.DBProviderInterface
+	deploymentID string
+}
+
+// newFeatureFlagStore creates a new instance of featureFlagStore.
+func newFeatureFlagStore() featureFlagStoreInterface {
+	return &featureFlagStore{
+		dbProvider:   getDBProvider(),
+		deploymentID: config.GetServerRuntime().Config.Server.Identifier,
+	}
+}
+
+// CreateFlag persists a new feature flag.
+func (s *featureFlagStore) CreateFlag(ctx context.Context, flag FeatureFlag) error {
+	dbClient, err := s.dbProvider.GetConfigDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	rules, err := json.Marshal(flag.Rules)
+	if err != nil {
+		return fmt.Errorf("failed to marshal targeting rules: %w", err)
+	}
+
+	if _, err := dbClient.ExecuteContext(ctx, queryCreateFeatureFlag, flag.Key, flag.Description, flag.Enabled,
+		flag.DefaultValue, string(rules), flag.CreatedAt, flag.UpdatedAt, s.deploymentID); err != nil {
+		return fmt.Errorf("failed to execute query: %w", err)
+	}
+	return nil
+}
+
+// GetFlagList retrieves all feature flags ordered by key.
+func (s *featureFlagStore) GetFlagList(ctx context.Context) ([]FeatureFlag, error) {
+	dbClient, err := s.dbProvider.GetConfigDBClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	results, err := dbClient.QueryContext(ctx, queryGetFeatureFlagList, s.deploymentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	flags := make([]FeatureFlag, 0, len(results))
+	for _, row := range results {
+		flag, err := buildFeatureFlagFromResultRow(row)
+		if err != nil {
+			return nil, fmt.Errorf("failed to build feature flag from result row: %w", err)
+		}
+		flags = append(flags, *flag)
+	}
+	return flags, nil
+}
+
+// GetFlag retrieves a feature flag by its key.
+func (s *featureFlagStore) GetFlag(ctx context.Context, key string) (*FeatureFlag, error) {
+	dbClient, err := s.dbProvider.GetConfigDBClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	results, err := dbClient.QueryContext(ctx, queryGetFeatureFlagByKey, key, s.deploymentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+	if len(results) == 0 {
+		return nil, ErrFeatureFlagNotFound
+	}
+	if len(results) != 1 {
+		return nil, fmt.Errorf("unexpected number of results: %d", len(results))
+	}
+
+	return buildFeatureFlagFromResultRow(results[0])
+}
+
+// UpdateFlag updates a feature flag.
+func (s *featureFlagStore) UpdateFlag(ctx context.Context, flag FeatureFlag) error {
+	dbClient, err := s.dbProvider.GetConfigDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	rules, err := json.Marshal(flag.Rules)
+	if err != nil {
+		return fmt.Errorf("failed to marshal targeting rules: %w", err)
+	}
+
+	if _, err := dbClient.ExecuteContext(ctx, queryUpdateFeatureFlag, flag.Key, flag.Description, flag.Enabled,
+		flag.DefaultValue, string(rules), flag.UpdatedAt, s.deploymentID); err != nil {
+		return fmt.Errorf("failed to execute query: %w", err)
+	}
+	return nil
+}
+
+// DeleteFlag deletes a feature flag by its key.
+func (s *featureFlagStore) DeleteFlag(ctx context.Context, key string) error {
+	dbClient, err := s.dbProvider.GetConfigDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	if _, err := dbClient.ExecuteContext(ctx, queryDeleteFeatureFlag, key, s.deploymentID); err != nil {
+		return fmt.Errorf("failed to execute query: %w", err)
+	}
+	return nil
+}
+
+// buildFeatureFlagFromResultRow constructs a FeatureFlag from a database result row.
+func buildFeatureFlagFromResultRow(row map[string]interface{}) (*FeatureFlag, error) {
+	key, ok := row["flag_key"].(string)
+	if !ok {
+		return nil, fmt.Errorf("failed to parse flag_key as string")
+	}
+	enabled, err := parseBool(row["enabled"], "enabled")
+	if err != nil {
+		return nil, err
+	}
+	defaultValue, err := parseBool(row["default_value"], "default_value")
+	if err != nil {
+		return nil, err
+	}
+
+	var rulesJSON []byte
+	switch v := row["rules"].(type) {
+	case string:
+		rulesJSON = []byte(v)
+	case []byte:
+		rulesJSON = v
+	default:
+		return nil, fmt.Errorf("failed to parse rules")
+	}
+	rules := []TargetingRule{}
+	if err := json.Unmarshal(rulesJSON, &rules); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal rules: %w", err)
+	}
+
+	createdAt, err := dbutils.ParseTimeField(row["created_at"], "created_at")
+	if err != nil {
+		return nil, err
+	}
+	updatedAt, err := dbutils.ParseTimeField(row["updated_at"], "updated_at")
+	if err != nil {
+		return nil, err
+	}
+	description, _ := row["description"].(string)
+
+	return &FeatureFlag{
+		Key:          key,
+		Description:  description,
+		Enabled:      enabled,
+		DefaultValue: defaultValue,
+		Rules:        rules,
+		CreatedAt:    createdAt,
+		UpdatedAt:    updatedAt,
+	}, nil
+}
+
+// parseBool parses a boolean field from the database result. SQLite returns booleans as integers.
+func parseBool(value interface{}, fieldName string) (bool, error) {
+	switch v := value.(type) {
+	case bool:
+		return v, nil
+	case int64:
+		return v != 0, nil
+	case float64:
+		return v != 0, nil
+	case string:
+		return v == "1" || v == "true", nil
+	case []byte:
+		return string(v) == "1" || string(v) == "true", nil
+	default:
+		return false, fmt.Errorf("failed to parse %s as bool", fieldName)
+	}
+}
@@ -0,0 +1,53 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package featureflag
+
+import "github.com/thunder-id/thunderid/internal/system/database/model"
+
+const featureFlagColumns = `FLAG_KEY, DESCRIPTION, ENABLED, DEFAULT_VALUE, RULES, CREATED_AT, UPDATED_AT`
+
+var (
+	// queryCreateFeatureFlag is the query to create a new feature flag.
+	queryCreateFeatureFlag = model.DBQuery{
+		ID: "FFQ-FLAG_MGT-01",
+		Query: `INSERT INTO "FEATURE_FLAG" (` + featureFlagColumns + `, DEPLOYMENT_ID) ` +
+			`VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`,
+	}
+	// queryGetFeatureFlagByKey is the query to get a feature flag by its key.
+	queryGetFeatureFlagByKey = model.DBQuery{
+		ID:    "FFQ-FLAG_MGT-02",
+		Query: `SELECT ` + featureFlagColumns + ` FROM "FEATURE_FLAG" WHERE FLAG_KEY = $1 AND DEPLOYMENT_ID = $2`,
+	}
+	// queryGetFeatureFlagList is the query to get the list of feature flags.
+	queryGetFeatureFlagList = model.DBQuery{
+		ID:    "FFQ-FLAG_MGT-03",
+		Query: `SELECT ` + featureFlagColumns + ` FROM "FEATURE_FLAG" WHERE DEPLOYMENT_ID = $1 ORDER BY FLAG_KEY`,
+	}
+	// queryUpdateFeatureFlag is the query to update a feature flag by its key.
+	queryUpdateFeatureFlag = model.DBQuery{
+		ID: "FFQ-FLAG_MGT-04",
+		Query: `UPDATE "FEATURE_FLAG" SET DESCRIPTION = $2, ENABLED = $3, DEFAULT_VALUE = $4, RULES = $5, ` +
+			`UPDATED_AT = $6 WHERE FLAG_KEY = $1 AND DEPLOYMENT_ID = $7`,
+	}
+	// queryDeleteFeatureFlag is the query to delete a feature flag by its key.
+	queryDeleteFeatureFlag = model.DBQuery{
+		ID:    "FFQ-FLAG_MGT-05",
+		Query: `DELETE FROM "FEATURE_FLAG" WHERE FLAG_KEY = $1 AND DEPLOYMENT_ID = $2`,
+	}
+)
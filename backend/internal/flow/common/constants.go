@@ -224,6 +224,26 @@ const (
 	// RuntimeKeySMSOTPPhoneAttr holds the schema attribute name used to look up the mobile number.
 	// TODO: Revisit when the generic OTP executor is implemented.
 	RuntimeKeySMSOTPPhoneAttr = "smsOTPPhoneAttr"
+	// RuntimeKeySMSOTPUnknownRecipient indicates that the SMS OTP mobile number did not match a unique user.
+	RuntimeKeySMSOTPUnknownRecipient = "smsOTPUnknownRecipient"
+	// RuntimeKeyTrustedDevice indicates whether the request comes from a trusted device of the authenticated user.
+	RuntimeKeyTrustedDevice = "trustedDevice"
+	// RuntimeKeyCredentialPolicySatisfied indicates whether the credentials of the user satisfy the credential
+	// policy of the user type.
+	RuntimeKeyCredentialPolicySatisfied = "credentialPolicySatisfied"
+	// RuntimeKeyCredentialPolicyMissing holds the comma-separated credential types the user can enroll to
+	// satisfy the credential policy of the user type.
+	RuntimeKeyCredentialPolicyMissing = "credentialPolicyMissing"
+	// RuntimeKeyBreakGlass indicates whether the authenticated user signed in with an active break-glass account.
+	RuntimeKeyBreakGlass = "breakGlass"
+	// RuntimeKeyDomainRouteMatched indicates whether the email domain of the user matched an active domain route.
+	RuntimeKeyDomainRouteMatched = "domainRouteMatched"
+	// RuntimeKeyDomainRouteOUID holds the organization unit ID of the matched domain route.
+	RuntimeKeyDomainRouteOUID = "domainRouteOuId"
+	// RuntimeKeyDomainRouteIDPID holds the identity provider ID of the matched domain route.
+	RuntimeKeyDomainRouteIDPID = "domainRouteIdpId"
+	// RuntimeKeyDomainRouteBranch holds the flow branch of the matched domain route.
+	RuntimeKeyDomainRouteBranch = "domainRouteBranch"
 	// RuntimeKeyMagicLinkUsedJti is the JWT ID claim value of a magic link token that has already been used.
 	RuntimeKeyMagicLinkUsedJti = "magicLinkUsedJti"
 	// RuntimeKeyOAuthState holds the generated OAuth state parameter for CSRF validation.
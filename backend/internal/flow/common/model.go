@@ -27,12 +27,20 @@ import (
 
 // Input represents the inputs required for a node
 type Input struct {
-	Ref         string   `json:"ref,omitempty"`
-	Identifier  string   `json:"identifier"`
-	Type        string   `json:"type"`
-	Required    bool     `json:"required"`
-	Options     []string `json:"options,omitempty"`
-	DisplayName string   `json:"-"`
+	Ref         string           `json:"ref,omitempty"`
+	Identifier  string           `json:"identifier"`
+	Type        string           `json:"type"`
+	Required    bool             `json:"required"`
+	Options     []string         `json:"options,omitempty"`
+	Validation  *InputValidation `json:"validation,omitempty"`
+	DisplayName string           `json:"-"`
+}
+
+// InputValidation holds the server-side validation rules of an input so that clients can validate
+// values before submitting them. The server remains authoritative.
+type InputValidation struct {
+	Pattern string   `json:"pattern,omitempty"`
+	Enum    []string `json:"enum,omitempty"`
 }
 
 // IsSensitive checks whether this input's type is considered sensitive.
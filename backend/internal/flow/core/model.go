@@ -38,12 +38,14 @@ type NodeContext struct {
 	CurrentAction string
 	CurrentNodeID string
 	ExecutorMode  string
+	Sandbox       bool
 
-	NodeProperties map[string]interface{}
-	NodeInputs     []common.Input
-	UserInputs     map[string]string
-	RuntimeData    map[string]string
-	ForwardedData  map[string]interface{}
+	NodeProperties    map[string]interface{}
+	NodeInputs        []common.Input
+	UserInputs        map[string]string
+	RuntimeData       map[string]string
+	ForwardedData     map[string]interface{}
+	DisallowedActions []string
 
 	Application       appmodel.Application
 	AuthenticatedUser authncm.AuthenticatedUser
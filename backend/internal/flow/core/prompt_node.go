@@ -80,6 +80,13 @@ func (n *promptNode) Execute(ctx *NodeContext) (*common.NodeResponse, *serviceer
 	logger := n.logger.With(log.String(log.LoggerKeyExecutionID, ctx.ExecutionID))
 	logger.Debug("Executing prompt node")
 
+	// Hide the actions leading to authentication methods the application does not allow.
+	if len(ctx.DisallowedActions) > 0 {
+		restricted := n.withoutActions(ctx.DisallowedActions)
+		ctx.DisallowedActions = nil
+		return restricted.Execute(ctx)
+	}
+
 	nodeResp := &common.NodeResponse{
 		Inputs:         make([]common.Input, 0),
 		AdditionalData: make(map[string]string),
@@ -326,6 +333,19 @@ func (n *promptNode) SetVariant(variant common.NodeVariant) {
 // resolvePromptInputs resolves the inputs and actions for the prompt node.
 // It checks for missing required inputs, validates action selection, attempts auto-selection
 // if applicable, and enriches inputs with dynamic data from ForwardedData.
+// withoutActions returns a copy of the prompt node without the prompts bound to the given action refs.
+func (n *promptNode) withoutActions(actionRefs []string) *promptNode {
+	restricted := *n
+	restricted.prompts = make([]common.Prompt, 0, len(n.prompts))
+	for _, prompt := range n.prompts {
+		if prompt.Action != nil && slices.Contains(actionRefs, prompt.Action.Ref) {
+			continue
+		}
+		restricted.prompts = append(restricted.prompts, prompt)
+	}
+	return &restricted
+}
+
 // Returns true if all required inputs are available and a valid action is selected, otherwise false.
 func (n *promptNode) resolvePromptInputs(ctx *NodeContext, nodeResp *common.NodeResponse) bool {
 	// Check for required inputs and collect missing ones
@@ -440,6 +460,9 @@ func (n *promptNode) enrichInputsFromForwardedData(ctx *NodeContext, nodeResp *c
 					log.String("identifier", fwdInput.Identifier),
 					log.Int("optionsCount", len(fwdInput.Options)))
 			}
+			if fwdInput.Validation != nil && nodeResp.Inputs[idx].Validation == nil {
+				nodeResp.Inputs[idx].Validation = fwdInput.Validation
+			}
 			continue
 		}
 		if _, ok := ctx.UserInputs[fwdInput.Identifier]; ok {
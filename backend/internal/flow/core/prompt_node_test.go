@@ -426,6 +426,53 @@ func (s *PromptOnlyNodeTestSuite) TestExecuteWithSets_NoActionSelected() {
 	s.Equal("username", resp.Inputs[0].Identifier)
 }
 
+func (s *PromptOnlyNodeTestSuite) TestExecuteWithDisallowedActions() {
+	node := newPromptNode("prompt-1", map[string]interface{}{}, false, false)
+	promptNode := node.(PromptNodeInterface)
+
+	promptNode.SetPrompts([]common.Prompt{
+		{
+			Inputs: []common.Input{{Identifier: "username", Required: true}},
+			Action: &common.Action{Ref: "action_001", NextNode: "basic_auth"},
+		},
+		{
+			Action: &common.Action{Ref: "action_002", NextNode: "google_auth"},
+		},
+		{
+			Action: &common.Action{Ref: "action_003", NextNode: "github_auth"},
+		},
+	})
+
+	ctx := &NodeContext{
+		ExecutionID:       "test-flow",
+		UserInputs:        map[string]string{},
+		DisallowedActions: []string{"action_001"},
+	}
+	resp, err := node.Execute(ctx)
+
+	s.Nil(err)
+	s.NotNil(resp)
+	s.Equal(common.NodeStatusIncomplete, resp.Status)
+	s.Len(resp.Actions, 2)
+	s.Equal("action_002", resp.Actions[0].Ref)
+	s.Equal("action_003", resp.Actions[1].Ref)
+	s.Empty(resp.Inputs, "Inputs of hidden prompts should not be requested")
+	s.Len(promptNode.GetPrompts(), 3, "Node prompts should not be modified")
+
+	// Selecting a hidden action is rejected as if it did not exist
+	ctx = &NodeContext{
+		ExecutionID:       "test-flow",
+		CurrentAction:     "action_001",
+		UserInputs:        map[string]string{"username": "alice"},
+		DisallowedActions: []string{"action_001"},
+	}
+	resp, err = node.Execute(ctx)
+
+	s.Nil(err)
+	s.Equal(common.NodeStatusIncomplete, resp.Status)
+	s.Len(resp.Actions, 2)
+}
+
 func (s *PromptOnlyNodeTestSuite) TestExecuteWithInvalidAction() {
 	node := newPromptNode("prompt-1", map[string]interface{}{}, false, false)
 	promptNode := node.(PromptNodeInterface)
@@ -2203,6 +2250,34 @@ func (s *PromptOnlyNodeTestSuite) TestEnrichInputsFromForwardedData_PropagatesSe
 	s.Equal([]string{"admin", "user"}, resp.Inputs[0].Options, "options must be propagated from ForwardedData")
 }
 
+func (s *PromptOnlyNodeTestSuite) TestEnrichInputsFromForwardedData_PropagatesValidation() {
+	node := newPromptNode("prompt-1", map[string]interface{}{}, false, false)
+	pn := node.(PromptNodeInterface)
+	pn.SetPrompts([]common.Prompt{
+		{
+			Inputs: []common.Input{{Identifier: "password", Type: common.InputTypePassword, Required: true}},
+			Action: &common.Action{Ref: "submit", NextNode: "next"},
+		},
+	})
+
+	validation := &common.InputValidation{Pattern: "^.{12,}$"}
+	ctx := &NodeContext{
+		ExecutionID:   "test-flow",
+		CurrentAction: "submit",
+		UserInputs:    map[string]string{},
+		ForwardedData: map[string]interface{}{
+			common.ForwardedDataKeyInputs: []common.Input{
+				{Identifier: "password", Type: common.InputTypePassword, Required: true, Validation: validation},
+			},
+		},
+	}
+	resp, err := node.Execute(ctx)
+
+	s.Nil(err)
+	s.Require().Len(resp.Inputs, 1)
+	s.Equal(validation, resp.Inputs[0].Validation, "validation must be propagated from ForwardedData")
+}
+
 func (s *PromptOnlyNodeTestSuite) TestHasRequiredInputs_UnknownActionFallsBackToAllInputs() {
 	node := newPromptNode("prompt-1", map[string]interface{}{}, false, false)
 	pn := node.(PromptNodeInterface)
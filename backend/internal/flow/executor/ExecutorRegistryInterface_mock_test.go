@@ -149,6 +149,52 @@ func (_c *ExecutorRegistryInterfaceMock_IsRegistered_Call) RunAndReturn(run func
 	return _c
 }
 
+// ListExecutors provides a mock function for the type ExecutorRegistryInterfaceMock
+func (_mock *ExecutorRegistryInterfaceMock) ListExecutors() []core.ExecutorInterface {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListExecutors")
+	}
+
+	var r0 []core.ExecutorInterface
+	if returnFunc, ok := ret.Get(0).(func() []core.ExecutorInterface); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]core.ExecutorInterface)
+		}
+	}
+	return r0
+}
+
+// ExecutorRegistryInterfaceMock_ListExecutors_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListExecutors'
+type ExecutorRegistryInterfaceMock_ListExecutors_Call struct {
+	*mock.Call
+}
+
+// ListExecutors is a helper method to define mock.On call
+func (_e *ExecutorRegistryInterfaceMock_Expecter) ListExecutors() *ExecutorRegistryInterfaceMock_ListExecutors_Call {
+	return &ExecutorRegistryInterfaceMock_ListExecutors_Call{Call: _e.mock.On("ListExecutors")}
+}
+
+func (_c *ExecutorRegistryInterfaceMock_ListExecutors_Call) Run(run func()) *ExecutorRegistryInterfaceMock_ListExecutors_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *ExecutorRegistryInterfaceMock_ListExecutors_Call) Return(executorInterfaces []core.ExecutorInterface) *ExecutorRegistryInterfaceMock_ListExecutors_Call {
+	_c.Call.Return(executorInterfaces)
+	return _c
+}
+
+func (_c *ExecutorRegistryInterfaceMock_ListExecutors_Call) RunAndReturn(run func() []core.ExecutorInterface) *ExecutorRegistryInterfaceMock_ListExecutors_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // RegisterExecutor provides a mock function for the type ExecutorRegistryInterfaceMock
 func (_mock *ExecutorRegistryInterfaceMock) RegisterExecutor(name string, ex core.ExecutorInterface) {
 	_mock.Called(name, ex)
@@ -0,0 +1,149 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package executor
+
+import (
+	"encoding/json"
+
+	authncm "github.com/thunder-id/thunderid/internal/authn/common"
+	"github.com/thunder-id/thunderid/internal/entityprovider"
+	"github.com/thunder-id/thunderid/internal/flow/common"
+	"github.com/thunder-id/thunderid/internal/flow/core"
+	"github.com/thunder-id/thunderid/internal/system/log"
+)
+
+// accountActivator activates a user account that was provisioned pending verification of its email
+// address, once the verification link sent to the user is confirmed.
+type accountActivator struct {
+	core.ExecutorInterface
+	entityProvider entityprovider.EntityProviderInterface
+	logger         *log.Logger
+}
+
+// newAccountActivator creates a new instance of the account activator executor.
+func newAccountActivator(
+	flowFactory core.FlowFactoryInterface,
+	entityProvider entityprovider.EntityProviderInterface,
+) *accountActivator {
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, "AccountActivator"))
+	base := flowFactory.CreateExecutor(
+		ExecutorNameAccountActivator,
+		common.ExecutorTypeRegistration,
+		[]common.Input{},
+		[]common.Input{
+			{
+				Identifier: userAttributeUserID,
+				Type:       common.InputTypeText,
+				Required:   true,
+			},
+		},
+	)
+	return &accountActivator{
+		ExecutorInterface: base,
+		entityProvider:    entityProvider,
+		logger:            logger,
+	}
+}
+
+// Execute activates the user identified by userID in RuntimeData and marks the user as authenticated.
+func (e *accountActivator) Execute(ctx *core.NodeContext) (*common.ExecutorResponse, error) {
+	logger := e.logger.With(log.String(log.LoggerKeyExecutionID, ctx.ExecutionID))
+	logger.Debug("Executing account activation")
+
+	execResp := &common.ExecutorResponse{
+		AdditionalData: make(map[string]string),
+		RuntimeData:    make(map[string]string),
+	}
+
+	if !e.ValidatePrerequisites(ctx, execResp) {
+		logger.Debug("Prerequisites not met for account activator")
+		return execResp, nil
+	}
+
+	userID := e.GetUserIDFromContext(ctx)
+	if userID == "" {
+		logger.Debug("User ID not found in flow context")
+		execResp.Status = common.ExecFailure
+		execResp.FailureReason = "User ID not found in flow context"
+		return execResp, nil
+	}
+
+	if ctx.Sandbox {
+		logger.Debug("Sandbox execution, skipping account activation")
+		execResp.AuthenticatedUser = ctx.AuthenticatedUser
+		execResp.AuthenticatedUser.IsAuthenticated = true
+		execResp.Status = common.ExecComplete
+		return execResp, nil
+	}
+
+	user, providerErr := e.entityProvider.GetEntity(userID)
+	if providerErr != nil {
+		if providerErr.Code == entityprovider.ErrorCodeEntityNotFound {
+			logger.Debug("User to activate not found", log.MaskedString(log.LoggerKeyUserID, userID))
+			execResp.Status = common.ExecFailure
+			execResp.FailureReason = failureReasonUserNotFound
+			return execResp, nil
+		}
+		logger.Error("Failed to retrieve the user to activate", log.MaskedString(log.LoggerKeyUserID, userID),
+			log.String("error", providerErr.Error()))
+		return nil, providerErr
+	}
+
+	switch user.State {
+	case entityprovider.EntityStateActive:
+		logger.Debug("User account is already active", log.MaskedString(log.LoggerKeyUserID, userID))
+	case entityprovider.EntityStatePendingVerification:
+		user.State = entityprovider.EntityStateActive
+		updated, updateErr := e.entityProvider.UpdateEntity(userID, user)
+		if updateErr != nil {
+			logger.Error("Failed to activate the user account", log.MaskedString(log.LoggerKeyUserID, userID),
+				log.String("error", updateErr.Error()))
+			execResp.Status = common.ExecFailure
+			execResp.FailureReason = "Failed to activate the user account"
+			return execResp, nil
+		}
+		if updated != nil {
+			user = updated
+		}
+		logger.Debug("User account activated", log.MaskedString(log.LoggerKeyUserID, userID))
+	default:
+		logger.Debug("User account is not pending verification", log.MaskedString(log.LoggerKeyUserID, userID))
+		execResp.Status = common.ExecFailure
+		execResp.FailureReason = "The user account cannot be activated"
+		return execResp, nil
+	}
+
+	attributes := make(map[string]interface{})
+	if len(user.Attributes) > 0 {
+		if err := json.Unmarshal(user.Attributes, &attributes); err != nil {
+			logger.Error("Failed to unmarshal user attributes", log.Error(err))
+			return nil, err
+		}
+	}
+
+	execResp.AuthenticatedUser = authncm.AuthenticatedUser{
+		IsAuthenticated: true,
+		UserID:          user.ID,
+		OUID:            user.OUID,
+		UserType:        user.Type,
+		Attributes:      attributes,
+	}
+	execResp.Status = common.ExecComplete
+	return execResp, nil
+}
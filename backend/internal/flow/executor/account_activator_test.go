@@ -0,0 +1,189 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package executor
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/entityprovider"
+	"github.com/thunder-id/thunderid/internal/flow/common"
+	"github.com/thunder-id/thunderid/internal/flow/core"
+	"github.com/thunder-id/thunderid/tests/mocks/entityprovidermock"
+	"github.com/thunder-id/thunderid/tests/mocks/flow/coremock"
+)
+
+type AccountActivatorTestSuite struct {
+	suite.Suite
+	mockFlowFactory    *coremock.FlowFactoryInterfaceMock
+	mockEntityProvider *entityprovidermock.EntityProviderInterfaceMock
+	mockBaseExecutor   *coremock.ExecutorInterfaceMock
+	executor           *accountActivator
+}
+
+func TestAccountActivatorSuite(t *testing.T) {
+	suite.Run(t, new(AccountActivatorTestSuite))
+}
+
+func (suite *AccountActivatorTestSuite) SetupTest() {
+	suite.mockFlowFactory = coremock.NewFlowFactoryInterfaceMock(suite.T())
+	suite.mockEntityProvider = entityprovidermock.NewEntityProviderInterfaceMock(suite.T())
+	suite.mockBaseExecutor = coremock.NewExecutorInterfaceMock(suite.T())
+
+	suite.mockFlowFactory.On("CreateExecutor",
+		ExecutorNameAccountActivator,
+		common.ExecutorTypeRegistration,
+		[]common.Input{},
+		[]common.Input{
+			{
+				Identifier: userAttributeUserID,
+				Type:       common.InputTypeText,
+				Required:   true,
+			},
+		}).Return(suite.mockBaseExecutor)
+
+	suite.executor = newAccountActivator(suite.mockFlowFactory, suite.mockEntityProvider)
+}
+
+func (suite *AccountActivatorTestSuite) newContext() *core.NodeContext {
+	return &core.NodeContext{
+		ExecutionID: "test-flow",
+		FlowType:    common.FlowTypeRegistration,
+		RuntimeData: map[string]string{userAttributeUserID: testUserID},
+	}
+}
+
+func (suite *AccountActivatorTestSuite) TestExecute_ActivatesPendingUser() {
+	ctx := suite.newContext()
+	attrs, _ := json.Marshal(map[string]interface{}{"email": "user@example.com"})
+	pendingUser := &entityprovider.Entity{
+		ID:         testUserID,
+		OUID:       "ou-1",
+		Type:       "customer",
+		State:      entityprovider.EntityStatePendingVerification,
+		Attributes: attrs,
+	}
+
+	suite.mockBaseExecutor.On("ValidatePrerequisites", ctx, mock.Anything).Return(true)
+	suite.mockBaseExecutor.On("GetUserIDFromContext", ctx).Return(testUserID)
+	suite.mockEntityProvider.On("GetEntity", testUserID).Return(pendingUser, nil)
+	suite.mockEntityProvider.On("UpdateEntity", testUserID, mock.MatchedBy(func(e *entityprovider.Entity) bool {
+		return e.State == entityprovider.EntityStateActive
+	})).Return(&entityprovider.Entity{
+		ID:         testUserID,
+		OUID:       "ou-1",
+		Type:       "customer",
+		State:      entityprovider.EntityStateActive,
+		Attributes: attrs,
+	}, nil)
+
+	resp, err := suite.executor.Execute(ctx)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), common.ExecComplete, resp.Status)
+	assert.True(suite.T(), resp.AuthenticatedUser.IsAuthenticated)
+	assert.Equal(suite.T(), testUserID, resp.AuthenticatedUser.UserID)
+	assert.Equal(suite.T(), "ou-1", resp.AuthenticatedUser.OUID)
+	assert.Equal(suite.T(), "user@example.com", resp.AuthenticatedUser.Attributes["email"])
+}
+
+func (suite *AccountActivatorTestSuite) TestExecute_AlreadyActiveUser() {
+	ctx := suite.newContext()
+
+	suite.mockBaseExecutor.On("ValidatePrerequisites", ctx, mock.Anything).Return(true)
+	suite.mockBaseExecutor.On("GetUserIDFromContext", ctx).Return(testUserID)
+	suite.mockEntityProvider.On("GetEntity", testUserID).Return(&entityprovider.Entity{
+		ID:    testUserID,
+		State: entityprovider.EntityStateActive,
+	}, nil)
+
+	resp, err := suite.executor.Execute(ctx)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), common.ExecComplete, resp.Status)
+	assert.True(suite.T(), resp.AuthenticatedUser.IsAuthenticated)
+	suite.mockEntityProvider.AssertNotCalled(suite.T(), "UpdateEntity", mock.Anything, mock.Anything)
+}
+
+func (suite *AccountActivatorTestSuite) TestExecute_UserInOtherState() {
+	ctx := suite.newContext()
+
+	suite.mockBaseExecutor.On("ValidatePrerequisites", ctx, mock.Anything).Return(true)
+	suite.mockBaseExecutor.On("GetUserIDFromContext", ctx).Return(testUserID)
+	suite.mockEntityProvider.On("GetEntity", testUserID).Return(&entityprovider.Entity{
+		ID:    testUserID,
+		State: entityprovider.EntityState("LOCKED"),
+	}, nil)
+
+	resp, err := suite.executor.Execute(ctx)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), common.ExecFailure, resp.Status)
+	assert.Equal(suite.T(), "The user account cannot be activated", resp.FailureReason)
+}
+
+func (suite *AccountActivatorTestSuite) TestExecute_UserNotFound() {
+	ctx := suite.newContext()
+
+	suite.mockBaseExecutor.On("ValidatePrerequisites", ctx, mock.Anything).Return(true)
+	suite.mockBaseExecutor.On("GetUserIDFromContext", ctx).Return(testUserID)
+	suite.mockEntityProvider.On("GetEntity", testUserID).Return(nil,
+		entityprovider.NewEntityProviderError(entityprovider.ErrorCodeEntityNotFound, "", ""))
+
+	resp, err := suite.executor.Execute(ctx)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), common.ExecFailure, resp.Status)
+	assert.Equal(suite.T(), failureReasonUserNotFound, resp.FailureReason)
+}
+
+func (suite *AccountActivatorTestSuite) TestExecute_UpdateFails() {
+	ctx := suite.newContext()
+
+	suite.mockBaseExecutor.On("ValidatePrerequisites", ctx, mock.Anything).Return(true)
+	suite.mockBaseExecutor.On("GetUserIDFromContext", ctx).Return(testUserID)
+	suite.mockEntityProvider.On("GetEntity", testUserID).Return(&entityprovider.Entity{
+		ID:    testUserID,
+		State: entityprovider.EntityStatePendingVerification,
+	}, nil)
+	suite.mockEntityProvider.On("UpdateEntity", testUserID, mock.Anything).Return(nil,
+		entityprovider.NewEntityProviderError(entityprovider.ErrorCodeSystemError, "", ""))
+
+	resp, err := suite.executor.Execute(ctx)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), common.ExecFailure, resp.Status)
+	assert.Equal(suite.T(), "Failed to activate the user account", resp.FailureReason)
+}
+
+func (suite *AccountActivatorTestSuite) TestExecute_MissingUserID() {
+	ctx := suite.newContext()
+
+	suite.mockBaseExecutor.On("ValidatePrerequisites", ctx, mock.Anything).Return(true)
+	suite.mockBaseExecutor.On("GetUserIDFromContext", ctx).Return("")
+
+	resp, err := suite.executor.Execute(ctx)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), common.ExecFailure, resp.Status)
+}
@@ -255,6 +255,11 @@ func (a *attributeCollector) updateUserInStore(ctx *core.NodeContext) error {
 	logger := a.logger.With(log.String(log.LoggerKeyExecutionID, ctx.ExecutionID))
 	logger.Debug("Updating user attributes")
 
+	if ctx.Sandbox {
+		logger.Debug("Sandbox execution, skipping user attribute update")
+		return nil
+	}
+
 	user, err := a.getUserFromStore(ctx)
 	if err != nil {
 		return fmt.Errorf("failed to retrieve user from store: %w", err)
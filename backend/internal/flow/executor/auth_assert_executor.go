@@ -27,6 +27,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/thunder-id/thunderid/internal/appuser"
 	"github.com/thunder-id/thunderid/internal/attributecache"
 	"github.com/thunder-id/thunderid/internal/authn/assert"
 	authncm "github.com/thunder-id/thunderid/internal/authn/common"
@@ -58,6 +59,7 @@ type authAssertExecutor struct {
 	entityProvider      entityprovider.EntityProviderInterface
 	attributeCacheSvc   attributecache.AttributeCacheServiceInterface
 	roleService         role.RoleServiceInterface
+	appUserService      appuser.AppUserServiceInterface
 	logger              *log.Logger
 }
 
@@ -73,6 +75,7 @@ func newAuthAssertExecutor(
 	entityProvider entityprovider.EntityProviderInterface,
 	attributeCacheSvc attributecache.AttributeCacheServiceInterface,
 	roleService role.RoleServiceInterface,
+	appUserService appuser.AppUserServiceInterface,
 ) *authAssertExecutor {
 	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, authAssertLoggerComponentName),
 		log.String(log.LoggerKeyExecutorName, ExecutorNameAuthAssert))
@@ -89,6 +92,7 @@ func newAuthAssertExecutor(
 		entityProvider:      entityProvider,
 		attributeCacheSvc:   attributeCacheSvc,
 		roleService:         roleService,
+		appUserService:      appUserService,
 		logger:              logger,
 	}
 }
@@ -103,7 +107,21 @@ func (a *authAssertExecutor) Execute(ctx *core.NodeContext) (*common.ExecutorRes
 		RuntimeData:    make(map[string]string),
 	}
 
-	if ctx.AuthenticatedUser.IsAuthenticated {
+	if ctx.AuthenticatedUser.IsAuthenticated && ctx.Sandbox {
+		logger.Debug("Sandbox execution, skipping authentication assertion generation")
+		execResp.Status = common.ExecComplete
+	} else if ctx.AuthenticatedUser.IsAuthenticated {
+		visible, err := a.resolveApplicationAssociation(ctx, logger)
+		if err != nil {
+			return nil, err
+		}
+		if !visible {
+			logger.Debug("Authenticated user is not associated with the application")
+			execResp.Status = common.ExecFailure
+			execResp.FailureReason = failureReasonUserNotFound
+			return execResp, nil
+		}
+
 		token, err := a.generateAuthAssertion(ctx, logger)
 		if err != nil {
 			return nil, err
@@ -124,6 +142,28 @@ func (a *authAssertExecutor) Execute(ctx *core.NodeContext) (*common.ExecutorRes
 	return execResp, nil
 }
 
+// resolveApplicationAssociation reports whether the authenticated user may sign in to the flow's application.
+// Applications that isolate their users only admit associated users, while other applications record the
+// association on the user's first sign in.
+func (a *authAssertExecutor) resolveApplicationAssociation(ctx *core.NodeContext,
+	logger *log.Logger) (bool, error) {
+	userID := ctx.AuthenticatedUser.UserID
+	if a.appUserService == nil || !a.appUserService.IsEnabled() || userID == "" || ctx.Application.ID == "" {
+		return true, nil
+	}
+
+	if ctx.Application.UserIsolation {
+		return isUserVisibleToApplication(ctx, a.appUserService, userID)
+	}
+
+	if svcErr := a.appUserService.RecordAppUser(ctx.Context, ctx.Application.ID, userID,
+		appuser.AssociationSourceLogin); svcErr != nil {
+		logger.Error("Failed to record the application association of the user",
+			log.String("error", svcErr.ErrorDescription.DefaultValue))
+	}
+	return true, nil
+}
+
 // generateAuthAssertion generates the authentication assertion token.
 func (a *authAssertExecutor) generateAuthAssertion(ctx *core.NodeContext, logger *log.Logger) (string, error) {
 	tokenSub := ""
@@ -420,6 +460,11 @@ func (a *authAssertExecutor) appendComputedAttributes(
 		attributes[oauth2const.ClaimUserType] = ctx.AuthenticatedUser.UserType
 	}
 
+	// Fill in the preferred locale and time zone from the OU and deployment defaults when the user has none
+	if err := a.appendLocalizationDefaults(ctx, requestedAttributes, attributes); err != nil {
+		return err
+	}
+
 	// Add OU details to the claims
 	ouAttributesConfigured := slices.Contains(requestedAttributes, oauth2const.ClaimOUID) ||
 		slices.Contains(requestedAttributes, oauth2const.ClaimOUName) ||
@@ -434,6 +479,40 @@ func (a *authAssertExecutor) appendComputedAttributes(
 	return nil
 }
 
+// appendLocalizationDefaults sets the requested locale and zoneinfo attributes that the user does not have
+// to the default of the user's organization unit or, failing that, of the deployment.
+func (a *authAssertExecutor) appendLocalizationDefaults(
+	ctx *core.NodeContext, requestedAttributes []string, attributes map[string]interface{}) error {
+	localeRequested := slices.Contains(requestedAttributes, userAttributeLocale)
+	zoneinfoRequested := slices.Contains(requestedAttributes, userAttributeZoneinfo)
+	if !localeRequested && !zoneinfoRequested {
+		return nil
+	}
+
+	preferred := ou.Localization{}
+	preferred.Locale, _ = attributes[userAttributeLocale].(string)
+	preferred.Zoneinfo, _ = attributes[userAttributeZoneinfo].(string)
+	if (!localeRequested || preferred.Locale != "") && (!zoneinfoRequested || preferred.Zoneinfo != "") {
+		return nil
+	}
+
+	resolved, svcErr := ou.ResolveLocalization(ctx.Context, a.ouService, ctx.AuthenticatedUser.OUID, preferred)
+	if svcErr != nil {
+		a.logger.Error("Failed to resolve localization defaults",
+			log.String(ouIDKey, ctx.AuthenticatedUser.OUID), log.Any("error", svcErr))
+		return errors.New("something went wrong while resolving localization defaults: " +
+			svcErr.ErrorDescription.DefaultValue)
+	}
+
+	if localeRequested && resolved.Locale != "" {
+		attributes[userAttributeLocale] = resolved.Locale
+	}
+	if zoneinfoRequested && resolved.Zoneinfo != "" {
+		attributes[userAttributeZoneinfo] = resolved.Zoneinfo
+	}
+	return nil
+}
+
 // getUserAttributesFromAuthnProvider retrieves user attributes from the authentication provider.
 func (a *authAssertExecutor) getUserAttributesFromAuthnProvider(ctx context.Context,
 	requestedAttributes []string, metadata *authnprovidercm.GetAttributesMetadata,
@@ -30,6 +30,7 @@ import (
 	"github.com/stretchr/testify/suite"
 
 	appmodel "github.com/thunder-id/thunderid/internal/application/model"
+	"github.com/thunder-id/thunderid/internal/appuser"
 	"github.com/thunder-id/thunderid/internal/attributecache"
 	authnassert "github.com/thunder-id/thunderid/internal/authn/assert"
 	authncm "github.com/thunder-id/thunderid/internal/authn/common"
@@ -43,6 +44,7 @@ import (
 	"github.com/thunder-id/thunderid/internal/ou"
 	"github.com/thunder-id/thunderid/internal/system/config"
 	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/tests/mocks/appusermock"
 	"github.com/thunder-id/thunderid/tests/mocks/attributecachemock"
 	"github.com/thunder-id/thunderid/tests/mocks/authn/assertmock"
 	"github.com/thunder-id/thunderid/tests/mocks/authnprovider/managermock"
@@ -96,7 +98,7 @@ func (suite *AuthAssertExecutorTestSuite) SetupTest() {
 
 	suite.executor = newAuthAssertExecutor(suite.mockFlowFactory, suite.mockJWTService,
 		suite.mockOUService, suite.mockAssertGenerator, suite.mockAuthnProvider, suite.mockEntityProvider,
-		suite.mockAttributeCacheSvc, suite.mockRoleService)
+		suite.mockAttributeCacheSvc, suite.mockRoleService, nil)
 }
 
 func createMockExecutorSimple(t *testing.T, name string,
@@ -195,6 +197,80 @@ func (suite *AuthAssertExecutorTestSuite) TestExecute_UserNotAuthenticated() {
 	assert.Equal(suite.T(), failureReasonUserNotAuthenticated, resp.FailureReason)
 }
 
+func (suite *AuthAssertExecutorTestSuite) TestExecute_UserIsolation_UnassociatedUser() {
+	mockAppUserService := appusermock.NewAppUserServiceInterfaceMock(suite.T())
+	suite.executor.appUserService = mockAppUserService
+	ctx := &core.NodeContext{
+		Context:     context.Background(),
+		ExecutionID: "flow-123",
+		FlowType:    common.FlowTypeAuthentication,
+		AuthenticatedUser: authncm.AuthenticatedUser{
+			IsAuthenticated: true,
+			UserID:          "user-123",
+		},
+		Application: appmodel.Application{
+			ID:                 "app-123",
+			InboundAuthProfile: inboundmodel.InboundAuthProfile{UserIsolation: true},
+		},
+	}
+	mockAppUserService.On("IsEnabled").Return(true)
+	mockAppUserService.On("IsAppUser", ctx.Context, "app-123", "user-123").Return(false, nil).Once()
+
+	resp, err := suite.executor.Execute(ctx)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), common.ExecFailure, resp.Status)
+	assert.Equal(suite.T(), failureReasonUserNotFound, resp.FailureReason)
+	assert.Empty(suite.T(), resp.Assertion)
+}
+
+func (suite *AuthAssertExecutorTestSuite) TestExecute_RecordsLoginAssociation() {
+	mockAppUserService := appusermock.NewAppUserServiceInterfaceMock(suite.T())
+	suite.executor.appUserService = mockAppUserService
+	ctx := &core.NodeContext{
+		Context:     context.Background(),
+		ExecutionID: "flow-123",
+		FlowType:    common.FlowTypeAuthentication,
+		AuthenticatedUser: authncm.AuthenticatedUser{
+			IsAuthenticated: true,
+			UserID:          "user-123",
+		},
+		Application: appmodel.Application{ID: "app-123"},
+	}
+	mockAppUserService.On("IsEnabled").Return(true)
+	mockAppUserService.On("RecordAppUser", ctx.Context, "app-123", "user-123",
+		appuser.AssociationSourceLogin).Return(nil).Once()
+	suite.mockJWTService.On("GenerateJWT", mock.Anything, "user-123", mock.Anything, mock.Anything,
+		mock.Anything, mock.Anything, mock.Anything).Return("jwt-token", int64(3600), nil)
+
+	resp, err := suite.executor.Execute(ctx)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), common.ExecComplete, resp.Status)
+	assert.Equal(suite.T(), "jwt-token", resp.Assertion)
+}
+
+func (suite *AuthAssertExecutorTestSuite) TestExecute_Sandbox_SkipsAssertion() {
+	ctx := &core.NodeContext{
+		ExecutionID: "flow-123",
+		FlowType:    common.FlowTypeAuthentication,
+		Sandbox:     true,
+		AuthenticatedUser: authncm.AuthenticatedUser{
+			IsAuthenticated: true,
+			UserID:          "user-123",
+		},
+	}
+
+	resp, err := suite.executor.Execute(ctx)
+
+	assert.NoError(suite.T(), err)
+	assert.NotNil(suite.T(), resp)
+	assert.Equal(suite.T(), common.ExecComplete, resp.Status)
+	assert.Empty(suite.T(), resp.Assertion)
+	suite.mockJWTService.AssertNotCalled(suite.T(), "GenerateJWT",
+		mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
 func (suite *AuthAssertExecutorTestSuite) TestExecute_WithAuthorizedPermissions() {
 	ctx := &core.NodeContext{
 		ExecutionID: "flow-123",
@@ -643,6 +719,48 @@ func (suite *AuthAssertExecutorTestSuite) TestExecute_WithOUNameAndHandle() {
 	suite.mockJWTService.AssertExpectations(suite.T())
 }
 
+func (suite *AuthAssertExecutorTestSuite) TestExecute_WithLocalizationDefaultsFromOU() {
+	ctx := &core.NodeContext{
+		ExecutionID: "flow-123",
+		EntityID:    "app-123",
+		FlowType:    common.FlowTypeAuthentication,
+		AuthenticatedUser: authncm.AuthenticatedUser{
+			IsAuthenticated: true,
+			UserID:          "user-123",
+			OUID:            testAssertOUID,
+			Attributes:      map[string]interface{}{"zoneinfo": "Asia/Tokyo"},
+		},
+		ExecutionHistory: map[string]*common.NodeExecutionRecord{},
+		Application: appmodel.Application{
+			InboundAuthProfile: inboundmodel.InboundAuthProfile{
+				Assertion: &inboundmodel.AssertionConfig{
+					UserAttributes: []string{"locale", "zoneinfo"},
+				},
+			},
+		},
+	}
+
+	suite.mockEntityProvider.On("GetEntity", "user-123").Return(&entityprovider.Entity{
+		ID:         "user-123",
+		Attributes: json.RawMessage(`{"email":"user@example.com"}`),
+	}, nil)
+	suite.mockOUService.On("GetOrganizationUnit", mock.Anything, testAssertOUID).Return(ou.OrganizationUnit{
+		ID:     testAssertOUID,
+		Locale: "fr-CA",
+	}, nil)
+
+	suite.mockJWTService.On("GenerateJWT", mock.Anything, "user-123", mock.Anything, mock.Anything,
+		mock.MatchedBy(func(claims map[string]interface{}) bool {
+			return claims["locale"] == "fr-CA" && claims["zoneinfo"] == "Asia/Tokyo"
+		}), mock.Anything, mock.Anything).Return("jwt-token", int64(3600), nil)
+
+	resp, err := suite.executor.Execute(ctx)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), common.ExecComplete, resp.Status)
+	suite.mockJWTService.AssertExpectations(suite.T())
+}
+
 func (suite *AuthAssertExecutorTestSuite) TestExecute_AppendUserDetailsToClaimsFails() {
 	attrs := map[string]interface{}{"email": testEmail}
 	attrsJSON, _ := json.Marshal(attrs)
@@ -23,11 +23,14 @@ package executor
 import (
 	"encoding/json"
 	"errors"
+	"time"
 
+	"github.com/thunder-id/thunderid/internal/appuser"
 	authncm "github.com/thunder-id/thunderid/internal/authn/common"
 	authnprovidercm "github.com/thunder-id/thunderid/internal/authnprovider/common"
 	authnprovidermgr "github.com/thunder-id/thunderid/internal/authnprovider/manager"
 	"github.com/thunder-id/thunderid/internal/entityprovider"
+	"github.com/thunder-id/thunderid/internal/enumeration"
 	"github.com/thunder-id/thunderid/internal/flow/common"
 	"github.com/thunder-id/thunderid/internal/flow/core"
 	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
@@ -38,9 +41,11 @@ import (
 type basicAuthExecutor struct {
 	core.ExecutorInterface
 	identifyingExecutorInterface
-	entityProvider entityprovider.EntityProviderInterface
-	authnProvider  authnprovidermgr.AuthnProviderManagerInterface
-	logger         *log.Logger
+	entityProvider     entityprovider.EntityProviderInterface
+	authnProvider      authnprovidermgr.AuthnProviderManagerInterface
+	enumerationService enumeration.EnumerationResistanceServiceInterface
+	appUserService     appuser.AppUserServiceInterface
+	logger             *log.Logger
 }
 
 var _ core.ExecutorInterface = (*basicAuthExecutor)(nil)
@@ -51,6 +56,8 @@ func newBasicAuthExecutor(
 	flowFactory core.FlowFactoryInterface,
 	entityProvider entityprovider.EntityProviderInterface,
 	authnProvider authnprovidermgr.AuthnProviderManagerInterface,
+	enumerationService enumeration.EnumerationResistanceServiceInterface,
+	appUserService appuser.AppUserServiceInterface,
 ) *basicAuthExecutor {
 	defaultInputs := []common.Input{
 		{
@@ -69,7 +76,7 @@ func newBasicAuthExecutor(
 		log.String(log.LoggerKeyExecutorName, ExecutorNameBasicAuth))
 
 	identifyExec := newIdentifyingExecutor(ExecutorNameBasicAuth, defaultInputs, []common.Input{},
-		flowFactory, entityProvider)
+		flowFactory, entityProvider, nil)
 	base := flowFactory.CreateExecutor(ExecutorNameBasicAuth, common.ExecutorTypeAuthentication,
 		defaultInputs, []common.Input{})
 
@@ -78,6 +85,8 @@ func newBasicAuthExecutor(
 		identifyingExecutorInterface: identifyExec,
 		entityProvider:               entityProvider,
 		authnProvider:                authnProvider,
+		enumerationService:           enumerationService,
+		appUserService:               appUserService,
 		logger:                       logger,
 	}
 }
@@ -213,6 +222,7 @@ func (b *basicAuthExecutor) getAuthenticatedUser(ctx *core.NodeContext,
 
 	// For authentication flows, call Authenticate directly.
 	metadata := b.buildAuthnMetadata(ctx)
+	started := time.Now()
 	newAuthUser, authnResult, svcErr := b.authnProvider.AuthenticateUser(ctx.Context, userIdentifiers,
 		userCredentials, nil, metadata, ctx.AuthUser)
 	if svcErr != nil {
@@ -223,11 +233,17 @@ func (b *basicAuthExecutor) getAuthenticatedUser(ctx *core.NodeContext,
 			switch svcErr.Code {
 			case authnprovidermgr.ErrorUserNotFound.Code:
 				execResp.FailureReason = failureReasonUserNotFound
+				if b.enumerationService != nil && b.enumerationService.IsEnabled() {
+					execResp.FailureReason = failureReasonInvalidCredentials
+				}
 			case authnprovidermgr.ErrorAuthenticationFailed.Code:
 				execResp.FailureReason = failureReasonInvalidCredentials
 			default:
 				execResp.FailureReason = "Failed to authenticate user: " + svcErr.ErrorDescription.DefaultValue
 			}
+			if b.enumerationService != nil {
+				b.enumerationService.PadFailure(ctx.Context, started)
+			}
 
 			return nil, nil
 		}
@@ -236,6 +252,26 @@ func (b *basicAuthExecutor) getAuthenticatedUser(ctx *core.NodeContext,
 			log.String("errorCode", svcErr.Code), log.String("errorDescription", svcErr.ErrorDescription.DefaultValue))
 		return nil, errors.New("failed to authenticate user")
 	}
+
+	// Users who are not associated with an isolating application are reported as not found.
+	visible, visibilityErr := isUserVisibleToApplication(ctx, b.appUserService, authnResult.UserID)
+	if visibilityErr != nil {
+		logger.Error("Failed to check the application association of the user", log.Error(visibilityErr))
+		return nil, errors.New("failed to authenticate user")
+	}
+	if !visible {
+		logger.Debug("Authenticated user is not associated with the application")
+		execResp.Status = common.ExecUserInputRequired
+		execResp.Inputs = b.GetRequiredInputs(ctx)
+		execResp.FailureReason = failureReasonUserNotFound
+		if b.enumerationService != nil && b.enumerationService.IsEnabled() {
+			execResp.FailureReason = failureReasonInvalidCredentials
+		}
+		if b.enumerationService != nil {
+			b.enumerationService.PadFailure(ctx.Context, started)
+		}
+		return nil, nil
+	}
 	execResp.AuthUser = newAuthUser
 
 	// Try to retrieve the user and get the attributes
@@ -22,6 +22,7 @@ import (
 	inboundmodel "github.com/thunder-id/thunderid/internal/inboundclient/model"
 	i18ncore "github.com/thunder-id/thunderid/internal/system/i18n/core"
 
+	"context"
 	"encoding/json"
 	"testing"
 
@@ -35,8 +36,10 @@ import (
 	"github.com/thunder-id/thunderid/internal/flow/common"
 	"github.com/thunder-id/thunderid/internal/flow/core"
 	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/tests/mocks/appusermock"
 	"github.com/thunder-id/thunderid/tests/mocks/authnprovider/managermock"
 	"github.com/thunder-id/thunderid/tests/mocks/entityprovidermock"
+	"github.com/thunder-id/thunderid/tests/mocks/enumerationmock"
 	"github.com/thunder-id/thunderid/tests/mocks/flow/coremock"
 )
 
@@ -71,7 +74,8 @@ func (suite *BasicAuthExecutorTestSuite) SetupTest() {
 	suite.mockFlowFactory.On("CreateExecutor", ExecutorNameBasicAuth, common.ExecutorTypeAuthentication,
 		defaultInputs, []common.Input{}).Return(mockExec)
 
-	suite.executor = newBasicAuthExecutor(suite.mockFlowFactory, suite.mockEntityProvider, suite.mockAuthnProvider)
+	suite.executor = newBasicAuthExecutor(suite.mockFlowFactory, suite.mockEntityProvider, suite.mockAuthnProvider,
+		nil, nil)
 }
 
 func createMockIdentifyingExecutor(t *testing.T) core.ExecutorInterface {
@@ -682,6 +686,72 @@ func (suite *BasicAuthExecutorTestSuite) TestExecute_RetryableAuthenticationErro
 	}
 }
 
+func (suite *BasicAuthExecutorTestSuite) TestExecute_EnumerationResistance_UserNotFound() {
+	enumerationService := enumerationmock.NewEnumerationResistanceServiceInterfaceMock(suite.T())
+	enumerationService.On("IsEnabled").Return(true)
+	enumerationService.On("PadFailure", mock.Anything, mock.AnythingOfType("time.Time")).Once()
+	suite.executor.enumerationService = enumerationService
+
+	ctx := &core.NodeContext{
+		ExecutionID: "flow-123",
+		FlowType:    common.FlowTypeAuthentication,
+		UserInputs: map[string]string{
+			userAttributeUsername: "nonexistent",
+			userAttributePassword: "password123",
+		},
+		RuntimeData: make(map[string]string),
+	}
+
+	suite.mockAuthnProvider.On("AuthenticateUser", mock.Anything, map[string]interface{}{
+		userAttributeUsername: "nonexistent",
+	}, map[string]interface{}{
+		userAttributePassword: "password123",
+	}, mock.Anything, mock.Anything, mock.Anything).Return(
+		authnprovidermgr.AuthUser{}, (*authnprovidermgr.AuthnBasicResult)(nil), &serviceerror.ServiceError{
+			Type: serviceerror.ClientErrorType,
+			Code: authnprovidermgr.ErrorUserNotFound.Code,
+		})
+
+	resp, err := suite.executor.Execute(ctx)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), common.ExecUserInputRequired, resp.Status)
+	assert.Equal(suite.T(), failureReasonInvalidCredentials, resp.FailureReason)
+}
+
+func (suite *BasicAuthExecutorTestSuite) TestExecute_UserIsolation_UnassociatedUser() {
+	mockAppUserService := appusermock.NewAppUserServiceInterfaceMock(suite.T())
+	mockAppUserService.On("IsEnabled").Return(true)
+	suite.executor.appUserService = mockAppUserService
+
+	ctx := &core.NodeContext{
+		Context:     context.Background(),
+		ExecutionID: "flow-123",
+		FlowType:    common.FlowTypeAuthentication,
+		UserInputs: map[string]string{
+			userAttributeUsername: "testuser",
+			userAttributePassword: "password123",
+		},
+		RuntimeData: make(map[string]string),
+		Application: appmodel.Application{
+			ID:                 "app-123",
+			InboundAuthProfile: inboundmodel.InboundAuthProfile{UserIsolation: true},
+		},
+	}
+
+	suite.mockAuthnProvider.On("AuthenticateUser", mock.Anything, mock.Anything, mock.Anything,
+		mock.Anything, mock.Anything, mock.Anything).Return(authnprovidermgr.AuthUser{},
+		&authnprovidermgr.AuthnBasicResult{UserID: testUserID}, nil)
+	mockAppUserService.On("IsAppUser", ctx.Context, "app-123", testUserID).Return(false, nil).Once()
+
+	resp, err := suite.executor.Execute(ctx)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), common.ExecUserInputRequired, resp.Status)
+	assert.Equal(suite.T(), failureReasonUserNotFound, resp.FailureReason)
+	assert.False(suite.T(), resp.AuthenticatedUser.IsAuthenticated)
+}
+
 func (suite *BasicAuthExecutorTestSuite) TestGetAuthenticatedUser_ClientError_ReturnsInputsForRetry() {
 	ctx := &core.NodeContext{
 		ExecutionID: "flow-123",
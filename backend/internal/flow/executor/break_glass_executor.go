@@ -0,0 +1,101 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package executor
+
+import (
+	"fmt"
+
+	"github.com/thunder-id/thunderid/internal/breakglass"
+	"github.com/thunder-id/thunderid/internal/flow/common"
+	"github.com/thunder-id/thunderid/internal/flow/core"
+	"github.com/thunder-id/thunderid/internal/system/log"
+)
+
+var _ core.ExecutorInterface = (*breakGlassExecutor)(nil)
+
+const (
+	breakGlassLoggerComponentName = "BreakGlassExecutor"
+)
+
+// breakGlassExecutor enforces the activation of break-glass accounts after the first factor.
+//
+// It records whether the authenticated user signed in with an active break-glass account in the breakGlass
+// runtime key, which federation and MFA nodes can reference in their condition to be skipped. Sign-ins of
+// break-glass accounts without an approved and unexpired activation fail.
+type breakGlassExecutor struct {
+	core.ExecutorInterface
+	breakGlassService breakglass.BreakGlassServiceInterface
+	logger            *log.Logger
+}
+
+// newBreakGlassExecutor creates a new instance of the break-glass executor.
+func newBreakGlassExecutor(
+	flowFactory core.FlowFactoryInterface,
+	breakGlassService breakglass.BreakGlassServiceInterface,
+) *breakGlassExecutor {
+	logger := log.GetLogger().With(
+		log.String(log.LoggerKeyComponentName, breakGlassLoggerComponentName),
+		log.String(log.LoggerKeyExecutorName, ExecutorNameBreakGlass))
+
+	base := flowFactory.CreateExecutor(ExecutorNameBreakGlass, common.ExecutorTypeUtility,
+		[]common.Input{}, []common.Input{})
+
+	return &breakGlassExecutor{
+		ExecutorInterface: base,
+		breakGlassService: breakGlassService,
+		logger:            logger,
+	}
+}
+
+// Execute checks whether the authenticated user is a break-glass account and whether it may sign in.
+func (e *breakGlassExecutor) Execute(ctx *core.NodeContext) (*common.ExecutorResponse, error) {
+	logger := e.logger.With(log.String(log.LoggerKeyExecutionID, ctx.ExecutionID))
+	logger.Debug("Executing break-glass executor")
+
+	execResp := &common.ExecutorResponse{
+		AdditionalData: make(map[string]string),
+		RuntimeData:    make(map[string]string),
+	}
+
+	if !ctx.AuthenticatedUser.IsAuthenticated {
+		execResp.Status = common.ExecFailure
+		execResp.FailureReason = failureReasonUserNotAuthenticated
+		return execResp, nil
+	}
+
+	isBreakGlass, svcErr := e.breakGlassService.AuthorizeUse(ctx.Context, ctx.AuthenticatedUser.UserID)
+	if svcErr != nil {
+		if svcErr.Code == breakglass.ErrorAccountNotActive.Code {
+			logger.Debug("Break-glass account is not active")
+			execResp.Status = common.ExecFailure
+			execResp.FailureReason = failureReasonBreakGlassNotActive
+			return execResp, nil
+		}
+		return nil, fmt.Errorf("failed to authorize break-glass account: %s", svcErr.Code)
+	}
+
+	execResp.RuntimeData[common.RuntimeKeyBreakGlass] = dataValueFalse
+	if isBreakGlass {
+		execResp.RuntimeData[common.RuntimeKeyBreakGlass] = dataValueTrue
+	}
+
+	logger.Debug("Break-glass check completed", log.Bool("breakGlass", isBreakGlass))
+	execResp.Status = common.ExecComplete
+	return execResp, nil
+}
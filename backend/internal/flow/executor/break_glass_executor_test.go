@@ -0,0 +1,126 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package executor
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	authncm "github.com/thunder-id/thunderid/internal/authn/common"
+	"github.com/thunder-id/thunderid/internal/breakglass"
+	"github.com/thunder-id/thunderid/internal/flow/common"
+	"github.com/thunder-id/thunderid/internal/flow/core"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/tests/mocks/breakglassmock"
+	"github.com/thunder-id/thunderid/tests/mocks/flow/coremock"
+)
+
+type BreakGlassExecutorTestSuite struct {
+	suite.Suite
+	mockFlowFactory *coremock.FlowFactoryInterfaceMock
+	mockBreakGlass  *breakglassmock.BreakGlassServiceInterfaceMock
+	executor        *breakGlassExecutor
+}
+
+func TestBreakGlassExecutorSuite(t *testing.T) {
+	suite.Run(t, new(BreakGlassExecutorTestSuite))
+}
+
+func (suite *BreakGlassExecutorTestSuite) SetupTest() {
+	suite.mockFlowFactory = coremock.NewFlowFactoryInterfaceMock(suite.T())
+	suite.mockBreakGlass = breakglassmock.NewBreakGlassServiceInterfaceMock(suite.T())
+	mockBaseExecutor := coremock.NewExecutorInterfaceMock(suite.T())
+
+	suite.mockFlowFactory.On("CreateExecutor", ExecutorNameBreakGlass, common.ExecutorTypeUtility,
+		[]common.Input{}, []common.Input{}).Return(mockBaseExecutor)
+
+	suite.executor = newBreakGlassExecutor(suite.mockFlowFactory, suite.mockBreakGlass)
+}
+
+func (suite *BreakGlassExecutorTestSuite) newNodeContext() *core.NodeContext {
+	return &core.NodeContext{
+		Context:     context.Background(),
+		ExecutionID: "test-flow-id",
+		UserInputs:  make(map[string]string),
+		RuntimeData: make(map[string]string),
+		AuthenticatedUser: authncm.AuthenticatedUser{
+			IsAuthenticated: true,
+			UserID:          "user-1",
+		},
+	}
+}
+
+func (suite *BreakGlassExecutorTestSuite) TestExecute_SetsBreakGlassRuntimeKey() {
+	testCases := []struct {
+		name         string
+		isBreakGlass bool
+		expected     string
+	}{
+		{"ActiveBreakGlassAccount", true, dataValueTrue},
+		{"RegularUser", false, dataValueFalse},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.mockBreakGlass.On("AuthorizeUse", mock.Anything, "user-1").Return(tc.isBreakGlass, nil).Once()
+
+			resp, err := suite.executor.Execute(suite.newNodeContext())
+
+			suite.NoError(err)
+			suite.Equal(common.ExecComplete, resp.Status)
+			suite.Equal(tc.expected, resp.RuntimeData[common.RuntimeKeyBreakGlass])
+		})
+	}
+}
+
+func (suite *BreakGlassExecutorTestSuite) TestExecute_InactiveAccountFails() {
+	suite.mockBreakGlass.On("AuthorizeUse", mock.Anything, "user-1").
+		Return(true, &breakglass.ErrorAccountNotActive).Once()
+
+	resp, err := suite.executor.Execute(suite.newNodeContext())
+
+	suite.NoError(err)
+	suite.Equal(common.ExecFailure, resp.Status)
+	suite.Equal(failureReasonBreakGlassNotActive, resp.FailureReason)
+	suite.NotContains(resp.RuntimeData, common.RuntimeKeyBreakGlass)
+}
+
+func (suite *BreakGlassExecutorTestSuite) TestExecute_ServiceError() {
+	suite.mockBreakGlass.On("AuthorizeUse", mock.Anything, "user-1").
+		Return(false, &serviceerror.InternalServerError).Once()
+
+	resp, err := suite.executor.Execute(suite.newNodeContext())
+
+	suite.Error(err)
+	suite.Nil(resp)
+}
+
+func (suite *BreakGlassExecutorTestSuite) TestExecute_NotAuthenticated() {
+	ctx := suite.newNodeContext()
+	ctx.AuthenticatedUser = authncm.AuthenticatedUser{}
+
+	resp, err := suite.executor.Execute(ctx)
+
+	suite.NoError(err)
+	suite.Equal(common.ExecFailure, resp.Status)
+	suite.Equal(failureReasonUserNotAuthenticated, resp.FailureReason)
+}
@@ -0,0 +1,308 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package executor
+
+import (
+	"github.com/thunder-id/thunderid/internal/entityprovider"
+	"github.com/thunder-id/thunderid/internal/flow/common"
+)
+
+// JSON schema types of node properties.
+const (
+	propertyTypeString  = "string"
+	propertyTypeBoolean = "boolean"
+	propertyTypeInteger = "integer"
+	propertyTypeArray   = "array"
+	propertyTypeObject  = "object"
+)
+
+// NodeProperty describes a node property read by an executor.
+type NodeProperty struct {
+	Type        string
+	Description string
+	Enum        []string
+}
+
+// ExecutorDescriptor holds the display metadata and configuration of an executor, used to present the
+// executor in the flow builder.
+type ExecutorDescriptor struct {
+	DisplayName string
+	Description string
+	Modes       []string
+	Properties  map[string]NodeProperty
+}
+
+// ConfigSchema returns the JSON schema of the node properties accepted by the executor.
+func (d ExecutorDescriptor) ConfigSchema() map[string]interface{} {
+	properties := make(map[string]interface{}, len(d.Properties))
+	for name, property := range d.Properties {
+		schema := map[string]interface{}{"type": property.Type}
+		if property.Description != "" {
+			schema["description"] = property.Description
+		}
+		if len(property.Enum) > 0 {
+			schema["enum"] = property.Enum
+		}
+		if property.Type == propertyTypeArray {
+			schema["items"] = map[string]interface{}{"type": propertyTypeString}
+		}
+		properties[name] = schema
+	}
+
+	return map[string]interface{}{
+		"type":                 propertyTypeObject,
+		"properties":           properties,
+		"additionalProperties": true,
+	}
+}
+
+// Node properties shared by the federated authentication executors.
+var federatedAuthProperties = map[string]NodeProperty{
+	"idpId": {Type: propertyTypeString, Description: "ID of the identity provider to authenticate with"},
+	common.NodePropertyAllowAuthenticationWithoutLocalUser: {
+		Type:        propertyTypeBoolean,
+		Description: "Whether authentication proceeds when no local user exists for the federated identity",
+	},
+	common.NodePropertyAllowRegistrationWithExistingUser: {
+		Type:        propertyTypeBoolean,
+		Description: "Whether registration proceeds when a local user already exists for the federated identity",
+	},
+	common.NodePropertyAllowCrossOUProvisioning: {
+		Type:        propertyTypeBoolean,
+		Description: "Whether an existing user of another organization unit is provisioned to the flow's unit",
+	},
+}
+
+// executorDescriptors holds the descriptors of the built-in executors, keyed by executor name.
+var executorDescriptors = map[string]ExecutorDescriptor{
+	ExecutorNameBasicAuth: {
+		DisplayName: "Username and Password",
+		Description: "Authenticates the user with an identifier and password.",
+	},
+	ExecutorNameSMSAuth: {
+		DisplayName: "SMS OTP",
+		Description: "Authenticates the user with a one-time password sent by SMS.",
+		Modes:       []string{ExecutorModeSend, ExecutorModeVerify},
+		Properties: map[string]NodeProperty{
+			propertyKeyNotificationSenderID: {Type: propertyTypeString,
+				Description: "ID of the notification sender used to send the OTP"},
+			propertyKeyAutoRegistrationUserType: {Type: propertyTypeString,
+				Description: "User type of the users registered on their first sign-in"},
+			propertyKeyPreventUserEnumeration: {Type: propertyTypeBoolean,
+				Description: "Whether the flow hides if the mobile number belongs to a user"},
+			common.NodePropertyAllowAuthenticationWithoutLocalUser: {Type: propertyTypeBoolean,
+				Description: "Whether authentication proceeds when no local user exists"},
+		},
+	},
+	ExecutorNamePasskeyAuth: {
+		DisplayName: "Passkey",
+		Description: "Registers and authenticates the user with a passkey.",
+		Modes: []string{passkeyExecutorModeChallenge, passkeyExecutorModeVerify, passkeyExecutorModeRegStart,
+			passkeyExecutorModeRegFinish},
+		Properties: map[string]NodeProperty{
+			"relyingPartyId":   {Type: propertyTypeString, Description: "WebAuthn relying party ID"},
+			"relyingPartyName": {Type: propertyTypeString, Description: "WebAuthn relying party name"},
+			"attestation": {Type: propertyTypeString, Description: "WebAuthn attestation conveyance preference",
+				Enum: []string{"none", "indirect", "direct", "enterprise"}},
+			"authenticatorSelection": {Type: propertyTypeObject, Description: "WebAuthn authenticator selection"},
+		},
+	},
+	ExecutorNameMagicLinkAuth: {
+		DisplayName: "Magic Link",
+		Description: "Authenticates the user with a sign-in link sent by email.",
+		Modes:       []string{ExecutorModeGenerate, ExecutorModeVerify},
+		Properties: map[string]NodeProperty{
+			propertyKeyTokenExpiry:  {Type: propertyTypeString, Description: "Validity of the link in seconds"},
+			propertyKeyMagicLinkURL: {Type: propertyTypeString, Description: "URL of the page that verifies the link"},
+		},
+	},
+	ExecutorNameOAuth: {
+		DisplayName: "OAuth",
+		Description: "Authenticates the user with an OAuth 2.0 identity provider.",
+		Properties:  federatedAuthProperties,
+	},
+	ExecutorNameOIDCAuth: {
+		DisplayName: "OpenID Connect",
+		Description: "Authenticates the user with an OpenID Connect identity provider.",
+		Properties:  federatedAuthProperties,
+	},
+	ExecutorNameGitHubAuth: {
+		DisplayName: "GitHub",
+		Description: "Authenticates the user with GitHub.",
+		Properties:  federatedAuthProperties,
+	},
+	ExecutorNameGoogleAuth: {
+		DisplayName: "Google",
+		Description: "Authenticates the user with Google.",
+		Properties:  federatedAuthProperties,
+	},
+	ExecutorNameIdentifying: {
+		DisplayName: "Identify User",
+		Description: "Identifies the user from the provided attributes.",
+		Modes:       []string{ExecutorModeIdentify, ExecutorModeResolve},
+	},
+	ExecutorNameAuthAssert: {
+		DisplayName: "Authentication Assertion",
+		Description: "Issues the assertion of the completed authentication.",
+	},
+	ExecutorNameProvisioning: {
+		DisplayName: "Provision User",
+		Description: "Creates the user with the collected attributes.",
+		Properties: map[string]NodeProperty{
+			propertyKeyAssignGroup: {Type: propertyTypeString, Description: "ID of the group to add the user to"},
+			propertyKeyAssignRole:  {Type: propertyTypeString, Description: "ID of the role to assign to the user"},
+			propertyKeyDynamicInputsIncludeOptional: {Type: propertyTypeBoolean,
+				Description: "Whether optional attributes of the user type are prompted"},
+			propertyKeyDynamicInputsIncludeOptionalCredentials: {Type: propertyTypeBoolean,
+				Description: "Whether optional credentials of the user type are prompted"},
+			propertyKeyMaxDynamicInputsPerPrompt: {Type: propertyTypeInteger,
+				Description: "Maximum number of attributes prompted at once"},
+			common.NodePropertyAllowCrossOUProvisioning: {Type: propertyTypeBoolean,
+				Description: "Whether an existing user of another organization unit is provisioned"},
+			propertyKeyInitialState: {Type: propertyTypeString, Description: "State the user is created in",
+				Enum: []string{string(entityprovider.EntityStateActive),
+					string(entityprovider.EntityStatePendingVerification)}},
+		},
+	},
+	ExecutorNameAttributeCollect: {
+		DisplayName: "Collect Attributes",
+		Description: "Collects user attributes and updates the user profile.",
+	},
+	ExecutorNameAuthorization: {
+		DisplayName: "Authorization",
+		Description: "Resolves the permissions the user is authorized for.",
+	},
+	ExecutorNamePermissionValidator: {
+		DisplayName: "Validate Permission",
+		Description: "Validates that the request has the required scopes.",
+		Properties: map[string]NodeProperty{
+			propertyKeyRequiredScopes: {Type: propertyTypeArray, Description: "Scopes required to continue"},
+		},
+	},
+	ExecutorNameOUCreation: {
+		DisplayName: "Create Organization Unit",
+		Description: "Creates an organization unit.",
+		Properties: map[string]NodeProperty{
+			"parentOuId": {Type: propertyTypeString, Description: "ID of the parent organization unit"},
+		},
+	},
+	ExecutorNameHTTPRequest: {
+		DisplayName: "HTTP Request",
+		Description: "Calls an external HTTP endpoint and maps its response to the flow.",
+		Properties: map[string]NodeProperty{
+			"url":             {Type: propertyTypeString, Description: "URL of the endpoint"},
+			"method":          {Type: propertyTypeString, Enum: []string{"GET", "POST", "PUT", "PATCH", "DELETE"}},
+			"headers":         {Type: propertyTypeObject, Description: "Request headers"},
+			"body":            {Type: propertyTypeObject, Description: "Request body"},
+			"timeout":         {Type: propertyTypeInteger, Description: "Request timeout in seconds"},
+			"responseMapping": {Type: propertyTypeObject, Description: "Response fields mapped to runtime data"},
+			"errorHandling":   {Type: propertyTypeObject, Description: "Failure and retry handling"},
+		},
+	},
+	ExecutorNameUserTypeResolver: {
+		DisplayName: "Resolve User Type",
+		Description: "Resolves the user type of the user being registered.",
+		Properties: map[string]NodeProperty{
+			propertyKeyAllowedUserTypes: {Type: propertyTypeArray, Description: "User types offered to the user"},
+		},
+	},
+	ExecutorNameInviteExecutor: {
+		DisplayName: "Invite",
+		Description: "Generates and verifies an invite link to complete registration.",
+		Modes:       []string{ExecutorModeGenerate, ExecutorModeVerify},
+	},
+	ExecutorNameEmailExecutor: {
+		DisplayName: "Send Email",
+		Description: "Sends an email rendered from a template.",
+		Modes:       []string{ExecutorModeSend},
+		Properties: map[string]NodeProperty{
+			propertyKeyEmailTemplate: {Type: propertyTypeString, Description: "Scenario of the email template"},
+		},
+	},
+	ExecutorNameCredentialSetter: {
+		DisplayName: "Set Credentials",
+		Description: "Sets the credentials of an existing user.",
+	},
+	ExecutorNameAccountActivator: {
+		DisplayName: "Activate Account",
+		Description: "Activates a user account that is pending verification of its email address.",
+	},
+	ExecutorNameCredentialPolicyEvaluator: {
+		DisplayName: "Evaluate Credential Policy",
+		Description: "Checks the credentials of the user against the credential policy of the user type.",
+	},
+	ExecutorNameConsent: {
+		DisplayName: "Consent",
+		Description: "Prompts for and records the user's consent to share attributes with the application.",
+		Properties: map[string]NodeProperty{
+			"timeout": {Type: propertyTypeString, Description: "Time allowed to respond, in seconds"},
+		},
+	},
+	ExecutorNameOUResolver: {
+		DisplayName: "Resolve Organization Unit",
+		Description: "Resolves the organization unit of the user being onboarded.",
+		Properties: map[string]NodeProperty{
+			common.NodePropertyOUResolveFrom: {Type: propertyTypeString,
+				Enum: []string{ouResolveFromCaller, ouResolveFromPrompt, ouResolveFromPromptAll}},
+		},
+	},
+	ExecutorNameAttributeUniquenessValidator: {
+		DisplayName: "Validate Unique Attributes",
+		Description: "Validates that unique attributes are not used by another user.",
+	},
+	ExecutorNameSMSExecutor: {
+		DisplayName: "Send SMS",
+		Description: "Sends an SMS rendered from a template.",
+		Properties: map[string]NodeProperty{
+			propertyKeyNotificationSenderID: {Type: propertyTypeString,
+				Description: "ID of the notification sender"},
+			propertyKeySMSTemplate: {Type: propertyTypeString, Description: "Scenario of the SMS template"},
+		},
+	},
+	ExecutorNameFederatedAuthResolver: {
+		DisplayName: "Resolve Federated User",
+		Description: "Sets the authenticated user from a completed federated authentication.",
+	},
+	ExecutorNameTrustedDevice: {
+		DisplayName: "Trusted Device",
+		Description: "Verifies and registers the trusted device of the user.",
+		Modes:       []string{ExecutorModeVerify, ExecutorModeGenerate},
+	},
+	ExecutorNameDomainRouting: {
+		DisplayName: "Domain Routing",
+		Description: "Routes the user to an identity provider by the domain of their email address.",
+		Properties: map[string]NodeProperty{
+			propertyKeyIdentifierAttribute: {Type: propertyTypeString,
+				Description: "User input holding the email address"},
+		},
+	},
+	ExecutorNameBreakGlass: {
+		DisplayName: "Break-Glass Check",
+		Description: "Verifies the activation of break-glass accounts.",
+	},
+}
+
+// GetExecutorDescriptor returns the descriptor of the named executor. Executors without a descriptor are
+// described by their name alone.
+func GetExecutorDescriptor(name string) ExecutorDescriptor {
+	if descriptor, ok := executorDescriptors[name]; ok {
+		return descriptor
+	}
+	return ExecutorDescriptor{DisplayName: name}
+}
@@ -0,0 +1,54 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package executor
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetExecutorDescriptor(t *testing.T) {
+	descriptor := GetExecutorDescriptor(ExecutorNameEmailExecutor)
+	assert.Equal(t, "Send Email", descriptor.DisplayName)
+	assert.Equal(t, []string{ExecutorModeSend}, descriptor.Modes)
+
+	unknown := GetExecutorDescriptor("CustomExecutor")
+	assert.Equal(t, ExecutorDescriptor{DisplayName: "CustomExecutor"}, unknown)
+}
+
+func TestExecutorDescriptor_ConfigSchema(t *testing.T) {
+	schema := GetExecutorDescriptor(ExecutorNameOUResolver).ConfigSchema()
+
+	assert.Equal(t, "object", schema["type"])
+	assert.Equal(t, true, schema["additionalProperties"])
+	properties := schema["properties"].(map[string]interface{})
+	assert.Equal(t, map[string]interface{}{
+		"type": "string",
+		"enum": []string{ouResolveFromCaller, ouResolveFromPrompt, ouResolveFromPromptAll},
+	}, properties["resolveFrom"])
+
+	schema = GetExecutorDescriptor(ExecutorNamePermissionValidator).ConfigSchema()
+	properties = schema["properties"].(map[string]interface{})
+	assert.Equal(t, map[string]interface{}{"type": "string"},
+		properties[propertyKeyRequiredScopes].(map[string]interface{})["items"])
+
+	schema = ExecutorDescriptor{}.ConfigSchema()
+	assert.Empty(t, schema["properties"])
+}
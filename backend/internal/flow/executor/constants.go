@@ -46,6 +46,11 @@ const (
 	ExecutorNameAttributeUniquenessValidator = "AttributeUniquenessValidator"
 	ExecutorNameSMSExecutor                  = "SMSExecutor"
 	ExecutorNameFederatedAuthResolver        = "FederatedAuthResolverExecutor"
+	ExecutorNameTrustedDevice                = "TrustedDeviceExecutor"
+	ExecutorNameDomainRouting                = "DomainRoutingExecutor"
+	ExecutorNameBreakGlass                   = "BreakGlassExecutor"
+	ExecutorNameAccountActivator             = "AccountActivator"
+	ExecutorNameCredentialPolicyEvaluator    = "CredentialPolicyEvaluator"
 )
 
 // Executor mode constants
@@ -65,6 +70,8 @@ const (
 	userAttributeEmail    = "email"
 	userAttributeGroups   = "groups"
 	userAttributeSub      = "sub"
+	userAttributeLocale   = "locale"
+	userAttributeZoneinfo = "zoneinfo"
 
 	userInputCode  = "code"
 	userInputNonce = "nonce"
@@ -77,6 +84,7 @@ const (
 	userInputOTP              = "otp"
 	userInputMagicLinkToken   = "token"
 	userInputConsentDecisions = "consent_decisions"
+	userInputRememberDevice   = "rememberDevice"
 
 	ouIDKey        = "ouId"
 	defaultOUIDKey = "defaultOUID"
@@ -101,6 +109,10 @@ const (
 	propertyKeyDynamicInputsIncludeOptional            = "includeOptional"
 	propertyKeyDynamicInputsIncludeOptionalCredentials = "includeOptionalCredentials"
 	propertyKeyMaxDynamicInputsPerPrompt               = "maxPerPrompt"
+	propertyKeyPreventUserEnumeration                  = "preventUserEnumeration"
+	propertyKeyAutoRegistrationUserType                = "autoRegistrationUserType"
+	propertyKeyIdentifierAttribute                     = "identifierAttribute"
+	propertyKeyInitialState                            = "initialState"
 )
 
 // nonSearchableInputs contains the list of user inputs/ attributes that are non-searchable.
@@ -115,4 +127,7 @@ const (
 	failureReasonAmbiguousUser        = "User identity is ambiguous"
 	failureReasonInvalidOTP           = "invalid OTP provided"
 	failureReasonInvalidMagicLink     = "Invalid magic link token"
+	failureReasonSandboxUnsupported   = "Operation is not supported in sandbox execution"
+	failureReasonOTPRateLimited       = "Too many OTP requests. Please try again later."
+	failureReasonBreakGlassNotActive  = "Break-glass account is not active"
 )
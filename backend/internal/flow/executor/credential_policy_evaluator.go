@@ -0,0 +1,125 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package executor
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/thunder-id/thunderid/internal/entityprovider"
+	"github.com/thunder-id/thunderid/internal/flow/common"
+	"github.com/thunder-id/thunderid/internal/flow/core"
+	"github.com/thunder-id/thunderid/internal/system/log"
+)
+
+var _ core.ExecutorInterface = (*credentialPolicyEvaluator)(nil)
+
+// failureReasonCredentialPolicyNotSatisfied is the failure reason returned when the credentials of the user
+// do not satisfy the credential policy of the user type.
+const failureReasonCredentialPolicyNotSatisfied = "Credential policy not satisfied"
+
+// credentialPolicyEvaluator evaluates the credentials held by the user against the credential policy of the
+// user type, so that flows can route users to enroll missing credentials.
+//
+// It records the result in the credentialPolicySatisfied runtime key and the credential types the user can
+// enroll in the credentialPolicyMissing runtime key. When the policy is not satisfied it fails, so that the
+// onFailure branch of the node can lead to the enrollment steps.
+type credentialPolicyEvaluator struct {
+	core.ExecutorInterface
+	entityProvider entityprovider.EntityProviderInterface
+	logger         *log.Logger
+}
+
+// newCredentialPolicyEvaluator creates a new instance of the credential policy evaluator executor.
+func newCredentialPolicyEvaluator(
+	flowFactory core.FlowFactoryInterface,
+	entityProvider entityprovider.EntityProviderInterface,
+) *credentialPolicyEvaluator {
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, "CredentialPolicyEvaluator"))
+	base := flowFactory.CreateExecutor(ExecutorNameCredentialPolicyEvaluator, common.ExecutorTypeUtility,
+		[]common.Input{}, []common.Input{})
+	return &credentialPolicyEvaluator{
+		ExecutorInterface: base,
+		entityProvider:    entityProvider,
+		logger:            logger,
+	}
+}
+
+// Execute evaluates the credential policy for the user in the flow context.
+func (e *credentialPolicyEvaluator) Execute(ctx *core.NodeContext) (*common.ExecutorResponse, error) {
+	logger := e.logger.With(log.String(log.LoggerKeyExecutionID, ctx.ExecutionID))
+	logger.Debug("Executing credential policy evaluator")
+
+	execResp := &common.ExecutorResponse{
+		AdditionalData: make(map[string]string),
+		RuntimeData:    make(map[string]string),
+	}
+
+	userID := e.GetUserIDFromContext(ctx)
+	if userID == "" {
+		logger.Debug("User ID not found in flow context")
+		execResp.Status = common.ExecFailure
+		execResp.FailureReason = failureReasonUserNotFound
+		return execResp, nil
+	}
+
+	if ctx.Sandbox {
+		logger.Debug("Sandbox execution, skipping credential policy evaluation")
+		execResp.RuntimeData[common.RuntimeKeyCredentialPolicySatisfied] = dataValueTrue
+		execResp.Status = common.ExecComplete
+		return execResp, nil
+	}
+
+	evaluation, providerErr := e.entityProvider.EvaluateCredentialPolicy(userID)
+	if providerErr != nil {
+		if providerErr.Code == entityprovider.ErrorCodeEntityNotFound {
+			logger.Debug("User not found", log.MaskedString(log.LoggerKeyUserID, userID))
+			execResp.Status = common.ExecFailure
+			execResp.FailureReason = failureReasonUserNotFound
+			return execResp, nil
+		}
+		logger.Error("Failed to evaluate the credential policy", log.MaskedString(log.LoggerKeyUserID, userID),
+			log.String("error", providerErr.Error()))
+		return nil, providerErr
+	}
+
+	if evaluation.Satisfied {
+		logger.Debug("Credential policy satisfied", log.MaskedString(log.LoggerKeyUserID, userID))
+		execResp.RuntimeData[common.RuntimeKeyCredentialPolicySatisfied] = dataValueTrue
+		execResp.Status = common.ExecComplete
+		return execResp, nil
+	}
+
+	missing := slices.Clone(evaluation.MissingRequired)
+	for _, alternative := range evaluation.UnsatisfiedAlternatives {
+		for _, credentialType := range alternative {
+			if !slices.Contains(missing, credentialType) {
+				missing = append(missing, credentialType)
+			}
+		}
+	}
+
+	logger.Debug("Credential policy not satisfied", log.MaskedString(log.LoggerKeyUserID, userID),
+		log.Int("missingFactors", evaluation.MissingFactors))
+	execResp.RuntimeData[common.RuntimeKeyCredentialPolicySatisfied] = dataValueFalse
+	execResp.RuntimeData[common.RuntimeKeyCredentialPolicyMissing] = strings.Join(missing, ",")
+	execResp.Status = common.ExecFailure
+	execResp.FailureReason = failureReasonCredentialPolicyNotSatisfied
+	return execResp, nil
+}
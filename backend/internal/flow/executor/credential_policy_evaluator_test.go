@@ -0,0 +1,129 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package executor
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/entityprovider"
+	"github.com/thunder-id/thunderid/internal/flow/common"
+	"github.com/thunder-id/thunderid/internal/flow/core"
+	"github.com/thunder-id/thunderid/tests/mocks/entityprovidermock"
+	"github.com/thunder-id/thunderid/tests/mocks/flow/coremock"
+)
+
+type CredentialPolicyEvaluatorTestSuite struct {
+	suite.Suite
+	mockFlowFactory    *coremock.FlowFactoryInterfaceMock
+	mockEntityProvider *entityprovidermock.EntityProviderInterfaceMock
+	mockBaseExecutor   *coremock.ExecutorInterfaceMock
+	executor           *credentialPolicyEvaluator
+}
+
+func TestCredentialPolicyEvaluatorSuite(t *testing.T) {
+	suite.Run(t, new(CredentialPolicyEvaluatorTestSuite))
+}
+
+func (suite *CredentialPolicyEvaluatorTestSuite) SetupTest() {
+	suite.mockFlowFactory = coremock.NewFlowFactoryInterfaceMock(suite.T())
+	suite.mockEntityProvider = entityprovidermock.NewEntityProviderInterfaceMock(suite.T())
+	suite.mockBaseExecutor = coremock.NewExecutorInterfaceMock(suite.T())
+
+	suite.mockFlowFactory.On("CreateExecutor", ExecutorNameCredentialPolicyEvaluator, common.ExecutorTypeUtility,
+		[]common.Input{}, []common.Input{}).Return(suite.mockBaseExecutor)
+
+	suite.executor = newCredentialPolicyEvaluator(suite.mockFlowFactory, suite.mockEntityProvider)
+}
+
+func (suite *CredentialPolicyEvaluatorTestSuite) newContext() *core.NodeContext {
+	return &core.NodeContext{
+		ExecutionID: "test-flow",
+		FlowType:    common.FlowTypeAuthentication,
+		RuntimeData: map[string]string{},
+	}
+}
+
+func (suite *CredentialPolicyEvaluatorTestSuite) TestExecute_Satisfied() {
+	ctx := suite.newContext()
+	suite.mockBaseExecutor.On("GetUserIDFromContext", ctx).Return(testUserID)
+	suite.mockEntityProvider.On("EvaluateCredentialPolicy", testUserID).
+		Return(&entityprovider.CredentialPolicyEvaluation{Satisfied: true}, nil)
+
+	resp, err := suite.executor.Execute(ctx)
+
+	suite.NoError(err)
+	suite.Equal(common.ExecComplete, resp.Status)
+	suite.Equal(dataValueTrue, resp.RuntimeData[common.RuntimeKeyCredentialPolicySatisfied])
+}
+
+func (suite *CredentialPolicyEvaluatorTestSuite) TestExecute_NotSatisfied() {
+	ctx := suite.newContext()
+	suite.mockBaseExecutor.On("GetUserIDFromContext", ctx).Return(testUserID)
+	suite.mockEntityProvider.On("EvaluateCredentialPolicy", testUserID).
+		Return(&entityprovider.CredentialPolicyEvaluation{
+			MissingRequired:         []string{"passkey"},
+			UnsatisfiedAlternatives: [][]string{{"pin", "passkey"}},
+		}, nil)
+
+	resp, err := suite.executor.Execute(ctx)
+
+	suite.NoError(err)
+	suite.Equal(common.ExecFailure, resp.Status)
+	suite.Equal(failureReasonCredentialPolicyNotSatisfied, resp.FailureReason)
+	suite.Equal(dataValueFalse, resp.RuntimeData[common.RuntimeKeyCredentialPolicySatisfied])
+	suite.Equal("passkey,pin", resp.RuntimeData[common.RuntimeKeyCredentialPolicyMissing])
+}
+
+func (suite *CredentialPolicyEvaluatorTestSuite) TestExecute_UserNotFound() {
+	ctx := suite.newContext()
+	suite.mockBaseExecutor.On("GetUserIDFromContext", ctx).Return(testUserID)
+	suite.mockEntityProvider.On("EvaluateCredentialPolicy", testUserID).
+		Return(nil, entityprovider.NewEntityProviderError(entityprovider.ErrorCodeEntityNotFound, "", ""))
+
+	resp, err := suite.executor.Execute(ctx)
+
+	suite.NoError(err)
+	suite.Equal(common.ExecFailure, resp.Status)
+	suite.Equal(failureReasonUserNotFound, resp.FailureReason)
+}
+
+func (suite *CredentialPolicyEvaluatorTestSuite) TestExecute_ProviderError() {
+	ctx := suite.newContext()
+	suite.mockBaseExecutor.On("GetUserIDFromContext", ctx).Return(testUserID)
+	suite.mockEntityProvider.On("EvaluateCredentialPolicy", testUserID).
+		Return(nil, entityprovider.NewEntityProviderError(entityprovider.ErrorCodeSystemError, "", ""))
+
+	resp, err := suite.executor.Execute(ctx)
+
+	suite.Error(err)
+	suite.Nil(resp)
+}
+
+func (suite *CredentialPolicyEvaluatorTestSuite) TestExecute_NoUser() {
+	ctx := suite.newContext()
+	suite.mockBaseExecutor.On("GetUserIDFromContext", ctx).Return("")
+
+	resp, err := suite.executor.Execute(ctx)
+
+	suite.NoError(err)
+	suite.Equal(common.ExecFailure, resp.Status)
+	suite.mockEntityProvider.AssertNotCalled(suite.T(), "EvaluateCredentialPolicy", testUserID)
+}
@@ -21,23 +21,29 @@ package executor
 import (
 	"encoding/json"
 
+	"github.com/thunder-id/thunderid/internal/accountprotection"
 	"github.com/thunder-id/thunderid/internal/entityprovider"
 	"github.com/thunder-id/thunderid/internal/flow/common"
 	"github.com/thunder-id/thunderid/internal/flow/core"
+	"github.com/thunder-id/thunderid/internal/securitynotification"
 	"github.com/thunder-id/thunderid/internal/system/log"
 )
 
 // credentialSetter allows users to set their credentials for an existing user account.
 type credentialSetter struct {
 	core.ExecutorInterface
-	entityProvider entityprovider.EntityProviderInterface
-	logger         *log.Logger
+	entityProvider    entityprovider.EntityProviderInterface
+	securityNotifier  securitynotification.SecurityNotificationServiceInterface
+	accountProtection accountprotection.AccountProtectionServiceInterface
+	logger            *log.Logger
 }
 
 // newCredentialSetter creates a new instance of the credential setter executor.
 func newCredentialSetter(
 	flowFactory core.FlowFactoryInterface,
 	entityProvider entityprovider.EntityProviderInterface,
+	securityNotifier securitynotification.SecurityNotificationServiceInterface,
+	accountProtection accountprotection.AccountProtectionServiceInterface,
 ) *credentialSetter {
 	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, "CredentialSetter"))
 	base := flowFactory.CreateExecutor(
@@ -61,6 +67,8 @@ func newCredentialSetter(
 	return &credentialSetter{
 		ExecutorInterface: base,
 		entityProvider:    entityProvider,
+		securityNotifier:  securityNotifier,
+		accountProtection: accountProtection,
 		logger:            logger,
 	}
 }
@@ -135,15 +143,34 @@ func (e *credentialSetter) Execute(ctx *core.NodeContext) (*common.ExecutorRespo
 	}
 
 	// Update user credentials
+	if ctx.Sandbox {
+		logger.Debug("Sandbox execution, skipping credential update")
+		execResp.Status = common.ExecComplete
+		return execResp, nil
+	}
 	svcErr := e.entityProvider.UpdateCredentials(userID, credentials)
 	if svcErr != nil {
 		logger.Debug("Failed to update user credentials", log.MaskedString(log.LoggerKeyUserID, userID))
 		execResp.Status = common.ExecFailure
 		execResp.FailureReason = "Failed to set credentials"
+		if svcErr.Code == entityprovider.ErrorCodeDeniedCredential {
+			execResp.FailureReason = "The password is not allowed"
+		}
 		return execResp, nil
 	}
 
 	logger.Debug("Successfully set credentials for user", log.MaskedString(log.LoggerKeyUserID, userID))
+	if e.accountProtection != nil && ctx.FlowType == common.FlowTypeRecovery {
+		e.accountProtection.RecordPasswordReset(ctx.Context, userID)
+	}
+	// Credentials set while onboarding or registering a user are the first credentials of the user.
+	if ctx.FlowType != common.FlowTypeUserOnboarding && ctx.FlowType != common.FlowTypeRegistration {
+		if e.accountProtection != nil {
+			e.accountProtection.NotifyCredentialChanged(ctx.Context, userID)
+		} else if e.securityNotifier != nil {
+			e.securityNotifier.NotifyCredentialChanged(ctx.Context, userID)
+		}
+	}
 	execResp.Status = common.ExecComplete
 	return execResp, nil
 }
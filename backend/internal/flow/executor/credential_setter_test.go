@@ -28,8 +28,10 @@ import (
 	"github.com/thunder-id/thunderid/internal/entityprovider"
 	"github.com/thunder-id/thunderid/internal/flow/common"
 	"github.com/thunder-id/thunderid/internal/flow/core"
+	"github.com/thunder-id/thunderid/tests/mocks/accountprotectionmock"
 	"github.com/thunder-id/thunderid/tests/mocks/entityprovidermock"
 	"github.com/thunder-id/thunderid/tests/mocks/flow/coremock"
+	"github.com/thunder-id/thunderid/tests/mocks/securitynotificationmock"
 )
 
 type CredentialSetterTestSuite struct {
@@ -57,7 +59,7 @@ func (suite *CredentialSetterTestSuite) SetupTest() {
 			},
 		}).Return(suite.mockBaseExecutor)
 
-	suite.executor = newCredentialSetter(suite.mockFlowFactory, suite.mockEntityProvider)
+	suite.executor = newCredentialSetter(suite.mockFlowFactory, suite.mockEntityProvider, nil, nil)
 }
 
 func (suite *CredentialSetterTestSuite) TestExecute_Success() {
@@ -93,6 +95,76 @@ func (suite *CredentialSetterTestSuite) TestExecute_Success() {
 	assert.Equal(suite.T(), common.ExecComplete, resp.Status)
 }
 
+func (suite *CredentialSetterTestSuite) TestExecute_NotifiesCredentialChange() {
+	tests := []struct {
+		name     string
+		flowType common.FlowType
+		notified bool
+	}{
+		{"Recovery", common.FlowTypeRecovery, true},
+		{"UserOnboarding", common.FlowTypeUserOnboarding, false},
+		{"Registration", common.FlowTypeRegistration, false},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			mockNotifier := securitynotificationmock.NewSecurityNotificationServiceInterfaceMock(suite.T())
+			suite.executor.securityNotifier = mockNotifier
+			ctx := &core.NodeContext{
+				ExecutionID: "test-flow",
+				FlowType:    tc.flowType,
+				UserInputs:  map[string]string{userAttributePassword: "securePass123!"},
+			}
+			suite.mockBaseExecutor.On("HasRequiredInputs", ctx, mock.Anything).Return(true)
+			suite.mockBaseExecutor.On("ValidatePrerequisites", ctx, mock.Anything).Return(true)
+			suite.mockBaseExecutor.On("GetUserIDFromContext", ctx).Return(testUserID)
+			suite.mockBaseExecutor.On("GetRequiredInputs", ctx).Return([]common.Input{
+				{Identifier: userAttributePassword, Type: common.InputTypePassword, Required: true},
+			})
+			suite.mockEntityProvider.On("UpdateCredentials", testUserID, mock.Anything).Return(nil)
+			if tc.notified {
+				mockNotifier.On("NotifyCredentialChanged", mock.Anything, testUserID).Once()
+			}
+
+			resp, err := suite.executor.Execute(ctx)
+
+			assert.NoError(suite.T(), err)
+			assert.Equal(suite.T(), common.ExecComplete, resp.Status)
+			if !tc.notified {
+				mockNotifier.AssertNotCalled(suite.T(), "NotifyCredentialChanged", mock.Anything, mock.Anything)
+			}
+		})
+	}
+}
+
+func (suite *CredentialSetterTestSuite) TestExecute_RecoveryHoldsAccountChanges() {
+	mockProtection := accountprotectionmock.NewAccountProtectionServiceInterfaceMock(suite.T())
+	mockNotifier := securitynotificationmock.NewSecurityNotificationServiceInterfaceMock(suite.T())
+	suite.executor.accountProtection = mockProtection
+	suite.executor.securityNotifier = mockNotifier
+	ctx := &core.NodeContext{
+		ExecutionID: "test-flow",
+		FlowType:    common.FlowTypeRecovery,
+		UserInputs:  map[string]string{userAttributePassword: "securePass123!"},
+	}
+	suite.mockBaseExecutor.On("HasRequiredInputs", ctx, mock.Anything).Return(true)
+	suite.mockBaseExecutor.On("ValidatePrerequisites", ctx, mock.Anything).Return(true)
+	suite.mockBaseExecutor.On("GetUserIDFromContext", ctx).Return(testUserID)
+	suite.mockBaseExecutor.On("GetRequiredInputs", ctx).Return([]common.Input{
+		{Identifier: userAttributePassword, Type: common.InputTypePassword, Required: true},
+	})
+	suite.mockEntityProvider.On("UpdateCredentials", testUserID, mock.Anything).Return(nil)
+	mockProtection.On("RecordPasswordReset", mock.Anything, testUserID).Once()
+	mockProtection.On("NotifyCredentialChanged", mock.Anything, testUserID).Once()
+
+	resp, err := suite.executor.Execute(ctx)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), common.ExecComplete, resp.Status)
+	mockNotifier.AssertNotCalled(suite.T(), "NotifyCredentialChanged", mock.Anything, mock.Anything)
+}
+
 func (suite *CredentialSetterTestSuite) TestExecute_MissingInput() {
 	ctx := &core.NodeContext{
 		ExecutionID: "test-flow",
@@ -0,0 +1,119 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package executor
+
+import (
+	"fmt"
+
+	"github.com/thunder-id/thunderid/internal/domainrouting"
+	"github.com/thunder-id/thunderid/internal/flow/common"
+	"github.com/thunder-id/thunderid/internal/flow/core"
+	"github.com/thunder-id/thunderid/internal/system/log"
+)
+
+var _ core.ExecutorInterface = (*domainRoutingExecutor)(nil)
+
+const (
+	domainRoutingLoggerComponentName = "DomainRoutingExecutor"
+	defaultIdentifierAttribute       = "email"
+)
+
+// domainRoutingExecutor performs home realm discovery by resolving the email domain of the identifier
+// collected in a previous step against the active domain routes.
+//
+// It records the outcome in the domainRouteMatched, domainRouteOuId, domainRouteIdpId and domainRouteBranch
+// runtime keys, which subsequent nodes can reference in their condition to branch the flow. The organization
+// unit of a matched route is also set as the ouId runtime key so that it is used by subsequent executors.
+type domainRoutingExecutor struct {
+	core.ExecutorInterface
+	domainRoutingService domainrouting.DomainRoutingServiceInterface
+	logger               *log.Logger
+}
+
+// newDomainRoutingExecutor creates a new instance of the domain routing executor.
+func newDomainRoutingExecutor(
+	flowFactory core.FlowFactoryInterface,
+	domainRoutingService domainrouting.DomainRoutingServiceInterface,
+) *domainRoutingExecutor {
+	logger := log.GetLogger().With(
+		log.String(log.LoggerKeyComponentName, domainRoutingLoggerComponentName),
+		log.String(log.LoggerKeyExecutorName, ExecutorNameDomainRouting))
+
+	base := flowFactory.CreateExecutor(ExecutorNameDomainRouting, common.ExecutorTypeUtility,
+		[]common.Input{}, []common.Input{})
+
+	return &domainRoutingExecutor{
+		ExecutorInterface:    base,
+		domainRoutingService: domainRoutingService,
+		logger:               logger,
+	}
+}
+
+// Execute resolves the domain route of the identifier and records the outcome in the runtime data.
+func (e *domainRoutingExecutor) Execute(ctx *core.NodeContext) (*common.ExecutorResponse, error) {
+	logger := e.logger.With(log.String(log.LoggerKeyExecutionID, ctx.ExecutionID))
+	logger.Debug("Executing domain routing executor")
+
+	execResp := &common.ExecutorResponse{
+		AdditionalData: make(map[string]string),
+		RuntimeData:    make(map[string]string),
+	}
+	execResp.Status = common.ExecComplete
+	execResp.RuntimeData[common.RuntimeKeyDomainRouteMatched] = dataValueFalse
+
+	identifier := e.getIdentifier(ctx)
+	if identifier == "" {
+		logger.Debug("No identifier available for domain routing")
+		return execResp, nil
+	}
+
+	route, svcErr := e.domainRoutingService.ResolveRoute(ctx.Context, identifier)
+	if svcErr != nil {
+		return nil, fmt.Errorf("failed to resolve domain route: %s", svcErr.Code)
+	}
+	if route == nil {
+		logger.Debug("No active domain route matched the identifier")
+		return execResp, nil
+	}
+
+	execResp.RuntimeData[common.RuntimeKeyDomainRouteMatched] = dataValueTrue
+	execResp.RuntimeData[common.RuntimeKeyDomainRouteOUID] = route.OUID
+	execResp.RuntimeData[common.RuntimeKeyDomainRouteIDPID] = route.IDPID
+	execResp.RuntimeData[common.RuntimeKeyDomainRouteBranch] = route.FlowBranch
+	if route.OUID != "" {
+		execResp.RuntimeData[ouIDKey] = route.OUID
+	}
+
+	logger.Debug("Domain route matched", log.String("routeID", route.ID), log.String("domain", route.Domain))
+	return execResp, nil
+}
+
+// getIdentifier returns the value of the identifier attribute configured in the node properties from the
+// user inputs, falling back to the runtime data.
+func (e *domainRoutingExecutor) getIdentifier(ctx *core.NodeContext) string {
+	attribute := defaultIdentifierAttribute
+	if val, ok := ctx.NodeProperties[propertyKeyIdentifierAttribute].(string); ok && val != "" {
+		attribute = val
+	}
+
+	if val := ctx.UserInputs[attribute]; val != "" {
+		return val
+	}
+	return ctx.RuntimeData[attribute]
+}
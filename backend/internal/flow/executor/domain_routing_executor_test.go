@@ -0,0 +1,140 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package executor
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/domainrouting"
+	"github.com/thunder-id/thunderid/internal/flow/common"
+	"github.com/thunder-id/thunderid/internal/flow/core"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/tests/mocks/domainroutingmock"
+	"github.com/thunder-id/thunderid/tests/mocks/flow/coremock"
+)
+
+type DomainRoutingExecutorTestSuite struct {
+	suite.Suite
+	mockFlowFactory   *coremock.FlowFactoryInterfaceMock
+	mockDomainRouting *domainroutingmock.DomainRoutingServiceInterfaceMock
+	executor          *domainRoutingExecutor
+}
+
+func TestDomainRoutingExecutorSuite(t *testing.T) {
+	suite.Run(t, new(DomainRoutingExecutorTestSuite))
+}
+
+func (suite *DomainRoutingExecutorTestSuite) SetupTest() {
+	suite.mockFlowFactory = coremock.NewFlowFactoryInterfaceMock(suite.T())
+	suite.mockDomainRouting = domainroutingmock.NewDomainRoutingServiceInterfaceMock(suite.T())
+	mockBaseExecutor := coremock.NewExecutorInterfaceMock(suite.T())
+
+	suite.mockFlowFactory.On("CreateExecutor", ExecutorNameDomainRouting, common.ExecutorTypeUtility,
+		[]common.Input{}, []common.Input{}).Return(mockBaseExecutor)
+
+	suite.executor = newDomainRoutingExecutor(suite.mockFlowFactory, suite.mockDomainRouting)
+}
+
+func (suite *DomainRoutingExecutorTestSuite) newNodeContext() *core.NodeContext {
+	return &core.NodeContext{
+		Context:     context.Background(),
+		ExecutionID: "test-flow-id",
+		UserInputs:  make(map[string]string),
+		RuntimeData: make(map[string]string),
+	}
+}
+
+func (suite *DomainRoutingExecutorTestSuite) TestExecute_RouteMatched() {
+	ctx := suite.newNodeContext()
+	ctx.UserInputs["email"] = "alice@example.com"
+	suite.mockDomainRouting.On("ResolveRoute", mock.Anything, "alice@example.com").Return(&domainrouting.DomainRoute{
+		ID:         "route-1",
+		Domain:     "example.com",
+		OUID:       "ou-1",
+		IDPID:      "idp-1",
+		FlowBranch: "enterprise",
+		Status:     domainrouting.RouteStatusActive,
+	}, nil).Once()
+
+	resp, err := suite.executor.Execute(ctx)
+
+	suite.NoError(err)
+	suite.Equal(common.ExecComplete, resp.Status)
+	suite.Equal(dataValueTrue, resp.RuntimeData[common.RuntimeKeyDomainRouteMatched])
+	suite.Equal("ou-1", resp.RuntimeData[common.RuntimeKeyDomainRouteOUID])
+	suite.Equal("idp-1", resp.RuntimeData[common.RuntimeKeyDomainRouteIDPID])
+	suite.Equal("enterprise", resp.RuntimeData[common.RuntimeKeyDomainRouteBranch])
+	suite.Equal("ou-1", resp.RuntimeData[ouIDKey])
+}
+
+func (suite *DomainRoutingExecutorTestSuite) TestExecute_IdentifierFromRuntimeDataWithCustomAttribute() {
+	ctx := suite.newNodeContext()
+	ctx.NodeProperties = map[string]interface{}{propertyKeyIdentifierAttribute: "username"}
+	ctx.RuntimeData["username"] = "bob@example.org"
+	suite.mockDomainRouting.On("ResolveRoute", mock.Anything, "bob@example.org").Return(&domainrouting.DomainRoute{
+		ID:         "route-2",
+		Domain:     "*.example.org",
+		FlowBranch: "partners",
+		Status:     domainrouting.RouteStatusActive,
+	}, nil).Once()
+
+	resp, err := suite.executor.Execute(ctx)
+
+	suite.NoError(err)
+	suite.Equal(dataValueTrue, resp.RuntimeData[common.RuntimeKeyDomainRouteMatched])
+	suite.Equal("partners", resp.RuntimeData[common.RuntimeKeyDomainRouteBranch])
+	suite.NotContains(resp.RuntimeData, ouIDKey)
+}
+
+func (suite *DomainRoutingExecutorTestSuite) TestExecute_NoRoute() {
+	ctx := suite.newNodeContext()
+	ctx.UserInputs["email"] = "alice@unknown.com"
+	suite.mockDomainRouting.On("ResolveRoute", mock.Anything, "alice@unknown.com").Return(nil, nil).Once()
+
+	resp, err := suite.executor.Execute(ctx)
+
+	suite.NoError(err)
+	suite.Equal(common.ExecComplete, resp.Status)
+	suite.Equal(dataValueFalse, resp.RuntimeData[common.RuntimeKeyDomainRouteMatched])
+	suite.NotContains(resp.RuntimeData, common.RuntimeKeyDomainRouteIDPID)
+}
+
+func (suite *DomainRoutingExecutorTestSuite) TestExecute_MissingIdentifier() {
+	resp, err := suite.executor.Execute(suite.newNodeContext())
+
+	suite.NoError(err)
+	suite.Equal(common.ExecComplete, resp.Status)
+	suite.Equal(dataValueFalse, resp.RuntimeData[common.RuntimeKeyDomainRouteMatched])
+}
+
+func (suite *DomainRoutingExecutorTestSuite) TestExecute_ServiceError() {
+	ctx := suite.newNodeContext()
+	ctx.UserInputs["email"] = "alice@example.com"
+	suite.mockDomainRouting.On("ResolveRoute", mock.Anything, "alice@example.com").
+		Return(nil, &serviceerror.InternalServerError).Once()
+
+	resp, err := suite.executor.Execute(ctx)
+
+	suite.Error(err)
+	suite.Nil(resp)
+}
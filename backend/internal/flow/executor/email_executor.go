@@ -25,6 +25,8 @@ import (
 	"github.com/thunder-id/thunderid/internal/entityprovider"
 	"github.com/thunder-id/thunderid/internal/flow/common"
 	"github.com/thunder-id/thunderid/internal/flow/core"
+	notifcm "github.com/thunder-id/thunderid/internal/notification/common"
+	"github.com/thunder-id/thunderid/internal/ou"
 	"github.com/thunder-id/thunderid/internal/system/email"
 	"github.com/thunder-id/thunderid/internal/system/log"
 	"github.com/thunder-id/thunderid/internal/system/template"
@@ -38,6 +40,7 @@ type emailExecutor struct {
 	emailClient     email.EmailClientInterface
 	templateService template.TemplateServiceInterface
 	entityProvider  entityprovider.EntityProviderInterface
+	ouService       ou.OrganizationUnitServiceInterface
 }
 
 // defaultEmailInput is the default input definition for email collection.
@@ -51,7 +54,8 @@ var defaultEmailInput = common.Input{
 // newEmailExecutor creates a new instance of the email executor.
 func newEmailExecutor(flowFactory core.FlowFactoryInterface, emailClient email.EmailClientInterface,
 	templateService template.TemplateServiceInterface,
-	entityProvider entityprovider.EntityProviderInterface) *emailExecutor {
+	entityProvider entityprovider.EntityProviderInterface,
+	ouService ou.OrganizationUnitServiceInterface) *emailExecutor {
 	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, "EmailExecutor"))
 	base := flowFactory.CreateExecutor(
 		ExecutorNameEmailExecutor,
@@ -67,6 +71,7 @@ func newEmailExecutor(flowFactory core.FlowFactoryInterface, emailClient email.E
 		emailClient:       emailClient,
 		templateService:   templateService,
 		entityProvider:    entityProvider,
+		ouService:         ouService,
 	}
 }
 
@@ -138,6 +143,11 @@ func (e *emailExecutor) executeSend(ctx *core.NodeContext) (*common.ExecutorResp
 	}
 
 	templateData := e.resolveTemplateData(ctx)
+	if _, ok := templateData[template.TemplateDataKeyLocale]; !ok {
+		if locale := e.resolveRecipientLocale(ctx, logger); locale != "" {
+			templateData[template.TemplateDataKeyLocale] = locale
+		}
+	}
 	rendered, svcErr := e.templateService.Render(ctx.Context, scenario, template.TemplateTypeEmail, templateData)
 	if svcErr != nil {
 		return nil, fmt.Errorf("failed to render email template: %s", svcErr.Code)
@@ -150,6 +160,20 @@ func (e *emailExecutor) executeSend(ctx *core.NodeContext) (*common.ExecutorResp
 		IsHTML:  rendered.IsHTML,
 	}
 
+	if capture := notifcm.GetNotificationCapture(ctx.Context); capture != nil {
+		capture.Capture(notifcm.CapturedNotification{
+			Channel:   notifcm.ChannelTypeEmail,
+			Recipient: recipient,
+			Subject:   rendered.Subject,
+			Body:      rendered.Body,
+		})
+		logger.Debug("Email captured instead of being sent", log.MaskedString("recipient", recipient))
+
+		execResp.AdditionalData[common.DataEmailSent] = dataValueTrue
+		execResp.Status = common.ExecComplete
+		return execResp, nil
+	}
+
 	if err := e.emailClient.Send(emailData); err != nil {
 		if isEmailError(err) {
 			logger.Error("Error sending mail : ", log.Error(err))
@@ -205,6 +229,37 @@ func (e *emailExecutor) resolveRecipientEmail(ctx *core.NodeContext, logger *log
 	return "", nil
 }
 
+// resolveRecipientLocale resolves the preferred locale of the user the email is sent to, falling back to
+// the default locale of the user's organization unit and then of the deployment. It returns an empty
+// string when there is no user in the runtime data or the user cannot be read; the default template is
+// rendered in that case.
+func (e *emailExecutor) resolveRecipientLocale(ctx *core.NodeContext, logger *log.Logger) string {
+	userID := ctx.RuntimeData[userAttributeUserID]
+	if userID == "" || e.entityProvider == nil {
+		return ""
+	}
+
+	user, providerErr := e.entityProvider.GetEntity(userID)
+	if providerErr != nil || user == nil {
+		logger.Debug("Could not read the recipient to resolve the preferred locale",
+			log.MaskedString(log.LoggerKeyUserID, userID))
+		return ""
+	}
+
+	preferred := ou.Localization{}
+	if locale, err := GetUserAttribute(user, userAttributeLocale); err == nil {
+		preferred.Locale = locale
+	}
+	resolved, svcErr := ou.ResolveLocalization(ctx.Context, e.ouService, user.OUID, preferred)
+	if svcErr != nil {
+		logger.Debug("Failed to resolve the preferred locale of the recipient",
+			log.String("error", svcErr.Error.DefaultValue))
+		return preferred.Locale
+	}
+
+	return resolved.Locale
+}
+
 // resolveTemplateData extracts template data from forwarded data or initializes an empty map if not present.
 func (e *emailExecutor) resolveTemplateData(ctx *core.NodeContext) template.TemplateData {
 	if ctx.ForwardedData != nil {
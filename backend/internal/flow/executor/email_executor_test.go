@@ -19,20 +19,26 @@
 package executor
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 
 	"github.com/thunder-id/thunderid/internal/entityprovider"
 	"github.com/thunder-id/thunderid/internal/flow/common"
 	"github.com/thunder-id/thunderid/internal/flow/core"
+	notifcm "github.com/thunder-id/thunderid/internal/notification/common"
+	"github.com/thunder-id/thunderid/internal/ou"
+	"github.com/thunder-id/thunderid/internal/system/config"
 	"github.com/thunder-id/thunderid/internal/system/email"
 	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
 	"github.com/thunder-id/thunderid/internal/system/template"
 	"github.com/thunder-id/thunderid/tests/mocks/emailmock"
 	"github.com/thunder-id/thunderid/tests/mocks/entityprovidermock"
 	"github.com/thunder-id/thunderid/tests/mocks/flow/coremock"
+	"github.com/thunder-id/thunderid/tests/mocks/oumock"
 	"github.com/thunder-id/thunderid/tests/mocks/templatemock"
 )
 
@@ -46,6 +52,9 @@ type EmailExecutorTestSuite struct {
 }
 
 func (suite *EmailExecutorTestSuite) SetupTest() {
+	// Initialize runtime for localization config access
+	_ = initializeTestRuntime()
+
 	suite.mockFlowFactory = coremock.NewFlowFactoryInterfaceMock(suite.T())
 	mockBaseExecutor := coremock.NewExecutorInterfaceMock(suite.T())
 	suite.mockEmailClient = emailmock.NewEmailClientInterfaceMock(suite.T())
@@ -66,6 +75,7 @@ func (suite *EmailExecutorTestSuite) SetupTest() {
 		suite.mockEmailClient,
 		suite.mockTemplateService,
 		suite.mockEntityProvider,
+		nil,
 	)
 }
 
@@ -111,6 +121,50 @@ func (suite *EmailExecutorTestSuite) TestExecute_SendMode_UserInviteTemplate_Suc
 	suite.Equal(dataValueTrue, resp.AdditionalData[common.DataEmailSent])
 }
 
+func (suite *EmailExecutorTestSuite) TestExecute_SendMode_CapturedInSandbox() {
+	capture := notifcm.NewNotificationCapture()
+	ctx := &core.NodeContext{
+		Context:      notifcm.WithNotificationCapture(context.Background(), capture),
+		ExecutionID:  "test-execution-id",
+		FlowType:     common.FlowTypeUserOnboarding,
+		ExecutorMode: ExecutorModeSend,
+		Sandbox:      true,
+		UserInputs: map[string]string{
+			"email": "user@example.com",
+		},
+		RuntimeData: map[string]string{
+			common.RuntimeKeyInviteLink: "https://localhost:5190/gate/invite?executionId=test&inviteToken=abc",
+		},
+		NodeProperties: map[string]interface{}{
+			"emailTemplate": "USER_INVITE",
+		},
+	}
+
+	suite.mockTemplateService.On("Render",
+		ctx.Context,
+		template.ScenarioUserInvite,
+		template.TemplateTypeEmail,
+		template.TemplateData{},
+	).Return(&template.RenderedTemplate{
+		Subject: "You're Invited to Register",
+		Body:    "<html><body>Complete Registration</body></html>",
+		IsHTML:  true,
+	}, nil)
+
+	resp, err := suite.executor.Execute(ctx)
+
+	suite.NoError(err)
+	suite.Equal(common.ExecComplete, resp.Status)
+	suite.Equal(dataValueTrue, resp.AdditionalData[common.DataEmailSent])
+	suite.Equal([]notifcm.CapturedNotification{{
+		Channel:   notifcm.ChannelTypeEmail,
+		Recipient: "user@example.com",
+		Subject:   "You're Invited to Register",
+		Body:      "<html><body>Complete Registration</body></html>",
+	}}, capture.Notifications())
+	suite.mockEmailClient.AssertNotCalled(suite.T(), "Send", mock.Anything)
+}
+
 func (suite *EmailExecutorTestSuite) TestExecute_SendMode_SelfRegistration_InviteLinkNotExposed() {
 	ctx := &core.NodeContext{
 		ExecutionID:  "test-execution-id",
@@ -476,7 +530,7 @@ func (suite *EmailExecutorTestSuite) TestExecute_SendMode_NilTemplateService() {
 		},
 	).Return(mockBaseExecutor)
 
-	noServiceExecutor := newEmailExecutor(mockFactory, suite.mockEmailClient, nil, suite.mockEntityProvider)
+	noServiceExecutor := newEmailExecutor(mockFactory, suite.mockEmailClient, nil, suite.mockEntityProvider, nil)
 
 	ctx := &core.NodeContext{
 		ExecutionID:  "test-execution-id",
@@ -649,7 +703,7 @@ func (suite *EmailExecutorTestSuite) TestExecute_SendMode_NilEmailClient_Returns
 		},
 	).Return(mockBaseExecutor)
 
-	noEmailExecutor := newEmailExecutor(mockFactory, nil, suite.mockTemplateService, suite.mockEntityProvider)
+	noEmailExecutor := newEmailExecutor(mockFactory, nil, suite.mockTemplateService, suite.mockEntityProvider, nil)
 
 	ctx := &core.NodeContext{
 		ExecutionID:  "test-execution-id",
@@ -869,6 +923,51 @@ func (suite *EmailExecutorTestSuite) TestExecute_SendMode_ResolvesEmailFromEntit
 	suite.Equal(common.ExecComplete, resp.Status)
 }
 
+func (suite *EmailExecutorTestSuite) TestExecute_SendMode_RendersTemplateInRecipientOULocale() {
+	config.ResetServerRuntime()
+	suite.Require().NoError(config.InitializeServerRuntime("/tmp/test", &config.Config{
+		Localization: config.LocalizationConfig{DefaultLocale: "en-US", DefaultZoneinfo: "UTC"},
+	}))
+	suite.T().Cleanup(config.ResetServerRuntime)
+
+	mockOUService := oumock.NewOrganizationUnitServiceInterfaceMock(suite.T())
+	mockOUService.On("GetOrganizationUnit", mock.Anything, "ou-fr").
+		Return(ou.OrganizationUnit{ID: "ou-fr", Locale: "fr-CA"}, nil)
+	suite.executor.ouService = mockOUService
+
+	ctx := &core.NodeContext{
+		ExecutionID:  "test-execution-id",
+		ExecutorMode: ExecutorModeSend,
+		RuntimeData: map[string]string{
+			userAttributeUserID: "test-db-user-id",
+		},
+	}
+
+	mockEntity := &entityprovider.Entity{
+		ID:         "test-db-user-id",
+		OUID:       "ou-fr",
+		Attributes: []byte(`{"email":"user@example.com"}`),
+	}
+	suite.mockEntityProvider.On("GetEntity", "test-db-user-id").Return(mockEntity, nil)
+
+	suite.mockTemplateService.On("Render",
+		ctx.Context,
+		template.ScenarioUserInvite,
+		template.TemplateTypeEmail,
+		template.TemplateData{template.TemplateDataKeyLocale: "fr-CA"},
+	).Return(&template.RenderedTemplate{Subject: "Invitation", Body: "Bonjour"}, nil)
+	suite.mockEmailClient.On("Send", email.EmailData{
+		To:      []string{"user@example.com"},
+		Subject: "Invitation",
+		Body:    "Bonjour",
+	}).Return(nil)
+
+	resp, err := suite.executor.Execute(ctx)
+
+	suite.NoError(err)
+	suite.Equal(common.ExecComplete, resp.Status)
+}
+
 func (suite *EmailExecutorTestSuite) TestExecute_SendMode_ForwardedDataInvalidType() {
 	ctx := &core.NodeContext{
 		ExecutionID:  "test-execution-id",
@@ -993,7 +1092,7 @@ func (suite *EmailExecutorTestSuite) TestExecute_SendMode_NilEntityProvider_Retu
 	).Return(mockBaseExecutor)
 
 	// Create executor with nil entity provider
-	noProviderExecutor := newEmailExecutor(mockFactory, suite.mockEmailClient, suite.mockTemplateService, nil)
+	noProviderExecutor := newEmailExecutor(mockFactory, suite.mockEmailClient, suite.mockTemplateService, nil, nil)
 
 	ctx := &core.NodeContext{
 		ExecutionID:  "test-execution-id",
@@ -20,6 +20,7 @@ package executor
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -122,6 +123,13 @@ func (h *httpRequestExecutor) Execute(ctx *core.NodeContext) (*common.ExecutorRe
 	h.enrichOURuntimeData(ctx, config)
 	h.resolvePlaceholders(ctx, config)
 
+	if ctx.Sandbox {
+		logger.Debug("Sandbox execution, skipping HTTP request", log.String("method", config.Method),
+			log.MaskedString("url", config.URL))
+		execResp.Status = common.ExecComplete
+		return execResp, nil
+	}
+
 	response, err := h.executeRequestWithRetry(ctx, config)
 	if err != nil {
 		logger.Error("Failed to execute HTTP request", log.Error(err))
@@ -414,8 +422,12 @@ func (h *httpRequestExecutor) executeRequest(ctx *core.NodeContext, config *http
 		bodyReader = bytes.NewReader(bodyBytes)
 	}
 
-	// Create HTTP request
-	req, err := http.NewRequest(config.Method, config.URL, bodyReader)
+	// Create HTTP request bound to the flow request, so it is abandoned once the request deadline passes
+	reqCtx := ctx.Context
+	if reqCtx == nil {
+		reqCtx = context.Background()
+	}
+	req, err := http.NewRequestWithContext(reqCtx, config.Method, config.URL, bodyReader)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create HTTP request: %w", err)
 	}
@@ -23,6 +23,7 @@ import (
 	"errors"
 	"slices"
 
+	"github.com/thunder-id/thunderid/internal/appuser"
 	"github.com/thunder-id/thunderid/internal/entityprovider"
 	"github.com/thunder-id/thunderid/internal/flow/common"
 	"github.com/thunder-id/thunderid/internal/flow/core"
@@ -44,6 +45,9 @@ type identifyingExecutorInterface interface {
 type identifyingExecutor struct {
 	core.ExecutorInterface
 	entityProvider entityprovider.EntityProviderInterface
+	// appUserService scopes the identified users to the flow's application. It is only consulted by
+	// Execute, so executors embedding the identifying executor may leave it nil.
+	appUserService appuser.AppUserServiceInterface
 	logger         *log.Logger
 }
 
@@ -56,6 +60,7 @@ func newIdentifyingExecutor(
 	defaultInputs, prerequisites []common.Input,
 	flowFactory core.FlowFactoryInterface,
 	entityProvider entityprovider.EntityProviderInterface,
+	appUserService appuser.AppUserServiceInterface,
 ) *identifyingExecutor {
 	if name == "" {
 		name = ExecutorNameIdentifying
@@ -68,6 +73,7 @@ func newIdentifyingExecutor(
 	return &identifyingExecutor{
 		ExecutorInterface: base,
 		entityProvider:    entityProvider,
+		appUserService:    appUserService,
 		logger:            logger,
 	}
 }
@@ -179,6 +185,19 @@ func (i *identifyingExecutor) executeIdentify(ctx *core.NodeContext,
 		return execResp, nil
 	}
 
+	visible, err := isUserVisibleToApplication(ctx, i.appUserService, *userID)
+	if err != nil {
+		logger.Error("Failed to check the application association of the user", log.Error(err))
+		return nil, err
+	}
+	if !visible {
+		logger.Debug("Identified user is not associated with the application")
+		execResp.Status = common.ExecUserInputRequired
+		execResp.Inputs = i.GetRequiredInputs(ctx)
+		execResp.FailureReason = failureReasonUserNotFound
+		return execResp, nil
+	}
+
 	execResp.RuntimeData[userAttributeUserID] = *userID
 	execResp.Status = common.ExecComplete
 
@@ -253,7 +272,33 @@ func (i *identifyingExecutor) getCandidates(ctx *core.NodeContext,
 	if hasCandidates {
 		return i.getFilteredCandidates(storedCandidates, searchAttrs, logger)
 	}
-	return i.searchCandidates(searchAttrs, logger)
+
+	candidates, err := i.searchCandidates(searchAttrs, logger)
+	if err != nil {
+		return nil, err
+	}
+	return i.filterVisibleCandidates(ctx, candidates, logger)
+}
+
+// filterVisibleCandidates drops the candidates that are not visible to the flow's application.
+func (i *identifyingExecutor) filterVisibleCandidates(ctx *core.NodeContext,
+	candidates []*entityprovider.Entity, logger *log.Logger) ([]*entityprovider.Entity, error) {
+	if !isApplicationUserIsolationEnabled(ctx, i.appUserService) {
+		return candidates, nil
+	}
+
+	visibleCandidates := make([]*entityprovider.Entity, 0, len(candidates))
+	for _, candidate := range candidates {
+		visible, err := isUserVisibleToApplication(ctx, i.appUserService, candidate.ID)
+		if err != nil {
+			logger.Debug("Failed to check the application association of a candidate user: " + err.Error())
+			return nil, errors.New(failureReasonFailedToIdentifyUser)
+		}
+		if visible {
+			visibleCandidates = append(visibleCandidates, candidate)
+		}
+	}
+	return visibleCandidates, nil
 }
 
 // searchCandidates performs the initial database search for matching users.
@@ -19,6 +19,7 @@
 package executor
 
 import (
+	"context"
 	"encoding/json"
 	"testing"
 
@@ -26,9 +27,12 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 
+	appmodel "github.com/thunder-id/thunderid/internal/application/model"
 	"github.com/thunder-id/thunderid/internal/entityprovider"
 	"github.com/thunder-id/thunderid/internal/flow/common"
 	"github.com/thunder-id/thunderid/internal/flow/core"
+	inboundmodel "github.com/thunder-id/thunderid/internal/inboundclient/model"
+	"github.com/thunder-id/thunderid/tests/mocks/appusermock"
 	"github.com/thunder-id/thunderid/tests/mocks/entityprovidermock"
 	"github.com/thunder-id/thunderid/tests/mocks/flow/coremock"
 )
@@ -53,7 +57,7 @@ func (suite *IdentifyingExecutorTestSuite) SetupTest() {
 		[]common.Input{}, []common.Input{}).Return(mockExec)
 
 	suite.executor = newIdentifyingExecutor(ExecutorNameIdentifying, []common.Input{},
-		[]common.Input{}, suite.mockFlowFactory, suite.mockEntityProvider)
+		[]common.Input{}, suite.mockFlowFactory, suite.mockEntityProvider, nil)
 }
 
 func (suite *IdentifyingExecutorTestSuite) TestNewIdentifyingExecutor() {
@@ -67,6 +71,7 @@ func (suite *IdentifyingExecutorTestSuite) TestNewIdentifyingExecutor() {
 		[]common.Input{},
 		suite.mockFlowFactory,
 		suite.mockEntityProvider,
+		nil,
 	)
 	assert.NotNil(suite.T(), exec)
 }
@@ -919,3 +924,72 @@ func TestExtractDisambiguationOptions(t *testing.T) {
 
 	assert.NotContains(t, inputsByKey, "given_name")
 }
+
+// --- Application user isolation tests ---
+
+func (suite *IdentifyingExecutorTestSuite) newIsolatedContext(mode string) *core.NodeContext {
+	return &core.NodeContext{
+		Context:      context.Background(),
+		ExecutionID:  "flow-123",
+		ExecutorMode: mode,
+		UserInputs:   map[string]string{"username": "testuser"},
+		RuntimeData:  make(map[string]string),
+		Application: appmodel.Application{
+			ID:                 "app-1",
+			InboundAuthProfile: inboundmodel.InboundAuthProfile{UserIsolation: true},
+		},
+	}
+}
+
+func (suite *IdentifyingExecutorTestSuite) TestExecute_UserIsolation_UnassociatedUserNotFound() {
+	mockAppUserService := appusermock.NewAppUserServiceInterfaceMock(suite.T())
+	suite.executor.appUserService = mockAppUserService
+	ctx := suite.newIsolatedContext("")
+
+	mockBase := suite.executor.ExecutorInterface.(*coremock.ExecutorInterfaceMock)
+	mockBase.On("HasRequiredInputs", mock.Anything, mock.Anything).Return(true)
+	mockBase.On("GetRequiredInputs", mock.Anything).Return([]common.Input{
+		{Identifier: "username", Type: "string", Required: true},
+	})
+
+	userID := testUserID
+	suite.mockEntityProvider.On("IdentifyEntity", map[string]interface{}{"username": "testuser"}).
+		Return(&userID, nil)
+	mockAppUserService.On("IsEnabled").Return(true)
+	mockAppUserService.On("IsAppUser", ctx.Context, "app-1", testUserID).Return(false, nil).Once()
+
+	resp, err := suite.executor.Execute(ctx)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), common.ExecUserInputRequired, resp.Status)
+	assert.Equal(suite.T(), failureReasonUserNotFound, resp.FailureReason)
+	assert.Empty(suite.T(), resp.RuntimeData[userAttributeUserID])
+}
+
+func (suite *IdentifyingExecutorTestSuite) TestExecuteResolve_UserIsolation_FiltersUnassociatedCandidates() {
+	mockAppUserService := appusermock.NewAppUserServiceInterfaceMock(suite.T())
+	suite.executor.appUserService = mockAppUserService
+	ctx := suite.newIsolatedContext(ExecutorModeResolve)
+	ctx.UserInputs = map[string]string{"given_name": "Alex"}
+
+	mockBase := suite.executor.ExecutorInterface.(*coremock.ExecutorInterfaceMock)
+	mockBase.On("HasRequiredInputs", mock.Anything, mock.Anything).Return(true)
+	mockBase.On("GetRequiredInputs", mock.Anything).Return([]common.Input{
+		{Identifier: "given_name", Type: "TEXT_INPUT", Required: true},
+	})
+
+	suite.mockEntityProvider.On("SearchEntities", map[string]interface{}{"given_name": "Alex"}).
+		Return([]*entityprovider.Entity{
+			{ID: "user-1", Type: "Person", Attributes: attrsAlexJohnson},
+			{ID: "user-2", Type: "Engineer", Attributes: attrsAlexSmith},
+		}, nil)
+	mockAppUserService.On("IsEnabled").Return(true)
+	mockAppUserService.On("IsAppUser", ctx.Context, "app-1", "user-1").Return(false, nil).Once()
+	mockAppUserService.On("IsAppUser", ctx.Context, "app-1", "user-2").Return(true, nil).Once()
+
+	resp, err := suite.executor.Execute(ctx)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), common.ExecComplete, resp.Status)
+	assert.Equal(suite.T(), "user-2", resp.RuntimeData[userAttributeUserID])
+}
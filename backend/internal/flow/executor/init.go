@@ -19,6 +19,10 @@
 package executor
 
 import (
+	"net/http"
+
+	"github.com/thunder-id/thunderid/internal/accountprotection"
+	"github.com/thunder-id/thunderid/internal/appuser"
 	"github.com/thunder-id/thunderid/internal/attributecache"
 	"github.com/thunder-id/thunderid/internal/authn/assert"
 	"github.com/thunder-id/thunderid/internal/authn/consent"
@@ -31,7 +35,10 @@ import (
 	"github.com/thunder-id/thunderid/internal/authn/passkey"
 	authnprovidermgr "github.com/thunder-id/thunderid/internal/authnprovider/manager"
 	"github.com/thunder-id/thunderid/internal/authz"
+	"github.com/thunder-id/thunderid/internal/breakglass"
+	"github.com/thunder-id/thunderid/internal/domainrouting"
 	"github.com/thunder-id/thunderid/internal/entityprovider"
+	"github.com/thunder-id/thunderid/internal/enumeration"
 	"github.com/thunder-id/thunderid/internal/flow/common"
 	"github.com/thunder-id/thunderid/internal/flow/core"
 	"github.com/thunder-id/thunderid/internal/group"
@@ -39,9 +46,11 @@ import (
 	"github.com/thunder-id/thunderid/internal/notification"
 	"github.com/thunder-id/thunderid/internal/ou"
 	"github.com/thunder-id/thunderid/internal/role"
+	"github.com/thunder-id/thunderid/internal/securitynotification"
 	"github.com/thunder-id/thunderid/internal/system/email"
 	"github.com/thunder-id/thunderid/internal/system/jose/jwt"
 	"github.com/thunder-id/thunderid/internal/system/template"
+	"github.com/thunder-id/thunderid/internal/trusteddevice"
 
 	"github.com/thunder-id/thunderid/internal/entitytype"
 )
@@ -72,12 +81,28 @@ func Initialize(
 	oidcSvc oidc.OIDCAuthnServiceInterface,
 	githubSvc github.GithubOAuthAuthnServiceInterface,
 	googleSvc google.GoogleOIDCAuthnServiceInterface,
+	trustedDeviceService trusteddevice.TrustedDeviceServiceInterface,
+	domainRoutingService domainrouting.DomainRoutingServiceInterface,
+	breakGlassService breakglass.BreakGlassServiceInterface,
+	securityNotifier securitynotification.SecurityNotificationServiceInterface,
+	accountProtection accountprotection.AccountProtectionServiceInterface,
+	enumerationService enumeration.EnumerationResistanceServiceInterface,
+	appUserService appuser.AppUserServiceInterface,
 ) ExecutorRegistryInterface {
 	reg := newExecutorRegistry()
 	reg.RegisterExecutor(ExecutorNameBasicAuth, newBasicAuthExecutor(
-		flowFactory, entityProvider, authnProvider))
+		flowFactory, entityProvider, authnProvider, enumerationService, appUserService))
+	if enumerationService != nil {
+		enumerationService.RegisterNormalizedEndpoint(enumeration.NormalizedEndpoint{
+			Method:      http.MethodPost,
+			Path:        "/flow/execute",
+			Description: "The BasicAuthExecutor reports unknown users as invalid credentials in authentication flows",
+			Normalizations: []enumeration.Normalization{enumeration.NormalizationResponse,
+				enumeration.NormalizationDummyHash, enumeration.NormalizationTiming},
+		})
+	}
 	reg.RegisterExecutor(ExecutorNameSMSAuth, newSMSOTPAuthExecutor(
-		flowFactory, otpService, authnProvider, entityProvider))
+		flowFactory, otpService, authnProvider, entityProvider, entityTypeService))
 	reg.RegisterExecutor(ExecutorNamePasskeyAuth, newPasskeyAuthExecutor(
 		flowFactory, passkeyService, authnProvider, entityProvider))
 	reg.RegisterExecutor(ExecutorNameMagicLinkAuth, newMagicLinkAuthExecutor(
@@ -94,30 +119,37 @@ func Initialize(
 		flowFactory, idpService, entityTypeService, googleSvc, authnProvider))
 
 	reg.RegisterExecutor(ExecutorNameProvisioning, newProvisioningExecutor(flowFactory,
-		groupService, roleService, roleAssignmentService, entityProvider, entityTypeService))
+		groupService, roleService, roleAssignmentService, entityProvider, entityTypeService, appUserService))
 	reg.RegisterExecutor(ExecutorNameOUCreation, newOUExecutor(flowFactory, ouService))
 
 	reg.RegisterExecutor(ExecutorNameAttributeCollect, newAttributeCollector(flowFactory, entityProvider))
 	reg.RegisterExecutor(ExecutorNameAuthAssert, newAuthAssertExecutor(flowFactory, jwtService,
 		ouService, authAssertGen, authnProvider, entityProvider,
-		attributeCacheSvc, roleService))
+		attributeCacheSvc, roleService, appUserService))
 	reg.RegisterExecutor(ExecutorNameAuthorization, newAuthorizationExecutor(flowFactory, authZService, entityProvider))
 	reg.RegisterExecutor(ExecutorNameHTTPRequest, newHTTPRequestExecutor(flowFactory, ouService))
 	reg.RegisterExecutor(ExecutorNameUserTypeResolver, newUserTypeResolver(flowFactory, entityTypeService, ouService))
 	reg.RegisterExecutor(ExecutorNameInviteExecutor, newInviteExecutor(flowFactory))
 	reg.RegisterExecutor(ExecutorNameEmailExecutor, newEmailExecutor(
-		flowFactory, emailClient, templateService, entityProvider))
-	reg.RegisterExecutor(ExecutorNameCredentialSetter, newCredentialSetter(flowFactory, entityProvider))
+		flowFactory, emailClient, templateService, entityProvider, ouService))
+	reg.RegisterExecutor(ExecutorNameCredentialSetter, newCredentialSetter(
+		flowFactory, entityProvider, securityNotifier, accountProtection))
+	reg.RegisterExecutor(ExecutorNameAccountActivator, newAccountActivator(flowFactory, entityProvider))
+	reg.RegisterExecutor(ExecutorNameCredentialPolicyEvaluator,
+		newCredentialPolicyEvaluator(flowFactory, entityProvider))
 	reg.RegisterExecutor(ExecutorNamePermissionValidator, newPermissionValidator(flowFactory))
 	reg.RegisterExecutor(ExecutorNameIdentifying, newIdentifyingExecutor(
 		"", []common.Input{{Identifier: userAttributeUsername, Type: "string", Required: true}}, []common.Input{},
-		flowFactory, entityProvider))
+		flowFactory, entityProvider, appUserService))
 	reg.RegisterExecutor(ExecutorNameConsent, newConsentExecutor(flowFactory, consentEnforcer, authnProvider))
 	reg.RegisterExecutor(ExecutorNameOUResolver, newOUResolverExecutor(flowFactory, ouService))
 	reg.RegisterExecutor(ExecutorNameAttributeUniquenessValidator, newAttributeUniquenessValidator(
 		flowFactory, entityTypeService, entityProvider))
 	reg.RegisterExecutor(ExecutorNameSMSExecutor, newSMSExecutor(flowFactory, notifSenderSvc, templateService))
 	reg.RegisterExecutor(ExecutorNameFederatedAuthResolver, newFederatedAuthResolverExecutor(flowFactory))
+	reg.RegisterExecutor(ExecutorNameTrustedDevice, newTrustedDeviceExecutor(flowFactory, trustedDeviceService))
+	reg.RegisterExecutor(ExecutorNameDomainRouting, newDomainRoutingExecutor(flowFactory, domainRoutingService))
+	reg.RegisterExecutor(ExecutorNameBreakGlass, newBreakGlassExecutor(flowFactory, breakGlassService))
 
 	return reg
 }
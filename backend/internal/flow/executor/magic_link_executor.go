@@ -73,7 +73,7 @@ func newMagicLinkAuthExecutor(
 		log.String(log.LoggerKeyExecutorName, ExecutorNameMagicLinkAuth))
 
 	identifyExec := newIdentifyingExecutor(ExecutorNameMagicLinkAuth, defaultInputs, prerequisites,
-		flowFactory, entityProvider)
+		flowFactory, entityProvider, nil)
 	base := flowFactory.CreateExecutor(ExecutorNameMagicLinkAuth, common.ExecutorTypeAuthentication,
 		defaultInputs, prerequisites)
 
@@ -0,0 +1,75 @@
+/*
+ * Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package executor
+
+import (
+	"sync"
+	"time"
+)
+
+// otpSendRateLimiter limits the number of OTP sends per recipient within a sliding time window.
+// The limiter state is held in memory and is therefore scoped to a single server instance.
+type otpSendRateLimiter struct {
+	mu     sync.Mutex
+	limit  int
+	window time.Duration
+	now    func() time.Time
+	sends  map[string][]time.Time
+}
+
+// newOTPSendRateLimiter creates a new otpSendRateLimiter allowing limit sends per window.
+func newOTPSendRateLimiter(limit int, window time.Duration) *otpSendRateLimiter {
+	return &otpSendRateLimiter{
+		limit:  limit,
+		window: window,
+		now:    time.Now,
+		sends:  make(map[string][]time.Time),
+	}
+}
+
+// allow records a send attempt for the given recipient and reports whether it is within the limit.
+// Rejected attempts are not recorded.
+func (l *otpSendRateLimiter) allow(recipient string) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	cutoff := now.Add(-l.window)
+
+	// Drop expired entries for every recipient so that the map does not grow unbounded.
+	for key, timestamps := range l.sends {
+		kept := timestamps[:0]
+		for _, ts := range timestamps {
+			if ts.After(cutoff) {
+				kept = append(kept, ts)
+			}
+		}
+		if len(kept) == 0 {
+			delete(l.sends, key)
+		} else {
+			l.sends[key] = kept
+		}
+	}
+
+	if len(l.sends[recipient]) >= l.limit {
+		return false
+	}
+	l.sends[recipient] = append(l.sends[recipient], now)
+	return true
+}
@@ -0,0 +1,41 @@
+/*
+ * Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package executor
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOTPSendRateLimiter_SlidingWindow(t *testing.T) {
+	now := time.Now()
+	limiter := newOTPSendRateLimiter(2, time.Minute)
+	limiter.now = func() time.Time { return now }
+
+	assert.True(t, limiter.allow("+1111111111"))
+	assert.True(t, limiter.allow("+1111111111"))
+	assert.False(t, limiter.allow("+1111111111"))
+	assert.True(t, limiter.allow("+2222222222"))
+
+	now = now.Add(time.Minute + time.Second)
+	assert.True(t, limiter.allow("+1111111111"))
+	assert.NotContains(t, limiter.sends, "+2222222222")
+}
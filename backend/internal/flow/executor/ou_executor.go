@@ -27,6 +27,7 @@ import (
 	"github.com/thunder-id/thunderid/internal/ou"
 	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
 	"github.com/thunder-id/thunderid/internal/system/log"
+	systemutils "github.com/thunder-id/thunderid/internal/system/utils"
 )
 
 const (
@@ -102,6 +103,16 @@ func (o *ouExecutor) Execute(ctx *core.NodeContext) (*common.ExecutorResponse, e
 		logger.Error("Failed to build organization unit request", log.String("error", err.Error()))
 		return nil, err
 	}
+	if ctx.Sandbox {
+		sandboxOUID, err := systemutils.GenerateUUIDv7()
+		if err != nil {
+			return nil, fmt.Errorf("failed to generate sandbox organization unit ID: %w", err)
+		}
+		logger.Debug("Sandbox execution, skipping organization unit creation")
+		execResp.RuntimeData[ouIDKey] = sandboxOUID
+		execResp.Status = common.ExecComplete
+		return execResp, nil
+	}
 	createdOU, svcErr := o.ouService.CreateOrganizationUnit(ctx.Context, ouRequest)
 	if svcErr != nil {
 		if svcErr.Type == serviceerror.ClientErrorType {
@@ -127,7 +127,7 @@ func newPasskeyAuthExecutor(
 		log.String(log.LoggerKeyExecutorName, ExecutorNamePasskeyAuth))
 
 	identifyExec := newIdentifyingExecutor(ExecutorNamePasskeyAuth, defaultInputs, prerequisites,
-		flowFactory, entityProvider)
+		flowFactory, entityProvider, nil)
 	base := flowFactory.CreateExecutor(ExecutorNamePasskeyAuth, common.ExecutorTypeAuthentication,
 		defaultInputs, prerequisites)
 
@@ -497,6 +497,13 @@ func (p *passkeyAuthExecutor) executeRegisterFinish(ctx *core.NodeContext,
 		CredentialName:    credentialName,
 	}
 
+	if ctx.Sandbox {
+		logger.Debug("Sandbox execution, passkey registration is not supported")
+		execResp.Status = common.ExecFailure
+		execResp.FailureReason = failureReasonSandboxUnsupported
+		return execResp, nil
+	}
+
 	// Call passkey service to finish registration
 	finishData, svcErr := p.passkeyService.FinishRegistration(ctx.Context, finishReq)
 	if svcErr != nil {
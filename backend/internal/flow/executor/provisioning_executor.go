@@ -24,6 +24,7 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/thunder-id/thunderid/internal/appuser"
 	authncm "github.com/thunder-id/thunderid/internal/authn/common"
 	"github.com/thunder-id/thunderid/internal/entityprovider"
 	"github.com/thunder-id/thunderid/internal/entitytype"
@@ -32,6 +33,7 @@ import (
 	"github.com/thunder-id/thunderid/internal/group"
 	"github.com/thunder-id/thunderid/internal/role"
 	"github.com/thunder-id/thunderid/internal/system/log"
+	systemutils "github.com/thunder-id/thunderid/internal/system/utils"
 )
 
 // provisioningExecutor implements the ExecutorInterface for user provisioning in a flow.
@@ -43,6 +45,7 @@ type provisioningExecutor struct {
 	roleService           role.RoleServiceInterface
 	roleAssignmentService role.RoleAssignmentServiceInterface
 	entityTypeService     entitytype.EntityTypeServiceInterface
+	appUserService        appuser.AppUserServiceInterface
 	logger                *log.Logger
 }
 
@@ -57,6 +60,7 @@ func newProvisioningExecutor(
 	roleAssignmentService role.RoleAssignmentServiceInterface,
 	entityProvider entityprovider.EntityProviderInterface,
 	entityTypeService entitytype.EntityTypeServiceInterface,
+	appUserService appuser.AppUserServiceInterface,
 ) *provisioningExecutor {
 	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, ExecutorNameProvisioning),
 		log.String(log.LoggerKeyExecutorName, ExecutorNameProvisioning))
@@ -65,7 +69,7 @@ func newProvisioningExecutor(
 		[]common.Input{}, []common.Input{})
 
 	identifyingExec := newIdentifyingExecutor(ExecutorNameProvisioning,
-		[]common.Input{}, []common.Input{}, flowFactory, entityProvider)
+		[]common.Input{}, []common.Input{}, flowFactory, entityProvider, nil)
 
 	return &provisioningExecutor{
 		ExecutorInterface:            base,
@@ -75,6 +79,7 @@ func newProvisioningExecutor(
 		roleService:                  roleService,
 		roleAssignmentService:        roleAssignmentService,
 		entityTypeService:            entityTypeService,
+		appUserService:               appUserService,
 		logger:                       logger,
 	}
 }
@@ -164,6 +169,17 @@ func (p *provisioningExecutor) Execute(ctx *core.NodeContext) (*common.ExecutorR
 		logger.Error("Failed to create user in the store", log.Error(err))
 		execResp.Status = common.ExecFailure
 		execResp.FailureReason = "Failed to create user"
+		var providerErr *entityprovider.EntityProviderError
+		if errors.As(err, &providerErr) {
+			switch providerErr.Code {
+			case entityprovider.ErrorCodeDeniedIdentifier:
+				execResp.FailureReason = "The username is not allowed"
+			case entityprovider.ErrorCodeDeniedCredential:
+				execResp.FailureReason = "The password is not allowed"
+			case entityprovider.ErrorCodeQuotaExceeded:
+				execResp.FailureReason = "The user quota has been reached"
+			}
+		}
 		return execResp, nil
 	}
 	if createdEntity == nil || createdEntity.ID == "" {
@@ -185,6 +201,19 @@ func (p *provisioningExecutor) Execute(ctx *core.NodeContext) (*common.ExecutorR
 		return execResp, nil
 	}
 
+	// Associate the user with the application the user registered through
+	if p.appUserService != nil && ctx.Application.ID != "" {
+		if svcErr := p.appUserService.RecordAppUser(ctx.Context, ctx.Application.ID, createdEntity.ID,
+			appuser.AssociationSourceRegistration); svcErr != nil {
+			logger.Error("Failed to associate provisioned user with the application",
+				log.MaskedString(log.LoggerKeyUserID, createdEntity.ID),
+				log.String("error", svcErr.ErrorDescription.DefaultValue))
+			execResp.Status = common.ExecFailure
+			execResp.FailureReason = "Failed to associate the user with the application"
+			return execResp, nil
+		}
+	}
+
 	retAttributes := make(map[string]interface{})
 	if len(createdEntity.Attributes) > 0 {
 		if err := json.Unmarshal(createdEntity.Attributes, &retAttributes); err != nil {
@@ -193,8 +222,9 @@ func (p *provisioningExecutor) Execute(ctx *core.NodeContext) (*common.ExecutorR
 		}
 	}
 
+	// A user provisioned pending verification is authenticated only once the account is activated.
 	authenticatedUser := authncm.AuthenticatedUser{
-		IsAuthenticated: true,
+		IsAuthenticated: createdEntity.State != entityprovider.EntityStatePendingVerification,
 		UserID:          createdEntity.ID,
 		OUID:            createdEntity.OUID,
 		UserType:        createdEntity.Type,
@@ -402,6 +432,7 @@ func (p *provisioningExecutor) buildMissingInputs(
 				Identifier:  attr.Attribute,
 				Type:        common.InputTypePassword,
 				Required:    effectiveRequired,
+				Validation:  toInputValidation(attr.Constraints),
 				DisplayName: attr.DisplayName,
 			}
 			if effectiveRequired {
@@ -432,6 +463,7 @@ func (p *provisioningExecutor) buildMissingInputs(
 				}
 			}
 			input.Required = effectiveRequired
+			input.Validation = toInputValidation(attr.Constraints)
 			if effectiveRequired {
 				ncRequired = append(ncRequired, input)
 			} else {
@@ -442,6 +474,15 @@ func (p *provisioningExecutor) buildMissingInputs(
 	return credRequired, credOptional, ncRequired, ncOptional
 }
 
+// toInputValidation converts the value constraints of a schema attribute to the validation rules
+// exposed on a prompted input.
+func toInputValidation(constraints *entitytype.ValueConstraints) *common.InputValidation {
+	if constraints == nil {
+		return nil
+	}
+	return &common.InputValidation{Pattern: constraints.Pattern, Enum: constraints.Enum}
+}
+
 // fetchSchemaAttributes retrieves schema attributes from the entity type service for the
 // current user type. allowCredential and allowNonCredential control which attribute classes
 // are returned.
@@ -580,7 +621,7 @@ func (p *provisioningExecutor) createUserInStore(nodeCtx *core.NodeContext,
 
 	newEntity := entityprovider.Entity{
 		Category: entityprovider.EntityCategoryUser,
-		State:    entityprovider.EntityStateActive,
+		State:    p.getInitialState(nodeCtx),
 		OUID:     ouID,
 		Type:     userType,
 	}
@@ -592,9 +633,19 @@ func (p *provisioningExecutor) createUserInStore(nodeCtx *core.NodeContext,
 	}
 	newEntity.Attributes = attributesJSON
 
+	if nodeCtx.Sandbox {
+		sandboxID, err := systemutils.GenerateUUIDv7()
+		if err != nil {
+			return nil, fmt.Errorf("failed to generate sandbox user ID: %w", err)
+		}
+		logger.Debug("Sandbox execution, skipping user creation")
+		newEntity.ID = sandboxID
+		return &newEntity, nil
+	}
+
 	retEntity, svcErr := p.entityProvider.CreateEntity(&newEntity, nil)
 	if svcErr != nil {
-		return nil, fmt.Errorf("failed to create user in the store: %s", svcErr.Message)
+		return nil, fmt.Errorf("failed to create user in the store: %w", svcErr)
 	}
 	if retEntity != nil && retEntity.ID != "" {
 		logger.Debug("User account created successfully", log.MaskedString(log.LoggerKeyUserID, retEntity.ID))
@@ -603,6 +654,16 @@ func (p *provisioningExecutor) createUserInStore(nodeCtx *core.NodeContext,
 	return retEntity, nil
 }
 
+// getInitialState retrieves the state the user is created in from node properties. Users are created
+// active unless the node is configured to create them pending verification of their email address.
+func (p *provisioningExecutor) getInitialState(ctx *core.NodeContext) entityprovider.EntityState {
+	if state, ok := ctx.NodeProperties[propertyKeyInitialState].(string); ok &&
+		state == string(entityprovider.EntityStatePendingVerification) {
+		return entityprovider.EntityStatePendingVerification
+	}
+	return entityprovider.EntityStateActive
+}
+
 // getOUID retrieves the organization unit ID from runtime data.
 // Priority: RuntimeData["ouId"] (set by OUResolverExecutor) > RuntimeData["defaultOUID"] (set by UserTypeResolver).
 func (p *provisioningExecutor) getOUID(ctx *core.NodeContext) string {
@@ -645,6 +706,10 @@ func (p *provisioningExecutor) assignGroupsAndRoles(
 		logger.Debug("No group or role configured for assignment, skipping")
 		return nil
 	}
+	if ctx.Sandbox {
+		logger.Debug("Sandbox execution, skipping group and role assignment")
+		return nil
+	}
 
 	logger.Debug("Assigning group and role to provisioned user",
 		log.MaskedString(log.LoggerKeyUserID, userID),
@@ -21,6 +21,7 @@ package executor
 import (
 	i18ncore "github.com/thunder-id/thunderid/internal/system/i18n/core"
 
+	"context"
 	"encoding/json"
 	"testing"
 
@@ -29,6 +30,8 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 
+	appmodel "github.com/thunder-id/thunderid/internal/application/model"
+	"github.com/thunder-id/thunderid/internal/appuser"
 	authncm "github.com/thunder-id/thunderid/internal/authn/common"
 	"github.com/thunder-id/thunderid/internal/entityprovider"
 	"github.com/thunder-id/thunderid/internal/entitytype/model"
@@ -37,6 +40,7 @@ import (
 	"github.com/thunder-id/thunderid/internal/group"
 	"github.com/thunder-id/thunderid/internal/role"
 	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/tests/mocks/appusermock"
 	"github.com/thunder-id/thunderid/tests/mocks/entityprovidermock"
 	"github.com/thunder-id/thunderid/tests/mocks/entitytypemock"
 	"github.com/thunder-id/thunderid/tests/mocks/flow/coremock"
@@ -87,7 +91,7 @@ func (suite *ProvisioningExecutorTestSuite) SetupTest() {
 
 	suite.executor = newProvisioningExecutor(suite.mockFlowFactory,
 		suite.mockGroupService, suite.mockRoleService, suite.mockRoleAssignmentService, suite.mockEntityProvider,
-		suite.mockEntityTypeService)
+		suite.mockEntityTypeService, nil)
 }
 
 // expectSchemaForProvisioning sets up the schema service mocks for Execute tests.
@@ -220,6 +224,96 @@ func (suite *ProvisioningExecutorTestSuite) TestExecute_Success() {
 	suite.mockRoleAssignmentService.AssertExpectations(suite.T())
 }
 
+func (suite *ProvisioningExecutorTestSuite) TestExecute_PendingVerification() {
+	suite.expectSchemaForProvisioning()
+	attrs := map[string]interface{}{"username": "newuser", attributeEmail: "new@example.com"}
+	attrsJSON, _ := json.Marshal(attrs)
+
+	ctx := &core.NodeContext{
+		ExecutionID: "flow-123",
+		FlowType:    common.FlowTypeRegistration,
+		UserInputs: map[string]string{
+			"username":     "newuser",
+			attributeEmail: "new@example.com",
+		},
+		RuntimeData: map[string]string{
+			ouIDKey:     testOUID,
+			userTypeKey: testUserType,
+		},
+		NodeInputs: []common.Input{
+			{Identifier: "username", Type: "string", Required: true},
+			{Identifier: attributeEmail, Type: "string", Required: true},
+		},
+		NodeProperties: map[string]interface{}{
+			propertyKeyInitialState: "PENDING_VERIFICATION",
+		},
+	}
+
+	suite.mockEntityProvider.On("IdentifyEntity", mock.Anything).
+		Return(nil, entityprovider.NewEntityProviderError(entityprovider.ErrorCodeEntityNotFound, "", ""))
+	suite.mockEntityProvider.On("CreateEntity", mock.MatchedBy(func(u *entityprovider.Entity) bool {
+		return u.State == entityprovider.EntityStatePendingVerification
+	}), mock.Anything).Return(&entityprovider.Entity{
+		ID:         testNewUserID,
+		OUID:       testOUID,
+		Type:       testUserType,
+		State:      entityprovider.EntityStatePendingVerification,
+		Attributes: attrsJSON,
+	}, nil)
+
+	resp, err := suite.executor.Execute(ctx)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), common.ExecComplete, resp.Status)
+	assert.False(suite.T(), resp.AuthenticatedUser.IsAuthenticated)
+	assert.Equal(suite.T(), testNewUserID, resp.AuthenticatedUser.UserID)
+	assert.Equal(suite.T(), testNewUserID, resp.RuntimeData[userAttributeUserID])
+}
+
+func (suite *ProvisioningExecutorTestSuite) TestExecute_Sandbox_SkipsUserCreation() {
+	suite.expectSchemaForProvisioning()
+
+	ctx := &core.NodeContext{
+		ExecutionID: "flow-123",
+		FlowType:    common.FlowTypeRegistration,
+		Sandbox:     true,
+		UserInputs: map[string]string{
+			"username":     "newuser",
+			attributeEmail: "new@example.com",
+		},
+		RuntimeData: map[string]string{
+			ouIDKey:     testOUID,
+			userTypeKey: testUserType,
+		},
+		NodeInputs: []common.Input{
+			{Identifier: "username", Type: "string", Required: true},
+			{Identifier: attributeEmail, Type: "string", Required: true},
+		},
+		NodeProperties: map[string]interface{}{
+			"assignGroup": "test-group-id",
+			"assignRole":  "test-role-id",
+		},
+	}
+
+	suite.mockEntityProvider.On("IdentifyEntity", map[string]interface{}{
+		"username":     "newuser",
+		attributeEmail: "new@example.com",
+	}).Return(nil, entityprovider.NewEntityProviderError(entityprovider.ErrorCodeEntityNotFound, "", ""))
+
+	resp, err := suite.executor.Execute(ctx)
+
+	assert.NoError(suite.T(), err)
+	assert.NotNil(suite.T(), resp)
+	assert.Equal(suite.T(), common.ExecComplete, resp.Status)
+	assert.True(suite.T(), resp.AuthenticatedUser.IsAuthenticated)
+	assert.NotEmpty(suite.T(), resp.AuthenticatedUser.UserID)
+	assert.Equal(suite.T(), "newuser", resp.AuthenticatedUser.Attributes["username"])
+	suite.mockEntityProvider.AssertNotCalled(suite.T(), "CreateEntity", mock.Anything, mock.Anything)
+	suite.mockGroupService.AssertNotCalled(suite.T(), "AddGroupMembers", mock.Anything, mock.Anything, mock.Anything)
+	suite.mockRoleAssignmentService.AssertNotCalled(suite.T(), "AddAssignments",
+		mock.Anything, mock.Anything, mock.Anything)
+}
+
 func (suite *ProvisioningExecutorTestSuite) TestExecute_UserAlreadyExists() {
 	suite.expectSchemaForProvisioning()
 	ctx := &core.NodeContext{
@@ -291,6 +385,128 @@ func (suite *ProvisioningExecutorTestSuite) TestExecute_CreateUserFails() {
 	suite.mockEntityProvider.AssertExpectations(suite.T())
 }
 
+func (suite *ProvisioningExecutorTestSuite) TestExecute_RecordsRegistrationAssociation() {
+	suite.expectSchemaForProvisioning()
+	mockAppUserService := appusermock.NewAppUserServiceInterfaceMock(suite.T())
+	suite.executor.appUserService = mockAppUserService
+	ctx := &core.NodeContext{
+		Context:     context.Background(),
+		ExecutionID: "flow-123",
+		FlowType:    common.FlowTypeRegistration,
+		UserInputs: map[string]string{
+			"username": "newuser",
+		},
+		RuntimeData: map[string]string{
+			ouIDKey:     testOUID,
+			userTypeKey: testUserType,
+		},
+		NodeInputs:  []common.Input{{Identifier: "username", Type: "string", Required: true}},
+		Application: appmodel.Application{ID: "app-123"},
+	}
+
+	suite.mockEntityProvider.On("IdentifyEntity", mock.Anything).Return(nil,
+		entityprovider.NewEntityProviderError(entityprovider.ErrorCodeEntityNotFound, "", ""))
+	suite.mockEntityProvider.On("CreateEntity", mock.Anything, mock.Anything).
+		Return(&entityprovider.Entity{ID: testNewUserID, OUID: testOUID, Type: testUserType}, nil)
+	mockAppUserService.On("RecordAppUser", ctx.Context, "app-123", testNewUserID,
+		appuser.AssociationSourceRegistration).Return(nil).Once()
+
+	resp, err := suite.executor.Execute(ctx)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), common.ExecComplete, resp.Status)
+	assert.Equal(suite.T(), testNewUserID, resp.RuntimeData[userAttributeUserID])
+}
+
+func (suite *ProvisioningExecutorTestSuite) TestExecute_RecordRegistrationAssociationFails() {
+	suite.expectSchemaForProvisioning()
+	mockAppUserService := appusermock.NewAppUserServiceInterfaceMock(suite.T())
+	suite.executor.appUserService = mockAppUserService
+	ctx := &core.NodeContext{
+		Context:     context.Background(),
+		ExecutionID: "flow-123",
+		FlowType:    common.FlowTypeRegistration,
+		UserInputs: map[string]string{
+			"username": "newuser",
+		},
+		RuntimeData: map[string]string{
+			ouIDKey:     testOUID,
+			userTypeKey: testUserType,
+		},
+		NodeInputs:  []common.Input{{Identifier: "username", Type: "string", Required: true}},
+		Application: appmodel.Application{ID: "app-123"},
+	}
+
+	suite.mockEntityProvider.On("IdentifyEntity", mock.Anything).Return(nil,
+		entityprovider.NewEntityProviderError(entityprovider.ErrorCodeEntityNotFound, "", ""))
+	suite.mockEntityProvider.On("CreateEntity", mock.Anything, mock.Anything).
+		Return(&entityprovider.Entity{ID: testNewUserID, OUID: testOUID, Type: testUserType}, nil)
+	mockAppUserService.On("RecordAppUser", ctx.Context, "app-123", testNewUserID,
+		appuser.AssociationSourceRegistration).Return(&serviceerror.InternalServerError).Once()
+
+	resp, err := suite.executor.Execute(ctx)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), common.ExecFailure, resp.Status)
+	assert.Equal(suite.T(), "Failed to associate the user with the application", resp.FailureReason)
+}
+
+func (suite *ProvisioningExecutorTestSuite) TestExecute_CreateUserFails_DeniedUsername() {
+	suite.expectSchemaForProvisioning()
+	ctx := &core.NodeContext{
+		ExecutionID: "flow-123",
+		FlowType:    common.FlowTypeRegistration,
+		UserInputs: map[string]string{
+			"username": "admin",
+		},
+		RuntimeData: map[string]string{
+			ouIDKey:     testOUID,
+			userTypeKey: testUserType,
+		},
+		NodeInputs: []common.Input{{Identifier: "username", Type: "string", Required: true}},
+	}
+
+	suite.mockEntityProvider.On("IdentifyEntity", mock.Anything).Return(nil,
+		entityprovider.NewEntityProviderError(entityprovider.ErrorCodeEntityNotFound, "", ""))
+	suite.mockEntityProvider.On("CreateEntity", mock.Anything, mock.Anything).
+		Return(nil, entityprovider.NewEntityProviderError(entityprovider.ErrorCodeDeniedIdentifier,
+			"Identifier not allowed", ""))
+
+	resp, err := suite.executor.Execute(ctx)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), common.ExecFailure, resp.Status)
+	assert.Equal(suite.T(), "The username is not allowed", resp.FailureReason)
+}
+
+func (suite *ProvisioningExecutorTestSuite) TestExecute_CreateUserFails_QuotaExceeded() {
+	suite.expectSchemaForProvisioning()
+	ctx := &core.NodeContext{
+		ExecutionID: "flow-123",
+		FlowType:    common.FlowTypeRegistration,
+		UserInputs: map[string]string{
+			"username": "admin",
+		},
+		RuntimeData: map[string]string{
+			ouIDKey:     testOUID,
+			userTypeKey: testUserType,
+		},
+		NodeInputs: []common.Input{{Identifier: "username", Type: "string", Required: true}},
+	}
+
+	suite.mockEntityProvider.On("IdentifyEntity", mock.Anything).Return(nil,
+		entityprovider.NewEntityProviderError(entityprovider.ErrorCodeEntityNotFound, "", ""))
+	suite.mockEntityProvider.On("CreateEntity", mock.Anything, mock.Anything).
+		Return(nil, entityprovider.NewEntityProviderError(entityprovider.ErrorCodeQuotaExceeded,
+			"Quota exceeded", ""))
+
+	resp, err := suite.executor.Execute(ctx)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), common.ExecFailure, resp.Status)
+	assert.Equal(suite.T(), "The user quota has been reached", resp.FailureReason)
+}
+
 func (suite *ProvisioningExecutorTestSuite) TestHasRequiredInputs_AttributesFromAuthUser() {
 	suite.mockEntityTypeService.On("GetAttributes", mock.Anything, mock.Anything, testUserType, true, true, false).
 		Return([]model.AttributeInfo{}, nil).Once()
@@ -543,7 +759,7 @@ func (suite *ProvisioningExecutorTestSuite) newExecutorWithNodeInputs(inputs []c
 
 	return newProvisioningExecutor(mockFlowFactory,
 		suite.mockGroupService, suite.mockRoleService, suite.mockRoleAssignmentService, suite.mockEntityProvider,
-		suite.mockEntityTypeService)
+		suite.mockEntityTypeService, nil)
 }
 
 func (suite *ProvisioningExecutorTestSuite) TestGetAttributesForProvisioning_FilteredPath_RequiredAttrFromUserInputs() {
@@ -1957,6 +2173,34 @@ func (suite *ProvisioningExecutorTestSuite) TestHasRequiredInputs_SchemaAttrMiss
 	assert.Len(suite.T(), fwdInputs, 2)
 }
 
+func (suite *ProvisioningExecutorTestSuite) TestHasRequiredInputs_SchemaAttrMissing_ExposesValidationRules() {
+	suite.mockEntityTypeService.On("GetAttributes", mock.Anything, mock.Anything, testUserType, true, true, false).
+		Return([]model.AttributeInfo{
+			{Attribute: "password", Required: true, Credential: true,
+				Constraints: &model.ValueConstraints{Pattern: "^.{12,}$"}},
+			{Attribute: "tier", Required: true, Constraints: &model.ValueConstraints{Enum: []string{"gold", "silver"}}},
+			{Attribute: "firstName", Required: true},
+		}, nil).Once()
+
+	ctx := &core.NodeContext{
+		ExecutionID: "flow-123",
+		UserInputs:  map[string]string{},
+		RuntimeData: map[string]string{userTypeKey: testUserType},
+	}
+	execResp := &common.ExecutorResponse{RuntimeData: make(map[string]string)}
+
+	result := suite.executor.HasRequiredInputs(ctx, execResp)
+
+	assert.False(suite.T(), result)
+	inputMap := make(map[string]common.Input, len(execResp.Inputs))
+	for _, inp := range execResp.Inputs {
+		inputMap[inp.Identifier] = inp
+	}
+	assert.Equal(suite.T(), &common.InputValidation{Pattern: "^.{12,}$"}, inputMap["password"].Validation)
+	assert.Equal(suite.T(), &common.InputValidation{Enum: []string{"gold", "silver"}}, inputMap["tier"].Validation)
+	assert.Nil(suite.T(), inputMap["firstName"].Validation)
+}
+
 // TestHasRequiredInputs_IncludeOptionalTrue_OptionalRenderedAsNotRequired verifies that when
 // includeOptional=true, optional schema attrs are forwarded with Required=false.
 func (suite *ProvisioningExecutorTestSuite) TestHasRequiredInputs_IncludeOptionalTrue_OptionalRenderedAsNotRequired() {
@@ -20,6 +20,7 @@ package executor
 
 import (
 	"fmt"
+	"sort"
 	"sync"
 
 	"github.com/thunder-id/thunderid/internal/flow/core"
@@ -31,6 +32,7 @@ type ExecutorRegistryInterface interface {
 	GetExecutor(name string) (core.ExecutorInterface, error)
 	RegisterExecutor(name string, ex core.ExecutorInterface)
 	IsRegistered(name string) bool
+	ListExecutors() []core.ExecutorInterface
 }
 
 // executorRegistry is the default implementation of ExecutorRegistryInterface.
@@ -87,3 +89,20 @@ func (r *executorRegistry) IsRegistered(name string) bool {
 	_, ok := r.executors[name]
 	return ok
 }
+
+// ListExecutors returns the registered executors ordered by their registered name.
+func (r *executorRegistry) ListExecutors() []core.ExecutorInterface {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	names := make([]string, 0, len(r.executors))
+	for name := range r.executors {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	executors := make([]core.ExecutorInterface, 0, len(names))
+	for _, name := range names {
+		executors = append(executors, r.executors[name])
+	}
+	return executors
+}
@@ -253,3 +253,17 @@ func (suite *ExecutorRegistryTestSuite) TestGetExecutor_NonExistentAfterRegistra
 	assert.Error(suite.T(), err)
 	assert.Nil(suite.T(), retrieved)
 }
+
+func (suite *ExecutorRegistryTestSuite) TestListExecutors_OrderedByName() {
+	executorB := createMockExecutorForRegistry(suite.T(), "executorB", common.ExecutorTypeUtility)
+	executorA := createMockExecutorForRegistry(suite.T(), "executorA", common.ExecutorTypeAuthentication)
+
+	suite.registry.RegisterExecutor("executorB", executorB)
+	suite.registry.RegisterExecutor("executorA", executorA)
+
+	executors := suite.registry.ListExecutors()
+
+	assert.Len(suite.T(), executors, 2)
+	assert.Equal(suite.T(), "executorA", executors[0].GetName())
+	assert.Equal(suite.T(), "executorB", executors[1].GetName())
+}
@@ -23,17 +23,30 @@ import (
 	"errors"
 	"fmt"
 	"strconv"
+	"time"
 
 	authncm "github.com/thunder-id/thunderid/internal/authn/common"
 	"github.com/thunder-id/thunderid/internal/authn/otp"
 	authnprovidermgr "github.com/thunder-id/thunderid/internal/authnprovider/manager"
 	"github.com/thunder-id/thunderid/internal/entityprovider"
+	"github.com/thunder-id/thunderid/internal/entitytype"
 	"github.com/thunder-id/thunderid/internal/flow/common"
 	"github.com/thunder-id/thunderid/internal/flow/core"
 	notifcommon "github.com/thunder-id/thunderid/internal/notification/common"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
 	"github.com/thunder-id/thunderid/internal/system/log"
 )
 
+const (
+	// smsOTPMaxSendsPerNumber is the maximum number of OTPs sent to a mobile number within smsOTPSendWindow.
+	smsOTPMaxSendsPerNumber = 5
+	// smsOTPSendWindow is the sliding window used for the per-number OTP send limit.
+	smsOTPSendWindow = 15 * time.Minute
+	// smsOTPMinSendDuration is the minimum duration of the send step when user enumeration prevention
+	// is enabled, so that known and unknown mobile numbers take a similar time to respond.
+	smsOTPMinSendDuration = 1500 * time.Millisecond
+)
+
 // mobileNumberInput is the default input definition for mobile number collection.
 var mobileNumberInput = common.Input{
 	Ref:        "mobile_number_input",
@@ -46,10 +59,15 @@ var mobileNumberInput = common.Input{
 type smsOTPAuthExecutor struct {
 	core.ExecutorInterface
 	identifyingExecutorInterface
-	entityProvider entityprovider.EntityProviderInterface
-	otpService     otp.OTPAuthnServiceInterface
-	authnProvider  authnprovidermgr.AuthnProviderManagerInterface
-	logger         *log.Logger
+	entityProvider    entityprovider.EntityProviderInterface
+	otpService        otp.OTPAuthnServiceInterface
+	authnProvider     authnprovidermgr.AuthnProviderManagerInterface
+	entityTypeService entitytype.EntityTypeServiceInterface
+	sendLimiter       *otpSendRateLimiter
+	minSendDuration   time.Duration
+	now               func() time.Time
+	sleep             func(time.Duration)
+	logger            *log.Logger
 }
 
 var _ core.ExecutorInterface = (*smsOTPAuthExecutor)(nil)
@@ -61,6 +79,7 @@ func newSMSOTPAuthExecutor(
 	otpService otp.OTPAuthnServiceInterface,
 	authnProvider authnprovidermgr.AuthnProviderManagerInterface,
 	entityProvider entityprovider.EntityProviderInterface,
+	entityTypeService entitytype.EntityTypeServiceInterface,
 ) *smsOTPAuthExecutor {
 	defaultInputs := []common.Input{
 		{
@@ -76,7 +95,7 @@ func newSMSOTPAuthExecutor(
 		log.String(log.LoggerKeyExecutorName, ExecutorNameSMSAuth))
 
 	identifyExec := newIdentifyingExecutor(ExecutorNameSMSAuth, defaultInputs, prerequisites,
-		flowFactory, entityProvider)
+		flowFactory, entityProvider, nil)
 	base := flowFactory.CreateExecutor(ExecutorNameSMSAuth, common.ExecutorTypeAuthentication,
 		defaultInputs, prerequisites)
 
@@ -86,6 +105,11 @@ func newSMSOTPAuthExecutor(
 		entityProvider:               entityProvider,
 		otpService:                   otpService,
 		authnProvider:                authnProvider,
+		entityTypeService:            entityTypeService,
+		sendLimiter:                  newOTPSendRateLimiter(smsOTPMaxSendsPerNumber, smsOTPSendWindow),
+		minSendDuration:              smsOTPMinSendDuration,
+		now:                          time.Now,
+		sleep:                        time.Sleep,
 		logger:                       logger,
 	}
 }
@@ -120,6 +144,17 @@ func (s *smsOTPAuthExecutor) executeSend(ctx *core.NodeContext,
 	execResp *common.ExecutorResponse) (*common.ExecutorResponse, error) {
 	logger := s.logger.With(log.String(log.LoggerKeyExecutionID, ctx.ExecutionID))
 
+	// Pad the send step to a minimum duration so that response times do not reveal whether
+	// the mobile number belongs to a user.
+	if isUserEnumerationPreventionEnabled(ctx) {
+		start := s.now()
+		defer func() {
+			if remaining := s.minSendDuration - s.now().Sub(start); remaining > 0 {
+				s.sleep(remaining)
+			}
+		}()
+	}
+
 	err := s.InitiateOTP(ctx, execResp)
 	if err != nil {
 		return execResp, err
@@ -165,6 +200,13 @@ func (s *smsOTPAuthExecutor) InitiateOTP(ctx *core.NodeContext,
 		return err
 	}
 
+	if !s.sendLimiter.allow(mobileNumber) {
+		logger.Debug("OTP send limit reached for the mobile number")
+		execResp.Status = common.ExecFailure
+		execResp.FailureReason = failureReasonOTPRateLimited
+		return nil
+	}
+
 	var userID *string
 	if ctx.AuthenticatedUser.IsAuthenticated {
 		userIDVal := s.GetUserIDFromContext(ctx)
@@ -204,10 +246,22 @@ func (s *smsOTPAuthExecutor) InitiateOTP(ctx *core.NodeContext,
 
 		execResp.Status = ""
 		execResp.FailureReason = ""
-	} else {
-		if execResp.Status == common.ExecFailure {
+	} else if execResp.Status == common.ExecFailure {
+		if !s.canProceedWithUnknownRecipient(ctx, execResp) {
 			return nil
 		}
+
+		execResp.Status = ""
+		execResp.FailureReason = ""
+		execResp.RuntimeData[common.RuntimeKeySMSOTPUnknownRecipient] = dataValueTrue
+
+		if !isAuthenticationWithoutLocalUserAllowed(ctx) {
+			// ANTI-ENUMERATION: Pretend the OTP was sent but skip the delivery.
+			logger.Debug("Mobile number did not match a unique user. Skipping delivery to prevent enumeration.")
+			return s.skipOTPDelivery(mobileNumber, phoneAttr, ctx, execResp, logger)
+		}
+		logger.Debug("User not found, sending OTP for automatic registration")
+	} else {
 		execResp.RuntimeData[userAttributeUserID] = *userID
 	}
 
@@ -228,6 +282,39 @@ func (s *smsOTPAuthExecutor) InitiateOTP(ctx *core.NodeContext,
 	return nil
 }
 
+// canProceedWithUnknownRecipient reports whether the send step should continue when the mobile number
+// does not identify a unique user in an authentication flow.
+func (s *smsOTPAuthExecutor) canProceedWithUnknownRecipient(ctx *core.NodeContext,
+	execResp *common.ExecutorResponse) bool {
+	switch execResp.FailureReason {
+	case failureReasonUserNotFound:
+		return isUserEnumerationPreventionEnabled(ctx) || isAuthenticationWithoutLocalUserAllowed(ctx)
+	case failureReasonAmbiguousUser:
+		return isUserEnumerationPreventionEnabled(ctx) && !isAuthenticationWithoutLocalUserAllowed(ctx)
+	default:
+		return false
+	}
+}
+
+// skipOTPDelivery completes the send step without sending an OTP, while updating the runtime data
+// the same way as a successful send.
+func (s *smsOTPAuthExecutor) skipOTPDelivery(mobileNumber, phoneAttr string, ctx *core.NodeContext,
+	execResp *common.ExecutorResponse, logger *log.Logger) error {
+	attemptCount, err := s.validateAttempts(ctx, execResp, logger)
+	if err != nil {
+		return fmt.Errorf("failed to validate OTP attempts: %w", err)
+	}
+	if execResp.Status == common.ExecFailure {
+		return nil
+	}
+
+	execResp.RuntimeData["attemptCount"] = strconv.Itoa(attemptCount + 1)
+	execResp.RuntimeData[common.RuntimeKeySMSOTPMobileNumber] = mobileNumber
+	execResp.RuntimeData[common.RuntimeKeySMSOTPPhoneAttr] = phoneAttr
+	execResp.Status = common.ExecComplete
+	return nil
+}
+
 // ProcessAuthFlowResponse processes the authentication flow response for SMS OTP.
 func (s *smsOTPAuthExecutor) ProcessAuthFlowResponse(ctx *core.NodeContext,
 	execResp *common.ExecutorResponse) error {
@@ -600,14 +687,24 @@ func (s *smsOTPAuthExecutor) getAuthenticatedUser(ctx *core.NodeContext,
 		return nil, nil
 	}
 
+	unknownRecipient := ctx.RuntimeData[common.RuntimeKeySMSOTPUnknownRecipient] == dataValueTrue
+	if unknownRecipient && !isAuthenticationWithoutLocalUserAllowed(ctx) {
+		// No OTP was delivered to an unknown mobile number, so reject it like an incorrect OTP.
+		logger.Debug("OTP was not delivered to the mobile number, rejecting the provided OTP")
+		execResp.Status = common.ExecUserInputRequired
+		execResp.Inputs = s.GetRequiredInputs(ctx)
+		execResp.FailureReason = failureReasonInvalidOTP
+		return nil, nil
+	}
+
 	sessionToken := ctx.RuntimeData["otpSessionToken"]
 	if sessionToken == "" {
 		logger.Error("No session token found for OTP validation", log.MaskedString(log.LoggerKeyUserID, userID))
 		return nil, fmt.Errorf("no session token found for OTP validation")
 	}
 
-	// Handle registration flows.
-	if ctx.FlowType == common.FlowTypeRegistration {
+	// Handle registration flows and automatic registration of unknown mobile numbers.
+	if ctx.FlowType == common.FlowTypeRegistration || unknownRecipient {
 		// For registration flows, we don't have a user in the system yet.
 		// So we just validate the OTP and return an authenticated user with the mobile number as an attribute.
 		svcErr := s.otpService.VerifyOTP(ctx.Context, sessionToken, providedOTP)
@@ -624,6 +721,15 @@ func (s *smsOTPAuthExecutor) getAuthenticatedUser(ctx *core.NodeContext,
 			return nil, fmt.Errorf("failed to verify OTP: %s", svcErr.ErrorDescription.DefaultValue)
 		}
 
+		if unknownRecipient {
+			if err := s.resolveAutoRegistrationUserType(ctx, execResp); err != nil {
+				return nil, err
+			}
+			if execResp.Status == common.ExecFailure {
+				return nil, nil
+			}
+		}
+
 		execResp.Status = common.ExecComplete
 		execResp.FailureReason = ""
 		return &authncm.AuthenticatedUser{
@@ -700,3 +806,37 @@ func (s *smsOTPAuthExecutor) getAuthenticatedUser(ctx *core.NodeContext,
 
 	return authenticatedUser, nil
 }
+
+// resolveAutoRegistrationUserType marks a verified unknown mobile number as eligible for provisioning
+// into the user type configured for automatic registration.
+func (s *smsOTPAuthExecutor) resolveAutoRegistrationUserType(ctx *core.NodeContext,
+	execResp *common.ExecutorResponse) error {
+	logger := s.logger.With(log.String(log.LoggerKeyExecutionID, ctx.ExecutionID))
+
+	userType, _ := ctx.NodeProperties[propertyKeyAutoRegistrationUserType].(string)
+	if userType == "" {
+		logger.Debug("User type is not configured for automatic registration")
+		execResp.Status = common.ExecFailure
+		execResp.FailureReason = errCannotProvisionUserAutomatically
+		return nil
+	}
+
+	entityType, svcErr := s.entityTypeService.GetEntityTypeByName(ctx.Context,
+		entitytype.TypeCategoryUser, userType)
+	if svcErr != nil {
+		if svcErr.Type == serviceerror.ClientErrorType {
+			execResp.Status = common.ExecFailure
+			execResp.FailureReason = svcErr.ErrorDescription.DefaultValue
+			return nil
+		}
+
+		logger.Error("Error while retrieving user type", log.String("errorCode", svcErr.Code),
+			log.String("description", svcErr.ErrorDescription.DefaultValue))
+		return errors.New("error while retrieving user type")
+	}
+
+	execResp.RuntimeData[common.RuntimeKeyUserEligibleForProvisioning] = dataValueTrue
+	execResp.RuntimeData[userTypeKey] = entityType.Name
+	execResp.RuntimeData[defaultOUIDKey] = entityType.OUID
+	return nil
+}
@@ -21,6 +21,7 @@ package executor
 import (
 	"encoding/json"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -29,11 +30,13 @@ import (
 	authncm "github.com/thunder-id/thunderid/internal/authn/common"
 	authnprovidermgr "github.com/thunder-id/thunderid/internal/authnprovider/manager"
 	"github.com/thunder-id/thunderid/internal/entityprovider"
+	"github.com/thunder-id/thunderid/internal/entitytype"
 	"github.com/thunder-id/thunderid/internal/flow/common"
 	"github.com/thunder-id/thunderid/internal/flow/core"
 	"github.com/thunder-id/thunderid/tests/mocks/authn/otpmock"
 	"github.com/thunder-id/thunderid/tests/mocks/authnprovider/managermock"
 	"github.com/thunder-id/thunderid/tests/mocks/entityprovidermock"
+	"github.com/thunder-id/thunderid/tests/mocks/entitytypemock"
 	"github.com/thunder-id/thunderid/tests/mocks/flow/coremock"
 )
 
@@ -43,6 +46,7 @@ type SMSAuthExecutorTestSuite struct {
 	mockAuthnProvider  *managermock.AuthnProviderManagerInterfaceMock
 	mockFlowFactory    *coremock.FlowFactoryInterfaceMock
 	mockEntityProvider *entityprovidermock.EntityProviderInterfaceMock
+	mockEntityType     *entitytypemock.EntityTypeServiceInterfaceMock
 	executor           *smsOTPAuthExecutor
 }
 
@@ -55,6 +59,7 @@ func (suite *SMSAuthExecutorTestSuite) SetupTest() {
 	suite.mockAuthnProvider = managermock.NewAuthnProviderManagerInterfaceMock(suite.T())
 	suite.mockFlowFactory = coremock.NewFlowFactoryInterfaceMock(suite.T())
 	suite.mockEntityProvider = entityprovidermock.NewEntityProviderInterfaceMock(suite.T())
+	suite.mockEntityType = entitytypemock.NewEntityTypeServiceInterfaceMock(suite.T())
 
 	defaultInputs := []common.Input{
 		{
@@ -92,7 +97,7 @@ func (suite *SMSAuthExecutorTestSuite) SetupTest() {
 		defaultInputs, []common.Input(nil)).Return(mockExec)
 
 	suite.executor = newSMSOTPAuthExecutor(suite.mockFlowFactory,
-		suite.mockOTPService, suite.mockAuthnProvider, suite.mockEntityProvider)
+		suite.mockOTPService, suite.mockAuthnProvider, suite.mockEntityProvider, suite.mockEntityType)
 	// Inject the mock base executor
 	suite.executor.ExecutorInterface = mockExec
 }
@@ -610,3 +615,165 @@ func (suite *SMSAuthExecutorTestSuite) TestGetAuthenticatedUser_FetchFromStore_N
 	assert.Equal(suite.T(), "user-123", result.UserID)
 	suite.mockEntityProvider.AssertExpectations(suite.T())
 }
+
+func newSMSOTPSendContext(mobileNumber string, properties map[string]interface{}) *core.NodeContext {
+	nodeProperties := map[string]interface{}{"senderId": "sender-1"}
+	for k, v := range properties {
+		nodeProperties[k] = v
+	}
+	return &core.NodeContext{
+		ExecutionID:    "flow-123",
+		FlowType:       common.FlowTypeAuthentication,
+		ExecutorMode:   ExecutorModeSend,
+		UserInputs:     map[string]string{common.AttributeMobileNumber: mobileNumber},
+		RuntimeData:    make(map[string]string),
+		NodeProperties: nodeProperties,
+	}
+}
+
+// TestExecuteSend_PreventUserEnumeration_GenericResponse verifies that known and unknown mobile numbers
+// receive the same response and that both are padded to the minimum send duration.
+func (suite *SMSAuthExecutorTestSuite) TestExecuteSend_PreventUserEnumeration_GenericResponse() {
+	knownUserID := testExistingUser123ID
+	suite.mockEntityProvider.On("IdentifyEntity", map[string]interface{}{
+		common.AttributeMobileNumber: "+1111111111",
+	}).Return(&knownUserID, nil)
+	suite.mockEntityProvider.On("IdentifyEntity", map[string]interface{}{
+		common.AttributeMobileNumber: "+2222222222",
+	}).Return(nil, entityprovider.NewEntityProviderError(entityprovider.ErrorCodeEntityNotFound, "not found", ""))
+	suite.mockOTPService.On("SendOTP", mock.Anything, "sender-1", mock.Anything, "+1111111111").
+		Return("session-token", nil).Once()
+
+	fixedNow := time.Now()
+	suite.executor.now = func() time.Time { return fixedNow }
+	var slept []time.Duration
+	suite.executor.sleep = func(d time.Duration) { slept = append(slept, d) }
+
+	properties := map[string]interface{}{propertyKeyPreventUserEnumeration: true}
+	knownResp, err := suite.executor.Execute(newSMSOTPSendContext("+1111111111", properties))
+	suite.Require().NoError(err)
+	unknownResp, err := suite.executor.Execute(newSMSOTPSendContext("+2222222222", properties))
+	suite.Require().NoError(err)
+
+	assert.Equal(suite.T(), common.ExecComplete, knownResp.Status)
+	assert.Equal(suite.T(), knownResp.Status, unknownResp.Status)
+	assert.Equal(suite.T(), knownResp.FailureReason, unknownResp.FailureReason)
+	assert.Equal(suite.T(), knownResp.AdditionalData, unknownResp.AdditionalData)
+	assert.Equal(suite.T(), dataValueTrue, unknownResp.RuntimeData[common.RuntimeKeySMSOTPUnknownRecipient])
+	assert.Empty(suite.T(), knownResp.RuntimeData[common.RuntimeKeySMSOTPUnknownRecipient])
+	assert.Equal(suite.T(), "+2222222222", unknownResp.RuntimeData[common.RuntimeKeySMSOTPMobileNumber])
+	assert.Equal(suite.T(), []time.Duration{smsOTPMinSendDuration, smsOTPMinSendDuration}, slept)
+}
+
+// TestExecuteSend_UnknownNumberWithoutEnumerationPrevention_Fails verifies the default behavior.
+func (suite *SMSAuthExecutorTestSuite) TestExecuteSend_UnknownNumberWithoutEnumerationPrevention_Fails() {
+	suite.mockEntityProvider.On("IdentifyEntity", mock.Anything).
+		Return(nil, entityprovider.NewEntityProviderError(entityprovider.ErrorCodeEntityNotFound, "not found", ""))
+
+	execResp, err := suite.executor.Execute(newSMSOTPSendContext("+2222222222", nil))
+
+	suite.Require().NoError(err)
+	assert.Equal(suite.T(), common.ExecFailure, execResp.Status)
+	assert.Equal(suite.T(), failureReasonUserNotFound, execResp.FailureReason)
+}
+
+// TestExecuteSend_PerNumberRateLimit verifies that OTP sends are limited per mobile number.
+func (suite *SMSAuthExecutorTestSuite) TestExecuteSend_PerNumberRateLimit() {
+	suite.mockEntityProvider.On("IdentifyEntity", mock.Anything).
+		Return(nil, entityprovider.NewEntityProviderError(entityprovider.ErrorCodeEntityNotFound, "not found", ""))
+	suite.executor.sleep = func(time.Duration) {}
+
+	properties := map[string]interface{}{propertyKeyPreventUserEnumeration: true}
+	for i := 0; i < smsOTPMaxSendsPerNumber; i++ {
+		execResp, err := suite.executor.Execute(newSMSOTPSendContext("+2222222222", properties))
+		suite.Require().NoError(err)
+		suite.Require().Equal(common.ExecComplete, execResp.Status)
+	}
+
+	execResp, err := suite.executor.Execute(newSMSOTPSendContext("+2222222222", properties))
+	suite.Require().NoError(err)
+	assert.Equal(suite.T(), common.ExecFailure, execResp.Status)
+	assert.Equal(suite.T(), failureReasonOTPRateLimited, execResp.FailureReason)
+
+	execResp, err = suite.executor.Execute(newSMSOTPSendContext("+3333333333", properties))
+	suite.Require().NoError(err)
+	assert.Equal(suite.T(), common.ExecComplete, execResp.Status)
+}
+
+// TestGetAuthenticatedUser_UnknownRecipient_RejectsOTP verifies that an OTP for an unknown mobile number
+// is rejected the same way as an incorrect OTP.
+func (suite *SMSAuthExecutorTestSuite) TestGetAuthenticatedUser_UnknownRecipient_RejectsOTP() {
+	ctx := &core.NodeContext{
+		ExecutionID: "flow-123",
+		FlowType:    common.FlowTypeAuthentication,
+		UserInputs:  map[string]string{userInputOTP: "123456"},
+		RuntimeData: map[string]string{
+			common.RuntimeKeySMSOTPMobileNumber:     "+2222222222",
+			common.RuntimeKeySMSOTPUnknownRecipient: dataValueTrue,
+		},
+		NodeProperties: map[string]interface{}{propertyKeyPreventUserEnumeration: true},
+	}
+	execResp := &common.ExecutorResponse{RuntimeData: make(map[string]string)}
+
+	user, err := suite.executor.getAuthenticatedUser(ctx, execResp)
+
+	assert.NoError(suite.T(), err)
+	assert.Nil(suite.T(), user)
+	assert.Equal(suite.T(), common.ExecUserInputRequired, execResp.Status)
+	assert.Equal(suite.T(), failureReasonInvalidOTP, execResp.FailureReason)
+	suite.mockOTPService.AssertNotCalled(suite.T(), "VerifyOTP", mock.Anything, mock.Anything, mock.Anything)
+}
+
+// TestExecuteSend_UnknownNumberWithAutoRegistration_SendsOTP verifies that an OTP is sent to an unknown
+// mobile number when automatic registration is enabled.
+func (suite *SMSAuthExecutorTestSuite) TestExecuteSend_UnknownNumberWithAutoRegistration_SendsOTP() {
+	suite.mockEntityProvider.On("IdentifyEntity", mock.Anything).
+		Return(nil, entityprovider.NewEntityProviderError(entityprovider.ErrorCodeEntityNotFound, "not found", ""))
+	suite.mockOTPService.On("SendOTP", mock.Anything, "sender-1", mock.Anything, "+2222222222").
+		Return("session-token", nil).Once()
+
+	execResp, err := suite.executor.Execute(newSMSOTPSendContext("+2222222222", map[string]interface{}{
+		common.NodePropertyAllowAuthenticationWithoutLocalUser: true,
+	}))
+
+	suite.Require().NoError(err)
+	assert.Equal(suite.T(), common.ExecComplete, execResp.Status)
+	assert.Equal(suite.T(), dataValueTrue, execResp.RuntimeData[common.RuntimeKeySMSOTPUnknownRecipient])
+	assert.Equal(suite.T(), "session-token", execResp.RuntimeData["otpSessionToken"])
+}
+
+// TestGetAuthenticatedUser_UnknownRecipientWithAutoRegistration_MarksEligible verifies that a verified
+// unknown mobile number is marked for provisioning into the configured user type.
+func (suite *SMSAuthExecutorTestSuite) TestGetAuthenticatedUser_UnknownRecipientWithAutoRegistration_MarksEligible() {
+	suite.mockOTPService.On("VerifyOTP", mock.Anything, "session-token", "123456").Return(nil).Once()
+	suite.mockEntityType.On("GetEntityTypeByName", mock.Anything, entitytype.TypeCategoryUser, "customer").
+		Return(&entitytype.EntityType{Name: "customer", OUID: "ou-1"}, nil).Once()
+
+	ctx := &core.NodeContext{
+		ExecutionID: "flow-123",
+		FlowType:    common.FlowTypeAuthentication,
+		UserInputs:  map[string]string{userInputOTP: "123456"},
+		RuntimeData: map[string]string{
+			common.RuntimeKeySMSOTPMobileNumber:     "+2222222222",
+			common.RuntimeKeySMSOTPPhoneAttr:        common.AttributeMobileNumber,
+			common.RuntimeKeySMSOTPUnknownRecipient: dataValueTrue,
+			"otpSessionToken":                       "session-token",
+		},
+		NodeProperties: map[string]interface{}{
+			common.NodePropertyAllowAuthenticationWithoutLocalUser: true,
+			propertyKeyAutoRegistrationUserType:                    "customer",
+		},
+	}
+	execResp := &common.ExecutorResponse{RuntimeData: make(map[string]string)}
+
+	user, err := suite.executor.getAuthenticatedUser(ctx, execResp)
+
+	suite.Require().NoError(err)
+	suite.Require().NotNil(user)
+	assert.False(suite.T(), user.IsAuthenticated)
+	assert.Equal(suite.T(), "+2222222222", user.Attributes[common.AttributeMobileNumber])
+	assert.Equal(suite.T(), common.ExecComplete, execResp.Status)
+	assert.Equal(suite.T(), dataValueTrue, execResp.RuntimeData[common.RuntimeKeyUserEligibleForProvisioning])
+	assert.Equal(suite.T(), "customer", execResp.RuntimeData[userTypeKey])
+	assert.Equal(suite.T(), "ou-1", execResp.RuntimeData[defaultOUIDKey])
+}
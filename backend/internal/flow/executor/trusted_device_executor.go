@@ -0,0 +1,148 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package executor
+
+import (
+	"fmt"
+
+	"github.com/thunder-id/thunderid/internal/flow/common"
+	"github.com/thunder-id/thunderid/internal/flow/core"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/internal/trusteddevice"
+)
+
+var _ core.ExecutorInterface = (*trustedDeviceExecutor)(nil)
+
+const (
+	trustedDeviceLoggerComponentName = "TrustedDeviceExecutor"
+)
+
+// trustedDeviceExecutor checks and registers trusted devices so that MFA steps can be skipped on
+// remembered browsers.
+//
+// In verify mode it records whether the request comes from a trusted device of the authenticated user
+// in the trustedDevice runtime key, which MFA nodes can reference in their condition.
+// In generate mode it registers the device as trusted when the user opted in through the rememberDevice
+// input, and returns the trusted device token for the flow endpoint to set as a cookie.
+type trustedDeviceExecutor struct {
+	core.ExecutorInterface
+	trustedDeviceService trusteddevice.TrustedDeviceServiceInterface
+	logger               *log.Logger
+}
+
+// newTrustedDeviceExecutor creates a new instance of the trusted device executor.
+func newTrustedDeviceExecutor(
+	flowFactory core.FlowFactoryInterface,
+	trustedDeviceService trusteddevice.TrustedDeviceServiceInterface,
+) *trustedDeviceExecutor {
+	logger := log.GetLogger().With(
+		log.String(log.LoggerKeyComponentName, trustedDeviceLoggerComponentName),
+		log.String(log.LoggerKeyExecutorName, ExecutorNameTrustedDevice))
+
+	base := flowFactory.CreateExecutor(ExecutorNameTrustedDevice, common.ExecutorTypeUtility,
+		[]common.Input{}, []common.Input{})
+
+	return &trustedDeviceExecutor{
+		ExecutorInterface:    base,
+		trustedDeviceService: trustedDeviceService,
+		logger:               logger,
+	}
+}
+
+// Execute delegates to the appropriate mode handler based on the executor mode.
+func (e *trustedDeviceExecutor) Execute(ctx *core.NodeContext) (*common.ExecutorResponse, error) {
+	switch ctx.ExecutorMode {
+	case ExecutorModeVerify:
+		return e.executeVerify(ctx)
+	case ExecutorModeGenerate:
+		return e.executeGenerate(ctx)
+	default:
+		return nil, fmt.Errorf("invalid executor mode for TrustedDeviceExecutor: %s", ctx.ExecutorMode)
+	}
+}
+
+// executeVerify records whether the request comes from a trusted device of the authenticated user.
+func (e *trustedDeviceExecutor) executeVerify(ctx *core.NodeContext) (*common.ExecutorResponse, error) {
+	logger := e.logger.With(log.String(log.LoggerKeyExecutionID, ctx.ExecutionID))
+	logger.Debug("Executing trusted device executor in verify mode")
+
+	execResp := &common.ExecutorResponse{
+		AdditionalData: make(map[string]string),
+		RuntimeData:    make(map[string]string),
+	}
+
+	if !ctx.AuthenticatedUser.IsAuthenticated {
+		execResp.Status = common.ExecFailure
+		execResp.FailureReason = failureReasonUserNotAuthenticated
+		return execResp, nil
+	}
+
+	trusted, svcErr := e.trustedDeviceService.VerifyDevice(ctx.Context, ctx.AuthenticatedUser.UserID,
+		trusteddevice.GetDeviceInfo(ctx.Context))
+	if svcErr != nil {
+		return nil, fmt.Errorf("failed to verify trusted device: %s", svcErr.Code)
+	}
+
+	execResp.RuntimeData[common.RuntimeKeyTrustedDevice] = dataValueFalse
+	if trusted {
+		execResp.RuntimeData[common.RuntimeKeyTrustedDevice] = dataValueTrue
+	}
+
+	logger.Debug("Trusted device check completed", log.Bool("trusted", trusted))
+	execResp.Status = common.ExecComplete
+	return execResp, nil
+}
+
+// executeGenerate registers the device as trusted when the user opted in to remember it.
+func (e *trustedDeviceExecutor) executeGenerate(ctx *core.NodeContext) (*common.ExecutorResponse, error) {
+	logger := e.logger.With(log.String(log.LoggerKeyExecutionID, ctx.ExecutionID))
+	logger.Debug("Executing trusted device executor in generate mode")
+
+	execResp := &common.ExecutorResponse{
+		AdditionalData: make(map[string]string),
+		RuntimeData:    make(map[string]string),
+	}
+	execResp.Status = common.ExecComplete
+
+	if !ctx.AuthenticatedUser.IsAuthenticated {
+		execResp.Status = common.ExecFailure
+		execResp.FailureReason = failureReasonUserNotAuthenticated
+		return execResp, nil
+	}
+	if ctx.UserInputs[userInputRememberDevice] != dataValueTrue ||
+		ctx.RuntimeData[common.RuntimeKeyTrustedDevice] == dataValueTrue {
+		logger.Debug("Device registration not requested or device already trusted")
+		return execResp, nil
+	}
+	if ctx.Sandbox {
+		logger.Debug("Skipping trusted device registration in sandbox execution")
+		return execResp, nil
+	}
+
+	token, _, svcErr := e.trustedDeviceService.RegisterDevice(ctx.Context, ctx.AuthenticatedUser.UserID,
+		trusteddevice.GetDeviceInfo(ctx.Context))
+	if svcErr != nil {
+		return nil, fmt.Errorf("failed to register trusted device: %s", svcErr.Code)
+	}
+
+	execResp.AdditionalData[trusteddevice.AdditionalDataKeyDeviceToken] = token
+	execResp.RuntimeData[common.RuntimeKeyTrustedDevice] = dataValueTrue
+	logger.Debug("Registered trusted device for the authenticated user")
+	return execResp, nil
+}
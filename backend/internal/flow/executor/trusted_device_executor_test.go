@@ -0,0 +1,169 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package executor
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	authncm "github.com/thunder-id/thunderid/internal/authn/common"
+	"github.com/thunder-id/thunderid/internal/flow/common"
+	"github.com/thunder-id/thunderid/internal/flow/core"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/trusteddevice"
+	"github.com/thunder-id/thunderid/tests/mocks/flow/coremock"
+	"github.com/thunder-id/thunderid/tests/mocks/trusteddevicemock"
+)
+
+type TrustedDeviceExecutorTestSuite struct {
+	suite.Suite
+	mockFlowFactory   *coremock.FlowFactoryInterfaceMock
+	mockTrustedDevice *trusteddevicemock.TrustedDeviceServiceInterfaceMock
+	executor          *trustedDeviceExecutor
+	deviceInfo        trusteddevice.DeviceInfo
+}
+
+func TestTrustedDeviceExecutorSuite(t *testing.T) {
+	suite.Run(t, new(TrustedDeviceExecutorTestSuite))
+}
+
+func (suite *TrustedDeviceExecutorTestSuite) SetupTest() {
+	suite.mockFlowFactory = coremock.NewFlowFactoryInterfaceMock(suite.T())
+	suite.mockTrustedDevice = trusteddevicemock.NewTrustedDeviceServiceInterfaceMock(suite.T())
+	mockBaseExecutor := coremock.NewExecutorInterfaceMock(suite.T())
+
+	suite.mockFlowFactory.On("CreateExecutor", ExecutorNameTrustedDevice, common.ExecutorTypeUtility,
+		[]common.Input{}, []common.Input{}).Return(mockBaseExecutor)
+
+	suite.executor = newTrustedDeviceExecutor(suite.mockFlowFactory, suite.mockTrustedDevice)
+	suite.deviceInfo = trusteddevice.DeviceInfo{Token: "device-token", Fingerprint: "fingerprint"}
+}
+
+func (suite *TrustedDeviceExecutorTestSuite) newNodeContext(mode string) *core.NodeContext {
+	return &core.NodeContext{
+		Context:      trusteddevice.WithDeviceInfo(context.Background(), suite.deviceInfo),
+		ExecutionID:  "test-flow-id",
+		ExecutorMode: mode,
+		UserInputs:   make(map[string]string),
+		RuntimeData:  make(map[string]string),
+		AuthenticatedUser: authncm.AuthenticatedUser{
+			IsAuthenticated: true,
+			UserID:          "user-1",
+		},
+	}
+}
+
+func (suite *TrustedDeviceExecutorTestSuite) TestExecute_VerifyMode() {
+	testCases := []struct {
+		name     string
+		trusted  bool
+		expected string
+	}{
+		{"TrustedDevice", true, dataValueTrue},
+		{"UntrustedDevice", false, dataValueFalse},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.mockTrustedDevice.On("VerifyDevice", mock.Anything, "user-1", suite.deviceInfo).
+				Return(tc.trusted, nil).Once()
+
+			resp, err := suite.executor.Execute(suite.newNodeContext(ExecutorModeVerify))
+
+			suite.NoError(err)
+			suite.Equal(common.ExecComplete, resp.Status)
+			suite.Equal(tc.expected, resp.RuntimeData[common.RuntimeKeyTrustedDevice])
+		})
+	}
+}
+
+func (suite *TrustedDeviceExecutorTestSuite) TestExecute_VerifyMode_ServiceError() {
+	suite.mockTrustedDevice.On("VerifyDevice", mock.Anything, "user-1", suite.deviceInfo).
+		Return(false, &serviceerror.InternalServerError).Once()
+
+	resp, err := suite.executor.Execute(suite.newNodeContext(ExecutorModeVerify))
+
+	suite.Error(err)
+	suite.Nil(resp)
+}
+
+func (suite *TrustedDeviceExecutorTestSuite) TestExecute_VerifyMode_NotAuthenticated() {
+	ctx := suite.newNodeContext(ExecutorModeVerify)
+	ctx.AuthenticatedUser = authncm.AuthenticatedUser{}
+
+	resp, err := suite.executor.Execute(ctx)
+
+	suite.NoError(err)
+	suite.Equal(common.ExecFailure, resp.Status)
+	suite.Equal(failureReasonUserNotAuthenticated, resp.FailureReason)
+}
+
+func (suite *TrustedDeviceExecutorTestSuite) TestExecute_GenerateMode_RegistersDevice() {
+	suite.mockTrustedDevice.On("RegisterDevice", mock.Anything, "user-1", suite.deviceInfo).
+		Return("new-device-token", &trusteddevice.TrustedDevice{ID: "device-1"}, nil).Once()
+	ctx := suite.newNodeContext(ExecutorModeGenerate)
+	ctx.UserInputs[userInputRememberDevice] = dataValueTrue
+
+	resp, err := suite.executor.Execute(ctx)
+
+	suite.NoError(err)
+	suite.Equal(common.ExecComplete, resp.Status)
+	suite.Equal("new-device-token", resp.AdditionalData[trusteddevice.AdditionalDataKeyDeviceToken])
+	suite.Equal(dataValueTrue, resp.RuntimeData[common.RuntimeKeyTrustedDevice])
+}
+
+func (suite *TrustedDeviceExecutorTestSuite) TestExecute_GenerateMode_SkipsRegistration() {
+	testCases := []struct {
+		name  string
+		setup func(ctx *core.NodeContext)
+	}{
+		{"NotRequested", func(ctx *core.NodeContext) {}},
+		{"AlreadyTrusted", func(ctx *core.NodeContext) {
+			ctx.UserInputs[userInputRememberDevice] = dataValueTrue
+			ctx.RuntimeData[common.RuntimeKeyTrustedDevice] = dataValueTrue
+		}},
+		{"Sandbox", func(ctx *core.NodeContext) {
+			ctx.UserInputs[userInputRememberDevice] = dataValueTrue
+			ctx.Sandbox = true
+		}},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			ctx := suite.newNodeContext(ExecutorModeGenerate)
+			tc.setup(ctx)
+
+			resp, err := suite.executor.Execute(ctx)
+
+			suite.NoError(err)
+			suite.Equal(common.ExecComplete, resp.Status)
+			suite.Empty(resp.AdditionalData)
+		})
+	}
+}
+
+func (suite *TrustedDeviceExecutorTestSuite) TestExecute_InvalidMode() {
+	resp, err := suite.executor.Execute(suite.newNodeContext(ExecutorModeSend))
+
+	suite.Error(err)
+	suite.Nil(resp)
+}
@@ -23,6 +23,7 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/thunder-id/thunderid/internal/appuser"
 	authncm "github.com/thunder-id/thunderid/internal/authn/common"
 	authnprovidermgr "github.com/thunder-id/thunderid/internal/authnprovider/manager"
 	"github.com/thunder-id/thunderid/internal/entityprovider"
@@ -79,6 +80,18 @@ func isAuthenticationWithoutLocalUserAllowed(ctx *core.NodeContext) bool {
 	return false
 }
 
+// isUserEnumerationPreventionEnabled returns the value of the preventUserEnumeration node property,
+// defaulting to false if absent or not a bool.
+// When enabled, executors respond the same way whether or not the provided identifier belongs to a user.
+func isUserEnumerationPreventionEnabled(ctx *core.NodeContext) bool {
+	if val, ok := ctx.NodeProperties[propertyKeyPreventUserEnumeration]; ok {
+		if boolVal, ok := val.(bool); ok {
+			return boolVal
+		}
+	}
+	return false
+}
+
 // isRegistrationWithExistingUserAllowed returns the value of the AllowRegistrationWithExistingUser
 // node property, defaulting to false if absent or not a bool.
 // This is used to determine if registration flow can proceed when an existing user account is found.
@@ -159,3 +172,27 @@ func getAuthenticatedIdentifierValue(ctx *core.NodeContext, key string) string {
 
 	return systemutils.ConvertInterfaceValueToString(value)
 }
+
+// isApplicationUserIsolationEnabled reports whether the flow's application only sees the users associated
+// with it.
+func isApplicationUserIsolationEnabled(ctx *core.NodeContext,
+	appUserService appuser.AppUserServiceInterface) bool {
+	return appUserService != nil && appUserService.IsEnabled() && ctx.Application.UserIsolation
+}
+
+// isUserVisibleToApplication reports whether the given user is visible to the flow's application.
+// Every user is visible unless the application isolates its users, in which case only users
+// associated with the application are visible.
+func isUserVisibleToApplication(ctx *core.NodeContext, appUserService appuser.AppUserServiceInterface,
+	userID string) (bool, error) {
+	if !isApplicationUserIsolationEnabled(ctx, appUserService) {
+		return true, nil
+	}
+
+	isAppUser, svcErr := appUserService.IsAppUser(ctx.Context, ctx.Application.ID, userID)
+	if svcErr != nil {
+		return false, errors.New("failed to resolve the application association of the user: " +
+			svcErr.ErrorDescription.DefaultValue)
+	}
+	return isAppUser, nil
+}
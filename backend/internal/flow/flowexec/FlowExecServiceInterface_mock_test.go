@@ -38,6 +38,39 @@ func (_m *FlowExecServiceInterfaceMock) EXPECT() *FlowExecServiceInterfaceMock_E
 	return &FlowExecServiceInterfaceMock_Expecter{mock: &_m.Mock}
 }
 
+// CloseExecutionStateStreams provides a mock function for the type FlowExecServiceInterfaceMock
+func (_mock *FlowExecServiceInterfaceMock) CloseExecutionStateStreams() {
+	_mock.Called()
+	return
+}
+
+// FlowExecServiceInterfaceMock_CloseExecutionStateStreams_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CloseExecutionStateStreams'
+type FlowExecServiceInterfaceMock_CloseExecutionStateStreams_Call struct {
+	*mock.Call
+}
+
+// CloseExecutionStateStreams is a helper method to define mock.On call
+func (_e *FlowExecServiceInterfaceMock_Expecter) CloseExecutionStateStreams() *FlowExecServiceInterfaceMock_CloseExecutionStateStreams_Call {
+	return &FlowExecServiceInterfaceMock_CloseExecutionStateStreams_Call{Call: _e.mock.On("CloseExecutionStateStreams")}
+}
+
+func (_c *FlowExecServiceInterfaceMock_CloseExecutionStateStreams_Call) Run(run func()) *FlowExecServiceInterfaceMock_CloseExecutionStateStreams_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *FlowExecServiceInterfaceMock_CloseExecutionStateStreams_Call) Return() *FlowExecServiceInterfaceMock_CloseExecutionStateStreams_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *FlowExecServiceInterfaceMock_CloseExecutionStateStreams_Call) RunAndReturn(run func()) *FlowExecServiceInterfaceMock_CloseExecutionStateStreams_Call {
+	_c.Run(run)
+	return _c
+}
+
 // Execute provides a mock function for the type FlowExecServiceInterfaceMock
 func (_mock *FlowExecServiceInterfaceMock) Execute(ctx context.Context, appID string, executionID string, flowType string, verbose bool, action string, inputs map[string]string, challengeToken string) (*FlowStep, *serviceerror.ServiceError) {
 	ret := _mock.Called(ctx, appID, executionID, flowType, verbose, action, inputs, challengeToken)
@@ -144,6 +177,82 @@ func (_c *FlowExecServiceInterfaceMock_Execute_Call) RunAndReturn(run func(ctx c
 	return _c
 }
 
+// GetExecutionContext provides a mock function for the type FlowExecServiceInterfaceMock
+func (_mock *FlowExecServiceInterfaceMock) GetExecutionContext(ctx context.Context, executionID string, language string) (*FlowExecutionContext, *serviceerror.ServiceError) {
+	ret := _mock.Called(ctx, executionID, language)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetExecutionContext")
+	}
+
+	var r0 *FlowExecutionContext
+	var r1 *serviceerror.ServiceError
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (*FlowExecutionContext, *serviceerror.ServiceError)); ok {
+		return returnFunc(ctx, executionID, language)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) *FlowExecutionContext); ok {
+		r0 = returnFunc(ctx, executionID, language)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*FlowExecutionContext)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) *serviceerror.ServiceError); ok {
+		r1 = returnFunc(ctx, executionID, language)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*serviceerror.ServiceError)
+		}
+	}
+	return r0, r1
+}
+
+// FlowExecServiceInterfaceMock_GetExecutionContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetExecutionContext'
+type FlowExecServiceInterfaceMock_GetExecutionContext_Call struct {
+	*mock.Call
+}
+
+// GetExecutionContext is a helper method to define mock.On call
+//   - ctx context.Context
+//   - executionID string
+//   - language string
+func (_e *FlowExecServiceInterfaceMock_Expecter) GetExecutionContext(ctx interface{}, executionID interface{}, language interface{}) *FlowExecServiceInterfaceMock_GetExecutionContext_Call {
+	return &FlowExecServiceInterfaceMock_GetExecutionContext_Call{Call: _e.mock.On("GetExecutionContext", ctx, executionID, language)}
+}
+
+func (_c *FlowExecServiceInterfaceMock_GetExecutionContext_Call) Run(run func(ctx context.Context, executionID string, language string)) *FlowExecServiceInterfaceMock_GetExecutionContext_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *FlowExecServiceInterfaceMock_GetExecutionContext_Call) Return(flowExecutionContext *FlowExecutionContext, serviceError *serviceerror.ServiceError) *FlowExecServiceInterfaceMock_GetExecutionContext_Call {
+	_c.Call.Return(flowExecutionContext, serviceError)
+	return _c
+}
+
+func (_c *FlowExecServiceInterfaceMock_GetExecutionContext_Call) RunAndReturn(run func(ctx context.Context, executionID string, language string) (*FlowExecutionContext, *serviceerror.ServiceError)) *FlowExecServiceInterfaceMock_GetExecutionContext_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // InitiateFlow provides a mock function for the type FlowExecServiceInterfaceMock
 func (_mock *FlowExecServiceInterfaceMock) InitiateFlow(ctx context.Context, initContext *FlowInitContext) (string, *serviceerror.ServiceError) {
 	ret := _mock.Called(ctx, initContext)
@@ -211,3 +320,79 @@ func (_c *FlowExecServiceInterfaceMock_InitiateFlow_Call) RunAndReturn(run func(
 	_c.Call.Return(run)
 	return _c
 }
+
+// SubscribeExecutionState provides a mock function for the type FlowExecServiceInterfaceMock
+func (_mock *FlowExecServiceInterfaceMock) SubscribeExecutionState(ctx context.Context, executionID string, challengeToken string) (<-chan FlowStateEvent, *serviceerror.ServiceError) {
+	ret := _mock.Called(ctx, executionID, challengeToken)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SubscribeExecutionState")
+	}
+
+	var r0 <-chan FlowStateEvent
+	var r1 *serviceerror.ServiceError
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (<-chan FlowStateEvent, *serviceerror.ServiceError)); ok {
+		return returnFunc(ctx, executionID, challengeToken)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) <-chan FlowStateEvent); ok {
+		r0 = returnFunc(ctx, executionID, challengeToken)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(<-chan FlowStateEvent)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) *serviceerror.ServiceError); ok {
+		r1 = returnFunc(ctx, executionID, challengeToken)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*serviceerror.ServiceError)
+		}
+	}
+	return r0, r1
+}
+
+// FlowExecServiceInterfaceMock_SubscribeExecutionState_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SubscribeExecutionState'
+type FlowExecServiceInterfaceMock_SubscribeExecutionState_Call struct {
+	*mock.Call
+}
+
+// SubscribeExecutionState is a helper method to define mock.On call
+//   - ctx context.Context
+//   - executionID string
+//   - challengeToken string
+func (_e *FlowExecServiceInterfaceMock_Expecter) SubscribeExecutionState(ctx interface{}, executionID interface{}, challengeToken interface{}) *FlowExecServiceInterfaceMock_SubscribeExecutionState_Call {
+	return &FlowExecServiceInterfaceMock_SubscribeExecutionState_Call{Call: _e.mock.On("SubscribeExecutionState", ctx, executionID, challengeToken)}
+}
+
+func (_c *FlowExecServiceInterfaceMock_SubscribeExecutionState_Call) Run(run func(ctx context.Context, executionID string, challengeToken string)) *FlowExecServiceInterfaceMock_SubscribeExecutionState_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *FlowExecServiceInterfaceMock_SubscribeExecutionState_Call) Return(ch <-chan FlowStateEvent, serviceError *serviceerror.ServiceError) *FlowExecServiceInterfaceMock_SubscribeExecutionState_Call {
+	_c.Call.Return(ch, serviceError)
+	return _c
+}
+
+func (_c *FlowExecServiceInterfaceMock_SubscribeExecutionState_Call) RunAndReturn(run func(ctx context.Context, executionID string, challengeToken string) (<-chan FlowStateEvent, *serviceerror.ServiceError)) *FlowExecServiceInterfaceMock_SubscribeExecutionState_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -0,0 +1,134 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package flowexec
+
+import (
+	"slices"
+
+	"github.com/thunder-id/thunderid/internal/flow/common"
+	"github.com/thunder-id/thunderid/internal/flow/core"
+	"github.com/thunder-id/thunderid/internal/flow/executor"
+	inboundmodel "github.com/thunder-id/thunderid/internal/inboundclient/model"
+)
+
+// authMethod represents the category of an authentication method used by a flow node.
+type authMethod string
+
+const (
+	authMethodNone      authMethod = ""
+	authMethodLocal     authMethod = "local"
+	authMethodPasskey   authMethod = "passkey"
+	authMethodFederated authMethod = "federated"
+)
+
+// nodePropertyIDPID is the node property holding the identity provider of a federated executor.
+const nodePropertyIDPID = "idpId"
+
+// executorAuthMethods maps authentication executors to the method category they implement.
+var executorAuthMethods = map[string]authMethod{
+	executor.ExecutorNameBasicAuth:     authMethodLocal,
+	executor.ExecutorNameSMSAuth:       authMethodLocal,
+	executor.ExecutorNameMagicLinkAuth: authMethodLocal,
+	executor.ExecutorNamePasskeyAuth:   authMethodPasskey,
+	executor.ExecutorNameOAuth:         authMethodFederated,
+	executor.ExecutorNameOIDCAuth:      authMethodFederated,
+	executor.ExecutorNameGitHubAuth:    authMethodFederated,
+	executor.ExecutorNameGoogleAuth:    authMethodFederated,
+}
+
+// resolveNodeAuthMethod returns the authentication method a node implements and, for federated
+// methods, the identity provider it authenticates against.
+func resolveNodeAuthMethod(node core.NodeInterface) (authMethod, string) {
+	if node == nil || node.GetType() != common.NodeTypeTaskExecution {
+		return authMethodNone, ""
+	}
+	executableNode, ok := node.(core.ExecutorBackedNodeInterface)
+	if !ok {
+		return authMethodNone, ""
+	}
+	method := executorAuthMethods[executableNode.GetExecutorName()]
+	if method != authMethodFederated {
+		return method, ""
+	}
+	idpID, _ := node.GetProperties()[nodePropertyIDPID].(string)
+	return method, idpID
+}
+
+// isAuthMethodAllowed reports whether the node may be executed under the given allowed
+// authentication methods. Nodes that are not authentication methods are always allowed.
+func isAuthMethodAllowed(allowed *inboundmodel.AuthMethodsConfig, node core.NodeInterface) bool {
+	if allowed == nil {
+		return true
+	}
+	method, idpID := resolveNodeAuthMethod(node)
+	switch method {
+	case authMethodLocal:
+		return allowed.Local
+	case authMethodPasskey:
+		return allowed.Passkey
+	case authMethodFederated:
+		return idpID != "" && slices.Contains(allowed.FederatedIDPs, idpID)
+	default:
+		return true
+	}
+}
+
+// getDisallowedActions returns the action references of a prompt node that lead to an
+// authentication method not allowed for the application.
+func getDisallowedActions(allowed *inboundmodel.AuthMethodsConfig, graph core.GraphInterface,
+	node core.NodeInterface) []string {
+	if allowed == nil || graph == nil {
+		return nil
+	}
+	promptNode, ok := node.(core.PromptNodeInterface)
+	if !ok {
+		return nil
+	}
+
+	var disallowed []string
+	for _, prompt := range promptNode.GetPrompts() {
+		if prompt.Action == nil || prompt.Action.NextNode == "" {
+			continue
+		}
+		nextNode, exists := graph.GetNode(prompt.Action.NextNode)
+		if exists && !isAuthMethodAllowed(allowed, nextNode) {
+			disallowed = append(disallowed, prompt.Action.Ref)
+		}
+	}
+	return disallowed
+}
+
+// hasAllowedAuthMethod reports whether the graph offers at least one authentication method
+// allowed for the application. Graphs without authentication method nodes are not restricted.
+func hasAllowedAuthMethod(allowed *inboundmodel.AuthMethodsConfig, graph core.GraphInterface) bool {
+	if allowed == nil || graph == nil {
+		return true
+	}
+
+	hasAuthMethod := false
+	for _, node := range graph.GetNodes() {
+		if method, _ := resolveNodeAuthMethod(node); method == authMethodNone {
+			continue
+		}
+		hasAuthMethod = true
+		if isAuthMethodAllowed(allowed, node) {
+			return true
+		}
+	}
+	return !hasAuthMethod
+}
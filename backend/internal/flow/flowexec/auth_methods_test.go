@@ -0,0 +1,116 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package flowexec
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/flow/common"
+	"github.com/thunder-id/thunderid/internal/flow/core"
+	"github.com/thunder-id/thunderid/internal/flow/executor"
+	inboundmodel "github.com/thunder-id/thunderid/internal/inboundclient/model"
+	"github.com/thunder-id/thunderid/tests/mocks/flow/coremock"
+)
+
+type AuthMethodsTestSuite struct {
+	suite.Suite
+}
+
+func TestAuthMethodsTestSuite(t *testing.T) {
+	suite.Run(t, new(AuthMethodsTestSuite))
+}
+
+func (s *AuthMethodsTestSuite) newExecutorNode(executorName, idpID string) *coremock.ExecutorBackedNodeInterfaceMock {
+	node := coremock.NewExecutorBackedNodeInterfaceMock(s.T())
+	node.On("GetType").Return(common.NodeTypeTaskExecution).Maybe()
+	node.On("GetExecutorName").Return(executorName).Maybe()
+	properties := map[string]interface{}{}
+	if idpID != "" {
+		properties["idpId"] = idpID
+	}
+	node.On("GetProperties").Return(properties).Maybe()
+	return node
+}
+
+func (s *AuthMethodsTestSuite) TestIsAuthMethodAllowed_NilConfigAllowsAll() {
+	node := s.newExecutorNode(executor.ExecutorNameBasicAuth, "")
+
+	s.True(isAuthMethodAllowed(nil, node))
+}
+
+func (s *AuthMethodsTestSuite) TestIsAuthMethodAllowed() {
+	allowed := &inboundmodel.AuthMethodsConfig{FederatedIDPs: []string{"idp-1"}}
+
+	s.False(isAuthMethodAllowed(allowed, s.newExecutorNode(executor.ExecutorNameBasicAuth, "")))
+	s.False(isAuthMethodAllowed(allowed, s.newExecutorNode(executor.ExecutorNamePasskeyAuth, "")))
+	s.True(isAuthMethodAllowed(allowed, s.newExecutorNode(executor.ExecutorNameGoogleAuth, "idp-1")))
+	s.False(isAuthMethodAllowed(allowed, s.newExecutorNode(executor.ExecutorNameOIDCAuth, "idp-2")))
+	s.True(isAuthMethodAllowed(allowed, s.newExecutorNode(executor.ExecutorNameAuthAssert, "")))
+
+	allowed = &inboundmodel.AuthMethodsConfig{Local: true, Passkey: true}
+	s.True(isAuthMethodAllowed(allowed, s.newExecutorNode(executor.ExecutorNameSMSAuth, "")))
+	s.True(isAuthMethodAllowed(allowed, s.newExecutorNode(executor.ExecutorNamePasskeyAuth, "")))
+	s.False(isAuthMethodAllowed(allowed, s.newExecutorNode(executor.ExecutorNameGitHubAuth, "idp-1")))
+}
+
+func (s *AuthMethodsTestSuite) TestGetDisallowedActions() {
+	allowed := &inboundmodel.AuthMethodsConfig{FederatedIDPs: []string{"idp-1"}}
+	promptNode := coremock.NewPromptNodeInterfaceMock(s.T())
+	promptNode.On("GetPrompts").Return([]common.Prompt{
+		{Action: &common.Action{Ref: "basic", NextNode: "basic_auth"}},
+		{Action: &common.Action{Ref: "google", NextNode: "google_auth"}},
+		{Action: &common.Action{Ref: "back", NextNode: "welcome"}},
+		{Inputs: []common.Input{{Identifier: "username"}}},
+	})
+	welcome := coremock.NewNodeInterfaceMock(s.T())
+	welcome.On("GetType").Return(common.NodeTypePrompt)
+	graph := coremock.NewGraphInterfaceMock(s.T())
+	graph.On("GetNode", "basic_auth").Return(
+		core.NodeInterface(s.newExecutorNode(executor.ExecutorNameBasicAuth, "")), true)
+	graph.On("GetNode", "google_auth").Return(
+		core.NodeInterface(s.newExecutorNode(executor.ExecutorNameGoogleAuth, "idp-1")), true)
+	graph.On("GetNode", "welcome").Return(core.NodeInterface(welcome), true)
+
+	s.Equal([]string{"basic"}, getDisallowedActions(allowed, graph, promptNode))
+	s.Nil(getDisallowedActions(nil, graph, promptNode))
+}
+
+func (s *AuthMethodsTestSuite) TestHasAllowedAuthMethod() {
+	graph := coremock.NewGraphInterfaceMock(s.T())
+	graph.On("GetNodes").Return(map[string]core.NodeInterface{
+		"basic_auth":  s.newExecutorNode(executor.ExecutorNameBasicAuth, ""),
+		"google_auth": s.newExecutorNode(executor.ExecutorNameGoogleAuth, "idp-1"),
+	})
+
+	s.True(hasAllowedAuthMethod(nil, graph))
+	s.True(hasAllowedAuthMethod(&inboundmodel.AuthMethodsConfig{Local: true}, graph))
+	s.True(hasAllowedAuthMethod(&inboundmodel.AuthMethodsConfig{FederatedIDPs: []string{"idp-1"}}, graph))
+	s.False(hasAllowedAuthMethod(&inboundmodel.AuthMethodsConfig{Passkey: true}, graph))
+	s.False(hasAllowedAuthMethod(&inboundmodel.AuthMethodsConfig{FederatedIDPs: []string{"idp-2"}}, graph))
+}
+
+func (s *AuthMethodsTestSuite) TestHasAllowedAuthMethod_NoAuthMethodNodes() {
+	graph := coremock.NewGraphInterfaceMock(s.T())
+	graph.On("GetNodes").Return(map[string]core.NodeInterface{
+		"assert": s.newExecutorNode(executor.ExecutorNameAuthAssert, ""),
+	})
+
+	s.True(hasAllowedAuthMethod(&inboundmodel.AuthMethodsConfig{Passkey: true}, graph))
+}
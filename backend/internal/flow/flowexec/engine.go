@@ -27,6 +27,7 @@ import (
 	"github.com/thunder-id/thunderid/internal/flow/common"
 	"github.com/thunder-id/thunderid/internal/flow/core"
 	"github.com/thunder-id/thunderid/internal/flow/executor"
+	sysContext "github.com/thunder-id/thunderid/internal/system/context"
 	"github.com/thunder-id/thunderid/internal/system/cryptolab"
 	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
 	"github.com/thunder-id/thunderid/internal/system/log"
@@ -97,6 +98,7 @@ func (fe *flowEngine) Execute(ctx *EngineContext) (FlowStep, *serviceerror.Servi
 			EntityID:          ctx.AppID,
 			CurrentAction:     ctx.CurrentAction,
 			Verbose:           ctx.Verbose,
+			Sandbox:           ctx.Sandbox,
 			NodeInputs:        getNodeInputs(ctx.CurrentNode),
 			UserInputs:        ctx.UserInputs,
 			CurrentNodeID:     ctx.CurrentNode.GetID(),
@@ -135,6 +137,18 @@ func (fe *flowEngine) Execute(ctx *EngineContext) (FlowStep, *serviceerror.Servi
 			continue
 		}
 
+		// Reject authentication methods that are not allowed for the application and hide the
+		// prompt actions leading to them.
+		allowedAuthMethods := ctx.Application.AllowedAuthMethods
+		if !isAuthMethodAllowed(allowedAuthMethods, currentNode) {
+			logger.Debug("Authentication method is not allowed for the application",
+				log.String("nodeID", currentNode.GetID()))
+			publishFlowFailedEvent(ctx, &ErrorAuthMethodNotAllowed, flowStartTime, time.Now().UnixMilli(),
+				fe.observabilitySvc)
+			return flowStep, &ErrorAuthMethodNotAllowed
+		}
+		nodeCtx.DisallowedActions = getDisallowedActions(allowedAuthMethods, ctx.Graph, currentNode)
+
 		svcErr := fe.setNodeExecutor(currentNode, logger)
 		if svcErr != nil {
 			return flowStep, svcErr
@@ -478,14 +492,15 @@ func (fe *flowEngine) shouldUpdateAuthenticatedUser(engineCtx *EngineContext) bo
 		return false
 	}
 
-	// For registration flows, only update from provisioning executor or conditionally
-	// from authentication executors
+	// For registration flows, only update from provisioning executor, the account activator or
+	// conditionally from authentication executors
 	if engineCtx.FlowType == common.FlowTypeRegistration {
 		if engineCtx.RuntimeData != nil && engineCtx.RuntimeData[common.RuntimeKeySkipProvisioning] == "true" {
 			return executorInst.GetType() == common.ExecutorTypeAuthentication
 		}
 
-		return executorInst.GetName() == executor.ExecutorNameProvisioning
+		return executorInst.GetName() == executor.ExecutorNameProvisioning ||
+			executorInst.GetName() == executor.ExecutorNameAccountActivator
 	}
 
 	// For user onboarding flows, update from authentication executors or from provisioning executor.
@@ -984,6 +999,7 @@ func publishNodeExecutionStartedEvent(
 		string(event.EventTypeFlowNodeExecutionStarted),
 		event.ComponentFlowEngine,
 	).
+		WithTenantID(sysContext.GetTenantID(ctx.Context)).
 		WithStatus(event.StatusInProgress).
 		WithData(event.DataKey.ExecutionID, ctx.ExecutionID).
 		WithData(event.DataKey.FlowType, string(ctx.FlowType)).
@@ -1055,6 +1071,7 @@ func publishNodeExecutionCompletedEvent(ctx *EngineContext, node core.NodeInterf
 		string(eventType),
 		event.ComponentFlowEngine,
 	).
+		WithTenantID(sysContext.GetTenantID(ctx.Context)).
 		WithStatus(status).
 		WithData(event.DataKey.ExecutionID, ctx.ExecutionID).
 		WithData(event.DataKey.FlowType, string(ctx.FlowType)).
@@ -1097,6 +1114,7 @@ func publishFlowStartedEvent(ctx *EngineContext, obsSvc observability.Observabil
 		string(event.EventTypeFlowStarted),
 		event.ComponentFlowEngine,
 	).
+		WithTenantID(sysContext.GetTenantID(ctx.Context)).
 		WithStatus(event.StatusInProgress).
 		WithData(event.DataKey.ExecutionID, ctx.ExecutionID).
 		WithData(event.DataKey.FlowType, string(ctx.FlowType)).
@@ -1129,6 +1147,7 @@ func publishFlowCompletedEvent(
 		string(event.EventTypeFlowCompleted),
 		event.ComponentFlowEngine,
 	).
+		WithTenantID(sysContext.GetTenantID(ctx.Context)).
 		WithStatus(event.StatusSuccess).
 		WithData(event.DataKey.ExecutionID, ctx.ExecutionID).
 		WithData(event.DataKey.FlowType, string(ctx.FlowType)).
@@ -1158,6 +1177,7 @@ func publishFlowFailedEvent(ctx *EngineContext, svcErr *serviceerror.ServiceErro
 		string(event.EventTypeFlowFailed),
 		event.ComponentFlowEngine,
 	).
+		WithTenantID(sysContext.GetTenantID(ctx.Context)).
 		WithStatus(event.StatusFailure).
 		WithData(event.DataKey.ExecutionID, ctx.ExecutionID).
 		WithData(event.DataKey.FlowType, string(ctx.FlowType)).
@@ -164,3 +164,18 @@ var ErrorInvalidChallengeToken = serviceerror.ServiceError{
 		DefaultValue: "The challenge token is missing or invalid",
 	},
 }
+
+// ErrorAuthMethodNotAllowed defines the error response when the flow requires an authentication
+// method that is not allowed for the application.
+var ErrorAuthMethodNotAllowed = serviceerror.ServiceError{
+	Code: "FES-1011",
+	Type: serviceerror.ClientErrorType,
+	Error: core.I18nMessage{
+		Key:          "error.flowexecservice.auth_method_not_allowed",
+		DefaultValue: "Authentication method not allowed",
+	},
+	ErrorDescription: core.I18nMessage{
+		Key:          "error.flowexecservice.auth_method_not_allowed_description",
+		DefaultValue: "The authentication method is not allowed for the application",
+	},
+}
@@ -0,0 +1,117 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package flowexec
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/thunder-id/thunderid/internal/flow/common"
+	"github.com/thunder-id/thunderid/internal/flow/core"
+	"github.com/thunder-id/thunderid/internal/flow/flowmeta"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/log"
+)
+
+// GetExecutionContext returns the data required to render the current step of an ongoing flow
+// execution, including the branding and localized labels resolved for the flow's application.
+func (s *flowExecService) GetExecutionContext(ctx context.Context, executionID, language string) (
+	*FlowExecutionContext, *serviceerror.ServiceError) {
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, "FlowExecService"),
+		log.String(log.LoggerKeyExecutionID, executionID))
+
+	engineCtx, svcErr := s.loadContextFromStore(ctx, executionID, logger)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+
+	execCtx := &FlowExecutionContext{
+		ExecutionID: engineCtx.ExecutionID,
+		FlowType:    string(engineCtx.FlowType),
+		Inputs:      make([]common.Input, 0),
+		Actions:     make([]common.Action, 0),
+	}
+	if engineCtx.FlowType != common.FlowTypeUserOnboarding {
+		execCtx.AllowedAuthMethods = engineCtx.Application.AllowedAuthMethods
+	}
+	populateCurrentStep(engineCtx, execCtx)
+
+	if s.flowMetaService != nil {
+		metaType := flowmeta.MetaType("")
+		if engineCtx.FlowType != common.FlowTypeUserOnboarding && engineCtx.AppID != "" {
+			metaType = flowmeta.MetaTypeAPP
+		}
+		var lang *string
+		if language != "" {
+			lang = &language
+		}
+		metadata, metaErr := s.flowMetaService.GetFlowMetadata(ctx, metaType, engineCtx.AppID, lang, nil)
+		if metaErr != nil {
+			return nil, metaErr
+		}
+		execCtx.Metadata = metadata
+	}
+
+	logger.Debug("Flow execution context resolved successfully")
+	return execCtx, nil
+}
+
+// populateCurrentStep sets the inputs and actions of the current step from the prompt node the
+// flow is waiting on. Inputs already provided and actions leading to authentication methods not
+// allowed for the application are left out.
+func populateCurrentStep(engineCtx *EngineContext, execCtx *FlowExecutionContext) {
+	if engineCtx.CurrentNode == nil {
+		return
+	}
+	execCtx.StepID = engineCtx.CurrentNode.GetID()
+
+	promptNode, ok := engineCtx.CurrentNode.(core.PromptNodeInterface)
+	if !ok {
+		return
+	}
+	execCtx.Meta = promptNode.GetMeta()
+
+	disallowed := getDisallowedActions(engineCtx.Application.AllowedAuthMethods, engineCtx.Graph,
+		engineCtx.CurrentNode)
+	var allowedOptions []string
+	if promptNode.GetVariant() == common.NodeVariantLoginOptions {
+		allowedOptions = strings.Fields(engineCtx.RuntimeData[common.RuntimeKeyAllowedLoginOptions])
+	}
+
+	seen := make(map[string]struct{})
+	for _, prompt := range promptNode.GetPrompts() {
+		for _, input := range prompt.Inputs {
+			if _, exists := seen[input.Identifier]; exists {
+				continue
+			}
+			seen[input.Identifier] = struct{}{}
+			if _, provided := engineCtx.UserInputs[input.Identifier]; !provided {
+				execCtx.Inputs = append(execCtx.Inputs, input)
+			}
+		}
+		if prompt.Action == nil || slices.Contains(disallowed, prompt.Action.Ref) {
+			continue
+		}
+		if len(allowedOptions) > 0 && !slices.Contains(allowedOptions, prompt.Action.Ref) {
+			continue
+		}
+		execCtx.Actions = append(execCtx.Actions, *prompt.Action)
+	}
+}
@@ -0,0 +1,204 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package flowexec
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/entityprovider"
+	"github.com/thunder-id/thunderid/internal/flow/common"
+	"github.com/thunder-id/thunderid/internal/flow/core"
+	"github.com/thunder-id/thunderid/internal/flow/executor"
+	"github.com/thunder-id/thunderid/internal/flow/flowmeta"
+	inboundmodel "github.com/thunder-id/thunderid/internal/inboundclient/model"
+	"github.com/thunder-id/thunderid/internal/system/cache"
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/tests/mocks/entityprovidermock"
+	"github.com/thunder-id/thunderid/tests/mocks/flow/coremock"
+	"github.com/thunder-id/thunderid/tests/mocks/flow/flowmetamock"
+	"github.com/thunder-id/thunderid/tests/mocks/flow/flowmgtmock"
+	"github.com/thunder-id/thunderid/tests/mocks/inboundclientmock"
+)
+
+type ExecutionContextTestSuite struct {
+	suite.Suite
+}
+
+func TestExecutionContextTestSuite(t *testing.T) {
+	suite.Run(t, new(ExecutionContextTestSuite))
+}
+
+func (s *ExecutionContextTestSuite) SetupTest() {
+	config.ResetServerRuntime()
+	s.Require().NoError(config.InitializeServerRuntime("test", &config.Config{}))
+}
+
+func (s *ExecutionContextTestSuite) TearDownTest() {
+	config.ResetServerRuntime()
+}
+
+func (s *ExecutionContextTestSuite) newPromptNode(variant common.NodeVariant,
+	prompts []common.Prompt) *coremock.PromptNodeInterfaceMock {
+	node := coremock.NewPromptNodeInterfaceMock(s.T())
+	node.On("GetID").Return("prompt_credentials").Maybe()
+	node.On("GetMeta").Return(map[string]interface{}{"components": []interface{}{}}).Maybe()
+	node.On("GetVariant").Return(variant).Maybe()
+	node.On("GetPrompts").Return(prompts).Maybe()
+	return node
+}
+
+func (s *ExecutionContextTestSuite) newAuthGraph() *coremock.GraphInterfaceMock {
+	basic := coremock.NewExecutorBackedNodeInterfaceMock(s.T())
+	basic.On("GetType").Return(common.NodeTypeTaskExecution).Maybe()
+	basic.On("GetExecutorName").Return(executor.ExecutorNameBasicAuth).Maybe()
+	google := coremock.NewExecutorBackedNodeInterfaceMock(s.T())
+	google.On("GetType").Return(common.NodeTypeTaskExecution).Maybe()
+	google.On("GetExecutorName").Return(executor.ExecutorNameGoogleAuth).Maybe()
+	google.On("GetProperties").Return(map[string]interface{}{"idpId": "idp-1"}).Maybe()
+
+	graph := coremock.NewGraphInterfaceMock(s.T())
+	graph.On("GetNode", "basic_auth").Return(core.NodeInterface(basic), true).Maybe()
+	graph.On("GetNode", "google_auth").Return(core.NodeInterface(google), true).Maybe()
+	return graph
+}
+
+func (s *ExecutionContextTestSuite) TestPopulateCurrentStep_FiltersProvidedInputsAndDisallowedActions() {
+	prompts := []common.Prompt{
+		{
+			Inputs: []common.Input{
+				{Identifier: "username", Type: "TEXT_INPUT", Required: true},
+				{Identifier: "password", Type: "PASSWORD_INPUT", Required: true},
+			},
+			Action: &common.Action{Ref: "basic", NextNode: "basic_auth"},
+		},
+		{Action: &common.Action{Ref: "google", NextNode: "google_auth"}},
+	}
+	engineCtx := &EngineContext{
+		CurrentNode: s.newPromptNode(common.NodeVariant(""), prompts),
+		Graph:       s.newAuthGraph(),
+		UserInputs:  map[string]string{"username": "alice"},
+		RuntimeData: map[string]string{},
+	}
+	engineCtx.Application.AllowedAuthMethods = &inboundmodel.AuthMethodsConfig{FederatedIDPs: []string{"idp-1"}}
+	execCtx := &FlowExecutionContext{Inputs: []common.Input{}, Actions: []common.Action{}}
+
+	populateCurrentStep(engineCtx, execCtx)
+
+	s.Equal("prompt_credentials", execCtx.StepID)
+	s.Equal([]common.Input{{Identifier: "password", Type: "PASSWORD_INPUT", Required: true}}, execCtx.Inputs)
+	s.Equal([]common.Action{{Ref: "google", NextNode: "google_auth"}}, execCtx.Actions)
+	s.NotNil(execCtx.Meta)
+}
+
+func (s *ExecutionContextTestSuite) TestPopulateCurrentStep_RestrictsLoginOptions() {
+	prompts := []common.Prompt{
+		{Action: &common.Action{Ref: "basic", NextNode: "basic_auth"}},
+		{Action: &common.Action{Ref: "google", NextNode: "google_auth"}},
+	}
+	engineCtx := &EngineContext{
+		CurrentNode: s.newPromptNode(common.NodeVariantLoginOptions, prompts),
+		Graph:       s.newAuthGraph(),
+		UserInputs:  map[string]string{},
+		RuntimeData: map[string]string{common.RuntimeKeyAllowedLoginOptions: "basic"},
+	}
+	execCtx := &FlowExecutionContext{Inputs: []common.Input{}, Actions: []common.Action{}}
+
+	populateCurrentStep(engineCtx, execCtx)
+
+	s.Equal([]common.Action{{Ref: "basic", NextNode: "basic_auth"}}, execCtx.Actions)
+}
+
+func (s *ExecutionContextTestSuite) TestPopulateCurrentStep_NonPromptNode() {
+	node := coremock.NewNodeInterfaceMock(s.T())
+	node.On("GetID").Return("task_node")
+	execCtx := &FlowExecutionContext{Inputs: []common.Input{}, Actions: []common.Action{}}
+
+	populateCurrentStep(&EngineContext{CurrentNode: node}, execCtx)
+
+	s.Equal("task_node", execCtx.StepID)
+	s.Empty(execCtx.Inputs)
+	s.Empty(execCtx.Actions)
+	s.Nil(execCtx.Meta)
+}
+
+func (s *ExecutionContextTestSuite) TestGetExecutionContext_InvalidExecutionID() {
+	mockStore := newFlowStoreInterfaceMock(s.T())
+	mockStore.EXPECT().GetFlowContext(mock.Anything, "missing").Return(nil, nil)
+	service := &flowExecService{flowStore: mockStore}
+
+	execCtx, svcErr := service.GetExecutionContext(context.Background(), "missing", "")
+
+	s.Nil(execCtx)
+	s.Equal(ErrorInvalidExecutionID.Code, svcErr.Code)
+}
+
+func (s *ExecutionContextTestSuite) TestGetExecutionContext_Success() {
+	flowFactory, _ := core.Initialize(cache.Initialize())
+	testGraph := flowFactory.CreateGraph("test-graph-id", common.FlowTypeAuthentication)
+	storedCtx, err := FromEngineContext(EngineContext{
+		ExecutionID:      "exec-1",
+		AppID:            "test-app-id",
+		FlowType:         common.FlowTypeAuthentication,
+		UserInputs:       map[string]string{},
+		RuntimeData:      map[string]string{},
+		ExecutionHistory: map[string]*common.NodeExecutionRecord{},
+		Graph:            testGraph,
+	})
+	s.Require().NoError(err)
+
+	allowed := &inboundmodel.AuthMethodsConfig{Local: true}
+	mockStore := newFlowStoreInterfaceMock(s.T())
+	mockStore.EXPECT().GetFlowContext(mock.Anything, "exec-1").Return(storedCtx, nil)
+	mockFlowMgtSvc := flowmgtmock.NewFlowMgtServiceInterfaceMock(s.T())
+	mockFlowMgtSvc.EXPECT().GetGraph(mock.Anything, "test-graph-id").Return(testGraph, nil)
+	mockInboundClient := inboundclientmock.NewInboundClientServiceInterfaceMock(s.T())
+	mockInboundClient.EXPECT().GetInboundClientByEntityID(mock.Anything, "test-app-id").Return(
+		&inboundmodel.InboundClient{ID: "test-app-id", AllowedAuthMethods: allowed}, nil)
+	mockEntityProvider := entityprovidermock.NewEntityProviderInterfaceMock(s.T())
+	mockEntityProvider.EXPECT().GetEntity("test-app-id").Return(
+		&entityprovider.Entity{ID: "test-app-id", Category: entityprovider.EntityCategoryApp},
+		(*entityprovider.EntityProviderError)(nil))
+	metadata := &flowmeta.FlowMetadataResponse{I18n: flowmeta.I18nMetadata{Language: "fr"}}
+	mockFlowMeta := flowmetamock.NewFlowMetaServiceInterfaceMock(s.T())
+	mockFlowMeta.EXPECT().GetFlowMetadata(mock.Anything, flowmeta.MetaTypeAPP, "test-app-id",
+		mock.MatchedBy(func(lang *string) bool { return lang != nil && *lang == "fr" }),
+		(*string)(nil)).Return(metadata, nil)
+
+	service := &flowExecService{
+		flowStore:            mockStore,
+		flowMgtService:       mockFlowMgtSvc,
+		inboundClientService: mockInboundClient,
+		entityProvider:       mockEntityProvider,
+		flowMetaService:      mockFlowMeta,
+	}
+
+	execCtx, svcErr := service.GetExecutionContext(context.Background(), "exec-1", "fr")
+
+	s.Nil(svcErr)
+	s.Equal("exec-1", execCtx.ExecutionID)
+	s.Equal(string(common.FlowTypeAuthentication), execCtx.FlowType)
+	s.Equal(allowed, execCtx.AllowedAuthMethods)
+	s.Equal(metadata, execCtx.Metadata)
+	s.Empty(execCtx.Inputs)
+	s.Empty(execCtx.Actions)
+}
@@ -19,22 +19,47 @@
 package flowexec
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
 	"net/http"
+	"time"
 
+	"github.com/thunder-id/thunderid/internal/system/config"
 	"github.com/thunder-id/thunderid/internal/system/error/apierror"
 	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
 	"github.com/thunder-id/thunderid/internal/system/log"
 	sysutils "github.com/thunder-id/thunderid/internal/system/utils"
+	"github.com/thunder-id/thunderid/internal/trusteddevice"
+)
+
+const (
+	// eventNameState names the events that report a step of an ongoing execution.
+	eventNameState = "state"
+	// eventNameEnd names the event that reports the end of an execution.
+	eventNameEnd = "end"
+	// eventStreamWriteTimeout bounds each write to an execution event stream.
+	eventStreamWriteTimeout = 10 * time.Second
 )
 
 // FlowExecutionHandler handles flow execution requests.
 type flowExecutionHandler struct {
-	flowExecService FlowExecServiceInterface
+	flowExecService      FlowExecServiceInterface
+	flowSandboxService   FlowSandboxServiceInterface
+	trustedDeviceService trusteddevice.TrustedDeviceServiceInterface
+	eventStream          config.FlowEventStreamConfig
 }
 
-func newFlowExecutionHandler(flowExecService FlowExecServiceInterface) *flowExecutionHandler {
+func newFlowExecutionHandler(flowExecService FlowExecServiceInterface,
+	flowSandboxService FlowSandboxServiceInterface,
+	trustedDeviceService trusteddevice.TrustedDeviceServiceInterface,
+	eventStream config.FlowEventStreamConfig) *flowExecutionHandler {
 	return &flowExecutionHandler{
-		flowExecService: flowExecService,
+		flowExecService:      flowExecService,
+		flowSandboxService:   flowSandboxService,
+		trustedDeviceService: trustedDeviceService,
+		eventStream:          eventStream,
 	}
 }
 
@@ -57,15 +82,202 @@ func (h *flowExecutionHandler) HandleFlowExecutionRequest(w http.ResponseWriter,
 	inputs := sysutils.SanitizeStringMap(flowR.Inputs)
 	challengeToken := sysutils.SanitizeString(flowR.ChallengeToken)
 
+	// Expose the trusted device cookie and fingerprint of the browser to the flow executors.
+	ctx := trusteddevice.WithDeviceInfo(r.Context(), trusteddevice.DeviceInfoFromRequest(r))
+
 	flowStep, flowErr := h.flowExecService.Execute(
-		r.Context(), appID, executionID, flowTypeStr, verbose, action, inputs, challengeToken)
+		ctx, appID, executionID, flowTypeStr, verbose, action, inputs, challengeToken)
 
 	if flowErr != nil {
 		handleFlowError(w, flowErr)
 		return
 	}
 
-	flowResp := FlowResponse{
+	flowResp := toFlowResponse(flowStep)
+	h.setTrustedDeviceCookie(w, &flowResp)
+
+	sysutils.WriteSuccessResponse(w, http.StatusOK, flowResp)
+
+	logger.Debug("Flow execution request handled successfully",
+		log.String(log.LoggerKeyExecutionID, flowResp.ExecutionID))
+}
+
+// HandleFlowSandboxRequest handles the sandbox flow execution request.
+func (h *flowExecutionHandler) HandleFlowSandboxRequest(w http.ResponseWriter, r *http.Request) {
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, "FlowExecutionHandler"))
+
+	sandboxR, err := sysutils.DecodeJSONBody[SandboxRequest](r)
+	if err != nil {
+		sysutils.WriteErrorResponse(w, http.StatusBadRequest, APIErrorFlowRequestJSONDecodeError)
+		return
+	}
+
+	appID := sysutils.SanitizeString(sandboxR.ApplicationID)
+	executionID := sysutils.SanitizeString(sandboxR.ExecutionID)
+	flowTypeStr := sysutils.SanitizeString(sandboxR.FlowType)
+	action := sysutils.SanitizeString(sandboxR.Action)
+	inputs := sysutils.SanitizeStringMap(sandboxR.Inputs)
+
+	result, flowErr := h.flowSandboxService.Execute(r.Context(), appID, executionID, flowTypeStr, action, inputs)
+	if flowErr != nil {
+		handleFlowError(w, flowErr)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(w, http.StatusOK, result)
+
+	logger.Debug("Sandbox flow execution request handled successfully",
+		log.String(log.LoggerKeyExecutionID, result.ExecutionID))
+}
+
+// HandleGetExecutionContextRequest handles the request to retrieve the rendering context of a flow execution.
+func (h *flowExecutionHandler) HandleGetExecutionContextRequest(w http.ResponseWriter, r *http.Request) {
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, "FlowExecutionHandler"))
+
+	executionID := sysutils.SanitizeString(r.PathValue("id"))
+	language := sysutils.SanitizeString(r.URL.Query().Get("language"))
+
+	execCtx, svcErr := h.flowExecService.GetExecutionContext(r.Context(), executionID, language)
+	if svcErr != nil {
+		if svcErr.Code == ErrorInvalidExecutionID.Code {
+			sysutils.WriteErrorResponse(w, http.StatusNotFound, apierror.ErrorResponse{
+				Code:        svcErr.Code,
+				Message:     svcErr.Error,
+				Description: svcErr.ErrorDescription,
+			})
+			return
+		}
+		handleFlowError(w, svcErr)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(w, http.StatusOK, execCtx)
+
+	logger.Debug("Flow execution context request handled successfully",
+		log.String(log.LoggerKeyExecutionID, executionID))
+}
+
+// HandleExecutionEventsRequest streams the state transitions of a flow execution as server-sent events, so
+// that the client that started a cross-device flow does not have to poll. The client authenticates with the
+// challenge token of the current step. The stream ends after the event that reports the end of the
+// execution, or after the configured maximum duration.
+func (h *flowExecutionHandler) HandleExecutionEventsRequest(w http.ResponseWriter, r *http.Request) {
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, "FlowExecutionHandler"))
+
+	executionID := sysutils.SanitizeString(r.PathValue("id"))
+	challengeToken := sysutils.SanitizeString(r.URL.Query().Get("challengeToken"))
+
+	ctx, cancel := r.Context(), func() {}
+	if h.eventStream.MaxDuration > 0 {
+		ctx, cancel = context.WithTimeout(ctx, time.Duration(h.eventStream.MaxDuration)*time.Second)
+	}
+	defer cancel()
+
+	events, svcErr := h.flowExecService.SubscribeExecutionState(ctx, executionID, challengeToken)
+	if svcErr != nil {
+		if svcErr.Code == ErrorInvalidExecutionID.Code {
+			sysutils.WriteErrorResponse(w, http.StatusNotFound, apierror.ErrorResponse{
+				Code:        svcErr.Code,
+				Message:     svcErr.Error,
+				Description: svcErr.ErrorDescription,
+			})
+			return
+		}
+		handleFlowError(w, svcErr)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+	rc := http.NewResponseController(w)
+	if err := flushEventStream(rc, nil); err != nil {
+		logger.Debug("Failed to open the execution event stream", log.Error(err))
+		return
+	}
+
+	heartbeat := time.NewTicker(time.Duration(max(h.eventStream.HeartbeatInterval, 1)) * time.Second)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-heartbeat.C:
+			if err := flushEventStream(rc, func() error {
+				_, err := fmt.Fprint(w, ": heartbeat\n\n")
+				return err
+			}); err != nil {
+				return
+			}
+		case event, ok := <-events:
+			if !ok {
+				return
+			}
+			if err := flushEventStream(rc, func() error {
+				return writeStateEvent(w, event)
+			}); err != nil {
+				logger.Debug("Failed to write to the execution event stream",
+					log.String(log.LoggerKeyExecutionID, executionID), log.Error(err))
+				return
+			}
+			if event.Final {
+				logger.Debug("Execution event stream closed as the execution ended",
+					log.String(log.LoggerKeyExecutionID, executionID))
+				return
+			}
+		}
+	}
+}
+
+// flushEventStream writes to an event stream and flushes it. The write deadline of the server is extended
+// before every write, since the stream outlives it.
+func flushEventStream(rc *http.ResponseController, write func() error) error {
+	if err := rc.SetWriteDeadline(time.Now().Add(eventStreamWriteTimeout)); err != nil &&
+		!errors.Is(err, http.ErrNotSupported) {
+		return err
+	}
+	if write != nil {
+		if err := write(); err != nil {
+			return err
+		}
+	}
+	return rc.Flush()
+}
+
+// writeStateEvent writes a flow state event in the server-sent events format. Final events are named
+// "end" and the others "state".
+func writeStateEvent(w http.ResponseWriter, event FlowStateEvent) error {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	name := eventNameState
+	if event.Final {
+		name = eventNameEnd
+	}
+	_, err = fmt.Fprintf(w, "event: %s\ndata: %s\n\n", name, data)
+	return err
+}
+
+// setTrustedDeviceCookie moves a trusted device token issued during the flow from the response body to
+// an HTTP-only cookie, so that it is not exposed to scripts in the browser.
+func (h *flowExecutionHandler) setTrustedDeviceCookie(w http.ResponseWriter, flowResp *FlowResponse) {
+	token, ok := flowResp.Data.AdditionalData[trusteddevice.AdditionalDataKeyDeviceToken]
+	if !ok {
+		return
+	}
+	delete(flowResp.Data.AdditionalData, trusteddevice.AdditionalDataKeyDeviceToken)
+
+	if token != "" && h.trustedDeviceService != nil {
+		http.SetCookie(w, trusteddevice.NewDeviceCookie(token, h.trustedDeviceService.GetValidityPeriod()))
+	}
+}
+
+// toFlowResponse converts a flow step into the flow execution API response body.
+func toFlowResponse(flowStep *FlowStep) FlowResponse {
+	return FlowResponse{
 		ExecutionID:    flowStep.ExecutionID,
 		StepID:         flowStep.StepID,
 		FlowStatus:     string(flowStep.Status),
@@ -75,11 +287,6 @@ func (h *flowExecutionHandler) HandleFlowExecutionRequest(w http.ResponseWriter,
 		FailureReason:  flowStep.FailureReason,
 		ChallengeToken: flowStep.ChallengeToken,
 	}
-
-	sysutils.WriteSuccessResponse(w, http.StatusOK, flowResp)
-
-	logger.Debug("Flow execution request handled successfully",
-		log.String(log.LoggerKeyExecutionID, flowResp.ExecutionID))
 }
 
 // handleFlowError handles errors that occur during flow execution as an API error response.
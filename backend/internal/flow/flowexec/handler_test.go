@@ -0,0 +1,178 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package flowexec
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/thunder-id/thunderid/internal/flow/common"
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/trusteddevice"
+	"github.com/thunder-id/thunderid/tests/mocks/trusteddevicemock"
+)
+
+func TestHandleFlowExecutionRequest_SetsTrustedDeviceCookie(t *testing.T) {
+	mockService := NewFlowExecServiceInterfaceMock(t)
+	mockTrustedDevice := trusteddevicemock.NewTrustedDeviceServiceInterfaceMock(t)
+	mockTrustedDevice.On("GetValidityPeriod").Return(int64(3600)).Once()
+
+	var receivedInfo trusteddevice.DeviceInfo
+	mockService.On("Execute", mock.Anything, "app-1", "", "AUTHENTICATION", false, "", mock.Anything, "").
+		Run(func(args mock.Arguments) {
+			receivedInfo = trusteddevice.GetDeviceInfo(args.Get(0).(context.Context))
+		}).
+		Return(&FlowStep{
+			ExecutionID: "exec-1",
+			Status:      common.FlowStatusComplete,
+			Data: FlowData{AdditionalData: map[string]string{
+				trusteddevice.AdditionalDataKeyDeviceToken: "new-device-token",
+			}},
+		}, nil).Once()
+
+	handler := newFlowExecutionHandler(mockService, nil, mockTrustedDevice, config.FlowEventStreamConfig{})
+	req := httptest.NewRequest(http.MethodPost, "/flow/execute",
+		strings.NewReader(`{"applicationId":"app-1","flowType":"AUTHENTICATION"}`))
+	req.AddCookie(&http.Cookie{Name: trusteddevice.CookieName, Value: "old-device-token"})
+	rr := httptest.NewRecorder()
+	handler.HandleFlowExecutionRequest(rr, req)
+
+	require.Equal(t, http.StatusOK, rr.Code)
+	require.Equal(t, "old-device-token", receivedInfo.Token)
+	require.NotEmpty(t, receivedInfo.Fingerprint)
+	require.NotContains(t, rr.Body.String(), "new-device-token")
+
+	cookies := rr.Result().Cookies()
+	require.Len(t, cookies, 1)
+	require.Equal(t, trusteddevice.CookieName, cookies[0].Name)
+	require.Equal(t, "new-device-token", cookies[0].Value)
+	require.Equal(t, 3600, cookies[0].MaxAge)
+	require.True(t, cookies[0].HttpOnly)
+}
+
+func TestHandleGetExecutionContextRequest_Success(t *testing.T) {
+	mockService := NewFlowExecServiceInterfaceMock(t)
+	mockService.On("GetExecutionContext", mock.Anything, "exec-1", "fr").Return(&FlowExecutionContext{
+		ExecutionID: "exec-1",
+		FlowType:    string(common.FlowTypeAuthentication),
+		Inputs:      []common.Input{{Identifier: "username", Type: "TEXT_INPUT", Required: true}},
+		Actions:     []common.Action{},
+	}, nil).Once()
+
+	handler := newFlowExecutionHandler(mockService, nil, nil, config.FlowEventStreamConfig{})
+	req := httptest.NewRequest(http.MethodGet, "/flow-executions/exec-1/context?language=fr", nil)
+	req.SetPathValue("id", "exec-1")
+	rr := httptest.NewRecorder()
+
+	handler.HandleGetExecutionContextRequest(rr, req)
+
+	require.Equal(t, http.StatusOK, rr.Code)
+	require.Contains(t, rr.Body.String(), `"executionId":"exec-1"`)
+	require.Contains(t, rr.Body.String(), `"identifier":"username"`)
+}
+
+func TestHandleGetExecutionContextRequest_NotFound(t *testing.T) {
+	mockService := NewFlowExecServiceInterfaceMock(t)
+	mockService.On("GetExecutionContext", mock.Anything, "missing", "").
+		Return(nil, &ErrorInvalidExecutionID).Once()
+
+	handler := newFlowExecutionHandler(mockService, nil, nil, config.FlowEventStreamConfig{})
+	req := httptest.NewRequest(http.MethodGet, "/flow-executions/missing/context", nil)
+	req.SetPathValue("id", "missing")
+	rr := httptest.NewRecorder()
+
+	handler.HandleGetExecutionContextRequest(rr, req)
+
+	require.Equal(t, http.StatusNotFound, rr.Code)
+	require.Contains(t, rr.Body.String(), ErrorInvalidExecutionID.Code)
+}
+
+func TestHandleExecutionEventsRequest_StreamsEvents(t *testing.T) {
+	events := make(chan FlowStateEvent, 2)
+	events <- FlowStateEvent{ExecutionID: "exec-1", FlowStatus: "INCOMPLETE", StepID: "node-1"}
+	events <- FlowStateEvent{ExecutionID: "exec-1", FlowStatus: "COMPLETE", Final: true}
+	mockService := NewFlowExecServiceInterfaceMock(t)
+	mockService.On("SubscribeExecutionState", mock.Anything, "exec-1", "token-1").
+		Return((<-chan FlowStateEvent)(events), nil).Once()
+
+	handler := newFlowExecutionHandler(mockService, nil, nil,
+		config.FlowEventStreamConfig{Enabled: true, HeartbeatInterval: 15, MaxDuration: 60})
+	req := httptest.NewRequest(http.MethodGet, "/flow-executions/exec-1/events?challengeToken=token-1", nil)
+	req.SetPathValue("id", "exec-1")
+	rr := httptest.NewRecorder()
+
+	handler.HandleExecutionEventsRequest(rr, req)
+
+	require.Equal(t, http.StatusOK, rr.Code)
+	require.Equal(t, "text/event-stream", rr.Header().Get("Content-Type"))
+	require.True(t, rr.Flushed)
+	require.Equal(t,
+		"event: state\ndata: {\"executionId\":\"exec-1\",\"flowStatus\":\"INCOMPLETE\",\"stepId\":\"node-1\"}\n\n"+
+			"event: end\ndata: {\"executionId\":\"exec-1\",\"flowStatus\":\"COMPLETE\"}\n\n",
+		rr.Body.String())
+}
+
+func TestHandleExecutionEventsRequest_SendsHeartbeats(t *testing.T) {
+	mockService := NewFlowExecServiceInterfaceMock(t)
+	mockService.On("SubscribeExecutionState", mock.Anything, "exec-1", "token-1").
+		Return((<-chan FlowStateEvent)(make(chan FlowStateEvent)), nil).Once()
+
+	handler := newFlowExecutionHandler(mockService, nil, nil,
+		config.FlowEventStreamConfig{Enabled: true, HeartbeatInterval: 1})
+	ctx, cancel := context.WithTimeout(context.Background(), 1500*time.Millisecond)
+	defer cancel()
+	req := httptest.NewRequest(http.MethodGet, "/flow-executions/exec-1/events?challengeToken=token-1", nil).
+		WithContext(ctx)
+	req.SetPathValue("id", "exec-1")
+	rr := httptest.NewRecorder()
+
+	handler.HandleExecutionEventsRequest(rr, req)
+
+	require.Equal(t, http.StatusOK, rr.Code)
+	require.Equal(t, ": heartbeat\n\n", rr.Body.String())
+}
+
+func TestHandleExecutionEventsRequest_Errors(t *testing.T) {
+	mockService := NewFlowExecServiceInterfaceMock(t)
+	mockService.On("SubscribeExecutionState", mock.Anything, "missing", "").
+		Return(nil, &ErrorInvalidExecutionID).Once()
+	mockService.On("SubscribeExecutionState", mock.Anything, "exec-1", "stale").
+		Return(nil, &ErrorInvalidChallengeToken).Once()
+	handler := newFlowExecutionHandler(mockService, nil, nil, config.FlowEventStreamConfig{Enabled: true})
+
+	req := httptest.NewRequest(http.MethodGet, "/flow-executions/missing/events", nil)
+	req.SetPathValue("id", "missing")
+	rr := httptest.NewRecorder()
+	handler.HandleExecutionEventsRequest(rr, req)
+	require.Equal(t, http.StatusNotFound, rr.Code)
+
+	req = httptest.NewRequest(http.MethodGet, "/flow-executions/exec-1/events?challengeToken=stale", nil)
+	req.SetPathValue("id", "exec-1")
+	rr = httptest.NewRecorder()
+	handler.HandleExecutionEventsRequest(rr, req)
+	require.Equal(t, http.StatusBadRequest, rr.Code)
+	require.Contains(t, rr.Body.String(), ErrorInvalidChallengeToken.Code)
+}
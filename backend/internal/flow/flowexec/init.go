@@ -20,17 +20,23 @@ package flowexec
 
 import (
 	"net/http"
+	"time"
 
 	"github.com/thunder-id/thunderid/internal/entityprovider"
 	"github.com/thunder-id/thunderid/internal/flow/executor"
+	"github.com/thunder-id/thunderid/internal/flow/flowmeta"
 	flowmgt "github.com/thunder-id/thunderid/internal/flow/mgt"
 	"github.com/thunder-id/thunderid/internal/inboundclient"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/tokenstore"
+	"github.com/thunder-id/thunderid/internal/securitynotification"
 	"github.com/thunder-id/thunderid/internal/system/config"
 	dbprovider "github.com/thunder-id/thunderid/internal/system/database/provider"
 	"github.com/thunder-id/thunderid/internal/system/kmprovider"
+	"github.com/thunder-id/thunderid/internal/system/log"
 	"github.com/thunder-id/thunderid/internal/system/middleware"
 	"github.com/thunder-id/thunderid/internal/system/observability"
 	"github.com/thunder-id/thunderid/internal/system/transaction"
+	"github.com/thunder-id/thunderid/internal/trusteddevice"
 )
 
 // Initialize creates and configures the flow execution service components.
@@ -43,6 +49,9 @@ func Initialize(
 	executorRegistry executor.ExecutorRegistryInterface,
 	observabilitySvc observability.ObservabilityServiceInterface,
 	cryptoSvc kmprovider.RuntimeCryptoProvider,
+	trustedDeviceService trusteddevice.TrustedDeviceServiceInterface,
+	flowMetaService flowmeta.FlowMetaServiceInterface,
+	securityNotifier securitynotification.SecurityNotificationServiceInterface,
 ) (FlowExecServiceInterface, error) {
 	var flowStore flowStoreInterface
 	var transactioner transaction.Transactioner
@@ -59,20 +68,41 @@ func Initialize(
 		}
 		flowStore = newFlowStore(dbProvider)
 	}
+	// Flow executions leave the runtime store only when a token store is configured for them.
+	if storeType := tokenstore.ResolveStoreType(tokenstore.ArtifactTypeFlowExecution); storeType != "" {
+		tokenFlowStore := newTokenFlowStore(tokenstore.Initialize(tokenstore.ArtifactTypeFlowExecution))
+		migrate := config.GetServerRuntime().Config.OAuth.TokenStore.FlowExecutionMigration
+		log.GetLogger().With(log.String(log.LoggerKeyComponentName, "FlowExecService")).Info(
+			"Keeping flow executions in the token store", log.String("store", storeType),
+			log.Bool("migrating", migrate))
+		if migrate {
+			flowStore = newMigratingFlowStore(tokenFlowStore, flowStore)
+		} else {
+			flowStore = tokenFlowStore
+		}
+	}
+	eventStream := config.GetServerRuntime().Config.Flow.EventStream
 	flowEngine := newFlowEngine(executorRegistry, observabilitySvc)
 	flowExecService := newFlowExecService(flowMgtService, flowStore, flowEngine,
-		inboundClientService, entityProvider, observabilitySvc, transactioner, cryptoSvc)
+		inboundClientService, entityProvider, observabilitySvc, transactioner, cryptoSvc, flowMetaService,
+		securityNotifier, newFlowStateBroker(), time.Duration(eventStream.PollInterval)*time.Second)
+
+	// Sandbox executions use a dedicated engine without observability so previews stay out of analytics.
+	sandboxExecService := newSandboxFlowExecService(flowMgtService, newFlowEngine(executorRegistry, nil),
+		inboundClientService, entityProvider, cryptoSvc)
+	flowSandboxService := newFlowSandboxService(sandboxExecService)
 
-	handler := newFlowExecutionHandler(flowExecService)
-	registerRoutes(mux, handler)
+	handler := newFlowExecutionHandler(flowExecService, flowSandboxService, trustedDeviceService, eventStream)
+	registerRoutes(mux, handler, eventStream.Enabled)
 
 	return flowExecService, nil
 }
 
-func registerRoutes(mux *http.ServeMux, handler *flowExecutionHandler) {
+func registerRoutes(mux *http.ServeMux, handler *flowExecutionHandler, eventStreamEnabled bool) {
 	opts := middleware.CORSOptions{
-		AllowedMethods:   []string{"POST"},
-		AllowedHeaders:   middleware.DefaultAllowedHeaders,
+		AllowedMethods: []string{"POST"},
+		AllowedHeaders: append(append([]string{}, middleware.DefaultAllowedHeaders...),
+			trusteddevice.HeaderDeviceFingerprint),
 		AllowCredentials: true,
 		MaxAge:           600,
 	}
@@ -82,4 +112,35 @@ func registerRoutes(mux *http.ServeMux, handler *flowExecutionHandler) {
 		func(w http.ResponseWriter, r *http.Request) {
 			w.WriteHeader(http.StatusNoContent)
 		}, opts))
+	mux.HandleFunc(middleware.WithCORS("POST /flow/sandbox/execute",
+		middleware.CorrelationIDMiddleware(http.HandlerFunc(handler.HandleFlowSandboxRequest)).ServeHTTP, opts))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS /flow/sandbox/execute",
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, opts))
+
+	contextOpts := middleware.CORSOptions{
+		AllowedMethods:   []string{"GET"},
+		AllowedHeaders:   middleware.DefaultAllowedHeaders,
+		AllowCredentials: true,
+		MaxAge:           600,
+	}
+	mux.HandleFunc(middleware.WithCORS("GET /flow-executions/{id}/context",
+		middleware.CorrelationIDMiddleware(http.HandlerFunc(handler.HandleGetExecutionContextRequest)).ServeHTTP,
+		contextOpts))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS /flow-executions/{id}/context",
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, contextOpts))
+
+	if !eventStreamEnabled {
+		return
+	}
+	mux.HandleFunc(middleware.WithCORS("GET /flow-executions/{id}/events",
+		middleware.CorrelationIDMiddleware(http.HandlerFunc(handler.HandleExecutionEventsRequest)).ServeHTTP,
+		contextOpts))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS /flow-executions/{id}/events",
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, contextOpts))
 }
@@ -0,0 +1,99 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package flowexec
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// inMemoryFlowStore is a process-local flowStoreInterface implementation used for sandbox executions.
+// Flow contexts stored here never reach the runtime database and are discarded once they expire.
+type inMemoryFlowStore struct {
+	mu       sync.Mutex
+	contexts map[string]FlowContextDB
+}
+
+// newInMemoryFlowStore creates a new empty in-memory flow store.
+func newInMemoryFlowStore() flowStoreInterface {
+	return &inMemoryFlowStore{
+		contexts: make(map[string]FlowContextDB),
+	}
+}
+
+// StoreFlowContext stores the flow context in memory.
+func (s *inMemoryFlowStore) StoreFlowContext(_ context.Context, dbModel FlowContextDB, expirySeconds int64) error {
+	now := time.Now().UTC()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.removeExpired(now)
+	dbModel.ExpiryTime = now.Add(time.Duration(expirySeconds) * time.Second)
+	dbModel.CreatedAt = now
+	dbModel.UpdatedAt = now
+	s.contexts[dbModel.ExecutionID] = dbModel
+	return nil
+}
+
+// GetFlowContext retrieves an unexpired flow context from memory.
+func (s *inMemoryFlowStore) GetFlowContext(_ context.Context, executionID string) (*FlowContextDB, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	dbModel, ok := s.contexts[executionID]
+	if !ok || !dbModel.ExpiryTime.After(time.Now().UTC()) {
+		return nil, nil
+	}
+	return &dbModel, nil
+}
+
+// UpdateFlowContext updates the serialized context of an existing flow context in memory.
+func (s *inMemoryFlowStore) UpdateFlowContext(_ context.Context, dbModel FlowContextDB) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	existing, ok := s.contexts[dbModel.ExecutionID]
+	if !ok {
+		return nil
+	}
+	existing.Context = dbModel.Context
+	existing.UpdatedAt = time.Now().UTC()
+	s.contexts[dbModel.ExecutionID] = existing
+	return nil
+}
+
+// DeleteFlowContext removes the flow context from memory.
+func (s *inMemoryFlowStore) DeleteFlowContext(_ context.Context, executionID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.contexts, executionID)
+	return nil
+}
+
+// removeExpired drops expired flow contexts. The caller must hold the lock.
+func (s *inMemoryFlowStore) removeExpired(now time.Time) {
+	for executionID, dbModel := range s.contexts {
+		if !dbModel.ExpiryTime.After(now) {
+			delete(s.contexts, executionID)
+		}
+	}
+}
@@ -31,6 +31,9 @@ import (
 	managerpkg "github.com/thunder-id/thunderid/internal/authnprovider/manager"
 	"github.com/thunder-id/thunderid/internal/flow/common"
 	"github.com/thunder-id/thunderid/internal/flow/core"
+	"github.com/thunder-id/thunderid/internal/flow/flowmeta"
+	inboundmodel "github.com/thunder-id/thunderid/internal/inboundclient/model"
+	notifcm "github.com/thunder-id/thunderid/internal/notification/common"
 )
 
 // EngineContext holds the overall context used by the flow engine during execution.
@@ -46,6 +49,7 @@ type EngineContext struct {
 	ForwardedData  map[string]interface{}
 	AdditionalData map[string]string
 	TraceID        string
+	Sandbox        bool
 
 	CurrentNode         core.NodeInterface
 	CurrentNodeResponse *common.NodeResponse
@@ -97,6 +101,29 @@ type FlowResponse struct {
 	FailureReason  string   `json:"failureReason,omitempty"`
 }
 
+// FlowStateEvent represents a state transition of a flow execution pushed to the client that started it.
+type FlowStateEvent struct {
+	ExecutionID string `json:"executionId"`
+	FlowStatus  string `json:"flowStatus"`
+	StepID      string `json:"stepId,omitempty"`
+	Type        string `json:"type,omitempty"`
+	// Final reports whether the execution has ended. No events follow a final event.
+	Final bool `json:"-"`
+}
+
+// FlowExecutionContext represents the aggregated data required to render the current step of a
+// flow execution without further round trips.
+type FlowExecutionContext struct {
+	ExecutionID        string                          `json:"executionId"`
+	FlowType           string                          `json:"flowType"`
+	StepID             string                          `json:"stepId,omitempty"`
+	Inputs             []common.Input                  `json:"inputs"`
+	Actions            []common.Action                 `json:"actions"`
+	Meta               interface{}                     `json:"meta,omitempty"`
+	AllowedAuthMethods *inboundmodel.AuthMethodsConfig `json:"allowedAuthMethods,omitempty"`
+	Metadata           *flowmeta.FlowMetadataResponse  `json:"metadata"`
+}
+
 // FlowRequest represents the flow execution API request body
 type FlowRequest struct {
 	ApplicationID  string            `json:"applicationId"`
@@ -108,6 +135,32 @@ type FlowRequest struct {
 	Inputs         map[string]string `json:"inputs"`
 }
 
+// SandboxRequest represents the sandbox flow execution API request body
+type SandboxRequest struct {
+	ApplicationID string            `json:"applicationId"`
+	FlowType      string            `json:"flowType"`
+	ExecutionID   string            `json:"executionId"`
+	Action        string            `json:"action"`
+	Inputs        map[string]string `json:"inputs"`
+}
+
+// SandboxStep represents a single interaction recorded during a sandbox flow execution.
+// Only the names of the submitted inputs are recorded so that credentials are not echoed back.
+type SandboxStep struct {
+	Action        string                         `json:"action,omitempty"`
+	Inputs        []string                       `json:"inputs,omitempty"`
+	Response      FlowResponse                   `json:"response"`
+	Notifications []notifcm.CapturedNotification `json:"notifications,omitempty"`
+}
+
+// SandboxResult represents the outcome of a sandbox flow execution, including every interaction
+// recorded so far for the execution.
+type SandboxResult struct {
+	ExecutionID string        `json:"executionId"`
+	FlowStatus  string        `json:"flowStatus"`
+	Steps       []SandboxStep `json:"steps"`
+}
+
 // FlowInitContext represents the context for initiating a new flow with runtime data
 type FlowInitContext struct {
 	ApplicationID string
@@ -119,7 +119,7 @@ func (s *redisFlowStore) UpdateFlowContext(ctx context.Context, dbModel FlowCont
 		return fmt.Errorf("failed to update flow context in Redis: %w", err)
 	}
 	if n == 0 {
-		return fmt.Errorf("flow context not found for executionID: %s", dbModel.ExecutionID)
+		return fmt.Errorf("%w for executionID: %s", errFlowContextNotFound, dbModel.ExecutionID)
 	}
 
 	return nil
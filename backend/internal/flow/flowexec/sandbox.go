@@ -0,0 +1,178 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package flowexec
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/flow/common"
+	notifcm "github.com/thunder-id/thunderid/internal/notification/common"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/log"
+)
+
+// sandboxSessionValidity is the duration for which an idle sandbox execution is retained.
+const sandboxSessionValidity = 30 * time.Minute
+
+// FlowSandboxServiceInterface defines the interface for executing flows in sandbox mode. Sandbox
+// executions run against ephemeral in-memory state, capture notifications instead of sending them,
+// never persist users or mint assertions, and return the full step-by-step interaction.
+type FlowSandboxServiceInterface interface {
+	Execute(ctx context.Context, appID, executionID, flowType, action string,
+		inputs map[string]string) (*SandboxResult, *serviceerror.ServiceError)
+}
+
+// sandboxSession holds the interaction transcript of an ongoing sandbox execution.
+type sandboxSession struct {
+	steps          []SandboxStep
+	challengeToken string
+	expiryTime     time.Time
+}
+
+// flowSandboxService is the implementation of FlowSandboxServiceInterface.
+type flowSandboxService struct {
+	flowExecService FlowExecServiceInterface
+	mu              sync.Mutex
+	sessions        map[string]*sandboxSession
+}
+
+// newFlowSandboxService creates a new sandbox service backed by the given sandbox flow execution service.
+func newFlowSandboxService(flowExecService FlowExecServiceInterface) FlowSandboxServiceInterface {
+	return &flowSandboxService{
+		flowExecService: flowExecService,
+		sessions:        make(map[string]*sandboxSession),
+	}
+}
+
+// Execute executes a step of a flow in sandbox mode and returns the interaction transcript.
+func (s *flowSandboxService) Execute(ctx context.Context, appID, executionID, flowType, action string,
+	inputs map[string]string) (*SandboxResult, *serviceerror.ServiceError) {
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, "FlowSandboxService"))
+
+	challengeToken := ""
+	if !isNewFlow(executionID) {
+		session := s.getSession(executionID)
+		if session == nil {
+			return nil, &ErrorInvalidExecutionID
+		}
+		challengeToken = session.challengeToken
+	}
+
+	capture := notifcm.NewNotificationCapture()
+	ctx = notifcm.WithNotificationCapture(ctx, capture)
+
+	flowStep, svcErr := s.flowExecService.Execute(ctx, appID, executionID, flowType, true,
+		action, inputs, challengeToken)
+	if svcErr != nil {
+		if !isNewFlow(executionID) && svcErr.Code != ErrorInvalidChallengeToken.Code {
+			s.removeSession(executionID)
+		}
+		return nil, svcErr
+	}
+
+	flowResp := toFlowResponse(flowStep)
+	flowResp.ChallengeToken = ""
+	flowResp.Assertion = ""
+
+	step := SandboxStep{
+		Action:        action,
+		Inputs:        getInputNames(inputs),
+		Response:      flowResp,
+		Notifications: capture.Notifications(),
+	}
+	steps := s.recordStep(flowStep, step)
+
+	logger.Debug("Sandbox flow step executed", log.String(log.LoggerKeyExecutionID, flowStep.ExecutionID),
+		log.String("flowStatus", string(flowStep.Status)))
+
+	return &SandboxResult{
+		ExecutionID: flowStep.ExecutionID,
+		FlowStatus:  string(flowStep.Status),
+		Steps:       steps,
+	}, nil
+}
+
+// getSession returns the unexpired sandbox session for the given execution, or nil if none exists.
+func (s *flowSandboxService) getSession(executionID string) *sandboxSession {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	session, ok := s.sessions[executionID]
+	if !ok || !session.expiryTime.After(time.Now()) {
+		return nil
+	}
+	return session
+}
+
+// removeSession discards the sandbox session for the given execution.
+func (s *flowSandboxService) removeSession(executionID string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.sessions, executionID)
+}
+
+// recordStep appends the step to the session transcript and returns a copy of the full transcript.
+// The session is discarded once the flow completes.
+func (s *flowSandboxService) recordStep(flowStep *FlowStep, step SandboxStep) []SandboxStep {
+	now := time.Now()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for executionID, session := range s.sessions {
+		if !session.expiryTime.After(now) {
+			delete(s.sessions, executionID)
+		}
+	}
+
+	session, ok := s.sessions[flowStep.ExecutionID]
+	if !ok {
+		session = &sandboxSession{}
+	}
+	session.steps = append(session.steps, step)
+	session.challengeToken = flowStep.ChallengeToken
+	session.expiryTime = now.Add(sandboxSessionValidity)
+
+	if flowStep.Status == common.FlowStatusComplete {
+		delete(s.sessions, flowStep.ExecutionID)
+	} else {
+		s.sessions[flowStep.ExecutionID] = session
+	}
+
+	steps := make([]SandboxStep, len(session.steps))
+	copy(steps, session.steps)
+	return steps
+}
+
+// getInputNames returns the sorted names of the given inputs.
+func getInputNames(inputs map[string]string) []string {
+	if len(inputs) == 0 {
+		return nil
+	}
+	names := make([]string, 0, len(inputs))
+	for name := range inputs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
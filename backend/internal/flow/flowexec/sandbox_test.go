@@ -0,0 +1,163 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package flowexec
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/flow/common"
+	notifcm "github.com/thunder-id/thunderid/internal/notification/common"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+)
+
+type FlowSandboxServiceTestSuite struct {
+	suite.Suite
+	execServiceMock *FlowExecServiceInterfaceMock
+	service         *flowSandboxService
+}
+
+func TestFlowSandboxServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(FlowSandboxServiceTestSuite))
+}
+
+func (s *FlowSandboxServiceTestSuite) SetupTest() {
+	s.execServiceMock = NewFlowExecServiceInterfaceMock(s.T())
+	s.service = newFlowSandboxService(s.execServiceMock).(*flowSandboxService)
+}
+
+func (s *FlowSandboxServiceTestSuite) TestExecute_RecordsStepsAndNotifications() {
+	inputs := map[string]string{"username": "alice", "password": "secret"}
+	s.execServiceMock.On("Execute", mock.Anything, "app-1", "", "AUTHENTICATION", true, "", inputs, "").
+		Run(func(args mock.Arguments) {
+			capture := notifcm.GetNotificationCapture(args.Get(0).(context.Context))
+			s.Require().NotNil(capture)
+			capture.Capture(notifcm.CapturedNotification{
+				Channel:   notifcm.ChannelTypeSMS,
+				Recipient: "+94771234567",
+				Body:      "Your code is 123456",
+			})
+		}).
+		Return(&FlowStep{
+			ExecutionID:    "exec-1",
+			Status:         common.FlowStatusIncomplete,
+			Type:           common.StepTypeView,
+			ChallengeToken: "challenge-1",
+		}, nil).Once()
+
+	result, svcErr := s.service.Execute(context.Background(), "app-1", "", "AUTHENTICATION", "", inputs)
+
+	s.Nil(svcErr)
+	s.Require().NotNil(result)
+	s.Equal("exec-1", result.ExecutionID)
+	s.Equal(string(common.FlowStatusIncomplete), result.FlowStatus)
+	s.Require().Len(result.Steps, 1)
+	s.Equal([]string{"password", "username"}, result.Steps[0].Inputs)
+	s.Empty(result.Steps[0].Response.ChallengeToken)
+	s.Require().Len(result.Steps[0].Notifications, 1)
+	s.Equal("Your code is 123456", result.Steps[0].Notifications[0].Body)
+
+	s.execServiceMock.On("Execute", mock.Anything, "app-1", "exec-1", "AUTHENTICATION", true,
+		"submit", map[string]string{"otp": "123456"}, "challenge-1").
+		Return(&FlowStep{
+			ExecutionID: "exec-1",
+			Status:      common.FlowStatusComplete,
+			Assertion:   "assertion",
+		}, nil).Once()
+
+	result, svcErr = s.service.Execute(context.Background(), "app-1", "exec-1", "AUTHENTICATION", "submit",
+		map[string]string{"otp": "123456"})
+
+	s.Nil(svcErr)
+	s.Require().NotNil(result)
+	s.Equal(string(common.FlowStatusComplete), result.FlowStatus)
+	s.Require().Len(result.Steps, 2)
+	s.Equal("submit", result.Steps[1].Action)
+	s.Empty(result.Steps[1].Response.Assertion)
+	s.Empty(result.Steps[1].Notifications)
+	s.Nil(s.service.getSession("exec-1"))
+}
+
+func (s *FlowSandboxServiceTestSuite) TestExecute_UnknownExecution() {
+	result, svcErr := s.service.Execute(context.Background(), "app-1", "unknown", "AUTHENTICATION", "", nil)
+
+	s.Nil(result)
+	s.Require().NotNil(svcErr)
+	s.Equal(ErrorInvalidExecutionID.Code, svcErr.Code)
+}
+
+func (s *FlowSandboxServiceTestSuite) TestExecute_ErrorDiscardsSession() {
+	s.service.sessions["exec-1"] = &sandboxSession{
+		steps:          []SandboxStep{{}},
+		challengeToken: "challenge-1",
+		expiryTime:     time.Now().Add(time.Minute),
+	}
+	s.execServiceMock.On("Execute", mock.Anything, "app-1", "exec-1", "AUTHENTICATION", true, "", mock.Anything,
+		"challenge-1").Return(nil, &serviceerror.InternalServerError).Once()
+
+	result, svcErr := s.service.Execute(context.Background(), "app-1", "exec-1", "AUTHENTICATION", "", nil)
+
+	s.Nil(result)
+	s.Require().NotNil(svcErr)
+	s.Equal(serviceerror.InternalServerError.Code, svcErr.Code)
+	s.Nil(s.service.getSession("exec-1"))
+}
+
+func (s *FlowSandboxServiceTestSuite) TestExecute_ExpiredSession() {
+	s.service.sessions["exec-1"] = &sandboxSession{
+		expiryTime: time.Now().Add(-time.Minute),
+	}
+
+	result, svcErr := s.service.Execute(context.Background(), "app-1", "exec-1", "AUTHENTICATION", "", nil)
+
+	s.Nil(result)
+	s.Require().NotNil(svcErr)
+	s.Equal(ErrorInvalidExecutionID.Code, svcErr.Code)
+}
+
+func (s *FlowSandboxServiceTestSuite) TestInMemoryFlowStore() {
+	store := newInMemoryFlowStore()
+	ctx := context.Background()
+
+	s.NoError(store.StoreFlowContext(ctx, FlowContextDB{ExecutionID: "exec-1", Context: "v1"}, 60))
+	dbModel, err := store.GetFlowContext(ctx, "exec-1")
+	s.NoError(err)
+	s.Require().NotNil(dbModel)
+	s.Equal("v1", dbModel.Context)
+
+	s.NoError(store.UpdateFlowContext(ctx, FlowContextDB{ExecutionID: "exec-1", Context: "v2"}))
+	dbModel, err = store.GetFlowContext(ctx, "exec-1")
+	s.NoError(err)
+	s.Require().NotNil(dbModel)
+	s.Equal("v2", dbModel.Context)
+
+	s.NoError(store.DeleteFlowContext(ctx, "exec-1"))
+	dbModel, err = store.GetFlowContext(ctx, "exec-1")
+	s.NoError(err)
+	s.Nil(dbModel)
+
+	s.NoError(store.StoreFlowContext(ctx, FlowContextDB{ExecutionID: "exec-2", Context: "v1"}, 0))
+	dbModel, err = store.GetFlowContext(ctx, "exec-2")
+	s.NoError(err)
+	s.Nil(dbModel)
+}
@@ -24,13 +24,16 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"time"
 
 	appmodel "github.com/thunder-id/thunderid/internal/application/model"
 	"github.com/thunder-id/thunderid/internal/entityprovider"
 	"github.com/thunder-id/thunderid/internal/flow/common"
+	"github.com/thunder-id/thunderid/internal/flow/flowmeta"
 	flowmgt "github.com/thunder-id/thunderid/internal/flow/mgt"
 	"github.com/thunder-id/thunderid/internal/inboundclient"
 	inboundmodel "github.com/thunder-id/thunderid/internal/inboundclient/model"
+	"github.com/thunder-id/thunderid/internal/securitynotification"
 	"github.com/thunder-id/thunderid/internal/system/config"
 	sysContext "github.com/thunder-id/thunderid/internal/system/context"
 	"github.com/thunder-id/thunderid/internal/system/cryptolab"
@@ -41,6 +44,7 @@ import (
 	"github.com/thunder-id/thunderid/internal/system/observability/event"
 	"github.com/thunder-id/thunderid/internal/system/transaction"
 	sysutils "github.com/thunder-id/thunderid/internal/system/utils"
+	"github.com/thunder-id/thunderid/internal/trusteddevice"
 )
 
 // FlowExecServiceInterface defines the interface for flow orchestration and acts as the
@@ -49,6 +53,11 @@ type FlowExecServiceInterface interface {
 	Execute(ctx context.Context, appID, executionID, flowType string, verbose bool,
 		action string, inputs map[string]string, challengeToken string) (*FlowStep, *serviceerror.ServiceError)
 	InitiateFlow(ctx context.Context, initContext *FlowInitContext) (string, *serviceerror.ServiceError)
+	GetExecutionContext(ctx context.Context, executionID, language string) (
+		*FlowExecutionContext, *serviceerror.ServiceError)
+	SubscribeExecutionState(ctx context.Context, executionID, challengeToken string) (
+		<-chan FlowStateEvent, *serviceerror.ServiceError)
+	CloseExecutionStateStreams()
 }
 
 const (
@@ -68,6 +77,11 @@ type flowExecService struct {
 	observabilitySvc     observability.ObservabilityServiceInterface
 	transactioner        transaction.Transactioner
 	cryptoSvc            kmprovider.RuntimeCryptoProvider
+	flowMetaService      flowmeta.FlowMetaServiceInterface
+	securityNotifier     securitynotification.SecurityNotificationServiceInterface
+	stateBroker          *flowStateBroker
+	statePollInterval    time.Duration
+	sandbox              bool
 }
 
 func newFlowExecService(flowMgtService flowmgt.FlowMgtServiceInterface,
@@ -76,7 +90,10 @@ func newFlowExecService(flowMgtService flowmgt.FlowMgtServiceInterface,
 	entityProvider entityprovider.EntityProviderInterface,
 	observabilitySvc observability.ObservabilityServiceInterface,
 	transactioner transaction.Transactioner,
-	cryptoSvc kmprovider.RuntimeCryptoProvider) FlowExecServiceInterface {
+	cryptoSvc kmprovider.RuntimeCryptoProvider,
+	flowMetaService flowmeta.FlowMetaServiceInterface,
+	securityNotifier securitynotification.SecurityNotificationServiceInterface,
+	stateBroker *flowStateBroker, statePollInterval time.Duration) FlowExecServiceInterface {
 	return &flowExecService{
 		flowMgtService:       flowMgtService,
 		flowStore:            flowStore,
@@ -86,6 +103,28 @@ func newFlowExecService(flowMgtService flowmgt.FlowMgtServiceInterface,
 		observabilitySvc:     observabilitySvc,
 		transactioner:        transactioner,
 		cryptoSvc:            cryptoSvc,
+		flowMetaService:      flowMetaService,
+		securityNotifier:     securityNotifier,
+		stateBroker:          stateBroker,
+		statePollInterval:    statePollInterval,
+	}
+}
+
+// newSandboxFlowExecService creates a flow execution service that keeps flow contexts in memory and
+// marks every execution as a sandbox execution, so executors skip side effects.
+func newSandboxFlowExecService(flowMgtService flowmgt.FlowMgtServiceInterface, flowEngine flowEngineInterface,
+	inboundClientService inboundclient.InboundClientServiceInterface,
+	entityProvider entityprovider.EntityProviderInterface,
+	cryptoSvc kmprovider.RuntimeCryptoProvider) FlowExecServiceInterface {
+	return &flowExecService{
+		flowMgtService:       flowMgtService,
+		flowStore:            newInMemoryFlowStore(),
+		flowEngine:           flowEngine,
+		inboundClientService: inboundClientService,
+		entityProvider:       entityProvider,
+		transactioner:        transaction.NewNoOpTransactioner(),
+		cryptoSvc:            cryptoSvc,
+		sandbox:              true,
 	}
 }
 
@@ -110,12 +149,13 @@ func (s *flowExecService) Execute(ctx context.Context,
 				log.String("flowType", flowType),
 				log.String("error", loadErr.Error.DefaultValue))
 
-			if s.observabilitySvc.IsEnabled() {
+			if s.observabilitySvc != nil && s.observabilitySvc.IsEnabled() {
 				evt := event.NewEvent(
 					traceID,
 					string(event.EventTypeFlowFailed),
 					event.ComponentFlowEngine,
 				).
+					WithTenantID(sysContext.GetTenantID(ctx)).
 					WithStatus(event.StatusFailure).
 					WithData(event.DataKey.EntityID, appID).
 					WithData(event.DataKey.FlowType, flowType).
@@ -144,6 +184,7 @@ func (s *flowExecService) Execute(ctx context.Context,
 
 	// Set trace ID to engine context (request context is already set during context loading)
 	engineCtx.TraceID = traceID
+	engineCtx.Sandbox = s.sandbox
 
 	flowStep, flowErr := s.flowEngine.Execute(engineCtx)
 
@@ -154,6 +195,7 @@ func (s *flowExecService) Execute(ctx context.Context,
 					log.String(log.LoggerKeyExecutionID, engineCtx.ExecutionID), log.Error(removeErr))
 				return nil, &serviceerror.InternalServerError
 			}
+			s.publishExecutionState(engineCtx, nil, flowErr)
 		}
 		return nil, flowErr
 	}
@@ -166,6 +208,7 @@ func (s *flowExecService) Execute(ctx context.Context,
 				return nil, &serviceerror.InternalServerError
 			}
 		}
+		s.notifySignIn(ctx, engineCtx)
 	} else {
 		if isNewFlow(executionID) {
 			if storeErr := s.storeContext(ctx, engineCtx, logger); storeErr != nil {
@@ -182,9 +225,30 @@ func (s *flowExecService) Execute(ctx context.Context,
 		}
 	}
 
+	if !isNewFlow(executionID) {
+		s.publishExecutionState(engineCtx, &flowStep, nil)
+	}
 	return &flowStep, nil
 }
 
+// notifySignIn notifies the user of a completed authentication flow, so that the user learns of sign-ins
+// from devices they have not signed in from before.
+func (s *flowExecService) notifySignIn(ctx context.Context, engineCtx *EngineContext) {
+	if s.securityNotifier == nil || s.sandbox || engineCtx.FlowType != common.FlowTypeAuthentication ||
+		!engineCtx.AuthenticatedUser.IsAuthenticated {
+		return
+	}
+
+	device := trusteddevice.GetDeviceInfo(ctx)
+	s.securityNotifier.NotifySignIn(ctx, securitynotification.SignIn{
+		UserID:            engineCtx.AuthenticatedUser.UserID,
+		AppName:           engineCtx.Application.Name,
+		DeviceFingerprint: device.Fingerprint,
+		UserAgent:         device.Name,
+		IPAddress:         sysContext.GetClientIP(ctx),
+	})
+}
+
 // initContext initializes a new flow context with the given details.
 func (s *flowExecService) loadNewContext(ctx context.Context, appID, flowTypeStr string, verbose bool,
 	action string, inputs map[string]string, logger *log.Logger) (
@@ -330,7 +394,8 @@ func (s *flowExecService) setApplicationToContext(engineCtx *EngineContext,
 
 // buildFlowApplication assembles the minimal model.Application view that downstream executors
 // read from engineCtx.Application. Only fields actually consumed by executors are populated:
-// Name, AllowedUserTypes, Assertion, LoginConsent, Metadata, and InboundAuthConfig (ClientID).
+// Name, AllowedUserTypes, AllowedAuthMethods, UserIsolation, Assertion, LoginConsent, Metadata, and
+// InboundAuthConfig (ClientID).
 func (s *flowExecService) buildFlowApplication(
 	ctx context.Context, appID string, logger *log.Logger,
 ) (*appmodel.Application, *serviceerror.ServiceError) {
@@ -357,9 +422,11 @@ func (s *flowExecService) buildFlowApplication(
 	app := &appmodel.Application{
 		ID: client.ID,
 		InboundAuthProfile: inboundmodel.InboundAuthProfile{
-			Assertion:        client.Assertion,
-			LoginConsent:     client.LoginConsent,
-			AllowedUserTypes: client.AllowedUserTypes,
+			Assertion:          client.Assertion,
+			LoginConsent:       client.LoginConsent,
+			AllowedUserTypes:   client.AllowedUserTypes,
+			AllowedAuthMethods: client.AllowedAuthMethods,
+			UserIsolation:      client.UserIsolation,
 		},
 	}
 
@@ -639,6 +706,13 @@ func (s *flowExecService) InitiateFlow(ctx context.Context,
 		return "", err
 	}
 
+	// Reject flows that offer no authentication method allowed for the application
+	if !hasAllowedAuthMethod(engineCtx.Application.AllowedAuthMethods, engineCtx.Graph) {
+		logger.Debug("Flow offers no authentication method allowed for the application",
+			log.String("appID", initContext.ApplicationID))
+		return "", &ErrorAuthMethodNotAllowed
+	}
+
 	// Replace the RuntimeData with initContext RuntimeData
 	engineCtx.RuntimeData = initContext.RuntimeData
 
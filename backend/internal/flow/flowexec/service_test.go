@@ -33,18 +33,22 @@ import (
 	flowmgt "github.com/thunder-id/thunderid/internal/flow/mgt"
 	"github.com/thunder-id/thunderid/internal/inboundclient"
 	inboundmodel "github.com/thunder-id/thunderid/internal/inboundclient/model"
+	"github.com/thunder-id/thunderid/internal/securitynotification"
 	"github.com/thunder-id/thunderid/internal/system/cache"
 	"github.com/thunder-id/thunderid/internal/system/config"
+	sysContext "github.com/thunder-id/thunderid/internal/system/context"
 	"github.com/thunder-id/thunderid/internal/system/cryptolab"
 	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
 	i18ncore "github.com/thunder-id/thunderid/internal/system/i18n/core"
 	"github.com/thunder-id/thunderid/internal/system/kmprovider"
 	"github.com/thunder-id/thunderid/internal/system/kmprovider/defaultkm"
 	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/internal/trusteddevice"
 	"github.com/thunder-id/thunderid/tests/mocks/crypto/cryptomock"
 	"github.com/thunder-id/thunderid/tests/mocks/entityprovidermock"
 	"github.com/thunder-id/thunderid/tests/mocks/flow/flowmgtmock"
 	"github.com/thunder-id/thunderid/tests/mocks/inboundclientmock"
+	"github.com/thunder-id/thunderid/tests/mocks/securitynotificationmock"
 )
 
 // txMarkerKey is an unexported type used as a context key for the transaction marker in tests.
@@ -1152,6 +1156,57 @@ func TestExecute_EngineError_NewFlow_ContextNeverRemoved(t *testing.T) {
 
 // --- buildFlowApplication / readEntitySystemAttributes ---
 
+func TestNotifySignIn_CompletedAuthenticationFlow(t *testing.T) {
+	mockNotifier := securitynotificationmock.NewSecurityNotificationServiceInterfaceMock(t)
+	service := &flowExecService{securityNotifier: mockNotifier}
+	ctx := trusteddevice.WithDeviceInfo(sysContext.WithClientIP(context.Background(), "192.0.2.10"),
+		trusteddevice.DeviceInfo{Fingerprint: "fingerprint-1", Name: "Mozilla/5.0"})
+	engineCtx := &EngineContext{
+		FlowType:          common.FlowTypeAuthentication,
+		AuthenticatedUser: authncm.AuthenticatedUser{IsAuthenticated: true, UserID: "user-1"},
+	}
+	engineCtx.Application.Name = "Console"
+
+	mockNotifier.EXPECT().NotifySignIn(ctx, securitynotification.SignIn{
+		UserID:            "user-1",
+		AppName:           "Console",
+		DeviceFingerprint: "fingerprint-1",
+		UserAgent:         "Mozilla/5.0",
+		IPAddress:         "192.0.2.10",
+	}).Once()
+
+	service.notifySignIn(ctx, engineCtx)
+}
+
+func TestNotifySignIn_Skipped(t *testing.T) {
+	authenticated := authncm.AuthenticatedUser{IsAuthenticated: true, UserID: "user-1"}
+	tests := []struct {
+		name      string
+		sandbox   bool
+		engineCtx *EngineContext
+	}{
+		{"RegistrationFlow", false,
+			&EngineContext{FlowType: common.FlowTypeRegistration, AuthenticatedUser: authenticated}},
+		{"NotAuthenticated", false, &EngineContext{FlowType: common.FlowTypeAuthentication}},
+		{"Sandbox", true, &EngineContext{FlowType: common.FlowTypeAuthentication, AuthenticatedUser: authenticated}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			mockNotifier := securitynotificationmock.NewSecurityNotificationServiceInterfaceMock(t)
+			service := &flowExecService{securityNotifier: mockNotifier, sandbox: tc.sandbox}
+
+			service.notifySignIn(context.Background(), tc.engineCtx)
+
+			mockNotifier.AssertNotCalled(t, "NotifySignIn", mock.Anything, mock.Anything)
+		})
+	}
+
+	// A service without a notifier does nothing.
+	(&flowExecService{}).notifySignIn(context.Background(),
+		&EngineContext{FlowType: common.FlowTypeAuthentication, AuthenticatedUser: authenticated})
+}
+
 func newBuildAppService(
 	t *testing.T,
 ) (*flowExecService, *inboundclientmock.InboundClientServiceInterfaceMock,
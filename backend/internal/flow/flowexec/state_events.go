@@ -0,0 +1,333 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package flowexec
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/flow/common"
+	"github.com/thunder-id/thunderid/internal/system/cryptolab"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/log"
+)
+
+const (
+	// flowStatusExpired is the final status of an execution that expired before it ended.
+	flowStatusExpired = "EXPIRED"
+	// flowStatusEnded is the final status of an execution that ended on another server node, whose outcome
+	// is not known to this node.
+	flowStatusEnded = "ENDED"
+
+	// defaultStatePollInterval is used when no store poll interval is configured.
+	defaultStatePollInterval = 2 * time.Second
+	// stateSubscriberBuffer is the number of updates buffered for a subscriber. Updates that do not fit are
+	// dropped, since the store checks catch up with the latest state.
+	stateSubscriberBuffer = 8
+)
+
+// flowStateUpdate is a state transition published by the node that executed a flow step. The revision
+// identifies the state so that a transition also detected by a store check is sent only once.
+type flowStateUpdate struct {
+	event    FlowStateEvent
+	revision string
+}
+
+// flowStateBroker delivers the state transitions of flow executions to the streams of this node.
+type flowStateBroker struct {
+	mu          sync.Mutex
+	subscribers map[string]map[chan flowStateUpdate]struct{}
+	closed      chan struct{}
+	closeOnce   sync.Once
+}
+
+// newFlowStateBroker creates an empty flow state broker.
+func newFlowStateBroker() *flowStateBroker {
+	return &flowStateBroker{
+		subscribers: make(map[string]map[chan flowStateUpdate]struct{}),
+		closed:      make(chan struct{}),
+	}
+}
+
+// close ends the streams of the subscribers. It is safe to call more than once.
+func (b *flowStateBroker) close() {
+	b.closeOnce.Do(func() {
+		close(b.closed)
+	})
+}
+
+// done returns a channel that is closed once the broker is closed. A nil broker is never closed.
+func (b *flowStateBroker) done() <-chan struct{} {
+	if b == nil {
+		return nil
+	}
+	return b.closed
+}
+
+// subscribe registers a subscriber for the transitions of an execution. The returned function removes it.
+func (b *flowStateBroker) subscribe(executionID string) (<-chan flowStateUpdate, func()) {
+	ch := make(chan flowStateUpdate, stateSubscriberBuffer)
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.subscribers[executionID] == nil {
+		b.subscribers[executionID] = make(map[chan flowStateUpdate]struct{})
+	}
+	b.subscribers[executionID][ch] = struct{}{}
+
+	return ch, func() {
+		b.mu.Lock()
+		defer b.mu.Unlock()
+		delete(b.subscribers[executionID], ch)
+		if len(b.subscribers[executionID]) == 0 {
+			delete(b.subscribers, executionID)
+		}
+	}
+}
+
+// publish delivers a transition to the subscribers of the execution without blocking.
+func (b *flowStateBroker) publish(executionID string, update flowStateUpdate) {
+	if b == nil {
+		return
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for ch := range b.subscribers[executionID] {
+		select {
+		case ch <- update:
+		default:
+		}
+	}
+}
+
+// flowStateSnapshot is the state of an execution read from the flow store.
+type flowStateSnapshot struct {
+	stepID             string
+	challengeTokenHash string
+	expiryTime         time.Time
+}
+
+// revision identifies the step of the execution. The challenge token is rotated on every step, so the
+// revision changes even when a step is repeated.
+func (s *flowStateSnapshot) revision() string {
+	return stateRevision(s.stepID, s.challengeTokenHash)
+}
+
+// stateRevision builds the revision of an execution state.
+func stateRevision(stepID, challengeTokenHash string) string {
+	return stepID + "\x00" + challengeTokenHash
+}
+
+// SubscribeExecutionState streams the state transitions of an ongoing flow execution. The caller proves
+// that it holds the execution with the challenge token of the current step. The returned channel receives
+// the current state first, and is closed after a final event, once ctx is done or once the streams of this
+// node are closed for shutdown.
+func (s *flowExecService) SubscribeExecutionState(ctx context.Context, executionID, challengeToken string) (
+	<-chan FlowStateEvent, *serviceerror.ServiceError) {
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, "FlowExecService"),
+		log.String(log.LoggerKeyExecutionID, executionID))
+
+	// Subscribe before reading the state so that no transition is missed in between.
+	var updates <-chan flowStateUpdate
+	unsubscribe := func() {}
+	if s.stateBroker != nil {
+		updates, unsubscribe = s.stateBroker.subscribe(executionID)
+	}
+
+	snapshot, svcErr := s.readStateSnapshot(ctx, executionID, logger)
+	if svcErr != nil {
+		unsubscribe()
+		return nil, svcErr
+	}
+	if snapshot.challengeTokenHash == "" || challengeToken == "" ||
+		!cryptolab.ValidateTokenHash(challengeToken, snapshot.challengeTokenHash) {
+		unsubscribe()
+		logger.Debug("Invalid challenge token provided for the execution state stream")
+		return nil, &ErrorInvalidChallengeToken
+	}
+
+	events := make(chan FlowStateEvent, 1)
+	go func() {
+		defer close(events)
+		defer unsubscribe()
+		s.watchExecutionState(ctx, executionID, snapshot, updates, events, logger)
+	}()
+
+	logger.Debug("Subscribed to the execution state")
+	return events, nil
+}
+
+// watchExecutionState sends the transitions of an execution until it ends or ctx is done. Transitions made
+// on this node arrive through the broker, while transitions made on other nodes and expiry are detected by
+// checking the flow store at the poll interval. The watch also stops when the broker is closed for shutdown,
+// so that the client reconnects to another node, which resumes the stream from the flow store.
+func (s *flowExecService) watchExecutionState(ctx context.Context, executionID string,
+	current *flowStateSnapshot, updates <-chan flowStateUpdate, events chan<- FlowStateEvent,
+	logger *log.Logger) {
+	revision := current.revision()
+	expiryTime := current.expiryTime
+	if !sendStateEvent(ctx, events, FlowStateEvent{ExecutionID: executionID,
+		FlowStatus: string(common.FlowStatusIncomplete), StepID: current.stepID}) {
+		return
+	}
+
+	pollInterval := s.statePollInterval
+	if pollInterval <= 0 {
+		pollInterval = defaultStatePollInterval
+	}
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	closed := s.stateBroker.done()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-closed:
+			logger.Debug("Closing the execution state stream for shutdown")
+			return
+		case update := <-updates:
+			if update.event.Final {
+				sendStateEvent(ctx, events, update.event)
+				return
+			}
+			if update.revision == revision {
+				continue
+			}
+			revision = update.revision
+			if !sendStateEvent(ctx, events, update.event) {
+				return
+			}
+		case <-ticker.C:
+			snapshot, svcErr := s.readStateSnapshot(ctx, executionID, logger)
+			if svcErr != nil && svcErr.Code != ErrorInvalidExecutionID.Code {
+				continue
+			}
+			if svcErr != nil {
+				sendStateEvent(ctx, events, endedStateEvent(executionID, expiryTime, updates))
+				return
+			}
+			if snapshot.revision() == revision {
+				continue
+			}
+			revision, expiryTime = snapshot.revision(), snapshot.expiryTime
+			if !sendStateEvent(ctx, events, FlowStateEvent{ExecutionID: executionID,
+				FlowStatus: string(common.FlowStatusIncomplete), StepID: snapshot.stepID}) {
+				return
+			}
+		}
+	}
+}
+
+// endedStateEvent builds the final event of an execution that is no longer in the flow store. The outcome
+// is taken from a final update that raced with the store check, if any.
+func endedStateEvent(executionID string, expiryTime time.Time, updates <-chan flowStateUpdate) FlowStateEvent {
+drain:
+	for {
+		select {
+		case update := <-updates:
+			if update.event.Final {
+				return update.event
+			}
+		default:
+			break drain
+		}
+	}
+
+	status := flowStatusEnded
+	if !time.Now().Before(expiryTime) {
+		status = flowStatusExpired
+	}
+	return FlowStateEvent{ExecutionID: executionID, FlowStatus: status, Final: true}
+}
+
+// sendStateEvent sends an event unless ctx is done first.
+func sendStateEvent(ctx context.Context, events chan<- FlowStateEvent, event FlowStateEvent) bool {
+	select {
+	case events <- event:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+// CloseExecutionStateStreams ends the execution state streams of this node. It is called when the server
+// shuts down, since the streams are long-lived and would otherwise hold the drain until it times out.
+func (s *flowExecService) CloseExecutionStateStreams() {
+	if s.stateBroker != nil {
+		s.stateBroker.close()
+	}
+}
+
+// readStateSnapshot reads the state of an execution from the flow store. ErrorInvalidExecutionID is
+// returned when the execution does not exist, has ended or has expired.
+func (s *flowExecService) readStateSnapshot(ctx context.Context, executionID string, logger *log.Logger) (
+	*flowStateSnapshot, *serviceerror.ServiceError) {
+	dbModel, svcErr := s.getFlowContext(ctx, executionID, logger)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+
+	var content flowContextContent
+	if err := json.Unmarshal([]byte(dbModel.Context), &content); err != nil {
+		logger.Error("Failed to parse flow context", log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+
+	snapshot := &flowStateSnapshot{expiryTime: dbModel.ExpiryTime}
+	if content.CurrentNodeID != nil {
+		snapshot.stepID = *content.CurrentNodeID
+	}
+	if content.ChallengeTokenHash != nil {
+		snapshot.challengeTokenHash = *content.ChallengeTokenHash
+	}
+	return snapshot, nil
+}
+
+// publishExecutionState announces the outcome of a flow step to the streams of the execution. A failed step
+// ends the execution, as its context has been removed. Nothing is published for sandbox executions, which
+// have no broker.
+func (s *flowExecService) publishExecutionState(engineCtx *EngineContext, flowStep *FlowStep,
+	svcErr *serviceerror.ServiceError) {
+	if s.stateBroker == nil || engineCtx == nil || engineCtx.ExecutionID == "" {
+		return
+	}
+
+	event := FlowStateEvent{ExecutionID: engineCtx.ExecutionID}
+	switch {
+	case svcErr != nil:
+		event.FlowStatus = string(common.FlowStatusError)
+		event.Final = true
+	case isComplete(*flowStep):
+		event.FlowStatus = string(common.FlowStatusComplete)
+		event.Final = true
+	default:
+		event.FlowStatus = string(flowStep.Status)
+		event.Type = string(flowStep.Type)
+		if engineCtx.CurrentNode != nil {
+			event.StepID = engineCtx.CurrentNode.GetID()
+		}
+	}
+
+	s.stateBroker.publish(engineCtx.ExecutionID,
+		flowStateUpdate{event: event, revision: stateRevision(event.StepID, engineCtx.ChallengeTokenHash)})
+}
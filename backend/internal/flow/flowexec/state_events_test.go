@@ -0,0 +1,270 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package flowexec
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/flow/common"
+	"github.com/thunder-id/thunderid/internal/system/cryptolab"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/tests/mocks/flow/coremock"
+)
+
+const testStateToken = "challenge-token"
+
+type StateEventsTestSuite struct {
+	suite.Suite
+}
+
+func TestStateEventsTestSuite(t *testing.T) {
+	suite.Run(t, new(StateEventsTestSuite))
+}
+
+// storedState builds a stored flow context waiting on the given step.
+func (s *StateEventsTestSuite) storedState(stepID, token string, expiry time.Time) *FlowContextDB {
+	content := flowContextContent{AppID: "app-1", GraphID: "graph-1", CurrentNodeID: &stepID}
+	if token != "" {
+		hash := cryptolab.HashToken(token)
+		content.ChallengeTokenHash = &hash
+	}
+	data, err := json.Marshal(content)
+	s.Require().NoError(err)
+	return &FlowContextDB{ExecutionID: "exec-1", Context: string(data), ExpiryTime: expiry}
+}
+
+// receive returns the next event, failing the test if none arrives in time.
+func (s *StateEventsTestSuite) receive(events <-chan FlowStateEvent) FlowStateEvent {
+	select {
+	case event, ok := <-events:
+		s.Require().True(ok, "event channel closed")
+		return event
+	case <-time.After(2 * time.Second):
+		s.FailNow("timed out waiting for an event")
+	}
+	return FlowStateEvent{}
+}
+
+// assertClosed fails the test unless the event channel is closed in time.
+func (s *StateEventsTestSuite) assertClosed(events <-chan FlowStateEvent) {
+	select {
+	case _, ok := <-events:
+		s.False(ok, "unexpected event")
+	case <-time.After(2 * time.Second):
+		s.Fail("timed out waiting for the event channel to close")
+	}
+}
+
+func (s *StateEventsTestSuite) TestSubscribe_RejectsInvalidChallengeToken() {
+	expiry := time.Now().Add(time.Hour)
+	mockStore := newFlowStoreInterfaceMock(s.T())
+	mockStore.EXPECT().GetFlowContext(mock.Anything, "exec-1").
+		Return(s.storedState("node-1", testStateToken, expiry), nil)
+	broker := newFlowStateBroker()
+	service := &flowExecService{flowStore: mockStore, stateBroker: broker}
+
+	for _, token := range []string{"", "wrong-token"} {
+		events, svcErr := service.SubscribeExecutionState(context.Background(), "exec-1", token)
+
+		s.Nil(events)
+		s.Equal(ErrorInvalidChallengeToken.Code, svcErr.Code)
+	}
+	s.Empty(broker.subscribers)
+}
+
+func (s *StateEventsTestSuite) TestSubscribe_RejectsExecutionWithoutChallengeToken() {
+	mockStore := newFlowStoreInterfaceMock(s.T())
+	mockStore.EXPECT().GetFlowContext(mock.Anything, "exec-1").
+		Return(s.storedState("node-1", "", time.Now().Add(time.Hour)), nil)
+	service := &flowExecService{flowStore: mockStore, stateBroker: newFlowStateBroker()}
+
+	_, svcErr := service.SubscribeExecutionState(context.Background(), "exec-1", testStateToken)
+
+	s.Equal(ErrorInvalidChallengeToken.Code, svcErr.Code)
+}
+
+func (s *StateEventsTestSuite) TestSubscribe_UnknownExecution() {
+	mockStore := newFlowStoreInterfaceMock(s.T())
+	mockStore.EXPECT().GetFlowContext(mock.Anything, "missing").Return(nil, nil)
+	service := &flowExecService{flowStore: mockStore, stateBroker: newFlowStateBroker()}
+
+	_, svcErr := service.SubscribeExecutionState(context.Background(), "missing", testStateToken)
+
+	s.Equal(ErrorInvalidExecutionID.Code, svcErr.Code)
+}
+
+func (s *StateEventsTestSuite) TestSubscribe_StreamsLocalTransitions() {
+	mockStore := newFlowStoreInterfaceMock(s.T())
+	mockStore.EXPECT().GetFlowContext(mock.Anything, "exec-1").
+		Return(s.storedState("node-1", testStateToken, time.Now().Add(time.Hour)), nil).Once()
+	broker := newFlowStateBroker()
+	service := &flowExecService{flowStore: mockStore, stateBroker: broker, statePollInterval: time.Hour}
+
+	events, svcErr := service.SubscribeExecutionState(context.Background(), "exec-1", testStateToken)
+	s.Require().Nil(svcErr)
+	s.Equal(FlowStateEvent{ExecutionID: "exec-1", FlowStatus: "INCOMPLETE", StepID: "node-1"}, s.receive(events))
+
+	step := FlowStateEvent{ExecutionID: "exec-1", FlowStatus: "INCOMPLETE", StepID: "node-2", Type: "VIEW"}
+	broker.publish("exec-1", flowStateUpdate{event: step, revision: "node-2:h2"})
+	broker.publish("exec-1", flowStateUpdate{event: step, revision: "node-2:h2"})
+	broker.publish("exec-2", flowStateUpdate{event: FlowStateEvent{ExecutionID: "exec-2"}, revision: "other"})
+	end := FlowStateEvent{ExecutionID: "exec-1", FlowStatus: "COMPLETE", Final: true}
+	broker.publish("exec-1", flowStateUpdate{event: end, revision: ":"})
+
+	s.Equal(step, s.receive(events))
+	s.Equal(end, s.receive(events))
+	s.assertClosed(events)
+	s.Eventually(func() bool {
+		broker.mu.Lock()
+		defer broker.mu.Unlock()
+		return len(broker.subscribers) == 0
+	}, time.Second, 10*time.Millisecond)
+}
+
+func (s *StateEventsTestSuite) TestSubscribe_DetectsStoreTransitionsAndEnd() {
+	expiry := time.Now().Add(time.Hour)
+	mockStore := newFlowStoreInterfaceMock(s.T())
+	mockStore.EXPECT().GetFlowContext(mock.Anything, "exec-1").
+		Return(s.storedState("node-1", testStateToken, expiry), nil).Twice()
+	mockStore.EXPECT().GetFlowContext(mock.Anything, "exec-1").
+		Return(s.storedState("node-2", "rotated-token", expiry), nil).Once()
+	mockStore.EXPECT().GetFlowContext(mock.Anything, "exec-1").Return(nil, nil).Once()
+	service := &flowExecService{flowStore: mockStore, statePollInterval: 10 * time.Millisecond}
+
+	events, svcErr := service.SubscribeExecutionState(context.Background(), "exec-1", testStateToken)
+	s.Require().Nil(svcErr)
+
+	s.Equal("node-1", s.receive(events).StepID)
+	s.Equal(FlowStateEvent{ExecutionID: "exec-1", FlowStatus: "INCOMPLETE", StepID: "node-2"}, s.receive(events))
+	s.Equal(FlowStateEvent{ExecutionID: "exec-1", FlowStatus: flowStatusEnded, Final: true}, s.receive(events))
+	s.assertClosed(events)
+}
+
+func (s *StateEventsTestSuite) TestSubscribe_ReportsExpiry() {
+	mockStore := newFlowStoreInterfaceMock(s.T())
+	mockStore.EXPECT().GetFlowContext(mock.Anything, "exec-1").
+		Return(s.storedState("node-1", testStateToken, time.Now()), nil).Once()
+	mockStore.EXPECT().GetFlowContext(mock.Anything, "exec-1").Return(nil, nil).Once()
+	service := &flowExecService{flowStore: mockStore, statePollInterval: 10 * time.Millisecond}
+
+	events, svcErr := service.SubscribeExecutionState(context.Background(), "exec-1", testStateToken)
+	s.Require().Nil(svcErr)
+
+	s.receive(events)
+	s.Equal(FlowStateEvent{ExecutionID: "exec-1", FlowStatus: flowStatusExpired, Final: true}, s.receive(events))
+	s.assertClosed(events)
+}
+
+func (s *StateEventsTestSuite) TestSubscribe_StopsWhenContextIsDone() {
+	mockStore := newFlowStoreInterfaceMock(s.T())
+	mockStore.EXPECT().GetFlowContext(mock.Anything, "exec-1").
+		Return(s.storedState("node-1", testStateToken, time.Now().Add(time.Hour)), nil).Once()
+	service := &flowExecService{flowStore: mockStore, stateBroker: newFlowStateBroker(),
+		statePollInterval: time.Hour}
+	ctx, cancel := context.WithCancel(context.Background())
+
+	events, svcErr := service.SubscribeExecutionState(ctx, "exec-1", testStateToken)
+	s.Require().Nil(svcErr)
+	s.receive(events)
+	cancel()
+
+	s.assertClosed(events)
+}
+
+func (s *StateEventsTestSuite) TestSubscribe_StopsWhenStreamsAreClosed() {
+	mockStore := newFlowStoreInterfaceMock(s.T())
+	mockStore.EXPECT().GetFlowContext(mock.Anything, "exec-1").
+		Return(s.storedState("node-1", testStateToken, time.Now().Add(time.Hour)), nil).Twice()
+	broker := newFlowStateBroker()
+	service := &flowExecService{flowStore: mockStore, stateBroker: broker, statePollInterval: time.Hour}
+
+	events, svcErr := service.SubscribeExecutionState(context.Background(), "exec-1", testStateToken)
+	s.Require().Nil(svcErr)
+	s.receive(events)
+	service.CloseExecutionStateStreams()
+	service.CloseExecutionStateStreams()
+
+	s.assertClosed(events)
+	s.Eventually(func() bool {
+		broker.mu.Lock()
+		defer broker.mu.Unlock()
+		return len(broker.subscribers) == 0
+	}, time.Second, 10*time.Millisecond)
+
+	// A stream opened after the streams are closed ends after the current state.
+	events, svcErr = service.SubscribeExecutionState(context.Background(), "exec-1", testStateToken)
+	s.Require().Nil(svcErr)
+	s.receive(events)
+	s.assertClosed(events)
+}
+
+func (s *StateEventsTestSuite) TestCloseExecutionStateStreams_SkipsSandboxExecutions() {
+	service := &flowExecService{}
+
+	s.NotPanics(service.CloseExecutionStateStreams)
+}
+
+func (s *StateEventsTestSuite) TestPublishExecutionState() {
+	broker := newFlowStateBroker()
+	service := &flowExecService{stateBroker: broker}
+	updates, unsubscribe := broker.subscribe("exec-1")
+	defer unsubscribe()
+	node := coremock.NewNodeInterfaceMock(s.T())
+	node.EXPECT().GetID().Return("node-2")
+	engineCtx := &EngineContext{ExecutionID: "exec-1", CurrentNode: node, ChallengeTokenHash: "hash"}
+
+	service.publishExecutionState(engineCtx, &FlowStep{Status: common.FlowStatusIncomplete,
+		Type: common.StepTypeView}, nil)
+	service.publishExecutionState(engineCtx, &FlowStep{Status: common.FlowStatusComplete}, nil)
+	service.publishExecutionState(engineCtx, nil, &serviceerror.InternalServerError)
+
+	s.Equal(flowStateUpdate{
+		event:    FlowStateEvent{ExecutionID: "exec-1", FlowStatus: "INCOMPLETE", StepID: "node-2", Type: "VIEW"},
+		revision: stateRevision("node-2", "hash"),
+	}, <-updates)
+	s.Equal(FlowStateEvent{ExecutionID: "exec-1", FlowStatus: "COMPLETE", Final: true}, (<-updates).event)
+	s.Equal(FlowStateEvent{ExecutionID: "exec-1", FlowStatus: "ERROR", Final: true}, (<-updates).event)
+}
+
+func (s *StateEventsTestSuite) TestPublishExecutionState_SkipsSandboxExecutions() {
+	service := &flowExecService{sandbox: true}
+
+	s.NotPanics(func() {
+		service.publishExecutionState(&EngineContext{ExecutionID: "exec-1"},
+			&FlowStep{Status: common.FlowStatusComplete}, nil)
+	})
+}
+
+func (s *StateEventsTestSuite) TestBrokerPublish_DropsUpdatesForSlowSubscribers() {
+	broker := newFlowStateBroker()
+	updates, unsubscribe := broker.subscribe("exec-1")
+	defer unsubscribe()
+
+	for i := 0; i < stateSubscriberBuffer+2; i++ {
+		broker.publish("exec-1", flowStateUpdate{revision: "r"})
+	}
+
+	s.Len(updates, stateSubscriberBuffer)
+}
@@ -29,6 +29,9 @@ import (
 	"github.com/thunder-id/thunderid/internal/system/database/provider"
 )
 
+// errFlowContextNotFound is returned when a flow context to update does not exist or has expired.
+var errFlowContextNotFound = errors.New("flow context not found")
+
 // flowStoreInterface defines the methods for flow context storage operations.
 type flowStoreInterface interface {
 	StoreFlowContext(ctx context.Context, dbModel FlowContextDB, expirySeconds int64) error
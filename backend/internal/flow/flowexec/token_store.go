@@ -0,0 +1,137 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package flowexec
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/tokenstore"
+)
+
+// tokenFlowStore is the implementation of flowStoreInterface that keeps flow contexts in the token store
+// configured for flow executions.
+type tokenFlowStore struct {
+	store tokenstore.TokenStoreInterface
+}
+
+// newTokenFlowStore creates a new flow store backed by a token store.
+func newTokenFlowStore(store tokenstore.TokenStoreInterface) flowStoreInterface {
+	return &tokenFlowStore{store: store}
+}
+
+// StoreFlowContext stores the flow context until it expires.
+func (s *tokenFlowStore) StoreFlowContext(ctx context.Context, dbModel FlowContextDB, expirySeconds int64) error {
+	data, err := json.Marshal(dbModel)
+	if err != nil {
+		return fmt.Errorf("failed to marshal flow context: %w", err)
+	}
+	return s.store.Store(ctx, dbModel.ExecutionID, data, time.Now().Add(time.Duration(expirySeconds)*time.Second))
+}
+
+// GetFlowContext retrieves the flow context, or nil when it does not exist or has expired.
+func (s *tokenFlowStore) GetFlowContext(ctx context.Context, executionID string) (*FlowContextDB, error) {
+	data, found, err := s.store.Get(ctx, executionID)
+	if err != nil || !found {
+		return nil, err
+	}
+
+	var result FlowContextDB
+	if err := json.Unmarshal(data, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal flow context: %w", err)
+	}
+	return &result, nil
+}
+
+// UpdateFlowContext updates the stored flow context, preserving its expiry.
+func (s *tokenFlowStore) UpdateFlowContext(ctx context.Context, dbModel FlowContextDB) error {
+	data, err := json.Marshal(dbModel)
+	if err != nil {
+		return fmt.Errorf("failed to marshal flow context: %w", err)
+	}
+
+	updated, err := s.store.Update(ctx, dbModel.ExecutionID, data)
+	if err != nil {
+		return err
+	}
+	if !updated {
+		return fmt.Errorf("%w for executionID: %s", errFlowContextNotFound, dbModel.ExecutionID)
+	}
+	return nil
+}
+
+// DeleteFlowContext removes the flow context.
+func (s *tokenFlowStore) DeleteFlowContext(ctx context.Context, executionID string) error {
+	return s.store.Delete(ctx, executionID)
+}
+
+// migratingFlowStore moves flow executions from the runtime flow store to a token store. Flow contexts are
+// written to both stores so that either can serve them, and contexts missing from the token store are read
+// from the runtime flow store.
+type migratingFlowStore struct {
+	store         flowStoreInterface
+	previousStore flowStoreInterface
+}
+
+// newMigratingFlowStore creates a flow store that writes to both stores and reads from the new store first.
+func newMigratingFlowStore(store, previousStore flowStoreInterface) flowStoreInterface {
+	return &migratingFlowStore{store: store, previousStore: previousStore}
+}
+
+// StoreFlowContext stores the flow context in both stores.
+func (m *migratingFlowStore) StoreFlowContext(ctx context.Context, dbModel FlowContextDB,
+	expirySeconds int64) error {
+	if err := m.store.StoreFlowContext(ctx, dbModel, expirySeconds); err != nil {
+		return err
+	}
+	return m.previousStore.StoreFlowContext(ctx, dbModel, expirySeconds)
+}
+
+// GetFlowContext retrieves the flow context from the new store, falling back to the previous store.
+func (m *migratingFlowStore) GetFlowContext(ctx context.Context, executionID string) (*FlowContextDB, error) {
+	result, err := m.store.GetFlowContext(ctx, executionID)
+	if err != nil || result != nil {
+		return result, err
+	}
+	return m.previousStore.GetFlowContext(ctx, executionID)
+}
+
+// UpdateFlowContext updates the flow context in both stores. A flow context stored before the migration
+// started is only held by the previous store, so the update succeeds when either store holds it.
+func (m *migratingFlowStore) UpdateFlowContext(ctx context.Context, dbModel FlowContextDB) error {
+	err := m.store.UpdateFlowContext(ctx, dbModel)
+	if err != nil && !errors.Is(err, errFlowContextNotFound) {
+		return err
+	}
+	previousErr := m.previousStore.UpdateFlowContext(ctx, dbModel)
+	if err == nil && errors.Is(previousErr, errFlowContextNotFound) {
+		return nil
+	}
+	return previousErr
+}
+
+// DeleteFlowContext removes the flow context from both stores.
+func (m *migratingFlowStore) DeleteFlowContext(ctx context.Context, executionID string) error {
+	if err := m.store.DeleteFlowContext(ctx, executionID); err != nil {
+		return err
+	}
+	return m.previousStore.DeleteFlowContext(ctx, executionID)
+}
@@ -0,0 +1,249 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package flowexec
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/tests/mocks/oauth/oauth2/tokenstoremock"
+)
+
+const tokenTestExecutionID = "test-execution-id"
+
+type TokenFlowStoreTestSuite struct {
+	suite.Suite
+	mockStore *tokenstoremock.TokenStoreInterfaceMock
+	store     flowStoreInterface
+	ctx       context.Context
+	dbModel   FlowContextDB
+}
+
+func TestTokenFlowStoreSuite(t *testing.T) {
+	suite.Run(t, new(TokenFlowStoreTestSuite))
+}
+
+func (suite *TokenFlowStoreTestSuite) SetupTest() {
+	suite.mockStore = tokenstoremock.NewTokenStoreInterfaceMock(suite.T())
+	suite.store = newTokenFlowStore(suite.mockStore)
+	suite.ctx = context.Background()
+	now := time.Now().UTC().Truncate(time.Second)
+	suite.dbModel = FlowContextDB{
+		ExecutionID: tokenTestExecutionID,
+		Context:     `{"flowId":"test-flow"}`,
+		ExpiryTime:  now.Add(30 * time.Minute),
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+}
+
+func (suite *TokenFlowStoreTestSuite) TestStoreFlowContext_Success() {
+	expected, _ := json.Marshal(suite.dbModel)
+	start := time.Now()
+	suite.mockStore.On("Store", suite.ctx, tokenTestExecutionID, expected, mock.MatchedBy(func(t time.Time) bool {
+		return !t.Before(start.Add(30*time.Minute)) && !t.After(time.Now().Add(30*time.Minute))
+	})).Return(nil)
+
+	err := suite.store.StoreFlowContext(suite.ctx, suite.dbModel, 1800)
+
+	suite.NoError(err)
+}
+
+func (suite *TokenFlowStoreTestSuite) TestStoreFlowContext_Error() {
+	suite.mockStore.On("Store", suite.ctx, tokenTestExecutionID, mock.Anything, mock.Anything).
+		Return(errors.New("store failed"))
+
+	err := suite.store.StoreFlowContext(suite.ctx, suite.dbModel, 1800)
+
+	suite.Error(err)
+}
+
+func (suite *TokenFlowStoreTestSuite) TestGetFlowContext_Success() {
+	data, _ := json.Marshal(suite.dbModel)
+	suite.mockStore.On("Get", suite.ctx, tokenTestExecutionID).Return(data, true, nil)
+
+	result, err := suite.store.GetFlowContext(suite.ctx, tokenTestExecutionID)
+
+	suite.NoError(err)
+	suite.Require().NotNil(result)
+	suite.Equal(suite.dbModel.Context, result.Context)
+	suite.True(suite.dbModel.ExpiryTime.Equal(result.ExpiryTime))
+}
+
+func (suite *TokenFlowStoreTestSuite) TestGetFlowContext_NotFound() {
+	suite.mockStore.On("Get", suite.ctx, tokenTestExecutionID).Return(nil, false, nil)
+
+	result, err := suite.store.GetFlowContext(suite.ctx, tokenTestExecutionID)
+
+	suite.NoError(err)
+	suite.Nil(result)
+}
+
+func (suite *TokenFlowStoreTestSuite) TestGetFlowContext_InvalidData() {
+	suite.mockStore.On("Get", suite.ctx, tokenTestExecutionID).Return([]byte("not-json"), true, nil)
+
+	result, err := suite.store.GetFlowContext(suite.ctx, tokenTestExecutionID)
+
+	suite.Error(err)
+	suite.Nil(result)
+}
+
+func (suite *TokenFlowStoreTestSuite) TestGetFlowContext_Error() {
+	suite.mockStore.On("Get", suite.ctx, tokenTestExecutionID).Return(nil, false, errors.New("get failed"))
+
+	result, err := suite.store.GetFlowContext(suite.ctx, tokenTestExecutionID)
+
+	suite.Error(err)
+	suite.Nil(result)
+}
+
+func (suite *TokenFlowStoreTestSuite) TestUpdateFlowContext_Success() {
+	expected, _ := json.Marshal(suite.dbModel)
+	suite.mockStore.On("Update", suite.ctx, tokenTestExecutionID, expected).Return(true, nil)
+
+	err := suite.store.UpdateFlowContext(suite.ctx, suite.dbModel)
+
+	suite.NoError(err)
+}
+
+func (suite *TokenFlowStoreTestSuite) TestUpdateFlowContext_NotFound() {
+	suite.mockStore.On("Update", suite.ctx, tokenTestExecutionID, mock.Anything).Return(false, nil)
+
+	err := suite.store.UpdateFlowContext(suite.ctx, suite.dbModel)
+
+	suite.ErrorIs(err, errFlowContextNotFound)
+}
+
+func (suite *TokenFlowStoreTestSuite) TestUpdateFlowContext_Error() {
+	suite.mockStore.On("Update", suite.ctx, tokenTestExecutionID, mock.Anything).
+		Return(false, errors.New("update failed"))
+
+	err := suite.store.UpdateFlowContext(suite.ctx, suite.dbModel)
+
+	suite.Error(err)
+	suite.NotErrorIs(err, errFlowContextNotFound)
+}
+
+func (suite *TokenFlowStoreTestSuite) TestDeleteFlowContext() {
+	suite.mockStore.On("Delete", suite.ctx, tokenTestExecutionID).Return(nil)
+
+	err := suite.store.DeleteFlowContext(suite.ctx, tokenTestExecutionID)
+
+	suite.NoError(err)
+}
+
+type MigratingFlowStoreTestSuite struct {
+	suite.Suite
+	mockStore         *flowStoreInterfaceMock
+	mockPreviousStore *flowStoreInterfaceMock
+	store             flowStoreInterface
+	ctx               context.Context
+	dbModel           FlowContextDB
+}
+
+func TestMigratingFlowStoreSuite(t *testing.T) {
+	suite.Run(t, new(MigratingFlowStoreTestSuite))
+}
+
+func (suite *MigratingFlowStoreTestSuite) SetupTest() {
+	suite.mockStore = newFlowStoreInterfaceMock(suite.T())
+	suite.mockPreviousStore = newFlowStoreInterfaceMock(suite.T())
+	suite.store = newMigratingFlowStore(suite.mockStore, suite.mockPreviousStore)
+	suite.ctx = context.Background()
+	suite.dbModel = FlowContextDB{ExecutionID: tokenTestExecutionID, Context: `{"flowId":"test-flow"}`}
+}
+
+func (suite *MigratingFlowStoreTestSuite) TestStoreFlowContext_WritesBothStores() {
+	suite.mockStore.On("StoreFlowContext", suite.ctx, suite.dbModel, int64(1800)).Return(nil).Once()
+	suite.mockPreviousStore.On("StoreFlowContext", suite.ctx, suite.dbModel, int64(1800)).Return(nil).Once()
+
+	suite.NoError(suite.store.StoreFlowContext(suite.ctx, suite.dbModel, 1800))
+}
+
+func (suite *MigratingFlowStoreTestSuite) TestStoreFlowContext_Error() {
+	suite.mockStore.On("StoreFlowContext", suite.ctx, suite.dbModel, int64(1800)).
+		Return(errors.New("store failed")).Once()
+
+	suite.Error(suite.store.StoreFlowContext(suite.ctx, suite.dbModel, 1800))
+	suite.mockPreviousStore.AssertNotCalled(suite.T(), "StoreFlowContext", mock.Anything, mock.Anything,
+		mock.Anything)
+}
+
+func (suite *MigratingFlowStoreTestSuite) TestGetFlowContext_ReadsNewStoreFirst() {
+	suite.mockStore.On("GetFlowContext", suite.ctx, tokenTestExecutionID).Return(&suite.dbModel, nil).Once()
+
+	result, err := suite.store.GetFlowContext(suite.ctx, tokenTestExecutionID)
+
+	suite.NoError(err)
+	suite.Equal(&suite.dbModel, result)
+	suite.mockPreviousStore.AssertNotCalled(suite.T(), "GetFlowContext", mock.Anything, mock.Anything)
+}
+
+func (suite *MigratingFlowStoreTestSuite) TestGetFlowContext_FallsBackToPreviousStore() {
+	suite.mockStore.On("GetFlowContext", suite.ctx, tokenTestExecutionID).Return(nil, nil).Once()
+	suite.mockPreviousStore.On("GetFlowContext", suite.ctx, tokenTestExecutionID).
+		Return(&suite.dbModel, nil).Once()
+
+	result, err := suite.store.GetFlowContext(suite.ctx, tokenTestExecutionID)
+
+	suite.NoError(err)
+	suite.Equal(&suite.dbModel, result)
+}
+
+func (suite *MigratingFlowStoreTestSuite) TestUpdateFlowContext_ContextOnlyInNewStore() {
+	suite.mockStore.On("UpdateFlowContext", suite.ctx, suite.dbModel).Return(nil).Once()
+	suite.mockPreviousStore.On("UpdateFlowContext", suite.ctx, suite.dbModel).
+		Return(errFlowContextNotFound).Once()
+
+	suite.NoError(suite.store.UpdateFlowContext(suite.ctx, suite.dbModel))
+}
+
+func (suite *MigratingFlowStoreTestSuite) TestUpdateFlowContext_ContextOnlyInPreviousStore() {
+	suite.mockStore.On("UpdateFlowContext", suite.ctx, suite.dbModel).Return(errFlowContextNotFound).Once()
+	suite.mockPreviousStore.On("UpdateFlowContext", suite.ctx, suite.dbModel).Return(nil).Once()
+
+	suite.NoError(suite.store.UpdateFlowContext(suite.ctx, suite.dbModel))
+}
+
+func (suite *MigratingFlowStoreTestSuite) TestUpdateFlowContext_ContextInNeitherStore() {
+	suite.mockStore.On("UpdateFlowContext", suite.ctx, suite.dbModel).Return(errFlowContextNotFound).Once()
+	suite.mockPreviousStore.On("UpdateFlowContext", suite.ctx, suite.dbModel).
+		Return(errFlowContextNotFound).Once()
+
+	suite.ErrorIs(suite.store.UpdateFlowContext(suite.ctx, suite.dbModel), errFlowContextNotFound)
+}
+
+func (suite *MigratingFlowStoreTestSuite) TestUpdateFlowContext_Error() {
+	suite.mockStore.On("UpdateFlowContext", suite.ctx, suite.dbModel).Return(errors.New("update failed")).Once()
+
+	suite.Error(suite.store.UpdateFlowContext(suite.ctx, suite.dbModel))
+	suite.mockPreviousStore.AssertNotCalled(suite.T(), "UpdateFlowContext", mock.Anything, mock.Anything)
+}
+
+func (suite *MigratingFlowStoreTestSuite) TestDeleteFlowContext_DeletesFromBothStores() {
+	suite.mockStore.On("DeleteFlowContext", suite.ctx, tokenTestExecutionID).Return(nil).Once()
+	suite.mockPreviousStore.On("DeleteFlowContext", suite.ctx, tokenTestExecutionID).Return(nil).Once()
+
+	suite.NoError(suite.store.DeleteFlowContext(suite.ctx, tokenTestExecutionID))
+}
@@ -687,6 +687,70 @@ func (_c *FlowMgtServiceInterfaceMock_ListFlows_Call) RunAndReturn(run func(ctx
 	return _c
 }
 
+// ListNodeTypes provides a mock function for the type FlowMgtServiceInterfaceMock
+func (_mock *FlowMgtServiceInterfaceMock) ListNodeTypes(ctx context.Context) (*FlowNodeTypeListResponse, *serviceerror.ServiceError) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListNodeTypes")
+	}
+
+	var r0 *FlowNodeTypeListResponse
+	var r1 *serviceerror.ServiceError
+	if returnFunc, ok := ret.Get(0).(func(context.Context) (*FlowNodeTypeListResponse, *serviceerror.ServiceError)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) *FlowNodeTypeListResponse); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*FlowNodeTypeListResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) *serviceerror.ServiceError); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*serviceerror.ServiceError)
+		}
+	}
+	return r0, r1
+}
+
+// FlowMgtServiceInterfaceMock_ListNodeTypes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListNodeTypes'
+type FlowMgtServiceInterfaceMock_ListNodeTypes_Call struct {
+	*mock.Call
+}
+
+// ListNodeTypes is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *FlowMgtServiceInterfaceMock_Expecter) ListNodeTypes(ctx interface{}) *FlowMgtServiceInterfaceMock_ListNodeTypes_Call {
+	return &FlowMgtServiceInterfaceMock_ListNodeTypes_Call{Call: _e.mock.On("ListNodeTypes", ctx)}
+}
+
+func (_c *FlowMgtServiceInterfaceMock_ListNodeTypes_Call) Run(run func(ctx context.Context)) *FlowMgtServiceInterfaceMock_ListNodeTypes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *FlowMgtServiceInterfaceMock_ListNodeTypes_Call) Return(flowNodeTypeListResponse *FlowNodeTypeListResponse, serviceError *serviceerror.ServiceError) *FlowMgtServiceInterfaceMock_ListNodeTypes_Call {
+	_c.Call.Return(flowNodeTypeListResponse, serviceError)
+	return _c
+}
+
+func (_c *FlowMgtServiceInterfaceMock_ListNodeTypes_Call) RunAndReturn(run func(ctx context.Context) (*FlowNodeTypeListResponse, *serviceerror.ServiceError)) *FlowMgtServiceInterfaceMock_ListNodeTypes_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // RestoreFlowVersion provides a mock function for the type FlowMgtServiceInterfaceMock
 func (_mock *FlowMgtServiceInterfaceMock) RestoreFlowVersion(ctx context.Context, flowID string, version int) (*CompleteFlowDefinition, *serviceerror.ServiceError) {
 	ret := _mock.Called(ctx, flowID, version)
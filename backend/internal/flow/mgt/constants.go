@@ -29,6 +29,14 @@ const (
 	defaultVersionHistory = 10
 )
 
+// Categories of the flow node catalog that are not derived from an executor type.
+const (
+	// nodeCategoryControl groups the nodes that mark the boundaries of a flow.
+	nodeCategoryControl = "CONTROL"
+	// nodeCategoryInteraction groups the nodes that collect input from the user.
+	nodeCategoryInteraction = "INTERACTION"
+)
+
 const (
 	// provisioningNodeID is the node ID for the inferred provisioning node
 	provisioningNodeID = "prov_node"
@@ -280,6 +280,20 @@ var (
 			DefaultValue: "Flow ID already exists",
 		},
 	}
+
+	// ErrorFlowQuotaExceeded is the error returned when creating a flow would exceed the flow quota.
+	ErrorFlowQuotaExceeded = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "FLM-1020",
+		Error: core.I18nMessage{
+			Key:          "error.flowmgtservice.quota_exceeded",
+			DefaultValue: "Quota exceeded",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.flowmgtservice.quota_exceeded_description",
+			DefaultValue: "The flow quota of the tenant has been reached",
+		},
+	}
 )
 
 // Internal errors
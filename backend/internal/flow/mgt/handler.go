@@ -19,12 +19,16 @@
 package flowmgt
 
 import (
+	"context"
+	"errors"
 	"net/http"
 	"strconv"
 
 	"github.com/thunder-id/thunderid/internal/flow/common"
 	"github.com/thunder-id/thunderid/internal/system/error/apierror"
 	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/i18n/core"
+	"github.com/thunder-id/thunderid/internal/system/jsonstream"
 	"github.com/thunder-id/thunderid/internal/system/log"
 	"github.com/thunder-id/thunderid/internal/system/utils"
 )
@@ -40,24 +44,30 @@ const (
 const (
 	pathParamFlowID    = "flowId"
 	pathParamVersion   = "version"
+	pathParamHandle    = "handle"
 	queryParamFlowType = "flowType"
-	queryParamLimit    = "limit"
-	queryParamOffset   = "offset"
 )
 
 // flowMgtHandler handles HTTP requests for flow management
 type flowMgtHandler struct {
-	service FlowMgtServiceInterface
-	logger  *log.Logger
+	service    FlowMgtServiceInterface
+	jsonLimits jsonstream.Limits
+	maxNodes   int
+	logger     *log.Logger
 }
 
 // newFlowMgtHandler creates a new instance of flowMgtHandler.
+// Flow definition bodies are decoded as a stream within the given JSON limits and node count limit.
 func newFlowMgtHandler(
 	service FlowMgtServiceInterface,
+	jsonLimits jsonstream.Limits,
+	maxNodes int,
 ) *flowMgtHandler {
 	return &flowMgtHandler{
-		service: service,
-		logger:  log.GetLogger().With(log.String(log.LoggerKeyComponentName, handlerLoggerComponentName)),
+		service:    service,
+		jsonLimits: jsonLimits,
+		maxNodes:   maxNodes,
+		logger:     log.GetLogger().With(log.String(log.LoggerKeyComponentName, handlerLoggerComponentName)),
 	}
 }
 
@@ -66,16 +76,22 @@ func newFlowMgtHandler(
 // listFlows handles GET requests to list flow definitions with pagination and optional filtering.
 func (h *flowMgtHandler) listFlows(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
-	limit, offset, svcErr := parsePaginationParams(r)
+	pagination, svcErr := utils.ParsePaginationParams(r.URL.Query(), &ErrorInvalidLimit, &ErrorInvalidOffset)
 	if svcErr != nil {
 		handleError(w, svcErr)
 		return
 	}
+	limit, offset := pagination.Limit, pagination.Offset
 
 	flowTypeStr := r.URL.Query().Get(queryParamFlowType)
 	flowType := common.FlowType(flowTypeStr)
 
-	flowList, svcErr := h.service.ListFlows(ctx, limit, offset, flowType)
+	var flowList *FlowListResponse
+	if utils.IsListAllRequested(r.URL.Query()) {
+		flowList, svcErr = h.listAllFlows(ctx, flowType)
+	} else {
+		flowList, svcErr = h.service.ListFlows(ctx, limit, offset, flowType)
+	}
 	if svcErr != nil {
 		handleError(w, svcErr)
 		return
@@ -85,12 +101,36 @@ func (h *flowMgtHandler) listFlows(w http.ResponseWriter, r *http.Request) {
 	h.logger.Debug("Flows listed successfully", log.Int(logKeyCount, flowList.Count))
 }
 
+// listAllFlows collects every flow definition of the given type, one page at a time.
+func (h *flowMgtHandler) listAllFlows(ctx context.Context, flowType common.FlowType) (
+	*FlowListResponse, *serviceerror.ServiceError) {
+	flows, svcErr := utils.CollectAllPages(maxPageSize,
+		func(limit, offset int) ([]BasicFlowDefinition, int, *serviceerror.ServiceError) {
+			page, svcErr := h.service.ListFlows(ctx, limit, offset, flowType)
+			if svcErr != nil {
+				return nil, 0, svcErr
+			}
+			return page.Flows, page.TotalResults, nil
+		})
+	if svcErr != nil {
+		return nil, svcErr
+	}
+
+	return &FlowListResponse{
+		TotalResults: len(flows),
+		StartIndex:   1,
+		Count:        len(flows),
+		Flows:        flows,
+		Links:        []utils.Link{},
+	}, nil
+}
+
 // createFlow handles POST requests to create a new flow definition.
 func (h *flowMgtHandler) createFlow(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
-	flowDefRequest, err := utils.DecodeJSONBody[FlowDefinitionRequest](r)
+	flowDefRequest, err := decodeFlowDefinitionRequest(r.Body, h.jsonLimits, h.maxNodes)
 	if err != nil {
-		handleInvalidRequestError(w)
+		handleDecodeError(w, err)
 		return
 	}
 
@@ -124,6 +164,22 @@ func (h *flowMgtHandler) getFlow(w http.ResponseWriter, r *http.Request) {
 	h.logger.Debug("Flow retrieved successfully", log.String(logKeyFlowID, flowID))
 }
 
+// getFlowByHandle handles GET requests to retrieve a flow definition by its handle and flow type.
+func (h *flowMgtHandler) getFlowByHandle(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	handle := r.PathValue(pathParamHandle)
+	flowType := common.FlowType(r.URL.Query().Get(queryParamFlowType))
+
+	flow, svcErr := h.service.GetFlowByHandle(ctx, handle, flowType)
+	if svcErr != nil {
+		handleError(w, svcErr)
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusOK, flow)
+	h.logger.Debug("Flow retrieved successfully by handle", log.String(logKeyFlowID, flow.ID))
+}
+
 // updateFlow handles PUT requests to update an existing flow definition.
 func (h *flowMgtHandler) updateFlow(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -133,9 +189,9 @@ func (h *flowMgtHandler) updateFlow(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	flowDefRequest, err := utils.DecodeJSONBody[FlowDefinitionRequest](r)
+	flowDefRequest, err := decodeFlowDefinitionRequest(r.Body, h.jsonLimits, h.maxNodes)
 	if err != nil {
-		handleInvalidRequestError(w)
+		handleDecodeError(w, err)
 		return
 	}
 
@@ -169,6 +225,18 @@ func (h *flowMgtHandler) deleteFlow(w http.ResponseWriter, r *http.Request) {
 	h.logger.Debug("Flow deleted successfully", log.String(logKeyFlowID, flowID))
 }
 
+// listNodeTypes handles GET requests to list the node types available to flow definitions.
+func (h *flowMgtHandler) listNodeTypes(w http.ResponseWriter, r *http.Request) {
+	nodeTypes, svcErr := h.service.ListNodeTypes(r.Context())
+	if svcErr != nil {
+		handleError(w, svcErr)
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusOK, nodeTypes)
+	h.logger.Debug("Flow node types listed successfully", log.Int("count", nodeTypes.TotalResults))
+}
+
 // Flow version management HTTP handler methods
 
 // listFlowVersions handles GET requests to list all versions of a specific flow definition.
@@ -244,32 +312,6 @@ func (h *flowMgtHandler) restoreFlowVersion(w http.ResponseWriter, r *http.Reque
 		log.String(logKeyFlowID, flowID), log.Int(logKeyVersion, request.Version))
 }
 
-// parsePaginationParams extracts and validates pagination parameters from the request.
-func parsePaginationParams(r *http.Request) (int, int, *serviceerror.ServiceError) {
-	limitStr := r.URL.Query().Get(queryParamLimit)
-	offsetStr := r.URL.Query().Get(queryParamOffset)
-
-	limit := defaultPageSize
-	if limitStr != "" {
-		parsedLimit, err := strconv.Atoi(limitStr)
-		if err != nil || parsedLimit < 0 {
-			return 0, 0, &ErrorInvalidLimit
-		}
-		limit = parsedLimit
-	}
-
-	offset := 0
-	if offsetStr != "" {
-		parsedOffset, err := strconv.Atoi(offsetStr)
-		if err != nil || parsedOffset < 0 {
-			return 0, 0, &ErrorInvalidOffset
-		}
-		offset = parsedOffset
-	}
-
-	return limit, offset, nil
-}
-
 // sanitizeFlowDefinitionRequest sanitizes input for creating or updating a flow definition.
 // TODO: Currently we're storing node representation as it is. In the future, we should sanitize and
 // validate it properly.
@@ -294,6 +336,33 @@ func handleInvalidRequestError(w http.ResponseWriter) {
 	utils.WriteErrorResponse(w, http.StatusBadRequest, errResp)
 }
 
+// handleDecodeError writes the error response for a request body that could not be decoded, pointing at
+// the offending value of the body when its location is known.
+func handleDecodeError(w http.ResponseWriter, err error) {
+	var streamErr *jsonstream.Error
+	if !errors.As(err, &streamErr) {
+		handleInvalidRequestError(w)
+		return
+	}
+
+	switch streamErr.Kind {
+	case jsonstream.ErrorKindSize:
+		utils.WriteErrorResponse(w, http.StatusRequestEntityTooLarge, apierror.ErrRequestBodyTooLarge)
+	case jsonstream.ErrorKindLimit:
+		errResp := apierror.ErrJSONTooComplex
+		errResp.Description = core.I18nMessage{
+			Key:          apierror.ErrJSONTooComplex.Description.Key,
+			DefaultValue: streamErr.Error(),
+		}
+		utils.WriteErrorResponse(w, http.StatusBadRequest, errResp)
+	default:
+		handleError(w, serviceerror.CustomServiceError(ErrorInvalidRequestFormat, core.I18nMessage{
+			Key:          ErrorInvalidRequestFormat.ErrorDescription.Key,
+			DefaultValue: streamErr.Error(),
+		}))
+	}
+}
+
 // handleError writes an error response based on the provided ServiceError.
 func handleError(w http.ResponseWriter, svcErr *serviceerror.ServiceError) {
 	errResp := apierror.ErrorResponse{
@@ -306,7 +375,7 @@ func handleError(w http.ResponseWriter, svcErr *serviceerror.ServiceError) {
 	switch svcErr.Code {
 	case ErrorFlowNotFound.Code, ErrorVersionNotFound.Code:
 		statusCode = http.StatusNotFound
-	case ErrorDuplicateFlowID.Code:
+	case ErrorDuplicateFlowID.Code, ErrorFlowQuotaExceeded.Code:
 		statusCode = http.StatusConflict
 	case serviceerror.InternalServerError.Code:
 		statusCode = http.StatusInternalServerError
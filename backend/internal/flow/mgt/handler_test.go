@@ -23,13 +23,17 @@ import (
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 
 	"github.com/thunder-id/thunderid/internal/flow/common"
+	"github.com/thunder-id/thunderid/internal/system/error/apierror"
 	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/jsonstream"
+	"github.com/thunder-id/thunderid/internal/system/utils"
 )
 
 const testFlowIDHandler = "test-flow-id"
@@ -46,7 +50,7 @@ func TestFlowMgtHandlerTestSuite(t *testing.T) {
 
 func (s *FlowMgtHandlerTestSuite) SetupTest() {
 	s.mockService = NewFlowMgtServiceInterfaceMock(s.T())
-	s.handler = newFlowMgtHandler(s.mockService)
+	s.handler = newFlowMgtHandler(s.mockService, jsonstream.Limits{}, 0)
 }
 
 // Test listFlows
@@ -101,6 +105,30 @@ func (s *FlowMgtHandlerTestSuite) TestListFlows_WithFlowType() {
 	s.Equal(http.StatusOK, w.Code)
 }
 
+func (s *FlowMgtHandlerTestSuite) TestListFlows_All() {
+	firstPage := make([]BasicFlowDefinition, maxPageSize)
+	for i := range firstPage {
+		firstPage[i] = BasicFlowDefinition{ID: "flow", FlowType: common.FlowTypeAuthentication}
+	}
+	s.mockService.EXPECT().ListFlows(mock.Anything, maxPageSize, 0, common.FlowTypeAuthentication).
+		Return(&FlowListResponse{TotalResults: maxPageSize + 1, Flows: firstPage}, nil)
+	s.mockService.EXPECT().ListFlows(mock.Anything, maxPageSize, maxPageSize, common.FlowTypeAuthentication).
+		Return(&FlowListResponse{TotalResults: maxPageSize + 1, Flows: []BasicFlowDefinition{{ID: "last"}}}, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/flows?all=true&flowType=AUTHENTICATION", nil)
+	w := httptest.NewRecorder()
+
+	s.handler.listFlows(w, req)
+
+	s.Equal(http.StatusOK, w.Code)
+	var response FlowListResponse
+	s.NoError(json.Unmarshal(w.Body.Bytes(), &response))
+	s.Equal(maxPageSize+1, response.TotalResults)
+	s.Equal(maxPageSize+1, response.Count)
+	s.Equal("last", response.Flows[maxPageSize].ID)
+	s.Empty(response.Links)
+}
+
 func (s *FlowMgtHandlerTestSuite) TestListFlows_InvalidLimit() {
 	req := httptest.NewRequest(http.MethodGet, "/flows?limit=invalid", nil)
 	w := httptest.NewRecorder()
@@ -186,6 +214,48 @@ func (s *FlowMgtHandlerTestSuite) TestCreateFlow_InvalidJSON() {
 	s.Equal(http.StatusBadRequest, w.Code)
 }
 
+func (s *FlowMgtHandlerTestSuite) TestCreateFlow_InvalidNodeReportsLocation() {
+	body := `{"handle":"new-flow","name":"New Flow","flowType":"AUTHENTICATION",` +
+		`"nodes":[{"id":"start","type":"START"},{"type":"END"}]}`
+	req := httptest.NewRequest(http.MethodPost, "/flows", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	s.handler.createFlow(w, req)
+
+	s.Equal(http.StatusBadRequest, w.Code)
+	var errResp apierror.ErrorResponse
+	s.NoError(json.Unmarshal(w.Body.Bytes(), &errResp))
+	s.Equal(ErrorInvalidRequestFormat.Code, errResp.Code)
+	s.Equal("node id is required at /nodes/1/id", errResp.Description.DefaultValue)
+}
+
+func (s *FlowMgtHandlerTestSuite) TestCreateFlow_TooManyNodes() {
+	handler := newFlowMgtHandler(s.mockService, jsonstream.Limits{}, 2)
+	body := `{"handle":"new-flow","nodes":[{"id":"a"},{"id":"b"},{"id":"c"}]}`
+	req := httptest.NewRequest(http.MethodPost, "/flows", strings.NewReader(body))
+	w := httptest.NewRecorder()
+
+	handler.createFlow(w, req)
+
+	s.Equal(http.StatusBadRequest, w.Code)
+	var errResp apierror.ErrorResponse
+	s.NoError(json.Unmarshal(w.Body.Bytes(), &errResp))
+	s.Equal(apierror.ErrJSONTooComplex.Code, errResp.Code)
+	s.Equal("flow definition has more than 2 nodes at /nodes/2", errResp.Description.DefaultValue)
+}
+
+func (s *FlowMgtHandlerTestSuite) TestCreateFlow_BodyTooLarge() {
+	body := `{"handle":"new-flow","name":"` + strings.Repeat("a", 128) + `","nodes":[]}`
+	req := httptest.NewRequest(http.MethodPost, "/flows", strings.NewReader(body))
+	w := httptest.NewRecorder()
+	req.Body = http.MaxBytesReader(w, req.Body, 64)
+
+	s.handler.createFlow(w, req)
+
+	s.Equal(http.StatusRequestEntityTooLarge, w.Code)
+}
+
 func (s *FlowMgtHandlerTestSuite) TestCreateFlow_ServiceError() {
 	flowDef := &FlowDefinition{
 		Handle:   "new-flow-handle",
@@ -411,6 +481,41 @@ func (s *FlowMgtHandlerTestSuite) TestListFlowVersions_NotFound() {
 	s.Equal(http.StatusNotFound, w.Code)
 }
 
+// Test listNodeTypes
+
+func (s *FlowMgtHandlerTestSuite) TestListNodeTypes_Success() {
+	expected := &FlowNodeTypeListResponse{
+		TotalResults: 1,
+		Categories: []FlowNodeCategory{
+			{Name: nodeCategoryControl, NodeTypes: []FlowNodeType{{Type: common.NodeTypeStart, DisplayName: "Start"}}},
+		},
+	}
+	s.mockService.EXPECT().ListNodeTypes(mock.Anything).Return(expected, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/flow-node-types", nil)
+	w := httptest.NewRecorder()
+
+	s.handler.listNodeTypes(w, req)
+
+	s.Equal(http.StatusOK, w.Code)
+	var response FlowNodeTypeListResponse
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	s.NoError(err)
+	s.Equal(1, response.TotalResults)
+	s.Equal(common.NodeTypeStart, response.Categories[0].NodeTypes[0].Type)
+}
+
+func (s *FlowMgtHandlerTestSuite) TestListNodeTypes_ServiceError() {
+	s.mockService.EXPECT().ListNodeTypes(mock.Anything).Return(nil, &serviceerror.InternalServerError)
+
+	req := httptest.NewRequest(http.MethodGet, "/flow-node-types", nil)
+	w := httptest.NewRecorder()
+
+	s.handler.listNodeTypes(w, req)
+
+	s.Equal(http.StatusInternalServerError, w.Code)
+}
+
 // Test getFlowVersion
 
 func (s *FlowMgtHandlerTestSuite) TestGetFlowVersion_Success() {
@@ -562,32 +667,32 @@ func (s *FlowMgtHandlerTestSuite) TestRestoreFlowVersion_NotFound() {
 	s.Equal(http.StatusNotFound, w.Code)
 }
 
-// Test parsePaginationParams
+// Test pagination parameter parsing
 
 func (s *FlowMgtHandlerTestSuite) TestParsePaginationParams_DefaultValues() {
 	req := httptest.NewRequest(http.MethodGet, "/flows", nil)
 
-	limit, offset, err := parsePaginationParams(req)
+	pagination, err := utils.ParsePaginationParams(req.URL.Query(), &ErrorInvalidLimit, &ErrorInvalidOffset)
 
 	s.Nil(err)
-	s.Equal(defaultPageSize, limit)
-	s.Equal(0, offset)
+	s.Equal(defaultPageSize, pagination.Limit)
+	s.Equal(0, pagination.Offset)
 }
 
 func (s *FlowMgtHandlerTestSuite) TestParsePaginationParams_CustomValues() {
 	req := httptest.NewRequest(http.MethodGet, "/flows?limit=20&offset=10", nil)
 
-	limit, offset, err := parsePaginationParams(req)
+	pagination, err := utils.ParsePaginationParams(req.URL.Query(), &ErrorInvalidLimit, &ErrorInvalidOffset)
 
 	s.Nil(err)
-	s.Equal(20, limit)
-	s.Equal(10, offset)
+	s.Equal(20, pagination.Limit)
+	s.Equal(10, pagination.Offset)
 }
 
 func (s *FlowMgtHandlerTestSuite) TestParsePaginationParams_InvalidLimit() {
 	req := httptest.NewRequest(http.MethodGet, "/flows?limit=invalid", nil)
 
-	_, _, err := parsePaginationParams(req)
+	_, err := utils.ParsePaginationParams(req.URL.Query(), &ErrorInvalidLimit, &ErrorInvalidOffset)
 
 	s.NotNil(err)
 	s.Equal(ErrorInvalidLimit.Code, err.Code)
@@ -596,7 +701,7 @@ func (s *FlowMgtHandlerTestSuite) TestParsePaginationParams_InvalidLimit() {
 func (s *FlowMgtHandlerTestSuite) TestParsePaginationParams_NegativeLimit() {
 	req := httptest.NewRequest(http.MethodGet, "/flows?limit=-1", nil)
 
-	_, _, err := parsePaginationParams(req)
+	_, err := utils.ParsePaginationParams(req.URL.Query(), &ErrorInvalidLimit, &ErrorInvalidOffset)
 
 	s.NotNil(err)
 	s.Equal(ErrorInvalidLimit.Code, err.Code)
@@ -605,7 +710,7 @@ func (s *FlowMgtHandlerTestSuite) TestParsePaginationParams_NegativeLimit() {
 func (s *FlowMgtHandlerTestSuite) TestParsePaginationParams_InvalidOffset() {
 	req := httptest.NewRequest(http.MethodGet, "/flows?offset=invalid", nil)
 
-	_, _, err := parsePaginationParams(req)
+	_, err := utils.ParsePaginationParams(req.URL.Query(), &ErrorInvalidLimit, &ErrorInvalidOffset)
 
 	s.NotNil(err)
 	s.Equal(ErrorInvalidOffset.Code, err.Code)
@@ -614,7 +719,7 @@ func (s *FlowMgtHandlerTestSuite) TestParsePaginationParams_InvalidOffset() {
 func (s *FlowMgtHandlerTestSuite) TestParsePaginationParams_NegativeOffset() {
 	req := httptest.NewRequest(http.MethodGet, "/flows?offset=-1", nil)
 
-	_, _, err := parsePaginationParams(req)
+	_, err := utils.ParsePaginationParams(req.URL.Query(), &ErrorInvalidLimit, &ErrorInvalidOffset)
 
 	s.NotNil(err)
 	s.Equal(ErrorInvalidOffset.Code, err.Code)
@@ -638,3 +743,34 @@ func (s *FlowMgtHandlerTestSuite) TestSanitizeFlowDefinitionRequest() {
 	s.Equal(common.FlowTypeAuthentication, result.FlowType)
 	s.Len(result.Nodes, 1)
 }
+
+// Test getFlowByHandle
+
+func (s *FlowMgtHandlerTestSuite) TestGetFlowByHandle_Success() {
+	s.mockService.EXPECT().GetFlowByHandle(mock.Anything, "basic-login", common.FlowTypeAuthentication).
+		Return(&CompleteFlowDefinition{ID: testFlowIDHandler, Handle: "basic-login"}, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/flows/by-handle/basic-login?flowType=AUTHENTICATION", nil)
+	req.SetPathValue(pathParamHandle, "basic-login")
+	w := httptest.NewRecorder()
+
+	s.handler.getFlowByHandle(w, req)
+
+	s.Equal(http.StatusOK, w.Code)
+	var response CompleteFlowDefinition
+	s.NoError(json.Unmarshal(w.Body.Bytes(), &response))
+	s.Equal(testFlowIDHandler, response.ID)
+}
+
+func (s *FlowMgtHandlerTestSuite) TestGetFlowByHandle_NotFound() {
+	s.mockService.EXPECT().GetFlowByHandle(mock.Anything, "missing", common.FlowTypeAuthentication).
+		Return(nil, &ErrorFlowNotFound)
+
+	req := httptest.NewRequest(http.MethodGet, "/flows/by-handle/missing?flowType=AUTHENTICATION", nil)
+	req.SetPathValue(pathParamHandle, "missing")
+	w := httptest.NewRecorder()
+
+	s.handler.getFlowByHandle(w, req)
+
+	s.Equal(http.StatusNotFound, w.Code)
+}
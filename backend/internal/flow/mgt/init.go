@@ -19,6 +19,7 @@
 package flowmgt
 
 import (
+	"context"
 	"net/http"
 	"strings"
 
@@ -26,12 +27,15 @@ import (
 
 	"github.com/thunder-id/thunderid/internal/flow/core"
 	"github.com/thunder-id/thunderid/internal/flow/executor"
+	"github.com/thunder-id/thunderid/internal/quota"
 
 	"github.com/thunder-id/thunderid/internal/system/cache"
 	"github.com/thunder-id/thunderid/internal/system/config"
 	serverconst "github.com/thunder-id/thunderid/internal/system/constants"
 	declarativeresource "github.com/thunder-id/thunderid/internal/system/declarative_resource"
+	"github.com/thunder-id/thunderid/internal/system/jsonstream"
 	"github.com/thunder-id/thunderid/internal/system/middleware"
+	"github.com/thunder-id/thunderid/internal/system/observability"
 	"github.com/thunder-id/thunderid/internal/system/transaction"
 )
 
@@ -43,6 +47,8 @@ func Initialize(
 	flowFactory core.FlowFactoryInterface,
 	executorRegistry executor.ExecutorRegistryInterface,
 	graphCache core.GraphCacheInterface,
+	quotaService quota.QuotaServiceInterface,
+	observabilitySvc observability.ObservabilityServiceInterface,
 ) (FlowMgtServiceInterface, declarativeresource.ResourceExporter, error) {
 	store, compositeStore, transactioner, err := initializeStore(cacheManager)
 	if err != nil {
@@ -51,9 +57,15 @@ func Initialize(
 
 	inferenceService := newFlowInferenceService()
 	graphBuilder := newGraphBuilder(flowFactory, executorRegistry, graphCache)
-	service := newFlowMgtService(store, inferenceService, graphBuilder, executorRegistry, compositeStore, transactioner)
+	service := newFlowMgtService(store, inferenceService, graphBuilder, executorRegistry, compositeStore, transactioner,
+		quotaService, observabilitySvc)
+	if quotaService != nil {
+		quotaService.RegisterUsageCounter(quota.ResourceTypeFlows, newUsageCounter(store))
+	}
 
-	handler := newFlowMgtHandler(service)
+	serverConfig := config.GetServerRuntime().Config
+	handler := newFlowMgtHandler(service, jsonstream.LimitsFromConfig(serverConfig.Server.RequestLimits),
+		serverConfig.Flow.MaxNodes)
 	registerRoutes(mux, handler)
 
 	// Register MCP tools
@@ -66,6 +78,15 @@ func Initialize(
 	return service, exporter, nil
 }
 
+// newUsageCounter creates a quota usage counter of the flows. Flows belong to the tenant, so every flow is
+// counted regardless of the scope.
+func newUsageCounter(store flowStoreInterface) quota.UsageCounter {
+	return quota.UsageCounterFunc(func(ctx context.Context, _ string) (int, error) {
+		_, total, err := store.ListFlows(ctx, 1, 0, "")
+		return total, err
+	})
+}
+
 // Store Selection (based on flow.store configuration):
 //
 // 1. MUTABLE mode (store: "mutable"):
@@ -172,12 +193,38 @@ func registerRoutes(mux *http.ServeMux, handler *flowMgtHandler) {
 		AllowCredentials: true,
 		MaxAge:           600,
 	}
-	mux.HandleFunc(middleware.WithCORS("GET /flows/{flowId}", handler.getFlow, opts2))
+	// GET requests under /flows/ are dispatched by path segments, since /flows/by-handle/{handle}
+	// would otherwise conflict with /flows/{flowId}/versions.
+	mux.HandleFunc(middleware.WithCORS("GET /flows/",
+		func(w http.ResponseWriter, r *http.Request) {
+			segments := strings.Split(strings.TrimPrefix(r.URL.Path, "/flows/"), "/")
+			switch {
+			case len(segments) == 2 && segments[0] == "by-handle":
+				r.SetPathValue(pathParamHandle, segments[1])
+				handler.getFlowByHandle(w, r)
+			case len(segments) == 1:
+				r.SetPathValue(pathParamFlowID, segments[0])
+				handler.getFlow(w, r)
+			case len(segments) == 2 && segments[1] == "versions":
+				r.SetPathValue(pathParamFlowID, segments[0])
+				handler.listFlowVersions(w, r)
+			case len(segments) == 3 && segments[1] == "versions":
+				r.SetPathValue(pathParamFlowID, segments[0])
+				r.SetPathValue(pathParamVersion, segments[2])
+				handler.getFlowVersion(w, r)
+			default:
+				http.NotFound(w, r)
+			}
+		}, opts2))
 	mux.HandleFunc(middleware.WithCORS("PUT /flows/{flowId}", handler.updateFlow, opts2))
 	mux.HandleFunc(middleware.WithCORS("DELETE /flows/{flowId}", handler.deleteFlow, opts2))
 	mux.HandleFunc(middleware.WithCORS("OPTIONS /flows/{flowId}", func(w http.ResponseWriter, r *http.Request) {
 		w.WriteHeader(http.StatusNoContent)
 	}, opts2))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS /flows/",
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, opts2))
 
 	opts3 := middleware.CORSOptions{
 		AllowedMethods:   []string{"GET"},
@@ -185,13 +232,17 @@ func registerRoutes(mux *http.ServeMux, handler *flowMgtHandler) {
 		AllowCredentials: true,
 		MaxAge:           600,
 	}
-	mux.HandleFunc(middleware.WithCORS("GET /flows/{flowId}/versions", handler.listFlowVersions, opts3))
+	mux.HandleFunc(middleware.WithCORS("GET /flow-node-types", handler.listNodeTypes, opts3))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS /flow-node-types",
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, opts3),
+	)
 	mux.HandleFunc(middleware.WithCORS("OPTIONS /flows/{flowId}/versions",
 		func(w http.ResponseWriter, r *http.Request) {
 			w.WriteHeader(http.StatusNoContent)
 		}, opts3),
 	)
-	mux.HandleFunc(middleware.WithCORS("GET /flows/{flowId}/versions/{version}", handler.getFlowVersion, opts3))
 	mux.HandleFunc(middleware.WithCORS("OPTIONS /flows/{flowId}/versions/{version}",
 		func(w http.ResponseWriter, r *http.Request) {
 			w.WriteHeader(http.StatusNoContent)
@@ -24,14 +24,17 @@ import (
 	"net/http/httptest"
 	"testing"
 
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 	yaml "gopkg.in/yaml.v3"
 
+	"github.com/thunder-id/thunderid/internal/flow/common"
 	"github.com/thunder-id/thunderid/internal/system/cache"
 	"github.com/thunder-id/thunderid/internal/system/config"
 	serverconst "github.com/thunder-id/thunderid/internal/system/constants"
 	"github.com/thunder-id/thunderid/internal/system/cors"
 	"github.com/thunder-id/thunderid/internal/system/database/provider"
+	"github.com/thunder-id/thunderid/internal/system/jsonstream"
 	"github.com/thunder-id/thunderid/tests/mocks/database/providermock"
 )
 
@@ -98,7 +101,7 @@ func TestInitTestSuite(t *testing.T) {
 
 func (s *InitTestSuite) TestRegisterRoutes_AllRoutesRegistered() {
 	mux := http.NewServeMux()
-	handler := newFlowMgtHandler(s.mockService)
+	handler := newFlowMgtHandler(s.mockService, jsonstream.Limits{}, 0)
 	registerRoutes(mux, handler)
 
 	// Test OPTIONS endpoints which don't require service calls
@@ -111,6 +114,7 @@ func (s *InitTestSuite) TestRegisterRoutes_AllRoutesRegistered() {
 		{"OPTIONS /flows/{flowId}/versions", "/flows/test-id/versions"},
 		{"OPTIONS /flows/{flowId}/versions/{version}", "/flows/test-id/versions/1"},
 		{"OPTIONS /flows/{flowId}/restore", "/flows/test-id/restore"},
+		{"OPTIONS /flows/by-handle/{handle}", "/flows/by-handle/basic-login"},
 	}
 
 	for _, tc := range testCases {
@@ -125,7 +129,7 @@ func (s *InitTestSuite) TestRegisterRoutes_AllRoutesRegistered() {
 
 func (s *InitTestSuite) TestRegisterRoutes_CORSHeadersConfigured() {
 	mux := http.NewServeMux()
-	handler := newFlowMgtHandler(s.mockService)
+	handler := newFlowMgtHandler(s.mockService, jsonstream.Limits{}, 0)
 
 	registerRoutes(mux, handler)
 
@@ -190,7 +194,7 @@ func (s *InitTestSuite) TestRegisterRoutes_CORSHeadersConfigured() {
 
 func (s *InitTestSuite) TestRegisterRoutes_OPTIONSHandlers() {
 	mux := http.NewServeMux()
-	handler := newFlowMgtHandler(s.mockService)
+	handler := newFlowMgtHandler(s.mockService, jsonstream.Limits{}, 0)
 
 	registerRoutes(mux, handler)
 
@@ -224,9 +228,39 @@ func (s *InitTestSuite) TestRegisterRoutes_WithNilHandler() {
 	}, "Should not panic when handler is nil during registration")
 }
 
+func (s *InitTestSuite) TestRegisterRoutes_GetRequestsAreDispatchedByPath() {
+	mux := http.NewServeMux()
+	registerRoutes(mux, newFlowMgtHandler(s.mockService, jsonstream.Limits{}, 0))
+
+	s.mockService.EXPECT().GetFlowByHandle(mock.Anything, "basic-login", common.FlowTypeAuthentication).
+		Return(&CompleteFlowDefinition{ID: testFlowIDInit}, nil).Once()
+	s.mockService.EXPECT().GetFlow(mock.Anything, testFlowIDInit).
+		Return(&CompleteFlowDefinition{ID: testFlowIDInit}, nil).Once()
+	s.mockService.EXPECT().ListFlowVersions(mock.Anything, testFlowIDInit).
+		Return(&FlowVersionListResponse{}, nil).Once()
+	s.mockService.EXPECT().GetFlowVersion(mock.Anything, testFlowIDInit, 2).
+		Return(&FlowVersion{}, nil).Once()
+
+	paths := []string{
+		"/flows/by-handle/basic-login?flowType=AUTHENTICATION",
+		"/flows/" + testFlowIDInit,
+		"/flows/" + testFlowIDInit + "/versions",
+		"/flows/" + testFlowIDInit + "/versions/2",
+	}
+	for _, path := range paths {
+		w := httptest.NewRecorder()
+		mux.ServeHTTP(w, httptest.NewRequest(http.MethodGet, path, nil))
+		s.Equal(http.StatusOK, w.Code, path)
+	}
+
+	w := httptest.NewRecorder()
+	mux.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/flows/"+testFlowIDInit+"/unknown", nil))
+	s.Equal(http.StatusNotFound, w.Code)
+}
+
 func (s *InitTestSuite) TestRegisterRoutes_PreflightRequests() {
 	mux := http.NewServeMux()
-	handler := newFlowMgtHandler(s.mockService)
+	handler := newFlowMgtHandler(s.mockService, jsonstream.Limits{}, 0)
 
 	registerRoutes(mux, handler)
 
@@ -28,6 +28,7 @@ import (
 
 	"github.com/thunder-id/thunderid/internal/flow/common"
 	"github.com/thunder-id/thunderid/internal/system/mcp/tool"
+	"github.com/thunder-id/thunderid/internal/system/utils"
 )
 
 // FlowDefinition represents the structure of a flow definition.
@@ -79,7 +80,7 @@ type FlowListResponse struct {
 	StartIndex   int                   `json:"startIndex" jsonschema:"Starting index of the current page."`
 	Count        int                   `json:"count" jsonschema:"Number of flows in the current page."`
 	Flows        []BasicFlowDefinition `json:"flows" jsonschema:"List of flow definitions."`
-	Links        []Link                `json:"links" jsonschema:"Pagination links."`
+	Links        []utils.Link          `json:"links" jsonschema:"Pagination links."`
 }
 
 // FlowVersion represents a specific version of a flow definition.
@@ -112,12 +113,6 @@ type RestoreVersionRequest struct {
 	Version int `json:"version" validate:"required"`
 }
 
-// Link represents a hypermedia link for pagination.
-type Link struct {
-	Href string `json:"href"`
-	Rel  string `json:"rel"`
-}
-
 // NodeLayout represents the layout information for a node in the flow composer UI.
 type NodeLayout struct {
 	Size     *NodeSize     `json:"size,omitempty" yaml:"size,omitempty" jsonschema:"Dimensions of the node."`
@@ -275,3 +270,28 @@ type segmentBoundary struct {
 	boundaryNodeID string
 	nextNodeID     string
 }
+
+// FlowNodeType describes a node type that can be used in a flow definition.
+type FlowNodeType struct {
+	Type          common.NodeType        `json:"type"`
+	Executor      string                 `json:"executor,omitempty"`
+	DisplayName   string                 `json:"displayName"`
+	Description   string                 `json:"description,omitempty"`
+	Category      string                 `json:"category"`
+	Modes         []string               `json:"modes,omitempty"`
+	ConfigSchema  map[string]interface{} `json:"configSchema"`
+	Inputs        []common.Input         `json:"inputs"`
+	Prerequisites []common.Input         `json:"prerequisites"`
+}
+
+// FlowNodeCategory groups the node types of a category.
+type FlowNodeCategory struct {
+	Name      string         `json:"name"`
+	NodeTypes []FlowNodeType `json:"nodeTypes"`
+}
+
+// FlowNodeTypeListResponse represents the catalog of node types available to flow definitions.
+type FlowNodeTypeListResponse struct {
+	TotalResults int                `json:"totalResults"`
+	Categories   []FlowNodeCategory `json:"categories"`
+}
@@ -0,0 +1,88 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package flowmgt
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/thunder-id/thunderid/internal/system/jsonstream"
+)
+
+// decodeFlowDefinitionRequest decodes a flow definition request body as a stream. Nodes are decoded and
+// validated one at a time, so that a request with too many nodes or an invalid node is rejected at the
+// offending node without reading the rest of the body. A zero maxNodes does not limit the node count.
+func decodeFlowDefinitionRequest(
+	body io.Reader, limits jsonstream.Limits, maxNodes int,
+) (*FlowDefinitionRequest, error) {
+	decoder := jsonstream.NewDecoder(body, limits)
+	request := &FlowDefinitionRequest{}
+
+	err := decoder.DecodeObject(func(name string) error {
+		switch name {
+		case "handle":
+			return decoder.Decode(&request.Handle)
+		case "name":
+			return decoder.Decode(&request.Name)
+		case "flowType":
+			return decoder.Decode(&request.FlowType)
+		case "nodes":
+			return decodeFlowNodes(decoder, request, maxNodes)
+		default:
+			return decoder.Decode(nil)
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := decoder.End(); err != nil {
+		return nil, err
+	}
+
+	return request, nil
+}
+
+// decodeFlowNodes decodes the nodes array of a flow definition request, rejecting nodes without an ID
+// and nodes that reuse the ID of an earlier node.
+func decodeFlowNodes(decoder *jsonstream.Decoder, request *FlowDefinitionRequest, maxNodes int) error {
+	firstIndexes := make(map[string]int)
+
+	return decoder.DecodeArray(func(index int) error {
+		if maxNodes > 0 && index >= maxNodes {
+			return decoder.Fail(jsonstream.ErrorKindLimit,
+				fmt.Sprintf("flow definition has more than %d nodes", maxNodes))
+		}
+
+		var node NodeDefinition
+		if err := decoder.Decode(&node); err != nil {
+			return err
+		}
+		if node.ID == "" {
+			return decoder.Fail(jsonstream.ErrorKindInvalid, "node id is required", "id")
+		}
+		if firstIndex, exists := firstIndexes[node.ID]; exists {
+			return decoder.Fail(jsonstream.ErrorKindInvalid,
+				fmt.Sprintf("node id %q is already used by the node at /nodes/%d", node.ID, firstIndex), "id")
+		}
+
+		firstIndexes[node.ID] = index
+		request.Nodes = append(request.Nodes, node)
+		return nil
+	})
+}
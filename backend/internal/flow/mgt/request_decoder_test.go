@@ -0,0 +1,99 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package flowmgt
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/flow/common"
+	"github.com/thunder-id/thunderid/internal/system/jsonstream"
+)
+
+type RequestDecoderTestSuite struct {
+	suite.Suite
+}
+
+func TestRequestDecoderTestSuite(t *testing.T) {
+	suite.Run(t, new(RequestDecoderTestSuite))
+}
+
+func (s *RequestDecoderTestSuite) requireStreamError(err error) *jsonstream.Error {
+	var streamErr *jsonstream.Error
+	s.Require().True(errors.As(err, &streamErr), "expected a stream error, got %v", err)
+	return streamErr
+}
+
+func (s *RequestDecoderTestSuite) TestDecodeFlowDefinitionRequest_Success() {
+	body := `{"handle":"basic","name":"Basic","flowType":"AUTHENTICATION","unknown":{"a":1},"nodes":[` +
+		`{"id":"start","type":"START","onSuccess":"prompt"},` +
+		`{"id":"prompt","type":"PROMPT","meta":{"components":[{"id":"c1"}]},"next":"end"},` +
+		`{"id":"end","type":"END"}]}`
+
+	request, err := decodeFlowDefinitionRequest(strings.NewReader(body), jsonstream.Limits{MaxDepth: 6}, 3)
+
+	s.Require().NoError(err)
+	s.Equal("basic", request.Handle)
+	s.Equal("Basic", request.Name)
+	s.Equal(common.FlowTypeAuthentication, request.FlowType)
+	s.Require().Len(request.Nodes, 3)
+	s.Equal("prompt", request.Nodes[0].OnSuccess)
+	s.NotNil(request.Nodes[1].Meta)
+	s.Equal("end", request.Nodes[2].ID)
+}
+
+func (s *RequestDecoderTestSuite) TestDecodeFlowDefinitionRequest_Rejections() {
+	testCases := []struct {
+		name     string
+		body     string
+		maxNodes int
+		kind     jsonstream.ErrorKind
+		pointer  string
+	}{
+		{"TooManyNodes", `{"nodes":[{"id":"a"},{"id":"b"},{"id":"c"}]}`, 2, jsonstream.ErrorKindLimit, "/nodes/2"},
+		{"MissingNodeID", `{"nodes":[{"id":"a"},{"type":"END"}]}`, 0, jsonstream.ErrorKindInvalid, "/nodes/1/id"},
+		{"DuplicateNodeID", `{"nodes":[{"id":"a"},{"id":"b"},{"id":"a"}]}`, 0, jsonstream.ErrorKindInvalid,
+			"/nodes/2/id"},
+		{"WrongFieldType", `{"nodes":[{"id":"a","prompts":{}}]}`, 0, jsonstream.ErrorKindSyntax, "/nodes/0/prompts"},
+		{"NodesNotAnArray", `{"nodes":"start"}`, 0, jsonstream.ErrorKindSyntax, "/nodes"},
+		{"Truncated", `{"handle":"basic","nodes":[{"id":"a"}`, 0, jsonstream.ErrorKindSyntax, "/nodes/1"},
+	}
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			_, err := decodeFlowDefinitionRequest(strings.NewReader(tc.body), jsonstream.Limits{}, tc.maxNodes)
+
+			streamErr := s.requireStreamError(err)
+			s.Equal(tc.kind, streamErr.Kind)
+			s.Equal(tc.pointer, streamErr.Pointer)
+		})
+	}
+}
+
+func (s *RequestDecoderTestSuite) TestDecodeFlowDefinitionRequest_EnforcesJSONLimits() {
+	body := `{"nodes":[{"id":"a","meta":{"components":[{"id":"c1"}]}}]}`
+
+	_, err := decodeFlowDefinitionRequest(strings.NewReader(body), jsonstream.Limits{MaxDepth: 4}, 0)
+
+	streamErr := s.requireStreamError(err)
+	s.Equal(jsonstream.ErrorKindLimit, streamErr.Kind)
+	s.Equal("/nodes/0/meta/components", streamErr.Pointer)
+}
@@ -22,16 +22,20 @@ package flowmgt
 import (
 	"context"
 	"errors"
-	"fmt"
 	"regexp"
 
 	"github.com/thunder-id/thunderid/internal/flow/common"
 	"github.com/thunder-id/thunderid/internal/flow/core"
 	"github.com/thunder-id/thunderid/internal/flow/executor"
+	"github.com/thunder-id/thunderid/internal/quota"
 	"github.com/thunder-id/thunderid/internal/system/config"
+	sysContext "github.com/thunder-id/thunderid/internal/system/context"
 	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
 	i18ncore "github.com/thunder-id/thunderid/internal/system/i18n/core"
 	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/internal/system/observability"
+	"github.com/thunder-id/thunderid/internal/system/observability/event"
+	"github.com/thunder-id/thunderid/internal/system/security"
 	"github.com/thunder-id/thunderid/internal/system/transaction"
 	"github.com/thunder-id/thunderid/internal/system/utils"
 )
@@ -67,6 +71,7 @@ type FlowMgtServiceInterface interface {
 		*CompleteFlowDefinition, *serviceerror.ServiceError)
 	GetGraph(ctx context.Context, flowID string) (core.GraphInterface, *serviceerror.ServiceError)
 	IsValidFlow(ctx context.Context, flowID string, flowType common.FlowType) (bool, *serviceerror.ServiceError)
+	ListNodeTypes(ctx context.Context) (*FlowNodeTypeListResponse, *serviceerror.ServiceError)
 }
 
 // flowMgtService is the default implementation of the FlowMgtServiceInterface.
@@ -77,6 +82,8 @@ type flowMgtService struct {
 	executorRegistry executor.ExecutorRegistryInterface
 	compositeStore   *compositeFlowStore
 	transactioner    transaction.Transactioner
+	quotaService     quota.QuotaServiceInterface
+	observabilitySvc observability.ObservabilityServiceInterface
 	logger           *log.Logger
 }
 
@@ -88,6 +95,8 @@ func newFlowMgtService(
 	executorRegistry executor.ExecutorRegistryInterface,
 	compositeStore *compositeFlowStore,
 	transactioner transaction.Transactioner,
+	quotaService quota.QuotaServiceInterface,
+	observabilitySvc observability.ObservabilityServiceInterface,
 ) FlowMgtServiceInterface {
 	return &flowMgtService{
 		store:            store,
@@ -96,6 +105,8 @@ func newFlowMgtService(
 		executorRegistry: executorRegistry,
 		compositeStore:   compositeStore,
 		transactioner:    transactioner,
+		quotaService:     quotaService,
+		observabilitySvc: observabilitySvc,
 		logger:           log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName)),
 	}
 }
@@ -130,7 +141,7 @@ func (s *flowMgtService) ListFlows(ctx context.Context, limit, offset int, flowT
 		StartIndex:   offset + 1,
 		Count:        len(flows),
 		Flows:        flows,
-		Links:        buildPaginationLinks(limit, offset, totalCount),
+		Links:        utils.BuildPaginationLinks("/flows", limit, offset, totalCount, ""),
 	}
 
 	return listResponse, nil
@@ -142,6 +153,15 @@ func (s *flowMgtService) CreateFlow(ctx context.Context, flowDef *FlowDefinition
 	if err := validateFlowDefinition(flowDef); err != nil {
 		return nil, err
 	}
+	if s.quotaService != nil {
+		if err := s.quotaService.CheckQuota(ctx, quota.ResourceTypeFlows, quota.TenantScope); err != nil {
+			if errors.Is(err, quota.ErrQuotaExceeded) {
+				return nil, &ErrorFlowQuotaExceeded
+			}
+			s.logger.Error("Failed to check the flow quota", log.Error(err))
+			return nil, &serviceerror.InternalServerError
+		}
+	}
 
 	flowID := flowDef.ID
 	if flowID == "" {
@@ -189,6 +209,7 @@ func (s *flowMgtService) CreateFlow(ctx context.Context, flowDef *FlowDefinition
 	}
 
 	s.logger.Debug("Flow created successfully", log.String(logKeyFlowID, flowID))
+	s.publishFlowEvent(ctx, event.EventTypeFlowDefinitionCreated, flowID, flowDef.FlowType)
 
 	s.tryInferRegistrationFlow(ctx, flowID, flowDef)
 
@@ -291,6 +312,7 @@ func (s *flowMgtService) UpdateFlow(ctx context.Context, flowID string, flowDef
 	}
 
 	logger.Debug("Flow updated successfully")
+	s.publishFlowEvent(ctx, event.EventTypeFlowDefinitionUpdated, flowID, flowDef.FlowType)
 
 	// Invalidate the cached graph since the flow has been updated
 	s.graphBuilder.InvalidateCache(ctx, flowID)
@@ -327,6 +349,7 @@ func (s *flowMgtService) DeleteFlow(ctx context.Context, flowID string) *service
 	}
 
 	logger.Debug("Flow deleted successfully")
+	s.publishFlowEvent(ctx, event.EventTypeFlowDefinitionDeleted, flowID, existingFlow.FlowType)
 
 	// Invalidate the cached graph since the flow has been deleted
 	s.graphBuilder.InvalidateCache(ctx, flowID)
@@ -429,6 +452,7 @@ func (s *flowMgtService) RestoreFlowVersion(ctx context.Context, flowID string,
 	}
 
 	logger.Debug("Flow version restored successfully")
+	s.publishFlowEvent(ctx, event.EventTypeFlowDefinitionUpdated, flowID, restoredFlow.FlowType)
 
 	// Invalidate the cached graph since a version has been restored
 	s.graphBuilder.InvalidateCache(ctx, flowID)
@@ -436,6 +460,24 @@ func (s *flowMgtService) RestoreFlowVersion(ctx context.Context, flowID string,
 	return restoredFlow, nil
 }
 
+// publishFlowEvent records a change to a flow definition in the audit trail.
+func (s *flowMgtService) publishFlowEvent(
+	ctx context.Context, eventType event.EventType, flowID string, flowType common.FlowType) {
+	if s.observabilitySvc == nil || !s.observabilitySvc.IsEnabled() {
+		return
+	}
+
+	evt := event.NewEvent(sysContext.GetTraceID(ctx), string(eventType), event.ComponentFlowManagement).
+		WithTenantID(sysContext.GetTenantID(ctx)).
+		WithStatus(event.StatusSuccess).
+		WithData(event.DataKey.FlowID, flowID).
+		WithData(event.DataKey.FlowType, string(flowType))
+	if actorID := security.GetSubject(ctx); actorID != "" {
+		evt.WithData(event.DataKey.ActorID, actorID)
+	}
+	s.observabilitySvc.PublishEvent(evt)
+}
+
 // Graph building methods
 
 // GetGraph retrieves or builds a graph for the given flow ID.
@@ -479,56 +521,77 @@ func (s *flowMgtService) IsValidFlow(
 	return flow.FlowType == flowType, nil
 }
 
-// Helper functions
-
-// isValidFlowType checks if the provided flow type is valid.
-func isValidFlowType(flowType common.FlowType) bool {
-	return flowType == common.FlowTypeAuthentication ||
-		flowType == common.FlowTypeRegistration ||
-		flowType == common.FlowTypeUserOnboarding ||
-		flowType == common.FlowTypeRecovery
-}
-
-// buildPaginationLinks constructs pagination links for the flow list response.
-func buildPaginationLinks(limit, offset, totalCount int) []Link {
-	links := make([]Link, 0)
-
-	// Add first and previous links if not on first page
-	if offset > 0 {
-		links = append(links, Link{
-			Href: fmt.Sprintf("/flows?offset=0&limit=%d", limit),
-			Rel:  "first",
+// ListNodeTypes returns the catalog of node types available to flow definitions, grouped by category.
+// Task execution node types are generated from the executors registered in the flow engine.
+func (s *flowMgtService) ListNodeTypes(ctx context.Context) (*FlowNodeTypeListResponse, *serviceerror.ServiceError) {
+	nodeTypes := []FlowNodeType{
+		newStaticNodeType(common.NodeTypeStart, "Start", "Entry point of the flow.", nodeCategoryControl),
+		newStaticNodeType(common.NodeTypeEnd, "End", "Completes the flow.", nodeCategoryControl),
+		newStaticNodeType(common.NodeTypePrompt, "Prompt",
+			"Renders a view to the user and collects the inputs and action chosen.", nodeCategoryInteraction),
+	}
+	for _, exec := range s.executorRegistry.ListExecutors() {
+		descriptor := executor.GetExecutorDescriptor(exec.GetName())
+		nodeTypes = append(nodeTypes, FlowNodeType{
+			Type:          common.NodeTypeTaskExecution,
+			Executor:      exec.GetName(),
+			DisplayName:   descriptor.DisplayName,
+			Description:   descriptor.Description,
+			Category:      string(exec.GetType()),
+			Modes:         descriptor.Modes,
+			ConfigSchema:  descriptor.ConfigSchema(),
+			Inputs:        nonNilInputs(exec.GetDefaultInputs()),
+			Prerequisites: nonNilInputs(exec.GetPrerequisites()),
 		})
+	}
 
-		prevOffset := offset - limit
-		if prevOffset < 0 {
-			prevOffset = 0
+	categories := make([]FlowNodeCategory, 0)
+	categoryIndex := make(map[string]int)
+	for _, nodeType := range nodeTypes {
+		idx, ok := categoryIndex[nodeType.Category]
+		if !ok {
+			idx = len(categories)
+			categoryIndex[nodeType.Category] = idx
+			categories = append(categories, FlowNodeCategory{Name: nodeType.Category})
 		}
-		links = append(links, Link{
-			Href: fmt.Sprintf("/flows?offset=%d&limit=%d", prevOffset, limit),
-			Rel:  "prev",
-		})
+		categories[idx].NodeTypes = append(categories[idx].NodeTypes, nodeType)
 	}
 
-	// Add next link if there are more results
-	if offset+limit < totalCount {
-		nextOffset := offset + limit
-		links = append(links, Link{
-			Href: fmt.Sprintf("/flows?offset=%d&limit=%d", nextOffset, limit),
-			Rel:  "next",
-		})
+	return &FlowNodeTypeListResponse{
+		TotalResults: len(nodeTypes),
+		Categories:   categories,
+	}, nil
+}
+
+// Helper functions
+
+// newStaticNodeType builds a catalog entry for a node type that is not backed by an executor.
+func newStaticNodeType(nodeType common.NodeType, displayName, description, category string) FlowNodeType {
+	return FlowNodeType{
+		Type:          nodeType,
+		DisplayName:   displayName,
+		Description:   description,
+		Category:      category,
+		ConfigSchema:  executor.ExecutorDescriptor{}.ConfigSchema(),
+		Inputs:        []common.Input{},
+		Prerequisites: []common.Input{},
 	}
+}
 
-	// Add last link if not on last page
-	lastPageOffset := ((totalCount - 1) / limit) * limit
-	if totalCount > 0 && offset < lastPageOffset {
-		links = append(links, Link{
-			Href: fmt.Sprintf("/flows?offset=%d&limit=%d", lastPageOffset, limit),
-			Rel:  "last",
-		})
+// nonNilInputs returns the given inputs, or an empty slice when nil, so that they serialize as an array.
+func nonNilInputs(inputs []common.Input) []common.Input {
+	if inputs == nil {
+		return []common.Input{}
 	}
+	return inputs
+}
 
-	return links
+// isValidFlowType checks if the provided flow type is valid.
+func isValidFlowType(flowType common.FlowType) bool {
+	return flowType == common.FlowTypeAuthentication ||
+		flowType == common.FlowTypeRegistration ||
+		flowType == common.FlowTypeUserOnboarding ||
+		flowType == common.FlowTypeRecovery
 }
 
 // validateFlowDefinition validates the flow definition request.
@@ -614,6 +677,13 @@ func (s *flowMgtService) tryInferRegistrationFlow(ctx context.Context, authFlowI
 		return
 	}
 
+	if s.quotaService != nil {
+		if err := s.quotaService.CheckQuota(ctx, quota.ResourceTypeFlows, quota.TenantScope); err != nil {
+			logger.Warn("Skipping registration flow inference as the flow quota cannot be met", log.Error(err))
+			return
+		}
+	}
+
 	_, storeErr := s.store.CreateFlow(ctx, regFlowID, regFlowDef)
 	if storeErr != nil {
 		logger.Error("Failed to create inferred registration flow", log.Error(storeErr))
@@ -21,16 +21,22 @@ package flowmgt
 import (
 	"context"
 	"errors"
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 
 	"github.com/thunder-id/thunderid/internal/flow/common"
+	"github.com/thunder-id/thunderid/internal/flow/core"
+	"github.com/thunder-id/thunderid/internal/flow/executor"
+	"github.com/thunder-id/thunderid/internal/quota"
 	"github.com/thunder-id/thunderid/internal/system/config"
 	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
 	"github.com/thunder-id/thunderid/internal/system/utils"
+	"github.com/thunder-id/thunderid/tests/mocks/flow/coremock"
 	"github.com/thunder-id/thunderid/tests/mocks/flow/executormock"
+	"github.com/thunder-id/thunderid/tests/mocks/quotamock"
 )
 
 const testFlowIDService = "test-flow-id"
@@ -61,7 +67,7 @@ func (s *FlowMgtServiceTestSuite) SetupTest() {
 	s.mockGraphBuilder = newGraphBuilderInterfaceMock(s.T())
 	s.mockExecutorRegistry = executormock.NewExecutorRegistryInterfaceMock(s.T())
 	s.service = newFlowMgtService(s.mockStore, s.mockInference, s.mockGraphBuilder,
-		s.mockExecutorRegistry, nil, &stubTransactioner{})
+		s.mockExecutorRegistry, nil, &stubTransactioner{}, nil, nil)
 
 	testConfig := &config.Config{
 		Flow: config.FlowConfig{
@@ -527,6 +533,35 @@ func (s *FlowMgtServiceTestSuite) TestCreateFlow_DuplicateHandle() {
 	s.Equal(&ErrorDuplicateFlowHandle, err)
 }
 
+func (s *FlowMgtServiceTestSuite) TestCreateFlow_QuotaExceeded() {
+	flowDef := &FlowDefinition{
+		Handle:   "test-handle",
+		Name:     "Test Flow",
+		FlowType: common.FlowTypeAuthentication,
+		Nodes:    []NodeDefinition{{Type: "start"}, {Type: "action"}, {Type: "end"}},
+	}
+	quotaService := quotamock.NewQuotaServiceInterfaceMock(s.T())
+	quotaService.EXPECT().CheckQuota(mock.Anything, quota.ResourceTypeFlows, quota.TenantScope).Return(
+		fmt.Errorf("%w: the flows quota of the tenant allows at most 5", quota.ErrQuotaExceeded))
+	service := newFlowMgtService(s.mockStore, s.mockInference, s.mockGraphBuilder,
+		s.mockExecutorRegistry, nil, &stubTransactioner{}, quotaService, nil)
+
+	result, err := service.CreateFlow(context.Background(), flowDef)
+
+	s.Nil(result)
+	s.Equal(&ErrorFlowQuotaExceeded, err)
+	s.mockStore.AssertNotCalled(s.T(), "CreateFlow", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (s *FlowMgtServiceTestSuite) TestNewUsageCounter() {
+	s.mockStore.EXPECT().ListFlows(mock.Anything, 1, 0, "").Return([]BasicFlowDefinition{}, 6, nil)
+
+	usage, err := newUsageCounter(s.mockStore).CountUsage(context.Background(), "ou-1")
+
+	s.NoError(err)
+	s.Equal(6, usage)
+}
+
 func (s *FlowMgtServiceTestSuite) TestCreateFlow_DuplicateHandleCheckError() {
 	flowDef := &FlowDefinition{
 		Handle:   "test-handle",
@@ -1091,7 +1126,7 @@ func (s *FlowMgtServiceTestSuite) TestTryInferRegistrationFlow_Success() {
 
 	mockExecutorRegistry := executormock.NewExecutorRegistryInterfaceMock(s.T())
 	service := newFlowMgtService(s.mockStore, s.mockInference, s.mockGraphBuilder,
-		mockExecutorRegistry, nil, &stubTransactioner{})
+		mockExecutorRegistry, nil, &stubTransactioner{}, nil, nil)
 
 	authFlowDef := &FlowDefinition{
 		Handle:   "auth-flow",
@@ -1151,7 +1186,7 @@ func (s *FlowMgtServiceTestSuite) TestTryInferRegistrationFlow_SkipsNonAuthFlow(
 
 	mockExecutorRegistry := executormock.NewExecutorRegistryInterfaceMock(s.T())
 	service := newFlowMgtService(s.mockStore, s.mockInference, s.mockGraphBuilder,
-		mockExecutorRegistry, nil, &stubTransactioner{})
+		mockExecutorRegistry, nil, &stubTransactioner{}, nil, nil)
 
 	regFlowDef := &FlowDefinition{
 		Handle:   "reg-flow",
@@ -1179,7 +1214,7 @@ func (s *FlowMgtServiceTestSuite) TestTryInferRegistrationFlow_HandlesInferenceE
 
 	mockExecutorRegistry := executormock.NewExecutorRegistryInterfaceMock(s.T())
 	service := newFlowMgtService(s.mockStore, s.mockInference, s.mockGraphBuilder,
-		mockExecutorRegistry, nil, &stubTransactioner{})
+		mockExecutorRegistry, nil, &stubTransactioner{}, nil, nil)
 
 	authFlowDef := &FlowDefinition{
 		Handle:   "auth-flow",
@@ -1209,7 +1244,7 @@ func (s *FlowMgtServiceTestSuite) TestTryInferRegistrationFlow_HandlesStoreError
 
 	mockExecutorRegistry := executormock.NewExecutorRegistryInterfaceMock(s.T())
 	service := newFlowMgtService(s.mockStore, s.mockInference, s.mockGraphBuilder,
-		mockExecutorRegistry, nil, &stubTransactioner{})
+		mockExecutorRegistry, nil, &stubTransactioner{}, nil, nil)
 
 	authFlowDef := &FlowDefinition{
 		Handle:   "auth-flow",
@@ -1249,7 +1284,7 @@ func (s *FlowMgtServiceTestSuite) TestTryInferRegistrationFlow_DisabledAutoInfer
 	// Auto-inference is disabled in SetupTest, so just verify early return
 	mockExecutorRegistry := executormock.NewExecutorRegistryInterfaceMock(s.T())
 	service := newFlowMgtService(s.mockStore, s.mockInference, s.mockGraphBuilder,
-		mockExecutorRegistry, nil, &stubTransactioner{})
+		mockExecutorRegistry, nil, &stubTransactioner{}, nil, nil)
 
 	authFlowDef := &FlowDefinition{
 		Handle:   "auth-flow",
@@ -1277,7 +1312,7 @@ func (s *FlowMgtServiceTestSuite) TestTryInferRegistrationFlow_SkipsPasskeyRegis
 
 	mockExecutorRegistry := executormock.NewExecutorRegistryInterfaceMock(s.T())
 	service := newFlowMgtService(s.mockStore, s.mockInference, s.mockGraphBuilder,
-		mockExecutorRegistry, nil, &stubTransactioner{})
+		mockExecutorRegistry, nil, &stubTransactioner{}, nil, nil)
 
 	// Auth flow with PasskeyAuthExecutor in register_start and register_finish modes
 	authFlowDef := &FlowDefinition{
@@ -1465,3 +1500,35 @@ func (s *FlowMgtServiceTestSuite) TestDeleteFlow_MutableFlowAllowed() {
 	s.mockStore.AssertExpectations(s.T())
 	s.mockGraphBuilder.AssertExpectations(s.T())
 }
+
+// ListNodeTypes tests
+
+func (s *FlowMgtServiceTestSuite) TestListNodeTypes_GroupsByCategory() {
+	basicAuth := coremock.NewExecutorInterfaceMock(s.T())
+	basicAuth.EXPECT().GetName().Return(executor.ExecutorNameBasicAuth)
+	basicAuth.EXPECT().GetType().Return(common.ExecutorTypeAuthentication)
+	basicAuth.EXPECT().GetDefaultInputs().Return([]common.Input{
+		{Identifier: "username", Type: "TEXT_INPUT", Required: true},
+	})
+	basicAuth.EXPECT().GetPrerequisites().Return(nil)
+	s.mockExecutorRegistry.EXPECT().ListExecutors().Return([]core.ExecutorInterface{basicAuth})
+
+	result, svcErr := s.service.ListNodeTypes(context.Background())
+
+	s.Nil(svcErr)
+	s.Equal(4, result.TotalResults)
+	s.Require().Len(result.Categories, 3)
+	s.Equal(nodeCategoryControl, result.Categories[0].Name)
+	s.Len(result.Categories[0].NodeTypes, 2)
+	s.Equal(nodeCategoryInteraction, result.Categories[1].Name)
+	s.Equal(common.NodeTypePrompt, result.Categories[1].NodeTypes[0].Type)
+
+	s.Equal(string(common.ExecutorTypeAuthentication), result.Categories[2].Name)
+	taskNode := result.Categories[2].NodeTypes[0]
+	s.Equal(common.NodeTypeTaskExecution, taskNode.Type)
+	s.Equal(executor.ExecutorNameBasicAuth, taskNode.Executor)
+	s.Equal("Username and Password", taskNode.DisplayName)
+	s.Len(taskNode.Inputs, 1)
+	s.NotNil(taskNode.Prerequisites)
+	s.Contains(taskNode.ConfigSchema, "properties")
+}
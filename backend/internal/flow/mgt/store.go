@@ -26,6 +26,7 @@ import (
 
 	"github.com/thunder-id/thunderid/internal/flow/common"
 	"github.com/thunder-id/thunderid/internal/system/config"
+	sysContext "github.com/thunder-id/thunderid/internal/system/context"
 	"github.com/thunder-id/thunderid/internal/system/database/provider"
 	"github.com/thunder-id/thunderid/internal/system/log"
 	"github.com/thunder-id/thunderid/internal/system/transaction"
@@ -87,6 +88,7 @@ func newFlowStore() (flowStoreInterface, transaction.Transactioner, error) {
 // ListFlows retrieves a paginated list of flow definitions with optional filtering by flow type.
 func (s *flowStore) ListFlows(ctx context.Context, limit, offset int, flowType string) (
 	[]BasicFlowDefinition, int, error) {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
 	var flows []BasicFlowDefinition
 	var totalCount int
 
@@ -95,22 +97,22 @@ func (s *flowStore) ListFlows(ctx context.Context, limit, offset int, flowType s
 		var err error
 
 		if flowType != "" {
-			countResults, err = dbClient.QueryContext(ctx, queryCountFlowsWithType, flowType, s.deploymentID)
+			countResults, err = dbClient.QueryContext(ctx, queryCountFlowsWithType, flowType, deploymentID)
 			if err != nil {
 				return fmt.Errorf("failed to count flows: %w", err)
 			}
 
-			results, err = dbClient.QueryContext(ctx, queryListFlowsWithType, flowType, s.deploymentID, limit, offset)
+			results, err = dbClient.QueryContext(ctx, queryListFlowsWithType, flowType, deploymentID, limit, offset)
 			if err != nil {
 				return fmt.Errorf("failed to list flows: %w", err)
 			}
 		} else {
-			countResults, err = dbClient.QueryContext(ctx, queryCountFlows, s.deploymentID)
+			countResults, err = dbClient.QueryContext(ctx, queryCountFlows, deploymentID)
 			if err != nil {
 				return fmt.Errorf("failed to count flows: %w", err)
 			}
 
-			results, err = dbClient.QueryContext(ctx, queryListFlows, s.deploymentID, limit, offset)
+			results, err = dbClient.QueryContext(ctx, queryListFlows, deploymentID, limit, offset)
 			if err != nil {
 				return fmt.Errorf("failed to list flows: %w", err)
 			}
@@ -143,6 +145,7 @@ func (s *flowStore) ListFlows(ctx context.Context, limit, offset int, flowType s
 // CreateFlow creates a new flow definition with version 1.
 func (s *flowStore) CreateFlow(ctx context.Context, flowID string, flow *FlowDefinition) (
 	*CompleteFlowDefinition, error) {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
 	nodesJSON, err := json.Marshal(flow.Nodes)
 	if err != nil {
 		return nil, fmt.Errorf("failed to marshal nodes: %w", err)
@@ -150,12 +153,12 @@ func (s *flowStore) CreateFlow(ctx context.Context, flowID string, flow *FlowDef
 
 	err = s.withDBClientContext(ctx, func(dbClient provider.DBClientInterface) error {
 		_, err := dbClient.ExecuteContext(ctx, queryCreateFlow, flowID, flow.Handle,
-			flow.Name, flow.FlowType, int64(1), s.deploymentID)
+			flow.Name, flow.FlowType, int64(1), deploymentID)
 		if err != nil {
 			return fmt.Errorf("failed to create flow: %w", err)
 		}
 
-		_, err = dbClient.ExecuteContext(ctx, queryInsertFlowVersion, flowID, 1, string(nodesJSON), s.deploymentID)
+		_, err = dbClient.ExecuteContext(ctx, queryInsertFlowVersion, flowID, 1, string(nodesJSON), deploymentID)
 		if err != nil {
 			return fmt.Errorf("failed to create flow version: %w", err)
 		}
@@ -171,9 +174,10 @@ func (s *flowStore) CreateFlow(ctx context.Context, flowID string, flow *FlowDef
 
 // GetFlowByID retrieves the active version of a flow definition by its ID.
 func (s *flowStore) GetFlowByID(ctx context.Context, flowID string) (*CompleteFlowDefinition, error) {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
 	var flow *CompleteFlowDefinition
 	err := s.withDBClientContext(ctx, func(dbClient provider.DBClientInterface) error {
-		results, err := dbClient.QueryContext(ctx, queryGetFlow, flowID, s.deploymentID)
+		results, err := dbClient.QueryContext(ctx, queryGetFlow, flowID, deploymentID)
 		if err != nil {
 			return fmt.Errorf("failed to get flow: %w", err)
 		}
@@ -192,9 +196,10 @@ func (s *flowStore) GetFlowByID(ctx context.Context, flowID string) (*CompleteFl
 // GetFlowByHandle retrieves a flow definition by handle and flow type.
 func (s *flowStore) GetFlowByHandle(ctx context.Context, handle string, flowType common.FlowType) (
 	*CompleteFlowDefinition, error) {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
 	var flow *CompleteFlowDefinition
 	err := s.withDBClientContext(ctx, func(dbClient provider.DBClientInterface) error {
-		results, err := dbClient.QueryContext(ctx, queryGetFlowByHandle, handle, string(flowType), s.deploymentID)
+		results, err := dbClient.QueryContext(ctx, queryGetFlowByHandle, handle, string(flowType), deploymentID)
 		if err != nil {
 			return fmt.Errorf("failed to get flow by handle: %w", err)
 		}
@@ -214,13 +219,14 @@ func (s *flowStore) GetFlowByHandle(ctx context.Context, handle string, flowType
 // Automatically deletes oldest versions if the count exceeds max_version_history.
 func (s *flowStore) UpdateFlow(ctx context.Context, flowID string, flow *FlowDefinition) (
 	*CompleteFlowDefinition, error) {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
 	nodesJSON, err := json.Marshal(flow.Nodes)
 	if err != nil {
 		return nil, fmt.Errorf("failed to marshal nodes: %w", err)
 	}
 
 	err = s.withDBClientContext(ctx, func(dbClient provider.DBClientInterface) error {
-		flowResults, err := dbClient.QueryContext(ctx, queryGetFlow, flowID, s.deploymentID)
+		flowResults, err := dbClient.QueryContext(ctx, queryGetFlow, flowID, deploymentID)
 		if err != nil {
 			return fmt.Errorf("failed to get flow metadata: %w", err)
 		}
@@ -240,7 +246,7 @@ func (s *flowStore) UpdateFlow(ctx context.Context, flowID string, flow *FlowDef
 			return err
 		}
 
-		_, err = dbClient.ExecuteContext(ctx, queryUpdateFlow, flowID, flow.Name, newVersion, s.deploymentID)
+		_, err = dbClient.ExecuteContext(ctx, queryUpdateFlow, flowID, flow.Name, newVersion, deploymentID)
 		if err != nil {
 			return fmt.Errorf("failed to update flow: %w", err)
 		}
@@ -256,8 +262,9 @@ func (s *flowStore) UpdateFlow(ctx context.Context, flowID string, flow *FlowDef
 
 // DeleteFlow deletes a flow definition and all its version history.
 func (s *flowStore) DeleteFlow(ctx context.Context, flowID string) error {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
 	return s.withDBClientContext(ctx, func(dbClient provider.DBClientInterface) error {
-		_, err := dbClient.ExecuteContext(ctx, queryDeleteFlow, flowID, s.deploymentID)
+		_, err := dbClient.ExecuteContext(ctx, queryDeleteFlow, flowID, deploymentID)
 		if err != nil {
 			return fmt.Errorf("failed to delete flow: %w", err)
 		}
@@ -267,10 +274,11 @@ func (s *flowStore) DeleteFlow(ctx context.Context, flowID string) error {
 
 // IsFlowExistsByHandle checks if a flow exists with the given handle and flow type.
 func (s *flowStore) IsFlowExistsByHandle(ctx context.Context, handle string, flowType common.FlowType) (bool, error) {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
 	var exists bool
 	err := s.withDBClientContext(ctx, func(dbClient provider.DBClientInterface) error {
 		results, err := dbClient.QueryContext(ctx, queryCheckFlowExistsByHandle,
-			handle, string(flowType), s.deploymentID)
+			handle, string(flowType), deploymentID)
 		if err != nil {
 			return fmt.Errorf("failed to check flow existence by handle: %w", err)
 		}
@@ -284,10 +292,11 @@ func (s *flowStore) IsFlowExistsByHandle(ctx context.Context, handle string, flo
 
 // ListFlowVersions retrieves all versions of a flow definition.
 func (s *flowStore) ListFlowVersions(ctx context.Context, flowID string) ([]BasicFlowVersion, error) {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
 	var versions []BasicFlowVersion
 
 	err := s.withDBClientContext(ctx, func(dbClient provider.DBClientInterface) error {
-		results, err := dbClient.QueryContext(ctx, queryListFlowVersions, flowID, s.deploymentID)
+		results, err := dbClient.QueryContext(ctx, queryListFlowVersions, flowID, deploymentID)
 		if err != nil {
 			return fmt.Errorf("failed to list flow versions: %w", err)
 		}
@@ -309,10 +318,11 @@ func (s *flowStore) ListFlowVersions(ctx context.Context, flowID string) ([]Basi
 
 // GetFlowVersion retrieves a specific version of a flow definition.
 func (s *flowStore) GetFlowVersion(ctx context.Context, flowID string, version int) (*FlowVersion, error) {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
 	var flowVersion *FlowVersion
 
 	err := s.withDBClientContext(ctx, func(dbClient provider.DBClientInterface) error {
-		results, err := dbClient.QueryContext(ctx, queryGetFlowVersionWithMetadata, flowID, version, s.deploymentID)
+		results, err := dbClient.QueryContext(ctx, queryGetFlowVersionWithMetadata, flowID, version, deploymentID)
 		if err != nil {
 			return fmt.Errorf("failed to get flow version: %w", err)
 		}
@@ -332,8 +342,9 @@ func (s *flowStore) GetFlowVersion(ctx context.Context, flowID string, version i
 // Automatically deletes oldest versions if the count exceeds max_version_history.
 func (s *flowStore) RestoreFlowVersion(ctx context.Context, flowID string, version int) (
 	*CompleteFlowDefinition, error) {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
 	err := s.withDBClientContext(ctx, func(dbClient provider.DBClientInterface) error {
-		flowResults, err := dbClient.QueryContext(ctx, queryGetFlow, flowID, s.deploymentID)
+		flowResults, err := dbClient.QueryContext(ctx, queryGetFlow, flowID, deploymentID)
 		if err != nil {
 			return fmt.Errorf("failed to get flow metadata: %w", err)
 		}
@@ -346,7 +357,7 @@ func (s *flowStore) RestoreFlowVersion(ctx context.Context, flowID string, versi
 			return errFlowNotFound
 		}
 
-		versionResults, err := dbClient.QueryContext(ctx, queryGetFlowVersion, flowID, version, s.deploymentID)
+		versionResults, err := dbClient.QueryContext(ctx, queryGetFlowVersion, flowID, version, deploymentID)
 		if err != nil {
 			return fmt.Errorf("failed to get version to restore: %w", err)
 		}
@@ -366,7 +377,7 @@ func (s *flowStore) RestoreFlowVersion(ctx context.Context, flowID string, versi
 			return err
 		}
 
-		_, err = dbClient.ExecuteContext(ctx, queryUpdateFlow, flowID, currentFlow.Name, newVersion, s.deploymentID)
+		_, err = dbClient.ExecuteContext(ctx, queryUpdateFlow, flowID, currentFlow.Name, newVersion, deploymentID)
 		if err != nil {
 			return fmt.Errorf("failed to update flow: %w", err)
 		}
@@ -384,12 +395,13 @@ func (s *flowStore) RestoreFlowVersion(ctx context.Context, flowID string, versi
 // if the count exceeds max_version_history.
 func (s *flowStore) pushToVersionStack(ctx context.Context, dbClient provider.DBClientInterface,
 	flowID string, version int, nodesJSON string) error {
-	_, err := dbClient.ExecuteContext(ctx, queryInsertFlowVersion, flowID, version, nodesJSON, s.deploymentID)
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
+	_, err := dbClient.ExecuteContext(ctx, queryInsertFlowVersion, flowID, version, nodesJSON, deploymentID)
 	if err != nil {
 		return fmt.Errorf("failed to insert flow version: %w", err)
 	}
 
-	countResults, err := dbClient.QueryContext(ctx, queryCountFlowVersions, flowID, s.deploymentID)
+	countResults, err := dbClient.QueryContext(ctx, queryCountFlowVersions, flowID, deploymentID)
 	if err != nil {
 		return fmt.Errorf("failed to count versions: %w", err)
 	}
@@ -400,7 +412,7 @@ func (s *flowStore) pushToVersionStack(ctx context.Context, dbClient provider.DB
 	}
 
 	if versionCount > s.maxVersionHistory {
-		if _, err := dbClient.ExecuteContext(ctx, queryDeleteOldestVersion, flowID, s.deploymentID); err != nil {
+		if _, err := dbClient.ExecuteContext(ctx, queryDeleteOldestVersion, flowID, deploymentID); err != nil {
 			return fmt.Errorf("failed to delete oldest version: %w", err)
 		}
 	}
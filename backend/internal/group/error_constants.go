@@ -184,6 +184,19 @@ var (
 			DefaultValue: "The member type must be 'user', 'group', or 'app'",
 		},
 	}
+	// ErrorGroupQuotaExceeded is the error returned when creating a group would exceed the group quota.
+	ErrorGroupQuotaExceeded = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "GRP-1015",
+		Error: core.I18nMessage{
+			Key:          "error.groupservice.quota_exceeded",
+			DefaultValue: "Quota exceeded",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.groupservice.quota_exceeded_description",
+			DefaultValue: "The group quota of the organization unit or the tenant has been reached",
+		},
+	}
 )
 
 // Server errors for group management operations.
@@ -20,10 +20,7 @@ package group
 
 import (
 	"net/http"
-	"net/url"
-	"strconv"
 
-	serverconst "github.com/thunder-id/thunderid/internal/system/constants"
 	"github.com/thunder-id/thunderid/internal/system/error/apierror"
 	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
 	"github.com/thunder-id/thunderid/internal/system/i18n/core"
@@ -51,15 +48,17 @@ func (gh *groupHandler) HandleGroupListRequest(w http.ResponseWriter, r *http.Re
 
 	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, handlerLoggerComponentName))
 
-	limit, offset, svcErr := parsePaginationParams(r.URL.Query())
+	pagination, svcErr := sysutils.ParsePaginationParams(r.URL.Query(), &ErrorInvalidLimit, &ErrorInvalidOffset)
 	if svcErr != nil {
 		gh.handleError(w, svcErr)
 		return
 	}
+	limit, offset := pagination.Limit, pagination.Offset
 
 	includeDisplay := r.URL.Query().Get(sysutils.QueryParamInclude) == sysutils.IncludeValueDisplay
 
-	groupListResponse, svcErr := gh.groupService.GetGroupList(ctx, limit, offset, includeDisplay)
+	groupListResponse, svcErr := gh.groupService.GetGroupList(
+		sysutils.WithSkipCount(ctx, pagination.SkipCount), limit, offset, includeDisplay)
 	if svcErr != nil {
 		gh.handleError(w, svcErr)
 		return
@@ -84,11 +83,12 @@ func (gh *groupHandler) HandleGroupListByPathRequest(w http.ResponseWriter, r *h
 		return
 	}
 
-	limit, offset, svcErr := parsePaginationParams(r.URL.Query())
+	pagination, svcErr := sysutils.ParsePaginationParams(r.URL.Query(), &ErrorInvalidLimit, &ErrorInvalidOffset)
 	if svcErr != nil {
 		gh.handleError(w, svcErr)
 		return
 	}
+	limit, offset := pagination.Limit, pagination.Offset
 
 	includeDisplay := r.URL.Query().Get(sysutils.QueryParamInclude) == sysutils.IncludeValueDisplay
 
@@ -289,11 +289,12 @@ func (gh *groupHandler) HandleGroupMembersGetRequest(w http.ResponseWriter, r *h
 		return
 	}
 
-	limit, offset, svcErr := parsePaginationParams(r.URL.Query())
+	pagination, svcErr := sysutils.ParsePaginationParams(r.URL.Query(), &ErrorInvalidLimit, &ErrorInvalidOffset)
 	if svcErr != nil {
 		gh.handleError(w, svcErr)
 		return
 	}
+	limit, offset := pagination.Limit, pagination.Offset
 
 	includeDisplay := r.URL.Query().Get(sysutils.QueryParamInclude) == sysutils.IncludeValueDisplay
 
@@ -376,7 +377,7 @@ func (gh *groupHandler) handleError(w http.ResponseWriter, svcErr *serviceerror.
 		switch svcErr.Code {
 		case ErrorGroupNotFound.Code:
 			statusCode = http.StatusNotFound
-		case ErrorGroupNameConflict.Code:
+		case ErrorGroupNameConflict.Code, ErrorGroupQuotaExceeded.Code:
 			statusCode = http.StatusConflict
 		case ErrorInvalidOUID.Code, ErrorCannotDeleteGroup.Code,
 			ErrorInvalidRequestFormat.Code, ErrorMissingGroupID.Code,
@@ -446,34 +447,6 @@ func (gh *groupHandler) sanitizeMembersRequest(request *MembersRequest) MembersR
 	return sanitized
 }
 
-// parsePaginationParams parses limit and offset query parameters from the request.
-func parsePaginationParams(query url.Values) (int, int, *serviceerror.ServiceError) {
-	limit := 0
-	offset := 0
-
-	if limitStr := query.Get("limit"); limitStr != "" {
-		if parsedLimit, err := strconv.Atoi(limitStr); err != nil {
-			return 0, 0, &ErrorInvalidLimit
-		} else {
-			limit = parsedLimit
-		}
-	}
-
-	if offsetStr := query.Get("offset"); offsetStr != "" {
-		if parsedOffset, err := strconv.Atoi(offsetStr); err != nil {
-			return 0, 0, &ErrorInvalidOffset
-		} else {
-			offset = parsedOffset
-		}
-	}
-
-	if limit == 0 {
-		limit = serverconst.DefaultPageSize
-	}
-
-	return limit, offset, nil
-}
-
 // extractAndValidatePath extracts and validates the path parameter from the request.
 func extractAndValidatePath(w http.ResponseWriter, r *http.Request) (string, bool) {
 	path := r.PathValue("path")
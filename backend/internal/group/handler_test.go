@@ -37,6 +37,7 @@ import (
 	"github.com/thunder-id/thunderid/internal/system/error/apierror"
 	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
 	i18ncore "github.com/thunder-id/thunderid/internal/system/i18n/core"
+	sysutils "github.com/thunder-id/thunderid/internal/system/utils"
 )
 
 // testEncodingErrorBody is the expected response body when a response write fails mid-encode.
@@ -1395,13 +1396,13 @@ func (suite *GroupHandlerTestSuite) TestGroupHandler_HandleGroupMembersGetReques
 
 func (suite *GroupHandlerTestSuite) TestGroupHandler_ParsePaginationParamsInvalidOffset() {
 	t := suite.T()
-	limit, offset, err := parsePaginationParams(mapStringToValues(map[string]string{
+	pagination, err := sysutils.ParsePaginationParams(mapStringToValues(map[string]string{
 		"limit":  "10",
 		"offset": "abc",
-	}))
+	}), &ErrorInvalidLimit, &ErrorInvalidOffset)
 
-	require.Zero(t, limit)
-	require.Zero(t, offset)
+	require.Zero(t, pagination.Limit)
+	require.Zero(t, pagination.Offset)
 	require.NotNil(t, err)
 	require.Equal(t, ErrorInvalidOffset, *err)
 }
@@ -19,12 +19,14 @@
 package group
 
 import (
+	"context"
 	"net/http"
 	"strings"
 
 	"github.com/thunder-id/thunderid/internal/entity"
 	"github.com/thunder-id/thunderid/internal/entitytype"
 	oupkg "github.com/thunder-id/thunderid/internal/ou"
+	"github.com/thunder-id/thunderid/internal/quota"
 	"github.com/thunder-id/thunderid/internal/system/database/provider"
 	declarativeresource "github.com/thunder-id/thunderid/internal/system/declarative_resource"
 	"github.com/thunder-id/thunderid/internal/system/middleware"
@@ -39,6 +41,7 @@ func Initialize(
 	entityService entity.EntityServiceInterface,
 	entityTypeService entitytype.EntityTypeServiceInterface,
 	authzService sysauthz.SystemAuthorizationServiceInterface,
+	quotaService quota.QuotaServiceInterface,
 ) (GroupServiceInterface, oupkg.OUGroupResolver, declarativeresource.ResourceExporter, error) {
 	transactioner, err := dbProvider.GetUserDBTransactioner()
 	if err != nil {
@@ -47,8 +50,11 @@ func Initialize(
 
 	groupStore := newGroupStore()
 	groupService := newGroupServiceWithStore(
-		groupStore, ouService, entityService, entityTypeService, authzService, transactioner,
+		groupStore, ouService, entityService, entityTypeService, authzService, quotaService, transactioner,
 	)
+	if quotaService != nil {
+		quotaService.RegisterUsageCounter(quota.ResourceTypeGroups, newUsageCounter(groupStore))
+	}
 
 	// Create resolver for OU package to query group data without cross-DB access
 	ouGroupResolver := newOUGroupResolver(groupStore)
@@ -60,6 +66,16 @@ func Initialize(
 	return groupService, ouGroupResolver, exporter, nil
 }
 
+// newUsageCounter creates a quota usage counter of the groups. The tenant scope counts every group.
+func newUsageCounter(groupStore groupStoreInterface) quota.UsageCounter {
+	return quota.UsageCounterFunc(func(ctx context.Context, scope string) (int, error) {
+		if scope == quota.TenantScope {
+			return groupStore.GetGroupListCount(ctx)
+		}
+		return groupStore.GetGroupsByOrganizationUnitCount(ctx, scope)
+	})
+}
+
 // registerRoutes registers the routes for group management operations.
 func registerRoutes(mux *http.ServeMux, groupHandler *groupHandler) {
 	opts1 := middleware.CORSOptions{
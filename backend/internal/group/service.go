@@ -29,6 +29,7 @@ import (
 	"github.com/thunder-id/thunderid/internal/entity"
 	"github.com/thunder-id/thunderid/internal/entitytype"
 	oupkg "github.com/thunder-id/thunderid/internal/ou"
+	"github.com/thunder-id/thunderid/internal/quota"
 	serverconst "github.com/thunder-id/thunderid/internal/system/constants"
 	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
 	"github.com/thunder-id/thunderid/internal/system/log"
@@ -59,6 +60,7 @@ type GroupServiceInterface interface {
 	GetGroupsByIDs(ctx context.Context, groupIDs []string) (map[string]*Group, *serviceerror.ServiceError)
 	AddGroupMembers(ctx context.Context, groupID string, members []Member) (*Group, *serviceerror.ServiceError)
 	RemoveGroupMembers(ctx context.Context, groupID string, members []Member) (*Group, *serviceerror.ServiceError)
+	RemoveMemberFromAllGroups(ctx context.Context, member Member) *serviceerror.ServiceError
 }
 
 // groupService is the default implementation of the GroupServiceInterface.
@@ -69,6 +71,7 @@ type groupService struct {
 	entityTypeService entitytype.EntityTypeServiceInterface
 	transactioner     transaction.Transactioner
 	authzService      sysauthz.SystemAuthorizationServiceInterface
+	quotaService      quota.QuotaServiceInterface
 }
 
 // newGroupServiceWithStore creates a new instance of GroupService with an externally provided store.
@@ -78,6 +81,7 @@ func newGroupServiceWithStore(
 	entityService entity.EntityServiceInterface,
 	entityTypeService entitytype.EntityTypeServiceInterface,
 	authzService sysauthz.SystemAuthorizationServiceInterface,
+	quotaService quota.QuotaServiceInterface,
 	transactioner transaction.Transactioner,
 ) GroupServiceInterface {
 	return &groupService{
@@ -86,6 +90,7 @@ func newGroupServiceWithStore(
 		entityService:     entityService,
 		entityTypeService: entityTypeService,
 		authzService:      authzService,
+		quotaService:      quotaService,
 		transactioner:     transactioner,
 	}
 }
@@ -113,17 +118,24 @@ func (gs *groupService) GetGroupList(ctx context.Context, limit, offset int, inc
 func (gs *groupService) listAllGroups(ctx context.Context, limit, offset int, includeDisplay bool) (
 	*GroupListResponse, *serviceerror.ServiceError) {
 	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName))
-	totalCount, err := gs.groupStore.GetGroupListCount(ctx)
-	if err != nil {
-		logger.Error("Failed to get group count", log.Error(err))
-		return nil, &serviceerror.InternalServerError
+	skipCount := utils.IsCountSkipped(ctx)
+	totalCount, fetchLimit := utils.TotalCountUnknown, limit+1
+	if !skipCount {
+		var err error
+		totalCount, err = gs.groupStore.GetGroupListCount(ctx)
+		if err != nil {
+			logger.Error("Failed to get group count", log.Error(err))
+			return nil, &serviceerror.InternalServerError
+		}
+		fetchLimit = limit
 	}
 
-	groups, err := gs.groupStore.GetGroupList(ctx, limit, offset)
+	groups, err := gs.groupStore.GetGroupList(ctx, fetchLimit, offset)
 	if err != nil {
 		logger.Error("Failed to list groups", log.Error(err))
 		return nil, &serviceerror.InternalServerError
 	}
+	groups, hasMore := utils.TrimPage(groups, limit)
 
 	groupBasics := make([]GroupBasic, 0, len(groups))
 	for _, groupDAO := range groups {
@@ -140,7 +152,7 @@ func (gs *groupService) listAllGroups(ctx context.Context, limit, offset int, in
 		Groups:       groupBasics,
 		StartIndex:   offset + 1,
 		Count:        len(groupBasics),
-		Links:        utils.BuildPaginationLinks("/groups", limit, offset, totalCount, displayQuery),
+		Links:        utils.BuildListPaginationLinks("/groups", limit, offset, totalCount, hasMore, displayQuery),
 	}
 
 	return response, nil
@@ -284,6 +296,10 @@ func (gs *groupService) CreateGroup(ctx context.Context, request CreateGroupRequ
 		return nil, err
 	}
 
+	if err := gs.checkQuota(ctx, request.OUID); err != nil {
+		return nil, err
+	}
+
 	if err := gs.validateEntityMembers(ctx, request.Members, security.ActionCreateGroup); err != nil {
 		return nil, err
 	}
@@ -592,6 +608,8 @@ func (gs *groupService) DeleteGroup(ctx context.Context, groupID string) *servic
 		return &serviceerror.InternalServerError
 	}
 
+	gs.authzService.DeleteResourceRelationships(ctx, security.ResourceTypeGroup, groupID)
+
 	logger.Debug("Successfully deleted group", log.String("id", groupID))
 	return nil
 }
@@ -777,6 +795,28 @@ func (gs *groupService) RemoveGroupMembers(
 	)
 }
 
+// RemoveMemberFromAllGroups removes a member from every group it belongs to.
+func (gs *groupService) RemoveMemberFromAllGroups(ctx context.Context, member Member) *serviceerror.ServiceError {
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName))
+
+	if svcErr := validateMemberTypes([]Member{member}); svcErr != nil {
+		return svcErr
+	}
+	if svcErr := gs.checkGroupAccess(ctx, security.ActionUpdateGroup, "", ""); svcErr != nil {
+		return svcErr
+	}
+
+	if err := gs.transactioner.Transact(ctx, func(txCtx context.Context) error {
+		return gs.groupStore.RemoveMemberFromAllGroups(txCtx, normalizeMembers([]Member{member})[0])
+	}); err != nil {
+		logger.Error("Failed to remove member from groups", log.String("memberID", member.ID), log.Error(err))
+		return &ErrorInternalServerError
+	}
+
+	logger.Debug("Successfully removed member from all groups", log.String("memberID", member.ID))
+	return nil
+}
+
 // modifyGroupMembers is the shared implementation for AddGroupMembers and RemoveGroupMembers.
 // It validates, normalizes, and applies storeOp inside a transaction, then resolves member types.
 func (gs *groupService) modifyGroupMembers(
@@ -1040,6 +1080,23 @@ func (gs *groupService) validateOU(ctx context.Context, ouID string) *serviceerr
 	return nil
 }
 
+// checkQuota rejects the creation of a group beyond the group quota of its organization unit or tenant.
+func (gs *groupService) checkQuota(ctx context.Context, ouID string) *serviceerror.ServiceError {
+	if gs.quotaService == nil {
+		return nil
+	}
+
+	if err := gs.quotaService.CheckQuota(ctx, quota.ResourceTypeGroups, ouID); err != nil {
+		if errors.Is(err, quota.ErrQuotaExceeded) {
+			return &ErrorGroupQuotaExceeded
+		}
+		logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName))
+		logger.Error("Failed to check the group quota", log.Error(err), log.String("ouID", ouID))
+		return &serviceerror.InternalServerError
+	}
+	return nil
+}
+
 // resolveDisplayAttributePaths collects unique user types and resolves their display
 // attribute paths from the entity type service.
 func resolveDisplayAttributePaths(
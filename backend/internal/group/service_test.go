@@ -22,6 +22,7 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/mock"
@@ -30,6 +31,7 @@ import (
 
 	"github.com/thunder-id/thunderid/internal/entity"
 	oupkg "github.com/thunder-id/thunderid/internal/ou"
+	"github.com/thunder-id/thunderid/internal/quota"
 	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
 	"github.com/thunder-id/thunderid/internal/system/log"
 	"github.com/thunder-id/thunderid/internal/system/security"
@@ -38,6 +40,7 @@ import (
 	"github.com/thunder-id/thunderid/tests/mocks/entitymock"
 	"github.com/thunder-id/thunderid/tests/mocks/entitytypemock"
 	"github.com/thunder-id/thunderid/tests/mocks/oumock"
+	"github.com/thunder-id/thunderid/tests/mocks/quotamock"
 	"github.com/thunder-id/thunderid/tests/mocks/sysauthzmock"
 )
 
@@ -61,6 +64,7 @@ func newAllowAllAuthz(t *testing.T) sysauthz.SystemAuthorizationServiceInterface
 		Return(true, (*serviceerror.ServiceError)(nil)).Maybe()
 	mockAuthz.On("GetAccessibleResources", mock.Anything, mock.Anything, security.ResourceTypeOU).
 		Return(&sysauthz.AccessibleResources{AllAllowed: true}, (*serviceerror.ServiceError)(nil)).Maybe()
+	mockAuthz.On("DeleteResourceRelationships", mock.Anything, mock.Anything, mock.Anything).Maybe()
 	return mockAuthz
 }
 
@@ -772,6 +776,65 @@ func (suite *GroupServiceTestSuite) TestGroupService_CreateGroup() {
 	}
 }
 
+func (suite *GroupServiceTestSuite) TestGroupService_CreateGroup_Quota() {
+	testCases := []struct {
+		name      string
+		quotaErr  error
+		expectErr *serviceerror.ServiceError
+	}{
+		{
+			name:      "quota exceeded",
+			quotaErr:  fmt.Errorf("%w: the groups quota of the tenant allows at most 3", quota.ErrQuotaExceeded),
+			expectErr: &ErrorGroupQuotaExceeded,
+		},
+		{
+			name:      "quota check error",
+			quotaErr:  errors.New("db failure"),
+			expectErr: &serviceerror.InternalServerError,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			storeMock := newGroupStoreInterfaceMock(suite.T())
+			ouServiceMock := oumock.NewOrganizationUnitServiceInterfaceMock(suite.T())
+			ouServiceMock.On("IsOrganizationUnitExists", mock.Anything, "ou-001").Return(true, nil).Once()
+			quotaServiceMock := quotamock.NewQuotaServiceInterfaceMock(suite.T())
+			quotaServiceMock.On("CheckQuota", mock.Anything, quota.ResourceTypeGroups, "ou-001").
+				Return(tc.quotaErr).Once()
+			service := &groupService{
+				authzService:  newAllowAllAuthz(suite.T()),
+				groupStore:    storeMock,
+				ouService:     ouServiceMock,
+				quotaService:  quotaServiceMock,
+				transactioner: &stubTransactioner{},
+			}
+
+			group, err := service.CreateGroup(context.Background(), CreateGroupRequest{Name: "engineering",
+				OUID: "ou-001"})
+
+			suite.Require().Nil(group)
+			suite.Require().NotNil(err)
+			suite.Require().Equal(tc.expectErr.Code, err.Code)
+			storeMock.AssertNotCalled(suite.T(), "CreateGroup", mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func (suite *GroupServiceTestSuite) TestNewUsageCounter() {
+	storeMock := newGroupStoreInterfaceMock(suite.T())
+	storeMock.On("GetGroupListCount", mock.Anything).Return(9, nil).Once()
+	storeMock.On("GetGroupsByOrganizationUnitCount", mock.Anything, "ou-001").Return(4, nil).Once()
+	counter := newUsageCounter(storeMock)
+
+	tenantUsage, err := counter.CountUsage(context.Background(), quota.TenantScope)
+	suite.Require().NoError(err)
+	suite.Equal(9, tenantUsage)
+	ouUsage, err := counter.CountUsage(context.Background(), "ou-001")
+	suite.Require().NoError(err)
+	suite.Equal(4, ouUsage)
+}
+
 func (suite *GroupServiceTestSuite) TestGroupService_CreateGroupByPath() {
 	type setupArgs struct {
 		store  *groupStoreInterfaceMock
@@ -1385,6 +1448,26 @@ func (suite *GroupServiceTestSuite) TestGroupService_DeleteGroup() {
 					Once()
 			},
 		},
+		{
+			name: "success deletes relationships",
+			id:   "grp-001",
+			setup: func(storeMock *groupStoreInterfaceMock) {
+				storeMock.On("GetGroup", mock.Anything, "grp-001").
+					Return(GroupDAO{ID: "grp-001"}, nil).
+					Once()
+				storeMock.On("DeleteGroup", mock.Anything, "grp-001").
+					Return(nil).
+					Once()
+			},
+			authzSetup: func(t *testing.T) sysauthz.SystemAuthorizationServiceInterface {
+				authzMock := sysauthzmock.NewSystemAuthorizationServiceInterfaceMock(t)
+				authzMock.On("IsActionAllowed", mock.Anything, mock.Anything, mock.Anything).
+					Return(true, (*serviceerror.ServiceError)(nil))
+				authzMock.On("DeleteResourceRelationships", mock.Anything, security.ResourceTypeGroup, "grp-001").
+					Once()
+				return authzMock
+			},
+		},
 		{
 			name:      "missing id",
 			id:        "",
@@ -2193,6 +2276,77 @@ func (suite *GroupServiceTestSuite) TestGroupService_RemoveGroupMembers() {
 	})
 }
 
+func (suite *GroupServiceTestSuite) TestGroupService_RemoveMemberFromAllGroups() {
+	testCases := []struct {
+		name    string
+		member  Member
+		setup   func(*groupStoreInterfaceMock)
+		authz   func(*testing.T) sysauthz.SystemAuthorizationServiceInterface
+		wantErr *serviceerror.ServiceError
+	}{
+		{
+			name:    "invalid member type",
+			member:  Member{ID: "usr-001", Type: "invalid"},
+			wantErr: &ErrorInvalidMemberType,
+		},
+		{
+			name:    "empty member id",
+			member:  Member{Type: MemberTypeUser},
+			wantErr: &ErrorInvalidRequestFormat,
+		},
+		{
+			name:    "authorization error",
+			member:  Member{ID: "usr-001", Type: MemberTypeUser},
+			authz:   newAuthzError,
+			wantErr: &serviceerror.InternalServerError,
+		},
+		{
+			name:   "store failure",
+			member: Member{ID: "usr-001", Type: MemberTypeUser},
+			setup: func(storeMock *groupStoreInterfaceMock) {
+				storeMock.On("RemoveMemberFromAllGroups", mock.Anything,
+					Member{ID: "usr-001", Type: memberTypeEntity}).Return(errors.New("db error")).Once()
+			},
+			wantErr: &ErrorInternalServerError,
+		},
+		{
+			name:   "success",
+			member: Member{ID: "grp-002", Type: MemberTypeGroup},
+			setup: func(storeMock *groupStoreInterfaceMock) {
+				storeMock.On("RemoveMemberFromAllGroups", mock.Anything,
+					Member{ID: "grp-002", Type: MemberTypeGroup}).Return(nil).Once()
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			storeMock := newGroupStoreInterfaceMock(suite.T())
+			if tc.setup != nil {
+				tc.setup(storeMock)
+			}
+			authz := newAllowAllAuthz
+			if tc.authz != nil {
+				authz = tc.authz
+			}
+			service := &groupService{
+				authzService:  authz(suite.T()),
+				groupStore:    storeMock,
+				transactioner: &stubTransactioner{},
+			}
+
+			err := service.RemoveMemberFromAllGroups(context.Background(), tc.member)
+
+			if tc.wantErr != nil {
+				suite.Require().NotNil(err)
+				suite.Require().Equal(*tc.wantErr, *err)
+			} else {
+				suite.Require().Nil(err)
+			}
+		})
+	}
+}
+
 // resolveUserDisplay Tests
 
 func TestResolveUserDisplay_WithDisplayAttr(t *testing.T) {
@@ -24,6 +24,7 @@ import (
 	"time"
 
 	"github.com/thunder-id/thunderid/internal/system/config"
+	sysContext "github.com/thunder-id/thunderid/internal/system/context"
 	"github.com/thunder-id/thunderid/internal/system/database/provider"
 	"github.com/thunder-id/thunderid/internal/system/log"
 )
@@ -52,6 +53,7 @@ type groupStoreInterface interface {
 		ctx context.Context, oUID string, limit, offset int) ([]GroupBasicDAO, error)
 	AddGroupMembers(ctx context.Context, groupID string, members []Member) error
 	RemoveGroupMembers(ctx context.Context, groupID string, members []Member) error
+	RemoveMemberFromAllGroups(ctx context.Context, member Member) error
 	GetGroupsByIDs(ctx context.Context, groupIDs []string) ([]GroupBasicDAO, error)
 }
 
@@ -71,12 +73,13 @@ func newGroupStore() groupStoreInterface {
 
 // GetGroupListCount retrieves the total count of root groups.
 func (s *groupStore) GetGroupListCount(ctx context.Context) (int, error) {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
 	dbClient, err := s.dbProvider.GetUserDBClient()
 	if err != nil {
 		return 0, fmt.Errorf("failed to get database client: %w", err)
 	}
 
-	countResults, err := dbClient.QueryContext(ctx, QueryGetGroupListCount, s.deploymentID)
+	countResults, err := dbClient.QueryContext(ctx, QueryGetGroupListCount, deploymentID)
 	if err != nil {
 		return 0, fmt.Errorf("failed to execute group list count query: %w", err)
 	}
@@ -93,11 +96,12 @@ func (s *groupStore) GetGroupListCount(ctx context.Context) (int, error) {
 
 // GetGroupList retrieves root groups.
 func (s *groupStore) GetGroupList(ctx context.Context, limit, offset int) ([]GroupBasicDAO, error) {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
 	dbClient, err := s.dbProvider.GetUserDBClient()
 	if err != nil {
 		return nil, fmt.Errorf("failed to get database client: %w", err)
 	}
-	results, err := dbClient.QueryContext(ctx, QueryGetGroupList, limit, offset, s.deploymentID)
+	results, err := dbClient.QueryContext(ctx, QueryGetGroupList, limit, offset, deploymentID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to execute group list query: %w", err)
 	}
@@ -124,6 +128,7 @@ func (s *groupStore) GetGroupList(ctx context.Context, limit, offset int) ([]Gro
 
 // GetGroupListCountByOUIDs retrieves the total count of groups belonging to a set of OUs.
 func (s *groupStore) GetGroupListCountByOUIDs(ctx context.Context, ouIDs []string) (int, error) {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
 	if len(ouIDs) == 0 {
 		return 0, nil
 	}
@@ -133,7 +138,7 @@ func (s *groupStore) GetGroupListCountByOUIDs(ctx context.Context, ouIDs []strin
 		return 0, fmt.Errorf("failed to get database client for counter query: %w", err)
 	}
 
-	query, args := buildGetGroupsCountByOUIDsQuery(ouIDs, s.deploymentID)
+	query, args := buildGetGroupsCountByOUIDsQuery(ouIDs, deploymentID)
 
 	var count int
 	countResults, err := dbClient.QueryContext(ctx, query, args...)
@@ -155,6 +160,7 @@ func (s *groupStore) GetGroupListCountByOUIDs(ctx context.Context, ouIDs []strin
 // GetGroupListByOUIDs retrieves groups belonging to a set of OUs with pagination.
 func (s *groupStore) GetGroupListByOUIDs(
 	ctx context.Context, ouIDs []string, limit, offset int) ([]GroupBasicDAO, error) {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
 	if len(ouIDs) == 0 {
 		return []GroupBasicDAO{}, nil
 	}
@@ -163,7 +169,7 @@ func (s *groupStore) GetGroupListByOUIDs(
 	if err != nil {
 		return nil, fmt.Errorf("failed to get database client for query: %w", err)
 	}
-	query, args := buildGetGroupsByOUIDsQuery(ouIDs, limit, offset, s.deploymentID)
+	query, args := buildGetGroupsByOUIDsQuery(ouIDs, limit, offset, deploymentID)
 
 	results, err := dbClient.QueryContext(ctx, query, args...)
 	if err != nil {
@@ -192,6 +198,7 @@ func (s *groupStore) GetGroupListByOUIDs(
 
 // CreateGroup adds a new group record to the database.
 func (s *groupStore) CreateGroup(ctx context.Context, group GroupDAO) error {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
 	dbClient, err := s.dbProvider.GetUserDBClient()
 	if err != nil {
 		return fmt.Errorf("failed to get database client: %w", err)
@@ -205,7 +212,7 @@ func (s *groupStore) CreateGroup(ctx context.Context, group GroupDAO) error {
 		group.OUID,
 		group.Name,
 		group.Description,
-		s.deploymentID,
+		deploymentID,
 		now,
 		now,
 	)
@@ -213,7 +220,7 @@ func (s *groupStore) CreateGroup(ctx context.Context, group GroupDAO) error {
 		return fmt.Errorf("failed to execute query: %w", err)
 	}
 
-	err = addMembersToGroup(ctx, dbClient, group.ID, group.Members, s.deploymentID)
+	err = addMembersToGroup(ctx, dbClient, group.ID, group.Members, deploymentID)
 	if err != nil {
 		return err
 	}
@@ -223,12 +230,13 @@ func (s *groupStore) CreateGroup(ctx context.Context, group GroupDAO) error {
 
 // GetGroup retrieves a group by its id.
 func (s *groupStore) GetGroup(ctx context.Context, id string) (GroupDAO, error) {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
 	dbClient, err := s.dbProvider.GetUserDBClient()
 	if err != nil {
 		return GroupDAO{}, fmt.Errorf("failed to get database client: %w", err)
 	}
 
-	results, err := dbClient.QueryContext(ctx, QueryGetGroupByID, id, s.deploymentID)
+	results, err := dbClient.QueryContext(ctx, QueryGetGroupByID, id, deploymentID)
 	if err != nil {
 		return GroupDAO{}, fmt.Errorf("failed to execute query: %w", err)
 	}
@@ -252,12 +260,13 @@ func (s *groupStore) GetGroup(ctx context.Context, id string) (GroupDAO, error)
 
 // GetGroupMembers retrieves members of a group with pagination.
 func (s *groupStore) GetGroupMembers(ctx context.Context, groupID string, limit, offset int) ([]Member, error) {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
 	dbClient, err := s.dbProvider.GetUserDBClient()
 	if err != nil {
 		return nil, fmt.Errorf("failed to get database client: %w", err)
 	}
 
-	results, err := dbClient.QueryContext(ctx, QueryGetGroupMembers, groupID, limit, offset, s.deploymentID)
+	results, err := dbClient.QueryContext(ctx, QueryGetGroupMembers, groupID, limit, offset, deploymentID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get group members: %w", err)
 	}
@@ -279,12 +288,13 @@ func (s *groupStore) GetGroupMembers(ctx context.Context, groupID string, limit,
 
 // GetGroupMemberCount retrieves the total count of members in a group.
 func (s *groupStore) GetGroupMemberCount(ctx context.Context, groupID string) (int, error) {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
 	dbClient, err := s.dbProvider.GetUserDBClient()
 	if err != nil {
 		return 0, fmt.Errorf("failed to get database client: %w", err)
 	}
 
-	countResults, err := dbClient.QueryContext(ctx, QueryGetGroupMemberCount, groupID, s.deploymentID)
+	countResults, err := dbClient.QueryContext(ctx, QueryGetGroupMemberCount, groupID, deploymentID)
 	if err != nil {
 		return 0, fmt.Errorf("failed to get group member count: %w", err)
 	}
@@ -302,6 +312,7 @@ func (s *groupStore) GetGroupMemberCount(ctx context.Context, groupID string) (i
 
 // UpdateGroup updates an existing group.
 func (s *groupStore) UpdateGroup(ctx context.Context, group GroupDAO) error {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
 	dbClient, err := s.dbProvider.GetUserDBClient()
 	if err != nil {
 		return fmt.Errorf("failed to get database client: %w", err)
@@ -315,7 +326,7 @@ func (s *groupStore) UpdateGroup(ctx context.Context, group GroupDAO) error {
 		group.Name,
 		group.Description,
 		time.Now().UTC(),
-		s.deploymentID,
+		deploymentID,
 	)
 	if err != nil {
 		return fmt.Errorf("failed to execute query: %w", err)
@@ -330,6 +341,7 @@ func (s *groupStore) UpdateGroup(ctx context.Context, group GroupDAO) error {
 
 // DeleteGroup deletes a group.
 func (s *groupStore) DeleteGroup(ctx context.Context, id string) error {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
 	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, storeLoggerComponentName))
 
 	dbClient, err := s.dbProvider.GetUserDBClient()
@@ -337,12 +349,12 @@ func (s *groupStore) DeleteGroup(ctx context.Context, id string) error {
 		return fmt.Errorf("failed to get database client: %w", err)
 	}
 
-	_, err = dbClient.ExecuteContext(ctx, QueryDeleteGroupMembers, id, s.deploymentID)
+	_, err = dbClient.ExecuteContext(ctx, QueryDeleteGroupMembers, id, deploymentID)
 	if err != nil {
 		return fmt.Errorf("failed to delete group members: %w", err)
 	}
 
-	result, err := dbClient.ExecuteContext(ctx, QueryDeleteGroup, id, s.deploymentID)
+	result, err := dbClient.ExecuteContext(ctx, QueryDeleteGroup, id, deploymentID)
 	if err != nil {
 		return fmt.Errorf("failed to execute query: %w", err)
 	}
@@ -356,6 +368,7 @@ func (s *groupStore) DeleteGroup(ctx context.Context, id string) error {
 
 // ValidateGroupIDs checks if all provided group IDs exist.
 func (s *groupStore) ValidateGroupIDs(ctx context.Context, groupIDs []string) ([]string, error) {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
 	if len(groupIDs) == 0 {
 		return []string{}, nil
 	}
@@ -365,7 +378,7 @@ func (s *groupStore) ValidateGroupIDs(ctx context.Context, groupIDs []string) ([
 		return nil, fmt.Errorf("failed to get database client: %w", err)
 	}
 
-	query, args, err := buildBulkGroupExistsQueryFunc(groupIDs, s.deploymentID)
+	query, args, err := buildBulkGroupExistsQueryFunc(groupIDs, deploymentID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to build bulk group exists query: %w", err)
 	}
@@ -396,35 +409,38 @@ func (s *groupStore) ValidateGroupIDs(ctx context.Context, groupIDs []string) ([
 // in the same organization unit.
 func (s *groupStore) CheckGroupNameConflictForCreate(
 	ctx context.Context, name string, oUID string) error {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
 	dbClient, err := s.dbProvider.GetUserDBClient()
 	if err != nil {
 		return fmt.Errorf("failed to get database client: %w", err)
 	}
 
-	return checkGroupNameConflictForCreate(ctx, dbClient, name, oUID, s.deploymentID)
+	return checkGroupNameConflictForCreate(ctx, dbClient, name, oUID, deploymentID)
 }
 
 // CheckGroupNameConflictForUpdate checks if the new group name conflicts with other groups
 // in the same organization unit.
 func (s *groupStore) CheckGroupNameConflictForUpdate(
 	ctx context.Context, name string, oUID string, groupID string) error {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
 	dbClient, err := s.dbProvider.GetUserDBClient()
 	if err != nil {
 		return fmt.Errorf("failed to get database client: %w", err)
 	}
 
-	return checkGroupNameConflictForUpdate(ctx, dbClient, name, oUID, groupID, s.deploymentID)
+	return checkGroupNameConflictForUpdate(ctx, dbClient, name, oUID, groupID, deploymentID)
 }
 
 // GetGroupsByOrganizationUnitCount retrieves the total count of groups in a specific organization unit.
 func (s *groupStore) GetGroupsByOrganizationUnitCount(ctx context.Context, oUID string) (int, error) {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
 	dbClient, err := s.dbProvider.GetUserDBClient()
 	if err != nil {
 		return 0, fmt.Errorf("failed to get database client: %w", err)
 	}
 
 	countResults, err := dbClient.QueryContext(
-		ctx, QueryGetGroupsByOrganizationUnitCount, oUID, s.deploymentID)
+		ctx, QueryGetGroupsByOrganizationUnitCount, oUID, deploymentID)
 	if err != nil {
 		return 0, fmt.Errorf("failed to get group count by organization unit: %w", err)
 	}
@@ -444,13 +460,14 @@ func (s *groupStore) GetGroupsByOrganizationUnitCount(ctx context.Context, oUID
 func (s *groupStore) GetGroupsByOrganizationUnit(
 	ctx context.Context, oUID string, limit, offset int,
 ) ([]GroupBasicDAO, error) {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
 	dbClient, err := s.dbProvider.GetUserDBClient()
 	if err != nil {
 		return nil, fmt.Errorf("failed to get database client: %w", err)
 	}
 
 	results, err := dbClient.QueryContext(
-		ctx, QueryGetGroupsByOrganizationUnit, oUID, limit, offset, s.deploymentID)
+		ctx, QueryGetGroupsByOrganizationUnit, oUID, limit, offset, deploymentID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get groups by organization unit: %w", err)
 	}
@@ -475,16 +492,18 @@ func (s *groupStore) GetGroupsByOrganizationUnit(
 
 // AddGroupMembers adds members to a group.
 func (s *groupStore) AddGroupMembers(ctx context.Context, groupID string, members []Member) error {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
 	dbClient, err := s.dbProvider.GetUserDBClient()
 	if err != nil {
 		return fmt.Errorf("failed to get database client: %w", err)
 	}
 
-	return addMembersToGroup(ctx, dbClient, groupID, members, s.deploymentID)
+	return addMembersToGroup(ctx, dbClient, groupID, members, deploymentID)
 }
 
 // RemoveGroupMembers removes members from a group.
 func (s *groupStore) RemoveGroupMembers(ctx context.Context, groupID string, members []Member) error {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
 	dbClient, err := s.dbProvider.GetUserDBClient()
 	if err != nil {
 		return fmt.Errorf("failed to get database client: %w", err)
@@ -493,7 +512,7 @@ func (s *groupStore) RemoveGroupMembers(ctx context.Context, groupID string, mem
 	for _, member := range members {
 		_, err := dbClient.ExecuteContext(
 			ctx, QueryDeleteGroupMember,
-			groupID, member.Type, member.ID, s.deploymentID,
+			groupID, member.Type, member.ID, deploymentID,
 		)
 		if err != nil {
 			return fmt.Errorf("failed to remove member from group: %w", err)
@@ -503,8 +522,26 @@ func (s *groupStore) RemoveGroupMembers(ctx context.Context, groupID string, mem
 	return nil
 }
 
+// RemoveMemberFromAllGroups removes a member from every group it belongs to.
+func (s *groupStore) RemoveMemberFromAllGroups(ctx context.Context, member Member) error {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
+	dbClient, err := s.dbProvider.GetUserDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	if _, err := dbClient.ExecuteContext(
+		ctx, QueryDeleteMemberFromAllGroups, member.Type, member.ID, deploymentID,
+	); err != nil {
+		return fmt.Errorf("failed to remove member from groups: %w", err)
+	}
+
+	return nil
+}
+
 // GetGroupsByIDs retrieves groups by a list of IDs.
 func (s *groupStore) GetGroupsByIDs(ctx context.Context, groupIDs []string) ([]GroupBasicDAO, error) {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
 	const batchSize = 100
 
 	if len(groupIDs) == 0 {
@@ -525,7 +562,7 @@ func (s *groupStore) GetGroupsByIDs(ctx context.Context, groupIDs []string) ([]G
 		}
 		chunk := groupIDs[start:end]
 
-		query, args, err := buildGetGroupsByIDsQuery(chunk, s.deploymentID)
+		query, args, err := buildGetGroupsByIDsQuery(chunk, deploymentID)
 		if err != nil {
 			return nil, fmt.Errorf("failed to build get groups by IDs query: %w", err)
 		}
@@ -218,6 +218,13 @@ var (
 		Query: `DELETE FROM "GROUP_MEMBER_REFERENCE" ` +
 			`WHERE GROUP_ID = $1 AND MEMBER_TYPE = $2 AND MEMBER_ID = $3 AND DEPLOYMENT_ID = $4`,
 	}
+
+	// QueryDeleteMemberFromAllGroups is the query to delete a member from every group it belongs to.
+	QueryDeleteMemberFromAllGroups = dbmodel.DBQuery{
+		ID: "GRQ-GROUP_MGT-20",
+		Query: `DELETE FROM "GROUP_MEMBER_REFERENCE" ` +
+			`WHERE MEMBER_TYPE = $1 AND MEMBER_ID = $2 AND DEPLOYMENT_ID = $3`,
+	}
 )
 
 // buildGroupINClauseQuery constructs a query with an IN clause for group IDs.
@@ -1835,3 +1835,45 @@ func (suite *GroupStoreTestSuite) TestGroupStore_AddMembersToGroupReturnsError()
 	require.Error(t, err)
 	require.Contains(t, err.Error(), "failed to add member to group")
 }
+
+func (suite *GroupStoreTestSuite) TestGroupStore_RemoveMemberFromAllGroups() {
+	member := Member{ID: "usr-1", Type: memberTypeEntity}
+
+	suite.Run("database client error", func() {
+		providerMock := providermock.NewDBProviderInterfaceMock(suite.T())
+		providerMock.On("GetUserDBClient").Return(nil, errors.New("client fail")).Once()
+		store := &groupStore{dbProvider: providerMock, deploymentID: testDeploymentID}
+
+		err := store.RemoveMemberFromAllGroups(context.Background(), member)
+
+		suite.Require().Error(err)
+		suite.Require().Contains(err.Error(), "failed to get database client")
+	})
+
+	suite.Run("execute error", func() {
+		providerMock := providermock.NewDBProviderInterfaceMock(suite.T())
+		dbClientMock := providermock.NewDBClientInterfaceMock(suite.T())
+		providerMock.On("GetUserDBClient").Return(dbClientMock, nil).Once()
+		dbClientMock.On("ExecuteContext", mock.Anything, QueryDeleteMemberFromAllGroups,
+			memberTypeEntity, "usr-1", testDeploymentID).Return(int64(0), errors.New("delete fail")).Once()
+		store := &groupStore{dbProvider: providerMock, deploymentID: testDeploymentID}
+
+		err := store.RemoveMemberFromAllGroups(context.Background(), member)
+
+		suite.Require().Error(err)
+		suite.Require().Contains(err.Error(), "failed to remove member from groups")
+	})
+
+	suite.Run("success", func() {
+		providerMock := providermock.NewDBProviderInterfaceMock(suite.T())
+		dbClientMock := providermock.NewDBClientInterfaceMock(suite.T())
+		providerMock.On("GetUserDBClient").Return(dbClientMock, nil).Once()
+		dbClientMock.On("ExecuteContext", mock.Anything, QueryDeleteMemberFromAllGroups,
+			memberTypeEntity, "usr-1", testDeploymentID).Return(int64(2), nil).Once()
+		store := &groupStore{dbProvider: providerMock, deploymentID: testDeploymentID}
+
+		err := store.RemoveMemberFromAllGroups(context.Background(), member)
+
+		suite.Require().NoError(err)
+	})
+}
@@ -25,6 +25,7 @@ import (
 
 	"github.com/thunder-id/thunderid/internal/system/cmodels"
 	"github.com/thunder-id/thunderid/internal/system/config"
+	sysContext "github.com/thunder-id/thunderid/internal/system/context"
 	dbmodel "github.com/thunder-id/thunderid/internal/system/database/model"
 	"github.com/thunder-id/thunderid/internal/system/database/provider"
 	"github.com/thunder-id/thunderid/internal/system/log"
@@ -70,6 +71,7 @@ func newIDPStore() (idpStoreInterface, transaction.Transactioner, error) {
 
 // CreateIdentityProvider handles the IdP creation in the database.
 func (s *idpStore) CreateIdentityProvider(ctx context.Context, idp IDPDTO) error {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
 	dbClient, err := s.dbProvider.GetConfigDBClient()
 	if err != nil {
 		return fmt.Errorf("failed to get database client: %w", err)
@@ -84,7 +86,7 @@ func (s *idpStore) CreateIdentityProvider(ctx context.Context, idp IDPDTO) error
 	}
 
 	_, err = dbClient.ExecuteContext(ctx,
-		queryCreateIdentityProvider, idp.ID, idp.Name, idp.Description, idp.Type, propertiesJSON, s.deploymentID,
+		queryCreateIdentityProvider, idp.ID, idp.Name, idp.Description, idp.Type, propertiesJSON, deploymentID,
 	)
 	if err != nil {
 		return fmt.Errorf("failed to execute query: %w", err)
@@ -95,12 +97,13 @@ func (s *idpStore) CreateIdentityProvider(ctx context.Context, idp IDPDTO) error
 
 // GetIdentityProviderList retrieves a list of IdPs from the database.
 func (s *idpStore) GetIdentityProviderList(ctx context.Context) ([]BasicIDPDTO, error) {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
 	dbClient, err := s.dbProvider.GetConfigDBClient()
 	if err != nil {
 		return nil, fmt.Errorf("failed to get database client: %w", err)
 	}
 
-	results, err := dbClient.QueryContext(ctx, queryGetIdentityProviderList, s.deploymentID)
+	results, err := dbClient.QueryContext(ctx, queryGetIdentityProviderList, deploymentID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to execute query: %w", err)
 	}
@@ -119,12 +122,13 @@ func (s *idpStore) GetIdentityProviderList(ctx context.Context) ([]BasicIDPDTO,
 
 // GetIdentityProviderListCount retrieves the total count of identity providers.
 func (s *idpStore) GetIdentityProviderListCount(ctx context.Context) (int, error) {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
 	dbClient, err := s.dbProvider.GetConfigDBClient()
 	if err != nil {
 		return 0, fmt.Errorf("failed to get database client: %w", err)
 	}
 
-	results, err := dbClient.QueryContext(ctx, queryGetIdentityProviderListCount, s.deploymentID)
+	results, err := dbClient.QueryContext(ctx, queryGetIdentityProviderListCount, deploymentID)
 	if err != nil {
 		return 0, fmt.Errorf("failed to execute query: %w", err)
 	}
@@ -162,6 +166,7 @@ func (s *idpStore) GetIdentityProviderByName(ctx context.Context, name string) (
 
 // GetIdentityProviderByIssuer retrieves a specific idp by its issuer property from the database.
 func (s *idpStore) GetIdentityProviderByIssuer(ctx context.Context, issuer string) (*IDPDTO, error) {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
 	dbClient, err := s.dbProvider.GetConfigDBClient()
 	if err != nil {
 		return nil, fmt.Errorf("failed to get database client: %w", err)
@@ -187,7 +192,7 @@ func (s *idpStore) GetIdentityProviderByIssuer(ctx context.Context, issuer strin
 		param = issuer
 	}
 
-	results, err := dbClient.QueryContext(ctx, queryGetIdentityProviderByIssuer, param, s.deploymentID)
+	results, err := dbClient.QueryContext(ctx, queryGetIdentityProviderByIssuer, param, deploymentID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to execute query: %w", err)
 	}
@@ -230,12 +235,13 @@ func (s *idpStore) GetIdentityProviderByIssuer(ctx context.Context, issuer strin
 
 // getIDP retrieves an IDP based on the provided query and identifier.
 func (s *idpStore) getIDP(ctx context.Context, query dbmodel.DBQuery, identifier string) (*IDPDTO, error) {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
 	dbClient, err := s.dbProvider.GetConfigDBClient()
 	if err != nil {
 		return nil, fmt.Errorf("failed to get database client: %w", err)
 	}
 
-	results, err := dbClient.QueryContext(ctx, query, identifier, s.deploymentID)
+	results, err := dbClient.QueryContext(ctx, query, identifier, deploymentID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to execute query: %w", err)
 	}
@@ -285,6 +291,7 @@ func (s *idpStore) getIDP(ctx context.Context, query dbmodel.DBQuery, identifier
 
 // UpdateIdentityProvider updates the idp in the database.
 func (s *idpStore) UpdateIdentityProvider(ctx context.Context, idp *IDPDTO) error {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
 	dbClient, err := s.dbProvider.GetConfigDBClient()
 	if err != nil {
 		return fmt.Errorf("failed to get database client: %w", err)
@@ -300,7 +307,7 @@ func (s *idpStore) UpdateIdentityProvider(ctx context.Context, idp *IDPDTO) erro
 
 	// Update the IDP in the database
 	_, err = dbClient.ExecuteContext(ctx, queryUpdateIdentityProviderByID, idp.ID, idp.Name,
-		idp.Description, idp.Type, propertiesJSON, s.deploymentID)
+		idp.Description, idp.Type, propertiesJSON, deploymentID)
 	if err != nil {
 		return fmt.Errorf("failed to execute query: %w", err)
 	}
@@ -310,6 +317,7 @@ func (s *idpStore) UpdateIdentityProvider(ctx context.Context, idp *IDPDTO) erro
 
 // DeleteIdentityProvider deletes the idp from the database.
 func (s *idpStore) DeleteIdentityProvider(ctx context.Context, id string) error {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
 	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, "IdPStore"))
 
 	dbClient, err := s.dbProvider.GetConfigDBClient()
@@ -317,7 +325,7 @@ func (s *idpStore) DeleteIdentityProvider(ctx context.Context, id string) error
 		return fmt.Errorf("failed to get database client: %w", err)
 	}
 
-	rowsAffected, err := dbClient.ExecuteContext(ctx, queryDeleteIdentityProviderByID, id, s.deploymentID)
+	rowsAffected, err := dbClient.ExecuteContext(ctx, queryDeleteIdentityProviderByID, id, deploymentID)
 	if err != nil {
 		return fmt.Errorf("failed to execute query: %w", err)
 	}
@@ -20,6 +20,7 @@ package inboundclient
 
 import (
 	"errors"
+	"fmt"
 
 	"github.com/thunder-id/thunderid/internal/cert"
 	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
@@ -71,8 +72,44 @@ var (
 	ErrOAuthInvalidRedirectURI = errors.New("invalid redirect URI")
 	// ErrOAuthRedirectURIFragmentNotAllowed is returned when a redirect URI contains a fragment.
 	ErrOAuthRedirectURIFragmentNotAllowed = errors.New("redirect URI must not contain a fragment")
+	// ErrOAuthRedirectURIWildcardHostNotAllowed is returned when a redirect URI has a wildcard host and
+	// wildcard matching is not enabled for the client.
+	ErrOAuthRedirectURIWildcardHostNotAllowed = fmt.Errorf("%w: wildcard host is not allowed",
+		ErrOAuthInvalidRedirectURI)
+	// ErrOAuthRedirectURIPatternNotAllowed is returned when a redirect URI has a wildcard path and
+	// wildcard matching is not enabled for the client.
+	ErrOAuthRedirectURIPatternNotAllowed = fmt.Errorf("%w: wildcard pattern is not allowed",
+		ErrOAuthInvalidRedirectURI)
+	// ErrOAuthInvalidRedirectURIMatchMode is returned when the redirect URI match mode is not supported.
+	ErrOAuthInvalidRedirectURIMatchMode = errors.New("invalid redirect URI match mode")
 	// ErrOAuthAuthCodeRequiresRedirectURIs is returned when authorization_code grant has no redirect URIs.
 	ErrOAuthAuthCodeRequiresRedirectURIs = errors.New("authorization_code grant requires redirect URIs")
+	// ErrOAuthInvalidFrontChannelLogoutURI is returned when the front-channel logout URI is not an absolute URL
+	// without a fragment.
+	ErrOAuthInvalidFrontChannelLogoutURI = errors.New("invalid front-channel logout URI")
+	// ErrOAuthInvalidBackChannelLogoutURI is returned when the back-channel logout URI is not an absolute URL
+	// without a fragment, or is not publicly reachable.
+	ErrOAuthInvalidBackChannelLogoutURI = errors.New("invalid back-channel logout URI")
+	// ErrOAuthInvalidRefreshTokenLifetime is returned when a refresh token validity period or idle timeout
+	// is negative.
+	ErrOAuthInvalidRefreshTokenLifetime = errors.New("invalid refresh token lifetime")
+	// ErrOAuthUnsupportedRequestObjectSigningAlg is returned when the request object signing algorithm is not
+	// supported.
+	ErrOAuthUnsupportedRequestObjectSigningAlg = errors.New("unsupported request object signing algorithm")
+	// ErrOAuthRequestObjectRequiresCertificate is returned when a request object signing algorithm is set
+	// without a certificate to verify request objects with.
+	ErrOAuthRequestObjectRequiresCertificate = errors.New(
+		"a certificate (JWKS or JWKS_URI) is required when a request object signing algorithm is set")
+	// ErrOAuthUnsupportedScopePolicyGrantType is returned when allowed scopes are configured for a grant type
+	// that does not support an allowed-scope policy.
+	ErrOAuthUnsupportedScopePolicyGrantType = errors.New("allowed scopes cannot be configured for this grant type")
+	// ErrOAuthScopePolicyGrantTypeNotAllowed is returned when allowed scopes are configured for a grant type
+	// the client is not allowed to use.
+	ErrOAuthScopePolicyGrantTypeNotAllowed = errors.New(
+		"allowed scopes are configured for a grant type the client does not use")
+	// ErrOAuthInvalidScopePolicy is returned when the allowed scopes of a grant type are empty or contain an
+	// invalid scope name.
+	ErrOAuthInvalidScopePolicy = errors.New("allowed scopes of a grant type must be a non-empty list of scope names")
 	// ErrOAuthInvalidGrantType is returned when an unsupported grant type is specified.
 	ErrOAuthInvalidGrantType = errors.New("invalid grant type")
 	// ErrOAuthInvalidResponseType is returned when an unsupported response type is specified.
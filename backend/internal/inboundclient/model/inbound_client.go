@@ -38,6 +38,8 @@ type InboundClient struct {
 	Assertion                 *AssertionConfig
 	LoginConsent              *LoginConsentConfig
 	AllowedUserTypes          []string
+	AllowedAuthMethods        *AuthMethodsConfig
+	UserIsolation             bool
 	Properties                map[string]interface{}
 	IsReadOnly                bool
 }
@@ -54,6 +56,8 @@ type InboundAuthProfile struct {
 	Assertion                 *AssertionConfig    `json:"assertion,omitempty"            yaml:"assertion,omitempty"              jsonschema:"Assertion configuration. Optional. Customize assertion validity periods and included user attributes."`
 	LoginConsent              *LoginConsentConfig `json:"loginConsent,omitempty"         yaml:"login_consent,omitempty"          jsonschema:"Login consent configuration settings."`
 	AllowedUserTypes          []string            `json:"allowedUserTypes,omitempty"     yaml:"allowed_user_types,omitempty"     jsonschema:"Allowed user types. Optional. Restricts which user types can authenticate to and register against this resource."`
+	AllowedAuthMethods        *AuthMethodsConfig  `json:"allowedAuthMethods,omitempty"   yaml:"allowed_auth_methods,omitempty"   jsonschema:"Allowed authentication methods. Optional. Restricts the authentication methods users can sign in with. If omitted, every method configured in the authentication flow is allowed."`
+	UserIsolation             bool                `json:"userIsolation,omitempty"        yaml:"user_isolation,omitempty"         jsonschema:"Isolate users. Optional. Limits the users visible to the resource to those who registered through or signed in to it, or were associated with it by an administrator."`
 	Certificate               *Certificate        `json:"certificate,omitempty"          yaml:"certificate,omitempty"            jsonschema:"Resource-level certificate. Optional. For certificate-based authentication or JWT validation."`
 }
 
@@ -68,6 +72,13 @@ type LoginConsentConfig struct {
 	ValidityPeriod int64 `json:"validityPeriod" yaml:"validity_period" jsonschema:"Consent validity period in seconds. 0 means never expire."`
 }
 
+// AuthMethodsConfig restricts the authentication methods that can be used to sign in to a resource.
+type AuthMethodsConfig struct {
+	Local         bool     `json:"local"                   yaml:"local"                    jsonschema:"Allow local authentication methods such as username and password, SMS OTP and magic links."`
+	Passkey       bool     `json:"passkey"                 yaml:"passkey"                  jsonschema:"Allow passkey authentication."`
+	FederatedIDPs []string `json:"federatedIdps,omitempty" yaml:"federated_idps,omitempty" jsonschema:"Identity provider IDs allowed for federated authentication."`
+}
+
 // Certificate is a user-supplied certificate input.
 type Certificate struct {
 	Type  cert.CertificateType `json:"type,omitempty"  yaml:"type,omitempty"  jsonschema:"Certificate type (PEM, JWK, etc.)."`
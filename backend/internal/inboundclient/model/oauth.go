@@ -43,16 +43,18 @@ const (
 	OAuthInboundAuthType InboundAuthType = "oauth2"
 )
 
-// OAuthTokenConfig wraps access and ID token configs.
+// OAuthTokenConfig wraps access, ID and refresh token configs.
 type OAuthTokenConfig struct {
-	AccessToken *AccessTokenConfig `json:"accessToken,omitempty" yaml:"access_token,omitempty" jsonschema:"Access token configuration."`
-	IDToken     *IDTokenConfig     `json:"idToken,omitempty"    yaml:"id_token,omitempty"     jsonschema:"ID token configuration."`
+	AccessToken  *AccessTokenConfig  `json:"accessToken,omitempty"  yaml:"access_token,omitempty"  jsonschema:"Access token configuration."`
+	IDToken      *IDTokenConfig      `json:"idToken,omitempty"      yaml:"id_token,omitempty"      jsonschema:"ID token configuration."`
+	RefreshToken *RefreshTokenConfig `json:"refreshToken,omitempty" yaml:"refresh_token,omitempty" jsonschema:"Refresh token configuration."`
 }
 
 // AccessTokenConfig is the access token configuration.
 type AccessTokenConfig struct {
 	ValidityPeriod int64    `json:"validityPeriod,omitempty" yaml:"validity_period,omitempty" jsonschema:"Access token validity period in seconds."`
 	UserAttributes []string `json:"userAttributes,omitempty" yaml:"user_attributes,omitempty" jsonschema:"User attributes to embed in the access token."`
+	IncludeRoles   bool     `json:"includeRoles,omitempty"   yaml:"include_roles,omitempty"   jsonschema:"Embed the user's roles and the scopes mapped to them in the access token."`
 }
 
 // IDTokenConfig is the ID token configuration.
@@ -64,6 +66,14 @@ type IDTokenConfig struct {
 	EncryptionEnc  string              `json:"encryptionEnc,omitempty"  yaml:"encryption_enc,omitempty"  jsonschema:"JWE content-encryption algorithm. Required when responseType is JWE or NESTED_JWT."`
 }
 
+// RefreshTokenConfig is the refresh token configuration. Zero values fall back to the server configuration.
+type RefreshTokenConfig struct {
+	ValidityPeriod int64 `json:"validityPeriod,omitempty" yaml:"validity_period,omitempty" jsonschema:"Absolute refresh token lifetime in seconds."`
+	IdleTimeout    int64 `json:"idleTimeout,omitempty"    yaml:"idle_timeout,omitempty"    jsonschema:"Seconds a refresh token may go unused before it expires."`
+	// RenewOnGrant overrides the server renew_on_grant setting when set.
+	RenewOnGrant *bool `json:"renewOnGrant,omitempty" yaml:"renew_on_grant,omitempty" jsonschema:"Rotate the refresh token on each refresh grant. Overrides the server configuration when set."`
+}
+
 // IDTokenResponseType is the response format of the ID token.
 type IDTokenResponseType string
 
@@ -76,6 +86,24 @@ const (
 	IDTokenResponseTypeNESTEDJWT IDTokenResponseType = "NESTED_JWT" //nolint:gosec // not a credential
 )
 
+// RedirectURIMatchMode is the strategy used to match a requested redirect URI against the registered URIs.
+type RedirectURIMatchMode string
+
+const (
+	// RedirectURIMatchModeExact matches redirect URIs by exact string comparison only.
+	RedirectURIMatchModeExact RedirectURIMatchMode = "EXACT"
+	// RedirectURIMatchModePattern additionally honors wildcard patterns when enabled on the server (default).
+	RedirectURIMatchModePattern RedirectURIMatchMode = "PATTERN"
+)
+
+// LogoutConfig is the front-channel and back-channel logout configuration of a client.
+type LogoutConfig struct {
+	FrontChannelLogoutURI             string `json:"frontchannelLogoutUri,omitempty"             yaml:"frontchannel_logout_uri,omitempty"              jsonschema:"URI rendered in an iframe on the end-session page to log the user out of the client."`
+	FrontChannelLogoutSessionRequired bool   `json:"frontchannelLogoutSessionRequired,omitempty" yaml:"frontchannel_logout_session_required,omitempty" jsonschema:"Include the iss and sid query parameters in the front-channel logout URI."`
+	BackChannelLogoutURI              string `json:"backchannelLogoutUri,omitempty"              yaml:"backchannel_logout_uri,omitempty"               jsonschema:"URI that receives a logout token from the server when the user logs out."`
+	BackChannelLogoutSessionRequired  bool   `json:"backchannelLogoutSessionRequired,omitempty"  yaml:"backchannel_logout_session_required,omitempty"  jsonschema:"Include the sid claim in the back-channel logout token."`
+}
+
 // UserInfoConfig is the user info endpoint configuration.
 type UserInfoConfig struct {
 	ResponseType   UserInfoResponseType `json:"responseType,omitempty"   yaml:"response_type,omitempty"   jsonschema:"UserInfo response type (JSON, JWS, JWE, NESTED_JWT). Required algorithm fields must match the selected response type."`
@@ -107,21 +135,104 @@ var (
 	SupportedUserInfoEncryptionEncs = []string{string(jwe.A128CBCHS256), string(jwe.A256GCM)}
 )
 
+// ClaimFallbackSourceType identifies how a claim fallback source derives a value.
+type ClaimFallbackSourceType string
+
+const (
+	// ClaimFallbackSourceAttributes takes the first non-empty value of a list of attribute paths.
+	ClaimFallbackSourceAttributes ClaimFallbackSourceType = "attributes"
+	// ClaimFallbackSourceComputed renders a template whose {path} placeholders are replaced by attribute values.
+	ClaimFallbackSourceComputed ClaimFallbackSourceType = "computed"
+	// ClaimFallbackSourceGravatar builds a gravatar URL from the hash of the verified email address.
+	ClaimFallbackSourceGravatar ClaimFallbackSourceType = "gravatar"
+)
+
+// ClaimFallbackSource is one step of the fallback chain of a claim. The steps of a chain are tried in order
+// until one yields a non-empty value.
+type ClaimFallbackSource struct {
+	Type              ClaimFallbackSourceType `json:"type"                        yaml:"type"                         jsonschema:"Fallback source type: 'attributes', 'computed' or 'gravatar'."`
+	Attributes        []string                `json:"attributes,omitempty"        yaml:"attributes,omitempty"         jsonschema:"Attribute paths tried in order. Required for the 'attributes' type."`
+	Template          string                  `json:"template,omitempty"          yaml:"template,omitempty"           jsonschema:"Template with {attribute} placeholders, e.g. '{given_name} {family_name}'. Required for the 'computed' type."`
+	EmailAttribute    string                  `json:"emailAttribute,omitempty"    yaml:"email_attribute,omitempty"    jsonschema:"Attribute holding the email address for the 'gravatar' type. Defaults to 'email'."`
+	VerifiedAttribute string                  `json:"verifiedAttribute,omitempty" yaml:"verified_attribute,omitempty" jsonschema:"Boolean attribute marking the email address verified for the 'gravatar' type. Defaults to 'email_verified'."`
+}
+
+// TemplatePlaceholders returns the attribute paths referenced by the {path} placeholders of the template, in
+// the order they appear.
+func (s ClaimFallbackSource) TemplatePlaceholders() []string {
+	placeholders := make([]string, 0)
+	rest := s.Template
+	for {
+		start := strings.Index(rest, "{")
+		if start < 0 {
+			return placeholders
+		}
+		end := strings.Index(rest[start:], "}")
+		if end < 0 {
+			return placeholders
+		}
+		if path := strings.TrimSpace(rest[start+1 : start+end]); path != "" {
+			placeholders = append(placeholders, path)
+		}
+		rest = rest[start+end+1:]
+	}
+}
+
+// TokenClaimRuleType identifies how a token customization rule derives the value of a claim.
+type TokenClaimRuleType string
+
+const (
+	// TokenClaimRuleStatic adds a fixed value.
+	TokenClaimRuleStatic TokenClaimRuleType = "static"
+	// TokenClaimRuleAttribute copies the value of a user attribute path.
+	TokenClaimRuleAttribute TokenClaimRuleType = "attribute"
+	// TokenClaimRuleExpression evaluates an expression over the user attributes and the token request.
+	TokenClaimRuleExpression TokenClaimRuleType = "expression"
+)
+
+// TokenClaimRule adds a claim to the tokens of an application. A rule that yields no value leaves the claim
+// unchanged.
+type TokenClaimRule struct {
+	Claim      string             `json:"claim"                yaml:"claim"                jsonschema:"Name of the claim the rule sets."`
+	Type       TokenClaimRuleType `json:"type"                 yaml:"type"                 jsonschema:"Rule type: 'static', 'attribute' or 'expression'."`
+	Value      interface{}        `json:"value,omitempty"      yaml:"value,omitempty"      jsonschema:"Claim value. Required for the 'static' type."`
+	Attribute  string             `json:"attribute,omitempty"  yaml:"attribute,omitempty"  jsonschema:"User attribute path, e.g. 'address.country'. Required for the 'attribute' type."`
+	Expression string             `json:"expression,omitempty" yaml:"expression,omitempty" jsonschema:"Expression computing the claim value, e.g. 'lower(user.department)'. Required for the 'expression' type."`
+}
+
+// TokenCustomization holds the claim rules an application applies to its tokens. Rules are applied in order, so
+// a later rule for a claim replaces the value set by an earlier one.
+type TokenCustomization struct {
+	AccessToken []TokenClaimRule `json:"accessToken,omitempty" yaml:"access_token,omitempty" jsonschema:"Claim rules applied to access tokens."`
+	IDToken     []TokenClaimRule `json:"idToken,omitempty"     yaml:"id_token,omitempty"     jsonschema:"Claim rules applied to ID tokens."`
+}
+
 // OAuthProfile is the persistence shape (OAUTH_PROFILE JSONB column).
 type OAuthProfile struct {
-	RedirectURIs                       []string            `json:"redirectUris"`
-	GrantTypes                         []string            `json:"grantTypes"`
-	ResponseTypes                      []string            `json:"responseTypes"`
-	TokenEndpointAuthMethod            string              `json:"tokenEndpointAuthMethod"`
-	PKCERequired                       bool                `json:"pkceRequired"`
-	PublicClient                       bool                `json:"publicClient"`
-	RequirePushedAuthorizationRequests bool                `json:"requirePushedAuthorizationRequests"`
-	Token                              *OAuthTokenConfig   `json:"token,omitempty"`
-	Scopes                             []string            `json:"scopes,omitempty"`
-	UserInfo                           *UserInfoConfig     `json:"userInfo,omitempty"`
-	ScopeClaims                        map[string][]string `json:"scopeClaims,omitempty"`
-	Certificate                        *Certificate        `json:"certificate,omitempty"`
-	AcrValues                          []string            `json:"acrValues,omitempty"`
+	RedirectURIs                       []string                         `json:"redirectUris"`
+	RedirectURIMatchMode               string                           `json:"redirectUriMatchMode,omitempty"`
+	GrantTypes                         []string                         `json:"grantTypes"`
+	ResponseTypes                      []string                         `json:"responseTypes"`
+	TokenEndpointAuthMethod            string                           `json:"tokenEndpointAuthMethod"`
+	PKCERequired                       bool                             `json:"pkceRequired"`
+	PublicClient                       bool                             `json:"publicClient"`
+	RequirePushedAuthorizationRequests bool                             `json:"requirePushedAuthorizationRequests"`
+	AllowCredentialDiscovery           bool                             `json:"allowCredentialDiscovery,omitempty"`
+	ProtocolTraceEnabled               bool                             `json:"protocolTraceEnabled,omitempty"`
+	RequestObjectSigningAlg            string                           `json:"requestObjectSigningAlg,omitempty"`
+	DPoPBoundAccessTokens              bool                             `json:"dpopBoundAccessTokens,omitempty"`
+	Token                              *OAuthTokenConfig                `json:"token,omitempty"`
+	Scopes                             []string                         `json:"scopes,omitempty"`
+	AllowedScopes                      []string                         `json:"allowedScopes,omitempty"`
+	RejectDisallowedScopes             bool                             `json:"rejectDisallowedScopes,omitempty"`
+	AllowedScopesByGrantType           map[string][]string              `json:"allowedScopesByGrantType,omitempty"`
+	Logout                             *LogoutConfig                    `json:"logout,omitempty"`
+	UserInfo                           *UserInfoConfig                  `json:"userInfo,omitempty"`
+	ScopeClaims                        map[string][]string              `json:"scopeClaims,omitempty"`
+	Certificate                        *Certificate                     `json:"certificate,omitempty"`
+	AcrValues                          []string                         `json:"acrValues,omitempty"`
+	ClaimFallbacks                     map[string][]ClaimFallbackSource `json:"claimFallbacks,omitempty"`
+	TokenCustomization                 *TokenCustomization              `json:"tokenCustomization,omitempty"`
 }
 
 // OAuthConfigWithSecret is the wire input shape and the create/update echo response shape.
@@ -130,18 +241,29 @@ type OAuthConfigWithSecret struct {
 	ClientID                           string                              `json:"clientId,omitempty"                          yaml:"client_id,omitempty"                          jsonschema:"OAuth client ID (auto-generated if not provided)"`
 	ClientSecret                       string                              `json:"clientSecret,omitempty"                      yaml:"client_secret,omitempty"                      jsonschema:"OAuth client secret (auto-generated if not provided)"`
 	RedirectURIs                       []string                            `json:"redirectUris,omitempty"                      yaml:"redirect_uris,omitempty"                      jsonschema:"Allowed redirect URIs. Required for Public (SPA/Mobile) and Confidential (Server) clients. Omit for M2M."`
+	RedirectURIMatchMode               RedirectURIMatchMode                `json:"redirectUriMatchMode,omitempty"              yaml:"redirect_uri_match_mode,omitempty"            jsonschema:"How redirect URIs are matched: 'EXACT' or 'PATTERN' (default). PATTERN honors wildcards when enabled on the server."`
 	GrantTypes                         []oauth2const.GrantType             `json:"grantTypes,omitempty"                        yaml:"grant_types,omitempty"                        jsonschema:"OAuth grant types. Common: [authorization_code, refresh_token] for user apps, [client_credentials] for M2M."`
 	ResponseTypes                      []oauth2const.ResponseType          `json:"responseTypes,omitempty"                     yaml:"response_types,omitempty"                     jsonschema:"OAuth response types. Common: [code] for user apps. Omit for M2M."`
 	TokenEndpointAuthMethod            oauth2const.TokenEndpointAuthMethod `json:"tokenEndpointAuthMethod,omitempty"           yaml:"token_endpoint_auth_method,omitempty"         jsonschema:"Client authentication method. Use 'none' for Public clients, 'client_secret_basic' for Confidential/M2M."`
 	PKCERequired                       bool                                `json:"pkceRequired"                                yaml:"pkce_required"                                jsonschema:"Require PKCE for security. Recommended for all user-interactive flows."`
 	PublicClient                       bool                                `json:"publicClient"                                yaml:"public_client"                                jsonschema:"Identify if client is public (cannot store secrets). Set true for SPA/Mobile."`
 	RequirePushedAuthorizationRequests bool                                `json:"requirePushedAuthorizationRequests"          yaml:"require_pushed_authorization_requests"        jsonschema:"Require Pushed Authorization Requests (PAR) per RFC 9126."`
+	AllowCredentialDiscovery           bool                                `json:"allowCredentialDiscovery"                    yaml:"allow_credential_discovery"                   jsonschema:"Allow the client to look up which credential types are available for an identifier via the credential check endpoint."`
+	ProtocolTraceEnabled               bool                                `json:"protocolTraceEnabled"                        yaml:"protocol_trace_enabled"                       jsonschema:"Capture redacted authorization and token protocol messages of the client for troubleshooting. Traces are kept for a short retention period."`
+	RequestObjectSigningAlg            string                              `json:"requestObjectSigningAlg,omitempty"           yaml:"request_object_signing_alg,omitempty"         jsonschema:"JWS algorithm required for signed request objects (JAR). Request objects are verified with the application certificate. Omit to accept any supported algorithm."`
+	DPoPBoundAccessTokens              bool                                `json:"dpopBoundAccessTokens"                       yaml:"dpop_bound_access_tokens"                     jsonschema:"Require DPoP proofs (RFC 9449) at the token endpoint and bind issued access tokens to the proof key."`
 	Token                              *OAuthTokenConfig                   `json:"token,omitempty"                             yaml:"token,omitempty"                              jsonschema:"Token configuration for access tokens and ID tokens"`
 	Scopes                             []string                            `json:"scopes,omitempty"                            yaml:"scopes,omitempty"                             jsonschema:"Allowed OAuth scopes. Add custom scopes as needed for your application."`
+	AllowedScopes                      []string                            `json:"allowedScopes,omitempty"                     yaml:"allowed_scopes,omitempty"                     jsonschema:"Scopes the client may obtain. Requested scopes outside this set are dropped, or rejected when rejectDisallowedScopes is set. Omit to allow any scope."`
+	RejectDisallowedScopes             bool                                `json:"rejectDisallowedScopes"                      yaml:"reject_disallowed_scopes"                     jsonschema:"Reject requests for scopes outside allowedScopes with invalid_scope, instead of dropping those scopes."`
+	AllowedScopesByGrantType           map[string][]string                 `json:"allowedScopesByGrantType,omitempty"          yaml:"allowed_scopes_by_grant_type,omitempty"       jsonschema:"Allowed-scope policy per grant type. Supported for client_credentials, where the client is granted the policy scopes it requests, or all of them when it requests none."`
+	Logout                             *LogoutConfig                       `json:"logout,omitempty"                            yaml:"logout,omitempty"                             jsonschema:"Front-channel and back-channel logout configuration."`
 	UserInfo                           *UserInfoConfig                     `json:"userInfo,omitempty"                          yaml:"user_info,omitempty"                          jsonschema:"UserInfo endpoint configuration. Configure user attributes returned from the OIDC userinfo endpoint."`
 	ScopeClaims                        map[string][]string                 `json:"scopeClaims,omitempty"                       yaml:"scope_claims,omitempty"                       jsonschema:"Scope-to-claims mapping. Maps OAuth scopes to user claims for both ID token and userinfo."`
 	Certificate                        *Certificate                        `json:"certificate,omitempty"                       yaml:"certificate,omitempty"                        jsonschema:"Application certificate. Optional. For certificate-based authentication or JWT validation."`
 	AcrValues                          []string                            `json:"acrValues,omitempty"                         yaml:"acr_values,omitempty"                         jsonschema:"Default ACR values applied when the request does not specify acr_values."`
+	ClaimFallbacks                     map[string][]ClaimFallbackSource    `json:"claimFallbacks,omitempty"                    yaml:"claim_fallbacks,omitempty"                    jsonschema:"Fallback chains per claim, resolved in order when the user has no value for the claim."`
+	TokenCustomization                 *TokenCustomization                 `json:"tokenCustomization,omitempty"                yaml:"token_customization,omitempty"                jsonschema:"Claim rules applied to the access and ID tokens of the application."`
 }
 
 // OAuthConfig is the wire output shape (GET responses). ClientSecret is structurally absent.
@@ -149,18 +271,36 @@ type OAuthConfigWithSecret struct {
 type OAuthConfig struct {
 	ClientID                           string                              `json:"clientId,omitempty"`
 	RedirectURIs                       []string                            `json:"redirectUris,omitempty"`
+	RedirectURIMatchMode               RedirectURIMatchMode                `json:"redirectUriMatchMode,omitempty"`
 	GrantTypes                         []oauth2const.GrantType             `json:"grantTypes,omitempty"`
 	ResponseTypes                      []oauth2const.ResponseType          `json:"responseTypes,omitempty"`
 	TokenEndpointAuthMethod            oauth2const.TokenEndpointAuthMethod `json:"tokenEndpointAuthMethod,omitempty"`
 	PKCERequired                       bool                                `json:"pkceRequired"`
 	PublicClient                       bool                                `json:"publicClient"`
 	RequirePushedAuthorizationRequests bool                                `json:"requirePushedAuthorizationRequests"`
+	AllowCredentialDiscovery           bool                                `json:"allowCredentialDiscovery"`
+	ProtocolTraceEnabled               bool                                `json:"protocolTraceEnabled"`
+	RequestObjectSigningAlg            string                              `json:"requestObjectSigningAlg,omitempty"`
+	DPoPBoundAccessTokens              bool                                `json:"dpopBoundAccessTokens"`
 	Token                              *OAuthTokenConfig                   `json:"token,omitempty"`
 	Scopes                             []string                            `json:"scopes,omitempty"`
+	AllowedScopes                      []string                            `json:"allowedScopes,omitempty"`
+	RejectDisallowedScopes             bool                                `json:"rejectDisallowedScopes"`
+	AllowedScopesByGrantType           map[string][]string                 `json:"allowedScopesByGrantType,omitempty"`
+	Logout                             *LogoutConfig                       `json:"logout,omitempty"`
 	UserInfo                           *UserInfoConfig                     `json:"userInfo,omitempty"`
 	ScopeClaims                        map[string][]string                 `json:"scopeClaims,omitempty"`
 	Certificate                        *Certificate                        `json:"certificate,omitempty"`
 	AcrValues                          []string                            `json:"acrValues,omitempty"`
+	ClaimFallbacks                     map[string][]ClaimFallbackSource    `json:"claimFallbacks,omitempty"`
+	TokenCustomization                 *TokenCustomization                 `json:"tokenCustomization,omitempty"`
+}
+
+// SupportedRequestObjectSigningAlgs lists JWS algorithms supported for signed request objects.
+var SupportedRequestObjectSigningAlgs = []string{
+	string(jws.RS256), string(jws.RS512), string(jws.PS256),
+	string(jws.ES256), string(jws.ES384), string(jws.ES512),
+	string(jws.EdDSA),
 }
 
 // SupportedIDTokenEncryptionAlgs lists JWE key-management algorithms supported for ID token encryption.
@@ -169,24 +309,38 @@ var SupportedIDTokenEncryptionAlgs = []string{string(jwe.RSAOAEP), string(jwe.RS
 // SupportedIDTokenEncryptionEncs lists JWE content-encryption algorithms supported for ID token encryption.
 var SupportedIDTokenEncryptionEncs = []string{string(jwe.A128CBCHS256), string(jwe.A256GCM)}
 
+// ScopePolicyGrantTypes lists the grant types for which an allowed-scope policy can be configured.
+var ScopePolicyGrantTypes = []oauth2const.GrantType{oauth2const.GrantTypeClientCredentials}
+
 // OAuthClient is the resolved runtime view.
 type OAuthClient struct {
 	ID                                 string                              `yaml:"id,omitempty"`
 	OUID                               string                              `yaml:"ou_id,omitempty"`
 	ClientID                           string                              `yaml:"client_id,omitempty"`
 	RedirectURIs                       []string                            `yaml:"redirect_uris,omitempty"`
+	RedirectURIMatchMode               RedirectURIMatchMode                `yaml:"redirect_uri_match_mode,omitempty"`
 	GrantTypes                         []oauth2const.GrantType             `yaml:"grant_types,omitempty"`
 	ResponseTypes                      []oauth2const.ResponseType          `yaml:"response_types,omitempty"`
 	TokenEndpointAuthMethod            oauth2const.TokenEndpointAuthMethod `yaml:"token_endpoint_auth_method,omitempty"`
 	PKCERequired                       bool                                `yaml:"pkce_required,omitempty"`
 	PublicClient                       bool                                `yaml:"public_client,omitempty"`
 	RequirePushedAuthorizationRequests bool                                `yaml:"require_pushed_authorization_requests,omitempty"`
+	AllowCredentialDiscovery           bool                                `yaml:"allow_credential_discovery,omitempty"`
+	ProtocolTraceEnabled               bool                                `yaml:"protocol_trace_enabled,omitempty"`
+	RequestObjectSigningAlg            string                              `yaml:"request_object_signing_alg,omitempty"`
+	DPoPBoundAccessTokens              bool                                `yaml:"dpop_bound_access_tokens,omitempty"`
 	Token                              *OAuthTokenConfig                   `yaml:"token,omitempty"`
 	Scopes                             []string                            `yaml:"scopes,omitempty"`
+	AllowedScopes                      []string                            `yaml:"allowed_scopes,omitempty"`
+	RejectDisallowedScopes             bool                                `yaml:"reject_disallowed_scopes,omitempty"`
+	AllowedScopesByGrantType           map[string][]string                 `yaml:"allowed_scopes_by_grant_type,omitempty"`
+	Logout                             *LogoutConfig                       `yaml:"logout,omitempty"`
 	UserInfo                           *UserInfoConfig                     `yaml:"user_info,omitempty"`
 	ScopeClaims                        map[string][]string                 `yaml:"scope_claims,omitempty"`
 	Certificate                        *Certificate                        `yaml:"certificate,omitempty"`
 	AcrValues                          []string                            `yaml:"acr_values,omitempty"`
+	ClaimFallbacks                     map[string][]ClaimFallbackSource    `yaml:"claim_fallbacks,omitempty"`
+	TokenCustomization                 *TokenCustomization                 `yaml:"token_customization,omitempty"`
 }
 
 // IsAllowedGrantType reports whether the given grant type is allowed for this client.
@@ -206,7 +360,7 @@ func (o *OAuthClient) IsAllowedTokenEndpointAuthMethod(method oauth2const.TokenE
 
 // ValidateRedirectURI validates the given redirect URI against this client's registered URIs.
 func (o *OAuthClient) ValidateRedirectURI(redirectURI string) error {
-	return ValidateRedirectURI(o.RedirectURIs, redirectURI)
+	return ValidateRedirectURIWithMode(o.RedirectURIs, o.RedirectURIMatchMode, redirectURI)
 }
 
 // RequiresPKCE reports whether PKCE is required for this client.
@@ -219,6 +373,32 @@ func (o *OAuthClient) RequiresPAR() bool {
 	return o.RequirePushedAuthorizationRequests || config.GetServerRuntime().Config.OAuth.PAR.RequirePAR
 }
 
+// ApplyAllowedScopes restricts the requested scopes to the allowed scopes of this client. Scopes outside
+// them are dropped, unless the client rejects disallowed scopes in which case false is returned. A client
+// without allowed scopes is not restricted.
+func (o *OAuthClient) ApplyAllowedScopes(scopes []string) ([]string, bool) {
+	if len(o.AllowedScopes) == 0 {
+		return scopes, true
+	}
+
+	allowed := make([]string, 0, len(scopes))
+	for _, scope := range scopes {
+		if slices.Contains(o.AllowedScopes, scope) {
+			allowed = append(allowed, scope)
+		} else if o.RejectDisallowedScopes {
+			return nil, false
+		}
+	}
+	return allowed, true
+}
+
+// GetGrantTypeAllowedScopes returns the allowed-scope policy of this client for the given grant type, and
+// whether one is configured.
+func (o *OAuthClient) GetGrantTypeAllowedScopes(grantType oauth2const.GrantType) ([]string, bool) {
+	scopes, ok := o.AllowedScopesByGrantType[string(grantType)]
+	return scopes, ok
+}
+
 // InboundAuthConfigWithSecret is the wire input wrapper and create/update echo response wrapper.
 type InboundAuthConfigWithSecret struct {
 	Type        InboundAuthType        `json:"type"             yaml:"type"             jsonschema:"Inbound authentication type. Use 'oauth2' for OAuth/OIDC applications."`
@@ -253,8 +433,15 @@ func IsAllowedResponseType(responseTypes []oauth2const.ResponseType, responseTyp
 	return slices.Contains(responseTypes, oauth2const.ResponseType(responseType))
 }
 
-// ValidateRedirectURI validates the provided redirect URI against the registered list.
+// ValidateRedirectURI validates the provided redirect URI against the registered list using the default
+// match mode.
 func ValidateRedirectURI(redirectURIs []string, redirectURI string) error {
+	return ValidateRedirectURIWithMode(redirectURIs, RedirectURIMatchModePattern, redirectURI)
+}
+
+// ValidateRedirectURIWithMode validates the provided redirect URI against the registered list using the
+// given match mode. An empty mode is treated as RedirectURIMatchModePattern.
+func ValidateRedirectURIWithMode(redirectURIs []string, mode RedirectURIMatchMode, redirectURI string) error {
 	logger := log.GetLogger()
 
 	if redirectURI == "" {
@@ -272,7 +459,7 @@ func ValidateRedirectURI(redirectURIs []string, redirectURI string) error {
 		return nil
 	}
 
-	if !matchAnyRedirectURIPattern(redirectURIs, redirectURI) {
+	if !matchAnyRedirectURIPattern(redirectURIs, mode, redirectURI) {
 		return fmt.Errorf("your application's redirect URL does not match with the registered redirect URLs")
 	}
 
@@ -289,11 +476,12 @@ func ValidateRedirectURI(redirectURIs []string, redirectURI string) error {
 }
 
 // matchAnyRedirectURIPattern compares incoming against each registered URI/pattern. AC-11: first match wins.
-func matchAnyRedirectURIPattern(patterns []string, redirectURI string) bool {
-	wildcardEnabled := config.GetServerRuntime().Config.OAuth.AllowWildcardRedirectURI
+func matchAnyRedirectURIPattern(patterns []string, mode RedirectURIMatchMode, redirectURI string) bool {
+	wildcardEnabled := mode != RedirectURIMatchModeExact &&
+		config.GetServerRuntime().Config.OAuth.AllowWildcardRedirectURI
 	for _, pattern := range patterns {
 		if !wildcardEnabled || !strings.Contains(pattern, "*") {
-			if pattern == redirectURI {
+			if pattern == redirectURI || matchLoopbackRedirectURI(pattern, redirectURI) {
 				return true
 			}
 			continue
@@ -308,3 +496,28 @@ func matchAnyRedirectURIPattern(patterns []string, redirectURI string) bool {
 	}
 	return false
 }
+
+// IsLoopbackRedirectURI reports whether the given URI is an http redirect URI on a loopback IP literal
+// (127.0.0.1 or [::1]) as used by native apps per RFC 8252 section 7.3.
+func IsLoopbackRedirectURI(uri string) bool {
+	parsed, err := url.Parse(uri)
+	if err != nil || parsed.Scheme != "http" {
+		return false
+	}
+	host := parsed.Hostname()
+	return host == "127.0.0.1" || host == "::1"
+}
+
+// matchLoopbackRedirectURI compares a registered loopback redirect URI with the requested one ignoring the
+// port, since native apps bind to an ephemeral port at request time (RFC 8252 section 7.3).
+func matchLoopbackRedirectURI(registered, redirectURI string) bool {
+	if !IsLoopbackRedirectURI(registered) || !IsLoopbackRedirectURI(redirectURI) {
+		return false
+	}
+	registeredURL, _ := url.Parse(registered)
+	requestedURL, _ := url.Parse(redirectURI)
+	return registeredURL.Hostname() == requestedURL.Hostname() &&
+		registeredURL.EscapedPath() == requestedURL.EscapedPath() &&
+		registeredURL.RawQuery == requestedURL.RawQuery &&
+		requestedURL.User == nil
+}
@@ -573,6 +573,31 @@ func (suite *OAuthClientTestSuite) TestRequiresPAR_BothFalse() {
 	suite.False(c.RequiresPAR())
 }
 
+func (suite *OAuthClientTestSuite) TestApplyAllowedScopes_NoRestriction() {
+	c := &model.OAuthClient{}
+	scopes, ok := c.ApplyAllowedScopes([]string{"openid", "read"})
+	suite.True(ok)
+	suite.Equal([]string{"openid", "read"}, scopes)
+}
+
+func (suite *OAuthClientTestSuite) TestApplyAllowedScopes_IntersectsByDefault() {
+	c := &model.OAuthClient{AllowedScopes: []string{"openid", "read"}}
+	scopes, ok := c.ApplyAllowedScopes([]string{"openid", "read", "write"})
+	suite.True(ok)
+	suite.Equal([]string{"openid", "read"}, scopes)
+}
+
+func (suite *OAuthClientTestSuite) TestApplyAllowedScopes_RejectsWhenConfigured() {
+	c := &model.OAuthClient{AllowedScopes: []string{"openid", "read"}, RejectDisallowedScopes: true}
+	scopes, ok := c.ApplyAllowedScopes([]string{"openid", "write"})
+	suite.False(ok)
+	suite.Nil(scopes)
+
+	scopes, ok = c.ApplyAllowedScopes([]string{"read"})
+	suite.True(ok)
+	suite.Equal([]string{"read"}, scopes)
+}
+
 func (suite *OAuthHelperTestSuite) TestMatchAnyRedirectURIPattern_WildcardEnabled_Matches() {
 	sysconfig.ResetServerRuntime()
 	cfg := &sysconfig.Config{}
@@ -643,3 +668,69 @@ func (suite *OAuthHelperTestSuite) TestMatchAnyRedirectURIPattern_HostWildcardDo
 	)
 	suite.Error(err)
 }
+
+func (suite *OAuthHelperTestSuite) TestValidateRedirectURIWithMode_ExactIgnoresWildcard() {
+	sysconfig.ResetServerRuntime()
+	cfg := &sysconfig.Config{}
+	cfg.OAuth.AllowWildcardRedirectURI = true
+	suite.Require().NoError(sysconfig.InitializeServerRuntime("/tmp/test", cfg))
+
+	err := model.ValidateRedirectURIWithMode(
+		[]string{"https://app.example.com/*"},
+		model.RedirectURIMatchModeExact,
+		"https://app.example.com/cb",
+	)
+	suite.EqualError(err, errRedirectURINotRegistered)
+}
+
+func (suite *OAuthHelperTestSuite) TestValidateRedirectURI_LoopbackIgnoresPort() {
+	testCases := []struct {
+		name       string
+		registered string
+		requested  string
+		valid      bool
+	}{
+		{"IPv4 ephemeral port", "http://127.0.0.1/callback", "http://127.0.0.1:51234/callback", true},
+		{"IPv4 registered port", "http://127.0.0.1:8080/callback", "http://127.0.0.1:9090/callback", true},
+		{"IPv6 ephemeral port", "http://[::1]/callback", "http://[::1]:51234/callback", true},
+		{"different path", "http://127.0.0.1/callback", "http://127.0.0.1:51234/other", false},
+		{"different loopback address", "http://127.0.0.1/callback", "http://[::1]:51234/callback", false},
+		{"https scheme", "https://127.0.0.1/callback", "https://127.0.0.1:51234/callback", false},
+		{"localhost hostname", "http://localhost/callback", "http://localhost:51234/callback", false},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			for _, mode := range []model.RedirectURIMatchMode{
+				model.RedirectURIMatchModeExact, model.RedirectURIMatchModePattern,
+			} {
+				err := model.ValidateRedirectURIWithMode([]string{tc.registered}, mode, tc.requested)
+				if tc.valid {
+					suite.NoError(err)
+				} else {
+					suite.Error(err)
+				}
+			}
+		})
+	}
+}
+
+func (suite *OAuthHelperTestSuite) TestOAuthClientValidateRedirectURI_UsesMatchMode() {
+	sysconfig.ResetServerRuntime()
+	cfg := &sysconfig.Config{}
+	cfg.OAuth.AllowWildcardRedirectURI = true
+	suite.Require().NoError(sysconfig.InitializeServerRuntime("/tmp/test", cfg))
+
+	client := &model.OAuthClient{RedirectURIs: []string{"https://app.example.com/*"}}
+	suite.NoError(client.ValidateRedirectURI("https://app.example.com/cb"))
+
+	client.RedirectURIMatchMode = model.RedirectURIMatchModeExact
+	suite.Error(client.ValidateRedirectURI("https://app.example.com/cb"))
+}
+
+func (suite *OAuthClientTestSuite) TestClaimFallbackSource_TemplatePlaceholders() {
+	source := model.ClaimFallbackSource{Template: "{given_name} { family_name } ({address.locality}) {} {open"}
+
+	assert.Equal(suite.T(), []string{"given_name", "family_name", "address.locality"}, source.TemplatePlaceholders())
+	assert.Empty(suite.T(), model.ClaimFallbackSource{Template: "Anonymous"}.TemplatePlaceholders())
+}
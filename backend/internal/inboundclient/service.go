@@ -25,6 +25,7 @@ import (
 	"fmt"
 	"slices"
 	"strings"
+	"unicode"
 
 	"github.com/thunder-id/thunderid/internal/cert"
 	"github.com/thunder-id/thunderid/internal/consent"
@@ -69,6 +70,9 @@ type InboundClientServiceInterface interface {
 	GetOAuthProfileByEntityID(ctx context.Context, entityID string) (*inboundmodel.OAuthProfile, error)
 	// GetOAuthClientByClientID resolves a full OAuthClient by its public client_id.
 	GetOAuthClientByClientID(ctx context.Context, clientID string) (*inboundmodel.OAuthClient, error)
+	// UpdateAllowedScopesByGrantType validates and persists the allowed-scope policies of the OAuth profile of
+	// the given entity, leaving the rest of the profile unchanged.
+	UpdateAllowedScopesByGrantType(ctx context.Context, entityID string, policies map[string][]string) error
 
 	// IsDeclarative reports whether the entity's inbound profile was loaded from a declarative resource file.
 	IsDeclarative(ctx context.Context, entityID string) bool
@@ -366,6 +370,30 @@ func (s *inboundClientService) syncOAuthProfile(ctx context.Context, entityID st
 	})
 }
 
+// UpdateAllowedScopesByGrantType validates and persists the allowed-scope policies of the OAuth profile of the
+// given entity, leaving the rest of the profile unchanged.
+func (s *inboundClientService) UpdateAllowedScopesByGrantType(ctx context.Context, entityID string,
+	policies map[string][]string) error {
+	if s.store.IsDeclarative(ctx, entityID) {
+		return ErrCannotModifyDeclarative
+	}
+	return s.transactioner.Transact(ctx, func(txCtx context.Context) error {
+		existing, err := s.store.GetOAuthProfileByEntityID(txCtx, entityID)
+		if err != nil {
+			return err
+		}
+		if existing == nil {
+			return ErrInboundClientNotFound
+		}
+		updated := *existing
+		updated.AllowedScopesByGrantType = policies
+		if err := validateScopePolicies(&updated); err != nil {
+			return err
+		}
+		return s.store.UpdateOAuthProfile(txCtx, entityID, &updated)
+	})
+}
+
 // GetOAuthClientByClientID resolves a full OAuthClient by its public client_id.
 func (s *inboundClientService) GetOAuthClientByClientID(ctx context.Context, clientID string) (
 	*inboundmodel.OAuthClient, error) {
@@ -422,16 +450,27 @@ func BuildOAuthClient(entityID, clientID, ouID string, p *inboundmodel.OAuthProf
 		OUID:                               ouID,
 		ClientID:                           clientID,
 		RedirectURIs:                       p.RedirectURIs,
+		RedirectURIMatchMode:               inboundmodel.RedirectURIMatchMode(p.RedirectURIMatchMode),
 		TokenEndpointAuthMethod:            oauth2const.TokenEndpointAuthMethod(p.TokenEndpointAuthMethod),
 		PKCERequired:                       p.PKCERequired,
 		PublicClient:                       p.PublicClient,
 		RequirePushedAuthorizationRequests: p.RequirePushedAuthorizationRequests,
+		DPoPBoundAccessTokens:              p.DPoPBoundAccessTokens,
+		AllowCredentialDiscovery:           p.AllowCredentialDiscovery,
+		ProtocolTraceEnabled:               p.ProtocolTraceEnabled,
+		RequestObjectSigningAlg:            p.RequestObjectSigningAlg,
 		Scopes:                             p.Scopes,
+		AllowedScopes:                      p.AllowedScopes,
+		RejectDisallowedScopes:             p.RejectDisallowedScopes,
+		AllowedScopesByGrantType:           p.AllowedScopesByGrantType,
+		Logout:                             p.Logout,
 		ScopeClaims:                        p.ScopeClaims,
 		Token:                              p.Token,
 		UserInfo:                           p.UserInfo,
 		Certificate:                        p.Certificate,
 		AcrValues:                          p.AcrValues,
+		ClaimFallbacks:                     p.ClaimFallbacks,
+		TokenCustomization:                 p.TokenCustomization,
 	}
 	for _, gt := range p.GrantTypes {
 		client.GrantTypes = append(client.GrantTypes, oauth2const.GrantType(gt))
@@ -629,6 +668,18 @@ func validateOAuthProfile(p *inboundmodel.OAuthProfile, hasClientSecret bool) er
 	if err := validateIDTokenConfig(p); err != nil {
 		return err
 	}
+	if err := validateLogoutConfig(p); err != nil {
+		return err
+	}
+	if err := validateRefreshTokenConfig(p); err != nil {
+		return err
+	}
+	if err := validateRequestObjectConfig(p); err != nil {
+		return err
+	}
+	if err := validateScopePolicies(p); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -737,8 +788,93 @@ func validateIDTokenConfig(p *inboundmodel.OAuthProfile) error {
 	return nil
 }
 
+// validateLogoutConfig validates the front-channel and back-channel logout URIs.
+// The back-channel logout URI is called by the server and must therefore pass the SSRF safety checks.
+func validateLogoutConfig(p *inboundmodel.OAuthProfile) error {
+	if p.Logout == nil {
+		return nil
+	}
+	if p.Logout.FrontChannelLogoutURI != "" && !isAbsoluteURLWithoutFragment(p.Logout.FrontChannelLogoutURI) {
+		return ErrOAuthInvalidFrontChannelLogoutURI
+	}
+	if p.Logout.BackChannelLogoutURI != "" {
+		if !isAbsoluteURLWithoutFragment(p.Logout.BackChannelLogoutURI) {
+			return ErrOAuthInvalidBackChannelLogoutURI
+		}
+		if err := syshttp.IsSSRFSafeURL(p.Logout.BackChannelLogoutURI); err != nil {
+			return ErrOAuthInvalidBackChannelLogoutURI
+		}
+	}
+	return nil
+}
+
+// validateRefreshTokenConfig validates the refresh token lifetime configuration.
+func validateRefreshTokenConfig(p *inboundmodel.OAuthProfile) error {
+	if p.Token == nil || p.Token.RefreshToken == nil {
+		return nil
+	}
+	if p.Token.RefreshToken.ValidityPeriod < 0 || p.Token.RefreshToken.IdleTimeout < 0 {
+		return ErrOAuthInvalidRefreshTokenLifetime
+	}
+	return nil
+}
+
+// validateRequestObjectConfig validates the signing algorithm of request objects. Request objects are verified
+// with the application certificate, so one must be configured when an algorithm is set.
+func validateRequestObjectConfig(p *inboundmodel.OAuthProfile) error {
+	if p.RequestObjectSigningAlg == "" {
+		return nil
+	}
+	if !slices.Contains(inboundmodel.SupportedRequestObjectSigningAlgs, p.RequestObjectSigningAlg) {
+		return ErrOAuthUnsupportedRequestObjectSigningAlg
+	}
+	if p.Certificate == nil || (p.Certificate.Type != cert.CertificateTypeJWKS &&
+		p.Certificate.Type != cert.CertificateTypeJWKSURI) {
+		return ErrOAuthRequestObjectRequiresCertificate
+	}
+	return nil
+}
+
+// validateScopePolicies validates the allowed-scope policies configured per grant type.
+func validateScopePolicies(p *inboundmodel.OAuthProfile) error {
+	for grantType, scopes := range p.AllowedScopesByGrantType {
+		if !slices.Contains(inboundmodel.ScopePolicyGrantTypes, oauth2const.GrantType(grantType)) {
+			return ErrOAuthUnsupportedScopePolicyGrantType
+		}
+		if !slices.Contains(p.GrantTypes, grantType) {
+			return ErrOAuthScopePolicyGrantTypeNotAllowed
+		}
+		if len(scopes) == 0 {
+			return ErrOAuthInvalidScopePolicy
+		}
+		for _, scope := range scopes {
+			if scope == "" || strings.ContainsFunc(scope, unicode.IsSpace) {
+				return ErrOAuthInvalidScopePolicy
+			}
+		}
+	}
+	return nil
+}
+
+// isAbsoluteURLWithoutFragment reports whether the given URI has a scheme and host and no fragment.
+func isAbsoluteURLWithoutFragment(uri string) bool {
+	parsedURI, err := sysutils.ParseURL(uri)
+	if err != nil {
+		return false
+	}
+	return parsedURI.Scheme != "" && parsedURI.Host != "" && parsedURI.Fragment == ""
+}
+
 // validateRedirectURIs validates redirect URIs and authorization_code grant requirements.
+// Wildcards are only accepted when enabled on the server and the client does not use exact matching.
 func validateRedirectURIs(p *inboundmodel.OAuthProfile) error {
+	mode := inboundmodel.RedirectURIMatchMode(p.RedirectURIMatchMode)
+	if mode != "" && mode != inboundmodel.RedirectURIMatchModeExact &&
+		mode != inboundmodel.RedirectURIMatchModePattern {
+		return ErrOAuthInvalidRedirectURIMatchMode
+	}
+	wildcardEnabled := mode != inboundmodel.RedirectURIMatchModeExact &&
+		config.GetServerRuntime().Config.OAuth.AllowWildcardRedirectURI
 	for _, redirectURI := range p.RedirectURIs {
 		// Reject wildcards in the scheme before URL parsing — url.Parse may misinterpret them.
 		if idx := strings.Index(redirectURI, "://"); idx != -1 {
@@ -756,10 +892,9 @@ func validateRedirectURIs(p *inboundmodel.OAuthProfile) error {
 		if parsedURI.Fragment != "" {
 			return ErrOAuthRedirectURIFragmentNotAllowed
 		}
-		wildcardEnabled := config.GetServerRuntime().Config.OAuth.AllowWildcardRedirectURI
 		if strings.ContainsRune(parsedURI.Host, '*') {
 			if !wildcardEnabled {
-				return ErrOAuthInvalidRedirectURI
+				return ErrOAuthRedirectURIWildcardHostNotAllowed
 			}
 			if err := validateHostWildcardPattern(parsedURI.Host); err != nil {
 				return err
@@ -772,7 +907,7 @@ func validateRedirectURIs(p *inboundmodel.OAuthProfile) error {
 			return ErrOAuthInvalidRedirectURI
 		}
 		if strings.ContainsRune(parsedURI.Path, '*') && !wildcardEnabled {
-			return ErrOAuthInvalidRedirectURI
+			return ErrOAuthRedirectURIPatternNotAllowed
 		}
 	}
 	if slices.Contains(p.GrantTypes, string(oauth2const.GrantTypeAuthorizationCode)) &&
@@ -891,17 +1026,24 @@ func validateTokenEndpointAuthMethod(p *inboundmodel.OAuthProfile, hasClientSecr
 	return nil
 }
 
-// validatePublicClient validates constraints required for public clients.
+// validatePublicClient validates constraints required for public clients. Public clients that only use the
+// device code grant are exempt from PKCE, since they never send authorization requests.
 func validatePublicClient(p *inboundmodel.OAuthProfile) error {
 	if oauth2const.TokenEndpointAuthMethod(p.TokenEndpointAuthMethod) != oauth2const.TokenEndpointAuthMethodNone {
 		return ErrOAuthPublicClientMustUseNoneAuth
 	}
-	if !p.PKCERequired {
+	if !p.PKCERequired && !isDeviceOnlyClient(p) {
 		return ErrOAuthPublicClientMustHavePKCE
 	}
 	return nil
 }
 
+// isDeviceOnlyClient reports whether the client uses the device code grant without the authorization code grant.
+func isDeviceOnlyClient(p *inboundmodel.OAuthProfile) bool {
+	return slices.Contains(p.GrantTypes, string(oauth2const.GrantTypeDeviceCode)) &&
+		!slices.Contains(p.GrantTypes, string(oauth2const.GrantTypeAuthorizationCode))
+}
+
 // validateFKs validates all FK references on an inbound client.
 func (s *inboundClientService) validateFKs(ctx context.Context, c *inboundmodel.InboundClient) error {
 	if c == nil {
@@ -1140,7 +1282,13 @@ func applyInboundDefaults(c *inboundmodel.InboundClient, oauthProfile *inboundmo
 		assertion = c.Assertion
 	}
 	accessToken, idToken := resolveOAuthTokens(oauthProfile.Token, assertion)
-	oauthProfile.Token = &inboundmodel.OAuthTokenConfig{AccessToken: accessToken, IDToken: idToken}
+	var refreshToken *inboundmodel.RefreshTokenConfig
+	if oauthProfile.Token != nil {
+		refreshToken = oauthProfile.Token.RefreshToken
+	}
+	oauthProfile.Token = &inboundmodel.OAuthTokenConfig{
+		AccessToken: accessToken, IDToken: idToken, RefreshToken: refreshToken,
+	}
 	oauthProfile.UserInfo = resolveUserInfo(oauthProfile.UserInfo, idToken)
 	oauthProfile.ScopeClaims = resolveScopeClaims(oauthProfile.ScopeClaims)
 }
@@ -1189,6 +1337,7 @@ func resolveOAuthTokens(in *inboundmodel.OAuthTokenConfig,
 		accessToken = &inboundmodel.AccessTokenConfig{
 			ValidityPeriod: in.AccessToken.ValidityPeriod,
 			UserAttributes: in.AccessToken.UserAttributes,
+			IncludeRoles:   in.AccessToken.IncludeRoles,
 		}
 	}
 	if accessToken != nil {
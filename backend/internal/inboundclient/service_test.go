@@ -1015,6 +1015,91 @@ func (suite *InboundClientServiceTestSuite) TestValidateOAuthProfile_NilProfile(
 	assert.NoError(suite.T(), validateOAuthProfile(nil, false))
 }
 
+// validateScopePolicies
+
+func clientCredentialsProfile(policies map[string][]string) *inboundmodel.OAuthProfile {
+	return &inboundmodel.OAuthProfile{
+		GrantTypes:               []string{"client_credentials"},
+		TokenEndpointAuthMethod:  "client_secret_basic",
+		AllowedScopesByGrantType: policies,
+	}
+}
+
+func (suite *InboundClientServiceTestSuite) TestValidateScopePolicies() {
+	tests := []struct {
+		name     string
+		profile  *inboundmodel.OAuthProfile
+		expected error
+	}{
+		{"NoPolicies", clientCredentialsProfile(nil), nil},
+		{"ValidPolicy", clientCredentialsProfile(map[string][]string{"client_credentials": {"read"}}), nil},
+		{"UnsupportedGrantType", &inboundmodel.OAuthProfile{
+			GrantTypes:               []string{"authorization_code"},
+			AllowedScopesByGrantType: map[string][]string{"authorization_code": {"read"}},
+		}, ErrOAuthUnsupportedScopePolicyGrantType},
+		{"GrantTypeNotUsed", &inboundmodel.OAuthProfile{
+			GrantTypes:               []string{"authorization_code"},
+			AllowedScopesByGrantType: map[string][]string{"client_credentials": {"read"}},
+		}, ErrOAuthScopePolicyGrantTypeNotAllowed},
+		{"EmptyScopeList", clientCredentialsProfile(map[string][]string{"client_credentials": {}}),
+			ErrOAuthInvalidScopePolicy},
+		{"BlankScope", clientCredentialsProfile(map[string][]string{"client_credentials": {""}}),
+			ErrOAuthInvalidScopePolicy},
+		{"ScopeWithWhitespace", clientCredentialsProfile(map[string][]string{"client_credentials": {"read write"}}),
+			ErrOAuthInvalidScopePolicy},
+	}
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			err := validateScopePolicies(tc.profile)
+			if tc.expected == nil {
+				assert.NoError(suite.T(), err)
+			} else {
+				assert.ErrorIs(suite.T(), err, tc.expected)
+			}
+		})
+	}
+}
+
+// ----- UpdateAllowedScopesByGrantType -----
+
+func (suite *InboundClientServiceTestSuite) TestUpdateAllowedScopesByGrantType_Succeeds() {
+	store := newInboundClientStoreInterfaceMock(suite.T())
+	store.EXPECT().IsDeclarative(mock.Anything, "p1").Return(false)
+	store.EXPECT().GetOAuthProfileByEntityID(mock.Anything, "p1").Return(clientCredentialsProfile(nil), nil)
+	policies := map[string][]string{"client_credentials": {"read"}}
+	store.EXPECT().UpdateOAuthProfile(mock.Anything, "p1", clientCredentialsProfile(policies)).Return(nil)
+
+	err := newServiceForTest(store).UpdateAllowedScopesByGrantType(context.Background(), "p1", policies)
+	assert.NoError(suite.T(), err)
+}
+
+func (suite *InboundClientServiceTestSuite) TestUpdateAllowedScopesByGrantType_RefusesDeclarative() {
+	store := newInboundClientStoreInterfaceMock(suite.T())
+	store.EXPECT().IsDeclarative(mock.Anything, "p1").Return(true)
+
+	err := newServiceForTest(store).UpdateAllowedScopesByGrantType(context.Background(), "p1", nil)
+	assert.ErrorIs(suite.T(), err, ErrCannotModifyDeclarative)
+}
+
+func (suite *InboundClientServiceTestSuite) TestUpdateAllowedScopesByGrantType_ProfileNotFound() {
+	store := newInboundClientStoreInterfaceMock(suite.T())
+	store.EXPECT().IsDeclarative(mock.Anything, "p1").Return(false)
+	store.EXPECT().GetOAuthProfileByEntityID(mock.Anything, "p1").Return(nil, nil)
+
+	err := newServiceForTest(store).UpdateAllowedScopesByGrantType(context.Background(), "p1", nil)
+	assert.ErrorIs(suite.T(), err, ErrInboundClientNotFound)
+}
+
+func (suite *InboundClientServiceTestSuite) TestUpdateAllowedScopesByGrantType_ValidationFails() {
+	store := newInboundClientStoreInterfaceMock(suite.T())
+	store.EXPECT().IsDeclarative(mock.Anything, "p1").Return(false)
+	store.EXPECT().GetOAuthProfileByEntityID(mock.Anything, "p1").Return(clientCredentialsProfile(nil), nil)
+
+	err := newServiceForTest(store).UpdateAllowedScopesByGrantType(context.Background(), "p1",
+		map[string][]string{"client_credentials": {}})
+	assert.ErrorIs(suite.T(), err, ErrOAuthInvalidScopePolicy)
+}
+
 // ----- BuildOAuthClient -----
 
 func (suite *InboundClientServiceTestSuite) TestBuildOAuthClient_MapsAllFields() {
@@ -1086,11 +1171,14 @@ func (suite *InboundClientServiceTestSuite) TestResolveOAuthTokens_NilInputUsesA
 
 func (suite *InboundClientServiceTestSuite) TestResolveOAuthTokens_InputOverrides() {
 	in := &inboundmodel.OAuthTokenConfig{
-		AccessToken: &inboundmodel.AccessTokenConfig{ValidityPeriod: 60, UserAttributes: []string{"sub"}},
-		IDToken:     &inboundmodel.IDTokenConfig{ValidityPeriod: 120, UserAttributes: []string{"email"}},
+		AccessToken: &inboundmodel.AccessTokenConfig{
+			ValidityPeriod: 60, UserAttributes: []string{"sub"}, IncludeRoles: true,
+		},
+		IDToken: &inboundmodel.IDTokenConfig{ValidityPeriod: 120, UserAttributes: []string{"email"}},
 	}
 	at, idt := resolveOAuthTokens(in, &inboundmodel.AssertionConfig{ValidityPeriod: 900})
 	assert.Equal(suite.T(), int64(60), at.ValidityPeriod)
+	assert.True(suite.T(), at.IncludeRoles)
 	assert.Equal(suite.T(), int64(120), idt.ValidityPeriod)
 }
 
@@ -1124,6 +1212,100 @@ func (suite *InboundClientServiceTestSuite) TestResolveScopeClaims_PassesThrough
 	assert.Equal(suite.T(), in, out)
 }
 
+// ----- validateLogoutConfig -----
+
+func (suite *InboundClientServiceTestSuite) TestValidateLogoutConfig() {
+	cases := []struct {
+		name    string
+		logout  *inboundmodel.LogoutConfig
+		wantErr error
+	}{
+		{"NotConfigured", nil, nil},
+		{"Valid", &inboundmodel.LogoutConfig{
+			FrontChannelLogoutURI: "http://localhost:3000/logout",
+			BackChannelLogoutURI:  "https://rp.example.com/backchannel",
+		}, nil},
+		{"RelativeFrontChannelURI", &inboundmodel.LogoutConfig{FrontChannelLogoutURI: "/logout"},
+			ErrOAuthInvalidFrontChannelLogoutURI},
+		{"FrontChannelURIWithFragment", &inboundmodel.LogoutConfig{FrontChannelLogoutURI: "https://rp/logout#x"},
+			ErrOAuthInvalidFrontChannelLogoutURI},
+		{"BackChannelURINotHTTPS", &inboundmodel.LogoutConfig{BackChannelLogoutURI: "http://rp.example.com/bc"},
+			ErrOAuthInvalidBackChannelLogoutURI},
+		{"BackChannelURIPrivateAddress", &inboundmodel.LogoutConfig{BackChannelLogoutURI: "https://127.0.0.1/bc"},
+			ErrOAuthInvalidBackChannelLogoutURI},
+	}
+	for _, tc := range cases {
+		suite.Run(tc.name, func() {
+			err := validateLogoutConfig(&inboundmodel.OAuthProfile{Logout: tc.logout})
+			if tc.wantErr == nil {
+				assert.NoError(suite.T(), err)
+			} else {
+				assert.ErrorIs(suite.T(), err, tc.wantErr)
+			}
+		})
+	}
+}
+
+// ----- validateRefreshTokenConfig -----
+
+func (suite *InboundClientServiceTestSuite) TestValidateRefreshTokenConfig() {
+	cases := []struct {
+		name    string
+		token   *inboundmodel.OAuthTokenConfig
+		wantErr error
+	}{
+		{"NotConfigured", nil, nil},
+		{"Valid", &inboundmodel.OAuthTokenConfig{RefreshToken: &inboundmodel.RefreshTokenConfig{
+			ValidityPeriod: 31536000, IdleTimeout: 2592000}}, nil},
+		{"NegativeValidityPeriod", &inboundmodel.OAuthTokenConfig{RefreshToken: &inboundmodel.RefreshTokenConfig{
+			ValidityPeriod: -1}}, ErrOAuthInvalidRefreshTokenLifetime},
+		{"NegativeIdleTimeout", &inboundmodel.OAuthTokenConfig{RefreshToken: &inboundmodel.RefreshTokenConfig{
+			IdleTimeout: -1}}, ErrOAuthInvalidRefreshTokenLifetime},
+	}
+	for _, tc := range cases {
+		suite.Run(tc.name, func() {
+			err := validateRefreshTokenConfig(&inboundmodel.OAuthProfile{Token: tc.token})
+			if tc.wantErr == nil {
+				assert.NoError(suite.T(), err)
+			} else {
+				assert.ErrorIs(suite.T(), err, tc.wantErr)
+			}
+		})
+	}
+}
+
+// ----- validateRequestObjectConfig -----
+
+func (suite *InboundClientServiceTestSuite) TestValidateRequestObjectConfig() {
+	jwksURI := &inboundmodel.Certificate{Type: cert.CertificateTypeJWKSURI, Value: "https://client.example.com/jwks"}
+	cases := []struct {
+		name        string
+		alg         string
+		certificate *inboundmodel.Certificate
+		wantErr     error
+	}{
+		{"NotConfigured", "", nil, nil},
+		{"Valid", "PS256", jwksURI, nil},
+		{"UnsupportedAlg", "HS256", jwksURI, ErrOAuthUnsupportedRequestObjectSigningAlg},
+		{"MissingCertificate", "RS256", nil, ErrOAuthRequestObjectRequiresCertificate},
+		{"PEMCertificate", "RS256", &inboundmodel.Certificate{Type: "PEM", Value: "pem"},
+			ErrOAuthRequestObjectRequiresCertificate},
+	}
+	for _, tc := range cases {
+		suite.Run(tc.name, func() {
+			err := validateRequestObjectConfig(&inboundmodel.OAuthProfile{
+				RequestObjectSigningAlg: tc.alg,
+				Certificate:             tc.certificate,
+			})
+			if tc.wantErr == nil {
+				assert.NoError(suite.T(), err)
+			} else {
+				assert.ErrorIs(suite.T(), err, tc.wantErr)
+			}
+		})
+	}
+}
+
 // ----- validateRedirectURIs error branches -----
 
 func (suite *InboundClientServiceTestSuite) TestValidateRedirectURIs_SchemeWildcardRejected() {
@@ -1249,6 +1431,56 @@ func (suite *InboundClientServiceTestSuite) TestValidateRedirectURIs_AuthCodeWit
 	assert.ErrorIs(suite.T(), validateRedirectURIs(p), ErrOAuthAuthCodeRequiresRedirectURIs)
 }
 
+// ----- Redirect URI match mode -----
+
+func (suite *InboundClientServiceTestSuite) TestValidateRedirectURIs_ExactMode_WildcardHostRejected() {
+	suite.enableWildcardConfig()
+	p := &inboundmodel.OAuthProfile{
+		RedirectURIs:         []string{"https://app-*.example.com/cb"},
+		RedirectURIMatchMode: string(inboundmodel.RedirectURIMatchModeExact),
+		GrantTypes:           []string{"authorization_code"},
+	}
+	assert.ErrorIs(suite.T(), validateRedirectURIs(p), ErrOAuthRedirectURIWildcardHostNotAllowed)
+}
+
+func (suite *InboundClientServiceTestSuite) TestValidateRedirectURIs_ExactMode_PathWildcardRejected() {
+	suite.enableWildcardConfig()
+	p := &inboundmodel.OAuthProfile{
+		RedirectURIs:         []string{"https://app.example.com/*"},
+		RedirectURIMatchMode: string(inboundmodel.RedirectURIMatchModeExact),
+		GrantTypes:           []string{"authorization_code"},
+	}
+	assert.ErrorIs(suite.T(), validateRedirectURIs(p), ErrOAuthRedirectURIPatternNotAllowed)
+}
+
+func (suite *InboundClientServiceTestSuite) TestValidateRedirectURIs_PatternMode_PathWildcardAccepted() {
+	suite.enableWildcardConfig()
+	p := &inboundmodel.OAuthProfile{
+		RedirectURIs:         []string{"https://app.example.com/*"},
+		RedirectURIMatchMode: string(inboundmodel.RedirectURIMatchModePattern),
+		GrantTypes:           []string{"authorization_code"},
+	}
+	assert.NoError(suite.T(), validateRedirectURIs(p))
+}
+
+func (suite *InboundClientServiceTestSuite) TestValidateRedirectURIs_InvalidMatchMode() {
+	p := &inboundmodel.OAuthProfile{
+		RedirectURIs:         []string{"https://app.example.com/cb"},
+		RedirectURIMatchMode: "REGEX",
+		GrantTypes:           []string{"authorization_code"},
+	}
+	assert.ErrorIs(suite.T(), validateRedirectURIs(p), ErrOAuthInvalidRedirectURIMatchMode)
+}
+
+func (suite *InboundClientServiceTestSuite) TestValidateRedirectURIs_LoopbackWithoutPortAccepted() {
+	p := &inboundmodel.OAuthProfile{
+		RedirectURIs:         []string{"http://127.0.0.1/callback", "http://[::1]/callback"},
+		RedirectURIMatchMode: string(inboundmodel.RedirectURIMatchModeExact),
+		GrantTypes:           []string{"authorization_code"},
+	}
+	assert.NoError(suite.T(), validateRedirectURIs(p))
+}
+
 // ----- containsInvalidWildcardSegment -----
 
 func (suite *InboundClientServiceTestSuite) TestContainsInvalidWildcardSegment_PartialWildcard() {
@@ -1601,6 +1833,17 @@ func (suite *InboundClientServiceTestSuite) TestValidatePublicClient_HappyPath()
 	assert.NoError(suite.T(), validatePublicClient(p))
 }
 
+func (suite *InboundClientServiceTestSuite) TestValidatePublicClient_DeviceOnlyWithoutPKCE() {
+	p := &inboundmodel.OAuthProfile{
+		TokenEndpointAuthMethod: "none",
+		GrantTypes:              []string{string(oauth2const.GrantTypeDeviceCode)},
+	}
+	assert.NoError(suite.T(), validatePublicClient(p))
+
+	p.GrantTypes = append(p.GrantTypes, string(oauth2const.GrantTypeAuthorizationCode))
+	assert.ErrorIs(suite.T(), validatePublicClient(p), ErrOAuthPublicClientMustHavePKCE)
+}
+
 // ----- validateFKs aggregate paths -----
 
 func (suite *InboundClientServiceTestSuite) TestValidateFKs_AuthFlowErrorPropagated() {
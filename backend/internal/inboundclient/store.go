@@ -25,6 +25,7 @@ import (
 
 	inboundmodel "github.com/thunder-id/thunderid/internal/inboundclient/model"
 	"github.com/thunder-id/thunderid/internal/system/config"
+	sysContext "github.com/thunder-id/thunderid/internal/system/context"
 	"github.com/thunder-id/thunderid/internal/system/database/provider"
 	"github.com/thunder-id/thunderid/internal/system/log"
 	"github.com/thunder-id/thunderid/internal/system/transaction"
@@ -34,10 +35,12 @@ import (
 // inboundClientJSONBlob is the internal structure for marshaling/unmarshaling the
 // PROPERTIES column.
 type inboundClientJSONBlob struct {
-	Assertion        *inboundmodel.AssertionConfig    `json:"assertion,omitempty"`
-	LoginConsent     *inboundmodel.LoginConsentConfig `json:"loginConsent,omitempty"`
-	AllowedUserTypes []string                         `json:"allowedUserTypes,omitempty"`
-	Properties       map[string]interface{}           `json:"properties,omitempty"`
+	Assertion          *inboundmodel.AssertionConfig    `json:"assertion,omitempty"`
+	LoginConsent       *inboundmodel.LoginConsentConfig `json:"loginConsent,omitempty"`
+	AllowedUserTypes   []string                         `json:"allowedUserTypes,omitempty"`
+	AllowedAuthMethods *inboundmodel.AuthMethodsConfig  `json:"allowedAuthMethods,omitempty"`
+	UserIsolation      bool                             `json:"userIsolation,omitempty"`
+	Properties         map[string]interface{}           `json:"properties,omitempty"`
 }
 
 // inboundClientStoreInterface defines persistence operations for inbound clients.
@@ -106,10 +109,12 @@ func marshalInboundClient(c inboundmodel.InboundClient) (
 	err error,
 ) {
 	blob := inboundClientJSONBlob{
-		Assertion:        c.Assertion,
-		LoginConsent:     c.LoginConsent,
-		AllowedUserTypes: c.AllowedUserTypes,
-		Properties:       c.Properties,
+		Assertion:          c.Assertion,
+		LoginConsent:       c.LoginConsent,
+		AllowedUserTypes:   c.AllowedUserTypes,
+		AllowedAuthMethods: c.AllowedAuthMethods,
+		UserIsolation:      c.UserIsolation,
+		Properties:         c.Properties,
 	}
 	propertiesBytes, err = marshalNullableJSON(blob)
 	if err != nil {
@@ -138,6 +143,7 @@ func marshalInboundClient(c inboundmodel.InboundClient) (
 
 // CreateInboundClient creates a new inbound client entry.
 func (st *store) CreateInboundClient(ctx context.Context, client inboundmodel.InboundClient) error {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, st.deploymentID)
 	dbClient, err := st.dbProvider.GetConfigDBClient()
 	if err != nil {
 		return fmt.Errorf("failed to get database client: %w", err)
@@ -151,7 +157,7 @@ func (st *store) CreateInboundClient(ctx context.Context, client inboundmodel.In
 
 	_, err = dbClient.ExecuteContext(ctx, queryCreateInboundClient,
 		client.ID, client.AuthFlowID, registrationFlowID, isRegEnabledStr,
-		recoveryFlowID, isRecoveryEnabledStr, themeID, layoutID, propsBytes, st.deploymentID)
+		recoveryFlowID, isRecoveryEnabledStr, themeID, layoutID, propsBytes, deploymentID)
 	if err != nil {
 		return fmt.Errorf("failed to insert inbound client: %w", err)
 	}
@@ -162,6 +168,7 @@ func (st *store) CreateInboundClient(ctx context.Context, client inboundmodel.In
 // marshaled to JSON internally.
 func (st *store) CreateOAuthProfile(ctx context.Context, entityID string,
 	oauthProfile *inboundmodel.OAuthProfile) error {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, st.deploymentID)
 	dbClient, err := st.dbProvider.GetConfigDBClient()
 	if err != nil {
 		return fmt.Errorf("failed to get database client: %w", err)
@@ -172,7 +179,7 @@ func (st *store) CreateOAuthProfile(ctx context.Context, entityID string,
 		return err
 	}
 
-	_, err = dbClient.ExecuteContext(ctx, queryCreateOAuthProfile, entityID, profileJSON, st.deploymentID)
+	_, err = dbClient.ExecuteContext(ctx, queryCreateOAuthProfile, entityID, profileJSON, deploymentID)
 	if err != nil {
 		return fmt.Errorf("failed to insert OAuth profile: %w", err)
 	}
@@ -181,12 +188,13 @@ func (st *store) CreateOAuthProfile(ctx context.Context, entityID string,
 
 // GetInboundClientByEntityID retrieves an inbound client by entity ID.
 func (st *store) GetInboundClientByEntityID(ctx context.Context, entityID string) (*inboundmodel.InboundClient, error) {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, st.deploymentID)
 	dbClient, err := st.dbProvider.GetConfigDBClient()
 	if err != nil {
 		return nil, fmt.Errorf("failed to get database client: %w", err)
 	}
 
-	results, err := dbClient.QueryContext(ctx, queryGetInboundClientByEntityID, entityID, st.deploymentID)
+	results, err := dbClient.QueryContext(ctx, queryGetInboundClientByEntityID, entityID, deploymentID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to execute query: %w", err)
 	}
@@ -198,12 +206,13 @@ func (st *store) GetInboundClientByEntityID(ctx context.Context, entityID string
 
 // GetOAuthProfileByEntityID retrieves an OAuth profile by entity ID.
 func (st *store) GetOAuthProfileByEntityID(ctx context.Context, entityID string) (*inboundmodel.OAuthProfile, error) {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, st.deploymentID)
 	dbClient, err := st.dbProvider.GetConfigDBClient()
 	if err != nil {
 		return nil, fmt.Errorf("failed to get database client: %w", err)
 	}
 
-	results, err := dbClient.QueryContext(ctx, queryGetOAuthProfileByEntityID, entityID, st.deploymentID)
+	results, err := dbClient.QueryContext(ctx, queryGetOAuthProfileByEntityID, entityID, deploymentID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to execute query: %w", err)
 	}
@@ -215,12 +224,13 @@ func (st *store) GetOAuthProfileByEntityID(ctx context.Context, entityID string)
 
 // GetInboundClientList retrieves all inbound clients.
 func (st *store) GetInboundClientList(ctx context.Context, limit int) ([]inboundmodel.InboundClient, error) {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, st.deploymentID)
 	dbClient, err := st.dbProvider.GetConfigDBClient()
 	if err != nil {
 		return nil, fmt.Errorf("failed to get database client: %w", err)
 	}
 
-	results, err := dbClient.QueryContext(ctx, queryGetInboundClientList, st.deploymentID, limit)
+	results, err := dbClient.QueryContext(ctx, queryGetInboundClientList, deploymentID, limit)
 	if err != nil {
 		return nil, fmt.Errorf("failed to execute query: %w", err)
 	}
@@ -238,12 +248,13 @@ func (st *store) GetInboundClientList(ctx context.Context, limit int) ([]inbound
 
 // GetTotalInboundClientCount retrieves the total count of inbound clients.
 func (st *store) GetTotalInboundClientCount(ctx context.Context) (int, error) {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, st.deploymentID)
 	dbClient, err := st.dbProvider.GetConfigDBClient()
 	if err != nil {
 		return 0, fmt.Errorf("failed to get database client: %w", err)
 	}
 
-	results, err := dbClient.QueryContext(ctx, queryGetInboundClientCount, st.deploymentID)
+	results, err := dbClient.QueryContext(ctx, queryGetInboundClientCount, deploymentID)
 	if err != nil {
 		return 0, fmt.Errorf("failed to execute query: %w", err)
 	}
@@ -259,6 +270,7 @@ func (st *store) GetTotalInboundClientCount(ctx context.Context) (int, error) {
 
 // UpdateInboundClient updates an inbound client.
 func (st *store) UpdateInboundClient(ctx context.Context, client inboundmodel.InboundClient) error {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, st.deploymentID)
 	dbClient, err := st.dbProvider.GetConfigDBClient()
 	if err != nil {
 		return fmt.Errorf("failed to get database client: %w", err)
@@ -272,7 +284,7 @@ func (st *store) UpdateInboundClient(ctx context.Context, client inboundmodel.In
 
 	rowsAffected, err := dbClient.ExecuteContext(ctx, queryUpdateInboundClientByEntityID,
 		client.ID, client.AuthFlowID, registrationFlowID, isRegEnabledStr,
-		recoveryFlowID, isRecoveryEnabledStr, themeID, layoutID, propsBytes, st.deploymentID)
+		recoveryFlowID, isRecoveryEnabledStr, themeID, layoutID, propsBytes, deploymentID)
 	if err != nil {
 		return fmt.Errorf("failed to update inbound client: %w", err)
 	}
@@ -286,6 +298,7 @@ func (st *store) UpdateInboundClient(ctx context.Context, client inboundmodel.In
 // to JSON internally.
 func (st *store) UpdateOAuthProfile(ctx context.Context, entityID string,
 	oauthProfile *inboundmodel.OAuthProfile) error {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, st.deploymentID)
 	dbClient, err := st.dbProvider.GetConfigDBClient()
 	if err != nil {
 		return fmt.Errorf("failed to get database client: %w", err)
@@ -297,7 +310,7 @@ func (st *store) UpdateOAuthProfile(ctx context.Context, entityID string,
 	}
 
 	rowsAffected, err := dbClient.ExecuteContext(ctx, queryUpdateOAuthProfileByEntityID,
-		entityID, profileJSON, st.deploymentID)
+		entityID, profileJSON, deploymentID)
 	if err != nil {
 		return fmt.Errorf("failed to update OAuth profile: %w", err)
 	}
@@ -322,12 +335,13 @@ func marshalOAuthProfile(p *inboundmodel.OAuthProfile) (json.RawMessage, error)
 
 // DeleteInboundClient deletes an inbound client by entity ID. Cascades to OAuth profile.
 func (st *store) DeleteInboundClient(ctx context.Context, entityID string) error {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, st.deploymentID)
 	dbClient, err := st.dbProvider.GetConfigDBClient()
 	if err != nil {
 		return fmt.Errorf("failed to get database client: %w", err)
 	}
 
-	_, err = dbClient.ExecuteContext(ctx, queryDeleteInboundClientByEntityID, entityID, st.deploymentID)
+	_, err = dbClient.ExecuteContext(ctx, queryDeleteInboundClientByEntityID, entityID, deploymentID)
 	if err != nil {
 		return fmt.Errorf("failed to delete inbound client: %w", err)
 	}
@@ -336,12 +350,13 @@ func (st *store) DeleteInboundClient(ctx context.Context, entityID string) error
 
 // DeleteOAuthProfile deletes an OAuth profile by entity ID.
 func (st *store) DeleteOAuthProfile(ctx context.Context, entityID string) error {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, st.deploymentID)
 	dbClient, err := st.dbProvider.GetConfigDBClient()
 	if err != nil {
 		return fmt.Errorf("failed to get database client: %w", err)
 	}
 
-	_, err = dbClient.ExecuteContext(ctx, queryDeleteOAuthProfileByEntityID, entityID, st.deploymentID)
+	_, err = dbClient.ExecuteContext(ctx, queryDeleteOAuthProfileByEntityID, entityID, deploymentID)
 	if err != nil {
 		return fmt.Errorf("failed to delete OAuth profile: %w", err)
 	}
@@ -350,12 +365,13 @@ func (st *store) DeleteOAuthProfile(ctx context.Context, entityID string) error
 
 // InboundClientExists checks if an inbound client exists by entity ID.
 func (st *store) InboundClientExists(ctx context.Context, entityID string) (bool, error) {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, st.deploymentID)
 	dbClient, err := st.dbProvider.GetConfigDBClient()
 	if err != nil {
 		return false, fmt.Errorf("failed to get database client: %w", err)
 	}
 
-	results, err := dbClient.QueryContext(ctx, queryCheckInboundClientExistsByEntityID, entityID, st.deploymentID)
+	results, err := dbClient.QueryContext(ctx, queryCheckInboundClientExistsByEntityID, entityID, deploymentID)
 	if err != nil {
 		return false, fmt.Errorf("failed to execute existence check query: %w", err)
 	}
@@ -412,6 +428,8 @@ func buildInboundClientFromRow(row map[string]interface{}) (*inboundmodel.Inboun
 			client.Assertion = blob.Assertion
 			client.LoginConsent = blob.LoginConsent
 			client.AllowedUserTypes = blob.AllowedUserTypes
+			client.AllowedAuthMethods = blob.AllowedAuthMethods
+			client.UserIsolation = blob.UserIsolation
 			client.Properties = blob.Properties
 		}
 	}
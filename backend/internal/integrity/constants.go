@@ -0,0 +1,33 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package integrity
+
+const loggerComponentName = "IntegrityService"
+
+// scanPageSize is the number of references read from a store and verified at a time.
+const scanPageSize = 100
+
+// scheduledScanLockName is the distributed lock held while a scheduled scan runs.
+const scheduledScanLockName = "integrity-scheduled-scan"
+
+// Member and assignee types as persisted by the group and role stores.
+const (
+	referenceTypeEntity = "entity"
+	referenceTypeGroup  = "group"
+)
@@ -0,0 +1,81 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package integrity
+
+import (
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/i18n/core"
+)
+
+// Client errors for integrity scan operations.
+var (
+	// ErrorInvalidRequestFormat is the error returned when the request body is malformed.
+	ErrorInvalidRequestFormat = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "INT-1001",
+		Error: core.I18nMessage{
+			Key:          "error.integrityservice.invalid_request_format",
+			DefaultValue: "Invalid request format",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.integrityservice.invalid_request_format_description",
+			DefaultValue: "The request body is malformed or contains invalid data",
+		},
+	}
+	// ErrorInvalidRepairAction is the error returned when an unsupported repair action is requested.
+	ErrorInvalidRepairAction = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "INT-1002",
+		Error: core.I18nMessage{
+			Key:          "error.integrityservice.invalid_repair_action",
+			DefaultValue: "Invalid repair action",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.integrityservice.invalid_repair_action_description",
+			DefaultValue: "The repair action must be either DELETE or REASSIGN",
+		},
+	}
+	// ErrorMissingTargetOU is the error returned when a reassign repair does not specify the target
+	// organization unit.
+	ErrorMissingTargetOU = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "INT-1003",
+		Error: core.I18nMessage{
+			Key:          "error.integrityservice.missing_target_ou",
+			DefaultValue: "Missing target organization unit",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.integrityservice.missing_target_ou_description",
+			DefaultValue: "The target organization unit ID must be provided to reassign orphaned resources",
+		},
+	}
+	// ErrorTargetOUNotFound is the error returned when the target organization unit does not exist.
+	ErrorTargetOUNotFound = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "INT-1004",
+		Error: core.I18nMessage{
+			Key:          "error.integrityservice.target_ou_not_found",
+			DefaultValue: "Target organization unit not found",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.integrityservice.target_ou_not_found_description",
+			DefaultValue: "The organization unit to reassign orphaned resources to could not be found",
+		},
+	}
+)
@@ -0,0 +1,68 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package integrity
+
+import (
+	"net/http"
+
+	sysutils "github.com/thunder-id/thunderid/internal/system/utils"
+)
+
+// integrityHandler is the handler for integrity scan operations.
+type integrityHandler struct {
+	integrityService IntegrityServiceInterface
+}
+
+// newIntegrityHandler creates a new instance of integrityHandler.
+func newIntegrityHandler(integrityService IntegrityServiceInterface) *integrityHandler {
+	return &integrityHandler{
+		integrityService: integrityService,
+	}
+}
+
+// HandleScanPostRequest handles the integrity scan request. An empty body runs a report-only scan.
+func (h *integrityHandler) HandleScanPostRequest(w http.ResponseWriter, r *http.Request) {
+	request := &ScanRequest{}
+	if r.ContentLength != 0 {
+		decoded, err := sysutils.DecodeJSONBody[ScanRequest](r)
+		if err != nil {
+			sysutils.WriteServiceErrorResponse(w, &ErrorInvalidRequestFormat, clientErrorStatusCodes)
+			return
+		}
+		request = decoded
+	}
+
+	report, svcErr := h.integrityService.Scan(r.Context(), ScanRequest{
+		Repair:     RepairAction(sysutils.SanitizeString(string(request.Repair))),
+		TargetOUID: sysutils.SanitizeString(request.TargetOUID),
+		DryRun:     request.DryRun,
+	})
+	if svcErr != nil {
+		sysutils.WriteServiceErrorResponse(w, svcErr, clientErrorStatusCodes)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(w, http.StatusOK, report)
+}
+
+// clientErrorStatusCodes maps the client errors of the handler to HTTP status codes. Other client errors are
+// returned with 400 Bad Request.
+var clientErrorStatusCodes = map[string]int{
+	ErrorTargetOUNotFound.Code: http.StatusNotFound,
+}
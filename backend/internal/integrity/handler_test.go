@@ -0,0 +1,113 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package integrity
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/system/error/apierror"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+)
+
+type HandlerTestSuite struct {
+	suite.Suite
+	mockService *IntegrityServiceInterfaceMock
+	handler     *integrityHandler
+}
+
+func TestHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(HandlerTestSuite))
+}
+
+func (s *HandlerTestSuite) SetupTest() {
+	s.mockService = NewIntegrityServiceInterfaceMock(s.T())
+	s.handler = newIntegrityHandler(s.mockService)
+}
+
+func (s *HandlerTestSuite) TestHandleScanPostRequest_Repair() {
+	s.mockService.On("Scan", mock.Anything,
+		ScanRequest{Repair: RepairActionReassign, TargetOUID: "ou-1", DryRun: true}).
+		Return(&ScanReport{Repair: RepairActionReassign, TargetOUID: "ou-1", DryRun: true, TotalOrphans: 1,
+			Orphans: []Orphan{{Type: OrphanTypeGroupMissingOU, ResourceID: "group-1", ReferenceID: "ou-2",
+				Action: RepairActionReassign}}}, nil)
+
+	req := httptest.NewRequest(http.MethodPost, "/admin/integrity/scan",
+		strings.NewReader(`{"repair":"REASSIGN","targetOuId":"ou-1","dryRun":true}`))
+	rr := httptest.NewRecorder()
+	s.handler.HandleScanPostRequest(rr, req)
+
+	s.Equal(http.StatusOK, rr.Code)
+	var body ScanReport
+	s.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &body))
+	s.Equal(1, body.TotalOrphans)
+	s.Equal(RepairActionReassign, body.Orphans[0].Action)
+}
+
+func (s *HandlerTestSuite) TestHandleScanPostRequest_EmptyBody() {
+	s.mockService.On("Scan", mock.Anything, ScanRequest{}).Return(&ScanReport{Orphans: []Orphan{}}, nil)
+
+	req := httptest.NewRequest(http.MethodPost, "/admin/integrity/scan", nil)
+	rr := httptest.NewRecorder()
+	s.handler.HandleScanPostRequest(rr, req)
+
+	s.Equal(http.StatusOK, rr.Code)
+}
+
+func (s *HandlerTestSuite) TestHandleScanPostRequest_InvalidBody() {
+	req := httptest.NewRequest(http.MethodPost, "/admin/integrity/scan", strings.NewReader(`{`))
+	rr := httptest.NewRecorder()
+	s.handler.HandleScanPostRequest(rr, req)
+
+	s.Equal(http.StatusBadRequest, rr.Code)
+	var errResp apierror.ErrorResponse
+	s.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &errResp))
+	s.Equal(ErrorInvalidRequestFormat.Code, errResp.Code)
+}
+
+func (s *HandlerTestSuite) TestHandleScanPostRequest_ErrorStatusCodes() {
+	testCases := []struct {
+		name     string
+		svcErr   *serviceerror.ServiceError
+		expected int
+	}{
+		{"InvalidRepair", &ErrorInvalidRepairAction, http.StatusBadRequest},
+		{"MissingTarget", &ErrorMissingTargetOU, http.StatusBadRequest},
+		{"TargetNotFound", &ErrorTargetOUNotFound, http.StatusNotFound},
+		{"ServerError", &serviceerror.InternalServerError, http.StatusInternalServerError},
+	}
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.mockService.On("Scan", mock.Anything, mock.Anything).Return(nil, tc.svcErr).Once()
+
+			req := httptest.NewRequest(http.MethodPost, "/admin/integrity/scan",
+				strings.NewReader(`{"repair":"DELETE"}`))
+			rr := httptest.NewRecorder()
+			s.handler.HandleScanPostRequest(rr, req)
+
+			s.Equal(tc.expected, rr.Code)
+		})
+	}
+}
@@ -0,0 +1,66 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package integrity
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/entity"
+	"github.com/thunder-id/thunderid/internal/group"
+	"github.com/thunder-id/thunderid/internal/ou"
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/distlock"
+	"github.com/thunder-id/thunderid/internal/system/middleware"
+)
+
+// Initialize initializes the integrity service, registers its routes and starts the scheduled scans.
+func Initialize(
+	mux *http.ServeMux,
+	ouService ou.OrganizationUnitServiceInterface,
+	entityService entity.EntityServiceInterface,
+	groupService group.GroupServiceInterface,
+	lockManager distlock.LockManagerInterface,
+) IntegrityServiceInterface {
+	integrityService := newIntegrityService(newIntegrityStore(), ouService, entityService, groupService)
+
+	integrityHandler := newIntegrityHandler(integrityService)
+	registerRoutes(mux, integrityHandler)
+
+	scanInterval := config.GetServerRuntime().Config.Integrity.ScanInterval
+	startScheduledScans(integrityService, lockManager, time.Duration(scanInterval)*time.Second)
+
+	return integrityService
+}
+
+// registerRoutes registers the routes for integrity scan operations.
+func registerRoutes(mux *http.ServeMux, integrityHandler *integrityHandler) {
+	opts := middleware.CORSOptions{
+		AllowedMethods:   []string{"POST"},
+		AllowedHeaders:   middleware.DefaultAllowedHeaders,
+		AllowCredentials: true,
+		MaxAge:           600,
+	}
+	mux.HandleFunc(middleware.WithCORS("POST /admin/integrity/scan",
+		integrityHandler.HandleScanPostRequest, opts))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS /admin/integrity/scan",
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, opts))
+}
@@ -0,0 +1,115 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package integrity provides detection and repair of orphaned references between the stores of the server,
+// such as group members pointing at deleted users or roles owned by missing organization units.
+package integrity
+
+import "time"
+
+// OrphanType identifies the kind of dangling reference found by an integrity scan.
+type OrphanType string
+
+const (
+	// OrphanTypeGroupMemberMissingEntity is a group membership of an entity that no longer exists.
+	OrphanTypeGroupMemberMissingEntity OrphanType = "GROUP_MEMBER_MISSING_ENTITY"
+	// OrphanTypeGroupMemberMissingGroup is a group membership of a nested group that no longer exists.
+	OrphanTypeGroupMemberMissingGroup OrphanType = "GROUP_MEMBER_MISSING_GROUP"
+	// OrphanTypeRoleAssignmentMissingEntity is a role assignment to an entity that no longer exists.
+	OrphanTypeRoleAssignmentMissingEntity OrphanType = "ROLE_ASSIGNMENT_MISSING_ENTITY"
+	// OrphanTypeRoleAssignmentMissingGroup is a role assignment to a group that no longer exists.
+	OrphanTypeRoleAssignmentMissingGroup OrphanType = "ROLE_ASSIGNMENT_MISSING_GROUP"
+	// OrphanTypeGroupMissingOU is a group that belongs to an organization unit that no longer exists.
+	OrphanTypeGroupMissingOU OrphanType = "GROUP_MISSING_OU"
+	// OrphanTypeRoleMissingOU is a role that belongs to an organization unit that no longer exists.
+	OrphanTypeRoleMissingOU OrphanType = "ROLE_MISSING_OU"
+	// OrphanTypeEntityMissingOU is an entity that belongs to an organization unit that no longer exists.
+	OrphanTypeEntityMissingOU OrphanType = "ENTITY_MISSING_OU"
+)
+
+// isOUReference reports whether the orphan is a resource owned by a missing organization unit.
+func (t OrphanType) isOUReference() bool {
+	return t == OrphanTypeGroupMissingOU || t == OrphanTypeRoleMissingOU || t == OrphanTypeEntityMissingOU
+}
+
+// RepairAction is the action taken, or planned in a dry run, to repair an orphan.
+type RepairAction string
+
+const (
+	// RepairActionDelete removes the dangling reference, or the resource owned by a missing organization unit.
+	RepairActionDelete RepairAction = "DELETE"
+	// RepairActionReassign moves a resource owned by a missing organization unit to another organization unit.
+	RepairActionReassign RepairAction = "REASSIGN"
+	// RepairActionSkip leaves the orphan untouched because the requested repair does not apply to it.
+	RepairActionSkip RepairAction = "SKIP"
+)
+
+// ScanRequest represents the request body of an integrity scan.
+type ScanRequest struct {
+	// Repair is the repair to apply to the detected orphans. An empty value only reports them.
+	Repair RepairAction `json:"repair,omitempty"`
+	// TargetOUID is the organization unit that receives reassigned resources.
+	TargetOUID string `json:"targetOuId,omitempty"`
+	// DryRun reports the planned repair of each orphan without applying it.
+	DryRun bool `json:"dryRun"`
+}
+
+// Orphan represents a dangling reference found by an integrity scan.
+type Orphan struct {
+	Type OrphanType `json:"type"`
+	// ResourceID is the ID of the group, role or entity that holds the reference.
+	ResourceID string `json:"resourceId"`
+	// ReferenceID is the ID of the missing resource.
+	ReferenceID string       `json:"referenceId"`
+	Action      RepairAction `json:"action,omitempty"`
+	Repaired    bool         `json:"repaired"`
+	Error       string       `json:"error,omitempty"`
+}
+
+// ScanReport represents the outcome of an integrity scan.
+type ScanReport struct {
+	Repair       RepairAction `json:"repair,omitempty"`
+	TargetOUID   string       `json:"targetOuId,omitempty"`
+	DryRun       bool         `json:"dryRun"`
+	TotalOrphans int          `json:"totalOrphans"`
+	Repaired     int          `json:"repaired"`
+	Failed       int          `json:"failed"`
+	Orphans      []Orphan     `json:"orphans"`
+	StartedAt    time.Time    `json:"startedAt"`
+	CompletedAt  time.Time    `json:"completedAt"`
+}
+
+// groupMemberRef is a group membership read from the user store.
+type groupMemberRef struct {
+	GroupID    string
+	MemberType string
+	MemberID   string
+}
+
+// roleAssignmentRef is a role assignment read from the config store.
+type roleAssignmentRef struct {
+	RoleID       string
+	AssigneeType string
+	AssigneeID   string
+}
+
+// ouRef is a resource together with the organization unit that owns it.
+type ouRef struct {
+	ID   string
+	OUID string
+}
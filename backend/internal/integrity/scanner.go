@@ -0,0 +1,222 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package integrity
+
+import (
+	"context"
+	"fmt"
+)
+
+// scanner collects the orphaned references of a single integrity scan. It memoizes the existence of
+// organization units, which are referenced by many resources.
+type scanner struct {
+	service  *integrityService
+	ouExists map[string]bool
+	orphans  []Orphan
+}
+
+// newScanner creates a scanner that checks references through the services of the integrity service.
+func newScanner(service *integrityService) *scanner {
+	return &scanner{
+		service:  service,
+		ouExists: make(map[string]bool),
+		orphans:  []Orphan{},
+	}
+}
+
+// detect runs every check and returns the orphaned references found.
+func (sc *scanner) detect(ctx context.Context) ([]Orphan, error) {
+	checks := []func(context.Context) error{
+		sc.scanGroupMembers,
+		sc.scanRoleAssignments,
+		func(ctx context.Context) error {
+			return sc.scanOURefs(ctx, OrphanTypeGroupMissingOU, sc.service.store.ListGroupOUs)
+		},
+		func(ctx context.Context) error {
+			return sc.scanOURefs(ctx, OrphanTypeRoleMissingOU, sc.service.store.ListRoleOUs)
+		},
+		func(ctx context.Context) error {
+			return sc.scanOURefs(ctx, OrphanTypeEntityMissingOU, sc.service.store.ListEntityOUs)
+		},
+	}
+	for _, check := range checks {
+		if err := check(ctx); err != nil {
+			return nil, err
+		}
+	}
+	return sc.orphans, nil
+}
+
+// scanGroupMembers finds group memberships of entities and groups that no longer exist.
+func (sc *scanner) scanGroupMembers(ctx context.Context) error {
+	for offset := 0; ; offset += scanPageSize {
+		members, err := sc.service.store.ListGroupMembers(ctx, scanPageSize, offset)
+		if err != nil {
+			return fmt.Errorf("failed to list group members: %w", err)
+		}
+
+		var entityIDs, groupIDs []string
+		for _, m := range members {
+			if m.MemberType == referenceTypeGroup {
+				groupIDs = append(groupIDs, m.MemberID)
+			} else {
+				entityIDs = append(entityIDs, m.MemberID)
+			}
+		}
+		missingEntities, err := sc.missingEntities(ctx, entityIDs)
+		if err != nil {
+			return err
+		}
+		missingGroups, err := sc.missingGroups(ctx, groupIDs)
+		if err != nil {
+			return err
+		}
+
+		for _, m := range members {
+			if m.MemberType == referenceTypeGroup && missingGroups[m.MemberID] {
+				sc.add(OrphanTypeGroupMemberMissingGroup, m.GroupID, m.MemberID)
+			} else if m.MemberType != referenceTypeGroup && missingEntities[m.MemberID] {
+				sc.add(OrphanTypeGroupMemberMissingEntity, m.GroupID, m.MemberID)
+			}
+		}
+		if len(members) < scanPageSize {
+			return nil
+		}
+	}
+}
+
+// scanRoleAssignments finds role assignments to entities and groups that no longer exist.
+func (sc *scanner) scanRoleAssignments(ctx context.Context) error {
+	for offset := 0; ; offset += scanPageSize {
+		assignments, err := sc.service.store.ListRoleAssignments(ctx, scanPageSize, offset)
+		if err != nil {
+			return fmt.Errorf("failed to list role assignments: %w", err)
+		}
+
+		var entityIDs, groupIDs []string
+		for _, a := range assignments {
+			if a.AssigneeType == referenceTypeGroup {
+				groupIDs = append(groupIDs, a.AssigneeID)
+			} else {
+				entityIDs = append(entityIDs, a.AssigneeID)
+			}
+		}
+		missingEntities, err := sc.missingEntities(ctx, entityIDs)
+		if err != nil {
+			return err
+		}
+		missingGroups, err := sc.missingGroups(ctx, groupIDs)
+		if err != nil {
+			return err
+		}
+
+		for _, a := range assignments {
+			if a.AssigneeType == referenceTypeGroup && missingGroups[a.AssigneeID] {
+				sc.add(OrphanTypeRoleAssignmentMissingGroup, a.RoleID, a.AssigneeID)
+			} else if a.AssigneeType != referenceTypeGroup && missingEntities[a.AssigneeID] {
+				sc.add(OrphanTypeRoleAssignmentMissingEntity, a.RoleID, a.AssigneeID)
+			}
+		}
+		if len(assignments) < scanPageSize {
+			return nil
+		}
+	}
+}
+
+// scanOURefs finds resources listed by list whose organization unit no longer exists.
+func (sc *scanner) scanOURefs(
+	ctx context.Context, orphanType OrphanType,
+	list func(ctx context.Context, limit, offset int) ([]ouRef, error),
+) error {
+	for offset := 0; ; offset += scanPageSize {
+		refs, err := list(ctx, scanPageSize, offset)
+		if err != nil {
+			return fmt.Errorf("failed to list %s candidates: %w", orphanType, err)
+		}
+
+		for _, ref := range refs {
+			exists, err := sc.organizationUnitExists(ctx, ref.OUID)
+			if err != nil {
+				return err
+			}
+			if !exists {
+				sc.add(orphanType, ref.ID, ref.OUID)
+			}
+		}
+		if len(refs) < scanPageSize {
+			return nil
+		}
+	}
+}
+
+// missingEntities returns the set of the given entity IDs that do not exist.
+func (sc *scanner) missingEntities(ctx context.Context, entityIDs []string) (map[string]bool, error) {
+	missing := make(map[string]bool)
+	if len(entityIDs) == 0 {
+		return missing, nil
+	}
+
+	invalidIDs, err := sc.service.entityService.ValidateEntityIDs(ctx, entityIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to validate entity IDs: %w", err)
+	}
+	for _, id := range invalidIDs {
+		missing[id] = true
+	}
+	return missing, nil
+}
+
+// missingGroups returns the set of the given group IDs that do not exist.
+func (sc *scanner) missingGroups(ctx context.Context, groupIDs []string) (map[string]bool, error) {
+	missing := make(map[string]bool)
+	if len(groupIDs) == 0 {
+		return missing, nil
+	}
+
+	groups, svcErr := sc.service.groupService.GetGroupsByIDs(ctx, groupIDs)
+	if svcErr != nil {
+		return nil, fmt.Errorf("failed to retrieve groups: %s", svcErr.ErrorDescription.DefaultValue)
+	}
+	for _, id := range groupIDs {
+		if _, ok := groups[id]; !ok {
+			missing[id] = true
+		}
+	}
+	return missing, nil
+}
+
+// organizationUnitExists reports whether the organization unit exists, consulting the memoized result first.
+func (sc *scanner) organizationUnitExists(ctx context.Context, ouID string) (bool, error) {
+	if exists, ok := sc.ouExists[ouID]; ok {
+		return exists, nil
+	}
+
+	exists, svcErr := sc.service.ouService.IsOrganizationUnitExists(ctx, ouID)
+	if svcErr != nil {
+		return false, fmt.Errorf("failed to check organization unit %s: %s",
+			ouID, svcErr.ErrorDescription.DefaultValue)
+	}
+	sc.ouExists[ouID] = exists
+	return exists, nil
+}
+
+// add records an orphaned reference.
+func (sc *scanner) add(orphanType OrphanType, resourceID, referenceID string) {
+	sc.orphans = append(sc.orphans, Orphan{Type: orphanType, ResourceID: resourceID, ReferenceID: referenceID})
+}
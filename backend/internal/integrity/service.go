@@ -0,0 +1,236 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package integrity
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/entity"
+	"github.com/thunder-id/thunderid/internal/group"
+	"github.com/thunder-id/thunderid/internal/ou"
+	"github.com/thunder-id/thunderid/internal/system/distlock"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/internal/system/security"
+)
+
+// IntegrityServiceInterface defines the interface for the integrity service.
+type IntegrityServiceInterface interface {
+	Scan(ctx context.Context, request ScanRequest) (*ScanReport, *serviceerror.ServiceError)
+}
+
+// integrityService is the default implementation of the IntegrityServiceInterface.
+type integrityService struct {
+	store         integrityStoreInterface
+	ouService     ou.OrganizationUnitServiceInterface
+	entityService entity.EntityServiceInterface
+	groupService  group.GroupServiceInterface
+}
+
+// newIntegrityService creates a new instance of integrityService.
+func newIntegrityService(
+	store integrityStoreInterface,
+	ouService ou.OrganizationUnitServiceInterface,
+	entityService entity.EntityServiceInterface,
+	groupService group.GroupServiceInterface,
+) IntegrityServiceInterface {
+	return &integrityService{
+		store:         store,
+		ouService:     ouService,
+		entityService: entityService,
+		groupService:  groupService,
+	}
+}
+
+// Scan detects orphaned references across the stores and optionally repairs them. Existence of the
+// referenced resources is checked through their services so that declarative resources are honored.
+func (s *integrityService) Scan(ctx context.Context, request ScanRequest) (*ScanReport, *serviceerror.ServiceError) {
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName))
+
+	switch request.Repair {
+	case "", RepairActionDelete:
+	case RepairActionReassign:
+		if request.TargetOUID == "" {
+			return nil, &ErrorMissingTargetOU
+		}
+	default:
+		return nil, &ErrorInvalidRepairAction
+	}
+
+	// Access to the scan is restricted to system administrators, which covers every resource it touches.
+	runtimeCtx := security.WithRuntimeContext(ctx)
+
+	if request.Repair == RepairActionReassign {
+		exists, svcErr := s.ouService.IsOrganizationUnitExists(runtimeCtx, request.TargetOUID)
+		if svcErr != nil {
+			logger.Error("Failed to check target organization unit existence",
+				log.String("ouID", request.TargetOUID))
+			return nil, &serviceerror.InternalServerError
+		}
+		if !exists {
+			return nil, &ErrorTargetOUNotFound
+		}
+	}
+
+	report := &ScanReport{
+		Repair:     request.Repair,
+		TargetOUID: request.TargetOUID,
+		DryRun:     request.DryRun,
+		StartedAt:  time.Now().UTC(),
+	}
+
+	orphans, err := newScanner(s).detect(runtimeCtx)
+	if err != nil {
+		logger.Error("Failed to detect orphaned references", log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+
+	for i := range orphans {
+		orphan := &orphans[i]
+		if request.Repair == "" {
+			continue
+		}
+		orphan.Action = plannedAction(orphan.Type, request.Repair)
+		if request.DryRun || orphan.Action == RepairActionSkip {
+			continue
+		}
+
+		if err := s.repair(runtimeCtx, *orphan, request.TargetOUID); err != nil {
+			logger.Warn("Failed to repair orphaned reference", log.String("type", string(orphan.Type)),
+				log.String("resourceID", orphan.ResourceID), log.Error(err))
+			orphan.Error = err.Error()
+			report.Failed++
+			continue
+		}
+		orphan.Repaired = true
+		report.Repaired++
+	}
+
+	report.Orphans = orphans
+	report.TotalOrphans = len(orphans)
+	report.CompletedAt = time.Now().UTC()
+
+	logger.Debug("Completed integrity scan", log.Int("orphans", report.TotalOrphans),
+		log.Int("repaired", report.Repaired), log.Int("failed", report.Failed))
+	return report, nil
+}
+
+// plannedAction returns the repair action that applies to an orphan for the requested repair.
+// Dangling references are always deleted, since there is nothing to reassign them to, while
+// entities are never deleted by a scan.
+func plannedAction(orphanType OrphanType, repair RepairAction) RepairAction {
+	if !orphanType.isOUReference() {
+		return RepairActionDelete
+	}
+	if repair == RepairActionDelete && orphanType == OrphanTypeEntityMissingOU {
+		return RepairActionSkip
+	}
+	return repair
+}
+
+// repair applies the planned action of an orphan.
+func (s *integrityService) repair(ctx context.Context, orphan Orphan, targetOUID string) error {
+	switch orphan.Type {
+	case OrphanTypeGroupMemberMissingEntity:
+		return s.store.DeleteGroupMember(ctx, orphan.ResourceID, referenceTypeEntity, orphan.ReferenceID)
+	case OrphanTypeGroupMemberMissingGroup:
+		return s.store.DeleteGroupMember(ctx, orphan.ResourceID, referenceTypeGroup, orphan.ReferenceID)
+	case OrphanTypeRoleAssignmentMissingEntity:
+		return s.store.DeleteRoleAssignment(ctx, orphan.ResourceID, referenceTypeEntity, orphan.ReferenceID)
+	case OrphanTypeRoleAssignmentMissingGroup:
+		return s.store.DeleteRoleAssignment(ctx, orphan.ResourceID, referenceTypeGroup, orphan.ReferenceID)
+	case OrphanTypeGroupMissingOU:
+		if orphan.Action == RepairActionReassign {
+			return s.store.UpdateGroupOU(ctx, orphan.ResourceID, targetOUID)
+		}
+		return s.store.DeleteGroup(ctx, orphan.ResourceID)
+	case OrphanTypeRoleMissingOU:
+		if orphan.Action == RepairActionReassign {
+			return s.store.UpdateRoleOU(ctx, orphan.ResourceID, targetOUID)
+		}
+		return s.store.DeleteRole(ctx, orphan.ResourceID)
+	case OrphanTypeEntityMissingOU:
+		return s.reassignEntity(ctx, orphan.ResourceID, targetOUID)
+	default:
+		return fmt.Errorf("unsupported orphan type %s", orphan.Type)
+	}
+}
+
+// reassignEntity moves an entity to another organization unit through the entity service, so that
+// cached copies of the entity are refreshed.
+func (s *integrityService) reassignEntity(ctx context.Context, entityID, targetOUID string) error {
+	e, err := s.entityService.GetEntity(ctx, entityID)
+	if err != nil {
+		return fmt.Errorf("failed to retrieve entity: %w", err)
+	}
+	e.OUID = targetOUID
+	if _, err := s.entityService.UpdateEntity(ctx, entityID, e); err != nil {
+		return fmt.Errorf("failed to update entity: %w", err)
+	}
+	return nil
+}
+
+// startScheduledScans runs a report-only scan at every interval and logs the orphans it finds. Each scan
+// holds a distributed lock so that only one node of the deployment scans at a time.
+func startScheduledScans(
+	service IntegrityServiceInterface, lockManager distlock.LockManagerInterface, interval time.Duration,
+) {
+	if interval <= 0 {
+		return
+	}
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for range ticker.C {
+			runScheduledScan(context.Background(), service, lockManager)
+		}
+	}()
+}
+
+// runScheduledScan runs a single report-only scan and logs its outcome. The scan is skipped when another
+// node holds the scan lock.
+func runScheduledScan(
+	ctx context.Context, service IntegrityServiceInterface, lockManager distlock.LockManagerInterface,
+) {
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName))
+
+	ran, err := lockManager.TryWithLock(ctx, scheduledScanLockName, func(ctx context.Context, _ int64) error {
+		report, svcErr := service.Scan(ctx, ScanRequest{})
+		if svcErr != nil {
+			logger.Error("Scheduled integrity scan failed", log.String("error", svcErr.Error.DefaultValue))
+			return nil
+		}
+		if report.TotalOrphans > 0 {
+			logger.Warn("Scheduled integrity scan found orphaned references",
+				log.Int("orphans", report.TotalOrphans))
+		}
+		return nil
+	})
+	if err != nil {
+		logger.Error("Failed to run scheduled integrity scan under the scan lock", log.Error(err))
+		return
+	}
+	if !ran {
+		logger.Debug("Skipped scheduled integrity scan as another node is running it")
+	}
+}
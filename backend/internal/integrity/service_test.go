@@ -0,0 +1,293 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package integrity
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/entity"
+	"github.com/thunder-id/thunderid/internal/group"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/tests/mocks/distlockmock"
+	"github.com/thunder-id/thunderid/tests/mocks/entitymock"
+	"github.com/thunder-id/thunderid/tests/mocks/groupmock"
+	"github.com/thunder-id/thunderid/tests/mocks/oumock"
+)
+
+const (
+	testTargetOUID  = "ou-target"
+	testExistingOU  = "ou-existing"
+	testMissingOU   = "ou-missing"
+	testGroupID     = "group-1"
+	testRoleID      = "role-1"
+	testEntityID    = "entity-1"
+	testOrphanedID  = "entity-deleted"
+	testDeletedGrp  = "group-deleted"
+	testOrphanGroup = "group-orphan"
+	testOrphanRole  = "role-orphan"
+	testOrphanUser  = "entity-orphan"
+)
+
+type IntegrityServiceTestSuite struct {
+	suite.Suite
+	mockStore         *integrityStoreInterfaceMock
+	mockOUService     *oumock.OrganizationUnitServiceInterfaceMock
+	mockEntityService *entitymock.EntityServiceInterfaceMock
+	mockGroupService  *groupmock.GroupServiceInterfaceMock
+	service           IntegrityServiceInterface
+}
+
+func TestIntegrityServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(IntegrityServiceTestSuite))
+}
+
+func (suite *IntegrityServiceTestSuite) SetupTest() {
+	suite.mockStore = newIntegrityStoreInterfaceMock(suite.T())
+	suite.mockOUService = oumock.NewOrganizationUnitServiceInterfaceMock(suite.T())
+	suite.mockEntityService = entitymock.NewEntityServiceInterfaceMock(suite.T())
+	suite.mockGroupService = groupmock.NewGroupServiceInterfaceMock(suite.T())
+	suite.service = newIntegrityService(suite.mockStore, suite.mockOUService, suite.mockEntityService,
+		suite.mockGroupService)
+}
+
+// setupDetection mocks the stores and services so that one orphan of every type is detected.
+func (suite *IntegrityServiceTestSuite) setupDetection() {
+	suite.mockStore.On("ListGroupMembers", mock.Anything, scanPageSize, 0).Return([]groupMemberRef{
+		{GroupID: testGroupID, MemberType: referenceTypeEntity, MemberID: testEntityID},
+		{GroupID: testGroupID, MemberType: referenceTypeEntity, MemberID: testOrphanedID},
+		{GroupID: testGroupID, MemberType: referenceTypeGroup, MemberID: testDeletedGrp},
+	}, nil).Once()
+	suite.mockEntityService.On("ValidateEntityIDs", mock.Anything, []string{testEntityID, testOrphanedID}).
+		Return([]string{testOrphanedID}, nil).Once()
+	suite.mockGroupService.On("GetGroupsByIDs", mock.Anything, []string{testDeletedGrp}).
+		Return(map[string]*group.Group{}, nil).Once()
+
+	suite.mockStore.On("ListRoleAssignments", mock.Anything, scanPageSize, 0).Return([]roleAssignmentRef{
+		{RoleID: testRoleID, AssigneeType: referenceTypeEntity, AssigneeID: testOrphanedID},
+		{RoleID: testRoleID, AssigneeType: referenceTypeGroup, AssigneeID: testGroupID},
+	}, nil).Once()
+	suite.mockEntityService.On("ValidateEntityIDs", mock.Anything, []string{testOrphanedID}).
+		Return([]string{testOrphanedID}, nil).Once()
+	suite.mockGroupService.On("GetGroupsByIDs", mock.Anything, []string{testGroupID}).
+		Return(map[string]*group.Group{testGroupID: {ID: testGroupID}}, nil).Once()
+
+	suite.mockStore.On("ListGroupOUs", mock.Anything, scanPageSize, 0).Return([]ouRef{
+		{ID: testGroupID, OUID: testExistingOU},
+		{ID: testOrphanGroup, OUID: testMissingOU},
+	}, nil).Once()
+	suite.mockStore.On("ListRoleOUs", mock.Anything, scanPageSize, 0).Return([]ouRef{
+		{ID: testOrphanRole, OUID: testMissingOU},
+	}, nil).Once()
+	suite.mockStore.On("ListEntityOUs", mock.Anything, scanPageSize, 0).Return([]ouRef{
+		{ID: testEntityID, OUID: testExistingOU},
+		{ID: testOrphanUser, OUID: testMissingOU},
+	}, nil).Once()
+	suite.mockOUService.On("IsOrganizationUnitExists", mock.Anything, testExistingOU).Return(true, nil).Once()
+	suite.mockOUService.On("IsOrganizationUnitExists", mock.Anything, testMissingOU).Return(false, nil).Once()
+}
+
+func (suite *IntegrityServiceTestSuite) TestScan_ReportOnly() {
+	suite.setupDetection()
+
+	report, svcErr := suite.service.Scan(context.Background(), ScanRequest{})
+
+	suite.Nil(svcErr)
+	suite.Equal(6, report.TotalOrphans)
+	suite.Equal(0, report.Repaired)
+	suite.Equal([]Orphan{
+		{Type: OrphanTypeGroupMemberMissingEntity, ResourceID: testGroupID, ReferenceID: testOrphanedID},
+		{Type: OrphanTypeGroupMemberMissingGroup, ResourceID: testGroupID, ReferenceID: testDeletedGrp},
+		{Type: OrphanTypeRoleAssignmentMissingEntity, ResourceID: testRoleID, ReferenceID: testOrphanedID},
+		{Type: OrphanTypeGroupMissingOU, ResourceID: testOrphanGroup, ReferenceID: testMissingOU},
+		{Type: OrphanTypeRoleMissingOU, ResourceID: testOrphanRole, ReferenceID: testMissingOU},
+		{Type: OrphanTypeEntityMissingOU, ResourceID: testOrphanUser, ReferenceID: testMissingOU},
+	}, report.Orphans)
+}
+
+func (suite *IntegrityServiceTestSuite) TestScan_DeleteRepair() {
+	suite.setupDetection()
+	suite.mockStore.On("DeleteGroupMember", mock.Anything, testGroupID, referenceTypeEntity, testOrphanedID).
+		Return(nil).Once()
+	suite.mockStore.On("DeleteGroupMember", mock.Anything, testGroupID, referenceTypeGroup, testDeletedGrp).
+		Return(nil).Once()
+	suite.mockStore.On("DeleteRoleAssignment", mock.Anything, testRoleID, referenceTypeEntity, testOrphanedID).
+		Return(nil).Once()
+	suite.mockStore.On("DeleteGroup", mock.Anything, testOrphanGroup).Return(nil).Once()
+	suite.mockStore.On("DeleteRole", mock.Anything, testOrphanRole).Return(errors.New("db error")).Once()
+
+	report, svcErr := suite.service.Scan(context.Background(), ScanRequest{Repair: RepairActionDelete})
+
+	suite.Nil(svcErr)
+	suite.Equal(6, report.TotalOrphans)
+	suite.Equal(4, report.Repaired)
+	suite.Equal(1, report.Failed)
+
+	roleOrphan := report.Orphans[4]
+	suite.Equal(RepairActionDelete, roleOrphan.Action)
+	suite.False(roleOrphan.Repaired)
+	suite.Contains(roleOrphan.Error, "db error")
+
+	entityOrphan := report.Orphans[5]
+	suite.Equal(RepairActionSkip, entityOrphan.Action)
+	suite.False(entityOrphan.Repaired)
+}
+
+func (suite *IntegrityServiceTestSuite) TestScan_ReassignRepair() {
+	suite.setupDetection()
+	suite.mockOUService.On("IsOrganizationUnitExists", mock.Anything, testTargetOUID).Return(true, nil).Once()
+	suite.mockStore.On("DeleteGroupMember", mock.Anything, testGroupID, mock.Anything, mock.Anything).
+		Return(nil).Twice()
+	suite.mockStore.On("DeleteRoleAssignment", mock.Anything, testRoleID, referenceTypeEntity, testOrphanedID).
+		Return(nil).Once()
+	suite.mockStore.On("UpdateGroupOU", mock.Anything, testOrphanGroup, testTargetOUID).Return(nil).Once()
+	suite.mockStore.On("UpdateRoleOU", mock.Anything, testOrphanRole, testTargetOUID).Return(nil).Once()
+	suite.mockEntityService.On("GetEntity", mock.Anything, testOrphanUser).
+		Return(&entity.Entity{ID: testOrphanUser, OUID: testMissingOU}, nil).Once()
+	suite.mockEntityService.On("UpdateEntity", mock.Anything, testOrphanUser,
+		mock.MatchedBy(func(e *entity.Entity) bool { return e.OUID == testTargetOUID })).
+		Return(&entity.Entity{ID: testOrphanUser, OUID: testTargetOUID}, nil).Once()
+
+	report, svcErr := suite.service.Scan(context.Background(),
+		ScanRequest{Repair: RepairActionReassign, TargetOUID: testTargetOUID})
+
+	suite.Nil(svcErr)
+	suite.Equal(6, report.Repaired)
+	suite.Equal(0, report.Failed)
+	suite.Equal(RepairActionDelete, report.Orphans[0].Action)
+	suite.Equal(RepairActionReassign, report.Orphans[3].Action)
+	suite.Equal(RepairActionReassign, report.Orphans[5].Action)
+}
+
+func (suite *IntegrityServiceTestSuite) TestScan_DryRun() {
+	suite.setupDetection()
+
+	report, svcErr := suite.service.Scan(context.Background(),
+		ScanRequest{Repair: RepairActionDelete, DryRun: true})
+
+	suite.Nil(svcErr)
+	suite.True(report.DryRun)
+	suite.Equal(0, report.Repaired)
+	suite.Equal(RepairActionDelete, report.Orphans[3].Action)
+	suite.Equal(RepairActionSkip, report.Orphans[5].Action)
+	for _, orphan := range report.Orphans {
+		suite.False(orphan.Repaired)
+	}
+}
+
+func (suite *IntegrityServiceTestSuite) TestScan_PaginatesReferences() {
+	firstPage := make([]ouRef, scanPageSize)
+	for i := range firstPage {
+		firstPage[i] = ouRef{ID: testGroupID, OUID: testExistingOU}
+	}
+	suite.mockStore.On("ListGroupMembers", mock.Anything, scanPageSize, 0).Return([]groupMemberRef{}, nil).Once()
+	suite.mockStore.On("ListRoleAssignments", mock.Anything, scanPageSize, 0).
+		Return([]roleAssignmentRef{}, nil).Once()
+	suite.mockStore.On("ListGroupOUs", mock.Anything, scanPageSize, 0).Return(firstPage, nil).Once()
+	suite.mockStore.On("ListGroupOUs", mock.Anything, scanPageSize, scanPageSize).
+		Return([]ouRef{{ID: testOrphanGroup, OUID: testMissingOU}}, nil).Once()
+	suite.mockStore.On("ListRoleOUs", mock.Anything, scanPageSize, 0).Return([]ouRef{}, nil).Once()
+	suite.mockStore.On("ListEntityOUs", mock.Anything, scanPageSize, 0).Return([]ouRef{}, nil).Once()
+	suite.mockOUService.On("IsOrganizationUnitExists", mock.Anything, testExistingOU).Return(true, nil).Once()
+	suite.mockOUService.On("IsOrganizationUnitExists", mock.Anything, testMissingOU).Return(false, nil).Once()
+
+	report, svcErr := suite.service.Scan(context.Background(), ScanRequest{})
+
+	suite.Nil(svcErr)
+	suite.Equal([]Orphan{{Type: OrphanTypeGroupMissingOU, ResourceID: testOrphanGroup,
+		ReferenceID: testMissingOU}}, report.Orphans)
+}
+
+func (suite *IntegrityServiceTestSuite) TestScan_StoreError() {
+	suite.mockStore.On("ListGroupMembers", mock.Anything, scanPageSize, 0).
+		Return(nil, errors.New("db error")).Once()
+
+	report, svcErr := suite.service.Scan(context.Background(), ScanRequest{})
+
+	suite.Nil(report)
+	suite.Equal(&serviceerror.InternalServerError, svcErr)
+}
+
+func (suite *IntegrityServiceTestSuite) TestScan_InvalidRequests() {
+	testCases := []struct {
+		name     string
+		request  ScanRequest
+		expected *serviceerror.ServiceError
+	}{
+		{"UnsupportedRepair", ScanRequest{Repair: "PURGE"}, &ErrorInvalidRepairAction},
+		{"SkipIsNotARequestAction", ScanRequest{Repair: RepairActionSkip}, &ErrorInvalidRepairAction},
+		{"ReassignWithoutTarget", ScanRequest{Repair: RepairActionReassign}, &ErrorMissingTargetOU},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			report, svcErr := suite.service.Scan(context.Background(), tc.request)
+
+			suite.Nil(report)
+			suite.Equal(tc.expected, svcErr)
+		})
+	}
+}
+
+func (suite *IntegrityServiceTestSuite) TestScan_TargetOUNotFound() {
+	suite.mockOUService.On("IsOrganizationUnitExists", mock.Anything, testTargetOUID).Return(false, nil).Once()
+
+	report, svcErr := suite.service.Scan(context.Background(),
+		ScanRequest{Repair: RepairActionReassign, TargetOUID: testTargetOUID})
+
+	suite.Nil(report)
+	suite.Equal(&ErrorTargetOUNotFound, svcErr)
+}
+
+func (suite *IntegrityServiceTestSuite) TestRunScheduledScan_RunsUnderLock() {
+	mockService := NewIntegrityServiceInterfaceMock(suite.T())
+	mockService.On("Scan", mock.Anything, ScanRequest{}).Return(&ScanReport{TotalOrphans: 1}, nil).Once()
+	mockLockManager := distlockmock.NewLockManagerInterfaceMock(suite.T())
+	mockLockManager.EXPECT().TryWithLock(mock.Anything, scheduledScanLockName, mock.Anything).
+		RunAndReturn(func(ctx context.Context, _ string, fn func(context.Context, int64) error) (bool, error) {
+			return true, fn(ctx, 1)
+		}).Once()
+
+	runScheduledScan(context.Background(), mockService, mockLockManager)
+}
+
+func (suite *IntegrityServiceTestSuite) TestRunScheduledScan_SkippedWhenLockHeld() {
+	mockService := NewIntegrityServiceInterfaceMock(suite.T())
+	mockLockManager := distlockmock.NewLockManagerInterfaceMock(suite.T())
+	mockLockManager.On("TryWithLock", mock.Anything, scheduledScanLockName, mock.Anything).
+		Return(false, nil).Once()
+
+	runScheduledScan(context.Background(), mockService, mockLockManager)
+
+	mockService.AssertNotCalled(suite.T(), "Scan", mock.Anything, mock.Anything)
+}
+
+func (suite *IntegrityServiceTestSuite) TestRunScheduledScan_LockError() {
+	mockService := NewIntegrityServiceInterfaceMock(suite.T())
+	mockLockManager := distlockmock.NewLockManagerInterfaceMock(suite.T())
+	mockLockManager.On("TryWithLock", mock.Anything, scheduledScanLockName, mock.Anything).
+		Return(false, errors.New("store unavailable")).Once()
+
+	runScheduledScan(context.Background(), mockService, mockLockManager)
+
+	mockService.AssertNotCalled(suite.T(), "Scan", mock.Anything, mock.Anything)
+}
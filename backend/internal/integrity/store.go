@@ -0,0 +1,229 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package integrity
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+	sysContext "github.com/thunder-id/thunderid/internal/system/context"
+	dbmodel "github.com/thunder-id/thunderid/internal/system/database/model"
+	"github.com/thunder-id/thunderid/internal/system/database/provider"
+)
+
+var getDBProvider = provider.GetDBProvider
+
+// integrityStoreInterface defines the store operations used to detect and repair orphaned references.
+type integrityStoreInterface interface {
+	ListGroupMembers(ctx context.Context, limit, offset int) ([]groupMemberRef, error)
+	ListGroupOUs(ctx context.Context, limit, offset int) ([]ouRef, error)
+	ListEntityOUs(ctx context.Context, limit, offset int) ([]ouRef, error)
+	ListRoleAssignments(ctx context.Context, limit, offset int) ([]roleAssignmentRef, error)
+	ListRoleOUs(ctx context.Context, limit, offset int) ([]ouRef, error)
+	DeleteGroupMember(ctx context.Context, groupID, memberType, memberID string) error
+	DeleteGroup(ctx context.Context, groupID string) error
+	UpdateGroupOU(ctx context.Context, groupID, ouID string) error
+	DeleteRoleAssignment(ctx context.Context, roleID, assigneeType, assigneeID string) error
+	DeleteRole(ctx context.Context, roleID string) error
+	UpdateRoleOU(ctx context.Context, roleID, ouID string) error
+}
+
+// integrityStore is the database backed implementation of integrityStoreInterface. Group and entity
+// references are read from the user database and role references from the config database.
+type integrityStore struct {
+	dbProvider   provider.DBProviderInterface
+	deploymentID string
+}
+
+// newIntegrityStore creates a new instance of integrityStore.
+func newIntegrityStore() integrityStoreInterface {
+	return &integrityStore{
+		dbProvider:   getDBProvider(),
+		deploymentID: config.GetServerRuntime().Config.Server.Identifier,
+	}
+}
+
+// ListGroupMembers lists a page of group memberships.
+func (s *integrityStore) ListGroupMembers(ctx context.Context, limit, offset int) ([]groupMemberRef, error) {
+	results, err := s.queryPage(ctx, s.dbProvider.GetUserDBClient, queryListGroupMembers, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	members := make([]groupMemberRef, 0, len(results))
+	for _, row := range results {
+		groupID, ok1 := row["group_id"].(string)
+		memberType, ok2 := row["member_type"].(string)
+		memberID, ok3 := row["member_id"].(string)
+		if !ok1 || !ok2 || !ok3 {
+			return nil, fmt.Errorf("failed to parse group member reference")
+		}
+		members = append(members, groupMemberRef{GroupID: groupID, MemberType: memberType, MemberID: memberID})
+	}
+	return members, nil
+}
+
+// ListGroupOUs lists a page of groups with their organization units.
+func (s *integrityStore) ListGroupOUs(ctx context.Context, limit, offset int) ([]ouRef, error) {
+	return s.listOURefs(ctx, s.dbProvider.GetUserDBClient, queryListGroupOUs, limit, offset)
+}
+
+// ListEntityOUs lists a page of entities with their organization units.
+func (s *integrityStore) ListEntityOUs(ctx context.Context, limit, offset int) ([]ouRef, error) {
+	return s.listOURefs(ctx, s.dbProvider.GetUserDBClient, queryListEntityOUs, limit, offset)
+}
+
+// ListRoleAssignments lists a page of role assignments.
+func (s *integrityStore) ListRoleAssignments(
+	ctx context.Context, limit, offset int,
+) ([]roleAssignmentRef, error) {
+	results, err := s.queryPage(ctx, s.dbProvider.GetConfigDBClient, queryListRoleAssignments, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	assignments := make([]roleAssignmentRef, 0, len(results))
+	for _, row := range results {
+		roleID, ok1 := row["role_id"].(string)
+		assigneeType, ok2 := row["assignee_type"].(string)
+		assigneeID, ok3 := row["assignee_id"].(string)
+		if !ok1 || !ok2 || !ok3 {
+			return nil, fmt.Errorf("failed to parse role assignment reference")
+		}
+		assignments = append(assignments,
+			roleAssignmentRef{RoleID: roleID, AssigneeType: assigneeType, AssigneeID: assigneeID})
+	}
+	return assignments, nil
+}
+
+// ListRoleOUs lists a page of roles with their organization units.
+func (s *integrityStore) ListRoleOUs(ctx context.Context, limit, offset int) ([]ouRef, error) {
+	return s.listOURefs(ctx, s.dbProvider.GetConfigDBClient, queryListRoleOUs, limit, offset)
+}
+
+// DeleteGroupMember deletes a single group membership.
+func (s *integrityStore) DeleteGroupMember(ctx context.Context, groupID, memberType, memberID string) error {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
+	return s.execute(ctx, s.dbProvider.GetUserDBClient, queryDeleteGroupMember,
+		groupID, memberType, memberID, deploymentID)
+}
+
+// DeleteGroup deletes a group together with its role assignments and its memberships in other groups.
+func (s *integrityStore) DeleteGroup(ctx context.Context, groupID string) error {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
+	if err := s.execute(ctx, s.dbProvider.GetConfigDBClient, queryDeleteAssigneeFromAllRoles,
+		referenceTypeGroup, groupID, deploymentID); err != nil {
+		return err
+	}
+	if err := s.execute(ctx, s.dbProvider.GetUserDBClient, queryDeleteMemberFromAllGroups,
+		referenceTypeGroup, groupID, deploymentID); err != nil {
+		return err
+	}
+	return s.execute(ctx, s.dbProvider.GetUserDBClient, queryDeleteGroup, groupID, deploymentID)
+}
+
+// UpdateGroupOU moves a group to another organization unit.
+func (s *integrityStore) UpdateGroupOU(ctx context.Context, groupID, ouID string) error {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
+	return s.execute(ctx, s.dbProvider.GetUserDBClient, queryUpdateGroupOU,
+		ouID, time.Now().UTC(), groupID, deploymentID)
+}
+
+// DeleteRoleAssignment deletes a single role assignment.
+func (s *integrityStore) DeleteRoleAssignment(
+	ctx context.Context, roleID, assigneeType, assigneeID string,
+) error {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
+	return s.execute(ctx, s.dbProvider.GetConfigDBClient, queryDeleteRoleAssignment,
+		roleID, assigneeType, assigneeID, deploymentID)
+}
+
+// DeleteRole deletes a role together with its assignments.
+func (s *integrityStore) DeleteRole(ctx context.Context, roleID string) error {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
+	if err := s.execute(ctx, s.dbProvider.GetConfigDBClient, queryDeleteRoleAssignments,
+		roleID, deploymentID); err != nil {
+		return err
+	}
+	return s.execute(ctx, s.dbProvider.GetConfigDBClient, queryDeleteRole, roleID, deploymentID)
+}
+
+// UpdateRoleOU moves a role to another organization unit.
+func (s *integrityStore) UpdateRoleOU(ctx context.Context, roleID, ouID string) error {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
+	return s.execute(ctx, s.dbProvider.GetConfigDBClient, queryUpdateRoleOU, ouID, roleID, deploymentID)
+}
+
+// listOURefs lists a page of resources with their organization units using the given query.
+func (s *integrityStore) listOURefs(
+	ctx context.Context, getClient func() (provider.DBClientInterface, error),
+	query dbmodel.DBQuery, limit, offset int,
+) ([]ouRef, error) {
+	results, err := s.queryPage(ctx, getClient, query, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	refs := make([]ouRef, 0, len(results))
+	for _, row := range results {
+		id, ok1 := row["id"].(string)
+		ouID, ok2 := row["ou_id"].(string)
+		if !ok1 || !ok2 {
+			return nil, fmt.Errorf("failed to parse organization unit reference")
+		}
+		refs = append(refs, ouRef{ID: id, OUID: ouID})
+	}
+	return refs, nil
+}
+
+// queryPage executes a paginated list query against the database returned by getClient.
+func (s *integrityStore) queryPage(
+	ctx context.Context, getClient func() (provider.DBClientInterface, error),
+	query dbmodel.DBQuery, limit, offset int,
+) ([]map[string]interface{}, error) {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
+	dbClient, err := getClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	results, err := dbClient.QueryContext(ctx, query, limit, offset, deploymentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+	return results, nil
+}
+
+// execute runs a write query against the database returned by getClient.
+func (s *integrityStore) execute(
+	ctx context.Context, getClient func() (provider.DBClientInterface, error),
+	query dbmodel.DBQuery, args ...interface{},
+) error {
+	dbClient, err := getClient()
+	if err != nil {
+		return fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	if _, err := dbClient.ExecuteContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("failed to execute query: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,113 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package integrity
+
+import dbmodel "github.com/thunder-id/thunderid/internal/system/database/model"
+
+var (
+	// queryListGroupMembers lists group memberships page by page.
+	queryListGroupMembers = dbmodel.DBQuery{
+		ID: "INTQ-INT_MGT-01",
+		Query: `SELECT GROUP_ID, MEMBER_TYPE, MEMBER_ID FROM "GROUP_MEMBER_REFERENCE" WHERE DEPLOYMENT_ID = $3 ` +
+			`ORDER BY GROUP_ID, MEMBER_TYPE, MEMBER_ID LIMIT $1 OFFSET $2`,
+	}
+
+	// queryListGroupOUs lists groups with their organization units page by page.
+	queryListGroupOUs = dbmodel.DBQuery{
+		ID:    "INTQ-INT_MGT-02",
+		Query: `SELECT ID, OU_ID FROM "GROUP" WHERE DEPLOYMENT_ID = $3 ORDER BY ID LIMIT $1 OFFSET $2`,
+	}
+
+	// queryListEntityOUs lists entities with their organization units page by page.
+	queryListEntityOUs = dbmodel.DBQuery{
+		ID:    "INTQ-INT_MGT-03",
+		Query: `SELECT ID, OU_ID FROM "ENTITY" WHERE DEPLOYMENT_ID = $3 ORDER BY ID LIMIT $1 OFFSET $2`,
+	}
+
+	// queryListRoleAssignments lists role assignments page by page.
+	queryListRoleAssignments = dbmodel.DBQuery{
+		ID: "INTQ-INT_MGT-04",
+		Query: `SELECT ROLE_ID, ASSIGNEE_TYPE, ASSIGNEE_ID FROM "ROLE_ASSIGNMENT" WHERE DEPLOYMENT_ID = $3 ` +
+			`ORDER BY ROLE_ID, ASSIGNEE_TYPE, ASSIGNEE_ID LIMIT $1 OFFSET $2`,
+	}
+
+	// queryListRoleOUs lists roles with their organization units page by page.
+	queryListRoleOUs = dbmodel.DBQuery{
+		ID:    "INTQ-INT_MGT-05",
+		Query: `SELECT ID, OU_ID FROM "ROLE" WHERE DEPLOYMENT_ID = $3 ORDER BY ID LIMIT $1 OFFSET $2`,
+	}
+
+	// queryDeleteGroupMember deletes a single group membership.
+	queryDeleteGroupMember = dbmodel.DBQuery{
+		ID: "INTQ-INT_MGT-06",
+		Query: `DELETE FROM "GROUP_MEMBER_REFERENCE" ` +
+			`WHERE GROUP_ID = $1 AND MEMBER_TYPE = $2 AND MEMBER_ID = $3 AND DEPLOYMENT_ID = $4`,
+	}
+
+	// queryDeleteMemberFromAllGroups deletes every group membership of a member.
+	queryDeleteMemberFromAllGroups = dbmodel.DBQuery{
+		ID: "INTQ-INT_MGT-07",
+		Query: `DELETE FROM "GROUP_MEMBER_REFERENCE" ` +
+			`WHERE MEMBER_TYPE = $1 AND MEMBER_ID = $2 AND DEPLOYMENT_ID = $3`,
+	}
+
+	// queryDeleteGroup deletes a group. Its memberships are removed by the foreign key cascade.
+	queryDeleteGroup = dbmodel.DBQuery{
+		ID:    "INTQ-INT_MGT-08",
+		Query: `DELETE FROM "GROUP" WHERE ID = $1 AND DEPLOYMENT_ID = $2`,
+	}
+
+	// queryUpdateGroupOU moves a group to another organization unit.
+	queryUpdateGroupOU = dbmodel.DBQuery{
+		ID:    "INTQ-INT_MGT-09",
+		Query: `UPDATE "GROUP" SET OU_ID = $1, UPDATED_AT = $2 WHERE ID = $3 AND DEPLOYMENT_ID = $4`,
+	}
+
+	// queryDeleteRoleAssignment deletes a single role assignment.
+	queryDeleteRoleAssignment = dbmodel.DBQuery{
+		ID: "INTQ-INT_MGT-10",
+		Query: `DELETE FROM "ROLE_ASSIGNMENT" ` +
+			`WHERE ROLE_ID = $1 AND ASSIGNEE_TYPE = $2 AND ASSIGNEE_ID = $3 AND DEPLOYMENT_ID = $4`,
+	}
+
+	// queryDeleteAssigneeFromAllRoles deletes every role assignment of an assignee.
+	queryDeleteAssigneeFromAllRoles = dbmodel.DBQuery{
+		ID: "INTQ-INT_MGT-11",
+		Query: `DELETE FROM "ROLE_ASSIGNMENT" ` +
+			`WHERE ASSIGNEE_TYPE = $1 AND ASSIGNEE_ID = $2 AND DEPLOYMENT_ID = $3`,
+	}
+
+	// queryDeleteRoleAssignments deletes every assignment of a role.
+	queryDeleteRoleAssignments = dbmodel.DBQuery{
+		ID:    "INTQ-INT_MGT-12",
+		Query: `DELETE FROM "ROLE_ASSIGNMENT" WHERE ROLE_ID = $1 AND DEPLOYMENT_ID = $2`,
+	}
+
+	// queryDeleteRole deletes a role. Its permissions are removed by the foreign key cascade.
+	queryDeleteRole = dbmodel.DBQuery{
+		ID:    "INTQ-INT_MGT-13",
+		Query: `DELETE FROM "ROLE" WHERE ID = $1 AND DEPLOYMENT_ID = $2`,
+	}
+
+	// queryUpdateRoleOU moves a role to another organization unit.
+	queryUpdateRoleOU = dbmodel.DBQuery{
+		ID:    "INTQ-INT_MGT-14",
+		Query: `UPDATE "ROLE" SET OU_ID = $1 WHERE ID = $2 AND DEPLOYMENT_ID = $3`,
+	}
+)
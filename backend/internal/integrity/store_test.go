@@ -0,0 +1,138 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package integrity
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/tests/mocks/database/providermock"
+)
+
+const testDeploymentID = "test-deployment-id"
+
+type IntegrityStoreTestSuite struct {
+	suite.Suite
+	mockDBProvider *providermock.DBProviderInterfaceMock
+	mockDBClient   *providermock.DBClientInterfaceMock
+	store          *integrityStore
+	ctx            context.Context
+}
+
+func TestIntegrityStoreTestSuite(t *testing.T) {
+	suite.Run(t, new(IntegrityStoreTestSuite))
+}
+
+func (suite *IntegrityStoreTestSuite) SetupTest() {
+	suite.mockDBProvider = providermock.NewDBProviderInterfaceMock(suite.T())
+	suite.mockDBClient = providermock.NewDBClientInterfaceMock(suite.T())
+	suite.store = &integrityStore{
+		dbProvider:   suite.mockDBProvider,
+		deploymentID: testDeploymentID,
+	}
+	suite.ctx = context.Background()
+}
+
+func (suite *IntegrityStoreTestSuite) TestListGroupMembers() {
+	suite.mockDBProvider.On("GetUserDBClient").Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.On("QueryContext", suite.ctx, queryListGroupMembers, 100, 0, testDeploymentID).
+		Return([]map[string]interface{}{
+			{"group_id": "group-1", "member_type": "entity", "member_id": "entity-1"},
+		}, nil).Once()
+
+	members, err := suite.store.ListGroupMembers(suite.ctx, 100, 0)
+
+	suite.NoError(err)
+	suite.Equal([]groupMemberRef{{GroupID: "group-1", MemberType: "entity", MemberID: "entity-1"}}, members)
+}
+
+func (suite *IntegrityStoreTestSuite) TestListRoleAssignments_ParseError() {
+	suite.mockDBProvider.On("GetConfigDBClient").Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.On("QueryContext", suite.ctx, queryListRoleAssignments, 100, 0, testDeploymentID).
+		Return([]map[string]interface{}{{"role_id": "role-1"}}, nil).Once()
+
+	assignments, err := suite.store.ListRoleAssignments(suite.ctx, 100, 0)
+
+	suite.Nil(assignments)
+	suite.ErrorContains(err, "failed to parse role assignment reference")
+}
+
+func (suite *IntegrityStoreTestSuite) TestListRoleOUs() {
+	suite.mockDBProvider.On("GetConfigDBClient").Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.On("QueryContext", suite.ctx, queryListRoleOUs, 100, 200, testDeploymentID).
+		Return([]map[string]interface{}{{"id": "role-1", "ou_id": "ou-1"}}, nil).Once()
+
+	refs, err := suite.store.ListRoleOUs(suite.ctx, 100, 200)
+
+	suite.NoError(err)
+	suite.Equal([]ouRef{{ID: "role-1", OUID: "ou-1"}}, refs)
+}
+
+func (suite *IntegrityStoreTestSuite) TestListEntityOUs_DBClientError() {
+	suite.mockDBProvider.On("GetUserDBClient").Return(nil, errors.New("db error")).Once()
+
+	refs, err := suite.store.ListEntityOUs(suite.ctx, 100, 0)
+
+	suite.Nil(refs)
+	suite.ErrorContains(err, "failed to get database client")
+}
+
+func (suite *IntegrityStoreTestSuite) TestDeleteGroup_RemovesReferences() {
+	configClient := providermock.NewDBClientInterfaceMock(suite.T())
+	suite.mockDBProvider.On("GetConfigDBClient").Return(configClient, nil).Once()
+	suite.mockDBProvider.On("GetUserDBClient").Return(suite.mockDBClient, nil).Twice()
+	configClient.On("ExecuteContext", suite.ctx, queryDeleteAssigneeFromAllRoles, "group", "group-1",
+		testDeploymentID).Return(int64(1), nil).Once()
+	suite.mockDBClient.On("ExecuteContext", suite.ctx, queryDeleteMemberFromAllGroups, "group", "group-1",
+		testDeploymentID).Return(int64(0), nil).Once()
+	suite.mockDBClient.On("ExecuteContext", suite.ctx, queryDeleteGroup, "group-1", testDeploymentID).
+		Return(int64(1), nil).Once()
+
+	suite.NoError(suite.store.DeleteGroup(suite.ctx, "group-1"))
+}
+
+func (suite *IntegrityStoreTestSuite) TestDeleteRole_StopsOnError() {
+	suite.mockDBProvider.On("GetConfigDBClient").Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.On("ExecuteContext", suite.ctx, queryDeleteRoleAssignments, "role-1", testDeploymentID).
+		Return(int64(0), errors.New("db error")).Once()
+
+	err := suite.store.DeleteRole(suite.ctx, "role-1")
+
+	suite.ErrorContains(err, "failed to execute query")
+}
+
+func (suite *IntegrityStoreTestSuite) TestUpdateGroupOU() {
+	suite.mockDBProvider.On("GetUserDBClient").Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.On("ExecuteContext", suite.ctx, queryUpdateGroupOU, "ou-2", mock.Anything, "group-1",
+		testDeploymentID).Return(int64(1), nil).Once()
+
+	suite.NoError(suite.store.UpdateGroupOU(suite.ctx, "group-1", "ou-2"))
+}
+
+func (suite *IntegrityStoreTestSuite) TestUpdateRoleOU() {
+	suite.mockDBProvider.On("GetConfigDBClient").Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.On("ExecuteContext", suite.ctx, queryUpdateRoleOU, "ou-2", "role-1", testDeploymentID).
+		Return(int64(1), nil).Once()
+
+	suite.NoError(suite.store.UpdateRoleOU(suite.ctx, "role-1", "ou-2"))
+}
@@ -0,0 +1,78 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package common
+
+import (
+	"context"
+	"sync"
+)
+
+type captureContextKey struct{}
+
+// CapturedNotification represents a notification that was captured instead of being delivered.
+type CapturedNotification struct {
+	Channel   ChannelType `json:"channel"`
+	SenderID  string      `json:"senderId,omitempty"`
+	Recipient string      `json:"recipient"`
+	Subject   string      `json:"subject,omitempty"`
+	Body      string      `json:"body"`
+}
+
+// NotificationCapture collects notifications that would have been sent during a dry run.
+type NotificationCapture struct {
+	mu            sync.Mutex
+	notifications []CapturedNotification
+}
+
+// NewNotificationCapture creates a new empty notification capture.
+func NewNotificationCapture() *NotificationCapture {
+	return &NotificationCapture{}
+}
+
+// Capture records the given notification.
+func (c *NotificationCapture) Capture(notification CapturedNotification) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.notifications = append(c.notifications, notification)
+}
+
+// Notifications returns a copy of the captured notifications in the order they were captured.
+func (c *NotificationCapture) Notifications() []CapturedNotification {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	result := make([]CapturedNotification, len(c.notifications))
+	copy(result, c.notifications)
+	return result
+}
+
+// WithNotificationCapture returns a context in which notifications are captured by the given
+// capture instead of being delivered.
+func WithNotificationCapture(ctx context.Context, capture *NotificationCapture) context.Context {
+	return context.WithValue(ctx, captureContextKey{}, capture)
+}
+
+// GetNotificationCapture returns the notification capture attached to the context, or nil when
+// notifications should be delivered.
+func GetNotificationCapture(ctx context.Context) *NotificationCapture {
+	if ctx == nil {
+		return nil
+	}
+	capture, _ := ctx.Value(captureContextKey{}).(*NotificationCapture)
+	return capture
+}
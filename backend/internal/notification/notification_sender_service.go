@@ -70,6 +70,16 @@ func (s *notificationSenderService) Send(ctx context.Context, channel common.Cha
 		return &ErrorUnsupportedChannel
 	}
 
+	if capture := common.GetNotificationCapture(ctx); capture != nil {
+		capture.Capture(common.CapturedNotification{
+			Channel:   channel,
+			SenderID:  senderID,
+			Recipient: data.Recipient,
+			Body:      data.Body,
+		})
+		return nil
+	}
+
 	if err := _client.Send(channel, data); err != nil {
 		s.logger.Error("Failed to send notification", log.String("channel", string(channel)), log.Error(err))
 		return &serviceerror.InternalServerError
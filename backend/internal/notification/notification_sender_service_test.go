@@ -96,6 +96,31 @@ func (suite *NotificationSenderServiceTestSuite) TestSendSMS_Success() {
 	suite.Nil(err)
 }
 
+func (suite *NotificationSenderServiceTestSuite) TestSendSMS_CapturedInContext() {
+	sender := suite.getValidSender()
+	suite.mockSenderMgtSvc.On("GetSender", mock.Anything, "sender-001").Return(sender, nil).Once()
+
+	mm := messagemock.NewNotificationClientInterfaceMock(suite.T())
+	mm.EXPECT().IsChannelSupported(common.ChannelTypeSMS).Return(true).Once()
+	suite.mockClientProvider.EXPECT().GetClient(mock.Anything).Return(mm, nil).Once()
+
+	capture := common.NewNotificationCapture()
+	ctx := common.WithNotificationCapture(context.Background(), capture)
+	err := suite.service.Send(ctx, common.ChannelTypeSMS, "sender-001",
+		common.NotificationData{Recipient: "+94714627887", Body: "Test message"})
+	suite.Nil(err)
+
+	captured := capture.Notifications()
+	suite.Len(captured, 1)
+	suite.Equal(common.CapturedNotification{
+		Channel:   common.ChannelTypeSMS,
+		SenderID:  "sender-001",
+		Recipient: "+94714627887",
+		Body:      "Test message",
+	}, captured[0])
+	mm.AssertNotCalled(suite.T(), "Send", mock.Anything, mock.Anything)
+}
+
 func (suite *NotificationSenderServiceTestSuite) TestSendSMS_GetSenderError() {
 	suite.mockSenderMgtSvc.On("GetSender", mock.Anything, "sender-001").
 		Return(nil, &ErrorSenderNotFound).Once()
@@ -273,6 +273,16 @@ func (s *otpService) sendSMSOTP(ctx context.Context, recipient, otp string,
 	}
 
 	notifData := common.NotificationData{Recipient: recipient, Body: rendered.Body}
+	if capture := common.GetNotificationCapture(ctx); capture != nil {
+		capture.Capture(common.CapturedNotification{
+			Channel:   common.ChannelTypeSMS,
+			SenderID:  sender.ID,
+			Recipient: recipient,
+			Body:      rendered.Body,
+		})
+		return nil
+	}
+
 	if err := _client.Send(common.ChannelTypeSMS, notifData); err != nil {
 		logger.Error("Failed to send SMS OTP", log.Error(err))
 		return &serviceerror.InternalServerError
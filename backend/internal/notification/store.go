@@ -25,6 +25,7 @@ import (
 	"github.com/thunder-id/thunderid/internal/notification/common"
 	"github.com/thunder-id/thunderid/internal/system/cmodels"
 	"github.com/thunder-id/thunderid/internal/system/config"
+	sysContext "github.com/thunder-id/thunderid/internal/system/context"
 	dbmodel "github.com/thunder-id/thunderid/internal/system/database/model"
 	"github.com/thunder-id/thunderid/internal/system/database/provider"
 	"github.com/thunder-id/thunderid/internal/system/log"
@@ -73,6 +74,7 @@ func newNotificationStore() (notificationStoreInterface, transaction.Transaction
 
 // createSender creates a new notification sender.
 func (s *notificationStore) createSender(ctx context.Context, sender common.NotificationSenderDTO) error {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
 	dbClient, err := s.dbProvider.GetConfigDBClient()
 	if err != nil {
 		return fmt.Errorf("failed to get database client: %w", err)
@@ -88,7 +90,7 @@ func (s *notificationStore) createSender(ctx context.Context, sender common.Noti
 	}
 
 	_, err = dbClient.ExecuteContext(ctx, queryCreateNotificationSender, sender.Name, sender.ID,
-		sender.Description, string(sender.Type), string(sender.Provider), propertiesJSON, s.deploymentID)
+		sender.Description, string(sender.Type), string(sender.Provider), propertiesJSON, deploymentID)
 	if err != nil {
 		return fmt.Errorf("failed to execute query: %w", err)
 	}
@@ -98,12 +100,13 @@ func (s *notificationStore) createSender(ctx context.Context, sender common.Noti
 
 // listSenders retrieves all notification senders
 func (s *notificationStore) listSenders(ctx context.Context) ([]common.NotificationSenderDTO, error) {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
 	dbClient, err := s.dbProvider.GetConfigDBClient()
 	if err != nil {
 		return nil, fmt.Errorf("failed to get database client: %w", err)
 	}
 
-	results, err := dbClient.QueryContext(ctx, queryGetAllNotificationSenders, s.deploymentID)
+	results, err := dbClient.QueryContext(ctx, queryGetAllNotificationSenders, deploymentID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to execute query: %w", err)
 	}
@@ -151,6 +154,7 @@ func (s *notificationStore) getSenderByName(ctx context.Context, name string) (*
 // getSender retrieves a notification sender by a specific identifier (ID or name).
 func (s *notificationStore) getSender(ctx context.Context, query dbmodel.DBQuery,
 	identifier string) (*common.NotificationSenderDTO, error) {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
 	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, "NotificationStore"))
 
 	dbClient, err := s.dbProvider.GetConfigDBClient()
@@ -158,7 +162,7 @@ func (s *notificationStore) getSender(ctx context.Context, query dbmodel.DBQuery
 		return nil, fmt.Errorf("failed to get database client: %w", err)
 	}
 
-	results, err := dbClient.QueryContext(ctx, query, identifier, s.deploymentID)
+	results, err := dbClient.QueryContext(ctx, query, identifier, deploymentID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to execute query: %w", err)
 	}
@@ -197,6 +201,7 @@ func (s *notificationStore) getSender(ctx context.Context, query dbmodel.DBQuery
 
 // updateSender updates an existing notification sender.
 func (s *notificationStore) updateSender(ctx context.Context, id string, sender common.NotificationSenderDTO) error {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
 	dbClient, err := s.dbProvider.GetConfigDBClient()
 	if err != nil {
 		return fmt.Errorf("failed to get database client: %w", err)
@@ -212,7 +217,7 @@ func (s *notificationStore) updateSender(ctx context.Context, id string, sender
 	}
 
 	_, err = dbClient.ExecuteContext(ctx, queryUpdateNotificationSender, sender.Name, sender.Description,
-		string(sender.Provider), propertiesJSON, id, string(sender.Type), s.deploymentID)
+		string(sender.Provider), propertiesJSON, id, string(sender.Type), deploymentID)
 	if err != nil {
 		return fmt.Errorf("failed to execute query: %w", err)
 	}
@@ -222,6 +227,7 @@ func (s *notificationStore) updateSender(ctx context.Context, id string, sender
 
 // deleteSender deletes a notification sender.
 func (s *notificationStore) deleteSender(ctx context.Context, id string) error {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
 	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, "NotificationStore"))
 
 	dbClient, err := s.dbProvider.GetConfigDBClient()
@@ -229,7 +235,7 @@ func (s *notificationStore) deleteSender(ctx context.Context, id string) error {
 		return fmt.Errorf("failed to get database client: %w", err)
 	}
 
-	rowsAffected, err := dbClient.ExecuteContext(ctx, queryDeleteNotificationSender, id, s.deploymentID)
+	rowsAffected, err := dbClient.ExecuteContext(ctx, queryDeleteNotificationSender, id, deploymentID)
 	if err != nil {
 		return fmt.Errorf("failed to execute delete query: %w", err)
 	}
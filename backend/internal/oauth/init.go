@@ -20,6 +20,7 @@
 package oauth
 
 import (
+	"context"
 	"net/http"
 
 	"github.com/thunder-id/thunderid/internal/application"
@@ -27,22 +28,37 @@ import (
 	authnprovidermgr "github.com/thunder-id/thunderid/internal/authnprovider/manager"
 	"github.com/thunder-id/thunderid/internal/authz"
 	"github.com/thunder-id/thunderid/internal/entityprovider"
+	"github.com/thunder-id/thunderid/internal/enumeration"
 	"github.com/thunder-id/thunderid/internal/flow/flowexec"
 	"github.com/thunder-id/thunderid/internal/idp"
 	"github.com/thunder-id/thunderid/internal/inboundclient"
 	"github.com/thunder-id/thunderid/internal/oauth/jwks"
+	"github.com/thunder-id/thunderid/internal/oauth/metadatacache"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/claimfallback"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/clientusage"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/credentialcheck"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/dcr"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/discovery"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/dpop"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/granthandlers"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/introspect"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/jwksresolver"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/logout"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/par"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/preissuance"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/protocoltrace"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/roleclaims"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/token"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/tokencustomizer"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/tokenexplain"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/tokenservice"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/userinfo"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/userroles"
 	"github.com/thunder-id/thunderid/internal/oauth/scope"
 	"github.com/thunder-id/thunderid/internal/ou"
 	"github.com/thunder-id/thunderid/internal/resource"
+	"github.com/thunder-id/thunderid/internal/role"
+	"github.com/thunder-id/thunderid/internal/system/cache"
 	"github.com/thunder-id/thunderid/internal/system/database/provider"
 	syshttp "github.com/thunder-id/thunderid/internal/system/http"
 	i18nmgt "github.com/thunder-id/thunderid/internal/system/i18n/mgt"
@@ -50,9 +66,11 @@ import (
 	"github.com/thunder-id/thunderid/internal/system/jose/jwt"
 	"github.com/thunder-id/thunderid/internal/system/kmprovider"
 	"github.com/thunder-id/thunderid/internal/system/observability"
+	"github.com/thunder-id/thunderid/internal/system/sysauthz"
 )
 
-// Initialize initializes all OAuth-related services and registers their routes.
+// Initialize initializes all OAuth-related services and registers their routes. It returns the credential
+// check service, which must be stopped during shutdown.
 func Initialize(
 	mux *http.ServeMux,
 	applicationService application.ApplicationServiceInterface,
@@ -70,34 +88,58 @@ func Initialize(
 	resourceService resource.ResourceServiceInterface,
 	i18nService i18nmgt.I18nServiceInterface,
 	idpService idp.IDPServiceInterface,
-) error {
+	sysAuthzService sysauthz.SystemAuthorizationServiceInterface,
+	clientUsageService clientusage.ClientUsageServiceInterface,
+	roleService role.RoleServiceInterface,
+	cacheManager cache.CacheManagerInterface,
+	enumerationService enumeration.EnumerationResistanceServiceInterface,
+) (credentialcheck.CredentialCheckServiceInterface, error) {
 	// Fetch runtime transactioner for OAuth services.
 	transactioner, err := provider.GetDBProvider().GetRuntimeDBTransactioner()
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	jwks.Initialize(mux, runtimeCrypto)
+	metadataCache := metadatacache.Initialize()
+	jwks.Initialize(mux, runtimeCrypto, metadataCache)
 	httpClient := syshttp.NewHTTPClientWithCheckRedirect(func(req *http.Request, _ []*http.Request) error {
 		return syshttp.IsSSRFSafeURL(req.URL.String())
 	})
 	resolver := jwksresolver.Initialize(httpClient)
-	tokenBuilder, tokenValidator := tokenservice.Initialize(jwtService, jweService, resolver, idpService)
+	preIssuanceService := preissuance.Initialize(entityProvider)
+	roleClaimsService := roleclaims.Initialize(entityProvider, roleService)
+	claimFallbackService := claimfallback.Initialize(cacheManager)
+	tokenCustomizerService := tokencustomizer.Initialize()
+	tokenBuilder, tokenValidator := tokenservice.Initialize(jwtService, jweService, resolver, idpService,
+		preIssuanceService, roleClaimsService, claimFallbackService, tokenCustomizerService, resourceService)
 	scopeValidator := scope.Initialize()
-	discoveryService := discovery.Initialize(mux, runtimeCrypto)
+	discoveryService := discovery.Initialize(mux, runtimeCrypto, metadataCache)
+	// Key rotations and configuration changes take effect on startup, so purge any cached copies of
+	// documents whose content changed.
+	metadataCache.Refresh(context.Background())
 	parService := par.Initialize(mux, inboundClient, authnProvider, jwtService, discoveryService,
 		resourceService)
+	protocolTraceService := protocoltrace.Initialize(mux, sysAuthzService)
+	tokenexplain.Initialize(mux, sysAuthzService, inboundClient, entityProvider, authzService, preIssuanceService)
 	grantHandlerProvider, err := granthandlers.Initialize(
-		mux, jwtService, inboundClient, flowExecService, tokenBuilder, tokenValidator,
-		attributeCacheSvc, ouService, authzService, entityProvider, resourceService, parService)
+		mux, jwtService, inboundClient, authnProvider, flowExecService, tokenBuilder, tokenValidator,
+		attributeCacheSvc, ouService, authzService, entityProvider, resourceService, parService,
+		discoveryService, protocolTraceService, clientUsageService, observabilitySvc)
 	if err != nil {
-		return err
+		return nil, err
 	}
+	dpopService := dpop.Initialize(jwtService)
 	token.Initialize(mux, jwtService, inboundClient, authnProvider, grantHandlerProvider,
-		scopeValidator, observabilitySvc, discoveryService, transactioner)
-	introspect.Initialize(mux, jwtService, inboundClient, authnProvider, discoveryService)
+		scopeValidator, observabilitySvc, discoveryService, transactioner, protocolTraceService,
+		clientUsageService, dpopService)
+	introspect.Initialize(mux, jwtService, inboundClient, authnProvider, discoveryService, resourceService)
 	userinfo.Initialize(mux, jwtService, jweService, resolver,
-		tokenValidator, inboundClient, ouService, attributeCacheSvc, transactioner)
+		tokenValidator, inboundClient, ouService, attributeCacheSvc, claimFallbackService, transactioner,
+		dpopService)
+	userroles.Initialize(mux, tokenValidator, roleClaimsService, dpopService)
+	logout.Initialize(mux, jwtService, inboundClient, httpClient)
+	credentialCheckService := credentialcheck.Initialize(mux, entityProvider, inboundClient, authnProvider,
+		jwtService, enumerationService)
 	dcr.Initialize(mux, applicationService, ouService, i18nService, transactioner)
-	return nil
+	return credentialCheckService, nil
 }
@@ -19,23 +19,41 @@
 package jwks
 
 import (
+	"context"
+	"fmt"
 	"net/http"
 
+	"github.com/thunder-id/thunderid/internal/oauth/metadatacache"
 	"github.com/thunder-id/thunderid/internal/system/kmprovider"
 	"github.com/thunder-id/thunderid/internal/system/middleware"
 )
 
-// Initialize initializes the JWKS service and registers its routes.
-func Initialize(mux *http.ServeMux, cryptoProvider kmprovider.RuntimeCryptoProvider) JWKSServiceInterface {
+// Initialize initializes the JWKS service, registers the JWKS document with the metadata cache and
+// registers its routes.
+func Initialize(
+	mux *http.ServeMux,
+	cryptoProvider kmprovider.RuntimeCryptoProvider,
+	metadataCache metadatacache.MetadataCacheServiceInterface,
+) JWKSServiceInterface {
 	// Initialize the JWKS service
 	jwksService := newJWKSService(cryptoProvider)
+	metadataCache.RegisterDocument(metadatacache.SurrogateKeyJWKS, func(_ context.Context) (any, error) {
+		jwksResponse, svcErr := jwksService.GetJWKS()
+		if svcErr != nil {
+			return nil, fmt.Errorf("failed to get JWKS: %s", svcErr.Code)
+		}
+		return jwksResponse, nil
+	})
+
 	jwksHandler := newJWKSHandler(jwksService)
-	registerRoutes(mux, jwksHandler)
+	registerRoutes(mux, jwksHandler, metadataCache)
 	return jwksService
 }
 
 // registerRoutes registers the routes for the JWKSAPIService.
-func registerRoutes(mux *http.ServeMux, jwksHandler *jwksHandler) {
+func registerRoutes(
+	mux *http.ServeMux, jwksHandler *jwksHandler, metadataCache metadatacache.MetadataCacheServiceInterface,
+) {
 	opts := middleware.CORSOptions{
 		AllowedMethods:   []string{"GET", "OPTIONS"},
 		AllowedHeaders:   middleware.DefaultAllowedHeaders,
@@ -43,7 +61,7 @@ func registerRoutes(mux *http.ServeMux, jwksHandler *jwksHandler) {
 		MaxAge:           600,
 	}
 	mux.HandleFunc(middleware.WithCORS("GET /oauth2/jwks",
-		jwksHandler.HandleJWKSRequest, opts))
+		metadataCache.Handle(metadatacache.SurrogateKeyJWKS, jwksHandler.HandleJWKSRequest), opts))
 	mux.HandleFunc(middleware.WithCORS("OPTIONS /oauth2/jwks",
 		func(w http.ResponseWriter, r *http.Request) {
 			w.WriteHeader(http.StatusNoContent)
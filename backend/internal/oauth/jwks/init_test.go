@@ -30,6 +30,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 
+	"github.com/thunder-id/thunderid/internal/oauth/metadatacache"
 	"github.com/thunder-id/thunderid/internal/system/config"
 	"github.com/thunder-id/thunderid/internal/system/cryptolab"
 	"github.com/thunder-id/thunderid/internal/system/kmprovider"
@@ -57,7 +58,7 @@ func (suite *InitTestSuite) TestInitialize() {
 	mux := http.NewServeMux()
 	cryptoMock := cryptomock.NewRuntimeCryptoProviderMock(suite.T())
 
-	service := Initialize(mux, cryptoMock)
+	service := Initialize(mux, cryptoMock, metadatacache.Initialize())
 
 	assert.NotNil(suite.T(), service)
 	assert.Implements(suite.T(), (*JWKSServiceInterface)(nil), service)
@@ -80,7 +81,7 @@ func (suite *InitTestSuite) TestInitialize_RegistersRoutes() {
 	}
 	cryptoMock.EXPECT().GetPublicKeys(mock.Anything, kmprovider.PublicKeyFilter{}).Return(keys, nil)
 
-	_ = Initialize(mux, cryptoMock)
+	_ = Initialize(mux, cryptoMock, metadatacache.Initialize())
 
 	req := httptest.NewRequest("GET", "/oauth2/jwks", nil)
 	w := httptest.NewRecorder()
@@ -25,6 +25,7 @@ import (
 	"crypto/ed25519"
 	"crypto/rsa"
 	"encoding/base64"
+	"slices"
 	"strings"
 
 	// Use crypto/sha1 only for JWKS x5t as required by spec for thumbprint.
@@ -98,6 +99,12 @@ func (s *jwksService) GetJWKS() (*JWKSResponse, *serviceerror.ServiceError) {
 		return nil, &serviceerror.InternalServerError
 	}
 
+	// Keys are returned in a stable order so that the response content, and its cache version, only
+	// changes when the keys change.
+	slices.SortFunc(jwksKeys, func(a, b JWKS) int {
+		return strings.Compare(a.Kid, b.Kid)
+	})
+
 	return &JWKSResponse{
 		Keys: jwksKeys,
 	}, nil
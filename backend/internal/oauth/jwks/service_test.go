@@ -232,6 +232,23 @@ func (suite *JWKSServiceTestSuite) TestGetJWKS_MultipleCertificates() {
 	assert.True(suite.T(), ecFound, "EC key not found in JWKS")
 }
 
+func (suite *JWKSServiceTestSuite) TestGetJWKS_SortedByKeyID() {
+	ecdsaKey1, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	ecdsaKey2, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	keys := []kmprovider.PublicKeyInfo{
+		{KeyID: "kid-b", Algorithm: cryptolab.AlgorithmES256, PublicKey: &ecdsaKey1.PublicKey, Thumbprint: "kid-b"},
+		{KeyID: "kid-a", Algorithm: cryptolab.AlgorithmES256, PublicKey: &ecdsaKey2.PublicKey, Thumbprint: "kid-a"},
+	}
+	suite.cryptoMock.EXPECT().GetPublicKeys(mock.Anything, kmprovider.PublicKeyFilter{}).
+		Return(keys, nil)
+
+	resp, svcErr := suite.jwksService.GetJWKS()
+	assert.Nil(suite.T(), svcErr)
+	assert.Len(suite.T(), resp.Keys, 2)
+	assert.Equal(suite.T(), "kid-a", resp.Keys[0].Kid)
+	assert.Equal(suite.T(), "kid-b", resp.Keys[1].Kid)
+}
+
 func (suite *JWKSServiceTestSuite) TestGetJWKS_ECDSA_AdditionalCurves() {
 	tests := []struct {
 		name  string
@@ -0,0 +1,60 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package metadatacache
+
+import "time"
+
+const loggerComponentName = "MetadataCacheService"
+
+// Surrogate keys of the cacheable metadata documents.
+const (
+	// SurrogateKeyAll is attached to every metadata document so that all of them can be purged at once.
+	SurrogateKeyAll = "oauth-metadata"
+	// SurrogateKeyJWKS identifies the JSON Web Key Set document.
+	SurrogateKeyJWKS = "jwks"
+	// SurrogateKeyAuthorizationServer identifies the OAuth 2.0 authorization server metadata document.
+	SurrogateKeyAuthorizationServer = "oauth-authorization-server"
+	// SurrogateKeyOpenIDConfiguration identifies the OpenID Connect discovery document.
+	SurrogateKeyOpenIDConfiguration = "openid-configuration"
+)
+
+// VersionQueryParam is the query parameter that pins a request to a content version of a document.
+const VersionQueryParam = "v"
+
+// Response headers understood by CDNs and shared caches.
+const (
+	headerCacheControl     = "Cache-Control"
+	headerETag             = "ETag"
+	headerIfNoneMatch      = "If-None-Match"
+	headerSurrogateKey     = "Surrogate-Key"
+	headerSurrogateControl = "Surrogate-Control"
+	headerCacheTag         = "Cache-Tag"
+)
+
+// immutableMaxAge is the max-age in seconds of a response requested with its current content version.
+const immutableMaxAge = 31536000
+
+// versionLength is the number of hex characters of the content hash used as the version.
+const versionLength = 16
+
+// purgeEventName is the event name of the purge webhook payload.
+const purgeEventName = "metadata.cache.purge"
+
+// webhookTimeout bounds the time spent delivering a purge webhook.
+const webhookTimeout = 10 * time.Second
@@ -0,0 +1,38 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package metadatacache makes the discovery and JWKS documents strongly cacheable by CDNs and shared
+// caches. Responses carry a content hash version, long max-age and surrogate keys, and a purge webhook
+// is notified whenever the content of a document changes.
+package metadatacache
+
+import (
+	"context"
+	"time"
+)
+
+// DocumentLoader loads the current content of a metadata document.
+type DocumentLoader func(ctx context.Context) (any, error)
+
+// purgeEvent is the payload posted to the purge webhook when the content of documents changes.
+type purgeEvent struct {
+	Event         string            `json:"event"`
+	Timestamp     time.Time         `json:"timestamp"`
+	SurrogateKeys []string          `json:"surrogateKeys"`
+	Versions      map[string]string `json:"versions"`
+}
@@ -0,0 +1,305 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package metadatacache
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"maps"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	serverconst "github.com/thunder-id/thunderid/internal/system/constants"
+	syshttp "github.com/thunder-id/thunderid/internal/system/http"
+	"github.com/thunder-id/thunderid/internal/system/log"
+)
+
+// ErrDocumentNotRegistered is returned when the version of an unregistered document is requested.
+var ErrDocumentNotRegistered = errors.New("metadata document is not registered")
+
+// MetadataCacheServiceInterface defines the interface for the metadata cache service.
+type MetadataCacheServiceInterface interface {
+	// RegisterDocument registers the loader of the document identified by the surrogate key.
+	RegisterDocument(surrogateKey string, loader DocumentLoader)
+	// GetVersion returns the content version of the document identified by the surrogate key.
+	GetVersion(ctx context.Context, surrogateKey string) (string, error)
+	// Handle wraps the handler of the document identified by the surrogate key with caching headers.
+	Handle(surrogateKey string, next http.HandlerFunc) http.HandlerFunc
+	// Refresh reloads every registered document and purges the documents whose content changed.
+	Refresh(ctx context.Context)
+}
+
+// metadataCacheService is the default implementation of the MetadataCacheServiceInterface.
+type metadataCacheService struct {
+	maxAge          int64
+	purgeWebhookURL string
+	httpClient      syshttp.HTTPClientInterface
+	logger          *log.Logger
+	mu              sync.Mutex
+	loaders         map[string]DocumentLoader
+	versions        map[string]string
+	// runAsync delivers a purge webhook. It runs the delivery on a new goroutine.
+	runAsync func(func())
+}
+
+// newMetadataCacheService creates a new instance of metadataCacheService.
+func newMetadataCacheService(
+	maxAge int64, purgeWebhookURL string, httpClient syshttp.HTTPClientInterface,
+) MetadataCacheServiceInterface {
+	return &metadataCacheService{
+		maxAge:          max(maxAge, 0),
+		purgeWebhookURL: purgeWebhookURL,
+		httpClient:      httpClient,
+		logger:          log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName)),
+		loaders:         make(map[string]DocumentLoader),
+		versions:        make(map[string]string),
+		runAsync:        func(f func()) { go f() },
+	}
+}
+
+// RegisterDocument registers the loader of the document identified by the surrogate key.
+func (s *metadataCacheService) RegisterDocument(surrogateKey string, loader DocumentLoader) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.loaders[surrogateKey] = loader
+}
+
+// GetVersion returns the content version of the document identified by the surrogate key. The version
+// recorded when the document was last loaded or served is returned if there is one.
+func (s *metadataCacheService) GetVersion(ctx context.Context, surrogateKey string) (string, error) {
+	s.mu.Lock()
+	version, ok := s.versions[surrogateKey]
+	loader, registered := s.loaders[surrogateKey]
+	s.mu.Unlock()
+	if ok {
+		return version, nil
+	}
+	if !registered {
+		return "", ErrDocumentNotRegistered
+	}
+
+	version, err := loadVersion(ctx, loader)
+	if err != nil {
+		return "", err
+	}
+	s.observe(surrogateKey, version)
+	return version, nil
+}
+
+// Refresh reloads every registered document and purges the documents whose content changed. It is
+// invoked on startup, which is when key rotations and configuration changes take effect.
+func (s *metadataCacheService) Refresh(ctx context.Context) {
+	s.mu.Lock()
+	loaders := maps.Clone(s.loaders)
+	s.mu.Unlock()
+
+	changed := make(map[string]string)
+	for _, surrogateKey := range slices.Sorted(maps.Keys(loaders)) {
+		version, err := loadVersion(ctx, loaders[surrogateKey])
+		if err != nil {
+			s.logger.Warn("Failed to load metadata document", log.String("surrogateKey", surrogateKey),
+				log.Error(err))
+			continue
+		}
+
+		s.mu.Lock()
+		if s.versions[surrogateKey] != version {
+			s.versions[surrogateKey] = version
+			changed[surrogateKey] = version
+		}
+		s.mu.Unlock()
+	}
+
+	s.purge(changed)
+}
+
+// Handle wraps the handler of the document identified by the surrogate key. Successful responses are
+// served with a content hash ETag, cache lifetimes and surrogate keys, and conditional requests are
+// answered with 304 Not Modified. A request whose version parameter matches the current content is
+// cacheable indefinitely.
+func (s *metadataCacheService) Handle(surrogateKey string, next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		recorder := &bufferedResponseWriter{header: make(http.Header), status: http.StatusOK}
+		next(recorder, r)
+
+		for name, values := range recorder.header {
+			w.Header()[name] = values
+		}
+		if recorder.status != http.StatusOK {
+			w.Header().Set(headerCacheControl, "no-store")
+			w.WriteHeader(recorder.status)
+			_, _ = w.Write(recorder.body.Bytes())
+			return
+		}
+
+		version := contentVersion(recorder.body.Bytes())
+		s.observe(surrogateKey, version)
+
+		etag := `"` + version + `"`
+		w.Header().Set(headerETag, etag)
+		w.Header().Set(headerCacheControl, s.cacheControl(r.URL.Query().Get(VersionQueryParam) == version))
+		w.Header().Set(headerSurrogateKey, SurrogateKeyAll+" "+surrogateKey)
+		w.Header().Set(headerCacheTag, SurrogateKeyAll+","+surrogateKey)
+		if s.maxAge > 0 {
+			w.Header().Set(headerSurrogateControl, "max-age="+strconv.FormatInt(s.maxAge, 10))
+		}
+
+		if matchesETag(r.Header.Get(headerIfNoneMatch), etag) {
+			w.Header().Del(serverconst.ContentTypeHeaderName)
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write(recorder.body.Bytes())
+	}
+}
+
+// cacheControl returns the Cache-Control header value of a successful response.
+func (s *metadataCacheService) cacheControl(versioned bool) string {
+	if versioned {
+		return "public, max-age=" + strconv.Itoa(immutableMaxAge) + ", immutable"
+	}
+	if s.maxAge == 0 {
+		return "no-cache"
+	}
+	return "public, max-age=" + strconv.FormatInt(s.maxAge, 10)
+}
+
+// observe records the version of a served or loaded document and purges the document if its content
+// changed since it was last recorded.
+func (s *metadataCacheService) observe(surrogateKey, version string) {
+	s.mu.Lock()
+	previous, ok := s.versions[surrogateKey]
+	s.versions[surrogateKey] = version
+	s.mu.Unlock()
+
+	if ok && previous != version {
+		s.purge(map[string]string{surrogateKey: version})
+	}
+}
+
+// purge notifies the purge webhook, if any, of the documents whose content changed. Delivery failures
+// are logged and the cached documents then expire on their own.
+func (s *metadataCacheService) purge(versions map[string]string) {
+	if s.purgeWebhookURL == "" || len(versions) == 0 {
+		return
+	}
+
+	payload := purgeEvent{
+		Event:         purgeEventName,
+		Timestamp:     time.Now().UTC(),
+		SurrogateKeys: slices.Sorted(maps.Keys(versions)),
+		Versions:      versions,
+	}
+	s.runAsync(func() {
+		s.notifyWebhook(payload)
+	})
+}
+
+// notifyWebhook posts a purge event to the purge webhook.
+func (s *metadataCacheService) notifyWebhook(payload purgeEvent) {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		s.logger.Error("Failed to marshal purge webhook payload", log.Error(err))
+		return
+	}
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodPost, s.purgeWebhookURL,
+		bytes.NewReader(body))
+	if err != nil {
+		s.logger.Error("Failed to create purge webhook request", log.Error(err))
+		return
+	}
+	req.Header.Set(serverconst.ContentTypeHeaderName, serverconst.ContentTypeJSON)
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		s.logger.Warn("Failed to deliver purge webhook", log.Error(err))
+		return
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		s.logger.Warn("Purge webhook returned an unexpected status", log.Int("status", resp.StatusCode))
+		return
+	}
+	s.logger.Debug("Requested purge of metadata documents",
+		log.String("surrogateKeys", strings.Join(payload.SurrogateKeys, " ")))
+}
+
+// loadVersion loads a document and returns the version of its JSON encoding. The encoding matches the
+// body written for the document by the HTTP handlers.
+func loadVersion(ctx context.Context, loader DocumentLoader) (string, error) {
+	document, err := loader(ctx)
+	if err != nil {
+		return "", err
+	}
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(document); err != nil {
+		return "", err
+	}
+	return contentVersion(buf.Bytes()), nil
+}
+
+// contentVersion returns the version of a document body, derived from its SHA-256 hash.
+func contentVersion(body []byte) string {
+	sum := sha256.Sum256(body)
+	return hex.EncodeToString(sum[:])[:versionLength]
+}
+
+// matchesETag reports whether an If-None-Match header value matches the ETag.
+func matchesETag(ifNoneMatch, etag string) bool {
+	for candidate := range strings.SplitSeq(ifNoneMatch, ",") {
+		candidate = strings.TrimPrefix(strings.TrimSpace(candidate), "W/")
+		if candidate == "*" || candidate == etag {
+			return true
+		}
+	}
+	return false
+}
+
+// bufferedResponseWriter buffers a response so that caching headers can be derived from its body.
+type bufferedResponseWriter struct {
+	header http.Header
+	status int
+	body   bytes.Buffer
+}
+
+// Header returns the header map of the buffered response.
+func (w *bufferedResponseWriter) Header() http.Header {
+	return w.header
+}
+
+// Write appends data to the buffered response body.
+func (w *bufferedResponseWriter) Write(data []byte) (int, error) {
+	return w.body.Write(data)
+}
+
+// WriteHeader records the status code of the buffered response.
+func (w *bufferedResponseWriter) WriteHeader(statusCode int) {
+	w.status = statusCode
+}
@@ -0,0 +1,209 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package metadatacache
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	sysutils "github.com/thunder-id/thunderid/internal/system/utils"
+	"github.com/thunder-id/thunderid/tests/mocks/httpmock"
+)
+
+const testWebhook = "https://cdn.example.com/purge"
+
+type testDocument struct {
+	Issuer string `json:"issuer"`
+}
+
+type MetadataCacheServiceTestSuite struct {
+	suite.Suite
+	mockHTTPClient *httpmock.HTTPClientInterfaceMock
+	service        *metadataCacheService
+	document       testDocument
+}
+
+func TestMetadataCacheServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(MetadataCacheServiceTestSuite))
+}
+
+func (suite *MetadataCacheServiceTestSuite) SetupTest() {
+	suite.mockHTTPClient = httpmock.NewHTTPClientInterfaceMock(suite.T())
+	suite.service = newMetadataCacheService(3600, testWebhook, suite.mockHTTPClient).(*metadataCacheService)
+	suite.service.runAsync = func(f func()) { f() }
+	suite.document = testDocument{Issuer: "https://auth.example.com"}
+	suite.service.RegisterDocument(SurrogateKeyJWKS, func(_ context.Context) (any, error) {
+		return suite.document, nil
+	})
+}
+
+func (suite *MetadataCacheServiceTestSuite) handler() http.HandlerFunc {
+	return suite.service.Handle(SurrogateKeyJWKS, func(w http.ResponseWriter, _ *http.Request) {
+		sysutils.WriteSuccessResponse(w, http.StatusOK, suite.document)
+	})
+}
+
+// expectPurge expects a purge webhook for the given surrogate keys and returns the captured payload.
+func (suite *MetadataCacheServiceTestSuite) expectPurge(surrogateKeys ...string) *purgeEvent {
+	captured := &purgeEvent{}
+	suite.mockHTTPClient.On("Do", mock.MatchedBy(func(req *http.Request) bool {
+		return req.Method == http.MethodPost && req.URL.String() == testWebhook
+	})).Run(func(args mock.Arguments) {
+		body, _ := io.ReadAll(args.Get(0).(*http.Request).Body)
+		suite.Require().NoError(json.Unmarshal(body, captured))
+		suite.Equal(surrogateKeys, captured.SurrogateKeys)
+	}).Return(&http.Response{StatusCode: http.StatusNoContent, Body: io.NopCloser(strings.NewReader(""))}, nil).
+		Once()
+	return captured
+}
+
+func (suite *MetadataCacheServiceTestSuite) TestHandle_SetsCachingHeaders() {
+	rr := httptest.NewRecorder()
+	suite.handler()(rr, httptest.NewRequest(http.MethodGet, "/oauth2/jwks", nil))
+
+	suite.Equal(http.StatusOK, rr.Code)
+	version, err := suite.service.GetVersion(context.Background(), SurrogateKeyJWKS)
+	suite.Require().NoError(err)
+	suite.Len(version, versionLength)
+	suite.Equal(`"`+version+`"`, rr.Header().Get(headerETag))
+	suite.Equal("public, max-age=3600", rr.Header().Get(headerCacheControl))
+	suite.Equal("max-age=3600", rr.Header().Get(headerSurrogateControl))
+	suite.Equal("oauth-metadata jwks", rr.Header().Get(headerSurrogateKey))
+	suite.Equal("application/json", rr.Header().Get("Content-Type"))
+	suite.JSONEq(`{"issuer":"https://auth.example.com"}`, rr.Body.String())
+}
+
+func (suite *MetadataCacheServiceTestSuite) TestHandle_VersionedRequestIsImmutable() {
+	version, err := suite.service.GetVersion(context.Background(), SurrogateKeyJWKS)
+	suite.Require().NoError(err)
+
+	rr := httptest.NewRecorder()
+	suite.handler()(rr, httptest.NewRequest(http.MethodGet, "/oauth2/jwks?v="+version, nil))
+
+	suite.Equal(http.StatusOK, rr.Code)
+	suite.Equal("public, max-age=31536000, immutable", rr.Header().Get(headerCacheControl))
+
+	rr = httptest.NewRecorder()
+	suite.handler()(rr, httptest.NewRequest(http.MethodGet, "/oauth2/jwks?v=stale", nil))
+
+	suite.Equal("public, max-age=3600", rr.Header().Get(headerCacheControl))
+}
+
+func (suite *MetadataCacheServiceTestSuite) TestHandle_NotModified() {
+	version, err := suite.service.GetVersion(context.Background(), SurrogateKeyJWKS)
+	suite.Require().NoError(err)
+
+	req := httptest.NewRequest(http.MethodGet, "/oauth2/jwks", nil)
+	req.Header.Set(headerIfNoneMatch, `W/"other", "`+version+`"`)
+	rr := httptest.NewRecorder()
+	suite.handler()(rr, req)
+
+	suite.Equal(http.StatusNotModified, rr.Code)
+	suite.Empty(rr.Body.String())
+	suite.Equal(`"`+version+`"`, rr.Header().Get(headerETag))
+}
+
+func (suite *MetadataCacheServiceTestSuite) TestHandle_ErrorResponseIsNotCached() {
+	handler := suite.service.Handle(SurrogateKeyJWKS, func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+		_, _ = w.Write([]byte("{}"))
+	})
+
+	rr := httptest.NewRecorder()
+	handler(rr, httptest.NewRequest(http.MethodGet, "/oauth2/jwks", nil))
+
+	suite.Equal(http.StatusInternalServerError, rr.Code)
+	suite.Equal("no-store", rr.Header().Get(headerCacheControl))
+	suite.Empty(rr.Header().Get(headerETag))
+	suite.Equal("{}", rr.Body.String())
+}
+
+func (suite *MetadataCacheServiceTestSuite) TestHandle_NoMaxAge() {
+	suite.service.maxAge = 0
+
+	rr := httptest.NewRecorder()
+	suite.handler()(rr, httptest.NewRequest(http.MethodGet, "/oauth2/jwks", nil))
+
+	suite.Equal("no-cache", rr.Header().Get(headerCacheControl))
+	suite.Empty(rr.Header().Get(headerSurrogateControl))
+}
+
+func (suite *MetadataCacheServiceTestSuite) TestHandle_PurgesChangedContent() {
+	suite.handler()(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/oauth2/jwks", nil))
+
+	suite.document.Issuer = "https://rotated.example.com"
+	captured := suite.expectPurge(SurrogateKeyJWKS)
+	rr := httptest.NewRecorder()
+	suite.handler()(rr, httptest.NewRequest(http.MethodGet, "/oauth2/jwks", nil))
+
+	suite.Equal(purgeEventName, captured.Event)
+	suite.Equal(`"`+captured.Versions[SurrogateKeyJWKS]+`"`, rr.Header().Get(headerETag))
+}
+
+func (suite *MetadataCacheServiceTestSuite) TestRefresh_PurgesNewAndChangedDocuments() {
+	suite.service.RegisterDocument(SurrogateKeyOpenIDConfiguration, func(_ context.Context) (any, error) {
+		return testDocument{Issuer: "https://auth.example.com/oidc"}, nil
+	})
+	suite.service.RegisterDocument(SurrogateKeyAuthorizationServer, func(_ context.Context) (any, error) {
+		return nil, errors.New("load failed")
+	})
+
+	suite.expectPurge(SurrogateKeyJWKS, SurrogateKeyOpenIDConfiguration)
+	suite.service.Refresh(context.Background())
+
+	// Nothing changed, so no purge is requested.
+	suite.service.Refresh(context.Background())
+
+	suite.document.Issuer = "https://rotated.example.com"
+	suite.expectPurge(SurrogateKeyJWKS)
+	suite.service.Refresh(context.Background())
+}
+
+func (suite *MetadataCacheServiceTestSuite) TestRefresh_WithoutWebhook() {
+	suite.service.purgeWebhookURL = ""
+
+	suite.service.Refresh(context.Background())
+
+	suite.mockHTTPClient.AssertNotCalled(suite.T(), "Do", mock.Anything)
+}
+
+func (suite *MetadataCacheServiceTestSuite) TestGetVersion_NotRegistered() {
+	_, err := suite.service.GetVersion(context.Background(), SurrogateKeyOpenIDConfiguration)
+
+	suite.ErrorIs(err, ErrDocumentNotRegistered)
+}
+
+func (suite *MetadataCacheServiceTestSuite) TestGetVersion_MatchesServedContent() {
+	version, err := suite.service.GetVersion(context.Background(), SurrogateKeyJWKS)
+	suite.Require().NoError(err)
+
+	rr := httptest.NewRecorder()
+	suite.handler()(rr, httptest.NewRequest(http.MethodGet, "/oauth2/jwks", nil))
+
+	suite.Equal(version, contentVersion(rr.Body.Bytes()))
+}
@@ -8,6 +8,7 @@ import (
 	"context"
 
 	mock "github.com/stretchr/testify/mock"
+	oauth2model "github.com/thunder-id/thunderid/internal/oauth/oauth2/model"
 )
 
 // NewAuthorizeServiceInterfaceMock creates a new instance of AuthorizeServiceInterfaceMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
@@ -254,3 +255,119 @@ func (_c *AuthorizeServiceInterfaceMock_HandleInitialAuthorizationRequest_Call)
 	_c.Call.Return(run)
 	return _c
 }
+
+// InitiateDeviceVerification provides a mock function for the type AuthorizeServiceInterfaceMock
+func (_mock *AuthorizeServiceInterfaceMock) InitiateDeviceVerification(ctx context.Context, oauthParams *oauth2model.OAuthParameters, userCode string) (*AuthorizationInitResult, *AuthorizationError) {
+	ret := _mock.Called(ctx, oauthParams, userCode)
+
+	if len(ret) == 0 {
+		panic("no return value specified for InitiateDeviceVerification")
+	}
+
+	var r0 *AuthorizationInitResult
+	var r1 *AuthorizationError
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *oauth2model.OAuthParameters, string) (*AuthorizationInitResult, *AuthorizationError)); ok {
+		return returnFunc(ctx, oauthParams, userCode)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *oauth2model.OAuthParameters, string) *AuthorizationInitResult); ok {
+		r0 = returnFunc(ctx, oauthParams, userCode)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*AuthorizationInitResult)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *oauth2model.OAuthParameters, string) *AuthorizationError); ok {
+		r1 = returnFunc(ctx, oauthParams, userCode)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*AuthorizationError)
+		}
+	}
+	return r0, r1
+}
+
+// AuthorizeServiceInterfaceMock_InitiateDeviceVerification_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InitiateDeviceVerification'
+type AuthorizeServiceInterfaceMock_InitiateDeviceVerification_Call struct {
+	*mock.Call
+}
+
+// InitiateDeviceVerification is a helper method to define mock.On call
+//   - ctx context.Context
+//   - oauthParams *oauth2model.OAuthParameters
+//   - userCode string
+func (_e *AuthorizeServiceInterfaceMock_Expecter) InitiateDeviceVerification(ctx interface{}, oauthParams interface{}, userCode interface{}) *AuthorizeServiceInterfaceMock_InitiateDeviceVerification_Call {
+	return &AuthorizeServiceInterfaceMock_InitiateDeviceVerification_Call{Call: _e.mock.On("InitiateDeviceVerification", ctx, oauthParams, userCode)}
+}
+
+func (_c *AuthorizeServiceInterfaceMock_InitiateDeviceVerification_Call) Run(run func(ctx context.Context, oauthParams *oauth2model.OAuthParameters, userCode string)) *AuthorizeServiceInterfaceMock_InitiateDeviceVerification_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *oauth2model.OAuthParameters
+		if args[1] != nil {
+			arg1 = args[1].(*oauth2model.OAuthParameters)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *AuthorizeServiceInterfaceMock_InitiateDeviceVerification_Call) Return(authorizationInitResult *AuthorizationInitResult, authorizationError *AuthorizationError) *AuthorizeServiceInterfaceMock_InitiateDeviceVerification_Call {
+	_c.Call.Return(authorizationInitResult, authorizationError)
+	return _c
+}
+
+func (_c *AuthorizeServiceInterfaceMock_InitiateDeviceVerification_Call) RunAndReturn(run func(ctx context.Context, oauthParams *oauth2model.OAuthParameters, userCode string) (*AuthorizationInitResult, *AuthorizationError)) *AuthorizeServiceInterfaceMock_InitiateDeviceVerification_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetDeviceAuthorizationApprover provides a mock function for the type AuthorizeServiceInterfaceMock
+func (_mock *AuthorizeServiceInterfaceMock) SetDeviceAuthorizationApprover(approver DeviceAuthorizationApproverInterface) {
+	_mock.Called(approver)
+	return
+}
+
+// AuthorizeServiceInterfaceMock_SetDeviceAuthorizationApprover_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetDeviceAuthorizationApprover'
+type AuthorizeServiceInterfaceMock_SetDeviceAuthorizationApprover_Call struct {
+	*mock.Call
+}
+
+// SetDeviceAuthorizationApprover is a helper method to define mock.On call
+//   - approver DeviceAuthorizationApproverInterface
+func (_e *AuthorizeServiceInterfaceMock_Expecter) SetDeviceAuthorizationApprover(approver interface{}) *AuthorizeServiceInterfaceMock_SetDeviceAuthorizationApprover_Call {
+	return &AuthorizeServiceInterfaceMock_SetDeviceAuthorizationApprover_Call{Call: _e.mock.On("SetDeviceAuthorizationApprover", approver)}
+}
+
+func (_c *AuthorizeServiceInterfaceMock_SetDeviceAuthorizationApprover_Call) Run(run func(approver DeviceAuthorizationApproverInterface)) *AuthorizeServiceInterfaceMock_SetDeviceAuthorizationApprover_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 DeviceAuthorizationApproverInterface
+		if args[0] != nil {
+			arg0 = args[0].(DeviceAuthorizationApproverInterface)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *AuthorizeServiceInterfaceMock_SetDeviceAuthorizationApprover_Call) Return() *AuthorizeServiceInterfaceMock_SetDeviceAuthorizationApprover_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *AuthorizeServiceInterfaceMock_SetDeviceAuthorizationApprover_Call) RunAndReturn(run func(approver DeviceAuthorizationApproverInterface)) *AuthorizeServiceInterfaceMock_SetDeviceAuthorizationApprover_Call {
+	_c.Run(run)
+	return _c
+}
@@ -35,6 +35,9 @@ import (
 // authRequestContext holds OAuth authorization request information.
 type authRequestContext struct {
 	OAuthParameters model.OAuthParameters
+	// DeviceUserCode is the user code of the device authorization request the user is verifying. It is
+	// empty for requests of the authorization endpoint.
+	DeviceUserCode string `json:",omitempty"`
 }
 
 // authorizationRequestStoreInterface defines the interface for authorization request storage.
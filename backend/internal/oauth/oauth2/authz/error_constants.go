@@ -29,3 +29,7 @@ var errAuthorizationCodeAlreadyConsumed = errors.New("authorization code already
 
 // errAuthRequestNotFound is returned when an authorization request context is not found in the store.
 var errAuthRequestNotFound = errors.New("authorization request context not found")
+
+// ErrDeviceAuthorizationNotFound is returned by a device authorization approver when the user code is unknown,
+// expired or already used.
+var ErrDeviceAuthorizationNotFound = errors.New("device authorization request not found")
@@ -24,7 +24,10 @@ import (
 
 	"github.com/thunder-id/thunderid/internal/flow/flowexec"
 	"github.com/thunder-id/thunderid/internal/inboundclient"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/clientusage"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/par"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/protocoltrace"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/tokenstore"
 	"github.com/thunder-id/thunderid/internal/resource"
 	"github.com/thunder-id/thunderid/internal/system/config"
 	"github.com/thunder-id/thunderid/internal/system/constants"
@@ -42,6 +45,8 @@ func Initialize(
 	jwtService jwt.JWTServiceInterface,
 	flowExecService flowexec.FlowExecServiceInterface,
 	parService par.PARServiceInterface,
+	protocolTrace protocoltrace.ProtocolTraceServiceInterface,
+	clientUsage clientusage.ClientUsageServiceInterface,
 ) (AuthorizeServiceInterface, error) {
 	authzCodeStore, authzReqStore, transactioner, err := initializeAuthorizationStores()
 	if err != nil {
@@ -50,7 +55,7 @@ func Initialize(
 
 	authzService := newAuthorizeService(
 		inboundClient, resourceService, jwtService, flowExecService,
-		authzCodeStore, authzReqStore, parService, transactioner,
+		authzCodeStore, authzReqStore, parService, transactioner, protocolTrace, clientUsage,
 	)
 	authzHandler := newAuthorizeHandler(authzService)
 	registerRoutes(mux, authzHandler)
@@ -58,12 +63,20 @@ func Initialize(
 }
 
 // initializeAuthorizationStores creates the authorization code store, request store, and transactioner.
+// The authorization code store follows the configured token store type, while the request store and
+// transactioner follow the runtime database type.
 func initializeAuthorizationStores() (
 	AuthorizationCodeStoreInterface, authorizationRequestStoreInterface, transaction.Transactioner, error) {
+	var authCodeStore AuthorizationCodeStoreInterface
+	if tokenstore.ResolveStoreType(tokenstore.ArtifactTypeAuthorizationCode) == tokenstore.StoreTypeRedis {
+		authCodeStore = newRedisAuthorizationCodeStore(provider.GetRedisProvider())
+	} else {
+		authCodeStore = newAuthorizationCodeStore()
+	}
+
 	if config.GetServerRuntime().Config.Database.Runtime.Type == provider.DataSourceTypeRedis {
-		redisProvider := provider.GetRedisProvider()
-		return newRedisAuthorizationCodeStore(redisProvider),
-			newRedisAuthorizationRequestStore(redisProvider),
+		return authCodeStore,
+			newRedisAuthorizationRequestStore(provider.GetRedisProvider()),
 			transaction.NewNoOpTransactioner(),
 			nil
 	}
@@ -72,7 +85,7 @@ func initializeAuthorizationStores() (
 	if err != nil {
 		return nil, nil, nil, err
 	}
-	return newAuthorizationCodeStore(), newAuthorizationRequestStore(), transactioner, nil
+	return authCodeStore, newAuthorizationRequestStore(), transactioner, nil
 }
 
 // registerRoutes registers the routes for OAuth2 authorization operations.
@@ -79,6 +79,16 @@ type AuthorizationError struct {
 	State             string // from the original request
 }
 
+// DeviceAuthorizationGrant holds the outcome of the user authentication of a device authorization request.
+type DeviceAuthorizationGrant struct {
+	UserCode         string
+	AuthorizedUserID string
+	AttributeCacheID string
+	PermissionScopes []string
+	CompletedACR     string
+	AuthTime         time.Time
+}
+
 // assertionClaims represents the claims extracted from the flow assertion JWT.
 type assertionClaims struct {
 	userID                string
@@ -0,0 +1,181 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package authz
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+
+	inboundmodel "github.com/thunder-id/thunderid/internal/inboundclient/model"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/clientauth"
+	oauth2const "github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/jose/jwt"
+	"github.com/thunder-id/thunderid/internal/system/log"
+)
+
+// requestObjectRegisteredClaims lists the JWT claims of a request object that are not authorization
+// request parameters.
+var requestObjectRegisteredClaims = []string{"iss", "aud", "exp", "iat", "nbf", "jti", "sub"}
+
+// resolveRequestObject verifies the signed request object (RFC 9101) of an authorization request and returns
+// the request with the parameters of the request object merged in. Parameters of the request object take
+// precedence over the query parameters, while client_id and response_type must match when sent in both.
+func (as *authorizeService) resolveRequestObject(
+	ctx context.Context, msg *OAuthMessage, app *inboundmodel.OAuthClient,
+) (*OAuthMessage, *AuthorizationError) {
+	requestObject := msg.RequestQueryParams[oauth2const.RequestParamRequest]
+
+	if err := as.verifyRequestObject(ctx, requestObject, app); err != nil {
+		as.logger.Debug("Failed to verify request object", log.String("client_id", app.ClientID),
+			log.Error(err))
+		return nil, invalidRequestObjectError("The request object is invalid")
+	}
+
+	claims, err := jwt.DecodeJWTPayload(requestObject)
+	if err != nil {
+		return nil, invalidRequestObjectError("The request object is invalid")
+	}
+	if _, ok := claims[oauth2const.RequestParamRequest]; ok {
+		return nil, invalidRequestObjectError("The request object must not contain the request parameter")
+	}
+	if _, ok := claims[oauth2const.RequestParamRequestURI]; ok {
+		return nil, invalidRequestObjectError("The request object must not contain the request_uri parameter")
+	}
+
+	params := maps.Clone(msg.RequestQueryParams)
+	delete(params, oauth2const.RequestParamRequest)
+	resources := msg.Resources
+
+	for name, value := range claims {
+		if slices.Contains(requestObjectRegisteredClaims, name) {
+			continue
+		}
+		if name == oauth2const.RequestParamResource {
+			resourceValues, ok := requestObjectResources(value)
+			if !ok {
+				return nil, invalidRequestObjectError("The resource parameter of the request object is invalid")
+			}
+			resources = resourceValues
+			if len(resources) > 0 {
+				params[name] = resources[0]
+			}
+			continue
+		}
+
+		paramValue, ok := requestObjectParam(value)
+		if !ok {
+			return nil, invalidRequestObjectError(
+				fmt.Sprintf("The %s parameter of the request object is invalid", name))
+		}
+		if name == oauth2const.RequestParamClientID || name == oauth2const.RequestParamResponseType {
+			if queryValue, exists := params[name]; exists && queryValue != paramValue {
+				return nil, invalidRequestObjectError(
+					fmt.Sprintf("The %s parameter does not match the request object", name))
+			}
+		}
+		params[name] = paramValue
+	}
+
+	return &OAuthMessage{
+		RequestType:        msg.RequestType,
+		AuthID:             msg.AuthID,
+		RequestQueryParams: params,
+		Resources:          resources,
+		RequestBodyParams:  msg.RequestBodyParams,
+	}, nil
+}
+
+// verifyRequestObject verifies the signature of the request object with the certificate of the client, and
+// checks that it is issued by the client to this server with the signing algorithm registered for the client.
+func (as *authorizeService) verifyRequestObject(
+	ctx context.Context, requestObject string, app *inboundmodel.OAuthClient,
+) error {
+	header, err := jwt.DecodeJWTHeader(requestObject)
+	if err != nil {
+		return fmt.Errorf("failed to decode request object header: %w", err)
+	}
+	alg, _ := header["alg"].(string)
+	if !slices.Contains(inboundmodel.SupportedRequestObjectSigningAlgs, alg) {
+		return fmt.Errorf("unsupported request object signing algorithm: %q", alg)
+	}
+	if app.RequestObjectSigningAlg != "" && alg != app.RequestObjectSigningAlg {
+		return fmt.Errorf("request object is not signed with the registered algorithm %s",
+			app.RequestObjectSigningAlg)
+	}
+
+	issuer := config.GetServerRuntime().Config.JWT.Issuer
+	return clientauth.VerifyClientSignedJWT(ctx, app, as.jwtService, requestObject, issuer, app.ClientID)
+}
+
+// requestObjectParam converts a request object claim to the string form of the authorization request
+// parameter. Objects, such as the claims parameter, are kept as JSON.
+func requestObjectParam(value any) (string, bool) {
+	switch v := value.(type) {
+	case string:
+		return v, true
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), true
+	case bool:
+		return strconv.FormatBool(v), true
+	case map[string]any:
+		data, err := json.Marshal(v)
+		if err != nil {
+			return "", false
+		}
+		return string(data), true
+	default:
+		return "", false
+	}
+}
+
+// requestObjectResources converts the resource claim of a request object, a single URI or an array of URIs,
+// to the requested resources.
+func requestObjectResources(value any) ([]string, bool) {
+	switch v := value.(type) {
+	case string:
+		return []string{v}, true
+	case []any:
+		resources := make([]string, 0, len(v))
+		for _, item := range v {
+			resource, ok := item.(string)
+			if !ok || strings.TrimSpace(resource) == "" {
+				return nil, false
+			}
+			resources = append(resources, resource)
+		}
+		return resources, true
+	default:
+		return nil, false
+	}
+}
+
+// invalidRequestObjectError builds the invalid_request_object error. The redirect URI cannot be trusted
+// before the request object is verified, so the error is shown on the error page instead.
+func invalidRequestObjectError(message string) *AuthorizationError {
+	return &AuthorizationError{
+		Code:    oauth2const.ErrorInvalidRequestObject,
+		Message: message,
+	}
+}
@@ -0,0 +1,214 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package authz
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/thunder-id/thunderid/internal/cert"
+	inboundmodel "github.com/thunder-id/thunderid/internal/inboundclient/model"
+	oauth2const "github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
+	oauth2model "github.com/thunder-id/thunderid/internal/oauth/oauth2/model"
+)
+
+const testRequestObjectJWKSURI = "https://client.example.com/jwks"
+
+// buildRequestObject builds an unsigned request object with the given header algorithm and claims. The
+// signature is verified by the mocked JWT service.
+func buildRequestObject(alg string, claims map[string]any) string {
+	header, _ := json.Marshal(map[string]any{"alg": alg, "kid": "client-key"})
+	payload, _ := json.Marshal(claims)
+	return base64.RawURLEncoding.EncodeToString(header) + "." +
+		base64.RawURLEncoding.EncodeToString(payload) + ".signature"
+}
+
+// requestObjectApp returns an OAuthClient with a JWKS URI to verify request objects with.
+func (suite *AuthorizeServiceTestSuite) requestObjectApp() *inboundmodel.OAuthClient {
+	app := suite.testApp()
+	app.Certificate = &inboundmodel.Certificate{Type: cert.CertificateTypeJWKSURI, Value: testRequestObjectJWKSURI}
+	return app
+}
+
+func (suite *AuthorizeServiceTestSuite) requestObjectMsg(requestObject string) *OAuthMessage {
+	return &OAuthMessage{
+		RequestType: oauth2const.TypeInitialAuthorizationRequest,
+		RequestQueryParams: map[string]string{
+			"client_id":     "test-client-id",
+			"response_type": "code",
+			"scope":         "read",
+			"request":       requestObject,
+		},
+	}
+}
+
+func (suite *AuthorizeServiceTestSuite) TestHandleInitialAuthorizationRequest_RequestObject() {
+	app := suite.requestObjectApp()
+	requestObject := buildRequestObject("RS256", map[string]any{
+		"iss":           "test-client-id",
+		"aud":           "https://localhost:8090",
+		"exp":           float64(4102444800),
+		"client_id":     "test-client-id",
+		"response_type": "code",
+		"redirect_uri":  "https://client.example.com/callback",
+		"scope":         "openid read",
+		"state":         "object-state",
+		"max_age":       float64(300),
+		"claims":        map[string]any{"id_token": map[string]any{"email": nil}},
+	})
+	suite.mockInboundClient.EXPECT().GetOAuthClientByClientID(mock.Anything, "test-client-id").Return(app, nil)
+	suite.mockJWTService.EXPECT().VerifyJWTWithJWKS(mock.Anything, requestObject, testRequestObjectJWKSURI,
+		"https://localhost:8090", "test-client-id").Return(nil)
+	suite.mockValidator.On("validateInitialAuthorizationRequest", mock.MatchedBy(func(msg *OAuthMessage) bool {
+		_, hasRequest := msg.RequestQueryParams["request"]
+		return !hasRequest && msg.RequestQueryParams["scope"] == "openid read" &&
+			msg.RequestQueryParams["state"] == "object-state" && msg.RequestQueryParams["max_age"] == "300"
+	}), app).Return(false, "", "")
+	suite.mockFlowExecService.EXPECT().InitiateFlow(mock.Anything, mock.Anything).Return("test-flow-id", nil)
+	var stored authRequestContext
+	suite.mockAuthReqStore.EXPECT().AddRequest(mock.Anything, mock.Anything).
+		RunAndReturn(func(_ context.Context, value authRequestContext) (string, error) {
+			stored = value
+			return testAuthID, nil
+		})
+
+	svc := suite.newService()
+	result, authErr := svc.HandleInitialAuthorizationRequest(context.Background(),
+		suite.requestObjectMsg(requestObject))
+
+	assert.Nil(suite.T(), authErr)
+	assert.Equal(suite.T(), testAuthID, result.QueryParams[oauth2const.AuthID])
+	assert.Equal(suite.T(), "object-state", stored.OAuthParameters.State)
+	assert.Equal(suite.T(), []string{"openid"}, stored.OAuthParameters.StandardScopes)
+	assert.IsType(suite.T(), &oauth2model.ClaimsRequest{}, stored.OAuthParameters.ClaimsRequest)
+}
+
+func (suite *AuthorizeServiceTestSuite) TestHandleInitialAuthorizationRequest_RequestObjectClientIDMismatch() {
+	app := suite.requestObjectApp()
+	requestObject := buildRequestObject("RS256", map[string]any{
+		"iss":       "test-client-id",
+		"client_id": "other-client-id",
+	})
+	suite.mockInboundClient.EXPECT().GetOAuthClientByClientID(mock.Anything, "test-client-id").Return(app, nil)
+	suite.mockJWTService.EXPECT().VerifyJWTWithJWKS(mock.Anything, requestObject, testRequestObjectJWKSURI,
+		"https://localhost:8090", "test-client-id").Return(nil)
+
+	svc := suite.newService()
+	result, authErr := svc.HandleInitialAuthorizationRequest(context.Background(),
+		suite.requestObjectMsg(requestObject))
+
+	assert.Nil(suite.T(), result)
+	assert.Equal(suite.T(), oauth2const.ErrorInvalidRequestObject, authErr.Code)
+	assert.False(suite.T(), authErr.SendErrorToClient)
+}
+
+func (suite *AuthorizeServiceTestSuite) TestHandleInitialAuthorizationRequest_RequestObjectResponseTypeMismatch() {
+	app := suite.requestObjectApp()
+	requestObject := buildRequestObject("RS256", map[string]any{"response_type": "token"})
+	suite.mockInboundClient.EXPECT().GetOAuthClientByClientID(mock.Anything, "test-client-id").Return(app, nil)
+	suite.mockJWTService.EXPECT().VerifyJWTWithJWKS(mock.Anything, mock.Anything, mock.Anything, mock.Anything,
+		mock.Anything).Return(nil)
+
+	svc := suite.newService()
+	_, authErr := svc.HandleInitialAuthorizationRequest(context.Background(), suite.requestObjectMsg(requestObject))
+
+	assert.Equal(suite.T(), oauth2const.ErrorInvalidRequestObject, authErr.Code)
+}
+
+func (suite *AuthorizeServiceTestSuite) TestHandleInitialAuthorizationRequest_RequestObjectAlgMismatch() {
+	app := suite.requestObjectApp()
+	app.RequestObjectSigningAlg = "PS256"
+	requestObject := buildRequestObject("RS256", map[string]any{"iss": "test-client-id"})
+	suite.mockInboundClient.EXPECT().GetOAuthClientByClientID(mock.Anything, "test-client-id").Return(app, nil)
+
+	svc := suite.newService()
+	_, authErr := svc.HandleInitialAuthorizationRequest(context.Background(), suite.requestObjectMsg(requestObject))
+
+	assert.Equal(suite.T(), oauth2const.ErrorInvalidRequestObject, authErr.Code)
+}
+
+func (suite *AuthorizeServiceTestSuite) TestHandleInitialAuthorizationRequest_RequestObjectUnsigned() {
+	app := suite.requestObjectApp()
+	requestObject := buildRequestObject("none", map[string]any{"iss": "test-client-id"})
+	suite.mockInboundClient.EXPECT().GetOAuthClientByClientID(mock.Anything, "test-client-id").Return(app, nil)
+
+	svc := suite.newService()
+	_, authErr := svc.HandleInitialAuthorizationRequest(context.Background(), suite.requestObjectMsg(requestObject))
+
+	assert.Equal(suite.T(), oauth2const.ErrorInvalidRequestObject, authErr.Code)
+}
+
+func (suite *AuthorizeServiceTestSuite) TestHandleInitialAuthorizationRequest_RequestObjectWithoutCertificate() {
+	app := suite.testApp()
+	requestObject := buildRequestObject("RS256", map[string]any{"iss": "test-client-id"})
+	suite.mockInboundClient.EXPECT().GetOAuthClientByClientID(mock.Anything, "test-client-id").Return(app, nil)
+
+	svc := suite.newService()
+	_, authErr := svc.HandleInitialAuthorizationRequest(context.Background(), suite.requestObjectMsg(requestObject))
+
+	assert.Equal(suite.T(), oauth2const.ErrorInvalidRequestObject, authErr.Code)
+}
+
+func (suite *AuthorizeServiceTestSuite) TestHandleInitialAuthorizationRequest_RequestObjectNested() {
+	app := suite.requestObjectApp()
+	requestObject := buildRequestObject("RS256", map[string]any{"request_uri": "urn:example:request"})
+	suite.mockInboundClient.EXPECT().GetOAuthClientByClientID(mock.Anything, "test-client-id").Return(app, nil)
+	suite.mockJWTService.EXPECT().VerifyJWTWithJWKS(mock.Anything, mock.Anything, mock.Anything, mock.Anything,
+		mock.Anything).Return(nil)
+
+	svc := suite.newService()
+	_, authErr := svc.HandleInitialAuthorizationRequest(context.Background(), suite.requestObjectMsg(requestObject))
+
+	assert.Equal(suite.T(), oauth2const.ErrorInvalidRequestObject, authErr.Code)
+}
+
+func (suite *AuthorizeServiceTestSuite) TestHandleInitialAuthorizationRequest_RequestObjectWithRequestURI() {
+	app := suite.requestObjectApp()
+	msg := suite.requestObjectMsg(buildRequestObject("RS256", map[string]any{}))
+	msg.RequestQueryParams["request_uri"] = "urn:ietf:params:oauth:request_uri:abc"
+	suite.mockInboundClient.EXPECT().GetOAuthClientByClientID(mock.Anything, "test-client-id").Return(app, nil)
+
+	svc := suite.newService()
+	_, authErr := svc.HandleInitialAuthorizationRequest(context.Background(), msg)
+
+	assert.Equal(suite.T(), oauth2const.ErrorInvalidRequest, authErr.Code)
+}
+
+func (suite *AuthorizeServiceTestSuite) TestResolveRequestObject_Resources() {
+	app := suite.requestObjectApp()
+	requestObject := buildRequestObject("RS256", map[string]any{
+		"resource": []any{"https://api.example.com", "https://other.example.com"},
+	})
+	suite.mockJWTService.EXPECT().VerifyJWTWithJWKS(mock.Anything, mock.Anything, mock.Anything, mock.Anything,
+		mock.Anything).Return(nil)
+	msg := suite.requestObjectMsg(requestObject)
+	msg.Resources = []string{"https://query.example.com"}
+
+	svc := suite.newService()
+	resolved, authErr := svc.resolveRequestObject(context.Background(), msg, app)
+
+	assert.Nil(suite.T(), authErr)
+	assert.Equal(suite.T(), []string{"https://api.example.com", "https://other.example.com"}, resolved.Resources)
+	assert.Equal(suite.T(), "read", resolved.RequestQueryParams["scope"])
+	assert.Contains(suite.T(), msg.RequestQueryParams, "request")
+}
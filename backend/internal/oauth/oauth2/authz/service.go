@@ -33,10 +33,13 @@ import (
 	"github.com/thunder-id/thunderid/internal/inboundclient"
 	inboundmodel "github.com/thunder-id/thunderid/internal/inboundclient/model"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/authz/requestvalidator"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/clientusage"
 	oauth2const "github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
 	oauth2model "github.com/thunder-id/thunderid/internal/oauth/oauth2/model"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/par"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/protocoltrace"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/resourceindicators"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/tokencustomizer"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/tokenservice"
 	oauth2utils "github.com/thunder-id/thunderid/internal/oauth/oauth2/utils"
 	"github.com/thunder-id/thunderid/internal/resource"
@@ -54,6 +57,17 @@ type AuthorizeServiceInterface interface {
 		ctx context.Context, msg *OAuthMessage,
 	) (*AuthorizationInitResult, *AuthorizationError)
 	HandleAuthorizationCallback(ctx context.Context, authID string, assertion string) (string, *AuthorizationError)
+	InitiateDeviceVerification(
+		ctx context.Context, oauthParams *oauth2model.OAuthParameters, userCode string,
+	) (*AuthorizationInitResult, *AuthorizationError)
+	SetDeviceAuthorizationApprover(approver DeviceAuthorizationApproverInterface)
+}
+
+// DeviceAuthorizationApproverInterface records the approval of a device authorization request once the user
+// verifying its user code completes authentication.
+type DeviceAuthorizationApproverInterface interface {
+	// ApproveDeviceAuthorization approves the request and returns the page the user agent is sent to.
+	ApproveDeviceAuthorization(ctx context.Context, grant DeviceAuthorizationGrant) (string, error)
 }
 
 // authorizeService implements the AuthorizeService for managing OAuth2 authorization flows.
@@ -67,6 +81,9 @@ type authorizeService struct {
 	jwtService      jwt.JWTServiceInterface
 	flowExecService flowexec.FlowExecServiceInterface
 	transactioner   transaction.Transactioner
+	protocolTrace   protocoltrace.ProtocolTraceServiceInterface
+	clientUsage     clientusage.ClientUsageServiceInterface
+	deviceApprover  DeviceAuthorizationApproverInterface
 	logger          *log.Logger
 }
 
@@ -80,6 +97,8 @@ func newAuthorizeService(
 	authReqStore authorizationRequestStoreInterface,
 	parService par.PARServiceInterface,
 	transactioner transaction.Transactioner,
+	protocolTrace protocoltrace.ProtocolTraceServiceInterface,
+	clientUsage clientusage.ClientUsageServiceInterface,
 ) AuthorizeServiceInterface {
 	return &authorizeService{
 		inboundClient:   inboundClient,
@@ -91,6 +110,8 @@ func newAuthorizeService(
 		jwtService:      jwtService,
 		flowExecService: flowExecService,
 		transactioner:   transactioner,
+		protocolTrace:   protocolTrace,
+		clientUsage:     clientUsage,
 		logger:          log.GetLogger().With(log.String(log.LoggerKeyComponentName, "AuthorizeService")),
 	}
 }
@@ -159,8 +180,28 @@ func (as *authorizeService) HandleInitialAuthorizationRequest(ctx context.Contex
 		}
 	}
 
+	result, authErr := as.handleClientAuthorizationRequest(ctx, msg, requestURI, clientID, app)
+	as.recordAuthorizationTrace(ctx, app, msg, authErr)
+	if authErr == nil && as.clientUsage != nil {
+		as.clientUsage.RecordAuthorization(ctx, app.ID)
+	}
+	return result, authErr
+}
+
+// handleClientAuthorizationRequest processes an initial authorization request of a resolved client.
+func (as *authorizeService) handleClientAuthorizationRequest(
+	ctx context.Context, msg *OAuthMessage, requestURI string, clientID string, app *inboundmodel.OAuthClient,
+) (*AuthorizationInitResult, *AuthorizationError) {
+	requestObject := msg.RequestQueryParams[oauth2const.RequestParamRequest]
+
 	// If request_uri is present, resolve the pushed authorization request.
 	if requestURI != "" {
+		if requestObject != "" {
+			return nil, &AuthorizationError{
+				Code:    oauth2const.ErrorInvalidRequest,
+				Message: "The request and request_uri parameters must not be used together",
+			}
+		}
 		return as.handlePARAuthorizationRequest(ctx, requestURI, clientID, app)
 	}
 
@@ -172,9 +213,34 @@ func (as *authorizeService) HandleInitialAuthorizationRequest(ctx context.Contex
 		}
 	}
 
+	// If a request object is present, continue with the parameters of the verified request object.
+	if requestObject != "" {
+		resolvedMsg, authErr := as.resolveRequestObject(ctx, msg, app)
+		if authErr != nil {
+			return nil, authErr
+		}
+		msg = resolvedMsg
+	}
+
 	return as.handleStandardAuthorizationRequest(ctx, msg, app)
 }
 
+// recordAuthorizationTrace captures the authorization request and its outcome when the client enabled
+// protocol tracing.
+func (as *authorizeService) recordAuthorizationTrace(
+	ctx context.Context, app *inboundmodel.OAuthClient, msg *OAuthMessage, authErr *AuthorizationError,
+) {
+	if as.protocolTrace == nil {
+		return
+	}
+
+	errorCode, errorDescription := "", ""
+	if authErr != nil {
+		errorCode, errorDescription = authErr.Code, authErr.Message
+	}
+	as.protocolTrace.RecordAuthorizationRequest(ctx, app, msg.RequestQueryParams, errorCode, errorDescription)
+}
+
 // handlePARAuthorizationRequest resolves a request_uri from a PAR and continues the authorization flow.
 func (as *authorizeService) handlePARAuthorizationRequest(
 	ctx context.Context, requestURI string, clientID string, app *inboundmodel.OAuthClient,
@@ -194,7 +260,7 @@ func (as *authorizeService) handlePARAuthorizationRequest(
 		}
 	}
 
-	return as.initiateFlowAndStoreRequest(ctx, oauthParams, app)
+	return as.initiateFlowAndStoreRequest(ctx, oauthParams, app, "")
 }
 
 // handleStandardAuthorizationRequest processes a standard authorization request (without PAR).
@@ -251,7 +317,16 @@ func (as *authorizeService) handleStandardAuthorizationRequest(
 		return nil, authErr
 	}
 
-	oidcScopes, nonOidcScopes := oauth2utils.SeparateOIDCAndNonOIDCScopes(scope, app.ScopeClaims)
+	oidcScopes, nonOidcScopes, scopesAllowed := oauth2utils.SeparateAllowedScopes(scope, app)
+	if !scopesAllowed {
+		return nil, &AuthorizationError{
+			Code:              oauth2const.ErrorInvalidScope,
+			Message:           "Requested scope is not allowed for this client",
+			SendErrorToClient: true,
+			ClientRedirectURI: redirectURI,
+			State:             state,
+		}
+	}
 
 	// Resolve resource identifiers to Resource Servers and downscope non-OIDC scopes against
 	// the union of permissions defined on those Resource Servers. Unknown identifiers cause
@@ -299,13 +374,14 @@ func (as *authorizeService) handleStandardAuthorizationRequest(
 		oauthParams.RedirectURI = app.RedirectURIs[0]
 	}
 
-	return as.initiateFlowAndStoreRequest(ctx, oauthParams, app)
+	return as.initiateFlowAndStoreRequest(ctx, oauthParams, app, "")
 }
 
 // initiateFlowAndStoreRequest initiates the authentication flow and stores the authorization request context.
 // This is the common path shared by both standard and PAR-based authorization requests.
 func (as *authorizeService) initiateFlowAndStoreRequest(
 	ctx context.Context, oauthParams *oauth2model.OAuthParameters, app *inboundmodel.OAuthClient,
+	deviceUserCode string,
 ) (*AuthorizationInitResult, *AuthorizationError) {
 	effectiveAcrValues := requestvalidator.ResolveACRValues(oauthParams.AcrValues, app.AcrValues)
 	essentialAttributes, optionalAttributes := getRequiredAttributes(
@@ -330,6 +406,17 @@ func (as *authorizeService) initiateFlowAndStoreRequest(
 	}
 
 	executionID, flowErr := as.flowExecService.InitiateFlow(ctx, flowInitCtx)
+	if flowErr != nil && flowErr.Code == flowexec.ErrorAuthMethodNotAllowed.Code {
+		as.logger.Debug("Authentication flow offers no authentication method allowed for the application",
+			log.String("client_id", oauthParams.ClientID))
+		return nil, &AuthorizationError{
+			Code:              oauth2const.ErrorUnauthorizedIDP,
+			Message:           "The application does not allow any authentication method offered by its flow",
+			SendErrorToClient: true,
+			ClientRedirectURI: oauthParams.RedirectURI,
+			State:             oauthParams.State,
+		}
+	}
 	if flowErr != nil {
 		as.logger.Error("Failed to initiate authentication flow",
 			log.String("error_code", flowErr.Code))
@@ -344,6 +431,7 @@ func (as *authorizeService) initiateFlowAndStoreRequest(
 
 	authRequestCtx := authRequestContext{
 		OAuthParameters: *oauthParams,
+		DeviceUserCode:  deviceUserCode,
 	}
 
 	// Store authorization request context in the store.
@@ -365,6 +453,11 @@ func (as *authorizeService) initiateFlowAndStoreRequest(
 	queryParams[oauth2const.AppID] = app.ID
 	queryParams[oauth2const.ExecutionID] = executionID
 
+	// Device authorization requests have no redirect URI.
+	if oauthParams.RedirectURI == "" {
+		return &AuthorizationInitResult{QueryParams: queryParams}, nil
+	}
+
 	// Add insecure warning if the redirect URI is not using TLS.
 	// TODO: May require another redirection to a warn consent page when it directly goes to a federated IDP.
 	parsedRedirectURI, err := utils.ParseURL(oauthParams.RedirectURI)
@@ -411,6 +504,12 @@ func (as *authorizeService) HandleAuthorizationCallback(ctx context.Context, aut
 			return err
 		}
 
+		if authRequestCtx.DeviceUserCode != "" {
+			var deviceErr error
+			redirectURI, authErr, deviceErr = as.completeDeviceAuthorization(ctx, authRequestCtx, assertion)
+			return deviceErr
+		}
+
 		if assertion == "" {
 			authErr = &AuthorizationError{
 				Code:              oauth2const.ErrorInvalidRequest,
@@ -553,6 +652,105 @@ func (as *authorizeService) HandleAuthorizationCallback(ctx context.Context, aut
 	return redirectURI, nil
 }
 
+// InitiateDeviceVerification initiates the authentication flow of the user verifying the user code of a
+// device authorization request. The returned query parameters address the login page.
+func (as *authorizeService) InitiateDeviceVerification(
+	ctx context.Context, oauthParams *oauth2model.OAuthParameters, userCode string,
+) (*AuthorizationInitResult, *AuthorizationError) {
+	app, lookupErr := as.inboundClient.GetOAuthClientByClientID(ctx, oauthParams.ClientID)
+	if lookupErr != nil {
+		as.logger.Error("Failed to retrieve OAuth client", log.Error(lookupErr))
+		return nil, &AuthorizationError{
+			Code:    oauth2const.ErrorServerError,
+			Message: "Failed to process authorization request",
+		}
+	}
+	if app == nil {
+		return nil, &AuthorizationError{
+			Code:    oauth2const.ErrorInvalidRequest,
+			Message: "Invalid client_id",
+		}
+	}
+
+	result, authErr := as.initiateFlowAndStoreRequest(ctx, oauthParams, app, userCode)
+	if authErr == nil && as.clientUsage != nil {
+		as.clientUsage.RecordAuthorization(ctx, app.ID)
+	}
+	return result, authErr
+}
+
+// SetDeviceAuthorizationApprover sets the approver of device authorization requests verified by users.
+func (as *authorizeService) SetDeviceAuthorizationApprover(approver DeviceAuthorizationApproverInterface) {
+	as.deviceApprover = approver
+}
+
+// completeDeviceAuthorization approves the device authorization request verified by the authenticated user.
+// Errors are shown on the error page as the device authorization request has no client redirect URI.
+func (as *authorizeService) completeDeviceAuthorization(
+	ctx context.Context, authRequestCtx *authRequestContext, assertion string,
+) (string, *AuthorizationError, error) {
+	if as.deviceApprover == nil {
+		return "", &AuthorizationError{
+			Code:    oauth2const.ErrorServerError,
+			Message: "Failed to process authorization request",
+		}, errors.New("device authorization approver is not configured")
+	}
+	if assertion == "" {
+		return "", &AuthorizationError{
+			Code:    oauth2const.ErrorInvalidRequest,
+			Message: "Invalid authorization request",
+		}, errors.New("assertion is empty")
+	}
+	if err := as.verifyAssertion(assertion); err != nil {
+		as.logger.Debug("Assertion verification failed", log.Error(err))
+		return "", &AuthorizationError{
+			Code:    oauth2const.ErrorInvalidRequest,
+			Message: "Authorization request failed",
+		}, err
+	}
+
+	claims, authTime, err := decodeAttributesFromAssertion(assertion)
+	if err != nil {
+		return "", &AuthorizationError{
+			Code:    oauth2const.ErrorServerError,
+			Message: "Failed to process authorization request",
+		}, err
+	}
+	if claims.userID == "" {
+		return "", &AuthorizationError{
+			Code:    oauth2const.ErrorServerError,
+			Message: "Authorization request failed",
+		}, errors.New("user ID is empty")
+	}
+
+	permissionScopes := []string{}
+	if claims.authorizedPermissions != "" {
+		permissionScopes = utils.ParseStringArray(claims.authorizedPermissions, " ")
+	}
+
+	redirectURI, err := as.deviceApprover.ApproveDeviceAuthorization(ctx, DeviceAuthorizationGrant{
+		UserCode:         authRequestCtx.DeviceUserCode,
+		AuthorizedUserID: claims.userID,
+		AttributeCacheID: claims.attributeCacheID,
+		PermissionScopes: permissionScopes,
+		CompletedACR:     claims.completedACR,
+		AuthTime:         authTime,
+	})
+	if err != nil {
+		if errors.Is(err, ErrDeviceAuthorizationNotFound) {
+			return "", &AuthorizationError{
+				Code:    oauth2const.ErrorInvalidRequest,
+				Message: "The device authorization request is invalid or has expired",
+			}, err
+		}
+		return "", &AuthorizationError{
+			Code:    oauth2const.ErrorServerError,
+			Message: "Failed to process authorization request",
+		}, err
+	}
+	return redirectURI, nil, nil
+}
+
 // loadAuthRequestContext loads the authorization request context from the store using the auth ID.
 func (as *authorizeService) loadAuthRequestContext(ctx context.Context, authID string) (*authRequestContext, error) {
 	ok, authRequestCtx, err := as.authReqStore.GetRequest(ctx, authID)
@@ -725,6 +923,11 @@ func getRequiredAttributes(oidcScopes []string, claimsRequest *oauth2model.Claim
 		appendAccessTokenAttributes(app, optionalAttributesMap)
 	}
 
+	// Add the attributes the token customization rules of the app read
+	for _, attr := range tokencustomizer.GetSourceAttributes(app.TokenCustomization) {
+		optionalAttributesMap[attr] = true
+	}
+
 	// Process OIDC-related attributes only if openid scope is present
 	if slices.Contains(oidcScopes, oauth2const.ScopeOpenID) {
 		appendOIDCAttributes(oidcScopes, claimsRequest, responseType, app,
@@ -40,6 +40,7 @@ import (
 	"github.com/thunder-id/thunderid/tests/mocks/flow/flowexecmock"
 	"github.com/thunder-id/thunderid/tests/mocks/inboundclientmock"
 	"github.com/thunder-id/thunderid/tests/mocks/jose/jwtmock"
+	"github.com/thunder-id/thunderid/tests/mocks/oauth/oauth2/protocoltracemock"
 )
 
 // stubTransactioner is a no-op Transactioner for use in service tests.
@@ -241,6 +242,25 @@ func (suite *AuthorizeServiceTestSuite) TestHandleInitialAuthorizationRequest_Va
 	assert.Equal(suite.T(), "test-state", authErr.State)
 }
 
+func (suite *AuthorizeServiceTestSuite) TestHandleInitialAuthorizationRequest_RecordsProtocolTrace() {
+	app := suite.testApp()
+	app.ProtocolTraceEnabled = true
+	suite.mockInboundClient.EXPECT().GetOAuthClientByClientID(mock.Anything, "test-client-id").Return(app, nil)
+	suite.mockValidator.On("validateInitialAuthorizationRequest", mock.Anything, app).
+		Return(true, oauth2const.ErrorUnsupportedResponseType, "Unsupported response_type value")
+
+	msg := suite.testMsg()
+	mockTrace := protocoltracemock.NewProtocolTraceServiceInterfaceMock(suite.T())
+	mockTrace.On("RecordAuthorizationRequest", mock.Anything, app, msg.RequestQueryParams,
+		oauth2const.ErrorUnsupportedResponseType, "Unsupported response_type value").Return().Once()
+	svc := suite.newService()
+	svc.protocolTrace = mockTrace
+
+	_, authErr := svc.HandleInitialAuthorizationRequest(context.Background(), msg)
+
+	assert.NotNil(suite.T(), authErr)
+}
+
 func (suite *AuthorizeServiceTestSuite) TestHandleInitialAuthorizationRequest_FlowInitError() {
 	app := suite.testApp()
 	suite.mockInboundClient.EXPECT().GetOAuthClientByClientID(mock.Anything, "test-client-id").Return(app, nil)
@@ -260,6 +280,25 @@ func (suite *AuthorizeServiceTestSuite) TestHandleInitialAuthorizationRequest_Fl
 	assert.Equal(suite.T(), "test-state", authErr.State)
 }
 
+func (suite *AuthorizeServiceTestSuite) TestHandleInitialAuthorizationRequest_AuthMethodNotAllowed() {
+	app := suite.testApp()
+	suite.mockInboundClient.EXPECT().GetOAuthClientByClientID(mock.Anything, "test-client-id").Return(app, nil)
+	suite.mockValidator.On("validateInitialAuthorizationRequest", mock.Anything, app).
+		Return(false, "", "")
+	suite.mockFlowExecService.EXPECT().InitiateFlow(mock.Anything, mock.Anything).
+		Return("", &flowexec.ErrorAuthMethodNotAllowed)
+
+	svc := suite.newService()
+	result, authErr := svc.HandleInitialAuthorizationRequest(context.Background(), suite.testMsg())
+
+	assert.Nil(suite.T(), result)
+	assert.NotNil(suite.T(), authErr)
+	assert.Equal(suite.T(), oauth2const.ErrorUnauthorizedIDP, authErr.Code)
+	assert.True(suite.T(), authErr.SendErrorToClient)
+	assert.Equal(suite.T(), "https://client.example.com/callback", authErr.ClientRedirectURI)
+	assert.Equal(suite.T(), "test-state", authErr.State)
+}
+
 func (suite *AuthorizeServiceTestSuite) TestHandleInitialAuthorizationRequest_Success() {
 	app := suite.testApp()
 	suite.mockInboundClient.EXPECT().GetOAuthClientByClientID(mock.Anything, "test-client-id").Return(app, nil)
@@ -278,6 +317,25 @@ func (suite *AuthorizeServiceTestSuite) TestHandleInitialAuthorizationRequest_Su
 	assert.Equal(suite.T(), "test-flow-id", result.QueryParams[oauth2const.ExecutionID])
 }
 
+func (suite *AuthorizeServiceTestSuite) TestHandleInitialAuthorizationRequest_DisallowedScopeRejected() {
+	app := suite.testApp()
+	app.AllowedScopes = []string{"read"}
+	app.RejectDisallowedScopes = true
+	suite.mockInboundClient.EXPECT().GetOAuthClientByClientID(mock.Anything, "test-client-id").Return(app, nil)
+	suite.mockValidator.On("validateInitialAuthorizationRequest", mock.Anything, app).
+		Return(false, "", "")
+
+	svc := suite.newService()
+	result, authErr := svc.HandleInitialAuthorizationRequest(context.Background(), suite.testMsg())
+
+	assert.Nil(suite.T(), result)
+	assert.NotNil(suite.T(), authErr)
+	assert.Equal(suite.T(), oauth2const.ErrorInvalidScope, authErr.Code)
+	assert.True(suite.T(), authErr.SendErrorToClient)
+	assert.Equal(suite.T(), "https://client.example.com/callback", authErr.ClientRedirectURI)
+	assert.Equal(suite.T(), "test-state", authErr.State)
+}
+
 func (suite *AuthorizeServiceTestSuite) TestHandleInitialAuthorizationRequest_InsecureRedirectURI() {
 	app := suite.testApp()
 	app.RedirectURIs = []string{"http://client.example.com/callback"}
@@ -611,6 +669,77 @@ func (suite *AuthorizeServiceTestSuite) TestHandleAuthorizationCallback_CreateAu
 	assert.Equal(suite.T(), oauth2const.ErrorServerError, authErr.Code)
 }
 
+func (suite *AuthorizeServiceTestSuite) TestInitiateDeviceVerification_Success() {
+	app := suite.testApp()
+	suite.mockInboundClient.EXPECT().GetOAuthClientByClientID(mock.Anything, "test-client-id").Return(app, nil)
+	suite.mockFlowExecService.EXPECT().InitiateFlow(mock.Anything, mock.Anything).Return("test-flow-id", nil)
+	suite.mockAuthReqStore.EXPECT().AddRequest(mock.Anything, mock.MatchedBy(func(c authRequestContext) bool {
+		return c.DeviceUserCode == "BCDFGHJK" && c.OAuthParameters.RedirectURI == ""
+	})).Return(testAuthID, nil)
+
+	svc := suite.newService()
+	result, authErr := svc.InitiateDeviceVerification(context.Background(),
+		&oauth2model.OAuthParameters{ClientID: "test-client-id"}, "BCDFGHJK")
+
+	assert.Nil(suite.T(), authErr)
+	assert.Equal(suite.T(), testAuthID, result.QueryParams[oauth2const.AuthID])
+	assert.Equal(suite.T(), "test-flow-id", result.QueryParams[oauth2const.ExecutionID])
+	assert.NotContains(suite.T(), result.QueryParams, oauth2const.ShowInsecureWarning)
+}
+
+func (suite *AuthorizeServiceTestSuite) TestInitiateDeviceVerification_InvalidClient() {
+	suite.mockInboundClient.EXPECT().GetOAuthClientByClientID(mock.Anything, "test-client-id").Return(nil, nil)
+
+	svc := suite.newService()
+	result, authErr := svc.InitiateDeviceVerification(context.Background(),
+		&oauth2model.OAuthParameters{ClientID: "test-client-id"}, "BCDFGHJK")
+
+	assert.Nil(suite.T(), result)
+	assert.Equal(suite.T(), oauth2const.ErrorInvalidRequest, authErr.Code)
+}
+
+func (suite *AuthorizeServiceTestSuite) TestHandleAuthorizationCallback_DeviceAuthorization() {
+	authCtx := authRequestContext{
+		OAuthParameters: oauth2model.OAuthParameters{ClientID: "test-client"},
+		DeviceUserCode:  "BCDFGHJK",
+	}
+	suite.mockAuthReqStore.EXPECT().GetRequest(mock.Anything, testAuthID).Return(true, authCtx, nil)
+	suite.mockAuthReqStore.EXPECT().ClearRequest(mock.Anything, testAuthID).Return(nil)
+	suite.mockJWTService.EXPECT().VerifyJWT(svcJWTWithIat, "", "").Return(nil)
+	approver := NewDeviceAuthorizationApproverInterfaceMock(suite.T())
+	approver.EXPECT().ApproveDeviceAuthorization(mock.Anything, mock.MatchedBy(func(g DeviceAuthorizationGrant) bool {
+		return g.UserCode == "BCDFGHJK" && g.AuthorizedUserID == "test-user" && !g.AuthTime.IsZero()
+	})).Return("https://localhost:3000/device?status=approved", nil)
+
+	svc := suite.newService()
+	svc.SetDeviceAuthorizationApprover(approver)
+	redirectURI, authErr := svc.HandleAuthorizationCallback(context.Background(), testAuthID, svcJWTWithIat)
+
+	assert.Nil(suite.T(), authErr)
+	assert.Equal(suite.T(), "https://localhost:3000/device?status=approved", redirectURI)
+}
+
+func (suite *AuthorizeServiceTestSuite) TestHandleAuthorizationCallback_DeviceAuthorizationNotFound() {
+	authCtx := authRequestContext{
+		OAuthParameters: oauth2model.OAuthParameters{ClientID: "test-client"},
+		DeviceUserCode:  "BCDFGHJK",
+	}
+	suite.mockAuthReqStore.EXPECT().GetRequest(mock.Anything, testAuthID).Return(true, authCtx, nil)
+	suite.mockAuthReqStore.EXPECT().ClearRequest(mock.Anything, testAuthID).Return(nil)
+	suite.mockJWTService.EXPECT().VerifyJWT(svcJWTWithIat, "", "").Return(nil)
+	approver := NewDeviceAuthorizationApproverInterfaceMock(suite.T())
+	approver.EXPECT().ApproveDeviceAuthorization(mock.Anything, mock.Anything).
+		Return("", ErrDeviceAuthorizationNotFound)
+
+	svc := suite.newService()
+	svc.SetDeviceAuthorizationApprover(approver)
+	redirectURI, authErr := svc.HandleAuthorizationCallback(context.Background(), testAuthID, svcJWTWithIat)
+
+	assert.Empty(suite.T(), redirectURI)
+	assert.Equal(suite.T(), oauth2const.ErrorInvalidRequest, authErr.Code)
+	assert.False(suite.T(), authErr.SendErrorToClient)
+}
+
 func (suite *AuthorizeServiceTestSuite) TestGetAuthorizationCodeDetails_GetError() {
 	suite.mockAuthzCodeStore.EXPECT().GetAuthorizationCode(mock.Anything, "code").
 		Return(nil, errors.New("database error"))
@@ -1153,6 +1282,24 @@ func (suite *AuthorizeServiceTestSuite) TestGetRequiredAttributes_NilApp() {
 	assert.Empty(suite.T(), optional)
 }
 
+func (suite *AuthorizeServiceTestSuite) TestGetRequiredAttributes_TokenCustomizationSources() {
+	app := &inboundmodel.OAuthClient{
+		ID:       "test-app",
+		ClientID: "test-client",
+		TokenCustomization: &inboundmodel.TokenCustomization{
+			AccessToken: []inboundmodel.TokenClaimRule{
+				{Claim: "country", Type: inboundmodel.TokenClaimRuleAttribute, Attribute: "address.country"},
+				{Claim: "dept", Type: inboundmodel.TokenClaimRuleExpression, Expression: "lower(user.department)"},
+			},
+		},
+	}
+
+	essential, optional := getRequiredAttributes(nil, nil, string(oauth2const.ResponseTypeCode), app)
+
+	assert.Empty(suite.T(), essential)
+	assert.ElementsMatch(suite.T(), []string{"address", "department"}, strings.Fields(optional))
+}
+
 func (suite *AuthorizeServiceTestSuite) TestGetRequiredAttributes_NilTokenConfig() {
 	app := &inboundmodel.OAuthClient{
 		ID:       "test-app",
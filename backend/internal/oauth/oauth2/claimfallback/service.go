@@ -0,0 +1,310 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package claimfallback resolves the fallback chains applications configure for claims the user has no
+// attribute value for.
+package claimfallback
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	entitytypemodel "github.com/thunder-id/thunderid/internal/entitytype/model"
+	inboundmodel "github.com/thunder-id/thunderid/internal/inboundclient/model"
+	"github.com/thunder-id/thunderid/internal/system/cache"
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/log"
+)
+
+// ClaimFallbackServiceInterface defines the interface for resolving the claim fallback chains of applications.
+type ClaimFallbackServiceInterface interface {
+	ApplyFallbacks(ctx context.Context, client *inboundmodel.OAuthClient, subject string,
+		userAttributes map[string]interface{}) map[string]interface{}
+	GetSourceAttributes(client *inboundmodel.OAuthClient) []string
+}
+
+// claimFallbackService implements ClaimFallbackServiceInterface.
+type claimFallbackService struct {
+	cache  cache.CacheInterface[map[string]interface{}]
+	config config.ClaimFallbackConfig
+	logger *log.Logger
+}
+
+// newClaimFallbackService creates a new instance of claimFallbackService.
+func newClaimFallbackService(
+	fallbackCache cache.CacheInterface[map[string]interface{}],
+	claimFallbackConfig config.ClaimFallbackConfig,
+) *claimFallbackService {
+	return &claimFallbackService{
+		cache:  fallbackCache,
+		config: claimFallbackConfig,
+		logger: log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName)),
+	}
+}
+
+// ApplyFallbacks returns the user attributes with the claims the user has no value for filled from the fallback
+// chains of the client. Values the user has are never replaced. The resolved values are cached per client and
+// subject, so a change to a source attribute is reflected once the cache entry expires.
+func (s *claimFallbackService) ApplyFallbacks(ctx context.Context, client *inboundmodel.OAuthClient,
+	subject string, userAttributes map[string]interface{}) map[string]interface{} {
+	if s.config.Disabled || client == nil || len(client.ClaimFallbacks) == 0 {
+		return userAttributes
+	}
+
+	missing := make([]string, 0, len(client.ClaimFallbacks))
+	for claim := range client.ClaimFallbacks {
+		if isEmptyValue(userAttributes[claim]) {
+			missing = append(missing, claim)
+		}
+	}
+	if len(missing) == 0 {
+		return userAttributes
+	}
+
+	resolved := s.resolveCached(ctx, client, subject, userAttributes)
+	result := make(map[string]interface{}, len(userAttributes)+len(missing))
+	for key, value := range userAttributes {
+		result[key] = value
+	}
+	for _, claim := range missing {
+		if value, ok := resolved[claim]; ok {
+			result[claim] = value
+		}
+	}
+	return result
+}
+
+// GetSourceAttributes returns the top-level attributes the fallback chains of the client read, so callers that
+// fetch a filtered set of user attributes can include them.
+func (s *claimFallbackService) GetSourceAttributes(client *inboundmodel.OAuthClient) []string {
+	if s.config.Disabled || client == nil {
+		return nil
+	}
+
+	attributes := make([]string, 0)
+	seen := make(map[string]bool)
+	add := func(path string) {
+		name, _, _ := strings.Cut(path, ".")
+		if name != "" && !seen[name] {
+			seen[name] = true
+			attributes = append(attributes, name)
+		}
+	}
+	for _, sources := range client.ClaimFallbacks {
+		for _, source := range sources {
+			switch source.Type {
+			case inboundmodel.ClaimFallbackSourceAttributes:
+				for _, path := range source.Attributes {
+					add(path)
+				}
+			case inboundmodel.ClaimFallbackSourceComputed:
+				for _, path := range source.TemplatePlaceholders() {
+					add(path)
+				}
+			case inboundmodel.ClaimFallbackSourceGravatar:
+				add(emailAttribute(source))
+				add(verifiedAttribute(source))
+			}
+		}
+	}
+	return attributes
+}
+
+// resolveCached returns the values of all fallback chains of the client, reading them from the cache when the
+// subject is known.
+func (s *claimFallbackService) resolveCached(ctx context.Context, client *inboundmodel.OAuthClient,
+	subject string, userAttributes map[string]interface{}) map[string]interface{} {
+	if subject == "" || s.cache == nil {
+		return s.resolve(client, userAttributes)
+	}
+
+	key := cache.CacheKey{Key: client.ClientID + ":" + subject}
+	if resolved, ok := s.cache.Get(ctx, key); ok {
+		return resolved
+	}
+	resolved := s.resolve(client, userAttributes)
+	if err := s.cache.Set(ctx, key, resolved); err != nil {
+		s.logger.Warn("Failed to cache resolved claim fallbacks", log.String("clientId", client.ClientID),
+			log.Error(err))
+	}
+	return resolved
+}
+
+// resolve evaluates the fallback chain of every claim of the client against the user attributes.
+func (s *claimFallbackService) resolve(client *inboundmodel.OAuthClient,
+	userAttributes map[string]interface{}) map[string]interface{} {
+	resolved := make(map[string]interface{}, len(client.ClaimFallbacks))
+	for claim, sources := range client.ClaimFallbacks {
+		for _, source := range sources {
+			if value, ok := s.resolveSource(source, userAttributes); ok {
+				resolved[claim] = value
+				break
+			}
+		}
+	}
+	return resolved
+}
+
+// resolveSource evaluates a single step of a fallback chain.
+func (s *claimFallbackService) resolveSource(source inboundmodel.ClaimFallbackSource,
+	userAttributes map[string]interface{}) (interface{}, bool) {
+	switch source.Type {
+	case inboundmodel.ClaimFallbackSourceAttributes:
+		for _, path := range source.Attributes {
+			if value := lookupAttribute(userAttributes, path); !isEmptyValue(value) {
+				return value, true
+			}
+		}
+	case inboundmodel.ClaimFallbackSourceComputed:
+		return renderTemplate(source, userAttributes)
+	case inboundmodel.ClaimFallbackSourceGravatar:
+		if !s.config.Gravatar.Enabled {
+			return nil, false
+		}
+		email, ok := verifiedEmail(source, userAttributes)
+		if !ok {
+			return nil, false
+		}
+		return s.gravatarURL(email), true
+	}
+	return nil, false
+}
+
+// gravatarURL builds the gravatar URL of an email address from the SHA-256 hash of its normalized form.
+func (s *claimFallbackService) gravatarURL(email string) string {
+	hash := sha256.Sum256([]byte(strings.ToLower(strings.TrimSpace(email))))
+	gravatarURL := s.config.Gravatar.BaseURL
+	if !strings.HasSuffix(gravatarURL, "/") {
+		gravatarURL += "/"
+	}
+	gravatarURL += hex.EncodeToString(hash[:])
+
+	query := url.Values{}
+	if s.config.Gravatar.DefaultImage != "" {
+		query.Set("d", s.config.Gravatar.DefaultImage)
+	}
+	if s.config.Gravatar.Size > 0 {
+		query.Set("s", strconv.Itoa(s.config.Gravatar.Size))
+	}
+	if len(query) > 0 {
+		gravatarURL += "?" + query.Encode()
+	}
+	return gravatarURL
+}
+
+// renderTemplate replaces the placeholders of a computed source with attribute values. Placeholders without a
+// value render as nothing and the surrounding whitespace is collapsed; the source yields nothing when no
+// placeholder has a value.
+func renderTemplate(source inboundmodel.ClaimFallbackSource,
+	userAttributes map[string]interface{}) (interface{}, bool) {
+	var rendered strings.Builder
+	found := false
+	rest := source.Template
+	for {
+		start := strings.Index(rest, "{")
+		end := strings.Index(rest[max(start, 0):], "}")
+		if start < 0 || end < 0 {
+			rendered.WriteString(rest)
+			break
+		}
+		rendered.WriteString(rest[:start])
+		value := lookupAttribute(userAttributes, strings.TrimSpace(rest[start+1:start+end]))
+		if !isEmptyValue(value) {
+			rendered.WriteString(fmt.Sprint(value))
+			found = true
+		}
+		rest = rest[start+end+1:]
+	}
+	if !found {
+		return nil, false
+	}
+	return strings.Join(strings.Fields(rendered.String()), " "), true
+}
+
+// verifiedEmail returns the verified email address a gravatar source reads. A multi-valued contact attribute
+// yields its selected value when that value is verified.
+func verifiedEmail(source inboundmodel.ClaimFallbackSource,
+	userAttributes map[string]interface{}) (string, bool) {
+	value := lookupAttribute(userAttributes, emailAttribute(source))
+	if contacts := entitytypemodel.ParseContactValues(value); contacts != nil {
+		contact, ok := entitytypemodel.SelectContactValue(contacts)
+		if !ok || !contact.Verified || contact.Value == "" {
+			return "", false
+		}
+		return contact.Value, true
+	}
+
+	email, ok := value.(string)
+	if !ok || strings.TrimSpace(email) == "" {
+		return "", false
+	}
+	verified, _ := lookupAttribute(userAttributes, verifiedAttribute(source)).(bool)
+	return email, verified
+}
+
+// lookupAttribute returns the value of a dot-separated attribute path.
+func lookupAttribute(userAttributes map[string]interface{}, path string) interface{} {
+	if path == "" {
+		return nil
+	}
+	var current interface{} = userAttributes
+	for _, segment := range strings.Split(path, ".") {
+		object, ok := current.(map[string]interface{})
+		if !ok {
+			return nil
+		}
+		current = object[segment]
+	}
+	return current
+}
+
+// isEmptyValue reports whether a claim value is absent, a blank string or an empty array or object.
+func isEmptyValue(value interface{}) bool {
+	switch v := value.(type) {
+	case nil:
+		return true
+	case string:
+		return strings.TrimSpace(v) == ""
+	case []interface{}:
+		return len(v) == 0
+	case map[string]interface{}:
+		return len(v) == 0
+	}
+	return false
+}
+
+// emailAttribute returns the attribute holding the email address of a gravatar source.
+func emailAttribute(source inboundmodel.ClaimFallbackSource) string {
+	if source.EmailAttribute != "" {
+		return source.EmailAttribute
+	}
+	return defaultEmailAttribute
+}
+
+// verifiedAttribute returns the attribute marking the email address of a gravatar source verified.
+func verifiedAttribute(source inboundmodel.ClaimFallbackSource) string {
+	if source.VerifiedAttribute != "" {
+		return source.VerifiedAttribute
+	}
+	return defaultVerifiedAttribute
+}
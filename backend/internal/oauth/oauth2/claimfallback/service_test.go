@@ -0,0 +1,188 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package claimfallback
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	inboundmodel "github.com/thunder-id/thunderid/internal/inboundclient/model"
+	"github.com/thunder-id/thunderid/internal/system/cache"
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/tests/mocks/cachemock"
+)
+
+type ClaimFallbackServiceTestSuite struct {
+	suite.Suite
+	mockCache *cachemock.CacheInterfaceMock[map[string]interface{}]
+	config    config.ClaimFallbackConfig
+	client    *inboundmodel.OAuthClient
+}
+
+func TestClaimFallbackServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(ClaimFallbackServiceTestSuite))
+}
+
+func (suite *ClaimFallbackServiceTestSuite) SetupTest() {
+	suite.mockCache = cachemock.NewCacheInterfaceMock[map[string]interface{}](suite.T())
+	suite.config = config.ClaimFallbackConfig{
+		Gravatar: config.GravatarConfig{
+			Enabled:      true,
+			BaseURL:      "https://gravatar.com/avatar",
+			DefaultImage: "identicon",
+			Size:         80,
+		},
+	}
+	suite.client = &inboundmodel.OAuthClient{
+		ClientID: "client-1",
+		ClaimFallbacks: map[string][]inboundmodel.ClaimFallbackSource{
+			"name": {
+				{Type: inboundmodel.ClaimFallbackSourceAttributes, Attributes: []string{"displayName", "profile.name"}},
+				{Type: inboundmodel.ClaimFallbackSourceComputed, Template: "{given_name} {family_name}"},
+			},
+			"picture": {
+				{Type: inboundmodel.ClaimFallbackSourceAttributes, Attributes: []string{"photo"}},
+				{Type: inboundmodel.ClaimFallbackSourceGravatar},
+			},
+		},
+	}
+}
+
+func (suite *ClaimFallbackServiceTestSuite) newService() *claimFallbackService {
+	return newClaimFallbackService(nil, suite.config)
+}
+
+func gravatarHash(email string) string {
+	hash := sha256.Sum256([]byte(email))
+	return hex.EncodeToString(hash[:])
+}
+
+func (suite *ClaimFallbackServiceTestSuite) TestApplyFallbacks_AttributePaths() {
+	attrs := map[string]interface{}{
+		"name":    "",
+		"profile": map[string]interface{}{"name": "Alice Smith"},
+		"photo":   "https://cdn.example.com/alice.png",
+	}
+
+	result := suite.newService().ApplyFallbacks(context.Background(), suite.client, "", attrs)
+
+	suite.Equal("Alice Smith", result["name"])
+	suite.Equal("https://cdn.example.com/alice.png", result["picture"])
+	suite.Equal("", attrs["name"], "the input attributes must not be modified")
+}
+
+func (suite *ClaimFallbackServiceTestSuite) TestApplyFallbacks_ComputedTemplate() {
+	result := suite.newService().ApplyFallbacks(context.Background(), suite.client, "",
+		map[string]interface{}{"given_name": "Alice", "family_name": "  "})
+
+	suite.Equal("Alice", result["name"])
+}
+
+func (suite *ClaimFallbackServiceTestSuite) TestApplyFallbacks_KeepsExistingValues() {
+	attrs := map[string]interface{}{"name": "Alice", "picture": "https://cdn.example.com/a.png"}
+
+	result := suite.newService().ApplyFallbacks(context.Background(), suite.client, "user-1", attrs)
+
+	suite.Equal(attrs, result)
+}
+
+func (suite *ClaimFallbackServiceTestSuite) TestApplyFallbacks_GravatarOfVerifiedEmail() {
+	result := suite.newService().ApplyFallbacks(context.Background(), suite.client, "",
+		map[string]interface{}{"email": " Alice@Example.com ", "email_verified": true})
+
+	suite.Equal("https://gravatar.com/avatar/"+gravatarHash("alice@example.com")+"?d=identicon&s=80",
+		result["picture"])
+	suite.NotContains(result, "name")
+}
+
+func (suite *ClaimFallbackServiceTestSuite) TestApplyFallbacks_GravatarSkipsUnverifiedEmail() {
+	result := suite.newService().ApplyFallbacks(context.Background(), suite.client, "",
+		map[string]interface{}{"email": "alice@example.com", "email_verified": false})
+
+	suite.NotContains(result, "picture")
+}
+
+func (suite *ClaimFallbackServiceTestSuite) TestApplyFallbacks_GravatarOfContactList() {
+	result := suite.newService().ApplyFallbacks(context.Background(), suite.client, "",
+		map[string]interface{}{"email": []interface{}{
+			map[string]interface{}{"value": "old@example.com", "verified": true},
+			map[string]interface{}{"value": "alice@example.com", "primary": true, "verified": true},
+		}})
+
+	suite.Equal("https://gravatar.com/avatar/"+gravatarHash("alice@example.com")+"?d=identicon&s=80",
+		result["picture"])
+}
+
+func (suite *ClaimFallbackServiceTestSuite) TestApplyFallbacks_GravatarDisabled() {
+	suite.config.Gravatar.Enabled = false
+
+	result := suite.newService().ApplyFallbacks(context.Background(), suite.client, "",
+		map[string]interface{}{"email": "alice@example.com", "email_verified": true})
+
+	suite.NotContains(result, "picture")
+}
+
+func (suite *ClaimFallbackServiceTestSuite) TestApplyFallbacks_Disabled() {
+	suite.config.Disabled = true
+	attrs := map[string]interface{}{"displayName": "Alice"}
+
+	result := suite.newService().ApplyFallbacks(context.Background(), suite.client, "", attrs)
+
+	suite.Equal(attrs, result)
+}
+
+func (suite *ClaimFallbackServiceTestSuite) TestApplyFallbacks_UsesCachedValues() {
+	key := cache.CacheKey{Key: "client-1:user-1"}
+	suite.mockCache.On("Get", mock.Anything, key).
+		Return(map[string]interface{}{"name": "Cached Name"}, true).Once()
+
+	svc := newClaimFallbackService(suite.mockCache, suite.config)
+	result := svc.ApplyFallbacks(context.Background(), suite.client, "user-1",
+		map[string]interface{}{"displayName": "Alice"})
+
+	suite.Equal("Cached Name", result["name"])
+	suite.NotContains(result, "picture")
+}
+
+func (suite *ClaimFallbackServiceTestSuite) TestApplyFallbacks_CachesResolvedValues() {
+	key := cache.CacheKey{Key: "client-1:user-1"}
+	suite.mockCache.On("Get", mock.Anything, key).Return(nil, false).Once()
+	suite.mockCache.On("Set", mock.Anything, key, map[string]interface{}{"name": "Alice"}).
+		Return(errors.New("cache unavailable")).Once()
+
+	svc := newClaimFallbackService(suite.mockCache, suite.config)
+	result := svc.ApplyFallbacks(context.Background(), suite.client, "user-1",
+		map[string]interface{}{"displayName": "Alice"})
+
+	suite.Equal("Alice", result["name"])
+}
+
+func (suite *ClaimFallbackServiceTestSuite) TestGetSourceAttributes() {
+	attributes := suite.newService().GetSourceAttributes(suite.client)
+
+	suite.ElementsMatch([]string{"displayName", "profile", "given_name", "family_name", "photo", "email",
+		"email_verified"}, attributes)
+	suite.Nil(suite.newService().GetSourceAttributes(nil))
+}
@@ -147,7 +147,7 @@ func authenticate(
 	switch detectedMethod {
 	// TODO: Move this to authnProvider.Authenticate
 	case constants.TokenEndpointAuthMethodPrivateKeyJWT:
-		if err := validateClientAssertion(oauthApp, jwtService, endpointURL, clientID,
+		if err := validateClientAssertion(ctx, oauthApp, jwtService, endpointURL, clientID,
 			clientAssertion); err != nil {
 			logger.Debug("Invalid client assertion: " + err.Error())
 			return nil, errInvalidClientAssertion
@@ -233,6 +233,7 @@ func extractClientIDFromAssertion(assertion string) (string, *authError) {
 // validateClientAssertion validates the provided client assertion JWT using the configured certificate and JWT service.
 // The endpointURL is used as the expected audience for JWT validation.
 func validateClientAssertion(
+	ctx context.Context,
 	oauthApp *inboundmodel.OAuthClient,
 	jwtService jwt.JWTServiceInterface,
 	endpointURL string,
@@ -240,11 +241,27 @@ func validateClientAssertion(
 	if oauthApp.Certificate == nil {
 		return fmt.Errorf("no certificate configured for client assertion validation")
 	}
+	if err := VerifyClientSignedJWT(ctx, oauthApp, jwtService, clientAssertion, endpointURL, clientID); err != nil {
+		return fmt.Errorf("client assertion %w", err)
+	}
+	return nil
+}
+
+// VerifyClientSignedJWT verifies a JWT signed by the client, such as a client assertion or a request object,
+// with the JWKS or JWKS URI certificate of the client, and validates its expiry, audience and issuer claims.
+func VerifyClientSignedJWT(
+	ctx context.Context,
+	oauthApp *inboundmodel.OAuthClient,
+	jwtService jwt.JWTServiceInterface,
+	token, expectedAud, expectedIss string) error {
+	if oauthApp.Certificate == nil {
+		return fmt.Errorf("no certificate configured for JWT verification")
+	}
 
 	if oauthApp.Certificate.Type == cert.CertificateTypeJWKSURI {
-		if err := jwtService.VerifyJWTWithJWKS(clientAssertion, oauthApp.Certificate.Value, endpointURL,
-			clientID); err != nil {
-			return fmt.Errorf("client assertion verification with JWKS URI failed: %v", err.Error)
+		if err := jwtService.VerifyJWTWithJWKS(ctx, token, oauthApp.Certificate.Value, expectedAud,
+			expectedIss); err != nil {
+			return fmt.Errorf("verification with JWKS URI failed: %v", err.Error)
 		}
 		return nil
 	}
@@ -257,7 +274,7 @@ func validateClientAssertion(
 	}
 
 	var kid string
-	if header, err := jwt.DecodeJWTHeader(clientAssertion); err != nil {
+	if header, err := jwt.DecodeJWTHeader(token); err != nil {
 		return fmt.Errorf("failed to decode header: %w", err)
 	} else if k, ok := header["kid"].(string); !ok || k == "" {
 		return fmt.Errorf("JWT header missing 'kid' claim or 'kid' is not a string")
@@ -281,8 +298,8 @@ func validateClientAssertion(
 		return fmt.Errorf("failed to convert JWK to public key: %w", err)
 	}
 
-	if err := jwtService.VerifyJWTWithPublicKey(clientAssertion, pubKey, endpointURL, clientID); err != nil {
-		return fmt.Errorf("client assertion verification failed: %v", err.Error)
+	if err := jwtService.VerifyJWTWithPublicKey(token, pubKey, expectedAud, expectedIss); err != nil {
+		return fmt.Errorf("verification failed: %v", err.Error)
 	}
 
 	return nil
@@ -19,6 +19,7 @@
 package clientauth
 
 import (
+	"context"
 	"crypto/rand"
 	"crypto/rsa"
 	"encoding/base64"
@@ -976,7 +977,7 @@ func (suite *ClientAuthTestSuite) TestValidateClientAssertion_NilCertificate() {
 	}
 
 	err := validateClientAssertion(
-		oauthApp, suite.mockJwtService, testEndpointURL, "test-client",
+		context.Background(), oauthApp, suite.mockJwtService, testEndpointURL, "test-client",
 		"some.jwt.token")
 	assert.NotNil(suite.T(), err)
 	assert.Contains(suite.T(), err.Error(), "no certificate configured")
@@ -994,12 +995,12 @@ func (suite *ClientAuthTestSuite) TestValidateClientAssertion_JWKSURI_Success()
 	assertion := buildFakeJWTWithSub("test-client")
 
 	suite.mockJwtService.EXPECT().
-		VerifyJWTWithJWKS(assertion, "https://example.com/.well-known/jwks.json",
+		VerifyJWTWithJWKS(mock.Anything, assertion, "https://example.com/.well-known/jwks.json",
 			"https://localhost:9443/oauth2/token", "test-client").
 		Return(nil)
 
 	err := validateClientAssertion(
-		oauthApp, suite.mockJwtService, testEndpointURL, "test-client", assertion)
+		context.Background(), oauthApp, suite.mockJwtService, testEndpointURL, "test-client", assertion)
 	assert.Nil(suite.T(), err)
 }
 
@@ -1015,12 +1016,12 @@ func (suite *ClientAuthTestSuite) TestValidateClientAssertion_JWKSURI_Verificati
 	assertion := buildFakeJWTWithSub("test-client")
 
 	suite.mockJwtService.EXPECT().
-		VerifyJWTWithJWKS(assertion, "https://example.com/.well-known/jwks.json",
+		VerifyJWTWithJWKS(mock.Anything, assertion, "https://example.com/.well-known/jwks.json",
 			"https://localhost:9443/oauth2/token", "test-client").
 		Return(&serviceerror.ServiceError{Error: i18ncore.I18nMessage{DefaultValue: "verification failed"}})
 
 	err := validateClientAssertion(
-		oauthApp, suite.mockJwtService, testEndpointURL, "test-client", assertion)
+		context.Background(), oauthApp, suite.mockJwtService, testEndpointURL, "test-client", assertion)
 	assert.NotNil(suite.T(), err)
 	assert.Contains(suite.T(), err.Error(), "client assertion verification with JWKS URI failed")
 }
@@ -1034,7 +1035,7 @@ func (suite *ClientAuthTestSuite) TestValidateClientAssertion_InvalidJWKSJSON()
 		},
 	}
 
-	err := validateClientAssertion(oauthApp, suite.mockJwtService, testEndpointURL,
+	err := validateClientAssertion(context.Background(), oauthApp, suite.mockJwtService, testEndpointURL,
 		"test-client", "some.jwt.token")
 	assert.NotNil(suite.T(), err)
 	assert.Contains(suite.T(), err.Error(), "invalid JWKS certificate format")
@@ -1050,7 +1051,7 @@ func (suite *ClientAuthTestSuite) TestValidateClientAssertion_InvalidJWTFormat()
 		},
 	}
 
-	err := validateClientAssertion(oauthApp, suite.mockJwtService, testEndpointURL,
+	err := validateClientAssertion(context.Background(), oauthApp, suite.mockJwtService, testEndpointURL,
 		"test-client", "invalid-jwt")
 	assert.NotNil(suite.T(), err)
 	assert.Contains(suite.T(), err.Error(), "failed to decode header")
@@ -1069,7 +1070,7 @@ func (suite *ClientAuthTestSuite) TestValidateClientAssertion_MissingKidInHeader
 	fakeJWT := buildTestJWT(map[string]any{"alg": "RS256", "typ": "JWT"}, map[string]any{"sub": "test-client"})
 
 	err := validateClientAssertion(
-		oauthApp, suite.mockJwtService, testEndpointURL, "test-client", fakeJWT)
+		context.Background(), oauthApp, suite.mockJwtService, testEndpointURL, "test-client", fakeJWT)
 	assert.NotNil(suite.T(), err)
 	assert.Contains(suite.T(), err.Error(), "JWT header missing 'kid' claim")
 }
@@ -1088,7 +1089,7 @@ func (suite *ClientAuthTestSuite) TestValidateClientAssertion_EmptyKidInHeader()
 		map[string]any{"sub": "test-client"})
 
 	err := validateClientAssertion(
-		oauthApp, suite.mockJwtService, testEndpointURL, "test-client", fakeJWT)
+		context.Background(), oauthApp, suite.mockJwtService, testEndpointURL, "test-client", fakeJWT)
 	assert.NotNil(suite.T(), err)
 	assert.Contains(suite.T(), err.Error(), "JWT header missing 'kid' claim")
 }
@@ -1107,7 +1108,7 @@ func (suite *ClientAuthTestSuite) TestValidateClientAssertion_KidNotAString() {
 		map[string]any{"sub": "test-client"})
 
 	err := validateClientAssertion(
-		oauthApp, suite.mockJwtService, testEndpointURL, "test-client", fakeJWT)
+		context.Background(), oauthApp, suite.mockJwtService, testEndpointURL, "test-client", fakeJWT)
 	assert.NotNil(suite.T(), err)
 	assert.Contains(suite.T(), err.Error(), "JWT header missing 'kid' claim")
 }
@@ -1126,7 +1127,7 @@ func (suite *ClientAuthTestSuite) TestValidateClientAssertion_NoMatchingKidInJWK
 		map[string]any{"sub": "test-client"})
 
 	err := validateClientAssertion(
-		oauthApp, suite.mockJwtService, testEndpointURL, "test-client", fakeJWT)
+		context.Background(), oauthApp, suite.mockJwtService, testEndpointURL, "test-client", fakeJWT)
 	assert.NotNil(suite.T(), err)
 	assert.Contains(suite.T(), err.Error(), "no matching key found in JWKS")
 }
@@ -1151,7 +1152,7 @@ func (suite *ClientAuthTestSuite) TestValidateClientAssertion_InvalidJWKCannotCo
 		map[string]any{"sub": "test-client"})
 
 	err := validateClientAssertion(
-		oauthApp, suite.mockJwtService, testEndpointURL, "test-client", fakeJWT)
+		context.Background(), oauthApp, suite.mockJwtService, testEndpointURL, "test-client", fakeJWT)
 	assert.NotNil(suite.T(), err)
 	assert.Contains(suite.T(), err.Error(), "failed to convert JWK to public key")
 }
@@ -1178,7 +1179,7 @@ func (suite *ClientAuthTestSuite) TestValidateClientAssertion_VerificationFails(
 		})
 
 	err := validateClientAssertion(
-		oauthApp, suite.mockJwtService, testEndpointURL, "test-client", fakeJWT)
+		context.Background(), oauthApp, suite.mockJwtService, testEndpointURL, "test-client", fakeJWT)
 	assert.NotNil(suite.T(), err)
 	assert.Contains(suite.T(), err.Error(), "client assertion verification failed")
 }
@@ -1201,7 +1202,7 @@ func (suite *ClientAuthTestSuite) TestValidateClientAssertion_Success() {
 		Return(nil)
 
 	err := validateClientAssertion(
-		oauthApp, suite.mockJwtService, testEndpointURL, "test-client", fakeJWT)
+		context.Background(), oauthApp, suite.mockJwtService, testEndpointURL, "test-client", fakeJWT)
 	assert.Nil(suite.T(), err)
 }
 
@@ -1221,7 +1222,7 @@ func (suite *ClientAuthTestSuite) TestValidateClientAssertion_EmptyJWKSKeys() {
 		map[string]any{"sub": "test-client"})
 
 	err := validateClientAssertion(
-		oauthApp, suite.mockJwtService, testEndpointURL, "test-client", fakeJWT)
+		context.Background(), oauthApp, suite.mockJwtService, testEndpointURL, "test-client", fakeJWT)
 	assert.NotNil(suite.T(), err)
 	assert.Contains(suite.T(), err.Error(), "no matching key found in JWKS")
 }
@@ -1257,6 +1258,6 @@ func (suite *ClientAuthTestSuite) TestValidateClientAssertion_MultipleKeysMatche
 		Return(nil)
 
 	err := validateClientAssertion(
-		oauthApp, suite.mockJwtService, testEndpointURL, "test-client", fakeJWT)
+		context.Background(), oauthApp, suite.mockJwtService, testEndpointURL, "test-client", fakeJWT)
 	assert.Nil(suite.T(), err)
 }
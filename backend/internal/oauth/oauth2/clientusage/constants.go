@@ -0,0 +1,39 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package clientusage
+
+import "time"
+
+const loggerComponentName = "ClientUsageService"
+
+// defaultFlushInterval is the interval between writes of the buffered usage when none is configured.
+const defaultFlushInterval = 30 * time.Second
+
+// defaultInactiveDays is the number of days without use after which a client is reported as inactive when
+// none is configured.
+const defaultInactiveDays = 90
+
+// maxPendingUsages is the number of clients with buffered usage that triggers a write before the next interval.
+const maxPendingUsages = 1000
+
+// scheduledReportLockName is the distributed lock held while a scheduled inactive client report runs.
+const scheduledReportLockName = "client-usage-inactive-report"
+
+// webhookTimeout bounds the time spent delivering a webhook alert.
+const webhookTimeout = 10 * time.Second
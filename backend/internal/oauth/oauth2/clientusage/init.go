@@ -0,0 +1,57 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package clientusage
+
+import (
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/entityprovider"
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/distlock"
+	syshttp "github.com/thunder-id/thunderid/internal/system/http"
+	"github.com/thunder-id/thunderid/internal/system/observability"
+)
+
+// Initialize initializes the client usage service, and starts writing the buffered usage and the scheduled
+// inactive client reports.
+func Initialize(
+	entityProvider entityprovider.EntityProviderInterface,
+	observabilitySvc observability.ObservabilityServiceInterface,
+	lockManager distlock.LockManagerInterface,
+) ClientUsageServiceInterface {
+	serverConfig := config.GetServerRuntime().Config
+	usageConfig := serverConfig.OAuth.ClientUsage
+
+	inactiveDays := usageConfig.InactiveDays
+	if inactiveDays <= 0 {
+		inactiveDays = defaultInactiveDays
+	}
+	clientUsageService := newClientUsageService(newClientUsageStore(), entityProvider, observabilitySvc,
+		syshttp.NewHTTPClientWithTimeout(webhookTimeout), usageConfig.WebhookURL,
+		time.Duration(inactiveDays)*24*time.Hour, serverConfig.Server.Identifier)
+
+	flushInterval := time.Duration(usageConfig.FlushInterval) * time.Second
+	if flushInterval <= 0 {
+		flushInterval = defaultFlushInterval
+	}
+	startFlushing(clientUsageService, flushInterval)
+	startScheduledReports(clientUsageService, lockManager, time.Duration(usageConfig.ReportInterval)*time.Second)
+
+	return clientUsageService
+}
@@ -0,0 +1,82 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package clientusage tracks when each OAuth client last obtained a token and last initiated an
+// authorization request, and reports the clients that have not been used for a configured period.
+package clientusage
+
+import "time"
+
+// ClientUsage represents the recorded usage of the OAuth client of an application.
+type ClientUsage struct {
+	AppID             string     `json:"appId"`
+	LastTokenIssuedAt *time.Time `json:"lastTokenIssuedAt,omitempty"`
+	LastAuthorizedAt  *time.Time `json:"lastAuthorizedAt,omitempty"`
+	// TrackedSince is the time usage tracking started for the application.
+	TrackedSince time.Time `json:"trackedSince"`
+}
+
+// LastUsedAt returns the time of the latest token issuance or authorization request, or nil when the client
+// has not been used since tracking started.
+func (u *ClientUsage) LastUsedAt() *time.Time {
+	switch {
+	case u.LastTokenIssuedAt == nil:
+		return u.LastAuthorizedAt
+	case u.LastAuthorizedAt == nil || u.LastTokenIssuedAt.After(*u.LastAuthorizedAt):
+		return u.LastTokenIssuedAt
+	default:
+		return u.LastAuthorizedAt
+	}
+}
+
+// IsInactiveSince reports whether the client has not been used since the given time. A client that has never
+// been used is inactive once it has been tracked since before that time.
+func (u *ClientUsage) IsInactiveSince(since time.Time) bool {
+	if lastUsedAt := u.LastUsedAt(); lastUsedAt != nil {
+		return lastUsedAt.Before(since)
+	}
+	return u.TrackedSince.Before(since)
+}
+
+// InactiveClient represents an application whose client has not been used for the configured period.
+type InactiveClient struct {
+	AppID      string     `json:"appId"`
+	Name       string     `json:"name,omitempty"`
+	LastUsedAt *time.Time `json:"lastUsedAt,omitempty"`
+}
+
+// InactiveClientReport represents the outcome of a scheduled inactive client report.
+type InactiveClientReport struct {
+	InactiveSince   time.Time        `json:"inactiveSince"`
+	TotalClients    int              `json:"totalClients"`
+	InactiveClients []InactiveClient `json:"inactiveClients"`
+	GeneratedAt     time.Time        `json:"generatedAt"`
+}
+
+// webhookEvent is the payload posted to the configured webhook when a scheduled report finds inactive clients.
+type webhookEvent struct {
+	Event     string               `json:"event"`
+	Timestamp time.Time            `json:"timestamp"`
+	Report    InactiveClientReport `json:"report"`
+}
+
+// usageKey identifies the buffered usage of an application within a deployment.
+type usageKey struct {
+	deploymentID string
+	appID        string
+}
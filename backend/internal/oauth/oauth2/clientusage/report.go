@@ -0,0 +1,202 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package clientusage
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/entityprovider"
+	serverconst "github.com/thunder-id/thunderid/internal/system/constants"
+	sysContext "github.com/thunder-id/thunderid/internal/system/context"
+	"github.com/thunder-id/thunderid/internal/system/distlock"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/internal/system/observability/event"
+)
+
+// buildInactiveClientReport lists the applications whose clients have not been used for the inactive period.
+// Tracking starts for applications that have no usage recorded, so that they are reported once they stay
+// unused for the whole period.
+func (s *clientUsageService) buildInactiveClientReport(ctx context.Context) (*InactiveClientReport, error) {
+	entities, epErr := s.entityProvider.GetEntityList(
+		entityprovider.EntityCategoryApp, serverconst.MaxCompositeStoreRecords, 0, nil)
+	if epErr != nil {
+		return nil, fmt.Errorf("failed to list applications: %w", epErr)
+	}
+
+	usages, err := s.store.GetUsageList(ctx)
+	if err != nil {
+		return nil, err
+	}
+	usageMap := make(map[string]ClientUsage, len(usages))
+	for i := range usages {
+		usageMap[usages[i].AppID] = usages[i]
+	}
+
+	now := s.now().UTC()
+	report := &InactiveClientReport{
+		InactiveSince:   now.Add(-s.inactivePeriod),
+		TotalClients:    len(entities),
+		InactiveClients: []InactiveClient{},
+		GeneratedAt:     now,
+	}
+	for i := range entities {
+		usage, ok := usageMap[entities[i].ID]
+		if !ok {
+			if err := s.store.StartTracking(ctx, entities[i].ID, now.Truncate(time.Second)); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		if usage.IsInactiveSince(report.InactiveSince) {
+			report.InactiveClients = append(report.InactiveClients, InactiveClient{
+				AppID:      entities[i].ID,
+				Name:       entityName(&entities[i]),
+				LastUsedAt: usage.LastUsedAt(),
+			})
+		}
+	}
+	return report, nil
+}
+
+// reportInactiveClients writes the buffered usage, builds the inactive client report and alerts the inactive
+// clients found.
+func (s *clientUsageService) reportInactiveClients(ctx context.Context) {
+	s.Flush(ctx)
+
+	report, err := s.buildInactiveClientReport(ctx)
+	if err != nil {
+		s.logger.Error("Failed to build the inactive client report", log.Error(err))
+		return
+	}
+	if len(report.InactiveClients) == 0 {
+		s.logger.Debug("No inactive clients found", log.Int("clients", report.TotalClients))
+		return
+	}
+
+	s.notify(ctx, report)
+}
+
+// notify records the inactive clients found by a report in the logs and the audit trail, and alerts the
+// configured webhook.
+func (s *clientUsageService) notify(ctx context.Context, report *InactiveClientReport) {
+	appIDs := make([]string, 0, len(report.InactiveClients))
+	for _, client := range report.InactiveClients {
+		appIDs = append(appIDs, client.AppID)
+	}
+	s.logger.Warn("Found clients that have not been used for the inactive period",
+		log.Int("inactiveClients", len(appIDs)), log.Any("appIDs", appIDs))
+
+	if s.observabilitySvc != nil && s.observabilitySvc.IsEnabled() {
+		evt := event.NewEvent(sysContext.GetTraceID(ctx), string(event.EventTypeInactiveClientsDetected),
+			event.ComponentClientUsage).
+			WithTenantID(sysContext.GetTenantID(ctx)).
+			WithStatus(event.StatusSuccess).
+			WithData(event.DataKey.ApplicationIDs, appIDs)
+		s.observabilitySvc.PublishEvent(evt)
+	}
+
+	s.notifyWebhook(ctx, webhookEvent{
+		Event:     string(event.EventTypeInactiveClientsDetected),
+		Timestamp: report.GeneratedAt,
+		Report:    *report,
+	})
+}
+
+// notifyWebhook posts an inactive client alert to the configured webhook, if any. Delivery failures are logged.
+func (s *clientUsageService) notifyWebhook(ctx context.Context, payload webhookEvent) {
+	if s.webhookURL == "" {
+		return
+	}
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		s.logger.Error("Failed to marshal webhook payload", log.Error(err))
+		return
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.webhookURL, bytes.NewReader(body))
+	if err != nil {
+		s.logger.Error("Failed to create webhook request", log.Error(err))
+		return
+	}
+	req.Header.Set(serverconst.ContentTypeHeaderName, serverconst.ContentTypeJSON)
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		s.logger.Warn("Failed to deliver inactive client webhook", log.Error(err))
+		return
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		s.logger.Warn("Inactive client webhook returned an unexpected status", log.Int("status", resp.StatusCode))
+	}
+}
+
+// entityName returns the name of an application entity, or an empty string when it has none.
+func entityName(e *entityprovider.Entity) string {
+	var sysAttrs map[string]interface{}
+	if len(e.SystemAttributes) == 0 || json.Unmarshal(e.SystemAttributes, &sysAttrs) != nil {
+		return ""
+	}
+	name, _ := sysAttrs["name"].(string)
+	return name
+}
+
+// startScheduledReports runs an inactive client report at every interval. Each report holds a distributed
+// lock so that only one node of the deployment reports at a time.
+func startScheduledReports(
+	service *clientUsageService, lockManager distlock.LockManagerInterface, interval time.Duration,
+) {
+	if interval <= 0 {
+		return
+	}
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for range ticker.C {
+			runScheduledReport(context.Background(), service, lockManager)
+		}
+	}()
+}
+
+// runScheduledReport runs a single inactive client report. The report is skipped when another node holds the
+// report lock.
+func runScheduledReport(
+	ctx context.Context, service *clientUsageService, lockManager distlock.LockManagerInterface,
+) {
+	ran, err := lockManager.TryWithLock(ctx, scheduledReportLockName, func(ctx context.Context, _ int64) error {
+		service.reportInactiveClients(ctx)
+		return nil
+	})
+	if err != nil {
+		service.logger.Error("Failed to run scheduled inactive client report under the report lock", log.Error(err))
+		return
+	}
+	if !ran {
+		service.logger.Debug("Skipped scheduled inactive client report as another node is running it")
+	}
+}
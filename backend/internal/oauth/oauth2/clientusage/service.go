@@ -0,0 +1,234 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package clientusage
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/entityprovider"
+	sysContext "github.com/thunder-id/thunderid/internal/system/context"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	syshttp "github.com/thunder-id/thunderid/internal/system/http"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/internal/system/observability"
+)
+
+// ClientUsageServiceInterface defines the interface for the client usage service.
+type ClientUsageServiceInterface interface {
+	RecordTokenIssued(ctx context.Context, appID string)
+	RecordAuthorization(ctx context.Context, appID string)
+	GetUsage(ctx context.Context, appID string) (*ClientUsage, *serviceerror.ServiceError)
+	GetUsageList(ctx context.Context) (map[string]ClientUsage, *serviceerror.ServiceError)
+	Flush(ctx context.Context)
+}
+
+// clientUsageService is the default implementation of the ClientUsageServiceInterface. Usage is buffered in
+// memory and written to the store in batches, so that recording a use never adds a database write to the
+// token and authorization endpoints.
+type clientUsageService struct {
+	store            clientUsageStoreInterface
+	entityProvider   entityprovider.EntityProviderInterface
+	observabilitySvc observability.ObservabilityServiceInterface
+	httpClient       syshttp.HTTPClientInterface
+	webhookURL       string
+	inactivePeriod   time.Duration
+	deploymentID     string
+	now              func() time.Time
+	logger           *log.Logger
+
+	mu      sync.Mutex
+	pending map[usageKey]*ClientUsage
+	flushCh chan struct{}
+}
+
+// newClientUsageService creates a new instance of clientUsageService.
+func newClientUsageService(
+	store clientUsageStoreInterface,
+	entityProvider entityprovider.EntityProviderInterface,
+	observabilitySvc observability.ObservabilityServiceInterface,
+	httpClient syshttp.HTTPClientInterface,
+	webhookURL string,
+	inactivePeriod time.Duration,
+	deploymentID string,
+) *clientUsageService {
+	return &clientUsageService{
+		store:            store,
+		entityProvider:   entityProvider,
+		observabilitySvc: observabilitySvc,
+		httpClient:       httpClient,
+		webhookURL:       webhookURL,
+		inactivePeriod:   inactivePeriod,
+		deploymentID:     deploymentID,
+		now:              time.Now,
+		logger:           log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName)),
+		pending:          make(map[usageKey]*ClientUsage),
+		flushCh:          make(chan struct{}, 1),
+	}
+}
+
+// RecordTokenIssued records that a token was issued to the client of an application.
+func (s *clientUsageService) RecordTokenIssued(ctx context.Context, appID string) {
+	s.record(ctx, appID, func(usage *ClientUsage, now time.Time) {
+		usage.LastTokenIssuedAt = &now
+	})
+}
+
+// RecordAuthorization records that the client of an application initiated an authorization request.
+func (s *clientUsageService) RecordAuthorization(ctx context.Context, appID string) {
+	s.record(ctx, appID, func(usage *ClientUsage, now time.Time) {
+		usage.LastAuthorizedAt = &now
+	})
+}
+
+// record buffers a use of the client of an application. A write is requested ahead of the next interval
+// when the buffer grows large.
+func (s *clientUsageService) record(ctx context.Context, appID string, apply func(*ClientUsage, time.Time)) {
+	if appID == "" {
+		return
+	}
+
+	now := s.now().UTC().Truncate(time.Second)
+	key := usageKey{deploymentID: sysContext.ScopeDeploymentID(ctx, s.deploymentID), appID: appID}
+
+	s.mu.Lock()
+	usage, ok := s.pending[key]
+	if !ok {
+		usage = &ClientUsage{AppID: appID, TrackedSince: now}
+		s.pending[key] = usage
+	}
+	apply(usage, now)
+	full := len(s.pending) >= maxPendingUsages
+	s.mu.Unlock()
+
+	if full {
+		select {
+		case s.flushCh <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// GetUsage retrieves the usage of the client of an application, including usage not yet written to the store.
+// It returns nil when no usage has been tracked for the application.
+func (s *clientUsageService) GetUsage(ctx context.Context, appID string) (*ClientUsage, *serviceerror.ServiceError) {
+	usage, err := s.store.GetUsage(ctx, appID)
+	if err != nil {
+		s.logger.Error("Failed to retrieve client usage", log.String("appID", appID), log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+
+	key := usageKey{deploymentID: sysContext.ScopeDeploymentID(ctx, s.deploymentID), appID: appID}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return mergeUsage(usage, s.pending[key]), nil
+}
+
+// GetUsageList retrieves the usage of the clients of all tracked applications, keyed by application ID,
+// including usage not yet written to the store.
+func (s *clientUsageService) GetUsageList(
+	ctx context.Context) (map[string]ClientUsage, *serviceerror.ServiceError) {
+	usages, err := s.store.GetUsageList(ctx)
+	if err != nil {
+		s.logger.Error("Failed to list client usage", log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+
+	usageMap := make(map[string]ClientUsage, len(usages))
+	for i := range usages {
+		usageMap[usages[i].AppID] = usages[i]
+	}
+
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for key, pending := range s.pending {
+		if key.deploymentID != deploymentID {
+			continue
+		}
+		var stored *ClientUsage
+		if usage, ok := usageMap[key.appID]; ok {
+			stored = &usage
+		}
+		usageMap[key.appID] = *mergeUsage(stored, pending)
+	}
+	return usageMap, nil
+}
+
+// Flush writes the buffered usage to the store. Usage that cannot be written is dropped, since a later use
+// records it again.
+func (s *clientUsageService) Flush(ctx context.Context) {
+	s.mu.Lock()
+	pending := s.pending
+	s.pending = make(map[usageKey]*ClientUsage)
+	s.mu.Unlock()
+
+	failed := 0
+	for key, usage := range pending {
+		if err := s.store.RecordUsage(ctx, key.deploymentID, *usage); err != nil {
+			s.logger.Debug("Failed to record client usage", log.String("appID", key.appID), log.Error(err))
+			failed++
+		}
+	}
+	if failed > 0 {
+		s.logger.Warn("Failed to record the usage of some clients", log.Int("failed", failed),
+			log.Int("total", len(pending)))
+	}
+}
+
+// mergeUsage combines the stored usage of an application with its buffered usage, keeping the latest times.
+func mergeUsage(stored, pending *ClientUsage) *ClientUsage {
+	if pending == nil {
+		return stored
+	}
+	if stored == nil {
+		merged := *pending
+		return &merged
+	}
+
+	merged := *stored
+	merged.LastTokenIssuedAt = latest(stored.LastTokenIssuedAt, pending.LastTokenIssuedAt)
+	merged.LastAuthorizedAt = latest(stored.LastAuthorizedAt, pending.LastAuthorizedAt)
+	return &merged
+}
+
+// latest returns the later of two optional times.
+func latest(a, b *time.Time) *time.Time {
+	if a == nil || (b != nil && b.After(*a)) {
+		return b
+	}
+	return a
+}
+
+// startFlushing writes the buffered usage at every interval, and whenever the buffer grows large.
+func startFlushing(service *clientUsageService, interval time.Duration) {
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+			case <-service.flushCh:
+			}
+			service.Flush(context.Background())
+		}
+	}()
+}
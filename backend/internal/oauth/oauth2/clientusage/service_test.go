@@ -0,0 +1,267 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package clientusage
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/entityprovider"
+	"github.com/thunder-id/thunderid/internal/system/config"
+	sysContext "github.com/thunder-id/thunderid/internal/system/context"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/observability/event"
+	"github.com/thunder-id/thunderid/tests/mocks/entityprovidermock"
+	"github.com/thunder-id/thunderid/tests/mocks/httpmock"
+	"github.com/thunder-id/thunderid/tests/mocks/observability/observabilitymock"
+)
+
+const (
+	testDeploymentID = "deployment-1"
+	testAppID        = "app-1"
+	testWebhook      = "https://hooks.example.com/inactive-clients"
+)
+
+type ClientUsageServiceTestSuite struct {
+	suite.Suite
+	mockStore          *clientUsageStoreInterfaceMock
+	mockEntityProvider *entityprovidermock.EntityProviderInterfaceMock
+	mockObservability  *observabilitymock.ObservabilityServiceInterfaceMock
+	mockHTTPClient     *httpmock.HTTPClientInterfaceMock
+	service            *clientUsageService
+	events             []*event.Event
+	now                time.Time
+}
+
+func TestClientUsageServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(ClientUsageServiceTestSuite))
+}
+
+func (suite *ClientUsageServiceTestSuite) SetupTest() {
+	_ = config.InitializeServerRuntime("", &config.Config{})
+
+	suite.mockStore = newClientUsageStoreInterfaceMock(suite.T())
+	suite.mockEntityProvider = entityprovidermock.NewEntityProviderInterfaceMock(suite.T())
+	suite.mockObservability = observabilitymock.NewObservabilityServiceInterfaceMock(suite.T())
+	suite.mockHTTPClient = httpmock.NewHTTPClientInterfaceMock(suite.T())
+	suite.service = newClientUsageService(suite.mockStore, suite.mockEntityProvider, suite.mockObservability,
+		suite.mockHTTPClient, "", 90*24*time.Hour, testDeploymentID)
+	suite.now = time.Date(2026, 6, 1, 12, 0, 0, 0, time.UTC)
+	suite.service.now = func() time.Time { return suite.now }
+
+	suite.events = nil
+	suite.mockObservability.On("IsEnabled").Return(true).Maybe()
+	suite.mockObservability.On("PublishEvent", mock.Anything).Run(func(args mock.Arguments) {
+		suite.events = append(suite.events, args.Get(0).(*event.Event))
+	}).Maybe()
+}
+
+func (suite *ClientUsageServiceTestSuite) TearDownTest() {
+	config.ResetServerRuntime()
+}
+
+// daysAgo returns the time the given number of days before the current test time.
+func (suite *ClientUsageServiceTestSuite) daysAgo(days int) *time.Time {
+	t := suite.now.AddDate(0, 0, -days)
+	return &t
+}
+
+// appEntity returns an application entity with the given ID and name.
+func appEntity(id, name string) entityprovider.Entity {
+	sysAttrs, _ := json.Marshal(map[string]interface{}{"name": name})
+	return entityprovider.Entity{ID: id, Category: entityprovider.EntityCategoryApp, SystemAttributes: sysAttrs}
+}
+
+func (suite *ClientUsageServiceTestSuite) TestFlush_WritesBufferedUsageOnce() {
+	suite.service.RecordAuthorization(context.Background(), testAppID)
+	suite.now = suite.now.Add(time.Minute)
+	suite.service.RecordTokenIssued(context.Background(), testAppID)
+
+	var recorded ClientUsage
+	suite.mockStore.On("RecordUsage", mock.Anything, testDeploymentID, mock.Anything).Run(func(args mock.Arguments) {
+		recorded = args.Get(2).(ClientUsage)
+	}).Return(nil).Once()
+
+	suite.service.Flush(context.Background())
+	suite.service.Flush(context.Background())
+
+	suite.Equal(testAppID, recorded.AppID)
+	suite.Require().NotNil(recorded.LastAuthorizedAt)
+	suite.Require().NotNil(recorded.LastTokenIssuedAt)
+	suite.Equal(suite.now.Add(-time.Minute), *recorded.LastAuthorizedAt)
+	suite.Equal(suite.now, *recorded.LastTokenIssuedAt)
+}
+
+func (suite *ClientUsageServiceTestSuite) TestFlush_KeepsUsageOfTenantsApart() {
+	tenantCtx := sysContext.WithTenantID(context.Background(), "tenant-1")
+	suite.service.RecordTokenIssued(context.Background(), testAppID)
+	suite.service.RecordTokenIssued(tenantCtx, testAppID)
+
+	suite.mockStore.On("RecordUsage", mock.Anything, testDeploymentID, mock.Anything).Return(nil).Once()
+	suite.mockStore.On("RecordUsage", mock.Anything, sysContext.ScopeDeploymentID(tenantCtx, testDeploymentID),
+		mock.Anything).Return(nil).Once()
+
+	suite.service.Flush(context.Background())
+}
+
+func (suite *ClientUsageServiceTestSuite) TestRecord_IgnoresEmptyAppID() {
+	suite.service.RecordTokenIssued(context.Background(), "")
+
+	suite.Empty(suite.service.pending)
+}
+
+func (suite *ClientUsageServiceTestSuite) TestRecord_RequestsFlushWhenBufferIsFull() {
+	for i := range maxPendingUsages {
+		suite.service.RecordTokenIssued(context.Background(), fmt.Sprintf("app-%d", i))
+	}
+
+	suite.Len(suite.service.flushCh, 1)
+}
+
+func (suite *ClientUsageServiceTestSuite) TestGetUsage_MergesBufferedUsage() {
+	suite.mockStore.On("GetUsage", mock.Anything, testAppID).Return(&ClientUsage{
+		AppID:             testAppID,
+		LastTokenIssuedAt: suite.daysAgo(10),
+		LastAuthorizedAt:  suite.daysAgo(5),
+		TrackedSince:      *suite.daysAgo(100),
+	}, nil)
+	suite.service.RecordTokenIssued(context.Background(), testAppID)
+
+	usage, svcErr := suite.service.GetUsage(context.Background(), testAppID)
+
+	suite.Nil(svcErr)
+	suite.Require().NotNil(usage)
+	suite.Equal(suite.now, *usage.LastTokenIssuedAt)
+	suite.Equal(*suite.daysAgo(5), *usage.LastAuthorizedAt)
+	suite.Equal(*suite.daysAgo(100), usage.TrackedSince)
+}
+
+func (suite *ClientUsageServiceTestSuite) TestGetUsage_NotTracked() {
+	suite.mockStore.On("GetUsage", mock.Anything, testAppID).Return(nil, nil)
+
+	usage, svcErr := suite.service.GetUsage(context.Background(), testAppID)
+
+	suite.Nil(svcErr)
+	suite.Nil(usage)
+}
+
+func (suite *ClientUsageServiceTestSuite) TestGetUsage_StoreError() {
+	suite.mockStore.On("GetUsage", mock.Anything, testAppID).Return(nil, errors.New("db down"))
+
+	usage, svcErr := suite.service.GetUsage(context.Background(), testAppID)
+
+	suite.Nil(usage)
+	suite.Require().NotNil(svcErr)
+	suite.Equal(serviceerror.InternalServerError.Code, svcErr.Code)
+}
+
+func (suite *ClientUsageServiceTestSuite) TestGetUsageList_MergesBufferedUsage() {
+	suite.mockStore.On("GetUsageList", mock.Anything).Return([]ClientUsage{
+		{AppID: testAppID, LastTokenIssuedAt: suite.daysAgo(10), TrackedSince: *suite.daysAgo(100)},
+	}, nil)
+	suite.service.RecordAuthorization(context.Background(), "app-2")
+	suite.service.RecordAuthorization(sysContext.WithTenantID(context.Background(), "tenant-1"), "app-3")
+
+	usages, svcErr := suite.service.GetUsageList(context.Background())
+
+	suite.Nil(svcErr)
+	suite.Len(usages, 2)
+	suite.Equal(*suite.daysAgo(10), *usages[testAppID].LastTokenIssuedAt)
+	suite.Equal(suite.now, *usages["app-2"].LastAuthorizedAt)
+}
+
+func (suite *ClientUsageServiceTestSuite) TestIsInactiveSince() {
+	since := *suite.daysAgo(90)
+
+	suite.True((&ClientUsage{LastTokenIssuedAt: suite.daysAgo(91), TrackedSince: *suite.daysAgo(200)}).
+		IsInactiveSince(since))
+	suite.False((&ClientUsage{LastTokenIssuedAt: suite.daysAgo(91), LastAuthorizedAt: suite.daysAgo(1)}).
+		IsInactiveSince(since))
+	suite.True((&ClientUsage{TrackedSince: *suite.daysAgo(91)}).IsInactiveSince(since))
+	suite.False((&ClientUsage{TrackedSince: *suite.daysAgo(10)}).IsInactiveSince(since))
+}
+
+func (suite *ClientUsageServiceTestSuite) TestReportInactiveClients_AlertsInactiveClients() {
+	suite.service.webhookURL = testWebhook
+	suite.mockEntityProvider.On("GetEntityList", entityprovider.EntityCategoryApp, mock.Anything, 0,
+		mock.Anything).Return([]entityprovider.Entity{
+		appEntity("app-active", "Active"),
+		appEntity("app-inactive", "Inactive"),
+		appEntity("app-never-used", "Never Used"),
+		appEntity("app-new", "New"),
+	}, nil)
+	suite.mockStore.On("GetUsageList", mock.Anything).Return([]ClientUsage{
+		{AppID: "app-active", LastTokenIssuedAt: suite.daysAgo(1), TrackedSince: *suite.daysAgo(200)},
+		{AppID: "app-inactive", LastAuthorizedAt: suite.daysAgo(120), TrackedSince: *suite.daysAgo(200)},
+		{AppID: "app-never-used", TrackedSince: *suite.daysAgo(100)},
+	}, nil)
+	suite.mockStore.On("StartTracking", mock.Anything, "app-new", suite.now).Return(nil).Once()
+	var payload webhookEvent
+	suite.mockHTTPClient.On("Do", mock.MatchedBy(func(req *http.Request) bool {
+		body, _ := io.ReadAll(req.Body)
+		return req.URL.String() == testWebhook && json.Unmarshal(body, &payload) == nil
+	})).Return(&http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(""))}, nil).Once()
+
+	suite.service.reportInactiveClients(context.Background())
+
+	suite.Require().Len(suite.events, 1)
+	suite.Equal(string(event.EventTypeInactiveClientsDetected), suite.events[0].Type)
+	suite.Equal([]string{"app-inactive", "app-never-used"}, suite.events[0].Data[event.DataKey.ApplicationIDs])
+	suite.Equal(string(event.EventTypeInactiveClientsDetected), payload.Event)
+	suite.Equal(4, payload.Report.TotalClients)
+	suite.Require().Len(payload.Report.InactiveClients, 2)
+	suite.Equal("Inactive", payload.Report.InactiveClients[0].Name)
+	suite.Equal(*suite.daysAgo(120), *payload.Report.InactiveClients[0].LastUsedAt)
+	suite.Nil(payload.Report.InactiveClients[1].LastUsedAt)
+}
+
+func (suite *ClientUsageServiceTestSuite) TestReportInactiveClients_NoInactiveClients() {
+	suite.service.webhookURL = testWebhook
+	suite.mockEntityProvider.On("GetEntityList", entityprovider.EntityCategoryApp, mock.Anything, 0,
+		mock.Anything).Return([]entityprovider.Entity{appEntity("app-active", "Active")}, nil)
+	suite.mockStore.On("GetUsageList", mock.Anything).Return([]ClientUsage{
+		{AppID: "app-active", LastTokenIssuedAt: suite.daysAgo(1), TrackedSince: *suite.daysAgo(200)},
+	}, nil)
+
+	suite.service.reportInactiveClients(context.Background())
+
+	suite.Empty(suite.events)
+	suite.mockHTTPClient.AssertNotCalled(suite.T(), "Do", mock.Anything)
+}
+
+func (suite *ClientUsageServiceTestSuite) TestReportInactiveClients_WritesBufferedUsageFirst() {
+	suite.service.RecordTokenIssued(context.Background(), testAppID)
+	suite.mockStore.On("RecordUsage", mock.Anything, testDeploymentID, mock.Anything).Return(nil).Once()
+	suite.mockEntityProvider.On("GetEntityList", entityprovider.EntityCategoryApp, mock.Anything, 0,
+		mock.Anything).Return(nil, &entityprovider.EntityProviderError{Message: "failed"})
+
+	suite.service.reportInactiveClients(context.Background())
+
+	suite.Empty(suite.events)
+}
@@ -0,0 +1,165 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package clientusage
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+	sysContext "github.com/thunder-id/thunderid/internal/system/context"
+	"github.com/thunder-id/thunderid/internal/system/database/provider"
+	dbutils "github.com/thunder-id/thunderid/internal/system/database/utils"
+)
+
+var getDBProvider = provider.GetDBProvider
+
+// clientUsageStoreInterface defines the interface for client usage store operations.
+type clientUsageStoreInterface interface {
+	RecordUsage(ctx context.Context, deploymentID string, usage ClientUsage) error
+	StartTracking(ctx context.Context, appID string, trackedSince time.Time) error
+	GetUsage(ctx context.Context, appID string) (*ClientUsage, error)
+	GetUsageList(ctx context.Context) ([]ClientUsage, error)
+}
+
+// clientUsageStore is the runtime database backed implementation of clientUsageStoreInterface.
+type clientUsageStore struct {
+	dbProvider   provider.DBProviderInterface
+	deploymentID string
+}
+
+// newClientUsageStore creates a new instance of clientUsageStore.
+func newClientUsageStore() clientUsageStoreInterface {
+	return &clientUsageStore{
+		dbProvider:   getDBProvider(),
+		deploymentID: config.GetServerRuntime().Config.Server.Identifier,
+	}
+}
+
+// RecordUsage records the usage of an application in the given deployment. Recorded times are only moved
+// forward, so that usage written out of order does not hide a later use.
+func (s *clientUsageStore) RecordUsage(ctx context.Context, deploymentID string, usage ClientUsage) error {
+	dbClient, err := s.dbProvider.GetRuntimeDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	if _, err := dbClient.ExecuteContext(ctx, queryRecordUsage, usage.AppID, nullableTime(usage.LastTokenIssuedAt),
+		nullableTime(usage.LastAuthorizedAt), usage.TrackedSince, deploymentID); err != nil {
+		return fmt.Errorf("failed to execute query: %w", err)
+	}
+	return nil
+}
+
+// StartTracking starts tracking the usage of an application that has no usage recorded.
+func (s *clientUsageStore) StartTracking(ctx context.Context, appID string, trackedSince time.Time) error {
+	dbClient, err := s.dbProvider.GetRuntimeDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	if _, err := dbClient.ExecuteContext(ctx, queryStartTracking, appID, trackedSince,
+		sysContext.ScopeDeploymentID(ctx, s.deploymentID)); err != nil {
+		return fmt.Errorf("failed to execute query: %w", err)
+	}
+	return nil
+}
+
+// GetUsage retrieves the recorded usage of an application. It returns nil when no usage is recorded.
+func (s *clientUsageStore) GetUsage(ctx context.Context, appID string) (*ClientUsage, error) {
+	dbClient, err := s.dbProvider.GetRuntimeDBClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	results, err := dbClient.QueryContext(ctx, queryGetUsage, appID, sysContext.ScopeDeploymentID(ctx, s.deploymentID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+	if len(results) == 0 {
+		return nil, nil
+	}
+
+	return buildClientUsageFromResultRow(results[0])
+}
+
+// GetUsageList retrieves the recorded usage of all applications.
+func (s *clientUsageStore) GetUsageList(ctx context.Context) ([]ClientUsage, error) {
+	dbClient, err := s.dbProvider.GetRuntimeDBClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	results, err := dbClient.QueryContext(ctx, queryGetUsageList, sysContext.ScopeDeploymentID(ctx, s.deploymentID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	usages := make([]ClientUsage, 0, len(results))
+	for _, row := range results {
+		usage, err := buildClientUsageFromResultRow(row)
+		if err != nil {
+			return nil, err
+		}
+		usages = append(usages, *usage)
+	}
+	return usages, nil
+}
+
+// buildClientUsageFromResultRow constructs a ClientUsage from a database result row.
+func buildClientUsageFromResultRow(row map[string]interface{}) (*ClientUsage, error) {
+	appID, ok := row["app_id"].(string)
+	if !ok {
+		return nil, fmt.Errorf("failed to parse app_id as string")
+	}
+
+	trackedSince, err := dbutils.ParseTimeField(row["tracked_since"], "tracked_since")
+	if err != nil {
+		return nil, err
+	}
+
+	usage := &ClientUsage{
+		AppID:        appID,
+		TrackedSince: trackedSince,
+	}
+	if row["last_token_issued_at"] != nil {
+		lastTokenIssuedAt, err := dbutils.ParseTimeField(row["last_token_issued_at"], "last_token_issued_at")
+		if err != nil {
+			return nil, err
+		}
+		usage.LastTokenIssuedAt = &lastTokenIssuedAt
+	}
+	if row["last_authorized_at"] != nil {
+		lastAuthorizedAt, err := dbutils.ParseTimeField(row["last_authorized_at"], "last_authorized_at")
+		if err != nil {
+			return nil, err
+		}
+		usage.LastAuthorizedAt = &lastAuthorizedAt
+	}
+	return usage, nil
+}
+
+// nullableTime returns the query argument of an optional time.
+func nullableTime(t *time.Time) interface{} {
+	if t == nil {
+		return nil
+	}
+	return *t
+}
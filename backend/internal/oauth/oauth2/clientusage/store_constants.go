@@ -0,0 +1,63 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package clientusage
+
+import dbmodel "github.com/thunder-id/thunderid/internal/system/database/model"
+
+var (
+	// queryRecordUsage records the usage of an application, keeping the latest of the recorded and the
+	// given times.
+	queryRecordUsage = dbmodel.DBQuery{
+		ID: "CUQ-USAGE_MGT-01",
+		Query: `INSERT INTO "OAUTH_CLIENT_USAGE" (APP_ID, LAST_TOKEN_ISSUED_AT, LAST_AUTHORIZED_AT, ` +
+			`TRACKED_SINCE, DEPLOYMENT_ID) VALUES ($1, $2, $3, $4, $5) ON CONFLICT (APP_ID, DEPLOYMENT_ID) ` +
+			`DO UPDATE SET LAST_TOKEN_ISSUED_AT = GREATEST("OAUTH_CLIENT_USAGE".LAST_TOKEN_ISSUED_AT, ` +
+			`EXCLUDED.LAST_TOKEN_ISSUED_AT), LAST_AUTHORIZED_AT = GREATEST("OAUTH_CLIENT_USAGE".LAST_AUTHORIZED_AT, ` +
+			`EXCLUDED.LAST_AUTHORIZED_AT)`,
+		SQLiteQuery: `INSERT INTO "OAUTH_CLIENT_USAGE" (APP_ID, LAST_TOKEN_ISSUED_AT, LAST_AUTHORIZED_AT, ` +
+			`TRACKED_SINCE, DEPLOYMENT_ID) VALUES ($1, $2, $3, $4, $5) ON CONFLICT (APP_ID, DEPLOYMENT_ID) ` +
+			`DO UPDATE SET LAST_TOKEN_ISSUED_AT = MAX(` +
+			`COALESCE("OAUTH_CLIENT_USAGE".LAST_TOKEN_ISSUED_AT, EXCLUDED.LAST_TOKEN_ISSUED_AT), ` +
+			`COALESCE(EXCLUDED.LAST_TOKEN_ISSUED_AT, "OAUTH_CLIENT_USAGE".LAST_TOKEN_ISSUED_AT)), ` +
+			`LAST_AUTHORIZED_AT = MAX(` +
+			`COALESCE("OAUTH_CLIENT_USAGE".LAST_AUTHORIZED_AT, EXCLUDED.LAST_AUTHORIZED_AT), ` +
+			`COALESCE(EXCLUDED.LAST_AUTHORIZED_AT, "OAUTH_CLIENT_USAGE".LAST_AUTHORIZED_AT))`,
+	}
+
+	// queryStartTracking starts tracking the usage of an application that has no usage recorded.
+	queryStartTracking = dbmodel.DBQuery{
+		ID: "CUQ-USAGE_MGT-02",
+		Query: `INSERT INTO "OAUTH_CLIENT_USAGE" (APP_ID, TRACKED_SINCE, DEPLOYMENT_ID) VALUES ($1, $2, $3) ` +
+			`ON CONFLICT (APP_ID, DEPLOYMENT_ID) DO NOTHING`,
+	}
+
+	// queryGetUsage retrieves the recorded usage of an application.
+	queryGetUsage = dbmodel.DBQuery{
+		ID: "CUQ-USAGE_MGT-03",
+		Query: `SELECT APP_ID, LAST_TOKEN_ISSUED_AT, LAST_AUTHORIZED_AT, TRACKED_SINCE FROM "OAUTH_CLIENT_USAGE" ` +
+			`WHERE APP_ID = $1 AND DEPLOYMENT_ID = $2`,
+	}
+
+	// queryGetUsageList retrieves the recorded usage of all applications.
+	queryGetUsageList = dbmodel.DBQuery{
+		ID: "CUQ-USAGE_MGT-04",
+		Query: `SELECT APP_ID, LAST_TOKEN_ISSUED_AT, LAST_AUTHORIZED_AT, TRACKED_SINCE FROM "OAUTH_CLIENT_USAGE" ` +
+			`WHERE DEPLOYMENT_ID = $1`,
+	}
+)
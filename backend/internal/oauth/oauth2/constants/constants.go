@@ -21,46 +21,52 @@ package constants
 
 import (
 	"errors"
+	"slices"
+	"sync"
 
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/model"
 )
 
 // OAuth2 request parameters.
 const (
-	RequestParamGrantType           string = "grant_type"
-	RequestParamClientID            string = "client_id"
-	RequestParamClientSecret        string = "client_secret"
-	RequestParamClientAssertion     string = "client_assertion"
-	RequestParamClientAssertionType string = "client_assertion_type"
-	RequestParamRedirectURI         string = "redirect_uri"
-	RequestParamUsername            string = "username"
-	RequestParamPassword            string = "password"
-	RequestParamScope               string = "scope"
-	RequestParamCode                string = "code"
-	RequestParamCodeVerifier        string = "code_verifier"
-	RequestParamCodeChallenge       string = "code_challenge"
-	RequestParamCodeChallengeMethod string = "code_challenge_method"
-	RequestParamRefreshToken        string = "refresh_token"
-	RequestParamResponseType        string = "response_type"
-	RequestParamState               string = "state"
-	RequestParamIss                 string = "iss"
-	RequestParamResource            string = "resource"
-	RequestParamError               string = "error"
-	RequestParamErrorDescription    string = "error_description"
-	RequestParamToken               string = "token"
-	RequestParamTokenTypeHint       string = "token_type_hint"
-	RequestParamSubjectToken        string = "subject_token"
-	RequestParamSubjectTokenType    string = "subject_token_type"
-	RequestParamActorToken          string = "actor_token"
-	RequestParamActorTokenType      string = "actor_token_type"
-	RequestParamRequestedTokenType  string = "requested_token_type"
-	RequestParamAudience            string = "audience"
-	RequestParamClaims              string = "claims"
-	RequestParamClaimsLocales       string = "claims_locales"
-	RequestParamNonce               string = "nonce"
-	RequestParamPrompt              string = "prompt"
-	RequestParamRequestURI          string = "request_uri"
-	RequestParamAcrValues           string = "acr_values"
+	RequestParamGrantType             string = "grant_type"
+	RequestParamClientID              string = "client_id"
+	RequestParamClientSecret          string = "client_secret"
+	RequestParamClientAssertion       string = "client_assertion"
+	RequestParamClientAssertionType   string = "client_assertion_type"
+	RequestParamRedirectURI           string = "redirect_uri"
+	RequestParamUsername              string = "username"
+	RequestParamPassword              string = "password"
+	RequestParamScope                 string = "scope"
+	RequestParamCode                  string = "code"
+	RequestParamCodeVerifier          string = "code_verifier"
+	RequestParamCodeChallenge         string = "code_challenge"
+	RequestParamCodeChallengeMethod   string = "code_challenge_method"
+	RequestParamRefreshToken          string = "refresh_token"
+	RequestParamResponseType          string = "response_type"
+	RequestParamState                 string = "state"
+	RequestParamIss                   string = "iss"
+	RequestParamResource              string = "resource"
+	RequestParamError                 string = "error"
+	RequestParamErrorDescription      string = "error_description"
+	RequestParamToken                 string = "token"
+	RequestParamTokenTypeHint         string = "token_type_hint"
+	RequestParamSubjectToken          string = "subject_token"
+	RequestParamSubjectTokenType      string = "subject_token_type"
+	RequestParamActorToken            string = "actor_token"
+	RequestParamActorTokenType        string = "actor_token_type"
+	RequestParamRequestedTokenType    string = "requested_token_type"
+	RequestParamAudience              string = "audience"
+	RequestParamClaims                string = "claims"
+	RequestParamClaimsLocales         string = "claims_locales"
+	RequestParamNonce                 string = "nonce"
+	RequestParamPrompt                string = "prompt"
+	RequestParamRequestURI            string = "request_uri"
+	RequestParamRequest               string = "request"
+	RequestParamAcrValues             string = "acr_values"
+	RequestParamIDTokenHint           string = "id_token_hint"
+	RequestParamPostLogoutRedirectURI string = "post_logout_redirect_uri"
+	RequestParamDeviceCode            string = "device_code"
 )
 
 // OIDC prompt parameter values.
@@ -111,6 +117,11 @@ const (
 	OAuth2LogoutEndpoint        string = "/oauth2/logout"
 	OAuth2DCREndpoint           string = "/oauth2/dcr/register"
 	OAuth2PAREndpoint           string = "/oauth2/par"
+	OAuth2RolesEndpoint         string = "/oauth2/roles"
+
+	OAuth2DeviceAuthorizationEndpoint string = "/oauth2/device_authorization"
+
+	OAuth2CredentialCheckEndpoint string = "/oauth2/credential-check"
 )
 
 // GrantType defines a type for OAuth2 grant types.
@@ -125,6 +136,8 @@ const (
 	GrantTypeRefreshToken GrantType = "refresh_token"
 	// GrantTypeTokenExchange represents the token exchange grant type.
 	GrantTypeTokenExchange GrantType = "urn:ietf:params:oauth:grant-type:token-exchange" //nolint:gosec
+	// GrantTypeDeviceCode represents the device authorization grant type (RFC 8628).
+	GrantTypeDeviceCode GrantType = "urn:ietf:params:oauth:grant-type:device_code"
 )
 
 // supportedGrantTypes is the single source of truth for all supported grant types.
@@ -133,8 +146,15 @@ var supportedGrantTypes = []GrantType{
 	GrantTypeClientCredentials,
 	GrantTypeRefreshToken,
 	GrantTypeTokenExchange,
+	GrantTypeDeviceCode,
 }
 
+// customGrantTypes holds the extension grant types contributed by custom grant handlers.
+var (
+	customGrantTypes   []GrantType
+	customGrantTypesMu sync.RWMutex
+)
+
 // IsValid checks if the GrantType is valid.
 func (gt GrantType) IsValid() bool {
 	for _, valid := range supportedGrantTypes {
@@ -142,7 +162,31 @@ func (gt GrantType) IsValid() bool {
 			return true
 		}
 	}
-	return false
+	return gt.IsCustom()
+}
+
+// IsCustom checks if the GrantType is an extension grant type registered by a custom grant handler.
+func (gt GrantType) IsCustom() bool {
+	customGrantTypesMu.RLock()
+	defer customGrantTypesMu.RUnlock()
+	return slices.Contains(customGrantTypes, gt)
+}
+
+// RegisterCustomGrantType adds an extension grant type to the set of supported grant types.
+func RegisterCustomGrantType(gt GrantType) {
+	customGrantTypesMu.Lock()
+	defer customGrantTypesMu.Unlock()
+	if !slices.Contains(customGrantTypes, gt) {
+		customGrantTypes = append(customGrantTypes, gt)
+	}
+}
+
+// ClearCustomGrantTypes removes all registered extension grant types.
+// This is primarily for testing purposes.
+func ClearCustomGrantTypes() {
+	customGrantTypesMu.Lock()
+	defer customGrantTypesMu.Unlock()
+	customGrantTypes = nil
 }
 
 // ResponseType defines a type for OAuth2 response types.
@@ -207,6 +251,7 @@ func (tam TokenEndpointAuthMethod) IsValid() bool {
 // OAuth2 token types.
 const (
 	TokenTypeBearer = "Bearer"
+	TokenTypeDPoP   = "DPoP"
 )
 
 // TokenTypeIdentifier defines a type for RFC 8693 token type identifiers.
@@ -244,19 +289,27 @@ func (tti TokenTypeIdentifier) IsValid() bool {
 
 // OAuth2 error codes.
 const (
-	ErrorInvalidRequest           string = "invalid_request"
-	ErrorInvalidClient            string = "invalid_client"
-	ErrorInvalidGrant             string = "invalid_grant"
-	ErrorUnauthorizedClient       string = "unauthorized_client"
-	ErrorUnsupportedGrantType     string = "unsupported_grant_type"
-	ErrorInvalidScope             string = "invalid_scope"
-	ErrorInvalidTarget            string = "invalid_target"
-	ErrorServerError              string = "server_error"
-	ErrorUnsupportedResponseType  string = "unsupported_response_type"
-	ErrorAccessDenied             string = "access_denied"
-	ErrorLoginRequired            string = "login_required"
-	ErrorConsentRequired          string = "consent_required"
-	ErrorAccountSelectionRequired string = "account_selection_required"
+	ErrorInvalidRequest                  string = "invalid_request"
+	ErrorInvalidClient                   string = "invalid_client"
+	ErrorInvalidGrant                    string = "invalid_grant"
+	ErrorUnauthorizedClient              string = "unauthorized_client"
+	ErrorUnsupportedGrantType            string = "unsupported_grant_type"
+	ErrorInvalidScope                    string = "invalid_scope"
+	ErrorInvalidTarget                   string = "invalid_target"
+	ErrorServerError                     string = "server_error"
+	ErrorUnsupportedResponseType         string = "unsupported_response_type"
+	ErrorAccessDenied                    string = "access_denied"
+	ErrorLoginRequired                   string = "login_required"
+	ErrorConsentRequired                 string = "consent_required"
+	ErrorAccountSelectionRequired        string = "account_selection_required"
+	ErrorUnauthorizedIDP                 string = "unauthorized_idp"
+	ErrorInsufficientAuthenticationLevel string = "insufficient_authentication_level"
+	ErrorAuthorizationPending            string = "authorization_pending"
+	ErrorSlowDown                        string = "slow_down"
+	ErrorExpiredToken                    string = "expired_token"
+	ErrorInvalidRequestObject            string = "invalid_request_object"
+	ErrorInvalidDPoPProof                string = "invalid_dpop_proof"
+	ErrorUseDPoPNonce                    string = "use_dpop_nonce"
 )
 
 // UnSupportedGrantTypeError is returned when an unsupported grant type is requested.
@@ -319,8 +372,16 @@ const (
 	ClaimClaimsRequest      string = "claims_req"
 	ClaimClaimsLocales      string = "claims_locales"
 	ClaimCompletedAuthClass string = "completed_auth_class"
+	ClaimTenantID           string = "tenant_id"
+	ClaimRolesRef           string = "roles_ref"
+	ClaimSubjectType        string = "sub_type"
+	ClaimServiceID          string = "service_id"
+	ClaimConfirmation       string = "cnf"
 )
 
+// PrincipalTypeService is the subject type of tokens issued to machine clients acting as a service identity.
+const PrincipalTypeService string = "service"
+
 // OIDC subject types.
 const (
 	SubjectTypePublic string = "public"
@@ -363,9 +424,15 @@ func GetSupportedResponseTypes() []string {
 
 // GetSupportedGrantTypes returns all supported OAuth2 grant types.
 func GetSupportedGrantTypes() []string {
-	result := make([]string, len(supportedGrantTypes))
-	for i, gt := range supportedGrantTypes {
-		result[i] = string(gt)
+	customGrantTypesMu.RLock()
+	defer customGrantTypesMu.RUnlock()
+
+	result := make([]string, 0, len(supportedGrantTypes)+len(customGrantTypes))
+	for _, gt := range supportedGrantTypes {
+		result = append(result, string(gt))
+	}
+	for _, gt := range customGrantTypes {
+		result = append(result, string(gt))
 	}
 	return result
 }
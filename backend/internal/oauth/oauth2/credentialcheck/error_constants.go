@@ -0,0 +1,87 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package credentialcheck
+
+import (
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/i18n/core"
+)
+
+// errorCodeTooManyRequests is returned when a client exceeds the lookup rate for an IP address.
+const errorCodeTooManyRequests = "too_many_requests"
+
+// Credential check service error constants.
+var (
+	// errorCapabilityNotGranted is returned when the client is not allowed to look up credential types.
+	errorCapabilityNotGranted = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: constants.ErrorUnauthorizedClient,
+		Error: core.I18nMessage{
+			Key:          "error.credentialcheckservice.capability_not_granted",
+			DefaultValue: "Unauthorized client",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.credentialcheckservice.capability_not_granted_description",
+			DefaultValue: "The client is not allowed to look up available credential types",
+		},
+	}
+
+	// errorMissingIdentifier is returned when the request does not carry an identifier.
+	errorMissingIdentifier = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: constants.ErrorInvalidRequest,
+		Error: core.I18nMessage{
+			Key:          "error.credentialcheckservice.missing_identifier",
+			DefaultValue: "Missing identifier",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.credentialcheckservice.missing_identifier_description",
+			DefaultValue: "The identifier parameter is required",
+		},
+	}
+
+	// errorUnsupportedIdentifierAttribute is returned when the identifier attribute is not one users sign in with.
+	errorUnsupportedIdentifierAttribute = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: constants.ErrorInvalidRequest,
+		Error: core.I18nMessage{
+			Key:          "error.credentialcheckservice.unsupported_identifier_attribute",
+			DefaultValue: "Unsupported identifier attribute",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.credentialcheckservice.unsupported_identifier_attribute_description",
+			DefaultValue: "The identifier attribute is not one that users sign in with",
+		},
+	}
+
+	// errorTooManyRequests is returned when the client exceeds the lookup rate for an IP address.
+	errorTooManyRequests = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: errorCodeTooManyRequests,
+		Error: core.I18nMessage{
+			Key:          "error.credentialcheckservice.too_many_requests",
+			DefaultValue: "Too many requests",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.credentialcheckservice.too_many_requests_description",
+			DefaultValue: "Too many credential checks from this IP address. Please try again later",
+		},
+	}
+)
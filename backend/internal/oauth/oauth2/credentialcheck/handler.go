@@ -0,0 +1,96 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package credentialcheck
+
+import (
+	"net/http"
+
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/clientauth"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
+	serverconst "github.com/thunder-id/thunderid/internal/system/constants"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	sysutils "github.com/thunder-id/thunderid/internal/system/utils"
+)
+
+// Request parameters of the credential check endpoint.
+const (
+	requestParamIdentifier          = "identifier"
+	requestParamIdentifierAttribute = "identifier_attribute"
+)
+
+// credentialCheckHandler handles credential check requests.
+type credentialCheckHandler struct {
+	service CredentialCheckServiceInterface
+	logger  *log.Logger
+}
+
+// newCredentialCheckHandler creates a new credential check handler.
+func newCredentialCheckHandler(service CredentialCheckServiceInterface) *credentialCheckHandler {
+	return &credentialCheckHandler{
+		service: service,
+		logger:  log.GetLogger().With(log.String(log.LoggerKeyComponentName, "CredentialCheckHandler")),
+	}
+}
+
+// HandleCredentialCheck handles requests to discover the credential types available for an identifier.
+// The client is authenticated by the client authentication middleware before this handler runs.
+func (h *credentialCheckHandler) HandleCredentialCheck(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	if err := r.ParseForm(); err != nil {
+		sysutils.WriteJSONError(w, constants.ErrorInvalidRequest, "Failed to decode request body",
+			http.StatusBadRequest, nil)
+		return
+	}
+
+	clientInfo := clientauth.GetOAuthClient(ctx)
+	if clientInfo == nil || clientInfo.OAuthApp == nil {
+		sysutils.WriteJSONError(w, constants.ErrorInvalidClient, "Client authentication failed",
+			http.StatusUnauthorized, nil)
+		return
+	}
+
+	response, svcErr := h.service.CheckCredentials(ctx, clientInfo.OAuthApp,
+		r.FormValue(requestParamIdentifierAttribute), r.FormValue(requestParamIdentifier))
+	if svcErr != nil {
+		h.writeServiceError(w, svcErr)
+		return
+	}
+
+	w.Header().Set(serverconst.CacheControlHeaderName, serverconst.CacheControlNoStore)
+	sysutils.WriteSuccessResponse(w, http.StatusOK, response)
+}
+
+// writeServiceError writes an OAuth style error response for the given service error.
+func (h *credentialCheckHandler) writeServiceError(w http.ResponseWriter, svcErr *serviceerror.ServiceError) {
+	if svcErr.Type == serviceerror.ServerErrorType {
+		sysutils.WriteJSONError(w, constants.ErrorServerError,
+			"An unexpected error occurred while processing the request", http.StatusInternalServerError, nil)
+		return
+	}
+
+	statusCode := http.StatusBadRequest
+	switch svcErr.Code {
+	case constants.ErrorUnauthorizedClient:
+		statusCode = http.StatusForbidden
+	case errorCodeTooManyRequests:
+		statusCode = http.StatusTooManyRequests
+	}
+	sysutils.WriteJSONError(w, svcErr.Code, svcErr.ErrorDescription.DefaultValue, statusCode, nil)
+}
@@ -0,0 +1,118 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package credentialcheck
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	inboundmodel "github.com/thunder-id/thunderid/internal/inboundclient/model"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/clientauth"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+)
+
+type CredentialCheckHandlerTestSuite struct {
+	suite.Suite
+	mockService *CredentialCheckServiceInterfaceMock
+	handler     *credentialCheckHandler
+	client      *inboundmodel.OAuthClient
+}
+
+func TestCredentialCheckHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(CredentialCheckHandlerTestSuite))
+}
+
+func (suite *CredentialCheckHandlerTestSuite) SetupTest() {
+	suite.mockService = NewCredentialCheckServiceInterfaceMock(suite.T())
+	suite.handler = newCredentialCheckHandler(suite.mockService)
+	suite.client = &inboundmodel.OAuthClient{ClientID: "client-1", AllowCredentialDiscovery: true}
+}
+
+func (suite *CredentialCheckHandlerTestSuite) newRequest(body string, withClient bool) *http.Request {
+	req := httptest.NewRequest(http.MethodPost, constants.OAuth2CredentialCheckEndpoint, strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	if withClient {
+		clientInfo := &clientauth.OAuthClientInfo{ClientID: suite.client.ClientID, OAuthApp: suite.client}
+		req = req.WithContext(context.WithValue(req.Context(), clientauth.OAuthClientKey, clientInfo))
+	}
+	return req
+}
+
+func (suite *CredentialCheckHandlerTestSuite) TestHandleCredentialCheck_Success() {
+	suite.mockService.On("CheckCredentials", mock.Anything, suite.client, "email", "alice@example.com").
+		Return(&CredentialCheckResponse{CredentialTypes: []string{"passkey"}}, nil)
+
+	rec := httptest.NewRecorder()
+	suite.handler.HandleCredentialCheck(rec,
+		suite.newRequest("identifier=alice%40example.com&identifier_attribute=email", true))
+
+	suite.Equal(http.StatusOK, rec.Code)
+	suite.Equal("no-store", rec.Header().Get("Cache-Control"))
+	var resp CredentialCheckResponse
+	suite.NoError(json.NewDecoder(rec.Body).Decode(&resp))
+	suite.Equal([]string{"passkey"}, resp.CredentialTypes)
+}
+
+func (suite *CredentialCheckHandlerTestSuite) TestHandleCredentialCheck_NoClient() {
+	rec := httptest.NewRecorder()
+	suite.handler.HandleCredentialCheck(rec, suite.newRequest("identifier=alice", false))
+
+	suite.Equal(http.StatusUnauthorized, rec.Code)
+	suite.mockService.AssertNotCalled(suite.T(), "CheckCredentials")
+}
+
+func (suite *CredentialCheckHandlerTestSuite) TestHandleCredentialCheck_ServiceErrors() {
+	testCases := []struct {
+		name         string
+		svcErr       *serviceerror.ServiceError
+		expectedCode int
+		expectedErr  string
+	}{
+		{"CapabilityNotGranted", &errorCapabilityNotGranted, http.StatusForbidden,
+			constants.ErrorUnauthorizedClient},
+		{"MissingIdentifier", &errorMissingIdentifier, http.StatusBadRequest, constants.ErrorInvalidRequest},
+		{"TooManyRequests", &errorTooManyRequests, http.StatusTooManyRequests, errorCodeTooManyRequests},
+		{"ServerError", &serviceerror.InternalServerError, http.StatusInternalServerError,
+			constants.ErrorServerError},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			svc := NewCredentialCheckServiceInterfaceMock(suite.T())
+			handler := newCredentialCheckHandler(svc)
+			svc.On("CheckCredentials", mock.Anything, suite.client, "", "alice").Return(nil, tc.svcErr)
+
+			rec := httptest.NewRecorder()
+			handler.HandleCredentialCheck(rec, suite.newRequest("identifier=alice", true))
+
+			suite.Equal(tc.expectedCode, rec.Code)
+			var resp map[string]interface{}
+			suite.NoError(json.NewDecoder(rec.Body).Decode(&resp))
+			suite.Equal(tc.expectedErr, resp["error"])
+		})
+	}
+}
@@ -0,0 +1,85 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package credentialcheck
+
+import (
+	"net/http"
+
+	authnprovidermgr "github.com/thunder-id/thunderid/internal/authnprovider/manager"
+	"github.com/thunder-id/thunderid/internal/entityprovider"
+	"github.com/thunder-id/thunderid/internal/enumeration"
+	"github.com/thunder-id/thunderid/internal/inboundclient"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/clientauth"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/jose/jwt"
+	"github.com/thunder-id/thunderid/internal/system/middleware"
+)
+
+// Initialize initializes the credential check handler and registers its routes.
+func Initialize(
+	mux *http.ServeMux,
+	entityProvider entityprovider.EntityProviderInterface,
+	inboundClient inboundclient.InboundClientServiceInterface,
+	authnProvider authnprovidermgr.AuthnProviderManagerInterface,
+	jwtService jwt.JWTServiceInterface,
+	enumerationService enumeration.EnumerationResistanceServiceInterface,
+) CredentialCheckServiceInterface {
+	credentialCheckService := newCredentialCheckService(entityProvider, enumerationService,
+		config.GetServerRuntime().Config.OAuth.CredentialCheck.IdentifierAttributes)
+	credentialCheckService.limiter.startPruning(lookupWindow)
+	credentialCheckHandler := newCredentialCheckHandler(credentialCheckService)
+	registerRoutes(mux, credentialCheckHandler, inboundClient, authnProvider, jwtService)
+	enumerationService.RegisterNormalizedEndpoint(enumeration.NormalizedEndpoint{
+		Method:      http.MethodPost,
+		Path:        constants.OAuth2CredentialCheckEndpoint,
+		Description: "Credential check answers unknown identifiers like a password-only user",
+		Normalizations: []enumeration.Normalization{enumeration.NormalizationResponse,
+			enumeration.NormalizationTiming},
+	})
+	return credentialCheckService
+}
+
+// registerRoutes registers the routes for the credential check endpoint.
+func registerRoutes(
+	mux *http.ServeMux,
+	credentialCheckHandler *credentialCheckHandler,
+	inboundClient inboundclient.InboundClientServiceInterface,
+	authnProvider authnprovidermgr.AuthnProviderManagerInterface,
+	jwtService jwt.JWTServiceInterface,
+) {
+	opts := middleware.CORSOptions{
+		AllowedMethods:   []string{"POST", "OPTIONS"},
+		AllowedHeaders:   middleware.DefaultAllowedHeaders,
+		AllowCredentials: true,
+		MaxAge:           600,
+	}
+
+	endpointURL := config.GetServerURL(&config.GetServerRuntime().Config.Server) +
+		constants.OAuth2CredentialCheckEndpoint
+	clientAuthMiddleware := clientauth.ClientAuthMiddleware(inboundClient, authnProvider, jwtService, endpointURL)
+	handler := clientAuthMiddleware(http.HandlerFunc(credentialCheckHandler.HandleCredentialCheck))
+
+	mux.HandleFunc(middleware.WithCORS("POST "+constants.OAuth2CredentialCheckEndpoint,
+		handler.ServeHTTP, opts))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS "+constants.OAuth2CredentialCheckEndpoint,
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, opts))
+}
@@ -0,0 +1,117 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package credentialcheck
+
+import (
+	"sync"
+	"time"
+)
+
+// lookupRateLimiter limits the number of credential checks per key within a sliding time window.
+// The limiter state is held in memory and is therefore scoped to a single server instance.
+type lookupRateLimiter struct {
+	mu       sync.Mutex
+	limit    int
+	window   time.Duration
+	now      func() time.Time
+	lookups  map[string][]time.Time
+	stopCh   chan struct{}
+	stopOnce sync.Once
+}
+
+// newLookupRateLimiter creates a new lookupRateLimiter allowing limit lookups per window.
+func newLookupRateLimiter(limit int, window time.Duration) *lookupRateLimiter {
+	return &lookupRateLimiter{
+		limit:   limit,
+		window:  window,
+		now:     time.Now,
+		lookups: make(map[string][]time.Time),
+		stopCh:  make(chan struct{}),
+	}
+}
+
+// allow records a lookup for the given key and reports whether it is within the limit.
+// Rejected lookups are not recorded.
+func (l *lookupRateLimiter) allow(key string) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	timestamps := dropExpired(l.lookups[key], now.Add(-l.window))
+	if len(timestamps) >= l.limit {
+		l.lookups[key] = timestamps
+		return false
+	}
+	l.lookups[key] = append(timestamps, now)
+	return true
+}
+
+// prune drops the keys whose lookups have all left the window so that the map does not grow unbounded.
+func (l *lookupRateLimiter) prune() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	cutoff := l.now().Add(-l.window)
+	for key, timestamps := range l.lookups {
+		if kept := dropExpired(timestamps, cutoff); len(kept) == 0 {
+			delete(l.lookups, key)
+		} else {
+			l.lookups[key] = kept
+		}
+	}
+}
+
+// startPruning prunes the limiter at every interval until the limiter is stopped.
+func (l *lookupRateLimiter) startPruning(interval time.Duration) {
+	if interval <= 0 {
+		return
+	}
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				l.prune()
+			case <-l.stopCh:
+				return
+			}
+		}
+	}()
+}
+
+// stop stops the pruning of the limiter. It is safe to call more than once.
+func (l *lookupRateLimiter) stop() {
+	l.stopOnce.Do(func() {
+		close(l.stopCh)
+	})
+}
+
+// dropExpired returns the timestamps after the cutoff, reusing the backing array of the given slice.
+func dropExpired(timestamps []time.Time, cutoff time.Time) []time.Time {
+	kept := timestamps[:0]
+	for _, ts := range timestamps {
+		if ts.After(cutoff) {
+			kept = append(kept, ts)
+		}
+	}
+	return kept
+}
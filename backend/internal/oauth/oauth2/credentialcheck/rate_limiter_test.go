@@ -0,0 +1,73 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package credentialcheck
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLookupRateLimiter_SlidingWindow(t *testing.T) {
+	now := time.Now()
+	limiter := newLookupRateLimiter(2, time.Minute)
+	limiter.now = func() time.Time { return now }
+
+	assert.True(t, limiter.allow("client|192.0.2.1"))
+	assert.True(t, limiter.allow("client|192.0.2.1"))
+	assert.False(t, limiter.allow("client|192.0.2.1"))
+	assert.True(t, limiter.allow("client|192.0.2.2"))
+
+	now = now.Add(time.Minute + time.Second)
+	assert.True(t, limiter.allow("client|192.0.2.1"))
+	assert.Len(t, limiter.lookups["client|192.0.2.1"], 1)
+	// Other keys are left alone until the limiter is pruned.
+	assert.Contains(t, limiter.lookups, "client|192.0.2.2")
+}
+
+func TestLookupRateLimiter_Prune(t *testing.T) {
+	now := time.Now()
+	limiter := newLookupRateLimiter(2, time.Minute)
+	limiter.now = func() time.Time { return now }
+
+	assert.True(t, limiter.allow("client|192.0.2.1"))
+	now = now.Add(30 * time.Second)
+	assert.True(t, limiter.allow("client|192.0.2.2"))
+
+	now = now.Add(45 * time.Second)
+	limiter.prune()
+
+	assert.NotContains(t, limiter.lookups, "client|192.0.2.1")
+	assert.Len(t, limiter.lookups["client|192.0.2.2"], 1)
+}
+
+func TestLookupRateLimiter_StopEndsPruning(t *testing.T) {
+	limiter := newLookupRateLimiter(2, time.Minute)
+	limiter.startPruning(time.Millisecond)
+
+	limiter.stop()
+	limiter.stop()
+
+	select {
+	case <-limiter.stopCh:
+	default:
+		t.Fatal("the stop channel must be closed")
+	}
+}
@@ -0,0 +1,137 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package credentialcheck implements an endpoint that lets sign-in UIs discover which credential types
+// are available for an identifier without revealing whether the account exists.
+package credentialcheck
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/entityprovider"
+	"github.com/thunder-id/thunderid/internal/enumeration"
+	inboundmodel "github.com/thunder-id/thunderid/internal/inboundclient/model"
+	sysContext "github.com/thunder-id/thunderid/internal/system/context"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/log"
+)
+
+const (
+	// maxLookupsPerSource is the number of checks a client may make from one IP address per window.
+	maxLookupsPerSource = 10
+	// lookupWindow is the sliding window over which maxLookupsPerSource is enforced.
+	lookupWindow = time.Minute
+)
+
+// decoyCredentialTypes is returned when the identifier cannot be resolved to a single user so that
+// the response is indistinguishable from that of a password-only account.
+var decoyCredentialTypes = []string{"password"}
+
+// CredentialCheckServiceInterface defines the interface for the credential check service.
+type CredentialCheckServiceInterface interface {
+	CheckCredentials(ctx context.Context, client *inboundmodel.OAuthClient,
+		identifierAttribute, identifier string) (*CredentialCheckResponse, *serviceerror.ServiceError)
+	Stop()
+}
+
+// credentialCheckService implements the CredentialCheckServiceInterface.
+type credentialCheckService struct {
+	entityProvider       entityprovider.EntityProviderInterface
+	enumerationService   enumeration.EnumerationResistanceServiceInterface
+	identifierAttributes []string
+	limiter              *lookupRateLimiter
+	logger               *log.Logger
+}
+
+// newCredentialCheckService creates a new credentialCheckService instance.
+func newCredentialCheckService(entityProvider entityprovider.EntityProviderInterface,
+	enumerationService enumeration.EnumerationResistanceServiceInterface,
+	identifierAttributes []string) *credentialCheckService {
+	return &credentialCheckService{
+		entityProvider:       entityProvider,
+		enumerationService:   enumerationService,
+		identifierAttributes: identifierAttributes,
+		limiter:              newLookupRateLimiter(maxLookupsPerSource, lookupWindow),
+		logger:               log.GetLogger().With(log.String(log.LoggerKeyComponentName, "CredentialCheckService")),
+	}
+}
+
+// CheckCredentials returns the credential types enrolled by the user with the given identifier.
+// Identifiers that do not resolve to exactly one user yield the decoy credential types. Every response is
+// padded to the minimum response time, even when enumeration resistance is disabled, since a known user
+// takes longer to resolve than an unknown one.
+func (s *credentialCheckService) CheckCredentials(ctx context.Context, client *inboundmodel.OAuthClient,
+	identifierAttribute, identifier string) (*CredentialCheckResponse, *serviceerror.ServiceError) {
+	started := time.Now()
+	if client == nil || !client.AllowCredentialDiscovery {
+		return nil, &errorCapabilityNotGranted
+	}
+	if identifier == "" {
+		return nil, &errorMissingIdentifier
+	}
+	if identifierAttribute == "" && len(s.identifierAttributes) > 0 {
+		identifierAttribute = s.identifierAttributes[0]
+	}
+	if !slices.Contains(s.identifierAttributes, identifierAttribute) {
+		return nil, &errorUnsupportedIdentifierAttribute
+	}
+
+	if !s.limiter.allow(client.ClientID + "|" + sysContext.GetClientIP(ctx)) {
+		s.logger.Debug("Credential check rate limit exceeded", log.MaskedString("clientID", client.ClientID))
+		return nil, &errorTooManyRequests
+	}
+
+	credentialTypes := s.resolveCredentialTypes(identifierAttribute, identifier)
+	s.enumerationService.PadResponse(ctx, started)
+	return &CredentialCheckResponse{CredentialTypes: credentialTypes}, nil
+}
+
+// Stop stops the background pruning of the rate limiter.
+func (s *credentialCheckService) Stop() {
+	s.limiter.stop()
+}
+
+// resolveCredentialTypes resolves the identifier to a user and returns its credential types, falling
+// back to the decoy credential types whenever the lookup does not yield any.
+func (s *credentialCheckService) resolveCredentialTypes(identifierAttribute, identifier string) []string {
+	entityID, epErr := s.entityProvider.IdentifyEntity(map[string]interface{}{identifierAttribute: identifier})
+	if epErr != nil || entityID == nil {
+		if epErr != nil && epErr.Code != entityprovider.ErrorCodeEntityNotFound &&
+			epErr.Code != entityprovider.ErrorCodeAmbiguousEntity {
+			s.logger.Error("Failed to identify entity for credential check", log.String("error", epErr.Error()))
+		}
+		return decoyCredentialTypes
+	}
+
+	entity, epErr := s.entityProvider.GetEntity(*entityID)
+	if epErr != nil || entity.Category != entityprovider.EntityCategoryUser {
+		return decoyCredentialTypes
+	}
+
+	types, epErr := s.entityProvider.GetCredentialTypes(*entityID)
+	if epErr != nil {
+		s.logger.Error("Failed to retrieve credential types", log.String("error", epErr.Error()))
+		return decoyCredentialTypes
+	}
+	if len(types) == 0 {
+		return decoyCredentialTypes
+	}
+	return types
+}
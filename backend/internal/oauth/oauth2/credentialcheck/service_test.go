@@ -0,0 +1,194 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package credentialcheck
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/entityprovider"
+	inboundmodel "github.com/thunder-id/thunderid/internal/inboundclient/model"
+	sysContext "github.com/thunder-id/thunderid/internal/system/context"
+	"github.com/thunder-id/thunderid/tests/mocks/entityprovidermock"
+	"github.com/thunder-id/thunderid/tests/mocks/enumerationmock"
+)
+
+const testUserID = "user-123"
+
+type CredentialCheckServiceTestSuite struct {
+	suite.Suite
+	mockEntityProvider *entityprovidermock.EntityProviderInterfaceMock
+	mockEnumeration    *enumerationmock.EnumerationResistanceServiceInterfaceMock
+	service            *credentialCheckService
+	client             *inboundmodel.OAuthClient
+}
+
+func TestCredentialCheckServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(CredentialCheckServiceTestSuite))
+}
+
+func (suite *CredentialCheckServiceTestSuite) SetupTest() {
+	suite.mockEntityProvider = entityprovidermock.NewEntityProviderInterfaceMock(suite.T())
+	suite.mockEnumeration = enumerationmock.NewEnumerationResistanceServiceInterfaceMock(suite.T())
+	suite.mockEnumeration.On("PadResponse", mock.Anything, mock.AnythingOfType("time.Time")).Maybe()
+	suite.service = newCredentialCheckService(suite.mockEntityProvider, suite.mockEnumeration,
+		[]string{"username", "email", "mobileNumber"})
+	suite.client = &inboundmodel.OAuthClient{ClientID: "client-1", AllowCredentialDiscovery: true}
+}
+
+func (suite *CredentialCheckServiceTestSuite) TestCheckCredentials_KnownUser() {
+	userID := testUserID
+	suite.mockEntityProvider.On("IdentifyEntity", map[string]interface{}{"username": "alice"}).
+		Return(&userID, nil)
+	suite.mockEntityProvider.On("GetEntity", userID).
+		Return(&entityprovider.Entity{ID: userID, Category: entityprovider.EntityCategoryUser}, nil)
+	suite.mockEntityProvider.On("GetCredentialTypes", userID).Return([]string{"passkey", "password"}, nil)
+
+	resp, svcErr := suite.service.CheckCredentials(context.Background(), suite.client, "", "alice")
+
+	suite.Nil(svcErr)
+	suite.Equal([]string{"passkey", "password"}, resp.CredentialTypes)
+}
+
+func (suite *CredentialCheckServiceTestSuite) TestCheckCredentials_CustomIdentifierAttribute() {
+	userID := testUserID
+	suite.mockEntityProvider.On("IdentifyEntity", map[string]interface{}{"email": "alice@example.com"}).
+		Return(&userID, nil)
+	suite.mockEntityProvider.On("GetEntity", userID).
+		Return(&entityprovider.Entity{ID: userID, Category: entityprovider.EntityCategoryUser}, nil)
+	suite.mockEntityProvider.On("GetCredentialTypes", userID).Return([]string{"passkey"}, nil)
+
+	resp, svcErr := suite.service.CheckCredentials(context.Background(), suite.client, "email",
+		"alice@example.com")
+
+	suite.Nil(svcErr)
+	suite.Equal([]string{"passkey"}, resp.CredentialTypes)
+}
+
+func (suite *CredentialCheckServiceTestSuite) TestCheckCredentials_UnknownUserReturnsDecoy() {
+	suite.mockEntityProvider.On("IdentifyEntity", map[string]interface{}{"username": "ghost"}).
+		Return(nil, entityprovider.NewEntityProviderError(entityprovider.ErrorCodeEntityNotFound,
+			"Entity not found", "not found"))
+
+	resp, svcErr := suite.service.CheckCredentials(context.Background(), suite.client, "", "ghost")
+
+	suite.Nil(svcErr)
+	suite.Equal(decoyCredentialTypes, resp.CredentialTypes)
+}
+
+func (suite *CredentialCheckServiceTestSuite) TestCheckCredentials_NonUserEntityReturnsDecoy() {
+	appID := "app-123"
+	suite.mockEntityProvider.On("IdentifyEntity", map[string]interface{}{"username": "svc"}).
+		Return(&appID, nil)
+	suite.mockEntityProvider.On("GetEntity", appID).
+		Return(&entityprovider.Entity{ID: appID, Category: entityprovider.EntityCategoryApp}, nil)
+
+	resp, svcErr := suite.service.CheckCredentials(context.Background(), suite.client, "", "svc")
+
+	suite.Nil(svcErr)
+	suite.Equal(decoyCredentialTypes, resp.CredentialTypes)
+}
+
+func (suite *CredentialCheckServiceTestSuite) TestCheckCredentials_NoCredentialsReturnsDecoy() {
+	userID := testUserID
+	suite.mockEntityProvider.On("IdentifyEntity", map[string]interface{}{"username": "alice"}).
+		Return(&userID, nil)
+	suite.mockEntityProvider.On("GetEntity", userID).
+		Return(&entityprovider.Entity{ID: userID, Category: entityprovider.EntityCategoryUser}, nil)
+	suite.mockEntityProvider.On("GetCredentialTypes", userID).Return([]string{}, nil)
+
+	resp, svcErr := suite.service.CheckCredentials(context.Background(), suite.client, "", "alice")
+
+	suite.Nil(svcErr)
+	suite.Equal(decoyCredentialTypes, resp.CredentialTypes)
+}
+
+func (suite *CredentialCheckServiceTestSuite) TestCheckCredentials_CapabilityNotGranted() {
+	client := &inboundmodel.OAuthClient{ClientID: "client-2"}
+
+	resp, svcErr := suite.service.CheckCredentials(context.Background(), client, "", "alice")
+
+	suite.Nil(resp)
+	suite.Equal(&errorCapabilityNotGranted, svcErr)
+}
+
+func (suite *CredentialCheckServiceTestSuite) TestCheckCredentials_MissingIdentifier() {
+	resp, svcErr := suite.service.CheckCredentials(context.Background(), suite.client, "", "")
+
+	suite.Nil(resp)
+	suite.Equal(&errorMissingIdentifier, svcErr)
+}
+
+func (suite *CredentialCheckServiceTestSuite) TestCheckCredentials_UnsupportedIdentifierAttribute() {
+	resp, svcErr := suite.service.CheckCredentials(context.Background(), suite.client, "given_name", "alice")
+
+	suite.Nil(resp)
+	suite.Equal(&errorUnsupportedIdentifierAttribute, svcErr)
+}
+
+func (suite *CredentialCheckServiceTestSuite) TestCheckCredentials_RateLimitedPerClientAndIPAddress() {
+	suite.service.limiter = newLookupRateLimiter(1, lookupWindow)
+	notFound := entityprovider.NewEntityProviderError(entityprovider.ErrorCodeEntityNotFound,
+		"Entity not found", "not found")
+	suite.mockEntityProvider.On("IdentifyEntity", mock.Anything).Return(nil, notFound).Times(3)
+	ctx := sysContext.WithClientIP(context.Background(), "192.0.2.1")
+
+	_, svcErr := suite.service.CheckCredentials(ctx, suite.client, "", "ghost")
+	suite.Nil(svcErr)
+
+	// Changing the identifier does not get around the limit.
+	resp, svcErr := suite.service.CheckCredentials(ctx, suite.client, "", "phantom")
+	suite.Nil(resp)
+	suite.Equal(&errorTooManyRequests, svcErr)
+
+	_, svcErr = suite.service.CheckCredentials(sysContext.WithClientIP(context.Background(), "192.0.2.2"),
+		suite.client, "", "ghost")
+	suite.Nil(svcErr)
+
+	otherClient := &inboundmodel.OAuthClient{ClientID: "client-2", AllowCredentialDiscovery: true}
+	_, svcErr = suite.service.CheckCredentials(ctx, otherClient, "", "ghost")
+	suite.Nil(svcErr)
+}
+
+func (suite *CredentialCheckServiceTestSuite) TestCheckCredentials_PadsUnknownAndKnownUsers() {
+	enumerationService := enumerationmock.NewEnumerationResistanceServiceInterfaceMock(suite.T())
+	enumerationService.On("PadResponse", mock.Anything, mock.AnythingOfType("time.Time")).Twice()
+	suite.service.enumerationService = enumerationService
+
+	userID := testUserID
+	suite.mockEntityProvider.On("IdentifyEntity", map[string]interface{}{"username": "alice"}).
+		Return(&userID, nil)
+	suite.mockEntityProvider.On("GetEntity", userID).
+		Return(&entityprovider.Entity{ID: userID, Category: entityprovider.EntityCategoryUser}, nil)
+	suite.mockEntityProvider.On("GetCredentialTypes", userID).Return([]string{"passkey"}, nil)
+	suite.mockEntityProvider.On("IdentifyEntity", map[string]interface{}{"username": "ghost"}).
+		Return(nil, entityprovider.NewEntityProviderError(entityprovider.ErrorCodeEntityNotFound,
+			"Entity not found", "not found"))
+
+	known, svcErr := suite.service.CheckCredentials(context.Background(), suite.client, "", "alice")
+	suite.Nil(svcErr)
+	suite.Equal([]string{"passkey"}, known.CredentialTypes)
+
+	unknown, svcErr := suite.service.CheckCredentials(context.Background(), suite.client, "", "ghost")
+	suite.Nil(svcErr)
+	suite.Equal(decoyCredentialTypes, unknown.CredentialTypes)
+}
@@ -0,0 +1,63 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package device implements the OAuth 2.0 Device Authorization Grant (RFC 8628).
+package device
+
+import "time"
+
+const (
+	// loggerComponentName is the component name used in the device authorization logs.
+	loggerComponentName = "DeviceAuthorizationService"
+
+	// defaultExpiresIn is the lifetime in seconds of a device code when none is configured.
+	defaultExpiresIn int64 = 600
+	// defaultInterval is the polling interval in seconds when none is configured.
+	defaultInterval int64 = 5
+	// slowDownIncrement is the number of seconds added to the polling interval of a device that polls too
+	// fast (RFC 8628 section 3.5).
+	slowDownIncrement int64 = 5
+	// expiredRetention is how long an expired device code is kept so that its polls are answered with
+	// expired_token rather than invalid_grant.
+	expiredRetention = 10 * time.Minute
+
+	// deviceCodeRandomBytes is the number of random bytes of a device code (32 bytes = 256 bits).
+	deviceCodeRandomBytes = 32
+	// userCodeCharset holds the characters of a user code. Vowels are left out so that user codes do not
+	// spell words, and the remaining consonants are unambiguous to type (RFC 8628 section 6.1).
+	userCodeCharset = "BCDFGHJKLMNPQRSTVWXZ"
+	// userCodeLength is the number of characters of a user code.
+	userCodeLength = 8
+	// maxUserCodeAttempts is the number of user codes generated before giving up on finding an unused one.
+	maxUserCodeAttempts = 5
+
+	// defaultVerificationPath is the device page of the gate client, relative to its login page.
+	defaultVerificationPath = "device"
+	// verificationStatusParam is the query parameter telling the verification page the outcome of the
+	// verification.
+	verificationStatusParam = "status"
+	// verificationStatusApproved is the verification outcome of an approved device authorization request.
+	verificationStatusApproved = "approved"
+	// userCodeParam is the query parameter carrying the user code in the complete verification URI.
+	userCodeParam = "user_code"
+
+	// Store key prefixes of the entries of a device authorization request.
+	deviceCodeKeyPrefix = "device:"
+	userCodeKeyPrefix   = "user:"
+	pollKeyPrefix       = "poll:"
+)
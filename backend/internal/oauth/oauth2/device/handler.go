@@ -0,0 +1,114 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package device
+
+import (
+	"net/http"
+
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/clientauth"
+	oauth2const "github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
+	oauth2model "github.com/thunder-id/thunderid/internal/oauth/oauth2/model"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/internal/system/utils"
+)
+
+// deviceHandlerInterface defines the interface for handling device authorization requests.
+type deviceHandlerInterface interface {
+	HandleDeviceAuthorizationRequest(w http.ResponseWriter, r *http.Request)
+	HandleVerificationRequest(w http.ResponseWriter, r *http.Request)
+}
+
+// deviceHandler implements deviceHandlerInterface.
+type deviceHandler struct {
+	deviceService DeviceAuthorizationServiceInterface
+	logger        *log.Logger
+}
+
+// newDeviceHandler creates a new device authorization handler instance.
+func newDeviceHandler(deviceService DeviceAuthorizationServiceInterface) deviceHandlerInterface {
+	return &deviceHandler{
+		deviceService: deviceService,
+		logger:        log.GetLogger().With(log.String(log.LoggerKeyComponentName, "DeviceAuthorizationHandler")),
+	}
+}
+
+// HandleDeviceAuthorizationRequest handles the POST /oauth2/device_authorization request.
+func (h *deviceHandler) HandleDeviceAuthorizationRequest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// Client authentication is handled by the ClientAuthMiddleware.
+	clientInfo := clientauth.GetOAuthClient(ctx)
+	if clientInfo == nil {
+		h.logger.Error("OAuth client not found in context - ClientAuthMiddleware must be applied")
+		utils.WriteJSONError(w, oauth2const.ErrorServerError,
+			"Something went wrong", http.StatusInternalServerError, nil)
+		return
+	}
+
+	if err := r.ParseForm(); err != nil {
+		utils.WriteJSONError(w, oauth2const.ErrorInvalidRequest, "Failed to parse request body",
+			http.StatusBadRequest, nil)
+		return
+	}
+
+	params := make(map[string]string)
+	for key, values := range r.PostForm {
+		if len(values) > 0 {
+			params[key] = values[0]
+		}
+	}
+	resources := r.PostForm[oauth2const.RequestParamResource]
+
+	resp, errResp := h.deviceService.HandleDeviceAuthorizationRequest(ctx, params, resources,
+		clientInfo.OAuthApp)
+	if errResp != nil {
+		h.writeError(w, errResp)
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusOK, resp)
+}
+
+// HandleVerificationRequest handles the POST /oauth2/device/verify request sent by the verification page
+// with the user code entered by the user.
+func (h *deviceHandler) HandleVerificationRequest(w http.ResponseWriter, r *http.Request) {
+	request, err := utils.DecodeJSONBody[VerificationRequest](r)
+	if err != nil || request.UserCode == "" {
+		utils.WriteJSONError(w, oauth2const.ErrorInvalidRequest, "The user code is required",
+			http.StatusBadRequest, nil)
+		return
+	}
+
+	redirectURI, errResp := h.deviceService.InitiateVerification(r.Context(), request.UserCode)
+	if errResp != nil {
+		h.writeError(w, errResp)
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusOK, VerificationResponse{RedirectURI: redirectURI})
+}
+
+// writeError writes an OAuth error response, using 500 for server errors and 400 otherwise.
+func (h *deviceHandler) writeError(w http.ResponseWriter, errResp *oauth2model.ErrorResponse) {
+	statusCode := http.StatusBadRequest
+	if errResp.Error == oauth2const.ErrorServerError {
+		statusCode = http.StatusInternalServerError
+	}
+	utils.WriteJSONError(w, errResp.Error, errResp.ErrorDescription, statusCode, nil)
+}
@@ -0,0 +1,160 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package device
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	inboundmodel "github.com/thunder-id/thunderid/internal/inboundclient/model"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/clientauth"
+	oauth2const "github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
+	oauth2model "github.com/thunder-id/thunderid/internal/oauth/oauth2/model"
+	"github.com/thunder-id/thunderid/internal/system/config"
+)
+
+type HandlerTestSuite struct {
+	suite.Suite
+}
+
+func TestHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(HandlerTestSuite))
+}
+
+func (s *HandlerTestSuite) SetupTest() {
+	testConfig := &config.Config{}
+	_ = config.InitializeServerRuntime("", testConfig)
+}
+
+func (s *HandlerTestSuite) TearDownTest() {
+	config.ResetServerRuntime()
+}
+
+func (s *HandlerTestSuite) newAuthenticatedRequest(body string) *http.Request {
+	req := httptest.NewRequest(http.MethodPost, "/oauth2/device_authorization", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	app := &inboundmodel.OAuthClient{ClientID: testClientID}
+	clientInfo := &clientauth.OAuthClientInfo{ClientID: testClientID, OAuthApp: app}
+	ctx := context.WithValue(req.Context(), clientauth.OAuthClientKey, clientInfo)
+	return req.WithContext(ctx)
+}
+
+func (s *HandlerTestSuite) TestHandleDeviceAuthorization_Success() {
+	svc := NewDeviceAuthorizationServiceInterfaceMock(s.T())
+	svc.EXPECT().HandleDeviceAuthorizationRequest(mock.Anything,
+		map[string]string{oauth2const.RequestParamScope: "openid"}, []string(nil), mock.Anything).
+		Return(&DeviceAuthorizationResponse{
+			DeviceCode: testDeviceCode,
+			UserCode:   "BCDF-GHJK",
+			ExpiresIn:  600,
+			Interval:   5,
+		}, nil)
+	handler := newDeviceHandler(svc)
+
+	rec := httptest.NewRecorder()
+	handler.HandleDeviceAuthorizationRequest(rec, s.newAuthenticatedRequest("scope=openid"))
+
+	assert.Equal(s.T(), http.StatusOK, rec.Code)
+	var resp DeviceAuthorizationResponse
+	err := json.NewDecoder(rec.Body).Decode(&resp)
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), testDeviceCode, resp.DeviceCode)
+	assert.Equal(s.T(), "BCDF-GHJK", resp.UserCode)
+}
+
+func (s *HandlerTestSuite) TestHandleDeviceAuthorization_NoClientAuth() {
+	svc := NewDeviceAuthorizationServiceInterfaceMock(s.T())
+	handler := newDeviceHandler(svc)
+
+	req := httptest.NewRequest(http.MethodPost, "/oauth2/device_authorization", strings.NewReader(""))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	rec := httptest.NewRecorder()
+	handler.HandleDeviceAuthorizationRequest(rec, req)
+
+	assert.Equal(s.T(), http.StatusInternalServerError, rec.Code)
+}
+
+func (s *HandlerTestSuite) TestHandleDeviceAuthorization_Error() {
+	svc := NewDeviceAuthorizationServiceInterfaceMock(s.T())
+	svc.EXPECT().HandleDeviceAuthorizationRequest(mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(nil, &oauth2model.ErrorResponse{Error: oauth2const.ErrorUnauthorizedClient})
+	handler := newDeviceHandler(svc)
+
+	rec := httptest.NewRecorder()
+	handler.HandleDeviceAuthorizationRequest(rec, s.newAuthenticatedRequest(""))
+
+	assert.Equal(s.T(), http.StatusBadRequest, rec.Code)
+	assert.Contains(s.T(), rec.Body.String(), oauth2const.ErrorUnauthorizedClient)
+}
+
+func (s *HandlerTestSuite) TestHandleVerification_Success() {
+	svc := NewDeviceAuthorizationServiceInterfaceMock(s.T())
+	svc.EXPECT().InitiateVerification(mock.Anything, "BCDF-GHJK").
+		Return("https://localhost:5190/gate/signin?authId=auth-id", nil)
+	handler := newDeviceHandler(svc)
+
+	req := httptest.NewRequest(http.MethodPost, "/oauth2/device/verify",
+		strings.NewReader(`{"userCode":"BCDF-GHJK"}`))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	handler.HandleVerificationRequest(rec, req)
+
+	assert.Equal(s.T(), http.StatusOK, rec.Code)
+	var resp VerificationResponse
+	err := json.NewDecoder(rec.Body).Decode(&resp)
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), "https://localhost:5190/gate/signin?authId=auth-id", resp.RedirectURI)
+}
+
+func (s *HandlerTestSuite) TestHandleVerification_MissingUserCode() {
+	svc := NewDeviceAuthorizationServiceInterfaceMock(s.T())
+	handler := newDeviceHandler(svc)
+
+	req := httptest.NewRequest(http.MethodPost, "/oauth2/device/verify", strings.NewReader(`{}`))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	handler.HandleVerificationRequest(rec, req)
+
+	assert.Equal(s.T(), http.StatusBadRequest, rec.Code)
+}
+
+func (s *HandlerTestSuite) TestHandleVerification_ServerError() {
+	svc := NewDeviceAuthorizationServiceInterfaceMock(s.T())
+	svc.EXPECT().InitiateVerification(mock.Anything, testUserCode).
+		Return("", &oauth2model.ErrorResponse{Error: oauth2const.ErrorServerError})
+	handler := newDeviceHandler(svc)
+
+	req := httptest.NewRequest(http.MethodPost, "/oauth2/device/verify",
+		strings.NewReader(`{"userCode":"`+testUserCode+`"}`))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	handler.HandleVerificationRequest(rec, req)
+
+	assert.Equal(s.T(), http.StatusInternalServerError, rec.Code)
+}
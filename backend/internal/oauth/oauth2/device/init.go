@@ -0,0 +1,105 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package device
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	authnprovidermgr "github.com/thunder-id/thunderid/internal/authnprovider/manager"
+	"github.com/thunder-id/thunderid/internal/inboundclient"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/authz"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/clientauth"
+	oauth2const "github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/discovery"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/tokenstore"
+	"github.com/thunder-id/thunderid/internal/resource"
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/jose/jwt"
+	"github.com/thunder-id/thunderid/internal/system/middleware"
+)
+
+// Initialize initializes the device authorization service and registers its routes. The service approves
+// the device authorization requests verified through the given authorization service.
+func Initialize(
+	mux *http.ServeMux,
+	inboundClient inboundclient.InboundClientServiceInterface,
+	authnProvider authnprovidermgr.AuthnProviderManagerInterface,
+	jwtService jwt.JWTServiceInterface,
+	discoveryService discovery.DiscoveryServiceInterface,
+	resourceService resource.ResourceServiceInterface,
+	authzService authz.AuthorizeServiceInterface,
+) DeviceAuthorizationServiceInterface {
+	runtime := config.GetServerRuntime()
+	deviceConfig := runtime.Config.OAuth.DeviceAuthorization
+
+	store := newDeviceAuthorizationStore(tokenstore.Initialize(tokenstore.ArtifactTypeDeviceCode))
+	deviceService := newDeviceAuthorizationService(store, authzService, resourceService,
+		deviceConfig.ExpiresIn, deviceConfig.Interval, getVerificationURI(runtime), runtime.GateClientLoginURL)
+	authzService.SetDeviceAuthorizationApprover(deviceService)
+
+	handler := newDeviceHandler(deviceService)
+	registerRoutes(mux, handler, inboundClient, authnProvider, jwtService, discoveryService)
+	return deviceService
+}
+
+// getVerificationURI returns the configured verification page URL, or the device page of the gate client
+// when none is configured.
+func getVerificationURI(runtime *config.ServerRuntime) *url.URL {
+	if configured := runtime.Config.OAuth.DeviceAuthorization.VerificationURI; configured != "" {
+		// The URL is validated when the configuration is loaded.
+		if parsed, err := url.Parse(configured); err == nil {
+			return parsed
+		}
+	}
+	return runtime.GateClientLoginURL.ResolveReference(&url.URL{Path: defaultVerificationPath})
+}
+
+// registerRoutes registers the device authorization endpoint, protected by client authentication, and the
+// user code verification endpoint called by the verification page.
+func registerRoutes(
+	mux *http.ServeMux,
+	handler deviceHandlerInterface,
+	inboundClient inboundclient.InboundClientServiceInterface,
+	authnProvider authnprovidermgr.AuthnProviderManagerInterface,
+	jwtService jwt.JWTServiceInterface,
+	discoveryService discovery.DiscoveryServiceInterface,
+) {
+	corsOpts := middleware.CORSOptions{
+		AllowedMethods:   []string{"POST"},
+		AllowedHeaders:   middleware.DefaultAllowedHeaders,
+		AllowCredentials: true,
+		MaxAge:           600,
+	}
+
+	metadata := discoveryService.GetOAuth2AuthorizationServerMetadata(context.Background())
+	endpointURL := metadata.DeviceAuthorizationEndpoint
+	clientAuthMiddleware := clientauth.ClientAuthMiddleware(inboundClient, authnProvider, jwtService, endpointURL)
+	wrappedHandler := clientAuthMiddleware(http.HandlerFunc(handler.HandleDeviceAuthorizationRequest))
+	mux.HandleFunc(middleware.WithCORS("POST "+oauth2const.OAuth2DeviceAuthorizationEndpoint,
+		wrappedHandler.ServeHTTP, corsOpts))
+
+	mux.HandleFunc(middleware.WithCORS("POST /oauth2/device/verify",
+		handler.HandleVerificationRequest, corsOpts))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS /oauth2/device/verify",
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, corsOpts))
+}
@@ -0,0 +1,75 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package device
+
+import "time"
+
+// deviceAuthorizationStatus is the status of a device authorization request.
+type deviceAuthorizationStatus string
+
+const (
+	// statusPending is the status of a request whose user code has not been verified yet.
+	statusPending deviceAuthorizationStatus = "pending"
+	// statusApproved is the status of a request approved by an authenticated user.
+	statusApproved deviceAuthorizationStatus = "approved"
+)
+
+// DeviceAuthorization is a device authorization request, kept until it expires or its tokens are issued.
+type DeviceAuthorization struct {
+	DeviceCode       string
+	UserCode         string
+	ClientID         string
+	StandardScopes   []string
+	PermissionScopes []string
+	Resources        []string
+	Status           deviceAuthorizationStatus
+	AuthorizedUserID string
+	AttributeCacheID string
+	CompletedACR     string
+	AuthTime         time.Time
+	ExpiryTime       time.Time
+}
+
+// pollState holds the polling state of a device authorization request. It is kept apart from the request so
+// that a poll never overwrites a concurrent approval.
+type pollState struct {
+	Interval     int64
+	LastPolledAt time.Time
+}
+
+// DeviceAuthorizationResponse is the response of the device authorization endpoint (RFC 8628 section 3.2).
+type DeviceAuthorizationResponse struct {
+	DeviceCode              string `json:"device_code"`
+	UserCode                string `json:"user_code"`
+	VerificationURI         string `json:"verification_uri"`
+	VerificationURIComplete string `json:"verification_uri_complete"`
+	ExpiresIn               int64  `json:"expires_in"`
+	Interval                int64  `json:"interval"`
+}
+
+// VerificationRequest is the request body of the user code verification endpoint.
+type VerificationRequest struct {
+	UserCode string `json:"userCode"`
+}
+
+// VerificationResponse is the response of the user code verification endpoint. It holds the login page the
+// user is sent to.
+type VerificationResponse struct {
+	RedirectURI string `json:"redirect_uri"`
+}
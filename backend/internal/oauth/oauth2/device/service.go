@@ -0,0 +1,422 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package device
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/url"
+	"strings"
+	"time"
+
+	inboundmodel "github.com/thunder-id/thunderid/internal/inboundclient/model"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/authz"
+	oauth2const "github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
+	oauth2model "github.com/thunder-id/thunderid/internal/oauth/oauth2/model"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/resourceindicators"
+	oauth2utils "github.com/thunder-id/thunderid/internal/oauth/oauth2/utils"
+	"github.com/thunder-id/thunderid/internal/resource"
+	"github.com/thunder-id/thunderid/internal/system/log"
+)
+
+// DeviceAuthorizationServiceInterface defines the interface for the device authorization service.
+type DeviceAuthorizationServiceInterface interface {
+	HandleDeviceAuthorizationRequest(
+		ctx context.Context, params map[string]string, resources []string, oauthApp *inboundmodel.OAuthClient,
+	) (*DeviceAuthorizationResponse, *oauth2model.ErrorResponse)
+	InitiateVerification(ctx context.Context, userCode string) (string, *oauth2model.ErrorResponse)
+	ApproveDeviceAuthorization(ctx context.Context, grant authz.DeviceAuthorizationGrant) (string, error)
+	ConsumeDeviceCode(
+		ctx context.Context, clientID string, deviceCode string,
+	) (*DeviceAuthorization, *oauth2model.ErrorResponse)
+}
+
+// deviceAuthorizationService implements DeviceAuthorizationServiceInterface.
+type deviceAuthorizationService struct {
+	store           deviceAuthorizationStoreInterface
+	authzService    authz.AuthorizeServiceInterface
+	resourceService resource.ResourceServiceInterface
+	expiresIn       int64
+	interval        int64
+	verificationURI *url.URL
+	loginURL        *url.URL
+	now             func() time.Time
+	logger          *log.Logger
+}
+
+// newDeviceAuthorizationService creates a new device authorization service. Non-positive durations fall back
+// to their defaults.
+func newDeviceAuthorizationService(
+	store deviceAuthorizationStoreInterface,
+	authzService authz.AuthorizeServiceInterface,
+	resourceService resource.ResourceServiceInterface,
+	expiresIn int64,
+	interval int64,
+	verificationURI *url.URL,
+	loginURL *url.URL,
+) DeviceAuthorizationServiceInterface {
+	if expiresIn <= 0 {
+		expiresIn = defaultExpiresIn
+	}
+	if interval <= 0 {
+		interval = defaultInterval
+	}
+	return &deviceAuthorizationService{
+		store:           store,
+		authzService:    authzService,
+		resourceService: resourceService,
+		expiresIn:       expiresIn,
+		interval:        interval,
+		verificationURI: verificationURI,
+		loginURL:        loginURL,
+		now:             time.Now,
+		logger:          log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName)),
+	}
+}
+
+// HandleDeviceAuthorizationRequest validates a device authorization request of an authenticated client and
+// issues its device code and user code.
+func (s *deviceAuthorizationService) HandleDeviceAuthorizationRequest(
+	ctx context.Context, params map[string]string, resources []string, oauthApp *inboundmodel.OAuthClient,
+) (*DeviceAuthorizationResponse, *oauth2model.ErrorResponse) {
+	if !oauthApp.IsAllowedGrantType(oauth2const.GrantTypeDeviceCode) {
+		return nil, &oauth2model.ErrorResponse{
+			Error:            oauth2const.ErrorUnauthorizedClient,
+			ErrorDescription: "The client is not authorized to use the device authorization grant",
+		}
+	}
+	if errResp := resourceindicators.ValidateResourceURIs(resources); errResp != nil {
+		return nil, errResp
+	}
+
+	oidcScopes, nonOidcScopes, scopesAllowed := oauth2utils.SeparateAllowedScopes(
+		params[oauth2const.RequestParamScope], oauthApp)
+	if !scopesAllowed {
+		return nil, &oauth2model.ErrorResponse{
+			Error:            oauth2const.ErrorInvalidScope,
+			ErrorDescription: "Requested scope is not allowed for this client",
+		}
+	}
+
+	// Resolve resource identifiers to Resource Servers and downscope non-OIDC scopes against
+	// the union of permissions defined on those Resource Servers.
+	_, nonOidcScopes, errResp := resourceindicators.ResolveAndDownscope(
+		ctx, s.resourceService, resources, nonOidcScopes)
+	if errResp != nil {
+		return nil, errResp
+	}
+
+	deviceCode, err := generateDeviceCode()
+	if err != nil {
+		s.logger.Error("Failed to generate device code", log.Error(err))
+		return nil, serverError()
+	}
+	userCode, err := s.generateUnusedUserCode(ctx)
+	if err != nil {
+		s.logger.Error("Failed to generate user code", log.Error(err))
+		return nil, serverError()
+	}
+
+	authorization := DeviceAuthorization{
+		DeviceCode:       deviceCode,
+		UserCode:         userCode,
+		ClientID:         oauthApp.ClientID,
+		StandardScopes:   oidcScopes,
+		PermissionScopes: nonOidcScopes,
+		Resources:        resources,
+		Status:           statusPending,
+		ExpiryTime:       s.now().Add(time.Duration(s.expiresIn) * time.Second),
+	}
+	if err := s.store.Store(ctx, authorization, pollState{Interval: s.interval}); err != nil {
+		s.logger.Error("Failed to store device authorization request", log.Error(err))
+		return nil, serverError()
+	}
+
+	displayedUserCode := formatUserCode(userCode)
+	verificationURIComplete, err := oauth2utils.GetURIWithQueryParams(s.verificationURI.String(),
+		map[string]string{userCodeParam: displayedUserCode})
+	if err != nil {
+		s.logger.Error("Failed to build the complete verification URI", log.Error(err))
+		return nil, serverError()
+	}
+
+	return &DeviceAuthorizationResponse{
+		DeviceCode:              deviceCode,
+		UserCode:                displayedUserCode,
+		VerificationURI:         s.verificationURI.String(),
+		VerificationURIComplete: verificationURIComplete,
+		ExpiresIn:               s.expiresIn,
+		Interval:                s.interval,
+	}, nil
+}
+
+// InitiateVerification starts the authentication of the user entering a user code and returns the login page
+// the user is sent to.
+func (s *deviceAuthorizationService) InitiateVerification(
+	ctx context.Context, userCode string,
+) (string, *oauth2model.ErrorResponse) {
+	userCode, ok := normalizeUserCode(userCode)
+	if !ok {
+		return "", invalidUserCodeError()
+	}
+
+	deviceCode, err := s.store.GetDeviceCode(ctx, userCode)
+	if err != nil {
+		s.logger.Error("Failed to resolve user code", log.Error(err))
+		return "", serverError()
+	}
+	if deviceCode == "" {
+		return "", invalidUserCodeError()
+	}
+	authorization, err := s.store.Get(ctx, deviceCode)
+	if err != nil {
+		s.logger.Error("Failed to retrieve device authorization request", log.Error(err))
+		return "", serverError()
+	}
+	if authorization == nil || authorization.Status != statusPending || !s.now().Before(authorization.ExpiryTime) {
+		return "", invalidUserCodeError()
+	}
+
+	oauthParams := &oauth2model.OAuthParameters{
+		ClientID:         authorization.ClientID,
+		StandardScopes:   authorization.StandardScopes,
+		PermissionScopes: authorization.PermissionScopes,
+		Resources:        authorization.Resources,
+	}
+	result, authErr := s.authzService.InitiateDeviceVerification(ctx, oauthParams, userCode)
+	if authErr != nil {
+		return "", &oauth2model.ErrorResponse{Error: authErr.Code, ErrorDescription: authErr.Message}
+	}
+
+	redirectURI, err := oauth2utils.GetURIWithQueryParams(s.loginURL.String(), result.QueryParams)
+	if err != nil {
+		s.logger.Error("Failed to build the login page URL", log.Error(err))
+		return "", serverError()
+	}
+	return redirectURI, nil
+}
+
+// ApproveDeviceAuthorization records the user that verified the user code of a device authorization request,
+// allowing the device to obtain its tokens. The user code cannot be verified again. It returns the page
+// telling the user that the device was authorized.
+func (s *deviceAuthorizationService) ApproveDeviceAuthorization(
+	ctx context.Context, grant authz.DeviceAuthorizationGrant,
+) (string, error) {
+	deviceCode, err := s.store.ConsumeUserCode(ctx, grant.UserCode)
+	if err != nil {
+		return "", fmt.Errorf("failed to consume user code: %w", err)
+	}
+	if deviceCode == "" {
+		return "", authz.ErrDeviceAuthorizationNotFound
+	}
+	authorization, err := s.store.Get(ctx, deviceCode)
+	if err != nil {
+		return "", fmt.Errorf("failed to retrieve device authorization request: %w", err)
+	}
+	if authorization == nil || authorization.Status != statusPending || !s.now().Before(authorization.ExpiryTime) {
+		return "", authz.ErrDeviceAuthorizationNotFound
+	}
+
+	authorization.Status = statusApproved
+	authorization.AuthorizedUserID = grant.AuthorizedUserID
+	authorization.AttributeCacheID = grant.AttributeCacheID
+	authorization.PermissionScopes = grant.PermissionScopes
+	authorization.CompletedACR = grant.CompletedACR
+	authorization.AuthTime = grant.AuthTime
+	found, err := s.store.Update(ctx, *authorization)
+	if err != nil {
+		return "", fmt.Errorf("failed to update device authorization request: %w", err)
+	}
+	if !found {
+		return "", authz.ErrDeviceAuthorizationNotFound
+	}
+
+	return oauth2utils.GetURIWithQueryParams(s.verificationURI.String(),
+		map[string]string{verificationStatusParam: verificationStatusApproved})
+}
+
+// ConsumeDeviceCode answers a poll of the token endpoint. It returns the approved device authorization
+// request, which can then no longer be used, or the error telling the device why no tokens are issued.
+func (s *deviceAuthorizationService) ConsumeDeviceCode(
+	ctx context.Context, clientID string, deviceCode string,
+) (*DeviceAuthorization, *oauth2model.ErrorResponse) {
+	authorization, err := s.store.Get(ctx, deviceCode)
+	if err != nil {
+		s.logger.Error("Failed to retrieve device authorization request", log.Error(err))
+		return nil, serverError()
+	}
+	if authorization == nil || authorization.ClientID != clientID {
+		return nil, &oauth2model.ErrorResponse{
+			Error:            oauth2const.ErrorInvalidGrant,
+			ErrorDescription: "Invalid device code",
+		}
+	}
+	now := s.now()
+	if !now.Before(authorization.ExpiryTime) {
+		return nil, &oauth2model.ErrorResponse{
+			Error:            oauth2const.ErrorExpiredToken,
+			ErrorDescription: "The device code has expired",
+		}
+	}
+
+	if errResp := s.checkPollingInterval(ctx, deviceCode, now); errResp != nil {
+		return nil, errResp
+	}
+	if authorization.Status != statusApproved {
+		return nil, &oauth2model.ErrorResponse{
+			Error:            oauth2const.ErrorAuthorizationPending,
+			ErrorDescription: "The authorization request is still pending",
+		}
+	}
+
+	consumed, err := s.store.Consume(ctx, deviceCode)
+	if err != nil {
+		s.logger.Error("Failed to consume device authorization request", log.Error(err))
+		return nil, serverError()
+	}
+	if consumed == nil {
+		return nil, &oauth2model.ErrorResponse{
+			Error:            oauth2const.ErrorInvalidGrant,
+			ErrorDescription: "Invalid device code",
+		}
+	}
+	return consumed, nil
+}
+
+// checkPollingInterval records a poll and returns slow_down, increasing the interval of the device, when the
+// device polls before its interval elapsed.
+func (s *deviceAuthorizationService) checkPollingInterval(
+	ctx context.Context, deviceCode string, now time.Time,
+) *oauth2model.ErrorResponse {
+	state, err := s.store.GetPollState(ctx, deviceCode)
+	if err != nil {
+		s.logger.Error("Failed to retrieve device authorization poll state", log.Error(err))
+		return serverError()
+	}
+	if state == nil {
+		state = &pollState{Interval: s.interval}
+	}
+
+	tooFast := !state.LastPolledAt.IsZero() &&
+		now.Sub(state.LastPolledAt) < time.Duration(state.Interval)*time.Second
+	if tooFast {
+		state.Interval += slowDownIncrement
+	}
+	state.LastPolledAt = now
+	if err := s.store.UpdatePollState(ctx, deviceCode, *state); err != nil {
+		s.logger.Error("Failed to update device authorization poll state", log.Error(err))
+		return serverError()
+	}
+
+	if tooFast {
+		return &oauth2model.ErrorResponse{
+			Error:            oauth2const.ErrorSlowDown,
+			ErrorDescription: fmt.Sprintf("Polling too frequently, wait %d seconds between requests", state.Interval),
+		}
+	}
+	return nil
+}
+
+// generateUnusedUserCode generates a user code that is not in use by another pending request.
+func (s *deviceAuthorizationService) generateUnusedUserCode(ctx context.Context) (string, error) {
+	for range maxUserCodeAttempts {
+		userCode, err := generateUserCode()
+		if err != nil {
+			return "", err
+		}
+		exists, err := s.store.UserCodeExists(ctx, userCode)
+		if err != nil {
+			return "", err
+		}
+		if !exists {
+			return userCode, nil
+		}
+	}
+	return "", errors.New("no unused user code found")
+}
+
+// generateDeviceCode generates a cryptographically secure device code.
+func generateDeviceCode() (string, error) {
+	bytes := make([]byte, deviceCodeRandomBytes)
+	if _, err := rand.Read(bytes); err != nil {
+		return "", fmt.Errorf("failed to generate random bytes for device code: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(bytes), nil
+}
+
+// generateUserCode generates a random user code from the user code character set.
+func generateUserCode() (string, error) {
+	charsetSize := big.NewInt(int64(len(userCodeCharset)))
+	code := make([]byte, userCodeLength)
+	for i := range code {
+		index, err := rand.Int(rand.Reader, charsetSize)
+		if err != nil {
+			return "", fmt.Errorf("failed to generate random user code: %w", err)
+		}
+		code[i] = userCodeCharset[index.Int64()]
+	}
+	return string(code), nil
+}
+
+// formatUserCode splits a user code into two halves separated by a hyphen, as it is shown to the user.
+func formatUserCode(userCode string) string {
+	half := len(userCode) / 2
+	return userCode[:half] + "-" + userCode[half:]
+}
+
+// normalizeUserCode removes the separators and case differences a user may introduce when typing a user code.
+// It reports whether the result is a well-formed user code.
+func normalizeUserCode(userCode string) (string, bool) {
+	normalized := strings.Map(func(r rune) rune {
+		if r == '-' || r == ' ' {
+			return -1
+		}
+		return r
+	}, strings.ToUpper(strings.TrimSpace(userCode)))
+
+	if len(normalized) != userCodeLength {
+		return "", false
+	}
+	for _, c := range normalized {
+		if !strings.ContainsRune(userCodeCharset, c) {
+			return "", false
+		}
+	}
+	return normalized, true
+}
+
+// invalidUserCodeError returns the error of an unknown, expired or already used user code.
+func invalidUserCodeError() *oauth2model.ErrorResponse {
+	return &oauth2model.ErrorResponse{
+		Error:            oauth2const.ErrorInvalidRequest,
+		ErrorDescription: "The user code is invalid or has expired",
+	}
+}
+
+// serverError returns the error of an unexpected failure.
+func serverError() *oauth2model.ErrorResponse {
+	return &oauth2model.ErrorResponse{
+		Error:            oauth2const.ErrorServerError,
+		ErrorDescription: "Failed to process device authorization request",
+	}
+}
@@ -0,0 +1,285 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package device
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	inboundmodel "github.com/thunder-id/thunderid/internal/inboundclient/model"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/authz"
+	oauth2const "github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
+	oauth2model "github.com/thunder-id/thunderid/internal/oauth/oauth2/model"
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/tests/mocks/oauth/oauth2/authzmock"
+	"github.com/thunder-id/thunderid/tests/mocks/resourcemock"
+)
+
+const (
+	testClientID   = "test-client"
+	testDeviceCode = "test-device-code"
+	testUserCode   = "BCDFGHJK"
+)
+
+type DeviceAuthorizationServiceTestSuite struct {
+	suite.Suite
+	mockStore        *deviceAuthorizationStoreInterfaceMock
+	mockAuthzService *authzmock.AuthorizeServiceInterfaceMock
+	service          *deviceAuthorizationService
+	now              time.Time
+}
+
+func TestDeviceAuthorizationServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(DeviceAuthorizationServiceTestSuite))
+}
+
+func (suite *DeviceAuthorizationServiceTestSuite) SetupTest() {
+	config.ResetServerRuntime()
+	_ = config.InitializeServerRuntime("", &config.Config{})
+
+	suite.mockStore = newDeviceAuthorizationStoreInterfaceMock(suite.T())
+	suite.mockAuthzService = authzmock.NewAuthorizeServiceInterfaceMock(suite.T())
+	verificationURI, _ := url.Parse("https://localhost:5190/gate/device")
+	loginURL, _ := url.Parse("https://localhost:5190/gate/signin")
+	suite.service = newDeviceAuthorizationService(suite.mockStore, suite.mockAuthzService,
+		resourcemock.NewResourceServiceInterfaceMock(suite.T()), 0, 0, verificationURI,
+		loginURL).(*deviceAuthorizationService)
+	suite.now = time.Now()
+	suite.service.now = func() time.Time { return suite.now }
+}
+
+func (suite *DeviceAuthorizationServiceTestSuite) TearDownTest() {
+	config.ResetServerRuntime()
+}
+
+func (suite *DeviceAuthorizationServiceTestSuite) pendingAuthorization() *DeviceAuthorization {
+	return &DeviceAuthorization{
+		DeviceCode: testDeviceCode,
+		UserCode:   testUserCode,
+		ClientID:   testClientID,
+		Status:     statusPending,
+		ExpiryTime: suite.now.Add(time.Minute),
+	}
+}
+
+func (suite *DeviceAuthorizationServiceTestSuite) TestHandleDeviceAuthorizationRequest_Success() {
+	app := &inboundmodel.OAuthClient{
+		ClientID:   testClientID,
+		GrantTypes: []oauth2const.GrantType{oauth2const.GrantTypeDeviceCode},
+	}
+	suite.mockStore.EXPECT().UserCodeExists(mock.Anything, mock.Anything).Return(false, nil)
+	suite.mockStore.EXPECT().Store(mock.Anything, mock.MatchedBy(func(a DeviceAuthorization) bool {
+		return a.ClientID == testClientID && a.Status == statusPending && len(a.UserCode) == userCodeLength &&
+			a.ExpiryTime.Equal(suite.now.Add(time.Duration(defaultExpiresIn)*time.Second))
+	}), pollState{Interval: defaultInterval}).Return(nil)
+
+	resp, errResp := suite.service.HandleDeviceAuthorizationRequest(context.Background(),
+		map[string]string{oauth2const.RequestParamScope: "openid"}, nil, app)
+
+	assert.Nil(suite.T(), errResp)
+	assert.NotEmpty(suite.T(), resp.DeviceCode)
+	assert.Regexp(suite.T(), "^[BCDFGHJKLMNPQRSTVWXZ]{4}-[BCDFGHJKLMNPQRSTVWXZ]{4}$", resp.UserCode)
+	assert.Equal(suite.T(), "https://localhost:5190/gate/device", resp.VerificationURI)
+	assert.Contains(suite.T(), resp.VerificationURIComplete, "user_code="+resp.UserCode)
+	assert.Equal(suite.T(), defaultExpiresIn, resp.ExpiresIn)
+	assert.Equal(suite.T(), defaultInterval, resp.Interval)
+}
+
+func (suite *DeviceAuthorizationServiceTestSuite) TestHandleDeviceAuthorizationRequest_GrantNotAllowed() {
+	app := &inboundmodel.OAuthClient{
+		ClientID:   testClientID,
+		GrantTypes: []oauth2const.GrantType{oauth2const.GrantTypeAuthorizationCode},
+	}
+
+	resp, errResp := suite.service.HandleDeviceAuthorizationRequest(context.Background(),
+		map[string]string{}, nil, app)
+
+	assert.Nil(suite.T(), resp)
+	assert.Equal(suite.T(), oauth2const.ErrorUnauthorizedClient, errResp.Error)
+}
+
+func (suite *DeviceAuthorizationServiceTestSuite) TestInitiateVerification_Success() {
+	suite.mockStore.EXPECT().GetDeviceCode(mock.Anything, testUserCode).Return(testDeviceCode, nil)
+	suite.mockStore.EXPECT().Get(mock.Anything, testDeviceCode).Return(suite.pendingAuthorization(), nil)
+	suite.mockAuthzService.EXPECT().InitiateDeviceVerification(mock.Anything,
+		mock.MatchedBy(func(p *oauth2model.OAuthParameters) bool { return p.ClientID == testClientID }),
+		testUserCode).
+		Return(&authz.AuthorizationInitResult{QueryParams: map[string]string{oauth2const.AuthID: "auth-id"}}, nil)
+
+	redirectURI, errResp := suite.service.InitiateVerification(context.Background(), " bcdf-ghjk ")
+
+	assert.Nil(suite.T(), errResp)
+	assert.Equal(suite.T(), "https://localhost:5190/gate/signin?authId=auth-id", redirectURI)
+}
+
+func (suite *DeviceAuthorizationServiceTestSuite) TestInitiateVerification_InvalidUserCode() {
+	redirectURI, errResp := suite.service.InitiateVerification(context.Background(), "AEIO-UAEI")
+
+	assert.Empty(suite.T(), redirectURI)
+	assert.Equal(suite.T(), oauth2const.ErrorInvalidRequest, errResp.Error)
+}
+
+func (suite *DeviceAuthorizationServiceTestSuite) TestInitiateVerification_UnknownUserCode() {
+	suite.mockStore.EXPECT().GetDeviceCode(mock.Anything, testUserCode).Return("", nil)
+
+	redirectURI, errResp := suite.service.InitiateVerification(context.Background(), testUserCode)
+
+	assert.Empty(suite.T(), redirectURI)
+	assert.Equal(suite.T(), oauth2const.ErrorInvalidRequest, errResp.Error)
+}
+
+func (suite *DeviceAuthorizationServiceTestSuite) TestApproveDeviceAuthorization_Success() {
+	suite.mockStore.EXPECT().ConsumeUserCode(mock.Anything, testUserCode).Return(testDeviceCode, nil)
+	suite.mockStore.EXPECT().Get(mock.Anything, testDeviceCode).Return(suite.pendingAuthorization(), nil)
+	suite.mockStore.EXPECT().Update(mock.Anything, mock.MatchedBy(func(a DeviceAuthorization) bool {
+		return a.Status == statusApproved && a.AuthorizedUserID == "user-1" &&
+			len(a.PermissionScopes) == 1 && a.PermissionScopes[0] == "read"
+	})).Return(true, nil)
+
+	redirectURI, err := suite.service.ApproveDeviceAuthorization(context.Background(), authz.DeviceAuthorizationGrant{
+		UserCode:         testUserCode,
+		AuthorizedUserID: "user-1",
+		PermissionScopes: []string{"read"},
+	})
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "https://localhost:5190/gate/device?status=approved", redirectURI)
+}
+
+func (suite *DeviceAuthorizationServiceTestSuite) TestApproveDeviceAuthorization_UserCodeAlreadyUsed() {
+	suite.mockStore.EXPECT().ConsumeUserCode(mock.Anything, testUserCode).Return("", nil)
+
+	_, err := suite.service.ApproveDeviceAuthorization(context.Background(),
+		authz.DeviceAuthorizationGrant{UserCode: testUserCode})
+
+	assert.ErrorIs(suite.T(), err, authz.ErrDeviceAuthorizationNotFound)
+}
+
+func (suite *DeviceAuthorizationServiceTestSuite) TestApproveDeviceAuthorization_StoreError() {
+	suite.mockStore.EXPECT().ConsumeUserCode(mock.Anything, testUserCode).Return("", errors.New("db error"))
+
+	_, err := suite.service.ApproveDeviceAuthorization(context.Background(),
+		authz.DeviceAuthorizationGrant{UserCode: testUserCode})
+
+	assert.Error(suite.T(), err)
+	assert.NotErrorIs(suite.T(), err, authz.ErrDeviceAuthorizationNotFound)
+}
+
+func (suite *DeviceAuthorizationServiceTestSuite) TestConsumeDeviceCode_AuthorizationPending() {
+	suite.mockStore.EXPECT().Get(mock.Anything, testDeviceCode).Return(suite.pendingAuthorization(), nil)
+	suite.mockStore.EXPECT().GetPollState(mock.Anything, testDeviceCode).
+		Return(&pollState{Interval: defaultInterval}, nil)
+	suite.mockStore.EXPECT().UpdatePollState(mock.Anything, testDeviceCode,
+		pollState{Interval: defaultInterval, LastPolledAt: suite.now}).Return(nil)
+
+	authorization, errResp := suite.service.ConsumeDeviceCode(context.Background(), testClientID, testDeviceCode)
+
+	assert.Nil(suite.T(), authorization)
+	assert.Equal(suite.T(), oauth2const.ErrorAuthorizationPending, errResp.Error)
+}
+
+func (suite *DeviceAuthorizationServiceTestSuite) TestConsumeDeviceCode_SlowDown() {
+	suite.mockStore.EXPECT().Get(mock.Anything, testDeviceCode).Return(suite.pendingAuthorization(), nil)
+	suite.mockStore.EXPECT().GetPollState(mock.Anything, testDeviceCode).
+		Return(&pollState{Interval: defaultInterval, LastPolledAt: suite.now.Add(-2 * time.Second)}, nil)
+	suite.mockStore.EXPECT().UpdatePollState(mock.Anything, testDeviceCode,
+		pollState{Interval: defaultInterval + slowDownIncrement, LastPolledAt: suite.now}).Return(nil)
+
+	authorization, errResp := suite.service.ConsumeDeviceCode(context.Background(), testClientID, testDeviceCode)
+
+	assert.Nil(suite.T(), authorization)
+	assert.Equal(suite.T(), oauth2const.ErrorSlowDown, errResp.Error)
+}
+
+func (suite *DeviceAuthorizationServiceTestSuite) TestConsumeDeviceCode_Expired() {
+	expired := suite.pendingAuthorization()
+	expired.ExpiryTime = suite.now.Add(-time.Second)
+	suite.mockStore.EXPECT().Get(mock.Anything, testDeviceCode).Return(expired, nil)
+
+	authorization, errResp := suite.service.ConsumeDeviceCode(context.Background(), testClientID, testDeviceCode)
+
+	assert.Nil(suite.T(), authorization)
+	assert.Equal(suite.T(), oauth2const.ErrorExpiredToken, errResp.Error)
+}
+
+func (suite *DeviceAuthorizationServiceTestSuite) TestConsumeDeviceCode_ClientMismatch() {
+	suite.mockStore.EXPECT().Get(mock.Anything, testDeviceCode).Return(suite.pendingAuthorization(), nil)
+
+	authorization, errResp := suite.service.ConsumeDeviceCode(context.Background(), "other-client", testDeviceCode)
+
+	assert.Nil(suite.T(), authorization)
+	assert.Equal(suite.T(), oauth2const.ErrorInvalidGrant, errResp.Error)
+}
+
+func (suite *DeviceAuthorizationServiceTestSuite) TestConsumeDeviceCode_Approved() {
+	approved := suite.pendingAuthorization()
+	approved.Status = statusApproved
+	approved.AuthorizedUserID = "user-1"
+	suite.mockStore.EXPECT().Get(mock.Anything, testDeviceCode).Return(approved, nil)
+	suite.mockStore.EXPECT().GetPollState(mock.Anything, testDeviceCode).
+		Return(&pollState{Interval: defaultInterval, LastPolledAt: suite.now.Add(-time.Minute)}, nil)
+	suite.mockStore.EXPECT().UpdatePollState(mock.Anything, testDeviceCode, mock.Anything).Return(nil)
+	suite.mockStore.EXPECT().Consume(mock.Anything, testDeviceCode).Return(approved, nil)
+
+	authorization, errResp := suite.service.ConsumeDeviceCode(context.Background(), testClientID, testDeviceCode)
+
+	assert.Nil(suite.T(), errResp)
+	assert.Equal(suite.T(), "user-1", authorization.AuthorizedUserID)
+}
+
+func (suite *DeviceAuthorizationServiceTestSuite) TestConsumeDeviceCode_AlreadyConsumed() {
+	approved := suite.pendingAuthorization()
+	approved.Status = statusApproved
+	suite.mockStore.EXPECT().Get(mock.Anything, testDeviceCode).Return(approved, nil)
+	suite.mockStore.EXPECT().GetPollState(mock.Anything, testDeviceCode).Return(nil, nil)
+	suite.mockStore.EXPECT().UpdatePollState(mock.Anything, testDeviceCode, mock.Anything).Return(nil)
+	suite.mockStore.EXPECT().Consume(mock.Anything, testDeviceCode).Return(nil, nil)
+
+	authorization, errResp := suite.service.ConsumeDeviceCode(context.Background(), testClientID, testDeviceCode)
+
+	assert.Nil(suite.T(), authorization)
+	assert.Equal(suite.T(), oauth2const.ErrorInvalidGrant, errResp.Error)
+}
+
+func TestNormalizeUserCode(t *testing.T) {
+	normalized, ok := normalizeUserCode("bcdf-ghjk")
+	assert.True(t, ok)
+	assert.Equal(t, "BCDFGHJK", normalized)
+
+	_, ok = normalizeUserCode("BCDF-GHJ")
+	assert.False(t, ok)
+	_, ok = normalizeUserCode("BCDF-GHJA")
+	assert.False(t, ok)
+}
+
+func TestGenerateUserCode(t *testing.T) {
+	userCode, err := generateUserCode()
+	assert.NoError(t, err)
+	assert.Len(t, userCode, userCodeLength)
+	normalized, ok := normalizeUserCode(formatUserCode(userCode))
+	assert.True(t, ok)
+	assert.Equal(t, userCode, normalized)
+}
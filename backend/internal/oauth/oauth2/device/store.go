@@ -0,0 +1,167 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package device
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/tokenstore"
+)
+
+// deviceAuthorizationStoreInterface defines the interface for device authorization request storage.
+type deviceAuthorizationStoreInterface interface {
+	Store(ctx context.Context, authorization DeviceAuthorization, state pollState) error
+	UserCodeExists(ctx context.Context, userCode string) (bool, error)
+	GetDeviceCode(ctx context.Context, userCode string) (string, error)
+	ConsumeUserCode(ctx context.Context, userCode string) (string, error)
+	Get(ctx context.Context, deviceCode string) (*DeviceAuthorization, error)
+	Update(ctx context.Context, authorization DeviceAuthorization) (bool, error)
+	Consume(ctx context.Context, deviceCode string) (*DeviceAuthorization, error)
+	GetPollState(ctx context.Context, deviceCode string) (*pollState, error)
+	UpdatePollState(ctx context.Context, deviceCode string, state pollState) error
+}
+
+// deviceAuthorizationStore keeps device authorization requests in the device code token store. A request is
+// kept under its device code, along with its polling state and an entry resolving its user code.
+type deviceAuthorizationStore struct {
+	tokenStore tokenstore.TokenStoreInterface
+}
+
+// newDeviceAuthorizationStore creates a new device authorization store backed by the given token store.
+func newDeviceAuthorizationStore(tokenStore tokenstore.TokenStoreInterface) deviceAuthorizationStoreInterface {
+	return &deviceAuthorizationStore{tokenStore: tokenStore}
+}
+
+// Store persists a new device authorization request. The request and its polling state outlive the request
+// expiry so that late polls can be told that the device code expired.
+func (s *deviceAuthorizationStore) Store(
+	ctx context.Context, authorization DeviceAuthorization, state pollState,
+) error {
+	data, err := json.Marshal(authorization)
+	if err != nil {
+		return fmt.Errorf("failed to marshal device authorization: %w", err)
+	}
+	stateData, err := json.Marshal(state)
+	if err != nil {
+		return fmt.Errorf("failed to marshal device authorization poll state: %w", err)
+	}
+
+	retainUntil := authorization.ExpiryTime.Add(expiredRetention)
+	if err := s.tokenStore.Store(ctx, deviceCodeKeyPrefix+authorization.DeviceCode, data,
+		retainUntil); err != nil {
+		return err
+	}
+	if err := s.tokenStore.Store(ctx, pollKeyPrefix+authorization.DeviceCode, stateData,
+		retainUntil); err != nil {
+		return err
+	}
+	return s.tokenStore.Store(ctx, userCodeKeyPrefix+authorization.UserCode, []byte(authorization.DeviceCode),
+		authorization.ExpiryTime)
+}
+
+// UserCodeExists reports whether the user code belongs to an unexpired device authorization request.
+func (s *deviceAuthorizationStore) UserCodeExists(ctx context.Context, userCode string) (bool, error) {
+	_, found, err := s.tokenStore.Get(ctx, userCodeKeyPrefix+userCode)
+	return found, err
+}
+
+// GetDeviceCode returns the device code of the request with the user code, or an empty string when the
+// user code is unknown, expired or already used.
+func (s *deviceAuthorizationStore) GetDeviceCode(ctx context.Context, userCode string) (string, error) {
+	data, found, err := s.tokenStore.Get(ctx, userCodeKeyPrefix+userCode)
+	if err != nil || !found {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// ConsumeUserCode removes the user code so that it cannot be verified again and returns the device code of
+// its request, or an empty string when the user code is unknown, expired or already used.
+func (s *deviceAuthorizationStore) ConsumeUserCode(ctx context.Context, userCode string) (string, error) {
+	data, found, err := s.tokenStore.Consume(ctx, userCodeKeyPrefix+userCode)
+	if err != nil || !found {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// Get returns the device authorization request with the device code, or nil when it is not found.
+func (s *deviceAuthorizationStore) Get(ctx context.Context, deviceCode string) (*DeviceAuthorization, error) {
+	data, found, err := s.tokenStore.Get(ctx, deviceCodeKeyPrefix+deviceCode)
+	if err != nil || !found {
+		return nil, err
+	}
+	return unmarshalDeviceAuthorization(data)
+}
+
+// Update replaces a stored device authorization request. It reports whether the request was found.
+func (s *deviceAuthorizationStore) Update(ctx context.Context, authorization DeviceAuthorization) (bool, error) {
+	data, err := json.Marshal(authorization)
+	if err != nil {
+		return false, fmt.Errorf("failed to marshal device authorization: %w", err)
+	}
+	return s.tokenStore.Update(ctx, deviceCodeKeyPrefix+authorization.DeviceCode, data)
+}
+
+// Consume atomically removes the device authorization request with the device code, along with its polling
+// state, and returns it. It returns nil when the request is not found or was already consumed.
+func (s *deviceAuthorizationStore) Consume(ctx context.Context, deviceCode string) (*DeviceAuthorization, error) {
+	data, found, err := s.tokenStore.Consume(ctx, deviceCodeKeyPrefix+deviceCode)
+	if err != nil || !found {
+		return nil, err
+	}
+	if err := s.tokenStore.Delete(ctx, pollKeyPrefix+deviceCode); err != nil {
+		return nil, err
+	}
+	return unmarshalDeviceAuthorization(data)
+}
+
+// GetPollState returns the polling state of the request with the device code, or nil when it is not found.
+func (s *deviceAuthorizationStore) GetPollState(ctx context.Context, deviceCode string) (*pollState, error) {
+	data, found, err := s.tokenStore.Get(ctx, pollKeyPrefix+deviceCode)
+	if err != nil || !found {
+		return nil, err
+	}
+	var state pollState
+	if err := json.Unmarshal(data, &state); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal device authorization poll state: %w", err)
+	}
+	return &state, nil
+}
+
+// UpdatePollState replaces the polling state of the request with the device code.
+func (s *deviceAuthorizationStore) UpdatePollState(ctx context.Context, deviceCode string, state pollState) error {
+	data, err := json.Marshal(state)
+	if err != nil {
+		return fmt.Errorf("failed to marshal device authorization poll state: %w", err)
+	}
+	_, err = s.tokenStore.Update(ctx, pollKeyPrefix+deviceCode, data)
+	return err
+}
+
+// unmarshalDeviceAuthorization decodes a stored device authorization request.
+func unmarshalDeviceAuthorization(data []byte) (*DeviceAuthorization, error) {
+	var authorization DeviceAuthorization
+	if err := json.Unmarshal(data, &authorization); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal device authorization: %w", err)
+	}
+	return &authorization, nil
+}
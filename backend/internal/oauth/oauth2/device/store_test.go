@@ -0,0 +1,151 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package device
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/tests/mocks/oauth/oauth2/tokenstoremock"
+)
+
+type DeviceAuthorizationStoreTestSuite struct {
+	suite.Suite
+	mockTokenStore *tokenstoremock.TokenStoreInterfaceMock
+	store          deviceAuthorizationStoreInterface
+}
+
+func TestDeviceAuthorizationStoreTestSuite(t *testing.T) {
+	suite.Run(t, new(DeviceAuthorizationStoreTestSuite))
+}
+
+func (suite *DeviceAuthorizationStoreTestSuite) SetupTest() {
+	suite.mockTokenStore = tokenstoremock.NewTokenStoreInterfaceMock(suite.T())
+	suite.store = newDeviceAuthorizationStore(suite.mockTokenStore)
+}
+
+func (suite *DeviceAuthorizationStoreTestSuite) TestStore() {
+	expiry := time.Now().Add(time.Minute)
+	authorization := DeviceAuthorization{DeviceCode: testDeviceCode, UserCode: testUserCode, ExpiryTime: expiry}
+	suite.mockTokenStore.EXPECT().Store(mock.Anything, deviceCodeKeyPrefix+testDeviceCode, mock.Anything,
+		expiry.Add(expiredRetention)).Return(nil)
+	suite.mockTokenStore.EXPECT().Store(mock.Anything, pollKeyPrefix+testDeviceCode, mock.Anything,
+		expiry.Add(expiredRetention)).Return(nil)
+	suite.mockTokenStore.EXPECT().Store(mock.Anything, userCodeKeyPrefix+testUserCode, []byte(testDeviceCode),
+		expiry).Return(nil)
+
+	err := suite.store.Store(context.Background(), authorization, pollState{Interval: defaultInterval})
+
+	assert.NoError(suite.T(), err)
+}
+
+func (suite *DeviceAuthorizationStoreTestSuite) TestStore_Error() {
+	suite.mockTokenStore.EXPECT().Store(mock.Anything, deviceCodeKeyPrefix+testDeviceCode, mock.Anything,
+		mock.Anything).Return(errors.New("store error"))
+
+	err := suite.store.Store(context.Background(), DeviceAuthorization{DeviceCode: testDeviceCode},
+		pollState{})
+
+	assert.Error(suite.T(), err)
+}
+
+func (suite *DeviceAuthorizationStoreTestSuite) TestGetDeviceCode() {
+	suite.mockTokenStore.EXPECT().Get(mock.Anything, userCodeKeyPrefix+testUserCode).
+		Return([]byte(testDeviceCode), true, nil)
+
+	deviceCode, err := suite.store.GetDeviceCode(context.Background(), testUserCode)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), testDeviceCode, deviceCode)
+}
+
+func (suite *DeviceAuthorizationStoreTestSuite) TestConsumeUserCode_NotFound() {
+	suite.mockTokenStore.EXPECT().Consume(mock.Anything, userCodeKeyPrefix+testUserCode).Return(nil, false, nil)
+
+	deviceCode, err := suite.store.ConsumeUserCode(context.Background(), testUserCode)
+
+	assert.NoError(suite.T(), err)
+	assert.Empty(suite.T(), deviceCode)
+}
+
+func (suite *DeviceAuthorizationStoreTestSuite) TestGet() {
+	data, _ := json.Marshal(DeviceAuthorization{DeviceCode: testDeviceCode, ClientID: testClientID})
+	suite.mockTokenStore.EXPECT().Get(mock.Anything, deviceCodeKeyPrefix+testDeviceCode).Return(data, true, nil)
+
+	authorization, err := suite.store.Get(context.Background(), testDeviceCode)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), testClientID, authorization.ClientID)
+}
+
+func (suite *DeviceAuthorizationStoreTestSuite) TestGet_InvalidData() {
+	suite.mockTokenStore.EXPECT().Get(mock.Anything, deviceCodeKeyPrefix+testDeviceCode).
+		Return([]byte("invalid"), true, nil)
+
+	authorization, err := suite.store.Get(context.Background(), testDeviceCode)
+
+	assert.Error(suite.T(), err)
+	assert.Nil(suite.T(), authorization)
+}
+
+func (suite *DeviceAuthorizationStoreTestSuite) TestConsume() {
+	data, _ := json.Marshal(DeviceAuthorization{DeviceCode: testDeviceCode, Status: statusApproved})
+	suite.mockTokenStore.EXPECT().Consume(mock.Anything, deviceCodeKeyPrefix+testDeviceCode).
+		Return(data, true, nil)
+	suite.mockTokenStore.EXPECT().Delete(mock.Anything, pollKeyPrefix+testDeviceCode).Return(nil)
+
+	authorization, err := suite.store.Consume(context.Background(), testDeviceCode)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), statusApproved, authorization.Status)
+}
+
+func (suite *DeviceAuthorizationStoreTestSuite) TestConsume_AlreadyConsumed() {
+	suite.mockTokenStore.EXPECT().Consume(mock.Anything, deviceCodeKeyPrefix+testDeviceCode).
+		Return(nil, false, nil)
+
+	authorization, err := suite.store.Consume(context.Background(), testDeviceCode)
+
+	assert.NoError(suite.T(), err)
+	assert.Nil(suite.T(), authorization)
+}
+
+func (suite *DeviceAuthorizationStoreTestSuite) TestPollState() {
+	polledAt := time.Now().UTC()
+	suite.mockTokenStore.EXPECT().Update(mock.Anything, pollKeyPrefix+testDeviceCode, mock.Anything).
+		Return(true, nil)
+	data, _ := json.Marshal(pollState{Interval: 10, LastPolledAt: polledAt})
+	suite.mockTokenStore.EXPECT().Get(mock.Anything, pollKeyPrefix+testDeviceCode).Return(data, true, nil)
+
+	err := suite.store.UpdatePollState(context.Background(), testDeviceCode,
+		pollState{Interval: 10, LastPolledAt: polledAt})
+	assert.NoError(suite.T(), err)
+
+	state, err := suite.store.GetPollState(context.Background(), testDeviceCode)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), int64(10), state.Interval)
+	assert.True(suite.T(), polledAt.Equal(state.LastPolledAt))
+}
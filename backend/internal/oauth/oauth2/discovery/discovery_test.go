@@ -30,18 +30,21 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 
+	"github.com/thunder-id/thunderid/internal/oauth/metadatacache"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
 	"github.com/thunder-id/thunderid/internal/system/config"
 	"github.com/thunder-id/thunderid/internal/system/cryptolab"
 	"github.com/thunder-id/thunderid/internal/system/kmprovider"
 	"github.com/thunder-id/thunderid/tests/mocks/crypto/cryptomock"
+	"github.com/thunder-id/thunderid/tests/mocks/metadatacachemock"
 )
 
 type DiscoveryTestSuite struct {
 	suite.Suite
-	cryptoMock       *cryptomock.RuntimeCryptoProviderMock
-	discoveryService DiscoveryServiceInterface
-	handler          discoveryHandlerInterface
+	cryptoMock        *cryptomock.RuntimeCryptoProviderMock
+	metadataCacheMock *metadatacachemock.MetadataCacheServiceInterfaceMock
+	discoveryService  DiscoveryServiceInterface
+	handler           discoveryHandlerInterface
 }
 
 func TestDiscoverySuite(t *testing.T) {
@@ -72,7 +75,10 @@ func (suite *DiscoveryTestSuite) SetupTest() {
 	_ = config.InitializeServerRuntime("test", testConfig)
 
 	suite.cryptoMock = cryptomock.NewRuntimeCryptoProviderMock(suite.T())
-	suite.discoveryService = newDiscoveryService(suite.cryptoMock)
+	suite.metadataCacheMock = metadatacachemock.NewMetadataCacheServiceInterfaceMock(suite.T())
+	suite.metadataCacheMock.On("GetVersion", mock.Anything, metadatacache.SurrogateKeyJWKS).
+		Return("0123456789abcdef", nil).Maybe()
+	suite.discoveryService = newDiscoveryService(suite.cryptoMock, suite.metadataCacheMock)
 	suite.handler = newDiscoveryHandler(suite.discoveryService)
 }
 
@@ -98,6 +104,7 @@ func (suite *DiscoveryTestSuite) TestOAuth2AuthorizationServerMetadata() {
 	assert.NotEmpty(suite.T(), metadata.JWKSUri)
 	assert.NotEmpty(suite.T(), metadata.RegistrationEndpoint)
 	assert.NotEmpty(suite.T(), metadata.IntrospectionEndpoint)
+	assert.NotEmpty(suite.T(), metadata.DeviceAuthorizationEndpoint)
 	assert.NotEmpty(suite.T(), metadata.UserInfoEndpoint)
 
 	// Verify only implemented endpoints are present
@@ -115,6 +122,11 @@ func (suite *DiscoveryTestSuite) TestOAuth2AuthorizationServerMetadata() {
 
 	// Verify RFC 9207 advertisement
 	assert.True(suite.T(), metadata.AuthorizationResponseIssParameterSupported)
+
+	// Verify RFC 9101 advertisement
+	assert.True(suite.T(), metadata.RequestParameterSupported)
+	assert.Contains(suite.T(), metadata.RequestObjectSigningAlgValuesSupported, "RS256")
+	assert.Contains(suite.T(), metadata.DPoPSigningAlgValuesSupported, "RS256")
 }
 
 func (suite *DiscoveryTestSuite) TestOIDCDiscovery() {
@@ -213,11 +225,12 @@ func TestGetSupportedGrantTypes(t *testing.T) {
 	supported := constants.GetSupportedGrantTypes()
 
 	assert.NotNil(t, supported)
-	assert.Equal(t, 4, len(supported))
+	assert.Equal(t, 5, len(supported))
 	assert.Contains(t, supported, "authorization_code")
 	assert.Contains(t, supported, "client_credentials")
 	assert.Contains(t, supported, "refresh_token")
 	assert.Contains(t, supported, "urn:ietf:params:oauth:grant-type:token-exchange")
+	assert.Contains(t, supported, "urn:ietf:params:oauth:grant-type:device_code")
 	assert.NotContains(t, supported, "password")
 	assert.NotContains(t, supported, "implicit")
 }
@@ -267,7 +280,7 @@ func (suite *DiscoveryTestSuite) TestInitialize() {
 		Return([]kmprovider.PublicKeyInfo{{KeyID: "k1", Algorithm: cryptolab.AlgorithmRS256}}, nil)
 
 	mux := http.NewServeMux()
-	service := Initialize(mux, suite.cryptoMock)
+	service := Initialize(mux, suite.cryptoMock, metadatacache.Initialize())
 
 	assert.NotNil(suite.T(), service)
 	assert.Implements(suite.T(), (*DiscoveryServiceInterface)(nil), service)
@@ -309,7 +322,7 @@ func (suite *DiscoveryTestSuite) TestGetBaseURL_WithPublicHostname() {
 	}
 	_ = config.InitializeServerRuntime("test", testConfig)
 
-	service := newDiscoveryService(suite.cryptoMock)
+	service := newDiscoveryService(suite.cryptoMock, suite.metadataCacheMock)
 	metadata := service.GetOAuth2AuthorizationServerMetadata(context.Background())
 	assert.Contains(suite.T(), metadata.AuthorizationEndpoint, "public.thunder.io")
 	config.ResetServerRuntime()
@@ -329,7 +342,7 @@ func (suite *DiscoveryTestSuite) TestGetBaseURL_WithHTTPOnly() {
 	}
 	_ = config.InitializeServerRuntime("test", testConfig)
 
-	service := newDiscoveryService(suite.cryptoMock)
+	service := newDiscoveryService(suite.cryptoMock, suite.metadataCacheMock)
 	metadata := service.GetOAuth2AuthorizationServerMetadata(context.Background())
 	assert.Contains(suite.T(), metadata.AuthorizationEndpoint, "http://")
 	config.ResetServerRuntime()
@@ -343,7 +356,7 @@ func (suite *DiscoveryTestSuite) TestOIDCDiscovery_MultipleKeyAlgorithms() {
 			{KeyID: "k2", Algorithm: cryptolab.AlgorithmES256},
 			{KeyID: "k3", Algorithm: cryptolab.AlgorithmEdDSA},
 		}, nil)
-	svc := newDiscoveryService(cryptoMock)
+	svc := newDiscoveryService(cryptoMock, suite.metadataCacheMock)
 	meta, err := svc.GetOIDCMetadata(context.Background())
 	assert.NoError(suite.T(), err)
 	algs := meta.IDTokenSigningAlgValuesSupported
@@ -361,7 +374,7 @@ func (suite *DiscoveryTestSuite) TestOIDCDiscovery_DeduplicatesAlgorithms() {
 			{KeyID: "k1", Algorithm: cryptolab.AlgorithmRS256},
 			{KeyID: "k2", Algorithm: cryptolab.AlgorithmRS256},
 		}, nil)
-	svc := newDiscoveryService(cryptoMock)
+	svc := newDiscoveryService(cryptoMock, suite.metadataCacheMock)
 	meta, err := svc.GetOIDCMetadata(context.Background())
 	assert.NoError(suite.T(), err)
 	algs := meta.IDTokenSigningAlgValuesSupported
@@ -369,3 +382,37 @@ func (suite *DiscoveryTestSuite) TestOIDCDiscovery_DeduplicatesAlgorithms() {
 	assert.Equal(suite.T(), 1, len(algs))
 	assert.Contains(suite.T(), algs, "RS256")
 }
+
+func (suite *DiscoveryTestSuite) TestOAuth2AuthorizationServerMetadata_VersionedJWKSUri() {
+	metadata := suite.discoveryService.GetOAuth2AuthorizationServerMetadata(context.Background())
+
+	assert.Equal(suite.T(), "https://localhost:8080/oauth2/jwks?v=0123456789abcdef", metadata.JWKSUri)
+}
+
+func (suite *DiscoveryTestSuite) TestOAuth2AuthorizationServerMetadata_UnversionedJWKSUri() {
+	metadataCacheMock := metadatacachemock.NewMetadataCacheServiceInterfaceMock(suite.T())
+	metadataCacheMock.On("GetVersion", mock.Anything, metadatacache.SurrogateKeyJWKS).
+		Return("", metadatacache.ErrDocumentNotRegistered).Once()
+	service := newDiscoveryService(suite.cryptoMock, metadataCacheMock)
+
+	metadata := service.GetOAuth2AuthorizationServerMetadata(context.Background())
+
+	assert.Equal(suite.T(), "https://localhost:8080/oauth2/jwks", metadata.JWKSUri)
+}
+
+func (suite *DiscoveryTestSuite) TestOIDCDiscovery_StableOrdering() {
+	suite.cryptoMock.EXPECT().GetPublicKeys(mock.Anything, kmprovider.PublicKeyFilter{}).
+		Return([]kmprovider.PublicKeyInfo{
+			{KeyID: "k1", Algorithm: cryptolab.AlgorithmRS256},
+			{KeyID: "k2", Algorithm: cryptolab.AlgorithmES256},
+		}, nil)
+
+	first, err := suite.discoveryService.GetOIDCMetadata(context.Background())
+	assert.NoError(suite.T(), err)
+	second, err := suite.discoveryService.GetOIDCMetadata(context.Background())
+	assert.NoError(suite.T(), err)
+
+	assert.Equal(suite.T(), []string{"ES256", "RS256"}, first.IDTokenSigningAlgValuesSupported)
+	assert.IsNonDecreasing(suite.T(), first.ScopesSupported)
+	assert.Equal(suite.T(), first.ClaimsSupported, second.ClaimsSupported)
+}
@@ -19,22 +19,40 @@
 package discovery
 
 import (
+	"context"
 	"net/http"
 
+	"github.com/thunder-id/thunderid/internal/oauth/metadatacache"
 	"github.com/thunder-id/thunderid/internal/system/kmprovider"
 	"github.com/thunder-id/thunderid/internal/system/middleware"
 )
 
-// Initialize initializes the discovery service and registers its routes
-func Initialize(mux *http.ServeMux, cryptoProvider kmprovider.RuntimeCryptoProvider) DiscoveryServiceInterface {
-	discoveryService := newDiscoveryService(cryptoProvider)
+// Initialize initializes the discovery service, registers the discovery documents with the metadata
+// cache and registers its routes
+func Initialize(
+	mux *http.ServeMux,
+	cryptoProvider kmprovider.RuntimeCryptoProvider,
+	metadataCache metadatacache.MetadataCacheServiceInterface,
+) DiscoveryServiceInterface {
+	discoveryService := newDiscoveryService(cryptoProvider, metadataCache)
+	metadataCache.RegisterDocument(metadatacache.SurrogateKeyAuthorizationServer,
+		func(ctx context.Context) (any, error) {
+			return discoveryService.GetOAuth2AuthorizationServerMetadata(ctx), nil
+		})
+	metadataCache.RegisterDocument(metadatacache.SurrogateKeyOpenIDConfiguration,
+		func(ctx context.Context) (any, error) {
+			return discoveryService.GetOIDCMetadata(ctx)
+		})
+
 	discoveryHandler := newDiscoveryHandler(discoveryService)
-	registerRoutes(mux, discoveryHandler)
+	registerRoutes(mux, discoveryHandler, metadataCache)
 	return discoveryService
 }
 
 // registerRoutes registers the routes for discovery endpoints
-func registerRoutes(mux *http.ServeMux, handler discoveryHandlerInterface) {
+func registerRoutes(
+	mux *http.ServeMux, handler discoveryHandlerInterface, metadataCache metadatacache.MetadataCacheServiceInterface,
+) {
 	opts := middleware.CORSOptions{
 		AllowedMethods:   []string{"GET", "OPTIONS"},
 		AllowedHeaders:   []string{"Content-Type"},
@@ -43,14 +61,15 @@ func registerRoutes(mux *http.ServeMux, handler discoveryHandlerInterface) {
 	}
 
 	mux.HandleFunc(middleware.WithCORS("GET /.well-known/oauth-authorization-server",
-		handler.HandleOAuth2AuthorizationServerMetadata, opts))
+		metadataCache.Handle(metadatacache.SurrogateKeyAuthorizationServer,
+			handler.HandleOAuth2AuthorizationServerMetadata), opts))
 	mux.HandleFunc(middleware.WithCORS("OPTIONS /.well-known/oauth-authorization-server",
 		func(w http.ResponseWriter, r *http.Request) {
 			w.WriteHeader(http.StatusNoContent)
 		}, opts))
 
 	mux.HandleFunc(middleware.WithCORS("GET /.well-known/openid-configuration",
-		handler.HandleOIDCDiscovery, opts))
+		metadataCache.Handle(metadatacache.SurrogateKeyOpenIDConfiguration, handler.HandleOIDCDiscovery), opts))
 	mux.HandleFunc(middleware.WithCORS("OPTIONS /.well-known/openid-configuration",
 		func(w http.ResponseWriter, r *http.Request) {
 			w.WriteHeader(http.StatusNoContent)
@@ -30,12 +30,16 @@ type OAuth2AuthorizationServerMetadata struct {
 	IntrospectionEndpoint                      string   `json:"introspection_endpoint,omitempty"`
 	PushedAuthorizationRequestEndpoint         string   `json:"pushed_authorization_request_endpoint,omitempty"`
 	RequirePushedAuthorizationRequests         bool     `json:"require_pushed_authorization_requests,omitempty"`
+	DeviceAuthorizationEndpoint                string   `json:"device_authorization_endpoint,omitempty"`
 	ScopesSupported                            []string `json:"scopes_supported"`
 	ResponseTypesSupported                     []string `json:"response_types_supported"`
 	GrantTypesSupported                        []string `json:"grant_types_supported"`
 	TokenEndpointAuthMethodsSupported          []string `json:"token_endpoint_auth_methods_supported"`
 	CodeChallengeMethodsSupported              []string `json:"code_challenge_methods_supported,omitempty"`
 	AuthorizationResponseIssParameterSupported bool     `json:"authorization_response_iss_parameter_supported"`
+	RequestParameterSupported                  bool     `json:"request_parameter_supported"`
+	RequestObjectSigningAlgValuesSupported     []string `json:"request_object_signing_alg_values_supported,omitempty"`
+	DPoPSigningAlgValuesSupported              []string `json:"dpop_signing_alg_values_supported,omitempty"`
 }
 
 // OIDCProviderMetadata represents OpenID Connect Provider Metadata (OIDC Discovery 1.0)
@@ -51,5 +55,9 @@ type OIDCProviderMetadata struct {
 	ClaimsSupported                      []string `json:"claims_supported"`
 	ClaimsParameterSupported             bool     `json:"claims_parameter_supported"`
 	EndSessionEndpoint                   string   `json:"end_session_endpoint,omitempty"`
+	FrontchannelLogoutSupported          bool     `json:"frontchannel_logout_supported"`
+	FrontchannelLogoutSessionSupported   bool     `json:"frontchannel_logout_session_supported"`
+	BackchannelLogoutSupported           bool     `json:"backchannel_logout_supported"`
+	BackchannelLogoutSessionSupported    bool     `json:"backchannel_logout_session_supported"`
 	AcrValuesSupported                   []string `json:"acr_values_supported,omitempty"`
 }
@@ -21,11 +21,14 @@ package discovery
 import (
 	"context"
 	"errors"
+	"maps"
 	"slices"
 	"sort"
 
 	inboundmodel "github.com/thunder-id/thunderid/internal/inboundclient/model"
+	"github.com/thunder-id/thunderid/internal/oauth/metadatacache"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/dpop"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/pkce"
 	"github.com/thunder-id/thunderid/internal/system/config"
 	"github.com/thunder-id/thunderid/internal/system/kmprovider"
@@ -42,12 +45,16 @@ type DiscoveryServiceInterface interface {
 type discoveryService struct {
 	baseURL        string
 	cryptoProvider kmprovider.RuntimeCryptoProvider
+	metadataCache  metadatacache.MetadataCacheServiceInterface
 }
 
 // newDiscoveryService creates a new discovery service instance
-func newDiscoveryService(cryptoProvider kmprovider.RuntimeCryptoProvider) DiscoveryServiceInterface {
+func newDiscoveryService(
+	cryptoProvider kmprovider.RuntimeCryptoProvider,
+	metadataCache metadatacache.MetadataCacheServiceInterface,
+) DiscoveryServiceInterface {
 	runtime := config.GetServerRuntime()
-	ds := &discoveryService{cryptoProvider: cryptoProvider}
+	ds := &discoveryService{cryptoProvider: cryptoProvider, metadataCache: metadataCache}
 	ds.baseURL = config.GetServerURL(&runtime.Config.Server)
 	return ds
 }
@@ -61,17 +68,21 @@ func (ds *discoveryService) GetOAuth2AuthorizationServerMetadata(
 		AuthorizationEndpoint:                      ds.getAuthorizationEndpoint(),
 		TokenEndpoint:                              ds.getTokenEndpoint(),
 		UserInfoEndpoint:                           ds.getUserInfoEndpoint(),
-		JWKSUri:                                    ds.getJWKSUri(),
+		JWKSUri:                                    ds.getJWKSUri(ctx),
 		RegistrationEndpoint:                       ds.getRegistrationEndpoint(),
 		IntrospectionEndpoint:                      ds.getIntrospectionEndpoint(),
 		PushedAuthorizationRequestEndpoint:         ds.getPAREndpoint(),
 		RequirePushedAuthorizationRequests:         ds.isGlobalPARRequired(),
+		DeviceAuthorizationEndpoint:                ds.getDeviceAuthorizationEndpoint(),
 		ScopesSupported:                            ds.getSupportedScopes(),
 		ResponseTypesSupported:                     ds.getSupportedResponseTypes(),
 		GrantTypesSupported:                        ds.getSupportedGrantTypes(),
 		TokenEndpointAuthMethodsSupported:          ds.getSupportedTokenEndpointAuthMethods(),
 		CodeChallengeMethodsSupported:              ds.getSupportedCodeChallengeMethods(),
 		AuthorizationResponseIssParameterSupported: true,
+		RequestParameterSupported:                  true,
+		RequestObjectSigningAlgValuesSupported:     inboundmodel.SupportedRequestObjectSigningAlgs,
+		DPoPSigningAlgValuesSupported:              dpop.SupportedSigningAlgs,
 	}
 
 	return metadata
@@ -96,6 +107,11 @@ func (ds *discoveryService) GetOIDCMetadata(ctx context.Context) (*OIDCProviderM
 		IDTokenEncryptionEncValuesSupported:  inboundmodel.SupportedIDTokenEncryptionEncs,
 		ClaimsSupported:                      ds.getSupportedClaims(),
 		ClaimsParameterSupported:             true,
+		EndSessionEndpoint:                   ds.getEndSessionEndpoint(),
+		FrontchannelLogoutSupported:          true,
+		FrontchannelLogoutSessionSupported:   true,
+		BackchannelLogoutSupported:           true,
+		BackchannelLogoutSessionSupported:    true,
 		AcrValuesSupported:                   ds.getSupportedAcrValues(),
 	}, nil
 }
@@ -112,8 +128,16 @@ func (ds *discoveryService) getTokenEndpoint() string {
 	return ds.baseURL + constants.OAuth2TokenEndpoint
 }
 
-func (ds *discoveryService) getJWKSUri() string {
-	return ds.baseURL + constants.OAuth2JWKSEndpoint
+// getJWKSUri returns the JWKS endpoint pinned to the current JWKS content version, so that the
+// endpoint URL changes, and cached copies are bypassed, whenever the keys change.
+func (ds *discoveryService) getJWKSUri(ctx context.Context) string {
+	jwksURI := ds.baseURL + constants.OAuth2JWKSEndpoint
+	version, err := ds.metadataCache.GetVersion(ctx, metadatacache.SurrogateKeyJWKS)
+	if err != nil {
+		log.GetLogger().Debug("Failed to resolve the JWKS version for discovery", log.Error(err))
+		return jwksURI
+	}
+	return jwksURI + "?" + metadatacache.VersionQueryParam + "=" + version
 }
 
 func (ds *discoveryService) getIntrospectionEndpoint() string {
@@ -124,6 +148,10 @@ func (ds *discoveryService) getUserInfoEndpoint() string {
 	return ds.baseURL + constants.OAuth2UserInfoEndpoint
 }
 
+func (ds *discoveryService) getEndSessionEndpoint() string {
+	return ds.baseURL + constants.OAuth2LogoutEndpoint
+}
+
 func (ds *discoveryService) getRegistrationEndpoint() string {
 	return ds.baseURL + constants.OAuth2DCREndpoint
 }
@@ -133,6 +161,7 @@ func (ds *discoveryService) getSupportedScopes() []string {
 	for scope := range constants.StandardOIDCScopes {
 		scopes = append(scopes, scope)
 	}
+	sort.Strings(scopes)
 	return scopes
 }
 
@@ -156,6 +185,10 @@ func (ds *discoveryService) getPAREndpoint() string {
 	return ds.baseURL + constants.OAuth2PAREndpoint
 }
 
+func (ds *discoveryService) getDeviceAuthorizationEndpoint() string {
+	return ds.baseURL + constants.OAuth2DeviceAuthorizationEndpoint
+}
+
 func (ds *discoveryService) isGlobalPARRequired() bool {
 	return config.GetServerRuntime().Config.OAuth.PAR.RequirePAR
 }
@@ -183,6 +216,7 @@ func (ds *discoveryService) getSupportedSigningAlgorithms(ctx context.Context) (
 		log.GetLogger().Error("No valid signing algorithms found in registered public keys", log.Error(err))
 		return nil, err
 	}
+	sort.Strings(result)
 	return result, nil
 }
 
@@ -201,8 +235,8 @@ func (ds *discoveryService) getSupportedClaims() []string {
 	var claims []string
 	claims = append(claims, constants.GetStandardClaims()...)
 
-	for _, scope := range constants.StandardOIDCScopes {
-		claims = append(claims, scope.Claims...)
+	for _, scope := range slices.Sorted(maps.Keys(constants.StandardOIDCScopes)) {
+		claims = append(claims, constants.StandardOIDCScopes[scope].Claims...)
 	}
 
 	// Remove duplicates
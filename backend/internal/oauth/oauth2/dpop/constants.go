@@ -0,0 +1,57 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package dpop implements OAuth 2.0 Demonstrating Proof of Possession (RFC 9449). It validates the DPoP
+// proofs presented at the token endpoint and at protected resources, and issues the nonces servers use to
+// limit the lifetime of proofs.
+package dpop
+
+import (
+	"github.com/thunder-id/thunderid/internal/system/jose/jws"
+)
+
+const (
+	// HeaderName is the request header carrying a DPoP proof.
+	HeaderName = "DPoP"
+	// NonceHeaderName is the response header carrying a server-issued DPoP nonce.
+	NonceHeaderName = "DPoP-Nonce"
+
+	// loggerComponentName is the component name used in the DPoP logs.
+	loggerComponentName = "DPoPService"
+
+	// proofType is the typ header value of a DPoP proof JWT.
+	proofType = "dpop+jwt"
+
+	// defaultProofMaxAge is the maximum age in seconds of a DPoP proof when none is configured.
+	defaultProofMaxAge int64 = 60
+	// defaultNonceExpiresIn is the lifetime in seconds of a DPoP nonce when none is configured.
+	defaultNonceExpiresIn int64 = 300
+	// allowedClockSkew is the number of seconds a proof may be issued ahead of the server clock.
+	allowedClockSkew int64 = 5
+	// nonceRandomBytes is the number of random bytes of a DPoP nonce (32 bytes = 256 bits).
+	nonceRandomBytes = 32
+)
+
+// SupportedSigningAlgs lists the JWS algorithms accepted for DPoP proofs. Symmetric algorithms are never
+// accepted, as the proof key must be bound to the client.
+var SupportedSigningAlgs = []string{
+	string(jws.RS256), string(jws.RS512), string(jws.PS256), string(jws.EdDSA),
+}
+
+// privateKeyMembers lists the JWK members that only appear in private keys.
+var privateKeyMembers = []string{"d", "p", "q", "dp", "dq", "qi", "oth", "k"}
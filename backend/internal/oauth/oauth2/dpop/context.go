@@ -0,0 +1,48 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package dpop
+
+import (
+	"context"
+)
+
+type contextKey string
+
+// thumbprintKey is the context key for the JWK thumbprint of the validated DPoP proof of a request.
+var thumbprintKey contextKey = "dpop_jkt"
+
+// WithThumbprint adds the JWK thumbprint of the validated DPoP proof of a request to the context.
+func WithThumbprint(ctx context.Context, thumbprint string) context.Context {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	return context.WithValue(ctx, thumbprintKey, thumbprint)
+}
+
+// GetThumbprint retrieves the JWK thumbprint of the validated DPoP proof of a request from the context.
+// An empty string is returned when the request carried no DPoP proof.
+func GetThumbprint(ctx context.Context) string {
+	if ctx == nil {
+		return ""
+	}
+	if thumbprint, ok := ctx.Value(thumbprintKey).(string); ok {
+		return thumbprint
+	}
+	return ""
+}
@@ -0,0 +1,35 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package dpop
+
+import (
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/tokenstore"
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/jose/jwt"
+)
+
+// Initialize initializes the DPoP service with the proof and nonce stores selected by the token store
+// configuration.
+func Initialize(jwtService jwt.JWTServiceInterface) DPoPServiceInterface {
+	dpopConfig := config.GetServerRuntime().Config.OAuth.DPoP
+	return newDPoPService(jwtService,
+		tokenstore.Initialize(tokenstore.ArtifactTypeDPoPProof),
+		tokenstore.Initialize(tokenstore.ArtifactTypeDPoPNonce),
+		dpopConfig.ProofMaxAge, dpopConfig.RequireNonce, dpopConfig.NonceExpiresIn)
+}
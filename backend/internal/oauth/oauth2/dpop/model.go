@@ -0,0 +1,36 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package dpop
+
+// ProofError describes why a DPoP proof was rejected. Code is an OAuth 2.0 error code. Nonce carries a
+// freshly issued nonce the client must include in its next proof when Code is use_dpop_nonce.
+type ProofError struct {
+	Code        string
+	Description string
+	Nonce       string
+}
+
+// proofRequest holds the request a DPoP proof is validated against.
+type proofRequest struct {
+	proofs       []string
+	method       string
+	url          string
+	accessToken  string
+	requireNonce bool
+}
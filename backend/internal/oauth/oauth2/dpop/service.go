@@ -0,0 +1,302 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package dpop
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"fmt"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/tokenstore"
+	"github.com/thunder-id/thunderid/internal/system/cryptolab"
+	"github.com/thunder-id/thunderid/internal/system/jose/jws"
+	"github.com/thunder-id/thunderid/internal/system/jose/jwt"
+	"github.com/thunder-id/thunderid/internal/system/log"
+)
+
+// DPoPServiceInterface defines the interface for validating DPoP proofs. Both validations return the RFC 7638
+// JWK thumbprint of the proof key.
+type DPoPServiceInterface interface {
+	ValidateTokenRequestProof(
+		ctx context.Context, proofs []string, method string, requestURL string,
+	) (string, *ProofError)
+	ValidateResourceRequestProof(
+		ctx context.Context, proofs []string, method string, requestURL string, accessToken string,
+	) (string, *ProofError)
+}
+
+// dpopService implements DPoPServiceInterface.
+type dpopService struct {
+	jwtService     jwt.JWTServiceInterface
+	proofStore     tokenstore.TokenStoreInterface
+	nonceStore     tokenstore.TokenStoreInterface
+	proofMaxAge    int64
+	requireNonce   bool
+	nonceExpiresIn int64
+	now            func() time.Time
+	logger         *log.Logger
+}
+
+// newDPoPService creates a new DPoP service. Non-positive durations fall back to their defaults.
+func newDPoPService(
+	jwtService jwt.JWTServiceInterface,
+	proofStore tokenstore.TokenStoreInterface,
+	nonceStore tokenstore.TokenStoreInterface,
+	proofMaxAge int64,
+	requireNonce bool,
+	nonceExpiresIn int64,
+) DPoPServiceInterface {
+	if proofMaxAge <= 0 {
+		proofMaxAge = defaultProofMaxAge
+	}
+	if nonceExpiresIn <= 0 {
+		nonceExpiresIn = defaultNonceExpiresIn
+	}
+	return &dpopService{
+		jwtService:     jwtService,
+		proofStore:     proofStore,
+		nonceStore:     nonceStore,
+		proofMaxAge:    proofMaxAge,
+		requireNonce:   requireNonce,
+		nonceExpiresIn: nonceExpiresIn,
+		now:            time.Now,
+		logger:         log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName)),
+	}
+}
+
+// ValidateTokenRequestProof validates the DPoP proof of a token request. A server nonce is required when
+// configured.
+func (s *dpopService) ValidateTokenRequestProof(
+	ctx context.Context, proofs []string, method string, requestURL string,
+) (string, *ProofError) {
+	return s.validateProof(ctx, &proofRequest{
+		proofs:       proofs,
+		method:       method,
+		url:          requestURL,
+		requireNonce: s.requireNonce,
+	})
+}
+
+// ValidateResourceRequestProof validates the DPoP proof of a request to a protected resource. The proof must
+// carry the hash of the presented access token.
+func (s *dpopService) ValidateResourceRequestProof(
+	ctx context.Context, proofs []string, method string, requestURL string, accessToken string,
+) (string, *ProofError) {
+	return s.validateProof(ctx, &proofRequest{
+		proofs:      proofs,
+		method:      method,
+		url:         requestURL,
+		accessToken: accessToken,
+	})
+}
+
+// validateProof performs the DPoP proof checks of RFC 9449 section 4.3 and returns the JWK thumbprint of the
+// proof key.
+func (s *dpopService) validateProof(ctx context.Context, req *proofRequest) (string, *ProofError) {
+	if len(req.proofs) != 1 {
+		return "", invalidProof("Exactly one DPoP proof must be presented")
+	}
+	proof := req.proofs[0]
+
+	header, payload, err := jwt.DecodeJWT(proof)
+	if err != nil {
+		return "", invalidProof("The DPoP proof is not a well-formed JWT")
+	}
+	if typ, _ := header["typ"].(string); typ != proofType {
+		return "", invalidProof("The DPoP proof must have the typ header " + proofType)
+	}
+	if alg, _ := header["alg"].(string); !slices.Contains(SupportedSigningAlgs, alg) {
+		return "", invalidProof("The DPoP proof is signed with an unsupported algorithm")
+	}
+
+	jwk, ok := header["jwk"].(map[string]interface{})
+	if !ok {
+		return "", invalidProof("The DPoP proof must carry its public key in the jwk header")
+	}
+	for _, member := range privateKeyMembers {
+		if _, exists := jwk[member]; exists {
+			return "", invalidProof("The DPoP proof key must not contain private key material")
+		}
+	}
+	publicKey, err := jws.JWKToPublicKey(jwk)
+	if err != nil {
+		return "", invalidProof("The DPoP proof carries an invalid public key")
+	}
+	if svcErr := s.jwtService.VerifyJWTSignatureWithPublicKey(proof, publicKey); svcErr != nil {
+		return "", invalidProof("The DPoP proof signature is invalid")
+	}
+	thumbprint, err := jws.JWKThumbprint(jwk)
+	if err != nil {
+		return "", invalidProof("The DPoP proof carries an invalid public key")
+	}
+
+	if htm, _ := payload["htm"].(string); htm != req.method {
+		return "", invalidProof("The DPoP proof htm claim does not match the request method")
+	}
+	if htu, _ := payload["htu"].(string); !matchesTargetURI(htu, req.url) {
+		return "", invalidProof("The DPoP proof htu claim does not match the request URL")
+	}
+
+	iat, ok := payload["iat"].(float64)
+	if !ok {
+		return "", invalidProof("The DPoP proof must carry an iat claim")
+	}
+	issuedAt := int64(iat)
+	now := s.now().Unix()
+	if issuedAt < now-s.proofMaxAge || issuedAt > now+allowedClockSkew {
+		return "", invalidProof("The DPoP proof is expired or issued in the future")
+	}
+
+	jti, _ := payload["jti"].(string)
+	if jti == "" {
+		return "", invalidProof("The DPoP proof must carry a jti claim")
+	}
+
+	if req.accessToken != "" {
+		ath, _ := payload["ath"].(string)
+		if subtle.ConstantTimeCompare([]byte(ath), []byte(accessTokenHash(req.accessToken))) != 1 {
+			return "", invalidProof("The DPoP proof ath claim does not match the access token")
+		}
+	}
+
+	nonce, _ := payload["nonce"].(string)
+	if nonce != "" || req.requireNonce {
+		if proofErr := s.checkNonce(ctx, nonce); proofErr != nil {
+			return "", proofErr
+		}
+	}
+
+	if proofErr := s.checkReplay(ctx, thumbprint, jti, issuedAt); proofErr != nil {
+		return "", proofErr
+	}
+	return thumbprint, nil
+}
+
+// checkNonce verifies that a nonce was issued by the server and has not expired. A missing or unknown nonce
+// is answered with a use_dpop_nonce error carrying a fresh nonce.
+func (s *dpopService) checkNonce(ctx context.Context, nonce string) *ProofError {
+	if nonce != "" {
+		_, found, err := s.nonceStore.Get(ctx, nonce)
+		if err != nil {
+			s.logger.Error("Failed to look up the DPoP nonce", log.Error(err))
+			return serverError()
+		}
+		if found {
+			return nil
+		}
+	}
+
+	freshNonce, err := s.issueNonce(ctx)
+	if err != nil {
+		s.logger.Error("Failed to issue a DPoP nonce", log.Error(err))
+		return serverError()
+	}
+	return &ProofError{
+		Code:        constants.ErrorUseDPoPNonce,
+		Description: "Authorization server requires nonce in DPoP proof",
+		Nonce:       freshNonce,
+	}
+}
+
+// issueNonce generates a new nonce and stores it for its lifetime.
+func (s *dpopService) issueNonce(ctx context.Context) (string, error) {
+	b := make([]byte, nonceRandomBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate DPoP nonce: %w", err)
+	}
+	nonce := base64.RawURLEncoding.EncodeToString(b)
+
+	now := s.now()
+	expiry := now.Add(time.Duration(s.nonceExpiresIn) * time.Second)
+	if err := s.nonceStore.Store(ctx, nonce, []byte(strconv.FormatInt(now.Unix(), 10)), expiry); err != nil {
+		return "", err
+	}
+	return nonce, nil
+}
+
+// checkReplay rejects a proof whose jti was already used with the same key, and records the jti until the
+// proof could no longer be accepted.
+func (s *dpopService) checkReplay(ctx context.Context, thumbprint string, jti string, issuedAt int64) *ProofError {
+	key := cryptolab.HashToken(thumbprint + ":" + jti)
+	_, found, err := s.proofStore.Get(ctx, key)
+	if err != nil {
+		s.logger.Error("Failed to look up the DPoP proof", log.Error(err))
+		return serverError()
+	}
+	if found {
+		return invalidProof("The DPoP proof has already been used")
+	}
+
+	expiry := time.Unix(issuedAt+s.proofMaxAge+allowedClockSkew, 0)
+	if err := s.proofStore.Store(ctx, key, []byte(jti), expiry); err != nil {
+		s.logger.Error("Failed to record the DPoP proof", log.Error(err))
+		return serverError()
+	}
+	return nil
+}
+
+// matchesTargetURI reports whether the htu claim of a proof identifies the request URL. The query and
+// fragment are ignored, and the scheme and host are compared case-insensitively (RFC 9449 section 4.3).
+func matchesTargetURI(htu string, requestURL string) bool {
+	claimed, err := normalizeTargetURI(htu)
+	if err != nil {
+		return false
+	}
+	expected, err := normalizeTargetURI(requestURL)
+	if err != nil {
+		return false
+	}
+	return claimed == expected
+}
+
+// normalizeTargetURI returns an absolute URI without its query and fragment, with a lower-case scheme and host.
+func normalizeTargetURI(rawURL string) (string, error) {
+	parsed, err := url.Parse(rawURL)
+	if err != nil {
+		return "", err
+	}
+	if !parsed.IsAbs() || parsed.Host == "" {
+		return "", fmt.Errorf("target URI %q is not absolute", rawURL)
+	}
+	parsed.Scheme = strings.ToLower(parsed.Scheme)
+	parsed.Host = strings.ToLower(parsed.Host)
+	parsed.RawQuery = ""
+	parsed.ForceQuery = false
+	parsed.Fragment = ""
+	parsed.RawFragment = ""
+	return parsed.String(), nil
+}
+
+// invalidProof returns an invalid_dpop_proof error with the given description.
+func invalidProof(description string) *ProofError {
+	return &ProofError{Code: constants.ErrorInvalidDPoPProof, Description: description}
+}
+
+// serverError returns the error reported when a proof could not be validated because of a server failure.
+func serverError() *ProofError {
+	return &ProofError{Code: constants.ErrorServerError, Description: "Failed to validate the DPoP proof"}
+}
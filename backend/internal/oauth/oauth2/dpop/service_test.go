@@ -0,0 +1,306 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package dpop
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/jose/jws"
+	"github.com/thunder-id/thunderid/tests/mocks/jose/jwtmock"
+	"github.com/thunder-id/thunderid/tests/mocks/oauth/oauth2/tokenstoremock"
+)
+
+const (
+	testTokenURL  = "https://localhost:8090/oauth2/token"
+	testProofJTI  = "proof-jti-1"
+	testNonce     = "server-nonce"
+	testAccessTkn = "access-token-value"
+)
+
+type DPoPServiceTestSuite struct {
+	suite.Suite
+	mockJWT        *jwtmock.JWTServiceInterfaceMock
+	mockProofStore *tokenstoremock.TokenStoreInterfaceMock
+	mockNonceStore *tokenstoremock.TokenStoreInterfaceMock
+	service        *dpopService
+	jwk            map[string]interface{}
+	thumbprint     string
+	now            time.Time
+}
+
+func TestDPoPServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(DPoPServiceTestSuite))
+}
+
+func (s *DPoPServiceTestSuite) SetupSuite() {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	s.Require().NoError(err)
+	s.jwk = map[string]interface{}{
+		"kty": "RSA",
+		"n":   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+		"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+	}
+	s.thumbprint, err = jws.JWKThumbprint(s.jwk)
+	s.Require().NoError(err)
+}
+
+func (s *DPoPServiceTestSuite) SetupTest() {
+	s.mockJWT = jwtmock.NewJWTServiceInterfaceMock(s.T())
+	s.mockProofStore = tokenstoremock.NewTokenStoreInterfaceMock(s.T())
+	s.mockNonceStore = tokenstoremock.NewTokenStoreInterfaceMock(s.T())
+	s.service = newDPoPService(s.mockJWT, s.mockProofStore, s.mockNonceStore, 0, false, 0).(*dpopService)
+	s.now = time.Unix(1760000000, 0)
+	s.service.now = func() time.Time { return s.now }
+}
+
+// buildProof builds a DPoP proof with the given header and claim overrides. A nil override removes the entry.
+func (s *DPoPServiceTestSuite) buildProof(headerOverrides, claimOverrides map[string]interface{}) string {
+	header := map[string]interface{}{"typ": proofType, "alg": "RS256", "jwk": s.jwk}
+	claims := map[string]interface{}{
+		"jti": testProofJTI,
+		"htm": http.MethodPost,
+		"htu": testTokenURL,
+		"iat": s.now.Unix(),
+	}
+	applyOverrides(header, headerOverrides)
+	applyOverrides(claims, claimOverrides)
+
+	headerJSON, err := json.Marshal(header)
+	s.Require().NoError(err)
+	claimsJSON, err := json.Marshal(claims)
+	s.Require().NoError(err)
+	return base64.RawURLEncoding.EncodeToString(headerJSON) + "." +
+		base64.RawURLEncoding.EncodeToString(claimsJSON) + ".signature"
+}
+
+func applyOverrides(target, overrides map[string]interface{}) {
+	for k, v := range overrides {
+		if v == nil {
+			delete(target, k)
+			continue
+		}
+		target[k] = v
+	}
+}
+
+func (s *DPoPServiceTestSuite) expectSignatureValid(proof string) {
+	s.mockJWT.EXPECT().VerifyJWTSignatureWithPublicKey(proof, mock.Anything).Return(nil)
+}
+
+func (s *DPoPServiceTestSuite) expectFreshProof() {
+	s.mockProofStore.EXPECT().Get(mock.Anything, mock.Anything).Return(nil, false, nil)
+	s.mockProofStore.EXPECT().Store(mock.Anything, mock.Anything, []byte(testProofJTI),
+		time.Unix(s.now.Unix()+defaultProofMaxAge+allowedClockSkew, 0)).Return(nil)
+}
+
+func (s *DPoPServiceTestSuite) TestValidateTokenRequestProof_Success() {
+	proof := s.buildProof(nil, nil)
+	s.expectSignatureValid(proof)
+	s.expectFreshProof()
+
+	thumbprint, proofErr := s.service.ValidateTokenRequestProof(context.Background(), []string{proof},
+		http.MethodPost, testTokenURL)
+
+	s.Nil(proofErr)
+	s.Equal(s.thumbprint, thumbprint)
+}
+
+func (s *DPoPServiceTestSuite) TestValidateTokenRequestProof_IgnoresQueryAndHostCase() {
+	proof := s.buildProof(nil, map[string]interface{}{"htu": "HTTPS://LOCALHOST:8090/oauth2/token"})
+	s.expectSignatureValid(proof)
+	s.expectFreshProof()
+
+	thumbprint, proofErr := s.service.ValidateTokenRequestProof(context.Background(), []string{proof},
+		http.MethodPost, testTokenURL+"?client_id=app")
+
+	s.Nil(proofErr)
+	s.Equal(s.thumbprint, thumbprint)
+}
+
+func (s *DPoPServiceTestSuite) TestValidateTokenRequestProof_InvalidProofs() {
+	privateJWK := map[string]interface{}{"kty": "RSA", "n": s.jwk["n"], "e": s.jwk["e"], "d": "secret"}
+	testCases := []struct {
+		name            string
+		proofs          []string
+		verifySignature bool
+	}{
+		{name: "NoProof", proofs: []string{}},
+		{name: "MultipleProofs", proofs: []string{s.buildProof(nil, nil), s.buildProof(nil, nil)}},
+		{name: "Malformed", proofs: []string{"not-a-jwt"}},
+		{name: "WrongType", proofs: []string{s.buildProof(map[string]interface{}{"typ": "JWT"}, nil)}},
+		{name: "UnsupportedAlg", proofs: []string{s.buildProof(map[string]interface{}{"alg": "HS256"}, nil)}},
+		{name: "MissingJWK", proofs: []string{s.buildProof(map[string]interface{}{"jwk": nil}, nil)}},
+		{name: "PrivateJWK", proofs: []string{s.buildProof(map[string]interface{}{"jwk": privateJWK}, nil)}},
+		{name: "MethodMismatch", verifySignature: true,
+			proofs: []string{s.buildProof(nil, map[string]interface{}{"htm": http.MethodGet})}},
+		{name: "URLMismatch", verifySignature: true,
+			proofs: []string{s.buildProof(nil, map[string]interface{}{"htu": "https://localhost:8090/oauth2/authorize"})}},
+		{name: "MissingIssuedAt", verifySignature: true,
+			proofs: []string{s.buildProof(nil, map[string]interface{}{"iat": nil})}},
+		{name: "Expired", verifySignature: true,
+			proofs: []string{s.buildProof(nil, map[string]interface{}{"iat": s.now.Unix() - defaultProofMaxAge - 1})}},
+		{name: "IssuedInFuture", verifySignature: true,
+			proofs: []string{s.buildProof(nil, map[string]interface{}{"iat": s.now.Unix() + allowedClockSkew + 1})}},
+		{name: "MissingJTI", verifySignature: true,
+			proofs: []string{s.buildProof(nil, map[string]interface{}{"jti": nil})}},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			if tc.verifySignature {
+				s.expectSignatureValid(tc.proofs[0])
+			}
+
+			thumbprint, proofErr := s.service.ValidateTokenRequestProof(context.Background(), tc.proofs,
+				http.MethodPost, testTokenURL)
+
+			s.Empty(thumbprint)
+			s.Require().NotNil(proofErr)
+			s.Equal(constants.ErrorInvalidDPoPProof, proofErr.Code)
+		})
+	}
+}
+
+func (s *DPoPServiceTestSuite) TestValidateTokenRequestProof_InvalidSignature() {
+	proof := s.buildProof(nil, nil)
+	s.mockJWT.EXPECT().VerifyJWTSignatureWithPublicKey(proof, mock.Anything).
+		Return(&serviceerror.InternalServerError)
+
+	_, proofErr := s.service.ValidateTokenRequestProof(context.Background(), []string{proof},
+		http.MethodPost, testTokenURL)
+
+	s.Require().NotNil(proofErr)
+	s.Equal(constants.ErrorInvalidDPoPProof, proofErr.Code)
+}
+
+func (s *DPoPServiceTestSuite) TestValidateTokenRequestProof_Replay() {
+	proof := s.buildProof(nil, nil)
+	s.expectSignatureValid(proof)
+	s.mockProofStore.EXPECT().Get(mock.Anything, mock.Anything).Return([]byte(testProofJTI), true, nil)
+
+	_, proofErr := s.service.ValidateTokenRequestProof(context.Background(), []string{proof},
+		http.MethodPost, testTokenURL)
+
+	s.Require().NotNil(proofErr)
+	s.Equal(constants.ErrorInvalidDPoPProof, proofErr.Code)
+	s.mockProofStore.AssertNotCalled(s.T(), "Store", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (s *DPoPServiceTestSuite) TestValidateTokenRequestProof_ReplayStoreFailure() {
+	proof := s.buildProof(nil, nil)
+	s.expectSignatureValid(proof)
+	s.mockProofStore.EXPECT().Get(mock.Anything, mock.Anything).Return(nil, false, errors.New("db down"))
+
+	_, proofErr := s.service.ValidateTokenRequestProof(context.Background(), []string{proof},
+		http.MethodPost, testTokenURL)
+
+	s.Require().NotNil(proofErr)
+	s.Equal(constants.ErrorServerError, proofErr.Code)
+}
+
+func (s *DPoPServiceTestSuite) TestValidateTokenRequestProof_NonceRequired() {
+	s.service.requireNonce = true
+	proof := s.buildProof(nil, nil)
+	s.expectSignatureValid(proof)
+	var issuedNonce string
+	s.mockNonceStore.EXPECT().Store(mock.Anything, mock.Anything, mock.Anything,
+		s.now.Add(time.Duration(defaultNonceExpiresIn)*time.Second)).
+		Run(func(_ context.Context, key string, _ []byte, _ time.Time) { issuedNonce = key }).
+		Return(nil)
+
+	_, proofErr := s.service.ValidateTokenRequestProof(context.Background(), []string{proof},
+		http.MethodPost, testTokenURL)
+
+	s.Require().NotNil(proofErr)
+	s.Equal(constants.ErrorUseDPoPNonce, proofErr.Code)
+	s.NotEmpty(proofErr.Nonce)
+	s.Equal(issuedNonce, proofErr.Nonce)
+}
+
+func (s *DPoPServiceTestSuite) TestValidateTokenRequestProof_ValidNonce() {
+	s.service.requireNonce = true
+	proof := s.buildProof(nil, map[string]interface{}{"nonce": testNonce})
+	s.expectSignatureValid(proof)
+	s.mockNonceStore.EXPECT().Get(mock.Anything, testNonce).Return([]byte("1760000000"), true, nil)
+	s.expectFreshProof()
+
+	thumbprint, proofErr := s.service.ValidateTokenRequestProof(context.Background(), []string{proof},
+		http.MethodPost, testTokenURL)
+
+	s.Nil(proofErr)
+	s.Equal(s.thumbprint, thumbprint)
+}
+
+func (s *DPoPServiceTestSuite) TestValidateTokenRequestProof_UnknownNonce() {
+	proof := s.buildProof(nil, map[string]interface{}{"nonce": "stale-nonce"})
+	s.expectSignatureValid(proof)
+	s.mockNonceStore.EXPECT().Get(mock.Anything, "stale-nonce").Return(nil, false, nil)
+	s.mockNonceStore.EXPECT().Store(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	_, proofErr := s.service.ValidateTokenRequestProof(context.Background(), []string{proof},
+		http.MethodPost, testTokenURL)
+
+	s.Require().NotNil(proofErr)
+	s.Equal(constants.ErrorUseDPoPNonce, proofErr.Code)
+	s.NotEqual("stale-nonce", proofErr.Nonce)
+}
+
+func (s *DPoPServiceTestSuite) TestValidateResourceRequestProof_Success() {
+	proof := s.buildProof(nil, map[string]interface{}{
+		"htm": http.MethodGet,
+		"ath": accessTokenHash(testAccessTkn),
+	})
+	s.expectSignatureValid(proof)
+	s.expectFreshProof()
+
+	thumbprint, proofErr := s.service.ValidateResourceRequestProof(context.Background(), []string{proof},
+		http.MethodGet, testTokenURL, testAccessTkn)
+
+	s.Nil(proofErr)
+	s.Equal(s.thumbprint, thumbprint)
+}
+
+func (s *DPoPServiceTestSuite) TestValidateResourceRequestProof_AccessTokenHashMismatch() {
+	proof := s.buildProof(nil, map[string]interface{}{
+		"htm": http.MethodGet,
+		"ath": accessTokenHash("another-token"),
+	})
+	s.expectSignatureValid(proof)
+
+	_, proofErr := s.service.ValidateResourceRequestProof(context.Background(), []string{proof},
+		http.MethodGet, testTokenURL, testAccessTkn)
+
+	s.Require().NotNil(proofErr)
+	s.Equal(constants.ErrorInvalidDPoPProof, proofErr.Code)
+}
@@ -0,0 +1,113 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package dpop
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
+	serverconst "github.com/thunder-id/thunderid/internal/system/constants"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/utils"
+)
+
+// ExtractAccessToken extracts the access token from an Authorization header using the Bearer or the DPoP
+// scheme (case-insensitive). The scheme is returned in its canonical form.
+func ExtractAccessToken(authHeader string) (string, string, error) {
+	if authHeader == "" {
+		return "", "", errors.New("missing Authorization header")
+	}
+
+	parts := strings.SplitN(authHeader, " ", 2)
+	var scheme string
+	switch {
+	case strings.EqualFold(parts[0], constants.TokenTypeBearer):
+		scheme = constants.TokenTypeBearer
+	case strings.EqualFold(parts[0], constants.TokenTypeDPoP):
+		scheme = constants.TokenTypeDPoP
+	default:
+		return "", "", errors.New("invalid Authorization header format. Expected: Bearer <token> or DPoP <token>")
+	}
+	if len(parts) != 2 || strings.TrimSpace(parts[1]) == "" {
+		return "", scheme, errors.New("missing access token")
+	}
+	return strings.TrimSpace(parts[1]), scheme, nil
+}
+
+// VerifyTokenBinding checks that an access token is presented the way its binding requires. A DPoP-bound
+// access token must come with a DPoP proof signed by the bound key, and a DPoP proof may only come with a
+// DPoP-bound access token. The proof thumbprint is read from the context.
+func VerifyTokenBinding(ctx context.Context, tokenThumbprint string) error {
+	proofThumbprint := GetThumbprint(ctx)
+	switch {
+	case tokenThumbprint == "" && proofThumbprint == "":
+		return nil
+	case tokenThumbprint == "":
+		return errors.New("a DPoP proof was presented with an access token that is not DPoP-bound")
+	case proofThumbprint == "":
+		return errors.New("the DPoP-bound access token was presented without a DPoP proof")
+	case tokenThumbprint != proofThumbprint:
+		return errors.New("the DPoP proof key does not match the key the access token is bound to")
+	}
+	return nil
+}
+
+// GetConfirmationThumbprint returns the JWK thumbprint a token is bound to through its cnf claim, or an empty
+// string when the token is not DPoP-bound.
+func GetConfirmationThumbprint(claims map[string]interface{}) string {
+	cnf, ok := claims[constants.ClaimConfirmation].(map[string]interface{})
+	if !ok {
+		return ""
+	}
+	jkt, _ := cnf["jkt"].(string)
+	return jkt
+}
+
+// WriteResourceProofError writes the response to a protected resource request whose DPoP proof was rejected,
+// challenging the client with the DPoP scheme (RFC 9449 section 7.1).
+func WriteResourceProofError(w http.ResponseWriter, proofErr *ProofError) {
+	if proofErr.Code == constants.ErrorServerError {
+		utils.WriteJSONError(w, constants.ErrorServerError,
+			serviceerror.InternalServerError.Error.DefaultValue, http.StatusInternalServerError, nil)
+		return
+	}
+
+	headers := map[string]string{
+		serverconst.WWWAuthenticateHeaderName: fmt.Sprintf("%s error=%q, error_description=%q",
+			constants.TokenTypeDPoP, proofErr.Code, proofErr.Description),
+	}
+	if proofErr.Nonce != "" {
+		headers[NonceHeaderName] = proofErr.Nonce
+	}
+	utils.WriteJSONError(w, proofErr.Code, proofErr.Description, http.StatusUnauthorized,
+		[]map[string]string{headers})
+}
+
+// accessTokenHash returns the ath value of a DPoP proof for an access token: the base64url-encoded SHA-256
+// hash of the token.
+func accessTokenHash(accessToken string) string {
+	sum := sha256.Sum256([]byte(accessToken))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
@@ -0,0 +1,100 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package dpop
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
+)
+
+func TestExtractAccessToken(t *testing.T) {
+	testCases := []struct {
+		name           string
+		header         string
+		expectedToken  string
+		expectedScheme string
+		expectErr      bool
+	}{
+		{name: "Bearer", header: "Bearer abc", expectedToken: "abc", expectedScheme: constants.TokenTypeBearer},
+		{name: "DPoP", header: "DPoP abc", expectedToken: "abc", expectedScheme: constants.TokenTypeDPoP},
+		{name: "CaseInsensitive", header: "dpop abc", expectedToken: "abc", expectedScheme: constants.TokenTypeDPoP},
+		{name: "Missing", header: "", expectErr: true},
+		{name: "UnknownScheme", header: "Basic abc", expectErr: true},
+		{name: "MissingToken", header: "DPoP ", expectedScheme: constants.TokenTypeDPoP, expectErr: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			token, scheme, err := ExtractAccessToken(tc.header)
+
+			assert.Equal(t, tc.expectedToken, token)
+			assert.Equal(t, tc.expectedScheme, scheme)
+			if tc.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestVerifyTokenBinding(t *testing.T) {
+	withProof := WithThumbprint(context.Background(), "jkt-1")
+
+	assert.NoError(t, VerifyTokenBinding(context.Background(), ""))
+	assert.NoError(t, VerifyTokenBinding(withProof, "jkt-1"))
+	assert.Error(t, VerifyTokenBinding(withProof, ""))
+	assert.Error(t, VerifyTokenBinding(context.Background(), "jkt-1"))
+	assert.Error(t, VerifyTokenBinding(withProof, "jkt-2"))
+}
+
+func TestGetConfirmationThumbprint(t *testing.T) {
+	assert.Equal(t, "jkt-1", GetConfirmationThumbprint(map[string]interface{}{
+		constants.ClaimConfirmation: map[string]interface{}{"jkt": "jkt-1"},
+	}))
+	assert.Empty(t, GetConfirmationThumbprint(map[string]interface{}{"sub": "user"}))
+}
+
+func TestWriteResourceProofError(t *testing.T) {
+	rr := httptest.NewRecorder()
+
+	WriteResourceProofError(rr, &ProofError{
+		Code: constants.ErrorUseDPoPNonce, Description: "nonce required", Nonce: "fresh-nonce",
+	})
+
+	assert.Equal(t, http.StatusUnauthorized, rr.Code)
+	assert.Equal(t, "fresh-nonce", rr.Header().Get(NonceHeaderName))
+	assert.Equal(t, `DPoP error="use_dpop_nonce", error_description="nonce required"`,
+		rr.Header().Get("WWW-Authenticate"))
+}
+
+func TestWriteResourceProofError_ServerError(t *testing.T) {
+	rr := httptest.NewRecorder()
+
+	WriteResourceProofError(rr, &ProofError{Code: constants.ErrorServerError, Description: "failed"})
+
+	assert.Equal(t, http.StatusInternalServerError, rr.Code)
+	assert.Empty(t, rr.Header().Get("WWW-Authenticate"))
+}
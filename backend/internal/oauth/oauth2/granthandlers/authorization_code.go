@@ -31,6 +31,7 @@ import (
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/model"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/pkce"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/resourceindicators"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/scopeceiling"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/tokenservice"
 	oauth2utils "github.com/thunder-id/thunderid/internal/oauth/oauth2/utils"
 	"github.com/thunder-id/thunderid/internal/resource"
@@ -183,6 +184,12 @@ func (h *authorizationCodeGrantHandler) HandleGrant(ctx context.Context, tokenRe
 		accessTokenScopes = oidcScopes
 	}
 
+	// Cap the scopes at the ceiling of the authentication the user completed.
+	accessTokenScopes, errResp = scopeceiling.Apply(authCode.CompletedACR, accessTokenScopes, oauthApp.ScopeClaims)
+	if errResp != nil {
+		return nil, errResp
+	}
+
 	// Generate access token using tokenBuilder (attributes will be filtered in BuildAccessToken)
 	accessToken, err := h.tokenBuilder.BuildAccessToken(&tokenservice.AccessTokenBuildContext{
 		Context:          ctx,
@@ -193,15 +200,14 @@ func (h *authorizationCodeGrantHandler) HandleGrant(ctx context.Context, tokenRe
 		UserAttributes:   attrs,
 		AttributeCacheID: authCode.AttributeCacheID,
 		GrantType:        string(constants.GrantTypeAuthorizationCode),
+		AuthTime:         authCode.TimeCreated.Unix(),
+		ACR:              authCode.CompletedACR,
 		OAuthApp:         oauthApp,
 		ClaimsRequest:    authCode.ClaimsRequest,
 		ClaimsLocales:    authCode.ClaimsLocales,
 	})
 	if err != nil {
-		return nil, &model.ErrorResponse{
-			Error:            constants.ErrorServerError,
-			ErrorDescription: "Failed to generate token",
-		}
+		return nil, accessTokenBuildError(err, "Failed to generate token")
 	}
 
 	// Carry the full (un-narrowed) audiences in OriginalAudiences so the token service can
@@ -220,6 +226,7 @@ func (h *authorizationCodeGrantHandler) HandleGrant(ctx context.Context, tokenRe
 			Subject:        authCode.AuthorizedUserID,
 			Audience:       tokenRequest.ClientID,
 			Scopes:         accessTokenScopes,
+			GrantType:      tokenRequest.GrantType,
 			UserAttributes: attrs,
 			AuthTime:       authCode.TimeCreated.Unix(),
 			OAuthApp:       oauthApp,
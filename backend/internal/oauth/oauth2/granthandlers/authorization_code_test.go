@@ -1355,3 +1355,49 @@ func (suite *AuthorizationCodeGrantHandlerTestSuite) TestHandleGrant_TokenReques
 	assert.NotNil(suite.T(), result)
 	assert.Equal(suite.T(), []string{testResourceURL}, capturedAudiences)
 }
+
+func (suite *AuthorizationCodeGrantHandlerTestSuite) TestHandleGrant_ScopesCappedAtScopeCeiling() {
+	oauthConfig := &config.GetServerRuntime().Config.OAuth
+	oauthConfig.ScopeCeilings.Rules = []config.ScopeCeilingRule{
+		{ACR: []string{"urn:thunder:acr:password"}, Scopes: []string{"read"}},
+	}
+	defer func() { oauthConfig.ScopeCeilings.Rules = nil }()
+
+	authCode := suite.testAuthzCode
+	authCode.CompletedACR = "urn:thunder:acr:password"
+	suite.mockAuthzService.On("GetAuthorizationCodeDetails", mock.Anything, testClientID, "test-auth-code").
+		Return(&authCode, nil)
+	suite.mockTokenBuilder.On("BuildAccessToken", mock.MatchedBy(func(ctx *tokenservice.AccessTokenBuildContext) bool {
+		return len(ctx.Scopes) == 1 && ctx.Scopes[0] == "read" && ctx.ACR == "urn:thunder:acr:password"
+	})).Return(&model.TokenDTO{
+		Token:    "mock-jwt-token",
+		Scopes:   []string{"read"},
+		ClientID: testClientID,
+	}, nil)
+
+	result, err := suite.handler.HandleGrant(context.Background(), suite.testTokenReq, suite.oauthApp)
+
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), []string{"read"}, result.AccessToken.Scopes)
+}
+
+func (suite *AuthorizationCodeGrantHandlerTestSuite) TestHandleGrant_ScopeCeilingRejectsScopes() {
+	oauthConfig := &config.GetServerRuntime().Config.OAuth
+	oauthConfig.ScopeCeilings = config.ScopeCeilingsConfig{
+		Reject: true,
+		Rules:  []config.ScopeCeilingRule{{ACR: []string{"urn:thunder:acr:password"}, Scopes: []string{"read"}}},
+	}
+	defer func() { oauthConfig.ScopeCeilings = config.ScopeCeilingsConfig{} }()
+
+	authCode := suite.testAuthzCode
+	authCode.CompletedACR = "urn:thunder:acr:password"
+	suite.mockAuthzService.On("GetAuthorizationCodeDetails", mock.Anything, testClientID, "test-auth-code").
+		Return(&authCode, nil)
+
+	result, err := suite.handler.HandleGrant(context.Background(), suite.testTokenReq, suite.oauthApp)
+
+	assert.Nil(suite.T(), result)
+	assert.NotNil(suite.T(), err)
+	assert.Equal(suite.T(), constants.ErrorInsufficientAuthenticationLevel, err.Error)
+	suite.mockTokenBuilder.AssertNotCalled(suite.T(), "BuildAccessToken", mock.Anything)
+}
@@ -83,8 +83,46 @@ func (h *clientCredentialsGrantHandler) HandleGrant(ctx context.Context, tokenRe
 	*model.TokenResponseDTO, *model.ErrorResponse) {
 	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, "ClientCredentialsGrantHandler"))
 
-	scopes := tokenservice.ParseScopes(tokenRequest.Scope)
+	scopes, errResp := applyAllowedScopes(oauthApp, tokenservice.ParseScopes(tokenRequest.Scope))
+	if errResp != nil {
+		return nil, errResp
+	}
 	hasResourceParam := len(tokenRequest.Resources) > 0
+	policyScopes, hasScopePolicy := oauthApp.GetGrantTypeAllowedScopes(constants.GrantTypeClientCredentials)
+
+	var groupIDs []string
+	if len(scopes) > 0 || hasScopePolicy {
+		groupIDs, errResp = h.getAppGroupIDs(oauthApp, logger)
+		if errResp != nil {
+			return nil, errResp
+		}
+	}
+
+	// With an allowed-scope policy, the granted scopes are resolved by the authorization service from the
+	// policy instead of the requested scopes.
+	if hasScopePolicy {
+		grantable, svcErr := h.authzService.GetGrantableScopes(ctx, authz.GetGrantableScopesRequest{
+			EntityID:        oauthApp.ID,
+			GroupIDs:        groupIDs,
+			RequestedScopes: scopes,
+			AllowedScopes:   policyScopes,
+		})
+		if svcErr != nil {
+			logger.Error("Failed to resolve the allowed-scope policy for app",
+				log.String("appID", oauthApp.ID), log.String("error", svcErr.Error.DefaultValue))
+			return nil, &model.ErrorResponse{
+				Error:            constants.ErrorServerError,
+				ErrorDescription: "Failed to generate token",
+			}
+		}
+		if len(grantable.DisallowedScopes) > 0 && oauthApp.RejectDisallowedScopes {
+			return nil, &model.ErrorResponse{
+				Error:            constants.ErrorInvalidScope,
+				ErrorDescription: "Requested scope is not allowed for this client",
+			}
+		}
+		scopes = grantable.GrantedScopes
+	}
 
 	// Resolve each requested resource identifier to an internal Resource Server.
 	// Unknown identifiers cause a 400 invalid_target.
@@ -104,29 +142,7 @@ func (h *clientCredentialsGrantHandler) HandleGrant(ctx context.Context, tokenRe
 		scopes = resourceindicators.UnionScopes(rsValidScopes)
 	}
 
-	if len(scopes) > 0 {
-		var groupIDs []string
-		if h.entityProv != nil {
-			groups, groupErr := h.entityProv.GetTransitiveEntityGroups(oauthApp.ID)
-			if groupErr != nil {
-				// Ignore unimplemented providers to preserve existing behavior.
-				if groupErr.Code != entityprovider.ErrorCodeNotImplemented {
-					logger.Error("Failed to resolve app group memberships",
-						log.String("appID", oauthApp.ID), log.String("error", groupErr.Error()))
-					return nil, &model.ErrorResponse{
-						Error:            constants.ErrorServerError,
-						ErrorDescription: "Failed to generate token",
-					}
-				}
-			} else {
-				for _, group := range groups {
-					if group.ID != "" && !slices.Contains(groupIDs, group.ID) {
-						groupIDs = append(groupIDs, group.ID)
-					}
-				}
-			}
-		}
-
+	if len(scopes) > 0 && !hasScopePolicy {
 		authzResp, svcErr := h.authzService.GetAuthorizedPermissions(ctx, authz.GetAuthorizedPermissionsRequest{
 			EntityID:             oauthApp.ID,
 			GroupIDs:             groupIDs,
@@ -161,24 +177,51 @@ func (h *clientCredentialsGrantHandler) HandleGrant(ctx context.Context, tokenRe
 	}
 
 	accessToken, err := h.tokenBuilder.BuildAccessToken(&tokenservice.AccessTokenBuildContext{
-		Context:          ctx,
-		Subject:          tokenRequest.ClientID,
-		Audiences:        audiences,
-		ClientID:         tokenRequest.ClientID,
-		Scopes:           scopes,
-		UserAttributes:   make(map[string]interface{}),
-		GrantType:        string(constants.GrantTypeClientCredentials),
-		OAuthApp:         oauthApp,
-		ClientAttributes: clientAttributes,
+		Context:           ctx,
+		Subject:           tokenRequest.ClientID,
+		Audiences:         audiences,
+		ClientID:          tokenRequest.ClientID,
+		Scopes:            scopes,
+		UserAttributes:    make(map[string]interface{}),
+		GrantType:         string(constants.GrantTypeClientCredentials),
+		ServiceIdentityID: oauthApp.ID,
+		OAuthApp:          oauthApp,
+		ClientAttributes:  clientAttributes,
 	})
 	if err != nil {
+		return nil, accessTokenBuildError(err, "Failed to generate token")
+	}
+
+	return &model.TokenResponseDTO{
+		AccessToken: *accessToken,
+	}, nil
+}
+
+// getAppGroupIDs returns the IDs of the groups the application belongs to, directly or transitively.
+func (h *clientCredentialsGrantHandler) getAppGroupIDs(
+	oauthApp *inboundmodel.OAuthClient, logger *log.Logger) ([]string, *model.ErrorResponse) {
+	if h.entityProv == nil {
+		return nil, nil
+	}
+	groups, groupErr := h.entityProv.GetTransitiveEntityGroups(oauthApp.ID)
+	if groupErr != nil {
+		// Ignore unimplemented providers to preserve existing behavior.
+		if groupErr.Code == entityprovider.ErrorCodeNotImplemented {
+			return nil, nil
+		}
+		logger.Error("Failed to resolve app group memberships",
+			log.String("appID", oauthApp.ID), log.String("error", groupErr.Error()))
 		return nil, &model.ErrorResponse{
 			Error:            constants.ErrorServerError,
 			ErrorDescription: "Failed to generate token",
 		}
 	}
 
-	return &model.TokenResponseDTO{
-		AccessToken: *accessToken,
-	}, nil
+	var groupIDs []string
+	for _, group := range groups {
+		if group.ID != "" && !slices.Contains(groupIDs, group.ID) {
+			groupIDs = append(groupIDs, group.ID)
+		}
+	}
+	return groupIDs, nil
 }
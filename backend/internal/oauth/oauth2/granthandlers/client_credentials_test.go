@@ -266,6 +266,33 @@ func (suite *ClientCredentialsGrantHandlerTestSuite) TestHandleGrant_JWTGenerati
 	suite.mockTokenBuilder.AssertExpectations(suite.T())
 }
 
+func (suite *ClientCredentialsGrantHandlerTestSuite) TestHandleGrant_PreIssuanceDenied() {
+	tokenRequest := &model.TokenRequest{
+		GrantType:    "client_credentials",
+		ClientID:     testClientID,
+		ClientSecret: "secret123",
+		Scope:        "read",
+	}
+
+	suite.mockAuthzService.On("GetAuthorizedPermissions", mock.Anything,
+		authz.GetAuthorizedPermissionsRequest{
+			EntityID:             suite.oauthApp.ID,
+			RequestedPermissions: []string{"read"},
+		}).Return(&authz.GetAuthorizedPermissionsResponse{
+		AuthorizedPermissions: []string{"read"},
+	}, nil)
+
+	suite.mockTokenBuilder.On("BuildAccessToken", mock.Anything).
+		Return(nil, &tokenservice.IssuanceDeniedError{Reason: "The subscription is suspended"})
+
+	result, errResp := suite.handler.HandleGrant(context.Background(), tokenRequest, suite.oauthApp)
+
+	assert.Nil(suite.T(), result)
+	assert.NotNil(suite.T(), errResp)
+	assert.Equal(suite.T(), constants.ErrorAccessDenied, errResp.Error)
+	assert.Equal(suite.T(), "The subscription is suspended", errResp.ErrorDescription)
+}
+
 func (suite *ClientCredentialsGrantHandlerTestSuite) TestHandleGrant_NilTokenAttributes() {
 	tokenRequest := &model.TokenRequest{
 		GrantType:    "client_credentials",
@@ -285,7 +312,7 @@ func (suite *ClientCredentialsGrantHandlerTestSuite) TestHandleGrant_NilTokenAtt
 	expectedToken := testJWTToken
 	suite.mockTokenBuilder.On("BuildAccessToken", mock.MatchedBy(func(ctx *tokenservice.AccessTokenBuildContext) bool {
 		return ctx.Subject == testClientID && (len(ctx.Audiences) > 0 && ctx.Audiences[0] == testClientID) &&
-			tokenservice.JoinScopes(ctx.Scopes) == testScopeRead
+			tokenservice.JoinScopes(ctx.Scopes) == testScopeRead && ctx.ServiceIdentityID == suite.oauthApp.ID
 	})).Return(&model.TokenDTO{
 		Token:     expectedToken,
 		TokenType: constants.TokenTypeBearer,
@@ -556,6 +583,159 @@ func (suite *ClientCredentialsGrantHandlerTestSuite) TestHandleGrant_NoAuthorize
 	assert.Empty(suite.T(), result.AccessToken.Scopes)
 }
 
+func (suite *ClientCredentialsGrantHandlerTestSuite) TestHandleGrant_DropsScopesOutsideAllowedScopes() {
+	suite.oauthApp.AllowedScopes = []string{"read"}
+	tokenRequest := &model.TokenRequest{
+		GrantType:    "client_credentials",
+		ClientID:     testClientID,
+		ClientSecret: "secret123",
+		Scope:        "read write",
+	}
+
+	suite.mockAuthzService.On("GetAuthorizedPermissions", mock.Anything,
+		authz.GetAuthorizedPermissionsRequest{
+			EntityID:             suite.oauthApp.ID,
+			RequestedPermissions: []string{"read"},
+		}).Return(&authz.GetAuthorizedPermissionsResponse{
+		AuthorizedPermissions: []string{"read"},
+	}, nil)
+
+	suite.mockTokenBuilder.On("BuildAccessToken",
+		mock.MatchedBy(func(ctx *tokenservice.AccessTokenBuildContext) bool {
+			return tokenservice.JoinScopes(ctx.Scopes) == "read"
+		})).Return(&model.TokenDTO{
+		Token:     testJWTToken,
+		TokenType: constants.TokenTypeBearer,
+		IssuedAt:  int64(1234567890),
+		ExpiresIn: 3600,
+		Scopes:    []string{"read"},
+		ClientID:  testClientID,
+	}, nil)
+
+	result, errResp := suite.handler.HandleGrant(context.Background(), tokenRequest, suite.oauthApp)
+
+	assert.Nil(suite.T(), errResp)
+	assert.Equal(suite.T(), []string{"read"}, result.AccessToken.Scopes)
+}
+
+func (suite *ClientCredentialsGrantHandlerTestSuite) TestHandleGrant_RejectsScopesOutsideAllowedScopes() {
+	suite.oauthApp.AllowedScopes = []string{"read"}
+	suite.oauthApp.RejectDisallowedScopes = true
+	tokenRequest := &model.TokenRequest{
+		GrantType:    "client_credentials",
+		ClientID:     testClientID,
+		ClientSecret: "secret123",
+		Scope:        "read write",
+	}
+
+	result, errResp := suite.handler.HandleGrant(context.Background(), tokenRequest, suite.oauthApp)
+
+	assert.Nil(suite.T(), result)
+	suite.Require().NotNil(errResp)
+	assert.Equal(suite.T(), constants.ErrorInvalidScope, errResp.Error)
+	suite.mockAuthzService.AssertNotCalled(suite.T(), "GetAuthorizedPermissions", mock.Anything, mock.Anything)
+}
+
+func (suite *ClientCredentialsGrantHandlerTestSuite) TestHandleGrant_ScopePolicyGrantsPolicyScopes() {
+	suite.oauthApp.AllowedScopesByGrantType = map[string][]string{
+		string(constants.GrantTypeClientCredentials): {"read", "write"},
+	}
+	tokenRequest := &model.TokenRequest{
+		GrantType:    "client_credentials",
+		ClientID:     testClientID,
+		ClientSecret: "secret123",
+	}
+
+	suite.mockAuthzService.On("GetGrantableScopes", mock.Anything,
+		authz.GetGrantableScopesRequest{
+			EntityID:        suite.oauthApp.ID,
+			RequestedScopes: []string{},
+			AllowedScopes:   []string{"read", "write"},
+		}).Return(&authz.GetGrantableScopesResponse{
+		GrantedScopes:    []string{"read"},
+		DisallowedScopes: []string{},
+	}, nil)
+
+	suite.mockTokenBuilder.On("BuildAccessToken",
+		mock.MatchedBy(func(ctx *tokenservice.AccessTokenBuildContext) bool {
+			return tokenservice.JoinScopes(ctx.Scopes) == "read"
+		})).Return(&model.TokenDTO{
+		Token:     testJWTToken,
+		TokenType: constants.TokenTypeBearer,
+		Scopes:    []string{"read"},
+		ClientID:  testClientID,
+	}, nil)
+
+	result, errResp := suite.handler.HandleGrant(context.Background(), tokenRequest, suite.oauthApp)
+
+	assert.Nil(suite.T(), errResp)
+	assert.Equal(suite.T(), []string{"read"}, result.AccessToken.Scopes)
+	suite.mockAuthzService.AssertNotCalled(suite.T(), "GetAuthorizedPermissions", mock.Anything, mock.Anything)
+}
+
+func (suite *ClientCredentialsGrantHandlerTestSuite) TestHandleGrant_ScopePolicyDropsDisallowedScopes() {
+	suite.oauthApp.AllowedScopesByGrantType = map[string][]string{
+		string(constants.GrantTypeClientCredentials): {"read"},
+	}
+	tokenRequest := &model.TokenRequest{
+		GrantType:    "client_credentials",
+		ClientID:     testClientID,
+		ClientSecret: "secret123",
+		Scope:        "read write",
+	}
+
+	suite.mockAuthzService.On("GetGrantableScopes", mock.Anything,
+		authz.GetGrantableScopesRequest{
+			EntityID:        suite.oauthApp.ID,
+			RequestedScopes: []string{"read", "write"},
+			AllowedScopes:   []string{"read"},
+		}).Return(&authz.GetGrantableScopesResponse{
+		GrantedScopes:    []string{"read"},
+		DisallowedScopes: []string{"write"},
+	}, nil)
+
+	suite.mockTokenBuilder.On("BuildAccessToken",
+		mock.MatchedBy(func(ctx *tokenservice.AccessTokenBuildContext) bool {
+			return tokenservice.JoinScopes(ctx.Scopes) == "read"
+		})).Return(&model.TokenDTO{
+		Token:     testJWTToken,
+		TokenType: constants.TokenTypeBearer,
+		Scopes:    []string{"read"},
+		ClientID:  testClientID,
+	}, nil)
+
+	result, errResp := suite.handler.HandleGrant(context.Background(), tokenRequest, suite.oauthApp)
+
+	assert.Nil(suite.T(), errResp)
+	assert.Equal(suite.T(), []string{"read"}, result.AccessToken.Scopes)
+}
+
+func (suite *ClientCredentialsGrantHandlerTestSuite) TestHandleGrant_ScopePolicyRejectsDisallowedScopes() {
+	suite.oauthApp.RejectDisallowedScopes = true
+	suite.oauthApp.AllowedScopesByGrantType = map[string][]string{
+		string(constants.GrantTypeClientCredentials): {"read"},
+	}
+	tokenRequest := &model.TokenRequest{
+		GrantType:    "client_credentials",
+		ClientID:     testClientID,
+		ClientSecret: "secret123",
+		Scope:        "read write",
+	}
+
+	suite.mockAuthzService.On("GetGrantableScopes", mock.Anything, mock.Anything).
+		Return(&authz.GetGrantableScopesResponse{
+			GrantedScopes:    []string{"read"},
+			DisallowedScopes: []string{"write"},
+		}, nil)
+
+	result, errResp := suite.handler.HandleGrant(context.Background(), tokenRequest, suite.oauthApp)
+
+	assert.Nil(suite.T(), result)
+	suite.Require().NotNil(errResp)
+	assert.Equal(suite.T(), constants.ErrorInvalidScope, errResp.Error)
+	suite.mockTokenBuilder.AssertNotCalled(suite.T(), "BuildAccessToken", mock.Anything)
+}
+
 func (suite *ClientCredentialsGrantHandlerTestSuite) TestHandleGrant_AuthzServiceError() {
 	tokenRequest := &model.TokenRequest{
 		GrantType:    "client_credentials",
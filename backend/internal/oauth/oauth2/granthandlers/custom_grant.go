@@ -0,0 +1,247 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package granthandlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"path/filepath"
+	"plugin"
+	"sync"
+
+	inboundmodel "github.com/thunder-id/thunderid/internal/inboundclient/model"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/model"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/tokenservice"
+	"github.com/thunder-id/thunderid/internal/ou"
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/log"
+)
+
+// CustomGrantPluginSymbol is the name of the function a custom grant plugin must export. Its type must be
+// func(register func(grantType string, factory CustomGrantHandlerFactory) error) error.
+const CustomGrantPluginSymbol = "RegisterGrantHandlers"
+
+// CustomGrantResult is the outcome of a custom grant: the subject the access token is issued to and
+// the claims to include in it.
+type CustomGrantResult struct {
+	// Subject is the sub claim of the issued access token. Required.
+	Subject string
+	// Audiences of the issued access token. Defaults to the client ID when empty.
+	Audiences []string
+	// Scopes granted to the client. The requested scopes, already filtered against the
+	// application, are available in the token request.
+	Scopes []string
+	// Claims are subject attributes made available to the application's token attribute configuration.
+	Claims map[string]interface{}
+}
+
+// CustomGrantHandlerInterface is implemented by deployment-specific extension grant types.
+// Grant-specific request parameters are available in tokenRequest.Parameters.
+type CustomGrantHandlerInterface interface {
+	ValidateGrant(ctx context.Context, tokenRequest *model.TokenRequest,
+		oauthApp *inboundmodel.OAuthClient) *model.ErrorResponse
+	ResolveGrant(ctx context.Context, tokenRequest *model.TokenRequest,
+		oauthApp *inboundmodel.OAuthClient) (*CustomGrantResult, *model.ErrorResponse)
+}
+
+// CustomGrantHandlerFactory creates a custom grant handler. Factories are registered at startup and
+// called when the grant handler provider is created.
+type CustomGrantHandlerFactory func() CustomGrantHandlerInterface
+
+var (
+	customGrantRegistry   = make(map[constants.GrantType]CustomGrantHandlerFactory)
+	customGrantRegistryMu sync.RWMutex
+)
+
+// RegisterCustomGrantHandler registers a handler for an extension grant type. The grant type must be an
+// absolute URI (RFC 6749 section 4.5) that is not already handled. Compiled-in handlers call this from
+// an init() function; plugins receive it through their RegisterGrantHandlers function.
+// Applications opt in by listing the grant type in their allowed grant types.
+func RegisterCustomGrantHandler(grantType string, factory CustomGrantHandlerFactory) error {
+	if factory == nil {
+		return errors.New("custom grant handler factory must not be nil")
+	}
+	if parsed, err := url.Parse(grantType); err != nil || !parsed.IsAbs() {
+		return fmt.Errorf("custom grant type must be an absolute URI: %q", grantType)
+	}
+
+	customGrantRegistryMu.Lock()
+	defer customGrantRegistryMu.Unlock()
+
+	gt := constants.GrantType(grantType)
+	if _, exists := customGrantRegistry[gt]; exists || gt.IsValid() {
+		return fmt.Errorf("grant type is already registered: %s", grantType)
+	}
+
+	customGrantRegistry[gt] = factory
+	constants.RegisterCustomGrantType(gt)
+	return nil
+}
+
+// ClearCustomGrantHandlers removes all registered custom grant handlers.
+// This is primarily for testing purposes.
+func ClearCustomGrantHandlers() {
+	customGrantRegistryMu.Lock()
+	defer customGrantRegistryMu.Unlock()
+
+	customGrantRegistry = make(map[constants.GrantType]CustomGrantHandlerFactory)
+	constants.ClearCustomGrantTypes()
+}
+
+// getCustomGrantFactories returns a copy of the registered custom grant handler factories.
+func getCustomGrantFactories() map[constants.GrantType]CustomGrantHandlerFactory {
+	customGrantRegistryMu.RLock()
+	defer customGrantRegistryMu.RUnlock()
+
+	factories := make(map[constants.GrantType]CustomGrantHandlerFactory, len(customGrantRegistry))
+	for gt, factory := range customGrantRegistry {
+		factories[gt] = factory
+	}
+	return factories
+}
+
+// LoadCustomGrantPlugins opens the plugins configured in oauth.custom_grants.plugins and lets each
+// register its grant handlers. It must run before applications are loaded so that their grant types
+// validate.
+func LoadCustomGrantPlugins() error {
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, "CustomGrantRegistry"))
+	runtime := config.GetServerRuntime()
+
+	for _, path := range runtime.Config.OAuth.CustomGrants.Plugins {
+		if !filepath.IsAbs(path) {
+			path = filepath.Join(runtime.ServerHome, path)
+		}
+
+		p, err := plugin.Open(path)
+		if err != nil {
+			return fmt.Errorf("failed to open custom grant plugin %s: %w", path, err)
+		}
+		symbol, err := p.Lookup(CustomGrantPluginSymbol)
+		if err != nil {
+			return fmt.Errorf("custom grant plugin %s does not export %s: %w", path, CustomGrantPluginSymbol, err)
+		}
+		registerFn, ok := symbol.(func(func(string, CustomGrantHandlerFactory) error) error)
+		if !ok {
+			return fmt.Errorf("custom grant plugin %s exports %s with an unexpected type", path,
+				CustomGrantPluginSymbol)
+		}
+		if err := registerFn(RegisterCustomGrantHandler); err != nil {
+			return fmt.Errorf("custom grant plugin %s failed to register handlers: %w", path, err)
+		}
+
+		logger.Info("Loaded custom grant plugin", log.String("path", path))
+	}
+	return nil
+}
+
+// customGrantHandler adapts a CustomGrantHandlerInterface to the GrantHandlerInterface by issuing an
+// access token for the subject and claims the custom handler resolves.
+type customGrantHandler struct {
+	grantType    constants.GrantType
+	handler      CustomGrantHandlerInterface
+	tokenBuilder tokenservice.TokenBuilderInterface
+	ouService    ou.OrganizationUnitServiceInterface
+}
+
+// newCustomGrantHandler creates a new instance of customGrantHandler.
+func newCustomGrantHandler(
+	grantType constants.GrantType,
+	handler CustomGrantHandlerInterface,
+	tokenBuilder tokenservice.TokenBuilderInterface,
+	ouService ou.OrganizationUnitServiceInterface,
+) GrantHandlerInterface {
+	return &customGrantHandler{
+		grantType:    grantType,
+		handler:      handler,
+		tokenBuilder: tokenBuilder,
+		ouService:    ouService,
+	}
+}
+
+// ValidateGrant validates the custom grant request.
+func (h *customGrantHandler) ValidateGrant(ctx context.Context, tokenRequest *model.TokenRequest,
+	oauthApp *inboundmodel.OAuthClient) *model.ErrorResponse {
+	if constants.GrantType(tokenRequest.GrantType) != h.grantType {
+		return &model.ErrorResponse{
+			Error:            constants.ErrorUnsupportedGrantType,
+			ErrorDescription: "Unsupported grant type",
+		}
+	}
+	return h.handler.ValidateGrant(ctx, tokenRequest, oauthApp)
+}
+
+// HandleGrant resolves the grant through the custom handler and issues an access token.
+func (h *customGrantHandler) HandleGrant(ctx context.Context, tokenRequest *model.TokenRequest,
+	oauthApp *inboundmodel.OAuthClient) (*model.TokenResponseDTO, *model.ErrorResponse) {
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, "CustomGrantHandler"))
+
+	result, errResp := h.handler.ResolveGrant(ctx, tokenRequest, oauthApp)
+	if errResp != nil {
+		return nil, errResp
+	}
+	if result == nil || result.Subject == "" {
+		logger.Error("Custom grant handler did not resolve a subject",
+			log.String("grantType", string(h.grantType)))
+		return nil, &model.ErrorResponse{
+			Error:            constants.ErrorServerError,
+			ErrorDescription: "Failed to generate token",
+		}
+	}
+
+	audiences := result.Audiences
+	if len(audiences) == 0 {
+		audiences = []string{tokenRequest.ClientID}
+	}
+	claims := result.Claims
+	if claims == nil {
+		claims = make(map[string]interface{})
+	}
+
+	clientAttributes, clientAttrErr := tokenservice.BuildClientAttributes(ctx, oauthApp, h.ouService)
+	if clientAttrErr != nil {
+		return nil, &model.ErrorResponse{
+			Error:            constants.ErrorServerError,
+			ErrorDescription: "Failed to generate token",
+		}
+	}
+
+	accessToken, err := h.tokenBuilder.BuildAccessToken(&tokenservice.AccessTokenBuildContext{
+		Context:          ctx,
+		Subject:          result.Subject,
+		Audiences:        audiences,
+		ClientID:         tokenRequest.ClientID,
+		Scopes:           result.Scopes,
+		UserAttributes:   claims,
+		GrantType:        string(h.grantType),
+		OAuthApp:         oauthApp,
+		ClientAttributes: clientAttributes,
+	})
+	if err != nil {
+		logger.Error("Failed to build access token for custom grant", log.Error(err),
+			log.String("grantType", string(h.grantType)))
+		return nil, accessTokenBuildError(err, "Failed to generate token")
+	}
+
+	return &model.TokenResponseDTO{
+		AccessToken: *accessToken,
+	}, nil
+}
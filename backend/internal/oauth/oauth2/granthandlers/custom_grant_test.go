@@ -0,0 +1,205 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package granthandlers
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	inboundmodel "github.com/thunder-id/thunderid/internal/inboundclient/model"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/model"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/tokenservice"
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/tests/mocks/oauth/oauth2/tokenservicemock"
+)
+
+const testCustomGrantType = "urn:example:params:oauth:grant-type:partner"
+
+// stubCustomGrantHandler is a configurable CustomGrantHandlerInterface used by the tests.
+type stubCustomGrantHandler struct {
+	validateErr *model.ErrorResponse
+	result      *CustomGrantResult
+	resolveErr  *model.ErrorResponse
+}
+
+func (s *stubCustomGrantHandler) ValidateGrant(context.Context, *model.TokenRequest,
+	*inboundmodel.OAuthClient) *model.ErrorResponse {
+	return s.validateErr
+}
+
+func (s *stubCustomGrantHandler) ResolveGrant(context.Context, *model.TokenRequest,
+	*inboundmodel.OAuthClient) (*CustomGrantResult, *model.ErrorResponse) {
+	return s.result, s.resolveErr
+}
+
+type CustomGrantHandlerTestSuite struct {
+	suite.Suite
+	mockTokenBuilder *tokenservicemock.TokenBuilderInterfaceMock
+	oauthApp         *inboundmodel.OAuthClient
+}
+
+func TestCustomGrantHandlerSuite(t *testing.T) {
+	suite.Run(t, new(CustomGrantHandlerTestSuite))
+}
+
+func (suite *CustomGrantHandlerTestSuite) SetupTest() {
+	ClearCustomGrantHandlers()
+	suite.mockTokenBuilder = tokenservicemock.NewTokenBuilderInterfaceMock(suite.T())
+	suite.oauthApp = &inboundmodel.OAuthClient{ID: "app-1", ClientID: testClientID}
+}
+
+func (suite *CustomGrantHandlerTestSuite) TearDownTest() {
+	ClearCustomGrantHandlers()
+}
+
+func (suite *CustomGrantHandlerTestSuite) TestRegisterCustomGrantHandler() {
+	factory := func() CustomGrantHandlerInterface { return &stubCustomGrantHandler{} }
+
+	suite.NoError(RegisterCustomGrantHandler(testCustomGrantType, factory))
+	suite.True(constants.GrantType(testCustomGrantType).IsValid())
+	suite.True(constants.GrantType(testCustomGrantType).IsCustom())
+	suite.Contains(constants.GetSupportedGrantTypes(), testCustomGrantType)
+
+	suite.Error(RegisterCustomGrantHandler(testCustomGrantType, factory), "duplicate registration")
+	suite.Error(RegisterCustomGrantHandler(string(constants.GrantTypeClientCredentials), factory),
+		"built-in grant type")
+	suite.Error(RegisterCustomGrantHandler("partner", factory), "not an absolute URI")
+	suite.Error(RegisterCustomGrantHandler("urn:example:other", nil), "nil factory")
+}
+
+func (suite *CustomGrantHandlerTestSuite) TestClearCustomGrantHandlers() {
+	suite.NoError(RegisterCustomGrantHandler(testCustomGrantType,
+		func() CustomGrantHandlerInterface { return &stubCustomGrantHandler{} }))
+
+	ClearCustomGrantHandlers()
+
+	suite.False(constants.GrantType(testCustomGrantType).IsValid())
+	suite.Empty(getCustomGrantFactories())
+}
+
+func (suite *CustomGrantHandlerTestSuite) TestProviderReturnsCustomGrantHandler() {
+	suite.NoError(RegisterCustomGrantHandler(testCustomGrantType,
+		func() CustomGrantHandlerInterface { return &stubCustomGrantHandler{} }))
+
+	provider := newGrantHandlerProvider(nil, nil, suite.mockTokenBuilder, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+	handler, err := provider.GetGrantHandler(constants.GrantType(testCustomGrantType))
+	suite.NoError(err)
+	suite.IsType(&customGrantHandler{}, handler)
+
+	_, err = provider.GetGrantHandler(constants.GrantType("urn:example:unknown"))
+	suite.ErrorIs(err, constants.UnSupportedGrantTypeError)
+}
+
+func (suite *CustomGrantHandlerTestSuite) TestValidateGrant() {
+	stub := &stubCustomGrantHandler{}
+	handler := newCustomGrantHandler(testCustomGrantType, stub, suite.mockTokenBuilder, nil)
+
+	suite.Nil(handler.ValidateGrant(context.Background(),
+		&model.TokenRequest{GrantType: testCustomGrantType}, suite.oauthApp))
+
+	errResp := handler.ValidateGrant(context.Background(),
+		&model.TokenRequest{GrantType: string(constants.GrantTypeClientCredentials)}, suite.oauthApp)
+	suite.Equal(constants.ErrorUnsupportedGrantType, errResp.Error)
+
+	stub.validateErr = &model.ErrorResponse{Error: constants.ErrorInvalidGrant, ErrorDescription: "bad assertion"}
+	errResp = handler.ValidateGrant(context.Background(),
+		&model.TokenRequest{GrantType: testCustomGrantType}, suite.oauthApp)
+	suite.Equal(stub.validateErr, errResp)
+}
+
+func (suite *CustomGrantHandlerTestSuite) TestHandleGrant_Success() {
+	stub := &stubCustomGrantHandler{result: &CustomGrantResult{
+		Subject: "partner-user-1",
+		Scopes:  []string{"read"},
+		Claims:  map[string]interface{}{"partner": "acme"},
+	}}
+	handler := newCustomGrantHandler(testCustomGrantType, stub, suite.mockTokenBuilder, nil)
+
+	suite.mockTokenBuilder.On("BuildAccessToken",
+		mock.MatchedBy(func(ctx *tokenservice.AccessTokenBuildContext) bool {
+			return ctx.Subject == "partner-user-1" &&
+				len(ctx.Audiences) == 1 && ctx.Audiences[0] == testClientID &&
+				ctx.GrantType == testCustomGrantType &&
+				ctx.UserAttributes["partner"] == "acme" &&
+				tokenservice.JoinScopes(ctx.Scopes) == "read"
+		})).Return(&model.TokenDTO{Token: testJWTToken, Subject: "partner-user-1"}, nil).Once()
+
+	result, errResp := handler.HandleGrant(context.Background(),
+		&model.TokenRequest{GrantType: testCustomGrantType, ClientID: testClientID}, suite.oauthApp)
+
+	suite.Nil(errResp)
+	suite.Equal(testJWTToken, result.AccessToken.Token)
+}
+
+func (suite *CustomGrantHandlerTestSuite) TestHandleGrant_Errors() {
+	resolveErr := &model.ErrorResponse{Error: constants.ErrorInvalidGrant, ErrorDescription: "rejected"}
+	testCases := []struct {
+		name          string
+		stub          *stubCustomGrantHandler
+		buildErr      error
+		expectedError string
+	}{
+		{"ResolveError", &stubCustomGrantHandler{resolveErr: resolveErr}, nil, constants.ErrorInvalidGrant},
+		{"NilResult", &stubCustomGrantHandler{}, nil, constants.ErrorServerError},
+		{"MissingSubject", &stubCustomGrantHandler{result: &CustomGrantResult{}}, nil,
+			constants.ErrorServerError},
+		{"BuildError", &stubCustomGrantHandler{result: &CustomGrantResult{Subject: "sub"}},
+			errors.New("signing failed"), constants.ErrorServerError},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			tokenBuilder := tokenservicemock.NewTokenBuilderInterfaceMock(suite.T())
+			if tc.buildErr != nil {
+				tokenBuilder.On("BuildAccessToken", mock.Anything).Return(nil, tc.buildErr).Once()
+			}
+			handler := newCustomGrantHandler(testCustomGrantType, tc.stub, tokenBuilder, nil)
+
+			result, errResp := handler.HandleGrant(context.Background(),
+				&model.TokenRequest{GrantType: testCustomGrantType, ClientID: testClientID}, suite.oauthApp)
+
+			suite.Nil(result)
+			suite.Equal(tc.expectedError, errResp.Error)
+		})
+	}
+}
+
+func (suite *CustomGrantHandlerTestSuite) TestLoadCustomGrantPlugins_MissingPlugin() {
+	config.ResetServerRuntime()
+	defer config.ResetServerRuntime()
+	cfg := &config.Config{}
+	cfg.OAuth.CustomGrants.Plugins = []string{"plugins/does-not-exist.so"}
+	suite.NoError(config.InitializeServerRuntime(suite.T().TempDir(), cfg))
+
+	suite.Error(LoadCustomGrantPlugins())
+}
+
+func (suite *CustomGrantHandlerTestSuite) TestLoadCustomGrantPlugins_NoPlugins() {
+	config.ResetServerRuntime()
+	defer config.ResetServerRuntime()
+	suite.NoError(config.InitializeServerRuntime("", &config.Config{}))
+
+	suite.NoError(LoadCustomGrantPlugins())
+}
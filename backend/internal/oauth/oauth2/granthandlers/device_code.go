@@ -0,0 +1,173 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package granthandlers
+
+import (
+	"context"
+	"slices"
+
+	"github.com/thunder-id/thunderid/internal/attributecache"
+	inboundmodel "github.com/thunder-id/thunderid/internal/inboundclient/model"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/device"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/model"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/resourceindicators"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/scopeceiling"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/tokenservice"
+	"github.com/thunder-id/thunderid/internal/resource"
+	"github.com/thunder-id/thunderid/internal/system/log"
+)
+
+// deviceCodeGrantHandler handles the device authorization grant type (RFC 8628).
+type deviceCodeGrantHandler struct {
+	deviceService   device.DeviceAuthorizationServiceInterface
+	tokenBuilder    tokenservice.TokenBuilderInterface
+	attributeCache  attributecache.AttributeCacheServiceInterface
+	resourceService resource.ResourceServiceInterface
+}
+
+// newDeviceCodeGrantHandler creates a new instance of deviceCodeGrantHandler.
+func newDeviceCodeGrantHandler(
+	deviceService device.DeviceAuthorizationServiceInterface,
+	tokenBuilder tokenservice.TokenBuilderInterface,
+	attributeCache attributecache.AttributeCacheServiceInterface,
+	resourceService resource.ResourceServiceInterface,
+) GrantHandlerInterface {
+	return &deviceCodeGrantHandler{
+		deviceService:   deviceService,
+		tokenBuilder:    tokenBuilder,
+		attributeCache:  attributeCache,
+		resourceService: resourceService,
+	}
+}
+
+// ValidateGrant validates the device code grant request.
+func (h *deviceCodeGrantHandler) ValidateGrant(ctx context.Context, tokenRequest *model.TokenRequest,
+	oauthApp *inboundmodel.OAuthClient) *model.ErrorResponse {
+	if constants.GrantType(tokenRequest.GrantType) != constants.GrantTypeDeviceCode {
+		return &model.ErrorResponse{
+			Error:            constants.ErrorUnsupportedGrantType,
+			ErrorDescription: "Unsupported grant type",
+		}
+	}
+	if tokenRequest.DeviceCode == "" {
+		return &model.ErrorResponse{
+			Error:            constants.ErrorInvalidRequest,
+			ErrorDescription: "Device code is required",
+		}
+	}
+	if tokenRequest.ClientID == "" {
+		return &model.ErrorResponse{
+			Error:            constants.ErrorInvalidClient,
+			ErrorDescription: "client_id is required",
+		}
+	}
+	return nil
+}
+
+// HandleGrant answers a poll of the device and issues its tokens once the user approved the request.
+func (h *deviceCodeGrantHandler) HandleGrant(ctx context.Context, tokenRequest *model.TokenRequest,
+	oauthApp *inboundmodel.OAuthClient) (
+	*model.TokenResponseDTO, *model.ErrorResponse) {
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, "DeviceCodeGrantHandler"))
+
+	authorization, errResp := h.deviceService.ConsumeDeviceCode(ctx, tokenRequest.ClientID,
+		tokenRequest.DeviceCode)
+	if errResp != nil {
+		return nil, errResp
+	}
+
+	// Get user attributes from attribute cache
+	attrs := make(map[string]interface{})
+	if authorization.AttributeCacheID != "" {
+		userAttributes, err := h.attributeCache.GetAttributeCache(ctx, authorization.AttributeCacheID)
+		if err != nil {
+			logger.Error("Failed to get user attributes from attribute cache. " + err.ErrorDescription.DefaultValue)
+			return nil, &model.ErrorResponse{
+				Error:            constants.ErrorServerError,
+				ErrorDescription: "Failed to get user attributes from attribute cache",
+			}
+		}
+		attrs = userAttributes.Attributes
+	}
+
+	authorizedScopes := append(slices.Clone(authorization.StandardScopes), authorization.PermissionScopes...)
+	resourceServers, errResp := resourceindicators.ResolveResourceServers(ctx, h.resourceService,
+		authorization.Resources)
+	if errResp != nil {
+		return nil, errResp
+	}
+	audiences, errResp := resourceindicators.ComposeAudiences(ctx, h.resourceService, authorization.ClientID,
+		resourceServers, authorizedScopes)
+	if errResp != nil {
+		return nil, errResp
+	}
+
+	// Cap the scopes at the ceiling of the authentication the user completed.
+	scopes, errResp := scopeceiling.Apply(authorization.CompletedACR, authorizedScopes, oauthApp.ScopeClaims)
+	if errResp != nil {
+		return nil, errResp
+	}
+
+	accessToken, err := h.tokenBuilder.BuildAccessToken(&tokenservice.AccessTokenBuildContext{
+		Context:          ctx,
+		Subject:          authorization.AuthorizedUserID,
+		Audiences:        audiences,
+		ClientID:         tokenRequest.ClientID,
+		Scopes:           scopes,
+		UserAttributes:   attrs,
+		AttributeCacheID: authorization.AttributeCacheID,
+		GrantType:        string(constants.GrantTypeDeviceCode),
+		AuthTime:         authorization.AuthTime.Unix(),
+		ACR:              authorization.CompletedACR,
+		OAuthApp:         oauthApp,
+	})
+	if err != nil {
+		return nil, accessTokenBuildError(err, "Failed to generate token")
+	}
+
+	tokenResponse := &model.TokenResponseDTO{
+		AccessToken: *accessToken,
+	}
+
+	// Generate ID token if 'openid' scope is present
+	if slices.Contains(scopes, constants.ScopeOpenID) {
+		idToken, err := h.tokenBuilder.BuildIDToken(&tokenservice.IDTokenBuildContext{
+			Context:        ctx,
+			Subject:        authorization.AuthorizedUserID,
+			Audience:       tokenRequest.ClientID,
+			Scopes:         scopes,
+			GrantType:      tokenRequest.GrantType,
+			UserAttributes: attrs,
+			AuthTime:       authorization.AuthTime.Unix(),
+			OAuthApp:       oauthApp,
+			CompletedACR:   authorization.CompletedACR,
+		})
+		if err != nil {
+			logger.Error("Failed to generate ID token", log.Error(err))
+			return nil, &model.ErrorResponse{
+				Error:            constants.ErrorServerError,
+				ErrorDescription: "Failed to generate token",
+			}
+		}
+		tokenResponse.IDToken = *idToken
+	}
+
+	return tokenResponse, nil
+}
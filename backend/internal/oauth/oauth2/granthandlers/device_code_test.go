@@ -0,0 +1,135 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package granthandlers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	inboundmodel "github.com/thunder-id/thunderid/internal/inboundclient/model"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/device"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/model"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/tokenservice"
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/tests/mocks/attributecachemock"
+	"github.com/thunder-id/thunderid/tests/mocks/oauth/oauth2/devicemock"
+	"github.com/thunder-id/thunderid/tests/mocks/oauth/oauth2/tokenservicemock"
+	"github.com/thunder-id/thunderid/tests/mocks/resourcemock"
+)
+
+const testDeviceCode = "test-device-code"
+
+type DeviceCodeGrantHandlerTestSuite struct {
+	suite.Suite
+	handler              *deviceCodeGrantHandler
+	mockDeviceService    *devicemock.DeviceAuthorizationServiceInterfaceMock
+	mockTokenBuilder     *tokenservicemock.TokenBuilderInterfaceMock
+	mockAttrCacheService *attributecachemock.AttributeCacheServiceInterfaceMock
+	mockResourceService  *resourcemock.ResourceServiceInterfaceMock
+	oauthApp             *inboundmodel.OAuthClient
+	tokenRequest         *model.TokenRequest
+}
+
+func TestDeviceCodeGrantHandlerSuite(t *testing.T) {
+	suite.Run(t, new(DeviceCodeGrantHandlerTestSuite))
+}
+
+func (suite *DeviceCodeGrantHandlerTestSuite) SetupTest() {
+	testConfig := &config.Config{
+		JWT: config.JWTConfig{
+			ValidityPeriod: 3600,
+		},
+	}
+	_ = config.InitializeServerRuntime("test", testConfig)
+
+	suite.mockDeviceService = devicemock.NewDeviceAuthorizationServiceInterfaceMock(suite.T())
+	suite.mockTokenBuilder = tokenservicemock.NewTokenBuilderInterfaceMock(suite.T())
+	suite.mockAttrCacheService = attributecachemock.NewAttributeCacheServiceInterfaceMock(suite.T())
+	suite.mockResourceService = resourcemock.NewResourceServiceInterfaceMock(suite.T())
+	suite.handler = newDeviceCodeGrantHandler(suite.mockDeviceService, suite.mockTokenBuilder,
+		suite.mockAttrCacheService, suite.mockResourceService).(*deviceCodeGrantHandler)
+
+	suite.oauthApp = &inboundmodel.OAuthClient{
+		ClientID:   testClientID,
+		GrantTypes: []constants.GrantType{constants.GrantTypeDeviceCode},
+	}
+	suite.tokenRequest = &model.TokenRequest{
+		GrantType:  string(constants.GrantTypeDeviceCode),
+		ClientID:   testClientID,
+		DeviceCode: testDeviceCode,
+	}
+}
+
+func (suite *DeviceCodeGrantHandlerTestSuite) TestValidateGrant() {
+	assert.Nil(suite.T(), suite.handler.ValidateGrant(context.Background(), suite.tokenRequest, suite.oauthApp))
+
+	missingCode := *suite.tokenRequest
+	missingCode.DeviceCode = ""
+	errResp := suite.handler.ValidateGrant(context.Background(), &missingCode, suite.oauthApp)
+	assert.Equal(suite.T(), constants.ErrorInvalidRequest, errResp.Error)
+
+	wrongGrant := *suite.tokenRequest
+	wrongGrant.GrantType = string(constants.GrantTypeAuthorizationCode)
+	errResp = suite.handler.ValidateGrant(context.Background(), &wrongGrant, suite.oauthApp)
+	assert.Equal(suite.T(), constants.ErrorUnsupportedGrantType, errResp.Error)
+}
+
+func (suite *DeviceCodeGrantHandlerTestSuite) TestHandleGrant_AuthorizationPending() {
+	suite.mockDeviceService.EXPECT().ConsumeDeviceCode(mock.Anything, testClientID, testDeviceCode).
+		Return(nil, &model.ErrorResponse{Error: constants.ErrorAuthorizationPending})
+
+	result, errResp := suite.handler.HandleGrant(context.Background(), suite.tokenRequest, suite.oauthApp)
+
+	assert.Nil(suite.T(), result)
+	assert.Equal(suite.T(), constants.ErrorAuthorizationPending, errResp.Error)
+}
+
+func (suite *DeviceCodeGrantHandlerTestSuite) TestHandleGrant_Success() {
+	authTime := time.Now()
+	suite.mockDeviceService.EXPECT().ConsumeDeviceCode(mock.Anything, testClientID, testDeviceCode).
+		Return(&device.DeviceAuthorization{
+			ClientID:         testClientID,
+			StandardScopes:   []string{constants.ScopeOpenID},
+			PermissionScopes: []string{"read"},
+			AuthorizedUserID: testUserID,
+			AuthTime:         authTime,
+		}, nil)
+	suite.mockResourceService.On("FindResourceServersByPermissions", mock.Anything, mock.Anything).
+		Return(nil, nil).Maybe()
+	suite.mockTokenBuilder.EXPECT().BuildAccessToken(mock.MatchedBy(
+		func(ctx *tokenservice.AccessTokenBuildContext) bool {
+			return ctx.Subject == testUserID && ctx.GrantType == string(constants.GrantTypeDeviceCode) &&
+				ctx.AuthTime == authTime.Unix() && len(ctx.Scopes) == 2
+		})).Return(&model.TokenDTO{Token: "test-access-token", Subject: testUserID}, nil)
+	suite.mockTokenBuilder.EXPECT().BuildIDToken(mock.MatchedBy(func(ctx *tokenservice.IDTokenBuildContext) bool {
+		return ctx.Subject == testUserID && ctx.Audience == testClientID
+	})).Return(&model.TokenDTO{Token: "test-id-token"}, nil)
+
+	result, errResp := suite.handler.HandleGrant(context.Background(), suite.tokenRequest, suite.oauthApp)
+
+	assert.Nil(suite.T(), errResp)
+	assert.Equal(suite.T(), "test-access-token", result.AccessToken.Token)
+	assert.Equal(suite.T(), "test-id-token", result.IDToken.Token)
+}
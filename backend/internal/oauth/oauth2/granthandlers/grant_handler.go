@@ -21,9 +21,12 @@ package granthandlers
 
 import (
 	"context"
+	"errors"
 
 	inboundmodel "github.com/thunder-id/thunderid/internal/inboundclient/model"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/model"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/tokenservice"
 )
 
 // GrantHandlerInterface defines the interface for handling OAuth 2.0 grants.
@@ -51,3 +54,31 @@ type RefreshTokenGrantHandlerInterface interface {
 		attributeCacheID string,
 	) *model.ErrorResponse
 }
+
+// applyAllowedScopes restricts the scopes of a token request to the scopes allowed for the client.
+func applyAllowedScopes(oauthApp *inboundmodel.OAuthClient, scopes []string) ([]string, *model.ErrorResponse) {
+	allowedScopes, ok := oauthApp.ApplyAllowedScopes(scopes)
+	if !ok {
+		return nil, &model.ErrorResponse{
+			Error:            constants.ErrorInvalidScope,
+			ErrorDescription: "Requested scope is not allowed for this client",
+		}
+	}
+	return allowedScopes, nil
+}
+
+// accessTokenBuildError maps an error returned while building an access token to a token error response.
+// Requests denied by a pre-issuance policy are reported as access_denied with the reason of the policy.
+func accessTokenBuildError(err error, description string) *model.ErrorResponse {
+	var deniedErr *tokenservice.IssuanceDeniedError
+	if errors.As(err, &deniedErr) {
+		return &model.ErrorResponse{
+			Error:            constants.ErrorAccessDenied,
+			ErrorDescription: deniedErr.Reason,
+		}
+	}
+	return &model.ErrorResponse{
+		Error:            constants.ErrorServerError,
+		ErrorDescription: description,
+	}
+}
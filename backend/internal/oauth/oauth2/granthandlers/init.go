@@ -22,16 +22,25 @@ import (
 	"net/http"
 
 	"github.com/thunder-id/thunderid/internal/attributecache"
+	authnprovidermgr "github.com/thunder-id/thunderid/internal/authnprovider/manager"
 	"github.com/thunder-id/thunderid/internal/authz"
 	"github.com/thunder-id/thunderid/internal/entityprovider"
 	"github.com/thunder-id/thunderid/internal/flow/flowexec"
 	"github.com/thunder-id/thunderid/internal/inboundclient"
 	oauth2authz "github.com/thunder-id/thunderid/internal/oauth/oauth2/authz"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/clientusage"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/device"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/discovery"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/par"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/protocoltrace"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/tokenservice"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/tokenstore"
 	"github.com/thunder-id/thunderid/internal/ou"
 	"github.com/thunder-id/thunderid/internal/resource"
+	"github.com/thunder-id/thunderid/internal/system/config"
 	"github.com/thunder-id/thunderid/internal/system/jose/jwt"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/internal/system/observability"
 )
 
 // Initialize initializes the grant handler provider with the given services.
@@ -39,6 +48,7 @@ func Initialize(
 	mux *http.ServeMux,
 	jwtService jwt.JWTServiceInterface,
 	inboundClient inboundclient.InboundClientServiceInterface,
+	authnProvider authnprovidermgr.AuthnProviderManagerInterface,
 	flowExecService flowexec.FlowExecServiceInterface,
 	tokenBuilder tokenservice.TokenBuilderInterface,
 	tokenValidator tokenservice.TokenValidatorInterface,
@@ -48,13 +58,30 @@ func Initialize(
 	entityProv entityprovider.EntityProviderInterface,
 	resourceService resource.ResourceServiceInterface,
 	parService par.PARServiceInterface,
+	discoveryService discovery.DiscoveryServiceInterface,
+	protocolTraceService protocoltrace.ProtocolTraceServiceInterface,
+	clientUsageService clientusage.ClientUsageServiceInterface,
+	observabilitySvc observability.ObservabilityServiceInterface,
 ) (GrantHandlerProviderInterface, error) {
 	oauthAuthzService, err := oauth2authz.Initialize(
-		mux, inboundClient, resourceService, jwtService, flowExecService, parService,
+		mux, inboundClient, resourceService, jwtService, flowExecService, parService, protocolTraceService,
+		clientUsageService,
 	)
 	if err != nil {
 		return nil, err
 	}
+	deviceService := device.Initialize(mux, inboundClient, authnProvider, jwtService, discoveryService,
+		resourceService, oauthAuthzService)
+
+	// Refresh tokens are stateless unless a token store is configured for them.
+	var refreshTokenStore tokenstore.TokenStoreInterface
+	if tokenstore.ResolveStoreType(tokenstore.ArtifactTypeRefreshToken) != "" {
+		refreshTokenStore = tokenstore.Initialize(tokenstore.ArtifactTypeRefreshToken)
+	} else if config.GetServerRuntime().Config.OAuth.RefreshToken.IdleTimeout > 0 {
+		log.GetLogger().Warn("Refresh token idle timeout is configured but no refresh token store is set; " +
+			"inactivity expiry will not be enforced")
+	}
+
 	grantHandlerProvider := newGrantHandlerProvider(
 		jwtService,
 		oauthAuthzService,
@@ -65,6 +92,9 @@ func Initialize(
 		authzService,
 		entityProv,
 		resourceService,
+		refreshTokenStore,
+		deviceService,
+		observabilitySvc,
 	)
 	return grantHandlerProvider, nil
 }
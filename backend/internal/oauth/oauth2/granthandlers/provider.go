@@ -24,10 +24,13 @@ import (
 	"github.com/thunder-id/thunderid/internal/entityprovider"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/authz"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/device"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/tokenservice"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/tokenstore"
 	"github.com/thunder-id/thunderid/internal/ou"
 	"github.com/thunder-id/thunderid/internal/resource"
 	"github.com/thunder-id/thunderid/internal/system/jose/jwt"
+	"github.com/thunder-id/thunderid/internal/system/observability"
 )
 
 // GrantHandlerProviderInterface defines the interface for the grant handler provider.
@@ -41,6 +44,8 @@ type GrantHandlerProvider struct {
 	authorizationCodeGrantHandler GrantHandlerInterface
 	refreshTokenGrantHandler      GrantHandlerInterface
 	tokenExchangeGrantHandler     GrantHandlerInterface
+	deviceCodeGrantHandler        GrantHandlerInterface
+	customGrantHandlers           map[constants.GrantType]GrantHandlerInterface
 }
 
 // newGrantHandlerProvider creates a new instance of GrantHandlerProvider.
@@ -54,16 +59,28 @@ func newGrantHandlerProvider(
 	rbacAuthzService rbacauthz.AuthorizationServiceInterface,
 	entityProv entityprovider.EntityProviderInterface,
 	resourceService resource.ResourceServiceInterface,
+	refreshTokenStore tokenstore.TokenStoreInterface,
+	deviceService device.DeviceAuthorizationServiceInterface,
+	observabilitySvc observability.ObservabilityServiceInterface,
 ) GrantHandlerProviderInterface {
+	customGrantHandlers := make(map[constants.GrantType]GrantHandlerInterface)
+	for grantType, factory := range getCustomGrantFactories() {
+		customGrantHandlers[grantType] = newCustomGrantHandler(grantType, factory(), tokenBuilder, ouService)
+	}
+
 	return &GrantHandlerProvider{
 		clientCredentialsGrantHandler: newClientCredentialsGrantHandler(
 			tokenBuilder, ouService, rbacAuthzService, entityProv, resourceService),
 		authorizationCodeGrantHandler: newAuthorizationCodeGrantHandler(
 			authzService, tokenBuilder, attrCacheService, resourceService),
 		refreshTokenGrantHandler: newRefreshTokenGrantHandler(
-			jwtService, tokenBuilder, tokenValidator, attrCacheService, resourceService),
+			jwtService, tokenBuilder, tokenValidator, attrCacheService, resourceService, refreshTokenStore,
+			observabilitySvc),
 		tokenExchangeGrantHandler: newTokenExchangeGrantHandler(
 			tokenBuilder, tokenValidator, resourceService),
+		deviceCodeGrantHandler: newDeviceCodeGrantHandler(
+			deviceService, tokenBuilder, attrCacheService, resourceService),
+		customGrantHandlers: customGrantHandlers,
 	}
 }
 
@@ -78,7 +95,12 @@ func (p *GrantHandlerProvider) GetGrantHandler(grantType constants.GrantType) (G
 		return p.refreshTokenGrantHandler, nil
 	case constants.GrantTypeTokenExchange:
 		return p.tokenExchangeGrantHandler, nil
+	case constants.GrantTypeDeviceCode:
+		return p.deviceCodeGrantHandler, nil
 	default:
+		if handler, ok := p.customGrantHandlers[grantType]; ok {
+			return handler, nil
+		}
 		return nil, constants.UnSupportedGrantTypeError
 	}
 }
@@ -20,6 +20,8 @@ package granthandlers
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"slices"
 	"strings"
 	"time"
@@ -27,17 +29,25 @@ import (
 	"github.com/thunder-id/thunderid/internal/attributecache"
 	inboundmodel "github.com/thunder-id/thunderid/internal/inboundclient/model"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/dpop"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/model"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/resourceindicators"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/scopeceiling"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/tokenservice"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/tokenstore"
 	oauth2utils "github.com/thunder-id/thunderid/internal/oauth/oauth2/utils"
 	"github.com/thunder-id/thunderid/internal/resource"
-	"github.com/thunder-id/thunderid/internal/system/config"
+	sysContext "github.com/thunder-id/thunderid/internal/system/context"
 	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
 	"github.com/thunder-id/thunderid/internal/system/jose/jwt"
 	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/internal/system/observability"
+	"github.com/thunder-id/thunderid/internal/system/observability/event"
 )
 
+// revokedFamilyKeyPrefix prefixes the refresh token store keys that mark a token family as revoked.
+const revokedFamilyKeyPrefix = "revoked-family:"
+
 // refreshTokenGrantHandler handles the refresh token grant type.
 type refreshTokenGrantHandler struct {
 	jwtService       jwt.JWTServiceInterface
@@ -45,6 +55,20 @@ type refreshTokenGrantHandler struct {
 	tokenValidator   tokenservice.TokenValidatorInterface
 	attrCacheService attributecache.AttributeCacheServiceInterface
 	resourceService  resource.ResourceServiceInterface
+	// refreshTokenStore tracks issued refresh tokens. It is nil when refresh tokens are stateless.
+	refreshTokenStore tokenstore.TokenStoreInterface
+	observabilitySvc  observability.ObservabilityServiceInterface
+}
+
+// refreshTokenEntry is the value tracked in the refresh token store for an issued refresh token.
+// FamilyID identifies the chain of rotated refresh tokens the token belongs to. It is empty for the
+// first token of a chain, whose own jti identifies the family. Rotated marks a token that has been
+// exchanged for a successor and is kept only to detect reuse.
+type refreshTokenEntry struct {
+	ClientID   string `json:"clientId"`
+	LastUsedAt int64  `json:"lastUsedAt"`
+	FamilyID   string `json:"familyId,omitempty"`
+	Rotated    bool   `json:"rotated,omitempty"`
 }
 
 // newRefreshTokenGrantHandler creates a new instance of RefreshTokenGrantHandler.
@@ -54,13 +78,17 @@ func newRefreshTokenGrantHandler(
 	tokenValidator tokenservice.TokenValidatorInterface,
 	attrCacheService attributecache.AttributeCacheServiceInterface,
 	resourceService resource.ResourceServiceInterface,
+	refreshTokenStore tokenstore.TokenStoreInterface,
+	observabilitySvc observability.ObservabilityServiceInterface,
 ) RefreshTokenGrantHandlerInterface {
 	return &refreshTokenGrantHandler{
-		jwtService:       jwtService,
-		tokenBuilder:     tokenBuilder,
-		tokenValidator:   tokenValidator,
-		attrCacheService: attrCacheService,
-		resourceService:  resourceService,
+		jwtService:        jwtService,
+		tokenBuilder:      tokenBuilder,
+		tokenValidator:    tokenValidator,
+		attrCacheService:  attrCacheService,
+		resourceService:   resourceService,
+		refreshTokenStore: refreshTokenStore,
+		observabilitySvc:  observabilitySvc,
 	}
 }
 
@@ -103,16 +131,49 @@ func (h *refreshTokenGrantHandler) HandleGrant(ctx context.Context, tokenRequest
 	refreshTokenClaims, err := h.tokenValidator.ValidateRefreshToken(tokenRequest.RefreshToken, tokenRequest.ClientID)
 	if err != nil {
 		logger.Debug("Failed to validate refresh token", log.Error(err))
+		if errors.Is(err, tokenservice.ErrRefreshTokenExpired) {
+			return nil, &model.ErrorResponse{
+				Error:            constants.ErrorInvalidGrant,
+				ErrorDescription: "Refresh token has expired",
+			}
+		}
 		return nil, &model.ErrorResponse{
 			Error:            constants.ErrorInvalidGrant,
 			ErrorDescription: "Invalid refresh token",
 		}
 	}
+	// A DPoP-bound refresh token may only be used with a proof signed by the key it is bound to.
+	if refreshTokenClaims.JKT != "" && refreshTokenClaims.JKT != dpop.GetThumbprint(ctx) {
+		logger.Debug("Rejecting DPoP-bound refresh token presented without a matching DPoP proof")
+		return nil, &model.ErrorResponse{
+			Error:            constants.ErrorInvalidDPoPProof,
+			ErrorDescription: "The DPoP proof does not match the key the refresh token is bound to",
+		}
+	}
 
 	newTokenScopes, scopeErr := h.validateAndApplyScopes(tokenRequest.Scope, refreshTokenClaims.Scopes, logger)
 	if scopeErr != nil {
 		return nil, scopeErr
 	}
+	// The client's allowed scopes may have been narrowed since the refresh token was issued.
+	newTokenScopes, scopeErr = applyAllowedScopes(oauthApp, newTokenScopes)
+	if scopeErr != nil {
+		return nil, scopeErr
+	}
+	// Tokens of users stay capped at the ceiling of the authentication the user completed, which may have
+	// been lowered since the refresh token was issued.
+	if refreshTokenClaims.GrantType == string(constants.GrantTypeAuthorizationCode) {
+		newTokenScopes, scopeErr = scopeceiling.Apply(refreshTokenClaims.ACR, newTokenScopes, oauthApp.ScopeClaims)
+		if scopeErr != nil {
+			return nil, scopeErr
+		}
+	}
+
+	renewRefreshToken := tokenservice.ResolveRefreshTokenRenewOnGrant(oauthApp)
+	familyID, errResp := h.checkRefreshTokenActive(ctx, refreshTokenClaims, oauthApp, renewRefreshToken, logger)
+	if errResp != nil {
+		return nil, errResp
+	}
 
 	// Compute narrowed audiences per RFC 8707 §2.1. When the client supplies resource parameters,
 	// narrow the audience to the intersection with the original refresh-token audiences.
@@ -189,16 +250,14 @@ func (h *refreshTokenGrantHandler) HandleGrant(ctx context.Context, tokenRequest
 		UserAttributes:   attrs,
 		AttributeCacheID: refreshTokenClaims.AttributeCacheID,
 		GrantType:        refreshTokenClaims.GrantType,
+		ACR:              refreshTokenClaims.ACR,
 		OAuthApp:         oauthApp,
 		ClaimsRequest:    refreshTokenClaims.ClaimsRequest,
 		ClaimsLocales:    refreshTokenClaims.ClaimsLocales,
 	})
 	if err != nil {
 		logger.Error("Failed to generate access token", log.Error(err))
-		return nil, &model.ErrorResponse{
-			Error:            constants.ErrorServerError,
-			ErrorDescription: "Failed to generate access token",
-		}
+		return nil, accessTokenBuildError(err, "Failed to generate access token")
 	}
 
 	// Prepare the token response
@@ -213,6 +272,7 @@ func (h *refreshTokenGrantHandler) HandleGrant(ctx context.Context, tokenRequest
 			Subject:        refreshTokenClaims.Sub,
 			Audience:       tokenRequest.ClientID,
 			Scopes:         newTokenScopes,
+			GrantType:      tokenRequest.GrantType,
 			UserAttributes: attrs,
 			OAuthApp:       oauthApp,
 			ClaimsRequest:  refreshTokenClaims.ClaimsRequest,
@@ -227,21 +287,18 @@ func (h *refreshTokenGrantHandler) HandleGrant(ctx context.Context, tokenRequest
 		tokenResponse.IDToken = *idToken
 	}
 
-	// Check configuration for refresh token renewal
-	conf := config.GetServerRuntime().Config
-	renewRefreshToken := conf.OAuth.RefreshToken.RenewOnGrant
+	if errResp := h.extendCacheTTL(ctx, cacheEntry, oauthApp, refreshTokenClaims.Iat,
+		accessToken.ExpiresIn, renewRefreshToken, refreshTokenClaims.AttributeCacheID, logger); errResp != nil {
+		return nil, errResp
+	}
 
 	// Issue a new refresh token if renew_on_grant is enabled; otherwise reuse the existing one.
-	// RFC 8707 §5: the refresh token preserves the full original audience, not the narrowed one.
+	// RFC 8707 §5: the refresh token preserves the full original audience, not the narrowed one, and
+	// RFC 6749 §6: the scope of the new refresh token is identical to the scope of the presented one.
 	if renewRefreshToken {
 		logger.Debug("Renewing refresh token", log.String("client_id", tokenRequest.ClientID))
-		errResp := h.IssueRefreshToken(ctx, tokenResponse, oauthApp,
-			refreshTokenClaims.Sub, refreshTokenClaims.Audiences,
-			refreshTokenClaims.GrantType, newTokenScopes,
-			refreshTokenClaims.ClaimsRequest, refreshTokenClaims.ClaimsLocales,
-			refreshTokenClaims.AttributeCacheID)
-		if errResp != nil && errResp.Error != "" {
-			logger.Error("Failed to issue refresh token", log.String("error", errResp.Error))
+		if errResp := h.rotateRefreshToken(ctx, tokenResponse, oauthApp, refreshTokenClaims, familyID,
+			logger); errResp != nil {
 			return nil, errResp
 		}
 	} else {
@@ -253,11 +310,6 @@ func (h *refreshTokenGrantHandler) HandleGrant(ctx context.Context, tokenRequest
 		}
 	}
 
-	if errResp := h.extendCacheTTL(ctx, cacheEntry, oauthApp, refreshTokenClaims.Iat,
-		accessToken.ExpiresIn, renewRefreshToken, refreshTokenClaims.AttributeCacheID, logger); errResp != nil {
-		return nil, errResp
-	}
-
 	return tokenResponse, nil
 }
 
@@ -272,6 +324,53 @@ func (h *refreshTokenGrantHandler) IssueRefreshToken(
 	claimsLocales string,
 	attributeCacheID string,
 ) *model.ErrorResponse {
+	return h.issueRefreshToken(ctx, tokenResponse, oauthApp, subject, audiences, grantType, scopes,
+		claimsRequest, claimsLocales, attributeCacheID, "")
+}
+
+// issueRefreshToken generates a new refresh token that joins the given token family. An empty family ID
+// starts a new family.
+func (h *refreshTokenGrantHandler) issueRefreshToken(
+	ctx context.Context,
+	tokenResponse *model.TokenResponseDTO,
+	oauthApp *inboundmodel.OAuthClient,
+	subject string, audiences []string, grantType string,
+	scopes []string,
+	claimsRequest *model.ClaimsRequest,
+	claimsLocales string,
+	attributeCacheID string,
+	familyID string,
+) *model.ErrorResponse {
+	refreshToken, errResp := h.buildRefreshToken(ctx, tokenResponse, oauthApp, subject, audiences, grantType,
+		scopes, claimsRequest, claimsLocales, attributeCacheID)
+	if errResp != nil {
+		return errResp
+	}
+
+	if h.refreshTokenStore != nil {
+		if errResp := h.trackRefreshToken(ctx, refreshToken, oauthApp.ClientID, familyID); errResp != nil {
+			return errResp
+		}
+	}
+
+	if tokenResponse == nil {
+		tokenResponse = &model.TokenResponseDTO{}
+	}
+	tokenResponse.RefreshToken = *refreshToken
+	return nil
+}
+
+// buildRefreshToken builds a new refresh token without tracking it in the refresh token store.
+func (h *refreshTokenGrantHandler) buildRefreshToken(
+	ctx context.Context,
+	tokenResponse *model.TokenResponseDTO,
+	oauthApp *inboundmodel.OAuthClient,
+	subject string, audiences []string, grantType string,
+	scopes []string,
+	claimsRequest *model.ClaimsRequest,
+	claimsLocales string,
+	attributeCacheID string,
+) (*model.TokenDTO, *model.ErrorResponse) {
 	tokenCtx := &tokenservice.RefreshTokenBuildContext{
 		Context:              ctx,
 		ClientID:             oauthApp.ClientID,
@@ -284,23 +383,350 @@ func (h *refreshTokenGrantHandler) IssueRefreshToken(
 		ClaimsRequest:        claimsRequest,
 		ClaimsLocales:        claimsLocales,
 	}
+	if tokenResponse != nil {
+		tokenCtx.ACR = tokenResponse.AccessToken.ACR
+	}
 
 	// Build refresh token using token builder
 	refreshToken, err := h.tokenBuilder.BuildRefreshToken(tokenCtx)
 	if err != nil {
-		return &model.ErrorResponse{
+		return nil, &model.ErrorResponse{
 			Error:            constants.ErrorServerError,
 			ErrorDescription: "Failed to generate refresh token",
 		}
 	}
+	return refreshToken, nil
+}
 
-	if tokenResponse == nil {
-		tokenResponse = &model.TokenResponseDTO{}
+// rotateRefreshToken replaces a presented refresh token with a new one of the same token family. The
+// presented token is consumed only once the new token is built, and is restored when the new token cannot
+// be tracked, so that a failed grant never leaves the client without a usable refresh token.
+func (h *refreshTokenGrantHandler) rotateRefreshToken(ctx context.Context,
+	tokenResponse *model.TokenResponseDTO, oauthApp *inboundmodel.OAuthClient,
+	claims *tokenservice.RefreshTokenClaims, familyID string, logger *log.Logger) *model.ErrorResponse {
+	refreshToken, errResp := h.buildRefreshToken(ctx, tokenResponse, oauthApp, claims.Sub, claims.Audiences,
+		claims.GrantType, claims.Scopes, claims.ClaimsRequest, claims.ClaimsLocales, claims.AttributeCacheID)
+	if errResp != nil {
+		logger.Error("Failed to issue refresh token", log.String("error", errResp.Error))
+		return errResp
+	}
+
+	if h.refreshTokenStore != nil {
+		consumed, errResp := h.consumeRefreshToken(ctx, claims, oauthApp, logger)
+		if errResp != nil {
+			return errResp
+		}
+		if errResp := h.trackRefreshToken(ctx, refreshToken, oauthApp.ClientID, familyID); errResp != nil {
+			h.restoreRefreshToken(ctx, claims, oauthApp, consumed, logger)
+			return errResp
+		}
+		if errResp := h.markRefreshTokenRotated(ctx, claims, oauthApp, familyID, logger); errResp != nil {
+			h.restoreRefreshToken(ctx, claims, oauthApp, consumed, logger)
+			return errResp
+		}
 	}
+
 	tokenResponse.RefreshToken = *refreshToken
 	return nil
 }
 
+// trackRefreshToken records an issued refresh token of the given token family in the refresh token store
+// until it expires.
+func (h *refreshTokenGrantHandler) trackRefreshToken(
+	ctx context.Context, refreshToken *model.TokenDTO, clientID, familyID string) *model.ErrorResponse {
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, "RefreshTokenGrantHandler"))
+
+	claims, err := jwt.DecodeJWTPayload(refreshToken.Token)
+	if err != nil {
+		logger.Error("Failed to decode issued refresh token", log.Error(err))
+		return &model.ErrorResponse{
+			Error:            constants.ErrorServerError,
+			ErrorDescription: "Failed to generate refresh token",
+		}
+	}
+	jti, ok := claims["jti"].(string)
+	if !ok || jti == "" {
+		logger.Error("Issued refresh token does not contain a jti claim")
+		return &model.ErrorResponse{
+			Error:            constants.ErrorServerError,
+			ErrorDescription: "Failed to generate refresh token",
+		}
+	}
+
+	value, err := json.Marshal(refreshTokenEntry{
+		ClientID: clientID, LastUsedAt: refreshToken.IssuedAt, FamilyID: familyID,
+	})
+	if err != nil {
+		logger.Error("Failed to marshal refresh token entry", log.Error(err))
+		return &model.ErrorResponse{
+			Error:            constants.ErrorServerError,
+			ErrorDescription: "Failed to generate refresh token",
+		}
+	}
+
+	expiryTime := time.Unix(refreshToken.IssuedAt+refreshToken.ExpiresIn, 0)
+	if err := h.refreshTokenStore.Store(ctx, jti, value, expiryTime); err != nil {
+		logger.Error("Failed to store refresh token", log.Error(err))
+		return &model.ErrorResponse{
+			Error:            constants.ErrorServerError,
+			ErrorDescription: "Failed to generate refresh token",
+		}
+	}
+	return nil
+}
+
+// checkRefreshTokenActive verifies that a presented refresh token is still tracked in the refresh
+// token store, has not been idle for longer than the idle timeout of the application and does not belong
+// to a revoked token family. The token is left in place when it is being renewed, since it is consumed only
+// after the rest of the grant has been validated; otherwise its last-used time is updated. Presenting a token
+// that was already rotated revokes its whole token family. It returns the family of the token, and is a
+// no-op when refresh tokens are stateless.
+func (h *refreshTokenGrantHandler) checkRefreshTokenActive(ctx context.Context,
+	claims *tokenservice.RefreshTokenClaims, oauthApp *inboundmodel.OAuthClient, renew bool,
+	logger *log.Logger) (string, *model.ErrorResponse) {
+	if h.refreshTokenStore == nil {
+		return "", nil
+	}
+	jti := claims.JTI
+	clientID := oauthApp.ClientID
+	if jti == "" {
+		logger.Debug("Refresh token does not contain a jti claim")
+		return "", &model.ErrorResponse{
+			Error:            constants.ErrorInvalidGrant,
+			ErrorDescription: "Invalid refresh token",
+		}
+	}
+
+	value, found, err := h.refreshTokenStore.Get(ctx, jti)
+	if err != nil {
+		logger.Error("Failed to look up refresh token in token store", log.Error(err))
+		return "", &model.ErrorResponse{
+			Error:            constants.ErrorServerError,
+			ErrorDescription: "Failed to validate refresh token",
+		}
+	}
+	if !found {
+		logger.Debug("Refresh token not found in token store")
+		return "", &model.ErrorResponse{
+			Error:            constants.ErrorInvalidGrant,
+			ErrorDescription: "Invalid refresh token",
+		}
+	}
+
+	// Entries tracked before idle expiry was introduced hold only the client ID and carry no
+	// last-used time, so the idle check is skipped for them until their next redemption.
+	var entry refreshTokenEntry
+	if err := json.Unmarshal(value, &entry); err != nil {
+		entry = refreshTokenEntry{}
+	}
+	familyID := entry.FamilyID
+	if familyID == "" {
+		familyID = jti
+	}
+
+	if entry.Rotated {
+		logger.Warn("Rotated refresh token was reused; revoking its token family",
+			log.String("client_id", clientID), log.String("family_id", familyID))
+		if errResp := h.revokeTokenFamily(ctx, familyID, oauthApp, logger); errResp != nil {
+			return "", errResp
+		}
+		h.publishReuseDetectedEvent(ctx, clientID, claims.Sub, familyID)
+		return "", &model.ErrorResponse{
+			Error:            constants.ErrorInvalidGrant,
+			ErrorDescription: "Refresh token has already been used",
+		}
+	}
+
+	// Only rotated tokens have a family that differs from their own jti, and a family can be revoked only
+	// after one of its tokens was rotated.
+	if entry.FamilyID != "" {
+		_, revoked, err := h.refreshTokenStore.Get(ctx, revokedFamilyKeyPrefix+entry.FamilyID)
+		if err != nil {
+			logger.Error("Failed to look up refresh token family in token store", log.Error(err))
+			return "", &model.ErrorResponse{
+				Error:            constants.ErrorServerError,
+				ErrorDescription: "Failed to validate refresh token",
+			}
+		}
+		if revoked {
+			logger.Debug("Refresh token belongs to a revoked token family", log.String("client_id", clientID))
+			return "", &model.ErrorResponse{
+				Error:            constants.ErrorInvalidGrant,
+				ErrorDescription: "Invalid refresh token",
+			}
+		}
+	}
+
+	idleTimeout := tokenservice.ResolveRefreshTokenIdleTimeout(oauthApp)
+	now := time.Now().Unix()
+	if idleTimeout > 0 && entry.LastUsedAt > 0 && now-entry.LastUsedAt > idleTimeout {
+		logger.Debug("Refresh token expired due to inactivity", log.String("client_id", clientID))
+		if err := h.refreshTokenStore.Delete(ctx, jti); err != nil {
+			logger.Error("Failed to delete idle refresh token from token store", log.Error(err))
+		}
+		return "", &model.ErrorResponse{
+			Error:            constants.ErrorInvalidGrant,
+			ErrorDescription: "Refresh token has expired due to inactivity",
+		}
+	}
+	if renew {
+		return familyID, nil
+	}
+
+	updated, err := json.Marshal(refreshTokenEntry{ClientID: clientID, LastUsedAt: now, FamilyID: entry.FamilyID})
+	if err != nil {
+		logger.Error("Failed to marshal refresh token entry", log.Error(err))
+		return "", &model.ErrorResponse{
+			Error:            constants.ErrorServerError,
+			ErrorDescription: "Failed to validate refresh token",
+		}
+	}
+	if found, err = h.refreshTokenStore.Update(ctx, jti, updated); err != nil {
+		logger.Error("Failed to update refresh token last-used time", log.Error(err))
+		return "", &model.ErrorResponse{
+			Error:            constants.ErrorServerError,
+			ErrorDescription: "Failed to validate refresh token",
+		}
+	}
+	if !found {
+		logger.Debug("Refresh token expired before its last-used time could be updated")
+		return "", &model.ErrorResponse{
+			Error:            constants.ErrorInvalidGrant,
+			ErrorDescription: "Invalid refresh token",
+		}
+	}
+	return familyID, nil
+}
+
+// consumeRefreshToken removes a presented refresh token from the refresh token store so that it cannot be
+// redeemed again, and returns its entry. Losing the race against a concurrent redemption of the same token
+// is treated as a reuse of a rotated token.
+func (h *refreshTokenGrantHandler) consumeRefreshToken(ctx context.Context,
+	claims *tokenservice.RefreshTokenClaims, oauthApp *inboundmodel.OAuthClient,
+	logger *log.Logger) ([]byte, *model.ErrorResponse) {
+	value, found, err := h.refreshTokenStore.Consume(ctx, claims.JTI)
+	if err != nil {
+		logger.Error("Failed to consume refresh token in token store", log.Error(err))
+		return nil, &model.ErrorResponse{
+			Error:            constants.ErrorServerError,
+			ErrorDescription: "Failed to validate refresh token",
+		}
+	}
+	if !found {
+		logger.Debug("Refresh token was removed from token store before it could be consumed")
+		return nil, &model.ErrorResponse{
+			Error:            constants.ErrorInvalidGrant,
+			ErrorDescription: "Invalid refresh token",
+		}
+	}
+
+	var entry refreshTokenEntry
+	if err := json.Unmarshal(value, &entry); err == nil && entry.Rotated {
+		familyID := entry.FamilyID
+		if familyID == "" {
+			familyID = claims.JTI
+		}
+		logger.Warn("Refresh token was rotated by a concurrent request; revoking its token family",
+			log.String("client_id", oauthApp.ClientID), log.String("family_id", familyID))
+		if errResp := h.revokeTokenFamily(ctx, familyID, oauthApp, logger); errResp != nil {
+			return nil, errResp
+		}
+		h.publishReuseDetectedEvent(ctx, oauthApp.ClientID, claims.Sub, familyID)
+		return nil, &model.ErrorResponse{
+			Error:            constants.ErrorInvalidGrant,
+			ErrorDescription: "Refresh token has already been used",
+		}
+	}
+	return value, nil
+}
+
+// restoreRefreshToken puts a consumed refresh token back into the refresh token store with the entry it
+// was consumed with, until it would have expired.
+func (h *refreshTokenGrantHandler) restoreRefreshToken(ctx context.Context,
+	claims *tokenservice.RefreshTokenClaims, oauthApp *inboundmodel.OAuthClient, value []byte,
+	logger *log.Logger) {
+	validity := tokenservice.ResolveTokenConfig(oauthApp, tokenservice.TokenTypeRefresh).ValidityPeriod
+	expiryTime := time.Unix(claims.Iat+validity, 0)
+	if err := h.refreshTokenStore.Store(ctx, claims.JTI, value, expiryTime); err != nil {
+		logger.Error("Failed to restore consumed refresh token", log.Error(err))
+	}
+}
+
+// markRefreshTokenRotated keeps a consumed refresh token in the refresh token store as rotated until
+// it would have expired, so that a later reuse of it is detected. This is a no-op when refresh tokens
+// are stateless.
+func (h *refreshTokenGrantHandler) markRefreshTokenRotated(ctx context.Context,
+	claims *tokenservice.RefreshTokenClaims, oauthApp *inboundmodel.OAuthClient, familyID string,
+	logger *log.Logger) *model.ErrorResponse {
+	if h.refreshTokenStore == nil {
+		return nil
+	}
+
+	value, err := json.Marshal(refreshTokenEntry{ClientID: oauthApp.ClientID, FamilyID: familyID, Rotated: true})
+	if err != nil {
+		logger.Error("Failed to marshal rotated refresh token entry", log.Error(err))
+		return &model.ErrorResponse{
+			Error:            constants.ErrorServerError,
+			ErrorDescription: "Failed to generate refresh token",
+		}
+	}
+	validity := tokenservice.ResolveTokenConfig(oauthApp, tokenservice.TokenTypeRefresh).ValidityPeriod
+	expiryTime := time.Unix(claims.Iat+validity, 0)
+	if err := h.refreshTokenStore.Store(ctx, claims.JTI, value, expiryTime); err != nil {
+		logger.Error("Failed to store rotated refresh token", log.Error(err))
+		return &model.ErrorResponse{
+			Error:            constants.ErrorServerError,
+			ErrorDescription: "Failed to generate refresh token",
+		}
+	}
+	return nil
+}
+
+// revokeTokenFamily marks a token family as revoked so that none of its refresh tokens can be redeemed.
+// The mark outlives every token of the family, since the latest of them was issued no later than now.
+func (h *refreshTokenGrantHandler) revokeTokenFamily(ctx context.Context, familyID string,
+	oauthApp *inboundmodel.OAuthClient, logger *log.Logger) *model.ErrorResponse {
+	value, err := json.Marshal(refreshTokenEntry{ClientID: oauthApp.ClientID, FamilyID: familyID})
+	if err != nil {
+		logger.Error("Failed to marshal revoked refresh token family", log.Error(err))
+		return &model.ErrorResponse{
+			Error:            constants.ErrorServerError,
+			ErrorDescription: "Failed to validate refresh token",
+		}
+	}
+	validity := tokenservice.ResolveTokenConfig(oauthApp, tokenservice.TokenTypeRefresh).ValidityPeriod
+	expiryTime := time.Now().Add(time.Duration(validity) * time.Second)
+	if err := h.refreshTokenStore.Store(ctx, revokedFamilyKeyPrefix+familyID, value, expiryTime); err != nil {
+		logger.Error("Failed to revoke refresh token family", log.Error(err))
+		return &model.ErrorResponse{
+			Error:            constants.ErrorServerError,
+			ErrorDescription: "Failed to validate refresh token",
+		}
+	}
+	return nil
+}
+
+// publishReuseDetectedEvent records the reuse of a rotated refresh token in the audit trail.
+func (h *refreshTokenGrantHandler) publishReuseDetectedEvent(ctx context.Context,
+	clientID, subject, familyID string) {
+	if h.observabilitySvc == nil || !h.observabilitySvc.IsEnabled() {
+		return
+	}
+
+	evt := event.NewEvent(
+		sysContext.GetTraceID(ctx),
+		string(event.EventTypeRefreshTokenReuseDetected),
+		event.ComponentAuthHandler,
+	).
+		WithTenantID(sysContext.GetTenantID(ctx)).
+		WithStatus(event.StatusFailure).
+		WithData(event.DataKey.ClientID, clientID).
+		WithData(event.DataKey.UserID, subject).
+		WithData(event.DataKey.TokenFamilyID, familyID)
+
+	h.observabilitySvc.PublishEvent(evt)
+}
+
 // extendCacheTTL extends the attribute cache TTL when the desired lifetime exceeds what is already
 // stored. The desired TTL is the larger of:
 //   - the refresh token's actual expiry (iat + validity; for a renewed token, iat = now)
@@ -20,7 +20,10 @@ package granthandlers
 
 import (
 	"context"
+	"encoding/base64"
+	"encoding/json"
 	"errors"
+	"slices"
 	"testing"
 	"time"
 
@@ -31,6 +34,7 @@ import (
 	"github.com/thunder-id/thunderid/internal/attributecache"
 	inboundmodel "github.com/thunder-id/thunderid/internal/inboundclient/model"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/dpop"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/model"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/tokenservice"
 	"github.com/thunder-id/thunderid/internal/resource"
@@ -38,9 +42,12 @@ import (
 	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
 	"github.com/thunder-id/thunderid/internal/system/i18n/core"
 	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/internal/system/observability/event"
 	"github.com/thunder-id/thunderid/tests/mocks/attributecachemock"
 	"github.com/thunder-id/thunderid/tests/mocks/jose/jwtmock"
 	"github.com/thunder-id/thunderid/tests/mocks/oauth/oauth2/tokenservicemock"
+	"github.com/thunder-id/thunderid/tests/mocks/oauth/oauth2/tokenstoremock"
+	"github.com/thunder-id/thunderid/tests/mocks/observability/observabilitymock"
 	"github.com/thunder-id/thunderid/tests/mocks/resourcemock"
 )
 
@@ -152,6 +159,8 @@ func (suite *RefreshTokenGrantHandlerTestSuite) TestNewRefreshTokenGrantHandler(
 		suite.mockTokenValidator,
 		suite.mockAttrCacheService,
 		suite.mockResourceService,
+		nil,
+		nil,
 	)
 	assert.NotNil(suite.T(), handler)
 	assert.Implements(suite.T(), (*RefreshTokenGrantHandlerInterface)(nil), handler)
@@ -212,6 +221,24 @@ func (suite *RefreshTokenGrantHandlerTestSuite) TestHandleGrant_InvalidSignature
 	assert.Equal(suite.T(), "Invalid refresh token", err.ErrorDescription)
 }
 
+func (suite *RefreshTokenGrantHandlerTestSuite) TestHandleGrant_DPoPBoundWithoutMatchingProof() {
+	suite.mockTokenValidator.On("ValidateRefreshToken", suite.validRefreshToken, testRefreshTokenClientID).
+		Return(&tokenservice.RefreshTokenClaims{
+			Sub:       testRefreshTokenUserID,
+			Audiences: []string{testRefreshTokenAudience},
+			GrantType: "authorization_code",
+			JKT:       "jkt-1",
+		}, nil)
+
+	ctx := dpop.WithThumbprint(context.Background(), "jkt-2")
+	response, err := suite.handler.HandleGrant(ctx, suite.testTokenReq, suite.oauthApp)
+
+	assert.Nil(suite.T(), response)
+	assert.NotNil(suite.T(), err)
+	assert.Equal(suite.T(), constants.ErrorInvalidDPoPProof, err.Error)
+	suite.mockTokenBuilder.AssertNotCalled(suite.T(), "BuildAccessToken", mock.Anything)
+}
+
 func (suite *RefreshTokenGrantHandlerTestSuite) TestIssueRefreshToken_Success() {
 	// Mock token builder for refresh token generation
 	suite.mockTokenBuilder.On("BuildRefreshToken", mock.MatchedBy(
@@ -621,6 +648,103 @@ func (suite *RefreshTokenGrantHandlerTestSuite) TestHandleGrant_NoIDToken_WhenOp
 	assert.Empty(suite.T(), response.IDToken.Token)
 }
 
+func (suite *RefreshTokenGrantHandlerTestSuite) TestHandleGrant_NarrowsToAllowedScopes() {
+	suite.oauthApp.AllowedScopes = []string{"read"}
+	suite.testTokenReq.Scope = ""
+	suite.mockTokenValidator.On("ValidateRefreshToken", suite.validRefreshToken, testRefreshTokenClientID).
+		Return(&tokenservice.RefreshTokenClaims{
+			Sub:       testRefreshTokenUserID,
+			Audiences: []string{testRefreshTokenAudience},
+			Scopes:    []string{"read", "write"},
+			GrantType: "authorization_code",
+			Iat:       int64(suite.validClaims["iat"].(float64)),
+		}, nil)
+
+	suite.mockTokenBuilder.On("BuildAccessToken", mock.MatchedBy(
+		func(ctx *tokenservice.AccessTokenBuildContext) bool {
+			return len(ctx.Scopes) == 1 && ctx.Scopes[0] == testScopeRead
+		})).Return(&model.TokenDTO{
+		Token:     "new.access.token",
+		IssuedAt:  time.Now().Unix(),
+		ExpiresIn: 3600,
+		Scopes:    []string{"read"},
+	}, nil)
+
+	response, err := suite.handler.HandleGrant(context.Background(), suite.testTokenReq, suite.oauthApp)
+
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), []string{"read"}, response.AccessToken.Scopes)
+}
+
+func (suite *RefreshTokenGrantHandlerTestSuite) TestHandleGrant_ScopesCappedAtScopeCeiling() {
+	config.GetServerRuntime().Config.OAuth.ScopeCeilings.Rules = []config.ScopeCeilingRule{
+		{ACR: []string{"urn:thunder:acr:password"}, Scopes: []string{"read"}},
+	}
+	suite.testTokenReq.Scope = ""
+	suite.mockTokenValidator.On("ValidateRefreshToken", suite.validRefreshToken, testRefreshTokenClientID).
+		Return(&tokenservice.RefreshTokenClaims{
+			Sub:       testRefreshTokenUserID,
+			Audiences: []string{testRefreshTokenAudience},
+			Scopes:    []string{"read", "write"},
+			GrantType: "authorization_code",
+			ACR:       "urn:thunder:acr:password",
+			Iat:       int64(suite.validClaims["iat"].(float64)),
+		}, nil)
+
+	suite.mockTokenBuilder.On("BuildAccessToken", mock.MatchedBy(
+		func(ctx *tokenservice.AccessTokenBuildContext) bool {
+			return len(ctx.Scopes) == 1 && ctx.Scopes[0] == testScopeRead && ctx.ACR == "urn:thunder:acr:password"
+		})).Return(&model.TokenDTO{
+		Token:     "new.access.token",
+		IssuedAt:  time.Now().Unix(),
+		ExpiresIn: 3600,
+		Scopes:    []string{"read"},
+	}, nil)
+
+	response, err := suite.handler.HandleGrant(context.Background(), suite.testTokenReq, suite.oauthApp)
+
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), []string{"read"}, response.AccessToken.Scopes)
+}
+
+func (suite *RefreshTokenGrantHandlerTestSuite) TestHandleGrant_ScopeCeilingRejectsScopes() {
+	config.GetServerRuntime().Config.OAuth.ScopeCeilings = config.ScopeCeilingsConfig{
+		Reject: true,
+		Rules:  []config.ScopeCeilingRule{{Scopes: []string{"read"}}},
+	}
+	suite.testTokenReq.Scope = "write"
+	suite.mockTokenValidator.On("ValidateRefreshToken", suite.validRefreshToken, testRefreshTokenClientID).
+		Return(&tokenservice.RefreshTokenClaims{
+			Sub:       testRefreshTokenUserID,
+			Audiences: []string{testRefreshTokenAudience},
+			Scopes:    []string{"read", "write"},
+			GrantType: "authorization_code",
+			Iat:       int64(suite.validClaims["iat"].(float64)),
+		}, nil)
+
+	response, err := suite.handler.HandleGrant(context.Background(), suite.testTokenReq, suite.oauthApp)
+
+	assert.Nil(suite.T(), response)
+	assert.NotNil(suite.T(), err)
+	assert.Equal(suite.T(), constants.ErrorInsufficientAuthenticationLevel, err.Error)
+}
+
+func (suite *RefreshTokenGrantHandlerTestSuite) TestIssueRefreshToken_CarriesACR() {
+	suite.mockTokenBuilder.On("BuildRefreshToken", mock.MatchedBy(
+		func(ctx *tokenservice.RefreshTokenBuildContext) bool {
+			return ctx.ACR == "urn:thunder:acr:mfa"
+		})).Return(&model.TokenDTO{Token: "new.refresh.token"}, nil)
+
+	tokenResponse := &model.TokenResponseDTO{AccessToken: model.TokenDTO{ACR: "urn:thunder:acr:mfa"}}
+
+	err := suite.handler.IssueRefreshToken(context.Background(), tokenResponse, suite.oauthApp,
+		testRefreshTokenUserID, []string{testRefreshTokenAudience},
+		"authorization_code", []string{"read"}, nil, "", "")
+
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), "new.refresh.token", tokenResponse.RefreshToken.Token)
+}
+
 func (suite *RefreshTokenGrantHandlerTestSuite) TestHandleGrant_IDTokenGenerationError() {
 	// Mock successful refresh token validation with openid scope
 	suite.mockTokenValidator.On("ValidateRefreshToken", suite.validRefreshToken, testRefreshTokenClientID).
@@ -851,13 +975,6 @@ func (suite *RefreshTokenGrantHandlerTestSuite) TestHandleGrant_RenewOnGrant_Ext
 		UserAttributes: map[string]interface{}{"aci": testCacheID},
 	}, nil)
 
-	suite.mockTokenBuilder.On("BuildRefreshToken", mock.Anything).Return(&model.TokenDTO{
-		Token:     "new.refresh.token",
-		IssuedAt:  time.Now().Unix(),
-		ExpiresIn: 86400,
-		Scopes:    []string{"read"},
-	}, nil)
-
 	extendErr := &serviceerror.ServiceError{
 		Type: serviceerror.ServerErrorType,
 		Code: "ACS-2001",
@@ -1357,3 +1474,510 @@ func (suite *RefreshTokenGrantHandlerTestSuite) TestHandleGrant_ResourceNarrowin
 	assert.NotNil(suite.T(), response)
 	assert.Equal(suite.T(), "new.access.token", response.AccessToken.Token)
 }
+
+// buildTestRefreshToken returns a JWT-shaped token whose payload carries the given jti.
+func buildTestRefreshToken(jti string) string {
+	payload := base64.RawURLEncoding.EncodeToString([]byte(`{"jti":"` + jti + `"}`))
+	return "eyJhbGciOiJub25lIn0." + payload + ".sig"
+}
+
+func (suite *RefreshTokenGrantHandlerTestSuite) TestIssueRefreshToken_TracksTokenInStore() {
+	mockTokenStore := tokenstoremock.NewTokenStoreInterfaceMock(suite.T())
+	suite.handler.refreshTokenStore = mockTokenStore
+	issuedAt := time.Now().Unix()
+
+	suite.mockTokenBuilder.On("BuildRefreshToken", mock.Anything).Return(&model.TokenDTO{
+		Token:     buildTestRefreshToken("new-jti"),
+		IssuedAt:  issuedAt,
+		ExpiresIn: 86400,
+	}, nil)
+	expectedValue, _ := json.Marshal(refreshTokenEntry{ClientID: testRefreshTokenClientID, LastUsedAt: issuedAt})
+	mockTokenStore.On("Store", mock.Anything, "new-jti", expectedValue,
+		time.Unix(issuedAt+86400, 0)).Return(nil)
+
+	tokenResponse := &model.TokenResponseDTO{}
+	err := suite.handler.IssueRefreshToken(context.Background(), tokenResponse, suite.oauthApp,
+		testRefreshTokenUserID, []string{testRefreshTokenAudience},
+		"authorization_code", []string{"read"}, nil, "", "")
+
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), buildTestRefreshToken("new-jti"), tokenResponse.RefreshToken.Token)
+}
+
+func (suite *RefreshTokenGrantHandlerTestSuite) TestIssueRefreshToken_TokenStoreError() {
+	mockTokenStore := tokenstoremock.NewTokenStoreInterfaceMock(suite.T())
+	suite.handler.refreshTokenStore = mockTokenStore
+
+	suite.mockTokenBuilder.On("BuildRefreshToken", mock.Anything).Return(&model.TokenDTO{
+		Token:     buildTestRefreshToken("new-jti"),
+		IssuedAt:  time.Now().Unix(),
+		ExpiresIn: 86400,
+	}, nil)
+	mockTokenStore.On("Store", mock.Anything, "new-jti", mock.Anything, mock.Anything).
+		Return(errors.New("store failed"))
+
+	err := suite.handler.IssueRefreshToken(context.Background(), &model.TokenResponseDTO{}, suite.oauthApp,
+		testRefreshTokenUserID, []string{testRefreshTokenAudience},
+		"authorization_code", []string{"read"}, nil, "", "")
+
+	assert.NotNil(suite.T(), err)
+	assert.Equal(suite.T(), constants.ErrorServerError, err.Error)
+}
+
+func (suite *RefreshTokenGrantHandlerTestSuite) TestHandleGrant_TokenStore_RevokedRefreshToken() {
+	mockTokenStore := tokenstoremock.NewTokenStoreInterfaceMock(suite.T())
+	suite.handler.refreshTokenStore = mockTokenStore
+
+	suite.mockTokenValidator.On("ValidateRefreshToken", suite.validRefreshToken, testRefreshTokenClientID).
+		Return(&tokenservice.RefreshTokenClaims{
+			JTI:       "old-jti",
+			Sub:       testRefreshTokenUserID,
+			Audiences: []string{testRefreshTokenAudience},
+			Scopes:    []string{"read", "write"},
+			GrantType: "authorization_code",
+			Iat:       int64(suite.validClaims["iat"].(float64)),
+		}, nil)
+	mockTokenStore.On("Get", mock.Anything, "old-jti").Return(nil, false, nil)
+
+	response, err := suite.handler.HandleGrant(context.Background(), suite.testTokenReq, suite.oauthApp)
+
+	assert.Nil(suite.T(), response)
+	assert.NotNil(suite.T(), err)
+	assert.Equal(suite.T(), constants.ErrorInvalidGrant, err.Error)
+	suite.mockTokenBuilder.AssertNotCalled(suite.T(), "BuildAccessToken", mock.Anything)
+}
+
+func (suite *RefreshTokenGrantHandlerTestSuite) TestHandleGrant_TokenStore_LookupError() {
+	mockTokenStore := tokenstoremock.NewTokenStoreInterfaceMock(suite.T())
+	suite.handler.refreshTokenStore = mockTokenStore
+
+	suite.mockTokenValidator.On("ValidateRefreshToken", suite.validRefreshToken, testRefreshTokenClientID).
+		Return(&tokenservice.RefreshTokenClaims{
+			JTI:    "old-jti",
+			Sub:    testRefreshTokenUserID,
+			Scopes: []string{"read", "write"},
+		}, nil)
+	mockTokenStore.On("Get", mock.Anything, "old-jti").Return(nil, false, errors.New("store down"))
+
+	response, err := suite.handler.HandleGrant(context.Background(), suite.testTokenReq, suite.oauthApp)
+
+	assert.Nil(suite.T(), response)
+	assert.NotNil(suite.T(), err)
+	assert.Equal(suite.T(), constants.ErrorServerError, err.Error)
+}
+
+func (suite *RefreshTokenGrantHandlerTestSuite) TestHandleGrant_TokenStore_MissingJTI() {
+	suite.handler.refreshTokenStore = tokenstoremock.NewTokenStoreInterfaceMock(suite.T())
+
+	suite.mockTokenValidator.On("ValidateRefreshToken", suite.validRefreshToken, testRefreshTokenClientID).
+		Return(&tokenservice.RefreshTokenClaims{
+			Sub:    testRefreshTokenUserID,
+			Scopes: []string{"read", "write"},
+		}, nil)
+
+	response, err := suite.handler.HandleGrant(context.Background(), suite.testTokenReq, suite.oauthApp)
+
+	assert.Nil(suite.T(), response)
+	assert.NotNil(suite.T(), err)
+	assert.Equal(suite.T(), constants.ErrorInvalidGrant, err.Error)
+}
+
+func (suite *RefreshTokenGrantHandlerTestSuite) TestHandleGrant_ExpiredRefreshToken() {
+	suite.mockTokenValidator.On("ValidateRefreshToken", suite.validRefreshToken, testRefreshTokenClientID).
+		Return(nil, tokenservice.ErrRefreshTokenExpired)
+
+	response, err := suite.handler.HandleGrant(context.Background(), suite.testTokenReq, suite.oauthApp)
+
+	assert.Nil(suite.T(), response)
+	assert.Equal(suite.T(), constants.ErrorInvalidGrant, err.Error)
+	assert.Equal(suite.T(), "Refresh token has expired", err.ErrorDescription)
+}
+
+// oldRefreshTokenClaims returns the claims of a presented refresh token tracked under "old-jti".
+func oldRefreshTokenClaims() *tokenservice.RefreshTokenClaims {
+	return &tokenservice.RefreshTokenClaims{JTI: "old-jti", Sub: testRefreshTokenUserID}
+}
+
+// appWithIdleTimeout returns the test application with the given refresh token idle timeout.
+func (suite *RefreshTokenGrantHandlerTestSuite) appWithIdleTimeout(idleTimeout int64) *inboundmodel.OAuthClient {
+	app := *suite.oauthApp
+	app.Token = &inboundmodel.OAuthTokenConfig{
+		RefreshToken: &inboundmodel.RefreshTokenConfig{IdleTimeout: idleTimeout},
+	}
+	return &app
+}
+
+func (suite *RefreshTokenGrantHandlerTestSuite) TestCheckRefreshTokenActive_UpdatesLastUsedTime() {
+	mockTokenStore := tokenstoremock.NewTokenStoreInterfaceMock(suite.T())
+	suite.handler.refreshTokenStore = mockTokenStore
+	lastUsedAt := time.Now().Add(-time.Hour).Unix()
+	value, _ := json.Marshal(refreshTokenEntry{ClientID: testRefreshTokenClientID, LastUsedAt: lastUsedAt})
+
+	mockTokenStore.On("Get", mock.Anything, "old-jti").Return(value, true, nil)
+	mockTokenStore.On("Update", mock.Anything, "old-jti", mock.MatchedBy(func(updated []byte) bool {
+		var entry refreshTokenEntry
+		return json.Unmarshal(updated, &entry) == nil && entry.ClientID == testRefreshTokenClientID &&
+			entry.LastUsedAt > lastUsedAt
+	})).Return(true, nil)
+
+	_, errResp := suite.handler.checkRefreshTokenActive(context.Background(), oldRefreshTokenClaims(),
+		suite.appWithIdleTimeout(86400), false, log.GetLogger())
+
+	assert.Nil(suite.T(), errResp)
+}
+
+func (suite *RefreshTokenGrantHandlerTestSuite) TestCheckRefreshTokenActive_IdleExpired() {
+	mockTokenStore := tokenstoremock.NewTokenStoreInterfaceMock(suite.T())
+	suite.handler.refreshTokenStore = mockTokenStore
+	value, _ := json.Marshal(refreshTokenEntry{
+		ClientID: testRefreshTokenClientID, LastUsedAt: time.Now().Add(-31 * 24 * time.Hour).Unix(),
+	})
+
+	mockTokenStore.On("Get", mock.Anything, "old-jti").Return(value, true, nil)
+	mockTokenStore.On("Delete", mock.Anything, "old-jti").Return(nil)
+
+	_, errResp := suite.handler.checkRefreshTokenActive(context.Background(), oldRefreshTokenClaims(),
+		suite.appWithIdleTimeout(30*24*60*60), false, log.GetLogger())
+
+	assert.NotNil(suite.T(), errResp)
+	assert.Equal(suite.T(), constants.ErrorInvalidGrant, errResp.Error)
+	assert.Equal(suite.T(), "Refresh token has expired due to inactivity", errResp.ErrorDescription)
+	mockTokenStore.AssertNotCalled(suite.T(), "Update", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *RefreshTokenGrantHandlerTestSuite) TestCheckRefreshTokenActive_IdleExpiredOnRenewal() {
+	mockTokenStore := tokenstoremock.NewTokenStoreInterfaceMock(suite.T())
+	suite.handler.refreshTokenStore = mockTokenStore
+	value, _ := json.Marshal(refreshTokenEntry{
+		ClientID: testRefreshTokenClientID, LastUsedAt: time.Now().Add(-2 * time.Hour).Unix(),
+	})
+
+	mockTokenStore.On("Get", mock.Anything, "old-jti").Return(value, true, nil)
+	mockTokenStore.On("Delete", mock.Anything, "old-jti").Return(nil)
+
+	_, errResp := suite.handler.checkRefreshTokenActive(context.Background(), oldRefreshTokenClaims(),
+		suite.appWithIdleTimeout(3600), true, log.GetLogger())
+
+	assert.NotNil(suite.T(), errResp)
+	assert.Equal(suite.T(), "Refresh token has expired due to inactivity", errResp.ErrorDescription)
+	mockTokenStore.AssertNotCalled(suite.T(), "Consume", mock.Anything, mock.Anything)
+}
+
+func (suite *RefreshTokenGrantHandlerTestSuite) TestCheckRefreshTokenActive_LegacyEntrySkipsIdleCheck() {
+	mockTokenStore := tokenstoremock.NewTokenStoreInterfaceMock(suite.T())
+	suite.handler.refreshTokenStore = mockTokenStore
+
+	mockTokenStore.On("Get", mock.Anything, "old-jti").Return([]byte(testRefreshTokenClientID), true, nil)
+	mockTokenStore.On("Update", mock.Anything, "old-jti", mock.Anything).Return(true, nil)
+
+	_, errResp := suite.handler.checkRefreshTokenActive(context.Background(), oldRefreshTokenClaims(),
+		suite.appWithIdleTimeout(60), false, log.GetLogger())
+
+	assert.Nil(suite.T(), errResp)
+}
+
+func (suite *RefreshTokenGrantHandlerTestSuite) TestCheckRefreshTokenActive_UpdateNotFound() {
+	mockTokenStore := tokenstoremock.NewTokenStoreInterfaceMock(suite.T())
+	suite.handler.refreshTokenStore = mockTokenStore
+	value, _ := json.Marshal(refreshTokenEntry{ClientID: testRefreshTokenClientID, LastUsedAt: time.Now().Unix()})
+
+	mockTokenStore.On("Get", mock.Anything, "old-jti").Return(value, true, nil)
+	mockTokenStore.On("Update", mock.Anything, "old-jti", mock.Anything).Return(false, nil)
+
+	_, errResp := suite.handler.checkRefreshTokenActive(context.Background(), oldRefreshTokenClaims(),
+		suite.appWithIdleTimeout(0), false, log.GetLogger())
+
+	assert.NotNil(suite.T(), errResp)
+	assert.Equal(suite.T(), constants.ErrorInvalidGrant, errResp.Error)
+}
+
+func (suite *RefreshTokenGrantHandlerTestSuite) TestHandleGrant_TokenStore_RenewOnGrantConsumesOldToken() {
+	config.GetServerRuntime().Config.OAuth.RefreshToken.RenewOnGrant = true
+	mockTokenStore := tokenstoremock.NewTokenStoreInterfaceMock(suite.T())
+	suite.handler.refreshTokenStore = mockTokenStore
+
+	suite.mockTokenValidator.On("ValidateRefreshToken", suite.validRefreshToken, testRefreshTokenClientID).
+		Return(&tokenservice.RefreshTokenClaims{
+			JTI:       "old-jti",
+			Sub:       testRefreshTokenUserID,
+			Audiences: []string{testRefreshTokenAudience},
+			Scopes:    []string{"read", "write"},
+			GrantType: "authorization_code",
+			Iat:       int64(suite.validClaims["iat"].(float64)),
+		}, nil)
+	mockTokenStore.On("Get", mock.Anything, "old-jti").Return([]byte(testRefreshTokenClientID), true, nil)
+	mockTokenStore.On("Consume", mock.Anything, "old-jti").Return([]byte(testRefreshTokenClientID), true, nil)
+	suite.mockTokenBuilder.On("BuildAccessToken", mock.Anything).Return(&model.TokenDTO{
+		Token:     "new.access.token",
+		IssuedAt:  time.Now().Unix(),
+		ExpiresIn: 3600,
+		Scopes:    []string{"read"},
+	}, nil)
+	// The renewed refresh token keeps the scope of the original grant, not the downscoped request.
+	suite.mockTokenBuilder.On("BuildRefreshToken", mock.MatchedBy(
+		func(ctx *tokenservice.RefreshTokenBuildContext) bool {
+			return slices.Equal(ctx.Scopes, []string{"read", "write"})
+		})).Return(&model.TokenDTO{
+		Token:     buildTestRefreshToken("new-jti"),
+		IssuedAt:  time.Now().Unix(),
+		ExpiresIn: 86400,
+		Scopes:    []string{"read"},
+	}, nil)
+	mockTokenStore.On("Store", mock.Anything, "new-jti", mock.MatchedBy(func(value []byte) bool {
+		var entry refreshTokenEntry
+		return json.Unmarshal(value, &entry) == nil && entry.FamilyID == "old-jti" && !entry.Rotated
+	}), mock.Anything).Return(nil)
+	mockTokenStore.On("Store", mock.Anything, "old-jti", mock.MatchedBy(func(value []byte) bool {
+		var entry refreshTokenEntry
+		return json.Unmarshal(value, &entry) == nil && entry.FamilyID == "old-jti" && entry.Rotated
+	}), time.Unix(int64(suite.validClaims["iat"].(float64))+86400, 0)).Return(nil)
+
+	response, err := suite.handler.HandleGrant(context.Background(), suite.testTokenReq, suite.oauthApp)
+
+	assert.Nil(suite.T(), err)
+	assert.NotNil(suite.T(), response)
+	assert.Equal(suite.T(), buildTestRefreshToken("new-jti"), response.RefreshToken.Token)
+	assert.Equal(suite.T(), []string{"read"}, response.AccessToken.Scopes)
+}
+
+func (suite *RefreshTokenGrantHandlerTestSuite) TestHandleGrant_RenewOnGrant_InvalidTargetKeepsOldToken() {
+	config.GetServerRuntime().Config.OAuth.RefreshToken.RenewOnGrant = true
+	mockTokenStore := tokenstoremock.NewTokenStoreInterfaceMock(suite.T())
+	suite.handler.refreshTokenStore = mockTokenStore
+
+	suite.mockTokenValidator.On("ValidateRefreshToken", suite.validRefreshToken, testRefreshTokenClientID).
+		Return(&tokenservice.RefreshTokenClaims{
+			JTI:       "old-jti",
+			Sub:       testRefreshTokenUserID,
+			Audiences: []string{testRS01URI},
+			Scopes:    []string{"read"},
+			GrantType: "authorization_code",
+			Iat:       int64(suite.validClaims["iat"].(float64)),
+		}, nil)
+	mockTokenStore.On("Get", mock.Anything, "old-jti").Return([]byte(testRefreshTokenClientID), true, nil)
+
+	tokenReq := *suite.testTokenReq
+	tokenReq.Resources = []string{"https://rs99.example.com"}
+	response, errResp := suite.handler.HandleGrant(context.Background(), &tokenReq, suite.oauthApp)
+
+	assert.Nil(suite.T(), response)
+	assert.Equal(suite.T(), constants.ErrorInvalidTarget, errResp.Error)
+	mockTokenStore.AssertNotCalled(suite.T(), "Consume", mock.Anything, mock.Anything)
+
+	// The rejected request left the original refresh token in place, so it can still be redeemed.
+	mockTokenStore.On("Consume", mock.Anything, "old-jti").Return([]byte(testRefreshTokenClientID), true, nil)
+	suite.mockTokenBuilder.On("BuildAccessToken", mock.Anything).Return(&model.TokenDTO{
+		Token:     "new.access.token",
+		IssuedAt:  time.Now().Unix(),
+		ExpiresIn: 3600,
+	}, nil)
+	suite.mockTokenBuilder.On("BuildRefreshToken", mock.Anything).Return(&model.TokenDTO{
+		Token:     buildTestRefreshToken("new-jti"),
+		IssuedAt:  time.Now().Unix(),
+		ExpiresIn: 86400,
+	}, nil)
+	mockTokenStore.On("Store", mock.Anything, "new-jti", mock.Anything, mock.Anything).Return(nil)
+	mockTokenStore.On("Store", mock.Anything, "old-jti", mock.Anything, mock.Anything).Return(nil)
+
+	response, errResp = suite.handler.HandleGrant(context.Background(), suite.testTokenReq, suite.oauthApp)
+
+	assert.Nil(suite.T(), errResp)
+	assert.Equal(suite.T(), buildTestRefreshToken("new-jti"), response.RefreshToken.Token)
+}
+
+func (suite *RefreshTokenGrantHandlerTestSuite) TestHandleGrant_RenewOnGrant_AttributeCacheErrorKeepsOldToken() {
+	config.GetServerRuntime().Config.OAuth.RefreshToken.RenewOnGrant = true
+	mockTokenStore := tokenstoremock.NewTokenStoreInterfaceMock(suite.T())
+	suite.handler.refreshTokenStore = mockTokenStore
+
+	claims := oldRefreshTokenClaims()
+	claims.Scopes = []string{"read"}
+	claims.AttributeCacheID = "cache-id"
+	suite.mockTokenValidator.On("ValidateRefreshToken", suite.validRefreshToken, testRefreshTokenClientID).
+		Return(claims, nil)
+	mockTokenStore.On("Get", mock.Anything, "old-jti").Return([]byte(testRefreshTokenClientID), true, nil)
+	suite.mockAttrCacheService.On("GetAttributeCache", mock.Anything, "cache-id").
+		Return(nil, &serviceerror.InternalServerError)
+
+	response, errResp := suite.handler.HandleGrant(context.Background(), suite.testTokenReq, suite.oauthApp)
+
+	assert.Nil(suite.T(), response)
+	assert.Equal(suite.T(), constants.ErrorServerError, errResp.Error)
+	mockTokenStore.AssertNotCalled(suite.T(), "Consume", mock.Anything, mock.Anything)
+	mockTokenStore.AssertNotCalled(suite.T(), "Delete", mock.Anything, mock.Anything)
+}
+
+func (suite *RefreshTokenGrantHandlerTestSuite) TestHandleGrant_RenewOnGrant_TrackFailureRestoresOldToken() {
+	config.GetServerRuntime().Config.OAuth.RefreshToken.RenewOnGrant = true
+	mockTokenStore := tokenstoremock.NewTokenStoreInterfaceMock(suite.T())
+	suite.handler.refreshTokenStore = mockTokenStore
+
+	claims := oldRefreshTokenClaims()
+	claims.Scopes = []string{"read"}
+	claims.Iat = int64(suite.validClaims["iat"].(float64))
+	suite.mockTokenValidator.On("ValidateRefreshToken", suite.validRefreshToken, testRefreshTokenClientID).
+		Return(claims, nil)
+	value, _ := json.Marshal(refreshTokenEntry{ClientID: testRefreshTokenClientID, LastUsedAt: time.Now().Unix()})
+	mockTokenStore.On("Get", mock.Anything, "old-jti").Return(value, true, nil)
+	mockTokenStore.On("Consume", mock.Anything, "old-jti").Return(value, true, nil)
+	suite.mockTokenBuilder.On("BuildAccessToken", mock.Anything).Return(&model.TokenDTO{
+		Token:     "new.access.token",
+		IssuedAt:  time.Now().Unix(),
+		ExpiresIn: 3600,
+	}, nil)
+	suite.mockTokenBuilder.On("BuildRefreshToken", mock.Anything).Return(&model.TokenDTO{
+		Token:     buildTestRefreshToken("new-jti"),
+		IssuedAt:  time.Now().Unix(),
+		ExpiresIn: 86400,
+	}, nil)
+	mockTokenStore.On("Store", mock.Anything, "new-jti", mock.Anything, mock.Anything).
+		Return(errors.New("store down"))
+	mockTokenStore.On("Store", mock.Anything, "old-jti", value, time.Unix(claims.Iat+86400, 0)).
+		Return(nil).Once()
+
+	response, errResp := suite.handler.HandleGrant(context.Background(), suite.testTokenReq, suite.oauthApp)
+
+	assert.Nil(suite.T(), response)
+	assert.Equal(suite.T(), constants.ErrorServerError, errResp.Error)
+}
+
+func (suite *RefreshTokenGrantHandlerTestSuite) TestHandleGrant_RenewOnGrant_ConcurrentRedemption() {
+	config.GetServerRuntime().Config.OAuth.RefreshToken.RenewOnGrant = true
+	mockTokenStore := tokenstoremock.NewTokenStoreInterfaceMock(suite.T())
+	suite.handler.refreshTokenStore = mockTokenStore
+
+	claims := oldRefreshTokenClaims()
+	claims.Scopes = []string{"read"}
+	suite.mockTokenValidator.On("ValidateRefreshToken", suite.validRefreshToken, testRefreshTokenClientID).
+		Return(claims, nil)
+	mockTokenStore.On("Get", mock.Anything, "old-jti").Return([]byte(testRefreshTokenClientID), true, nil)
+	mockTokenStore.On("Consume", mock.Anything, "old-jti").Return(nil, false, nil)
+	suite.mockTokenBuilder.On("BuildAccessToken", mock.Anything).Return(&model.TokenDTO{
+		Token:     "new.access.token",
+		IssuedAt:  time.Now().Unix(),
+		ExpiresIn: 3600,
+	}, nil)
+	suite.mockTokenBuilder.On("BuildRefreshToken", mock.Anything).Return(&model.TokenDTO{
+		Token:     buildTestRefreshToken("new-jti"),
+		IssuedAt:  time.Now().Unix(),
+		ExpiresIn: 86400,
+	}, nil)
+
+	response, errResp := suite.handler.HandleGrant(context.Background(), suite.testTokenReq, suite.oauthApp)
+
+	assert.Nil(suite.T(), response)
+	assert.Equal(suite.T(), constants.ErrorInvalidGrant, errResp.Error)
+	mockTokenStore.AssertNotCalled(suite.T(), "Store", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *RefreshTokenGrantHandlerTestSuite) TestHandleGrant_AppRenewOnGrantOverridesServerConfig() {
+	mockTokenStore := tokenstoremock.NewTokenStoreInterfaceMock(suite.T())
+	suite.handler.refreshTokenStore = mockTokenStore
+	renew := true
+	app := *suite.oauthApp
+	app.Token = &inboundmodel.OAuthTokenConfig{
+		RefreshToken: &inboundmodel.RefreshTokenConfig{RenewOnGrant: &renew},
+	}
+
+	suite.mockTokenValidator.On("ValidateRefreshToken", suite.validRefreshToken, testRefreshTokenClientID).
+		Return(&tokenservice.RefreshTokenClaims{
+			JTI:       "old-jti",
+			Sub:       testRefreshTokenUserID,
+			Scopes:    []string{"read", "write"},
+			GrantType: "authorization_code",
+			Iat:       int64(suite.validClaims["iat"].(float64)),
+		}, nil)
+	value, _ := json.Marshal(refreshTokenEntry{
+		ClientID: testRefreshTokenClientID, LastUsedAt: time.Now().Unix(), FamilyID: "family-jti",
+	})
+	mockTokenStore.On("Get", mock.Anything, "old-jti").Return(value, true, nil)
+	mockTokenStore.On("Get", mock.Anything, revokedFamilyKeyPrefix+"family-jti").Return(nil, false, nil)
+	mockTokenStore.On("Consume", mock.Anything, "old-jti").Return(value, true, nil)
+	suite.mockTokenBuilder.On("BuildAccessToken", mock.Anything).Return(&model.TokenDTO{
+		Token:     "new.access.token",
+		IssuedAt:  time.Now().Unix(),
+		ExpiresIn: 3600,
+	}, nil)
+	suite.mockTokenBuilder.On("BuildRefreshToken", mock.Anything).Return(&model.TokenDTO{
+		Token:     buildTestRefreshToken("new-jti"),
+		IssuedAt:  time.Now().Unix(),
+		ExpiresIn: 86400,
+	}, nil)
+	mockTokenStore.On("Store", mock.Anything, "new-jti", mock.MatchedBy(func(value []byte) bool {
+		var entry refreshTokenEntry
+		return json.Unmarshal(value, &entry) == nil && entry.FamilyID == "family-jti"
+	}), mock.Anything).Return(nil)
+	mockTokenStore.On("Store", mock.Anything, "old-jti", mock.Anything, mock.Anything).Return(nil)
+
+	response, err := suite.handler.HandleGrant(context.Background(), suite.testTokenReq, &app)
+
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), buildTestRefreshToken("new-jti"), response.RefreshToken.Token)
+}
+
+func (suite *RefreshTokenGrantHandlerTestSuite) TestHandleGrant_RotatedTokenReuseRevokesFamily() {
+	config.GetServerRuntime().Config.OAuth.RefreshToken.RenewOnGrant = true
+	mockTokenStore := tokenstoremock.NewTokenStoreInterfaceMock(suite.T())
+	mockObservability := observabilitymock.NewObservabilityServiceInterfaceMock(suite.T())
+	suite.handler.refreshTokenStore = mockTokenStore
+	suite.handler.observabilitySvc = mockObservability
+
+	claims := oldRefreshTokenClaims()
+	claims.Scopes = []string{"read", "write"}
+	suite.mockTokenValidator.On("ValidateRefreshToken", suite.validRefreshToken, testRefreshTokenClientID).
+		Return(claims, nil)
+	value, _ := json.Marshal(refreshTokenEntry{
+		ClientID: testRefreshTokenClientID, FamilyID: "family-jti", Rotated: true,
+	})
+	mockTokenStore.On("Get", mock.Anything, "old-jti").Return(value, true, nil)
+	mockTokenStore.On("Store", mock.Anything, revokedFamilyKeyPrefix+"family-jti", mock.Anything,
+		mock.Anything).Return(nil)
+	mockObservability.On("IsEnabled").Return(true)
+	mockObservability.On("PublishEvent", mock.MatchedBy(func(evt *event.Event) bool {
+		return evt.Type == string(event.EventTypeRefreshTokenReuseDetected) &&
+			evt.Data[event.DataKey.TokenFamilyID] == "family-jti" &&
+			evt.Data[event.DataKey.UserID] == testRefreshTokenUserID
+	})).Return()
+
+	response, err := suite.handler.HandleGrant(context.Background(), suite.testTokenReq, suite.oauthApp)
+
+	assert.Nil(suite.T(), response)
+	assert.Equal(suite.T(), constants.ErrorInvalidGrant, err.Error)
+	assert.Equal(suite.T(), "Refresh token has already been used", err.ErrorDescription)
+	suite.mockTokenBuilder.AssertNotCalled(suite.T(), "BuildAccessToken", mock.Anything)
+}
+
+func (suite *RefreshTokenGrantHandlerTestSuite) TestCheckRefreshTokenActive_RevokedFamily() {
+	mockTokenStore := tokenstoremock.NewTokenStoreInterfaceMock(suite.T())
+	suite.handler.refreshTokenStore = mockTokenStore
+	value, _ := json.Marshal(refreshTokenEntry{
+		ClientID: testRefreshTokenClientID, LastUsedAt: time.Now().Unix(), FamilyID: "family-jti",
+	})
+
+	mockTokenStore.On("Get", mock.Anything, "old-jti").Return(value, true, nil)
+	mockTokenStore.On("Get", mock.Anything, revokedFamilyKeyPrefix+"family-jti").Return([]byte("{}"), true, nil)
+
+	familyID, errResp := suite.handler.checkRefreshTokenActive(context.Background(), oldRefreshTokenClaims(),
+		suite.oauthApp, true, log.GetLogger())
+
+	assert.Empty(suite.T(), familyID)
+	assert.NotNil(suite.T(), errResp)
+	assert.Equal(suite.T(), constants.ErrorInvalidGrant, errResp.Error)
+	assert.Equal(suite.T(), "Invalid refresh token", errResp.ErrorDescription)
+}
+
+func (suite *RefreshTokenGrantHandlerTestSuite) TestCheckRefreshTokenActive_RotatedTokenReuse_RevokeFails() {
+	mockTokenStore := tokenstoremock.NewTokenStoreInterfaceMock(suite.T())
+	suite.handler.refreshTokenStore = mockTokenStore
+	value, _ := json.Marshal(refreshTokenEntry{ClientID: testRefreshTokenClientID, Rotated: true})
+
+	mockTokenStore.On("Get", mock.Anything, "old-jti").Return(value, true, nil)
+	mockTokenStore.On("Store", mock.Anything, revokedFamilyKeyPrefix+"old-jti", mock.Anything, mock.Anything).
+		Return(errors.New("store down"))
+
+	_, errResp := suite.handler.checkRefreshTokenActive(context.Background(), oldRefreshTokenClaims(),
+		suite.oauthApp, false, log.GetLogger())
+
+	assert.NotNil(suite.T(), errResp)
+	assert.Equal(suite.T(), constants.ErrorServerError, errResp.Error)
+}
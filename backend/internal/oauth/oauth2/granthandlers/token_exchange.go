@@ -163,6 +163,10 @@ func (h *tokenExchangeGrantHandler) HandleGrant(ctx context.Context, tokenReques
 	if errResp != nil {
 		return nil, errResp
 	}
+	finalScopes, errResp = applyAllowedScopes(oauthApp, finalScopes)
+	if errResp != nil {
+		return nil, errResp
+	}
 
 	// Determine final audiences per RFC 8693 §2.1: audience and resource parameters may be
 	// combined. audience values are opaque logical names passed verbatim; resource values are
@@ -202,10 +206,7 @@ func (h *tokenExchangeGrantHandler) HandleGrant(ctx context.Context, tokenReques
 	})
 	if err != nil {
 		logger.Error("Failed to generate token", log.Error(err))
-		return nil, &model.ErrorResponse{
-			Error:            constants.ErrorServerError,
-			ErrorDescription: "Failed to generate token",
-		}
+		return nil, accessTokenBuildError(err, "Failed to generate token")
 	}
 
 	return &model.TokenResponseDTO{
@@ -26,6 +26,7 @@ import (
 	"github.com/thunder-id/thunderid/internal/inboundclient"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/clientauth"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/discovery"
+	"github.com/thunder-id/thunderid/internal/resource"
 	"github.com/thunder-id/thunderid/internal/system/jose/jwt"
 	"github.com/thunder-id/thunderid/internal/system/middleware"
 )
@@ -37,8 +38,9 @@ func Initialize(
 	inboundClient inboundclient.InboundClientServiceInterface,
 	authnProvider authnprovidermgr.AuthnProviderManagerInterface,
 	discoveryService discovery.DiscoveryServiceInterface,
+	resourceService resource.ResourceServiceInterface,
 ) TokenIntrospectionServiceInterface {
-	introspectionService := newTokenIntrospectionService(jwtService)
+	introspectionService := newTokenIntrospectionService(jwtService, resourceService)
 	introspectHandler := newTokenIntrospectionHandler(introspectionService)
 	registerRoutes(mux, introspectHandler, inboundClient, authnProvider, jwtService, discoveryService)
 	return introspectionService
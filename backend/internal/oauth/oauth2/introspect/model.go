@@ -38,4 +38,6 @@ type IntrospectResponse struct {
 	Aud       any    `json:"aud,omitempty"`
 	Iss       string `json:"iss,omitempty"`
 	Jti       string `json:"jti,omitempty"`
+	// Cnf carries the JWK thumbprint of the key a DPoP-bound token is bound to.
+	Cnf map[string]string `json:"cnf,omitempty"`
 }
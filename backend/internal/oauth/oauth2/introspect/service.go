@@ -22,8 +22,11 @@ package introspect
 import (
 	"context"
 	"errors"
+	"strings"
 
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/dpop"
+	"github.com/thunder-id/thunderid/internal/resource"
 	"github.com/thunder-id/thunderid/internal/system/jose/jwt"
 	"github.com/thunder-id/thunderid/internal/system/log"
 )
@@ -35,13 +38,17 @@ type TokenIntrospectionServiceInterface interface {
 
 // tokenIntrospectionService implements the TokenIntrospectionServiceInterface.
 type tokenIntrospectionService struct {
-	jwtService jwt.JWTServiceInterface
+	jwtService      jwt.JWTServiceInterface
+	resourceService resource.ResourceServiceInterface
 }
 
 // newTokenIntrospectionService creates a new tokenIntrospectionService instance (internal use).
-func newTokenIntrospectionService(jwtService jwt.JWTServiceInterface) TokenIntrospectionServiceInterface {
+func newTokenIntrospectionService(
+	jwtService jwt.JWTServiceInterface, resourceService resource.ResourceServiceInterface,
+) TokenIntrospectionServiceInterface {
 	return &tokenIntrospectionService{
-		jwtService: jwtService,
+		jwtService:      jwtService,
+		resourceService: resourceService,
 	}
 }
 
@@ -73,7 +80,55 @@ func (s *tokenIntrospectionService) IntrospectToken(
 	// TODO: Add validations for token revocation and validity to be used by the resource server
 	//  who makes the introspection call when the support is implemented.
 
-	return s.prepareValidResponse(payload), nil
+	response := s.prepareValidResponse(payload)
+	response.Scope = s.expandScope(ctx, logger, response.Scope, response.Aud)
+
+	return response, nil
+}
+
+// expandScope adds the scopes implied by the token scopes on the resource servers of the token
+// audiences, so that a resource server relying on introspection sees the implied scopes as granted.
+// Audiences that are not resource servers are skipped, and the scope is left unchanged when the
+// implied scopes cannot be resolved.
+func (s *tokenIntrospectionService) expandScope(
+	ctx context.Context, logger *log.Logger, scope string, aud interface{},
+) string {
+	if s.resourceService == nil || scope == "" {
+		return scope
+	}
+
+	var audiences []string
+	switch v := aud.(type) {
+	case string:
+		audiences = []string{v}
+	case []string:
+		audiences = v
+	}
+
+	scopes := strings.Fields(scope)
+	expanded := scopes
+	seen := make(map[string]struct{}, len(scopes))
+	for _, sc := range scopes {
+		seen[sc] = struct{}{}
+	}
+	for _, audience := range audiences {
+		rs, svcErr := s.resourceService.GetResourceServerByIdentifier(ctx, audience)
+		if svcErr != nil || !resource.RequiresScopeExpansion(rs, scopes) {
+			continue
+		}
+		rsScopes, svcErr := s.resourceService.ExpandScopes(ctx, rs, scopes)
+		if svcErr != nil {
+			logger.Error("Failed to expand token scopes", log.String("resourceServerId", rs.ID))
+			return scope
+		}
+		for _, sc := range rsScopes {
+			if _, ok := seen[sc]; !ok {
+				seen[sc] = struct{}{}
+				expanded = append(expanded, sc)
+			}
+		}
+	}
+	return strings.Join(expanded, " ")
 }
 
 // validateToken verifies the signature and validity of the token.
@@ -136,6 +191,11 @@ func (s *tokenIntrospectionService) prepareValidResponse(payload map[string]inte
 	if jti, ok := payload["jti"].(string); ok {
 		response.Jti = jti
 	}
+	// DPoP-bound tokens expose their binding so that resource servers can verify the proof (RFC 9449 section 6.2).
+	if jkt := dpop.GetConfirmationThumbprint(payload); jkt != "" {
+		response.TokenType = constants.TokenTypeDPoP
+		response.Cnf = map[string]string{"jkt": jkt}
+	}
 
 	return response
 }
@@ -30,9 +30,11 @@ import (
 	"time"
 
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
+	"github.com/thunder-id/thunderid/internal/resource"
 	"github.com/thunder-id/thunderid/internal/system/cryptolab"
 	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
 	"github.com/thunder-id/thunderid/tests/mocks/jose/jwtmock"
+	"github.com/thunder-id/thunderid/tests/mocks/resourcemock"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -64,7 +66,7 @@ func (s *TokenIntrospectionServiceTestSuite) SetupTest() {
 		s.T().Fatal("Error generating RSA key:", err)
 	}
 
-	s.introspectService = newTokenIntrospectionService(s.jwtServiceMock)
+	s.introspectService = newTokenIntrospectionService(s.jwtServiceMock, nil)
 
 	s.validToken = s.createValidToken()
 	s.expiredToken = s.createExpiredToken()
@@ -376,6 +378,76 @@ func (s *TokenIntrospectionServiceTestSuite) TestIntrospectToken() {
 	}
 }
 
+func (s *TokenIntrospectionServiceTestSuite) TestIntrospectToken_ExpandsImpliedScopes() {
+	resourceServiceMock := resourcemock.NewResourceServiceInterfaceMock(s.T())
+	introspectService := newTokenIntrospectionService(s.jwtServiceMock, resourceServiceMock)
+	token := s.createToken(map[string]interface{}{
+		"exp":   float64(time.Now().Add(time.Hour).Unix()),
+		"scope": "users:* admin",
+		"aud":   []interface{}{"client123", "https://api.example.com"},
+	})
+	rs := &resource.ResourceServer{
+		ID:                "rs-1",
+		Identifier:        "https://api.example.com",
+		Delimiter:         ":",
+		ScopeImplications: map[string][]string{"admin": {"users:*"}},
+	}
+
+	s.jwtServiceMock.On("VerifyJWT", token, "", "").Return(nil)
+	resourceServiceMock.On("GetResourceServerByIdentifier", mock.Anything, "client123").
+		Return(nil, &serviceerror.ServiceError{Type: serviceerror.ClientErrorType})
+	resourceServiceMock.On("GetResourceServerByIdentifier", mock.Anything, "https://api.example.com").
+		Return(rs, (*serviceerror.ServiceError)(nil))
+	resourceServiceMock.On("ExpandScopes", mock.Anything, rs, []string{"users:*", "admin"}).
+		Return([]string{"users:*", "admin", "users:read", "users:write"}, (*serviceerror.ServiceError)(nil))
+
+	response, err := introspectService.IntrospectToken(context.Background(), token, "")
+
+	assert.NoError(s.T(), err)
+	assert.True(s.T(), response.Active)
+	assert.Equal(s.T(), "users:* admin users:read users:write", response.Scope)
+}
+
+func (s *TokenIntrospectionServiceTestSuite) TestIntrospectToken_ExpandScopesFails_KeepsScope() {
+	resourceServiceMock := resourcemock.NewResourceServiceInterfaceMock(s.T())
+	introspectService := newTokenIntrospectionService(s.jwtServiceMock, resourceServiceMock)
+	token := s.createToken(map[string]interface{}{
+		"exp":   float64(time.Now().Add(time.Hour).Unix()),
+		"scope": "users:*",
+		"aud":   "https://api.example.com",
+	})
+	rs := &resource.ResourceServer{ID: "rs-1", Identifier: "https://api.example.com", Delimiter: ":"}
+
+	s.jwtServiceMock.On("VerifyJWT", token, "", "").Return(nil)
+	resourceServiceMock.On("GetResourceServerByIdentifier", mock.Anything, "https://api.example.com").
+		Return(rs, (*serviceerror.ServiceError)(nil))
+	resourceServiceMock.On("ExpandScopes", mock.Anything, rs, []string{"users:*"}).
+		Return(nil, &serviceerror.InternalServerError)
+
+	response, err := introspectService.IntrospectToken(context.Background(), token, "")
+
+	assert.NoError(s.T(), err)
+	assert.True(s.T(), response.Active)
+	assert.Equal(s.T(), "users:*", response.Scope)
+}
+
+func (s *TokenIntrospectionServiceTestSuite) TestIntrospectToken_DPoPBoundToken() {
+	introspectService := newTokenIntrospectionService(s.jwtServiceMock, nil)
+	token := s.createToken(map[string]interface{}{
+		"exp": float64(time.Now().Add(time.Hour).Unix()),
+		"cnf": map[string]interface{}{"jkt": "jkt-1"},
+	})
+
+	s.jwtServiceMock.On("VerifyJWT", token, "", "").Return(nil)
+
+	response, err := introspectService.IntrospectToken(context.Background(), token, "")
+
+	assert.NoError(s.T(), err)
+	assert.True(s.T(), response.Active)
+	assert.Equal(s.T(), "DPoP", response.TokenType)
+	assert.Equal(s.T(), map[string]string{"jkt": "jkt-1"}, response.Cnf)
+}
+
 // Helper methods to create tokens with specific claims
 func (s *TokenIntrospectionServiceTestSuite) createToken(claims map[string]interface{}) string {
 	header := map[string]interface{}{
@@ -0,0 +1,97 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package logout
+
+import (
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/i18n/core"
+)
+
+// Logout service error constants.
+var (
+	// errorInvalidIDTokenHint is returned when the id_token_hint is malformed or was not issued by this server.
+	errorInvalidIDTokenHint = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "invalid_request",
+		Error: core.I18nMessage{
+			Key:          "error.logoutservice.invalid_id_token_hint",
+			DefaultValue: "Invalid id_token_hint",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.logoutservice.invalid_id_token_hint_description",
+			DefaultValue: "The id_token_hint is malformed or was not issued by this server",
+		},
+	}
+
+	// errorMissingClient is returned when the request does not identify the client being logged out of.
+	errorMissingClient = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "invalid_request",
+		Error: core.I18nMessage{
+			Key:          "error.logoutservice.missing_client",
+			DefaultValue: "Missing client",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.logoutservice.missing_client_description",
+			DefaultValue: "Either id_token_hint or client_id is required",
+		},
+	}
+
+	// errorClientIDMismatch is returned when client_id is not an audience of the id_token_hint.
+	errorClientIDMismatch = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "invalid_request",
+		Error: core.I18nMessage{
+			Key:          "error.logoutservice.client_id_mismatch",
+			DefaultValue: "Client mismatch",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.logoutservice.client_id_mismatch_description",
+			DefaultValue: "The client_id is not an audience of the id_token_hint",
+		},
+	}
+
+	// errorUnknownClient is returned when client_id does not identify a registered client.
+	errorUnknownClient = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "invalid_request",
+		Error: core.I18nMessage{
+			Key:          "error.logoutservice.unknown_client",
+			DefaultValue: "Unknown client",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.logoutservice.unknown_client_description",
+			DefaultValue: "The client_id does not identify a registered client",
+		},
+	}
+
+	// errorInvalidPostLogoutRedirectURI is returned when post_logout_redirect_uri cannot be accepted for the client.
+	errorInvalidPostLogoutRedirectURI = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "invalid_request",
+		Error: core.I18nMessage{
+			Key:          "error.logoutservice.invalid_post_logout_redirect_uri",
+			DefaultValue: "Invalid post_logout_redirect_uri",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.logoutservice.invalid_post_logout_redirect_uri_description",
+			DefaultValue: "The post_logout_redirect_uri is not a registered redirect URI of the client",
+		},
+	}
+)
@@ -0,0 +1,176 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package logout
+
+import (
+	"fmt"
+	"html/template"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
+	oauth2utils "github.com/thunder-id/thunderid/internal/oauth/oauth2/utils"
+	"github.com/thunder-id/thunderid/internal/system/config"
+	serverconst "github.com/thunder-id/thunderid/internal/system/constants"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	sysutils "github.com/thunder-id/thunderid/internal/system/utils"
+)
+
+// frontChannelRedirectDelaySeconds is how long the end-session page waits for the front-channel logout
+// iframes to load before following the post-logout redirect.
+const frontChannelRedirectDelaySeconds = 2
+
+// endSessionPageCSP restricts the end-session page to framing the front-channel logout URIs.
+const endSessionPageCSP = "default-src 'none'; frame-src https: http:; style-src 'unsafe-inline'; " +
+	serverconst.ContentSecurityPolicyFrameAncestorsNone
+
+// endSessionPage renders the front-channel logout URIs in hidden iframes and then follows the
+// post-logout redirect, if any.
+var endSessionPage = template.Must(template.New("endSession").Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>Signed out</title>
+{{- if .RedirectURI}}
+<meta http-equiv="refresh" content="{{.Delay}};url={{.RedirectURI}}">
+{{- end}}
+</head>
+<body>
+<p>You have been signed out.</p>
+{{- range .FrontChannelLogoutURIs}}
+<iframe src="{{.}}" style="display:none" title="logout"></iframe>
+{{- end}}
+</body>
+</html>
+`))
+
+// logoutHandler handles OpenID Connect end-session requests.
+type logoutHandler struct {
+	service LogoutServiceInterface
+	logger  *log.Logger
+}
+
+// newLogoutHandler creates a new logout handler.
+func newLogoutHandler(logoutService LogoutServiceInterface) *logoutHandler {
+	return &logoutHandler{
+		service: logoutService,
+		logger:  log.GetLogger().With(log.String(log.LoggerKeyComponentName, "LogoutHandler")),
+	}
+}
+
+// HandleLogout handles end-session requests. Browsers receive an HTML page that performs the front-channel
+// logout, while callers that accept JSON receive the per-client logout outcomes.
+func (h *logoutHandler) HandleLogout(w http.ResponseWriter, r *http.Request) {
+	wantsJSON := strings.Contains(r.Header.Get(serverconst.AcceptHeaderName), serverconst.ContentTypeJSON)
+	if err := r.ParseForm(); err != nil {
+		h.writeError(w, r, wantsJSON, constants.ErrorInvalidRequest, "Failed to parse request parameters",
+			http.StatusBadRequest)
+		return
+	}
+
+	request := &LogoutRequest{
+		IDTokenHint:           r.FormValue(constants.RequestParamIDTokenHint),
+		ClientID:              r.FormValue(constants.RequestParamClientID),
+		PostLogoutRedirectURI: r.FormValue(constants.RequestParamPostLogoutRedirectURI),
+		State:                 r.FormValue(constants.RequestParamState),
+	}
+	result, svcErr := h.service.Logout(r.Context(), request)
+	if svcErr != nil {
+		if svcErr.Type == serviceerror.ServerErrorType {
+			h.writeError(w, r, wantsJSON, constants.ErrorServerError,
+				"An unexpected error occurred while processing the request", http.StatusInternalServerError)
+			return
+		}
+		h.writeError(w, r, wantsJSON, svcErr.Code, svcErr.ErrorDescription.DefaultValue, http.StatusBadRequest)
+		return
+	}
+
+	for _, outcome := range result.Outcomes {
+		if outcome.Status == LogoutStatusFailed {
+			h.logger.Warn("Failed to log the user out of a client", log.String("clientID", outcome.ClientID),
+				log.String("channel", string(outcome.Channel)), log.String("error", outcome.Error))
+		}
+	}
+
+	w.Header().Set(serverconst.CacheControlHeaderName, serverconst.CacheControlNoStore)
+	w.Header().Set(serverconst.PragmaHeaderName, serverconst.PragmaNoCache)
+	if wantsJSON {
+		sysutils.WriteSuccessResponse(w, http.StatusOK, result)
+		return
+	}
+	if len(result.FrontChannelLogoutURIs) == 0 && result.PostLogoutRedirectURI != "" {
+		http.Redirect(w, r, result.PostLogoutRedirectURI, http.StatusFound)
+		return
+	}
+	h.writeEndSessionPage(w, result)
+}
+
+// writeEndSessionPage writes the HTML end-session page for the given logout result.
+func (h *logoutHandler) writeEndSessionPage(w http.ResponseWriter, result *LogoutResult) {
+	frontChannelURIs := make([]template.URL, 0, len(result.FrontChannelLogoutURIs))
+	for _, uri := range result.FrontChannelLogoutURIs {
+		// The URIs are validated as absolute URLs when the client is registered.
+		frontChannelURIs = append(frontChannelURIs, template.URL(uri)) // #nosec G203
+	}
+
+	w.Header().Set(serverconst.ContentTypeHeaderName, serverconst.ContentTypeHTML)
+	w.Header().Set(serverconst.ContentSecurityPolicyHeaderName, endSessionPageCSP)
+	w.Header().Set(serverconst.XFrameOptionsHeaderName, serverconst.XFrameOptionsDeny)
+	w.WriteHeader(http.StatusOK)
+	err := endSessionPage.Execute(w, struct {
+		Delay                  int
+		RedirectURI            string
+		FrontChannelLogoutURIs []template.URL
+	}{
+		Delay:                  frontChannelRedirectDelaySeconds,
+		RedirectURI:            result.PostLogoutRedirectURI,
+		FrontChannelLogoutURIs: frontChannelURIs,
+	})
+	if err != nil {
+		h.logger.Error("Failed to render the end-session page", log.Error(err))
+	}
+}
+
+// writeError writes an error as JSON, or redirects the user agent to the error page.
+func (h *logoutHandler) writeError(w http.ResponseWriter, r *http.Request, wantsJSON bool,
+	code, description string, statusCode int) {
+	if wantsJSON {
+		sysutils.WriteJSONError(w, code, description, statusCode, nil)
+		return
+	}
+
+	gateClientConfig := config.GetServerRuntime().Config.GateClient
+	errorPageURL := (&url.URL{
+		Scheme: gateClientConfig.Scheme,
+		Host:   fmt.Sprintf("%s:%d", gateClientConfig.Hostname, gateClientConfig.Port),
+		Path:   gateClientConfig.ErrorPath,
+	}).String()
+	redirectURL, err := oauth2utils.GetURIWithQueryParams(errorPageURL, map[string]string{
+		"errorCode":    code,
+		"errorMessage": description,
+	})
+	if err != nil {
+		h.logger.Error("Failed to construct error page URL", log.Error(err))
+		http.Error(w, "Failed to redirect to error page", http.StatusInternalServerError)
+		return
+	}
+	http.Redirect(w, r, redirectURL, http.StatusFound)
+}
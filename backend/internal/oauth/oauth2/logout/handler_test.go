@@ -0,0 +1,145 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package logout
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+)
+
+type LogoutHandlerTestSuite struct {
+	suite.Suite
+	mockService *LogoutServiceInterfaceMock
+	handler     *logoutHandler
+}
+
+func TestLogoutHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(LogoutHandlerTestSuite))
+}
+
+func (suite *LogoutHandlerTestSuite) SetupTest() {
+	config.ResetServerRuntime()
+	testConfig := &config.Config{
+		GateClient: config.GateClientConfig{
+			Scheme:    "https",
+			Hostname:  "localhost",
+			Port:      3000,
+			ErrorPath: "/error",
+		},
+	}
+	_ = config.InitializeServerRuntime("test", testConfig)
+
+	suite.mockService = NewLogoutServiceInterfaceMock(suite.T())
+	suite.handler = newLogoutHandler(suite.mockService)
+}
+
+func (suite *LogoutHandlerTestSuite) TearDownTest() {
+	config.ResetServerRuntime()
+}
+
+func (suite *LogoutHandlerTestSuite) TestHandleLogout_RendersFrontChannelIframes() {
+	suite.mockService.EXPECT().Logout(mock.Anything, &LogoutRequest{
+		IDTokenHint:           "hint",
+		PostLogoutRedirectURI: testRedirectURI,
+	}).Return(&LogoutResult{
+		FrontChannelLogoutURIs: []string{testFrontChannelURI + "?iss=a&sid=b"},
+		PostLogoutRedirectURI:  testRedirectURI,
+	}, nil)
+
+	req := httptest.NewRequest(http.MethodGet,
+		"/oauth2/logout?id_token_hint=hint&post_logout_redirect_uri="+testRedirectURI, nil)
+	rr := httptest.NewRecorder()
+	suite.handler.HandleLogout(rr, req)
+
+	suite.Equal(http.StatusOK, rr.Code)
+	suite.Contains(rr.Header().Get("Content-Type"), "text/html")
+	suite.Contains(rr.Body.String(), `<iframe src="`+testFrontChannelURI+`?iss=a&amp;sid=b"`)
+	suite.Contains(rr.Body.String(), `url=`+testRedirectURI)
+}
+
+func (suite *LogoutHandlerTestSuite) TestHandleLogout_RedirectsWithoutFrontChannelClients() {
+	suite.mockService.EXPECT().Logout(mock.Anything, mock.Anything).Return(&LogoutResult{
+		FrontChannelLogoutURIs: []string{},
+		PostLogoutRedirectURI:  testRedirectURI,
+	}, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/oauth2/logout?client_id="+testClientID, nil)
+	rr := httptest.NewRecorder()
+	suite.handler.HandleLogout(rr, req)
+
+	suite.Equal(http.StatusFound, rr.Code)
+	suite.Equal(testRedirectURI, rr.Header().Get("Location"))
+}
+
+func (suite *LogoutHandlerTestSuite) TestHandleLogout_ReturnsOutcomesAsJSON() {
+	result := &LogoutResult{
+		FrontChannelLogoutURIs: []string{},
+		Outcomes: []ClientLogoutOutcome{
+			{ClientID: testOtherClientID, Channel: LogoutChannelBackChannel, Status: LogoutStatusSucceeded},
+		},
+	}
+	suite.mockService.EXPECT().Logout(mock.Anything, &LogoutRequest{ClientID: testOtherClientID}).
+		Return(result, nil)
+
+	req := httptest.NewRequest(http.MethodPost, "/oauth2/logout",
+		strings.NewReader("client_id="+testOtherClientID))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	rr := httptest.NewRecorder()
+	suite.handler.HandleLogout(rr, req)
+
+	suite.Equal(http.StatusOK, rr.Code)
+	var body LogoutResult
+	suite.NoError(json.Unmarshal(rr.Body.Bytes(), &body))
+	suite.Equal(*result, body)
+}
+
+func (suite *LogoutHandlerTestSuite) TestHandleLogout_ClientErrorAsJSON() {
+	suite.mockService.EXPECT().Logout(mock.Anything, mock.Anything).Return(nil, &errorMissingClient)
+
+	req := httptest.NewRequest(http.MethodGet, "/oauth2/logout", nil)
+	req.Header.Set("Accept", "application/json")
+	rr := httptest.NewRecorder()
+	suite.handler.HandleLogout(rr, req)
+
+	suite.Equal(http.StatusBadRequest, rr.Code)
+	suite.Contains(rr.Body.String(), errorMissingClient.ErrorDescription.DefaultValue)
+}
+
+func (suite *LogoutHandlerTestSuite) TestHandleLogout_ErrorRedirectsToErrorPage() {
+	suite.mockService.EXPECT().Logout(mock.Anything, mock.Anything).
+		Return(nil, &serviceerror.InternalServerError)
+
+	req := httptest.NewRequest(http.MethodGet, "/oauth2/logout", nil)
+	rr := httptest.NewRecorder()
+	suite.handler.HandleLogout(rr, req)
+
+	suite.Equal(http.StatusFound, rr.Code)
+	suite.True(strings.HasPrefix(rr.Header().Get("Location"), "https://localhost:3000/error?"))
+	suite.Contains(rr.Header().Get("Location"), "errorCode=server_error")
+}
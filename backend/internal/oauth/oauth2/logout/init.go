@@ -0,0 +1,61 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package logout
+
+import (
+	"net/http"
+
+	"github.com/thunder-id/thunderid/internal/inboundclient"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
+	syshttp "github.com/thunder-id/thunderid/internal/system/http"
+	"github.com/thunder-id/thunderid/internal/system/jose/jwt"
+	"github.com/thunder-id/thunderid/internal/system/middleware"
+)
+
+// Initialize initializes the logout handler and registers its routes.
+func Initialize(
+	mux *http.ServeMux,
+	jwtService jwt.JWTServiceInterface,
+	inboundClient inboundclient.InboundClientServiceInterface,
+	httpClient syshttp.HTTPClientInterface,
+) LogoutServiceInterface {
+	logoutService := newLogoutService(jwtService, inboundClient, httpClient)
+	logoutHandler := newLogoutHandler(logoutService)
+	registerRoutes(mux, logoutHandler)
+	return logoutService
+}
+
+// registerRoutes registers the routes for the end-session endpoint.
+func registerRoutes(mux *http.ServeMux, logoutHandler *logoutHandler) {
+	opts := middleware.CORSOptions{
+		AllowedMethods:   []string{"GET", "POST", "OPTIONS"},
+		AllowedHeaders:   middleware.DefaultAllowedHeaders,
+		AllowCredentials: true,
+		MaxAge:           600,
+	}
+
+	mux.HandleFunc(middleware.WithCORS("GET "+constants.OAuth2LogoutEndpoint,
+		logoutHandler.HandleLogout, opts))
+	mux.HandleFunc(middleware.WithCORS("POST "+constants.OAuth2LogoutEndpoint,
+		logoutHandler.HandleLogout, opts))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS "+constants.OAuth2LogoutEndpoint,
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, opts))
+}
@@ -0,0 +1,64 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package logout
+
+// LogoutRequest is an OpenID Connect RP-initiated logout request.
+type LogoutRequest struct {
+	IDTokenHint           string
+	ClientID              string
+	PostLogoutRedirectURI string
+	State                 string
+}
+
+// LogoutChannel identifies the mechanism used to notify a client of a logout.
+type LogoutChannel string
+
+const (
+	// LogoutChannelFrontChannel notifies the client through an iframe loaded by the user agent.
+	LogoutChannelFrontChannel LogoutChannel = "FRONT_CHANNEL"
+	// LogoutChannelBackChannel notifies the client by posting a logout token from the server.
+	LogoutChannelBackChannel LogoutChannel = "BACK_CHANNEL"
+)
+
+// LogoutStatus is the outcome of notifying a client of a logout.
+type LogoutStatus string
+
+const (
+	// LogoutStatusRendered means the front-channel logout URI was handed to the user agent to load.
+	LogoutStatusRendered LogoutStatus = "RENDERED"
+	// LogoutStatusSucceeded means the client acknowledged the back-channel logout token.
+	LogoutStatusSucceeded LogoutStatus = "SUCCEEDED"
+	// LogoutStatusFailed means the client could not be notified.
+	LogoutStatusFailed LogoutStatus = "FAILED"
+)
+
+// ClientLogoutOutcome reports the result of logging the user out of a single client over one channel.
+type ClientLogoutOutcome struct {
+	ClientID string        `json:"clientId"`
+	Channel  LogoutChannel `json:"channel"`
+	Status   LogoutStatus  `json:"status"`
+	Error    string        `json:"error,omitempty"`
+}
+
+// LogoutResult is the result of a processed logout request.
+type LogoutResult struct {
+	FrontChannelLogoutURIs []string              `json:"frontchannelLogoutUris"`
+	PostLogoutRedirectURI  string                `json:"postLogoutRedirectUri,omitempty"`
+	Outcomes               []ClientLogoutOutcome `json:"outcomes"`
+}
@@ -0,0 +1,362 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package logout implements the OpenID Connect end-session endpoint with front-channel and
+// back-channel logout propagation.
+package logout
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/inboundclient"
+	inboundmodel "github.com/thunder-id/thunderid/internal/inboundclient/model"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
+	oauth2utils "github.com/thunder-id/thunderid/internal/oauth/oauth2/utils"
+	"github.com/thunder-id/thunderid/internal/system/config"
+	serverconst "github.com/thunder-id/thunderid/internal/system/constants"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	syshttp "github.com/thunder-id/thunderid/internal/system/http"
+	"github.com/thunder-id/thunderid/internal/system/jose/jwt"
+	"github.com/thunder-id/thunderid/internal/system/log"
+)
+
+const (
+	// backChannelLogoutEvent is the event type carried by back-channel logout tokens.
+	backChannelLogoutEvent = "http://schemas.openid.net/event/backchannel-logout"
+	// logoutTokenValidityPeriod is the validity period of a back-channel logout token in seconds.
+	logoutTokenValidityPeriod = 120
+	// defaultBackChannelTimeout is used when oauth.logout.backchannel_timeout is not configured.
+	defaultBackChannelTimeout = 5 * time.Second
+
+	claimSid   = "sid"
+	claimAzp   = "azp"
+	claimEvent = "events"
+
+	requestParamLogoutToken = "logout_token" // #nosec G101
+)
+
+// LogoutServiceInterface defines the interface for OpenID Connect RP-initiated logout.
+type LogoutServiceInterface interface {
+	Logout(ctx context.Context, request *LogoutRequest) (*LogoutResult, *serviceerror.ServiceError)
+}
+
+// logoutService implements the LogoutServiceInterface.
+type logoutService struct {
+	jwtService    jwt.JWTServiceInterface
+	inboundClient inboundclient.InboundClientServiceInterface
+	httpClient    syshttp.HTTPClientInterface
+	logger        *log.Logger
+}
+
+// newLogoutService creates a new logoutService instance.
+func newLogoutService(
+	jwtService jwt.JWTServiceInterface,
+	inboundClient inboundclient.InboundClientServiceInterface,
+	httpClient syshttp.HTTPClientInterface,
+) LogoutServiceInterface {
+	return &logoutService{
+		jwtService:    jwtService,
+		inboundClient: inboundClient,
+		httpClient:    httpClient,
+		logger:        log.GetLogger().With(log.String(log.LoggerKeyComponentName, "LogoutService")),
+	}
+}
+
+// idTokenHint holds the claims of a verified id_token_hint that are relevant to logout.
+type idTokenHint struct {
+	subject   string
+	sessionID string
+	authParty string
+	audiences []string
+}
+
+// Logout resolves the clients identified by the request and notifies each of them of the logout.
+// The server keeps no record of the clients a user has signed in to, so the logout is propagated to the
+// audiences of the id_token_hint and to the client identified by client_id.
+func (s *logoutService) Logout(
+	ctx context.Context, request *LogoutRequest,
+) (*LogoutResult, *serviceerror.ServiceError) {
+	var hint *idTokenHint
+	if request.IDTokenHint != "" {
+		var svcErr *serviceerror.ServiceError
+		if hint, svcErr = s.parseIDTokenHint(request.IDTokenHint); svcErr != nil {
+			return nil, svcErr
+		}
+	}
+
+	clientIDs, svcErr := resolveClientIDs(hint, request.ClientID)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	clients, svcErr := s.getClients(ctx, clientIDs, request.ClientID)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+
+	result := &LogoutResult{
+		FrontChannelLogoutURIs: []string{},
+		Outcomes:               []ClientLogoutOutcome{},
+	}
+	if request.PostLogoutRedirectURI != "" {
+		redirectURI, svcErr := resolvePostLogoutRedirectURI(request, hint, clients)
+		if svcErr != nil {
+			return nil, svcErr
+		}
+		result.PostLogoutRedirectURI = redirectURI
+	}
+
+	for _, client := range clients {
+		if client.Logout == nil || client.Logout.FrontChannelLogoutURI == "" {
+			continue
+		}
+		outcome := ClientLogoutOutcome{
+			ClientID: client.ClientID,
+			Channel:  LogoutChannelFrontChannel,
+			Status:   LogoutStatusRendered,
+		}
+		logoutURI, err := buildFrontChannelLogoutURI(client.Logout, hint)
+		if err != nil {
+			outcome.Status = LogoutStatusFailed
+			outcome.Error = "failed to build the front-channel logout URI"
+		} else {
+			result.FrontChannelLogoutURIs = append(result.FrontChannelLogoutURIs, logoutURI)
+		}
+		result.Outcomes = append(result.Outcomes, outcome)
+	}
+	result.Outcomes = append(result.Outcomes, s.sendBackChannelLogouts(ctx, clients, hint)...)
+
+	return result, nil
+}
+
+// parseIDTokenHint verifies that the id_token_hint was issued by this server and extracts its claims.
+// An expired ID token is accepted as a hint.
+func (s *logoutService) parseIDTokenHint(token string) (*idTokenHint, *serviceerror.ServiceError) {
+	if err := s.jwtService.VerifyJWTSignature(token); err != nil {
+		s.logger.Debug("Failed to verify id_token_hint", log.String("error", err.Error.DefaultValue))
+		return nil, &errorInvalidIDTokenHint
+	}
+	payload, err := jwt.DecodeJWTPayload(token)
+	if err != nil {
+		return nil, &errorInvalidIDTokenHint
+	}
+	if iss, _ := payload[constants.ClaimIss].(string); iss != config.GetServerRuntime().Config.JWT.Issuer {
+		return nil, &errorInvalidIDTokenHint
+	}
+
+	hint := &idTokenHint{}
+	hint.subject, _ = payload[constants.ClaimSub].(string)
+	hint.sessionID, _ = payload[claimSid].(string)
+	hint.authParty, _ = payload[claimAzp].(string)
+	switch aud := payload[constants.ClaimAud].(type) {
+	case string:
+		hint.audiences = []string{aud}
+	case []interface{}:
+		for _, value := range aud {
+			if audience, ok := value.(string); ok {
+				hint.audiences = append(hint.audiences, audience)
+			}
+		}
+	}
+	if hint.subject == "" || len(hint.audiences) == 0 {
+		return nil, &errorInvalidIDTokenHint
+	}
+	return hint, nil
+}
+
+// resolveClientIDs returns the client IDs to log the user out of.
+func resolveClientIDs(hint *idTokenHint, clientID string) ([]string, *serviceerror.ServiceError) {
+	if hint == nil {
+		if clientID == "" {
+			return nil, &errorMissingClient
+		}
+		return []string{clientID}, nil
+	}
+	if clientID != "" && !slices.Contains(hint.audiences, clientID) {
+		return nil, &errorClientIDMismatch
+	}
+	return hint.audiences, nil
+}
+
+// getClients resolves the given client IDs. Audiences that are not registered clients are skipped, while an
+// unknown client_id parameter is rejected.
+func (s *logoutService) getClients(
+	ctx context.Context, clientIDs []string, requestedClientID string,
+) ([]*inboundmodel.OAuthClient, *serviceerror.ServiceError) {
+	clients := make([]*inboundmodel.OAuthClient, 0, len(clientIDs))
+	for _, clientID := range clientIDs {
+		client, err := s.inboundClient.GetOAuthClientByClientID(ctx, clientID)
+		if err != nil {
+			s.logger.Error("Failed to retrieve OAuth client", log.String("clientID", clientID), log.Error(err))
+			return nil, &serviceerror.InternalServerError
+		}
+		if client == nil {
+			if clientID == requestedClientID {
+				return nil, &errorUnknownClient
+			}
+			continue
+		}
+		clients = append(clients, client)
+	}
+	return clients, nil
+}
+
+// resolvePostLogoutRedirectURI validates post_logout_redirect_uri against the redirect URIs of the client the
+// request originates from and appends the state parameter.
+func resolvePostLogoutRedirectURI(request *LogoutRequest, hint *idTokenHint,
+	clients []*inboundmodel.OAuthClient) (string, *serviceerror.ServiceError) {
+	originClientID := request.ClientID
+	if originClientID == "" && hint != nil {
+		if hint.authParty != "" {
+			originClientID = hint.authParty
+		} else if len(hint.audiences) == 1 {
+			originClientID = hint.audiences[0]
+		}
+	}
+	if originClientID == "" {
+		return "", &errorMissingClient
+	}
+
+	idx := slices.IndexFunc(clients, func(client *inboundmodel.OAuthClient) bool {
+		return client.ClientID == originClientID
+	})
+	if idx == -1 {
+		return "", &errorUnknownClient
+	}
+	if err := clients[idx].ValidateRedirectURI(request.PostLogoutRedirectURI); err != nil {
+		return "", &errorInvalidPostLogoutRedirectURI
+	}
+
+	if request.State == "" {
+		return request.PostLogoutRedirectURI, nil
+	}
+	redirectURI, err := oauth2utils.GetURIWithQueryParams(request.PostLogoutRedirectURI,
+		map[string]string{constants.RequestParamState: request.State})
+	if err != nil {
+		return "", &errorInvalidPostLogoutRedirectURI
+	}
+	return redirectURI, nil
+}
+
+// buildFrontChannelLogoutURI returns the front-channel logout URI of a client, adding the iss and sid
+// parameters when the client requires them.
+func buildFrontChannelLogoutURI(logoutConfig *inboundmodel.LogoutConfig, hint *idTokenHint) (string, error) {
+	if !logoutConfig.FrontChannelLogoutSessionRequired {
+		return logoutConfig.FrontChannelLogoutURI, nil
+	}
+	params := map[string]string{
+		constants.RequestParamIss: config.GetServerRuntime().Config.JWT.Issuer,
+	}
+	if hint != nil && hint.sessionID != "" {
+		params[claimSid] = hint.sessionID
+	}
+	return oauth2utils.GetURIWithQueryParams(logoutConfig.FrontChannelLogoutURI, params)
+}
+
+// sendBackChannelLogouts posts logout tokens to the back-channel logout URIs of the clients concurrently.
+func (s *logoutService) sendBackChannelLogouts(ctx context.Context, clients []*inboundmodel.OAuthClient,
+	hint *idTokenHint) []ClientLogoutOutcome {
+	timeout := time.Duration(config.GetServerRuntime().Config.OAuth.Logout.BackChannelTimeout) * time.Second
+	if timeout <= 0 {
+		timeout = defaultBackChannelTimeout
+	}
+
+	targets := make([]*inboundmodel.OAuthClient, 0, len(clients))
+	for _, client := range clients {
+		if client.Logout != nil && client.Logout.BackChannelLogoutURI != "" {
+			targets = append(targets, client)
+		}
+	}
+
+	outcomes := make([]ClientLogoutOutcome, len(targets))
+	var wg sync.WaitGroup
+	for i, client := range targets {
+		wg.Go(func() {
+			outcomes[i] = s.sendBackChannelLogout(ctx, client, hint, timeout)
+		})
+	}
+	wg.Wait()
+	return outcomes
+}
+
+// sendBackChannelLogout posts a logout token to the back-channel logout URI of a single client.
+func (s *logoutService) sendBackChannelLogout(ctx context.Context, client *inboundmodel.OAuthClient,
+	hint *idTokenHint, timeout time.Duration) ClientLogoutOutcome {
+	outcome := ClientLogoutOutcome{
+		ClientID: client.ClientID,
+		Channel:  LogoutChannelBackChannel,
+		Status:   LogoutStatusFailed,
+	}
+	if hint == nil {
+		outcome.Error = "no id_token_hint was provided to identify the user"
+		return outcome
+	}
+
+	claims := map[string]interface{}{
+		constants.ClaimAud: client.ClientID,
+		claimEvent:         map[string]interface{}{backChannelLogoutEvent: map[string]interface{}{}},
+	}
+	if client.Logout.BackChannelLogoutSessionRequired && hint.sessionID != "" {
+		claims[claimSid] = hint.sessionID
+	}
+	logoutToken, _, svcErr := s.jwtService.GenerateJWT(ctx, hint.subject,
+		config.GetServerRuntime().Config.JWT.Issuer, logoutTokenValidityPeriod, claims,
+		jwt.TokenTypeLogoutToken, "")
+	if svcErr != nil {
+		s.logger.Error("Failed to generate logout token", log.String("clientID", client.ClientID),
+			log.String("error", svcErr.Error.DefaultValue))
+		outcome.Error = "failed to generate the logout token"
+		return outcome
+	}
+
+	// The notification must complete even if the user agent abandons the end-session request.
+	reqCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), timeout)
+	defer cancel()
+	body := url.Values{requestParamLogoutToken: {logoutToken}}.Encode()
+	req, err := http.NewRequestWithContext(reqCtx, http.MethodPost, client.Logout.BackChannelLogoutURI,
+		strings.NewReader(body))
+	if err != nil {
+		outcome.Error = "failed to build the back-channel logout request"
+		return outcome
+	}
+	req.Header.Set(serverconst.ContentTypeHeaderName, serverconst.ContentTypeFormURLEncoded)
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		s.logger.Debug("Back-channel logout request failed", log.String("clientID", client.ClientID),
+			log.Error(err))
+		outcome.Error = "the back-channel logout request failed or timed out"
+		return outcome
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
+		outcome.Error = fmt.Sprintf("the client responded with status %d", resp.StatusCode)
+		return outcome
+	}
+
+	outcome.Status = LogoutStatusSucceeded
+	return outcome
+}
@@ -0,0 +1,279 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package logout
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	inboundmodel "github.com/thunder-id/thunderid/internal/inboundclient/model"
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/jose/jwt"
+	"github.com/thunder-id/thunderid/tests/mocks/httpmock"
+	"github.com/thunder-id/thunderid/tests/mocks/inboundclientmock"
+	"github.com/thunder-id/thunderid/tests/mocks/jose/jwtmock"
+)
+
+const (
+	testIssuer          = "https://localhost:8090"
+	testClientID        = "client-1"
+	testOtherClientID   = "client-2"
+	testFrontChannelURI = "https://rp1.example.com/frontchannel-logout"
+	testBackChannelURI  = "https://rp2.example.com/backchannel-logout"
+	testRedirectURI     = "https://rp1.example.com/callback"
+	testLogoutToken     = "logout-token" //nolint:gosec // test token
+	testSubject         = "user-1"
+	testSessionID       = "session-1"
+)
+
+// buildIDToken builds an unsigned JWT carrying the given claims. Signature verification is mocked.
+func buildIDToken(claims map[string]interface{}) string {
+	header, _ := json.Marshal(map[string]string{"alg": "RS256", "typ": "JWT"})
+	payload, _ := json.Marshal(claims)
+	return base64.RawURLEncoding.EncodeToString(header) + "." +
+		base64.RawURLEncoding.EncodeToString(payload) + ".signature"
+}
+
+type LogoutServiceTestSuite struct {
+	suite.Suite
+	mockJWTService    *jwtmock.JWTServiceInterfaceMock
+	mockInboundClient *inboundclientmock.InboundClientServiceInterfaceMock
+	mockHTTPClient    *httpmock.HTTPClientInterfaceMock
+	service           *logoutService
+}
+
+func TestLogoutServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(LogoutServiceTestSuite))
+}
+
+func (suite *LogoutServiceTestSuite) SetupTest() {
+	config.ResetServerRuntime()
+	testConfig := &config.Config{
+		JWT: config.JWTConfig{Issuer: testIssuer},
+	}
+	_ = config.InitializeServerRuntime("test", testConfig)
+
+	suite.mockJWTService = jwtmock.NewJWTServiceInterfaceMock(suite.T())
+	suite.mockInboundClient = inboundclientmock.NewInboundClientServiceInterfaceMock(suite.T())
+	suite.mockHTTPClient = httpmock.NewHTTPClientInterfaceMock(suite.T())
+	suite.service = newLogoutService(suite.mockJWTService, suite.mockInboundClient,
+		suite.mockHTTPClient).(*logoutService)
+}
+
+func (suite *LogoutServiceTestSuite) TearDownTest() {
+	config.ResetServerRuntime()
+}
+
+// idTokenHint returns a verified id_token_hint issued to the given audiences.
+func (suite *LogoutServiceTestSuite) idTokenHint(aud interface{}) string {
+	token := buildIDToken(map[string]interface{}{
+		"iss": testIssuer,
+		"sub": testSubject,
+		"aud": aud,
+		"sid": testSessionID,
+	})
+	suite.mockJWTService.EXPECT().VerifyJWTSignature(token).Return(nil)
+	return token
+}
+
+func frontChannelClient() *inboundmodel.OAuthClient {
+	return &inboundmodel.OAuthClient{
+		ClientID:     testClientID,
+		RedirectURIs: []string{testRedirectURI},
+		Logout: &inboundmodel.LogoutConfig{
+			FrontChannelLogoutURI:             testFrontChannelURI,
+			FrontChannelLogoutSessionRequired: true,
+		},
+	}
+}
+
+func backChannelClient() *inboundmodel.OAuthClient {
+	return &inboundmodel.OAuthClient{
+		ClientID: testOtherClientID,
+		Logout: &inboundmodel.LogoutConfig{
+			BackChannelLogoutURI:             testBackChannelURI,
+			BackChannelLogoutSessionRequired: true,
+		},
+	}
+}
+
+func (suite *LogoutServiceTestSuite) TestLogout_MissingClient() {
+	result, svcErr := suite.service.Logout(context.Background(), &LogoutRequest{})
+
+	suite.Nil(result)
+	suite.Equal(&errorMissingClient, svcErr)
+}
+
+func (suite *LogoutServiceTestSuite) TestLogout_InvalidIDTokenHintSignature() {
+	token := buildIDToken(map[string]interface{}{"iss": testIssuer, "sub": testSubject, "aud": testClientID})
+	suite.mockJWTService.EXPECT().VerifyJWTSignature(token).Return(&serviceerror.ServiceError{
+		Error: errorInvalidIDTokenHint.Error,
+	})
+
+	result, svcErr := suite.service.Logout(context.Background(), &LogoutRequest{IDTokenHint: token})
+
+	suite.Nil(result)
+	suite.Equal(&errorInvalidIDTokenHint, svcErr)
+}
+
+func (suite *LogoutServiceTestSuite) TestLogout_IDTokenHintFromOtherIssuer() {
+	token := buildIDToken(map[string]interface{}{
+		"iss": "https://other.example.com", "sub": testSubject, "aud": testClientID,
+	})
+	suite.mockJWTService.EXPECT().VerifyJWTSignature(token).Return(nil)
+
+	result, svcErr := suite.service.Logout(context.Background(), &LogoutRequest{IDTokenHint: token})
+
+	suite.Nil(result)
+	suite.Equal(&errorInvalidIDTokenHint, svcErr)
+}
+
+func (suite *LogoutServiceTestSuite) TestLogout_ClientIDNotInAudience() {
+	result, svcErr := suite.service.Logout(context.Background(), &LogoutRequest{
+		IDTokenHint: suite.idTokenHint(testClientID),
+		ClientID:    testOtherClientID,
+	})
+
+	suite.Nil(result)
+	suite.Equal(&errorClientIDMismatch, svcErr)
+}
+
+func (suite *LogoutServiceTestSuite) TestLogout_UnknownClientID() {
+	suite.mockInboundClient.EXPECT().GetOAuthClientByClientID(mock.Anything, testClientID).Return(nil, nil)
+
+	result, svcErr := suite.service.Logout(context.Background(), &LogoutRequest{ClientID: testClientID})
+
+	suite.Nil(result)
+	suite.Equal(&errorUnknownClient, svcErr)
+}
+
+func (suite *LogoutServiceTestSuite) TestLogout_PropagatesToFrontAndBackChannelClients() {
+	suite.mockInboundClient.EXPECT().GetOAuthClientByClientID(mock.Anything, testClientID).
+		Return(frontChannelClient(), nil)
+	suite.mockInboundClient.EXPECT().GetOAuthClientByClientID(mock.Anything, testOtherClientID).
+		Return(backChannelClient(), nil)
+	suite.mockJWTService.EXPECT().GenerateJWT(mock.Anything, testSubject, testIssuer, int64(logoutTokenValidityPeriod),
+		mock.MatchedBy(func(claims map[string]interface{}) bool {
+			events, _ := claims[claimEvent].(map[string]interface{})
+			_, hasEvent := events[backChannelLogoutEvent]
+			return hasEvent && claims["aud"] == testOtherClientID && claims[claimSid] == testSessionID
+		}), jwt.TokenTypeLogoutToken, "").Return(testLogoutToken, int64(0), nil)
+	suite.mockHTTPClient.EXPECT().Do(mock.MatchedBy(func(req *http.Request) bool {
+		body, _ := io.ReadAll(req.Body)
+		values, _ := url.ParseQuery(string(body))
+		return req.Method == http.MethodPost && req.URL.String() == testBackChannelURI &&
+			values.Get(requestParamLogoutToken) == testLogoutToken
+	})).Return(&http.Response{StatusCode: http.StatusNoContent, Body: io.NopCloser(strings.NewReader(""))}, nil)
+
+	result, svcErr := suite.service.Logout(context.Background(), &LogoutRequest{
+		IDTokenHint: suite.idTokenHint([]interface{}{testClientID, testOtherClientID}),
+	})
+
+	suite.Nil(svcErr)
+	suite.Equal([]string{testFrontChannelURI + "?iss=" + url.QueryEscape(testIssuer) + "&sid=" + testSessionID},
+		result.FrontChannelLogoutURIs)
+	suite.Equal([]ClientLogoutOutcome{
+		{ClientID: testClientID, Channel: LogoutChannelFrontChannel, Status: LogoutStatusRendered},
+		{ClientID: testOtherClientID, Channel: LogoutChannelBackChannel, Status: LogoutStatusSucceeded},
+	}, result.Outcomes)
+}
+
+func (suite *LogoutServiceTestSuite) TestLogout_ReportsFailedBackChannelLogout() {
+	suite.mockInboundClient.EXPECT().GetOAuthClientByClientID(mock.Anything, testOtherClientID).
+		Return(backChannelClient(), nil)
+	suite.mockJWTService.EXPECT().GenerateJWT(mock.Anything, testSubject, testIssuer, mock.Anything,
+		mock.Anything, jwt.TokenTypeLogoutToken, "").Return(testLogoutToken, int64(0), nil)
+	suite.mockHTTPClient.EXPECT().Do(mock.Anything).
+		Return(&http.Response{StatusCode: http.StatusBadRequest, Body: io.NopCloser(strings.NewReader(""))}, nil)
+
+	result, svcErr := suite.service.Logout(context.Background(), &LogoutRequest{
+		IDTokenHint: suite.idTokenHint(testOtherClientID),
+	})
+
+	suite.Nil(svcErr)
+	suite.Equal([]ClientLogoutOutcome{{
+		ClientID: testOtherClientID,
+		Channel:  LogoutChannelBackChannel,
+		Status:   LogoutStatusFailed,
+		Error:    "the client responded with status 400",
+	}}, result.Outcomes)
+}
+
+func (suite *LogoutServiceTestSuite) TestLogout_BackChannelRequiresIDTokenHint() {
+	suite.mockInboundClient.EXPECT().GetOAuthClientByClientID(mock.Anything, testOtherClientID).
+		Return(backChannelClient(), nil)
+
+	result, svcErr := suite.service.Logout(context.Background(), &LogoutRequest{ClientID: testOtherClientID})
+
+	suite.Nil(svcErr)
+	suite.Len(result.Outcomes, 1)
+	suite.Equal(LogoutStatusFailed, result.Outcomes[0].Status)
+	suite.mockHTTPClient.AssertNotCalled(suite.T(), "Do", mock.Anything)
+}
+
+func (suite *LogoutServiceTestSuite) TestLogout_SkipsAudiencesThatAreNotClients() {
+	suite.mockInboundClient.EXPECT().GetOAuthClientByClientID(mock.Anything, testClientID).
+		Return(frontChannelClient(), nil)
+	suite.mockInboundClient.EXPECT().GetOAuthClientByClientID(mock.Anything, "resource-server").Return(nil, nil)
+
+	result, svcErr := suite.service.Logout(context.Background(), &LogoutRequest{
+		IDTokenHint: suite.idTokenHint([]interface{}{testClientID, "resource-server"}),
+	})
+
+	suite.Nil(svcErr)
+	suite.Len(result.Outcomes, 1)
+	suite.Equal(testClientID, result.Outcomes[0].ClientID)
+}
+
+func (suite *LogoutServiceTestSuite) TestLogout_PostLogoutRedirectURI() {
+	suite.mockInboundClient.EXPECT().GetOAuthClientByClientID(mock.Anything, testClientID).
+		Return(frontChannelClient(), nil)
+
+	result, svcErr := suite.service.Logout(context.Background(), &LogoutRequest{
+		IDTokenHint:           suite.idTokenHint(testClientID),
+		PostLogoutRedirectURI: testRedirectURI,
+		State:                 "xyz",
+	})
+
+	suite.Nil(svcErr)
+	suite.Equal(testRedirectURI+"?state=xyz", result.PostLogoutRedirectURI)
+}
+
+func (suite *LogoutServiceTestSuite) TestLogout_UnregisteredPostLogoutRedirectURI() {
+	suite.mockInboundClient.EXPECT().GetOAuthClientByClientID(mock.Anything, testClientID).
+		Return(frontChannelClient(), nil)
+
+	result, svcErr := suite.service.Logout(context.Background(), &LogoutRequest{
+		ClientID:              testClientID,
+		PostLogoutRedirectURI: "https://attacker.example.com/",
+	})
+
+	suite.Nil(result)
+	suite.Equal(&errorInvalidPostLogoutRedirectURI, svcErr)
+}
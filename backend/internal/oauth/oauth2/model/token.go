@@ -19,6 +19,8 @@
 // Package model defines the data structures used in the OAuth2 module.
 package model
 
+import "net/url"
+
 // TokenRequest represents the OAuth2 token request.
 type TokenRequest struct {
 	GrantType          string   `json:"grant_type"`
@@ -38,6 +40,9 @@ type TokenRequest struct {
 	ActorTokenType     string   `json:"actor_token_type,omitempty"`
 	RequestedTokenType string   `json:"requested_token_type,omitempty"`
 	Audiences          []string `json:"audiences,omitempty"`
+	DeviceCode         string   `json:"device_code,omitempty"`
+	// Parameters holds all request parameters except client credentials, for use by custom grant handlers.
+	Parameters url.Values `json:"-"`
 }
 
 // TokenResponse represents the OAuth2 token response.
@@ -66,6 +71,7 @@ type TokenDTO struct {
 	OriginalAudiences []string
 	ClaimsRequest     *ClaimsRequest
 	ClaimsLocales     string
+	ACR               string
 }
 
 // TokenResponseDTO represents the data transfer object for token responses.
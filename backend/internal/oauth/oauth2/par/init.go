@@ -26,6 +26,7 @@ import (
 	"github.com/thunder-id/thunderid/internal/inboundclient"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/clientauth"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/discovery"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/tokenstore"
 	"github.com/thunder-id/thunderid/internal/resource"
 	"github.com/thunder-id/thunderid/internal/system/config"
 	"github.com/thunder-id/thunderid/internal/system/database/provider"
@@ -54,7 +55,7 @@ func Initialize(
 func initializePARStore() parStoreInterface {
 	deploymentID := config.GetServerRuntime().Config.Server.Identifier
 
-	if config.GetServerRuntime().Config.Database.Runtime.Type == provider.DataSourceTypeRedis {
+	if tokenstore.ResolveStoreType(tokenstore.ArtifactTypePARRequest) == tokenstore.StoreTypeRedis {
 		return newRedisPARRequestStore(provider.GetRedisProvider(), deploymentID)
 	}
 	return newPARRequestStore(deploymentID)
@@ -106,7 +106,10 @@ func (s *parService) HandlePushedAuthorizationRequest(
 	}
 
 	scope := params[oauth2const.RequestParamScope]
-	oidcScopes, nonOidcScopes := oauth2utils.SeparateOIDCAndNonOIDCScopes(scope, oauthApp.ScopeClaims)
+	oidcScopes, nonOidcScopes, scopesAllowed := oauth2utils.SeparateAllowedScopes(scope, oauthApp)
+	if !scopesAllowed {
+		return nil, oauth2const.ErrorInvalidScope, "Requested scope is not allowed for this client"
+	}
 
 	// Resolve resource identifiers to Resource Servers and downscope non-OIDC scopes against
 	// the union of permissions defined on those Resource Servers. Unknown identifiers cause
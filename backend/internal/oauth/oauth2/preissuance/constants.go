@@ -0,0 +1,52 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package preissuance
+
+import (
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
+)
+
+const (
+	loggerComponentName = "PreIssuanceService"
+
+	// decisionAllow and decisionDeny are the decisions returned by the external policy endpoint.
+	decisionAllow = "allow"
+	decisionDeny  = "deny"
+
+	// defaultDenyReason is returned to the client when a policy denies a request without a reason.
+	defaultDenyReason = "Token issuance is not permitted"
+	// failClosedReason is returned to the client when no decision could be obtained in closed fail mode.
+	failClosedReason = "Token issuance could not be authorized"
+
+	// defaultTimeout applies when no timeout is configured for the external policy endpoint.
+	defaultTimeout = 2 * time.Second
+	// maxResponseBytes caps the size of a response read from the external policy endpoint.
+	maxResponseBytes = 64 << 10
+	// maxCachedDecisions is the number of cached decisions above which expired entries are evicted.
+	maxCachedDecisions = 10000
+)
+
+// reservedClaims are claims that policies cannot set because they are managed by the token builder.
+var reservedClaims = map[string]struct{}{
+	"iss": {}, "sub": {}, "aud": {}, "exp": {}, "nbf": {}, "iat": {}, "jti": {},
+	"scope": {}, "client_id": {}, "grant_type": {}, "act": {}, "aci": {},
+	constants.ClaimTenantID: {},
+}
@@ -0,0 +1,166 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package preissuance
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	serverconst "github.com/thunder-id/thunderid/internal/system/constants"
+	syshttp "github.com/thunder-id/thunderid/internal/system/http"
+)
+
+// cachedDecision holds a decision of the external policy endpoint with its expiry time.
+type cachedDecision struct {
+	decision  *Decision
+	expiresAt time.Time
+}
+
+// httpPolicy is a policy that delegates the decision to an external HTTP endpoint. The token request
+// context is posted as JSON and the endpoint responds with {"decision": "allow" | "deny", "reason": "...",
+// "claims": {...}}.
+type httpPolicy struct {
+	url        string
+	cacheTTL   time.Duration
+	httpClient syshttp.HTTPClientInterface
+	cache      sync.Map
+	cacheSize  int
+	cacheMu    sync.Mutex
+}
+
+// newHTTPPolicy creates a new instance of httpPolicy. The HTTP client must be configured with the
+// decision timeout.
+func newHTTPPolicy(url string, cacheTTL time.Duration, httpClient syshttp.HTTPClientInterface) *httpPolicy {
+	return &httpPolicy{
+		url:        url,
+		cacheTTL:   cacheTTL,
+		httpClient: httpClient,
+	}
+}
+
+// Evaluate posts the token request context to the external endpoint and returns its decision. Decisions
+// are reused for identical contexts until the cache TTL elapses.
+func (p *httpPolicy) Evaluate(ctx context.Context, issuanceCtx *IssuanceContext) (*Decision, error) {
+	body, err := json.Marshal(issuanceCtx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal the pre-issuance request: %w", err)
+	}
+
+	digest := sha256.Sum256(body)
+	cacheKey := hex.EncodeToString(digest[:])
+	if decision, ok := p.getCachedDecision(cacheKey); ok {
+		return decision, nil
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.url, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create the pre-issuance request: %w", err)
+	}
+	req.Header.Set(serverconst.ContentTypeHeaderName, serverconst.ContentTypeJSON)
+
+	resp, err := p.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to call the pre-issuance endpoint: %w", err)
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("the pre-issuance endpoint returned status %d", resp.StatusCode)
+	}
+
+	respBody, err := io.ReadAll(io.LimitReader(resp.Body, maxResponseBytes+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the pre-issuance response: %w", err)
+	}
+	if len(respBody) > maxResponseBytes {
+		return nil, fmt.Errorf("the pre-issuance response exceeds %d bytes", maxResponseBytes)
+	}
+
+	var policyResp policyResponse
+	if err := json.Unmarshal(respBody, &policyResp); err != nil {
+		return nil, fmt.Errorf("failed to parse the pre-issuance response: %w", err)
+	}
+
+	var decision *Decision
+	switch policyResp.Decision {
+	case decisionAllow:
+		decision = &Decision{Allow: true, Claims: policyResp.Claims}
+	case decisionDeny:
+		decision = &Decision{Allow: false, Reason: policyResp.Reason}
+	default:
+		return nil, fmt.Errorf("the pre-issuance endpoint returned an unknown decision %q", policyResp.Decision)
+	}
+
+	p.cacheDecision(cacheKey, decision)
+	return decision, nil
+}
+
+// getCachedDecision returns the cached decision for a key if it has not expired.
+func (p *httpPolicy) getCachedDecision(key string) (*Decision, bool) {
+	if p.cacheTTL <= 0 {
+		return nil, false
+	}
+	cached, ok := p.cache.Load(key)
+	if !ok {
+		return nil, false
+	}
+	entry := cached.(*cachedDecision)
+	if time.Now().After(entry.expiresAt) {
+		return nil, false
+	}
+	return entry.decision, true
+}
+
+// cacheDecision caches a decision for the cache TTL. Expired decisions are evicted once the number of
+// cached decisions grows beyond maxCachedDecisions.
+func (p *httpPolicy) cacheDecision(key string, decision *Decision) {
+	if p.cacheTTL <= 0 {
+		return
+	}
+
+	p.cacheMu.Lock()
+	defer p.cacheMu.Unlock()
+	if _, loaded := p.cache.Swap(key, &cachedDecision{
+		decision:  decision,
+		expiresAt: time.Now().Add(p.cacheTTL),
+	}); !loaded {
+		p.cacheSize++
+	}
+	if p.cacheSize <= maxCachedDecisions {
+		return
+	}
+
+	now := time.Now()
+	p.cache.Range(func(k, v any) bool {
+		if now.After(v.(*cachedDecision).expiresAt) {
+			p.cache.Delete(k)
+			p.cacheSize--
+		}
+		return true
+	})
+}
@@ -0,0 +1,157 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package preissuance
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+	syshttp "github.com/thunder-id/thunderid/internal/system/http"
+)
+
+func newTestHTTPClient(t *testing.T, timeout time.Duration) syshttp.HTTPClientInterface {
+	_ = config.InitializeServerRuntime("", &config.Config{})
+	t.Cleanup(config.ResetServerRuntime)
+	return syshttp.NewHTTPClientWithTimeout(timeout)
+}
+
+func newTestPolicyServer(t *testing.T, status int, body string, calls *int32) *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(calls, 1)
+		var issuanceCtx IssuanceContext
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&issuanceCtx))
+		assert.Equal(t, "user-1", issuanceCtx.Subject)
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(body))
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestHTTPPolicy_Evaluate(t *testing.T) {
+	testCases := []struct {
+		name     string
+		status   int
+		body     string
+		expected *Decision
+		wantErr  bool
+	}{
+		{
+			name:     "Allow",
+			status:   http.StatusOK,
+			body:     `{"decision": "allow", "claims": {"plan": "gold"}}`,
+			expected: &Decision{Allow: true, Claims: map[string]interface{}{"plan": "gold"}},
+		},
+		{
+			name:     "Deny",
+			status:   http.StatusOK,
+			body:     `{"decision": "deny", "reason": "The subscription is suspended"}`,
+			expected: &Decision{Allow: false, Reason: "The subscription is suspended"},
+		},
+		{name: "UnknownDecision", status: http.StatusOK, body: `{"decision": "maybe"}`, wantErr: true},
+		{name: "InvalidBody", status: http.StatusOK, body: `not json`, wantErr: true},
+		{name: "UnexpectedStatus", status: http.StatusServiceUnavailable, body: `{}`, wantErr: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var calls int32
+			server := newTestPolicyServer(t, tc.status, tc.body, &calls)
+			policy := newHTTPPolicy(server.URL, 0, newTestHTTPClient(t, time.Second))
+
+			decision, err := policy.Evaluate(context.Background(), &IssuanceContext{Subject: "user-1"})
+
+			if tc.wantErr {
+				assert.Error(t, err)
+				assert.Nil(t, decision)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, decision)
+		})
+	}
+}
+
+func TestHTTPPolicy_Evaluate_CachesDecisions(t *testing.T) {
+	var calls int32
+	server := newTestPolicyServer(t, http.StatusOK, `{"decision": "allow"}`, &calls)
+	policy := newHTTPPolicy(server.URL, time.Minute, newTestHTTPClient(t, time.Second))
+
+	for i := 0; i < 3; i++ {
+		decision, err := policy.Evaluate(context.Background(), &IssuanceContext{Subject: "user-1", ClientID: "a"})
+		require.NoError(t, err)
+		assert.True(t, decision.Allow)
+	}
+	_, err := policy.Evaluate(context.Background(), &IssuanceContext{Subject: "user-1", ClientID: "b"})
+	require.NoError(t, err)
+
+	assert.Equal(t, int32(2), atomic.LoadInt32(&calls))
+}
+
+func TestHTTPPolicy_Evaluate_DoesNotCacheFailures(t *testing.T) {
+	var calls int32
+	server := newTestPolicyServer(t, http.StatusInternalServerError, `{}`, &calls)
+	policy := newHTTPPolicy(server.URL, time.Minute, newTestHTTPClient(t, time.Second))
+
+	for i := 0; i < 2; i++ {
+		_, err := policy.Evaluate(context.Background(), &IssuanceContext{Subject: "user-1"})
+		assert.Error(t, err)
+	}
+
+	assert.Equal(t, int32(2), atomic.LoadInt32(&calls))
+}
+
+func TestHTTPPolicy_Evaluate_Timeout(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-time.After(200 * time.Millisecond):
+		}
+	}))
+	t.Cleanup(server.Close)
+	policy := newHTTPPolicy(server.URL, 0, newTestHTTPClient(t, 20*time.Millisecond))
+
+	_, err := policy.Evaluate(context.Background(), &IssuanceContext{Subject: "user-1"})
+
+	assert.Error(t, err)
+}
+
+func TestHTTPPolicy_CacheDecision_EvictsExpiredEntries(t *testing.T) {
+	policy := newHTTPPolicy("http://localhost", time.Minute, nil)
+	for i := 0; i < maxCachedDecisions; i++ {
+		policy.cache.Store(i, &cachedDecision{expiresAt: time.Now().Add(-time.Second)})
+	}
+	policy.cacheSize = maxCachedDecisions
+
+	policy.cacheDecision("key", &Decision{Allow: true})
+
+	assert.Equal(t, 1, policy.cacheSize)
+	decision, ok := policy.getCachedDecision("key")
+	assert.True(t, ok)
+	assert.True(t, decision.Allow)
+}
@@ -0,0 +1,46 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package preissuance
+
+import (
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/entityprovider"
+	"github.com/thunder-id/thunderid/internal/system/config"
+	syshttp "github.com/thunder-id/thunderid/internal/system/http"
+)
+
+// Initialize initializes the pre-issuance service. The external policy endpoint is registered as a
+// policy when it is configured, and other services can register internal policies with the returned
+// service.
+func Initialize(entityProvider entityprovider.EntityProviderInterface) PreIssuanceServiceInterface {
+	hookConfig := config.GetServerRuntime().Config.OAuth.PreIssuanceHook
+	service := newPreIssuanceService(entityProvider, FailMode(hookConfig.FailMode))
+
+	if hookConfig.URL != "" {
+		timeout := defaultTimeout
+		if hookConfig.TimeoutMS > 0 {
+			timeout = time.Duration(hookConfig.TimeoutMS) * time.Millisecond
+		}
+		service.RegisterPolicy(newHTTPPolicy(hookConfig.URL, time.Duration(hookConfig.CacheTTL)*time.Second,
+			syshttp.NewHTTPClientWithTimeout(timeout)))
+	}
+
+	return service
+}
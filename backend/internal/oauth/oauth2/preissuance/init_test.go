@@ -0,0 +1,57 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package preissuance
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+)
+
+func TestInitialize(t *testing.T) {
+	testCases := []struct {
+		name     string
+		url      string
+		policies int
+	}{
+		{name: "WithoutEndpoint", url: "", policies: 0},
+		{name: "WithEndpoint", url: "https://policy.example.com/decide", policies: 1},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			testConfig := &config.Config{}
+			testConfig.OAuth.PreIssuanceHook = config.PreIssuanceHookConfig{
+				URL:      tc.url,
+				FailMode: "closed",
+			}
+			_ = config.InitializeServerRuntime("", testConfig)
+			defer config.ResetServerRuntime()
+
+			service := Initialize(nil)
+
+			impl, ok := service.(*preIssuanceService)
+			assert.True(t, ok)
+			assert.Equal(t, FailModeClosed, impl.failMode)
+			assert.Len(t, impl.policies, tc.policies)
+		})
+	}
+}
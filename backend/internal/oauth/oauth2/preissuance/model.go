@@ -0,0 +1,76 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package preissuance provides the policy hook that is consulted synchronously before an OAuth 2.0
+// access token is issued. Policies can deny a token request or annotate the token with extra claims.
+package preissuance
+
+import (
+	"context"
+)
+
+// FailMode decides the outcome of a token request when a policy cannot reach a decision.
+type FailMode string
+
+const (
+	// FailModeOpen issues the token without the annotations of the failed policy.
+	FailModeOpen FailMode = "open"
+	// FailModeClosed denies the token request.
+	FailModeClosed FailMode = "closed"
+)
+
+// IssuanceContext describes the token request evaluated by the policies.
+type IssuanceContext struct {
+	TenantID   string   `json:"tenantId,omitempty"`
+	Subject    string   `json:"subject"`
+	EntityType string   `json:"entityType,omitempty"`
+	OUID       string   `json:"ouId,omitempty"`
+	ClientID   string   `json:"clientId"`
+	AppID      string   `json:"appId,omitempty"`
+	AppOUID    string   `json:"appOuId,omitempty"`
+	GrantType  string   `json:"grantType"`
+	Scopes     []string `json:"scopes,omitempty"`
+}
+
+// Decision is the outcome of evaluating a token request.
+type Decision struct {
+	Allow  bool
+	Reason string
+	Claims map[string]interface{}
+}
+
+// PolicyInterface is implemented by policies that decide whether a token may be issued. A policy returns
+// an error when it cannot reach a decision, in which case the configured fail mode applies.
+type PolicyInterface interface {
+	Evaluate(ctx context.Context, issuanceCtx *IssuanceContext) (*Decision, error)
+}
+
+// PolicyFunc adapts a function to PolicyInterface.
+type PolicyFunc func(ctx context.Context, issuanceCtx *IssuanceContext) (*Decision, error)
+
+// Evaluate calls f(ctx, issuanceCtx).
+func (f PolicyFunc) Evaluate(ctx context.Context, issuanceCtx *IssuanceContext) (*Decision, error) {
+	return f(ctx, issuanceCtx)
+}
+
+// policyResponse is the response body expected from the external policy endpoint.
+type policyResponse struct {
+	Decision string                 `json:"decision"`
+	Reason   string                 `json:"reason,omitempty"`
+	Claims   map[string]interface{} `json:"claims,omitempty"`
+}
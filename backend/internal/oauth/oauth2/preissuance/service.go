@@ -0,0 +1,137 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package preissuance
+
+import (
+	"context"
+	"sync"
+
+	"github.com/thunder-id/thunderid/internal/entityprovider"
+	"github.com/thunder-id/thunderid/internal/system/log"
+)
+
+// PreIssuanceServiceInterface defines the interface for the pre-issuance policy hook.
+type PreIssuanceServiceInterface interface {
+	RegisterPolicy(policy PolicyInterface)
+	Evaluate(ctx context.Context, issuanceCtx *IssuanceContext) *Decision
+}
+
+// preIssuanceService implements PreIssuanceServiceInterface.
+type preIssuanceService struct {
+	entityProvider entityprovider.EntityProviderInterface
+	failMode       FailMode
+	mu             sync.RWMutex
+	policies       []PolicyInterface
+	logger         *log.Logger
+}
+
+// newPreIssuanceService creates a new instance of preIssuanceService.
+func newPreIssuanceService(
+	entityProvider entityprovider.EntityProviderInterface,
+	failMode FailMode,
+) *preIssuanceService {
+	return &preIssuanceService{
+		entityProvider: entityProvider,
+		failMode:       failMode,
+		logger:         log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName)),
+	}
+}
+
+// RegisterPolicy adds a policy that is evaluated before access tokens are issued. Policies are evaluated
+// in the order they are registered.
+func (s *preIssuanceService) RegisterPolicy(policy PolicyInterface) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.policies = append(s.policies, policy)
+}
+
+// Evaluate evaluates the registered policies against a token request. The first policy that denies the
+// request decides the outcome. Claims returned by the allowing policies are merged, and claims managed
+// by the token builder are dropped.
+func (s *preIssuanceService) Evaluate(ctx context.Context, issuanceCtx *IssuanceContext) *Decision {
+	s.mu.RLock()
+	policies := s.policies
+	s.mu.RUnlock()
+
+	if len(policies) == 0 {
+		return &Decision{Allow: true}
+	}
+	s.resolveSubject(issuanceCtx)
+
+	claims := make(map[string]interface{})
+	for _, policy := range policies {
+		decision, err := policy.Evaluate(ctx, issuanceCtx)
+		if err != nil {
+			if s.failMode == FailModeClosed {
+				s.logger.Error("Denying token request as a pre-issuance policy failed",
+					log.String("clientId", issuanceCtx.ClientID), log.Error(err))
+				return &Decision{Allow: false, Reason: failClosedReason}
+			}
+			s.logger.Warn("Ignoring a failed pre-issuance policy",
+				log.String("clientId", issuanceCtx.ClientID), log.Error(err))
+			continue
+		}
+		if decision == nil {
+			continue
+		}
+		if !decision.Allow {
+			reason := decision.Reason
+			if reason == "" {
+				reason = defaultDenyReason
+			}
+			s.logger.Debug("Token request denied by a pre-issuance policy",
+				log.String("clientId", issuanceCtx.ClientID), log.String("reason", reason))
+			return &Decision{Allow: false, Reason: reason}
+		}
+		for key, value := range decision.Claims {
+			if _, reserved := reservedClaims[key]; reserved {
+				s.logger.Debug("Dropping reserved claim returned by a pre-issuance policy",
+					log.String("claim", key))
+				continue
+			}
+			claims[key] = value
+		}
+	}
+
+	return &Decision{Allow: true, Claims: claims}
+}
+
+// resolveSubject fills in the type and organization unit of the token subject when they are not known.
+// Subjects that are not local entities, such as subjects of exchanged external tokens, are left as is.
+func (s *preIssuanceService) resolveSubject(issuanceCtx *IssuanceContext) {
+	if s.entityProvider == nil || issuanceCtx.Subject == "" ||
+		(issuanceCtx.EntityType != "" && issuanceCtx.OUID != "") {
+		return
+	}
+
+	entity, epErr := s.entityProvider.GetEntity(issuanceCtx.Subject)
+	if epErr != nil {
+		if epErr.Code != entityprovider.ErrorCodeEntityNotFound {
+			s.logger.Warn("Failed to resolve the token subject for pre-issuance policies",
+				log.String("error", epErr.Description))
+		}
+		return
+	}
+	if issuanceCtx.EntityType == "" {
+		issuanceCtx.EntityType = entity.Type
+	}
+	if issuanceCtx.OUID == "" {
+		issuanceCtx.OUID = entity.OUID
+	}
+}
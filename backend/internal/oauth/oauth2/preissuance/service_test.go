@@ -0,0 +1,158 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package preissuance
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/entityprovider"
+	"github.com/thunder-id/thunderid/tests/mocks/entityprovidermock"
+)
+
+type PreIssuanceServiceTestSuite struct {
+	suite.Suite
+	mockEntityProvider *entityprovidermock.EntityProviderInterfaceMock
+}
+
+func TestPreIssuanceServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(PreIssuanceServiceTestSuite))
+}
+
+func (suite *PreIssuanceServiceTestSuite) SetupTest() {
+	suite.mockEntityProvider = entityprovidermock.NewEntityProviderInterfaceMock(suite.T())
+}
+
+func (suite *PreIssuanceServiceTestSuite) newIssuanceContext() *IssuanceContext {
+	return &IssuanceContext{
+		Subject:   "user-1",
+		ClientID:  "client-1",
+		GrantType: "authorization_code",
+	}
+}
+
+func (suite *PreIssuanceServiceTestSuite) TestEvaluate_NoPolicies() {
+	service := newPreIssuanceService(suite.mockEntityProvider, FailModeClosed)
+
+	decision := service.Evaluate(context.Background(), suite.newIssuanceContext())
+
+	suite.True(decision.Allow)
+	suite.Empty(decision.Claims)
+	suite.mockEntityProvider.AssertNotCalled(suite.T(), "GetEntity", mock.Anything)
+}
+
+func (suite *PreIssuanceServiceTestSuite) TestEvaluate_ResolvesSubjectAndMergesClaims() {
+	suite.mockEntityProvider.On("GetEntity", "user-1").Return(&entityprovider.Entity{
+		ID:   "user-1",
+		Type: "customer",
+		OUID: "ou-1",
+	}, nil)
+	service := newPreIssuanceService(suite.mockEntityProvider, FailModeClosed)
+	service.RegisterPolicy(PolicyFunc(func(_ context.Context, issuanceCtx *IssuanceContext) (*Decision, error) {
+		suite.Equal("customer", issuanceCtx.EntityType)
+		suite.Equal("ou-1", issuanceCtx.OUID)
+		return &Decision{Allow: true, Claims: map[string]interface{}{"plan": "gold", "sub": "other"}}, nil
+	}))
+	service.RegisterPolicy(PolicyFunc(func(context.Context, *IssuanceContext) (*Decision, error) {
+		return &Decision{Allow: true, Claims: map[string]interface{}{"seats": 10}}, nil
+	}))
+
+	decision := service.Evaluate(context.Background(), suite.newIssuanceContext())
+
+	suite.True(decision.Allow)
+	suite.Equal(map[string]interface{}{"plan": "gold", "seats": 10}, decision.Claims)
+}
+
+func (suite *PreIssuanceServiceTestSuite) TestEvaluate_SubjectNotFound() {
+	suite.mockEntityProvider.On("GetEntity", "user-1").Return(nil,
+		entityprovider.NewEntityProviderError(entityprovider.ErrorCodeEntityNotFound, "not found", ""))
+	service := newPreIssuanceService(suite.mockEntityProvider, FailModeClosed)
+	service.RegisterPolicy(PolicyFunc(func(_ context.Context, issuanceCtx *IssuanceContext) (*Decision, error) {
+		suite.Empty(issuanceCtx.OUID)
+		return &Decision{Allow: true}, nil
+	}))
+
+	decision := service.Evaluate(context.Background(), suite.newIssuanceContext())
+
+	suite.True(decision.Allow)
+}
+
+func (suite *PreIssuanceServiceTestSuite) TestEvaluate_DenyStopsEvaluation() {
+	suite.mockEntityProvider.On("GetEntity", "user-1").Return(&entityprovider.Entity{ID: "user-1"}, nil)
+	service := newPreIssuanceService(suite.mockEntityProvider, FailModeOpen)
+	service.RegisterPolicy(PolicyFunc(func(context.Context, *IssuanceContext) (*Decision, error) {
+		return &Decision{Allow: false, Reason: "The subscription is suspended"}, nil
+	}))
+	nextPolicy := NewPolicyInterfaceMock(suite.T())
+	service.RegisterPolicy(nextPolicy)
+
+	decision := service.Evaluate(context.Background(), suite.newIssuanceContext())
+
+	suite.False(decision.Allow)
+	suite.Equal("The subscription is suspended", decision.Reason)
+	nextPolicy.AssertNotCalled(suite.T(), "Evaluate", mock.Anything, mock.Anything)
+}
+
+func (suite *PreIssuanceServiceTestSuite) TestEvaluate_DenyWithoutReason() {
+	suite.mockEntityProvider.On("GetEntity", "user-1").Return(&entityprovider.Entity{ID: "user-1"}, nil)
+	service := newPreIssuanceService(suite.mockEntityProvider, FailModeOpen)
+	service.RegisterPolicy(PolicyFunc(func(context.Context, *IssuanceContext) (*Decision, error) {
+		return &Decision{Allow: false}, nil
+	}))
+
+	decision := service.Evaluate(context.Background(), suite.newIssuanceContext())
+
+	suite.False(decision.Allow)
+	suite.Equal(defaultDenyReason, decision.Reason)
+}
+
+func (suite *PreIssuanceServiceTestSuite) TestEvaluate_PolicyFailure() {
+	testCases := []struct {
+		name     string
+		failMode FailMode
+		allow    bool
+	}{
+		{name: "FailOpen", failMode: FailModeOpen, allow: true},
+		{name: "DefaultFailMode", failMode: "", allow: true},
+		{name: "FailClosed", failMode: FailModeClosed, allow: false},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			issuanceCtx := suite.newIssuanceContext()
+			issuanceCtx.EntityType = "customer"
+			issuanceCtx.OUID = "ou-1"
+			service := newPreIssuanceService(nil, tc.failMode)
+			policy := NewPolicyInterfaceMock(suite.T())
+			policy.On("Evaluate", mock.Anything, issuanceCtx).Return(nil, errors.New("timeout"))
+			service.RegisterPolicy(policy)
+
+			decision := service.Evaluate(context.Background(), issuanceCtx)
+
+			suite.Equal(tc.allow, decision.Allow)
+			if !tc.allow {
+				suite.Equal(failClosedReason, decision.Reason)
+			}
+		})
+	}
+}
@@ -0,0 +1,65 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package protocoltrace
+
+import oauth2const "github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
+
+const (
+	// loggerComponentName is the component name used in protocol trace logs.
+	loggerComponentName = "ProtocolTraceService"
+	// handlerLoggerComponentName is the component name used in protocol trace handler logs.
+	handlerLoggerComponentName = "ProtocolTraceHandler"
+
+	// defaultRetentionPeriod is the default number of seconds a protocol trace is kept.
+	defaultRetentionPeriod = int64(60 * 60)
+
+	// redactedValue replaces the value of a redacted request parameter.
+	redactedValue = "[REDACTED]"
+
+	// tracesPath is the path of the protocol traces endpoint, used to build pagination links.
+	tracesPath = "/diagnostics/oauth-traces"
+	// queryParamClientID is the query parameter filtering protocol traces by client.
+	queryParamClientID = "clientId"
+)
+
+// Types of issued tokens recorded in protocol traces.
+const (
+	issuedTokenTypeAccessToken  = "access_token"
+	issuedTokenTypeRefreshToken = "refresh_token"
+	issuedTokenTypeIDToken      = "id_token"
+)
+
+// redactedParameters holds the request parameters that carry secrets, credentials or personal data.
+// Their values are never stored; the trace only shows that they were present.
+var redactedParameters = map[string]bool{
+	oauth2const.RequestParamClientSecret:    true,
+	oauth2const.RequestParamClientAssertion: true,
+	oauth2const.RequestParamCode:            true,
+	oauth2const.RequestParamCodeVerifier:    true,
+	oauth2const.RequestParamUsername:        true,
+	oauth2const.RequestParamPassword:        true,
+	oauth2const.RequestParamRefreshToken:    true,
+	oauth2const.RequestParamSubjectToken:    true,
+	oauth2const.RequestParamActorToken:      true,
+	oauth2const.RequestParamToken:           true,
+	oauth2const.RequestParamIDTokenHint:     true,
+	oauth2const.Assertion:                   true,
+	"login_hint":                            true,
+	"request":                               true,
+}
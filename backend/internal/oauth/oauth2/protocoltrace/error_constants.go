@@ -0,0 +1,54 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package protocoltrace
+
+import (
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/i18n/core"
+)
+
+// Client errors for protocol trace operations.
+var (
+	// ErrorInvalidLimit is the error returned when the limit parameter is invalid.
+	ErrorInvalidLimit = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "PTR-1001",
+		Error: core.I18nMessage{
+			Key:          "error.protocoltraceservice.invalid_limit_parameter",
+			DefaultValue: "Invalid limit parameter",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.protocoltraceservice.invalid_limit_parameter_description",
+			DefaultValue: "The limit parameter must be a positive integer",
+		},
+	}
+	// ErrorInvalidOffset is the error returned when the offset parameter is invalid.
+	ErrorInvalidOffset = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "PTR-1002",
+		Error: core.I18nMessage{
+			Key:          "error.protocoltraceservice.invalid_offset_parameter",
+			DefaultValue: "Invalid offset parameter",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.protocoltraceservice.invalid_offset_parameter_description",
+			DefaultValue: "The offset parameter must be a non-negative integer",
+		},
+	}
+)
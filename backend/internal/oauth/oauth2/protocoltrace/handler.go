@@ -0,0 +1,70 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package protocoltrace
+
+import (
+	"net/http"
+
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	sysutils "github.com/thunder-id/thunderid/internal/system/utils"
+)
+
+// protocolTraceHandler is the handler for protocol trace operations.
+type protocolTraceHandler struct {
+	protocolTraceService ProtocolTraceServiceInterface
+}
+
+// newProtocolTraceHandler creates a new instance of protocolTraceHandler.
+func newProtocolTraceHandler(protocolTraceService ProtocolTraceServiceInterface) *protocolTraceHandler {
+	return &protocolTraceHandler{
+		protocolTraceService: protocolTraceService,
+	}
+}
+
+// HandleListRequest handles the request to list the captured protocol traces, optionally filtered by the
+// client given in the clientId query parameter.
+func (h *protocolTraceHandler) HandleListRequest(w http.ResponseWriter, r *http.Request) {
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, handlerLoggerComponentName))
+
+	query := r.URL.Query()
+	pagination, svcErr := sysutils.ParsePaginationParams(query, &ErrorInvalidLimit, &ErrorInvalidOffset)
+	if svcErr != nil {
+		sysutils.WriteServiceErrorResponse(w, svcErr, clientErrorStatusCodes)
+		return
+	}
+	clientID := sysutils.SanitizeString(query.Get(queryParamClientID))
+
+	traces, svcErr := h.protocolTraceService.ListTraces(
+		sysutils.WithSkipCount(r.Context(), pagination.SkipCount), clientID, pagination.Limit, pagination.Offset)
+	if svcErr != nil {
+		sysutils.WriteServiceErrorResponse(w, svcErr, clientErrorStatusCodes)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(w, http.StatusOK, traces)
+	logger.Debug("Protocol trace list response sent", log.String("clientID", clientID),
+		log.Int("count", traces.Count))
+}
+
+// clientErrorStatusCodes maps the client errors of the handler to HTTP status codes. Other client errors are
+// returned with 400 Bad Request.
+var clientErrorStatusCodes = map[string]int{
+	serviceerror.ErrorUnauthorized.Code: http.StatusForbidden,
+}
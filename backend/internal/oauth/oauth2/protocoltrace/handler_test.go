@@ -0,0 +1,97 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package protocoltrace
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/system/error/apierror"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+)
+
+type HandlerTestSuite struct {
+	suite.Suite
+	mockService *ProtocolTraceServiceInterfaceMock
+	handler     *protocolTraceHandler
+}
+
+func TestHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(HandlerTestSuite))
+}
+
+func (s *HandlerTestSuite) SetupTest() {
+	s.mockService = NewProtocolTraceServiceInterfaceMock(s.T())
+	s.handler = newProtocolTraceHandler(s.mockService)
+}
+
+func (s *HandlerTestSuite) TestHandleListRequest() {
+	s.mockService.On("ListTraces", mock.Anything, testClientID, 5, 10).Return(&ProtocolTraceListResponse{
+		TotalResults: 1,
+		Count:        1,
+		Traces:       []ProtocolTrace{{ID: "trace-1", ClientID: testClientID}},
+	}, nil).Once()
+
+	rr := httptest.NewRecorder()
+	s.handler.HandleListRequest(rr, httptest.NewRequest(http.MethodGet,
+		"/diagnostics/oauth-traces?clientId="+testClientID+"&limit=5&offset=10", nil))
+
+	s.Equal(http.StatusOK, rr.Code)
+	var body ProtocolTraceListResponse
+	s.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &body))
+	s.Equal("trace-1", body.Traces[0].ID)
+}
+
+func (s *HandlerTestSuite) TestHandleListRequest_InvalidLimit() {
+	rr := httptest.NewRecorder()
+	s.handler.HandleListRequest(rr, httptest.NewRequest(http.MethodGet, "/diagnostics/oauth-traces?limit=0", nil))
+
+	s.Equal(http.StatusBadRequest, rr.Code)
+	var body apierror.ErrorResponse
+	s.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &body))
+	s.Equal(ErrorInvalidLimit.Code, body.Code)
+}
+
+func (s *HandlerTestSuite) TestHandleListRequest_ErrorStatusCodes() {
+	testCases := []struct {
+		name       string
+		svcErr     *serviceerror.ServiceError
+		statusCode int
+	}{
+		{"Unauthorized", &serviceerror.ErrorUnauthorized, http.StatusForbidden},
+		{"InternalError", &serviceerror.InternalServerError, http.StatusInternalServerError},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.mockService.On("ListTraces", mock.Anything, "", mock.Anything, mock.Anything).
+				Return(nil, tc.svcErr).Once()
+
+			rr := httptest.NewRecorder()
+			s.handler.HandleListRequest(rr, httptest.NewRequest(http.MethodGet, "/diagnostics/oauth-traces", nil))
+
+			s.Equal(tc.statusCode, rr.Code)
+		})
+	}
+}
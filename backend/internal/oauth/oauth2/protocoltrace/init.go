@@ -0,0 +1,57 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package protocoltrace
+
+import (
+	"net/http"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/middleware"
+	"github.com/thunder-id/thunderid/internal/system/sysauthz"
+)
+
+// Initialize initializes the protocol trace service and registers its routes.
+func Initialize(
+	mux *http.ServeMux,
+	authzService sysauthz.SystemAuthorizationServiceInterface,
+) ProtocolTraceServiceInterface {
+	protocolTraceService := newProtocolTraceService(newProtocolTraceStore(), authzService,
+		config.GetServerRuntime().Config.OAuth.ProtocolTrace.RetentionPeriod)
+
+	protocolTraceHandler := newProtocolTraceHandler(protocolTraceService)
+	registerRoutes(mux, protocolTraceHandler)
+
+	return protocolTraceService
+}
+
+// registerRoutes registers the routes for protocol trace operations.
+func registerRoutes(mux *http.ServeMux, protocolTraceHandler *protocolTraceHandler) {
+	opts := middleware.CORSOptions{
+		AllowedMethods:   []string{"GET"},
+		AllowedHeaders:   middleware.DefaultAllowedHeaders,
+		AllowCredentials: true,
+		MaxAge:           600,
+	}
+	mux.HandleFunc(middleware.WithCORS("GET /diagnostics/oauth-traces",
+		protocolTraceHandler.HandleListRequest, opts))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS /diagnostics/oauth-traces",
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, opts))
+}
@@ -0,0 +1,78 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package protocoltrace captures redacted OAuth protocol messages of clients that opted in to protocol
+// tracing, and keeps them for a short period to help troubleshoot client integrations.
+package protocoltrace
+
+import (
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/system/utils"
+)
+
+// MessageType is the type of a captured protocol message.
+type MessageType string
+
+const (
+	// MessageTypeAuthorizationRequest is a request to the authorization endpoint.
+	MessageTypeAuthorizationRequest MessageType = "authorization_request"
+	// MessageTypeTokenRequest is a request to the token endpoint.
+	MessageTypeTokenRequest MessageType = "token_request"
+)
+
+// ProtocolTrace represents a captured protocol message and its outcome.
+// Secrets and personal data in the request parameters are redacted, and issued tokens are
+// represented by their metadata only.
+type ProtocolTrace struct {
+	ID               string              `json:"id"`
+	ClientID         string              `json:"clientId"`
+	MessageType      MessageType         `json:"messageType"`
+	Parameters       map[string][]string `json:"parameters,omitempty"`
+	Error            string              `json:"error,omitempty"`
+	ErrorDescription string              `json:"errorDescription,omitempty"`
+	IssuedTokens     []IssuedToken       `json:"issuedTokens,omitempty"`
+	CreatedAt        time.Time           `json:"createdAt"`
+	ExpiresAt        time.Time           `json:"expiresAt"`
+}
+
+// IssuedToken holds the metadata of a token issued in response to a protocol message.
+type IssuedToken struct {
+	Type            string `json:"type"`
+	TokenType       string `json:"tokenType,omitempty"`
+	IssuedTokenType string `json:"issuedTokenType,omitempty"`
+	ExpiresIn       int64  `json:"expiresIn,omitempty"`
+	Scope           string `json:"scope,omitempty"`
+}
+
+// ProtocolTraceListResponse represents the response for listing protocol traces with pagination.
+type ProtocolTraceListResponse struct {
+	TotalResults int             `json:"totalResults"`
+	StartIndex   int             `json:"startIndex"`
+	Count        int             `json:"count"`
+	Traces       []ProtocolTrace `json:"traces"`
+	Links        []utils.Link    `json:"links"`
+}
+
+// traceData is the persisted payload of a protocol trace.
+type traceData struct {
+	Parameters       map[string][]string `json:"parameters,omitempty"`
+	Error            string              `json:"error,omitempty"`
+	ErrorDescription string              `json:"errorDescription,omitempty"`
+	IssuedTokens     []IssuedToken       `json:"issuedTokens,omitempty"`
+}
@@ -0,0 +1,229 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package protocoltrace
+
+import (
+	"context"
+	"net/url"
+	"slices"
+	"time"
+
+	inboundmodel "github.com/thunder-id/thunderid/internal/inboundclient/model"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/model"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/internal/system/security"
+	"github.com/thunder-id/thunderid/internal/system/sysauthz"
+	"github.com/thunder-id/thunderid/internal/system/utils"
+)
+
+// ProtocolTraceServiceInterface defines the interface for the protocol trace service.
+type ProtocolTraceServiceInterface interface {
+	RecordAuthorizationRequest(ctx context.Context, client *inboundmodel.OAuthClient, params map[string]string,
+		errorCode, errorDescription string)
+	RecordTokenRequest(ctx context.Context, client *inboundmodel.OAuthClient, params url.Values,
+		response *model.TokenResponse, errorCode, errorDescription string)
+	ListTraces(ctx context.Context, clientID string, limit, offset int) (
+		*ProtocolTraceListResponse, *serviceerror.ServiceError)
+}
+
+// protocolTraceService is the default implementation of the ProtocolTraceServiceInterface.
+type protocolTraceService struct {
+	store           protocolTraceStoreInterface
+	authzService    sysauthz.SystemAuthorizationServiceInterface
+	retentionPeriod int64
+	now             func() time.Time
+	logger          *log.Logger
+}
+
+// newProtocolTraceService creates a new instance of protocolTraceService.
+func newProtocolTraceService(
+	store protocolTraceStoreInterface,
+	authzService sysauthz.SystemAuthorizationServiceInterface,
+	retentionPeriod int64,
+) ProtocolTraceServiceInterface {
+	if retentionPeriod <= 0 {
+		retentionPeriod = defaultRetentionPeriod
+	}
+
+	return &protocolTraceService{
+		store:           store,
+		authzService:    authzService,
+		retentionPeriod: retentionPeriod,
+		now:             time.Now,
+		logger:          log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName)),
+	}
+}
+
+// RecordAuthorizationRequest captures an authorization request of a client that enabled protocol tracing,
+// together with the error it was rejected with, if any. Clients without protocol tracing are ignored.
+func (s *protocolTraceService) RecordAuthorizationRequest(
+	ctx context.Context, client *inboundmodel.OAuthClient, params map[string]string,
+	errorCode, errorDescription string,
+) {
+	if client == nil || !client.ProtocolTraceEnabled {
+		return
+	}
+
+	values := make(url.Values, len(params))
+	for key, value := range params {
+		values.Set(key, value)
+	}
+	s.record(ctx, ProtocolTrace{
+		ClientID:         client.ClientID,
+		MessageType:      MessageTypeAuthorizationRequest,
+		Parameters:       redactParameters(values),
+		Error:            errorCode,
+		ErrorDescription: errorDescription,
+	})
+}
+
+// RecordTokenRequest captures a token request of a client that enabled protocol tracing, together with
+// the metadata of the issued tokens or the error the request failed with. Clients without protocol tracing
+// are ignored.
+func (s *protocolTraceService) RecordTokenRequest(
+	ctx context.Context, client *inboundmodel.OAuthClient, params url.Values,
+	response *model.TokenResponse, errorCode, errorDescription string,
+) {
+	if client == nil || !client.ProtocolTraceEnabled {
+		return
+	}
+
+	s.record(ctx, ProtocolTrace{
+		ClientID:         client.ClientID,
+		MessageType:      MessageTypeTokenRequest,
+		Parameters:       redactParameters(params),
+		Error:            errorCode,
+		ErrorDescription: errorDescription,
+		IssuedTokens:     buildIssuedTokens(response),
+	})
+}
+
+// record stores a protocol trace. Failures are logged and never affect the traced request.
+func (s *protocolTraceService) record(ctx context.Context, trace ProtocolTrace) {
+	id, err := utils.GenerateUUIDv7()
+	if err != nil {
+		s.logger.Error("Failed to generate protocol trace ID", log.Error(err))
+		return
+	}
+
+	now := s.now().UTC()
+	trace.ID = id
+	trace.CreatedAt = now
+	trace.ExpiresAt = now.Add(time.Duration(s.retentionPeriod) * time.Second)
+
+	if err := s.store.CreateTrace(ctx, trace); err != nil {
+		s.logger.Error("Failed to store protocol trace", log.String("clientID", trace.ClientID),
+			log.Error(err))
+		return
+	}
+	s.logger.Debug("Recorded protocol trace", log.String("clientID", trace.ClientID),
+		log.String("messageType", string(trace.MessageType)))
+}
+
+// ListTraces lists the unexpired protocol traces, most recent first. When clientID is not empty, only the
+// traces of that client are listed.
+func (s *protocolTraceService) ListTraces(ctx context.Context, clientID string, limit, offset int) (
+	*ProtocolTraceListResponse, *serviceerror.ServiceError) {
+	allowed, svcErr := s.authzService.IsActionAllowed(ctx, security.ActionReadOAuthTraces,
+		&sysauthz.ActionContext{ResourceID: clientID})
+	if svcErr != nil {
+		s.logger.Error("Failed to check authorization for action",
+			log.String("action", string(security.ActionReadOAuthTraces)), log.Any("error", svcErr))
+		return nil, &serviceerror.InternalServerError
+	}
+	if !allowed {
+		return nil, &serviceerror.ErrorUnauthorized
+	}
+
+	now := s.now().UTC()
+	totalCount, fetchLimit := utils.TotalCountUnknown, limit+1
+	if !utils.IsCountSkipped(ctx) {
+		var err error
+		totalCount, err = s.store.CountTraces(ctx, clientID, now)
+		if err != nil {
+			s.logger.Error("Failed to count protocol traces", log.Error(err))
+			return nil, &serviceerror.InternalServerError
+		}
+		fetchLimit = limit
+	}
+
+	traces, err := s.store.ListTraces(ctx, clientID, now, fetchLimit, offset)
+	if err != nil {
+		s.logger.Error("Failed to list protocol traces", log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+	traces, hasMore := utils.TrimPage(traces, limit)
+
+	extraQuery := ""
+	if clientID != "" {
+		extraQuery = "&" + queryParamClientID + "=" + url.QueryEscape(clientID)
+	}
+
+	return &ProtocolTraceListResponse{
+		TotalResults: totalCount,
+		StartIndex:   offset + 1,
+		Count:        len(traces),
+		Traces:       traces,
+		Links:        utils.BuildListPaginationLinks(tracesPath, limit, offset, totalCount, hasMore, extraQuery),
+	}, nil
+}
+
+// redactParameters returns a copy of the request parameters in which the values of parameters carrying
+// secrets, credentials or personal data are replaced with a placeholder.
+func redactParameters(params url.Values) map[string][]string {
+	if len(params) == 0 {
+		return nil
+	}
+
+	redacted := make(map[string][]string, len(params))
+	for key, values := range params {
+		if redactedParameters[key] {
+			redacted[key] = []string{redactedValue}
+			continue
+		}
+		redacted[key] = slices.Clone(values)
+	}
+	return redacted
+}
+
+// buildIssuedTokens returns the metadata of the tokens in a token response. Token values are never kept.
+func buildIssuedTokens(response *model.TokenResponse) []IssuedToken {
+	if response == nil {
+		return nil
+	}
+
+	var tokens []IssuedToken
+	if response.AccessToken != "" {
+		tokens = append(tokens, IssuedToken{
+			Type:            issuedTokenTypeAccessToken,
+			TokenType:       response.TokenType,
+			IssuedTokenType: response.IssuedTokenType,
+			ExpiresIn:       response.ExpiresIn,
+			Scope:           response.Scope,
+		})
+	}
+	if response.RefreshToken != "" {
+		tokens = append(tokens, IssuedToken{Type: issuedTokenTypeRefreshToken})
+	}
+	if response.IDToken != "" {
+		tokens = append(tokens, IssuedToken{Type: issuedTokenTypeIDToken})
+	}
+	return tokens
+}
@@ -0,0 +1,197 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package protocoltrace
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	inboundmodel "github.com/thunder-id/thunderid/internal/inboundclient/model"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/model"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/security"
+	"github.com/thunder-id/thunderid/internal/system/utils"
+	"github.com/thunder-id/thunderid/tests/mocks/sysauthzmock"
+)
+
+const testClientID = "client-1"
+
+type ProtocolTraceServiceTestSuite struct {
+	suite.Suite
+	mockStore *protocolTraceStoreInterfaceMock
+	mockAuthz *sysauthzmock.SystemAuthorizationServiceInterfaceMock
+	service   *protocolTraceService
+	client    *inboundmodel.OAuthClient
+	now       time.Time
+}
+
+func TestProtocolTraceServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(ProtocolTraceServiceTestSuite))
+}
+
+func (suite *ProtocolTraceServiceTestSuite) SetupTest() {
+	suite.mockStore = newProtocolTraceStoreInterfaceMock(suite.T())
+	suite.mockAuthz = sysauthzmock.NewSystemAuthorizationServiceInterfaceMock(suite.T())
+	suite.service = newProtocolTraceService(suite.mockStore, suite.mockAuthz, 600).(*protocolTraceService)
+	suite.now = time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	suite.service.now = func() time.Time { return suite.now }
+	suite.client = &inboundmodel.OAuthClient{ClientID: testClientID, ProtocolTraceEnabled: true}
+}
+
+func (suite *ProtocolTraceServiceTestSuite) TestNewProtocolTraceService_DefaultRetention() {
+	service := newProtocolTraceService(suite.mockStore, suite.mockAuthz, 0).(*protocolTraceService)
+	suite.Equal(defaultRetentionPeriod, service.retentionPeriod)
+}
+
+func (suite *ProtocolTraceServiceTestSuite) TestRecordAuthorizationRequest_RedactsParameters() {
+	var stored ProtocolTrace
+	suite.mockStore.On("CreateTrace", mock.Anything, mock.AnythingOfType("protocoltrace.ProtocolTrace")).
+		Run(func(args mock.Arguments) { stored = args.Get(1).(ProtocolTrace) }).Return(nil).Once()
+
+	suite.service.RecordAuthorizationRequest(context.Background(), suite.client, map[string]string{
+		"client_id":     testClientID,
+		"response_type": "code",
+		"login_hint":    "alice@example.com",
+	}, "invalid_request", "Invalid redirect_uri")
+
+	suite.NotEmpty(stored.ID)
+	suite.Equal(testClientID, stored.ClientID)
+	suite.Equal(MessageTypeAuthorizationRequest, stored.MessageType)
+	suite.Equal([]string{"code"}, stored.Parameters["response_type"])
+	suite.Equal([]string{redactedValue}, stored.Parameters["login_hint"])
+	suite.Equal("invalid_request", stored.Error)
+	suite.Equal("Invalid redirect_uri", stored.ErrorDescription)
+	suite.Equal(suite.now, stored.CreatedAt)
+	suite.Equal(suite.now.Add(600*time.Second), stored.ExpiresAt)
+}
+
+func (suite *ProtocolTraceServiceTestSuite) TestRecord_IgnoresClientsWithoutTracing() {
+	client := &inboundmodel.OAuthClient{ClientID: testClientID}
+
+	suite.service.RecordAuthorizationRequest(context.Background(), client, map[string]string{}, "", "")
+	suite.service.RecordTokenRequest(context.Background(), client, url.Values{}, nil, "", "")
+	suite.service.RecordTokenRequest(context.Background(), nil, url.Values{}, nil, "", "")
+
+	suite.mockStore.AssertNotCalled(suite.T(), "CreateTrace", mock.Anything, mock.Anything)
+}
+
+func (suite *ProtocolTraceServiceTestSuite) TestRecordTokenRequest_KeepsOnlyTokenMetadata() {
+	var stored ProtocolTrace
+	suite.mockStore.On("CreateTrace", mock.Anything, mock.AnythingOfType("protocoltrace.ProtocolTrace")).
+		Run(func(args mock.Arguments) { stored = args.Get(1).(ProtocolTrace) }).Return(nil).Once()
+
+	suite.service.RecordTokenRequest(context.Background(), suite.client, url.Values{
+		"grant_type":    {"authorization_code"},
+		"code":          {"secret-code"},
+		"code_verifier": {"secret-verifier"},
+		"redirect_uri":  {"https://app.example.com/callback"},
+	}, &model.TokenResponse{
+		AccessToken:  "access-token-value",
+		TokenType:    "Bearer",
+		ExpiresIn:    3600,
+		Scope:        "openid",
+		RefreshToken: "refresh-token-value",
+		IDToken:      "id-token-value",
+	}, "", "")
+
+	suite.Equal(MessageTypeTokenRequest, stored.MessageType)
+	suite.Equal([]string{"authorization_code"}, stored.Parameters["grant_type"])
+	suite.Equal([]string{redactedValue}, stored.Parameters["code"])
+	suite.Equal([]string{redactedValue}, stored.Parameters["code_verifier"])
+	suite.Equal([]IssuedToken{
+		{Type: issuedTokenTypeAccessToken, TokenType: "Bearer", ExpiresIn: 3600, Scope: "openid"},
+		{Type: issuedTokenTypeRefreshToken},
+		{Type: issuedTokenTypeIDToken},
+	}, stored.IssuedTokens)
+}
+
+func (suite *ProtocolTraceServiceTestSuite) TestRecordTokenRequest_StoreErrorIsIgnored() {
+	suite.mockStore.On("CreateTrace", mock.Anything, mock.Anything).Return(errors.New("db error")).Once()
+
+	suite.NotPanics(func() {
+		suite.service.RecordTokenRequest(context.Background(), suite.client, url.Values{}, nil,
+			"invalid_grant", "Invalid authorization code")
+	})
+}
+
+func (suite *ProtocolTraceServiceTestSuite) TestListTraces_Success() {
+	suite.mockAuthz.On("IsActionAllowed", mock.Anything, security.ActionReadOAuthTraces, mock.Anything).
+		Return(true, nil).Once()
+	suite.mockStore.On("CountTraces", mock.Anything, testClientID, suite.now).Return(3, nil).Once()
+	suite.mockStore.On("ListTraces", mock.Anything, testClientID, suite.now, 2, 0).
+		Return([]ProtocolTrace{{ID: "trace-1"}, {ID: "trace-2"}}, nil).Once()
+
+	response, svcErr := suite.service.ListTraces(context.Background(), testClientID, 2, 0)
+
+	suite.Nil(svcErr)
+	suite.Equal(3, response.TotalResults)
+	suite.Equal(2, response.Count)
+	suite.Equal(1, response.StartIndex)
+	suite.NotEmpty(response.Links)
+	suite.Contains(response.Links[0].Href, "clientId="+testClientID)
+}
+
+func (suite *ProtocolTraceServiceTestSuite) TestListTraces_SkipCount() {
+	suite.mockAuthz.On("IsActionAllowed", mock.Anything, security.ActionReadOAuthTraces, mock.Anything).
+		Return(true, nil).Once()
+	suite.mockStore.On("ListTraces", mock.Anything, "", suite.now, 2, 0).
+		Return([]ProtocolTrace{{ID: "trace-1"}}, nil).Once()
+
+	response, svcErr := suite.service.ListTraces(utils.WithSkipCount(context.Background(), true), "", 1, 0)
+
+	suite.Nil(svcErr)
+	suite.Equal(utils.TotalCountUnknown, response.TotalResults)
+	suite.Equal(1, response.Count)
+	suite.mockStore.AssertNotCalled(suite.T(), "CountTraces", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *ProtocolTraceServiceTestSuite) TestListTraces_Unauthorized() {
+	suite.mockAuthz.On("IsActionAllowed", mock.Anything, security.ActionReadOAuthTraces, mock.Anything).
+		Return(false, nil).Once()
+
+	response, svcErr := suite.service.ListTraces(context.Background(), "", 10, 0)
+
+	suite.Nil(response)
+	suite.Equal(&serviceerror.ErrorUnauthorized, svcErr)
+}
+
+func (suite *ProtocolTraceServiceTestSuite) TestListTraces_AuthorizationError() {
+	suite.mockAuthz.On("IsActionAllowed", mock.Anything, security.ActionReadOAuthTraces, mock.Anything).
+		Return(false, &serviceerror.InternalServerError).Once()
+
+	_, svcErr := suite.service.ListTraces(context.Background(), "", 10, 0)
+
+	suite.Equal(&serviceerror.InternalServerError, svcErr)
+}
+
+func (suite *ProtocolTraceServiceTestSuite) TestListTraces_StoreError() {
+	suite.mockAuthz.On("IsActionAllowed", mock.Anything, security.ActionReadOAuthTraces, mock.Anything).
+		Return(true, nil).Once()
+	suite.mockStore.On("CountTraces", mock.Anything, "", suite.now).Return(0, errors.New("db error")).Once()
+
+	_, svcErr := suite.service.ListTraces(context.Background(), "", 10, 0)
+
+	suite.Equal(&serviceerror.InternalServerError, svcErr)
+}
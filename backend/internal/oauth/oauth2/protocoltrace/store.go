@@ -0,0 +1,193 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package protocoltrace
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+	sysContext "github.com/thunder-id/thunderid/internal/system/context"
+	"github.com/thunder-id/thunderid/internal/system/database/provider"
+	dbutils "github.com/thunder-id/thunderid/internal/system/database/utils"
+)
+
+var getDBProvider = provider.GetDBProvider
+
+// protocolTraceStoreInterface defines the interface for protocol trace store operations.
+type protocolTraceStoreInterface interface {
+	CreateTrace(ctx context.Context, trace ProtocolTrace) error
+	ListTraces(ctx context.Context, clientID string, now time.Time, limit, offset int) ([]ProtocolTrace, error)
+	CountTraces(ctx context.Context, clientID string, now time.Time) (int, error)
+}
+
+// protocolTraceStore is the runtime database backed implementation of protocolTraceStoreInterface.
+type protocolTraceStore struct {
+	dbProvider   provider.DBProviderInterface
+	deploymentID string
+}
+
+// newProtocolTraceStore creates a new instance of protocolTraceStore.
+func newProtocolTraceStore() protocolTraceStoreInterface {
+	return &protocolTraceStore{
+		dbProvider:   getDBProvider(),
+		deploymentID: config.GetServerRuntime().Config.Server.Identifier,
+	}
+}
+
+// CreateTrace persists a new protocol trace.
+func (s *protocolTraceStore) CreateTrace(ctx context.Context, trace ProtocolTrace) error {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
+	dbClient, err := s.dbProvider.GetRuntimeDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	data, err := json.Marshal(traceData{
+		Parameters:       trace.Parameters,
+		Error:            trace.Error,
+		ErrorDescription: trace.ErrorDescription,
+		IssuedTokens:     trace.IssuedTokens,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to marshal trace data: %w", err)
+	}
+
+	if _, err := dbClient.ExecuteContext(ctx, queryCreateProtocolTrace, trace.ID, trace.ClientID,
+		string(trace.MessageType), string(data), trace.CreatedAt, trace.ExpiresAt, deploymentID); err != nil {
+		return fmt.Errorf("failed to execute query: %w", err)
+	}
+	return nil
+}
+
+// ListTraces retrieves the protocol traces that have not expired at the given time, most recent first.
+// When clientID is not empty, only the traces of that client are returned.
+func (s *protocolTraceStore) ListTraces(
+	ctx context.Context, clientID string, now time.Time, limit, offset int,
+) ([]ProtocolTrace, error) {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
+	dbClient, err := s.dbProvider.GetRuntimeDBClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	var results []map[string]interface{}
+	if clientID != "" {
+		results, err = dbClient.QueryContext(ctx, queryListProtocolTracesByClient, clientID, now, deploymentID,
+			limit, offset)
+	} else {
+		results, err = dbClient.QueryContext(ctx, queryListProtocolTraces, now, deploymentID, limit, offset)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	traces := make([]ProtocolTrace, 0, len(results))
+	for _, row := range results {
+		trace, err := buildProtocolTraceFromResultRow(row)
+		if err != nil {
+			return nil, err
+		}
+		traces = append(traces, *trace)
+	}
+	return traces, nil
+}
+
+// CountTraces counts the protocol traces that have not expired at the given time.
+// When clientID is not empty, only the traces of that client are counted.
+func (s *protocolTraceStore) CountTraces(ctx context.Context, clientID string, now time.Time) (int, error) {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
+	dbClient, err := s.dbProvider.GetRuntimeDBClient()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	var results []map[string]interface{}
+	if clientID != "" {
+		results, err = dbClient.QueryContext(ctx, queryCountProtocolTracesByClient, clientID, now, deploymentID)
+	} else {
+		results, err = dbClient.QueryContext(ctx, queryCountProtocolTraces, now, deploymentID)
+	}
+	if err != nil {
+		return 0, fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	if len(results) > 0 {
+		switch total := results[0]["total"].(type) {
+		case int64:
+			return int(total), nil
+		case float64:
+			return int(total), nil
+		}
+	}
+	return 0, nil
+}
+
+// buildProtocolTraceFromResultRow constructs a ProtocolTrace from a database result row.
+func buildProtocolTraceFromResultRow(row map[string]interface{}) (*ProtocolTrace, error) {
+	id, ok := row["id"].(string)
+	if !ok {
+		return nil, fmt.Errorf("failed to parse id as string")
+	}
+	clientID, ok := row["client_id"].(string)
+	if !ok {
+		return nil, fmt.Errorf("failed to parse client_id as string")
+	}
+	messageType, ok := row["message_type"].(string)
+	if !ok {
+		return nil, fmt.Errorf("failed to parse message_type as string")
+	}
+
+	var dataJSON []byte
+	switch v := row["trace_data"].(type) {
+	case string:
+		dataJSON = []byte(v)
+	case []byte:
+		dataJSON = v
+	default:
+		return nil, fmt.Errorf("failed to parse trace_data")
+	}
+	var data traceData
+	if err := json.Unmarshal(dataJSON, &data); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal trace_data: %w", err)
+	}
+
+	createdAt, err := dbutils.ParseTimeField(row["created_at"], "created_at")
+	if err != nil {
+		return nil, err
+	}
+	expiresAt, err := dbutils.ParseTimeField(row["expiry_time"], "expiry_time")
+	if err != nil {
+		return nil, err
+	}
+
+	return &ProtocolTrace{
+		ID:               id,
+		ClientID:         clientID,
+		MessageType:      MessageType(messageType),
+		Parameters:       data.Parameters,
+		Error:            data.Error,
+		ErrorDescription: data.ErrorDescription,
+		IssuedTokens:     data.IssuedTokens,
+		CreatedAt:        createdAt,
+		ExpiresAt:        expiresAt,
+	}, nil
+}
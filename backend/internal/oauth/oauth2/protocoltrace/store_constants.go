@@ -0,0 +1,60 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package protocoltrace
+
+import dbmodel "github.com/thunder-id/thunderid/internal/system/database/model"
+
+var (
+	// queryCreateProtocolTrace inserts a new protocol trace.
+	queryCreateProtocolTrace = dbmodel.DBQuery{
+		ID: "PTQ-TRACE_MGT-01",
+		Query: `INSERT INTO "OAUTH_PROTOCOL_TRACE" (ID, CLIENT_ID, MESSAGE_TYPE, TRACE_DATA, CREATED_AT, ` +
+			`EXPIRY_TIME, DEPLOYMENT_ID) VALUES ($1, $2, $3, $4, $5, $6, $7)`,
+	}
+
+	// queryListProtocolTraces retrieves unexpired protocol traces, most recent first.
+	queryListProtocolTraces = dbmodel.DBQuery{
+		ID: "PTQ-TRACE_MGT-02",
+		Query: `SELECT ID, CLIENT_ID, MESSAGE_TYPE, TRACE_DATA, CREATED_AT, EXPIRY_TIME ` +
+			`FROM "OAUTH_PROTOCOL_TRACE" WHERE EXPIRY_TIME > $1 AND DEPLOYMENT_ID = $2 ` +
+			`ORDER BY CREATED_AT DESC LIMIT $3 OFFSET $4`,
+	}
+
+	// queryListProtocolTracesByClient retrieves the unexpired protocol traces of a client, most recent first.
+	queryListProtocolTracesByClient = dbmodel.DBQuery{
+		ID: "PTQ-TRACE_MGT-03",
+		Query: `SELECT ID, CLIENT_ID, MESSAGE_TYPE, TRACE_DATA, CREATED_AT, EXPIRY_TIME ` +
+			`FROM "OAUTH_PROTOCOL_TRACE" WHERE CLIENT_ID = $1 AND EXPIRY_TIME > $2 AND DEPLOYMENT_ID = $3 ` +
+			`ORDER BY CREATED_AT DESC LIMIT $4 OFFSET $5`,
+	}
+
+	// queryCountProtocolTraces counts the unexpired protocol traces.
+	queryCountProtocolTraces = dbmodel.DBQuery{
+		ID: "PTQ-TRACE_MGT-04",
+		Query: `SELECT COUNT(*) AS total FROM "OAUTH_PROTOCOL_TRACE" ` +
+			`WHERE EXPIRY_TIME > $1 AND DEPLOYMENT_ID = $2`,
+	}
+
+	// queryCountProtocolTracesByClient counts the unexpired protocol traces of a client.
+	queryCountProtocolTracesByClient = dbmodel.DBQuery{
+		ID: "PTQ-TRACE_MGT-05",
+		Query: `SELECT COUNT(*) AS total FROM "OAUTH_PROTOCOL_TRACE" ` +
+			`WHERE CLIENT_ID = $1 AND EXPIRY_TIME > $2 AND DEPLOYMENT_ID = $3`,
+	}
+)
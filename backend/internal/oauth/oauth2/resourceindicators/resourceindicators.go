@@ -87,8 +87,9 @@ func ResolveResourceServers(
 // returns the subset of requestedScopes that are defined as permissions on at least one resolved
 // RS (RFC 6749 §3.3). Unknown identifiers surface as invalid_target (RFC 8707 §2.2); scopes not
 // defined on any RS are silently dropped. The downscoped slice preserves the order of
-// requestedScopes. When resources is empty or requestedScopes is empty, scopes are returned
-// unchanged.
+// requestedScopes, followed by the permissions implied by them through the scope implication
+// rules of the resolved RSes. When resources is empty or requestedScopes is empty, scopes are
+// returned unchanged.
 func ResolveAndDownscope(
 	ctx context.Context,
 	resourceService resource.ResourceServiceInterface,
@@ -119,12 +120,20 @@ func ResolveAndDownscope(
 			delete(allowed, s)
 		}
 	}
+	for _, s := range UnionScopes(rsValidScopes) {
+		if _, ok := allowed[s]; ok {
+			downscoped = append(downscoped, s)
+			delete(allowed, s)
+		}
+	}
 	return resolvedRSes, downscoped, nil
 }
 
 // ComputeRSValidScopes returns, for each resolved Resource Server, the subset of requested
-// scopes that are defined as permissions on that RS. Scopes not defined on any RS are absent
-// from the union of the per-RS slices (downscoping per RFC 6749 §3.3).
+// scopes that are defined as permissions on that RS. When the RS has scope implication rules or a
+// wildcard scope is requested, the requested scopes are first expanded with the scopes they imply.
+// Scopes not defined on any RS are absent from the union of the per-RS slices (downscoping per
+// RFC 6749 §3.3).
 func ComputeRSValidScopes(
 	ctx context.Context,
 	resourceService resource.ResourceServiceInterface,
@@ -136,7 +145,18 @@ func ComputeRSValidScopes(
 		return rsValidScopes, nil
 	}
 	for _, rs := range resolvedRSes {
-		invalid, valErr := resourceService.ValidatePermissions(ctx, rs.ID, requestedScopes)
+		scopes := requestedScopes
+		if resource.RequiresScopeExpansion(rs, requestedScopes) {
+			expanded, expErr := resourceService.ExpandScopes(ctx, rs, requestedScopes)
+			if expErr != nil {
+				return nil, &model.ErrorResponse{
+					Error:            constants.ErrorServerError,
+					ErrorDescription: "Failed to expand scopes",
+				}
+			}
+			scopes = expanded
+		}
+		invalid, valErr := resourceService.ValidatePermissions(ctx, rs.ID, scopes)
 		if valErr != nil {
 			return nil, &model.ErrorResponse{
 				Error:            constants.ErrorServerError,
@@ -147,8 +167,8 @@ func ComputeRSValidScopes(
 		for _, p := range invalid {
 			invalidSet[p] = struct{}{}
 		}
-		valid := make([]string, 0, len(requestedScopes))
-		for _, p := range requestedScopes {
+		valid := make([]string, 0, len(scopes))
+		for _, p := range scopes {
 			if _, isInvalid := invalidSet[p]; !isInvalid {
 				valid = append(valid, p)
 			}
@@ -273,6 +273,72 @@ func (suite *ResourceIndicatorsTestSuite) TestFilterByIdentifiers_PreservesOrder
 	assert.Equal(suite.T(), []*resource.ResourceServer{rs1, rs2}, result)
 }
 
+// ComputeRSValidScopes tests
+
+func (suite *ResourceIndicatorsTestSuite) TestComputeRSValidScopes_NoImplications_ValidatesRequestedScopes() {
+	rs := &resource.ResourceServer{ID: "rs01", Delimiter: ":"}
+	suite.mockResourceService.On("ValidatePermissions", mock.Anything, "rs01", []string{"read", "unknown"}).
+		Return([]string{"unknown"}, (*serviceerror.ServiceError)(nil))
+
+	result, errResp := ComputeRSValidScopes(context.Background(), suite.mockResourceService,
+		[]*resource.ResourceServer{rs}, []string{"read", "unknown"})
+
+	assert.Nil(suite.T(), errResp)
+	assert.Equal(suite.T(), map[string][]string{"rs01": {"read"}}, result)
+	suite.mockResourceService.AssertNotCalled(suite.T(), "ExpandScopes", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *ResourceIndicatorsTestSuite) TestComputeRSValidScopes_ExpandsImpliedScopes() {
+	rs := &resource.ResourceServer{
+		ID:                "rs01",
+		Delimiter:         ":",
+		ScopeImplications: map[string][]string{"admin": {"users:read"}},
+	}
+	suite.mockResourceService.On("ExpandScopes", mock.Anything, rs, []string{"admin"}).
+		Return([]string{"admin", "users:read"}, (*serviceerror.ServiceError)(nil))
+	suite.mockResourceService.On("ValidatePermissions", mock.Anything, "rs01", []string{"admin", "users:read"}).
+		Return([]string{"admin"}, (*serviceerror.ServiceError)(nil))
+
+	result, errResp := ComputeRSValidScopes(context.Background(), suite.mockResourceService,
+		[]*resource.ResourceServer{rs}, []string{"admin"})
+
+	assert.Nil(suite.T(), errResp)
+	assert.Equal(suite.T(), map[string][]string{"rs01": {"users:read"}}, result)
+}
+
+func (suite *ResourceIndicatorsTestSuite) TestComputeRSValidScopes_ExpandError_ReturnsServerError() {
+	rs := &resource.ResourceServer{ID: "rs01", Delimiter: ":"}
+	suite.mockResourceService.On("ExpandScopes", mock.Anything, rs, []string{"users:*"}).
+		Return(nil, &serviceerror.InternalServerError)
+
+	result, errResp := ComputeRSValidScopes(context.Background(), suite.mockResourceService,
+		[]*resource.ResourceServer{rs}, []string{"users:*"})
+
+	assert.Nil(suite.T(), result)
+	assert.NotNil(suite.T(), errResp)
+	assert.Equal(suite.T(), constants.ErrorServerError, errResp.Error)
+}
+
+// ResolveAndDownscope tests
+
+func (suite *ResourceIndicatorsTestSuite) TestResolveAndDownscope_AppendsImpliedScopes() {
+	rs := &resource.ResourceServer{ID: "rs01", Identifier: "https://api.example.com", Delimiter: ":"}
+	suite.mockResourceService.On("GetResourceServerByIdentifier", mock.Anything, "https://api.example.com").
+		Return(rs, (*serviceerror.ServiceError)(nil))
+	suite.mockResourceService.On("ExpandScopes", mock.Anything, rs, []string{"profile", "users:*"}).
+		Return([]string{"profile", "users:*", "users:read", "users:write"}, (*serviceerror.ServiceError)(nil))
+	suite.mockResourceService.On("ValidatePermissions", mock.Anything, "rs01",
+		[]string{"profile", "users:*", "users:read", "users:write"}).
+		Return([]string{"users:*"}, (*serviceerror.ServiceError)(nil))
+
+	resolved, scopes, errResp := ResolveAndDownscope(context.Background(), suite.mockResourceService,
+		[]string{"https://api.example.com"}, []string{"profile", "users:*"})
+
+	assert.Nil(suite.T(), errResp)
+	assert.Equal(suite.T(), []*resource.ResourceServer{rs}, resolved)
+	assert.Equal(suite.T(), []string{"profile", "users:read", "users:write"}, scopes)
+}
+
 // UnionScopes tests
 
 func (suite *ResourceIndicatorsTestSuite) TestUnionScopes_Empty() {
@@ -0,0 +1,125 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package roleclaims
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/thunder-id/thunderid/internal/entityprovider"
+	"github.com/thunder-id/thunderid/internal/role"
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/log"
+)
+
+// RoleClaimsServiceInterface defines the interface for resolving the roles claim of access tokens.
+type RoleClaimsServiceInterface interface {
+	ResolveRoleClaims(ctx context.Context, entityID string) (*RoleClaims, error)
+	GetClaimName() string
+	GetMaxClaimSize() int
+}
+
+// roleClaimsService implements RoleClaimsServiceInterface.
+type roleClaimsService struct {
+	entityProvider entityprovider.EntityProviderInterface
+	roleService    role.RoleServiceInterface
+	config         config.RoleClaimsConfig
+	logger         *log.Logger
+}
+
+// newRoleClaimsService creates a new instance of roleClaimsService.
+func newRoleClaimsService(
+	entityProvider entityprovider.EntityProviderInterface,
+	roleService role.RoleServiceInterface,
+	roleClaimsConfig config.RoleClaimsConfig,
+) *roleClaimsService {
+	return &roleClaimsService{
+		entityProvider: entityProvider,
+		roleService:    roleService,
+		config:         roleClaimsConfig,
+		logger:         log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName)),
+	}
+}
+
+// ResolveRoleClaims resolves the roles assigned to an entity directly or through its groups, and the scopes
+// granted to it by the role mappings. A mapping restricted to an organization unit only applies to the role
+// defined in that organization unit.
+func (s *roleClaimsService) ResolveRoleClaims(ctx context.Context, entityID string) (*RoleClaims, error) {
+	if entityID == "" {
+		return &RoleClaims{Roles: []string{}, Scopes: []string{}}, nil
+	}
+
+	var groupIDs []string
+	if s.entityProvider != nil {
+		groups, groupErr := s.entityProvider.GetTransitiveEntityGroups(entityID)
+		if groupErr != nil {
+			// Subjects that are not local entities have no group memberships to resolve.
+			if groupErr.Code != entityprovider.ErrorCodeNotImplemented &&
+				groupErr.Code != entityprovider.ErrorCodeEntityNotFound {
+				return nil, fmt.Errorf("failed to resolve group memberships: %w", groupErr)
+			}
+		} else {
+			for _, group := range groups {
+				if group.ID != "" && !slices.Contains(groupIDs, group.ID) {
+					groupIDs = append(groupIDs, group.ID)
+				}
+			}
+		}
+	}
+
+	roles, svcErr := s.roleService.GetEntityRoles(ctx, entityID, groupIDs)
+	if svcErr != nil {
+		return nil, fmt.Errorf("failed to resolve roles: %s", svcErr.Code)
+	}
+
+	claims := &RoleClaims{Roles: make([]string, 0, len(roles)), Scopes: make([]string, 0)}
+	for _, assigned := range roles {
+		if !slices.Contains(claims.Roles, assigned.Name) {
+			claims.Roles = append(claims.Roles, assigned.Name)
+		}
+		for _, mapping := range s.config.Mappings {
+			if mapping.Role != assigned.Name || (mapping.OUID != "" && mapping.OUID != assigned.OUID) {
+				continue
+			}
+			for _, scope := range mapping.Scopes {
+				if !slices.Contains(claims.Scopes, scope) {
+					claims.Scopes = append(claims.Scopes, scope)
+				}
+			}
+		}
+	}
+
+	s.logger.Debug("Resolved role claims", log.MaskedString("entityID", entityID),
+		log.Int("roleCount", len(claims.Roles)), log.Int("scopeCount", len(claims.Scopes)))
+	return claims, nil
+}
+
+// GetClaimName returns the name of the roles claim.
+func (s *roleClaimsService) GetClaimName() string {
+	if s.config.ClaimName == "" {
+		return defaultClaimName
+	}
+	return s.config.ClaimName
+}
+
+// GetMaxClaimSize returns the maximum size in bytes of the serialized roles claim. Zero means no limit.
+func (s *roleClaimsService) GetMaxClaimSize() int {
+	return s.config.MaxClaimSize
+}
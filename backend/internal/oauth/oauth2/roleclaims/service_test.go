@@ -0,0 +1,143 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package roleclaims
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/entityprovider"
+	"github.com/thunder-id/thunderid/internal/role"
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/tests/mocks/entityprovidermock"
+	"github.com/thunder-id/thunderid/tests/mocks/rolemock"
+)
+
+type RoleClaimsServiceTestSuite struct {
+	suite.Suite
+	mockEntityProvider *entityprovidermock.EntityProviderInterfaceMock
+	mockRoleService    *rolemock.RoleServiceInterfaceMock
+	config             config.RoleClaimsConfig
+}
+
+func TestRoleClaimsServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(RoleClaimsServiceTestSuite))
+}
+
+func (suite *RoleClaimsServiceTestSuite) SetupTest() {
+	suite.mockEntityProvider = entityprovidermock.NewEntityProviderInterfaceMock(suite.T())
+	suite.mockRoleService = rolemock.NewRoleServiceInterfaceMock(suite.T())
+	suite.config = config.RoleClaimsConfig{
+		Mappings: []config.RoleScopeMapping{
+			{Role: "admin", Scopes: []string{"users:read", "users:write"}},
+			{Role: "auditor", OUID: "ou-finance", Scopes: []string{"ledger:read"}},
+			{Role: "viewer", Scopes: []string{"users:read"}},
+		},
+	}
+}
+
+func (suite *RoleClaimsServiceTestSuite) newService() *roleClaimsService {
+	return newRoleClaimsService(suite.mockEntityProvider, suite.mockRoleService, suite.config)
+}
+
+func (suite *RoleClaimsServiceTestSuite) TestResolveRoleClaims_MapsRolesToScopes() {
+	suite.mockEntityProvider.On("GetTransitiveEntityGroups", "user-1").
+		Return([]entityprovider.EntityGroup{{ID: "group-1"}, {ID: "group-1"}}, nil)
+	suite.mockRoleService.On("GetEntityRoles", mock.Anything, "user-1", []string{"group-1"}).
+		Return([]role.Role{
+			{ID: "r1", Name: "admin", OUID: "ou-root"},
+			{ID: "r2", Name: "viewer", OUID: "ou-root"},
+			{ID: "r3", Name: "viewer", OUID: "ou-sales"},
+		}, nil)
+
+	claims, err := suite.newService().ResolveRoleClaims(context.Background(), "user-1")
+
+	suite.NoError(err)
+	suite.Equal([]string{"admin", "viewer"}, claims.Roles)
+	suite.Equal([]string{"users:read", "users:write"}, claims.Scopes)
+}
+
+func (suite *RoleClaimsServiceTestSuite) TestResolveRoleClaims_OUScopedMapping() {
+	suite.mockEntityProvider.On("GetTransitiveEntityGroups", "user-1").Return([]entityprovider.EntityGroup{}, nil)
+	suite.mockRoleService.On("GetEntityRoles", mock.Anything, "user-1", []string(nil)).
+		Return([]role.Role{{ID: "r1", Name: "auditor", OUID: "ou-sales"}}, nil)
+
+	claims, err := suite.newService().ResolveRoleClaims(context.Background(), "user-1")
+
+	suite.NoError(err)
+	suite.Equal([]string{"auditor"}, claims.Roles)
+	suite.Empty(claims.Scopes)
+}
+
+func (suite *RoleClaimsServiceTestSuite) TestResolveRoleClaims_SubjectNotAnEntity() {
+	suite.mockEntityProvider.On("GetTransitiveEntityGroups", "external-sub").
+		Return(nil, entityprovider.NewEntityProviderError(entityprovider.ErrorCodeEntityNotFound, "not found", ""))
+	suite.mockRoleService.On("GetEntityRoles", mock.Anything, "external-sub", []string(nil)).
+		Return([]role.Role{}, nil)
+
+	claims, err := suite.newService().ResolveRoleClaims(context.Background(), "external-sub")
+
+	suite.NoError(err)
+	suite.Empty(claims.Roles)
+}
+
+func (suite *RoleClaimsServiceTestSuite) TestResolveRoleClaims_GroupLookupFails() {
+	suite.mockEntityProvider.On("GetTransitiveEntityGroups", "user-1").
+		Return(nil, entityprovider.NewEntityProviderError(entityprovider.ErrorCodeSystemError, "boom", ""))
+
+	claims, err := suite.newService().ResolveRoleClaims(context.Background(), "user-1")
+
+	suite.Error(err)
+	suite.Nil(claims)
+}
+
+func (suite *RoleClaimsServiceTestSuite) TestResolveRoleClaims_RoleLookupFails() {
+	suite.mockEntityProvider.On("GetTransitiveEntityGroups", "user-1").Return([]entityprovider.EntityGroup{}, nil)
+	suite.mockRoleService.On("GetEntityRoles", mock.Anything, "user-1", []string(nil)).
+		Return(nil, &serviceerror.InternalServerError)
+
+	claims, err := suite.newService().ResolveRoleClaims(context.Background(), "user-1")
+
+	suite.Error(err)
+	suite.Nil(claims)
+}
+
+func (suite *RoleClaimsServiceTestSuite) TestResolveRoleClaims_EmptySubject() {
+	claims, err := suite.newService().ResolveRoleClaims(context.Background(), "")
+
+	suite.NoError(err)
+	suite.Empty(claims.Roles)
+	suite.Empty(claims.Scopes)
+}
+
+func (suite *RoleClaimsServiceTestSuite) TestClaimSettings() {
+	service := suite.newService()
+	suite.Equal(defaultClaimName, service.GetClaimName())
+	suite.Equal(0, service.GetMaxClaimSize())
+
+	suite.config.ClaimName = "groups_roles"
+	suite.config.MaxClaimSize = 512
+	service = suite.newService()
+	suite.Equal("groups_roles", service.GetClaimName())
+	suite.Equal(512, service.GetMaxClaimSize())
+}
@@ -0,0 +1,172 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package scopeceiling caps the scopes of tokens issued to users by the strength of the authentication the
+// user completed, as configured in oauth.scope_ceilings.
+package scopeceiling
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/model"
+	oauth2utils "github.com/thunder-id/thunderid/internal/oauth/oauth2/utils"
+	"github.com/thunder-id/thunderid/internal/system/config"
+)
+
+// allScopes is the scope value of a rule that allows all scopes.
+const allScopes = "*"
+
+// Ceiling is the set of scopes allowed for an authentication context. A nil Ceiling allows all scopes.
+type Ceiling struct {
+	scopes map[string]struct{}
+}
+
+// Resolve returns the ceiling of the first configured rule matching the given completed ACR, or nil when
+// no rule matches.
+func Resolve(acr string) *Ceiling {
+	oauthConfig := config.GetServerRuntime().Config.OAuth
+	return resolve(oauthConfig.ScopeCeilings.Rules, oauthConfig.AuthClass.AcrAMR, acr)
+}
+
+// resolve returns the ceiling of the first rule matching the given ACR.
+func resolve(rules []config.ScopeCeilingRule, acrAMR map[string][]string, acr string) *Ceiling {
+	for _, rule := range rules {
+		if !matches(rule, acrAMR, acr) {
+			continue
+		}
+		if slices.Contains(rule.Scopes, allScopes) {
+			return nil
+		}
+		ceiling := &Ceiling{scopes: make(map[string]struct{}, len(rule.Scopes))}
+		for _, scope := range rule.Scopes {
+			ceiling.scopes[scope] = struct{}{}
+		}
+		return ceiling
+	}
+	return nil
+}
+
+// matches reports whether the rule applies to the given ACR. An AMR rule applies when every authentication
+// method of the ACR is listed in the rule.
+func matches(rule config.ScopeCeilingRule, acrAMR map[string][]string, acr string) bool {
+	if len(rule.ACR) == 0 && len(rule.AMR) == 0 {
+		return true
+	}
+	if acr == "" {
+		return false
+	}
+	if slices.Contains(rule.ACR, acr) {
+		return true
+	}
+	amrs := acrAMR[acr]
+	if len(rule.AMR) == 0 || len(amrs) == 0 {
+		return false
+	}
+	for _, amr := range amrs {
+		if !slices.Contains(rule.AMR, amr) {
+			return false
+		}
+	}
+	return true
+}
+
+// Allows reports whether the ceiling allows the given scope.
+func (c *Ceiling) Allows(scope string) bool {
+	if c == nil {
+		return true
+	}
+	_, ok := c.scopes[scope]
+	return ok
+}
+
+// Apply caps the given scopes at the ceiling of the completed ACR. OIDC scopes, identified with the given
+// scope to claims mapping of the client, are always allowed. Scopes above the ceiling are dropped, or the
+// request fails with insufficient_authentication_level when the ceilings are configured to reject them.
+func Apply(acr string, scopes []string, scopeClaims map[string][]string) ([]string, *model.ErrorResponse) {
+	oauthConfig := config.GetServerRuntime().Config.OAuth
+	rules := oauthConfig.ScopeCeilings.Rules
+	ceiling := resolve(rules, oauthConfig.AuthClass.AcrAMR, acr)
+	if ceiling == nil {
+		return scopes, nil
+	}
+
+	_, nonOIDCScopes := oauth2utils.SeparateOIDCAndNonOIDCScopes(strings.Join(scopes, " "), scopeClaims)
+	exceeded := make([]string, 0)
+	for _, scope := range nonOIDCScopes {
+		if !ceiling.Allows(scope) {
+			exceeded = append(exceeded, scope)
+		}
+	}
+	if len(exceeded) == 0 {
+		return scopes, nil
+	}
+
+	if oauthConfig.ScopeCeilings.Reject {
+		return nil, &model.ErrorResponse{
+			Error: constants.ErrorInsufficientAuthenticationLevel,
+			ErrorDescription: insufficientLevelDescription(exceeded,
+				stepUpACRs(rules, oauthConfig.AuthClass.AcrAMR, exceeded)),
+		}
+	}
+
+	allowed := make([]string, 0, len(scopes))
+	for _, scope := range scopes {
+		if !slices.Contains(exceeded, scope) {
+			allowed = append(allowed, scope)
+		}
+	}
+	return allowed, nil
+}
+
+// stepUpACRs returns the known ACR values whose ceiling allows all the given scopes. The known ACR values
+// are those mapped in oauth.auth_class.acr_amr and those named in the rules.
+func stepUpACRs(rules []config.ScopeCeilingRule, acrAMR map[string][]string, scopes []string) []string {
+	known := make(map[string]struct{}, len(acrAMR))
+	for acr := range acrAMR {
+		known[acr] = struct{}{}
+	}
+	for _, rule := range rules {
+		for _, acr := range rule.ACR {
+			known[acr] = struct{}{}
+		}
+	}
+
+	acrs := make([]string, 0, len(known))
+	for acr := range known {
+		ceiling := resolve(rules, acrAMR, acr)
+		if !slices.ContainsFunc(scopes, func(scope string) bool { return !ceiling.Allows(scope) }) {
+			acrs = append(acrs, acr)
+		}
+	}
+	sort.Strings(acrs)
+	return acrs
+}
+
+// insufficientLevelDescription builds the error description that guides the client to request the scopes
+// again with a stronger authentication.
+func insufficientLevelDescription(scopes []string, acrs []string) string {
+	description := fmt.Sprintf("The scopes %s require a stronger authentication", strings.Join(scopes, " "))
+	if len(acrs) == 0 {
+		return description
+	}
+	return fmt.Sprintf("%s. Authenticate again with acr_values=%s", description, strings.Join(acrs, " "))
+}
@@ -0,0 +1,143 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package scopeceiling
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
+	"github.com/thunder-id/thunderid/internal/system/config"
+)
+
+const (
+	testACRPassword = "urn:thunder:acr:password"
+	testACRMFA      = "urn:thunder:acr:mfa"
+)
+
+type ScopeCeilingTestSuite struct {
+	suite.Suite
+}
+
+func TestScopeCeilingTestSuite(t *testing.T) {
+	suite.Run(t, new(ScopeCeilingTestSuite))
+}
+
+func (suite *ScopeCeilingTestSuite) SetupTest() {
+	config.ResetServerRuntime()
+	testConfig := &config.Config{
+		OAuth: config.OAuthConfig{
+			AuthClass: config.AuthClassConfig{
+				Amrs: []string{"pwd", "otp"},
+				AcrAMR: map[string][]string{
+					testACRPassword: {"pwd"},
+					testACRMFA:      {"pwd", "otp"},
+				},
+			},
+			ScopeCeilings: config.ScopeCeilingsConfig{
+				Rules: []config.ScopeCeilingRule{
+					{ACR: []string{testACRMFA}, Scopes: []string{"*"}},
+					{AMR: []string{"pwd"}, Scopes: []string{"orders:read"}},
+					{Scopes: []string{}},
+				},
+			},
+		},
+	}
+	_ = config.InitializeServerRuntime("test", testConfig)
+}
+
+func (suite *ScopeCeilingTestSuite) TearDownTest() {
+	config.ResetServerRuntime()
+}
+
+func (suite *ScopeCeilingTestSuite) TestResolve() {
+	suite.Nil(Resolve(testACRMFA))
+
+	passwordCeiling := Resolve(testACRPassword)
+	suite.NotNil(passwordCeiling)
+	suite.True(passwordCeiling.Allows("orders:read"))
+	suite.False(passwordCeiling.Allows("orders:write"))
+
+	// A context without ACR falls through to the rule matching any authentication context.
+	suite.False(Resolve("").Allows("orders:read"))
+}
+
+func (suite *ScopeCeilingTestSuite) TestResolve_AMRRuleRequiresAllMethodsListed() {
+	rules := []config.ScopeCeilingRule{{AMR: []string{"pwd"}, Scopes: []string{"orders:read"}}}
+	acrAMR := map[string][]string{testACRMFA: {"pwd", "otp"}}
+
+	suite.Nil(resolve(rules, acrAMR, testACRMFA))
+	suite.Nil(resolve(rules, acrAMR, "urn:unknown"))
+}
+
+func (suite *ScopeCeilingTestSuite) TestResolve_NoRules() {
+	suite.Nil(resolve(nil, nil, testACRPassword))
+}
+
+func (suite *ScopeCeilingTestSuite) TestApply_DropsScopesAboveCeiling() {
+	scopes, errResp := Apply(testACRPassword, []string{"openid", "profile", "orders:read", "orders:write"}, nil)
+
+	suite.Nil(errResp)
+	suite.Equal([]string{"openid", "profile", "orders:read"}, scopes)
+}
+
+func (suite *ScopeCeilingTestSuite) TestApply_KeepsCustomOIDCScopes() {
+	scopes, errResp := Apply("", []string{"openid", "employee"}, map[string][]string{"employee": {"dept"}})
+
+	suite.Nil(errResp)
+	suite.Equal([]string{"openid", "employee"}, scopes)
+}
+
+func (suite *ScopeCeilingTestSuite) TestApply_Unrestricted() {
+	scopes, errResp := Apply(testACRMFA, []string{"orders:read", "orders:write"}, nil)
+
+	suite.Nil(errResp)
+	suite.Equal([]string{"orders:read", "orders:write"}, scopes)
+}
+
+func (suite *ScopeCeilingTestSuite) TestApply_RejectsScopesAboveCeiling() {
+	config.GetServerRuntime().Config.OAuth.ScopeCeilings.Reject = true
+
+	scopes, errResp := Apply(testACRPassword, []string{"orders:read", "orders:write"}, nil)
+
+	suite.Nil(scopes)
+	suite.NotNil(errResp)
+	suite.Equal(constants.ErrorInsufficientAuthenticationLevel, errResp.Error)
+	suite.Equal("The scopes orders:write require a stronger authentication. "+
+		"Authenticate again with acr_values="+testACRMFA, errResp.ErrorDescription)
+}
+
+func (suite *ScopeCeilingTestSuite) TestApply_RejectsWithoutStepUpACR() {
+	config.GetServerRuntime().Config.OAuth.ScopeCeilings.Reject = true
+	config.GetServerRuntime().Config.OAuth.ScopeCeilings.Rules[0].Scopes = []string{"orders:read"}
+
+	_, errResp := Apply(testACRPassword, []string{"orders:write"}, nil)
+
+	suite.NotNil(errResp)
+	suite.Equal("The scopes orders:write require a stronger authentication", errResp.ErrorDescription)
+}
+
+func (suite *ScopeCeilingTestSuite) TestStepUpACRs() {
+	rules := config.GetServerRuntime().Config.OAuth.ScopeCeilings.Rules
+	acrAMR := config.GetServerRuntime().Config.OAuth.AuthClass.AcrAMR
+
+	suite.Equal([]string{testACRMFA, testACRPassword}, stepUpACRs(rules, acrAMR, []string{"orders:read"}))
+	suite.Equal([]string{testACRMFA}, stepUpACRs(rules, acrAMR, []string{"orders:write"}))
+}
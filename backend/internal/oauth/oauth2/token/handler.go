@@ -19,12 +19,19 @@
 package token
 
 import (
+	"context"
 	"net/http"
+	"net/url"
+	"slices"
 	"time"
 
+	inboundmodel "github.com/thunder-id/thunderid/internal/inboundclient/model"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/clientauth"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/clientusage"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/dpop"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/model"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/protocoltrace"
 	sysconst "github.com/thunder-id/thunderid/internal/system/constants"
 	"github.com/thunder-id/thunderid/internal/system/log"
 	"github.com/thunder-id/thunderid/internal/system/observability"
@@ -40,16 +47,29 @@ type TokenHandlerInterface interface {
 type tokenHandler struct {
 	tokenService     TokenServiceInterface
 	observabilitySvc observability.ObservabilityServiceInterface
+	protocolTrace    protocoltrace.ProtocolTraceServiceInterface
+	clientUsage      clientusage.ClientUsageServiceInterface
+	dpopService      dpop.DPoPServiceInterface
+	// tokenEndpoint is the URL of the token endpoint that DPoP proofs must target.
+	tokenEndpoint string
 }
 
 // newTokenHandler creates a new instance of tokenHandler.
 func newTokenHandler(
 	tokenService TokenServiceInterface,
 	observabilitySvc observability.ObservabilityServiceInterface,
+	protocolTrace protocoltrace.ProtocolTraceServiceInterface,
+	clientUsage clientusage.ClientUsageServiceInterface,
+	dpopService dpop.DPoPServiceInterface,
+	tokenEndpoint string,
 ) TokenHandlerInterface {
 	return &tokenHandler{
 		tokenService:     tokenService,
 		observabilitySvc: observabilitySvc,
+		protocolTrace:    protocolTrace,
+		clientUsage:      clientUsage,
+		dpopService:      dpopService,
+		tokenEndpoint:    tokenEndpoint,
 	}
 }
 
@@ -78,6 +98,15 @@ func (th *tokenHandler) HandleTokenRequest(w http.ResponseWriter, r *http.Reques
 		return
 	}
 
+	ctx, proofErr := th.validateDPoPProof(r, clientInfo.OAuthApp)
+	if proofErr != nil {
+		publishTokenIssuanceFailedEvent(th.observabilitySvc, r.Context(), clientInfo.ClientID,
+			r.FormValue(constants.RequestParamGrantType), r.FormValue("scope"),
+			dpopErrorStatusCode(proofErr), proofErr.Description, startTime)
+		writeDPoPError(w, proofErr)
+		return
+	}
+
 	// Build the token request domain model from the HTTP form values.
 	tokenRequest := &model.TokenRequest{
 		GrantType:          r.FormValue(constants.RequestParamGrantType),
@@ -97,10 +126,13 @@ func (th *tokenHandler) HandleTokenRequest(w http.ResponseWriter, r *http.Reques
 		ActorTokenType:     r.FormValue(constants.RequestParamActorTokenType),
 		RequestedTokenType: r.FormValue(constants.RequestParamRequestedTokenType),
 		Audiences:          r.Form[constants.RequestParamAudience],
+		DeviceCode:         r.FormValue(constants.RequestParamDeviceCode),
+		Parameters:         requestParameters(r.Form),
 	}
 
 	// Delegate all business logic to the token service.
-	tokenResponse, tokenError := th.tokenService.ProcessTokenRequest(r.Context(), tokenRequest, clientInfo.OAuthApp)
+	tokenResponse, tokenError := th.tokenService.ProcessTokenRequest(ctx, tokenRequest, clientInfo.OAuthApp)
+	th.recordTokenTrace(r, clientInfo, tokenRequest, tokenResponse, tokenError)
 	if tokenError != nil {
 		if tokenError.Error != "" {
 			var statusCode int
@@ -118,6 +150,10 @@ func (th *tokenHandler) HandleTokenRequest(w http.ResponseWriter, r *http.Reques
 		return
 	}
 
+	if th.clientUsage != nil {
+		th.clientUsage.RecordTokenIssued(r.Context(), clientInfo.OAuthApp.ID)
+	}
+
 	logger.Debug("Token response sending", log.String("client_id", clientInfo.ClientID))
 
 	// Must include the following headers when sensitive data is returned.
@@ -126,3 +162,76 @@ func (th *tokenHandler) HandleTokenRequest(w http.ResponseWriter, r *http.Reques
 
 	utils.WriteSuccessResponse(w, http.StatusOK, tokenResponse)
 }
+
+// validateDPoPProof validates the DPoP proof of a token request and returns the request context carrying the
+// thumbprint of the proof key, to which the issued tokens are bound. Clients that require DPoP-bound access
+// tokens must present a proof.
+func (th *tokenHandler) validateDPoPProof(
+	r *http.Request, oauthApp *inboundmodel.OAuthClient,
+) (context.Context, *dpop.ProofError) {
+	proofs := r.Header.Values(dpop.HeaderName)
+	if len(proofs) == 0 {
+		if oauthApp != nil && oauthApp.DPoPBoundAccessTokens {
+			return nil, &dpop.ProofError{
+				Code:        constants.ErrorInvalidDPoPProof,
+				Description: "The client requires DPoP-bound access tokens but no DPoP proof was presented",
+			}
+		}
+		return r.Context(), nil
+	}
+
+	thumbprint, proofErr := th.dpopService.ValidateTokenRequestProof(r.Context(), proofs, r.Method, th.tokenEndpoint)
+	if proofErr != nil {
+		return nil, proofErr
+	}
+	return dpop.WithThumbprint(r.Context(), thumbprint), nil
+}
+
+// dpopErrorStatusCode returns the HTTP status code of a DPoP proof error at the token endpoint.
+func dpopErrorStatusCode(proofErr *dpop.ProofError) int {
+	if proofErr.Code == constants.ErrorServerError {
+		return http.StatusInternalServerError
+	}
+	return http.StatusBadRequest
+}
+
+// writeDPoPError writes a DPoP proof error. A use_dpop_nonce error carries the nonce the client must use in
+// the DPoP-Nonce header.
+func writeDPoPError(w http.ResponseWriter, proofErr *dpop.ProofError) {
+	var headers []map[string]string
+	if proofErr.Nonce != "" {
+		headers = []map[string]string{{dpop.NonceHeaderName: proofErr.Nonce}}
+	}
+	utils.WriteJSONError(w, proofErr.Code, proofErr.Description, dpopErrorStatusCode(proofErr), headers)
+}
+
+// recordTokenTrace captures the token request and its outcome when the client enabled protocol tracing.
+// Client authentication parameters are never part of the captured request.
+func (th *tokenHandler) recordTokenTrace(r *http.Request, clientInfo *clientauth.OAuthClientInfo,
+	tokenRequest *model.TokenRequest, tokenResponse *model.TokenResponse, tokenError *model.ErrorResponse) {
+	if th.protocolTrace == nil {
+		return
+	}
+
+	errorCode, errorDescription := "", ""
+	if tokenError != nil {
+		errorCode, errorDescription = tokenError.Error, tokenError.ErrorDescription
+		if errorCode == "" {
+			errorCode = constants.ErrorServerError
+		}
+	}
+	th.protocolTrace.RecordTokenRequest(r.Context(), clientInfo.OAuthApp, tokenRequest.Parameters,
+		tokenResponse, errorCode, errorDescription)
+}
+
+// requestParameters returns a copy of the request form without the client authentication parameters.
+func requestParameters(form url.Values) url.Values {
+	params := make(url.Values, len(form))
+	for key, values := range form {
+		if key == constants.RequestParamClientSecret || key == constants.RequestParamClientAssertion {
+			continue
+		}
+		params[key] = slices.Clone(values)
+	}
+	return params
+}
@@ -34,7 +34,11 @@ import (
 	inboundmodel "github.com/thunder-id/thunderid/internal/inboundclient/model"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/clientauth"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/dpop"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/model"
+	"github.com/thunder-id/thunderid/tests/mocks/oauth/oauth2/clientusagemock"
+	"github.com/thunder-id/thunderid/tests/mocks/oauth/oauth2/dpopmock"
+	"github.com/thunder-id/thunderid/tests/mocks/oauth/oauth2/protocoltracemock"
 )
 
 type TokenHandlerTestSuite struct {
@@ -52,7 +56,7 @@ func (suite *TokenHandlerTestSuite) SetupTest() {
 
 // newHandler creates a tokenHandler backed by the suite's service mock.
 func (suite *TokenHandlerTestSuite) newHandler() *tokenHandler {
-	return newTokenHandler(suite.mockTokenService, nil).(*tokenHandler)
+	return newTokenHandler(suite.mockTokenService, nil, nil, nil, nil, "").(*tokenHandler)
 }
 
 // buildRequest constructs a POST /token request with URL-encoded form data.
@@ -76,7 +80,7 @@ func (suite *TokenHandlerTestSuite) withClientContext(
 }
 
 func (suite *TokenHandlerTestSuite) TestnewTokenHandler() {
-	handler := newTokenHandler(suite.mockTokenService, nil)
+	handler := newTokenHandler(suite.mockTokenService, nil, nil, nil, nil, "")
 	assert.NotNil(suite.T(), handler)
 	assert.Implements(suite.T(), (*TokenHandlerInterface)(nil), handler)
 }
@@ -145,7 +149,7 @@ func (suite *TokenHandlerTestSuite) TestHandleTokenRequest_ServiceErrors() {
 	for _, tc := range tests {
 		suite.Run(tc.name, func() {
 			mockSvc := NewTokenServiceInterfaceMock(suite.T())
-			handler := newTokenHandler(mockSvc, nil).(*tokenHandler)
+			handler := newTokenHandler(mockSvc, nil, nil, nil, nil, "").(*tokenHandler)
 			mockApp := &inboundmodel.OAuthClient{ClientID: "test-client-id"}
 			formData := url.Values{}
 			formData.Set("grant_type", tc.grantType)
@@ -259,3 +263,142 @@ func (suite *TokenHandlerTestSuite) TestHandleTokenRequest_SuccessWithIssuedToke
 	assert.Equal(suite.T(), "exchanged-token", response["access_token"])
 	assert.Equal(suite.T(), string(constants.TokenTypeIdentifierAccessToken), response["issued_token_type"])
 }
+
+func (suite *TokenHandlerTestSuite) TestRequestParameters_ExcludesClientCredentials() {
+	form := url.Values{
+		"grant_type":                          []string{"urn:example:params:oauth:grant-type:partner"},
+		"assertion":                           []string{"partner-assertion"},
+		"client_secret":                       []string{"secret"},
+		constants.RequestParamClientAssertion: []string{"client-jwt"},
+	}
+
+	params := requestParameters(form)
+
+	assert.Equal(suite.T(), "partner-assertion", params.Get("assertion"))
+	assert.Equal(suite.T(), "urn:example:params:oauth:grant-type:partner", params.Get("grant_type"))
+	assert.False(suite.T(), params.Has("client_secret"))
+	assert.False(suite.T(), params.Has(constants.RequestParamClientAssertion))
+}
+
+func (suite *TokenHandlerTestSuite) TestHandleTokenRequest_RecordsProtocolTrace() {
+	mockTrace := protocoltracemock.NewProtocolTraceServiceInterfaceMock(suite.T())
+	handler := newTokenHandler(suite.mockTokenService, nil, mockTrace, nil, nil, "").(*tokenHandler)
+	mockApp := &inboundmodel.OAuthClient{ClientID: "test-client-id", ProtocolTraceEnabled: true}
+	formData := url.Values{}
+	formData.Set("grant_type", "authorization_code")
+	formData.Set("code", "auth-code")
+	formData.Set("client_secret", "test-secret")
+	req := suite.withClientContext(suite.buildRequest(formData), mockApp)
+
+	suite.mockTokenService.EXPECT().
+		ProcessTokenRequest(mock.Anything, mock.Anything, mock.Anything).
+		Return(nil, &model.ErrorResponse{Error: constants.ErrorInvalidGrant, ErrorDescription: "Invalid code"})
+	mockTrace.On("RecordTokenRequest", mock.Anything, mockApp,
+		mock.MatchedBy(func(params url.Values) bool {
+			return params.Get("code") == "auth-code" && !params.Has("client_secret")
+		}), (*model.TokenResponse)(nil), constants.ErrorInvalidGrant, "Invalid code").Return().Once()
+
+	rr := httptest.NewRecorder()
+	handler.HandleTokenRequest(rr, req)
+
+	assert.Equal(suite.T(), http.StatusBadRequest, rr.Code)
+}
+
+func (suite *TokenHandlerTestSuite) TestHandleTokenRequest_RecordsClientUsageOnSuccess() {
+	mockClientUsage := clientusagemock.NewClientUsageServiceInterfaceMock(suite.T())
+	handler := newTokenHandler(suite.mockTokenService, nil, nil, mockClientUsage, nil, "").(*tokenHandler)
+	mockApp := &inboundmodel.OAuthClient{ID: "app-1", ClientID: "test-client-id"}
+	formData := url.Values{}
+	formData.Set("grant_type", "client_credentials")
+	req := suite.withClientContext(suite.buildRequest(formData), mockApp)
+
+	suite.mockTokenService.EXPECT().
+		ProcessTokenRequest(mock.Anything, mock.Anything, mock.Anything).
+		Return(&model.TokenResponse{AccessToken: "token", TokenType: "Bearer"}, nil)
+	mockClientUsage.On("RecordTokenIssued", mock.Anything, "app-1").Return().Once()
+
+	rr := httptest.NewRecorder()
+	handler.HandleTokenRequest(rr, req)
+
+	assert.Equal(suite.T(), http.StatusOK, rr.Code)
+}
+
+func (suite *TokenHandlerTestSuite) TestHandleTokenRequest_DoesNotRecordClientUsageOnError() {
+	mockClientUsage := clientusagemock.NewClientUsageServiceInterfaceMock(suite.T())
+	handler := newTokenHandler(suite.mockTokenService, nil, nil, mockClientUsage, nil, "").(*tokenHandler)
+	mockApp := &inboundmodel.OAuthClient{ID: "app-1", ClientID: "test-client-id"}
+	formData := url.Values{}
+	formData.Set("grant_type", "client_credentials")
+	req := suite.withClientContext(suite.buildRequest(formData), mockApp)
+
+	suite.mockTokenService.EXPECT().
+		ProcessTokenRequest(mock.Anything, mock.Anything, mock.Anything).
+		Return(nil, &model.ErrorResponse{Error: constants.ErrorInvalidScope})
+
+	rr := httptest.NewRecorder()
+	handler.HandleTokenRequest(rr, req)
+
+	assert.Equal(suite.T(), http.StatusBadRequest, rr.Code)
+	mockClientUsage.AssertNotCalled(suite.T(), "RecordTokenIssued", mock.Anything, mock.Anything)
+}
+
+func (suite *TokenHandlerTestSuite) TestHandleTokenRequest_DPoPProofBindsRequestContext() {
+	mockDPoP := dpopmock.NewDPoPServiceInterfaceMock(suite.T())
+	handler := newTokenHandler(suite.mockTokenService, nil, nil, nil, mockDPoP,
+		"https://localhost:8090/oauth2/token").(*tokenHandler)
+	formData := url.Values{}
+	formData.Set("grant_type", "client_credentials")
+	req := suite.withClientContext(suite.buildRequest(formData), &inboundmodel.OAuthClient{ClientID: "test-client-id"})
+	req.Header.Set(dpop.HeaderName, "proof-jwt")
+
+	mockDPoP.EXPECT().ValidateTokenRequestProof(mock.Anything, []string{"proof-jwt"}, http.MethodPost,
+		"https://localhost:8090/oauth2/token").Return("jkt-1", nil)
+	suite.mockTokenService.EXPECT().
+		ProcessTokenRequest(mock.MatchedBy(func(ctx context.Context) bool {
+			return dpop.GetThumbprint(ctx) == "jkt-1"
+		}), mock.Anything, mock.Anything).
+		Return(&model.TokenResponse{AccessToken: "token", TokenType: constants.TokenTypeDPoP}, nil)
+
+	rr := httptest.NewRecorder()
+	handler.HandleTokenRequest(rr, req)
+
+	assert.Equal(suite.T(), http.StatusOK, rr.Code)
+}
+
+func (suite *TokenHandlerTestSuite) TestHandleTokenRequest_DPoPNonceChallenge() {
+	mockDPoP := dpopmock.NewDPoPServiceInterfaceMock(suite.T())
+	handler := newTokenHandler(suite.mockTokenService, nil, nil, nil, mockDPoP, "").(*tokenHandler)
+	formData := url.Values{}
+	formData.Set("grant_type", "client_credentials")
+	req := suite.withClientContext(suite.buildRequest(formData), &inboundmodel.OAuthClient{ClientID: "test-client-id"})
+	req.Header.Set(dpop.HeaderName, "proof-jwt")
+
+	mockDPoP.EXPECT().ValidateTokenRequestProof(mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return("", &dpop.ProofError{Code: constants.ErrorUseDPoPNonce, Description: "nonce required",
+			Nonce: "fresh-nonce"})
+
+	rr := httptest.NewRecorder()
+	handler.HandleTokenRequest(rr, req)
+
+	assert.Equal(suite.T(), http.StatusBadRequest, rr.Code)
+	assert.Equal(suite.T(), "fresh-nonce", rr.Header().Get(dpop.NonceHeaderName))
+	assert.Contains(suite.T(), rr.Body.String(), constants.ErrorUseDPoPNonce)
+	suite.mockTokenService.AssertNotCalled(suite.T(), "ProcessTokenRequest", mock.Anything, mock.Anything,
+		mock.Anything)
+}
+
+func (suite *TokenHandlerTestSuite) TestHandleTokenRequest_DPoPRequiredByClient() {
+	handler := suite.newHandler()
+	formData := url.Values{}
+	formData.Set("grant_type", "client_credentials")
+	req := suite.withClientContext(suite.buildRequest(formData),
+		&inboundmodel.OAuthClient{ClientID: "test-client-id", DPoPBoundAccessTokens: true})
+
+	rr := httptest.NewRecorder()
+	handler.HandleTokenRequest(rr, req)
+
+	assert.Equal(suite.T(), http.StatusBadRequest, rr.Code)
+	assert.Contains(suite.T(), rr.Body.String(), constants.ErrorInvalidDPoPProof)
+	suite.mockTokenService.AssertNotCalled(suite.T(), "ProcessTokenRequest", mock.Anything, mock.Anything,
+		mock.Anything)
+}
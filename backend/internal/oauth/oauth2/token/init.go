@@ -21,12 +21,16 @@ package token
 import (
 	"context"
 	"net/http"
+	"slices"
 
 	authnprovidermgr "github.com/thunder-id/thunderid/internal/authnprovider/manager"
 	"github.com/thunder-id/thunderid/internal/inboundclient"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/clientauth"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/clientusage"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/discovery"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/dpop"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/granthandlers"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/protocoltrace"
 	"github.com/thunder-id/thunderid/internal/oauth/scope"
 	"github.com/thunder-id/thunderid/internal/system/jose/jwt"
 	"github.com/thunder-id/thunderid/internal/system/middleware"
@@ -45,10 +49,15 @@ func Initialize(
 	observabilitySvc observability.ObservabilityServiceInterface,
 	discoveryService discovery.DiscoveryServiceInterface,
 	transactioner transaction.Transactioner,
+	protocolTraceService protocoltrace.ProtocolTraceServiceInterface,
+	clientUsageService clientusage.ClientUsageServiceInterface,
+	dpopService dpop.DPoPServiceInterface,
 ) TokenHandlerInterface {
+	endpointURL := discoveryService.GetOAuth2AuthorizationServerMetadata(context.Background()).TokenEndpoint
 	tokenSvc := newTokenService(grantHandlerProvider, scopeValidator, observabilitySvc, transactioner)
-	tokenHandler := newTokenHandler(tokenSvc, observabilitySvc)
-	registerRoutes(mux, tokenHandler, inboundClient, authnProvider, jwtService, discoveryService)
+	tokenHandler := newTokenHandler(tokenSvc, observabilitySvc, protocolTraceService, clientUsageService,
+		dpopService, endpointURL)
+	registerRoutes(mux, tokenHandler, inboundClient, authnProvider, jwtService, endpointURL)
 	return tokenHandler
 }
 
@@ -59,16 +68,15 @@ func registerRoutes(
 	inboundClient inboundclient.InboundClientServiceInterface,
 	authnProvider authnprovidermgr.AuthnProviderManagerInterface,
 	jwtService jwt.JWTServiceInterface,
-	discoveryService discovery.DiscoveryServiceInterface,
+	endpointURL string,
 ) {
 	corsOpts := middleware.CORSOptions{
 		AllowedMethods:   []string{"POST"},
-		AllowedHeaders:   middleware.DefaultAllowedHeaders,
+		AllowedHeaders:   append(slices.Clone(middleware.DefaultAllowedHeaders), dpop.HeaderName),
 		AllowCredentials: true,
 		MaxAge:           600,
 	}
 
-	endpointURL := discoveryService.GetOAuth2AuthorizationServerMetadata(context.Background()).TokenEndpoint
 	clientAuthMiddleware := clientauth.ClientAuthMiddleware(inboundClient, authnProvider, jwtService, endpointURL)
 	handler := clientAuthMiddleware(http.HandlerFunc(tokenHandler.HandleTokenRequest))
 
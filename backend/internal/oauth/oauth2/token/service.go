@@ -182,7 +182,7 @@ func (ts *tokenService) ProcessTokenRequest(
 	}
 
 	// Issue refresh token if applicable.
-	if grantType == constants.GrantTypeAuthorizationCode &&
+	if (grantType == constants.GrantTypeAuthorizationCode || grantType == constants.GrantTypeDeviceCode) &&
 		oauthApp.IsAllowedGrantType(constants.GrantTypeRefreshToken) {
 		logger.Debug("Issuing refresh token for the token request",
 			log.String("client_id", clientID), log.String("grant_type", grantTypeStr))
@@ -270,6 +270,7 @@ func (ts *tokenService) publishTokenIssuanceStartedEvent(ctx context.Context, cl
 		string(event.EventTypeTokenIssuanceStarted),
 		event.ComponentAuthHandler,
 	).
+		WithTenantID(sysContext.GetTenantID(ctx)).
 		WithStatus(event.StatusInProgress).
 		WithData(event.DataKey.ClientID, clientID).
 		WithData(event.DataKey.GrantType, grantType).
@@ -292,6 +293,7 @@ func (ts *tokenService) publishTokenIssuedEvent(
 		string(event.EventTypeTokenIssued),
 		event.ComponentAuthHandler,
 	).
+		WithTenantID(sysContext.GetTenantID(ctx)).
 		WithStatus(event.StatusSuccess).
 		WithData(event.DataKey.ClientID, clientID).
 		WithData(event.DataKey.GrantType, grantType).
@@ -322,6 +324,7 @@ func publishTokenIssuanceFailedEvent(
 		string(event.EventTypeTokenIssuanceFailed),
 		event.ComponentAuthHandler,
 	).
+		WithTenantID(sysContext.GetTenantID(ctx)).
 		WithStatus(event.StatusFailure).
 		WithData(event.DataKey.ClientID, clientID).
 		WithData(event.DataKey.GrantType, grantType).
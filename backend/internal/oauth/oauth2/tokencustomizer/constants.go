@@ -0,0 +1,53 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package tokencustomizer
+
+import "github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
+
+const loggerComponentName = "TokenCustomizerService"
+
+const (
+	// maxExpressionDepth caps the nesting depth of an expression so parsing and evaluation stay bounded.
+	maxExpressionDepth = 32
+	// maxClaimNameLength caps the length of a claim name set by a rule.
+	maxClaimNameLength = 256
+)
+
+// Variables an expression can read.
+const (
+	variableUser      = "user"
+	variableSubject   = "sub"
+	variableClientID  = "client_id"
+	variableGrantType = "grant_type"
+	variableScopes    = "scopes"
+)
+
+// reservedClaims are claims rules cannot set because they are managed by the token builder.
+var reservedClaims = map[string]struct{}{
+	"iss": {}, "sub": {}, "aud": {}, "exp": {}, "nbf": {}, "iat": {}, "jti": {},
+	"scope": {}, "client_id": {}, "grant_type": {}, "act": {}, "aci": {}, "azp": {},
+	"auth_time": {}, "acr": {}, "amr": {}, "nonce": {}, "sid": {},
+	"at_hash": {}, "c_hash": {}, "s_hash": {},
+	constants.ClaimTenantID:      {},
+	constants.ClaimConfirmation:  {},
+	constants.ClaimClaimsRequest: {},
+	constants.ClaimClaimsLocales: {},
+	constants.ClaimSubjectType:   {},
+	constants.ClaimServiceID:     {},
+}
@@ -0,0 +1,833 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package tokencustomizer
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// Expressions are a small, side-effect free language for computing claim values. An expression can read the user
+// attributes and the token request and call the built-in functions, so it cannot loop, perform I/O or reach any
+// other server state.
+//
+//	literals:  'text', "text", 42, 1.5, true, false, null, [a, b]
+//	variables: user.<attribute path>, sub, client_id, grant_type, scopes
+//	operators: c ? a : b, ||, &&, ==, !=, <, <=, >, >=, in, +, !, ( )
+//	functions: lower, upper, trim, join, split, contains, coalesce
+
+// errNestedTooDeeply is returned when an expression nests deeper than maxExpressionDepth.
+var errNestedTooDeeply = fmt.Errorf("expression is nested deeper than %d levels", maxExpressionDepth)
+
+// expression is a parsed expression.
+type expression struct {
+	root exprNode
+	// attributes are the user attribute paths the expression reads.
+	attributes []string
+}
+
+// compileExpression parses an expression no longer than maxLength bytes.
+func compileExpression(source string, maxLength int) (*expression, error) {
+	if strings.TrimSpace(source) == "" {
+		return nil, errors.New("expression is empty")
+	}
+	if maxLength > 0 && len(source) > maxLength {
+		return nil, fmt.Errorf("expression is longer than %d characters", maxLength)
+	}
+
+	tokens, err := tokenize(source)
+	if err != nil {
+		return nil, err
+	}
+	p := &exprParser{tokens: tokens}
+	root, err := p.parseConditional()
+	if err != nil {
+		return nil, err
+	}
+	if next := p.peek(); next.kind != tokenEOF {
+		return nil, fmt.Errorf("unexpected %q at position %d", next.text, next.pos)
+	}
+	return &expression{root: root, attributes: p.attributes}, nil
+}
+
+// evaluate computes the value of the expression for a token request.
+func (e *expression) evaluate(claimCtx *ClaimContext) (interface{}, error) {
+	return e.root.eval(claimCtx)
+}
+
+// tokenKind identifies the kind of a lexical token of an expression.
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenNumber
+	tokenString
+	tokenIdent
+	tokenOperator
+)
+
+// exprToken is a lexical token of an expression.
+type exprToken struct {
+	kind tokenKind
+	text string
+	// value is the decoded value of a number or string token.
+	value interface{}
+	pos   int
+}
+
+// operators lists the operator tokens, longest first so that "<=" is not read as "<".
+var operators = []string{"||", "&&", "==", "!=", "<=", ">=", "<", ">", "!", "+", "?", ":", "(", ")", "[", "]", ","}
+
+// tokenize splits an expression into lexical tokens.
+func tokenize(source string) ([]exprToken, error) {
+	tokens := make([]exprToken, 0)
+	runes := []rune(source)
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case r == '\'' || r == '"':
+			text, next, err := readString(runes, i)
+			if err != nil {
+				return nil, err
+			}
+			tokens = append(tokens, exprToken{kind: tokenString, text: string(runes[i:next]), value: text, pos: i})
+			i = next
+		case unicode.IsDigit(r):
+			start := i
+			for i < len(runes) && (unicode.IsDigit(runes[i]) || runes[i] == '.') {
+				i++
+			}
+			number, err := strconv.ParseFloat(string(runes[start:i]), 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid number %q at position %d", string(runes[start:i]), start)
+			}
+			tokens = append(tokens, exprToken{kind: tokenNumber, text: string(runes[start:i]), value: number,
+				pos: start})
+		case unicode.IsLetter(r) || r == '_':
+			start := i
+			for i < len(runes) && (unicode.IsLetter(runes[i]) || unicode.IsDigit(runes[i]) ||
+				runes[i] == '_' || runes[i] == '.') {
+				i++
+			}
+			tokens = append(tokens, exprToken{kind: tokenIdent, text: string(runes[start:i]), pos: start})
+		default:
+			operator := ""
+			for _, candidate := range operators {
+				if strings.HasPrefix(string(runes[i:min(i+2, len(runes))]), candidate) {
+					operator = candidate
+					break
+				}
+			}
+			if operator == "" {
+				return nil, fmt.Errorf("unexpected character %q at position %d", r, i)
+			}
+			tokens = append(tokens, exprToken{kind: tokenOperator, text: operator, pos: i})
+			i += len(operator)
+		}
+	}
+	return append(tokens, exprToken{kind: tokenEOF, pos: len(runes)}), nil
+}
+
+// readString reads the quoted string starting at runes[start] and returns its decoded text and the position
+// after the closing quote.
+func readString(runes []rune, start int) (string, int, error) {
+	quote := runes[start]
+	var text strings.Builder
+	for i := start + 1; i < len(runes); i++ {
+		switch runes[i] {
+		case quote:
+			return text.String(), i + 1, nil
+		case '\\':
+			i++
+			if i == len(runes) {
+				break
+			}
+			switch runes[i] {
+			case 'n':
+				text.WriteRune('\n')
+			case 't':
+				text.WriteRune('\t')
+			default:
+				text.WriteRune(runes[i])
+			}
+		default:
+			text.WriteRune(runes[i])
+		}
+	}
+	return "", 0, fmt.Errorf("unterminated string at position %d", start)
+}
+
+// exprParser is a recursive descent parser of expressions.
+type exprParser struct {
+	tokens     []exprToken
+	pos        int
+	depth      int
+	attributes []string
+}
+
+func (p *exprParser) peek() exprToken {
+	return p.tokens[p.pos]
+}
+
+func (p *exprParser) next() exprToken {
+	token := p.tokens[p.pos]
+	if token.kind != tokenEOF {
+		p.pos++
+	}
+	return token
+}
+
+// accept consumes the next token if it is the given operator or keyword.
+func (p *exprParser) accept(text string) bool {
+	token := p.peek()
+	if (token.kind == tokenOperator || token.kind == tokenIdent) && token.text == text {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+// expect consumes the next token, which must be the given operator.
+func (p *exprParser) expect(text string) error {
+	if !p.accept(text) {
+		token := p.peek()
+		if token.kind == tokenEOF {
+			return fmt.Errorf("expected %q at the end of the expression", text)
+		}
+		return fmt.Errorf("expected %q at position %d, found %q", text, token.pos, token.text)
+	}
+	return nil
+}
+
+// parseConditional parses "condition ? then : otherwise", the lowest precedence level. It is the entry point of
+// every nested expression, so it also enforces the nesting limit.
+func (p *exprParser) parseConditional() (exprNode, error) {
+	p.depth++
+	defer func() { p.depth-- }()
+	if p.depth > maxExpressionDepth {
+		return nil, errNestedTooDeeply
+	}
+
+	condition, err := p.parseBinary(0)
+	if err != nil {
+		return nil, err
+	}
+	if !p.accept("?") {
+		return condition, nil
+	}
+	then, err := p.parseConditional()
+	if err != nil {
+		return nil, err
+	}
+	if err := p.expect(":"); err != nil {
+		return nil, err
+	}
+	otherwise, err := p.parseConditional()
+	if err != nil {
+		return nil, err
+	}
+	return &conditionalNode{condition: condition, then: then, otherwise: otherwise}, nil
+}
+
+// binaryPrecedence lists the binary operators from the lowest to the highest precedence.
+var binaryPrecedence = [][]string{
+	{"||"},
+	{"&&"},
+	{"==", "!="},
+	{"<", "<=", ">", ">=", "in"},
+	{"+"},
+}
+
+// parseBinary parses the left-associative binary operators of a precedence level and the levels above it.
+func (p *exprParser) parseBinary(level int) (exprNode, error) {
+	if level == len(binaryPrecedence) {
+		return p.parseUnary()
+	}
+	left, err := p.parseBinary(level + 1)
+	if err != nil {
+		return nil, err
+	}
+	for {
+		op := ""
+		for _, candidate := range binaryPrecedence[level] {
+			if p.accept(candidate) {
+				op = candidate
+				break
+			}
+		}
+		if op == "" {
+			return left, nil
+		}
+		right, err := p.parseBinary(level + 1)
+		if err != nil {
+			return nil, err
+		}
+		left = &binaryNode{op: op, left: left, right: right}
+	}
+}
+
+// parseUnary parses a negation or a primary expression.
+func (p *exprParser) parseUnary() (exprNode, error) {
+	if !p.accept("!") {
+		return p.parsePrimary()
+	}
+	p.depth++
+	defer func() { p.depth-- }()
+	if p.depth > maxExpressionDepth {
+		return nil, errNestedTooDeeply
+	}
+	operand, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	return &notNode{operand: operand}, nil
+}
+
+// parsePrimary parses a literal, a variable, a function call, a list or a parenthesized expression.
+func (p *exprParser) parsePrimary() (exprNode, error) {
+	token := p.next()
+	switch token.kind {
+	case tokenNumber, tokenString:
+		return &literalNode{value: token.value}, nil
+	case tokenIdent:
+		switch token.text {
+		case "true":
+			return &literalNode{value: true}, nil
+		case "false":
+			return &literalNode{value: false}, nil
+		case "null":
+			return &literalNode{value: nil}, nil
+		}
+		if p.accept("(") {
+			return p.parseCall(token)
+		}
+		return p.parseVariable(token)
+	case tokenOperator:
+		switch token.text {
+		case "(":
+			inner, err := p.parseConditional()
+			if err != nil {
+				return nil, err
+			}
+			if err := p.expect(")"); err != nil {
+				return nil, err
+			}
+			return inner, nil
+		case "[":
+			items, err := p.parseArguments("]")
+			if err != nil {
+				return nil, err
+			}
+			return &listNode{items: items}, nil
+		}
+	case tokenEOF:
+		return nil, errors.New("unexpected end of the expression")
+	}
+	return nil, fmt.Errorf("unexpected %q at position %d", token.text, token.pos)
+}
+
+// parseArguments parses a comma separated list of expressions up to the closing operator.
+func (p *exprParser) parseArguments(closing string) ([]exprNode, error) {
+	items := make([]exprNode, 0)
+	if p.accept(closing) {
+		return items, nil
+	}
+	for {
+		item, err := p.parseConditional()
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+		if p.accept(closing) {
+			return items, nil
+		}
+		if err := p.expect(","); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// parseCall parses the arguments of a call to a built-in function.
+func (p *exprParser) parseCall(name exprToken) (exprNode, error) {
+	function, ok := exprFunctions[name.text]
+	if !ok {
+		return nil, fmt.Errorf("unknown function %q at position %d", name.text, name.pos)
+	}
+	args, err := p.parseArguments(")")
+	if err != nil {
+		return nil, err
+	}
+	if len(args) < function.minArgs || (function.maxArgs >= 0 && len(args) > function.maxArgs) {
+		return nil, fmt.Errorf("function %q does not accept %d arguments", name.text, len(args))
+	}
+	return &callNode{name: name.text, function: function, args: args}, nil
+}
+
+// parseVariable resolves a variable reference.
+func (p *exprParser) parseVariable(token exprToken) (exprNode, error) {
+	root, path, hasPath := strings.Cut(token.text, ".")
+	switch root {
+	case variableUser:
+		if !hasPath || slices.Contains(strings.Split(path, "."), "") {
+			return nil, fmt.Errorf("invalid user attribute reference %q at position %d", token.text, token.pos)
+		}
+		p.attributes = append(p.attributes, path)
+		return &variableNode{root: root, path: path}, nil
+	case variableSubject, variableClientID, variableGrantType, variableScopes:
+		if hasPath {
+			return nil, fmt.Errorf("variable %q has no fields", root)
+		}
+		return &variableNode{root: root}, nil
+	}
+	return nil, fmt.Errorf("unknown variable %q at position %d", token.text, token.pos)
+}
+
+// exprNode is a node of a parsed expression.
+type exprNode interface {
+	eval(claimCtx *ClaimContext) (interface{}, error)
+}
+
+// literalNode is a constant value.
+type literalNode struct {
+	value interface{}
+}
+
+func (n *literalNode) eval(*ClaimContext) (interface{}, error) {
+	return n.value, nil
+}
+
+// variableNode reads a user attribute or a property of the token request.
+type variableNode struct {
+	root string
+	path string
+}
+
+func (n *variableNode) eval(claimCtx *ClaimContext) (interface{}, error) {
+	switch n.root {
+	case variableUser:
+		return lookupAttribute(claimCtx.UserAttributes, n.path), nil
+	case variableSubject:
+		return claimCtx.Subject, nil
+	case variableClientID:
+		return claimCtx.ClientID, nil
+	case variableGrantType:
+		return claimCtx.GrantType, nil
+	case variableScopes:
+		scopes := make([]interface{}, 0, len(claimCtx.Scopes))
+		for _, scope := range claimCtx.Scopes {
+			scopes = append(scopes, scope)
+		}
+		return scopes, nil
+	}
+	return nil, fmt.Errorf("unknown variable %q", n.root)
+}
+
+// listNode builds a list.
+type listNode struct {
+	items []exprNode
+}
+
+func (n *listNode) eval(claimCtx *ClaimContext) (interface{}, error) {
+	values := make([]interface{}, 0, len(n.items))
+	for _, item := range n.items {
+		value, err := item.eval(claimCtx)
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, value)
+	}
+	return values, nil
+}
+
+// notNode negates the truthiness of its operand.
+type notNode struct {
+	operand exprNode
+}
+
+func (n *notNode) eval(claimCtx *ClaimContext) (interface{}, error) {
+	value, err := n.operand.eval(claimCtx)
+	if err != nil {
+		return nil, err
+	}
+	return !isTruthy(value), nil
+}
+
+// conditionalNode evaluates one of two branches depending on the truthiness of a condition.
+type conditionalNode struct {
+	condition exprNode
+	then      exprNode
+	otherwise exprNode
+}
+
+func (n *conditionalNode) eval(claimCtx *ClaimContext) (interface{}, error) {
+	condition, err := n.condition.eval(claimCtx)
+	if err != nil {
+		return nil, err
+	}
+	if isTruthy(condition) {
+		return n.then.eval(claimCtx)
+	}
+	return n.otherwise.eval(claimCtx)
+}
+
+// binaryNode applies a binary operator. The logical operators short-circuit and yield booleans.
+type binaryNode struct {
+	op    string
+	left  exprNode
+	right exprNode
+}
+
+func (n *binaryNode) eval(claimCtx *ClaimContext) (interface{}, error) {
+	left, err := n.left.eval(claimCtx)
+	if err != nil {
+		return nil, err
+	}
+	switch n.op {
+	case "||":
+		if isTruthy(left) {
+			return true, nil
+		}
+	case "&&":
+		if !isTruthy(left) {
+			return false, nil
+		}
+	}
+	right, err := n.right.eval(claimCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	switch n.op {
+	case "||", "&&":
+		return isTruthy(right), nil
+	case "==":
+		return valuesEqual(left, right), nil
+	case "!=":
+		return !valuesEqual(left, right), nil
+	case "<", "<=", ">", ">=":
+		order, err := compareValues(left, right)
+		if err != nil {
+			return nil, err
+		}
+		switch n.op {
+		case "<":
+			return order < 0, nil
+		case "<=":
+			return order <= 0, nil
+		case ">":
+			return order > 0, nil
+		}
+		return order >= 0, nil
+	case "in":
+		return containsValue(right, left)
+	case "+":
+		return addValues(left, right)
+	}
+	return nil, fmt.Errorf("unsupported operator %q", n.op)
+}
+
+// callNode calls a built-in function.
+type callNode struct {
+	name     string
+	function exprFunction
+	args     []exprNode
+}
+
+func (n *callNode) eval(claimCtx *ClaimContext) (interface{}, error) {
+	args := make([]interface{}, 0, len(n.args))
+	for _, arg := range n.args {
+		value, err := arg.eval(claimCtx)
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, value)
+	}
+	value, err := n.function.call(args)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", n.name, err)
+	}
+	return value, nil
+}
+
+// exprFunction is a built-in function. A maxArgs of -1 accepts any number of arguments.
+type exprFunction struct {
+	minArgs int
+	maxArgs int
+	call    func(args []interface{}) (interface{}, error)
+}
+
+// exprFunctions are the functions an expression can call. They return null for a null string or list argument
+// so that a missing attribute yields no claim rather than an error.
+var exprFunctions = map[string]exprFunction{
+	"lower": {minArgs: 1, maxArgs: 1, call: stringFunction(strings.ToLower)},
+	"upper": {minArgs: 1, maxArgs: 1, call: stringFunction(strings.ToUpper)},
+	"trim":  {minArgs: 1, maxArgs: 1, call: stringFunction(strings.TrimSpace)},
+	"join": {minArgs: 2, maxArgs: 2, call: func(args []interface{}) (interface{}, error) {
+		if args[0] == nil {
+			return nil, nil
+		}
+		items, ok := toList(args[0])
+		separator, isString := args[1].(string)
+		if !ok || !isString {
+			return nil, errors.New("expects a list and a string separator")
+		}
+		parts := make([]string, 0, len(items))
+		for _, item := range items {
+			part, ok := formatScalar(item)
+			if !ok {
+				return nil, fmt.Errorf("cannot join a %s", typeName(item))
+			}
+			parts = append(parts, part)
+		}
+		return strings.Join(parts, separator), nil
+	}},
+	"split": {minArgs: 2, maxArgs: 2, call: func(args []interface{}) (interface{}, error) {
+		if args[0] == nil {
+			return nil, nil
+		}
+		text, isText := args[0].(string)
+		separator, isSeparator := args[1].(string)
+		if !isText || !isSeparator {
+			return nil, errors.New("expects a string and a string separator")
+		}
+		parts := strings.Split(text, separator)
+		values := make([]interface{}, 0, len(parts))
+		for _, part := range parts {
+			values = append(values, part)
+		}
+		return values, nil
+	}},
+	"contains": {minArgs: 2, maxArgs: 2, call: func(args []interface{}) (interface{}, error) {
+		return containsValue(args[0], args[1])
+	}},
+	"coalesce": {minArgs: 1, maxArgs: -1, call: func(args []interface{}) (interface{}, error) {
+		for _, arg := range args {
+			if !isEmptyValue(arg) {
+				return arg, nil
+			}
+		}
+		return nil, nil
+	}},
+}
+
+// stringFunction adapts a string transformation to a built-in function of one argument.
+func stringFunction(transform func(string) string) func(args []interface{}) (interface{}, error) {
+	return func(args []interface{}) (interface{}, error) {
+		switch value := args[0].(type) {
+		case nil:
+			return nil, nil
+		case string:
+			return transform(value), nil
+		}
+		return nil, fmt.Errorf("expects a string, not a %s", typeName(args[0]))
+	}
+}
+
+// isTruthy reports whether a value counts as true in a condition. Null, false, zero and empty strings, lists and
+// objects are false.
+func isTruthy(value interface{}) bool {
+	if number, ok := toNumber(value); ok {
+		return number != 0
+	}
+	switch v := value.(type) {
+	case nil:
+		return false
+	case bool:
+		return v
+	case string:
+		return v != ""
+	case map[string]interface{}:
+		return len(v) > 0
+	}
+	if items, ok := toList(value); ok {
+		return len(items) > 0
+	}
+	return true
+}
+
+// valuesEqual reports whether two values are equal, comparing numbers by value and lists element by element.
+func valuesEqual(a, b interface{}) bool {
+	if x, ok := toNumber(a); ok {
+		y, ok := toNumber(b)
+		return ok && x == y
+	}
+	if x, ok := toList(a); ok {
+		y, ok := toList(b)
+		if !ok || len(x) != len(y) {
+			return false
+		}
+		for i := range x {
+			if !valuesEqual(x[i], y[i]) {
+				return false
+			}
+		}
+		return true
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+// compareValues orders two numbers or two strings.
+func compareValues(a, b interface{}) (int, error) {
+	if x, ok := toNumber(a); ok {
+		if y, ok := toNumber(b); ok {
+			switch {
+			case x < y:
+				return -1, nil
+			case x > y:
+				return 1, nil
+			}
+			return 0, nil
+		}
+	}
+	if x, ok := a.(string); ok {
+		if y, ok := b.(string); ok {
+			return strings.Compare(x, y), nil
+		}
+	}
+	return 0, fmt.Errorf("cannot compare a %s with a %s", typeName(a), typeName(b))
+}
+
+// containsValue reports whether a list holds an item, a string holds a substring or an object holds a key. A
+// null container holds nothing.
+func containsValue(container, item interface{}) (bool, error) {
+	if container == nil {
+		return false, nil
+	}
+	if items, ok := toList(container); ok {
+		for _, candidate := range items {
+			if valuesEqual(candidate, item) {
+				return true, nil
+			}
+		}
+		return false, nil
+	}
+	switch c := container.(type) {
+	case string:
+		if text, ok := item.(string); ok {
+			return strings.Contains(c, text), nil
+		}
+	case map[string]interface{}:
+		if key, ok := item.(string); ok {
+			_, exists := c[key]
+			return exists, nil
+		}
+	}
+	return false, fmt.Errorf("cannot look up a %s in a %s", typeName(item), typeName(container))
+}
+
+// addValues adds two numbers, concatenates two lists or concatenates a string with a scalar. Null counts as an
+// empty string in a concatenation, so a missing attribute does not fail the expression.
+func addValues(a, b interface{}) (interface{}, error) {
+	if x, ok := toNumber(a); ok {
+		if y, ok := toNumber(b); ok {
+			return x + y, nil
+		}
+	}
+	if x, ok := toList(a); ok {
+		if y, ok := toList(b); ok {
+			return append(append(make([]interface{}, 0, len(x)+len(y)), x...), y...), nil
+		}
+	}
+	_, leftIsString := a.(string)
+	_, rightIsString := b.(string)
+	if leftIsString || rightIsString {
+		left, leftOK := formatScalar(a)
+		right, rightOK := formatScalar(b)
+		if leftOK && rightOK {
+			return left + right, nil
+		}
+	}
+	return nil, fmt.Errorf("cannot add a %s and a %s", typeName(a), typeName(b))
+}
+
+// formatScalar formats a string, number, boolean or null as a string.
+func formatScalar(value interface{}) (string, bool) {
+	if number, ok := toNumber(value); ok {
+		return strconv.FormatFloat(number, 'f', -1, 64), true
+	}
+	switch v := value.(type) {
+	case nil:
+		return "", true
+	case string:
+		return v, true
+	case bool:
+		return strconv.FormatBool(v), true
+	}
+	return "", false
+}
+
+// toNumber converts a numeric value to a float64.
+func toNumber(value interface{}) (float64, bool) {
+	switch v := value.(type) {
+	case float64:
+		return v, true
+	case float32:
+		return float64(v), true
+	case int:
+		return float64(v), true
+	case int32:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	}
+	return 0, false
+}
+
+// toList converts a list value to a []interface{}.
+func toList(value interface{}) ([]interface{}, bool) {
+	switch v := value.(type) {
+	case []interface{}:
+		return v, true
+	case []string:
+		items := make([]interface{}, 0, len(v))
+		for _, item := range v {
+			items = append(items, item)
+		}
+		return items, true
+	}
+	return nil, false
+}
+
+// typeName returns the expression type name of a value for error messages.
+func typeName(value interface{}) string {
+	if _, ok := toNumber(value); ok {
+		return "number"
+	}
+	if _, ok := toList(value); ok {
+		return "list"
+	}
+	switch value.(type) {
+	case nil:
+		return "null"
+	case string:
+		return "string"
+	case bool:
+		return "boolean"
+	case map[string]interface{}:
+		return "object"
+	}
+	return fmt.Sprintf("%T", value)
+}
@@ -0,0 +1,144 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package tokencustomizer
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type ExpressionTestSuite struct {
+	suite.Suite
+	claimCtx *ClaimContext
+}
+
+func TestExpressionTestSuite(t *testing.T) {
+	suite.Run(t, new(ExpressionTestSuite))
+}
+
+func (suite *ExpressionTestSuite) SetupTest() {
+	suite.claimCtx = &ClaimContext{
+		Subject:   "user-1",
+		ClientID:  "client-1",
+		GrantType: "authorization_code",
+		Scopes:    []string{"openid", "profile"},
+		UserAttributes: map[string]interface{}{
+			"given_name":  "Alice",
+			"family_name": "Smith",
+			"department":  " Engineering ",
+			"age":         float64(34),
+			"groups":      []interface{}{"admins", "devs"},
+			"address":     map[string]interface{}{"country": "LK"},
+		},
+	}
+}
+
+func (suite *ExpressionTestSuite) evaluate(source string) interface{} {
+	expr, err := compileExpression(source, 1024)
+	suite.Require().NoError(err, source)
+	value, err := expr.evaluate(suite.claimCtx)
+	suite.Require().NoError(err, source)
+	return value
+}
+
+func (suite *ExpressionTestSuite) TestEvaluate() {
+	cases := []struct {
+		source   string
+		expected interface{}
+	}{
+		{`'text'`, "text"},
+		{`"it\'s"`, "it's"},
+		{`1.5 + 2`, float64(3.5)},
+		{`null`, nil},
+		{`user.given_name + " " + user.family_name`, "Alice Smith"},
+		{`user.given_name + user.missing`, "Alice"},
+		{`"age-" + user.age`, "age-34"},
+		{`user.address.country`, "LK"},
+		{`lower(trim(user.department))`, "engineering"},
+		{`upper(user.missing)`, nil},
+		{`join(user.groups, ",")`, "admins,devs"},
+		{`split("a b", " ")`, []interface{}{"a", "b"}},
+		{`"admins" in user.groups`, true},
+		{`contains(scopes, "email")`, false},
+		{`"Eng" in user.department`, true},
+		{`"country" in user.address`, true},
+		{`user.age >= 18 && grant_type == "authorization_code"`, true},
+		{`user.age < 18 || !(client_id != "client-1")`, true},
+		{`user.age > 40 ? "senior" : user.age > 30 ? "mid" : "junior"`, "mid"},
+		{`coalesce(user.nickname, "", user.given_name)`, "Alice"},
+		{`["a"] + scopes`, []interface{}{"a", "openid", "profile"}},
+		{`sub`, "user-1"},
+		{`[1, 2] == [1.0, 2]`, true},
+	}
+	for _, tc := range cases {
+		suite.Equal(tc.expected, suite.evaluate(tc.source), tc.source)
+	}
+}
+
+func (suite *ExpressionTestSuite) TestCompile_Invalid() {
+	for _, source := range []string{
+		``,
+		`user`,
+		`user..name`,
+		`secret.value`,
+		`sub.name`,
+		`exec("rm")`,
+		`lower()`,
+		`lower("a", "b")`,
+		`"unterminated`,
+		`1 +`,
+		`(1`,
+		`a = b`,
+		`1.2.3`,
+		`true false`,
+		strings.Repeat("(", 40) + "1" + strings.Repeat(")", 40),
+		strings.Repeat("!", 40) + "true",
+	} {
+		_, err := compileExpression(source, 1024)
+		suite.Error(err, source)
+	}
+}
+
+func (suite *ExpressionTestSuite) TestCompile_MaxLength() {
+	_, err := compileExpression(`"`+strings.Repeat("a", 20)+`"`, 10)
+	suite.Error(err)
+}
+
+func (suite *ExpressionTestSuite) TestCompile_CollectsAttributes() {
+	expr, err := compileExpression(`coalesce(user.nickname, user.name.given) + sub`, 1024)
+	suite.Require().NoError(err)
+	suite.Equal([]string{"nickname", "name.given"}, expr.attributes)
+}
+
+func (suite *ExpressionTestSuite) TestEvaluate_TypeErrors() {
+	for _, source := range []string{
+		`user.groups < 1`,
+		`user.address + 1`,
+		`lower(user.age)`,
+		`join(user.given_name, ",")`,
+		`1 in 2`,
+	} {
+		expr, err := compileExpression(source, 1024)
+		suite.Require().NoError(err, source)
+		_, err = expr.evaluate(suite.claimCtx)
+		suite.Error(err, source)
+	}
+}
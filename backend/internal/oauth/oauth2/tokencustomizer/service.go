@@ -0,0 +1,238 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package tokencustomizer applies the claim rules applications configure for their access and ID tokens.
+package tokencustomizer
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	inboundmodel "github.com/thunder-id/thunderid/internal/inboundclient/model"
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/log"
+)
+
+// TokenCustomizerServiceInterface defines the interface for applying the token customization rules of applications.
+type TokenCustomizerServiceInterface interface {
+	CustomizeAccessTokenClaims(client *inboundmodel.OAuthClient, claimCtx *ClaimContext,
+		claims map[string]interface{})
+	CustomizeIDTokenClaims(client *inboundmodel.OAuthClient, claimCtx *ClaimContext, claims map[string]interface{})
+}
+
+// tokenCustomizerService implements TokenCustomizerServiceInterface.
+type tokenCustomizerService struct {
+	config config.TokenCustomizationConfig
+	logger *log.Logger
+}
+
+// newTokenCustomizerService creates a new instance of tokenCustomizerService.
+func newTokenCustomizerService(customizationConfig config.TokenCustomizationConfig) *tokenCustomizerService {
+	return &tokenCustomizerService{
+		config: customizationConfig,
+		logger: log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName)),
+	}
+}
+
+// CustomizeAccessTokenClaims applies the access token rules of the client to the claims.
+func (s *tokenCustomizerService) CustomizeAccessTokenClaims(client *inboundmodel.OAuthClient,
+	claimCtx *ClaimContext, claims map[string]interface{}) {
+	if s.config.Disabled || client == nil || client.TokenCustomization == nil {
+		return
+	}
+	s.applyRules(client.TokenCustomization.AccessToken, claimCtx, claims)
+}
+
+// CustomizeIDTokenClaims applies the ID token rules of the client to the claims.
+func (s *tokenCustomizerService) CustomizeIDTokenClaims(client *inboundmodel.OAuthClient,
+	claimCtx *ClaimContext, claims map[string]interface{}) {
+	if s.config.Disabled || client == nil || client.TokenCustomization == nil {
+		return
+	}
+	s.applyRules(client.TokenCustomization.IDToken, claimCtx, claims)
+}
+
+// applyRules sets the claims the rules yield a value for. Rules for reserved claims and rules that fail to
+// evaluate are skipped, so a misbehaving rule never blocks token issuance.
+func (s *tokenCustomizerService) applyRules(rules []inboundmodel.TokenClaimRule, claimCtx *ClaimContext,
+	claims map[string]interface{}) {
+	if claimCtx == nil {
+		claimCtx = &ClaimContext{}
+	}
+	for _, rule := range rules {
+		if isReservedClaim(rule.Claim) {
+			s.logger.Debug("Skipping token claim rule for a reserved claim", log.String("claim", rule.Claim))
+			continue
+		}
+		value, err := s.resolve(rule, claimCtx)
+		if err != nil {
+			s.logger.Warn("Failed to evaluate token claim rule", log.String("claim", rule.Claim),
+				log.String("clientId", claimCtx.ClientID), log.Error(err))
+			continue
+		}
+		if !isEmptyValue(value) {
+			claims[rule.Claim] = value
+		}
+	}
+}
+
+// resolve returns the value a rule yields for a token request.
+func (s *tokenCustomizerService) resolve(rule inboundmodel.TokenClaimRule, claimCtx *ClaimContext) (
+	interface{}, error) {
+	switch rule.Type {
+	case inboundmodel.TokenClaimRuleStatic:
+		return rule.Value, nil
+	case inboundmodel.TokenClaimRuleAttribute:
+		return lookupAttribute(claimCtx.UserAttributes, rule.Attribute), nil
+	case inboundmodel.TokenClaimRuleExpression:
+		if !s.config.Expressions.Enabled {
+			return nil, errors.New("expression rules are disabled on the server")
+		}
+		expr, err := compileExpression(rule.Expression, s.config.Expressions.MaxLength)
+		if err != nil {
+			return nil, err
+		}
+		return expr.evaluate(claimCtx)
+	}
+	return nil, fmt.Errorf("unsupported rule type %q", rule.Type)
+}
+
+// GetSourceAttributes returns the top-level user attributes the rules of an application read, so callers that
+// fetch a filtered set of user attributes can include them. Rules that fail to parse read no attributes.
+func GetSourceAttributes(customization *inboundmodel.TokenCustomization) []string {
+	if customization == nil {
+		return nil
+	}
+
+	attributes := make([]string, 0)
+	add := func(path string) {
+		name, _, _ := strings.Cut(path, ".")
+		if name != "" && !slices.Contains(attributes, name) {
+			attributes = append(attributes, name)
+		}
+	}
+	for _, rule := range slices.Concat(customization.AccessToken, customization.IDToken) {
+		switch rule.Type {
+		case inboundmodel.TokenClaimRuleAttribute:
+			add(rule.Attribute)
+		case inboundmodel.TokenClaimRuleExpression:
+			if expr, err := compileExpression(rule.Expression, 0); err == nil {
+				for _, path := range expr.attributes {
+					add(path)
+				}
+			}
+		}
+	}
+	return attributes
+}
+
+// ValidateCustomization checks the claim rules of an application against the server configuration. It rejects
+// rules with an empty or reserved claim name, incomplete rules, unknown rule types, expressions that do not parse
+// and expression rules while they are disabled on the server.
+func ValidateCustomization(customization *inboundmodel.TokenCustomization,
+	customizationConfig config.TokenCustomizationConfig) error {
+	if customization == nil {
+		return nil
+	}
+	for _, rule := range customization.AccessToken {
+		if err := validateRule(rule, customizationConfig); err != nil {
+			return fmt.Errorf("access token rule: %w", err)
+		}
+	}
+	for _, rule := range customization.IDToken {
+		if err := validateRule(rule, customizationConfig); err != nil {
+			return fmt.Errorf("ID token rule: %w", err)
+		}
+	}
+	return nil
+}
+
+// validateRule checks a single claim rule.
+func validateRule(rule inboundmodel.TokenClaimRule, customizationConfig config.TokenCustomizationConfig) error {
+	claim := rule.Claim
+	switch {
+	case strings.TrimSpace(claim) == "":
+		return errors.New("claim name must not be empty")
+	case len(claim) > maxClaimNameLength:
+		return fmt.Errorf("claim name must not be longer than %d characters", maxClaimNameLength)
+	case isReservedClaim(claim):
+		return fmt.Errorf("claim %q is reserved and cannot be customized", claim)
+	}
+
+	switch rule.Type {
+	case inboundmodel.TokenClaimRuleStatic:
+		if isEmptyValue(rule.Value) {
+			return fmt.Errorf("static rule of claim %q must have a value", claim)
+		}
+	case inboundmodel.TokenClaimRuleAttribute:
+		if rule.Attribute == "" || slices.Contains(strings.Split(rule.Attribute, "."), "") {
+			return fmt.Errorf("attribute rule of claim %q must have a valid attribute path", claim)
+		}
+	case inboundmodel.TokenClaimRuleExpression:
+		if !customizationConfig.Expressions.Enabled {
+			return fmt.Errorf("expression rule of claim %q is not allowed as expression rules are disabled "+
+				"on the server", claim)
+		}
+		if _, err := compileExpression(rule.Expression, customizationConfig.Expressions.MaxLength); err != nil {
+			return fmt.Errorf("expression of claim %q is invalid: %w", claim, err)
+		}
+	default:
+		return fmt.Errorf("rule type %q of claim %q is not supported", rule.Type, claim)
+	}
+	return nil
+}
+
+// isReservedClaim reports whether a claim is managed by the token builder.
+func isReservedClaim(claim string) bool {
+	_, reserved := reservedClaims[claim]
+	return reserved || claim == ""
+}
+
+// lookupAttribute returns the value at a dot separated path of the user attributes, or nil if any segment is
+// missing.
+func lookupAttribute(userAttributes map[string]interface{}, path string) interface{} {
+	if path == "" {
+		return nil
+	}
+	var current interface{} = userAttributes
+	for _, segment := range strings.Split(path, ".") {
+		object, ok := current.(map[string]interface{})
+		if !ok {
+			return nil
+		}
+		current = object[segment]
+	}
+	return current
+}
+
+// isEmptyValue reports whether a claim value is absent, a blank string or an empty array or object.
+func isEmptyValue(value interface{}) bool {
+	switch v := value.(type) {
+	case nil:
+		return true
+	case string:
+		return strings.TrimSpace(v) == ""
+	case []interface{}:
+		return len(v) == 0
+	case map[string]interface{}:
+		return len(v) == 0
+	}
+	return false
+}
@@ -0,0 +1,167 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package tokencustomizer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	inboundmodel "github.com/thunder-id/thunderid/internal/inboundclient/model"
+	"github.com/thunder-id/thunderid/internal/system/config"
+)
+
+type TokenCustomizerServiceTestSuite struct {
+	suite.Suite
+	config   config.TokenCustomizationConfig
+	client   *inboundmodel.OAuthClient
+	claimCtx *ClaimContext
+}
+
+func TestTokenCustomizerServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(TokenCustomizerServiceTestSuite))
+}
+
+func (suite *TokenCustomizerServiceTestSuite) SetupTest() {
+	suite.config = config.TokenCustomizationConfig{
+		Expressions: config.TokenExpressionConfig{Enabled: true, MaxLength: 1024},
+	}
+	suite.client = &inboundmodel.OAuthClient{
+		ClientID: "client-1",
+		TokenCustomization: &inboundmodel.TokenCustomization{
+			AccessToken: []inboundmodel.TokenClaimRule{
+				{Claim: "tier", Type: inboundmodel.TokenClaimRuleStatic, Value: "gold"},
+				{Claim: "country", Type: inboundmodel.TokenClaimRuleAttribute, Attribute: "address.country"},
+				{Claim: "dept", Type: inboundmodel.TokenClaimRuleExpression, Expression: "lower(user.department)"},
+				{Claim: "tier", Type: inboundmodel.TokenClaimRuleExpression,
+					Expression: `"admins" in user.groups ? "platinum" : null`},
+				{Claim: "nickname", Type: inboundmodel.TokenClaimRuleAttribute, Attribute: "nickname"},
+				{Claim: "sub", Type: inboundmodel.TokenClaimRuleStatic, Value: "spoofed"},
+				{Claim: "broken", Type: inboundmodel.TokenClaimRuleExpression, Expression: "lower(user.groups)"},
+			},
+			IDToken: []inboundmodel.TokenClaimRule{
+				{Claim: "full_name", Type: inboundmodel.TokenClaimRuleExpression,
+					Expression: `user.given_name + " " + user.family_name`},
+			},
+		},
+	}
+	suite.claimCtx = &ClaimContext{
+		Subject:  "user-1",
+		ClientID: "client-1",
+		UserAttributes: map[string]interface{}{
+			"given_name":  "Alice",
+			"family_name": "Smith",
+			"department":  "Engineering",
+			"groups":      []interface{}{"admins"},
+			"address":     map[string]interface{}{"country": "LK"},
+		},
+	}
+}
+
+func (suite *TokenCustomizerServiceTestSuite) TestCustomizeAccessTokenClaims() {
+	claims := map[string]interface{}{"sub": "user-1", "nickname": "ali"}
+
+	newTokenCustomizerService(suite.config).CustomizeAccessTokenClaims(suite.client, suite.claimCtx, claims)
+
+	suite.Equal(map[string]interface{}{
+		"sub":      "user-1",
+		"nickname": "ali",
+		"tier":     "platinum",
+		"country":  "LK",
+		"dept":     "engineering",
+	}, claims)
+}
+
+func (suite *TokenCustomizerServiceTestSuite) TestCustomizeIDTokenClaims() {
+	claims := map[string]interface{}{}
+
+	newTokenCustomizerService(suite.config).CustomizeIDTokenClaims(suite.client, suite.claimCtx, claims)
+
+	suite.Equal(map[string]interface{}{"full_name": "Alice Smith"}, claims)
+}
+
+func (suite *TokenCustomizerServiceTestSuite) TestCustomize_ExpressionsDisabled() {
+	suite.config.Expressions.Enabled = false
+	claims := map[string]interface{}{}
+
+	newTokenCustomizerService(suite.config).CustomizeAccessTokenClaims(suite.client, suite.claimCtx, claims)
+
+	suite.Equal(map[string]interface{}{"tier": "gold", "country": "LK"}, claims)
+}
+
+func (suite *TokenCustomizerServiceTestSuite) TestCustomize_Disabled() {
+	suite.config.Disabled = true
+	claims := map[string]interface{}{}
+	service := newTokenCustomizerService(suite.config)
+
+	service.CustomizeAccessTokenClaims(suite.client, suite.claimCtx, claims)
+	service.CustomizeIDTokenClaims(suite.client, suite.claimCtx, claims)
+
+	suite.Empty(claims)
+}
+
+func (suite *TokenCustomizerServiceTestSuite) TestCustomize_NoCustomization() {
+	claims := map[string]interface{}{}
+	service := newTokenCustomizerService(suite.config)
+
+	service.CustomizeAccessTokenClaims(nil, suite.claimCtx, claims)
+	service.CustomizeIDTokenClaims(&inboundmodel.OAuthClient{}, nil, claims)
+
+	suite.Empty(claims)
+}
+
+func (suite *TokenCustomizerServiceTestSuite) TestGetSourceAttributes() {
+	attributes := GetSourceAttributes(suite.client.TokenCustomization)
+
+	suite.Nil(GetSourceAttributes(nil))
+	suite.Equal([]string{"address", "department", "groups", "nickname", "given_name", "family_name"}, attributes)
+}
+
+func (suite *TokenCustomizerServiceTestSuite) TestValidateCustomization() {
+	suite.NoError(ValidateCustomization(nil, suite.config))
+	suite.NoError(ValidateCustomization(&inboundmodel.TokenCustomization{
+		AccessToken: []inboundmodel.TokenClaimRule{
+			{Claim: "tier", Type: inboundmodel.TokenClaimRuleStatic, Value: "gold"},
+		},
+		IDToken: suite.client.TokenCustomization.IDToken,
+	}, suite.config))
+
+	for _, rule := range []inboundmodel.TokenClaimRule{
+		{Claim: " ", Type: inboundmodel.TokenClaimRuleStatic, Value: "x"},
+		{Claim: "iss", Type: inboundmodel.TokenClaimRuleStatic, Value: "x"},
+		{Claim: "cnf", Type: inboundmodel.TokenClaimRuleStatic, Value: "x"},
+		{Claim: "tier", Type: inboundmodel.TokenClaimRuleStatic},
+		{Claim: "tier", Type: inboundmodel.TokenClaimRuleAttribute, Attribute: "a..b"},
+		{Claim: "tier", Type: inboundmodel.TokenClaimRuleExpression, Expression: "unknown(1)"},
+		{Claim: "tier", Type: "script"},
+	} {
+		err := ValidateCustomization(&inboundmodel.TokenCustomization{
+			IDToken: []inboundmodel.TokenClaimRule{rule},
+		}, suite.config)
+		suite.Error(err, rule.Claim)
+	}
+}
+
+func (suite *TokenCustomizerServiceTestSuite) TestValidateCustomization_ExpressionsDisabled() {
+	suite.config.Expressions.Enabled = false
+
+	err := ValidateCustomization(suite.client.TokenCustomization, suite.config)
+
+	suite.ErrorContains(err, "expression rules are disabled")
+}
@@ -0,0 +1,56 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package tokenexplain
+
+const (
+	// loggerComponentName is the component name used in token explain logs.
+	loggerComponentName = "TokenExplainService"
+	// handlerLoggerComponentName is the component name used in token explain handler logs.
+	handlerLoggerComponentName = "TokenExplainHandler"
+
+	// explainPath is the path of the token explain endpoint.
+	explainPath = "/diagnostics/token-explain"
+)
+
+// Reasons of the decisions taken by the token issuance pipeline.
+const (
+	reasonGrantTypeAllowed        = "The client is allowed to use the grant type"
+	reasonGrantTypeNotAllowed     = "The client is not allowed to use the grant type"
+	reasonNoAllowedScopes         = "The client does not restrict the scopes it may request"
+	reasonScopeAllowed            = "The scope is in the allowed scopes of the client"
+	reasonScopeNotAllowed         = "The scope is not in the allowed scopes of the client"
+	reasonScopeInPolicy           = "The scope is in the allowed-scope policy of the grant type"
+	reasonScopeNotInPolicy        = "The scope is not in the allowed-scope policy of the grant type"
+	reasonPolicyDefaultScope      = "No scope is requested, so the scopes of the allowed-scope policy are requested"
+	reasonOIDCScope               = "OIDC scopes are not subject to authorization"
+	reasonScopeAuthorized         = "The subject is granted the permission through its roles"
+	reasonScopeNotAuthorized      = "The subject is not granted the permission through any of its roles"
+	reasonNoScopeCeiling          = "No scope ceiling rule matches the authentication context"
+	reasonScopeWithinCeiling      = "The scope is within the ceiling of the authentication context"
+	reasonScopeAboveCeiling       = "The scope exceeds the ceiling of the authentication context"
+	reasonNoAccessTokenAttributes = "The client does not configure user attributes for access tokens"
+	reasonNoSubjectAttributes     = "The subject of the grant has no user attributes"
+	reasonClaimIncluded           = "The user has a value for the attribute"
+	reasonClaimNoValue            = "The user has no value for the attribute"
+	reasonClaimNotAllowed         = "The attribute is not in the ID token user attributes of the client"
+	reasonPolicyClaimIncluded     = "The claim is added by a pre-issuance policy"
+	reasonPolicyClaimShadowed     = "The claim is already set by the token builder"
+	reasonPolicyAllowed           = "The pre-issuance policies allow the token request"
+	reasonNoOpenIDScope           = "The openid scope is not granted, so no ID token is issued"
+)
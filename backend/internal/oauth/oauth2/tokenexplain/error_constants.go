@@ -0,0 +1,107 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package tokenexplain
+
+import (
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/i18n/core"
+)
+
+// Client errors for token explain operations.
+var (
+	// ErrorInvalidRequestFormat is the error returned when the request body is malformed.
+	ErrorInvalidRequestFormat = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "TXP-1001",
+		Error: core.I18nMessage{
+			Key:          "error.tokenexplainservice.invalid_request_format",
+			DefaultValue: "Invalid request format",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.tokenexplainservice.invalid_request_format_description",
+			DefaultValue: "The request body is malformed or contains invalid data",
+		},
+	}
+	// ErrorMissingClientID is the error returned when the client ID is not provided.
+	ErrorMissingClientID = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "TXP-1002",
+		Error: core.I18nMessage{
+			Key:          "error.tokenexplainservice.missing_client_id",
+			DefaultValue: "Missing client ID",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.tokenexplainservice.missing_client_id_description",
+			DefaultValue: "The clientId field is required",
+		},
+	}
+	// ErrorInvalidGrantType is the error returned when the grant type is not supported.
+	ErrorInvalidGrantType = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "TXP-1003",
+		Error: core.I18nMessage{
+			Key:          "error.tokenexplainservice.invalid_grant_type",
+			DefaultValue: "Invalid grant type",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.tokenexplainservice.invalid_grant_type_description",
+			DefaultValue: "The grantType field must be a supported grant type",
+		},
+	}
+	// ErrorInvalidSubject is the error returned when the user ID does not fit the grant type.
+	ErrorInvalidSubject = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "TXP-1004",
+		Error: core.I18nMessage{
+			Key:          "error.tokenexplainservice.invalid_subject",
+			DefaultValue: "Invalid subject",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key: "error.tokenexplainservice.invalid_subject_description",
+			DefaultValue: "The userId field is required for user grants and must be omitted for " +
+				"the client_credentials grant",
+		},
+	}
+	// ErrorClientNotFound is the error returned when the client does not exist.
+	ErrorClientNotFound = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "TXP-1005",
+		Error: core.I18nMessage{
+			Key:          "error.tokenexplainservice.client_not_found",
+			DefaultValue: "Client not found",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.tokenexplainservice.client_not_found_description",
+			DefaultValue: "No OAuth client exists with the given client ID",
+		},
+	}
+	// ErrorUserNotFound is the error returned when the user does not exist.
+	ErrorUserNotFound = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "TXP-1006",
+		Error: core.I18nMessage{
+			Key:          "error.tokenexplainservice.user_not_found",
+			DefaultValue: "User not found",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.tokenexplainservice.user_not_found_description",
+			DefaultValue: "No user exists with the given user ID",
+		},
+	}
+)
@@ -0,0 +1,72 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package tokenexplain
+
+import (
+	"net/http"
+
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	sysutils "github.com/thunder-id/thunderid/internal/system/utils"
+)
+
+// tokenExplainHandler is the handler for token explain operations.
+type tokenExplainHandler struct {
+	tokenExplainService TokenExplainServiceInterface
+}
+
+// newTokenExplainHandler creates a new instance of tokenExplainHandler.
+func newTokenExplainHandler(tokenExplainService TokenExplainServiceInterface) *tokenExplainHandler {
+	return &tokenExplainHandler{
+		tokenExplainService: tokenExplainService,
+	}
+}
+
+// HandleExplainRequest handles the request to simulate a token request and explain its outcome.
+func (h *tokenExplainHandler) HandleExplainRequest(w http.ResponseWriter, r *http.Request) {
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, handlerLoggerComponentName))
+
+	request, err := sysutils.DecodeJSONBody[TokenExplainRequest](r)
+	if err != nil {
+		sysutils.WriteServiceErrorResponse(w, &ErrorInvalidRequestFormat, clientErrorStatusCodes)
+		return
+	}
+	request.ClientID = sysutils.SanitizeString(request.ClientID)
+	request.GrantType = sysutils.SanitizeString(request.GrantType)
+	request.UserID = sysutils.SanitizeString(request.UserID)
+	request.ACR = sysutils.SanitizeString(request.ACR)
+
+	response, svcErr := h.tokenExplainService.Explain(r.Context(), request)
+	if svcErr != nil {
+		sysutils.WriteServiceErrorResponse(w, svcErr, clientErrorStatusCodes)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(w, http.StatusOK, response)
+	logger.Debug("Token explain response sent", log.String("clientID", request.ClientID),
+		log.Int("decisions", len(response.Decisions)))
+}
+
+// clientErrorStatusCodes maps the client errors of the handler to HTTP status codes. Other client errors are
+// returned with 400 Bad Request.
+var clientErrorStatusCodes = map[string]int{
+	serviceerror.ErrorUnauthorized.Code: http.StatusForbidden,
+	ErrorClientNotFound.Code:            http.StatusNotFound,
+	ErrorUserNotFound.Code:              http.StatusNotFound,
+}
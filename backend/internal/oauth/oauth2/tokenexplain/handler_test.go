@@ -0,0 +1,110 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package tokenexplain
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/system/error/apierror"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+)
+
+type HandlerTestSuite struct {
+	suite.Suite
+	mockService *TokenExplainServiceInterfaceMock
+	handler     *tokenExplainHandler
+}
+
+func TestHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(HandlerTestSuite))
+}
+
+func (s *HandlerTestSuite) SetupTest() {
+	s.mockService = NewTokenExplainServiceInterfaceMock(s.T())
+	s.handler = newTokenExplainHandler(s.mockService)
+}
+
+func (s *HandlerTestSuite) TestHandleExplainRequest() {
+	s.mockService.On("Explain", mock.Anything, &TokenExplainRequest{
+		ClientID:  testClientID,
+		GrantType: "client_credentials",
+		Scopes:    []string{"orders:read"},
+	}).Return(&TokenExplainResponse{
+		ClientID: testClientID,
+		Issuable: true,
+		Scopes:   []string{"orders:read"},
+		Decisions: []Decision{
+			{Stage: StageAuthorization, Target: "orders:read", Outcome: OutcomeGranted, Reason: "granted"},
+		},
+	}, nil).Once()
+
+	rr := httptest.NewRecorder()
+	s.handler.HandleExplainRequest(rr, httptest.NewRequest(http.MethodPost, explainPath, strings.NewReader(
+		`{"clientId":"client-1","grantType":"client_credentials","scopes":["orders:read"]}`)))
+
+	s.Equal(http.StatusOK, rr.Code)
+	var body TokenExplainResponse
+	s.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &body))
+	s.True(body.Issuable)
+	s.Equal(OutcomeGranted, body.Decisions[0].Outcome)
+}
+
+func (s *HandlerTestSuite) TestHandleExplainRequest_InvalidBody() {
+	rr := httptest.NewRecorder()
+	s.handler.HandleExplainRequest(rr, httptest.NewRequest(http.MethodPost, explainPath,
+		strings.NewReader("{")))
+
+	s.Equal(http.StatusBadRequest, rr.Code)
+	var body apierror.ErrorResponse
+	s.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &body))
+	s.Equal(ErrorInvalidRequestFormat.Code, body.Code)
+}
+
+func (s *HandlerTestSuite) TestHandleExplainRequest_ErrorStatusCodes() {
+	testCases := []struct {
+		name       string
+		svcErr     *serviceerror.ServiceError
+		statusCode int
+	}{
+		{"Unauthorized", &serviceerror.ErrorUnauthorized, http.StatusForbidden},
+		{"ClientNotFound", &ErrorClientNotFound, http.StatusNotFound},
+		{"UserNotFound", &ErrorUserNotFound, http.StatusNotFound},
+		{"InvalidSubject", &ErrorInvalidSubject, http.StatusBadRequest},
+		{"InternalError", &serviceerror.InternalServerError, http.StatusInternalServerError},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.mockService.On("Explain", mock.Anything, mock.Anything).Return(nil, tc.svcErr).Once()
+
+			rr := httptest.NewRecorder()
+			s.handler.HandleExplainRequest(rr, httptest.NewRequest(http.MethodPost, explainPath,
+				strings.NewReader(`{"clientId":"client-1","grantType":"authorization_code"}`)))
+
+			s.Equal(tc.statusCode, rr.Code)
+		})
+	}
+}
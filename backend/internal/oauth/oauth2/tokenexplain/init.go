@@ -0,0 +1,64 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package tokenexplain
+
+import (
+	"net/http"
+
+	"github.com/thunder-id/thunderid/internal/authz"
+	"github.com/thunder-id/thunderid/internal/entityprovider"
+	"github.com/thunder-id/thunderid/internal/inboundclient"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/preissuance"
+	"github.com/thunder-id/thunderid/internal/system/middleware"
+	"github.com/thunder-id/thunderid/internal/system/sysauthz"
+)
+
+// Initialize initializes the token explain service and registers its routes.
+func Initialize(
+	mux *http.ServeMux,
+	sysAuthzService sysauthz.SystemAuthorizationServiceInterface,
+	inboundClient inboundclient.InboundClientServiceInterface,
+	entityProvider entityprovider.EntityProviderInterface,
+	authzService authz.AuthorizationServiceInterface,
+	preIssuanceService preissuance.PreIssuanceServiceInterface,
+) TokenExplainServiceInterface {
+	tokenExplainService := newTokenExplainService(sysAuthzService, inboundClient, entityProvider,
+		authzService, preIssuanceService)
+
+	tokenExplainHandler := newTokenExplainHandler(tokenExplainService)
+	registerRoutes(mux, tokenExplainHandler)
+
+	return tokenExplainService
+}
+
+// registerRoutes registers the routes for token explain operations.
+func registerRoutes(mux *http.ServeMux, tokenExplainHandler *tokenExplainHandler) {
+	opts := middleware.CORSOptions{
+		AllowedMethods:   []string{"POST"},
+		AllowedHeaders:   middleware.DefaultAllowedHeaders,
+		AllowCredentials: true,
+		MaxAge:           600,
+	}
+	mux.HandleFunc(middleware.WithCORS("POST "+explainPath,
+		tokenExplainHandler.HandleExplainRequest, opts))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS "+explainPath,
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, opts))
+}
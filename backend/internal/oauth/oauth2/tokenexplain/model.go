@@ -0,0 +1,95 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package tokenexplain simulates the token issuance pipeline for a client, a subject, scopes and a grant
+// without issuing a token, and explains each decision the pipeline would take. It helps troubleshoot why a
+// token is missing a scope or a claim.
+package tokenexplain
+
+// Stage is a stage of the token issuance pipeline.
+type Stage string
+
+const (
+	// StageGrantType checks that the client may use the grant type.
+	StageGrantType Stage = "GRANT_TYPE"
+	// StageAllowedScopes restricts the requested scopes to the scopes allowed for the client.
+	StageAllowedScopes Stage = "ALLOWED_SCOPES"
+	// StageScopePolicy restricts the scopes to the allowed-scope policy of the client for the grant type.
+	StageScopePolicy Stage = "SCOPE_POLICY"
+	// StageAuthorization keeps the non-OIDC scopes the subject is authorized for.
+	StageAuthorization Stage = "AUTHORIZATION"
+	// StageScopeCeiling caps the scopes at the ceiling of the completed authentication context.
+	StageScopeCeiling Stage = "SCOPE_CEILING"
+	// StageAccessTokenClaims embeds the user attributes configured for access tokens.
+	StageAccessTokenClaims Stage = "ACCESS_TOKEN_CLAIMS"
+	// StageIDTokenClaims embeds the claims of the granted OIDC scopes in the ID token.
+	StageIDTokenClaims Stage = "ID_TOKEN_CLAIMS"
+	// StagePreIssuancePolicy consults the pre-issuance policies.
+	StagePreIssuancePolicy Stage = "PRE_ISSUANCE_POLICY"
+)
+
+// Outcome is the outcome of a decision.
+type Outcome string
+
+const (
+	// OutcomeAllowed means the pipeline continues.
+	OutcomeAllowed Outcome = "ALLOWED"
+	// OutcomeDenied means the token request fails.
+	OutcomeDenied Outcome = "DENIED"
+	// OutcomeGranted means the scope is kept.
+	OutcomeGranted Outcome = "GRANTED"
+	// OutcomeDropped means the scope is silently removed from the token.
+	OutcomeDropped Outcome = "DROPPED"
+	// OutcomeRejected means the scope makes the token request fail.
+	OutcomeRejected Outcome = "REJECTED"
+	// OutcomeIncluded means the claim is embedded in the token.
+	OutcomeIncluded Outcome = "INCLUDED"
+	// OutcomeOmitted means the claim is left out of the token.
+	OutcomeOmitted Outcome = "OMITTED"
+)
+
+// TokenExplainRequest describes the token request to simulate.
+type TokenExplainRequest struct {
+	ClientID  string   `json:"clientId"`
+	GrantType string   `json:"grantType"`
+	UserID    string   `json:"userId,omitempty"`
+	Scopes    []string `json:"scopes,omitempty"`
+	ACR       string   `json:"acr,omitempty"`
+}
+
+// Decision explains a single decision of the token issuance pipeline. Target is the scope or claim the
+// decision applies to, and is empty for decisions on the token request as a whole.
+type Decision struct {
+	Stage   Stage   `json:"stage"`
+	Target  string  `json:"target,omitempty"`
+	Outcome Outcome `json:"outcome"`
+	Reason  string  `json:"reason"`
+}
+
+// TokenExplainResponse is the outcome of a simulated token request. Only the names of the claims are
+// returned; claim values are never disclosed.
+type TokenExplainResponse struct {
+	ClientID          string     `json:"clientId"`
+	GrantType         string     `json:"grantType"`
+	Subject           string     `json:"subject"`
+	Issuable          bool       `json:"issuable"`
+	Scopes            []string   `json:"scopes"`
+	AccessTokenClaims []string   `json:"accessTokenClaims"`
+	IDTokenClaims     []string   `json:"idTokenClaims,omitempty"`
+	Decisions         []Decision `json:"decisions"`
+}
@@ -0,0 +1,507 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package tokenexplain
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/thunder-id/thunderid/internal/authz"
+	"github.com/thunder-id/thunderid/internal/entityprovider"
+	"github.com/thunder-id/thunderid/internal/inboundclient"
+	inboundmodel "github.com/thunder-id/thunderid/internal/inboundclient/model"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/preissuance"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/scopeceiling"
+	oauth2utils "github.com/thunder-id/thunderid/internal/oauth/oauth2/utils"
+	"github.com/thunder-id/thunderid/internal/system/config"
+	sysContext "github.com/thunder-id/thunderid/internal/system/context"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/internal/system/security"
+	"github.com/thunder-id/thunderid/internal/system/sysauthz"
+)
+
+// TokenExplainServiceInterface defines the interface for the token explain service.
+type TokenExplainServiceInterface interface {
+	Explain(ctx context.Context, request *TokenExplainRequest) (*TokenExplainResponse, *serviceerror.ServiceError)
+}
+
+// tokenExplainService is the default implementation of the TokenExplainServiceInterface.
+type tokenExplainService struct {
+	sysAuthzService    sysauthz.SystemAuthorizationServiceInterface
+	inboundClient      inboundclient.InboundClientServiceInterface
+	entityProvider     entityprovider.EntityProviderInterface
+	authzService       authz.AuthorizationServiceInterface
+	preIssuanceService preissuance.PreIssuanceServiceInterface
+	logger             *log.Logger
+}
+
+// newTokenExplainService creates a new instance of tokenExplainService.
+func newTokenExplainService(
+	sysAuthzService sysauthz.SystemAuthorizationServiceInterface,
+	inboundClient inboundclient.InboundClientServiceInterface,
+	entityProvider entityprovider.EntityProviderInterface,
+	authzService authz.AuthorizationServiceInterface,
+	preIssuanceService preissuance.PreIssuanceServiceInterface,
+) TokenExplainServiceInterface {
+	return &tokenExplainService{
+		sysAuthzService:    sysAuthzService,
+		inboundClient:      inboundClient,
+		entityProvider:     entityProvider,
+		authzService:       authzService,
+		preIssuanceService: preIssuanceService,
+		logger:             log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName)),
+	}
+}
+
+// explanation accumulates the decisions of a simulated token request.
+type explanation struct {
+	response *TokenExplainResponse
+}
+
+// add records a decision.
+func (e *explanation) add(stage Stage, target string, outcome Outcome, reason string) {
+	e.response.Decisions = append(e.response.Decisions, Decision{
+		Stage:   stage,
+		Target:  target,
+		Outcome: outcome,
+		Reason:  reason,
+	})
+}
+
+// deny records a decision that fails the token request.
+func (e *explanation) deny(stage Stage, target string, outcome Outcome, reason string) {
+	e.add(stage, target, outcome, reason)
+	e.response.Issuable = false
+	e.response.Scopes = []string{}
+	e.response.AccessTokenClaims = []string{}
+	e.response.IDTokenClaims = nil
+}
+
+// Explain simulates the token issuance pipeline for the given request without issuing a token, and
+// returns the decision taken at each stage. The simulation covers the grant type of the client, the allowed
+// scopes of the client, the authorization of the scopes, the scope ceiling, the user attribute claims and
+// the pre-issuance policies.
+func (s *tokenExplainService) Explain(ctx context.Context, request *TokenExplainRequest) (
+	*TokenExplainResponse, *serviceerror.ServiceError) {
+	if svcErr := validateRequest(request); svcErr != nil {
+		return nil, svcErr
+	}
+
+	allowed, svcErr := s.sysAuthzService.IsActionAllowed(ctx, security.ActionExplainTokens,
+		&sysauthz.ActionContext{ResourceID: request.ClientID})
+	if svcErr != nil {
+		s.logger.Error("Failed to check authorization for action",
+			log.String("action", string(security.ActionExplainTokens)), log.Any("error", svcErr))
+		return nil, &serviceerror.InternalServerError
+	}
+	if !allowed {
+		return nil, &serviceerror.ErrorUnauthorized
+	}
+
+	oauthApp, err := s.inboundClient.GetOAuthClientByClientID(ctx, request.ClientID)
+	if err != nil {
+		s.logger.Error("Failed to resolve the OAuth client", log.String("clientID", request.ClientID),
+			log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+	if oauthApp == nil {
+		return nil, &ErrorClientNotFound
+	}
+
+	grantType := constants.GrantType(request.GrantType)
+	subject := request.UserID
+	if grantType == constants.GrantTypeClientCredentials {
+		subject = oauthApp.ID
+	}
+
+	var user *entityprovider.Entity
+	if request.UserID != "" {
+		var epErr *entityprovider.EntityProviderError
+		user, epErr = s.entityProvider.GetEntity(request.UserID)
+		if epErr != nil {
+			if epErr.Code == entityprovider.ErrorCodeEntityNotFound {
+				return nil, &ErrorUserNotFound
+			}
+			s.logger.Error("Failed to resolve the user", log.MaskedString(log.LoggerKeyUserID, request.UserID),
+				log.String("error", epErr.Error()))
+			return nil, &serviceerror.InternalServerError
+		}
+	}
+
+	e := &explanation{response: &TokenExplainResponse{
+		ClientID:          request.ClientID,
+		GrantType:         request.GrantType,
+		Subject:           subject,
+		Issuable:          true,
+		AccessTokenClaims: []string{},
+	}}
+
+	if !oauthApp.IsAllowedGrantType(grantType) {
+		e.deny(StageGrantType, request.GrantType, OutcomeDenied, reasonGrantTypeNotAllowed)
+		return e.response, nil
+	}
+	e.add(StageGrantType, request.GrantType, OutcomeAllowed, reasonGrantTypeAllowed)
+
+	scopes, ok := explainAllowedScopes(e, oauthApp, request.Scopes)
+	if !ok {
+		return e.response, nil
+	}
+
+	if scopes, ok = explainScopePolicy(e, oauthApp, grantType, scopes); !ok {
+		return e.response, nil
+	}
+
+	scopes, svcErr = s.explainAuthorization(ctx, e, oauthApp, grantType, subject, scopes)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+
+	if grantType == constants.GrantTypeAuthorizationCode || grantType == constants.GrantTypeRefreshToken {
+		if scopes, ok = explainScopeCeiling(e, oauthApp, request.ACR, scopes); !ok {
+			return e.response, nil
+		}
+	}
+	if scopes == nil {
+		scopes = []string{}
+	}
+	e.response.Scopes = scopes
+
+	userAttributes, svcErr := s.getUserAttributes(user)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	explainAccessTokenClaims(e, oauthApp, userAttributes)
+	explainIDTokenClaims(e, oauthApp, scopes, userAttributes)
+
+	s.explainPreIssuancePolicy(ctx, e, oauthApp, user)
+
+	s.logger.Debug("Explained token request", log.String("clientID", request.ClientID),
+		log.String("grantType", request.GrantType), log.Bool("issuable", e.response.Issuable))
+	return e.response, nil
+}
+
+// validateRequest validates the token request to simulate.
+func validateRequest(request *TokenExplainRequest) *serviceerror.ServiceError {
+	if request == nil {
+		return &ErrorInvalidRequestFormat
+	}
+	if request.ClientID == "" {
+		return &ErrorMissingClientID
+	}
+
+	grantType := constants.GrantType(request.GrantType)
+	if !grantType.IsValid() {
+		return &ErrorInvalidGrantType
+	}
+	if (grantType == constants.GrantTypeClientCredentials) != (request.UserID == "") {
+		return &ErrorInvalidSubject
+	}
+	return nil
+}
+
+// explainAllowedScopes restricts the requested scopes to the allowed scopes of the client. It returns
+// false when the token request is rejected for asking for a scope the client is not allowed to request.
+func explainAllowedScopes(e *explanation, oauthApp *inboundmodel.OAuthClient, scopes []string) ([]string, bool) {
+	if len(oauthApp.AllowedScopes) == 0 {
+		for _, scope := range scopes {
+			e.add(StageAllowedScopes, scope, OutcomeGranted, reasonNoAllowedScopes)
+		}
+		return scopes, true
+	}
+
+	allowed := make([]string, 0, len(scopes))
+	for _, scope := range scopes {
+		if slices.Contains(oauthApp.AllowedScopes, scope) {
+			e.add(StageAllowedScopes, scope, OutcomeGranted, reasonScopeAllowed)
+			allowed = append(allowed, scope)
+			continue
+		}
+		if oauthApp.RejectDisallowedScopes {
+			e.deny(StageAllowedScopes, scope, OutcomeRejected, reasonScopeNotAllowed)
+			return nil, false
+		}
+		e.add(StageAllowedScopes, scope, OutcomeDropped, reasonScopeNotAllowed)
+	}
+	return allowed, true
+}
+
+// explainScopePolicy restricts the scopes to the allowed-scope policy of the client for the grant type, or
+// requests every policy scope when no scope is requested. It returns false when the token request is
+// rejected for asking for a scope outside the policy.
+func explainScopePolicy(e *explanation, oauthApp *inboundmodel.OAuthClient, grantType constants.GrantType,
+	scopes []string) ([]string, bool) {
+	policyScopes, ok := oauthApp.GetGrantTypeAllowedScopes(grantType)
+	if !ok {
+		return scopes, true
+	}
+	if len(scopes) == 0 {
+		for _, scope := range policyScopes {
+			e.add(StageScopePolicy, scope, OutcomeGranted, reasonPolicyDefaultScope)
+		}
+		return policyScopes, true
+	}
+
+	allowed := make([]string, 0, len(scopes))
+	for _, scope := range scopes {
+		if slices.Contains(policyScopes, scope) {
+			e.add(StageScopePolicy, scope, OutcomeGranted, reasonScopeInPolicy)
+			allowed = append(allowed, scope)
+			continue
+		}
+		if oauthApp.RejectDisallowedScopes {
+			e.deny(StageScopePolicy, scope, OutcomeRejected, reasonScopeNotInPolicy)
+			return nil, false
+		}
+		e.add(StageScopePolicy, scope, OutcomeDropped, reasonScopeNotInPolicy)
+	}
+	return allowed, true
+}
+
+// explainAuthorization keeps the scopes the subject is authorized for. For user grants only the non-OIDC
+// scopes are authorized, while the client credentials grant authorizes every scope against the client.
+func (s *tokenExplainService) explainAuthorization(ctx context.Context, e *explanation,
+	oauthApp *inboundmodel.OAuthClient, grantType constants.GrantType, subject string, scopes []string) (
+	[]string, *serviceerror.ServiceError) {
+	requested := scopes
+	if grantType != constants.GrantTypeClientCredentials {
+		var oidcScopes []string
+		oidcScopes, requested = oauth2utils.SeparateOIDCAndNonOIDCScopes(strings.Join(scopes, " "),
+			oauthApp.ScopeClaims)
+		for _, scope := range oidcScopes {
+			e.add(StageAuthorization, scope, OutcomeGranted, reasonOIDCScope)
+		}
+	}
+	if len(requested) == 0 {
+		return scopes, nil
+	}
+
+	groupIDs, svcErr := s.getGroupIDs(subject)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	authzResp, svcErr := s.authzService.GetAuthorizedPermissions(ctx, authz.GetAuthorizedPermissionsRequest{
+		EntityID:             subject,
+		GroupIDs:             groupIDs,
+		RequestedPermissions: requested,
+	})
+	if svcErr != nil {
+		s.logger.Error("Failed to get authorized permissions", log.MaskedString(log.LoggerKeyUserID, subject),
+			log.String("error", svcErr.Error.DefaultValue))
+		return nil, &serviceerror.InternalServerError
+	}
+
+	authorized := make([]string, 0, len(scopes))
+	for _, scope := range scopes {
+		if !slices.Contains(requested, scope) {
+			authorized = append(authorized, scope)
+			continue
+		}
+		if slices.Contains(authzResp.AuthorizedPermissions, scope) {
+			e.add(StageAuthorization, scope, OutcomeGranted, reasonScopeAuthorized)
+			authorized = append(authorized, scope)
+			continue
+		}
+		e.add(StageAuthorization, scope, OutcomeDropped, reasonScopeNotAuthorized)
+	}
+	return authorized, nil
+}
+
+// getGroupIDs returns the IDs of the groups the subject belongs to, including inherited groups.
+func (s *tokenExplainService) getGroupIDs(subject string) ([]string, *serviceerror.ServiceError) {
+	groups, epErr := s.entityProvider.GetTransitiveEntityGroups(subject)
+	if epErr != nil {
+		if epErr.Code == entityprovider.ErrorCodeNotImplemented {
+			return nil, nil
+		}
+		s.logger.Error("Failed to resolve group memberships", log.MaskedString(log.LoggerKeyUserID, subject),
+			log.String("error", epErr.Error()))
+		return nil, &serviceerror.InternalServerError
+	}
+
+	groupIDs := make([]string, 0, len(groups))
+	for _, group := range groups {
+		if group.ID != "" && !slices.Contains(groupIDs, group.ID) {
+			groupIDs = append(groupIDs, group.ID)
+		}
+	}
+	return groupIDs, nil
+}
+
+// explainScopeCeiling caps the non-OIDC scopes at the ceiling of the completed ACR. It returns false when
+// the token request is rejected for asking for a scope above the ceiling.
+func explainScopeCeiling(e *explanation, oauthApp *inboundmodel.OAuthClient, acr string, scopes []string) (
+	[]string, bool) {
+	ceiling := scopeceiling.Resolve(acr)
+	if ceiling == nil {
+		e.add(StageScopeCeiling, "", OutcomeAllowed, reasonNoScopeCeiling)
+		return scopes, true
+	}
+
+	_, nonOIDCScopes := oauth2utils.SeparateOIDCAndNonOIDCScopes(strings.Join(scopes, " "), oauthApp.ScopeClaims)
+	capped := make([]string, 0, len(scopes))
+	for _, scope := range scopes {
+		if !slices.Contains(nonOIDCScopes, scope) {
+			capped = append(capped, scope)
+			continue
+		}
+		if ceiling.Allows(scope) {
+			e.add(StageScopeCeiling, scope, OutcomeGranted, reasonScopeWithinCeiling)
+			capped = append(capped, scope)
+			continue
+		}
+		if config.GetServerRuntime().Config.OAuth.ScopeCeilings.Reject {
+			e.deny(StageScopeCeiling, scope, OutcomeRejected, reasonScopeAboveCeiling)
+			return nil, false
+		}
+		e.add(StageScopeCeiling, scope, OutcomeDropped, reasonScopeAboveCeiling)
+	}
+	return capped, true
+}
+
+// getUserAttributes returns the attributes of the user, or nil when the grant has no user subject.
+func (s *tokenExplainService) getUserAttributes(user *entityprovider.Entity) (
+	map[string]interface{}, *serviceerror.ServiceError) {
+	if user == nil {
+		return nil, nil
+	}
+
+	attributes := make(map[string]interface{})
+	if len(user.Attributes) > 0 {
+		if err := json.Unmarshal(user.Attributes, &attributes); err != nil {
+			s.logger.Error("Failed to unmarshal user attributes",
+				log.MaskedString(log.LoggerKeyUserID, user.ID), log.Error(err))
+			return nil, &serviceerror.InternalServerError
+		}
+	}
+	return attributes, nil
+}
+
+// explainAccessTokenClaims decides which of the user attributes configured for access tokens are embedded
+// in the access token.
+func explainAccessTokenClaims(e *explanation, oauthApp *inboundmodel.OAuthClient,
+	userAttributes map[string]interface{}) {
+	var configured []string
+	if oauthApp.Token != nil && oauthApp.Token.AccessToken != nil {
+		configured = oauthApp.Token.AccessToken.UserAttributes
+	}
+	if len(configured) == 0 {
+		e.add(StageAccessTokenClaims, "", OutcomeOmitted, reasonNoAccessTokenAttributes)
+		return
+	}
+	if userAttributes == nil {
+		e.add(StageAccessTokenClaims, "", OutcomeOmitted, reasonNoSubjectAttributes)
+		return
+	}
+
+	for _, attribute := range configured {
+		if _, ok := userAttributes[attribute]; ok {
+			e.add(StageAccessTokenClaims, attribute, OutcomeIncluded, reasonClaimIncluded)
+			e.response.AccessTokenClaims = append(e.response.AccessTokenClaims, attribute)
+			continue
+		}
+		e.add(StageAccessTokenClaims, attribute, OutcomeOmitted, reasonClaimNoValue)
+	}
+}
+
+// explainIDTokenClaims decides which claims of the granted OIDC scopes are embedded in the ID token. A
+// claim is embedded when it is in the ID token user attributes of the client and the user has a value.
+func explainIDTokenClaims(e *explanation, oauthApp *inboundmodel.OAuthClient, scopes []string,
+	userAttributes map[string]interface{}) {
+	if userAttributes == nil {
+		return
+	}
+	if !slices.Contains(scopes, constants.ScopeOpenID) {
+		e.add(StageIDTokenClaims, "", OutcomeOmitted, reasonNoOpenIDScope)
+		return
+	}
+
+	var allowedAttributes []string
+	if oauthApp.Token != nil && oauthApp.Token.IDToken != nil {
+		allowedAttributes = oauthApp.Token.IDToken.UserAttributes
+	}
+
+	explained := make(map[string]struct{})
+	for _, scope := range scopes {
+		scopeClaims, ok := oauthApp.ScopeClaims[scope]
+		if !ok {
+			scopeClaims = constants.StandardOIDCScopes[scope].Claims
+		}
+		for _, claim := range scopeClaims {
+			if _, done := explained[claim]; done {
+				continue
+			}
+			explained[claim] = struct{}{}
+
+			switch {
+			case !slices.Contains(allowedAttributes, claim):
+				e.add(StageIDTokenClaims, claim, OutcomeOmitted, reasonClaimNotAllowed)
+			case userAttributes[claim] == nil:
+				e.add(StageIDTokenClaims, claim, OutcomeOmitted, reasonClaimNoValue)
+			default:
+				e.add(StageIDTokenClaims, claim, OutcomeIncluded, reasonClaimIncluded)
+				e.response.IDTokenClaims = append(e.response.IDTokenClaims, claim)
+			}
+		}
+	}
+}
+
+// explainPreIssuancePolicy consults the pre-issuance policies the way the token builder does, and decides
+// which of the claims returned by the policies are embedded in the access token.
+func (s *tokenExplainService) explainPreIssuancePolicy(ctx context.Context, e *explanation,
+	oauthApp *inboundmodel.OAuthClient, user *entityprovider.Entity) {
+	issuanceCtx := &preissuance.IssuanceContext{
+		TenantID:  sysContext.GetTenantID(ctx),
+		Subject:   e.response.Subject,
+		ClientID:  oauthApp.ClientID,
+		AppID:     oauthApp.ID,
+		AppOUID:   oauthApp.OUID,
+		GrantType: e.response.GrantType,
+		Scopes:    e.response.Scopes,
+	}
+	if user != nil {
+		issuanceCtx.EntityType = user.Type
+		issuanceCtx.OUID = user.OUID
+	}
+
+	decision := s.preIssuanceService.Evaluate(ctx, issuanceCtx)
+	if !decision.Allow {
+		e.deny(StagePreIssuancePolicy, "", OutcomeDenied, decision.Reason)
+		return
+	}
+	e.add(StagePreIssuancePolicy, "", OutcomeAllowed, reasonPolicyAllowed)
+
+	claims := make([]string, 0, len(decision.Claims))
+	for claim := range decision.Claims {
+		claims = append(claims, claim)
+	}
+	sort.Strings(claims)
+	for _, claim := range claims {
+		if slices.Contains(e.response.AccessTokenClaims, claim) {
+			e.add(StagePreIssuancePolicy, claim, OutcomeOmitted, reasonPolicyClaimShadowed)
+			continue
+		}
+		e.add(StagePreIssuancePolicy, claim, OutcomeIncluded, reasonPolicyClaimIncluded)
+		e.response.AccessTokenClaims = append(e.response.AccessTokenClaims, claim)
+	}
+}
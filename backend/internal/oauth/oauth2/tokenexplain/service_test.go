@@ -0,0 +1,410 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package tokenexplain
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/authz"
+	"github.com/thunder-id/thunderid/internal/entityprovider"
+	inboundmodel "github.com/thunder-id/thunderid/internal/inboundclient/model"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/preissuance"
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/security"
+	"github.com/thunder-id/thunderid/tests/mocks/authzmock"
+	"github.com/thunder-id/thunderid/tests/mocks/entityprovidermock"
+	"github.com/thunder-id/thunderid/tests/mocks/inboundclientmock"
+	"github.com/thunder-id/thunderid/tests/mocks/oauth/oauth2/preissuancemock"
+	"github.com/thunder-id/thunderid/tests/mocks/sysauthzmock"
+)
+
+const (
+	testClientID = "client-1"
+	testAppID    = "app-1"
+	testUserID   = "user-1"
+	testACRMFA   = "mfa"
+)
+
+type TokenExplainServiceTestSuite struct {
+	suite.Suite
+	mockSysAuthz      *sysauthzmock.SystemAuthorizationServiceInterfaceMock
+	mockInboundClient *inboundclientmock.InboundClientServiceInterfaceMock
+	mockEntity        *entityprovidermock.EntityProviderInterfaceMock
+	mockAuthz         *authzmock.AuthorizationServiceInterfaceMock
+	mockPreIssuance   *preissuancemock.PreIssuanceServiceInterfaceMock
+	service           TokenExplainServiceInterface
+	client            *inboundmodel.OAuthClient
+}
+
+func TestTokenExplainServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(TokenExplainServiceTestSuite))
+}
+
+func (suite *TokenExplainServiceTestSuite) SetupTest() {
+	suite.initConfig(false)
+	suite.mockSysAuthz = sysauthzmock.NewSystemAuthorizationServiceInterfaceMock(suite.T())
+	suite.mockInboundClient = inboundclientmock.NewInboundClientServiceInterfaceMock(suite.T())
+	suite.mockEntity = entityprovidermock.NewEntityProviderInterfaceMock(suite.T())
+	suite.mockAuthz = authzmock.NewAuthorizationServiceInterfaceMock(suite.T())
+	suite.mockPreIssuance = preissuancemock.NewPreIssuanceServiceInterfaceMock(suite.T())
+	suite.service = newTokenExplainService(suite.mockSysAuthz, suite.mockInboundClient, suite.mockEntity,
+		suite.mockAuthz, suite.mockPreIssuance)
+	suite.client = &inboundmodel.OAuthClient{
+		ID:       testAppID,
+		ClientID: testClientID,
+		GrantTypes: []constants.GrantType{
+			constants.GrantTypeAuthorizationCode,
+			constants.GrantTypeClientCredentials,
+		},
+		AllowedScopes: []string{"openid", "profile", "orders:read", "orders:write"},
+		Token: &inboundmodel.OAuthTokenConfig{
+			AccessToken: &inboundmodel.AccessTokenConfig{UserAttributes: []string{"email", "phone"}},
+			IDToken:     &inboundmodel.IDTokenConfig{UserAttributes: []string{"name"}},
+		},
+	}
+}
+
+func (suite *TokenExplainServiceTestSuite) TearDownTest() {
+	config.ResetServerRuntime()
+}
+
+func (suite *TokenExplainServiceTestSuite) initConfig(rejectAboveCeiling bool) {
+	config.ResetServerRuntime()
+	_ = config.InitializeServerRuntime("test", &config.Config{
+		OAuth: config.OAuthConfig{
+			ScopeCeilings: config.ScopeCeilingsConfig{
+				Reject: rejectAboveCeiling,
+				Rules: []config.ScopeCeilingRule{
+					{ACR: []string{testACRMFA}, Scopes: []string{"*"}},
+					{Scopes: []string{"orders:read"}},
+				},
+			},
+		},
+	})
+}
+
+func (suite *TokenExplainServiceTestSuite) expectAuthorized() {
+	suite.mockSysAuthz.On("IsActionAllowed", mock.Anything, security.ActionExplainTokens, mock.Anything).
+		Return(true, nil).Once()
+}
+
+func (suite *TokenExplainServiceTestSuite) expectClient() {
+	suite.mockInboundClient.On("GetOAuthClientByClientID", mock.Anything, testClientID).
+		Return(suite.client, nil).Once()
+}
+
+func (suite *TokenExplainServiceTestSuite) expectUser(attributes map[string]interface{}) {
+	raw, _ := json.Marshal(attributes)
+	suite.mockEntity.On("GetEntity", testUserID).Return(&entityprovider.Entity{
+		ID: testUserID, Type: "customer", OUID: "ou-1", Attributes: raw,
+	}, nil).Once()
+}
+
+func (suite *TokenExplainServiceTestSuite) userRequest(scopes ...string) *TokenExplainRequest {
+	return &TokenExplainRequest{
+		ClientID:  testClientID,
+		GrantType: string(constants.GrantTypeAuthorizationCode),
+		UserID:    testUserID,
+		Scopes:    scopes,
+	}
+}
+
+func findDecision(decisions []Decision, stage Stage, target string) *Decision {
+	for i := range decisions {
+		if decisions[i].Stage == stage && decisions[i].Target == target {
+			return &decisions[i]
+		}
+	}
+	return nil
+}
+
+func (suite *TokenExplainServiceTestSuite) TestExplain_UserGrant() {
+	suite.expectAuthorized()
+	suite.expectClient()
+	suite.expectUser(map[string]interface{}{"email": "alice@example.com", "name": "Alice"})
+	suite.mockEntity.On("GetTransitiveEntityGroups", testUserID).
+		Return([]entityprovider.EntityGroup{{ID: "group-1"}}, nil).Once()
+	suite.mockAuthz.On("GetAuthorizedPermissions", mock.Anything, authz.GetAuthorizedPermissionsRequest{
+		EntityID:             testUserID,
+		GroupIDs:             []string{"group-1"},
+		RequestedPermissions: []string{"orders:read", "orders:write"},
+	}).Return(&authz.GetAuthorizedPermissionsResponse{AuthorizedPermissions: []string{"orders:read"}}, nil).Once()
+	suite.mockPreIssuance.On("Evaluate", mock.Anything, mock.MatchedBy(func(ic *preissuance.IssuanceContext) bool {
+		return ic.Subject == testUserID && ic.EntityType == "customer" && ic.OUID == "ou-1" &&
+			ic.AppID == testAppID
+	})).Return(&preissuance.Decision{Allow: true, Claims: map[string]interface{}{
+		"email": "policy@example.com", "tier": "gold",
+	}}).Once()
+
+	response, svcErr := suite.service.Explain(context.Background(),
+		suite.userRequest("openid", "profile", "orders:read", "orders:write", "admin"))
+
+	suite.Nil(svcErr)
+	suite.True(response.Issuable)
+	suite.Equal(testUserID, response.Subject)
+	suite.Equal([]string{"openid", "profile", "orders:read"}, response.Scopes)
+	suite.Equal([]string{"email", "tier"}, response.AccessTokenClaims)
+	suite.Equal([]string{"name"}, response.IDTokenClaims)
+
+	suite.Equal(OutcomeDropped, findDecision(response.Decisions, StageAllowedScopes, "admin").Outcome)
+	suite.Equal(OutcomeGranted, findDecision(response.Decisions, StageAuthorization, "openid").Outcome)
+	suite.Equal(OutcomeDropped, findDecision(response.Decisions, StageAuthorization, "orders:write").Outcome)
+	suite.Equal(OutcomeGranted, findDecision(response.Decisions, StageScopeCeiling, "orders:read").Outcome)
+	suite.Equal(OutcomeOmitted, findDecision(response.Decisions, StageAccessTokenClaims, "phone").Outcome)
+	suite.Equal(OutcomeOmitted, findDecision(response.Decisions, StageIDTokenClaims, "given_name").Outcome)
+	suite.Equal(OutcomeOmitted, findDecision(response.Decisions, StagePreIssuancePolicy, "email").Outcome)
+	suite.Equal(OutcomeIncluded, findDecision(response.Decisions, StagePreIssuancePolicy, "tier").Outcome)
+}
+
+func (suite *TokenExplainServiceTestSuite) TestExplain_ScopeCeiling() {
+	testCases := []struct {
+		name     string
+		reject   bool
+		issuable bool
+		outcome  Outcome
+	}{
+		{"Drop", false, true, OutcomeDropped},
+		{"Reject", true, false, OutcomeRejected},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.initConfig(tc.reject)
+			suite.expectAuthorized()
+			suite.expectClient()
+			suite.expectUser(map[string]interface{}{})
+			suite.mockEntity.On("GetTransitiveEntityGroups", testUserID).Return(nil, nil).Once()
+			suite.mockAuthz.On("GetAuthorizedPermissions", mock.Anything, mock.Anything).Return(
+				&authz.GetAuthorizedPermissionsResponse{
+					AuthorizedPermissions: []string{"orders:read", "orders:write"},
+				}, nil).Once()
+			if tc.issuable {
+				suite.mockPreIssuance.On("Evaluate", mock.Anything, mock.Anything).
+					Return(&preissuance.Decision{Allow: true}).Once()
+			}
+
+			response, svcErr := suite.service.Explain(context.Background(),
+				suite.userRequest("orders:read", "orders:write"))
+
+			suite.Nil(svcErr)
+			suite.Equal(tc.issuable, response.Issuable)
+			suite.Equal(tc.outcome, findDecision(response.Decisions, StageScopeCeiling, "orders:write").Outcome)
+			if tc.issuable {
+				suite.Equal([]string{"orders:read"}, response.Scopes)
+			} else {
+				suite.Empty(response.Scopes)
+			}
+		})
+	}
+}
+
+func (suite *TokenExplainServiceTestSuite) TestExplain_ScopeCeilingLiftedByACR() {
+	suite.expectAuthorized()
+	suite.expectClient()
+	suite.expectUser(map[string]interface{}{})
+	suite.mockEntity.On("GetTransitiveEntityGroups", testUserID).Return(nil, nil).Once()
+	suite.mockAuthz.On("GetAuthorizedPermissions", mock.Anything, mock.Anything).Return(
+		&authz.GetAuthorizedPermissionsResponse{AuthorizedPermissions: []string{"orders:write"}}, nil).Once()
+	suite.mockPreIssuance.On("Evaluate", mock.Anything, mock.Anything).
+		Return(&preissuance.Decision{Allow: true}).Once()
+
+	request := suite.userRequest("orders:write")
+	request.ACR = testACRMFA
+	response, svcErr := suite.service.Explain(context.Background(), request)
+
+	suite.Nil(svcErr)
+	suite.Equal([]string{"orders:write"}, response.Scopes)
+	suite.Equal(OutcomeAllowed, findDecision(response.Decisions, StageScopeCeiling, "").Outcome)
+}
+
+func (suite *TokenExplainServiceTestSuite) TestExplain_ClientCredentials() {
+	suite.expectAuthorized()
+	suite.expectClient()
+	suite.mockEntity.On("GetTransitiveEntityGroups", testAppID).
+		Return(nil, entityprovider.NewEntityProviderError(entityprovider.ErrorCodeNotImplemented, "", "")).Once()
+	suite.mockAuthz.On("GetAuthorizedPermissions", mock.Anything, authz.GetAuthorizedPermissionsRequest{
+		EntityID:             testAppID,
+		RequestedPermissions: []string{"orders:read"},
+	}).Return(&authz.GetAuthorizedPermissionsResponse{AuthorizedPermissions: []string{"orders:read"}}, nil).Once()
+	suite.mockPreIssuance.On("Evaluate", mock.Anything, mock.Anything).
+		Return(&preissuance.Decision{Allow: true}).Once()
+
+	response, svcErr := suite.service.Explain(context.Background(), &TokenExplainRequest{
+		ClientID:  testClientID,
+		GrantType: string(constants.GrantTypeClientCredentials),
+		Scopes:    []string{"orders:read"},
+	})
+
+	suite.Nil(svcErr)
+	suite.True(response.Issuable)
+	suite.Equal(testAppID, response.Subject)
+	suite.Equal([]string{"orders:read"}, response.Scopes)
+	suite.Empty(response.AccessTokenClaims)
+	suite.Equal(OutcomeOmitted, findDecision(response.Decisions, StageAccessTokenClaims, "").Outcome)
+	suite.Nil(findDecision(response.Decisions, StageScopeCeiling, ""))
+}
+
+func (suite *TokenExplainServiceTestSuite) TestExplain_ClientCredentialsScopePolicy() {
+	suite.client.AllowedScopesByGrantType = map[string][]string{
+		string(constants.GrantTypeClientCredentials): {"orders:read", "orders:write"},
+	}
+	suite.expectAuthorized()
+	suite.expectClient()
+	suite.mockEntity.On("GetTransitiveEntityGroups", testAppID).
+		Return(nil, entityprovider.NewEntityProviderError(entityprovider.ErrorCodeNotImplemented, "", "")).Once()
+	suite.mockAuthz.On("GetAuthorizedPermissions", mock.Anything, authz.GetAuthorizedPermissionsRequest{
+		EntityID:             testAppID,
+		RequestedPermissions: []string{"orders:read", "orders:write"},
+	}).Return(&authz.GetAuthorizedPermissionsResponse{AuthorizedPermissions: []string{"orders:read"}}, nil).Once()
+	suite.mockPreIssuance.On("Evaluate", mock.Anything, mock.Anything).
+		Return(&preissuance.Decision{Allow: true}).Once()
+
+	response, svcErr := suite.service.Explain(context.Background(), &TokenExplainRequest{
+		ClientID:  testClientID,
+		GrantType: string(constants.GrantTypeClientCredentials),
+	})
+
+	suite.Nil(svcErr)
+	suite.True(response.Issuable)
+	suite.Equal([]string{"orders:read"}, response.Scopes)
+	suite.Equal(OutcomeGranted, findDecision(response.Decisions, StageScopePolicy, "orders:write").Outcome)
+	suite.Equal(OutcomeDropped, findDecision(response.Decisions, StageAuthorization, "orders:write").Outcome)
+}
+
+func (suite *TokenExplainServiceTestSuite) TestExplain_RejectDisallowedScope() {
+	suite.client.RejectDisallowedScopes = true
+	suite.expectAuthorized()
+	suite.expectClient()
+	suite.expectUser(map[string]interface{}{})
+
+	response, svcErr := suite.service.Explain(context.Background(), suite.userRequest("openid", "admin"))
+
+	suite.Nil(svcErr)
+	suite.False(response.Issuable)
+	suite.Equal(OutcomeRejected, findDecision(response.Decisions, StageAllowedScopes, "admin").Outcome)
+	suite.Nil(findDecision(response.Decisions, StageAuthorization, "openid"))
+}
+
+func (suite *TokenExplainServiceTestSuite) TestExplain_GrantTypeNotAllowed() {
+	suite.expectAuthorized()
+	suite.expectClient()
+	suite.expectUser(map[string]interface{}{})
+
+	request := suite.userRequest("openid")
+	request.GrantType = string(constants.GrantTypeRefreshToken)
+	response, svcErr := suite.service.Explain(context.Background(), request)
+
+	suite.Nil(svcErr)
+	suite.False(response.Issuable)
+	suite.Len(response.Decisions, 1)
+	suite.Equal(OutcomeDenied, response.Decisions[0].Outcome)
+}
+
+func (suite *TokenExplainServiceTestSuite) TestExplain_PolicyDenies() {
+	suite.expectAuthorized()
+	suite.expectClient()
+	suite.expectUser(map[string]interface{}{"email": "alice@example.com"})
+	suite.mockPreIssuance.On("Evaluate", mock.Anything, mock.Anything).
+		Return(&preissuance.Decision{Allow: false, Reason: "Outside business hours"}).Once()
+
+	response, svcErr := suite.service.Explain(context.Background(), suite.userRequest("openid"))
+
+	suite.Nil(svcErr)
+	suite.False(response.Issuable)
+	suite.Empty(response.AccessTokenClaims)
+	decision := findDecision(response.Decisions, StagePreIssuancePolicy, "")
+	suite.Equal(OutcomeDenied, decision.Outcome)
+	suite.Equal("Outside business hours", decision.Reason)
+}
+
+func (suite *TokenExplainServiceTestSuite) TestExplain_InvalidRequest() {
+	testCases := []struct {
+		name    string
+		request *TokenExplainRequest
+		svcErr  *serviceerror.ServiceError
+	}{
+		{"NilRequest", nil, &ErrorInvalidRequestFormat},
+		{"MissingClientID", &TokenExplainRequest{GrantType: "client_credentials"}, &ErrorMissingClientID},
+		{"InvalidGrantType", &TokenExplainRequest{ClientID: testClientID, GrantType: "password"},
+			&ErrorInvalidGrantType},
+		{"MissingUserID", &TokenExplainRequest{ClientID: testClientID, GrantType: "authorization_code"},
+			&ErrorInvalidSubject},
+		{"UnexpectedUserID", &TokenExplainRequest{ClientID: testClientID, GrantType: "client_credentials",
+			UserID: testUserID}, &ErrorInvalidSubject},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			response, svcErr := suite.service.Explain(context.Background(), tc.request)
+			suite.Nil(response)
+			suite.Equal(tc.svcErr, svcErr)
+		})
+	}
+}
+
+func (suite *TokenExplainServiceTestSuite) TestExplain_Unauthorized() {
+	suite.mockSysAuthz.On("IsActionAllowed", mock.Anything, security.ActionExplainTokens, mock.Anything).
+		Return(false, nil).Once()
+
+	response, svcErr := suite.service.Explain(context.Background(), suite.userRequest("openid"))
+
+	suite.Nil(response)
+	suite.Equal(&serviceerror.ErrorUnauthorized, svcErr)
+}
+
+func (suite *TokenExplainServiceTestSuite) TestExplain_ClientNotFound() {
+	suite.expectAuthorized()
+	suite.mockInboundClient.On("GetOAuthClientByClientID", mock.Anything, testClientID).Return(nil, nil).Once()
+
+	response, svcErr := suite.service.Explain(context.Background(), suite.userRequest("openid"))
+
+	suite.Nil(response)
+	suite.Equal(&ErrorClientNotFound, svcErr)
+}
+
+func (suite *TokenExplainServiceTestSuite) TestExplain_ClientLookupFails() {
+	suite.expectAuthorized()
+	suite.mockInboundClient.On("GetOAuthClientByClientID", mock.Anything, testClientID).
+		Return(nil, errors.New("db down")).Once()
+
+	response, svcErr := suite.service.Explain(context.Background(), suite.userRequest("openid"))
+
+	suite.Nil(response)
+	suite.Equal(&serviceerror.InternalServerError, svcErr)
+}
+
+func (suite *TokenExplainServiceTestSuite) TestExplain_UserNotFound() {
+	suite.expectAuthorized()
+	suite.expectClient()
+	suite.mockEntity.On("GetEntity", testUserID).
+		Return(nil, entityprovider.NewEntityProviderError(entityprovider.ErrorCodeEntityNotFound, "", "")).Once()
+
+	response, svcErr := suite.service.Explain(context.Background(), suite.userRequest("openid"))
+
+	suite.Nil(response)
+	suite.Equal(&ErrorUserNotFound, svcErr)
+}
@@ -20,13 +20,25 @@ package tokenservice
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"slices"
 
 	inboundmodel "github.com/thunder-id/thunderid/internal/inboundclient/model"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/claimfallback"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/dpop"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/jwksresolver"
 	oauth2model "github.com/thunder-id/thunderid/internal/oauth/oauth2/model"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/preissuance"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/roleclaims"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/scopeceiling"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/tokencustomizer"
 	oauth2utils "github.com/thunder-id/thunderid/internal/oauth/oauth2/utils"
+	"github.com/thunder-id/thunderid/internal/resource"
+	"github.com/thunder-id/thunderid/internal/system/config"
+	sysContext "github.com/thunder-id/thunderid/internal/system/context"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
 	"github.com/thunder-id/thunderid/internal/system/jose/jwe"
 	"github.com/thunder-id/thunderid/internal/system/jose/jwt"
 )
@@ -50,6 +62,14 @@ type tokenBuilder struct {
 	jwtService   jwt.JWTServiceInterface
 	jweService   jwe.JWEServiceInterface
 	jwksResolver *jwksresolver.Resolver
+	preIssuance  preissuance.PreIssuanceServiceInterface
+	roleClaims   roleclaims.RoleClaimsServiceInterface
+	// claimFallback fills the ID token claims the user has no attribute for.
+	claimFallback claimfallback.ClaimFallbackServiceInterface
+	// tokenCustomizer applies the claim rules the application configures for its tokens.
+	tokenCustomizer tokencustomizer.TokenCustomizerServiceInterface
+	// resourceService resolves the token format preferred by the resource servers in the audience.
+	resourceService resource.ResourceServiceInterface
 }
 
 // newTokenBuilder creates a new TokenBuilder instance.
@@ -57,11 +77,21 @@ func newTokenBuilder(
 	jwtService jwt.JWTServiceInterface,
 	jweService jwe.JWEServiceInterface,
 	resolver *jwksresolver.Resolver,
+	preIssuance preissuance.PreIssuanceServiceInterface,
+	roleClaims roleclaims.RoleClaimsServiceInterface,
+	claimFallback claimfallback.ClaimFallbackServiceInterface,
+	tokenCustomizer tokencustomizer.TokenCustomizerServiceInterface,
+	resourceService resource.ResourceServiceInterface,
 ) TokenBuilderInterface {
 	return &tokenBuilder{
-		jwtService:   jwtService,
-		jweService:   jweService,
-		jwksResolver: resolver,
+		jwtService:      jwtService,
+		jweService:      jweService,
+		jwksResolver:    resolver,
+		preIssuance:     preIssuance,
+		roleClaims:      roleClaims,
+		claimFallback:   claimFallback,
+		tokenCustomizer: tokenCustomizer,
+		resourceService: resourceService,
 	}
 }
 
@@ -78,9 +108,39 @@ func (tb *tokenBuilder) BuildAccessToken(ctx *AccessTokenBuildContext) (*oauth2m
 	if claimsErr != nil {
 		return nil, fmt.Errorf("failed to build access token claims: %w", claimsErr)
 	}
+	if roleErr := tb.addRoleClaims(ctx, jwtClaims); roleErr != nil {
+		return nil, fmt.Errorf("failed to build access token role claims: %w", roleErr)
+	}
+	if tb.tokenCustomizer != nil {
+		tb.tokenCustomizer.CustomizeAccessTokenClaims(ctx.OAuthApp, &tokencustomizer.ClaimContext{
+			Subject:        ctx.Subject,
+			ClientID:       ctx.ClientID,
+			GrantType:      ctx.GrantType,
+			Scopes:         ctx.Scopes,
+			UserAttributes: ctx.UserAttributes,
+		}, jwtClaims)
+	}
+
+	if tb.preIssuance != nil {
+		decision := tb.preIssuance.Evaluate(resolveContext(ctx.Context), newIssuanceContext(ctx))
+		if !decision.Allow {
+			return nil, &IssuanceDeniedError{Reason: decision.Reason}
+		}
+		// Policy claims annotate the token and never replace the claims built from the request.
+		for key, value := range decision.Claims {
+			if _, exists := jwtClaims[key]; !exists {
+				jwtClaims[key] = value
+			}
+		}
+	}
+
+	tokenType := constants.TokenTypeBearer
+	if _, bound := jwtClaims[constants.ClaimConfirmation]; bound {
+		tokenType = constants.TokenTypeDPoP
+	}
 
 	tokenDTO := &oauth2model.TokenDTO{
-		TokenType:        constants.TokenTypeBearer,
+		TokenType:        tokenType,
 		ExpiresIn:        tokenConfig.ValidityPeriod,
 		Scopes:           ctx.Scopes,
 		ClientID:         ctx.ClientID,
@@ -90,6 +150,12 @@ func (tb *tokenBuilder) BuildAccessToken(ctx *AccessTokenBuildContext) (*oauth2m
 		Audiences:        ctx.Audiences,
 		ClaimsRequest:    ctx.ClaimsRequest,
 		ClaimsLocales:    ctx.ClaimsLocales,
+		ACR:              ctx.ACR,
+	}
+
+	jwtType, typeErr := tb.resolveAccessTokenType(resolveContext(ctx.Context), ctx.Audiences)
+	if typeErr != nil {
+		return nil, typeErr
 	}
 
 	token, iat, err := tb.jwtService.GenerateJWT(
@@ -98,7 +164,7 @@ func (tb *tokenBuilder) BuildAccessToken(ctx *AccessTokenBuildContext) (*oauth2m
 		tokenConfig.Issuer,
 		tokenConfig.ValidityPeriod,
 		jwtClaims,
-		jwt.TokenTypeAccessToken,
+		jwtType,
 		"",
 	)
 	if err != nil {
@@ -112,6 +178,37 @@ func (tb *tokenBuilder) BuildAccessToken(ctx *AccessTokenBuildContext) (*oauth2m
 	return tokenDTO, nil
 }
 
+// resolveAccessTokenType returns the JWT type of an access token issued for the audiences. Access
+// tokens are typed "at+jwt" as defined in RFC 9068, unless every audience that is a registered
+// resource server prefers the jwt token format. Audiences that are not resource servers, such as
+// the client ID, do not affect the type.
+func (tb *tokenBuilder) resolveAccessTokenType(ctx context.Context, audiences []string) (string, error) {
+	if tb.resourceService == nil {
+		return jwt.TokenTypeAccessToken, nil
+	}
+
+	preferJWT := false
+	for _, audience := range audiences {
+		resourceServer, svcErr := tb.resourceService.GetResourceServerByIdentifier(ctx, audience)
+		if svcErr != nil {
+			if svcErr.Type == serviceerror.ServerErrorType {
+				return "", fmt.Errorf("failed to resolve resource server of audience %s: %s",
+					audience, svcErr.Error.DefaultValue)
+			}
+			continue
+		}
+		if resourceServer.TokenFormat != resource.TokenFormatJWT {
+			return jwt.TokenTypeAccessToken, nil
+		}
+		preferJWT = true
+	}
+
+	if preferJWT {
+		return jwt.TokenTypeJWT, nil
+	}
+	return jwt.TokenTypeAccessToken, nil
+}
+
 // buildAccessTokenClaims builds the claims map for an access token.
 func (tb *tokenBuilder) buildAccessTokenClaims(
 	ctx *AccessTokenBuildContext,
@@ -145,12 +242,31 @@ func (tb *tokenBuilder) buildAccessTokenClaims(
 	if ctx.AttributeCacheID != "" {
 		claims["aci"] = ctx.AttributeCacheID
 	}
+	if ctx.AuthTime > 0 {
+		claims[constants.ClaimAuthTime] = ctx.AuthTime
+	}
+	if ctx.ACR != "" {
+		claims["acr"] = ctx.ACR
+	}
+	if ctx.ServiceIdentityID != "" {
+		claims[constants.ClaimSubjectType] = constants.PrincipalTypeService
+		claims[constants.ClaimServiceID] = ctx.ServiceIdentityID
+	}
 
 	if ctx.ActorClaims != nil {
 		actClaim := tb.buildActorClaim(ctx.ActorClaims)
 		claims["act"] = actClaim
 	}
 
+	// Bind the token to the tenant it was issued in so that it cannot be replayed against another tenant.
+	if tenantID := sysContext.GetTenantID(ctx.Context); tenantID != "" {
+		claims[constants.ClaimTenantID] = tenantID
+	}
+	// Bind the token to the key of the DPoP proof of the token request (RFC 9449 section 6).
+	if thumbprint := dpop.GetThumbprint(ctx.Context); thumbprint != "" {
+		claims[constants.ClaimConfirmation] = map[string]interface{}{"jkt": thumbprint}
+	}
+
 	// Include only userinfo claims request for UserInfo endpoint support
 	if ctx.ClaimsRequest != nil && ctx.ClaimsRequest.UserInfo != nil {
 		userinfoClaims := &oauth2model.ClaimsRequest{
@@ -179,6 +295,74 @@ func (tb *tokenBuilder) buildAccessTokenClaims(
 	return claims, nil
 }
 
+// addRoleClaims adds the roles of the token subject and the scopes mapped to them to the access token claims
+// when the application enables role inclusion. Roles are resolved on every build so that refreshed tokens
+// reflect the current role assignments. A roles claim larger than the configured limit is replaced by a
+// reference to the roles endpoint.
+func (tb *tokenBuilder) addRoleClaims(ctx *AccessTokenBuildContext, claims map[string]interface{}) error {
+	if tb.roleClaims == nil || ctx.Subject == "" || ctx.OAuthApp == nil || ctx.OAuthApp.Token == nil ||
+		ctx.OAuthApp.Token.AccessToken == nil || !ctx.OAuthApp.Token.AccessToken.IncludeRoles {
+		return nil
+	}
+
+	// Roles of a machine client are assigned to the service identity it acts as.
+	entityID := ctx.Subject
+	if ctx.ServiceIdentityID != "" {
+		entityID = ctx.ServiceIdentityID
+	}
+	roleClaims, err := tb.roleClaims.ResolveRoleClaims(resolveContext(ctx.Context), entityID)
+	if err != nil {
+		return err
+	}
+
+	if len(roleClaims.Scopes) > 0 {
+		// Role-derived scopes of user tokens are capped at the ceiling of the authentication the user completed.
+		var ceiling *scopeceiling.Ceiling
+		if ctx.GrantType == string(constants.GrantTypeAuthorizationCode) {
+			ceiling = scopeceiling.Resolve(ctx.ACR)
+		}
+		scopes := append([]string{}, ctx.Scopes...)
+		for _, scope := range roleClaims.Scopes {
+			if ceiling.Allows(scope) && !slices.Contains(scopes, scope) {
+				scopes = append(scopes, scope)
+			}
+		}
+		claims["scope"] = JoinScopes(scopes)
+	}
+
+	serialized, err := json.Marshal(roleClaims.Roles)
+	if err != nil {
+		return fmt.Errorf("failed to serialize roles claim: %w", err)
+	}
+	if maxSize := tb.roleClaims.GetMaxClaimSize(); maxSize > 0 && len(serialized) > maxSize {
+		claims[constants.ClaimRolesRef] = config.GetServerURL(&config.GetServerRuntime().Config.Server) +
+			constants.OAuth2RolesEndpoint
+		return nil
+	}
+	claims[tb.roleClaims.GetClaimName()] = roleClaims.Roles
+	return nil
+}
+
+// newIssuanceContext builds the context evaluated by the pre-issuance policies from an access token
+// build context.
+func newIssuanceContext(ctx *AccessTokenBuildContext) *preissuance.IssuanceContext {
+	issuanceCtx := &preissuance.IssuanceContext{
+		TenantID:  sysContext.GetTenantID(ctx.Context),
+		Subject:   ctx.Subject,
+		ClientID:  ctx.ClientID,
+		GrantType: ctx.GrantType,
+		Scopes:    ctx.Scopes,
+	}
+	if ouID, ok := ctx.UserAttributes[constants.ClaimOUID].(string); ok {
+		issuanceCtx.OUID = ouID
+	}
+	if ctx.OAuthApp != nil {
+		issuanceCtx.AppID = ctx.OAuthApp.ID
+		issuanceCtx.AppOUID = ctx.OAuthApp.OUID
+	}
+	return issuanceCtx
+}
+
 // buildAccessTokenUserAttributes builds user attributes for the access token based on app configuration.
 func (tb *tokenBuilder) buildAccessTokenUserAttributes(
 	attrs map[string]interface{},
@@ -289,6 +473,16 @@ func (tb *tokenBuilder) buildRefreshTokenClaims(ctx *RefreshTokenBuildContext) (
 	if ctx.AttributeCacheID != "" {
 		claims["aci"] = ctx.AttributeCacheID
 	}
+	if ctx.ACR != "" {
+		claims["acr"] = ctx.ACR
+	}
+	// Refresh tokens of public clients are bound to the DPoP proof key, as the client cannot authenticate
+	// when refreshing them (RFC 9449 section 5).
+	if ctx.OAuthApp != nil && ctx.OAuthApp.PublicClient {
+		if thumbprint := dpop.GetThumbprint(ctx.Context); thumbprint != "" {
+			claims[constants.ClaimConfirmation] = map[string]interface{}{"jkt": thumbprint}
+		}
+	}
 
 	// Include claims request if present
 	if ctx.ClaimsRequest != nil && !ctx.ClaimsRequest.IsEmpty() {
@@ -400,6 +594,10 @@ func (tb *tokenBuilder) buildIDTokenClaims(ctx *IDTokenBuildContext) map[string]
 	if userAttributes == nil {
 		userAttributes = make(map[string]interface{})
 	}
+	if tb.claimFallback != nil {
+		userAttributes = tb.claimFallback.ApplyFallbacks(resolveContext(ctx.Context), ctx.OAuthApp, ctx.Subject,
+			userAttributes)
+	}
 
 	// Get scope claims mapping and allowed user attributes from app config
 	var scopeClaimsMapping map[string][]string
@@ -428,5 +626,18 @@ func (tb *tokenBuilder) buildIDTokenClaims(ctx *IDTokenBuildContext) map[string]
 		claims[key] = value
 	}
 
+	if tb.tokenCustomizer != nil {
+		claimCtx := &tokencustomizer.ClaimContext{
+			Subject:        ctx.Subject,
+			GrantType:      ctx.GrantType,
+			Scopes:         ctx.Scopes,
+			UserAttributes: userAttributes,
+		}
+		if ctx.OAuthApp != nil {
+			claimCtx.ClientID = ctx.OAuthApp.ClientID
+		}
+		tb.tokenCustomizer.CustomizeIDTokenClaims(ctx.OAuthApp, claimCtx, claims)
+	}
+
 	return claims
 }
@@ -24,6 +24,7 @@ import (
 	"crypto/rsa"
 	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"io"
 	"math/big"
 	"net/http"
@@ -41,13 +42,25 @@ import (
 	certmodel "github.com/thunder-id/thunderid/internal/cert"
 	inboundmodel "github.com/thunder-id/thunderid/internal/inboundclient/model"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/dpop"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/jwksresolver"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/preissuance"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/roleclaims"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/tokencustomizer"
+	"github.com/thunder-id/thunderid/internal/resource"
 	"github.com/thunder-id/thunderid/internal/system/config"
+	sysContext "github.com/thunder-id/thunderid/internal/system/context"
 	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
 	"github.com/thunder-id/thunderid/internal/system/jose/jwe"
+	"github.com/thunder-id/thunderid/internal/system/jose/jwt"
 	"github.com/thunder-id/thunderid/tests/mocks/httpmock"
 	"github.com/thunder-id/thunderid/tests/mocks/jose/jwemock"
 	"github.com/thunder-id/thunderid/tests/mocks/jose/jwtmock"
+	"github.com/thunder-id/thunderid/tests/mocks/oauth/oauth2/claimfallbackmock"
+	"github.com/thunder-id/thunderid/tests/mocks/oauth/oauth2/preissuancemock"
+	"github.com/thunder-id/thunderid/tests/mocks/oauth/oauth2/roleclaimsmock"
+	"github.com/thunder-id/thunderid/tests/mocks/oauth/oauth2/tokencustomizermock"
+	"github.com/thunder-id/thunderid/tests/mocks/resourcemock"
 )
 
 const (
@@ -98,7 +111,7 @@ func (suite *TokenBuilderTestSuite) SetupTest() {
 
 func (suite *TokenBuilderTestSuite) TestNewTokenBuilder() {
 	jwtService := jwtmock.NewJWTServiceInterfaceMock(suite.T())
-	builder := newTokenBuilder(jwtService, nil, nil)
+	builder := newTokenBuilder(jwtService, nil, nil, nil, nil, nil, nil, nil)
 
 	assert.NotNil(suite.T(), builder)
 	assert.Implements(suite.T(), (*TokenBuilderInterface)(nil), builder)
@@ -145,6 +158,392 @@ func (suite *TokenBuilderTestSuite) TestBuildAccessToken_Success_Basic() {
 	suite.mockJWTService.AssertExpectations(suite.T())
 }
 
+func (suite *TokenBuilderTestSuite) TestBuildAccessToken_DPoPBound() {
+	ctx := &AccessTokenBuildContext{
+		Context:   dpop.WithThumbprint(context.Background(), "jkt-1"),
+		Subject:   "user123",
+		Audiences: []string{"app123"},
+		ClientID:  "test-client",
+		GrantType: string(constants.GrantTypeClientCredentials),
+		OAuthApp:  suite.oauthApp,
+	}
+
+	suite.mockJWTService.On("GenerateJWT", mock.Anything, "user123", mock.Anything, mock.Anything,
+		mock.MatchedBy(func(claims map[string]interface{}) bool {
+			cnf, ok := claims[constants.ClaimConfirmation].(map[string]interface{})
+			return ok && cnf["jkt"] == "jkt-1"
+		}), mock.Anything, mock.Anything,
+	).Return(testAccessToken, time.Now().Unix(), nil)
+
+	result, err := suite.builder.BuildAccessToken(ctx)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), constants.TokenTypeDPoP, result.TokenType)
+}
+
+func (suite *TokenBuilderTestSuite) TestBuildAccessToken_PreIssuanceAnnotatesClaims() {
+	mockPreIssuance := preissuancemock.NewPreIssuanceServiceInterfaceMock(suite.T())
+	suite.builder.preIssuance = mockPreIssuance
+	suite.oauthApp.ID = testAppID
+	suite.oauthApp.OUID = "app-ou"
+	ctx := &AccessTokenBuildContext{
+		Subject:        "user123",
+		Audiences:      []string{"app123"},
+		ClientID:       "test-client",
+		Scopes:         []string{"read"},
+		UserAttributes: map[string]interface{}{"name": testUserName, constants.ClaimOUID: "user-ou"},
+		GrantType:      string(constants.GrantTypeAuthorizationCode),
+		OAuthApp:       suite.oauthApp,
+	}
+
+	mockPreIssuance.On("Evaluate", mock.Anything, &preissuance.IssuanceContext{
+		Subject:   "user123",
+		OUID:      "user-ou",
+		ClientID:  "test-client",
+		AppID:     testAppID,
+		AppOUID:   "app-ou",
+		GrantType: string(constants.GrantTypeAuthorizationCode),
+		Scopes:    []string{"read"},
+	}).Return(&preissuance.Decision{
+		Allow:  true,
+		Claims: map[string]interface{}{"plan": "gold", "name": "Someone Else"},
+	})
+	suite.mockJWTService.On("GenerateJWT",
+		mock.Anything, "user123", "https://thunder.io", int64(3600),
+		mock.MatchedBy(func(claims map[string]interface{}) bool {
+			return claims["plan"] == "gold" && claims["name"] == testUserName
+		}), mock.Anything, mock.Anything,
+	).Return(testAccessToken, time.Now().Unix(), nil)
+
+	result, err := suite.builder.BuildAccessToken(ctx)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), testAccessToken, result.Token)
+}
+
+func (suite *TokenBuilderTestSuite) TestBuildAccessToken_AppliesTokenCustomization() {
+	mockCustomizer := tokencustomizermock.NewTokenCustomizerServiceInterfaceMock(suite.T())
+	suite.builder.tokenCustomizer = mockCustomizer
+	userAttributes := map[string]interface{}{"name": testUserName, "department": "Engineering"}
+	ctx := &AccessTokenBuildContext{
+		Subject:        "user123",
+		Audiences:      []string{"app123"},
+		ClientID:       "test-client",
+		Scopes:         []string{"read"},
+		UserAttributes: userAttributes,
+		GrantType:      string(constants.GrantTypeAuthorizationCode),
+		OAuthApp:       suite.oauthApp,
+	}
+
+	mockCustomizer.On("CustomizeAccessTokenClaims", suite.oauthApp, &tokencustomizer.ClaimContext{
+		Subject:        "user123",
+		ClientID:       "test-client",
+		GrantType:      string(constants.GrantTypeAuthorizationCode),
+		Scopes:         []string{"read"},
+		UserAttributes: userAttributes,
+	}, mock.Anything).Run(func(args mock.Arguments) {
+		args.Get(2).(map[string]interface{})["dept"] = "engineering"
+	})
+	suite.mockJWTService.On("GenerateJWT",
+		mock.Anything, "user123", "https://thunder.io", int64(3600),
+		mock.MatchedBy(func(claims map[string]interface{}) bool {
+			return claims["dept"] == "engineering" && claims["scope"] == "read"
+		}), mock.Anything, mock.Anything,
+	).Return(testAccessToken, time.Now().Unix(), nil)
+
+	result, err := suite.builder.BuildAccessToken(ctx)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), testAccessToken, result.Token)
+}
+
+func (suite *TokenBuilderTestSuite) TestBuildAccessToken_IncludesRolesAndMappedScopes() {
+	mockRoleClaims := roleclaimsmock.NewRoleClaimsServiceInterfaceMock(suite.T())
+	suite.builder.roleClaims = mockRoleClaims
+	suite.oauthApp.Token.AccessToken.IncludeRoles = true
+	ctx := &AccessTokenBuildContext{
+		Subject:   "user123",
+		Audiences: []string{"app123"},
+		ClientID:  "test-client",
+		Scopes:    []string{"read"},
+		GrantType: string(constants.GrantTypeRefreshToken),
+		OAuthApp:  suite.oauthApp,
+	}
+
+	mockRoleClaims.On("ResolveRoleClaims", mock.Anything, "user123").Return(&roleclaims.RoleClaims{
+		Roles:  []string{"admin"},
+		Scopes: []string{"read", "users:write"},
+	}, nil)
+	mockRoleClaims.On("GetMaxClaimSize").Return(4096)
+	mockRoleClaims.On("GetClaimName").Return("roles")
+	suite.mockJWTService.On("GenerateJWT",
+		mock.Anything, "user123", "https://thunder.io", int64(3600),
+		mock.MatchedBy(func(claims map[string]interface{}) bool {
+			roles, ok := claims["roles"].([]string)
+			return ok && len(roles) == 1 && roles[0] == "admin" && claims["scope"] == "read users:write"
+		}), mock.Anything, mock.Anything,
+	).Return(testAccessToken, time.Now().Unix(), nil)
+
+	result, err := suite.builder.BuildAccessToken(ctx)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), []string{"read"}, result.Scopes)
+}
+
+func (suite *TokenBuilderTestSuite) TestBuildAccessToken_RoleScopesCappedAtScopeCeiling() {
+	oauthConfig := &config.GetServerRuntime().Config.OAuth
+	oauthConfig.ScopeCeilings.Rules = []config.ScopeCeilingRule{
+		{ACR: []string{"urn:thunder:acr:password"}, Scopes: []string{"read"}},
+	}
+	defer func() { oauthConfig.ScopeCeilings.Rules = nil }()
+
+	mockRoleClaims := roleclaimsmock.NewRoleClaimsServiceInterfaceMock(suite.T())
+	suite.builder.roleClaims = mockRoleClaims
+	suite.oauthApp.Token.AccessToken.IncludeRoles = true
+	ctx := &AccessTokenBuildContext{
+		Subject:   "user123",
+		Audiences: []string{"app123"},
+		ClientID:  "test-client",
+		Scopes:    []string{"read"},
+		GrantType: string(constants.GrantTypeAuthorizationCode),
+		ACR:       "urn:thunder:acr:password",
+		OAuthApp:  suite.oauthApp,
+	}
+
+	mockRoleClaims.On("ResolveRoleClaims", mock.Anything, "user123").Return(&roleclaims.RoleClaims{
+		Roles:  []string{"admin"},
+		Scopes: []string{"read", "users:write"},
+	}, nil)
+	mockRoleClaims.On("GetMaxClaimSize").Return(4096)
+	mockRoleClaims.On("GetClaimName").Return("roles")
+	suite.mockJWTService.On("GenerateJWT",
+		mock.Anything, "user123", "https://thunder.io", int64(3600),
+		mock.MatchedBy(func(claims map[string]interface{}) bool {
+			return claims["scope"] == "read" && claims["acr"] == "urn:thunder:acr:password"
+		}), mock.Anything, mock.Anything,
+	).Return(testAccessToken, time.Now().Unix(), nil)
+
+	result, err := suite.builder.BuildAccessToken(ctx)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "urn:thunder:acr:password", result.ACR)
+}
+
+func (suite *TokenBuilderTestSuite) TestBuildAccessToken_ServiceIdentity() {
+	mockRoleClaims := roleclaimsmock.NewRoleClaimsServiceInterfaceMock(suite.T())
+	suite.builder.roleClaims = mockRoleClaims
+	suite.oauthApp.Token.AccessToken.IncludeRoles = true
+	ctx := &AccessTokenBuildContext{
+		Subject:           "test-client",
+		Audiences:         []string{"test-client"},
+		ClientID:          "test-client",
+		GrantType:         string(constants.GrantTypeClientCredentials),
+		ServiceIdentityID: "app123",
+		OAuthApp:          suite.oauthApp,
+	}
+
+	mockRoleClaims.On("ResolveRoleClaims", mock.Anything, "app123").Return(&roleclaims.RoleClaims{
+		Roles: []string{"reporter"}, Scopes: []string{},
+	}, nil)
+	mockRoleClaims.On("GetMaxClaimSize").Return(0)
+	mockRoleClaims.On("GetClaimName").Return("roles")
+	suite.mockJWTService.On("GenerateJWT",
+		mock.Anything, "test-client", "https://thunder.io", int64(3600),
+		mock.MatchedBy(func(claims map[string]interface{}) bool {
+			roles, ok := claims["roles"].([]string)
+			return ok && len(roles) == 1 && roles[0] == "reporter" &&
+				claims[constants.ClaimSubjectType] == constants.PrincipalTypeService &&
+				claims[constants.ClaimServiceID] == "app123"
+		}), mock.Anything, mock.Anything,
+	).Return(testAccessToken, time.Now().Unix(), nil)
+
+	result, err := suite.builder.BuildAccessToken(ctx)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), testAccessToken, result.Token)
+}
+
+func (suite *TokenBuilderTestSuite) TestBuildAccessToken_OversizedRolesClaimUsesReference() {
+	mockRoleClaims := roleclaimsmock.NewRoleClaimsServiceInterfaceMock(suite.T())
+	suite.builder.roleClaims = mockRoleClaims
+	suite.oauthApp.Token.AccessToken.IncludeRoles = true
+	ctx := &AccessTokenBuildContext{
+		Subject:   "user123",
+		Audiences: []string{"app123"},
+		ClientID:  "test-client",
+		OAuthApp:  suite.oauthApp,
+	}
+
+	mockRoleClaims.On("ResolveRoleClaims", mock.Anything, "user123").Return(&roleclaims.RoleClaims{
+		Roles:  []string{"administrators", "auditors"},
+		Scopes: []string{},
+	}, nil)
+	mockRoleClaims.On("GetMaxClaimSize").Return(10)
+	suite.mockJWTService.On("GenerateJWT",
+		mock.Anything, "user123", "https://thunder.io", int64(3600),
+		mock.MatchedBy(func(claims map[string]interface{}) bool {
+			ref, ok := claims[constants.ClaimRolesRef].(string)
+			_, hasRoles := claims["roles"]
+			return ok && strings.HasSuffix(ref, constants.OAuth2RolesEndpoint) && !hasRoles
+		}), mock.Anything, mock.Anything,
+	).Return(testAccessToken, time.Now().Unix(), nil)
+
+	_, err := suite.builder.BuildAccessToken(ctx)
+
+	assert.NoError(suite.T(), err)
+}
+
+func (suite *TokenBuilderTestSuite) TestBuildAccessToken_RolesNotIncludedWhenDisabled() {
+	mockRoleClaims := roleclaimsmock.NewRoleClaimsServiceInterfaceMock(suite.T())
+	suite.builder.roleClaims = mockRoleClaims
+	ctx := &AccessTokenBuildContext{
+		Subject:   "user123",
+		Audiences: []string{"app123"},
+		ClientID:  "test-client",
+		OAuthApp:  suite.oauthApp,
+	}
+
+	suite.mockJWTService.On("GenerateJWT",
+		mock.Anything, "user123", "https://thunder.io", int64(3600),
+		mock.MatchedBy(func(claims map[string]interface{}) bool {
+			_, hasRoles := claims["roles"]
+			return !hasRoles
+		}), mock.Anything, mock.Anything,
+	).Return(testAccessToken, time.Now().Unix(), nil)
+
+	_, err := suite.builder.BuildAccessToken(ctx)
+
+	assert.NoError(suite.T(), err)
+	mockRoleClaims.AssertNotCalled(suite.T(), "ResolveRoleClaims", mock.Anything, mock.Anything)
+}
+
+func (suite *TokenBuilderTestSuite) TestBuildAccessToken_RoleClaimsError() {
+	mockRoleClaims := roleclaimsmock.NewRoleClaimsServiceInterfaceMock(suite.T())
+	suite.builder.roleClaims = mockRoleClaims
+	suite.oauthApp.Token.AccessToken.IncludeRoles = true
+	ctx := &AccessTokenBuildContext{
+		Subject:  "user123",
+		ClientID: "test-client",
+		OAuthApp: suite.oauthApp,
+	}
+
+	mockRoleClaims.On("ResolveRoleClaims", mock.Anything, "user123").Return(nil, errors.New("role store down"))
+
+	result, err := suite.builder.BuildAccessToken(ctx)
+
+	assert.Error(suite.T(), err)
+	assert.Nil(suite.T(), result)
+}
+
+func (suite *TokenBuilderTestSuite) TestBuildAccessToken_TokenTypeFromResourceServers() {
+	testCases := []struct {
+		name         string
+		audiences    []string
+		tokenFormats map[string]string
+		expectedType string
+	}{
+		{
+			name:         "NoResourceServer",
+			audiences:    []string{"test-client"},
+			expectedType: jwt.TokenTypeAccessToken,
+		},
+		{
+			name:         "DefaultTokenFormat",
+			audiences:    []string{"https://api.example.com"},
+			tokenFormats: map[string]string{"https://api.example.com": resource.TokenFormatAccessTokenJWT},
+			expectedType: jwt.TokenTypeAccessToken,
+		},
+		{
+			name:         "JWTTokenFormat",
+			audiences:    []string{"https://api.example.com", "test-client"},
+			tokenFormats: map[string]string{"https://api.example.com": resource.TokenFormatJWT},
+			expectedType: jwt.TokenTypeJWT,
+		},
+		{
+			name:      "MixedTokenFormats",
+			audiences: []string{"https://api.example.com", "https://orders.example.com"},
+			tokenFormats: map[string]string{
+				"https://api.example.com":    resource.TokenFormatJWT,
+				"https://orders.example.com": resource.TokenFormatAccessTokenJWT,
+			},
+			expectedType: jwt.TokenTypeAccessToken,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			mockResourceService := resourcemock.NewResourceServiceInterfaceMock(suite.T())
+			suite.builder.resourceService = mockResourceService
+			for _, audience := range tc.audiences {
+				if tokenFormat, ok := tc.tokenFormats[audience]; ok {
+					mockResourceService.On("GetResourceServerByIdentifier", mock.Anything, audience).
+						Return(&resource.ResourceServer{Identifier: audience, TokenFormat: tokenFormat}, nil).Maybe()
+				} else {
+					mockResourceService.On("GetResourceServerByIdentifier", mock.Anything, audience).
+						Return(nil, &resource.ErrorResourceServerNotFound).Maybe()
+				}
+			}
+			suite.mockJWTService.On("GenerateJWT", mock.Anything, "user123", "https://thunder.io", int64(3600),
+				mock.Anything, tc.expectedType, "").Return(testAccessToken, time.Now().Unix(), nil)
+
+			result, err := suite.builder.BuildAccessToken(&AccessTokenBuildContext{
+				Subject:   "user123",
+				Audiences: tc.audiences,
+				ClientID:  "test-client",
+				OAuthApp:  suite.oauthApp,
+			})
+
+			assert.NoError(suite.T(), err)
+			assert.Equal(suite.T(), testAccessToken, result.Token)
+		})
+	}
+}
+
+func (suite *TokenBuilderTestSuite) TestBuildAccessToken_TokenTypeResolutionError() {
+	mockResourceService := resourcemock.NewResourceServiceInterfaceMock(suite.T())
+	suite.builder.resourceService = mockResourceService
+	mockResourceService.On("GetResourceServerByIdentifier", mock.Anything, "https://api.example.com").
+		Return(nil, &serviceerror.InternalServerError)
+
+	result, err := suite.builder.BuildAccessToken(&AccessTokenBuildContext{
+		Subject:   "user123",
+		Audiences: []string{"https://api.example.com"},
+		ClientID:  "test-client",
+		OAuthApp:  suite.oauthApp,
+	})
+
+	assert.Error(suite.T(), err)
+	assert.Nil(suite.T(), result)
+	suite.mockJWTService.AssertNotCalled(suite.T(), "GenerateJWT")
+}
+
+func (suite *TokenBuilderTestSuite) TestBuildAccessToken_PreIssuanceDenied() {
+	mockPreIssuance := preissuancemock.NewPreIssuanceServiceInterfaceMock(suite.T())
+	suite.builder.preIssuance = mockPreIssuance
+	ctx := &AccessTokenBuildContext{
+		Subject:   "user123",
+		Audiences: []string{"app123"},
+		ClientID:  "test-client",
+		GrantType: string(constants.GrantTypeRefreshToken),
+		OAuthApp:  suite.oauthApp,
+	}
+
+	mockPreIssuance.On("Evaluate", mock.Anything, mock.Anything).Return(&preissuance.Decision{
+		Allow:  false,
+		Reason: "The subscription is suspended",
+	})
+
+	result, err := suite.builder.BuildAccessToken(ctx)
+
+	assert.Nil(suite.T(), result)
+	var deniedErr *IssuanceDeniedError
+	assert.ErrorAs(suite.T(), err, &deniedErr)
+	assert.Equal(suite.T(), "The subscription is suspended", deniedErr.Reason)
+	suite.mockJWTService.AssertNotCalled(suite.T(), "GenerateJWT",
+		mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
 func (suite *TokenBuilderTestSuite) TestBuildAccessToken_Success_WithActorClaim() {
 	actorClaims := &SubjectTokenClaims{
 		Sub:            "actor123",
@@ -325,6 +724,35 @@ func (suite *TokenBuilderTestSuite) TestBuildAccessToken_Success_EmptyGrantType(
 	suite.mockJWTService.AssertExpectations(suite.T())
 }
 
+func (suite *TokenBuilderTestSuite) TestBuildAccessToken_Success_WithAuthTime() {
+	ctx := &AccessTokenBuildContext{
+		Subject:        "user123",
+		Audiences:      []string{"app123"},
+		ClientID:       "test-client",
+		Scopes:         []string{"read"},
+		UserAttributes: map[string]interface{}{constants.ClaimAuthTime: int64(1)},
+		GrantType:      string(constants.GrantTypeAuthorizationCode),
+		AuthTime:       int64(1700000000),
+		OAuthApp:       suite.oauthApp,
+	}
+
+	suite.mockJWTService.On("GenerateJWT",
+		mock.Anything,
+		"user123",
+		"https://thunder.io",
+		int64(3600),
+		mock.MatchedBy(func(claims map[string]interface{}) bool {
+			return claims[constants.ClaimAuthTime] == int64(1700000000)
+		}), mock.Anything, mock.Anything,
+	).Return(testAccessToken, time.Now().Unix(), nil)
+
+	result, err := suite.builder.BuildAccessToken(ctx)
+
+	assert.NoError(suite.T(), err)
+	assert.NotNil(suite.T(), result)
+	suite.mockJWTService.AssertExpectations(suite.T())
+}
+
 func (suite *TokenBuilderTestSuite) TestBuildAccessToken_Success_CustomValidityPeriod() {
 	customOAuthApp := &inboundmodel.OAuthClient{
 		ClientID: "test-client",
@@ -446,6 +874,33 @@ func (suite *TokenBuilderTestSuite) TestBuildAccessToken_Success_WithClaimsLocal
 	suite.mockJWTService.AssertExpectations(suite.T())
 }
 
+func (suite *TokenBuilderTestSuite) TestBuildAccessToken_Success_WithTenant() {
+	ctx := &AccessTokenBuildContext{
+		Context:   sysContext.WithTenantID(context.Background(), "tenant-1"),
+		Subject:   "user123",
+		Audiences: []string{"app123"},
+		ClientID:  "test-client",
+		GrantType: string(constants.GrantTypeAuthorizationCode),
+		OAuthApp:  suite.oauthApp,
+	}
+
+	suite.mockJWTService.On("GenerateJWT",
+		mock.Anything,
+		"user123",
+		"https://thunder.io",
+		int64(3600),
+		mock.MatchedBy(func(claims map[string]interface{}) bool {
+			return claims[constants.ClaimTenantID] == "tenant-1"
+		}), mock.Anything, mock.Anything,
+	).Return(testAccessToken, time.Now().Unix(), nil)
+
+	result, err := suite.builder.BuildAccessToken(ctx)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), testAccessToken, result.Token)
+	suite.mockJWTService.AssertExpectations(suite.T())
+}
+
 func (suite *TokenBuilderTestSuite) TestBuildRefreshToken_Success_Basic() {
 	// Create OAuth app with user attributes configured
 	oauthAppWithUserAttrs := &inboundmodel.OAuthClient{
@@ -928,6 +1383,89 @@ func (suite *TokenBuilderTestSuite) TestBuildIDToken_Success_WithScopeClaims() {
 	suite.mockJWTService.AssertExpectations(suite.T())
 }
 
+func (suite *TokenBuilderTestSuite) TestBuildIDToken_Success_WithClaimFallbacks() {
+	oauthApp := &inboundmodel.OAuthClient{
+		ClientID: "test-client",
+		Token: &inboundmodel.OAuthTokenConfig{
+			IDToken: &inboundmodel.IDTokenConfig{
+				ValidityPeriod: 3600,
+				UserAttributes: []string{"name", "picture"},
+			},
+		},
+	}
+	userAttributes := map[string]interface{}{"displayName": testUserName}
+
+	mockClaimFallback := claimfallbackmock.NewClaimFallbackServiceInterfaceMock(suite.T())
+	mockClaimFallback.On("ApplyFallbacks", mock.Anything, oauthApp, "user123", userAttributes).
+		Return(map[string]interface{}{"displayName": testUserName, "name": testUserName,
+			"picture": "https://gravatar.com/avatar/abc"})
+	suite.builder.claimFallback = mockClaimFallback
+
+	suite.mockJWTService.On("GenerateJWT",
+		mock.Anything,
+		"user123",
+		"https://thunder.io",
+		int64(3600),
+		mock.MatchedBy(func(claims map[string]interface{}) bool {
+			_, hasSource := claims["displayName"]
+			return claims["name"] == testUserName && claims["picture"] == "https://gravatar.com/avatar/abc" &&
+				!hasSource
+		}), mock.Anything, mock.Anything,
+	).Return(testIDToken, time.Now().Unix(), nil)
+
+	result, err := suite.builder.BuildIDToken(&IDTokenBuildContext{
+		Subject:        "user123",
+		Audience:       "test-client",
+		Scopes:         []string{"openid", "profile"},
+		UserAttributes: userAttributes,
+		OAuthApp:       oauthApp,
+	})
+
+	assert.NoError(suite.T(), err)
+	assert.NotNil(suite.T(), result)
+}
+
+func (suite *TokenBuilderTestSuite) TestBuildIDToken_AppliesTokenCustomization() {
+	oauthApp := &inboundmodel.OAuthClient{
+		ClientID: "test-client",
+		Token: &inboundmodel.OAuthTokenConfig{
+			IDToken: &inboundmodel.IDTokenConfig{ValidityPeriod: 3600},
+		},
+	}
+	userAttributes := map[string]interface{}{"given_name": "Alice"}
+
+	mockCustomizer := tokencustomizermock.NewTokenCustomizerServiceInterfaceMock(suite.T())
+	mockCustomizer.On("CustomizeIDTokenClaims", oauthApp, &tokencustomizer.ClaimContext{
+		Subject:        "user123",
+		ClientID:       "test-client",
+		GrantType:      string(constants.GrantTypeRefreshToken),
+		Scopes:         []string{"openid"},
+		UserAttributes: userAttributes,
+	}, mock.Anything).Run(func(args mock.Arguments) {
+		args.Get(2).(map[string]interface{})["greeting"] = "Hello Alice"
+	})
+	suite.builder.tokenCustomizer = mockCustomizer
+
+	suite.mockJWTService.On("GenerateJWT",
+		mock.Anything, "user123", "https://thunder.io", int64(3600),
+		mock.MatchedBy(func(claims map[string]interface{}) bool {
+			return claims["greeting"] == "Hello Alice"
+		}), mock.Anything, mock.Anything,
+	).Return(testIDToken, time.Now().Unix(), nil)
+
+	result, err := suite.builder.BuildIDToken(&IDTokenBuildContext{
+		Subject:        "user123",
+		Audience:       "test-client",
+		Scopes:         []string{"openid"},
+		GrantType:      string(constants.GrantTypeRefreshToken),
+		UserAttributes: userAttributes,
+		OAuthApp:       oauthApp,
+	})
+
+	assert.NoError(suite.T(), err)
+	assert.NotNil(suite.T(), result)
+}
+
 func (suite *TokenBuilderTestSuite) TestBuildIDToken_Success_WithStandardOIDCScopes() {
 	oauthAppWithUserAttrs := &inboundmodel.OAuthClient{
 		ClientID: "test-client",
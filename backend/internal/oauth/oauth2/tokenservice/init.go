@@ -20,7 +20,12 @@ package tokenservice
 
 import (
 	"github.com/thunder-id/thunderid/internal/idp"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/claimfallback"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/jwksresolver"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/preissuance"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/roleclaims"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/tokencustomizer"
+	"github.com/thunder-id/thunderid/internal/resource"
 	"github.com/thunder-id/thunderid/internal/system/jose/jwe"
 	"github.com/thunder-id/thunderid/internal/system/jose/jwt"
 )
@@ -32,8 +37,14 @@ func Initialize(
 	jweService jwe.JWEServiceInterface,
 	resolver *jwksresolver.Resolver,
 	idpService idp.IDPServiceInterface,
+	preIssuance preissuance.PreIssuanceServiceInterface,
+	roleClaims roleclaims.RoleClaimsServiceInterface,
+	claimFallback claimfallback.ClaimFallbackServiceInterface,
+	tokenCustomizer tokencustomizer.TokenCustomizerServiceInterface,
+	resourceService resource.ResourceServiceInterface,
 ) (TokenBuilderInterface, TokenValidatorInterface) {
-	tokenBuilder := newTokenBuilder(jwtService, jweService, resolver)
+	tokenBuilder := newTokenBuilder(jwtService, jweService, resolver, preIssuance, roleClaims, claimFallback,
+		tokenCustomizer, resourceService)
 	tokenValidator := newTokenValidator(jwtService, idpService)
 	return tokenBuilder, tokenValidator
 }
@@ -56,11 +56,20 @@ type AccessTokenBuildContext struct {
 	UserAttributes   map[string]interface{}
 	AttributeCacheID string
 	GrantType        string
-	OAuthApp         *inboundmodel.OAuthClient
-	ActorClaims      *SubjectTokenClaims
-	ClaimsRequest    *oauth2model.ClaimsRequest
-	ClaimsLocales    string
-	ClientAttributes map[string]interface{}
+	// AuthTime is the time the user authenticated at, in seconds since the epoch. It is set only when the
+	// token is issued right after the user authenticated.
+	AuthTime int64
+	// ACR is the authentication context class the user completed. It caps the role-derived scopes of tokens
+	// issued for the authorization code grant at the configured scope ceiling.
+	ACR string
+	// ServiceIdentityID is the ID of the service identity a machine client acts as. It is set only for tokens
+	// issued to the client itself, such as with the client credentials grant.
+	ServiceIdentityID string
+	OAuthApp          *inboundmodel.OAuthClient
+	ActorClaims       *SubjectTokenClaims
+	ClaimsRequest     *oauth2model.ClaimsRequest
+	ClaimsLocales     string
+	ClientAttributes  map[string]interface{}
 }
 
 // RefreshTokenBuildContext contains all the information needed to build a refresh token.
@@ -75,6 +84,7 @@ type RefreshTokenBuildContext struct {
 	OAuthApp             *inboundmodel.OAuthClient
 	ClaimsRequest        *oauth2model.ClaimsRequest
 	ClaimsLocales        string
+	ACR                  string
 }
 
 // IDTokenBuildContext contains all the information needed to build an ID token (OIDC).
@@ -83,6 +93,7 @@ type IDTokenBuildContext struct {
 	Subject        string
 	Audience       string
 	Scopes         []string
+	GrantType      string
 	UserAttributes map[string]interface{}
 	AuthTime       int64
 	OAuthApp       *inboundmodel.OAuthClient
@@ -93,6 +104,7 @@ type IDTokenBuildContext struct {
 
 // RefreshTokenClaims represents the validated claims from a refresh token.
 type RefreshTokenClaims struct {
+	JTI              string
 	Sub              string
 	Audiences        []string
 	GrantType        string
@@ -101,6 +113,9 @@ type RefreshTokenClaims struct {
 	Iat              int64
 	ClaimsRequest    *oauth2model.ClaimsRequest
 	ClaimsLocales    string
+	ACR              string
+	// JKT is the JWK thumbprint of the DPoP key the refresh token is bound to, if any.
+	JKT string
 }
 
 // SubjectTokenClaims represents the validated claims from a subject token (for token exchange).
@@ -121,5 +136,17 @@ type AccessTokenClaims struct {
 	GrantType string
 	Scopes    []string
 	ClientID  string
-	Claims    map[string]interface{}
+	// JKT is the JWK thumbprint of the DPoP key the access token is bound to, if any.
+	JKT    string
+	Claims map[string]interface{}
+}
+
+// IssuanceDeniedError is returned when a pre-issuance policy denies an access token request.
+type IssuanceDeniedError struct {
+	Reason string
+}
+
+// Error returns the error message.
+func (e *IssuanceDeniedError) Error() string {
+	return "token issuance denied: " + e.Reason
 }
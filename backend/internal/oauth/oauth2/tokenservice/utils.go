@@ -82,11 +82,36 @@ func ResolveTokenConfig(oauthApp *inboundmodel.OAuthClient, tokenType TokenType)
 		if conf.OAuth.RefreshToken.ValidityPeriod > 0 {
 			tokenConfig.ValidityPeriod = conf.OAuth.RefreshToken.ValidityPeriod
 		}
+		if oauthApp != nil && oauthApp.Token != nil && oauthApp.Token.RefreshToken != nil {
+			if oauthApp.Token.RefreshToken.ValidityPeriod > 0 {
+				tokenConfig.ValidityPeriod = oauthApp.Token.RefreshToken.ValidityPeriod
+			}
+		}
 	}
 
 	return tokenConfig
 }
 
+// ResolveRefreshTokenIdleTimeout resolves the refresh token inactivity timeout in seconds from the OAuth app
+// or falls back to global config. Zero means refresh tokens do not expire due to inactivity.
+func ResolveRefreshTokenIdleTimeout(oauthApp *inboundmodel.OAuthClient) int64 {
+	if oauthApp != nil && oauthApp.Token != nil && oauthApp.Token.RefreshToken != nil &&
+		oauthApp.Token.RefreshToken.IdleTimeout > 0 {
+		return oauthApp.Token.RefreshToken.IdleTimeout
+	}
+	return config.GetServerRuntime().Config.OAuth.RefreshToken.IdleTimeout
+}
+
+// ResolveRefreshTokenRenewOnGrant reports whether refresh tokens of the OAuth app are rotated on each refresh
+// grant, falling back to global config when the app does not override it.
+func ResolveRefreshTokenRenewOnGrant(oauthApp *inboundmodel.OAuthClient) bool {
+	if oauthApp != nil && oauthApp.Token != nil && oauthApp.Token.RefreshToken != nil &&
+		oauthApp.Token.RefreshToken.RenewOnGrant != nil {
+		return *oauthApp.Token.RefreshToken.RenewOnGrant
+	}
+	return config.GetServerRuntime().Config.OAuth.RefreshToken.RenewOnGrant
+}
+
 // extractStringClaim safely extracts a non-empty string claim from a claims map.
 func extractStringClaim(claims map[string]interface{}, key string) (string, error) {
 	value, ok := claims[key]
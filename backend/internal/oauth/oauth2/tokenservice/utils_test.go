@@ -649,6 +649,88 @@ func (suite *UtilsTestSuite) TestResolveTokenConfig_RefreshToken_WithTokenConfig
 	assert.Equal(suite.T(), "https://thunder.io", result.Issuer)
 }
 
+func (suite *UtilsTestSuite) TestResolveTokenConfig_RefreshToken_WithAppValidityPeriod() {
+	config.ResetServerRuntime()
+	testConfig := &config.Config{
+		JWT: config.JWTConfig{
+			Issuer:         "https://thunder.io",
+			ValidityPeriod: 3600,
+		},
+		OAuth: config.OAuthConfig{
+			RefreshToken: config.RefreshTokenConfig{
+				ValidityPeriod: 86400,
+			},
+		},
+	}
+	_ = config.InitializeServerRuntime("test", testConfig)
+
+	oauthApp := &inboundmodel.OAuthClient{
+		ClientID: "test-client",
+		Token: &inboundmodel.OAuthTokenConfig{
+			RefreshToken: &inboundmodel.RefreshTokenConfig{ValidityPeriod: 31536000},
+		},
+	}
+
+	result := ResolveTokenConfig(oauthApp, TokenTypeRefresh)
+
+	assert.Equal(suite.T(), int64(31536000), result.ValidityPeriod)
+}
+
+func (suite *UtilsTestSuite) TestResolveRefreshTokenIdleTimeout() {
+	config.ResetServerRuntime()
+	testConfig := &config.Config{
+		OAuth: config.OAuthConfig{
+			RefreshToken: config.RefreshTokenConfig{
+				IdleTimeout: 86400,
+			},
+		},
+	}
+	_ = config.InitializeServerRuntime("test", testConfig)
+
+	appWithIdleTimeout := &inboundmodel.OAuthClient{
+		Token: &inboundmodel.OAuthTokenConfig{
+			RefreshToken: &inboundmodel.RefreshTokenConfig{IdleTimeout: 2592000},
+		},
+	}
+	appWithoutIdleTimeout := &inboundmodel.OAuthClient{
+		Token: &inboundmodel.OAuthTokenConfig{
+			RefreshToken: &inboundmodel.RefreshTokenConfig{ValidityPeriod: 31536000},
+		},
+	}
+
+	assert.Equal(suite.T(), int64(2592000), ResolveRefreshTokenIdleTimeout(appWithIdleTimeout))
+	assert.Equal(suite.T(), int64(86400), ResolveRefreshTokenIdleTimeout(appWithoutIdleTimeout))
+	assert.Equal(suite.T(), int64(86400), ResolveRefreshTokenIdleTimeout(nil))
+}
+
+func (suite *UtilsTestSuite) TestResolveRefreshTokenRenewOnGrant() {
+	config.ResetServerRuntime()
+	testConfig := &config.Config{
+		OAuth: config.OAuthConfig{
+			RefreshToken: config.RefreshTokenConfig{
+				RenewOnGrant: true,
+			},
+		},
+	}
+	_ = config.InitializeServerRuntime("test", testConfig)
+
+	disabled := false
+	appWithOverride := &inboundmodel.OAuthClient{
+		Token: &inboundmodel.OAuthTokenConfig{
+			RefreshToken: &inboundmodel.RefreshTokenConfig{RenewOnGrant: &disabled},
+		},
+	}
+	appWithoutOverride := &inboundmodel.OAuthClient{
+		Token: &inboundmodel.OAuthTokenConfig{
+			RefreshToken: &inboundmodel.RefreshTokenConfig{IdleTimeout: 3600},
+		},
+	}
+
+	assert.False(suite.T(), ResolveRefreshTokenRenewOnGrant(appWithOverride))
+	assert.True(suite.T(), ResolveRefreshTokenRenewOnGrant(appWithoutOverride))
+	assert.True(suite.T(), ResolveRefreshTokenRenewOnGrant(nil))
+}
+
 func (suite *UtilsTestSuite) TestResolveTokenConfig_AccessToken_WithNilOAuthApp() {
 	config.ResetServerRuntime()
 	testConfig := &config.Config{
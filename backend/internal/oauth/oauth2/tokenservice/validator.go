@@ -20,18 +20,23 @@ package tokenservice
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"slices"
 	"time"
 
 	"github.com/thunder-id/thunderid/internal/idp"
 	inboundmodel "github.com/thunder-id/thunderid/internal/inboundclient/model"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/dpop"
 	oauth2model "github.com/thunder-id/thunderid/internal/oauth/oauth2/model"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/utils"
 	"github.com/thunder-id/thunderid/internal/system/config"
 	"github.com/thunder-id/thunderid/internal/system/jose/jwt"
 )
 
+// ErrRefreshTokenExpired is returned when a refresh token has passed its absolute expiry time.
+var ErrRefreshTokenExpired = errors.New("refresh token has expired")
+
 // TokenValidatorInterface defines the interface for validating tokens.
 type TokenValidatorInterface interface {
 	ValidateAccessToken(token string) (*AccessTokenClaims, error)
@@ -108,6 +113,7 @@ func (tv *tokenValidator) ValidateAccessToken(token string) (*AccessTokenClaims,
 		GrantType: grantType,
 		Scopes:    scopes,
 		ClientID:  clientID,
+		JKT:       dpop.GetConfirmationThumbprint(claims),
 		Claims:    claims,
 	}, nil
 }
@@ -115,6 +121,9 @@ func (tv *tokenValidator) ValidateAccessToken(token string) (*AccessTokenClaims,
 // ValidateRefreshToken validates a refresh token and extracts the claims.
 func (tv *tokenValidator) ValidateRefreshToken(token string, clientID string) (*RefreshTokenClaims, error) {
 	if err := tv.jwtService.VerifyJWT(token, "", ""); err != nil {
+		if err.Code == jwt.ErrorTokenExpired.Code {
+			return nil, ErrRefreshTokenExpired
+		}
 		return nil, fmt.Errorf("invalid refresh token: %v", err.Error)
 	}
 
@@ -128,12 +137,14 @@ func (tv *tokenValidator) ValidateRefreshToken(token string, clientID string) (*
 	}
 
 	// Extract claims
+	jti, _ := extractStringClaim(claims, "jti")
 	sub, _ := extractStringClaim(claims, "access_token_sub")
 	audiences := extractStringSliceClaim(claims, "access_token_aud")
 	grantType, _ := extractStringClaim(claims, "grant_type")
 	iat, _ := extractInt64Claim(claims, "iat")
 	scopes := extractScopesFromClaims(claims, false)
 	attributeCacheID, _ := extractStringClaim(claims, "aci")
+	acr, _ := extractStringClaim(claims, "acr")
 
 	// Extract claims request if present
 	var claimsRequest *oauth2model.ClaimsRequest
@@ -151,6 +162,7 @@ func (tv *tokenValidator) ValidateRefreshToken(token string, clientID string) (*
 
 	// Extract user type and organizational unit details if present
 	return &RefreshTokenClaims{
+		JTI:              jti,
 		Sub:              sub,
 		Audiences:        audiences,
 		GrantType:        grantType,
@@ -159,6 +171,8 @@ func (tv *tokenValidator) ValidateRefreshToken(token string, clientID string) (*
 		Iat:              iat,
 		ClaimsRequest:    claimsRequest,
 		ClaimsLocales:    claimsLocales,
+		ACR:              acr,
+		JKT:              dpop.GetConfirmationThumbprint(claims),
 	}, nil
 }
 
@@ -192,7 +206,7 @@ func (tv *tokenValidator) ValidateSubjectToken(
 		return nil, fmt.Errorf("failed to exchange token for issuer %q: %w", iss, resolveErr)
 	}
 
-	svcErr := tv.jwtService.VerifyJWTSignatureWithJWKS(token, issuerInfo.JWKSURL)
+	svcErr := tv.jwtService.VerifyJWTSignatureWithJWKS(ctx, token, issuerInfo.JWKSURL)
 	if svcErr != nil {
 		return nil, fmt.Errorf("invalid subject token signature: %v", svcErr.Error)
 	}
@@ -29,6 +29,7 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 
 	"github.com/thunder-id/thunderid/internal/idp"
@@ -36,6 +37,7 @@ import (
 	"github.com/thunder-id/thunderid/internal/system/cmodels"
 	"github.com/thunder-id/thunderid/internal/system/config"
 	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/jose/jwt"
 	"github.com/thunder-id/thunderid/tests/mocks/idp/idpmock"
 	"github.com/thunder-id/thunderid/tests/mocks/jose/jwtmock"
 )
@@ -700,6 +702,8 @@ func (suite *TokenValidatorTestSuite) TestValidateRefreshToken_Success_Basic() {
 		"access_token_aud": testAppID,
 		"grant_type":       "authorization_code",
 		"aci":              "test-cache-id",
+		"jti":              "test-jti",
+		"acr":              "urn:thunder:acr:mfa",
 	}
 	token := suite.createTestJWT(claims)
 
@@ -709,11 +713,13 @@ func (suite *TokenValidatorTestSuite) TestValidateRefreshToken_Success_Basic() {
 
 	assert.NoError(suite.T(), err)
 	assert.NotNil(suite.T(), result)
+	assert.Equal(suite.T(), "test-jti", result.JTI)
 	assert.Equal(suite.T(), "user123", result.Sub)
 	assert.Equal(suite.T(), []string{testAppID}, result.Audiences)
 	assert.Equal(suite.T(), "authorization_code", result.GrantType)
 	assert.Equal(suite.T(), []string{"read", "write"}, result.Scopes)
 	assert.Equal(suite.T(), "test-cache-id", result.AttributeCacheID)
+	assert.Equal(suite.T(), "urn:thunder:acr:mfa", result.ACR)
 	suite.mockJWTService.AssertExpectations(suite.T())
 }
 
@@ -790,6 +796,17 @@ func (suite *TokenValidatorTestSuite) TestValidateRefreshToken_Error_InvalidSign
 	suite.mockJWTService.AssertExpectations(suite.T())
 }
 
+func (suite *TokenValidatorTestSuite) TestValidateRefreshToken_Error_Expired() {
+	token := "expired.refresh.token"
+
+	suite.mockJWTService.On("VerifyJWT", token, "", "").Return(&jwt.ErrorTokenExpired)
+
+	result, err := suite.validator.ValidateRefreshToken(token, "test-client")
+
+	assert.ErrorIs(suite.T(), err, ErrRefreshTokenExpired)
+	assert.Nil(suite.T(), result)
+}
+
 func (suite *TokenValidatorTestSuite) TestValidateRefreshToken_Error_InvalidJWTFormat() {
 	token := invalidJWTFormat
 
@@ -2017,7 +2034,7 @@ func (suite *ExternalIDPValidatorTestSuite) TestValidateSubjectToken_ExternalIDP
 
 	suite.mockIDPService.On("GetIdentityProviderByIssuer", context.Background(), testExternalIssuer).
 		Return(idpDTO, nil)
-	suite.mockJWTService.On("VerifyJWTSignatureWithJWKS", token, testExternalJWKS).Return(nil)
+	suite.mockJWTService.On("VerifyJWTSignatureWithJWKS", mock.Anything, token, testExternalJWKS).Return(nil)
 
 	result, err := suite.validator.ValidateSubjectToken(context.Background(), token, suite.oauthApp)
 
@@ -2043,7 +2060,7 @@ func (suite *ExternalIDPValidatorTestSuite) TestValidateSubjectToken_ExternalIDP
 
 	suite.mockIDPService.On("GetIdentityProviderByIssuer", context.Background(), testExternalIssuer).
 		Return(idpDTO, nil)
-	suite.mockJWTService.On("VerifyJWTSignatureWithJWKS", token, testExternalJWKS).Return(nil)
+	suite.mockJWTService.On("VerifyJWTSignatureWithJWKS", mock.Anything, token, testExternalJWKS).Return(nil)
 
 	result, err := suite.validator.ValidateSubjectToken(context.Background(), token, suite.oauthApp)
 
@@ -2068,7 +2085,7 @@ func (suite *ExternalIDPValidatorTestSuite) TestValidateSubjectToken_ExternalIDP
 
 	suite.mockIDPService.On("GetIdentityProviderByIssuer", context.Background(), testExternalIssuer).
 		Return(idpDTO, nil)
-	suite.mockJWTService.On("VerifyJWTSignatureWithJWKS", token, testExternalJWKS).Return(nil)
+	suite.mockJWTService.On("VerifyJWTSignatureWithJWKS", mock.Anything, token, testExternalJWKS).Return(nil)
 
 	result, err := suite.validator.ValidateSubjectToken(context.Background(), token, suite.oauthApp)
 
@@ -2092,7 +2109,7 @@ func (suite *ExternalIDPValidatorTestSuite) TestValidateSubjectToken_ExternalIDP
 
 	suite.mockIDPService.On("GetIdentityProviderByIssuer", context.Background(), testExternalIssuer).
 		Return(idpDTO, nil)
-	suite.mockJWTService.On("VerifyJWTSignatureWithJWKS", token, testExternalJWKS).Return(nil)
+	suite.mockJWTService.On("VerifyJWTSignatureWithJWKS", mock.Anything, token, testExternalJWKS).Return(nil)
 
 	result, err := suite.validator.ValidateSubjectToken(context.Background(), token, suite.oauthApp)
 
@@ -2116,7 +2133,7 @@ func (suite *ExternalIDPValidatorTestSuite) TestValidateSubjectToken_ExternalIDP
 
 	suite.mockIDPService.On("GetIdentityProviderByIssuer", context.Background(), testExternalIssuer).
 		Return(idpDTO, nil)
-	suite.mockJWTService.On("VerifyJWTSignatureWithJWKS", token, testExternalJWKS).
+	suite.mockJWTService.On("VerifyJWTSignatureWithJWKS", mock.Anything, token, testExternalJWKS).
 		Return(&serviceerror.ServiceError{
 			Type:  serviceerror.ServerErrorType,
 			Code:  "SIGNATURE_VERIFICATION_FAILED",
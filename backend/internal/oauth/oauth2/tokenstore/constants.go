@@ -0,0 +1,46 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package tokenstore
+
+// ArtifactType identifies a category of short-lived artifact held in a token store.
+type ArtifactType string
+
+const (
+	// ArtifactTypeAuthorizationCode identifies OAuth2 authorization codes.
+	ArtifactTypeAuthorizationCode ArtifactType = "authorization_code"
+	// ArtifactTypeDeviceCode identifies device authorization grant device codes.
+	ArtifactTypeDeviceCode ArtifactType = "device_code"
+	// ArtifactTypePARRequest identifies pushed authorization request URIs.
+	ArtifactTypePARRequest ArtifactType = "par_request"
+	// ArtifactTypeRefreshToken identifies issued refresh tokens.
+	ArtifactTypeRefreshToken ArtifactType = "refresh_token"
+	// ArtifactTypeDPoPProof identifies the JTIs of accepted DPoP proofs, held to detect proof replay.
+	ArtifactTypeDPoPProof ArtifactType = "dpop_proof"
+	// ArtifactTypeDPoPNonce identifies server-issued DPoP nonces.
+	ArtifactTypeDPoPNonce ArtifactType = "dpop_nonce"
+	// ArtifactTypeFlowExecution identifies the contexts of flow executions in progress.
+	ArtifactTypeFlowExecution ArtifactType = "flow_execution"
+)
+
+const (
+	// StoreTypeDatabase stores artifacts in the runtime database.
+	StoreTypeDatabase = "database"
+	// StoreTypeRedis stores artifacts in Redis, relying on key TTLs for expiry.
+	StoreTypeRedis = "redis"
+)
@@ -0,0 +1,68 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package tokenstore
+
+import (
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/database/provider"
+)
+
+// Initialize creates the token store configured for the given artifact type.
+func Initialize(artifactType ArtifactType) TokenStoreInterface {
+	deploymentID := config.GetServerRuntime().Config.Server.Identifier
+
+	if ResolveStoreType(artifactType) == StoreTypeRedis {
+		return newRedisTokenStore(provider.GetRedisProvider(), artifactType, deploymentID)
+	}
+	return newTokenStore(artifactType, deploymentID)
+}
+
+// ResolveStoreType returns the store type configured for the given artifact type.
+// Authorization codes, device codes, PAR request URIs and DPoP artifacts fall back to the runtime
+// database type when not configured. Refresh tokens are stateless and flow executions stay in the
+// runtime flow store unless a store is configured, in which case an empty string is returned for the
+// unconfigured case.
+func ResolveStoreType(artifactType ArtifactType) string {
+	cfg := config.GetServerRuntime().Config
+	tokenStoreCfg := cfg.OAuth.TokenStore
+
+	var configured string
+	switch artifactType {
+	case ArtifactTypeAuthorizationCode:
+		configured = tokenStoreCfg.AuthorizationCode
+	case ArtifactTypeDeviceCode:
+		configured = tokenStoreCfg.DeviceCode
+	case ArtifactTypePARRequest:
+		configured = tokenStoreCfg.PARRequest
+	case ArtifactTypeRefreshToken:
+		return tokenStoreCfg.RefreshToken
+	case ArtifactTypeFlowExecution:
+		return tokenStoreCfg.FlowExecution
+	case ArtifactTypeDPoPProof, ArtifactTypeDPoPNonce:
+		configured = tokenStoreCfg.DPoP
+	}
+	if configured != "" {
+		return configured
+	}
+
+	if cfg.Database.Runtime.Type == provider.DataSourceTypeRedis {
+		return StoreTypeRedis
+	}
+	return StoreTypeDatabase
+}
@@ -0,0 +1,95 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package tokenstore
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/database/provider"
+)
+
+type InitTestSuite struct {
+	suite.Suite
+}
+
+func TestInitTestSuite(t *testing.T) {
+	suite.Run(t, new(InitTestSuite))
+}
+
+func (s *InitTestSuite) TearDownTest() {
+	config.ResetServerRuntime()
+}
+
+func (s *InitTestSuite) initRuntime(runtimeType string, tokenStoreCfg config.TokenStoreConfig) {
+	testConfig := &config.Config{}
+	testConfig.Database.Runtime.Type = runtimeType
+	testConfig.OAuth.TokenStore = tokenStoreCfg
+	s.Require().NoError(config.InitializeServerRuntime("", testConfig))
+}
+
+func (s *InitTestSuite) TestResolveStoreType_DefaultsToRuntimeDatabase() {
+	s.initRuntime("sqlite", config.TokenStoreConfig{})
+
+	s.Equal(StoreTypeDatabase, ResolveStoreType(ArtifactTypeAuthorizationCode))
+	s.Equal(StoreTypeDatabase, ResolveStoreType(ArtifactTypeDeviceCode))
+	s.Equal(StoreTypeDatabase, ResolveStoreType(ArtifactTypePARRequest))
+	s.Equal(StoreTypeDatabase, ResolveStoreType(ArtifactTypeDPoPProof))
+	s.Equal(StoreTypeDatabase, ResolveStoreType(ArtifactTypeDPoPNonce))
+	s.Empty(ResolveStoreType(ArtifactTypeRefreshToken))
+}
+
+func (s *InitTestSuite) TestResolveStoreType_DefaultsToRedisRuntime() {
+	s.initRuntime(provider.DataSourceTypeRedis, config.TokenStoreConfig{})
+
+	s.Equal(StoreTypeRedis, ResolveStoreType(ArtifactTypeAuthorizationCode))
+	s.Equal(StoreTypeRedis, ResolveStoreType(ArtifactTypePARRequest))
+	s.Empty(ResolveStoreType(ArtifactTypeRefreshToken))
+	s.Empty(ResolveStoreType(ArtifactTypeFlowExecution))
+}
+
+func (s *InitTestSuite) TestResolveStoreType_ConfiguredPerArtifact() {
+	s.initRuntime(provider.DataSourceTypeRedis, config.TokenStoreConfig{
+		AuthorizationCode: StoreTypeDatabase,
+		DeviceCode:        StoreTypeRedis,
+		RefreshToken:      StoreTypeDatabase,
+		DPoP:              StoreTypeDatabase,
+		FlowExecution:     StoreTypeDatabase,
+	})
+
+	s.Equal(StoreTypeDatabase, ResolveStoreType(ArtifactTypeAuthorizationCode))
+	s.Equal(StoreTypeRedis, ResolveStoreType(ArtifactTypeDeviceCode))
+	s.Equal(StoreTypeRedis, ResolveStoreType(ArtifactTypePARRequest))
+	s.Equal(StoreTypeDatabase, ResolveStoreType(ArtifactTypeRefreshToken))
+	s.Equal(StoreTypeDatabase, ResolveStoreType(ArtifactTypeDPoPProof))
+	s.Equal(StoreTypeDatabase, ResolveStoreType(ArtifactTypeDPoPNonce))
+	s.Equal(StoreTypeDatabase, ResolveStoreType(ArtifactTypeFlowExecution))
+}
+
+func (s *InitTestSuite) TestInitialize_DatabaseStore() {
+	s.initRuntime("sqlite", config.TokenStoreConfig{})
+
+	store := Initialize(ArtifactTypeDeviceCode)
+
+	dbStore, ok := store.(*tokenStore)
+	s.Require().True(ok)
+	s.Equal(ArtifactTypeDeviceCode, dbStore.artifactType)
+}
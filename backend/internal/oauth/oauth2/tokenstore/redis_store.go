@@ -0,0 +1,122 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package tokenstore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"github.com/thunder-id/thunderid/internal/system/database/provider"
+)
+
+// tokenStoreRedisClient abstracts the Redis commands used by the token store.
+type tokenStoreRedisClient interface {
+	Set(ctx context.Context, key string, value any, expiration time.Duration) *redis.StatusCmd
+	SetArgs(ctx context.Context, key string, value any, a redis.SetArgs) *redis.StatusCmd
+	Get(ctx context.Context, key string) *redis.StringCmd
+	GetDel(ctx context.Context, key string) *redis.StringCmd
+	Del(ctx context.Context, keys ...string) *redis.IntCmd
+}
+
+// redisTokenStore is the Redis-backed implementation of TokenStoreInterface.
+// Entries are written with a TTL so expiry requires no cleanup job.
+type redisTokenStore struct {
+	client       tokenStoreRedisClient
+	keyPrefix    string
+	artifactType ArtifactType
+	deploymentID string
+}
+
+// newRedisTokenStore creates a new Redis-backed token store for the given artifact type.
+func newRedisTokenStore(
+	p provider.RedisProviderInterface, artifactType ArtifactType, deploymentID string,
+) TokenStoreInterface {
+	return &redisTokenStore{
+		client:       p.GetRedisClient(),
+		keyPrefix:    p.GetKeyPrefix(),
+		artifactType: artifactType,
+		deploymentID: deploymentID,
+	}
+}
+
+// tokenKey builds the Redis key for a token store entry.
+func (s *redisTokenStore) tokenKey(key string) string {
+	return fmt.Sprintf("%s:runtime:%s:%s:%s", s.keyPrefix, s.deploymentID, s.artifactType, key)
+}
+
+// Store persists a value under the given key with a TTL derived from the expiry time.
+func (s *redisTokenStore) Store(ctx context.Context, key string, value []byte, expiryTime time.Time) error {
+	ttl := time.Until(expiryTime)
+	if ttl <= 0 {
+		return fmt.Errorf("%s already expired", s.artifactType)
+	}
+	if err := s.client.Set(ctx, s.tokenKey(key), value, ttl).Err(); err != nil {
+		return fmt.Errorf("failed to store %s in Redis: %w", s.artifactType, err)
+	}
+	return nil
+}
+
+// Get retrieves the value stored under the given key without consuming it.
+func (s *redisTokenStore) Get(ctx context.Context, key string) ([]byte, bool, error) {
+	data, err := s.client.Get(ctx, s.tokenKey(key)).Bytes()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, false, nil
+		}
+		return nil, false, fmt.Errorf("failed to get %s from Redis: %w", s.artifactType, err)
+	}
+	return data, true, nil
+}
+
+// Consume atomically retrieves and deletes the entry via Redis GETDEL.
+func (s *redisTokenStore) Consume(ctx context.Context, key string) ([]byte, bool, error) {
+	data, err := s.client.GetDel(ctx, s.tokenKey(key)).Bytes()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, false, nil
+		}
+		return nil, false, fmt.Errorf("failed to consume %s from Redis: %w", s.artifactType, err)
+	}
+	return data, true, nil
+}
+
+// Update replaces the value of an existing entry while keeping its remaining TTL.
+// It reports whether a matching entry was found.
+func (s *redisTokenStore) Update(ctx context.Context, key string, value []byte) (bool, error) {
+	err := s.client.SetArgs(ctx, s.tokenKey(key), value, redis.SetArgs{Mode: "XX", KeepTTL: true}).Err()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to update %s in Redis: %w", s.artifactType, err)
+	}
+	return true, nil
+}
+
+// Delete removes the entry stored under the given key, if present.
+func (s *redisTokenStore) Delete(ctx context.Context, key string) error {
+	if err := s.client.Del(ctx, s.tokenKey(key)).Err(); err != nil {
+		return fmt.Errorf("failed to delete %s from Redis: %w", s.artifactType, err)
+	}
+	return nil
+}
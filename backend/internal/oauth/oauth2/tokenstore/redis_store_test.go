@@ -0,0 +1,218 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package tokenstore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+const redisTestKeyPrefix = "thunderid"
+
+type RedisStoreTestSuite struct {
+	suite.Suite
+	mockClient *tokenStoreRedisClientMock
+	store      *redisTokenStore
+	ctx        context.Context
+	redisKey   string
+}
+
+func TestRedisStoreTestSuite(t *testing.T) {
+	suite.Run(t, new(RedisStoreTestSuite))
+}
+
+func (s *RedisStoreTestSuite) SetupTest() {
+	s.mockClient = newTokenStoreRedisClientMock(s.T())
+	s.store = &redisTokenStore{
+		client:       s.mockClient,
+		keyPrefix:    redisTestKeyPrefix,
+		artifactType: ArtifactTypeAuthorizationCode,
+		deploymentID: testDeploymentID,
+	}
+	s.ctx = context.Background()
+	s.redisKey = fmt.Sprintf("%s:runtime:%s:authorization_code:%s",
+		redisTestKeyPrefix, testDeploymentID, testTokenKey)
+}
+
+func (s *RedisStoreTestSuite) TestTokenKey() {
+	s.Equal(s.redisKey, s.store.tokenKey(testTokenKey))
+}
+
+// Tests for Store
+
+func (s *RedisStoreTestSuite) TestStore_Success() {
+	s.mockClient.On("Set", s.ctx, s.redisKey, []byte("value"),
+		mock.MatchedBy(func(ttl time.Duration) bool { return ttl > 0 && ttl <= time.Minute }),
+	).Return(redis.NewStatusCmd(s.ctx))
+
+	err := s.store.Store(s.ctx, testTokenKey, []byte("value"), time.Now().Add(time.Minute))
+
+	s.NoError(err)
+}
+
+func (s *RedisStoreTestSuite) TestStore_AlreadyExpired() {
+	err := s.store.Store(s.ctx, testTokenKey, []byte("value"), time.Now().Add(-time.Second))
+
+	s.Error(err)
+	s.mockClient.AssertNotCalled(s.T(), "Set")
+}
+
+func (s *RedisStoreTestSuite) TestStore_RedisError() {
+	cmd := redis.NewStatusCmd(s.ctx)
+	cmd.SetErr(errors.New("connection refused"))
+	s.mockClient.On("Set", s.ctx, s.redisKey, mock.Anything, mock.Anything).Return(cmd)
+
+	err := s.store.Store(s.ctx, testTokenKey, []byte("value"), time.Now().Add(time.Minute))
+
+	s.ErrorContains(err, "connection refused")
+}
+
+// Tests for Get
+
+func (s *RedisStoreTestSuite) TestGet_Found() {
+	cmd := redis.NewStringCmd(s.ctx)
+	cmd.SetVal("value")
+	s.mockClient.On("Get", s.ctx, s.redisKey).Return(cmd)
+
+	value, found, err := s.store.Get(s.ctx, testTokenKey)
+
+	s.NoError(err)
+	s.True(found)
+	s.Equal([]byte("value"), value)
+}
+
+func (s *RedisStoreTestSuite) TestGet_NotFound() {
+	cmd := redis.NewStringCmd(s.ctx)
+	cmd.SetErr(redis.Nil)
+	s.mockClient.On("Get", s.ctx, s.redisKey).Return(cmd)
+
+	value, found, err := s.store.Get(s.ctx, testTokenKey)
+
+	s.NoError(err)
+	s.False(found)
+	s.Nil(value)
+}
+
+func (s *RedisStoreTestSuite) TestGet_RedisError() {
+	cmd := redis.NewStringCmd(s.ctx)
+	cmd.SetErr(errors.New("connection refused"))
+	s.mockClient.On("Get", s.ctx, s.redisKey).Return(cmd)
+
+	_, found, err := s.store.Get(s.ctx, testTokenKey)
+
+	s.Error(err)
+	s.False(found)
+}
+
+// Tests for Consume
+
+func (s *RedisStoreTestSuite) TestConsume_Found() {
+	cmd := redis.NewStringCmd(s.ctx)
+	cmd.SetVal("value")
+	s.mockClient.On("GetDel", s.ctx, s.redisKey).Return(cmd)
+
+	value, found, err := s.store.Consume(s.ctx, testTokenKey)
+
+	s.NoError(err)
+	s.True(found)
+	s.Equal([]byte("value"), value)
+}
+
+func (s *RedisStoreTestSuite) TestConsume_NotFound() {
+	cmd := redis.NewStringCmd(s.ctx)
+	cmd.SetErr(redis.Nil)
+	s.mockClient.On("GetDel", s.ctx, s.redisKey).Return(cmd)
+
+	_, found, err := s.store.Consume(s.ctx, testTokenKey)
+
+	s.NoError(err)
+	s.False(found)
+}
+
+func (s *RedisStoreTestSuite) TestConsume_RedisError() {
+	cmd := redis.NewStringCmd(s.ctx)
+	cmd.SetErr(errors.New("connection refused"))
+	s.mockClient.On("GetDel", s.ctx, s.redisKey).Return(cmd)
+
+	_, found, err := s.store.Consume(s.ctx, testTokenKey)
+
+	s.Error(err)
+	s.False(found)
+}
+
+// Tests for Update
+
+func (s *RedisStoreTestSuite) TestUpdate_Found() {
+	s.mockClient.On("SetArgs", s.ctx, s.redisKey, []byte("value"),
+		redis.SetArgs{Mode: "XX", KeepTTL: true}).Return(redis.NewStatusCmd(s.ctx))
+
+	found, err := s.store.Update(s.ctx, testTokenKey, []byte("value"))
+
+	s.NoError(err)
+	s.True(found)
+}
+
+func (s *RedisStoreTestSuite) TestUpdate_NotFound() {
+	cmd := redis.NewStatusCmd(s.ctx)
+	cmd.SetErr(redis.Nil)
+	s.mockClient.On("SetArgs", s.ctx, s.redisKey, []byte("value"), mock.Anything).Return(cmd)
+
+	found, err := s.store.Update(s.ctx, testTokenKey, []byte("value"))
+
+	s.NoError(err)
+	s.False(found)
+}
+
+func (s *RedisStoreTestSuite) TestUpdate_RedisError() {
+	cmd := redis.NewStatusCmd(s.ctx)
+	cmd.SetErr(errors.New("connection refused"))
+	s.mockClient.On("SetArgs", s.ctx, s.redisKey, []byte("value"), mock.Anything).Return(cmd)
+
+	found, err := s.store.Update(s.ctx, testTokenKey, []byte("value"))
+
+	s.Error(err)
+	s.False(found)
+}
+
+// Tests for Delete
+
+func (s *RedisStoreTestSuite) TestDelete_Success() {
+	s.mockClient.On("Del", s.ctx, s.redisKey).Return(redis.NewIntCmd(s.ctx))
+
+	err := s.store.Delete(s.ctx, testTokenKey)
+
+	s.NoError(err)
+}
+
+func (s *RedisStoreTestSuite) TestDelete_RedisError() {
+	cmd := redis.NewIntCmd(s.ctx)
+	cmd.SetErr(errors.New("connection refused"))
+	s.mockClient.On("Del", s.ctx, s.redisKey).Return(cmd)
+
+	err := s.store.Delete(s.ctx, testTokenKey)
+
+	s.Error(err)
+}
@@ -0,0 +1,160 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package tokenstore provides pluggable storage for short-lived OAuth artifacts such as
+// authorization codes, device codes, PAR request URIs, refresh tokens and DPoP proofs and nonces,
+// and for the contexts of flow executions in progress.
+package tokenstore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/system/database/provider"
+)
+
+// TokenStoreInterface defines a key-value store for short-lived OAuth artifacts of a single type.
+// Entries expire at the supplied expiry time and are never returned afterwards. Consume provides
+// atomic single-use semantics: at most one caller obtains the value of a given key.
+type TokenStoreInterface interface {
+	Store(ctx context.Context, key string, value []byte, expiryTime time.Time) error
+	Get(ctx context.Context, key string) ([]byte, bool, error)
+	Consume(ctx context.Context, key string) ([]byte, bool, error)
+	Update(ctx context.Context, key string, value []byte) (bool, error)
+	Delete(ctx context.Context, key string) error
+}
+
+// tokenStore is the runtime-database-backed implementation of TokenStoreInterface.
+type tokenStore struct {
+	dbProvider   provider.DBProviderInterface
+	artifactType ArtifactType
+	deploymentID string
+}
+
+// newTokenStore creates a new DB-backed token store for the given artifact type.
+func newTokenStore(artifactType ArtifactType, deploymentID string) TokenStoreInterface {
+	return &tokenStore{
+		dbProvider:   provider.GetDBProvider(),
+		artifactType: artifactType,
+		deploymentID: deploymentID,
+	}
+}
+
+// Store persists a value under the given key until the expiry time.
+func (s *tokenStore) Store(ctx context.Context, key string, value []byte, expiryTime time.Time) error {
+	dbClient, err := s.dbProvider.GetRuntimeDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	if _, err := dbClient.ExecuteContext(ctx, queryInsertToken, key, string(s.artifactType), string(value),
+		expiryTime.UTC(), s.deploymentID); err != nil {
+		return fmt.Errorf("failed to insert %s: %w", s.artifactType, err)
+	}
+	return nil
+}
+
+// Get retrieves the value stored under the given key without consuming it.
+func (s *tokenStore) Get(ctx context.Context, key string) ([]byte, bool, error) {
+	dbClient, err := s.dbProvider.GetRuntimeDBClient()
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	results, err := dbClient.QueryContext(ctx, queryGetToken, key, string(s.artifactType), time.Now().UTC(),
+		s.deploymentID)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to retrieve %s: %w", s.artifactType, err)
+	}
+	if len(results) == 0 {
+		return nil, false, nil
+	}
+
+	value, err := getTokenDataFromRow(results[0])
+	if err != nil {
+		return nil, false, err
+	}
+	return value, true, nil
+}
+
+// Consume atomically deletes the entry stored under the given key and returns its value.
+// The delete and read happen in a single statement so concurrent callers cannot both succeed.
+func (s *tokenStore) Consume(ctx context.Context, key string) ([]byte, bool, error) {
+	dbClient, err := s.dbProvider.GetRuntimeDBClient()
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	results, err := dbClient.QueryContext(ctx, queryConsumeToken, key, string(s.artifactType),
+		time.Now().UTC(), s.deploymentID)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to consume %s: %w", s.artifactType, err)
+	}
+	if len(results) == 0 {
+		return nil, false, nil
+	}
+
+	value, err := getTokenDataFromRow(results[0])
+	if err != nil {
+		return nil, false, err
+	}
+	return value, true, nil
+}
+
+// Update replaces the value of a non-expired entry without changing its expiry time.
+// It reports whether a matching entry was found.
+func (s *tokenStore) Update(ctx context.Context, key string, value []byte) (bool, error) {
+	dbClient, err := s.dbProvider.GetRuntimeDBClient()
+	if err != nil {
+		return false, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	rowsAffected, err := dbClient.ExecuteContext(ctx, queryUpdateToken, string(value), key,
+		string(s.artifactType), time.Now().UTC(), s.deploymentID)
+	if err != nil {
+		return false, fmt.Errorf("failed to update %s: %w", s.artifactType, err)
+	}
+	return rowsAffected > 0, nil
+}
+
+// Delete removes the entry stored under the given key, if present.
+func (s *tokenStore) Delete(ctx context.Context, key string) error {
+	dbClient, err := s.dbProvider.GetRuntimeDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	if _, err := dbClient.ExecuteContext(ctx, queryDeleteToken, key, string(s.artifactType),
+		s.deploymentID); err != nil {
+		return fmt.Errorf("failed to delete %s: %w", s.artifactType, err)
+	}
+	return nil
+}
+
+// getTokenDataFromRow extracts the stored value from a database row.
+func getTokenDataFromRow(row map[string]interface{}) ([]byte, error) {
+	if val, ok := row[dbColumnTokenData].(string); ok {
+		return []byte(val), nil
+	}
+	if val, ok := row[dbColumnTokenData].([]byte); ok {
+		return val, nil
+	}
+	return nil, errors.New("token_data is missing or of unexpected type")
+}
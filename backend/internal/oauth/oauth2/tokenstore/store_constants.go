@@ -0,0 +1,61 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package tokenstore
+
+import dbmodel "github.com/thunder-id/thunderid/internal/system/database/model"
+
+// Database column names for token store entries.
+const (
+	dbColumnTokenData = "token_data"
+)
+
+// queryInsertToken is the query to insert a token store entry.
+var queryInsertToken = dbmodel.DBQuery{
+	ID: "TSQ-TS-01",
+	Query: `INSERT INTO "OAUTH_TOKEN" (TOKEN_KEY, ARTIFACT_TYPE, TOKEN_DATA, EXPIRY_TIME, DEPLOYMENT_ID) ` +
+		`VALUES ($1, $2, $3, $4, $5)`,
+}
+
+// queryGetToken is the query to retrieve a non-expired token store entry.
+var queryGetToken = dbmodel.DBQuery{
+	ID: "TSQ-TS-02",
+	Query: `SELECT TOKEN_DATA FROM "OAUTH_TOKEN" ` +
+		`WHERE TOKEN_KEY = $1 AND ARTIFACT_TYPE = $2 AND EXPIRY_TIME > $3 AND DEPLOYMENT_ID = $4`,
+}
+
+// queryConsumeToken atomically deletes a non-expired token store entry and returns its value.
+var queryConsumeToken = dbmodel.DBQuery{
+	ID: "TSQ-TS-03",
+	Query: `DELETE FROM "OAUTH_TOKEN" ` +
+		`WHERE TOKEN_KEY = $1 AND ARTIFACT_TYPE = $2 AND EXPIRY_TIME > $3 AND DEPLOYMENT_ID = $4 ` +
+		`RETURNING TOKEN_DATA`,
+}
+
+// queryDeleteToken is the query to delete a token store entry.
+var queryDeleteToken = dbmodel.DBQuery{
+	ID:    "TSQ-TS-04",
+	Query: `DELETE FROM "OAUTH_TOKEN" WHERE TOKEN_KEY = $1 AND ARTIFACT_TYPE = $2 AND DEPLOYMENT_ID = $3`,
+}
+
+// queryUpdateToken is the query to replace the value of a non-expired token store entry.
+var queryUpdateToken = dbmodel.DBQuery{
+	ID: "TSQ-TS-05",
+	Query: `UPDATE "OAUTH_TOKEN" SET TOKEN_DATA = $1 ` +
+		`WHERE TOKEN_KEY = $2 AND ARTIFACT_TYPE = $3 AND EXPIRY_TIME > $4 AND DEPLOYMENT_ID = $5`,
+}
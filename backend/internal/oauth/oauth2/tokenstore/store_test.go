@@ -0,0 +1,241 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package tokenstore
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/tests/mocks/database/providermock"
+)
+
+const (
+	testDeploymentID = "test-deployment-id"
+	testTokenKey     = "test-token-key"
+)
+
+type StoreTestSuite struct {
+	suite.Suite
+	mockDBProvider *providermock.DBProviderInterfaceMock
+	mockDBClient   *providermock.DBClientInterfaceMock
+	store          *tokenStore
+	ctx            context.Context
+}
+
+func TestStoreTestSuite(t *testing.T) {
+	suite.Run(t, new(StoreTestSuite))
+}
+
+func (s *StoreTestSuite) SetupTest() {
+	s.mockDBProvider = providermock.NewDBProviderInterfaceMock(s.T())
+	s.mockDBClient = providermock.NewDBClientInterfaceMock(s.T())
+	s.store = &tokenStore{
+		dbProvider:   s.mockDBProvider,
+		artifactType: ArtifactTypeRefreshToken,
+		deploymentID: testDeploymentID,
+	}
+	s.ctx = context.Background()
+}
+
+// Tests for Store
+
+func (s *StoreTestSuite) TestStore_Success() {
+	expiry := time.Now().Add(time.Hour)
+	s.mockDBProvider.On("GetRuntimeDBClient").Return(s.mockDBClient, nil)
+	s.mockDBClient.On("ExecuteContext", mock.Anything, queryInsertToken, testTokenKey,
+		string(ArtifactTypeRefreshToken), "value", expiry.UTC(), testDeploymentID).Return(int64(1), nil)
+
+	err := s.store.Store(s.ctx, testTokenKey, []byte("value"), expiry)
+
+	s.NoError(err)
+}
+
+func (s *StoreTestSuite) TestStore_DBClientError() {
+	s.mockDBProvider.On("GetRuntimeDBClient").Return(nil, errors.New("db client error"))
+
+	err := s.store.Store(s.ctx, testTokenKey, []byte("value"), time.Now().Add(time.Hour))
+
+	s.Error(err)
+}
+
+func (s *StoreTestSuite) TestStore_ExecuteError() {
+	s.mockDBProvider.On("GetRuntimeDBClient").Return(s.mockDBClient, nil)
+	s.mockDBClient.On("ExecuteContext", mock.Anything, queryInsertToken,
+		mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything,
+	).Return(int64(0), errors.New("insert failed"))
+
+	err := s.store.Store(s.ctx, testTokenKey, []byte("value"), time.Now().Add(time.Hour))
+
+	s.ErrorContains(err, "insert failed")
+}
+
+// Tests for Get
+
+func (s *StoreTestSuite) TestGet_Found() {
+	s.mockDBProvider.On("GetRuntimeDBClient").Return(s.mockDBClient, nil)
+	s.mockDBClient.On("QueryContext", mock.Anything, queryGetToken, testTokenKey,
+		string(ArtifactTypeRefreshToken), mock.AnythingOfType("time.Time"), testDeploymentID,
+	).Return([]map[string]interface{}{{dbColumnTokenData: "value"}}, nil)
+
+	value, found, err := s.store.Get(s.ctx, testTokenKey)
+
+	s.NoError(err)
+	s.True(found)
+	s.Equal([]byte("value"), value)
+}
+
+func (s *StoreTestSuite) TestGet_NotFound() {
+	s.mockDBProvider.On("GetRuntimeDBClient").Return(s.mockDBClient, nil)
+	s.mockDBClient.On("QueryContext", mock.Anything, queryGetToken,
+		mock.Anything, mock.Anything, mock.Anything, mock.Anything,
+	).Return([]map[string]interface{}{}, nil)
+
+	value, found, err := s.store.Get(s.ctx, testTokenKey)
+
+	s.NoError(err)
+	s.False(found)
+	s.Nil(value)
+}
+
+func (s *StoreTestSuite) TestGet_QueryError() {
+	s.mockDBProvider.On("GetRuntimeDBClient").Return(s.mockDBClient, nil)
+	s.mockDBClient.On("QueryContext", mock.Anything, queryGetToken,
+		mock.Anything, mock.Anything, mock.Anything, mock.Anything,
+	).Return(nil, errors.New("query failed"))
+
+	_, found, err := s.store.Get(s.ctx, testTokenKey)
+
+	s.Error(err)
+	s.False(found)
+}
+
+func (s *StoreTestSuite) TestGet_UnexpectedDataType() {
+	s.mockDBProvider.On("GetRuntimeDBClient").Return(s.mockDBClient, nil)
+	s.mockDBClient.On("QueryContext", mock.Anything, queryGetToken,
+		mock.Anything, mock.Anything, mock.Anything, mock.Anything,
+	).Return([]map[string]interface{}{{dbColumnTokenData: 42}}, nil)
+
+	_, found, err := s.store.Get(s.ctx, testTokenKey)
+
+	s.Error(err)
+	s.False(found)
+}
+
+// Tests for Consume
+
+func (s *StoreTestSuite) TestConsume_Found() {
+	s.mockDBProvider.On("GetRuntimeDBClient").Return(s.mockDBClient, nil)
+	s.mockDBClient.On("QueryContext", mock.Anything, queryConsumeToken, testTokenKey,
+		string(ArtifactTypeRefreshToken), mock.AnythingOfType("time.Time"), testDeploymentID,
+	).Return([]map[string]interface{}{{dbColumnTokenData: []byte("value")}}, nil)
+
+	value, found, err := s.store.Consume(s.ctx, testTokenKey)
+
+	s.NoError(err)
+	s.True(found)
+	s.Equal([]byte("value"), value)
+}
+
+func (s *StoreTestSuite) TestConsume_NotFound() {
+	s.mockDBProvider.On("GetRuntimeDBClient").Return(s.mockDBClient, nil)
+	s.mockDBClient.On("QueryContext", mock.Anything, queryConsumeToken,
+		mock.Anything, mock.Anything, mock.Anything, mock.Anything,
+	).Return([]map[string]interface{}{}, nil)
+
+	_, found, err := s.store.Consume(s.ctx, testTokenKey)
+
+	s.NoError(err)
+	s.False(found)
+}
+
+func (s *StoreTestSuite) TestConsume_DBClientError() {
+	s.mockDBProvider.On("GetRuntimeDBClient").Return(nil, errors.New("db client error"))
+
+	_, found, err := s.store.Consume(s.ctx, testTokenKey)
+
+	s.Error(err)
+	s.False(found)
+}
+
+// Tests for Update
+
+func (s *StoreTestSuite) TestUpdate_Found() {
+	s.mockDBProvider.On("GetRuntimeDBClient").Return(s.mockDBClient, nil)
+	s.mockDBClient.On("ExecuteContext", mock.Anything, queryUpdateToken, "value", testTokenKey,
+		string(ArtifactTypeRefreshToken), mock.AnythingOfType("time.Time"), testDeploymentID,
+	).Return(int64(1), nil)
+
+	found, err := s.store.Update(s.ctx, testTokenKey, []byte("value"))
+
+	s.NoError(err)
+	s.True(found)
+}
+
+func (s *StoreTestSuite) TestUpdate_NotFound() {
+	s.mockDBProvider.On("GetRuntimeDBClient").Return(s.mockDBClient, nil)
+	s.mockDBClient.On("ExecuteContext", mock.Anything, queryUpdateToken,
+		mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything,
+	).Return(int64(0), nil)
+
+	found, err := s.store.Update(s.ctx, testTokenKey, []byte("value"))
+
+	s.NoError(err)
+	s.False(found)
+}
+
+func (s *StoreTestSuite) TestUpdate_ExecuteError() {
+	s.mockDBProvider.On("GetRuntimeDBClient").Return(s.mockDBClient, nil)
+	s.mockDBClient.On("ExecuteContext", mock.Anything, queryUpdateToken,
+		mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything,
+	).Return(int64(0), errors.New("update failed"))
+
+	found, err := s.store.Update(s.ctx, testTokenKey, []byte("value"))
+
+	assert.ErrorContains(s.T(), err, "update failed")
+	s.False(found)
+}
+
+// Tests for Delete
+
+func (s *StoreTestSuite) TestDelete_Success() {
+	s.mockDBProvider.On("GetRuntimeDBClient").Return(s.mockDBClient, nil)
+	s.mockDBClient.On("ExecuteContext", mock.Anything, queryDeleteToken, testTokenKey,
+		string(ArtifactTypeRefreshToken), testDeploymentID).Return(int64(1), nil)
+
+	err := s.store.Delete(s.ctx, testTokenKey)
+
+	s.NoError(err)
+}
+
+func (s *StoreTestSuite) TestDelete_ExecuteError() {
+	s.mockDBProvider.On("GetRuntimeDBClient").Return(s.mockDBClient, nil)
+	s.mockDBClient.On("ExecuteContext", mock.Anything, queryDeleteToken,
+		mock.Anything, mock.Anything, mock.Anything,
+	).Return(int64(0), errors.New("delete failed"))
+
+	err := s.store.Delete(s.ctx, testTokenKey)
+
+	assert.ErrorContains(s.T(), err, "delete failed")
+}
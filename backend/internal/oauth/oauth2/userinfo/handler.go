@@ -24,6 +24,7 @@ import (
 
 	inboundmodel "github.com/thunder-id/thunderid/internal/inboundclient/model"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/dpop"
 	serverconst "github.com/thunder-id/thunderid/internal/system/constants"
 	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
 	"github.com/thunder-id/thunderid/internal/system/log"
@@ -34,15 +35,22 @@ const handlerLoggerComponentName = "UserInfoHandler"
 
 // userInfoHandler handles OIDC UserInfo requests.
 type userInfoHandler struct {
-	service userInfoServiceInterface
-	logger  *log.Logger
+	service     userInfoServiceInterface
+	dpopService dpop.DPoPServiceInterface
+	// endpointURL is the URL of the UserInfo endpoint that DPoP proofs must target.
+	endpointURL string
+	logger      *log.Logger
 }
 
 // newUserInfoHandler creates a new userInfo handler.
-func newUserInfoHandler(userInfoService userInfoServiceInterface) *userInfoHandler {
+func newUserInfoHandler(
+	userInfoService userInfoServiceInterface, dpopService dpop.DPoPServiceInterface, endpointURL string,
+) *userInfoHandler {
 	return &userInfoHandler{
-		service: userInfoService,
-		logger:  log.GetLogger().With(log.String(log.LoggerKeyComponentName, handlerLoggerComponentName)),
+		service:     userInfoService,
+		dpopService: dpopService,
+		endpointURL: endpointURL,
+		logger:      log.GetLogger().With(log.String(log.LoggerKeyComponentName, handlerLoggerComponentName)),
 	}
 }
 
@@ -50,19 +58,31 @@ func newUserInfoHandler(userInfoService userInfoServiceInterface) *userInfoHandl
 func (h *userInfoHandler) HandleUserInfo(w http.ResponseWriter, r *http.Request) {
 	// Extract access token from Authorization header
 	authHeader := r.Header.Get(serverconst.AuthorizationHeaderName)
-	accessToken, err := utils.ExtractBearerToken(authHeader)
+	accessToken, scheme, err := dpop.ExtractAccessToken(authHeader)
 	if err != nil {
-		if authHeader == "" || !utils.IsBearerAuth(authHeader) {
+		if scheme == "" {
 			w.Header().Set(serverconst.WWWAuthenticateHeaderName, serverconst.TokenTypeBearer)
 			w.WriteHeader(http.StatusUnauthorized)
 		} else {
 			writeBearerError(w, constants.ErrorInvalidRequest,
-				"Invalid or malformed Bearer token", http.StatusBadRequest)
+				"Invalid or malformed "+scheme+" token", http.StatusBadRequest)
 		}
 		return
 	}
 
-	result, svcErr := h.service.GetUserInfo(r.Context(), accessToken)
+	// Access tokens presented with the DPoP scheme must come with a proof of possession of the bound key.
+	ctx := r.Context()
+	if scheme == constants.TokenTypeDPoP {
+		thumbprint, proofErr := h.dpopService.ValidateResourceRequestProof(ctx,
+			r.Header.Values(dpop.HeaderName), r.Method, h.endpointURL, accessToken)
+		if proofErr != nil {
+			dpop.WriteResourceProofError(w, proofErr)
+			return
+		}
+		ctx = dpop.WithThumbprint(ctx, thumbprint)
+	}
+
+	result, svcErr := h.service.GetUserInfo(ctx, accessToken)
 	if svcErr != nil {
 		h.writeServiceErrorResponse(w, svcErr)
 		return
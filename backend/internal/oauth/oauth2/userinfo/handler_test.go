@@ -44,7 +44,7 @@ func TestUserInfoHandlerTestSuite(t *testing.T) {
 
 func (s *UserInfoHandlerTestSuite) SetupTest() {
 	s.mockService = new(userInfoServiceInterfaceMock)
-	s.handler = newUserInfoHandler(s.mockService)
+	s.handler = newUserInfoHandler(s.mockService, nil, "")
 }
 
 // TestHandleUserInfo_MissingAuthorizationHeader tests missing Authorization header.
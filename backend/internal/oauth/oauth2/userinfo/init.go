@@ -20,13 +20,17 @@ package userinfo
 
 import (
 	"net/http"
+	"slices"
 
 	"github.com/thunder-id/thunderid/internal/attributecache"
 	"github.com/thunder-id/thunderid/internal/inboundclient"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/claimfallback"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/dpop"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/jwksresolver"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/tokenservice"
 	"github.com/thunder-id/thunderid/internal/ou"
+	"github.com/thunder-id/thunderid/internal/system/config"
 	"github.com/thunder-id/thunderid/internal/system/jose/jwe"
 	"github.com/thunder-id/thunderid/internal/system/jose/jwt"
 	"github.com/thunder-id/thunderid/internal/system/middleware"
@@ -43,11 +47,14 @@ func Initialize(
 	inboundClient inboundclient.InboundClientServiceInterface,
 	ouService ou.OrganizationUnitServiceInterface,
 	attributeCacheSvc attributecache.AttributeCacheServiceInterface,
+	claimFallback claimfallback.ClaimFallbackServiceInterface,
 	transactioner transaction.Transactioner,
+	dpopService dpop.DPoPServiceInterface,
 ) userInfoServiceInterface {
 	userInfoService := newUserInfoService(jwtService, jweService, resolver, tokenValidator,
-		inboundClient, ouService, attributeCacheSvc, transactioner)
-	userInfoHandler := newUserInfoHandler(userInfoService)
+		inboundClient, ouService, attributeCacheSvc, claimFallback, transactioner)
+	endpointURL := config.GetServerURL(&config.GetServerRuntime().Config.Server) + constants.OAuth2UserInfoEndpoint
+	userInfoHandler := newUserInfoHandler(userInfoService, dpopService, endpointURL)
 	registerRoutes(mux, userInfoHandler)
 	return userInfoService
 }
@@ -56,7 +63,7 @@ func Initialize(
 func registerRoutes(mux *http.ServeMux, userInfoHandler *userInfoHandler) {
 	opts := middleware.CORSOptions{
 		AllowedMethods:   []string{"GET", "POST", "OPTIONS"},
-		AllowedHeaders:   middleware.DefaultAllowedHeaders,
+		AllowedHeaders:   append(slices.Clone(middleware.DefaultAllowedHeaders), dpop.HeaderName),
 		AllowCredentials: true,
 		MaxAge:           600,
 	}
@@ -26,6 +26,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/suite"
 
+	"github.com/thunder-id/thunderid/internal/system/config"
 	"github.com/thunder-id/thunderid/tests/mocks/attributecachemock"
 	"github.com/thunder-id/thunderid/tests/mocks/inboundclientmock"
 	"github.com/thunder-id/thunderid/tests/mocks/jose/jwtmock"
@@ -54,6 +55,12 @@ func (suite *InitTestSuite) SetupTest() {
 	suite.mockOUService = oumock.NewOrganizationUnitServiceInterfaceMock(suite.T())
 	suite.mockAttributeCacheService = attributecachemock.NewAttributeCacheServiceInterfaceMock(suite.T())
 	suite.mockTransactioner = &MockTransactioner{}
+	config.ResetServerRuntime()
+	_ = config.InitializeServerRuntime("test-home", &config.Config{})
+}
+
+func (suite *InitTestSuite) TearDownTest() {
+	config.ResetServerRuntime()
 }
 
 func (suite *InitTestSuite) TestInitialize() {
@@ -61,7 +68,7 @@ func (suite *InitTestSuite) TestInitialize() {
 
 	service := Initialize(mux, suite.mockJWTService, nil, nil,
 		suite.mockTokenValidator, suite.mockInboundClient,
-		suite.mockOUService, suite.mockAttributeCacheService, suite.mockTransactioner)
+		suite.mockOUService, suite.mockAttributeCacheService, nil, suite.mockTransactioner, nil)
 
 	assert.NotNil(suite.T(), service)
 }
@@ -71,7 +78,7 @@ func (suite *InitTestSuite) TestInitialize_RegistersRoutes() {
 
 	Initialize(mux, suite.mockJWTService, nil, nil,
 		suite.mockTokenValidator, suite.mockInboundClient,
-		suite.mockOUService, suite.mockAttributeCacheService, suite.mockTransactioner)
+		suite.mockOUService, suite.mockAttributeCacheService, nil, suite.mockTransactioner, nil)
 
 	// Verify that the routes are registered by attempting to get a handler for them.
 	// The pattern includes the method because of CORS middleware wrapping.
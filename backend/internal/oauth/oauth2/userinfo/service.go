@@ -27,7 +27,9 @@ import (
 	"github.com/thunder-id/thunderid/internal/attributecache"
 	"github.com/thunder-id/thunderid/internal/inboundclient"
 	inboundmodel "github.com/thunder-id/thunderid/internal/inboundclient/model"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/claimfallback"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/dpop"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/jwksresolver"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/model"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/tokenservice"
@@ -57,6 +59,7 @@ type userInfoService struct {
 	inboundClient     inboundclient.InboundClientServiceInterface
 	ouService         ou.OrganizationUnitServiceInterface
 	attributeCacheSvc attributecache.AttributeCacheServiceInterface
+	claimFallback     claimfallback.ClaimFallbackServiceInterface
 	transactioner     transaction.Transactioner
 	logger            *log.Logger
 }
@@ -70,6 +73,7 @@ func newUserInfoService(
 	inboundClient inboundclient.InboundClientServiceInterface,
 	ouService ou.OrganizationUnitServiceInterface,
 	attributeCacheSvc attributecache.AttributeCacheServiceInterface,
+	claimFallback claimfallback.ClaimFallbackServiceInterface,
 	transactioner transaction.Transactioner,
 ) userInfoServiceInterface {
 	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, serviceLoggerComponentName))
@@ -81,6 +85,7 @@ func newUserInfoService(
 		inboundClient:     inboundClient,
 		ouService:         ouService,
 		attributeCacheSvc: attributeCacheSvc,
+		claimFallback:     claimFallback,
 		transactioner:     transactioner,
 		logger:            logger,
 	}
@@ -99,6 +104,10 @@ func (s *userInfoService) GetUserInfo(
 		s.logger.Debug("Failed to verify access token", log.Error(err))
 		return nil, &errorInvalidAccessToken
 	}
+	if err := dpop.VerifyTokenBinding(ctx, accessTokenClaims.JKT); err != nil {
+		s.logger.Debug("Access token presented without a valid proof of possession", log.Error(err))
+		return nil, &errorInvalidAccessToken
+	}
 	tokenClaims := accessTokenClaims.Claims
 	sub := accessTokenClaims.Sub
 
@@ -126,13 +135,22 @@ func (s *userInfoService) GetUserInfo(
 		attributeCacheID = val
 	}
 
-	// Fetch user attributes with groups and default claims
+	// Fetch user attributes with groups and default claims, along with the attributes the claim fallback
+	// chains read. Only the allowed attributes are returned as claims.
+	fetchedAttributes := allowedUserAttributes
+	if s.claimFallback != nil && len(allowedUserAttributes) > 0 {
+		fetchedAttributes = append(slices.Clone(allowedUserAttributes),
+			s.claimFallback.GetSourceAttributes(oauthApp)...)
+	}
 	userAttributes, err := tokenservice.FetchUserAttributes(ctx, s.attributeCacheSvc,
-		allowedUserAttributes, attributeCacheID)
+		fetchedAttributes, attributeCacheID)
 	if err != nil {
 		s.logger.Error("Failed to fetch user attributes", log.MaskedString(log.LoggerKeyUserID, sub), log.Error(err))
 		return nil, &serviceerror.InternalServerError
 	}
+	if s.claimFallback != nil {
+		userAttributes = s.claimFallback.ApplyFallbacks(ctx, oauthApp, sub, userAttributes)
+	}
 
 	response, svcErr := s.buildUserInfoResponse(sub, scopes, userAttributes, oauthApp, tokenClaims)
 	if svcErr != nil {
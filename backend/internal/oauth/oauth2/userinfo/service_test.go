@@ -37,6 +37,7 @@ import (
 	"github.com/thunder-id/thunderid/internal/attributecache"
 	inboundmodel "github.com/thunder-id/thunderid/internal/inboundclient/model"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/dpop"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/tokenservice"
 	"github.com/thunder-id/thunderid/internal/system/config"
 	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
@@ -44,6 +45,7 @@ import (
 	"github.com/thunder-id/thunderid/tests/mocks/attributecachemock"
 	"github.com/thunder-id/thunderid/tests/mocks/inboundclientmock"
 	"github.com/thunder-id/thunderid/tests/mocks/jose/jwtmock"
+	"github.com/thunder-id/thunderid/tests/mocks/oauth/oauth2/claimfallbackmock"
 	"github.com/thunder-id/thunderid/tests/mocks/oauth/oauth2/tokenservicemock"
 	"github.com/thunder-id/thunderid/tests/mocks/oumock"
 )
@@ -81,7 +83,7 @@ func (s *UserInfoServiceTestSuite) SetupTest() {
 	s.userInfoService = newUserInfoService(
 		s.mockJWTService, nil, nil, s.mockTokenValidator,
 		s.mockInboundClient, s.mockOUService,
-		s.mockAttributeCacheService, s.mockTransactioner)
+		s.mockAttributeCacheService, nil, s.mockTransactioner)
 
 	// Initialize server runtime for tests
 	config.ResetServerRuntime()
@@ -303,6 +305,48 @@ func (s *UserInfoServiceTestSuite) TestGetUserInfo_Success_StandardScopes() {
 	s.mockInboundClient.AssertExpectations(s.T())
 }
 
+// TestGetUserInfo_Success_WithClaimFallbacks tests that the claim fallbacks read the source attributes
+// while only the allowed attributes are returned.
+func (s *UserInfoServiceTestSuite) TestGetUserInfo_Success_WithClaimFallbacks() {
+	claims := map[string]interface{}{
+		"exp":       float64(time.Now().Add(time.Hour).Unix()),
+		"nbf":       float64(time.Now().Add(-time.Minute).Unix()),
+		"sub":       "user123",
+		"scope":     "openid profile",
+		"client_id": "client123",
+		"aci":       "cache-fallback-123",
+	}
+	token := s.createToken(claims)
+
+	oauthApp := &inboundmodel.OAuthClient{
+		UserInfo: &inboundmodel.UserInfoConfig{
+			UserAttributes: []string{"name", "picture"},
+		},
+	}
+	mockClaimFallback := claimfallbackmock.NewClaimFallbackServiceInterfaceMock(s.T())
+	s.userInfoService.(*userInfoService).claimFallback = mockClaimFallback
+
+	s.mockTokenValidator.On("ValidateAccessToken", token).Return(
+		&tokenservice.AccessTokenClaims{Sub: "user123", Claims: claims}, nil)
+	s.mockAttributeCacheService.On("GetAttributeCache", mock.Anything, "cache-fallback-123").Return(
+		&attributecache.AttributeCache{ID: "cache-fallback-123", Attributes: map[string]interface{}{
+			"name": "John Doe", "photo": "https://cdn.example.com/john.png", "phone": "+15555550100",
+		}}, nil)
+	s.mockInboundClient.On("GetOAuthClientByClientID", mock.Anything, "client123").Return(oauthApp, nil)
+	mockClaimFallback.On("GetSourceAttributes", oauthApp).Return([]string{"photo"})
+	mockClaimFallback.On("ApplyFallbacks", mock.Anything, oauthApp, "user123", map[string]interface{}{
+		"name": "John Doe", "photo": "https://cdn.example.com/john.png",
+	}).Return(map[string]interface{}{
+		"name": "John Doe", "photo": "https://cdn.example.com/john.png", "picture": "https://cdn.example.com/john.png",
+	})
+
+	response, svcErr := s.userInfoService.GetUserInfo(context.Background(), token)
+	assert.Nil(s.T(), svcErr)
+	assert.Equal(s.T(), "https://cdn.example.com/john.png", response.JSONBody["picture"])
+	assert.NotContains(s.T(), response.JSONBody, "photo")
+	assert.NotContains(s.T(), response.JSONBody, "phone")
+}
+
 // TestGetUserInfo_Success_WithGroups tests successful response with groups
 func (s *UserInfoServiceTestSuite) TestGetUserInfo_Success_WithGroups() {
 	claims := map[string]interface{}{
@@ -806,6 +850,29 @@ func (s *UserInfoServiceTestSuite) TestGetUserInfo_GroupsWithEmptyGroups() {
 	s.mockInboundClient.AssertExpectations(s.T())
 }
 
+// TestGetUserInfo_DPoPBoundTokenWithoutProof tests that a DPoP-bound token presented without a proof is rejected
+func (s *UserInfoServiceTestSuite) TestGetUserInfo_DPoPBoundTokenWithoutProof() {
+	claims := map[string]interface{}{
+		"sub":   "user123",
+		"scope": "openid",
+		"cnf":   map[string]interface{}{"jkt": "jkt-1"},
+	}
+	token := s.createToken(claims)
+
+	s.mockTokenValidator.On("ValidateAccessToken", token).Return(
+		&tokenservice.AccessTokenClaims{Sub: "user123", JKT: "jkt-1", Claims: claims}, nil)
+
+	response, svcErr := s.userInfoService.GetUserInfo(context.Background(), token)
+	assert.Nil(s.T(), response)
+	assert.NotNil(s.T(), svcErr)
+	assert.Equal(s.T(), errorInvalidAccessToken.Code, svcErr.Code)
+
+	response, svcErr = s.userInfoService.GetUserInfo(dpop.WithThumbprint(context.Background(), "jkt-2"), token)
+	assert.Nil(s.T(), response)
+	assert.NotNil(s.T(), svcErr)
+	assert.Equal(s.T(), errorInvalidAccessToken.Code, svcErr.Code)
+}
+
 // TestGetUserInfo_ClientCredentialsGrant_Rejected tests that client_credentials grant is rejected
 func (s *UserInfoServiceTestSuite) TestGetUserInfo_ClientCredentialsGrant_Rejected() {
 	claims := map[string]interface{}{
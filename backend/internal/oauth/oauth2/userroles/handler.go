@@ -0,0 +1,121 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package userroles
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/dpop"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/roleclaims"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/tokenservice"
+	serverconst "github.com/thunder-id/thunderid/internal/system/constants"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/internal/system/utils"
+)
+
+const (
+	handlerLoggerComponentName = "UserRolesHandler"
+	errorInvalidToken          = "invalid_token"
+)
+
+// userRolesHandler handles requests for the roles of the subject of an access token.
+type userRolesHandler struct {
+	tokenValidator    tokenservice.TokenValidatorInterface
+	roleClaimsService roleclaims.RoleClaimsServiceInterface
+	dpopService       dpop.DPoPServiceInterface
+	// endpointURL is the URL of the roles endpoint that DPoP proofs must target.
+	endpointURL string
+	logger      *log.Logger
+}
+
+// newUserRolesHandler creates a new roles handler.
+func newUserRolesHandler(
+	tokenValidator tokenservice.TokenValidatorInterface,
+	roleClaimsService roleclaims.RoleClaimsServiceInterface,
+	dpopService dpop.DPoPServiceInterface,
+	endpointURL string,
+) *userRolesHandler {
+	return &userRolesHandler{
+		tokenValidator:    tokenValidator,
+		roleClaimsService: roleClaimsService,
+		dpopService:       dpopService,
+		endpointURL:       endpointURL,
+		logger:            log.GetLogger().With(log.String(log.LoggerKeyComponentName, handlerLoggerComponentName)),
+	}
+}
+
+// HandleGetRoles returns the current roles of the subject of the access token. Access tokens presented with
+// the DPoP scheme must come with a proof of possession of the bound key.
+func (h *userRolesHandler) HandleGetRoles(w http.ResponseWriter, r *http.Request) {
+	authHeader := r.Header.Get(serverconst.AuthorizationHeaderName)
+	accessToken, scheme, err := dpop.ExtractAccessToken(authHeader)
+	if err != nil {
+		if scheme == "" {
+			w.Header().Set(serverconst.WWWAuthenticateHeaderName, serverconst.TokenTypeBearer)
+			w.WriteHeader(http.StatusUnauthorized)
+		} else {
+			writeBearerError(w, constants.ErrorInvalidRequest,
+				"Invalid or malformed "+scheme+" token", http.StatusBadRequest)
+		}
+		return
+	}
+
+	ctx := r.Context()
+	if scheme == constants.TokenTypeDPoP {
+		thumbprint, proofErr := h.dpopService.ValidateResourceRequestProof(ctx,
+			r.Header.Values(dpop.HeaderName), r.Method, h.endpointURL, accessToken)
+		if proofErr != nil {
+			dpop.WriteResourceProofError(w, proofErr)
+			return
+		}
+		ctx = dpop.WithThumbprint(ctx, thumbprint)
+	}
+
+	claims, err := h.tokenValidator.ValidateAccessToken(accessToken)
+	if err == nil {
+		err = dpop.VerifyTokenBinding(ctx, claims.JKT)
+	}
+	if err != nil {
+		h.logger.Debug("Rejecting roles request with an invalid access token", log.Error(err))
+		writeBearerError(w, errorInvalidToken, "Invalid or expired access token", http.StatusUnauthorized)
+		return
+	}
+
+	roleClaims, err := h.roleClaimsService.ResolveRoleClaims(ctx, claims.Sub)
+	if err != nil {
+		h.logger.Error("Failed to resolve the roles of the token subject", log.Error(err))
+		utils.WriteJSONError(w, constants.ErrorServerError,
+			serviceerror.InternalServerError.Error.DefaultValue, http.StatusInternalServerError, nil)
+		return
+	}
+
+	w.Header().Set(serverconst.CacheControlHeaderName, serverconst.CacheControlNoStore)
+	w.Header().Set(serverconst.PragmaHeaderName, serverconst.PragmaNoCache)
+	utils.WriteSuccessResponse(w, http.StatusOK, rolesResponse{Subject: claims.Sub, Roles: roleClaims.Roles})
+}
+
+// writeBearerError writes a JSON error response with a WWW-Authenticate: Bearer header.
+func writeBearerError(w http.ResponseWriter, errorCode, errorDescription string, statusCode int) {
+	wwwAuth := fmt.Sprintf("Bearer error=%q, error_description=%q", errorCode, errorDescription)
+	utils.WriteJSONError(w, errorCode, errorDescription, statusCode,
+		[]map[string]string{{serverconst.WWWAuthenticateHeaderName: wwwAuth}})
+}
@@ -0,0 +1,159 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package userroles
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/dpop"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/roleclaims"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/tokenservice"
+	"github.com/thunder-id/thunderid/tests/mocks/oauth/oauth2/dpopmock"
+	"github.com/thunder-id/thunderid/tests/mocks/oauth/oauth2/roleclaimsmock"
+	"github.com/thunder-id/thunderid/tests/mocks/oauth/oauth2/tokenservicemock"
+)
+
+const testRolesEndpoint = "https://localhost:8090/oauth2/roles"
+
+type UserRolesHandlerTestSuite struct {
+	suite.Suite
+	mockValidator  *tokenservicemock.TokenValidatorInterfaceMock
+	mockRoleClaims *roleclaimsmock.RoleClaimsServiceInterfaceMock
+	mockDPoP       *dpopmock.DPoPServiceInterfaceMock
+	handler        *userRolesHandler
+}
+
+func TestUserRolesHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(UserRolesHandlerTestSuite))
+}
+
+func (s *UserRolesHandlerTestSuite) SetupTest() {
+	s.mockValidator = tokenservicemock.NewTokenValidatorInterfaceMock(s.T())
+	s.mockRoleClaims = roleclaimsmock.NewRoleClaimsServiceInterfaceMock(s.T())
+	s.mockDPoP = dpopmock.NewDPoPServiceInterfaceMock(s.T())
+	s.handler = newUserRolesHandler(s.mockValidator, s.mockRoleClaims, s.mockDPoP, testRolesEndpoint)
+}
+
+func (s *UserRolesHandlerTestSuite) newRequest(authHeader string) *http.Request {
+	req := httptest.NewRequest(http.MethodGet, constants.OAuth2RolesEndpoint, nil)
+	if authHeader != "" {
+		req.Header.Set("Authorization", authHeader)
+	}
+	return req
+}
+
+func (s *UserRolesHandlerTestSuite) TestHandleGetRoles_Success() {
+	s.mockValidator.On("ValidateAccessToken", "token-1").Return(&tokenservice.AccessTokenClaims{Sub: "user-1"}, nil)
+	s.mockRoleClaims.On("ResolveRoleClaims", mock.Anything, "user-1").
+		Return(&roleclaims.RoleClaims{Roles: []string{"admin", "viewer"}, Scopes: []string{}}, nil)
+	rr := httptest.NewRecorder()
+
+	s.handler.HandleGetRoles(rr, s.newRequest("Bearer token-1"))
+
+	s.Equal(http.StatusOK, rr.Code)
+	s.Equal("no-store", rr.Header().Get("Cache-Control"))
+	var body rolesResponse
+	s.NoError(json.Unmarshal(rr.Body.Bytes(), &body))
+	s.Equal(rolesResponse{Subject: "user-1", Roles: []string{"admin", "viewer"}}, body)
+}
+
+func (s *UserRolesHandlerTestSuite) TestHandleGetRoles_MissingAuthorizationHeader() {
+	rr := httptest.NewRecorder()
+
+	s.handler.HandleGetRoles(rr, s.newRequest(""))
+
+	s.Equal(http.StatusUnauthorized, rr.Code)
+	s.Equal("Bearer", rr.Header().Get("WWW-Authenticate"))
+}
+
+func (s *UserRolesHandlerTestSuite) TestHandleGetRoles_MalformedBearerToken() {
+	rr := httptest.NewRecorder()
+
+	s.handler.HandleGetRoles(rr, s.newRequest("Bearer "))
+
+	s.Equal(http.StatusBadRequest, rr.Code)
+	s.Contains(rr.Body.String(), constants.ErrorInvalidRequest)
+}
+
+func (s *UserRolesHandlerTestSuite) TestHandleGetRoles_InvalidToken() {
+	s.mockValidator.On("ValidateAccessToken", "token-1").Return(nil, errors.New("expired"))
+	rr := httptest.NewRecorder()
+
+	s.handler.HandleGetRoles(rr, s.newRequest("Bearer token-1"))
+
+	s.Equal(http.StatusUnauthorized, rr.Code)
+	s.Contains(rr.Header().Get("WWW-Authenticate"), errorInvalidToken)
+}
+
+func (s *UserRolesHandlerTestSuite) TestHandleGetRoles_ResolveFails() {
+	s.mockValidator.On("ValidateAccessToken", "token-1").Return(&tokenservice.AccessTokenClaims{Sub: "user-1"}, nil)
+	s.mockRoleClaims.On("ResolveRoleClaims", mock.Anything, "user-1").Return(nil, errors.New("store down"))
+	rr := httptest.NewRecorder()
+
+	s.handler.HandleGetRoles(rr, s.newRequest("Bearer token-1"))
+
+	s.Equal(http.StatusInternalServerError, rr.Code)
+	s.Contains(rr.Body.String(), constants.ErrorServerError)
+}
+
+func (s *UserRolesHandlerTestSuite) TestHandleGetRoles_DPoPBoundToken() {
+	s.mockDPoP.EXPECT().ValidateResourceRequestProof(mock.Anything, []string{"proof-jwt"}, http.MethodGet,
+		testRolesEndpoint, "token-1").Return("jkt-1", nil)
+	s.mockValidator.On("ValidateAccessToken", "token-1").
+		Return(&tokenservice.AccessTokenClaims{Sub: "user-1", JKT: "jkt-1"}, nil)
+	s.mockRoleClaims.On("ResolveRoleClaims", mock.Anything, "user-1").
+		Return(&roleclaims.RoleClaims{Roles: []string{"admin"}, Scopes: []string{}}, nil)
+	req := s.newRequest("DPoP token-1")
+	req.Header.Set(dpop.HeaderName, "proof-jwt")
+	rr := httptest.NewRecorder()
+
+	s.handler.HandleGetRoles(rr, req)
+
+	s.Equal(http.StatusOK, rr.Code)
+}
+
+func (s *UserRolesHandlerTestSuite) TestHandleGetRoles_DPoPBoundTokenAsBearer() {
+	s.mockValidator.On("ValidateAccessToken", "token-1").
+		Return(&tokenservice.AccessTokenClaims{Sub: "user-1", JKT: "jkt-1"}, nil)
+	rr := httptest.NewRecorder()
+
+	s.handler.HandleGetRoles(rr, s.newRequest("Bearer token-1"))
+
+	s.Equal(http.StatusUnauthorized, rr.Code)
+	s.Contains(rr.Header().Get("WWW-Authenticate"), errorInvalidToken)
+}
+
+func (s *UserRolesHandlerTestSuite) TestHandleGetRoles_InvalidDPoPProof() {
+	s.mockDPoP.EXPECT().ValidateResourceRequestProof(mock.Anything, mock.Anything, mock.Anything, mock.Anything,
+		mock.Anything).Return("", &dpop.ProofError{Code: constants.ErrorInvalidDPoPProof, Description: "bad proof"})
+	rr := httptest.NewRecorder()
+
+	s.handler.HandleGetRoles(rr, s.newRequest("DPoP token-1"))
+
+	s.Equal(http.StatusUnauthorized, rr.Code)
+	s.Contains(rr.Header().Get("WWW-Authenticate"), "DPoP error=\"invalid_dpop_proof\"")
+}
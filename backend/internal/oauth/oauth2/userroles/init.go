@@ -0,0 +1,62 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package userroles serves the roles of the subject of an access token. Access tokens whose roles claim
+// exceeds the configured size carry a roles_ref claim pointing to this endpoint instead.
+package userroles
+
+import (
+	"net/http"
+	"slices"
+
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/dpop"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/roleclaims"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/tokenservice"
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/middleware"
+)
+
+// Initialize initializes the roles handler and registers its routes.
+func Initialize(
+	mux *http.ServeMux,
+	tokenValidator tokenservice.TokenValidatorInterface,
+	roleClaimsService roleclaims.RoleClaimsServiceInterface,
+	dpopService dpop.DPoPServiceInterface,
+) {
+	endpointURL := config.GetServerURL(&config.GetServerRuntime().Config.Server) + constants.OAuth2RolesEndpoint
+	handler := newUserRolesHandler(tokenValidator, roleClaimsService, dpopService, endpointURL)
+	registerRoutes(mux, handler)
+}
+
+// registerRoutes registers the routes for the roles endpoint.
+func registerRoutes(mux *http.ServeMux, handler *userRolesHandler) {
+	opts := middleware.CORSOptions{
+		AllowedMethods:   []string{"GET", "OPTIONS"},
+		AllowedHeaders:   append(slices.Clone(middleware.DefaultAllowedHeaders), dpop.HeaderName),
+		AllowCredentials: true,
+		MaxAge:           600,
+	}
+
+	mux.HandleFunc(middleware.WithCORS("GET "+constants.OAuth2RolesEndpoint,
+		handler.HandleGetRoles, opts))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS "+constants.OAuth2RolesEndpoint,
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, opts))
+}
@@ -25,7 +25,9 @@ import (
 	"encoding/json"
 	"fmt"
 	"regexp"
+	"strings"
 
+	inboundmodel "github.com/thunder-id/thunderid/internal/inboundclient/model"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/model"
 	"github.com/thunder-id/thunderid/internal/system/utils"
@@ -152,6 +154,18 @@ func SeparateOIDCAndNonOIDCScopes(scopes string, scopeClaimsMapping map[string][
 	return oidcScopes, nonOidcScopes
 }
 
+// SeparateAllowedScopes restricts the given scopes to the scopes allowed for the client and separates
+// the remainder into OIDC and non-OIDC scopes. Returns false when the request must be rejected because
+// it asks for a scope the client is not allowed to request.
+func SeparateAllowedScopes(scopes string, app *inboundmodel.OAuthClient) ([]string, []string, bool) {
+	allowedScopes, ok := app.ApplyAllowedScopes(utils.ParseStringArray(scopes, " "))
+	if !ok {
+		return nil, nil, false
+	}
+	oidcScopes, nonOidcScopes := SeparateOIDCAndNonOIDCScopes(strings.Join(allowedScopes, " "), app.ScopeClaims)
+	return oidcScopes, nonOidcScopes, true
+}
+
 // ParseClaimsRequest parses the claims parameter JSON string into a ClaimsRequest struct.
 // Returns nil if the input is empty.
 // Returns an error if the JSON is malformed or violates OIDC spec constraints.
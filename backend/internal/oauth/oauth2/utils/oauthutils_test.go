@@ -28,8 +28,10 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/suite"
 
+	inboundmodel "github.com/thunder-id/thunderid/internal/inboundclient/model"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
 	"github.com/thunder-id/thunderid/internal/oauth/oauth2/model"
+	"github.com/thunder-id/thunderid/internal/system/config"
 	sysutils "github.com/thunder-id/thunderid/internal/system/utils"
 )
 
@@ -699,6 +701,19 @@ func (suite *OAuth2UtilsTestSuite) TestSeparateOIDCAndNonOIDCScopes() {
 	}
 }
 
+func (suite *OAuth2UtilsTestSuite) TestSeparateAllowedScopes() {
+	config.ResetServerRuntime()
+	suite.Require().NoError(config.InitializeServerRuntime("/tmp/test", &config.Config{}))
+	defer config.ResetServerRuntime()
+	app := &inboundmodel.OAuthClient{AllowedScopes: []string{"openid", "read"}}
+
+	oidcScopes, nonOidcScopes, ok := SeparateAllowedScopes("openid profile read write", app)
+
+	suite.True(ok)
+	suite.Equal([]string{"openid"}, oidcScopes)
+	suite.Equal([]string{"read"}, nonOidcScopes)
+}
+
 func (suite *OAuth2UtilsTestSuite) TestSeparateOIDCAndNonOIDCScopes_StandardOIDCScopes() {
 	// Test all standard OIDC scopes are correctly identified
 	// Based on constants.StandardOIDCScopes, these are the standard scopes
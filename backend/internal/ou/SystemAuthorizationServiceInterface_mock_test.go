@@ -94,3 +94,17 @@ func (_m *systemAuthorizationServiceMock) GetAccessibleResources(
 func (_m *systemAuthorizationServiceMock) SetOUHierarchyResolver(resolver sysauthz.OUHierarchyResolver) {
 	_m.Called(resolver)
 }
+
+// SetRelationshipProvider provides a mock function for the type systemAuthorizationServiceMock.
+func (_m *systemAuthorizationServiceMock) SetRelationshipProvider(provider sysauthz.RelationshipProvider) {
+	_m.Called(provider)
+}
+
+// DeleteResourceRelationships provides a mock function for the type systemAuthorizationServiceMock.
+func (_m *systemAuthorizationServiceMock) DeleteResourceRelationships(
+	ctx context.Context,
+	resourceType security.ResourceType,
+	resourceID string,
+) {
+	_m.Called(ctx, resourceType, resourceID)
+}
@@ -232,6 +232,14 @@ func validateOUWrapper(data interface{}, fileStore *fileBasedStore, dbStore orga
 		return fmt.Errorf("organization unit handle is required")
 	}
 
+	if svcErr := validateOULocalization(ou.Locale, ou.Zoneinfo); svcErr != nil {
+		return fmt.Errorf("organization unit '%s': %s", ou.ID, svcErr.ErrorDescription.DefaultValue)
+	}
+
+	if svcErr := validateOUDefaultUserType(ou.DefaultUserType); svcErr != nil {
+		return fmt.Errorf("organization unit '%s': %s", ou.ID, svcErr.ErrorDescription.DefaultValue)
+	}
+
 	// Check for duplicate ID in the file store
 	if existingData, err := fileStore.GenericFileBasedStore.Get(ou.ID); err == nil && existingData != nil {
 		return fmt.Errorf("duplicate organization unit ID '%s': "+
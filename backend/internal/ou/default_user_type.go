@@ -0,0 +1,72 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package ou
+
+import (
+	"context"
+
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+)
+
+// DefaultUserTypeResolution holds the default user type resolved for an organization unit.
+type DefaultUserTypeResolution struct {
+	// UserType is the default user type of the nearest organization unit in the parent chain that defines
+	// one, or empty when none does.
+	UserType string
+	// SourceOUID is the ID of the organization unit that defines UserType.
+	SourceOUID string
+	// Lineage lists the IDs of the organization units visited, starting with the given one. It holds the
+	// whole parent chain when no organization unit defines a default user type.
+	Lineage []string
+}
+
+// ResolveDefaultUserType resolves the default user type of an organization unit. The default is inherited
+// from the nearest organization unit in the parent chain of ouID that defines one.
+func ResolveDefaultUserType(
+	ctx context.Context, ouService OrganizationUnitServiceInterface, ouID string,
+) (DefaultUserTypeResolution, *serviceerror.ServiceError) {
+	resolution := DefaultUserTypeResolution{}
+
+	current := ouID
+	visited := make(map[string]struct{})
+	for current != "" {
+		if _, ok := visited[current]; ok {
+			break
+		}
+		visited[current] = struct{}{}
+
+		orgUnit, svcErr := ouService.GetOrganizationUnit(ctx, current)
+		if svcErr != nil {
+			return DefaultUserTypeResolution{}, svcErr
+		}
+		resolution.Lineage = append(resolution.Lineage, orgUnit.ID)
+		if orgUnit.DefaultUserType != "" {
+			resolution.UserType = orgUnit.DefaultUserType
+			resolution.SourceOUID = orgUnit.ID
+			break
+		}
+
+		if orgUnit.Parent == nil {
+			break
+		}
+		current = *orgUnit.Parent
+	}
+
+	return resolution, nil
+}
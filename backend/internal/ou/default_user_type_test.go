@@ -0,0 +1,101 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package ou
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolveDefaultUserType(t *testing.T) {
+	ctx := context.Background()
+	parentID := "parent"
+	rootID := "root"
+	childID := "child"
+
+	t.Run("nearest organization unit wins", func(t *testing.T) {
+		ouService := NewOrganizationUnitServiceInterfaceMock(t)
+		ouService.On("GetOrganizationUnit", mock.Anything, "child").
+			Return(OrganizationUnit{ID: "child", Parent: &parentID}, nil).Once()
+		ouService.On("GetOrganizationUnit", mock.Anything, parentID).
+			Return(OrganizationUnit{ID: parentID, Parent: &rootID, DefaultUserType: "employee"}, nil).Once()
+
+		resolution, svcErr := ResolveDefaultUserType(ctx, ouService, "child")
+
+		require.Nil(t, svcErr)
+		require.Equal(t, DefaultUserTypeResolution{
+			UserType: "employee", SourceOUID: parentID, Lineage: []string{"child", parentID},
+		}, resolution)
+	})
+
+	t.Run("no default in the chain returns the lineage", func(t *testing.T) {
+		ouService := NewOrganizationUnitServiceInterfaceMock(t)
+		ouService.On("GetOrganizationUnit", mock.Anything, "child").
+			Return(OrganizationUnit{ID: "child", Parent: &rootID}, nil).Once()
+		ouService.On("GetOrganizationUnit", mock.Anything, rootID).
+			Return(OrganizationUnit{ID: rootID}, nil).Once()
+
+		resolution, svcErr := ResolveDefaultUserType(ctx, ouService, "child")
+
+		require.Nil(t, svcErr)
+		require.Empty(t, resolution.UserType)
+		require.Equal(t, []string{"child", rootID}, resolution.Lineage)
+	})
+
+	t.Run("cyclic chain terminates", func(t *testing.T) {
+		ouService := NewOrganizationUnitServiceInterfaceMock(t)
+		ouService.On("GetOrganizationUnit", mock.Anything, "child").
+			Return(OrganizationUnit{ID: "child", Parent: &parentID}, nil).Once()
+		ouService.On("GetOrganizationUnit", mock.Anything, parentID).
+			Return(OrganizationUnit{ID: parentID, Parent: &childID}, nil).Once()
+
+		resolution, svcErr := ResolveDefaultUserType(ctx, ouService, "child")
+
+		require.Nil(t, svcErr)
+		require.Equal(t, []string{"child", parentID}, resolution.Lineage)
+	})
+
+	t.Run("service error is returned", func(t *testing.T) {
+		ouService := NewOrganizationUnitServiceInterfaceMock(t)
+		ouService.On("GetOrganizationUnit", mock.Anything, "child").
+			Return(OrganizationUnit{}, &ErrorOrganizationUnitNotFound).Once()
+
+		_, svcErr := ResolveDefaultUserType(ctx, ouService, "child")
+
+		require.Equal(t, &ErrorOrganizationUnitNotFound, svcErr)
+	})
+
+	t.Run("empty organization unit resolves nothing", func(t *testing.T) {
+		resolution, svcErr := ResolveDefaultUserType(ctx, NewOrganizationUnitServiceInterfaceMock(t), "")
+
+		require.Nil(t, svcErr)
+		require.Equal(t, DefaultUserTypeResolution{}, resolution)
+	})
+}
+
+func TestValidateOUDefaultUserType(t *testing.T) {
+	require.Nil(t, validateOUDefaultUserType(""))
+	require.Nil(t, validateOUDefaultUserType("employee"))
+	for _, value := range []string{" employee", "employee ", " "} {
+		require.Equal(t, &ErrorInvalidDefaultUserType, validateOUDefaultUserType(value), value)
+	}
+}
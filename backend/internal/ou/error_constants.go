@@ -210,6 +210,45 @@ var (
 			DefaultValue: "The filter parameter is invalid. Use format: attribute (eq|gt|lt) \"value\"",
 		},
 	}
+	// ErrorInvalidLocale is the error returned when the locale is not a canonical BCP 47 language tag.
+	ErrorInvalidLocale = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "OU-1015",
+		Error: core.I18nMessage{
+			Key:          "error.ouservice.invalid_locale",
+			DefaultValue: "Invalid locale",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.ouservice.invalid_locale_description",
+			DefaultValue: "The locale must be a canonical BCP 47 language tag (e.g., 'en', 'fr-CA')",
+		},
+	}
+	// ErrorInvalidZoneinfo is the error returned when the zoneinfo is not an IANA time zone name.
+	ErrorInvalidZoneinfo = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "OU-1016",
+		Error: core.I18nMessage{
+			Key:          "error.ouservice.invalid_zoneinfo",
+			DefaultValue: "Invalid zoneinfo",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.ouservice.invalid_zoneinfo_description",
+			DefaultValue: "The zoneinfo must be an IANA time zone name (e.g., 'Europe/Paris')",
+		},
+	}
+	// ErrorInvalidDefaultUserType is the error returned when the default user type is not a valid user type name.
+	ErrorInvalidDefaultUserType = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "OU-1017",
+		Error: core.I18nMessage{
+			Key:          "error.ouservice.invalid_default_user_type",
+			DefaultValue: "Invalid default user type",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.ouservice.invalid_default_user_type_description",
+			DefaultValue: "The default user type must be a user type name without leading or trailing spaces",
+		},
+	}
 )
 
 // Error variables
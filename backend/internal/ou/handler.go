@@ -20,10 +20,7 @@ package ou
 
 import (
 	"net/http"
-	"net/url"
-	"strconv"
 
-	serverconst "github.com/thunder-id/thunderid/internal/system/constants"
 	"github.com/thunder-id/thunderid/internal/system/error/apierror"
 	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
 	"github.com/thunder-id/thunderid/internal/system/filter"
@@ -50,15 +47,12 @@ func (ouh *organizationUnitHandler) HandleOUListRequest(w http.ResponseWriter, r
 	ctx := r.Context()
 	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName))
 
-	limit, offset, svcErr := parsePaginationParams(r.URL.Query())
+	pagination, svcErr := sysutils.ParsePaginationParams(r.URL.Query(), &ErrorInvalidLimit, &ErrorInvalidOffset)
 	if svcErr != nil {
 		ouh.handleError(w, svcErr)
 		return
 	}
-
-	if limit == 0 {
-		limit = serverconst.DefaultPageSize
-	}
+	limit, offset := pagination.Limit, pagination.Offset
 
 	f, err := filter.ParseFilterParam(r.URL.Query())
 	if err != nil {
@@ -66,7 +60,8 @@ func (ouh *organizationUnitHandler) HandleOUListRequest(w http.ResponseWriter, r
 		return
 	}
 
-	ouListResponse, svcErr := ouh.service.GetOrganizationUnitList(ctx, limit, offset, f)
+	ouListResponse, svcErr := ouh.service.GetOrganizationUnitList(
+		sysutils.WithSkipCount(ctx, pagination.SkipCount), limit, offset, f)
 	if svcErr != nil {
 		ouh.handleError(w, svcErr)
 		return
@@ -253,6 +248,9 @@ func (ouh *organizationUnitHandler) sanitizeOrganizationUnitRequest(
 		TosURI:          request.TosURI,
 		PolicyURI:       request.PolicyURI,
 		CookiePolicyURI: request.CookiePolicyURI,
+		Locale:          request.Locale,
+		Zoneinfo:        request.Zoneinfo,
+		DefaultUserType: request.DefaultUserType,
 	}
 }
 
@@ -286,30 +284,6 @@ func validateUpdateRequest(
 	return sanitizedRequest, false
 }
 
-// parsePaginationParams parses limit and offset query parameters from the request.
-func parsePaginationParams(query url.Values) (int, int, *serviceerror.ServiceError) {
-	limit := 0
-	offset := 0
-
-	if limitStr := query.Get("limit"); limitStr != "" {
-		if parsedLimit, err := strconv.Atoi(limitStr); err != nil {
-			return 0, 0, &ErrorInvalidLimit
-		} else {
-			limit = parsedLimit
-		}
-	}
-
-	if offsetStr := query.Get("offset"); offsetStr != "" {
-		if parsedOffset, err := strconv.Atoi(offsetStr); err != nil {
-			return 0, 0, &ErrorInvalidOffset
-		} else {
-			offset = parsedOffset
-		}
-	}
-
-	return limit, offset, nil
-}
-
 // handleResourceListRequest is a generic handler for listing resources under an organization unit.
 func (ouh *organizationUnitHandler) handleResourceListRequest(
 	w http.ResponseWriter, r *http.Request, resourceType string,
@@ -322,15 +296,12 @@ func (ouh *organizationUnitHandler) handleResourceListRequest(
 		return
 	}
 
-	limit, offset, svcErr := parsePaginationParams(r.URL.Query())
+	pagination, svcErr := sysutils.ParsePaginationParams(r.URL.Query(), &ErrorInvalidLimit, &ErrorInvalidOffset)
 	if svcErr != nil {
 		ouh.handleError(w, svcErr)
 		return
 	}
-
-	if limit == 0 {
-		limit = serverconst.DefaultPageSize
-	}
+	limit, offset := pagination.Limit, pagination.Offset
 
 	response, svcErr := serviceFunc(id, limit, offset)
 	if svcErr != nil {
@@ -439,15 +410,12 @@ func (ouh *organizationUnitHandler) handleResourceListByPathRequest(
 		return
 	}
 
-	limit, offset, svcErr := parsePaginationParams(r.URL.Query())
+	pagination, svcErr := sysutils.ParsePaginationParams(r.URL.Query(), &ErrorInvalidLimit, &ErrorInvalidOffset)
 	if svcErr != nil {
 		ouh.handleError(w, svcErr)
 		return
 	}
-
-	if limit == 0 {
-		limit = serverconst.DefaultPageSize
-	}
+	limit, offset := pagination.Limit, pagination.Offset
 
 	response, svcErr := serviceFunc(path, limit, offset)
 	if svcErr != nil {
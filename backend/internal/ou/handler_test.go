@@ -210,6 +210,18 @@ func (suite *OrganizationUnitHandlerTestSuite) TestOUHandler_RegisterRoutes() {
 			},
 			wantStatus: http.StatusOK,
 		},
+		{
+			name:   "by-handle dispatch",
+			method: http.MethodGet,
+			path:   "/organization-units/by-handle/root/child",
+			setup: func(serviceMock *OrganizationUnitServiceInterfaceMock) {
+				serviceMock.
+					On("GetOrganizationUnitByPath", mock.Anything, "root/child").
+					Return(OrganizationUnit{ID: "ou-child"}, nil).
+					Once()
+			},
+			wantStatus: http.StatusOK,
+		},
 		{
 			name:       "unknown subresource",
 			method:     http.MethodGet,
@@ -228,6 +240,12 @@ func (suite *OrganizationUnitHandlerTestSuite) TestOUHandler_RegisterRoutes() {
 			path:       "/organization-units/ou-123",
 			wantStatus: http.StatusNoContent,
 		},
+		{
+			name:       "by-handle options route",
+			method:     http.MethodOptions,
+			path:       "/organization-units/by-handle/root",
+			wantStatus: http.StatusNoContent,
+		},
 		{
 			name:       "tree options route",
 			method:     http.MethodOptions,
@@ -211,6 +211,10 @@ func registerRoutes(mux *http.ServeMux, ouHandler *organizationUnitHandler) {
 			r.URL.Path = newPath
 			ouHandler.HandleOUGetByPathRequest(w, r)
 		}, corsOptions2))
+	// The hierarchical handle path is the stable identifier of an organization unit, so the by-handle
+	// lookup resolves it in the same way as the tree lookup.
+	mux.HandleFunc(middleware.WithCORS("GET /organization-units/by-handle/{path...}",
+		ouHandler.HandleOUGetByPathRequest, corsOptions2))
 	mux.HandleFunc(middleware.WithCORS("PUT /organization-units/tree/{path...}",
 		ouHandler.HandleOUPutByPathRequest, corsOptions2))
 	mux.HandleFunc(middleware.WithCORS("DELETE /organization-units/tree/{path...}",
@@ -0,0 +1,78 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package ou
+
+import (
+	"context"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+)
+
+// Localization holds the preferred language and time zone resolved for a user.
+type Localization struct {
+	Locale   string
+	Zoneinfo string
+}
+
+// ResolveLocalization completes the given user preferences with the defaults of the user's organization unit.
+//
+// Each value is resolved independently in the order user → nearest organization unit in the parent chain
+// of ouID that defines it → deployment default. An empty ouID or a nil ouService skips the organization
+// unit step.
+func ResolveLocalization(
+	ctx context.Context, ouService OrganizationUnitServiceInterface, ouID string, user Localization,
+) (Localization, *serviceerror.ServiceError) {
+	resolved := user
+
+	current := ouID
+	visited := make(map[string]struct{})
+	for ouService != nil && current != "" && (resolved.Locale == "" || resolved.Zoneinfo == "") {
+		if _, ok := visited[current]; ok {
+			break
+		}
+		visited[current] = struct{}{}
+
+		orgUnit, svcErr := ouService.GetOrganizationUnit(ctx, current)
+		if svcErr != nil {
+			return Localization{}, svcErr
+		}
+		if resolved.Locale == "" {
+			resolved.Locale = orgUnit.Locale
+		}
+		if resolved.Zoneinfo == "" {
+			resolved.Zoneinfo = orgUnit.Zoneinfo
+		}
+
+		if orgUnit.Parent == nil {
+			break
+		}
+		current = *orgUnit.Parent
+	}
+
+	defaults := config.GetServerRuntime().Config.Localization
+	if resolved.Locale == "" {
+		resolved.Locale = defaults.DefaultLocale
+	}
+	if resolved.Zoneinfo == "" {
+		resolved.Zoneinfo = defaults.DefaultZoneinfo
+	}
+
+	return resolved, nil
+}
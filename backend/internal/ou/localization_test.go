@@ -0,0 +1,84 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package ou
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+)
+
+func initLocalizationTestRuntime(t *testing.T) {
+	config.ResetServerRuntime()
+	testConfig := &config.Config{
+		Localization: config.LocalizationConfig{DefaultLocale: "en-US", DefaultZoneinfo: "UTC"},
+	}
+	require.NoError(t, config.InitializeServerRuntime("/tmp/test", testConfig))
+	t.Cleanup(config.ResetServerRuntime)
+}
+
+func TestResolveLocalization(t *testing.T) {
+	initLocalizationTestRuntime(t)
+	ctx := context.Background()
+	parentID := "parent"
+
+	t.Run("user preferences take precedence", func(t *testing.T) {
+		ouService := NewOrganizationUnitServiceInterfaceMock(t)
+
+		resolved, svcErr := ResolveLocalization(ctx, ouService, "child",
+			Localization{Locale: "de", Zoneinfo: "Europe/Berlin"})
+
+		require.Nil(t, svcErr)
+		require.Equal(t, Localization{Locale: "de", Zoneinfo: "Europe/Berlin"}, resolved)
+	})
+
+	t.Run("organization unit chain then deployment default", func(t *testing.T) {
+		ouService := NewOrganizationUnitServiceInterfaceMock(t)
+		ouService.On("GetOrganizationUnit", mock.Anything, "child").
+			Return(OrganizationUnit{ID: "child", Parent: &parentID}, nil).Once()
+		ouService.On("GetOrganizationUnit", mock.Anything, parentID).
+			Return(OrganizationUnit{ID: parentID, Locale: "fr-CA"}, nil).Once()
+
+		resolved, svcErr := ResolveLocalization(ctx, ouService, "child", Localization{})
+
+		require.Nil(t, svcErr)
+		require.Equal(t, Localization{Locale: "fr-CA", Zoneinfo: "UTC"}, resolved)
+	})
+
+	t.Run("no organization unit", func(t *testing.T) {
+		resolved, svcErr := ResolveLocalization(ctx, nil, "", Localization{Zoneinfo: "Asia/Tokyo"})
+
+		require.Nil(t, svcErr)
+		require.Equal(t, Localization{Locale: "en-US", Zoneinfo: "Asia/Tokyo"}, resolved)
+	})
+
+	t.Run("organization unit lookup error", func(t *testing.T) {
+		ouService := NewOrganizationUnitServiceInterfaceMock(t)
+		ouService.On("GetOrganizationUnit", mock.Anything, "child").
+			Return(OrganizationUnit{}, &ErrorOrganizationUnitNotFound).Once()
+
+		_, svcErr := ResolveLocalization(ctx, ouService, "child", Localization{})
+
+		require.Equal(t, &ErrorOrganizationUnitNotFound, svcErr)
+	})
+}
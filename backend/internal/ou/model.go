@@ -50,6 +50,9 @@ type OrganizationUnit struct {
 	TosURI          string    `json:"tosUri,omitempty" yaml:"tos_uri,omitempty"`
 	PolicyURI       string    `json:"policyUri,omitempty" yaml:"policy_uri,omitempty"`
 	CookiePolicyURI string    `json:"cookiePolicyUri,omitempty" yaml:"cookie_policy_uri,omitempty"`
+	Locale          string    `json:"locale,omitempty" yaml:"locale,omitempty"`
+	Zoneinfo        string    `json:"zoneinfo,omitempty" yaml:"zoneinfo,omitempty"`
+	DefaultUserType string    `json:"defaultUserType,omitempty" yaml:"default_user_type,omitempty"`
 	CreatedAt       time.Time `json:"createdAt" yaml:"created_at"`
 	UpdatedAt       time.Time `json:"updatedAt" yaml:"updated_at"`
 }
@@ -66,6 +69,9 @@ type OrganizationUnitRequest struct {
 	TosURI          string  `json:"tosUri,omitempty"`
 	PolicyURI       string  `json:"policyUri,omitempty"`
 	CookiePolicyURI string  `json:"cookiePolicyUri,omitempty"`
+	Locale          string  `json:"locale,omitempty"`
+	Zoneinfo        string  `json:"zoneinfo,omitempty"`
+	DefaultUserType string  `json:"defaultUserType,omitempty"`
 }
 
 // OrganizationUnitRequestWithID represents the request body for creating an organization unit
@@ -82,6 +88,9 @@ type OrganizationUnitRequestWithID struct {
 	TosURI          string  `json:"tosUri,omitempty" yaml:"tos_uri,omitempty"`
 	PolicyURI       string  `json:"policyUri,omitempty" yaml:"policy_uri,omitempty"`
 	CookiePolicyURI string  `json:"cookiePolicyUri,omitempty" yaml:"cookie_policy_uri,omitempty"`
+	Locale          string  `json:"locale,omitempty" yaml:"locale,omitempty"`
+	Zoneinfo        string  `json:"zoneinfo,omitempty" yaml:"zoneinfo,omitempty"`
+	DefaultUserType string  `json:"defaultUserType,omitempty" yaml:"default_user_type,omitempty"`
 }
 
 // OrganizationUnitListResponse represents the response for listing organization units with pagination.
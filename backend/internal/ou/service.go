@@ -161,13 +161,19 @@ func (ous *organizationUnitService) listAllOrganizationUnits(
 	ctx context.Context, limit, offset int, f *filter.FilterGroup,
 ) (*OrganizationUnitListResponse, *serviceerror.ServiceError) {
 	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentNameService))
-	totalCount, err := ous.ouStore.GetOrganizationUnitListCount(ctx, f)
-	if err != nil {
-		logger.Error("Failed to get organization unit count", log.Error(err))
-		return nil, &serviceerror.InternalServerError
+	skipCount := utils.IsCountSkipped(ctx)
+	totalCount, fetchLimit := utils.TotalCountUnknown, limit+1
+	if !skipCount {
+		var err error
+		totalCount, err = ous.ouStore.GetOrganizationUnitListCount(ctx, f)
+		if err != nil {
+			logger.Error("Failed to get organization unit count", log.Error(err))
+			return nil, &serviceerror.InternalServerError
+		}
+		fetchLimit = limit
 	}
 
-	ouList, err := ous.ouStore.GetOrganizationUnitList(ctx, limit, offset, f)
+	ouList, err := ous.ouStore.GetOrganizationUnitList(ctx, fetchLimit, offset, f)
 	if err != nil {
 		// Check if it's a limit exceeded error
 		if errors.Is(err, ErrResultLimitExceededInCompositeMode) {
@@ -176,13 +182,15 @@ func (ous *organizationUnitService) listAllOrganizationUnits(
 		logger.Error("Failed to list organization units", log.Error(err))
 		return nil, &serviceerror.InternalServerError
 	}
+	ouList, hasMore := utils.TrimPage(ouList, limit)
 
 	return &OrganizationUnitListResponse{
 		TotalResults:      totalCount,
 		OrganizationUnits: ouList,
 		StartIndex:        offset + 1,
 		Count:             len(ouList),
-		Links:             utils.BuildPaginationLinks("/organization-units", limit, offset, totalCount, ""),
+		Links: utils.BuildListPaginationLinks(
+			"/organization-units", limit, offset, totalCount, hasMore, ""),
 	}, nil
 }
 
@@ -303,6 +311,16 @@ func (ous *organizationUnitService) CreateOrganizationUnit(
 			return errors.New("validation error")
 		}
 
+		if svcErr := validateOULocalization(request.Locale, request.Zoneinfo); svcErr != nil {
+			capturedSvcErr = svcErr
+			return errors.New("validation error")
+		}
+
+		if svcErr := validateOUDefaultUserType(request.DefaultUserType); svcErr != nil {
+			capturedSvcErr = svcErr
+			return errors.New("validation error")
+		}
+
 		if request.Parent != nil {
 			if svcErr := ous.checkOUAccess(txCtx, security.ActionCreateOU, *request.Parent); svcErr != nil {
 				capturedSvcErr = svcErr
@@ -362,6 +380,9 @@ func (ous *organizationUnitService) CreateOrganizationUnit(
 			TosURI:          request.TosURI,
 			PolicyURI:       request.PolicyURI,
 			CookiePolicyURI: request.CookiePolicyURI,
+			Locale:          request.Locale,
+			Zoneinfo:        request.Zoneinfo,
+			DefaultUserType: request.DefaultUserType,
 			CreatedAt:       now,
 			UpdatedAt:       now,
 		}
@@ -609,6 +630,14 @@ func (ous *organizationUnitService) updateOUInternal(
 		return OrganizationUnit{}, err
 	}
 
+	if err := validateOULocalization(request.Locale, request.Zoneinfo); err != nil {
+		return OrganizationUnit{}, err
+	}
+
+	if err := validateOUDefaultUserType(request.DefaultUserType); err != nil {
+		return OrganizationUnit{}, err
+	}
+
 	if request.Parent != nil {
 		exists, err := ous.ouStore.IsOrganizationUnitExists(ctx, *request.Parent)
 		if err != nil {
@@ -665,6 +694,9 @@ func (ous *organizationUnitService) updateOUInternal(
 		TosURI:          request.TosURI,
 		PolicyURI:       request.PolicyURI,
 		CookiePolicyURI: request.CookiePolicyURI,
+		Locale:          request.Locale,
+		Zoneinfo:        request.Zoneinfo,
+		DefaultUserType: request.DefaultUserType,
 		CreatedAt:       existingOU.CreatedAt,
 		UpdatedAt:       time.Now().UTC(),
 	}
@@ -1060,6 +1092,26 @@ func (ous *organizationUnitService) validateOUName(name string) *serviceerror.Se
 	return nil
 }
 
+// validateOULocalization validates the optional default locale and time zone of an organization unit.
+func validateOULocalization(locale, zoneinfo string) *serviceerror.ServiceError {
+	if locale != "" && !utils.IsValidLocale(locale) {
+		return &ErrorInvalidLocale
+	}
+	if zoneinfo != "" && !utils.IsValidZoneinfo(zoneinfo) {
+		return &ErrorInvalidZoneinfo
+	}
+	return nil
+}
+
+// validateOUDefaultUserType validates the optional default user type of an organization unit. The user type
+// itself is resolved when a user is created, so it may be defined after the organization unit.
+func validateOUDefaultUserType(userType string) *serviceerror.ServiceError {
+	if strings.TrimSpace(userType) != userType {
+		return &ErrorInvalidDefaultUserType
+	}
+	return nil
+}
+
 // validateOUHandle validates organization unit handle.
 func (ous *organizationUnitService) validateOUHandle(handle string) *serviceerror.ServiceError {
 	trimmed := strings.TrimSpace(handle)
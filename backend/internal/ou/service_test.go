@@ -418,6 +418,21 @@ func (suite *OrganizationUnitServiceTestSuite) TestOUService_CreateOrganizationU
 			request: OrganizationUnitRequestWithID{Handle: " ", Name: "Finance"},
 			wantErr: &ErrorInvalidRequestFormat,
 		},
+		{
+			name:    "invalid locale",
+			request: OrganizationUnitRequestWithID{Handle: "finance", Name: "Finance", Locale: "en_US"},
+			wantErr: &ErrorInvalidLocale,
+		},
+		{
+			name:    "invalid zoneinfo",
+			request: OrganizationUnitRequestWithID{Handle: "finance", Name: "Finance", Zoneinfo: "Mars/Base"},
+			wantErr: &ErrorInvalidZoneinfo,
+		},
+		{
+			name:    "invalid default user type",
+			request: OrganizationUnitRequestWithID{Handle: "finance", Name: "Finance", DefaultUserType: " employee"},
+			wantErr: &ErrorInvalidDefaultUserType,
+		},
 		{
 			name: "parent existence check error",
 			request: OrganizationUnitRequestWithID{
@@ -27,6 +27,7 @@ import (
 	"time"
 
 	"github.com/thunder-id/thunderid/internal/system/config"
+	sysContext "github.com/thunder-id/thunderid/internal/system/context"
 	dbmodel "github.com/thunder-id/thunderid/internal/system/database/model"
 	"github.com/thunder-id/thunderid/internal/system/database/provider"
 	"github.com/thunder-id/thunderid/internal/system/filter"
@@ -84,6 +85,7 @@ func newOrganizationUnitStore() (organizationUnitStoreInterface, transaction.Tra
 func (s *organizationUnitStore) GetOrganizationUnitListCount(
 	ctx context.Context, f *filter.FilterGroup,
 ) (int, error) {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
 	dbClient, err := s.dbProvider.GetUserDBClient()
 	if err != nil {
 		return 0, fmt.Errorf("failed to get database client: %w", err)
@@ -93,7 +95,7 @@ func (s *organizationUnitStore) GetOrganizationUnitListCount(
 	if err != nil {
 		return 0, fmt.Errorf("failed to build count query: %w", err)
 	}
-	args := append([]interface{}{s.deploymentID}, filterArgs...)
+	args := append([]interface{}{deploymentID}, filterArgs...)
 
 	results, err := dbClient.QueryContext(ctx, query, args...)
 	if err != nil {
@@ -116,6 +118,7 @@ func (s *organizationUnitStore) GetOrganizationUnitListCount(
 func (s *organizationUnitStore) GetOrganizationUnitList(
 	ctx context.Context, limit, offset int, f *filter.FilterGroup,
 ) ([]OrganizationUnitBasic, error) {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
 	dbClient, err := s.dbProvider.GetUserDBClient()
 	if err != nil {
 		return nil, fmt.Errorf("failed to get database client: %w", err)
@@ -125,7 +128,7 @@ func (s *organizationUnitStore) GetOrganizationUnitList(
 	if err != nil {
 		return nil, fmt.Errorf("failed to build list query: %w", err)
 	}
-	args := append([]interface{}{limit, offset, s.deploymentID}, filterArgs...)
+	args := append([]interface{}{limit, offset, deploymentID}, filterArgs...)
 
 	results, err := dbClient.QueryContext(ctx, query, args...)
 	if err != nil {
@@ -148,6 +151,7 @@ func (s *organizationUnitStore) GetOrganizationUnitList(
 func (s *organizationUnitStore) GetOrganizationUnitsByIDs(
 	ctx context.Context, ids []string,
 ) ([]OrganizationUnitBasic, error) {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
 	if len(ids) == 0 {
 		return []OrganizationUnitBasic{}, nil
 	}
@@ -162,7 +166,7 @@ func (s *organizationUnitStore) GetOrganizationUnitsByIDs(
 	for _, id := range ids {
 		args = append(args, id)
 	}
-	args = append(args, s.deploymentID)
+	args = append(args, deploymentID)
 
 	results, err := dbClient.QueryContext(ctx, query, args...)
 	if err != nil {
@@ -183,6 +187,7 @@ func (s *organizationUnitStore) GetOrganizationUnitsByIDs(
 
 // CreateOrganizationUnit creates a new organization unit in the database.
 func (s *organizationUnitStore) CreateOrganizationUnit(ctx context.Context, ou OrganizationUnit) error {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
 	dbClient, err := s.dbProvider.GetUserDBClient()
 	if err != nil {
 		return fmt.Errorf("failed to get database client: %w", err)
@@ -204,7 +209,7 @@ func (s *organizationUnitStore) CreateOrganizationUnit(ctx context.Context, ou O
 		ou.ThemeID,
 		ou.LayoutID,
 		string(ouMetadataBytes),
-		s.deploymentID,
+		deploymentID,
 		ou.CreatedAt,
 		ou.UpdatedAt,
 	)
@@ -217,12 +222,13 @@ func (s *organizationUnitStore) CreateOrganizationUnit(ctx context.Context, ou O
 
 // GetOrganizationUnit retrieves an organization unit by its id.
 func (s *organizationUnitStore) GetOrganizationUnit(ctx context.Context, id string) (OrganizationUnit, error) {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
 	dbClient, err := s.dbProvider.GetUserDBClient()
 	if err != nil {
 		return OrganizationUnit{}, fmt.Errorf("failed to get database client: %w", err)
 	}
 
-	results, err := dbClient.QueryContext(ctx, queryGetOrganizationUnitByID, id, s.deploymentID)
+	results, err := dbClient.QueryContext(ctx, queryGetOrganizationUnitByID, id, deploymentID)
 	if err != nil {
 		return OrganizationUnit{}, fmt.Errorf("failed to execute query: %w", err)
 	}
@@ -244,6 +250,7 @@ func (s *organizationUnitStore) GetOrganizationUnit(ctx context.Context, id stri
 func (s *organizationUnitStore) GetOrganizationUnitByHandle(
 	ctx context.Context, handle string, parent *string,
 ) (OrganizationUnit, error) {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
 	dbClient, err := s.dbProvider.GetUserDBClient()
 	if err != nil {
 		return OrganizationUnit{}, fmt.Errorf("failed to get database client: %w", err)
@@ -251,9 +258,9 @@ func (s *organizationUnitStore) GetOrganizationUnitByHandle(
 
 	var results []map[string]interface{}
 	if parent == nil {
-		results, err = dbClient.QueryContext(ctx, queryGetRootOrganizationUnitByHandle, handle, s.deploymentID)
+		results, err = dbClient.QueryContext(ctx, queryGetRootOrganizationUnitByHandle, handle, deploymentID)
 	} else {
-		results, err = dbClient.QueryContext(ctx, queryGetOrganizationUnitByHandle, handle, *parent, s.deploymentID)
+		results, err = dbClient.QueryContext(ctx, queryGetOrganizationUnitByHandle, handle, *parent, deploymentID)
 	}
 	if err != nil {
 		return OrganizationUnit{}, fmt.Errorf("failed to execute query for handle %s: %w", handle, err)
@@ -313,13 +320,14 @@ func (s *organizationUnitStore) GetOrganizationUnitByPath(
 func (s *organizationUnitStore) getOrganizationUnitByHandleWithClient(
 	ctx context.Context, dbClient provider.DBClientInterface, handle string, parent *string,
 ) (OrganizationUnit, error) {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
 	var results []map[string]interface{}
 	var err error
 
 	if parent == nil {
-		results, err = dbClient.QueryContext(ctx, queryGetRootOrganizationUnitByHandle, handle, s.deploymentID)
+		results, err = dbClient.QueryContext(ctx, queryGetRootOrganizationUnitByHandle, handle, deploymentID)
 	} else {
-		results, err = dbClient.QueryContext(ctx, queryGetOrganizationUnitByHandle, handle, *parent, s.deploymentID)
+		results, err = dbClient.QueryContext(ctx, queryGetOrganizationUnitByHandle, handle, *parent, deploymentID)
 	}
 	if err != nil {
 		return OrganizationUnit{}, fmt.Errorf("failed to execute query for handle %s: %w", handle, err)
@@ -339,12 +347,13 @@ func (s *organizationUnitStore) getOrganizationUnitByHandleWithClient(
 
 // IsOrganizationUnitExists checks if an organization unit exists by ID.
 func (s *organizationUnitStore) IsOrganizationUnitExists(ctx context.Context, id string) (bool, error) {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
 	dbClient, err := s.dbProvider.GetUserDBClient()
 	if err != nil {
 		return false, fmt.Errorf("failed to get database client: %w", err)
 	}
 
-	results, err := dbClient.QueryContext(ctx, queryCheckOrganizationUnitExists, id, s.deploymentID)
+	results, err := dbClient.QueryContext(ctx, queryCheckOrganizationUnitExists, id, deploymentID)
 	if err != nil {
 		return false, fmt.Errorf("failed to execute existence check query: %w", err)
 	}
@@ -370,6 +379,7 @@ func (s *organizationUnitStore) IsOrganizationUnitDeclarative(ctx context.Contex
 
 // UpdateOrganizationUnit updates an existing organization unit.
 func (s *organizationUnitStore) UpdateOrganizationUnit(ctx context.Context, ou OrganizationUnit) error {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
 	dbClient, err := s.dbProvider.GetUserDBClient()
 	if err != nil {
 		return fmt.Errorf("failed to get database client: %w", err)
@@ -392,7 +402,7 @@ func (s *organizationUnitStore) UpdateOrganizationUnit(ctx context.Context, ou O
 		ou.LayoutID,
 		string(ouMetadataBytes),
 		ou.UpdatedAt,
-		s.deploymentID,
+		deploymentID,
 	)
 	if err != nil {
 		return fmt.Errorf("failed to execute query: %w", err)
@@ -403,12 +413,13 @@ func (s *organizationUnitStore) UpdateOrganizationUnit(ctx context.Context, ou O
 
 // DeleteOrganizationUnit deletes an organization unit.
 func (s *organizationUnitStore) DeleteOrganizationUnit(ctx context.Context, id string) error {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
 	dbClient, err := s.dbProvider.GetUserDBClient()
 	if err != nil {
 		return fmt.Errorf("failed to get database client: %w", err)
 	}
 
-	_, err = dbClient.ExecuteContext(ctx, queryDeleteOrganizationUnit, id, s.deploymentID)
+	_, err = dbClient.ExecuteContext(ctx, queryDeleteOrganizationUnit, id, deploymentID)
 	if err != nil {
 		return fmt.Errorf("failed to execute query: %w", err)
 	}
@@ -420,6 +431,7 @@ func (s *organizationUnitStore) DeleteOrganizationUnit(ctx context.Context, id s
 func (s *organizationUnitStore) GetOrganizationUnitChildrenCount(
 	ctx context.Context, parentID string, f *filter.FilterGroup,
 ) (int, error) {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
 	dbClient, err := s.dbProvider.GetUserDBClient()
 	if err != nil {
 		return 0, fmt.Errorf("failed to get database client: %w", err)
@@ -429,7 +441,7 @@ func (s *organizationUnitStore) GetOrganizationUnitChildrenCount(
 	if err != nil {
 		return 0, fmt.Errorf("failed to build count query: %w", err)
 	}
-	args := append([]interface{}{parentID, s.deploymentID}, filterArgs...)
+	args := append([]interface{}{parentID, deploymentID}, filterArgs...)
 
 	results, err := dbClient.QueryContext(ctx, query, args...)
 	if err != nil {
@@ -453,6 +465,7 @@ func (s *organizationUnitStore) GetOrganizationUnitChildrenCount(
 func (s *organizationUnitStore) GetOrganizationUnitChildrenList(ctx context.Context,
 	parentID string, limit, offset int, f *filter.FilterGroup,
 ) ([]OrganizationUnitBasic, error) {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
 	dbClient, err := s.dbProvider.GetUserDBClient()
 	if err != nil {
 		return nil, fmt.Errorf("failed to get database client: %w", err)
@@ -462,7 +475,7 @@ func (s *organizationUnitStore) GetOrganizationUnitChildrenList(ctx context.Cont
 	if err != nil {
 		return nil, fmt.Errorf("failed to build list query: %w", err)
 	}
-	args := append([]interface{}{parentID, limit, offset, s.deploymentID}, filterArgs...)
+	args := append([]interface{}{parentID, limit, offset, deploymentID}, filterArgs...)
 
 	results, err := dbClient.QueryContext(ctx, query, args...)
 	if err != nil {
@@ -485,12 +498,13 @@ func (s *organizationUnitStore) GetOrganizationUnitChildrenList(ctx context.Cont
 func (s *organizationUnitStore) CheckOrganizationUnitNameConflict(
 	ctx context.Context, name string, parentID *string,
 ) (bool, error) {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
 	return s.checkConflict(ctx,
 		queryCheckOrganizationUnitNameConflict,
 		queryCheckOrganizationUnitNameConflictRoot,
 		name,
 		parentID,
-		s.deploymentID,
+		deploymentID,
 	)
 }
 
@@ -498,12 +512,13 @@ func (s *organizationUnitStore) CheckOrganizationUnitNameConflict(
 func (s *organizationUnitStore) CheckOrganizationUnitHandleConflict(
 	ctx context.Context, handle string, parentID *string,
 ) (bool, error) {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
 	return s.checkConflict(ctx,
 		queryCheckOrganizationUnitHandleConflict,
 		queryCheckOrganizationUnitHandleConflictRoot,
 		handle,
 		parentID,
-		s.deploymentID,
+		deploymentID,
 	)
 }
 
@@ -621,6 +636,21 @@ func buildOrganizationUnitFromResultRow(
 		return OrganizationUnit{}, err
 	}
 
+	locale, err := extractStringFromOUMetadata(ouMetadataData, "locale")
+	if err != nil {
+		return OrganizationUnit{}, err
+	}
+
+	zoneinfo, err := extractStringFromOUMetadata(ouMetadataData, "zoneinfo")
+	if err != nil {
+		return OrganizationUnit{}, err
+	}
+
+	defaultUserType, err := extractStringFromOUMetadata(ouMetadataData, "default_user_type")
+	if err != nil {
+		return OrganizationUnit{}, err
+	}
+
 	createdAt, err := parseTimeField(row["created_at"], "created_at")
 	if err != nil {
 		return OrganizationUnit{}, fmt.Errorf("failed to parse created_at: %w", err)
@@ -643,6 +673,9 @@ func buildOrganizationUnitFromResultRow(
 		TosURI:          tosURI,
 		PolicyURI:       policyURI,
 		CookiePolicyURI: cookiePolicyURI,
+		Locale:          locale,
+		Zoneinfo:        zoneinfo,
+		DefaultUserType: defaultUserType,
 		CreatedAt:       createdAt,
 		UpdatedAt:       updatedAt,
 	}, nil
@@ -724,6 +757,16 @@ func getOUMetadataDataBytes(ou *OrganizationUnit) ([]byte, error) {
 		"policy_uri":        ou.PolicyURI,
 		"cookie_policy_uri": ou.CookiePolicyURI,
 	}
+	// The localization and user type defaults are optional and stored only when set.
+	if ou.Locale != "" {
+		jsonData["locale"] = ou.Locale
+	}
+	if ou.Zoneinfo != "" {
+		jsonData["zoneinfo"] = ou.Zoneinfo
+	}
+	if ou.DefaultUserType != "" {
+		jsonData["default_user_type"] = ou.DefaultUserType
+	}
 
 	jsonBytes, err := json.Marshal(jsonData)
 	if err != nil {
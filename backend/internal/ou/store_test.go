@@ -341,6 +341,41 @@ func TestBuildOrganizationUnitBasicFromResultRow(t *testing.T) {
 		require.Equal(t, "https://example.com/logo.png", ou.LogoURL)
 	})
 
+	t.Run("with localization defaults", func(t *testing.T) {
+		row := map[string]interface{}{
+			"ou_id":      "ou1",
+			"handle":     "root",
+			"name":       "Root",
+			"parent_id":  nil,
+			"created_at": "2025-01-01 10:00:00",
+			"updated_at": "2025-01-01 10:00:00",
+			"metadata":   `{"logo_url":"","locale":"fr-CA","zoneinfo":"America/Toronto"}`,
+		}
+
+		ou, err := buildOrganizationUnitFromResultRow(row)
+
+		require.NoError(t, err)
+		require.Equal(t, "fr-CA", ou.Locale)
+		require.Equal(t, "America/Toronto", ou.Zoneinfo)
+	})
+
+	t.Run("with default user type", func(t *testing.T) {
+		row := map[string]interface{}{
+			"ou_id":      "ou1",
+			"handle":     "root",
+			"name":       "Root",
+			"parent_id":  nil,
+			"created_at": "2025-01-01 10:00:00",
+			"updated_at": "2025-01-01 10:00:00",
+			"metadata":   `{"logo_url":"","default_user_type":"employee"}`,
+		}
+
+		ou, err := buildOrganizationUnitFromResultRow(row)
+
+		require.NoError(t, err)
+		require.Equal(t, "employee", ou.DefaultUserType)
+	})
+
 	t.Run("success with nil metadata", func(t *testing.T) {
 		row := map[string]interface{}{
 			"ou_id":       "ou1",
@@ -1897,6 +1932,22 @@ func TestTrimTimeString(t *testing.T) {
 	require.Equal(t, "2025-01-01T10:00:00Z", trimTimeString("2025-01-01T10:00:00Z"))
 }
 
+func TestGetOUMetadataDataBytes_LocalizationDefaults(t *testing.T) {
+	data, err := getOUMetadataDataBytes(&OrganizationUnit{Locale: "fr-CA", Zoneinfo: "America/Toronto"})
+
+	require.NoError(t, err)
+	require.JSONEq(t, `{"cookie_policy_uri":"","logo_url":"","policy_uri":"","tos_uri":"",`+
+		`"locale":"fr-CA","zoneinfo":"America/Toronto"}`, string(data))
+}
+
+func TestGetOUMetadataDataBytes_DefaultUserType(t *testing.T) {
+	data, err := getOUMetadataDataBytes(&OrganizationUnit{DefaultUserType: "employee"})
+
+	require.NoError(t, err)
+	require.JSONEq(t, `{"cookie_policy_uri":"","logo_url":"","policy_uri":"","tos_uri":"",`+
+		`"default_user_type":"employee"}`, string(data))
+}
+
 func TestParseOUMetadata(t *testing.T) {
 	t.Run("missing metadata key", func(t *testing.T) {
 		data, err := parseOUMetadata(map[string]interface{}{})
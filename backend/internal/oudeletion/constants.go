@@ -0,0 +1,32 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package oudeletion
+
+import "time"
+
+const loggerComponentName = "OUDeletionService"
+
+// webhookEventJobFinished is the event type of the webhook sent when a job reaches a terminal state.
+const webhookEventJobFinished = "ou.deletion.finished"
+
+// webhookTimeout bounds the time spent delivering a webhook notification.
+const webhookTimeout = 10 * time.Second
+
+// defaultJobRetention is the number of seconds a job is retained when no retention is configured.
+const defaultJobRetention = int64(7 * 24 * 60 * 60)
@@ -0,0 +1,124 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package oudeletion
+
+import (
+	"errors"
+
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/i18n/core"
+)
+
+// ErrDeletionJobNotFound is returned when the deletion job is not found in the store.
+var ErrDeletionJobNotFound = errors.New("deletion job not found")
+
+// Client errors for organization unit deletion operations.
+var (
+	// ErrorInvalidRequestFormat is the error returned when the request body is malformed.
+	ErrorInvalidRequestFormat = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "OUD-1001",
+		Error: core.I18nMessage{
+			Key:          "error.oudeletionservice.invalid_request_format",
+			DefaultValue: "Invalid request format",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.oudeletionservice.invalid_request_format_description",
+			DefaultValue: "The request body is malformed or contains invalid data",
+		},
+	}
+	// ErrorMissingOUID is the error returned when the organization unit ID is missing.
+	ErrorMissingOUID = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "OUD-1002",
+		Error: core.I18nMessage{
+			Key:          "error.oudeletionservice.missing_ou_id",
+			DefaultValue: "Missing organization unit ID",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.oudeletionservice.missing_ou_id_description",
+			DefaultValue: "The organization unit ID to delete must be provided",
+		},
+	}
+	// ErrorOrganizationUnitNotFound is the error returned when the organization unit is not found.
+	ErrorOrganizationUnitNotFound = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "OUD-1003",
+		Error: core.I18nMessage{
+			Key:          "error.oudeletionservice.ou_not_found",
+			DefaultValue: "Organization unit not found",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.oudeletionservice.ou_not_found_description",
+			DefaultValue: "The organization unit to delete could not be found",
+		},
+	}
+	// ErrorDeletionJobNotFound is the error returned when a deletion job is not found.
+	ErrorDeletionJobNotFound = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "OUD-1004",
+		Error: core.I18nMessage{
+			Key:          "error.oudeletionservice.job_not_found",
+			DefaultValue: "Deletion job not found",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.oudeletionservice.job_not_found_description",
+			DefaultValue: "The requested organization unit deletion job could not be found",
+		},
+	}
+	// ErrorDeletionJobConflict is the error returned when the organization unit already has an active deletion job.
+	ErrorDeletionJobConflict = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "OUD-1005",
+		Error: core.I18nMessage{
+			Key:          "error.oudeletionservice.job_conflict",
+			DefaultValue: "Deletion already in progress",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.oudeletionservice.job_conflict_description",
+			DefaultValue: "The organization unit already has an active deletion job",
+		},
+	}
+	// ErrorDeclarativeResource is the error returned when the subtree contains a declarative organization unit.
+	ErrorDeclarativeResource = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "OUD-1006",
+		Error: core.I18nMessage{
+			Key:          "error.oudeletionservice.declarative_resource",
+			DefaultValue: "Cannot delete declarative resource",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.oudeletionservice.declarative_resource_description",
+			DefaultValue: "The organization unit or one of its child organization units is declarative and cannot be deleted",
+		},
+	}
+	// ErrorJobNotCancellable is the error returned when a deletion job has already finished.
+	ErrorJobNotCancellable = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "OUD-1007",
+		Error: core.I18nMessage{
+			Key:          "error.oudeletionservice.job_not_cancellable",
+			DefaultValue: "Deletion job cannot be cancelled",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.oudeletionservice.job_not_cancellable_description",
+			DefaultValue: "The deletion job has already finished and cannot be cancelled",
+		},
+	}
+)
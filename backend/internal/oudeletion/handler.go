@@ -0,0 +1,87 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package oudeletion
+
+import (
+	"net/http"
+
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	sysutils "github.com/thunder-id/thunderid/internal/system/utils"
+)
+
+// ouDeletionHandler is the handler for organization unit deletion job operations.
+type ouDeletionHandler struct {
+	deletionService OUDeletionServiceInterface
+}
+
+// newOUDeletionHandler creates a new instance of ouDeletionHandler.
+func newOUDeletionHandler(deletionService OUDeletionServiceInterface) *ouDeletionHandler {
+	return &ouDeletionHandler{
+		deletionService: deletionService,
+	}
+}
+
+// HandleDeletionJobPostRequest handles the start deletion job request.
+func (h *ouDeletionHandler) HandleDeletionJobPostRequest(w http.ResponseWriter, r *http.Request) {
+	request, err := sysutils.DecodeJSONBody[DeletionJobRequest](r)
+	if err != nil {
+		sysutils.WriteServiceErrorResponse(w, &ErrorInvalidRequestFormat, clientErrorStatusCodes)
+		return
+	}
+
+	job, svcErr := h.deletionService.StartDeletion(r.Context(), sysutils.SanitizeString(request.OUID))
+	if svcErr != nil {
+		sysutils.WriteServiceErrorResponse(w, svcErr, clientErrorStatusCodes)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(w, http.StatusAccepted, job)
+}
+
+// HandleDeletionJobGetRequest handles the get deletion job request.
+func (h *ouDeletionHandler) HandleDeletionJobGetRequest(w http.ResponseWriter, r *http.Request) {
+	job, svcErr := h.deletionService.GetDeletionJob(r.Context(), r.PathValue("id"))
+	if svcErr != nil {
+		sysutils.WriteServiceErrorResponse(w, svcErr, clientErrorStatusCodes)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(w, http.StatusOK, job)
+}
+
+// HandleDeletionJobCancelRequest handles the cancel deletion job request.
+func (h *ouDeletionHandler) HandleDeletionJobCancelRequest(w http.ResponseWriter, r *http.Request) {
+	job, svcErr := h.deletionService.CancelDeletion(r.Context(), r.PathValue("id"))
+	if svcErr != nil {
+		sysutils.WriteServiceErrorResponse(w, svcErr, clientErrorStatusCodes)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(w, http.StatusOK, job)
+}
+
+// clientErrorStatusCodes maps the client errors of the handler to HTTP status codes. Other client errors are
+// returned with 400 Bad Request.
+var clientErrorStatusCodes = map[string]int{
+	ErrorOrganizationUnitNotFound.Code:  http.StatusNotFound,
+	ErrorDeletionJobNotFound.Code:       http.StatusNotFound,
+	ErrorDeletionJobConflict.Code:       http.StatusConflict,
+	ErrorJobNotCancellable.Code:         http.StatusConflict,
+	serviceerror.ErrorUnauthorized.Code: http.StatusForbidden,
+}
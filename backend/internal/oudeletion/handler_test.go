@@ -0,0 +1,155 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package oudeletion
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/system/error/apierror"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+)
+
+type HandlerTestSuite struct {
+	suite.Suite
+	mockService *OUDeletionServiceInterfaceMock
+	handler     *ouDeletionHandler
+}
+
+func TestHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(HandlerTestSuite))
+}
+
+func (s *HandlerTestSuite) SetupTest() {
+	s.mockService = NewOUDeletionServiceInterfaceMock(s.T())
+	s.handler = newOUDeletionHandler(s.mockService)
+}
+
+func (s *HandlerTestSuite) TestHandleDeletionJobPostRequest_Accepted() {
+	s.mockService.On("StartDeletion", mock.Anything, "ou-1").
+		Return(&DeletionJob{ID: "job-1", OUID: "ou-1", Status: JobStatusPending}, nil)
+
+	req := httptest.NewRequest(http.MethodPost, "/organization-units/deletion-jobs",
+		strings.NewReader(`{"ouId":"ou-1"}`))
+	rr := httptest.NewRecorder()
+	s.handler.HandleDeletionJobPostRequest(rr, req)
+
+	s.Equal(http.StatusAccepted, rr.Code)
+	var body DeletionJob
+	s.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &body))
+	s.Equal("job-1", body.ID)
+	s.Equal(JobStatusPending, body.Status)
+}
+
+func (s *HandlerTestSuite) TestHandleDeletionJobPostRequest_InvalidBody() {
+	req := httptest.NewRequest(http.MethodPost, "/organization-units/deletion-jobs", strings.NewReader(`{`))
+	rr := httptest.NewRecorder()
+	s.handler.HandleDeletionJobPostRequest(rr, req)
+
+	s.Equal(http.StatusBadRequest, rr.Code)
+	var errResp apierror.ErrorResponse
+	s.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &errResp))
+	s.Equal(ErrorInvalidRequestFormat.Code, errResp.Code)
+}
+
+func (s *HandlerTestSuite) TestHandleDeletionJobPostRequest_ErrorStatusCodes() {
+	testCases := []struct {
+		name     string
+		svcErr   *serviceerror.ServiceError
+		expected int
+	}{
+		{"not found", &ErrorOrganizationUnitNotFound, http.StatusNotFound},
+		{"conflict", &ErrorDeletionJobConflict, http.StatusConflict},
+		{"declarative", &ErrorDeclarativeResource, http.StatusBadRequest},
+		{"unauthorized", &serviceerror.ErrorUnauthorized, http.StatusForbidden},
+		{"server error", &serviceerror.InternalServerError, http.StatusInternalServerError},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			s.mockService.On("StartDeletion", mock.Anything, "ou-1").Return(nil, tc.svcErr)
+
+			req := httptest.NewRequest(http.MethodPost, "/organization-units/deletion-jobs",
+				strings.NewReader(`{"ouId":"ou-1"}`))
+			rr := httptest.NewRecorder()
+			s.handler.HandleDeletionJobPostRequest(rr, req)
+
+			s.Equal(tc.expected, rr.Code)
+		})
+	}
+}
+
+func (s *HandlerTestSuite) TestHandleDeletionJobGetRequest() {
+	s.mockService.On("GetDeletionJob", mock.Anything, "job-1").
+		Return(&DeletionJob{ID: "job-1", Status: JobStatusRunning, Progress: JobProgress{DeletedUsers: 5}}, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/organization-units/deletion-jobs/job-1", nil)
+	req.SetPathValue("id", "job-1")
+	rr := httptest.NewRecorder()
+	s.handler.HandleDeletionJobGetRequest(rr, req)
+
+	s.Equal(http.StatusOK, rr.Code)
+	var body DeletionJob
+	s.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &body))
+	s.Equal(5, body.Progress.DeletedUsers)
+}
+
+func (s *HandlerTestSuite) TestHandleDeletionJobGetRequest_NotFound() {
+	s.mockService.On("GetDeletionJob", mock.Anything, "job-1").Return(nil, &ErrorDeletionJobNotFound)
+
+	req := httptest.NewRequest(http.MethodGet, "/organization-units/deletion-jobs/job-1", nil)
+	req.SetPathValue("id", "job-1")
+	rr := httptest.NewRecorder()
+	s.handler.HandleDeletionJobGetRequest(rr, req)
+
+	s.Equal(http.StatusNotFound, rr.Code)
+}
+
+func (s *HandlerTestSuite) TestHandleDeletionJobCancelRequest() {
+	s.mockService.On("CancelDeletion", mock.Anything, "job-1").
+		Return(&DeletionJob{ID: "job-1", Status: JobStatusCancelled}, nil)
+
+	req := httptest.NewRequest(http.MethodPost, "/organization-units/deletion-jobs/job-1/cancel", nil)
+	req.SetPathValue("id", "job-1")
+	rr := httptest.NewRecorder()
+	s.handler.HandleDeletionJobCancelRequest(rr, req)
+
+	s.Equal(http.StatusOK, rr.Code)
+	var body DeletionJob
+	s.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &body))
+	s.Equal(JobStatusCancelled, body.Status)
+}
+
+func (s *HandlerTestSuite) TestHandleDeletionJobCancelRequest_NotCancellable() {
+	s.mockService.On("CancelDeletion", mock.Anything, "job-1").Return(nil, &ErrorJobNotCancellable)
+
+	req := httptest.NewRequest(http.MethodPost, "/organization-units/deletion-jobs/job-1/cancel", nil)
+	req.SetPathValue("id", "job-1")
+	rr := httptest.NewRecorder()
+	s.handler.HandleDeletionJobCancelRequest(rr, req)
+
+	s.Equal(http.StatusConflict, rr.Code)
+}
@@ -0,0 +1,88 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package oudeletion
+
+import (
+	"net/http"
+
+	"github.com/thunder-id/thunderid/internal/group"
+	"github.com/thunder-id/thunderid/internal/ou"
+	"github.com/thunder-id/thunderid/internal/role"
+	"github.com/thunder-id/thunderid/internal/system/config"
+	syshttp "github.com/thunder-id/thunderid/internal/system/http"
+	"github.com/thunder-id/thunderid/internal/system/middleware"
+	"github.com/thunder-id/thunderid/internal/system/sysauthz"
+	"github.com/thunder-id/thunderid/internal/user"
+)
+
+// Initialize initializes the organization unit deletion service and registers its routes.
+func Initialize(
+	mux *http.ServeMux,
+	ouService ou.OrganizationUnitServiceInterface,
+	userService user.UserServiceInterface,
+	groupService group.GroupServiceInterface,
+	roleAssignmentService role.RoleAssignmentServiceInterface,
+	authzService sysauthz.SystemAuthorizationServiceInterface,
+) OUDeletionServiceInterface {
+	deletionConfig := config.GetServerRuntime().Config.OrganizationUnit.Deletion
+	deletionService := newOUDeletionService(newDeletionJobStore(), ouService, userService, groupService,
+		roleAssignmentService, authzService, syshttp.NewHTTPClientWithTimeout(webhookTimeout),
+		deletionConfig.ChunkSize, deletionConfig.JobRetention, deletionConfig.WebhookURL)
+
+	deletionHandler := newOUDeletionHandler(deletionService)
+	registerRoutes(mux, deletionHandler)
+
+	return deletionService
+}
+
+// registerRoutes registers the routes for organization unit deletion job operations.
+func registerRoutes(mux *http.ServeMux, deletionHandler *ouDeletionHandler) {
+	opts1 := middleware.CORSOptions{
+		AllowedMethods:   []string{"POST"},
+		AllowedHeaders:   middleware.DefaultAllowedHeaders,
+		AllowCredentials: true,
+		MaxAge:           600,
+	}
+	mux.HandleFunc(middleware.WithCORS("POST /organization-units/deletion-jobs",
+		deletionHandler.HandleDeletionJobPostRequest, opts1))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS /organization-units/deletion-jobs",
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, opts1))
+
+	opts2 := middleware.CORSOptions{
+		AllowedMethods:   []string{"GET"},
+		AllowedHeaders:   middleware.DefaultAllowedHeaders,
+		AllowCredentials: true,
+		MaxAge:           600,
+	}
+	mux.HandleFunc(middleware.WithCORS("GET /organization-units/deletion-jobs/{id}",
+		deletionHandler.HandleDeletionJobGetRequest, opts2))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS /organization-units/deletion-jobs/{id}",
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, opts2))
+
+	mux.HandleFunc(middleware.WithCORS("POST /organization-units/deletion-jobs/{id}/cancel",
+		deletionHandler.HandleDeletionJobCancelRequest, opts1))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS /organization-units/deletion-jobs/{id}/cancel",
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, opts1))
+}
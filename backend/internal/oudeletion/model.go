@@ -0,0 +1,76 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package oudeletion provides asynchronous deletion of organization units together with the users,
+// groups and child organization units they contain.
+package oudeletion
+
+import "time"
+
+// JobStatus represents the lifecycle state of a deletion job.
+type JobStatus string
+
+const (
+	// JobStatusPending indicates the job is accepted but has not started deleting resources.
+	JobStatusPending JobStatus = "PENDING"
+	// JobStatusRunning indicates the job is deleting resources.
+	JobStatusRunning JobStatus = "RUNNING"
+	// JobStatusCompleted indicates the organization unit and its contents were deleted.
+	JobStatusCompleted JobStatus = "COMPLETED"
+	// JobStatusFailed indicates the job stopped because a resource could not be deleted.
+	JobStatusFailed JobStatus = "FAILED"
+	// JobStatusCancelled indicates the job was cancelled before it completed.
+	JobStatusCancelled JobStatus = "CANCELLED"
+)
+
+// IsTerminal reports whether the status is a final state of a deletion job.
+func (s JobStatus) IsTerminal() bool {
+	return s == JobStatusCompleted || s == JobStatusFailed || s == JobStatusCancelled
+}
+
+// JobProgress holds the resource counters of a deletion job.
+type JobProgress struct {
+	TotalUsers               int `json:"totalUsers"`
+	DeletedUsers             int `json:"deletedUsers"`
+	TotalGroups              int `json:"totalGroups"`
+	DeletedGroups            int `json:"deletedGroups"`
+	TotalOrganizationUnits   int `json:"totalOrganizationUnits"`
+	DeletedOrganizationUnits int `json:"deletedOrganizationUnits"`
+}
+
+// DeletionJob represents an asynchronous organization unit deletion job.
+type DeletionJob struct {
+	ID            string      `json:"id"`
+	OUID          string      `json:"ouId"`
+	Status        JobStatus   `json:"status"`
+	Progress      JobProgress `json:"progress"`
+	FailureReason string      `json:"failureReason,omitempty"`
+	CreatedAt     time.Time   `json:"createdAt"`
+	UpdatedAt     time.Time   `json:"updatedAt"`
+}
+
+// DeletionJobRequest represents the request body for starting a deletion job.
+type DeletionJobRequest struct {
+	OUID string `json:"ouId"`
+}
+
+// deletionWebhookEvent is the payload posted to the configured webhook when a job finishes.
+type deletionWebhookEvent struct {
+	Event string      `json:"event"`
+	Job   DeletionJob `json:"job"`
+}
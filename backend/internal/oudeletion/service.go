@@ -0,0 +1,535 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package oudeletion
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/group"
+	"github.com/thunder-id/thunderid/internal/ou"
+	"github.com/thunder-id/thunderid/internal/role"
+	"github.com/thunder-id/thunderid/internal/system/backgroundjob"
+	serverconst "github.com/thunder-id/thunderid/internal/system/constants"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	syshttp "github.com/thunder-id/thunderid/internal/system/http"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/internal/system/security"
+	"github.com/thunder-id/thunderid/internal/system/sysauthz"
+	"github.com/thunder-id/thunderid/internal/system/utils"
+	"github.com/thunder-id/thunderid/internal/user"
+)
+
+// errJobCancelled signals that the worker observed a cancellation of the job it is running.
+var errJobCancelled = errors.New("deletion job cancelled")
+
+// OUDeletionServiceInterface defines the interface for the organization unit deletion service.
+type OUDeletionServiceInterface interface {
+	StartDeletion(ctx context.Context, ouID string) (*DeletionJob, *serviceerror.ServiceError)
+	GetDeletionJob(ctx context.Context, jobID string) (*DeletionJob, *serviceerror.ServiceError)
+	CancelDeletion(ctx context.Context, jobID string) (*DeletionJob, *serviceerror.ServiceError)
+}
+
+// ouDeletionService is the default implementation of the OUDeletionServiceInterface.
+type ouDeletionService struct {
+	jobStore              deletionJobStoreInterface
+	lease                 *backgroundjob.Lease[JobStatus]
+	ouService             ou.OrganizationUnitServiceInterface
+	userService           user.UserServiceInterface
+	groupService          group.GroupServiceInterface
+	roleAssignmentService role.RoleAssignmentServiceInterface
+	authzService          sysauthz.SystemAuthorizationServiceInterface
+	httpClient            syshttp.HTTPClientInterface
+	chunkSize             int
+	jobRetention          int64
+	webhookURL            string
+	// runAsync starts the worker of a job. It runs the worker on a new goroutine.
+	runAsync func(func())
+}
+
+// newOUDeletionService creates a new instance of ouDeletionService.
+func newOUDeletionService(
+	jobStore deletionJobStoreInterface,
+	ouService ou.OrganizationUnitServiceInterface,
+	userService user.UserServiceInterface,
+	groupService group.GroupServiceInterface,
+	roleAssignmentService role.RoleAssignmentServiceInterface,
+	authzService sysauthz.SystemAuthorizationServiceInterface,
+	httpClient syshttp.HTTPClientInterface,
+	chunkSize int,
+	jobRetention int64,
+	webhookURL string,
+) OUDeletionServiceInterface {
+	if chunkSize < 1 || chunkSize > serverconst.MaxPageSize {
+		chunkSize = serverconst.MaxPageSize
+	}
+	if jobRetention <= 0 {
+		jobRetention = defaultJobRetention
+	}
+	return &ouDeletionService{
+		jobStore:              jobStore,
+		lease:                 backgroundjob.NewLease(jobStore.UpdateStatus, JobStatusPending, JobStatusFailed),
+		ouService:             ouService,
+		userService:           userService,
+		groupService:          groupService,
+		roleAssignmentService: roleAssignmentService,
+		authzService:          authzService,
+		httpClient:            httpClient,
+		chunkSize:             chunkSize,
+		jobRetention:          jobRetention,
+		webhookURL:            webhookURL,
+		runAsync:              func(f func()) { go f() },
+	}
+}
+
+// StartDeletion validates that the organization unit can be deleted and starts a job that deletes it
+// together with its child organization units, users and groups.
+func (s *ouDeletionService) StartDeletion(
+	ctx context.Context, ouID string,
+) (*DeletionJob, *serviceerror.ServiceError) {
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName))
+
+	if ouID == "" {
+		return nil, &ErrorMissingOUID
+	}
+	if svcErr := s.checkAccess(ctx, security.ActionDeleteOU, ouID); svcErr != nil {
+		return nil, svcErr
+	}
+
+	// The caller is authorized to delete the organization unit, which covers everything beneath it.
+	runtimeCtx := security.WithRuntimeContext(ctx)
+
+	exists, svcErr := s.ouService.IsOrganizationUnitExists(runtimeCtx, ouID)
+	if svcErr != nil {
+		logger.Error("Failed to check organization unit existence", log.String("ouID", ouID))
+		return nil, &serviceerror.InternalServerError
+	}
+	if !exists {
+		return nil, &ErrorOrganizationUnitNotFound
+	}
+
+	if svcErr := s.releaseActiveJob(runtimeCtx, ouID); svcErr != nil {
+		return nil, svcErr
+	}
+
+	ouIDs, progress, svcErr := s.planDeletion(runtimeCtx, ouID)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+
+	jobID, err := utils.GenerateUUIDv7()
+	if err != nil {
+		logger.Error("Failed to generate deletion job ID", log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+	now := time.Now().UTC()
+	job := DeletionJob{
+		ID:        jobID,
+		OUID:      ouID,
+		Status:    JobStatusPending,
+		Progress:  progress,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	expiryTime := now.Add(time.Duration(s.jobRetention) * time.Second)
+	if err := s.jobStore.CreateJob(runtimeCtx, job, expiryTime); err != nil {
+		logger.Error("Failed to create deletion job", log.String("ouID", ouID), log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+
+	backgroundjob.StartWorker(runtimeCtx, s.runAsync, func(workerCtx context.Context) {
+		s.runJob(workerCtx, jobID, progress, ouIDs)
+	})
+
+	logger.Debug("Started organization unit deletion job", log.String("jobID", jobID), log.String("ouID", ouID))
+	return &job, nil
+}
+
+// GetDeletionJob retrieves a deletion job by its ID.
+func (s *ouDeletionService) GetDeletionJob(
+	ctx context.Context, jobID string,
+) (*DeletionJob, *serviceerror.ServiceError) {
+	job, svcErr := s.getJob(ctx, jobID)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	if svcErr := s.checkAccess(ctx, security.ActionReadOU, job.OUID); svcErr != nil {
+		return nil, svcErr
+	}
+	return job, nil
+}
+
+// CancelDeletion cancels a pending or running deletion job. Resources deleted before the
+// cancellation are not restored.
+func (s *ouDeletionService) CancelDeletion(
+	ctx context.Context, jobID string,
+) (*DeletionJob, *serviceerror.ServiceError) {
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName))
+
+	job, svcErr := s.getJob(ctx, jobID)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	if svcErr := s.checkAccess(ctx, security.ActionDeleteOU, job.OUID); svcErr != nil {
+		return nil, svcErr
+	}
+	if job.Status.IsTerminal() {
+		return nil, &ErrorJobNotCancellable
+	}
+
+	cancelled := false
+	for _, from := range []JobStatus{JobStatusPending, JobStatusRunning} {
+		applied, err := s.jobStore.UpdateStatus(ctx, jobID, from, JobStatusCancelled, "")
+		if err != nil {
+			logger.Error("Failed to cancel deletion job", log.String("jobID", jobID), log.Error(err))
+			return nil, &serviceerror.InternalServerError
+		}
+		if applied {
+			cancelled = true
+			break
+		}
+	}
+	if !cancelled {
+		return nil, &ErrorJobNotCancellable
+	}
+
+	logger.Debug("Cancelled organization unit deletion job", log.String("jobID", jobID))
+	return s.getJob(ctx, jobID)
+}
+
+// getJob retrieves a deletion job from the store and maps store errors to service errors.
+func (s *ouDeletionService) getJob(ctx context.Context, jobID string) (*DeletionJob, *serviceerror.ServiceError) {
+	if jobID == "" {
+		return nil, &ErrorDeletionJobNotFound
+	}
+
+	job, err := s.jobStore.GetJob(ctx, jobID)
+	if err != nil {
+		if errors.Is(err, ErrDeletionJobNotFound) {
+			return nil, &ErrorDeletionJobNotFound
+		}
+		log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName)).
+			Error("Failed to retrieve deletion job", log.String("jobID", jobID), log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+	return job, nil
+}
+
+// releaseActiveJob rejects the request when the organization unit already has an active job.
+// An active job that has not reported progress within backgroundjob.StaleTimeout is marked as failed
+// instead, so that a job interrupted by a restart does not block the organization unit forever.
+func (s *ouDeletionService) releaseActiveJob(ctx context.Context, ouID string) *serviceerror.ServiceError {
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName))
+
+	active, err := s.jobStore.GetActiveJobByOUID(ctx, ouID)
+	if err != nil {
+		if errors.Is(err, ErrDeletionJobNotFound) {
+			return nil
+		}
+		logger.Error("Failed to check active deletion jobs", log.String("ouID", ouID), log.Error(err))
+		return &serviceerror.InternalServerError
+	}
+	if err := s.lease.ReleaseStale(ctx, active.ID, active.Status, active.UpdatedAt); err != nil {
+		if errors.Is(err, backgroundjob.ErrJobConflict) {
+			return &ErrorDeletionJobConflict
+		}
+		logger.Error("Failed to release stale deletion job", log.String("jobID", active.ID), log.Error(err))
+		return &serviceerror.InternalServerError
+	}
+	return nil
+}
+
+// planDeletion walks the subtree of the organization unit, verifies that none of its organization units
+// are declarative and counts the resources to delete. The returned organization unit IDs are ordered so
+// that every organization unit appears before its parent.
+func (s *ouDeletionService) planDeletion(
+	ctx context.Context, rootID string,
+) ([]string, JobProgress, *serviceerror.ServiceError) {
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName))
+
+	var progress JobProgress
+	ouIDs := []string{rootID}
+	for i := 0; i < len(ouIDs); i++ {
+		ouID := ouIDs[i]
+		if s.ouService.IsOrganizationUnitDeclarative(ctx, ouID) {
+			return nil, progress, &ErrorDeclarativeResource
+		}
+
+		users, svcErr := s.ouService.GetOrganizationUnitUsers(ctx, ouID, 1, 0, false)
+		if svcErr != nil {
+			logger.Error("Failed to count organization unit users", log.String("ouID", ouID))
+			return nil, progress, &serviceerror.InternalServerError
+		}
+		progress.TotalUsers += users.TotalResults
+
+		groups, svcErr := s.ouService.GetOrganizationUnitGroups(ctx, ouID, 1, 0)
+		if svcErr != nil {
+			logger.Error("Failed to count organization unit groups", log.String("ouID", ouID))
+			return nil, progress, &serviceerror.InternalServerError
+		}
+		progress.TotalGroups += groups.TotalResults
+
+		for offset := 0; ; offset += serverconst.MaxPageSize {
+			children, svcErr := s.ouService.GetOrganizationUnitChildren(
+				ctx, ouID, serverconst.MaxPageSize, offset, nil)
+			if svcErr != nil {
+				logger.Error("Failed to list child organization units", log.String("ouID", ouID))
+				return nil, progress, &serviceerror.InternalServerError
+			}
+			for _, child := range children.OrganizationUnits {
+				ouIDs = append(ouIDs, child.ID)
+			}
+			if len(children.OrganizationUnits) == 0 || offset+len(children.OrganizationUnits) >= children.TotalResults {
+				break
+			}
+		}
+	}
+	progress.TotalOrganizationUnits = len(ouIDs)
+
+	// Breadth-first order lists parents before children; deletion needs the reverse.
+	for i, j := 0, len(ouIDs)-1; i < j; i, j = i+1, j-1 {
+		ouIDs[i], ouIDs[j] = ouIDs[j], ouIDs[i]
+	}
+	return ouIDs, progress, nil
+}
+
+// runJob deletes the planned organization units and records the outcome of the job.
+func (s *ouDeletionService) runJob(ctx context.Context, jobID string, progress JobProgress, ouIDs []string) {
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName),
+		log.String("jobID", jobID))
+	defer s.notifyWebhook(ctx, jobID)
+
+	started, err := s.jobStore.UpdateStatus(ctx, jobID, JobStatusPending, JobStatusRunning, "")
+	if err != nil {
+		logger.Error("Failed to start deletion job", log.Error(err))
+		return
+	}
+	if !started {
+		logger.Debug("Deletion job was cancelled before it started")
+		return
+	}
+
+	if err := s.deleteOrganizationUnits(ctx, jobID, &progress, ouIDs); err != nil {
+		if errors.Is(err, errJobCancelled) {
+			logger.Debug("Deletion job was cancelled")
+			return
+		}
+		logger.Error("Deletion job failed", log.Error(err))
+		if _, err := s.jobStore.UpdateStatus(
+			ctx, jobID, JobStatusRunning, JobStatusFailed, err.Error()); err != nil {
+			logger.Error("Failed to mark deletion job as failed", log.Error(err))
+		}
+		return
+	}
+
+	if _, err := s.jobStore.UpdateStatus(ctx, jobID, JobStatusRunning, JobStatusCompleted, ""); err != nil {
+		logger.Error("Failed to mark deletion job as completed", log.Error(err))
+		return
+	}
+	logger.Debug("Deletion job completed")
+}
+
+// deleteOrganizationUnits deletes the users, groups and finally each of the given organization units.
+func (s *ouDeletionService) deleteOrganizationUnits(
+	ctx context.Context, jobID string, progress *JobProgress, ouIDs []string,
+) error {
+	for _, ouID := range ouIDs {
+		if err := s.deleteUsers(ctx, jobID, progress, ouID); err != nil {
+			return err
+		}
+		if err := s.deleteGroups(ctx, jobID, progress, ouID); err != nil {
+			return err
+		}
+
+		if svcErr := s.ouService.DeleteOrganizationUnit(ctx, ouID); svcErr != nil &&
+			svcErr.Code != ou.ErrorOrganizationUnitNotFound.Code {
+			return fmt.Errorf("failed to delete organization unit %s: %s", ouID, svcErr.ErrorDescription.DefaultValue)
+		}
+		progress.DeletedOrganizationUnits++
+		if err := s.checkpoint(ctx, jobID, *progress); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// deleteUsers deletes the users of an organization unit chunk by chunk.
+func (s *ouDeletionService) deleteUsers(
+	ctx context.Context, jobID string, progress *JobProgress, ouID string,
+) error {
+	for {
+		users, svcErr := s.ouService.GetOrganizationUnitUsers(ctx, ouID, s.chunkSize, 0, false)
+		if svcErr != nil {
+			return fmt.Errorf("failed to list users of organization unit %s: %s",
+				ouID, svcErr.ErrorDescription.DefaultValue)
+		}
+		if len(users.Users) == 0 {
+			return nil
+		}
+
+		for _, u := range users.Users {
+			if err := s.deleteUser(ctx, u.ID); err != nil {
+				return err
+			}
+			progress.DeletedUsers++
+		}
+		if err := s.checkpoint(ctx, jobID, *progress); err != nil {
+			return err
+		}
+	}
+}
+
+// deleteUser removes the role assignments and group memberships of a user and permanently deletes the user.
+func (s *ouDeletionService) deleteUser(ctx context.Context, userID string) error {
+	if svcErr := s.roleAssignmentService.RemoveAssigneeFromAllRoles(ctx,
+		role.RoleAssignment{ID: userID, Type: role.AssigneeTypeUser}); svcErr != nil {
+		return fmt.Errorf("failed to remove role assignments of user %s: %s",
+			userID, svcErr.ErrorDescription.DefaultValue)
+	}
+	if svcErr := s.groupService.RemoveMemberFromAllGroups(ctx,
+		group.Member{ID: userID, Type: group.MemberTypeUser}); svcErr != nil {
+		return fmt.Errorf("failed to remove group memberships of user %s: %s",
+			userID, svcErr.ErrorDescription.DefaultValue)
+	}
+	if svcErr := s.userService.PurgeUser(ctx, userID); svcErr != nil &&
+		svcErr.Code != user.ErrorUserNotFound.Code {
+		return fmt.Errorf("failed to delete user %s: %s", userID, svcErr.ErrorDescription.DefaultValue)
+	}
+	return nil
+}
+
+// deleteGroups deletes the groups of an organization unit chunk by chunk.
+func (s *ouDeletionService) deleteGroups(
+	ctx context.Context, jobID string, progress *JobProgress, ouID string,
+) error {
+	for {
+		groups, svcErr := s.ouService.GetOrganizationUnitGroups(ctx, ouID, s.chunkSize, 0)
+		if svcErr != nil {
+			return fmt.Errorf("failed to list groups of organization unit %s: %s",
+				ouID, svcErr.ErrorDescription.DefaultValue)
+		}
+		if len(groups.Groups) == 0 {
+			return nil
+		}
+
+		for _, g := range groups.Groups {
+			if err := s.deleteGroup(ctx, g.ID); err != nil {
+				return err
+			}
+			progress.DeletedGroups++
+		}
+		if err := s.checkpoint(ctx, jobID, *progress); err != nil {
+			return err
+		}
+	}
+}
+
+// deleteGroup removes the role assignments and parent group memberships of a group and deletes the group.
+func (s *ouDeletionService) deleteGroup(ctx context.Context, groupID string) error {
+	if svcErr := s.roleAssignmentService.RemoveAssigneeFromAllRoles(ctx,
+		role.RoleAssignment{ID: groupID, Type: role.AssigneeTypeGroup}); svcErr != nil {
+		return fmt.Errorf("failed to remove role assignments of group %s: %s",
+			groupID, svcErr.ErrorDescription.DefaultValue)
+	}
+	if svcErr := s.groupService.RemoveMemberFromAllGroups(ctx,
+		group.Member{ID: groupID, Type: group.MemberTypeGroup}); svcErr != nil {
+		return fmt.Errorf("failed to remove group memberships of group %s: %s",
+			groupID, svcErr.ErrorDescription.DefaultValue)
+	}
+	if svcErr := s.groupService.DeleteGroup(ctx, groupID); svcErr != nil &&
+		svcErr.Code != group.ErrorGroupNotFound.Code {
+		return fmt.Errorf("failed to delete group %s: %s", groupID, svcErr.ErrorDescription.DefaultValue)
+	}
+	return nil
+}
+
+// checkpoint persists the job progress and reports errJobCancelled when the job was cancelled.
+func (s *ouDeletionService) checkpoint(ctx context.Context, jobID string, progress JobProgress) error {
+	if err := s.jobStore.UpdateProgress(ctx, jobID, progress); err != nil {
+		return fmt.Errorf("failed to persist job progress: %w", err)
+	}
+
+	job, err := s.jobStore.GetJob(ctx, jobID)
+	if err != nil {
+		return fmt.Errorf("failed to retrieve job status: %w", err)
+	}
+	if job.Status == JobStatusCancelled {
+		return errJobCancelled
+	}
+	return nil
+}
+
+// notifyWebhook posts the final state of a job to the configured webhook, if any.
+func (s *ouDeletionService) notifyWebhook(ctx context.Context, jobID string) {
+	if s.webhookURL == "" {
+		return
+	}
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName),
+		log.String("jobID", jobID))
+
+	job, err := s.jobStore.GetJob(ctx, jobID)
+	if err != nil {
+		logger.Error("Failed to retrieve deletion job for webhook notification", log.Error(err))
+		return
+	}
+	body, err := json.Marshal(deletionWebhookEvent{Event: webhookEventJobFinished, Job: *job})
+	if err != nil {
+		logger.Error("Failed to marshal webhook payload", log.Error(err))
+		return
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.webhookURL, bytes.NewReader(body))
+	if err != nil {
+		logger.Error("Failed to create webhook request", log.Error(err))
+		return
+	}
+	req.Header.Set(serverconst.ContentTypeHeaderName, serverconst.ContentTypeJSON)
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		logger.Warn("Failed to deliver deletion job webhook", log.Error(err))
+		return
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		logger.Warn("Deletion job webhook returned an unexpected status", log.Int("status", resp.StatusCode))
+	}
+}
+
+// checkAccess validates that the caller is authorized to perform the action on the organization unit.
+func (s *ouDeletionService) checkAccess(
+	ctx context.Context, action security.Action, ouID string,
+) *serviceerror.ServiceError {
+	allowed, svcErr := s.authzService.IsActionAllowed(ctx, action,
+		&sysauthz.ActionContext{ResourceType: security.ResourceTypeOU, OUID: ouID})
+	if svcErr != nil {
+		return &serviceerror.InternalServerError
+	}
+	if !allowed {
+		return &serviceerror.ErrorUnauthorized
+	}
+	return nil
+}
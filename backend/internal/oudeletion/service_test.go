@@ -0,0 +1,399 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package oudeletion
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/group"
+	"github.com/thunder-id/thunderid/internal/ou"
+	"github.com/thunder-id/thunderid/internal/role"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/security"
+	"github.com/thunder-id/thunderid/internal/system/sysauthz"
+	"github.com/thunder-id/thunderid/internal/user"
+	"github.com/thunder-id/thunderid/tests/mocks/groupmock"
+	"github.com/thunder-id/thunderid/tests/mocks/httpmock"
+	"github.com/thunder-id/thunderid/tests/mocks/oumock"
+	"github.com/thunder-id/thunderid/tests/mocks/rolemock"
+	"github.com/thunder-id/thunderid/tests/mocks/sysauthzmock"
+	"github.com/thunder-id/thunderid/tests/mocks/usermock"
+)
+
+const (
+	testRootOUID  = "ou-root"
+	testChildOUID = "ou-child"
+	testJobID     = "job-1"
+	testWebhook   = "https://hooks.example.com/ou-deletion"
+)
+
+type OUDeletionServiceTestSuite struct {
+	suite.Suite
+	mockStore       *deletionJobStoreInterfaceMock
+	mockOUService   *oumock.OrganizationUnitServiceInterfaceMock
+	mockUserService *usermock.UserServiceInterfaceMock
+	mockGroup       *groupmock.GroupServiceInterfaceMock
+	mockRole        *rolemock.RoleAssignmentServiceInterfaceMock
+	mockAuthz       *sysauthzmock.SystemAuthorizationServiceInterfaceMock
+	mockHTTPClient  *httpmock.HTTPClientInterfaceMock
+	service         *ouDeletionService
+}
+
+func TestOUDeletionServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(OUDeletionServiceTestSuite))
+}
+
+func (suite *OUDeletionServiceTestSuite) SetupTest() {
+	suite.mockStore = newDeletionJobStoreInterfaceMock(suite.T())
+	suite.mockOUService = oumock.NewOrganizationUnitServiceInterfaceMock(suite.T())
+	suite.mockUserService = usermock.NewUserServiceInterfaceMock(suite.T())
+	suite.mockGroup = groupmock.NewGroupServiceInterfaceMock(suite.T())
+	suite.mockRole = rolemock.NewRoleAssignmentServiceInterfaceMock(suite.T())
+	suite.mockAuthz = sysauthzmock.NewSystemAuthorizationServiceInterfaceMock(suite.T())
+	suite.mockHTTPClient = httpmock.NewHTTPClientInterfaceMock(suite.T())
+	suite.service = newOUDeletionService(suite.mockStore, suite.mockOUService, suite.mockUserService,
+		suite.mockGroup, suite.mockRole, suite.mockAuthz, suite.mockHTTPClient, 2, 0, "").(*ouDeletionService)
+	suite.service.runAsync = func(f func()) { f() }
+}
+
+func (suite *OUDeletionServiceTestSuite) allowAction(action security.Action, ouID string) {
+	suite.mockAuthz.On("IsActionAllowed", mock.Anything, action,
+		&sysauthz.ActionContext{ResourceType: security.ResourceTypeOU, OUID: ouID}).
+		Return(true, (*serviceerror.ServiceError)(nil)).Once()
+}
+
+// expectPlan sets up a subtree of a root organization unit with a single child.
+func (suite *OUDeletionServiceTestSuite) expectPlan() {
+	suite.mockOUService.On("IsOrganizationUnitDeclarative", mock.Anything, mock.Anything).Return(false)
+	suite.mockOUService.On("GetOrganizationUnitUsers", mock.Anything, testRootOUID, 1, 0, false).
+		Return(&ou.UserListResponse{TotalResults: 0}, (*serviceerror.ServiceError)(nil)).Once()
+	suite.mockOUService.On("GetOrganizationUnitUsers", mock.Anything, testChildOUID, 1, 0, false).
+		Return(&ou.UserListResponse{TotalResults: 3}, (*serviceerror.ServiceError)(nil)).Once()
+	suite.mockOUService.On("GetOrganizationUnitGroups", mock.Anything, testRootOUID, 1, 0).
+		Return(&ou.GroupListResponse{TotalResults: 1}, (*serviceerror.ServiceError)(nil)).Once()
+	suite.mockOUService.On("GetOrganizationUnitGroups", mock.Anything, testChildOUID, 1, 0).
+		Return(&ou.GroupListResponse{TotalResults: 0}, (*serviceerror.ServiceError)(nil)).Once()
+	suite.mockOUService.On("GetOrganizationUnitChildren", mock.Anything, testRootOUID, 100, 0,
+		mock.Anything).Return(&ou.OrganizationUnitListResponse{
+		TotalResults:      1,
+		OrganizationUnits: []ou.OrganizationUnitBasic{{ID: testChildOUID}},
+	}, (*serviceerror.ServiceError)(nil)).Once()
+	suite.mockOUService.On("GetOrganizationUnitChildren", mock.Anything, testChildOUID, 100, 0,
+		mock.Anything).Return(&ou.OrganizationUnitListResponse{}, (*serviceerror.ServiceError)(nil)).Once()
+}
+
+func (suite *OUDeletionServiceTestSuite) expectUserDeleted(userID string, deleteErr *serviceerror.ServiceError) {
+	suite.mockRole.On("RemoveAssigneeFromAllRoles", mock.Anything,
+		role.RoleAssignment{ID: userID, Type: role.AssigneeTypeUser}).Return(nil).Once()
+	suite.mockGroup.On("RemoveMemberFromAllGroups", mock.Anything,
+		group.Member{ID: userID, Type: group.MemberTypeUser}).Return(nil).Once()
+	suite.mockUserService.On("PurgeUser", mock.Anything, userID).Return(deleteErr).Once()
+}
+
+func (suite *OUDeletionServiceTestSuite) expectCheckpoint(status JobStatus) {
+	suite.mockStore.On("UpdateProgress", mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+	suite.mockStore.On("GetJob", mock.Anything, mock.Anything).
+		Return(&DeletionJob{ID: testJobID, Status: status}, nil).Once()
+}
+
+func (suite *OUDeletionServiceTestSuite) TestStartDeletion_MissingOUID() {
+	job, err := suite.service.StartDeletion(context.Background(), "")
+
+	suite.Nil(job)
+	suite.Equal(ErrorMissingOUID.Code, err.Code)
+}
+
+func (suite *OUDeletionServiceTestSuite) TestStartDeletion_Unauthorized() {
+	suite.mockAuthz.On("IsActionAllowed", mock.Anything, security.ActionDeleteOU, mock.Anything).
+		Return(false, (*serviceerror.ServiceError)(nil)).Once()
+
+	job, err := suite.service.StartDeletion(context.Background(), testRootOUID)
+
+	suite.Nil(job)
+	suite.Equal(serviceerror.ErrorUnauthorized.Code, err.Code)
+}
+
+func (suite *OUDeletionServiceTestSuite) TestStartDeletion_OUNotFound() {
+	suite.allowAction(security.ActionDeleteOU, testRootOUID)
+	suite.mockOUService.On("IsOrganizationUnitExists", mock.Anything, testRootOUID).
+		Return(false, (*serviceerror.ServiceError)(nil)).Once()
+
+	job, err := suite.service.StartDeletion(context.Background(), testRootOUID)
+
+	suite.Nil(job)
+	suite.Equal(ErrorOrganizationUnitNotFound.Code, err.Code)
+}
+
+func (suite *OUDeletionServiceTestSuite) TestStartDeletion_ActiveJobConflict() {
+	suite.allowAction(security.ActionDeleteOU, testRootOUID)
+	suite.mockOUService.On("IsOrganizationUnitExists", mock.Anything, testRootOUID).
+		Return(true, (*serviceerror.ServiceError)(nil)).Once()
+	suite.mockStore.On("GetActiveJobByOUID", mock.Anything, testRootOUID).
+		Return(&DeletionJob{ID: "other", Status: JobStatusRunning, UpdatedAt: time.Now().UTC()}, nil).Once()
+
+	job, err := suite.service.StartDeletion(context.Background(), testRootOUID)
+
+	suite.Nil(job)
+	suite.Equal(ErrorDeletionJobConflict.Code, err.Code)
+}
+
+func (suite *OUDeletionServiceTestSuite) TestStartDeletion_DeclarativeSubtree() {
+	suite.allowAction(security.ActionDeleteOU, testRootOUID)
+	suite.mockOUService.On("IsOrganizationUnitExists", mock.Anything, testRootOUID).
+		Return(true, (*serviceerror.ServiceError)(nil)).Once()
+	suite.mockStore.On("GetActiveJobByOUID", mock.Anything, testRootOUID).
+		Return(nil, ErrDeletionJobNotFound).Once()
+	suite.mockOUService.On("IsOrganizationUnitDeclarative", mock.Anything, testRootOUID).Return(true).Once()
+
+	job, err := suite.service.StartDeletion(context.Background(), testRootOUID)
+
+	suite.Nil(job)
+	suite.Equal(ErrorDeclarativeResource.Code, err.Code)
+}
+
+func (suite *OUDeletionServiceTestSuite) TestStartDeletion_CreateJobError() {
+	suite.allowAction(security.ActionDeleteOU, testRootOUID)
+	suite.mockOUService.On("IsOrganizationUnitExists", mock.Anything, testRootOUID).
+		Return(true, (*serviceerror.ServiceError)(nil)).Once()
+	suite.mockStore.On("GetActiveJobByOUID", mock.Anything, testRootOUID).
+		Return(nil, ErrDeletionJobNotFound).Once()
+	suite.expectPlan()
+	suite.mockStore.On("CreateJob", mock.Anything, mock.Anything, mock.Anything).
+		Return(errors.New("db error")).Once()
+
+	job, err := suite.service.StartDeletion(context.Background(), testRootOUID)
+
+	suite.Nil(job)
+	suite.Equal(serviceerror.InternalServerError.Code, err.Code)
+}
+
+func (suite *OUDeletionServiceTestSuite) TestStartDeletion_ReleasesStaleJobAndDeletesSubtree() {
+	suite.service.webhookURL = testWebhook
+	suite.allowAction(security.ActionDeleteOU, testRootOUID)
+	suite.mockOUService.On("IsOrganizationUnitExists", mock.Anything, testRootOUID).
+		Return(true, (*serviceerror.ServiceError)(nil)).Once()
+	suite.mockStore.On("GetActiveJobByOUID", mock.Anything, testRootOUID).
+		Return(&DeletionJob{ID: "stale", Status: JobStatusRunning,
+			UpdatedAt: time.Now().UTC().Add(-time.Hour)}, nil).Once()
+	suite.mockStore.On("UpdateStatus", mock.Anything, "stale", JobStatusRunning, JobStatusFailed,
+		mock.Anything).Return(true, nil).Once()
+	suite.expectPlan()
+
+	var createdJob DeletionJob
+	suite.mockStore.On("CreateJob", mock.Anything, mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			createdJob = args.Get(1).(DeletionJob)
+		}).Return(nil).Once()
+	suite.mockStore.On("UpdateStatus", mock.Anything, mock.Anything, JobStatusPending, JobStatusRunning, "").
+		Return(true, nil).Once()
+
+	// The child organization unit is processed first: three users in two chunks.
+	suite.mockOUService.On("GetOrganizationUnitUsers", mock.Anything, testChildOUID, 2, 0, false).
+		Return(&ou.UserListResponse{Users: []ou.User{{ID: "u1"}, {ID: "u2"}}},
+			(*serviceerror.ServiceError)(nil)).Once()
+	suite.mockOUService.On("GetOrganizationUnitUsers", mock.Anything, testChildOUID, 2, 0, false).
+		Return(&ou.UserListResponse{Users: []ou.User{{ID: "u3"}}}, (*serviceerror.ServiceError)(nil)).Once()
+	suite.mockOUService.On("GetOrganizationUnitUsers", mock.Anything, testChildOUID, 2, 0, false).
+		Return(&ou.UserListResponse{}, (*serviceerror.ServiceError)(nil)).Once()
+	suite.expectUserDeleted("u1", nil)
+	suite.expectUserDeleted("u2", nil)
+	// A user deleted concurrently is treated as deleted.
+	suite.expectUserDeleted("u3", &user.ErrorUserNotFound)
+	suite.mockOUService.On("GetOrganizationUnitGroups", mock.Anything, testChildOUID, 2, 0).
+		Return(&ou.GroupListResponse{}, (*serviceerror.ServiceError)(nil)).Once()
+	suite.mockOUService.On("DeleteOrganizationUnit", mock.Anything, testChildOUID).
+		Return((*serviceerror.ServiceError)(nil)).Once()
+
+	// The root organization unit has a single group.
+	suite.mockOUService.On("GetOrganizationUnitUsers", mock.Anything, testRootOUID, 2, 0, false).
+		Return(&ou.UserListResponse{}, (*serviceerror.ServiceError)(nil)).Once()
+	suite.mockOUService.On("GetOrganizationUnitGroups", mock.Anything, testRootOUID, 2, 0).
+		Return(&ou.GroupListResponse{Groups: []ou.Group{{ID: "g1"}}}, (*serviceerror.ServiceError)(nil)).Once()
+	suite.mockOUService.On("GetOrganizationUnitGroups", mock.Anything, testRootOUID, 2, 0).
+		Return(&ou.GroupListResponse{}, (*serviceerror.ServiceError)(nil)).Once()
+	suite.mockRole.On("RemoveAssigneeFromAllRoles", mock.Anything,
+		role.RoleAssignment{ID: "g1", Type: role.AssigneeTypeGroup}).Return(nil).Once()
+	suite.mockGroup.On("RemoveMemberFromAllGroups", mock.Anything,
+		group.Member{ID: "g1", Type: group.MemberTypeGroup}).Return(nil).Once()
+	suite.mockGroup.On("DeleteGroup", mock.Anything, "g1").Return(nil).Once()
+	suite.mockOUService.On("DeleteOrganizationUnit", mock.Anything, testRootOUID).
+		Return(&ou.ErrorOrganizationUnitNotFound).Once()
+
+	// Two user chunks, one group chunk and two organization units.
+	for i := 0; i < 5; i++ {
+		suite.expectCheckpoint(JobStatusRunning)
+	}
+	suite.mockStore.On("UpdateStatus", mock.Anything, mock.Anything, JobStatusRunning, JobStatusCompleted, "").
+		Return(true, nil).Once()
+	suite.mockStore.On("GetJob", mock.Anything, mock.Anything).
+		Return(&DeletionJob{ID: testJobID, OUID: testRootOUID, Status: JobStatusCompleted}, nil).Once()
+
+	var payload deletionWebhookEvent
+	suite.mockHTTPClient.On("Do", mock.MatchedBy(func(req *http.Request) bool {
+		body, _ := io.ReadAll(req.Body)
+		return req.URL.String() == testWebhook && json.Unmarshal(body, &payload) == nil
+	})).Return(&http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(""))}, nil).Once()
+
+	job, err := suite.service.StartDeletion(context.Background(), testRootOUID)
+
+	suite.Nil(err)
+	suite.Equal(JobStatusPending, job.Status)
+	suite.Equal(testRootOUID, job.OUID)
+	suite.Equal(JobProgress{TotalUsers: 3, TotalGroups: 1, TotalOrganizationUnits: 2}, job.Progress)
+	suite.Equal(job.ID, createdJob.ID)
+	suite.Equal(webhookEventJobFinished, payload.Event)
+	suite.Equal(JobStatusCompleted, payload.Job.Status)
+}
+
+func (suite *OUDeletionServiceTestSuite) TestRunJob_CancelledBeforeStart() {
+	suite.mockStore.On("UpdateStatus", mock.Anything, testJobID, JobStatusPending, JobStatusRunning, "").
+		Return(false, nil).Once()
+
+	suite.service.runJob(context.Background(), testJobID, JobProgress{}, []string{testRootOUID})
+
+	suite.mockOUService.AssertNotCalled(suite.T(), "DeleteOrganizationUnit", mock.Anything, mock.Anything)
+}
+
+func (suite *OUDeletionServiceTestSuite) TestRunJob_CancelledBetweenChunks() {
+	suite.mockStore.On("UpdateStatus", mock.Anything, testJobID, JobStatusPending, JobStatusRunning, "").
+		Return(true, nil).Once()
+	suite.mockOUService.On("GetOrganizationUnitUsers", mock.Anything, testRootOUID, 2, 0, false).
+		Return(&ou.UserListResponse{Users: []ou.User{{ID: "u1"}}}, (*serviceerror.ServiceError)(nil)).Once()
+	suite.expectUserDeleted("u1", nil)
+	suite.expectCheckpoint(JobStatusCancelled)
+
+	suite.service.runJob(context.Background(), testJobID, JobProgress{}, []string{testRootOUID})
+
+	suite.mockOUService.AssertNotCalled(suite.T(), "DeleteOrganizationUnit", mock.Anything, mock.Anything)
+}
+
+func (suite *OUDeletionServiceTestSuite) TestRunJob_FailsWhenUserCannotBeDeleted() {
+	suite.mockStore.On("UpdateStatus", mock.Anything, testJobID, JobStatusPending, JobStatusRunning, "").
+		Return(true, nil).Once()
+	suite.mockOUService.On("GetOrganizationUnitUsers", mock.Anything, testRootOUID, 2, 0, false).
+		Return(&ou.UserListResponse{Users: []ou.User{{ID: "u1"}}}, (*serviceerror.ServiceError)(nil)).Once()
+	suite.mockRole.On("RemoveAssigneeFromAllRoles", mock.Anything, mock.Anything).Return(nil).Once()
+	suite.mockGroup.On("RemoveMemberFromAllGroups", mock.Anything, mock.Anything).Return(nil).Once()
+	suite.mockUserService.On("PurgeUser", mock.Anything, "u1").
+		Return(&serviceerror.InternalServerError).Once()
+	suite.mockStore.On("UpdateStatus", mock.Anything, testJobID, JobStatusRunning, JobStatusFailed,
+		mock.MatchedBy(func(reason string) bool { return strings.Contains(reason, "failed to delete user u1") })).
+		Return(true, nil).Once()
+
+	suite.service.runJob(context.Background(), testJobID, JobProgress{}, []string{testRootOUID})
+}
+
+func (suite *OUDeletionServiceTestSuite) TestGetDeletionJob() {
+	suite.Run("not found", func() {
+		suite.SetupTest()
+		suite.mockStore.On("GetJob", mock.Anything, testJobID).Return(nil, ErrDeletionJobNotFound).Once()
+
+		job, err := suite.service.GetDeletionJob(context.Background(), testJobID)
+
+		suite.Nil(job)
+		suite.Equal(ErrorDeletionJobNotFound.Code, err.Code)
+	})
+
+	suite.Run("store error", func() {
+		suite.SetupTest()
+		suite.mockStore.On("GetJob", mock.Anything, testJobID).Return(nil, errors.New("db error")).Once()
+
+		job, err := suite.service.GetDeletionJob(context.Background(), testJobID)
+
+		suite.Nil(job)
+		suite.Equal(serviceerror.InternalServerError.Code, err.Code)
+	})
+
+	suite.Run("success", func() {
+		suite.SetupTest()
+		suite.mockStore.On("GetJob", mock.Anything, testJobID).
+			Return(&DeletionJob{ID: testJobID, OUID: testRootOUID, Status: JobStatusRunning}, nil).Once()
+		suite.allowAction(security.ActionReadOU, testRootOUID)
+
+		job, err := suite.service.GetDeletionJob(context.Background(), testJobID)
+
+		suite.Nil(err)
+		suite.Equal(JobStatusRunning, job.Status)
+	})
+}
+
+func (suite *OUDeletionServiceTestSuite) TestCancelDeletion() {
+	suite.Run("already finished", func() {
+		suite.SetupTest()
+		suite.mockStore.On("GetJob", mock.Anything, testJobID).
+			Return(&DeletionJob{ID: testJobID, OUID: testRootOUID, Status: JobStatusCompleted}, nil).Once()
+		suite.allowAction(security.ActionDeleteOU, testRootOUID)
+
+		job, err := suite.service.CancelDeletion(context.Background(), testJobID)
+
+		suite.Nil(job)
+		suite.Equal(ErrorJobNotCancellable.Code, err.Code)
+	})
+
+	suite.Run("finished concurrently", func() {
+		suite.SetupTest()
+		suite.mockStore.On("GetJob", mock.Anything, testJobID).
+			Return(&DeletionJob{ID: testJobID, OUID: testRootOUID, Status: JobStatusRunning}, nil).Once()
+		suite.allowAction(security.ActionDeleteOU, testRootOUID)
+		suite.mockStore.On("UpdateStatus", mock.Anything, testJobID, mock.Anything, JobStatusCancelled, "").
+			Return(false, nil).Twice()
+
+		job, err := suite.service.CancelDeletion(context.Background(), testJobID)
+
+		suite.Nil(job)
+		suite.Equal(ErrorJobNotCancellable.Code, err.Code)
+	})
+
+	suite.Run("success", func() {
+		suite.SetupTest()
+		suite.mockStore.On("GetJob", mock.Anything, testJobID).
+			Return(&DeletionJob{ID: testJobID, OUID: testRootOUID, Status: JobStatusRunning}, nil).Once()
+		suite.allowAction(security.ActionDeleteOU, testRootOUID)
+		suite.mockStore.On("UpdateStatus", mock.Anything, testJobID, JobStatusPending, JobStatusCancelled, "").
+			Return(false, nil).Once()
+		suite.mockStore.On("UpdateStatus", mock.Anything, testJobID, JobStatusRunning, JobStatusCancelled, "").
+			Return(true, nil).Once()
+		suite.mockStore.On("GetJob", mock.Anything, testJobID).
+			Return(&DeletionJob{ID: testJobID, OUID: testRootOUID, Status: JobStatusCancelled}, nil).Once()
+
+		job, err := suite.service.CancelDeletion(context.Background(), testJobID)
+
+		suite.Nil(err)
+		suite.Equal(JobStatusCancelled, job.Status)
+	})
+}
+
+func (suite *OUDeletionServiceTestSuite) TestNotifyWebhook_DeliveryFailureIsIgnored() {
+	suite.service.webhookURL = testWebhook
+	suite.mockStore.On("GetJob", mock.Anything, testJobID).
+		Return(&DeletionJob{ID: testJobID, Status: JobStatusFailed}, nil).Once()
+	suite.mockHTTPClient.On("Do", mock.Anything).Return(nil, errors.New("connection refused")).Once()
+
+	suite.service.notifyWebhook(context.Background(), testJobID)
+}
@@ -0,0 +1,200 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package oudeletion
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+	sysContext "github.com/thunder-id/thunderid/internal/system/context"
+	dbmodel "github.com/thunder-id/thunderid/internal/system/database/model"
+	"github.com/thunder-id/thunderid/internal/system/database/provider"
+	dbutils "github.com/thunder-id/thunderid/internal/system/database/utils"
+)
+
+var getDBProvider = provider.GetDBProvider
+
+// deletionJobStoreInterface defines the interface for deletion job store operations.
+type deletionJobStoreInterface interface {
+	CreateJob(ctx context.Context, job DeletionJob, expiryTime time.Time) error
+	GetJob(ctx context.Context, id string) (*DeletionJob, error)
+	GetActiveJobByOUID(ctx context.Context, ouID string) (*DeletionJob, error)
+	UpdateProgress(ctx context.Context, id string, progress JobProgress) error
+	UpdateStatus(ctx context.Context, id string, from, to JobStatus, failureReason string) (bool, error)
+}
+
+// deletionJobStore is the runtime database backed implementation of deletionJobStoreInterface.
+type deletionJobStore struct {
+	dbProvider   provider.DBProviderInterface
+	deploymentID string
+}
+
+// newDeletionJobStore creates a new instance of deletionJobStore.
+func newDeletionJobStore() deletionJobStoreInterface {
+	return &deletionJobStore{
+		dbProvider:   getDBProvider(),
+		deploymentID: config.GetServerRuntime().Config.Server.Identifier,
+	}
+}
+
+// CreateJob persists a new deletion job.
+func (s *deletionJobStore) CreateJob(ctx context.Context, job DeletionJob, expiryTime time.Time) error {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
+	dbClient, err := s.dbProvider.GetRuntimeDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	progress, err := json.Marshal(job.Progress)
+	if err != nil {
+		return fmt.Errorf("failed to marshal job progress: %w", err)
+	}
+
+	if _, err := dbClient.ExecuteContext(ctx, queryCreateDeletionJob, job.ID, job.OUID, string(job.Status),
+		string(progress), job.CreatedAt, job.UpdatedAt, expiryTime, deploymentID); err != nil {
+		return fmt.Errorf("failed to execute query: %w", err)
+	}
+	return nil
+}
+
+// GetJob retrieves a deletion job by its ID.
+func (s *deletionJobStore) GetJob(ctx context.Context, id string) (*DeletionJob, error) {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
+	return s.getJob(ctx, queryGetDeletionJob, id, deploymentID)
+}
+
+// GetActiveJobByOUID retrieves the pending or running deletion job of an organization unit.
+func (s *deletionJobStore) GetActiveJobByOUID(ctx context.Context, ouID string) (*DeletionJob, error) {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
+	return s.getJob(ctx, queryGetActiveDeletionJobByOUID, ouID,
+		string(JobStatusPending), string(JobStatusRunning), deploymentID)
+}
+
+// UpdateProgress persists the progress counters of a deletion job.
+func (s *deletionJobStore) UpdateProgress(ctx context.Context, id string, progress JobProgress) error {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
+	dbClient, err := s.dbProvider.GetRuntimeDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	progressJSON, err := json.Marshal(progress)
+	if err != nil {
+		return fmt.Errorf("failed to marshal job progress: %w", err)
+	}
+
+	if _, err := dbClient.ExecuteContext(ctx, queryUpdateDeletionJobProgress, string(progressJSON),
+		time.Now().UTC(), id, deploymentID); err != nil {
+		return fmt.Errorf("failed to execute query: %w", err)
+	}
+	return nil
+}
+
+// UpdateStatus moves a deletion job to a new status if it is still in the expected status.
+// It reports whether the transition was applied.
+func (s *deletionJobStore) UpdateStatus(
+	ctx context.Context, id string, from, to JobStatus, failureReason string,
+) (bool, error) {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
+	dbClient, err := s.dbProvider.GetRuntimeDBClient()
+	if err != nil {
+		return false, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	rowsAffected, err := dbClient.ExecuteContext(ctx, queryUpdateDeletionJobStatus, string(to), failureReason,
+		time.Now().UTC(), id, string(from), deploymentID)
+	if err != nil {
+		return false, fmt.Errorf("failed to execute query: %w", err)
+	}
+	return rowsAffected > 0, nil
+}
+
+// getJob retrieves a single deletion job using the given query.
+func (s *deletionJobStore) getJob(
+	ctx context.Context, query dbmodel.DBQuery, args ...interface{},
+) (*DeletionJob, error) {
+	dbClient, err := s.dbProvider.GetRuntimeDBClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	results, err := dbClient.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+	if len(results) == 0 {
+		return nil, ErrDeletionJobNotFound
+	}
+
+	return buildDeletionJobFromResultRow(results[0])
+}
+
+// buildDeletionJobFromResultRow constructs a DeletionJob from a database result row.
+func buildDeletionJobFromResultRow(row map[string]interface{}) (*DeletionJob, error) {
+	id, ok := row["id"].(string)
+	if !ok {
+		return nil, fmt.Errorf("failed to parse id as string")
+	}
+	ouID, ok := row["ou_id"].(string)
+	if !ok {
+		return nil, fmt.Errorf("failed to parse ou_id as string")
+	}
+	status, ok := row["status"].(string)
+	if !ok {
+		return nil, fmt.Errorf("failed to parse status as string")
+	}
+
+	var progressJSON []byte
+	switch v := row["progress"].(type) {
+	case string:
+		progressJSON = []byte(v)
+	case []byte:
+		progressJSON = v
+	default:
+		return nil, fmt.Errorf("failed to parse progress")
+	}
+	var progress JobProgress
+	if err := json.Unmarshal(progressJSON, &progress); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal job progress: %w", err)
+	}
+
+	failureReason, _ := row["failure_reason"].(string)
+
+	createdAt, err := dbutils.ParseTimeField(row["created_at"], "created_at")
+	if err != nil {
+		return nil, err
+	}
+	updatedAt, err := dbutils.ParseTimeField(row["updated_at"], "updated_at")
+	if err != nil {
+		return nil, err
+	}
+
+	return &DeletionJob{
+		ID:            id,
+		OUID:          ouID,
+		Status:        JobStatus(status),
+		Progress:      progress,
+		FailureReason: failureReason,
+		CreatedAt:     createdAt,
+		UpdatedAt:     updatedAt,
+	}, nil
+}
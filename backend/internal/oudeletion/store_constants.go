@@ -0,0 +1,58 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package oudeletion
+
+import dbmodel "github.com/thunder-id/thunderid/internal/system/database/model"
+
+var (
+	// queryCreateDeletionJob inserts a new deletion job.
+	queryCreateDeletionJob = dbmodel.DBQuery{
+		ID: "OUDQ-JOB_MGT-01",
+		Query: `INSERT INTO "OU_DELETION_JOB" (ID, OU_ID, STATUS, PROGRESS, CREATED_AT, UPDATED_AT, ` +
+			`EXPIRY_TIME, DEPLOYMENT_ID) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`,
+	}
+
+	// queryGetDeletionJob retrieves a deletion job by ID.
+	queryGetDeletionJob = dbmodel.DBQuery{
+		ID: "OUDQ-JOB_MGT-02",
+		Query: `SELECT ID, OU_ID, STATUS, PROGRESS, FAILURE_REASON, CREATED_AT, UPDATED_AT ` +
+			`FROM "OU_DELETION_JOB" WHERE ID = $1 AND DEPLOYMENT_ID = $2`,
+	}
+
+	// queryGetActiveDeletionJobByOUID retrieves the pending or running deletion job of an organization unit.
+	queryGetActiveDeletionJobByOUID = dbmodel.DBQuery{
+		ID: "OUDQ-JOB_MGT-03",
+		Query: `SELECT ID, OU_ID, STATUS, PROGRESS, FAILURE_REASON, CREATED_AT, UPDATED_AT ` +
+			`FROM "OU_DELETION_JOB" WHERE OU_ID = $1 AND STATUS IN ($2, $3) AND DEPLOYMENT_ID = $4 ` +
+			`ORDER BY CREATED_AT DESC LIMIT 1`,
+	}
+
+	// queryUpdateDeletionJobProgress updates the progress counters of a deletion job.
+	queryUpdateDeletionJobProgress = dbmodel.DBQuery{
+		ID:    "OUDQ-JOB_MGT-04",
+		Query: `UPDATE "OU_DELETION_JOB" SET PROGRESS = $1, UPDATED_AT = $2 WHERE ID = $3 AND DEPLOYMENT_ID = $4`,
+	}
+
+	// queryUpdateDeletionJobStatus moves a deletion job from an expected status to a new status.
+	queryUpdateDeletionJobStatus = dbmodel.DBQuery{
+		ID: "OUDQ-JOB_MGT-05",
+		Query: `UPDATE "OU_DELETION_JOB" SET STATUS = $1, FAILURE_REASON = $2, UPDATED_AT = $3 ` +
+			`WHERE ID = $4 AND STATUS = $5 AND DEPLOYMENT_ID = $6`,
+	}
+)
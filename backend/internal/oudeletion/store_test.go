@@ -0,0 +1,197 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package oudeletion
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	sysContext "github.com/thunder-id/thunderid/internal/system/context"
+	"github.com/thunder-id/thunderid/tests/mocks/database/providermock"
+)
+
+const testDeploymentID = "test-deployment-id"
+
+type DeletionJobStoreTestSuite struct {
+	suite.Suite
+	mockDBProvider *providermock.DBProviderInterfaceMock
+	mockDBClient   *providermock.DBClientInterfaceMock
+	store          *deletionJobStore
+	ctx            context.Context
+}
+
+func TestDeletionJobStoreTestSuite(t *testing.T) {
+	suite.Run(t, new(DeletionJobStoreTestSuite))
+}
+
+func (suite *DeletionJobStoreTestSuite) SetupTest() {
+	suite.mockDBProvider = providermock.NewDBProviderInterfaceMock(suite.T())
+	suite.mockDBClient = providermock.NewDBClientInterfaceMock(suite.T())
+	suite.store = &deletionJobStore{
+		dbProvider:   suite.mockDBProvider,
+		deploymentID: testDeploymentID,
+	}
+	suite.ctx = context.Background()
+}
+
+func jobRow() map[string]interface{} {
+	return map[string]interface{}{
+		"id":             "job-1",
+		"ou_id":          "ou-1",
+		"status":         "RUNNING",
+		"progress":       `{"totalUsers":10,"deletedUsers":4}`,
+		"failure_reason": nil,
+		"created_at":     "2026-01-02 03:04:05.123456",
+		"updated_at":     time.Date(2026, 1, 2, 3, 5, 0, 0, time.UTC),
+	}
+}
+
+func (suite *DeletionJobStoreTestSuite) TestCreateJob_Success() {
+	now := time.Now().UTC()
+	job := DeletionJob{ID: "job-1", OUID: "ou-1", Status: JobStatusPending,
+		Progress: JobProgress{TotalUsers: 10}, CreatedAt: now, UpdatedAt: now}
+	expiry := now.Add(time.Hour)
+
+	suite.mockDBProvider.On("GetRuntimeDBClient").Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.On("ExecuteContext", suite.ctx, queryCreateDeletionJob, "job-1", "ou-1", "PENDING",
+		`{"totalUsers":10,"deletedUsers":0,"totalGroups":0,"deletedGroups":0,"totalOrganizationUnits":0,`+
+			`"deletedOrganizationUnits":0}`, now, now, expiry, testDeploymentID).Return(int64(1), nil).Once()
+
+	suite.NoError(suite.store.CreateJob(suite.ctx, job, expiry))
+}
+
+func (suite *DeletionJobStoreTestSuite) TestCreateJob_DBClientError() {
+	suite.mockDBProvider.On("GetRuntimeDBClient").Return(nil, errors.New("db error")).Once()
+
+	err := suite.store.CreateJob(suite.ctx, DeletionJob{}, time.Now())
+
+	suite.ErrorContains(err, "failed to get database client")
+}
+
+func (suite *DeletionJobStoreTestSuite) TestGetJob_Success() {
+	suite.mockDBProvider.On("GetRuntimeDBClient").Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.On("QueryContext", suite.ctx, queryGetDeletionJob, "job-1", testDeploymentID).
+		Return([]map[string]interface{}{jobRow()}, nil).Once()
+
+	job, err := suite.store.GetJob(suite.ctx, "job-1")
+
+	suite.NoError(err)
+	suite.Equal("ou-1", job.OUID)
+	suite.Equal(JobStatusRunning, job.Status)
+	suite.Equal(JobProgress{TotalUsers: 10, DeletedUsers: 4}, job.Progress)
+	suite.Empty(job.FailureReason)
+	suite.Equal(2026, job.CreatedAt.Year())
+	suite.Equal(5, job.UpdatedAt.Minute())
+}
+
+func (suite *DeletionJobStoreTestSuite) TestGetJob_ScopedToTenant() {
+	ctx := sysContext.WithTenantID(suite.ctx, "tenant-1")
+	suite.mockDBProvider.On("GetRuntimeDBClient").Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.On("QueryContext", ctx, queryGetDeletionJob, "job-1", testDeploymentID+"/tenant-1").
+		Return([]map[string]interface{}{}, nil).Once()
+
+	job, err := suite.store.GetJob(ctx, "job-1")
+
+	suite.Nil(job)
+	suite.ErrorIs(err, ErrDeletionJobNotFound)
+}
+
+func (suite *DeletionJobStoreTestSuite) TestGetJob_InvalidRow() {
+	row := jobRow()
+	row["progress"] = 42
+	suite.mockDBProvider.On("GetRuntimeDBClient").Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.On("QueryContext", suite.ctx, queryGetDeletionJob, "job-1", testDeploymentID).
+		Return([]map[string]interface{}{row}, nil).Once()
+
+	_, err := suite.store.GetJob(suite.ctx, "job-1")
+
+	suite.ErrorContains(err, "failed to parse progress")
+}
+
+func (suite *DeletionJobStoreTestSuite) TestGetActiveJobByOUID() {
+	suite.mockDBProvider.On("GetRuntimeDBClient").Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.On("QueryContext", suite.ctx, queryGetActiveDeletionJobByOUID, "ou-1",
+		"PENDING", "RUNNING", testDeploymentID).Return([]map[string]interface{}{jobRow()}, nil).Once()
+
+	job, err := suite.store.GetActiveJobByOUID(suite.ctx, "ou-1")
+
+	suite.NoError(err)
+	suite.Equal("job-1", job.ID)
+}
+
+func (suite *DeletionJobStoreTestSuite) TestGetActiveJobByOUID_QueryError() {
+	suite.mockDBProvider.On("GetRuntimeDBClient").Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.On("QueryContext", suite.ctx, queryGetActiveDeletionJobByOUID, "ou-1",
+		"PENDING", "RUNNING", testDeploymentID).Return(nil, errors.New("query error")).Once()
+
+	_, err := suite.store.GetActiveJobByOUID(suite.ctx, "ou-1")
+
+	suite.ErrorContains(err, "failed to execute query")
+}
+
+func (suite *DeletionJobStoreTestSuite) TestUpdateProgress() {
+	suite.mockDBProvider.On("GetRuntimeDBClient").Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.On("ExecuteContext", suite.ctx, queryUpdateDeletionJobProgress, mock.Anything,
+		mock.Anything, "job-1", testDeploymentID).Return(int64(1), nil).Once()
+
+	suite.NoError(suite.store.UpdateProgress(suite.ctx, "job-1", JobProgress{DeletedUsers: 2}))
+}
+
+func (suite *DeletionJobStoreTestSuite) TestUpdateStatus() {
+	suite.Run("applied", func() {
+		suite.SetupTest()
+		suite.mockDBProvider.On("GetRuntimeDBClient").Return(suite.mockDBClient, nil).Once()
+		suite.mockDBClient.On("ExecuteContext", suite.ctx, queryUpdateDeletionJobStatus, "FAILED", "boom",
+			mock.Anything, "job-1", "RUNNING", testDeploymentID).Return(int64(1), nil).Once()
+
+		applied, err := suite.store.UpdateStatus(suite.ctx, "job-1", JobStatusRunning, JobStatusFailed, "boom")
+
+		suite.NoError(err)
+		suite.True(applied)
+	})
+
+	suite.Run("not applied", func() {
+		suite.SetupTest()
+		suite.mockDBProvider.On("GetRuntimeDBClient").Return(suite.mockDBClient, nil).Once()
+		suite.mockDBClient.On("ExecuteContext", suite.ctx, queryUpdateDeletionJobStatus, "CANCELLED", "",
+			mock.Anything, "job-1", "PENDING", testDeploymentID).Return(int64(0), nil).Once()
+
+		applied, err := suite.store.UpdateStatus(suite.ctx, "job-1", JobStatusPending, JobStatusCancelled, "")
+
+		suite.NoError(err)
+		suite.False(applied)
+	})
+
+	suite.Run("execute error", func() {
+		suite.SetupTest()
+		suite.mockDBProvider.On("GetRuntimeDBClient").Return(suite.mockDBClient, nil).Once()
+		suite.mockDBClient.On("ExecuteContext", suite.ctx, queryUpdateDeletionJobStatus, mock.Anything,
+			mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+			Return(int64(0), errors.New("exec error")).Once()
+
+		_, err := suite.store.UpdateStatus(suite.ctx, "job-1", JobStatusPending, JobStatusCancelled, "")
+
+		suite.ErrorContains(err, "failed to execute query")
+	})
+}
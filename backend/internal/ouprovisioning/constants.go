@@ -0,0 +1,72 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package ouprovisioning
+
+const loggerComponentName = "OUProvisioningService"
+
+// Handle sources of the provisioned organization units.
+const (
+	// handleSourceExternalID derives the handle of an organization unit from the external ID of its department.
+	handleSourceExternalID = "external_id"
+	// handleSourceName derives the handle of an organization unit from the name of its department.
+	handleSourceName = "name"
+)
+
+// maxDepartments is the maximum number of departments a single feed can contain.
+const maxDepartments = 5000
+
+// scimMemberTypeGroup is the SCIM member type of a nested group.
+const scimMemberTypeGroup = "Group"
+
+// Columns of a CSV department feed.
+const (
+	csvColumnExternalID       = "external_id"
+	csvColumnName             = "name"
+	csvColumnParentExternalID = "parent_external_id"
+	csvColumnDescription      = "description"
+)
+
+// ChangeAction is the action taken on an organization unit to reconcile it with the feed.
+type ChangeAction string
+
+// Reconciliation change actions.
+const (
+	// ChangeActionCreate creates an organization unit for a new department.
+	ChangeActionCreate ChangeAction = "CREATE"
+	// ChangeActionUpdate updates the name or description of an organization unit.
+	ChangeActionUpdate ChangeAction = "UPDATE"
+	// ChangeActionMove moves an organization unit under a different parent, updating it if needed.
+	ChangeActionMove ChangeAction = "MOVE"
+	// ChangeActionRetire moves an organization unit of a removed department under the retired
+	// organization unit, or deletes it when no retired organization unit is configured.
+	ChangeActionRetire ChangeAction = "RETIRE"
+)
+
+// ChangeStatus is the outcome of a reconciliation change.
+type ChangeStatus string
+
+// Reconciliation change statuses.
+const (
+	// ChangeStatusPlanned indicates that the change was computed in a dry run and not applied.
+	ChangeStatusPlanned ChangeStatus = "PLANNED"
+	// ChangeStatusApplied indicates that the change was applied.
+	ChangeStatusApplied ChangeStatus = "APPLIED"
+	// ChangeStatusFailed indicates that the change could not be applied.
+	ChangeStatusFailed ChangeStatus = "FAILED"
+)
@@ -0,0 +1,101 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package ouprovisioning
+
+import (
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/i18n/core"
+)
+
+// Client errors for organization unit provisioning operations.
+var (
+	// ErrorInvalidRequestFormat is the error returned when the request is malformed.
+	ErrorInvalidRequestFormat = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "OUP-1001",
+		Error: core.I18nMessage{
+			Key:          "error.ouprovisioningservice.invalid_request_format",
+			DefaultValue: "Invalid request format",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.ouprovisioningservice.invalid_request_format_description",
+			DefaultValue: "The request body or query parameters are malformed or contain invalid data",
+		},
+	}
+	// ErrorProvisioningNotConfigured is the error returned when no root organization unit is configured.
+	ErrorProvisioningNotConfigured = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "OUP-1002",
+		Error: core.I18nMessage{
+			Key:          "error.ouprovisioningservice.provisioning_not_configured",
+			DefaultValue: "Provisioning not configured",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.ouprovisioningservice.provisioning_not_configured_description",
+			DefaultValue: "Organization unit provisioning requires a root organization unit to be configured",
+		},
+	}
+	// ErrorRootOrganizationUnitNotFound is the error returned when a configured organization unit does not exist.
+	ErrorRootOrganizationUnitNotFound = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "OUP-1003",
+		Error: core.I18nMessage{
+			Key:          "error.ouprovisioningservice.root_organization_unit_not_found",
+			DefaultValue: "Organization unit not found",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.ouprovisioningservice.root_organization_unit_not_found_description",
+			DefaultValue: "The root or retired organization unit configured for provisioning does not exist",
+		},
+	}
+	// ErrorInvalidFeed is the error returned when the department feed is invalid.
+	ErrorInvalidFeed = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "OUP-1004",
+		Error: core.I18nMessage{
+			Key:          "error.ouprovisioningservice.invalid_feed",
+			DefaultValue: "Invalid department feed",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.ouprovisioningservice.invalid_feed_description",
+			DefaultValue: "The department feed is invalid",
+		},
+	}
+	// ErrorChangeRateExceeded is the error returned when a feed retires more organization units than the
+	// configured change rate threshold allows.
+	ErrorChangeRateExceeded = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "OUP-1005",
+		Error: core.I18nMessage{
+			Key:          "error.ouprovisioningservice.change_rate_exceeded",
+			DefaultValue: "Change rate exceeded",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.ouprovisioningservice.change_rate_exceeded_description",
+			DefaultValue: "The feed retires more organization units than the configured change rate threshold allows",
+		},
+	}
+)
+
+// invalidFeedErr returns the invalid feed error with the reason appended to its description.
+func invalidFeedErr(reason string) *serviceerror.ServiceError {
+	e := ErrorInvalidFeed
+	e.ErrorDescription.DefaultValue += ": " + reason
+	return &e
+}
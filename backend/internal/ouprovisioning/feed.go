@@ -0,0 +1,140 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package ouprovisioning
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+)
+
+// departmentsFromSCIMGroups converts a SCIM group list into departments. A group becomes the parent
+// of every group listed among its members, and a group that is not a member of any other group
+// becomes a top level department.
+func departmentsFromSCIMGroups(request SCIMGroupListRequest) ([]Department, *serviceerror.ServiceError) {
+	// SCIM members reference groups by their ID, so resolve both IDs and external IDs to the key
+	// that identifies the department.
+	keys := make(map[string]string, len(request.Resources)*2)
+	departments := make([]Department, 0, len(request.Resources))
+	for i, group := range request.Resources {
+		key := strings.TrimSpace(group.ExternalID)
+		if key == "" {
+			key = strings.TrimSpace(group.ID)
+		}
+		if key == "" {
+			return nil, invalidFeedErr(fmt.Sprintf("group %d has no id or externalId", i+1))
+		}
+		if id := strings.TrimSpace(group.ID); id != "" {
+			keys[id] = key
+		}
+		keys[key] = key
+		departments = append(departments, Department{
+			ExternalID: key,
+			Name:       strings.TrimSpace(group.DisplayName),
+		})
+	}
+
+	parents := make(map[string]string, len(departments))
+	for i, group := range request.Resources {
+		for _, member := range group.Members {
+			if member.Type != "" && member.Type != scimMemberTypeGroup {
+				continue
+			}
+			childKey, ok := keys[strings.TrimSpace(member.Value)]
+			if !ok {
+				if member.Type == scimMemberTypeGroup {
+					return nil, invalidFeedErr(fmt.Sprintf("group %q references unknown member group %q",
+						departments[i].ExternalID, member.Value))
+				}
+				// Members without a type that do not resolve to a group are users.
+				continue
+			}
+			if parent, exists := parents[childKey]; exists && parent != departments[i].ExternalID {
+				return nil, invalidFeedErr(fmt.Sprintf("group %q is a member of more than one group", childKey))
+			}
+			parents[childKey] = departments[i].ExternalID
+		}
+	}
+
+	for i := range departments {
+		departments[i].ParentExternalID = parents[departments[i].ExternalID]
+	}
+	return departments, nil
+}
+
+// departmentsFromCSV reads departments from a CSV feed. The first record is a header naming the
+// external_id, name and parent_external_id columns, and optionally the description column. Column
+// names are matched case-insensitively and unknown columns are ignored.
+func departmentsFromCSV(r io.Reader) ([]Department, *serviceerror.ServiceError) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, invalidFeedErr("the feed is empty")
+		}
+		return nil, invalidFeedErr(err.Error())
+	}
+
+	columns := make(map[string]int, len(header))
+	for i, column := range header {
+		columns[strings.ToLower(strings.TrimSpace(strings.TrimPrefix(column, "\ufeff")))] = i
+	}
+	for _, required := range []string{csvColumnExternalID, csvColumnName, csvColumnParentExternalID} {
+		if _, ok := columns[required]; !ok {
+			return nil, invalidFeedErr(fmt.Sprintf("missing column %q", required))
+		}
+	}
+	// Records may have a different number of fields than the header when optional columns are blank.
+	reader.FieldsPerRecord = -1
+
+	field := func(record []string, column string) string {
+		i, ok := columns[column]
+		if !ok || i >= len(record) {
+			return ""
+		}
+		return strings.TrimSpace(record[i])
+	}
+
+	departments := make([]Department, 0)
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, invalidFeedErr(err.Error())
+		}
+		if len(departments) >= maxDepartments {
+			return nil, invalidFeedErr(fmt.Sprintf("the feed exceeds %d departments", maxDepartments))
+		}
+		departments = append(departments, Department{
+			ExternalID:       field(record, csvColumnExternalID),
+			Name:             field(record, csvColumnName),
+			ParentExternalID: field(record, csvColumnParentExternalID),
+			Description:      field(record, csvColumnDescription),
+		})
+	}
+	return departments, nil
+}
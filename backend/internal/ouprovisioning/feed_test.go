@@ -0,0 +1,112 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package ouprovisioning
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDepartmentsFromSCIMGroups(t *testing.T) {
+	request := SCIMGroupListRequest{
+		Resources: []SCIMGroup{
+			{ID: "g1", ExternalID: "eng", DisplayName: "Engineering", Members: []SCIMMember{
+				{Value: "g2", Type: "Group"},
+				{Value: "user-1", Type: "User"},
+				{Value: "user-2"},
+			}},
+			{ID: "g2", DisplayName: "QA"},
+		},
+	}
+
+	departments, svcErr := departmentsFromSCIMGroups(request)
+
+	require.Nil(t, svcErr)
+	require.Equal(t, []Department{
+		{ExternalID: "eng", Name: "Engineering"},
+		{ExternalID: "g2", Name: "QA", ParentExternalID: "eng"},
+	}, departments)
+}
+
+func TestDepartmentsFromSCIMGroups_Invalid(t *testing.T) {
+	testCases := []struct {
+		name    string
+		request SCIMGroupListRequest
+		reason  string
+	}{
+		{"MissingID", SCIMGroupListRequest{Resources: []SCIMGroup{{DisplayName: "Engineering"}}},
+			"group 1 has no id or externalId"},
+		{"UnknownMemberGroup", SCIMGroupListRequest{Resources: []SCIMGroup{
+			{ID: "g1", DisplayName: "Engineering", Members: []SCIMMember{{Value: "g9", Type: "Group"}}},
+		}}, `references unknown member group "g9"`},
+		{"MultipleParents", SCIMGroupListRequest{Resources: []SCIMGroup{
+			{ID: "g1", DisplayName: "A", Members: []SCIMMember{{Value: "g3"}}},
+			{ID: "g2", DisplayName: "B", Members: []SCIMMember{{Value: "g3"}}},
+			{ID: "g3", DisplayName: "C"},
+		}}, `group "g3" is a member of more than one group`},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, svcErr := departmentsFromSCIMGroups(tc.request)
+
+			require.NotNil(t, svcErr)
+			require.Equal(t, ErrorInvalidFeed.Code, svcErr.Code)
+			require.Contains(t, svcErr.ErrorDescription.DefaultValue, tc.reason)
+		})
+	}
+}
+
+func TestDepartmentsFromCSV(t *testing.T) {
+	feed := "\ufeffName,External_ID,Parent_External_ID,Cost_Center,Description\n" +
+		"Engineering,eng,,100,Builds things\n" +
+		"QA,qa,eng,200\n"
+
+	departments, svcErr := departmentsFromCSV(strings.NewReader(feed))
+
+	require.Nil(t, svcErr)
+	require.Equal(t, []Department{
+		{ExternalID: "eng", Name: "Engineering", Description: "Builds things"},
+		{ExternalID: "qa", Name: "QA", ParentExternalID: "eng"},
+	}, departments)
+}
+
+func TestDepartmentsFromCSV_Invalid(t *testing.T) {
+	testCases := []struct {
+		name   string
+		feed   string
+		reason string
+	}{
+		{"Empty", "", "the feed is empty"},
+		{"MissingColumn", "external_id,name\neng,Engineering\n", `missing column "parent_external_id"`},
+		{"Malformed", "external_id,name,parent_external_id\n\"eng,Engineering,\n", "extraneous or missing"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, svcErr := departmentsFromCSV(strings.NewReader(tc.feed))
+
+			require.NotNil(t, svcErr)
+			require.Equal(t, ErrorInvalidFeed.Code, svcErr.Code)
+			require.Contains(t, svcErr.ErrorDescription.DefaultValue, tc.reason)
+		})
+	}
+}
@@ -0,0 +1,119 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package ouprovisioning
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	sysutils "github.com/thunder-id/thunderid/internal/system/utils"
+)
+
+// ouProvisioningHandler is the handler for organization unit provisioning operations.
+type ouProvisioningHandler struct {
+	provisioningService OUProvisioningServiceInterface
+}
+
+// newOUProvisioningHandler creates a new instance of ouProvisioningHandler.
+func newOUProvisioningHandler(provisioningService OUProvisioningServiceInterface) *ouProvisioningHandler {
+	return &ouProvisioningHandler{
+		provisioningService: provisioningService,
+	}
+}
+
+// HandleSCIMPostRequest handles the request to reconcile organization units with a SCIM group feed.
+func (h *ouProvisioningHandler) HandleSCIMPostRequest(w http.ResponseWriter, r *http.Request) {
+	options, svcErr := parseReconcileOptions(r)
+	if svcErr != nil {
+		sysutils.WriteServiceErrorResponse(w, svcErr, clientErrorStatusCodes)
+		return
+	}
+
+	request, err := sysutils.DecodeJSONBody[SCIMGroupListRequest](r)
+	if err != nil {
+		sysutils.WriteServiceErrorResponse(w, &ErrorInvalidRequestFormat, clientErrorStatusCodes)
+		return
+	}
+
+	departments, svcErr := departmentsFromSCIMGroups(*request)
+	if svcErr != nil {
+		sysutils.WriteServiceErrorResponse(w, svcErr, clientErrorStatusCodes)
+		return
+	}
+
+	h.reconcile(w, r, departments, options)
+}
+
+// HandleCSVPostRequest handles the request to reconcile organization units with a CSV department feed.
+func (h *ouProvisioningHandler) HandleCSVPostRequest(w http.ResponseWriter, r *http.Request) {
+	options, svcErr := parseReconcileOptions(r)
+	if svcErr != nil {
+		sysutils.WriteServiceErrorResponse(w, svcErr, clientErrorStatusCodes)
+		return
+	}
+
+	departments, svcErr := departmentsFromCSV(r.Body)
+	if svcErr != nil {
+		sysutils.WriteServiceErrorResponse(w, svcErr, clientErrorStatusCodes)
+		return
+	}
+
+	h.reconcile(w, r, departments, options)
+}
+
+// reconcile reconciles organization units with the departments and writes the reconciliation report.
+func (h *ouProvisioningHandler) reconcile(
+	w http.ResponseWriter, r *http.Request, departments []Department, options ReconcileOptions,
+) {
+	report, svcErr := h.provisioningService.Reconcile(r.Context(), departments, options)
+	if svcErr != nil {
+		sysutils.WriteServiceErrorResponse(w, svcErr, clientErrorStatusCodes)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(w, http.StatusOK, report)
+}
+
+// parseReconcileOptions parses the dryRun and force query parameters of a reconciliation request.
+func parseReconcileOptions(r *http.Request) (ReconcileOptions, *serviceerror.ServiceError) {
+	options := ReconcileOptions{}
+	query := r.URL.Query()
+	for name, target := range map[string]*bool{"dryRun": &options.DryRun, "force": &options.Force} {
+		value := query.Get(name)
+		if value == "" {
+			continue
+		}
+		parsed, err := strconv.ParseBool(value)
+		if err != nil {
+			return ReconcileOptions{}, &ErrorInvalidRequestFormat
+		}
+		*target = parsed
+	}
+	return options, nil
+}
+
+// clientErrorStatusCodes maps the client errors of the handler to HTTP status codes. Other client errors are
+// returned with 400 Bad Request.
+var clientErrorStatusCodes = map[string]int{
+	ErrorRootOrganizationUnitNotFound.Code: http.StatusNotFound,
+	ErrorChangeRateExceeded.Code:           http.StatusConflict,
+	ErrorProvisioningNotConfigured.Code:    http.StatusConflict,
+	serviceerror.ErrorUnauthorized.Code:    http.StatusForbidden,
+}
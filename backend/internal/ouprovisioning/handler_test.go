@@ -0,0 +1,142 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package ouprovisioning
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/system/error/apierror"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+)
+
+type HandlerTestSuite struct {
+	suite.Suite
+	mockService *OUProvisioningServiceInterfaceMock
+	handler     *ouProvisioningHandler
+}
+
+func TestHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(HandlerTestSuite))
+}
+
+func (s *HandlerTestSuite) SetupTest() {
+	s.mockService = NewOUProvisioningServiceInterfaceMock(s.T())
+	s.handler = newOUProvisioningHandler(s.mockService)
+}
+
+func (s *HandlerTestSuite) TestHandleSCIMPostRequest() {
+	s.mockService.On("Reconcile", mock.Anything,
+		[]Department{{ExternalID: "eng", Name: "Engineering"}}, ReconcileOptions{DryRun: true}).
+		Return(&ReconciliationReport{DryRun: true, Summary: ReconciliationSummary{Created: 1}}, nil)
+
+	req := httptest.NewRequest(http.MethodPost, "/organization-units/provisioning/scim?dryRun=true",
+		strings.NewReader(`{"Resources":[{"id":"g1","externalId":"eng","displayName":"Engineering"}]}`))
+	rr := httptest.NewRecorder()
+	s.handler.HandleSCIMPostRequest(rr, req)
+
+	s.Equal(http.StatusOK, rr.Code)
+	var body ReconciliationReport
+	s.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &body))
+	s.True(body.DryRun)
+	s.Equal(1, body.Summary.Created)
+}
+
+func (s *HandlerTestSuite) TestHandleSCIMPostRequest_InvalidBody() {
+	req := httptest.NewRequest(http.MethodPost, "/organization-units/provisioning/scim", strings.NewReader(`{`))
+	rr := httptest.NewRecorder()
+	s.handler.HandleSCIMPostRequest(rr, req)
+
+	s.Equal(http.StatusBadRequest, rr.Code)
+	s.assertErrorCode(rr, ErrorInvalidRequestFormat.Code)
+}
+
+func (s *HandlerTestSuite) TestHandleCSVPostRequest() {
+	s.mockService.On("Reconcile", mock.Anything,
+		[]Department{{ExternalID: "eng", Name: "Engineering"}}, ReconcileOptions{Force: true}).
+		Return(&ReconciliationReport{Summary: ReconciliationSummary{Unchanged: 1}}, nil)
+
+	req := httptest.NewRequest(http.MethodPost, "/organization-units/provisioning/csv?force=true",
+		strings.NewReader("external_id,name,parent_external_id\neng,Engineering,\n"))
+	rr := httptest.NewRecorder()
+	s.handler.HandleCSVPostRequest(rr, req)
+
+	s.Equal(http.StatusOK, rr.Code)
+}
+
+func (s *HandlerTestSuite) TestHandleCSVPostRequest_InvalidFeed() {
+	req := httptest.NewRequest(http.MethodPost, "/organization-units/provisioning/csv",
+		strings.NewReader("external_id,name\n"))
+	rr := httptest.NewRecorder()
+	s.handler.HandleCSVPostRequest(rr, req)
+
+	s.Equal(http.StatusBadRequest, rr.Code)
+	s.assertErrorCode(rr, ErrorInvalidFeed.Code)
+}
+
+func (s *HandlerTestSuite) TestHandleCSVPostRequest_InvalidOption() {
+	req := httptest.NewRequest(http.MethodPost, "/organization-units/provisioning/csv?dryRun=maybe",
+		strings.NewReader("external_id,name,parent_external_id\neng,Engineering,\n"))
+	rr := httptest.NewRecorder()
+	s.handler.HandleCSVPostRequest(rr, req)
+
+	s.Equal(http.StatusBadRequest, rr.Code)
+	s.assertErrorCode(rr, ErrorInvalidRequestFormat.Code)
+}
+
+func (s *HandlerTestSuite) TestHandleCSVPostRequest_ErrorStatusCodes() {
+	testCases := []struct {
+		name   string
+		err    *serviceerror.ServiceError
+		status int
+	}{
+		{"RootNotFound", &ErrorRootOrganizationUnitNotFound, http.StatusNotFound},
+		{"ChangeRateExceeded", &ErrorChangeRateExceeded, http.StatusConflict},
+		{"NotConfigured", &ErrorProvisioningNotConfigured, http.StatusConflict},
+		{"Unauthorized", &serviceerror.ErrorUnauthorized, http.StatusForbidden},
+		{"InternalError", &serviceerror.InternalServerError, http.StatusInternalServerError},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			s.mockService.On("Reconcile", mock.Anything, mock.Anything, mock.Anything).Return(nil, tc.err)
+
+			req := httptest.NewRequest(http.MethodPost, "/organization-units/provisioning/csv",
+				strings.NewReader("external_id,name,parent_external_id\neng,Engineering,\n"))
+			rr := httptest.NewRecorder()
+			s.handler.HandleCSVPostRequest(rr, req)
+
+			s.Equal(tc.status, rr.Code)
+			s.assertErrorCode(rr, tc.err.Code)
+		})
+	}
+}
+
+func (s *HandlerTestSuite) assertErrorCode(rr *httptest.ResponseRecorder, code string) {
+	var body apierror.ErrorResponse
+	s.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &body))
+	s.Equal(code, body.Code)
+}
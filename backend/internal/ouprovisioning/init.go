@@ -0,0 +1,64 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package ouprovisioning
+
+import (
+	"net/http"
+
+	"github.com/thunder-id/thunderid/internal/ou"
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/middleware"
+)
+
+// Initialize initializes the organization unit provisioning service and registers its routes.
+func Initialize(
+	mux *http.ServeMux,
+	ouService ou.OrganizationUnitServiceInterface,
+) OUProvisioningServiceInterface {
+	provisioningConfig := config.GetServerRuntime().Config.OrganizationUnit.Provisioning
+	provisioningService := newOUProvisioningService(ouService, provisioningConfig)
+
+	provisioningHandler := newOUProvisioningHandler(provisioningService)
+	registerRoutes(mux, provisioningHandler)
+
+	return provisioningService
+}
+
+// registerRoutes registers the routes for organization unit provisioning operations.
+func registerRoutes(mux *http.ServeMux, provisioningHandler *ouProvisioningHandler) {
+	opts := middleware.CORSOptions{
+		AllowedMethods:   []string{"POST"},
+		AllowedHeaders:   middleware.DefaultAllowedHeaders,
+		AllowCredentials: true,
+		MaxAge:           600,
+	}
+	mux.HandleFunc(middleware.WithCORS("POST /organization-units/provisioning/scim",
+		provisioningHandler.HandleSCIMPostRequest, opts))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS /organization-units/provisioning/scim",
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, opts))
+
+	mux.HandleFunc(middleware.WithCORS("POST /organization-units/provisioning/csv",
+		provisioningHandler.HandleCSVPostRequest, opts))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS /organization-units/provisioning/csv",
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, opts))
+}
@@ -0,0 +1,85 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package ouprovisioning provides inbound provisioning of the organization unit tree from the department
+// hierarchy of an HR system, delivered as SCIM groups or as a CSV feed.
+package ouprovisioning
+
+// Department represents a department of the HR feed.
+type Department struct {
+	ExternalID       string
+	Name             string
+	ParentExternalID string
+	Description      string
+}
+
+// ReconcileOptions holds the options of a reconciliation.
+type ReconcileOptions struct {
+	// DryRun computes the changes without applying them.
+	DryRun bool
+	// Force applies the changes even if they exceed the configured change rate threshold.
+	Force bool
+}
+
+// SCIMGroupListRequest represents a SCIM list of groups describing the department hierarchy. The hierarchy
+// is derived from the nested group members of each group.
+type SCIMGroupListRequest struct {
+	Schemas   []string    `json:"schemas,omitempty"`
+	Resources []SCIMGroup `json:"Resources"`
+}
+
+// SCIMGroup represents a SCIM group describing a department.
+type SCIMGroup struct {
+	ID          string       `json:"id,omitempty"`
+	ExternalID  string       `json:"externalId,omitempty"`
+	DisplayName string       `json:"displayName"`
+	Members     []SCIMMember `json:"members,omitempty"`
+}
+
+// SCIMMember represents a member of a SCIM group.
+type SCIMMember struct {
+	Value string `json:"value"`
+	Type  string `json:"type,omitempty"`
+}
+
+// ReconciliationReport represents the outcome of reconciling the organization unit tree with a feed.
+type ReconciliationReport struct {
+	DryRun  bool                  `json:"dryRun"`
+	Summary ReconciliationSummary `json:"summary"`
+	Changes []OUChange            `json:"changes"`
+}
+
+// ReconciliationSummary holds the number of changes of each action in a reconciliation.
+type ReconciliationSummary struct {
+	Created   int `json:"created"`
+	Updated   int `json:"updated"`
+	Moved     int `json:"moved"`
+	Retired   int `json:"retired"`
+	Unchanged int `json:"unchanged"`
+	Failed    int `json:"failed"`
+}
+
+// OUChange represents a change to an organization unit in a reconciliation.
+type OUChange struct {
+	Action     ChangeAction `json:"action"`
+	Status     ChangeStatus `json:"status"`
+	ExternalID string       `json:"externalId,omitempty"`
+	Handle     string       `json:"handle"`
+	OUID       string       `json:"ouId,omitempty"`
+	Error      string       `json:"error,omitempty"`
+}
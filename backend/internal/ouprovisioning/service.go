@@ -0,0 +1,517 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package ouprovisioning
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/thunder-id/thunderid/internal/ou"
+	"github.com/thunder-id/thunderid/internal/system/config"
+	serverconst "github.com/thunder-id/thunderid/internal/system/constants"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/log"
+)
+
+// invalidHandleCharsRegex matches the runs of characters that are replaced when deriving a handle.
+var invalidHandleCharsRegex = regexp.MustCompile(`[^a-z0-9_-]+`)
+
+// OUProvisioningServiceInterface defines the interface for the organization unit provisioning service.
+type OUProvisioningServiceInterface interface {
+	Reconcile(
+		ctx context.Context, departments []Department, options ReconcileOptions,
+	) (*ReconciliationReport, *serviceerror.ServiceError)
+}
+
+// ouProvisioningService is the default implementation of the OUProvisioningServiceInterface.
+type ouProvisioningService struct {
+	ouService     ou.OrganizationUnitServiceInterface
+	rootOU        string
+	retiredOU     string
+	handleSource  string
+	handlePrefix  string
+	maxChangeRate int
+}
+
+// newOUProvisioningService creates a new instance of ouProvisioningService.
+func newOUProvisioningService(
+	ouService ou.OrganizationUnitServiceInterface,
+	provisioningConfig config.OUProvisioningConfig,
+) OUProvisioningServiceInterface {
+	handleSource := provisioningConfig.HandleSource
+	if handleSource != handleSourceName {
+		handleSource = handleSourceExternalID
+	}
+	return &ouProvisioningService{
+		ouService:     ouService,
+		rootOU:        strings.Trim(provisioningConfig.RootOU, "/"),
+		retiredOU:     strings.Trim(provisioningConfig.RetiredOU, "/"),
+		handleSource:  handleSource,
+		handlePrefix:  provisioningConfig.HandlePrefix,
+		maxChangeRate: max(provisioningConfig.MaxChangeRate, 0),
+	}
+}
+
+// managedOU is an organization unit under the provisioning root that is managed by the feed.
+type managedOU struct {
+	id          string
+	handle      string
+	name        string
+	description string
+	parentID    string
+	depth       int
+}
+
+// plannedChange is a change computed by the reconciliation together with the department it applies.
+type plannedChange struct {
+	change     OUChange
+	department *Department
+	existing   *managedOU
+}
+
+// Reconcile reconciles the organization units under the provisioning root with the departments of a
+// feed. Departments without an organization unit are created, organization units whose department
+// changed are updated or moved, and organization units without a department are retired.
+func (s *ouProvisioningService) Reconcile(
+	ctx context.Context, departments []Department, options ReconcileOptions,
+) (*ReconciliationReport, *serviceerror.ServiceError) {
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName))
+
+	if s.rootOU == "" {
+		return nil, &ErrorProvisioningNotConfigured
+	}
+
+	ordered, handles, svcErr := s.validateFeed(departments)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+
+	rootID, svcErr := s.resolveOU(ctx, s.rootOU)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	retiredID := ""
+	if s.retiredOU != "" {
+		if retiredID, svcErr = s.resolveOU(ctx, s.retiredOU); svcErr != nil {
+			return nil, svcErr
+		}
+	}
+
+	managed, svcErr := s.listManagedOUs(ctx, rootID, retiredID)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+
+	changes, unchanged := s.planChanges(ordered, handles, managed, rootID)
+
+	retired := 0
+	for _, change := range changes {
+		if change.change.Action == ChangeActionRetire {
+			retired++
+		}
+	}
+	if s.maxChangeRate > 0 && !options.Force && retired*100 > s.maxChangeRate*len(managed) {
+		logger.Warn("Organization unit feed exceeds the change rate threshold",
+			log.Int("retired", retired), log.Int("managed", len(managed)))
+		return nil, changeRateExceededErr(retired, len(managed), s.maxChangeRate)
+	}
+
+	if !options.DryRun {
+		s.applyChanges(ctx, changes, handles, managed, rootID, retiredID)
+	}
+
+	report := &ReconciliationReport{
+		DryRun:  options.DryRun,
+		Changes: make([]OUChange, 0, len(changes)),
+	}
+	report.Summary.Unchanged = unchanged
+	for _, planned := range changes {
+		report.Changes = append(report.Changes, planned.change)
+		if planned.change.Status == ChangeStatusFailed {
+			report.Summary.Failed++
+			continue
+		}
+		switch planned.change.Action {
+		case ChangeActionCreate:
+			report.Summary.Created++
+		case ChangeActionUpdate:
+			report.Summary.Updated++
+		case ChangeActionMove:
+			report.Summary.Moved++
+		case ChangeActionRetire:
+			report.Summary.Retired++
+		}
+	}
+
+	logger.Debug("Reconciled organization units with the department feed",
+		log.Bool("dryRun", options.DryRun), log.Int("created", report.Summary.Created),
+		log.Int("updated", report.Summary.Updated), log.Int("moved", report.Summary.Moved),
+		log.Int("retired", report.Summary.Retired), log.Int("failed", report.Summary.Failed))
+	return report, nil
+}
+
+// validateFeed validates the departments of a feed and returns them ordered so that every department
+// follows its parent, together with the handle derived for each department keyed by its external ID.
+func (s *ouProvisioningService) validateFeed(
+	departments []Department,
+) ([]Department, map[string]string, *serviceerror.ServiceError) {
+	if len(departments) == 0 {
+		return nil, nil, invalidFeedErr("the feed has no departments")
+	}
+	if len(departments) > maxDepartments {
+		return nil, nil, invalidFeedErr(fmt.Sprintf("the feed exceeds %d departments", maxDepartments))
+	}
+
+	handles := make(map[string]string, len(departments))
+	owners := make(map[string]string, len(departments))
+	for i, department := range departments {
+		if department.ExternalID == "" {
+			return nil, nil, invalidFeedErr(fmt.Sprintf("department %d has no external ID", i+1))
+		}
+		if department.Name == "" {
+			return nil, nil, invalidFeedErr(fmt.Sprintf("department %q has no name", department.ExternalID))
+		}
+		if _, exists := handles[department.ExternalID]; exists {
+			return nil, nil, invalidFeedErr(fmt.Sprintf("duplicate department %q", department.ExternalID))
+		}
+		handle := s.deriveHandle(department)
+		if handle == "" {
+			return nil, nil, invalidFeedErr(
+				fmt.Sprintf("department %q does not produce a valid handle", department.ExternalID))
+		}
+		if owner, exists := owners[handle]; exists {
+			return nil, nil, invalidFeedErr(fmt.Sprintf("departments %q and %q produce the same handle %q",
+				owner, department.ExternalID, handle))
+		}
+		handles[department.ExternalID] = handle
+		owners[handle] = department.ExternalID
+	}
+
+	children := make(map[string][]Department, len(departments))
+	for _, department := range departments {
+		if department.ParentExternalID != "" {
+			if _, exists := handles[department.ParentExternalID]; !exists {
+				return nil, nil, invalidFeedErr(fmt.Sprintf("department %q references unknown parent %q",
+					department.ExternalID, department.ParentExternalID))
+			}
+		}
+		children[department.ParentExternalID] = append(children[department.ParentExternalID], department)
+	}
+
+	ordered := make([]Department, 0, len(departments))
+	ordered = append(ordered, children[""]...)
+	for i := 0; i < len(ordered); i++ {
+		ordered = append(ordered, children[ordered[i].ExternalID]...)
+	}
+	if len(ordered) < len(departments) {
+		placed := make(map[string]bool, len(ordered))
+		for _, department := range ordered {
+			placed[department.ExternalID] = true
+		}
+		for _, department := range departments {
+			if !placed[department.ExternalID] {
+				return nil, nil, invalidFeedErr(
+					fmt.Sprintf("department %q is part of a circular hierarchy", department.ExternalID))
+			}
+		}
+	}
+
+	return ordered, handles, nil
+}
+
+// deriveHandle derives the organization unit handle of a department from its external ID or name.
+// The source is lowercased, runs of characters other than letters, digits, '_' and '-' are replaced
+// with a single '-', and the configured prefix is prepended.
+func (s *ouProvisioningService) deriveHandle(department Department) string {
+	source := department.ExternalID
+	if s.handleSource == handleSourceName {
+		source = department.Name
+	}
+	handle := strings.Trim(invalidHandleCharsRegex.ReplaceAllString(strings.ToLower(source), "-"), "-")
+	if handle == "" {
+		return ""
+	}
+	return s.handlePrefix + handle
+}
+
+// resolveOU returns the ID of the organization unit at the given handle path.
+func (s *ouProvisioningService) resolveOU(ctx context.Context, handlePath string) (string, *serviceerror.ServiceError) {
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName))
+
+	orgUnit, svcErr := s.ouService.GetOrganizationUnitByPath(ctx, handlePath)
+	if svcErr != nil {
+		if svcErr.Code == ou.ErrorOrganizationUnitNotFound.Code {
+			return "", &ErrorRootOrganizationUnitNotFound
+		}
+		if svcErr.Code == serviceerror.ErrorUnauthorized.Code {
+			return "", svcErr
+		}
+		logger.Error("Failed to resolve organization unit", log.String("path", handlePath),
+			log.String("error", svcErr.Error.DefaultValue))
+		return "", &serviceerror.InternalServerError
+	}
+	return orgUnit.ID, nil
+}
+
+// listManagedOUs lists the organization units under the provisioning root, keyed by handle. The retired
+// organization unit and declarative organization units are excluded together with their descendants.
+func (s *ouProvisioningService) listManagedOUs(
+	ctx context.Context, rootID, retiredID string,
+) (map[string]*managedOU, *serviceerror.ServiceError) {
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName))
+
+	managed := make(map[string]*managedOU)
+	queue := []managedOU{{id: rootID}}
+	for len(queue) > 0 {
+		parent := queue[0]
+		queue = queue[1:]
+
+		for offset := 0; ; offset += serverconst.MaxPageSize {
+			page, svcErr := s.ouService.GetOrganizationUnitChildren(ctx, parent.id, serverconst.MaxPageSize,
+				offset, nil)
+			if svcErr != nil {
+				if svcErr.Code == serviceerror.ErrorUnauthorized.Code {
+					return nil, svcErr
+				}
+				logger.Error("Failed to list child organization units", log.String("ouID", parent.id),
+					log.String("error", svcErr.Error.DefaultValue))
+				return nil, &serviceerror.InternalServerError
+			}
+
+			for _, child := range page.OrganizationUnits {
+				if child.ID == retiredID || child.IsReadOnly {
+					continue
+				}
+				orgUnit := managedOU{
+					id:          child.ID,
+					handle:      child.Handle,
+					name:        child.Name,
+					description: child.Description,
+					parentID:    parent.id,
+					depth:       parent.depth + 1,
+				}
+				// Handles are only unique among siblings. When a handle repeats in the subtree, the
+				// shallowest organization unit is the one matched with the feed.
+				if _, exists := managed[child.Handle]; !exists {
+					managed[child.Handle] = &orgUnit
+				} else {
+					managed[child.ID] = &orgUnit
+				}
+				queue = append(queue, orgUnit)
+			}
+
+			if len(page.OrganizationUnits) == 0 || offset+len(page.OrganizationUnits) >= page.TotalResults {
+				break
+			}
+		}
+	}
+	return managed, nil
+}
+
+// planChanges computes the changes that reconcile the managed organization units with the ordered
+// departments, and returns them with the number of organization units that are already in sync.
+func (s *ouProvisioningService) planChanges(
+	ordered []Department, handles map[string]string, managed map[string]*managedOU, rootID string,
+) ([]plannedChange, int) {
+	changes := make([]plannedChange, 0)
+	unchanged := 0
+	matched := make(map[string]bool, len(ordered))
+
+	for i := range ordered {
+		department := &ordered[i]
+		handle := handles[department.ExternalID]
+		change := OUChange{
+			Status:     ChangeStatusPlanned,
+			ExternalID: department.ExternalID,
+			Handle:     handle,
+		}
+
+		existing, exists := managed[handle]
+		if !exists {
+			change.Action = ChangeActionCreate
+			changes = append(changes, plannedChange{change: change, department: department})
+			continue
+		}
+		matched[handle] = true
+		change.OUID = existing.id
+
+		parentID := rootID
+		if department.ParentExternalID != "" {
+			parentID = ""
+			if parent, ok := managed[handles[department.ParentExternalID]]; ok {
+				parentID = parent.id
+			}
+		}
+		updated := existing.name != department.Name ||
+			(department.Description != "" && existing.description != department.Description)
+
+		switch {
+		case existing.parentID != parentID:
+			change.Action = ChangeActionMove
+		case updated:
+			change.Action = ChangeActionUpdate
+		default:
+			unchanged++
+			continue
+		}
+		changes = append(changes, plannedChange{change: change, department: department, existing: existing})
+	}
+
+	retired := make([]*managedOU, 0)
+	for key, orgUnit := range managed {
+		if !matched[key] {
+			retired = append(retired, orgUnit)
+		}
+	}
+	// Retire the deepest organization units first so that parents are emptied before they are retired.
+	slices.SortFunc(retired, func(a, b *managedOU) int {
+		if a.depth != b.depth {
+			return b.depth - a.depth
+		}
+		return cmp.Compare(a.handle, b.handle)
+	})
+	for _, orgUnit := range retired {
+		changes = append(changes, plannedChange{
+			change: OUChange{
+				Action: ChangeActionRetire,
+				Status: ChangeStatusPlanned,
+				Handle: orgUnit.handle,
+				OUID:   orgUnit.id,
+			},
+			existing: orgUnit,
+		})
+	}
+
+	return changes, unchanged
+}
+
+// applyChanges applies the planned changes in order and records the outcome of each change.
+func (s *ouProvisioningService) applyChanges(
+	ctx context.Context, changes []plannedChange, handles map[string]string, managed map[string]*managedOU,
+	rootID, retiredID string,
+) {
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName))
+
+	// ouIDs maps the external ID of a department to the ID of its organization unit. Departments whose
+	// organization unit could not be created are absent, so their descendants are not provisioned.
+	ouIDs := make(map[string]string, len(handles))
+	for externalID, handle := range handles {
+		if orgUnit, ok := managed[handle]; ok {
+			ouIDs[externalID] = orgUnit.id
+		}
+	}
+
+	for i := range changes {
+		planned := &changes[i]
+		var svcErr *serviceerror.ServiceError
+
+		if planned.change.Action == ChangeActionRetire {
+			if retiredID != "" {
+				svcErr = s.updateOU(ctx, planned.existing.id, nil, retiredID)
+			} else {
+				svcErr = s.ouService.DeleteOrganizationUnit(ctx, planned.existing.id)
+			}
+		} else {
+			department := planned.department
+			parentID := rootID
+			if department.ParentExternalID != "" {
+				var ok bool
+				if parentID, ok = ouIDs[department.ParentExternalID]; !ok {
+					planned.change.Status = ChangeStatusFailed
+					planned.change.Error = "parent organization unit was not provisioned"
+					continue
+				}
+			}
+
+			if planned.change.Action == ChangeActionCreate {
+				var created ou.OrganizationUnit
+				created, svcErr = s.ouService.CreateOrganizationUnit(ctx, ou.OrganizationUnitRequestWithID{
+					Handle:      planned.change.Handle,
+					Name:        department.Name,
+					Description: department.Description,
+					Parent:      &parentID,
+				})
+				if svcErr == nil {
+					planned.change.OUID = created.ID
+					ouIDs[department.ExternalID] = created.ID
+				}
+			} else {
+				svcErr = s.updateOU(ctx, planned.existing.id, department, parentID)
+			}
+		}
+
+		if svcErr != nil {
+			logger.Debug("Failed to apply organization unit change", log.String("action",
+				string(planned.change.Action)), log.String("handle", planned.change.Handle),
+				log.String("error", svcErr.ErrorDescription.DefaultValue))
+			planned.change.Status = ChangeStatusFailed
+			planned.change.Error = svcErr.ErrorDescription.DefaultValue
+			continue
+		}
+		planned.change.Status = ChangeStatusApplied
+	}
+}
+
+// updateOU moves an organization unit under the given parent and, when a department is given, updates
+// its name and description. Attributes that are not derived from the feed are preserved.
+func (s *ouProvisioningService) updateOU(
+	ctx context.Context, id string, department *Department, parentID string,
+) *serviceerror.ServiceError {
+	current, svcErr := s.ouService.GetOrganizationUnit(ctx, id)
+	if svcErr != nil {
+		return svcErr
+	}
+
+	request := ou.OrganizationUnitRequestWithID{
+		Handle:          current.Handle,
+		Name:            current.Name,
+		Description:     current.Description,
+		Parent:          &parentID,
+		ThemeID:         current.ThemeID,
+		LayoutID:        current.LayoutID,
+		LogoURL:         current.LogoURL,
+		TosURI:          current.TosURI,
+		PolicyURI:       current.PolicyURI,
+		CookiePolicyURI: current.CookiePolicyURI,
+		Locale:          current.Locale,
+		Zoneinfo:        current.Zoneinfo,
+		DefaultUserType: current.DefaultUserType,
+	}
+	if department != nil {
+		request.Name = department.Name
+		if department.Description != "" {
+			request.Description = department.Description
+		}
+	}
+
+	_, svcErr = s.ouService.UpdateOrganizationUnit(ctx, id, request)
+	return svcErr
+}
+
+// changeRateExceededErr returns the change rate exceeded error with the counts appended to its description.
+func changeRateExceededErr(retired, managed, threshold int) *serviceerror.ServiceError {
+	e := ErrorChangeRateExceeded
+	e.ErrorDescription.DefaultValue += fmt.Sprintf(": %d of %d organization units would be retired, "+
+		"exceeding the %d%% threshold", retired, managed, threshold)
+	return &e
+}
@@ -0,0 +1,288 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package ouprovisioning
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/ou"
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/tests/mocks/oumock"
+)
+
+const testRootPath = "acme/departments"
+
+type OUProvisioningServiceTestSuite struct {
+	suite.Suite
+	mockOUService *oumock.OrganizationUnitServiceInterfaceMock
+	config        config.OUProvisioningConfig
+}
+
+func TestOUProvisioningServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(OUProvisioningServiceTestSuite))
+}
+
+func (suite *OUProvisioningServiceTestSuite) SetupTest() {
+	suite.mockOUService = oumock.NewOrganizationUnitServiceInterfaceMock(suite.T())
+	suite.config = config.OUProvisioningConfig{
+		RootOU:        testRootPath,
+		HandleSource:  handleSourceExternalID,
+		MaxChangeRate: 50,
+	}
+}
+
+func (suite *OUProvisioningServiceTestSuite) newService() *ouProvisioningService {
+	return newOUProvisioningService(suite.mockOUService, suite.config).(*ouProvisioningService)
+}
+
+func (suite *OUProvisioningServiceTestSuite) expectChildren(parentID string, children ...ou.OrganizationUnitBasic) {
+	suite.mockOUService.On("GetOrganizationUnitChildren", mock.Anything, parentID, 100, 0, mock.Anything).
+		Return(&ou.OrganizationUnitListResponse{TotalResults: len(children), OrganizationUnits: children}, nil).
+		Once()
+}
+
+// expectExistingTree sets up the tree root -> {eng -> qa, legacy}.
+func (suite *OUProvisioningServiceTestSuite) expectExistingTree() {
+	suite.mockOUService.On("GetOrganizationUnitByPath", mock.Anything, testRootPath).
+		Return(ou.OrganizationUnit{ID: "root"}, nil).Once()
+	suite.expectChildren("root",
+		ou.OrganizationUnitBasic{ID: "ou-eng", Handle: "eng", Name: "Engineering"},
+		ou.OrganizationUnitBasic{ID: "ou-legacy", Handle: "legacy", Name: "Legacy"})
+	suite.expectChildren("ou-eng", ou.OrganizationUnitBasic{ID: "ou-qa", Handle: "qa", Name: "QA"})
+	suite.expectChildren("ou-legacy")
+	suite.expectChildren("ou-qa")
+}
+
+// testFeed moves qa under a new platform department and drops legacy.
+func testFeed() []Department {
+	return []Department{
+		{ExternalID: "qa", Name: "QA", ParentExternalID: "platform"},
+		{ExternalID: "eng", Name: "Engineering"},
+		{ExternalID: "platform", Name: "Platform", ParentExternalID: "eng"},
+	}
+}
+
+func (suite *OUProvisioningServiceTestSuite) TestReconcile_DryRun() {
+	suite.expectExistingTree()
+
+	report, svcErr := suite.newService().Reconcile(suite.T().Context(), testFeed(), ReconcileOptions{DryRun: true})
+
+	suite.Require().Nil(svcErr)
+	suite.True(report.DryRun)
+	suite.Equal(ReconciliationSummary{Created: 1, Moved: 1, Retired: 1, Unchanged: 1}, report.Summary)
+	suite.Equal([]OUChange{
+		{Action: ChangeActionCreate, Status: ChangeStatusPlanned, ExternalID: "platform", Handle: "platform"},
+		{Action: ChangeActionMove, Status: ChangeStatusPlanned, ExternalID: "qa", Handle: "qa", OUID: "ou-qa"},
+		{Action: ChangeActionRetire, Status: ChangeStatusPlanned, Handle: "legacy", OUID: "ou-legacy"},
+	}, report.Changes)
+}
+
+func (suite *OUProvisioningServiceTestSuite) TestReconcile_AppliesChanges() {
+	suite.expectExistingTree()
+	suite.mockOUService.On("CreateOrganizationUnit", mock.Anything, mock.MatchedBy(
+		func(request ou.OrganizationUnitRequestWithID) bool {
+			return request.Handle == "platform" && request.Name == "Platform" && *request.Parent == "ou-eng"
+		})).Return(ou.OrganizationUnit{ID: "ou-platform"}, nil).Once()
+	suite.mockOUService.On("GetOrganizationUnit", mock.Anything, "ou-qa").
+		Return(ou.OrganizationUnit{ID: "ou-qa", Handle: "qa", Name: "QA", ThemeID: "theme-1"}, nil).Once()
+	suite.mockOUService.On("UpdateOrganizationUnit", mock.Anything, "ou-qa", mock.MatchedBy(
+		func(request ou.OrganizationUnitRequestWithID) bool {
+			return request.Handle == "qa" && request.ThemeID == "theme-1" && *request.Parent == "ou-platform"
+		})).Return(ou.OrganizationUnit{ID: "ou-qa"}, nil).Once()
+	suite.mockOUService.On("DeleteOrganizationUnit", mock.Anything, "ou-legacy").
+		Return(nil).Once()
+
+	report, svcErr := suite.newService().Reconcile(suite.T().Context(), testFeed(), ReconcileOptions{})
+
+	suite.Require().Nil(svcErr)
+	suite.False(report.DryRun)
+	suite.Equal(ReconciliationSummary{Created: 1, Moved: 1, Retired: 1, Unchanged: 1}, report.Summary)
+	suite.Require().Len(report.Changes, 3)
+	for _, change := range report.Changes {
+		suite.Equal(ChangeStatusApplied, change.Status)
+	}
+	suite.Equal("ou-platform", report.Changes[0].OUID)
+}
+
+func (suite *OUProvisioningServiceTestSuite) TestReconcile_RetiresIntoRetiredOU() {
+	suite.config.RetiredOU = "acme/retired"
+	suite.expectExistingTree()
+	suite.mockOUService.On("GetOrganizationUnitByPath", mock.Anything, "acme/retired").
+		Return(ou.OrganizationUnit{ID: "ou-retired"}, nil).Once()
+	suite.mockOUService.On("GetOrganizationUnit", mock.Anything, "ou-legacy").
+		Return(ou.OrganizationUnit{ID: "ou-legacy", Handle: "legacy", Name: "Legacy"}, nil).Once()
+	suite.mockOUService.On("UpdateOrganizationUnit", mock.Anything, "ou-legacy", mock.MatchedBy(
+		func(request ou.OrganizationUnitRequestWithID) bool {
+			return request.Name == "Legacy" && *request.Parent == "ou-retired"
+		})).Return(ou.OrganizationUnit{ID: "ou-legacy"}, nil).Once()
+
+	departments := []Department{
+		{ExternalID: "eng", Name: "Engineering"},
+		{ExternalID: "qa", Name: "QA", ParentExternalID: "eng"},
+	}
+	report, svcErr := suite.newService().Reconcile(suite.T().Context(), departments, ReconcileOptions{})
+
+	suite.Require().Nil(svcErr)
+	suite.Equal(ReconciliationSummary{Retired: 1, Unchanged: 2}, report.Summary)
+	suite.Equal(ChangeStatusApplied, report.Changes[0].Status)
+}
+
+func (suite *OUProvisioningServiceTestSuite) TestReconcile_ChildOfFailedCreateIsNotProvisioned() {
+	suite.mockOUService.On("GetOrganizationUnitByPath", mock.Anything, testRootPath).
+		Return(ou.OrganizationUnit{ID: "root"}, nil).Once()
+	suite.expectChildren("root")
+	suite.mockOUService.On("CreateOrganizationUnit", mock.Anything, mock.Anything).
+		Return(ou.OrganizationUnit{}, &ou.ErrorOrganizationUnitNameConflict).Once()
+
+	departments := []Department{
+		{ExternalID: "eng", Name: "Engineering"},
+		{ExternalID: "qa", Name: "QA", ParentExternalID: "eng"},
+	}
+	report, svcErr := suite.newService().Reconcile(suite.T().Context(), departments, ReconcileOptions{})
+
+	suite.Require().Nil(svcErr)
+	suite.Equal(ReconciliationSummary{Failed: 2}, report.Summary)
+	suite.Equal(ou.ErrorOrganizationUnitNameConflict.ErrorDescription.DefaultValue, report.Changes[0].Error)
+	suite.Equal("parent organization unit was not provisioned", report.Changes[1].Error)
+}
+
+func (suite *OUProvisioningServiceTestSuite) TestReconcile_ChangeRateExceeded() {
+	suite.config.MaxChangeRate = 20
+	suite.expectExistingTree()
+
+	_, svcErr := suite.newService().Reconcile(suite.T().Context(), testFeed(), ReconcileOptions{DryRun: true})
+
+	suite.Require().NotNil(svcErr)
+	suite.Equal(ErrorChangeRateExceeded.Code, svcErr.Code)
+	suite.Contains(svcErr.ErrorDescription.DefaultValue, "1 of 3 organization units would be retired")
+}
+
+func (suite *OUProvisioningServiceTestSuite) TestReconcile_ForceBypassesChangeRate() {
+	suite.config.MaxChangeRate = 20
+	suite.expectExistingTree()
+
+	report, svcErr := suite.newService().Reconcile(suite.T().Context(), testFeed(),
+		ReconcileOptions{DryRun: true, Force: true})
+
+	suite.Require().Nil(svcErr)
+	suite.Equal(1, report.Summary.Retired)
+}
+
+func (suite *OUProvisioningServiceTestSuite) TestReconcile_SkipsDeclarativeOUs() {
+	suite.mockOUService.On("GetOrganizationUnitByPath", mock.Anything, testRootPath).
+		Return(ou.OrganizationUnit{ID: "root"}, nil).Once()
+	suite.expectChildren("root",
+		ou.OrganizationUnitBasic{ID: "ou-eng", Handle: "eng", Name: "Engineering"},
+		ou.OrganizationUnitBasic{ID: "ou-static", Handle: "static", Name: "Static", IsReadOnly: true})
+	suite.expectChildren("ou-eng")
+
+	report, svcErr := suite.newService().Reconcile(suite.T().Context(),
+		[]Department{{ExternalID: "eng", Name: "Engineering"}}, ReconcileOptions{})
+
+	suite.Require().Nil(svcErr)
+	suite.Equal(ReconciliationSummary{Unchanged: 1}, report.Summary)
+	suite.Empty(report.Changes)
+}
+
+func (suite *OUProvisioningServiceTestSuite) TestReconcile_NotConfigured() {
+	suite.config.RootOU = ""
+
+	_, svcErr := suite.newService().Reconcile(suite.T().Context(), testFeed(), ReconcileOptions{})
+
+	suite.Require().NotNil(svcErr)
+	suite.Equal(ErrorProvisioningNotConfigured.Code, svcErr.Code)
+}
+
+func (suite *OUProvisioningServiceTestSuite) TestReconcile_RootNotFound() {
+	suite.mockOUService.On("GetOrganizationUnitByPath", mock.Anything, testRootPath).
+		Return(ou.OrganizationUnit{}, &ou.ErrorOrganizationUnitNotFound).Once()
+
+	_, svcErr := suite.newService().Reconcile(suite.T().Context(), testFeed(), ReconcileOptions{})
+
+	suite.Require().NotNil(svcErr)
+	suite.Equal(ErrorRootOrganizationUnitNotFound.Code, svcErr.Code)
+}
+
+func (suite *OUProvisioningServiceTestSuite) TestReconcile_Unauthorized() {
+	suite.mockOUService.On("GetOrganizationUnitByPath", mock.Anything, testRootPath).
+		Return(ou.OrganizationUnit{}, &serviceerror.ErrorUnauthorized).Once()
+
+	_, svcErr := suite.newService().Reconcile(suite.T().Context(), testFeed(), ReconcileOptions{})
+
+	suite.Require().NotNil(svcErr)
+	suite.Equal(serviceerror.ErrorUnauthorized.Code, svcErr.Code)
+}
+
+func (suite *OUProvisioningServiceTestSuite) TestValidateFeed_InvalidFeeds() {
+	testCases := []struct {
+		name        string
+		departments []Department
+		reason      string
+	}{
+		{"Empty", nil, "the feed has no departments"},
+		{"MissingExternalID", []Department{{Name: "Engineering"}}, "department 1 has no external ID"},
+		{"MissingName", []Department{{ExternalID: "eng"}}, `department "eng" has no name`},
+		{"Duplicate", []Department{{ExternalID: "eng", Name: "A"}, {ExternalID: "eng", Name: "B"}},
+			`duplicate department "eng"`},
+		{"HandleCollision", []Department{{ExternalID: "R&D", Name: "A"}, {ExternalID: "r-d", Name: "B"}},
+			`produce the same handle "r-d"`},
+		{"InvalidHandle", []Department{{ExternalID: "%%", Name: "A"}}, "does not produce a valid handle"},
+		{"UnknownParent", []Department{{ExternalID: "eng", Name: "A", ParentExternalID: "hq"}},
+			`references unknown parent "hq"`},
+		{"Cycle", []Department{
+			{ExternalID: "hq", Name: "HQ"},
+			{ExternalID: "a", Name: "A", ParentExternalID: "b"},
+			{ExternalID: "b", Name: "B", ParentExternalID: "a"},
+		}, `department "a" is part of a circular hierarchy`},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			_, _, svcErr := suite.newService().validateFeed(tc.departments)
+
+			suite.Require().NotNil(svcErr)
+			suite.Equal(ErrorInvalidFeed.Code, svcErr.Code)
+			suite.Contains(svcErr.ErrorDescription.DefaultValue, tc.reason)
+		})
+	}
+}
+
+func (suite *OUProvisioningServiceTestSuite) TestValidateFeed_OrdersParentsFirst() {
+	ordered, handles, svcErr := suite.newService().validateFeed(testFeed())
+
+	suite.Require().Nil(svcErr)
+	suite.Equal([]string{"eng", "platform", "qa"},
+		[]string{ordered[0].ExternalID, ordered[1].ExternalID, ordered[2].ExternalID})
+	suite.Equal("platform", handles["platform"])
+}
+
+func (suite *OUProvisioningServiceTestSuite) TestDeriveHandle() {
+	suite.config.HandlePrefix = "hr-"
+	service := suite.newService()
+	suite.Equal("hr-dept-042", service.deriveHandle(Department{ExternalID: " DEPT 042 ", Name: "Sales"}))
+
+	suite.config.HandleSource = handleSourceName
+	service = suite.newService()
+	suite.Equal("hr-research-development", service.deriveHandle(Department{ExternalID: "1",
+		Name: "Research & Development"}))
+}
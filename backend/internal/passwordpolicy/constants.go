@@ -0,0 +1,38 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package passwordpolicy
+
+import "time"
+
+// TenantScope is the scope of the password policy that applies to the whole tenant rather than an
+// organization unit.
+const TenantScope = ""
+
+const (
+	loggerComponentName = "PasswordPolicyService"
+
+	// breachCheckPrefixLength is the number of hexadecimal characters of the SHA-1 hash of a password sent to
+	// the breached password service.
+	breachCheckPrefixLength = 5
+	// defaultBreachCheckTimeout bounds a request to the breached password service when no timeout is configured.
+	defaultBreachCheckTimeout = 5 * time.Second
+	// minAttributeValueLength is the minimum length of the user attribute values matched by the dictionary
+	// check. Shorter values match too many passwords by chance.
+	minAttributeValueLength = 4
+)
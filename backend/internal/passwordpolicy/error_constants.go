@@ -0,0 +1,102 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package passwordpolicy
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/i18n/core"
+)
+
+// ErrPasswordPolicyViolation is returned when a new password does not satisfy the password policy that
+// applies to the user. The returned error wraps one of the more specific errors below.
+var ErrPasswordPolicyViolation = errors.New("password policy violation")
+
+var (
+	// ErrPasswordTooShort is returned when a password is shorter than the minimum length of the policy.
+	ErrPasswordTooShort = fmt.Errorf("%w: password is too short", ErrPasswordPolicyViolation)
+	// ErrPasswordTooSimple is returned when a password uses fewer character classes than the policy requires.
+	ErrPasswordTooSimple = fmt.Errorf("%w: password uses too few character classes", ErrPasswordPolicyViolation)
+	// ErrPasswordInDictionary is returned when a password is a common password or contains attribute values
+	// of the user.
+	ErrPasswordInDictionary = fmt.Errorf("%w: password is a dictionary word", ErrPasswordPolicyViolation)
+	// ErrPasswordReused is returned when a password matches one of the recent passwords of the user.
+	ErrPasswordReused = fmt.Errorf("%w: password was used recently", ErrPasswordPolicyViolation)
+	// ErrPasswordBreached is returned when a password was found in the breached password service.
+	ErrPasswordBreached = fmt.Errorf("%w: password appeared in a data breach", ErrPasswordPolicyViolation)
+)
+
+// Client errors for password policy operations.
+var (
+	// ErrorInvalidRequestFormat is the error returned when the request body is malformed.
+	ErrorInvalidRequestFormat = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "PWP-1001",
+		Error: core.I18nMessage{
+			Key:          "error.passwordpolicyservice.invalid_request_format",
+			DefaultValue: "Invalid request format",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.passwordpolicyservice.invalid_request_format_description",
+			DefaultValue: "The request body is malformed or contains invalid data",
+		},
+	}
+	// ErrorInvalidPolicy is the error returned when the limits of a password policy are out of range.
+	ErrorInvalidPolicy = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "PWP-1002",
+		Error: core.I18nMessage{
+			Key:          "error.passwordpolicyservice.invalid_policy",
+			DefaultValue: "Invalid password policy",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key: "error.passwordpolicyservice.invalid_policy_description",
+			DefaultValue: "The password policy must not have negative values, more than 4 character classes " +
+				"or empty credential attribute names",
+		},
+	}
+	// ErrorOrganizationUnitNotFound is the error returned when the organization unit does not exist.
+	ErrorOrganizationUnitNotFound = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "PWP-1003",
+		Error: core.I18nMessage{
+			Key:          "error.passwordpolicyservice.organization_unit_not_found",
+			DefaultValue: "Organization unit not found",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.passwordpolicyservice.organization_unit_not_found_description",
+			DefaultValue: "The organization unit with the specified id does not exist",
+		},
+	}
+	// ErrorPolicyNotFound is the error returned when no password policy is set for the scope.
+	ErrorPolicyNotFound = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "PWP-1004",
+		Error: core.I18nMessage{
+			Key:          "error.passwordpolicyservice.policy_not_found",
+			DefaultValue: "Password policy not found",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.passwordpolicyservice.policy_not_found_description",
+			DefaultValue: "No password policy is set for the tenant or the organization unit",
+		},
+	}
+)
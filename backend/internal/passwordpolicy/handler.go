@@ -0,0 +1,85 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package passwordpolicy
+
+import (
+	"net/http"
+
+	"github.com/thunder-id/thunderid/internal/entitytype"
+	sysutils "github.com/thunder-id/thunderid/internal/system/utils"
+)
+
+// passwordPolicyHandler is the handler for password policy management operations. The tenant routes have
+// no organization unit ID path value, so the same handlers serve the policies of the tenant and of
+// organization units.
+type passwordPolicyHandler struct {
+	passwordPolicyService PasswordPolicyServiceInterface
+}
+
+// newPasswordPolicyHandler creates a new instance of passwordPolicyHandler.
+func newPasswordPolicyHandler(passwordPolicyService PasswordPolicyServiceInterface) *passwordPolicyHandler {
+	return &passwordPolicyHandler{
+		passwordPolicyService: passwordPolicyService,
+	}
+}
+
+// HandlePolicyGetRequest handles the get password policy request.
+func (h *passwordPolicyHandler) HandlePolicyGetRequest(w http.ResponseWriter, r *http.Request) {
+	policy, svcErr := h.passwordPolicyService.GetPolicy(r.Context(), r.PathValue("id"))
+	if svcErr != nil {
+		sysutils.WriteServiceErrorResponse(w, svcErr, clientErrorStatusCodes)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(w, http.StatusOK, policy)
+}
+
+// HandlePolicyPutRequest handles the set password policy request.
+func (h *passwordPolicyHandler) HandlePolicyPutRequest(w http.ResponseWriter, r *http.Request) {
+	request, err := sysutils.DecodeJSONBody[entitytype.PasswordPolicy](r)
+	if err != nil {
+		sysutils.WriteServiceErrorResponse(w, &ErrorInvalidRequestFormat, clientErrorStatusCodes)
+		return
+	}
+
+	policy, svcErr := h.passwordPolicyService.SetPolicy(r.Context(), r.PathValue("id"), *request)
+	if svcErr != nil {
+		sysutils.WriteServiceErrorResponse(w, svcErr, clientErrorStatusCodes)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(w, http.StatusOK, policy)
+}
+
+// HandlePolicyDeleteRequest handles the delete password policy request.
+func (h *passwordPolicyHandler) HandlePolicyDeleteRequest(w http.ResponseWriter, r *http.Request) {
+	if svcErr := h.passwordPolicyService.DeletePolicy(r.Context(), r.PathValue("id")); svcErr != nil {
+		sysutils.WriteServiceErrorResponse(w, svcErr, clientErrorStatusCodes)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(w, http.StatusNoContent, nil)
+}
+
+// clientErrorStatusCodes maps the client errors of the handler to HTTP status codes. Other client errors are
+// returned with 400 Bad Request.
+var clientErrorStatusCodes = map[string]int{
+	ErrorOrganizationUnitNotFound.Code: http.StatusNotFound,
+	ErrorPolicyNotFound.Code:           http.StatusNotFound,
+}
@@ -0,0 +1,128 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package passwordpolicy
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/entitytype"
+	"github.com/thunder-id/thunderid/internal/system/error/apierror"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+)
+
+type HandlerTestSuite struct {
+	suite.Suite
+	mockService *PasswordPolicyServiceInterfaceMock
+	handler     *passwordPolicyHandler
+}
+
+func TestHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(HandlerTestSuite))
+}
+
+func (s *HandlerTestSuite) SetupTest() {
+	s.mockService = NewPasswordPolicyServiceInterfaceMock(s.T())
+	s.handler = newPasswordPolicyHandler(s.mockService)
+}
+
+func (s *HandlerTestSuite) TestHandlePolicyGetRequest_Tenant() {
+	s.mockService.On("GetPolicy", mock.Anything, TenantScope).
+		Return(&entitytype.PasswordPolicy{MinLength: 12, CheckBreached: true}, nil)
+
+	rr := httptest.NewRecorder()
+	s.handler.HandlePolicyGetRequest(rr, httptest.NewRequest(http.MethodGet, "/password-policies", nil))
+
+	s.Equal(http.StatusOK, rr.Code)
+	s.JSONEq(`{"minLength":12,"checkBreached":true}`, rr.Body.String())
+}
+
+func (s *HandlerTestSuite) TestHandlePolicyGetRequest_NotFound() {
+	s.mockService.On("GetPolicy", mock.Anything, "ou-1").Return(nil, &ErrorPolicyNotFound)
+
+	req := httptest.NewRequest(http.MethodGet, "/password-policies/organization-units/ou-1", nil)
+	req.SetPathValue("id", "ou-1")
+	rr := httptest.NewRecorder()
+	s.handler.HandlePolicyGetRequest(rr, req)
+
+	s.Equal(http.StatusNotFound, rr.Code)
+	var errResp apierror.ErrorResponse
+	s.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &errResp))
+	s.Equal(ErrorPolicyNotFound.Code, errResp.Code)
+}
+
+func (s *HandlerTestSuite) TestHandlePolicyPutRequest_Success() {
+	policy := entitytype.PasswordPolicy{Credentials: []string{"password"}, MinLength: 10, HistoryCount: 5}
+	s.mockService.On("SetPolicy", mock.Anything, "ou-1", policy).Return(&policy, nil)
+
+	req := httptest.NewRequest(http.MethodPut, "/password-policies/organization-units/ou-1",
+		strings.NewReader(`{"credentials":["password"],"minLength":10,"historyCount":5}`))
+	req.SetPathValue("id", "ou-1")
+	rr := httptest.NewRecorder()
+	s.handler.HandlePolicyPutRequest(rr, req)
+
+	s.Equal(http.StatusOK, rr.Code)
+	s.JSONEq(`{"credentials":["password"],"minLength":10,"historyCount":5}`, rr.Body.String())
+}
+
+func (s *HandlerTestSuite) TestHandlePolicyPutRequest_InvalidBody() {
+	rr := httptest.NewRecorder()
+	s.handler.HandlePolicyPutRequest(rr, httptest.NewRequest(http.MethodPut, "/password-policies",
+		strings.NewReader(`{"minLength":`)))
+
+	s.Equal(http.StatusBadRequest, rr.Code)
+	var errResp apierror.ErrorResponse
+	s.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &errResp))
+	s.Equal(ErrorInvalidRequestFormat.Code, errResp.Code)
+	s.mockService.AssertNotCalled(s.T(), "SetPolicy", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (s *HandlerTestSuite) TestHandlePolicyPutRequest_InvalidPolicy() {
+	s.mockService.On("SetPolicy", mock.Anything, TenantScope, mock.Anything).Return(nil, &ErrorInvalidPolicy)
+
+	rr := httptest.NewRecorder()
+	s.handler.HandlePolicyPutRequest(rr, httptest.NewRequest(http.MethodPut, "/password-policies",
+		strings.NewReader(`{"minLength":-1}`)))
+
+	s.Equal(http.StatusBadRequest, rr.Code)
+}
+
+func (s *HandlerTestSuite) TestHandlePolicyDeleteRequest_Success() {
+	s.mockService.On("DeletePolicy", mock.Anything, TenantScope).Return(nil)
+
+	rr := httptest.NewRecorder()
+	s.handler.HandlePolicyDeleteRequest(rr, httptest.NewRequest(http.MethodDelete, "/password-policies", nil))
+
+	s.Equal(http.StatusNoContent, rr.Code)
+}
+
+func (s *HandlerTestSuite) TestHandlePolicyDeleteRequest_InternalError() {
+	s.mockService.On("DeletePolicy", mock.Anything, TenantScope).Return(&serviceerror.InternalServerError)
+
+	rr := httptest.NewRecorder()
+	s.handler.HandlePolicyDeleteRequest(rr, httptest.NewRequest(http.MethodDelete, "/password-policies", nil))
+
+	s.Equal(http.StatusInternalServerError, rr.Code)
+}
@@ -0,0 +1,94 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package passwordpolicy
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/denylist"
+	"github.com/thunder-id/thunderid/internal/entity"
+	"github.com/thunder-id/thunderid/internal/entitytype"
+	oupkg "github.com/thunder-id/thunderid/internal/ou"
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/cryptolab/hash"
+	syshttp "github.com/thunder-id/thunderid/internal/system/http"
+	"github.com/thunder-id/thunderid/internal/system/middleware"
+)
+
+// Initialize initializes the password policy service and registers its routes. The user service enforces
+// the returned service when credentials are set.
+func Initialize(
+	mux *http.ServeMux,
+	ouService oupkg.OrganizationUnitServiceInterface,
+	entityTypeService entitytype.EntityTypeServiceInterface,
+	entityService entity.EntityServiceInterface,
+	denyListService denylist.DenyListServiceInterface,
+	hashService hash.HashServiceInterface,
+) (PasswordPolicyServiceInterface, error) {
+	store, transactioner, err := newPasswordPolicyStore()
+	if err != nil {
+		return nil, err
+	}
+
+	policyConfig := config.GetServerRuntime().Config.PasswordPolicy
+	timeout := defaultBreachCheckTimeout
+	if policyConfig.BreachCheckTimeout > 0 {
+		timeout = time.Duration(policyConfig.BreachCheckTimeout) * time.Second
+	}
+	passwordPolicyService := newPasswordPolicyService(store, transactioner, ouService, entityTypeService,
+		entityService, denyListService, hashService, syshttp.NewHTTPClientWithTimeout(timeout),
+		policyConfig.BreachCheckURL, policyConfig.HistoryDepth)
+
+	passwordPolicyHandler := newPasswordPolicyHandler(passwordPolicyService)
+	registerRoutes(mux, passwordPolicyHandler)
+
+	return passwordPolicyService, nil
+}
+
+// registerRoutes registers the routes for password policy management operations.
+func registerRoutes(mux *http.ServeMux, passwordPolicyHandler *passwordPolicyHandler) {
+	opts := middleware.CORSOptions{
+		AllowedMethods:   []string{"GET", "PUT", "DELETE"},
+		AllowedHeaders:   middleware.DefaultAllowedHeaders,
+		AllowCredentials: true,
+		MaxAge:           600,
+	}
+	mux.HandleFunc(middleware.WithCORS("GET /password-policies",
+		passwordPolicyHandler.HandlePolicyGetRequest, opts))
+	mux.HandleFunc(middleware.WithCORS("PUT /password-policies",
+		passwordPolicyHandler.HandlePolicyPutRequest, opts))
+	mux.HandleFunc(middleware.WithCORS("DELETE /password-policies",
+		passwordPolicyHandler.HandlePolicyDeleteRequest, opts))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS /password-policies",
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, opts))
+
+	mux.HandleFunc(middleware.WithCORS("GET /password-policies/organization-units/{id}",
+		passwordPolicyHandler.HandlePolicyGetRequest, opts))
+	mux.HandleFunc(middleware.WithCORS("PUT /password-policies/organization-units/{id}",
+		passwordPolicyHandler.HandlePolicyPutRequest, opts))
+	mux.HandleFunc(middleware.WithCORS("DELETE /password-policies/organization-units/{id}",
+		passwordPolicyHandler.HandlePolicyDeleteRequest, opts))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS /password-policies/organization-units/{id}",
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, opts))
+}
@@ -0,0 +1,34 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package passwordpolicy
+
+import "encoding/json"
+
+// PasswordTarget identifies the user whose new passwords are checked against the password policy. The
+// policy of the user type takes precedence over the policy of the organization unit, which takes
+// precedence over the policy of the tenant.
+type PasswordTarget struct {
+	// UserID is the ID of the user. It is empty when the user is being created, in which case the
+	// password history is not checked.
+	UserID   string
+	OUID     string
+	UserType string
+	// Attributes are the attributes of the user, matched by the dictionary check.
+	Attributes json.RawMessage
+}
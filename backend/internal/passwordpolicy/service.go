@@ -0,0 +1,475 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package passwordpolicy
+
+import (
+	"bufio"
+	"context"
+	"crypto/sha1" //nolint:gosec // SHA-1 is required by the range API of the breached password service
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"net/http"
+	"slices"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/thunder-id/thunderid/internal/denylist"
+	"github.com/thunder-id/thunderid/internal/entity"
+	"github.com/thunder-id/thunderid/internal/entitytype"
+	oupkg "github.com/thunder-id/thunderid/internal/ou"
+	"github.com/thunder-id/thunderid/internal/system/cryptolab/hash"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	syshttp "github.com/thunder-id/thunderid/internal/system/http"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/internal/system/transaction"
+)
+
+// PasswordPolicyServiceInterface defines the interface for the password policy service.
+type PasswordPolicyServiceInterface interface {
+	GetPolicy(ctx context.Context, ouID string) (*entitytype.PasswordPolicy, *serviceerror.ServiceError)
+	SetPolicy(ctx context.Context, ouID string,
+		policy entitytype.PasswordPolicy) (*entitytype.PasswordPolicy, *serviceerror.ServiceError)
+	DeletePolicy(ctx context.Context, ouID string) *serviceerror.ServiceError
+	CheckPasswords(ctx context.Context, target PasswordTarget, passwords map[string]string) error
+	RecordPasswords(ctx context.Context, target PasswordTarget, credentialTypes []string) error
+}
+
+// passwordPolicyService is the default implementation of the PasswordPolicyServiceInterface.
+type passwordPolicyService struct {
+	store             passwordPolicyStoreInterface
+	transactioner     transaction.Transactioner
+	ouService         oupkg.OrganizationUnitServiceInterface
+	entityTypeService entitytype.EntityTypeServiceInterface
+	entityService     entity.EntityServiceInterface
+	denyListService   denylist.DenyListServiceInterface
+	hashService       hash.HashServiceInterface
+	httpClient        syshttp.HTTPClientInterface
+	breachCheckURL    string
+	historyDepth      int
+	logger            *log.Logger
+}
+
+// newPasswordPolicyService creates a new instance of passwordPolicyService.
+func newPasswordPolicyService(
+	store passwordPolicyStoreInterface,
+	transactioner transaction.Transactioner,
+	ouService oupkg.OrganizationUnitServiceInterface,
+	entityTypeService entitytype.EntityTypeServiceInterface,
+	entityService entity.EntityServiceInterface,
+	denyListService denylist.DenyListServiceInterface,
+	hashService hash.HashServiceInterface,
+	httpClient syshttp.HTTPClientInterface,
+	breachCheckURL string,
+	historyDepth int,
+) PasswordPolicyServiceInterface {
+	return &passwordPolicyService{
+		store:             store,
+		transactioner:     transactioner,
+		ouService:         ouService,
+		entityTypeService: entityTypeService,
+		entityService:     entityService,
+		denyListService:   denyListService,
+		hashService:       hashService,
+		httpClient:        httpClient,
+		breachCheckURL:    strings.TrimSuffix(breachCheckURL, "/"),
+		historyDepth:      historyDepth,
+		logger:            log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName)),
+	}
+}
+
+// GetPolicy retrieves the password policy of an organization unit, or of the tenant when the organization
+// unit ID is empty.
+func (s *passwordPolicyService) GetPolicy(
+	ctx context.Context, ouID string) (*entitytype.PasswordPolicy, *serviceerror.ServiceError) {
+	if svcErr := s.validateScope(ctx, ouID); svcErr != nil {
+		return nil, svcErr
+	}
+
+	policy, err := s.store.GetPolicy(ctx, ouID)
+	if err != nil {
+		s.logger.Error("Failed to get password policy", log.Error(err), log.String("ouID", ouID))
+		return nil, &serviceerror.InternalServerError
+	}
+	if policy == nil {
+		return nil, &ErrorPolicyNotFound
+	}
+	return policy, nil
+}
+
+// SetPolicy replaces the password policy of an organization unit, or of the tenant when the organization
+// unit ID is empty.
+func (s *passwordPolicyService) SetPolicy(ctx context.Context, ouID string,
+	policy entitytype.PasswordPolicy) (*entitytype.PasswordPolicy, *serviceerror.ServiceError) {
+	if svcErr := s.validateScope(ctx, ouID); svcErr != nil {
+		return nil, svcErr
+	}
+	if !policy.IsValid() || slices.Contains(policy.Credentials, "") {
+		return nil, &ErrorInvalidPolicy
+	}
+
+	if err := s.store.SavePolicy(ctx, ouID, policy); err != nil {
+		s.logger.Error("Failed to set password policy", log.Error(err), log.String("ouID", ouID))
+		return nil, &serviceerror.InternalServerError
+	}
+
+	s.logger.Debug("Set password policy", log.String("ouID", ouID))
+	return &policy, nil
+}
+
+// DeletePolicy removes the password policy of an organization unit, or of the tenant when the organization
+// unit ID is empty.
+func (s *passwordPolicyService) DeletePolicy(ctx context.Context, ouID string) *serviceerror.ServiceError {
+	if svcErr := s.validateScope(ctx, ouID); svcErr != nil {
+		return svcErr
+	}
+
+	if err := s.store.DeletePolicy(ctx, ouID); err != nil {
+		s.logger.Error("Failed to delete password policy", log.Error(err), log.String("ouID", ouID))
+		return &serviceerror.InternalServerError
+	}
+	return nil
+}
+
+// CheckPasswords checks new credential values against the password policy that applies to the user. It
+// returns an error wrapping ErrPasswordPolicyViolation when a value does not satisfy the policy.
+func (s *passwordPolicyService) CheckPasswords(ctx context.Context, target PasswordTarget,
+	passwords map[string]string) error {
+	if len(passwords) == 0 {
+		return nil
+	}
+	policy, err := s.resolvePolicy(ctx, target)
+	if err != nil || policy == nil {
+		return err
+	}
+
+	for _, credentialType := range slices.Sorted(maps.Keys(passwords)) {
+		if !policy.AppliesTo(credentialType) {
+			continue
+		}
+		if err := s.checkPassword(ctx, policy, target, credentialType, passwords); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// RecordPasswords adds the current values of the given credential types of a user to its password history,
+// so that they cannot be reused while they are among the most recent passwords of the user. Nothing is
+// recorded when the policy that applies to the user does not prevent reuse.
+func (s *passwordPolicyService) RecordPasswords(ctx context.Context, target PasswordTarget,
+	credentialTypes []string) error {
+	if target.UserID == "" || len(credentialTypes) == 0 {
+		return nil
+	}
+	policy, err := s.resolvePolicy(ctx, target)
+	if err != nil || policy == nil || policy.HistoryCount == 0 {
+		return err
+	}
+
+	return s.transactioner.Transact(ctx, func(txCtx context.Context) error {
+		for _, credentialType := range credentialTypes {
+			if !policy.AppliesTo(credentialType) {
+				continue
+			}
+			current, err := s.entityService.GetCredentialsByType(txCtx, target.UserID, credentialType)
+			if err != nil {
+				return fmt.Errorf("failed to get credentials: %w", err)
+			}
+			for _, credential := range current {
+				if err := s.store.AddHistory(txCtx, target.UserID, credentialType, credential); err != nil {
+					return err
+				}
+			}
+			if err := s.store.TrimHistory(txCtx, target.UserID, credentialType, policy.HistoryCount); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// resolvePolicy returns the password policy that applies to the user. The configured history depth applies
+// when the policy does not set a history count, or when no policy is set. It returns nil when no policy is
+// set and the history depth is zero.
+func (s *passwordPolicyService) resolvePolicy(
+	ctx context.Context, target PasswordTarget) (*entitytype.PasswordPolicy, error) {
+	policy, err := s.findPolicy(ctx, target)
+	if err != nil || s.historyDepth == 0 {
+		return policy, err
+	}
+
+	if policy == nil {
+		return &entitytype.PasswordPolicy{HistoryCount: s.historyDepth}, nil
+	}
+	if policy.HistoryCount == 0 {
+		withHistory := *policy
+		withHistory.HistoryCount = s.historyDepth
+		return &withHistory, nil
+	}
+	return policy, nil
+}
+
+// findPolicy returns the password policy set for the user: the policy of its user type, or else the policy
+// of its organization unit, or else the policy of the tenant. It returns nil when none is set.
+func (s *passwordPolicyService) findPolicy(
+	ctx context.Context, target PasswordTarget) (*entitytype.PasswordPolicy, error) {
+	if target.UserType != "" {
+		policy, svcErr := s.entityTypeService.GetPasswordPolicy(ctx, entitytype.TypeCategoryUser, target.UserType)
+		if svcErr != nil {
+			return nil, fmt.Errorf("failed to get password policy of user type: %s",
+				svcErr.ErrorDescription.DefaultValue)
+		}
+		if policy != nil {
+			return policy, nil
+		}
+	}
+
+	scopes := []string{TenantScope}
+	if target.OUID != TenantScope {
+		scopes = []string{target.OUID, TenantScope}
+	}
+	for _, scope := range scopes {
+		policy, err := s.store.GetPolicy(ctx, scope)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get password policy: %w", err)
+		}
+		if policy != nil {
+			return policy, nil
+		}
+	}
+	return nil, nil
+}
+
+// checkPassword checks a new credential value against the policy. The checks that need a lookup run after
+// the local ones, and the breached password service is queried last.
+func (s *passwordPolicyService) checkPassword(ctx context.Context, policy *entitytype.PasswordPolicy,
+	target PasswordTarget, credentialType string, passwords map[string]string) error {
+	password := passwords[credentialType]
+	if utf8.RuneCountInString(password) < policy.MinLength {
+		return ErrPasswordTooShort
+	}
+	if countCharacterClasses(password) < policy.MinCharacterClasses {
+		return ErrPasswordTooSimple
+	}
+
+	if policy.CheckDictionary {
+		inDictionary, err := s.isDictionaryPassword(ctx, password, target.Attributes, passwords)
+		if err != nil {
+			return err
+		}
+		if inDictionary {
+			return ErrPasswordInDictionary
+		}
+	}
+	if policy.HistoryCount > 0 && target.UserID != "" {
+		reused, err := s.isReusedPassword(ctx, target.UserID, credentialType, password, policy.HistoryCount)
+		if err != nil {
+			return err
+		}
+		if reused {
+			return ErrPasswordReused
+		}
+	}
+	if policy.CheckBreached && s.isBreachedPassword(ctx, password) {
+		return ErrPasswordBreached
+	}
+	return nil
+}
+
+// isDictionaryPassword reports whether the password contains a string attribute value of the user, or is
+// on the password deny list once its leading and trailing non-letters are removed. The new credential
+// values are not matched as attributes.
+func (s *passwordPolicyService) isDictionaryPassword(ctx context.Context, password string,
+	attributes json.RawMessage, passwords map[string]string) (bool, error) {
+	lowered := strings.ToLower(password)
+	for _, value := range attributeValues(attributes, passwords) {
+		if strings.Contains(lowered, value) {
+			return true, nil
+		}
+	}
+
+	if s.denyListService == nil {
+		return false, nil
+	}
+	word := strings.TrimFunc(password, func(r rune) bool { return !unicode.IsLetter(r) })
+	if word == "" {
+		return false, nil
+	}
+	denied, svcErr := s.denyListService.IsDenied(ctx, denylist.ListTypePassword, word)
+	if svcErr != nil {
+		return false, fmt.Errorf("failed to check password deny list: %s", svcErr.ErrorDescription.DefaultValue)
+	}
+	return denied, nil
+}
+
+// isReusedPassword reports whether the password matches the current value or one of the most recent
+// previous values of the credential type of the user.
+func (s *passwordPolicyService) isReusedPassword(ctx context.Context, userID, credentialType, password string,
+	historyCount int) (bool, error) {
+	current, err := s.entityService.GetCredentialsByType(ctx, userID, credentialType)
+	if err != nil {
+		return false, fmt.Errorf("failed to get credentials: %w", err)
+	}
+	history, err := s.store.GetHistory(ctx, userID, credentialType, historyCount)
+	if err != nil {
+		return false, fmt.Errorf("failed to get password history: %w", err)
+	}
+
+	checked := make(map[string]bool, len(current)+len(history))
+	for _, stored := range append(current, history...) {
+		if checked[stored.Value] {
+			continue
+		}
+		checked[stored.Value] = true
+		matched, err := s.hashService.Verify([]byte(password), hash.Credential{
+			Algorithm: stored.StorageAlgo,
+			Hash:      stored.Value,
+			Parameters: hash.CredParameters{
+				Salt:       stored.StorageAlgoParams.Salt,
+				Iterations: stored.StorageAlgoParams.Iterations,
+				KeySize:    stored.StorageAlgoParams.KeySize,
+			},
+		})
+		if err == nil && matched {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// isBreachedPassword reports whether the password was found in the breached password service. Only the
+// first characters of the SHA-1 hash of the password are sent. The password is accepted when the service
+// cannot be reached, so that an outage of the service does not block password changes.
+func (s *passwordPolicyService) isBreachedPassword(ctx context.Context, password string) bool {
+	if s.breachCheckURL == "" {
+		return false
+	}
+
+	sum := sha1.Sum([]byte(password)) //nolint:gosec // SHA-1 is required by the range API
+	digest := strings.ToUpper(hex.EncodeToString(sum[:]))
+	prefix, suffix := digest[:breachCheckPrefixLength], digest[breachCheckPrefixLength:]
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.breachCheckURL+"/"+prefix, nil)
+	if err != nil {
+		s.logger.Warn("Failed to create breached password request", log.Error(err))
+		return false
+	}
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		s.logger.Warn("Failed to query the breached password service", log.Error(err))
+		return false
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+	if resp.StatusCode != http.StatusOK {
+		s.logger.Warn("Breached password service returned an unexpected status", log.Int("status", resp.StatusCode))
+		return false
+	}
+
+	scanner := bufio.NewScanner(resp.Body)
+	for scanner.Scan() {
+		hashSuffix, count, found := strings.Cut(strings.TrimSpace(scanner.Text()), ":")
+		// Padding entries have a count of zero.
+		if found && strings.EqualFold(hashSuffix, suffix) && strings.TrimLeft(count, "0") != "" {
+			return true
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		s.logger.Warn("Failed to read the breached password service response", log.Error(err))
+	}
+	return false
+}
+
+// validateScope checks that the organization unit of a scope exists.
+func (s *passwordPolicyService) validateScope(ctx context.Context, ouID string) *serviceerror.ServiceError {
+	if ouID == TenantScope {
+		return nil
+	}
+
+	exists, svcErr := s.ouService.IsOrganizationUnitExists(ctx, ouID)
+	if svcErr != nil {
+		s.logger.Error("Failed to check organization unit existence", log.String("ouID", ouID),
+			log.String("error", svcErr.Error.DefaultValue))
+		return &serviceerror.InternalServerError
+	}
+	if !exists {
+		return &ErrorOrganizationUnitNotFound
+	}
+	return nil
+}
+
+// countCharacterClasses counts the character classes used by a password out of lowercase letters,
+// uppercase letters, digits and symbols.
+func countCharacterClasses(password string) int {
+	var lower, upper, digit, symbol bool
+	for _, r := range password {
+		switch {
+		case unicode.IsLower(r):
+			lower = true
+		case unicode.IsUpper(r):
+			upper = true
+		case unicode.IsDigit(r):
+			digit = true
+		default:
+			symbol = true
+		}
+	}
+
+	count := 0
+	for _, used := range []bool{lower, upper, digit, symbol} {
+		if used {
+			count++
+		}
+	}
+	return count
+}
+
+// attributeValues returns the lowercased top-level string attribute values of a user that are long enough
+// to be matched by the dictionary check, along with the local part of email addresses. Attributes that hold
+// the new credential values are skipped.
+func attributeValues(attributes json.RawMessage, passwords map[string]string) []string {
+	if len(attributes) == 0 {
+		return nil
+	}
+	var attrs map[string]interface{}
+	if err := json.Unmarshal(attributes, &attrs); err != nil {
+		return nil
+	}
+
+	values := make([]string, 0, len(attrs))
+	for name, raw := range attrs {
+		value, ok := raw.(string)
+		if _, isPassword := passwords[name]; !ok || isPassword {
+			continue
+		}
+		value = strings.ToLower(value)
+		if localPart, _, isEmail := strings.Cut(value, "@"); isEmail &&
+			utf8.RuneCountInString(localPart) >= minAttributeValueLength {
+			values = append(values, localPart)
+		}
+		if utf8.RuneCountInString(value) >= minAttributeValueLength {
+			values = append(values, value)
+		}
+	}
+	return values
+}
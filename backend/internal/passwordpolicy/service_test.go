@@ -0,0 +1,411 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package passwordpolicy
+
+import (
+	"context"
+	"crypto/sha1" //nolint:gosec // SHA-1 is required by the range API of the breached password service
+	"encoding/hex"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/denylist"
+	"github.com/thunder-id/thunderid/internal/entity"
+	"github.com/thunder-id/thunderid/internal/entitytype"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/transaction"
+	"github.com/thunder-id/thunderid/tests/mocks/crypto/hashmock"
+	"github.com/thunder-id/thunderid/tests/mocks/denylistmock"
+	"github.com/thunder-id/thunderid/tests/mocks/entitymock"
+	"github.com/thunder-id/thunderid/tests/mocks/entitytypemock"
+	"github.com/thunder-id/thunderid/tests/mocks/httpmock"
+	"github.com/thunder-id/thunderid/tests/mocks/oumock"
+)
+
+const testBreachCheckURL = "https://breach.example.com/range"
+
+type PasswordPolicyServiceTestSuite struct {
+	suite.Suite
+	mockStore             *passwordPolicyStoreInterfaceMock
+	mockOUService         *oumock.OrganizationUnitServiceInterfaceMock
+	mockEntityTypeService *entitytypemock.EntityTypeServiceInterfaceMock
+	mockEntityService     *entitymock.EntityServiceInterfaceMock
+	mockDenyListService   *denylistmock.DenyListServiceInterfaceMock
+	mockHashService       *hashmock.HashServiceInterfaceMock
+	mockHTTPClient        *httpmock.HTTPClientInterfaceMock
+	service               *passwordPolicyService
+}
+
+func TestPasswordPolicyServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(PasswordPolicyServiceTestSuite))
+}
+
+func (suite *PasswordPolicyServiceTestSuite) SetupTest() {
+	suite.mockStore = newPasswordPolicyStoreInterfaceMock(suite.T())
+	suite.mockOUService = oumock.NewOrganizationUnitServiceInterfaceMock(suite.T())
+	suite.mockEntityTypeService = entitytypemock.NewEntityTypeServiceInterfaceMock(suite.T())
+	suite.mockEntityService = entitymock.NewEntityServiceInterfaceMock(suite.T())
+	suite.mockDenyListService = denylistmock.NewDenyListServiceInterfaceMock(suite.T())
+	suite.mockHashService = hashmock.NewHashServiceInterfaceMock(suite.T())
+	suite.mockHTTPClient = httpmock.NewHTTPClientInterfaceMock(suite.T())
+	suite.service = newPasswordPolicyService(suite.mockStore, transaction.NewNoOpTransactioner(),
+		suite.mockOUService, suite.mockEntityTypeService, suite.mockEntityService, suite.mockDenyListService,
+		suite.mockHashService, suite.mockHTTPClient, testBreachCheckURL+"/", 0).(*passwordPolicyService)
+}
+
+// expectPolicy makes the given policy the one that applies to users of the test user type.
+func (suite *PasswordPolicyServiceTestSuite) expectPolicy(policy *entitytype.PasswordPolicy) {
+	suite.mockEntityTypeService.On("GetPasswordPolicy", mock.Anything, entitytype.TypeCategoryUser, "employee").
+		Return(policy, nil)
+}
+
+// target returns a password target of the test user type with the given attributes.
+func target(userID, attributes string) PasswordTarget {
+	return PasswordTarget{UserID: userID, OUID: "ou-1", UserType: "employee", Attributes: []byte(attributes)}
+}
+
+// breachResponse returns a range API response that lists the hash suffix of the password with a count.
+func breachResponse(password, count string) *http.Response {
+	sum := sha1.Sum([]byte(password)) //nolint:gosec // SHA-1 is required by the range API
+	suffix := strings.ToUpper(hex.EncodeToString(sum[:]))[breachCheckPrefixLength:]
+	body := "0018A45C4D1DEF81644B54AB7F969B88D65:1\r\n" + suffix + ":" + count + "\r\n"
+	return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(body))}
+}
+
+func (suite *PasswordPolicyServiceTestSuite) TestGetPolicy_Tenant() {
+	suite.mockStore.On("GetPolicy", mock.Anything, TenantScope).
+		Return(&entitytype.PasswordPolicy{MinLength: 12}, nil)
+
+	policy, svcErr := suite.service.GetPolicy(suite.T().Context(), TenantScope)
+
+	suite.Nil(svcErr)
+	suite.Equal(12, policy.MinLength)
+	suite.mockOUService.AssertNotCalled(suite.T(), "IsOrganizationUnitExists", mock.Anything, mock.Anything)
+}
+
+func (suite *PasswordPolicyServiceTestSuite) TestGetPolicy_NotFound() {
+	suite.mockOUService.On("IsOrganizationUnitExists", mock.Anything, "ou-1").Return(true, nil)
+	suite.mockStore.On("GetPolicy", mock.Anything, "ou-1").Return(nil, nil)
+
+	policy, svcErr := suite.service.GetPolicy(suite.T().Context(), "ou-1")
+
+	suite.Nil(policy)
+	suite.Equal(&ErrorPolicyNotFound, svcErr)
+}
+
+func (suite *PasswordPolicyServiceTestSuite) TestGetPolicy_OrganizationUnitNotFound() {
+	suite.mockOUService.On("IsOrganizationUnitExists", mock.Anything, "missing").Return(false, nil)
+
+	_, svcErr := suite.service.GetPolicy(suite.T().Context(), "missing")
+
+	suite.Equal(&ErrorOrganizationUnitNotFound, svcErr)
+}
+
+func (suite *PasswordPolicyServiceTestSuite) TestGetPolicy_OrganizationUnitCheckFails() {
+	suite.mockOUService.On("IsOrganizationUnitExists", mock.Anything, "ou-1").
+		Return(false, &serviceerror.InternalServerError)
+
+	_, svcErr := suite.service.GetPolicy(suite.T().Context(), "ou-1")
+
+	suite.Equal(&serviceerror.InternalServerError, svcErr)
+}
+
+func (suite *PasswordPolicyServiceTestSuite) TestSetPolicy_Success() {
+	policy := entitytype.PasswordPolicy{MinLength: 10, MinCharacterClasses: 3, HistoryCount: 5}
+	suite.mockOUService.On("IsOrganizationUnitExists", mock.Anything, "ou-1").Return(true, nil)
+	suite.mockStore.On("SavePolicy", mock.Anything, "ou-1", policy).Return(nil)
+
+	saved, svcErr := suite.service.SetPolicy(suite.T().Context(), "ou-1", policy)
+
+	suite.Nil(svcErr)
+	suite.Equal(policy, *saved)
+}
+
+func (suite *PasswordPolicyServiceTestSuite) TestSetPolicy_InvalidPolicy() {
+	testCases := []entitytype.PasswordPolicy{
+		{MinLength: -1},
+		{MinCharacterClasses: entitytype.PasswordCharacterClasses + 1},
+		{HistoryCount: -1},
+		{Credentials: []string{""}},
+	}
+	for _, policy := range testCases {
+		_, svcErr := suite.service.SetPolicy(suite.T().Context(), TenantScope, policy)
+
+		suite.Equal(&ErrorInvalidPolicy, svcErr)
+	}
+	suite.mockStore.AssertNotCalled(suite.T(), "SavePolicy", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *PasswordPolicyServiceTestSuite) TestSetPolicy_StoreError() {
+	suite.mockStore.On("SavePolicy", mock.Anything, TenantScope, mock.Anything).Return(errors.New("db error"))
+
+	_, svcErr := suite.service.SetPolicy(suite.T().Context(), TenantScope, entitytype.PasswordPolicy{MinLength: 8})
+
+	suite.Equal(&serviceerror.InternalServerError, svcErr)
+}
+
+func (suite *PasswordPolicyServiceTestSuite) TestDeletePolicy_Success() {
+	suite.mockOUService.On("IsOrganizationUnitExists", mock.Anything, "ou-1").Return(true, nil)
+	suite.mockStore.On("DeletePolicy", mock.Anything, "ou-1").Return(nil)
+
+	suite.Nil(suite.service.DeletePolicy(suite.T().Context(), "ou-1"))
+}
+
+func (suite *PasswordPolicyServiceTestSuite) TestCheckPasswords_NoPolicy() {
+	suite.expectPolicy(nil)
+	suite.mockStore.On("GetPolicy", mock.Anything, "ou-1").Return(nil, nil)
+	suite.mockStore.On("GetPolicy", mock.Anything, TenantScope).Return(nil, nil)
+
+	err := suite.service.CheckPasswords(suite.T().Context(), target("", "{}"), map[string]string{"password": "a"})
+
+	suite.NoError(err)
+}
+
+func (suite *PasswordPolicyServiceTestSuite) TestCheckPasswords_NoPasswords() {
+	suite.NoError(suite.service.CheckPasswords(suite.T().Context(), target("", "{}"), nil))
+	suite.mockEntityTypeService.AssertNotCalled(suite.T(), "GetPasswordPolicy", mock.Anything, mock.Anything,
+		mock.Anything)
+}
+
+func (suite *PasswordPolicyServiceTestSuite) TestCheckPasswords_FallsBackToOrganizationUnitPolicy() {
+	suite.expectPolicy(nil)
+	suite.mockStore.On("GetPolicy", mock.Anything, "ou-1").Return(&entitytype.PasswordPolicy{MinLength: 10}, nil)
+
+	err := suite.service.CheckPasswords(suite.T().Context(), target("", "{}"),
+		map[string]string{"password": "short"})
+
+	suite.ErrorIs(err, ErrPasswordTooShort)
+	suite.mockStore.AssertNotCalled(suite.T(), "GetPolicy", mock.Anything, TenantScope)
+}
+
+func (suite *PasswordPolicyServiceTestSuite) TestCheckPasswords_FallsBackToTenantPolicy() {
+	suite.expectPolicy(nil)
+	suite.mockStore.On("GetPolicy", mock.Anything, "ou-1").Return(nil, nil)
+	suite.mockStore.On("GetPolicy", mock.Anything, TenantScope).
+		Return(&entitytype.PasswordPolicy{MinLength: 10}, nil)
+
+	err := suite.service.CheckPasswords(suite.T().Context(), target("", "{}"),
+		map[string]string{"password": "short"})
+
+	suite.ErrorIs(err, ErrPasswordTooShort)
+	suite.ErrorIs(err, ErrPasswordPolicyViolation)
+}
+
+func (suite *PasswordPolicyServiceTestSuite) TestCheckPasswords_UserTypePolicyError() {
+	suite.mockEntityTypeService.On("GetPasswordPolicy", mock.Anything, entitytype.TypeCategoryUser, "employee").
+		Return(nil, &serviceerror.InternalServerError)
+
+	err := suite.service.CheckPasswords(suite.T().Context(), target("", "{}"), map[string]string{"password": "a"})
+
+	suite.Error(err)
+	suite.NotErrorIs(err, ErrPasswordPolicyViolation)
+}
+
+func (suite *PasswordPolicyServiceTestSuite) TestCheckPasswords_CharacterClasses() {
+	suite.expectPolicy(&entitytype.PasswordPolicy{MinCharacterClasses: 3})
+
+	err := suite.service.CheckPasswords(suite.T().Context(), target("", "{}"),
+		map[string]string{"password": "lowercase1"})
+	suite.ErrorIs(err, ErrPasswordTooSimple)
+
+	err = suite.service.CheckPasswords(suite.T().Context(), target("", "{}"),
+		map[string]string{"password": "Lowercase1"})
+	suite.NoError(err)
+}
+
+func (suite *PasswordPolicyServiceTestSuite) TestCheckPasswords_SkipsOtherCredentials() {
+	suite.expectPolicy(&entitytype.PasswordPolicy{Credentials: []string{"password"}, MinLength: 8})
+
+	err := suite.service.CheckPasswords(suite.T().Context(), target("", "{}"), map[string]string{"pin": "1234"})
+
+	suite.NoError(err)
+}
+
+func (suite *PasswordPolicyServiceTestSuite) TestCheckPasswords_DictionaryAttributeValue() {
+	suite.expectPolicy(&entitytype.PasswordPolicy{CheckDictionary: true})
+	attributes := `{"username":"jsmith","email":"alice.w@example.com","password":"Secret#99"}`
+
+	err := suite.service.CheckPasswords(suite.T().Context(), target("", attributes),
+		map[string]string{"password": "xJSmith#2026"})
+	suite.ErrorIs(err, ErrPasswordInDictionary)
+
+	err = suite.service.CheckPasswords(suite.T().Context(), target("", attributes),
+		map[string]string{"password": "Alice.W-2026"})
+	suite.ErrorIs(err, ErrPasswordInDictionary)
+}
+
+func (suite *PasswordPolicyServiceTestSuite) TestCheckPasswords_DictionaryDenyList() {
+	suite.expectPolicy(&entitytype.PasswordPolicy{CheckDictionary: true})
+	suite.mockDenyListService.On("IsDenied", mock.Anything, denylist.ListTypePassword, "Summer").
+		Return(true, nil).Once()
+	suite.mockDenyListService.On("IsDenied", mock.Anything, denylist.ListTypePassword, "Correct-Horse").
+		Return(false, nil).Once()
+
+	err := suite.service.CheckPasswords(suite.T().Context(), target("", "{}"),
+		map[string]string{"password": "2024Summer!"})
+	suite.ErrorIs(err, ErrPasswordInDictionary)
+
+	err = suite.service.CheckPasswords(suite.T().Context(), target("", "{}"),
+		map[string]string{"password": "Correct-Horse9"})
+	suite.NoError(err)
+}
+
+func (suite *PasswordPolicyServiceTestSuite) TestCheckPasswords_Reused() {
+	suite.expectPolicy(&entitytype.PasswordPolicy{HistoryCount: 3})
+	current := entity.StoredCredential{StorageAlgo: "PBKDF2", Value: "current-hash"}
+	previous := entity.StoredCredential{StorageAlgo: "PBKDF2", Value: "previous-hash"}
+	suite.mockEntityService.On("GetCredentialsByType", mock.Anything, "user-1", "password").
+		Return([]entity.StoredCredential{current}, nil)
+	suite.mockStore.On("GetHistory", mock.Anything, "user-1", "password", 3).
+		Return([]entity.StoredCredential{current, previous}, nil)
+	suite.mockHashService.On("Verify", []byte("Old#Pass1"), mock.Anything).Return(false, nil).Once()
+	suite.mockHashService.On("Verify", []byte("Old#Pass1"), mock.Anything).Return(true, nil).Once()
+
+	err := suite.service.CheckPasswords(suite.T().Context(), target("user-1", "{}"),
+		map[string]string{"password": "Old#Pass1"})
+
+	suite.ErrorIs(err, ErrPasswordReused)
+	suite.mockHashService.AssertNumberOfCalls(suite.T(), "Verify", 2)
+}
+
+func (suite *PasswordPolicyServiceTestSuite) TestCheckPasswords_HistorySkippedForNewUser() {
+	suite.expectPolicy(&entitytype.PasswordPolicy{HistoryCount: 3})
+
+	err := suite.service.CheckPasswords(suite.T().Context(), target("", "{}"),
+		map[string]string{"password": "New#Pass1"})
+
+	suite.NoError(err)
+	suite.mockEntityService.AssertNotCalled(suite.T(), "GetCredentialsByType", mock.Anything, mock.Anything,
+		mock.Anything)
+}
+
+func (suite *PasswordPolicyServiceTestSuite) TestCheckPasswords_Breached() {
+	suite.expectPolicy(&entitytype.PasswordPolicy{CheckBreached: true})
+	suite.mockHTTPClient.On("Do", mock.MatchedBy(func(req *http.Request) bool {
+		return req.Method == http.MethodGet && strings.HasPrefix(req.URL.String(), testBreachCheckURL+"/") &&
+			len(req.URL.String()) == len(testBreachCheckURL)+1+breachCheckPrefixLength
+	})).Return(breachResponse("P@ssw0rd", "3645804"), nil).Once()
+
+	err := suite.service.CheckPasswords(suite.T().Context(), target("", "{}"),
+		map[string]string{"password": "P@ssw0rd"})
+
+	suite.ErrorIs(err, ErrPasswordBreached)
+}
+
+func (suite *PasswordPolicyServiceTestSuite) TestCheckPasswords_BreachPaddingEntryIgnored() {
+	suite.expectPolicy(&entitytype.PasswordPolicy{CheckBreached: true})
+	suite.mockHTTPClient.On("Do", mock.Anything).Return(breachResponse("Unique#Pass1", "0"), nil).Once()
+
+	err := suite.service.CheckPasswords(suite.T().Context(), target("", "{}"),
+		map[string]string{"password": "Unique#Pass1"})
+
+	suite.NoError(err)
+}
+
+func (suite *PasswordPolicyServiceTestSuite) TestCheckPasswords_BreachServiceUnavailable() {
+	suite.expectPolicy(&entitytype.PasswordPolicy{CheckBreached: true})
+	suite.mockHTTPClient.On("Do", mock.Anything).Return(nil, errors.New("connection refused")).Once()
+
+	err := suite.service.CheckPasswords(suite.T().Context(), target("", "{}"),
+		map[string]string{"password": "Unique#Pass1"})
+
+	suite.NoError(err)
+}
+
+func (suite *PasswordPolicyServiceTestSuite) TestCheckPasswords_HistoryDepthWithoutPolicy() {
+	suite.service.historyDepth = 4
+	suite.expectPolicy(nil)
+	suite.mockStore.On("GetPolicy", mock.Anything, "ou-1").Return(nil, nil)
+	suite.mockStore.On("GetPolicy", mock.Anything, TenantScope).Return(nil, nil)
+	suite.mockEntityService.On("GetCredentialsByType", mock.Anything, "user-1", "password").
+		Return([]entity.StoredCredential{{StorageAlgo: "PBKDF2", Value: "current-hash"}}, nil)
+	suite.mockStore.On("GetHistory", mock.Anything, "user-1", "password", 4).
+		Return([]entity.StoredCredential{}, nil)
+	suite.mockHashService.On("Verify", []byte("Old#Pass1"), mock.Anything).Return(true, nil).Once()
+
+	err := suite.service.CheckPasswords(suite.T().Context(), target("user-1", "{}"),
+		map[string]string{"password": "Old#Pass1"})
+
+	suite.ErrorIs(err, ErrPasswordReused)
+}
+
+func (suite *PasswordPolicyServiceTestSuite) TestResolvePolicy_HistoryDepth() {
+	suite.service.historyDepth = 4
+	suite.mockEntityTypeService.On("GetPasswordPolicy", mock.Anything, entitytype.TypeCategoryUser, "employee").
+		Return(&entitytype.PasswordPolicy{MinLength: 8}, nil).Once()
+	suite.mockEntityTypeService.On("GetPasswordPolicy", mock.Anything, entitytype.TypeCategoryUser, "employee").
+		Return(&entitytype.PasswordPolicy{HistoryCount: 2}, nil).Once()
+
+	policy, err := suite.service.resolvePolicy(suite.T().Context(), target("user-1", "{}"))
+	suite.NoError(err)
+	suite.Equal(entitytype.PasswordPolicy{MinLength: 8, HistoryCount: 4}, *policy)
+
+	policy, err = suite.service.resolvePolicy(suite.T().Context(), target("user-1", "{}"))
+	suite.NoError(err)
+	suite.Equal(2, policy.HistoryCount)
+}
+
+func (suite *PasswordPolicyServiceTestSuite) TestRecordPasswords_AddsAndTrimsHistory() {
+	suite.expectPolicy(&entitytype.PasswordPolicy{Credentials: []string{"password"}, HistoryCount: 2})
+	current := entity.StoredCredential{StorageAlgo: "PBKDF2", Value: "current-hash"}
+	suite.mockEntityService.On("GetCredentialsByType", mock.Anything, "user-1", "password").
+		Return([]entity.StoredCredential{current}, nil)
+	suite.mockStore.On("AddHistory", mock.Anything, "user-1", "password", current).Return(nil).Once()
+	suite.mockStore.On("TrimHistory", mock.Anything, "user-1", "password", 2).Return(nil).Once()
+
+	err := suite.service.RecordPasswords(context.Background(), target("user-1", "{}"), []string{"password", "pin"})
+
+	suite.NoError(err)
+}
+
+func (suite *PasswordPolicyServiceTestSuite) TestRecordPasswords_NoHistory() {
+	suite.expectPolicy(&entitytype.PasswordPolicy{MinLength: 8})
+
+	err := suite.service.RecordPasswords(suite.T().Context(), target("user-1", "{}"), []string{"password"})
+
+	suite.NoError(err)
+	suite.mockStore.AssertNotCalled(suite.T(), "AddHistory", mock.Anything, mock.Anything, mock.Anything,
+		mock.Anything)
+}
+
+func (suite *PasswordPolicyServiceTestSuite) TestRecordPasswords_StoreError() {
+	suite.expectPolicy(&entitytype.PasswordPolicy{HistoryCount: 2})
+	suite.mockEntityService.On("GetCredentialsByType", mock.Anything, "user-1", "password").
+		Return([]entity.StoredCredential{{Value: "current-hash"}}, nil)
+	suite.mockStore.On("AddHistory", mock.Anything, "user-1", "password", mock.Anything).
+		Return(errors.New("db error"))
+
+	err := suite.service.RecordPasswords(suite.T().Context(), target("user-1", "{}"), []string{"password"})
+
+	suite.Error(err)
+}
+
+func (suite *PasswordPolicyServiceTestSuite) TestCountCharacterClasses() {
+	suite.Equal(0, countCharacterClasses(""))
+	suite.Equal(1, countCharacterClasses("abc"))
+	suite.Equal(2, countCharacterClasses("abcDEF"))
+	suite.Equal(3, countCharacterClasses("abcDEF123"))
+	suite.Equal(4, countCharacterClasses("abcDEF123!"))
+}
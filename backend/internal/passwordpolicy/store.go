@@ -0,0 +1,205 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package passwordpolicy
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/entity"
+	"github.com/thunder-id/thunderid/internal/entitytype"
+	"github.com/thunder-id/thunderid/internal/system/config"
+	sysContext "github.com/thunder-id/thunderid/internal/system/context"
+	"github.com/thunder-id/thunderid/internal/system/database/provider"
+	"github.com/thunder-id/thunderid/internal/system/transaction"
+	"github.com/thunder-id/thunderid/internal/system/utils"
+)
+
+var getDBProvider = provider.GetDBProvider
+
+// passwordPolicyStoreInterface defines the interface for password policy and password history store
+// operations.
+type passwordPolicyStoreInterface interface {
+	GetPolicy(ctx context.Context, scope string) (*entitytype.PasswordPolicy, error)
+	SavePolicy(ctx context.Context, scope string, policy entitytype.PasswordPolicy) error
+	DeletePolicy(ctx context.Context, scope string) error
+	GetHistory(ctx context.Context, userID, credentialType string, limit int) ([]entity.StoredCredential, error)
+	AddHistory(ctx context.Context, userID, credentialType string, credential entity.StoredCredential) error
+	TrimHistory(ctx context.Context, userID, credentialType string, keep int) error
+}
+
+// passwordPolicyStore is the default implementation of passwordPolicyStoreInterface. Policies are kept in
+// the config database and the password history in the user database, next to the users.
+type passwordPolicyStore struct {
+	dbProvider   provider.DBProviderInterface
+	deploymentID string
+}
+
+// newPasswordPolicyStore creates a new instance of passwordPolicyStore. The returned transactioner spans
+// the user database.
+func newPasswordPolicyStore() (passwordPolicyStoreInterface, transaction.Transactioner, error) {
+	dbProvider := getDBProvider()
+	transactioner, err := dbProvider.GetUserDBTransactioner()
+	if err != nil {
+		return nil, nil, err
+	}
+	return &passwordPolicyStore{
+		dbProvider:   dbProvider,
+		deploymentID: config.GetServerRuntime().Config.Server.Identifier,
+	}, transactioner, nil
+}
+
+// GetPolicy retrieves the password policy of a scope. It returns nil when the scope has no policy.
+func (s *passwordPolicyStore) GetPolicy(ctx context.Context, scope string) (*entitytype.PasswordPolicy, error) {
+	dbClient, err := s.dbProvider.GetConfigDBClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	results, err := dbClient.QueryContext(ctx, queryGetPolicy, scope,
+		sysContext.ScopeDeploymentID(ctx, s.deploymentID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+	if len(results) == 0 {
+		return nil, nil
+	}
+
+	policyJSON, err := bytesField(results[0], "policy")
+	if err != nil {
+		return nil, err
+	}
+	var policy entitytype.PasswordPolicy
+	if err := json.Unmarshal(policyJSON, &policy); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal password policy: %w", err)
+	}
+	return &policy, nil
+}
+
+// SavePolicy creates or replaces the password policy of a scope.
+func (s *passwordPolicyStore) SavePolicy(ctx context.Context, scope string, policy entitytype.PasswordPolicy) error {
+	dbClient, err := s.dbProvider.GetConfigDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	policyJSON, err := json.Marshal(policy)
+	if err != nil {
+		return fmt.Errorf("failed to marshal password policy: %w", err)
+	}
+	if _, err := dbClient.ExecuteContext(ctx, querySavePolicy, scope, string(policyJSON), time.Now().UTC(),
+		sysContext.ScopeDeploymentID(ctx, s.deploymentID)); err != nil {
+		return fmt.Errorf("failed to execute query: %w", err)
+	}
+	return nil
+}
+
+// DeletePolicy deletes the password policy of a scope.
+func (s *passwordPolicyStore) DeletePolicy(ctx context.Context, scope string) error {
+	dbClient, err := s.dbProvider.GetConfigDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	if _, err := dbClient.ExecuteContext(ctx, queryDeletePolicy, scope,
+		sysContext.ScopeDeploymentID(ctx, s.deploymentID)); err != nil {
+		return fmt.Errorf("failed to execute query: %w", err)
+	}
+	return nil
+}
+
+// GetHistory retrieves the most recent previous passwords of a user for a credential type, newest first.
+func (s *passwordPolicyStore) GetHistory(ctx context.Context, userID, credentialType string,
+	limit int) ([]entity.StoredCredential, error) {
+	dbClient, err := s.dbProvider.GetUserDBClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	results, err := dbClient.QueryContext(ctx, queryGetHistory, userID, credentialType,
+		sysContext.ScopeDeploymentID(ctx, s.deploymentID), limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	history := make([]entity.StoredCredential, 0, len(results))
+	for _, row := range results {
+		credentialJSON, err := bytesField(row, "credential")
+		if err != nil {
+			return nil, err
+		}
+		var credential entity.StoredCredential
+		if err := json.Unmarshal(credentialJSON, &credential); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal password history entry: %w", err)
+		}
+		history = append(history, credential)
+	}
+	return history, nil
+}
+
+// AddHistory adds a stored password to the password history of a user.
+func (s *passwordPolicyStore) AddHistory(ctx context.Context, userID, credentialType string,
+	credential entity.StoredCredential) error {
+	dbClient, err := s.dbProvider.GetUserDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	id, err := utils.GenerateUUIDv7()
+	if err != nil {
+		return fmt.Errorf("failed to generate password history id: %w", err)
+	}
+	credentialJSON, err := json.Marshal(credential)
+	if err != nil {
+		return fmt.Errorf("failed to marshal password history entry: %w", err)
+	}
+	if _, err := dbClient.ExecuteContext(ctx, queryAddHistory, id, userID, credentialType, string(credentialJSON),
+		time.Now().UTC(), sysContext.ScopeDeploymentID(ctx, s.deploymentID)); err != nil {
+		return fmt.Errorf("failed to execute query: %w", err)
+	}
+	return nil
+}
+
+// TrimHistory deletes all but the given number of most recent passwords of a user for a credential type.
+func (s *passwordPolicyStore) TrimHistory(ctx context.Context, userID, credentialType string, keep int) error {
+	dbClient, err := s.dbProvider.GetUserDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	if _, err := dbClient.ExecuteContext(ctx, queryTrimHistory, userID, credentialType,
+		sysContext.ScopeDeploymentID(ctx, s.deploymentID), keep); err != nil {
+		return fmt.Errorf("failed to execute query: %w", err)
+	}
+	return nil
+}
+
+// bytesField reads a text or JSON column of a database result row.
+func bytesField(row map[string]interface{}, column string) ([]byte, error) {
+	switch v := row[column].(type) {
+	case string:
+		return []byte(v), nil
+	case []byte:
+		return v, nil
+	default:
+		return nil, fmt.Errorf("failed to parse %s", column)
+	}
+}
@@ -0,0 +1,63 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package passwordpolicy
+
+import "github.com/thunder-id/thunderid/internal/system/database/model"
+
+var (
+	// queryGetPolicy is the query to get the password policy of an organization unit or the tenant.
+	queryGetPolicy = model.DBQuery{
+		ID:    "PWQ-PWD_POLICY_MGT-01",
+		Query: `SELECT POLICY FROM "PASSWORD_POLICY" WHERE OU_ID = $1 AND DEPLOYMENT_ID = $2`,
+	}
+	// querySavePolicy is the query to create or replace the password policy of an organization unit or the
+	// tenant.
+	querySavePolicy = model.DBQuery{
+		ID: "PWQ-PWD_POLICY_MGT-02",
+		Query: `INSERT INTO "PASSWORD_POLICY" (OU_ID, POLICY, UPDATED_AT, DEPLOYMENT_ID) ` +
+			`VALUES ($1, $2, $3, $4) ON CONFLICT (OU_ID, DEPLOYMENT_ID) ` +
+			`DO UPDATE SET POLICY = EXCLUDED.POLICY, UPDATED_AT = EXCLUDED.UPDATED_AT`,
+	}
+	// queryDeletePolicy is the query to delete the password policy of an organization unit or the tenant.
+	queryDeletePolicy = model.DBQuery{
+		ID:    "PWQ-PWD_POLICY_MGT-03",
+		Query: `DELETE FROM "PASSWORD_POLICY" WHERE OU_ID = $1 AND DEPLOYMENT_ID = $2`,
+	}
+	// queryGetHistory is the query to get the most recent previous passwords of a user for a credential type.
+	queryGetHistory = model.DBQuery{
+		ID: "PWQ-PWD_POLICY_MGT-04",
+		Query: `SELECT CREDENTIAL FROM "PASSWORD_HISTORY" ` +
+			`WHERE ENTITY_ID = $1 AND CREDENTIAL_TYPE = $2 AND DEPLOYMENT_ID = $3 ` +
+			`ORDER BY CREATED_AT DESC, ID DESC LIMIT $4`,
+	}
+	// queryAddHistory is the query to add a password to the password history of a user.
+	queryAddHistory = model.DBQuery{
+		ID: "PWQ-PWD_POLICY_MGT-05",
+		Query: `INSERT INTO "PASSWORD_HISTORY" (ID, ENTITY_ID, CREDENTIAL_TYPE, CREDENTIAL, CREATED_AT, ` +
+			`DEPLOYMENT_ID) VALUES ($1, $2, $3, $4, $5, $6)`,
+	}
+	// queryTrimHistory is the query to delete all but the most recent passwords of a user for a credential type.
+	queryTrimHistory = model.DBQuery{
+		ID: "PWQ-PWD_POLICY_MGT-06",
+		Query: `DELETE FROM "PASSWORD_HISTORY" ` +
+			`WHERE ENTITY_ID = $1 AND CREDENTIAL_TYPE = $2 AND DEPLOYMENT_ID = $3 AND ID NOT IN (` +
+			`SELECT ID FROM "PASSWORD_HISTORY" WHERE ENTITY_ID = $1 AND CREDENTIAL_TYPE = $2 ` +
+			`AND DEPLOYMENT_ID = $3 ORDER BY CREATED_AT DESC, ID DESC LIMIT $4)`,
+	}
+)
@@ -0,0 +1,59 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package quota
+
+import "time"
+
+// ResourceType identifies the kind of resources limited by a quota.
+type ResourceType string
+
+// Resource types that can be limited by quotas.
+const (
+	// ResourceTypeUsers limits the number of users.
+	ResourceTypeUsers ResourceType = "users"
+	// ResourceTypeGroups limits the number of groups.
+	ResourceTypeGroups ResourceType = "groups"
+	// ResourceTypeApplications limits the number of applications.
+	ResourceTypeApplications ResourceType = "applications"
+	// ResourceTypeFlows limits the number of flows. Flows do not belong to organization units, so their
+	// quota can only be set for the tenant.
+	ResourceTypeFlows ResourceType = "flows"
+)
+
+// TenantScope is the scope of the quotas that apply to the whole tenant rather than an organization unit.
+const TenantScope = ""
+
+const (
+	loggerComponentName = "QuotaService"
+
+	// thresholdEventName is the event name of the webhook payload sent when a threshold is reached.
+	thresholdEventName = "quota.threshold.reached"
+	// webhookTimeout bounds the time spent delivering a threshold webhook.
+	webhookTimeout = 10 * time.Second
+)
+
+// tenantResourceTypes lists the resource types that can be limited for the tenant, in response order.
+var tenantResourceTypes = []ResourceType{
+	ResourceTypeUsers, ResourceTypeGroups, ResourceTypeApplications, ResourceTypeFlows,
+}
+
+// ouResourceTypes lists the resource types that can be limited for an organization unit, in response order.
+var ouResourceTypes = []ResourceType{
+	ResourceTypeUsers, ResourceTypeGroups, ResourceTypeApplications,
+}
@@ -0,0 +1,87 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package quota
+
+import (
+	"errors"
+
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/i18n/core"
+)
+
+// ErrQuotaExceeded is returned when creating a resource would exceed the quota of its organization unit
+// or tenant.
+var ErrQuotaExceeded = errors.New("quota exceeded")
+
+// Client errors for quota operations.
+var (
+	// ErrorInvalidRequestFormat is the error returned when the request body is malformed.
+	ErrorInvalidRequestFormat = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "QTA-1001",
+		Error: core.I18nMessage{
+			Key:          "error.quotaservice.invalid_request_format",
+			DefaultValue: "Invalid request format",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.quotaservice.invalid_request_format_description",
+			DefaultValue: "The request body is malformed or contains invalid data",
+		},
+	}
+	// ErrorInvalidResourceType is the error returned when a quota is set for an unsupported resource type.
+	ErrorInvalidResourceType = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "QTA-1002",
+		Error: core.I18nMessage{
+			Key:          "error.quotaservice.invalid_resource_type",
+			DefaultValue: "Invalid resource type",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key: "error.quotaservice.invalid_resource_type_description",
+			DefaultValue: "The resource type must be one of users, groups or applications, " +
+				"or flows for the tenant, and must not be repeated",
+		},
+	}
+	// ErrorInvalidLimit is the error returned when the limit of a quota is negative.
+	ErrorInvalidLimit = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "QTA-1003",
+		Error: core.I18nMessage{
+			Key:          "error.quotaservice.invalid_limit",
+			DefaultValue: "Invalid limit",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.quotaservice.invalid_limit_description",
+			DefaultValue: "The limit of a quota must not be negative",
+		},
+	}
+	// ErrorOrganizationUnitNotFound is the error returned when the organization unit does not exist.
+	ErrorOrganizationUnitNotFound = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "QTA-1004",
+		Error: core.I18nMessage{
+			Key:          "error.quotaservice.organization_unit_not_found",
+			DefaultValue: "Organization unit not found",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.quotaservice.organization_unit_not_found_description",
+			DefaultValue: "The organization unit with the specified id does not exist",
+		},
+	}
+)
@@ -0,0 +1,82 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package quota
+
+import (
+	"net/http"
+
+	sysutils "github.com/thunder-id/thunderid/internal/system/utils"
+)
+
+// quotaHandler is the handler for quota management operations. The tenant routes have no organization
+// unit ID path value, so the same handlers serve the quotas of the tenant and of organization units.
+type quotaHandler struct {
+	quotaService QuotaServiceInterface
+}
+
+// newQuotaHandler creates a new instance of quotaHandler.
+func newQuotaHandler(quotaService QuotaServiceInterface) *quotaHandler {
+	return &quotaHandler{
+		quotaService: quotaService,
+	}
+}
+
+// HandleQuotaGetRequest handles the get quotas request.
+func (h *quotaHandler) HandleQuotaGetRequest(w http.ResponseWriter, r *http.Request) {
+	response, svcErr := h.quotaService.GetQuotas(r.Context(), r.PathValue("id"))
+	if svcErr != nil {
+		sysutils.WriteServiceErrorResponse(w, svcErr, clientErrorStatusCodes)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(w, http.StatusOK, response)
+}
+
+// HandleQuotaPutRequest handles the set quotas request.
+func (h *quotaHandler) HandleQuotaPutRequest(w http.ResponseWriter, r *http.Request) {
+	request, err := sysutils.DecodeJSONBody[QuotaRequest](r)
+	if err != nil {
+		sysutils.WriteServiceErrorResponse(w, &ErrorInvalidRequestFormat, clientErrorStatusCodes)
+		return
+	}
+
+	response, svcErr := h.quotaService.SetQuotas(r.Context(), r.PathValue("id"), *request)
+	if svcErr != nil {
+		sysutils.WriteServiceErrorResponse(w, svcErr, clientErrorStatusCodes)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(w, http.StatusOK, response)
+}
+
+// HandleQuotaDeleteRequest handles the delete quotas request.
+func (h *quotaHandler) HandleQuotaDeleteRequest(w http.ResponseWriter, r *http.Request) {
+	if svcErr := h.quotaService.DeleteQuotas(r.Context(), r.PathValue("id")); svcErr != nil {
+		sysutils.WriteServiceErrorResponse(w, svcErr, clientErrorStatusCodes)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(w, http.StatusNoContent, nil)
+}
+
+// clientErrorStatusCodes maps the client errors of the handler to HTTP status codes. Other client errors are
+// returned with 400 Bad Request.
+var clientErrorStatusCodes = map[string]int{
+	ErrorOrganizationUnitNotFound.Code: http.StatusNotFound,
+}
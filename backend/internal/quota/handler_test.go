@@ -0,0 +1,120 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package quota
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/system/error/apierror"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+)
+
+type HandlerTestSuite struct {
+	suite.Suite
+	mockService *QuotaServiceInterfaceMock
+	handler     *quotaHandler
+}
+
+func TestHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(HandlerTestSuite))
+}
+
+func (s *HandlerTestSuite) SetupTest() {
+	s.mockService = NewQuotaServiceInterfaceMock(s.T())
+	s.handler = newQuotaHandler(s.mockService)
+}
+
+func (s *HandlerTestSuite) TestHandleQuotaGetRequest_Tenant() {
+	limit := 10
+	s.mockService.On("GetQuotas", mock.Anything, TenantScope).Return(&QuotaResponse{
+		Quotas: []QuotaUsage{{ResourceType: ResourceTypeUsers, Limit: &limit, Usage: 4},
+			{ResourceType: ResourceTypeFlows, Usage: 2}},
+	}, nil)
+
+	rr := httptest.NewRecorder()
+	s.handler.HandleQuotaGetRequest(rr, httptest.NewRequest(http.MethodGet, "/quotas", nil))
+
+	s.Equal(http.StatusOK, rr.Code)
+	s.JSONEq(`{"quotas":[{"resourceType":"users","limit":10,"usage":4},{"resourceType":"flows","usage":2}]}`,
+		rr.Body.String())
+}
+
+func (s *HandlerTestSuite) TestHandleQuotaGetRequest_OrganizationUnitNotFound() {
+	s.mockService.On("GetQuotas", mock.Anything, "missing").Return(nil, &ErrorOrganizationUnitNotFound)
+
+	req := httptest.NewRequest(http.MethodGet, "/quotas/organization-units/missing", nil)
+	req.SetPathValue("id", "missing")
+	rr := httptest.NewRecorder()
+	s.handler.HandleQuotaGetRequest(rr, req)
+
+	s.Equal(http.StatusNotFound, rr.Code)
+	var errResp apierror.ErrorResponse
+	s.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &errResp))
+	s.Equal(ErrorOrganizationUnitNotFound.Code, errResp.Code)
+}
+
+func (s *HandlerTestSuite) TestHandleQuotaPutRequest_Success() {
+	limit := 3
+	s.mockService.On("SetQuotas", mock.Anything, "ou-1",
+		QuotaRequest{Quotas: []Quota{{ResourceType: ResourceTypeGroups, Limit: 3}}}).
+		Return(&QuotaResponse{OUID: "ou-1",
+			Quotas: []QuotaUsage{{ResourceType: ResourceTypeGroups, Limit: &limit}}}, nil)
+
+	req := httptest.NewRequest(http.MethodPut, "/quotas/organization-units/ou-1",
+		strings.NewReader(`{"quotas":[{"resourceType":"groups","limit":3}]}`))
+	req.SetPathValue("id", "ou-1")
+	rr := httptest.NewRecorder()
+	s.handler.HandleQuotaPutRequest(rr, req)
+
+	s.Equal(http.StatusOK, rr.Code)
+	s.JSONEq(`{"ouId":"ou-1","quotas":[{"resourceType":"groups","limit":3,"usage":0}]}`, rr.Body.String())
+}
+
+func (s *HandlerTestSuite) TestHandleQuotaPutRequest_InvalidBody() {
+	rr := httptest.NewRecorder()
+	s.handler.HandleQuotaPutRequest(rr, httptest.NewRequest(http.MethodPut, "/quotas", strings.NewReader(`{`)))
+
+	s.Equal(http.StatusBadRequest, rr.Code)
+	s.mockService.AssertNotCalled(s.T(), "SetQuotas", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (s *HandlerTestSuite) TestHandleQuotaDeleteRequest() {
+	s.mockService.On("DeleteQuotas", mock.Anything, TenantScope).Return(nil).Once()
+
+	rr := httptest.NewRecorder()
+	s.handler.HandleQuotaDeleteRequest(rr, httptest.NewRequest(http.MethodDelete, "/quotas", nil))
+
+	s.Equal(http.StatusNoContent, rr.Code)
+}
+
+func (s *HandlerTestSuite) TestHandleQuotaDeleteRequest_ServerError() {
+	s.mockService.On("DeleteQuotas", mock.Anything, TenantScope).Return(&serviceerror.InternalServerError)
+
+	rr := httptest.NewRecorder()
+	s.handler.HandleQuotaDeleteRequest(rr, httptest.NewRequest(http.MethodDelete, "/quotas", nil))
+
+	s.Equal(http.StatusInternalServerError, rr.Code)
+}
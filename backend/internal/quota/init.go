@@ -0,0 +1,101 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package quota
+
+import (
+	"net/http"
+	"slices"
+
+	oupkg "github.com/thunder-id/thunderid/internal/ou"
+	"github.com/thunder-id/thunderid/internal/system/config"
+	syshttp "github.com/thunder-id/thunderid/internal/system/http"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/internal/system/middleware"
+	"github.com/thunder-id/thunderid/internal/system/observability"
+)
+
+// Initialize initializes the quota service and registers its routes. The services of the limited
+// resources register their usage counters with the returned service.
+func Initialize(
+	mux *http.ServeMux,
+	ouService oupkg.OrganizationUnitServiceInterface,
+	observabilitySvc observability.ObservabilityServiceInterface,
+) (QuotaServiceInterface, error) {
+	store, transactioner, err := newQuotaStore()
+	if err != nil {
+		return nil, err
+	}
+
+	quotaConfig := config.GetServerRuntime().Config.Quota
+	quotaService := newQuotaService(store, transactioner, ouService,
+		normalizeThresholds(quotaConfig.ThresholdPercentages), quotaConfig.WebhookURL,
+		syshttp.NewHTTPClientWithTimeout(webhookTimeout), observabilitySvc)
+
+	quotaHandler := newQuotaHandler(quotaService)
+	registerRoutes(mux, quotaHandler)
+
+	return quotaService, nil
+}
+
+// normalizeThresholds sorts the configured threshold percentages and drops repeated values and values
+// outside the range 1 to 100.
+func normalizeThresholds(percentages []int) []int {
+	thresholds := make([]int, 0, len(percentages))
+	for _, percentage := range percentages {
+		if percentage < 1 || percentage > 100 {
+			log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName)).Warn(
+				"Ignoring quota threshold percentage outside the range 1 to 100", log.Int("percentage", percentage))
+			continue
+		}
+		thresholds = append(thresholds, percentage)
+	}
+	slices.Sort(thresholds)
+	return slices.Compact(thresholds)
+}
+
+// registerRoutes registers the routes for quota management operations.
+func registerRoutes(mux *http.ServeMux, quotaHandler *quotaHandler) {
+	opts := middleware.CORSOptions{
+		AllowedMethods:   []string{"GET", "PUT", "DELETE"},
+		AllowedHeaders:   middleware.DefaultAllowedHeaders,
+		AllowCredentials: true,
+		MaxAge:           600,
+	}
+	mux.HandleFunc(middleware.WithCORS("GET /quotas",
+		quotaHandler.HandleQuotaGetRequest, opts))
+	mux.HandleFunc(middleware.WithCORS("PUT /quotas",
+		quotaHandler.HandleQuotaPutRequest, opts))
+	mux.HandleFunc(middleware.WithCORS("DELETE /quotas",
+		quotaHandler.HandleQuotaDeleteRequest, opts))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS /quotas",
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, opts))
+
+	mux.HandleFunc(middleware.WithCORS("GET /quotas/organization-units/{id}",
+		quotaHandler.HandleQuotaGetRequest, opts))
+	mux.HandleFunc(middleware.WithCORS("PUT /quotas/organization-units/{id}",
+		quotaHandler.HandleQuotaPutRequest, opts))
+	mux.HandleFunc(middleware.WithCORS("DELETE /quotas/organization-units/{id}",
+		quotaHandler.HandleQuotaDeleteRequest, opts))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS /quotas/organization-units/{id}",
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, opts))
+}
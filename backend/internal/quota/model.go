@@ -0,0 +1,77 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package quota provides the resource quotas of organization units and tenants, which limit the number of
+// users, groups, applications and flows that can be created.
+package quota
+
+import (
+	"context"
+	"time"
+)
+
+// UsageCounter counts the resources of a type in a scope. The scope is an organization unit ID, or
+// TenantScope for the whole tenant.
+type UsageCounter interface {
+	CountUsage(ctx context.Context, scope string) (int, error)
+}
+
+// UsageCounterFunc is an adapter to use an ordinary function as a UsageCounter.
+type UsageCounterFunc func(ctx context.Context, scope string) (int, error)
+
+// CountUsage calls f(ctx, scope).
+func (f UsageCounterFunc) CountUsage(ctx context.Context, scope string) (int, error) {
+	return f(ctx, scope)
+}
+
+// Quota represents the limit of the number of resources of a type.
+type Quota struct {
+	ResourceType ResourceType `json:"resourceType"`
+	Limit        int          `json:"limit"`
+}
+
+// QuotaUsage represents the limit and the current usage of the resources of a type. Limit is omitted when
+// the resources of the type are not limited.
+type QuotaUsage struct {
+	ResourceType ResourceType `json:"resourceType"`
+	Limit        *int         `json:"limit,omitempty"`
+	Usage        int          `json:"usage"`
+}
+
+// QuotaRequest represents the request body for setting the quotas of an organization unit or the tenant.
+type QuotaRequest struct {
+	Quotas []Quota `json:"quotas"`
+}
+
+// QuotaResponse represents the quotas and the current usage of an organization unit or the tenant.
+type QuotaResponse struct {
+	OUID   string       `json:"ouId,omitempty"`
+	Quotas []QuotaUsage `json:"quotas"`
+}
+
+// thresholdEvent is the payload posted to the configured webhook when the usage of a quota reaches a
+// threshold percentage.
+type thresholdEvent struct {
+	Event        string       `json:"event"`
+	Timestamp    time.Time    `json:"timestamp"`
+	OUID         string       `json:"ouId,omitempty"`
+	ResourceType ResourceType `json:"resourceType"`
+	Limit        int          `json:"limit"`
+	Usage        int          `json:"usage"`
+	Threshold    int          `json:"threshold"`
+}
@@ -0,0 +1,356 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package quota
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"sync"
+	"time"
+
+	oupkg "github.com/thunder-id/thunderid/internal/ou"
+	serverconst "github.com/thunder-id/thunderid/internal/system/constants"
+	sysContext "github.com/thunder-id/thunderid/internal/system/context"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	syshttp "github.com/thunder-id/thunderid/internal/system/http"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/internal/system/observability"
+	"github.com/thunder-id/thunderid/internal/system/observability/event"
+	"github.com/thunder-id/thunderid/internal/system/transaction"
+)
+
+// QuotaServiceInterface defines the interface for the quota service.
+type QuotaServiceInterface interface {
+	GetQuotas(ctx context.Context, ouID string) (*QuotaResponse, *serviceerror.ServiceError)
+	SetQuotas(ctx context.Context, ouID string, request QuotaRequest) (*QuotaResponse, *serviceerror.ServiceError)
+	DeleteQuotas(ctx context.Context, ouID string) *serviceerror.ServiceError
+	CheckQuota(ctx context.Context, resourceType ResourceType, ouID string) error
+	RegisterUsageCounter(resourceType ResourceType, counter UsageCounter)
+}
+
+// quotaService is the default implementation of the QuotaServiceInterface.
+type quotaService struct {
+	store         quotaStoreInterface
+	transactioner transaction.Transactioner
+	ouService     oupkg.OrganizationUnitServiceInterface
+	thresholds    []int
+	webhookURL    string
+	httpClient    syshttp.HTTPClientInterface
+	observability observability.ObservabilityServiceInterface
+	logger        *log.Logger
+	mu            sync.RWMutex
+	counters      map[ResourceType]UsageCounter
+	// runAsync delivers a threshold webhook. It runs the delivery on a new goroutine.
+	runAsync func(func())
+}
+
+// newQuotaService creates a new instance of quotaService.
+func newQuotaService(
+	store quotaStoreInterface,
+	transactioner transaction.Transactioner,
+	ouService oupkg.OrganizationUnitServiceInterface,
+	thresholds []int,
+	webhookURL string,
+	httpClient syshttp.HTTPClientInterface,
+	observabilitySvc observability.ObservabilityServiceInterface,
+) QuotaServiceInterface {
+	return &quotaService{
+		store:         store,
+		transactioner: transactioner,
+		ouService:     ouService,
+		thresholds:    thresholds,
+		webhookURL:    webhookURL,
+		httpClient:    httpClient,
+		observability: observabilitySvc,
+		logger:        log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName)),
+		counters:      make(map[ResourceType]UsageCounter),
+		runAsync:      func(f func()) { go f() },
+	}
+}
+
+// RegisterUsageCounter registers the counter of the current usage of a resource type.
+func (s *quotaService) RegisterUsageCounter(resourceType ResourceType, counter UsageCounter) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.counters[resourceType] = counter
+}
+
+// GetQuotas retrieves the quotas and the current usage of an organization unit, or of the tenant when the
+// organization unit ID is empty.
+func (s *quotaService) GetQuotas(ctx context.Context, ouID string) (*QuotaResponse, *serviceerror.ServiceError) {
+	if svcErr := s.validateScope(ctx, ouID); svcErr != nil {
+		return nil, svcErr
+	}
+	return s.buildQuotaResponse(ctx, ouID)
+}
+
+// SetQuotas replaces the quotas of an organization unit, or of the tenant when the organization unit ID is
+// empty. Resource types that are not listed in the request are no longer limited.
+func (s *quotaService) SetQuotas(ctx context.Context, ouID string,
+	request QuotaRequest) (*QuotaResponse, *serviceerror.ServiceError) {
+	if svcErr := s.validateScope(ctx, ouID); svcErr != nil {
+		return nil, svcErr
+	}
+
+	allowed := scopeResourceTypes(ouID)
+	seen := make(map[ResourceType]bool, len(request.Quotas))
+	for _, quota := range request.Quotas {
+		if !slices.Contains(allowed, quota.ResourceType) || seen[quota.ResourceType] {
+			return nil, &ErrorInvalidResourceType
+		}
+		if quota.Limit < 0 {
+			return nil, &ErrorInvalidLimit
+		}
+		seen[quota.ResourceType] = true
+	}
+
+	err := s.transactioner.Transact(ctx, func(txCtx context.Context) error {
+		if err := s.store.DeleteQuotas(txCtx, ouID); err != nil {
+			return err
+		}
+		for _, quota := range request.Quotas {
+			if err := s.store.CreateQuota(txCtx, ouID, quota); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		s.logger.Error("Failed to set quotas", log.Error(err), log.String("ouID", ouID))
+		return nil, &serviceerror.InternalServerError
+	}
+
+	s.logger.Debug("Set quotas", log.String("ouID", ouID), log.Int("count", len(request.Quotas)))
+	return s.buildQuotaResponse(ctx, ouID)
+}
+
+// DeleteQuotas removes the quotas of an organization unit, or of the tenant when the organization unit ID
+// is empty.
+func (s *quotaService) DeleteQuotas(ctx context.Context, ouID string) *serviceerror.ServiceError {
+	if svcErr := s.validateScope(ctx, ouID); svcErr != nil {
+		return svcErr
+	}
+
+	if err := s.store.DeleteQuotas(ctx, ouID); err != nil {
+		s.logger.Error("Failed to delete quotas", log.Error(err), log.String("ouID", ouID))
+		return &serviceerror.InternalServerError
+	}
+	return nil
+}
+
+// CheckQuota checks whether one more resource of a type can be created in an organization unit. Both the
+// quota of the organization unit and the quota of the tenant are enforced, and ErrQuotaExceeded is returned
+// when either is already used up. Every threshold percentage reached by the resource about to be created
+// is published as an operational event and posted to the webhook.
+func (s *quotaService) CheckQuota(ctx context.Context, resourceType ResourceType, ouID string) error {
+	scopes := []string{TenantScope}
+	if ouID != TenantScope && slices.Contains(ouResourceTypes, resourceType) {
+		scopes = []string{ouID, TenantScope}
+	}
+
+	var events []thresholdEvent
+	for _, scope := range scopes {
+		quotas, err := s.store.GetQuotas(ctx, scope)
+		if err != nil {
+			return fmt.Errorf("failed to get quotas: %w", err)
+		}
+		index := slices.IndexFunc(quotas, func(q Quota) bool { return q.ResourceType == resourceType })
+		if index < 0 {
+			continue
+		}
+		limit := quotas[index].Limit
+
+		usage, err := s.countUsage(ctx, resourceType, scope)
+		if err != nil {
+			return err
+		}
+		if usage >= limit {
+			s.logger.Debug("Quota exceeded", log.String("resourceType", string(resourceType)),
+				log.String("ouID", scope), log.Int("limit", limit))
+			return fmt.Errorf("%w: the %s quota of %s allows at most %d", ErrQuotaExceeded, resourceType,
+				describeScope(scope), limit)
+		}
+
+		for _, threshold := range s.thresholds {
+			if usage*100 < threshold*limit && (usage+1)*100 >= threshold*limit {
+				events = append(events, thresholdEvent{
+					Event:        thresholdEventName,
+					OUID:         scope,
+					ResourceType: resourceType,
+					Limit:        limit,
+					Usage:        usage + 1,
+					Threshold:    threshold,
+				})
+			}
+		}
+	}
+
+	for _, reached := range events {
+		s.notify(ctx, reached)
+	}
+	return nil
+}
+
+// validateScope checks that the organization unit of a scope exists.
+func (s *quotaService) validateScope(ctx context.Context, ouID string) *serviceerror.ServiceError {
+	if ouID == TenantScope {
+		return nil
+	}
+
+	exists, svcErr := s.ouService.IsOrganizationUnitExists(ctx, ouID)
+	if svcErr != nil {
+		s.logger.Error("Failed to check organization unit existence", log.String("ouID", ouID),
+			log.String("error", svcErr.Error.DefaultValue))
+		return &serviceerror.InternalServerError
+	}
+	if !exists {
+		return &ErrorOrganizationUnitNotFound
+	}
+	return nil
+}
+
+// buildQuotaResponse builds the quotas and the current usage of every resource type of a scope.
+func (s *quotaService) buildQuotaResponse(
+	ctx context.Context, ouID string) (*QuotaResponse, *serviceerror.ServiceError) {
+	quotas, err := s.store.GetQuotas(ctx, ouID)
+	if err != nil {
+		s.logger.Error("Failed to get quotas", log.Error(err), log.String("ouID", ouID))
+		return nil, &serviceerror.InternalServerError
+	}
+	limits := make(map[ResourceType]int, len(quotas))
+	for _, quota := range quotas {
+		limits[quota.ResourceType] = quota.Limit
+	}
+
+	resourceTypes := scopeResourceTypes(ouID)
+	response := &QuotaResponse{OUID: ouID, Quotas: make([]QuotaUsage, 0, len(resourceTypes))}
+	for _, resourceType := range resourceTypes {
+		usage, err := s.countUsage(ctx, resourceType, ouID)
+		if err != nil {
+			s.logger.Error("Failed to count resource usage", log.Error(err), log.String("ouID", ouID))
+			return nil, &serviceerror.InternalServerError
+		}
+		quotaUsage := QuotaUsage{ResourceType: resourceType, Usage: usage}
+		if limit, ok := limits[resourceType]; ok {
+			quotaUsage.Limit = &limit
+		}
+		response.Quotas = append(response.Quotas, quotaUsage)
+	}
+	return response, nil
+}
+
+// countUsage counts the resources of a type in a scope with the registered usage counter.
+func (s *quotaService) countUsage(ctx context.Context, resourceType ResourceType, scope string) (int, error) {
+	s.mu.RLock()
+	counter, ok := s.counters[resourceType]
+	s.mu.RUnlock()
+	if !ok {
+		return 0, fmt.Errorf("no usage counter is registered for %s", resourceType)
+	}
+
+	usage, err := counter.CountUsage(ctx, scope)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count %s: %w", resourceType, err)
+	}
+	return usage, nil
+}
+
+// notify records a reached threshold in the logs, publishes it as an operational event and posts it to the
+// configured webhook, if any.
+func (s *quotaService) notify(ctx context.Context, reached thresholdEvent) {
+	s.logger.Info("Quota threshold reached", log.String("resourceType", string(reached.ResourceType)),
+		log.String("ouID", reached.OUID), log.Int("threshold", reached.Threshold), log.Int("usage", reached.Usage),
+		log.Int("limit", reached.Limit))
+	s.publishThresholdEvent(ctx, reached)
+	if s.webhookURL == "" {
+		return
+	}
+
+	reached.Timestamp = time.Now().UTC()
+	s.runAsync(func() {
+		s.notifyWebhook(reached)
+	})
+}
+
+// publishThresholdEvent publishes a reached threshold on the event bus.
+func (s *quotaService) publishThresholdEvent(ctx context.Context, reached thresholdEvent) {
+	if s.observability == nil || !s.observability.IsEnabled() {
+		return
+	}
+
+	evt := event.NewEvent(sysContext.GetTraceID(ctx), string(event.EventTypeQuotaThresholdReached),
+		event.ComponentQuota).
+		WithTenantID(sysContext.GetTenantID(ctx)).
+		WithStatus(event.StatusSuccess).
+		WithData(event.DataKey.ResourceType, string(reached.ResourceType)).
+		WithData(event.DataKey.OUID, reached.OUID).
+		WithData(event.DataKey.Threshold, reached.Threshold).
+		WithData(event.DataKey.Usage, reached.Usage).
+		WithData(event.DataKey.Limit, reached.Limit)
+	s.observability.PublishEvent(evt)
+}
+
+// notifyWebhook posts a threshold event to the configured webhook. Delivery failures are logged and do
+// not fail the operation.
+func (s *quotaService) notifyWebhook(payload thresholdEvent) {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		s.logger.Error("Failed to marshal quota webhook payload", log.Error(err))
+		return
+	}
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodPost, s.webhookURL,
+		bytes.NewReader(body))
+	if err != nil {
+		s.logger.Error("Failed to create quota webhook request", log.Error(err))
+		return
+	}
+	req.Header.Set(serverconst.ContentTypeHeaderName, serverconst.ContentTypeJSON)
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		s.logger.Warn("Failed to deliver quota webhook", log.Error(err))
+		return
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		s.logger.Warn("Quota webhook returned an unexpected status", log.Int("status", resp.StatusCode))
+	}
+}
+
+// scopeResourceTypes returns the resource types that can be limited in a scope.
+func scopeResourceTypes(scope string) []ResourceType {
+	if scope == TenantScope {
+		return tenantResourceTypes
+	}
+	return ouResourceTypes
+}
+
+// describeScope returns a readable description of a scope for error messages.
+func describeScope(scope string) string {
+	if scope == TenantScope {
+		return "the tenant"
+	}
+	return "organization unit " + scope
+}
@@ -0,0 +1,296 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package quota
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/observability/event"
+	"github.com/thunder-id/thunderid/internal/system/transaction"
+	"github.com/thunder-id/thunderid/tests/mocks/httpmock"
+	"github.com/thunder-id/thunderid/tests/mocks/observability/observabilitymock"
+	"github.com/thunder-id/thunderid/tests/mocks/oumock"
+)
+
+const testWebhook = "https://hooks.example.com/quota"
+
+type QuotaServiceTestSuite struct {
+	suite.Suite
+	mockStore      *quotaStoreInterfaceMock
+	mockOUService  *oumock.OrganizationUnitServiceInterfaceMock
+	mockHTTPClient *httpmock.HTTPClientInterfaceMock
+	mockObs        *observabilitymock.ObservabilityServiceInterfaceMock
+	events         []*event.Event
+	usage          map[ResourceType]map[string]int
+	service        *quotaService
+}
+
+func TestQuotaServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(QuotaServiceTestSuite))
+}
+
+func (suite *QuotaServiceTestSuite) SetupTest() {
+	suite.mockStore = newQuotaStoreInterfaceMock(suite.T())
+	suite.mockOUService = oumock.NewOrganizationUnitServiceInterfaceMock(suite.T())
+	suite.mockHTTPClient = httpmock.NewHTTPClientInterfaceMock(suite.T())
+	suite.mockObs = observabilitymock.NewObservabilityServiceInterfaceMock(suite.T())
+	suite.service = newQuotaService(suite.mockStore, transaction.NewNoOpTransactioner(), suite.mockOUService,
+		[]int{80, 100}, testWebhook, suite.mockHTTPClient, suite.mockObs).(*quotaService)
+	suite.service.runAsync = func(f func()) { f() }
+
+	suite.events = nil
+	suite.mockObs.On("IsEnabled").Return(true).Maybe()
+	suite.mockObs.On("PublishEvent", mock.Anything).Run(func(args mock.Arguments) {
+		suite.events = append(suite.events, args.Get(0).(*event.Event))
+	}).Maybe()
+
+	suite.usage = make(map[ResourceType]map[string]int)
+	for _, resourceType := range tenantResourceTypes {
+		suite.usage[resourceType] = make(map[string]int)
+		suite.service.RegisterUsageCounter(resourceType, suite.counter(resourceType))
+	}
+}
+
+// counter returns a usage counter that reports the usage recorded in the suite.
+func (suite *QuotaServiceTestSuite) counter(resourceType ResourceType) UsageCounter {
+	return UsageCounterFunc(func(_ context.Context, scope string) (int, error) {
+		return suite.usage[resourceType][scope], nil
+	})
+}
+
+// expectWebhook expects a threshold webhook and returns the captured payload.
+func (suite *QuotaServiceTestSuite) expectWebhook() *thresholdEvent {
+	captured := &thresholdEvent{}
+	suite.mockHTTPClient.On("Do", mock.MatchedBy(func(req *http.Request) bool {
+		return req.Method == http.MethodPost && req.URL.String() == testWebhook
+	})).Run(func(args mock.Arguments) {
+		body, _ := io.ReadAll(args.Get(0).(*http.Request).Body)
+		suite.Require().NoError(json.Unmarshal(body, captured))
+	}).Return(&http.Response{StatusCode: http.StatusNoContent, Body: io.NopCloser(strings.NewReader(""))}, nil).
+		Once()
+	return captured
+}
+
+func (suite *QuotaServiceTestSuite) TestGetQuotas_Tenant() {
+	suite.mockStore.On("GetQuotas", mock.Anything, TenantScope).
+		Return([]Quota{{ResourceType: ResourceTypeUsers, Limit: 10}}, nil)
+	suite.usage[ResourceTypeUsers][TenantScope] = 3
+	suite.usage[ResourceTypeFlows][TenantScope] = 2
+
+	response, svcErr := suite.service.GetQuotas(suite.T().Context(), TenantScope)
+
+	suite.Nil(svcErr)
+	suite.Empty(response.OUID)
+	suite.Len(response.Quotas, 4)
+	suite.Equal(ResourceTypeUsers, response.Quotas[0].ResourceType)
+	suite.Equal(10, *response.Quotas[0].Limit)
+	suite.Equal(3, response.Quotas[0].Usage)
+	suite.Equal(ResourceTypeFlows, response.Quotas[3].ResourceType)
+	suite.Nil(response.Quotas[3].Limit)
+	suite.Equal(2, response.Quotas[3].Usage)
+	suite.mockOUService.AssertNotCalled(suite.T(), "IsOrganizationUnitExists", mock.Anything, mock.Anything)
+}
+
+func (suite *QuotaServiceTestSuite) TestGetQuotas_OrganizationUnit() {
+	suite.mockOUService.On("IsOrganizationUnitExists", mock.Anything, "ou-1").Return(true, nil)
+	suite.mockStore.On("GetQuotas", mock.Anything, "ou-1").
+		Return([]Quota{{ResourceType: ResourceTypeGroups, Limit: 4}}, nil)
+	suite.usage[ResourceTypeGroups]["ou-1"] = 1
+
+	response, svcErr := suite.service.GetQuotas(suite.T().Context(), "ou-1")
+
+	suite.Nil(svcErr)
+	suite.Equal("ou-1", response.OUID)
+	suite.Len(response.Quotas, 3)
+	suite.Equal(ResourceTypeGroups, response.Quotas[1].ResourceType)
+	suite.Equal(4, *response.Quotas[1].Limit)
+	suite.Equal(1, response.Quotas[1].Usage)
+}
+
+func (suite *QuotaServiceTestSuite) TestGetQuotas_OrganizationUnitNotFound() {
+	suite.mockOUService.On("IsOrganizationUnitExists", mock.Anything, "missing").Return(false, nil)
+
+	response, svcErr := suite.service.GetQuotas(suite.T().Context(), "missing")
+
+	suite.Nil(response)
+	suite.Equal(ErrorOrganizationUnitNotFound.Code, svcErr.Code)
+}
+
+func (suite *QuotaServiceTestSuite) TestGetQuotas_StoreError() {
+	suite.mockStore.On("GetQuotas", mock.Anything, TenantScope).Return(nil, errors.New("db down"))
+
+	response, svcErr := suite.service.GetQuotas(suite.T().Context(), TenantScope)
+
+	suite.Nil(response)
+	suite.Equal(serviceerror.InternalServerError.Code, svcErr.Code)
+}
+
+func (suite *QuotaServiceTestSuite) TestSetQuotas_ReplacesQuotas() {
+	quotas := []Quota{{ResourceType: ResourceTypeUsers, Limit: 50}, {ResourceType: ResourceTypeApplications}}
+	suite.mockOUService.On("IsOrganizationUnitExists", mock.Anything, "ou-1").Return(true, nil)
+	suite.mockStore.On("DeleteQuotas", mock.Anything, "ou-1").Return(nil).Once()
+	suite.mockStore.On("CreateQuota", mock.Anything, "ou-1", quotas[0]).Return(nil).Once()
+	suite.mockStore.On("CreateQuota", mock.Anything, "ou-1", quotas[1]).Return(nil).Once()
+	suite.mockStore.On("GetQuotas", mock.Anything, "ou-1").Return(quotas, nil)
+
+	response, svcErr := suite.service.SetQuotas(suite.T().Context(), "ou-1", QuotaRequest{Quotas: quotas})
+
+	suite.Nil(svcErr)
+	suite.Equal(50, *response.Quotas[0].Limit)
+	suite.Nil(response.Quotas[1].Limit)
+	suite.Equal(0, *response.Quotas[2].Limit)
+}
+
+func (suite *QuotaServiceTestSuite) TestSetQuotas_InvalidRequests() {
+	testCases := []struct {
+		name     string
+		ouID     string
+		quotas   []Quota
+		expected string
+	}{
+		{"UnknownResourceType", TenantScope, []Quota{{ResourceType: "roles", Limit: 1}},
+			ErrorInvalidResourceType.Code},
+		{"FlowsForOrganizationUnit", "ou-1", []Quota{{ResourceType: ResourceTypeFlows, Limit: 1}},
+			ErrorInvalidResourceType.Code},
+		{"RepeatedResourceType", TenantScope, []Quota{{ResourceType: ResourceTypeUsers, Limit: 1},
+			{ResourceType: ResourceTypeUsers, Limit: 2}}, ErrorInvalidResourceType.Code},
+		{"NegativeLimit", TenantScope, []Quota{{ResourceType: ResourceTypeGroups, Limit: -1}},
+			ErrorInvalidLimit.Code},
+	}
+	suite.mockOUService.On("IsOrganizationUnitExists", mock.Anything, "ou-1").Return(true, nil)
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			response, svcErr := suite.service.SetQuotas(suite.T().Context(), tc.ouID, QuotaRequest{Quotas: tc.quotas})
+
+			suite.Nil(response)
+			suite.Equal(tc.expected, svcErr.Code)
+		})
+	}
+	suite.mockStore.AssertNotCalled(suite.T(), "DeleteQuotas", mock.Anything, mock.Anything)
+}
+
+func (suite *QuotaServiceTestSuite) TestDeleteQuotas() {
+	suite.mockStore.On("DeleteQuotas", mock.Anything, TenantScope).Return(nil).Once()
+
+	suite.Nil(suite.service.DeleteQuotas(suite.T().Context(), TenantScope))
+}
+
+func (suite *QuotaServiceTestSuite) TestCheckQuota_NoQuotas() {
+	suite.mockStore.On("GetQuotas", mock.Anything, "ou-1").Return([]Quota{}, nil).Once()
+	suite.mockStore.On("GetQuotas", mock.Anything, TenantScope).Return([]Quota{}, nil).Once()
+
+	suite.NoError(suite.service.CheckQuota(suite.T().Context(), ResourceTypeUsers, "ou-1"))
+}
+
+func (suite *QuotaServiceTestSuite) TestCheckQuota_OrganizationUnitQuotaExceeded() {
+	suite.mockStore.On("GetQuotas", mock.Anything, "ou-1").
+		Return([]Quota{{ResourceType: ResourceTypeUsers, Limit: 5}}, nil).Once()
+	suite.usage[ResourceTypeUsers]["ou-1"] = 5
+
+	err := suite.service.CheckQuota(suite.T().Context(), ResourceTypeUsers, "ou-1")
+
+	suite.ErrorIs(err, ErrQuotaExceeded)
+	suite.Contains(err.Error(), "organization unit ou-1")
+	suite.mockStore.AssertNotCalled(suite.T(), "GetQuotas", mock.Anything, TenantScope)
+}
+
+func (suite *QuotaServiceTestSuite) TestCheckQuota_TenantQuotaExceeded() {
+	suite.mockStore.On("GetQuotas", mock.Anything, "ou-1").
+		Return([]Quota{{ResourceType: ResourceTypeApplications, Limit: 5}}, nil).Once()
+	suite.mockStore.On("GetQuotas", mock.Anything, TenantScope).
+		Return([]Quota{{ResourceType: ResourceTypeApplications, Limit: 20}}, nil).Once()
+	suite.usage[ResourceTypeApplications]["ou-1"] = 1
+	suite.usage[ResourceTypeApplications][TenantScope] = 20
+
+	err := suite.service.CheckQuota(suite.T().Context(), ResourceTypeApplications, "ou-1")
+
+	suite.ErrorIs(err, ErrQuotaExceeded)
+	suite.Contains(err.Error(), "the tenant")
+	suite.mockHTTPClient.AssertNotCalled(suite.T(), "Do", mock.Anything)
+	suite.Empty(suite.events)
+}
+
+func (suite *QuotaServiceTestSuite) TestCheckQuota_FlowsUseTenantQuota() {
+	suite.mockStore.On("GetQuotas", mock.Anything, TenantScope).
+		Return([]Quota{{ResourceType: ResourceTypeFlows, Limit: 0}}, nil).Once()
+
+	err := suite.service.CheckQuota(suite.T().Context(), ResourceTypeFlows, "ou-1")
+
+	suite.ErrorIs(err, ErrQuotaExceeded)
+	suite.mockStore.AssertNotCalled(suite.T(), "GetQuotas", mock.Anything, "ou-1")
+}
+
+func (suite *QuotaServiceTestSuite) TestCheckQuota_NotifiesReachedThreshold() {
+	suite.mockStore.On("GetQuotas", mock.Anything, "ou-1").
+		Return([]Quota{{ResourceType: ResourceTypeGroups, Limit: 5}}, nil).Once()
+	suite.mockStore.On("GetQuotas", mock.Anything, TenantScope).Return([]Quota{}, nil).Once()
+	suite.usage[ResourceTypeGroups]["ou-1"] = 3
+	captured := suite.expectWebhook()
+
+	suite.NoError(suite.service.CheckQuota(suite.T().Context(), ResourceTypeGroups, "ou-1"))
+
+	suite.Equal(thresholdEventName, captured.Event)
+	suite.Equal("ou-1", captured.OUID)
+	suite.Equal(ResourceTypeGroups, captured.ResourceType)
+	suite.Equal(5, captured.Limit)
+	suite.Equal(4, captured.Usage)
+	suite.Equal(80, captured.Threshold)
+	suite.False(captured.Timestamp.IsZero())
+
+	suite.Require().Len(suite.events, 1)
+	suite.Equal(string(event.EventTypeQuotaThresholdReached), suite.events[0].Type)
+	suite.Equal("ou-1", suite.events[0].Data[event.DataKey.OUID])
+	suite.Equal(string(ResourceTypeGroups), suite.events[0].Data[event.DataKey.ResourceType])
+	suite.Equal(80, suite.events[0].Data[event.DataKey.Threshold])
+}
+
+func (suite *QuotaServiceTestSuite) TestCheckQuota_NoNotificationBelowThreshold() {
+	suite.mockStore.On("GetQuotas", mock.Anything, TenantScope).
+		Return([]Quota{{ResourceType: ResourceTypeUsers, Limit: 10}}, nil).Once()
+	suite.usage[ResourceTypeUsers][TenantScope] = 8
+
+	suite.NoError(suite.service.CheckQuota(suite.T().Context(), ResourceTypeUsers, TenantScope))
+
+	suite.mockHTTPClient.AssertNotCalled(suite.T(), "Do", mock.Anything)
+}
+
+func (suite *QuotaServiceTestSuite) TestCheckQuota_CounterError() {
+	suite.mockStore.On("GetQuotas", mock.Anything, TenantScope).
+		Return([]Quota{{ResourceType: ResourceTypeUsers, Limit: 10}}, nil).Once()
+	suite.service.RegisterUsageCounter(ResourceTypeUsers,
+		UsageCounterFunc(func(context.Context, string) (int, error) {
+			return 0, errors.New("db down")
+		}))
+
+	err := suite.service.CheckQuota(suite.T().Context(), ResourceTypeUsers, TenantScope)
+
+	suite.Error(err)
+	suite.NotErrorIs(err, ErrQuotaExceeded)
+}
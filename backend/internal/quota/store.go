@@ -0,0 +1,137 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package quota
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+	sysContext "github.com/thunder-id/thunderid/internal/system/context"
+	"github.com/thunder-id/thunderid/internal/system/database/provider"
+	"github.com/thunder-id/thunderid/internal/system/transaction"
+)
+
+var getDBProvider = provider.GetDBProvider
+
+// quotaStoreInterface defines the interface for quota store operations.
+type quotaStoreInterface interface {
+	CreateQuota(ctx context.Context, scope string, quota Quota) error
+	GetQuotas(ctx context.Context, scope string) ([]Quota, error)
+	DeleteQuotas(ctx context.Context, scope string) error
+}
+
+// quotaStore is the default implementation of quotaStoreInterface.
+type quotaStore struct {
+	dbProvider   provider.DBProviderInterface
+	deploymentID string
+}
+
+// newQuotaStore creates a new instance of quotaStore.
+func newQuotaStore() (quotaStoreInterface, transaction.Transactioner, error) {
+	dbProvider := getDBProvider()
+	transactioner, err := dbProvider.GetConfigDBTransactioner()
+	if err != nil {
+		return nil, nil, err
+	}
+	return &quotaStore{
+		dbProvider:   dbProvider,
+		deploymentID: config.GetServerRuntime().Config.Server.Identifier,
+	}, transactioner, nil
+}
+
+// CreateQuota persists the quota of a resource type in a scope.
+func (s *quotaStore) CreateQuota(ctx context.Context, scope string, quota Quota) error {
+	dbClient, err := s.dbProvider.GetConfigDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	if _, err := dbClient.ExecuteContext(ctx, queryCreateQuota, scope, string(quota.ResourceType), quota.Limit,
+		sysContext.ScopeDeploymentID(ctx, s.deploymentID)); err != nil {
+		return fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	return nil
+}
+
+// GetQuotas retrieves the quotas of a scope ordered by resource type.
+func (s *quotaStore) GetQuotas(ctx context.Context, scope string) ([]Quota, error) {
+	dbClient, err := s.dbProvider.GetConfigDBClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	results, err := dbClient.QueryContext(ctx, queryGetQuotas, scope,
+		sysContext.ScopeDeploymentID(ctx, s.deploymentID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	quotas := make([]Quota, 0, len(results))
+	for _, row := range results {
+		quota, err := buildQuotaFromResultRow(row)
+		if err != nil {
+			return nil, fmt.Errorf("failed to build quota from result row: %w", err)
+		}
+		quotas = append(quotas, *quota)
+	}
+
+	return quotas, nil
+}
+
+// DeleteQuotas deletes the quotas of a scope.
+func (s *quotaStore) DeleteQuotas(ctx context.Context, scope string) error {
+	dbClient, err := s.dbProvider.GetConfigDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	if _, err := dbClient.ExecuteContext(ctx, queryDeleteQuotas, scope,
+		sysContext.ScopeDeploymentID(ctx, s.deploymentID)); err != nil {
+		return fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	return nil
+}
+
+// buildQuotaFromResultRow constructs a Quota from a database result row.
+func buildQuotaFromResultRow(row map[string]interface{}) (*Quota, error) {
+	resourceType, ok := row["resource_type"].(string)
+	if !ok {
+		return nil, fmt.Errorf("failed to parse resource_type as string")
+	}
+
+	var limit int
+	switch v := row["quota_limit"].(type) {
+	case int64:
+		limit = int(v)
+	case int32:
+		limit = int(v)
+	case float64:
+		limit = int(v)
+	default:
+		return nil, fmt.Errorf("failed to parse quota_limit as integer")
+	}
+
+	return &Quota{
+		ResourceType: ResourceType(resourceType),
+		Limit:        limit,
+	}, nil
+}
@@ -0,0 +1,41 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package quota
+
+import "github.com/thunder-id/thunderid/internal/system/database/model"
+
+var (
+	// queryCreateQuota is the query to create the quota of a resource type.
+	queryCreateQuota = model.DBQuery{
+		ID: "QTQ-QUOTA_MGT-01",
+		Query: `INSERT INTO "RESOURCE_QUOTA" (OU_ID, RESOURCE_TYPE, QUOTA_LIMIT, DEPLOYMENT_ID) ` +
+			`VALUES ($1, $2, $3, $4)`,
+	}
+	// queryGetQuotas is the query to get the quotas of an organization unit or the tenant.
+	queryGetQuotas = model.DBQuery{
+		ID: "QTQ-QUOTA_MGT-02",
+		Query: `SELECT RESOURCE_TYPE, QUOTA_LIMIT FROM "RESOURCE_QUOTA" ` +
+			`WHERE OU_ID = $1 AND DEPLOYMENT_ID = $2 ORDER BY RESOURCE_TYPE`,
+	}
+	// queryDeleteQuotas is the query to delete the quotas of an organization unit or the tenant.
+	queryDeleteQuotas = model.DBQuery{
+		ID:    "QTQ-QUOTA_MGT-03",
+		Query: `DELETE FROM "RESOURCE_QUOTA" WHERE OU_ID = $1 AND DEPLOYMENT_ID = $2`,
+	}
+)
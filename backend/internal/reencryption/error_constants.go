@@ -0,0 +1,72 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package reencryption
+
+import (
+	"errors"
+
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/i18n/core"
+)
+
+// ErrReencryptionJobNotFound is returned when the re-encryption job is not found in the store.
+var ErrReencryptionJobNotFound = errors.New("re-encryption job not found")
+
+// Client errors for re-encryption operations.
+var (
+	// ErrorReencryptionJobNotFound is the error returned when a re-encryption job is not found.
+	ErrorReencryptionJobNotFound = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "REE-1001",
+		Error: core.I18nMessage{
+			Key:          "error.reencryptionservice.job_not_found",
+			DefaultValue: "Re-encryption job not found",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.reencryptionservice.job_not_found_description",
+			DefaultValue: "The requested re-encryption job could not be found",
+		},
+	}
+	// ErrorReencryptionJobConflict is the error returned when another re-encryption job is active.
+	ErrorReencryptionJobConflict = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "REE-1002",
+		Error: core.I18nMessage{
+			Key:          "error.reencryptionservice.job_conflict",
+			DefaultValue: "Re-encryption already in progress",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.reencryptionservice.job_conflict_description",
+			DefaultValue: "Another re-encryption job is already active",
+		},
+	}
+	// ErrorJobNotResumable is the error returned when a re-encryption job has not failed.
+	ErrorJobNotResumable = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "REE-1003",
+		Error: core.I18nMessage{
+			Key:          "error.reencryptionservice.job_not_resumable",
+			DefaultValue: "Re-encryption job cannot be resumed",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.reencryptionservice.job_not_resumable_description",
+			DefaultValue: "Only a failed re-encryption job can be resumed",
+		},
+	}
+)
@@ -0,0 +1,78 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package reencryption
+
+import (
+	"net/http"
+
+	sysutils "github.com/thunder-id/thunderid/internal/system/utils"
+)
+
+// reencryptionHandler is the handler for re-encryption job operations.
+type reencryptionHandler struct {
+	reencryptionService ReencryptionServiceInterface
+}
+
+// newReencryptionHandler creates a new instance of reencryptionHandler.
+func newReencryptionHandler(reencryptionService ReencryptionServiceInterface) *reencryptionHandler {
+	return &reencryptionHandler{
+		reencryptionService: reencryptionService,
+	}
+}
+
+// HandleReencryptionJobPostRequest handles the start re-encryption job request.
+func (h *reencryptionHandler) HandleReencryptionJobPostRequest(w http.ResponseWriter, r *http.Request) {
+	job, svcErr := h.reencryptionService.StartReencryption(r.Context())
+	if svcErr != nil {
+		sysutils.WriteServiceErrorResponse(w, svcErr, clientErrorStatusCodes)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(w, http.StatusAccepted, job)
+}
+
+// HandleReencryptionJobGetRequest handles the get re-encryption job request.
+func (h *reencryptionHandler) HandleReencryptionJobGetRequest(w http.ResponseWriter, r *http.Request) {
+	job, svcErr := h.reencryptionService.GetReencryptionJob(r.Context(), r.PathValue("id"))
+	if svcErr != nil {
+		sysutils.WriteServiceErrorResponse(w, svcErr, clientErrorStatusCodes)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(w, http.StatusOK, job)
+}
+
+// HandleReencryptionJobResumeRequest handles the resume re-encryption job request.
+func (h *reencryptionHandler) HandleReencryptionJobResumeRequest(w http.ResponseWriter, r *http.Request) {
+	job, svcErr := h.reencryptionService.ResumeReencryption(r.Context(), r.PathValue("id"))
+	if svcErr != nil {
+		sysutils.WriteServiceErrorResponse(w, svcErr, clientErrorStatusCodes)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(w, http.StatusAccepted, job)
+}
+
+// clientErrorStatusCodes maps the client errors of the handler to HTTP status codes. Other client errors are
+// returned with 400 Bad Request.
+var clientErrorStatusCodes = map[string]int{
+	ErrorReencryptionJobNotFound.Code: http.StatusNotFound,
+	ErrorReencryptionJobConflict.Code: http.StatusConflict,
+	ErrorJobNotResumable.Code:         http.StatusConflict,
+}
@@ -0,0 +1,139 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package reencryption
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/system/error/apierror"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+)
+
+type HandlerTestSuite struct {
+	suite.Suite
+	mockService *ReencryptionServiceInterfaceMock
+	handler     *reencryptionHandler
+}
+
+func TestHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(HandlerTestSuite))
+}
+
+func (s *HandlerTestSuite) SetupTest() {
+	s.mockService = NewReencryptionServiceInterfaceMock(s.T())
+	s.handler = newReencryptionHandler(s.mockService)
+}
+
+func (s *HandlerTestSuite) TestHandleReencryptionJobPostRequest_Accepted() {
+	s.mockService.On("StartReencryption", mock.Anything).
+		Return(&ReencryptionJob{ID: "job-1", Status: JobStatusPending}, nil)
+
+	req := httptest.NewRequest(http.MethodPost, "/admin/encryption/reencryption-jobs", nil)
+	rr := httptest.NewRecorder()
+	s.handler.HandleReencryptionJobPostRequest(rr, req)
+
+	s.Equal(http.StatusAccepted, rr.Code)
+	var body ReencryptionJob
+	s.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &body))
+	s.Equal("job-1", body.ID)
+	s.Equal(JobStatusPending, body.Status)
+}
+
+func (s *HandlerTestSuite) TestHandleReencryptionJobPostRequest_ErrorStatusCodes() {
+	testCases := []struct {
+		name     string
+		svcErr   *serviceerror.ServiceError
+		expected int
+	}{
+		{"Conflict", &ErrorReencryptionJobConflict, http.StatusConflict},
+		{"ServerError", &serviceerror.InternalServerError, http.StatusInternalServerError},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			mockService := NewReencryptionServiceInterfaceMock(s.T())
+			mockService.On("StartReencryption", mock.Anything).Return(nil, tc.svcErr)
+			handler := newReencryptionHandler(mockService)
+
+			req := httptest.NewRequest(http.MethodPost, "/admin/encryption/reencryption-jobs", nil)
+			rr := httptest.NewRecorder()
+			handler.HandleReencryptionJobPostRequest(rr, req)
+
+			s.Equal(tc.expected, rr.Code)
+			var errResp apierror.ErrorResponse
+			s.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &errResp))
+			s.Equal(tc.svcErr.Code, errResp.Code)
+		})
+	}
+}
+
+func (s *HandlerTestSuite) TestHandleReencryptionJobGetRequest() {
+	s.mockService.On("GetReencryptionJob", mock.Anything, "job-1").
+		Return(&ReencryptionJob{ID: "job-1", Status: JobStatusRunning}, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/admin/encryption/reencryption-jobs/job-1", nil)
+	req.SetPathValue("id", "job-1")
+	rr := httptest.NewRecorder()
+	s.handler.HandleReencryptionJobGetRequest(rr, req)
+
+	s.Equal(http.StatusOK, rr.Code)
+	var body ReencryptionJob
+	s.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &body))
+	s.Equal(JobStatusRunning, body.Status)
+}
+
+func (s *HandlerTestSuite) TestHandleReencryptionJobGetRequest_NotFound() {
+	s.mockService.On("GetReencryptionJob", mock.Anything, "missing").Return(nil, &ErrorReencryptionJobNotFound)
+
+	req := httptest.NewRequest(http.MethodGet, "/admin/encryption/reencryption-jobs/missing", nil)
+	req.SetPathValue("id", "missing")
+	rr := httptest.NewRecorder()
+	s.handler.HandleReencryptionJobGetRequest(rr, req)
+
+	s.Equal(http.StatusNotFound, rr.Code)
+}
+
+func (s *HandlerTestSuite) TestHandleReencryptionJobResumeRequest() {
+	s.mockService.On("ResumeReencryption", mock.Anything, "job-1").
+		Return(&ReencryptionJob{ID: "job-1", Status: JobStatusPending}, nil)
+
+	req := httptest.NewRequest(http.MethodPost, "/admin/encryption/reencryption-jobs/job-1/resume", nil)
+	req.SetPathValue("id", "job-1")
+	rr := httptest.NewRecorder()
+	s.handler.HandleReencryptionJobResumeRequest(rr, req)
+
+	s.Equal(http.StatusAccepted, rr.Code)
+}
+
+func (s *HandlerTestSuite) TestHandleReencryptionJobResumeRequest_NotResumable() {
+	s.mockService.On("ResumeReencryption", mock.Anything, "job-1").Return(nil, &ErrorJobNotResumable)
+
+	req := httptest.NewRequest(http.MethodPost, "/admin/encryption/reencryption-jobs/job-1/resume", nil)
+	req.SetPathValue("id", "job-1")
+	rr := httptest.NewRecorder()
+	s.handler.HandleReencryptionJobResumeRequest(rr, req)
+
+	s.Equal(http.StatusConflict, rr.Code)
+}
@@ -0,0 +1,78 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package reencryption
+
+import (
+	"net/http"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/kmprovider"
+	"github.com/thunder-id/thunderid/internal/system/middleware"
+)
+
+// Initialize initializes the re-encryption service and registers its routes.
+func Initialize(
+	mux *http.ServeMux,
+	cryptoProvider kmprovider.ConfigCryptoProvider,
+) ReencryptionServiceInterface {
+	reencryptionConfig := config.GetServerRuntime().Config.Crypto.Encryption.Reencryption
+	reencryptionService := newReencryptionService(newReencryptionStore(), cryptoProvider,
+		reencryptionConfig.BatchSize, reencryptionConfig.JobRetention)
+
+	reencryptionHandler := newReencryptionHandler(reencryptionService)
+	registerRoutes(mux, reencryptionHandler)
+
+	return reencryptionService
+}
+
+// registerRoutes registers the routes for re-encryption job operations.
+func registerRoutes(mux *http.ServeMux, reencryptionHandler *reencryptionHandler) {
+	opts1 := middleware.CORSOptions{
+		AllowedMethods:   []string{"POST"},
+		AllowedHeaders:   middleware.DefaultAllowedHeaders,
+		AllowCredentials: true,
+		MaxAge:           600,
+	}
+	mux.HandleFunc(middleware.WithCORS("POST /admin/encryption/reencryption-jobs",
+		reencryptionHandler.HandleReencryptionJobPostRequest, opts1))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS /admin/encryption/reencryption-jobs",
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, opts1))
+
+	opts2 := middleware.CORSOptions{
+		AllowedMethods:   []string{"GET"},
+		AllowedHeaders:   middleware.DefaultAllowedHeaders,
+		AllowCredentials: true,
+		MaxAge:           600,
+	}
+	mux.HandleFunc(middleware.WithCORS("GET /admin/encryption/reencryption-jobs/{id}",
+		reencryptionHandler.HandleReencryptionJobGetRequest, opts2))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS /admin/encryption/reencryption-jobs/{id}",
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, opts2))
+
+	mux.HandleFunc(middleware.WithCORS("POST /admin/encryption/reencryption-jobs/{id}/resume",
+		reencryptionHandler.HandleReencryptionJobResumeRequest, opts1))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS /admin/encryption/reencryption-jobs/{id}/resume",
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, opts1))
+}
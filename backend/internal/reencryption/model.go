@@ -0,0 +1,78 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package reencryption provides resumable jobs that re-encrypt stored secrets with the current
+// encryption key after the key has been rotated.
+package reencryption
+
+import "time"
+
+// JobStatus represents the lifecycle state of a re-encryption job.
+type JobStatus string
+
+const (
+	// JobStatusPending indicates the job is accepted but has not started re-encrypting data.
+	JobStatusPending JobStatus = "PENDING"
+	// JobStatusRunning indicates the job is re-encrypting data.
+	JobStatusRunning JobStatus = "RUNNING"
+	// JobStatusCompleted indicates all stored data is encrypted with the current key.
+	JobStatusCompleted JobStatus = "COMPLETED"
+	// JobStatusFailed indicates the job stopped before it completed. A failed job can be resumed.
+	JobStatusFailed JobStatus = "FAILED"
+)
+
+// Target identifies a group of stored records that contain encrypted values.
+type Target string
+
+const (
+	// TargetIdentityProviders covers the secret properties of identity providers.
+	TargetIdentityProviders Target = "IDENTITY_PROVIDERS"
+	// TargetNotificationSenders covers the secret properties of notification senders.
+	TargetNotificationSenders Target = "NOTIFICATION_SENDERS"
+	// TargetFlowContexts covers the encrypted contexts of in-progress flows.
+	TargetFlowContexts Target = "FLOW_CONTEXTS"
+)
+
+// reencryptionTargets lists the targets processed by a job, in processing order.
+var reencryptionTargets = []Target{TargetIdentityProviders, TargetNotificationSenders, TargetFlowContexts}
+
+// TargetProgress holds the progress of a re-encryption job for a single target.
+type TargetProgress struct {
+	Target            Target `json:"target"`
+	Completed         bool   `json:"completed"`
+	ProcessedRecords  int    `json:"processedRecords"`
+	ReencryptedValues int    `json:"reencryptedValues"`
+	// LastRecordID is the ID of the last processed record. A resumed job continues after this record.
+	LastRecordID string `json:"lastRecordId,omitempty"`
+}
+
+// ReencryptionJob represents an asynchronous re-encryption job.
+type ReencryptionJob struct {
+	ID            string           `json:"id"`
+	Status        JobStatus        `json:"status"`
+	Progress      []TargetProgress `json:"progress"`
+	FailureReason string           `json:"failureReason,omitempty"`
+	CreatedAt     time.Time        `json:"createdAt"`
+	UpdatedAt     time.Time        `json:"updatedAt"`
+}
+
+// encryptedRecord is a stored record holding encrypted values of a target.
+type encryptedRecord struct {
+	ID    string
+	Value string
+}
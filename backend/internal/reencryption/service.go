@@ -0,0 +1,394 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package reencryption
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/system/backgroundjob"
+	"github.com/thunder-id/thunderid/internal/system/cmodels"
+	serverconst "github.com/thunder-id/thunderid/internal/system/constants"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/kmprovider"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/internal/system/utils"
+)
+
+// errJobInterrupted signals that the worker observed that the job it is running is no longer running,
+// for example because it was released as stale by another node.
+var errJobInterrupted = errors.New("re-encryption job interrupted")
+
+// ReencryptionServiceInterface defines the interface for the re-encryption service.
+type ReencryptionServiceInterface interface {
+	StartReencryption(ctx context.Context) (*ReencryptionJob, *serviceerror.ServiceError)
+	GetReencryptionJob(ctx context.Context, jobID string) (*ReencryptionJob, *serviceerror.ServiceError)
+	ResumeReencryption(ctx context.Context, jobID string) (*ReencryptionJob, *serviceerror.ServiceError)
+}
+
+// reencryptionService is the default implementation of the ReencryptionServiceInterface.
+type reencryptionService struct {
+	store          reencryptionStoreInterface
+	lease          *backgroundjob.Lease[JobStatus]
+	cryptoProvider kmprovider.ConfigCryptoProvider
+	batchSize      int
+	jobRetention   int64
+	// runAsync starts the worker of a job. It runs the worker on a new goroutine.
+	runAsync func(func())
+}
+
+// newReencryptionService creates a new instance of reencryptionService.
+func newReencryptionService(
+	store reencryptionStoreInterface,
+	cryptoProvider kmprovider.ConfigCryptoProvider,
+	batchSize int,
+	jobRetention int64,
+) ReencryptionServiceInterface {
+	if batchSize < 1 || batchSize > serverconst.MaxPageSize {
+		batchSize = serverconst.MaxPageSize
+	}
+	if jobRetention <= 0 {
+		jobRetention = defaultJobRetention
+	}
+	return &reencryptionService{
+		store:          store,
+		lease:          backgroundjob.NewLease(store.UpdateStatus, JobStatusPending, JobStatusFailed),
+		cryptoProvider: cryptoProvider,
+		batchSize:      batchSize,
+		jobRetention:   jobRetention,
+		runAsync:       func(f func()) { go f() },
+	}
+}
+
+// StartReencryption starts a job that re-encrypts all stored encrypted values with the current key.
+// If an active job was interrupted before it completed, that job is resumed instead.
+func (s *reencryptionService) StartReencryption(ctx context.Context) (*ReencryptionJob, *serviceerror.ServiceError) {
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName))
+
+	interrupted, svcErr := s.releaseActiveJob(ctx)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	if interrupted != nil {
+		return s.resumeJob(ctx, interrupted.ID)
+	}
+
+	jobID, err := utils.GenerateUUIDv7()
+	if err != nil {
+		logger.Error("Failed to generate re-encryption job ID", log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+	progress := make([]TargetProgress, 0, len(reencryptionTargets))
+	for _, target := range reencryptionTargets {
+		progress = append(progress, TargetProgress{Target: target})
+	}
+	now := time.Now().UTC()
+	job := ReencryptionJob{
+		ID:        jobID,
+		Status:    JobStatusPending,
+		Progress:  progress,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	expiryTime := now.Add(time.Duration(s.jobRetention) * time.Second)
+	if err := s.store.CreateJob(ctx, job, expiryTime); err != nil {
+		logger.Error("Failed to create re-encryption job", log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+
+	s.startWorker(ctx, jobID, progress)
+
+	logger.Debug("Started re-encryption job", log.String("jobID", jobID))
+	return &job, nil
+}
+
+// GetReencryptionJob retrieves a re-encryption job by its ID.
+func (s *reencryptionService) GetReencryptionJob(
+	ctx context.Context, jobID string,
+) (*ReencryptionJob, *serviceerror.ServiceError) {
+	return s.getJob(ctx, jobID)
+}
+
+// ResumeReencryption resumes a failed re-encryption job from its last persisted progress.
+func (s *reencryptionService) ResumeReencryption(
+	ctx context.Context, jobID string,
+) (*ReencryptionJob, *serviceerror.ServiceError) {
+	job, svcErr := s.getJob(ctx, jobID)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	if job.Status != JobStatusFailed {
+		return nil, &ErrorJobNotResumable
+	}
+
+	if _, svcErr := s.releaseActiveJob(ctx); svcErr != nil {
+		return nil, svcErr
+	}
+	return s.resumeJob(ctx, jobID)
+}
+
+// resumeJob moves a failed job back to pending and starts a worker that continues from its progress.
+func (s *reencryptionService) resumeJob(
+	ctx context.Context, jobID string,
+) (*ReencryptionJob, *serviceerror.ServiceError) {
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName))
+
+	if err := s.lease.Resume(ctx, jobID); err != nil {
+		if errors.Is(err, backgroundjob.ErrJobConflict) {
+			// Another request resumed the job first.
+			return nil, &ErrorReencryptionJobConflict
+		}
+		logger.Error("Failed to resume re-encryption job", log.String("jobID", jobID), log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+
+	job, svcErr := s.getJob(ctx, jobID)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	s.startWorker(ctx, jobID, job.Progress)
+
+	logger.Debug("Resumed re-encryption job", log.String("jobID", jobID))
+	return job, nil
+}
+
+// startWorker runs the job on a worker that outlives the request.
+func (s *reencryptionService) startWorker(ctx context.Context, jobID string, progress []TargetProgress) {
+	backgroundjob.StartWorker(ctx, s.runAsync, func(workerCtx context.Context) {
+		s.runJob(workerCtx, jobID, progress)
+	})
+}
+
+// getJob retrieves a re-encryption job from the store and maps store errors to service errors.
+func (s *reencryptionService) getJob(
+	ctx context.Context, jobID string,
+) (*ReencryptionJob, *serviceerror.ServiceError) {
+	if jobID == "" {
+		return nil, &ErrorReencryptionJobNotFound
+	}
+
+	job, err := s.store.GetJob(ctx, jobID)
+	if err != nil {
+		if errors.Is(err, ErrReencryptionJobNotFound) {
+			return nil, &ErrorReencryptionJobNotFound
+		}
+		log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName)).
+			Error("Failed to retrieve re-encryption job", log.String("jobID", jobID), log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+	return job, nil
+}
+
+// releaseActiveJob rejects the request when another job is active. An active job that has not reported
+// progress within backgroundjob.StaleTimeout is marked as failed and returned so that it can be resumed.
+func (s *reencryptionService) releaseActiveJob(ctx context.Context) (*ReencryptionJob, *serviceerror.ServiceError) {
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName))
+
+	active, err := s.store.GetActiveJob(ctx)
+	if err != nil {
+		if errors.Is(err, ErrReencryptionJobNotFound) {
+			return nil, nil
+		}
+		logger.Error("Failed to check active re-encryption jobs", log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+	if err := s.lease.ReleaseStale(ctx, active.ID, active.Status, active.UpdatedAt); err != nil {
+		if errors.Is(err, backgroundjob.ErrJobConflict) {
+			return nil, &ErrorReencryptionJobConflict
+		}
+		logger.Error("Failed to release stale re-encryption job", log.String("jobID", active.ID), log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+	return active, nil
+}
+
+// runJob re-encrypts the records of each target that has not completed and records the outcome of the job.
+func (s *reencryptionService) runJob(ctx context.Context, jobID string, progress []TargetProgress) {
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName),
+		log.String("jobID", jobID))
+
+	started, err := s.store.UpdateStatus(ctx, jobID, JobStatusPending, JobStatusRunning, "")
+	if err != nil {
+		logger.Error("Failed to start re-encryption job", log.Error(err))
+		return
+	}
+	if !started {
+		logger.Debug("Re-encryption job is no longer pending")
+		return
+	}
+
+	for i := range progress {
+		if progress[i].Completed {
+			continue
+		}
+		if err := s.reencryptTarget(ctx, jobID, progress, i); err != nil {
+			if errors.Is(err, errJobInterrupted) {
+				logger.Debug("Re-encryption job was interrupted")
+				return
+			}
+			logger.Error("Re-encryption job failed", log.String("target", string(progress[i].Target)),
+				log.Error(err))
+			if _, err := s.store.UpdateStatus(
+				ctx, jobID, JobStatusRunning, JobStatusFailed, err.Error()); err != nil {
+				logger.Error("Failed to mark re-encryption job as failed", log.Error(err))
+			}
+			return
+		}
+	}
+
+	if _, err := s.store.UpdateStatus(ctx, jobID, JobStatusRunning, JobStatusCompleted, ""); err != nil {
+		logger.Error("Failed to mark re-encryption job as completed", log.Error(err))
+		return
+	}
+	logger.Debug("Re-encryption job completed")
+}
+
+// reencryptTarget re-encrypts the records of a target batch by batch, starting after the last
+// processed record, and persists the progress after every batch.
+func (s *reencryptionService) reencryptTarget(
+	ctx context.Context, jobID string, progress []TargetProgress, index int,
+) error {
+	targetProgress := &progress[index]
+	for {
+		records, err := s.store.ListRecords(ctx, targetProgress.Target, targetProgress.LastRecordID, s.batchSize)
+		if err != nil {
+			return fmt.Errorf("failed to list records: %w", err)
+		}
+
+		for _, record := range records {
+			count, err := s.reencryptRecord(ctx, targetProgress.Target, record)
+			if err != nil {
+				return fmt.Errorf("failed to re-encrypt record %s: %w", record.ID, err)
+			}
+			targetProgress.ProcessedRecords++
+			targetProgress.ReencryptedValues += count
+			targetProgress.LastRecordID = record.ID
+		}
+		if len(records) < s.batchSize {
+			targetProgress.Completed = true
+		}
+		if err := s.checkpoint(ctx, jobID, progress); err != nil {
+			return err
+		}
+		if targetProgress.Completed {
+			return nil
+		}
+	}
+}
+
+// reencryptRecord re-encrypts the values of a record that were encrypted with a previous key and returns
+// the number of re-encrypted values. A record that changed while it was processed is left untouched,
+// since the concurrent write already encrypted it with the current key.
+func (s *reencryptionService) reencryptRecord(ctx context.Context, target Target, record encryptedRecord) (int, error) {
+	if record.Value == "" {
+		return 0, nil
+	}
+
+	var newValue string
+	var count int
+	var err error
+	if target == TargetFlowContexts {
+		newValue, count, err = s.reencryptCiphertext(ctx, record.Value)
+	} else {
+		newValue, count, err = s.reencryptProperties(ctx, record.Value)
+	}
+	if err != nil || count == 0 {
+		return 0, err
+	}
+
+	updated, err := s.store.UpdateRecord(ctx, target, record.ID, record.Value, newValue)
+	if err != nil {
+		return 0, err
+	}
+	if !updated {
+		return 0, nil
+	}
+	return count, nil
+}
+
+// reencryptProperties re-encrypts the secret values in a serialized property list.
+func (s *reencryptionService) reencryptProperties(ctx context.Context, value string) (string, int, error) {
+	var properties []cmodels.PropertyDTO
+	if err := json.Unmarshal([]byte(value), &properties); err != nil {
+		return "", 0, fmt.Errorf("failed to unmarshal properties: %w", err)
+	}
+
+	count := 0
+	for i := range properties {
+		if !properties[i].IsSecret || properties[i].Value == "" {
+			continue
+		}
+		ciphertext, reencrypted, err := s.reencryptCiphertext(ctx, properties[i].Value)
+		if err != nil {
+			return "", 0, fmt.Errorf("failed to re-encrypt property %s: %w", properties[i].Name, err)
+		}
+		properties[i].Value = ciphertext
+		count += reencrypted
+	}
+	if count == 0 {
+		return value, 0, nil
+	}
+
+	serialized, err := json.Marshal(properties)
+	if err != nil {
+		return "", 0, fmt.Errorf("failed to marshal properties: %w", err)
+	}
+	return string(serialized), count, nil
+}
+
+// reencryptCiphertext re-encrypts a ciphertext with the current key if it was encrypted with a previous
+// key. It returns the resulting ciphertext and the number of re-encrypted values.
+func (s *reencryptionService) reencryptCiphertext(ctx context.Context, ciphertext string) (string, int, error) {
+	needsReencryption, err := s.cryptoProvider.NeedsReencryption(ctx, []byte(ciphertext))
+	if err != nil {
+		return "", 0, err
+	}
+	if !needsReencryption {
+		return ciphertext, 0, nil
+	}
+
+	plaintext, err := s.cryptoProvider.Decrypt(ctx, []byte(ciphertext))
+	if err != nil {
+		return "", 0, err
+	}
+	reencrypted, err := s.cryptoProvider.Encrypt(ctx, plaintext)
+	if err != nil {
+		return "", 0, err
+	}
+	return string(reencrypted), 1, nil
+}
+
+// checkpoint persists the job progress and reports errJobInterrupted when the job is no longer running.
+func (s *reencryptionService) checkpoint(ctx context.Context, jobID string, progress []TargetProgress) error {
+	job, err := s.store.GetJob(ctx, jobID)
+	if err != nil {
+		return fmt.Errorf("failed to retrieve job status: %w", err)
+	}
+	if job.Status != JobStatusRunning {
+		return errJobInterrupted
+	}
+
+	if err := s.store.UpdateProgress(ctx, jobID, progress); err != nil {
+		return fmt.Errorf("failed to persist job progress: %w", err)
+	}
+	return nil
+}
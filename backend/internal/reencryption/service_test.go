@@ -0,0 +1,277 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package reencryption
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/system/backgroundjob"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/tests/mocks/crypto/cryptomock"
+)
+
+const (
+	testJobID         = "job-1"
+	oldCiphertext     = `{"alg":"AES-GCM","ct":"b2xk","kid":"old"}`
+	currentCiphertext = `{"alg":"AES-GCM","ct":"bmV3","kid":"current"}`
+)
+
+type ReencryptionServiceTestSuite struct {
+	suite.Suite
+	mockStore  *reencryptionStoreInterfaceMock
+	mockCrypto *cryptomock.ConfigCryptoProviderMock
+	service    *reencryptionService
+}
+
+func TestReencryptionServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(ReencryptionServiceTestSuite))
+}
+
+func (suite *ReencryptionServiceTestSuite) SetupTest() {
+	suite.mockStore = newReencryptionStoreInterfaceMock(suite.T())
+	suite.mockCrypto = cryptomock.NewConfigCryptoProviderMock(suite.T())
+	suite.service = newReencryptionService(suite.mockStore, suite.mockCrypto, 2, 0).(*reencryptionService)
+	suite.service.runAsync = func(f func()) { f() }
+}
+
+// expectCiphertexts sets up the crypto provider to treat oldCiphertext as encrypted with a previous key.
+func (suite *ReencryptionServiceTestSuite) expectCiphertexts() {
+	suite.mockCrypto.On("NeedsReencryption", mock.Anything, []byte(oldCiphertext)).Return(true, nil).Maybe()
+	suite.mockCrypto.On("NeedsReencryption", mock.Anything, []byte(currentCiphertext)).Return(false, nil).Maybe()
+	suite.mockCrypto.On("Decrypt", mock.Anything, []byte(oldCiphertext)).Return([]byte("secret"), nil).Maybe()
+	suite.mockCrypto.On("Encrypt", mock.Anything, []byte("secret")).Return([]byte(currentCiphertext), nil).Maybe()
+}
+
+func (suite *ReencryptionServiceTestSuite) expectCheckpoint(status JobStatus) {
+	suite.mockStore.On("GetJob", mock.Anything, testJobID).
+		Return(&ReencryptionJob{ID: testJobID, Status: status}, nil).Once()
+	if status == JobStatusRunning {
+		suite.mockStore.On("UpdateProgress", mock.Anything, testJobID, mock.Anything).Return(nil).Once()
+	}
+}
+
+func (suite *ReencryptionServiceTestSuite) TestStartReencryption_ActiveJobConflict() {
+	suite.mockStore.On("GetActiveJob", mock.Anything).
+		Return(&ReencryptionJob{ID: "active", Status: JobStatusRunning, UpdatedAt: time.Now().UTC()}, nil).Once()
+
+	job, err := suite.service.StartReencryption(context.Background())
+
+	suite.Nil(job)
+	suite.Equal(ErrorReencryptionJobConflict.Code, err.Code)
+}
+
+func (suite *ReencryptionServiceTestSuite) TestStartReencryption_CreateJobError() {
+	suite.mockStore.On("GetActiveJob", mock.Anything).Return(nil, ErrReencryptionJobNotFound).Once()
+	suite.mockStore.On("CreateJob", mock.Anything, mock.Anything, mock.Anything).
+		Return(errors.New("db error")).Once()
+
+	job, err := suite.service.StartReencryption(context.Background())
+
+	suite.Nil(job)
+	suite.Equal(serviceerror.InternalServerError.Code, err.Code)
+}
+
+func (suite *ReencryptionServiceTestSuite) TestStartReencryption_ReencryptsAllTargets() {
+	suite.expectCiphertexts()
+	suite.mockStore.On("GetActiveJob", mock.Anything).Return(nil, ErrReencryptionJobNotFound).Once()
+
+	var createdJob ReencryptionJob
+	suite.mockStore.On("CreateJob", mock.Anything, mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			createdJob = args.Get(1).(ReencryptionJob)
+		}).Return(nil).Once()
+	suite.mockStore.On("UpdateStatus", mock.Anything, mock.Anything, JobStatusPending, JobStatusRunning, "").
+		Return(true, nil).Once()
+
+	// Identity providers span two batches: a full batch followed by an empty one.
+	oldProperties := `[{"name":"client_id","value":"abc","isSecret":false},` +
+		`{"name":"client_secret","value":` + quoteJSON(oldCiphertext) + `,"isSecret":true}]`
+	currentProperties := `[{"name":"client_secret","value":` + quoteJSON(currentCiphertext) + `,"isSecret":true}]`
+	suite.mockStore.On("ListRecords", mock.Anything, TargetIdentityProviders, "", 2).
+		Return([]encryptedRecord{{ID: "idp-1", Value: oldProperties}, {ID: "idp-2", Value: currentProperties}},
+			nil).Once()
+	suite.mockStore.On("UpdateRecord", mock.Anything, TargetIdentityProviders, "idp-1", oldProperties,
+		`[{"name":"client_id","value":"abc","isSecret":false},`+
+			`{"name":"client_secret","value":`+quoteJSON(currentCiphertext)+`,"isSecret":true}]`).
+		Return(true, nil).Once()
+	suite.mockStore.On("ListRecords", mock.Anything, TargetIdentityProviders, "idp-2", 2).
+		Return([]encryptedRecord{}, nil).Once()
+
+	suite.mockStore.On("ListRecords", mock.Anything, TargetNotificationSenders, "", 2).
+		Return([]encryptedRecord{{ID: "sender-1"}}, nil).Once()
+
+	// A flow context that changed concurrently is left untouched.
+	suite.mockStore.On("ListRecords", mock.Anything, TargetFlowContexts, "", 2).
+		Return([]encryptedRecord{{ID: "flow-1", Value: oldCiphertext}}, nil).Once()
+	suite.mockStore.On("UpdateRecord", mock.Anything, TargetFlowContexts, "flow-1", oldCiphertext,
+		currentCiphertext).Return(false, nil).Once()
+
+	// Two identity provider batches, one notification sender batch and one flow context batch.
+	var finalProgress []TargetProgress
+	suite.mockStore.On("GetJob", mock.Anything, mock.Anything).
+		Return(&ReencryptionJob{Status: JobStatusRunning}, nil).Times(4)
+	suite.mockStore.On("UpdateProgress", mock.Anything, mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			finalProgress = append([]TargetProgress(nil), args.Get(2).([]TargetProgress)...)
+		}).Return(nil).Times(4)
+	suite.mockStore.On("UpdateStatus", mock.Anything, mock.Anything, JobStatusRunning, JobStatusCompleted, "").
+		Return(true, nil).Once()
+
+	job, err := suite.service.StartReencryption(context.Background())
+
+	suite.Nil(err)
+	suite.Equal(JobStatusPending, job.Status)
+	suite.Equal(job.ID, createdJob.ID)
+	suite.Equal([]TargetProgress{
+		{Target: TargetIdentityProviders, Completed: true, ProcessedRecords: 2, ReencryptedValues: 1,
+			LastRecordID: "idp-2"},
+		{Target: TargetNotificationSenders, Completed: true, ProcessedRecords: 1, LastRecordID: "sender-1"},
+		{Target: TargetFlowContexts, Completed: true, ProcessedRecords: 1, LastRecordID: "flow-1"},
+	}, finalProgress)
+}
+
+func (suite *ReencryptionServiceTestSuite) TestStartReencryption_ResumesStaleJob() {
+	suite.mockStore.On("GetActiveJob", mock.Anything).
+		Return(&ReencryptionJob{ID: testJobID, Status: JobStatusRunning,
+			UpdatedAt: time.Now().UTC().Add(-time.Hour)}, nil).Once()
+	suite.mockStore.On("UpdateStatus", mock.Anything, testJobID, JobStatusRunning, JobStatusFailed,
+		backgroundjob.InterruptedReason).Return(true, nil).Once()
+	suite.mockStore.On("UpdateStatus", mock.Anything, testJobID, JobStatusFailed, JobStatusPending, "").
+		Return(true, nil).Once()
+	suite.mockStore.On("GetJob", mock.Anything, testJobID).Return(&ReencryptionJob{
+		ID:     testJobID,
+		Status: JobStatusPending,
+		Progress: []TargetProgress{
+			{Target: TargetIdentityProviders, Completed: true, ProcessedRecords: 5},
+			{Target: TargetNotificationSenders, Completed: true},
+			{Target: TargetFlowContexts, ProcessedRecords: 2, LastRecordID: "flow-2"},
+		},
+	}, nil).Once()
+	suite.mockStore.On("UpdateStatus", mock.Anything, testJobID, JobStatusPending, JobStatusRunning, "").
+		Return(true, nil).Once()
+	suite.mockStore.On("ListRecords", mock.Anything, TargetFlowContexts, "flow-2", 2).
+		Return([]encryptedRecord{}, nil).Once()
+	suite.expectCheckpoint(JobStatusRunning)
+	suite.mockStore.On("UpdateStatus", mock.Anything, testJobID, JobStatusRunning, JobStatusCompleted, "").
+		Return(true, nil).Once()
+
+	job, err := suite.service.StartReencryption(context.Background())
+
+	suite.Nil(err)
+	suite.Equal(testJobID, job.ID)
+	suite.mockStore.AssertNotCalled(suite.T(), "CreateJob", mock.Anything, mock.Anything, mock.Anything)
+	suite.mockStore.AssertNotCalled(suite.T(), "ListRecords", mock.Anything, TargetIdentityProviders,
+		mock.Anything, mock.Anything)
+}
+
+func (suite *ReencryptionServiceTestSuite) TestRunJob_FailsWhenValueCannotBeDecrypted() {
+	suite.mockCrypto.On("NeedsReencryption", mock.Anything, []byte(oldCiphertext)).Return(true, nil).Once()
+	suite.mockCrypto.On("Decrypt", mock.Anything, []byte(oldCiphertext)).
+		Return(nil, errors.New("decryption key not found for kid")).Once()
+	suite.mockStore.On("UpdateStatus", mock.Anything, testJobID, JobStatusPending, JobStatusRunning, "").
+		Return(true, nil).Once()
+	suite.mockStore.On("ListRecords", mock.Anything, TargetFlowContexts, "", 2).
+		Return([]encryptedRecord{{ID: "flow-1", Value: oldCiphertext}}, nil).Once()
+	suite.mockStore.On("UpdateStatus", mock.Anything, testJobID, JobStatusRunning, JobStatusFailed,
+		mock.MatchedBy(func(reason string) bool {
+			return reason == "failed to re-encrypt record flow-1: decryption key not found for kid"
+		})).Return(true, nil).Once()
+
+	suite.service.runJob(context.Background(), testJobID, []TargetProgress{{Target: TargetFlowContexts}})
+}
+
+func (suite *ReencryptionServiceTestSuite) TestRunJob_StopsWhenJobIsNoLongerRunning() {
+	suite.mockStore.On("UpdateStatus", mock.Anything, testJobID, JobStatusPending, JobStatusRunning, "").
+		Return(true, nil).Once()
+	suite.mockStore.On("ListRecords", mock.Anything, TargetNotificationSenders, "", 2).
+		Return([]encryptedRecord{{ID: "sender-1"}, {ID: "sender-2"}}, nil).Once()
+	suite.expectCheckpoint(JobStatusFailed)
+
+	suite.service.runJob(context.Background(), testJobID, []TargetProgress{
+		{Target: TargetNotificationSenders}, {Target: TargetFlowContexts},
+	})
+
+	suite.mockStore.AssertNotCalled(suite.T(), "ListRecords", mock.Anything, TargetFlowContexts,
+		mock.Anything, mock.Anything)
+}
+
+func (suite *ReencryptionServiceTestSuite) TestRunJob_NoLongerPending() {
+	suite.mockStore.On("UpdateStatus", mock.Anything, testJobID, JobStatusPending, JobStatusRunning, "").
+		Return(false, nil).Once()
+
+	suite.service.runJob(context.Background(), testJobID, []TargetProgress{{Target: TargetFlowContexts}})
+
+	suite.mockStore.AssertNotCalled(suite.T(), "ListRecords", mock.Anything, mock.Anything,
+		mock.Anything, mock.Anything)
+}
+
+func (suite *ReencryptionServiceTestSuite) TestGetReencryptionJob() {
+	suite.mockStore.On("GetJob", mock.Anything, testJobID).
+		Return(&ReencryptionJob{ID: testJobID, Status: JobStatusCompleted}, nil).Once()
+	suite.mockStore.On("GetJob", mock.Anything, "missing").Return(nil, ErrReencryptionJobNotFound).Once()
+	suite.mockStore.On("GetJob", mock.Anything, "broken").Return(nil, errors.New("db error")).Once()
+
+	job, err := suite.service.GetReencryptionJob(context.Background(), testJobID)
+	suite.Nil(err)
+	suite.Equal(JobStatusCompleted, job.Status)
+
+	_, err = suite.service.GetReencryptionJob(context.Background(), "missing")
+	suite.Equal(ErrorReencryptionJobNotFound.Code, err.Code)
+
+	_, err = suite.service.GetReencryptionJob(context.Background(), "broken")
+	suite.Equal(serviceerror.InternalServerError.Code, err.Code)
+
+	_, err = suite.service.GetReencryptionJob(context.Background(), "")
+	suite.Equal(ErrorReencryptionJobNotFound.Code, err.Code)
+}
+
+func (suite *ReencryptionServiceTestSuite) TestResumeReencryption_NotFailed() {
+	suite.mockStore.On("GetJob", mock.Anything, testJobID).
+		Return(&ReencryptionJob{ID: testJobID, Status: JobStatusCompleted}, nil).Once()
+
+	job, err := suite.service.ResumeReencryption(context.Background(), testJobID)
+
+	suite.Nil(job)
+	suite.Equal(ErrorJobNotResumable.Code, err.Code)
+}
+
+func (suite *ReencryptionServiceTestSuite) TestResumeReencryption_ConcurrentResume() {
+	suite.mockStore.On("GetJob", mock.Anything, testJobID).
+		Return(&ReencryptionJob{ID: testJobID, Status: JobStatusFailed}, nil).Once()
+	suite.mockStore.On("GetActiveJob", mock.Anything).Return(nil, ErrReencryptionJobNotFound).Once()
+	suite.mockStore.On("UpdateStatus", mock.Anything, testJobID, JobStatusFailed, JobStatusPending, "").
+		Return(false, nil).Once()
+
+	job, err := suite.service.ResumeReencryption(context.Background(), testJobID)
+
+	suite.Nil(job)
+	suite.Equal(ErrorReencryptionJobConflict.Code, err.Code)
+}
+
+func quoteJSON(value string) string {
+	quoted, _ := json.Marshal(value)
+	return string(quoted)
+}
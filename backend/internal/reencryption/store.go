@@ -0,0 +1,291 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package reencryption
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+	sysContext "github.com/thunder-id/thunderid/internal/system/context"
+	dbmodel "github.com/thunder-id/thunderid/internal/system/database/model"
+	"github.com/thunder-id/thunderid/internal/system/database/provider"
+	dbutils "github.com/thunder-id/thunderid/internal/system/database/utils"
+)
+
+var getDBProvider = provider.GetDBProvider
+
+// targetQuery holds the queries used to read and update the encrypted records of a target.
+type targetQuery struct {
+	runtimeDB bool
+	list      dbmodel.DBQuery
+	update    dbmodel.DBQuery
+}
+
+// targetQueries maps each target to the queries of the table that stores its encrypted records.
+var targetQueries = map[Target]targetQuery{
+	TargetIdentityProviders: {
+		list:   queryListIdentityProviderProperties,
+		update: queryUpdateIdentityProviderProperties,
+	},
+	TargetNotificationSenders: {
+		list:   queryListNotificationSenderProperties,
+		update: queryUpdateNotificationSenderProperties,
+	},
+	TargetFlowContexts: {
+		runtimeDB: true,
+		list:      queryListFlowContexts,
+		update:    queryUpdateFlowContext,
+	},
+}
+
+// reencryptionStoreInterface defines the interface for re-encryption job and encrypted record operations.
+type reencryptionStoreInterface interface {
+	CreateJob(ctx context.Context, job ReencryptionJob, expiryTime time.Time) error
+	GetJob(ctx context.Context, id string) (*ReencryptionJob, error)
+	GetActiveJob(ctx context.Context) (*ReencryptionJob, error)
+	UpdateProgress(ctx context.Context, id string, progress []TargetProgress) error
+	UpdateStatus(ctx context.Context, id string, from, to JobStatus, failureReason string) (bool, error)
+	ListRecords(ctx context.Context, target Target, afterID string, limit int) ([]encryptedRecord, error)
+	UpdateRecord(ctx context.Context, target Target, id, oldValue, newValue string) (bool, error)
+}
+
+// reencryptionStore is the database backed implementation of reencryptionStoreInterface.
+type reencryptionStore struct {
+	dbProvider   provider.DBProviderInterface
+	deploymentID string
+}
+
+// newReencryptionStore creates a new instance of reencryptionStore.
+func newReencryptionStore() reencryptionStoreInterface {
+	return &reencryptionStore{
+		dbProvider:   getDBProvider(),
+		deploymentID: config.GetServerRuntime().Config.Server.Identifier,
+	}
+}
+
+// CreateJob persists a new re-encryption job.
+func (s *reencryptionStore) CreateJob(ctx context.Context, job ReencryptionJob, expiryTime time.Time) error {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
+	dbClient, err := s.dbProvider.GetRuntimeDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	progress, err := json.Marshal(job.Progress)
+	if err != nil {
+		return fmt.Errorf("failed to marshal job progress: %w", err)
+	}
+
+	if _, err := dbClient.ExecuteContext(ctx, queryCreateReencryptionJob, job.ID, string(job.Status),
+		string(progress), job.CreatedAt, job.UpdatedAt, expiryTime, deploymentID); err != nil {
+		return fmt.Errorf("failed to execute query: %w", err)
+	}
+	return nil
+}
+
+// GetJob retrieves a re-encryption job by its ID.
+func (s *reencryptionStore) GetJob(ctx context.Context, id string) (*ReencryptionJob, error) {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
+	return s.getJob(ctx, queryGetReencryptionJob, id, deploymentID)
+}
+
+// GetActiveJob retrieves the pending or running re-encryption job.
+func (s *reencryptionStore) GetActiveJob(ctx context.Context) (*ReencryptionJob, error) {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
+	return s.getJob(ctx, queryGetActiveReencryptionJob,
+		string(JobStatusPending), string(JobStatusRunning), deploymentID)
+}
+
+// UpdateProgress persists the progress of a re-encryption job.
+func (s *reencryptionStore) UpdateProgress(ctx context.Context, id string, progress []TargetProgress) error {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
+	dbClient, err := s.dbProvider.GetRuntimeDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	progressJSON, err := json.Marshal(progress)
+	if err != nil {
+		return fmt.Errorf("failed to marshal job progress: %w", err)
+	}
+
+	if _, err := dbClient.ExecuteContext(ctx, queryUpdateReencryptionJobProgress, string(progressJSON),
+		time.Now().UTC(), id, deploymentID); err != nil {
+		return fmt.Errorf("failed to execute query: %w", err)
+	}
+	return nil
+}
+
+// UpdateStatus moves a re-encryption job to a new status if it is still in the expected status.
+// It reports whether the transition was applied.
+func (s *reencryptionStore) UpdateStatus(
+	ctx context.Context, id string, from, to JobStatus, failureReason string,
+) (bool, error) {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
+	dbClient, err := s.dbProvider.GetRuntimeDBClient()
+	if err != nil {
+		return false, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	rowsAffected, err := dbClient.ExecuteContext(ctx, queryUpdateReencryptionJobStatus, string(to), failureReason,
+		time.Now().UTC(), id, string(from), deploymentID)
+	if err != nil {
+		return false, fmt.Errorf("failed to execute query: %w", err)
+	}
+	return rowsAffected > 0, nil
+}
+
+// ListRecords retrieves up to limit records of the target whose ID sorts after afterID.
+func (s *reencryptionStore) ListRecords(
+	ctx context.Context, target Target, afterID string, limit int,
+) ([]encryptedRecord, error) {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
+	queries, ok := targetQueries[target]
+	if !ok {
+		return nil, fmt.Errorf("unsupported re-encryption target: %s", target)
+	}
+	dbClient, err := s.getDBClient(queries)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	results, err := dbClient.QueryContext(ctx, queries.list, deploymentID, afterID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	records := make([]encryptedRecord, 0, len(results))
+	for _, row := range results {
+		id, ok := row["record_id"].(string)
+		if !ok {
+			return nil, fmt.Errorf("failed to parse record_id as string")
+		}
+		record := encryptedRecord{ID: id}
+		switch v := row["record_value"].(type) {
+		case string:
+			record.Value = v
+		case []byte:
+			record.Value = string(v)
+		case nil:
+		default:
+			return nil, fmt.Errorf("failed to parse record_value of record %s", id)
+		}
+		records = append(records, record)
+	}
+	return records, nil
+}
+
+// UpdateRecord replaces the encrypted value of a record if the stored value still equals oldValue.
+// It reports whether the record was updated.
+func (s *reencryptionStore) UpdateRecord(
+	ctx context.Context, target Target, id, oldValue, newValue string,
+) (bool, error) {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
+	queries, ok := targetQueries[target]
+	if !ok {
+		return false, fmt.Errorf("unsupported re-encryption target: %s", target)
+	}
+	dbClient, err := s.getDBClient(queries)
+	if err != nil {
+		return false, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	rowsAffected, err := dbClient.ExecuteContext(ctx, queries.update, newValue, id, deploymentID, oldValue)
+	if err != nil {
+		return false, fmt.Errorf("failed to execute query: %w", err)
+	}
+	return rowsAffected > 0, nil
+}
+
+// getDBClient returns the client of the database that stores the records of a target.
+func (s *reencryptionStore) getDBClient(queries targetQuery) (provider.DBClientInterface, error) {
+	if queries.runtimeDB {
+		return s.dbProvider.GetRuntimeDBClient()
+	}
+	return s.dbProvider.GetConfigDBClient()
+}
+
+// getJob retrieves a single re-encryption job using the given query.
+func (s *reencryptionStore) getJob(
+	ctx context.Context, query dbmodel.DBQuery, args ...interface{},
+) (*ReencryptionJob, error) {
+	dbClient, err := s.dbProvider.GetRuntimeDBClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	results, err := dbClient.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+	if len(results) == 0 {
+		return nil, ErrReencryptionJobNotFound
+	}
+
+	return buildReencryptionJobFromResultRow(results[0])
+}
+
+// buildReencryptionJobFromResultRow constructs a ReencryptionJob from a database result row.
+func buildReencryptionJobFromResultRow(row map[string]interface{}) (*ReencryptionJob, error) {
+	id, ok := row["id"].(string)
+	if !ok {
+		return nil, fmt.Errorf("failed to parse id as string")
+	}
+	status, ok := row["status"].(string)
+	if !ok {
+		return nil, fmt.Errorf("failed to parse status as string")
+	}
+
+	var progressJSON []byte
+	switch v := row["progress"].(type) {
+	case string:
+		progressJSON = []byte(v)
+	case []byte:
+		progressJSON = v
+	default:
+		return nil, fmt.Errorf("failed to parse progress")
+	}
+	var progress []TargetProgress
+	if err := json.Unmarshal(progressJSON, &progress); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal job progress: %w", err)
+	}
+
+	failureReason, _ := row["failure_reason"].(string)
+
+	createdAt, err := dbutils.ParseTimeField(row["created_at"], "created_at")
+	if err != nil {
+		return nil, err
+	}
+	updatedAt, err := dbutils.ParseTimeField(row["updated_at"], "updated_at")
+	if err != nil {
+		return nil, err
+	}
+
+	return &ReencryptionJob{
+		ID:            id,
+		Status:        JobStatus(status),
+		Progress:      progress,
+		FailureReason: failureReason,
+		CreatedAt:     createdAt,
+		UpdatedAt:     updatedAt,
+	}, nil
+}
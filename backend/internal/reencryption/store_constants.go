@@ -0,0 +1,99 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package reencryption
+
+import dbmodel "github.com/thunder-id/thunderid/internal/system/database/model"
+
+var (
+	// queryCreateReencryptionJob inserts a new re-encryption job.
+	queryCreateReencryptionJob = dbmodel.DBQuery{
+		ID: "REEQ-JOB_MGT-01",
+		Query: `INSERT INTO "REENCRYPTION_JOB" (ID, STATUS, PROGRESS, CREATED_AT, UPDATED_AT, EXPIRY_TIME, ` +
+			`DEPLOYMENT_ID) VALUES ($1, $2, $3, $4, $5, $6, $7)`,
+	}
+
+	// queryGetReencryptionJob retrieves a re-encryption job by ID.
+	queryGetReencryptionJob = dbmodel.DBQuery{
+		ID: "REEQ-JOB_MGT-02",
+		Query: `SELECT ID, STATUS, PROGRESS, FAILURE_REASON, CREATED_AT, UPDATED_AT ` +
+			`FROM "REENCRYPTION_JOB" WHERE ID = $1 AND DEPLOYMENT_ID = $2`,
+	}
+
+	// queryGetActiveReencryptionJob retrieves the pending or running re-encryption job.
+	queryGetActiveReencryptionJob = dbmodel.DBQuery{
+		ID: "REEQ-JOB_MGT-03",
+		Query: `SELECT ID, STATUS, PROGRESS, FAILURE_REASON, CREATED_AT, UPDATED_AT ` +
+			`FROM "REENCRYPTION_JOB" WHERE STATUS IN ($1, $2) AND DEPLOYMENT_ID = $3 ` +
+			`ORDER BY CREATED_AT DESC LIMIT 1`,
+	}
+
+	// queryUpdateReencryptionJobProgress updates the progress of a re-encryption job.
+	queryUpdateReencryptionJobProgress = dbmodel.DBQuery{
+		ID:    "REEQ-JOB_MGT-04",
+		Query: `UPDATE "REENCRYPTION_JOB" SET PROGRESS = $1, UPDATED_AT = $2 WHERE ID = $3 AND DEPLOYMENT_ID = $4`,
+	}
+
+	// queryUpdateReencryptionJobStatus moves a re-encryption job from an expected status to a new status.
+	queryUpdateReencryptionJobStatus = dbmodel.DBQuery{
+		ID: "REEQ-JOB_MGT-05",
+		Query: `UPDATE "REENCRYPTION_JOB" SET STATUS = $1, FAILURE_REASON = $2, UPDATED_AT = $3 ` +
+			`WHERE ID = $4 AND STATUS = $5 AND DEPLOYMENT_ID = $6`,
+	}
+
+	// queryListIdentityProviderProperties retrieves a batch of identity provider properties ordered by ID.
+	queryListIdentityProviderProperties = dbmodel.DBQuery{
+		ID: "REEQ-DATA-01",
+		Query: `SELECT ID AS RECORD_ID, PROPERTIES AS RECORD_VALUE FROM "IDP" ` +
+			`WHERE DEPLOYMENT_ID = $1 AND ID > $2 ORDER BY ID LIMIT $3`,
+	}
+
+	// queryUpdateIdentityProviderProperties replaces the properties of an identity provider if they are unchanged.
+	queryUpdateIdentityProviderProperties = dbmodel.DBQuery{
+		ID:    "REEQ-DATA-02",
+		Query: `UPDATE "IDP" SET PROPERTIES = $1 WHERE ID = $2 AND DEPLOYMENT_ID = $3 AND PROPERTIES = $4`,
+	}
+
+	// queryListNotificationSenderProperties retrieves a batch of notification sender properties ordered by ID.
+	queryListNotificationSenderProperties = dbmodel.DBQuery{
+		ID: "REEQ-DATA-03",
+		Query: `SELECT ID AS RECORD_ID, PROPERTIES AS RECORD_VALUE FROM "NOTIFICATION_SENDER" ` +
+			`WHERE DEPLOYMENT_ID = $1 AND ID > $2 ORDER BY ID LIMIT $3`,
+	}
+
+	// queryUpdateNotificationSenderProperties replaces the properties of a notification sender if they are
+	// unchanged.
+	queryUpdateNotificationSenderProperties = dbmodel.DBQuery{
+		ID: "REEQ-DATA-04",
+		Query: `UPDATE "NOTIFICATION_SENDER" SET PROPERTIES = $1 ` +
+			`WHERE ID = $2 AND DEPLOYMENT_ID = $3 AND PROPERTIES = $4`,
+	}
+
+	// queryListFlowContexts retrieves a batch of encrypted flow contexts ordered by flow ID.
+	queryListFlowContexts = dbmodel.DBQuery{
+		ID: "REEQ-DATA-05",
+		Query: `SELECT FLOW_ID AS RECORD_ID, CONTEXT AS RECORD_VALUE FROM "FLOW_CONTEXT" ` +
+			`WHERE DEPLOYMENT_ID = $1 AND FLOW_ID > $2 ORDER BY FLOW_ID LIMIT $3`,
+	}
+
+	// queryUpdateFlowContext replaces the encrypted context of a flow if it is unchanged.
+	queryUpdateFlowContext = dbmodel.DBQuery{
+		ID:    "REEQ-DATA-06",
+		Query: `UPDATE "FLOW_CONTEXT" SET CONTEXT = $1 WHERE FLOW_ID = $2 AND DEPLOYMENT_ID = $3 AND CONTEXT = $4`,
+	}
+)
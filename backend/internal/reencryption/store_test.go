@@ -0,0 +1,162 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package reencryption
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/tests/mocks/database/providermock"
+)
+
+const testDeploymentID = "test-deployment-id"
+
+type ReencryptionStoreTestSuite struct {
+	suite.Suite
+	mockDBProvider *providermock.DBProviderInterfaceMock
+	mockDBClient   *providermock.DBClientInterfaceMock
+	store          *reencryptionStore
+	ctx            context.Context
+}
+
+func TestReencryptionStoreTestSuite(t *testing.T) {
+	suite.Run(t, new(ReencryptionStoreTestSuite))
+}
+
+func (suite *ReencryptionStoreTestSuite) SetupTest() {
+	suite.mockDBProvider = providermock.NewDBProviderInterfaceMock(suite.T())
+	suite.mockDBClient = providermock.NewDBClientInterfaceMock(suite.T())
+	suite.store = &reencryptionStore{
+		dbProvider:   suite.mockDBProvider,
+		deploymentID: testDeploymentID,
+	}
+	suite.ctx = context.Background()
+}
+
+func (suite *ReencryptionStoreTestSuite) TestCreateJob_Success() {
+	now := time.Now().UTC()
+	job := ReencryptionJob{ID: "job-1", Status: JobStatusPending,
+		Progress: []TargetProgress{{Target: TargetFlowContexts}}, CreatedAt: now, UpdatedAt: now}
+	expiry := now.Add(time.Hour)
+
+	suite.mockDBProvider.On("GetRuntimeDBClient").Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.On("ExecuteContext", suite.ctx, queryCreateReencryptionJob, "job-1", "PENDING",
+		`[{"target":"FLOW_CONTEXTS","completed":false,"processedRecords":0,"reencryptedValues":0}]`,
+		now, now, expiry, testDeploymentID).Return(int64(1), nil).Once()
+
+	suite.NoError(suite.store.CreateJob(suite.ctx, job, expiry))
+}
+
+func (suite *ReencryptionStoreTestSuite) TestGetJob_Success() {
+	suite.mockDBProvider.On("GetRuntimeDBClient").Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.On("QueryContext", suite.ctx, queryGetReencryptionJob, "job-1", testDeploymentID).
+		Return([]map[string]interface{}{{
+			"id":     "job-1",
+			"status": "FAILED",
+			"progress": []byte(`[{"target":"IDENTITY_PROVIDERS","completed":false,"processedRecords":3,` +
+				`"reencryptedValues":2,"lastRecordId":"idp-3"}]`),
+			"failure_reason": "decryption key not found for kid",
+			"created_at":     "2026-01-02 03:04:05.123456",
+			"updated_at":     time.Date(2026, 1, 2, 3, 5, 0, 0, time.UTC),
+		}}, nil).Once()
+
+	job, err := suite.store.GetJob(suite.ctx, "job-1")
+
+	suite.NoError(err)
+	suite.Equal(JobStatusFailed, job.Status)
+	suite.Equal([]TargetProgress{{Target: TargetIdentityProviders, ProcessedRecords: 3, ReencryptedValues: 2,
+		LastRecordID: "idp-3"}}, job.Progress)
+	suite.Equal("decryption key not found for kid", job.FailureReason)
+	suite.Equal(5, job.UpdatedAt.Minute())
+}
+
+func (suite *ReencryptionStoreTestSuite) TestGetActiveJob_NotFound() {
+	suite.mockDBProvider.On("GetRuntimeDBClient").Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.On("QueryContext", suite.ctx, queryGetActiveReencryptionJob, "PENDING", "RUNNING",
+		testDeploymentID).Return([]map[string]interface{}{}, nil).Once()
+
+	job, err := suite.store.GetActiveJob(suite.ctx)
+
+	suite.Nil(job)
+	suite.ErrorIs(err, ErrReencryptionJobNotFound)
+}
+
+func (suite *ReencryptionStoreTestSuite) TestUpdateStatus() {
+	suite.mockDBProvider.On("GetRuntimeDBClient").Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.On("ExecuteContext", suite.ctx, queryUpdateReencryptionJobStatus, "RUNNING", "",
+		mock.Anything, "job-1", "PENDING", testDeploymentID).Return(int64(0), nil).Once()
+
+	applied, err := suite.store.UpdateStatus(suite.ctx, "job-1", JobStatusPending, JobStatusRunning, "")
+
+	suite.NoError(err)
+	suite.False(applied)
+}
+
+func (suite *ReencryptionStoreTestSuite) TestListRecords_UsesTargetDatabase() {
+	suite.mockDBProvider.On("GetConfigDBClient").Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.On("QueryContext", suite.ctx, queryListIdentityProviderProperties, testDeploymentID,
+		"idp-1", 50).Return([]map[string]interface{}{
+		{"record_id": "idp-2", "record_value": []byte(`[]`)},
+		{"record_id": "idp-3", "record_value": nil},
+	}, nil).Once()
+
+	records, err := suite.store.ListRecords(suite.ctx, TargetIdentityProviders, "idp-1", 50)
+
+	suite.NoError(err)
+	suite.Equal([]encryptedRecord{{ID: "idp-2", Value: "[]"}, {ID: "idp-3"}}, records)
+
+	suite.mockDBProvider.On("GetRuntimeDBClient").Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.On("QueryContext", suite.ctx, queryListFlowContexts, testDeploymentID, "", 50).
+		Return([]map[string]interface{}{{"record_id": "flow-1", "record_value": `{"kid":"old"}`}}, nil).Once()
+
+	records, err = suite.store.ListRecords(suite.ctx, TargetFlowContexts, "", 50)
+
+	suite.NoError(err)
+	suite.Equal([]encryptedRecord{{ID: "flow-1", Value: `{"kid":"old"}`}}, records)
+}
+
+func (suite *ReencryptionStoreTestSuite) TestListRecords_UnsupportedTarget() {
+	_, err := suite.store.ListRecords(suite.ctx, Target("UNKNOWN"), "", 50)
+
+	suite.ErrorContains(err, "unsupported re-encryption target")
+}
+
+func (suite *ReencryptionStoreTestSuite) TestUpdateRecord() {
+	suite.mockDBProvider.On("GetConfigDBClient").Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.On("ExecuteContext", suite.ctx, queryUpdateNotificationSenderProperties, "new",
+		"sender-1", testDeploymentID, "old").Return(int64(1), nil).Once()
+
+	updated, err := suite.store.UpdateRecord(suite.ctx, TargetNotificationSenders, "sender-1", "old", "new")
+
+	suite.NoError(err)
+	suite.True(updated)
+}
+
+func (suite *ReencryptionStoreTestSuite) TestUpdateRecord_DBClientError() {
+	suite.mockDBProvider.On("GetRuntimeDBClient").Return(nil, errors.New("db error")).Once()
+
+	_, err := suite.store.UpdateRecord(suite.ctx, TargetFlowContexts, "flow-1", "old", "new")
+
+	suite.ErrorContains(err, "failed to get database client")
+}
@@ -0,0 +1,86 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package reindex
+
+import (
+	"errors"
+
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/i18n/core"
+)
+
+// ErrReindexJobNotFound is returned when the reindex job is not found in the store.
+var ErrReindexJobNotFound = errors.New("reindex job not found")
+
+// Client errors for reindex operations.
+var (
+	// ErrorReindexJobNotFound is the error returned when a reindex job is not found.
+	ErrorReindexJobNotFound = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "RIX-1001",
+		Error: core.I18nMessage{
+			Key:          "error.reindexservice.job_not_found",
+			DefaultValue: "Reindex job not found",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.reindexservice.job_not_found_description",
+			DefaultValue: "The requested reindex job could not be found",
+		},
+	}
+	// ErrorReindexJobConflict is the error returned when another reindex job is active.
+	ErrorReindexJobConflict = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "RIX-1002",
+		Error: core.I18nMessage{
+			Key:          "error.reindexservice.job_conflict",
+			DefaultValue: "Reindex already in progress",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.reindexservice.job_conflict_description",
+			DefaultValue: "Another reindex job is already active",
+		},
+	}
+	// ErrorJobNotResumable is the error returned when a reindex job has not failed.
+	ErrorJobNotResumable = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "RIX-1003",
+		Error: core.I18nMessage{
+			Key:          "error.reindexservice.job_not_resumable",
+			DefaultValue: "Reindex job cannot be resumed",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.reindexservice.job_not_resumable_description",
+			DefaultValue: "Only a failed reindex job can be resumed",
+		},
+	}
+	// ErrorIndexedAttributesChanged is the error returned when a failed reindex job is resumed after the
+	// indexed attributes were changed.
+	ErrorIndexedAttributesChanged = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "RIX-1004",
+		Error: core.I18nMessage{
+			Key:          "error.reindexservice.indexed_attributes_changed",
+			DefaultValue: "Indexed attributes changed",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.reindexservice.indexed_attributes_changed_description",
+			DefaultValue: "The indexed attributes changed after the job was created. Start a new reindex job",
+		},
+	}
+)
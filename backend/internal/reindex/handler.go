@@ -0,0 +1,79 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package reindex
+
+import (
+	"net/http"
+
+	sysutils "github.com/thunder-id/thunderid/internal/system/utils"
+)
+
+// reindexHandler is the handler for reindex job operations.
+type reindexHandler struct {
+	reindexService ReindexServiceInterface
+}
+
+// newReindexHandler creates a new instance of reindexHandler.
+func newReindexHandler(reindexService ReindexServiceInterface) *reindexHandler {
+	return &reindexHandler{
+		reindexService: reindexService,
+	}
+}
+
+// HandleReindexJobPostRequest handles the start reindex job request.
+func (h *reindexHandler) HandleReindexJobPostRequest(w http.ResponseWriter, r *http.Request) {
+	job, svcErr := h.reindexService.StartReindex(r.Context())
+	if svcErr != nil {
+		sysutils.WriteServiceErrorResponse(w, svcErr, clientErrorStatusCodes)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(w, http.StatusAccepted, job)
+}
+
+// HandleReindexJobGetRequest handles the get reindex job request.
+func (h *reindexHandler) HandleReindexJobGetRequest(w http.ResponseWriter, r *http.Request) {
+	job, svcErr := h.reindexService.GetReindexJob(r.Context(), r.PathValue("id"))
+	if svcErr != nil {
+		sysutils.WriteServiceErrorResponse(w, svcErr, clientErrorStatusCodes)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(w, http.StatusOK, job)
+}
+
+// HandleReindexJobResumeRequest handles the resume reindex job request.
+func (h *reindexHandler) HandleReindexJobResumeRequest(w http.ResponseWriter, r *http.Request) {
+	job, svcErr := h.reindexService.ResumeReindex(r.Context(), r.PathValue("id"))
+	if svcErr != nil {
+		sysutils.WriteServiceErrorResponse(w, svcErr, clientErrorStatusCodes)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(w, http.StatusAccepted, job)
+}
+
+// clientErrorStatusCodes maps the client errors of the handler to HTTP status codes. Other client errors are
+// returned with 400 Bad Request.
+var clientErrorStatusCodes = map[string]int{
+	ErrorReindexJobNotFound.Code:       http.StatusNotFound,
+	ErrorReindexJobConflict.Code:       http.StatusConflict,
+	ErrorJobNotResumable.Code:          http.StatusConflict,
+	ErrorIndexedAttributesChanged.Code: http.StatusConflict,
+}
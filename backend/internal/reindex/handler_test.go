@@ -0,0 +1,150 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package reindex
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/system/error/apierror"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+)
+
+type HandlerTestSuite struct {
+	suite.Suite
+	mockService *ReindexServiceInterfaceMock
+	handler     *reindexHandler
+}
+
+func TestHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(HandlerTestSuite))
+}
+
+func (s *HandlerTestSuite) SetupTest() {
+	s.mockService = NewReindexServiceInterfaceMock(s.T())
+	s.handler = newReindexHandler(s.mockService)
+}
+
+func (s *HandlerTestSuite) TestHandleReindexJobPostRequest_Accepted() {
+	s.mockService.On("StartReindex", mock.Anything).
+		Return(&ReindexJob{ID: "job-1", Status: JobStatusPending}, nil)
+
+	req := httptest.NewRequest(http.MethodPost, "/admin/users/reindex-jobs", nil)
+	rr := httptest.NewRecorder()
+	s.handler.HandleReindexJobPostRequest(rr, req)
+
+	s.Equal(http.StatusAccepted, rr.Code)
+	var body ReindexJob
+	s.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &body))
+	s.Equal("job-1", body.ID)
+	s.Equal(JobStatusPending, body.Status)
+}
+
+func (s *HandlerTestSuite) TestHandleReindexJobPostRequest_ErrorStatusCodes() {
+	testCases := []struct {
+		name     string
+		svcErr   *serviceerror.ServiceError
+		expected int
+	}{
+		{"Conflict", &ErrorReindexJobConflict, http.StatusConflict},
+		{"ServerError", &serviceerror.InternalServerError, http.StatusInternalServerError},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			mockService := NewReindexServiceInterfaceMock(s.T())
+			mockService.On("StartReindex", mock.Anything).Return(nil, tc.svcErr)
+			handler := newReindexHandler(mockService)
+
+			req := httptest.NewRequest(http.MethodPost, "/admin/users/reindex-jobs", nil)
+			rr := httptest.NewRecorder()
+			handler.HandleReindexJobPostRequest(rr, req)
+
+			s.Equal(tc.expected, rr.Code)
+			var errResp apierror.ErrorResponse
+			s.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &errResp))
+			s.Equal(tc.svcErr.Code, errResp.Code)
+		})
+	}
+}
+
+func (s *HandlerTestSuite) TestHandleReindexJobGetRequest() {
+	s.mockService.On("GetReindexJob", mock.Anything, "job-1").
+		Return(&ReindexJob{ID: "job-1", Status: JobStatusRunning}, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/admin/users/reindex-jobs/job-1", nil)
+	req.SetPathValue("id", "job-1")
+	rr := httptest.NewRecorder()
+	s.handler.HandleReindexJobGetRequest(rr, req)
+
+	s.Equal(http.StatusOK, rr.Code)
+	var body ReindexJob
+	s.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &body))
+	s.Equal(JobStatusRunning, body.Status)
+}
+
+func (s *HandlerTestSuite) TestHandleReindexJobGetRequest_NotFound() {
+	s.mockService.On("GetReindexJob", mock.Anything, "missing").Return(nil, &ErrorReindexJobNotFound)
+
+	req := httptest.NewRequest(http.MethodGet, "/admin/users/reindex-jobs/missing", nil)
+	req.SetPathValue("id", "missing")
+	rr := httptest.NewRecorder()
+	s.handler.HandleReindexJobGetRequest(rr, req)
+
+	s.Equal(http.StatusNotFound, rr.Code)
+}
+
+func (s *HandlerTestSuite) TestHandleReindexJobResumeRequest() {
+	s.mockService.On("ResumeReindex", mock.Anything, "job-1").
+		Return(&ReindexJob{ID: "job-1", Status: JobStatusPending}, nil)
+
+	req := httptest.NewRequest(http.MethodPost, "/admin/users/reindex-jobs/job-1/resume", nil)
+	req.SetPathValue("id", "job-1")
+	rr := httptest.NewRecorder()
+	s.handler.HandleReindexJobResumeRequest(rr, req)
+
+	s.Equal(http.StatusAccepted, rr.Code)
+}
+
+func (s *HandlerTestSuite) TestHandleReindexJobResumeRequest_NotResumable() {
+	s.mockService.On("ResumeReindex", mock.Anything, "job-1").Return(nil, &ErrorJobNotResumable)
+
+	req := httptest.NewRequest(http.MethodPost, "/admin/users/reindex-jobs/job-1/resume", nil)
+	req.SetPathValue("id", "job-1")
+	rr := httptest.NewRecorder()
+	s.handler.HandleReindexJobResumeRequest(rr, req)
+
+	s.Equal(http.StatusConflict, rr.Code)
+}
+
+func (s *HandlerTestSuite) TestHandleReindexJobResumeRequest_IndexedAttributesChanged() {
+	s.mockService.On("ResumeReindex", mock.Anything, "job-1").Return(nil, &ErrorIndexedAttributesChanged)
+
+	req := httptest.NewRequest(http.MethodPost, "/admin/users/reindex-jobs/job-1/resume", nil)
+	req.SetPathValue("id", "job-1")
+	rr := httptest.NewRecorder()
+	s.handler.HandleReindexJobResumeRequest(rr, req)
+
+	s.Equal(http.StatusConflict, rr.Code)
+}
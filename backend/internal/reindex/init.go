@@ -0,0 +1,76 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package reindex
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/entity"
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/middleware"
+)
+
+// Initialize initializes the reindex service and registers its routes.
+func Initialize(mux *http.ServeMux, entityService entity.EntityServiceInterface) ReindexServiceInterface {
+	reindexConfig := config.GetServerRuntime().Config.User.Reindex
+	reindexService := newReindexService(newReindexStore(), entityService, reindexConfig.BatchSize,
+		time.Duration(reindexConfig.BatchInterval)*time.Millisecond, reindexConfig.JobRetention)
+
+	reindexHandler := newReindexHandler(reindexService)
+	registerRoutes(mux, reindexHandler)
+
+	return reindexService
+}
+
+// registerRoutes registers the routes for reindex job operations.
+func registerRoutes(mux *http.ServeMux, reindexHandler *reindexHandler) {
+	opts1 := middleware.CORSOptions{
+		AllowedMethods:   []string{"POST"},
+		AllowedHeaders:   middleware.DefaultAllowedHeaders,
+		AllowCredentials: true,
+		MaxAge:           600,
+	}
+	mux.HandleFunc(middleware.WithCORS("POST /admin/users/reindex-jobs",
+		reindexHandler.HandleReindexJobPostRequest, opts1))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS /admin/users/reindex-jobs",
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, opts1))
+
+	opts2 := middleware.CORSOptions{
+		AllowedMethods:   []string{"GET"},
+		AllowedHeaders:   middleware.DefaultAllowedHeaders,
+		AllowCredentials: true,
+		MaxAge:           600,
+	}
+	mux.HandleFunc(middleware.WithCORS("GET /admin/users/reindex-jobs/{id}",
+		reindexHandler.HandleReindexJobGetRequest, opts2))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS /admin/users/reindex-jobs/{id}",
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, opts2))
+
+	mux.HandleFunc(middleware.WithCORS("POST /admin/users/reindex-jobs/{id}/resume",
+		reindexHandler.HandleReindexJobResumeRequest, opts1))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS /admin/users/reindex-jobs/{id}/resume",
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, opts1))
+}
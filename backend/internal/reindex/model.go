@@ -0,0 +1,73 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package reindex provides resumable jobs that bring the indexed identifiers of existing users in line
+// with the configured indexed attributes.
+package reindex
+
+import "time"
+
+// JobStatus represents the lifecycle state of a reindex job.
+type JobStatus string
+
+const (
+	// JobStatusPending indicates the job is accepted but has not started reindexing users.
+	JobStatusPending JobStatus = "PENDING"
+	// JobStatusRunning indicates the job is reindexing users.
+	JobStatusRunning JobStatus = "RUNNING"
+	// JobStatusCompleted indicates the identifiers of all users match the indexed attributes of the job.
+	JobStatusCompleted JobStatus = "COMPLETED"
+	// JobStatusFailed indicates the job stopped before it completed. A failed job can be resumed.
+	JobStatusFailed JobStatus = "FAILED"
+)
+
+// ReindexProgress holds the progress of a reindex job.
+type ReindexProgress struct {
+	// TotalUsers is the number of users when the job was created.
+	TotalUsers         int `json:"totalUsers"`
+	ProcessedUsers     int `json:"processedUsers"`
+	SkippedUsers       int `json:"skippedUsers"`
+	AddedIdentifiers   int `json:"addedIdentifiers"`
+	RemovedIdentifiers int `json:"removedIdentifiers"`
+	// LastUserID is the ID of the last processed user. A resumed job continues after this user.
+	LastUserID string `json:"lastUserId,omitempty"`
+}
+
+// ReindexJob represents an asynchronous reindex job.
+type ReindexJob struct {
+	ID                string          `json:"id"`
+	Status            JobStatus       `json:"status"`
+	IndexedAttributes []string        `json:"indexedAttributes"`
+	Progress          ReindexProgress `json:"progress"`
+	FailureReason     string          `json:"failureReason,omitempty"`
+	CreatedAt         time.Time       `json:"createdAt"`
+	UpdatedAt         time.Time       `json:"updatedAt"`
+}
+
+// indexedUser is a stored user with the attributes its identifiers are derived from.
+type indexedUser struct {
+	ID               string
+	Attributes       string
+	SystemAttributes string
+}
+
+// identifier is the indexed value of an attribute of a user.
+type identifier struct {
+	value  string
+	source string
+}
@@ -0,0 +1,420 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package reindex
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/entity"
+	"github.com/thunder-id/thunderid/internal/system/backgroundjob"
+	serverconst "github.com/thunder-id/thunderid/internal/system/constants"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/internal/system/utils"
+)
+
+// errJobInterrupted signals that the worker observed that the job it is running is no longer running,
+// for example because it was released as stale by another node.
+var errJobInterrupted = errors.New("reindex job interrupted")
+
+// ReindexServiceInterface defines the interface for the reindex service.
+type ReindexServiceInterface interface {
+	StartReindex(ctx context.Context) (*ReindexJob, *serviceerror.ServiceError)
+	GetReindexJob(ctx context.Context, jobID string) (*ReindexJob, *serviceerror.ServiceError)
+	ResumeReindex(ctx context.Context, jobID string) (*ReindexJob, *serviceerror.ServiceError)
+}
+
+// reindexService is the default implementation of the ReindexServiceInterface.
+type reindexService struct {
+	store         reindexStoreInterface
+	lease         *backgroundjob.Lease[JobStatus]
+	entityService entity.EntityServiceInterface
+	batchSize     int
+	batchInterval time.Duration
+	jobRetention  int64
+	// runAsync starts the worker of a job. It runs the worker on a new goroutine.
+	runAsync func(func())
+	// sleep pauses the worker between batches until the duration elapses or the context is done.
+	sleep func(ctx context.Context, d time.Duration)
+}
+
+// newReindexService creates a new instance of reindexService.
+func newReindexService(
+	store reindexStoreInterface,
+	entityService entity.EntityServiceInterface,
+	batchSize int,
+	batchInterval time.Duration,
+	jobRetention int64,
+) ReindexServiceInterface {
+	if batchSize < 1 || batchSize > serverconst.MaxPageSize {
+		batchSize = serverconst.MaxPageSize
+	}
+	if batchInterval < 0 {
+		batchInterval = 0
+	}
+	if jobRetention <= 0 {
+		jobRetention = defaultJobRetention
+	}
+	return &reindexService{
+		store:         store,
+		lease:         backgroundjob.NewLease(store.UpdateStatus, JobStatusPending, JobStatusFailed),
+		entityService: entityService,
+		batchSize:     batchSize,
+		batchInterval: batchInterval,
+		jobRetention:  jobRetention,
+		runAsync:      func(f func()) { go f() },
+		sleep:         utils.SleepContext,
+	}
+}
+
+// StartReindex starts a job that brings the identifiers of all users in line with the indexed attributes.
+// If an active job with the same indexed attributes was interrupted before it completed, that job is
+// resumed instead.
+func (s *reindexService) StartReindex(ctx context.Context) (*ReindexJob, *serviceerror.ServiceError) {
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName))
+	attributes := s.entityService.GetIndexedAttributes()
+
+	interrupted, svcErr := s.releaseActiveJob(ctx)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	if interrupted != nil && slices.Equal(interrupted.IndexedAttributes, attributes) {
+		return s.resumeJob(ctx, interrupted.ID)
+	}
+
+	totalUsers, err := s.store.CountUsers(ctx)
+	if err != nil {
+		logger.Error("Failed to count users", log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+	jobID, err := utils.GenerateUUIDv7()
+	if err != nil {
+		logger.Error("Failed to generate reindex job ID", log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+	now := time.Now().UTC()
+	job := ReindexJob{
+		ID:                jobID,
+		Status:            JobStatusPending,
+		IndexedAttributes: attributes,
+		Progress:          ReindexProgress{TotalUsers: totalUsers},
+		CreatedAt:         now,
+		UpdatedAt:         now,
+	}
+	expiryTime := now.Add(time.Duration(s.jobRetention) * time.Second)
+	if err := s.store.CreateJob(ctx, job, expiryTime); err != nil {
+		logger.Error("Failed to create reindex job", log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+
+	s.startWorker(ctx, &job)
+
+	logger.Debug("Started reindex job", log.String("jobID", jobID))
+	return &job, nil
+}
+
+// GetReindexJob retrieves a reindex job by its ID.
+func (s *reindexService) GetReindexJob(ctx context.Context, jobID string) (*ReindexJob, *serviceerror.ServiceError) {
+	return s.getJob(ctx, jobID)
+}
+
+// ResumeReindex resumes a failed reindex job from its last persisted progress. The job can only be
+// resumed while the indexed attributes are those it was created with.
+func (s *reindexService) ResumeReindex(ctx context.Context, jobID string) (*ReindexJob, *serviceerror.ServiceError) {
+	job, svcErr := s.getJob(ctx, jobID)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	if job.Status != JobStatusFailed {
+		return nil, &ErrorJobNotResumable
+	}
+	if !slices.Equal(job.IndexedAttributes, s.entityService.GetIndexedAttributes()) {
+		return nil, &ErrorIndexedAttributesChanged
+	}
+
+	if _, svcErr := s.releaseActiveJob(ctx); svcErr != nil {
+		return nil, svcErr
+	}
+	return s.resumeJob(ctx, jobID)
+}
+
+// resumeJob moves a failed job back to pending and starts a worker that continues from its progress.
+func (s *reindexService) resumeJob(ctx context.Context, jobID string) (*ReindexJob, *serviceerror.ServiceError) {
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName))
+
+	if err := s.lease.Resume(ctx, jobID); err != nil {
+		if errors.Is(err, backgroundjob.ErrJobConflict) {
+			// Another request resumed the job first.
+			return nil, &ErrorReindexJobConflict
+		}
+		logger.Error("Failed to resume reindex job", log.String("jobID", jobID), log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+
+	job, svcErr := s.getJob(ctx, jobID)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	s.startWorker(ctx, job)
+
+	logger.Debug("Resumed reindex job", log.String("jobID", jobID))
+	return job, nil
+}
+
+// startWorker runs the job on a worker that outlives the request.
+func (s *reindexService) startWorker(ctx context.Context, job *ReindexJob) {
+	jobID := job.ID
+	attributes := slices.Clone(job.IndexedAttributes)
+	progress := job.Progress
+	backgroundjob.StartWorker(ctx, s.runAsync, func(workerCtx context.Context) {
+		s.runJob(workerCtx, jobID, attributes, progress)
+	})
+}
+
+// getJob retrieves a reindex job from the store and maps store errors to service errors.
+func (s *reindexService) getJob(ctx context.Context, jobID string) (*ReindexJob, *serviceerror.ServiceError) {
+	if jobID == "" {
+		return nil, &ErrorReindexJobNotFound
+	}
+
+	job, err := s.store.GetJob(ctx, jobID)
+	if err != nil {
+		if errors.Is(err, ErrReindexJobNotFound) {
+			return nil, &ErrorReindexJobNotFound
+		}
+		log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName)).
+			Error("Failed to retrieve reindex job", log.String("jobID", jobID), log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+	return job, nil
+}
+
+// releaseActiveJob rejects the request when another job is active. An active job that has not reported
+// progress within backgroundjob.StaleTimeout is marked as failed and returned so that it can be resumed.
+func (s *reindexService) releaseActiveJob(ctx context.Context) (*ReindexJob, *serviceerror.ServiceError) {
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName))
+
+	active, err := s.store.GetActiveJob(ctx)
+	if err != nil {
+		if errors.Is(err, ErrReindexJobNotFound) {
+			return nil, nil
+		}
+		logger.Error("Failed to check active reindex jobs", log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+	if err := s.lease.ReleaseStale(ctx, active.ID, active.Status, active.UpdatedAt); err != nil {
+		if errors.Is(err, backgroundjob.ErrJobConflict) {
+			return nil, &ErrorReindexJobConflict
+		}
+		logger.Error("Failed to release stale reindex job", log.String("jobID", active.ID), log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+	return active, nil
+}
+
+// runJob reindexes users batch by batch, starting after the last processed user, pausing between batches
+// and persisting the progress after every batch, and records the outcome of the job.
+func (s *reindexService) runJob(ctx context.Context, jobID string, attributes []string, progress ReindexProgress) {
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName),
+		log.String("jobID", jobID))
+
+	started, err := s.store.UpdateStatus(ctx, jobID, JobStatusPending, JobStatusRunning, "")
+	if err != nil {
+		logger.Error("Failed to start reindex job", log.Error(err))
+		return
+	}
+	if !started {
+		logger.Debug("Reindex job is no longer pending")
+		return
+	}
+
+	indexed := make(map[string]bool, len(attributes))
+	for _, attribute := range attributes {
+		indexed[attribute] = true
+	}
+
+	for {
+		completed, err := s.reindexBatch(ctx, jobID, indexed, &progress)
+		if err != nil {
+			if errors.Is(err, errJobInterrupted) {
+				logger.Debug("Reindex job was interrupted")
+				return
+			}
+			logger.Error("Reindex job failed", log.Error(err))
+			if _, err := s.store.UpdateStatus(ctx, jobID, JobStatusRunning, JobStatusFailed, err.Error()); err != nil {
+				logger.Error("Failed to mark reindex job as failed", log.Error(err))
+			}
+			return
+		}
+		if completed {
+			break
+		}
+		s.sleep(ctx, s.batchInterval)
+	}
+
+	if _, err := s.store.UpdateStatus(ctx, jobID, JobStatusRunning, JobStatusCompleted, ""); err != nil {
+		logger.Error("Failed to mark reindex job as completed", log.Error(err))
+		return
+	}
+	logger.Debug("Reindex job completed", log.Int("processedUsers", progress.ProcessedUsers),
+		log.Int("addedIdentifiers", progress.AddedIdentifiers),
+		log.Int("removedIdentifiers", progress.RemovedIdentifiers))
+}
+
+// reindexBatch reindexes the next batch of users and persists the progress. It reports whether the last
+// batch was processed.
+func (s *reindexService) reindexBatch(
+	ctx context.Context, jobID string, indexed map[string]bool, progress *ReindexProgress,
+) (bool, error) {
+	users, err := s.store.ListUsers(ctx, progress.LastUserID, s.batchSize)
+	if err != nil {
+		return false, fmt.Errorf("failed to list users: %w", err)
+	}
+
+	userIDs := make([]string, 0, len(users))
+	for _, user := range users {
+		userIDs = append(userIDs, user.ID)
+	}
+	stored, err := s.store.ListIdentifierNames(ctx, userIDs)
+	if err != nil {
+		return false, fmt.Errorf("failed to list identifiers: %w", err)
+	}
+
+	for _, user := range users {
+		if err := s.reindexUser(ctx, user, stored[user.ID], indexed, progress); err != nil {
+			return false, fmt.Errorf("failed to reindex user %s: %w", user.ID, err)
+		}
+		progress.ProcessedUsers++
+		progress.LastUserID = user.ID
+	}
+
+	if err := s.checkpoint(ctx, jobID, *progress); err != nil {
+		return false, err
+	}
+	return len(users) < s.batchSize, nil
+}
+
+// reindexUser removes the identifiers of a user for attributes that are no longer indexed and adds the
+// missing identifiers of indexed attributes. A user whose attributes cannot be read is skipped.
+func (s *reindexService) reindexUser(
+	ctx context.Context, user indexedUser, storedNames []string, indexed map[string]bool, progress *ReindexProgress,
+) error {
+	identifiers, err := extractIdentifiers(user, indexed)
+	if err != nil {
+		log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName)).
+			Warn("Skipping user with unreadable attributes", log.String("userID", user.ID), log.Error(err))
+		progress.SkippedUsers++
+		return nil
+	}
+
+	stored := make(map[string]bool, len(storedNames))
+	for _, name := range storedNames {
+		stored[name] = true
+		if indexed[name] {
+			continue
+		}
+		removed, err := s.store.DeleteIdentifier(ctx, user.ID, name)
+		if err != nil {
+			return err
+		}
+		if removed {
+			progress.RemovedIdentifiers++
+		}
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(identifiers)) {
+		if stored[name] {
+			continue
+		}
+		added, err := s.store.AddIdentifier(ctx, user.ID, name, identifiers[name])
+		if err != nil {
+			return err
+		}
+		if added {
+			progress.AddedIdentifiers++
+		}
+	}
+	return nil
+}
+
+// checkpoint persists the job progress and reports errJobInterrupted when the job is no longer running.
+func (s *reindexService) checkpoint(ctx context.Context, jobID string, progress ReindexProgress) error {
+	job, err := s.store.GetJob(ctx, jobID)
+	if err != nil {
+		return fmt.Errorf("failed to retrieve job status: %w", err)
+	}
+	if job.Status != JobStatusRunning {
+		return errJobInterrupted
+	}
+
+	if err := s.store.UpdateProgress(ctx, jobID, progress); err != nil {
+		return fmt.Errorf("failed to persist job progress: %w", err)
+	}
+	return nil
+}
+
+// extractIdentifiers returns the identifiers of the indexed attributes of a user. As in the entity store,
+// a system attribute takes precedence over a schema attribute with the same name, and only scalar values
+// are indexed.
+func extractIdentifiers(user indexedUser, indexed map[string]bool) (map[string]identifier, error) {
+	identifiers := make(map[string]identifier)
+	sources := []struct {
+		value  string
+		source string
+	}{
+		{user.Attributes, identifierSourceAttribute},
+		{user.SystemAttributes, identifierSourceSystem},
+	}
+	for _, src := range sources {
+		if src.value == "" {
+			continue
+		}
+		var attributes map[string]interface{}
+		if err := json.Unmarshal([]byte(src.value), &attributes); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal %s attributes: %w", src.source, err)
+		}
+		for name, value := range attributes {
+			if !indexed[name] {
+				continue
+			}
+			if str := identifierValue(value); str != "" {
+				identifiers[name] = identifier{value: str, source: src.source}
+			}
+		}
+	}
+	return identifiers, nil
+}
+
+// identifierValue converts an attribute value to its indexed form. Complex values are not indexed.
+func identifierValue(value interface{}) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case float64, bool:
+		return fmt.Sprintf("%v", v)
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,288 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package reindex
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/system/backgroundjob"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/tests/mocks/entitymock"
+)
+
+const testJobID = "job-1"
+
+var testAttributes = []string{"email", "username"}
+
+type ReindexServiceTestSuite struct {
+	suite.Suite
+	mockStore  *reindexStoreInterfaceMock
+	mockEntity *entitymock.EntityServiceInterfaceMock
+	service    *reindexService
+	sleeps     []time.Duration
+}
+
+func TestReindexServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(ReindexServiceTestSuite))
+}
+
+func (suite *ReindexServiceTestSuite) SetupTest() {
+	suite.mockStore = newReindexStoreInterfaceMock(suite.T())
+	suite.mockEntity = entitymock.NewEntityServiceInterfaceMock(suite.T())
+	suite.mockEntity.On("GetIndexedAttributes").Return(testAttributes).Maybe()
+	suite.service = newReindexService(suite.mockStore, suite.mockEntity, 2, 50*time.Millisecond, 0).(*reindexService)
+	suite.service.runAsync = func(f func()) { f() }
+	suite.sleeps = nil
+	suite.service.sleep = func(_ context.Context, d time.Duration) { suite.sleeps = append(suite.sleeps, d) }
+}
+
+func (suite *ReindexServiceTestSuite) expectCheckpoint(status JobStatus) {
+	suite.mockStore.On("GetJob", mock.Anything, testJobID).
+		Return(&ReindexJob{ID: testJobID, Status: status}, nil).Once()
+	if status == JobStatusRunning {
+		suite.mockStore.On("UpdateProgress", mock.Anything, testJobID, mock.Anything).Return(nil).Once()
+	}
+}
+
+func (suite *ReindexServiceTestSuite) TestNewReindexService_Defaults() {
+	service := newReindexService(suite.mockStore, suite.mockEntity, 0, -time.Second, 0).(*reindexService)
+
+	suite.Equal(100, service.batchSize)
+	suite.Equal(time.Duration(0), service.batchInterval)
+	suite.Equal(defaultJobRetention, service.jobRetention)
+}
+
+func (suite *ReindexServiceTestSuite) TestStartReindex_ActiveJobConflict() {
+	suite.mockStore.On("GetActiveJob", mock.Anything).
+		Return(&ReindexJob{ID: "active", Status: JobStatusRunning, UpdatedAt: time.Now().UTC()}, nil).Once()
+
+	job, err := suite.service.StartReindex(context.Background())
+
+	suite.Nil(job)
+	suite.Equal(ErrorReindexJobConflict.Code, err.Code)
+}
+
+func (suite *ReindexServiceTestSuite) TestStartReindex_CountUsersError() {
+	suite.mockStore.On("GetActiveJob", mock.Anything).Return(nil, ErrReindexJobNotFound).Once()
+	suite.mockStore.On("CountUsers", mock.Anything).Return(0, errors.New("db error")).Once()
+
+	job, err := suite.service.StartReindex(context.Background())
+
+	suite.Nil(job)
+	suite.Equal(serviceerror.InternalServerError.Code, err.Code)
+}
+
+func (suite *ReindexServiceTestSuite) TestStartReindex_ReindexesAllUsers() {
+	suite.mockStore.On("GetActiveJob", mock.Anything).Return(nil, ErrReindexJobNotFound).Once()
+	suite.mockStore.On("CountUsers", mock.Anything).Return(3, nil).Once()
+
+	var createdJob ReindexJob
+	suite.mockStore.On("CreateJob", mock.Anything, mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			createdJob = args.Get(1).(ReindexJob)
+		}).Return(nil).Once()
+	suite.mockStore.On("UpdateStatus", mock.Anything, mock.Anything, JobStatusPending, JobStatusRunning, "").
+		Return(true, nil).Once()
+
+	// The first batch is full: user-1 gains an email identifier and loses a de-configured one, and the
+	// username of user-2 is already indexed.
+	suite.mockStore.On("ListUsers", mock.Anything, "", 2).Return([]indexedUser{
+		{ID: "user-1", Attributes: `{"email":"a@example.com","mobileNumber":"123","address":{"city":"x"}}`},
+		{ID: "user-2", Attributes: `{"username":"bob"}`, SystemAttributes: `{"email":"b@example.com"}`},
+	}, nil).Once()
+	suite.mockStore.On("ListIdentifierNames", mock.Anything, []string{"user-1", "user-2"}).
+		Return(map[string][]string{"user-1": {"mobileNumber"}, "user-2": {"username"}}, nil).Once()
+	suite.mockStore.On("DeleteIdentifier", mock.Anything, "user-1", "mobileNumber").Return(true, nil).Once()
+	suite.mockStore.On("AddIdentifier", mock.Anything, "user-1", "email",
+		identifier{value: "a@example.com", source: identifierSourceAttribute}).Return(true, nil).Once()
+	suite.mockStore.On("AddIdentifier", mock.Anything, "user-2", "email",
+		identifier{value: "b@example.com", source: identifierSourceSystem}).Return(true, nil).Once()
+
+	// The second batch holds a user whose attributes cannot be read.
+	suite.mockStore.On("ListUsers", mock.Anything, "user-2", 2).
+		Return([]indexedUser{{ID: "user-3", Attributes: `{`}}, nil).Once()
+	suite.mockStore.On("ListIdentifierNames", mock.Anything, []string{"user-3"}).
+		Return(map[string][]string{}, nil).Once()
+
+	var finalProgress ReindexProgress
+	suite.mockStore.On("GetJob", mock.Anything, mock.Anything).
+		Return(&ReindexJob{Status: JobStatusRunning}, nil).Times(2)
+	suite.mockStore.On("UpdateProgress", mock.Anything, mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			finalProgress = args.Get(2).(ReindexProgress)
+		}).Return(nil).Times(2)
+	suite.mockStore.On("UpdateStatus", mock.Anything, mock.Anything, JobStatusRunning, JobStatusCompleted, "").
+		Return(true, nil).Once()
+
+	job, err := suite.service.StartReindex(context.Background())
+
+	suite.Nil(err)
+	suite.Equal(JobStatusPending, job.Status)
+	suite.Equal(job.ID, createdJob.ID)
+	suite.Equal(testAttributes, createdJob.IndexedAttributes)
+	suite.Equal(3, createdJob.Progress.TotalUsers)
+	suite.Equal(ReindexProgress{
+		TotalUsers: 3, ProcessedUsers: 3, SkippedUsers: 1, AddedIdentifiers: 2, RemovedIdentifiers: 1,
+		LastUserID: "user-3",
+	}, finalProgress)
+	suite.Equal([]time.Duration{50 * time.Millisecond}, suite.sleeps)
+}
+
+func (suite *ReindexServiceTestSuite) TestStartReindex_ResumesStaleJob() {
+	suite.mockStore.On("GetActiveJob", mock.Anything).
+		Return(&ReindexJob{ID: testJobID, Status: JobStatusRunning, IndexedAttributes: testAttributes,
+			UpdatedAt: time.Now().UTC().Add(-time.Hour)}, nil).Once()
+	suite.mockStore.On("UpdateStatus", mock.Anything, testJobID, JobStatusRunning, JobStatusFailed,
+		backgroundjob.InterruptedReason).Return(true, nil).Once()
+	suite.mockStore.On("UpdateStatus", mock.Anything, testJobID, JobStatusFailed, JobStatusPending, "").
+		Return(true, nil).Once()
+	suite.mockStore.On("GetJob", mock.Anything, testJobID).Return(&ReindexJob{
+		ID:                testJobID,
+		Status:            JobStatusPending,
+		IndexedAttributes: testAttributes,
+		Progress:          ReindexProgress{TotalUsers: 5, ProcessedUsers: 4, LastUserID: "user-4"},
+	}, nil).Once()
+	suite.mockStore.On("UpdateStatus", mock.Anything, testJobID, JobStatusPending, JobStatusRunning, "").
+		Return(true, nil).Once()
+	suite.mockStore.On("ListUsers", mock.Anything, "user-4", 2).Return([]indexedUser{}, nil).Once()
+	suite.mockStore.On("ListIdentifierNames", mock.Anything, []string{}).Return(map[string][]string{}, nil).Once()
+	suite.expectCheckpoint(JobStatusRunning)
+	suite.mockStore.On("UpdateStatus", mock.Anything, testJobID, JobStatusRunning, JobStatusCompleted, "").
+		Return(true, nil).Once()
+
+	job, err := suite.service.StartReindex(context.Background())
+
+	suite.Nil(err)
+	suite.Equal(testJobID, job.ID)
+	suite.mockStore.AssertNotCalled(suite.T(), "CreateJob", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *ReindexServiceTestSuite) TestStartReindex_ReplacesStaleJobWithOtherAttributes() {
+	suite.mockStore.On("GetActiveJob", mock.Anything).
+		Return(&ReindexJob{ID: testJobID, Status: JobStatusRunning, IndexedAttributes: []string{"email"},
+			UpdatedAt: time.Now().UTC().Add(-time.Hour)}, nil).Once()
+	suite.mockStore.On("UpdateStatus", mock.Anything, testJobID, JobStatusRunning, JobStatusFailed,
+		backgroundjob.InterruptedReason).Return(true, nil).Once()
+	suite.mockStore.On("CountUsers", mock.Anything).Return(0, nil).Once()
+	suite.mockStore.On("CreateJob", mock.Anything, mock.Anything, mock.Anything).
+		Return(errors.New("db error")).Once()
+
+	job, err := suite.service.StartReindex(context.Background())
+
+	suite.Nil(job)
+	suite.Equal(serviceerror.InternalServerError.Code, err.Code)
+}
+
+func (suite *ReindexServiceTestSuite) TestRunJob_FailsWhenIdentifierCannotBeAdded() {
+	suite.mockStore.On("UpdateStatus", mock.Anything, testJobID, JobStatusPending, JobStatusRunning, "").
+		Return(true, nil).Once()
+	suite.mockStore.On("ListUsers", mock.Anything, "", 2).
+		Return([]indexedUser{{ID: "user-1", Attributes: `{"username":"alice"}`}}, nil).Once()
+	suite.mockStore.On("ListIdentifierNames", mock.Anything, []string{"user-1"}).
+		Return(map[string][]string{}, nil).Once()
+	suite.mockStore.On("AddIdentifier", mock.Anything, "user-1", "username", mock.Anything).
+		Return(false, errors.New("db error")).Once()
+	suite.mockStore.On("UpdateStatus", mock.Anything, testJobID, JobStatusRunning, JobStatusFailed,
+		"failed to reindex user user-1: db error").Return(true, nil).Once()
+
+	suite.service.runJob(context.Background(), testJobID, testAttributes, ReindexProgress{})
+}
+
+func (suite *ReindexServiceTestSuite) TestRunJob_StopsWhenJobIsNoLongerRunning() {
+	suite.mockStore.On("UpdateStatus", mock.Anything, testJobID, JobStatusPending, JobStatusRunning, "").
+		Return(true, nil).Once()
+	suite.mockStore.On("ListUsers", mock.Anything, "", 2).
+		Return([]indexedUser{{ID: "user-1"}, {ID: "user-2"}}, nil).Once()
+	suite.mockStore.On("ListIdentifierNames", mock.Anything, []string{"user-1", "user-2"}).
+		Return(map[string][]string{}, nil).Once()
+	suite.expectCheckpoint(JobStatusFailed)
+
+	suite.service.runJob(context.Background(), testJobID, testAttributes, ReindexProgress{})
+
+	suite.Empty(suite.sleeps)
+}
+
+func (suite *ReindexServiceTestSuite) TestRunJob_NoLongerPending() {
+	suite.mockStore.On("UpdateStatus", mock.Anything, testJobID, JobStatusPending, JobStatusRunning, "").
+		Return(false, nil).Once()
+
+	suite.service.runJob(context.Background(), testJobID, testAttributes, ReindexProgress{})
+
+	suite.mockStore.AssertNotCalled(suite.T(), "ListUsers", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *ReindexServiceTestSuite) TestGetReindexJob() {
+	suite.mockStore.On("GetJob", mock.Anything, testJobID).
+		Return(&ReindexJob{ID: testJobID, Status: JobStatusCompleted}, nil).Once()
+	suite.mockStore.On("GetJob", mock.Anything, "missing").Return(nil, ErrReindexJobNotFound).Once()
+	suite.mockStore.On("GetJob", mock.Anything, "broken").Return(nil, errors.New("db error")).Once()
+
+	job, err := suite.service.GetReindexJob(context.Background(), testJobID)
+	suite.Nil(err)
+	suite.Equal(JobStatusCompleted, job.Status)
+
+	_, err = suite.service.GetReindexJob(context.Background(), "missing")
+	suite.Equal(ErrorReindexJobNotFound.Code, err.Code)
+
+	_, err = suite.service.GetReindexJob(context.Background(), "broken")
+	suite.Equal(serviceerror.InternalServerError.Code, err.Code)
+
+	_, err = suite.service.GetReindexJob(context.Background(), "")
+	suite.Equal(ErrorReindexJobNotFound.Code, err.Code)
+}
+
+func (suite *ReindexServiceTestSuite) TestResumeReindex_NotFailed() {
+	suite.mockStore.On("GetJob", mock.Anything, testJobID).
+		Return(&ReindexJob{ID: testJobID, Status: JobStatusCompleted}, nil).Once()
+
+	job, err := suite.service.ResumeReindex(context.Background(), testJobID)
+
+	suite.Nil(job)
+	suite.Equal(ErrorJobNotResumable.Code, err.Code)
+}
+
+func (suite *ReindexServiceTestSuite) TestResumeReindex_IndexedAttributesChanged() {
+	suite.mockStore.On("GetJob", mock.Anything, testJobID).
+		Return(&ReindexJob{ID: testJobID, Status: JobStatusFailed, IndexedAttributes: []string{"email"}}, nil).
+		Once()
+
+	job, err := suite.service.ResumeReindex(context.Background(), testJobID)
+
+	suite.Nil(job)
+	suite.Equal(ErrorIndexedAttributesChanged.Code, err.Code)
+}
+
+func (suite *ReindexServiceTestSuite) TestResumeReindex_ConcurrentResume() {
+	suite.mockStore.On("GetJob", mock.Anything, testJobID).
+		Return(&ReindexJob{ID: testJobID, Status: JobStatusFailed, IndexedAttributes: testAttributes}, nil).Once()
+	suite.mockStore.On("GetActiveJob", mock.Anything).Return(nil, ErrReindexJobNotFound).Once()
+	suite.mockStore.On("UpdateStatus", mock.Anything, testJobID, JobStatusFailed, JobStatusPending, "").
+		Return(false, nil).Once()
+
+	job, err := suite.service.ResumeReindex(context.Background(), testJobID)
+
+	suite.Nil(job)
+	suite.Equal(ErrorReindexJobConflict.Code, err.Code)
+}
@@ -0,0 +1,328 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package reindex
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/entity"
+	"github.com/thunder-id/thunderid/internal/system/config"
+	sysContext "github.com/thunder-id/thunderid/internal/system/context"
+	dbmodel "github.com/thunder-id/thunderid/internal/system/database/model"
+	"github.com/thunder-id/thunderid/internal/system/database/provider"
+	dbutils "github.com/thunder-id/thunderid/internal/system/database/utils"
+)
+
+var getDBProvider = provider.GetDBProvider
+
+// reindexStoreInterface defines the interface for reindex job and user identifier operations.
+type reindexStoreInterface interface {
+	CreateJob(ctx context.Context, job ReindexJob, expiryTime time.Time) error
+	GetJob(ctx context.Context, id string) (*ReindexJob, error)
+	GetActiveJob(ctx context.Context) (*ReindexJob, error)
+	UpdateProgress(ctx context.Context, id string, progress ReindexProgress) error
+	UpdateStatus(ctx context.Context, id string, from, to JobStatus, failureReason string) (bool, error)
+	CountUsers(ctx context.Context) (int, error)
+	ListUsers(ctx context.Context, afterID string, limit int) ([]indexedUser, error)
+	ListIdentifierNames(ctx context.Context, userIDs []string) (map[string][]string, error)
+	AddIdentifier(ctx context.Context, userID, name string, value identifier) (bool, error)
+	DeleteIdentifier(ctx context.Context, userID, name string) (bool, error)
+}
+
+// reindexStore is the database backed implementation of reindexStoreInterface.
+type reindexStore struct {
+	dbProvider   provider.DBProviderInterface
+	deploymentID string
+}
+
+// newReindexStore creates a new instance of reindexStore.
+func newReindexStore() reindexStoreInterface {
+	return &reindexStore{
+		dbProvider:   getDBProvider(),
+		deploymentID: config.GetServerRuntime().Config.Server.Identifier,
+	}
+}
+
+// CreateJob persists a new reindex job.
+func (s *reindexStore) CreateJob(ctx context.Context, job ReindexJob, expiryTime time.Time) error {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
+	dbClient, err := s.dbProvider.GetRuntimeDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	attributes, err := json.Marshal(job.IndexedAttributes)
+	if err != nil {
+		return fmt.Errorf("failed to marshal indexed attributes: %w", err)
+	}
+	progress, err := json.Marshal(job.Progress)
+	if err != nil {
+		return fmt.Errorf("failed to marshal job progress: %w", err)
+	}
+
+	if _, err := dbClient.ExecuteContext(ctx, queryCreateReindexJob, job.ID, string(job.Status),
+		string(attributes), string(progress), job.CreatedAt, job.UpdatedAt, expiryTime, deploymentID); err != nil {
+		return fmt.Errorf("failed to execute query: %w", err)
+	}
+	return nil
+}
+
+// GetJob retrieves a reindex job by its ID.
+func (s *reindexStore) GetJob(ctx context.Context, id string) (*ReindexJob, error) {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
+	return s.getJob(ctx, queryGetReindexJob, id, deploymentID)
+}
+
+// GetActiveJob retrieves the pending or running reindex job.
+func (s *reindexStore) GetActiveJob(ctx context.Context) (*ReindexJob, error) {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
+	return s.getJob(ctx, queryGetActiveReindexJob, string(JobStatusPending), string(JobStatusRunning), deploymentID)
+}
+
+// UpdateProgress persists the progress of a reindex job.
+func (s *reindexStore) UpdateProgress(ctx context.Context, id string, progress ReindexProgress) error {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
+	dbClient, err := s.dbProvider.GetRuntimeDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	progressJSON, err := json.Marshal(progress)
+	if err != nil {
+		return fmt.Errorf("failed to marshal job progress: %w", err)
+	}
+
+	if _, err := dbClient.ExecuteContext(ctx, queryUpdateReindexJobProgress, string(progressJSON),
+		time.Now().UTC(), id, deploymentID); err != nil {
+		return fmt.Errorf("failed to execute query: %w", err)
+	}
+	return nil
+}
+
+// UpdateStatus moves a reindex job to a new status if it is still in the expected status.
+// It reports whether the transition was applied.
+func (s *reindexStore) UpdateStatus(
+	ctx context.Context, id string, from, to JobStatus, failureReason string,
+) (bool, error) {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
+	dbClient, err := s.dbProvider.GetRuntimeDBClient()
+	if err != nil {
+		return false, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	rowsAffected, err := dbClient.ExecuteContext(ctx, queryUpdateReindexJobStatus, string(to), failureReason,
+		time.Now().UTC(), id, string(from), deploymentID)
+	if err != nil {
+		return false, fmt.Errorf("failed to execute query: %w", err)
+	}
+	return rowsAffected > 0, nil
+}
+
+// CountUsers returns the number of users.
+func (s *reindexStore) CountUsers(ctx context.Context) (int, error) {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
+	dbClient, err := s.dbProvider.GetUserDBClient()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	results, err := dbClient.QueryContext(ctx, queryCountUsers, string(entity.EntityCategoryUser), deploymentID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to execute query: %w", err)
+	}
+	if len(results) == 0 {
+		return 0, nil
+	}
+	if count, ok := results[0]["total"].(int64); ok {
+		return int(count), nil
+	}
+	return 0, fmt.Errorf("unexpected type for total: %T", results[0]["total"])
+}
+
+// ListUsers retrieves up to limit users whose ID sorts after afterID.
+func (s *reindexStore) ListUsers(ctx context.Context, afterID string, limit int) ([]indexedUser, error) {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
+	dbClient, err := s.dbProvider.GetUserDBClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	results, err := dbClient.QueryContext(ctx, queryListUsers, string(entity.EntityCategoryUser), deploymentID,
+		afterID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	users := make([]indexedUser, 0, len(results))
+	for _, row := range results {
+		id, ok := row["id"].(string)
+		if !ok {
+			return nil, fmt.Errorf("failed to parse id as string")
+		}
+		users = append(users, indexedUser{
+			ID:               id,
+			Attributes:       parseTextField(row["attributes"]),
+			SystemAttributes: parseTextField(row["system_attributes"]),
+		})
+	}
+	return users, nil
+}
+
+// ListIdentifierNames returns the names of the stored identifiers of each of the given users.
+func (s *reindexStore) ListIdentifierNames(ctx context.Context, userIDs []string) (map[string][]string, error) {
+	names := make(map[string][]string, len(userIDs))
+	if len(userIDs) == 0 {
+		return names, nil
+	}
+
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
+	dbClient, err := s.dbProvider.GetUserDBClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	query, args, err := buildListIdentifierNamesQuery(userIDs, deploymentID)
+	if err != nil {
+		return nil, err
+	}
+	results, err := dbClient.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	for _, row := range results {
+		userID, ok := row["entity_id"].(string)
+		if !ok {
+			return nil, fmt.Errorf("failed to parse entity_id as string")
+		}
+		name, ok := row["name"].(string)
+		if !ok {
+			return nil, fmt.Errorf("failed to parse name as string")
+		}
+		names[userID] = append(names[userID], name)
+	}
+	return names, nil
+}
+
+// AddIdentifier stores the identifier of an attribute of a user. It reports whether the identifier was added;
+// it is not added when the user no longer exists or already has an identifier for the attribute.
+func (s *reindexStore) AddIdentifier(ctx context.Context, userID, name string, value identifier) (bool, error) {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
+	dbClient, err := s.dbProvider.GetUserDBClient()
+	if err != nil {
+		return false, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	rowsAffected, err := dbClient.ExecuteContext(ctx, queryInsertIdentifier, userID, name, value.value,
+		value.source, time.Now().UTC(), deploymentID)
+	if err != nil {
+		return false, fmt.Errorf("failed to execute query: %w", err)
+	}
+	return rowsAffected > 0, nil
+}
+
+// DeleteIdentifier removes the identifier of an attribute from a user and reports whether it was removed.
+func (s *reindexStore) DeleteIdentifier(ctx context.Context, userID, name string) (bool, error) {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
+	dbClient, err := s.dbProvider.GetUserDBClient()
+	if err != nil {
+		return false, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	rowsAffected, err := dbClient.ExecuteContext(ctx, queryDeleteIdentifier, userID, deploymentID, name)
+	if err != nil {
+		return false, fmt.Errorf("failed to execute query: %w", err)
+	}
+	return rowsAffected > 0, nil
+}
+
+// getJob retrieves a single reindex job using the given query.
+func (s *reindexStore) getJob(ctx context.Context, query dbmodel.DBQuery, args ...interface{}) (*ReindexJob, error) {
+	dbClient, err := s.dbProvider.GetRuntimeDBClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	results, err := dbClient.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+	if len(results) == 0 {
+		return nil, ErrReindexJobNotFound
+	}
+
+	return buildReindexJobFromResultRow(results[0])
+}
+
+// buildReindexJobFromResultRow constructs a ReindexJob from a database result row.
+func buildReindexJobFromResultRow(row map[string]interface{}) (*ReindexJob, error) {
+	id, ok := row["id"].(string)
+	if !ok {
+		return nil, fmt.Errorf("failed to parse id as string")
+	}
+	status, ok := row["status"].(string)
+	if !ok {
+		return nil, fmt.Errorf("failed to parse status as string")
+	}
+
+	var attributes []string
+	if err := json.Unmarshal([]byte(parseTextField(row["indexed_attributes"])), &attributes); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal indexed attributes: %w", err)
+	}
+	var progress ReindexProgress
+	if err := json.Unmarshal([]byte(parseTextField(row["progress"])), &progress); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal job progress: %w", err)
+	}
+
+	failureReason, _ := row["failure_reason"].(string)
+
+	createdAt, err := dbutils.ParseTimeField(row["created_at"], "created_at")
+	if err != nil {
+		return nil, err
+	}
+	updatedAt, err := dbutils.ParseTimeField(row["updated_at"], "updated_at")
+	if err != nil {
+		return nil, err
+	}
+
+	return &ReindexJob{
+		ID:                id,
+		Status:            JobStatus(status),
+		IndexedAttributes: attributes,
+		Progress:          progress,
+		FailureReason:     failureReason,
+		CreatedAt:         createdAt,
+		UpdatedAt:         updatedAt,
+	}, nil
+}
+
+// parseTextField returns the value of a text column, which drivers return as a string or as bytes.
+func parseTextField(field interface{}) string {
+	switch v := field.(type) {
+	case string:
+		return v
+	case []byte:
+		return string(v)
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,122 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package reindex
+
+import (
+	"fmt"
+	"strings"
+
+	dbmodel "github.com/thunder-id/thunderid/internal/system/database/model"
+)
+
+var (
+	// queryCreateReindexJob inserts a new reindex job.
+	queryCreateReindexJob = dbmodel.DBQuery{
+		ID: "RIXQ-JOB_MGT-01",
+		Query: `INSERT INTO "REINDEX_JOB" (ID, STATUS, INDEXED_ATTRIBUTES, PROGRESS, CREATED_AT, UPDATED_AT, ` +
+			`EXPIRY_TIME, DEPLOYMENT_ID) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`,
+	}
+
+	// queryGetReindexJob retrieves a reindex job by ID.
+	queryGetReindexJob = dbmodel.DBQuery{
+		ID: "RIXQ-JOB_MGT-02",
+		Query: `SELECT ID, STATUS, INDEXED_ATTRIBUTES, PROGRESS, FAILURE_REASON, CREATED_AT, UPDATED_AT ` +
+			`FROM "REINDEX_JOB" WHERE ID = $1 AND DEPLOYMENT_ID = $2`,
+	}
+
+	// queryGetActiveReindexJob retrieves the pending or running reindex job.
+	queryGetActiveReindexJob = dbmodel.DBQuery{
+		ID: "RIXQ-JOB_MGT-03",
+		Query: `SELECT ID, STATUS, INDEXED_ATTRIBUTES, PROGRESS, FAILURE_REASON, CREATED_AT, UPDATED_AT ` +
+			`FROM "REINDEX_JOB" WHERE STATUS IN ($1, $2) AND DEPLOYMENT_ID = $3 ` +
+			`ORDER BY CREATED_AT DESC LIMIT 1`,
+	}
+
+	// queryUpdateReindexJobProgress updates the progress of a reindex job.
+	queryUpdateReindexJobProgress = dbmodel.DBQuery{
+		ID:    "RIXQ-JOB_MGT-04",
+		Query: `UPDATE "REINDEX_JOB" SET PROGRESS = $1, UPDATED_AT = $2 WHERE ID = $3 AND DEPLOYMENT_ID = $4`,
+	}
+
+	// queryUpdateReindexJobStatus moves a reindex job from an expected status to a new status.
+	queryUpdateReindexJobStatus = dbmodel.DBQuery{
+		ID: "RIXQ-JOB_MGT-05",
+		Query: `UPDATE "REINDEX_JOB" SET STATUS = $1, FAILURE_REASON = $2, UPDATED_AT = $3 ` +
+			`WHERE ID = $4 AND STATUS = $5 AND DEPLOYMENT_ID = $6`,
+	}
+
+	// queryCountUsers counts the users of the deployment.
+	queryCountUsers = dbmodel.DBQuery{
+		ID:    "RIXQ-DATA-01",
+		Query: `SELECT COUNT(*) AS total FROM "ENTITY" WHERE CATEGORY = $1 AND DEPLOYMENT_ID = $2`,
+	}
+
+	// queryListUsers retrieves a batch of users ordered by ID.
+	queryListUsers = dbmodel.DBQuery{
+		ID: "RIXQ-DATA-02",
+		Query: `SELECT ID, ATTRIBUTES, SYSTEM_ATTRIBUTES FROM "ENTITY" ` +
+			`WHERE CATEGORY = $1 AND DEPLOYMENT_ID = $2 AND ID > $3 ORDER BY ID LIMIT $4`,
+	}
+
+	// queryInsertIdentifier adds an identifier to a user that still exists unless the user already has an
+	// identifier for the attribute, which a concurrent write of the user keeps up to date.
+	queryInsertIdentifier = dbmodel.DBQuery{
+		ID: "RIXQ-DATA-03",
+		Query: `INSERT INTO "ENTITY_IDENTIFIER" (ENTITY_ID, NAME, VALUE, SOURCE, DEPLOYMENT_ID, CREATED_AT) ` +
+			`SELECT ID, $2, $3, $4, DEPLOYMENT_ID, $5 FROM "ENTITY" WHERE ID = $1 AND DEPLOYMENT_ID = $6 ` +
+			`ON CONFLICT (ENTITY_ID, DEPLOYMENT_ID, NAME) DO NOTHING`,
+	}
+
+	// queryDeleteIdentifier removes the identifier of an attribute from a user.
+	queryDeleteIdentifier = dbmodel.DBQuery{
+		ID:    "RIXQ-DATA-04",
+		Query: `DELETE FROM "ENTITY_IDENTIFIER" WHERE ENTITY_ID = $1 AND DEPLOYMENT_ID = $2 AND NAME = $3`,
+	}
+)
+
+// buildListIdentifierNamesQuery constructs a query that lists the names of the identifiers of the given users.
+func buildListIdentifierNamesQuery(
+	userIDs []string, deploymentID string,
+) (dbmodel.DBQuery, []interface{}, error) {
+	if len(userIDs) == 0 {
+		return dbmodel.DBQuery{}, nil, fmt.Errorf("userIDs list cannot be empty")
+	}
+
+	args := make([]interface{}, len(userIDs)+1)
+	postgresPlaceholders := make([]string, len(userIDs))
+	sqlitePlaceholders := make([]string, len(userIDs))
+	for i, userID := range userIDs {
+		postgresPlaceholders[i] = fmt.Sprintf("$%d", i+1)
+		sqlitePlaceholders[i] = "?"
+		args[i] = userID
+	}
+	args[len(userIDs)] = deploymentID
+
+	baseQuery := `SELECT ENTITY_ID, NAME FROM "ENTITY_IDENTIFIER" WHERE ENTITY_ID IN (%s) AND DEPLOYMENT_ID = %s`
+	postgresQuery := fmt.Sprintf(baseQuery, strings.Join(postgresPlaceholders, ","),
+		fmt.Sprintf("$%d", len(userIDs)+1))
+	sqliteQuery := fmt.Sprintf(baseQuery, strings.Join(sqlitePlaceholders, ","), "?")
+
+	return dbmodel.DBQuery{
+		ID:            "RIXQ-DATA-05",
+		Query:         postgresQuery,
+		PostgresQuery: postgresQuery,
+		SQLiteQuery:   sqliteQuery,
+	}, args, nil
+}
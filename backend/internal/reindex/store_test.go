@@ -0,0 +1,211 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package reindex
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/tests/mocks/database/providermock"
+)
+
+const testDeploymentID = "test-deployment-id"
+
+type ReindexStoreTestSuite struct {
+	suite.Suite
+	mockDBProvider *providermock.DBProviderInterfaceMock
+	mockDBClient   *providermock.DBClientInterfaceMock
+	store          *reindexStore
+	ctx            context.Context
+}
+
+func TestReindexStoreTestSuite(t *testing.T) {
+	suite.Run(t, new(ReindexStoreTestSuite))
+}
+
+func (suite *ReindexStoreTestSuite) SetupTest() {
+	suite.mockDBProvider = providermock.NewDBProviderInterfaceMock(suite.T())
+	suite.mockDBClient = providermock.NewDBClientInterfaceMock(suite.T())
+	suite.store = &reindexStore{
+		dbProvider:   suite.mockDBProvider,
+		deploymentID: testDeploymentID,
+	}
+	suite.ctx = context.Background()
+}
+
+func (suite *ReindexStoreTestSuite) TestCreateJob_Success() {
+	now := time.Now().UTC()
+	job := ReindexJob{ID: "job-1", Status: JobStatusPending, IndexedAttributes: []string{"email"},
+		Progress: ReindexProgress{TotalUsers: 10}, CreatedAt: now, UpdatedAt: now}
+	expiry := now.Add(time.Hour)
+
+	suite.mockDBProvider.On("GetRuntimeDBClient").Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.On("ExecuteContext", suite.ctx, queryCreateReindexJob, "job-1", "PENDING", `["email"]`,
+		`{"totalUsers":10,"processedUsers":0,"skippedUsers":0,"addedIdentifiers":0,"removedIdentifiers":0}`,
+		now, now, expiry, testDeploymentID).Return(int64(1), nil).Once()
+
+	suite.NoError(suite.store.CreateJob(suite.ctx, job, expiry))
+}
+
+func (suite *ReindexStoreTestSuite) TestGetJob_Success() {
+	suite.mockDBProvider.On("GetRuntimeDBClient").Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.On("QueryContext", suite.ctx, queryGetReindexJob, "job-1", testDeploymentID).
+		Return([]map[string]interface{}{{
+			"id":                 "job-1",
+			"status":             "FAILED",
+			"indexed_attributes": `["email","username"]`,
+			"progress": []byte(`{"totalUsers":10,"processedUsers":4,"addedIdentifiers":3,` +
+				`"lastUserId":"user-4"}`),
+			"failure_reason": "failed to list users: db error",
+			"created_at":     "2026-01-02 03:04:05.123456",
+			"updated_at":     time.Date(2026, 1, 2, 3, 5, 0, 0, time.UTC),
+		}}, nil).Once()
+
+	job, err := suite.store.GetJob(suite.ctx, "job-1")
+
+	suite.NoError(err)
+	suite.Equal(JobStatusFailed, job.Status)
+	suite.Equal([]string{"email", "username"}, job.IndexedAttributes)
+	suite.Equal(ReindexProgress{TotalUsers: 10, ProcessedUsers: 4, AddedIdentifiers: 3, LastUserID: "user-4"},
+		job.Progress)
+	suite.Equal("failed to list users: db error", job.FailureReason)
+	suite.Equal(5, job.UpdatedAt.Minute())
+}
+
+func (suite *ReindexStoreTestSuite) TestGetActiveJob_NotFound() {
+	suite.mockDBProvider.On("GetRuntimeDBClient").Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.On("QueryContext", suite.ctx, queryGetActiveReindexJob, "PENDING", "RUNNING",
+		testDeploymentID).Return([]map[string]interface{}{}, nil).Once()
+
+	job, err := suite.store.GetActiveJob(suite.ctx)
+
+	suite.Nil(job)
+	suite.ErrorIs(err, ErrReindexJobNotFound)
+}
+
+func (suite *ReindexStoreTestSuite) TestUpdateStatus() {
+	suite.mockDBProvider.On("GetRuntimeDBClient").Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.On("ExecuteContext", suite.ctx, queryUpdateReindexJobStatus, "RUNNING", "",
+		mock.Anything, "job-1", "PENDING", testDeploymentID).Return(int64(0), nil).Once()
+
+	applied, err := suite.store.UpdateStatus(suite.ctx, "job-1", JobStatusPending, JobStatusRunning, "")
+
+	suite.NoError(err)
+	suite.False(applied)
+}
+
+func (suite *ReindexStoreTestSuite) TestCountUsers() {
+	suite.mockDBProvider.On("GetUserDBClient").Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.On("QueryContext", suite.ctx, queryCountUsers, "user", testDeploymentID).
+		Return([]map[string]interface{}{{"total": int64(42)}}, nil).Once()
+
+	count, err := suite.store.CountUsers(suite.ctx)
+
+	suite.NoError(err)
+	suite.Equal(42, count)
+}
+
+func (suite *ReindexStoreTestSuite) TestListUsers() {
+	suite.mockDBProvider.On("GetUserDBClient").Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.On("QueryContext", suite.ctx, queryListUsers, "user", testDeploymentID, "user-1", 50).
+		Return([]map[string]interface{}{
+			{"id": "user-2", "attributes": []byte(`{"email":"a@example.com"}`), "system_attributes": nil},
+			{"id": "user-3", "attributes": `{}`, "system_attributes": `{"sub":"abc"}`},
+		}, nil).Once()
+
+	users, err := suite.store.ListUsers(suite.ctx, "user-1", 50)
+
+	suite.NoError(err)
+	suite.Equal([]indexedUser{
+		{ID: "user-2", Attributes: `{"email":"a@example.com"}`},
+		{ID: "user-3", Attributes: `{}`, SystemAttributes: `{"sub":"abc"}`},
+	}, users)
+}
+
+func (suite *ReindexStoreTestSuite) TestListIdentifierNames() {
+	suite.mockDBProvider.On("GetUserDBClient").Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.On("QueryContext", suite.ctx, mock.AnythingOfType("model.DBQuery"),
+		"user-1", "user-2", testDeploymentID).Return([]map[string]interface{}{
+		{"entity_id": "user-1", "name": "email"},
+		{"entity_id": "user-1", "name": "username"},
+		{"entity_id": "user-2", "name": "email"},
+	}, nil).Once()
+
+	names, err := suite.store.ListIdentifierNames(suite.ctx, []string{"user-1", "user-2"})
+
+	suite.NoError(err)
+	suite.Equal(map[string][]string{"user-1": {"email", "username"}, "user-2": {"email"}}, names)
+}
+
+func (suite *ReindexStoreTestSuite) TestListIdentifierNames_NoUsers() {
+	names, err := suite.store.ListIdentifierNames(suite.ctx, nil)
+
+	suite.NoError(err)
+	suite.Empty(names)
+}
+
+func (suite *ReindexStoreTestSuite) TestAddIdentifier() {
+	suite.mockDBProvider.On("GetUserDBClient").Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.On("ExecuteContext", suite.ctx, queryInsertIdentifier, "user-1", "email", "a@example.com",
+		"attribute", mock.Anything, testDeploymentID).Return(int64(0), nil).Once()
+
+	added, err := suite.store.AddIdentifier(suite.ctx, "user-1", "email",
+		identifier{value: "a@example.com", source: identifierSourceAttribute})
+
+	suite.NoError(err)
+	suite.False(added)
+}
+
+func (suite *ReindexStoreTestSuite) TestDeleteIdentifier() {
+	suite.mockDBProvider.On("GetUserDBClient").Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.On("ExecuteContext", suite.ctx, queryDeleteIdentifier, "user-1", testDeploymentID,
+		"mobileNumber").Return(int64(1), nil).Once()
+
+	removed, err := suite.store.DeleteIdentifier(suite.ctx, "user-1", "mobileNumber")
+
+	suite.NoError(err)
+	suite.True(removed)
+}
+
+func (suite *ReindexStoreTestSuite) TestDeleteIdentifier_DBClientError() {
+	suite.mockDBProvider.On("GetUserDBClient").Return(nil, errors.New("db error")).Once()
+
+	_, err := suite.store.DeleteIdentifier(suite.ctx, "user-1", "mobileNumber")
+
+	suite.ErrorContains(err, "failed to get database client")
+}
+
+func (suite *ReindexStoreTestSuite) TestBuildListIdentifierNamesQuery() {
+	query, args, err := buildListIdentifierNamesQuery([]string{"user-1", "user-2"}, testDeploymentID)
+
+	suite.NoError(err)
+	suite.Equal(`SELECT ENTITY_ID, NAME FROM "ENTITY_IDENTIFIER" WHERE ENTITY_ID IN ($1,$2) AND DEPLOYMENT_ID = $3`,
+		query.PostgresQuery)
+	suite.Equal(`SELECT ENTITY_ID, NAME FROM "ENTITY_IDENTIFIER" WHERE ENTITY_ID IN (?,?) AND DEPLOYMENT_ID = ?`,
+		query.SQLiteQuery)
+	suite.Equal([]interface{}{"user-1", "user-2", testDeploymentID}, args)
+
+	_, _, err = buildListIdentifierNamesQuery(nil, testDeploymentID)
+	suite.Error(err)
+}
@@ -0,0 +1,47 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package relationship
+
+const (
+	// loggerComponentName is the component name used in relationship logs.
+	loggerComponentName = "RelationshipService"
+
+	// wildcardSubjectID is the subject ID granting a relation to every subject of the subject type.
+	wildcardSubjectID = "*"
+
+	// defaultMaxCheckDepth is the default number of userset and implied relation hops followed by a check.
+	defaultMaxCheckDepth = 8
+	// defaultMaxWriteSize is the default number of tuples written and deleted in a single request.
+	defaultMaxWriteSize = 100
+
+	// maxTypeLength is the maximum length of object types, subject types and relations.
+	maxTypeLength = 100
+	// maxIDLength is the maximum length of object and subject IDs.
+	maxIDLength = 255
+
+	// relationshipsPath is the path of the relationships endpoint, used to build pagination links.
+	relationshipsPath = "/authz/relationships"
+
+	// Query parameters filtering the relationship tuples returned by a read.
+	queryParamObjectType  = "objectType"
+	queryParamObjectID    = "objectId"
+	queryParamRelation    = "relation"
+	queryParamSubjectType = "subjectType"
+	queryParamSubjectID   = "subjectId"
+)
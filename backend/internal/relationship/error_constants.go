@@ -0,0 +1,107 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package relationship
+
+import (
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/i18n/core"
+)
+
+// Client errors for relationship operations.
+var (
+	// ErrorInvalidRequestFormat is the error returned when the request body is malformed.
+	ErrorInvalidRequestFormat = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "REL-1001",
+		Error: core.I18nMessage{
+			Key:          "error.relationshipservice.invalid_request_format",
+			DefaultValue: "Invalid request format",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.relationshipservice.invalid_request_format_description",
+			DefaultValue: "The request body is malformed or contains invalid data",
+		},
+	}
+	// ErrorInvalidRelationship is the error returned when a relationship tuple or check is incomplete or malformed.
+	ErrorInvalidRelationship = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "REL-1002",
+		Error: core.I18nMessage{
+			Key:          "error.relationshipservice.invalid_relationship",
+			DefaultValue: "Invalid relationship",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key: "error.relationshipservice.invalid_relationship_description",
+			DefaultValue: "Object type, object ID, relation, subject type and subject ID are required, and " +
+				"types and relations may contain only lowercase letters, digits, underscores and hyphens",
+		},
+	}
+	// ErrorTooManyRelationships is the error returned when a write request exceeds the maximum number of tuples.
+	ErrorTooManyRelationships = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "REL-1003",
+		Error: core.I18nMessage{
+			Key:          "error.relationshipservice.too_many_relationships",
+			DefaultValue: "Too many relationships",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.relationshipservice.too_many_relationships_description",
+			DefaultValue: "The request writes or deletes more relationship tuples than allowed in a single request",
+		},
+	}
+	// ErrorEmptyWriteRequest is the error returned when a write request has no tuples to write or delete.
+	ErrorEmptyWriteRequest = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "REL-1004",
+		Error: core.I18nMessage{
+			Key:          "error.relationshipservice.empty_write_request",
+			DefaultValue: "Empty write request",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.relationshipservice.empty_write_request_description",
+			DefaultValue: "At least one relationship tuple must be written or deleted",
+		},
+	}
+	// ErrorInvalidLimit is the error returned when the limit parameter is invalid.
+	ErrorInvalidLimit = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "REL-1005",
+		Error: core.I18nMessage{
+			Key:          "error.relationshipservice.invalid_limit_parameter",
+			DefaultValue: "Invalid limit parameter",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.relationshipservice.invalid_limit_parameter_description",
+			DefaultValue: "The limit parameter must be a positive integer",
+		},
+	}
+	// ErrorInvalidOffset is the error returned when the offset parameter is invalid.
+	ErrorInvalidOffset = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "REL-1006",
+		Error: core.I18nMessage{
+			Key:          "error.relationshipservice.invalid_offset_parameter",
+			DefaultValue: "Invalid offset parameter",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.relationshipservice.invalid_offset_parameter_description",
+			DefaultValue: "The offset parameter must be a non-negative integer",
+		},
+	}
+)
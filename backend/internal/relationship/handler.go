@@ -0,0 +1,104 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package relationship
+
+import (
+	"net/http"
+
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	sysutils "github.com/thunder-id/thunderid/internal/system/utils"
+)
+
+// relationshipHandler is the handler for relationship operations.
+type relationshipHandler struct {
+	relationshipService RelationshipServiceInterface
+}
+
+// newRelationshipHandler creates a new instance of relationshipHandler.
+func newRelationshipHandler(relationshipService RelationshipServiceInterface) *relationshipHandler {
+	return &relationshipHandler{
+		relationshipService: relationshipService,
+	}
+}
+
+// HandleReadRequest handles the request to read the relationship tuples matching the filter given in
+// the query parameters.
+func (h *relationshipHandler) HandleReadRequest(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	pagination, svcErr := sysutils.ParsePaginationParams(query, &ErrorInvalidLimit, &ErrorInvalidOffset)
+	if svcErr != nil {
+		sysutils.WriteServiceErrorResponse(w, svcErr, clientErrorStatusCodes)
+		return
+	}
+	filter := RelationshipFilter{
+		ObjectType:  sysutils.SanitizeString(query.Get(queryParamObjectType)),
+		ObjectID:    sysutils.SanitizeString(query.Get(queryParamObjectID)),
+		Relation:    sysutils.SanitizeString(query.Get(queryParamRelation)),
+		SubjectType: sysutils.SanitizeString(query.Get(queryParamSubjectType)),
+		SubjectID:   sysutils.SanitizeString(query.Get(queryParamSubjectID)),
+	}
+
+	relationships, svcErr := h.relationshipService.ListRelationships(
+		sysutils.WithSkipCount(r.Context(), pagination.SkipCount), filter, pagination.Limit, pagination.Offset)
+	if svcErr != nil {
+		sysutils.WriteServiceErrorResponse(w, svcErr, clientErrorStatusCodes)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(w, http.StatusOK, relationships)
+}
+
+// HandleWriteRequest handles the request to write and delete relationship tuples.
+func (h *relationshipHandler) HandleWriteRequest(w http.ResponseWriter, r *http.Request) {
+	request, err := sysutils.DecodeJSONBody[RelationshipWriteRequest](r)
+	if err != nil {
+		sysutils.WriteServiceErrorResponse(w, &ErrorInvalidRequestFormat, clientErrorStatusCodes)
+		return
+	}
+
+	if svcErr := h.relationshipService.WriteRelationships(r.Context(), request); svcErr != nil {
+		sysutils.WriteServiceErrorResponse(w, svcErr, clientErrorStatusCodes)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// HandleCheckRequest handles the request to check whether a subject holds a relation on an object.
+func (h *relationshipHandler) HandleCheckRequest(w http.ResponseWriter, r *http.Request) {
+	request, err := sysutils.DecodeJSONBody[CheckRequest](r)
+	if err != nil {
+		sysutils.WriteServiceErrorResponse(w, &ErrorInvalidRequestFormat, clientErrorStatusCodes)
+		return
+	}
+
+	response, svcErr := h.relationshipService.Check(r.Context(), request)
+	if svcErr != nil {
+		sysutils.WriteServiceErrorResponse(w, svcErr, clientErrorStatusCodes)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(w, http.StatusOK, response)
+}
+
+// clientErrorStatusCodes maps the client errors of the handler to HTTP status codes. Other client errors are
+// returned with 400 Bad Request.
+var clientErrorStatusCodes = map[string]int{
+	serviceerror.ErrorUnauthorized.Code: http.StatusForbidden,
+}
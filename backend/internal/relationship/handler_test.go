@@ -0,0 +1,153 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package relationship
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/system/error/apierror"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+)
+
+type HandlerTestSuite struct {
+	suite.Suite
+	mockService *RelationshipServiceInterfaceMock
+	handler     *relationshipHandler
+}
+
+func TestHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(HandlerTestSuite))
+}
+
+func (s *HandlerTestSuite) SetupTest() {
+	s.mockService = NewRelationshipServiceInterfaceMock(s.T())
+	s.handler = newRelationshipHandler(s.mockService)
+}
+
+func (s *HandlerTestSuite) TestHandleReadRequest() {
+	filter := RelationshipFilter{ObjectType: "group", ObjectID: "group-1", Relation: "editor"}
+	s.mockService.On("ListRelationships", mock.Anything, filter, 5, 10).
+		Return(&RelationshipListResponse{TotalResults: 1, Count: 1,
+			Relationships: []Relationship{userTuple("group-1", "editor", "user-1")}}, nil)
+
+	req := httptest.NewRequest(http.MethodGet,
+		"/authz/relationships?objectType=group&objectId=group-1&relation=editor&limit=5&offset=10", nil)
+	rr := httptest.NewRecorder()
+	s.handler.HandleReadRequest(rr, req)
+
+	s.Equal(http.StatusOK, rr.Code)
+	var body RelationshipListResponse
+	s.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &body))
+	s.Equal(1, body.TotalResults)
+	s.Equal("user-1", body.Relationships[0].SubjectID)
+}
+
+func (s *HandlerTestSuite) TestHandleReadRequest_InvalidLimit() {
+	req := httptest.NewRequest(http.MethodGet, "/authz/relationships?limit=abc", nil)
+	rr := httptest.NewRecorder()
+	s.handler.HandleReadRequest(rr, req)
+
+	s.Equal(http.StatusBadRequest, rr.Code)
+}
+
+func (s *HandlerTestSuite) TestHandleWriteRequest() {
+	write := userTuple("group-1", "editor", "user-1")
+	s.mockService.On("WriteRelationships", mock.Anything, &RelationshipWriteRequest{Writes: []Relationship{write}}).
+		Return(nil)
+
+	req := httptest.NewRequest(http.MethodPost, "/authz/relationships", strings.NewReader(
+		`{"writes":[{"objectType":"group","objectId":"group-1","relation":"editor","subjectType":"user",`+
+			`"subjectId":"user-1"}]}`))
+	rr := httptest.NewRecorder()
+	s.handler.HandleWriteRequest(rr, req)
+
+	s.Equal(http.StatusNoContent, rr.Code)
+}
+
+func (s *HandlerTestSuite) TestHandleWriteRequest_Errors() {
+	testCases := []struct {
+		name           string
+		body           string
+		svcErr         *serviceerror.ServiceError
+		expectedStatus int
+		expectedCode   string
+	}{
+		{"MalformedBody", `{"writes":`, nil, http.StatusBadRequest, ErrorInvalidRequestFormat.Code},
+		{"InvalidRelationship", `{"writes":[{}]}`, &ErrorInvalidRelationship, http.StatusBadRequest,
+			ErrorInvalidRelationship.Code},
+		{"NotAllowed", `{"writes":[{}]}`, &serviceerror.ErrorUnauthorized, http.StatusForbidden,
+			serviceerror.ErrorUnauthorized.Code},
+		{"ServerError", `{"writes":[{}]}`, &serviceerror.InternalServerError, http.StatusInternalServerError,
+			serviceerror.InternalServerError.Code},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			if tc.svcErr != nil {
+				s.mockService.On("WriteRelationships", mock.Anything, mock.Anything).Return(tc.svcErr)
+			}
+
+			req := httptest.NewRequest(http.MethodPost, "/authz/relationships", strings.NewReader(tc.body))
+			rr := httptest.NewRecorder()
+			s.handler.HandleWriteRequest(rr, req)
+
+			s.Equal(tc.expectedStatus, rr.Code)
+			var errResp apierror.ErrorResponse
+			s.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &errResp))
+			s.Equal(tc.expectedCode, errResp.Code)
+		})
+	}
+}
+
+func (s *HandlerTestSuite) TestHandleCheckRequest() {
+	request := &CheckRequest{ObjectType: "group", ObjectID: "group-1", Relation: "viewer", SubjectType: "user",
+		SubjectID: "user-1"}
+	s.mockService.On("Check", mock.Anything, request).Return(&CheckResponse{Allowed: true}, nil)
+
+	req := httptest.NewRequest(http.MethodPost, "/authz/relationships/check", strings.NewReader(
+		`{"objectType":"group","objectId":"group-1","relation":"viewer","subjectType":"user","subjectId":"user-1"}`))
+	rr := httptest.NewRecorder()
+	s.handler.HandleCheckRequest(rr, req)
+
+	s.Equal(http.StatusOK, rr.Code)
+	var body CheckResponse
+	s.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &body))
+	s.True(body.Allowed)
+}
+
+func (s *HandlerTestSuite) TestHandleCheckRequest_Errors() {
+	req := httptest.NewRequest(http.MethodPost, "/authz/relationships/check", strings.NewReader(`not-json`))
+	rr := httptest.NewRecorder()
+	s.handler.HandleCheckRequest(rr, req)
+	s.Equal(http.StatusBadRequest, rr.Code)
+
+	s.mockService.On("Check", mock.Anything, mock.Anything).Return(nil, &ErrorInvalidRelationship)
+	req = httptest.NewRequest(http.MethodPost, "/authz/relationships/check", strings.NewReader(`{}`))
+	rr = httptest.NewRecorder()
+	s.handler.HandleCheckRequest(rr, req)
+	s.Equal(http.StatusBadRequest, rr.Code)
+}
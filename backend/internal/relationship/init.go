@@ -0,0 +1,87 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package relationship
+
+import (
+	"net/http"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/middleware"
+	"github.com/thunder-id/thunderid/internal/system/observability"
+	"github.com/thunder-id/thunderid/internal/system/sysauthz"
+)
+
+// Initialize initializes the relationship service, registers its routes and lets system authorization
+// delegate relationship checks to it. The relationship service is not initialized when the feature is
+// disabled.
+func Initialize(
+	mux *http.ServeMux,
+	authzService sysauthz.SystemAuthorizationServiceInterface,
+	observabilitySvc observability.ObservabilityServiceInterface,
+) (RelationshipServiceInterface, error) {
+	relationshipConfig := config.GetServerRuntime().Config.Relationships
+	if !relationshipConfig.Enabled {
+		return nil, nil
+	}
+
+	store, transactioner, err := newRelationshipStore()
+	if err != nil {
+		return nil, err
+	}
+
+	relationshipService := newRelationshipService(store, transactioner, authzService, observabilitySvc,
+		relationshipConfig.MaxCheckDepth, relationshipConfig.MaxWriteSize, relationshipConfig.ImpliedRelations)
+	authzService.SetRelationshipProvider(relationshipService)
+
+	relationshipHandler := newRelationshipHandler(relationshipService)
+	registerRoutes(mux, relationshipHandler)
+
+	return relationshipService, nil
+}
+
+// registerRoutes registers the routes for relationship operations.
+func registerRoutes(mux *http.ServeMux, relationshipHandler *relationshipHandler) {
+	opts := middleware.CORSOptions{
+		AllowedMethods:   []string{"GET", "POST"},
+		AllowedHeaders:   middleware.DefaultAllowedHeaders,
+		AllowCredentials: true,
+		MaxAge:           600,
+	}
+	mux.HandleFunc(middleware.WithCORS("GET /authz/relationships",
+		relationshipHandler.HandleReadRequest, opts))
+	mux.HandleFunc(middleware.WithCORS("POST /authz/relationships",
+		relationshipHandler.HandleWriteRequest, opts))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS /authz/relationships",
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, opts))
+
+	checkOpts := middleware.CORSOptions{
+		AllowedMethods:   []string{"POST"},
+		AllowedHeaders:   middleware.DefaultAllowedHeaders,
+		AllowCredentials: true,
+		MaxAge:           600,
+	}
+	mux.HandleFunc(middleware.WithCORS("POST /authz/relationships/check",
+		relationshipHandler.HandleCheckRequest, checkOpts))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS /authz/relationships/check",
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, checkOpts))
+}
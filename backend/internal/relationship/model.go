@@ -0,0 +1,75 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package relationship provides a relationship tuple store and check API modeled on Zanzibar. A
+// relationship tuple grants a subject, or the set of subjects holding a relation on another object,
+// a relation on an object. System authorization can delegate actions on selected resource types to
+// relationship checks.
+package relationship
+
+import "github.com/thunder-id/thunderid/internal/system/utils"
+
+// Relationship represents a relationship tuple granting a subject a relation on an object. When
+// SubjectRelation is set, the subject is the userset of every subject holding that relation on the
+// subject object. A SubjectID of "*" grants the relation to every subject of the subject type.
+type Relationship struct {
+	ObjectType      string `json:"objectType"`
+	ObjectID        string `json:"objectId"`
+	Relation        string `json:"relation"`
+	SubjectType     string `json:"subjectType"`
+	SubjectID       string `json:"subjectId"`
+	SubjectRelation string `json:"subjectRelation,omitempty"`
+}
+
+// RelationshipWriteRequest represents a request to write and delete relationship tuples atomically.
+type RelationshipWriteRequest struct {
+	Writes  []Relationship `json:"writes"`
+	Deletes []Relationship `json:"deletes"`
+}
+
+// RelationshipFilter restricts the relationship tuples returned by a read. Empty fields match any value.
+type RelationshipFilter struct {
+	ObjectType  string
+	ObjectID    string
+	Relation    string
+	SubjectType string
+	SubjectID   string
+}
+
+// RelationshipListResponse represents the response for reading relationship tuples with pagination.
+type RelationshipListResponse struct {
+	TotalResults  int            `json:"totalResults"`
+	StartIndex    int            `json:"startIndex"`
+	Count         int            `json:"count"`
+	Relationships []Relationship `json:"relationships"`
+	Links         []utils.Link   `json:"links"`
+}
+
+// CheckRequest represents a request to check whether a subject holds a relation on an object.
+type CheckRequest struct {
+	ObjectType  string `json:"objectType"`
+	ObjectID    string `json:"objectId"`
+	Relation    string `json:"relation"`
+	SubjectType string `json:"subjectType"`
+	SubjectID   string `json:"subjectId"`
+}
+
+// CheckResponse represents the outcome of a relationship check.
+type CheckResponse struct {
+	Allowed bool `json:"allowed"`
+}
@@ -0,0 +1,341 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package relationship
+
+import (
+	"context"
+	"net/url"
+	"regexp"
+
+	sysContext "github.com/thunder-id/thunderid/internal/system/context"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/internal/system/observability"
+	"github.com/thunder-id/thunderid/internal/system/observability/event"
+	"github.com/thunder-id/thunderid/internal/system/security"
+	"github.com/thunder-id/thunderid/internal/system/sysauthz"
+	"github.com/thunder-id/thunderid/internal/system/transaction"
+	"github.com/thunder-id/thunderid/internal/system/utils"
+)
+
+// namePattern matches the object types, subject types and relations accepted in relationship tuples.
+var namePattern = regexp.MustCompile(`^[a-z0-9_-]+$`)
+
+// RelationshipServiceInterface defines the interface for the relationship service. It also implements
+// sysauthz.RelationshipProvider so that system authorization can delegate checks to it.
+type RelationshipServiceInterface interface {
+	WriteRelationships(ctx context.Context, request *RelationshipWriteRequest) *serviceerror.ServiceError
+	ListRelationships(ctx context.Context, filter RelationshipFilter,
+		limit, offset int) (*RelationshipListResponse, *serviceerror.ServiceError)
+	Check(ctx context.Context, request *CheckRequest) (*CheckResponse, *serviceerror.ServiceError)
+	CheckRelationship(ctx context.Context, objectType, objectID, relation, subjectType,
+		subjectID string) (bool, *serviceerror.ServiceError)
+	DeleteRelationships(ctx context.Context, entityType, entityID string) *serviceerror.ServiceError
+}
+
+// relationshipService is the default implementation of the RelationshipServiceInterface.
+type relationshipService struct {
+	store            relationshipStoreInterface
+	transactioner    transaction.Transactioner
+	authzService     sysauthz.SystemAuthorizationServiceInterface
+	observabilitySvc observability.ObservabilityServiceInterface
+	maxCheckDepth    int
+	maxWriteSize     int
+	impliedRelations map[string]map[string][]string
+	logger           *log.Logger
+}
+
+// newRelationshipService creates a new instance of relationshipService.
+func newRelationshipService(
+	store relationshipStoreInterface,
+	transactioner transaction.Transactioner,
+	authzService sysauthz.SystemAuthorizationServiceInterface,
+	observabilitySvc observability.ObservabilityServiceInterface,
+	maxCheckDepth int,
+	maxWriteSize int,
+	impliedRelations map[string]map[string][]string,
+) RelationshipServiceInterface {
+	if maxCheckDepth <= 0 {
+		maxCheckDepth = defaultMaxCheckDepth
+	}
+	if maxWriteSize <= 0 {
+		maxWriteSize = defaultMaxWriteSize
+	}
+
+	return &relationshipService{
+		store:            store,
+		transactioner:    transactioner,
+		authzService:     authzService,
+		observabilitySvc: observabilitySvc,
+		maxCheckDepth:    maxCheckDepth,
+		maxWriteSize:     maxWriteSize,
+		impliedRelations: impliedRelations,
+		logger:           log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName)),
+	}
+}
+
+// WriteRelationships writes and deletes the tuples of the request in a single transaction. Writing an
+// existing tuple and deleting a missing tuple are no-ops.
+func (s *relationshipService) WriteRelationships(ctx context.Context,
+	request *RelationshipWriteRequest) *serviceerror.ServiceError {
+	if svcErr := s.authorize(ctx, security.ActionWriteRelationships); svcErr != nil {
+		return svcErr
+	}
+	if request == nil || len(request.Writes)+len(request.Deletes) == 0 {
+		return &ErrorEmptyWriteRequest
+	}
+	if len(request.Writes)+len(request.Deletes) > s.maxWriteSize {
+		return &ErrorTooManyRelationships
+	}
+	for _, relationship := range append(append([]Relationship{}, request.Writes...), request.Deletes...) {
+		if !isValidRelationship(relationship) {
+			return &ErrorInvalidRelationship
+		}
+	}
+
+	err := s.transactioner.Transact(ctx, func(txCtx context.Context) error {
+		for _, relationship := range request.Deletes {
+			if err := s.store.DeleteRelationship(txCtx, relationship); err != nil {
+				return err
+			}
+		}
+		for _, relationship := range request.Writes {
+			if err := s.store.CreateRelationship(txCtx, relationship); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		s.logger.Error("Failed to write relationships", log.Error(err))
+		return &serviceerror.InternalServerError
+	}
+
+	s.publishWriteEvent(ctx, len(request.Writes), len(request.Deletes))
+	return nil
+}
+
+// ListRelationships returns a page of the tuples matching the filter.
+func (s *relationshipService) ListRelationships(ctx context.Context, filter RelationshipFilter,
+	limit, offset int) (*RelationshipListResponse, *serviceerror.ServiceError) {
+	if svcErr := s.authorize(ctx, security.ActionReadRelationships); svcErr != nil {
+		return nil, svcErr
+	}
+
+	totalCount, fetchLimit := utils.TotalCountUnknown, limit+1
+	if !utils.IsCountSkipped(ctx) {
+		var err error
+		totalCount, err = s.store.CountRelationships(ctx, filter)
+		if err != nil {
+			s.logger.Error("Failed to count relationships", log.Error(err))
+			return nil, &serviceerror.InternalServerError
+		}
+		fetchLimit = limit
+	}
+
+	relationships, err := s.store.ListRelationships(ctx, filter, fetchLimit, offset)
+	if err != nil {
+		s.logger.Error("Failed to list relationships", log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+	relationships, hasMore := utils.TrimPage(relationships, limit)
+
+	return &RelationshipListResponse{
+		TotalResults:  totalCount,
+		StartIndex:    offset + 1,
+		Count:         len(relationships),
+		Relationships: relationships,
+		Links: utils.BuildListPaginationLinks(relationshipsPath, limit, offset, totalCount, hasMore,
+			buildFilterQuery(filter)),
+	}, nil
+}
+
+// Check reports whether the subject of the request holds the relation on the object.
+func (s *relationshipService) Check(ctx context.Context,
+	request *CheckRequest) (*CheckResponse, *serviceerror.ServiceError) {
+	if svcErr := s.authorize(ctx, security.ActionReadRelationships); svcErr != nil {
+		return nil, svcErr
+	}
+	if request == nil || !isValidRelationship(Relationship{
+		ObjectType:  request.ObjectType,
+		ObjectID:    request.ObjectID,
+		Relation:    request.Relation,
+		SubjectType: request.SubjectType,
+		SubjectID:   request.SubjectID,
+	}) || request.SubjectID == wildcardSubjectID {
+		return nil, &ErrorInvalidRelationship
+	}
+
+	allowed, svcErr := s.CheckRelationship(ctx, request.ObjectType, request.ObjectID, request.Relation,
+		request.SubjectType, request.SubjectID)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	return &CheckResponse{Allowed: allowed}, nil
+}
+
+// CheckRelationship reports whether the subject holds the relation on the object. The relation is held
+// through a tuple naming the subject or the wildcard subject, through a userset tuple whose subjects the
+// subject belongs to, or through a relation that implies it. Userset and implied relation hops are
+// bounded by the maximum check depth. The check is not authorized, as it backs system authorization.
+func (s *relationshipService) CheckRelationship(ctx context.Context, objectType, objectID, relation,
+	subjectType, subjectID string) (bool, *serviceerror.ServiceError) {
+	allowed, err := s.check(ctx, objectType, objectID, relation, subjectType, subjectID, 0, map[string]bool{})
+	if err != nil {
+		s.logger.Error("Failed to check relationship", log.Error(err),
+			log.String("objectType", objectType), log.String("relation", relation))
+		return false, &serviceerror.InternalServerError
+	}
+	return allowed, nil
+}
+
+// check evaluates a relation on an object for the subject. visited holds the object relations already
+// evaluated, so that cyclic usersets terminate.
+func (s *relationshipService) check(ctx context.Context, objectType, objectID, relation, subjectType,
+	subjectID string, depth int, visited map[string]bool) (bool, error) {
+	key := objectType + ":" + objectID + "#" + relation
+	if depth > s.maxCheckDepth || visited[key] {
+		return false, nil
+	}
+	visited[key] = true
+
+	relationships, err := s.store.GetRelationshipsByObjectRelation(ctx, objectType, objectID, relation)
+	if err != nil {
+		return false, err
+	}
+
+	for _, relationship := range relationships {
+		if relationship.SubjectRelation == "" && relationship.SubjectType == subjectType &&
+			(relationship.SubjectID == subjectID || relationship.SubjectID == wildcardSubjectID) {
+			return true, nil
+		}
+	}
+
+	for _, relationship := range relationships {
+		if relationship.SubjectRelation == "" {
+			continue
+		}
+		allowed, err := s.check(ctx, relationship.SubjectType, relationship.SubjectID,
+			relationship.SubjectRelation, subjectType, subjectID, depth+1, visited)
+		if err != nil || allowed {
+			return allowed, err
+		}
+	}
+
+	for _, implying := range s.impliedRelations[objectType][relation] {
+		allowed, err := s.check(ctx, objectType, objectID, implying, subjectType, subjectID, depth+1, visited)
+		if err != nil || allowed {
+			return allowed, err
+		}
+	}
+
+	return false, nil
+}
+
+// DeleteRelationships removes every tuple in which the entity is the object or the subject. The deletion is
+// not authorized, as it cleans up after a resource deletion that was already authorized.
+func (s *relationshipService) DeleteRelationships(ctx context.Context,
+	entityType, entityID string) *serviceerror.ServiceError {
+	err := s.transactioner.Transact(ctx, func(txCtx context.Context) error {
+		if err := s.store.DeleteRelationshipsByObject(txCtx, entityType, entityID); err != nil {
+			return err
+		}
+		return s.store.DeleteRelationshipsBySubject(txCtx, entityType, entityID)
+	})
+	if err != nil {
+		s.logger.Error("Failed to delete relationships", log.Error(err), log.String("entityType", entityType))
+		return &serviceerror.InternalServerError
+	}
+	return nil
+}
+
+// authorize checks that the caller may perform the relationship action.
+func (s *relationshipService) authorize(ctx context.Context, action security.Action) *serviceerror.ServiceError {
+	allowed, svcErr := s.authzService.IsActionAllowed(ctx, action, nil)
+	if svcErr != nil {
+		s.logger.Error("Failed to check authorization for action",
+			log.String("action", string(action)), log.Any("error", svcErr))
+		return &serviceerror.InternalServerError
+	}
+	if !allowed {
+		return &serviceerror.ErrorUnauthorized
+	}
+	return nil
+}
+
+// publishWriteEvent records a relationship write in the audit trail.
+func (s *relationshipService) publishWriteEvent(ctx context.Context, writeCount, deleteCount int) {
+	if s.observabilitySvc == nil || !s.observabilitySvc.IsEnabled() {
+		return
+	}
+
+	evt := event.NewEvent(sysContext.GetTraceID(ctx), string(event.EventTypeRelationshipsWritten),
+		event.ComponentRelationship).
+		WithTenantID(sysContext.GetTenantID(ctx)).
+		WithStatus(event.StatusSuccess).
+		WithData(event.DataKey.WriteCount, writeCount).
+		WithData(event.DataKey.DeleteCount, deleteCount)
+	if actorID := security.GetSubject(ctx); actorID != "" {
+		evt.WithData(event.DataKey.ActorID, actorID)
+	}
+	s.observabilitySvc.PublishEvent(evt)
+}
+
+// isValidRelationship reports whether the tuple names an object, a relation and a subject with
+// well-formed types and relations. The wildcard subject cannot be combined with a subject relation, and
+// an object cannot be the wildcard.
+func isValidRelationship(relationship Relationship) bool {
+	for _, name := range []string{relationship.ObjectType, relationship.Relation, relationship.SubjectType} {
+		if len(name) > maxTypeLength || !namePattern.MatchString(name) {
+			return false
+		}
+	}
+	if relationship.SubjectRelation != "" && (len(relationship.SubjectRelation) > maxTypeLength ||
+		!namePattern.MatchString(relationship.SubjectRelation) || relationship.SubjectID == wildcardSubjectID) {
+		return false
+	}
+	for _, id := range []string{relationship.ObjectID, relationship.SubjectID} {
+		if id == "" || len(id) > maxIDLength {
+			return false
+		}
+	}
+	return relationship.ObjectID != wildcardSubjectID
+}
+
+// buildFilterQuery returns the query string of the filter, appended to the pagination links.
+func buildFilterQuery(filter RelationshipFilter) string {
+	params := []struct {
+		name  string
+		value string
+	}{
+		{queryParamObjectType, filter.ObjectType},
+		{queryParamObjectID, filter.ObjectID},
+		{queryParamRelation, filter.Relation},
+		{queryParamSubjectType, filter.SubjectType},
+		{queryParamSubjectID, filter.SubjectID},
+	}
+
+	query := ""
+	for _, param := range params {
+		if param.value != "" {
+			query += "&" + param.name + "=" + url.QueryEscape(param.value)
+		}
+	}
+	return query
+}
@@ -0,0 +1,307 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package relationship
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/observability/event"
+	"github.com/thunder-id/thunderid/internal/system/security"
+	"github.com/thunder-id/thunderid/internal/system/sysauthz"
+	"github.com/thunder-id/thunderid/internal/system/transaction"
+	"github.com/thunder-id/thunderid/internal/system/utils"
+	"github.com/thunder-id/thunderid/tests/mocks/observability/observabilitymock"
+	"github.com/thunder-id/thunderid/tests/mocks/sysauthzmock"
+)
+
+type RelationshipServiceTestSuite struct {
+	suite.Suite
+	mockStore         *relationshipStoreInterfaceMock
+	mockAuthz         *sysauthzmock.SystemAuthorizationServiceInterfaceMock
+	mockObservability *observabilitymock.ObservabilityServiceInterfaceMock
+	service           *relationshipService
+	events            []*event.Event
+}
+
+func TestRelationshipServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(RelationshipServiceTestSuite))
+}
+
+func (suite *RelationshipServiceTestSuite) SetupTest() {
+	suite.mockStore = newRelationshipStoreInterfaceMock(suite.T())
+	suite.mockAuthz = sysauthzmock.NewSystemAuthorizationServiceInterfaceMock(suite.T())
+	suite.mockObservability = observabilitymock.NewObservabilityServiceInterfaceMock(suite.T())
+	suite.service = newRelationshipService(suite.mockStore, transaction.NewNoOpTransactioner(), suite.mockAuthz,
+		suite.mockObservability, 3, 2, map[string]map[string][]string{
+			"group": {"viewer": {"editor"}, "editor": {"owner"}},
+		}).(*relationshipService)
+
+	suite.events = nil
+	suite.mockObservability.On("IsEnabled").Return(true).Maybe()
+	suite.mockObservability.On("PublishEvent", mock.Anything).Run(func(args mock.Arguments) {
+		suite.events = append(suite.events, args.Get(0).(*event.Event))
+	}).Maybe()
+}
+
+func (suite *RelationshipServiceTestSuite) allow(action security.Action, allowed bool) {
+	suite.mockAuthz.On("IsActionAllowed", mock.Anything, action, (*sysauthz.ActionContext)(nil)).
+		Return(allowed, nil).Once()
+}
+
+// expectTuples stubs the tuples granting the relation on the object.
+func (suite *RelationshipServiceTestSuite) expectTuples(objectType, objectID, relation string,
+	relationships ...Relationship) {
+	suite.mockStore.On("GetRelationshipsByObjectRelation", mock.Anything, objectType, objectID, relation).
+		Return(relationships, nil).Once()
+}
+
+func userTuple(objectID, relation, userID string) Relationship {
+	return Relationship{ObjectType: "group", ObjectID: objectID, Relation: relation, SubjectType: "user",
+		SubjectID: userID}
+}
+
+func (suite *RelationshipServiceTestSuite) TestNewRelationshipService_Defaults() {
+	svc := newRelationshipService(suite.mockStore, nil, suite.mockAuthz, nil, 0, 0, nil).(*relationshipService)
+	suite.Equal(defaultMaxCheckDepth, svc.maxCheckDepth)
+	suite.Equal(defaultMaxWriteSize, svc.maxWriteSize)
+}
+
+func (suite *RelationshipServiceTestSuite) TestWriteRelationships_Success() {
+	suite.allow(security.ActionWriteRelationships, true)
+	write := userTuple("group-1", "editor", "user-1")
+	remove := userTuple("group-1", "viewer", "user-1")
+	suite.mockStore.On("DeleteRelationship", mock.Anything, remove).Return(nil).Once()
+	suite.mockStore.On("CreateRelationship", mock.Anything, write).Return(nil).Once()
+
+	svcErr := suite.service.WriteRelationships(context.Background(),
+		&RelationshipWriteRequest{Writes: []Relationship{write}, Deletes: []Relationship{remove}})
+
+	suite.Nil(svcErr)
+	suite.Require().Len(suite.events, 1)
+	suite.Equal(string(event.EventTypeRelationshipsWritten), suite.events[0].Type)
+}
+
+func (suite *RelationshipServiceTestSuite) TestWriteRelationships_Unauthorized() {
+	suite.allow(security.ActionWriteRelationships, false)
+
+	svcErr := suite.service.WriteRelationships(context.Background(),
+		&RelationshipWriteRequest{Writes: []Relationship{userTuple("group-1", "editor", "user-1")}})
+
+	suite.Equal(&serviceerror.ErrorUnauthorized, svcErr)
+}
+
+func (suite *RelationshipServiceTestSuite) TestWriteRelationships_InvalidRequests() {
+	valid := userTuple("group-1", "editor", "user-1")
+	cases := []struct {
+		name    string
+		request *RelationshipWriteRequest
+		want    *serviceerror.ServiceError
+	}{
+		{"empty", &RelationshipWriteRequest{}, &ErrorEmptyWriteRequest},
+		{"too many", &RelationshipWriteRequest{Writes: []Relationship{valid, valid}, Deletes: []Relationship{valid}},
+			&ErrorTooManyRelationships},
+		{"missing subject", &RelationshipWriteRequest{Writes: []Relationship{
+			{ObjectType: "group", ObjectID: "group-1", Relation: "editor", SubjectType: "user"}}},
+			&ErrorInvalidRelationship},
+		{"invalid relation", &RelationshipWriteRequest{Deletes: []Relationship{
+			userTuple("group-1", "Editor", "user-1")}}, &ErrorInvalidRelationship},
+		{"wildcard userset", &RelationshipWriteRequest{Writes: []Relationship{
+			{ObjectType: "group", ObjectID: "group-1", Relation: "editor", SubjectType: "group",
+				SubjectID: wildcardSubjectID, SubjectRelation: "member"}}}, &ErrorInvalidRelationship},
+		{"wildcard object", &RelationshipWriteRequest{Writes: []Relationship{
+			userTuple(wildcardSubjectID, "editor", "user-1")}}, &ErrorInvalidRelationship},
+	}
+
+	for _, tc := range cases {
+		suite.Run(tc.name, func() {
+			suite.allow(security.ActionWriteRelationships, true)
+			suite.Equal(tc.want, suite.service.WriteRelationships(context.Background(), tc.request))
+		})
+	}
+}
+
+func (suite *RelationshipServiceTestSuite) TestWriteRelationships_StoreError() {
+	suite.allow(security.ActionWriteRelationships, true)
+	suite.mockStore.On("CreateRelationship", mock.Anything, mock.Anything).Return(errors.New("db error")).Once()
+
+	svcErr := suite.service.WriteRelationships(context.Background(),
+		&RelationshipWriteRequest{Writes: []Relationship{userTuple("group-1", "editor", "user-1")}})
+
+	suite.Equal(&serviceerror.InternalServerError, svcErr)
+	suite.Empty(suite.events)
+}
+
+func (suite *RelationshipServiceTestSuite) TestListRelationships() {
+	suite.allow(security.ActionReadRelationships, true)
+	filter := RelationshipFilter{ObjectType: "group", SubjectID: "user-1"}
+	tuples := []Relationship{userTuple("group-1", "editor", "user-1")}
+	suite.mockStore.On("CountRelationships", mock.Anything, filter).Return(3, nil).Once()
+	suite.mockStore.On("ListRelationships", mock.Anything, filter, 1, 0).Return(tuples, nil).Once()
+
+	response, svcErr := suite.service.ListRelationships(context.Background(), filter, 1, 0)
+
+	suite.Nil(svcErr)
+	suite.Equal(3, response.TotalResults)
+	suite.Equal(tuples, response.Relationships)
+	suite.Require().NotEmpty(response.Links)
+	suite.Contains(response.Links[0].Href, "&objectType=group&subjectId=user-1")
+}
+
+func (suite *RelationshipServiceTestSuite) TestListRelationships_SkipCount() {
+	suite.allow(security.ActionReadRelationships, true)
+	tuples := []Relationship{userTuple("group-1", "editor", "user-1"), userTuple("group-2", "editor", "user-1")}
+	suite.mockStore.On("ListRelationships", mock.Anything, RelationshipFilter{}, 2, 0).Return(tuples, nil).Once()
+
+	response, svcErr := suite.service.ListRelationships(utils.WithSkipCount(context.Background(), true),
+		RelationshipFilter{}, 1, 0)
+
+	suite.Nil(svcErr)
+	suite.Equal(utils.TotalCountUnknown, response.TotalResults)
+	suite.Equal(1, response.Count)
+}
+
+func (suite *RelationshipServiceTestSuite) TestListRelationships_Errors() {
+	suite.allow(security.ActionReadRelationships, false)
+	_, svcErr := suite.service.ListRelationships(context.Background(), RelationshipFilter{}, 10, 0)
+	suite.Equal(&serviceerror.ErrorUnauthorized, svcErr)
+
+	suite.allow(security.ActionReadRelationships, true)
+	suite.mockStore.On("CountRelationships", mock.Anything, RelationshipFilter{}).
+		Return(0, errors.New("db error")).Once()
+	_, svcErr = suite.service.ListRelationships(context.Background(), RelationshipFilter{}, 10, 0)
+	suite.Equal(&serviceerror.InternalServerError, svcErr)
+}
+
+func (suite *RelationshipServiceTestSuite) TestCheck_DirectTuple() {
+	suite.allow(security.ActionReadRelationships, true)
+	suite.expectTuples("group", "group-1", "viewer", userTuple("group-1", "viewer", "user-1"))
+
+	response, svcErr := suite.service.Check(context.Background(), &CheckRequest{ObjectType: "group",
+		ObjectID: "group-1", Relation: "viewer", SubjectType: "user", SubjectID: "user-1"})
+
+	suite.Nil(svcErr)
+	suite.True(response.Allowed)
+}
+
+func (suite *RelationshipServiceTestSuite) TestCheck_InvalidRequest() {
+	suite.allow(security.ActionReadRelationships, true)
+
+	_, svcErr := suite.service.Check(context.Background(), &CheckRequest{ObjectType: "group",
+		ObjectID: "group-1", Relation: "viewer", SubjectType: "user", SubjectID: wildcardSubjectID})
+
+	suite.Equal(&ErrorInvalidRelationship, svcErr)
+}
+
+func (suite *RelationshipServiceTestSuite) TestCheckRelationship_Wildcard() {
+	suite.expectTuples("group", "group-1", "viewer", userTuple("group-1", "viewer", wildcardSubjectID))
+
+	allowed, svcErr := suite.service.CheckRelationship(context.Background(), "group", "group-1", "viewer",
+		"user", "user-1")
+
+	suite.Nil(svcErr)
+	suite.True(allowed)
+}
+
+func (suite *RelationshipServiceTestSuite) TestCheckRelationship_Userset() {
+	// Members of team-1 are editors of group-1, and user-1 is a member of team-1.
+	suite.expectTuples("group", "group-1", "editor", Relationship{ObjectType: "group", ObjectID: "group-1",
+		Relation: "editor", SubjectType: "team", SubjectID: "team-1", SubjectRelation: "member"})
+	suite.expectTuples("team", "team-1", "member", Relationship{ObjectType: "team", ObjectID: "team-1",
+		Relation: "member", SubjectType: "user", SubjectID: "user-1"})
+
+	allowed, svcErr := suite.service.CheckRelationship(context.Background(), "group", "group-1", "editor",
+		"user", "user-1")
+
+	suite.Nil(svcErr)
+	suite.True(allowed)
+}
+
+func (suite *RelationshipServiceTestSuite) TestCheckRelationship_ImpliedRelation() {
+	// Owners are editors, and editors are viewers.
+	suite.expectTuples("group", "group-1", "viewer")
+	suite.expectTuples("group", "group-1", "editor")
+	suite.expectTuples("group", "group-1", "owner", userTuple("group-1", "owner", "user-1"))
+
+	allowed, svcErr := suite.service.CheckRelationship(context.Background(), "group", "group-1", "viewer",
+		"user", "user-1")
+
+	suite.Nil(svcErr)
+	suite.True(allowed)
+}
+
+func (suite *RelationshipServiceTestSuite) TestCheckRelationship_CyclicUsersetTerminates() {
+	suite.expectTuples("team", "team-1", "member", Relationship{ObjectType: "team", ObjectID: "team-1",
+		Relation: "member", SubjectType: "team", SubjectID: "team-2", SubjectRelation: "member"})
+	suite.expectTuples("team", "team-2", "member", Relationship{ObjectType: "team", ObjectID: "team-2",
+		Relation: "member", SubjectType: "team", SubjectID: "team-1", SubjectRelation: "member"})
+
+	allowed, svcErr := suite.service.CheckRelationship(context.Background(), "team", "team-1", "member",
+		"user", "user-1")
+
+	suite.Nil(svcErr)
+	suite.False(allowed)
+}
+
+func (suite *RelationshipServiceTestSuite) TestCheckRelationship_DepthLimit() {
+	// The chain team-0 -> team-4 is deeper than the maximum check depth of 3.
+	for i := 0; i < 4; i++ {
+		suite.expectTuples("team", teamID(i), "member", Relationship{ObjectType: "team", ObjectID: teamID(i),
+			Relation: "member", SubjectType: "team", SubjectID: teamID(i + 1), SubjectRelation: "member"})
+	}
+
+	allowed, svcErr := suite.service.CheckRelationship(context.Background(), "team", teamID(0), "member",
+		"user", "user-1")
+
+	suite.Nil(svcErr)
+	suite.False(allowed)
+}
+
+func (suite *RelationshipServiceTestSuite) TestCheckRelationship_StoreError() {
+	suite.mockStore.On("GetRelationshipsByObjectRelation", mock.Anything, "group", "group-1", "viewer").
+		Return(nil, errors.New("db error")).Once()
+
+	allowed, svcErr := suite.service.CheckRelationship(context.Background(), "group", "group-1", "viewer",
+		"user", "user-1")
+
+	suite.False(allowed)
+	suite.Equal(&serviceerror.InternalServerError, svcErr)
+}
+
+func (suite *RelationshipServiceTestSuite) TestDeleteRelationships() {
+	suite.mockStore.On("DeleteRelationshipsByObject", mock.Anything, "group", "group-1").Return(nil).Once()
+	suite.mockStore.On("DeleteRelationshipsBySubject", mock.Anything, "group", "group-1").Return(nil).Once()
+
+	suite.Nil(suite.service.DeleteRelationships(context.Background(), "group", "group-1"))
+
+	suite.mockStore.On("DeleteRelationshipsByObject", mock.Anything, "user", "user-1").
+		Return(errors.New("db error")).Once()
+	suite.Equal(&serviceerror.InternalServerError,
+		suite.service.DeleteRelationships(context.Background(), "user", "user-1"))
+}
+
+func teamID(i int) string {
+	return "team-" + strconv.Itoa(i)
+}
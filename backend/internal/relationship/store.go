@@ -0,0 +1,226 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package relationship
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+	sysContext "github.com/thunder-id/thunderid/internal/system/context"
+	"github.com/thunder-id/thunderid/internal/system/database/provider"
+	"github.com/thunder-id/thunderid/internal/system/transaction"
+)
+
+var getDBProvider = provider.GetDBProvider
+
+// relationshipStoreInterface defines the interface for relationship tuple store operations.
+type relationshipStoreInterface interface {
+	CreateRelationship(ctx context.Context, relationship Relationship) error
+	DeleteRelationship(ctx context.Context, relationship Relationship) error
+	GetRelationshipsByObjectRelation(ctx context.Context, objectType, objectID,
+		relation string) ([]Relationship, error)
+	ListRelationships(ctx context.Context, filter RelationshipFilter, limit, offset int) ([]Relationship, error)
+	CountRelationships(ctx context.Context, filter RelationshipFilter) (int, error)
+	DeleteRelationshipsByObject(ctx context.Context, objectType, objectID string) error
+	DeleteRelationshipsBySubject(ctx context.Context, subjectType, subjectID string) error
+}
+
+// relationshipStore is the default implementation of relationshipStoreInterface.
+type relationshipStore struct {
+	dbProvider   provider.DBProviderInterface
+	deploymentID string
+}
+
+// newRelationshipStore creates a new instance of relationshipStore along with the transactioner of the
+// configuration database, so that the tuples of a write request are applied atomically.
+func newRelationshipStore() (relationshipStoreInterface, transaction.Transactioner, error) {
+	dbProvider := getDBProvider()
+	client, err := dbProvider.GetConfigDBClient()
+	if err != nil {
+		return nil, nil, err
+	}
+	transactioner, err := client.GetTransactioner()
+	if err != nil {
+		return nil, nil, err
+	}
+	return &relationshipStore{
+		dbProvider:   dbProvider,
+		deploymentID: config.GetServerRuntime().Config.Server.Identifier,
+	}, transactioner, nil
+}
+
+// CreateRelationship persists a relationship tuple.
+func (s *relationshipStore) CreateRelationship(ctx context.Context, relationship Relationship) error {
+	dbClient, err := s.dbProvider.GetConfigDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	if _, err := dbClient.ExecuteContext(ctx, queryCreateRelationship, relationship.ObjectType,
+		relationship.ObjectID, relationship.Relation, relationship.SubjectType, relationship.SubjectID,
+		relationship.SubjectRelation, time.Now().UTC(), sysContext.ScopeDeploymentID(ctx, s.deploymentID)); err != nil {
+		return fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteRelationship deletes a relationship tuple.
+func (s *relationshipStore) DeleteRelationship(ctx context.Context, relationship Relationship) error {
+	dbClient, err := s.dbProvider.GetConfigDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	if _, err := dbClient.ExecuteContext(ctx, queryDeleteRelationship, relationship.ObjectType,
+		relationship.ObjectID, relationship.Relation, relationship.SubjectType, relationship.SubjectID,
+		relationship.SubjectRelation, sysContext.ScopeDeploymentID(ctx, s.deploymentID)); err != nil {
+		return fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	return nil
+}
+
+// GetRelationshipsByObjectRelation retrieves the tuples granting the relation on the object.
+func (s *relationshipStore) GetRelationshipsByObjectRelation(ctx context.Context, objectType, objectID,
+	relation string) ([]Relationship, error) {
+	dbClient, err := s.dbProvider.GetConfigDBClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	results, err := dbClient.QueryContext(ctx, queryGetRelationshipsByObjectRelation, objectType, objectID,
+		relation, sysContext.ScopeDeploymentID(ctx, s.deploymentID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	return buildRelationshipsFromResultRows(results)
+}
+
+// ListRelationships retrieves a page of the tuples matching the filter.
+func (s *relationshipStore) ListRelationships(ctx context.Context, filter RelationshipFilter,
+	limit, offset int) ([]Relationship, error) {
+	dbClient, err := s.dbProvider.GetConfigDBClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	query, args := buildListRelationshipsQuery(filter, limit, offset,
+		sysContext.ScopeDeploymentID(ctx, s.deploymentID))
+	results, err := dbClient.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	return buildRelationshipsFromResultRows(results)
+}
+
+// CountRelationships counts the tuples matching the filter.
+func (s *relationshipStore) CountRelationships(ctx context.Context, filter RelationshipFilter) (int, error) {
+	dbClient, err := s.dbProvider.GetConfigDBClient()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	query, args := buildCountRelationshipsQuery(filter, sysContext.ScopeDeploymentID(ctx, s.deploymentID))
+	results, err := dbClient.QueryContext(ctx, query, args...)
+	if err != nil {
+		return 0, fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	if len(results) > 0 {
+		switch total := results[0]["total"].(type) {
+		case int64:
+			return int(total), nil
+		case float64:
+			return int(total), nil
+		}
+	}
+	return 0, nil
+}
+
+// DeleteRelationshipsByObject deletes the tuples granting any relation on the object.
+func (s *relationshipStore) DeleteRelationshipsByObject(ctx context.Context, objectType, objectID string) error {
+	dbClient, err := s.dbProvider.GetConfigDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	if _, err := dbClient.ExecuteContext(ctx, queryDeleteRelationshipsByObject, objectType, objectID,
+		sysContext.ScopeDeploymentID(ctx, s.deploymentID)); err != nil {
+		return fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteRelationshipsBySubject deletes the tuples in which the entity is the subject or the object of a
+// userset.
+func (s *relationshipStore) DeleteRelationshipsBySubject(ctx context.Context, subjectType, subjectID string) error {
+	dbClient, err := s.dbProvider.GetConfigDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	if _, err := dbClient.ExecuteContext(ctx, queryDeleteRelationshipsBySubject, subjectType, subjectID,
+		sysContext.ScopeDeploymentID(ctx, s.deploymentID)); err != nil {
+		return fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	return nil
+}
+
+// buildRelationshipsFromResultRows constructs relationship tuples from database result rows.
+func buildRelationshipsFromResultRows(rows []map[string]interface{}) ([]Relationship, error) {
+	relationships := make([]Relationship, 0, len(rows))
+	for _, row := range rows {
+		relationship, err := buildRelationshipFromResultRow(row)
+		if err != nil {
+			return nil, fmt.Errorf("failed to build relationship from result row: %w", err)
+		}
+		relationships = append(relationships, *relationship)
+	}
+	return relationships, nil
+}
+
+// buildRelationshipFromResultRow constructs a Relationship from a database result row.
+func buildRelationshipFromResultRow(row map[string]interface{}) (*Relationship, error) {
+	columns := []string{"object_type", "object_id", "relation", "subject_type", "subject_id"}
+	values := make([]string, len(columns))
+	for i, column := range columns {
+		value, ok := row[column].(string)
+		if !ok {
+			return nil, fmt.Errorf("failed to parse %s as string", column)
+		}
+		values[i] = value
+	}
+	subjectRelation, _ := row["subject_relation"].(string)
+
+	return &Relationship{
+		ObjectType:      values[0],
+		ObjectID:        values[1],
+		Relation:        values[2],
+		SubjectType:     values[3],
+		SubjectID:       values[4],
+		SubjectRelation: subjectRelation,
+	}, nil
+}
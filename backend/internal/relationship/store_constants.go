@@ -0,0 +1,120 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package relationship
+
+import (
+	"fmt"
+	"strings"
+
+	dbmodel "github.com/thunder-id/thunderid/internal/system/database/model"
+)
+
+const relationshipColumns = `OBJECT_TYPE, OBJECT_ID, RELATION, SUBJECT_TYPE, SUBJECT_ID, SUBJECT_RELATION`
+
+var (
+	// queryCreateRelationship inserts a relationship tuple. Writing an existing tuple is a no-op.
+	queryCreateRelationship = dbmodel.DBQuery{
+		ID: "RLQ-RELATIONSHIP_MGT-01",
+		Query: `INSERT INTO "RELATIONSHIP_TUPLE" (` + relationshipColumns + `, CREATED_AT, DEPLOYMENT_ID) ` +
+			`VALUES ($1, $2, $3, $4, $5, $6, $7, $8) ON CONFLICT (DEPLOYMENT_ID, OBJECT_TYPE, OBJECT_ID, ` +
+			`RELATION, SUBJECT_TYPE, SUBJECT_ID, SUBJECT_RELATION) DO NOTHING`,
+	}
+
+	// queryDeleteRelationship deletes a relationship tuple. Deleting a missing tuple is a no-op.
+	queryDeleteRelationship = dbmodel.DBQuery{
+		ID: "RLQ-RELATIONSHIP_MGT-02",
+		Query: `DELETE FROM "RELATIONSHIP_TUPLE" WHERE OBJECT_TYPE = $1 AND OBJECT_ID = $2 AND RELATION = $3 ` +
+			`AND SUBJECT_TYPE = $4 AND SUBJECT_ID = $5 AND SUBJECT_RELATION = $6 AND DEPLOYMENT_ID = $7`,
+	}
+
+	// queryGetRelationshipsByObjectRelation retrieves the tuples granting a relation on an object.
+	queryGetRelationshipsByObjectRelation = dbmodel.DBQuery{
+		ID: "RLQ-RELATIONSHIP_MGT-03",
+		Query: `SELECT ` + relationshipColumns + ` FROM "RELATIONSHIP_TUPLE" WHERE OBJECT_TYPE = $1 ` +
+			`AND OBJECT_ID = $2 AND RELATION = $3 AND DEPLOYMENT_ID = $4`,
+	}
+
+	// queryDeleteRelationshipsByObject deletes the tuples granting any relation on an object.
+	queryDeleteRelationshipsByObject = dbmodel.DBQuery{
+		ID: "RLQ-RELATIONSHIP_MGT-04",
+		Query: `DELETE FROM "RELATIONSHIP_TUPLE" WHERE OBJECT_TYPE = $1 AND OBJECT_ID = $2 ` +
+			`AND DEPLOYMENT_ID = $3`,
+	}
+
+	// queryDeleteRelationshipsBySubject deletes the tuples granting a subject, or a userset of it, a relation.
+	queryDeleteRelationshipsBySubject = dbmodel.DBQuery{
+		ID: "RLQ-RELATIONSHIP_MGT-05",
+		Query: `DELETE FROM "RELATIONSHIP_TUPLE" WHERE SUBJECT_TYPE = $1 AND SUBJECT_ID = $2 ` +
+			`AND DEPLOYMENT_ID = $3`,
+	}
+)
+
+// buildRelationshipFilterClause returns the WHERE clause and args restricting the tuples to the filter
+// and the deployment. Empty filter fields are not part of the clause.
+func buildRelationshipFilterClause(filter RelationshipFilter, deploymentID string) (string, []interface{}) {
+	fields := []struct {
+		column string
+		value  string
+	}{
+		{"OBJECT_TYPE", filter.ObjectType},
+		{"OBJECT_ID", filter.ObjectID},
+		{"RELATION", filter.Relation},
+		{"SUBJECT_TYPE", filter.SubjectType},
+		{"SUBJECT_ID", filter.SubjectID},
+	}
+
+	conditions := make([]string, 0, len(fields)+1)
+	args := make([]interface{}, 0, len(fields)+1)
+	for _, field := range fields {
+		if field.value == "" {
+			continue
+		}
+		args = append(args, field.value)
+		conditions = append(conditions, fmt.Sprintf("%s = $%d", field.column, len(args)))
+	}
+	args = append(args, deploymentID)
+	conditions = append(conditions, fmt.Sprintf("DEPLOYMENT_ID = $%d", len(args)))
+
+	return "WHERE " + strings.Join(conditions, " AND "), args
+}
+
+// buildListRelationshipsQuery returns the query and args to retrieve a page of the tuples matching the
+// filter, in a stable order.
+func buildListRelationshipsQuery(filter RelationshipFilter, limit, offset int,
+	deploymentID string) (dbmodel.DBQuery, []interface{}) {
+	whereClause, args := buildRelationshipFilterClause(filter, deploymentID)
+	args = append(args, limit, offset)
+
+	return dbmodel.DBQuery{
+		ID: "RLQ-RELATIONSHIP_MGT-06",
+		Query: fmt.Sprintf(`SELECT `+relationshipColumns+` FROM "RELATIONSHIP_TUPLE" %s `+
+			`ORDER BY OBJECT_TYPE, OBJECT_ID, RELATION, SUBJECT_TYPE, SUBJECT_ID, SUBJECT_RELATION `+
+			`LIMIT $%d OFFSET $%d`, whereClause, len(args)-1, len(args)),
+	}, args
+}
+
+// buildCountRelationshipsQuery returns the query and args to count the tuples matching the filter.
+func buildCountRelationshipsQuery(filter RelationshipFilter, deploymentID string) (dbmodel.DBQuery, []interface{}) {
+	whereClause, args := buildRelationshipFilterClause(filter, deploymentID)
+
+	return dbmodel.DBQuery{
+		ID:    "RLQ-RELATIONSHIP_MGT-07",
+		Query: `SELECT COUNT(*) AS total FROM "RELATIONSHIP_TUPLE" ` + whereClause,
+	}, args
+}
@@ -0,0 +1,41 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package relationship
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuildListRelationshipsQuery(t *testing.T) {
+	query, args := buildListRelationshipsQuery(RelationshipFilter{ObjectType: "group", SubjectID: "user-1"},
+		10, 20, "deployment-1")
+
+	assert.Contains(t, query.Query, "WHERE OBJECT_TYPE = $1 AND SUBJECT_ID = $2 AND DEPLOYMENT_ID = $3 ")
+	assert.Contains(t, query.Query, "LIMIT $4 OFFSET $5")
+	assert.Equal(t, []interface{}{"group", "user-1", "deployment-1", 10, 20}, args)
+}
+
+func TestBuildCountRelationshipsQuery(t *testing.T) {
+	query, args := buildCountRelationshipsQuery(RelationshipFilter{}, "deployment-1")
+
+	assert.Equal(t, `SELECT COUNT(*) AS total FROM "RELATIONSHIP_TUPLE" WHERE DEPLOYMENT_ID = $1`, query.Query)
+	assert.Equal(t, []interface{}{"deployment-1"}, args)
+}
@@ -563,6 +563,29 @@ func (c *compositeResourceStore) ValidatePermissions(
 	return result, nil
 }
 
+// GetPermissions returns the permissions of a resource server from both stores, without duplicates.
+func (c *compositeResourceStore) GetPermissions(ctx context.Context, resServerID string) ([]string, error) {
+	dbPermissions, err := c.dbStore.GetPermissions(ctx, resServerID)
+	if err != nil {
+		return nil, err
+	}
+	filePermissions, err := c.fileStore.GetPermissions(ctx, resServerID)
+	if err != nil {
+		return nil, err
+	}
+
+	seen := make(map[string]struct{}, len(dbPermissions)+len(filePermissions))
+	permissions := make([]string, 0, len(dbPermissions)+len(filePermissions))
+	for _, perm := range append(dbPermissions, filePermissions...) {
+		if _, ok := seen[perm]; ok {
+			continue
+		}
+		seen[perm] = struct{}{}
+		permissions = append(permissions, perm)
+	}
+	return permissions, nil
+}
+
 func (c *compositeResourceStore) FindResourceServersByPermissions(
 	ctx context.Context, permissions []string,
 ) ([]ResourceServer, error) {
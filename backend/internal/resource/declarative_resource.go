@@ -111,6 +111,9 @@ func (e *resourceServerExporter) GetResourceByID(ctx context.Context, id string)
 		OUID:        server.OUID,
 		Delimiter:   server.Delimiter,
 		Resources:   []Resource{},
+		TokenFormat: server.TokenFormat,
+
+		ScopeImplications: server.ScopeImplications,
 	}
 
 	// Get all resources for this server
@@ -315,6 +318,17 @@ func ProcessResourceServer(rs *ResourceServer) error {
 	}
 	rs.Delimiter = delimiter
 
+	if svcErr := validateScopeImplications(rs.ScopeImplications, delimiter); svcErr != nil {
+		return fmt.Errorf("invalid scope implications in resource server '%s': %s",
+			rs.ID, svcErr.ErrorDescription.DefaultValue)
+	}
+
+	tokenFormat, svcErr := resolveTokenFormat(rs.TokenFormat)
+	if svcErr != nil {
+		return fmt.Errorf("invalid token format '%s' in resource server '%s'", rs.TokenFormat, rs.ID)
+	}
+	rs.TokenFormat = tokenFormat
+
 	// Build a map of handle to resource for parent resolution and detect duplicates
 	resourceHandleMap := make(map[string]*Resource)
 	for i := range rs.Resources {
@@ -388,6 +388,7 @@ func TestProcessResourceServer_SetsPermissionsAndDelimiter(t *testing.T) {
 
 	assert.NoError(t, err)
 	assert.Equal(t, ":", rs.Delimiter)
+	assert.Equal(t, TokenFormatAccessTokenJWT, rs.TokenFormat)
 	assert.Equal(t, "test-api:users", rs.Resources[0].Permission)
 	assert.Equal(t, "test-api:users:read", rs.Resources[0].Actions[0].Permission)
 	assert.Equal(t, "test-api:users:profile", rs.Resources[1].Permission)
@@ -442,6 +443,21 @@ func TestProcessResourceServer_DuplicateHandle(t *testing.T) {
 	assert.Contains(t, err.Error(), "duplicate resource handle")
 }
 
+func TestProcessResourceServer_InvalidTokenFormat(t *testing.T) {
+	rs := &ResourceServer{
+		ID:          "rs1",
+		Name:        "Test Server",
+		Handle:      "test-api",
+		OUID:        "ou1",
+		TokenFormat: "opaque",
+	}
+
+	err := ProcessResourceServer(rs)
+
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid token format 'opaque'")
+}
+
 func TestProcessResource_SetsPermissions(t *testing.T) {
 	root := &Resource{Handle: "root"}
 	resource := &Resource{
@@ -314,6 +314,45 @@ var (
 			DefaultValue: "A resource server with the specified ID already exists",
 		},
 	}
+	// ErrorInvalidScopeImplication is returned when a scope implication rule is malformed.
+	ErrorInvalidScopeImplication = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "RES-1024",
+		Error: core.I18nMessage{
+			Key:          "error.resourceservice.invalid_scope_implication",
+			DefaultValue: "Invalid scope implication",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.resourceservice.invalid_scope_implication_description",
+			DefaultValue: "Scope implications must use valid scopes, with a wildcard only as the last segment",
+		},
+	}
+	// ErrorCyclicScopeImplication is returned when scope implication rules imply a scope from itself.
+	ErrorCyclicScopeImplication = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "RES-1025",
+		Error: core.I18nMessage{
+			Key:          "error.resourceservice.cyclic_scope_implication",
+			DefaultValue: "Cyclic scope implication",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.resourceservice.cyclic_scope_implication_description",
+			DefaultValue: "Scope %s implies itself through the scope implications",
+		},
+	}
+	// ErrorInvalidTokenFormat is returned when the token format of a resource server is not supported.
+	ErrorInvalidTokenFormat = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "RES-1026",
+		Error: core.I18nMessage{
+			Key:          "error.resourceservice.invalid_token_format",
+			DefaultValue: "Invalid token format",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.resourceservice.invalid_token_format_description",
+			DefaultValue: "Token format must be one of: at+jwt, jwt",
+		},
+	}
 	// ErrorDelimiterInResourceServerHandle is returned when the resource server handle contains the delimiter.
 	ErrorDelimiterInResourceServerHandle = serviceerror.ServiceError{
 		Type: serviceerror.ClientErrorType,
@@ -621,6 +621,29 @@ func (f *fileBasedResourceStore) ValidatePermissions(
 	return invalidList, nil
 }
 
+// GetPermissions returns the permissions of all resources and actions of a declarative resource server.
+func (f *fileBasedResourceStore) GetPermissions(ctx context.Context, resServerID string) ([]string, error) {
+	list, err := f.GenericFileBasedStore.List()
+	if err != nil {
+		return nil, err
+	}
+
+	permissions := make([]string, 0)
+	for _, item := range list {
+		rs, ok := item.Data.(*ResourceServer)
+		if !ok || rs.ID != resServerID {
+			continue
+		}
+		for _, res := range rs.Resources {
+			permissions = append(permissions, res.Permission)
+			for _, action := range res.Actions {
+				permissions = append(permissions, action.Permission)
+			}
+		}
+	}
+	return permissions, nil
+}
+
 func (f *fileBasedResourceStore) FindResourceServersByPermissions(
 	ctx context.Context, permissions []string,
 ) ([]ResourceServer, error) {
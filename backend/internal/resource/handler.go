@@ -23,6 +23,7 @@ import (
 	"net/url"
 	"strconv"
 
+	"github.com/thunder-id/thunderid/internal/system/config"
 	serverconst "github.com/thunder-id/thunderid/internal/system/constants"
 	"github.com/thunder-id/thunderid/internal/system/error/apierror"
 	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
@@ -79,6 +80,9 @@ func (h *resourceHandler) HandleResourceServerPostRequest(w http.ResponseWriter,
 		Identifier:  sanitized.Identifier,
 		OUID:        sanitized.OUID,
 		Delimiter:   sanitized.Delimiter,
+		TokenFormat: sanitized.TokenFormat,
+
+		ScopeImplications: sanitized.ScopeImplications,
 	}
 
 	result, svcErr := h.resourceService.CreateResourceServer(ctx, serviceReq)
@@ -105,6 +109,40 @@ func (h *resourceHandler) HandleResourceServerGetRequest(w http.ResponseWriter,
 	sysutils.WriteSuccessResponse(w, http.StatusOK, response)
 }
 
+// HandleResourceServerMetadataRequest handles retrieving the OAuth 2.0 protected resource metadata
+// (RFC 9728) of a resource server. SDKs use it to discover how to obtain and validate tokens for the
+// resource server. Only resource servers with an identifier can be token audiences, so others are
+// reported as not found.
+func (h *resourceHandler) HandleResourceServerMetadataRequest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	id := r.PathValue("id")
+	resourceServer, svcErr := h.resourceService.GetResourceServer(ctx, id)
+	if svcErr != nil {
+		handleError(w, svcErr)
+		return
+	}
+	if resourceServer.Identifier == "" {
+		handleError(w, &ErrorResourceServerNotFound)
+		return
+	}
+
+	permissions, svcErr := h.resourceService.GetResourceServerPermissions(ctx, id)
+	if svcErr != nil {
+		handleError(w, svcErr)
+		return
+	}
+
+	response := &ResourceServerMetadataResponse{
+		Resource:               resourceServer.Identifier,
+		ResourceName:           resourceServer.Name,
+		AuthorizationServers:   []string{config.GetServerRuntime().Config.JWT.Issuer},
+		ScopesSupported:        permissions,
+		BearerMethodsSupported: []string{"header"},
+		AccessTokenFormat:      resourceServer.TokenFormat,
+	}
+	sysutils.WriteSuccessResponse(w, http.StatusOK, response)
+}
+
 // HandleResourceServerPutRequest handles updating a resource server.
 func (h *resourceHandler) HandleResourceServerPutRequest(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -122,6 +160,9 @@ func (h *resourceHandler) HandleResourceServerPutRequest(w http.ResponseWriter,
 		Handle:      sanitized.Handle,
 		Identifier:  sanitized.Identifier,
 		OUID:        sanitized.OUID,
+		TokenFormat: sanitized.TokenFormat,
+
+		ScopeImplications: sanitized.ScopeImplications,
 	}
 
 	result, svcErr := h.resourceService.UpdateResourceServer(ctx, id, serviceReq)
@@ -549,6 +590,9 @@ func sanitizeCreateResourceServerRequest(req *CreateResourceServerRequest) Creat
 		Identifier:  sysutils.SanitizeString(req.Identifier),
 		OUID:        sysutils.SanitizeString(req.OUID),
 		Delimiter:   sysutils.SanitizeString(req.Delimiter),
+		TokenFormat: sysutils.SanitizeString(req.TokenFormat),
+
+		ScopeImplications: sanitizeScopeImplications(req.ScopeImplications),
 	}
 }
 
@@ -560,9 +604,28 @@ func sanitizeUpdateResourceServerRequest(req *UpdateResourceServerRequest) Updat
 		Handle:      sysutils.SanitizeString(req.Handle),
 		Identifier:  sysutils.SanitizeString(req.Identifier),
 		OUID:        sysutils.SanitizeString(req.OUID),
+		TokenFormat: sysutils.SanitizeString(req.TokenFormat),
+
+		ScopeImplications: sanitizeScopeImplications(req.ScopeImplications),
 	}
 }
 
+// sanitizeScopeImplications sanitizes the scopes of scope implication rules.
+func sanitizeScopeImplications(implications map[string][]string) map[string][]string {
+	if implications == nil {
+		return nil
+	}
+	sanitized := make(map[string][]string, len(implications))
+	for scope, implied := range implications {
+		sanitizedImplied := make([]string, len(implied))
+		for i, impliedScope := range implied {
+			sanitizedImplied[i] = sysutils.SanitizeString(impliedScope)
+		}
+		sanitized[sysutils.SanitizeString(scope)] = sanitizedImplied
+	}
+	return sanitized
+}
+
 // sanitizeCreateResourceRequest sanitizes input for creating a resource.
 func sanitizeCreateResourceRequest(req *CreateResourceRequest) CreateResourceRequest {
 	sanitized := CreateResourceRequest{
@@ -618,6 +681,9 @@ func toResourceServerResponse(rs *ResourceServer) *ResourceServerResponse {
 		OUID:        rs.OUID,
 		Delimiter:   rs.Delimiter,
 		IsReadOnly:  rs.IsReadOnly,
+		TokenFormat: rs.TokenFormat,
+
+		ScopeImplications: rs.ScopeImplications,
 	}
 }
 
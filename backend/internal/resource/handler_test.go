@@ -28,6 +28,7 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 
+	"github.com/thunder-id/thunderid/internal/system/config"
 	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
 )
 
@@ -179,6 +180,69 @@ func (suite *HandlerTestSuite) TestHandleResourceServerGetRequest_NotFound() {
 	suite.Equal(http.StatusNotFound, w.Code)
 }
 
+func (suite *HandlerTestSuite) TestHandleResourceServerMetadataRequest_Success() {
+	config.ResetServerRuntime()
+	testConfig := &config.Config{JWT: config.JWTConfig{Issuer: "https://localhost:8090"}}
+	suite.Require().NoError(config.InitializeServerRuntime("/tmp/test", testConfig))
+	defer config.ResetServerRuntime()
+
+	suite.mockService.On("GetResourceServer", mock.Anything, "rs-123").Return(&ResourceServer{
+		ID:          "rs-123",
+		Name:        "Orders API",
+		Identifier:  "https://api.example.com/orders",
+		TokenFormat: TokenFormatJWT,
+	}, nil)
+	suite.mockService.On("GetResourceServerPermissions", mock.Anything, "rs-123").
+		Return([]string{"orders:read", "orders:write"}, nil)
+
+	req := httptest.NewRequest("GET", resourceServerMetadataPath+"/rs-123", nil)
+	req.SetPathValue("id", "rs-123")
+	w := httptest.NewRecorder()
+
+	suite.handler.HandleResourceServerMetadataRequest(w, req)
+
+	suite.Equal(http.StatusOK, w.Code)
+	var resp ResourceServerMetadataResponse
+	suite.NoError(json.Unmarshal(w.Body.Bytes(), &resp))
+	suite.Equal(ResourceServerMetadataResponse{
+		Resource:               "https://api.example.com/orders",
+		ResourceName:           "Orders API",
+		AuthorizationServers:   []string{"https://localhost:8090"},
+		ScopesSupported:        []string{"orders:read", "orders:write"},
+		BearerMethodsSupported: []string{"header"},
+		AccessTokenFormat:      TokenFormatJWT,
+	}, resp)
+}
+
+func (suite *HandlerTestSuite) TestHandleResourceServerMetadataRequest_NoIdentifier() {
+	suite.mockService.On("GetResourceServer", mock.Anything, "rs-123").Return(&ResourceServer{
+		ID:   "rs-123",
+		Name: "test-rs",
+	}, nil)
+
+	req := httptest.NewRequest("GET", resourceServerMetadataPath+"/rs-123", nil)
+	req.SetPathValue("id", "rs-123")
+	w := httptest.NewRecorder()
+
+	suite.handler.HandleResourceServerMetadataRequest(w, req)
+
+	suite.Equal(http.StatusNotFound, w.Code)
+	suite.mockService.AssertNotCalled(suite.T(), "GetResourceServerPermissions", mock.Anything, mock.Anything)
+}
+
+func (suite *HandlerTestSuite) TestHandleResourceServerMetadataRequest_NotFound() {
+	suite.mockService.On("GetResourceServer", mock.Anything, "rs-123").
+		Return(nil, &ErrorResourceServerNotFound)
+
+	req := httptest.NewRequest("GET", resourceServerMetadataPath+"/rs-123", nil)
+	req.SetPathValue("id", "rs-123")
+	w := httptest.NewRecorder()
+
+	suite.handler.HandleResourceServerMetadataRequest(w, req)
+
+	suite.Equal(http.StatusNotFound, w.Code)
+}
+
 func (suite *HandlerTestSuite) TestHandleResourceServerPutRequest_Success() {
 	reqBody := UpdateResourceServerRequest{
 		Name: "updated-rs",
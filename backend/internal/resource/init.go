@@ -87,6 +87,10 @@ func initializeStore() (resourceStoreInterface, transaction.Transactioner, error
 	}
 }
 
+// resourceServerMetadataPath is the path under which the protected resource metadata of each resource
+// server is served, followed by the resource server ID.
+const resourceServerMetadataPath = "/.well-known/oauth-protected-resource/resource-servers"
+
 // registerRoutes registers all routes for the resource management API.
 func registerRoutes(mux *http.ServeMux, handler *resourceHandler) {
 	// Resource Server routes
@@ -124,6 +128,21 @@ func registerRoutes(mux *http.ServeMux, handler *resourceHandler) {
 			w.WriteHeader(http.StatusNoContent)
 		}, resourceServerDetailOpts))
 
+	// Resource server metadata routes (public)
+	resourceServerMetadataOpts := middleware.CORSOptions{
+		AllowedMethods:   []string{"GET", "OPTIONS"},
+		AllowedHeaders:   []string{"Content-Type"},
+		AllowCredentials: false,
+		MaxAge:           600,
+	}
+
+	mux.HandleFunc(middleware.WithCORS("GET "+resourceServerMetadataPath+"/{id}",
+		handler.HandleResourceServerMetadataRequest, resourceServerMetadataOpts))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS "+resourceServerMetadataPath+"/{id}",
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, resourceServerMetadataOpts))
+
 	// Resource routes
 	resourceOpts := middleware.CORSOptions{
 		AllowedMethods:   []string{"GET", "POST"},
@@ -30,6 +30,9 @@ type ResourceServerResponse struct {
 	OUID        string `json:"ouId"`
 	Delimiter   string `json:"delimiter"`
 	IsReadOnly  bool   `json:"isReadOnly"`
+	TokenFormat string `json:"tokenFormat"`
+
+	ScopeImplications map[string][]string `json:"scopeImplications,omitempty"`
 }
 
 // ResourceResponse represents a resource.
@@ -42,6 +45,17 @@ type ResourceResponse struct {
 	Permission  string  `json:"permission"`
 }
 
+// ResourceServerMetadataResponse represents the OAuth 2.0 protected resource metadata of a resource
+// server, as defined in RFC 9728.
+type ResourceServerMetadataResponse struct {
+	Resource               string   `json:"resource"`
+	ResourceName           string   `json:"resource_name,omitempty"`
+	AuthorizationServers   []string `json:"authorization_servers"`
+	ScopesSupported        []string `json:"scopes_supported"`
+	BearerMethodsSupported []string `json:"bearer_methods_supported"`
+	AccessTokenFormat      string   `json:"access_token_format"`
+}
+
 // ActionResponse represents an action.
 type ActionResponse struct {
 	ID          string `json:"id"`
@@ -92,6 +106,9 @@ type CreateResourceServerRequest struct {
 	Identifier  string `json:"identifier,omitempty"`
 	OUID        string `json:"ouId"`
 	Delimiter   string `json:"delimiter,omitempty"`
+	TokenFormat string `json:"tokenFormat,omitempty"`
+
+	ScopeImplications map[string][]string `json:"scopeImplications,omitempty"`
 }
 
 // UpdateResourceServerRequest represents the request to update a resource server.
@@ -101,6 +118,9 @@ type UpdateResourceServerRequest struct {
 	Handle      string `json:"handle,omitempty"`
 	Identifier  string `json:"identifier,omitempty"`
 	OUID        string `json:"ouId"`
+	TokenFormat string `json:"tokenFormat,omitempty"`
+
+	ScopeImplications map[string][]string `json:"scopeImplications,omitempty"`
 }
 
 // CreateResourceRequest represents the request to create a resource.
@@ -201,4 +221,9 @@ type ResourceServer struct {
 	Delimiter   string     `yaml:"delimiter,omitempty" json:"delimiter,omitempty" yamlfmt:"quoted"`
 	IsReadOnly  bool       `yaml:"-" json:"-"`
 	Resources   []Resource `yaml:"resources,omitempty" json:"resources,omitempty"`
+	// TokenFormat is the format of the access tokens issued for the resource server.
+	TokenFormat string `yaml:"token_format,omitempty" json:"tokenFormat,omitempty"`
+	// ScopeImplications maps a scope to the scopes it implies. Implied scopes may themselves imply
+	// further scopes and may end in a wildcard segment, such as "users.*".
+	ScopeImplications map[string][]string `yaml:"scope_implications,omitempty" json:"scopeImplications,omitempty"`
 }
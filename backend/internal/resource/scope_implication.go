@@ -0,0 +1,190 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package resource
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/i18n/core"
+)
+
+// scopeWildcard is the last segment of a wildcard scope. "users.*" covers every permission under
+// "users", and "*" covers every permission of the resource server.
+const scopeWildcard = "*"
+
+// RequiresScopeExpansion reports whether any of the scopes implies further scopes on the resource
+// server, either through a scope implication rule or as a wildcard.
+func RequiresScopeExpansion(resourceServer *ResourceServer, scopes []string) bool {
+	if resourceServer == nil {
+		return false
+	}
+	for _, scope := range scopes {
+		if _, ok := resourceServer.ScopeImplications[scope]; ok {
+			return true
+		}
+		if isWildcardScope(scope, resourceServer.Delimiter) {
+			return true
+		}
+	}
+	return false
+}
+
+// expandScopes returns the scopes followed by every scope they imply, in the order they are reached.
+// Implications are followed transitively, and wildcards are replaced by the permissions they cover.
+// The scopes themselves are kept, including wildcards and scopes that are not permissions.
+func expandScopes(resourceServer *ResourceServer, permissions []string, scopes []string) []string {
+	expanded := make([]string, 0, len(scopes))
+	seen := make(map[string]struct{}, len(scopes))
+	queue := append([]string{}, scopes...)
+
+	for len(queue) > 0 {
+		scope := queue[0]
+		queue = queue[1:]
+		if _, ok := seen[scope]; ok {
+			continue
+		}
+		seen[scope] = struct{}{}
+		expanded = append(expanded, scope)
+
+		queue = append(queue, resourceServer.ScopeImplications[scope]...)
+		if isWildcardScope(scope, resourceServer.Delimiter) {
+			for _, permission := range permissions {
+				if wildcardCovers(scope, permission) {
+					queue = append(queue, permission)
+				}
+			}
+		}
+	}
+	return expanded
+}
+
+// isWildcardScope reports whether the scope ends in a wildcard segment.
+func isWildcardScope(scope, delimiter string) bool {
+	return scope == scopeWildcard || strings.HasSuffix(scope, delimiter+scopeWildcard)
+}
+
+// wildcardCovers reports whether the wildcard scope, which must end in a wildcard segment, covers the
+// scope. "users.*" covers "users.read" and "users.profile.read" but neither "users" nor itself.
+func wildcardCovers(wildcard, scope string) bool {
+	if scope == wildcard {
+		return false
+	}
+	prefix := strings.TrimSuffix(wildcard, scopeWildcard)
+	return len(scope) > len(prefix) && strings.HasPrefix(scope, prefix)
+}
+
+// validateScopeImplications checks that every scope in the implication rules is well formed and that
+// no scope implies itself, directly, through other rules, or through a wildcard covering it.
+func validateScopeImplications(
+	implications map[string][]string, delimiter string,
+) *serviceerror.ServiceError {
+	for scope, implied := range implications {
+		if !isValidImplicationScope(scope, delimiter) || len(implied) == 0 {
+			return &ErrorInvalidScopeImplication
+		}
+		for _, impliedScope := range implied {
+			if !isValidImplicationScope(impliedScope, delimiter) {
+				return &ErrorInvalidScopeImplication
+			}
+		}
+	}
+
+	if cyclicScope := findImplicationCycle(implications, delimiter); cyclicScope != "" {
+		return serviceerror.CustomServiceError(ErrorCyclicScopeImplication, core.I18nMessage{
+			Key:          ErrorCyclicScopeImplication.ErrorDescription.Key,
+			DefaultValue: fmt.Sprintf(ErrorCyclicScopeImplication.ErrorDescription.DefaultValue, cyclicScope),
+		})
+	}
+	return nil
+}
+
+// isValidImplicationScope reports whether the scope consists of permission characters, optionally
+// followed by a wildcard as its last segment.
+func isValidImplicationScope(scope, delimiter string) bool {
+	if scope == scopeWildcard {
+		return true
+	}
+	name := strings.TrimSuffix(scope, delimiter+scopeWildcard)
+	if name == "" || len(scope) > 100 {
+		return false
+	}
+	for _, char := range name {
+		if !strings.ContainsRune(validPermissionCharacters, char) {
+			return false
+		}
+	}
+	return true
+}
+
+// findImplicationCycle returns a scope that implies itself through the implication rules, or an empty
+// string when the rules are acyclic. A scope implies the scopes it is mapped to, and a wildcard scope
+// additionally implies every rule scope it covers.
+func findImplicationCycle(implications map[string][]string, delimiter string) string {
+	scopes := make([]string, 0, len(implications))
+	for scope := range implications {
+		scopes = append(scopes, scope)
+	}
+	sort.Strings(scopes)
+
+	edges := func(scope string) []string {
+		next := append([]string{}, implications[scope]...)
+		if isWildcardScope(scope, delimiter) {
+			for _, ruleScope := range scopes {
+				if wildcardCovers(scope, ruleScope) {
+					next = append(next, ruleScope)
+				}
+			}
+		}
+		return next
+	}
+
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+	state := make(map[string]int)
+	var visit func(scope string) string
+	visit = func(scope string) string {
+		switch state[scope] {
+		case visiting:
+			return scope
+		case visited:
+			return ""
+		}
+		state[scope] = visiting
+		for _, next := range edges(scope) {
+			if cyclicScope := visit(next); cyclicScope != "" {
+				return cyclicScope
+			}
+		}
+		state[scope] = visited
+		return ""
+	}
+
+	for _, scope := range scopes {
+		if cyclicScope := visit(scope); cyclicScope != "" {
+			return cyclicScope
+		}
+	}
+	return ""
+}
@@ -0,0 +1,131 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package resource
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type ScopeImplicationTestSuite struct {
+	suite.Suite
+}
+
+func TestScopeImplicationTestSuite(t *testing.T) {
+	suite.Run(t, new(ScopeImplicationTestSuite))
+}
+
+func (suite *ScopeImplicationTestSuite) TestRequiresScopeExpansion() {
+	rs := &ResourceServer{
+		Delimiter:         ":",
+		ScopeImplications: map[string][]string{"admin": {"users:read"}},
+	}
+
+	suite.True(RequiresScopeExpansion(rs, []string{"openid", "admin"}))
+	suite.True(RequiresScopeExpansion(rs, []string{"users:*"}))
+	suite.True(RequiresScopeExpansion(rs, []string{"*"}))
+	suite.False(RequiresScopeExpansion(rs, []string{"users:read", "users.*"}))
+	suite.False(RequiresScopeExpansion(nil, []string{"admin"}))
+}
+
+func (suite *ScopeImplicationTestSuite) TestExpandScopes_FollowsImplicationsTransitively() {
+	rs := &ResourceServer{
+		Delimiter: ":",
+		ScopeImplications: map[string][]string{
+			"admin":        {"users:manage", "groups:read"},
+			"users:manage": {"users:read", "users:write"},
+		},
+	}
+
+	result := expandScopes(rs, nil, []string{"openid", "admin", "users:read"})
+
+	suite.Equal([]string{"openid", "admin", "users:read", "users:manage", "groups:read", "users:write"}, result)
+}
+
+func (suite *ScopeImplicationTestSuite) TestExpandScopes_ExpandsWildcards() {
+	rs := &ResourceServer{Delimiter: ":"}
+	permissions := []string{"groups:read", "users", "users:profile:read", "users:read"}
+
+	suite.Equal([]string{"users:*", "users:profile:read", "users:read"},
+		expandScopes(rs, permissions, []string{"users:*"}))
+	suite.Equal([]string{"*", "groups:read", "users", "users:profile:read", "users:read"},
+		expandScopes(rs, permissions, []string{"*"}))
+}
+
+func (suite *ScopeImplicationTestSuite) TestValidateScopeImplications_Valid() {
+	implications := map[string][]string{
+		"admin":   {"users:*", "groups:read"},
+		"users:*": {"profile:read"},
+		"*":       {"audit:read"},
+	}
+
+	suite.Nil(validateScopeImplications(implications, ":"))
+	suite.Nil(validateScopeImplications(nil, ":"))
+}
+
+func (suite *ScopeImplicationTestSuite) TestValidateScopeImplications_Invalid() {
+	testCases := []struct {
+		name         string
+		implications map[string][]string
+	}{
+		{name: "EmptyScope", implications: map[string][]string{"": {"users:read"}}},
+		{name: "EmptyImpliedScope", implications: map[string][]string{"admin": {""}}},
+		{name: "NoImpliedScopes", implications: map[string][]string{"admin": nil}},
+		{name: "WildcardNotLast", implications: map[string][]string{"admin": {"users:*:read"}}},
+		{name: "PartialWildcardSegment", implications: map[string][]string{"admin": {"users:re*"}}},
+		{name: "InvalidCharacter", implications: map[string][]string{"admin users": {"users:read"}}},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := validateScopeImplications(tc.implications, ":")
+
+			suite.NotNil(err)
+			suite.Equal(ErrorInvalidScopeImplication.Code, err.Code)
+		})
+	}
+}
+
+func (suite *ScopeImplicationTestSuite) TestValidateScopeImplications_Cycles() {
+	testCases := []struct {
+		name         string
+		implications map[string][]string
+	}{
+		{name: "SelfImplication", implications: map[string][]string{"admin": {"admin"}}},
+		{name: "IndirectCycle", implications: map[string][]string{
+			"a": {"b"}, "b": {"c"}, "c": {"a"},
+		}},
+		{name: "ThroughWildcard", implications: map[string][]string{
+			"admin": {"users:*"}, "users:manage": {"admin"},
+		}},
+		{name: "WildcardImpliesCoveredScope", implications: map[string][]string{
+			"users:*": {"users:read"}, "users:read": {"admin"}, "admin": {"users:*"},
+		}},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := validateScopeImplications(tc.implications, ":")
+
+			suite.NotNil(err)
+			suite.Equal(ErrorCyclicScopeImplication.Code, err.Code)
+		})
+	}
+}
@@ -94,6 +94,17 @@ type ResourceServiceInterface interface {
 		ctx context.Context, resourceServerID string, permissions []string,
 	) ([]string, *serviceerror.ServiceError)
 
+	// GetResourceServerPermissions returns the permissions of all resources and actions of a resource
+	// server.
+	GetResourceServerPermissions(ctx context.Context, resourceServerID string) ([]string, *serviceerror.ServiceError)
+
+	// ExpandScopes returns the scopes followed by every scope they imply on the resource server,
+	// following the scope implication rules transitively and replacing wildcards with the
+	// permissions they cover.
+	ExpandScopes(
+		ctx context.Context, resourceServer *ResourceServer, scopes []string,
+	) ([]string, *serviceerror.ServiceError)
+
 	// FindResourceServersByPermissions returns registered resource servers that define at least
 	// one permission in the supplied set. Used by the OAuth2 token layer to populate aud when no
 	// explicit resource parameter was supplied.
@@ -200,6 +211,16 @@ func (rs *resourceService) CreateResourceServer(
 		resourceServer.Delimiter = rs.defaultDelimiter
 	}
 
+	if svcErr := validateScopeImplications(resourceServer.ScopeImplications, resourceServer.Delimiter); svcErr != nil {
+		return nil, svcErr
+	}
+
+	tokenFormat, formatErr := resolveTokenFormat(resourceServer.TokenFormat)
+	if formatErr != nil {
+		return nil, formatErr
+	}
+	resourceServer.TokenFormat = tokenFormat
+
 	// Validate handle format and ensure it does not contain the delimiter character
 	if resourceServer.Handle != "" {
 		if svcErr := validateHandle(resourceServer.Handle, resourceServer.Delimiter); svcErr != nil {
@@ -245,6 +266,9 @@ func (rs *resourceService) CreateResourceServer(
 			Identifier:  resourceServer.Identifier,
 			OUID:        resourceServer.OUID,
 			Delimiter:   resourceServer.Delimiter,
+			TokenFormat: resourceServer.TokenFormat,
+
+			ScopeImplications: resourceServer.ScopeImplications,
 		}
 		return nil
 	}); err != nil {
@@ -370,6 +394,20 @@ func (rs *resourceService) UpdateResourceServer(
 	// Delimiter is always preserved from the existing record
 	resourceServer.Delimiter = existingResServer.Delimiter
 
+	if svcErr := validateScopeImplications(resourceServer.ScopeImplications, resourceServer.Delimiter); svcErr != nil {
+		return nil, svcErr
+	}
+
+	// Token format: preserve existing if not provided
+	if resourceServer.TokenFormat == "" {
+		resourceServer.TokenFormat = existingResServer.TokenFormat
+	}
+	tokenFormat, formatErr := resolveTokenFormat(resourceServer.TokenFormat)
+	if formatErr != nil {
+		return nil, formatErr
+	}
+	resourceServer.TokenFormat = tokenFormat
+
 	// Handle: preserve existing if not provided; validate and check uniqueness if changed
 	if resourceServer.Handle == "" {
 		resourceServer.Handle = existingResServer.Handle
@@ -457,6 +495,9 @@ func (rs *resourceService) UpdateResourceServer(
 			Identifier:  resourceServer.Identifier,
 			OUID:        resourceServer.OUID,
 			Delimiter:   resourceServer.Delimiter,
+			TokenFormat: resourceServer.TokenFormat,
+
+			ScopeImplications: resourceServer.ScopeImplications,
 		}
 		return nil
 	}); err != nil {
@@ -1296,6 +1337,54 @@ func (rs *resourceService) ValidatePermissions(
 	return invalidPermissions, nil
 }
 
+// GetResourceServerPermissions returns the permissions of all resources and actions of a resource
+// server.
+func (rs *resourceService) GetResourceServerPermissions(
+	ctx context.Context, resourceServerID string,
+) ([]string, *serviceerror.ServiceError) {
+	if resourceServerID == "" {
+		return nil, &ErrorMissingID
+	}
+
+	permissions, err := rs.resourceStore.GetPermissions(ctx, resourceServerID)
+	if err != nil {
+		rs.logger.Error("Failed to get permissions of resource server",
+			log.String("resourceServerId", resourceServerID), log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+	if permissions == nil {
+		permissions = []string{}
+	}
+	return permissions, nil
+}
+
+// ExpandScopes returns the scopes followed by every scope they imply on the resource server. The
+// permissions of the resource server are only read when a wildcard scope has to be expanded.
+func (rs *resourceService) ExpandScopes(
+	ctx context.Context, resourceServer *ResourceServer, scopes []string,
+) ([]string, *serviceerror.ServiceError) {
+	if !RequiresScopeExpansion(resourceServer, scopes) {
+		return scopes, nil
+	}
+
+	var permissions []string
+	for _, scope := range expandScopes(resourceServer, nil, scopes) {
+		if !isWildcardScope(scope, resourceServer.Delimiter) {
+			continue
+		}
+		var err error
+		permissions, err = rs.resourceStore.GetPermissions(ctx, resourceServer.ID)
+		if err != nil {
+			rs.logger.Error("Failed to get permissions of resource server",
+				log.String("resourceServerId", resourceServer.ID), log.Error(err))
+			return nil, &serviceerror.InternalServerError
+		}
+		break
+	}
+
+	return expandScopes(resourceServer, permissions, scopes), nil
+}
+
 // FindResourceServersByPermissions returns registered resource servers that define at least one
 // permission in the supplied set.
 func (rs *resourceService) FindResourceServersByPermissions(
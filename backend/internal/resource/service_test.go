@@ -417,6 +417,71 @@ func (suite *ResourceServiceTestSuite) TestCreateResourceServer_DelimiterInHandl
 	suite.Equal(ErrorDelimiterInResourceServerHandle.Code, err.Code)
 }
 
+func (suite *ResourceServiceTestSuite) TestCreateResourceServer_InvalidScopeImplications() {
+	testCases := []struct {
+		name          string
+		implications  map[string][]string
+		expectedError serviceerror.ServiceError
+	}{
+		{
+			name:          "InvalidScope",
+			implications:  map[string][]string{"users:*:read": {"users:read"}},
+			expectedError: ErrorInvalidScopeImplication,
+		},
+		{
+			name:          "NoImpliedScopes",
+			implications:  map[string][]string{"admin": {}},
+			expectedError: ErrorInvalidScopeImplication,
+		},
+		{
+			name:          "Cycle",
+			implications:  map[string][]string{"admin": {"users:*"}, "users:manage": {"admin"}},
+			expectedError: ErrorCyclicScopeImplication,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			rs := ResourceServer{
+				Name:              "test-rs",
+				Delimiter:         ":",
+				OUID:              "ou-123",
+				ScopeImplications: tc.implications,
+			}
+			suite.mockOU.On("GetOrganizationUnit", mock.Anything, "ou-123").
+				Return(oupkg.OrganizationUnit{ID: "ou-123"}, nil)
+			suite.mockStore.On("CheckResourceServerNameExists", mock.Anything, "test-rs").
+				Return(false, nil)
+
+			result, err := suite.service.CreateResourceServer(context.Background(), rs)
+
+			suite.Nil(result)
+			suite.NotNil(err)
+			suite.Equal(tc.expectedError.Code, err.Code)
+		})
+	}
+}
+
+func (suite *ResourceServiceTestSuite) TestCreateResourceServer_InvalidTokenFormat() {
+	rs := ResourceServer{
+		Name:        "test-rs",
+		Delimiter:   ":",
+		OUID:        "ou-123",
+		TokenFormat: "opaque",
+	}
+	suite.mockOU.On("GetOrganizationUnit", mock.Anything, "ou-123").
+		Return(oupkg.OrganizationUnit{ID: "ou-123"}, nil)
+	suite.mockStore.On("CheckResourceServerNameExists", mock.Anything, "test-rs").
+		Return(false, nil)
+
+	result, err := suite.service.CreateResourceServer(context.Background(), rs)
+
+	suite.Nil(result)
+	suite.NotNil(err)
+	suite.Equal(ErrorInvalidTokenFormat.Code, err.Code)
+}
+
 func (suite *ResourceServiceTestSuite) TestCreateResourceServer_DelimiterInRSHandleDefaultDelimiter() {
 	rs := ResourceServer{
 		Name:   "test-rs",
@@ -4348,6 +4413,92 @@ func (suite *ResourceServiceTestSuite) TestValidatePermissions() {
 	}
 }
 
+// ExpandScopes Tests
+
+func (suite *ResourceServiceTestSuite) TestExpandScopes_NoImplications() {
+	rs := &ResourceServer{ID: "rs-1", Delimiter: ":"}
+
+	result, err := suite.service.ExpandScopes(context.Background(), rs, []string{"users:read"})
+
+	suite.Nil(err)
+	suite.Equal([]string{"users:read"}, result)
+	suite.mockStore.AssertNotCalled(suite.T(), "GetPermissions", mock.Anything, mock.Anything)
+}
+
+func (suite *ResourceServiceTestSuite) TestExpandScopes_ImplicationsWithoutWildcard() {
+	rs := &ResourceServer{
+		ID:                "rs-1",
+		Delimiter:         ":",
+		ScopeImplications: map[string][]string{"admin": {"users:read", "users:write"}},
+	}
+
+	result, err := suite.service.ExpandScopes(context.Background(), rs, []string{"admin"})
+
+	suite.Nil(err)
+	suite.Equal([]string{"admin", "users:read", "users:write"}, result)
+	suite.mockStore.AssertNotCalled(suite.T(), "GetPermissions", mock.Anything, mock.Anything)
+}
+
+func (suite *ResourceServiceTestSuite) TestExpandScopes_ImpliedWildcard() {
+	rs := &ResourceServer{
+		ID:                "rs-1",
+		Delimiter:         ":",
+		ScopeImplications: map[string][]string{"admin": {"users:*"}},
+	}
+	suite.mockStore.On("GetPermissions", mock.Anything, "rs-1").
+		Return([]string{"groups:read", "users:read", "users:write"}, nil)
+
+	result, err := suite.service.ExpandScopes(context.Background(), rs, []string{"admin"})
+
+	suite.Nil(err)
+	suite.Equal([]string{"admin", "users:*", "users:read", "users:write"}, result)
+}
+
+func (suite *ResourceServiceTestSuite) TestExpandScopes_StoreError() {
+	rs := &ResourceServer{ID: "rs-1", Delimiter: ":"}
+	suite.mockStore.On("GetPermissions", mock.Anything, "rs-1").
+		Return(nil, errors.New("db error"))
+
+	result, err := suite.service.ExpandScopes(context.Background(), rs, []string{"users:*"})
+
+	suite.Nil(result)
+	suite.NotNil(err)
+	suite.Equal(serviceerror.InternalServerError.Code, err.Code)
+}
+
+// GetResourceServerPermissions Tests
+
+func (suite *ResourceServiceTestSuite) TestGetResourceServerPermissions() {
+	suite.mockStore.On("GetPermissions", mock.Anything, "rs-1").
+		Return([]string{"users:read", "users:write"}, nil)
+
+	result, err := suite.service.GetResourceServerPermissions(context.Background(), "rs-1")
+
+	suite.Nil(err)
+	suite.Equal([]string{"users:read", "users:write"}, result)
+}
+
+func (suite *ResourceServiceTestSuite) TestGetResourceServerPermissions_NoPermissions() {
+	suite.mockStore.On("GetPermissions", mock.Anything, "rs-1").Return(nil, nil)
+
+	result, err := suite.service.GetResourceServerPermissions(context.Background(), "rs-1")
+
+	suite.Nil(err)
+	suite.NotNil(result)
+	suite.Empty(result)
+}
+
+func (suite *ResourceServiceTestSuite) TestGetResourceServerPermissions_Errors() {
+	result, err := suite.service.GetResourceServerPermissions(context.Background(), "")
+	suite.Nil(result)
+	suite.Equal(ErrorMissingID.Code, err.Code)
+
+	suite.mockStore.On("GetPermissions", mock.Anything, "rs-1").Return(nil, errors.New("db error"))
+	result, err = suite.service.GetResourceServerPermissions(context.Background(), "rs-1")
+	suite.Nil(result)
+	suite.Equal(serviceerror.InternalServerError.Code, err.Code)
+}
+
 // Test cases for declarative resource functionality
 
 func (suite *ResourceServiceTestSuite) TestIsResourceServerDeclarative_True() {
@@ -24,6 +24,7 @@ import (
 	"fmt"
 
 	"github.com/thunder-id/thunderid/internal/system/config"
+	sysContext "github.com/thunder-id/thunderid/internal/system/context"
 	"github.com/thunder-id/thunderid/internal/system/database/provider"
 	"github.com/thunder-id/thunderid/internal/system/transaction"
 )
@@ -75,6 +76,7 @@ type resourceStoreInterface interface {
 		ctx context.Context, resServerID string, resID *string, handle string,
 	) (bool, error)
 	ValidatePermissions(ctx context.Context, resServerID string, permissions []string) ([]string, error)
+	GetPermissions(ctx context.Context, resServerID string) ([]string, error)
 	FindResourceServersByPermissions(ctx context.Context, permissions []string) ([]ResourceServer, error)
 }
 
@@ -86,7 +88,9 @@ type resourceStore struct {
 
 // resourceServerProperties represents the JSON structure of PROPERTIES column.
 type resourceServerProperties struct {
-	Delimiter string `json:"delimiter"`
+	Delimiter         string              `json:"delimiter"`
+	TokenFormat       string              `json:"tokenFormat,omitempty"`
+	ScopeImplications map[string][]string `json:"scopeImplications,omitempty"`
 }
 
 // newResourceStore creates a new instance of resourceStore.
@@ -104,6 +108,7 @@ func newResourceStore() (resourceStoreInterface, transaction.Transactioner, erro
 
 // CreateResourceServer creates a new resource server in the database.
 func (s *resourceStore) CreateResourceServer(ctx context.Context, id string, rs ResourceServer) error {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
 	return s.withDBClient(func(dbClient provider.DBClientInterface) error {
 		_, err := dbClient.ExecuteContext(
 			ctx,
@@ -115,7 +120,7 @@ func (s *resourceStore) CreateResourceServer(ctx context.Context, id string, rs
 			resolveNullableString(rs.Handle),
 			resolveNullableString(rs.Identifier),
 			buildPropertiesJSON(rs),
-			s.deploymentID,
+			deploymentID,
 		)
 		if err != nil {
 			return fmt.Errorf("failed to create resource server: %w", err)
@@ -127,9 +132,10 @@ func (s *resourceStore) CreateResourceServer(ctx context.Context, id string, rs
 
 // GetResourceServer retrieves a resource server by UUID.
 func (s *resourceStore) GetResourceServer(ctx context.Context, id string) (ResourceServer, error) {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
 	var rs ResourceServer
 	err := s.withDBClient(func(dbClient provider.DBClientInterface) error {
-		results, err := dbClient.QueryContext(ctx, queryGetResourceServerByID, id, s.deploymentID)
+		results, err := dbClient.QueryContext(ctx, queryGetResourceServerByID, id, deploymentID)
 		if err != nil {
 			return fmt.Errorf("failed to get resource server: %w", err)
 		}
@@ -146,9 +152,10 @@ func (s *resourceStore) GetResourceServer(ctx context.Context, id string) (Resou
 
 // GetResourceServerList retrieves a list of resource servers with pagination.
 func (s *resourceStore) GetResourceServerList(ctx context.Context, limit, offset int) ([]ResourceServer, error) {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
 	var resourceServers []ResourceServer
 	err := s.withDBClient(func(dbClient provider.DBClientInterface) error {
-		results, err := dbClient.QueryContext(ctx, queryGetResourceServerList, limit, offset, s.deploymentID)
+		results, err := dbClient.QueryContext(ctx, queryGetResourceServerList, limit, offset, deploymentID)
 		if err != nil {
 			return fmt.Errorf("failed to get resource server list: %w", err)
 		}
@@ -172,9 +179,10 @@ func (s *resourceStore) GetResourceServerList(ctx context.Context, limit, offset
 
 // GetResourceServerListCount retrieves the total count of resource servers.
 func (s *resourceStore) GetResourceServerListCount(ctx context.Context) (int, error) {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
 	var count int
 	err := s.withDBClient(func(dbClient provider.DBClientInterface) error {
-		results, err := dbClient.QueryContext(ctx, queryGetResourceServerListCount, s.deploymentID)
+		results, err := dbClient.QueryContext(ctx, queryGetResourceServerListCount, deploymentID)
 		if err != nil {
 			return fmt.Errorf("failed to get resource server count: %w", err)
 		}
@@ -187,6 +195,7 @@ func (s *resourceStore) GetResourceServerListCount(ctx context.Context) (int, er
 
 // UpdateResourceServer updates a resource server.
 func (s *resourceStore) UpdateResourceServer(ctx context.Context, id string, rs ResourceServer) error {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
 	return s.withDBClient(func(dbClient provider.DBClientInterface) error {
 		_, err := dbClient.ExecuteContext(
 			ctx,
@@ -198,7 +207,7 @@ func (s *resourceStore) UpdateResourceServer(ctx context.Context, id string, rs
 			resolveNullableString(rs.Identifier),
 			buildPropertiesJSON(rs),
 			id,
-			s.deploymentID,
+			deploymentID,
 		)
 		if err != nil {
 			return fmt.Errorf("failed to update resource server: %w", err)
@@ -210,8 +219,9 @@ func (s *resourceStore) UpdateResourceServer(ctx context.Context, id string, rs
 
 // DeleteResourceServer deletes a resource server.
 func (s *resourceStore) DeleteResourceServer(ctx context.Context, id string) error {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
 	return s.withDBClient(func(dbClient provider.DBClientInterface) error {
-		_, err := dbClient.ExecuteContext(ctx, queryDeleteResourceServer, id, s.deploymentID)
+		_, err := dbClient.ExecuteContext(ctx, queryDeleteResourceServer, id, deploymentID)
 		if err != nil {
 			return fmt.Errorf("failed to delete resource server: %w", err)
 		}
@@ -222,9 +232,10 @@ func (s *resourceStore) DeleteResourceServer(ctx context.Context, id string) err
 
 // CheckResourceServerNameExists checks if a resource server name exists.
 func (s *resourceStore) CheckResourceServerNameExists(ctx context.Context, name string) (bool, error) {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
 	var exists bool
 	err := s.withDBClient(func(dbClient provider.DBClientInterface) error {
-		results, err := dbClient.QueryContext(ctx, queryCheckResourceServerNameExists, name, s.deploymentID)
+		results, err := dbClient.QueryContext(ctx, queryCheckResourceServerNameExists, name, deploymentID)
 		if err != nil {
 			return fmt.Errorf("failed to check resource server name: %w", err)
 		}
@@ -237,9 +248,10 @@ func (s *resourceStore) CheckResourceServerNameExists(ctx context.Context, name
 
 // CheckResourceServerHandleExists checks if a resource server handle exists.
 func (s *resourceStore) CheckResourceServerHandleExists(ctx context.Context, handle string) (bool, error) {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
 	var exists bool
 	err := s.withDBClient(func(dbClient provider.DBClientInterface) error {
-		results, err := dbClient.QueryContext(ctx, queryCheckResourceServerHandleExists, handle, s.deploymentID)
+		results, err := dbClient.QueryContext(ctx, queryCheckResourceServerHandleExists, handle, deploymentID)
 		if err != nil {
 			return fmt.Errorf("failed to check resource server handle: %w", err)
 		}
@@ -252,9 +264,10 @@ func (s *resourceStore) CheckResourceServerHandleExists(ctx context.Context, han
 
 // CheckResourceServerIdentifierExists checks if a resource server identifier exists.
 func (s *resourceStore) CheckResourceServerIdentifierExists(ctx context.Context, identifier string) (bool, error) {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
 	var exists bool
 	err := s.withDBClient(func(dbClient provider.DBClientInterface) error {
-		results, err := dbClient.QueryContext(ctx, queryCheckResourceServerIdentifierExists, identifier, s.deploymentID)
+		results, err := dbClient.QueryContext(ctx, queryCheckResourceServerIdentifierExists, identifier, deploymentID)
 		if err != nil {
 			return fmt.Errorf("failed to check resource server identifier: %w", err)
 		}
@@ -267,9 +280,10 @@ func (s *resourceStore) CheckResourceServerIdentifierExists(ctx context.Context,
 
 // GetResourceServerByIdentifier retrieves a resource server by its identifier.
 func (s *resourceStore) GetResourceServerByIdentifier(ctx context.Context, identifier string) (ResourceServer, error) {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
 	var rs ResourceServer
 	err := s.withDBClient(func(dbClient provider.DBClientInterface) error {
-		results, err := dbClient.QueryContext(ctx, queryGetResourceServerByIdentifier, identifier, s.deploymentID)
+		results, err := dbClient.QueryContext(ctx, queryGetResourceServerByIdentifier, identifier, deploymentID)
 		if err != nil {
 			return fmt.Errorf("failed to get resource server by identifier: %w", err)
 		}
@@ -286,10 +300,11 @@ func (s *resourceStore) GetResourceServerByIdentifier(ctx context.Context, ident
 
 // CheckResourceServerHasDependencies checks if resource server has dependencies.
 func (s *resourceStore) CheckResourceServerHasDependencies(ctx context.Context, resServerID string) (bool, error) {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
 	var hasDeps bool
 	err := s.withDBClient(func(dbClient provider.DBClientInterface) error {
 		results, err := dbClient.QueryContext(
-			ctx, queryCheckResourceServerHasDependencies, resServerID, s.deploymentID,
+			ctx, queryCheckResourceServerHasDependencies, resServerID, deploymentID,
 		)
 		if err != nil {
 			return fmt.Errorf("failed to check dependencies: %w", err)
@@ -317,6 +332,7 @@ func (s *resourceStore) CreateResource(
 	parentID *string,
 	res Resource,
 ) error {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
 	return s.withDBClient(func(dbClient provider.DBClientInterface) error {
 		_, err := dbClient.ExecuteContext(
 			ctx,
@@ -329,7 +345,7 @@ func (s *resourceStore) CreateResource(
 			res.Permission,  // $6: PERMISSION
 			"{}",            // $7: PROPERTIES (empty JSON).
 			parentID,        // $8: PARENT_RESOURCE_ID (UUID FK or NULL)
-			s.deploymentID,  // $9: DEPLOYMENT_ID
+			deploymentID,    // $9: DEPLOYMENT_ID
 		)
 		if err != nil {
 			return fmt.Errorf("failed to create resource: %w", err)
@@ -341,9 +357,10 @@ func (s *resourceStore) CreateResource(
 
 // GetResource retrieves a resource by UUID.
 func (s *resourceStore) GetResource(ctx context.Context, id string, resServerID string) (Resource, error) {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
 	var res Resource
 	err := s.withDBClient(func(dbClient provider.DBClientInterface) error {
-		results, err := dbClient.QueryContext(ctx, queryGetResourceByID, id, resServerID, s.deploymentID)
+		results, err := dbClient.QueryContext(ctx, queryGetResourceByID, id, resServerID, deploymentID)
 		if err != nil {
 			return fmt.Errorf("failed to get resource: %w", err)
 		}
@@ -362,10 +379,11 @@ func (s *resourceStore) GetResource(ctx context.Context, id string, resServerID
 func (s *resourceStore) GetResourceList(
 	ctx context.Context, resServerID string, limit, offset int,
 ) ([]Resource, error) {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
 	var resources []Resource
 	err := s.withDBClient(func(dbClient provider.DBClientInterface) error {
 		results, err := dbClient.QueryContext(
-			ctx, queryGetResourceList, resServerID, limit, offset, s.deploymentID,
+			ctx, queryGetResourceList, resServerID, limit, offset, deploymentID,
 		)
 		if err != nil {
 			return fmt.Errorf("failed to get resource list: %w", err)
@@ -393,6 +411,7 @@ func (s *resourceStore) GetResourceListByParent(
 	ctx context.Context,
 	resServerID string, parentID *string, limit, offset int,
 ) ([]Resource, error) {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
 	var resources []Resource
 	err := s.withDBClient(func(dbClient provider.DBClientInterface) error {
 		var results []map[string]interface{}
@@ -401,12 +420,12 @@ func (s *resourceStore) GetResourceListByParent(
 		if parentID == nil {
 			results, err = dbClient.QueryContext(
 				ctx,
-				queryGetResourceListByNullParent, resServerID, limit, offset, s.deploymentID,
+				queryGetResourceListByNullParent, resServerID, limit, offset, deploymentID,
 			)
 		} else {
 			results, err = dbClient.QueryContext(
 				ctx,
-				queryGetResourceListByParent, resServerID, *parentID, limit, offset, s.deploymentID,
+				queryGetResourceListByParent, resServerID, *parentID, limit, offset, deploymentID,
 			)
 		}
 
@@ -433,9 +452,10 @@ func (s *resourceStore) GetResourceListByParent(
 
 // GetResourceListCount retrieves the count of all resources.
 func (s *resourceStore) GetResourceListCount(ctx context.Context, resServerID string) (int, error) {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
 	var count int
 	err := s.withDBClient(func(dbClient provider.DBClientInterface) error {
-		results, err := dbClient.QueryContext(ctx, queryGetResourceListCount, resServerID, s.deploymentID)
+		results, err := dbClient.QueryContext(ctx, queryGetResourceListCount, resServerID, deploymentID)
 		if err != nil {
 			return fmt.Errorf("failed to get resource count: %w", err)
 		}
@@ -450,6 +470,7 @@ func (s *resourceStore) GetResourceListCount(ctx context.Context, resServerID st
 func (s *resourceStore) GetResourceListCountByParent(
 	ctx context.Context, resServerID string, parentID *string,
 ) (int, error) {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
 	var count int
 	err := s.withDBClient(func(dbClient provider.DBClientInterface) error {
 		var results []map[string]interface{}
@@ -457,12 +478,12 @@ func (s *resourceStore) GetResourceListCountByParent(
 		// Treat nil parent ID as top-level resources
 		if parentID == nil {
 			results, err = dbClient.QueryContext(
-				ctx, queryGetResourceListCountByNullParent, resServerID, s.deploymentID,
+				ctx, queryGetResourceListCountByNullParent, resServerID, deploymentID,
 			)
 		} else {
 			results, err = dbClient.QueryContext(
 				ctx,
-				queryGetResourceListCountByParent, resServerID, *parentID, s.deploymentID)
+				queryGetResourceListCountByParent, resServerID, *parentID, deploymentID)
 		}
 
 		if err != nil {
@@ -477,6 +498,7 @@ func (s *resourceStore) GetResourceListCountByParent(
 
 // UpdateResource updates a resource.
 func (s *resourceStore) UpdateResource(ctx context.Context, id string, resServerID string, res Resource) error {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
 	return s.withDBClient(func(dbClient provider.DBClientInterface) error {
 		_, err := dbClient.ExecuteContext(
 			ctx,
@@ -486,7 +508,7 @@ func (s *resourceStore) UpdateResource(ctx context.Context, id string, resServer
 			"{}",            // $3: PROPERTIES (empty JSON).
 			id,              // $4: RESOURCE_ID
 			resServerID,     // $5: RESOURCE_SERVER_ID (UUID FK)
-			s.deploymentID,  // $6: DEPLOYMENT_ID
+			deploymentID,    // $6: DEPLOYMENT_ID
 		)
 		if err != nil {
 			return fmt.Errorf("failed to update resource: %w", err)
@@ -500,6 +522,7 @@ func (s *resourceStore) UpdateResource(ctx context.Context, id string, resServer
 func (s *resourceStore) UpdateResourcePermission(
 	ctx context.Context, id string, resServerID string, permission string,
 ) error {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
 	return s.withDBClient(func(dbClient provider.DBClientInterface) error {
 		_, err := dbClient.ExecuteContext(
 			ctx,
@@ -507,7 +530,7 @@ func (s *resourceStore) UpdateResourcePermission(
 			permission,
 			id,
 			resServerID,
-			s.deploymentID,
+			deploymentID,
 		)
 		if err != nil {
 			return fmt.Errorf("failed to update resource permission: %w", err)
@@ -518,8 +541,9 @@ func (s *resourceStore) UpdateResourcePermission(
 
 // DeleteResource deletes a resource.
 func (s *resourceStore) DeleteResource(ctx context.Context, id string, resServerID string) error {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
 	return s.withDBClient(func(dbClient provider.DBClientInterface) error {
-		_, err := dbClient.ExecuteContext(ctx, queryDeleteResource, id, resServerID, s.deploymentID)
+		_, err := dbClient.ExecuteContext(ctx, queryDeleteResource, id, resServerID, deploymentID)
 		if err != nil {
 			return fmt.Errorf("failed to delete resource: %w", err)
 		}
@@ -533,6 +557,7 @@ func (s *resourceStore) CheckResourceHandleExists(
 	ctx context.Context,
 	resServerID string, handle string, parentID *string,
 ) (bool, error) {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
 	var exists bool
 	err := s.withDBClient(func(dbClient provider.DBClientInterface) error {
 		var results []map[string]interface{}
@@ -540,13 +565,13 @@ func (s *resourceStore) CheckResourceHandleExists(
 		if parentID == nil {
 			results, err = dbClient.QueryContext(
 				ctx,
-				queryCheckResourceHandleExistsUnderNullParent, resServerID, handle, s.deploymentID,
+				queryCheckResourceHandleExistsUnderNullParent, resServerID, handle, deploymentID,
 			)
 		} else {
 			results, err = dbClient.QueryContext(
 				ctx,
 				queryCheckResourceHandleExistsUnderParent, resServerID, handle, *parentID,
-				s.deploymentID,
+				deploymentID,
 			)
 		}
 
@@ -562,9 +587,10 @@ func (s *resourceStore) CheckResourceHandleExists(
 
 // CheckResourceHasDependencies checks if resource has dependencies.
 func (s *resourceStore) CheckResourceHasDependencies(ctx context.Context, resID string) (bool, error) {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
 	var hasDeps bool
 	err := s.withDBClient(func(dbClient provider.DBClientInterface) error {
-		results, err := dbClient.QueryContext(ctx, queryCheckResourceHasDependencies, resID, s.deploymentID)
+		results, err := dbClient.QueryContext(ctx, queryCheckResourceHasDependencies, resID, deploymentID)
 		if err != nil {
 			return fmt.Errorf("failed to check dependencies: %w", err)
 		}
@@ -577,10 +603,11 @@ func (s *resourceStore) CheckResourceHasDependencies(ctx context.Context, resID
 
 // CheckCircularDependency checks if setting a parent would create circular dependency.
 func (s *resourceStore) CheckCircularDependency(ctx context.Context, resourceID, newParentID string) (bool, error) {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
 	var hasCircular bool
 	err := s.withDBClient(func(dbClient provider.DBClientInterface) error {
 		results, err := dbClient.QueryContext(
-			ctx, queryCheckCircularDependency, newParentID, resourceID, s.deploymentID,
+			ctx, queryCheckCircularDependency, newParentID, resourceID, deploymentID,
 		)
 		if err != nil {
 			return fmt.Errorf("failed to check circular dependency: %w", err)
@@ -602,6 +629,7 @@ func (s *resourceStore) CreateAction(
 	resID *string,
 	action Action,
 ) error {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
 	return s.withDBClient(func(dbClient provider.DBClientInterface) error {
 		_, err := dbClient.ExecuteContext(
 			ctx,
@@ -614,7 +642,7 @@ func (s *resourceStore) CreateAction(
 			action.Description, // $6: DESCRIPTION
 			action.Permission,  // $7: PERMISSION
 			"{}",               // $8: PROPERTIES (empty JSON).
-			s.deploymentID,     // $9: DEPLOYMENT_ID
+			deploymentID,       // $9: DEPLOYMENT_ID
 		)
 		if err != nil {
 			return fmt.Errorf("failed to create action: %w", err)
@@ -630,11 +658,12 @@ func (s *resourceStore) CreateAction(
 func (s *resourceStore) GetAction(
 	ctx context.Context, id string, resServerID string, resID *string,
 ) (Action, error) {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
 	var action Action
 	err := s.withDBClient(func(dbClient provider.DBClientInterface) error {
 		// Single unified query handles both resource server and resource level via nullable parameter
 		results, err := dbClient.QueryContext(
-			ctx, queryGetActionByID, id, resServerID, resID, s.deploymentID,
+			ctx, queryGetActionByID, id, resServerID, resID, deploymentID,
 		)
 		if err != nil {
 			return fmt.Errorf("failed to get action: %w", err)
@@ -655,11 +684,12 @@ func (s *resourceStore) GetActionList(
 	ctx context.Context,
 	resServerID string, resID *string, limit, offset int,
 ) ([]Action, error) {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
 	var actions []Action
 	err := s.withDBClient(func(dbClient provider.DBClientInterface) error {
 		results, err := dbClient.QueryContext(
 			ctx, queryGetActionList, resServerID, resID, limit, offset,
-			s.deploymentID,
+			deploymentID,
 		)
 		if err != nil {
 			return fmt.Errorf("failed to get action list: %w", err)
@@ -686,10 +716,11 @@ func (s *resourceStore) GetActionList(
 func (s *resourceStore) GetActionListCount(
 	ctx context.Context, resServerID string, resID *string,
 ) (int, error) {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
 	var count int
 	err := s.withDBClient(func(dbClient provider.DBClientInterface) error {
 		results, err := dbClient.QueryContext(
-			ctx, queryGetActionListCount, resServerID, resID, s.deploymentID,
+			ctx, queryGetActionListCount, resServerID, resID, deploymentID,
 		)
 		if err != nil {
 			return fmt.Errorf("failed to get action count: %w", err)
@@ -705,6 +736,7 @@ func (s *resourceStore) GetActionListCount(
 func (s *resourceStore) UpdateAction(
 	ctx context.Context, id string, resServerID string, resID *string, action Action,
 ) error {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
 	return s.withDBClient(func(dbClient provider.DBClientInterface) error {
 		// Single unified query handles both levels via nullable parameter
 		_, err := dbClient.ExecuteContext(
@@ -716,7 +748,7 @@ func (s *resourceStore) UpdateAction(
 			id,                 // $4: ACTION_ID
 			resServerID,        // $5: RESOURCE_SERVER_ID (UUID FK)
 			resID,              // $6: RESOURCE_ID (UUID FK or NULL)
-			s.deploymentID,     // $7: DEPLOYMENT_ID
+			deploymentID,       // $7: DEPLOYMENT_ID
 		)
 		if err != nil {
 			return fmt.Errorf("failed to update action: %w", err)
@@ -730,15 +762,16 @@ func (s *resourceStore) UpdateAction(
 func (s *resourceStore) UpdateActionPermission(
 	ctx context.Context, id string, resServerID string, resID *string, permission string,
 ) error {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
 	return s.withDBClient(func(dbClient provider.DBClientInterface) error {
 		_, err := dbClient.ExecuteContext(
 			ctx,
 			queryUpdateActionPermission,
-			permission,     // $1: PERMISSION
-			id,             // $2: ACTION_ID
-			resServerID,    // $3: RESOURCE_SERVER_ID
-			resID,          // $4: RESOURCE_ID (nullable)
-			s.deploymentID, // $5: DEPLOYMENT_ID
+			permission,   // $1: PERMISSION
+			id,           // $2: ACTION_ID
+			resServerID,  // $3: RESOURCE_SERVER_ID
+			resID,        // $4: RESOURCE_ID (nullable)
+			deploymentID, // $5: DEPLOYMENT_ID
 		)
 		if err != nil {
 			return fmt.Errorf("failed to update action permission: %w", err)
@@ -751,14 +784,15 @@ func (s *resourceStore) UpdateActionPermission(
 func (s *resourceStore) DeleteAction(
 	ctx context.Context, id string, resServerID string, resID *string,
 ) error {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
 	return s.withDBClient(func(dbClient provider.DBClientInterface) error {
 		_, err := dbClient.ExecuteContext(
 			ctx,
 			queryDeleteAction,
-			id,             // $1: ACTION_ID
-			resServerID,    // $2: RESOURCE_SERVER_ID (UUID FK)
-			resID,          // $3: RESOURCE_ID (UUID FK or NULL)
-			s.deploymentID, // $4: DEPLOYMENT_ID
+			id,           // $1: ACTION_ID
+			resServerID,  // $2: RESOURCE_SERVER_ID (UUID FK)
+			resID,        // $3: RESOURCE_ID (UUID FK or NULL)
+			deploymentID, // $4: DEPLOYMENT_ID
 		)
 		if err != nil {
 			return fmt.Errorf("failed to delete action: %w", err)
@@ -772,10 +806,11 @@ func (s *resourceStore) DeleteAction(
 func (s *resourceStore) IsActionExist(
 	ctx context.Context, id string, resServerID string, resID *string,
 ) (bool, error) {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
 	var exists bool
 	err := s.withDBClient(func(dbClient provider.DBClientInterface) error {
 		results, err := dbClient.QueryContext(
-			ctx, queryCheckActionExists, id, resServerID, resID, s.deploymentID,
+			ctx, queryCheckActionExists, id, resServerID, resID, deploymentID,
 		)
 		if err != nil {
 			return fmt.Errorf("failed to check action existence: %w", err)
@@ -792,11 +827,12 @@ func (s *resourceStore) CheckActionHandleExists(
 	ctx context.Context,
 	resServerID string, resID *string, handle string,
 ) (bool, error) {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
 	var exists bool
 	err := s.withDBClient(func(dbClient provider.DBClientInterface) error {
 		results, err := dbClient.QueryContext(
 			ctx,
-			queryCheckActionHandleExists, resServerID, resID, handle, s.deploymentID,
+			queryCheckActionHandleExists, resServerID, resID, handle, deploymentID,
 		)
 		if err != nil {
 			return fmt.Errorf("failed to check action handle: %w", err)
@@ -813,6 +849,7 @@ func (s *resourceStore) CheckActionHandleExists(
 func (s *resourceStore) ValidatePermissions(
 	ctx context.Context, resServerID string, permissions []string,
 ) ([]string, error) {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
 	// Early return for empty input
 	if len(permissions) == 0 {
 		return []string{}, nil
@@ -832,7 +869,7 @@ func (s *resourceStore) ValidatePermissions(
 			ctx,
 			queryValidatePermissions,
 			resServerID,
-			s.deploymentID,
+			deploymentID,
 			string(permissionsJSON),
 		)
 		if err != nil {
@@ -858,11 +895,39 @@ func (s *resourceStore) ValidatePermissions(
 	return invalidPermissions, nil
 }
 
+// GetPermissions returns the permissions of all resources and actions of a resource server.
+func (s *resourceStore) GetPermissions(ctx context.Context, resServerID string) ([]string, error) {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
+
+	var permissions []string
+	err := s.withDBClient(func(dbClient provider.DBClientInterface) error {
+		results, err := dbClient.QueryContext(ctx, queryGetPermissions, resServerID, deploymentID)
+		if err != nil {
+			return fmt.Errorf("failed to get permissions: %w", err)
+		}
+
+		permissions = make([]string, 0, len(results))
+		for _, row := range results {
+			perm, ok := row["permission"].(string)
+			if !ok {
+				return fmt.Errorf("permission field is missing or invalid in query result")
+			}
+			permissions = append(permissions, perm)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return permissions, nil
+}
+
 // FindResourceServersByPermissions returns distinct resource servers that define at least one of
 // the supplied permissions.
 func (s *resourceStore) FindResourceServersByPermissions(
 	ctx context.Context, permissions []string,
 ) ([]ResourceServer, error) {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
 	if len(permissions) == 0 {
 		return []ResourceServer{}, nil
 	}
@@ -877,7 +942,7 @@ func (s *resourceStore) FindResourceServersByPermissions(
 		results, err := dbClient.QueryContext(
 			ctx,
 			queryFindResourceServersByPermissions,
-			s.deploymentID,
+			deploymentID,
 			string(permissionsJSON),
 		)
 		if err != nil {
@@ -979,14 +1044,24 @@ func resolveProperties(row map[string]interface{}, rs *ResourceServer) {
 		if len(propsBytes) > 0 {
 			if err := json.Unmarshal(propsBytes, &props); err == nil {
 				rs.Delimiter = props.Delimiter
+				rs.TokenFormat = props.TokenFormat
+				rs.ScopeImplications = props.ScopeImplications
 			}
 		}
 	}
+	// Resource servers stored before token formats were introduced use the default token format.
+	if rs.TokenFormat == "" {
+		rs.TokenFormat = TokenFormatAccessTokenJWT
+	}
 }
 
 // buildPropertiesJSON builds the PROPERTIES JSON for a ResourceServer.
 func buildPropertiesJSON(rs ResourceServer) interface{} {
-	properties := resourceServerProperties{Delimiter: rs.Delimiter}
+	properties := resourceServerProperties{
+		Delimiter:         rs.Delimiter,
+		TokenFormat:       rs.TokenFormat,
+		ScopeImplications: rs.ScopeImplications,
+	}
 	if propsJSON, err := json.Marshal(properties); err == nil {
 		return propsJSON
 	}
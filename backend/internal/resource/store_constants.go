@@ -405,6 +405,17 @@ var (
 		        ORDER BY rs.IDENTIFIER`,
 	}
 
+	// queryGetPermissions returns the permissions of all resources and actions of a resource server.
+	queryGetPermissions = dbmodel.DBQuery{
+		ID: "RSQ-RES_MGT-38",
+		Query: `SELECT PERMISSION AS permission FROM "RESOURCE"
+		        WHERE RESOURCE_SERVER_ID = $1 AND DEPLOYMENT_ID = $2
+		        UNION
+		        SELECT PERMISSION AS permission FROM "ACTION"
+		        WHERE RESOURCE_SERVER_ID = $1 AND DEPLOYMENT_ID = $2
+		        ORDER BY permission`,
+	}
+
 	// queryValidatePermissions validates if permissions exist for a resource server.
 	// Returns only the INVALID permissions (those not found in the database).
 	// Parameter $3 must be a JSON array string (e.g., ["read", "write", "admin"]).
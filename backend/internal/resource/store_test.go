@@ -3632,6 +3632,60 @@ func (suite *ResourceStoreTestSuite) TestValidatePermissions() {
 	}
 }
 
+func (suite *ResourceStoreTestSuite) TestGetPermissions() {
+	suite.mockDBProvider.On("GetConfigDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On("QueryContext", context.Background(), queryGetPermissions, "rs1", "test-deployment").
+		Return([]map[string]interface{}{
+			{"permission": "users:read"},
+			{"permission": "users:write"},
+		}, nil)
+
+	permissions, err := suite.store.GetPermissions(context.Background(), "rs1")
+
+	suite.NoError(err)
+	suite.Equal([]string{"users:read", "users:write"}, permissions)
+}
+
+func (suite *ResourceStoreTestSuite) TestGetPermissions_Errors() {
+	testCases := []struct {
+		name          string
+		rows          []map[string]interface{}
+		queryErr      error
+		errorContains string
+	}{
+		{
+			name:          "QueryError",
+			queryErr:      errors.New("database connection lost"),
+			errorContains: "failed to get permissions",
+		},
+		{
+			name:          "InvalidRow",
+			rows:          []map[string]interface{}{{"permission": 123}},
+			errorContains: "permission field is missing or invalid",
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.mockDBProvider = providermock.NewDBProviderInterfaceMock(suite.T())
+			suite.mockDBClient = providermock.NewDBClientInterfaceMock(suite.T())
+			suite.store = &resourceStore{
+				dbProvider:   suite.mockDBProvider,
+				deploymentID: "test-deployment",
+			}
+			suite.mockDBProvider.On("GetConfigDBClient").Return(suite.mockDBClient, nil)
+			suite.mockDBClient.On("QueryContext", context.Background(), queryGetPermissions, "rs1", "test-deployment").
+				Return(tc.rows, tc.queryErr)
+
+			permissions, err := suite.store.GetPermissions(context.Background(), "rs1")
+
+			suite.Error(err)
+			suite.Contains(err.Error(), tc.errorContains)
+			suite.Nil(permissions)
+		})
+	}
+}
+
 // TestIsResourceServerDeclarative tests that database store always returns false
 func (suite *ResourceStoreTestSuite) TestIsResourceServerDeclarative() {
 	testCases := []struct {
@@ -3669,6 +3723,26 @@ func (suite *ResourceStoreTestSuite) TestIsResourceServerDeclarative() {
 	}
 }
 
+func (suite *ResourceStoreTestSuite) TestResolveProperties_TokenFormat() {
+	testCases := []struct {
+		name       string
+		properties interface{}
+		expected   string
+	}{
+		{name: "Stored", properties: `{"delimiter":":","tokenFormat":"jwt"}`, expected: TokenFormatJWT},
+		{name: "NotStored", properties: []byte(`{"delimiter":":"}`), expected: TokenFormatAccessTokenJWT},
+		{name: "NoProperties", properties: nil, expected: TokenFormatAccessTokenJWT},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			rs := ResourceServer{}
+			resolveProperties(map[string]interface{}{"properties": tc.properties}, &rs)
+			suite.Equal(tc.expected, rs.TokenFormat)
+		})
+	}
+}
+
 // TestBuildPropertiesJSONFunction tests the buildPropertiesJSON function
 // This is a helper function test that constructs JSON properties from a ResourceServer
 func (suite *ResourceStoreTestSuite) TestBuildPropertiesJSONFunction() {
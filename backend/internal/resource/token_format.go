@@ -0,0 +1,43 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package resource
+
+import "github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+
+const (
+	// TokenFormatAccessTokenJWT issues access tokens as JWTs typed "at+jwt", as defined in RFC 9068.
+	// It is the default token format of a resource server.
+	TokenFormatAccessTokenJWT = "at+jwt"
+	// TokenFormatJWT issues access tokens as JWTs typed "JWT", for resource servers whose JWT libraries
+	// reject the "at+jwt" type.
+	TokenFormatJWT = "jwt"
+)
+
+// resolveTokenFormat returns the token format to store for a resource server, applying the default
+// when none is set.
+func resolveTokenFormat(tokenFormat string) (string, *serviceerror.ServiceError) {
+	switch tokenFormat {
+	case "":
+		return TokenFormatAccessTokenJWT, nil
+	case TokenFormatAccessTokenJWT, TokenFormatJWT:
+		return tokenFormat, nil
+	default:
+		return "", &ErrorInvalidTokenFormat
+	}
+}
@@ -0,0 +1,66 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package resource
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type TokenFormatTestSuite struct {
+	suite.Suite
+}
+
+func TestTokenFormatTestSuite(t *testing.T) {
+	suite.Run(t, new(TokenFormatTestSuite))
+}
+
+func (suite *TokenFormatTestSuite) TestResolveTokenFormat() {
+	testCases := []struct {
+		name        string
+		tokenFormat string
+		expected    string
+	}{
+		{name: "Default", tokenFormat: "", expected: TokenFormatAccessTokenJWT},
+		{name: "AccessTokenJWT", tokenFormat: TokenFormatAccessTokenJWT, expected: TokenFormatAccessTokenJWT},
+		{name: "JWT", tokenFormat: TokenFormatJWT, expected: TokenFormatJWT},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			tokenFormat, err := resolveTokenFormat(tc.tokenFormat)
+
+			suite.Nil(err)
+			suite.Equal(tc.expected, tokenFormat)
+		})
+	}
+}
+
+func (suite *TokenFormatTestSuite) TestResolveTokenFormat_Invalid() {
+	for _, tokenFormat := range []string{"opaque", "JWT", "at+JWT"} {
+		suite.Run(tokenFormat, func() {
+			resolved, err := resolveTokenFormat(tokenFormat)
+
+			suite.Empty(resolved)
+			suite.NotNil(err)
+			suite.Equal(ErrorInvalidTokenFormat.Code, err.Code)
+		})
+	}
+}
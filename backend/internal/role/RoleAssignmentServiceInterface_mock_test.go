@@ -285,6 +285,65 @@ func (_c *RoleAssignmentServiceInterfaceMock_GetRoleAssignmentsByType_Call) RunA
 	return _c
 }
 
+// RemoveAssigneeFromAllRoles provides a mock function for the type RoleAssignmentServiceInterfaceMock
+func (_mock *RoleAssignmentServiceInterfaceMock) RemoveAssigneeFromAllRoles(ctx context.Context, assignment RoleAssignment) *serviceerror.ServiceError {
+	ret := _mock.Called(ctx, assignment)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveAssigneeFromAllRoles")
+	}
+
+	var r0 *serviceerror.ServiceError
+	if returnFunc, ok := ret.Get(0).(func(context.Context, RoleAssignment) *serviceerror.ServiceError); ok {
+		r0 = returnFunc(ctx, assignment)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*serviceerror.ServiceError)
+		}
+	}
+	return r0
+}
+
+// RoleAssignmentServiceInterfaceMock_RemoveAssigneeFromAllRoles_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveAssigneeFromAllRoles'
+type RoleAssignmentServiceInterfaceMock_RemoveAssigneeFromAllRoles_Call struct {
+	*mock.Call
+}
+
+// RemoveAssigneeFromAllRoles is a helper method to define mock.On call
+//   - ctx context.Context
+//   - assignment RoleAssignment
+func (_e *RoleAssignmentServiceInterfaceMock_Expecter) RemoveAssigneeFromAllRoles(ctx interface{}, assignment interface{}) *RoleAssignmentServiceInterfaceMock_RemoveAssigneeFromAllRoles_Call {
+	return &RoleAssignmentServiceInterfaceMock_RemoveAssigneeFromAllRoles_Call{Call: _e.mock.On("RemoveAssigneeFromAllRoles", ctx, assignment)}
+}
+
+func (_c *RoleAssignmentServiceInterfaceMock_RemoveAssigneeFromAllRoles_Call) Run(run func(ctx context.Context, assignment RoleAssignment)) *RoleAssignmentServiceInterfaceMock_RemoveAssigneeFromAllRoles_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 RoleAssignment
+		if args[1] != nil {
+			arg1 = args[1].(RoleAssignment)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *RoleAssignmentServiceInterfaceMock_RemoveAssigneeFromAllRoles_Call) Return(serviceError *serviceerror.ServiceError) *RoleAssignmentServiceInterfaceMock_RemoveAssigneeFromAllRoles_Call {
+	_c.Call.Return(serviceError)
+	return _c
+}
+
+func (_c *RoleAssignmentServiceInterfaceMock_RemoveAssigneeFromAllRoles_Call) RunAndReturn(run func(ctx context.Context, assignment RoleAssignment) *serviceerror.ServiceError) *RoleAssignmentServiceInterfaceMock_RemoveAssigneeFromAllRoles_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // RemoveAssignments provides a mock function for the type RoleAssignmentServiceInterfaceMock
 func (_mock *RoleAssignmentServiceInterfaceMock) RemoveAssignments(ctx context.Context, id string, assignments []RoleAssignment) *serviceerror.ServiceError {
 	ret := _mock.Called(ctx, id, assignments)
@@ -249,6 +249,158 @@ func (_c *RoleServiceInterfaceMock_GetAuthorizedPermissions_Call) RunAndReturn(r
 	return _c
 }
 
+// GetEntityRoles provides a mock function for the type RoleServiceInterfaceMock
+func (_mock *RoleServiceInterfaceMock) GetEntityRoles(ctx context.Context, entityID string, groupIDs []string) ([]Role, *serviceerror.ServiceError) {
+	ret := _mock.Called(ctx, entityID, groupIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetEntityRoles")
+	}
+
+	var r0 []Role
+	var r1 *serviceerror.ServiceError
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, []string) ([]Role, *serviceerror.ServiceError)); ok {
+		return returnFunc(ctx, entityID, groupIDs)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, []string) []Role); ok {
+		r0 = returnFunc(ctx, entityID, groupIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]Role)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, []string) *serviceerror.ServiceError); ok {
+		r1 = returnFunc(ctx, entityID, groupIDs)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*serviceerror.ServiceError)
+		}
+	}
+	return r0, r1
+}
+
+// RoleServiceInterfaceMock_GetEntityRoles_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetEntityRoles'
+type RoleServiceInterfaceMock_GetEntityRoles_Call struct {
+	*mock.Call
+}
+
+// GetEntityRoles is a helper method to define mock.On call
+//   - ctx context.Context
+//   - entityID string
+//   - groupIDs []string
+func (_e *RoleServiceInterfaceMock_Expecter) GetEntityRoles(ctx interface{}, entityID interface{}, groupIDs interface{}) *RoleServiceInterfaceMock_GetEntityRoles_Call {
+	return &RoleServiceInterfaceMock_GetEntityRoles_Call{Call: _e.mock.On("GetEntityRoles", ctx, entityID, groupIDs)}
+}
+
+func (_c *RoleServiceInterfaceMock_GetEntityRoles_Call) Run(run func(ctx context.Context, entityID string, groupIDs []string)) *RoleServiceInterfaceMock_GetEntityRoles_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 []string
+		if args[2] != nil {
+			arg2 = args[2].([]string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *RoleServiceInterfaceMock_GetEntityRoles_Call) Return(roles []Role, serviceError *serviceerror.ServiceError) *RoleServiceInterfaceMock_GetEntityRoles_Call {
+	_c.Call.Return(roles, serviceError)
+	return _c
+}
+
+func (_c *RoleServiceInterfaceMock_GetEntityRoles_Call) RunAndReturn(run func(ctx context.Context, entityID string, groupIDs []string) ([]Role, *serviceerror.ServiceError)) *RoleServiceInterfaceMock_GetEntityRoles_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRoleByName provides a mock function for the type RoleServiceInterfaceMock
+func (_mock *RoleServiceInterfaceMock) GetRoleByName(ctx context.Context, ouID string, name string) (*RoleWithPermissions, *serviceerror.ServiceError) {
+	ret := _mock.Called(ctx, ouID, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRoleByName")
+	}
+
+	var r0 *RoleWithPermissions
+	var r1 *serviceerror.ServiceError
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (*RoleWithPermissions, *serviceerror.ServiceError)); ok {
+		return returnFunc(ctx, ouID, name)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) *RoleWithPermissions); ok {
+		r0 = returnFunc(ctx, ouID, name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*RoleWithPermissions)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) *serviceerror.ServiceError); ok {
+		r1 = returnFunc(ctx, ouID, name)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*serviceerror.ServiceError)
+		}
+	}
+	return r0, r1
+}
+
+// RoleServiceInterfaceMock_GetRoleByName_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRoleByName'
+type RoleServiceInterfaceMock_GetRoleByName_Call struct {
+	*mock.Call
+}
+
+// GetRoleByName is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ouID string
+//   - name string
+func (_e *RoleServiceInterfaceMock_Expecter) GetRoleByName(ctx interface{}, ouID interface{}, name interface{}) *RoleServiceInterfaceMock_GetRoleByName_Call {
+	return &RoleServiceInterfaceMock_GetRoleByName_Call{Call: _e.mock.On("GetRoleByName", ctx, ouID, name)}
+}
+
+func (_c *RoleServiceInterfaceMock_GetRoleByName_Call) Run(run func(ctx context.Context, ouID string, name string)) *RoleServiceInterfaceMock_GetRoleByName_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *RoleServiceInterfaceMock_GetRoleByName_Call) Return(roleWithPermissions *RoleWithPermissions, serviceError *serviceerror.ServiceError) *RoleServiceInterfaceMock_GetRoleByName_Call {
+	_c.Call.Return(roleWithPermissions, serviceError)
+	return _c
+}
+
+func (_c *RoleServiceInterfaceMock_GetRoleByName_Call) RunAndReturn(run func(ctx context.Context, ouID string, name string) (*RoleWithPermissions, *serviceerror.ServiceError)) *RoleServiceInterfaceMock_GetRoleByName_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetRoleList provides a mock function for the type RoleServiceInterfaceMock
 func (_mock *RoleServiceInterfaceMock) GetRoleList(ctx context.Context, limit int, offset int) (*RoleList, *serviceerror.ServiceError) {
 	ret := _mock.Called(ctx, limit, offset)
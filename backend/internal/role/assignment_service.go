@@ -23,12 +23,17 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/thunder-id/thunderid/internal/entity"
 	"github.com/thunder-id/thunderid/internal/entitytype"
 	"github.com/thunder-id/thunderid/internal/group"
+	sysContext "github.com/thunder-id/thunderid/internal/system/context"
 	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
 	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/internal/system/observability"
+	"github.com/thunder-id/thunderid/internal/system/observability/event"
+	"github.com/thunder-id/thunderid/internal/system/security"
 	"github.com/thunder-id/thunderid/internal/system/transaction"
 	"github.com/thunder-id/thunderid/internal/system/utils"
 )
@@ -43,6 +48,7 @@ type RoleAssignmentServiceInterface interface {
 		includeDisplay bool, assigneeType string) (*AssignmentList, *serviceerror.ServiceError)
 	AddAssignments(ctx context.Context, id string, assignments []RoleAssignment) *serviceerror.ServiceError
 	RemoveAssignments(ctx context.Context, id string, assignments []RoleAssignment) *serviceerror.ServiceError
+	RemoveAssigneeFromAllRoles(ctx context.Context, assignment RoleAssignment) *serviceerror.ServiceError
 }
 
 // roleAssignmentService is the default implementation of RoleAssignmentServiceInterface.
@@ -52,6 +58,7 @@ type roleAssignmentService struct {
 	groupService      group.GroupServiceInterface
 	entityTypeService entitytype.EntityTypeServiceInterface
 	transactioner     transaction.Transactioner
+	observabilitySvc  observability.ObservabilityServiceInterface
 }
 
 // newRoleAssignmentService creates a new instance of roleAssignmentService.
@@ -61,6 +68,7 @@ func newRoleAssignmentService(
 	groupService group.GroupServiceInterface,
 	entityTypeService entitytype.EntityTypeServiceInterface,
 	transactioner transaction.Transactioner,
+	observabilitySvc observability.ObservabilityServiceInterface,
 ) RoleAssignmentServiceInterface {
 	return &roleAssignmentService{
 		roleStore:         roleStore,
@@ -68,6 +76,7 @@ func newRoleAssignmentService(
 		groupService:      groupService,
 		entityTypeService: entityTypeService,
 		transactioner:     transactioner,
+		observabilitySvc:  observabilitySvc,
 	}
 }
 
@@ -259,6 +268,8 @@ func (as *roleAssignmentService) AddAssignments(
 		return &serviceerror.InternalServerError
 	}
 
+	as.publishAssignmentEvent(ctx, event.EventTypeRoleAssignmentsAdded, id, normalized)
+
 	logger.Debug("Successfully added assignments to role", log.String("id", id))
 	return nil
 }
@@ -282,10 +293,57 @@ func (as *roleAssignmentService) RemoveAssignments(
 		return &serviceerror.InternalServerError
 	}
 
+	as.publishAssignmentEvent(ctx, event.EventTypeRoleAssignmentsRemoved, id, normalized)
+
 	logger.Debug("Successfully removed assignments from role", log.String("id", id))
 	return nil
 }
 
+// RemoveAssigneeFromAllRoles removes every role assignment held by the given assignee.
+func (as *roleAssignmentService) RemoveAssigneeFromAllRoles(
+	ctx context.Context, assignment RoleAssignment) *serviceerror.ServiceError {
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, assignmentLoggerComponentName))
+
+	if err := as.validateAssignmentsRequest([]RoleAssignment{assignment}); err != nil {
+		return err
+	}
+
+	if err := as.transactioner.Transact(ctx, func(txCtx context.Context) error {
+		return as.roleStore.DeleteAssignmentsByAssignee(txCtx, normalizeAssignments([]RoleAssignment{assignment})[0])
+	}); err != nil {
+		logger.Error("Failed to remove assignee from roles", log.String("assigneeID", assignment.ID), log.Error(err))
+		return &serviceerror.InternalServerError
+	}
+
+	logger.Debug("Successfully removed assignee from all roles", log.String("assigneeID", assignment.ID))
+	return nil
+}
+
+// publishAssignmentEvent records a change to the assignments of a role in the audit trail.
+func (as *roleAssignmentService) publishAssignmentEvent(
+	ctx context.Context, eventType event.EventType, id string, assignments []RoleAssignment) {
+	if as.observabilitySvc == nil || !as.observabilitySvc.IsEnabled() {
+		return
+	}
+
+	assignees := make([]string, 0, len(assignments))
+	for _, assignment := range assignments {
+		assignees = append(assignees, string(assignment.Type)+":"+assignment.ID)
+	}
+	evt := event.NewEvent(sysContext.GetTraceID(ctx), string(eventType), event.ComponentRoleManagement).
+		WithTenantID(sysContext.GetTenantID(ctx)).
+		WithStatus(event.StatusSuccess).
+		WithData(event.DataKey.RoleID, id).
+		WithData(event.DataKey.Assignees, strings.Join(assignees, ","))
+	if role, err := as.roleStore.GetRole(ctx, id); err == nil {
+		evt.WithData(event.DataKey.OUID, role.OUID)
+	}
+	if actorID := security.GetSubject(ctx); actorID != "" {
+		evt.WithData(event.DataKey.ActorID, actorID)
+	}
+	as.observabilitySvc.PublishEvent(evt)
+}
+
 // prepareAssignments validates and normalizes assignments before a mutation.
 // Unlike the previous role service implementation, this allows modifying assignments for
 // both mutable and declarative (file-backed) roles.
@@ -62,6 +62,7 @@ func (suite *RoleAssignmentServiceTestSuite) SetupTest() {
 		suite.mockGroupService,
 		suite.mockEntityTypeService,
 		suite.transactioner,
+		nil,
 	)
 }
 
@@ -626,3 +627,34 @@ func (suite *RoleAssignmentServiceTestSuite) TestRemoveAssignments_Success() {
 
 	suite.Nil(err)
 }
+
+// RemoveAssigneeFromAllRoles Tests
+
+func (suite *RoleAssignmentServiceTestSuite) TestRemoveAssigneeFromAllRoles_Success() {
+	suite.mockStore.On("DeleteAssignmentsByAssignee", mock.Anything,
+		RoleAssignment{ID: testUserID1, Type: assigneeTypeEntity}).Return(nil)
+
+	err := suite.service.RemoveAssigneeFromAllRoles(context.Background(),
+		RoleAssignment{ID: testUserID1, Type: AssigneeTypeUser})
+
+	suite.Nil(err)
+}
+
+func (suite *RoleAssignmentServiceTestSuite) TestRemoveAssigneeFromAllRoles_InvalidType() {
+	err := suite.service.RemoveAssigneeFromAllRoles(context.Background(),
+		RoleAssignment{ID: testUserID1, Type: "invalid"})
+
+	suite.NotNil(err)
+	suite.Equal(ErrorInvalidAssigneeType.Code, err.Code)
+}
+
+func (suite *RoleAssignmentServiceTestSuite) TestRemoveAssigneeFromAllRoles_StoreError() {
+	suite.mockStore.On("DeleteAssignmentsByAssignee", mock.Anything,
+		RoleAssignment{ID: "group1", Type: AssigneeTypeGroup}).Return(errors.New("store error"))
+
+	err := suite.service.RemoveAssigneeFromAllRoles(context.Background(),
+		RoleAssignment{ID: "group1", Type: AssigneeTypeGroup})
+
+	suite.NotNil(err)
+	suite.Equal(serviceerror.InternalServerError.Code, err.Code)
+}
@@ -0,0 +1,80 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package role
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/thunder-id/thunderid/internal/changerequest"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	sysutils "github.com/thunder-id/thunderid/internal/system/utils"
+)
+
+// newRoleUpdateApplier returns the applier that updates a role once its change request is approved.
+func newRoleUpdateApplier(roleService RoleServiceInterface) changerequest.OperationApplier {
+	return func(ctx context.Context, resourceID string, payload json.RawMessage) *serviceerror.ServiceError {
+		var role RoleUpdateDetail
+		if err := json.Unmarshal(payload, &role); err != nil {
+			log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName)).
+				Error("Failed to unmarshal role update payload", log.String("id", resourceID), log.Error(err))
+			return &serviceerror.InternalServerError
+		}
+
+		_, svcErr := roleService.UpdateRoleWithPermissions(ctx, resourceID, role)
+		return svcErr
+	}
+}
+
+// isRoleUpdateApprovalRequired reports whether role updates must be approved by a second administrator.
+func (rh *roleHandler) isRoleUpdateApprovalRequired() bool {
+	return rh.changeRequestService != nil &&
+		rh.changeRequestService.IsApprovalRequired(changerequest.OperationRoleUpdate)
+}
+
+// requestRoleUpdateApproval holds a role update for approval and responds with the pending change request.
+// The role must exist and be mutable so that a change request is not created for an update that can never
+// be applied.
+func (rh *roleHandler) requestRoleUpdateApproval(
+	ctx context.Context, w http.ResponseWriter, id string, role RoleUpdateDetail) {
+	if _, svcErr := rh.roleService.GetRoleWithPermissions(ctx, id); svcErr != nil {
+		handleError(w, svcErr)
+		return
+	}
+	isDeclarative, svcErr := rh.roleService.IsRoleDeclarative(ctx, id)
+	if svcErr != nil {
+		handleError(w, svcErr)
+		return
+	}
+	if isDeclarative {
+		handleError(w, &ErrorImmutableRole)
+		return
+	}
+
+	changeRequest, svcErr := rh.changeRequestService.CreateChangeRequest(
+		ctx, changerequest.OperationRoleUpdate, id, role)
+	if svcErr != nil {
+		handleError(w, svcErr)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(w, http.StatusAccepted, changeRequest)
+}
@@ -0,0 +1,138 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package role
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/changerequest"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/tests/mocks/changerequestmock"
+)
+
+type RoleChangeApprovalTestSuite struct {
+	suite.Suite
+	mockService              *RoleServiceInterfaceMock
+	mockChangeRequestService *changerequestmock.ChangeRequestServiceInterfaceMock
+	handler                  *roleHandler
+}
+
+func TestRoleChangeApprovalTestSuite(t *testing.T) {
+	suite.Run(t, new(RoleChangeApprovalTestSuite))
+}
+
+func (suite *RoleChangeApprovalTestSuite) SetupTest() {
+	suite.mockService = NewRoleServiceInterfaceMock(suite.T())
+	suite.mockChangeRequestService = changerequestmock.NewChangeRequestServiceInterfaceMock(suite.T())
+	suite.handler = newRoleHandler(suite.mockService, nil, suite.mockChangeRequestService)
+}
+
+func (suite *RoleChangeApprovalTestSuite) putRole(body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPut, "/roles/role1", bytes.NewBufferString(body))
+	req.Header.Set("Content-Type", "application/json")
+	req.SetPathValue("id", "role1")
+	w := httptest.NewRecorder()
+	suite.handler.HandleRolePutRequest(w, req)
+	return w
+}
+
+func (suite *RoleChangeApprovalTestSuite) TestHandleRolePutRequest_CreatesChangeRequest() {
+	suite.mockChangeRequestService.On("IsApprovalRequired", changerequest.OperationRoleUpdate).Return(true)
+	suite.mockService.On("GetRoleWithPermissions", mock.Anything, "role1").
+		Return(&RoleWithPermissions{ID: "role1"}, nil)
+	suite.mockService.On("IsRoleDeclarative", mock.Anything, "role1").Return(false, nil)
+	suite.mockChangeRequestService.On("CreateChangeRequest", mock.Anything, changerequest.OperationRoleUpdate,
+		"role1", mock.MatchedBy(func(role RoleUpdateDetail) bool {
+			return role.Name == "Updated Role" && role.OUID == "ou1"
+		})).Return(&changerequest.ChangeRequest{
+		ID:     "change-request-1",
+		Status: changerequest.ChangeRequestStatusPending,
+	}, nil)
+
+	w := suite.putRole(`{"name":"Updated Role","ouId":"ou1"}`)
+
+	suite.Equal(http.StatusAccepted, w.Code)
+	var response changerequest.ChangeRequest
+	suite.Require().NoError(json.NewDecoder(w.Body).Decode(&response))
+	suite.Equal("change-request-1", response.ID)
+	suite.mockService.AssertNotCalled(suite.T(), "UpdateRoleWithPermissions", mock.Anything, mock.Anything,
+		mock.Anything)
+}
+
+func (suite *RoleChangeApprovalTestSuite) TestHandleRolePutRequest_RoleNotFound() {
+	suite.mockChangeRequestService.On("IsApprovalRequired", changerequest.OperationRoleUpdate).Return(true)
+	suite.mockService.On("GetRoleWithPermissions", mock.Anything, "role1").Return(nil, &ErrorRoleNotFound)
+
+	w := suite.putRole(`{"name":"Updated Role","ouId":"ou1"}`)
+
+	suite.Equal(http.StatusNotFound, w.Code)
+}
+
+func (suite *RoleChangeApprovalTestSuite) TestHandleRolePutRequest_DeclarativeRole() {
+	suite.mockChangeRequestService.On("IsApprovalRequired", changerequest.OperationRoleUpdate).Return(true)
+	suite.mockService.On("GetRoleWithPermissions", mock.Anything, "role1").
+		Return(&RoleWithPermissions{ID: "role1"}, nil)
+	suite.mockService.On("IsRoleDeclarative", mock.Anything, "role1").Return(true, nil)
+
+	w := suite.putRole(`{"name":"Updated Role","ouId":"ou1"}`)
+
+	suite.Equal(http.StatusBadRequest, w.Code)
+}
+
+func (suite *RoleChangeApprovalTestSuite) TestHandleRolePutRequest_ApprovalNotRequired() {
+	suite.mockChangeRequestService.On("IsApprovalRequired", changerequest.OperationRoleUpdate).Return(false)
+	suite.mockService.On("UpdateRoleWithPermissions", mock.Anything, "role1", mock.Anything).
+		Return(&RoleWithPermissions{ID: "role1", Name: "Updated Role"}, nil)
+
+	w := suite.putRole(`{"name":"Updated Role","ouId":"ou1"}`)
+
+	suite.Equal(http.StatusOK, w.Code)
+}
+
+func (suite *RoleChangeApprovalTestSuite) TestRoleUpdateApplier() {
+	suite.mockService.On("UpdateRoleWithPermissions", mock.Anything, "role1", RoleUpdateDetail{
+		Name:        "Updated Role",
+		OUID:        "ou1",
+		Permissions: []ResourcePermissions{{ResourceServerID: "rs1", Permissions: []string{"perm1"}}},
+	}).Return(&RoleWithPermissions{ID: "role1"}, nil)
+	payload, err := json.Marshal(RoleUpdateDetail{
+		Name:        "Updated Role",
+		OUID:        "ou1",
+		Permissions: []ResourcePermissions{{ResourceServerID: "rs1", Permissions: []string{"perm1"}}},
+	})
+	suite.Require().NoError(err)
+
+	svcErr := newRoleUpdateApplier(suite.mockService)(context.Background(), "role1", payload)
+
+	suite.Nil(svcErr)
+}
+
+func (suite *RoleChangeApprovalTestSuite) TestRoleUpdateApplier_InvalidPayload() {
+	svcErr := newRoleUpdateApplier(suite.mockService)(context.Background(), "role1", json.RawMessage(`[`))
+
+	suite.Equal(serviceerror.InternalServerError.Code, svcErr.Code)
+}
@@ -311,6 +311,11 @@ func (c *compositeRoleStore) RemoveAssignments(ctx context.Context, id string, a
 	return c.dbStore.RemoveAssignments(ctx, id, assignments)
 }
 
+// DeleteAssignmentsByAssignee deletes all assignments of an assignee from the database store only.
+func (c *compositeRoleStore) DeleteAssignmentsByAssignee(ctx context.Context, assignment RoleAssignment) error {
+	return c.dbStore.DeleteAssignmentsByAssignee(ctx, assignment)
+}
+
 // CheckRoleNameExists checks if a role with the given name exists in either store.
 func (c *compositeRoleStore) CheckRoleNameExists(ctx context.Context, ouID, name string) (bool, error) {
 	return declarativeresource.CompositeBooleanCheckHelper(
@@ -332,6 +337,16 @@ func (c *compositeRoleStore) CheckRoleNameExistsExcludingID(
 	)
 }
 
+// GetRoleIDByName retrieves the ID of a role by its name from either store.
+// Checks database store first, then falls back to file store.
+func (c *compositeRoleStore) GetRoleIDByName(ctx context.Context, ouID, name string) (string, error) {
+	return declarativeresource.CompositeGetHelper(
+		func() (string, error) { return c.dbStore.GetRoleIDByName(ctx, ouID, name) },
+		func() (string, error) { return c.fileStore.GetRoleIDByName(ctx, ouID, name) },
+		ErrRoleNotFound,
+	)
+}
+
 // GetAuthorizedPermissions retrieves authorized permissions assembled from three sources:
 //
 //  1. dbStore — DB-managed roles, where both ROLE_PERMISSION and ROLE_ASSIGNMENT rows exist.
@@ -469,6 +484,23 @@ func (c *compositeRoleStore) GetUserRoles(
 	return mergePermissions(dbRoleNames, fileRoleNames), nil
 }
 
+// GetEntityRoles retrieves roles assigned to an entity from both stores.
+func (c *compositeRoleStore) GetEntityRoles(
+	ctx context.Context, entityID string, groupIDs []string,
+) ([]Role, error) {
+	dbRoles, err := c.dbStore.GetEntityRoles(ctx, entityID, groupIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	fileRoles, err := c.fileStore.GetEntityRoles(ctx, entityID, groupIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	return mergeRoles(dbRoles, fileRoles), nil
+}
+
 // IsRoleDeclarative checks if a role is immutable (exists in file store).
 func (c *compositeRoleStore) IsRoleDeclarative(ctx context.Context, roleID string) (bool, error) {
 	fileExists, err := c.fileStore.IsRoleExist(ctx, roleID)
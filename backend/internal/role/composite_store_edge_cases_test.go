@@ -168,6 +168,16 @@ func (suite *CompositeRoleStoreEdgeCaseTestSuite) TestRemoveAssignments_Delegate
 	suite.mockDBStore.AssertExpectations(suite.T())
 }
 
+func (suite *CompositeRoleStoreEdgeCaseTestSuite) TestDeleteAssignmentsByAssignee_DelegatesToDB() {
+	assignment := RoleAssignment{ID: "user1", Type: assigneeTypeEntity}
+	suite.mockDBStore.On("DeleteAssignmentsByAssignee", suite.ctx, assignment).Return(nil)
+
+	err := suite.store.DeleteAssignmentsByAssignee(suite.ctx, assignment)
+
+	assert.NoError(suite.T(), err)
+	suite.mockDBStore.AssertExpectations(suite.T())
+}
+
 // Test CheckRoleNameExists checks file store first, returns true if found
 func (suite *CompositeRoleStoreEdgeCaseTestSuite) TestCheckRoleNameExists_ChecksBothStores() {
 	// CompositeBooleanCheckHelper checks fileStore first. If it returns true, it stops.
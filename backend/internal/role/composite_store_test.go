@@ -305,3 +305,27 @@ func (suite *CompositeRoleStoreTestSuite) TestGetRoleAssignments_FileAssignments
 	suite.Error(err)
 	suite.Equal(testErr, err)
 }
+
+func (suite *CompositeRoleStoreTestSuite) TestGetEntityRoles_MergesStores() {
+	suite.mockDBStore.On("GetEntityRoles", mock.Anything, "user1", []string{"group1"}).
+		Return([]Role{{ID: "role1", Name: "admin", OUID: "ou1"}}, nil)
+	suite.mockFileStore.On("GetEntityRoles", mock.Anything, "user1", []string{"group1"}).
+		Return([]Role{{ID: "role1", Name: "admin", OUID: "ou1"}, {ID: "role2", Name: "viewer", OUID: "ou1"}}, nil)
+
+	roles, err := suite.store.GetEntityRoles(context.Background(), "user1", []string{"group1"})
+
+	suite.NoError(err)
+	suite.Len(roles, 2)
+	suite.False(roles[0].IsReadOnly)
+	suite.True(roles[1].IsReadOnly)
+}
+
+func (suite *CompositeRoleStoreTestSuite) TestGetEntityRoles_DBError() {
+	suite.mockDBStore.On("GetEntityRoles", mock.Anything, "user1", []string(nil)).
+		Return(nil, errors.New("db error"))
+
+	roles, err := suite.store.GetEntityRoles(context.Background(), "user1", nil)
+
+	suite.Error(err)
+	suite.Nil(roles)
+}
@@ -252,6 +252,20 @@ var (
 			DefaultValue: "A role with the specified ID already exists",
 		},
 	}
+	// ErrorMissingRoleHandle is the error returned when a role is looked up by name without
+	// both the name and the organization unit ID.
+	ErrorMissingRoleHandle = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "ROL-1019",
+		Error: core.I18nMessage{
+			Key:          "error.roleservice.missing_role_handle",
+			DefaultValue: "Invalid request format",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.roleservice.missing_role_handle_description",
+			DefaultValue: "Role name and organization unit ID are required",
+		},
+	}
 	// ResultLimitExceededInCompositeMode is the error returned when the total number of records exceeds
 	// the maximum limit in composite mode (combining database and declarative resources).
 	ResultLimitExceededInCompositeMode = serviceerror.ServiceError{
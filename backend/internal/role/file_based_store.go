@@ -266,6 +266,11 @@ func (f *fileBasedStore) RemoveAssignments(ctx context.Context, id string, assig
 	return errors.New("RemoveAssignments is not supported in file-based store")
 }
 
+// DeleteAssignmentsByAssignee is not supported in file-based store.
+func (f *fileBasedStore) DeleteAssignmentsByAssignee(ctx context.Context, assignment RoleAssignment) error {
+	return errors.New("DeleteAssignmentsByAssignee is not supported in file-based store")
+}
+
 // CheckRoleNameExists checks if a role with the given name exists in the file-based store.
 func (f *fileBasedStore) CheckRoleNameExists(ctx context.Context, ouID, name string) (bool, error) {
 	list, err := f.GenericFileBasedStore.List()
@@ -290,6 +295,29 @@ func (f *fileBasedStore) CheckRoleNameExists(ctx context.Context, ouID, name str
 	return false, nil
 }
 
+// GetRoleIDByName returns the ID of the role with the given name in the specified organization unit.
+func (f *fileBasedStore) GetRoleIDByName(ctx context.Context, ouID, name string) (string, error) {
+	list, err := f.GenericFileBasedStore.List()
+	if err != nil {
+		return "", err
+	}
+
+	for _, item := range list {
+		roleData, err := roleFromDeclarativeData(item.ID.ID, item.Data)
+		if err != nil {
+			log.GetLogger().Warn("Skipping malformed role in GetRoleIDByName",
+				log.String("roleID", item.ID.ID),
+				log.Error(err))
+			continue
+		}
+		if roleData.OUID == ouID && roleData.Name == name {
+			return roleData.ID, nil
+		}
+	}
+
+	return "", ErrRoleNotFound
+}
+
 // CheckRoleNameExistsExcludingID checks for a role name conflict excluding a specific role ID.
 func (f *fileBasedStore) CheckRoleNameExistsExcludingID(
 	ctx context.Context,
@@ -416,6 +444,43 @@ func (f *fileBasedStore) GetUserRoles(
 	return roleNames, nil
 }
 
+// GetEntityRoles retrieves the ID, name and organization unit of roles assigned to an entity
+// directly and/or through group membership.
+func (f *fileBasedStore) GetEntityRoles(
+	ctx context.Context, entityID string, groupIDs []string,
+) ([]Role, error) {
+	if entityID == "" && len(groupIDs) == 0 {
+		return []Role{}, nil
+	}
+
+	list, err := f.GenericFileBasedStore.List()
+	if err != nil {
+		return nil, err
+	}
+
+	groupSet := make(map[string]bool, len(groupIDs))
+	for _, groupID := range groupIDs {
+		groupSet[groupID] = true
+	}
+
+	roles := make([]Role, 0)
+	for _, item := range list {
+		roleData, err := roleFromDeclarativeData(item.ID.ID, item.Data)
+		if err != nil {
+			log.GetLogger().Warn("Skipping malformed role in GetEntityRoles",
+				log.String("roleID", item.ID.ID),
+				log.Error(err))
+			continue
+		}
+		if !matchesAssignee(roleData.Assignments, entityID, groupSet) {
+			continue
+		}
+		roles = append(roles, Role{ID: roleData.ID, Name: roleData.Name, OUID: roleData.OUID})
+	}
+
+	return roles, nil
+}
+
 // GetEntityRoleIDs is a no-op for the file-based store. Role assignments are persisted in the
 // database store and queried from there by the composite store; the file store has no
 // independent record of API-added assignments. Returning an empty slice keeps the composite
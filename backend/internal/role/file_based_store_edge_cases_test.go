@@ -561,6 +561,36 @@ func (suite *RoleFileBasedStoreEdgeCaseTestSuite) TestGetAuthorizedPermissions_A
 	assert.ElementsMatch(suite.T(), []string{"read:docs", "write:docs"}, perms)
 }
 
+// Test GetEntityRoles returns role details for direct and group assignments.
+func (suite *RoleFileBasedStoreEdgeCaseTestSuite) TestGetEntityRoles_DirectAndGroup() {
+	suite.seedRole(RoleWithPermissionsAndAssignments{
+		ID:          "role1",
+		Name:        "Direct",
+		OUID:        "ou1",
+		Assignments: []RoleAssignment{{ID: "user-1", Type: assigneeTypeEntity}},
+	})
+	suite.seedRole(RoleWithPermissionsAndAssignments{
+		ID:          "role2",
+		Name:        "ViaGroup",
+		OUID:        "ou2",
+		Assignments: []RoleAssignment{{ID: "group-1", Type: AssigneeTypeGroup}},
+	})
+	suite.seedRole(RoleWithPermissionsAndAssignments{
+		ID:          "role3",
+		Name:        "Other",
+		OUID:        "ou1",
+		Assignments: []RoleAssignment{{ID: "user-2", Type: assigneeTypeEntity}},
+	})
+
+	roles, err := suite.store.GetEntityRoles(context.Background(), "user-1", []string{"group-1"})
+
+	assert.NoError(suite.T(), err)
+	assert.ElementsMatch(suite.T(), []Role{
+		{ID: "role1", Name: "Direct", OUID: "ou1"},
+		{ID: "role2", Name: "ViaGroup", OUID: "ou2"},
+	}, roles)
+}
+
 // Test GetUserRoles works for app entities.
 func (suite *RoleFileBasedStoreEdgeCaseTestSuite) TestGetUserRoles_AppEntity() {
 	suite.seedRole(RoleWithPermissionsAndAssignments{
@@ -151,6 +151,29 @@ func (suite *RoleFileBasedStoreTestSuite) TestCheckRoleNameExists() {
 	suite.False(exists)
 }
 
+func (suite *RoleFileBasedStoreTestSuite) TestGetRoleIDByName() {
+	suite.seedRole(RoleWithPermissionsAndAssignments{
+		ID:   "role1",
+		Name: "Admin",
+		OUID: "ou1",
+	})
+	suite.seedRole(RoleWithPermissionsAndAssignments{
+		ID:   "role2",
+		Name: "Admin",
+		OUID: "ou2",
+	})
+
+	id, err := suite.store.GetRoleIDByName(context.Background(), "ou2", "Admin")
+
+	suite.NoError(err)
+	suite.Equal("role2", id)
+
+	id, err = suite.store.GetRoleIDByName(context.Background(), "ou1", "Missing")
+
+	suite.ErrorIs(err, ErrRoleNotFound)
+	suite.Empty(id)
+}
+
 func (suite *RoleFileBasedStoreTestSuite) TestCheckRoleNameExistsExcludingID() {
 	suite.seedRole(RoleWithPermissionsAndAssignments{
 		ID:   "role1",
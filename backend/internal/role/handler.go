@@ -19,10 +19,10 @@
 package role
 
 import (
+	"context"
 	"net/http"
-	"net/url"
-	"strconv"
 
+	"github.com/thunder-id/thunderid/internal/changerequest"
 	serverconst "github.com/thunder-id/thunderid/internal/system/constants"
 	"github.com/thunder-id/thunderid/internal/system/error/apierror"
 	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
@@ -34,15 +34,18 @@ const handlerLoggerComponentName = "RoleHandler"
 
 // roleHandler is the handler for role management operations.
 type roleHandler struct {
-	roleService       RoleServiceInterface
-	assignmentService RoleAssignmentServiceInterface
+	roleService          RoleServiceInterface
+	assignmentService    RoleAssignmentServiceInterface
+	changeRequestService changerequest.ChangeRequestServiceInterface
 }
 
 // newRoleHandler creates a new instance of roleHandler
-func newRoleHandler(roleService RoleServiceInterface, assignmentService RoleAssignmentServiceInterface) *roleHandler {
+func newRoleHandler(roleService RoleServiceInterface, assignmentService RoleAssignmentServiceInterface,
+	changeRequestService changerequest.ChangeRequestServiceInterface) *roleHandler {
 	return &roleHandler{
-		roleService:       roleService,
-		assignmentService: assignmentService,
+		roleService:          roleService,
+		assignmentService:    assignmentService,
+		changeRequestService: changeRequestService,
 	}
 }
 
@@ -51,13 +54,20 @@ func (rh *roleHandler) HandleRoleListRequest(w http.ResponseWriter, r *http.Requ
 	ctx := r.Context()
 	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, handlerLoggerComponentName))
 
-	limit, offset, svcErr := parsePaginationParams(r.URL.Query())
+	pagination, svcErr := sysutils.ParsePaginationParams(r.URL.Query(), &ErrorInvalidLimit, &ErrorInvalidOffset)
 	if svcErr != nil {
 		handleError(w, svcErr)
 		return
 	}
+	limit, offset := pagination.Limit, pagination.Offset
 
-	roleList, svcErr := rh.roleService.GetRoleList(ctx, limit, offset)
+	var roleList *RoleList
+	if sysutils.IsListAllRequested(r.URL.Query()) {
+		roleList, svcErr = rh.listAllRoles(ctx)
+	} else {
+		roleList, svcErr = rh.roleService.GetRoleList(
+			sysutils.WithSkipCount(ctx, pagination.SkipCount), limit, offset)
+	}
 	if svcErr != nil {
 		handleError(w, svcErr)
 		return
@@ -85,6 +95,29 @@ func (rh *roleHandler) HandleRoleListRequest(w http.ResponseWriter, r *http.Requ
 		log.Int("count", roleListResponse.Count))
 }
 
+// listAllRoles collects every role accessible to the caller, one page at a time.
+func (rh *roleHandler) listAllRoles(ctx context.Context) (*RoleList, *serviceerror.ServiceError) {
+	roles, svcErr := sysutils.CollectAllPages(serverconst.MaxPageSize,
+		func(limit, offset int) ([]Role, int, *serviceerror.ServiceError) {
+			page, svcErr := rh.roleService.GetRoleList(ctx, limit, offset)
+			if svcErr != nil {
+				return nil, 0, svcErr
+			}
+			return page.Roles, page.TotalResults, nil
+		})
+	if svcErr != nil {
+		return nil, svcErr
+	}
+
+	return &RoleList{
+		TotalResults: len(roles),
+		StartIndex:   1,
+		Count:        len(roles),
+		Roles:        roles,
+		Links:        []sysutils.Link{},
+	}, nil
+}
+
 // HandleRolePostRequest handles the create role request.
 func (rh *roleHandler) HandleRolePostRequest(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -135,6 +168,27 @@ func (rh *roleHandler) HandleRoleGetRequest(w http.ResponseWriter, r *http.Reque
 	logger.Debug("Successfully retrieved role", log.String("role id", id))
 }
 
+// HandleRoleGetByHandleRequest handles the get role by name request.
+// Role names are unique within an organization unit, so the organization unit ID is also required.
+func (rh *roleHandler) HandleRoleGetByHandleRequest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, handlerLoggerComponentName))
+
+	name := r.PathValue("handle")
+	ouID := r.URL.Query().Get("ouId")
+	serviceRole, svcErr := rh.roleService.GetRoleByName(ctx, ouID, name)
+	if svcErr != nil {
+		handleError(w, svcErr)
+		return
+	}
+
+	role := rh.toHTTPRoleResponse(serviceRole)
+
+	sysutils.WriteSuccessResponse(w, http.StatusOK, role)
+
+	logger.Debug("Successfully retrieved role by name", log.String("role id", role.ID))
+}
+
 // HandleRolePutRequest handles the update role request.
 func (rh *roleHandler) HandleRolePutRequest(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -152,6 +206,12 @@ func (rh *roleHandler) HandleRolePutRequest(w http.ResponseWriter, r *http.Reque
 	// Convert HTTP request to service request
 	serviceRequest := RoleUpdateDetail(sanitizedRequest)
 
+	if rh.isRoleUpdateApprovalRequired() {
+		rh.requestRoleUpdateApproval(ctx, w, id, serviceRequest)
+		logger.Debug("Role update is pending approval", log.String("role id", id))
+		return
+	}
+
 	serviceRole, svcErr := rh.roleService.UpdateRoleWithPermissions(ctx, id, serviceRequest)
 	if svcErr != nil {
 		handleError(w, svcErr)
@@ -188,11 +248,12 @@ func (rh *roleHandler) HandleRoleAssignmentsGetRequest(w http.ResponseWriter, r
 	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, handlerLoggerComponentName))
 
 	id := r.PathValue("id")
-	limit, offset, svcErr := parsePaginationParams(r.URL.Query())
+	pagination, svcErr := sysutils.ParsePaginationParams(r.URL.Query(), &ErrorInvalidLimit, &ErrorInvalidOffset)
 	if svcErr != nil {
 		handleError(w, svcErr)
 		return
 	}
+	limit, offset := pagination.Limit, pagination.Offset
 
 	// Parse include parameter to check if display names should be included
 	includeDisplay := r.URL.Query().Get(sysutils.QueryParamInclude) == sysutils.IncludeValueDisplay
@@ -402,34 +463,6 @@ func (rh *roleHandler) sanitizeAssignmentsRequest(request *AssignmentsRequest) A
 	return sanitized
 }
 
-// parsePaginationParams parses limit and offset query parameters from the request.
-func parsePaginationParams(query url.Values) (int, int, *serviceerror.ServiceError) {
-	limit := 0
-	offset := 0
-
-	if limitStr := query.Get("limit"); limitStr != "" {
-		if parsedLimit, err := strconv.Atoi(limitStr); err != nil {
-			return 0, 0, &ErrorInvalidLimit
-		} else {
-			limit = parsedLimit
-		}
-	}
-
-	if offsetStr := query.Get("offset"); offsetStr != "" {
-		if parsedOffset, err := strconv.Atoi(offsetStr); err != nil {
-			return 0, 0, &ErrorInvalidOffset
-		} else {
-			offset = parsedOffset
-		}
-	}
-
-	if limit == 0 {
-		limit = serverconst.DefaultPageSize
-	}
-
-	return limit, offset, nil
-}
-
 // toRoleCreationDetail converts HTTP CreateRoleRequest to service layer RoleCreationDetail.
 func (rh *roleHandler) toRoleCreationDetail(req CreateRoleRequest) RoleCreationDetail {
 	serviceAssignments := make([]RoleAssignment, len(req.Assignments))
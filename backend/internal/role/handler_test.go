@@ -48,7 +48,7 @@ func TestRoleHandlerTestSuite(t *testing.T) {
 func (suite *RoleHandlerTestSuite) SetupTest() {
 	suite.mockService = NewRoleServiceInterfaceMock(suite.T())
 	suite.mockAssignmentService = NewRoleAssignmentServiceInterfaceMock(suite.T())
-	suite.handler = newRoleHandler(suite.mockService, suite.mockAssignmentService)
+	suite.handler = newRoleHandler(suite.mockService, suite.mockAssignmentService, nil)
 }
 
 // HandleRoleListRequest Tests
@@ -110,6 +110,34 @@ func (suite *RoleHandlerTestSuite) TestHandleRoleListRequest_ServiceError() {
 	suite.Equal(http.StatusBadRequest, w.Code)
 }
 
+func (suite *RoleHandlerTestSuite) TestHandleRoleListRequest_All() {
+	suite.mockService.On("GetRoleList", mock.Anything, 100, 0).Return(&RoleList{
+		TotalResults: 2,
+		StartIndex:   1,
+		Count:        2,
+		Roles: []Role{
+			{ID: "role1", Name: "Admin"},
+			{ID: "role2", Name: "User"},
+		},
+		Links: []utils.Link{},
+	}, nil).Once()
+
+	req := httptest.NewRequest(http.MethodGet, "/roles?all=true", nil)
+	w := httptest.NewRecorder()
+
+	suite.handler.HandleRoleListRequest(w, req)
+
+	suite.Equal(http.StatusOK, w.Code)
+
+	var response RoleListResponse
+	err := json.NewDecoder(w.Body).Decode(&response)
+	suite.NoError(err)
+	suite.Equal(2, response.TotalResults)
+	suite.Equal(2, response.Count)
+	suite.Len(response.Roles, 2)
+	suite.Empty(response.Links)
+}
+
 // HandleRolePostRequest Tests
 func (suite *RoleHandlerTestSuite) TestHandleRolePostRequest_Success() {
 	request := CreateRoleRequest{
@@ -226,6 +254,37 @@ func (suite *RoleHandlerTestSuite) TestHandleRoleGetRequest_NotFound() {
 	suite.Equal(http.StatusNotFound, w.Code)
 }
 
+func (suite *RoleHandlerTestSuite) TestHandleRoleGetByHandleRequest_Success() {
+	expectedRole := &RoleWithPermissions{ID: "role1", Name: "Admin", OUID: "ou1"}
+	suite.mockService.On("GetRoleByName", mock.Anything, "ou1", "Admin").Return(expectedRole, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/roles/by-handle/Admin?ouId=ou1", nil)
+	req.SetPathValue("handle", "Admin")
+	w := httptest.NewRecorder()
+
+	suite.handler.HandleRoleGetByHandleRequest(w, req)
+
+	suite.Equal(http.StatusOK, w.Code)
+
+	var response RoleResponse
+	err := json.NewDecoder(w.Body).Decode(&response)
+	suite.NoError(err)
+	suite.Equal("role1", response.ID)
+	suite.Equal("ou1", response.OUID)
+}
+
+func (suite *RoleHandlerTestSuite) TestHandleRoleGetByHandleRequest_MissingOU() {
+	suite.mockService.On("GetRoleByName", mock.Anything, "", "Admin").Return(nil, &ErrorMissingRoleHandle)
+
+	req := httptest.NewRequest(http.MethodGet, "/roles/by-handle/Admin", nil)
+	req.SetPathValue("handle", "Admin")
+	w := httptest.NewRecorder()
+
+	suite.handler.HandleRoleGetByHandleRequest(w, req)
+
+	suite.Equal(http.StatusBadRequest, w.Code)
+}
+
 // HandleRolePutRequest Tests
 func (suite *RoleHandlerTestSuite) TestHandleRolePutRequest_Success() {
 	request := UpdateRoleRequest{
@@ -456,14 +515,14 @@ func (suite *RoleHandlerTestSuite) TestParsePaginationParams() {
 	for _, tc := range testCases {
 		suite.T().Run(tc.name, func(t *testing.T) {
 			query, _ := url.ParseQuery(tc.queryString)
-			limit, offset, err := parsePaginationParams(query)
+			pagination, err := utils.ParsePaginationParams(query, &ErrorInvalidLimit, &ErrorInvalidOffset)
 
 			if tc.expectError {
 				suite.NotNil(err)
 			} else {
 				suite.Nil(err)
-				suite.Equal(tc.expectedLimit, limit)
-				suite.Equal(tc.expectedOffset, offset)
+				suite.Equal(tc.expectedLimit, pagination.Limit)
+				suite.Equal(tc.expectedOffset, pagination.Offset)
 			}
 		})
 	}
@@ -22,6 +22,7 @@ import (
 	"net/http"
 	"strings"
 
+	"github.com/thunder-id/thunderid/internal/changerequest"
 	"github.com/thunder-id/thunderid/internal/entity"
 	"github.com/thunder-id/thunderid/internal/entitytype"
 	"github.com/thunder-id/thunderid/internal/group"
@@ -30,6 +31,7 @@ import (
 	serverconst "github.com/thunder-id/thunderid/internal/system/constants"
 	declarativeresource "github.com/thunder-id/thunderid/internal/system/declarative_resource"
 	"github.com/thunder-id/thunderid/internal/system/middleware"
+	"github.com/thunder-id/thunderid/internal/system/observability"
 	"github.com/thunder-id/thunderid/internal/system/transaction"
 )
 
@@ -41,6 +43,8 @@ func Initialize(
 	ouService oupkg.OrganizationUnitServiceInterface,
 	resourceService resourcepkg.ResourceServiceInterface,
 	entityTypeService entitytype.EntityTypeServiceInterface,
+	changeRequestService changerequest.ChangeRequestServiceInterface,
+	observabilitySvc observability.ObservabilityServiceInterface,
 ) (RoleServiceInterface, RoleAssignmentServiceInterface, declarativeresource.ResourceExporter, error) {
 	// Step 1: Initialize store and transactioner based on store mode
 	roleStore, transactioner, err := initializeStore()
@@ -54,9 +58,12 @@ func Initialize(
 		transactioner,
 	)
 	assignmentService := newRoleAssignmentService(
-		roleStore, entityService, groupService, entityTypeService, transactioner,
+		roleStore, entityService, groupService, entityTypeService, transactioner, observabilitySvc,
 	)
-	roleHandler := newRoleHandler(roleService, assignmentService)
+	if changeRequestService != nil {
+		changeRequestService.RegisterOperation(changerequest.OperationRoleUpdate, newRoleUpdateApplier(roleService))
+	}
+	roleHandler := newRoleHandler(roleService, assignmentService, changeRequestService)
 	registerRoutes(mux, roleHandler)
 	exporter := newRoleExporter(roleService, assignmentService)
 	return roleService, assignmentService, exporter, nil
@@ -136,11 +143,16 @@ func registerRoutes(mux *http.ServeMux, roleHandler *roleHandler) {
 		AllowCredentials: true,
 		MaxAge:           600,
 	}
-	// Special handling for /roles/{id} and /roles/{id}/assignments
+	// Special handling for /roles/by-handle/{name}, /roles/{id} and /roles/{id}/assignments
 	mux.HandleFunc(middleware.WithCORS("GET /roles/",
 		func(w http.ResponseWriter, r *http.Request) {
 			path := strings.TrimPrefix(r.URL.Path, "/roles/")
 			segments := strings.Split(path, "/")
+			if len(segments) == 2 && segments[0] == "by-handle" && segments[1] != "" {
+				r.SetPathValue("handle", segments[1])
+				roleHandler.HandleRoleGetByHandleRequest(w, r)
+				return
+			}
 			r.SetPathValue("id", segments[0])
 
 			if len(segments) == 1 {
@@ -165,6 +177,10 @@ func registerRoutes(mux *http.ServeMux, roleHandler *roleHandler) {
 	mux.HandleFunc(middleware.WithCORS("OPTIONS /roles/{id}/assignments", func(w http.ResponseWriter, r *http.Request) {
 		w.WriteHeader(http.StatusNoContent)
 	}, opts4))
+	// Catch-all for preflight requests on /roles/by-handle/{name}
+	mux.HandleFunc(middleware.WithCORS("OPTIONS /roles/", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}, opts4))
 
 	opts3 := middleware.CORSOptions{
 		AllowedMethods:   []string{"POST"},
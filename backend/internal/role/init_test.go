@@ -23,6 +23,7 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"net/http/httptest"
 	"os"
 	"path/filepath"
 	"testing"
@@ -657,7 +658,7 @@ func (suite *InitTestSuite) TestInitialize_DBClientError() {
 	}()
 
 	mux := http.NewServeMux()
-	_, _, _, err := Initialize(mux, nil, nil, nil, nil, nil)
+	_, _, _, err := Initialize(mux, nil, nil, nil, nil, nil, nil, nil)
 
 	suite.Error(err)
 	suite.Equal("mock db client error", err.Error())
@@ -681,7 +682,7 @@ func (suite *InitTestSuite) TestInitialize_TransactionerError() {
 	}()
 
 	mux := http.NewServeMux()
-	_, _, _, err := Initialize(mux, nil, nil, nil, nil, nil)
+	_, _, _, err := Initialize(mux, nil, nil, nil, nil, nil, nil, nil)
 
 	suite.Error(err)
 	suite.Equal("mock transactioner error", err.Error())
@@ -712,7 +713,7 @@ func (suite *InitTestSuite) TestInitialize_Success() {
 	}()
 
 	mux := http.NewServeMux()
-	svc, _, exporter, err := Initialize(mux, nil, nil, nil, nil, nil)
+	svc, _, exporter, err := Initialize(mux, nil, nil, nil, nil, nil, nil, nil)
 
 	suite.NoError(err)
 	suite.NotNil(svc)
@@ -721,6 +722,27 @@ func (suite *InitTestSuite) TestInitialize_Success() {
 	mockClient.AssertExpectations(suite.T())
 }
 
+// TestRegisterRoutes_ByHandleDispatch tests that by-handle lookups are routed ahead of ID lookups.
+func (suite *InitTestSuite) TestRegisterRoutes_ByHandleDispatch() {
+	mockService := NewRoleServiceInterfaceMock(suite.T())
+	mockService.On("GetRoleByName", mock.Anything, "ou1", "Admin").
+		Return(&RoleWithPermissions{ID: "role1", Name: "Admin", OUID: "ou1"}, nil).Once()
+	mux := http.NewServeMux()
+	registerRoutes(mux, newRoleHandler(mockService, nil, nil))
+
+	w := httptest.NewRecorder()
+	mux.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/roles/by-handle/Admin?ouId=ou1", nil))
+	suite.Equal(http.StatusOK, w.Code)
+
+	w = httptest.NewRecorder()
+	mux.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/roles/by-handle/Admin/extra", nil))
+	suite.Equal(http.StatusNotFound, w.Code)
+
+	w = httptest.NewRecorder()
+	mux.ServeHTTP(w, httptest.NewRequest(http.MethodOptions, "/roles/by-handle/Admin", nil))
+	suite.Equal(http.StatusNoContent, w.Code)
+}
+
 // TestInitialize_StoreInitError tests Initialize when store initialization fails
 func (suite *InitTestSuite) TestInitialize_StoreInitError() {
 	config.ResetServerRuntime()
@@ -748,7 +770,7 @@ func (suite *InitTestSuite) TestInitialize_StoreInitError() {
 	}()
 
 	mux := http.NewServeMux()
-	svc, _, exporter, err := Initialize(mux, nil, nil, nil, nil, nil)
+	svc, _, exporter, err := Initialize(mux, nil, nil, nil, nil, nil, nil, nil)
 
 	suite.Error(err)
 	if err != nil {
@@ -42,6 +42,7 @@ type RoleServiceInterface interface {
 	CreateRole(ctx context.Context, role RoleCreationDetail) (
 		*RoleWithPermissionsAndAssignments, *serviceerror.ServiceError)
 	GetRoleWithPermissions(ctx context.Context, id string) (*RoleWithPermissions, *serviceerror.ServiceError)
+	GetRoleByName(ctx context.Context, ouID, name string) (*RoleWithPermissions, *serviceerror.ServiceError)
 	UpdateRoleWithPermissions(ctx context.Context, id string, role RoleUpdateDetail) (
 		*RoleWithPermissions, *serviceerror.ServiceError)
 	DeleteRole(ctx context.Context, id string) *serviceerror.ServiceError
@@ -50,6 +51,7 @@ type RoleServiceInterface interface {
 		ctx context.Context, entityID string, groups []string, requestedPermissions []string,
 	) ([]string, *serviceerror.ServiceError)
 	GetUserRoles(ctx context.Context, entityID string, groupIDs []string) ([]string, *serviceerror.ServiceError)
+	GetEntityRoles(ctx context.Context, entityID string, groupIDs []string) ([]Role, *serviceerror.ServiceError)
 }
 
 // roleService is the default implementation of the RoleServiceInterface.
@@ -89,16 +91,22 @@ func (rs *roleService) GetRoleList(ctx context.Context, limit, offset int) (*Rol
 		return nil, err
 	}
 
-	totalCount, err := rs.roleStore.GetRoleListCount(ctx)
-	if err != nil {
-		if errors.Is(err, errResultLimitExceededInCompositeMode) {
-			return nil, &ResultLimitExceededInCompositeMode
+	skipCount := utils.IsCountSkipped(ctx)
+	totalCount, fetchLimit := utils.TotalCountUnknown, limit+1
+	if !skipCount {
+		var err error
+		totalCount, err = rs.roleStore.GetRoleListCount(ctx)
+		if err != nil {
+			if errors.Is(err, errResultLimitExceededInCompositeMode) {
+				return nil, &ResultLimitExceededInCompositeMode
+			}
+			logger.Error("Failed to get role count", log.Error(err))
+			return nil, &serviceerror.InternalServerError
 		}
-		logger.Error("Failed to get role count", log.Error(err))
-		return nil, &serviceerror.InternalServerError
+		fetchLimit = limit
 	}
 
-	roles, err := rs.roleStore.GetRoleList(ctx, limit, offset)
+	roles, err := rs.roleStore.GetRoleList(ctx, fetchLimit, offset)
 	if err != nil {
 		if errors.Is(err, errResultLimitExceededInCompositeMode) {
 			return nil, &ResultLimitExceededInCompositeMode
@@ -106,6 +114,7 @@ func (rs *roleService) GetRoleList(ctx context.Context, limit, offset int) (*Rol
 		logger.Error("Failed to list roles", log.Error(err))
 		return nil, &serviceerror.InternalServerError
 	}
+	roles, hasMore := utils.TrimPage(roles, limit)
 
 	if len(roles) > 0 {
 		seen := make(map[string]struct{}, len(roles))
@@ -133,7 +142,7 @@ func (rs *roleService) GetRoleList(ctx context.Context, limit, offset int) (*Rol
 		Roles:        roles,
 		StartIndex:   offset + 1,
 		Count:        len(roles),
-		Links:        utils.BuildPaginationLinks("/roles", limit, offset, totalCount, ""),
+		Links:        utils.BuildListPaginationLinks("/roles", limit, offset, totalCount, hasMore, ""),
 	}
 
 	return response, nil
@@ -272,6 +281,28 @@ func (rs *roleService) GetRoleWithPermissions(ctx context.Context, id string) (
 	return &role, nil
 }
 
+// GetRoleByName retrieves a role by its name, which is unique within an organization unit.
+func (rs *roleService) GetRoleByName(ctx context.Context, ouID, name string) (
+	*RoleWithPermissions, *serviceerror.ServiceError) {
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName))
+
+	if ouID == "" || name == "" {
+		return nil, &ErrorMissingRoleHandle
+	}
+
+	id, err := rs.roleStore.GetRoleIDByName(ctx, ouID, name)
+	if err != nil {
+		if errors.Is(err, ErrRoleNotFound) {
+			logger.Debug("Role not found by name", log.String("ouId", ouID), log.String("name", name))
+			return nil, &ErrorRoleNotFound
+		}
+		logger.Error("Failed to resolve role by name", log.String("ouId", ouID), log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+
+	return rs.GetRoleWithPermissions(ctx, id)
+}
+
 // UpdateRole updates an existing role.
 func (rs *roleService) UpdateRoleWithPermissions(
 	ctx context.Context, id string, role RoleUpdateDetail) (*RoleWithPermissions, *serviceerror.ServiceError) {
@@ -460,6 +491,27 @@ func (rs *roleService) GetUserRoles(
 	return roles, nil
 }
 
+// GetEntityRoles retrieves the roles, including their organization units, assigned to an entity
+// directly and/or through group membership.
+func (rs *roleService) GetEntityRoles(
+	ctx context.Context, entityID string, groupIDs []string,
+) ([]Role, *serviceerror.ServiceError) {
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName))
+
+	if entityID == "" && len(groupIDs) == 0 {
+		return []Role{}, nil
+	}
+
+	roles, err := rs.roleStore.GetEntityRoles(ctx, entityID, groupIDs)
+	if err != nil {
+		logger.Error("Failed to get entity role details",
+			log.MaskedString("entityID", entityID), log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+
+	return roles, nil
+}
+
 // IsRoleDeclarative returns true if the role is declarative.
 func (rs *roleService) IsRoleDeclarative(ctx context.Context, id string) (bool, *serviceerror.ServiceError) {
 	isDeclarative, err := rs.roleStore.IsRoleDeclarative(ctx, id)
@@ -138,6 +138,27 @@ func (suite *RoleServiceTestSuite) TestGetRoleList_Success() {
 	suite.Equal("default", result.Roles[1].OUHandle)
 }
 
+func (suite *RoleServiceTestSuite) TestGetRoleList_SkipCount() {
+	storedRoles := []Role{
+		{ID: "role1", Name: "Admin", OUID: "ou1"},
+		{ID: "role2", Name: "User", OUID: "ou1"},
+		{ID: "role3", Name: "Viewer", OUID: "ou1"},
+	}
+	suite.mockStore.On("GetRoleList", mock.Anything, 3, 0).Return(storedRoles, nil)
+	suite.mockOUService.On("GetOrganizationUnitHandlesByIDs", mock.Anything,
+		[]string{"ou1"}).Return(map[string]string{"ou1": "default"}, nil)
+
+	ctx := utils.WithSkipCount(context.Background(), true)
+	result, err := suite.service.GetRoleList(ctx, 2, 0)
+
+	suite.Nil(err)
+	suite.Equal(utils.TotalCountUnknown, result.TotalResults)
+	suite.Equal(2, result.Count)
+	suite.Len(result.Links, 1)
+	suite.Equal("/roles?offset=2&limit=2&count=false", result.Links[0].Href)
+	suite.mockStore.AssertNotCalled(suite.T(), "GetRoleListCount", mock.Anything)
+}
+
 func (suite *RoleServiceTestSuite) TestGetRoleList_InvalidPagination() {
 	testCases := []struct {
 		name    string
@@ -704,6 +725,49 @@ func (suite *RoleServiceTestSuite) TestGetRole_StoreError() {
 	suite.Equal(serviceerror.InternalServerError.Code, err.Code)
 }
 
+// GetRoleByName Tests
+func (suite *RoleServiceTestSuite) TestGetRoleByName_Success() {
+	suite.mockStore.On("GetRoleIDByName", mock.Anything, "ou1", "Admin").Return("role1", nil)
+	suite.mockStore.On("GetRole", mock.Anything, "role1").
+		Return(RoleWithPermissions{ID: "role1", Name: "Admin", OUID: "ou1"}, nil)
+	suite.mockOUService.On("GetOrganizationUnit", mock.Anything,
+		"ou1").Return(oupkg.OrganizationUnit{ID: "ou1", Handle: "default"}, nil)
+
+	result, err := suite.service.GetRoleByName(context.Background(), "ou1", "Admin")
+
+	suite.Nil(err)
+	suite.Equal("role1", result.ID)
+	suite.Equal("default", result.OUHandle)
+}
+
+func (suite *RoleServiceTestSuite) TestGetRoleByName_MissingHandle() {
+	result, err := suite.service.GetRoleByName(context.Background(), "", "Admin")
+	suite.Nil(result)
+	suite.Equal(ErrorMissingRoleHandle.Code, err.Code)
+
+	result, err = suite.service.GetRoleByName(context.Background(), "ou1", "")
+	suite.Nil(result)
+	suite.Equal(ErrorMissingRoleHandle.Code, err.Code)
+}
+
+func (suite *RoleServiceTestSuite) TestGetRoleByName_NotFound() {
+	suite.mockStore.On("GetRoleIDByName", mock.Anything, "ou1", "Missing").Return("", ErrRoleNotFound)
+
+	result, err := suite.service.GetRoleByName(context.Background(), "ou1", "Missing")
+
+	suite.Nil(result)
+	suite.Equal(ErrorRoleNotFound.Code, err.Code)
+}
+
+func (suite *RoleServiceTestSuite) TestGetRoleByName_StoreError() {
+	suite.mockStore.On("GetRoleIDByName", mock.Anything, "ou1", "Admin").Return("", errors.New("database error"))
+
+	result, err := suite.service.GetRoleByName(context.Background(), "ou1", "Admin")
+
+	suite.Nil(result)
+	suite.Equal(serviceerror.InternalServerError.Code, err.Code)
+}
+
 // UpdateRole Tests
 func (suite *RoleServiceTestSuite) TestUpdateRole_MissingRoleID() {
 	request := RoleUpdateDetail{
@@ -1457,3 +1521,31 @@ func (suite *RoleServiceTestSuite) TestIsRoleDeclarative_StoreReturnsError() {
 	suite.False(isDeclarative)
 	suite.Equal(&serviceerror.InternalServerError, err)
 }
+
+func (suite *RoleServiceTestSuite) TestGetEntityRoles() {
+	expected := []Role{{ID: "role1", Name: "admin", OUID: "ou1"}}
+	suite.mockStore.On("GetEntityRoles", mock.Anything, testUserID1, []string{"group1"}).Return(expected, nil)
+
+	roles, err := suite.service.GetEntityRoles(context.Background(), testUserID1, []string{"group1"})
+
+	suite.Nil(err)
+	suite.Equal(expected, roles)
+}
+
+func (suite *RoleServiceTestSuite) TestGetEntityRoles_NoAssignees() {
+	roles, err := suite.service.GetEntityRoles(context.Background(), "", nil)
+
+	suite.Nil(err)
+	suite.Empty(roles)
+	suite.mockStore.AssertNotCalled(suite.T(), "GetEntityRoles", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *RoleServiceTestSuite) TestGetEntityRoles_StoreError() {
+	suite.mockStore.On("GetEntityRoles", mock.Anything, testUserID1, []string(nil)).
+		Return(nil, errors.New("db error"))
+
+	roles, err := suite.service.GetEntityRoles(context.Background(), testUserID1, nil)
+
+	suite.Nil(roles)
+	suite.Equal(&serviceerror.InternalServerError, err)
+}
@@ -24,6 +24,7 @@ import (
 
 	"github.com/thunder-id/thunderid/internal/system/config"
 	serverconst "github.com/thunder-id/thunderid/internal/system/constants"
+	sysContext "github.com/thunder-id/thunderid/internal/system/context"
 	"github.com/thunder-id/thunderid/internal/system/database/provider"
 	"github.com/thunder-id/thunderid/internal/system/log"
 	"github.com/thunder-id/thunderid/internal/system/transaction"
@@ -50,11 +51,14 @@ type roleStoreInterface interface {
 	DeleteAssignmentsByRoleID(ctx context.Context, id string) error
 	AddAssignments(ctx context.Context, id string, assignments []RoleAssignment) error
 	RemoveAssignments(ctx context.Context, id string, assignments []RoleAssignment) error
+	DeleteAssignmentsByAssignee(ctx context.Context, assignment RoleAssignment) error
 	CheckRoleNameExists(ctx context.Context, ouID, name string) (bool, error)
 	CheckRoleNameExistsExcludingID(ctx context.Context, ouID, name, excludeRoleID string) (bool, error)
+	GetRoleIDByName(ctx context.Context, ouID, name string) (string, error)
 	GetAuthorizedPermissions(
 		ctx context.Context, entityID string, groupIDs []string, requestedPermissions []string) ([]string, error)
 	GetUserRoles(ctx context.Context, entityID string, groupIDs []string) ([]string, error)
+	GetEntityRoles(ctx context.Context, entityID string, groupIDs []string) ([]Role, error)
 	// GetEntityRoleIDs returns the set of role IDs assigned to the entity directly or via
 	// group membership. Unlike GetUserRoles this does not require the role to exist in the
 	// underlying store; it returns raw assignee->role bindings. Used by the composite store
@@ -89,12 +93,13 @@ func newRoleStore() (roleStoreInterface, transaction.Transactioner, error) {
 
 // GetRoleListCount retrieves the total count of roles.
 func (s *roleStore) GetRoleListCount(ctx context.Context) (int, error) {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
 	dbClient, err := s.getConfigDBClient()
 	if err != nil {
 		return 0, err
 	}
 
-	countResults, err := dbClient.QueryContext(ctx, queryGetRoleListCount, s.deploymentID)
+	countResults, err := dbClient.QueryContext(ctx, queryGetRoleListCount, deploymentID)
 	if err != nil {
 		return 0, fmt.Errorf("failed to execute count query: %w", err)
 	}
@@ -104,12 +109,13 @@ func (s *roleStore) GetRoleListCount(ctx context.Context) (int, error) {
 
 // GetRoleList retrieves roles with pagination.
 func (s *roleStore) GetRoleList(ctx context.Context, limit, offset int) ([]Role, error) {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
 	dbClient, err := s.getConfigDBClient()
 	if err != nil {
 		return nil, err
 	}
 
-	results, err := dbClient.QueryContext(ctx, queryGetRoleList, limit, offset, s.deploymentID)
+	results, err := dbClient.QueryContext(ctx, queryGetRoleList, limit, offset, deploymentID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to execute role list query: %w", err)
 	}
@@ -128,6 +134,7 @@ func (s *roleStore) GetRoleList(ctx context.Context, limit, offset int) ([]Role,
 
 // CreateRole creates a new role in the database.
 func (s *roleStore) CreateRole(ctx context.Context, id string, role RoleCreationDetail) error {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
 	dbClient, err := s.getConfigDBClient()
 	if err != nil {
 		return err
@@ -139,17 +146,17 @@ func (s *roleStore) CreateRole(ctx context.Context, id string, role RoleCreation
 		role.OUID,
 		role.Name,
 		role.Description,
-		s.deploymentID,
+		deploymentID,
 	)
 	if err != nil {
 		return fmt.Errorf("failed to execute query: %w", err)
 	}
 
-	if err := addPermissionsToRole(ctx, dbClient, id, role.Permissions, s.deploymentID); err != nil {
+	if err := addPermissionsToRole(ctx, dbClient, id, role.Permissions, deploymentID); err != nil {
 		return err
 	}
 
-	if err := addAssignmentsToRole(ctx, dbClient, id, role.Assignments, s.deploymentID); err != nil {
+	if err := addAssignmentsToRole(ctx, dbClient, id, role.Assignments, deploymentID); err != nil {
 		return err
 	}
 
@@ -158,12 +165,13 @@ func (s *roleStore) CreateRole(ctx context.Context, id string, role RoleCreation
 
 // GetRole retrieves a role by its id.
 func (s *roleStore) GetRole(ctx context.Context, id string) (RoleWithPermissions, error) {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
 	dbClient, err := s.getConfigDBClient()
 	if err != nil {
 		return RoleWithPermissions{}, err
 	}
 
-	results, err := dbClient.QueryContext(ctx, queryGetRoleByID, id, s.deploymentID)
+	results, err := dbClient.QueryContext(ctx, queryGetRoleByID, id, deploymentID)
 	if err != nil {
 		return RoleWithPermissions{}, fmt.Errorf("failed to execute query: %w", err)
 	}
@@ -198,12 +206,13 @@ func (s *roleStore) GetRole(ctx context.Context, id string) (RoleWithPermissions
 
 // IsRoleExist checks if a role exists by its ID without fetching its details.
 func (s *roleStore) IsRoleExist(ctx context.Context, id string) (bool, error) {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
 	dbClient, err := s.getConfigDBClient()
 	if err != nil {
 		return false, err
 	}
 
-	results, err := dbClient.QueryContext(ctx, queryCheckRoleExists, id, s.deploymentID)
+	results, err := dbClient.QueryContext(ctx, queryCheckRoleExists, id, deploymentID)
 	if err != nil {
 		return false, fmt.Errorf("failed to check role existence: %w", err)
 	}
@@ -213,12 +222,13 @@ func (s *roleStore) IsRoleExist(ctx context.Context, id string) (bool, error) {
 
 // GetRoleAssignments retrieves assignments for a role with pagination.
 func (s *roleStore) GetRoleAssignments(ctx context.Context, id string, limit, offset int) ([]RoleAssignment, error) {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
 	dbClient, err := s.getConfigDBClient()
 	if err != nil {
 		return nil, err
 	}
 
-	results, err := dbClient.QueryContext(ctx, queryGetRoleAssignments, id, limit, offset, s.deploymentID)
+	results, err := dbClient.QueryContext(ctx, queryGetRoleAssignments, id, limit, offset, deploymentID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get role assignments: %w", err)
 	}
@@ -230,13 +240,14 @@ func (s *roleStore) GetRoleAssignments(ctx context.Context, id string, limit, of
 func (s *roleStore) GetRoleAssignmentsByType(
 	ctx context.Context, id string, limit, offset int, assigneeType string,
 ) ([]RoleAssignment, error) {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
 	dbClient, err := s.getConfigDBClient()
 	if err != nil {
 		return nil, err
 	}
 
 	results, err := dbClient.QueryContext(
-		ctx, queryGetRoleAssignmentsByType, id, limit, offset, s.deploymentID, assigneeType)
+		ctx, queryGetRoleAssignmentsByType, id, limit, offset, deploymentID, assigneeType)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get role assignments: %w", err)
 	}
@@ -267,12 +278,13 @@ func parseAssignmentResults(results []map[string]interface{}) ([]RoleAssignment,
 
 // GetRoleAssignmentsCount retrieves the total count of assignments for a role.
 func (s *roleStore) GetRoleAssignmentsCount(ctx context.Context, id string) (int, error) {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
 	dbClient, err := s.getConfigDBClient()
 	if err != nil {
 		return 0, err
 	}
 
-	countResults, err := dbClient.QueryContext(ctx, queryGetRoleAssignmentsCount, id, s.deploymentID)
+	countResults, err := dbClient.QueryContext(ctx, queryGetRoleAssignmentsCount, id, deploymentID)
 	if err != nil {
 		return 0, fmt.Errorf("failed to get role assignments count: %w", err)
 	}
@@ -282,13 +294,14 @@ func (s *roleStore) GetRoleAssignmentsCount(ctx context.Context, id string) (int
 
 // GetRoleAssignmentsCountByType retrieves the total count of assignments for a role filtered by type.
 func (s *roleStore) GetRoleAssignmentsCountByType(ctx context.Context, id string, assigneeType string) (int, error) {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
 	dbClient, err := s.getConfigDBClient()
 	if err != nil {
 		return 0, err
 	}
 
 	countResults, err := dbClient.QueryContext(
-		ctx, queryGetRoleAssignmentsCountByType, id, s.deploymentID, assigneeType)
+		ctx, queryGetRoleAssignmentsCountByType, id, deploymentID, assigneeType)
 	if err != nil {
 		return 0, fmt.Errorf("failed to get role assignments count: %w", err)
 	}
@@ -298,6 +311,7 @@ func (s *roleStore) GetRoleAssignmentsCountByType(ctx context.Context, id string
 
 // UpdateRole updates an existing role.
 func (s *roleStore) UpdateRole(ctx context.Context, id string, role RoleUpdateDetail) error {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
 	dbClient, err := s.getConfigDBClient()
 	if err != nil {
 		return err
@@ -309,7 +323,7 @@ func (s *roleStore) UpdateRole(ctx context.Context, id string, role RoleUpdateDe
 		role.Name,
 		role.Description,
 		id,
-		s.deploymentID,
+		deploymentID,
 	)
 	if err != nil {
 		return fmt.Errorf("failed to execute query: %w", err)
@@ -319,7 +333,7 @@ func (s *roleStore) UpdateRole(ctx context.Context, id string, role RoleUpdateDe
 		return ErrRoleNotFound
 	}
 
-	if err := updateRolePermissions(ctx, dbClient, id, role.Permissions, s.deploymentID); err != nil {
+	if err := updateRolePermissions(ctx, dbClient, id, role.Permissions, deploymentID); err != nil {
 		return err
 	}
 
@@ -328,6 +342,7 @@ func (s *roleStore) UpdateRole(ctx context.Context, id string, role RoleUpdateDe
 
 // DeleteRole deletes a role.
 func (s *roleStore) DeleteRole(ctx context.Context, id string) error {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
 	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, storeLoggerComponentName))
 
 	dbClient, err := s.getConfigDBClient()
@@ -335,7 +350,7 @@ func (s *roleStore) DeleteRole(ctx context.Context, id string) error {
 		return err
 	}
 
-	rowsAffected, err := dbClient.ExecuteContext(ctx, queryDeleteRole, id, s.deploymentID)
+	rowsAffected, err := dbClient.ExecuteContext(ctx, queryDeleteRole, id, deploymentID)
 	if err != nil {
 		return fmt.Errorf("failed to execute query: %w", err)
 	}
@@ -349,12 +364,13 @@ func (s *roleStore) DeleteRole(ctx context.Context, id string) error {
 
 // DeleteAssignmentsByRoleID deletes all assignments for a role. Used for cascade delete.
 func (s *roleStore) DeleteAssignmentsByRoleID(ctx context.Context, id string) error {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
 	dbClient, err := s.getConfigDBClient()
 	if err != nil {
 		return err
 	}
 
-	_, err = dbClient.ExecuteContext(ctx, queryDeleteAllRoleAssignments, id, s.deploymentID)
+	_, err = dbClient.ExecuteContext(ctx, queryDeleteAllRoleAssignments, id, deploymentID)
 	if err != nil {
 		return fmt.Errorf("failed to delete assignments for role: %w", err)
 	}
@@ -363,16 +379,18 @@ func (s *roleStore) DeleteAssignmentsByRoleID(ctx context.Context, id string) er
 
 // AddAssignments adds assignments to a role.
 func (s *roleStore) AddAssignments(ctx context.Context, id string, assignments []RoleAssignment) error {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
 	dbClient, err := s.getConfigDBClient()
 	if err != nil {
 		return err
 	}
 
-	return addAssignmentsToRole(ctx, dbClient, id, assignments, s.deploymentID)
+	return addAssignmentsToRole(ctx, dbClient, id, assignments, deploymentID)
 }
 
 // RemoveAssignments removes assignments from a role.
 func (s *roleStore) RemoveAssignments(ctx context.Context, id string, assignments []RoleAssignment) error {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
 	dbClient, err := s.getConfigDBClient()
 	if err != nil {
 		return err
@@ -380,7 +398,7 @@ func (s *roleStore) RemoveAssignments(ctx context.Context, id string, assignment
 
 	for _, assignment := range assignments {
 		_, err := dbClient.ExecuteContext(
-			ctx, queryDeleteRoleAssignmentsByIDs, id, assignment.Type, assignment.ID, s.deploymentID)
+			ctx, queryDeleteRoleAssignmentsByIDs, id, assignment.Type, assignment.ID, deploymentID)
 		if err != nil {
 			return fmt.Errorf("failed to remove assignment from role: %w", err)
 		}
@@ -388,10 +406,27 @@ func (s *roleStore) RemoveAssignments(ctx context.Context, id string, assignment
 	return nil
 }
 
+// DeleteAssignmentsByAssignee deletes all assignments of an assignee across roles.
+func (s *roleStore) DeleteAssignmentsByAssignee(ctx context.Context, assignment RoleAssignment) error {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
+	dbClient, err := s.getConfigDBClient()
+	if err != nil {
+		return err
+	}
+
+	_, err = dbClient.ExecuteContext(
+		ctx, queryDeleteRoleAssignmentsByAssignee, assignment.Type, assignment.ID, deploymentID)
+	if err != nil {
+		return fmt.Errorf("failed to delete assignments for assignee: %w", err)
+	}
+	return nil
+}
+
 // getRolePermissions retrieves all permissions for a role.
 func (s *roleStore) getRolePermissions(
 	ctx context.Context, dbClient provider.DBClientInterface, id string) ([]ResourcePermissions, error) {
-	results, err := dbClient.QueryContext(ctx, queryGetRolePermissions, id, s.deploymentID)
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
+	results, err := dbClient.QueryContext(ctx, queryGetRolePermissions, id, deploymentID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get role permissions: %w", err)
 	}
@@ -507,12 +542,13 @@ func updateRolePermissions(
 
 // CheckRoleNameExists checks if a role with the given name exists in the specified organization unit.
 func (s *roleStore) CheckRoleNameExists(ctx context.Context, ouID, name string) (bool, error) {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
 	dbClient, err := s.getConfigDBClient()
 	if err != nil {
 		return false, err
 	}
 
-	results, err := dbClient.QueryContext(ctx, queryCheckRoleNameExists, ouID, name, s.deploymentID)
+	results, err := dbClient.QueryContext(ctx, queryCheckRoleNameExists, ouID, name, deploymentID)
 	if err != nil {
 		return false, fmt.Errorf("failed to check role name existence: %w", err)
 	}
@@ -520,17 +556,37 @@ func (s *roleStore) CheckRoleNameExists(ctx context.Context, ouID, name string)
 	return parseBoolFromCount(results)
 }
 
+// GetRoleIDByName retrieves the ID of the role with the given name in the specified organization unit.
+func (s *roleStore) GetRoleIDByName(ctx context.Context, ouID, name string) (string, error) {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
+	dbClient, err := s.getConfigDBClient()
+	if err != nil {
+		return "", err
+	}
+
+	results, err := dbClient.QueryContext(ctx, queryGetRoleIDByName, ouID, name, deploymentID)
+	if err != nil {
+		return "", fmt.Errorf("failed to execute query: %w", err)
+	}
+	if len(results) == 0 {
+		return "", ErrRoleNotFound
+	}
+
+	return parseStringField(results[0], "id")
+}
+
 // CheckRoleNameExistsExcludingID checks if a role with the given name exists in the specified organization unit,
 // excluding the role with the given ID.
 func (s *roleStore) CheckRoleNameExistsExcludingID(
 	ctx context.Context, ouID, name, excludeRoleID string) (bool, error) {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
 	dbClient, err := s.getConfigDBClient()
 	if err != nil {
 		return false, err
 	}
 
 	results, err := dbClient.QueryContext(
-		ctx, queryCheckRoleNameExistsExcludingID, ouID, name, excludeRoleID, s.deploymentID)
+		ctx, queryCheckRoleNameExistsExcludingID, ouID, name, excludeRoleID, deploymentID)
 	if err != nil {
 		return false, fmt.Errorf("failed to check role name existence: %w", err)
 	}
@@ -546,6 +602,7 @@ func (s *roleStore) GetAuthorizedPermissions(
 	groupIDs []string,
 	requestedPermissions []string,
 ) ([]string, error) {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
 	dbClient, err := s.getConfigDBClient()
 	if err != nil {
 		return nil, err
@@ -557,7 +614,7 @@ func (s *roleStore) GetAuthorizedPermissions(
 	}
 
 	// Build dynamic query based on provided parameters
-	query, args := buildAuthorizedPermissionsQuery(entityID, groupIDs, requestedPermissions, s.deploymentID)
+	query, args := buildAuthorizedPermissionsQuery(entityID, groupIDs, requestedPermissions, deploymentID)
 
 	results, err := dbClient.QueryContext(ctx, query, args...)
 	if err != nil {
@@ -578,6 +635,7 @@ func (s *roleStore) GetAuthorizedPermissions(
 func (s *roleStore) GetUserRoles(
 	ctx context.Context, entityID string, groupIDs []string,
 ) ([]string, error) {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
 	dbClient, err := s.getConfigDBClient()
 	if err != nil {
 		return nil, err
@@ -587,7 +645,7 @@ func (s *roleStore) GetUserRoles(
 		groupIDs = []string{}
 	}
 
-	query, args := buildUserRolesQuery(entityID, groupIDs, s.deploymentID)
+	query, args := buildUserRolesQuery(entityID, groupIDs, deploymentID)
 
 	results, err := dbClient.QueryContext(ctx, query, args...)
 	if err != nil {
@@ -604,6 +662,45 @@ func (s *roleStore) GetUserRoles(
 	return roles, nil
 }
 
+// GetEntityRoles retrieves the ID, name and organization unit of roles assigned to an entity
+// directly and/or through group membership.
+func (s *roleStore) GetEntityRoles(
+	ctx context.Context, entityID string, groupIDs []string,
+) ([]Role, error) {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
+	if groupIDs == nil {
+		groupIDs = []string{}
+	}
+	if entityID == "" && len(groupIDs) == 0 {
+		return []Role{}, nil
+	}
+
+	dbClient, err := s.getConfigDBClient()
+	if err != nil {
+		return nil, err
+	}
+
+	query, args := buildEntityRolesQuery(entityID, groupIDs, deploymentID)
+
+	results, err := dbClient.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get entity role details: %w", err)
+	}
+
+	roles := make([]Role, 0, len(results))
+	for _, row := range results {
+		id, _ := row["id"].(string)
+		name, _ := row["name"].(string)
+		ouID, _ := row["ou_id"].(string)
+		if id == "" || name == "" {
+			continue
+		}
+		roles = append(roles, Role{ID: id, Name: name, OUID: ouID})
+	}
+
+	return roles, nil
+}
+
 // GetEntityRoleIDs retrieves the IDs of roles assigned to an entity directly and/or via
 // group membership, without joining the ROLE table. This surfaces assignments to roles
 // whose definitions live only in the file-based declarative store. Callers (notably the
@@ -612,6 +709,7 @@ func (s *roleStore) GetUserRoles(
 func (s *roleStore) GetEntityRoleIDs(
 	ctx context.Context, entityID string, groupIDs []string,
 ) ([]string, error) {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
 	if groupIDs == nil {
 		groupIDs = []string{}
 	}
@@ -625,7 +723,7 @@ func (s *roleStore) GetEntityRoleIDs(
 		return nil, err
 	}
 
-	query, args := buildEntityRoleIDsQuery(entityID, groupIDs, s.deploymentID)
+	query, args := buildEntityRoleIDsQuery(entityID, groupIDs, deploymentID)
 
 	results, err := dbClient.QueryContext(ctx, query, args...)
 	if err != nil {
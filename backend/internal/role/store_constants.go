@@ -116,6 +116,13 @@ var (
 		Query: `DELETE FROM "ROLE_ASSIGNMENT" WHERE ROLE_ID = $1 AND DEPLOYMENT_ID = $2`,
 	}
 
+	// queryDeleteRoleAssignmentsByAssignee deletes all assignments of an assignee across roles.
+	queryDeleteRoleAssignmentsByAssignee = dbmodel.DBQuery{
+		ID: "RLQ-ROLE_MGT-23",
+		Query: `DELETE FROM "ROLE_ASSIGNMENT" ` +
+			`WHERE ASSIGNEE_TYPE = $1 AND ASSIGNEE_ID = $2 AND DEPLOYMENT_ID = $3`,
+	}
+
 	// queryCheckRoleNameExists checks if a role name already exists for a given organization unit.
 	queryCheckRoleNameExists = dbmodel.DBQuery{
 		ID:    "RLQ-ROLE_MGT-14",
@@ -129,6 +136,12 @@ var (
 			WHERE OU_ID = $1 AND NAME = $2 AND ID != $3 AND DEPLOYMENT_ID = $4`,
 	}
 
+	// queryGetRoleIDByName retrieves the ID of the role with the given name in an organization unit.
+	queryGetRoleIDByName = dbmodel.DBQuery{
+		ID:    "RLQ-ROLE_MGT-24",
+		Query: `SELECT ID FROM "ROLE" WHERE OU_ID = $1 AND NAME = $2 AND DEPLOYMENT_ID = $3`,
+	}
+
 	// queryCheckRoleExists checks if a role exists by its ID.
 	queryCheckRoleExists = dbmodel.DBQuery{
 		ID:    "RLQ-ROLE_MGT-16",
@@ -246,7 +259,29 @@ func buildUserRolesQuery(
 	groupIDs []string,
 	deploymentID string,
 ) (dbmodel.DBQuery, []interface{}) {
-	baseQuery := `SELECT DISTINCT r.NAME
+	return buildAssignedRolesQuery("RLQ-ROLE_MGT-21", "r.NAME", entityID, groupIDs, deploymentID)
+}
+
+// buildEntityRolesQuery constructs a database-specific query to retrieve the ID, name and
+// organization unit of roles assigned to an entity directly and/or through group membership.
+func buildEntityRolesQuery(
+	entityID string,
+	groupIDs []string,
+	deploymentID string,
+) (dbmodel.DBQuery, []interface{}) {
+	return buildAssignedRolesQuery("RLQ-ROLE_MGT-25", "r.ID, r.NAME, r.OU_ID", entityID, groupIDs, deploymentID)
+}
+
+// buildAssignedRolesQuery constructs a query selecting the given role columns for roles assigned
+// to an entity directly and/or through group membership, ordered by role name.
+func buildAssignedRolesQuery(
+	queryID string,
+	columns string,
+	entityID string,
+	groupIDs []string,
+	deploymentID string,
+) (dbmodel.DBQuery, []interface{}) {
+	baseQuery := `SELECT DISTINCT ` + columns + `
 		FROM "ROLE" r
 		INNER JOIN "ROLE_ASSIGNMENT" ra ON r.ID = ra.ROLE_ID AND r.DEPLOYMENT_ID = $1 AND ra.DEPLOYMENT_ID = $1
 		WHERE r.DEPLOYMENT_ID = $1 AND `
@@ -302,7 +337,7 @@ func buildUserRolesQuery(
 		" ORDER BY r.NAME"
 
 	query := dbmodel.DBQuery{
-		ID:            "RLQ-ROLE_MGT-21",
+		ID:            queryID,
 		Query:         postgresQuery,
 		PostgresQuery: postgresQuery,
 		SQLiteQuery:   sqliteQuery,
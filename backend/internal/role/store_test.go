@@ -1143,6 +1143,66 @@ func (suite *RoleStoreTestSuite) TestRemoveAssignments() {
 	}
 }
 
+func (suite *RoleStoreTestSuite) TestDeleteAssignmentsByAssignee() {
+	assignment := RoleAssignment{ID: "user1", Type: assigneeTypeEntity}
+	testCases := []struct {
+		name         string
+		setupMocks   func()
+		shouldErr    bool
+		errorMessage string
+	}{
+		{
+			name: "Success",
+			setupMocks: func() {
+				suite.mockDBProvider.On("GetConfigDBClient").Return(suite.mockDBClient, nil)
+				suite.mockDBClient.On("ExecuteContext", mock.Anything, queryDeleteRoleAssignmentsByAssignee,
+					assigneeTypeEntity, "user1", testDeploymentID).Return(int64(2), nil)
+			},
+		},
+		{
+			name: "ExecError",
+			setupMocks: func() {
+				suite.mockDBProvider.On("GetConfigDBClient").Return(suite.mockDBClient, nil)
+				suite.mockDBClient.On("ExecuteContext", mock.Anything, queryDeleteRoleAssignmentsByAssignee,
+					assigneeTypeEntity, "user1", testDeploymentID).Return(int64(0), errors.New("delete failed"))
+			},
+			shouldErr:    true,
+			errorMessage: "failed to delete assignments for assignee",
+		},
+		{
+			name: "DBClientError",
+			setupMocks: func() {
+				suite.mockDBProvider.On("GetConfigDBClient").Return(nil, errors.New("db client error"))
+			},
+			shouldErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.mockDBProvider = providermock.NewDBProviderInterfaceMock(suite.T())
+			suite.mockDBClient = providermock.NewDBClientInterfaceMock(suite.T())
+			suite.store = &roleStore{
+				dbProvider:   suite.mockDBProvider,
+				deploymentID: testDeploymentID,
+			}
+
+			tc.setupMocks()
+
+			err := suite.store.DeleteAssignmentsByAssignee(context.Background(), assignment)
+
+			if tc.shouldErr {
+				suite.Error(err)
+				if tc.errorMessage != "" {
+					suite.Contains(err.Error(), tc.errorMessage)
+				}
+			} else {
+				suite.NoError(err)
+			}
+		})
+	}
+}
+
 func (suite *RoleStoreTestSuite) TestCheckRoleNameExists() {
 	testCases := []struct {
 		name          string
@@ -1233,6 +1293,70 @@ func (suite *RoleStoreTestSuite) TestCheckRoleNameExists() {
 	}
 }
 
+func (suite *RoleStoreTestSuite) TestGetRoleIDByName() {
+	testCases := []struct {
+		name        string
+		setupMocks  func()
+		expectedID  string
+		expectedErr error
+		shouldErr   bool
+	}{
+		{
+			name: "Found",
+			setupMocks: func() {
+				suite.mockDBProvider.On("GetConfigDBClient").Return(suite.mockDBClient, nil)
+				suite.mockDBClient.On("QueryContext", mock.Anything, queryGetRoleIDByName, "ou1", "Admin",
+					testDeploymentID).Return([]map[string]interface{}{{"id": "role1"}}, nil)
+			},
+			expectedID: "role1",
+		},
+		{
+			name: "NotFound",
+			setupMocks: func() {
+				suite.mockDBProvider.On("GetConfigDBClient").Return(suite.mockDBClient, nil)
+				suite.mockDBClient.On("QueryContext", mock.Anything, queryGetRoleIDByName, "ou1", "Admin",
+					testDeploymentID).Return([]map[string]interface{}{}, nil)
+			},
+			expectedErr: ErrRoleNotFound,
+			shouldErr:   true,
+		},
+		{
+			name: "QueryError",
+			setupMocks: func() {
+				suite.mockDBProvider.On("GetConfigDBClient").Return(suite.mockDBClient, nil)
+				suite.mockDBClient.On("QueryContext", mock.Anything, queryGetRoleIDByName, "ou1", "Admin",
+					testDeploymentID).Return(nil, errors.New("query error"))
+			},
+			shouldErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.mockDBProvider = providermock.NewDBProviderInterfaceMock(suite.T())
+			suite.mockDBClient = providermock.NewDBClientInterfaceMock(suite.T())
+			suite.store = &roleStore{
+				dbProvider:   suite.mockDBProvider,
+				deploymentID: testDeploymentID,
+			}
+
+			tc.setupMocks()
+
+			id, err := suite.store.GetRoleIDByName(context.Background(), "ou1", "Admin")
+
+			if tc.shouldErr {
+				suite.Error(err)
+				if tc.expectedErr != nil {
+					suite.ErrorIs(err, tc.expectedErr)
+				}
+			} else {
+				suite.NoError(err)
+			}
+			suite.Equal(tc.expectedID, id)
+		})
+	}
+}
+
 func (suite *RoleStoreTestSuite) TestCheckRoleNameExistsExcludingID() {
 	testCases := []struct {
 		name          string
@@ -1905,3 +2029,44 @@ func (suite *RoleStoreTestSuite) TestGetEntityRoleIDs_IgnoresMalformedRows() {
 	suite.NoError(err)
 	suite.Equal([]string{"role-a", "role-c"}, roleIDs)
 }
+
+func (suite *RoleStoreTestSuite) TestGetEntityRoles_Success() {
+	suite.mockDBProvider.On("GetConfigDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On(
+		"QueryContext", mock.Anything, mock.Anything,
+		testDeploymentID, testUserID1, "group1",
+	).Return(
+		[]map[string]interface{}{
+			{"id": "role-a", "name": "admin", "ou_id": "ou1"},
+			{"id": "role-b", "name": "viewer", "ou_id": "ou2"},
+			{"id": 123, "name": "broken"},
+		}, nil)
+
+	roles, err := suite.store.GetEntityRoles(context.Background(), testUserID1, []string{"group1"})
+
+	suite.NoError(err)
+	suite.Equal([]Role{
+		{ID: "role-a", Name: "admin", OUID: "ou1"},
+		{ID: "role-b", Name: "viewer", OUID: "ou2"},
+	}, roles)
+}
+
+func (suite *RoleStoreTestSuite) TestGetEntityRoles_NoAssignees() {
+	roles, err := suite.store.GetEntityRoles(context.Background(), "", nil)
+
+	suite.NoError(err)
+	suite.Empty(roles)
+	suite.mockDBProvider.AssertNotCalled(suite.T(), "GetConfigDBClient")
+}
+
+func (suite *RoleStoreTestSuite) TestGetEntityRoles_QueryError() {
+	suite.mockDBProvider.On("GetConfigDBClient").Return(suite.mockDBClient, nil)
+	suite.mockDBClient.On(
+		"QueryContext", mock.Anything, mock.Anything, testDeploymentID, testUserID1,
+	).Return(nil, errors.New("query error"))
+
+	roles, err := suite.store.GetEntityRoles(context.Background(), testUserID1, nil)
+
+	suite.Error(err)
+	suite.Nil(roles)
+}
@@ -0,0 +1,294 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package scim
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/internal/user"
+)
+
+// ProcessBulk runs the operations of a bulk request in order and returns the outcome of each operation
+// that ran. An operation may refer to the resource created by an earlier POST operation with
+// "bulkId:<bulkId>" in its path or data. Operations are not atomic: a failed operation does not undo earlier
+// ones, and processing stops once the number of failed operations reaches failOnErrors. The plaintext
+// passwords of the users the request creates are hashed as one batch before the operations run.
+func (s *scimService) ProcessBulk(ctx context.Context, request BulkRequest) (
+	*BulkResponse, *serviceerror.ServiceError) {
+	if len(request.Operations) == 0 || len(request.Operations) > maxBulkOperations {
+		return nil, &ErrorTooManyBulkOperations
+	}
+
+	ctx = s.prehashBulkUsers(ctx, request.Operations)
+
+	createdIDs := make(map[string]string)
+	responses := make([]BulkOperationResponse, 0, len(request.Operations))
+	failed := 0
+	for _, operation := range request.Operations {
+		response := s.processBulkOperation(ctx, operation, createdIDs)
+		responses = append(responses, response)
+		if response.Response != nil {
+			failed++
+			if request.FailOnErrors > 0 && failed >= request.FailOnErrors {
+				break
+			}
+		}
+	}
+
+	return &BulkResponse{
+		Schemas:    []string{SchemaBulkResponse},
+		Operations: responses,
+	}, nil
+}
+
+// processBulkOperation runs an operation of a bulk request and records the ID of the resource a POST
+// operation creates under its bulkId.
+func (s *scimService) processBulkOperation(ctx context.Context, operation BulkOperation,
+	createdIDs map[string]string) BulkOperationResponse {
+	method := strings.ToUpper(operation.Method)
+	response := BulkOperationResponse{
+		Method:  method,
+		BulkID:  operation.BulkID,
+		Version: operation.Version,
+	}
+
+	resourcePath, id, svcErr := parseBulkPath(operation.Path, createdIDs)
+	if svcErr == nil {
+		id, svcErr = s.runBulkOperation(ctx, method, operation, resourcePath, id, createdIDs)
+	}
+	if svcErr != nil {
+		statusCode, errorResponse := newErrorResponse(svcErr)
+		response.Status = strconv.Itoa(statusCode)
+		response.Response = &errorResponse
+		return response
+	}
+
+	switch method {
+	case http.MethodPost:
+		createdIDs[operation.BulkID] = id
+		response.Status = strconv.Itoa(http.StatusCreated)
+	case http.MethodDelete:
+		response.Status = strconv.Itoa(http.StatusNoContent)
+	default:
+		response.Status = strconv.Itoa(http.StatusOK)
+	}
+	response.Location = s.baseURL + basePath + resourcePath + "/" + id
+	return response
+}
+
+// runBulkOperation runs an operation on the resources of a path and returns the ID of the resource it
+// applied to. POST operations apply to the resource path, and the other methods to a resource ID.
+func (s *scimService) runBulkOperation(ctx context.Context, method string, operation BulkOperation,
+	resourcePath, id string, createdIDs map[string]string) (string, *serviceerror.ServiceError) {
+	if (method == http.MethodPost) != (id == "") {
+		return "", &ErrorInvalidBulkOperation
+	}
+
+	switch method {
+	case http.MethodPost:
+		if operation.BulkID == "" {
+			return "", &ErrorInvalidBulkOperation
+		}
+		if _, exists := createdIDs[operation.BulkID]; exists {
+			return "", &ErrorInvalidBulkOperation
+		}
+		var resource Resource
+		if svcErr := decodeBulkData(operation.Data, createdIDs, &resource); svcErr != nil {
+			return "", svcErr
+		}
+		var created Resource
+		var svcErr *serviceerror.ServiceError
+		if resourcePath == bulkUsersPath {
+			created, svcErr = s.CreateUser(ctx, resource)
+		} else {
+			created, svcErr = s.CreateGroup(ctx, resource)
+		}
+		if svcErr != nil {
+			return "", svcErr
+		}
+		createdID, _ := created[attributeID].(string)
+		return createdID, nil
+	case http.MethodPut:
+		var resource Resource
+		if svcErr := decodeBulkData(operation.Data, createdIDs, &resource); svcErr != nil {
+			return "", svcErr
+		}
+		var svcErr *serviceerror.ServiceError
+		if resourcePath == bulkUsersPath {
+			_, svcErr = s.ReplaceUser(ctx, id, resource)
+		} else {
+			_, svcErr = s.ReplaceGroup(ctx, id, resource)
+		}
+		return id, svcErr
+	case http.MethodPatch:
+		var request PatchRequest
+		if svcErr := decodeBulkData(operation.Data, createdIDs, &request); svcErr != nil {
+			return "", svcErr
+		}
+		var svcErr *serviceerror.ServiceError
+		if resourcePath == bulkUsersPath {
+			_, svcErr = s.PatchUser(ctx, id, request)
+		} else {
+			_, svcErr = s.PatchGroup(ctx, id, request)
+		}
+		return id, svcErr
+	case http.MethodDelete:
+		if resourcePath == bulkUsersPath {
+			return id, s.DeleteUser(ctx, id)
+		}
+		return id, s.DeleteGroup(ctx, id)
+	default:
+		return "", &ErrorInvalidBulkOperation
+	}
+}
+
+// prehashBulkUsers hashes the plaintext passwords of the users created by the POST operations of a bulk
+// request, and returns a context carrying the hashes. Users whose passwords cannot be hashed up front have
+// them hashed as they are created.
+func (s *scimService) prehashBulkUsers(ctx context.Context, operations []BulkOperation) context.Context {
+	var resources []Resource
+	for _, operation := range operations {
+		if !strings.EqualFold(operation.Method, http.MethodPost) || operation.Path != bulkUsersPath ||
+			operation.BulkID == "" {
+			continue
+		}
+		var resource Resource
+		if err := json.Unmarshal(operation.Data, &resource); err == nil && resource != nil {
+			resources = append(resources, resource)
+		}
+	}
+	if len(resources) == 0 {
+		return ctx
+	}
+
+	userType, svcErr := s.getUserType(ctx)
+	if svcErr != nil {
+		return ctx
+	}
+	mappings, svcErr := s.getMappings(ctx, userType.Name)
+	if svcErr != nil {
+		return ctx
+	}
+	users := make([]*user.User, 0, len(resources))
+	for _, resource := range resources {
+		attributes, err := json.Marshal(toUserAttributes(resource, mappings))
+		if err != nil {
+			continue
+		}
+		users = append(users, &user.User{OUID: userType.OUID, Type: userType.Name, Attributes: attributes})
+	}
+
+	hashedCtx, svcErr := s.userService.PrehashCredentials(ctx, users)
+	if svcErr != nil {
+		logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName))
+		logger.Warn("Failed to hash the passwords of bulk provisioned users in a batch",
+			log.String("code", svcErr.Code))
+		return ctx
+	}
+	return hashedCtx
+}
+
+// parseBulkPath returns the resource path and the resource ID of the path of a bulk operation. A resource
+// ID of the form "bulkId:<bulkId>" is replaced with the ID of the resource created under the bulkId.
+func parseBulkPath(path string, createdIDs map[string]string) (string, string, *serviceerror.ServiceError) {
+	for _, resourcePath := range []string{bulkUsersPath, bulkGroupsPath} {
+		if path == resourcePath {
+			return resourcePath, "", nil
+		}
+		id, ok := strings.CutPrefix(path, resourcePath+"/")
+		if !ok || id == "" || strings.Contains(id, "/") {
+			continue
+		}
+		resolved, svcErr := resolveBulkID(id, createdIDs)
+		if svcErr != nil {
+			return "", "", svcErr
+		}
+		return resourcePath, resolved, nil
+	}
+	return "", "", &ErrorInvalidBulkOperation
+}
+
+// decodeBulkData decodes the data of a bulk operation into target after replacing the bulkId references in
+// its string values.
+func decodeBulkData(data json.RawMessage, createdIDs map[string]string, target interface{}) *serviceerror.ServiceError {
+	var value interface{}
+	if err := json.Unmarshal(data, &value); err != nil {
+		return &ErrorInvalidRequestFormat
+	}
+	resolved, svcErr := resolveBulkIDs(value, createdIDs)
+	if svcErr != nil {
+		return svcErr
+	}
+	resolvedData, err := json.Marshal(resolved)
+	if err != nil {
+		return &ErrorInvalidRequestFormat
+	}
+	if err := json.Unmarshal(resolvedData, target); err != nil {
+		return &ErrorInvalidRequestFormat
+	}
+	return nil
+}
+
+// resolveBulkIDs replaces the bulkId references among the string values of a decoded JSON value.
+func resolveBulkIDs(value interface{}, createdIDs map[string]string) (interface{}, *serviceerror.ServiceError) {
+	switch v := value.(type) {
+	case string:
+		return resolveBulkID(v, createdIDs)
+	case []interface{}:
+		for i, element := range v {
+			resolved, svcErr := resolveBulkIDs(element, createdIDs)
+			if svcErr != nil {
+				return nil, svcErr
+			}
+			v[i] = resolved
+		}
+		return v, nil
+	case map[string]interface{}:
+		for key, element := range v {
+			resolved, svcErr := resolveBulkIDs(element, createdIDs)
+			if svcErr != nil {
+				return nil, svcErr
+			}
+			v[key] = resolved
+		}
+		return v, nil
+	default:
+		return value, nil
+	}
+}
+
+// resolveBulkID returns the ID of the resource created under the bulkId a value refers to, or the value
+// itself when it is not a bulkId reference.
+func resolveBulkID(value string, createdIDs map[string]string) (string, *serviceerror.ServiceError) {
+	bulkID, ok := strings.CutPrefix(value, bulkIDPrefix)
+	if !ok {
+		return value, nil
+	}
+	id, ok := createdIDs[bulkID]
+	if !ok {
+		return "", &ErrorUnresolvedBulkID
+	}
+	return id, nil
+}
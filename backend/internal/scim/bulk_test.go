@@ -0,0 +1,128 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package scim
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/thunder-id/thunderid/internal/group"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/user"
+)
+
+// prehashedContextKey marks the context returned by the mocked PrehashCredentials.
+type prehashedContextKey struct{}
+
+func (suite *SCIMServiceTestSuite) TestProcessBulk_ResolvesBulkIDs() {
+	suite.mockUserService.On("PrehashCredentials", mock.Anything, mock.MatchedBy(func(users []*user.User) bool {
+		return len(users) == 1 && users[0].Type == testUserType && users[0].OUID == testOUID &&
+			string(users[0].Attributes) == `{"password":"secret","username":"alice"}`
+	})).Return(func(ctx context.Context, _ []*user.User) (context.Context, *serviceerror.ServiceError) {
+		return context.WithValue(ctx, prehashedContextKey{}, true), nil
+	}).Once()
+	suite.mockUserService.On("CreateUser", mock.MatchedBy(func(ctx context.Context) bool {
+		return ctx.Value(prehashedContextKey{}) == true
+	}), mock.Anything).Return(suite.newUser(`{"username": "alice"}`), nil).Once()
+	members := []group.Member{{ID: testUserID, Type: group.MemberTypeUser}}
+	suite.mockGroupService.On("CreateGroup", mock.Anything, group.CreateGroupRequest{
+		Name: "Admins", OUID: testOUID, Members: members,
+	}).Return(&group.Group{ID: testGroupID, Name: "Admins", OUID: testOUID, Members: members}, nil).Once()
+	suite.mockGroupService.On("DeleteGroup", mock.Anything, testGroupID).Return(nil).Once()
+
+	response, svcErr := suite.service.ProcessBulk(suite.ctx, BulkRequest{Operations: []BulkOperation{
+		{Method: "POST", BulkID: "alice", Path: "/Users",
+			Data: json.RawMessage(`{"userName": "alice", "password": "secret"}`)},
+		{Method: "POST", BulkID: "admins", Path: "/Groups",
+			Data: json.RawMessage(`{"displayName": "Admins", "members": [{"value": "bulkId:alice"}]}`)},
+		{Method: "delete", Path: "/Groups/bulkId:admins"},
+	}})
+
+	suite.Require().Nil(svcErr)
+	suite.Equal([]string{SchemaBulkResponse}, response.Schemas)
+	suite.Equal([]BulkOperationResponse{
+		{Method: "POST", BulkID: "alice", Status: "201", Location: testBaseURL + "/scim/v2/Users/" + testUserID},
+		{Method: "POST", BulkID: "admins", Status: "201", Location: testBaseURL + "/scim/v2/Groups/" + testGroupID},
+		{Method: "DELETE", Status: "204", Location: testBaseURL + "/scim/v2/Groups/" + testGroupID},
+	}, response.Operations)
+}
+
+func (suite *SCIMServiceTestSuite) TestProcessBulk_ContinuesAfterErrors() {
+	suite.mockUserService.On("DeleteUser", mock.Anything, testUserID).Return(nil).Once()
+
+	response, svcErr := suite.service.ProcessBulk(suite.ctx, BulkRequest{Operations: []BulkOperation{
+		{Method: "PATCH", Path: "/Users/bulkId:missing", Data: json.RawMessage(`{}`)},
+		{Method: "POST", Path: "/Users", Data: json.RawMessage(`{"userName": "bob"}`)},
+		{Method: "GET", Path: "/Users/" + testUserID},
+		{Method: "DELETE", Path: "/Roles/" + testUserID},
+		{Method: "DELETE", Path: "/Users/" + testUserID},
+	}})
+
+	suite.Require().Nil(svcErr)
+	suite.Require().Len(response.Operations, 5)
+	for _, operation := range response.Operations[:4] {
+		suite.Equal("400", operation.Status)
+		suite.Require().NotNil(operation.Response)
+		suite.Equal(ErrorTypeInvalidValue, operation.Response.ScimType)
+	}
+	suite.Equal(ErrorUnresolvedBulkID.ErrorDescription.DefaultValue, response.Operations[0].Response.Detail)
+	suite.Equal(ErrorInvalidBulkOperation.ErrorDescription.DefaultValue, response.Operations[1].Response.Detail)
+	suite.Equal("204", response.Operations[4].Status)
+	suite.mockUserService.AssertNotCalled(suite.T(), "PrehashCredentials", mock.Anything, mock.Anything)
+}
+
+func (suite *SCIMServiceTestSuite) TestProcessBulk_FailOnErrors() {
+	suite.mockUserService.On("DeleteUser", mock.Anything, testUserID).Return(&user.ErrorUserNotFound).Once()
+
+	response, svcErr := suite.service.ProcessBulk(suite.ctx, BulkRequest{
+		FailOnErrors: 1,
+		Operations: []BulkOperation{
+			{Method: "DELETE", Path: "/Users/" + testUserID},
+			{Method: "DELETE", Path: "/Users/user-2"},
+		},
+	})
+
+	suite.Require().Nil(svcErr)
+	suite.Require().Len(response.Operations, 1)
+	suite.Equal("404", response.Operations[0].Status)
+}
+
+func (suite *SCIMServiceTestSuite) TestProcessBulk_PrehashFailureCreatesUsers() {
+	suite.mockUserService.On("PrehashCredentials", mock.Anything, mock.Anything).
+		Return(nil, &serviceerror.InternalServerError).Once()
+	suite.mockUserService.On("CreateUser", mock.Anything, mock.Anything).
+		Return(suite.newUser(`{"username": "alice"}`), nil).Once()
+
+	response, svcErr := suite.service.ProcessBulk(suite.ctx, BulkRequest{Operations: []BulkOperation{
+		{Method: "POST", BulkID: "alice", Path: "/Users",
+			Data: json.RawMessage(`{"userName": "alice", "password": "secret"}`)},
+	}})
+
+	suite.Require().Nil(svcErr)
+	suite.Equal("201", response.Operations[0].Status)
+}
+
+func (suite *SCIMServiceTestSuite) TestProcessBulk_InvalidNumberOfOperations() {
+	for _, count := range []int{0, maxBulkOperations + 1} {
+		_, svcErr := suite.service.ProcessBulk(suite.ctx, BulkRequest{Operations: make([]BulkOperation, count)})
+		suite.Equal(&ErrorTooManyBulkOperations, svcErr, count)
+	}
+}
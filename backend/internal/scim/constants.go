@@ -0,0 +1,152 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package scim
+
+const loggerComponentName = "SCIMService"
+
+// contentTypeSCIM is the media type of SCIM requests and responses.
+const contentTypeSCIM = "application/scim+json"
+
+// SCIM schema URIs.
+const (
+	// SchemaUser is the schema of the core user resource.
+	SchemaUser = "urn:ietf:params:scim:schemas:core:2.0:User"
+	// SchemaEnterpriseUser is the schema of the enterprise user extension.
+	SchemaEnterpriseUser = "urn:ietf:params:scim:schemas:extension:enterprise:2.0:User"
+	// SchemaGroup is the schema of the core group resource.
+	SchemaGroup = "urn:ietf:params:scim:schemas:core:2.0:Group"
+	// SchemaListResponse is the schema of list responses.
+	SchemaListResponse = "urn:ietf:params:scim:api:messages:2.0:ListResponse"
+	// SchemaPatchOp is the schema of patch requests.
+	SchemaPatchOp = "urn:ietf:params:scim:api:messages:2.0:PatchOp"
+	// SchemaError is the schema of error responses.
+	SchemaError = "urn:ietf:params:scim:api:messages:2.0:Error"
+	// SchemaServiceProviderConfig is the schema of the service provider configuration.
+	SchemaServiceProviderConfig = "urn:ietf:params:scim:schemas:core:2.0:ServiceProviderConfig"
+	// SchemaBulkRequest is the schema of bulk requests.
+	SchemaBulkRequest = "urn:ietf:params:scim:api:messages:2.0:BulkRequest"
+	// SchemaBulkResponse is the schema of bulk responses.
+	SchemaBulkResponse = "urn:ietf:params:scim:api:messages:2.0:BulkResponse"
+)
+
+// SCIM resource types.
+const (
+	resourceTypeUser  = "User"
+	resourceTypeGroup = "Group"
+)
+
+// Endpoint paths of the SCIM resources.
+const (
+	basePath   = "/scim/v2"
+	usersPath  = basePath + "/Users"
+	groupsPath = basePath + "/Groups"
+	bulkPath   = basePath + "/Bulk"
+)
+
+// Paths of the resources bulk operations apply to, relative to the base path.
+const (
+	bulkUsersPath  = "/Users"
+	bulkGroupsPath = "/Groups"
+)
+
+// Common SCIM attribute names.
+const (
+	attributeSchemas     = "schemas"
+	attributeID          = "id"
+	attributeMeta        = "meta"
+	attributeValue       = "value"
+	attributeType        = "type"
+	attributeDisplay     = "display"
+	attributeRef         = "$ref"
+	attributeActive      = "active"
+	attributeGroups      = "groups"
+	attributeDisplayName = "displayName"
+	attributeMembers     = "members"
+)
+
+// PatchOperationType is the type of a SCIM patch operation.
+type PatchOperationType string
+
+// Patch operation types. Operation types are matched case-insensitively.
+const (
+	// PatchOperationAdd adds values to an attribute.
+	PatchOperationAdd PatchOperationType = "add"
+	// PatchOperationReplace replaces the values of an attribute.
+	PatchOperationReplace PatchOperationType = "replace"
+	// PatchOperationRemove removes the values of an attribute.
+	PatchOperationRemove PatchOperationType = "remove"
+)
+
+// ErrorType is the scimType of a SCIM error response.
+type ErrorType string
+
+// SCIM error types.
+const (
+	// ErrorTypeInvalidFilter indicates a filter that cannot be parsed or is not supported.
+	ErrorTypeInvalidFilter ErrorType = "invalidFilter"
+	// ErrorTypeInvalidPath indicates an attribute path that cannot be parsed or is not supported.
+	ErrorTypeInvalidPath ErrorType = "invalidPath"
+	// ErrorTypeInvalidSyntax indicates a request body that cannot be parsed.
+	ErrorTypeInvalidSyntax ErrorType = "invalidSyntax"
+	// ErrorTypeInvalidValue indicates a value that is not valid for its attribute.
+	ErrorTypeInvalidValue ErrorType = "invalidValue"
+	// ErrorTypeMutability indicates an attempt to modify a read-only attribute.
+	ErrorTypeMutability ErrorType = "mutability"
+	// ErrorTypeNoTarget indicates a patch operation without a target.
+	ErrorTypeNoTarget ErrorType = "noTarget"
+	// ErrorTypeUniqueness indicates a value that conflicts with the value of another resource.
+	ErrorTypeUniqueness ErrorType = "uniqueness"
+)
+
+// maxPatchOperations is the maximum number of operations of a patch request.
+const maxPatchOperations = 100
+
+// maxBulkOperations is the maximum number of operations of a bulk request.
+const maxBulkOperations = 1000
+
+// maxBulkPayloadSize is the maximum size of a bulk request body in bytes.
+const maxBulkPayloadSize = 1048576
+
+// bulkIDPrefix prefixes a value that refers to the resource created by the bulk operation with the bulkId.
+const bulkIDPrefix = "bulkId:"
+
+// maxGroupScanPages is the maximum number of pages of groups scanned to find the groups matching a filter.
+const maxGroupScanPages = 100
+
+// defaultAttributeMappings maps SCIM attribute paths to the user attributes they are stored in. A path to a
+// multi-valued attribute without a filter maps the value of its primary value, or of its first value.
+var defaultAttributeMappings = map[string]string{
+	"userName":                               "username",
+	"externalId":                             "externalId",
+	"name.givenName":                         "given_name",
+	"name.familyName":                        "family_name",
+	"name.formatted":                         "name",
+	"displayName":                            "displayName",
+	"nickName":                               "nickname",
+	"title":                                  "title",
+	"locale":                                 "locale",
+	"active":                                 "active",
+	"password":                               "password",
+	"emails":                                 "email",
+	`phoneNumbers[type eq "mobile"].value`:   "mobileNumber",
+	`phoneNumbers[type eq "work"].value`:     "phone_number",
+	SchemaEnterpriseUser + ":employeeNumber": "employeeNumber",
+	SchemaEnterpriseUser + ":department":     "department",
+	SchemaEnterpriseUser + ":organization":   "organization",
+}
@@ -0,0 +1,186 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package scim
+
+import (
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/i18n/core"
+)
+
+// Client errors for SCIM operations.
+var (
+	// ErrorInvalidRequestFormat is the error returned when the request body is malformed.
+	ErrorInvalidRequestFormat = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "SCM-1001",
+		Error: core.I18nMessage{
+			Key:          "error.scimservice.invalid_request_format",
+			DefaultValue: "Invalid request format",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.scimservice.invalid_request_format_description",
+			DefaultValue: "The request body is malformed or contains invalid data",
+		},
+	}
+	// ErrorInvalidFilter is the error returned when the filter cannot be parsed or is not supported.
+	ErrorInvalidFilter = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "SCM-1002",
+		Error: core.I18nMessage{
+			Key:          "error.scimservice.invalid_filter",
+			DefaultValue: "Invalid filter",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.scimservice.invalid_filter_description",
+			DefaultValue: "The filter cannot be parsed or uses an unsupported operator or attribute",
+		},
+	}
+	// ErrorInvalidPath is the error returned when an attribute path cannot be parsed or is not supported.
+	ErrorInvalidPath = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "SCM-1003",
+		Error: core.I18nMessage{
+			Key:          "error.scimservice.invalid_path",
+			DefaultValue: "Invalid path",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.scimservice.invalid_path_description",
+			DefaultValue: "An attribute path cannot be parsed or is not supported",
+		},
+	}
+	// ErrorNoTarget is the error returned when a patch operation has no target.
+	ErrorNoTarget = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "SCM-1004",
+		Error: core.I18nMessage{
+			Key:          "error.scimservice.no_target",
+			DefaultValue: "No target",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.scimservice.no_target_description",
+			DefaultValue: "The patch operation does not specify the attribute to modify",
+		},
+	}
+	// ErrorInvalidValue is the error returned when a value is not valid for its attribute.
+	ErrorInvalidValue = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "SCM-1005",
+		Error: core.I18nMessage{
+			Key:          "error.scimservice.invalid_value",
+			DefaultValue: "Invalid value",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.scimservice.invalid_value_description",
+			DefaultValue: "A value is not valid for its attribute",
+		},
+	}
+	// ErrorMutability is the error returned when a patch operation modifies a read-only attribute.
+	ErrorMutability = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "SCM-1006",
+		Error: core.I18nMessage{
+			Key:          "error.scimservice.mutability",
+			DefaultValue: "Read-only attribute",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.scimservice.mutability_description",
+			DefaultValue: "The request modifies an attribute that cannot be modified",
+		},
+	}
+	// ErrorTooManyOperations is the error returned when a patch request has no operations or too many operations.
+	ErrorTooManyOperations = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "SCM-1007",
+		Error: core.I18nMessage{
+			Key:          "error.scimservice.too_many_operations",
+			DefaultValue: "Invalid number of operations",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.scimservice.too_many_operations_description",
+			DefaultValue: "The patch request must have between 1 and 100 operations",
+		},
+	}
+	// ErrorMissingDisplayName is the error returned when a group has no display name.
+	ErrorMissingDisplayName = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "SCM-1008",
+		Error: core.I18nMessage{
+			Key:          "error.scimservice.missing_display_name",
+			DefaultValue: "Missing display name",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.scimservice.missing_display_name_description",
+			DefaultValue: "The group must have a display name",
+		},
+	}
+	// ErrorTooManyBulkOperations is the error returned when a bulk request has no operations or too many
+	// operations.
+	ErrorTooManyBulkOperations = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "SCM-1009",
+		Error: core.I18nMessage{
+			Key:          "error.scimservice.too_many_bulk_operations",
+			DefaultValue: "Invalid number of operations",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.scimservice.too_many_bulk_operations_description",
+			DefaultValue: "The bulk request must have between 1 and 1000 operations",
+		},
+	}
+	// ErrorBulkPayloadTooLarge is the error returned when a bulk request body exceeds the maximum payload size.
+	ErrorBulkPayloadTooLarge = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "SCM-1010",
+		Error: core.I18nMessage{
+			Key:          "error.scimservice.bulk_payload_too_large",
+			DefaultValue: "Payload too large",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.scimservice.bulk_payload_too_large_description",
+			DefaultValue: "The bulk request body exceeds the maximum payload size",
+		},
+	}
+	// ErrorInvalidBulkOperation is the error returned when a bulk operation has an unsupported method or path.
+	ErrorInvalidBulkOperation = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "SCM-1011",
+		Error: core.I18nMessage{
+			Key:          "error.scimservice.invalid_bulk_operation",
+			DefaultValue: "Invalid bulk operation",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.scimservice.invalid_bulk_operation_description",
+			DefaultValue: "The bulk operation has an unsupported method or path, or a POST without a unique bulkId",
+		},
+	}
+	// ErrorUnresolvedBulkID is the error returned when a bulk operation refers to a bulkId that no earlier
+	// operation created a resource for.
+	ErrorUnresolvedBulkID = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "SCM-1012",
+		Error: core.I18nMessage{
+			Key:          "error.scimservice.unresolved_bulk_id",
+			DefaultValue: "Unresolved bulkId",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.scimservice.unresolved_bulk_id_description",
+			DefaultValue: "The operation refers to a bulkId that no earlier operation created a resource for",
+		},
+	}
+)
@@ -0,0 +1,340 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package scim
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/thunder-id/thunderid/internal/group"
+	serverconst "github.com/thunder-id/thunderid/internal/system/constants"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	sysutils "github.com/thunder-id/thunderid/internal/system/utils"
+	"github.com/thunder-id/thunderid/internal/user"
+)
+
+// scimHandler is the handler for SCIM operations.
+type scimHandler struct {
+	scimService SCIMServiceInterface
+}
+
+// newSCIMHandler creates a new instance of scimHandler.
+func newSCIMHandler(scimService SCIMServiceInterface) *scimHandler {
+	return &scimHandler{
+		scimService: scimService,
+	}
+}
+
+// HandleUserListRequest handles the request to list users.
+func (h *scimHandler) HandleUserListRequest(w http.ResponseWriter, r *http.Request) {
+	response, svcErr := h.scimService.ListUsers(r.Context(), parseListRequest(r.URL.Query()))
+	if svcErr != nil {
+		writeErrorResponse(w, svcErr)
+		return
+	}
+	writeResponse(w, http.StatusOK, response)
+}
+
+// HandleUserPostRequest handles the request to create a user.
+func (h *scimHandler) HandleUserPostRequest(w http.ResponseWriter, r *http.Request) {
+	resource, err := sysutils.DecodeJSONBody[Resource](r)
+	if err != nil {
+		writeErrorResponse(w, &ErrorInvalidRequestFormat)
+		return
+	}
+
+	created, svcErr := h.scimService.CreateUser(r.Context(), *resource)
+	if svcErr != nil {
+		writeErrorResponse(w, svcErr)
+		return
+	}
+	writeCreatedResponse(w, created)
+}
+
+// HandleUserGetRequest handles the request to retrieve a user.
+func (h *scimHandler) HandleUserGetRequest(w http.ResponseWriter, r *http.Request) {
+	resource, svcErr := h.scimService.GetUser(r.Context(), r.PathValue("id"))
+	if svcErr != nil {
+		writeErrorResponse(w, svcErr)
+		return
+	}
+	writeResponse(w, http.StatusOK, resource)
+}
+
+// HandleUserPutRequest handles the request to replace a user.
+func (h *scimHandler) HandleUserPutRequest(w http.ResponseWriter, r *http.Request) {
+	resource, err := sysutils.DecodeJSONBody[Resource](r)
+	if err != nil {
+		writeErrorResponse(w, &ErrorInvalidRequestFormat)
+		return
+	}
+
+	updated, svcErr := h.scimService.ReplaceUser(r.Context(), r.PathValue("id"), *resource)
+	if svcErr != nil {
+		writeErrorResponse(w, svcErr)
+		return
+	}
+	writeResponse(w, http.StatusOK, updated)
+}
+
+// HandleUserPatchRequest handles the request to patch a user.
+func (h *scimHandler) HandleUserPatchRequest(w http.ResponseWriter, r *http.Request) {
+	request, err := sysutils.DecodeJSONBody[PatchRequest](r)
+	if err != nil {
+		writeErrorResponse(w, &ErrorInvalidRequestFormat)
+		return
+	}
+
+	updated, svcErr := h.scimService.PatchUser(r.Context(), r.PathValue("id"), *request)
+	if svcErr != nil {
+		writeErrorResponse(w, svcErr)
+		return
+	}
+	writeResponse(w, http.StatusOK, updated)
+}
+
+// HandleUserDeleteRequest handles the request to delete a user.
+func (h *scimHandler) HandleUserDeleteRequest(w http.ResponseWriter, r *http.Request) {
+	if svcErr := h.scimService.DeleteUser(r.Context(), r.PathValue("id")); svcErr != nil {
+		writeErrorResponse(w, svcErr)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// HandleGroupListRequest handles the request to list groups.
+func (h *scimHandler) HandleGroupListRequest(w http.ResponseWriter, r *http.Request) {
+	response, svcErr := h.scimService.ListGroups(r.Context(), parseListRequest(r.URL.Query()))
+	if svcErr != nil {
+		writeErrorResponse(w, svcErr)
+		return
+	}
+	writeResponse(w, http.StatusOK, response)
+}
+
+// HandleGroupPostRequest handles the request to create a group.
+func (h *scimHandler) HandleGroupPostRequest(w http.ResponseWriter, r *http.Request) {
+	resource, err := sysutils.DecodeJSONBody[Resource](r)
+	if err != nil {
+		writeErrorResponse(w, &ErrorInvalidRequestFormat)
+		return
+	}
+
+	created, svcErr := h.scimService.CreateGroup(r.Context(), *resource)
+	if svcErr != nil {
+		writeErrorResponse(w, svcErr)
+		return
+	}
+	writeCreatedResponse(w, created)
+}
+
+// HandleGroupGetRequest handles the request to retrieve a group.
+func (h *scimHandler) HandleGroupGetRequest(w http.ResponseWriter, r *http.Request) {
+	resource, svcErr := h.scimService.GetGroup(r.Context(), r.PathValue("id"))
+	if svcErr != nil {
+		writeErrorResponse(w, svcErr)
+		return
+	}
+	writeResponse(w, http.StatusOK, resource)
+}
+
+// HandleGroupPutRequest handles the request to replace a group.
+func (h *scimHandler) HandleGroupPutRequest(w http.ResponseWriter, r *http.Request) {
+	resource, err := sysutils.DecodeJSONBody[Resource](r)
+	if err != nil {
+		writeErrorResponse(w, &ErrorInvalidRequestFormat)
+		return
+	}
+
+	updated, svcErr := h.scimService.ReplaceGroup(r.Context(), r.PathValue("id"), *resource)
+	if svcErr != nil {
+		writeErrorResponse(w, svcErr)
+		return
+	}
+	writeResponse(w, http.StatusOK, updated)
+}
+
+// HandleGroupPatchRequest handles the request to patch a group.
+func (h *scimHandler) HandleGroupPatchRequest(w http.ResponseWriter, r *http.Request) {
+	request, err := sysutils.DecodeJSONBody[PatchRequest](r)
+	if err != nil {
+		writeErrorResponse(w, &ErrorInvalidRequestFormat)
+		return
+	}
+
+	updated, svcErr := h.scimService.PatchGroup(r.Context(), r.PathValue("id"), *request)
+	if svcErr != nil {
+		writeErrorResponse(w, svcErr)
+		return
+	}
+	writeResponse(w, http.StatusOK, updated)
+}
+
+// HandleGroupDeleteRequest handles the request to delete a group.
+func (h *scimHandler) HandleGroupDeleteRequest(w http.ResponseWriter, r *http.Request) {
+	if svcErr := h.scimService.DeleteGroup(r.Context(), r.PathValue("id")); svcErr != nil {
+		writeErrorResponse(w, svcErr)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// HandleBulkRequest handles a bulk request. Bodies larger than the maximum payload size are rejected.
+func (h *scimHandler) HandleBulkRequest(w http.ResponseWriter, r *http.Request) {
+	data, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxBulkPayloadSize))
+	if err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			writeErrorResponse(w, &ErrorBulkPayloadTooLarge)
+			return
+		}
+		writeErrorResponse(w, &ErrorInvalidRequestFormat)
+		return
+	}
+	var request BulkRequest
+	if err := json.Unmarshal(data, &request); err != nil {
+		writeErrorResponse(w, &ErrorInvalidRequestFormat)
+		return
+	}
+
+	response, svcErr := h.scimService.ProcessBulk(r.Context(), request)
+	if svcErr != nil {
+		writeErrorResponse(w, svcErr)
+		return
+	}
+	writeResponse(w, http.StatusOK, response)
+}
+
+// HandleServiceProviderConfigRequest handles the request to retrieve the SCIM features the server supports.
+func (h *scimHandler) HandleServiceProviderConfigRequest(w http.ResponseWriter, r *http.Request) {
+	writeResponse(w, http.StatusOK, ServiceProviderConfig{
+		Schemas: []string{SchemaServiceProviderConfig},
+		Patch:   supportedFeature{Supported: true},
+		Bulk: bulkFeature{
+			Supported:      true,
+			MaxOperations:  maxBulkOperations,
+			MaxPayloadSize: maxBulkPayloadSize,
+		},
+		Filter:         filterFeature{Supported: true, MaxResults: serverconst.MaxPageSize},
+		ChangePassword: supportedFeature{Supported: true},
+		AuthenticationSchemes: []authenticationScheme{{
+			Type:        "oauthbearertoken",
+			Name:        "OAuth Bearer Token",
+			Description: "Authentication with an OAuth 2.0 access token of the server",
+			Primary:     true,
+		}},
+	})
+}
+
+// parseListRequest parses the query of a list request. A missing or invalid start index or count is
+// replaced with its default.
+func parseListRequest(query url.Values) ListRequest {
+	request := ListRequest{
+		Filter:     query.Get("filter"),
+		StartIndex: 1,
+		Count:      serverconst.DefaultPageSize,
+	}
+	if startIndex, err := strconv.Atoi(query.Get("startIndex")); err == nil {
+		request.StartIndex = startIndex
+	}
+	if count, err := strconv.Atoi(query.Get("count")); err == nil {
+		request.Count = count
+	}
+	return request
+}
+
+// writeCreatedResponse writes a created resource with its location.
+func writeCreatedResponse(w http.ResponseWriter, resource Resource) {
+	if meta, ok := resource[attributeMeta].(map[string]interface{}); ok {
+		if location, ok := meta["location"].(string); ok {
+			w.Header().Set("Location", location)
+		}
+	}
+	writeResponse(w, http.StatusCreated, resource)
+}
+
+// writeResponse writes a SCIM response.
+func writeResponse(w http.ResponseWriter, statusCode int, data interface{}) {
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(data); err != nil {
+		logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName))
+		logger.Error("Failed to encode SCIM response", log.Error(err))
+		writeErrorResponse(w, &serviceerror.InternalServerError)
+		return
+	}
+	w.Header().Set(serverconst.ContentTypeHeaderName, contentTypeSCIM)
+	w.WriteHeader(statusCode)
+	_, _ = w.Write(buf.Bytes())
+}
+
+// writeErrorResponse writes a service error as a SCIM error response.
+func writeErrorResponse(w http.ResponseWriter, svcErr *serviceerror.ServiceError) {
+	statusCode, errorResponse := newErrorResponse(svcErr)
+	body, _ := json.Marshal(errorResponse)
+	w.Header().Set(serverconst.ContentTypeHeaderName, contentTypeSCIM)
+	w.WriteHeader(statusCode)
+	_, _ = w.Write(body)
+}
+
+// newErrorResponse returns the HTTP status code and the SCIM error response of a service error.
+func newErrorResponse(svcErr *serviceerror.ServiceError) (int, ErrorResponse) {
+	statusCode := http.StatusInternalServerError
+	var scimType ErrorType
+	if svcErr.Type == serviceerror.ClientErrorType {
+		statusCode, scimType = getClientErrorStatus(svcErr.Code)
+	}
+	return statusCode, ErrorResponse{
+		Schemas:  []string{SchemaError},
+		ScimType: scimType,
+		Detail:   svcErr.ErrorDescription.DefaultValue,
+		Status:   strconv.Itoa(statusCode),
+	}
+}
+
+// getClientErrorStatus returns the HTTP status code and the SCIM error type of a client error.
+func getClientErrorStatus(errorCode string) (int, ErrorType) {
+	switch errorCode {
+	case ErrorInvalidRequestFormat.Code:
+		return http.StatusBadRequest, ErrorTypeInvalidSyntax
+	case ErrorInvalidFilter.Code:
+		return http.StatusBadRequest, ErrorTypeInvalidFilter
+	case ErrorInvalidPath.Code:
+		return http.StatusBadRequest, ErrorTypeInvalidPath
+	case ErrorNoTarget.Code:
+		return http.StatusBadRequest, ErrorTypeNoTarget
+	case ErrorTooManyBulkOperations.Code, ErrorBulkPayloadTooLarge.Code:
+		return http.StatusRequestEntityTooLarge, ""
+	case ErrorMutability.Code, user.ErrorCannotModifyDeclarativeResource.Code:
+		return http.StatusBadRequest, ErrorTypeMutability
+	case user.ErrorUserNotFound.Code, group.ErrorGroupNotFound.Code:
+		return http.StatusNotFound, ""
+	case user.ErrorAttributeConflict.Code, user.ErrorEmailConflict.Code, group.ErrorGroupNameConflict.Code:
+		return http.StatusConflict, ErrorTypeUniqueness
+	case serviceerror.ErrorUnauthorized.Code:
+		return http.StatusForbidden, ""
+	default:
+		return http.StatusBadRequest, ErrorTypeInvalidValue
+	}
+}
@@ -0,0 +1,269 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package scim
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/group"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/user"
+)
+
+type HandlerTestSuite struct {
+	suite.Suite
+	mockService *SCIMServiceInterfaceMock
+	handler     *scimHandler
+}
+
+func TestHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(HandlerTestSuite))
+}
+
+func (s *HandlerTestSuite) SetupTest() {
+	s.mockService = NewSCIMServiceInterfaceMock(s.T())
+	s.handler = newSCIMHandler(s.mockService)
+}
+
+func (s *HandlerTestSuite) decodeError(rr *httptest.ResponseRecorder) ErrorResponse {
+	var body ErrorResponse
+	s.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &body))
+	return body
+}
+
+func (s *HandlerTestSuite) TestHandleUserListRequest() {
+	s.mockService.On("ListUsers", mock.Anything, ListRequest{Filter: `userName eq "alice"`, StartIndex: 3, Count: 5}).
+		Return(newListResponse(1, 3, []Resource{{"id": testUserID}}), nil).Once()
+
+	req := httptest.NewRequest(http.MethodGet,
+		"/scim/v2/Users?filter=userName%20eq%20%22alice%22&startIndex=3&count=5", nil)
+	rr := httptest.NewRecorder()
+	s.handler.HandleUserListRequest(rr, req)
+
+	s.Equal(http.StatusOK, rr.Code)
+	s.Equal(contentTypeSCIM, rr.Header().Get("Content-Type"))
+	var body map[string]interface{}
+	s.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &body))
+	s.Equal(float64(1), body["totalResults"])
+	s.Len(body["Resources"], 1)
+}
+
+func (s *HandlerTestSuite) TestHandleUserListRequest_Defaults() {
+	s.mockService.On("ListUsers", mock.Anything, ListRequest{StartIndex: 1, Count: 30}).
+		Return(newListResponse(0, 1, []Resource{}), nil).Once()
+
+	rr := httptest.NewRecorder()
+	s.handler.HandleUserListRequest(rr, httptest.NewRequest(http.MethodGet, "/scim/v2/Users?count=x", nil))
+
+	s.Equal(http.StatusOK, rr.Code)
+}
+
+func (s *HandlerTestSuite) TestHandleUserListRequest_InvalidFilter() {
+	s.mockService.On("ListUsers", mock.Anything, mock.Anything).Return(nil, &ErrorInvalidFilter).Once()
+
+	rr := httptest.NewRecorder()
+	s.handler.HandleUserListRequest(rr, httptest.NewRequest(http.MethodGet, "/scim/v2/Users", nil))
+
+	s.Equal(http.StatusBadRequest, rr.Code)
+	body := s.decodeError(rr)
+	s.Equal([]string{SchemaError}, body.Schemas)
+	s.Equal(ErrorTypeInvalidFilter, body.ScimType)
+	s.Equal("400", body.Status)
+}
+
+func (s *HandlerTestSuite) TestHandleUserPostRequest() {
+	s.mockService.On("CreateUser", mock.Anything, Resource{"userName": "alice"}).Return(Resource{
+		"id":   testUserID,
+		"meta": buildMeta(resourceTypeUser, testBaseURL+"/scim/v2/Users/"+testUserID),
+	}, nil).Once()
+
+	req := httptest.NewRequest(http.MethodPost, "/scim/v2/Users", strings.NewReader(`{"userName": "alice"}`))
+	rr := httptest.NewRecorder()
+	s.handler.HandleUserPostRequest(rr, req)
+
+	s.Equal(http.StatusCreated, rr.Code)
+	s.Equal(testBaseURL+"/scim/v2/Users/"+testUserID, rr.Header().Get("Location"))
+}
+
+func (s *HandlerTestSuite) TestHandleUserPostRequest_InvalidBody() {
+	req := httptest.NewRequest(http.MethodPost, "/scim/v2/Users", strings.NewReader(`{"userName":`))
+	rr := httptest.NewRecorder()
+	s.handler.HandleUserPostRequest(rr, req)
+
+	s.Equal(http.StatusBadRequest, rr.Code)
+	s.Equal(ErrorTypeInvalidSyntax, s.decodeError(rr).ScimType)
+}
+
+func (s *HandlerTestSuite) TestHandleUserPostRequest_Conflict() {
+	s.mockService.On("CreateUser", mock.Anything, mock.Anything).Return(Resource(nil), &user.ErrorAttributeConflict).Once()
+
+	req := httptest.NewRequest(http.MethodPost, "/scim/v2/Users", strings.NewReader(`{"userName": "alice"}`))
+	rr := httptest.NewRecorder()
+	s.handler.HandleUserPostRequest(rr, req)
+
+	s.Equal(http.StatusConflict, rr.Code)
+	s.Equal(ErrorTypeUniqueness, s.decodeError(rr).ScimType)
+}
+
+func (s *HandlerTestSuite) TestHandleUserGetRequest_NotFound() {
+	s.mockService.On("GetUser", mock.Anything, testUserID).Return(Resource(nil), &user.ErrorUserNotFound).Once()
+
+	req := httptest.NewRequest(http.MethodGet, "/scim/v2/Users/"+testUserID, nil)
+	req.SetPathValue("id", testUserID)
+	rr := httptest.NewRecorder()
+	s.handler.HandleUserGetRequest(rr, req)
+
+	s.Equal(http.StatusNotFound, rr.Code)
+	s.Equal("404", s.decodeError(rr).Status)
+}
+
+func (s *HandlerTestSuite) TestHandleUserPatchRequest() {
+	s.mockService.On("PatchUser", mock.Anything, testUserID, mock.MatchedBy(func(r PatchRequest) bool {
+		return len(r.Operations) == 1 && r.Operations[0].Op == "Replace" && r.Operations[0].Path == "active"
+	})).Return(Resource{"id": testUserID, "active": false}, nil).Once()
+
+	req := httptest.NewRequest(http.MethodPatch, "/scim/v2/Users/"+testUserID, strings.NewReader(
+		`{"schemas": ["`+SchemaPatchOp+`"], "Operations": [{"op": "Replace", "path": "active", "value": false}]}`))
+	req.SetPathValue("id", testUserID)
+	rr := httptest.NewRecorder()
+	s.handler.HandleUserPatchRequest(rr, req)
+
+	s.Equal(http.StatusOK, rr.Code)
+}
+
+func (s *HandlerTestSuite) TestHandleUserDeleteRequest() {
+	s.mockService.On("DeleteUser", mock.Anything, testUserID).Return(nil).Once()
+
+	req := httptest.NewRequest(http.MethodDelete, "/scim/v2/Users/"+testUserID, nil)
+	req.SetPathValue("id", testUserID)
+	rr := httptest.NewRecorder()
+	s.handler.HandleUserDeleteRequest(rr, req)
+
+	s.Equal(http.StatusNoContent, rr.Code)
+}
+
+func (s *HandlerTestSuite) TestHandleGroupPutRequest() {
+	s.mockService.On("ReplaceGroup", mock.Anything, testGroupID, Resource{"displayName": "Admins"}).
+		Return(Resource{"id": testGroupID, "displayName": "Admins"}, nil).Once()
+
+	req := httptest.NewRequest(http.MethodPut, "/scim/v2/Groups/"+testGroupID,
+		strings.NewReader(`{"displayName": "Admins"}`))
+	req.SetPathValue("id", testGroupID)
+	rr := httptest.NewRecorder()
+	s.handler.HandleGroupPutRequest(rr, req)
+
+	s.Equal(http.StatusOK, rr.Code)
+}
+
+func (s *HandlerTestSuite) TestHandleGroupDeleteRequest_NotFound() {
+	s.mockService.On("DeleteGroup", mock.Anything, testGroupID).Return(&group.ErrorGroupNotFound).Once()
+
+	req := httptest.NewRequest(http.MethodDelete, "/scim/v2/Groups/"+testGroupID, nil)
+	req.SetPathValue("id", testGroupID)
+	rr := httptest.NewRecorder()
+	s.handler.HandleGroupDeleteRequest(rr, req)
+
+	s.Equal(http.StatusNotFound, rr.Code)
+}
+
+func (s *HandlerTestSuite) TestHandleGroupPatchRequest_Mutability() {
+	s.mockService.On("PatchGroup", mock.Anything, testGroupID, mock.Anything).Return(Resource(nil), &ErrorMutability).Once()
+
+	req := httptest.NewRequest(http.MethodPatch, "/scim/v2/Groups/"+testGroupID,
+		strings.NewReader(`{"Operations": [{"op": "replace", "path": "id", "value": "x"}]}`))
+	req.SetPathValue("id", testGroupID)
+	rr := httptest.NewRecorder()
+	s.handler.HandleGroupPatchRequest(rr, req)
+
+	s.Equal(http.StatusBadRequest, rr.Code)
+	s.Equal(ErrorTypeMutability, s.decodeError(rr).ScimType)
+}
+
+func (s *HandlerTestSuite) TestHandleGroupListRequest_ServerError() {
+	s.mockService.On("ListGroups", mock.Anything, mock.Anything).
+		Return(nil, &serviceerror.InternalServerError).Once()
+
+	rr := httptest.NewRecorder()
+	s.handler.HandleGroupListRequest(rr, httptest.NewRequest(http.MethodGet, "/scim/v2/Groups", nil))
+
+	s.Equal(http.StatusInternalServerError, rr.Code)
+	s.Empty(s.decodeError(rr).ScimType)
+}
+
+func (s *HandlerTestSuite) TestHandleServiceProviderConfigRequest() {
+	rr := httptest.NewRecorder()
+	s.handler.HandleServiceProviderConfigRequest(rr,
+		httptest.NewRequest(http.MethodGet, "/scim/v2/ServiceProviderConfig", nil))
+
+	s.Equal(http.StatusOK, rr.Code)
+	var body map[string]interface{}
+	s.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &body))
+	s.Equal(true, body["patch"].(map[string]interface{})["supported"])
+	s.Equal(map[string]interface{}{
+		"supported": true, "maxOperations": float64(maxBulkOperations), "maxPayloadSize": float64(maxBulkPayloadSize),
+	}, body["bulk"])
+}
+
+func (s *HandlerTestSuite) TestHandleBulkRequest() {
+	s.mockService.On("ProcessBulk", mock.Anything, BulkRequest{
+		Schemas:    []string{SchemaBulkRequest},
+		Operations: []BulkOperation{{Method: "DELETE", Path: "/Users/" + testUserID}},
+	}).Return(&BulkResponse{
+		Schemas:    []string{SchemaBulkResponse},
+		Operations: []BulkOperationResponse{{Method: "DELETE", Status: "204"}},
+	}, nil).Once()
+
+	req := httptest.NewRequest(http.MethodPost, "/scim/v2/Bulk", strings.NewReader(`{"schemas": ["`+
+		SchemaBulkRequest+`"], "Operations": [{"method": "DELETE", "path": "/Users/`+testUserID+`"}]}`))
+	rr := httptest.NewRecorder()
+	s.handler.HandleBulkRequest(rr, req)
+
+	s.Equal(http.StatusOK, rr.Code)
+	var body BulkResponse
+	s.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &body))
+	s.Equal("204", body.Operations[0].Status)
+}
+
+func (s *HandlerTestSuite) TestHandleBulkRequest_PayloadTooLarge() {
+	body := `{"Operations": [{"method": "POST", "path": "/Users", "data": {"userName": "` +
+		strings.Repeat("a", maxBulkPayloadSize) + `"}}]}`
+	rr := httptest.NewRecorder()
+	s.handler.HandleBulkRequest(rr, httptest.NewRequest(http.MethodPost, "/scim/v2/Bulk", strings.NewReader(body)))
+
+	s.Equal(http.StatusRequestEntityTooLarge, rr.Code)
+	s.mockService.AssertNotCalled(s.T(), "ProcessBulk", mock.Anything, mock.Anything)
+}
+
+func (s *HandlerTestSuite) TestHandleBulkRequest_TooManyOperations() {
+	s.mockService.On("ProcessBulk", mock.Anything, mock.Anything).
+		Return((*BulkResponse)(nil), &ErrorTooManyBulkOperations).Once()
+
+	rr := httptest.NewRecorder()
+	s.handler.HandleBulkRequest(rr, httptest.NewRequest(http.MethodPost, "/scim/v2/Bulk",
+		strings.NewReader(`{"Operations": []}`)))
+
+	s.Equal(http.StatusRequestEntityTooLarge, rr.Code)
+}
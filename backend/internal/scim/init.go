@@ -0,0 +1,100 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package scim
+
+import (
+	"net/http"
+
+	"github.com/thunder-id/thunderid/internal/entitytype"
+	"github.com/thunder-id/thunderid/internal/group"
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/middleware"
+	"github.com/thunder-id/thunderid/internal/user"
+)
+
+// Initialize initializes the SCIM service and registers its routes. It returns nil when the SCIM
+// endpoints are disabled, and an error when an attribute mapping is invalid.
+func Initialize(
+	mux *http.ServeMux,
+	userService user.UserServiceInterface,
+	groupService group.GroupServiceInterface,
+	entityTypeService entitytype.EntityTypeServiceInterface,
+) (SCIMServiceInterface, error) {
+	runtime := config.GetServerRuntime()
+	scimConfig := runtime.Config.SCIM
+	if !scimConfig.Enabled {
+		return nil, nil
+	}
+
+	mappings, err := buildAttributeMappings(scimConfig.AttributeMappings)
+	if err != nil {
+		return nil, err
+	}
+
+	scimService := newSCIMService(userService, groupService, entityTypeService, scimConfig.UserType, mappings,
+		config.GetServerURL(&runtime.Config.Server))
+
+	scimHandler := newSCIMHandler(scimService)
+	registerRoutes(mux, scimHandler)
+
+	return scimService, nil
+}
+
+// registerRoutes registers the routes for SCIM operations.
+func registerRoutes(mux *http.ServeMux, scimHandler *scimHandler) {
+	opts1 := middleware.CORSOptions{
+		AllowedMethods:   []string{"GET", "POST"},
+		AllowedHeaders:   middleware.DefaultAllowedHeaders,
+		AllowCredentials: true,
+		MaxAge:           600,
+	}
+	mux.HandleFunc(middleware.WithCORS("GET "+usersPath, scimHandler.HandleUserListRequest, opts1))
+	mux.HandleFunc(middleware.WithCORS("POST "+usersPath, scimHandler.HandleUserPostRequest, opts1))
+	mux.HandleFunc(middleware.WithCORS("GET "+groupsPath, scimHandler.HandleGroupListRequest, opts1))
+	mux.HandleFunc(middleware.WithCORS("POST "+groupsPath, scimHandler.HandleGroupPostRequest, opts1))
+	mux.HandleFunc(middleware.WithCORS("POST "+bulkPath, scimHandler.HandleBulkRequest, opts1))
+	mux.HandleFunc(middleware.WithCORS("GET "+basePath+"/ServiceProviderConfig",
+		scimHandler.HandleServiceProviderConfigRequest, opts1))
+	for _, path := range []string{usersPath, groupsPath, bulkPath, basePath + "/ServiceProviderConfig"} {
+		mux.HandleFunc(middleware.WithCORS("OPTIONS "+path, func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, opts1))
+	}
+
+	opts2 := middleware.CORSOptions{
+		AllowedMethods:   []string{"GET", "PUT", "PATCH", "DELETE"},
+		AllowedHeaders:   middleware.DefaultAllowedHeaders,
+		AllowCredentials: true,
+		MaxAge:           600,
+	}
+	mux.HandleFunc(middleware.WithCORS("GET "+usersPath+"/{id}", scimHandler.HandleUserGetRequest, opts2))
+	mux.HandleFunc(middleware.WithCORS("PUT "+usersPath+"/{id}", scimHandler.HandleUserPutRequest, opts2))
+	mux.HandleFunc(middleware.WithCORS("PATCH "+usersPath+"/{id}", scimHandler.HandleUserPatchRequest, opts2))
+	mux.HandleFunc(middleware.WithCORS("DELETE "+usersPath+"/{id}", scimHandler.HandleUserDeleteRequest, opts2))
+	mux.HandleFunc(middleware.WithCORS("GET "+groupsPath+"/{id}", scimHandler.HandleGroupGetRequest, opts2))
+	mux.HandleFunc(middleware.WithCORS("PUT "+groupsPath+"/{id}", scimHandler.HandleGroupPutRequest, opts2))
+	mux.HandleFunc(middleware.WithCORS("PATCH "+groupsPath+"/{id}", scimHandler.HandleGroupPatchRequest, opts2))
+	mux.HandleFunc(middleware.WithCORS("DELETE "+groupsPath+"/{id}",
+		scimHandler.HandleGroupDeleteRequest, opts2))
+	for _, path := range []string{usersPath + "/{id}", groupsPath + "/{id}"} {
+		mux.HandleFunc(middleware.WithCORS("OPTIONS "+path, func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, opts2))
+	}
+}
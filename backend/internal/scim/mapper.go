@@ -0,0 +1,264 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package scim
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/thunder-id/thunderid/internal/entitytype"
+	"github.com/thunder-id/thunderid/internal/group"
+	"github.com/thunder-id/thunderid/internal/user"
+)
+
+// buildAttributeMappings merges the configured mappings into the default mappings and parses their paths.
+// A mapping to an empty attribute removes the default mapping of the path.
+func buildAttributeMappings(overrides map[string]string) ([]attributeMapping, error) {
+	merged := make(map[string]string, len(defaultAttributeMappings)+len(overrides))
+	for path, attribute := range defaultAttributeMappings {
+		merged[path] = attribute
+	}
+	for path, attribute := range overrides {
+		merged[path] = attribute
+	}
+
+	paths := make([]string, 0, len(merged))
+	for path, attribute := range merged {
+		if attribute != "" {
+			paths = append(paths, path)
+		}
+	}
+	sort.Strings(paths)
+
+	mappings := make([]attributeMapping, 0, len(paths))
+	for _, rawPath := range paths {
+		path, err := parsePath(rawPath)
+		if err != nil {
+			return nil, fmt.Errorf("invalid SCIM attribute mapping %q: %w", rawPath, err)
+		}
+		if path.attribute == "" || (path.schema == "" && isReservedAttribute(path.attribute)) {
+			return nil, fmt.Errorf("SCIM attribute %q cannot be mapped", rawPath)
+		}
+		mappings = append(mappings, attributeMapping{path: path, attribute: merged[rawPath]})
+	}
+	return mappings, nil
+}
+
+// isReservedAttribute reports whether a core attribute is managed by the server and cannot be mapped.
+func isReservedAttribute(attribute string) bool {
+	for _, reserved := range []string{attributeSchemas, attributeID, attributeMeta, attributeGroups} {
+		if strings.EqualFold(attribute, reserved) {
+			return true
+		}
+	}
+	return false
+}
+
+// filterMappings returns the mappings to the attributes of a user type, marking the mappings to credential
+// attributes.
+func filterMappings(mappings []attributeMapping, attributes []entitytype.AttributeInfo) []attributeMapping {
+	credentials := make(map[string]bool, len(attributes))
+	for _, attribute := range attributes {
+		credentials[attribute.Attribute] = attribute.Credential
+	}
+	filtered := make([]attributeMapping, 0, len(mappings))
+	for _, mapping := range mappings {
+		credential, ok := credentials[mapping.attribute]
+		if !ok {
+			continue
+		}
+		mapping.credential = credential
+		filtered = append(filtered, mapping)
+	}
+	return filtered
+}
+
+// findMapping returns the mapping of the attribute a filter compares. A comparison on the value
+// sub-attribute of a multi-valued attribute matches the mapping of the attribute.
+func findMapping(mappings []attributeMapping, path attributePath) (attributeMapping, bool) {
+	for _, mapping := range mappings {
+		if mapping.path.equals(path) {
+			return mapping, true
+		}
+		if path.isMultiValued() && path.filter == nil && strings.EqualFold(path.subAttribute, attributeValue) &&
+			mapping.path.filter == nil && mapping.path.subAttribute == "" &&
+			strings.EqualFold(mapping.path.attribute, path.attribute) {
+			return mapping, true
+		}
+	}
+	return attributeMapping{}, false
+}
+
+// toUserResource maps a user to a SCIM user resource. Credential attributes are never returned, and a
+// user is active unless an attribute mapped to active says otherwise.
+func toUserResource(u *user.User, mappings []attributeMapping, baseURL string) Resource {
+	attributes := map[string]interface{}{}
+	if len(u.Attributes) > 0 {
+		_ = json.Unmarshal(u.Attributes, &attributes)
+	}
+
+	resource := Resource{attributeID: u.ID}
+	for _, mapping := range mappings {
+		if mapping.credential {
+			continue
+		}
+		if value, ok := attributes[mapping.attribute]; ok && value != nil {
+			setValue(resource, mapping.path, value)
+		}
+	}
+	if _, ok := getAttribute(resource, attributeActive); !ok {
+		resource[attributeActive] = true
+	}
+
+	schemas := []interface{}{SchemaUser}
+	if _, ok := resource[SchemaEnterpriseUser]; ok {
+		schemas = append(schemas, SchemaEnterpriseUser)
+	}
+	resource[attributeSchemas] = schemas
+	resource[attributeMeta] = buildMeta(resourceTypeUser, baseURL+usersPath+"/"+u.ID)
+	return resource
+}
+
+// toUserAttributes maps the attributes of a SCIM user resource to user attributes. An active value sent as
+// a string, as some identity providers do, is converted to a boolean.
+func toUserAttributes(resource Resource, mappings []attributeMapping) map[string]interface{} {
+	attributes := make(map[string]interface{}, len(mappings))
+	for _, mapping := range mappings {
+		value, ok := getValue(resource, mapping.path)
+		if !ok {
+			continue
+		}
+		if text, isText := value.(string); isText && mapping.path.schema == "" &&
+			strings.EqualFold(mapping.path.attribute, attributeActive) {
+			if active, err := strconv.ParseBool(text); err == nil {
+				value = active
+			}
+		}
+		attributes[mapping.attribute] = value
+	}
+	return attributes
+}
+
+// mergeUserAttributes replaces the mapped attributes of a user with the attributes mapped from a SCIM
+// resource, keeping the attributes that are not mapped.
+func mergeUserAttributes(existing json.RawMessage, mapped map[string]interface{},
+	mappings []attributeMapping) (json.RawMessage, error) {
+	attributes := map[string]interface{}{}
+	if len(existing) > 0 {
+		if err := json.Unmarshal(existing, &attributes); err != nil {
+			return nil, err
+		}
+	}
+	for _, mapping := range mappings {
+		delete(attributes, mapping.attribute)
+	}
+	for attribute, value := range mapped {
+		attributes[attribute] = value
+	}
+	return json.Marshal(attributes)
+}
+
+// toGroupResource maps a group to a SCIM group resource. Only user and group members are returned.
+func toGroupResource(g *group.Group, baseURL string) Resource {
+	members := make([]interface{}, 0, len(g.Members))
+	for _, member := range g.Members {
+		memberType, path := "", ""
+		switch member.Type {
+		case group.MemberTypeUser:
+			memberType, path = resourceTypeUser, usersPath
+		case group.MemberTypeGroup:
+			memberType, path = resourceTypeGroup, groupsPath
+		default:
+			continue
+		}
+		scimMember := map[string]interface{}{
+			attributeValue: member.ID,
+			attributeType:  memberType,
+			attributeRef:   baseURL + path + "/" + member.ID,
+		}
+		if member.Display != "" {
+			scimMember[attributeDisplay] = member.Display
+		}
+		members = append(members, scimMember)
+	}
+
+	resource := Resource{
+		attributeSchemas:     []interface{}{SchemaGroup},
+		attributeID:          g.ID,
+		attributeDisplayName: g.Name,
+		attributeMeta:        buildMeta(resourceTypeGroup, baseURL+groupsPath+"/"+g.ID),
+	}
+	if len(members) > 0 {
+		resource[attributeMembers] = members
+	}
+	return resource
+}
+
+// toGroupMembers maps the members of a SCIM group resource to group members. Members are users unless
+// their type is Group.
+func toGroupMembers(resource Resource) ([]group.Member, error) {
+	value, ok := getAttribute(resource, attributeMembers)
+	if !ok || value == nil {
+		return nil, nil
+	}
+	values, ok := value.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("%w: members must be a list", errInvalidValue)
+	}
+
+	members := make([]group.Member, 0, len(values))
+	for _, element := range values {
+		object, ok := element.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("%w: a member must be an object", errInvalidValue)
+		}
+		id, _ := getAttribute(object, attributeValue)
+		memberID, ok := id.(string)
+		if !ok || memberID == "" {
+			return nil, fmt.Errorf("%w: a member has no value", errInvalidValue)
+		}
+		memberType := group.MemberTypeUser
+		if scimType, _ := getAttribute(object, attributeType); scimType != nil {
+			if typeName, _ := scimType.(string); strings.EqualFold(typeName, resourceTypeGroup) {
+				memberType = group.MemberTypeGroup
+			}
+		}
+		members = append(members, group.Member{ID: memberID, Type: memberType})
+	}
+	return members, nil
+}
+
+// getDisplayName returns the display name of a SCIM group resource.
+func getDisplayName(resource Resource) (string, bool) {
+	value, _ := getAttribute(resource, attributeDisplayName)
+	displayName, ok := value.(string)
+	displayName = strings.TrimSpace(displayName)
+	return displayName, ok && displayName != ""
+}
+
+// buildMeta returns the meta attribute of a resource.
+func buildMeta(resourceType, location string) map[string]interface{} {
+	return map[string]interface{}{
+		"resourceType": resourceType,
+		"location":     location,
+	}
+}
@@ -0,0 +1,192 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package scim
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/entitytype"
+	"github.com/thunder-id/thunderid/internal/group"
+	"github.com/thunder-id/thunderid/internal/user"
+)
+
+const testBaseURL = "https://localhost:8090"
+
+type MapperTestSuite struct {
+	suite.Suite
+	mappings []attributeMapping
+}
+
+func TestMapperTestSuite(t *testing.T) {
+	suite.Run(t, new(MapperTestSuite))
+}
+
+func (suite *MapperTestSuite) SetupTest() {
+	mappings, err := buildAttributeMappings(nil)
+	suite.Require().NoError(err)
+	suite.mappings = filterMappings(mappings, []entitytype.AttributeInfo{
+		{Attribute: "username"},
+		{Attribute: "given_name"},
+		{Attribute: "email"},
+		{Attribute: "mobileNumber"},
+		{Attribute: "active"},
+		{Attribute: "department"},
+		{Attribute: "password", Credential: true},
+	})
+}
+
+func (suite *MapperTestSuite) TestBuildAttributeMappings_Overrides() {
+	mappings, err := buildAttributeMappings(map[string]string{
+		"userName":        "",
+		"name.middleName": "middle_name",
+	})
+
+	suite.NoError(err)
+	attributes := map[string]string{}
+	for _, mapping := range mappings {
+		attributes[mapping.attribute] = mapping.path.attribute
+	}
+	suite.NotContains(attributes, "username")
+	suite.Equal("name", attributes["middle_name"])
+}
+
+func (suite *MapperTestSuite) TestBuildAttributeMappings_Invalid() {
+	for _, path := range []string{"groups", "meta.created", "1name", SchemaEnterpriseUser} {
+		_, err := buildAttributeMappings(map[string]string{path: "attribute"})
+		suite.Error(err, path)
+	}
+}
+
+func (suite *MapperTestSuite) TestFilterMappings() {
+	suite.Len(suite.mappings, 7)
+	for _, mapping := range suite.mappings {
+		suite.Equal(mapping.attribute == "password", mapping.credential)
+	}
+}
+
+func (suite *MapperTestSuite) TestToUserResource() {
+	u := &user.User{
+		ID:   "user-1",
+		Type: "Person",
+		Attributes: json.RawMessage(`{"username": "alice", "given_name": "Alice", "email": "alice@example.com",
+			"mobileNumber": "+1234", "department": "Sales", "password": "secret", "internal": "x"}`),
+	}
+
+	resource := toUserResource(u, suite.mappings, testBaseURL)
+
+	suite.Equal(Resource{
+		"id":       "user-1",
+		"userName": "alice",
+		"name":     map[string]interface{}{"givenName": "Alice"},
+		"emails":   []interface{}{map[string]interface{}{"value": "alice@example.com", "primary": true}},
+		"phoneNumbers": []interface{}{
+			map[string]interface{}{"value": "+1234", "type": "mobile"},
+		},
+		"active":             true,
+		SchemaEnterpriseUser: map[string]interface{}{"department": "Sales"},
+		"schemas":            []interface{}{SchemaUser, SchemaEnterpriseUser},
+		"meta": map[string]interface{}{
+			"resourceType": "User",
+			"location":     testBaseURL + "/scim/v2/Users/user-1",
+		},
+	}, resource)
+}
+
+func (suite *MapperTestSuite) TestToUserAttributes() {
+	var resource Resource
+	_ = json.Unmarshal([]byte(`{
+		"schemas": ["`+SchemaUser+`"],
+		"userName": "alice",
+		"password": "secret",
+		"active": "False",
+		"emails": [{"value": "other@example.com"}, {"value": "alice@example.com", "primary": true}],
+		"phoneNumbers": [{"value": "+5678", "type": "work"}, {"value": "+1234", "type": "mobile"}],
+		"title": "Engineer"
+	}`), &resource)
+
+	attributes := toUserAttributes(resource, suite.mappings)
+
+	suite.Equal(map[string]interface{}{
+		"username":     "alice",
+		"password":     "secret",
+		"active":       false,
+		"email":        "alice@example.com",
+		"mobileNumber": "+1234",
+	}, attributes)
+}
+
+func (suite *MapperTestSuite) TestMergeUserAttributes() {
+	merged, err := mergeUserAttributes(json.RawMessage(`{"username": "alice", "given_name": "Alice", "internal": 1}`),
+		map[string]interface{}{"username": "alice2"}, suite.mappings)
+
+	suite.NoError(err)
+	suite.JSONEq(`{"username": "alice2", "internal": 1}`, string(merged))
+}
+
+func (suite *MapperTestSuite) TestFindMapping_ValueSubAttribute() {
+	path, _ := parsePath("emails.value")
+
+	mapping, ok := findMapping(suite.mappings, path)
+
+	suite.True(ok)
+	suite.Equal("email", mapping.attribute)
+}
+
+func (suite *MapperTestSuite) TestToGroupResource() {
+	g := &group.Group{
+		ID:   "group-1",
+		Name: "Admins",
+		Members: []group.Member{
+			{ID: "user-1", Type: group.MemberTypeUser, Display: "alice"},
+			{ID: "group-2", Type: group.MemberTypeGroup},
+			{ID: "app-1", Type: group.MemberType("app")},
+		},
+	}
+
+	resource := toGroupResource(g, testBaseURL)
+
+	suite.Equal("Admins", resource["displayName"])
+	suite.Equal([]interface{}{
+		map[string]interface{}{
+			"value": "user-1", "type": "User", "display": "alice", "$ref": testBaseURL + "/scim/v2/Users/user-1",
+		},
+		map[string]interface{}{
+			"value": "group-2", "type": "Group", "$ref": testBaseURL + "/scim/v2/Groups/group-2",
+		},
+	}, resource["members"])
+}
+
+func (suite *MapperTestSuite) TestToGroupMembers() {
+	members, err := toGroupMembers(Resource{"members": []interface{}{
+		map[string]interface{}{"value": "user-1"},
+		map[string]interface{}{"value": "group-2", "type": "group"},
+	}})
+
+	suite.NoError(err)
+	suite.Equal([]group.Member{
+		{ID: "user-1", Type: group.MemberTypeUser},
+		{ID: "group-2", Type: group.MemberTypeGroup},
+	}, members)
+
+	_, err = toGroupMembers(Resource{"members": []interface{}{map[string]interface{}{"display": "x"}}})
+	suite.Error(err)
+}
@@ -0,0 +1,159 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package scim provides SCIM 2.0 endpoints that provision users and groups from enterprise identity providers
+// onto the user and group services.
+package scim
+
+import "encoding/json"
+
+// Resource represents a SCIM resource as its JSON attributes. Attribute names are matched
+// case-insensitively.
+type Resource map[string]interface{}
+
+// ListResponse represents a page of SCIM resources.
+type ListResponse struct {
+	Schemas      []string   `json:"schemas"`
+	TotalResults int        `json:"totalResults"`
+	StartIndex   int        `json:"startIndex"`
+	ItemsPerPage int        `json:"itemsPerPage"`
+	Resources    []Resource `json:"Resources"`
+}
+
+// ListRequest represents the query of a list request. StartIndex is one-based.
+type ListRequest struct {
+	Filter     string
+	StartIndex int
+	Count      int
+}
+
+// PatchRequest represents a SCIM patch request.
+type PatchRequest struct {
+	Schemas    []string         `json:"schemas"`
+	Operations []PatchOperation `json:"Operations"`
+}
+
+// PatchOperation represents an operation of a SCIM patch request.
+type PatchOperation struct {
+	Op    PatchOperationType `json:"op"`
+	Path  string             `json:"path,omitempty"`
+	Value json.RawMessage    `json:"value,omitempty"`
+}
+
+// BulkRequest represents a SCIM bulk request. The operations run in order and processing stops once
+// FailOnErrors operations have failed; zero processes every operation.
+type BulkRequest struct {
+	Schemas      []string        `json:"schemas"`
+	FailOnErrors int             `json:"failOnErrors,omitempty"`
+	Operations   []BulkOperation `json:"Operations"`
+}
+
+// BulkOperation represents an operation of a SCIM bulk request. The path is relative to the base path, such
+// as /Users or /Groups/{id}, and the data is the resource of a POST or PUT, or the patch request of a PATCH.
+type BulkOperation struct {
+	Method  string          `json:"method"`
+	BulkID  string          `json:"bulkId,omitempty"`
+	Version string          `json:"version,omitempty"`
+	Path    string          `json:"path"`
+	Data    json.RawMessage `json:"data,omitempty"`
+}
+
+// BulkResponse represents a SCIM bulk response.
+type BulkResponse struct {
+	Schemas    []string                `json:"schemas"`
+	Operations []BulkOperationResponse `json:"Operations"`
+}
+
+// BulkOperationResponse represents the outcome of an operation of a SCIM bulk request. The status is the HTTP
+// status code as a string, and the response is the error of a failed operation.
+type BulkOperationResponse struct {
+	Location string         `json:"location,omitempty"`
+	Method   string         `json:"method"`
+	BulkID   string         `json:"bulkId,omitempty"`
+	Version  string         `json:"version,omitempty"`
+	Status   string         `json:"status"`
+	Response *ErrorResponse `json:"response,omitempty"`
+}
+
+// ErrorResponse represents a SCIM error response. The status is the HTTP status code as a string.
+type ErrorResponse struct {
+	Schemas  []string  `json:"schemas"`
+	ScimType ErrorType `json:"scimType,omitempty"`
+	Detail   string    `json:"detail,omitempty"`
+	Status   string    `json:"status"`
+}
+
+// ServiceProviderConfig represents the SCIM features supported by the server.
+type ServiceProviderConfig struct {
+	Schemas               []string               `json:"schemas"`
+	Patch                 supportedFeature       `json:"patch"`
+	Bulk                  bulkFeature            `json:"bulk"`
+	Filter                filterFeature          `json:"filter"`
+	ChangePassword        supportedFeature       `json:"changePassword"`
+	Sort                  supportedFeature       `json:"sort"`
+	ETag                  supportedFeature       `json:"etag"`
+	AuthenticationSchemes []authenticationScheme `json:"authenticationSchemes"`
+}
+
+// supportedFeature represents whether a SCIM feature is supported.
+type supportedFeature struct {
+	Supported bool `json:"supported"`
+}
+
+// bulkFeature represents the support of bulk operations.
+type bulkFeature struct {
+	Supported      bool `json:"supported"`
+	MaxOperations  int  `json:"maxOperations"`
+	MaxPayloadSize int  `json:"maxPayloadSize"`
+}
+
+// filterFeature represents the support of filters.
+type filterFeature struct {
+	Supported  bool `json:"supported"`
+	MaxResults int  `json:"maxResults"`
+}
+
+// authenticationScheme represents an authentication scheme accepted by the SCIM endpoints.
+type authenticationScheme struct {
+	Type        string `json:"type"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Primary     bool   `json:"primary"`
+}
+
+// attributePath represents a parsed SCIM attribute path: an optional schema URI, an attribute, an optional
+// filter on the values of a multi-valued attribute and an optional sub-attribute.
+type attributePath struct {
+	schema       string
+	attribute    string
+	filter       []condition
+	subAttribute string
+}
+
+// condition represents an equality comparison of a filter. The conditions of a filter are combined with and.
+type condition struct {
+	path  attributePath
+	value interface{}
+}
+
+// attributeMapping represents the mapping of a SCIM attribute path to a user attribute.
+type attributeMapping struct {
+	path       attributePath
+	attribute  string
+	credential bool
+}
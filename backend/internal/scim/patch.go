@@ -0,0 +1,316 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package scim
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// applyPatch applies the operations of a patch request to a resource. Operations on the read-only
+// attributes fail with errMutability.
+func applyPatch(resource Resource, operations []PatchOperation, readOnly []string) error {
+	for _, operation := range operations {
+		if err := applyOperation(resource, operation, readOnly); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// applyOperation applies a single patch operation to a resource.
+func applyOperation(resource Resource, operation PatchOperation, readOnly []string) error {
+	var value interface{}
+	if len(operation.Value) > 0 {
+		if err := json.Unmarshal(operation.Value, &value); err != nil {
+			return fmt.Errorf("%w: %s", errInvalidValue, err.Error())
+		}
+	}
+
+	op := PatchOperationType(strings.ToLower(string(operation.Op)))
+	switch op {
+	case PatchOperationAdd, PatchOperationReplace:
+		if value == nil {
+			return fmt.Errorf("%w: the %s operation has no value", errInvalidValue, op)
+		}
+		if operation.Path == "" {
+			object, ok := value.(map[string]interface{})
+			if !ok {
+				return fmt.Errorf("%w: the value of an operation without a path must be an object", errInvalidValue)
+			}
+			for name, attributeValue := range object {
+				if strings.EqualFold(name, attributeSchemas) {
+					continue
+				}
+				if err := applyPathValue(resource, op, name, attributeValue, readOnly); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+		return applyPathValue(resource, op, operation.Path, value, readOnly)
+	case PatchOperationRemove:
+		if operation.Path == "" {
+			return fmt.Errorf("%w: the remove operation has no path", errNoTarget)
+		}
+		path, err := parsePath(operation.Path)
+		if err != nil {
+			return err
+		}
+		if err := checkMutable(path, readOnly); err != nil {
+			return err
+		}
+		return removeValue(resource, path, value)
+	default:
+		return fmt.Errorf("%w: unknown operation %q", errInvalidValue, operation.Op)
+	}
+}
+
+// applyPathValue applies an add or replace operation on the attribute a path selects. A path that selects
+// an extension applies the operation on each attribute of the value.
+func applyPathValue(resource Resource, op PatchOperationType, rawPath string, value interface{},
+	readOnly []string) error {
+	path, err := parsePath(rawPath)
+	if err != nil {
+		return err
+	}
+	if path.attribute == "" {
+		object, ok := value.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("%w: the value of %s must be an object", errInvalidValue, path.schema)
+		}
+		for name, attributeValue := range object {
+			if scanName(name) != len(name) {
+				return fmt.Errorf("%w: %q", errInvalidPath, name)
+			}
+			if err := setPathValue(resource, op, attributePath{schema: path.schema, attribute: name},
+				attributeValue); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if err := checkMutable(path, readOnly); err != nil {
+		return err
+	}
+	return setPathValue(resource, op, path, value)
+}
+
+// checkMutable fails when a path selects a read-only attribute.
+func checkMutable(path attributePath, readOnly []string) error {
+	if path.schema != "" {
+		return nil
+	}
+	for _, attribute := range readOnly {
+		if strings.EqualFold(path.attribute, attribute) {
+			return fmt.Errorf("%w: %s", errMutability, attribute)
+		}
+	}
+	return nil
+}
+
+// setPathValue adds or replaces the value of the attribute a path selects. Values of multi-valued
+// attributes that match the filter of the path are updated, and a value is added when none matches.
+// Replacing a complex attribute keeps the sub-attributes the value does not specify.
+func setPathValue(resource Resource, op PatchOperationType, path attributePath, value interface{}) error {
+	container := getContainer(resource, path, true)
+	existing, _ := getAttribute(container, path.attribute)
+
+	if path.filter != nil {
+		values, ok := existing.([]interface{})
+		if existing != nil && !ok {
+			return fmt.Errorf("%w: %s is not multi-valued", errInvalidPath, path.attribute)
+		}
+		matched := false
+		for i, candidate := range values {
+			if !matchesFilter(candidate, path.filter) {
+				continue
+			}
+			matched = true
+			if path.subAttribute != "" {
+				setAttribute(candidate.(map[string]interface{}), path.subAttribute, value)
+			} else {
+				values[i] = mergeValue(candidate, value)
+			}
+		}
+		if !matched {
+			element := map[string]interface{}{}
+			for _, c := range path.filter {
+				element[c.path.attribute] = c.value
+			}
+			if path.subAttribute != "" {
+				element[path.subAttribute] = value
+			} else if object, isObject := value.(map[string]interface{}); isObject {
+				for name, attributeValue := range object {
+					setAttribute(element, name, attributeValue)
+				}
+			} else {
+				return fmt.Errorf("%w: the value of %s must be an object", errInvalidValue, path.attribute)
+			}
+			values = append(values, element)
+		}
+		setAttribute(container, path.attribute, values)
+		return nil
+	}
+
+	if path.subAttribute != "" {
+		if _, isList := existing.([]interface{}); isList || path.isMultiValued() {
+			return fmt.Errorf("%w: %s.%s requires a filter", errInvalidPath, path.attribute, path.subAttribute)
+		}
+		object, ok := existing.(map[string]interface{})
+		if !ok {
+			object = map[string]interface{}{}
+			setAttribute(container, path.attribute, object)
+		}
+		setAttribute(object, path.subAttribute, value)
+		return nil
+	}
+
+	existingValues, isList := existing.([]interface{})
+	if isList || path.isMultiValued() {
+		values, ok := value.([]interface{})
+		if !ok {
+			values = []interface{}{value}
+		}
+		if op == PatchOperationReplace {
+			setAttribute(container, path.attribute, values)
+			return nil
+		}
+		for _, added := range values {
+			if !containsValue(existingValues, added) {
+				existingValues = append(existingValues, added)
+			}
+		}
+		setAttribute(container, path.attribute, existingValues)
+		return nil
+	}
+
+	setAttribute(container, path.attribute, mergeValue(existing, value))
+	return nil
+}
+
+// mergeValue merges the sub-attributes of a complex value into an existing complex value, or returns the
+// value when either is not complex.
+func mergeValue(existing, value interface{}) interface{} {
+	existingObject, ok := existing.(map[string]interface{})
+	object, isObject := value.(map[string]interface{})
+	if !ok || !isObject {
+		return value
+	}
+	for name, attributeValue := range object {
+		setAttribute(existingObject, name, attributeValue)
+	}
+	return existingObject
+}
+
+// containsValue reports whether a multi-valued attribute holds a value. Complex values with a value
+// sub-attribute are compared by it.
+func containsValue(values []interface{}, value interface{}) bool {
+	for _, candidate := range values {
+		if sameValue(candidate, value) {
+			return true
+		}
+	}
+	return false
+}
+
+// sameValue reports whether two values of a multi-valued attribute are the same value.
+func sameValue(a, b interface{}) bool {
+	objectA, okA := a.(map[string]interface{})
+	objectB, okB := b.(map[string]interface{})
+	if okA && okB {
+		valueA, hasA := getAttribute(objectA, attributeValue)
+		valueB, hasB := getAttribute(objectB, attributeValue)
+		if hasA && hasB {
+			return valuesEqual(valueA, valueB)
+		}
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+// removeValue removes the attribute a path selects. Values of multi-valued attributes are removed when
+// they match the filter of the path or, without a filter, when they are listed in the value of the
+// operation. Removing an attribute that has no value succeeds.
+func removeValue(resource Resource, path attributePath, value interface{}) error {
+	container := getContainer(resource, path, false)
+	if container == nil {
+		return nil
+	}
+	if path.attribute == "" {
+		deleteAttribute(resource, path.schema)
+		return nil
+	}
+	existing, ok := getAttribute(container, path.attribute)
+	if !ok {
+		return nil
+	}
+	values, isList := existing.([]interface{})
+
+	if path.filter != nil {
+		if !isList {
+			return fmt.Errorf("%w: %s is not multi-valued", errInvalidPath, path.attribute)
+		}
+		kept := make([]interface{}, 0, len(values))
+		for _, candidate := range values {
+			switch {
+			case !matchesFilter(candidate, path.filter):
+				kept = append(kept, candidate)
+			case path.subAttribute != "":
+				deleteAttribute(candidate.(map[string]interface{}), path.subAttribute)
+				kept = append(kept, candidate)
+			}
+		}
+		setOrDelete(container, path.attribute, kept)
+		return nil
+	}
+
+	if path.subAttribute != "" {
+		if isList {
+			return fmt.Errorf("%w: %s.%s requires a filter", errInvalidPath, path.attribute, path.subAttribute)
+		}
+		if object, isObject := existing.(map[string]interface{}); isObject {
+			deleteAttribute(object, path.subAttribute)
+		}
+		return nil
+	}
+
+	if removed, listed := value.([]interface{}); isList && listed {
+		kept := make([]interface{}, 0, len(values))
+		for _, candidate := range values {
+			if !containsValue(removed, candidate) {
+				kept = append(kept, candidate)
+			}
+		}
+		setOrDelete(container, path.attribute, kept)
+		return nil
+	}
+	deleteAttribute(container, path.attribute)
+	return nil
+}
+
+// setOrDelete sets the values of a multi-valued attribute, or removes the attribute when no value remains.
+func setOrDelete(container map[string]interface{}, attribute string, values []interface{}) {
+	if len(values) == 0 {
+		deleteAttribute(container, attribute)
+		return
+	}
+	setAttribute(container, attribute, values)
+}
@@ -0,0 +1,171 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package scim
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type PatchTestSuite struct {
+	suite.Suite
+}
+
+func TestPatchTestSuite(t *testing.T) {
+	suite.Run(t, new(PatchTestSuite))
+}
+
+func (suite *PatchTestSuite) newResource() Resource {
+	var resource Resource
+	_ = json.Unmarshal([]byte(`{
+		"id": "user-1",
+		"userName": "alice",
+		"name": {"givenName": "Alice", "familyName": "Smith"},
+		"emails": [
+			{"value": "alice@example.com", "type": "work", "primary": true},
+			{"value": "alice@home.example.com", "type": "home"}
+		]
+	}`), &resource)
+	return resource
+}
+
+func (suite *PatchTestSuite) operation(op PatchOperationType, path string, value string) PatchOperation {
+	operation := PatchOperation{Op: op, Path: path}
+	if value != "" {
+		operation.Value = json.RawMessage(value)
+	}
+	return operation
+}
+
+func (suite *PatchTestSuite) TestApplyPatch_ReplaceSimpleAttribute() {
+	resource := suite.newResource()
+
+	err := applyPatch(resource, []PatchOperation{
+		suite.operation("Replace", "userName", `"alice2"`),
+	}, userReadOnlyAttributes)
+
+	suite.NoError(err)
+	suite.Equal("alice2", resource["userName"])
+}
+
+func (suite *PatchTestSuite) TestApplyPatch_ReplaceComplexAttributeKeepsSubAttributes() {
+	resource := suite.newResource()
+
+	err := applyPatch(resource, []PatchOperation{
+		suite.operation(PatchOperationReplace, "name", `{"givenName": "Alicia"}`),
+	}, userReadOnlyAttributes)
+
+	suite.NoError(err)
+	suite.Equal(map[string]interface{}{"givenName": "Alicia", "familyName": "Smith"}, resource["name"])
+}
+
+func (suite *PatchTestSuite) TestApplyPatch_WithoutPath() {
+	resource := suite.newResource()
+
+	err := applyPatch(resource, []PatchOperation{
+		suite.operation(PatchOperationReplace, "", `{"active": false, "name.familyName": "Jones",
+			"`+SchemaEnterpriseUser+`:department": "Sales"}`),
+	}, userReadOnlyAttributes)
+
+	suite.NoError(err)
+	suite.Equal(false, resource["active"])
+	suite.Equal("Jones", resource["name"].(map[string]interface{})["familyName"])
+	suite.Equal(map[string]interface{}{"department": "Sales"}, resource[SchemaEnterpriseUser])
+}
+
+func (suite *PatchTestSuite) TestApplyPatch_FilteredValue() {
+	resource := suite.newResource()
+
+	err := applyPatch(resource, []PatchOperation{
+		suite.operation(PatchOperationReplace, `emails[type eq "work"].value`, `"new@example.com"`),
+		suite.operation(PatchOperationAdd, `phoneNumbers[type eq "mobile"].value`, `"+1234"`),
+	}, userReadOnlyAttributes)
+
+	suite.NoError(err)
+	emails := resource["emails"].([]interface{})
+	suite.Equal("new@example.com", emails[0].(map[string]interface{})["value"])
+	suite.Equal([]interface{}{map[string]interface{}{"type": "mobile", "value": "+1234"}}, resource["phoneNumbers"])
+}
+
+func (suite *PatchTestSuite) TestApplyPatch_AddMultiValuedSkipsDuplicates() {
+	resource := suite.newResource()
+
+	err := applyPatch(resource, []PatchOperation{
+		suite.operation(PatchOperationAdd, "emails",
+			`[{"value": "ALICE@example.com"}, {"value": "other@example.com", "type": "other"}]`),
+	}, userReadOnlyAttributes)
+
+	suite.NoError(err)
+	suite.Len(resource["emails"], 3)
+}
+
+func (suite *PatchTestSuite) TestApplyPatch_Remove() {
+	resource := suite.newResource()
+
+	err := applyPatch(resource, []PatchOperation{
+		suite.operation(PatchOperationRemove, `emails[type eq "home"]`, ""),
+		suite.operation(PatchOperationRemove, "name.familyName", ""),
+		suite.operation(PatchOperationRemove, "title", ""),
+	}, userReadOnlyAttributes)
+
+	suite.NoError(err)
+	suite.Len(resource["emails"], 1)
+	suite.Equal(map[string]interface{}{"givenName": "Alice"}, resource["name"])
+}
+
+func (suite *PatchTestSuite) TestApplyPatch_RemoveListedMembers() {
+	resource := Resource{"members": []interface{}{
+		map[string]interface{}{"value": "user-1"},
+		map[string]interface{}{"value": "user-2"},
+	}}
+
+	err := applyPatch(resource, []PatchOperation{
+		suite.operation(PatchOperationRemove, "members", `[{"value": "user-1"}, {"value": "user-2"}]`),
+	}, groupReadOnlyAttributes)
+
+	suite.NoError(err)
+	suite.NotContains(resource, "members")
+}
+
+func (suite *PatchTestSuite) TestApplyPatch_Errors() {
+	testCases := []struct {
+		name      string
+		operation PatchOperation
+		expected  error
+	}{
+		{"Read-only attribute", suite.operation(PatchOperationReplace, "id", `"other"`), errMutability},
+		{"Remove without path", suite.operation(PatchOperationRemove, "", ""), errNoTarget},
+		{"Missing value", suite.operation(PatchOperationAdd, "title", ""), errInvalidValue},
+		{"Unknown operation", suite.operation("move", "title", `"x"`), errInvalidValue},
+		{"Invalid path", suite.operation(PatchOperationAdd, "1title", `"x"`), errInvalidPath},
+		{"Sub-attribute without filter", suite.operation(PatchOperationReplace, "emails.value", `"x"`),
+			errInvalidPath},
+		{"Value without path is not an object", suite.operation(PatchOperationAdd, "", `"x"`), errInvalidValue},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := applyPatch(suite.newResource(), []PatchOperation{tc.operation}, userReadOnlyAttributes)
+			suite.True(errors.Is(err, tc.expected), err)
+		})
+	}
+}
@@ -0,0 +1,274 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package scim
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Errors returned while parsing filters and attribute paths and while applying patch operations.
+var (
+	errInvalidFilter = errors.New("invalid filter")
+	errInvalidPath   = errors.New("invalid attribute path")
+	errInvalidValue  = errors.New("invalid value")
+	errNoTarget      = errors.New("no target")
+	errMutability    = errors.New("attribute is read-only")
+)
+
+// extensionSchemas lists the schema URIs of the extensions whose attributes are nested under their URI.
+var extensionSchemas = []string{SchemaEnterpriseUser}
+
+// coreSchemas lists the schema URIs of the core resources whose attributes are at the top level.
+var coreSchemas = []string{SchemaUser, SchemaGroup}
+
+// multiValuedAttributes lists the multi-valued attributes of the core schemas.
+var multiValuedAttributes = map[string]struct{}{
+	"emails":           {},
+	"phonenumbers":     {},
+	"ims":              {},
+	"photos":           {},
+	"addresses":        {},
+	"groups":           {},
+	"entitlements":     {},
+	"roles":            {},
+	"x509certificates": {},
+	"members":          {},
+}
+
+// parseFilter parses a filter made of equality comparisons combined with and, such as
+// `userName eq "alice" and active eq true`. Other operators are not supported.
+func parseFilter(filter string) ([]condition, error) {
+	conditions := make([]condition, 0, 1)
+	remaining := strings.TrimSpace(filter)
+	for {
+		if remaining == "" {
+			return nil, fmt.Errorf("%w: missing comparison", errInvalidFilter)
+		}
+		pathEnd := scanPath(remaining)
+		path, err := parsePath(remaining[:pathEnd])
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s", errInvalidFilter, err.Error())
+		}
+		remaining = strings.TrimLeft(remaining[pathEnd:], " ")
+
+		operator, rest, _ := strings.Cut(remaining, " ")
+		if !strings.EqualFold(operator, "eq") {
+			return nil, fmt.Errorf("%w: the %q operator is not supported", errInvalidFilter, operator)
+		}
+		value, rest, err := parseFilterValue(strings.TrimLeft(rest, " "))
+		if err != nil {
+			return nil, err
+		}
+		conditions = append(conditions, condition{path: path, value: value})
+
+		remaining = strings.TrimSpace(rest)
+		if remaining == "" {
+			return conditions, nil
+		}
+		connector, rest, _ := strings.Cut(remaining, " ")
+		if !strings.EqualFold(connector, "and") {
+			return nil, fmt.Errorf("%w: the %q connector is not supported", errInvalidFilter, connector)
+		}
+		remaining = strings.TrimSpace(rest)
+	}
+}
+
+// scanPath returns the length of the attribute path at the start of a filter, including a bracketed
+// value filter.
+func scanPath(filter string) int {
+	depth := 0
+	inString := false
+	for i := 0; i < len(filter); i++ {
+		switch c := filter[i]; {
+		case inString && c == '\\':
+			i++
+		case c == '"':
+			inString = !inString
+		case inString:
+		case c == '[':
+			depth++
+		case c == ']':
+			depth--
+		case c == ' ' && depth == 0:
+			return i
+		}
+	}
+	return len(filter)
+}
+
+// parseFilterValue parses the comparison value at the start of a filter and returns the rest of the
+// filter.
+func parseFilterValue(filter string) (interface{}, string, error) {
+	if strings.HasPrefix(filter, `"`) {
+		for i := 1; i < len(filter); i++ {
+			switch filter[i] {
+			case '\\':
+				i++
+			case '"':
+				value, err := strconv.Unquote(filter[:i+1])
+				if err != nil {
+					return nil, "", fmt.Errorf("%w: invalid string %s", errInvalidFilter, filter[:i+1])
+				}
+				return value, filter[i+1:], nil
+			}
+		}
+		return nil, "", fmt.Errorf("%w: unterminated string", errInvalidFilter)
+	}
+
+	literal, rest, _ := strings.Cut(filter, " ")
+	switch strings.ToLower(literal) {
+	case "true":
+		return true, rest, nil
+	case "false":
+		return false, rest, nil
+	case "null":
+		return nil, rest, nil
+	}
+	number, err := strconv.ParseFloat(literal, 64)
+	if err != nil {
+		return nil, "", fmt.Errorf("%w: invalid value %q", errInvalidFilter, literal)
+	}
+	return number, rest, nil
+}
+
+// parsePath parses an attribute path such as `name.givenName`, `emails[type eq "work"].value` or
+// `urn:ietf:params:scim:schemas:extension:enterprise:2.0:User:department`. A path that is only the URI of
+// an extension schema selects the extension itself.
+func parsePath(path string) (attributePath, error) {
+	parsed := attributePath{}
+	remaining := strings.TrimSpace(path)
+	if len(remaining) > 4 && strings.EqualFold(remaining[:4], "urn:") {
+		schema, rest, err := cutSchema(remaining)
+		if err != nil {
+			return attributePath{}, err
+		}
+		if rest == "" {
+			if schema == "" {
+				return attributePath{}, fmt.Errorf("%w: %q has no attribute", errInvalidPath, path)
+			}
+			return attributePath{schema: schema}, nil
+		}
+		parsed.schema = schema
+		remaining = rest
+	}
+
+	nameEnd := scanName(remaining)
+	if nameEnd == 0 {
+		return attributePath{}, fmt.Errorf("%w: %q", errInvalidPath, path)
+	}
+	parsed.attribute = remaining[:nameEnd]
+	remaining = remaining[nameEnd:]
+
+	if strings.HasPrefix(remaining, "[") {
+		filterEnd := strings.LastIndex(remaining, "]")
+		if filterEnd < 0 {
+			return attributePath{}, fmt.Errorf("%w: %q has an unterminated filter", errInvalidPath, path)
+		}
+		filter, err := parseFilter(remaining[1:filterEnd])
+		if err != nil {
+			return attributePath{}, fmt.Errorf("%w: %s", errInvalidPath, err.Error())
+		}
+		for _, c := range filter {
+			if c.path.schema != "" || c.path.filter != nil || c.path.subAttribute != "" {
+				return attributePath{}, fmt.Errorf("%w: %q filters on a nested attribute", errInvalidPath, path)
+			}
+		}
+		parsed.filter = filter
+		remaining = remaining[filterEnd+1:]
+	}
+
+	if strings.HasPrefix(remaining, ".") {
+		remaining = remaining[1:]
+		subEnd := scanName(remaining)
+		if subEnd == 0 {
+			return attributePath{}, fmt.Errorf("%w: %q", errInvalidPath, path)
+		}
+		parsed.subAttribute = remaining[:subEnd]
+		remaining = remaining[subEnd:]
+	}
+	if remaining != "" {
+		return attributePath{}, fmt.Errorf("%w: %q", errInvalidPath, path)
+	}
+	return parsed, nil
+}
+
+// cutSchema splits a path that starts with a schema URI into the schema of an extension, or an empty
+// schema for a core schema, and the rest of the path.
+func cutSchema(path string) (string, string, error) {
+	for _, schema := range coreSchemas {
+		if rest, ok := cutPrefixFold(path, schema+":"); ok {
+			return "", rest, nil
+		}
+	}
+	for _, schema := range extensionSchemas {
+		if strings.EqualFold(path, schema) {
+			return schema, "", nil
+		}
+		if rest, ok := cutPrefixFold(path, schema+":"); ok {
+			return schema, rest, nil
+		}
+	}
+	return "", "", fmt.Errorf("%w: %q uses an unknown schema", errInvalidPath, path)
+}
+
+// cutPrefixFold removes a prefix matched case-insensitively.
+func cutPrefixFold(s, prefix string) (string, bool) {
+	if len(s) < len(prefix) || !strings.EqualFold(s[:len(prefix)], prefix) {
+		return s, false
+	}
+	return s[len(prefix):], true
+}
+
+// scanName returns the length of the attribute name at the start of a path.
+func scanName(path string) int {
+	for i, c := range path {
+		isLetter := (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+		isNameChar := isLetter || (c >= '0' && c <= '9') || c == '_' || c == '-'
+		if (i == 0 && !isLetter && c != '$') || (i > 0 && !isNameChar) {
+			return i
+		}
+	}
+	return len(path)
+}
+
+// isMultiValued reports whether the attribute of the path is a multi-valued attribute.
+func (p attributePath) isMultiValued() bool {
+	if p.schema != "" {
+		return false
+	}
+	_, ok := multiValuedAttributes[strings.ToLower(p.attribute)]
+	return ok
+}
+
+// equals reports whether two paths select the same attribute.
+func (p attributePath) equals(other attributePath) bool {
+	if !strings.EqualFold(p.schema, other.schema) || !strings.EqualFold(p.attribute, other.attribute) ||
+		!strings.EqualFold(p.subAttribute, other.subAttribute) || len(p.filter) != len(other.filter) {
+		return false
+	}
+	for i := range p.filter {
+		if !p.filter[i].path.equals(other.filter[i].path) || !valuesEqual(p.filter[i].value, other.filter[i].value) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,184 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package scim
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type PathTestSuite struct {
+	suite.Suite
+}
+
+func TestPathTestSuite(t *testing.T) {
+	suite.Run(t, new(PathTestSuite))
+}
+
+func (suite *PathTestSuite) TestParsePath() {
+	testCases := []struct {
+		name     string
+		path     string
+		expected attributePath
+	}{
+		{
+			name:     "Simple attribute",
+			path:     "userName",
+			expected: attributePath{attribute: "userName"},
+		},
+		{
+			name:     "Sub-attribute",
+			path:     "name.givenName",
+			expected: attributePath{attribute: "name", subAttribute: "givenName"},
+		},
+		{
+			name: "Value filter with sub-attribute",
+			path: `emails[type eq "work"].value`,
+			expected: attributePath{
+				attribute:    "emails",
+				filter:       []condition{{path: attributePath{attribute: "type"}, value: "work"}},
+				subAttribute: "value",
+			},
+		},
+		{
+			name:     "Core schema prefix",
+			path:     SchemaUser + ":userName",
+			expected: attributePath{attribute: "userName"},
+		},
+		{
+			name:     "Extension attribute",
+			path:     SchemaEnterpriseUser + ":department",
+			expected: attributePath{schema: SchemaEnterpriseUser, attribute: "department"},
+		},
+		{
+			name:     "Extension",
+			path:     SchemaEnterpriseUser,
+			expected: attributePath{schema: SchemaEnterpriseUser},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			path, err := parsePath(tc.path)
+			suite.NoError(err)
+			suite.Equal(tc.expected, path)
+		})
+	}
+}
+
+func (suite *PathTestSuite) TestParsePath_Invalid() {
+	for _, path := range []string{"", "1name", "name.", "emails[type eq \"work\"", "emails[type gt 1]",
+		"urn:example:unknown:name", "name.givenName.extra"} {
+		_, err := parsePath(path)
+		suite.True(errors.Is(err, errInvalidPath), path)
+	}
+}
+
+func (suite *PathTestSuite) TestParseFilter() {
+	conditions, err := parseFilter(`userName eq "al\"ice" AND active eq true and age eq 30 and title eq null`)
+
+	suite.NoError(err)
+	suite.Equal([]condition{
+		{path: attributePath{attribute: "userName"}, value: `al"ice`},
+		{path: attributePath{attribute: "active"}, value: true},
+		{path: attributePath{attribute: "age"}, value: float64(30)},
+		{path: attributePath{attribute: "title"}, value: nil},
+	}, conditions)
+}
+
+func (suite *PathTestSuite) TestParseFilter_ValueFilterWithSpaces() {
+	conditions, err := parseFilter(`emails[type eq "work address"].value eq "a@example.com"`)
+
+	suite.NoError(err)
+	suite.Len(conditions, 1)
+	suite.Equal("a@example.com", conditions[0].value)
+	suite.Equal("work address", conditions[0].path.filter[0].value)
+}
+
+func (suite *PathTestSuite) TestParseFilter_Invalid() {
+	for _, filter := range []string{"", `userName co "a"`, `userName eq "a" or active eq true`,
+		`userName eq "a`, "userName eq abc", `userName eq "a" and`} {
+		_, err := parseFilter(filter)
+		suite.True(errors.Is(err, errInvalidFilter), filter)
+	}
+}
+
+func (suite *PathTestSuite) TestAttributePathEquals() {
+	path, _ := parsePath(`Emails[Type eq "WORK"].Value`)
+	other, _ := parsePath(`emails[type eq "work"].value`)
+	different, _ := parsePath(`emails[type eq "home"].value`)
+
+	suite.True(path.equals(other))
+	suite.False(path.equals(different))
+}
+
+func (suite *PathTestSuite) TestGetValue() {
+	resource := Resource{
+		"Name": map[string]interface{}{"givenName": "Alice"},
+		"emails": []interface{}{
+			map[string]interface{}{"value": "home@example.com", "type": "home"},
+			map[string]interface{}{"value": "work@example.com", "type": "work", "primary": true},
+		},
+		SchemaEnterpriseUser: map[string]interface{}{"department": "Sales"},
+	}
+
+	testCases := []struct {
+		path     string
+		expected interface{}
+	}{
+		{path: "name.givenName", expected: "Alice"},
+		{path: "emails", expected: "work@example.com"},
+		{path: `emails[type eq "home"].value`, expected: "home@example.com"},
+		{path: SchemaEnterpriseUser + ":department", expected: "Sales"},
+	}
+	for _, tc := range testCases {
+		path, _ := parsePath(tc.path)
+		value, ok := getValue(resource, path)
+		suite.True(ok, tc.path)
+		suite.Equal(tc.expected, value, tc.path)
+	}
+
+	path, _ := parsePath(`emails[type eq "other"].value`)
+	_, ok := getValue(resource, path)
+	suite.False(ok)
+}
+
+func (suite *PathTestSuite) TestSetValue() {
+	resource := Resource{}
+	for path, value := range map[string]interface{}{
+		"name.givenName":                     "Alice",
+		"emails":                             "alice@example.com",
+		`phoneNumbers[type eq "work"].value`: "+1234",
+		SchemaEnterpriseUser + ":department": "Sales",
+	} {
+		parsed, _ := parsePath(path)
+		setValue(resource, parsed, value)
+	}
+
+	suite.Equal(Resource{
+		"name":   map[string]interface{}{"givenName": "Alice"},
+		"emails": []interface{}{map[string]interface{}{"value": "alice@example.com", "primary": true}},
+		"phoneNumbers": []interface{}{
+			map[string]interface{}{"value": "+1234", "type": "work"},
+		},
+		SchemaEnterpriseUser: map[string]interface{}{"department": "Sales"},
+	}, resource)
+}
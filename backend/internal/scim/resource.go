@@ -0,0 +1,218 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package scim
+
+import "strings"
+
+// findKey returns the key of an attribute of a complex value, matching the name case-insensitively.
+func findKey(value map[string]interface{}, name string) (string, bool) {
+	if _, ok := value[name]; ok {
+		return name, true
+	}
+	for key := range value {
+		if strings.EqualFold(key, name) {
+			return key, true
+		}
+	}
+	return name, false
+}
+
+// getAttribute returns the value of an attribute of a complex value, matching the name case-insensitively.
+func getAttribute(value map[string]interface{}, name string) (interface{}, bool) {
+	key, ok := findKey(value, name)
+	if !ok {
+		return nil, false
+	}
+	return value[key], true
+}
+
+// setAttribute sets an attribute of a complex value, replacing an attribute whose name differs only in case.
+func setAttribute(value map[string]interface{}, name string, attributeValue interface{}) {
+	key, _ := findKey(value, name)
+	value[key] = attributeValue
+}
+
+// deleteAttribute removes an attribute of a complex value, matching the name case-insensitively.
+func deleteAttribute(value map[string]interface{}, name string) {
+	if key, ok := findKey(value, name); ok {
+		delete(value, key)
+	}
+}
+
+// getContainer returns the complex value holding the attributes of the schema of a path: the resource for
+// core attributes, or the extension object for extension attributes. The extension object is created when
+// create is set.
+func getContainer(resource Resource, path attributePath, create bool) map[string]interface{} {
+	if path.schema == "" {
+		return resource
+	}
+	if extension, ok := getAttribute(resource, path.schema); ok {
+		if object, ok := extension.(map[string]interface{}); ok {
+			return object
+		}
+	}
+	if !create {
+		return nil
+	}
+	extension := map[string]interface{}{}
+	setAttribute(resource, path.schema, extension)
+	return extension
+}
+
+// matchesFilter reports whether a value of a multi-valued attribute matches all conditions of a filter.
+func matchesFilter(value interface{}, filter []condition) bool {
+	object, ok := value.(map[string]interface{})
+	if !ok {
+		return false
+	}
+	for _, c := range filter {
+		attributeValue, _ := getAttribute(object, c.path.attribute)
+		if !valuesEqual(attributeValue, c.value) {
+			return false
+		}
+	}
+	return true
+}
+
+// valuesEqual compares two values decoded from JSON. Strings are compared case-insensitively.
+func valuesEqual(a, b interface{}) bool {
+	switch x := a.(type) {
+	case string:
+		y, ok := b.(string)
+		return ok && strings.EqualFold(x, y)
+	case bool:
+		y, ok := b.(bool)
+		return ok && x == y
+	case float64:
+		y, ok := b.(float64)
+		return ok && x == y
+	case nil:
+		return b == nil
+	}
+	return false
+}
+
+// selectPrimary returns the primary value of a multi-valued attribute, or its first value when none is
+// primary.
+func selectPrimary(values []interface{}) (interface{}, bool) {
+	if len(values) == 0 {
+		return nil, false
+	}
+	for _, value := range values {
+		if object, ok := value.(map[string]interface{}); ok {
+			if primary, _ := getAttribute(object, "primary"); primary == true {
+				return value, true
+			}
+		}
+	}
+	return values[0], true
+}
+
+// getValue returns the value a mapped path selects in a resource. A path to a multi-valued attribute
+// selects the value of its primary value, or of the first value matching its filter.
+func getValue(resource Resource, path attributePath) (interface{}, bool) {
+	container := getContainer(resource, path, false)
+	if container == nil {
+		return nil, false
+	}
+	value, ok := getAttribute(container, path.attribute)
+	if !ok || value == nil {
+		return nil, false
+	}
+
+	if values, isList := value.([]interface{}); isList {
+		var selected interface{}
+		found := false
+		if path.filter != nil {
+			for _, candidate := range values {
+				if matchesFilter(candidate, path.filter) {
+					selected, found = candidate, true
+					break
+				}
+			}
+		} else {
+			selected, found = selectPrimary(values)
+		}
+		if !found {
+			return nil, false
+		}
+		value = selected
+		if path.subAttribute == "" {
+			if object, isObject := value.(map[string]interface{}); isObject {
+				return getAttribute(object, attributeValue)
+			}
+			return value, true
+		}
+	}
+
+	if path.subAttribute == "" {
+		return value, true
+	}
+	object, ok := value.(map[string]interface{})
+	if !ok {
+		return nil, false
+	}
+	subValue, ok := getAttribute(object, path.subAttribute)
+	return subValue, ok && subValue != nil
+}
+
+// setValue sets the value a mapped path selects in a resource. A value of a multi-valued attribute is
+// added when no value matches the filter of the path, with the attributes the filter compares, and a path
+// to a multi-valued attribute without a filter adds a primary value.
+func setValue(resource Resource, path attributePath, value interface{}) {
+	container := getContainer(resource, path, true)
+	if path.filter == nil && !path.isMultiValued() {
+		if path.subAttribute == "" {
+			setAttribute(container, path.attribute, value)
+			return
+		}
+		object, _ := getAttribute(container, path.attribute)
+		complexValue, ok := object.(map[string]interface{})
+		if !ok {
+			complexValue = map[string]interface{}{}
+			setAttribute(container, path.attribute, complexValue)
+		}
+		setAttribute(complexValue, path.subAttribute, value)
+		return
+	}
+
+	existing, _ := getAttribute(container, path.attribute)
+	values, _ := existing.([]interface{})
+	subAttribute := path.subAttribute
+	if subAttribute == "" {
+		subAttribute = attributeValue
+	}
+	if path.filter != nil {
+		for _, candidate := range values {
+			if matchesFilter(candidate, path.filter) {
+				setAttribute(candidate.(map[string]interface{}), subAttribute, value)
+				return
+			}
+		}
+	}
+
+	element := map[string]interface{}{subAttribute: value}
+	for _, c := range path.filter {
+		element[c.path.attribute] = c.value
+	}
+	if path.filter == nil {
+		element["primary"] = true
+	}
+	setAttribute(container, path.attribute, append(values, element))
+}
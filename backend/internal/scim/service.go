@@ -0,0 +1,534 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package scim
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math"
+	"strings"
+
+	"github.com/thunder-id/thunderid/internal/entitytype"
+	"github.com/thunder-id/thunderid/internal/group"
+	serverconst "github.com/thunder-id/thunderid/internal/system/constants"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/internal/user"
+)
+
+// userReadOnlyAttributes lists the user attributes patch requests cannot modify.
+var userReadOnlyAttributes = []string{attributeID, attributeMeta, attributeGroups}
+
+// groupReadOnlyAttributes lists the group attributes patch requests cannot modify.
+var groupReadOnlyAttributes = []string{attributeID, attributeMeta}
+
+// SCIMServiceInterface defines the SCIM operations on users and groups.
+type SCIMServiceInterface interface {
+	ListUsers(ctx context.Context, request ListRequest) (*ListResponse, *serviceerror.ServiceError)
+	GetUser(ctx context.Context, userID string) (Resource, *serviceerror.ServiceError)
+	CreateUser(ctx context.Context, resource Resource) (Resource, *serviceerror.ServiceError)
+	ReplaceUser(ctx context.Context, userID string, resource Resource) (Resource, *serviceerror.ServiceError)
+	PatchUser(ctx context.Context, userID string, request PatchRequest) (Resource, *serviceerror.ServiceError)
+	DeleteUser(ctx context.Context, userID string) *serviceerror.ServiceError
+	ListGroups(ctx context.Context, request ListRequest) (*ListResponse, *serviceerror.ServiceError)
+	GetGroup(ctx context.Context, groupID string) (Resource, *serviceerror.ServiceError)
+	CreateGroup(ctx context.Context, resource Resource) (Resource, *serviceerror.ServiceError)
+	ReplaceGroup(ctx context.Context, groupID string, resource Resource) (Resource, *serviceerror.ServiceError)
+	PatchGroup(ctx context.Context, groupID string, request PatchRequest) (Resource, *serviceerror.ServiceError)
+	DeleteGroup(ctx context.Context, groupID string) *serviceerror.ServiceError
+	ProcessBulk(ctx context.Context, request BulkRequest) (*BulkResponse, *serviceerror.ServiceError)
+}
+
+// scimService is the default implementation of SCIMServiceInterface.
+type scimService struct {
+	userService       user.UserServiceInterface
+	groupService      group.GroupServiceInterface
+	entityTypeService entitytype.EntityTypeServiceInterface
+	userType          string
+	mappings          []attributeMapping
+	baseURL           string
+}
+
+// newSCIMService creates a new instance of the SCIM service. Users and groups are provisioned with the user
+// type, and SCIM attributes are mapped to user attributes with the mappings that apply to the user type.
+func newSCIMService(
+	userService user.UserServiceInterface,
+	groupService group.GroupServiceInterface,
+	entityTypeService entitytype.EntityTypeServiceInterface,
+	userType string,
+	mappings []attributeMapping,
+	baseURL string,
+) SCIMServiceInterface {
+	return &scimService{
+		userService:       userService,
+		groupService:      groupService,
+		entityTypeService: entityTypeService,
+		userType:          userType,
+		mappings:          mappings,
+		baseURL:           strings.TrimSuffix(baseURL, "/"),
+	}
+}
+
+// ListUsers returns a page of users, optionally filtered by mapped attributes.
+func (s *scimService) ListUsers(ctx context.Context, request ListRequest) (
+	*ListResponse, *serviceerror.ServiceError) {
+	mappings, svcErr := s.getMappings(ctx, s.userType)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	filters, svcErr := buildUserFilters(request.Filter, mappings)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+
+	startIndex, count := normalizePagination(request)
+	users, svcErr := s.userService.GetUserList(ctx, max(count, 1), startIndex-1, filters, false)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+
+	resources := make([]Resource, 0, len(users.Users))
+	if count > 0 {
+		mappingsByType := map[string][]attributeMapping{s.userType: mappings}
+		for i := range users.Users {
+			u := &users.Users[i]
+			typeMappings, ok := mappingsByType[u.Type]
+			if !ok {
+				if typeMappings, svcErr = s.getMappings(ctx, u.Type); svcErr != nil {
+					return nil, svcErr
+				}
+				mappingsByType[u.Type] = typeMappings
+			}
+			resources = append(resources, toUserResource(u, typeMappings, s.baseURL))
+		}
+	}
+	return newListResponse(users.TotalResults, startIndex, resources), nil
+}
+
+// GetUser returns a user with the groups it is a member of.
+func (s *scimService) GetUser(ctx context.Context, userID string) (Resource, *serviceerror.ServiceError) {
+	u, svcErr := s.userService.GetUser(ctx, userID, false)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	return s.buildUserResource(ctx, u)
+}
+
+// CreateUser creates a user of the configured user type in the organization unit of the user type.
+func (s *scimService) CreateUser(ctx context.Context, resource Resource) (Resource, *serviceerror.ServiceError) {
+	userType, svcErr := s.getUserType(ctx)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	mappings, svcErr := s.getMappings(ctx, userType.Name)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+
+	attributes, err := json.Marshal(toUserAttributes(resource, mappings))
+	if err != nil {
+		return nil, &ErrorInvalidValue
+	}
+	created, svcErr := s.userService.CreateUser(ctx, &user.User{
+		OUID:       userType.OUID,
+		Type:       userType.Name,
+		Attributes: attributes,
+	})
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	return toUserResource(created, mappings, s.baseURL), nil
+}
+
+// ReplaceUser replaces the mapped attributes of a user with the attributes of a SCIM resource.
+func (s *scimService) ReplaceUser(ctx context.Context, userID string, resource Resource) (
+	Resource, *serviceerror.ServiceError) {
+	existing, svcErr := s.userService.GetUser(ctx, userID, false)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	return s.updateUser(ctx, existing, resource)
+}
+
+// PatchUser applies the operations of a patch request to a user.
+func (s *scimService) PatchUser(ctx context.Context, userID string, request PatchRequest) (
+	Resource, *serviceerror.ServiceError) {
+	if svcErr := validatePatchRequest(request); svcErr != nil {
+		return nil, svcErr
+	}
+	existing, svcErr := s.userService.GetUser(ctx, userID, false)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	mappings, svcErr := s.getMappings(ctx, existing.Type)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+
+	resource := toUserResource(existing, mappings, s.baseURL)
+	if err := applyPatch(resource, request.Operations, userReadOnlyAttributes); err != nil {
+		return nil, toServiceError(err)
+	}
+	return s.updateUser(ctx, existing, resource)
+}
+
+// DeleteUser deletes a user.
+func (s *scimService) DeleteUser(ctx context.Context, userID string) *serviceerror.ServiceError {
+	return s.userService.DeleteUser(ctx, userID)
+}
+
+// ListGroups returns a page of groups, optionally filtered by display name. Members are not returned in
+// lists.
+func (s *scimService) ListGroups(ctx context.Context, request ListRequest) (
+	*ListResponse, *serviceerror.ServiceError) {
+	startIndex, count := normalizePagination(request)
+	if request.Filter != "" {
+		displayName, svcErr := parseDisplayNameFilter(request.Filter)
+		if svcErr != nil {
+			return nil, svcErr
+		}
+		return s.findGroups(ctx, displayName, startIndex, count)
+	}
+
+	groups, svcErr := s.groupService.GetGroupList(ctx, max(count, 1), startIndex-1, false)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	resources := make([]Resource, 0, len(groups.Groups))
+	if count > 0 {
+		for _, basic := range groups.Groups {
+			resources = append(resources, toGroupResource(&group.Group{ID: basic.ID, Name: basic.Name}, s.baseURL))
+		}
+	}
+	return newListResponse(groups.TotalResults, startIndex, resources), nil
+}
+
+// GetGroup returns a group with its members.
+func (s *scimService) GetGroup(ctx context.Context, groupID string) (Resource, *serviceerror.ServiceError) {
+	g, svcErr := s.groupService.GetGroup(ctx, groupID, true)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	return toGroupResource(g, s.baseURL), nil
+}
+
+// CreateGroup creates a group in the organization unit of the configured user type.
+func (s *scimService) CreateGroup(ctx context.Context, resource Resource) (Resource, *serviceerror.ServiceError) {
+	displayName, ok := getDisplayName(resource)
+	if !ok {
+		return nil, &ErrorMissingDisplayName
+	}
+	members, err := toGroupMembers(resource)
+	if err != nil {
+		return nil, toServiceError(err)
+	}
+	userType, svcErr := s.getUserType(ctx)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+
+	created, svcErr := s.groupService.CreateGroup(ctx, group.CreateGroupRequest{
+		Name:    displayName,
+		OUID:    userType.OUID,
+		Members: members,
+	})
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	return toGroupResource(created, s.baseURL), nil
+}
+
+// ReplaceGroup replaces the display name and the user and group members of a group.
+func (s *scimService) ReplaceGroup(ctx context.Context, groupID string, resource Resource) (
+	Resource, *serviceerror.ServiceError) {
+	existing, svcErr := s.groupService.GetGroup(ctx, groupID, false)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	return s.updateGroup(ctx, existing, resource)
+}
+
+// PatchGroup applies the operations of a patch request to a group.
+func (s *scimService) PatchGroup(ctx context.Context, groupID string, request PatchRequest) (
+	Resource, *serviceerror.ServiceError) {
+	if svcErr := validatePatchRequest(request); svcErr != nil {
+		return nil, svcErr
+	}
+	existing, svcErr := s.groupService.GetGroup(ctx, groupID, false)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+
+	resource := toGroupResource(existing, s.baseURL)
+	if err := applyPatch(resource, request.Operations, groupReadOnlyAttributes); err != nil {
+		return nil, toServiceError(err)
+	}
+	return s.updateGroup(ctx, existing, resource)
+}
+
+// DeleteGroup deletes a group.
+func (s *scimService) DeleteGroup(ctx context.Context, groupID string) *serviceerror.ServiceError {
+	return s.groupService.DeleteGroup(ctx, groupID)
+}
+
+// updateUser updates a user with the attributes mapped from a SCIM resource.
+func (s *scimService) updateUser(ctx context.Context, existing *user.User, resource Resource) (
+	Resource, *serviceerror.ServiceError) {
+	mappings, svcErr := s.getMappings(ctx, existing.Type)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	attributes, err := mergeUserAttributes(existing.Attributes, toUserAttributes(resource, mappings), mappings)
+	if err != nil {
+		return nil, &ErrorInvalidValue
+	}
+
+	updated, svcErr := s.userService.UpdateUser(ctx, existing.ID, &user.User{
+		OUID:       existing.OUID,
+		Type:       existing.Type,
+		Attributes: attributes,
+	})
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	return s.buildUserResource(ctx, updated)
+}
+
+// buildUserResource maps a user to a SCIM user resource with the groups the user is a member of.
+func (s *scimService) buildUserResource(ctx context.Context, u *user.User) (Resource, *serviceerror.ServiceError) {
+	mappings, svcErr := s.getMappings(ctx, u.Type)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	resource := toUserResource(u, mappings, s.baseURL)
+
+	groups := make([]interface{}, 0)
+	for offset := 0; ; offset += serverconst.MaxPageSize {
+		page, svcErr := s.userService.GetUserGroups(ctx, u.ID, serverconst.MaxPageSize, offset)
+		if svcErr != nil {
+			return nil, svcErr
+		}
+		for _, g := range page.Groups {
+			groups = append(groups, map[string]interface{}{
+				attributeValue:   g.ID,
+				attributeDisplay: g.Name,
+				attributeRef:     s.baseURL + groupsPath + "/" + g.ID,
+			})
+		}
+		if len(page.Groups) < serverconst.MaxPageSize || offset+len(page.Groups) >= page.TotalResults {
+			break
+		}
+	}
+	if len(groups) > 0 {
+		resource[attributeGroups] = groups
+	}
+	return resource, nil
+}
+
+// updateGroup updates the display name of a group and adds and removes its user and group members to
+// match a SCIM resource. Members of other types are kept.
+func (s *scimService) updateGroup(ctx context.Context, existing *group.Group, resource Resource) (
+	Resource, *serviceerror.ServiceError) {
+	displayName, ok := getDisplayName(resource)
+	if !ok {
+		return nil, &ErrorMissingDisplayName
+	}
+	members, err := toGroupMembers(resource)
+	if err != nil {
+		return nil, toServiceError(err)
+	}
+
+	if displayName != existing.Name {
+		if _, svcErr := s.groupService.UpdateGroup(ctx, existing.ID, group.UpdateGroupRequest{
+			Name:        displayName,
+			Description: existing.Description,
+			OUID:        existing.OUID,
+		}); svcErr != nil {
+			return nil, svcErr
+		}
+	}
+
+	current := make(map[string]group.Member, len(existing.Members))
+	for _, member := range existing.Members {
+		if member.Type == group.MemberTypeUser || member.Type == group.MemberTypeGroup {
+			current[member.ID] = member
+		}
+	}
+	added := make([]group.Member, 0)
+	for _, member := range members {
+		if _, ok := current[member.ID]; ok {
+			delete(current, member.ID)
+			continue
+		}
+		added = append(added, member)
+	}
+	removed := make([]group.Member, 0, len(current))
+	for _, member := range existing.Members {
+		if _, ok := current[member.ID]; ok {
+			removed = append(removed, member)
+		}
+	}
+
+	if len(added) > 0 {
+		if _, svcErr := s.groupService.AddGroupMembers(ctx, existing.ID, added); svcErr != nil {
+			return nil, svcErr
+		}
+	}
+	if len(removed) > 0 {
+		if _, svcErr := s.groupService.RemoveGroupMembers(ctx, existing.ID, removed); svcErr != nil {
+			return nil, svcErr
+		}
+	}
+	return s.GetGroup(ctx, existing.ID)
+}
+
+// findGroups returns a page of the groups with a display name. Group names are unique within an
+// organization unit only, so the groups are scanned page by page.
+func (s *scimService) findGroups(ctx context.Context, displayName string, startIndex, count int) (
+	*ListResponse, *serviceerror.ServiceError) {
+	matches := make([]Resource, 0, 1)
+	for page := 0; page < maxGroupScanPages; page++ {
+		groups, svcErr := s.groupService.GetGroupList(ctx, serverconst.MaxPageSize,
+			page*serverconst.MaxPageSize, false)
+		if svcErr != nil {
+			return nil, svcErr
+		}
+		for _, basic := range groups.Groups {
+			if strings.EqualFold(basic.Name, displayName) {
+				matches = append(matches, toGroupResource(&group.Group{ID: basic.ID, Name: basic.Name}, s.baseURL))
+			}
+		}
+		if (page+1)*serverconst.MaxPageSize >= groups.TotalResults {
+			break
+		}
+	}
+
+	total := len(matches)
+	from := min(startIndex-1, total)
+	to := min(from+count, total)
+	return newListResponse(total, startIndex, matches[from:to]), nil
+}
+
+// getUserType returns the user type provisioned users are created with.
+func (s *scimService) getUserType(ctx context.Context) (*entitytype.EntityType, *serviceerror.ServiceError) {
+	userType, svcErr := s.entityTypeService.GetEntityTypeByName(ctx, entitytype.TypeCategoryUser, s.userType)
+	if svcErr != nil {
+		logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName))
+		logger.Error("Failed to resolve the SCIM user type", log.String("userType", s.userType),
+			log.Any("error", svcErr))
+		return nil, &serviceerror.InternalServerError
+	}
+	return userType, nil
+}
+
+// getMappings returns the attribute mappings that apply to the attributes of a user type.
+func (s *scimService) getMappings(ctx context.Context, userType string) (
+	[]attributeMapping, *serviceerror.ServiceError) {
+	attributes, svcErr := s.entityTypeService.GetAttributes(ctx, entitytype.TypeCategoryUser, userType,
+		true, true, false)
+	if svcErr != nil {
+		logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName))
+		logger.Error("Failed to resolve the attributes of the user type", log.String("userType", userType),
+			log.Any("error", svcErr))
+		return nil, &serviceerror.InternalServerError
+	}
+	return filterMappings(s.mappings, attributes), nil
+}
+
+// buildUserFilters maps the comparisons of a filter to user attribute filters.
+func buildUserFilters(filter string, mappings []attributeMapping) (
+	map[string]interface{}, *serviceerror.ServiceError) {
+	filters := make(map[string]interface{})
+	if strings.TrimSpace(filter) == "" {
+		return filters, nil
+	}
+	conditions, err := parseFilter(filter)
+	if err != nil {
+		return nil, &ErrorInvalidFilter
+	}
+	for _, c := range conditions {
+		mapping, ok := findMapping(mappings, c.path)
+		if !ok || mapping.credential || c.value == nil {
+			return nil, &ErrorInvalidFilter
+		}
+		value := c.value
+		if number, isNumber := value.(float64); isNumber && number == math.Trunc(number) {
+			value = int64(number)
+		}
+		filters[mapping.attribute] = value
+	}
+	return filters, nil
+}
+
+// parseDisplayNameFilter returns the display name of a group filter. Groups can only be filtered by their
+// display name.
+func parseDisplayNameFilter(filter string) (string, *serviceerror.ServiceError) {
+	conditions, err := parseFilter(filter)
+	if err != nil || len(conditions) != 1 {
+		return "", &ErrorInvalidFilter
+	}
+	c := conditions[0]
+	displayName, ok := c.value.(string)
+	if !ok || !c.path.equals(attributePath{attribute: attributeDisplayName}) {
+		return "", &ErrorInvalidFilter
+	}
+	return displayName, nil
+}
+
+// normalizePagination returns the one-based start index and the number of resources of a list request.
+// A start index below one is treated as one, and the count is capped at the maximum page size.
+func normalizePagination(request ListRequest) (int, int) {
+	return max(request.StartIndex, 1), min(max(request.Count, 0), serverconst.MaxPageSize)
+}
+
+// newListResponse creates a list response.
+func newListResponse(totalResults, startIndex int, resources []Resource) *ListResponse {
+	return &ListResponse{
+		Schemas:      []string{SchemaListResponse},
+		TotalResults: totalResults,
+		StartIndex:   startIndex,
+		ItemsPerPage: len(resources),
+		Resources:    resources,
+	}
+}
+
+// validatePatchRequest checks the number of operations of a patch request.
+func validatePatchRequest(request PatchRequest) *serviceerror.ServiceError {
+	if len(request.Operations) == 0 || len(request.Operations) > maxPatchOperations {
+		return &ErrorTooManyOperations
+	}
+	return nil
+}
+
+// toServiceError maps an error of a filter, a path or a patch operation to a service error.
+func toServiceError(err error) *serviceerror.ServiceError {
+	switch {
+	case errors.Is(err, errInvalidFilter):
+		return &ErrorInvalidFilter
+	case errors.Is(err, errInvalidPath):
+		return &ErrorInvalidPath
+	case errors.Is(err, errNoTarget):
+		return &ErrorNoTarget
+	case errors.Is(err, errMutability):
+		return &ErrorMutability
+	default:
+		return &ErrorInvalidValue
+	}
+}
@@ -0,0 +1,329 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package scim
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/entity"
+	"github.com/thunder-id/thunderid/internal/entitytype"
+	"github.com/thunder-id/thunderid/internal/group"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/user"
+	"github.com/thunder-id/thunderid/tests/mocks/entitytypemock"
+	"github.com/thunder-id/thunderid/tests/mocks/groupmock"
+	"github.com/thunder-id/thunderid/tests/mocks/usermock"
+)
+
+const (
+	testUserType = "Person"
+	testOUID     = "ou-1"
+	testUserID   = "user-1"
+	testGroupID  = "group-1"
+)
+
+type SCIMServiceTestSuite struct {
+	suite.Suite
+	mockUserService       *usermock.UserServiceInterfaceMock
+	mockGroupService      *groupmock.GroupServiceInterfaceMock
+	mockEntityTypeService *entitytypemock.EntityTypeServiceInterfaceMock
+	service               SCIMServiceInterface
+	ctx                   context.Context
+}
+
+func TestSCIMServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(SCIMServiceTestSuite))
+}
+
+func (suite *SCIMServiceTestSuite) SetupTest() {
+	suite.mockUserService = usermock.NewUserServiceInterfaceMock(suite.T())
+	suite.mockGroupService = groupmock.NewGroupServiceInterfaceMock(suite.T())
+	suite.mockEntityTypeService = entitytypemock.NewEntityTypeServiceInterfaceMock(suite.T())
+	mappings, err := buildAttributeMappings(nil)
+	suite.Require().NoError(err)
+	suite.service = newSCIMService(suite.mockUserService, suite.mockGroupService, suite.mockEntityTypeService,
+		testUserType, mappings, testBaseURL+"/")
+	suite.ctx = context.Background()
+
+	suite.mockEntityTypeService.On("GetAttributes", mock.Anything, entitytype.TypeCategoryUser, testUserType,
+		true, true, false).Return([]entitytype.AttributeInfo{
+		{Attribute: "username"},
+		{Attribute: "email"},
+		{Attribute: "password", Credential: true},
+	}, nil).Maybe()
+	suite.mockEntityTypeService.On("GetEntityTypeByName", mock.Anything, entitytype.TypeCategoryUser,
+		testUserType).Return(&entitytype.EntityType{Name: testUserType, OUID: testOUID}, nil).Maybe()
+}
+
+func (suite *SCIMServiceTestSuite) newUser(attributes string) *user.User {
+	return &user.User{ID: testUserID, OUID: testOUID, Type: testUserType, Attributes: json.RawMessage(attributes)}
+}
+
+func (suite *SCIMServiceTestSuite) expectUserGroups(groups ...entity.EntityGroup) {
+	suite.mockUserService.On("GetUserGroups", mock.Anything, testUserID, 100, 0).
+		Return(&user.UserGroupListResponse{TotalResults: len(groups), Groups: groups}, nil).Once()
+}
+
+func (suite *SCIMServiceTestSuite) TestListUsers() {
+	suite.mockUserService.On("GetUserList", mock.Anything, 10, 5,
+		map[string]interface{}{"username": "alice", "email": "alice@example.com"}, false).
+		Return(&user.UserListResponse{
+			TotalResults: 6,
+			Users:        []user.User{*suite.newUser(`{"username": "alice", "password": "hash"}`)},
+		}, nil).Once()
+
+	response, svcErr := suite.service.ListUsers(suite.ctx, ListRequest{
+		Filter:     `userName eq "alice" and emails.value eq "alice@example.com"`,
+		StartIndex: 6,
+		Count:      10,
+	})
+
+	suite.Nil(svcErr)
+	suite.Equal([]string{SchemaListResponse}, response.Schemas)
+	suite.Equal(6, response.TotalResults)
+	suite.Equal(6, response.StartIndex)
+	suite.Equal(1, response.ItemsPerPage)
+	suite.Equal("alice", response.Resources[0]["userName"])
+	suite.NotContains(response.Resources[0], "password")
+}
+
+func (suite *SCIMServiceTestSuite) TestListUsers_CountZero() {
+	suite.mockUserService.On("GetUserList", mock.Anything, 1, 0, map[string]interface{}{}, false).
+		Return(&user.UserListResponse{TotalResults: 3, Users: []user.User{*suite.newUser(`{}`)}}, nil).Once()
+
+	response, svcErr := suite.service.ListUsers(suite.ctx, ListRequest{StartIndex: 0, Count: 0})
+
+	suite.Nil(svcErr)
+	suite.Equal(3, response.TotalResults)
+	suite.Equal(1, response.StartIndex)
+	suite.Empty(response.Resources)
+}
+
+func (suite *SCIMServiceTestSuite) TestListUsers_InvalidFilter() {
+	for _, filter := range []string{`userName co "a"`, `title eq "x"`, `password eq "x"`} {
+		_, svcErr := suite.service.ListUsers(suite.ctx, ListRequest{Filter: filter, StartIndex: 1, Count: 10})
+		suite.Equal(&ErrorInvalidFilter, svcErr, filter)
+	}
+}
+
+func (suite *SCIMServiceTestSuite) TestGetUser() {
+	suite.mockUserService.On("GetUser", mock.Anything, testUserID, false).
+		Return(suite.newUser(`{"username": "alice"}`), nil).Once()
+	suite.expectUserGroups(entity.EntityGroup{ID: testGroupID, Name: "Admins"})
+
+	resource, svcErr := suite.service.GetUser(suite.ctx, testUserID)
+
+	suite.Nil(svcErr)
+	suite.Equal("alice", resource["userName"])
+	suite.Equal([]interface{}{map[string]interface{}{
+		"value": testGroupID, "display": "Admins", "$ref": testBaseURL + "/scim/v2/Groups/" + testGroupID,
+	}}, resource["groups"])
+}
+
+func (suite *SCIMServiceTestSuite) TestGetUser_NotFound() {
+	suite.mockUserService.On("GetUser", mock.Anything, testUserID, false).
+		Return(nil, &user.ErrorUserNotFound).Once()
+
+	_, svcErr := suite.service.GetUser(suite.ctx, testUserID)
+
+	suite.Equal(&user.ErrorUserNotFound, svcErr)
+}
+
+func (suite *SCIMServiceTestSuite) TestCreateUser() {
+	suite.mockUserService.On("CreateUser", mock.Anything, mock.MatchedBy(func(u *user.User) bool {
+		return u.OUID == testOUID && u.Type == testUserType &&
+			string(u.Attributes) == `{"email":"alice@example.com","password":"secret","username":"alice"}`
+	})).Return(suite.newUser(`{"username": "alice", "email": "alice@example.com"}`), nil).Once()
+
+	resource, svcErr := suite.service.CreateUser(suite.ctx, Resource{
+		"userName": "alice",
+		"password": "secret",
+		"emails":   []interface{}{map[string]interface{}{"value": "alice@example.com"}},
+	})
+
+	suite.Nil(svcErr)
+	suite.Equal(testUserID, resource["id"])
+	suite.NotContains(resource, "password")
+}
+
+func (suite *SCIMServiceTestSuite) TestCreateUser_UserTypeNotFound() {
+	mockEntityTypeService := entitytypemock.NewEntityTypeServiceInterfaceMock(suite.T())
+	mockEntityTypeService.On("GetEntityTypeByName", mock.Anything, entitytype.TypeCategoryUser, testUserType).
+		Return(nil, &serviceerror.ServiceError{Type: serviceerror.ClientErrorType, Code: "ETY-1001"}).Once()
+	service := newSCIMService(suite.mockUserService, suite.mockGroupService, mockEntityTypeService,
+		testUserType, nil, testBaseURL)
+
+	_, svcErr := service.CreateUser(suite.ctx, Resource{"userName": "alice"})
+
+	suite.Equal(&serviceerror.InternalServerError, svcErr)
+}
+
+func (suite *SCIMServiceTestSuite) TestPatchUser() {
+	suite.mockUserService.On("GetUser", mock.Anything, testUserID, false).
+		Return(suite.newUser(`{"username": "alice", "email": "alice@example.com", "internal": 1}`), nil).Once()
+	suite.mockUserService.On("UpdateUser", mock.Anything, testUserID, mock.MatchedBy(func(u *user.User) bool {
+		return u.OUID == testOUID && u.Type == testUserType &&
+			string(u.Attributes) == `{"email":"alice@example.com","internal":1,"username":"alice2"}`
+	})).Return(suite.newUser(`{"username": "alice2", "email": "alice@example.com", "internal": 1}`), nil).Once()
+	suite.expectUserGroups()
+
+	resource, svcErr := suite.service.PatchUser(suite.ctx, testUserID, PatchRequest{
+		Schemas:    []string{SchemaPatchOp},
+		Operations: []PatchOperation{{Op: PatchOperationReplace, Path: "userName", Value: json.RawMessage(`"alice2"`)}},
+	})
+
+	suite.Nil(svcErr)
+	suite.Equal("alice2", resource["userName"])
+}
+
+func (suite *SCIMServiceTestSuite) TestPatchUser_InvalidOperations() {
+	_, svcErr := suite.service.PatchUser(suite.ctx, testUserID, PatchRequest{})
+	suite.Equal(&ErrorTooManyOperations, svcErr)
+
+	suite.mockUserService.On("GetUser", mock.Anything, testUserID, false).
+		Return(suite.newUser(`{"username": "alice"}`), nil).Once()
+	_, svcErr = suite.service.PatchUser(suite.ctx, testUserID, PatchRequest{
+		Operations: []PatchOperation{{Op: PatchOperationRemove, Path: "groups"}},
+	})
+	suite.Equal(&ErrorMutability, svcErr)
+}
+
+func (suite *SCIMServiceTestSuite) TestDeleteUser() {
+	suite.mockUserService.On("DeleteUser", mock.Anything, testUserID).Return(nil).Once()
+
+	suite.Nil(suite.service.DeleteUser(suite.ctx, testUserID))
+}
+
+func (suite *SCIMServiceTestSuite) TestListGroups() {
+	suite.mockGroupService.On("GetGroupList", mock.Anything, 2, 1, false).Return(&group.GroupListResponse{
+		TotalResults: 3,
+		Groups:       []group.GroupBasic{{ID: testGroupID, Name: "Admins"}, {ID: "group-2", Name: "Users"}},
+	}, nil).Once()
+
+	response, svcErr := suite.service.ListGroups(suite.ctx, ListRequest{StartIndex: 2, Count: 2})
+
+	suite.Nil(svcErr)
+	suite.Equal(3, response.TotalResults)
+	suite.Equal(2, response.ItemsPerPage)
+	suite.Equal("Admins", response.Resources[0]["displayName"])
+	suite.NotContains(response.Resources[0], "members")
+}
+
+func (suite *SCIMServiceTestSuite) TestListGroups_DisplayNameFilter() {
+	suite.mockGroupService.On("GetGroupList", mock.Anything, 100, 0, false).Return(&group.GroupListResponse{
+		TotalResults: 3,
+		Groups: []group.GroupBasic{
+			{ID: testGroupID, Name: "Admins"}, {ID: "group-2", Name: "Users"}, {ID: "group-3", Name: "admins"},
+		},
+	}, nil).Once()
+
+	response, svcErr := suite.service.ListGroups(suite.ctx, ListRequest{
+		Filter: `displayName eq "Admins"`, StartIndex: 2, Count: 10,
+	})
+
+	suite.Nil(svcErr)
+	suite.Equal(2, response.TotalResults)
+	suite.Equal(1, response.ItemsPerPage)
+	suite.Equal("group-3", response.Resources[0]["id"])
+}
+
+func (suite *SCIMServiceTestSuite) TestListGroups_InvalidFilter() {
+	for _, filter := range []string{`id eq "x"`, `displayName eq true`, `displayName eq "a" and displayName eq "b"`} {
+		_, svcErr := suite.service.ListGroups(suite.ctx, ListRequest{Filter: filter, StartIndex: 1, Count: 10})
+		suite.Equal(&ErrorInvalidFilter, svcErr, filter)
+	}
+}
+
+func (suite *SCIMServiceTestSuite) TestCreateGroup() {
+	members := []group.Member{{ID: testUserID, Type: group.MemberTypeUser}}
+	suite.mockGroupService.On("CreateGroup", mock.Anything, group.CreateGroupRequest{
+		Name: "Admins", OUID: testOUID, Members: members,
+	}).Return(&group.Group{ID: testGroupID, Name: "Admins", OUID: testOUID, Members: members}, nil).Once()
+
+	resource, svcErr := suite.service.CreateGroup(suite.ctx, Resource{
+		"displayName": "Admins",
+		"members":     []interface{}{map[string]interface{}{"value": testUserID}},
+	})
+
+	suite.Nil(svcErr)
+	suite.Equal(testGroupID, resource["id"])
+	suite.Len(resource["members"], 1)
+}
+
+func (suite *SCIMServiceTestSuite) TestCreateGroup_MissingDisplayName() {
+	_, svcErr := suite.service.CreateGroup(suite.ctx, Resource{"displayName": " "})
+
+	suite.Equal(&ErrorMissingDisplayName, svcErr)
+}
+
+func (suite *SCIMServiceTestSuite) TestPatchGroup() {
+	existing := &group.Group{
+		ID:   testGroupID,
+		Name: "Admins",
+		OUID: testOUID,
+		Members: []group.Member{
+			{ID: testUserID, Type: group.MemberTypeUser},
+			{ID: "app-1", Type: group.MemberType("app")},
+		},
+	}
+	suite.mockGroupService.On("GetGroup", mock.Anything, testGroupID, false).Return(existing, nil).Once()
+	suite.mockGroupService.On("UpdateGroup", mock.Anything, testGroupID, group.UpdateGroupRequest{
+		Name: "Operators", OUID: testOUID,
+	}).Return(&group.Group{ID: testGroupID, Name: "Operators"}, nil).Once()
+	suite.mockGroupService.On("AddGroupMembers", mock.Anything, testGroupID,
+		[]group.Member{{ID: "user-2", Type: group.MemberTypeUser}}).Return(existing, nil).Once()
+	suite.mockGroupService.On("RemoveGroupMembers", mock.Anything, testGroupID,
+		[]group.Member{{ID: testUserID, Type: group.MemberTypeUser}}).Return(existing, nil).Once()
+	suite.mockGroupService.On("GetGroup", mock.Anything, testGroupID, true).Return(&group.Group{
+		ID: testGroupID, Name: "Operators", Members: []group.Member{{ID: "user-2", Type: group.MemberTypeUser}},
+	}, nil).Once()
+
+	resource, svcErr := suite.service.PatchGroup(suite.ctx, testGroupID, PatchRequest{
+		Operations: []PatchOperation{
+			{Op: PatchOperationReplace, Path: "displayName", Value: json.RawMessage(`"Operators"`)},
+			{Op: PatchOperationAdd, Path: "members", Value: json.RawMessage(`[{"value": "user-2"}]`)},
+			{Op: PatchOperationRemove, Path: `members[value eq "user-1"]`},
+		},
+	})
+
+	suite.Nil(svcErr)
+	suite.Equal("Operators", resource["displayName"])
+}
+
+func (suite *SCIMServiceTestSuite) TestPatchGroup_NotFound() {
+	suite.mockGroupService.On("GetGroup", mock.Anything, testGroupID, false).
+		Return(nil, &group.ErrorGroupNotFound).Once()
+
+	_, svcErr := suite.service.PatchGroup(suite.ctx, testGroupID, PatchRequest{
+		Operations: []PatchOperation{{Op: PatchOperationRemove, Path: "members"}},
+	})
+
+	suite.Equal(&group.ErrorGroupNotFound, svcErr)
+}
+
+func (suite *SCIMServiceTestSuite) TestDeleteGroup() {
+	suite.mockGroupService.On("DeleteGroup", mock.Anything, testGroupID).Return(nil).Once()
+
+	suite.Nil(suite.service.DeleteGroup(suite.ctx, testGroupID))
+}
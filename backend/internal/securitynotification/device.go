@@ -0,0 +1,81 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package securitynotification
+
+import "strings"
+
+// userAgentToken maps a token found in a user agent to the name it is described with.
+type userAgentToken struct {
+	token string
+	name  string
+}
+
+// browserTokens lists the browser tokens in the order they are matched. Browsers built on Chromium also
+// carry the Chrome and Safari tokens, so they are matched first.
+var browserTokens = []userAgentToken{
+	{token: "Edg/", name: "Edge"},
+	{token: "OPR/", name: "Opera"},
+	{token: "SamsungBrowser/", name: "Samsung Internet"},
+	{token: "Firefox/", name: "Firefox"},
+	{token: "FxiOS/", name: "Firefox"},
+	{token: "CriOS/", name: "Chrome"},
+	{token: "Chrome/", name: "Chrome"},
+	{token: "Safari/", name: "Safari"},
+}
+
+// osTokens lists the operating system tokens in the order they are matched. Android and iOS user agents
+// also carry the Linux and Mac OS X tokens, so they are matched first.
+var osTokens = []userAgentToken{
+	{token: "Android", name: "Android"},
+	{token: "iPhone", name: "iOS"},
+	{token: "iPad", name: "iPadOS"},
+	{token: "Windows", name: "Windows"},
+	{token: "CrOS", name: "ChromeOS"},
+	{token: "Mac OS X", name: "macOS"},
+	{token: "Linux", name: "Linux"},
+}
+
+// describeDevice returns a human readable description of the device of a user agent, such as
+// "Chrome on Windows".
+func describeDevice(userAgent string) string {
+	browser := matchUserAgentToken(userAgent, browserTokens)
+	os := matchUserAgentToken(userAgent, osTokens)
+
+	switch {
+	case browser != "" && os != "":
+		return browser + " on " + os
+	case browser != "":
+		return browser
+	case os != "":
+		return os
+	default:
+		return unknownValue
+	}
+}
+
+// matchUserAgentToken returns the name of the first token found in the user agent, or an empty string when
+// none is found.
+func matchUserAgentToken(userAgent string, tokens []userAgentToken) string {
+	for _, t := range tokens {
+		if strings.Contains(userAgent, t.token) {
+			return t.name
+		}
+	}
+	return ""
+}
@@ -0,0 +1,44 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package securitynotification
+
+import (
+	"github.com/thunder-id/thunderid/internal/entityprovider"
+	"github.com/thunder-id/thunderid/internal/notification"
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/email"
+	"github.com/thunder-id/thunderid/internal/system/template"
+)
+
+// Initialize initializes the security notification service. It returns nil when security notifications
+// are disabled. The email client may be nil when email is not configured.
+func Initialize(
+	entityProvider entityprovider.EntityProviderInterface,
+	templateService template.TemplateServiceInterface,
+	emailClient email.EmailClientInterface,
+	notifSenderSvc notification.NotificationSenderServiceInterface,
+) SecurityNotificationServiceInterface {
+	notificationConfig := config.GetServerRuntime().Config.SecurityNotification
+	if !notificationConfig.Enabled {
+		return nil
+	}
+
+	return newSecurityNotificationService(newSecurityNotificationStore(), entityProvider, templateService,
+		emailClient, notifSenderSvc, notificationConfig)
+}
@@ -0,0 +1,52 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package securitynotification
+
+import "github.com/thunder-id/thunderid/internal/system/template"
+
+// SignIn holds the details of a completed sign-in that a user is notified of.
+type SignIn struct {
+	// UserID is the ID of the user who signed in.
+	UserID string
+	// AppName is the name of the application the user signed in to.
+	AppName string
+	// DeviceFingerprint identifies the device the user signed in from. Sign-ins without a fingerprint are
+	// always treated as coming from a new device.
+	DeviceFingerprint string
+	// UserAgent is the user agent of the device the user signed in from.
+	UserAgent string
+	// IPAddress is the IP address the user signed in from.
+	IPAddress string
+}
+
+// AccountChange holds the details of a high-risk change of a user's account that the user is notified of.
+type AccountChange struct {
+	// UserID is the ID of the user whose account was changed.
+	UserID string
+	// Scenario is the scenario of the notification sent to the current email address and mobile number of
+	// the user.
+	Scenario template.ScenarioType
+	// PreviousEmail is the email address the change replaced, if any. It is notified as well, as the user may
+	// no longer be reachable at the current address.
+	PreviousEmail string
+	// PreviousEmailScenario is the scenario of the notification sent to the previous email address.
+	PreviousEmailScenario template.ScenarioType
+	// Data holds the details of the change made available to the templates.
+	Data template.TemplateData
+}
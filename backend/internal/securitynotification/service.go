@@ -0,0 +1,345 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package securitynotification notifies users of security relevant activity on their account, such as a
+// sign-in from a new device or a password change.
+package securitynotification
+
+import (
+	"context"
+	"encoding/json"
+	"html"
+	"strings"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/entityprovider"
+	"github.com/thunder-id/thunderid/internal/entitytype/model"
+	"github.com/thunder-id/thunderid/internal/notification"
+	notifcm "github.com/thunder-id/thunderid/internal/notification/common"
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/email"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/internal/system/template"
+)
+
+// SecurityNotificationServiceInterface defines the interface for notifying users of security relevant
+// activity on their account. Notifications are sent in the background and failures are logged, so that
+// the activity being notified of never fails because of them.
+type SecurityNotificationServiceInterface interface {
+	// NotifySignIn notifies the user of a sign-in from a device the user has not signed in from before.
+	NotifySignIn(ctx context.Context, signIn SignIn)
+
+	// NotifyCredentialChanged notifies the user that their password was changed.
+	NotifyCredentialChanged(ctx context.Context, userID string)
+
+	// NotifyAccountChange notifies the user of a high-risk change of their account over every channel the
+	// user can be reached on, regardless of the channel the user prefers.
+	NotifyAccountChange(ctx context.Context, change AccountChange)
+}
+
+// securityNotificationService is the default implementation of SecurityNotificationServiceInterface.
+type securityNotificationService struct {
+	store           securityNotificationStoreInterface
+	entityProvider  entityprovider.EntityProviderInterface
+	templateService template.TemplateServiceInterface
+	emailClient     email.EmailClientInterface
+	notifSenderSvc  notification.NotificationSenderServiceInterface
+	config          config.SecurityNotificationConfig
+	events          map[string]bool
+	throttler       *notificationThrottler
+	now             func() time.Time
+	dispatch        func(func())
+	logger          *log.Logger
+}
+
+// newSecurityNotificationService creates a new instance of securityNotificationService. The email client
+// may be nil when email is not configured, in which case email notifications are skipped.
+func newSecurityNotificationService(
+	store securityNotificationStoreInterface,
+	entityProvider entityprovider.EntityProviderInterface,
+	templateService template.TemplateServiceInterface,
+	emailClient email.EmailClientInterface,
+	notifSenderSvc notification.NotificationSenderServiceInterface,
+	cfg config.SecurityNotificationConfig,
+) *securityNotificationService {
+	events := make(map[string]bool, len(cfg.Events))
+	for _, evt := range cfg.Events {
+		events[evt] = true
+	}
+	return &securityNotificationService{
+		store:           store,
+		entityProvider:  entityProvider,
+		templateService: templateService,
+		emailClient:     emailClient,
+		notifSenderSvc:  notifSenderSvc,
+		config:          cfg,
+		events:          events,
+		throttler: newNotificationThrottler(cfg.ThrottleLimit,
+			time.Duration(cfg.ThrottleWindow)*time.Second),
+		now:      time.Now,
+		dispatch: func(f func()) { go f() },
+		logger:   log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName)),
+	}
+}
+
+// NotifySignIn records the device of the sign-in and notifies the user when the device is not known.
+func (s *securityNotificationService) NotifySignIn(ctx context.Context, signIn SignIn) {
+	if !s.events[config.SecurityNotificationEventNewSignIn] || signIn.UserID == "" {
+		return
+	}
+	ctx = context.WithoutCancel(ctx)
+	s.dispatch(func() {
+		s.notifySignIn(ctx, signIn)
+	})
+}
+
+// NotifyCredentialChanged notifies the user that their password was changed.
+func (s *securityNotificationService) NotifyCredentialChanged(ctx context.Context, userID string) {
+	if !s.events[config.SecurityNotificationEventCredentialChanged] || userID == "" {
+		return
+	}
+	ctx = context.WithoutCancel(ctx)
+	s.dispatch(func() {
+		s.notify(ctx, userID, template.ScenarioCredentialChanged, template.TemplateData{
+			templateDataKeyTime: s.now().UTC().Format(time.RFC1123),
+		})
+	})
+}
+
+// NotifyAccountChange notifies the user of a high-risk change of their account over every channel.
+func (s *securityNotificationService) NotifyAccountChange(ctx context.Context, change AccountChange) {
+	if change.UserID == "" {
+		return
+	}
+	ctx = context.WithoutCancel(ctx)
+	s.dispatch(func() {
+		s.notifyAccountChange(ctx, change)
+	})
+}
+
+// notifySignIn sends the new sign-in notification unless the user signed in from the device before.
+func (s *securityNotificationService) notifySignIn(ctx context.Context, signIn SignIn) {
+	now := s.now().UTC()
+
+	if signIn.DeviceFingerprint != "" {
+		known, err := s.store.IsKnownDevice(ctx, signIn.UserID, signIn.DeviceFingerprint, now)
+		if err != nil {
+			s.logger.Warn("Failed to check the known devices of the user",
+				log.MaskedString(log.LoggerKeyUserID, signIn.UserID), log.Error(err))
+			return
+		}
+		expiresAt := now.Add(time.Duration(s.config.KnownDeviceValidity) * time.Second)
+		if err := s.store.RecordDevice(ctx, signIn.UserID, signIn.DeviceFingerprint, now, expiresAt); err != nil {
+			s.logger.Warn("Failed to record the sign-in device of the user",
+				log.MaskedString(log.LoggerKeyUserID, signIn.UserID), log.Error(err))
+		}
+		if known {
+			s.logger.Debug("Sign-in from a known device, skipping notification",
+				log.MaskedString(log.LoggerKeyUserID, signIn.UserID))
+			return
+		}
+	}
+
+	s.notify(ctx, signIn.UserID, template.ScenarioNewSignIn, template.TemplateData{
+		templateDataKeyAppName:    valueOrUnknown(signIn.AppName),
+		templateDataKeyDeviceName: describeDevice(signIn.UserAgent),
+		templateDataKeyIPAddress:  valueOrUnknown(signIn.IPAddress),
+		templateDataKeyTime:       now.Format(time.RFC1123),
+	})
+}
+
+// notify sends the notification of the scenario over the channel preferred by the user.
+func (s *securityNotificationService) notify(ctx context.Context, userID string, scenario template.ScenarioType,
+	data template.TemplateData) {
+	logger := s.logger.With(log.MaskedString(log.LoggerKeyUserID, userID), log.String("scenario", string(scenario)))
+
+	user, epErr := s.entityProvider.GetEntity(userID)
+	if epErr != nil {
+		logger.Warn("Failed to retrieve the user to notify", log.String("error", epErr.Error()))
+		return
+	}
+	attributes := map[string]interface{}{}
+	if len(user.Attributes) > 0 {
+		if err := json.Unmarshal(user.Attributes, &attributes); err != nil {
+			logger.Warn("Failed to parse the attributes of the user to notify", log.Error(err))
+			return
+		}
+	}
+
+	channel := s.resolveChannel(attributes)
+	var recipient string
+	switch channel {
+	case config.SecurityNotificationChannelEmail:
+		recipient = contactAttribute(attributes, s.config.EmailAttribute)
+	case config.SecurityNotificationChannelSMS:
+		recipient = contactAttribute(attributes, s.config.MobileAttribute)
+	default:
+		logger.Debug("Security notifications are turned off for the user")
+		return
+	}
+	if recipient == "" {
+		logger.Debug("User has no recipient for the notification channel", log.String("channel", channel))
+		return
+	}
+	if !s.throttler.allow(userID) {
+		logger.Debug("Security notification throttled")
+		return
+	}
+
+	if channel == config.SecurityNotificationChannelEmail {
+		s.sendEmail(ctx, logger, recipient, scenario, data)
+	} else {
+		s.sendSMS(ctx, logger, recipient, scenario, data)
+	}
+}
+
+// notifyAccountChange sends the notification of an account change to the email address and mobile number
+// of the user, and to the previous email address when the change replaced it.
+func (s *securityNotificationService) notifyAccountChange(ctx context.Context, change AccountChange) {
+	logger := s.logger.With(log.MaskedString(log.LoggerKeyUserID, change.UserID),
+		log.String("scenario", string(change.Scenario)))
+
+	user, epErr := s.entityProvider.GetEntity(change.UserID)
+	if epErr != nil {
+		logger.Warn("Failed to retrieve the user to notify", log.String("error", epErr.Error()))
+		return
+	}
+	attributes := map[string]interface{}{}
+	if len(user.Attributes) > 0 {
+		if err := json.Unmarshal(user.Attributes, &attributes); err != nil {
+			logger.Warn("Failed to parse the attributes of the user to notify", log.Error(err))
+			return
+		}
+	}
+	if !s.throttler.allow(change.UserID) {
+		logger.Debug("Security notification throttled")
+		return
+	}
+
+	data := make(template.TemplateData, len(change.Data)+1)
+	for key, value := range change.Data {
+		data[key] = value
+	}
+	data[templateDataKeyTime] = s.now().UTC().Format(time.RFC1123)
+
+	emailAddress := contactAttribute(attributes, s.config.EmailAttribute)
+	if emailAddress != "" {
+		s.sendEmail(ctx, logger, emailAddress, change.Scenario, data)
+	}
+	if mobileNumber := contactAttribute(attributes, s.config.MobileAttribute); mobileNumber != "" {
+		s.sendSMS(ctx, logger, mobileNumber, change.Scenario, data)
+	}
+	if change.PreviousEmail != "" && change.PreviousEmail != emailAddress {
+		scenario := change.PreviousEmailScenario
+		if scenario == "" {
+			scenario = change.Scenario
+		}
+		s.sendEmail(ctx, logger, change.PreviousEmail, scenario, data)
+	}
+}
+
+// resolveChannel returns the channel preferred by the user, falling back to the default channel when the
+// user has not chosen a supported channel.
+func (s *securityNotificationService) resolveChannel(attributes map[string]interface{}) string {
+	channel := strings.ToLower(strings.TrimSpace(stringAttribute(attributes, s.config.PreferenceAttribute)))
+	switch channel {
+	case config.SecurityNotificationChannelEmail, config.SecurityNotificationChannelSMS,
+		config.SecurityNotificationChannelNone:
+		return channel
+	default:
+		return s.config.DefaultChannel
+	}
+}
+
+// sendEmail renders the email template of the scenario and sends it to the given address.
+func (s *securityNotificationService) sendEmail(ctx context.Context, logger *log.Logger, recipient string,
+	scenario template.ScenarioType, data template.TemplateData) {
+	if s.emailClient == nil {
+		logger.Warn("Email is not configured, skipping security notification")
+		return
+	}
+
+	// Email templates are HTML, and details such as the user agent are supplied by the client.
+	escaped := make(template.TemplateData, len(data))
+	for key, value := range data {
+		escaped[key] = html.EscapeString(value)
+	}
+	rendered, svcErr := s.templateService.Render(ctx, scenario, template.TemplateTypeEmail, escaped)
+	if svcErr != nil {
+		logger.Warn("Failed to render the security notification email", log.String("error", svcErr.Code))
+		return
+	}
+
+	if err := s.emailClient.Send(email.EmailData{
+		To:      []string{recipient},
+		Subject: rendered.Subject,
+		Body:    rendered.Body,
+		IsHTML:  rendered.IsHTML,
+	}); err != nil {
+		logger.Warn("Failed to send the security notification email", log.Error(err))
+		return
+	}
+	logger.Debug("Security notification email sent")
+}
+
+// sendSMS renders the SMS template of the scenario and sends it to the given mobile number.
+func (s *securityNotificationService) sendSMS(ctx context.Context, logger *log.Logger, recipient string,
+	scenario template.ScenarioType, data template.TemplateData) {
+	if s.config.SMSSenderID == "" || s.notifSenderSvc == nil {
+		logger.Warn("SMS sender is not configured, skipping security notification")
+		return
+	}
+
+	rendered, svcErr := s.templateService.Render(ctx, scenario, template.TemplateTypeSMS, data)
+	if svcErr != nil {
+		logger.Warn("Failed to render the security notification SMS", log.String("error", svcErr.Code))
+		return
+	}
+
+	if svcErr := s.notifSenderSvc.Send(ctx, notifcm.ChannelTypeSMS, s.config.SMSSenderID,
+		notifcm.NotificationData{Recipient: recipient, Body: rendered.Body}); svcErr != nil {
+		logger.Warn("Failed to send the security notification SMS", log.String("error", svcErr.Code))
+		return
+	}
+	logger.Debug("Security notification SMS sent")
+}
+
+// stringAttribute returns the string value of a user attribute, or an empty string when the attribute is
+// missing or not a string.
+func stringAttribute(attributes map[string]interface{}, name string) string {
+	value, _ := attributes[name].(string)
+	return value
+}
+
+// contactAttribute returns the email address or phone number held by a user attribute. When the attribute
+// holds several contact values, the value selected for notifications is returned.
+func contactAttribute(attributes map[string]interface{}, name string) string {
+	if value, ok := attributes[name].(string); ok {
+		return value
+	}
+	selected, _ := model.SelectContactValue(model.ParseContactValues(attributes[name]))
+	return selected.Value
+}
+
+// valueOrUnknown returns the value, or a placeholder when the value is empty.
+func valueOrUnknown(value string) string {
+	if value == "" {
+		return unknownValue
+	}
+	return value
+}
@@ -0,0 +1,327 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package securitynotification
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/entityprovider"
+	notifcm "github.com/thunder-id/thunderid/internal/notification/common"
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/email"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/template"
+	"github.com/thunder-id/thunderid/tests/mocks/emailmock"
+	"github.com/thunder-id/thunderid/tests/mocks/entityprovidermock"
+	"github.com/thunder-id/thunderid/tests/mocks/notification/notificationmock"
+	"github.com/thunder-id/thunderid/tests/mocks/templatemock"
+)
+
+const (
+	testUserID      = "user-1"
+	testFingerprint = "fingerprint-1"
+	testUserAgent   = "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) " +
+		"Chrome/120.0.0.0 Safari/537.36"
+)
+
+type SecurityNotificationServiceTestSuite struct {
+	suite.Suite
+	mockStore          *securityNotificationStoreInterfaceMock
+	mockEntityProvider *entityprovidermock.EntityProviderInterfaceMock
+	mockTemplate       *templatemock.TemplateServiceInterfaceMock
+	mockEmail          *emailmock.EmailClientInterfaceMock
+	mockSender         *notificationmock.NotificationSenderServiceInterfaceMock
+	config             config.SecurityNotificationConfig
+	now                time.Time
+}
+
+func TestSecurityNotificationServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(SecurityNotificationServiceTestSuite))
+}
+
+func (suite *SecurityNotificationServiceTestSuite) SetupTest() {
+	suite.mockStore = newSecurityNotificationStoreInterfaceMock(suite.T())
+	suite.mockEntityProvider = entityprovidermock.NewEntityProviderInterfaceMock(suite.T())
+	suite.mockTemplate = templatemock.NewTemplateServiceInterfaceMock(suite.T())
+	suite.mockEmail = emailmock.NewEmailClientInterfaceMock(suite.T())
+	suite.mockSender = notificationmock.NewNotificationSenderServiceInterfaceMock(suite.T())
+	suite.config = config.SecurityNotificationConfig{
+		Enabled: true,
+		Events: []string{config.SecurityNotificationEventNewSignIn,
+			config.SecurityNotificationEventCredentialChanged},
+		DefaultChannel:      config.SecurityNotificationChannelEmail,
+		PreferenceAttribute: "securityNotificationChannel",
+		EmailAttribute:      "email",
+		MobileAttribute:     "mobileNumber",
+		SMSSenderID:         "sender-1",
+		KnownDeviceValidity: 3600,
+		ThrottleLimit:       2,
+		ThrottleWindow:      60,
+	}
+	suite.now = time.Date(2026, 6, 1, 12, 0, 0, 0, time.UTC)
+}
+
+// newService creates the service under test, dispatching notifications synchronously.
+func (suite *SecurityNotificationServiceTestSuite) newService() *securityNotificationService {
+	service := newSecurityNotificationService(suite.mockStore, suite.mockEntityProvider, suite.mockTemplate,
+		suite.mockEmail, suite.mockSender, suite.config)
+	service.now = func() time.Time { return suite.now }
+	service.dispatch = func(f func()) { f() }
+	return service
+}
+
+// expectUser sets up the user returned by the entity provider.
+func (suite *SecurityNotificationServiceTestSuite) expectUser(attributes string) {
+	suite.mockEntityProvider.On("GetEntity", testUserID).Return(&entityprovider.Entity{
+		ID:         testUserID,
+		Attributes: []byte(attributes),
+	}, nil)
+}
+
+func (suite *SecurityNotificationServiceTestSuite) signIn() SignIn {
+	return SignIn{
+		UserID:            testUserID,
+		AppName:           "Console",
+		DeviceFingerprint: testFingerprint,
+		UserAgent:         testUserAgent,
+		IPAddress:         "192.0.2.10",
+	}
+}
+
+func (suite *SecurityNotificationServiceTestSuite) TestNotifySignIn_NewDeviceSendsEmail() {
+	expiresAt := suite.now.Add(time.Hour)
+	suite.mockStore.On("IsKnownDevice", mock.Anything, testUserID, testFingerprint, suite.now).Return(false, nil)
+	suite.mockStore.On("RecordDevice", mock.Anything, testUserID, testFingerprint, suite.now, expiresAt).Return(nil)
+	suite.expectUser(`{"email":"alice@example.com"}`)
+	suite.mockTemplate.On("Render", mock.Anything, template.ScenarioNewSignIn, template.TemplateTypeEmail,
+		mock.MatchedBy(func(data template.TemplateData) bool {
+			return data[templateDataKeyDeviceName] == "Chrome on Windows" &&
+				data[templateDataKeyAppName] == "Console" && data[templateDataKeyIPAddress] == "192.0.2.10"
+		})).Return(&template.RenderedTemplate{Subject: "New sign-in", Body: "<p>body</p>", IsHTML: true}, nil)
+	suite.mockEmail.On("Send", email.EmailData{
+		To: []string{"alice@example.com"}, Subject: "New sign-in", Body: "<p>body</p>", IsHTML: true,
+	}).Return(nil)
+
+	suite.newService().NotifySignIn(context.Background(), suite.signIn())
+}
+
+func (suite *SecurityNotificationServiceTestSuite) TestNotifySignIn_SendsToSelectedContactValue() {
+	suite.mockStore.On("IsKnownDevice", mock.Anything, testUserID, testFingerprint, suite.now).Return(false, nil)
+	suite.mockStore.On("RecordDevice", mock.Anything, testUserID, testFingerprint, suite.now,
+		mock.Anything).Return(nil)
+	suite.expectUser(`{"email":[{"value":"alice@home.example.com"},` +
+		`{"value":"alice@work.example.com","primary":true}]}`)
+	suite.mockTemplate.On("Render", mock.Anything, template.ScenarioNewSignIn, template.TemplateTypeEmail,
+		mock.Anything).Return(&template.RenderedTemplate{Subject: "New sign-in", Body: "body"}, nil)
+	suite.mockEmail.On("Send", email.EmailData{
+		To: []string{"alice@work.example.com"}, Subject: "New sign-in", Body: "body",
+	}).Return(nil)
+
+	suite.newService().NotifySignIn(context.Background(), suite.signIn())
+}
+
+func (suite *SecurityNotificationServiceTestSuite) TestNotifySignIn_KnownDeviceIsNotNotified() {
+	suite.mockStore.On("IsKnownDevice", mock.Anything, testUserID, testFingerprint, suite.now).Return(true, nil)
+	suite.mockStore.On("RecordDevice", mock.Anything, testUserID, testFingerprint, suite.now,
+		mock.Anything).Return(nil)
+
+	suite.newService().NotifySignIn(context.Background(), suite.signIn())
+
+	suite.mockEntityProvider.AssertNotCalled(suite.T(), "GetEntity", mock.Anything)
+}
+
+func (suite *SecurityNotificationServiceTestSuite) TestNotifySignIn_StoreFailureSkipsNotification() {
+	suite.mockStore.On("IsKnownDevice", mock.Anything, testUserID, testFingerprint, suite.now).
+		Return(false, errors.New("db down"))
+
+	suite.newService().NotifySignIn(context.Background(), suite.signIn())
+
+	suite.mockStore.AssertNotCalled(suite.T(), "RecordDevice", mock.Anything, mock.Anything, mock.Anything,
+		mock.Anything, mock.Anything)
+	suite.mockEntityProvider.AssertNotCalled(suite.T(), "GetEntity", mock.Anything)
+}
+
+func (suite *SecurityNotificationServiceTestSuite) TestNotifySignIn_EventDisabled() {
+	suite.config.Events = []string{config.SecurityNotificationEventCredentialChanged}
+
+	suite.newService().NotifySignIn(context.Background(), suite.signIn())
+
+	suite.mockStore.AssertNotCalled(suite.T(), "IsKnownDevice", mock.Anything, mock.Anything, mock.Anything,
+		mock.Anything)
+}
+
+func (suite *SecurityNotificationServiceTestSuite) TestNotifySignIn_EscapesEmailTemplateData() {
+	signIn := suite.signIn()
+	signIn.DeviceFingerprint = ""
+	signIn.AppName = "<b>App</b>"
+	suite.expectUser(`{"email":"alice@example.com"}`)
+	suite.mockTemplate.On("Render", mock.Anything, template.ScenarioNewSignIn, template.TemplateTypeEmail,
+		mock.MatchedBy(func(data template.TemplateData) bool {
+			return data[templateDataKeyAppName] == "&lt;b&gt;App&lt;/b&gt;"
+		})).Return(&template.RenderedTemplate{Subject: "New sign-in", Body: "body"}, nil)
+	suite.mockEmail.On("Send", mock.Anything).Return(nil)
+
+	suite.newService().NotifySignIn(context.Background(), signIn)
+}
+
+func (suite *SecurityNotificationServiceTestSuite) TestNotifyCredentialChanged_SMSPreference() {
+	suite.expectUser(`{"email":"alice@example.com","mobileNumber":"+94771234567",` +
+		`"securityNotificationChannel":"SMS"}`)
+	suite.mockTemplate.On("Render", mock.Anything, template.ScenarioCredentialChanged, template.TemplateTypeSMS,
+		mock.Anything).Return(&template.RenderedTemplate{Body: "Your password was changed."}, nil)
+	suite.mockSender.On("Send", mock.Anything, notifcm.ChannelTypeSMS, "sender-1", notifcm.NotificationData{
+		Recipient: "+94771234567", Body: "Your password was changed.",
+	}).Return(nil)
+
+	suite.newService().NotifyCredentialChanged(context.Background(), testUserID)
+
+	suite.mockEmail.AssertNotCalled(suite.T(), "Send", mock.Anything)
+}
+
+func (suite *SecurityNotificationServiceTestSuite) TestNotifyCredentialChanged_NonePreference() {
+	suite.expectUser(`{"email":"alice@example.com","securityNotificationChannel":"none"}`)
+
+	suite.newService().NotifyCredentialChanged(context.Background(), testUserID)
+
+	suite.mockTemplate.AssertNotCalled(suite.T(), "Render", mock.Anything, mock.Anything, mock.Anything,
+		mock.Anything)
+}
+
+func (suite *SecurityNotificationServiceTestSuite) TestNotifyCredentialChanged_UnsupportedPreferenceUsesDefault() {
+	suite.expectUser(`{"email":"alice@example.com","securityNotificationChannel":"pigeon"}`)
+	suite.mockTemplate.On("Render", mock.Anything, template.ScenarioCredentialChanged, template.TemplateTypeEmail,
+		mock.Anything).Return(&template.RenderedTemplate{Subject: "Password changed", Body: "body"}, nil)
+	suite.mockEmail.On("Send", mock.Anything).Return(nil)
+
+	suite.newService().NotifyCredentialChanged(context.Background(), testUserID)
+}
+
+func (suite *SecurityNotificationServiceTestSuite) TestNotifyCredentialChanged_MissingRecipient() {
+	suite.expectUser(`{"securityNotificationChannel":"email"}`)
+
+	suite.newService().NotifyCredentialChanged(context.Background(), testUserID)
+
+	suite.mockTemplate.AssertNotCalled(suite.T(), "Render", mock.Anything, mock.Anything, mock.Anything,
+		mock.Anything)
+}
+
+func (suite *SecurityNotificationServiceTestSuite) TestNotifyCredentialChanged_Throttled() {
+	suite.expectUser(`{"email":"alice@example.com"}`)
+	suite.mockTemplate.On("Render", mock.Anything, template.ScenarioCredentialChanged, template.TemplateTypeEmail,
+		mock.Anything).Return(&template.RenderedTemplate{Subject: "Password changed", Body: "body"}, nil)
+	suite.mockEmail.On("Send", mock.Anything).Return(nil).Times(2)
+
+	service := suite.newService()
+	for i := 0; i < 3; i++ {
+		service.NotifyCredentialChanged(context.Background(), testUserID)
+	}
+}
+
+func (suite *SecurityNotificationServiceTestSuite) TestNotifyCredentialChanged_EmailNotConfigured() {
+	suite.expectUser(`{"email":"alice@example.com"}`)
+	service := suite.newService()
+	service.emailClient = nil
+
+	service.NotifyCredentialChanged(context.Background(), testUserID)
+
+	suite.mockTemplate.AssertNotCalled(suite.T(), "Render", mock.Anything, mock.Anything, mock.Anything,
+		mock.Anything)
+}
+
+func (suite *SecurityNotificationServiceTestSuite) TestNotifyCredentialChanged_RenderFailure() {
+	suite.expectUser(`{"email":"alice@example.com"}`)
+	suite.mockTemplate.On("Render", mock.Anything, template.ScenarioCredentialChanged, template.TemplateTypeEmail,
+		mock.Anything).Return(nil, &serviceerror.InternalServerError)
+
+	suite.newService().NotifyCredentialChanged(context.Background(), testUserID)
+
+	suite.mockEmail.AssertNotCalled(suite.T(), "Send", mock.Anything)
+}
+
+func (suite *SecurityNotificationServiceTestSuite) TestNotifyCredentialChanged_UserNotFound() {
+	suite.mockEntityProvider.On("GetEntity", testUserID).Return(nil,
+		entityprovider.NewEntityProviderError(entityprovider.ErrorCodeEntityNotFound, "not found", ""))
+
+	suite.newService().NotifyCredentialChanged(context.Background(), testUserID)
+
+	suite.mockTemplate.AssertNotCalled(suite.T(), "Render", mock.Anything, mock.Anything, mock.Anything,
+		mock.Anything)
+}
+
+func (suite *SecurityNotificationServiceTestSuite) TestNotifyAccountChange_AllChannelsAndPreviousAddress() {
+	suite.expectUser(`{"email":"new@example.com","mobileNumber":"+94771234567",` +
+		`"securityNotificationChannel":"none"}`)
+	suite.mockTemplate.On("Render", mock.Anything, template.ScenarioEmailChanged, template.TemplateTypeEmail,
+		mock.MatchedBy(func(data template.TemplateData) bool {
+			return data["newEmail"] == "new@example.com" && data[templateDataKeyTime] != ""
+		})).Return(&template.RenderedTemplate{Subject: "Email changed", Body: "body"}, nil)
+	suite.mockTemplate.On("Render", mock.Anything, template.ScenarioEmailChanged, template.TemplateTypeSMS,
+		mock.Anything).Return(&template.RenderedTemplate{Body: "Email changed"}, nil)
+	suite.mockTemplate.On("Render", mock.Anything, template.ScenarioEmailChangeRevert, template.TemplateTypeEmail,
+		mock.MatchedBy(func(data template.TemplateData) bool {
+			return data["revertLink"] == "https://localhost/revert?token=a&amp;b"
+		})).Return(&template.RenderedTemplate{Subject: "Email changed", Body: "revert"}, nil)
+	suite.mockEmail.On("Send", email.EmailData{To: []string{"new@example.com"}, Subject: "Email changed",
+		Body: "body"}).Return(nil)
+	suite.mockEmail.On("Send", email.EmailData{To: []string{"old@example.com"}, Subject: "Email changed",
+		Body: "revert"}).Return(nil)
+	suite.mockSender.On("Send", mock.Anything, notifcm.ChannelTypeSMS, "sender-1", notifcm.NotificationData{
+		Recipient: "+94771234567", Body: "Email changed",
+	}).Return(nil)
+
+	suite.newService().NotifyAccountChange(context.Background(), AccountChange{
+		UserID:                testUserID,
+		Scenario:              template.ScenarioEmailChanged,
+		PreviousEmail:         "old@example.com",
+		PreviousEmailScenario: template.ScenarioEmailChangeRevert,
+		Data: template.TemplateData{
+			"newEmail":   "new@example.com",
+			"revertLink": "https://localhost/revert?token=a&b",
+		},
+	})
+}
+
+func (suite *SecurityNotificationServiceTestSuite) TestNotifyAccountChange_EmailOnly() {
+	suite.expectUser(`{"email":"alice@example.com"}`)
+	suite.mockTemplate.On("Render", mock.Anything, template.ScenarioCredentialChanged, template.TemplateTypeEmail,
+		mock.Anything).Return(&template.RenderedTemplate{Subject: "Password changed", Body: "body"}, nil)
+	suite.mockEmail.On("Send", mock.Anything).Return(nil).Once()
+
+	suite.newService().NotifyAccountChange(context.Background(), AccountChange{
+		UserID:   testUserID,
+		Scenario: template.ScenarioCredentialChanged,
+	})
+
+	suite.mockSender.AssertNotCalled(suite.T(), "Send", mock.Anything, mock.Anything, mock.Anything,
+		mock.Anything)
+}
+
+func (suite *SecurityNotificationServiceTestSuite) TestNotifyAccountChange_MissingUserID() {
+	suite.newService().NotifyAccountChange(context.Background(), AccountChange{
+		Scenario: template.ScenarioCredentialChanged,
+	})
+
+	suite.mockEntityProvider.AssertNotCalled(suite.T(), "GetEntity", mock.Anything)
+}
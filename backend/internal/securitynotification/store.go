@@ -0,0 +1,92 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package securitynotification
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+	sysContext "github.com/thunder-id/thunderid/internal/system/context"
+	"github.com/thunder-id/thunderid/internal/system/database/provider"
+)
+
+var getDBProvider = provider.GetDBProvider
+
+// securityNotificationStoreInterface defines the interface for the store of the devices users signed in from.
+type securityNotificationStoreInterface interface {
+	IsKnownDevice(ctx context.Context, userID, fingerprint string, now time.Time) (bool, error)
+	RecordDevice(ctx context.Context, userID, fingerprint string, seenAt, expiresAt time.Time) error
+}
+
+// securityNotificationStore is the runtime database backed implementation of
+// securityNotificationStoreInterface.
+type securityNotificationStore struct {
+	dbProvider   provider.DBProviderInterface
+	deploymentID string
+}
+
+// newSecurityNotificationStore creates a new instance of securityNotificationStore.
+func newSecurityNotificationStore() securityNotificationStoreInterface {
+	return &securityNotificationStore{
+		dbProvider:   getDBProvider(),
+		deploymentID: config.GetServerRuntime().Config.Server.Identifier,
+	}
+}
+
+// IsKnownDevice reports whether the user signed in from the device and the record has not expired.
+func (s *securityNotificationStore) IsKnownDevice(ctx context.Context, userID, fingerprint string,
+	now time.Time) (bool, error) {
+	dbClient, err := s.dbProvider.GetRuntimeDBClient()
+	if err != nil {
+		return false, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	results, err := dbClient.QueryContext(ctx, queryCountKnownDevice, userID, fingerprint, now,
+		sysContext.ScopeDeploymentID(ctx, s.deploymentID))
+	if err != nil {
+		return false, fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	if len(results) > 0 {
+		switch total := results[0]["total"].(type) {
+		case int64:
+			return total > 0, nil
+		case float64:
+			return total > 0, nil
+		}
+	}
+	return false, nil
+}
+
+// RecordDevice records a sign-in of the user from the device.
+func (s *securityNotificationStore) RecordDevice(ctx context.Context, userID, fingerprint string,
+	seenAt, expiresAt time.Time) error {
+	dbClient, err := s.dbProvider.GetRuntimeDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	if _, err := dbClient.ExecuteContext(ctx, queryRecordDevice, userID, fingerprint, seenAt, expiresAt,
+		sysContext.ScopeDeploymentID(ctx, s.deploymentID)); err != nil {
+		return fmt.Errorf("failed to execute query: %w", err)
+	}
+	return nil
+}
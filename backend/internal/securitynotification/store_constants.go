@@ -0,0 +1,39 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package securitynotification
+
+import dbmodel "github.com/thunder-id/thunderid/internal/system/database/model"
+
+var (
+	// queryCountKnownDevice counts the unexpired records of a device a user signed in from.
+	queryCountKnownDevice = dbmodel.DBQuery{
+		ID: "SNQ-DEVICE_MGT-01",
+		Query: `SELECT COUNT(*) AS total FROM "SECURITY_NOTIFICATION_DEVICE" WHERE USER_ID = $1 ` +
+			`AND FINGERPRINT_HASH = $2 AND EXPIRY_TIME > $3 AND DEPLOYMENT_ID = $4`,
+	}
+
+	// queryRecordDevice records a sign-in from a device, extending the expiry of a device already recorded.
+	queryRecordDevice = dbmodel.DBQuery{
+		ID: "SNQ-DEVICE_MGT-02",
+		Query: `INSERT INTO "SECURITY_NOTIFICATION_DEVICE" (USER_ID, FINGERPRINT_HASH, LAST_SEEN_AT, ` +
+			`EXPIRY_TIME, DEPLOYMENT_ID) VALUES ($1, $2, $3, $4, $5) ` +
+			`ON CONFLICT (USER_ID, FINGERPRINT_HASH, DEPLOYMENT_ID) ` +
+			`DO UPDATE SET LAST_SEEN_AT = EXCLUDED.LAST_SEEN_AT, EXPIRY_TIME = EXCLUDED.EXPIRY_TIME`,
+	}
+)
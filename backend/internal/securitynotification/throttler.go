@@ -0,0 +1,75 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package securitynotification
+
+import (
+	"sync"
+	"time"
+)
+
+// notificationThrottler limits the number of security notifications sent to a user within a sliding time
+// window. The throttler state is held in memory and is therefore scoped to a single server instance.
+type notificationThrottler struct {
+	mu     sync.Mutex
+	limit  int
+	window time.Duration
+	now    func() time.Time
+	sent   map[string][]time.Time
+}
+
+// newNotificationThrottler creates a new notificationThrottler allowing limit notifications per window.
+func newNotificationThrottler(limit int, window time.Duration) *notificationThrottler {
+	return &notificationThrottler{
+		limit:  limit,
+		window: window,
+		now:    time.Now,
+		sent:   make(map[string][]time.Time),
+	}
+}
+
+// allow records a notification to the given user and reports whether it is within the limit.
+// Rejected notifications are not recorded.
+func (t *notificationThrottler) allow(userID string) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	now := t.now()
+	cutoff := now.Add(-t.window)
+
+	// Drop expired entries for every user so that the map does not grow unbounded.
+	for key, timestamps := range t.sent {
+		kept := timestamps[:0]
+		for _, ts := range timestamps {
+			if ts.After(cutoff) {
+				kept = append(kept, ts)
+			}
+		}
+		if len(kept) == 0 {
+			delete(t.sent, key)
+		} else {
+			t.sent[key] = kept
+		}
+	}
+
+	if len(t.sent[userID]) >= t.limit {
+		return false
+	}
+	t.sent[userID] = append(t.sent[userID], now)
+	return true
+}
@@ -0,0 +1,41 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package securitynotification
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNotificationThrottler_SlidingWindow(t *testing.T) {
+	now := time.Now()
+	throttler := newNotificationThrottler(2, time.Minute)
+	throttler.now = func() time.Time { return now }
+
+	assert.True(t, throttler.allow("user-1"))
+	assert.True(t, throttler.allow("user-1"))
+	assert.False(t, throttler.allow("user-1"))
+	assert.True(t, throttler.allow("user-2"))
+
+	now = now.Add(time.Minute + time.Second)
+	assert.True(t, throttler.allow("user-1"))
+	assert.NotContains(t, throttler.sent, "user-2")
+}
@@ -0,0 +1,105 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package sharedsignals
+
+import "time"
+
+const (
+	// loggerComponentName is the component name used in shared signals logs.
+	loggerComponentName = "SharedSignalsService"
+
+	// subscriberID identifies the shared signals subscriber on the event bus.
+	subscriberID = "shared-signals"
+
+	// retryLockName is the distributed lock held while failed push deliveries are retried.
+	retryLockName = "shared-signals-retry"
+
+	// pollPath is the path of the poll endpoint of the poll receivers.
+	pollPath = "/shared-signals/poll"
+
+	// configurationPath is the path of the transmitter configuration metadata.
+	configurationPath = "/.well-known/ssf-configuration"
+
+	// defaultPushTimeout is used when shared_signals.push_timeout is not configured.
+	defaultPushTimeout = 5 * time.Second
+
+	// defaultMaxEvents is the number of events returned by a poll that does not set maxEvents.
+	defaultMaxEvents = 100
+
+	// maxPollEvents is the largest number of events returned by a poll.
+	maxPollEvents = 100
+
+	// retryBatchSize is the largest number of events of a receiver retried at once.
+	retryBatchSize = 100
+
+	// specVersion is the version of the Shared Signals Framework the transmitter implements.
+	specVersion = "1_0"
+
+	// contentTypeSecEvent is the media type of a Security Event Token as defined in RFC 8417.
+	contentTypeSecEvent = "application/secevent+jwt"
+)
+
+// Delivery methods of the receivers, as configured and as identified by the Shared Signals Framework.
+const (
+	deliveryMethodPush    = "push"
+	deliveryMethodPoll    = "poll"
+	deliveryMethodPushURI = "urn:ietf:rfc:8935"
+	deliveryMethodPollURI = "urn:ietf:rfc:8936"
+)
+
+// Event types of the security events sent to the receivers.
+const (
+	// EventTypeSessionRevoked signals that a session of the subject is revoked (CAEP).
+	EventTypeSessionRevoked = "https://schemas.openid.net/secevent/caep/event-type/session-revoked"
+	// EventTypeCredentialChange signals that a credential of the subject is created, changed or removed (CAEP).
+	EventTypeCredentialChange = "https://schemas.openid.net/secevent/caep/event-type/credential-change"
+	// EventTypeAccountDisabled signals that the account of the subject can no longer sign in (RISC).
+	EventTypeAccountDisabled = "https://schemas.openid.net/secevent/risc/event-type/account-disabled"
+)
+
+// Claims of the Security Event Tokens and the payloads of their events.
+const (
+	claimEvents           = "events"
+	claimSubID            = "sub_id"
+	claimTxn              = "txn"
+	claimJti              = "jti"
+	claimAud              = "aud"
+	claimEventTimestamp   = "event_timestamp"
+	claimInitiatingEntity = "initiating_entity"
+	claimReasonAdmin      = "reason_admin"
+	claimCredentialType   = "credential_type"
+	claimChangeType       = "change_type"
+)
+
+// Values of the initiating_entity claim of the CAEP events.
+const (
+	initiatingEntityAdmin  = "admin"
+	initiatingEntityUser   = "user"
+	initiatingEntityPolicy = "policy"
+	initiatingEntitySystem = "system"
+)
+
+// Values of the change_type claim of the credential change events.
+const (
+	changeTypeUpdate = "update"
+	changeTypeDelete = "delete"
+)
+
+// subjectFormatIssSub is the format of a subject identified by its issuer and subject (RFC 9493).
+const subjectFormatIssSub = "iss_sub"
@@ -0,0 +1,68 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package sharedsignals
+
+import (
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/i18n/core"
+)
+
+// Client errors for shared signals operations.
+var (
+	// ErrorInvalidRequestFormat is the error returned when the poll request body is malformed.
+	ErrorInvalidRequestFormat = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "SSF-1001",
+		Error: core.I18nMessage{
+			Key:          "error.sharedsignalsservice.invalid_request_format",
+			DefaultValue: "Invalid request format",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.sharedsignalsservice.invalid_request_format_description",
+			DefaultValue: "The request body is malformed or contains invalid data",
+		},
+	}
+	// ErrorInvalidMaxEvents is the error returned when the maxEvents parameter of a poll is negative.
+	ErrorInvalidMaxEvents = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "SSF-1002",
+		Error: core.I18nMessage{
+			Key:          "error.sharedsignalsservice.invalid_max_events",
+			DefaultValue: "Invalid maxEvents parameter",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.sharedsignalsservice.invalid_max_events_description",
+			DefaultValue: "The maxEvents parameter must be a non-negative integer",
+		},
+	}
+	// ErrorReceiverNotAuthorized is the error returned when the caller of the poll endpoint is not a
+	// configured poll receiver.
+	ErrorReceiverNotAuthorized = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "SSF-1003",
+		Error: core.I18nMessage{
+			Key:          "error.sharedsignalsservice.receiver_not_authorized",
+			DefaultValue: "Receiver not authorized",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.sharedsignalsservice.receiver_not_authorized_description",
+			DefaultValue: "The caller is not a configured poll receiver of security events",
+		},
+	}
+)
@@ -0,0 +1,107 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package sharedsignals
+
+import (
+	"strings"
+
+	"github.com/thunder-id/thunderid/internal/system/observability/event"
+)
+
+// securityEvent is a security event of a user, carried by a Security Event Token.
+type securityEvent struct {
+	eventType string
+	userID    string
+	payload   map[string]interface{}
+	txn       string
+}
+
+// buildSecurityEvents returns the security events signalled by an event of the event bus. Events that
+// signal no security event return none. A credential change is signalled once per credential type, since a
+// Security Event Token carries a single event of each type.
+func buildSecurityEvents(evt *event.Event) []securityEvent {
+	if evt == nil {
+		return nil
+	}
+	userID, _ := evt.Data[event.DataKey.UserID].(string)
+	if userID == "" {
+		return nil
+	}
+	newEvent := func(eventType string, payload map[string]interface{}) securityEvent {
+		return securityEvent{eventType: eventType, userID: userID, payload: payload, txn: evt.TraceID}
+	}
+
+	switch event.EventType(evt.Type) {
+	case event.EventTypeRefreshTokenReuseDetected:
+		return []securityEvent{newEvent(EventTypeSessionRevoked, map[string]interface{}{
+			claimEventTimestamp:   evt.Timestamp.Unix(),
+			claimInitiatingEntity: initiatingEntityPolicy,
+			claimReasonAdmin:      map[string]string{"en": "Reuse of a rotated refresh token was detected"},
+		})}
+	case event.EventTypeUserCredentialsUpdated, event.EventTypeUserCredentialDeleted:
+		changeType := changeTypeUpdate
+		if evt.Type == string(event.EventTypeUserCredentialDeleted) {
+			changeType = changeTypeDelete
+		}
+		credentialTypes, _ := evt.Data[event.DataKey.CredentialTypes].(string)
+		var events []securityEvent
+		for _, credentialType := range strings.Split(credentialTypes, ",") {
+			if credentialType == "" {
+				continue
+			}
+			events = append(events, newEvent(EventTypeCredentialChange, map[string]interface{}{
+				claimEventTimestamp:   evt.Timestamp.Unix(),
+				claimInitiatingEntity: initiatingEntity(evt, userID),
+				claimCredentialType:   credentialType,
+				claimChangeType:       changeType,
+			}))
+		}
+		return events
+	case event.EventTypeUserDeleted:
+		return []securityEvent{newEvent(EventTypeAccountDisabled, map[string]interface{}{})}
+	case event.EventTypeAccountDeletionRequested:
+		if loginDisabled, _ := evt.Data[event.DataKey.LoginDisabled].(bool); loginDisabled {
+			return []securityEvent{newEvent(EventTypeAccountDisabled, map[string]interface{}{})}
+		}
+	}
+	return nil
+}
+
+// initiatingEntity returns who initiated the change to a user: the user, an administrator, or the system
+// when the change has no actor.
+func initiatingEntity(evt *event.Event, userID string) string {
+	actorID, _ := evt.Data[event.DataKey.ActorID].(string)
+	switch actorID {
+	case "":
+		return initiatingEntitySystem
+	case userID:
+		return initiatingEntityUser
+	default:
+		return initiatingEntityAdmin
+	}
+}
+
+// isSupportedEventType reports whether an event type URI is sent by the transmitter.
+func isSupportedEventType(eventType string) bool {
+	switch eventType {
+	case EventTypeSessionRevoked, EventTypeCredentialChange, EventTypeAccountDisabled:
+		return true
+	}
+	return false
+}
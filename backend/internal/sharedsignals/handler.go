@@ -0,0 +1,71 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package sharedsignals
+
+import (
+	"net/http"
+
+	"github.com/thunder-id/thunderid/internal/system/security"
+	sysutils "github.com/thunder-id/thunderid/internal/system/utils"
+)
+
+// sharedSignalsHandler is the handler for shared signals operations.
+type sharedSignalsHandler struct {
+	sharedSignalsService SharedSignalsServiceInterface
+}
+
+// newSharedSignalsHandler creates a new instance of sharedSignalsHandler.
+func newSharedSignalsHandler(sharedSignalsService SharedSignalsServiceInterface) *sharedSignalsHandler {
+	return &sharedSignalsHandler{
+		sharedSignalsService: sharedSignalsService,
+	}
+}
+
+// HandlePollRequest handles a poll of the events of the receiver of the calling client.
+func (h *sharedSignalsHandler) HandlePollRequest(w http.ResponseWriter, r *http.Request) {
+	identity := security.GetServiceIdentity(r.Context())
+	if identity == nil {
+		sysutils.WriteServiceErrorResponse(w, &ErrorReceiverNotAuthorized, clientErrorStatusCodes)
+		return
+	}
+	request, err := sysutils.DecodeJSONBody[PollRequest](r)
+	if err != nil {
+		sysutils.WriteServiceErrorResponse(w, &ErrorInvalidRequestFormat, clientErrorStatusCodes)
+		return
+	}
+
+	response, svcErr := h.sharedSignalsService.Poll(r.Context(), identity.ClientID, request)
+	if svcErr != nil {
+		sysutils.WriteServiceErrorResponse(w, svcErr, clientErrorStatusCodes)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(w, http.StatusOK, response)
+}
+
+// HandleConfigurationRequest handles the request for the configuration metadata of the transmitter.
+func (h *sharedSignalsHandler) HandleConfigurationRequest(w http.ResponseWriter, r *http.Request) {
+	sysutils.WriteSuccessResponse(w, http.StatusOK, h.sharedSignalsService.GetTransmitterConfiguration())
+}
+
+// clientErrorStatusCodes maps the client errors of the handler to HTTP status codes. Other client errors are
+// returned with 400 Bad Request.
+var clientErrorStatusCodes = map[string]int{
+	ErrorReceiverNotAuthorized.Code: http.StatusForbidden,
+}
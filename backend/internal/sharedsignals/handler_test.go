@@ -0,0 +1,136 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package sharedsignals
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/system/error/apierror"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/security"
+)
+
+type HandlerTestSuite struct {
+	suite.Suite
+	mockService *SharedSignalsServiceInterfaceMock
+	handler     *sharedSignalsHandler
+}
+
+func TestHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(HandlerTestSuite))
+}
+
+func (s *HandlerTestSuite) SetupTest() {
+	s.mockService = NewSharedSignalsServiceInterfaceMock(s.T())
+	s.handler = newSharedSignalsHandler(s.mockService)
+}
+
+func (s *HandlerTestSuite) newPollRequest(body string, attributes map[string]interface{}) *http.Request {
+	req := httptest.NewRequest(http.MethodPost, pollPath, strings.NewReader(body))
+	if attributes != nil {
+		req = req.WithContext(security.WithSecurityContextTest(req.Context(),
+			security.NewSecurityContextForTest("app-1", "", "", nil, attributes)))
+	}
+	return req
+}
+
+func serviceAttributes(clientID string) map[string]interface{} {
+	return map[string]interface{}{"sub_type": "service", "service_id": "app-1", "client_id": clientID}
+}
+
+func (s *HandlerTestSuite) TestHandlePollRequest() {
+	s.mockService.On("Poll", mock.Anything, testClientID, mock.MatchedBy(func(request *PollRequest) bool {
+		return *request.MaxEvents == 5 && request.ReturnImmediately && len(request.Ack) == 1 &&
+			request.SetErrs["event-2"].Err == "invalid_key"
+	})).Return(&PollResponse{Sets: map[string]string{"event-3": "token-3"}, MoreAvailable: true}, nil).Once()
+
+	rr := httptest.NewRecorder()
+	s.handler.HandlePollRequest(rr, s.newPollRequest(`{"maxEvents":5,"returnImmediately":true,`+
+		`"ack":["event-1"],"setErrs":{"event-2":{"err":"invalid_key","description":"Unknown key"}}}`,
+		serviceAttributes(testClientID)))
+
+	s.Equal(http.StatusOK, rr.Code)
+	var body PollResponse
+	s.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &body))
+	s.Equal("token-3", body.Sets["event-3"])
+	s.True(body.MoreAvailable)
+}
+
+func (s *HandlerTestSuite) TestHandlePollRequest_NotAServiceCaller() {
+	rr := httptest.NewRecorder()
+	s.handler.HandlePollRequest(rr, s.newPollRequest(`{}`, map[string]interface{}{}))
+
+	s.Equal(http.StatusForbidden, rr.Code)
+	var body apierror.ErrorResponse
+	s.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &body))
+	s.Equal(ErrorReceiverNotAuthorized.Code, body.Code)
+}
+
+func (s *HandlerTestSuite) TestHandlePollRequest_InvalidBody() {
+	rr := httptest.NewRecorder()
+	s.handler.HandlePollRequest(rr, s.newPollRequest(`{"maxEvents":"all"}`, serviceAttributes(testClientID)))
+
+	s.Equal(http.StatusBadRequest, rr.Code)
+	var body apierror.ErrorResponse
+	s.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &body))
+	s.Equal(ErrorInvalidRequestFormat.Code, body.Code)
+}
+
+func (s *HandlerTestSuite) TestHandlePollRequest_ServiceErrors() {
+	cases := []struct {
+		svcErr     *serviceerror.ServiceError
+		statusCode int
+	}{
+		{&ErrorReceiverNotAuthorized, http.StatusForbidden},
+		{&ErrorInvalidMaxEvents, http.StatusBadRequest},
+		{&serviceerror.InternalServerError, http.StatusInternalServerError},
+	}
+	for _, tc := range cases {
+		s.mockService.On("Poll", mock.Anything, testClientID, mock.Anything).Return(nil, tc.svcErr).Once()
+
+		rr := httptest.NewRecorder()
+		s.handler.HandlePollRequest(rr, s.newPollRequest(`{}`, serviceAttributes(testClientID)))
+
+		s.Equal(tc.statusCode, rr.Code, tc.svcErr.Code)
+	}
+}
+
+func (s *HandlerTestSuite) TestHandleConfigurationRequest() {
+	s.mockService.On("GetTransmitterConfiguration").Return(&TransmitterConfiguration{
+		SpecVersion: specVersion, Issuer: testIssuer, JWKSURI: testIssuer + "/oauth2/jwks",
+		DeliveryMethodsSupported: []string{deliveryMethodPushURI, deliveryMethodPollURI},
+	}).Once()
+
+	rr := httptest.NewRecorder()
+	s.handler.HandleConfigurationRequest(rr, httptest.NewRequest(http.MethodGet, configurationPath, nil))
+
+	s.Equal(http.StatusOK, rr.Code)
+	var body map[string]interface{}
+	s.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &body))
+	s.Equal(testIssuer, body["issuer"])
+	s.Equal(testIssuer+"/oauth2/jwks", body["jwks_uri"])
+	s.Len(body["delivery_methods_supported"], 2)
+}
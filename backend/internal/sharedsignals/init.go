@@ -0,0 +1,135 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package sharedsignals
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
+	"github.com/thunder-id/thunderid/internal/system/config"
+	syshttp "github.com/thunder-id/thunderid/internal/system/http"
+	"github.com/thunder-id/thunderid/internal/system/jose/jwt"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/internal/system/middleware"
+	"github.com/thunder-id/thunderid/internal/system/observability"
+	"github.com/thunder-id/thunderid/internal/system/scheduler"
+	"github.com/thunder-id/thunderid/internal/system/security"
+)
+
+// Initialize initializes the shared signals service, subscribes it to the audit events of the event bus,
+// registers its routes and starts the scheduled retries of push deliveries. The service is not initialized
+// when shared signals are disabled.
+func Initialize(
+	mux *http.ServeMux,
+	jwtService jwt.JWTServiceInterface,
+	jobScheduler scheduler.SchedulerInterface,
+	observabilitySvc observability.ObservabilityServiceInterface,
+) SharedSignalsServiceInterface {
+	runtimeConfig := config.GetServerRuntime().Config
+	sharedSignalsConfig := runtimeConfig.SharedSignals
+	if !sharedSignalsConfig.Enabled {
+		return nil
+	}
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName))
+
+	receivers := make([]receiver, 0, len(sharedSignalsConfig.Receivers))
+	for _, receiverConfig := range sharedSignalsConfig.Receivers {
+		for _, eventType := range receiverConfig.Events {
+			if !isSupportedEventType(eventType) {
+				logger.Warn("Receiver is configured with an unsupported event type",
+					log.String("receiverID", receiverConfig.ID), log.String("eventType", eventType))
+			}
+		}
+		receivers = append(receivers, receiver{
+			ID:                  receiverConfig.ID,
+			TenantID:            receiverConfig.Tenant,
+			Audience:            receiverConfig.Audience,
+			DeliveryMethod:      receiverConfig.DeliveryMethod,
+			EndpointURL:         receiverConfig.EndpointURL,
+			AuthorizationHeader: receiverConfig.AuthorizationHeader,
+			ClientID:            receiverConfig.ClientID,
+			Events:              receiverConfig.Events,
+		})
+	}
+	pushTimeout := time.Duration(sharedSignalsConfig.PushTimeout) * time.Second
+	if pushTimeout <= 0 {
+		pushTimeout = defaultPushTimeout
+	}
+
+	sharedSignalsService := newSharedSignalsService(newSecurityEventStore(), jwtService,
+		syshttp.NewHTTPClientWithTimeout(pushTimeout), receivers, runtimeConfig.JWT.Issuer,
+		config.GetServerURL(&runtimeConfig.Server)+constants.OAuth2JWKSEndpoint,
+		time.Duration(sharedSignalsConfig.Retention)*time.Second, pushTimeout)
+
+	if publisher := observabilitySvc.GetPublisher(); publisher != nil {
+		publisher.Subscribe(newSharedSignalsSubscriber(sharedSignalsService))
+	} else {
+		logger.Warn("Observability is disabled, so no security events are transmitted")
+	}
+
+	registerRoutes(mux, newSharedSignalsHandler(sharedSignalsService))
+
+	jobScheduler.Schedule(retryLockName, time.Duration(sharedSignalsConfig.RetryInterval)*time.Second,
+		newScheduledRetry(sharedSignalsService))
+
+	return sharedSignalsService
+}
+
+// registerRoutes registers the routes for shared signals operations.
+func registerRoutes(mux *http.ServeMux, sharedSignalsHandler *sharedSignalsHandler) {
+	opts := middleware.CORSOptions{
+		AllowedMethods:   []string{"GET", "POST"},
+		AllowedHeaders:   middleware.DefaultAllowedHeaders,
+		AllowCredentials: true,
+		MaxAge:           600,
+	}
+	mux.HandleFunc(middleware.WithCORS("POST "+pollPath, sharedSignalsHandler.HandlePollRequest, opts))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS "+pollPath,
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, opts))
+	mux.HandleFunc(middleware.WithCORS("GET "+configurationPath,
+		sharedSignalsHandler.HandleConfigurationRequest, opts))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS "+configurationPath,
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, opts))
+}
+
+// newScheduledRetry returns the scheduled job that deletes the expired events and retries the failed push
+// deliveries.
+func newScheduledRetry(service SharedSignalsServiceInterface) scheduler.JobFunc {
+	return func(ctx context.Context) error {
+		logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName))
+		ctx = security.WithRuntimeContext(ctx)
+
+		deleted, deleteErr := service.DeleteExpiredEvents(ctx)
+		if deleted > 0 {
+			logger.Debug("Deleted expired security events", log.Int("count", deleted))
+		}
+		delivered, retryErr := service.RetryPushDeliveries(ctx)
+		if delivered > 0 {
+			logger.Debug("Delivered security events on retry", log.Int("count", delivered))
+		}
+		return errors.Join(deleteErr, retryErr)
+	}
+}
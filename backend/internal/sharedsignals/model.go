@@ -0,0 +1,94 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package sharedsignals provides the transmitter of the Shared Signals Framework. Security relevant events
+// published on the observability event bus, such as the revocation of a session, the change of a credential
+// or the disabling of an account, are sent to the configured receivers as Security Event Tokens (RFC 8417),
+// either pushed to the endpoint of the receiver (RFC 8935) or held for the receiver to poll (RFC 8936).
+package sharedsignals
+
+import (
+	"slices"
+	"time"
+)
+
+// receiver is a configured receiver of security events.
+type receiver struct {
+	ID                  string
+	TenantID            string
+	Audience            string
+	DeliveryMethod      string
+	EndpointURL         string
+	AuthorizationHeader string
+	ClientID            string
+	Events              []string
+}
+
+// accepts reports whether the receiver is sent the events of an event type.
+func (r receiver) accepts(eventType string) bool {
+	return len(r.Events) == 0 || slices.Contains(r.Events, eventType)
+}
+
+// SecurityEvent represents a Security Event Token awaiting delivery to, or acknowledgement by, its receiver.
+type SecurityEvent struct {
+	ID         string
+	ReceiverID string
+	EventType  string
+	Token      string
+	Attempts   int
+	CreatedAt  time.Time
+}
+
+// PollRequest represents a poll of the events of a receiver as defined in RFC 8936.
+type PollRequest struct {
+	// MaxEvents is the largest number of events to return. Zero only acknowledges events.
+	MaxEvents *int `json:"maxEvents,omitempty"`
+	// ReturnImmediately asks not to wait for events. Polls are always answered immediately.
+	ReturnImmediately bool `json:"returnImmediately,omitempty"`
+	// Ack lists the IDs of the events the receiver has processed.
+	Ack []string `json:"ack,omitempty"`
+	// SetErrs maps the IDs of the events the receiver could not process to the error.
+	SetErrs map[string]SETError `json:"setErrs,omitempty"`
+}
+
+// SETError represents the error of a receiver processing a Security Event Token.
+type SETError struct {
+	Err         string `json:"err"`
+	Description string `json:"description"`
+}
+
+// PollResponse represents the events returned to a poll, keyed by their IDs.
+type PollResponse struct {
+	Sets          map[string]string `json:"sets"`
+	MoreAvailable bool              `json:"moreAvailable,omitempty"`
+}
+
+// TransmitterConfiguration represents the configuration metadata of the transmitter.
+type TransmitterConfiguration struct {
+	SpecVersion              string   `json:"spec_version"`
+	Issuer                   string   `json:"issuer"`
+	JWKSURI                  string   `json:"jwks_uri"`
+	DeliveryMethodsSupported []string `json:"delivery_methods_supported"`
+}
+
+// subjectIdentifier identifies the subject of a security event (RFC 9493).
+type subjectIdentifier struct {
+	Format string `json:"format"`
+	Iss    string `json:"iss"`
+	Sub    string `json:"sub"`
+}
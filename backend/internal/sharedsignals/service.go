@@ -0,0 +1,312 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package sharedsignals
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	serverconst "github.com/thunder-id/thunderid/internal/system/constants"
+	sysContext "github.com/thunder-id/thunderid/internal/system/context"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	syshttp "github.com/thunder-id/thunderid/internal/system/http"
+	"github.com/thunder-id/thunderid/internal/system/jose/jwt"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/internal/system/observability/event"
+	"github.com/thunder-id/thunderid/internal/system/utils"
+)
+
+// SharedSignalsServiceInterface defines the interface for the shared signals service.
+type SharedSignalsServiceInterface interface {
+	TransmitEvent(ctx context.Context, evt *event.Event) error
+	Poll(ctx context.Context, clientID string, request *PollRequest) (*PollResponse, *serviceerror.ServiceError)
+	RetryPushDeliveries(ctx context.Context) (int, error)
+	DeleteExpiredEvents(ctx context.Context) (int, error)
+	GetTransmitterConfiguration() *TransmitterConfiguration
+}
+
+// sharedSignalsService is the default implementation of the SharedSignalsServiceInterface.
+type sharedSignalsService struct {
+	store       securityEventStoreInterface
+	jwtService  jwt.JWTServiceInterface
+	httpClient  syshttp.HTTPClientInterface
+	receivers   []receiver
+	issuer      string
+	jwksURI     string
+	retention   time.Duration
+	pushTimeout time.Duration
+	now         func() time.Time
+	logger      *log.Logger
+}
+
+// newSharedSignalsService creates a new instance of sharedSignalsService. Security events are issued by the
+// issuer and kept for their receivers for the retention period.
+func newSharedSignalsService(
+	store securityEventStoreInterface,
+	jwtService jwt.JWTServiceInterface,
+	httpClient syshttp.HTTPClientInterface,
+	receivers []receiver,
+	issuer, jwksURI string,
+	retention, pushTimeout time.Duration,
+) SharedSignalsServiceInterface {
+	return &sharedSignalsService{
+		store:       store,
+		jwtService:  jwtService,
+		httpClient:  httpClient,
+		receivers:   receivers,
+		issuer:      issuer,
+		jwksURI:     jwksURI,
+		retention:   retention,
+		pushTimeout: pushTimeout,
+		now:         time.Now,
+		logger:      log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName)),
+	}
+}
+
+// TransmitEvent sends the security events signalled by an event to the receivers of the tenant that are sent
+// their event types. The events are stored for their receivers, and the events of push receivers are pushed
+// at once. Events that signal no security event are ignored.
+func (s *sharedSignalsService) TransmitEvent(ctx context.Context, evt *event.Event) error {
+	var errs []error
+	receivers := s.tenantReceivers(ctx)
+	for _, secEvent := range buildSecurityEvents(evt) {
+		for _, r := range receivers {
+			if !r.accepts(secEvent.eventType) {
+				continue
+			}
+			securityEvent, err := s.createSecurityEvent(ctx, r, secEvent)
+			if err != nil {
+				errs = append(errs, err)
+				continue
+			}
+			if r.DeliveryMethod == deliveryMethodPush {
+				s.deliver(ctx, r, *securityEvent)
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Poll acknowledges the events the receiver of the client in the tenant has processed and returns its oldest
+// pending events (RFC 8936). Events the receiver reports errors for are logged and removed like acknowledged
+// ones.
+func (s *sharedSignalsService) Poll(ctx context.Context, clientID string,
+	request *PollRequest) (*PollResponse, *serviceerror.ServiceError) {
+	receivers := s.tenantReceivers(ctx)
+	idx := slices.IndexFunc(receivers, func(r receiver) bool {
+		return r.DeliveryMethod == deliveryMethodPoll && r.ClientID == clientID
+	})
+	if clientID == "" || idx == -1 {
+		return nil, &ErrorReceiverNotAuthorized
+	}
+	r := receivers[idx]
+	maxEvents := defaultMaxEvents
+	if request.MaxEvents != nil {
+		if *request.MaxEvents < 0 {
+			return nil, &ErrorInvalidMaxEvents
+		}
+		maxEvents = min(*request.MaxEvents, maxPollEvents)
+	}
+	logger := s.logger.With(log.String("receiverID", r.ID))
+
+	for id, setErr := range request.SetErrs {
+		logger.Warn("Receiver failed to process security event", log.String("eventID", id),
+			log.String("err", setErr.Err), log.String("description", setErr.Description))
+	}
+	processed := slices.AppendSeq(slices.Clone(request.Ack), maps.Keys(request.SetErrs))
+	if _, err := s.store.DeleteSecurityEvents(ctx, r.ID, processed); err != nil {
+		logger.Error("Failed to delete processed security events", log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+
+	securityEvents, err := s.store.ListSecurityEvents(ctx, r.ID, s.now().UTC(), maxEvents+1)
+	if err != nil {
+		logger.Error("Failed to list security events", log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+	securityEvents, hasMore := utils.TrimPage(securityEvents, maxEvents)
+
+	response := &PollResponse{
+		Sets:          make(map[string]string, len(securityEvents)),
+		MoreAvailable: hasMore,
+	}
+	for _, securityEvent := range securityEvents {
+		response.Sets[securityEvent.ID] = securityEvent.Token
+	}
+	return response, nil
+}
+
+// RetryPushDeliveries pushes the pending events of every push receiver of the tenant again, oldest first, and
+// returns the number of events delivered. Events created within the push timeout may still be in flight and
+// are left for the next run. The retries of a receiver stop at its first failed delivery.
+func (s *sharedSignalsService) RetryPushDeliveries(ctx context.Context) (int, error) {
+	delivered := 0
+	before := s.now().UTC().Add(-s.pushTimeout)
+	for _, r := range s.tenantReceivers(ctx) {
+		if r.DeliveryMethod != deliveryMethodPush {
+			continue
+		}
+		securityEvents, err := s.store.ListSecurityEvents(ctx, r.ID, before, retryBatchSize)
+		if err != nil {
+			return delivered, fmt.Errorf("failed to list security events of receiver %s: %w", r.ID, err)
+		}
+		for _, securityEvent := range securityEvents {
+			if !s.deliver(ctx, r, securityEvent) {
+				break
+			}
+			delivered++
+		}
+	}
+	return delivered, nil
+}
+
+// DeleteExpiredEvents deletes the undelivered events older than the retention period and returns the number
+// of events deleted.
+func (s *sharedSignalsService) DeleteExpiredEvents(ctx context.Context) (int, error) {
+	if s.retention <= 0 {
+		return 0, nil
+	}
+	return s.store.DeleteSecurityEventsBefore(ctx, s.now().UTC().Add(-s.retention))
+}
+
+// tenantReceivers returns the receivers of the tenant the context is scoped to.
+func (s *sharedSignalsService) tenantReceivers(ctx context.Context) []receiver {
+	tenantID := sysContext.GetTenantID(ctx)
+	receivers := make([]receiver, 0, len(s.receivers))
+	for _, r := range s.receivers {
+		if r.TenantID == tenantID {
+			receivers = append(receivers, r)
+		}
+	}
+	return receivers
+}
+
+// GetTransmitterConfiguration returns the configuration metadata of the transmitter.
+func (s *sharedSignalsService) GetTransmitterConfiguration() *TransmitterConfiguration {
+	return &TransmitterConfiguration{
+		SpecVersion:              specVersion,
+		Issuer:                   s.issuer,
+		JWKSURI:                  s.jwksURI,
+		DeliveryMethodsSupported: []string{deliveryMethodPushURI, deliveryMethodPollURI},
+	}
+}
+
+// createSecurityEvent issues the Security Event Token of a security event for a receiver and stores it.
+func (s *sharedSignalsService) createSecurityEvent(ctx context.Context, r receiver,
+	secEvent securityEvent) (*SecurityEvent, error) {
+	id, err := utils.GenerateUUIDv7()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate security event ID: %w", err)
+	}
+
+	claims := map[string]interface{}{
+		claimJti:    id,
+		claimAud:    r.Audience,
+		claimSubID:  subjectIdentifier{Format: subjectFormatIssSub, Iss: s.issuer, Sub: secEvent.userID},
+		claimEvents: map[string]interface{}{secEvent.eventType: secEvent.payload},
+	}
+	if secEvent.txn != "" {
+		claims[claimTxn] = secEvent.txn
+	}
+	token, _, svcErr := s.jwtService.GenerateJWT(ctx, secEvent.userID, s.issuer, int64(s.retention.Seconds()),
+		claims, jwt.TokenTypeSecurityEventToken, "")
+	if svcErr != nil {
+		return nil, fmt.Errorf("failed to generate security event token: %s", svcErr.Error.DefaultValue)
+	}
+
+	securityEvent := &SecurityEvent{
+		ID:         id,
+		ReceiverID: r.ID,
+		EventType:  secEvent.eventType,
+		Token:      token,
+		CreatedAt:  s.now().UTC(),
+	}
+	if err := s.store.CreateSecurityEvent(ctx, *securityEvent); err != nil {
+		return nil, fmt.Errorf("failed to create security event: %w", err)
+	}
+	return securityEvent, nil
+}
+
+// deliver pushes a pending event to its receiver and reports whether the receiver took it. An event the
+// receiver accepts or rejects is removed, while any other failure is counted and the event is retried later.
+func (s *sharedSignalsService) deliver(ctx context.Context, r receiver, securityEvent SecurityEvent) bool {
+	logger := s.logger.With(log.String("receiverID", r.ID), log.String("eventID", securityEvent.ID))
+
+	setErr, err := s.push(ctx, r, securityEvent.Token)
+	if err != nil {
+		logger.Debug("Failed to push security event", log.Int("attempts", securityEvent.Attempts+1),
+			log.Error(err))
+		if err := s.store.IncrementAttempts(ctx, securityEvent.ID); err != nil {
+			logger.Error("Failed to record the failed delivery of security event", log.Error(err))
+		}
+		return false
+	}
+	if setErr != nil {
+		logger.Warn("Receiver rejected security event", log.String("err", setErr.Err),
+			log.String("description", setErr.Description))
+	}
+
+	if _, err := s.store.DeleteSecurityEvents(ctx, r.ID, []string{securityEvent.ID}); err != nil {
+		logger.Error("Failed to delete delivered security event", log.Error(err))
+	}
+	return true
+}
+
+// push posts a Security Event Token to the endpoint of a receiver (RFC 8935). The error the receiver
+// rejects the token with is returned when it responds with a SET error.
+func (s *sharedSignalsService) push(ctx context.Context, r receiver, token string) (*SETError, error) {
+	// The delivery must complete even if the request that triggered the event finishes first.
+	reqCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), s.pushTimeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(reqCtx, http.MethodPost, r.EndpointURL, strings.NewReader(token))
+	if err != nil {
+		return nil, fmt.Errorf("failed to build the push request: %w", err)
+	}
+	req.Header.Set(serverconst.ContentTypeHeaderName, contentTypeSecEvent)
+	req.Header.Set(serverconst.AcceptHeaderName, serverconst.ContentTypeJSON)
+	if r.AuthorizationHeader != "" {
+		req.Header.Set(serverconst.AuthorizationHeaderName, r.AuthorizationHeader)
+	}
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("the push request failed: %w", err)
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	switch resp.StatusCode {
+	case http.StatusAccepted:
+		return nil, nil
+	case http.StatusBadRequest:
+		var setErr SETError
+		if err := json.NewDecoder(resp.Body).Decode(&setErr); err == nil && setErr.Err != "" {
+			return &setErr, nil
+		}
+	}
+	return nil, fmt.Errorf("the receiver responded with status %d", resp.StatusCode)
+}
@@ -0,0 +1,406 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package sharedsignals
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	sysContext "github.com/thunder-id/thunderid/internal/system/context"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/jose/jwt"
+	"github.com/thunder-id/thunderid/internal/system/observability/event"
+	"github.com/thunder-id/thunderid/tests/mocks/httpmock"
+	"github.com/thunder-id/thunderid/tests/mocks/jose/jwtmock"
+)
+
+const (
+	testIssuer   = "https://thunder.test"
+	testUserID   = "user-1"
+	testAdminID  = "admin-1"
+	testClientID = "poll-client"
+	testToken    = "header.payload.signature"
+)
+
+type SharedSignalsServiceTestSuite struct {
+	suite.Suite
+	mockStore      *securityEventStoreInterfaceMock
+	mockJWTService *jwtmock.JWTServiceInterfaceMock
+	mockHTTPClient *httpmock.HTTPClientInterfaceMock
+	service        *sharedSignalsService
+	now            time.Time
+}
+
+func TestSharedSignalsServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(SharedSignalsServiceTestSuite))
+}
+
+func (suite *SharedSignalsServiceTestSuite) SetupTest() {
+	suite.mockStore = newSecurityEventStoreInterfaceMock(suite.T())
+	suite.mockJWTService = jwtmock.NewJWTServiceInterfaceMock(suite.T())
+	suite.mockHTTPClient = httpmock.NewHTTPClientInterfaceMock(suite.T())
+	receivers := []receiver{
+		{ID: "push-receiver", Audience: "https://push.test", DeliveryMethod: deliveryMethodPush,
+			EndpointURL: "https://push.test/events", AuthorizationHeader: "Bearer push-secret"},
+		{ID: "poll-receiver", Audience: "https://poll.test", DeliveryMethod: deliveryMethodPoll,
+			ClientID: testClientID, Events: []string{EventTypeSessionRevoked}},
+		{ID: "tenant-receiver", TenantID: "tenant-1", Audience: "https://tenant.test",
+			DeliveryMethod: deliveryMethodPoll, ClientID: "tenant-client"},
+	}
+	suite.service = newSharedSignalsService(suite.mockStore, suite.mockJWTService, suite.mockHTTPClient,
+		receivers, testIssuer, testIssuer+"/oauth2/jwks", time.Hour, 5*time.Second).(*sharedSignalsService)
+	suite.now = time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	suite.service.now = func() time.Time { return suite.now }
+}
+
+func newEvent(eventType event.EventType, data map[string]interface{}) *event.Event {
+	evt := event.NewEvent("trace-1", string(eventType), event.ComponentUserManagement)
+	evt.Timestamp = time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	for key, value := range data {
+		evt.WithData(key, value)
+	}
+	return evt
+}
+
+func response(statusCode int, body string) *http.Response {
+	return &http.Response{StatusCode: statusCode, Body: io.NopCloser(strings.NewReader(body))}
+}
+
+func (suite *SharedSignalsServiceTestSuite) TestBuildSecurityEvents() {
+	timestamp := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC).Unix()
+
+	events := buildSecurityEvents(newEvent(event.EventTypeRefreshTokenReuseDetected, map[string]interface{}{
+		event.DataKey.UserID: testUserID, event.DataKey.ClientID: "client-1",
+	}))
+	suite.Require().Len(events, 1)
+	suite.Equal(EventTypeSessionRevoked, events[0].eventType)
+	suite.Equal(testUserID, events[0].userID)
+	suite.Equal("trace-1", events[0].txn)
+	suite.Equal(timestamp, events[0].payload[claimEventTimestamp])
+	suite.Equal(initiatingEntityPolicy, events[0].payload[claimInitiatingEntity])
+
+	events = buildSecurityEvents(newEvent(event.EventTypeUserCredentialsUpdated, map[string]interface{}{
+		event.DataKey.UserID: testUserID, event.DataKey.CredentialTypes: "passkey,password",
+		event.DataKey.ActorID: testUserID,
+	}))
+	suite.Require().Len(events, 2)
+	suite.Equal(EventTypeCredentialChange, events[0].eventType)
+	suite.Equal("passkey", events[0].payload[claimCredentialType])
+	suite.Equal("password", events[1].payload[claimCredentialType])
+	suite.Equal(changeTypeUpdate, events[1].payload[claimChangeType])
+	suite.Equal(initiatingEntityUser, events[1].payload[claimInitiatingEntity])
+
+	events = buildSecurityEvents(newEvent(event.EventTypeUserCredentialDeleted, map[string]interface{}{
+		event.DataKey.UserID: testUserID, event.DataKey.CredentialTypes: "password",
+		event.DataKey.ActorID: testAdminID,
+	}))
+	suite.Require().Len(events, 1)
+	suite.Equal(changeTypeDelete, events[0].payload[claimChangeType])
+	suite.Equal(initiatingEntityAdmin, events[0].payload[claimInitiatingEntity])
+
+	events = buildSecurityEvents(newEvent(event.EventTypeUserDeleted, map[string]interface{}{
+		event.DataKey.UserID: testUserID,
+	}))
+	suite.Require().Len(events, 1)
+	suite.Equal(EventTypeAccountDisabled, events[0].eventType)
+
+	events = buildSecurityEvents(newEvent(event.EventTypeAccountDeletionRequested, map[string]interface{}{
+		event.DataKey.UserID: testUserID, event.DataKey.LoginDisabled: true,
+	}))
+	suite.Require().Len(events, 1)
+	suite.Equal(EventTypeAccountDisabled, events[0].eventType)
+}
+
+func (suite *SharedSignalsServiceTestSuite) TestBuildSecurityEvents_NoSecurityEvent() {
+	suite.Empty(buildSecurityEvents(nil))
+	suite.Empty(buildSecurityEvents(newEvent(event.EventTypeAccountDeletionRequested, map[string]interface{}{
+		event.DataKey.UserID: testUserID,
+	})))
+	suite.Empty(buildSecurityEvents(newEvent(event.EventTypeUserDeleted, nil)))
+	suite.Empty(buildSecurityEvents(newEvent(event.EventTypeUserCreated, map[string]interface{}{
+		event.DataKey.UserID: testUserID,
+	})))
+	suite.Empty(buildSecurityEvents(newEvent(event.EventTypeUserCredentialsUpdated, map[string]interface{}{
+		event.DataKey.UserID: testUserID,
+	})))
+}
+
+func (suite *SharedSignalsServiceTestSuite) expectToken(audience, eventType string) {
+	suite.mockJWTService.On("GenerateJWT", mock.Anything, testUserID, testIssuer, int64(3600),
+		mock.MatchedBy(func(claims map[string]interface{}) bool {
+			events, _ := claims[claimEvents].(map[string]interface{})
+			subID, _ := claims[claimSubID].(subjectIdentifier)
+			return claims[claimAud] == audience && claims[claimJti] != "" && claims[claimTxn] == "trace-1" &&
+				events[eventType] != nil && subID == subjectIdentifier{Format: subjectFormatIssSub,
+				Iss: testIssuer, Sub: testUserID}
+		}), jwt.TokenTypeSecurityEventToken, "").Return(testToken, int64(0), nil).Once()
+}
+
+func (suite *SharedSignalsServiceTestSuite) TestTransmitEvent_Pushed() {
+	suite.expectToken("https://push.test", EventTypeCredentialChange)
+	var stored SecurityEvent
+	suite.mockStore.On("CreateSecurityEvent", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		stored = args.Get(1).(SecurityEvent)
+	}).Return(nil).Once()
+	suite.mockHTTPClient.On("Do", mock.MatchedBy(func(req *http.Request) bool {
+		body, _ := io.ReadAll(req.Body)
+		return req.URL.String() == "https://push.test/events" && string(body) == testToken &&
+			req.Header.Get("Content-Type") == contentTypeSecEvent &&
+			req.Header.Get("Authorization") == "Bearer push-secret"
+	})).Return(response(http.StatusAccepted, ""), nil).Once()
+	suite.mockStore.On("DeleteSecurityEvents", mock.Anything, "push-receiver", mock.Anything).
+		Return(1, nil).Once()
+
+	err := suite.service.TransmitEvent(context.Background(), newEvent(event.EventTypeUserCredentialsUpdated,
+		map[string]interface{}{event.DataKey.UserID: testUserID, event.DataKey.CredentialTypes: "password"}))
+
+	suite.NoError(err)
+	suite.Equal("push-receiver", stored.ReceiverID)
+	suite.Equal(EventTypeCredentialChange, stored.EventType)
+	suite.Equal(testToken, stored.Token)
+	suite.Equal(suite.now, stored.CreatedAt)
+	suite.mockStore.AssertCalled(suite.T(), "DeleteSecurityEvents", mock.Anything, "push-receiver",
+		[]string{stored.ID})
+}
+
+func (suite *SharedSignalsServiceTestSuite) TestTransmitEvent_HeldForPollReceiver() {
+	suite.expectToken("https://push.test", EventTypeSessionRevoked)
+	suite.expectToken("https://poll.test", EventTypeSessionRevoked)
+	suite.mockStore.On("CreateSecurityEvent", mock.Anything, mock.MatchedBy(func(e SecurityEvent) bool {
+		return e.ReceiverID == "push-receiver"
+	})).Return(nil).Once()
+	suite.mockStore.On("CreateSecurityEvent", mock.Anything, mock.MatchedBy(func(e SecurityEvent) bool {
+		return e.ReceiverID == "poll-receiver"
+	})).Return(nil).Once()
+	suite.mockHTTPClient.On("Do", mock.Anything).Return(nil, errors.New("connection refused")).Once()
+	suite.mockStore.On("IncrementAttempts", mock.Anything, mock.Anything).Return(nil).Once()
+
+	err := suite.service.TransmitEvent(context.Background(), newEvent(event.EventTypeRefreshTokenReuseDetected,
+		map[string]interface{}{event.DataKey.UserID: testUserID}))
+
+	suite.NoError(err)
+	suite.mockStore.AssertNotCalled(suite.T(), "DeleteSecurityEvents", mock.Anything, mock.Anything,
+		mock.Anything)
+}
+
+func (suite *SharedSignalsServiceTestSuite) TestTransmitEvent_RejectedByReceiver() {
+	suite.expectToken("https://push.test", EventTypeAccountDisabled)
+	suite.mockStore.On("CreateSecurityEvent", mock.Anything, mock.Anything).Return(nil).Once()
+	suite.mockHTTPClient.On("Do", mock.Anything).Return(response(http.StatusBadRequest,
+		`{"err":"invalid_audience","description":"The audience is not recognized"}`), nil).Once()
+	suite.mockStore.On("DeleteSecurityEvents", mock.Anything, "push-receiver", mock.Anything).
+		Return(1, nil).Once()
+
+	err := suite.service.TransmitEvent(context.Background(), newEvent(event.EventTypeUserDeleted,
+		map[string]interface{}{event.DataKey.UserID: testUserID}))
+
+	suite.NoError(err)
+	suite.mockStore.AssertNotCalled(suite.T(), "IncrementAttempts", mock.Anything, mock.Anything)
+}
+
+func (suite *SharedSignalsServiceTestSuite) TestTransmitEvent_StoreError() {
+	suite.expectToken("https://push.test", EventTypeAccountDisabled)
+	suite.mockStore.On("CreateSecurityEvent", mock.Anything, mock.Anything).Return(errors.New("db error")).Once()
+
+	err := suite.service.TransmitEvent(context.Background(), newEvent(event.EventTypeUserDeleted,
+		map[string]interface{}{event.DataKey.UserID: testUserID}))
+
+	suite.Error(err)
+	suite.mockHTTPClient.AssertNotCalled(suite.T(), "Do", mock.Anything)
+}
+
+func (suite *SharedSignalsServiceTestSuite) TestTransmitEvent_NoSecurityEvent() {
+	err := suite.service.TransmitEvent(context.Background(), newEvent(event.EventTypeUserCreated,
+		map[string]interface{}{event.DataKey.UserID: testUserID}))
+
+	suite.NoError(err)
+	suite.mockJWTService.AssertNotCalled(suite.T(), "GenerateJWT", mock.Anything, mock.Anything, mock.Anything,
+		mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *SharedSignalsServiceTestSuite) TestTransmitEvent_TenantEventReachesOnlyTenantReceivers() {
+	ctx := sysContext.WithTenantID(context.Background(), "tenant-1")
+	suite.expectToken("https://tenant.test", EventTypeSessionRevoked)
+	suite.mockStore.On("CreateSecurityEvent", ctx, mock.MatchedBy(func(e SecurityEvent) bool {
+		return e.ReceiverID == "tenant-receiver"
+	})).Return(nil).Once()
+
+	err := suite.service.TransmitEvent(ctx, newEvent(event.EventTypeRefreshTokenReuseDetected,
+		map[string]interface{}{event.DataKey.UserID: testUserID}).WithTenantID("tenant-1"))
+
+	suite.NoError(err)
+	suite.mockHTTPClient.AssertNotCalled(suite.T(), "Do", mock.Anything)
+}
+
+func (suite *SharedSignalsServiceTestSuite) TestPoll() {
+	maxEvents := 2
+	suite.mockStore.On("DeleteSecurityEvents", mock.Anything, "poll-receiver", []string{"event-1", "event-2"}).
+		Return(2, nil).Once()
+	suite.mockStore.On("ListSecurityEvents", mock.Anything, "poll-receiver", suite.now, 3).Return([]SecurityEvent{
+		{ID: "event-3", Token: "token-3"}, {ID: "event-4", Token: "token-4"}, {ID: "event-5", Token: "token-5"},
+	}, nil).Once()
+
+	response, svcErr := suite.service.Poll(context.Background(), testClientID, &PollRequest{
+		MaxEvents: &maxEvents,
+		Ack:       []string{"event-1"},
+		SetErrs:   map[string]SETError{"event-2": {Err: "invalid_key", Description: "Unknown signing key"}},
+	})
+
+	suite.Nil(svcErr)
+	suite.Equal(map[string]string{"event-3": "token-3", "event-4": "token-4"}, response.Sets)
+	suite.True(response.MoreAvailable)
+}
+
+func (suite *SharedSignalsServiceTestSuite) TestPoll_DefaultsAndLimitsMaxEvents() {
+	suite.mockStore.On("DeleteSecurityEvents", mock.Anything, "poll-receiver", []string(nil)).Return(0, nil).Twice()
+	suite.mockStore.On("ListSecurityEvents", mock.Anything, "poll-receiver", suite.now, defaultMaxEvents+1).
+		Return([]SecurityEvent{}, nil).Once()
+	suite.mockStore.On("ListSecurityEvents", mock.Anything, "poll-receiver", suite.now, maxPollEvents+1).
+		Return([]SecurityEvent{}, nil).Once()
+
+	response, svcErr := suite.service.Poll(context.Background(), testClientID, &PollRequest{})
+	suite.Nil(svcErr)
+	suite.Empty(response.Sets)
+	suite.False(response.MoreAvailable)
+
+	maxEvents := 10000
+	_, svcErr = suite.service.Poll(context.Background(), testClientID, &PollRequest{MaxEvents: &maxEvents})
+	suite.Nil(svcErr)
+}
+
+func (suite *SharedSignalsServiceTestSuite) TestPoll_InvalidRequest() {
+	_, svcErr := suite.service.Poll(context.Background(), "unknown-client", &PollRequest{})
+	suite.Equal(&ErrorReceiverNotAuthorized, svcErr)
+
+	_, svcErr = suite.service.Poll(context.Background(), "", &PollRequest{})
+	suite.Equal(&ErrorReceiverNotAuthorized, svcErr)
+
+	maxEvents := -1
+	_, svcErr = suite.service.Poll(context.Background(), testClientID, &PollRequest{MaxEvents: &maxEvents})
+	suite.Equal(&ErrorInvalidMaxEvents, svcErr)
+}
+
+func (suite *SharedSignalsServiceTestSuite) TestPoll_ReceiverOfAnotherTenant() {
+	_, svcErr := suite.service.Poll(sysContext.WithTenantID(context.Background(), "tenant-1"), testClientID,
+		&PollRequest{})
+	suite.Equal(&ErrorReceiverNotAuthorized, svcErr)
+
+	_, svcErr = suite.service.Poll(context.Background(), "tenant-client", &PollRequest{})
+	suite.Equal(&ErrorReceiverNotAuthorized, svcErr)
+}
+
+func (suite *SharedSignalsServiceTestSuite) TestPoll_StoreError() {
+	suite.mockStore.On("DeleteSecurityEvents", mock.Anything, "poll-receiver", []string{"event-1"}).
+		Return(0, errors.New("db error")).Once()
+
+	_, svcErr := suite.service.Poll(context.Background(), testClientID, &PollRequest{Ack: []string{"event-1"}})
+
+	suite.Equal(&serviceerror.InternalServerError, svcErr)
+}
+
+func (suite *SharedSignalsServiceTestSuite) TestRetryPushDeliveries() {
+	suite.mockStore.On("ListSecurityEvents", mock.Anything, "push-receiver", suite.now.Add(-5*time.Second),
+		retryBatchSize).Return([]SecurityEvent{
+		{ID: "event-1", ReceiverID: "push-receiver", Token: "token-1", Attempts: 1},
+		{ID: "event-2", ReceiverID: "push-receiver", Token: "token-2", Attempts: 1},
+		{ID: "event-3", ReceiverID: "push-receiver", Token: "token-3", Attempts: 1},
+	}, nil).Once()
+	suite.mockHTTPClient.On("Do", mock.Anything).Return(response(http.StatusAccepted, ""), nil).Once()
+	suite.mockStore.On("DeleteSecurityEvents", mock.Anything, "push-receiver", []string{"event-1"}).
+		Return(1, nil).Once()
+	suite.mockHTTPClient.On("Do", mock.Anything).Return(response(http.StatusServiceUnavailable, ""), nil).Once()
+	suite.mockStore.On("IncrementAttempts", mock.Anything, "event-2").Return(nil).Once()
+
+	delivered, err := suite.service.RetryPushDeliveries(context.Background())
+
+	suite.NoError(err)
+	suite.Equal(1, delivered)
+	suite.mockHTTPClient.AssertNumberOfCalls(suite.T(), "Do", 2)
+}
+
+func (suite *SharedSignalsServiceTestSuite) TestRetryPushDeliveries_StoreError() {
+	suite.mockStore.On("ListSecurityEvents", mock.Anything, "push-receiver", mock.Anything, retryBatchSize).
+		Return(nil, errors.New("db error")).Once()
+
+	_, err := suite.service.RetryPushDeliveries(context.Background())
+
+	suite.Error(err)
+}
+
+func (suite *SharedSignalsServiceTestSuite) TestRetryPushDeliveries_NoPushReceiverInTenant() {
+	delivered, err := suite.service.RetryPushDeliveries(sysContext.WithTenantID(context.Background(), "tenant-1"))
+
+	suite.NoError(err)
+	suite.Zero(delivered)
+	suite.mockStore.AssertNotCalled(suite.T(), "ListSecurityEvents", mock.Anything, mock.Anything, mock.Anything,
+		mock.Anything)
+}
+
+func (suite *SharedSignalsServiceTestSuite) TestDeleteExpiredEvents() {
+	suite.mockStore.On("DeleteSecurityEventsBefore", mock.Anything, suite.now.Add(-time.Hour)).Return(4, nil).Once()
+
+	deleted, err := suite.service.DeleteExpiredEvents(context.Background())
+
+	suite.NoError(err)
+	suite.Equal(4, deleted)
+}
+
+func (suite *SharedSignalsServiceTestSuite) TestGetTransmitterConfiguration() {
+	configuration := suite.service.GetTransmitterConfiguration()
+
+	suite.Equal(testIssuer, configuration.Issuer)
+	suite.Equal(testIssuer+"/oauth2/jwks", configuration.JWKSURI)
+	suite.Equal([]string{deliveryMethodPushURI, deliveryMethodPollURI}, configuration.DeliveryMethodsSupported)
+}
+
+func (suite *SharedSignalsServiceTestSuite) TestScheduledRetry() {
+	ctx := sysContext.WithTenantID(context.Background(), "tenant-1")
+	inTenant := mock.MatchedBy(func(ctx context.Context) bool { return sysContext.GetTenantID(ctx) == "tenant-1" })
+	mockService := NewSharedSignalsServiceInterfaceMock(suite.T())
+	mockService.On("DeleteExpiredEvents", inTenant).Return(1, nil).Once()
+	mockService.On("RetryPushDeliveries", inTenant).Return(2, nil).Once()
+
+	suite.NoError(newScheduledRetry(mockService)(ctx))
+}
+
+func (suite *SharedSignalsServiceTestSuite) TestScheduledRetry_RetriesAfterCleanupFailure() {
+	mockService := NewSharedSignalsServiceInterfaceMock(suite.T())
+	mockService.On("DeleteExpiredEvents", mock.Anything).Return(0, errors.New("db down")).Once()
+	mockService.On("RetryPushDeliveries", mock.Anything).Return(2, nil).Once()
+
+	suite.ErrorContains(newScheduledRetry(mockService)(context.Background()), "db down")
+}
+
+func (suite *SharedSignalsServiceTestSuite) TestSubscriberTransmitsEventInItsTenant() {
+	mockService := NewSharedSignalsServiceInterfaceMock(suite.T())
+	evt := newEvent(event.EventTypeUserDeleted, nil).WithTenantID("tenant-1")
+	mockService.On("TransmitEvent", mock.MatchedBy(func(ctx context.Context) bool {
+		return sysContext.GetTenantID(ctx) == "tenant-1"
+	}), evt).Return(nil).Once()
+
+	suite.NoError(newSharedSignalsSubscriber(mockService).OnEvent(evt))
+}
@@ -0,0 +1,183 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package sharedsignals
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+	sysContext "github.com/thunder-id/thunderid/internal/system/context"
+	"github.com/thunder-id/thunderid/internal/system/database/provider"
+	dbutils "github.com/thunder-id/thunderid/internal/system/database/utils"
+)
+
+var getDBProvider = provider.GetDBProvider
+
+// securityEventStoreInterface defines the interface for security event store operations.
+type securityEventStoreInterface interface {
+	CreateSecurityEvent(ctx context.Context, securityEvent SecurityEvent) error
+	ListSecurityEvents(ctx context.Context, receiverID string, before time.Time, limit int) ([]SecurityEvent, error)
+	IncrementAttempts(ctx context.Context, id string) error
+	DeleteSecurityEvents(ctx context.Context, receiverID string, ids []string) (int, error)
+	DeleteSecurityEventsBefore(ctx context.Context, before time.Time) (int, error)
+}
+
+// securityEventStore is the default implementation of securityEventStoreInterface.
+type securityEventStore struct {
+	dbProvider   provider.DBProviderInterface
+	deploymentID string
+}
+
+// newSecurityEventStore creates a new instance of securityEventStore.
+func newSecurityEventStore() securityEventStoreInterface {
+	return &securityEventStore{
+		dbProvider:   getDBProvider(),
+		deploymentID: config.GetServerRuntime().Config.Server.Identifier,
+	}
+}
+
+// CreateSecurityEvent persists a new security event.
+func (s *securityEventStore) CreateSecurityEvent(ctx context.Context, securityEvent SecurityEvent) error {
+	dbClient, err := s.dbProvider.GetConfigDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	if _, err := dbClient.ExecuteContext(ctx, queryCreateSecurityEvent, securityEvent.ID,
+		securityEvent.ReceiverID, securityEvent.EventType, securityEvent.Token, securityEvent.Attempts,
+		securityEvent.CreatedAt, sysContext.ScopeDeploymentID(ctx, s.deploymentID)); err != nil {
+		return fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	return nil
+}
+
+// ListSecurityEvents retrieves the oldest security events of a receiver created before the given time.
+func (s *securityEventStore) ListSecurityEvents(ctx context.Context, receiverID string, before time.Time,
+	limit int) ([]SecurityEvent, error) {
+	dbClient, err := s.dbProvider.GetConfigDBClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	results, err := dbClient.QueryContext(ctx, queryListSecurityEvents, receiverID, before,
+		sysContext.ScopeDeploymentID(ctx, s.deploymentID), limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	securityEvents := make([]SecurityEvent, 0, len(results))
+	for _, row := range results {
+		securityEvent, err := buildSecurityEventFromResultRow(row)
+		if err != nil {
+			return nil, fmt.Errorf("failed to build security event from result row: %w", err)
+		}
+		securityEvents = append(securityEvents, *securityEvent)
+	}
+
+	return securityEvents, nil
+}
+
+// IncrementAttempts counts a failed delivery of a security event.
+func (s *securityEventStore) IncrementAttempts(ctx context.Context, id string) error {
+	dbClient, err := s.dbProvider.GetConfigDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	if _, err := dbClient.ExecuteContext(ctx, queryIncrementAttempts, id,
+		sysContext.ScopeDeploymentID(ctx, s.deploymentID)); err != nil {
+		return fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteSecurityEvents deletes the security events of a receiver with the given IDs and returns the number
+// of security events deleted. IDs of other receivers are ignored.
+func (s *securityEventStore) DeleteSecurityEvents(ctx context.Context, receiverID string,
+	ids []string) (int, error) {
+	if len(ids) == 0 {
+		return 0, nil
+	}
+	dbClient, err := s.dbProvider.GetConfigDBClient()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	query, args := buildDeleteSecurityEventsQuery(receiverID, ids,
+		sysContext.ScopeDeploymentID(ctx, s.deploymentID))
+	deleted, err := dbClient.ExecuteContext(ctx, query, args...)
+	if err != nil {
+		return 0, fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	return int(deleted), nil
+}
+
+// DeleteSecurityEventsBefore deletes the security events created before the given time and returns the
+// number of security events deleted.
+func (s *securityEventStore) DeleteSecurityEventsBefore(ctx context.Context, before time.Time) (int, error) {
+	dbClient, err := s.dbProvider.GetConfigDBClient()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	deleted, err := dbClient.ExecuteContext(ctx, queryDeleteSecurityEventsBefore, before,
+		sysContext.ScopeDeploymentID(ctx, s.deploymentID))
+	if err != nil {
+		return 0, fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	return int(deleted), nil
+}
+
+// buildSecurityEventFromResultRow constructs a SecurityEvent from a database result row.
+func buildSecurityEventFromResultRow(row map[string]interface{}) (*SecurityEvent, error) {
+	id, ok := row["id"].(string)
+	if !ok {
+		return nil, fmt.Errorf("failed to parse id as string")
+	}
+	token := dbutils.ParseStringOrBytes(row["token"])
+	if token == "" {
+		return nil, fmt.Errorf("failed to parse token as string")
+	}
+	createdAt, err := dbutils.ParseTimeField(row["created_at"], "created_at")
+	if err != nil {
+		return nil, err
+	}
+
+	securityEvent := &SecurityEvent{
+		ID:        id,
+		Token:     token,
+		CreatedAt: createdAt,
+	}
+	securityEvent.ReceiverID, _ = row["receiver_id"].(string)
+	securityEvent.EventType, _ = row["event_type"].(string)
+	switch attempts := row["attempts"].(type) {
+	case int64:
+		securityEvent.Attempts = int(attempts)
+	case float64:
+		securityEvent.Attempts = int(attempts)
+	}
+
+	return securityEvent, nil
+}
@@ -0,0 +1,74 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package sharedsignals
+
+import (
+	"fmt"
+	"strings"
+
+	dbmodel "github.com/thunder-id/thunderid/internal/system/database/model"
+)
+
+const securityEventColumns = `ID, RECEIVER_ID, EVENT_TYPE, TOKEN, ATTEMPTS, CREATED_AT`
+
+var (
+	// queryCreateSecurityEvent is the query to create a new security event.
+	queryCreateSecurityEvent = dbmodel.DBQuery{
+		ID: "SSF-SHARED_SIGNALS_MGT-01",
+		Query: `INSERT INTO "SECURITY_EVENT" (` + securityEventColumns + `, DEPLOYMENT_ID) ` +
+			`VALUES ($1, $2, $3, $4, $5, $6, $7)`,
+	}
+	// queryListSecurityEvents is the query to list the oldest security events of a receiver created before
+	// a time.
+	queryListSecurityEvents = dbmodel.DBQuery{
+		ID: "SSF-SHARED_SIGNALS_MGT-02",
+		Query: `SELECT ` + securityEventColumns + ` FROM "SECURITY_EVENT" ` +
+			`WHERE RECEIVER_ID = $1 AND CREATED_AT < $2 AND DEPLOYMENT_ID = $3 ` +
+			`ORDER BY CREATED_AT, ID LIMIT $4`,
+	}
+	// queryIncrementAttempts is the query to count a failed delivery of a security event.
+	queryIncrementAttempts = dbmodel.DBQuery{
+		ID:    "SSF-SHARED_SIGNALS_MGT-03",
+		Query: `UPDATE "SECURITY_EVENT" SET ATTEMPTS = ATTEMPTS + 1 WHERE ID = $1 AND DEPLOYMENT_ID = $2`,
+	}
+	// queryDeleteSecurityEventsBefore is the query to delete the security events created before a time.
+	queryDeleteSecurityEventsBefore = dbmodel.DBQuery{
+		ID:    "SSF-SHARED_SIGNALS_MGT-04",
+		Query: `DELETE FROM "SECURITY_EVENT" WHERE CREATED_AT < $1 AND DEPLOYMENT_ID = $2`,
+	}
+)
+
+// buildDeleteSecurityEventsQuery returns the query and args to delete the security events of a receiver
+// with the given IDs.
+func buildDeleteSecurityEventsQuery(receiverID string, ids []string,
+	deploymentID string) (dbmodel.DBQuery, []interface{}) {
+	args := []interface{}{receiverID}
+	placeholders := make([]string, 0, len(ids))
+	for _, id := range ids {
+		args = append(args, id)
+		placeholders = append(placeholders, fmt.Sprintf("$%d", len(args)))
+	}
+	args = append(args, deploymentID)
+
+	return dbmodel.DBQuery{
+		ID: "SSF-SHARED_SIGNALS_MGT-05",
+		Query: fmt.Sprintf(`DELETE FROM "SECURITY_EVENT" WHERE RECEIVER_ID = $1 AND ID IN (%s) `+
+			`AND DEPLOYMENT_ID = $%d`, strings.Join(placeholders, ", "), len(args)),
+	}, args
+}
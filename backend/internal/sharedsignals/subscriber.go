@@ -0,0 +1,80 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package sharedsignals
+
+import (
+	"context"
+
+	sysContext "github.com/thunder-id/thunderid/internal/system/context"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/internal/system/observability/event"
+	"github.com/thunder-id/thunderid/internal/system/observability/subscriber"
+)
+
+// sharedSignalsSubscriber transmits the security events signalled by the events of the event bus.
+type sharedSignalsSubscriber struct {
+	service SharedSignalsServiceInterface
+	logger  *log.Logger
+}
+
+var _ subscriber.SubscriberInterface = (*sharedSignalsSubscriber)(nil)
+
+// newSharedSignalsSubscriber creates a new instance of sharedSignalsSubscriber.
+func newSharedSignalsSubscriber(service SharedSignalsServiceInterface) *sharedSignalsSubscriber {
+	return &sharedSignalsSubscriber{
+		service: service,
+		logger:  log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName)),
+	}
+}
+
+// GetID returns the unique identifier of the subscriber.
+func (s *sharedSignalsSubscriber) GetID() string {
+	return subscriberID
+}
+
+// GetCategories returns the audit category, which carries the changes to sessions, credentials and accounts.
+func (s *sharedSignalsSubscriber) GetCategories() []event.EventCategory {
+	return []event.EventCategory{event.CategoryAudit}
+}
+
+// OnEvent transmits the security events signalled by an event to the receivers of the tenant the event
+// occurred in.
+func (s *sharedSignalsSubscriber) OnEvent(evt *event.Event) error {
+	ctx := sysContext.WithTenantID(context.Background(), evt.TenantID)
+	if err := s.service.TransmitEvent(ctx, evt); err != nil {
+		s.logger.Error("Failed to transmit security event", log.String("eventType", evt.Type), log.Error(err))
+		return err
+	}
+	return nil
+}
+
+// Close releases no resources.
+func (s *sharedSignalsSubscriber) Close() error {
+	return nil
+}
+
+// IsEnabled reports true, since the subscriber is only created when shared signals are enabled.
+func (s *sharedSignalsSubscriber) IsEnabled() bool {
+	return true
+}
+
+// Initialize needs no setup.
+func (s *sharedSignalsSubscriber) Initialize() error {
+	return nil
+}
@@ -0,0 +1,155 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package apiversion provides API version negotiation for HTTP routes, allowing a service to expose
+// multiple representations of a resource and to announce deprecation of older versions.
+//
+// A versioned route is reachable both through an explicit path prefix ("/v1/users") and through the
+// unversioned path ("/users"), where the version is negotiated with the API-Version request header.
+package apiversion
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/thunder-id/thunderid/internal/system/utils"
+)
+
+// HandleFunc registers a versioned route on the mux. The unversioned pattern negotiates the version
+// from the API-Version header and falls back to the route's default version; each version is also
+// registered under its own path prefix. Registration panics on an invalid route, in line with
+// http.ServeMux registration errors.
+func HandleFunc(mux *http.ServeMux, pattern string, route Route) {
+	defaultVersion, err := route.resolveDefault()
+	if err != nil {
+		panic(fmt.Sprintf("apiversion: invalid route %q: %v", pattern, err))
+	}
+
+	method, path := splitPattern(pattern)
+	for _, version := range route.Versions {
+		versionedPattern := joinPattern(method, "/"+version.Name+path)
+		mux.HandleFunc(versionedPattern, serveVersion(pattern, version))
+	}
+
+	mux.HandleFunc(pattern, func(w http.ResponseWriter, r *http.Request) {
+		requested := normalizeVersion(r.Header.Get(HeaderAPIVersion))
+		if requested == "" {
+			serveVersion(pattern, defaultVersion)(w, r)
+			return
+		}
+		version, ok := route.find(requested)
+		if !ok {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, errUnsupportedVersion)
+			return
+		}
+		serveVersion(pattern, version)(w, r)
+	})
+}
+
+// StripVersionPrefix removes a leading version segment from a request path so that path-based
+// policies such as public path lists and permission mappings apply to every version of a route.
+func StripVersionPrefix(path string) string {
+	loc := versionPathPrefix.FindStringIndex(path)
+	if loc == nil {
+		return path
+	}
+	return "/" + path[loc[1]:]
+}
+
+// serveVersion wraps a version handler to emit version and deprecation headers and usage metrics.
+func serveVersion(pattern string, version Version) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(HeaderAPIVersion, version.Name)
+		if version.IsDeprecated() {
+			w.Header().Set(headerDeprecation, fmt.Sprintf("@%d", version.DeprecatedAt.Unix()))
+		}
+		if !version.SunsetAt.IsZero() {
+			w.Header().Set(headerSunset, version.SunsetAt.UTC().Format(http.TimeFormat))
+		}
+		recordVersionUsage(r.Context(), pattern, version)
+		version.Handler(w, r)
+	}
+}
+
+// resolveDefault validates the route and returns the version served on the unversioned path.
+func (rt Route) resolveDefault() (Version, error) {
+	if len(rt.Versions) == 0 {
+		return Version{}, fmt.Errorf("at least one version is required")
+	}
+	seen := make(map[string]struct{}, len(rt.Versions))
+	for _, version := range rt.Versions {
+		if !versionPathPrefix.MatchString("/" + version.Name) {
+			return Version{}, fmt.Errorf("version name %q must be of the form v<number>", version.Name)
+		}
+		if version.Handler == nil {
+			return Version{}, fmt.Errorf("version %q has no handler", version.Name)
+		}
+		if _, ok := seen[version.Name]; ok {
+			return Version{}, fmt.Errorf("version %q is registered more than once", version.Name)
+		}
+		seen[version.Name] = struct{}{}
+	}
+
+	if rt.DefaultVersion == "" {
+		return rt.Versions[len(rt.Versions)-1], nil
+	}
+	version, ok := rt.find(rt.DefaultVersion)
+	if !ok {
+		return Version{}, fmt.Errorf("default version %q is not registered", rt.DefaultVersion)
+	}
+	return version, nil
+}
+
+// find returns the version with the given name.
+func (rt Route) find(name string) (Version, bool) {
+	for _, version := range rt.Versions {
+		if version.Name == name {
+			return version, true
+		}
+	}
+	return Version{}, false
+}
+
+// normalizeVersion converts a header value such as "2", "v2" or "V2" to its canonical form "v2".
+func normalizeVersion(value string) string {
+	value = strings.ToLower(strings.TrimSpace(value))
+	if value == "" {
+		return ""
+	}
+	if !strings.HasPrefix(value, "v") {
+		value = "v" + value
+	}
+	return value
+}
+
+// splitPattern splits a ServeMux pattern into its optional method and path parts.
+func splitPattern(pattern string) (string, string) {
+	if method, path, found := strings.Cut(pattern, " "); found {
+		return method, strings.TrimSpace(path)
+	}
+	return "", pattern
+}
+
+// joinPattern builds a ServeMux pattern from an optional method and a path.
+func joinPattern(method, path string) string {
+	if method == "" {
+		return path
+	}
+	return method + " " + path
+}
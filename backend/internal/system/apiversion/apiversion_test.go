@@ -0,0 +1,165 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package apiversion
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type APIVersionTestSuite struct {
+	suite.Suite
+	mux          *http.ServeMux
+	deprecatedAt time.Time
+	sunsetAt     time.Time
+}
+
+func TestAPIVersionTestSuite(t *testing.T) {
+	suite.Run(t, new(APIVersionTestSuite))
+}
+
+func (s *APIVersionTestSuite) SetupTest() {
+	s.deprecatedAt = time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	s.sunsetAt = time.Date(2026, 12, 31, 0, 0, 0, 0, time.UTC)
+	s.mux = http.NewServeMux()
+	HandleFunc(s.mux, "GET /items/{id}", Route{
+		Versions: []Version{
+			{
+				Name:         "v1",
+				Handler:      writeBody("v1:"),
+				DeprecatedAt: s.deprecatedAt,
+				SunsetAt:     s.sunsetAt,
+			},
+			{Name: "v2", Handler: writeBody("v2:")},
+		},
+	})
+}
+
+func writeBody(prefix string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(prefix + r.PathValue("id")))
+	}
+}
+
+func (s *APIVersionTestSuite) serve(path, versionHeader string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodGet, path, nil)
+	if versionHeader != "" {
+		req.Header.Set(HeaderAPIVersion, versionHeader)
+	}
+	rr := httptest.NewRecorder()
+	s.mux.ServeHTTP(rr, req)
+	return rr
+}
+
+func (s *APIVersionTestSuite) TestUnversionedPath_ServesDefaultVersion() {
+	rr := s.serve("/items/42", "")
+
+	s.Equal(http.StatusOK, rr.Code)
+	s.Equal("v2:42", rr.Body.String())
+	s.Equal("v2", rr.Header().Get(HeaderAPIVersion))
+	s.Empty(rr.Header().Get(headerDeprecation))
+	s.Empty(rr.Header().Get(headerSunset))
+}
+
+func (s *APIVersionTestSuite) TestUnversionedPath_HeaderNegotiation() {
+	for _, header := range []string{"v1", "1", " V1 "} {
+		rr := s.serve("/items/42", header)
+
+		s.Equal(http.StatusOK, rr.Code, header)
+		s.Equal("v1:42", rr.Body.String(), header)
+	}
+}
+
+func (s *APIVersionTestSuite) TestUnversionedPath_UnsupportedVersion() {
+	rr := s.serve("/items/42", "v9")
+
+	s.Equal(http.StatusBadRequest, rr.Code)
+	s.Contains(rr.Body.String(), errUnsupportedVersion.Code)
+}
+
+func (s *APIVersionTestSuite) TestPathPrefix_SelectsVersion() {
+	rr := s.serve("/v2/items/42", "v1")
+
+	s.Equal(http.StatusOK, rr.Code)
+	s.Equal("v2:42", rr.Body.String())
+}
+
+func (s *APIVersionTestSuite) TestDeprecatedVersion_SetsHeaders() {
+	rr := s.serve("/v1/items/42", "")
+
+	s.Equal(http.StatusOK, rr.Code)
+	s.Equal("v1", rr.Header().Get(HeaderAPIVersion))
+	s.Equal("@1767225600", rr.Header().Get(headerDeprecation))
+	s.Equal("Thu, 31 Dec 2026 00:00:00 GMT", rr.Header().Get(headerSunset))
+}
+
+func (s *APIVersionTestSuite) TestDefaultVersion_Explicit() {
+	mux := http.NewServeMux()
+	HandleFunc(mux, "/things", Route{
+		DefaultVersion: "v1",
+		Versions: []Version{
+			{Name: "v1", Handler: writeBody("v1")},
+			{Name: "v2", Handler: writeBody("v2")},
+		},
+	})
+	rr := httptest.NewRecorder()
+	mux.ServeHTTP(rr, httptest.NewRequest(http.MethodPost, "/things", nil))
+
+	s.Equal("v1", rr.Body.String())
+}
+
+func (s *APIVersionTestSuite) TestHandleFunc_InvalidRoutePanics() {
+	handler := writeBody("")
+	testCases := []struct {
+		name  string
+		route Route
+	}{
+		{"NoVersions", Route{}},
+		{"InvalidName", Route{Versions: []Version{{Name: "latest", Handler: handler}}}},
+		{"NilHandler", Route{Versions: []Version{{Name: "v1"}}}},
+		{"Duplicate", Route{Versions: []Version{{Name: "v1", Handler: handler}, {Name: "v1", Handler: handler}}}},
+		{"UnknownDefault", Route{DefaultVersion: "v3", Versions: []Version{{Name: "v1", Handler: handler}}}},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.Panics(func() { HandleFunc(http.NewServeMux(), "GET /x", tc.route) })
+		})
+	}
+}
+
+func (s *APIVersionTestSuite) TestStripVersionPrefix() {
+	testCases := map[string]string{
+		"/v1/users":      "/users",
+		"/v12/users/abc": "/users/abc",
+		"/v1":            "/",
+		"/v1/":           "/",
+		"/users":         "/users",
+		"/v1users":       "/v1users",
+		"/vx/users":      "/vx/users",
+	}
+
+	for input, expected := range testCases {
+		s.Equal(expected, StripVersionPrefix(input), input)
+	}
+}
@@ -0,0 +1,35 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package apiversion
+
+import "regexp"
+
+// HeaderAPIVersion is the request header used to negotiate an API version on unversioned paths.
+// The same header is set on every response to report the version that served the request.
+const HeaderAPIVersion = "API-Version"
+
+const (
+	// headerDeprecation is the RFC 9745 response header announcing that a version is deprecated.
+	headerDeprecation = "Deprecation"
+	// headerSunset is the RFC 8594 response header announcing when a version will be removed.
+	headerSunset = "Sunset"
+)
+
+// versionPathPrefix matches a leading version path segment such as "/v1" or "/v2/".
+var versionPathPrefix = regexp.MustCompile(`^/v[0-9]+(/|$)`)
@@ -0,0 +1,37 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package apiversion
+
+import (
+	"github.com/thunder-id/thunderid/internal/system/error/apierror"
+	"github.com/thunder-id/thunderid/internal/system/i18n/core"
+)
+
+// errUnsupportedVersion is returned when the requested API version is not exposed by the route.
+var errUnsupportedVersion = apierror.ErrorResponse{
+	Code: "APV-1001",
+	Message: core.I18nMessage{
+		Key:          "error.apiversion.unsupported_version",
+		DefaultValue: "Unsupported API version",
+	},
+	Description: core.I18nMessage{
+		Key:          "error.apiversion.unsupported_version_description",
+		DefaultValue: "The requested API version is not supported for this resource",
+	},
+}
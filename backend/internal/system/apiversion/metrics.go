@@ -0,0 +1,59 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package apiversion
+
+import (
+	"context"
+	"strconv"
+	"sync"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+type versionMetrics struct {
+	once     sync.Once
+	requests metric.Int64Counter
+}
+
+var apiVersionMetrics versionMetrics
+
+func initVersionMetrics() {
+	apiVersionMetrics.once.Do(func() {
+		meter := otel.Meter("github.com/thunder-id/thunderid/apiversion")
+		apiVersionMetrics.requests, _ = meter.Int64Counter(
+			"thunderid_api_version_requests_total",
+			metric.WithDescription("Total API requests served per route and API version"),
+		)
+	})
+}
+
+// recordVersionUsage records a request served by the given version of a route.
+func recordVersionUsage(ctx context.Context, pattern string, version Version) {
+	initVersionMetrics()
+	if apiVersionMetrics.requests == nil {
+		return
+	}
+	apiVersionMetrics.requests.Add(ctx, 1, metric.WithAttributes(
+		attribute.String("route", pattern),
+		attribute.String("version", version.Name),
+		attribute.String("deprecated", strconv.FormatBool(version.IsDeprecated())),
+	))
+}
@@ -0,0 +1,48 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package apiversion
+
+import (
+	"net/http"
+	"time"
+)
+
+// Version is a single representation of a versioned route.
+type Version struct {
+	// Name is the version identifier used in the path prefix and the API-Version header, e.g. "v1".
+	Name    string
+	Handler http.HandlerFunc
+	// DeprecatedAt is the time the version was deprecated. The zero value means not deprecated.
+	DeprecatedAt time.Time
+	// SunsetAt is the time the version is scheduled for removal. The zero value means no sunset.
+	SunsetAt time.Time
+}
+
+// Route groups the versions a service exposes for a single route pattern.
+type Route struct {
+	Versions []Version
+	// DefaultVersion is served on the unversioned path when the client does not request a version.
+	// When empty, the last entry of Versions is used.
+	DefaultVersion string
+}
+
+// IsDeprecated reports whether the version has been deprecated.
+func (v Version) IsDeprecated() bool {
+	return !v.DeprecatedAt.IsZero()
+}
@@ -0,0 +1,30 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package backgroundjob
+
+import "time"
+
+const loggerComponentName = "BackgroundJob"
+
+// StaleTimeout is the time after which an active job without progress updates is considered abandoned,
+// for example because the node running it was restarted.
+const StaleTimeout = 15 * time.Minute
+
+// InterruptedReason is the failure reason recorded for a job abandoned before it completed.
+const InterruptedReason = "The job was interrupted before it completed"
@@ -0,0 +1,96 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package backgroundjob provides the lease handling shared by background jobs that persist their status,
+// so that a job abandoned by a node can be released and resumed from another node.
+package backgroundjob
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/system/log"
+)
+
+// ErrJobConflict is returned when another job is active, or when another request changed the status of
+// the job first.
+var ErrJobConflict = errors.New("background job conflict")
+
+// StatusUpdater moves a persisted job from one status to another, recording the failure reason, and
+// reports whether the job was in the expected status.
+type StatusUpdater[S ~string] func(ctx context.Context, jobID string, from, to S, reason string) (bool, error)
+
+// Lease releases and resumes the persisted jobs of one kind.
+type Lease[S ~string] struct {
+	updateStatus StatusUpdater[S]
+	pending      S
+	failed       S
+}
+
+// NewLease creates a lease for jobs whose status is changed through updateStatus. A resumed job is moved
+// from the failed status back to the pending status.
+func NewLease[S ~string](updateStatus StatusUpdater[S], pending, failed S) *Lease[S] {
+	return &Lease[S]{
+		updateStatus: updateStatus,
+		pending:      pending,
+		failed:       failed,
+	}
+}
+
+// ReleaseStale marks the active job as failed when it has not reported progress within StaleTimeout, so
+// that it can be resumed. It returns ErrJobConflict while the job is still making progress, and when
+// another request released the job first.
+func (l *Lease[S]) ReleaseStale(ctx context.Context, jobID string, status S, updatedAt time.Time) error {
+	if time.Since(updatedAt) < StaleTimeout {
+		return ErrJobConflict
+	}
+
+	log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName)).
+		Warn("Releasing stale background job", log.String("jobID", jobID))
+	released, err := l.updateStatus(ctx, jobID, status, l.failed, InterruptedReason)
+	if err != nil {
+		return err
+	}
+	if !released {
+		return ErrJobConflict
+	}
+	return nil
+}
+
+// Resume moves a failed job back to pending. It returns ErrJobConflict when another request resumed the
+// job first.
+func (l *Lease[S]) Resume(ctx context.Context, jobID string) error {
+	resumed, err := l.updateStatus(ctx, jobID, l.failed, l.pending, "")
+	if err != nil {
+		return err
+	}
+	if !resumed {
+		return ErrJobConflict
+	}
+	return nil
+}
+
+// StartWorker runs the worker of a job through runAsync. The worker outlives the request, so it gets a
+// context that does not inherit the request cancellation.
+func StartWorker(ctx context.Context, runAsync func(func()), worker func(ctx context.Context)) {
+	workerCtx := context.WithoutCancel(ctx)
+	runAsync(func() {
+		worker(workerCtx)
+	})
+}
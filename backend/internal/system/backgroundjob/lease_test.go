@@ -0,0 +1,124 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package backgroundjob
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type testStatus string
+
+const (
+	testStatusPending testStatus = "PENDING"
+	testStatusRunning testStatus = "RUNNING"
+	testStatusFailed  testStatus = "FAILED"
+)
+
+// statusUpdate records a call to the status updater.
+type statusUpdate struct {
+	jobID  string
+	from   testStatus
+	to     testStatus
+	reason string
+}
+
+type LeaseTestSuite struct {
+	suite.Suite
+	updates []statusUpdate
+	applied bool
+	err     error
+	lease   *Lease[testStatus]
+}
+
+func TestLeaseTestSuite(t *testing.T) {
+	suite.Run(t, new(LeaseTestSuite))
+}
+
+func (suite *LeaseTestSuite) SetupTest() {
+	suite.updates = nil
+	suite.applied = true
+	suite.err = nil
+	suite.lease = NewLease(func(_ context.Context, jobID string, from, to testStatus, reason string) (bool, error) {
+		suite.updates = append(suite.updates, statusUpdate{jobID: jobID, from: from, to: to, reason: reason})
+		return suite.applied, suite.err
+	}, testStatusPending, testStatusFailed)
+}
+
+func (suite *LeaseTestSuite) TestReleaseStale_ActiveJobConflicts() {
+	err := suite.lease.ReleaseStale(context.Background(), "job-1", testStatusRunning, time.Now())
+
+	suite.ErrorIs(err, ErrJobConflict)
+	suite.Empty(suite.updates)
+}
+
+func (suite *LeaseTestSuite) TestReleaseStale_MarksStaleJobAsFailed() {
+	err := suite.lease.ReleaseStale(context.Background(), "job-1", testStatusRunning, time.Now().Add(-time.Hour))
+
+	suite.NoError(err)
+	suite.Equal([]statusUpdate{{jobID: "job-1", from: testStatusRunning, to: testStatusFailed,
+		reason: InterruptedReason}}, suite.updates)
+}
+
+func (suite *LeaseTestSuite) TestReleaseStale_ReleasedByAnotherRequest() {
+	suite.applied = false
+
+	err := suite.lease.ReleaseStale(context.Background(), "job-1", testStatusRunning, time.Now().Add(-time.Hour))
+
+	suite.ErrorIs(err, ErrJobConflict)
+}
+
+func (suite *LeaseTestSuite) TestReleaseStale_StoreError() {
+	suite.err = errors.New("db error")
+
+	err := suite.lease.ReleaseStale(context.Background(), "job-1", testStatusRunning, time.Now().Add(-time.Hour))
+
+	suite.EqualError(err, "db error")
+}
+
+func (suite *LeaseTestSuite) TestResume() {
+	err := suite.lease.Resume(context.Background(), "job-1")
+
+	suite.NoError(err)
+	suite.Equal([]statusUpdate{{jobID: "job-1", from: testStatusFailed, to: testStatusPending}}, suite.updates)
+}
+
+func (suite *LeaseTestSuite) TestResume_ResumedByAnotherRequest() {
+	suite.applied = false
+
+	err := suite.lease.Resume(context.Background(), "job-1")
+
+	suite.ErrorIs(err, ErrJobConflict)
+}
+
+func (suite *LeaseTestSuite) TestStartWorker_DetachesFromRequestCancellation() {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	var workerErr error
+	StartWorker(ctx, func(f func()) { f() }, func(workerCtx context.Context) {
+		workerErr = workerCtx.Err()
+	})
+
+	suite.NoError(workerErr)
+}
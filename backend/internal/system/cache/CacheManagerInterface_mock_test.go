@@ -256,6 +256,52 @@ func (_c *CacheManagerInterfaceMock_getCache_Call) RunAndReturn(run func(cacheKe
 	return _c
 }
 
+// getInvalidationBus provides a mock function for the type CacheManagerInterfaceMock
+func (_mock *CacheManagerInterfaceMock) getInvalidationBus() InvalidationBusInterface {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for getInvalidationBus")
+	}
+
+	var r0 InvalidationBusInterface
+	if returnFunc, ok := ret.Get(0).(func() InvalidationBusInterface); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(InvalidationBusInterface)
+		}
+	}
+	return r0
+}
+
+// CacheManagerInterfaceMock_getInvalidationBus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'getInvalidationBus'
+type CacheManagerInterfaceMock_getInvalidationBus_Call struct {
+	*mock.Call
+}
+
+// getInvalidationBus is a helper method to define mock.On call
+func (_e *CacheManagerInterfaceMock_Expecter) getInvalidationBus() *CacheManagerInterfaceMock_getInvalidationBus_Call {
+	return &CacheManagerInterfaceMock_getInvalidationBus_Call{Call: _e.mock.On("getInvalidationBus")}
+}
+
+func (_c *CacheManagerInterfaceMock_getInvalidationBus_Call) Run(run func()) *CacheManagerInterfaceMock_getInvalidationBus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *CacheManagerInterfaceMock_getInvalidationBus_Call) Return(invalidationBusInterface InvalidationBusInterface) *CacheManagerInterfaceMock_getInvalidationBus_Call {
+	_c.Call.Return(invalidationBusInterface)
+	return _c
+}
+
+func (_c *CacheManagerInterfaceMock_getInvalidationBus_Call) RunAndReturn(run func() InvalidationBusInterface) *CacheManagerInterfaceMock_getInvalidationBus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // getMutex provides a mock function for the type CacheManagerInterfaceMock
 func (_mock *CacheManagerInterfaceMock) getMutex() *sync.RWMutex {
 	ret := _mock.Called()
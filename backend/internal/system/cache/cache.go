@@ -22,6 +22,7 @@ package cache
 import (
 	"context"
 
+	sysContext "github.com/thunder-id/thunderid/internal/system/context"
 	"github.com/thunder-id/thunderid/internal/system/log"
 )
 
@@ -42,6 +43,8 @@ type Cache[T any] struct {
 	enabled   bool
 	cacheName string
 	cacheImpl CacheInterface[T]
+	// bus propagates invalidations of node-local caches. It is nil for shared caches.
+	bus InvalidationBusInterface
 }
 
 // GetName returns the name of the cache.
@@ -55,6 +58,7 @@ func (c *Cache[T]) Set(ctx context.Context, key CacheKey, value T) error {
 		log.String("cacheName", c.cacheName))
 
 	if c.IsEnabled() && c.cacheImpl.IsEnabled() {
+		key = scopeKey(ctx, key)
 		if err := c.cacheImpl.Set(ctx, key, value); err != nil {
 			logger.Warn("Failed to set value in the cache", log.String("key", key.ToString()), log.Error(err))
 		}
@@ -66,7 +70,7 @@ func (c *Cache[T]) Set(ctx context.Context, key CacheKey, value T) error {
 // Get retrieves a value from the cache.
 func (c *Cache[T]) Get(ctx context.Context, key CacheKey) (T, bool) {
 	if c.IsEnabled() && c.cacheImpl.IsEnabled() {
-		if value, found := c.cacheImpl.Get(ctx, key); found {
+		if value, found := c.cacheImpl.Get(ctx, scopeKey(ctx, key)); found {
 			return value, true
 		}
 	}
@@ -81,9 +85,11 @@ func (c *Cache[T]) Delete(ctx context.Context, key CacheKey) error {
 		log.String("cacheName", c.cacheName))
 
 	if c.IsEnabled() && c.cacheImpl.IsEnabled() {
+		key = scopeKey(ctx, key)
 		if err := c.cacheImpl.Delete(ctx, key); err != nil {
 			logger.Warn("Failed to delete value from the cache", log.String("key", key.ToString()), log.Error(err))
 		}
+		c.publishInvalidation(ctx, key.ToString())
 	}
 
 	return nil
@@ -100,6 +106,7 @@ func (c *Cache[T]) Clear(ctx context.Context) error {
 		if err := c.cacheImpl.Clear(ctx); err != nil {
 			logger.Warn("Failed to clear the cache", log.Error(err))
 		}
+		c.publishInvalidation(ctx, "")
 	}
 
 	return nil
@@ -124,3 +131,49 @@ func (c *Cache[T]) CleanupExpired() {
 		c.cacheImpl.CleanupExpired()
 	}
 }
+
+// scopeKey prefixes the key with the tenant of the context so that tenants never share cache entries.
+func scopeKey(ctx context.Context, key CacheKey) CacheKey {
+	if tenantID := sysContext.GetTenantID(ctx); tenantID != "" {
+		return CacheKey{Key: tenantID + ":" + key.Key}
+	}
+	return key
+}
+
+// subscribeInvalidations applies invalidations published by other nodes to this cache and publishes
+// local deletions to them.
+func (c *Cache[T]) subscribeInvalidations(bus InvalidationBusInterface) {
+	if bus == nil {
+		return
+	}
+	c.bus = bus
+	bus.Subscribe(c.cacheName, func(ctx context.Context, keys []CacheKey, clearAll bool) {
+		logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, "Cache"),
+			log.String("cacheName", c.cacheName))
+
+		if clearAll {
+			if err := c.cacheImpl.Clear(ctx); err != nil {
+				logger.Warn("Failed to clear the cache on invalidation", log.Error(err))
+			}
+			return
+		}
+		for _, key := range keys {
+			if err := c.cacheImpl.Delete(ctx, key); err != nil {
+				logger.Warn("Failed to delete value from the cache on invalidation",
+					log.String("key", key.ToString()), log.Error(err))
+			}
+		}
+	})
+}
+
+// publishInvalidation announces a local deletion to other nodes. An empty key invalidates the whole cache.
+func (c *Cache[T]) publishInvalidation(ctx context.Context, key string) {
+	if c.bus == nil {
+		return
+	}
+	if err := c.bus.Publish(ctx, c.cacheName, key); err != nil {
+		logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, "Cache"),
+			log.String("cacheName", c.cacheName))
+		logger.Warn("Failed to publish cache invalidation", log.String("key", key), log.Error(err))
+	}
+}
@@ -28,6 +28,7 @@ import (
 	"github.com/stretchr/testify/suite"
 
 	"github.com/thunder-id/thunderid/internal/system/config"
+	sysContext "github.com/thunder-id/thunderid/internal/system/context"
 )
 
 const (
@@ -176,6 +177,32 @@ func (suite *CacheTestSuite) TestGet() {
 	assert.Equal(t, "", value3)
 }
 
+func (suite *CacheTestSuite) TestTenantScopedKeys() {
+	t := suite.T()
+
+	mockCache := NewCacheInterfaceMock[string](t)
+	mockCache.EXPECT().IsEnabled().Return(true)
+
+	cache := &Cache[string]{
+		enabled:   true,
+		cacheImpl: mockCache,
+	}
+
+	ctx := sysContext.WithTenantID(context.Background(), "tenant-1")
+	key := CacheKey{Key: "testKey"}
+	scopedKey := CacheKey{Key: "tenant-1:testKey"}
+
+	mockCache.EXPECT().Set(ctx, scopedKey, testValue).Return(nil)
+	mockCache.EXPECT().Get(ctx, scopedKey).Return(testValue, true)
+	mockCache.EXPECT().Delete(ctx, scopedKey).Return(nil)
+
+	assert.NoError(t, cache.Set(ctx, key, testValue))
+	value, found := cache.Get(ctx, key)
+	assert.True(t, found)
+	assert.Equal(t, testValue, value)
+	assert.NoError(t, cache.Delete(ctx, key))
+}
+
 func (suite *CacheTestSuite) TestDelete() {
 	t := suite.T()
 
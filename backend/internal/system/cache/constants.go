@@ -37,3 +37,6 @@ const (
 	// cacheTypeRedis represents a Redis-backed cache type.
 	cacheTypeRedis cacheType = "redis"
 )
+
+// defaultInvalidationChannel is the Redis pub/sub channel used when none is configured.
+const defaultInvalidationChannel = "cache-invalidation"
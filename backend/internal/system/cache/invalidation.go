@@ -0,0 +1,262 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package cache
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"github.com/thunder-id/thunderid/internal/system/log"
+)
+
+const (
+	// maxPendingInvalidationKeys is the number of pending key invalidations for a cache after which
+	// they are collapsed into a single clear of the cache.
+	maxPendingInvalidationKeys = 100
+	// invalidationVersionRetention is how long applied invalidation versions are remembered for
+	// discarding duplicate and out-of-order events.
+	invalidationVersionRetention = 10 * time.Minute
+)
+
+// InvalidationEvent describes a change that makes entries of a node-local cache stale on other nodes.
+type InvalidationEvent struct {
+	CacheName string `json:"cacheName"`
+	// Key is the invalidated cache key. An empty key invalidates the whole cache.
+	Key string `json:"key,omitempty"`
+	// Version orders invalidations of the same key. Events at or below the last applied version are ignored.
+	Version int64 `json:"version"`
+	// Origin identifies the publishing node, which does not apply its own events.
+	Origin string `json:"origin"`
+}
+
+// InvalidationHandler applies a batch of invalidations to a cache. When clearAll is true the whole
+// cache must be cleared and keys is empty.
+type InvalidationHandler func(ctx context.Context, keys []CacheKey, clearAll bool)
+
+// InvalidationBusInterface propagates cache invalidations between nodes.
+type InvalidationBusInterface interface {
+	// Publish announces that the given key of the cache changed. An empty key invalidates the whole cache.
+	Publish(ctx context.Context, cacheName string, key string) error
+	// Subscribe registers a handler for invalidations of the named cache published by other nodes.
+	Subscribe(cacheName string, handler InvalidationHandler)
+	Close()
+}
+
+// pendingInvalidation accumulates the invalidations received for a cache within a coalesce window.
+type pendingInvalidation struct {
+	keys     map[string]struct{}
+	clearAll bool
+}
+
+// invalidationBus implements InvalidationBusInterface on top of a message transport. Received events are
+// deduplicated by version and coalesced per cache before being applied, so that a burst of changes
+// causes one round of cache misses instead of many.
+type invalidationBus struct {
+	origin         string
+	coalesceWindow time.Duration
+	send           func(ctx context.Context, payload []byte) error
+	closeTransport func()
+
+	mu           sync.Mutex
+	subscribers  map[string][]InvalidationHandler
+	versions     map[string]int64
+	pending      map[string]*pendingInvalidation
+	flushPending bool
+	lastVersion  int64
+	now          func() time.Time
+}
+
+// newInvalidationBus creates an invalidation bus that sends events through the given transport.
+func newInvalidationBus(coalesceWindow time.Duration,
+	send func(ctx context.Context, payload []byte) error) *invalidationBus {
+	return &invalidationBus{
+		origin:         newInvalidationOrigin(),
+		coalesceWindow: coalesceWindow,
+		send:           send,
+		subscribers:    make(map[string][]InvalidationHandler),
+		versions:       make(map[string]int64),
+		pending:        make(map[string]*pendingInvalidation),
+		now:            time.Now,
+	}
+}
+
+// newRedisInvalidationBus creates an invalidation bus that exchanges events over a Redis pub/sub channel.
+func newRedisInvalidationBus(client *redis.Client, channel string,
+	coalesceWindow time.Duration) *invalidationBus {
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, "CacheInvalidationBus"))
+
+	bus := newInvalidationBus(coalesceWindow, func(ctx context.Context, payload []byte) error {
+		return client.Publish(ctx, channel, payload).Err()
+	})
+
+	pubsub := client.Subscribe(context.Background(), channel)
+	bus.closeTransport = func() {
+		if err := pubsub.Close(); err != nil {
+			logger.Warn("Failed to close cache invalidation subscription", log.Error(err))
+		}
+	}
+	go func() {
+		for msg := range pubsub.Channel() {
+			bus.receive([]byte(msg.Payload))
+		}
+	}()
+
+	logger.Debug("Subscribed to cache invalidation channel", log.String("channel", channel))
+	return bus
+}
+
+// Publish announces an invalidation to the other nodes.
+func (b *invalidationBus) Publish(ctx context.Context, cacheName string, key string) error {
+	payload, err := json.Marshal(InvalidationEvent{
+		CacheName: cacheName,
+		Key:       key,
+		Version:   b.nextVersion(),
+		Origin:    b.origin,
+	})
+	if err != nil {
+		return err
+	}
+	return b.send(ctx, payload)
+}
+
+// Subscribe registers a handler for invalidations of the named cache.
+func (b *invalidationBus) Subscribe(cacheName string, handler InvalidationHandler) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.subscribers[cacheName] = append(b.subscribers[cacheName], handler)
+}
+
+// Close stops receiving invalidations.
+func (b *invalidationBus) Close() {
+	if b.closeTransport != nil {
+		b.closeTransport()
+	}
+}
+
+// nextVersion returns a version that is greater than any version previously issued by this node.
+func (b *invalidationBus) nextVersion() int64 {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	version := b.now().UnixNano()
+	if version <= b.lastVersion {
+		version = b.lastVersion + 1
+	}
+	b.lastVersion = version
+	return version
+}
+
+// receive records an event from the transport and schedules the pending invalidations to be applied.
+func (b *invalidationBus) receive(payload []byte) {
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, "CacheInvalidationBus"))
+
+	var event InvalidationEvent
+	if err := json.Unmarshal(payload, &event); err != nil {
+		logger.Warn("Discarding malformed cache invalidation event", log.Error(err))
+		return
+	}
+	if event.Origin == b.origin || event.CacheName == "" {
+		return
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if _, subscribed := b.subscribers[event.CacheName]; !subscribed {
+		return
+	}
+
+	versionKey := event.CacheName + "\x00" + event.Key
+	if event.Version <= b.versions[versionKey] {
+		return
+	}
+	b.versions[versionKey] = event.Version
+
+	pending, ok := b.pending[event.CacheName]
+	if !ok {
+		pending = &pendingInvalidation{keys: make(map[string]struct{})}
+		b.pending[event.CacheName] = pending
+	}
+	if event.Key == "" || len(pending.keys) >= maxPendingInvalidationKeys {
+		pending.clearAll = true
+		pending.keys = make(map[string]struct{})
+	} else if !pending.clearAll {
+		pending.keys[event.Key] = struct{}{}
+	}
+
+	if b.flushPending {
+		return
+	}
+	b.flushPending = true
+	if b.coalesceWindow <= 0 {
+		go b.flush()
+	} else {
+		time.AfterFunc(b.coalesceWindow, b.flush)
+	}
+}
+
+// flush applies the pending invalidations to the subscribed caches.
+func (b *invalidationBus) flush() {
+	b.mu.Lock()
+	pending := b.pending
+	b.pending = make(map[string]*pendingInvalidation)
+	b.flushPending = false
+	b.pruneVersions()
+	handlers := make(map[string][]InvalidationHandler, len(pending))
+	for cacheName := range pending {
+		handlers[cacheName] = b.subscribers[cacheName]
+	}
+	b.mu.Unlock()
+
+	ctx := context.Background()
+	for cacheName, invalidation := range pending {
+		keys := make([]CacheKey, 0, len(invalidation.keys))
+		for key := range invalidation.keys {
+			keys = append(keys, CacheKey{Key: key})
+		}
+		for _, handler := range handlers[cacheName] {
+			handler(ctx, keys, invalidation.clearAll)
+		}
+	}
+}
+
+// pruneVersions forgets versions older than the retention period. The caller must hold the lock.
+func (b *invalidationBus) pruneVersions() {
+	cutoff := b.now().Add(-invalidationVersionRetention).UnixNano()
+	for key, version := range b.versions {
+		if version < cutoff {
+			delete(b.versions, key)
+		}
+	}
+}
+
+// newInvalidationOrigin returns a random identifier for this node.
+func newInvalidationOrigin() string {
+	buf := make([]byte, 8)
+	if _, err := rand.Read(buf); err != nil {
+		return time.Now().Format(time.RFC3339Nano)
+	}
+	return hex.EncodeToString(buf)
+}
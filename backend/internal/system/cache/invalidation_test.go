@@ -0,0 +1,226 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+)
+
+// loopbackTransport delivers every published payload to all connected buses, like a pub/sub channel.
+type loopbackTransport struct {
+	mu    sync.Mutex
+	buses []*invalidationBus
+}
+
+func (l *loopbackTransport) newBus(coalesceWindow time.Duration) *invalidationBus {
+	bus := newInvalidationBus(coalesceWindow, func(_ context.Context, payload []byte) error {
+		l.mu.Lock()
+		buses := append([]*invalidationBus(nil), l.buses...)
+		l.mu.Unlock()
+		for _, b := range buses {
+			b.receive(payload)
+		}
+		return nil
+	})
+	l.mu.Lock()
+	l.buses = append(l.buses, bus)
+	l.mu.Unlock()
+	return bus
+}
+
+// invalidationRecorder collects the batches delivered to a subscriber.
+type invalidationRecorder struct {
+	mu      sync.Mutex
+	batches []recordedInvalidation
+	done    chan struct{}
+}
+
+type recordedInvalidation struct {
+	keys     []CacheKey
+	clearAll bool
+}
+
+func newInvalidationRecorder() *invalidationRecorder {
+	return &invalidationRecorder{done: make(chan struct{}, 16)}
+}
+
+func (r *invalidationRecorder) handle(_ context.Context, keys []CacheKey, clearAll bool) {
+	r.mu.Lock()
+	r.batches = append(r.batches, recordedInvalidation{keys: keys, clearAll: clearAll})
+	r.mu.Unlock()
+	r.done <- struct{}{}
+}
+
+type InvalidationBusTestSuite struct {
+	suite.Suite
+}
+
+func TestInvalidationBusSuite(t *testing.T) {
+	suite.Run(t, new(InvalidationBusTestSuite))
+}
+
+func (suite *InvalidationBusTestSuite) SetupTest() {
+	config.ResetServerRuntime()
+	suite.Require().NoError(config.InitializeServerRuntime("/test/thunderid/home", &config.Config{
+		Cache: config.CacheConfig{Size: 100, TTL: 3600, EvictionPolicy: "LRU"},
+	}))
+}
+
+func (suite *InvalidationBusTestSuite) TearDownTest() {
+	config.ResetServerRuntime()
+}
+
+func (suite *InvalidationBusTestSuite) waitForBatch(recorder *invalidationRecorder) {
+	select {
+	case <-recorder.done:
+	case <-time.After(2 * time.Second):
+		suite.FailNow("timed out waiting for invalidation")
+	}
+}
+
+func (suite *InvalidationBusTestSuite) TestPublishDeliversToOtherNodesOnly() {
+	transport := &loopbackTransport{}
+	nodeA := transport.newBus(0)
+	nodeB := transport.newBus(0)
+	recorderA := newInvalidationRecorder()
+	recorderB := newInvalidationRecorder()
+	nodeA.Subscribe("EntityTypeByIDCache", recorderA.handle)
+	nodeB.Subscribe("EntityTypeByIDCache", recorderB.handle)
+
+	suite.NoError(nodeA.Publish(context.Background(), "EntityTypeByIDCache", "schema-1"))
+
+	suite.waitForBatch(recorderB)
+	suite.Equal([]recordedInvalidation{{keys: []CacheKey{{Key: "schema-1"}}}}, recorderB.batches)
+	suite.Empty(recorderA.batches)
+}
+
+func (suite *InvalidationBusTestSuite) TestReceiveDiscardsStaleVersions() {
+	bus := newInvalidationBus(time.Hour, nil)
+	bus.Subscribe("FlowByIDCache", newInvalidationRecorder().handle)
+
+	send := func(version int64) {
+		payload, err := json.Marshal(InvalidationEvent{
+			CacheName: "FlowByIDCache", Key: "flow-1", Version: version, Origin: "other-node",
+		})
+		suite.Require().NoError(err)
+		bus.receive(payload)
+	}
+
+	send(10)
+	send(10)
+	send(5)
+	suite.Equal(int64(10), bus.versions["FlowByIDCache\x00flow-1"])
+	suite.Len(bus.pending["FlowByIDCache"].keys, 1)
+}
+
+func (suite *InvalidationBusTestSuite) TestReceiveIgnoresUnsubscribedAndMalformedEvents() {
+	bus := newInvalidationBus(time.Hour, nil)
+
+	bus.receive([]byte("not json"))
+	payload, err := json.Marshal(InvalidationEvent{CacheName: "OtherCache", Key: "k", Version: 1, Origin: "x"})
+	suite.Require().NoError(err)
+	bus.receive(payload)
+
+	suite.Empty(bus.pending)
+	suite.False(bus.flushPending)
+}
+
+func (suite *InvalidationBusTestSuite) TestCoalescesBurstIntoSingleBatch() {
+	transport := &loopbackTransport{}
+	publisher := transport.newBus(0)
+	subscriber := transport.newBus(50 * time.Millisecond)
+	recorder := newInvalidationRecorder()
+	subscriber.Subscribe("InboundClientCache", recorder.handle)
+
+	for _, key := range []string{"app-1", "app-2", "app-1"} {
+		suite.NoError(publisher.Publish(context.Background(), "InboundClientCache", key))
+	}
+
+	suite.waitForBatch(recorder)
+	suite.Len(recorder.batches, 1)
+	suite.False(recorder.batches[0].clearAll)
+	suite.ElementsMatch([]CacheKey{{Key: "app-1"}, {Key: "app-2"}}, recorder.batches[0].keys)
+}
+
+func (suite *InvalidationBusTestSuite) TestCollapsesLargeBurstIntoClear() {
+	transport := &loopbackTransport{}
+	publisher := transport.newBus(0)
+	subscriber := transport.newBus(50 * time.Millisecond)
+	recorder := newInvalidationRecorder()
+	subscriber.Subscribe("EntityByIDCache", recorder.handle)
+
+	for i := 0; i <= maxPendingInvalidationKeys; i++ {
+		suite.NoError(publisher.Publish(context.Background(), "EntityByIDCache", time.Duration(i).String()))
+	}
+
+	suite.waitForBatch(recorder)
+	suite.Len(recorder.batches, 1)
+	suite.True(recorder.batches[0].clearAll)
+	suite.Empty(recorder.batches[0].keys)
+}
+
+func (suite *InvalidationBusTestSuite) TestNextVersionIsMonotonic() {
+	bus := newInvalidationBus(0, nil)
+	fixed := time.Unix(100, 0)
+	bus.now = func() time.Time { return fixed }
+
+	first := bus.nextVersion()
+	second := bus.nextVersion()
+	suite.Greater(second, first)
+}
+
+func (suite *InvalidationBusTestSuite) TestCacheDeletePropagatesToOtherNode() {
+	transport := &loopbackTransport{}
+	busA := transport.newBus(0)
+	busB := transport.newBus(0)
+	cacheConfig := config.GetServerRuntime().Config.Cache
+
+	newNodeCache := func(bus *invalidationBus) *Cache[string] {
+		c := &Cache[string]{
+			enabled:   true,
+			cacheName: "FlowByHandleCache",
+			cacheImpl: newInMemoryCache[string]("FlowByHandleCache", true, cacheConfig, config.CacheProperty{}),
+		}
+		c.subscribeInvalidations(bus)
+		return c
+	}
+	cacheA := newNodeCache(busA)
+	cacheB := newNodeCache(busB)
+	recorder := newInvalidationRecorder()
+	busB.Subscribe("FlowByHandleCache", recorder.handle)
+
+	ctx := context.Background()
+	key := CacheKey{Key: "default-basic-flow"}
+	suite.NoError(cacheA.Set(ctx, key, "v1"))
+	suite.NoError(cacheB.Set(ctx, key, "v1"))
+
+	suite.NoError(cacheA.Delete(ctx, key))
+	suite.waitForBatch(recorder)
+
+	_, found := cacheB.Get(ctx, key)
+	suite.False(found)
+}
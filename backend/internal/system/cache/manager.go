@@ -38,6 +38,7 @@ type CacheManagerInterface interface {
 	getCache(cacheKey string) (interface{}, bool)
 	addCache(cacheKey string, cacheInstance interface{})
 	getRedisClient() *redis.Client
+	getInvalidationBus() InvalidationBusInterface
 	startCleanupRoutine()
 	cleanupAllCaches()
 	reset()
@@ -50,6 +51,7 @@ type CacheManager struct {
 	enabled         bool
 	cleanupInterval time.Duration
 	redisClient     *redis.Client
+	invalidationBus InvalidationBusInterface
 }
 
 // Initialize creates and returns a new CacheManagerInterface instance.
@@ -71,18 +73,7 @@ func Initialize() CacheManagerInterface {
 	cm.enabled = true
 
 	if getCacheType(cacheConfig) == cacheTypeRedis {
-		cm.redisClient = redis.NewClient(&redis.Options{
-			Addr:            cacheConfig.Redis.Address,
-			Username:        cacheConfig.Redis.Username,
-			Password:        cacheConfig.Redis.Password,
-			DB:              cacheConfig.Redis.DB,
-			MaxRetries:      cacheConfig.Redis.MaxRetries,
-			MinRetryBackoff: time.Duration(cacheConfig.Redis.MinRetryBackoffMS) * time.Millisecond,
-			MaxRetryBackoff: time.Duration(cacheConfig.Redis.MaxRetryBackoffMS) * time.Millisecond,
-			DialTimeout:     time.Duration(cacheConfig.Redis.DialTimeoutMS) * time.Millisecond,
-			ReadTimeout:     time.Duration(cacheConfig.Redis.ReadTimeoutMS) * time.Millisecond,
-			WriteTimeout:    time.Duration(cacheConfig.Redis.WriteTimeoutMS) * time.Millisecond,
-		})
+		cm.redisClient = newRedisClient(cacheConfig.Redis)
 		if err := cm.redisClient.Ping(context.Background()).Err(); err != nil {
 			logger.Error("Failed to connect to Redis. Cache initialization aborted.", log.Error(err))
 			if closeErr := cm.redisClient.Close(); closeErr != nil {
@@ -98,11 +89,74 @@ func Initialize() CacheManagerInterface {
 		cm.startCleanupRoutine()
 	}
 
+	if cacheConfig.Invalidation.Enabled {
+		cm.initInvalidationBus(cacheConfig)
+	}
+
 	logger.Debug("Cache Manager initialized", log.Bool("enabled", cm.enabled),
 		log.Any("cleanupInterval", cm.cleanupInterval))
 	return cm
 }
 
+// initInvalidationBus connects the invalidation bus over Redis pub/sub. The cache Redis client is reused
+// when caches are stored in Redis; otherwise a dedicated connection is opened for the bus.
+func (cm *CacheManager) initInvalidationBus(cacheConfig config.CacheConfig) {
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, "CacheManager"))
+
+	client := cm.redisClient
+	if client == nil {
+		if cacheConfig.Redis.Address == "" {
+			logger.Error("Cache invalidation requires cache.redis.address to be configured. " +
+				"Invalidations will not be propagated to other nodes.")
+			return
+		}
+		client = newRedisClient(cacheConfig.Redis)
+		if err := client.Ping(context.Background()).Err(); err != nil {
+			logger.Error("Failed to connect to Redis for cache invalidation. "+
+				"Invalidations will not be propagated to other nodes.", log.Error(err))
+			if closeErr := client.Close(); closeErr != nil {
+				logger.Warn("Failed to close Redis client after ping failure", log.Error(closeErr))
+			}
+			return
+		}
+	}
+
+	channel := cacheConfig.Invalidation.Channel
+	if channel == "" {
+		channel = defaultInvalidationChannel
+	}
+	channel = buildRedisKeyPrefix(cacheConfig.Redis.KeyPrefix) + ":" + channel
+	coalesceWindow := time.Duration(cacheConfig.Invalidation.CoalesceWindowMS) * time.Millisecond
+
+	bus := newRedisInvalidationBus(client, channel, coalesceWindow)
+	if client != cm.redisClient {
+		closeTransport := bus.closeTransport
+		bus.closeTransport = func() {
+			closeTransport()
+			if err := client.Close(); err != nil {
+				logger.Warn("Failed to close cache invalidation Redis client", log.Error(err))
+			}
+		}
+	}
+	cm.invalidationBus = bus
+}
+
+// newRedisClient creates a Redis client from the given connection settings.
+func newRedisClient(redisConfig config.RedisConfig) *redis.Client {
+	return redis.NewClient(&redis.Options{
+		Addr:            redisConfig.Address,
+		Username:        redisConfig.Username,
+		Password:        redisConfig.Password,
+		DB:              redisConfig.DB,
+		MaxRetries:      redisConfig.MaxRetries,
+		MinRetryBackoff: time.Duration(redisConfig.MinRetryBackoffMS) * time.Millisecond,
+		MaxRetryBackoff: time.Duration(redisConfig.MaxRetryBackoffMS) * time.Millisecond,
+		DialTimeout:     time.Duration(redisConfig.DialTimeoutMS) * time.Millisecond,
+		ReadTimeout:     time.Duration(redisConfig.ReadTimeoutMS) * time.Millisecond,
+		WriteTimeout:    time.Duration(redisConfig.WriteTimeoutMS) * time.Millisecond,
+	})
+}
+
 // Close shuts down the CacheManager and releases resources.
 func (cm *CacheManager) Close() {
 	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, "CacheManager"))
@@ -110,6 +164,11 @@ func (cm *CacheManager) Close() {
 	cm.mu.Lock()
 	defer cm.mu.Unlock()
 
+	if cm.invalidationBus != nil {
+		cm.invalidationBus.Close()
+		cm.invalidationBus = nil
+	}
+
 	if cm.redisClient != nil {
 		if err := cm.redisClient.Close(); err != nil {
 			logger.Warn("Failed to close Redis client", log.Error(err))
@@ -152,6 +211,11 @@ func (cm *CacheManager) getRedisClient() *redis.Client {
 	return cm.redisClient
 }
 
+// getInvalidationBus returns the cache invalidation bus, or nil if invalidation is not enabled.
+func (cm *CacheManager) getInvalidationBus() InvalidationBusInterface {
+	return cm.invalidationBus
+}
+
 // startCleanupRoutine starts a background routine to clean up expired caches at regular intervals.
 func (cm *CacheManager) startCleanupRoutine() {
 	if cm.cleanupInterval <= 0 {
@@ -291,6 +355,9 @@ func newCache[T any](cm CacheManagerInterface, cacheName string) CacheInterface[
 		cacheName: cacheName,
 		cacheImpl: internalCache,
 	}
+	if _, isLocal := internalCache.(*inMemoryCache[T]); isLocal {
+		cacheInst.subscribeInvalidations(cm.getInvalidationBus())
+	}
 
 	return cacheInst
 }
@@ -339,6 +406,9 @@ func GetInMemoryCache[T any](cm CacheManagerInterface, cacheName string) CacheIn
 		cacheName: cacheName,
 		cacheImpl: internalCache,
 	}
+	if newCacheInst.enabled {
+		newCacheInst.subscribeInvalidations(cm.getInvalidationBus())
+	}
 	cm.addCache(cacheKey, newCacheInst)
 	return newCacheInst
 }
@@ -0,0 +1,40 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package cachewarming
+
+import "time"
+
+const (
+	loggerComponentName = "CacheWarming"
+
+	// defaultConcurrency applies when no concurrency is configured.
+	defaultConcurrency = 4
+	// defaultTimeBudget applies when no time budget is configured.
+	defaultTimeBudget = 30 * time.Second
+)
+
+// Resource types that can be warmed on startup.
+const (
+	// ResourceTypeEntityTypes warms the user and agent types.
+	ResourceTypeEntityTypes = "entity_types"
+	// ResourceTypeApplications warms the inbound clients and OAuth profiles of applications.
+	ResourceTypeApplications = "applications"
+	// ResourceTypeFlows warms the flow definitions and their compiled graphs.
+	ResourceTypeFlows = "flows"
+)
@@ -0,0 +1,44 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package cachewarming
+
+import (
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+)
+
+// Initialize creates the cache warming service with the configured settings. Warming is skipped when the
+// cache is disabled, as there is nothing to load the resources into.
+func Initialize() CacheWarmingServiceInterface {
+	cfg := config.GetServerRuntime().Config.Cache
+	warmingConfig := cfg.Warming
+
+	concurrency := defaultConcurrency
+	if warmingConfig.Concurrency > 0 {
+		concurrency = warmingConfig.Concurrency
+	}
+	timeBudget := defaultTimeBudget
+	if warmingConfig.TimeBudget > 0 {
+		timeBudget = time.Duration(warmingConfig.TimeBudget) * time.Second
+	}
+
+	return newCacheWarmingService(warmingConfig.Enabled && !cfg.Disabled, warmingConfig.ResourceTypes,
+		concurrency, timeBudget, warmingConfig.ReadinessGate)
+}
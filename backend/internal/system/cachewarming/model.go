@@ -0,0 +1,55 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package cachewarming
+
+import "context"
+
+// Warmer loads the resources of a resource type into their caches. Resources must be loaded through the
+// read-through lookups of their cache-backed stores, so that warming only fills entries that are missing
+// and never overwrites or invalidates entries written by other nodes.
+type Warmer interface {
+	// ListKeys returns the keys of the resources to warm.
+	ListKeys(ctx context.Context) ([]string, error)
+	// Load loads the resource with the given key through its cache.
+	Load(ctx context.Context, key string) error
+}
+
+// FuncWarmer adapts a pair of functions to the Warmer interface.
+type FuncWarmer struct {
+	ListFunc func(ctx context.Context) ([]string, error)
+	LoadFunc func(ctx context.Context, key string) error
+}
+
+// ListKeys calls ListFunc.
+func (w FuncWarmer) ListKeys(ctx context.Context) ([]string, error) {
+	return w.ListFunc(ctx)
+}
+
+// Load calls LoadFunc.
+func (w FuncWarmer) Load(ctx context.Context, key string) error {
+	return w.LoadFunc(ctx, key)
+}
+
+// resourceReport summarizes the warming of one resource type.
+type resourceReport struct {
+	resourceType string
+	total        int
+	loaded       int
+	failed       int
+}
@@ -0,0 +1,222 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package cachewarming
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/system/log"
+)
+
+// CacheWarmingServiceInterface loads frequently used resources into their caches on startup.
+type CacheWarmingServiceInterface interface {
+	// RegisterWarmer registers the warmer of a resource type. A later registration replaces an earlier one.
+	RegisterWarmer(resourceType string, warmer Warmer)
+	// Start warms the caches in the background and returns a channel that is closed once warming
+	// finishes or runs out of its time budget. When the readiness gate is enabled the gate reports the
+	// node as warming until then.
+	Start(ctx context.Context, gate ReadinessGate) <-chan struct{}
+}
+
+// ReadinessGate holds back the readiness of the node while its caches are warmed.
+type ReadinessGate interface {
+	MarkWarming()
+	MarkWarm()
+}
+
+// cacheWarmingService is the default implementation of CacheWarmingServiceInterface.
+type cacheWarmingService struct {
+	enabled       bool
+	resourceTypes []string
+	concurrency   int
+	timeBudget    time.Duration
+	readinessGate bool
+	mu            sync.Mutex
+	warmers       map[string]Warmer
+	now           func() time.Time
+	logger        *log.Logger
+}
+
+// newCacheWarmingService creates a cache warming service with the given settings.
+func newCacheWarmingService(enabled bool, resourceTypes []string, concurrency int, timeBudget time.Duration,
+	readinessGate bool) *cacheWarmingService {
+	return &cacheWarmingService{
+		enabled:       enabled,
+		resourceTypes: resourceTypes,
+		concurrency:   concurrency,
+		timeBudget:    timeBudget,
+		readinessGate: readinessGate,
+		warmers:       make(map[string]Warmer),
+		now:           time.Now,
+		logger:        log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName)),
+	}
+}
+
+// RegisterWarmer registers the warmer of a resource type.
+func (s *cacheWarmingService) RegisterWarmer(resourceType string, warmer Warmer) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.warmers[resourceType] = warmer
+}
+
+// Start warms the caches in the background.
+func (s *cacheWarmingService) Start(ctx context.Context, gate ReadinessGate) <-chan struct{} {
+	done := make(chan struct{})
+	if !s.enabled {
+		close(done)
+		return done
+	}
+
+	gated := s.readinessGate && gate != nil
+	if gated {
+		gate.MarkWarming()
+	}
+
+	go func() {
+		defer close(done)
+		if gated {
+			defer gate.MarkWarm()
+		}
+		s.warm(ctx)
+	}()
+	return done
+}
+
+// warm warms every selected resource type within the time budget and logs the outcome.
+func (s *cacheWarmingService) warm(ctx context.Context) []resourceReport {
+	ctx, cancel := context.WithTimeout(ctx, s.timeBudget)
+	defer cancel()
+
+	started := s.now()
+	s.logger.Info("Started warming caches", log.Int("concurrency", s.concurrency),
+		log.Int("timeBudgetSeconds", int(s.timeBudget.Seconds())))
+
+	reports := make([]resourceReport, 0)
+	for _, resourceType := range s.selectResourceTypes() {
+		if ctx.Err() != nil {
+			break
+		}
+		reports = append(reports, s.warmResourceType(ctx, resourceType))
+	}
+
+	loaded := 0
+	failed := 0
+	for _, report := range reports {
+		loaded += report.loaded
+		failed += report.failed
+	}
+	if ctx.Err() != nil {
+		s.logger.Warn("Stopped warming caches at the end of the time budget", log.Int("loaded", loaded),
+			log.Int("failed", failed), log.Int("elapsedMs", int(s.now().Sub(started).Milliseconds())))
+	} else {
+		s.logger.Info("Finished warming caches", log.Int("loaded", loaded), log.Int("failed", failed),
+			log.Int("elapsedMs", int(s.now().Sub(started).Milliseconds())))
+	}
+	return reports
+}
+
+// warmResourceType loads the resources of one type through its warmer, at most concurrency at a time.
+func (s *cacheWarmingService) warmResourceType(ctx context.Context, resourceType string) resourceReport {
+	s.mu.Lock()
+	warmer := s.warmers[resourceType]
+	s.mu.Unlock()
+
+	report := resourceReport{resourceType: resourceType}
+	started := s.now()
+
+	keys, err := warmer.ListKeys(ctx)
+	if err != nil {
+		s.logger.Warn("Failed to list the resources to warm", log.String("resourceType", resourceType),
+			log.Error(err))
+		return report
+	}
+	report.total = len(keys)
+
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	slots := make(chan struct{}, s.concurrency)
+schedule:
+	for _, key := range keys {
+		select {
+		case <-ctx.Done():
+			break schedule
+		case slots <- struct{}{}:
+		}
+		if ctx.Err() != nil {
+			<-slots
+			break
+		}
+
+		wg.Add(1)
+		go func(key string) {
+			defer wg.Done()
+			defer func() { <-slots }()
+
+			err := warmer.Load(ctx, key)
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				report.failed++
+				if s.logger.IsDebugEnabled() {
+					s.logger.Debug("Failed to warm resource", log.String("resourceType", resourceType),
+						log.String("key", key), log.Error(err))
+				}
+				return
+			}
+			report.loaded++
+		}(key)
+	}
+	wg.Wait()
+
+	s.logger.Info("Warmed resource caches", log.String("resourceType", resourceType),
+		log.Int("total", report.total), log.Int("loaded", report.loaded), log.Int("failed", report.failed),
+		log.Int("skipped", report.total-report.loaded-report.failed),
+		log.Int("elapsedMs", int(s.now().Sub(started).Milliseconds())))
+	return report
+}
+
+// selectResourceTypes returns the configured resource types that have a registered warmer, or every
+// registered resource type in a stable order when none are configured.
+func (s *cacheWarmingService) selectResourceTypes() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if len(s.resourceTypes) == 0 {
+		resourceTypes := make([]string, 0, len(s.warmers))
+		for resourceType := range s.warmers {
+			resourceTypes = append(resourceTypes, resourceType)
+		}
+		sort.Strings(resourceTypes)
+		return resourceTypes
+	}
+
+	resourceTypes := make([]string, 0, len(s.resourceTypes))
+	for _, resourceType := range s.resourceTypes {
+		if _, ok := s.warmers[resourceType]; !ok {
+			s.logger.Warn("Skipping cache warming of an unsupported resource type",
+				log.String("resourceType", resourceType))
+			continue
+		}
+		resourceTypes = append(resourceTypes, resourceType)
+	}
+	return resourceTypes
+}
@@ -0,0 +1,210 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package cachewarming
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+)
+
+type CacheWarmingServiceTestSuite struct {
+	suite.Suite
+	ctx context.Context
+}
+
+func TestCacheWarmingServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(CacheWarmingServiceTestSuite))
+}
+
+func (s *CacheWarmingServiceTestSuite) SetupTest() {
+	s.ctx = context.Background()
+}
+
+func (s *CacheWarmingServiceTestSuite) TearDownTest() {
+	config.ResetServerRuntime()
+}
+
+// waitFor waits for the warming started by Start to finish.
+func (s *CacheWarmingServiceTestSuite) waitFor(done <-chan struct{}) {
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		s.FailNow("cache warming did not finish")
+	}
+}
+
+func (s *CacheWarmingServiceTestSuite) TestInitialize_Defaults() {
+	testConfig := &config.Config{}
+	testConfig.Cache.Warming.Enabled = true
+	s.Require().NoError(config.InitializeServerRuntime("", testConfig))
+
+	svc, ok := Initialize().(*cacheWarmingService)
+
+	s.Require().True(ok)
+	s.True(svc.enabled)
+	s.Equal(defaultConcurrency, svc.concurrency)
+	s.Equal(defaultTimeBudget, svc.timeBudget)
+	s.False(svc.readinessGate)
+}
+
+func (s *CacheWarmingServiceTestSuite) TestInitialize_ConfiguredAndDisabledCache() {
+	testConfig := &config.Config{}
+	testConfig.Cache.Disabled = true
+	testConfig.Cache.Warming = config.CacheWarmingConfig{
+		Enabled: true, ResourceTypes: []string{ResourceTypeFlows}, Concurrency: 2, TimeBudget: 5,
+		ReadinessGate: true,
+	}
+	s.Require().NoError(config.InitializeServerRuntime("", testConfig))
+
+	svc, ok := Initialize().(*cacheWarmingService)
+
+	s.Require().True(ok)
+	s.False(svc.enabled)
+	s.Equal([]string{ResourceTypeFlows}, svc.resourceTypes)
+	s.Equal(2, svc.concurrency)
+	s.Equal(5*time.Second, svc.timeBudget)
+	s.True(svc.readinessGate)
+}
+
+func (s *CacheWarmingServiceTestSuite) TestStart_Disabled() {
+	svc := newCacheWarmingService(false, nil, 1, time.Second, true)
+	warmer := NewWarmerMock(s.T())
+	gate := NewReadinessGateMock(s.T())
+	svc.RegisterWarmer(ResourceTypeFlows, warmer)
+
+	s.waitFor(svc.Start(s.ctx, gate))
+
+	warmer.AssertNotCalled(s.T(), "ListKeys", mock.Anything)
+	gate.AssertNotCalled(s.T(), "MarkWarming")
+}
+
+func (s *CacheWarmingServiceTestSuite) TestStart_WarmsRegisteredTypesBehindGate() {
+	svc := newCacheWarmingService(true, nil, 2, time.Second, true)
+	flows := NewWarmerMock(s.T())
+	applications := NewWarmerMock(s.T())
+	gate := NewReadinessGateMock(s.T())
+	var warming atomic.Bool
+	gate.On("MarkWarming").Run(func(mock.Arguments) { warming.Store(true) }).Once()
+	gate.On("MarkWarm").Run(func(mock.Arguments) { warming.Store(false) }).Once()
+	flows.On("ListKeys", mock.Anything).Return([]string{"f1", "f2"}, nil).Once()
+	flows.On("Load", mock.Anything, mock.Anything).Run(func(mock.Arguments) {
+		s.True(warming.Load())
+	}).Return(nil).Twice()
+	applications.On("ListKeys", mock.Anything).Return([]string{"a1"}, nil).Once()
+	applications.On("Load", mock.Anything, "a1").Return(errors.New("load failed")).Once()
+	svc.RegisterWarmer(ResourceTypeFlows, flows)
+	svc.RegisterWarmer(ResourceTypeApplications, applications)
+
+	s.waitFor(svc.Start(s.ctx, gate))
+
+	s.False(warming.Load())
+}
+
+func (s *CacheWarmingServiceTestSuite) TestStart_WithoutGate() {
+	svc := newCacheWarmingService(true, nil, 1, time.Second, false)
+	gate := NewReadinessGateMock(s.T())
+
+	s.waitFor(svc.Start(s.ctx, gate))
+
+	gate.AssertNotCalled(s.T(), "MarkWarming")
+}
+
+func (s *CacheWarmingServiceTestSuite) TestWarm_ConfiguredTypesOnly() {
+	svc := newCacheWarmingService(true, []string{"unknown", ResourceTypeEntityTypes}, 1, time.Second, false)
+	entityTypes := NewWarmerMock(s.T())
+	flows := NewWarmerMock(s.T())
+	entityTypes.On("ListKeys", mock.Anything).Return([]string{"user:t1"}, nil).Once()
+	entityTypes.On("Load", mock.Anything, "user:t1").Return(nil).Once()
+	svc.RegisterWarmer(ResourceTypeEntityTypes, entityTypes)
+	svc.RegisterWarmer(ResourceTypeFlows, flows)
+
+	reports := svc.warm(s.ctx)
+
+	s.Equal([]resourceReport{{resourceType: ResourceTypeEntityTypes, total: 1, loaded: 1}}, reports)
+	flows.AssertNotCalled(s.T(), "ListKeys", mock.Anything)
+}
+
+func (s *CacheWarmingServiceTestSuite) TestWarm_ListFailureContinues() {
+	svc := newCacheWarmingService(true, nil, 1, time.Second, false)
+	applications := NewWarmerMock(s.T())
+	flows := NewWarmerMock(s.T())
+	applications.On("ListKeys", mock.Anything).Return(nil, errors.New("db down")).Once()
+	flows.On("ListKeys", mock.Anything).Return([]string{"f1"}, nil).Once()
+	flows.On("Load", mock.Anything, "f1").Return(nil).Once()
+	svc.RegisterWarmer(ResourceTypeApplications, applications)
+	svc.RegisterWarmer(ResourceTypeFlows, flows)
+
+	reports := svc.warm(s.ctx)
+
+	s.Equal([]resourceReport{
+		{resourceType: ResourceTypeApplications},
+		{resourceType: ResourceTypeFlows, total: 1, loaded: 1},
+	}, reports)
+}
+
+func (s *CacheWarmingServiceTestSuite) TestWarm_RespectsConcurrency() {
+	svc := newCacheWarmingService(true, nil, 2, 5*time.Second, false)
+	flows := NewWarmerMock(s.T())
+	var mu sync.Mutex
+	inFlight := 0
+	maxInFlight := 0
+	flows.On("ListKeys", mock.Anything).Return([]string{"f1", "f2", "f3", "f4", "f5", "f6"}, nil).Once()
+	flows.On("Load", mock.Anything, mock.Anything).Run(func(mock.Arguments) {
+		mu.Lock()
+		inFlight++
+		maxInFlight = max(maxInFlight, inFlight)
+		mu.Unlock()
+		time.Sleep(10 * time.Millisecond)
+		mu.Lock()
+		inFlight--
+		mu.Unlock()
+	}).Return(nil).Times(6)
+	svc.RegisterWarmer(ResourceTypeFlows, flows)
+
+	reports := svc.warm(s.ctx)
+
+	s.Equal(6, reports[0].loaded)
+	s.LessOrEqual(maxInFlight, 2)
+}
+
+func (s *CacheWarmingServiceTestSuite) TestWarm_StopsAtTimeBudget() {
+	svc := newCacheWarmingService(true, nil, 1, 50*time.Millisecond, false)
+	flows := NewWarmerMock(s.T())
+	applications := NewWarmerMock(s.T())
+	applications.On("ListKeys", mock.Anything).Return([]string{"a1", "a2", "a3"}, nil).Once()
+	applications.On("Load", mock.Anything, "a1").Run(func(args mock.Arguments) {
+		<-args.Get(0).(context.Context).Done()
+	}).Return(context.DeadlineExceeded).Once()
+	svc.RegisterWarmer(ResourceTypeApplications, applications)
+	svc.RegisterWarmer(ResourceTypeFlows, flows)
+
+	reports := svc.warm(s.ctx)
+
+	s.Equal([]resourceReport{{resourceType: ResourceTypeApplications, total: 3, failed: 1}}, reports)
+	flows.AssertNotCalled(s.T(), "ListKeys", mock.Anything)
+}
@@ -31,6 +31,7 @@ import (
 	"time"
 
 	"github.com/thunder-id/thunderid/internal/system/cors"
+	"github.com/thunder-id/thunderid/internal/system/proxy"
 	"github.com/thunder-id/thunderid/internal/system/utils"
 
 	yaml "gopkg.in/yaml.v3"
@@ -49,6 +50,9 @@ const schemeHTTPS = "https"
 type SecurityConfig struct {
 	JWKSCacheTTL  int                 `yaml:"jwks_cache_ttl" json:"jwks_cache_ttl"`
 	TrustedIssuer TrustedIssuerConfig `yaml:"trusted_issuer" json:"trusted_issuer"`
+	// LegacyIssuers are the issuers whose tokens are accepted for selected APIs while clients migrate from
+	// them.
+	LegacyIssuers []LegacyIssuerConfig `yaml:"legacy_issuers" json:"legacy_issuers"`
 }
 
 // Validate checks the security configuration for correctness, including any nested
@@ -57,17 +61,235 @@ func (c *SecurityConfig) Validate() error {
 	if c.JWKSCacheTTL < 0 {
 		return fmt.Errorf("server.security.jwks_cache_ttl must be non-negative (got %d)", c.JWKSCacheTTL)
 	}
-	return c.TrustedIssuer.Validate()
+	if err := c.TrustedIssuer.Validate(); err != nil {
+		return err
+	}
+
+	issuers := make(map[string]struct{}, len(c.LegacyIssuers))
+	for i := range c.LegacyIssuers {
+		legacyIssuer := &c.LegacyIssuers[i]
+		if err := legacyIssuer.Validate(); err != nil {
+			return err
+		}
+		if legacyIssuer.Issuer == c.TrustedIssuer.Issuer {
+			return fmt.Errorf("server.security.legacy_issuers issuer %q is the trusted issuer", legacyIssuer.Issuer)
+		}
+		if _, exists := issuers[legacyIssuer.Issuer]; exists {
+			return fmt.Errorf("server.security.legacy_issuers issuer %q is configured more than once",
+				legacyIssuer.Issuer)
+		}
+		issuers[legacyIssuer.Issuer] = struct{}{}
+	}
+	return nil
 }
 
 // ServerConfig holds the server configuration details.
 type ServerConfig struct {
-	Hostname       string         `yaml:"hostname" json:"hostname"`
-	Port           int            `yaml:"port" json:"port"`
-	HTTPOnly       bool           `yaml:"http_only" json:"http_only"`
-	PublicURL      string         `yaml:"public_url" json:"public_url"`
-	Identifier     string         `yaml:"identifier" json:"identifier"`
-	SecurityConfig SecurityConfig `yaml:"security" json:"security"`
+	Hostname        string            `yaml:"hostname" json:"hostname"`
+	Port            int               `yaml:"port" json:"port"`
+	HTTPOnly        bool              `yaml:"http_only" json:"http_only"`
+	PublicURL       string            `yaml:"public_url" json:"public_url"`
+	Identifier      string            `yaml:"identifier" json:"identifier"`
+	SecurityConfig  SecurityConfig    `yaml:"security" json:"security"`
+	Proxy           ProxyConfig       `yaml:"proxy" json:"proxy"`
+	RequestLimits   RequestLimits     `yaml:"request_limits" json:"request_limits"`
+	RequestTimeouts RequestTimeouts   `yaml:"request_timeouts" json:"request_timeouts"`
+	Shutdown        ShutdownConfig    `yaml:"shutdown" json:"shutdown"`
+	ErrorFormat     ErrorFormatConfig `yaml:"error_format" json:"error_format"`
+	// AdaptiveConcurrency sheds the excess traffic of the token and authentication endpoints under load.
+	AdaptiveConcurrency AdaptiveConcurrency `yaml:"adaptive_concurrency" json:"adaptive_concurrency"`
+}
+
+// ProxyConfig holds the configuration for running the server behind reverse proxies and load balancers.
+// Forwarding headers, PROXY protocol headers and forwarded client certificates are honoured only when
+// they arrive from one of the trusted proxies.
+type ProxyConfig struct {
+	TrustedProxies   []string `yaml:"trusted_proxies" json:"trusted_proxies"`
+	ProxyProtocol    bool     `yaml:"proxy_protocol" json:"proxy_protocol"`
+	ClientCertHeader string   `yaml:"client_cert_header" json:"client_cert_header"`
+}
+
+// Validate checks that the trusted proxy entries and the client certificate header are well formed.
+func (c *ProxyConfig) Validate() error {
+	if _, err := proxy.NewResolver(c.TrustedProxies, c.ClientCertHeader); err != nil {
+		return fmt.Errorf("server.proxy: %w", err)
+	}
+	if len(c.TrustedProxies) == 0 && (c.ProxyProtocol || c.ClientCertHeader != "") {
+		return fmt.Errorf("server.proxy.trusted_proxies is required when proxy_protocol or client_cert_header is set")
+	}
+	return nil
+}
+
+// RequestLimits holds the limits enforced on request bodies before they reach the route handlers.
+type RequestLimits struct {
+	// MaxBodySize is the maximum request body size in bytes for routes without a route limit. Zero disables it.
+	MaxBodySize int64 `yaml:"max_body_size" json:"max_body_size"`
+	// MaxJSONDepth is the maximum nesting depth of objects and arrays in a JSON body. Zero disables it.
+	MaxJSONDepth int `yaml:"max_json_depth" json:"max_json_depth"`
+	// MaxJSONArrayLength is the maximum number of elements of any array in a JSON body. Zero disables it.
+	MaxJSONArrayLength int `yaml:"max_json_array_length" json:"max_json_array_length"`
+	// MaxJSONValues is the maximum number of values in a JSON body decoded as a stream by the route
+	// handler. Zero disables it.
+	MaxJSONValues int `yaml:"max_json_values" json:"max_json_values"`
+	// Routes overrides the body size limit for groups of routes. The first matching route applies.
+	Routes []RouteRequestLimit `yaml:"routes" json:"routes"`
+}
+
+// RouteRequestLimit overrides the request body size limit for the routes matching a path pattern.
+type RouteRequestLimit struct {
+	// Path is a path pattern where "*" matches a single segment and a trailing "/**" matches any subpath.
+	Path        string `yaml:"path" json:"path"`
+	MaxBodySize int64  `yaml:"max_body_size" json:"max_body_size"`
+	// StreamJSON leaves the JSON limits to the route handler, which enforces them while decoding the body
+	// as a stream instead of the body being buffered and checked up front.
+	StreamJSON bool `yaml:"stream_json" json:"stream_json"`
+}
+
+// Validate checks that the request limits are not negative and that every route limit has a path.
+func (c *RequestLimits) Validate() error {
+	if c.MaxBodySize < 0 || c.MaxJSONDepth < 0 || c.MaxJSONArrayLength < 0 || c.MaxJSONValues < 0 {
+		return fmt.Errorf("server.request_limits values must not be negative")
+	}
+	for _, route := range c.Routes {
+		if !strings.HasPrefix(route.Path, "/") {
+			return fmt.Errorf("server.request_limits.routes path %q must start with '/'", route.Path)
+		}
+		if route.MaxBodySize < 0 {
+			return fmt.Errorf("server.request_limits.routes max_body_size of %q must not be negative", route.Path)
+		}
+	}
+	return nil
+}
+
+// RequestTimeouts holds the time budgets within which requests must be served. The budget is carried as
+// the request context deadline, and requests that exceed it are answered with 504 Gateway Timeout.
+type RequestTimeouts struct {
+	// Default is the budget in seconds for routes without a route budget. Zero disables it.
+	Default int64 `yaml:"default" json:"default"`
+	// Routes overrides the budget for groups of routes. The first matching route applies.
+	Routes []RouteRequestTimeout `yaml:"routes" json:"routes"`
+}
+
+// RouteRequestTimeout overrides the request time budget for the routes matching a path pattern.
+type RouteRequestTimeout struct {
+	// Path is a path pattern where "*" matches a single segment and a trailing "/**" matches any subpath.
+	Path string `yaml:"path" json:"path"`
+	// Timeout is the budget in seconds. Zero disables the budget for the matching routes.
+	Timeout int64 `yaml:"timeout" json:"timeout"`
+}
+
+// Validate checks that the request timeouts are not negative and that every route timeout has a path.
+func (c *RequestTimeouts) Validate() error {
+	if c.Default < 0 {
+		return fmt.Errorf("server.request_timeouts.default must not be negative")
+	}
+	for _, route := range c.Routes {
+		if !strings.HasPrefix(route.Path, "/") {
+			return fmt.Errorf("server.request_timeouts.routes path %q must start with '/'", route.Path)
+		}
+		if route.Timeout < 0 {
+			return fmt.Errorf("server.request_timeouts.routes timeout of %q must not be negative", route.Path)
+		}
+	}
+	return nil
+}
+
+// AdaptiveConcurrency holds the adaptive concurrency limits of the routes that shed excess traffic under
+// load. Each route keeps its own limit of concurrent requests, which grows additively while requests are
+// served within the latency tolerance and shrinks multiplicatively when they slow down or fail. Requests
+// over the limit are answered with 503 Service Unavailable and a Retry-After header.
+type AdaptiveConcurrency struct {
+	Enabled bool `yaml:"enabled" json:"enabled"`
+	// Routes are the path patterns that are limited. Each pattern keeps its own limit.
+	Routes []string `yaml:"routes" json:"routes"`
+	// InitialLimit is the number of concurrent requests a route admits before any latency is observed.
+	InitialLimit int `yaml:"initial_limit" json:"initial_limit"`
+	// MinLimit and MaxLimit bound the number of concurrent requests a route admits.
+	MinLimit int `yaml:"min_limit" json:"min_limit"`
+	MaxLimit int `yaml:"max_limit" json:"max_limit"`
+	// LatencyTolerance is the multiple of the baseline latency of a route above which a request is treated
+	// as a sign of overload.
+	LatencyTolerance float64 `yaml:"latency_tolerance" json:"latency_tolerance"`
+	// BackoffRatio is the factor the limit is multiplied by on overload.
+	BackoffRatio float64 `yaml:"backoff_ratio" json:"backoff_ratio"`
+	// MaxClientShare is the fraction of the limit of a route that the requests of a single client may hold.
+	MaxClientShare float64 `yaml:"max_client_share" json:"max_client_share"`
+	// RetryAfter is the number of seconds clients are asked to wait before retrying a rejected request.
+	RetryAfter int64 `yaml:"retry_after" json:"retry_after"`
+}
+
+// Validate checks that the limits of an enabled adaptive concurrency configuration are consistent.
+func (c *AdaptiveConcurrency) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+	for _, route := range c.Routes {
+		if !strings.HasPrefix(route, "/") {
+			return fmt.Errorf("server.adaptive_concurrency.routes path %q must start with '/'", route)
+		}
+	}
+	if c.MinLimit < 1 || c.InitialLimit < c.MinLimit || c.MaxLimit < c.InitialLimit {
+		return fmt.Errorf("server.adaptive_concurrency limits must satisfy 1 <= min_limit <= initial_limit <= " +
+			"max_limit")
+	}
+	if c.LatencyTolerance < 1 {
+		return fmt.Errorf("server.adaptive_concurrency.latency_tolerance must be at least 1")
+	}
+	if c.BackoffRatio <= 0 || c.BackoffRatio >= 1 {
+		return fmt.Errorf("server.adaptive_concurrency.backoff_ratio must be between 0 and 1")
+	}
+	if c.MaxClientShare <= 0 || c.MaxClientShare > 1 {
+		return fmt.Errorf("server.adaptive_concurrency.max_client_share must be greater than 0 and at most 1")
+	}
+	if c.RetryAfter < 0 {
+		return fmt.Errorf("server.adaptive_concurrency.retry_after must not be negative")
+	}
+	return nil
+}
+
+// ShutdownConfig holds the configuration for draining the server on shutdown.
+type ShutdownConfig struct {
+	// DrainDelay is the number of seconds the server keeps serving requests after reporting itself as not
+	// ready, giving load balancers time to stop routing new requests to it.
+	DrainDelay int64 `yaml:"drain_delay" json:"drain_delay"`
+	// DrainTimeout is the number of seconds to wait for in-flight requests to complete before the
+	// remaining connections are closed.
+	DrainTimeout int64 `yaml:"drain_timeout" json:"drain_timeout"`
+}
+
+// Validate checks that the shutdown durations are not negative.
+func (c *ShutdownConfig) Validate() error {
+	if c.DrainDelay < 0 || c.DrainTimeout < 0 {
+		return fmt.Errorf("server.shutdown values must not be negative")
+	}
+	return nil
+}
+
+// Supported API error response formats.
+const (
+	// ErrorFormatLegacy writes errors as code, message and description objects.
+	ErrorFormatLegacy = "legacy"
+	// ErrorFormatProblem writes errors as RFC 9457 problem details.
+	ErrorFormatProblem = "problem"
+)
+
+// ErrorFormatConfig holds the configuration for the format of API error responses. Clients can ask for
+// problem details per request with the Accept header regardless of the default format.
+type ErrorFormatConfig struct {
+	// Default is the format of error responses for requests that do not ask for problem details.
+	Default string `yaml:"default" json:"default"`
+	// TypeBaseURI is prefixed to the error code to form the type of a problem details response.
+	TypeBaseURI string `yaml:"type_base_uri" json:"type_base_uri"`
+}
+
+// Validate checks that the default error format is supported.
+func (c *ErrorFormatConfig) Validate() error {
+	switch c.Default {
+	case "", ErrorFormatLegacy, ErrorFormatProblem:
+		return nil
+	default:
+		return fmt.Errorf("server.error_format.default must be %q or %q", ErrorFormatLegacy, ErrorFormatProblem)
+	}
 }
 
 // GateClientConfig holds the client configuration details.
@@ -85,6 +307,9 @@ type TLSConfig struct {
 	MinVersion string `yaml:"min_version" json:"min_version"`
 	CertFile   string `yaml:"cert_file" json:"cert_file"`
 	KeyFile    string `yaml:"key_file" json:"key_file"`
+	// RequestClientCert asks clients for a certificate during the TLS handshake so that it can be used
+	// for mutual TLS client authentication. The certificate is not verified against a CA at this layer.
+	RequestClientCert bool `yaml:"request_client_cert" json:"request_client_cert"`
 }
 
 // DataSource holds the individual database connection details.
@@ -166,6 +391,44 @@ type CacheConfig struct {
 	CleanupInterval int             `yaml:"cleanup_interval" json:"cleanup_interval"`
 	Properties      []CacheProperty `yaml:"properties,omitempty" json:"properties,omitempty"`
 	Redis           RedisConfig     `yaml:"redis" json:"redis"`
+	// Invalidation propagates invalidations of node-local caches to other nodes.
+	Invalidation CacheInvalidationConfig `yaml:"invalidation" json:"invalidation"`
+	// Warming loads frequently used resources into the caches on startup.
+	Warming CacheWarmingConfig `yaml:"warming" json:"warming"`
+}
+
+// CacheWarmingConfig holds the configuration of the cache warming phase run on startup.
+type CacheWarmingConfig struct {
+	Enabled bool `yaml:"enabled" json:"enabled"`
+	// ResourceTypes lists the resource types to warm. An empty list warms every supported resource type.
+	ResourceTypes []string `yaml:"resource_types" json:"resource_types"`
+	// Concurrency is the number of resources loaded in parallel. Zero applies the default of 4.
+	Concurrency int `yaml:"concurrency" json:"concurrency"`
+	// TimeBudget is the number of seconds warming may run before the remaining resources are skipped.
+	// Zero applies the default of 30 seconds.
+	TimeBudget int `yaml:"time_budget" json:"time_budget"`
+	// ReadinessGate keeps the readiness check reporting the node as not ready until warming finishes.
+	ReadinessGate bool `yaml:"readiness_gate" json:"readiness_gate"`
+}
+
+// Validate checks that the cache warming settings are usable.
+func (c *CacheWarmingConfig) Validate() error {
+	if c.Concurrency < 0 {
+		return fmt.Errorf("cache.warming.concurrency must not be negative")
+	}
+	if c.TimeBudget < 0 {
+		return fmt.Errorf("cache.warming.time_budget must not be negative")
+	}
+	return nil
+}
+
+// CacheInvalidationConfig holds the configuration for the cache invalidation bus. Invalidation events
+// are exchanged over Redis pub/sub using the connection settings in cache.redis.
+type CacheInvalidationConfig struct {
+	Enabled bool   `yaml:"enabled" json:"enabled"`
+	Channel string `yaml:"channel" json:"channel"`
+	// CoalesceWindowMS is how long received invalidations are batched before they are applied.
+	CoalesceWindowMS int `yaml:"coalesce_window_ms" json:"coalesce_window_ms"`
 }
 
 // RedisConfig holds the Redis connection configuration.
@@ -196,6 +459,9 @@ type JWTConfig struct {
 type RefreshTokenConfig struct {
 	RenewOnGrant   bool  `yaml:"renew_on_grant" json:"renew_on_grant"`
 	ValidityPeriod int64 `yaml:"validity_period" json:"validity_period"`
+	// IdleTimeout is the number of seconds a refresh token may go unused before it expires.
+	// Zero disables inactivity expiry.
+	IdleTimeout int64 `yaml:"idle_timeout" json:"idle_timeout"`
 }
 
 // AuthorizationCodeConfig holds the authorization code configuration details.
@@ -214,13 +480,377 @@ type PARConfig struct {
 	ExpiresIn  int64 `yaml:"expires_in" json:"expires_in"`
 }
 
+// DeviceAuthorizationConfig holds the OAuth 2.0 Device Authorization Grant (RFC 8628) configuration.
+type DeviceAuthorizationConfig struct {
+	// ExpiresIn is the lifetime in seconds of a device code and its user code. Zero uses the default of
+	// 600 seconds.
+	ExpiresIn int64 `yaml:"expires_in" json:"expires_in"`
+	// Interval is the minimum number of seconds a device must wait between polls of the token endpoint.
+	// Zero uses the default of 5 seconds.
+	Interval int64 `yaml:"interval" json:"interval"`
+	// VerificationURI is the page where users enter the user code. Empty uses the device page of the gate
+	// client.
+	VerificationURI string `yaml:"verification_uri" json:"verification_uri"`
+}
+
+// Validate checks that the durations are not negative and that the verification URI is absolute.
+func (c *DeviceAuthorizationConfig) Validate() error {
+	if c.ExpiresIn < 0 {
+		return fmt.Errorf("oauth.device_authorization.expires_in must be non-negative (got %d)", c.ExpiresIn)
+	}
+	if c.Interval < 0 {
+		return fmt.Errorf("oauth.device_authorization.interval must be non-negative (got %d)", c.Interval)
+	}
+	if c.VerificationURI != "" {
+		parsed, err := url.Parse(c.VerificationURI)
+		if err != nil || !parsed.IsAbs() || parsed.Host == "" {
+			return fmt.Errorf("oauth.device_authorization.verification_uri must be an absolute URL (got %q)",
+				c.VerificationURI)
+		}
+	}
+	return nil
+}
+
+// DPoPConfig holds the OAuth 2.0 Demonstrating Proof of Possession (RFC 9449) configuration.
+type DPoPConfig struct {
+	// ProofMaxAge is the maximum age in seconds of a DPoP proof, measured from its iat claim. Zero uses the
+	// default of 60 seconds.
+	ProofMaxAge int64 `yaml:"proof_max_age" json:"proof_max_age"`
+	// RequireNonce requires DPoP proofs presented at the token endpoint to carry a nonce issued by the server.
+	// Proofs without a valid nonce are answered with a use_dpop_nonce challenge carrying a fresh nonce.
+	RequireNonce bool `yaml:"require_nonce" json:"require_nonce"`
+	// NonceExpiresIn is the lifetime in seconds of a server-issued DPoP nonce. Zero uses the default of 300
+	// seconds.
+	NonceExpiresIn int64 `yaml:"nonce_expires_in" json:"nonce_expires_in"`
+}
+
+// Validate checks that the durations are not negative.
+func (c *DPoPConfig) Validate() error {
+	if c.ProofMaxAge < 0 {
+		return fmt.Errorf("oauth.dpop.proof_max_age must be non-negative (got %d)", c.ProofMaxAge)
+	}
+	if c.NonceExpiresIn < 0 {
+		return fmt.Errorf("oauth.dpop.nonce_expires_in must be non-negative (got %d)", c.NonceExpiresIn)
+	}
+	return nil
+}
+
+// LogoutConfig holds the OIDC front-channel and back-channel logout configuration.
+type LogoutConfig struct {
+	// BackChannelTimeout is the timeout in seconds for each back-channel logout request.
+	BackChannelTimeout int64 `yaml:"backchannel_timeout" json:"backchannel_timeout"`
+}
+
+// MetadataCacheConfig holds the HTTP caching configuration of the discovery and JWKS endpoints.
+type MetadataCacheConfig struct {
+	// MaxAge is the number of seconds shared caches and browsers may serve a discovery or JWKS
+	// response without revalidating it. Zero requires revalidation on every request.
+	MaxAge int64 `yaml:"max_age" json:"max_age"`
+	// PurgeWebhookURL is the endpoint notified with the surrogate keys to purge when the content of a
+	// discovery or JWKS response changes, such as after a key rotation or configuration change.
+	PurgeWebhookURL string `yaml:"purge_webhook_url" json:"purge_webhook_url"`
+}
+
+// PreIssuanceHookConfig holds the configuration of the external policy consulted before access tokens
+// are issued.
+type PreIssuanceHookConfig struct {
+	// URL is the endpoint that receives the token request context and returns an allow or deny decision.
+	// The external policy is disabled when empty.
+	URL string `yaml:"url" json:"url"`
+	// TimeoutMS is the number of milliseconds to wait for a decision from the endpoint. Zero uses the
+	// default of two seconds.
+	TimeoutMS int `yaml:"timeout_ms" json:"timeout_ms"`
+	// FailMode decides the outcome when no decision can be obtained. "closed" denies the token request
+	// and "open" issues the token without annotations.
+	FailMode string `yaml:"fail_mode" json:"fail_mode"`
+	// CacheTTL is the number of seconds a decision is reused for the same token request context.
+	// Zero disables caching.
+	CacheTTL int `yaml:"cache_ttl" json:"cache_ttl"`
+}
+
+// ProtocolTraceConfig holds the configuration of the capture of redacted OAuth protocol messages for clients
+// that enable protocol tracing.
+type ProtocolTraceConfig struct {
+	// RetentionPeriod is the number of seconds a captured protocol trace is kept. Zero uses the default of
+	// one hour.
+	RetentionPeriod int64 `yaml:"retention_period" json:"retention_period"`
+}
+
+// RoleClaimsConfig holds the configuration of the roles claim and the role-derived scopes added to access
+// tokens issued to applications that enable role inclusion.
+type RoleClaimsConfig struct {
+	// ClaimName is the name of the claim carrying the role names. Empty uses "roles".
+	ClaimName string `yaml:"claim_name" json:"claim_name"`
+	// MaxClaimSize is the maximum size in bytes of the serialized roles claim. A larger claim is replaced by a
+	// roles_ref claim pointing to the roles endpoint. Zero disables the limit.
+	MaxClaimSize int `yaml:"max_claim_size" json:"max_claim_size"`
+	// Mappings are the scopes granted by each role.
+	Mappings []RoleScopeMapping `yaml:"mappings" json:"mappings"`
+}
+
+// ClaimFallbackConfig holds the configuration of the fallback chains that applications configure to fill the
+// claims a user has no attribute for.
+type ClaimFallbackConfig struct {
+	// Disabled turns off the fallback chains of all applications.
+	Disabled bool `yaml:"disabled" json:"disabled"`
+	// Gravatar configures the fallback source that derives a picture from the verified email address.
+	Gravatar GravatarConfig `yaml:"gravatar" json:"gravatar"`
+}
+
+// GravatarConfig holds the configuration of the gravatar fallback source.
+type GravatarConfig struct {
+	// Enabled allows applications to use gravatar sources. Privacy-sensitive deployments disable it so that
+	// hashes of email addresses are never handed out in claims.
+	Enabled bool `yaml:"enabled" json:"enabled"`
+	// BaseURL is the URL the hash of the email address is appended to.
+	BaseURL string `yaml:"base_url" json:"base_url"`
+	// DefaultImage is the image gravatar serves for an address without a gravatar. Empty uses gravatar's
+	// own default.
+	DefaultImage string `yaml:"default_image" json:"default_image"`
+	// Size is the size in pixels of the served image. Zero uses gravatar's own default.
+	Size int `yaml:"size" json:"size"`
+}
+
+// Validate checks that the gravatar base URL is an absolute HTTPS URL when gravatar sources are enabled and
+// that the image size is within the range gravatar supports.
+func (c *ClaimFallbackConfig) Validate() error {
+	if !c.Gravatar.Enabled {
+		return nil
+	}
+	baseURL, err := url.Parse(c.Gravatar.BaseURL)
+	if err != nil || baseURL.Scheme != "https" || baseURL.Host == "" {
+		return fmt.Errorf("oauth.claim_fallback.gravatar.base_url must be an absolute https URL (got %q)",
+			c.Gravatar.BaseURL)
+	}
+	if c.Gravatar.Size < 0 || c.Gravatar.Size > 2048 {
+		return fmt.Errorf("oauth.claim_fallback.gravatar.size must be between 0 and 2048 (got %d)",
+			c.Gravatar.Size)
+	}
+	return nil
+}
+
+// TokenCustomizationConfig holds the configuration of the claim rules that applications configure for their
+// access and ID tokens.
+type TokenCustomizationConfig struct {
+	// Disabled turns off the token customization rules of all applications.
+	Disabled bool `yaml:"disabled" json:"disabled"`
+	// Expressions configures the rules that compute a claim value with an expression.
+	Expressions TokenExpressionConfig `yaml:"expressions" json:"expressions"`
+}
+
+// TokenExpressionConfig holds the configuration of expression rules.
+type TokenExpressionConfig struct {
+	// Enabled allows applications to use expression rules.
+	Enabled bool `yaml:"enabled" json:"enabled"`
+	// MaxLength is the maximum length in bytes of an expression.
+	MaxLength int `yaml:"max_length" json:"max_length"`
+}
+
+// Validate checks that the maximum expression length is positive when expression rules are enabled.
+func (c *TokenCustomizationConfig) Validate() error {
+	if c.Expressions.Enabled && c.Expressions.MaxLength <= 0 {
+		return fmt.Errorf("oauth.token_customization.expressions.max_length must be positive (got %d)",
+			c.Expressions.MaxLength)
+	}
+	return nil
+}
+
+// RoleScopeMapping maps a role to the scopes it grants.
+type RoleScopeMapping struct {
+	// Role is the name of the role.
+	Role string `yaml:"role" json:"role"`
+	// OUID restricts the mapping to the role defined in the given organization unit. Empty matches the role in
+	// any organization unit.
+	OUID string `yaml:"ou_id" json:"ou_id"`
+	// Scopes are the scopes granted to holders of the role.
+	Scopes []string `yaml:"scopes" json:"scopes"`
+}
+
+// Validate checks that the claim size limit is not negative and that every mapping names a role and its
+// scopes.
+func (c *RoleClaimsConfig) Validate() error {
+	if c.MaxClaimSize < 0 {
+		return fmt.Errorf("oauth.role_claims.max_claim_size cannot be negative (got %d)", c.MaxClaimSize)
+	}
+	for _, mapping := range c.Mappings {
+		if mapping.Role == "" {
+			return fmt.Errorf("oauth.role_claims.mappings entries must have a role")
+		}
+		if len(mapping.Scopes) == 0 {
+			return fmt.Errorf("oauth.role_claims.mappings entry for role %q must have scopes", mapping.Role)
+		}
+		for _, scope := range mapping.Scopes {
+			if scope == "" || strings.ContainsAny(scope, " \t") {
+				return fmt.Errorf("oauth.role_claims.mappings entry for role %q has invalid scope %q",
+					mapping.Role, scope)
+			}
+		}
+	}
+	return nil
+}
+
+// CredentialCheckConfig holds the configuration of the endpoint that lets sign-in UIs discover the credential
+// types available for an identifier.
+type CredentialCheckConfig struct {
+	// IdentifierAttributes are the user attributes users sign in with. Identifiers can only be checked against
+	// these attributes, and the first of them is used when a request does not name one.
+	IdentifierAttributes []string `yaml:"identifier_attributes" json:"identifier_attributes"`
+}
+
+// ScopeCeilingsConfig holds the rules that cap the scopes of tokens issued to users by the strength of the
+// authentication the user completed.
+type ScopeCeilingsConfig struct {
+	// Reject fails token requests that ask for scopes above the ceiling with insufficient_authentication_level.
+	// When false (default), such scopes are dropped from the issued token.
+	Reject bool `yaml:"reject" json:"reject"`
+	// Rules are evaluated in order and the first rule matching the authentication context sets the ceiling.
+	// When no rule matches, the scopes are not restricted.
+	Rules []ScopeCeilingRule `yaml:"rules" json:"rules"`
+}
+
+// ScopeCeilingRule caps the scopes of tokens issued for an authentication context. A rule without ACR and
+// AMR values matches any authentication context.
+type ScopeCeilingRule struct {
+	// ACR matches when the user completed one of the listed authentication context classes.
+	ACR []string `yaml:"acr" json:"acr"`
+	// AMR matches when every authentication method of the completed authentication context class, as mapped
+	// in oauth.auth_class.acr_amr, is listed.
+	AMR []string `yaml:"amr" json:"amr"`
+	// Scopes are the scopes allowed for the authentication context. "*" allows all scopes. OIDC scopes are
+	// always allowed.
+	Scopes []string `yaml:"scopes" json:"scopes"`
+}
+
+// Validate checks that every rule has valid values and that a rule matching any authentication context is
+// the last rule, as the rules after it would never be evaluated.
+func (c *ScopeCeilingsConfig) Validate() error {
+	for i, rule := range c.Rules {
+		for _, value := range append(append([]string{}, rule.ACR...), rule.AMR...) {
+			if strings.TrimSpace(value) == "" {
+				return fmt.Errorf("oauth.scope_ceilings.rules[%d] has an empty ACR or AMR value", i)
+			}
+		}
+		for _, scope := range rule.Scopes {
+			if scope == "" || strings.ContainsAny(scope, " \t") {
+				return fmt.Errorf("oauth.scope_ceilings.rules[%d] has invalid scope %q", i, scope)
+			}
+		}
+		if len(rule.ACR) == 0 && len(rule.AMR) == 0 && i != len(c.Rules)-1 {
+			return fmt.Errorf("oauth.scope_ceilings.rules[%d] matches any authentication context "+
+				"and must be the last rule", i)
+		}
+	}
+	return nil
+}
+
+// ClientUsageConfig holds the configuration of the tracking of OAuth client usage and the scheduled report of
+// inactive clients.
+type ClientUsageConfig struct {
+	// FlushInterval is the interval in seconds between writes of the buffered usage to the database. Zero uses
+	// the default of 30 seconds.
+	FlushInterval int64 `yaml:"flush_interval" json:"flush_interval"`
+	// InactiveDays is the number of days without a token issuance or an authorization request after which a
+	// client is reported as inactive. Zero uses the default of 90 days.
+	InactiveDays int64 `yaml:"inactive_days" json:"inactive_days"`
+	// ReportInterval is the interval in seconds between scheduled reports of inactive clients. Zero disables
+	// them.
+	ReportInterval int64 `yaml:"report_interval" json:"report_interval"`
+	// WebhookURL is the endpoint notified when a scheduled report finds inactive clients.
+	WebhookURL string `yaml:"webhook_url" json:"webhook_url"`
+}
+
+// Validate checks that the fail mode is supported and that the durations are not negative.
+func (c *PreIssuanceHookConfig) Validate() error {
+	switch c.FailMode {
+	case "", "open", "closed":
+	default:
+		return fmt.Errorf("oauth.pre_issuance_hook.fail_mode must be open or closed (got %q)", c.FailMode)
+	}
+	if c.TimeoutMS < 0 {
+		return fmt.Errorf("oauth.pre_issuance_hook.timeout_ms must be non-negative (got %d)", c.TimeoutMS)
+	}
+	if c.CacheTTL < 0 {
+		return fmt.Errorf("oauth.pre_issuance_hook.cache_ttl must be non-negative (got %d)", c.CacheTTL)
+	}
+	return nil
+}
+
+// CustomGrantsConfig holds the configuration for custom OAuth grant type handlers.
+type CustomGrantsConfig struct {
+	// Plugins lists Go plugin files that register custom grant handlers when loaded.
+	// Relative paths are resolved against the server home.
+	Plugins []string `yaml:"plugins" json:"plugins"`
+}
+
+// TokenStoreConfig holds the per-artifact store selection for short-lived OAuth artifacts and flow
+// executions. Each value is either "database" or "redis". Authorization codes, device codes, PAR
+// request URIs and DPoP proofs and nonces default to the runtime database type when empty. Refresh
+// tokens remain stateless and flow executions stay in the runtime flow store when empty.
+type TokenStoreConfig struct {
+	AuthorizationCode string `yaml:"authorization_code" json:"authorization_code"`
+	DeviceCode        string `yaml:"device_code" json:"device_code"`
+	PARRequest        string `yaml:"par_request" json:"par_request"`
+	RefreshToken      string `yaml:"refresh_token" json:"refresh_token"`
+	DPoP              string `yaml:"dpop" json:"dpop"`
+	FlowExecution     string `yaml:"flow_execution" json:"flow_execution"`
+	// FlowExecutionMigration also writes flow executions to the runtime flow store and reads the executions
+	// missing from the token store from it, so that executions started before the switch can complete.
+	FlowExecutionMigration bool `yaml:"flow_execution_migration" json:"flow_execution_migration"`
+}
+
+// Validate checks that each configured token store type is supported.
+func (c *TokenStoreConfig) Validate() error {
+	stores := []struct {
+		name  string
+		value string
+	}{
+		{"authorization_code", c.AuthorizationCode},
+		{"device_code", c.DeviceCode},
+		{"par_request", c.PARRequest},
+		{"refresh_token", c.RefreshToken},
+		{"dpop", c.DPoP},
+		{"flow_execution", c.FlowExecution},
+	}
+	for _, store := range stores {
+		switch store.value {
+		case "", "database", "redis":
+		default:
+			return fmt.Errorf("token_store: unsupported store type %q for %s", store.value, store.name)
+		}
+	}
+	if c.FlowExecutionMigration && c.FlowExecution == "" {
+		return fmt.Errorf("token_store: flow_execution_migration requires a flow_execution store")
+	}
+	return nil
+}
+
+// UsesRedis reports whether any artifact type is explicitly configured to use the Redis store.
+func (c *TokenStoreConfig) UsesRedis() bool {
+	return c.AuthorizationCode == "redis" || c.DeviceCode == "redis" ||
+		c.PARRequest == "redis" || c.RefreshToken == "redis" || c.DPoP == "redis" || c.FlowExecution == "redis"
+}
+
 // OAuthConfig holds the OAuth configuration details.
 type OAuthConfig struct {
-	RefreshToken      RefreshTokenConfig      `yaml:"refresh_token" json:"refresh_token"`
-	AuthorizationCode AuthorizationCodeConfig `yaml:"authorization_code" json:"authorization_code"`
-	DCR               DCRConfig               `yaml:"dcr" json:"dcr"`
-	PAR               PARConfig               `yaml:"par" json:"par"`
-	AuthClass         AuthClassConfig         `yaml:"auth_class" json:"auth_class"`
+	RefreshToken        RefreshTokenConfig        `yaml:"refresh_token" json:"refresh_token"`
+	AuthorizationCode   AuthorizationCodeConfig   `yaml:"authorization_code" json:"authorization_code"`
+	DCR                 DCRConfig                 `yaml:"dcr" json:"dcr"`
+	PAR                 PARConfig                 `yaml:"par" json:"par"`
+	DeviceAuthorization DeviceAuthorizationConfig `yaml:"device_authorization" json:"device_authorization"`
+	DPoP                DPoPConfig                `yaml:"dpop" json:"dpop"`
+	AuthClass           AuthClassConfig           `yaml:"auth_class" json:"auth_class"`
+	TokenStore          TokenStoreConfig          `yaml:"token_store" json:"token_store"`
+	CustomGrants        CustomGrantsConfig        `yaml:"custom_grants" json:"custom_grants"`
+	Logout              LogoutConfig              `yaml:"logout" json:"logout"`
+	MetadataCache       MetadataCacheConfig       `yaml:"metadata_cache" json:"metadata_cache"`
+	PreIssuanceHook     PreIssuanceHookConfig     `yaml:"pre_issuance_hook" json:"pre_issuance_hook"`
+	ProtocolTrace       ProtocolTraceConfig       `yaml:"protocol_trace" json:"protocol_trace"`
+	ClientUsage         ClientUsageConfig         `yaml:"client_usage" json:"client_usage"`
+	RoleClaims          RoleClaimsConfig          `yaml:"role_claims" json:"role_claims"`
+	ClaimFallback       ClaimFallbackConfig       `yaml:"claim_fallback" json:"claim_fallback"`
+	TokenCustomization  TokenCustomizationConfig  `yaml:"token_customization" json:"token_customization"`
+	ScopeCeilings       ScopeCeilingsConfig       `yaml:"scope_ceilings" json:"scope_ceilings"`
+	CredentialCheck     CredentialCheckConfig     `yaml:"credential_check" json:"credential_check"`
 	// AllowWildcardRedirectURI enables wildcard pattern matching for redirect URIs.
 	// When false (default), only exact redirect URI matching is performed.
 	AllowWildcardRedirectURI bool `yaml:"allow_wildcard_redirect_uri" json:"allow_wildcard_redirect_uri"`
@@ -231,8 +861,43 @@ type FlowConfig struct {
 	DefaultAuthFlowHandle    string `yaml:"default_auth_flow_handle" json:"default_auth_flow_handle"`
 	UserOnboardingFlowHandle string `yaml:"user_onboarding_flow_handle" json:"user_onboarding_flow_handle"`
 	MaxVersionHistory        int    `yaml:"max_version_history" json:"max_version_history"`
+	MaxNodes                 int    `yaml:"max_nodes" json:"max_nodes"`
 	AutoInferRegistration    bool   `yaml:"auto_infer_registration" json:"auto_infer_registration"`
 	Store                    string `yaml:"store" json:"store"`
+	// EventStream configures the stream that pushes the state transitions of a flow execution to the
+	// client that started it.
+	EventStream FlowEventStreamConfig `yaml:"event_stream" json:"event_stream"`
+}
+
+// FlowEventStreamConfig holds the configuration of the server-sent events stream of flow executions.
+type FlowEventStreamConfig struct {
+	Enabled bool `yaml:"enabled" json:"enabled"`
+	// HeartbeatInterval is the number of seconds between the comments written to keep an idle stream open
+	// through proxies.
+	HeartbeatInterval int64 `yaml:"heartbeat_interval" json:"heartbeat_interval"`
+	// PollInterval is the number of seconds between the checks of the flow store, which detect transitions
+	// made on other server nodes and expired executions.
+	PollInterval int64 `yaml:"poll_interval" json:"poll_interval"`
+	// MaxDuration is the number of seconds after which a stream is closed, after which the client has to
+	// reconnect. Zero keeps the stream open until the execution ends or expires.
+	MaxDuration int64 `yaml:"max_duration" json:"max_duration"`
+}
+
+// Validate checks that the intervals of an enabled event stream are positive.
+func (c *FlowEventStreamConfig) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+	if c.HeartbeatInterval < 1 {
+		return fmt.Errorf("flow.event_stream.heartbeat_interval must be at least 1")
+	}
+	if c.PollInterval < 1 {
+		return fmt.Errorf("flow.event_stream.poll_interval must be at least 1")
+	}
+	if c.MaxDuration < 0 {
+		return fmt.Errorf("flow.event_stream.max_duration must not be negative")
+	}
+	return nil
 }
 
 // CryptoConfig holds the cryptographic configuration details.
@@ -252,6 +917,18 @@ type KeyConfig struct {
 // EncryptionConfig holds the encryption configuration details.
 type EncryptionConfig struct {
 	Key string `yaml:"key" json:"key"`
+	// PreviousKeys are retired keys that are still used to decrypt data encrypted before a key rotation.
+	PreviousKeys []string `yaml:"previous_keys" json:"previous_keys"`
+	// Reencryption holds the configuration of jobs that re-encrypt stored data with the current key.
+	Reencryption ReencryptionConfig `yaml:"reencryption" json:"reencryption"`
+}
+
+// ReencryptionConfig holds the configuration of re-encryption jobs run after an encryption key rotation.
+type ReencryptionConfig struct {
+	// BatchSize is the number of records re-encrypted before the job progress is persisted.
+	BatchSize int `yaml:"batch_size" json:"batch_size"`
+	// JobRetention is the number of seconds a re-encryption job is retained after it is created.
+	JobRetention int64 `yaml:"job_retention" json:"job_retention"`
 }
 
 // PasswordHashingConfig holds the password hashing configuration details.
@@ -260,6 +937,28 @@ type PasswordHashingConfig struct {
 	Argon2ID  Argon2IDConfig `yaml:"argon2id" json:"argon2id"`
 	PBKDF2    PBKDF2Config   `yaml:"pbkdf2" json:"pbkdf2"`
 	SHA256    SHA256Config   `yaml:"sha256" json:"sha256"`
+	// Legacy holds the stored password formats accepted for verification besides the configured algorithm.
+	Legacy LegacyPasswordHashingConfig `yaml:"legacy" json:"legacy"`
+}
+
+// LegacyPasswordHashingConfig holds the configuration of password formats kept for verification only.
+type LegacyPasswordHashingConfig struct {
+	// UpgradeOnLogin re-hashes a password stored in another format with the configured algorithm
+	// after a successful login.
+	UpgradeOnLogin bool `yaml:"upgrade_on_login" json:"upgrade_on_login"`
+	// Formats lists the accepted legacy formats and their deprecation schedule.
+	Formats []LegacyPasswordFormatConfig `yaml:"formats" json:"formats"`
+}
+
+// LegacyPasswordFormatConfig holds the deprecation schedule of a stored password format.
+type LegacyPasswordFormatConfig struct {
+	// Algorithm is the stored format, such as BCRYPT, MD5_CRYPT or SHA256.
+	Algorithm string `yaml:"algorithm" json:"algorithm"`
+	// SunsetDate is the date (YYYY-MM-DD or RFC 3339) from which the format is retired.
+	SunsetDate string `yaml:"sunset_date" json:"sunset_date"`
+	// Enforcement is "warn" to keep accepting the format after the sunset date, or "block" to refuse
+	// logins with it. Defaults to "warn".
+	Enforcement string `yaml:"enforcement" json:"enforcement"`
 }
 
 // Argon2IDConfig holds the Argon2id password hashing configuration details.
@@ -363,7 +1062,175 @@ type UserConfig struct {
 	// If not specified, falls back to global DeclarativeResources.Enabled setting:
 	//   - If DeclarativeResources.Enabled = true: behaves as "declarative"
 	//   - If DeclarativeResources.Enabled = false: behaves as "mutable"
-	Store string `yaml:"store" json:"store"`
+	Store              string                   `yaml:"store" json:"store"`
+	Picture            UserPictureConfig        `yaml:"picture" json:"picture"`
+	SensitiveReadAudit SensitiveReadAuditConfig `yaml:"sensitive_read_audit" json:"sensitive_read_audit"`
+	// PendingVerification holds the configuration of users awaiting verification of their email address.
+	PendingVerification PendingVerificationConfig `yaml:"pending_verification" json:"pending_verification"`
+	// SoftDelete holds the configuration of keeping deleted users restorable until their retention expires.
+	SoftDelete UserSoftDeleteConfig `yaml:"soft_delete" json:"soft_delete"`
+	// IdentifierFilter holds the configuration of the in-memory filter that short-circuits lookups of
+	// identifiers that no entity has.
+	IdentifierFilter IdentifierFilterConfig `yaml:"identifier_filter" json:"identifier_filter"`
+	// Provisioning holds the configuration of provisioning users with their groups and roles.
+	Provisioning UserProvisioningConfig `yaml:"provisioning" json:"provisioning"`
+	// Reindex holds the configuration of jobs that reindex existing users after the indexed attributes change.
+	Reindex UserReindexConfig `yaml:"reindex" json:"reindex"`
+	// ApplicationAssociation holds the configuration of recording the applications users registered through
+	// or signed in to.
+	ApplicationAssociation UserAppAssociationConfig `yaml:"application_association" json:"application_association"`
+}
+
+// UserAppAssociationConfig holds the configuration of user-application associations, which limit the
+// users visible to applications that isolate their users.
+type UserAppAssociationConfig struct {
+	// Enabled records an association when a user registers through or first signs in to an application, and
+	// scopes the users visible to applications with user isolation enabled to their associated users.
+	Enabled bool `yaml:"enabled" json:"enabled"`
+}
+
+// UserReindexConfig holds the configuration of jobs that bring the indexed identifiers of existing users in line
+// with the indexed attributes.
+type UserReindexConfig struct {
+	// BatchSize is the number of users reindexed before the job progress is persisted.
+	BatchSize int `yaml:"batch_size" json:"batch_size"`
+	// BatchInterval is the number of milliseconds a job pauses between batches to limit the database load.
+	BatchInterval int64 `yaml:"batch_interval" json:"batch_interval"`
+	// JobRetention is the number of seconds a reindex job is retained after it is created.
+	JobRetention int64 `yaml:"job_retention" json:"job_retention"`
+}
+
+// UserProvisioningConfig holds the configuration of provisioning users with their group memberships and
+// role assignments.
+type UserProvisioningConfig struct {
+	// EmailAttribute is the user attribute holding the email address the invitation is sent to.
+	EmailAttribute string `yaml:"email_attribute" json:"email_attribute"`
+	// InvitationURL is the URL of the page linked from the invitation email. Defaults to the sign-in page of
+	// the gate client.
+	InvitationURL string `yaml:"invitation_url" json:"invitation_url"`
+}
+
+// Validate checks that the invitation URL is absolute when it is set.
+func (c *UserProvisioningConfig) Validate() error {
+	if c.InvitationURL != "" {
+		parsed, err := url.Parse(c.InvitationURL)
+		if err != nil || !parsed.IsAbs() {
+			return fmt.Errorf("user.provisioning.invitation_url must be an absolute URL")
+		}
+	}
+	return nil
+}
+
+// IdentifierFilterConfig holds the configuration of the bloom filter kept in front of identifier lookups.
+// A lookup on an indexed attribute whose value is not in the filter is answered as not found without
+// querying the database.
+type IdentifierFilterConfig struct {
+	Enabled bool `yaml:"enabled" json:"enabled"`
+	// ExpectedEntries is the number of identifier values the filter is sized for.
+	ExpectedEntries int `yaml:"expected_entries" json:"expected_entries"`
+	// FalsePositiveRate is the target rate of lookups of absent identifiers that still reach the database,
+	// between 0 and 1 exclusive.
+	FalsePositiveRate float64 `yaml:"false_positive_rate" json:"false_positive_rate"`
+	// RebuildInterval is the interval in seconds between rebuilds of the filter from the entity store.
+	RebuildInterval int64 `yaml:"rebuild_interval" json:"rebuild_interval"`
+	// MaxResponsePadding is the maximum number of milliseconds a short-circuited lookup is delayed so
+	// that it takes as long as a lookup answered by the database. Zero disables the padding.
+	MaxResponsePadding int64 `yaml:"max_response_padding" json:"max_response_padding"`
+}
+
+// Validate checks the filter sizing and intervals when the filter is enabled.
+func (c *IdentifierFilterConfig) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+	if c.ExpectedEntries <= 0 {
+		return fmt.Errorf("user.identifier_filter.expected_entries must be positive (got %d)", c.ExpectedEntries)
+	}
+	if c.FalsePositiveRate <= 0 || c.FalsePositiveRate >= 1 {
+		return fmt.Errorf("user.identifier_filter.false_positive_rate must be between 0 and 1 (got %v)",
+			c.FalsePositiveRate)
+	}
+	if c.RebuildInterval <= 0 {
+		return fmt.Errorf("user.identifier_filter.rebuild_interval must be positive (got %d)", c.RebuildInterval)
+	}
+	if c.MaxResponsePadding < 0 {
+		return fmt.Errorf("user.identifier_filter.max_response_padding cannot be negative (got %d)",
+			c.MaxResponsePadding)
+	}
+	return nil
+}
+
+// PendingVerificationConfig holds the configuration of the purge of users that were registered pending
+// verification of their email address and never verified it.
+type PendingVerificationConfig struct {
+	// Retention is the number of seconds an unverified user is retained after it is created. Zero disables
+	// the purge.
+	Retention int64 `yaml:"retention" json:"retention"`
+	// PurgeInterval is the interval in seconds between scheduled purges of expired unverified users.
+	PurgeInterval int64 `yaml:"purge_interval" json:"purge_interval"`
+}
+
+// UserSoftDeleteConfig holds the configuration of soft deletion of users, which keeps deleted users restorable
+// until they are purged.
+type UserSoftDeleteConfig struct {
+	// Enabled marks deleted users as deleted instead of removing them.
+	Enabled bool `yaml:"enabled" json:"enabled"`
+	// Retention is the number of seconds a deleted user is retained before it is purged. Zero disables the
+	// scheduled purge, leaving deleted users until they are purged explicitly.
+	Retention int64 `yaml:"retention" json:"retention"`
+	// PurgeInterval is the interval in seconds between scheduled purges of deleted users past their retention.
+	PurgeInterval int64 `yaml:"purge_interval" json:"purge_interval"`
+}
+
+// SensitiveReadAuditConfig holds the configuration for auditing reads of user attributes marked as
+// sensitive in the user type schema.
+type SensitiveReadAuditConfig struct {
+	Enabled bool `yaml:"enabled" json:"enabled"`
+	// SampleRate is the fraction of sensitive reads that are audited, between 0 and 1.
+	SampleRate float64 `yaml:"sample_rate" json:"sample_rate"`
+}
+
+// Validate checks that the sample rate is within the accepted range.
+func (c *SensitiveReadAuditConfig) Validate() error {
+	if c.SampleRate < 0 || c.SampleRate > 1 {
+		return fmt.Errorf("user.sensitive_read_audit.sample_rate must be between 0 and 1 (got %v)", c.SampleRate)
+	}
+	return nil
+}
+
+// UserPictureConfig holds the configuration for user profile pictures.
+type UserPictureConfig struct {
+	// MaxSize is the maximum accepted upload size in bytes.
+	MaxSize int64 `yaml:"max_size" json:"max_size"`
+	// MaxDimension is the maximum width and height in pixels; larger images are scaled down to fit.
+	MaxDimension int `yaml:"max_dimension" json:"max_dimension"`
+	// URLValidityPeriod is the validity period in seconds of signed picture URLs.
+	URLValidityPeriod int64 `yaml:"url_validity_period" json:"url_validity_period"`
+}
+
+// ObjectStoreConfig holds the configuration for the binary object store.
+type ObjectStoreConfig struct {
+	// Type selects the object store implementation. Valid values: "local", "s3".
+	Type  string                 `yaml:"type" json:"type"`
+	Local LocalObjectStoreConfig `yaml:"local" json:"local"`
+	S3    S3ObjectStoreConfig    `yaml:"s3" json:"s3"`
+}
+
+// LocalObjectStoreConfig holds the configuration for the local disk object store.
+type LocalObjectStoreConfig struct {
+	// Directory is the root directory for stored objects, relative to the server home when not absolute.
+	Directory string `yaml:"directory" json:"directory"`
+}
+
+// S3ObjectStoreConfig holds the configuration for an S3-compatible object store.
+type S3ObjectStoreConfig struct {
+	Endpoint        string `yaml:"endpoint" json:"endpoint"`
+	Region          string `yaml:"region" json:"region"`
+	Bucket          string `yaml:"bucket" json:"bucket"`
+	AccessKeyID     string `yaml:"access_key_id" json:"access_key_id"`
+	SecretAccessKey string `yaml:"secret_access_key" json:"secret_access_key"`
+	// UsePathStyle addresses the bucket as a path segment instead of a virtual-hosted subdomain.
+	UsePathStyle bool `yaml:"use_path_style" json:"use_path_style"`
 }
 
 // SystemResourceServerConfig holds configuration for the built-in system resource server.
@@ -392,6 +1259,39 @@ type OrganizationUnitConfig struct {
 	//   - If DeclarativeResources.Enabled = true: behaves as "declarative"
 	//   - If DeclarativeResources.Enabled = false: behaves as "mutable"
 	Store string `yaml:"store" json:"store"`
+	// Deletion holds the configuration of asynchronous organization unit deletion jobs.
+	Deletion OUDeletionConfig `yaml:"deletion" json:"deletion"`
+	// Provisioning holds the configuration of organization unit provisioning from an HR feed.
+	Provisioning OUProvisioningConfig `yaml:"provisioning" json:"provisioning"`
+}
+
+// OUDeletionConfig holds the configuration of asynchronous organization unit deletion jobs.
+type OUDeletionConfig struct {
+	// ChunkSize is the number of users or groups deleted before the job progress is persisted.
+	// It is capped at the maximum page size.
+	ChunkSize int `yaml:"chunk_size" json:"chunk_size"`
+	// JobRetention is the number of seconds a deletion job is retained after it is created.
+	JobRetention int64 `yaml:"job_retention" json:"job_retention"`
+	// WebhookURL is the endpoint notified when a deletion job reaches a terminal state.
+	WebhookURL string `yaml:"webhook_url" json:"webhook_url"`
+}
+
+// OUProvisioningConfig holds the rules that map the departments of an HR feed to organization units.
+type OUProvisioningConfig struct {
+	// RootOU is the handle path of the organization unit under which the department hierarchy is
+	// provisioned. Provisioning is disabled when it is empty.
+	RootOU string `yaml:"root_ou" json:"root_ou"`
+	// HandleSource is the department field the handle of an organization unit is derived from.
+	// Valid values: "external_id", "name"
+	HandleSource string `yaml:"handle_source" json:"handle_source"`
+	// HandlePrefix is prepended to the handles derived from the departments.
+	HandlePrefix string `yaml:"handle_prefix" json:"handle_prefix"`
+	// RetiredOU is the handle path of the organization unit that organization units of departments
+	// removed from the feed are moved under. They are deleted when it is empty.
+	RetiredOU string `yaml:"retired_ou" json:"retired_ou"`
+	// MaxChangeRate is the maximum percentage of the provisioned organization units a single feed can
+	// retire unless the feed is forced. Zero disables the check.
+	MaxChangeRate int `yaml:"max_change_rate" json:"max_change_rate"`
 }
 
 // IdentityProviderConfig holds the identity provider service configuration.
@@ -521,6 +1421,415 @@ type ConsentConfig struct {
 	MaxRetries int    `yaml:"max_retries" json:"max_retries"` // Max retry attempts for transient errors. Default: 3
 }
 
+// TenantConfig holds the organization-level multi-tenancy configuration.
+type TenantConfig struct {
+	Enabled bool `yaml:"enabled" json:"enabled"`
+	// ResolutionMethods lists how the tenant of a request is resolved, in order of precedence.
+	// Valid values: "hostname", "path", "claim".
+	ResolutionMethods []string `yaml:"resolution_methods" json:"resolution_methods"`
+}
+
+// IntegrityConfig holds the configuration of the integrity scan of orphaned references.
+type IntegrityConfig struct {
+	// ScanInterval is the interval in seconds between scheduled report-only scans. Zero disables them.
+	ScanInterval int64 `yaml:"scan_interval" json:"scan_interval"`
+}
+
+// ConfigDriftConfig holds the configuration of the detection of changes to security relevant configuration.
+type ConfigDriftConfig struct {
+	// CheckInterval is the interval in seconds between scheduled checks against the approved baseline.
+	// Zero disables them.
+	CheckInterval int64 `yaml:"check_interval" json:"check_interval"`
+	// WebhookURL is the endpoint notified when a scheduled check finds settings that drifted from the baseline.
+	WebhookURL string `yaml:"webhook_url" json:"webhook_url"`
+}
+
+// TrustedDeviceConfig holds the configuration of trusted devices that can skip multi-factor authentication.
+type TrustedDeviceConfig struct {
+	// ValidityPeriod is the number of seconds a device remains trusted after it is registered.
+	ValidityPeriod int64 `yaml:"validity_period" json:"validity_period"`
+	// MaxDevicesPerUser is the maximum number of trusted devices kept for a user. The least recently
+	// used device is removed when a new device is registered beyond this limit.
+	MaxDevicesPerUser int `yaml:"max_devices_per_user" json:"max_devices_per_user"`
+}
+
+// Security notification events and channels.
+const (
+	// SecurityNotificationEventNewSignIn notifies a user of a sign-in from a device not seen before.
+	SecurityNotificationEventNewSignIn = "new_sign_in"
+	// SecurityNotificationEventCredentialChanged notifies a user that their password was changed.
+	SecurityNotificationEventCredentialChanged = "credential_changed"
+
+	// SecurityNotificationChannelEmail delivers security notifications by email.
+	SecurityNotificationChannelEmail = "email"
+	// SecurityNotificationChannelSMS delivers security notifications by SMS.
+	SecurityNotificationChannelSMS = "sms"
+	// SecurityNotificationChannelNone turns security notifications off.
+	SecurityNotificationChannelNone = "none"
+)
+
+// SecurityNotificationConfig holds the configuration of the notifications sent to users about security
+// relevant activity on their account.
+type SecurityNotificationConfig struct {
+	// Enabled turns security notifications on.
+	Enabled bool `yaml:"enabled" json:"enabled"`
+	// Events lists the events users are notified of. Either new_sign_in or credential_changed.
+	Events []string `yaml:"events" json:"events"`
+	// DefaultChannel is the channel used when a user has not chosen one. Either email, sms or none.
+	DefaultChannel string `yaml:"default_channel" json:"default_channel"`
+	// PreferenceAttribute is the user attribute holding the channel chosen by the user.
+	PreferenceAttribute string `yaml:"preference_attribute" json:"preference_attribute"`
+	// EmailAttribute is the user attribute holding the email address notifications are sent to.
+	EmailAttribute string `yaml:"email_attribute" json:"email_attribute"`
+	// MobileAttribute is the user attribute holding the mobile number notifications are sent to.
+	MobileAttribute string `yaml:"mobile_attribute" json:"mobile_attribute"`
+	// SMSSenderID is the ID of the notification sender used for the sms channel.
+	SMSSenderID string `yaml:"sms_sender_id" json:"sms_sender_id"`
+	// KnownDeviceValidity is the number of seconds a device stays known after a sign-in from it. Sign-ins
+	// from a known device do not trigger a new sign-in notification.
+	KnownDeviceValidity int64 `yaml:"known_device_validity" json:"known_device_validity"`
+	// ThrottleLimit is the maximum number of notifications sent to a user within ThrottleWindow.
+	ThrottleLimit int `yaml:"throttle_limit" json:"throttle_limit"`
+	// ThrottleWindow is the number of seconds over which ThrottleLimit applies.
+	ThrottleWindow int64 `yaml:"throttle_window" json:"throttle_window"`
+}
+
+// Validate checks that the security notification settings are usable when notifications are enabled.
+func (c *SecurityNotificationConfig) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+	for _, evt := range c.Events {
+		if evt != SecurityNotificationEventNewSignIn && evt != SecurityNotificationEventCredentialChanged {
+			return fmt.Errorf("security_notification.events has an unsupported event %q", evt)
+		}
+	}
+	switch c.DefaultChannel {
+	case SecurityNotificationChannelEmail, SecurityNotificationChannelSMS, SecurityNotificationChannelNone:
+	default:
+		return fmt.Errorf("security_notification.default_channel must be one of email, sms or none")
+	}
+	if c.PreferenceAttribute == "" || c.EmailAttribute == "" || c.MobileAttribute == "" {
+		return fmt.Errorf("security_notification.preference_attribute, email_attribute and mobile_attribute " +
+			"must be set")
+	}
+	if c.DefaultChannel == SecurityNotificationChannelSMS && c.SMSSenderID == "" {
+		return fmt.Errorf("security_notification.sms_sender_id must be set when the default channel is sms")
+	}
+	if c.KnownDeviceValidity <= 0 || c.ThrottleLimit <= 0 || c.ThrottleWindow <= 0 {
+		return fmt.Errorf("security_notification.known_device_validity, throttle_limit and throttle_window " +
+			"must be positive")
+	}
+	return nil
+}
+
+// EmailChangeConfig holds the configuration of the confirmation of email address changes made by users.
+type EmailChangeConfig struct {
+	// Enabled keeps a change of a user's own email address pending until it is confirmed, instead of
+	// applying it right away.
+	Enabled bool `yaml:"enabled" json:"enabled"`
+	// EmailAttribute is the user attribute holding the email address.
+	EmailAttribute string `yaml:"email_attribute" json:"email_attribute"`
+	// ConfirmOldAddress additionally requires the change to be confirmed from the current email address.
+	ConfirmOldAddress bool `yaml:"confirm_old_address" json:"confirm_old_address"`
+	// ValidityPeriod is the number of seconds a pending change can be confirmed in.
+	ValidityPeriod int64 `yaml:"validity_period" json:"validity_period"`
+	// ConfirmationURL is the URL of the page that confirms a change. The confirmation token is added as the
+	// token query parameter. Defaults to the email-change/confirm page of the gate client.
+	ConfirmationURL string `yaml:"confirmation_url" json:"confirmation_url"`
+}
+
+// Validate checks that the email change settings are usable when email change confirmation is enabled.
+func (c *EmailChangeConfig) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+	if c.EmailAttribute == "" {
+		return fmt.Errorf("email_change.email_attribute must be set")
+	}
+	if c.ValidityPeriod <= 0 {
+		return fmt.Errorf("email_change.validity_period must be positive")
+	}
+	if c.ConfirmationURL != "" {
+		parsed, err := url.Parse(c.ConfirmationURL)
+		if err != nil || !parsed.IsAbs() {
+			return fmt.Errorf("email_change.confirmation_url must be an absolute URL")
+		}
+	}
+	return nil
+}
+
+// AccountProtectionConfig holds the configuration of the protection of user accounts against takeover
+// through changes of their credentials and email address.
+type AccountProtectionConfig struct {
+	// Enabled turns account protection on.
+	Enabled bool `yaml:"enabled" json:"enabled"`
+	// EmailAttribute is the user attribute holding the email address.
+	EmailAttribute string `yaml:"email_attribute" json:"email_attribute"`
+	// StepUpMaxAge is the maximum number of seconds since the user last authenticated for the user to change
+	// their own password or email address. Zero turns the step-up requirement off.
+	StepUpMaxAge int64 `yaml:"step_up_max_age" json:"step_up_max_age"`
+	// ResetCooldown is the number of seconds after a password reset during which the user cannot change their
+	// own password or email address. Zero turns the cooldown off.
+	ResetCooldown int64 `yaml:"reset_cooldown" json:"reset_cooldown"`
+	// RevertWindow is the number of seconds the link sent to the previous email address can revert an email
+	// address change. Zero turns revert links off.
+	RevertWindow int64 `yaml:"revert_window" json:"revert_window"`
+	// RevertURL is the URL of the page that reverts an email address change. The revert token is added as the
+	// token query parameter. Defaults to the account-protection/revert page of the gate client.
+	RevertURL string `yaml:"revert_url" json:"revert_url"`
+}
+
+// Validate checks that the account protection settings are usable when account protection is enabled.
+func (c *AccountProtectionConfig) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+	if c.EmailAttribute == "" {
+		return fmt.Errorf("account_protection.email_attribute must be set")
+	}
+	if c.StepUpMaxAge < 0 || c.ResetCooldown < 0 || c.RevertWindow < 0 {
+		return fmt.Errorf("account_protection durations must not be negative")
+	}
+	if c.RevertURL != "" {
+		parsed, err := url.Parse(c.RevertURL)
+		if err != nil || !parsed.IsAbs() {
+			return fmt.Errorf("account_protection.revert_url must be an absolute URL")
+		}
+	}
+	return nil
+}
+
+// AccountDeletionConfig holds the configuration of the deletion of accounts requested by their users.
+type AccountDeletionConfig struct {
+	// Enabled lets users request the deletion of their own account.
+	Enabled bool `yaml:"enabled" json:"enabled"`
+	// EmailAttribute is the user attribute holding the email address the cancellation link is sent to.
+	EmailAttribute string `yaml:"email_attribute" json:"email_attribute"`
+	// GracePeriod is the number of seconds after the request at which the account is deleted. The deletion
+	// can be cancelled until then.
+	GracePeriod int64 `yaml:"grace_period" json:"grace_period"`
+	// DisableLogin stops the user from signing in while the deletion is pending.
+	DisableLogin bool `yaml:"disable_login" json:"disable_login"`
+	// CancellationURL is the URL of the page that cancels a deletion. The cancellation token is added as the
+	// token query parameter. Defaults to the account-deletion/cancel page of the gate client.
+	CancellationURL string `yaml:"cancellation_url" json:"cancellation_url"`
+	// PurgeInterval is the number of seconds between the runs of the job that deletes the accounts whose
+	// grace period has ended.
+	PurgeInterval int64 `yaml:"purge_interval" json:"purge_interval"`
+}
+
+// Validate checks that the account deletion settings are usable when account deletion is enabled.
+func (c *AccountDeletionConfig) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+	if c.EmailAttribute == "" {
+		return fmt.Errorf("account_deletion.email_attribute must be set")
+	}
+	if c.GracePeriod <= 0 {
+		return fmt.Errorf("account_deletion.grace_period must be positive")
+	}
+	if c.PurgeInterval < 0 {
+		return fmt.Errorf("account_deletion.purge_interval must not be negative")
+	}
+	if c.CancellationURL != "" {
+		parsed, err := url.Parse(c.CancellationURL)
+		if err != nil || !parsed.IsAbs() {
+			return fmt.Errorf("account_deletion.cancellation_url must be an absolute URL")
+		}
+	}
+	return nil
+}
+
+// EnumerationResistanceConfig holds the configuration of the measures that stop the identify and authenticate
+// endpoints from revealing whether an account exists.
+type EnumerationResistanceConfig struct {
+	// Enabled turns enumeration resistance on.
+	Enabled bool `yaml:"enabled" json:"enabled"`
+	// MinResponseTime is the number of milliseconds a failed authentication takes at the least.
+	MinResponseTime int64 `yaml:"min_response_time" json:"min_response_time"`
+	// MaxJitter is the maximum number of milliseconds of random delay added to a failed authentication.
+	MaxJitter int64 `yaml:"max_jitter" json:"max_jitter"`
+}
+
+// Validate checks that the enumeration resistance delays are not negative.
+func (c *EnumerationResistanceConfig) Validate() error {
+	if c.MinResponseTime < 0 || c.MaxJitter < 0 {
+		return fmt.Errorf("enumeration_resistance delays must not be negative")
+	}
+	return nil
+}
+
+// EnrollmentSessionConfig holds the configuration of the enrollment sessions that hand authenticator
+// enrollment URIs to the client rendering them as QR codes.
+type EnrollmentSessionConfig struct {
+	// Validity is the number of seconds the enrollment URI of an enrollment session can be retrieved. Zero
+	// applies the default of 300 seconds.
+	Validity int64 `yaml:"validity" json:"validity"`
+}
+
+// Validate checks that the enrollment session settings are usable.
+func (c *EnrollmentSessionConfig) Validate() error {
+	if c.Validity < 0 {
+		return fmt.Errorf("enrollment_session.validity must not be negative")
+	}
+	return nil
+}
+
+// BreakGlassConfig holds the configuration of break-glass accounts used for emergency access.
+type BreakGlassConfig struct {
+	// MaxActivationPeriod is the maximum number of seconds a break-glass account stays active after its
+	// activation is approved. It is also the activation period when a request does not specify one.
+	MaxActivationPeriod int64 `yaml:"max_activation_period" json:"max_activation_period"`
+	// ApprovalTimeout is the number of seconds an activation request can be approved before it lapses.
+	ApprovalTimeout int64 `yaml:"approval_timeout" json:"approval_timeout"`
+	// WebhookURL is the endpoint alerted whenever a break-glass account is activated, deactivated or used.
+	WebhookURL string `yaml:"webhook_url" json:"webhook_url"`
+}
+
+// ChangeApprovalConfig holds the configuration of the four-eyes approval of sensitive operations.
+type ChangeApprovalConfig struct {
+	// Operations lists the operations that create a pending change request instead of being applied
+	// immediately, such as "role.update".
+	Operations []string `yaml:"operations" json:"operations"`
+	// Expiry is the number of seconds a change request can be approved or rejected before it expires.
+	Expiry int64 `yaml:"expiry" json:"expiry"`
+}
+
+// AccessReviewConfig holds the configuration of the access review campaigns of role assignments.
+type AccessReviewConfig struct {
+	// AutoRevoke is the default for campaigns that do not say whether assignments left unreviewed at the
+	// deadline are revoked.
+	AutoRevoke bool `yaml:"auto_revoke" json:"auto_revoke"`
+	// DefaultDuration is the number of seconds from creation to the deadline of campaigns created without
+	// a deadline.
+	DefaultDuration int64 `yaml:"default_duration" json:"default_duration"`
+	// ProcessInterval is the number of seconds between the checks that complete campaigns whose deadline
+	// has passed. Zero disables the scheduled completion.
+	ProcessInterval int64 `yaml:"process_interval" json:"process_interval"`
+}
+
+// Validate checks that the access review durations are not negative.
+func (c *AccessReviewConfig) Validate() error {
+	if c.DefaultDuration < 0 || c.ProcessInterval < 0 {
+		return fmt.Errorf("access_review values must not be negative")
+	}
+	return nil
+}
+
+// RelationshipConfig holds the configuration of the relationship tuple store and check API.
+type RelationshipConfig struct {
+	// Enabled registers the relationship API and lets system authorization delegate checks to it.
+	Enabled bool `yaml:"enabled" json:"enabled"`
+	// MaxCheckDepth bounds the number of userset and implied relation hops followed by a check.
+	MaxCheckDepth int `yaml:"max_check_depth" json:"max_check_depth"`
+	// MaxWriteSize is the maximum number of tuples written and deleted in a single request.
+	MaxWriteSize int `yaml:"max_write_size" json:"max_write_size"`
+	// ImpliedRelations maps an object type to the relations implied by other relations on the same
+	// object. For example {"group": {"viewer": ["editor"]}} grants viewer to every editor.
+	ImpliedRelations map[string]map[string][]string `yaml:"implied_relations" json:"implied_relations"`
+}
+
+// Validate checks that the relationship limits are usable.
+func (c *RelationshipConfig) Validate() error {
+	if c.MaxCheckDepth < 0 || c.MaxWriteSize < 0 {
+		return fmt.Errorf("relationships.max_check_depth and relationships.max_write_size must not be negative")
+	}
+	for objectType, relations := range c.ImpliedRelations {
+		for relation, implying := range relations {
+			for _, r := range implying {
+				if r == "" || r == relation {
+					return fmt.Errorf("relationships.implied_relations.%s.%s has an invalid implying relation %q",
+						objectType, relation, r)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// Failure modes applied by the system authorization service when authorization data cannot be resolved.
+const (
+	// AuthzFailureModeFailClosed denies the operation when authorization data cannot be resolved.
+	AuthzFailureModeFailClosed = "fail_closed"
+	// AuthzFailureModeFailOpen allows the operation when authorization data cannot be resolved.
+	AuthzFailureModeFailOpen = "fail_open"
+)
+
+// SystemAuthorizationConfig holds the configuration of the system authorization service.
+type SystemAuthorizationConfig struct {
+	// FailureHandling defines the decision taken for each action category when the data backing an
+	// authorization decision is unavailable.
+	FailureHandling AuthzFailureHandlingConfig `yaml:"failure_handling" json:"failure_handling"`
+	// CircuitBreaker configures the circuit breaker guarding the authorization data store.
+	CircuitBreaker AuthzCircuitBreakerConfig `yaml:"circuit_breaker" json:"circuit_breaker"`
+	// RelationshipDelegations lists the resource types whose actions are additionally granted by
+	// relationship tuples. Requires the relationships feature to be enabled.
+	RelationshipDelegations []AuthzRelationshipDelegation `yaml:"relationship_delegations" json:"relationship_delegations"`
+}
+
+// AuthzRelationshipDelegation maps the actions on a resource type to the relation that grants them.
+type AuthzRelationshipDelegation struct {
+	// ResourceType is the system resource type the delegation applies to, such as "group".
+	ResourceType string `yaml:"resource_type" json:"resource_type"`
+	// Relations maps an action, such as "group:update", to the relation the caller must hold on the
+	// resource for the action to be allowed.
+	Relations map[string]string `yaml:"relations" json:"relations"`
+}
+
+// AuthzFailureHandlingConfig holds the failure mode of each authorization action category.
+type AuthzFailureHandlingConfig struct {
+	// Read is the failure mode of read and list actions. Either fail_closed or fail_open.
+	Read string `yaml:"read" json:"read"`
+	// Write is the failure mode of create, update and delete actions. Only fail_closed is accepted.
+	Write string `yaml:"write" json:"write"`
+}
+
+// AuthzCircuitBreakerConfig holds the configuration of the authorization data store circuit breaker.
+type AuthzCircuitBreakerConfig struct {
+	// FailureThreshold is the number of consecutive store failures that opens the circuit.
+	// Zero disables the circuit breaker.
+	FailureThreshold int `yaml:"failure_threshold" json:"failure_threshold"`
+	// OpenDuration is the number of seconds the circuit stays open before a trial request is let through.
+	OpenDuration int64 `yaml:"open_duration" json:"open_duration"`
+}
+
+// Validate checks that the failure modes are supported and that admin writes always fail closed.
+func (c *SystemAuthorizationConfig) Validate() error {
+	switch c.FailureHandling.Read {
+	case "", AuthzFailureModeFailClosed, AuthzFailureModeFailOpen:
+	default:
+		return fmt.Errorf("system_authorization.failure_handling.read must be %s or %s (got %q)",
+			AuthzFailureModeFailClosed, AuthzFailureModeFailOpen, c.FailureHandling.Read)
+	}
+	if c.FailureHandling.Write != "" && c.FailureHandling.Write != AuthzFailureModeFailClosed {
+		return fmt.Errorf("system_authorization.failure_handling.write must be %s (got %q)",
+			AuthzFailureModeFailClosed, c.FailureHandling.Write)
+	}
+	if c.CircuitBreaker.FailureThreshold < 0 || c.CircuitBreaker.OpenDuration < 0 {
+		return fmt.Errorf("system_authorization.circuit_breaker values must not be negative")
+	}
+	seen := make(map[string]bool, len(c.RelationshipDelegations))
+	for _, delegation := range c.RelationshipDelegations {
+		if delegation.ResourceType == "" {
+			return fmt.Errorf("system_authorization.relationship_delegations entries must set resource_type")
+		}
+		if seen[delegation.ResourceType] {
+			return fmt.Errorf("system_authorization.relationship_delegations has duplicate resource_type %q",
+				delegation.ResourceType)
+		}
+		seen[delegation.ResourceType] = true
+		for action, relation := range delegation.Relations {
+			if action == "" || relation == "" {
+				return fmt.Errorf("system_authorization.relationship_delegations for %q must map non-empty "+
+					"actions to non-empty relations", delegation.ResourceType)
+			}
+		}
+	}
+	return nil
+}
+
 // RequiredClaim defines a claim name and expected value that must be present in the token.
 type RequiredClaim struct {
 	Claim string `yaml:"claim" json:"claim"`
@@ -589,6 +1898,63 @@ func (c *TrustedIssuerConfig) Validate() error {
 	}
 }
 
+// LegacyIssuerConfig holds the configuration of an identity provider whose tokens are accepted during a
+// migration. Tokens are verified with the JWKS of the issuer, mapped to the user whose UserAttribute equals
+// the UserClaim of the token, and accepted only for the APIs listed until the sunset time.
+type LegacyIssuerConfig struct {
+	// Issuer is the iss claim of the tokens of the legacy identity provider.
+	Issuer string `yaml:"issuer" json:"issuer"`
+	// JWKSURL is the JWKS endpoint of the legacy identity provider.
+	JWKSURL string `yaml:"jwks_url" json:"jwks_url"`
+	// Audience is the aud claim required in the tokens. Empty accepts any audience.
+	Audience string `yaml:"audience" json:"audience"`
+	// UserClaim is the claim of the token identifying the user. Empty uses "sub".
+	UserClaim string `yaml:"user_claim" json:"user_claim"`
+	// UserAttribute is the user attribute matched against the value of the user claim.
+	UserAttribute string `yaml:"user_attribute" json:"user_attribute"`
+	// APIs are the path patterns of the APIs the tokens are accepted for, in the syntax of the public paths.
+	APIs []string `yaml:"apis" json:"apis"`
+	// Sunset is the RFC 3339 time after which the tokens are rejected.
+	Sunset string `yaml:"sunset" json:"sunset"`
+}
+
+// Validate checks that the legacy issuer names its JWKS endpoint, the user mapping, the APIs and a valid
+// sunset time.
+func (c *LegacyIssuerConfig) Validate() error {
+	if c.Issuer == "" {
+		return fmt.Errorf("server.security.legacy_issuers entries must have an issuer")
+	}
+	if c.JWKSURL == "" {
+		return fmt.Errorf("server.security.legacy_issuers issuer %q must have a jwks_url", c.Issuer)
+	}
+	parsed, err := url.Parse(c.JWKSURL)
+	if err != nil || (parsed.Scheme != schemeHTTPS && !isLocalHTTPURL(parsed)) {
+		return fmt.Errorf("server.security.legacy_issuers issuer %q must have an https jwks_url", c.Issuer)
+	}
+	if c.UserAttribute == "" {
+		return fmt.Errorf("server.security.legacy_issuers issuer %q must have a user_attribute", c.Issuer)
+	}
+	if len(c.APIs) == 0 {
+		return fmt.Errorf("server.security.legacy_issuers issuer %q must list the apis it is accepted for", c.Issuer)
+	}
+	if _, err := c.SunsetTime(); err != nil {
+		return fmt.Errorf("server.security.legacy_issuers issuer %q must have an RFC 3339 sunset: %w", c.Issuer, err)
+	}
+	return nil
+}
+
+// SunsetTime returns the time after which the tokens of the legacy issuer are rejected.
+func (c *LegacyIssuerConfig) SunsetTime() (time.Time, error) {
+	return time.Parse(time.RFC3339, c.Sunset)
+}
+
+// isLocalHTTPURL reports whether the URL is an http URL of the local host, which is allowed for local
+// development and tests.
+func isLocalHTTPURL(parsed *url.URL) bool {
+	host := parsed.Hostname()
+	return parsed.Scheme == "http" && (host == "localhost" || host == "127.0.0.1" || host == "::1")
+}
+
 // AuthClassConfig holds the ACR-AMR mapping configuration.
 type AuthClassConfig struct {
 	Amrs   []string            `yaml:"amrs" json:"amrs"`
@@ -629,36 +1995,359 @@ func (c *AuthClassConfig) Validate() error {
 	return nil
 }
 
+// ConfigValidationConfig holds the configuration of the validation run on the configuration at startup.
+type ConfigValidationConfig struct {
+	// Strict refuses to start the server when the validation reports warnings.
+	Strict bool `yaml:"strict" json:"strict"`
+	// CheckSMTP enables the reachability check of the configured SMTP server.
+	CheckSMTP bool `yaml:"check_smtp" json:"check_smtp"`
+	// Timeout is the number of seconds a single connectivity check may take.
+	Timeout int `yaml:"timeout" json:"timeout"`
+}
+
+// DistributedLockConfig holds the configuration of the locks that coordinate work across cluster nodes.
+type DistributedLockConfig struct {
+	// Store is the store that holds the lock leases, either "database" or "redis". Empty follows the
+	// runtime database type.
+	Store string `yaml:"store" json:"store"`
+	// LeaseTTL is the number of seconds a lock stays held without renewal. Held locks are renewed at a
+	// third of the TTL, so a lock is released by the TTL only when its holder stops.
+	LeaseTTL int `yaml:"lease_ttl" json:"lease_ttl"`
+	// RetryIntervalMS is the number of milliseconds to wait between attempts to acquire a held lock.
+	RetryIntervalMS int `yaml:"retry_interval_ms" json:"retry_interval_ms"`
+}
+
+// Validate checks that the lock store is supported and that the durations are not negative.
+func (c *DistributedLockConfig) Validate() error {
+	switch c.Store {
+	case "", "database", "redis":
+	default:
+		return fmt.Errorf("distributed_lock.store must be database or redis (got %q)", c.Store)
+	}
+	if c.LeaseTTL < 0 {
+		return fmt.Errorf("distributed_lock.lease_ttl must be non-negative (got %d)", c.LeaseTTL)
+	}
+	if c.RetryIntervalMS < 0 {
+		return fmt.Errorf("distributed_lock.retry_interval_ms must be non-negative (got %d)", c.RetryIntervalMS)
+	}
+	return nil
+}
+
+// LocalizationConfig holds the deployment-wide defaults for the preferred language and time zone of users.
+type LocalizationConfig struct {
+	// DefaultLocale is the BCP 47 language tag used for users whose locale is not set on the user or
+	// their organization unit.
+	DefaultLocale string `yaml:"default_locale" json:"default_locale"`
+	// DefaultZoneinfo is the IANA time zone used for users whose time zone is not set on the user or
+	// their organization unit.
+	DefaultZoneinfo string `yaml:"default_zoneinfo" json:"default_zoneinfo"`
+}
+
+// Validate checks that the defaults, when set, are a canonical BCP 47 tag and a known IANA time zone.
+func (c *LocalizationConfig) Validate() error {
+	if c.DefaultLocale != "" && !utils.IsValidLocale(c.DefaultLocale) {
+		return fmt.Errorf("localization.default_locale must be a canonical BCP 47 language tag (got %q)",
+			c.DefaultLocale)
+	}
+	if c.DefaultZoneinfo != "" && !utils.IsValidZoneinfo(c.DefaultZoneinfo) {
+		return fmt.Errorf("localization.default_zoneinfo must be an IANA time zone name (got %q)",
+			c.DefaultZoneinfo)
+	}
+	return nil
+}
+
+// QuotaConfig holds the configuration of the resource quotas of organization units and tenants.
+type QuotaConfig struct {
+	// ThresholdPercentages lists the usage percentages of a quota at which the webhook is notified.
+	ThresholdPercentages []int `yaml:"threshold_percentages" json:"threshold_percentages"`
+	// WebhookURL is the endpoint notified when the usage of a quota reaches a threshold percentage.
+	WebhookURL string `yaml:"webhook_url" json:"webhook_url"`
+}
+
+// AdminNotificationConfig holds the configuration of the in-app notification inbox of administrators.
+type AdminNotificationConfig struct {
+	// Enabled records operational events as notifications and registers the notification API.
+	Enabled bool `yaml:"enabled" json:"enabled"`
+	// RecipientRoles lists the names of the roles whose members receive every notification.
+	RecipientRoles []string `yaml:"recipient_roles" json:"recipient_roles"`
+	// EventRecipientRoles maps an event type, such as "QUOTA_THRESHOLD_REACHED", to the names of the roles
+	// that receive its notifications instead of RecipientRoles.
+	EventRecipientRoles map[string][]string `yaml:"event_recipient_roles" json:"event_recipient_roles"`
+	// Retention is the number of seconds a notification is kept before it is deleted.
+	Retention int64 `yaml:"retention" json:"retention"`
+	// CleanupInterval is the number of seconds between the deletions of expired notifications and the
+	// checks of the server certificate expiry. Zero disables both.
+	CleanupInterval int64 `yaml:"cleanup_interval" json:"cleanup_interval"`
+	// CertificateExpiryWarning is the number of seconds before the expiry of the server certificate from
+	// which administrators are notified.
+	CertificateExpiryWarning int64 `yaml:"certificate_expiry_warning" json:"certificate_expiry_warning"`
+}
+
+// Validate checks that the admin notification durations are not negative.
+func (c *AdminNotificationConfig) Validate() error {
+	if c.Retention < 0 || c.CleanupInterval < 0 || c.CertificateExpiryWarning < 0 {
+		return fmt.Errorf("admin_notification values must not be negative")
+	}
+	return nil
+}
+
+// WebhookConfig holds the configuration of the store-and-forward delivery of events to webhook
+// subscriptions.
+type WebhookConfig struct {
+	// Enabled queues events for the configured subscriptions and registers the webhook API.
+	Enabled bool `yaml:"enabled" json:"enabled"`
+	// DeliveryInterval is the number of seconds between the deliveries of queued events and the deletions
+	// of expired events. Zero disables both.
+	DeliveryInterval int64 `yaml:"delivery_interval" json:"delivery_interval"`
+	// Timeout is the timeout in seconds of a delivery. Zero uses the default of ten seconds.
+	Timeout int `yaml:"timeout" json:"timeout"`
+	// Retention is the number of seconds an event is kept for redelivery, whether it was delivered or not,
+	// for subscriptions that do not set their own. Zero keeps events indefinitely.
+	Retention int64 `yaml:"retention" json:"retention"`
+	// MaxQueueDepth is the largest number of pending events of a subscription that does not set its own.
+	// Zero does not limit the queue.
+	MaxQueueDepth int `yaml:"max_queue_depth" json:"max_queue_depth"`
+	// Subscriptions are the endpoints the events are delivered to.
+	Subscriptions []WebhookSubscriptionConfig `yaml:"subscriptions" json:"subscriptions"`
+}
+
+// WebhookSubscriptionConfig holds the configuration of a single webhook subscription.
+type WebhookSubscriptionConfig struct {
+	// ID identifies the subscription in the webhook API.
+	ID string `yaml:"id" json:"id"`
+	// Tenant is the ID of the tenant whose events are delivered to the subscription. Empty delivers the
+	// events of the deployment root.
+	Tenant string `yaml:"tenant" json:"tenant"`
+	// EndpointURL is the URL the events are posted to.
+	EndpointURL string `yaml:"endpoint_url" json:"endpoint_url"`
+	// Secret signs the body of every delivery with HMAC-SHA256. Deliveries are not signed when empty.
+	Secret string `yaml:"secret" json:"secret"`
+	// Events lists the types of the events delivered to the subscription. Empty delivers every audit and
+	// operational event.
+	Events []string `yaml:"events" json:"events"`
+	// MaxQueueDepth overrides webhooks.max_queue_depth for the subscription.
+	MaxQueueDepth int `yaml:"max_queue_depth" json:"max_queue_depth"`
+	// Retention overrides webhooks.retention for the subscription.
+	Retention int64 `yaml:"retention" json:"retention"`
+}
+
+// Validate checks that the webhook values are not negative and that every subscription has an endpoint.
+func (c *WebhookConfig) Validate() error {
+	if c.DeliveryInterval < 0 || c.Timeout < 0 || c.Retention < 0 || c.MaxQueueDepth < 0 {
+		return fmt.Errorf("webhooks values must not be negative")
+	}
+	subscriptionIDs := make(map[string]bool, len(c.Subscriptions))
+	for _, subscription := range c.Subscriptions {
+		if subscription.ID == "" {
+			return fmt.Errorf("webhooks.subscriptions entries must have an id")
+		}
+		if subscriptionIDs[subscription.ID] {
+			return fmt.Errorf("webhooks.subscriptions has more than one subscription with id %q", subscription.ID)
+		}
+		subscriptionIDs[subscription.ID] = true
+		endpoint, err := url.Parse(subscription.EndpointURL)
+		if err != nil || (endpoint.Scheme != schemeHTTPS && endpoint.Scheme != "http") || endpoint.Host == "" {
+			return fmt.Errorf("webhooks.subscriptions entry %q must have an http(s) endpoint_url", subscription.ID)
+		}
+		if subscription.MaxQueueDepth < 0 || subscription.Retention < 0 {
+			return fmt.Errorf("webhooks.subscriptions entry %q values must not be negative", subscription.ID)
+		}
+	}
+	return nil
+}
+
+// AuditConfig holds the configuration of the audit log of administrative and runtime events.
+type AuditConfig struct {
+	// Enabled records audit events in the audit log and registers the audit event API.
+	Enabled bool `yaml:"enabled" json:"enabled"`
+	// Retention is the number of seconds an audit event is kept before it is deleted.
+	Retention int64 `yaml:"retention" json:"retention"`
+	// CleanupInterval is the number of seconds between the deletions of expired audit events. Zero
+	// disables the deletion.
+	CleanupInterval int64 `yaml:"cleanup_interval" json:"cleanup_interval"`
+}
+
+// Validate checks that the audit durations are not negative.
+func (c *AuditConfig) Validate() error {
+	if c.Retention < 0 || c.CleanupInterval < 0 {
+		return fmt.Errorf("audit values must not be negative")
+	}
+	return nil
+}
+
+// SharedSignalsConfig holds the configuration of the transmitter of Security Event Tokens (RFC 8417) to the
+// receivers of the Shared Signals Framework.
+type SharedSignalsConfig struct {
+	// Enabled transmits security events to the configured receivers and registers the poll endpoint.
+	Enabled bool `yaml:"enabled" json:"enabled"`
+	// Retention is the number of seconds an undelivered security event is kept for its receiver. It is also
+	// the validity period of the Security Event Token.
+	Retention int64 `yaml:"retention" json:"retention"`
+	// RetryInterval is the number of seconds between the retries of failed push deliveries and the deletions
+	// of expired security events. Zero disables both.
+	RetryInterval int64 `yaml:"retry_interval" json:"retry_interval"`
+	// PushTimeout is the timeout in seconds of a push delivery. Zero uses the default of five seconds.
+	PushTimeout int `yaml:"push_timeout" json:"push_timeout"`
+	// Receivers are the receivers the security events are transmitted to.
+	Receivers []SharedSignalsReceiverConfig `yaml:"receivers" json:"receivers"`
+}
+
+// SharedSignalsReceiverConfig holds the stream of security events of a single receiver.
+type SharedSignalsReceiverConfig struct {
+	// ID identifies the receiver, and is sent as the stream ID of its security events.
+	ID string `yaml:"id" json:"id"`
+	// Tenant is the ID of the tenant whose security events are sent to the receiver. Empty sends the security
+	// events of the deployment root.
+	Tenant string `yaml:"tenant" json:"tenant"`
+	// Audience is the aud claim of the Security Event Tokens sent to the receiver.
+	Audience string `yaml:"audience" json:"audience"`
+	// DeliveryMethod is "push" to post the events to EndpointURL (RFC 8935), or "poll" for the receiver to
+	// fetch them from the poll endpoint (RFC 8936).
+	DeliveryMethod string `yaml:"delivery_method" json:"delivery_method"`
+	// EndpointURL is the URL the events of a push receiver are posted to.
+	EndpointURL string `yaml:"endpoint_url" json:"endpoint_url"`
+	// AuthorizationHeader is the value of the Authorization header of push deliveries. No header is sent when
+	// empty.
+	AuthorizationHeader string `yaml:"authorization_header" json:"authorization_header"`
+	// ClientID is the OAuth client ID a poll receiver authenticates the poll requests with.
+	ClientID string `yaml:"client_id" json:"client_id"`
+	// Events lists the URIs of the event types sent to the receiver. Empty sends every supported event type.
+	Events []string `yaml:"events" json:"events"`
+}
+
+// Validate checks that the shared signals durations are not negative and that every receiver can be
+// delivered to.
+func (c *SharedSignalsConfig) Validate() error {
+	if c.Retention < 0 || c.RetryInterval < 0 || c.PushTimeout < 0 {
+		return fmt.Errorf("shared_signals values must not be negative")
+	}
+	receiverIDs := make(map[string]bool, len(c.Receivers))
+	for _, receiver := range c.Receivers {
+		if receiver.ID == "" {
+			return fmt.Errorf("shared_signals.receivers entries must have an id")
+		}
+		if receiverIDs[receiver.ID] {
+			return fmt.Errorf("shared_signals.receivers has more than one receiver with id %q", receiver.ID)
+		}
+		receiverIDs[receiver.ID] = true
+		if receiver.Audience == "" {
+			return fmt.Errorf("shared_signals.receivers entry %q must have an audience", receiver.ID)
+		}
+		switch receiver.DeliveryMethod {
+		case "push":
+			endpoint, err := url.Parse(receiver.EndpointURL)
+			if err != nil || (endpoint.Scheme != schemeHTTPS && endpoint.Scheme != "http") || endpoint.Host == "" {
+				return fmt.Errorf("shared_signals.receivers entry %q must have an http(s) endpoint_url",
+					receiver.ID)
+			}
+		case "poll":
+			if receiver.ClientID == "" {
+				return fmt.Errorf("shared_signals.receivers entry %q must have a client_id", receiver.ID)
+			}
+		default:
+			return fmt.Errorf("shared_signals.receivers entry %q has invalid delivery_method %q, "+
+				"must be push or poll", receiver.ID, receiver.DeliveryMethod)
+		}
+	}
+	return nil
+}
+
+// PasswordPolicyConfig holds the configuration of password policy enforcement.
+type PasswordPolicyConfig struct {
+	// BreachCheckURL is the base URL of the range API of the breached password service. The first five
+	// characters of the SHA-1 hash of a password are appended to it, so the password never leaves the server.
+	BreachCheckURL string `yaml:"breach_check_url" json:"breach_check_url"`
+	// BreachCheckTimeout is the timeout in seconds of a request to the breached password service.
+	BreachCheckTimeout int `yaml:"breach_check_timeout" json:"breach_check_timeout"`
+	// HistoryDepth is the number of previous passwords of a user that cannot be reused when the password
+	// policy of the user does not set a history count. Zero disables password history by default.
+	HistoryDepth int `yaml:"history_depth" json:"history_depth"`
+}
+
+// Validate checks that the password policy values are not negative.
+func (c *PasswordPolicyConfig) Validate() error {
+	if c.BreachCheckTimeout < 0 || c.HistoryDepth < 0 {
+		return fmt.Errorf("password_policy values must not be negative")
+	}
+	return nil
+}
+
+// SCIMConfig holds the configuration of the SCIM 2.0 provisioning endpoints.
+type SCIMConfig struct {
+	// Enabled registers the SCIM 2.0 endpoints under /scim/v2.
+	Enabled bool `yaml:"enabled" json:"enabled"`
+	// UserType is the name of the user type provisioned users are created with. Users are created in the
+	// organization unit of the user type, and so are provisioned groups.
+	UserType string `yaml:"user_type" json:"user_type"`
+	// AttributeMappings maps SCIM attribute paths, such as "name.givenName", to user attributes. The
+	// mappings override the default mappings, and an empty attribute removes a default mapping.
+	AttributeMappings map[string]string `yaml:"attribute_mappings" json:"attribute_mappings"`
+}
+
+// Validate checks that a user type is set when the SCIM endpoints are enabled.
+func (c *SCIMConfig) Validate() error {
+	if c.Enabled && c.UserType == "" {
+		return fmt.Errorf("scim.user_type must be set")
+	}
+	return nil
+}
+
 // Config holds the complete configuration details of the server.
 type Config struct {
-	Server               ServerConfig           `yaml:"server" json:"server"`
-	GateClient           GateClientConfig       `yaml:"gate_client" json:"gate_client"`
-	TLS                  TLSConfig              `yaml:"tls" json:"tls"`
-	Database             DatabaseConfig         `yaml:"database" json:"database"`
-	Cache                CacheConfig            `yaml:"cache" json:"cache"`
-	JWT                  JWTConfig              `yaml:"jwt" json:"jwt"`
-	OAuth                OAuthConfig            `yaml:"oauth" json:"oauth"`
-	Flow                 FlowConfig             `yaml:"flow" json:"flow"`
-	Crypto               CryptoConfig           `yaml:"crypto" json:"crypto"`
-	CORS                 CORSConfig             `yaml:"cors" json:"cors"`
-	User                 UserConfig             `yaml:"user" json:"user"`
-	DeclarativeResources DeclarativeResources   `yaml:"declarative_resources" json:"declarative_resources"`
-	Resource             ResourceConfig         `yaml:"resource" json:"resource"`
-	OrganizationUnit     OrganizationUnitConfig `yaml:"organization_unit" json:"organization_unit"`
-	IdentityProvider     IdentityProviderConfig `yaml:"identity_provider" json:"identity_provider"`
-	Application          ApplicationConfig      `yaml:"application" json:"application"`
-	EntityType           EntityTypeConfig       `yaml:"user_type" json:"user_type"`
-	Observability        ObservabilityConfig    `yaml:"observability" json:"observability"`
-	Passkey              PasskeyConfig          `yaml:"passkey" json:"passkey"`
-	AuthnProvider        AuthnProviderConfig    `yaml:"authn_provider" json:"authn_provider"`
-	UserProvider         UserProviderConfig     `yaml:"user_provider" json:"user_provider"`
-	EntityProvider       EntityProviderConfig   `yaml:"entity_provider" json:"entity_provider"`
-	Role                 RoleConfig             `yaml:"role" json:"role"`
-	Theme                ThemeConfig            `yaml:"theme" json:"theme"`
-	Layout               LayoutConfig           `yaml:"layout" json:"layout"`
-	Translation          TranslationConfig      `yaml:"translation" json:"translation"`
-	Email                EmailConfig            `yaml:"email" json:"email"`
-	Consent              ConsentConfig          `yaml:"consent" json:"consent"`
+	Server                ServerConfig                `yaml:"server" json:"server"`
+	GateClient            GateClientConfig            `yaml:"gate_client" json:"gate_client"`
+	TLS                   TLSConfig                   `yaml:"tls" json:"tls"`
+	Database              DatabaseConfig              `yaml:"database" json:"database"`
+	Cache                 CacheConfig                 `yaml:"cache" json:"cache"`
+	ObjectStore           ObjectStoreConfig           `yaml:"object_store" json:"object_store"`
+	JWT                   JWTConfig                   `yaml:"jwt" json:"jwt"`
+	OAuth                 OAuthConfig                 `yaml:"oauth" json:"oauth"`
+	Flow                  FlowConfig                  `yaml:"flow" json:"flow"`
+	Crypto                CryptoConfig                `yaml:"crypto" json:"crypto"`
+	CORS                  CORSConfig                  `yaml:"cors" json:"cors"`
+	User                  UserConfig                  `yaml:"user" json:"user"`
+	DeclarativeResources  DeclarativeResources        `yaml:"declarative_resources" json:"declarative_resources"`
+	Resource              ResourceConfig              `yaml:"resource" json:"resource"`
+	OrganizationUnit      OrganizationUnitConfig      `yaml:"organization_unit" json:"organization_unit"`
+	IdentityProvider      IdentityProviderConfig      `yaml:"identity_provider" json:"identity_provider"`
+	Application           ApplicationConfig           `yaml:"application" json:"application"`
+	EntityType            EntityTypeConfig            `yaml:"user_type" json:"user_type"`
+	Observability         ObservabilityConfig         `yaml:"observability" json:"observability"`
+	Passkey               PasskeyConfig               `yaml:"passkey" json:"passkey"`
+	AuthnProvider         AuthnProviderConfig         `yaml:"authn_provider" json:"authn_provider"`
+	UserProvider          UserProviderConfig          `yaml:"user_provider" json:"user_provider"`
+	EntityProvider        EntityProviderConfig        `yaml:"entity_provider" json:"entity_provider"`
+	Role                  RoleConfig                  `yaml:"role" json:"role"`
+	Theme                 ThemeConfig                 `yaml:"theme" json:"theme"`
+	Layout                LayoutConfig                `yaml:"layout" json:"layout"`
+	Translation           TranslationConfig           `yaml:"translation" json:"translation"`
+	Email                 EmailConfig                 `yaml:"email" json:"email"`
+	Consent               ConsentConfig               `yaml:"consent" json:"consent"`
+	Tenant                TenantConfig                `yaml:"tenant" json:"tenant"`
+	Integrity             IntegrityConfig             `yaml:"integrity" json:"integrity"`
+	ConfigDrift           ConfigDriftConfig           `yaml:"config_drift" json:"config_drift"`
+	TrustedDevice         TrustedDeviceConfig         `yaml:"trusted_device" json:"trusted_device"`
+	SecurityNotification  SecurityNotificationConfig  `yaml:"security_notification" json:"security_notification"`
+	EmailChange           EmailChangeConfig           `yaml:"email_change" json:"email_change"`
+	AccountProtection     AccountProtectionConfig     `yaml:"account_protection" json:"account_protection"`
+	AccountDeletion       AccountDeletionConfig       `yaml:"account_deletion" json:"account_deletion"`
+	EnumerationResistance EnumerationResistanceConfig `yaml:"enumeration_resistance" json:"enumeration_resistance"`
+	EnrollmentSession     EnrollmentSessionConfig     `yaml:"enrollment_session" json:"enrollment_session"`
+	BreakGlass            BreakGlassConfig            `yaml:"break_glass" json:"break_glass"`
+	ChangeApproval        ChangeApprovalConfig        `yaml:"change_approval" json:"change_approval"`
+	AccessReview          AccessReviewConfig          `yaml:"access_review" json:"access_review"`
+	SystemAuthorization   SystemAuthorizationConfig   `yaml:"system_authorization" json:"system_authorization"`
+	Relationships         RelationshipConfig          `yaml:"relationships" json:"relationships"`
+	ConfigValidation      ConfigValidationConfig      `yaml:"config_validation" json:"config_validation"`
+	Quota                 QuotaConfig                 `yaml:"quota" json:"quota"`
+	AdminNotification     AdminNotificationConfig     `yaml:"admin_notification" json:"admin_notification"`
+	Webhooks              WebhookConfig               `yaml:"webhooks" json:"webhooks"`
+	Audit                 AuditConfig                 `yaml:"audit" json:"audit"`
+	SharedSignals         SharedSignalsConfig         `yaml:"shared_signals" json:"shared_signals"`
+	DistributedLock       DistributedLockConfig       `yaml:"distributed_lock" json:"distributed_lock"`
+	Localization          LocalizationConfig          `yaml:"localization" json:"localization"`
+	SCIM                  SCIMConfig                  `yaml:"scim" json:"scim"`
+	PasswordPolicy        PasswordPolicyConfig        `yaml:"password_policy" json:"password_policy"`
 }
 
 // LoadConfig loads the configurations from the specified YAML file and applies defaults.
@@ -706,15 +2395,133 @@ func LoadConfig(configPath string, defaultPath string, serverHome string) (*Conf
 	if err := cfg.Server.SecurityConfig.Validate(); err != nil {
 		return nil, err
 	}
+	if err := cfg.Server.Proxy.Validate(); err != nil {
+		return nil, err
+	}
+	if err := cfg.Server.RequestLimits.Validate(); err != nil {
+		return nil, err
+	}
+	if err := cfg.Server.AdaptiveConcurrency.Validate(); err != nil {
+		return nil, err
+	}
+	if err := cfg.Server.RequestTimeouts.Validate(); err != nil {
+		return nil, err
+	}
+	if err := cfg.Server.Shutdown.Validate(); err != nil {
+		return nil, err
+	}
+	if err := cfg.Server.ErrorFormat.Validate(); err != nil {
+		return nil, err
+	}
 	if err := cfg.CORS.Validate(); err != nil {
 		return nil, err
 	}
+	if err := cfg.User.SensitiveReadAudit.Validate(); err != nil {
+		return nil, err
+	}
+	if err := cfg.User.IdentifierFilter.Validate(); err != nil {
+		return nil, err
+	}
+	if err := cfg.User.Provisioning.Validate(); err != nil {
+		return nil, err
+	}
+	if err := cfg.OAuth.RoleClaims.Validate(); err != nil {
+		return nil, err
+	}
+	if err := cfg.OAuth.ClaimFallback.Validate(); err != nil {
+		return nil, err
+	}
+	if err := cfg.OAuth.TokenCustomization.Validate(); err != nil {
+		return nil, err
+	}
+	if err := cfg.OAuth.ScopeCeilings.Validate(); err != nil {
+		return nil, err
+	}
+	if err := cfg.OAuth.DeviceAuthorization.Validate(); err != nil {
+		return nil, err
+	}
+	if err := cfg.OAuth.DPoP.Validate(); err != nil {
+		return nil, err
+	}
+	if err := cfg.SystemAuthorization.Validate(); err != nil {
+		return nil, err
+	}
+	if err := cfg.Relationships.Validate(); err != nil {
+		return nil, err
+	}
+	if len(cfg.SystemAuthorization.RelationshipDelegations) > 0 && !cfg.Relationships.Enabled {
+		return nil, fmt.Errorf("system_authorization.relationship_delegations requires relationships.enabled")
+	}
+	if err := cfg.SecurityNotification.Validate(); err != nil {
+		return nil, err
+	}
+	if err := cfg.EmailChange.Validate(); err != nil {
+		return nil, err
+	}
+	if err := cfg.AccountProtection.Validate(); err != nil {
+		return nil, err
+	}
+	if err := cfg.AccountDeletion.Validate(); err != nil {
+		return nil, err
+	}
+	if err := cfg.EnumerationResistance.Validate(); err != nil {
+		return nil, err
+	}
+	if err := cfg.EnrollmentSession.Validate(); err != nil {
+		return nil, err
+	}
+	if err := cfg.Cache.Warming.Validate(); err != nil {
+		return nil, err
+	}
+	if err := cfg.AccessReview.Validate(); err != nil {
+		return nil, err
+	}
+	if err := cfg.AdminNotification.Validate(); err != nil {
+		return nil, err
+	}
+	if err := cfg.Webhooks.Validate(); err != nil {
+		return nil, err
+	}
+	if err := cfg.Audit.Validate(); err != nil {
+		return nil, err
+	}
+	if err := cfg.SharedSignals.Validate(); err != nil {
+		return nil, err
+	}
+	if err := cfg.PasswordPolicy.Validate(); err != nil {
+		return nil, err
+	}
+	if err := cfg.Flow.EventStream.Validate(); err != nil {
+		return nil, err
+	}
 
 	// Validate ACR-AMR mapping.
 	if err := cfg.OAuth.AuthClass.Validate(); err != nil {
 		return nil, err
 	}
 
+	if err := cfg.OAuth.TokenStore.Validate(); err != nil {
+		return nil, err
+	}
+	if err := cfg.OAuth.PreIssuanceHook.Validate(); err != nil {
+		return nil, err
+	}
+	if cfg.OAuth.TokenStore.UsesRedis() && cfg.Database.Runtime.Redis.Address == "" {
+		return nil, fmt.Errorf("token_store: database.runtime.redis.address is required for a redis token store")
+	}
+	if err := cfg.DistributedLock.Validate(); err != nil {
+		return nil, err
+	}
+	if cfg.DistributedLock.Store == "redis" && cfg.Database.Runtime.Redis.Address == "" {
+		return nil, fmt.Errorf("distributed_lock: database.runtime.redis.address is required for a redis lock store")
+	}
+	if err := cfg.Localization.Validate(); err != nil {
+		return nil, err
+	}
+	if err := cfg.SCIM.Validate(); err != nil {
+		return nil, err
+	}
+
 	return &cfg, nil
 }
 
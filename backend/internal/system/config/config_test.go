@@ -965,6 +965,621 @@ func (suite *ConfigTestSuite) TestSecurityConfig_Validate_ZeroJWKSCacheTTL() {
 	assert.NoError(suite.T(), err)
 }
 
+func (suite *ConfigTestSuite) TestPreIssuanceHookConfig_Validate() {
+	assert.NoError(suite.T(), (&PreIssuanceHookConfig{}).Validate())
+	assert.NoError(suite.T(), (&PreIssuanceHookConfig{FailMode: "closed", TimeoutMS: 500, CacheTTL: 30}).Validate())
+
+	err := (&PreIssuanceHookConfig{FailMode: "ignore"}).Validate()
+	assert.Error(suite.T(), err)
+	assert.Contains(suite.T(), err.Error(), "fail_mode")
+
+	err = (&PreIssuanceHookConfig{TimeoutMS: -1}).Validate()
+	assert.Error(suite.T(), err)
+	assert.Contains(suite.T(), err.Error(), "timeout_ms")
+
+	err = (&PreIssuanceHookConfig{CacheTTL: -1}).Validate()
+	assert.Error(suite.T(), err)
+	assert.Contains(suite.T(), err.Error(), "cache_ttl")
+}
+
+func (suite *ConfigTestSuite) TestDeviceAuthorizationConfig_Validate() {
+	assert.NoError(suite.T(), (&DeviceAuthorizationConfig{}).Validate())
+	assert.NoError(suite.T(), (&DeviceAuthorizationConfig{
+		ExpiresIn: 600, Interval: 5, VerificationURI: "https://example.com/device"}).Validate())
+
+	err := (&DeviceAuthorizationConfig{ExpiresIn: -1}).Validate()
+	assert.Error(suite.T(), err)
+	assert.Contains(suite.T(), err.Error(), "expires_in")
+
+	err = (&DeviceAuthorizationConfig{Interval: -1}).Validate()
+	assert.Error(suite.T(), err)
+	assert.Contains(suite.T(), err.Error(), "interval")
+
+	err = (&DeviceAuthorizationConfig{VerificationURI: "/device"}).Validate()
+	assert.Error(suite.T(), err)
+	assert.Contains(suite.T(), err.Error(), "verification_uri")
+}
+
+func (suite *ConfigTestSuite) TestDPoPConfig_Validate() {
+	assert.NoError(suite.T(), (&DPoPConfig{}).Validate())
+	assert.NoError(suite.T(), (&DPoPConfig{ProofMaxAge: 60, RequireNonce: true, NonceExpiresIn: 300}).Validate())
+
+	err := (&DPoPConfig{ProofMaxAge: -1}).Validate()
+	assert.Error(suite.T(), err)
+	assert.Contains(suite.T(), err.Error(), "proof_max_age")
+
+	err = (&DPoPConfig{NonceExpiresIn: -1}).Validate()
+	assert.Error(suite.T(), err)
+	assert.Contains(suite.T(), err.Error(), "nonce_expires_in")
+}
+
+func (suite *ConfigTestSuite) TestDistributedLockConfig_Validate() {
+	assert.NoError(suite.T(), (&DistributedLockConfig{}).Validate())
+	assert.NoError(suite.T(), (&DistributedLockConfig{Store: "redis", LeaseTTL: 30, RetryIntervalMS: 500}).Validate())
+
+	err := (&DistributedLockConfig{Store: "etcd"}).Validate()
+	assert.Error(suite.T(), err)
+	assert.Contains(suite.T(), err.Error(), "distributed_lock.store")
+
+	err = (&DistributedLockConfig{LeaseTTL: -1}).Validate()
+	assert.Error(suite.T(), err)
+	assert.Contains(suite.T(), err.Error(), "lease_ttl")
+
+	err = (&DistributedLockConfig{RetryIntervalMS: -1}).Validate()
+	assert.Error(suite.T(), err)
+	assert.Contains(suite.T(), err.Error(), "retry_interval_ms")
+}
+
+func (suite *ConfigTestSuite) TestLocalizationConfig_Validate() {
+	assert.NoError(suite.T(), (&LocalizationConfig{}).Validate())
+	assert.NoError(suite.T(), (&LocalizationConfig{DefaultLocale: "fr-CA", DefaultZoneinfo: "Europe/Paris"}).Validate())
+
+	err := (&LocalizationConfig{DefaultLocale: "en_US"}).Validate()
+	assert.Error(suite.T(), err)
+	assert.Contains(suite.T(), err.Error(), "default_locale")
+
+	err = (&LocalizationConfig{DefaultZoneinfo: "Nowhere/City"}).Validate()
+	assert.Error(suite.T(), err)
+	assert.Contains(suite.T(), err.Error(), "default_zoneinfo")
+}
+
+func (suite *ConfigTestSuite) TestProxyConfig_Validate_Valid() {
+	cfg := &ProxyConfig{
+		TrustedProxies:   []string{"10.0.0.0/8", "192.0.2.1"},
+		ProxyProtocol:    true,
+		ClientCertHeader: "X-Client-Cert",
+	}
+	assert.NoError(suite.T(), cfg.Validate())
+	assert.NoError(suite.T(), (&ProxyConfig{}).Validate())
+}
+
+func (suite *ConfigTestSuite) TestProxyConfig_Validate_InvalidTrustedProxy() {
+	cfg := &ProxyConfig{
+		TrustedProxies: []string{"not-an-ip"},
+	}
+	err := cfg.Validate()
+	assert.Error(suite.T(), err)
+	assert.Contains(suite.T(), err.Error(), "server.proxy")
+}
+
+func (suite *ConfigTestSuite) TestProxyConfig_Validate_RequiresTrustedProxies() {
+	err := (&ProxyConfig{ProxyProtocol: true}).Validate()
+	assert.Error(suite.T(), err)
+	assert.Contains(suite.T(), err.Error(), "trusted_proxies")
+
+	err = (&ProxyConfig{ClientCertHeader: "X-Client-Cert"}).Validate()
+	assert.Error(suite.T(), err)
+	assert.Contains(suite.T(), err.Error(), "trusted_proxies")
+}
+
+func (suite *ConfigTestSuite) TestSensitiveReadAuditConfig_Validate() {
+	assert.NoError(suite.T(), (&SensitiveReadAuditConfig{Enabled: true, SampleRate: 0.25}).Validate())
+	assert.NoError(suite.T(), (&SensitiveReadAuditConfig{}).Validate())
+
+	err := (&SensitiveReadAuditConfig{Enabled: true, SampleRate: 1.5}).Validate()
+	assert.Error(suite.T(), err)
+	assert.Contains(suite.T(), err.Error(), "sample_rate")
+
+	assert.Error(suite.T(), (&SensitiveReadAuditConfig{SampleRate: -0.1}).Validate())
+}
+
+func (suite *ConfigTestSuite) TestIdentifierFilterConfig_Validate() {
+	valid := IdentifierFilterConfig{Enabled: true, ExpectedEntries: 1000, FalsePositiveRate: 0.01,
+		RebuildInterval: 3600, MaxResponsePadding: 200}
+	assert.NoError(suite.T(), valid.Validate())
+	assert.NoError(suite.T(), (&IdentifierFilterConfig{}).Validate())
+
+	invalid := valid
+	invalid.FalsePositiveRate = 1
+	err := invalid.Validate()
+	assert.Error(suite.T(), err)
+	assert.Contains(suite.T(), err.Error(), "false_positive_rate")
+
+	invalid = valid
+	invalid.ExpectedEntries = 0
+	assert.Error(suite.T(), invalid.Validate())
+
+	invalid = valid
+	invalid.RebuildInterval = 0
+	assert.Error(suite.T(), invalid.Validate())
+
+	invalid = valid
+	invalid.MaxResponsePadding = -1
+	assert.Error(suite.T(), invalid.Validate())
+}
+
+func (suite *ConfigTestSuite) TestSystemAuthorizationConfig_Validate() {
+	assert.NoError(suite.T(), (&SystemAuthorizationConfig{}).Validate())
+	assert.NoError(suite.T(), (&SystemAuthorizationConfig{
+		FailureHandling: AuthzFailureHandlingConfig{Read: AuthzFailureModeFailOpen, Write: AuthzFailureModeFailClosed},
+		CircuitBreaker:  AuthzCircuitBreakerConfig{FailureThreshold: 5, OpenDuration: 30},
+	}).Validate())
+
+	err := (&SystemAuthorizationConfig{
+		FailureHandling: AuthzFailureHandlingConfig{Write: AuthzFailureModeFailOpen},
+	}).Validate()
+	assert.Error(suite.T(), err)
+	assert.Contains(suite.T(), err.Error(), "failure_handling.write")
+
+	assert.Error(suite.T(), (&SystemAuthorizationConfig{
+		FailureHandling: AuthzFailureHandlingConfig{Read: "ignore"},
+	}).Validate())
+	assert.Error(suite.T(), (&SystemAuthorizationConfig{
+		CircuitBreaker: AuthzCircuitBreakerConfig{FailureThreshold: -1},
+	}).Validate())
+}
+
+func (suite *ConfigTestSuite) TestSystemAuthorizationConfig_ValidateRelationshipDelegations() {
+	assert.NoError(suite.T(), (&SystemAuthorizationConfig{
+		RelationshipDelegations: []AuthzRelationshipDelegation{
+			{ResourceType: "group", Relations: map[string]string{"group:update": "editor"}},
+		},
+	}).Validate())
+
+	assert.Error(suite.T(), (&SystemAuthorizationConfig{
+		RelationshipDelegations: []AuthzRelationshipDelegation{{Relations: map[string]string{"group:view": "viewer"}}},
+	}).Validate())
+	assert.Error(suite.T(), (&SystemAuthorizationConfig{
+		RelationshipDelegations: []AuthzRelationshipDelegation{{ResourceType: "group"}, {ResourceType: "group"}},
+	}).Validate())
+	assert.Error(suite.T(), (&SystemAuthorizationConfig{
+		RelationshipDelegations: []AuthzRelationshipDelegation{
+			{ResourceType: "group", Relations: map[string]string{"group:update": ""}},
+		},
+	}).Validate())
+}
+
+func (suite *ConfigTestSuite) TestRelationshipConfig_Validate() {
+	assert.NoError(suite.T(), (&RelationshipConfig{}).Validate())
+	assert.NoError(suite.T(), (&RelationshipConfig{
+		MaxCheckDepth:    8,
+		MaxWriteSize:     100,
+		ImpliedRelations: map[string]map[string][]string{"group": {"viewer": {"editor"}}},
+	}).Validate())
+
+	assert.Error(suite.T(), (&RelationshipConfig{MaxCheckDepth: -1}).Validate())
+	assert.Error(suite.T(), (&RelationshipConfig{
+		ImpliedRelations: map[string]map[string][]string{"group": {"viewer": {"viewer"}}},
+	}).Validate())
+}
+
+func (suite *ConfigTestSuite) TestSecurityNotificationConfig_Validate() {
+	valid := func() SecurityNotificationConfig {
+		return SecurityNotificationConfig{
+			Enabled:             true,
+			Events:              []string{"new_sign_in", "credential_changed"},
+			DefaultChannel:      "email",
+			PreferenceAttribute: "securityNotificationChannel",
+			EmailAttribute:      "email",
+			MobileAttribute:     "mobileNumber",
+			KnownDeviceValidity: 7776000,
+			ThrottleLimit:       5,
+			ThrottleWindow:      3600,
+		}
+	}
+	cfg := valid()
+	assert.NoError(suite.T(), cfg.Validate())
+	assert.NoError(suite.T(), (&SecurityNotificationConfig{DefaultChannel: "pigeon"}).Validate())
+
+	cfg = valid()
+	cfg.Events = []string{"new_device"}
+	assert.Error(suite.T(), cfg.Validate())
+	cfg = valid()
+	cfg.DefaultChannel = "pigeon"
+	assert.Error(suite.T(), cfg.Validate())
+	cfg = valid()
+	cfg.DefaultChannel = "sms"
+	assert.Error(suite.T(), cfg.Validate())
+	cfg.SMSSenderID = "sender-1"
+	assert.NoError(suite.T(), cfg.Validate())
+	cfg = valid()
+	cfg.EmailAttribute = ""
+	assert.Error(suite.T(), cfg.Validate())
+	cfg = valid()
+	cfg.ThrottleWindow = 0
+	assert.Error(suite.T(), cfg.Validate())
+}
+
+func (suite *ConfigTestSuite) TestEmailChangeConfig_Validate() {
+	valid := func() EmailChangeConfig {
+		return EmailChangeConfig{
+			Enabled:        true,
+			EmailAttribute: "email",
+			ValidityPeriod: 86400,
+		}
+	}
+	cfg := valid()
+	assert.NoError(suite.T(), cfg.Validate())
+	assert.NoError(suite.T(), (&EmailChangeConfig{}).Validate())
+
+	cfg.ConfirmationURL = "https://accounts.example.com/email/confirm"
+	assert.NoError(suite.T(), cfg.Validate())
+	cfg.ConfirmationURL = "/email/confirm"
+	assert.Error(suite.T(), cfg.Validate())
+	cfg = valid()
+	cfg.EmailAttribute = ""
+	assert.Error(suite.T(), cfg.Validate())
+	cfg = valid()
+	cfg.ValidityPeriod = 0
+	assert.Error(suite.T(), cfg.Validate())
+}
+
+func (suite *ConfigTestSuite) TestAccountDeletionConfig_Validate() {
+	valid := func() AccountDeletionConfig {
+		return AccountDeletionConfig{
+			Enabled:        true,
+			EmailAttribute: "email",
+			GracePeriod:    2592000,
+		}
+	}
+	cfg := valid()
+	assert.NoError(suite.T(), cfg.Validate())
+	assert.NoError(suite.T(), (&AccountDeletionConfig{}).Validate())
+
+	cfg.CancellationURL = "https://accounts.example.com/deletion/cancel"
+	assert.NoError(suite.T(), cfg.Validate())
+	cfg.CancellationURL = "/deletion/cancel"
+	assert.Error(suite.T(), cfg.Validate())
+	cfg = valid()
+	cfg.EmailAttribute = ""
+	assert.Error(suite.T(), cfg.Validate())
+	cfg = valid()
+	cfg.GracePeriod = 0
+	assert.Error(suite.T(), cfg.Validate())
+	cfg = valid()
+	cfg.PurgeInterval = -1
+	assert.Error(suite.T(), cfg.Validate())
+}
+
+func (suite *ConfigTestSuite) TestSCIMConfig_Validate() {
+	assert.NoError(suite.T(), (&SCIMConfig{}).Validate())
+	assert.NoError(suite.T(), (&SCIMConfig{Enabled: true, UserType: "Person"}).Validate())
+	assert.Error(suite.T(), (&SCIMConfig{Enabled: true}).Validate())
+}
+
+func (suite *ConfigTestSuite) TestUserProvisioningConfig_Validate() {
+	cfg := UserProvisioningConfig{EmailAttribute: "email"}
+	assert.NoError(suite.T(), cfg.Validate())
+
+	cfg.InvitationURL = "https://accounts.example.com/welcome"
+	assert.NoError(suite.T(), cfg.Validate())
+	cfg.InvitationURL = "/welcome"
+	assert.Error(suite.T(), cfg.Validate())
+}
+
+func (suite *ConfigTestSuite) TestAccountProtectionConfig_Validate() {
+	valid := func() AccountProtectionConfig {
+		return AccountProtectionConfig{
+			Enabled:        true,
+			EmailAttribute: "email",
+			StepUpMaxAge:   300,
+			ResetCooldown:  86400,
+			RevertWindow:   604800,
+		}
+	}
+	cfg := valid()
+	assert.NoError(suite.T(), cfg.Validate())
+	assert.NoError(suite.T(), (&AccountProtectionConfig{}).Validate())
+
+	cfg.RevertURL = "https://accounts.example.com/revert"
+	assert.NoError(suite.T(), cfg.Validate())
+	cfg.RevertURL = "/revert"
+	assert.Error(suite.T(), cfg.Validate())
+	cfg = valid()
+	cfg.EmailAttribute = ""
+	assert.Error(suite.T(), cfg.Validate())
+	cfg = valid()
+	cfg.ResetCooldown = -1
+	assert.Error(suite.T(), cfg.Validate())
+	cfg = valid()
+	cfg.StepUpMaxAge = 0
+	cfg.RevertWindow = 0
+	assert.NoError(suite.T(), cfg.Validate())
+}
+
+func (suite *ConfigTestSuite) TestEnumerationResistanceConfig_Validate() {
+	assert.NoError(suite.T(), (&EnumerationResistanceConfig{}).Validate())
+	assert.NoError(suite.T(), (&EnumerationResistanceConfig{Enabled: true, MinResponseTime: 300,
+		MaxJitter: 100}).Validate())
+	assert.Error(suite.T(), (&EnumerationResistanceConfig{MinResponseTime: -1}).Validate())
+	assert.Error(suite.T(), (&EnumerationResistanceConfig{MaxJitter: -1}).Validate())
+}
+
+func (suite *ConfigTestSuite) TestRequestLimits_Validate() {
+	valid := RequestLimits{MaxBodySize: 1024, MaxJSONDepth: 8,
+		Routes: []RouteRequestLimit{{Path: "/import/**", MaxBodySize: 4096}}}
+	assert.NoError(suite.T(), valid.Validate())
+	assert.NoError(suite.T(), (&RequestLimits{}).Validate())
+
+	assert.Error(suite.T(), (&RequestLimits{MaxJSONArrayLength: -1}).Validate())
+
+	err := (&RequestLimits{Routes: []RouteRequestLimit{{Path: "import", MaxBodySize: 10}}}).Validate()
+	assert.Error(suite.T(), err)
+	assert.Contains(suite.T(), err.Error(), "must start with '/'")
+}
+
+func (suite *ConfigTestSuite) TestRequestTimeouts_Validate() {
+	valid := RequestTimeouts{Default: 8, Routes: []RouteRequestTimeout{{Path: "/import/**", Timeout: 0}}}
+	assert.NoError(suite.T(), valid.Validate())
+	assert.NoError(suite.T(), (&RequestTimeouts{}).Validate())
+
+	assert.Error(suite.T(), (&RequestTimeouts{Default: -1}).Validate())
+	assert.Error(suite.T(), (&RequestTimeouts{Routes: []RouteRequestTimeout{{Path: "/flows", Timeout: -1}}}).Validate())
+
+	err := (&RequestTimeouts{Routes: []RouteRequestTimeout{{Path: "oauth2/token", Timeout: 5}}}).Validate()
+	assert.Error(suite.T(), err)
+	assert.Contains(suite.T(), err.Error(), "must start with '/'")
+}
+
+func (suite *ConfigTestSuite) TestAdaptiveConcurrency_Validate() {
+	valid := AdaptiveConcurrency{Enabled: true, Routes: []string{"/oauth2/token"}, InitialLimit: 20, MinLimit: 5,
+		MaxLimit: 200, LatencyTolerance: 2, BackoffRatio: 0.9, MaxClientShare: 0.5, RetryAfter: 1}
+	assert.NoError(suite.T(), valid.Validate())
+	assert.NoError(suite.T(), (&AdaptiveConcurrency{}).Validate())
+
+	invalid := valid
+	invalid.Routes = []string{"oauth2/token"}
+	assert.ErrorContains(suite.T(), invalid.Validate(), "must start with '/'")
+
+	invalid = valid
+	invalid.InitialLimit = 300
+	assert.Error(suite.T(), invalid.Validate())
+
+	invalid = valid
+	invalid.MinLimit = 0
+	assert.Error(suite.T(), invalid.Validate())
+
+	invalid = valid
+	invalid.LatencyTolerance = 0.5
+	assert.Error(suite.T(), invalid.Validate())
+
+	invalid = valid
+	invalid.BackoffRatio = 1
+	assert.Error(suite.T(), invalid.Validate())
+
+	invalid = valid
+	invalid.MaxClientShare = 0
+	assert.Error(suite.T(), invalid.Validate())
+
+	invalid = valid
+	invalid.RetryAfter = -1
+	assert.Error(suite.T(), invalid.Validate())
+}
+
+func (suite *ConfigTestSuite) TestTokenCustomizationConfig_Validate() {
+	assert.NoError(suite.T(), (&TokenCustomizationConfig{}).Validate())
+	assert.NoError(suite.T(), (&TokenCustomizationConfig{
+		Expressions: TokenExpressionConfig{Enabled: true, MaxLength: 1024}}).Validate())
+	assert.Error(suite.T(), (&TokenCustomizationConfig{
+		Expressions: TokenExpressionConfig{Enabled: true}}).Validate())
+}
+
+func (suite *ConfigTestSuite) TestClaimFallbackConfig_Validate() {
+	valid := ClaimFallbackConfig{Gravatar: GravatarConfig{Enabled: true, BaseURL: "https://gravatar.com/avatar/",
+		Size: 80}}
+	assert.NoError(suite.T(), valid.Validate())
+	assert.NoError(suite.T(), (&ClaimFallbackConfig{}).Validate())
+
+	for _, gravatar := range []GravatarConfig{
+		{Enabled: true},
+		{Enabled: true, BaseURL: "http://gravatar.com/avatar/"},
+		{Enabled: true, BaseURL: "https://gravatar.com/avatar/", Size: -1},
+		{Enabled: true, BaseURL: "https://gravatar.com/avatar/", Size: 4096},
+	} {
+		assert.Error(suite.T(), (&ClaimFallbackConfig{Gravatar: gravatar}).Validate())
+	}
+}
+
+func (suite *ConfigTestSuite) TestFlowEventStreamConfig_Validate() {
+	valid := FlowEventStreamConfig{Enabled: true, HeartbeatInterval: 15, PollInterval: 2, MaxDuration: 600}
+	assert.NoError(suite.T(), valid.Validate())
+	assert.NoError(suite.T(), (&FlowEventStreamConfig{}).Validate())
+
+	for _, cfg := range []FlowEventStreamConfig{
+		{Enabled: true, PollInterval: 2},
+		{Enabled: true, HeartbeatInterval: 15},
+		{Enabled: true, HeartbeatInterval: 15, PollInterval: 2, MaxDuration: -1},
+	} {
+		assert.Error(suite.T(), cfg.Validate())
+	}
+}
+
+func (suite *ConfigTestSuite) TestRoleClaimsConfig_Validate() {
+	valid := RoleClaimsConfig{
+		ClaimName:    "roles",
+		MaxClaimSize: 4096,
+		Mappings:     []RoleScopeMapping{{Role: "admin", OUID: "ou1", Scopes: []string{"users:write"}}},
+	}
+	assert.NoError(suite.T(), valid.Validate())
+	assert.NoError(suite.T(), (&RoleClaimsConfig{}).Validate())
+
+	assert.Error(suite.T(), (&RoleClaimsConfig{MaxClaimSize: -1}).Validate())
+	assert.Error(suite.T(), (&RoleClaimsConfig{Mappings: []RoleScopeMapping{{Scopes: []string{"a"}}}}).Validate())
+	assert.Error(suite.T(), (&RoleClaimsConfig{Mappings: []RoleScopeMapping{{Role: "admin"}}}).Validate())
+
+	err := (&RoleClaimsConfig{Mappings: []RoleScopeMapping{{Role: "admin", Scopes: []string{"a b"}}}}).Validate()
+	assert.Error(suite.T(), err)
+	assert.Contains(suite.T(), err.Error(), "invalid scope")
+}
+
+func (suite *ConfigTestSuite) TestScopeCeilingsConfig_Validate() {
+	valid := ScopeCeilingsConfig{
+		Reject: true,
+		Rules: []ScopeCeilingRule{
+			{ACR: []string{"urn:acr:mfa"}, Scopes: []string{"*"}},
+			{AMR: []string{"pwd"}, Scopes: []string{"orders:read"}},
+			{Scopes: []string{}},
+		},
+	}
+	assert.NoError(suite.T(), valid.Validate())
+	assert.NoError(suite.T(), (&ScopeCeilingsConfig{}).Validate())
+
+	assert.Error(suite.T(), (&ScopeCeilingsConfig{Rules: []ScopeCeilingRule{{ACR: []string{" "}}}}).Validate())
+	err := (&ScopeCeilingsConfig{Rules: []ScopeCeilingRule{{AMR: []string{"pwd"}, Scopes: []string{"a b"}}}}).Validate()
+	assert.Error(suite.T(), err)
+	assert.Contains(suite.T(), err.Error(), "invalid scope")
+
+	err = (&ScopeCeilingsConfig{Rules: []ScopeCeilingRule{
+		{Scopes: []string{"a"}}, {ACR: []string{"urn:acr:mfa"}, Scopes: []string{"*"}},
+	}}).Validate()
+	assert.Error(suite.T(), err)
+	assert.Contains(suite.T(), err.Error(), "must be the last rule")
+}
+
+func (suite *ConfigTestSuite) TestShutdownConfig_Validate() {
+	assert.NoError(suite.T(), (&ShutdownConfig{DrainDelay: 5, DrainTimeout: 30}).Validate())
+	assert.NoError(suite.T(), (&ShutdownConfig{}).Validate())
+
+	assert.Error(suite.T(), (&ShutdownConfig{DrainDelay: -1}).Validate())
+	assert.Error(suite.T(), (&ShutdownConfig{DrainTimeout: -1}).Validate())
+}
+
+func (suite *ConfigTestSuite) TestAccessReviewConfig_Validate() {
+	assert.NoError(suite.T(), (&AccessReviewConfig{DefaultDuration: 1209600, ProcessInterval: 3600}).Validate())
+	assert.NoError(suite.T(), (&AccessReviewConfig{}).Validate())
+
+	assert.Error(suite.T(), (&AccessReviewConfig{DefaultDuration: -1}).Validate())
+	assert.Error(suite.T(), (&AccessReviewConfig{ProcessInterval: -1}).Validate())
+}
+
+func (suite *ConfigTestSuite) TestAdminNotificationConfig_Validate() {
+	assert.NoError(suite.T(), (&AdminNotificationConfig{Retention: 2592000, CleanupInterval: 3600}).Validate())
+	assert.NoError(suite.T(), (&AdminNotificationConfig{}).Validate())
+
+	assert.Error(suite.T(), (&AdminNotificationConfig{Retention: -1}).Validate())
+	assert.Error(suite.T(), (&AdminNotificationConfig{CleanupInterval: -1}).Validate())
+	assert.Error(suite.T(), (&AdminNotificationConfig{CertificateExpiryWarning: -1}).Validate())
+}
+
+func (suite *ConfigTestSuite) TestAuditConfig_Validate() {
+	assert.NoError(suite.T(), (&AuditConfig{Retention: 7776000, CleanupInterval: 3600}).Validate())
+	assert.NoError(suite.T(), (&AuditConfig{}).Validate())
+
+	assert.Error(suite.T(), (&AuditConfig{Retention: -1}).Validate())
+	assert.Error(suite.T(), (&AuditConfig{CleanupInterval: -1}).Validate())
+}
+
+func (suite *ConfigTestSuite) TestSharedSignalsConfig_Validate() {
+	valid := SharedSignalsConfig{Retention: 604800, RetryInterval: 60, PushTimeout: 5,
+		Receivers: []SharedSignalsReceiverConfig{
+			{ID: "push-receiver", Audience: "https://push.example.com", DeliveryMethod: "push",
+				EndpointURL: "https://push.example.com/events"},
+			{ID: "poll-receiver", Audience: "https://poll.example.com", DeliveryMethod: "poll",
+				ClientID: "poll-client"},
+		}}
+	assert.NoError(suite.T(), valid.Validate())
+	assert.NoError(suite.T(), (&SharedSignalsConfig{}).Validate())
+
+	assert.Error(suite.T(), (&SharedSignalsConfig{Retention: -1}).Validate())
+	assert.Error(suite.T(), (&SharedSignalsConfig{PushTimeout: -1}).Validate())
+
+	invalid := valid
+	invalid.Receivers = []SharedSignalsReceiverConfig{valid.Receivers[0], valid.Receivers[0]}
+	assert.Error(suite.T(), invalid.Validate())
+
+	for _, receiver := range []SharedSignalsReceiverConfig{
+		{Audience: "aud", DeliveryMethod: "poll", ClientID: "client"},
+		{ID: "r", DeliveryMethod: "poll", ClientID: "client"},
+		{ID: "r", Audience: "aud", DeliveryMethod: "poll"},
+		{ID: "r", Audience: "aud", DeliveryMethod: "push", EndpointURL: "ftp://example.com/events"},
+		{ID: "r", Audience: "aud", DeliveryMethod: "stream"},
+	} {
+		invalid.Receivers = []SharedSignalsReceiverConfig{receiver}
+		err := invalid.Validate()
+		assert.Error(suite.T(), err)
+		assert.Contains(suite.T(), err.Error(), "shared_signals.receivers")
+	}
+}
+
+func (suite *ConfigTestSuite) TestPasswordPolicyConfig_Validate() {
+	assert.NoError(suite.T(), (&PasswordPolicyConfig{BreachCheckTimeout: 5, HistoryDepth: 5}).Validate())
+	assert.NoError(suite.T(), (&PasswordPolicyConfig{}).Validate())
+
+	assert.Error(suite.T(), (&PasswordPolicyConfig{BreachCheckTimeout: -1}).Validate())
+	assert.Error(suite.T(), (&PasswordPolicyConfig{HistoryDepth: -1}).Validate())
+}
+
+func (suite *ConfigTestSuite) TestErrorFormatConfig_Validate() {
+	assert.NoError(suite.T(), (&ErrorFormatConfig{}).Validate())
+	assert.NoError(suite.T(), (&ErrorFormatConfig{Default: ErrorFormatLegacy}).Validate())
+	assert.NoError(suite.T(), (&ErrorFormatConfig{Default: ErrorFormatProblem}).Validate())
+
+	assert.Error(suite.T(), (&ErrorFormatConfig{Default: "xml"}).Validate())
+}
+
+func (suite *ConfigTestSuite) TestLegacyIssuerConfig_Validate() {
+	valid := LegacyIssuerConfig{
+		Issuer:        "https://legacy-idp.example.com",
+		JWKSURL:       "https://legacy-idp.example.com/jwks",
+		UserAttribute: "email",
+		APIs:          []string{"/users/**"},
+		Sunset:        "2027-01-01T00:00:00Z",
+	}
+	assert.NoError(suite.T(), valid.Validate())
+
+	localJWKS := valid
+	localJWKS.JWKSURL = "http://localhost:9000/jwks"
+	assert.NoError(suite.T(), localJWKS.Validate())
+
+	for _, mutate := range []func(c *LegacyIssuerConfig){
+		func(c *LegacyIssuerConfig) { c.Issuer = "" },
+		func(c *LegacyIssuerConfig) { c.JWKSURL = "" },
+		func(c *LegacyIssuerConfig) { c.JWKSURL = "http://legacy-idp.example.com/jwks" },
+		func(c *LegacyIssuerConfig) { c.UserAttribute = "" },
+		func(c *LegacyIssuerConfig) { c.APIs = nil },
+		func(c *LegacyIssuerConfig) { c.Sunset = "" },
+		func(c *LegacyIssuerConfig) { c.Sunset = "2027-01-01" },
+	} {
+		invalid := valid
+		mutate(&invalid)
+		assert.Error(suite.T(), invalid.Validate())
+	}
+}
+
+func (suite *ConfigTestSuite) TestSecurityConfig_Validate_LegacyIssuers() {
+	legacyIssuer := LegacyIssuerConfig{
+		Issuer:        "https://legacy-idp.example.com",
+		JWKSURL:       "https://legacy-idp.example.com/jwks",
+		UserAttribute: "email",
+		APIs:          []string{"/users/**"},
+		Sunset:        "2027-01-01T00:00:00Z",
+	}
+	assert.NoError(suite.T(), (&SecurityConfig{LegacyIssuers: []LegacyIssuerConfig{legacyIssuer}}).Validate())
+
+	err := (&SecurityConfig{LegacyIssuers: []LegacyIssuerConfig{legacyIssuer, legacyIssuer}}).Validate()
+	assert.Error(suite.T(), err)
+	assert.Contains(suite.T(), err.Error(), "more than once")
+
+	err = (&SecurityConfig{
+		TrustedIssuer: TrustedIssuerConfig{
+			Issuer: legacyIssuer.Issuer, JWKSURL: legacyIssuer.JWKSURL, Audience: "https://thunder.example.com",
+		},
+		LegacyIssuers: []LegacyIssuerConfig{legacyIssuer},
+	}).Validate()
+	assert.Error(suite.T(), err)
+	assert.Contains(suite.T(), err.Error(), "is the trusted issuer")
+}
+
 func (suite *ConfigTestSuite) TestSecurityConfig_Validate_DelegatesToTrustedIssuer() {
 	// A security config with a misconfigured trusted issuer must surface that error
 	// through SecurityConfig.Validate, since the parent is now the entry point.
@@ -1089,3 +1704,49 @@ func (suite *ConfigTestSuite) TestAuthClassValidate_EmptyAMRReference() {
 	assert.Error(suite.T(), err)
 	assert.Contains(suite.T(), err.Error(), "references an empty AMR key")
 }
+
+func (suite *ConfigTestSuite) TestTokenStoreValidate_EmptyConfig() {
+	cfg := TokenStoreConfig{}
+	assert.NoError(suite.T(), cfg.Validate())
+	assert.False(suite.T(), cfg.UsesRedis())
+}
+
+func (suite *ConfigTestSuite) TestTokenStoreValidate_SupportedTypes() {
+	cfg := TokenStoreConfig{
+		AuthorizationCode: "redis",
+		DeviceCode:        "database",
+		RefreshToken:      "redis",
+	}
+	assert.NoError(suite.T(), cfg.Validate())
+	assert.True(suite.T(), cfg.UsesRedis())
+}
+
+func (suite *ConfigTestSuite) TestTokenStoreValidate_UnsupportedType() {
+	cfg := TokenStoreConfig{PARRequest: "memcached"}
+	err := cfg.Validate()
+	assert.Error(suite.T(), err)
+	assert.Contains(suite.T(), err.Error(), "par_request")
+}
+
+func (suite *ConfigTestSuite) TestTokenStoreValidate_FlowExecution() {
+	cfg := TokenStoreConfig{FlowExecution: "redis", FlowExecutionMigration: true}
+	assert.NoError(suite.T(), cfg.Validate())
+	assert.True(suite.T(), cfg.UsesRedis())
+
+	err := (&TokenStoreConfig{FlowExecutionMigration: true}).Validate()
+	assert.Error(suite.T(), err)
+	assert.Contains(suite.T(), err.Error(), "flow_execution_migration")
+}
+
+func (suite *ConfigTestSuite) TestCacheWarmingConfig_Validate() {
+	assert.NoError(suite.T(), (&CacheWarmingConfig{}).Validate())
+	assert.NoError(suite.T(), (&CacheWarmingConfig{Enabled: true, Concurrency: 8, TimeBudget: 60}).Validate())
+
+	err := (&CacheWarmingConfig{Concurrency: -1}).Validate()
+	assert.Error(suite.T(), err)
+	assert.Contains(suite.T(), err.Error(), "concurrency")
+
+	err = (&CacheWarmingConfig{TimeBudget: -1}).Validate()
+	assert.Error(suite.T(), err)
+	assert.Contains(suite.T(), err.Error(), "time_budget")
+}
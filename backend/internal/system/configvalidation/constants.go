@@ -0,0 +1,50 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package configvalidation
+
+import "time"
+
+// Severity is the severity of a configuration validation finding.
+type Severity string
+
+const (
+	// SeverityError marks a finding that prevents the server from starting.
+	SeverityError Severity = "ERROR"
+	// SeverityWarning marks a finding that prevents the server from starting only in strict mode.
+	SeverityWarning Severity = "WARNING"
+)
+
+// Names of the configuration validation checks.
+const (
+	CheckConfig   = "config"
+	CheckDatabase = "database"
+	CheckCrypto   = "crypto"
+	CheckIssuer   = "issuer"
+	CheckFlow     = "flow"
+	CheckSMTP     = "smtp"
+)
+
+// Flow types of the flows referenced from the configuration.
+const (
+	FlowTypeAuthentication = "AUTHENTICATION"
+	FlowTypeUserOnboarding = "USER_ONBOARDING"
+)
+
+// defaultTimeout is the timeout of a connectivity check when no timeout is configured.
+const defaultTimeout = 5 * time.Second
@@ -0,0 +1,75 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package configvalidation
+
+// Finding is a problem found in the server configuration.
+type Finding struct {
+	Check       string   `json:"check"`
+	Severity    Severity `json:"severity"`
+	Field       string   `json:"field,omitempty"`
+	Message     string   `json:"message"`
+	Remediation string   `json:"remediation,omitempty"`
+}
+
+// Report is the result of a configuration validation. A report is valid when it has no errors and, in
+// strict mode, no warnings.
+type Report struct {
+	Valid    bool      `json:"valid"`
+	Strict   bool      `json:"strict"`
+	Errors   int       `json:"errors"`
+	Warnings int       `json:"warnings"`
+	Findings []Finding `json:"findings"`
+}
+
+// NewReport creates an empty report.
+func NewReport(strict bool) *Report {
+	return &Report{Valid: true, Strict: strict, Findings: []Finding{}}
+}
+
+// Add adds a finding to the report.
+func (r *Report) Add(finding Finding) {
+	r.Findings = append(r.Findings, finding)
+	switch finding.Severity {
+	case SeverityError:
+		r.Errors++
+	case SeverityWarning:
+		r.Warnings++
+	}
+	r.Valid = r.Errors == 0 && (!r.Strict || r.Warnings == 0)
+}
+
+// Merge adds the findings of another report to the report.
+func (r *Report) Merge(other *Report) {
+	if other == nil {
+		return
+	}
+	for _, finding := range other.Findings {
+		r.Add(finding)
+	}
+}
+
+// addError adds an error finding to the report.
+func (r *Report) addError(check, field, message, remediation string) {
+	r.Add(Finding{Check: check, Severity: SeverityError, Field: field, Message: message, Remediation: remediation})
+}
+
+// addWarning adds a warning finding to the report.
+func (r *Report) addWarning(check, field, message, remediation string) {
+	r.Add(Finding{Check: check, Severity: SeverityWarning, Field: field, Message: message, Remediation: remediation})
+}
@@ -0,0 +1,299 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package configvalidation validates the server configuration at startup and reports actionable findings.
+package configvalidation
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/cryptolab/hash"
+	"github.com/thunder-id/thunderid/internal/system/database/model"
+	dbprovider "github.com/thunder-id/thunderid/internal/system/database/provider"
+	"github.com/thunder-id/thunderid/internal/system/kmprovider/defaultkm"
+	"github.com/thunder-id/thunderid/internal/system/kmprovider/defaultkm/pkiservice"
+)
+
+// queryPing is the query used to verify database connectivity.
+var queryPing = model.DBQuery{
+	ID:    "CFV-00001",
+	Query: "SELECT 1",
+}
+
+// FlowLookup looks up the flows referenced from the configuration.
+type FlowLookup interface {
+	// FlowExists reports whether a flow with the given handle and flow type exists.
+	FlowExists(ctx context.Context, handle string, flowType string) (bool, error)
+}
+
+// validator runs the configuration validation checks.
+type validator struct {
+	cfg        *config.Config
+	timeout    time.Duration
+	dbProvider dbprovider.DBProviderInterface
+	dial       func(ctx context.Context, network, address string) (net.Conn, error)
+}
+
+// Validate validates the database connectivity, cryptographic material, issuer URL, and optionally the
+// SMTP server reachability of the configuration. The server runtime must be initialized with the
+// configuration before the validation.
+func Validate(ctx context.Context, cfg *config.Config) *Report {
+	return newValidator(cfg, dbprovider.GetDBProvider()).validate(ctx)
+}
+
+// ValidateFlowReferences validates that the flows referenced from the configuration exist. Missing flows
+// are reported as warnings since the default flows are created by the bootstrap scripts after the first
+// startup.
+func ValidateFlowReferences(ctx context.Context, cfg *config.Config, lookup FlowLookup) *Report {
+	report := NewReport(cfg.ConfigValidation.Strict)
+	checkFlowReference(ctx, report, lookup, "flow.default_auth_flow_handle",
+		cfg.Flow.DefaultAuthFlowHandle, FlowTypeAuthentication)
+	checkFlowReference(ctx, report, lookup, "flow.user_onboarding_flow_handle",
+		cfg.Flow.UserOnboardingFlowHandle, FlowTypeUserOnboarding)
+	return report
+}
+
+// newValidator creates a validator for the configuration.
+func newValidator(cfg *config.Config, dbProvider dbprovider.DBProviderInterface) *validator {
+	timeout := defaultTimeout
+	if cfg.ConfigValidation.Timeout > 0 {
+		timeout = time.Duration(cfg.ConfigValidation.Timeout) * time.Second
+	}
+	return &validator{
+		cfg:        cfg,
+		timeout:    timeout,
+		dbProvider: dbProvider,
+		dial:       (&net.Dialer{}).DialContext,
+	}
+}
+
+// validate runs the checks of the validator.
+func (v *validator) validate(ctx context.Context) *Report {
+	report := NewReport(v.cfg.ConfigValidation.Strict)
+	v.checkDatabases(ctx, report)
+	v.checkCrypto(report)
+	checkIssuer(report, v.cfg.JWT.Issuer)
+	v.checkSMTP(ctx, report)
+	return report
+}
+
+// checkDatabases verifies that the configured databases are reachable.
+func (v *validator) checkDatabases(ctx context.Context, report *Report) {
+	databases := v.cfg.Database
+	v.checkDatabase(ctx, report, "database.config", databases.Config, v.dbProvider.GetConfigDBClient)
+	if databases.Runtime.Type != dbprovider.DataSourceTypeRedis {
+		v.checkDatabase(ctx, report, "database.runtime", databases.Runtime, v.dbProvider.GetRuntimeDBClient)
+	}
+	v.checkDatabase(ctx, report, "database.user", databases.User, v.dbProvider.GetUserDBClient)
+
+	if databases.Runtime.Type == dbprovider.DataSourceTypeRedis || v.cfg.OAuth.TokenStore.UsesRedis() {
+		v.checkRedis(ctx, report, databases.Runtime.Redis)
+	}
+}
+
+// checkDatabase verifies that a SQL database is configured and reachable.
+func (v *validator) checkDatabase(ctx context.Context, report *Report, field string, dataSource config.DataSource,
+	getClient func() (dbprovider.DBClientInterface, error)) {
+	var remediation string
+	switch dataSource.Type {
+	case "postgres":
+		remediation = fmt.Sprintf("Verify that the PostgreSQL server at %s is reachable and that the database "+
+			"name, username, and password in %s.postgres are correct.",
+			net.JoinHostPort(dataSource.Postgres.Hostname, strconv.Itoa(dataSource.Postgres.Port)), field)
+	case "sqlite":
+		remediation = fmt.Sprintf("Verify that the SQLite database file %q exists and is readable and writable "+
+			"by the server.", dataSource.SQLite.Path)
+	case "":
+		report.addError(CheckDatabase, field+".type", "the database type is not configured",
+			fmt.Sprintf("Set %s.type to postgres or sqlite.", field))
+		return
+	default:
+		report.addError(CheckDatabase, field+".type",
+			fmt.Sprintf("the database type %q is not supported", dataSource.Type),
+			fmt.Sprintf("Set %s.type to postgres or sqlite.", field))
+		return
+	}
+
+	client, err := getClient()
+	if err == nil {
+		ctx, cancel := context.WithTimeout(ctx, v.timeout)
+		defer cancel()
+		_, err = client.QueryContext(ctx, queryPing)
+	}
+	if err != nil {
+		report.addError(CheckDatabase, field, fmt.Sprintf("cannot connect to the database: %v", err), remediation)
+	}
+}
+
+// checkRedis verifies that the configured Redis server is reachable.
+func (v *validator) checkRedis(ctx context.Context, report *Report, dataSource config.RedisDataSource) {
+	if dataSource.Address == "" {
+		report.addError(CheckDatabase, "database.runtime.redis.address", "the Redis address is not configured",
+			"Set database.runtime.redis.address to the host and port of the Redis server.")
+		return
+	}
+
+	client := redis.NewClient(&redis.Options{
+		Addr:        dataSource.Address,
+		Username:    dataSource.Username,
+		Password:    dataSource.Password,
+		DB:          dataSource.DB,
+		MaxRetries:  -1,
+		DialTimeout: v.timeout,
+	})
+	defer func() {
+		_ = client.Close()
+	}()
+
+	ctx, cancel := context.WithTimeout(ctx, v.timeout)
+	defer cancel()
+	if err := client.Ping(ctx).Err(); err != nil {
+		report.addError(CheckDatabase, "database.runtime.redis",
+			fmt.Sprintf("cannot connect to the Redis server at %s: %v", dataSource.Address, err),
+			"Verify that the Redis server is reachable and that the username, password, and database "+
+				"number in database.runtime.redis are correct.")
+	}
+}
+
+// checkCrypto verifies that the encryption key, signing keys, and password hashing algorithm are usable.
+func (v *validator) checkCrypto(report *Report) {
+	if _, err := defaultkm.InitConfigProvider(); err != nil {
+		report.addError(CheckCrypto, "crypto.encryption", fmt.Sprintf("the encryption key is invalid: %v", err),
+			"Set crypto.encryption.key and crypto.encryption.previous_keys to hex encoded 16, 24, or 32 byte "+
+				"AES keys.")
+	}
+
+	pkiService, err := pkiservice.Initialize()
+	switch {
+	case err != nil:
+		report.addError(CheckCrypto, "crypto.keys", fmt.Sprintf("the signing keys cannot be loaded: %v", err),
+			"Verify that each entry in crypto.keys has an ID and refers to a readable certificate and private key "+
+				"of a supported type: RSA, ECDSA P-256, P-384, or P-521, or Ed25519.")
+	case len(pkiService.GetSupportedSigningAlgorithms()) == 0:
+		report.addError(CheckCrypto, "crypto.keys", "no signing algorithm is available for the configured keys",
+			"Configure at least one RSA, ECDSA, or Ed25519 key in crypto.keys.")
+	}
+
+	preferredKeyID := v.cfg.JWT.PreferredKeyID
+	if preferredKeyID != "" && !slices.ContainsFunc(v.cfg.Crypto.Keys, func(key config.KeyConfig) bool {
+		return key.ID == preferredKeyID
+	}) {
+		report.addError(CheckCrypto, "jwt.preferred_key_id",
+			fmt.Sprintf("the preferred signing key %q is not defined in crypto.keys", preferredKeyID),
+			"Set jwt.preferred_key_id to the ID of a key in crypto.keys.")
+	}
+
+	algorithm := v.cfg.Crypto.PasswordHashing.Algorithm
+	switch hash.CredAlgorithm(strings.ToUpper(algorithm)) {
+	case hash.PBKDF2, hash.ARGON2ID:
+	case "", hash.SHA256:
+		report.addWarning(CheckCrypto, "crypto.password_hashing.algorithm",
+			"passwords are hashed with SHA256, which is not designed for password storage",
+			"Set crypto.password_hashing.algorithm to PBKDF2 or ARGON2ID.")
+	default:
+		report.addError(CheckCrypto, "crypto.password_hashing.algorithm",
+			fmt.Sprintf("the password hashing algorithm %q is not supported", algorithm),
+			"Set crypto.password_hashing.algorithm to PBKDF2, ARGON2ID, or SHA256.")
+	}
+}
+
+// checkIssuer verifies that the token issuer is a URL that relying parties can use.
+func checkIssuer(report *Report, issuer string) {
+	const field = "jwt.issuer"
+	const remediation = "Set jwt.issuer to the public HTTPS URL of the server, for example https://id.example.com."
+
+	issuerURL, err := url.Parse(issuer)
+	if err != nil || issuerURL.Host == "" || (issuerURL.Scheme != "https" && issuerURL.Scheme != "http") {
+		report.addError(CheckIssuer, field, fmt.Sprintf("the issuer %q is not an absolute HTTP or HTTPS URL", issuer),
+			remediation)
+		return
+	}
+	if issuerURL.RawQuery != "" || issuerURL.Fragment != "" {
+		report.addError(CheckIssuer, field, fmt.Sprintf("the issuer %q contains a query or fragment", issuer),
+			remediation)
+		return
+	}
+	if issuerURL.Scheme == "http" && !isLoopbackHost(issuerURL.Hostname()) {
+		report.addWarning(CheckIssuer, field, fmt.Sprintf("the issuer %q does not use HTTPS", issuer), remediation)
+	}
+	if strings.HasSuffix(issuerURL.Path, "/") {
+		report.addWarning(CheckIssuer, field, fmt.Sprintf("the issuer %q ends with a slash", issuer),
+			"Remove the trailing slash from jwt.issuer. Relying parties compare the issuer as an exact string.")
+	}
+}
+
+// isLoopbackHost reports whether a host name refers to the local machine.
+func isLoopbackHost(host string) bool {
+	if strings.EqualFold(host, "localhost") {
+		return true
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
+
+// checkSMTP verifies that the configured SMTP server accepts connections when the check is enabled.
+func (v *validator) checkSMTP(ctx context.Context, report *Report) {
+	smtp := v.cfg.Email.SMTP
+	if !v.cfg.ConfigValidation.CheckSMTP || smtp.Host == "" {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, v.timeout)
+	defer cancel()
+	address := net.JoinHostPort(smtp.Host, strconv.Itoa(smtp.Port))
+	conn, err := v.dial(ctx, "tcp", address)
+	if err != nil {
+		report.addWarning(CheckSMTP, "email.smtp",
+			fmt.Sprintf("the SMTP server at %s is not reachable: %v", address, err),
+			"Verify email.smtp.host and email.smtp.port, and that outbound connections to the SMTP server are "+
+				"allowed.")
+		return
+	}
+	_ = conn.Close()
+}
+
+// checkFlowReference verifies that a flow referenced from the configuration exists.
+func checkFlowReference(ctx context.Context, report *Report, lookup FlowLookup, field, handle, flowType string) {
+	flowTypeName := strings.ToLower(strings.ReplaceAll(flowType, "_", " "))
+	remediation := fmt.Sprintf("Set %s to the handle of an existing %s flow, or create a flow with the handle.",
+		field, flowTypeName)
+	if handle == "" {
+		report.addWarning(CheckFlow, field, "the flow handle is not configured", remediation)
+		return
+	}
+
+	exists, err := lookup.FlowExists(ctx, handle, flowType)
+	switch {
+	case err != nil:
+		report.addError(CheckFlow, field, fmt.Sprintf("cannot look up the flow %q: %v", handle, err),
+			"Verify that the configuration database and declarative flow resources are readable.")
+	case !exists:
+		report.addWarning(CheckFlow, field, fmt.Sprintf("the %s flow %q does not exist", flowTypeName, handle),
+			remediation)
+	}
+}
@@ -0,0 +1,353 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package configvalidation
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/tests/mocks/database/providermock"
+)
+
+const testEncryptionKey = "0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"
+
+type ValidatorTestSuite struct {
+	suite.Suite
+	serverHome string
+}
+
+func TestValidatorTestSuite(t *testing.T) {
+	suite.Run(t, new(ValidatorTestSuite))
+}
+
+func (s *ValidatorTestSuite) SetupTest() {
+	s.serverHome = s.T().TempDir()
+	writeSigningKey(s.T(), s.serverHome)
+}
+
+func (s *ValidatorTestSuite) TearDownTest() {
+	config.ResetServerRuntime()
+}
+
+// newConfig returns a configuration that passes the crypto and issuer checks.
+func (s *ValidatorTestSuite) newConfig() *config.Config {
+	return &config.Config{
+		JWT: config.JWTConfig{Issuer: "https://id.example.com", PreferredKeyID: "signing-key"},
+		Crypto: config.CryptoConfig{
+			Encryption:      config.EncryptionConfig{Key: testEncryptionKey},
+			PasswordHashing: config.PasswordHashingConfig{Algorithm: "PBKDF2"},
+			Keys: []config.KeyConfig{
+				{ID: "signing-key", CertFile: "signing.cert", KeyFile: "signing.key"},
+			},
+		},
+	}
+}
+
+// initRuntime initializes the server runtime with the configuration.
+func (s *ValidatorTestSuite) initRuntime(cfg *config.Config) {
+	config.ResetServerRuntime()
+	s.Require().NoError(config.InitializeServerRuntime(s.serverHome, cfg))
+}
+
+func (s *ValidatorTestSuite) TestReport_ValidityFollowsSeverityAndStrictMode() {
+	report := NewReport(false)
+	report.addWarning(CheckIssuer, "jwt.issuer", "warning", "")
+	s.True(report.Valid)
+	s.Equal(1, report.Warnings)
+
+	strictReport := NewReport(true)
+	strictReport.Merge(report)
+	s.False(strictReport.Valid)
+
+	report.addError(CheckCrypto, "crypto.keys", "error", "")
+	s.False(report.Valid)
+	s.Equal(1, report.Errors)
+	s.Len(report.Findings, 2)
+}
+
+func (s *ValidatorTestSuite) TestCheckIssuer() {
+	testCases := []struct {
+		name     string
+		issuer   string
+		severity Severity
+	}{
+		{"HTTPS", "https://id.example.com", ""},
+		{"HTTPLoopback", "http://localhost:8090", ""},
+		{"HTTPLoopbackIP", "http://127.0.0.1:8090", ""},
+		{"HTTPPublicHost", "http://id.example.com", SeverityWarning},
+		{"TrailingSlash", "https://id.example.com/", SeverityWarning},
+		{"RelativeURL", "id.example.com", SeverityError},
+		{"UnsupportedScheme", "ftp://id.example.com", SeverityError},
+		{"Query", "https://id.example.com?tenant=a", SeverityError},
+		{"Fragment", "https://id.example.com#a", SeverityError},
+		{"Empty", "", SeverityError},
+	}
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			report := NewReport(false)
+			checkIssuer(report, tc.issuer)
+			if tc.severity == "" {
+				s.Empty(report.Findings)
+				return
+			}
+			s.Require().Len(report.Findings, 1)
+			s.Equal(tc.severity, report.Findings[0].Severity)
+			s.Equal("jwt.issuer", report.Findings[0].Field)
+			s.NotEmpty(report.Findings[0].Remediation)
+		})
+	}
+}
+
+func (s *ValidatorTestSuite) TestCheckDatabases_Reachable() {
+	cfg := s.newConfig()
+	cfg.Database = config.DatabaseConfig{
+		Config:  config.DataSource{Type: "sqlite"},
+		Runtime: config.DataSource{Type: "sqlite"},
+		User:    config.DataSource{Type: "postgres"},
+	}
+	client := providermock.NewDBClientInterfaceMock(s.T())
+	client.On("QueryContext", mock.Anything, queryPing).Return([]map[string]interface{}{{"1": 1}}, nil).Times(3)
+	provider := providermock.NewDBProviderInterfaceMock(s.T())
+	provider.On("GetConfigDBClient").Return(client, nil)
+	provider.On("GetRuntimeDBClient").Return(client, nil)
+	provider.On("GetUserDBClient").Return(client, nil)
+
+	report := NewReport(false)
+	newValidator(cfg, provider).checkDatabases(context.Background(), report)
+
+	s.Empty(report.Findings)
+}
+
+func (s *ValidatorTestSuite) TestCheckDatabases_Failures() {
+	cfg := s.newConfig()
+	cfg.Database = config.DatabaseConfig{
+		Config: config.DataSource{Type: "postgres",
+			Postgres: config.PostgresDataSource{Hostname: "db.example.com", Port: 5432}},
+		Runtime: config.DataSource{Type: ""},
+		User:    config.DataSource{Type: "mysql"},
+	}
+	provider := providermock.NewDBProviderInterfaceMock(s.T())
+	provider.On("GetConfigDBClient").Return(nil, errors.New("connection refused"))
+
+	report := NewReport(false)
+	newValidator(cfg, provider).checkDatabases(context.Background(), report)
+
+	s.Require().Len(report.Findings, 3)
+	s.Equal("database.config", report.Findings[0].Field)
+	s.Contains(report.Findings[0].Message, "connection refused")
+	s.Contains(report.Findings[0].Remediation, "db.example.com:5432")
+	s.Equal("database.runtime.type", report.Findings[1].Field)
+	s.Equal("database.user.type", report.Findings[2].Field)
+	s.Equal(3, report.Errors)
+}
+
+func (s *ValidatorTestSuite) TestCheckDatabases_UnreachableRedis() {
+	cfg := s.newConfig()
+	cfg.ConfigValidation.Timeout = 1
+	cfg.Database = config.DatabaseConfig{
+		Config:  config.DataSource{Type: "sqlite"},
+		Runtime: config.DataSource{Type: "redis", Redis: config.RedisDataSource{Address: unusedAddress(s.T())}},
+		User:    config.DataSource{Type: "sqlite"},
+	}
+	client := providermock.NewDBClientInterfaceMock(s.T())
+	client.On("QueryContext", mock.Anything, queryPing).Return([]map[string]interface{}{}, nil).Times(2)
+	provider := providermock.NewDBProviderInterfaceMock(s.T())
+	provider.On("GetConfigDBClient").Return(client, nil)
+	provider.On("GetUserDBClient").Return(client, nil)
+
+	report := NewReport(false)
+	newValidator(cfg, provider).checkDatabases(context.Background(), report)
+
+	s.Require().Len(report.Findings, 1)
+	s.Equal("database.runtime.redis", report.Findings[0].Field)
+	s.Equal(SeverityError, report.Findings[0].Severity)
+}
+
+func (s *ValidatorTestSuite) TestCheckCrypto_Valid() {
+	cfg := s.newConfig()
+	s.initRuntime(cfg)
+
+	report := NewReport(false)
+	newValidator(cfg, nil).checkCrypto(report)
+
+	s.Empty(report.Findings)
+}
+
+func (s *ValidatorTestSuite) TestCheckCrypto_Invalid() {
+	cfg := s.newConfig()
+	cfg.JWT.PreferredKeyID = "missing-key"
+	cfg.Crypto.Encryption.Key = "not-hex"
+	cfg.Crypto.PasswordHashing.Algorithm = "md5"
+	cfg.Crypto.Keys[0].KeyFile = "missing.key"
+	s.initRuntime(cfg)
+
+	report := NewReport(false)
+	newValidator(cfg, nil).checkCrypto(report)
+
+	fields := make([]string, 0, len(report.Findings))
+	for _, finding := range report.Findings {
+		s.Equal(SeverityError, finding.Severity)
+		fields = append(fields, finding.Field)
+	}
+	s.Equal([]string{"crypto.encryption", "crypto.keys", "jwt.preferred_key_id",
+		"crypto.password_hashing.algorithm"}, fields)
+}
+
+func (s *ValidatorTestSuite) TestCheckCrypto_SHA256PasswordHashingWarning() {
+	cfg := s.newConfig()
+	cfg.Crypto.PasswordHashing.Algorithm = "sha256"
+	s.initRuntime(cfg)
+
+	report := NewReport(false)
+	newValidator(cfg, nil).checkCrypto(report)
+
+	s.Require().Len(report.Findings, 1)
+	s.Equal(SeverityWarning, report.Findings[0].Severity)
+	s.Equal("crypto.password_hashing.algorithm", report.Findings[0].Field)
+}
+
+func (s *ValidatorTestSuite) TestCheckSMTP() {
+	cfg := s.newConfig()
+	cfg.Email.SMTP = config.SMTPEmailConfig{Host: "smtp.example.com", Port: 587}
+	dialed := ""
+	v := newValidator(cfg, nil)
+	v.dial = func(_ context.Context, _, address string) (net.Conn, error) {
+		dialed = address
+		return nil, errors.New("i/o timeout")
+	}
+
+	report := NewReport(false)
+	v.checkSMTP(context.Background(), report)
+	s.Empty(report.Findings, "the SMTP check is disabled by default")
+	s.Empty(dialed)
+
+	cfg.ConfigValidation.CheckSMTP = true
+	v.checkSMTP(context.Background(), report)
+	s.Equal("smtp.example.com:587", dialed)
+	s.Require().Len(report.Findings, 1)
+	s.Equal(SeverityWarning, report.Findings[0].Severity)
+	s.Contains(report.Findings[0].Message, "i/o timeout")
+}
+
+func (s *ValidatorTestSuite) TestCheckSMTP_Reachable() {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	s.Require().NoError(err)
+	defer func() {
+		_ = listener.Close()
+	}()
+	addr := listener.Addr().(*net.TCPAddr)
+
+	cfg := s.newConfig()
+	cfg.ConfigValidation.CheckSMTP = true
+	cfg.Email.SMTP = config.SMTPEmailConfig{Host: "127.0.0.1", Port: addr.Port}
+
+	report := NewReport(false)
+	newValidator(cfg, nil).checkSMTP(context.Background(), report)
+
+	s.Empty(report.Findings)
+}
+
+func (s *ValidatorTestSuite) TestValidateFlowReferences() {
+	cfg := s.newConfig()
+	cfg.ConfigValidation.Strict = true
+	cfg.Flow = config.FlowConfig{
+		DefaultAuthFlowHandle:    "default-basic-flow",
+		UserOnboardingFlowHandle: "default-user-onboarding",
+	}
+	lookup := NewFlowLookupMock(s.T())
+	lookup.On("FlowExists", mock.Anything, "default-basic-flow", FlowTypeAuthentication).Return(true, nil)
+	lookup.On("FlowExists", mock.Anything, "default-user-onboarding", FlowTypeUserOnboarding).Return(false, nil)
+
+	report := ValidateFlowReferences(context.Background(), cfg, lookup)
+
+	s.Require().Len(report.Findings, 1)
+	s.Equal("flow.user_onboarding_flow_handle", report.Findings[0].Field)
+	s.Equal(SeverityWarning, report.Findings[0].Severity)
+	s.Equal(0, report.Errors)
+	s.False(report.Valid, "warnings fail the validation in strict mode")
+}
+
+func (s *ValidatorTestSuite) TestValidateFlowReferences_LookupErrorAndMissingHandle() {
+	cfg := s.newConfig()
+	cfg.Flow = config.FlowConfig{DefaultAuthFlowHandle: "default-basic-flow"}
+	lookup := NewFlowLookupMock(s.T())
+	lookup.On("FlowExists", mock.Anything, "default-basic-flow", FlowTypeAuthentication).
+		Return(false, errors.New("database is locked"))
+
+	report := ValidateFlowReferences(context.Background(), cfg, lookup)
+
+	s.Require().Len(report.Findings, 2)
+	s.Equal(SeverityError, report.Findings[0].Severity)
+	s.Contains(report.Findings[0].Message, "database is locked")
+	s.Equal(SeverityWarning, report.Findings[1].Severity)
+	s.Equal("flow.user_onboarding_flow_handle", report.Findings[1].Field)
+}
+
+// unusedAddress returns a loopback address on which nothing is listening.
+func unusedAddress(t *testing.T) string {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to listen: %v", err)
+	}
+	address := listener.Addr().String()
+	_ = listener.Close()
+	return address
+}
+
+// writeSigningKey writes a self-signed RSA certificate and key to signing.cert and signing.key in dir.
+func writeSigningKey(t *testing.T, dir string) {
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "test"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	certDER, err := x509.CreateCertificate(rand.Reader, template, template, &privateKey.PublicKey, privateKey)
+	if err != nil {
+		t.Fatalf("failed to create certificate: %v", err)
+	}
+
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certDER})
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(privateKey)})
+	if err := os.WriteFile(filepath.Join(dir, "signing.cert"), certPEM, 0o600); err != nil {
+		t.Fatalf("failed to write certificate: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "signing.key"), keyPEM, 0o600); err != nil {
+		t.Fatalf("failed to write key: %v", err)
+	}
+}
@@ -47,9 +47,18 @@ const AuthSchemeBearer = "Bearer "
 // ContentTypeJSON is the content type for JSON data.
 const ContentTypeJSON = "application/json"
 
+// ContentTypeYAML is the content type for YAML data.
+const ContentTypeYAML = "application/yaml"
+
+// ContentTypeProblemJSON is the content type for RFC 9457 problem details.
+const ContentTypeProblemJSON = "application/problem+json"
+
 // ContentTypeJWT is the content type for JWT data.
 const ContentTypeJWT = "application/jwt"
 
+// ContentTypeHTML is the content type for HTML documents.
+const ContentTypeHTML = "text/html; charset=utf-8"
+
 // ContentTypeFormURLEncoded is the content type for form-urlencoded data.
 const ContentTypeFormURLEncoded = "application/x-www-form-urlencoded"
 
@@ -0,0 +1,71 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package context
+
+import (
+	"context"
+	"crypto/x509"
+)
+
+const (
+	// ClientIPKey is the context key for storing the IP address of the client that originated a request.
+	ClientIPKey contextKey = "client_ip"
+	// ClientCertificateKey is the context key for storing the TLS client certificate of a request.
+	ClientCertificateKey contextKey = "client_certificate"
+)
+
+// ============================================================================
+// Client Functions
+// ============================================================================
+
+// WithClientIP adds the IP address of the originating client to the context.
+func WithClientIP(ctx context.Context, clientIP string) context.Context {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	return context.WithValue(ctx, ClientIPKey, clientIP)
+}
+
+// GetClientIP retrieves the IP address of the originating client from the context.
+// Returns an empty string when the client IP has not been resolved.
+func GetClientIP(ctx context.Context) string {
+	if ctx == nil {
+		return ""
+	}
+	clientIP, _ := ctx.Value(ClientIPKey).(string)
+	return clientIP
+}
+
+// WithClientCertificate adds the TLS client certificate presented by the client to the context.
+func WithClientCertificate(ctx context.Context, cert *x509.Certificate) context.Context {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	return context.WithValue(ctx, ClientCertificateKey, cert)
+}
+
+// GetClientCertificate retrieves the TLS client certificate from the context.
+// Returns nil when the client did not present a certificate.
+func GetClientCertificate(ctx context.Context) *x509.Certificate {
+	if ctx == nil {
+		return nil
+	}
+	cert, _ := ctx.Value(ClientCertificateKey).(*x509.Certificate)
+	return cert
+}
@@ -20,6 +20,7 @@ package context
 
 import (
 	"context"
+	"crypto/x509"
 	"testing"
 
 	"github.com/stretchr/testify/suite"
@@ -256,3 +257,34 @@ func (s *ContextTestSuite) TestGenerateUUID_MultipleGenerations() {
 		seen[uuid] = true
 	}
 }
+
+func (suite *ContextTestSuite) TestWithTenantID() {
+	ctx := WithTenantID(context.Background(), "tenant-1")
+
+	suite.Equal("tenant-1", GetTenantID(ctx))
+	suite.Equal("", GetTenantID(context.Background()))
+	suite.Equal("", GetTenantID(nil)) //nolint:staticcheck // Testing nil context handling
+}
+
+func (suite *ContextTestSuite) TestScopeDeploymentID() {
+	suite.Equal("deployment-1", ScopeDeploymentID(context.Background(), "deployment-1"))
+	suite.Equal("deployment-1/tenant-1",
+		ScopeDeploymentID(WithTenantID(context.Background(), "tenant-1"), "deployment-1"))
+}
+
+func (suite *ContextTestSuite) TestWithClientIP() {
+	ctx := WithClientIP(context.Background(), "192.0.2.10")
+
+	suite.Equal("192.0.2.10", GetClientIP(ctx))
+	suite.Equal("", GetClientIP(context.Background()))
+	suite.Equal("", GetClientIP(nil)) //nolint:staticcheck // Testing nil context handling
+}
+
+func (suite *ContextTestSuite) TestWithClientCertificate() {
+	cert := &x509.Certificate{}
+	ctx := WithClientCertificate(context.Background(), cert)
+
+	suite.Same(cert, GetClientCertificate(ctx))
+	suite.Nil(GetClientCertificate(context.Background()))
+	suite.Nil(GetClientCertificate(nil)) //nolint:staticcheck // Testing nil context handling
+}
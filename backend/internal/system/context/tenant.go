@@ -0,0 +1,62 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package context
+
+import (
+	"context"
+)
+
+// TenantIDKey is the context key for storing the ID of the tenant a request is scoped to.
+const TenantIDKey contextKey = "tenant_id"
+
+// tenantDeploymentIDSeparator separates the deployment ID and the tenant ID in a tenant-scoped deployment ID.
+const tenantDeploymentIDSeparator = "/"
+
+// ============================================================================
+// Tenant Functions
+// ============================================================================
+
+// WithTenantID scopes the context to the given tenant.
+func WithTenantID(ctx context.Context, tenantID string) context.Context {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	return context.WithValue(ctx, TenantIDKey, tenantID)
+}
+
+// GetTenantID retrieves the tenant ID from the context.
+// Returns an empty string when the context is not scoped to a tenant.
+func GetTenantID(ctx context.Context) string {
+	if ctx == nil {
+		return ""
+	}
+	tenantID, _ := ctx.Value(TenantIDKey).(string)
+	return tenantID
+}
+
+// ScopeDeploymentID returns the deployment ID stores should filter by for the given context.
+// Tenant data is partitioned through the same DEPLOYMENT_ID column that isolates deployments, so
+// a tenant-scoped context yields "<deploymentID>/<tenantID>" and an unscoped context yields the
+// deployment ID unchanged.
+func ScopeDeploymentID(ctx context.Context, deploymentID string) string {
+	tenantID := GetTenantID(ctx)
+	if tenantID == "" {
+		return deploymentID
+	}
+	return deploymentID + tenantDeploymentIDSeparator + tenantID
+}
@@ -217,3 +217,14 @@ func (suite *CompilerTestSuite) TestUnmarshalYAMLUnsupportedNodeRejected() {
 	err := yaml.Unmarshal(doc, &entries)
 	suite.Require().Error(err)
 }
+
+func (suite *CompilerTestSuite) TestOriginEntriesStrings() {
+	doc := []byte(`
+- https://example.com
+- regex: '^https://.+\.staging\.example\.com$'
+`)
+	var entries OriginEntries
+	suite.Require().NoError(yaml.Unmarshal(doc, &entries))
+	assert.Equal(suite.T(), []string{"https://example.com", `regex:^https://.+\.staging\.example\.com$`},
+		entries.Strings())
+}
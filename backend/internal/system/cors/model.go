@@ -56,3 +56,19 @@ func (regexEntry) isOriginEntry() {}
 // decoding. Custom YAML unmarshaling on this type dispatches between the two
 // entry forms.
 type OriginEntries []entry
+
+// Strings renders the entries in their configured order for display and
+// comparison. A literal entry renders as its value and a regex entry as its
+// pattern prefixed with "regex:".
+func (e OriginEntries) Strings() []string {
+	values := make([]string, 0, len(e))
+	for _, item := range e {
+		switch v := item.(type) {
+		case literalEntry:
+			values = append(values, v.Value)
+		case regexEntry:
+			values = append(values, "regex:"+v.Pattern)
+		}
+	}
+	return values
+}
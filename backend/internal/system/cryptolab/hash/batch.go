@@ -0,0 +1,126 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package hash
+
+import (
+	"context"
+	"runtime"
+	"sync"
+	"sync/atomic"
+)
+
+// batchSlots bounds the number of batch hashing workers running across the whole process.
+// Every batch job draws from the same pool, so concurrent bulk jobs queue behind each other
+// instead of multiplying CPU usage, and at least one core is always left for interactive hashing.
+var batchSlots = make(chan struct{}, defaultBatchSlots())
+
+// BatchResult holds the outcome of hashing a single batch item.
+type BatchResult struct {
+	Credential Credential
+	Err        error
+}
+
+// BatchConcurrencyLimit returns the process-wide limit on concurrent batch hashing workers.
+func BatchConcurrencyLimit() int {
+	return cap(batchSlots)
+}
+
+// GenerateBatch hashes values with a bounded worker pool and returns one result per value, in
+// input order. Workers acquire a slot from the process-wide pool before each item and release it
+// afterwards, so a large job yields to other jobs between items. Per-item failures are reported in
+// the corresponding BatchResult; the returned error is non-nil only when ctx is done before every
+// item has been hashed.
+func GenerateBatch(ctx context.Context, hasher HashServiceInterface, values [][]byte) ([]BatchResult, error) {
+	results := make([]BatchResult, len(values))
+	if len(values) == 0 {
+		return results, nil
+	}
+
+	workers := BatchConcurrencyLimit()
+	if workers > len(values) {
+		workers = len(values)
+	}
+
+	// The work queue holds at most one pending item per worker; the producer blocks until a worker
+	// is free, so a job never buffers more work than it can run.
+	jobs := make(chan int, workers)
+	var completed atomic.Int64
+	var wg sync.WaitGroup
+
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for idx := range jobs {
+				if !acquireBatchSlot(ctx) {
+					continue
+				}
+				cred, err := hasher.Generate(values[idx])
+				releaseBatchSlot()
+				results[idx] = BatchResult{Credential: cred, Err: err}
+				completed.Add(1)
+			}
+		}()
+	}
+
+feed:
+	for idx := range values {
+		select {
+		case jobs <- idx:
+		case <-ctx.Done():
+			break feed
+		}
+	}
+	close(jobs)
+	wg.Wait()
+
+	if err := ctx.Err(); err != nil && completed.Load() < int64(len(values)) {
+		return results, err
+	}
+	return results, nil
+}
+
+// acquireBatchSlot blocks until a process-wide batch slot is available or ctx is done.
+func acquireBatchSlot(ctx context.Context) bool {
+	select {
+	case <-ctx.Done():
+		return false
+	default:
+	}
+	select {
+	case batchSlots <- struct{}{}:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+// releaseBatchSlot returns a slot to the process-wide batch pool.
+func releaseBatchSlot() {
+	<-batchSlots
+}
+
+// defaultBatchSlots leaves one core free for interactive requests on multi-core hosts.
+func defaultBatchSlots() int {
+	procs := runtime.GOMAXPROCS(0)
+	if procs > 1 {
+		return procs - 1
+	}
+	return 1
+}
@@ -0,0 +1,135 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package hash
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type BatchHashTestSuite struct {
+	suite.Suite
+}
+
+func TestBatchHashTestSuite(t *testing.T) {
+	suite.Run(t, new(BatchHashTestSuite))
+}
+
+// stubHasher records concurrency and optionally fails for a given input.
+type stubHasher struct {
+	active    int32
+	maxActive int32
+	delay     time.Duration
+	failOn    string
+}
+
+func (h *stubHasher) Generate(credentialValue []byte) (Credential, error) {
+	cur := atomic.AddInt32(&h.active, 1)
+	defer atomic.AddInt32(&h.active, -1)
+	for {
+		prev := atomic.LoadInt32(&h.maxActive)
+		if cur <= prev || atomic.CompareAndSwapInt32(&h.maxActive, prev, cur) {
+			break
+		}
+	}
+	time.Sleep(h.delay)
+	if string(credentialValue) == h.failOn {
+		return Credential{}, errors.New("hash failed")
+	}
+	return Credential{Algorithm: SHA256, Hash: "h:" + string(credentialValue)}, nil
+}
+
+func (h *stubHasher) Verify(credentialValueToVerify []byte, referenceCredential Credential) (bool, error) {
+	return referenceCredential.Hash == "h:"+string(credentialValueToVerify), nil
+}
+
+func (h *stubHasher) NeedsUpgrade(referenceCredential Credential) bool {
+	return false
+}
+
+func (h *stubHasher) GetFormatPolicy(algorithm CredAlgorithm) FormatPolicy {
+	return FormatPolicy{Algorithm: algorithm}
+}
+
+func batchValues(n int) [][]byte {
+	values := make([][]byte, n)
+	for i := range values {
+		values[i] = []byte{byte('a' + i%26), byte('0' + i/26)}
+	}
+	return values
+}
+
+func (suite *BatchHashTestSuite) TestGenerateBatch_PreservesOrderAndVerifies() {
+	hasher, err := newHashService(HashConfig{Algorithm: SHA256, SaltSize: defaultSaltSize})
+	suite.Require().NoError(err)
+	values := batchValues(20)
+
+	results, err := GenerateBatch(context.Background(), hasher, values)
+
+	suite.Require().NoError(err)
+	suite.Require().Len(results, len(values))
+	for i, res := range results {
+		suite.Require().NoError(res.Err)
+		ok, verifyErr := hasher.Verify(values[i], res.Credential)
+		suite.Require().NoError(verifyErr)
+		suite.True(ok)
+	}
+}
+
+func (suite *BatchHashTestSuite) TestGenerateBatch_Empty() {
+	results, err := GenerateBatch(context.Background(), &stubHasher{}, nil)
+
+	suite.NoError(err)
+	suite.Empty(results)
+}
+
+func (suite *BatchHashTestSuite) TestGenerateBatch_RespectsProcessLimit() {
+	hasher := &stubHasher{delay: 2 * time.Millisecond}
+
+	_, err := GenerateBatch(context.Background(), hasher, batchValues(40))
+
+	suite.NoError(err)
+	suite.LessOrEqual(int(atomic.LoadInt32(&hasher.maxActive)), BatchConcurrencyLimit())
+}
+
+func (suite *BatchHashTestSuite) TestGenerateBatch_ReportsItemErrors() {
+	hasher := &stubHasher{failOn: "c0"}
+
+	results, err := GenerateBatch(context.Background(), hasher, batchValues(5))
+
+	suite.Require().NoError(err)
+	suite.Error(results[2].Err)
+	suite.Equal("h:a0", results[0].Credential.Hash)
+	suite.NoError(results[4].Err)
+}
+
+func (suite *BatchHashTestSuite) TestGenerateBatch_CancelledContext() {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	results, err := GenerateBatch(ctx, &stubHasher{}, batchValues(3))
+
+	suite.ErrorIs(err, context.Canceled)
+	suite.Len(results, 3)
+}
@@ -0,0 +1,185 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package hash
+
+import (
+	"crypto/md5" //nolint:gosec // G501 - MD5 is required to verify legacy md5-crypt credentials
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+const (
+	md5CryptPrefix      = "$1$"
+	md5CryptMaxSaltSize = 8
+	md5CryptRounds      = 1000
+	cryptAlphabet       = "./0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"
+)
+
+// ErrCredentialFormatRetired is returned by Verify when the stored credential format has passed its
+// sunset date and verification of the format is blocked.
+var ErrCredentialFormatRetired = errors.New("credential format has passed its sunset date")
+
+// LegacyVerifier verifies credentials stored in a format that is no longer used to generate new hashes.
+// Legacy credentials carry the complete encoded hash, including any salt and cost, in Credential.Hash.
+type LegacyVerifier interface {
+	Verify(credentialValueToVerify []byte, referenceCredential Credential) (bool, error)
+}
+
+var (
+	legacyVerifiersMu sync.RWMutex
+	legacyVerifiers   = map[CredAlgorithm]LegacyVerifier{
+		BCRYPT:   bcryptVerifier{},
+		MD5CRYPT: md5CryptVerifier{},
+	}
+)
+
+// RegisterLegacyVerifier registers a verifier for a legacy credential format, replacing any verifier
+// registered for the same format. It must be called before Initialize, and the format must also be
+// listed in HashConfig.LegacyFormats to be accepted.
+func RegisterLegacyVerifier(algorithm CredAlgorithm, verifier LegacyVerifier) error {
+	if algorithm == "" || verifier == nil {
+		return errors.New("legacy verifier algorithm and implementation must be provided")
+	}
+	if isGeneratedAlgorithm(algorithm) {
+		return fmt.Errorf("cannot register a legacy verifier for %s", algorithm)
+	}
+	legacyVerifiersMu.Lock()
+	defer legacyVerifiersMu.Unlock()
+	legacyVerifiers[algorithm] = verifier
+	return nil
+}
+
+// getLegacyVerifier returns the verifier registered for the given legacy format.
+func getLegacyVerifier(algorithm CredAlgorithm) (LegacyVerifier, bool) {
+	legacyVerifiersMu.RLock()
+	defer legacyVerifiersMu.RUnlock()
+	verifier, ok := legacyVerifiers[algorithm]
+	return verifier, ok
+}
+
+// bcryptVerifier verifies bcrypt credentials in the modular crypt format ($2a$, $2b$, $2y$).
+type bcryptVerifier struct{}
+
+func (bcryptVerifier) Verify(credentialValueToVerify []byte, referenceCredential Credential) (bool, error) {
+	if err := validateCredentialAlgorithm(referenceCredential, BCRYPT); err != nil {
+		return false, err
+	}
+	err := bcrypt.CompareHashAndPassword([]byte(referenceCredential.Hash), credentialValueToVerify)
+	if err == nil {
+		return true, nil
+	}
+	if errors.Is(err, bcrypt.ErrMismatchedHashAndPassword) {
+		return false, nil
+	}
+	return false, err
+}
+
+// md5CryptVerifier verifies credentials in the MD5-based crypt format ($1$salt$hash).
+type md5CryptVerifier struct{}
+
+func (md5CryptVerifier) Verify(credentialValueToVerify []byte, referenceCredential Credential) (bool, error) {
+	if err := validateCredentialAlgorithm(referenceCredential, MD5CRYPT); err != nil {
+		return false, err
+	}
+	encoded := referenceCredential.Hash
+	if !strings.HasPrefix(encoded, md5CryptPrefix) {
+		return false, errors.New("invalid md5-crypt hash")
+	}
+	salt, _, found := strings.Cut(strings.TrimPrefix(encoded, md5CryptPrefix), "$")
+	if !found {
+		return false, errors.New("invalid md5-crypt hash")
+	}
+	computed := md5Crypt(credentialValueToVerify, []byte(salt))
+	return subtle.ConstantTimeCompare([]byte(computed), []byte(encoded)) == 1, nil
+}
+
+// md5Crypt computes the md5-crypt encoding of the password with the given salt.
+//
+//nolint:gosec // G401 - MD5 is required to verify legacy md5-crypt credentials
+func md5Crypt(password, salt []byte) string {
+	if len(salt) > md5CryptMaxSaltSize {
+		salt = salt[:md5CryptMaxSaltSize]
+	}
+
+	alternate := md5.New()
+	alternate.Write(password)
+	alternate.Write(salt)
+	alternate.Write(password)
+	alternateSum := alternate.Sum(nil)
+
+	digest := md5.New()
+	digest.Write(password)
+	digest.Write([]byte(md5CryptPrefix))
+	digest.Write(salt)
+	for i := len(password); i > 0; i -= md5.Size {
+		digest.Write(alternateSum[:min(i, md5.Size)])
+	}
+	for i := len(password); i > 0; i >>= 1 {
+		if i&1 == 1 {
+			digest.Write([]byte{0})
+		} else {
+			digest.Write(password[:1])
+		}
+	}
+	final := digest.Sum(nil)
+
+	for i := 0; i < md5CryptRounds; i++ {
+		round := md5.New()
+		if i&1 == 1 {
+			round.Write(password)
+		} else {
+			round.Write(final)
+		}
+		if i%3 != 0 {
+			round.Write(salt)
+		}
+		if i%7 != 0 {
+			round.Write(password)
+		}
+		if i&1 == 1 {
+			round.Write(final)
+		} else {
+			round.Write(password)
+		}
+		final = round.Sum(nil)
+	}
+
+	var sb strings.Builder
+	sb.WriteString(md5CryptPrefix)
+	sb.Write(salt)
+	sb.WriteByte('$')
+	for _, group := range [][3]int{{0, 6, 12}, {1, 7, 13}, {2, 8, 14}, {3, 9, 15}, {4, 10, 5}} {
+		writeCryptBase64(&sb, uint(final[group[0]])<<16|uint(final[group[1]])<<8|uint(final[group[2]]), 4)
+	}
+	writeCryptBase64(&sb, uint(final[11]), 2)
+	return sb.String()
+}
+
+// writeCryptBase64 writes the n least significant 6-bit groups of value using the crypt alphabet.
+func writeCryptBase64(sb *strings.Builder, value uint, n int) {
+	for ; n > 0; n-- {
+		sb.WriteByte(cryptAlphabet[value&0x3f])
+		value >>= 6
+	}
+}
@@ -0,0 +1,191 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package hash
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"golang.org/x/crypto/bcrypt"
+)
+
+const (
+	testMD5CryptPassword = "password"
+	testMD5CryptHash     = "$1$saltsalt$qjXMvbEw8oaL.CzflDtaK/"
+	testCustomAlgorithm  = CredAlgorithm("TEST_PLAIN")
+)
+
+type LegacyVerifierTestSuite struct {
+	suite.Suite
+}
+
+func TestLegacyVerifierTestSuite(t *testing.T) {
+	suite.Run(t, new(LegacyVerifierTestSuite))
+}
+
+// plainVerifier is a test plugin that compares credentials in plain text.
+type plainVerifier struct{}
+
+func (plainVerifier) Verify(credentialValueToVerify []byte, referenceCredential Credential) (bool, error) {
+	return string(credentialValueToVerify) == referenceCredential.Hash, nil
+}
+
+func (suite *LegacyVerifierTestSuite) newService(formats ...LegacyFormatConfig) *hashService {
+	svc, err := newHashService(HashConfig{
+		Algorithm:       SHA256,
+		SaltSize:        defaultSaltSize,
+		LegacyFormats:   formats,
+		UpgradeOnVerify: true,
+	})
+	suite.Require().NoError(err)
+	return svc.(*hashService)
+}
+
+func (suite *LegacyVerifierTestSuite) TestMD5Crypt() {
+	suite.Equal(testMD5CryptHash, md5Crypt([]byte(testMD5CryptPassword), []byte("saltsalt")))
+	suite.Equal("$1$ab$rn6aQS/o7141mj179E/zA.", md5Crypt([]byte(""), []byte("ab")))
+}
+
+func (suite *LegacyVerifierTestSuite) TestVerify_MD5Crypt() {
+	svc := suite.newService(LegacyFormatConfig{Algorithm: MD5CRYPT})
+	ref := Credential{Algorithm: MD5CRYPT, Hash: testMD5CryptHash}
+
+	ok, err := svc.Verify([]byte(testMD5CryptPassword), ref)
+	suite.NoError(err)
+	suite.True(ok)
+
+	ok, err = svc.Verify([]byte("wrong"), ref)
+	suite.NoError(err)
+	suite.False(ok)
+
+	_, err = svc.Verify([]byte(testMD5CryptPassword), Credential{Algorithm: MD5CRYPT, Hash: "invalid"})
+	suite.Error(err)
+}
+
+func (suite *LegacyVerifierTestSuite) TestVerify_Bcrypt() {
+	encoded, err := bcrypt.GenerateFromPassword([]byte("secret"), bcrypt.MinCost)
+	suite.Require().NoError(err)
+	svc := suite.newService(LegacyFormatConfig{Algorithm: BCRYPT})
+	ref := Credential{Algorithm: BCRYPT, Hash: string(encoded)}
+
+	ok, err := svc.Verify([]byte("secret"), ref)
+	suite.NoError(err)
+	suite.True(ok)
+
+	ok, err = svc.Verify([]byte("wrong"), ref)
+	suite.NoError(err)
+	suite.False(ok)
+}
+
+func (suite *LegacyVerifierTestSuite) TestVerify_LegacyFormatNotListed() {
+	svc := suite.newService()
+
+	ok, err := svc.Verify([]byte(testMD5CryptPassword), Credential{Algorithm: MD5CRYPT, Hash: testMD5CryptHash})
+	suite.Error(err)
+	suite.False(ok)
+}
+
+func (suite *LegacyVerifierTestSuite) TestVerify_OtherGeneratedAlgorithm() {
+	pbkdf2Service, err := newHashService(HashConfig{
+		Algorithm: PBKDF2, SaltSize: defaultSaltSize, Iterations: 1000, KeySize: defaultPBKDF2KeySize,
+	})
+	suite.Require().NoError(err)
+	ref, err := pbkdf2Service.Generate([]byte("secret"))
+	suite.Require().NoError(err)
+
+	ok, err := suite.newService().Verify([]byte("secret"), ref)
+	suite.NoError(err)
+	suite.True(ok)
+}
+
+func (suite *LegacyVerifierTestSuite) TestVerify_BlockedAfterSunset() {
+	sunset := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	svc := suite.newService(LegacyFormatConfig{Algorithm: MD5CRYPT, SunsetDate: sunset, BlockAfterSunset: true})
+	ref := Credential{Algorithm: MD5CRYPT, Hash: testMD5CryptHash}
+
+	svc.now = func() time.Time { return sunset.Add(-time.Hour) }
+	ok, err := svc.Verify([]byte(testMD5CryptPassword), ref)
+	suite.NoError(err)
+	suite.True(ok)
+
+	svc.now = func() time.Time { return sunset }
+	ok, err = svc.Verify([]byte(testMD5CryptPassword), ref)
+	suite.ErrorIs(err, ErrCredentialFormatRetired)
+	suite.False(ok)
+}
+
+func (suite *LegacyVerifierTestSuite) TestVerify_SunsetWithoutBlock() {
+	svc := suite.newService(LegacyFormatConfig{Algorithm: MD5CRYPT, SunsetDate: time.Unix(0, 0)})
+
+	ok, err := svc.Verify([]byte(testMD5CryptPassword), Credential{Algorithm: MD5CRYPT, Hash: testMD5CryptHash})
+	suite.NoError(err)
+	suite.True(ok)
+}
+
+func (suite *LegacyVerifierTestSuite) TestNeedsUpgrade() {
+	svc := suite.newService(LegacyFormatConfig{Algorithm: MD5CRYPT})
+	suite.True(svc.NeedsUpgrade(Credential{Algorithm: MD5CRYPT}))
+	suite.False(svc.NeedsUpgrade(Credential{Algorithm: SHA256}))
+
+	svc.upgradeOnVerify = false
+	suite.False(svc.NeedsUpgrade(Credential{Algorithm: MD5CRYPT}))
+}
+
+func (suite *LegacyVerifierTestSuite) TestGetFormatPolicy() {
+	sunset := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	svc := suite.newService(LegacyFormatConfig{Algorithm: BCRYPT, SunsetDate: sunset, BlockAfterSunset: true})
+	svc.now = func() time.Time { return sunset.Add(time.Hour) }
+
+	suite.Equal(FormatPolicy{Algorithm: SHA256, Current: true, Supported: true}, svc.GetFormatPolicy(SHA256))
+	suite.Equal(FormatPolicy{Algorithm: PBKDF2, Supported: true}, svc.GetFormatPolicy(PBKDF2))
+	suite.Equal(FormatPolicy{Algorithm: BCRYPT, Supported: true, SunsetDate: sunset, Blocked: true},
+		svc.GetFormatPolicy(BCRYPT))
+	suite.Equal(FormatPolicy{Algorithm: MD5CRYPT}, svc.GetFormatPolicy(MD5CRYPT))
+}
+
+func (suite *LegacyVerifierTestSuite) TestNewHashService_InvalidLegacyFormats() {
+	_, err := newHashService(HashConfig{
+		Algorithm: SHA256, SaltSize: defaultSaltSize, LegacyFormats: []LegacyFormatConfig{{Algorithm: SHA256}},
+	})
+	suite.Error(err)
+
+	_, err = newHashService(HashConfig{
+		Algorithm: SHA256, SaltSize: defaultSaltSize, LegacyFormats: []LegacyFormatConfig{{Algorithm: "UNKNOWN"}},
+	})
+	suite.Error(err)
+}
+
+func (suite *LegacyVerifierTestSuite) TestRegisterLegacyVerifier() {
+	suite.Error(RegisterLegacyVerifier(PBKDF2, plainVerifier{}))
+	suite.Error(RegisterLegacyVerifier("", plainVerifier{}))
+	suite.Error(RegisterLegacyVerifier(testCustomAlgorithm, nil))
+
+	suite.Require().NoError(RegisterLegacyVerifier(testCustomAlgorithm, plainVerifier{}))
+	defer func() {
+		legacyVerifiersMu.Lock()
+		delete(legacyVerifiers, testCustomAlgorithm)
+		legacyVerifiersMu.Unlock()
+	}()
+
+	svc := suite.newService(LegacyFormatConfig{Algorithm: testCustomAlgorithm})
+	ok, err := svc.Verify([]byte("secret"), Credential{Algorithm: testCustomAlgorithm, Hash: "secret"})
+	suite.NoError(err)
+	suite.True(ok)
+}
@@ -18,6 +18,8 @@
 
 package hash
 
+import "time"
+
 // CredAlgorithm represents the supported credential hashing algorithms.
 type CredAlgorithm string
 
@@ -28,6 +30,10 @@ const (
 	PBKDF2 CredAlgorithm = "PBKDF2"
 	// ARGON2ID represents the Argon2id key derivation function.
 	ARGON2ID CredAlgorithm = "ARGON2ID"
+	// BCRYPT represents the bcrypt password hashing format. It is supported for verification only.
+	BCRYPT CredAlgorithm = "BCRYPT"
+	// MD5CRYPT represents the MD5-based crypt format ($1$). It is supported for verification only.
+	MD5CRYPT CredAlgorithm = "MD5_CRYPT"
 )
 
 // CredParameters holds the parameters for credential hashing algorithms.
@@ -68,4 +74,33 @@ type HashConfig struct {
 	KeySize     int
 	Memory      int
 	Parallelism int
+	// LegacyFormats lists the stored credential formats accepted for verification besides the configured
+	// algorithm, together with their deprecation schedule. Verify-only formats such as BCRYPT must be
+	// listed here to be accepted.
+	LegacyFormats []LegacyFormatConfig
+	// UpgradeOnVerify reports credentials stored in a format other than the configured algorithm as due
+	// for upgrade, so that callers can re-hash them after a successful verification.
+	UpgradeOnVerify bool
+}
+
+// LegacyFormatConfig holds the deprecation schedule of a stored credential format.
+type LegacyFormatConfig struct {
+	Algorithm CredAlgorithm
+	// SunsetDate is the time after which the format is considered retired. Zero means no sunset.
+	SunsetDate time.Time
+	// BlockAfterSunset refuses verification of credentials in the format once the sunset date has passed.
+	BlockAfterSunset bool
+}
+
+// FormatPolicy describes how the hash service treats credentials stored in a given format.
+type FormatPolicy struct {
+	Algorithm CredAlgorithm
+	// Current reports whether new credentials are generated in this format.
+	Current bool
+	// Supported reports whether credentials stored in this format can be verified.
+	Supported bool
+	// SunsetDate is the configured sunset date of the format, or zero when none is set.
+	SunsetDate time.Time
+	// Blocked reports whether verification is refused because the sunset date has passed.
+	Blocked bool
 }
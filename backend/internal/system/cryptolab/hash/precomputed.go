@@ -0,0 +1,91 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package hash
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"sync"
+)
+
+// precomputedContextKey is the context key of the credentials hashed ahead of time.
+type precomputedContextKey struct{}
+
+// precomputedCredentials holds credentials hashed ahead of time, grouped by the handle of the value they
+// hash. A handle is an HMAC of the value under a key that lives only as long as the credentials, so the
+// plaintext values are never held.
+type precomputedCredentials struct {
+	mu       sync.Mutex
+	key      []byte
+	byHandle map[string][]Credential
+}
+
+// handle returns the opaque handle of a value.
+func (p *precomputedCredentials) handle(value []byte) string {
+	mac := hmac.New(sha256.New, p.key)
+	mac.Write(value)
+	return string(mac.Sum(nil))
+}
+
+// WithPrecomputedCredentials returns a context carrying the credentials that GenerateBatch hashed for the
+// given values, so that the code creating the resources they belong to stores them instead of hashing the
+// same values again one at a time. Values that failed to hash are left out. Credentials already carried by
+// the context and not yet taken are kept.
+func WithPrecomputedCredentials(ctx context.Context, values [][]byte, results []BatchResult) context.Context {
+	precomputed := &precomputedCredentials{byHandle: make(map[string][]Credential, len(values))}
+	if existing, ok := ctx.Value(precomputedContextKey{}).(*precomputedCredentials); ok {
+		existing.mu.Lock()
+		precomputed.key = existing.key
+		for handle, credentials := range existing.byHandle {
+			precomputed.byHandle[handle] = append([]Credential(nil), credentials...)
+		}
+		existing.mu.Unlock()
+	} else {
+		precomputed.key = make([]byte, sha256.Size)
+		_, _ = rand.Read(precomputed.key)
+	}
+	for i, value := range values {
+		if i < len(results) && results[i].Err == nil {
+			handle := precomputed.handle(value)
+			precomputed.byHandle[handle] = append(precomputed.byHandle[handle], results[i].Credential)
+		}
+	}
+	return context.WithValue(ctx, precomputedContextKey{}, precomputed)
+}
+
+// TakePrecomputedCredential removes and returns a credential hashed ahead of time for a value carried by
+// the context. Each precomputed credential is handed out once, so resources sharing a value never share
+// a salt.
+func TakePrecomputedCredential(ctx context.Context, value []byte) (Credential, bool) {
+	precomputed, ok := ctx.Value(precomputedContextKey{}).(*precomputedCredentials)
+	if !ok {
+		return Credential{}, false
+	}
+	handle := precomputed.handle(value)
+	precomputed.mu.Lock()
+	defer precomputed.mu.Unlock()
+	credentials := precomputed.byHandle[handle]
+	if len(credentials) == 0 {
+		return Credential{}, false
+	}
+	precomputed.byHandle[handle] = credentials[1:]
+	return credentials[0], true
+}
@@ -0,0 +1,91 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package hash
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTakePrecomputedCredential(t *testing.T) {
+	values := [][]byte{[]byte("first"), []byte("second")}
+	results := []BatchResult{
+		{Credential: Credential{Algorithm: PBKDF2, Hash: "first-hash"}},
+		{Err: errors.New("hash failed")},
+	}
+
+	ctx := WithPrecomputedCredentials(context.Background(), values, results)
+
+	credential, ok := TakePrecomputedCredential(ctx, []byte("first"))
+	assert.True(t, ok)
+	assert.Equal(t, "first-hash", credential.Hash)
+	_, ok = TakePrecomputedCredential(ctx, []byte("first"))
+	assert.False(t, ok)
+	_, ok = TakePrecomputedCredential(ctx, []byte("second"))
+	assert.False(t, ok)
+	_, ok = TakePrecomputedCredential(context.Background(), []byte("first"))
+	assert.False(t, ok)
+}
+
+func TestTakePrecomputedCredential_SameValueHandsOutEachCredentialOnce(t *testing.T) {
+	values := [][]byte{[]byte("shared"), []byte("shared")}
+	results := []BatchResult{
+		{Credential: Credential{Hash: "hash-1"}},
+		{Credential: Credential{Hash: "hash-2"}},
+	}
+
+	ctx := WithPrecomputedCredentials(context.Background(), values, results)
+
+	first, ok := TakePrecomputedCredential(ctx, []byte("shared"))
+	assert.True(t, ok)
+	second, ok := TakePrecomputedCredential(ctx, []byte("shared"))
+	assert.True(t, ok)
+	assert.Equal(t, []string{"hash-1", "hash-2"}, []string{first.Hash, second.Hash})
+	_, ok = TakePrecomputedCredential(ctx, []byte("shared"))
+	assert.False(t, ok)
+}
+
+func TestWithPrecomputedCredentials_KeepsEarlierCredentials(t *testing.T) {
+	ctx := WithPrecomputedCredentials(context.Background(), [][]byte{[]byte("first")},
+		[]BatchResult{{Credential: Credential{Hash: "first-hash"}}})
+	ctx = WithPrecomputedCredentials(ctx, [][]byte{[]byte("second")},
+		[]BatchResult{{Credential: Credential{Hash: "second-hash"}}})
+
+	first, ok := TakePrecomputedCredential(ctx, []byte("first"))
+	assert.True(t, ok)
+	assert.Equal(t, "first-hash", first.Hash)
+	second, ok := TakePrecomputedCredential(ctx, []byte("second"))
+	assert.True(t, ok)
+	assert.Equal(t, "second-hash", second.Hash)
+}
+
+func TestWithPrecomputedCredentials_DoesNotHoldPlaintext(t *testing.T) {
+	ctx := WithPrecomputedCredentials(context.Background(), [][]byte{[]byte("secret-password")},
+		[]BatchResult{{Credential: Credential{Hash: "hash"}}})
+
+	precomputed := ctx.Value(precomputedContextKey{}).(*precomputedCredentials)
+	for handle := range precomputed.byHandle {
+		assert.NotContains(t, handle, "secret-password")
+	}
+	_, ok := TakePrecomputedCredential(ctx, []byte("secret-password"))
+	assert.True(t, ok)
+}
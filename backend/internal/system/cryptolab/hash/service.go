@@ -27,6 +27,7 @@ import (
 	"crypto/subtle"
 	"encoding/hex"
 	"fmt"
+	"time"
 
 	"golang.org/x/crypto/argon2"
 )
@@ -40,6 +41,24 @@ const (
 type HashServiceInterface interface {
 	Generate(credentialValue []byte) (Credential, error)
 	Verify(credentialValueToVerify []byte, referenceCredential Credential) (bool, error)
+	NeedsUpgrade(referenceCredential Credential) bool
+	GetFormatPolicy(algorithm CredAlgorithm) FormatPolicy
+}
+
+// credentialHasher generates and verifies credentials of a single algorithm.
+type credentialHasher interface {
+	Generate(credentialValue []byte) (Credential, error)
+	Verify(credentialValueToVerify []byte, referenceCredential Credential) (bool, error)
+}
+
+// hashService generates credentials with the configured algorithm and verifies credentials stored in
+// the configured algorithm or in any accepted legacy format.
+type hashService struct {
+	algorithm       CredAlgorithm
+	hasher          credentialHasher
+	legacyFormats   map[CredAlgorithm]LegacyFormatConfig
+	upgradeOnVerify bool
+	now             func() time.Time
 }
 
 type sha256HashProvider struct {
@@ -61,6 +80,107 @@ type argon2idHashProvider struct {
 }
 
 func newHashService(cfg HashConfig) (HashServiceInterface, error) {
+	hasher, err := newCredentialHasher(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	legacyFormats := make(map[CredAlgorithm]LegacyFormatConfig, len(cfg.LegacyFormats))
+	for _, format := range cfg.LegacyFormats {
+		if format.Algorithm == cfg.Algorithm {
+			return nil, fmt.Errorf("legacy credential format %s is the configured algorithm", format.Algorithm)
+		}
+		if !isGeneratedAlgorithm(format.Algorithm) {
+			if _, ok := getLegacyVerifier(format.Algorithm); !ok {
+				return nil, fmt.Errorf("no verifier registered for legacy credential format %s", format.Algorithm)
+			}
+		}
+		legacyFormats[format.Algorithm] = format
+	}
+
+	return &hashService{
+		algorithm:       cfg.Algorithm,
+		hasher:          hasher,
+		legacyFormats:   legacyFormats,
+		upgradeOnVerify: cfg.UpgradeOnVerify,
+		now:             time.Now,
+	}, nil
+}
+
+// Generate hashes the credential value with the configured algorithm.
+func (s *hashService) Generate(credentialValue []byte) (Credential, error) {
+	return s.hasher.Generate(credentialValue)
+}
+
+// Verify checks the credential value against a reference credential stored in the configured algorithm
+// or in an accepted legacy format. Credentials in a format whose sunset date has passed are refused with
+// ErrCredentialFormatRetired when the format is configured to block after sunset.
+func (s *hashService) Verify(credentialValueToVerify []byte, referenceCredential Credential) (bool, error) {
+	if referenceCredential.Algorithm == s.algorithm {
+		return s.hasher.Verify(credentialValueToVerify, referenceCredential)
+	}
+	verifier, err := s.getFormatVerifier(referenceCredential.Algorithm)
+	if err != nil {
+		return false, err
+	}
+	if s.isBlocked(referenceCredential.Algorithm) {
+		return false, ErrCredentialFormatRetired
+	}
+	return verifier.Verify(credentialValueToVerify, referenceCredential)
+}
+
+// NeedsUpgrade reports whether the reference credential should be re-hashed with the configured
+// algorithm after it has been verified.
+func (s *hashService) NeedsUpgrade(referenceCredential Credential) bool {
+	return s.upgradeOnVerify && referenceCredential.Algorithm != s.algorithm
+}
+
+// GetFormatPolicy describes how credentials stored in the given format are treated.
+func (s *hashService) GetFormatPolicy(algorithm CredAlgorithm) FormatPolicy {
+	policy := FormatPolicy{
+		Algorithm: algorithm,
+		Current:   algorithm == s.algorithm,
+	}
+	if _, err := s.getFormatVerifier(algorithm); err == nil || policy.Current {
+		policy.Supported = true
+	}
+	if format, ok := s.legacyFormats[algorithm]; ok {
+		policy.SunsetDate = format.SunsetDate
+		policy.Blocked = s.isBlocked(algorithm)
+	}
+	return policy
+}
+
+// getFormatVerifier returns a verifier for a format other than the configured algorithm. Formats this
+// package generates are always verifiable; verify-only formats must be listed as legacy formats.
+func (s *hashService) getFormatVerifier(algorithm CredAlgorithm) (LegacyVerifier, error) {
+	switch algorithm {
+	case SHA256:
+		return &sha256HashProvider{}, nil
+	case PBKDF2:
+		return &pbkdf2HashProvider{}, nil
+	case ARGON2ID:
+		return &argon2idHashProvider{}, nil
+	}
+	if _, listed := s.legacyFormats[algorithm]; listed {
+		if verifier, ok := getLegacyVerifier(algorithm); ok {
+			return verifier, nil
+		}
+	}
+	return nil, fmt.Errorf("unsupported credential algorithm: %s", algorithm)
+}
+
+// isBlocked reports whether verification of the format is refused because its sunset date has passed.
+func (s *hashService) isBlocked(algorithm CredAlgorithm) bool {
+	format, ok := s.legacyFormats[algorithm]
+	if !ok || !format.BlockAfterSunset || format.SunsetDate.IsZero() {
+		return false
+	}
+	return !s.now().Before(format.SunsetDate)
+}
+
+// newCredentialHasher returns the hasher of the configured algorithm.
+func newCredentialHasher(cfg HashConfig) (credentialHasher, error) {
 	switch cfg.Algorithm {
 	case SHA256:
 		if err := validatePositiveInt(cfg.SaltSize, "salt size"); err != nil {
@@ -271,6 +391,16 @@ func (a *argon2idHashProvider) Verify(credentialValueToVerify []byte, referenceC
 	return subtle.ConstantTimeCompare(h, referenceHash) == 1, nil
 }
 
+// isGeneratedAlgorithm reports whether this package can generate credentials of the algorithm.
+func isGeneratedAlgorithm(algorithm CredAlgorithm) bool {
+	switch algorithm {
+	case SHA256, PBKDF2, ARGON2ID:
+		return true
+	default:
+		return false
+	}
+}
+
 func generateSalt(saltSize int) ([]byte, error) {
 	salt := make([]byte, saltSize)
 	if _, err := rand.Read(salt); err != nil {
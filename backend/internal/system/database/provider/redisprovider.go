@@ -59,9 +59,12 @@ var (
 // initRedisProvider initializes the singleton Redis provider.
 func initRedisProvider() {
 	redisOnce.Do(func() {
-		cfg := config.GetServerRuntime().Config.Database.Runtime
-		// This is a no-op when runtime.type is not "redis".
-		if cfg.Type != DataSourceTypeRedis {
+		serverCfg := config.GetServerRuntime().Config
+		cfg := serverCfg.Database.Runtime
+		// This is a no-op when neither the runtime store, any OAuth token store nor the distributed lock
+		// store uses Redis.
+		if cfg.Type != DataSourceTypeRedis && !serverCfg.OAuth.TokenStore.UsesRedis() &&
+			serverCfg.DistributedLock.Store != DataSourceTypeRedis {
 			return
 		}
 
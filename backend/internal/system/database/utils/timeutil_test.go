@@ -0,0 +1,73 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package utils
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type TimeUtilTestSuite struct {
+	suite.Suite
+}
+
+func TestTimeUtilSuite(t *testing.T) {
+	suite.Run(t, new(TimeUtilTestSuite))
+}
+
+func (suite *TimeUtilTestSuite) TestParseTimeField_SQLiteString() {
+	parsed, err := ParseTimeField("2026-03-04 05:06:07.123456789", "created_at")
+
+	suite.NoError(err)
+	suite.Equal(time.Date(2026, 3, 4, 5, 6, 7, 123456789, time.UTC), parsed)
+}
+
+func (suite *TimeUtilTestSuite) TestParseTimeField_SQLiteStringWithZone() {
+	parsed, err := ParseTimeField("2026-03-04 05:06:07 +0000 UTC", "created_at")
+
+	suite.NoError(err)
+	suite.Equal(time.Date(2026, 3, 4, 5, 6, 7, 0, time.UTC), parsed)
+}
+
+func (suite *TimeUtilTestSuite) TestParseTimeField_RFC3339String() {
+	parsed, err := ParseTimeField("2026-03-04T05:06:07+02:00", "created_at")
+
+	suite.NoError(err)
+	suite.True(parsed.Equal(time.Date(2026, 3, 4, 3, 6, 7, 0, time.UTC)))
+}
+
+func (suite *TimeUtilTestSuite) TestParseTimeField_Time() {
+	now := time.Now()
+	parsed, err := ParseTimeField(now, "created_at")
+
+	suite.NoError(err)
+	suite.Equal(now, parsed)
+}
+
+func (suite *TimeUtilTestSuite) TestParseTimeField_Errors() {
+	_, err := ParseTimeField("not a time", "created_at")
+	suite.ErrorContains(err, "error parsing created_at")
+
+	_, err = ParseTimeField(nil, "created_at")
+	suite.EqualError(err, "created_at is nil")
+
+	_, err = ParseTimeField(42, "created_at")
+	suite.EqualError(err, "unexpected type for created_at: int")
+}
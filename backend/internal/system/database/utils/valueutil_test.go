@@ -0,0 +1,39 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type ValueUtilTestSuite struct {
+	suite.Suite
+}
+
+func TestValueUtilSuite(t *testing.T) {
+	suite.Run(t, new(ValueUtilTestSuite))
+}
+
+func (suite *ValueUtilTestSuite) TestParseStringOrBytes() {
+	suite.Equal("value", ParseStringOrBytes("value"))
+	suite.Equal("value", ParseStringOrBytes([]byte("value")))
+	suite.Equal("", ParseStringOrBytes(nil))
+	suite.Equal("", ParseStringOrBytes(42))
+}
@@ -0,0 +1,45 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package distlock
+
+import "time"
+
+const (
+	loggerComponentName = "DistributedLock"
+
+	// storeTypeDatabase and storeTypeRedis are the supported lock stores.
+	storeTypeDatabase = "database"
+	storeTypeRedis    = "redis"
+
+	// defaultLeaseTTL applies when no lease TTL is configured.
+	defaultLeaseTTL = 30 * time.Second
+	// defaultRetryInterval applies when no retry interval is configured.
+	defaultRetryInterval = 500 * time.Millisecond
+	// renewalsPerLease is the number of times a held lock is renewed within one lease TTL.
+	renewalsPerLease = 3
+	// releaseTimeout bounds the time spent releasing a lock.
+	releaseTimeout = 5 * time.Second
+)
+
+// Outcomes of a lock acquisition attempt recorded in the metrics.
+const (
+	acquireResultAcquired  = "acquired"
+	acquireResultContended = "contended"
+	acquireResultError     = "error"
+)
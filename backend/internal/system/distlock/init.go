@@ -0,0 +1,87 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package distlock
+
+import (
+	"os"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/database/provider"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/internal/system/utils"
+)
+
+// Initialize creates the lock manager with the configured lock store. Each call identifies a distinct
+// lock owner, so a node should share the returned manager between its features.
+func Initialize() (LockManagerInterface, error) {
+	cfg := config.GetServerRuntime().Config
+	lockConfig := cfg.DistributedLock
+	deploymentID := cfg.Server.Identifier
+
+	var store lockStoreInterface
+	if resolveStoreType(cfg) == storeTypeRedis {
+		store = newRedisLockStore(provider.GetRedisProvider(), deploymentID)
+	} else {
+		store = newLockStore(deploymentID)
+	}
+
+	owner, err := newOwnerID()
+	if err != nil {
+		return nil, err
+	}
+
+	leaseTTL := defaultLeaseTTL
+	if lockConfig.LeaseTTL > 0 {
+		leaseTTL = time.Duration(lockConfig.LeaseTTL) * time.Second
+	}
+	retryInterval := defaultRetryInterval
+	if lockConfig.RetryIntervalMS > 0 {
+		retryInterval = time.Duration(lockConfig.RetryIntervalMS) * time.Millisecond
+	}
+
+	log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName)).Debug(
+		"Initialized distributed lock manager", log.String("owner", owner))
+	return newLockManager(store, owner, leaseTTL, retryInterval), nil
+}
+
+// resolveStoreType returns the configured lock store, falling back to the runtime database type.
+func resolveStoreType(cfg config.Config) string {
+	if cfg.DistributedLock.Store != "" {
+		return cfg.DistributedLock.Store
+	}
+	if cfg.Database.Runtime.Type == provider.DataSourceTypeRedis {
+		return storeTypeRedis
+	}
+	return storeTypeDatabase
+}
+
+// newOwnerID returns an identifier that is unique to this lock manager, prefixed with the host name to
+// make lock holders recognizable.
+func newOwnerID() (string, error) {
+	id, err := utils.GenerateUUIDv7()
+	if err != nil {
+		return "", err
+	}
+	hostname, err := os.Hostname()
+	if err != nil || hostname == "" {
+		return id, nil
+	}
+	return hostname + "/" + id, nil
+}
@@ -0,0 +1,97 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package distlock
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/database/provider"
+)
+
+type InitTestSuite struct {
+	suite.Suite
+}
+
+func TestInitTestSuite(t *testing.T) {
+	suite.Run(t, new(InitTestSuite))
+}
+
+func (s *InitTestSuite) TearDownTest() {
+	config.ResetServerRuntime()
+}
+
+func (s *InitTestSuite) TestResolveStoreType() {
+	cfg := config.Config{}
+	cfg.Database.Runtime.Type = "sqlite"
+	s.Equal(storeTypeDatabase, resolveStoreType(cfg))
+
+	cfg.Database.Runtime.Type = provider.DataSourceTypeRedis
+	s.Equal(storeTypeRedis, resolveStoreType(cfg))
+
+	cfg.DistributedLock.Store = storeTypeDatabase
+	s.Equal(storeTypeDatabase, resolveStoreType(cfg))
+}
+
+func (s *InitTestSuite) TestInitialize_DatabaseStoreWithDefaults() {
+	testConfig := &config.Config{}
+	testConfig.Server.Identifier = testDeploymentID
+	testConfig.Database.Runtime.Type = "sqlite"
+	s.Require().NoError(config.InitializeServerRuntime("", testConfig))
+
+	manager, err := Initialize()
+
+	s.Require().NoError(err)
+	impl, ok := manager.(*lockManager)
+	s.Require().True(ok)
+	store, ok := impl.store.(*lockStore)
+	s.Require().True(ok)
+	s.Equal(testDeploymentID, store.deploymentID)
+	s.Equal(defaultLeaseTTL, impl.leaseTTL)
+	s.Equal(defaultRetryInterval, impl.retryInterval)
+	s.NotEmpty(impl.owner)
+}
+
+func (s *InitTestSuite) TestInitialize_ConfiguredTimings() {
+	testConfig := &config.Config{}
+	testConfig.Database.Runtime.Type = "sqlite"
+	testConfig.DistributedLock = config.DistributedLockConfig{LeaseTTL: 10, RetryIntervalMS: 100}
+	s.Require().NoError(config.InitializeServerRuntime("", testConfig))
+
+	manager, err := Initialize()
+
+	s.Require().NoError(err)
+	impl := manager.(*lockManager)
+	s.Equal(10*time.Second, impl.leaseTTL)
+	s.Equal(100*time.Millisecond, impl.retryInterval)
+}
+
+func (s *InitTestSuite) TestNewOwnerID_Unique() {
+	first, err := newOwnerID()
+	s.Require().NoError(err)
+	second, err := newOwnerID()
+	s.Require().NoError(err)
+
+	s.NotEqual(first, second)
+	s.False(strings.HasSuffix(first, "/"))
+}
@@ -0,0 +1,140 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package distlock
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/system/log"
+)
+
+// Lock is a held distributed lock. Its lease is renewed in the background until the lock is released or
+// the context it was acquired with is done. Work done under the lock should use Context, which is
+// cancelled with ErrLockLost when the lease cannot be renewed, and should pass the fencing token to the
+// resources it updates so that they can reject writes from an earlier holder.
+type Lock struct {
+	name         string
+	owner        string
+	fencingToken int64
+	ttl          time.Duration
+	store        lockStoreInterface
+	ctx          context.Context
+	cancel       context.CancelCauseFunc
+	stopRenewal  chan struct{}
+	renewalDone  chan struct{}
+	releaseOnce  sync.Once
+	logger       *log.Logger
+}
+
+// newLock creates a held lock and starts renewing its lease.
+func newLock(
+	ctx context.Context, name, owner string, fencingToken int64, ttl time.Duration, store lockStoreInterface,
+	logger *log.Logger,
+) *Lock {
+	lockCtx, cancel := context.WithCancelCause(ctx)
+	lock := &Lock{
+		name:         name,
+		owner:        owner,
+		fencingToken: fencingToken,
+		ttl:          ttl,
+		store:        store,
+		ctx:          lockCtx,
+		cancel:       cancel,
+		stopRenewal:  make(chan struct{}),
+		renewalDone:  make(chan struct{}),
+		logger:       logger,
+	}
+	go lock.renew()
+	return lock
+}
+
+// Name returns the name of the lock.
+func (l *Lock) Name() string {
+	return l.name
+}
+
+// FencingToken returns the fencing token of the lease. Each acquisition of a lock receives a higher
+// token than the previous one.
+func (l *Lock) FencingToken() int64 {
+	return l.fencingToken
+}
+
+// Context returns a context that is cancelled when the lock is released or lost. context.Cause returns
+// ErrLockLost when the lease could not be renewed.
+func (l *Lock) Context() context.Context {
+	return l.ctx
+}
+
+// Release stops renewing the lease and releases the lock so that other nodes can acquire it. Calling
+// Release more than once has no further effect.
+func (l *Lock) Release() error {
+	var err error
+	l.releaseOnce.Do(func() {
+		close(l.stopRenewal)
+		<-l.renewalDone
+		l.cancel(context.Canceled)
+
+		ctx, cancel := context.WithTimeout(context.Background(), releaseTimeout)
+		defer cancel()
+		err = l.store.Release(ctx, l.name, l.owner, l.fencingToken)
+	})
+	return err
+}
+
+// renew extends the lease at a fraction of its TTL. Failed renewals are retried until the lease would
+// have expired, after which the lock is treated as lost.
+func (l *Lock) renew() {
+	defer close(l.renewalDone)
+
+	interval := l.ttl / renewalsPerLease
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	lastRenewal := time.Now()
+
+	for {
+		select {
+		case <-l.stopRenewal:
+			return
+		case <-l.ctx.Done():
+			return
+		case <-ticker.C:
+			ctx, cancel := context.WithTimeout(context.Background(), interval)
+			renewed, err := l.store.Renew(ctx, l.name, l.owner, l.fencingToken, l.ttl)
+			cancel()
+
+			if err == nil && renewed {
+				lastRenewal = time.Now()
+				continue
+			}
+			if err != nil && time.Since(lastRenewal)+interval < l.ttl {
+				l.logger.Warn("Failed to renew distributed lock, retrying",
+					log.String("lock", l.name), log.Error(err))
+				continue
+			}
+
+			l.logger.Error("Lost distributed lock as its lease could not be renewed",
+				log.String("lock", l.name), log.Any("fencingToken", l.fencingToken))
+			recordLostLease(context.Background(), l.name)
+			l.cancel(ErrLockLost)
+			return
+		}
+	}
+}
@@ -0,0 +1,159 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package distlock provides distributed locks that give mutual exclusion across the nodes of a
+// deployment. Leases are kept in the runtime database or in Redis, renewed while held and issued with
+// increasing fencing tokens.
+package distlock
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/system/log"
+)
+
+// ErrLockLost is returned when the lease of a held lock could not be renewed, so another node may have
+// acquired the lock while the work was running.
+var ErrLockLost = errors.New("distributed lock lost")
+
+// maxLockNameLength is the maximum length of a lock name.
+const maxLockNameLength = 255
+
+// LockManagerInterface defines the interface for acquiring distributed locks.
+type LockManagerInterface interface {
+	// TryAcquire acquires the named lock if it is free and reports whether it was acquired.
+	TryAcquire(ctx context.Context, name string) (*Lock, bool, error)
+	// Acquire waits until the named lock is acquired or ctx is done.
+	Acquire(ctx context.Context, name string) (*Lock, error)
+	// WithLock acquires the named lock, waiting while it is held elsewhere, runs fn while holding it and
+	// releases it.
+	WithLock(ctx context.Context, name string, fn func(ctx context.Context, fencingToken int64) error) error
+	// TryWithLock runs fn while holding the named lock if the lock is free, and reports whether fn ran.
+	TryWithLock(ctx context.Context, name string,
+		fn func(ctx context.Context, fencingToken int64) error) (bool, error)
+}
+
+// lockManager implements LockManagerInterface.
+type lockManager struct {
+	store         lockStoreInterface
+	owner         string
+	leaseTTL      time.Duration
+	retryInterval time.Duration
+	logger        *log.Logger
+}
+
+// newLockManager creates a new instance of lockManager.
+func newLockManager(
+	store lockStoreInterface, owner string, leaseTTL, retryInterval time.Duration,
+) *lockManager {
+	return &lockManager{
+		store:         store,
+		owner:         owner,
+		leaseTTL:      leaseTTL,
+		retryInterval: retryInterval,
+		logger:        log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName)),
+	}
+}
+
+// TryAcquire acquires the named lock if it is free and reports whether it was acquired.
+func (m *lockManager) TryAcquire(ctx context.Context, name string) (*Lock, bool, error) {
+	if name == "" || len(name) > maxLockNameLength {
+		return nil, false, fmt.Errorf("lock name must have 1 to %d characters", maxLockNameLength)
+	}
+
+	fencingToken, acquired, err := m.store.Acquire(ctx, name, m.owner, m.leaseTTL)
+	if err != nil {
+		recordAcquireAttempt(ctx, name, acquireResultError)
+		return nil, false, err
+	}
+	if !acquired {
+		recordAcquireAttempt(ctx, name, acquireResultContended)
+		return nil, false, nil
+	}
+
+	recordAcquireAttempt(ctx, name, acquireResultAcquired)
+	m.logger.Debug("Acquired distributed lock", log.String("lock", name), log.Any("fencingToken", fencingToken))
+	return newLock(ctx, name, m.owner, fencingToken, m.leaseTTL, m.store, m.logger), true, nil
+}
+
+// Acquire waits until the named lock is acquired or ctx is done. Attempts are repeated at the retry
+// interval while the lock is held elsewhere.
+func (m *lockManager) Acquire(ctx context.Context, name string) (*Lock, error) {
+	start := time.Now()
+	for {
+		lock, acquired, err := m.TryAcquire(ctx, name)
+		if err != nil {
+			return nil, err
+		}
+		if acquired {
+			recordWaitDuration(ctx, name, time.Since(start))
+			return lock, nil
+		}
+
+		timer := time.NewTimer(m.retryInterval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, fmt.Errorf("failed to acquire lock %s: %w", name, ctx.Err())
+		case <-timer.C:
+		}
+	}
+}
+
+// WithLock acquires the named lock, waiting while it is held elsewhere, runs fn while holding it and
+// releases it. fn receives a context that is cancelled if the lock is lost. ErrLockLost is returned when
+// fn succeeds after the lock was lost.
+func (m *lockManager) WithLock(
+	ctx context.Context, name string, fn func(ctx context.Context, fencingToken int64) error,
+) error {
+	lock, err := m.Acquire(ctx, name)
+	if err != nil {
+		return err
+	}
+	return m.runLocked(lock, fn)
+}
+
+// TryWithLock runs fn while holding the named lock if the lock is free, and reports whether fn ran.
+func (m *lockManager) TryWithLock(
+	ctx context.Context, name string, fn func(ctx context.Context, fencingToken int64) error,
+) (bool, error) {
+	lock, acquired, err := m.TryAcquire(ctx, name)
+	if err != nil || !acquired {
+		return false, err
+	}
+	return true, m.runLocked(lock, fn)
+}
+
+// runLocked runs fn under a held lock and releases the lock afterwards.
+func (m *lockManager) runLocked(lock *Lock, fn func(ctx context.Context, fencingToken int64) error) error {
+	fnErr := fn(lock.Context(), lock.FencingToken())
+	lost := errors.Is(context.Cause(lock.Context()), ErrLockLost)
+
+	if err := lock.Release(); err != nil {
+		m.logger.Warn("Failed to release distributed lock; it is released when its lease expires",
+			log.String("lock", lock.Name()), log.Error(err))
+	}
+
+	if fnErr == nil && lost {
+		return ErrLockLost
+	}
+	return fnErr
+}
@@ -0,0 +1,253 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package distlock
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+const (
+	testLeaseTTL      = 30 * time.Millisecond
+	testRetryInterval = 5 * time.Millisecond
+)
+
+type ManagerTestSuite struct {
+	suite.Suite
+	mockStore *lockStoreInterfaceMock
+	manager   *lockManager
+	ctx       context.Context
+}
+
+func TestManagerTestSuite(t *testing.T) {
+	suite.Run(t, new(ManagerTestSuite))
+}
+
+func (s *ManagerTestSuite) SetupTest() {
+	s.mockStore = newLockStoreInterfaceMock(s.T())
+	s.manager = newLockManager(s.mockStore, testOwner, testLeaseTTL, testRetryInterval)
+	s.ctx = context.Background()
+}
+
+// Tests for TryAcquire
+
+func (s *ManagerTestSuite) TestTryAcquire_Acquired() {
+	s.mockStore.On("Acquire", s.ctx, testLockName, testOwner, testLeaseTTL).Return(int64(2), true, nil).Once()
+	s.mockStore.On("Renew", mock.Anything, testLockName, testOwner, int64(2), testLeaseTTL).
+		Return(true, nil).Maybe()
+	s.mockStore.On("Release", mock.Anything, testLockName, testOwner, int64(2)).Return(nil).Once()
+
+	lock, acquired, err := s.manager.TryAcquire(s.ctx, testLockName)
+
+	s.Require().NoError(err)
+	s.Require().True(acquired)
+	s.Equal(testLockName, lock.Name())
+	s.Equal(int64(2), lock.FencingToken())
+	s.NoError(lock.Context().Err())
+
+	s.NoError(lock.Release())
+	s.NoError(lock.Release())
+	s.ErrorIs(lock.Context().Err(), context.Canceled)
+}
+
+func (s *ManagerTestSuite) TestTryAcquire_Contended() {
+	s.mockStore.On("Acquire", s.ctx, testLockName, testOwner, testLeaseTTL).Return(int64(0), false, nil).Once()
+
+	lock, acquired, err := s.manager.TryAcquire(s.ctx, testLockName)
+
+	s.NoError(err)
+	s.False(acquired)
+	s.Nil(lock)
+}
+
+func (s *ManagerTestSuite) TestTryAcquire_StoreError() {
+	s.mockStore.On("Acquire", s.ctx, testLockName, testOwner, testLeaseTTL).
+		Return(int64(0), false, errors.New("store error")).Once()
+
+	lock, acquired, err := s.manager.TryAcquire(s.ctx, testLockName)
+
+	s.Error(err)
+	s.False(acquired)
+	s.Nil(lock)
+}
+
+func (s *ManagerTestSuite) TestTryAcquire_InvalidName() {
+	_, _, err := s.manager.TryAcquire(s.ctx, "")
+	s.Error(err)
+
+	_, _, err = s.manager.TryAcquire(s.ctx, string(make([]byte, maxLockNameLength+1)))
+	s.Error(err)
+
+	s.mockStore.AssertNotCalled(s.T(), "Acquire")
+}
+
+// Tests for Acquire
+
+func (s *ManagerTestSuite) TestAcquire_WaitsForLock() {
+	s.mockStore.On("Acquire", s.ctx, testLockName, testOwner, testLeaseTTL).Return(int64(0), false, nil).Twice()
+	s.mockStore.On("Acquire", s.ctx, testLockName, testOwner, testLeaseTTL).Return(int64(3), true, nil).Once()
+	s.mockStore.On("Renew", mock.Anything, testLockName, testOwner, int64(3), testLeaseTTL).
+		Return(true, nil).Maybe()
+	s.mockStore.On("Release", mock.Anything, testLockName, testOwner, int64(3)).Return(nil).Once()
+
+	lock, err := s.manager.Acquire(s.ctx, testLockName)
+
+	s.Require().NoError(err)
+	s.Equal(int64(3), lock.FencingToken())
+	s.NoError(lock.Release())
+}
+
+func (s *ManagerTestSuite) TestAcquire_ContextDone() {
+	ctx, cancel := context.WithTimeout(s.ctx, 3*testRetryInterval)
+	defer cancel()
+	s.mockStore.On("Acquire", ctx, testLockName, testOwner, testLeaseTTL).Return(int64(0), false, nil)
+
+	lock, err := s.manager.Acquire(ctx, testLockName)
+
+	s.ErrorIs(err, context.DeadlineExceeded)
+	s.Nil(lock)
+}
+
+// Tests for WithLock
+
+func (s *ManagerTestSuite) TestWithLock_RunsAndReleases() {
+	s.mockStore.On("Acquire", s.ctx, testLockName, testOwner, testLeaseTTL).Return(int64(5), true, nil).Once()
+	s.mockStore.On("Renew", mock.Anything, testLockName, testOwner, int64(5), testLeaseTTL).
+		Return(true, nil).Maybe()
+	s.mockStore.On("Release", mock.Anything, testLockName, testOwner, int64(5)).Return(nil).Once()
+
+	var gotToken int64
+	err := s.manager.WithLock(s.ctx, testLockName, func(ctx context.Context, fencingToken int64) error {
+		gotToken = fencingToken
+		return nil
+	})
+
+	s.NoError(err)
+	s.Equal(int64(5), gotToken)
+}
+
+func (s *ManagerTestSuite) TestWithLock_ReturnsFunctionError() {
+	fnErr := errors.New("job failed")
+	s.mockStore.On("Acquire", s.ctx, testLockName, testOwner, testLeaseTTL).Return(int64(5), true, nil).Once()
+	s.mockStore.On("Renew", mock.Anything, testLockName, testOwner, int64(5), testLeaseTTL).
+		Return(true, nil).Maybe()
+	s.mockStore.On("Release", mock.Anything, testLockName, testOwner, int64(5)).
+		Return(errors.New("release failed")).Once()
+
+	err := s.manager.WithLock(s.ctx, testLockName, func(ctx context.Context, fencingToken int64) error {
+		return fnErr
+	})
+
+	s.ErrorIs(err, fnErr)
+}
+
+func (s *ManagerTestSuite) TestWithLock_RenewsLease() {
+	s.mockStore.On("Acquire", s.ctx, testLockName, testOwner, testLeaseTTL).Return(int64(5), true, nil).Once()
+	renewed := make(chan struct{}, 1)
+	s.mockStore.On("Renew", mock.Anything, testLockName, testOwner, int64(5), testLeaseTTL).
+		Run(func(args mock.Arguments) {
+			select {
+			case renewed <- struct{}{}:
+			default:
+			}
+		}).Return(true, nil)
+	s.mockStore.On("Release", mock.Anything, testLockName, testOwner, int64(5)).Return(nil).Once()
+
+	err := s.manager.WithLock(s.ctx, testLockName, func(ctx context.Context, fencingToken int64) error {
+		<-renewed
+		return ctx.Err()
+	})
+
+	s.NoError(err)
+}
+
+func (s *ManagerTestSuite) TestWithLock_LeaseLost() {
+	s.mockStore.On("Acquire", s.ctx, testLockName, testOwner, testLeaseTTL).Return(int64(5), true, nil).Once()
+	s.mockStore.On("Renew", mock.Anything, testLockName, testOwner, int64(5), testLeaseTTL).
+		Return(false, nil).Once()
+	s.mockStore.On("Release", mock.Anything, testLockName, testOwner, int64(5)).Return(nil).Once()
+
+	err := s.manager.WithLock(s.ctx, testLockName, func(ctx context.Context, fencingToken int64) error {
+		<-ctx.Done()
+		return nil
+	})
+
+	s.ErrorIs(err, ErrLockLost)
+}
+
+func (s *ManagerTestSuite) TestWithLock_RenewalErrorsUntilLeaseLapses() {
+	s.mockStore.On("Acquire", s.ctx, testLockName, testOwner, testLeaseTTL).Return(int64(5), true, nil).Once()
+	s.mockStore.On("Renew", mock.Anything, testLockName, testOwner, int64(5), testLeaseTTL).
+		Return(false, errors.New("store unavailable"))
+	s.mockStore.On("Release", mock.Anything, testLockName, testOwner, int64(5)).Return(nil).Once()
+
+	err := s.manager.WithLock(s.ctx, testLockName, func(ctx context.Context, fencingToken int64) error {
+		<-ctx.Done()
+		return nil
+	})
+
+	s.ErrorIs(err, ErrLockLost)
+}
+
+func (s *ManagerTestSuite) TestWithLock_AcquireError() {
+	s.mockStore.On("Acquire", s.ctx, testLockName, testOwner, testLeaseTTL).
+		Return(int64(0), false, errors.New("store error")).Once()
+
+	called := false
+	err := s.manager.WithLock(s.ctx, testLockName, func(ctx context.Context, fencingToken int64) error {
+		called = true
+		return nil
+	})
+
+	s.Error(err)
+	s.False(called)
+}
+
+// Tests for TryWithLock
+
+func (s *ManagerTestSuite) TestTryWithLock_Runs() {
+	s.mockStore.On("Acquire", s.ctx, testLockName, testOwner, testLeaseTTL).Return(int64(6), true, nil).Once()
+	s.mockStore.On("Renew", mock.Anything, testLockName, testOwner, int64(6), testLeaseTTL).
+		Return(true, nil).Maybe()
+	s.mockStore.On("Release", mock.Anything, testLockName, testOwner, int64(6)).Return(nil).Once()
+
+	ran, err := s.manager.TryWithLock(s.ctx, testLockName, func(ctx context.Context, fencingToken int64) error {
+		return nil
+	})
+
+	s.NoError(err)
+	s.True(ran)
+}
+
+func (s *ManagerTestSuite) TestTryWithLock_Contended() {
+	s.mockStore.On("Acquire", s.ctx, testLockName, testOwner, testLeaseTTL).Return(int64(0), false, nil).Once()
+
+	ran, err := s.manager.TryWithLock(s.ctx, testLockName, func(ctx context.Context, fencingToken int64) error {
+		s.Fail("function must not run while the lock is held elsewhere")
+		return nil
+	})
+
+	s.NoError(err)
+	s.False(ran)
+}
@@ -0,0 +1,91 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package distlock
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+type lockMetrics struct {
+	once         sync.Once
+	acquisitions metric.Int64Counter
+	waitDuration metric.Float64Histogram
+	lostLeases   metric.Int64Counter
+}
+
+var distLockMetrics lockMetrics
+
+func initLockMetrics() {
+	distLockMetrics.once.Do(func() {
+		meter := otel.Meter("github.com/thunder-id/thunderid/distlock")
+		distLockMetrics.acquisitions, _ = meter.Int64Counter(
+			"thunderid_distributed_lock_acquisitions_total",
+			metric.WithDescription("Total distributed lock acquisition attempts by outcome"),
+		)
+		distLockMetrics.waitDuration, _ = meter.Float64Histogram(
+			"thunderid_distributed_lock_wait_duration_seconds",
+			metric.WithDescription("Time spent waiting for a held distributed lock before acquiring it"),
+			metric.WithUnit("s"),
+		)
+		distLockMetrics.lostLeases, _ = meter.Int64Counter(
+			"thunderid_distributed_lock_lost_total",
+			metric.WithDescription("Total distributed locks lost because their lease could not be renewed"),
+		)
+	})
+}
+
+// recordAcquireAttempt records the outcome of an attempt to acquire a lock.
+func recordAcquireAttempt(ctx context.Context, name, result string) {
+	initLockMetrics()
+	if distLockMetrics.acquisitions == nil {
+		return
+	}
+	distLockMetrics.acquisitions.Add(ctx, 1, metric.WithAttributes(
+		attribute.String("lock", name),
+		attribute.String("result", result),
+	))
+}
+
+// recordWaitDuration records the time spent waiting before a lock was acquired.
+func recordWaitDuration(ctx context.Context, name string, wait time.Duration) {
+	initLockMetrics()
+	if distLockMetrics.waitDuration == nil {
+		return
+	}
+	distLockMetrics.waitDuration.Record(ctx, wait.Seconds(), metric.WithAttributes(
+		attribute.String("lock", name),
+	))
+}
+
+// recordLostLease records a lock that was lost while held.
+func recordLostLease(ctx context.Context, name string) {
+	initLockMetrics()
+	if distLockMetrics.lostLeases == nil {
+		return
+	}
+	distLockMetrics.lostLeases.Add(ctx, 1, metric.WithAttributes(
+		attribute.String("lock", name),
+	))
+}
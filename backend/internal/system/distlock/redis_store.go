@@ -0,0 +1,126 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package distlock
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"github.com/thunder-id/thunderid/internal/system/database/provider"
+)
+
+// acquireScript sets the lease key when it does not exist and returns the next fencing token, or 0 when
+// the lock is held. The fencing counter has no TTL so that tokens keep increasing across holders.
+const acquireScript = `
+if redis.call('EXISTS', KEYS[1]) == 1 then
+	return 0
+end
+local token = redis.call('INCR', KEYS[2])
+redis.call('SET', KEYS[1], ARGV[1] .. '|' .. token, 'PX', ARGV[2])
+return token
+`
+
+// renewScript extends the lease key when it still holds the given lease.
+const renewScript = `
+if redis.call('GET', KEYS[1]) == ARGV[1] then
+	return redis.call('PEXPIRE', KEYS[1], ARGV[2])
+end
+return 0
+`
+
+// releaseScript deletes the lease key when it still holds the given lease.
+const releaseScript = `
+if redis.call('GET', KEYS[1]) == ARGV[1] then
+	return redis.call('DEL', KEYS[1])
+end
+return 0
+`
+
+// lockRedisClient abstracts the Redis commands used by the lock store.
+type lockRedisClient interface {
+	Eval(ctx context.Context, script string, keys []string, args ...interface{}) *redis.Cmd
+}
+
+// redisLockStore is the Redis-backed implementation of lockStoreInterface. Leases are keys with a TTL,
+// so expired leases need no cleanup.
+type redisLockStore struct {
+	client       lockRedisClient
+	keyPrefix    string
+	deploymentID string
+}
+
+// newRedisLockStore creates a new Redis-backed lock store.
+func newRedisLockStore(p provider.RedisProviderInterface, deploymentID string) lockStoreInterface {
+	return &redisLockStore{
+		client:       p.GetRedisClient(),
+		keyPrefix:    p.GetKeyPrefix(),
+		deploymentID: deploymentID,
+	}
+}
+
+// leaseKey builds the Redis key that holds the lease of a lock.
+func (s *redisLockStore) leaseKey(name string) string {
+	return fmt.Sprintf("%s:runtime:%s:lock:%s", s.keyPrefix, s.deploymentID, name)
+}
+
+// fencingKey builds the Redis key that holds the last fencing token issued for a lock.
+func (s *redisLockStore) fencingKey(name string) string {
+	return fmt.Sprintf("%s:runtime:%s:lockfencing:%s", s.keyPrefix, s.deploymentID, name)
+}
+
+// Acquire takes the lease of a lock that is free. It returns the fencing token of the new lease and
+// reports whether the lock was acquired.
+func (s *redisLockStore) Acquire(ctx context.Context, name, owner string, ttl time.Duration) (int64, bool, error) {
+	fencingToken, err := s.client.Eval(ctx, acquireScript, []string{s.leaseKey(name), s.fencingKey(name)},
+		owner, ttl.Milliseconds()).Int64()
+	if err != nil {
+		return 0, false, fmt.Errorf("failed to acquire lock %s in Redis: %w", name, err)
+	}
+	return fencingToken, fencingToken > 0, nil
+}
+
+// Renew extends a lease that has not expired. It reports whether the lease is still held.
+func (s *redisLockStore) Renew(
+	ctx context.Context, name, owner string, fencingToken int64, ttl time.Duration,
+) (bool, error) {
+	renewed, err := s.client.Eval(ctx, renewScript, []string{s.leaseKey(name)},
+		leaseValue(owner, fencingToken), ttl.Milliseconds()).Int64()
+	if err != nil {
+		return false, fmt.Errorf("failed to renew lock %s in Redis: %w", name, err)
+	}
+	return renewed == 1, nil
+}
+
+// Release deletes a lease so that the lock can be acquired immediately.
+func (s *redisLockStore) Release(ctx context.Context, name, owner string, fencingToken int64) error {
+	if err := s.client.Eval(ctx, releaseScript, []string{s.leaseKey(name)},
+		leaseValue(owner, fencingToken)).Err(); err != nil {
+		return fmt.Errorf("failed to release lock %s in Redis: %w", name, err)
+	}
+	return nil
+}
+
+// leaseValue builds the value stored in a lease key.
+func leaseValue(owner string, fencingToken int64) string {
+	return owner + "|" + strconv.FormatInt(fencingToken, 10)
+}
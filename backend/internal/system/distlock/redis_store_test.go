@@ -0,0 +1,151 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package distlock
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/suite"
+)
+
+const redisTestKeyPrefix = "thunderid"
+
+type RedisStoreTestSuite struct {
+	suite.Suite
+	mockClient *lockRedisClientMock
+	store      *redisLockStore
+	ctx        context.Context
+	leaseKey   string
+	fencingKey string
+}
+
+func TestRedisStoreTestSuite(t *testing.T) {
+	suite.Run(t, new(RedisStoreTestSuite))
+}
+
+func (s *RedisStoreTestSuite) SetupTest() {
+	s.mockClient = newLockRedisClientMock(s.T())
+	s.store = &redisLockStore{
+		client:       s.mockClient,
+		keyPrefix:    redisTestKeyPrefix,
+		deploymentID: testDeploymentID,
+	}
+	s.ctx = context.Background()
+	s.leaseKey = "thunderid:runtime:test-deployment-id:lock:test-lock"
+	s.fencingKey = "thunderid:runtime:test-deployment-id:lockfencing:test-lock"
+}
+
+func (s *RedisStoreTestSuite) intCmd(value int64, err error) *redis.Cmd {
+	cmd := redis.NewCmd(s.ctx)
+	if err != nil {
+		cmd.SetErr(err)
+	} else {
+		cmd.SetVal(value)
+	}
+	return cmd
+}
+
+func (s *RedisStoreTestSuite) TestKeys() {
+	s.Equal(s.leaseKey, s.store.leaseKey(testLockName))
+	s.Equal(s.fencingKey, s.store.fencingKey(testLockName))
+}
+
+// Tests for Acquire
+
+func (s *RedisStoreTestSuite) TestAcquire_Success() {
+	s.mockClient.On("Eval", s.ctx, acquireScript, []string{s.leaseKey, s.fencingKey}, testOwner, int64(60000)).
+		Return(s.intCmd(4, nil))
+
+	token, acquired, err := s.store.Acquire(s.ctx, testLockName, testOwner, time.Minute)
+
+	s.NoError(err)
+	s.True(acquired)
+	s.Equal(int64(4), token)
+}
+
+func (s *RedisStoreTestSuite) TestAcquire_Held() {
+	s.mockClient.On("Eval", s.ctx, acquireScript, []string{s.leaseKey, s.fencingKey}, testOwner, int64(60000)).
+		Return(s.intCmd(0, nil))
+
+	_, acquired, err := s.store.Acquire(s.ctx, testLockName, testOwner, time.Minute)
+
+	s.NoError(err)
+	s.False(acquired)
+}
+
+func (s *RedisStoreTestSuite) TestAcquire_Error() {
+	s.mockClient.On("Eval", s.ctx, acquireScript, []string{s.leaseKey, s.fencingKey}, testOwner, int64(60000)).
+		Return(s.intCmd(0, errors.New("connection refused")))
+
+	_, acquired, err := s.store.Acquire(s.ctx, testLockName, testOwner, time.Minute)
+
+	s.Error(err)
+	s.False(acquired)
+}
+
+// Tests for Renew
+
+func (s *RedisStoreTestSuite) TestRenew_Success() {
+	s.mockClient.On("Eval", s.ctx, renewScript, []string{s.leaseKey}, "test-owner|4", int64(60000)).
+		Return(s.intCmd(1, nil))
+
+	renewed, err := s.store.Renew(s.ctx, testLockName, testOwner, 4, time.Minute)
+
+	s.NoError(err)
+	s.True(renewed)
+}
+
+func (s *RedisStoreTestSuite) TestRenew_LeaseLost() {
+	s.mockClient.On("Eval", s.ctx, renewScript, []string{s.leaseKey}, "test-owner|4", int64(60000)).
+		Return(s.intCmd(0, nil))
+
+	renewed, err := s.store.Renew(s.ctx, testLockName, testOwner, 4, time.Minute)
+
+	s.NoError(err)
+	s.False(renewed)
+}
+
+func (s *RedisStoreTestSuite) TestRenew_Error() {
+	s.mockClient.On("Eval", s.ctx, renewScript, []string{s.leaseKey}, "test-owner|4", int64(60000)).
+		Return(s.intCmd(0, errors.New("connection refused")))
+
+	renewed, err := s.store.Renew(s.ctx, testLockName, testOwner, 4, time.Minute)
+
+	s.Error(err)
+	s.False(renewed)
+}
+
+// Tests for Release
+
+func (s *RedisStoreTestSuite) TestRelease_Success() {
+	s.mockClient.On("Eval", s.ctx, releaseScript, []string{s.leaseKey}, "test-owner|4").Return(s.intCmd(1, nil))
+
+	s.NoError(s.store.Release(s.ctx, testLockName, testOwner, 4))
+}
+
+func (s *RedisStoreTestSuite) TestRelease_Error() {
+	s.mockClient.On("Eval", s.ctx, releaseScript, []string{s.leaseKey}, "test-owner|4").
+		Return(s.intCmd(0, errors.New("connection refused")))
+
+	s.Error(s.store.Release(s.ctx, testLockName, testOwner, 4))
+}
@@ -0,0 +1,119 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package distlock
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/system/database/provider"
+)
+
+// lockStoreInterface defines the operations on lock leases. A lease is identified by the lock name, the
+// owner that holds it and the fencing token issued when it was acquired.
+type lockStoreInterface interface {
+	Acquire(ctx context.Context, name, owner string, ttl time.Duration) (int64, bool, error)
+	Renew(ctx context.Context, name, owner string, fencingToken int64, ttl time.Duration) (bool, error)
+	Release(ctx context.Context, name, owner string, fencingToken int64) error
+}
+
+// lockStore is the runtime-database-backed implementation of lockStoreInterface.
+type lockStore struct {
+	dbProvider   provider.DBProviderInterface
+	deploymentID string
+}
+
+// newLockStore creates a new DB-backed lock store.
+func newLockStore(deploymentID string) lockStoreInterface {
+	return &lockStore{
+		dbProvider:   provider.GetDBProvider(),
+		deploymentID: deploymentID,
+	}
+}
+
+// Acquire takes the lease of a lock that is free or whose lease has expired. It returns the fencing token
+// of the new lease and reports whether the lock was acquired.
+func (s *lockStore) Acquire(ctx context.Context, name, owner string, ttl time.Duration) (int64, bool, error) {
+	dbClient, err := s.dbProvider.GetRuntimeDBClient()
+	if err != nil {
+		return 0, false, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	now := time.Now().UTC()
+	results, err := dbClient.QueryContext(ctx, queryAcquireLock, name, s.deploymentID, owner, now.Add(ttl), now)
+	if err != nil {
+		return 0, false, fmt.Errorf("failed to acquire lock %s: %w", name, err)
+	}
+	if len(results) == 0 {
+		return 0, false, nil
+	}
+
+	fencingToken, err := parseFencingToken(results[0][dbColumnFencingToken])
+	if err != nil {
+		return 0, false, err
+	}
+	return fencingToken, true, nil
+}
+
+// Renew extends a lease that has not expired. It reports whether the lease is still held.
+func (s *lockStore) Renew(
+	ctx context.Context, name, owner string, fencingToken int64, ttl time.Duration,
+) (bool, error) {
+	dbClient, err := s.dbProvider.GetRuntimeDBClient()
+	if err != nil {
+		return false, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	now := time.Now().UTC()
+	rowsAffected, err := dbClient.ExecuteContext(ctx, queryRenewLock, now.Add(ttl), name, s.deploymentID, owner,
+		fencingToken, now)
+	if err != nil {
+		return false, fmt.Errorf("failed to renew lock %s: %w", name, err)
+	}
+	return rowsAffected > 0, nil
+}
+
+// Release expires a lease so that the lock can be acquired immediately.
+func (s *lockStore) Release(ctx context.Context, name, owner string, fencingToken int64) error {
+	dbClient, err := s.dbProvider.GetRuntimeDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	if _, err := dbClient.ExecuteContext(ctx, queryReleaseLock, time.Now().UTC(), name, s.deploymentID, owner,
+		fencingToken); err != nil {
+		return fmt.Errorf("failed to release lock %s: %w", name, err)
+	}
+	return nil
+}
+
+// parseFencingToken converts a fencing token read from the database to int64.
+func parseFencingToken(value interface{}) (int64, error) {
+	switch token := value.(type) {
+	case int64:
+		return token, nil
+	case int32:
+		return int64(token), nil
+	case int:
+		return int64(token), nil
+	default:
+		return 0, fmt.Errorf("fencing_token is missing or of unexpected type %T", value)
+	}
+}
@@ -0,0 +1,54 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package distlock
+
+import dbmodel "github.com/thunder-id/thunderid/internal/system/database/model"
+
+// Database column names for lock leases.
+const (
+	dbColumnFencingToken = "fencing_token"
+)
+
+// queryAcquireLock inserts the lease of a free lock or takes over an expired lease, incrementing the
+// fencing token. No row is returned when the lock is held.
+var queryAcquireLock = dbmodel.DBQuery{
+	ID: "DLQ-LOCK-01",
+	Query: `INSERT INTO "DISTRIBUTED_LOCK" (LOCK_NAME, DEPLOYMENT_ID, OWNER_ID, FENCING_TOKEN, LEASE_EXPIRY_TIME) ` +
+		`VALUES ($1, $2, $3, 1, $4) ` +
+		`ON CONFLICT (LOCK_NAME, DEPLOYMENT_ID) DO UPDATE SET OWNER_ID = EXCLUDED.OWNER_ID, ` +
+		`FENCING_TOKEN = "DISTRIBUTED_LOCK".FENCING_TOKEN + 1, LEASE_EXPIRY_TIME = EXCLUDED.LEASE_EXPIRY_TIME ` +
+		`WHERE "DISTRIBUTED_LOCK".LEASE_EXPIRY_TIME <= $5 ` +
+		`RETURNING FENCING_TOKEN`,
+}
+
+// queryRenewLock extends the lease of a lock that is still held by the given owner and fencing token.
+var queryRenewLock = dbmodel.DBQuery{
+	ID: "DLQ-LOCK-02",
+	Query: `UPDATE "DISTRIBUTED_LOCK" SET LEASE_EXPIRY_TIME = $1 ` +
+		`WHERE LOCK_NAME = $2 AND DEPLOYMENT_ID = $3 AND OWNER_ID = $4 AND FENCING_TOKEN = $5 ` +
+		`AND LEASE_EXPIRY_TIME > $6`,
+}
+
+// queryReleaseLock expires the lease of a lock held by the given owner and fencing token. The row is kept
+// so that the next holder receives a higher fencing token.
+var queryReleaseLock = dbmodel.DBQuery{
+	ID: "DLQ-LOCK-03",
+	Query: `UPDATE "DISTRIBUTED_LOCK" SET LEASE_EXPIRY_TIME = $1 ` +
+		`WHERE LOCK_NAME = $2 AND DEPLOYMENT_ID = $3 AND OWNER_ID = $4 AND FENCING_TOKEN = $5`,
+}
@@ -0,0 +1,183 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package distlock
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/tests/mocks/database/providermock"
+)
+
+const (
+	testDeploymentID = "test-deployment-id"
+	testLockName     = "test-lock"
+	testOwner        = "test-owner"
+)
+
+type StoreTestSuite struct {
+	suite.Suite
+	mockDBProvider *providermock.DBProviderInterfaceMock
+	mockDBClient   *providermock.DBClientInterfaceMock
+	store          *lockStore
+	ctx            context.Context
+}
+
+func TestStoreTestSuite(t *testing.T) {
+	suite.Run(t, new(StoreTestSuite))
+}
+
+func (s *StoreTestSuite) SetupTest() {
+	s.mockDBProvider = providermock.NewDBProviderInterfaceMock(s.T())
+	s.mockDBClient = providermock.NewDBClientInterfaceMock(s.T())
+	s.store = &lockStore{
+		dbProvider:   s.mockDBProvider,
+		deploymentID: testDeploymentID,
+	}
+	s.ctx = context.Background()
+}
+
+// Tests for Acquire
+
+func (s *StoreTestSuite) TestAcquire_Success() {
+	s.mockDBProvider.On("GetRuntimeDBClient").Return(s.mockDBClient, nil)
+	s.mockDBClient.On("QueryContext", mock.Anything, queryAcquireLock, testLockName, testDeploymentID, testOwner,
+		mock.AnythingOfType("time.Time"), mock.AnythingOfType("time.Time"),
+	).Return([]map[string]interface{}{{dbColumnFencingToken: int64(7)}}, nil)
+
+	token, acquired, err := s.store.Acquire(s.ctx, testLockName, testOwner, time.Minute)
+
+	s.NoError(err)
+	s.True(acquired)
+	s.Equal(int64(7), token)
+}
+
+func (s *StoreTestSuite) TestAcquire_Held() {
+	s.mockDBProvider.On("GetRuntimeDBClient").Return(s.mockDBClient, nil)
+	s.mockDBClient.On("QueryContext", mock.Anything, queryAcquireLock, mock.Anything, mock.Anything,
+		mock.Anything, mock.Anything, mock.Anything).Return([]map[string]interface{}{}, nil)
+
+	token, acquired, err := s.store.Acquire(s.ctx, testLockName, testOwner, time.Minute)
+
+	s.NoError(err)
+	s.False(acquired)
+	s.Zero(token)
+}
+
+func (s *StoreTestSuite) TestAcquire_DBClientError() {
+	s.mockDBProvider.On("GetRuntimeDBClient").Return(nil, errors.New("db client error"))
+
+	_, acquired, err := s.store.Acquire(s.ctx, testLockName, testOwner, time.Minute)
+
+	s.Error(err)
+	s.False(acquired)
+}
+
+func (s *StoreTestSuite) TestAcquire_QueryError() {
+	s.mockDBProvider.On("GetRuntimeDBClient").Return(s.mockDBClient, nil)
+	s.mockDBClient.On("QueryContext", mock.Anything, queryAcquireLock, mock.Anything, mock.Anything,
+		mock.Anything, mock.Anything, mock.Anything).Return(nil, errors.New("query failed"))
+
+	_, acquired, err := s.store.Acquire(s.ctx, testLockName, testOwner, time.Minute)
+
+	s.Error(err)
+	s.False(acquired)
+}
+
+func (s *StoreTestSuite) TestAcquire_InvalidFencingToken() {
+	s.mockDBProvider.On("GetRuntimeDBClient").Return(s.mockDBClient, nil)
+	s.mockDBClient.On("QueryContext", mock.Anything, queryAcquireLock, mock.Anything, mock.Anything,
+		mock.Anything, mock.Anything, mock.Anything,
+	).Return([]map[string]interface{}{{dbColumnFencingToken: "7"}}, nil)
+
+	_, acquired, err := s.store.Acquire(s.ctx, testLockName, testOwner, time.Minute)
+
+	s.Error(err)
+	s.False(acquired)
+}
+
+// Tests for Renew
+
+func (s *StoreTestSuite) TestRenew_Success() {
+	s.mockDBProvider.On("GetRuntimeDBClient").Return(s.mockDBClient, nil)
+	s.mockDBClient.On("ExecuteContext", mock.Anything, queryRenewLock, mock.AnythingOfType("time.Time"),
+		testLockName, testDeploymentID, testOwner, int64(3), mock.AnythingOfType("time.Time"),
+	).Return(int64(1), nil)
+
+	renewed, err := s.store.Renew(s.ctx, testLockName, testOwner, 3, time.Minute)
+
+	s.NoError(err)
+	s.True(renewed)
+}
+
+func (s *StoreTestSuite) TestRenew_LeaseLost() {
+	s.mockDBProvider.On("GetRuntimeDBClient").Return(s.mockDBClient, nil)
+	s.mockDBClient.On("ExecuteContext", mock.Anything, queryRenewLock, mock.Anything, mock.Anything,
+		mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(int64(0), nil)
+
+	renewed, err := s.store.Renew(s.ctx, testLockName, testOwner, 3, time.Minute)
+
+	s.NoError(err)
+	s.False(renewed)
+}
+
+func (s *StoreTestSuite) TestRenew_ExecuteError() {
+	s.mockDBProvider.On("GetRuntimeDBClient").Return(s.mockDBClient, nil)
+	s.mockDBClient.On("ExecuteContext", mock.Anything, queryRenewLock, mock.Anything, mock.Anything,
+		mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(int64(0), errors.New("update failed"))
+
+	renewed, err := s.store.Renew(s.ctx, testLockName, testOwner, 3, time.Minute)
+
+	s.Error(err)
+	s.False(renewed)
+}
+
+// Tests for Release
+
+func (s *StoreTestSuite) TestRelease_Success() {
+	s.mockDBProvider.On("GetRuntimeDBClient").Return(s.mockDBClient, nil)
+	s.mockDBClient.On("ExecuteContext", mock.Anything, queryReleaseLock, mock.AnythingOfType("time.Time"),
+		testLockName, testDeploymentID, testOwner, int64(3)).Return(int64(1), nil)
+
+	s.NoError(s.store.Release(s.ctx, testLockName, testOwner, 3))
+}
+
+func (s *StoreTestSuite) TestRelease_ExecuteError() {
+	s.mockDBProvider.On("GetRuntimeDBClient").Return(s.mockDBClient, nil)
+	s.mockDBClient.On("ExecuteContext", mock.Anything, queryReleaseLock, mock.Anything, mock.Anything,
+		mock.Anything, mock.Anything, mock.Anything).Return(int64(0), errors.New("update failed"))
+
+	s.Error(s.store.Release(s.ctx, testLockName, testOwner, 3))
+}
+
+func (s *StoreTestSuite) TestParseFencingToken() {
+	for _, value := range []interface{}{int64(5), int32(5), 5} {
+		token, err := parseFencingToken(value)
+		s.NoError(err)
+		s.Equal(int64(5), token)
+	}
+
+	_, err := parseFencingToken(nil)
+	s.Error(err)
+}
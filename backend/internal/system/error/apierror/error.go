@@ -58,3 +58,93 @@ var (
 		},
 	}
 )
+
+// Request limit error responses, returned by the request limit middleware.
+var (
+	// ErrRequestBodyTooLarge is returned when the request body exceeds the size limit of the route (HTTP 413).
+	ErrRequestBodyTooLarge = ErrorResponse{
+		Code: "REQ-4130",
+		Message: core.I18nMessage{
+			Key:          "error.request.body_too_large",
+			DefaultValue: "Request body too large",
+		},
+		Description: core.I18nMessage{
+			Key:          "error.request.body_too_large_description",
+			DefaultValue: "The request body exceeds the maximum size allowed for this resource",
+		},
+	}
+
+	// ErrInvalidRequestBody is returned when the request body cannot be read (HTTP 400).
+	ErrInvalidRequestBody = ErrorResponse{
+		Code: "REQ-4000",
+		Message: core.I18nMessage{
+			Key:          "error.request.invalid_body",
+			DefaultValue: "Invalid request body",
+		},
+		Description: core.I18nMessage{
+			Key:          "error.request.invalid_body_description",
+			DefaultValue: "The request body could not be read",
+		},
+	}
+
+	// ErrJSONTooComplex is returned when a JSON request body exceeds the nesting depth or array length
+	// limits (HTTP 400).
+	ErrJSONTooComplex = ErrorResponse{
+		Code: "REQ-4001",
+		Message: core.I18nMessage{
+			Key:          "error.request.json_too_complex",
+			DefaultValue: "JSON payload too complex",
+		},
+		Description: core.I18nMessage{
+			Key:          "error.request.json_too_complex_description",
+			DefaultValue: "The JSON request body exceeds the maximum nesting depth or array length",
+		},
+	}
+
+	// ErrInvalidYAMLBody is returned when a YAML request body cannot be parsed or cannot be represented as
+	// JSON (HTTP 400).
+	ErrInvalidYAMLBody = ErrorResponse{
+		Code: "REQ-4002",
+		Message: core.I18nMessage{
+			Key:          "error.request.invalid_yaml_body",
+			DefaultValue: "Invalid YAML request body",
+		},
+		Description: core.I18nMessage{
+			Key:          "error.request.invalid_yaml_body_description",
+			DefaultValue: "The YAML request body could not be parsed",
+		},
+	}
+)
+
+// Request timeout error responses, returned by the request timeout middleware.
+var (
+	// ErrRequestTimeout is returned when a request is not served within the time budget of the route (HTTP 504).
+	ErrRequestTimeout = ErrorResponse{
+		Code: "REQ-5040",
+		Message: core.I18nMessage{
+			Key:          "error.request.timeout",
+			DefaultValue: "Request timed out",
+		},
+		Description: core.I18nMessage{
+			Key:          "error.request.timeout_description",
+			DefaultValue: "The request could not be completed within the time allowed for this resource",
+		},
+	}
+)
+
+// Overload error responses, returned by the adaptive concurrency middleware.
+var (
+	// ErrServerOverloaded is returned when a route is serving as many concurrent requests as it admits
+	// (HTTP 503).
+	ErrServerOverloaded = ErrorResponse{
+		Code: "REQ-5030",
+		Message: core.I18nMessage{
+			Key:          "error.request.server_overloaded",
+			DefaultValue: "Server overloaded",
+		},
+		Description: core.I18nMessage{
+			Key:          "error.request.server_overloaded_description",
+			DefaultValue: "The server is busy. Retry after the time given in the Retry-After header",
+		},
+	}
+)
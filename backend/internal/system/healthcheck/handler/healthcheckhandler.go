@@ -54,7 +54,13 @@ func (hch *HealthCheckHandler) HandleReadinessRequest(w http.ResponseWriter, r *
 	serverstatus := hch.Service.CheckReadiness()
 
 	statusCode := http.StatusOK
-	if serverstatus.Status != model.StatusUp {
+	if serverstatus.Status == model.StatusDraining {
+		logger.Debug("Readiness check reported the server as draining")
+		statusCode = http.StatusServiceUnavailable
+	} else if serverstatus.Status == model.StatusWarming {
+		logger.Debug("Readiness check reported the server as warming its caches")
+		statusCode = http.StatusServiceUnavailable
+	} else if serverstatus.Status != model.StatusUp {
 		logger.Error("Readiness check failed", log.String("serverstatus", string(serverstatus.Status)))
 		statusCode = http.StatusServiceUnavailable
 	} else {
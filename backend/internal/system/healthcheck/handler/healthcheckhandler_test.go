@@ -141,3 +141,39 @@ func (suite *HealthCheckHandlerTestSuite) TestHandleReadinessRequest_Down() {
 
 	suite.mockService.AssertExpectations(suite.T())
 }
+
+func (suite *HealthCheckHandlerTestSuite) TestHandleReadinessRequest_Draining() {
+	req := httptest.NewRequest("GET", "/health/readiness", nil)
+	rec := httptest.NewRecorder()
+
+	suite.mockService.On("CheckReadiness").Return(model.ServerStatus{Status: model.StatusDraining})
+
+	suite.handler.HandleReadinessRequest(rec, req)
+
+	assert.Equal(suite.T(), http.StatusServiceUnavailable, rec.Code)
+
+	var response model.ServerStatus
+	err := json.NewDecoder(rec.Body).Decode(&response)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), model.StatusDraining, response.Status)
+
+	suite.mockService.AssertExpectations(suite.T())
+}
+
+func (suite *HealthCheckHandlerTestSuite) TestHandleReadinessRequest_Warming() {
+	req := httptest.NewRequest("GET", "/health/readiness", nil)
+	rec := httptest.NewRecorder()
+
+	suite.mockService.On("CheckReadiness").Return(model.ServerStatus{Status: model.StatusWarming})
+
+	suite.handler.HandleReadinessRequest(rec, req)
+
+	assert.Equal(suite.T(), http.StatusServiceUnavailable, rec.Code)
+
+	var response model.ServerStatus
+	err := json.NewDecoder(rec.Body).Decode(&response)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), model.StatusWarming, response.Status)
+
+	suite.mockService.AssertExpectations(suite.T())
+}
@@ -42,4 +42,8 @@ const (
 	StatusDown Status = "DOWN"
 	// StatusUnknown indicates that the service status is unknown.
 	StatusUnknown Status = "UNKNOWN"
+	// StatusDraining indicates that the server is shutting down and no longer accepts new traffic.
+	StatusDraining Status = "DRAINING"
+	// StatusWarming indicates that the server is loading its caches and does not accept traffic yet.
+	StatusWarming Status = "WARMING"
 )
@@ -21,6 +21,7 @@ package service
 
 import (
 	"context"
+	"sync/atomic"
 
 	"github.com/thunder-id/thunderid/internal/system/config"
 	dbmodel "github.com/thunder-id/thunderid/internal/system/database/model"
@@ -32,12 +33,17 @@ import (
 // HealthCheckServiceInterface defines the interface for the health check service.
 type HealthCheckServiceInterface interface {
 	CheckReadiness() model.ServerStatus
+	MarkDraining()
+	MarkWarming()
+	MarkWarm()
 }
 
 // HealthCheckService is the default implementation of the HealthCheckServiceInterface.
 type HealthCheckService struct {
 	DBProvider    provider.DBProviderInterface
 	RedisProvider provider.RedisProviderInterface
+	draining      atomic.Bool
+	warming       atomic.Bool
 }
 
 // Initialize creates a new instance of HealthCheckService with the provided dependencies.
@@ -49,8 +55,33 @@ func Initialize(dbProvider provider.DBProviderInterface,
 	}
 }
 
-// CheckReadiness checks the readiness of the server and its dependencies.
+// MarkDraining makes the readiness check report the server as draining so that load balancers
+// stop routing new traffic to it during shutdown.
+func (hcs *HealthCheckService) MarkDraining() {
+	hcs.draining.Store(true)
+}
+
+// MarkWarming makes the readiness check report the server as warming so that load balancers do not
+// route traffic to it until its caches are loaded.
+func (hcs *HealthCheckService) MarkWarming() {
+	hcs.warming.Store(true)
+}
+
+// MarkWarm ends the warming state set by MarkWarming.
+func (hcs *HealthCheckService) MarkWarm() {
+	hcs.warming.Store(false)
+}
+
+// CheckReadiness checks the readiness of the server and its dependencies. A draining or warming server
+// is reported as not ready without checking its dependencies.
 func (hcs *HealthCheckService) CheckReadiness() model.ServerStatus {
+	if hcs.draining.Load() {
+		return model.ServerStatus{Status: model.StatusDraining}
+	}
+	if hcs.warming.Load() {
+		return model.ServerStatus{Status: model.StatusWarming}
+	}
+
 	configDBStatus := model.ServiceStatus{
 		ServiceName: "ConfigDB",
 		Status:      hcs.checkConfigDatabaseStatus(queryConfigDBTable),
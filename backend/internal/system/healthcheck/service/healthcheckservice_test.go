@@ -275,3 +275,28 @@ func (suite *HealthCheckServiceTestSuite) TestCheckReadiness_DBRetrievalError()
 
 	suite.mockDBProvider.AssertExpectations(suite.T())
 }
+
+func (suite *HealthCheckServiceTestSuite) TestCheckReadiness_Warming() {
+	suite.service.MarkWarming()
+
+	serverStatus := suite.service.CheckReadiness()
+
+	assert.Equal(suite.T(), model.StatusWarming, serverStatus.Status)
+	assert.Empty(suite.T(), serverStatus.ServiceStatus)
+	suite.mockDBProvider.AssertNotCalled(suite.T(), "GetConfigDBClient")
+
+	suite.service.MarkWarm()
+	assert.False(suite.T(), suite.service.(*HealthCheckService).warming.Load())
+}
+
+func (suite *HealthCheckServiceTestSuite) TestCheckReadiness_Draining() {
+	suite.service.MarkDraining()
+
+	serverStatus := suite.service.CheckReadiness()
+
+	assert.Equal(suite.T(), model.StatusDraining, serverStatus.Status)
+	assert.Empty(suite.T(), serverStatus.ServiceStatus)
+	suite.mockDBProvider.AssertNotCalled(suite.T(), "GetConfigDBClient")
+	suite.mockDBProvider.AssertNotCalled(suite.T(), "GetRuntimeDBClient")
+	suite.mockDBProvider.AssertNotCalled(suite.T(), "GetUserDBClient")
+}
@@ -35,6 +35,80 @@ var defaultMessages = map[string]string{
 	"design.resolve.error.missing_id_description": "The 'id' query parameter is required",
 	"design.resolve.error.unsupported_type": "Unsupported resolve type",
 	"design.resolve.error.unsupported_type_description": "The specified resolve type is not yet supported. Currently only 'APP' type is supported",
+	"error.accountdeletionservice.account_not_deletable": "Account cannot be deleted",
+	"error.accountdeletionservice.account_not_deletable_description": "The account is managed declaratively and cannot be deleted",
+	"error.accountdeletionservice.authentication_failed": "Authentication failed",
+	"error.accountdeletionservice.authentication_failed_description": "The caller could not be identified",
+	"error.accountdeletionservice.cancellation_not_sent": "Cancellation link not sent",
+	"error.accountdeletionservice.cancellation_not_sent_description": "The account deletion could not be scheduled as the cancellation link was not sent",
+	"error.accountdeletionservice.deletion_already_requested": "Account deletion already requested",
+	"error.accountdeletionservice.deletion_already_requested_description": "The deletion of the account is already scheduled",
+	"error.accountdeletionservice.invalid_cancellation_token": "Invalid cancellation token",
+	"error.accountdeletionservice.invalid_cancellation_token_description": "The cancellation token is invalid or the deletion can no longer be cancelled",
+	"error.accountdeletionservice.invalid_request_format": "Invalid request format",
+	"error.accountdeletionservice.invalid_request_format_description": "The request body is malformed or contains invalid data",
+	"error.accountdeletionservice.missing_email": "Missing email address",
+	"error.accountdeletionservice.missing_email_description": "The user has no email address to send the cancellation link to",
+	"error.accountdeletionservice.missing_user_id": "Missing user ID",
+	"error.accountdeletionservice.missing_user_id_description": "The user ID must be provided",
+	"error.accountdeletionservice.pending_deletion_not_found": "Pending account deletion not found",
+	"error.accountdeletionservice.pending_deletion_not_found_description": "The user has no pending account deletion",
+	"error.accountdeletionservice.user_not_found": "User not found",
+	"error.accountdeletionservice.user_not_found_description": "The user could not be found",
+	"error.accessreviewservice.authentication_failed": "Authentication failed",
+	"error.accessreviewservice.authentication_failed_description": "The caller could not be identified",
+	"error.accessreviewservice.campaign_not_active": "Campaign not active",
+	"error.accessreviewservice.campaign_not_active_description": "The access review campaign has already been completed",
+	"error.accessreviewservice.campaign_not_found": "Access review campaign not found",
+	"error.accessreviewservice.campaign_not_found_description": "The requested access review campaign could not be found",
+	"error.accessreviewservice.invalid_deadline": "Invalid deadline",
+	"error.accessreviewservice.invalid_deadline_description": "The deadline of the campaign must be in the future",
+	"error.accessreviewservice.invalid_decision": "Invalid decision",
+	"error.accessreviewservice.invalid_decision_description": "The decision must be KEEP or REVOKE",
+	"error.accessreviewservice.invalid_request_format": "Invalid request format",
+	"error.accessreviewservice.invalid_request_format_description": "The request body is malformed or contains invalid data",
+	"error.accessreviewservice.invalid_status_filter": "Invalid status filter",
+	"error.accessreviewservice.invalid_status_filter_description": "The status filter must be one of ACTIVE or COMPLETED",
+	"error.accessreviewservice.missing_campaign_name": "Missing campaign name",
+	"error.accessreviewservice.missing_campaign_name_description": "The name of the campaign must be provided",
+	"error.accessreviewservice.missing_reviewers": "Missing reviewers",
+	"error.accessreviewservice.missing_reviewers_description": "The campaign must have at least one reviewer",
+	"error.accessreviewservice.missing_targets": "Missing campaign targets",
+	"error.accessreviewservice.missing_targets_description": "The campaign must target at least one role or organization unit",
+	"error.accessreviewservice.no_assignments_to_review": "No assignments to review",
+	"error.accessreviewservice.no_assignments_to_review_description": "The targeted roles have no assignments to review",
+	"error.accessreviewservice.no_eligible_reviewer": "No eligible reviewer",
+	"error.accessreviewservice.no_eligible_reviewer_description": "An assignment cannot be reviewed by its own holder. Add another reviewer",
+	"error.accessreviewservice.not_assigned_reviewer": "Not the assigned reviewer",
+	"error.accessreviewservice.not_assigned_reviewer_description": "One or more review items are assigned to another reviewer",
+	"error.accessreviewservice.review_item_not_found": "Review item not found",
+	"error.accessreviewservice.review_item_not_found_description": "One or more review items could not be found in the campaign",
+	"error.accessreviewservice.target_role_not_found": "Role not found",
+	"error.accessreviewservice.target_role_not_found_description": "One or more of the targeted roles could not be found",
+	"error.adminnotificationservice.authentication_failed": "Authentication failed",
+	"error.adminnotificationservice.authentication_failed_description": "The caller could not be identified",
+	"error.adminnotificationservice.invalid_limit_parameter": "Invalid limit parameter",
+	"error.adminnotificationservice.invalid_limit_parameter_description": "The limit parameter must be a positive integer",
+	"error.adminnotificationservice.invalid_offset_parameter": "Invalid offset parameter",
+	"error.adminnotificationservice.invalid_offset_parameter_description": "The offset parameter must be a non-negative integer",
+	"error.adminnotificationservice.invalid_severity": "Invalid severity filter",
+	"error.adminnotificationservice.invalid_severity_description": "The severity filter must be one of INFO, WARNING or CRITICAL",
+	"error.adminnotificationservice.invalid_status_filter": "Invalid status filter",
+	"error.adminnotificationservice.invalid_status_filter_description": "The status filter must be one of read or unread",
+	"error.adminnotificationservice.notification_not_found": "Notification not found",
+	"error.adminnotificationservice.notification_not_found_description": "The requested notification could not be found",
+	"error.accountprotectionservice.change_not_revertible": "Change cannot be reverted",
+	"error.accountprotectionservice.change_not_revertible_description": "The email address of the user no longer matches the change to revert",
+	"error.accountprotectionservice.change_on_hold": "Change on hold",
+	"error.accountprotectionservice.change_on_hold_description": "Password and email address changes are on hold for a while after a password reset",
+	"error.accountprotectionservice.email_already_in_use": "Email address already in use",
+	"error.accountprotectionservice.email_already_in_use_description": "The previous email address is now used by another user",
+	"error.accountprotectionservice.invalid_request_format": "Invalid request format",
+	"error.accountprotectionservice.invalid_request_format_description": "The request body is malformed or contains invalid data",
+	"error.accountprotectionservice.invalid_revert_token": "Invalid revert token",
+	"error.accountprotectionservice.invalid_revert_token_description": "The revert token is invalid or has expired",
+	"error.accountprotectionservice.step_up_required": "Re-authentication required",
+	"error.accountprotectionservice.step_up_required_description": "Sign in again to change your password or email address",
 	"error.agentservice.agent_already_exists_with_client_id": "Client ID already in use",
 	"error.agentservice.agent_already_exists_with_client_id_description": "An entity with the same client ID already exists",
 	"error.agentservice.agent_already_exists_with_name": "Agent already exists",
@@ -70,6 +144,7 @@ var defaultMessages = map[string]string{
 	"error.agentservice.invalid_agent_type_description": "The agent type must be provided",
 	"error.agentservice.invalid_auth_flow_id": "Invalid auth flow ID",
 	"error.agentservice.invalid_auth_flow_id_description": "The provided authentication flow ID is invalid",
+	"error.agentservice.invalid_backchannel_logout_uri_description": "Back-channel logout URI must be a publicly reachable HTTPS URL without a fragment",
 	"error.agentservice.invalid_certificate_type": "Invalid certificate type",
 	"error.agentservice.invalid_certificate_type_description": "The provided certificate type is not supported",
 	"error.agentservice.invalid_certificate_value": "Invalid certificate value",
@@ -78,6 +153,7 @@ var defaultMessages = map[string]string{
 	"error.agentservice.invalid_credential_description": "The provided credential is invalid",
 	"error.agentservice.invalid_filter": "Invalid filter parameter",
 	"error.agentservice.invalid_filter_description": "The filter format is invalid",
+	"error.agentservice.invalid_frontchannel_logout_uri_description": "Front-channel logout URI must be an absolute URL without a fragment",
 	"error.agentservice.invalid_grant_type": "Invalid grant type",
 	"error.agentservice.invalid_grant_type_description": "One or more grant types are not supported",
 	"error.agentservice.invalid_jwks_uri": "Invalid JWKS URI",
@@ -92,6 +168,7 @@ var defaultMessages = map[string]string{
 	"error.agentservice.invalid_public_client_configuration_description": "The public client configuration is invalid",
 	"error.agentservice.invalid_redirect_uri": "Invalid redirect URI",
 	"error.agentservice.invalid_redirect_uri_description": "One or more redirect URIs are not valid",
+	"error.agentservice.invalid_refresh_token_lifetime_description": "Refresh token validity period and idle timeout must not be negative",
 	"error.agentservice.invalid_registration_flow_id": "Invalid registration flow ID",
 	"error.agentservice.invalid_registration_flow_id_description": "The provided registration flow ID is invalid",
 	"error.agentservice.invalid_request_format": "Invalid request format",
@@ -140,6 +217,8 @@ var defaultMessages = map[string]string{
 	"error.agentservice.userinfo_unsupported_encryption_enc_description": "userinfo content-encryption algorithm is not supported",
 	"error.agentservice.userinfo_unsupported_response_type_description": "userinfo responseType is not supported",
 	"error.agentservice.userinfo_unsupported_signing_alg_description": "userinfo signing algorithm is not supported",
+	"error.apiversion.unsupported_version": "Unsupported API version",
+	"error.apiversion.unsupported_version_description": "The requested API version is not supported for this resource",
 	"error.applicationservice.application_already_exists": "Application already exists",
 	"error.applicationservice.application_already_exists_description": "An application with the same name already exists",
 	"error.applicationservice.application_is_nil": "Application is nil",
@@ -173,6 +252,8 @@ var defaultMessages = map[string]string{
 	"error.applicationservice.idtoken_unsupported_response_type_description": "ID token responseType is not supported",
 	"error.applicationservice.invalid_acr_values": "Invalid ACR value",
 	"error.applicationservice.invalid_acr_values_description": "One or more ACR values in acr_values are not recognized by the system",
+	"error.applicationservice.invalid_allowed_auth_methods": "Invalid allowed authentication methods",
+	"error.applicationservice.invalid_allowed_auth_methods_description": "At least one authentication method must be allowed and federated identity provider IDs must be non-empty and unique",
 	"error.applicationservice.invalid_application_id": "Invalid application ID",
 	"error.applicationservice.invalid_application_id_description": "The provided application ID is invalid or empty",
 	"error.applicationservice.invalid_application_name": "Invalid application name",
@@ -181,14 +262,20 @@ var defaultMessages = map[string]string{
 	"error.applicationservice.invalid_application_url_description": "The provided application URL is not a valid URI",
 	"error.applicationservice.invalid_auth_flow_id": "Invalid auth flow ID",
 	"error.applicationservice.invalid_auth_flow_id_description": "The provided authentication flow ID is invalid",
+	"error.applicationservice.invalid_backchannel_logout_uri_description": "Back-channel logout URI must be a publicly reachable HTTPS URL without a fragment",
 	"error.applicationservice.invalid_certificate_type": "Invalid certificate type",
 	"error.applicationservice.invalid_certificate_type_description": "The provided certificate type is not supported",
 	"error.applicationservice.invalid_certificate_value": "Invalid certificate value",
 	"error.applicationservice.invalid_certificate_value_description": "The provided certificate value is invalid",
+	"error.applicationservice.invalid_claim_fallbacks": "Invalid claim fallbacks",
+	"error.applicationservice.invalid_claim_fallbacks_description": "One or more claim fallback chains of the application are invalid",
 	"error.applicationservice.invalid_client_id": "Invalid client ID",
 	"error.applicationservice.invalid_client_id_description": "The provided client ID is invalid or empty",
+	"error.applicationservice.invalid_frontchannel_logout_uri_description": "Front-channel logout URI must be an absolute URL without a fragment",
 	"error.applicationservice.invalid_grant_type": "Invalid grant type",
 	"error.applicationservice.invalid_grant_type_description": "One or more provided grant types are invalid",
+	"error.applicationservice.invalid_inactive_since_parameter": "Invalid inactiveSince parameter",
+	"error.applicationservice.invalid_inactive_since_parameter_description": "The inactiveSince parameter must be an RFC 3339 timestamp or a date",
 	"error.applicationservice.invalid_inbound_auth_config": "Invalid inbound auth config",
 	"error.applicationservice.invalid_inbound_auth_config_description": "The provided inbound authentication configuration is invalid",
 	"error.applicationservice.invalid_jwks_uri": "Invalid JWKS URI",
@@ -199,18 +286,22 @@ var defaultMessages = map[string]string{
 	"error.applicationservice.invalid_logo_url_description": "The provided logo URL is not a valid URI",
 	"error.applicationservice.invalid_oauth_configuration": "Invalid OAuth configuration",
 	"error.applicationservice.invalid_oauth_configuration_description": "The OAuth configuration is invalid",
+	"error.applicationservice.invalid_scope_policy_description": "Allowed scopes of a grant type must be a non-empty list of scope names",
 	"error.applicationservice.invalid_public_client_configuration": "Invalid public client configuration",
 	"error.applicationservice.invalid_public_client_configuration_description": "The public client configuration is invalid",
 	"error.applicationservice.invalid_recovery_flow_id": "Invalid recovery flow ID",
 	"error.applicationservice.invalid_recovery_flow_id_description": "The provided recovery flow ID is invalid",
 	"error.applicationservice.invalid_redirect_uri": "Invalid redirect URI",
 	"error.applicationservice.invalid_redirect_uri_description": "One or more provided redirect URIs are not valid URIs",
+	"error.applicationservice.invalid_refresh_token_lifetime_description": "Refresh token validity period and idle timeout must not be negative",
 	"error.applicationservice.invalid_registration_flow_id": "Invalid registration flow ID",
 	"error.applicationservice.invalid_registration_flow_id_description": "The provided registration flow ID is invalid",
 	"error.applicationservice.invalid_request_format": "Invalid request format",
 	"error.applicationservice.invalid_request_format_description": "The request body is malformed or contains invalid data",
 	"error.applicationservice.invalid_response_type": "Invalid response type",
 	"error.applicationservice.invalid_response_type_description": "One or more provided response types are invalid",
+	"error.applicationservice.invalid_token_customization": "Invalid token customization",
+	"error.applicationservice.invalid_token_customization_description": "One or more token customization rules of the application are invalid",
 	"error.applicationservice.invalid_token_endpoint_auth_method": "Invalid token endpoint authentication method",
 	"error.applicationservice.invalid_token_endpoint_auth_method_description": "The provided token endpoint authentication method is invalid",
 	"error.applicationservice.invalid_user_attribute": "Invalid user attribute",
@@ -223,17 +314,32 @@ var defaultMessages = map[string]string{
 	"error.applicationservice.multiple_oauth_configs_description": "An application may have at most one inbound auth config per protocol",
 	"error.applicationservice.none_auth_method_cannot_have_cert_or_secret_description": "'none' authentication method cannot have a certificate or client secret",
 	"error.applicationservice.none_auth_method_requires_public_client_description": "'none' authentication method requires the client to be a public client",
+	"error.applicationservice.oauth_config_not_found": "OAuth configuration not found",
+	"error.applicationservice.oauth_config_not_found_description": "The application does not have an OAuth configuration",
 	"error.applicationservice.pkce_requires_authorization_code_description": "PKCE can only be enabled when the authorization_code grant type is selected",
 	"error.applicationservice.private_key_jwt_cannot_have_client_secret_description": "private_key_jwt authentication method cannot have a client secret",
 	"error.applicationservice.private_key_jwt_requires_certificate_description": "private_key_jwt authentication method requires a certificate",
 	"error.applicationservice.public_client_must_have_pkce_description": "Public clients must have PKCE required set to true",
 	"error.applicationservice.public_client_must_use_none_auth_description": "Public clients must use 'none' as token endpoint authentication method",
+	"error.applicationservice.quota_exceeded": "Quota exceeded",
+	"error.applicationservice.quota_exceeded_description": "The application quota of the organization unit or the tenant has been reached",
+	"error.applicationservice.invalid_limit_parameter": "Invalid pagination parameter",
+	"error.applicationservice.invalid_limit_parameter_description": "The limit parameter must be between 1 and 100",
+	"error.applicationservice.invalid_offset_parameter": "Invalid pagination parameter",
+	"error.applicationservice.invalid_offset_parameter_description": "The offset parameter must be a non-negative integer",
 	"error.applicationservice.redirect_uri_fragment_not_allowed_description": "Redirect URIs must not contain a fragment component",
+	"error.applicationservice.redirect_uri_wildcard_host_not_allowed_description": "Redirect URIs must not use a wildcard host unless wildcard matching is enabled",
+	"error.applicationservice.redirect_uri_pattern_not_allowed_description": "Redirect URIs must not contain wildcards when exact matching is configured",
+	"error.applicationservice.invalid_redirect_uri_match_mode_description": "Redirect URI match mode must be one of EXACT or PATTERN",
 	"error.applicationservice.refresh_token_cannot_be_sole_grant_description": "refresh_token grant type cannot be used without another grant type",
+	"error.applicationservice.request_object_requires_certificate_description": "A certificate (JWKS or JWKS_URI) is required when a request object signing algorithm is set",
 	"error.applicationservice.response_types_require_authorization_code_description": "Response types can only be configured with the authorization_code grant type",
 	"error.applicationservice.result_limit_exceeded": "Result limit exceeded",
+	"error.applicationservice.scope_policy_grant_type_not_allowed_description": "Allowed scopes can only be configured for grant types the application uses",
 	"error.applicationservice.theme_not_found": "Theme not found",
 	"error.applicationservice.theme_not_found_description": "The specified theme configuration does not exist",
+	"error.applicationservice.unsupported_request_object_signing_alg_description": "Request object signing algorithm is not supported",
+	"error.applicationservice.unsupported_scope_policy_grant_type_description": "Allowed scopes can only be configured for the client_credentials grant type",
 	"error.applicationservice.userinfo_alg_requires_response_type_description": "userinfo responseType is required when signingAlg or encryptionAlg is set",
 	"error.applicationservice.userinfo_encryption_alg_requires_enc_description": "userinfo encryptionEnc is required when encryptionAlg is set",
 	"error.applicationservice.userinfo_encryption_enc_requires_alg_description": "userinfo encryptionAlg is required when encryptionEnc is set",
@@ -246,6 +352,22 @@ var defaultMessages = map[string]string{
 	"error.applicationservice.userinfo_unsupported_encryption_enc_description": "userinfo content-encryption algorithm is not supported",
 	"error.applicationservice.userinfo_unsupported_response_type_description": "userinfo responseType is not supported",
 	"error.applicationservice.userinfo_unsupported_signing_alg_description": "userinfo signing algorithm is not supported",
+	"error.appuserservice.app_user_already_exists": "Application user already exists",
+	"error.appuserservice.app_user_already_exists_description": "The user is already associated with the application",
+	"error.appuserservice.app_user_not_found": "Application user not found",
+	"error.appuserservice.app_user_not_found_description": "The user is not associated with the application",
+	"error.appuserservice.application_not_found": "Application not found",
+	"error.appuserservice.application_not_found_description": "The requested application could not be found",
+	"error.appuserservice.declarative_user": "Declarative user",
+	"error.appuserservice.declarative_user_description": "Declarative users cannot be associated with applications",
+	"error.appuserservice.invalid_limit_parameter": "Invalid limit parameter",
+	"error.appuserservice.invalid_limit_parameter_description": "The limit parameter must be between 1 and 100",
+	"error.appuserservice.invalid_offset_parameter": "Invalid offset parameter",
+	"error.appuserservice.invalid_offset_parameter_description": "The offset parameter must be a non-negative integer",
+	"error.appuserservice.invalid_request_format": "Invalid request format",
+	"error.appuserservice.invalid_request_format_description": "The request body is malformed or the user ID is missing",
+	"error.appuserservice.user_not_found": "User not found",
+	"error.appuserservice.user_not_found_description": "The requested user could not be found",
 	"error.assertservice.invalid_authenticator": "Invalid authenticator",
 	"error.assertservice.invalid_authenticator_description": "Authenticator name cannot be empty",
 	"error.assertservice.nil_assurance_context": "Nil assurance context",
@@ -264,6 +386,16 @@ var defaultMessages = map[string]string{
 	"error.attributecache.missing_attributes_description": "Attributes are required",
 	"error.attributecache.missing_cache_id": "Missing cache ID",
 	"error.attributecache.missing_cache_id_description": "Cache ID is required",
+	"error.auditservice.invalid_limit_parameter": "Invalid limit parameter",
+	"error.auditservice.invalid_limit_parameter_description": "The limit parameter must be a positive integer",
+	"error.auditservice.invalid_offset_parameter": "Invalid offset parameter",
+	"error.auditservice.invalid_offset_parameter_description": "The offset parameter must be a non-negative integer",
+	"error.auditservice.invalid_outcome": "Invalid outcome filter",
+	"error.auditservice.invalid_outcome_description": "The outcome filter must be one of success, failure, in_progress or pending",
+	"error.auditservice.invalid_time_filter": "Invalid time filter",
+	"error.auditservice.invalid_time_filter_description": "The from and to filters must be RFC 3339 times",
+	"error.auditservice.invalid_time_range": "Invalid time range",
+	"error.auditservice.invalid_time_range_description": "The from filter must be before the to filter",
 	"error.auth.forbidden": "Forbidden",
 	"error.auth.forbidden_description": "You do not have sufficient permissions to access this resource",
 	"error.auth.unauthorized": "Unauthorized",
@@ -356,6 +488,30 @@ var defaultMessages = map[string]string{
 	"error.authoidcservice.invalid_id_token_description": "The ID token is invalid or malformed",
 	"error.authoidcservice.invalid_id_token_signature": "Invalid ID token signature",
 	"error.authoidcservice.invalid_id_token_signature_description": "The ID token signature verification failed",
+	"error.breakglassservice.account_conflict": "Break-glass account conflict",
+	"error.breakglassservice.account_conflict_description": "The user is already designated as a break-glass account",
+	"error.breakglassservice.account_not_active": "Break-glass account not active",
+	"error.breakglassservice.account_not_active_description": "The break-glass account has no approved and unexpired activation",
+	"error.breakglassservice.account_not_found": "Break-glass account not found",
+	"error.breakglassservice.account_not_found_description": "The requested break-glass account could not be found",
+	"error.breakglassservice.authentication_failed": "Authentication failed",
+	"error.breakglassservice.authentication_failed_description": "The caller could not be identified",
+	"error.breakglassservice.invalid_account_state": "Invalid account state",
+	"error.breakglassservice.invalid_account_state_description": "The operation is not allowed in the current state of the break-glass account",
+	"error.breakglassservice.invalid_activation_period": "Invalid activation period",
+	"error.breakglassservice.invalid_activation_period_description": "The activation period must be positive and within the configured maximum",
+	"error.breakglassservice.invalid_description": "Invalid description",
+	"error.breakglassservice.invalid_description_description": "The description must not exceed 500 characters",
+	"error.breakglassservice.invalid_reason": "Invalid activation reason",
+	"error.breakglassservice.invalid_reason_description": "A reason of at most 500 characters must be provided for the activation",
+	"error.breakglassservice.invalid_request_format": "Invalid request format",
+	"error.breakglassservice.invalid_request_format_description": "The request body is malformed or contains invalid data",
+	"error.breakglassservice.missing_user_id": "Missing user ID",
+	"error.breakglassservice.missing_user_id_description": "The userId of the break-glass account must be provided",
+	"error.breakglassservice.self_approval_not_allowed": "Self approval not allowed",
+	"error.breakglassservice.self_approval_not_allowed_description": "The activation must be approved by someone other than the requester and the account user",
+	"error.breakglassservice.user_not_found": "User not found",
+	"error.breakglassservice.user_not_found_description": "The user of the break-glass account does not exist",
 	"error.certservice.certificate_already_exists": "Certificate already exists",
 	"error.certservice.certificate_already_exists_description": "A certificate with the same reference type and ID already exists",
 	"error.certservice.certificate_not_found": "Certificate not found",
@@ -372,6 +528,18 @@ var defaultMessages = map[string]string{
 	"error.certservice.invalid_reference_type_description": "The provided certificate reference type is invalid",
 	"error.certservice.reference_update_not_allowed": "Reference update is not allowed",
 	"error.certservice.reference_update_not_allowed_description": "Updating the reference type or ID of an existing certificate is not allowed",
+	"error.changerequestservice.authentication_failed": "Authentication failed",
+	"error.changerequestservice.authentication_failed_description": "The caller could not be identified",
+	"error.changerequestservice.change_request_not_found": "Change request not found",
+	"error.changerequestservice.change_request_not_found_description": "The requested change request could not be found",
+	"error.changerequestservice.invalid_change_request_state": "Invalid change request state",
+	"error.changerequestservice.invalid_change_request_state_description": "The change request has already been decided or has expired",
+	"error.changerequestservice.invalid_status_filter": "Invalid status filter",
+	"error.changerequestservice.invalid_status_filter_description": "The status filter must be one of PENDING, APPROVED, REJECTED, EXPIRED or FAILED",
+	"error.changerequestservice.self_approval_not_allowed": "Self approval not allowed",
+	"error.changerequestservice.self_approval_not_allowed_description": "The change request must be approved by someone other than the requester",
+	"error.configdriftservice.baseline_not_found": "Baseline not found",
+	"error.configdriftservice.baseline_not_found_description": "No configuration baseline has been approved. Create a baseline to detect drift",
 	"error.consentenforcerservice.consent_create_failed": "Failed to create consent record",
 	"error.consentenforcerservice.consent_create_failed_description": "Error while creating consent record in the consent service",
 	"error.consentenforcerservice.consent_search_failed": "Failed to search consent records",
@@ -420,6 +588,14 @@ var defaultMessages = map[string]string{
 	"error.consentservice.purpose_not_found_description": "The consent purpose with the specified ID does not exist",
 	"error.consentservice.unauthorized": "Unauthorized to access consent service",
 	"error.consentservice.unauthorized_description": "The consent service returned an unauthorized response",
+	"error.credentialcheckservice.capability_not_granted": "Unauthorized client",
+	"error.credentialcheckservice.capability_not_granted_description": "The client is not allowed to look up available credential types",
+	"error.credentialcheckservice.missing_identifier": "Missing identifier",
+	"error.credentialcheckservice.missing_identifier_description": "The identifier parameter is required",
+	"error.credentialcheckservice.unsupported_identifier_attribute": "Unsupported identifier attribute",
+	"error.credentialcheckservice.unsupported_identifier_attribute_description": "The identifier attribute is not one that users sign in with",
+	"error.credentialcheckservice.too_many_requests": "Too many requests",
+	"error.credentialcheckservice.too_many_requests_description": "Too many credential checks from this IP address. Please try again later",
 	"error.dcr.invalid_client_metadata": "Invalid client metadata",
 	"error.dcr.invalid_client_metadata_description": "One or more client metadata values are invalid",
 	"error.dcr.invalid_redirect_uri": "Invalid redirect URI",
@@ -438,8 +614,66 @@ var defaultMessages = map[string]string{
 	"error.declarative_resource.delete_operation_not_allowed_description": "Deleting declarative resources is not permitted",
 	"error.declarative_resource.update_operation_not_allowed": "Declarative resource update operation is not allowed",
 	"error.declarative_resource.update_operation_not_allowed_description": "Updating declarative resources is not permitted",
+	"error.denylistservice.deny_list_entry_conflict": "Deny list entry conflict",
+	"error.denylistservice.deny_list_entry_conflict_description": "The same entry already exists in the deny list",
+	"error.denylistservice.deny_list_not_found": "Deny list not found",
+	"error.denylistservice.deny_list_not_found_description": "The deny list must be one of 'usernames' or 'passwords'",
+	"error.denylistservice.invalid_bulk_request": "Invalid bulk request",
+	"error.denylistservice.invalid_bulk_request_description": "A bulk upload must contain between 1 and 1000 entries",
+	"error.denylistservice.invalid_match_type": "Invalid match type",
+	"error.denylistservice.invalid_match_type_description": "The match type must be one of EXACT or PATTERN",
+	"error.denylistservice.invalid_pattern": "Invalid pattern",
+	"error.denylistservice.invalid_pattern_description": "The value of a PATTERN entry must be a valid regular expression",
+	"error.denylistservice.invalid_request_format": "Invalid request format",
+	"error.denylistservice.invalid_request_format_description": "The request body is malformed or contains invalid data",
+	"error.denylistservice.invalid_value": "Invalid value",
+	"error.denylistservice.invalid_value_description": "The value must not be empty and must be at most 255 characters long",
+	"error.domainroutingservice.domain_route_conflict": "Domain route conflict",
+	"error.domainroutingservice.domain_route_conflict_description": "A route for the same domain already exists",
+	"error.domainroutingservice.domain_route_not_found": "Domain route not found",
+	"error.domainroutingservice.domain_route_not_found_description": "The requested domain route could not be found",
+	"error.domainroutingservice.domain_verification_failed": "Domain verification failed",
+	"error.domainroutingservice.domain_verification_failed_description": "The expected DNS TXT record was not found for the domain",
+	"error.domainroutingservice.identity_provider_not_found": "Identity provider not found",
+	"error.domainroutingservice.identity_provider_not_found_description": "The identity provider of the route does not exist",
+	"error.domainroutingservice.invalid_domain": "Invalid domain",
+	"error.domainroutingservice.invalid_domain_description": "The domain must be a valid domain name, optionally prefixed with '*.' for subdomains",
+	"error.domainroutingservice.invalid_flow_branch": "Invalid flow branch",
+	"error.domainroutingservice.invalid_flow_branch_description": "The flow branch must contain only letters, digits, hyphens and underscores",
+	"error.domainroutingservice.invalid_request_format": "Invalid request format",
+	"error.domainroutingservice.invalid_request_format_description": "The request body is malformed or contains invalid data",
+	"error.domainroutingservice.missing_route_target": "Missing route target",
+	"error.domainroutingservice.missing_route_target_description": "At least one of ouId, idpId or flowBranch must be provided",
+	"error.domainroutingservice.organization_unit_not_found": "Organization unit not found",
+	"error.domainroutingservice.organization_unit_not_found_description": "The organization unit of the route does not exist",
+	"error.emailchangeservice.authentication_failed": "Authentication failed",
+	"error.emailchangeservice.authentication_failed_description": "The caller could not be identified",
+	"error.emailchangeservice.confirmation_not_sent": "Confirmation not sent",
+	"error.emailchangeservice.confirmation_not_sent_description": "The email address change could not be confirmed as the confirmation email was not sent",
+	"error.emailchangeservice.email_already_in_use": "Email address already in use",
+	"error.emailchangeservice.email_already_in_use_description": "The new email address is already used by another user",
+	"error.emailchangeservice.invalid_confirmation_token": "Invalid confirmation token",
+	"error.emailchangeservice.invalid_confirmation_token_description": "The confirmation token is invalid or has expired",
+	"error.emailchangeservice.invalid_email": "Invalid email address",
+	"error.emailchangeservice.invalid_email_description": "The new email address is not valid",
+	"error.emailchangeservice.invalid_request_format": "Invalid request format",
+	"error.emailchangeservice.invalid_request_format_description": "The request body is malformed or contains invalid data",
+	"error.emailchangeservice.missing_user_id": "Missing user ID",
+	"error.emailchangeservice.missing_user_id_description": "The user ID must be provided",
+	"error.emailchangeservice.pending_change_not_found": "Pending email change not found",
+	"error.emailchangeservice.pending_change_not_found_description": "The user has no pending email change",
+	"error.emailchangeservice.user_not_found": "User not found",
+	"error.emailchangeservice.user_not_found_description": "The user could not be found",
 	"error.encoding_error": "Encoding error",
 	"error.encoding_error_description": "An error occurred while encoding the response",
+	"error.enrollmentsessionservice.invalid_enrollment_type": "Invalid enrollment type",
+	"error.enrollmentsessionservice.invalid_enrollment_type_description": "The enrollment type is not supported",
+	"error.enrollmentsessionservice.invalid_payload": "Invalid enrollment payload",
+	"error.enrollmentsessionservice.invalid_payload_description": "The enrollment payload is empty or too long to encode as a QR code",
+	"error.enrollmentsessionservice.invalid_request_format": "Invalid request format",
+	"error.enrollmentsessionservice.invalid_request_format_description": "The request body is malformed or contains invalid data",
+	"error.enrollmentsessionservice.invalid_session_token": "Invalid enrollment session",
+	"error.enrollmentsessionservice.invalid_session_token_description": "The enrollment session is invalid or has expired",
 	"error.entitytypeservice.agent_type_cannot_delete": "Agent type cannot be deleted",
 	"error.entitytypeservice.agent_type_cannot_delete_description": "The default agent type cannot be deleted. Edit the schema instead",
 	"error.entitytypeservice.agent_type_name_conflict": "Agent type name conflict",
@@ -461,9 +695,13 @@ var defaultMessages = map[string]string{
 	"error.entitytypeservice.entity_type_not_found_description": "The entity type with the specified id does not exist",
 	"error.entitytypeservice.invalid_agent_type_request": "Invalid agent type request",
 	"error.entitytypeservice.invalid_agent_type_request_description": "The agent type request contains invalid or missing required fields",
+	"error.entitytypeservice.invalid_credential_policy": "Invalid credential policy",
+	"error.entitytypeservice.invalid_credential_policy_description": "The credential policy must only reference credential attributes of the schema or system-managed credential types and must be satisfiable",
 	"error.entitytypeservice.invalid_display_attribute": "Invalid display attribute",
 	"error.entitytypeservice.invalid_display_attribute_description": "Display attribute must reference an attribute defined in the schema (use dot notation for nested attributes, e.g. 'address.city')",
 	"error.entitytypeservice.invalid_entity_type_request": "Invalid entity type request",
+	"error.entitytypeservice.invalid_password_policy": "Invalid password policy",
+	"error.entitytypeservice.invalid_password_policy_description": "The password policy must not have negative values or more than 4 character classes and must only reference credential attributes of the schema",
 	"error.entitytypeservice.invalid_entity_type_request_description": "The entity type request contains invalid or missing required fields",
 	"error.entitytypeservice.invalid_limit_parameter": "Invalid pagination parameter",
 	"error.entitytypeservice.invalid_limit_parameter_description": "The limit parameter must be a positive integer",
@@ -477,6 +715,8 @@ var defaultMessages = map[string]string{
 	"error.entitytypeservice.non_displayable_attribute_type_description": "Display attribute must reference a string or number type",
 	"error.entitytypeservice.result_limit_exceeded": "Result limit exceeded",
 	"error.entitytypeservice.result_limit_exceeded_description": "The combined result set from both file-based and database stores exceeds the maximum limit. Please refine your query to return fewer results.",
+	"error.entitytypeservice.uniqueness_scope_conflict": "Uniqueness scope conflict",
+	"error.entitytypeservice.uniqueness_scope_conflict_description": "Existing entities of the type share values of an attribute within its new uniqueness scope. Resolve the duplicate values before tightening the scope",
 	"error.entitytypeservice.update_schema_request_parse_failed_description": "Failed to parse request body",
 	"error.entitytypeservice.user_type_name_conflict": "User type name conflict",
 	"error.entitytypeservice.user_type_name_conflict_description": "A user type with the same name already exists",
@@ -490,8 +730,22 @@ var defaultMessages = map[string]string{
 	"error.exportservice.no_resources_found": "No resources found",
 	"error.exportservice.no_resources_found_description": "No valid resources found for the provided identifiers",
 	"error.exportservice.no_valid_resources_for_export_description": "No valid resources found for export",
+	"error.featureflagservice.feature_flag_conflict": "Feature flag conflict",
+	"error.featureflagservice.feature_flag_conflict_description": "A feature flag with the same key already exists",
+	"error.featureflagservice.feature_flag_not_found": "Feature flag not found",
+	"error.featureflagservice.feature_flag_not_found_description": "The requested feature flag could not be found",
+	"error.featureflagservice.invalid_description": "Invalid description",
+	"error.featureflagservice.invalid_description_description": "The flag description must not exceed 500 characters",
+	"error.featureflagservice.invalid_flag_key": "Invalid flag key",
+	"error.featureflagservice.invalid_flag_key_description": "The flag key must contain only lowercase letters, digits, dots, hyphens and underscores",
+	"error.featureflagservice.invalid_request_format": "Invalid request format",
+	"error.featureflagservice.invalid_request_format_description": "The request body is malformed or contains invalid data",
+	"error.featureflagservice.invalid_targeting_rule": "Invalid targeting rule",
+	"error.featureflagservice.invalid_targeting_rule_description": "A flag can have at most 50 rules, each with a condition and a percentage between 0 and 100",
 	"error.flowexecservice.application_retrieval_error": "Application retrieval error",
 	"error.flowexecservice.application_retrieval_error_description": "Error while retrieving application details",
+	"error.flowexecservice.auth_method_not_allowed": "Authentication method not allowed",
+	"error.flowexecservice.auth_method_not_allowed_description": "The authentication method is not allowed for the application",
 	"error.flowexecservice.invalid_app_id": "Invalid request",
 	"error.flowexecservice.invalid_app_id_description": "Invalid app ID provided in the request",
 	"error.flowexecservice.invalid_challenge_token": "Invalid challenge token",
@@ -565,6 +819,8 @@ var defaultMessages = map[string]string{
 	"error.flowmgtservice.invalid_offset_parameter_description": "The offset parameter must be a non-negative integer",
 	"error.flowmgtservice.invalid_request_format": "Invalid request format",
 	"error.flowmgtservice.invalid_request_format_description": "The request body is malformed or contains invalid data",
+	"error.flowmgtservice.quota_exceeded": "Quota exceeded",
+	"error.flowmgtservice.quota_exceeded_description": "The flow quota of the tenant has been reached",
 	"error.groupservice.cannot_delete_group": "Cannot delete group",
 	"error.groupservice.cannot_delete_group_description": "Cannot delete group with child groups",
 	"error.groupservice.empty_members_list": "Empty members list",
@@ -592,6 +848,8 @@ var defaultMessages = map[string]string{
 	"error.groupservice.invalid_request_format_description": "The request body is malformed or contains invalid data",
 	"error.groupservice.missing_group_id": "Invalid request format",
 	"error.groupservice.missing_group_id_description": "Group ID is required",
+	"error.groupservice.quota_exceeded": "Quota exceeded",
+	"error.groupservice.quota_exceeded_description": "The group quota of the organization unit or the tenant has been reached",
 	"error.i18nservice.empty_translations": "Empty translations",
 	"error.i18nservice.empty_translations_description": "At least one translation must be provided",
 	"error.i18nservice.export_error": "Failed to fetch translation languages for export",
@@ -647,6 +905,14 @@ var defaultMessages = map[string]string{
 	"error.import.templateResolutionFailed": "Template resolution failed",
 	"error.import.templateResolutionFailed.description": "Failed to resolve one or more template variables in YAML content",
 	"error.import.unsupportedResourceType": "unsupported resource type for declarative file management",
+	"error.integrityservice.invalid_repair_action": "Invalid repair action",
+	"error.integrityservice.invalid_repair_action_description": "The repair action must be either DELETE or REASSIGN",
+	"error.integrityservice.invalid_request_format": "Invalid request format",
+	"error.integrityservice.invalid_request_format_description": "The request body is malformed or contains invalid data",
+	"error.integrityservice.missing_target_ou": "Missing target organization unit",
+	"error.integrityservice.missing_target_ou_description": "The target organization unit ID must be provided to reassign orphaned resources",
+	"error.integrityservice.target_ou_not_found": "Target organization unit not found",
+	"error.integrityservice.target_ou_not_found_description": "The organization unit to reassign orphaned resources to could not be found",
 	"error.internal_server_error": "Internal server error",
 	"error.internal_server_error_description": "An unexpected error occurred while processing the request",
 	"error.jweservice.decoding_jwe_error": "JWE decode error",
@@ -683,6 +949,16 @@ var defaultMessages = map[string]string{
 	"error.jwtservice.token_expired_description": "The JWT token has expired",
 	"error.jwtservice.unsupported_jws_algorithm": "Unsupported JWS algorithm",
 	"error.jwtservice.unsupported_jws_algorithm_description": "The specified JWS algorithm is not supported",
+	"error.logoutservice.client_id_mismatch": "Client mismatch",
+	"error.logoutservice.client_id_mismatch_description": "The client_id is not an audience of the id_token_hint",
+	"error.logoutservice.invalid_id_token_hint": "Invalid id_token_hint",
+	"error.logoutservice.invalid_id_token_hint_description": "The id_token_hint is malformed or was not issued by this server",
+	"error.logoutservice.invalid_post_logout_redirect_uri": "Invalid post_logout_redirect_uri",
+	"error.logoutservice.invalid_post_logout_redirect_uri_description": "The post_logout_redirect_uri is not a registered redirect URI of the client",
+	"error.logoutservice.missing_client": "Missing client",
+	"error.logoutservice.missing_client_description": "Either id_token_hint or client_id is required",
+	"error.logoutservice.unknown_client": "Unknown client",
+	"error.logoutservice.unknown_client_description": "The client_id does not identify a registered client",
 	"error.magiclinkservice.expired_token": "Expired token",
 	"error.magiclinkservice.expired_token_description": "The magic link token has expired",
 	"error.magiclinkservice.invalid_token": "Invalid token",
@@ -723,20 +999,50 @@ var defaultMessages = map[string]string{
 	"error.notificationservice.unsupported_channel_description": "The provided channel is not supported",
 	"error.notificationservice.update_not_allowed": "Update not allowed",
 	"error.notificationservice.update_not_allowed_description": "Updating the sender type is not allowed",
+	"error.oudeletionservice.declarative_resource": "Cannot delete declarative resource",
+	"error.oudeletionservice.declarative_resource_description": "The organization unit or one of its child organization units is declarative and cannot be deleted",
+	"error.oudeletionservice.invalid_request_format": "Invalid request format",
+	"error.oudeletionservice.invalid_request_format_description": "The request body is malformed or contains invalid data",
+	"error.oudeletionservice.job_conflict": "Deletion already in progress",
+	"error.oudeletionservice.job_conflict_description": "The organization unit already has an active deletion job",
+	"error.oudeletionservice.job_not_cancellable": "Deletion job cannot be cancelled",
+	"error.oudeletionservice.job_not_cancellable_description": "The deletion job has already finished and cannot be cancelled",
+	"error.oudeletionservice.job_not_found": "Deletion job not found",
+	"error.oudeletionservice.job_not_found_description": "The requested organization unit deletion job could not be found",
+	"error.oudeletionservice.missing_ou_id": "Missing organization unit ID",
+	"error.oudeletionservice.missing_ou_id_description": "The organization unit ID to delete must be provided",
+	"error.oudeletionservice.ou_not_found": "Organization unit not found",
+	"error.oudeletionservice.ou_not_found_description": "The organization unit to delete could not be found",
+	"error.ouprovisioningservice.change_rate_exceeded": "Change rate exceeded",
+	"error.ouprovisioningservice.change_rate_exceeded_description": "The feed retires more organization units than the configured change rate threshold allows",
+	"error.ouprovisioningservice.invalid_feed": "Invalid department feed",
+	"error.ouprovisioningservice.invalid_feed_description": "The department feed is invalid",
+	"error.ouprovisioningservice.invalid_request_format": "Invalid request format",
+	"error.ouprovisioningservice.invalid_request_format_description": "The request body or query parameters are malformed or contain invalid data",
+	"error.ouprovisioningservice.provisioning_not_configured": "Provisioning not configured",
+	"error.ouprovisioningservice.provisioning_not_configured_description": "Organization unit provisioning requires a root organization unit to be configured",
+	"error.ouprovisioningservice.root_organization_unit_not_found": "Organization unit not found",
+	"error.ouprovisioningservice.root_organization_unit_not_found_description": "The root or retired organization unit configured for provisioning does not exist",
 	"error.ouservice.cannot_modify_declarative_resource": "Cannot modify declarative resource",
 	"error.ouservice.cannot_modify_declarative_resource_description": "The organization unit is declarative and cannot be modified or deleted",
 	"error.ouservice.circular_dependency_detected": "Circular dependency detected",
 	"error.ouservice.circular_dependency_detected_description": "Setting this parent would create a circular dependency",
 	"error.ouservice.invalid_filter": "Invalid filter parameter",
 	"error.ouservice.invalid_filter_description": "The filter parameter is invalid. Use format: attribute (eq|gt|lt) \"value\"",
+	"error.ouservice.invalid_default_user_type": "Invalid default user type",
+	"error.ouservice.invalid_default_user_type_description": "The default user type must be a user type name without leading or trailing spaces",
 	"error.ouservice.invalid_handle_path": "Invalid handle path",
 	"error.ouservice.invalid_handle_path_description": "The specified handle path does not exist",
 	"error.ouservice.invalid_limit_parameter": "Invalid limit parameter",
 	"error.ouservice.invalid_limit_parameter_description": "The limit parameter must be a positive integer",
+	"error.ouservice.invalid_locale": "Invalid locale",
+	"error.ouservice.invalid_locale_description": "The locale must be a canonical BCP 47 language tag (e.g., 'en', 'fr-CA')",
 	"error.ouservice.invalid_offset_parameter": "Invalid offset parameter",
 	"error.ouservice.invalid_offset_parameter_description": "The offset parameter must be a non-negative integer",
 	"error.ouservice.invalid_request_format": "Invalid request format",
 	"error.ouservice.invalid_request_format_description": "The request body is malformed, contains invalid data, or required fields are missing/empty",
+	"error.ouservice.invalid_zoneinfo": "Invalid zoneinfo",
+	"error.ouservice.invalid_zoneinfo_description": "The zoneinfo must be an IANA time zone name (e.g., 'Europe/Paris')",
 	"error.ouservice.missing_ou_id": "Invalid request format",
 	"error.ouservice.missing_ou_id_description": "Organization unit ID is required",
 	"error.ouservice.organization_unit_handle_conflict": "Organization unit handle conflict",
@@ -780,6 +1086,64 @@ var defaultMessages = map[string]string{
 	"error.passkeyservice.session_expired_description": "The session has expired. Please start a new session",
 	"error.passkeyservice.user_not_found": "User not found",
 	"error.passkeyservice.user_not_found_description": "The specified user was not found",
+	"error.passwordpolicyservice.invalid_policy": "Invalid password policy",
+	"error.passwordpolicyservice.invalid_policy_description": "The password policy must not have negative values, more than 4 character classes or empty credential attribute names",
+	"error.passwordpolicyservice.invalid_request_format": "Invalid request format",
+	"error.passwordpolicyservice.invalid_request_format_description": "The request body is malformed or contains invalid data",
+	"error.passwordpolicyservice.organization_unit_not_found": "Organization unit not found",
+	"error.passwordpolicyservice.organization_unit_not_found_description": "The organization unit with the specified id does not exist",
+	"error.passwordpolicyservice.policy_not_found": "Password policy not found",
+	"error.passwordpolicyservice.policy_not_found_description": "No password policy is set for the tenant or the organization unit",
+	"error.protocoltraceservice.invalid_limit_parameter": "Invalid limit parameter",
+	"error.protocoltraceservice.invalid_limit_parameter_description": "The limit parameter must be a positive integer",
+	"error.protocoltraceservice.invalid_offset_parameter": "Invalid offset parameter",
+	"error.protocoltraceservice.invalid_offset_parameter_description": "The offset parameter must be a non-negative integer",
+	"error.quotaservice.invalid_limit": "Invalid limit",
+	"error.quotaservice.invalid_limit_description": "The limit of a quota must not be negative",
+	"error.quotaservice.invalid_request_format": "Invalid request format",
+	"error.quotaservice.invalid_request_format_description": "The request body is malformed or contains invalid data",
+	"error.quotaservice.invalid_resource_type": "Invalid resource type",
+	"error.quotaservice.invalid_resource_type_description": "The resource type must be one of users, groups or applications, or flows for the tenant, and must not be repeated",
+	"error.quotaservice.organization_unit_not_found": "Organization unit not found",
+	"error.quotaservice.organization_unit_not_found_description": "The organization unit with the specified id does not exist",
+	"error.reencryptionservice.job_conflict": "Re-encryption already in progress",
+	"error.reencryptionservice.job_conflict_description": "Another re-encryption job is already active",
+	"error.reencryptionservice.job_not_found": "Re-encryption job not found",
+	"error.reencryptionservice.job_not_found_description": "The requested re-encryption job could not be found",
+	"error.reencryptionservice.job_not_resumable": "Re-encryption job cannot be resumed",
+	"error.reencryptionservice.job_not_resumable_description": "Only a failed re-encryption job can be resumed",
+	"error.reindexservice.indexed_attributes_changed": "Indexed attributes changed",
+	"error.reindexservice.indexed_attributes_changed_description": "The indexed attributes changed after the job was created. Start a new reindex job",
+	"error.reindexservice.job_conflict": "Reindex already in progress",
+	"error.reindexservice.job_conflict_description": "Another reindex job is already active",
+	"error.reindexservice.job_not_found": "Reindex job not found",
+	"error.reindexservice.job_not_found_description": "The requested reindex job could not be found",
+	"error.reindexservice.job_not_resumable": "Reindex job cannot be resumed",
+	"error.reindexservice.job_not_resumable_description": "Only a failed reindex job can be resumed",
+	"error.relationshipservice.empty_write_request": "Empty write request",
+	"error.relationshipservice.empty_write_request_description": "At least one relationship tuple must be written or deleted",
+	"error.relationshipservice.invalid_limit_parameter": "Invalid limit parameter",
+	"error.relationshipservice.invalid_limit_parameter_description": "The limit parameter must be a positive integer",
+	"error.relationshipservice.invalid_offset_parameter": "Invalid offset parameter",
+	"error.relationshipservice.invalid_offset_parameter_description": "The offset parameter must be a non-negative integer",
+	"error.relationshipservice.invalid_relationship": "Invalid relationship",
+	"error.relationshipservice.invalid_relationship_description": "Object type, object ID, relation, subject type and subject ID are required, and types and relations may contain only lowercase letters, digits, underscores and hyphens",
+	"error.relationshipservice.invalid_request_format": "Invalid request format",
+	"error.relationshipservice.invalid_request_format_description": "The request body is malformed or contains invalid data",
+	"error.relationshipservice.too_many_relationships": "Too many relationships",
+	"error.relationshipservice.too_many_relationships_description": "The request writes or deletes more relationship tuples than allowed in a single request",
+	"error.request.body_too_large": "Request body too large",
+	"error.request.body_too_large_description": "The request body exceeds the maximum size allowed for this resource",
+	"error.request.invalid_body": "Invalid request body",
+	"error.request.invalid_body_description": "The request body could not be read",
+	"error.request.invalid_yaml_body": "Invalid YAML request body",
+	"error.request.invalid_yaml_body_description": "The YAML request body could not be parsed",
+	"error.request.json_too_complex": "JSON payload too complex",
+	"error.request.json_too_complex_description": "The JSON request body exceeds the maximum nesting depth or array length",
+	"error.request.server_overloaded": "Server overloaded",
+	"error.request.server_overloaded_description": "The server is busy. Retry after the time given in the Retry-After header",
+	"error.request.timeout": "Request timed out",
+	"error.request.timeout_description": "The request could not be completed within the time allowed for this resource",
 	"error.resourceservice.action_not_found": "Action not found",
 	"error.resourceservice.action_not_found_description": "The action with the specified id does not exist",
 	"error.resourceservice.cannot_delete": "Cannot delete",
@@ -792,6 +1156,8 @@ var defaultMessages = map[string]string{
 	"error.resourceservice.cannot_modify_declarative_resource_server_description": "Resource server %s is defined in declarative configuration and cannot be modified",
 	"error.resourceservice.circular_dependency_detected": "Circular dependency detected",
 	"error.resourceservice.circular_dependency_detected_description": "Setting this parent would create a circular dependency",
+	"error.resourceservice.cyclic_scope_implication": "Cyclic scope implication",
+	"error.resourceservice.cyclic_scope_implication_description": "Scope %s implies itself through the scope implications",
 	"error.resourceservice.delimiter_conflict_in_handle": "Delimiter conflict in handle",
 	"error.resourceservice.delimiter_conflict_in_handle_description": "Handle cannot contain the delimiter character",
 	"error.resourceservice.delimiter_conflict_in_resource_server_handle": "Delimiter conflict in handle",
@@ -810,6 +1176,10 @@ var defaultMessages = map[string]string{
 	"error.resourceservice.invalid_offset_parameter_description": "The offset parameter must be a non-negative integer",
 	"error.resourceservice.invalid_request_format": "Invalid request format",
 	"error.resourceservice.invalid_request_format_description": "The request body is malformed or contains invalid data",
+	"error.resourceservice.invalid_scope_implication": "Invalid scope implication",
+	"error.resourceservice.invalid_scope_implication_description": "Scope implications must use valid scopes, with a wildcard only as the last segment",
+	"error.resourceservice.invalid_token_format": "Invalid token format",
+	"error.resourceservice.invalid_token_format_description": "Token format must be one of: at+jwt, jwt",
 	"error.resourceservice.missing_id": "Invalid request format",
 	"error.resourceservice.missing_id_description": "ID is required",
 	"error.resourceservice.name_conflict": "Name conflict",
@@ -852,6 +1222,8 @@ var defaultMessages = map[string]string{
 	"error.roleservice.invalid_request_format": "Invalid request format",
 	"error.roleservice.invalid_request_format_description": "The request body is malformed or contains invalid data",
 	"error.roleservice.missing_entity_or_groups": "Invalid request format",
+	"error.roleservice.missing_role_handle": "Invalid request format",
+	"error.roleservice.missing_role_handle_description": "Role name and organization unit ID are required",
 	"error.roleservice.missing_role_id": "Invalid request format",
 	"error.roleservice.missing_role_id_description": "Role ID is required",
 	"error.roleservice.organization_unit_not_found": "Organization unit not found",
@@ -864,8 +1236,76 @@ var defaultMessages = map[string]string{
 	"error.roleservice.role_name_conflict_description": "A role with the same name exists under the same organization unit",
 	"error.roleservice.role_not_found": "Role not found",
 	"error.roleservice.role_not_found_description": "The role with the specified id does not exist",
+	"error.scimservice.bulk_payload_too_large": "Payload too large",
+	"error.scimservice.bulk_payload_too_large_description": "The bulk request body exceeds the maximum payload size",
+	"error.scimservice.invalid_bulk_operation": "Invalid bulk operation",
+	"error.scimservice.invalid_bulk_operation_description": "The bulk operation has an unsupported method or path, or a POST without a unique bulkId",
+	"error.scimservice.invalid_filter": "Invalid filter",
+	"error.scimservice.invalid_filter_description": "The filter cannot be parsed or uses an unsupported operator or attribute",
+	"error.scimservice.invalid_path": "Invalid path",
+	"error.scimservice.invalid_path_description": "An attribute path cannot be parsed or is not supported",
+	"error.scimservice.invalid_request_format": "Invalid request format",
+	"error.scimservice.invalid_request_format_description": "The request body is malformed or contains invalid data",
+	"error.scimservice.invalid_value": "Invalid value",
+	"error.scimservice.invalid_value_description": "A value is not valid for its attribute",
+	"error.scimservice.missing_display_name": "Missing display name",
+	"error.scimservice.missing_display_name_description": "The group must have a display name",
+	"error.scimservice.mutability": "Read-only attribute",
+	"error.scimservice.mutability_description": "The request modifies an attribute that cannot be modified",
+	"error.scimservice.no_target": "No target",
+	"error.scimservice.no_target_description": "The patch operation does not specify the attribute to modify",
+	"error.scimservice.too_many_bulk_operations": "Invalid number of operations",
+	"error.scimservice.too_many_bulk_operations_description": "The bulk request must have between 1 and 1000 operations",
+	"error.scimservice.too_many_operations": "Invalid number of operations",
+	"error.scimservice.too_many_operations_description": "The patch request must have between 1 and 100 operations",
+	"error.scimservice.unresolved_bulk_id": "Unresolved bulkId",
+	"error.scimservice.unresolved_bulk_id_description": "The operation refers to a bulkId that no earlier operation created a resource for",
+	"error.sharedsignalsservice.invalid_max_events": "Invalid maxEvents parameter",
+	"error.sharedsignalsservice.invalid_max_events_description": "The maxEvents parameter must be a non-negative integer",
+	"error.sharedsignalsservice.invalid_request_format": "Invalid request format",
+	"error.sharedsignalsservice.invalid_request_format_description": "The request body is malformed or contains invalid data",
+	"error.sharedsignalsservice.receiver_not_authorized": "Receiver not authorized",
+	"error.sharedsignalsservice.receiver_not_authorized_description": "The caller is not a configured poll receiver of security events",
 	"error.templateservice.template_not_found": "Template not found",
 	"error.templateservice.template_not_found_description": "The requested template does not exist for the given scenario",
+	"error.tenantservice.invalid_request_format": "Invalid request format",
+	"error.tenantservice.invalid_request_format_description": "The request body is malformed or contains invalid data",
+	"error.tenantservice.invalid_tenant_handle": "Invalid tenant handle",
+	"error.tenantservice.invalid_tenant_handle_description": "The tenant handle must contain only lowercase letters, digits and hyphens",
+	"error.tenantservice.invalid_tenant_id": "Invalid tenant ID",
+	"error.tenantservice.invalid_tenant_id_description": "The provided tenant ID is invalid or empty",
+	"error.tenantservice.invalid_tenant_name": "Invalid tenant name",
+	"error.tenantservice.invalid_tenant_name_description": "The provided tenant name is invalid or empty",
+	"error.tenantservice.tenant_handle_conflict": "Tenant handle conflict",
+	"error.tenantservice.tenant_handle_conflict_description": "A tenant with the same handle already exists",
+	"error.tenantservice.tenant_hostname_conflict": "Tenant hostname conflict",
+	"error.tenantservice.tenant_hostname_conflict_description": "Another tenant is already bound to the provided hostname",
+	"error.tenantservice.tenant_management_not_allowed": "Tenant management not allowed",
+	"error.tenantservice.tenant_management_not_allowed_description": "Tenants can only be managed outside of a tenant scope",
+	"error.tenantservice.tenant_mismatch": "Tenant mismatch",
+	"error.tenantservice.tenant_mismatch_description": "The access token was not issued for the requested tenant",
+	"error.tenantservice.tenant_not_found": "Tenant not found",
+	"error.tenantservice.tenant_not_found_description": "The requested tenant could not be found",
+	"error.tokenexplainservice.client_not_found": "Client not found",
+	"error.tokenexplainservice.client_not_found_description": "No OAuth client exists with the given client ID",
+	"error.tokenexplainservice.invalid_grant_type": "Invalid grant type",
+	"error.tokenexplainservice.invalid_grant_type_description": "The grantType field must be a supported grant type",
+	"error.tokenexplainservice.invalid_request_format": "Invalid request format",
+	"error.tokenexplainservice.invalid_request_format_description": "The request body is malformed or contains invalid data",
+	"error.tokenexplainservice.invalid_subject": "Invalid subject",
+	"error.tokenexplainservice.invalid_subject_description": "The userId field is required for user grants and must be omitted for the client_credentials grant",
+	"error.tokenexplainservice.missing_client_id": "Missing client ID",
+	"error.tokenexplainservice.missing_client_id_description": "The clientId field is required",
+	"error.tokenexplainservice.user_not_found": "User not found",
+	"error.tokenexplainservice.user_not_found_description": "No user exists with the given user ID",
+	"error.trusteddeviceservice.authentication_failed": "Authentication failed",
+	"error.trusteddeviceservice.authentication_failed_description": "The caller could not be identified",
+	"error.trusteddeviceservice.device_not_found": "Trusted device not found",
+	"error.trusteddeviceservice.device_not_found_description": "The requested trusted device could not be found",
+	"error.trusteddeviceservice.missing_user_id": "Missing user ID",
+	"error.trusteddeviceservice.missing_user_id_description": "The user ID must be provided",
+	"error.trusteddeviceservice.user_not_found": "User not found",
+	"error.trusteddeviceservice.user_not_found_description": "The user could not be found",
 	"error.unauthorized": "Unauthorized",
 	"error.unauthorized_description": "The caller is not authorized to perform this operation",
 	"error.userinfoservice.client_credentials_not_supported": "Invalid access token",
@@ -876,18 +1316,40 @@ var defaultMessages = map[string]string{
 	"error.userinfoservice.invalid_access_token_description": "The access token is invalid, expired, or malformed",
 	"error.userinfoservice.missing_sub_claim": "Invalid access token",
 	"error.userinfoservice.missing_sub_claim_description": "The access token is missing or has an invalid 'sub' claim",
+	"error.userprovisioningservice.invalid_provisioning_request": "Invalid provisioning request",
+	"error.userprovisioningservice.invalid_provisioning_request_description": "The provisioning request is invalid",
+	"error.userprovisioningservice.invalid_request_format": "Invalid request format",
+	"error.userprovisioningservice.invalid_request_format_description": "The request body is malformed or contains invalid data",
+	"error.userprovisioningservice.invitation_not_configured": "Invitation not configured",
+	"error.userprovisioningservice.invitation_not_configured_description": "Invitations cannot be sent because email is not configured",
+	"error.userprovisioningservice.missing_invitation_email": "Missing email address",
+	"error.userprovisioningservice.missing_invitation_email_description": "An invitation requires the user to have an email address",
+	"error.userprovisioningservice.provisioning_failed": "Provisioning failed",
+	"error.userprovisioningservice.provisioning_failed_description": "The user was not provisioned and no changes were made",
 	"error.userservice.ambiguous_user": "Ambiguous user",
 	"error.userservice.ambiguous_user_description": "Multiple users match the provided filters",
+	"error.userservice.ambiguous_user_type": "Ambiguous user type",
+	"error.userservice.ambiguous_user_type_description": "Several user types are available to the organization unit and none is its default. Specify the user type or set a default user type on the organization unit",
 	"error.userservice.attribute_conflict": "Attribute conflict",
 	"error.userservice.attribute_conflict_description": "A user with the same unique attribute value already exists",
 	"error.userservice.authentication_failed": "Authentication failed",
 	"error.userservice.authentication_failed_description": "Invalid credentials provided",
 	"error.userservice.cannot_modify_declarative_resource": "Cannot modify declarative resource",
 	"error.userservice.cannot_modify_declarative_resource_description": "The user is declarative and cannot be modified or deleted",
+	"error.userservice.credential_not_found": "Credential not found",
+	"error.userservice.credential_not_found_description": "The user does not have a credential of the given type",
+	"error.userservice.credential_policy_violation": "Credential policy violation",
+	"error.userservice.credential_policy_violation_description": "The credentials of the user would not satisfy the credential policy of the user type",
+	"error.userservice.denied_credential": "Credential not allowed",
+	"error.userservice.denied_credential_description": "The credential is not allowed in this deployment",
+	"error.userservice.denied_identifier": "Identifier not allowed",
+	"error.userservice.denied_identifier_description": "The user identifier is not allowed in this deployment",
 	"error.userservice.email_conflict": "Email conflict",
 	"error.userservice.email_conflict_description": "A user with the same email already exists",
 	"error.userservice.handle_path_required": "Handle path required",
 	"error.userservice.handle_path_required_description": "Handle path is required for this operation",
+	"error.userservice.invalid_attribute_projection": "Invalid attribute projection",
+	"error.userservice.invalid_attribute_projection_description": "The attributes and excludedAttributes parameters are invalid or used together",
 	"error.userservice.invalid_credential": "Invalid request format",
 	"error.userservice.invalid_credential_description": "Invalid credential fields in request",
 	"error.userservice.invalid_filter_parameter": "Invalid filter parameter",
@@ -902,8 +1364,14 @@ var defaultMessages = map[string]string{
 	"error.userservice.invalid_offset_parameter_description": "The offset parameter must be a non-negative integer",
 	"error.userservice.invalid_organization_unit": "Invalid organization unit",
 	"error.userservice.invalid_organization_unit_description": "Organization unit id must be specified as a valid UUID",
+	"error.userservice.invalid_picture": "Invalid picture",
+	"error.userservice.invalid_picture_description": "The picture must be a valid JPEG, PNG or GIF image",
+	"error.userservice.invalid_picture_signature": "Invalid picture URL",
+	"error.userservice.invalid_picture_signature_description": "The picture URL signature is invalid or has expired",
 	"error.userservice.invalid_request_format": "Invalid request format",
 	"error.userservice.invalid_request_format_description": "The request body is malformed or contains invalid data",
+	"error.userservice.invalid_user_status": "Invalid user status",
+	"error.userservice.invalid_user_status_description": "The status filter must be 'deleted'",
 	"error.userservice.missing_credentials": "Missing credentials",
 	"error.userservice.missing_credentials_description": "At least one credential field must be provided",
 	"error.userservice.missing_required_fields": "Missing required fields",
@@ -914,12 +1382,47 @@ var defaultMessages = map[string]string{
 	"error.userservice.organization_unit_mismatch_description": "The organization unit does not match the user type configuration",
 	"error.userservice.organization_unit_not_found": "Organization unit not found",
 	"error.userservice.organization_unit_not_found_description": "The specified organization unit does not exist",
+	"error.userservice.password_breached_description": "The password has appeared in a data breach. Choose a different password",
+	"error.userservice.password_in_dictionary_description": "The password is a common password or contains details of the user",
+	"error.userservice.password_policy_violation": "Password policy violation",
+	"error.userservice.password_policy_violation_description": "The password does not satisfy the password policy",
+	"error.userservice.password_reused_description": "The password matches one of the recent passwords of the user",
+	"error.userservice.password_too_short_description": "The password is shorter than the minimum length of the password policy",
+	"error.userservice.password_too_simple_description": "The password does not use enough kinds of characters. Mix lowercase and uppercase letters, digits and symbols",
+	"error.userservice.picture_not_found": "Picture not found",
+	"error.userservice.picture_not_found_description": "The user does not have a picture",
+	"error.userservice.picture_too_large": "Picture too large",
+	"error.userservice.picture_too_large_description": "The picture exceeds the maximum allowed size",
+	"error.userservice.quota_exceeded": "Quota exceeded",
+	"error.userservice.quota_exceeded_description": "The user quota of the organization unit or the tenant has been reached",
 	"error.userservice.schema_validation_failed": "Schema validation failed",
 	"error.userservice.schema_validation_failed_description": "User attributes do not conform to the required schema",
+	"error.userservice.user_not_deleted": "User not deleted",
+	"error.userservice.user_not_deleted_description": "Only a deleted user can be restored",
 	"error.userservice.user_not_found": "User not found",
 	"error.userservice.user_not_found_description": "The user with the specified id does not exist",
 	"error.userservice.user_type_not_found": "User type not found",
 	"error.userservice.user_type_not_found_description": "The specified user type does not exist",
+	"error.userservice.user_type_not_resolved": "User type not resolved",
+	"error.userservice.user_type_not_resolved_description": "No user type is available to the organization unit. Specify the user type",
+	"error.wso2migrationservice.invalid_request_format": "Invalid request format",
+	"error.wso2migrationservice.invalid_request_format_description": "The request body is malformed or contains invalid data",
+	"error.wso2migrationservice.missing_ou_id": "Missing organization unit ID",
+	"error.wso2migrationservice.missing_ou_id_description": "The organization unit to migrate the resources to must be provided",
+	"error.wso2migrationservice.missing_user_type": "Missing user type",
+	"error.wso2migrationservice.missing_user_type_description": "The user type to migrate the users to must be provided",
+	"error.wso2migrationservice.nothing_to_migrate": "Nothing to migrate",
+	"error.wso2migrationservice.nothing_to_migrate_description": "At least one service provider, user or role must be provided",
+	"error.wso2migrationservice.ou_not_found": "Organization unit not found",
+	"error.wso2migrationservice.ou_not_found_description": "The organization unit to migrate the resources to does not exist",
+	"error.wso2migrationservice.too_many_items": "Too many resources",
+	"error.wso2migrationservice.too_many_items_description": "A migration request can carry at most 1000 resources",
+	"error.wso2migrationservice.user_type_not_found": "User type not found",
+	"error.wso2migrationservice.user_type_not_found_description": "The user type to migrate the users to does not exist",
+	"error.webhookservice.invalid_from_parameter": "Invalid from parameter",
+	"error.webhookservice.invalid_from_parameter_description": "The from parameter must be an RFC 3339 time that is not in the future",
+	"error.webhookservice.subscription_not_found": "Webhook subscription not found",
+	"error.webhookservice.subscription_not_found_description": "The requested webhook subscription could not be found",
 	"layout.error.already_exists": "Layout already exists",
 	"layout.error.already_exists_description": "A layout with the same ID already exists",
 	"layout.error.cannot_delete_declarative": "Cannot delete declarative layout",
@@ -157,7 +157,7 @@ type userAdapter interface {
 	CreateUser(ctx context.Context, user *user.User) (*user.User, *serviceerror.ServiceError)
 	GetUser(ctx context.Context, userID string, includeDisplay bool) (*user.User, *serviceerror.ServiceError)
 	UpdateUser(ctx context.Context, userID string, user *user.User) (*user.User, *serviceerror.ServiceError)
-	DeleteUser(ctx context.Context, userID string) *serviceerror.ServiceError
+	PurgeUser(ctx context.Context, userID string) *serviceerror.ServiceError
 	UpdateUserCredentials(ctx context.Context, userID string, credentials json.RawMessage) *serviceerror.ServiceError
 }
 
@@ -802,6 +802,8 @@ func applicationRequestToDTO(req *appmodel.ApplicationRequestWithID) *appmodel.A
 			Assertion:                 req.Assertion,
 			LoginConsent:              req.LoginConsent,
 			AllowedUserTypes:          req.AllowedUserTypes,
+			AllowedAuthMethods:        req.AllowedAuthMethods,
+			UserIsolation:             req.UserIsolation,
 			Certificate:               req.Certificate,
 		},
 		Template:  req.Template,
@@ -826,18 +828,29 @@ func applicationRequestToDTO(req *appmodel.ApplicationRequestWithID) *appmodel.A
 					ClientID:                           config.OAuthConfig.ClientID,
 					ClientSecret:                       config.OAuthConfig.ClientSecret,
 					RedirectURIs:                       config.OAuthConfig.RedirectURIs,
+					RedirectURIMatchMode:               config.OAuthConfig.RedirectURIMatchMode,
 					GrantTypes:                         config.OAuthConfig.GrantTypes,
 					ResponseTypes:                      config.OAuthConfig.ResponseTypes,
 					TokenEndpointAuthMethod:            config.OAuthConfig.TokenEndpointAuthMethod,
 					PKCERequired:                       config.OAuthConfig.PKCERequired,
 					PublicClient:                       config.OAuthConfig.PublicClient,
 					RequirePushedAuthorizationRequests: config.OAuthConfig.RequirePushedAuthorizationRequests,
+					DPoPBoundAccessTokens:              config.OAuthConfig.DPoPBoundAccessTokens,
+					AllowCredentialDiscovery:           config.OAuthConfig.AllowCredentialDiscovery,
+					ProtocolTraceEnabled:               config.OAuthConfig.ProtocolTraceEnabled,
+					RequestObjectSigningAlg:            config.OAuthConfig.RequestObjectSigningAlg,
 					Token:                              config.OAuthConfig.Token,
 					Scopes:                             config.OAuthConfig.Scopes,
+					AllowedScopes:                      config.OAuthConfig.AllowedScopes,
+					RejectDisallowedScopes:             config.OAuthConfig.RejectDisallowedScopes,
+					AllowedScopesByGrantType:           config.OAuthConfig.AllowedScopesByGrantType,
+					Logout:                             config.OAuthConfig.Logout,
 					UserInfo:                           config.OAuthConfig.UserInfo,
 					ScopeClaims:                        config.OAuthConfig.ScopeClaims,
 					Certificate:                        config.OAuthConfig.Certificate,
 					AcrValues:                          config.OAuthConfig.AcrValues,
+					ClaimFallbacks:                     config.OAuthConfig.ClaimFallbacks,
+					TokenCustomization:                 config.OAuthConfig.TokenCustomization,
 				},
 			})
 		}
@@ -105,6 +105,9 @@ func (s *importService) importOrganizationUnit(
 		TosURI:          req.TosURI,
 		PolicyURI:       req.PolicyURI,
 		CookiePolicyURI: req.CookiePolicyURI,
+		Locale:          req.Locale,
+		Zoneinfo:        req.Zoneinfo,
+		DefaultUserType: req.DefaultUserType,
 	}
 	updateReq := createReq
 
@@ -673,7 +676,7 @@ func (s *importService) importUser(
 			created.ID,
 			json.RawMessage(credentialsJSON),
 		); credErr != nil {
-			if rollbackErr := s.userService.DeleteUser(ctx, created.ID); rollbackErr != nil {
+			if rollbackErr := s.userService.PurgeUser(ctx, created.ID); rollbackErr != nil {
 				combinedErr := &serviceerror.ServiceError{
 					Code: credErr.Code,
 					Type: credErr.Type,
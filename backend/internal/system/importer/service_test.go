@@ -566,7 +566,7 @@ func (f *fakeUserService) UpdateUser(
 	return &updated, nil
 }
 
-func (f *fakeUserService) DeleteUser(_ context.Context, userID string) *serviceerror.ServiceError {
+func (f *fakeUserService) PurgeUser(_ context.Context, userID string) *serviceerror.ServiceError {
 	f.deleted = append(f.deleted, userID)
 	return nil
 }
@@ -24,6 +24,7 @@ import (
 	"crypto/ecdh"
 	"crypto/ed25519"
 	"crypto/rsa"
+	"crypto/sha256"
 	"encoding/base64"
 	"encoding/json"
 	"errors"
@@ -95,6 +96,44 @@ func JWKToPublicKey(jwk map[string]interface{}) (crypto.PublicKey, error) {
 	}
 }
 
+// JWKThumbprint computes the RFC 7638 SHA-256 thumbprint of a public JWK, base64url-encoded without padding.
+// Only the required members of the key type take part in the thumbprint.
+func JWKThumbprint(jwk map[string]interface{}) (string, error) {
+	kty, ok := jwk["kty"].(string)
+	if !ok {
+		return "", errors.New("JWK missing kty")
+	}
+
+	var members []string
+	switch kty {
+	case "RSA":
+		members = []string{"e", "kty", "n"}
+	case "EC":
+		members = []string{"crv", "kty", "x", "y"}
+	case "OKP":
+		members = []string{"crv", "kty", "x"}
+	default:
+		return "", fmt.Errorf("unsupported JWK kty: %s", kty)
+	}
+
+	required := make(map[string]string, len(members))
+	for _, member := range members {
+		value, ok := jwk[member].(string)
+		if !ok || value == "" {
+			return "", fmt.Errorf("JWK missing required member %s", member)
+		}
+		required[member] = value
+	}
+
+	// Maps are marshaled with sorted keys and no whitespace, which is the canonical form of RFC 7638.
+	canonical, err := json.Marshal(required)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal JWK members: %w", err)
+	}
+	sum := sha256.Sum256(canonical)
+	return base64.RawURLEncoding.EncodeToString(sum[:]), nil
+}
+
 // jwkToRSAPublicKey converts a JWK to an RSA public key.
 func jwkToRSAPublicKey(jwk map[string]interface{}) (*rsa.PublicKey, error) {
 	nStr, nOK := jwk["n"].(string)
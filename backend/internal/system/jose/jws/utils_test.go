@@ -412,3 +412,35 @@ func (suite *JWSUtilsTestSuite) TestJWKToPublicKeyInvalidEC() {
 	assert.Contains(suite.T(), err.Error(), "point not on curve")
 	assert.Nil(suite.T(), publicKey)
 }
+
+func (suite *JWSUtilsTestSuite) TestJWKThumbprintRFC7638Example() {
+	// Example key and thumbprint from RFC 7638 section 3.1. Optional members are ignored.
+	jwk := map[string]interface{}{
+		"kty": "RSA",
+		"n": "0vx7agoebGcQSuuPiLJXZptN9nndrQmbXEps2aiAFbWhM78LhWx4cbbfAAtVT86zwu1RK7aPFFxuhDR1L6tSoc_BJECPebWKRXjB" +
+			"ZCiFV4n3oknjhMstn64tZ_2W-5JsGY4Hc5n9yBXArwl93lqt7_RN5w6Cf0h4QyQ5v-65YGjQR0_FDW2QvzqY368QQMicAtaSqzs8" +
+			"KJZgnYb9c7d0zgdAZHzu6qMQvRL5hajrn1n91CbOpbISD08qNLyrdkt-bFTWhAI4vMQFh6WeZu0fM4lFd2NcRwr3XPksINHaQ-G_" +
+			"xBniIqbw0Ls1jF44-csFCur-kEgU8awapJzKnqDKgw",
+		"e":   "AQAB",
+		"alg": "RS256",
+		"kid": "2011-04-29",
+	}
+
+	thumbprint, err := JWKThumbprint(jwk)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "NzbLsXh8uDCcd-6MNwXF4W_7noWXFZAfHkxZsRGC9Xs", thumbprint)
+}
+
+func (suite *JWSUtilsTestSuite) TestJWKThumbprintMissingMember() {
+	_, err := JWKThumbprint(map[string]interface{}{"kty": "EC", "crv": "P-256", "x": "abc"})
+
+	assert.Error(suite.T(), err)
+	assert.Contains(suite.T(), err.Error(), "missing required member y")
+}
+
+func (suite *JWSUtilsTestSuite) TestJWKThumbprintUnsupportedKeyType() {
+	_, err := JWKThumbprint(map[string]interface{}{"kty": "oct", "k": "secret"})
+
+	assert.Error(suite.T(), err)
+}
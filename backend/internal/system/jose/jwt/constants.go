@@ -24,4 +24,15 @@ const (
 
 	// TokenTypeAccessToken is the JWT type header value for access tokens as defined in RFC 9068.
 	TokenTypeAccessToken = "at+jwt"
+
+	// TokenTypeLogoutToken is the JWT type header value for back-channel logout tokens as defined in
+	// OpenID Connect Back-Channel Logout 1.0.
+	TokenTypeLogoutToken = "logout+jwt"
+
+	// TokenTypeRevertToken is the JWT type header value for tokens that revert a change of a user account.
+	TokenTypeRevertToken = "revert+jwt"
+
+	// TokenTypeSecurityEventToken is the JWT type header value for Security Event Tokens as defined in
+	// RFC 8417.
+	TokenTypeSecurityEventToken = "secevent+jwt"
 )
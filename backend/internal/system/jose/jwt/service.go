@@ -52,10 +52,11 @@ type JWTServiceInterface interface {
 	VerifyJWT(jwtToken string, expectedAud, expectedIss string) *serviceerror.ServiceError
 	VerifyJWTWithPublicKey(jwtToken string, jwtPublicKey crypto.PublicKey, expectedAud,
 		expectedIss string) *serviceerror.ServiceError
-	VerifyJWTWithJWKS(jwtToken, jwksURL, expectedAud, expectedIss string) *serviceerror.ServiceError
+	VerifyJWTWithJWKS(ctx context.Context, jwtToken, jwksURL, expectedAud,
+		expectedIss string) *serviceerror.ServiceError
 	VerifyJWTSignature(jwtToken string) *serviceerror.ServiceError
 	VerifyJWTSignatureWithPublicKey(jwtToken string, jwtPublicKey crypto.PublicKey) *serviceerror.ServiceError
-	VerifyJWTSignatureWithJWKS(jwtToken string, jwksURL string) *serviceerror.ServiceError
+	VerifyJWTSignatureWithJWKS(ctx context.Context, jwtToken string, jwksURL string) *serviceerror.ServiceError
 }
 
 // jwksCacheEntry holds a cached JWKS response with its expiry time.
@@ -312,14 +313,15 @@ func (js *jwtService) VerifyJWTWithPublicKey(jwtToken string, jwtPublicKey crypt
 }
 
 // VerifyJWTWithJWKS verifies the JWT token using a JWK Set (JWKS) endpoint.
+// The JWKS fetch, when not served from the cache, is bound to ctx.
 func (js *jwtService) VerifyJWTWithJWKS(
-	jwtToken, jwksURL, expectedAud, expectedIss string) *serviceerror.ServiceError {
+	ctx context.Context, jwtToken, jwksURL, expectedAud, expectedIss string) *serviceerror.ServiceError {
 	parts := strings.Split(jwtToken, ".")
 	if len(parts) != 3 {
 		return &ErrorInvalidJWTFormat
 	}
 
-	if err := js.VerifyJWTSignatureWithJWKS(jwtToken, jwksURL); err != nil {
+	if err := js.VerifyJWTSignatureWithJWKS(ctx, jwtToken, jwksURL); err != nil {
 		return &ErrorInvalidTokenSignature
 	}
 
@@ -387,7 +389,9 @@ func (js *jwtService) VerifyJWTSignatureWithPublicKey(jwtToken string,
 }
 
 // VerifyJWTSignatureWithJWKS verifies the signature of a JWT token using a JWK Set (JWKS) endpoint.
-func (js *jwtService) VerifyJWTSignatureWithJWKS(jwtToken string, jwksURL string) *serviceerror.ServiceError {
+// The JWKS fetch, when not served from the cache, is bound to ctx.
+func (js *jwtService) VerifyJWTSignatureWithJWKS(
+	ctx context.Context, jwtToken string, jwksURL string) *serviceerror.ServiceError {
 	// Get the key ID from the JWT header
 	header, err := DecodeJWTHeader(jwtToken)
 	if err != nil {
@@ -400,7 +404,7 @@ func (js *jwtService) VerifyJWTSignatureWithJWKS(jwtToken string, jwksURL string
 	}
 
 	// Get JWKS keys (from cache or fetch)
-	keys, svcErr := js.getJWKSKeys(jwksURL)
+	keys, svcErr := js.getJWKSKeys(ctx, jwksURL)
 	if svcErr != nil {
 		return svcErr
 	}
@@ -433,7 +437,8 @@ func (js *jwtService) VerifyJWTSignatureWithJWKS(jwtToken string, jwksURL string
 }
 
 // getJWKSKeys returns JWKS keys for the given URL, using a TTL-based cache.
-func (js *jwtService) getJWKSKeys(jwksURL string) ([]map[string]interface{}, *serviceerror.ServiceError) {
+func (js *jwtService) getJWKSKeys(
+	ctx context.Context, jwksURL string) ([]map[string]interface{}, *serviceerror.ServiceError) {
 	if cached, ok := js.jwksCache.Load(jwksURL); ok {
 		entry := cached.(*jwksCacheEntry)
 		if time.Now().Before(entry.expiresAt) {
@@ -441,7 +446,12 @@ func (js *jwtService) getJWKSKeys(jwksURL string) ([]map[string]interface{}, *se
 		}
 	}
 
-	resp, err := js.httpClient.Get(jwksURL)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, jwksURL, nil)
+	if err != nil {
+		js.logger.Debug("Failed to build JWKS request: " + err.Error())
+		return nil, &ErrorFailedToGetJWKS
+	}
+	resp, err := js.httpClient.Do(req)
 	if err != nil {
 		js.logger.Debug("Failed to fetch JWKS from URL: " + err.Error())
 		return nil, &ErrorFailedToGetJWKS
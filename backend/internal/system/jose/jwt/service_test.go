@@ -1125,7 +1125,7 @@ func (suite *JWTServiceTestSuite) TestVerifyJWTWithJWKS() {
 		suite.T().Run(tc.name, func(t *testing.T) {
 			token, jwksURL, expectedAud, expectedIss := tc.setupFunc()
 
-			err := suite.jwtService.VerifyJWTWithJWKS(token, jwksURL, expectedAud, expectedIss)
+			err := suite.jwtService.VerifyJWTWithJWKS(context.Background(), token, jwksURL, expectedAud, expectedIss)
 
 			if tc.expectError {
 				assert.NotNil(t, err)
@@ -1455,7 +1455,7 @@ func (suite *JWTServiceTestSuite) TestVerifyJWTSignatureWithJWKS() {
 	testServer := suite.mockJWKSServer()
 	defer testServer.Close()
 
-	err = suite.jwtService.VerifyJWTSignatureWithJWKS(token, testServer.URL)
+	err = suite.jwtService.VerifyJWTSignatureWithJWKS(context.Background(), token, testServer.URL)
 	assert.Nil(suite.T(), err)
 }
 
@@ -1513,21 +1513,21 @@ func (suite *JWTServiceTestSuite) TestVerifyJWTSignatureWithJWKSUsesCache() {
 	assert.Nil(suite.T(), genErr)
 
 	// 1. First call against serverA — cache miss, one fetch.
-	assert.Nil(suite.T(), suite.jwtService.VerifyJWTSignatureWithJWKS(token, serverA.URL))
+	assert.Nil(suite.T(), suite.jwtService.VerifyJWTSignatureWithJWKS(context.Background(), token, serverA.URL))
 	assert.Equal(suite.T(), int32(1), atomic.LoadInt32(&fetchCountA),
 		"first call to serverA should fetch JWKS once")
 	assert.Equal(suite.T(), int32(0), atomic.LoadInt32(&fetchCountB),
 		"serverB should not have been touched yet")
 
 	// 2. Second call against serverA — cache hit, no additional fetch.
-	assert.Nil(suite.T(), suite.jwtService.VerifyJWTSignatureWithJWKS(token, serverA.URL))
+	assert.Nil(suite.T(), suite.jwtService.VerifyJWTSignatureWithJWKS(context.Background(), token, serverA.URL))
 	assert.Equal(suite.T(), int32(1), atomic.LoadInt32(&fetchCountA),
 		"second call to serverA should hit the cache, not re-fetch")
 
 	// 3a. First call against serverB — must miss the cache (different URL key) and
 	//     fetch independently. A buggy cache that returns any entry would skip this
 	//     fetch and the count would stay at 0.
-	assert.Nil(suite.T(), suite.jwtService.VerifyJWTSignatureWithJWKS(token, serverB.URL))
+	assert.Nil(suite.T(), suite.jwtService.VerifyJWTSignatureWithJWKS(context.Background(), token, serverB.URL))
 	assert.Equal(suite.T(), int32(1), atomic.LoadInt32(&fetchCountB),
 		"first call to serverB should fetch independently (cache is keyed by URL)")
 	assert.Equal(suite.T(), int32(1), atomic.LoadInt32(&fetchCountA),
@@ -1535,7 +1535,7 @@ func (suite *JWTServiceTestSuite) TestVerifyJWTSignatureWithJWKSUsesCache() {
 
 	// 3b. Going back to serverA must STILL be a cache hit — the serverB fetch must
 	//     not have evicted or overwritten serverA's cache entry.
-	assert.Nil(suite.T(), suite.jwtService.VerifyJWTSignatureWithJWKS(token, serverA.URL))
+	assert.Nil(suite.T(), suite.jwtService.VerifyJWTSignatureWithJWKS(context.Background(), token, serverA.URL))
 	assert.Equal(suite.T(), int32(1), atomic.LoadInt32(&fetchCountA),
 		"serverA's cache entry must survive an unrelated fetch of serverB")
 }
@@ -1556,7 +1556,7 @@ func (suite *JWTServiceTestSuite) TestVerifyJWTSignatureWithJWKSInvalidToken() {
 
 	for _, tc := range testCases {
 		suite.T().Run(tc.name, func(t *testing.T) {
-			err := suite.jwtService.VerifyJWTSignatureWithJWKS(tc.token, testServer.URL)
+			err := suite.jwtService.VerifyJWTSignatureWithJWKS(context.Background(), tc.token, testServer.URL)
 			assert.NotNil(t, err)
 		})
 	}
@@ -1572,7 +1572,7 @@ func (suite *JWTServiceTestSuite) TestVerifyJWTSignatureWithJWKSKeyIDNotFound()
 		"kid": "non-existent-key-id",
 	})
 
-	err := suite.jwtService.VerifyJWTSignatureWithJWKS(nonExistentKidJWT, testServer.URL)
+	err := suite.jwtService.VerifyJWTSignatureWithJWKS(context.Background(), nonExistentKidJWT, testServer.URL)
 	assert.NotNil(suite.T(), err)
 	assert.Equal(suite.T(), ErrorNoMatchingJWKFound, *err)
 }
@@ -1587,7 +1587,7 @@ func (suite *JWTServiceTestSuite) TestVerifyJWTSignatureWithJWKSNoKeyID() {
 		// No kid field
 	})
 
-	err := suite.jwtService.VerifyJWTSignatureWithJWKS(noKidJWT, testServer.URL)
+	err := suite.jwtService.VerifyJWTSignatureWithJWKS(context.Background(), noKidJWT, testServer.URL)
 	assert.NotNil(suite.T(), err)
 	assert.Equal(suite.T(), ErrorDecodingJWTHeader, *err)
 }
@@ -1722,7 +1722,7 @@ func (suite *JWTServiceTestSuite) TestVerifyJWTSignatureWithJWKSHTTPErrors() {
 
 			token := tc.setupToken()
 
-			err := suite.jwtService.VerifyJWTSignatureWithJWKS(token, testServer.URL)
+			err := suite.jwtService.VerifyJWTSignatureWithJWKS(context.Background(), token, testServer.URL)
 			assert.NotNil(t, err)
 			assert.Equal(t, tc.expectedError, *err)
 		})
@@ -1735,7 +1735,7 @@ func (suite *JWTServiceTestSuite) TestVerifyJWTSignatureWithJWKSNetworkError() {
 		"test-subject", testIssuer, 3600, map[string]interface{}{"aud": testAudience}, TokenTypeJWT, "")
 	assert.Nil(suite.T(), err)
 
-	err = suite.jwtService.VerifyJWTSignatureWithJWKS(token, "http://localhost:99999/invalid")
+	err = suite.jwtService.VerifyJWTSignatureWithJWKS(context.Background(), token, "http://localhost:99999/invalid")
 	assert.NotNil(suite.T(), err)
 	assert.Equal(suite.T(), ErrorFailedToGetJWKS, *err)
 }
@@ -0,0 +1,355 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package jsonstream decodes JSON documents from a stream while enforcing size and complexity limits.
+// Documents are read one token at a time so that large arrays and objects can be decoded and validated
+// element by element, and rejected at the first offending value without buffering the whole document.
+package jsonstream
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+)
+
+// Limits holds the limits enforced while decoding a JSON document. A zero limit is not enforced.
+type Limits struct {
+	// MaxDepth is the maximum nesting depth of objects and arrays.
+	MaxDepth int
+	// MaxArrayLength is the maximum number of elements of any array.
+	MaxArrayLength int
+	// MaxValues is the maximum number of values in the document, counting objects, arrays and scalars.
+	MaxValues int
+}
+
+// LimitsFromConfig returns the JSON limits of the request limits configuration.
+func LimitsFromConfig(limits config.RequestLimits) Limits {
+	return Limits{
+		MaxDepth:       limits.MaxJSONDepth,
+		MaxArrayLength: limits.MaxJSONArrayLength,
+		MaxValues:      limits.MaxJSONValues,
+	}
+}
+
+// ErrorKind classifies why a document was rejected.
+type ErrorKind int
+
+const (
+	// ErrorKindSyntax means the document is malformed or a value does not match the expected type.
+	ErrorKindSyntax ErrorKind = iota
+	// ErrorKindLimit means the document exceeds a depth, length or value count limit.
+	ErrorKindLimit
+	// ErrorKindSize means the underlying reader stopped because the body exceeds its size limit.
+	ErrorKindSize
+	// ErrorKindInvalid means the document was rejected by the validation of the caller.
+	ErrorKindInvalid
+)
+
+// Error describes why a document was rejected and the location of the offending value.
+type Error struct {
+	Kind ErrorKind
+	// Pointer is the JSON pointer (RFC 6901) of the offending value. The empty pointer is the document root.
+	Pointer string
+	Reason  string
+}
+
+// Error returns the reason followed by the location of the offending value.
+func (e *Error) Error() string {
+	if e.Pointer == "" {
+		return e.Reason + " at the document root"
+	}
+	return e.Reason + " at " + e.Pointer
+}
+
+// Decoder reads a JSON document token by token. The document is consumed with DecodeObject, DecodeArray
+// and Decode, and End checks that nothing follows it.
+type Decoder struct {
+	dec    *json.Decoder
+	limits Limits
+	path   []string
+	depth  int
+	values int
+}
+
+// NewDecoder creates a decoder reading from r.
+func NewDecoder(r io.Reader, limits Limits) *Decoder {
+	dec := json.NewDecoder(r)
+	dec.UseNumber()
+	return &Decoder{dec: dec, limits: limits}
+}
+
+// Pointer returns the JSON pointer of the value being decoded.
+func (d *Decoder) Pointer() string {
+	return pointer(d.path)
+}
+
+// Fail returns an error of the given kind for the value being decoded, or for the descendant of it
+// addressed by the given path segments.
+func (d *Decoder) Fail(kind ErrorKind, reason string, segments ...string) *Error {
+	path := append(append([]string{}, d.path...), segments...)
+	return &Error{Kind: kind, Pointer: pointer(path), Reason: reason}
+}
+
+// DecodeObject reads an object and calls fn with the name of each member. fn must consume the member
+// value with Decode, DecodeObject or DecodeArray. A null value is treated as an empty object.
+func (d *Decoder) DecodeObject(fn func(name string) error) error {
+	isNull, err := d.open('{', "object")
+	if err != nil || isNull {
+		return err
+	}
+	defer d.close()
+
+	for d.dec.More() {
+		token, err := d.token()
+		if err != nil {
+			return err
+		}
+		name, ok := token.(string)
+		if !ok {
+			return d.Fail(ErrorKindSyntax, "expected an object member name")
+		}
+		d.path = append(d.path, name)
+		if err := fn(name); err != nil {
+			return err
+		}
+		d.path = d.path[:len(d.path)-1]
+	}
+	_, err = d.token()
+	return err
+}
+
+// DecodeArray reads an array and calls fn with the index of each element. fn must consume the element
+// with Decode, DecodeObject or DecodeArray. A null value is treated as an empty array.
+func (d *Decoder) DecodeArray(fn func(index int) error) error {
+	isNull, err := d.open('[', "array")
+	if err != nil || isNull {
+		return err
+	}
+	defer d.close()
+
+	for index := 0; d.dec.More(); index++ {
+		d.path = append(d.path, strconv.Itoa(index))
+		if d.limits.MaxArrayLength > 0 && index >= d.limits.MaxArrayLength {
+			return d.Fail(ErrorKindLimit, fmt.Sprintf("array has more than %d elements", d.limits.MaxArrayLength))
+		}
+		if err := fn(index); err != nil {
+			return err
+		}
+		d.path = d.path[:len(d.path)-1]
+	}
+	_, err = d.token()
+	return err
+}
+
+// Decode reads the next value and unmarshals it into v, or discards it when v is nil. Only the bytes of
+// this value are held in memory while it is decoded.
+func (d *Decoder) Decode(v any) error {
+	var buf bytes.Buffer
+	if err := d.copyValue(&buf); err != nil {
+		return err
+	}
+	if v == nil {
+		return nil
+	}
+
+	if err := json.Unmarshal(buf.Bytes(), v); err != nil {
+		var typeErr *json.UnmarshalTypeError
+		if errors.As(err, &typeErr) {
+			var segments []string
+			if typeErr.Field != "" {
+				segments = strings.Split(typeErr.Field, ".")
+			}
+			return d.Fail(ErrorKindSyntax,
+				fmt.Sprintf("cannot use a JSON %s as %s", typeErr.Value, typeErr.Type), segments...)
+		}
+		return d.Fail(ErrorKindSyntax, err.Error())
+	}
+	return nil
+}
+
+// End checks that the document is not followed by further data.
+func (d *Decoder) End() error {
+	_, err := d.dec.Token()
+	if errors.Is(err, io.EOF) {
+		return nil
+	}
+	if err != nil {
+		return d.wrapReadError(err)
+	}
+	return d.Fail(ErrorKindSyntax, "unexpected data after the document")
+}
+
+// open reads the opening delimiter of a container, or a null literal in its place.
+func (d *Decoder) open(delim json.Delim, name string) (bool, error) {
+	token, err := d.token()
+	if err != nil {
+		return false, err
+	}
+	if token == nil {
+		return true, d.countValue()
+	}
+	if token != delim {
+		return false, d.Fail(ErrorKindSyntax, "expected an "+name)
+	}
+	if err := d.countValue(); err != nil {
+		return false, err
+	}
+	return false, d.enter()
+}
+
+// close leaves a container opened by open.
+func (d *Decoder) close() {
+	d.depth--
+}
+
+// copyValue reads the next value, enforcing the limits, and writes it to buf as compact JSON.
+func (d *Decoder) copyValue(buf *bytes.Buffer) error {
+	token, err := d.token()
+	if err != nil {
+		return err
+	}
+	if err := d.countValue(); err != nil {
+		return err
+	}
+
+	delim, ok := token.(json.Delim)
+	if !ok {
+		return writeScalar(buf, token)
+	}
+	if err := d.enter(); err != nil {
+		return err
+	}
+	defer d.close()
+
+	if delim == '{' {
+		buf.WriteByte('{')
+		for i := 0; d.dec.More(); i++ {
+			nameToken, err := d.token()
+			if err != nil {
+				return err
+			}
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+			if err := writeScalar(buf, nameToken); err != nil {
+				return err
+			}
+			buf.WriteByte(':')
+			d.path = append(d.path, fmt.Sprint(nameToken))
+			if err := d.copyValue(buf); err != nil {
+				return err
+			}
+			d.path = d.path[:len(d.path)-1]
+		}
+		buf.WriteByte('}')
+	} else {
+		buf.WriteByte('[')
+		for i := 0; d.dec.More(); i++ {
+			d.path = append(d.path, strconv.Itoa(i))
+			if d.limits.MaxArrayLength > 0 && i >= d.limits.MaxArrayLength {
+				return d.Fail(ErrorKindLimit, fmt.Sprintf("array has more than %d elements", d.limits.MaxArrayLength))
+			}
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+			if err := d.copyValue(buf); err != nil {
+				return err
+			}
+			d.path = d.path[:len(d.path)-1]
+		}
+		buf.WriteByte(']')
+	}
+
+	_, err = d.token()
+	return err
+}
+
+// enter records that a container was opened and enforces the depth limit.
+func (d *Decoder) enter() error {
+	d.depth++
+	if d.limits.MaxDepth > 0 && d.depth > d.limits.MaxDepth {
+		return d.Fail(ErrorKindLimit, fmt.Sprintf("nesting is deeper than %d levels", d.limits.MaxDepth))
+	}
+	return nil
+}
+
+// countValue records that a value was read and enforces the value count limit.
+func (d *Decoder) countValue() error {
+	d.values++
+	if d.limits.MaxValues > 0 && d.values > d.limits.MaxValues {
+		return d.Fail(ErrorKindLimit, fmt.Sprintf("document has more than %d values", d.limits.MaxValues))
+	}
+	return nil
+}
+
+// token reads the next token, converting read and syntax errors into located errors.
+func (d *Decoder) token() (json.Token, error) {
+	token, err := d.dec.Token()
+	if err != nil {
+		return nil, d.wrapReadError(err)
+	}
+	return token, nil
+}
+
+// wrapReadError converts an error of the underlying decoder into an Error at the current location.
+func (d *Decoder) wrapReadError(err error) *Error {
+	var maxBytesErr *http.MaxBytesError
+	if errors.As(err, &maxBytesErr) {
+		return d.Fail(ErrorKindSize, fmt.Sprintf("document is larger than %d bytes", maxBytesErr.Limit))
+	}
+	if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+		return d.Fail(ErrorKindSyntax, "unexpected end of the document")
+	}
+	return d.Fail(ErrorKindSyntax, err.Error())
+}
+
+// writeScalar writes a string, number, boolean or null token to buf as JSON.
+func writeScalar(buf *bytes.Buffer, token json.Token) error {
+	switch value := token.(type) {
+	case json.Number:
+		buf.WriteString(value.String())
+	case bool:
+		buf.WriteString(strconv.FormatBool(value))
+	case nil:
+		buf.WriteString("null")
+	default:
+		encoded, err := json.Marshal(value)
+		if err != nil {
+			return err
+		}
+		buf.Write(encoded)
+	}
+	return nil
+}
+
+// pointer builds a JSON pointer from path segments, escaping "~" and "/" as required by RFC 6901.
+func pointer(path []string) string {
+	var builder strings.Builder
+	for _, segment := range path {
+		builder.WriteByte('/')
+		builder.WriteString(strings.ReplaceAll(strings.ReplaceAll(segment, "~", "~0"), "/", "~1"))
+	}
+	return builder.String()
+}
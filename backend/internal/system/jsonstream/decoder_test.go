@@ -0,0 +1,216 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package jsonstream
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+)
+
+type testItem struct {
+	ID    string         `json:"id"`
+	Count int            `json:"count"`
+	Meta  map[string]any `json:"meta"`
+}
+
+// decodeItems decodes a document of the form {"name": ..., "items": [...]}, reporting the index of each
+// decoded item.
+func decodeItems(body string, limits Limits) (string, []testItem, error) {
+	decoder := NewDecoder(strings.NewReader(body), limits)
+	var name string
+	var items []testItem
+	err := decoder.DecodeObject(func(member string) error {
+		switch member {
+		case "name":
+			return decoder.Decode(&name)
+		case "items":
+			return decoder.DecodeArray(func(index int) error {
+				var item testItem
+				if err := decoder.Decode(&item); err != nil {
+					return err
+				}
+				if item.ID == "" {
+					return decoder.Fail(ErrorKindInvalid, "id is required", "id")
+				}
+				items = append(items, item)
+				return nil
+			})
+		default:
+			return decoder.Decode(nil)
+		}
+	})
+	if err == nil {
+		err = decoder.End()
+	}
+	return name, items, err
+}
+
+func requireStreamError(t *testing.T, err error) *Error {
+	var streamErr *Error
+	require.True(t, errors.As(err, &streamErr), "expected a stream error, got %v", err)
+	return streamErr
+}
+
+func TestDecoder_DecodesDocument(t *testing.T) {
+	body := `{"name":"flow","ignored":{"a":[1,{"b":null}]},"items":[{"id":"a","count":1},` +
+		`{"id":"b","meta":{"k":"<v>"}}]}`
+
+	name, items, err := decodeItems(body, Limits{MaxDepth: 4, MaxArrayLength: 2, MaxValues: 20})
+
+	require.NoError(t, err)
+	assert.Equal(t, "flow", name)
+	require.Len(t, items, 2)
+	assert.Equal(t, testItem{ID: "a", Count: 1}, items[0])
+	assert.Equal(t, "<v>", items[1].Meta["k"])
+}
+
+func TestDecoder_TreatsNullContainersAsEmpty(t *testing.T) {
+	name, items, err := decodeItems(`{"items":null}`, Limits{})
+
+	require.NoError(t, err)
+	assert.Empty(t, name)
+	assert.Empty(t, items)
+}
+
+func TestDecoder_Limits(t *testing.T) {
+	testCases := []struct {
+		name    string
+		body    string
+		limits  Limits
+		pointer string
+	}{
+		{"TooDeep", `{"items":[{"id":"a","meta":{"k":{"x":1}}}]}`, Limits{MaxDepth: 4}, "/items/0/meta/k"},
+		{"ArrayTooLong", `{"items":[{"id":"a"},{"id":"b"},{"id":"c"}]}`, Limits{MaxArrayLength: 2}, "/items/2"},
+		{"NestedArrayTooLong", `{"ignored":[[1,2,3]]}`, Limits{MaxArrayLength: 2}, "/ignored/0/2"},
+		{"TooManyValues", `{"items":[{"id":"a","count":1}]}`, Limits{MaxValues: 4}, "/items/0/count"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, _, err := decodeItems(tc.body, tc.limits)
+
+			streamErr := requireStreamError(t, err)
+			assert.Equal(t, ErrorKindLimit, streamErr.Kind)
+			assert.Equal(t, tc.pointer, streamErr.Pointer)
+		})
+	}
+}
+
+func TestDecoder_StopsAtFirstRejectedElement(t *testing.T) {
+	body := `{"items":[{"id":"a"},{"count":2},` + strings.Repeat(`{"id":"x"},`, 100) + `{"id":"z"}]}`
+	reader := strings.NewReader(body)
+	decoder := NewDecoder(reader, Limits{})
+
+	var decoded int
+	err := decoder.DecodeObject(func(string) error {
+		return decoder.DecodeArray(func(int) error {
+			var item testItem
+			if err := decoder.Decode(&item); err != nil {
+				return err
+			}
+			if item.ID == "" {
+				return decoder.Fail(ErrorKindInvalid, "id is required", "id")
+			}
+			decoded++
+			return nil
+		})
+	})
+
+	streamErr := requireStreamError(t, err)
+	assert.Equal(t, ErrorKindInvalid, streamErr.Kind)
+	assert.Equal(t, "/items/1/id", streamErr.Pointer)
+	assert.Equal(t, "id is required at /items/1/id", streamErr.Error())
+	assert.Equal(t, 1, decoded)
+}
+
+func TestDecoder_ReportsTypeErrorsWithPointer(t *testing.T) {
+	_, _, err := decodeItems(`{"items":[{"id":"a"},{"id":"b","count":"two"}]}`, Limits{})
+
+	streamErr := requireStreamError(t, err)
+	assert.Equal(t, ErrorKindSyntax, streamErr.Kind)
+	assert.Equal(t, "/items/1/count", streamErr.Pointer)
+}
+
+func TestDecoder_ReportsSyntaxErrors(t *testing.T) {
+	testCases := []struct {
+		name    string
+		body    string
+		pointer string
+	}{
+		{"Truncated", `{"items":[{"id":"a"`, "/items/0"},
+		{"InvalidToken", `{"name":"flow","items":[{"id":x}]}`, "/items/0/id"},
+		{"NotAnObject", `[1]`, ""},
+		{"TrailingData", `{"name":"flow"} {}`, ""},
+		{"ItemsNotAnArray", `{"items":{"id":"a"}}`, "/items"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, _, err := decodeItems(tc.body, Limits{})
+
+			streamErr := requireStreamError(t, err)
+			assert.Equal(t, ErrorKindSyntax, streamErr.Kind)
+			assert.Equal(t, tc.pointer, streamErr.Pointer)
+		})
+	}
+}
+
+func TestDecoder_ReportsOversizedBody(t *testing.T) {
+	body := `{"items":[` + strings.Repeat(`{"id":"a"},`, 50) + `{"id":"z"}]}`
+	rr := httptest.NewRecorder()
+	reader := http.MaxBytesReader(rr, httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body)).Body, 64)
+
+	decoder := NewDecoder(reader, Limits{})
+	err := decoder.DecodeObject(func(string) error {
+		return decoder.DecodeArray(func(int) error {
+			return decoder.Decode(nil)
+		})
+	})
+
+	streamErr := requireStreamError(t, err)
+	assert.Equal(t, ErrorKindSize, streamErr.Kind)
+}
+
+func TestDecoder_DecodesRawMessage(t *testing.T) {
+	decoder := NewDecoder(strings.NewReader(` { "a" : [ 1, 2.5e3, true, null, "x\"y" ] } `), Limits{})
+
+	var raw json.RawMessage
+	require.NoError(t, decoder.Decode(&raw))
+	require.NoError(t, decoder.End())
+
+	assert.Equal(t, `{"a":[1,2.5e3,true,null,"x\"y"]}`, string(raw))
+}
+
+func TestPointerEscaping(t *testing.T) {
+	assert.Equal(t, "", pointer(nil))
+	assert.Equal(t, "/a~1b/m~0n/0", pointer([]string{"a/b", "m~n", "0"}))
+}
+
+func TestLimitsFromConfig(t *testing.T) {
+	limits := LimitsFromConfig(config.RequestLimits{MaxJSONDepth: 8, MaxJSONArrayLength: 100, MaxJSONValues: 5000})
+
+	assert.Equal(t, Limits{MaxDepth: 8, MaxArrayLength: 100, MaxValues: 5000}, limits)
+}
@@ -35,11 +35,17 @@ type encryptionService struct {
 	keys         map[string][]byte
 }
 
-func newEncryptionService(key []byte) kmprovider.ConfigCryptoProvider {
+// newEncryptionService creates an encryptionService that encrypts with the given key. The previous keys
+// are only used to decrypt data that was encrypted before the key was rotated.
+func newEncryptionService(key []byte, previousKeys ...[]byte) kmprovider.ConfigCryptoProvider {
 	kid := hash.GenerateThumbprint(key)
+	keys := map[string][]byte{kid: key}
+	for _, previousKey := range previousKeys {
+		keys[hash.GenerateThumbprint(previousKey)] = previousKey
+	}
 	return &encryptionService{
 		defaultKeyID: kid,
-		keys:         map[string][]byte{kid: key},
+		keys:         keys,
 	}
 }
 
@@ -85,6 +91,15 @@ func (es *encryptionService) Decrypt(_ context.Context, encodedData []byte) ([]b
 	return cryptolab.Decrypt(key, cryptolab.AlgorithmParams{Algorithm: cryptolab.AlgorithmAESGCM}, ciphertext)
 }
 
+// NeedsReencryption reports whether the encrypted data was produced with a key other than the default key.
+func (es *encryptionService) NeedsReencryption(_ context.Context, encodedData []byte) (bool, error) {
+	var encData EncryptedData
+	if err := json.Unmarshal(encodedData, &encData); err != nil {
+		return false, fmt.Errorf("invalid data format: %w", err)
+	}
+	return encData.KeyID != es.defaultKeyID, nil
+}
+
 func (es *encryptionService) defaultKey() []byte {
 	if es.defaultKeyID == "" || len(es.keys) == 0 {
 		return nil
@@ -49,3 +49,36 @@ func TestEncryptionService_Encrypt_InvalidKeySize(t *testing.T) {
 	_, err := es.Encrypt(context.Background(), []byte("plaintext"))
 	require.Error(t, err)
 }
+
+func TestEncryptionService_DecryptsWithPreviousKey(t *testing.T) {
+	oldKey := []byte("0123456789abcdef0123456789abcdef")
+	newKey := []byte("fedcba9876543210fedcba9876543210")
+
+	oldService := newEncryptionService(oldKey)
+	ciphertext, err := oldService.Encrypt(context.Background(), []byte("secret"))
+	require.NoError(t, err)
+
+	rotated := newEncryptionService(newKey, oldKey)
+	plaintext, err := rotated.Decrypt(context.Background(), ciphertext)
+	require.NoError(t, err)
+	assert.Equal(t, "secret", string(plaintext))
+
+	needsReencryption, err := rotated.NeedsReencryption(context.Background(), ciphertext)
+	require.NoError(t, err)
+	assert.True(t, needsReencryption)
+
+	reencrypted, err := rotated.Encrypt(context.Background(), plaintext)
+	require.NoError(t, err)
+	needsReencryption, err = rotated.NeedsReencryption(context.Background(), reencrypted)
+	require.NoError(t, err)
+	assert.False(t, needsReencryption)
+
+	_, err = oldService.Decrypt(context.Background(), reencrypted)
+	assert.Error(t, err)
+}
+
+func TestEncryptionService_NeedsReencryption_InvalidData(t *testing.T) {
+	es := newEncryptionService([]byte("0123456789abcdef"))
+	_, err := es.NeedsReencryption(context.Background(), []byte("not-json"))
+	assert.Error(t, err)
+}
@@ -22,6 +22,7 @@ package defaultkm
 import (
 	"encoding/hex"
 	"errors"
+	"fmt"
 	"sync"
 
 	"github.com/thunder-id/thunderid/internal/system/config"
@@ -78,16 +79,34 @@ func Initialize() (kmprovider.RuntimeCryptoProvider, kmprovider.ConfigCryptoProv
 }
 
 func initConfigProvider() (kmprovider.ConfigCryptoProvider, error) {
-	encryptionKey := config.GetServerRuntime().Config.Crypto.Encryption.Key
-	if encryptionKey == "" {
+	encryptionConfig := config.GetServerRuntime().Config.Crypto.Encryption
+	if encryptionConfig.Key == "" {
 		return nil, errors.New("encryption key not configured in crypto.encryption.key")
 	}
-	key, err := hex.DecodeString(encryptionKey)
+	key, err := decodeEncryptionKey(encryptionConfig.Key)
+	if err != nil {
+		return nil, err
+	}
+
+	previousKeys := make([][]byte, 0, len(encryptionConfig.PreviousKeys))
+	for _, encodedKey := range encryptionConfig.PreviousKeys {
+		previousKey, err := decodeEncryptionKey(encodedKey)
+		if err != nil {
+			return nil, fmt.Errorf("invalid key in crypto.encryption.previous_keys: %w", err)
+		}
+		previousKeys = append(previousKeys, previousKey)
+	}
+	return newEncryptionService(key, previousKeys...), nil
+}
+
+// decodeEncryptionKey decodes a hex encoded AES key and validates its length.
+func decodeEncryptionKey(encodedKey string) ([]byte, error) {
+	key, err := hex.DecodeString(encodedKey)
 	if err != nil {
 		return nil, err
 	}
 	if len(key) != 16 && len(key) != 24 && len(key) != 32 {
 		return nil, errors.New("invalid AES key length: must be 16, 24, or 32 bytes")
 	}
-	return newEncryptionService(key), nil
+	return key, nil
 }
@@ -60,9 +60,16 @@ func LoadTLSConfig(cfg *config.Config, certFilePath string, keyFilePath string)
 		log.String("certFile", certFilePath),
 		log.String("keyFile", keyFilePath))
 
+	clientAuth := tls.NoClientCert
+	if cfg.TLS.RequestClientCert {
+		// Client certificates are validated by the authenticating component, not during the handshake.
+		clientAuth = tls.RequestClientCert
+	}
+
 	// #nosec G402 -- Min TLS version is TLS 1.2 or higher based on config
 	return &tls.Config{
 		Certificates: []tls.Certificate{cert},
 		MinVersion:   http.GetTLSVersion(*cfg),
+		ClientAuth:   clientAuth,
 	}, nil
 }
@@ -30,6 +30,8 @@ import (
 type ConfigCryptoProvider interface {
 	Encrypt(ctx context.Context, content []byte) ([]byte, error)
 	Decrypt(ctx context.Context, content []byte) ([]byte, error)
+	// NeedsReencryption reports whether the content was encrypted with a key other than the current key.
+	NeedsReencryption(ctx context.Context, content []byte) (bool, error)
 }
 
 // RuntimeCryptoProvider provides asymmetric cryptographic operations including
@@ -43,7 +43,11 @@ func AccessLogHandler(logger *Logger, next http.Handler) http.Handler {
 		// Calculate elapsed time in milliseconds
 		elapsedMs := time.Since(start).Milliseconds()
 
-		host, _, _ := net.SplitHostPort(r.RemoteAddr)
+		// Prefer the client IP resolved from trusted proxy headers by the ClientInfoMiddleware.
+		host := sysContext.GetClientIP(r.Context())
+		if host == "" {
+			host, _, _ = net.SplitHostPort(r.RemoteAddr)
+		}
 		if host == "" {
 			host = r.RemoteAddr
 		}
@@ -84,3 +88,8 @@ func (lrw *loggingResponseWriter) Write(b []byte) (int, error) {
 	lrw.size += size
 	return size, err
 }
+
+// Unwrap returns the underlying response writer for http.ResponseController.
+func (lrw *loggingResponseWriter) Unwrap() http.ResponseWriter {
+	return lrw.ResponseWriter
+}
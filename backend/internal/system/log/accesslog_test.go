@@ -28,6 +28,8 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/suite"
+
+	sysContext "github.com/thunder-id/thunderid/internal/system/context"
 )
 
 type AccessLogTestSuite struct {
@@ -82,6 +84,27 @@ func (suite *AccessLogTestSuite) TestAccessLogHandler() {
 	assert.NotContains(suite.T(), output, `\"`)
 }
 
+func (suite *AccessLogTestSuite) TestAccessLogHandler_UsesResolvedClientIP() {
+	var buf bytes.Buffer
+	log := &Logger{
+		internal: slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug})),
+	}
+
+	handler := AccessLogHandler(log, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	req := httptest.NewRequest("GET", "/test", nil)
+	req.RemoteAddr = "10.0.0.1:12345"
+	req = req.WithContext(sysContext.WithClientIP(req.Context(), "203.0.113.7"))
+
+	handler.ServeHTTP(httptest.NewRecorder(), req)
+
+	output := buf.String()
+	assert.Contains(suite.T(), output, "203.0.113.7")
+	assert.NotContains(suite.T(), output, "10.0.0.1")
+}
+
 func (suite *AccessLogTestSuite) TestLoggingResponseWriter() {
 	rec := httptest.NewRecorder()
 	lrw := &loggingResponseWriter{
@@ -109,3 +132,11 @@ func (suite *AccessLogTestSuite) TestLoggingResponseWriter() {
 	// Verify the actual content was written to the underlying ResponseWriter
 	assert.Equal(suite.T(), "test content more", rec.Body.String())
 }
+
+func (suite *AccessLogTestSuite) TestLoggingResponseWriter_SupportsFlush() {
+	rec := httptest.NewRecorder()
+	lrw := &loggingResponseWriter{ResponseWriter: rec, statusCode: http.StatusOK}
+
+	assert.NoError(suite.T(), http.NewResponseController(lrw).Flush())
+	assert.True(suite.T(), rec.Flushed)
+}
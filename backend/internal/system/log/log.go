@@ -22,6 +22,7 @@ package log
 import (
 	"context"
 	"errors"
+	"io"
 	"log/slog"
 	"os"
 	"strings"
@@ -81,6 +82,26 @@ func initLogger() error {
 	return nil
 }
 
+// SetOutput redirects the output of the singleton logger to the given writer. Loggers derived from the
+// singleton before the call keep writing to the previous output.
+func SetOutput(w io.Writer) {
+	l := GetLogger()
+	handlerOptions := &slog.HandlerOptions{
+		Level: getHandlerLevel(l.internal.Handler()),
+	}
+	l.internal = slog.New(slog.NewTextHandler(w, handlerOptions))
+}
+
+// getHandlerLevel returns the lowest level enabled in the handler.
+func getHandlerLevel(handler slog.Handler) slog.Level {
+	for _, level := range []slog.Level{slog.LevelDebug, slog.LevelInfo, slog.LevelWarn} {
+		if handler.Enabled(context.Background(), level) {
+			return level
+		}
+	}
+	return slog.LevelError
+}
+
 // With creates a new logger instance with additional fields.
 func (l *Logger) With(fields ...Field) *Logger {
 	return &Logger{
@@ -345,3 +345,16 @@ func (suite *LogTestSuite) TestConvertFields() {
 	assert.Contains(suite.T(), output, "int=42")
 	assert.Contains(suite.T(), output, "bool=true")
 }
+
+func (suite *LogTestSuite) TestSetOutput() {
+	err := os.Setenv(constants.LogLevelEnvironmentVariable, "warn")
+	suite.NoError(err)
+
+	var output bytes.Buffer
+	SetOutput(&output)
+	GetLogger().Info("info message")
+	GetLogger().Warn("warn message")
+
+	suite.NotContains(output.String(), "info message")
+	suite.Contains(output.String(), "warn message")
+}
@@ -0,0 +1,250 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package middleware
+
+import (
+	"math"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/system/apiversion"
+	"github.com/thunder-id/thunderid/internal/system/config"
+	sysContext "github.com/thunder-id/thunderid/internal/system/context"
+	"github.com/thunder-id/thunderid/internal/system/error/apierror"
+	"github.com/thunder-id/thunderid/internal/system/utils"
+)
+
+const (
+	// rejectReasonLimit labels requests rejected because the route is at its limit.
+	rejectReasonLimit = "limit"
+	// rejectReasonClient labels requests rejected because the client holds its share of the limit.
+	rejectReasonClient = "client"
+
+	// latencySmoothing is the weight of a new latency sample in the smoothed latency of a route.
+	latencySmoothing = 0.1
+	// baselineDrift is the number of samples over which the baseline latency follows a lasting rise in
+	// latency, so that the baseline adapts when the cost of serving a route changes.
+	baselineDrift = 1000
+)
+
+// AdaptiveConcurrencyMiddleware sheds the excess traffic of the configured routes. Each route admits a
+// limited number of concurrent requests, and the requests of a single client may hold only a share of
+// that limit. The limit follows an AIMD scheme: it grows by one request per limit of requests served
+// within the latency tolerance of the route and is multiplied by the backoff ratio, at most once per
+// smoothed latency, when a request is slower than the tolerance or fails with a server error. Requests
+// over the limit are answered with 503 Service Unavailable and a Retry-After header.
+func AdaptiveConcurrencyMiddleware(cfg config.AdaptiveConcurrency, next http.Handler) http.Handler {
+	if !cfg.Enabled || len(cfg.Routes) == 0 {
+		return next
+	}
+
+	limiters := make(map[string]*concurrencyLimiter, len(cfg.Routes))
+	for _, route := range cfg.Routes {
+		limiters[route] = newConcurrencyLimiter(cfg)
+	}
+	retryAfter := strconv.FormatInt(cfg.RetryAfter, 10)
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		route, ok := matchConcurrencyRoute(cfg.Routes, apiversion.StripVersionPrefix(r.URL.Path))
+		if !ok || r.Method == http.MethodOptions {
+			next.ServeHTTP(w, r)
+			return
+		}
+		limiter := limiters[route]
+
+		client := concurrencyClientKey(r)
+		if reason := limiter.acquire(client); reason != "" {
+			recordConcurrencyRejection(r.Context(), route, reason)
+			w.Header().Set("Retry-After", retryAfter)
+			utils.WriteErrorResponse(w, http.StatusServiceUnavailable, apierror.ErrServerOverloaded)
+			return
+		}
+
+		start := time.Now()
+		sw := &statusResponseWriter{ResponseWriter: w, statusCode: http.StatusOK}
+		defer func() {
+			failed := sw.statusCode >= http.StatusInternalServerError
+			limit, health := limiter.release(client, time.Since(start), failed)
+			recordConcurrencyState(r.Context(), route, limit, health)
+		}()
+		next.ServeHTTP(sw, r)
+	})
+}
+
+// matchConcurrencyRoute returns the first limited route pattern matching the path.
+func matchConcurrencyRoute(routes []string, path string) (string, bool) {
+	for _, route := range routes {
+		if matchPathPattern(route, path) {
+			return route, true
+		}
+	}
+	return "", false
+}
+
+// concurrencyClientKey identifies the client of a request for fair sharing of the limit. Clients are
+// identified by the client ID of HTTP Basic authentication or the client_id query parameter, and
+// otherwise by their IP address.
+func concurrencyClientKey(r *http.Request) string {
+	if clientID, _, ok := r.BasicAuth(); ok && clientID != "" {
+		return "client:" + clientID
+	}
+	if clientID := r.URL.Query().Get("client_id"); clientID != "" {
+		return "client:" + clientID
+	}
+	if ip := sysContext.GetClientIP(r.Context()); ip != "" {
+		return "ip:" + ip
+	}
+	return ""
+}
+
+// concurrencyLimiter holds the adaptive concurrency limit of a route and the requests it is serving.
+type concurrencyLimiter struct {
+	mu          sync.Mutex
+	limit       float64
+	minLimit    float64
+	maxLimit    float64
+	tolerance   float64
+	backoff     float64
+	clientShare float64
+	inFlight    int
+	clients     map[string]int
+	// baseline estimates the latency of the route when it is not overloaded.
+	baseline time.Duration
+	// smoothed is the exponentially weighted moving average of the latency of the route.
+	smoothed     time.Duration
+	lastDecrease time.Time
+	now          func() time.Time
+}
+
+// newConcurrencyLimiter creates a limiter that starts at the initial limit of the configuration.
+func newConcurrencyLimiter(cfg config.AdaptiveConcurrency) *concurrencyLimiter {
+	return &concurrencyLimiter{
+		limit:       float64(cfg.InitialLimit),
+		minLimit:    float64(cfg.MinLimit),
+		maxLimit:    float64(cfg.MaxLimit),
+		tolerance:   cfg.LatencyTolerance,
+		backoff:     cfg.BackoffRatio,
+		clientShare: cfg.MaxClientShare,
+		clients:     make(map[string]int),
+		now:         time.Now,
+	}
+}
+
+// acquire admits a request of a client, or returns the reason it is rejected.
+func (l *concurrencyLimiter) acquire(client string) string {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	limit := int(l.limit)
+	if l.inFlight >= limit {
+		return rejectReasonLimit
+	}
+	if client != "" && l.clients[client] >= l.clientLimit(limit) {
+		return rejectReasonClient
+	}
+
+	l.inFlight++
+	if client != "" {
+		l.clients[client]++
+	}
+	return ""
+}
+
+// release records the completion of an admitted request and adjusts the limit by its latency and
+// outcome. It returns the new limit and the health score of the route.
+func (l *concurrencyLimiter) release(client string, latency time.Duration, failed bool) (int, float64) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	usage := float64(l.inFlight) / l.limit
+	l.inFlight--
+	if client != "" {
+		if l.clients[client] <= 1 {
+			delete(l.clients, client)
+		} else {
+			l.clients[client]--
+		}
+	}
+	l.observe(latency)
+
+	overloaded := failed || float64(latency) > l.tolerance*float64(l.baseline)
+	switch {
+	case overloaded:
+		now := l.now()
+		if now.Sub(l.lastDecrease) >= l.smoothed {
+			l.limit = math.Max(l.minLimit, l.limit*l.backoff)
+			l.lastDecrease = now
+		}
+	case usage >= 0.5:
+		// The limit only grows while it is being used, so that idle routes do not build up headroom.
+		l.limit = math.Min(l.maxLimit, l.limit+1/l.limit)
+	}
+	return int(l.limit), l.health()
+}
+
+// observe updates the baseline and smoothed latency of the route with a latency sample.
+func (l *concurrencyLimiter) observe(latency time.Duration) {
+	if l.baseline == 0 || latency < l.baseline {
+		l.baseline = latency
+	} else {
+		l.baseline += (latency - l.baseline) / baselineDrift
+	}
+	if l.smoothed == 0 {
+		l.smoothed = latency
+	} else {
+		l.smoothed += time.Duration(latencySmoothing * float64(latency-l.smoothed))
+	}
+}
+
+// health scores the route from 0 to 1 as the ratio of its baseline latency to its smoothed latency. A
+// route served at its baseline latency scores 1.
+func (l *concurrencyLimiter) health() float64 {
+	if l.smoothed <= 0 {
+		return 1
+	}
+	return math.Min(1, float64(l.baseline)/float64(l.smoothed))
+}
+
+// clientLimit returns the number of concurrent requests a single client may hold under a limit.
+func (l *concurrencyLimiter) clientLimit(limit int) int {
+	return max(1, int(math.Ceil(float64(limit)*l.clientShare)))
+}
+
+// statusResponseWriter records the status code of the response written by a handler.
+type statusResponseWriter struct {
+	http.ResponseWriter
+	statusCode  int
+	wroteHeader bool
+}
+
+// WriteHeader records and writes the status code.
+func (w *statusResponseWriter) WriteHeader(statusCode int) {
+	if !w.wroteHeader {
+		w.wroteHeader = true
+		w.statusCode = statusCode
+	}
+	w.ResponseWriter.WriteHeader(statusCode)
+}
+
+// Unwrap returns the underlying response writer for http.ResponseController.
+func (w *statusResponseWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
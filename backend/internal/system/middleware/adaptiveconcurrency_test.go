@@ -0,0 +1,185 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package middleware
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+	sysContext "github.com/thunder-id/thunderid/internal/system/context"
+	"github.com/thunder-id/thunderid/internal/system/error/apierror"
+)
+
+var testAdaptiveConcurrency = config.AdaptiveConcurrency{
+	Enabled:          true,
+	Routes:           []string{"/oauth2/token", "/auth/**"},
+	InitialLimit:     4,
+	MinLimit:         2,
+	MaxLimit:         8,
+	LatencyTolerance: 2,
+	BackoffRatio:     0.5,
+	MaxClientShare:   0.5,
+	RetryAfter:       3,
+}
+
+func TestAdaptiveConcurrencyMiddleware_Disabled(t *testing.T) {
+	disabled := testAdaptiveConcurrency
+	disabled.Enabled = false
+	disabled.InitialLimit = 0
+	handler := AdaptiveConcurrencyMiddleware(disabled, http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+		}))
+
+	rr := httptest.NewRecorder()
+	handler.ServeHTTP(rr, httptest.NewRequest(http.MethodPost, "/oauth2/token", nil))
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+}
+
+func TestAdaptiveConcurrencyMiddleware_RejectsOverLimit(t *testing.T) {
+	release := make(chan struct{})
+	started := make(chan struct{}, 4)
+	handler := AdaptiveConcurrencyMiddleware(testAdaptiveConcurrency, http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			started <- struct{}{}
+			<-release
+			w.WriteHeader(http.StatusOK)
+		}))
+
+	done := make(chan struct{})
+	for i := range 4 {
+		go func() {
+			req := httptest.NewRequest(http.MethodPost, "/oauth2/token", nil)
+			req.SetBasicAuth("client-"+string(rune('a'+i)), "secret")
+			handler.ServeHTTP(httptest.NewRecorder(), req)
+			done <- struct{}{}
+		}()
+	}
+	for range 4 {
+		<-started
+	}
+
+	rr := httptest.NewRecorder()
+	handler.ServeHTTP(rr, httptest.NewRequest(http.MethodPost, "/v1/oauth2/token", nil))
+	close(release)
+	for range 4 {
+		<-done
+	}
+
+	assert.Equal(t, http.StatusServiceUnavailable, rr.Code)
+	assert.Equal(t, "3", rr.Header().Get("Retry-After"))
+	var body apierror.ErrorResponse
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &body))
+	assert.Equal(t, apierror.ErrServerOverloaded.Code, body.Code)
+}
+
+func TestAdaptiveConcurrencyMiddleware_UnlimitedRoute(t *testing.T) {
+	called := false
+	handler := AdaptiveConcurrencyMiddleware(testAdaptiveConcurrency, http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			called = true
+			w.WriteHeader(http.StatusNoContent)
+		}))
+
+	rr := httptest.NewRecorder()
+	handler.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/users", nil))
+
+	assert.True(t, called)
+	assert.Equal(t, http.StatusNoContent, rr.Code)
+}
+
+func TestConcurrencyLimiter_ClientShare(t *testing.T) {
+	limiter := newConcurrencyLimiter(testAdaptiveConcurrency)
+
+	assert.Empty(t, limiter.acquire("client:a"))
+	assert.Empty(t, limiter.acquire("client:a"))
+	assert.Equal(t, rejectReasonClient, limiter.acquire("client:a"))
+	assert.Empty(t, limiter.acquire("client:b"))
+	assert.Empty(t, limiter.acquire("client:b"))
+	assert.Equal(t, rejectReasonLimit, limiter.acquire("client:c"))
+
+	limiter.release("client:a", time.Millisecond, false)
+	assert.Empty(t, limiter.acquire("client:a"))
+}
+
+func TestConcurrencyLimiter_IncreasesUnderLoad(t *testing.T) {
+	limiter := newConcurrencyLimiter(testAdaptiveConcurrency)
+
+	for range 20 {
+		for range 3 {
+			require.Empty(t, limiter.acquire(""))
+		}
+		for range 3 {
+			limiter.release("", 10*time.Millisecond, false)
+		}
+	}
+
+	assert.Greater(t, limiter.limit, float64(testAdaptiveConcurrency.InitialLimit))
+	assert.LessOrEqual(t, limiter.limit, float64(testAdaptiveConcurrency.MaxLimit))
+}
+
+func TestConcurrencyLimiter_DecreasesOnSlowRequests(t *testing.T) {
+	limiter := newConcurrencyLimiter(testAdaptiveConcurrency)
+	now := time.Now()
+	limiter.now = func() time.Time { return now }
+
+	require.Empty(t, limiter.acquire(""))
+	limiter.release("", 10*time.Millisecond, false)
+
+	require.Empty(t, limiter.acquire(""))
+	limit, health := limiter.release("", 100*time.Millisecond, false)
+	assert.Equal(t, 2, limit)
+	assert.Less(t, health, 1.0)
+
+	// A second slow request within the smoothed latency does not back off again.
+	require.Empty(t, limiter.acquire(""))
+	limit, _ = limiter.release("", 100*time.Millisecond, false)
+	assert.Equal(t, 2, limit)
+}
+
+func TestConcurrencyLimiter_DecreasesOnFailure(t *testing.T) {
+	limiter := newConcurrencyLimiter(testAdaptiveConcurrency)
+
+	require.Empty(t, limiter.acquire(""))
+	limit, _ := limiter.release("", time.Millisecond, true)
+
+	assert.Equal(t, 2, limit)
+}
+
+func TestConcurrencyClientKey(t *testing.T) {
+	req := httptest.NewRequest(http.MethodPost, "/oauth2/token", nil)
+	req.SetBasicAuth("app-1", "secret")
+	assert.Equal(t, "client:app-1", concurrencyClientKey(req))
+
+	req = httptest.NewRequest(http.MethodGet, "/oauth2/authorize?client_id=app-2", nil)
+	assert.Equal(t, "client:app-2", concurrencyClientKey(req))
+
+	req = httptest.NewRequest(http.MethodPost, "/flow/execute", nil)
+	req = req.WithContext(sysContext.WithClientIP(context.Background(), "192.0.2.10"))
+	assert.Equal(t, "ip:192.0.2.10", concurrencyClientKey(req))
+}
@@ -0,0 +1,51 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package middleware
+
+import (
+	"net/http"
+
+	sysContext "github.com/thunder-id/thunderid/internal/system/context"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/internal/system/proxy"
+)
+
+// ClientInfoMiddleware resolves the IP address and TLS client certificate of the client that
+// originated each request and stores them in the request context. Forwarding headers and forwarded
+// client certificates are honoured only when the request arrives from a trusted proxy; otherwise the
+// forwarded certificate header is removed so that downstream handlers cannot be misled by it.
+func ClientInfoMiddleware(resolver *proxy.Resolver, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := sysContext.WithClientIP(r.Context(), resolver.ClientIP(r))
+
+		cert, err := resolver.ClientCertificate(r)
+		if err != nil {
+			logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, "ClientInfoMiddleware"))
+			logger.Warn("Ignoring invalid forwarded client certificate", log.Error(err))
+		} else if cert != nil {
+			ctx = sysContext.WithClientCertificate(ctx, cert)
+		}
+
+		if header := resolver.ClientCertHeader(); header != "" && (err != nil || !resolver.IsTrustedPeer(r)) {
+			r.Header.Del(header)
+		}
+
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
@@ -0,0 +1,92 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sysContext "github.com/thunder-id/thunderid/internal/system/context"
+	"github.com/thunder-id/thunderid/internal/system/proxy"
+)
+
+func TestClientInfoMiddleware_ResolvesClientIPFromTrustedProxy(t *testing.T) {
+	resolver, err := proxy.NewResolver([]string{"10.0.0.0/8"}, "X-Client-Cert")
+	require.NoError(t, err)
+
+	var clientIP string
+	handler := ClientInfoMiddleware(resolver, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		clientIP = sysContext.GetClientIP(r.Context())
+	}))
+
+	req := httptest.NewRequest(http.MethodGet, "/test", nil)
+	req.RemoteAddr = "10.0.0.1:1234"
+	req.Header.Set("X-Forwarded-For", "198.51.100.1")
+	handler.ServeHTTP(httptest.NewRecorder(), req)
+
+	assert.Equal(t, "198.51.100.1", clientIP)
+}
+
+func TestClientInfoMiddleware_StripsCertHeaderFromUntrustedPeer(t *testing.T) {
+	resolver, err := proxy.NewResolver([]string{"10.0.0.0/8"}, "X-Client-Cert")
+	require.NoError(t, err)
+
+	var certHeader string
+	var hasCert bool
+	handler := ClientInfoMiddleware(resolver, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		certHeader = r.Header.Get("X-Client-Cert")
+		hasCert = sysContext.GetClientCertificate(r.Context()) != nil
+	}))
+
+	req := httptest.NewRequest(http.MethodGet, "/test", nil)
+	req.RemoteAddr = "203.0.113.5:1234"
+	req.Header.Set("X-Client-Cert", "forged")
+	req.Header.Set("X-Forwarded-For", "198.51.100.1")
+	handler.ServeHTTP(httptest.NewRecorder(), req)
+
+	assert.Empty(t, certHeader)
+	assert.False(t, hasCert)
+}
+
+func TestClientInfoMiddleware_IgnoresInvalidForwardedCertificate(t *testing.T) {
+	resolver, err := proxy.NewResolver([]string{"10.0.0.0/8"}, "X-Client-Cert")
+	require.NoError(t, err)
+
+	var certHeader string
+	var hasCert bool
+	handler := ClientInfoMiddleware(resolver, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		certHeader = r.Header.Get("X-Client-Cert")
+		hasCert = sysContext.GetClientCertificate(r.Context()) != nil
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	req := httptest.NewRequest(http.MethodGet, "/test", nil)
+	req.RemoteAddr = "10.0.0.1:1234"
+	req.Header.Set("X-Client-Cert", "not-a-certificate")
+	rr := httptest.NewRecorder()
+	handler.ServeHTTP(rr, req)
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.Empty(t, certHeader)
+	assert.False(t, hasCert)
+}
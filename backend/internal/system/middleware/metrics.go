@@ -0,0 +1,105 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package middleware
+
+import (
+	"context"
+	"sync"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+type timeoutMetrics struct {
+	once     sync.Once
+	timeouts metric.Int64Counter
+}
+
+var requestTimeoutMetrics timeoutMetrics
+
+type concurrencyMetrics struct {
+	once        sync.Once
+	rejections  metric.Int64Counter
+	limit       metric.Int64Gauge
+	healthScore metric.Float64Gauge
+}
+
+var adaptiveConcurrencyMetrics concurrencyMetrics
+
+func initTimeoutMetrics() {
+	requestTimeoutMetrics.once.Do(func() {
+		meter := otel.Meter("github.com/thunder-id/thunderid/middleware")
+		requestTimeoutMetrics.timeouts, _ = meter.Int64Counter(
+			"thunderid_http_request_timeouts_total",
+			metric.WithDescription("Total requests that exceeded their time budget, per route"),
+		)
+	})
+}
+
+// recordRequestTimeout records a request that exceeded the time budget of the given route.
+func recordRequestTimeout(ctx context.Context, route string) {
+	initTimeoutMetrics()
+	if requestTimeoutMetrics.timeouts == nil {
+		return
+	}
+	requestTimeoutMetrics.timeouts.Add(ctx, 1, metric.WithAttributes(attribute.String("route", route)))
+}
+
+func initConcurrencyMetrics() {
+	adaptiveConcurrencyMetrics.once.Do(func() {
+		meter := otel.Meter("github.com/thunder-id/thunderid/middleware")
+		adaptiveConcurrencyMetrics.rejections, _ = meter.Int64Counter(
+			"thunderid_adaptive_concurrency_rejections_total",
+			metric.WithDescription("Total requests shed by the adaptive concurrency limit, per route and reason"),
+		)
+		adaptiveConcurrencyMetrics.limit, _ = meter.Int64Gauge(
+			"thunderid_adaptive_concurrency_limit",
+			metric.WithDescription("Concurrent requests admitted by the adaptive concurrency limit, per route"),
+		)
+		adaptiveConcurrencyMetrics.healthScore, _ = meter.Float64Gauge(
+			"thunderid_adaptive_concurrency_health_score",
+			metric.WithDescription("Ratio of the baseline latency to the smoothed latency, per route"),
+		)
+	})
+}
+
+// recordConcurrencyRejection records a request shed by the adaptive concurrency limit of a route.
+func recordConcurrencyRejection(ctx context.Context, route, reason string) {
+	initConcurrencyMetrics()
+	if adaptiveConcurrencyMetrics.rejections == nil {
+		return
+	}
+	adaptiveConcurrencyMetrics.rejections.Add(ctx, 1, metric.WithAttributes(
+		attribute.String("route", route),
+		attribute.String("reason", reason),
+	))
+}
+
+// recordConcurrencyState records the adaptive concurrency limit and the health score of a route.
+func recordConcurrencyState(ctx context.Context, route string, limit int, health float64) {
+	initConcurrencyMetrics()
+	attrs := metric.WithAttributes(attribute.String("route", route))
+	if adaptiveConcurrencyMetrics.limit != nil {
+		adaptiveConcurrencyMetrics.limit.Record(ctx, int64(limit), attrs)
+	}
+	if adaptiveConcurrencyMetrics.healthScore != nil {
+		adaptiveConcurrencyMetrics.healthScore.Record(ctx, health, attrs)
+	}
+}
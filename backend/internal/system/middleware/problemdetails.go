@@ -0,0 +1,171 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package middleware
+
+import (
+	"bytes"
+	"encoding/json"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/constants"
+	"github.com/thunder-id/thunderid/internal/system/error/apierror"
+)
+
+// defaultProblemType is the problem type used when no type base URI is configured.
+const defaultProblemType = "about:blank"
+
+// problemDetails is an RFC 9457 problem details object. The error code is carried as an extension member
+// so that clients can keep branching on it.
+type problemDetails struct {
+	Type     string `json:"type"`
+	Title    string `json:"title"`
+	Status   int    `json:"status"`
+	Detail   string `json:"detail,omitempty"`
+	Instance string `json:"instance,omitempty"`
+	Code     string `json:"code"`
+}
+
+// ProblemDetailsMiddleware writes API error responses as RFC 9457 problem details when the request accepts
+// application/problem+json or the configured default format is problem details. Error responses of the
+// handlers are converted as they are written, so every handler shares the mapping: the error code forms
+// the type, or about:blank without a type base URI, and is kept as the code member, the message becomes the title, the description becomes the
+// detail and the request path becomes the instance. Responses of other shapes, such as OAuth error
+// responses whose format is defined by the protocol, are left as they are.
+func ProblemDetailsMiddleware(errorFormat config.ErrorFormatConfig, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if errorFormat.Default != config.ErrorFormatProblem &&
+			!acceptsProblemJSON(r.Header.Get(constants.AcceptHeaderName)) {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		pw := &problemResponseWriter{
+			ResponseWriter: w,
+			typeBaseURI:    errorFormat.TypeBaseURI,
+			instance:       r.URL.Path,
+		}
+		defer pw.finish()
+		next.ServeHTTP(pw, r)
+	})
+}
+
+// acceptsProblemJSON reports whether the Accept header lists application/problem+json with a non-zero
+// quality.
+func acceptsProblemJSON(accept string) bool {
+	for _, mediaRange := range strings.Split(accept, ",") {
+		mediaType, params, err := mime.ParseMediaType(strings.TrimSpace(mediaRange))
+		if err != nil || mediaType != constants.ContentTypeProblemJSON {
+			continue
+		}
+		if value, ok := params["q"]; ok {
+			if q, err := strconv.ParseFloat(value, 64); err != nil || q <= 0 {
+				continue
+			}
+		}
+		return true
+	}
+	return false
+}
+
+// problemResponseWriter buffers JSON error responses so that they can be converted to problem details once
+// the handler has finished writing them. Other responses are written through.
+type problemResponseWriter struct {
+	http.ResponseWriter
+	typeBaseURI string
+	instance    string
+	buf         bytes.Buffer
+	statusCode  int
+	buffering   bool
+}
+
+// WriteHeader buffers JSON error responses and writes any other status code through.
+func (w *problemResponseWriter) WriteHeader(statusCode int) {
+	if w.statusCode != 0 {
+		return
+	}
+	w.statusCode = statusCode
+	contentType, _, _ := mime.ParseMediaType(w.Header().Get(constants.ContentTypeHeaderName))
+	if statusCode >= http.StatusBadRequest && contentType == constants.ContentTypeJSON {
+		w.buffering = true
+		return
+	}
+	w.ResponseWriter.WriteHeader(statusCode)
+}
+
+// Write buffers the body of an error response, or writes the body through.
+func (w *problemResponseWriter) Write(data []byte) (int, error) {
+	if w.statusCode == 0 {
+		w.WriteHeader(http.StatusOK)
+	}
+	if w.buffering {
+		return w.buf.Write(data)
+	}
+	return w.ResponseWriter.Write(data)
+}
+
+// Unwrap returns the underlying response writer for http.ResponseController.
+func (w *problemResponseWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
+
+// finish writes a buffered error response, as problem details when the body is an API error response.
+func (w *problemResponseWriter) finish() {
+	if !w.buffering {
+		return
+	}
+
+	body := w.buf.Bytes()
+	if problem, ok := w.toProblem(body); ok {
+		if encoded, err := json.Marshal(problem); err == nil {
+			body = append(encoded, '\n')
+			w.Header().Set(constants.ContentTypeHeaderName, constants.ContentTypeProblemJSON)
+			w.Header().Del("Content-Length")
+		}
+	}
+
+	w.ResponseWriter.WriteHeader(w.statusCode)
+	_, _ = w.ResponseWriter.Write(body)
+}
+
+// toProblem maps an API error response body to problem details. It reports false for bodies that are not
+// API error responses.
+func (w *problemResponseWriter) toProblem(body []byte) (problemDetails, bool) {
+	var errResp apierror.ErrorResponse
+	if err := json.Unmarshal(body, &errResp); err != nil || errResp.Code == "" ||
+		errResp.Message.DefaultValue == "" {
+		return problemDetails{}, false
+	}
+
+	problemType := defaultProblemType
+	if w.typeBaseURI != "" {
+		problemType = w.typeBaseURI + errResp.Code
+	}
+	return problemDetails{
+		Type:     problemType,
+		Title:    errResp.Message.DefaultValue,
+		Status:   w.statusCode,
+		Detail:   errResp.Description.DefaultValue,
+		Instance: w.instance,
+		Code:     errResp.Code,
+	}, true
+}
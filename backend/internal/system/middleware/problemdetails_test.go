@@ -0,0 +1,145 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package middleware
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/error/apierror"
+	"github.com/thunder-id/thunderid/internal/system/i18n/core"
+	"github.com/thunder-id/thunderid/internal/system/utils"
+)
+
+var testErrorFormat = config.ErrorFormatConfig{
+	Default:     config.ErrorFormatLegacy,
+	TypeBaseURI: "urn:thunder:error:",
+}
+
+var testNotFoundError = apierror.ErrorResponse{
+	Code:        "USR-1003",
+	Message:     core.I18nMessage{Key: "error.user.not_found", DefaultValue: "User not found"},
+	Description: core.I18nMessage{Key: "error.user.not_found_description", DefaultValue: "No user has the given id"},
+}
+
+// serveProblem runs a request through the middleware with the given handler.
+func serveProblem(errorFormat config.ErrorFormatConfig, accept string,
+	handler http.HandlerFunc) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodGet, "/users/user-1", nil)
+	if accept != "" {
+		req.Header.Set("Accept", accept)
+	}
+	rr := httptest.NewRecorder()
+	ProblemDetailsMiddleware(errorFormat, handler).ServeHTTP(rr, req)
+	return rr
+}
+
+func writeNotFound(w http.ResponseWriter, _ *http.Request) {
+	utils.WriteErrorResponse(w, http.StatusNotFound, testNotFoundError)
+}
+
+func TestProblemDetailsMiddleware_ConvertsErrorWhenAccepted(t *testing.T) {
+	rr := serveProblem(testErrorFormat, "application/problem+json, application/json", writeNotFound)
+
+	assert.Equal(t, http.StatusNotFound, rr.Code)
+	assert.Equal(t, "application/problem+json", rr.Header().Get("Content-Type"))
+	var problem map[string]interface{}
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &problem))
+	assert.Equal(t, map[string]interface{}{
+		"type":     "urn:thunder:error:USR-1003",
+		"title":    "User not found",
+		"status":   float64(http.StatusNotFound),
+		"detail":   "No user has the given id",
+		"instance": "/users/user-1",
+		"code":     "USR-1003",
+	}, problem)
+}
+
+func TestProblemDetailsMiddleware_KeepsLegacyFormatByDefault(t *testing.T) {
+	rr := serveProblem(testErrorFormat, "application/json", writeNotFound)
+
+	assert.Equal(t, http.StatusNotFound, rr.Code)
+	assert.Equal(t, "application/json", rr.Header().Get("Content-Type"))
+	var errResp apierror.ErrorResponse
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &errResp))
+	assert.Equal(t, testNotFoundError, errResp)
+}
+
+func TestProblemDetailsMiddleware_ConvertsErrorWhenConfiguredAsDefault(t *testing.T) {
+	errorFormat := config.ErrorFormatConfig{Default: config.ErrorFormatProblem}
+
+	rr := serveProblem(errorFormat, "", writeNotFound)
+
+	assert.Equal(t, "application/problem+json", rr.Header().Get("Content-Type"))
+	var problem problemDetails
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &problem))
+	assert.Equal(t, "about:blank", problem.Type)
+	assert.Equal(t, "USR-1003", problem.Code)
+	assert.Equal(t, http.StatusNotFound, problem.Status)
+}
+
+func TestProblemDetailsMiddleware_IgnoresProblemJSONWithZeroQuality(t *testing.T) {
+	rr := serveProblem(testErrorFormat, "application/problem+json;q=0", writeNotFound)
+
+	assert.Equal(t, "application/json", rr.Header().Get("Content-Type"))
+}
+
+func TestProblemDetailsMiddleware_PassesSuccessResponses(t *testing.T) {
+	rr := serveProblem(testErrorFormat, "application/problem+json", func(w http.ResponseWriter, _ *http.Request) {
+		utils.WriteSuccessResponse(w, http.StatusOK, map[string]string{"id": "user-1"})
+	})
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.Equal(t, "application/json", rr.Header().Get("Content-Type"))
+	assert.JSONEq(t, `{"id":"user-1"}`, rr.Body.String())
+}
+
+func TestProblemDetailsMiddleware_KeepsOtherErrorShapes(t *testing.T) {
+	rr := serveProblem(testErrorFormat, "application/problem+json", func(w http.ResponseWriter, _ *http.Request) {
+		utils.WriteJSONError(w, "invalid_request", "Missing grant type", http.StatusBadRequest, nil)
+	})
+
+	assert.Equal(t, http.StatusBadRequest, rr.Code)
+	assert.Equal(t, "application/json", rr.Header().Get("Content-Type"))
+	assert.JSONEq(t, `{"error":"invalid_request","error_description":"Missing grant type"}`, rr.Body.String())
+}
+
+func TestProblemDetailsMiddleware_KeepsNonJSONErrors(t *testing.T) {
+	rr := serveProblem(testErrorFormat, "application/problem+json", func(w http.ResponseWriter, r *http.Request) {
+		http.NotFound(w, r)
+	})
+
+	assert.Equal(t, http.StatusNotFound, rr.Code)
+	assert.Contains(t, rr.Body.String(), "404 page not found")
+}
+
+func TestAcceptsProblemJSON(t *testing.T) {
+	assert.True(t, acceptsProblemJSON("application/problem+json"))
+	assert.True(t, acceptsProblemJSON("application/json, application/problem+json;q=0.5"))
+	assert.False(t, acceptsProblemJSON(""))
+	assert.False(t, acceptsProblemJSON("*/*"))
+	assert.False(t, acceptsProblemJSON("application/problem+json;q=0"))
+	assert.False(t, acceptsProblemJSON("application/problem+json;q=invalid"))
+}
@@ -0,0 +1,154 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package middleware
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/thunder-id/thunderid/internal/system/apiversion"
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/error/apierror"
+	"github.com/thunder-id/thunderid/internal/system/utils"
+)
+
+// errJSONTooComplex signals that a JSON body exceeds the nesting depth or array length limits.
+var errJSONTooComplex = errors.New("json payload exceeds the configured limits")
+
+// RequestLimitMiddleware enforces the request body size limit of the matched route and the JSON nesting
+// depth and array length limits before the request reaches the route handlers. The JSON limits are checked
+// whatever the content type of the body, since handlers decode JSON regardless of the header. Bodies that
+// are not JSON, such as forms, YAML or images, end the check at their first token. Routes that stream JSON
+// are only size limited, since their handlers enforce the JSON limits while decoding.
+func RequestLimitMiddleware(limits config.RequestLimits, next http.Handler) http.Handler {
+	checkJSON := limits.MaxJSONDepth > 0 || limits.MaxJSONArrayLength > 0
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Body == nil || r.Body == http.NoBody {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		maxSize, streamJSON := routeLimit(limits, apiversion.StripVersionPrefix(r.URL.Path))
+		if maxSize > 0 {
+			if r.ContentLength > maxSize {
+				utils.WriteErrorResponse(w, http.StatusRequestEntityTooLarge, apierror.ErrRequestBodyTooLarge)
+				return
+			}
+			r.Body = http.MaxBytesReader(w, r.Body, maxSize)
+		}
+
+		if !checkJSON || streamJSON {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		data, err := io.ReadAll(r.Body)
+		if err != nil {
+			var maxBytesErr *http.MaxBytesError
+			if errors.As(err, &maxBytesErr) {
+				utils.WriteErrorResponse(w, http.StatusRequestEntityTooLarge, apierror.ErrRequestBodyTooLarge)
+				return
+			}
+			utils.WriteErrorResponse(w, http.StatusBadRequest, apierror.ErrInvalidRequestBody)
+			return
+		}
+		if err := checkJSONLimits(data, limits.MaxJSONDepth, limits.MaxJSONArrayLength); err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, apierror.ErrJSONTooComplex)
+			return
+		}
+
+		r.Body = io.NopCloser(bytes.NewReader(data))
+		r.ContentLength = int64(len(data))
+		next.ServeHTTP(w, r)
+	})
+}
+
+// routeLimit returns the body size limit of the first route matching the path, or the default limit, and
+// whether the route decodes JSON as a stream.
+func routeLimit(limits config.RequestLimits, path string) (int64, bool) {
+	for _, route := range limits.Routes {
+		if matchPathPattern(route.Path, path) {
+			return route.MaxBodySize, route.StreamJSON
+		}
+	}
+	return limits.MaxBodySize, false
+}
+
+// matchPathPattern reports whether the path matches the pattern. A "*" segment matches a single path
+// segment and a trailing "**" segment matches zero or more segments.
+func matchPathPattern(pattern, path string) bool {
+	patternSegments := strings.Split(strings.Trim(pattern, "/"), "/")
+	pathSegments := strings.Split(strings.Trim(path, "/"), "/")
+
+	for i, segment := range patternSegments {
+		if segment == "**" && i == len(patternSegments)-1 {
+			return true
+		}
+		if i >= len(pathSegments) || (segment != "*" && segment != pathSegments[i]) {
+			return false
+		}
+	}
+	return len(patternSegments) == len(pathSegments)
+}
+
+// checkJSONLimits walks the tokens of a JSON document and returns errJSONTooComplex when an object or
+// array is nested deeper than maxDepth or an array has more than maxArrayLength elements. A zero limit
+// is not enforced. Malformed documents are left to the handlers to reject.
+func checkJSONLimits(data []byte, maxDepth, maxArrayLength int) error {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	// lengths holds the element count of each open container; objects are marked with -1.
+	var lengths []int
+	for {
+		token, err := decoder.Token()
+		if err != nil {
+			return nil
+		}
+
+		delim, isDelim := token.(json.Delim)
+		if isDelim && (delim == '}' || delim == ']') {
+			lengths = lengths[:len(lengths)-1]
+			continue
+		}
+
+		if top := len(lengths) - 1; top >= 0 && lengths[top] >= 0 {
+			lengths[top]++
+			if maxArrayLength > 0 && lengths[top] > maxArrayLength {
+				return errJSONTooComplex
+			}
+		}
+
+		if isDelim {
+			if maxDepth > 0 && len(lengths) >= maxDepth {
+				return errJSONTooComplex
+			}
+			if delim == '[' {
+				lengths = append(lengths, 0)
+			} else {
+				lengths = append(lengths, -1)
+			}
+		}
+	}
+}
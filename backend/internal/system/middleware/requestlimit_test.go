@@ -0,0 +1,185 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package middleware
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/error/apierror"
+)
+
+var testRequestLimits = config.RequestLimits{
+	MaxBodySize:        64,
+	MaxJSONDepth:       3,
+	MaxJSONArrayLength: 4,
+	Routes: []config.RouteRequestLimit{
+		{Path: "/users/*/picture", MaxBodySize: 1024},
+		{Path: "/import/**", MaxBodySize: 0},
+		{Path: "/flows", MaxBodySize: 128, StreamJSON: true},
+	},
+}
+
+// serveWithLimits runs a request through the middleware and returns the recorder and the body seen by
+// the next handler.
+func serveWithLimits(t *testing.T, req *http.Request) (*httptest.ResponseRecorder, string) {
+	var received string
+	handler := RequestLimitMiddleware(testRequestLimits, http.HandlerFunc(func(w http.ResponseWriter,
+		r *http.Request) {
+		data, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		received = string(data)
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	rr := httptest.NewRecorder()
+	handler.ServeHTTP(rr, req)
+	return rr, received
+}
+
+func assertErrorCode(t *testing.T, rr *httptest.ResponseRecorder, status int, code string) {
+	assert.Equal(t, status, rr.Code)
+	var errResp apierror.ErrorResponse
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &errResp))
+	assert.Equal(t, code, errResp.Code)
+}
+
+func TestRequestLimitMiddleware_PassesValidJSON(t *testing.T) {
+	body := `{"attributes":{"tags":["a","b"]}}`
+	req := httptest.NewRequest(http.MethodPost, "/users", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+
+	rr, received := serveWithLimits(t, req)
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.Equal(t, body, received)
+}
+
+func TestRequestLimitMiddleware_RejectsDeclaredOversizedBody(t *testing.T) {
+	req := httptest.NewRequest(http.MethodPost, "/users", strings.NewReader(strings.Repeat("a", 65)))
+
+	rr, _ := serveWithLimits(t, req)
+
+	assertErrorCode(t, rr, http.StatusRequestEntityTooLarge, apierror.ErrRequestBodyTooLarge.Code)
+}
+
+func TestRequestLimitMiddleware_RejectsStreamedOversizedJSON(t *testing.T) {
+	req := httptest.NewRequest(http.MethodPost, "/users", strings.NewReader(`"`+strings.Repeat("a", 100)+`"`))
+	req.ContentLength = -1
+
+	rr, _ := serveWithLimits(t, req)
+
+	assertErrorCode(t, rr, http.StatusRequestEntityTooLarge, apierror.ErrRequestBodyTooLarge.Code)
+}
+
+func TestRequestLimitMiddleware_RouteLimits(t *testing.T) {
+	picture := httptest.NewRequest(http.MethodPut, "/users/u-1/picture", strings.NewReader(strings.Repeat("a", 512)))
+	picture.Header.Set("Content-Type", "image/png")
+	rr, received := serveWithLimits(t, picture)
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.Len(t, received, 512)
+
+	versioned := httptest.NewRequest(http.MethodPut, "/v1/users/u-1/picture",
+		strings.NewReader(strings.Repeat("a", 2048)))
+	versioned.Header.Set("Content-Type", "image/png")
+	rr, _ = serveWithLimits(t, versioned)
+	assertErrorCode(t, rr, http.StatusRequestEntityTooLarge, apierror.ErrRequestBodyTooLarge.Code)
+
+	unlimited := httptest.NewRequest(http.MethodPost, "/import", strings.NewReader(strings.Repeat("a", 4096)))
+	unlimited.Header.Set("Content-Type", "text/plain")
+	rr, _ = serveWithLimits(t, unlimited)
+	assert.Equal(t, http.StatusOK, rr.Code)
+}
+
+func TestRequestLimitMiddleware_RejectsComplexJSON(t *testing.T) {
+	testCases := []struct {
+		name string
+		body string
+	}{
+		{"TooDeep", `{"a":{"b":{"c":{}}}}`},
+		{"TooDeepArrays", `[[[[1]]]]`},
+		{"ArrayTooLong", `{"a":[1,2,3,4,5]}`},
+		{"NestedArrayTooLong", `[[],[{"x":[1,2,3,4,5]}]]`},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/users", strings.NewReader(tc.body))
+
+			rr, _ := serveWithLimits(t, req)
+
+			assertErrorCode(t, rr, http.StatusBadRequest, apierror.ErrJSONTooComplex.Code)
+		})
+	}
+}
+
+func TestRequestLimitMiddleware_LeavesJSONLimitsOfStreamedRoutesToHandlers(t *testing.T) {
+	body := `{"nodes":[[[[1]]],[1,2,3,4,5]]}`
+	req := httptest.NewRequest(http.MethodPost, "/flows", strings.NewReader(body))
+
+	rr, received := serveWithLimits(t, req)
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.Equal(t, body, received)
+
+	oversized := httptest.NewRequest(http.MethodPost, "/flows", strings.NewReader(strings.Repeat("a", 256)))
+	rr, _ = serveWithLimits(t, oversized)
+	assertErrorCode(t, rr, http.StatusRequestEntityTooLarge, apierror.ErrRequestBodyTooLarge.Code)
+}
+
+func TestRequestLimitMiddleware_LeavesMalformedAndNonJSONBodiesToHandlers(t *testing.T) {
+	malformed := httptest.NewRequest(http.MethodPost, "/users", strings.NewReader(`{"a":`))
+	rr, received := serveWithLimits(t, malformed)
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.Equal(t, `{"a":`, received)
+
+	form := httptest.NewRequest(http.MethodPost, "/oauth2/token", strings.NewReader("a=[[[[[1]]]]]"))
+	form.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	rr, _ = serveWithLimits(t, form)
+	assert.Equal(t, http.StatusOK, rr.Code)
+}
+
+func TestRequestLimitMiddleware_ChecksJSONWhateverTheContentType(t *testing.T) {
+	for _, contentType := range []string{"text/plain", "application/x-www-form-urlencoded", "invalid;;"} {
+		t.Run(contentType, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/users", strings.NewReader(`[[[[1]]]]`))
+			req.Header.Set("Content-Type", contentType)
+
+			rr, _ := serveWithLimits(t, req)
+
+			assertErrorCode(t, rr, http.StatusBadRequest, apierror.ErrJSONTooComplex.Code)
+		})
+	}
+}
+
+func TestMatchPathPattern(t *testing.T) {
+	assert.True(t, matchPathPattern("/users/*/picture", "/users/u-1/picture"))
+	assert.False(t, matchPathPattern("/users/*/picture", "/users/u-1"))
+	assert.False(t, matchPathPattern("/users/*/picture", "/users/u-1/picture/view"))
+	assert.True(t, matchPathPattern("/import/**", "/import"))
+	assert.True(t, matchPathPattern("/import/**", "/import/delete"))
+	assert.False(t, matchPathPattern("/import/**", "/imports"))
+}
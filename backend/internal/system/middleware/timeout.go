@@ -0,0 +1,114 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package middleware
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/system/apiversion"
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/error/apierror"
+	"github.com/thunder-id/thunderid/internal/system/utils"
+)
+
+// defaultTimeoutRoute labels the timeout metrics of routes served under the default budget.
+const defaultTimeoutRoute = "default"
+
+// RequestTimeoutMiddleware bounds each request by the time budget of the matched route. The budget is set
+// as the deadline of the request context, so services, stores and outbound HTTP calls that honour the
+// context give up once it has passed. A request that runs out of budget is answered with 504 Gateway
+// Timeout, unless the handler has already committed a successful response.
+func RequestTimeoutMiddleware(timeouts config.RequestTimeouts, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		route, budget := routeTimeout(timeouts, apiversion.StripVersionPrefix(r.URL.Path))
+		if budget <= 0 {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		ctx, cancel := context.WithTimeout(r.Context(), budget)
+		defer cancel()
+
+		tw := &timeoutResponseWriter{ResponseWriter: w, ctx: ctx}
+		next.ServeHTTP(tw, r.WithContext(ctx))
+
+		if !tw.wroteHeader && errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			tw.timedOut = true
+			utils.WriteErrorResponse(w, http.StatusGatewayTimeout, apierror.ErrRequestTimeout)
+		}
+		if tw.timedOut {
+			recordRequestTimeout(r.Context(), route)
+		}
+	})
+}
+
+// routeTimeout returns the label and time budget of the first route matching the path, or the default
+// budget.
+func routeTimeout(timeouts config.RequestTimeouts, path string) (string, time.Duration) {
+	for _, route := range timeouts.Routes {
+		if matchPathPattern(route.Path, path) {
+			return route.Path, time.Duration(route.Timeout) * time.Second
+		}
+	}
+	return defaultTimeoutRoute, time.Duration(timeouts.Default) * time.Second
+}
+
+// timeoutResponseWriter replaces server error responses written after the request deadline has passed
+// with a 504 response, since those errors are the result of cancelled downstream calls.
+type timeoutResponseWriter struct {
+	http.ResponseWriter
+	ctx         context.Context
+	wroteHeader bool
+	timedOut    bool
+}
+
+// WriteHeader writes the status code, or a 504 response when the deadline has passed and the handler
+// reports a server error.
+func (w *timeoutResponseWriter) WriteHeader(statusCode int) {
+	if w.wroteHeader {
+		return
+	}
+	w.wroteHeader = true
+	if statusCode >= http.StatusInternalServerError && errors.Is(w.ctx.Err(), context.DeadlineExceeded) {
+		w.timedOut = true
+		w.ResponseWriter.Header().Del("Content-Length")
+		utils.WriteErrorResponse(w.ResponseWriter, http.StatusGatewayTimeout, apierror.ErrRequestTimeout)
+		return
+	}
+	w.ResponseWriter.WriteHeader(statusCode)
+}
+
+// Write writes the response body, discarding it when the response has been replaced by a 504 response.
+func (w *timeoutResponseWriter) Write(data []byte) (int, error) {
+	if !w.wroteHeader {
+		w.WriteHeader(http.StatusOK)
+	}
+	if w.timedOut {
+		return len(data), nil
+	}
+	return w.ResponseWriter.Write(data)
+}
+
+// Unwrap returns the underlying response writer for http.ResponseController.
+func (w *timeoutResponseWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
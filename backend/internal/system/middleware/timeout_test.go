@@ -0,0 +1,138 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/error/apierror"
+)
+
+var testRequestTimeouts = config.RequestTimeouts{
+	Default: 5,
+	Routes: []config.RouteRequestTimeout{
+		{Path: "/slow", Timeout: 1},
+		{Path: "/import/**", Timeout: 0},
+	},
+}
+
+func TestRouteTimeout(t *testing.T) {
+	route, budget := routeTimeout(testRequestTimeouts, "/slow")
+	assert.Equal(t, "/slow", route)
+	assert.Equal(t, time.Second, budget)
+
+	route, budget = routeTimeout(testRequestTimeouts, "/import/users")
+	assert.Equal(t, "/import/**", route)
+	assert.Zero(t, budget)
+
+	route, budget = routeTimeout(testRequestTimeouts, "/users")
+	assert.Equal(t, defaultTimeoutRoute, route)
+	assert.Equal(t, 5*time.Second, budget)
+}
+
+func TestRequestTimeoutMiddleware_SetsDeadline(t *testing.T) {
+	var deadline time.Time
+	var hasDeadline bool
+	handler := RequestTimeoutMiddleware(testRequestTimeouts, http.HandlerFunc(func(w http.ResponseWriter,
+		r *http.Request) {
+		deadline, hasDeadline = r.Context().Deadline()
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	rr := httptest.NewRecorder()
+	handler.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/v1/slow", nil))
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.True(t, hasDeadline)
+	assert.WithinDuration(t, time.Now().Add(time.Second), deadline, time.Second)
+}
+
+func TestRequestTimeoutMiddleware_NoBudget(t *testing.T) {
+	var hasDeadline bool
+	handler := RequestTimeoutMiddleware(testRequestTimeouts, http.HandlerFunc(func(w http.ResponseWriter,
+		r *http.Request) {
+		_, hasDeadline = r.Context().Deadline()
+		w.WriteHeader(http.StatusCreated)
+	}))
+
+	rr := httptest.NewRecorder()
+	handler.ServeHTTP(rr, httptest.NewRequest(http.MethodPost, "/import/users", nil))
+
+	assert.Equal(t, http.StatusCreated, rr.Code)
+	assert.False(t, hasDeadline)
+}
+
+func TestRequestTimeoutMiddleware_NoResponseBeforeDeadline(t *testing.T) {
+	timeouts := config.RequestTimeouts{Routes: []config.RouteRequestTimeout{{Path: "/slow", Timeout: 1}}}
+	handler := RequestTimeoutMiddleware(timeouts, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-r.Context().Done()
+	}))
+
+	rr := httptest.NewRecorder()
+	handler.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/slow", nil))
+
+	assertErrorCode(t, rr, http.StatusGatewayTimeout, apierror.ErrRequestTimeout.Code)
+}
+
+func TestTimeoutResponseWriter_ReplacesServerErrorAfterDeadline(t *testing.T) {
+	ctx, cancel := context.WithDeadline(context.Background(), time.Now().Add(-time.Second))
+	defer cancel()
+	rr := httptest.NewRecorder()
+	tw := &timeoutResponseWriter{ResponseWriter: rr, ctx: ctx}
+
+	tw.WriteHeader(http.StatusInternalServerError)
+	n, err := tw.Write([]byte(`{"code":"SSE-5000"}`))
+
+	assert.NoError(t, err)
+	assert.Equal(t, len(`{"code":"SSE-5000"}`), n)
+	assert.True(t, tw.timedOut)
+	assertErrorCode(t, rr, http.StatusGatewayTimeout, apierror.ErrRequestTimeout.Code)
+}
+
+func TestTimeoutResponseWriter_KeepsSuccessAfterDeadline(t *testing.T) {
+	ctx, cancel := context.WithDeadline(context.Background(), time.Now().Add(-time.Second))
+	defer cancel()
+	rr := httptest.NewRecorder()
+	tw := &timeoutResponseWriter{ResponseWriter: rr, ctx: ctx}
+
+	_, err := tw.Write([]byte("ok"))
+
+	assert.NoError(t, err)
+	assert.False(t, tw.timedOut)
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.Equal(t, "ok", rr.Body.String())
+}
+
+func TestTimeoutResponseWriter_KeepsServerErrorBeforeDeadline(t *testing.T) {
+	rr := httptest.NewRecorder()
+	tw := &timeoutResponseWriter{ResponseWriter: rr, ctx: context.Background()}
+
+	tw.WriteHeader(http.StatusServiceUnavailable)
+	tw.WriteHeader(http.StatusOK)
+
+	assert.False(t, tw.timedOut)
+	assert.Equal(t, http.StatusServiceUnavailable, rr.Code)
+}
@@ -0,0 +1,514 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package middleware
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/thunder-id/thunderid/internal/system/apiversion"
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/constants"
+	"github.com/thunder-id/thunderid/internal/system/error/apierror"
+	"github.com/thunder-id/thunderid/internal/system/i18n/core"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/internal/system/utils"
+)
+
+// yamlRoutes lists the configuration resource routes that accept and return YAML bodies.
+var yamlRoutes = []string{"/flows/**", "/user-types/**", "/applications/**", "/roles/**"}
+
+// yamlMediaTypes lists the media types accepted for YAML bodies.
+var yamlMediaTypes = map[string]bool{
+	constants.ContentTypeYAML: true,
+	"application/x-yaml":      true,
+	"text/yaml":               true,
+	"text/x-yaml":             true,
+}
+
+// errConvertedTooLarge signals that a converted body exceeds the body size limit of the route.
+var errConvertedTooLarge = errors.New("converted body exceeds the size limit")
+
+// YAMLContentMiddleware negotiates YAML bodies on the configuration resource routes. Request bodies sent as
+// YAML are converted to JSON before they reach the route handlers, so the handlers and the JSON limits of
+// the request limit middleware see the same body as for a JSON request. Responses are converted to YAML
+// when the Accept header prefers YAML over JSON. YAML bodies are read within the body size limit of the
+// route and a body that cannot be parsed is rejected with 400.
+func YAMLContentMiddleware(limits config.RequestLimits, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path := apiversion.StripVersionPrefix(r.URL.Path)
+		if !isYAMLRoute(path) {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		w.Header().Add("Vary", constants.AcceptHeaderName)
+		if prefersYAML(r.Header.Get(constants.AcceptHeaderName)) {
+			yw := &yamlResponseWriter{ResponseWriter: w}
+			defer yw.finish()
+			w = yw
+		}
+
+		if r.Body == nil || r.Body == http.NoBody || !isYAMLContentType(r.Header.Get("Content-Type")) {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		maxSize, _ := routeLimit(limits, path)
+		if maxSize > 0 {
+			if r.ContentLength > maxSize {
+				utils.WriteErrorResponse(w, http.StatusRequestEntityTooLarge, apierror.ErrRequestBodyTooLarge)
+				return
+			}
+			r.Body = http.MaxBytesReader(w, r.Body, maxSize)
+		}
+
+		data, err := io.ReadAll(r.Body)
+		if err != nil {
+			var maxBytesErr *http.MaxBytesError
+			if errors.As(err, &maxBytesErr) {
+				utils.WriteErrorResponse(w, http.StatusRequestEntityTooLarge, apierror.ErrRequestBodyTooLarge)
+				return
+			}
+			utils.WriteErrorResponse(w, http.StatusBadRequest, apierror.ErrInvalidRequestBody)
+			return
+		}
+
+		converted, err := yamlToJSON(data, maxSize)
+		if err != nil {
+			if errors.Is(err, errConvertedTooLarge) {
+				utils.WriteErrorResponse(w, http.StatusRequestEntityTooLarge, apierror.ErrRequestBodyTooLarge)
+				return
+			}
+			errResp := apierror.ErrInvalidYAMLBody
+			errResp.Description = core.I18nMessage{
+				Key:          apierror.ErrInvalidYAMLBody.Description.Key,
+				DefaultValue: err.Error(),
+			}
+			utils.WriteErrorResponse(w, http.StatusBadRequest, errResp)
+			return
+		}
+
+		r.Body = io.NopCloser(bytes.NewReader(converted))
+		r.ContentLength = int64(len(converted))
+		r.Header.Set("Content-Type", constants.ContentTypeJSON)
+		r.Header.Del("Content-Length")
+		next.ServeHTTP(w, r)
+	})
+}
+
+// isYAMLRoute reports whether the path belongs to a route that negotiates YAML bodies.
+func isYAMLRoute(path string) bool {
+	for _, route := range yamlRoutes {
+		if matchPathPattern(route, path) {
+			return true
+		}
+	}
+	return false
+}
+
+// isYAMLContentType reports whether a body with the given content type is decoded as YAML.
+func isYAMLContentType(contentType string) bool {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return false
+	}
+	return yamlMediaTypes[mediaType] || strings.HasSuffix(mediaType, "+yaml")
+}
+
+// prefersYAML reports whether the Accept header prefers YAML over JSON. JSON stays the default, so YAML is
+// only chosen when it is ranked above JSON and no wildcard is ranked above it.
+func prefersYAML(accept string) bool {
+	if accept == "" {
+		return false
+	}
+
+	var yamlQ, jsonQ, wildcardQ float64
+	for _, mediaRange := range strings.Split(accept, ",") {
+		mediaType, params, err := mime.ParseMediaType(strings.TrimSpace(mediaRange))
+		if err != nil {
+			continue
+		}
+		q := 1.0
+		if value, ok := params["q"]; ok {
+			if q, err = strconv.ParseFloat(value, 64); err != nil {
+				continue
+			}
+		}
+
+		switch {
+		case yamlMediaTypes[mediaType] || strings.HasSuffix(mediaType, "+yaml"):
+			yamlQ = math.Max(yamlQ, q)
+		case mediaType == constants.ContentTypeJSON || strings.HasSuffix(mediaType, "+json"):
+			jsonQ = math.Max(jsonQ, q)
+		case mediaType == "*/*" || mediaType == "application/*":
+			wildcardQ = math.Max(wildcardQ, q)
+		}
+	}
+	return yamlQ > 0 && yamlQ > jsonQ && yamlQ >= wildcardQ
+}
+
+// yamlResponseWriter buffers the response of a request that prefers YAML, so that a JSON body can be
+// converted once the handler has finished writing it.
+type yamlResponseWriter struct {
+	http.ResponseWriter
+	buf        bytes.Buffer
+	statusCode int
+}
+
+// WriteHeader records the status code until the response is written by finish.
+func (w *yamlResponseWriter) WriteHeader(statusCode int) {
+	if w.statusCode == 0 {
+		w.statusCode = statusCode
+	}
+}
+
+// Write buffers the response body.
+func (w *yamlResponseWriter) Write(data []byte) (int, error) {
+	if w.statusCode == 0 {
+		w.statusCode = http.StatusOK
+	}
+	return w.buf.Write(data)
+}
+
+// Unwrap returns the underlying response writer for http.ResponseController.
+func (w *yamlResponseWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
+
+// finish writes the buffered response, converting a JSON body to YAML. A body that cannot be converted is
+// written as JSON.
+func (w *yamlResponseWriter) finish() {
+	if w.statusCode == 0 {
+		return
+	}
+
+	body := w.buf.Bytes()
+	header := w.ResponseWriter.Header()
+	if w.buf.Len() > 0 && isJSONResponse(header.Get("Content-Type")) {
+		converted, err := jsonToYAML(body)
+		if err != nil {
+			log.GetLogger().Warn("Failed to convert the response body to YAML", log.Error(err))
+		} else {
+			body = converted
+			header.Set("Content-Type", constants.ContentTypeYAML)
+			header.Del("Content-Length")
+		}
+	}
+
+	w.ResponseWriter.WriteHeader(w.statusCode)
+	_, _ = w.ResponseWriter.Write(body)
+}
+
+// isJSONResponse reports whether a response with the given content type carries a JSON body.
+func isJSONResponse(contentType string) bool {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return false
+	}
+	return mediaType == constants.ContentTypeJSON || strings.HasSuffix(mediaType, "+json")
+}
+
+// jsonToYAML converts a JSON document to YAML. Object keys keep their order and scalars keep their
+// exact representation, so converting the result back to JSON yields the same document.
+func jsonToYAML(data []byte) ([]byte, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	node, err := decodeJSONNode(decoder)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := decoder.Token(); !errors.Is(err, io.EOF) {
+		return nil, errors.New("unexpected data after the JSON document")
+	}
+
+	var buf bytes.Buffer
+	encoder := yaml.NewEncoder(&buf)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(node); err != nil {
+		return nil, err
+	}
+	if err := encoder.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// decodeJSONNode decodes the next JSON value of the decoder into a YAML node.
+func decodeJSONNode(decoder *json.Decoder) (*yaml.Node, error) {
+	token, err := decoder.Token()
+	if err != nil {
+		return nil, err
+	}
+
+	switch value := token.(type) {
+	case json.Delim:
+		node := &yaml.Node{Kind: yaml.SequenceNode, Tag: "!!seq"}
+		if value == '{' {
+			node = &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map"}
+		}
+		for decoder.More() {
+			if node.Kind == yaml.MappingNode {
+				key, err := decoder.Token()
+				if err != nil {
+					return nil, err
+				}
+				node.Content = append(node.Content, scalarNode("!!str", key.(string)))
+			}
+			child, err := decodeJSONNode(decoder)
+			if err != nil {
+				return nil, err
+			}
+			node.Content = append(node.Content, child)
+		}
+		if _, err := decoder.Token(); err != nil {
+			return nil, err
+		}
+		return node, nil
+	case string:
+		return scalarNode("!!str", value), nil
+	case json.Number:
+		if strings.ContainsAny(value.String(), ".eE") {
+			return scalarNode("!!float", value.String()), nil
+		}
+		return scalarNode("!!int", value.String()), nil
+	case bool:
+		return scalarNode("!!bool", strconv.FormatBool(value)), nil
+	default:
+		return scalarNode("!!null", "null"), nil
+	}
+}
+
+// scalarNode returns a YAML scalar node with the given tag and value.
+func scalarNode(tag, value string) *yaml.Node {
+	return &yaml.Node{Kind: yaml.ScalarNode, Tag: tag, Value: value}
+}
+
+// yamlToJSON converts a single YAML document to JSON. Anchors and merge keys are expanded, mapping keys
+// keep their order and numbers that are valid JSON keep their exact representation. Values that JSON
+// cannot represent, such as non-scalar keys or infinite numbers, are rejected. A positive maxSize bounds
+// the size of the converted document, so that expanding aliases cannot inflate a small body.
+func yamlToJSON(data []byte, maxSize int64) ([]byte, error) {
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+
+	var document yaml.Node
+	if err := decoder.Decode(&document); err != nil {
+		if errors.Is(err, io.EOF) {
+			return []byte{}, nil
+		}
+		return nil, err
+	}
+	var next yaml.Node
+	if err := decoder.Decode(&next); !errors.Is(err, io.EOF) {
+		if err != nil {
+			return nil, err
+		}
+		return nil, errors.New("yaml: the request body must contain a single document")
+	}
+
+	converter := &yamlConverter{maxSize: maxSize}
+	if err := converter.write(&document); err != nil {
+		return nil, err
+	}
+	return converter.buf.Bytes(), nil
+}
+
+// yamlConverter writes YAML nodes as JSON.
+type yamlConverter struct {
+	buf     bytes.Buffer
+	maxSize int64
+}
+
+// write writes a YAML node as JSON.
+func (c *yamlConverter) write(node *yaml.Node) error {
+	if c.maxSize > 0 && int64(c.buf.Len()) > c.maxSize {
+		return errConvertedTooLarge
+	}
+
+	switch node.Kind {
+	case yaml.DocumentNode:
+		return c.write(node.Content[0])
+	case yaml.AliasNode:
+		return c.write(node.Alias)
+	case yaml.SequenceNode:
+		c.buf.WriteByte('[')
+		for i, child := range node.Content {
+			if i > 0 {
+				c.buf.WriteByte(',')
+			}
+			if err := c.write(child); err != nil {
+				return err
+			}
+		}
+		c.buf.WriteByte(']')
+		return nil
+	case yaml.MappingNode:
+		return c.writeMapping(node)
+	default:
+		return c.writeScalar(node)
+	}
+}
+
+// writeMapping writes a YAML mapping as a JSON object. Keys of merged mappings are written after the keys
+// of the mapping itself and only when the mapping does not define them.
+func (c *yamlConverter) writeMapping(node *yaml.Node) error {
+	keys, values, err := mappingEntries(node)
+	if err != nil {
+		return err
+	}
+
+	c.buf.WriteByte('{')
+	for i, key := range keys {
+		if i > 0 {
+			c.buf.WriteByte(',')
+		}
+		encodedKey, err := json.Marshal(key)
+		if err != nil {
+			return err
+		}
+		c.buf.Write(encodedKey)
+		c.buf.WriteByte(':')
+		if err := c.write(values[i]); err != nil {
+			return err
+		}
+	}
+	c.buf.WriteByte('}')
+	return nil
+}
+
+// mappingEntries returns the keys and values of a YAML mapping with merge keys expanded.
+func mappingEntries(node *yaml.Node) ([]string, []*yaml.Node, error) {
+	var keys []string
+	var values []*yaml.Node
+	var merged []*yaml.Node
+	seen := make(map[string]bool)
+
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		keyNode, valueNode := resolveAlias(node.Content[i]), node.Content[i+1]
+		if keyNode.Kind != yaml.ScalarNode {
+			return nil, nil, fmt.Errorf("yaml: line %d: mapping keys must be scalars", keyNode.Line)
+		}
+		if keyNode.ShortTag() == "!!merge" {
+			merged = append(merged, valueNode)
+			continue
+		}
+		if seen[keyNode.Value] {
+			return nil, nil, fmt.Errorf("yaml: line %d: mapping key %q already defined", keyNode.Line,
+				keyNode.Value)
+		}
+		seen[keyNode.Value] = true
+		keys = append(keys, keyNode.Value)
+		values = append(values, valueNode)
+	}
+
+	for _, mergeNode := range merged {
+		mergeNode = resolveAlias(mergeNode)
+		sources := []*yaml.Node{mergeNode}
+		if mergeNode.Kind == yaml.SequenceNode {
+			sources = mergeNode.Content
+		}
+		for _, source := range sources {
+			source = resolveAlias(source)
+			if source.Kind != yaml.MappingNode {
+				return nil, nil, fmt.Errorf("yaml: line %d: merge keys must refer to mappings", source.Line)
+			}
+			sourceKeys, sourceValues, err := mappingEntries(source)
+			if err != nil {
+				return nil, nil, err
+			}
+			for j, key := range sourceKeys {
+				if !seen[key] {
+					seen[key] = true
+					keys = append(keys, key)
+					values = append(values, sourceValues[j])
+				}
+			}
+		}
+	}
+	return keys, values, nil
+}
+
+// resolveAlias returns the node an alias refers to, or the node itself.
+func resolveAlias(node *yaml.Node) *yaml.Node {
+	for node.Kind == yaml.AliasNode {
+		node = node.Alias
+	}
+	return node
+}
+
+// writeScalar writes a YAML scalar as a JSON value according to its resolved tag.
+func (c *yamlConverter) writeScalar(node *yaml.Node) error {
+	switch node.ShortTag() {
+	case "!!null":
+		c.buf.WriteString("null")
+		return nil
+	case "!!bool":
+		var value bool
+		if err := node.Decode(&value); err != nil {
+			return err
+		}
+		c.buf.WriteString(strconv.FormatBool(value))
+		return nil
+	case "!!int", "!!float":
+		return c.writeNumber(node)
+	case "!!str", "!!timestamp", "!!binary":
+		encoded, err := json.Marshal(node.Value)
+		if err != nil {
+			return err
+		}
+		c.buf.Write(encoded)
+		return nil
+	default:
+		return fmt.Errorf("yaml: line %d: unsupported tag %s", node.Line, node.Tag)
+	}
+}
+
+// writeNumber writes a YAML number as a JSON number. Numbers that are valid JSON are written as they
+// appear in the document, while other notations such as hexadecimal integers are normalized.
+func (c *yamlConverter) writeNumber(node *yaml.Node) error {
+	if json.Valid([]byte(node.Value)) {
+		c.buf.WriteString(node.Value)
+		return nil
+	}
+
+	var value interface{}
+	if err := node.Decode(&value); err != nil {
+		return err
+	}
+	if number, ok := value.(float64); ok && (math.IsInf(number, 0) || math.IsNaN(number)) {
+		return fmt.Errorf("yaml: line %d: %s cannot be represented in JSON", node.Line, node.Value)
+	}
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	c.buf.Write(encoded)
+	return nil
+}
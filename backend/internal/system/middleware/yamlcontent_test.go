@@ -0,0 +1,253 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package middleware
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/error/apierror"
+)
+
+var testYAMLLimits = config.RequestLimits{
+	MaxBodySize: 256,
+	Routes: []config.RouteRequestLimit{
+		{Path: "/flows", MaxBodySize: 0, StreamJSON: true},
+	},
+}
+
+// serveYAML runs a request through the middleware with a handler that echoes the received body as JSON,
+// and returns the recorder and the body and content type seen by the handler.
+func serveYAML(t *testing.T, req *http.Request) (*httptest.ResponseRecorder, string, string) {
+	var received, contentType string
+	handler := YAMLContentMiddleware(testYAMLLimits, http.HandlerFunc(func(w http.ResponseWriter,
+		r *http.Request) {
+		data, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		received = string(data)
+		contentType = r.Header.Get("Content-Type")
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"id":"app-1","name":"Sample","enabled":true,"scopes":["openid"],"ttl":3600}`))
+	}))
+
+	rr := httptest.NewRecorder()
+	handler.ServeHTTP(rr, req)
+	return rr, received, contentType
+}
+
+func assertYAMLErrorCode(t *testing.T, rr *httptest.ResponseRecorder, status int,
+	code string) apierror.ErrorResponse {
+	assert.Equal(t, status, rr.Code)
+	var errResp apierror.ErrorResponse
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &errResp))
+	assert.Equal(t, code, errResp.Code)
+	return errResp
+}
+
+func TestYAMLContentMiddleware_ConvertsYAMLRequest(t *testing.T) {
+	body := "name: Sample\nscopes:\n  - openid\nttl: 3600\n"
+	req := httptest.NewRequest(http.MethodPost, "/applications", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/yaml")
+
+	rr, received, contentType := serveYAML(t, req)
+
+	assert.Equal(t, http.StatusCreated, rr.Code)
+	assert.Equal(t, `{"name":"Sample","scopes":["openid"],"ttl":3600}`, received)
+	assert.Equal(t, "application/json", contentType)
+	assert.Equal(t, "application/json", rr.Header().Get("Content-Type"))
+	assert.Equal(t, "Accept", rr.Header().Get("Vary"))
+}
+
+func TestYAMLContentMiddleware_ConvertsResponseWhenYAMLAccepted(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/v1/roles/role-1", nil)
+	req.Header.Set("Accept", "application/yaml")
+
+	rr, _, _ := serveYAML(t, req)
+
+	assert.Equal(t, http.StatusCreated, rr.Code)
+	assert.Equal(t, "application/yaml", rr.Header().Get("Content-Type"))
+	assert.Equal(t, "id: app-1\nname: Sample\nenabled: true\nscopes:\n  - openid\nttl: 3600\n", rr.Body.String())
+}
+
+func TestYAMLContentMiddleware_IgnoresOtherRoutes(t *testing.T) {
+	body := "name: Sample\n"
+	req := httptest.NewRequest(http.MethodPost, "/users", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/yaml")
+	req.Header.Set("Accept", "application/yaml")
+
+	rr, received, contentType := serveYAML(t, req)
+
+	assert.Equal(t, body, received)
+	assert.Equal(t, "application/yaml", contentType)
+	assert.Equal(t, "application/json", rr.Header().Get("Content-Type"))
+	assert.Empty(t, rr.Header().Get("Vary"))
+}
+
+func TestYAMLContentMiddleware_PassesJSONRequest(t *testing.T) {
+	body := `{"name":"Sample"}`
+	req := httptest.NewRequest(http.MethodPost, "/user-types", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+
+	_, received, contentType := serveYAML(t, req)
+
+	assert.Equal(t, body, received)
+	assert.Equal(t, "application/json", contentType)
+}
+
+func TestYAMLContentMiddleware_RejectsInvalidYAML(t *testing.T) {
+	req := httptest.NewRequest(http.MethodPost, "/applications", strings.NewReader("name: [Sample\n"))
+	req.Header.Set("Content-Type", "application/yaml")
+
+	rr, received, _ := serveYAML(t, req)
+
+	errResp := assertYAMLErrorCode(t, rr, http.StatusBadRequest, apierror.ErrInvalidYAMLBody.Code)
+	assert.Contains(t, errResp.Description.DefaultValue, "line")
+	assert.Equal(t, apierror.ErrInvalidYAMLBody.Description.Key, errResp.Description.Key)
+	assert.Empty(t, received)
+}
+
+func TestYAMLContentMiddleware_ReportsYAMLErrorsAsYAMLWhenAccepted(t *testing.T) {
+	req := httptest.NewRequest(http.MethodPut, "/roles/role-1", strings.NewReader("a: 1\n---\nb: 2\n"))
+	req.Header.Set("Content-Type", "application/yaml")
+	req.Header.Set("Accept", "application/yaml")
+
+	rr, _, _ := serveYAML(t, req)
+
+	assert.Equal(t, http.StatusBadRequest, rr.Code)
+	assert.Equal(t, "application/yaml", rr.Header().Get("Content-Type"))
+	var errResp map[string]interface{}
+	require.NoError(t, yaml.Unmarshal(rr.Body.Bytes(), &errResp))
+	assert.Equal(t, apierror.ErrInvalidYAMLBody.Code, errResp["code"])
+	description, ok := errResp["description"].(map[string]interface{})
+	require.True(t, ok)
+	assert.Contains(t, description["defaultValue"], "single document")
+}
+
+func TestYAMLContentMiddleware_EnforcesBodySizeLimit(t *testing.T) {
+	req := httptest.NewRequest(http.MethodPost, "/applications",
+		strings.NewReader("name: "+strings.Repeat("a", 300)+"\n"))
+	req.Header.Set("Content-Type", "application/yaml")
+
+	rr, _, _ := serveYAML(t, req)
+
+	assertYAMLErrorCode(t, rr, http.StatusRequestEntityTooLarge, apierror.ErrRequestBodyTooLarge.Code)
+}
+
+func TestYAMLContentMiddleware_RejectsExpandingAliases(t *testing.T) {
+	body := "a: &a [x, x, x, x, x, x, x, x]\nb: &b [*a, *a, *a, *a, *a, *a, *a, *a]\n" +
+		"c: [*b, *b, *b, *b, *b, *b, *b, *b]\n"
+	req := httptest.NewRequest(http.MethodPost, "/applications", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/yaml")
+
+	rr, _, _ := serveYAML(t, req)
+
+	assertYAMLErrorCode(t, rr, http.StatusRequestEntityTooLarge, apierror.ErrRequestBodyTooLarge.Code)
+}
+
+func TestPrefersYAML(t *testing.T) {
+	tests := []struct {
+		accept   string
+		expected bool
+	}{
+		{"", false},
+		{"*/*", false},
+		{"application/json", false},
+		{"application/yaml", true},
+		{"text/yaml", true},
+		{"application/yaml, */*;q=0.8", true},
+		{"application/yaml, */*", true},
+		{"application/json, application/yaml", false},
+		{"application/json;q=0.5, application/yaml", true},
+		{"application/yaml;q=0.5, */*", false},
+		{"application/yaml;q=0", false},
+		{"application/yaml;q=invalid", false},
+	}
+
+	for _, tc := range tests {
+		assert.Equal(t, tc.expected, prefersYAML(tc.accept), tc.accept)
+	}
+}
+
+func TestYAMLToJSON_ScalarsAndMerges(t *testing.T) {
+	body := "defaults: &defaults\n  timeout: 30\n  retries: 0x03\n" +
+		"step:\n  <<: *defaults\n  timeout: 60\n  quoted: \"true\"\n  date: 2024-01-01\n  empty: ~\n  ratio: 1.50\n"
+
+	converted, err := yamlToJSON([]byte(body), 0)
+
+	require.NoError(t, err)
+	assert.Equal(t, `{"defaults":{"timeout":30,"retries":3},`+
+		`"step":{"timeout":60,"quoted":"true","date":"2024-01-01","empty":null,"ratio":1.50,"retries":3}}`,
+		string(converted))
+}
+
+func TestYAMLToJSON_RejectsUnrepresentableValues(t *testing.T) {
+	tests := []string{
+		"? [a, b]\n: value\n",
+		"a: 1\na: 2\n",
+		"a: .inf\n",
+		"a: !custom value\n",
+		"a:\n  <<: [1]\n",
+	}
+
+	for _, body := range tests {
+		_, err := yamlToJSON([]byte(body), 0)
+		assert.Error(t, err, body)
+	}
+}
+
+func TestYAMLConversion_RoundTripIsStable(t *testing.T) {
+	documents := []string{
+		`{"handle":"login","name":"Login <basic>","flowType":"AUTHENTICATION","nodes":[{"id":"start",` +
+			`"type":"START","onSuccess":"prompt"},{"id":"prompt","type":"PROMPT","meta":{"components":[]},` +
+			`"properties":{},"inputs":[{"ref":"username","required":true,"identifier":"username"}],` +
+			`"onSuccess":"end"},{"id":"end","type":"END"}]}`,
+		`{"name":"customer","schema":{"email":{"type":"string","unique":true,"regex":"^\\S+@\\S+$"},` +
+			`"age":{"type":"number","minimum":0.5,"maximum":1e3},"note":{"type":"string","default":"line1\nline2"},` +
+			`"code":{"type":"string","default":"007"},"flag":{"type":"string","default":"yes"},"none":null}}`,
+		`["a",1,-2.25,true,null,{},[],"",": colon","#hash","null","1e5","unicode \u00e9\u4e2d"]`,
+	}
+
+	for _, document := range documents {
+		converted, err := jsonToYAML([]byte(document))
+		require.NoError(t, err)
+
+		restored, err := yamlToJSON(converted, 0)
+		require.NoError(t, err)
+		assert.JSONEq(t, document, string(restored))
+
+		reconverted, err := jsonToYAML(restored)
+		require.NoError(t, err)
+		assert.Equal(t, string(converted), string(reconverted))
+
+		again, err := yamlToJSON(reconverted, 0)
+		require.NoError(t, err)
+		assert.Equal(t, string(restored), string(again))
+	}
+}
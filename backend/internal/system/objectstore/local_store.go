@@ -0,0 +1,126 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package objectstore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// localObjectStore stores objects as files below a root directory.
+// The content type is not persisted and is detected from the content on read.
+type localObjectStore struct {
+	rootDir string
+}
+
+// newLocalObjectStore creates a new local disk object store rooted at the given directory.
+func newLocalObjectStore(rootDir string) ObjectStoreInterface {
+	return &localObjectStore{rootDir: rootDir}
+}
+
+// Put writes the object to disk, replacing any existing object with the same key.
+func (s *localObjectStore) Put(_ context.Context, key string, object Object) error {
+	path, err := s.resolvePath(key)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+		return fmt.Errorf("failed to create object directory: %w", err)
+	}
+
+	// Write to a temporary file and rename so readers never observe a partially written object.
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".tmp-*")
+	if err != nil {
+		return fmt.Errorf("failed to create temporary object file: %w", err)
+	}
+	tmpName := tmp.Name()
+	if _, err := tmp.Write(object.Data); err != nil {
+		_ = tmp.Close()
+		_ = os.Remove(tmpName)
+		return fmt.Errorf("failed to write object: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		_ = os.Remove(tmpName)
+		return fmt.Errorf("failed to write object: %w", err)
+	}
+	if err := os.Rename(tmpName, path); err != nil {
+		_ = os.Remove(tmpName)
+		return fmt.Errorf("failed to store object: %w", err)
+	}
+	return nil
+}
+
+// Get reads the object from disk.
+func (s *localObjectStore) Get(_ context.Context, key string) (*Object, error) {
+	path, err := s.resolvePath(key)
+	if err != nil {
+		return nil, err
+	}
+	data, err := os.ReadFile(path) // #nosec G304 -- path is confined to the root directory
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, ErrObjectNotFound
+		}
+		return nil, fmt.Errorf("failed to read object: %w", err)
+	}
+	return &Object{Data: data, ContentType: http.DetectContentType(data)}, nil
+}
+
+// Exists reports whether an object is stored under the key.
+func (s *localObjectStore) Exists(_ context.Context, key string) (bool, error) {
+	path, err := s.resolvePath(key)
+	if err != nil {
+		return false, err
+	}
+	if _, err := os.Stat(path); err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to stat object: %w", err)
+	}
+	return true, nil
+}
+
+// Delete removes the object from disk. Deleting a missing object is not an error.
+func (s *localObjectStore) Delete(_ context.Context, key string) error {
+	path, err := s.resolvePath(key)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(path); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("failed to delete object: %w", err)
+	}
+	return nil
+}
+
+// resolvePath maps a key to a file path, rejecting keys that would escape the root directory.
+func (s *localObjectStore) resolvePath(key string) (string, error) {
+	cleaned := filepath.Clean(filepath.FromSlash(key))
+	if key == "" || filepath.IsAbs(cleaned) || cleaned == "." ||
+		cleaned == ".." || strings.HasPrefix(cleaned, ".."+string(filepath.Separator)) {
+		return "", fmt.Errorf("invalid object key: %q", key)
+	}
+	return filepath.Join(s.rootDir, cleaned), nil
+}
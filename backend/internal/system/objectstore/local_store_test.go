@@ -0,0 +1,95 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package objectstore
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type LocalObjectStoreTestSuite struct {
+	suite.Suite
+	rootDir string
+	store   ObjectStoreInterface
+	ctx     context.Context
+}
+
+func TestLocalObjectStoreTestSuite(t *testing.T) {
+	suite.Run(t, new(LocalObjectStoreTestSuite))
+}
+
+func (s *LocalObjectStoreTestSuite) SetupTest() {
+	s.rootDir = s.T().TempDir()
+	s.store = newLocalObjectStore(s.rootDir)
+	s.ctx = context.Background()
+}
+
+func (s *LocalObjectStoreTestSuite) TestPutGetDelete() {
+	data := []byte("\x89PNG\r\n\x1a\nrest")
+	s.Require().NoError(s.store.Put(s.ctx, "users/u1/picture", Object{Data: data, ContentType: "image/png"}))
+
+	exists, err := s.store.Exists(s.ctx, "users/u1/picture")
+	s.NoError(err)
+	s.True(exists)
+
+	object, err := s.store.Get(s.ctx, "users/u1/picture")
+	s.Require().NoError(err)
+	s.Equal(data, object.Data)
+	s.Equal("image/png", object.ContentType)
+	s.FileExists(filepath.Join(s.rootDir, "users", "u1", "picture"))
+
+	s.Require().NoError(s.store.Delete(s.ctx, "users/u1/picture"))
+	exists, err = s.store.Exists(s.ctx, "users/u1/picture")
+	s.NoError(err)
+	s.False(exists)
+}
+
+func (s *LocalObjectStoreTestSuite) TestPut_Overwrites() {
+	s.Require().NoError(s.store.Put(s.ctx, "k", Object{Data: []byte("first")}))
+	s.Require().NoError(s.store.Put(s.ctx, "k", Object{Data: []byte("second")}))
+
+	object, err := s.store.Get(s.ctx, "k")
+	s.Require().NoError(err)
+	s.Equal([]byte("second"), object.Data)
+
+	entries, err := os.ReadDir(s.rootDir)
+	s.Require().NoError(err)
+	s.Len(entries, 1, "temporary files must not be left behind")
+}
+
+func (s *LocalObjectStoreTestSuite) TestGet_NotFound() {
+	_, err := s.store.Get(s.ctx, "missing")
+	s.ErrorIs(err, ErrObjectNotFound)
+}
+
+func (s *LocalObjectStoreTestSuite) TestDelete_Missing() {
+	s.NoError(s.store.Delete(s.ctx, "missing"))
+}
+
+func (s *LocalObjectStoreTestSuite) TestInvalidKeys() {
+	for _, key := range []string{"", ".", "..", "../escape", "a/../../escape", "/abs"} {
+		s.Error(s.store.Put(s.ctx, key, Object{Data: []byte("x")}), key)
+		_, err := s.store.Get(s.ctx, key)
+		s.Error(err, key)
+	}
+}
@@ -0,0 +1,79 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package objectstore provides a pluggable store for binary objects such as user pictures.
+package objectstore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+)
+
+const (
+	// StoreTypeLocal stores objects on the local disk.
+	StoreTypeLocal = "local"
+	// StoreTypeS3 stores objects in an S3-compatible object storage service.
+	StoreTypeS3 = "s3"
+)
+
+// ErrObjectNotFound is returned when the requested object does not exist.
+var ErrObjectNotFound = errors.New("object not found")
+
+// Object is a stored binary object together with its media type.
+type Object struct {
+	Data        []byte
+	ContentType string
+}
+
+// ObjectStoreInterface defines the operations supported by an object store.
+// Keys are slash-separated relative paths such as "users/<id>/picture".
+type ObjectStoreInterface interface {
+	Put(ctx context.Context, key string, object Object) error
+	Get(ctx context.Context, key string) (*Object, error)
+	Exists(ctx context.Context, key string) (bool, error)
+	Delete(ctx context.Context, key string) error
+}
+
+// Initialize creates the object store configured in object_store.
+func Initialize() (ObjectStoreInterface, error) {
+	runtime := config.GetServerRuntime()
+	cfg := runtime.Config.ObjectStore
+
+	switch cfg.Type {
+	case "", StoreTypeLocal:
+		directory := cfg.Local.Directory
+		if directory == "" {
+			return nil, errors.New("object_store.local.directory must be configured")
+		}
+		if !filepath.IsAbs(directory) {
+			directory = filepath.Join(runtime.ServerHome, directory)
+		}
+		return newLocalObjectStore(directory), nil
+	case StoreTypeS3:
+		if cfg.S3.Endpoint == "" || cfg.S3.Bucket == "" || cfg.S3.Region == "" {
+			return nil, errors.New("object_store.s3 requires endpoint, region and bucket")
+		}
+		return newS3ObjectStore(cfg.S3)
+	default:
+		return nil, fmt.Errorf("unsupported object store type: %s", cfg.Type)
+	}
+}
@@ -0,0 +1,254 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package objectstore
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+)
+
+const (
+	s3Service         = "s3"
+	s3Algorithm       = "AWS4-HMAC-SHA256"
+	s3DateFormat      = "20060102"
+	s3TimestampFormat = "20060102T150405Z"
+	s3RequestTimeout  = 30 * time.Second
+)
+
+// s3ObjectStore stores objects in an S3-compatible service using the REST API signed with
+// AWS Signature Version 4.
+type s3ObjectStore struct {
+	httpClient      *http.Client
+	endpoint        *url.URL
+	region          string
+	bucket          string
+	accessKeyID     string
+	secretAccessKey string
+	usePathStyle    bool
+	now             func() time.Time
+}
+
+// newS3ObjectStore creates a new S3-compatible object store.
+func newS3ObjectStore(cfg config.S3ObjectStoreConfig) (ObjectStoreInterface, error) {
+	endpoint, err := url.Parse(cfg.Endpoint)
+	if err != nil || endpoint.Scheme == "" || endpoint.Host == "" {
+		return nil, fmt.Errorf("invalid object_store.s3.endpoint: %q", cfg.Endpoint)
+	}
+	return &s3ObjectStore{
+		httpClient:      &http.Client{Timeout: s3RequestTimeout},
+		endpoint:        endpoint,
+		region:          cfg.Region,
+		bucket:          cfg.Bucket,
+		accessKeyID:     cfg.AccessKeyID,
+		secretAccessKey: cfg.SecretAccessKey,
+		usePathStyle:    cfg.UsePathStyle,
+		now:             time.Now,
+	}, nil
+}
+
+// Put uploads the object, replacing any existing object with the same key.
+func (s *s3ObjectStore) Put(ctx context.Context, key string, object Object) error {
+	resp, err := s.do(ctx, http.MethodPut, key, object.Data, object.ContentType)
+	if err != nil {
+		return err
+	}
+	defer closeBody(resp)
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("failed to put object: unexpected status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// Get downloads the object.
+func (s *s3ObjectStore) Get(ctx context.Context, key string) (*Object, error) {
+	resp, err := s.do(ctx, http.MethodGet, key, nil, "")
+	if err != nil {
+		return nil, err
+	}
+	defer closeBody(resp)
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, ErrObjectNotFound
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to get object: unexpected status %d", resp.StatusCode)
+	}
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read object: %w", err)
+	}
+	contentType := resp.Header.Get("Content-Type")
+	if contentType == "" {
+		contentType = http.DetectContentType(data)
+	}
+	return &Object{Data: data, ContentType: contentType}, nil
+}
+
+// Exists reports whether an object is stored under the key.
+func (s *s3ObjectStore) Exists(ctx context.Context, key string) (bool, error) {
+	resp, err := s.do(ctx, http.MethodHead, key, nil, "")
+	if err != nil {
+		return false, err
+	}
+	defer closeBody(resp)
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return true, nil
+	case http.StatusNotFound:
+		return false, nil
+	default:
+		return false, fmt.Errorf("failed to check object: unexpected status %d", resp.StatusCode)
+	}
+}
+
+// Delete removes the object. Deleting a missing object is not an error.
+func (s *s3ObjectStore) Delete(ctx context.Context, key string) error {
+	resp, err := s.do(ctx, http.MethodDelete, key, nil, "")
+	if err != nil {
+		return err
+	}
+	defer closeBody(resp)
+	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK &&
+		resp.StatusCode != http.StatusNotFound {
+		return fmt.Errorf("failed to delete object: unexpected status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// do builds, signs and sends a request for the given object key.
+func (s *s3ObjectStore) do(
+	ctx context.Context, method, key string, body []byte, contentType string,
+) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, method, s.objectURL(key), bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to build object store request: %w", err)
+	}
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+	req.ContentLength = int64(len(body))
+	s.sign(req, body)
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("object store request failed: %w", err)
+	}
+	return resp, nil
+}
+
+// objectURL returns the URL of an object using path-style or virtual-hosted-style addressing.
+func (s *s3ObjectStore) objectURL(key string) string {
+	host := s.endpoint.Host
+	basePath := strings.TrimSuffix(s.endpoint.EscapedPath(), "/")
+	if s.usePathStyle {
+		basePath += "/" + s.bucket
+	} else {
+		host = s.bucket + "." + host
+	}
+	return s.endpoint.Scheme + "://" + host + basePath + "/" + escapeS3Path(key)
+}
+
+// sign adds AWS Signature Version 4 authentication headers to the request.
+func (s *s3ObjectStore) sign(req *http.Request, body []byte) {
+	now := s.now().UTC()
+	timestamp := now.Format(s3TimestampFormat)
+	date := now.Format(s3DateFormat)
+	payloadHash := sha256Hex(body)
+
+	req.Header.Set("Host", req.URL.Host)
+	req.Header.Set("X-Amz-Date", timestamp)
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+
+	signedHeaderNames := make([]string, 0, len(req.Header))
+	for name := range req.Header {
+		signedHeaderNames = append(signedHeaderNames, strings.ToLower(name))
+	}
+	sort.Strings(signedHeaderNames)
+
+	var canonicalHeaders strings.Builder
+	for _, name := range signedHeaderNames {
+		value := req.Header.Get(name)
+		if name == "host" {
+			value = req.URL.Host
+		}
+		canonicalHeaders.WriteString(name + ":" + strings.TrimSpace(value) + "\n")
+	}
+	signedHeaders := strings.Join(signedHeaderNames, ";")
+
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		req.URL.EscapedPath(),
+		req.URL.RawQuery,
+		canonicalHeaders.String(),
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+
+	scope := date + "/" + s.region + "/" + s3Service + "/aws4_request"
+	stringToSign := strings.Join([]string{
+		s3Algorithm, timestamp, scope, sha256Hex([]byte(canonicalRequest)),
+	}, "\n")
+
+	signingKey := hmacSHA256([]byte("AWS4"+s.secretAccessKey), date)
+	signingKey = hmacSHA256(signingKey, s.region)
+	signingKey = hmacSHA256(signingKey, s3Service)
+	signingKey = hmacSHA256(signingKey, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(signingKey, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf("%s Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		s3Algorithm, s.accessKeyID, scope, signedHeaders, signature))
+	req.Header.Del("Host")
+}
+
+// escapeS3Path URI-encodes each segment of an object key as required by Signature Version 4.
+func escapeS3Path(key string) string {
+	segments := strings.Split(key, "/")
+	for i, segment := range segments {
+		segments[i] = strings.ReplaceAll(url.PathEscape(segment), "+", "%2B")
+	}
+	return strings.Join(segments, "/")
+}
+
+func sha256Hex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	_, _ = mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
+
+// closeBody drains and closes a response body so the connection can be reused.
+func closeBody(resp *http.Response) {
+	_, _ = io.Copy(io.Discard, resp.Body)
+	_ = resp.Body.Close()
+}
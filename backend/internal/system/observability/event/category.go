@@ -47,6 +47,12 @@ const (
 	// CategoryFlows groups all flow orchestration events for tracing end-to-end flows.
 	CategoryFlows EventCategory = "observability.flows"
 
+	// CategoryAudit groups audit events that record access to protected data.
+	CategoryAudit EventCategory = "observability.audit"
+
+	// CategoryOperations groups operational events that need the attention of administrators.
+	CategoryOperations EventCategory = "observability.operations"
+
 	// CategoryAll is a special category that matches all events.
 	// Subscribers to this category receive all events regardless of type.
 	CategoryAll EventCategory = "observability.all"
@@ -80,6 +86,47 @@ var eventTypeToCategory = map[EventType]EventCategory{
 	EventTypeFlowUserInputRequired:      CategoryFlows,
 	EventTypeFlowCompleted:              CategoryFlows,
 	EventTypeFlowFailed:                 CategoryFlows,
+
+	// Audit events
+	EventTypeUserCreated:                   CategoryAudit,
+	EventTypeUserUpdated:                   CategoryAudit,
+	EventTypeUserDeleted:                   CategoryAudit,
+	EventTypeUserRestored:                  CategoryAudit,
+	EventTypeUserCredentialsUpdated:        CategoryAudit,
+	EventTypeUserCredentialDeleted:         CategoryAudit,
+	EventTypeRoleAssignmentsAdded:          CategoryAudit,
+	EventTypeRoleAssignmentsRemoved:        CategoryAudit,
+	EventTypeFlowDefinitionCreated:         CategoryAudit,
+	EventTypeFlowDefinitionUpdated:         CategoryAudit,
+	EventTypeFlowDefinitionDeleted:         CategoryAudit,
+	EventTypeSensitiveAttributesRead:       CategoryAudit,
+	EventTypeBreakGlassAccountCreated:      CategoryAudit,
+	EventTypeBreakGlassAccountDeleted:      CategoryAudit,
+	EventTypeBreakGlassActivationRequested: CategoryAudit,
+	EventTypeBreakGlassActivated:           CategoryAudit,
+	EventTypeBreakGlassDeactivated:         CategoryAudit,
+	EventTypeBreakGlassUsed:                CategoryAudit,
+	EventTypeBreakGlassUseDenied:           CategoryAudit,
+	EventTypeAuthorizationFailOpen:         CategoryAudit,
+	EventTypeChangeRequestCreated:          CategoryAudit,
+	EventTypeChangeRequestApproved:         CategoryAudit,
+	EventTypeChangeRequestRejected:         CategoryAudit,
+	EventTypeChangeRequestFailed:           CategoryAudit,
+	EventTypeAccessReviewCreated:           CategoryAudit,
+	EventTypeAccessReviewCompleted:         CategoryAudit,
+	EventTypeAccessReviewAssignmentRevoked: CategoryAudit,
+	EventTypeConfigDriftDetected:           CategoryAudit,
+	EventTypeInactiveClientsDetected:       CategoryAudit,
+	EventTypeRefreshTokenReuseDetected:     CategoryAudit,
+	EventTypeRelationshipsWritten:          CategoryAudit,
+	EventTypeAccountDeletionRequested:      CategoryAudit,
+	EventTypeAccountDeletionCancelled:      CategoryAudit,
+	EventTypeAccountDeletionCompleted:      CategoryAudit,
+
+	// Operational events
+	EventTypeCertificateExpiring:   CategoryOperations,
+	EventTypeScheduledJobFailed:    CategoryOperations,
+	EventTypeQuotaThresholdReached: CategoryOperations,
 }
 
 // GetCategory returns the category for a given event type.
@@ -106,6 +153,8 @@ func GetAllCategories() []EventCategory {
 		CategoryAuthentication,
 		CategoryAuthorization,
 		CategoryFlows,
+		CategoryAudit,
+		CategoryOperations,
 	}
 }
 
@@ -64,6 +64,83 @@ func TestGetCategory(t *testing.T) {
 			eventType:    EventTypeFlowNodeExecutionStarted,
 			wantCategory: CategoryFlows,
 		},
+
+		// Audit events
+		{
+			name:         "user created",
+			eventType:    EventTypeUserCreated,
+			wantCategory: CategoryAudit,
+		},
+		{
+			name:         "role assignments added",
+			eventType:    EventTypeRoleAssignmentsAdded,
+			wantCategory: CategoryAudit,
+		},
+		{
+			name:         "sensitive attributes read",
+			eventType:    EventTypeSensitiveAttributesRead,
+			wantCategory: CategoryAudit,
+		},
+		{
+			name:         "break-glass account used",
+			eventType:    EventTypeBreakGlassUsed,
+			wantCategory: CategoryAudit,
+		},
+		{
+			name:         "change request approved",
+			eventType:    EventTypeChangeRequestApproved,
+			wantCategory: CategoryAudit,
+		},
+		{
+			name:         "access review assignment revoked",
+			eventType:    EventTypeAccessReviewAssignmentRevoked,
+			wantCategory: CategoryAudit,
+		},
+		{
+			name:         "authorization fail-open",
+			eventType:    EventTypeAuthorizationFailOpen,
+			wantCategory: CategoryAudit,
+		},
+		{
+			name:         "config drift detected",
+			eventType:    EventTypeConfigDriftDetected,
+			wantCategory: CategoryAudit,
+		},
+		{
+			name:         "inactive clients detected",
+			eventType:    EventTypeInactiveClientsDetected,
+			wantCategory: CategoryAudit,
+		},
+		{
+			name:         "refresh token reuse detected",
+			eventType:    EventTypeRefreshTokenReuseDetected,
+			wantCategory: CategoryAudit,
+		},
+		{
+			name:         "relationships written",
+			eventType:    EventTypeRelationshipsWritten,
+			wantCategory: CategoryAudit,
+		},
+		{
+			name:         "account deletion completed",
+			eventType:    EventTypeAccountDeletionCompleted,
+			wantCategory: CategoryAudit,
+		},
+		{
+			name:         "certificate expiring",
+			eventType:    EventTypeCertificateExpiring,
+			wantCategory: CategoryOperations,
+		},
+		{
+			name:         "scheduled job failed",
+			eventType:    EventTypeScheduledJobFailed,
+			wantCategory: CategoryOperations,
+		},
+		{
+			name:         "quota threshold reached",
+			eventType:    EventTypeQuotaThresholdReached,
+			wantCategory: CategoryOperations,
+		},
 	}
 
 	for _, tt := range tests {
@@ -174,6 +251,8 @@ func TestGetAllCategories(t *testing.T) {
 		CategoryAuthentication: false,
 		CategoryAuthorization:  false,
 		CategoryFlows:          false,
+		CategoryAudit:          false,
+		CategoryOperations:     false,
 	}
 
 	for _, cat := range categories {
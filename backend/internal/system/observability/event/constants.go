@@ -26,6 +26,45 @@ const (
 
 	// ComponentAuthHandler identifies events from authentication handlers.
 	ComponentAuthHandler = "AuthHandler"
+
+	// ComponentUserManagement identifies events from the user management APIs.
+	ComponentUserManagement = "UserManagement"
+
+	// ComponentRoleManagement identifies events from the role management APIs.
+	ComponentRoleManagement = "RoleManagement"
+
+	// ComponentFlowManagement identifies events from the flow management APIs.
+	ComponentFlowManagement = "FlowManagement"
+
+	// ComponentBreakGlass identifies events from break-glass account management and usage.
+	ComponentBreakGlass = "BreakGlass"
+
+	// ComponentChangeRequest identifies events from the approval workflow of sensitive operations.
+	ComponentChangeRequest = "ChangeRequest"
+
+	// ComponentAccessReview identifies events from the access review campaigns of role assignments.
+	ComponentAccessReview = "AccessReview"
+
+	// ComponentSystemAuthorization identifies events from the system authorization service.
+	ComponentSystemAuthorization = "SystemAuthorization"
+
+	// ComponentConfigDrift identifies events from the detection of configuration drift.
+	ComponentConfigDrift = "ConfigDrift"
+
+	// ComponentClientUsage identifies events from the tracking of OAuth client usage.
+	ComponentClientUsage = "ClientUsage"
+
+	// ComponentRelationship identifies events from the relationship tuple store.
+	ComponentRelationship = "Relationship"
+
+	// ComponentQuota identifies events from the resource quotas of organization units and tenants.
+	ComponentQuota = "Quota"
+
+	// ComponentAccountDeletion identifies events from the deletion of accounts requested by their users.
+	ComponentAccountDeletion = "AccountDeletion"
+
+	// ComponentCertificateMonitor identifies events from the monitoring of server certificate expiry.
+	ComponentCertificateMonitor = "CertificateMonitor"
 )
 
 // Authentication and Authorization Event Types
@@ -63,4 +102,122 @@ const (
 
 	// EventTypeFlowFailed is triggered when flow execution fails.
 	EventTypeFlowFailed EventType = "FLOW_FAILED"
+
+	// Audit Events
+
+	// EventTypeUserCreated is triggered when a user is created.
+	EventTypeUserCreated EventType = "USER_CREATED"
+
+	// EventTypeUserUpdated is triggered when the organization unit, type or attributes of a user are updated.
+	EventTypeUserUpdated EventType = "USER_UPDATED"
+
+	// EventTypeUserDeleted is triggered when a user is deleted or purged.
+	EventTypeUserDeleted EventType = "USER_DELETED"
+
+	// EventTypeUserRestored is triggered when a soft-deleted user is restored.
+	EventTypeUserRestored EventType = "USER_RESTORED"
+
+	// EventTypeUserCredentialsUpdated is triggered when credentials of a user are set or replaced.
+	EventTypeUserCredentialsUpdated EventType = "USER_CREDENTIALS_UPDATED" //nolint:gosec
+
+	// EventTypeUserCredentialDeleted is triggered when credentials of a user are removed.
+	EventTypeUserCredentialDeleted EventType = "USER_CREDENTIAL_DELETED" //nolint:gosec
+
+	// EventTypeRoleAssignmentsAdded is triggered when users or groups are assigned to a role.
+	EventTypeRoleAssignmentsAdded EventType = "ROLE_ASSIGNMENTS_ADDED"
+
+	// EventTypeRoleAssignmentsRemoved is triggered when users or groups are unassigned from a role.
+	EventTypeRoleAssignmentsRemoved EventType = "ROLE_ASSIGNMENTS_REMOVED"
+
+	// EventTypeFlowDefinitionCreated is triggered when a flow definition is created.
+	EventTypeFlowDefinitionCreated EventType = "FLOW_DEFINITION_CREATED"
+
+	// EventTypeFlowDefinitionUpdated is triggered when a flow definition is updated or restored to a version.
+	EventTypeFlowDefinitionUpdated EventType = "FLOW_DEFINITION_UPDATED"
+
+	// EventTypeFlowDefinitionDeleted is triggered when a flow definition is deleted.
+	EventTypeFlowDefinitionDeleted EventType = "FLOW_DEFINITION_DELETED"
+
+	// EventTypeSensitiveAttributesRead is triggered when a response discloses attributes marked as sensitive.
+	EventTypeSensitiveAttributesRead EventType = "SENSITIVE_ATTRIBUTES_READ"
+
+	// EventTypeBreakGlassAccountCreated is triggered when a user is designated as a break-glass account.
+	EventTypeBreakGlassAccountCreated EventType = "BREAK_GLASS_ACCOUNT_CREATED"
+
+	// EventTypeBreakGlassAccountDeleted is triggered when a break-glass account is removed.
+	EventTypeBreakGlassAccountDeleted EventType = "BREAK_GLASS_ACCOUNT_DELETED"
+
+	// EventTypeBreakGlassActivationRequested is triggered when the activation of a break-glass account is requested.
+	EventTypeBreakGlassActivationRequested EventType = "BREAK_GLASS_ACTIVATION_REQUESTED"
+
+	// EventTypeBreakGlassActivated is triggered when the activation of a break-glass account is approved.
+	EventTypeBreakGlassActivated EventType = "BREAK_GLASS_ACTIVATED"
+
+	// EventTypeBreakGlassDeactivated is triggered when a break-glass account is deactivated before it expires.
+	EventTypeBreakGlassDeactivated EventType = "BREAK_GLASS_DEACTIVATED"
+
+	// EventTypeBreakGlassUsed is triggered when an active break-glass account signs in.
+	EventTypeBreakGlassUsed EventType = "BREAK_GLASS_USED"
+
+	// EventTypeBreakGlassUseDenied is triggered when an inactive break-glass account attempts to sign in.
+	EventTypeBreakGlassUseDenied EventType = "BREAK_GLASS_USE_DENIED"
+
+	// EventTypeAuthorizationFailOpen is triggered when an action is allowed without evaluating its
+	// authorization policies because the authorization data store is unavailable.
+	EventTypeAuthorizationFailOpen EventType = "AUTHORIZATION_FAIL_OPEN"
+
+	// EventTypeChangeRequestCreated is triggered when a sensitive operation is held for approval.
+	EventTypeChangeRequestCreated EventType = "CHANGE_REQUEST_CREATED"
+
+	// EventTypeChangeRequestApproved is triggered when a change request is approved and its change applied.
+	EventTypeChangeRequestApproved EventType = "CHANGE_REQUEST_APPROVED"
+
+	// EventTypeChangeRequestRejected is triggered when a change request is rejected or withdrawn.
+	EventTypeChangeRequestRejected EventType = "CHANGE_REQUEST_REJECTED"
+
+	// EventTypeChangeRequestFailed is triggered when the change of an approved change request cannot be applied.
+	EventTypeChangeRequestFailed EventType = "CHANGE_REQUEST_FAILED"
+
+	// EventTypeAccessReviewCreated is triggered when an access review campaign is created.
+	EventTypeAccessReviewCreated EventType = "ACCESS_REVIEW_CREATED"
+
+	// EventTypeAccessReviewCompleted is triggered when an access review campaign is completed.
+	EventTypeAccessReviewCompleted EventType = "ACCESS_REVIEW_COMPLETED"
+
+	// EventTypeAccessReviewAssignmentRevoked is triggered when a role assignment is revoked by an access review.
+	EventTypeAccessReviewAssignmentRevoked EventType = "ACCESS_REVIEW_ASSIGNMENT_REVOKED"
+
+	// EventTypeConfigDriftDetected is triggered when security relevant settings drift from the approved baseline.
+	EventTypeConfigDriftDetected EventType = "CONFIG_DRIFT_DETECTED"
+
+	// EventTypeRefreshTokenReuseDetected is triggered when a rotated refresh token is presented again and its
+	// token family is revoked.
+	EventTypeRefreshTokenReuseDetected EventType = "REFRESH_TOKEN_REUSE_DETECTED" //nolint:gosec
+
+	// EventTypeInactiveClientsDetected is triggered when a report finds clients unused for the inactive period.
+	EventTypeInactiveClientsDetected EventType = "INACTIVE_CLIENTS_DETECTED"
+
+	// EventTypeRelationshipsWritten is triggered when relationship tuples are written or deleted.
+	EventTypeRelationshipsWritten EventType = "RELATIONSHIPS_WRITTEN"
+
+	// EventTypeAccountDeletionRequested is triggered when a user requests the deletion of their own account.
+	EventTypeAccountDeletionRequested EventType = "ACCOUNT_DELETION_REQUESTED"
+
+	// EventTypeAccountDeletionCancelled is triggered when a requested account deletion is cancelled.
+	EventTypeAccountDeletionCancelled EventType = "ACCOUNT_DELETION_CANCELLED"
+
+	// EventTypeAccountDeletionCompleted is triggered when an account is deleted after its grace period.
+	EventTypeAccountDeletionCompleted EventType = "ACCOUNT_DELETION_COMPLETED"
+)
+
+// Operational Event Types
+const (
+	// EventTypeCertificateExpiring is triggered when a server certificate is about to expire or has expired.
+	EventTypeCertificateExpiring EventType = "CERTIFICATE_EXPIRING"
+
+	// EventTypeScheduledJobFailed is triggered when a scheduled background job fails.
+	EventTypeScheduledJobFailed EventType = "SCHEDULED_JOB_FAILED"
+
+	// EventTypeQuotaThresholdReached is triggered when the usage of a resource quota reaches a threshold percentage.
+	EventTypeQuotaThresholdReached EventType = "QUOTA_THRESHOLD_REACHED"
 )
@@ -31,6 +31,7 @@ var DataKey = struct {
 	Username string
 	ClientID string
 	EntityID string
+	ActorID  string
 
 	// Flow Execution Keys
 	ExecutionID   string
@@ -48,8 +49,41 @@ var DataKey = struct {
 	FailureReason string
 
 	// OAuth/Token Keys
-	Scope     string
-	GrantType string
+	Scope         string
+	GrantType     string
+	TokenFamilyID string
+
+	// Audit Keys
+	Attributes      string
+	AccountID       string
+	Reason          string
+	ExpiresAt       string
+	Action          string
+	ChangeRequestID string
+	Operation       string
+	ResourceID      string
+	Settings        string
+	ApplicationIDs  string
+	WriteCount      string
+	DeleteCount     string
+	CampaignID      string
+	AssigneeID      string
+	RevokedCount    string
+	DeletionTime    string
+	CredentialTypes string
+	Assignees       string
+	RoleID          string
+	FlowID          string
+	LoginDisabled   string
+
+	// Operational Keys
+	JobName            string
+	ResourceType       string
+	OUID               string
+	Limit              string
+	Usage              string
+	Threshold          string
+	CertificateSubject string
 
 	// Event Metadata Keys
 	Message     string
@@ -69,6 +103,7 @@ var DataKey = struct {
 	Username: "username",
 	ClientID: "client_id",
 	EntityID: "app_id",
+	ActorID:  "actor_id",
 
 	// Flow Execution Keys
 	ExecutionID:   "execution_id",
@@ -86,8 +121,41 @@ var DataKey = struct {
 	FailureReason: "failure_reason",
 
 	// OAuth/Token Keys
-	Scope:     "scope",
-	GrantType: "grant_type",
+	Scope:         "scope",
+	GrantType:     "grant_type",
+	TokenFamilyID: "token_family_id",
+
+	// Audit Keys
+	Attributes:      "attributes",
+	AccountID:       "account_id",
+	Reason:          "reason",
+	ExpiresAt:       "expires_at",
+	Action:          "action",
+	ChangeRequestID: "change_request_id",
+	Operation:       "operation",
+	ResourceID:      "resource_id",
+	Settings:        "settings",
+	ApplicationIDs:  "application_ids",
+	WriteCount:      "write_count",
+	DeleteCount:     "delete_count",
+	CampaignID:      "campaign_id",
+	AssigneeID:      "assignee_id",
+	RevokedCount:    "revoked_count",
+	DeletionTime:    "deletion_time",
+	CredentialTypes: "credential_types",
+	Assignees:       "assignees",
+	RoleID:          "role_id",
+	FlowID:          "flow_id",
+	LoginDisabled:   "login_disabled",
+
+	// Operational Keys
+	JobName:            "job_name",
+	ResourceType:       "resource_type",
+	OUID:               "ou_id",
+	Limit:              "limit",
+	Usage:              "usage",
+	Threshold:          "threshold",
+	CertificateSubject: "certificate_subject",
 
 	// Event Metadata Keys
 	Message:     "message",
@@ -45,6 +45,9 @@ type Event struct {
 	// Component is the source component/service that generated the event.
 	Component string `json:"component"`
 
+	// TenantID is the ID of the tenant the event occurred in. It is empty for events of the deployment root.
+	TenantID string `json:"tenant_id,omitempty"`
+
 	// Status indicates the outcome of the event (e.g., "success", "failure", "in_progress").
 	Status string `json:"status"`
 
@@ -94,6 +97,12 @@ func (e *Event) WithStatus(status string) *Event {
 	return e
 }
 
+// WithTenantID sets the tenant the event occurred in and returns the event for chaining.
+func (e *Event) WithTenantID(tenantID string) *Event {
+	e.TenantID = tenantID
+	return e
+}
+
 // WithData sets a data field and returns the event for chaining.
 // Use this to add event-specific information like user_id, client_id, error details, etc.
 func (e *Event) WithData(key string, value interface{}) *Event {
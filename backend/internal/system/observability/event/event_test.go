@@ -80,6 +80,18 @@ func TestEventBuilderPattern(t *testing.T) {
 	}
 }
 
+func TestEventWithTenantID(t *testing.T) {
+	evt := NewEvent("trace-123", string(EventTypeTokenIssuanceStarted), "test-component")
+
+	if result := evt.WithTenantID("tenant-1"); result != evt {
+		t.Error("WithTenantID should return the same event instance")
+	}
+
+	if evt.TenantID != "tenant-1" {
+		t.Errorf("Expected TenantID %s, got %s", "tenant-1", evt.TenantID)
+	}
+}
+
 func TestEventWithDataMap(t *testing.T) {
 	evt := NewEvent("trace-123", "user.created", "UserService")
 
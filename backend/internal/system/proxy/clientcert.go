@@ -0,0 +1,96 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package proxy
+
+import (
+	"crypto/x509"
+	"encoding/base64"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	pemCertBegin = "-----BEGIN CERTIFICATE-----"
+	pemCertEnd   = "-----END CERTIFICATE-----"
+	// xfccCertKey is the key holding the URL encoded PEM certificate in an Envoy
+	// X-Forwarded-Client-Cert header element.
+	xfccCertKey = "Cert"
+)
+
+// parseForwardedCertificate decodes a client certificate forwarded by a TLS terminating proxy and
+// checks that it is within its validity period. The common proxy encodings are accepted: PEM (with
+// newlines optionally replaced by spaces), URL encoded PEM, base64 encoded DER and the Cert field
+// of an Envoy X-Forwarded-Client-Cert element.
+func parseForwardedCertificate(value string, now time.Time) (*x509.Certificate, error) {
+	value = strings.Trim(strings.TrimSpace(value), `"`)
+	if cert, ok := xfccCertificate(value); ok {
+		value = cert
+	}
+	if strings.Contains(value, "%") {
+		// A '+' is only a space when the proxy used query escaping, which shows in the PEM markers.
+		// Otherwise it is a literal base64 character and must be preserved.
+		unescape := url.PathUnescape
+		if strings.Contains(value, "BEGIN+CERTIFICATE") {
+			unescape = url.QueryUnescape
+		}
+		unescaped, err := unescape(value)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidClientCert, err)
+		}
+		value = unescaped
+	}
+
+	encoded := value
+	if begin := strings.Index(value, pemCertBegin); begin >= 0 {
+		encoded = value[begin+len(pemCertBegin):]
+		end := strings.Index(encoded, pemCertEnd)
+		if end < 0 {
+			return nil, fmt.Errorf("%w: missing PEM end marker", ErrInvalidClientCert)
+		}
+		encoded = encoded[:end]
+	}
+	encoded = strings.Join(strings.Fields(encoded), "")
+
+	der, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidClientCert, err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidClientCert, err)
+	}
+	if now.Before(cert.NotBefore) || now.After(cert.NotAfter) {
+		return nil, ErrClientCertNotValid
+	}
+	return cert, nil
+}
+
+// xfccCertificate returns the Cert field of the first element of an Envoy X-Forwarded-Client-Cert header.
+func xfccCertificate(value string) (string, bool) {
+	element, _, _ := strings.Cut(value, ",")
+	for _, pair := range strings.Split(element, ";") {
+		key, field, found := strings.Cut(strings.TrimSpace(pair), "=")
+		if found && strings.EqualFold(key, xfccCertKey) {
+			return strings.Trim(field, `"`), true
+		}
+	}
+	return "", false
+}
@@ -0,0 +1,109 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package proxy
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/pem"
+	"math/big"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+// newTestCertificate creates a self signed certificate and returns its DER and PEM encodings.
+func newTestCertificate(t *testing.T, notBefore, notAfter time.Time) ([]byte, string) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "client"},
+		NotBefore:    notBefore,
+		NotAfter:     notAfter,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("failed to create certificate: %v", err)
+	}
+	return der, string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+}
+
+type ClientCertTestSuite struct {
+	suite.Suite
+	der     []byte
+	certPEM string
+}
+
+func TestClientCertTestSuite(t *testing.T) {
+	suite.Run(t, new(ClientCertTestSuite))
+}
+
+func (s *ClientCertTestSuite) SetupSuite() {
+	s.der, s.certPEM = newTestCertificate(s.T(), time.Now().Add(-time.Hour), time.Now().Add(time.Hour))
+}
+
+func (s *ClientCertTestSuite) TestParseForwardedCertificate_Encodings() {
+	encodings := map[string]string{
+		"pem":              s.certPEM,
+		"pem with spaces":  strings.ReplaceAll(s.certPEM, "\n", " "),
+		"url encoded pem":  url.QueryEscape(s.certPEM),
+		"path encoded pem": url.PathEscape(s.certPEM),
+		"base64 der":       base64.StdEncoding.EncodeToString(s.der),
+		"envoy xfcc":       `Hash=abc;Cert="` + url.QueryEscape(s.certPEM) + `";Subject="CN=client"`,
+	}
+
+	for name, value := range encodings {
+		s.Run(name, func() {
+			cert, err := parseForwardedCertificate(value, time.Now())
+			s.Require().NoError(err)
+			s.Equal("client", cert.Subject.CommonName)
+		})
+	}
+}
+
+func (s *ClientCertTestSuite) TestParseForwardedCertificate_Invalid() {
+	for _, value := range []string{
+		"not a certificate",
+		"-----BEGIN CERTIFICATE-----MIIB",
+		base64.StdEncoding.EncodeToString([]byte("garbage")),
+		"%zz",
+	} {
+		_, err := parseForwardedCertificate(value, time.Now())
+		s.ErrorIs(err, ErrInvalidClientCert, value)
+	}
+}
+
+func (s *ClientCertTestSuite) TestParseForwardedCertificate_OutsideValidity() {
+	_, err := parseForwardedCertificate(s.certPEM, time.Now().Add(2*time.Hour))
+	s.ErrorIs(err, ErrClientCertNotValid)
+
+	_, err = parseForwardedCertificate(s.certPEM, time.Now().Add(-2*time.Hour))
+	s.ErrorIs(err, ErrClientCertNotValid)
+}
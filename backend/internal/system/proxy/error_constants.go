@@ -0,0 +1,40 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package proxy
+
+import "errors"
+
+// Sentinel errors returned by the proxy package. Callers may wrap these
+// for additional context but should compare via errors.Is.
+var (
+	// ErrInvalidTrustedProxy is returned when a trusted proxy entry is neither an IP address nor a CIDR range.
+	ErrInvalidTrustedProxy = errors.New("proxy: invalid trusted proxy entry")
+
+	// ErrInvalidCertHeader is returned when the forwarded client certificate header name is malformed.
+	ErrInvalidCertHeader = errors.New("proxy: invalid client certificate header")
+
+	// ErrInvalidClientCert is returned when a forwarded client certificate cannot be decoded or parsed.
+	ErrInvalidClientCert = errors.New("proxy: invalid forwarded client certificate")
+
+	// ErrClientCertNotValid is returned when a forwarded client certificate is outside its validity period.
+	ErrClientCertNotValid = errors.New("proxy: forwarded client certificate is expired or not yet valid")
+
+	// ErrInvalidProxyHeader is returned when a PROXY protocol header is malformed.
+	ErrInvalidProxyHeader = errors.New("proxy: invalid PROXY protocol header")
+)
@@ -0,0 +1,261 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package proxy
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// headerReadTimeout bounds the time a trusted proxy has to send the PROXY protocol header.
+	headerReadTimeout = 10 * time.Second
+	// v1MaxHeaderLength is the maximum length of a PROXY protocol v1 header including CRLF.
+	v1MaxHeaderLength = 107
+	// v1Prefix starts every PROXY protocol v1 header.
+	v1Prefix = "PROXY "
+	// v2HeaderLength is the length of the fixed part of a PROXY protocol v2 header.
+	v2HeaderLength = 16
+)
+
+// peerAddrContextKey is the context key of the address of the peer that opened a connection.
+type peerAddrContextKey struct{}
+
+// v2Signature starts every PROXY protocol v2 header.
+var v2Signature = []byte{0x0D, 0x0A, 0x0D, 0x0A, 0x00, 0x0D, 0x0A, 0x51, 0x55, 0x49, 0x54, 0x0A}
+
+// NewListener wraps a listener so that connections from trusted proxies may announce the original
+// client address with a PROXY protocol v1 or v2 header. Connections from other peers are returned
+// unchanged, so an untrusted client cannot spoof its address with a forged header.
+func NewListener(ln net.Listener, trusted TrustedProxies) net.Listener {
+	return &listener{
+		Listener: ln,
+		trusted:  trusted,
+	}
+}
+
+// listener is a net.Listener that accepts PROXY protocol headers from trusted proxies.
+type listener struct {
+	net.Listener
+	trusted TrustedProxies
+}
+
+// Accept waits for and returns the next connection to the listener.
+func (l *listener) Accept() (net.Conn, error) {
+	conn, err := l.Listener.Accept()
+	if err != nil {
+		return nil, err
+	}
+	if !l.trusted.ContainsAddr(conn.RemoteAddr()) {
+		return conn, nil
+	}
+	return &proxyConn{
+		Conn:   conn,
+		reader: bufio.NewReader(conn),
+	}, nil
+}
+
+// ConnContext stores the address of the peer that opened the connection in the context. For a connection
+// from a trusted proxy this is the address of the proxy, not the client address announced in its PROXY
+// protocol header. It is meant to be set as the ConnContext of the http.Server.
+func ConnContext(ctx context.Context, conn net.Conn) context.Context {
+	return context.WithValue(ctx, peerAddrContextKey{}, peerAddr(conn))
+}
+
+// peerAddr returns the address of the peer that opened the connection, looking through TLS connections
+// and PROXY protocol headers.
+func peerAddr(conn net.Conn) net.Addr {
+	if tlsConn, ok := conn.(interface{ NetConn() net.Conn }); ok {
+		conn = tlsConn.NetConn()
+	}
+	if pc, ok := conn.(*proxyConn); ok {
+		return pc.Conn.RemoteAddr()
+	}
+	return conn.RemoteAddr()
+}
+
+// proxyConn is a connection from a trusted proxy. The PROXY protocol header is read lazily on the
+// first read or remote address lookup so that a slow proxy does not block the accept loop.
+type proxyConn struct {
+	net.Conn
+	reader     *bufio.Reader
+	once       sync.Once
+	remoteAddr net.Addr
+	err        error
+}
+
+// Read reads data from the connection after the PROXY protocol header.
+func (c *proxyConn) Read(b []byte) (int, error) {
+	c.readHeader()
+	if c.err != nil {
+		return 0, c.err
+	}
+	return c.reader.Read(b)
+}
+
+// RemoteAddr returns the client address announced by the proxy, or the address of the proxy
+// when no header was sent.
+func (c *proxyConn) RemoteAddr() net.Addr {
+	c.readHeader()
+	return c.remoteAddr
+}
+
+// readHeader reads the PROXY protocol header once.
+func (c *proxyConn) readHeader() {
+	c.once.Do(func() {
+		c.remoteAddr = c.Conn.RemoteAddr()
+		if err := c.Conn.SetReadDeadline(time.Now().Add(headerReadTimeout)); err != nil {
+			c.err = err
+			return
+		}
+		addr, err := readProxyHeader(c.reader)
+		if err != nil {
+			c.err = err
+			return
+		}
+		if err := c.Conn.SetReadDeadline(time.Time{}); err != nil {
+			c.err = err
+			return
+		}
+		if addr != nil {
+			c.remoteAddr = addr
+		}
+	})
+}
+
+// readProxyHeader reads a PROXY protocol header from the reader and returns the announced source
+// address. A nil address is returned when the connection does not start with a header or when the
+// header does not carry a source address, as with the LOCAL command or the UNKNOWN protocol.
+func readProxyHeader(r *bufio.Reader) (net.Addr, error) {
+	first, err := r.Peek(1)
+	if err != nil {
+		return nil, err
+	}
+	switch first[0] {
+	case v1Prefix[0]:
+		prefix, err := r.Peek(len(v1Prefix))
+		if err != nil || string(prefix) != v1Prefix {
+			return nil, nil
+		}
+		return readV1Header(r)
+	case v2Signature[0]:
+		signature, err := r.Peek(len(v2Signature))
+		if err != nil || !bytes.Equal(signature, v2Signature) {
+			return nil, nil
+		}
+		return readV2Header(r)
+	default:
+		return nil, nil
+	}
+}
+
+// readV1Header reads a text PROXY protocol v1 header such as
+// "PROXY TCP4 192.0.2.1 198.51.100.1 56324 443\r\n".
+func readV1Header(r *bufio.Reader) (net.Addr, error) {
+	var line []byte
+	for {
+		b, err := r.ReadByte()
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidProxyHeader, err)
+		}
+		line = append(line, b)
+		if b == '\n' {
+			break
+		}
+		if len(line) >= v1MaxHeaderLength {
+			return nil, fmt.Errorf("%w: v1 header is too long", ErrInvalidProxyHeader)
+		}
+	}
+	if !bytes.HasSuffix(line, []byte("\r\n")) {
+		return nil, fmt.Errorf("%w: v1 header is not terminated by CRLF", ErrInvalidProxyHeader)
+	}
+
+	fields := strings.Fields(string(line))
+	if len(fields) >= 2 && fields[1] == "UNKNOWN" {
+		return nil, nil
+	}
+	if len(fields) != 6 || (fields[1] != "TCP4" && fields[1] != "TCP6") {
+		return nil, fmt.Errorf("%w: malformed v1 header", ErrInvalidProxyHeader)
+	}
+	ip := net.ParseIP(fields[2])
+	if ip == nil || (fields[1] == "TCP4") != (ip.To4() != nil) {
+		return nil, fmt.Errorf("%w: invalid v1 source address", ErrInvalidProxyHeader)
+	}
+	port, err := strconv.ParseUint(fields[4], 10, 16)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid v1 source port", ErrInvalidProxyHeader)
+	}
+	return &net.TCPAddr{IP: ip, Port: int(port)}, nil
+}
+
+// readV2Header reads a binary PROXY protocol v2 header.
+func readV2Header(r *bufio.Reader) (net.Addr, error) {
+	header := make([]byte, v2HeaderLength)
+	if _, err := io.ReadFull(r, header); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidProxyHeader, err)
+	}
+	if header[12]>>4 != 0x2 {
+		return nil, fmt.Errorf("%w: unsupported v2 version", ErrInvalidProxyHeader)
+	}
+	command := header[12] & 0x0F
+	family := header[13] >> 4
+	payload := make([]byte, binary.BigEndian.Uint16(header[14:16]))
+	if _, err := io.ReadFull(r, payload); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidProxyHeader, err)
+	}
+
+	switch command {
+	case 0x0:
+		// LOCAL: the connection was opened by the proxy itself, for example for health checks.
+		return nil, nil
+	case 0x1:
+	default:
+		return nil, fmt.Errorf("%w: unsupported v2 command", ErrInvalidProxyHeader)
+	}
+
+	switch family {
+	case 0x1:
+		if len(payload) < 12 {
+			return nil, fmt.Errorf("%w: truncated v2 IPv4 addresses", ErrInvalidProxyHeader)
+		}
+		return &net.TCPAddr{
+			IP:   net.IP(payload[0:4]),
+			Port: int(binary.BigEndian.Uint16(payload[8:10])),
+		}, nil
+	case 0x2:
+		if len(payload) < 36 {
+			return nil, fmt.Errorf("%w: truncated v2 IPv6 addresses", ErrInvalidProxyHeader)
+		}
+		return &net.TCPAddr{
+			IP:   net.IP(payload[0:16]),
+			Port: int(binary.BigEndian.Uint16(payload[32:34])),
+		}, nil
+	default:
+		return nil, nil
+	}
+}
@@ -0,0 +1,171 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package proxy
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"io"
+	"net"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type ListenerTestSuite struct {
+	suite.Suite
+}
+
+func TestListenerTestSuite(t *testing.T) {
+	suite.Run(t, new(ListenerTestSuite))
+}
+
+// v2Header builds a PROXY protocol v2 header with the given command, family and payload.
+func v2Header(command, family byte, payload []byte) []byte {
+	header := append([]byte{}, v2Signature...)
+	header = append(header, 0x20|command, family<<4|0x1)
+	header = binary.BigEndian.AppendUint16(header, uint16(len(payload)))
+	return append(header, payload...)
+}
+
+func (s *ListenerTestSuite) TestReadProxyHeader_V1() {
+	reader := bufio.NewReader(strings.NewReader("PROXY TCP4 192.0.2.1 198.51.100.1 56324 443\r\nGET / HTTP/1.1\r\n"))
+
+	addr, err := readProxyHeader(reader)
+	s.Require().NoError(err)
+	s.Equal("192.0.2.1:56324", addr.String())
+
+	rest, _ := io.ReadAll(reader)
+	s.Equal("GET / HTTP/1.1\r\n", string(rest))
+}
+
+func (s *ListenerTestSuite) TestReadProxyHeader_V1Unknown() {
+	addr, err := readProxyHeader(bufio.NewReader(strings.NewReader("PROXY UNKNOWN\r\n")))
+	s.NoError(err)
+	s.Nil(addr)
+}
+
+func (s *ListenerTestSuite) TestReadProxyHeader_V1Invalid() {
+	for _, header := range []string{
+		"PROXY TCP4 192.0.2.1 198.51.100.1 56324\r\n",
+		"PROXY TCP4 2001:db8::1 198.51.100.1 56324 443\r\n",
+		"PROXY TCP4 192.0.2.1 198.51.100.1 99999 443\r\n",
+		"PROXY TCP4 192.0.2.1 198.51.100.1 56324 443\n",
+		"PROXY " + strings.Repeat("A", v1MaxHeaderLength) + "\r\n",
+	} {
+		_, err := readProxyHeader(bufio.NewReader(strings.NewReader(header)))
+		s.ErrorIs(err, ErrInvalidProxyHeader, header)
+	}
+}
+
+func (s *ListenerTestSuite) TestReadProxyHeader_V2() {
+	ipv4 := append(net.ParseIP("192.0.2.1").To4(), net.ParseIP("198.51.100.1").To4()...)
+	ipv4 = binary.BigEndian.AppendUint16(ipv4, 56324)
+	ipv4 = binary.BigEndian.AppendUint16(ipv4, 443)
+
+	addr, err := readProxyHeader(bufio.NewReader(bytes.NewReader(v2Header(0x1, 0x1, ipv4))))
+	s.Require().NoError(err)
+	s.Equal("192.0.2.1:56324", addr.String())
+
+	ipv6 := append(net.ParseIP("2001:db8::1").To16(), net.ParseIP("2001:db8::2").To16()...)
+	ipv6 = binary.BigEndian.AppendUint16(ipv6, 56324)
+	ipv6 = binary.BigEndian.AppendUint16(ipv6, 443)
+
+	addr, err = readProxyHeader(bufio.NewReader(bytes.NewReader(v2Header(0x1, 0x2, ipv6))))
+	s.Require().NoError(err)
+	s.Equal("[2001:db8::1]:56324", addr.String())
+}
+
+func (s *ListenerTestSuite) TestReadProxyHeader_V2Local() {
+	addr, err := readProxyHeader(bufio.NewReader(bytes.NewReader(v2Header(0x0, 0x0, nil))))
+	s.NoError(err)
+	s.Nil(addr)
+}
+
+func (s *ListenerTestSuite) TestReadProxyHeader_V2Truncated() {
+	_, err := readProxyHeader(bufio.NewReader(bytes.NewReader(v2Header(0x1, 0x1, []byte{1, 2, 3}))))
+	s.ErrorIs(err, ErrInvalidProxyHeader)
+}
+
+func (s *ListenerTestSuite) TestReadProxyHeader_NoHeader() {
+	reader := bufio.NewReader(strings.NewReader("POST / HTTP/1.1\r\n"))
+
+	addr, err := readProxyHeader(reader)
+	s.NoError(err)
+	s.Nil(addr)
+
+	rest, _ := io.ReadAll(reader)
+	s.Equal("POST / HTTP/1.1\r\n", string(rest))
+}
+
+func (s *ListenerTestSuite) TestListener_TrustedProxy() {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	s.Require().NoError(err)
+	trusted, err := ParseTrustedProxies([]string{"127.0.0.1"})
+	s.Require().NoError(err)
+	ln = NewListener(ln, trusted)
+	defer func() { _ = ln.Close() }()
+
+	go func() {
+		client, dialErr := net.Dial("tcp", ln.Addr().String())
+		if dialErr != nil {
+			return
+		}
+		defer func() { _ = client.Close() }()
+		_, _ = client.Write([]byte("PROXY TCP4 192.0.2.1 198.51.100.1 56324 443\r\nhello"))
+	}()
+
+	conn, err := ln.Accept()
+	s.Require().NoError(err)
+	defer func() { _ = conn.Close() }()
+
+	s.Equal("192.0.2.1:56324", conn.RemoteAddr().String())
+	body, err := io.ReadAll(conn)
+	s.NoError(err)
+	s.Equal("hello", string(body))
+}
+
+func (s *ListenerTestSuite) TestListener_UntrustedPeer() {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	s.Require().NoError(err)
+	trusted, err := ParseTrustedProxies([]string{"192.0.2.0/24"})
+	s.Require().NoError(err)
+	ln = NewListener(ln, trusted)
+	defer func() { _ = ln.Close() }()
+
+	go func() {
+		client, dialErr := net.Dial("tcp", ln.Addr().String())
+		if dialErr != nil {
+			return
+		}
+		defer func() { _ = client.Close() }()
+		_, _ = client.Write([]byte("PROXY TCP4 192.0.2.1 198.51.100.1 56324 443\r\n"))
+	}()
+
+	conn, err := ln.Accept()
+	s.Require().NoError(err)
+	defer func() { _ = conn.Close() }()
+
+	s.NotContains(conn.RemoteAddr().String(), "192.0.2.1")
+	body, err := io.ReadAll(conn)
+	s.NoError(err)
+	s.Equal("PROXY TCP4 192.0.2.1 198.51.100.1 56324 443\r\n", string(body))
+}
@@ -0,0 +1,181 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package proxy
+
+import (
+	"crypto/x509"
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+	"strings"
+	"time"
+)
+
+const (
+	// headerForwarded is the standard forwarding header defined in RFC 7239.
+	headerForwarded = "Forwarded"
+	// headerXForwardedFor is the de facto forwarding header set by most proxies.
+	headerXForwardedFor = "X-Forwarded-For"
+)
+
+// Resolver resolves the original client information of requests that pass through trusted proxies.
+type Resolver struct {
+	trusted    TrustedProxies
+	certHeader string
+}
+
+// NewResolver creates a Resolver from the configured trusted proxy entries and the name of the header
+// that trusted proxies use to forward client certificates. An empty header disables certificate forwarding.
+func NewResolver(trustedProxies []string, clientCertHeader string) (*Resolver, error) {
+	trusted, err := ParseTrustedProxies(trustedProxies)
+	if err != nil {
+		return nil, err
+	}
+	clientCertHeader = strings.TrimSpace(clientCertHeader)
+	if clientCertHeader != "" && !isValidHeaderName(clientCertHeader) {
+		return nil, fmt.Errorf("%w: %q", ErrInvalidCertHeader, clientCertHeader)
+	}
+
+	return &Resolver{
+		trusted:    trusted,
+		certHeader: clientCertHeader,
+	}, nil
+}
+
+// ClientCertHeader returns the header trusted proxies use to forward client certificates.
+func (r *Resolver) ClientCertHeader() string {
+	return r.certHeader
+}
+
+// IsTrustedPeer reports whether the request arrived directly from a trusted proxy. When the connection
+// carries a PROXY protocol header, the proxy that opened the connection is checked rather than the client
+// address it announced.
+func (r *Resolver) IsTrustedPeer(req *http.Request) bool {
+	return r.trusted.Contains(parseHost(peerAddress(req)))
+}
+
+// ClientIP returns the IP address of the client that originated the request. Forwarding headers
+// are walked from the nearest hop outwards and the first address that is not a trusted proxy is
+// returned, so a client cannot spoof its address by prepending entries to the headers.
+func (r *Resolver) ClientIP(req *http.Request) string {
+	peer := parseHost(req.RemoteAddr)
+	if !peer.IsValid() {
+		return req.RemoteAddr
+	}
+	if !r.trusted.Contains(peer) {
+		return peer.String()
+	}
+
+	client := peer
+	hops := forwardedHops(req.Header)
+	for i := len(hops) - 1; i >= 0; i-- {
+		if !hops[i].IsValid() {
+			break
+		}
+		client = hops[i]
+		if !r.trusted.Contains(client) {
+			break
+		}
+	}
+	return client.String()
+}
+
+// ClientCertificate returns the certificate the client presented during the TLS handshake. When TLS
+// is terminated by a trusted proxy the certificate is read from the configured forwarding header.
+// A nil certificate is returned when the client did not present one.
+func (r *Resolver) ClientCertificate(req *http.Request) (*x509.Certificate, error) {
+	if req.TLS != nil && len(req.TLS.PeerCertificates) > 0 {
+		return req.TLS.PeerCertificates[0], nil
+	}
+	if r.certHeader == "" || !r.IsTrustedPeer(req) {
+		return nil, nil
+	}
+	value := req.Header.Get(r.certHeader)
+	if value == "" {
+		return nil, nil
+	}
+	return parseForwardedCertificate(value, time.Now())
+}
+
+// peerAddress returns the address of the peer that opened the connection of the request, as recorded by
+// ConnContext, or the remote address of the request when it was not recorded.
+func peerAddress(req *http.Request) string {
+	if addr, ok := req.Context().Value(peerAddrContextKey{}).(net.Addr); ok && addr != nil {
+		return addr.String()
+	}
+	return req.RemoteAddr
+}
+
+// forwardedHops returns the addresses recorded by proxies, ordered from the client to the nearest
+// proxy. The Forwarded header takes precedence over X-Forwarded-For. Entries that are not IP
+// addresses, such as obfuscated identifiers, are returned as invalid addresses.
+func forwardedHops(header http.Header) []netip.Addr {
+	if values := header.Values(headerForwarded); len(values) > 0 {
+		var hops []netip.Addr
+		for _, element := range splitList(values) {
+			hops = append(hops, parseHost(forwardedFor(element)))
+		}
+		return hops
+	}
+
+	var hops []netip.Addr
+	for _, entry := range splitList(header.Values(headerXForwardedFor)) {
+		hops = append(hops, parseHost(entry))
+	}
+	return hops
+}
+
+// forwardedFor returns the value of the "for" parameter of a Forwarded header element.
+func forwardedFor(element string) string {
+	for _, pair := range strings.Split(element, ";") {
+		key, value, found := strings.Cut(strings.TrimSpace(pair), "=")
+		if found && strings.EqualFold(key, "for") {
+			return strings.Trim(strings.TrimSpace(value), `"`)
+		}
+	}
+	return ""
+}
+
+// splitList splits comma separated header values into their trimmed entries.
+func splitList(values []string) []string {
+	var entries []string
+	for _, value := range values {
+		for _, entry := range strings.Split(value, ",") {
+			if entry = strings.TrimSpace(entry); entry != "" {
+				entries = append(entries, entry)
+			}
+		}
+	}
+	return entries
+}
+
+// isValidHeaderName reports whether the value is a valid HTTP header field name.
+func isValidHeaderName(name string) bool {
+	for i := 0; i < len(name); i++ {
+		c := name[i]
+		switch {
+		case c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z', c >= '0' && c <= '9':
+		case strings.IndexByte("!#$%&'*+-.^_`|~", c) >= 0:
+		default:
+			return false
+		}
+	}
+	return name != ""
+}
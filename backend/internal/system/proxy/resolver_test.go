@@ -0,0 +1,216 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package proxy
+
+import (
+	"bufio"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type ResolverTestSuite struct {
+	suite.Suite
+	resolver *Resolver
+}
+
+func TestResolverTestSuite(t *testing.T) {
+	suite.Run(t, new(ResolverTestSuite))
+}
+
+func (s *ResolverTestSuite) SetupTest() {
+	resolver, err := NewResolver([]string{"10.0.0.0/8"}, "X-Client-Cert")
+	s.Require().NoError(err)
+	s.resolver = resolver
+}
+
+func (s *ResolverTestSuite) newRequest(remoteAddr string, headers map[string]string) *http.Request {
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.RemoteAddr = remoteAddr
+	for name, value := range headers {
+		req.Header.Set(name, value)
+	}
+	return req
+}
+
+func (s *ResolverTestSuite) TestNewResolver_InvalidConfig() {
+	_, err := NewResolver([]string{"invalid"}, "")
+	s.ErrorIs(err, ErrInvalidTrustedProxy)
+
+	_, err = NewResolver(nil, "X Client Cert")
+	s.ErrorIs(err, ErrInvalidCertHeader)
+}
+
+func (s *ResolverTestSuite) TestClientIP() {
+	testCases := []struct {
+		name       string
+		remoteAddr string
+		headers    map[string]string
+		expected   string
+	}{
+		{
+			name:       "untrusted peer ignores headers",
+			remoteAddr: "203.0.113.5:1234",
+			headers:    map[string]string{"X-Forwarded-For": "198.51.100.1"},
+			expected:   "203.0.113.5",
+		},
+		{
+			name:       "trusted peer without headers",
+			remoteAddr: "10.0.0.1:1234",
+			expected:   "10.0.0.1",
+		},
+		{
+			name:       "x-forwarded-for from trusted peer",
+			remoteAddr: "10.0.0.1:1234",
+			headers:    map[string]string{"X-Forwarded-For": "198.51.100.1"},
+			expected:   "198.51.100.1",
+		},
+		{
+			name:       "spoofed entries before the client are skipped",
+			remoteAddr: "10.0.0.1:1234",
+			headers:    map[string]string{"X-Forwarded-For": "1.2.3.4, 198.51.100.1, 10.0.0.2"},
+			expected:   "198.51.100.1",
+		},
+		{
+			name:       "forwarded header takes precedence",
+			remoteAddr: "10.0.0.1:1234",
+			headers: map[string]string{
+				"Forwarded":       `for="[2001:db8::1]:4711";proto=https, for=10.0.0.2`,
+				"X-Forwarded-For": "198.51.100.1",
+			},
+			expected: "2001:db8::1",
+		},
+		{
+			name:       "obfuscated hop stops the walk",
+			remoteAddr: "10.0.0.1:1234",
+			headers:    map[string]string{"Forwarded": "for=198.51.100.1, for=_hidden, for=10.0.0.2"},
+			expected:   "10.0.0.2",
+		},
+		{
+			name:       "non ip remote address",
+			remoteAddr: "pipe",
+			expected:   "pipe",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.Equal(tc.expected, s.resolver.ClientIP(s.newRequest(tc.remoteAddr, tc.headers)))
+		})
+	}
+}
+
+func (s *ResolverTestSuite) TestClientCertificate_FromTLSConnection() {
+	cert := &x509.Certificate{}
+	req := s.newRequest("203.0.113.5:1234", nil)
+	req.TLS = &tls.ConnectionState{PeerCertificates: []*x509.Certificate{cert}}
+
+	result, err := s.resolver.ClientCertificate(req)
+	s.NoError(err)
+	s.Same(cert, result)
+}
+
+func (s *ResolverTestSuite) TestClientCertificate_FromTrustedProxy() {
+	_, certPEM := newTestCertificate(s.T(), time.Now().Add(-time.Hour), time.Now().Add(time.Hour))
+	req := s.newRequest("10.0.0.1:1234", map[string]string{"X-Client-Cert": url.QueryEscape(certPEM)})
+
+	result, err := s.resolver.ClientCertificate(req)
+	s.NoError(err)
+	s.Require().NotNil(result)
+	s.Equal("client", result.Subject.CommonName)
+}
+
+func (s *ResolverTestSuite) TestClientCertificate_IgnoredFromUntrustedPeer() {
+	_, certPEM := newTestCertificate(s.T(), time.Now().Add(-time.Hour), time.Now().Add(time.Hour))
+	req := s.newRequest("203.0.113.5:1234", map[string]string{"X-Client-Cert": url.QueryEscape(certPEM)})
+
+	result, err := s.resolver.ClientCertificate(req)
+	s.NoError(err)
+	s.Nil(result)
+}
+
+func (s *ResolverTestSuite) TestClientCertificate_NoHeader() {
+	result, err := s.resolver.ClientCertificate(s.newRequest("10.0.0.1:1234", nil))
+	s.NoError(err)
+	s.Nil(result)
+}
+
+func (s *ResolverTestSuite) TestClientCertificate_IgnoredWhenAnnouncedAddressIsTrusted() {
+	_, certPEM := newTestCertificate(s.T(), time.Now().Add(-time.Hour), time.Now().Add(time.Hour))
+	req := s.newRequest("10.0.0.1:1234", map[string]string{"X-Client-Cert": url.QueryEscape(certPEM)})
+	peer := &net.TCPAddr{IP: net.ParseIP("203.0.113.5"), Port: 1234}
+	req = req.WithContext(context.WithValue(req.Context(), peerAddrContextKey{}, net.Addr(peer)))
+
+	result, err := s.resolver.ClientCertificate(req)
+	s.NoError(err)
+	s.Nil(result)
+}
+
+func (s *ResolverTestSuite) TestClientCertificate_FromTrustedProxyWithProxyProtocol() {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	s.Require().NoError(err)
+	trusted, err := ParseTrustedProxies([]string{"127.0.0.1"})
+	s.Require().NoError(err)
+	resolver, err := NewResolver([]string{"127.0.0.1"}, "X-Client-Cert")
+	s.Require().NoError(err)
+
+	type result struct {
+		clientIP string
+		cert     *x509.Certificate
+		err      error
+	}
+	results := make(chan result, 1)
+	server := &http.Server{
+		Handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			cert, certErr := resolver.ClientCertificate(r)
+			results <- result{clientIP: resolver.ClientIP(r), cert: cert, err: certErr}
+		}),
+		ReadHeaderTimeout: 5 * time.Second,
+		ConnContext:       ConnContext,
+	}
+	go func() { _ = server.Serve(NewListener(ln, trusted)) }()
+	defer func() { _ = server.Close() }()
+
+	client, err := net.Dial("tcp", ln.Addr().String())
+	s.Require().NoError(err)
+	defer func() { _ = client.Close() }()
+
+	_, certPEM := newTestCertificate(s.T(), time.Now().Add(-time.Hour), time.Now().Add(time.Hour))
+	_, err = client.Write([]byte("PROXY TCP4 203.0.113.7 198.51.100.1 56324 443\r\n" +
+		"GET / HTTP/1.1\r\nHost: localhost\r\nX-Client-Cert: " + url.QueryEscape(certPEM) +
+		"\r\nConnection: close\r\n\r\n"))
+	s.Require().NoError(err)
+	resp, err := http.ReadResponse(bufio.NewReader(client), nil)
+	s.Require().NoError(err)
+	_ = resp.Body.Close()
+
+	got := <-results
+	s.Equal("203.0.113.7", got.clientIP)
+	s.NoError(got.err)
+	s.Require().NotNil(got.cert)
+	s.Equal("client", got.cert.Subject.CommonName)
+}
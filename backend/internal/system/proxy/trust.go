@@ -0,0 +1,93 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package proxy provides support for running the server behind reverse proxies and load
+// balancers: resolution of the original client IP from forwarding headers, extraction of
+// client certificates forwarded by TLS terminating proxies, and a PROXY protocol listener.
+// Forwarded information is honoured only when it arrives from a configured trusted proxy.
+package proxy
+
+import (
+	"fmt"
+	"net"
+	"net/netip"
+	"strings"
+)
+
+// TrustedProxies is the set of network ranges whose forwarded information is trusted.
+type TrustedProxies []netip.Prefix
+
+// ParseTrustedProxies parses a list of IP addresses and CIDR ranges into TrustedProxies.
+func ParseTrustedProxies(entries []string) (TrustedProxies, error) {
+	trusted := make(TrustedProxies, 0, len(entries))
+	for i, entry := range entries {
+		entry = strings.TrimSpace(entry)
+		if strings.Contains(entry, "/") {
+			prefix, err := netip.ParsePrefix(entry)
+			if err != nil {
+				return nil, fmt.Errorf("%w at index %d: %q", ErrInvalidTrustedProxy, i, entry)
+			}
+			trusted = append(trusted, prefix.Masked())
+			continue
+		}
+		addr, err := netip.ParseAddr(entry)
+		if err != nil {
+			return nil, fmt.Errorf("%w at index %d: %q", ErrInvalidTrustedProxy, i, entry)
+		}
+		addr = addr.Unmap()
+		trusted = append(trusted, netip.PrefixFrom(addr, addr.BitLen()))
+	}
+	return trusted, nil
+}
+
+// Contains reports whether the address belongs to one of the trusted ranges.
+func (t TrustedProxies) Contains(addr netip.Addr) bool {
+	if !addr.IsValid() {
+		return false
+	}
+	addr = addr.Unmap()
+	for _, prefix := range t {
+		if prefix.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+// ContainsAddr reports whether the host of a network address belongs to one of the trusted ranges.
+func (t TrustedProxies) ContainsAddr(addr net.Addr) bool {
+	if addr == nil {
+		return false
+	}
+	return t.Contains(parseHost(addr.String()))
+}
+
+// parseHost parses an IP address that may carry a port, brackets or an IPv6 zone.
+// An invalid address is returned when the value is not an IP address.
+func parseHost(value string) netip.Addr {
+	value = strings.TrimSpace(value)
+	if host, _, err := net.SplitHostPort(value); err == nil {
+		value = host
+	}
+	value = strings.TrimSuffix(strings.TrimPrefix(value, "["), "]")
+	addr, err := netip.ParseAddr(value)
+	if err != nil {
+		return netip.Addr{}
+	}
+	return addr.WithZone("").Unmap()
+}
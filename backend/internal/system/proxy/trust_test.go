@@ -0,0 +1,63 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package proxy
+
+import (
+	"net"
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type TrustTestSuite struct {
+	suite.Suite
+}
+
+func TestTrustTestSuite(t *testing.T) {
+	suite.Run(t, new(TrustTestSuite))
+}
+
+func (s *TrustTestSuite) TestParseTrustedProxies() {
+	trusted, err := ParseTrustedProxies([]string{"10.0.0.0/8", " 192.0.2.1 ", "2001:db8::/32"})
+	s.Require().NoError(err)
+
+	s.True(trusted.Contains(netip.MustParseAddr("10.1.2.3")))
+	s.True(trusted.Contains(netip.MustParseAddr("192.0.2.1")))
+	s.True(trusted.Contains(netip.MustParseAddr("::ffff:192.0.2.1")))
+	s.True(trusted.Contains(netip.MustParseAddr("2001:db8::1")))
+	s.False(trusted.Contains(netip.MustParseAddr("192.0.2.2")))
+	s.False(trusted.Contains(netip.Addr{}))
+}
+
+func (s *TrustTestSuite) TestParseTrustedProxies_InvalidEntry() {
+	for _, entry := range []string{"", "not-an-ip", "10.0.0.0/33", "example.com"} {
+		_, err := ParseTrustedProxies([]string{entry})
+		s.ErrorIs(err, ErrInvalidTrustedProxy, entry)
+	}
+}
+
+func (s *TrustTestSuite) TestContainsAddr() {
+	trusted, err := ParseTrustedProxies([]string{"127.0.0.1"})
+	s.Require().NoError(err)
+
+	s.True(trusted.ContainsAddr(&net.TCPAddr{IP: net.ParseIP("127.0.0.1"), Port: 8080}))
+	s.False(trusted.ContainsAddr(&net.TCPAddr{IP: net.ParseIP("127.0.0.2"), Port: 8080}))
+	s.False(trusted.ContainsAddr(nil))
+}
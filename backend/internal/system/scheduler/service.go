@@ -0,0 +1,141 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package scheduler runs background jobs at a fixed interval, on one node of the deployment at a time and
+// in every tenant partition.
+package scheduler
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	sysContext "github.com/thunder-id/thunderid/internal/system/context"
+	"github.com/thunder-id/thunderid/internal/system/distlock"
+	"github.com/thunder-id/thunderid/internal/system/log"
+)
+
+// JobFunc runs one pass of a background job over the partition the context is scoped to.
+type JobFunc func(ctx context.Context) error
+
+// TenantListerFunc returns the IDs of the tenants of the deployment.
+type TenantListerFunc func(ctx context.Context) ([]string, error)
+
+// SchedulerInterface runs background jobs at a fixed interval.
+type SchedulerInterface interface {
+	// Schedule runs the job at every interval until the scheduler is stopped. The name identifies the job
+	// and names the distributed lock that keeps it to one node at a time. Jobs with a non-positive interval
+	// are not scheduled.
+	Schedule(name string, interval time.Duration, job JobFunc)
+	// Stop stops all scheduled jobs and waits for running passes to return.
+	Stop()
+}
+
+// scheduler is the default implementation of SchedulerInterface.
+type scheduler struct {
+	lockManager distlock.LockManagerInterface
+	listTenants TenantListerFunc
+	ctx         context.Context
+	cancel      context.CancelFunc
+	wg          sync.WaitGroup
+	logger      *log.Logger
+}
+
+// newScheduler creates a new instance of scheduler.
+func newScheduler(lockManager distlock.LockManagerInterface, listTenants TenantListerFunc) *scheduler {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &scheduler{
+		lockManager: lockManager,
+		listTenants: listTenants,
+		ctx:         ctx,
+		cancel:      cancel,
+		logger:      log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName)),
+	}
+}
+
+// Schedule runs the job at every interval until the scheduler is stopped.
+func (s *scheduler) Schedule(name string, interval time.Duration, job JobFunc) {
+	if interval <= 0 {
+		return
+	}
+
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-s.ctx.Done():
+				return
+			case <-ticker.C:
+				s.run(s.ctx, name, job)
+			}
+		}
+	}()
+}
+
+// Stop stops all scheduled jobs and waits for running passes to return.
+func (s *scheduler) Stop() {
+	s.cancel()
+	s.wg.Wait()
+}
+
+// run runs one pass of the job while holding its lock. The pass is skipped when another node holds the
+// lock.
+func (s *scheduler) run(ctx context.Context, name string, job JobFunc) {
+	logger := s.logger.With(log.String("job", name))
+
+	ran, err := s.lockManager.TryWithLock(ctx, name, func(ctx context.Context, _ int64) error {
+		s.runInEveryPartition(ctx, logger, job)
+		return nil
+	})
+	if err != nil {
+		logger.Error("Failed to run scheduled job under its lock", log.Error(err))
+		return
+	}
+	if !ran {
+		logger.Debug("Skipped scheduled job as another node is running it")
+	}
+}
+
+// runInEveryPartition runs the job in the deployment root and then in every tenant. A failure in one
+// partition is logged and does not stop the job from running in the others.
+func (s *scheduler) runInEveryPartition(ctx context.Context, logger *log.Logger, job JobFunc) {
+	if err := job(ctx); err != nil {
+		logger.Error("Scheduled job failed", log.Error(err))
+	}
+	if s.listTenants == nil {
+		return
+	}
+
+	tenantIDs, err := s.listTenants(ctx)
+	if err != nil {
+		logger.Error("Failed to list the tenants to run the scheduled job in", log.Error(err))
+		return
+	}
+	for _, tenantID := range tenantIDs {
+		if ctx.Err() != nil {
+			return
+		}
+		if err := job(sysContext.WithTenantID(ctx, tenantID)); err != nil {
+			logger.Error("Scheduled job failed", log.String("tenantID", tenantID), log.Error(err))
+		}
+	}
+}
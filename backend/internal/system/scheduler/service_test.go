@@ -0,0 +1,155 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	sysContext "github.com/thunder-id/thunderid/internal/system/context"
+	"github.com/thunder-id/thunderid/tests/mocks/distlockmock"
+)
+
+type SchedulerTestSuite struct {
+	suite.Suite
+	mockLockManager *distlockmock.LockManagerInterfaceMock
+}
+
+func TestSchedulerTestSuite(t *testing.T) {
+	suite.Run(t, new(SchedulerTestSuite))
+}
+
+func (suite *SchedulerTestSuite) SetupTest() {
+	suite.mockLockManager = distlockmock.NewLockManagerInterfaceMock(suite.T())
+}
+
+// expectLockAcquired makes the lock manager run the locked function as if the lock were free.
+func (suite *SchedulerTestSuite) expectLockAcquired(name string) {
+	suite.mockLockManager.EXPECT().TryWithLock(mock.Anything, name, mock.Anything).
+		RunAndReturn(func(ctx context.Context, _ string, fn func(context.Context, int64) error) (bool, error) {
+			return true, fn(ctx, 1)
+		})
+}
+
+func (suite *SchedulerTestSuite) TestRun_RunsJobInRootAndEveryTenant() {
+	suite.expectLockAcquired("test-job")
+	s := newScheduler(suite.mockLockManager, func(ctx context.Context) ([]string, error) {
+		suite.Empty(sysContext.GetTenantID(ctx))
+		return []string{"tenant-1", "tenant-2"}, nil
+	})
+
+	var tenantIDs []string
+	s.run(context.Background(), "test-job", func(ctx context.Context) error {
+		tenantIDs = append(tenantIDs, sysContext.GetTenantID(ctx))
+		return nil
+	})
+
+	suite.Equal([]string{"", "tenant-1", "tenant-2"}, tenantIDs)
+}
+
+func (suite *SchedulerTestSuite) TestRun_ContinuesAfterPartitionFailure() {
+	suite.expectLockAcquired("test-job")
+	s := newScheduler(suite.mockLockManager, func(context.Context) ([]string, error) {
+		return []string{"tenant-1", "tenant-2"}, nil
+	})
+
+	var tenantIDs []string
+	s.run(context.Background(), "test-job", func(ctx context.Context) error {
+		tenantIDs = append(tenantIDs, sysContext.GetTenantID(ctx))
+		return errors.New("job failed")
+	})
+
+	suite.Equal([]string{"", "tenant-1", "tenant-2"}, tenantIDs)
+}
+
+func (suite *SchedulerTestSuite) TestRun_RunsJobInRootWhenTenantsCannotBeListed() {
+	suite.expectLockAcquired("test-job")
+	s := newScheduler(suite.mockLockManager, func(context.Context) ([]string, error) {
+		return nil, errors.New("store unavailable")
+	})
+
+	runs := 0
+	s.run(context.Background(), "test-job", func(ctx context.Context) error {
+		suite.Empty(sysContext.GetTenantID(ctx))
+		runs++
+		return nil
+	})
+
+	suite.Equal(1, runs)
+}
+
+func (suite *SchedulerTestSuite) TestRun_SkippedWhenLockHeld() {
+	suite.mockLockManager.EXPECT().TryWithLock(mock.Anything, "test-job", mock.Anything).Return(false, nil).Once()
+	s := newScheduler(suite.mockLockManager, nil)
+
+	s.run(context.Background(), "test-job", func(context.Context) error {
+		suite.Fail("job must not run while another node holds the lock")
+		return nil
+	})
+}
+
+func (suite *SchedulerTestSuite) TestSchedule_RunsUntilStopped() {
+	suite.expectLockAcquired("test-job")
+	s := newScheduler(suite.mockLockManager, nil)
+
+	var mu sync.Mutex
+	runs := 0
+	ran := make(chan struct{}, 1)
+	s.Schedule("test-job", time.Millisecond, func(context.Context) error {
+		mu.Lock()
+		runs++
+		mu.Unlock()
+		select {
+		case ran <- struct{}{}:
+		default:
+		}
+		return nil
+	})
+
+	select {
+	case <-ran:
+	case <-time.After(time.Second):
+		suite.FailNow("scheduled job did not run")
+	}
+	s.Stop()
+
+	mu.Lock()
+	stoppedAt := runs
+	mu.Unlock()
+	time.Sleep(10 * time.Millisecond)
+	mu.Lock()
+	defer mu.Unlock()
+	suite.Equal(stoppedAt, runs)
+}
+
+func (suite *SchedulerTestSuite) TestSchedule_IgnoresNonPositiveInterval() {
+	s := newScheduler(suite.mockLockManager, nil)
+
+	s.Schedule("test-job", 0, func(context.Context) error {
+		suite.Fail("job must not be scheduled")
+		return nil
+	})
+	s.Stop()
+}
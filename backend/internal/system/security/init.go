@@ -21,14 +21,26 @@ package security
 import (
 	"net/http"
 
+	"github.com/thunder-id/thunderid/internal/system/config"
 	"github.com/thunder-id/thunderid/internal/system/jose/jwt"
 )
 
-// Initialize creates and returns the security middleware with necessary authenticators.
-func Initialize(jwtService jwt.JWTServiceInterface) (func(http.Handler) http.Handler, error) {
-	jwtAuthenticator := newJWTAuthenticator(jwtService)
-	securityService, err := newSecurityService(
-		[]AuthenticatorInterface{jwtAuthenticator}, publicPaths, apiPermissionEntries)
+// Initialize creates and returns the security middleware with necessary authenticators. Tokens of the given
+// legacy issuers are accepted for their APIs, with their users mapped by the legacy user resolver.
+func Initialize(jwtService jwt.JWTServiceInterface, legacyIssuers []config.LegacyIssuerConfig,
+	legacyUserResolver LegacyUserResolverFunc) (func(http.Handler) http.Handler, error) {
+	authenticators := make([]AuthenticatorInterface, 0, 2)
+	// Tokens of legacy issuers are recognized by their issuer before the JWT authenticator handles them.
+	if len(legacyIssuers) > 0 {
+		legacyAuthenticator, err := newLegacyTokenAuthenticator(jwtService, legacyUserResolver, legacyIssuers)
+		if err != nil {
+			return nil, err
+		}
+		authenticators = append(authenticators, legacyAuthenticator)
+	}
+	authenticators = append(authenticators, newJWTAuthenticator(jwtService))
+
+	securityService, err := newSecurityService(authenticators, publicPaths, apiPermissionEntries)
 	if err != nil {
 		return nil, err
 	}
@@ -19,6 +19,7 @@
 package security
 
 import (
+	"context"
 	"net/http"
 	"strings"
 
@@ -65,7 +66,7 @@ func (h *jwtAuthenticator) Authenticate(r *http.Request) (*SecurityContext, erro
 	// and verifies tokens exclusively against its JWKS. Otherwise, verify with the
 	// server's own signing key.
 	if config.GetServerRuntime().Config.Server.SecurityConfig.TrustedIssuer.IsConfigured() {
-		if !h.verifyFederatedToken(token) {
+		if !h.verifyFederatedToken(r.Context(), token) {
 			return nil, errInvalidToken
 		}
 	} else {
@@ -101,7 +102,7 @@ func (h *jwtAuthenticator) Authenticate(r *http.Request) (*SecurityContext, erro
 //   - aud: matches this server's own identifier (the resource server)
 //   - signature: verified via the auth server's JWKS endpoint
 //   - required_claims: each configured claim must match the expected value
-func (h *jwtAuthenticator) verifyFederatedToken(token string) (verified bool) {
+func (h *jwtAuthenticator) verifyFederatedToken(ctx context.Context, token string) (verified bool) {
 	trustedIssuer := config.GetServerRuntime().Config.Server.SecurityConfig.TrustedIssuer
 	if !trustedIssuer.IsConfigured() {
 		return false
@@ -119,7 +120,7 @@ func (h *jwtAuthenticator) verifyFederatedToken(token string) (verified bool) {
 
 	// VerifyJWTWithJWKS validates signature, aud (resource server identity), iss, and time claims.
 	if svcErr := h.jwtService.VerifyJWTWithJWKS(
-		token, trustedIssuer.JWKSURL, trustedIssuer.Audience, trustedIssuer.Issuer,
+		ctx, token, trustedIssuer.JWKSURL, trustedIssuer.Audience, trustedIssuer.Issuer,
 	); svcErr != nil {
 		return false
 	}
@@ -27,6 +27,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 
 	"github.com/thunder-id/thunderid/internal/system/config"
@@ -482,7 +483,7 @@ func (suite *JWTAuthenticatorTestSuite) TestVerifyFederatedToken_Disabled() {
 		map[string]interface{}{"sub": "user1", "iss": testFederatedIssuer},
 	)
 
-	result := suite.authenticator.verifyFederatedToken(token)
+	result := suite.authenticator.verifyFederatedToken(context.Background(), token)
 	assert.False(suite.T(), result)
 }
 
@@ -508,7 +509,7 @@ func (suite *JWTAuthenticatorTestSuite) TestVerifyFederatedToken_IssuerMismatch(
 		map[string]interface{}{"sub": "user1", "iss": "https://wrong-auth:8090"},
 	)
 
-	result := suite.authenticator.verifyFederatedToken(token)
+	result := suite.authenticator.verifyFederatedToken(context.Background(), token)
 	assert.False(suite.T(), result)
 }
 
@@ -539,10 +540,10 @@ func (suite *JWTAuthenticatorTestSuite) TestVerifyFederatedToken_JWKSVerificatio
 	)
 
 	mockJWT := jwtmock.NewJWTServiceInterfaceMock(suite.T())
-	mockJWT.On("VerifyJWTWithJWKS", token, jwksURL, audience, issuer).Return(nil)
+	mockJWT.On("VerifyJWTWithJWKS", mock.Anything, token, jwksURL, audience, issuer).Return(nil)
 	auth := newJWTAuthenticator(mockJWT)
 
-	result := auth.verifyFederatedToken(token)
+	result := auth.verifyFederatedToken(context.Background(), token)
 	assert.True(suite.T(), result)
 	mockJWT.AssertExpectations(suite.T())
 }
@@ -574,14 +575,14 @@ func (suite *JWTAuthenticatorTestSuite) TestVerifyFederatedToken_JWKSVerificatio
 	)
 
 	mockJWT := jwtmock.NewJWTServiceInterfaceMock(suite.T())
-	mockJWT.On("VerifyJWTWithJWKS", token, jwksURL, audience, issuer).Return(&serviceerror.ServiceError{
+	mockJWT.On("VerifyJWTWithJWKS", mock.Anything, token, jwksURL, audience, issuer).Return(&serviceerror.ServiceError{
 		Type:  serviceerror.ServerErrorType,
 		Code:  "JWKS_ERROR",
 		Error: i18ncore.I18nMessage{DefaultValue: "JWKS verification failed"},
 	})
 	auth := newJWTAuthenticator(mockJWT)
 
-	result := auth.verifyFederatedToken(token)
+	result := auth.verifyFederatedToken(context.Background(), token)
 	assert.False(suite.T(), result)
 	mockJWT.AssertExpectations(suite.T())
 }
@@ -670,10 +671,10 @@ func (suite *JWTAuthenticatorTestSuite) TestVerifyFederatedToken_RequiredClaims(
 			)
 
 			mockJWT := jwtmock.NewJWTServiceInterfaceMock(suite.T())
-			mockJWT.On("VerifyJWTWithJWKS", token, jwksURL, audience, issuer).Return(nil)
+			mockJWT.On("VerifyJWTWithJWKS", mock.Anything, token, jwksURL, audience, issuer).Return(nil)
 			auth := newJWTAuthenticator(mockJWT)
 
-			result := auth.verifyFederatedToken(token)
+			result := auth.verifyFederatedToken(context.Background(), token)
 			assert.Equal(suite.T(), tc.expectedResult, result)
 			mockJWT.AssertExpectations(suite.T())
 		})
@@ -738,7 +739,7 @@ func (suite *JWTAuthenticatorTestSuite) TestVerifyFederatedToken_InvalidPayload(
 			mockJWT := jwtmock.NewJWTServiceInterfaceMock(suite.T())
 			auth := newJWTAuthenticator(mockJWT)
 
-			result := auth.verifyFederatedToken(tc.token)
+			result := auth.verifyFederatedToken(context.Background(), tc.token)
 			assert.False(suite.T(), result, "malformed token must not verify")
 			mockJWT.AssertNotCalled(suite.T(), "VerifyJWTWithJWKS")
 		})
@@ -779,7 +780,7 @@ func (suite *JWTAuthenticatorTestSuite) TestAuthenticate_FederatedTokenFailure()
 	)
 
 	mockJWT := jwtmock.NewJWTServiceInterfaceMock(suite.T())
-	mockJWT.On("VerifyJWTWithJWKS", token, jwksURL, audience, issuer).Return(&serviceerror.ServiceError{
+	mockJWT.On("VerifyJWTWithJWKS", mock.Anything, token, jwksURL, audience, issuer).Return(&serviceerror.ServiceError{
 		Type:  serviceerror.ServerErrorType,
 		Code:  "JWKS_ERROR",
 		Error: i18ncore.I18nMessage{DefaultValue: "JWKS verification failed"},
@@ -828,7 +829,7 @@ func (suite *JWTAuthenticatorTestSuite) TestAuthenticate_FederatedTokenSuccess()
 
 	mockJWT := jwtmock.NewJWTServiceInterfaceMock(suite.T())
 	// When trusted issuer is configured, the local-key path is skipped entirely.
-	mockJWT.On("VerifyJWTWithJWKS", token, jwksURL, audience, issuer).Return(nil)
+	mockJWT.On("VerifyJWTWithJWKS", mock.Anything, token, jwksURL, audience, issuer).Return(nil)
 	auth := newJWTAuthenticator(mockJWT)
 
 	req := httptest.NewRequest(http.MethodGet, "/users", nil)
@@ -0,0 +1,165 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package security
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/system/apiversion"
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/constants"
+	"github.com/thunder-id/thunderid/internal/system/jose/jwt"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/internal/system/utils"
+)
+
+// defaultLegacyUserClaim is the claim identifying the user when a legacy issuer does not configure one.
+const defaultLegacyUserClaim = "sub"
+
+// LegacyUserResolverFunc resolves the user whose attribute holds the given value. It returns the ID of the
+// user and the ID of the organization unit of the user.
+type LegacyUserResolverFunc func(ctx context.Context, attribute, value string) (string, string, error)
+
+// legacyIssuer is a legacy issuer with its API patterns compiled and its sunset time parsed.
+type legacyIssuer struct {
+	config.LegacyIssuerConfig
+	apis   []*regexp.Regexp
+	sunset time.Time
+}
+
+// legacyTokenAuthenticator accepts Bearer tokens issued by a legacy identity provider during a migration.
+// Tokens are accepted only for the APIs configured for their issuer and only until its sunset time.
+type legacyTokenAuthenticator struct {
+	jwtService   jwt.JWTServiceInterface
+	userResolver LegacyUserResolverFunc
+	issuers      map[string]*legacyIssuer
+	logger       *log.Logger
+}
+
+// newLegacyTokenAuthenticator creates a legacy token authenticator for the given legacy issuers.
+func newLegacyTokenAuthenticator(jwtService jwt.JWTServiceInterface, userResolver LegacyUserResolverFunc,
+	issuerConfigs []config.LegacyIssuerConfig) (*legacyTokenAuthenticator, error) {
+	issuers := make(map[string]*legacyIssuer, len(issuerConfigs))
+	for _, issuerConfig := range issuerConfigs {
+		apis, err := compilePathPatterns(issuerConfig.APIs)
+		if err != nil {
+			return nil, fmt.Errorf("invalid apis of legacy issuer %q: %w", issuerConfig.Issuer, err)
+		}
+		sunset, err := issuerConfig.SunsetTime()
+		if err != nil {
+			return nil, fmt.Errorf("invalid sunset of legacy issuer %q: %w", issuerConfig.Issuer, err)
+		}
+		issuers[issuerConfig.Issuer] = &legacyIssuer{LegacyIssuerConfig: issuerConfig, apis: apis, sunset: sunset}
+	}
+
+	return &legacyTokenAuthenticator{
+		jwtService:   jwtService,
+		userResolver: userResolver,
+		issuers:      issuers,
+		logger:       log.GetLogger().With(log.String(log.LoggerKeyComponentName, "LegacyTokenAuthenticator")),
+	}, nil
+}
+
+// CanHandle checks if the request carries a Bearer token whose iss claim names a legacy issuer.
+func (h *legacyTokenAuthenticator) CanHandle(r *http.Request) bool {
+	return h.issuerOf(r) != nil
+}
+
+// Authenticate verifies the legacy token and builds a SecurityContext for the user it maps to.
+func (h *legacyTokenAuthenticator) Authenticate(r *http.Request) (*SecurityContext, error) {
+	issuer := h.issuerOf(r)
+	if issuer == nil {
+		return nil, errInvalidToken
+	}
+	ctx := r.Context()
+	token, _ := extractToken(r.Header.Get(constants.AuthorizationHeaderName))
+
+	if !time.Now().Before(issuer.sunset) {
+		h.logger.Warn("Rejected a token of a legacy issuer past its sunset", log.String("issuer", issuer.Issuer))
+		recordLegacyTokenUsage(ctx, issuer.Issuer, legacyTokenResultSunset)
+		return nil, errInvalidToken
+	}
+	if !issuer.acceptsPath(apiversion.StripVersionPrefix(r.URL.Path)) {
+		recordLegacyTokenUsage(ctx, issuer.Issuer, legacyTokenResultAPINotAllowed)
+		return nil, errInvalidToken
+	}
+	if svcErr := h.jwtService.VerifyJWTWithJWKS(
+		ctx, token, issuer.JWKSURL, issuer.Audience, issuer.Issuer); svcErr != nil {
+		recordLegacyTokenUsage(ctx, issuer.Issuer, legacyTokenResultInvalid)
+		return nil, errInvalidToken
+	}
+
+	attributes, err := jwt.DecodeJWTPayload(token)
+	if err != nil {
+		recordLegacyTokenUsage(ctx, issuer.Issuer, legacyTokenResultInvalid)
+		return nil, errInvalidToken
+	}
+	userClaim := issuer.UserClaim
+	if userClaim == "" {
+		userClaim = defaultLegacyUserClaim
+	}
+	userValue := extractAttribute(attributes, userClaim)
+	if userValue == "" || h.userResolver == nil {
+		recordLegacyTokenUsage(ctx, issuer.Issuer, legacyTokenResultUnmappedUser)
+		return nil, errInvalidToken
+	}
+	userID, ouID, err := h.userResolver(ctx, issuer.UserAttribute, userValue)
+	if err != nil || userID == "" {
+		h.logger.Debug("Failed to map the user of a legacy token", log.String("issuer", issuer.Issuer),
+			log.Error(err))
+		recordLegacyTokenUsage(ctx, issuer.Issuer, legacyTokenResultUnmappedUser)
+		return nil, errInvalidToken
+	}
+
+	recordLegacyTokenUsage(ctx, issuer.Issuer, legacyTokenResultAccepted)
+	return newSecurityContext(userID, ouID, token, extractScopes(attributes), attributes), nil
+}
+
+// issuerOf returns the legacy issuer named by the iss claim of the Bearer token of the request, or nil when
+// the request carries no token of a legacy issuer.
+func (h *legacyTokenAuthenticator) issuerOf(r *http.Request) *legacyIssuer {
+	authHeader := r.Header.Get(constants.AuthorizationHeaderName)
+	if !utils.HasPrefixFold(authHeader, constants.AuthSchemeBearer) {
+		return nil
+	}
+	token, err := extractToken(authHeader)
+	if err != nil || token == "" {
+		return nil
+	}
+	attributes, err := jwt.DecodeJWTPayload(token)
+	if err != nil {
+		return nil
+	}
+	iss, _ := attributes["iss"].(string)
+	return h.issuers[iss]
+}
+
+// acceptsPath reports whether tokens of the legacy issuer are accepted for the given path.
+func (i *legacyIssuer) acceptsPath(path string) bool {
+	for _, api := range i.apis {
+		if api.MatchString(path) {
+			return true
+		}
+	}
+	return false
+}
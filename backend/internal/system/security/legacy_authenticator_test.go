@@ -0,0 +1,190 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package security
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/tests/mocks/jose/jwtmock"
+)
+
+const (
+	testLegacyIssuer  = "https://legacy-idp.example.com"
+	testLegacyJWKSURL = "https://legacy-idp.example.com/jwks"
+)
+
+type LegacyTokenAuthenticatorTestSuite struct {
+	suite.Suite
+	mockJWT       *jwtmock.JWTServiceInterfaceMock
+	issuerConfig  config.LegacyIssuerConfig
+	resolvedValue string
+}
+
+func TestLegacyTokenAuthenticatorSuite(t *testing.T) {
+	suite.Run(t, new(LegacyTokenAuthenticatorTestSuite))
+}
+
+func (suite *LegacyTokenAuthenticatorTestSuite) SetupTest() {
+	suite.mockJWT = jwtmock.NewJWTServiceInterfaceMock(suite.T())
+	suite.issuerConfig = config.LegacyIssuerConfig{
+		Issuer:        testLegacyIssuer,
+		JWKSURL:       testLegacyJWKSURL,
+		UserClaim:     "email",
+		UserAttribute: "email",
+		APIs:          []string{"/users/**"},
+		Sunset:        time.Now().Add(time.Hour).UTC().Format(time.RFC3339),
+	}
+	suite.resolvedValue = ""
+}
+
+func (suite *LegacyTokenAuthenticatorTestSuite) newAuthenticator() *legacyTokenAuthenticator {
+	authenticator, err := newLegacyTokenAuthenticator(suite.mockJWT,
+		func(_ context.Context, attribute, value string) (string, string, error) {
+			suite.resolvedValue = attribute + "=" + value
+			if value != "alice@example.com" {
+				return "", "", errors.New("user not found")
+			}
+			return "user-1", "ou-1", nil
+		}, []config.LegacyIssuerConfig{suite.issuerConfig})
+	suite.Require().NoError(err)
+	return authenticator
+}
+
+func (suite *LegacyTokenAuthenticatorTestSuite) newRequest(path string, claims map[string]interface{}) (
+	*http.Request, string) {
+	token := buildFakeJWT(map[string]interface{}{"alg": "RS256"}, claims)
+	req := httptest.NewRequest(http.MethodGet, path, nil)
+	req.Header.Set("Authorization", "Bearer "+token)
+	return req, token
+}
+
+func (suite *LegacyTokenAuthenticatorTestSuite) TestCanHandle() {
+	authenticator := suite.newAuthenticator()
+
+	req, _ := suite.newRequest("/users", map[string]interface{}{"iss": testLegacyIssuer})
+	suite.True(authenticator.CanHandle(req))
+
+	req, _ = suite.newRequest("/users", map[string]interface{}{"iss": "https://thunder.example.com"})
+	suite.False(authenticator.CanHandle(req))
+
+	suite.False(authenticator.CanHandle(httptest.NewRequest(http.MethodGet, "/users", nil)))
+}
+
+func (suite *LegacyTokenAuthenticatorTestSuite) TestAuthenticate_Success() {
+	authenticator := suite.newAuthenticator()
+	req, token := suite.newRequest("/users/user-1", map[string]interface{}{
+		"iss": testLegacyIssuer, "sub": "legacy-42", "email": "alice@example.com", "scope": "system",
+	})
+	suite.mockJWT.On("VerifyJWTWithJWKS", mock.Anything, token, testLegacyJWKSURL, "", testLegacyIssuer).
+		Return(nil)
+
+	securityCtx, err := authenticator.Authenticate(req)
+
+	suite.NoError(err)
+	ctx := withSecurityContext(context.Background(), securityCtx)
+	suite.Equal("user-1", GetSubject(ctx))
+	suite.Equal("ou-1", GetOUID(ctx))
+	suite.Equal([]string{"system"}, GetPermissions(ctx))
+	suite.Equal("email=alice@example.com", suite.resolvedValue)
+}
+
+func (suite *LegacyTokenAuthenticatorTestSuite) TestAuthenticate_RejectsAfterSunset() {
+	suite.issuerConfig.Sunset = time.Now().Add(-time.Minute).UTC().Format(time.RFC3339)
+	authenticator := suite.newAuthenticator()
+	req, _ := suite.newRequest("/users", map[string]interface{}{"iss": testLegacyIssuer, "email": "alice@example.com"})
+
+	securityCtx, err := authenticator.Authenticate(req)
+
+	suite.Nil(securityCtx)
+	suite.ErrorIs(err, errInvalidToken)
+	suite.mockJWT.AssertNotCalled(suite.T(), "VerifyJWTWithJWKS",
+		mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *LegacyTokenAuthenticatorTestSuite) TestAuthenticate_RejectsAPINotAllowed() {
+	authenticator := suite.newAuthenticator()
+	req, _ := suite.newRequest("/roles", map[string]interface{}{"iss": testLegacyIssuer, "email": "alice@example.com"})
+
+	_, err := authenticator.Authenticate(req)
+
+	suite.ErrorIs(err, errInvalidToken)
+}
+
+func (suite *LegacyTokenAuthenticatorTestSuite) TestAuthenticate_RejectsInvalidSignature() {
+	authenticator := suite.newAuthenticator()
+	req, token := suite.newRequest("/users", map[string]interface{}{
+		"iss": testLegacyIssuer, "email": "alice@example.com",
+	})
+	suite.mockJWT.On("VerifyJWTWithJWKS", mock.Anything, token, testLegacyJWKSURL, "", testLegacyIssuer).
+		Return(&serviceerror.InternalServerError)
+
+	_, err := authenticator.Authenticate(req)
+
+	suite.ErrorIs(err, errInvalidToken)
+	suite.Empty(suite.resolvedValue)
+}
+
+func (suite *LegacyTokenAuthenticatorTestSuite) TestAuthenticate_RejectsUnmappedUser() {
+	authenticator := suite.newAuthenticator()
+	req, token := suite.newRequest("/users", map[string]interface{}{
+		"iss": testLegacyIssuer, "email": "mallory@example.com",
+	})
+	suite.mockJWT.On("VerifyJWTWithJWKS", mock.Anything, token, testLegacyJWKSURL, "", testLegacyIssuer).
+		Return(nil)
+
+	_, err := authenticator.Authenticate(req)
+
+	suite.ErrorIs(err, errInvalidToken)
+}
+
+func (suite *LegacyTokenAuthenticatorTestSuite) TestAuthenticate_RejectsMissingUserClaim() {
+	suite.issuerConfig.UserClaim = ""
+	authenticator := suite.newAuthenticator()
+	req, token := suite.newRequest("/users", map[string]interface{}{
+		"iss": testLegacyIssuer, "email": "alice@example.com",
+	})
+	suite.mockJWT.On("VerifyJWTWithJWKS", mock.Anything, token, testLegacyJWKSURL, "", testLegacyIssuer).
+		Return(nil)
+
+	_, err := authenticator.Authenticate(req)
+
+	suite.ErrorIs(err, errInvalidToken)
+	suite.Empty(suite.resolvedValue)
+}
+
+func (suite *LegacyTokenAuthenticatorTestSuite) TestNewLegacyTokenAuthenticator_InvalidConfig() {
+	suite.issuerConfig.APIs = []string{"/users/**/roles"}
+	_, err := newLegacyTokenAuthenticator(suite.mockJWT, nil, []config.LegacyIssuerConfig{suite.issuerConfig})
+	suite.Error(err)
+
+	suite.issuerConfig.APIs = []string{"/users"}
+	suite.issuerConfig.Sunset = "next year"
+	_, err = newLegacyTokenAuthenticator(suite.mockJWT, nil, []config.LegacyIssuerConfig{suite.issuerConfig})
+	suite.Error(err)
+}
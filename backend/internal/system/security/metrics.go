@@ -0,0 +1,66 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package security
+
+import (
+	"context"
+	"sync"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+// Results of the validation of a token of a legacy issuer.
+const (
+	legacyTokenResultAccepted      = "accepted"
+	legacyTokenResultSunset        = "sunset"
+	legacyTokenResultAPINotAllowed = "api_not_allowed"
+	legacyTokenResultInvalid       = "invalid"
+	legacyTokenResultUnmappedUser  = "unmapped_user"
+)
+
+type securityMetrics struct {
+	once         sync.Once
+	legacyTokens metric.Int64Counter
+}
+
+var secMetrics securityMetrics
+
+func initSecurityMetrics() {
+	secMetrics.once.Do(func() {
+		meter := otel.Meter("github.com/thunder-id/thunderid/security")
+		secMetrics.legacyTokens, _ = meter.Int64Counter(
+			"thunderid_legacy_token_validations_total",
+			metric.WithDescription("Total validations of tokens issued by legacy issuers, per issuer and result"),
+		)
+	})
+}
+
+// recordLegacyTokenUsage records the validation of a token of a legacy issuer with the given result.
+func recordLegacyTokenUsage(ctx context.Context, issuer, result string) {
+	initSecurityMetrics()
+	if secMetrics.legacyTokens == nil {
+		return
+	}
+	secMetrics.legacyTokens.Add(ctx, 1, metric.WithAttributes(
+		attribute.String("issuer", issuer),
+		attribute.String("result", result),
+	))
+}
@@ -41,10 +41,15 @@ var publicPaths = []string{
 	"/register/passkey/**",
 	"/flow/execute/**",
 	"/flow/meta",
+	"/flow-executions/*/context",
+	// Execution event streams are authorized by the challenge token of the current step.
+	"/flow-executions/*/events",
 	"/oauth2/**",
 	"/.well-known/openid-configuration/**",
 	"/.well-known/oauth-authorization-server/**",
 	"/.well-known/oauth-protected-resource",
+	"/.well-known/oauth-protected-resource/resource-servers/*",
+	"/.well-known/ssf-configuration",
 	"/gate/**",
 	"/console/**",
 	"/error/**",
@@ -53,6 +58,14 @@ var publicPaths = []string{
 	"/i18n/languages/*/translations/resolve",
 	"/i18n/languages/*/translations/ns/*/keys/*/resolve",
 	"/mcp/**", // MCP authorization is handled at MCP server handler.
+	// User picture reads are authorized by the signature in the URL; only GET is served.
+	"/users/*/picture/view",
+	// Email change confirmations are authorized by the confirmation token.
+	"/email-change/confirm",
+	// Email change reverts are authorized by the signed revert token.
+	"/account-protection/revert",
+	// Account deletion cancellations are authorized by the cancellation token.
+	"/account-deletion/cancel",
 }
 
 // ---- Resource types ----
@@ -136,6 +149,22 @@ const (
 	ActionDeleteAgentType Action = "agenttype:delete"
 	// ActionListAgentTypes lists agent types.
 	ActionListAgentTypes Action = "agenttype:list"
+
+	// Change request actions.
+	// ActionApproveChangeRequest approves or rejects a change request of another administrator.
+	ActionApproveChangeRequest Action = "changerequest:approve"
+
+	// Diagnostics actions.
+	// ActionReadOAuthTraces reads the protocol traces captured for OAuth clients.
+	ActionReadOAuthTraces Action = "diagnostics:read-oauth-traces"
+	// ActionExplainTokens simulates token requests of OAuth clients and explains their outcome.
+	ActionExplainTokens Action = "diagnostics:explain-tokens"
+
+	// Relationship actions.
+	// ActionReadRelationships reads and checks relationship tuples.
+	ActionReadRelationships Action = "relationship:read"
+	// ActionWriteRelationships writes and deletes relationship tuples.
+	ActionWriteRelationships Action = "relationship:write"
 )
 
 // ---- Permissions ----
@@ -236,8 +265,17 @@ func InitSystemPermissions(handle string) {
 		{"GET /users/me/**", ""},
 		{"PUT /users/me/**", ""},
 		{"POST /users/me/update-credentials", ""},
+		{"DELETE /users/me/email-change", ""},
+		{"POST /users/me/delete-request", ""},
+		{"DELETE /users/me/delete-request", ""},
+		{"POST /enrollment-sessions/qr", ""},
+		{"POST /enrollment-sessions/revoke", ""},
 		{"GET /register/passkey/**", ""},
 		{"POST /register/passkey/**", ""},
+		{"GET /access-reviews/my-items", ""},
+		{"POST /access-reviews/*/decisions", ""},
+		// Security event polls are authorized against the client IDs of the configured poll receivers.
+		{"POST /shared-signals/poll", ""},
 
 		// Organization unit APIs — exact named paths before wildcards.
 		{"GET /organization-units/tree", p.OUView},
@@ -245,6 +283,9 @@ func InitSystemPermissions(handle string) {
 		{"DELETE /organization-units/tree", p.OU},
 		{"GET /organization-units", p.OUView},
 		{"POST /organization-units", p.OU},
+		{"POST /organization-units/deletion-jobs", p.OU},
+		{"POST /organization-units/deletion-jobs/**", p.OU},
+		{"POST /organization-units/provisioning/**", p.OU},
 		{"GET /organization-units/**", p.OUView},
 		{"PUT /organization-units/**", p.OU},
 		{"DELETE /organization-units/**", p.OU},
@@ -255,6 +296,8 @@ func InitSystemPermissions(handle string) {
 		{"GET /users/**", p.UserView},
 		{"PUT /users/**", p.User},
 		{"DELETE /users/**", p.User},
+		{"GET /email-change", p.UserView},
+		{"DELETE /email-change", p.User},
 
 		// Group APIs.
 		{"GET /groups", p.GroupView},
@@ -358,6 +358,14 @@ func TestGetRequiredPermissionForAPI(t *testing.T) {
 			name:   "POST /register/passkey/finish self-service",
 			method: http.MethodPost, path: "/register/passkey/finish", wantPerm: "",
 		},
+		{
+			name:   "GET /access-reviews/my-items self-service",
+			method: http.MethodGet, path: "/access-reviews/my-items", wantPerm: "",
+		},
+		{
+			name:   "POST /access-reviews/{id}/decisions self-service",
+			method: http.MethodPost, path: "/access-reviews/c1/decisions", wantPerm: "",
+		},
 
 		// ---- Prefix match — dynamic path segments ----
 		{
@@ -0,0 +1,77 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package security
+
+import "context"
+
+// PrincipalType identifies the kind of caller a security context belongs to.
+type PrincipalType string
+
+const (
+	// PrincipalTypeUser identifies a user, or any caller whose token carries no subject type.
+	PrincipalTypeUser PrincipalType = "user"
+	// PrincipalTypeService identifies a machine client acting as a service identity.
+	PrincipalTypeService PrincipalType = "service"
+)
+
+// Token claims describing the principal of a security context.
+const (
+	claimSubjectType = "sub_type"
+	claimServiceID   = "service_id"
+	claimClientID    = "client_id"
+)
+
+// ServiceIdentity is the identity a machine client acts as. It is the application the client belongs to, so
+// the role assignments and relationships of the application apply to the client.
+type ServiceIdentity struct {
+	// ID is the ID of the application the client belongs to.
+	ID string
+	// ClientID is the OAuth client ID of the client.
+	ClientID string
+}
+
+// GetPrincipalType returns the kind of the authenticated caller.
+// Returns empty string if no security context is present or the caller has no subject.
+func GetPrincipalType(ctx context.Context) PrincipalType {
+	if GetServiceIdentity(ctx) != nil {
+		return PrincipalTypeService
+	}
+	if GetSubject(ctx) == "" {
+		return ""
+	}
+	return PrincipalTypeUser
+}
+
+// GetServiceIdentity returns the service identity of a machine client caller.
+// Returns nil if no security context is present or the caller is not a machine client.
+func GetServiceIdentity(ctx context.Context) *ServiceIdentity {
+	authCtx := getSecurityContext(ctx)
+	if authCtx == nil || authCtx.subject == "" {
+		return nil
+	}
+	if subjectType, _ := authCtx.attributes[claimSubjectType].(string); subjectType != string(PrincipalTypeService) {
+		return nil
+	}
+	serviceID, _ := authCtx.attributes[claimServiceID].(string)
+	if serviceID == "" {
+		return nil
+	}
+	clientID, _ := authCtx.attributes[claimClientID].(string)
+	return &ServiceIdentity{ID: serviceID, ClientID: clientID}
+}
@@ -0,0 +1,67 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package security
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type PrincipalTestSuite struct {
+	suite.Suite
+}
+
+func TestPrincipalTestSuite(t *testing.T) {
+	suite.Run(t, new(PrincipalTestSuite))
+}
+
+func (s *PrincipalTestSuite) TestServicePrincipal() {
+	ctx := withSecurityContext(context.Background(), newSecurityContext("client-1", "ou-1", "token", nil,
+		map[string]interface{}{
+			claimSubjectType: string(PrincipalTypeService),
+			claimServiceID:   "app-1",
+			claimClientID:    "client-1",
+		}))
+
+	s.Equal(PrincipalTypeService, GetPrincipalType(ctx))
+	s.Equal(&ServiceIdentity{ID: "app-1", ClientID: "client-1"}, GetServiceIdentity(ctx))
+}
+
+func (s *PrincipalTestSuite) TestUserPrincipal() {
+	ctx := withSecurityContext(context.Background(), newSecurityContext(testUserID, "ou-1", "token", nil,
+		map[string]interface{}{"sub": testUserID}))
+
+	s.Equal(PrincipalTypeUser, GetPrincipalType(ctx))
+	s.Nil(GetServiceIdentity(ctx))
+}
+
+func (s *PrincipalTestSuite) TestServiceSubjectTypeWithoutServiceID() {
+	ctx := withSecurityContext(context.Background(), newSecurityContext("client-1", "ou-1", "token", nil,
+		map[string]interface{}{claimSubjectType: string(PrincipalTypeService)}))
+
+	s.Equal(PrincipalTypeUser, GetPrincipalType(ctx))
+	s.Nil(GetServiceIdentity(ctx))
+}
+
+func (s *PrincipalTestSuite) TestNoSecurityContext() {
+	s.Equal(PrincipalType(""), GetPrincipalType(context.Background()))
+	s.Nil(GetServiceIdentity(context.Background()))
+}
@@ -25,6 +25,7 @@ import (
 	"os"
 	"regexp"
 
+	"github.com/thunder-id/thunderid/internal/system/apiversion"
 	"github.com/thunder-id/thunderid/internal/system/log"
 )
 
@@ -94,7 +95,8 @@ func newSecurityService(authenticators []AuthenticatorInterface, publicPaths []s
 // Process handles the complete security flow: authentication and authorization.
 // Returns an enriched context on success, or an error if authentication or authorization fails.
 func (s *securityService) Process(r *http.Request) (context.Context, error) {
-	isPublic := s.isPublicPath(r.URL.Path)
+	// Path-based policies apply to every version of a route, so match on the unversioned path.
+	isPublic := s.isPublicPath(apiversion.StripVersionPrefix(r.URL.Path))
 
 	// Check if the request is options (CORS preflight)
 	if r.Method == http.MethodOptions {
@@ -138,7 +140,7 @@ func (s *securityService) Process(r *http.Request) (context.Context, error) {
 // authorize checks whether the permissions stored in the request context satisfy
 // the requirements for the requested path using hierarchical scope matching.
 func (s *securityService) authorize(r *http.Request) error {
-	required := s.getRequiredPermissionForAPI(r.Method, r.URL.Path)
+	required := s.getRequiredPermissionForAPI(r.Method, apiversion.StripVersionPrefix(r.URL.Path))
 	// Empty required means any authenticated user may access the path.
 	if required == "" {
 		return nil
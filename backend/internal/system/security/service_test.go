@@ -410,6 +410,7 @@ func (suite *SecurityServiceTestSuite) TestProcess_PublicPathVariations() {
 		{"Nested signin path", "/gate/forgot-password/confirm"},
 		{"Deep console path", "/console/api/v1/test"},
 		{"Health check with query", "/health/liveness?detailed=true"},
+		{"Versioned public path", "/v2/oauth2/token"},
 	}
 
 	for _, tc := range testCases {
@@ -0,0 +1,152 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package sysauthz
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+)
+
+// circuitState is the state of the authorization data store circuit breaker.
+type circuitState string
+
+const (
+	// circuitClosed lets every call through to the store.
+	circuitClosed circuitState = "closed"
+	// circuitOpen rejects every call without reaching the store.
+	circuitOpen circuitState = "open"
+	// circuitHalfOpen lets a single trial call through to probe whether the store has recovered.
+	circuitHalfOpen circuitState = "half_open"
+)
+
+// circuitBreaker stops calling the authorization data store after repeated failures so that an
+// outage fails fast instead of adding the store latency to every authorization decision.
+// The breaker state is held in memory and is therefore scoped to a single server instance.
+type circuitBreaker struct {
+	mu           sync.Mutex
+	threshold    int
+	openDuration time.Duration
+	now          func() time.Time
+	state        circuitState
+	failures     int
+	openedAt     time.Time
+}
+
+// newCircuitBreaker creates a circuit breaker that opens after threshold consecutive failures and
+// stays open for openDuration. Returns nil when threshold is not positive, which disables the breaker.
+func newCircuitBreaker(threshold int, openDuration time.Duration) *circuitBreaker {
+	if threshold <= 0 {
+		return nil
+	}
+	return &circuitBreaker{
+		threshold:    threshold,
+		openDuration: openDuration,
+		now:          time.Now,
+		state:        circuitClosed,
+	}
+}
+
+// allow reports whether a call to the store may proceed. An open circuit moves to half-open once
+// the open duration has elapsed and lets a single trial call through.
+func (b *circuitBreaker) allow(ctx context.Context) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	switch b.state {
+	case circuitOpen:
+		if b.now().Sub(b.openedAt) < b.openDuration {
+			return false
+		}
+		b.transition(ctx, circuitHalfOpen)
+		return true
+	case circuitHalfOpen:
+		// A trial call is already in flight.
+		return false
+	default:
+		return true
+	}
+}
+
+// record updates the breaker with the outcome of a store call. Only server errors count as store
+// failures; client errors such as a missing organization unit prove the store is reachable.
+func (b *circuitBreaker) record(ctx context.Context, svcErr *serviceerror.ServiceError) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if !isStoreFailure(svcErr) {
+		b.failures = 0
+		if b.state != circuitClosed {
+			b.transition(ctx, circuitClosed)
+		}
+		return
+	}
+
+	b.failures++
+	if b.state == circuitHalfOpen || b.failures >= b.threshold {
+		b.openedAt = b.now()
+		if b.state != circuitOpen {
+			b.transition(ctx, circuitOpen)
+		}
+	}
+}
+
+// transition moves the breaker to the given state. The caller must hold the lock.
+func (b *circuitBreaker) transition(ctx context.Context, state circuitState) {
+	b.state = state
+	recordCircuitTransition(ctx, state)
+}
+
+// isStoreFailure reports whether the service error signals that the authorization data store is unavailable.
+func isStoreFailure(svcErr *serviceerror.ServiceError) bool {
+	return svcErr != nil && svcErr.Type == serviceerror.ServerErrorType
+}
+
+// circuitBreakingResolver guards an OUHierarchyResolver with a circuit breaker. While the circuit is
+// open, calls fail immediately with a server error without reaching the underlying store.
+type circuitBreakingResolver struct {
+	resolver OUHierarchyResolver
+	breaker  *circuitBreaker
+}
+
+// IsAncestor delegates to the underlying resolver when the circuit allows it.
+func (r *circuitBreakingResolver) IsAncestor(
+	ctx context.Context, ancestorOUID, descendantOUID string,
+) (bool, *serviceerror.ServiceError) {
+	if !r.breaker.allow(ctx) {
+		return false, &serviceerror.InternalServerError
+	}
+	isAncestor, svcErr := r.resolver.IsAncestor(ctx, ancestorOUID, descendantOUID)
+	r.breaker.record(ctx, svcErr)
+	return isAncestor, svcErr
+}
+
+// GetAncestorOUIDs delegates to the underlying resolver when the circuit allows it.
+func (r *circuitBreakingResolver) GetAncestorOUIDs(
+	ctx context.Context, ouID string,
+) ([]string, *serviceerror.ServiceError) {
+	if !r.breaker.allow(ctx) {
+		return nil, &serviceerror.InternalServerError
+	}
+	ancestorIDs, svcErr := r.resolver.GetAncestorOUIDs(ctx, ouID)
+	r.breaker.record(ctx, svcErr)
+	return ancestorIDs, svcErr
+}
@@ -0,0 +1,114 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package sysauthz
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+)
+
+func newTestCircuitBreaker(now *time.Time) *circuitBreaker {
+	breaker := newCircuitBreaker(2, 30*time.Second)
+	breaker.now = func() time.Time { return *now }
+	return breaker
+}
+
+func TestNewCircuitBreaker_DisabledWithoutThreshold(t *testing.T) {
+	assert.Nil(t, newCircuitBreaker(0, time.Minute))
+}
+
+func TestCircuitBreaker_OpensAfterConsecutiveFailures(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	breaker := newTestCircuitBreaker(&now)
+
+	breaker.record(ctx, &serviceerror.InternalServerError)
+	assert.True(t, breaker.allow(ctx))
+	breaker.record(ctx, &serviceerror.InternalServerError)
+
+	assert.Equal(t, circuitOpen, breaker.state)
+	assert.False(t, breaker.allow(ctx))
+}
+
+func TestCircuitBreaker_ClientErrorsDoNotCountAsFailures(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	breaker := newTestCircuitBreaker(&now)
+	clientErr := &serviceerror.ServiceError{Type: serviceerror.ClientErrorType, Code: "OU-1003"}
+
+	breaker.record(ctx, &serviceerror.InternalServerError)
+	breaker.record(ctx, clientErr)
+	breaker.record(ctx, &serviceerror.InternalServerError)
+
+	assert.Equal(t, circuitClosed, breaker.state)
+}
+
+func TestCircuitBreaker_HalfOpenTrial(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	breaker := newTestCircuitBreaker(&now)
+	breaker.record(ctx, &serviceerror.InternalServerError)
+	breaker.record(ctx, &serviceerror.InternalServerError)
+
+	now = now.Add(31 * time.Second)
+	assert.True(t, breaker.allow(ctx))
+	assert.Equal(t, circuitHalfOpen, breaker.state)
+	assert.False(t, breaker.allow(ctx), "only a single trial call is let through")
+
+	// A failed trial reopens the circuit for another open duration.
+	breaker.record(ctx, &serviceerror.InternalServerError)
+	assert.Equal(t, circuitOpen, breaker.state)
+	assert.False(t, breaker.allow(ctx))
+
+	// A successful trial closes the circuit.
+	now = now.Add(31 * time.Second)
+	assert.True(t, breaker.allow(ctx))
+	breaker.record(ctx, nil)
+	assert.Equal(t, circuitClosed, breaker.state)
+	assert.True(t, breaker.allow(ctx))
+}
+
+func TestCircuitBreakingResolver_SkipsStoreWhileOpen(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	stub := &stubOUHierarchyResolver{
+		isAncestorErr:  &serviceerror.InternalServerError,
+		ancestorIDsErr: &serviceerror.InternalServerError,
+	}
+	resolver := &circuitBreakingResolver{resolver: stub, breaker: newTestCircuitBreaker(&now)}
+
+	_, svcErr := resolver.IsAncestor(ctx, "parent-ou", "child-ou")
+	assert.NotNil(t, svcErr)
+	_, svcErr = resolver.GetAncestorOUIDs(ctx, "child-ou")
+	assert.NotNil(t, svcErr)
+	assert.Equal(t, 2, stub.calls)
+
+	isAncestor, svcErr := resolver.IsAncestor(ctx, "parent-ou", "child-ou")
+	assert.False(t, isAncestor)
+	assert.Equal(t, serviceerror.InternalServerError.Code, svcErr.Code)
+	ancestorIDs, svcErr := resolver.GetAncestorOUIDs(ctx, "child-ou")
+	assert.Nil(t, ancestorIDs)
+	assert.Equal(t, serviceerror.InternalServerError.Code, svcErr.Code)
+	assert.Equal(t, 2, stub.calls, "the store must not be called while the circuit is open")
+}
@@ -0,0 +1,95 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package sysauthz
+
+import (
+	"context"
+	"strings"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+	sysContext "github.com/thunder-id/thunderid/internal/system/context"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/internal/system/observability/event"
+	"github.com/thunder-id/thunderid/internal/system/security"
+)
+
+// actionCategory groups actions that share a failure mode.
+type actionCategory string
+
+const (
+	// actionCategoryRead covers actions that only read or list resources.
+	actionCategoryRead actionCategory = "read"
+	// actionCategoryWrite covers every other action. Unknown actions are treated as writes.
+	actionCategoryWrite actionCategory = "write"
+)
+
+// getActionCategory derives the category of an action from the verb after the resource prefix
+// (e.g. "ou:list-children" is a read).
+func getActionCategory(action security.Action) actionCategory {
+	_, verb, _ := strings.Cut(string(action), ":")
+	switch verb {
+	case "read", "list", "list-children":
+		return actionCategoryRead
+	default:
+		return actionCategoryWrite
+	}
+}
+
+// failsOpen applies the configured failure mode when the policies of an action could not be
+// evaluated. It returns true only when the authorization data store is unavailable and the action
+// belongs to a category configured to fail open. Writes always fail closed.
+func (s *systemAuthorizationService) failsOpen(ctx context.Context, action security.Action,
+	svcErr *serviceerror.ServiceError) bool {
+	if !isStoreFailure(svcErr) {
+		return false
+	}
+
+	category := getActionCategory(action)
+	if category == actionCategoryRead && s.readFailureMode == config.AuthzFailureModeFailOpen {
+		recordOutageDecision(ctx, category, config.AuthzFailureModeFailOpen)
+		s.auditFailOpen(ctx, action)
+		return true
+	}
+
+	recordOutageDecision(ctx, category, config.AuthzFailureModeFailClosed)
+	s.logger.WithContext(ctx).Error("Authorization denied: authorization data store is unavailable",
+		log.String("action", string(action)),
+		log.String("category", string(category)))
+	return false
+}
+
+// auditFailOpen records an action allowed in fail-open mode in the logs and the audit trail.
+func (s *systemAuthorizationService) auditFailOpen(ctx context.Context, action security.Action) {
+	subject := security.GetSubject(ctx)
+	s.logger.WithContext(ctx).Warn("Authorization granted in fail-open mode: authorization data store is unavailable",
+		log.String("action", string(action)),
+		log.MaskedString("subject", subject))
+
+	if s.observabilitySvc != nil && s.observabilitySvc.IsEnabled() {
+		evt := event.NewEvent(sysContext.GetTraceID(ctx), string(event.EventTypeAuthorizationFailOpen),
+			event.ComponentSystemAuthorization).
+			WithTenantID(sysContext.GetTenantID(ctx)).
+			WithStatus(event.StatusSuccess).
+			WithData(event.DataKey.Action, string(action)).
+			WithData(event.DataKey.ActorID, subject).
+			WithData(event.DataKey.Reason, "authorization data store unavailable")
+		s.observabilitySvc.PublishEvent(evt)
+	}
+}
@@ -0,0 +1,136 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package sysauthz
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/observability"
+	"github.com/thunder-id/thunderid/internal/system/observability/event"
+	"github.com/thunder-id/thunderid/internal/system/security"
+	"github.com/thunder-id/thunderid/tests/mocks/observability/observabilitymock"
+)
+
+// newOutageTestService builds a service whose OU hierarchy resolver always fails with a server error.
+func newOutageTestService(readFailureMode string,
+	observabilitySvc observability.ObservabilityServiceInterface) SystemAuthorizationServiceInterface {
+	authzConfig := config.SystemAuthorizationConfig{
+		FailureHandling: config.AuthzFailureHandlingConfig{
+			Read:  readFailureMode,
+			Write: config.AuthzFailureModeFailClosed,
+		},
+	}
+	service := newSystemAuthorizationService(authzConfig, observabilitySvc)
+	service.SetOUHierarchyResolver(&stubOUHierarchyResolver{
+		isAncestorErr:  &serviceerror.InternalServerError,
+		ancestorIDsErr: &serviceerror.InternalServerError,
+	})
+	return service
+}
+
+func TestGetActionCategory(t *testing.T) {
+	assert.Equal(t, actionCategoryRead, getActionCategory(security.ActionReadUserType))
+	assert.Equal(t, actionCategoryRead, getActionCategory(security.ActionListUserTypes))
+	assert.Equal(t, actionCategoryRead, getActionCategory(security.ActionListChildOUs))
+	assert.Equal(t, actionCategoryWrite, getActionCategory(security.ActionCreateOU))
+	assert.Equal(t, actionCategoryWrite, getActionCategory(security.ActionDeleteUser))
+	assert.Equal(t, actionCategoryWrite, getActionCategory(security.Action("unknown")))
+}
+
+func TestStoreOutage_FailClosed(t *testing.T) {
+	service := newOutageTestService(config.AuthzFailureModeFailClosed, nil)
+	ctx := buildCtxWithOU("system:usertype:view", "child-ou")
+
+	allowed, svcErr := service.IsActionAllowed(ctx, security.ActionReadUserType,
+		&ActionContext{OUID: "parent-ou", ResourceType: security.ResourceTypeUserType})
+	assert.False(t, allowed)
+	assert.NotNil(t, svcErr)
+
+	result, svcErr := service.GetAccessibleResources(ctx, security.ActionListUserTypes,
+		security.ResourceTypeUserType)
+	assert.Nil(t, result)
+	assert.NotNil(t, svcErr)
+}
+
+func TestStoreOutage_FailOpenReadsAreAudited(t *testing.T) {
+	observabilitySvc := observabilitymock.NewObservabilityServiceInterfaceMock(t)
+	observabilitySvc.On("IsEnabled").Return(true)
+	var published []*event.Event
+	observabilitySvc.On("PublishEvent", mock.Anything).Run(func(args mock.Arguments) {
+		published = append(published, args.Get(0).(*event.Event))
+	}).Return()
+	service := newOutageTestService(config.AuthzFailureModeFailOpen, observabilitySvc)
+	ctx := buildCtxWithOU("system:usertype:view", "child-ou")
+
+	allowed, svcErr := service.IsActionAllowed(ctx, security.ActionReadUserType,
+		&ActionContext{OUID: "parent-ou", ResourceType: security.ResourceTypeUserType})
+	assert.True(t, allowed)
+	assert.Nil(t, svcErr)
+
+	result, svcErr := service.GetAccessibleResources(ctx, security.ActionListUserTypes,
+		security.ResourceTypeUserType)
+	assert.Nil(t, svcErr)
+	assert.True(t, result.AllAllowed)
+
+	assert.Len(t, published, 2)
+	assert.Equal(t, string(event.EventTypeAuthorizationFailOpen), published[0].Type)
+	assert.Equal(t, event.ComponentSystemAuthorization, published[0].Component)
+	assert.Equal(t, string(security.ActionReadUserType), published[0].Data[event.DataKey.Action])
+	assert.Equal(t, "user123", published[0].Data[event.DataKey.ActorID])
+	assert.Equal(t, string(security.ActionListUserTypes), published[1].Data[event.DataKey.Action])
+}
+
+func TestStoreOutage_FailOpenDoesNotApplyToWrites(t *testing.T) {
+	service := newOutageTestService(config.AuthzFailureModeFailOpen, nil)
+	svc := service.(*systemAuthorizationService)
+	// Route a write action through a failing policy to simulate a store outage during a write.
+	svc.policies.membershipPolicy = &stubPolicy{actionErr: &serviceerror.InternalServerError}
+	ctx := buildCtxWithOU("system:usertype", "ou1")
+
+	allowed, svcErr := service.IsActionAllowed(ctx, security.ActionUpdateUserType,
+		&ActionContext{OUID: "ou1", ResourceType: security.ResourceTypeUserType})
+	assert.False(t, allowed)
+	assert.NotNil(t, svcErr)
+}
+
+func TestStoreOutage_ClientErrorsAreNotTreatedAsOutages(t *testing.T) {
+	service := newOutageTestService(config.AuthzFailureModeFailOpen, nil)
+	service.SetOUHierarchyResolver(&stubOUHierarchyResolver{
+		ancestorIDsErr: &serviceerror.ServiceError{Type: serviceerror.ClientErrorType, Code: "OU-1003"},
+	})
+	ctx := buildCtxWithOU("system:usertype:view", "child-ou")
+
+	result, svcErr := service.GetAccessibleResources(ctx, security.ActionListUserTypes,
+		security.ResourceTypeUserType)
+	assert.Nil(t, result)
+	assert.NotNil(t, svcErr)
+}
+
+func TestInitialize_RejectsFailOpenWrites(t *testing.T) {
+	service, err := Initialize(config.SystemAuthorizationConfig{
+		FailureHandling: config.AuthzFailureHandlingConfig{Write: config.AuthzFailureModeFailOpen},
+	}, nil)
+	assert.Nil(t, service)
+	assert.Error(t, err)
+}
@@ -18,8 +18,18 @@
 
 package sysauthz
 
+import (
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/observability"
+)
+
 // Initialize creates and returns a SystemAuthorizationServiceInterface instance.
 // This package exposes no HTTP routes and requires no store — it is a pure service.
-func Initialize() (SystemAuthorizationServiceInterface, error) {
-	return newSystemAuthorizationService(), nil
+// The configuration decides how authorization behaves when the authorization data store is unavailable.
+func Initialize(authzConfig config.SystemAuthorizationConfig,
+	observabilitySvc observability.ObservabilityServiceInterface) (SystemAuthorizationServiceInterface, error) {
+	if err := authzConfig.Validate(); err != nil {
+		return nil, err
+	}
+	return newSystemAuthorizationService(authzConfig, observabilitySvc), nil
 }
@@ -0,0 +1,73 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package sysauthz
+
+import (
+	"context"
+	"sync"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+type authzMetrics struct {
+	once               sync.Once
+	outageDecisions    metric.Int64Counter
+	circuitTransitions metric.Int64Counter
+}
+
+var sysAuthzMetrics authzMetrics
+
+func initAuthzMetrics() {
+	sysAuthzMetrics.once.Do(func() {
+		meter := otel.Meter("github.com/thunder-id/thunderid/sysauthz")
+		sysAuthzMetrics.outageDecisions, _ = meter.Int64Counter(
+			"thunderid_authz_outage_decisions_total",
+			metric.WithDescription("Total authorization decisions taken while the authorization data store was down"),
+		)
+		sysAuthzMetrics.circuitTransitions, _ = meter.Int64Counter(
+			"thunderid_authz_circuit_breaker_transitions_total",
+			metric.WithDescription("Total state transitions of the authorization data store circuit breaker"),
+		)
+	})
+}
+
+// recordOutageDecision records an authorization decision taken by the failure mode of an action category.
+func recordOutageDecision(ctx context.Context, category actionCategory, failureMode string) {
+	initAuthzMetrics()
+	if sysAuthzMetrics.outageDecisions == nil {
+		return
+	}
+	sysAuthzMetrics.outageDecisions.Add(ctx, 1, metric.WithAttributes(
+		attribute.String("category", string(category)),
+		attribute.String("decision", failureMode),
+	))
+}
+
+// recordCircuitTransition records a transition of the circuit breaker to the given state.
+func recordCircuitTransition(ctx context.Context, state circuitState) {
+	initAuthzMetrics()
+	if sysAuthzMetrics.circuitTransitions == nil {
+		return
+	}
+	sysAuthzMetrics.circuitTransitions.Add(ctx, 1, metric.WithAttributes(
+		attribute.String("state", string(state)),
+	))
+}
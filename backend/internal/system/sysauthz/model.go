@@ -43,6 +43,20 @@ type OUHierarchyResolver interface {
 	GetAncestorOUIDs(ctx context.Context, ouID string) ([]string, *serviceerror.ServiceError)
 }
 
+// RelationshipProvider checks and cleans up the relationship tuples that grant callers access to
+// individual resources. Like OUHierarchyResolver it is defined here to avoid an import cycle: the
+// relationship package implements it and injects a concrete instance via
+// SystemAuthorizationServiceInterface.SetRelationshipProvider at application startup.
+type RelationshipProvider interface {
+	// CheckRelationship reports whether the subject holds the relation on the object, either directly,
+	// through a userset or through an implied relation.
+	CheckRelationship(ctx context.Context, objectType, objectID, relation, subjectType,
+		subjectID string) (bool, *serviceerror.ServiceError)
+
+	// DeleteRelationships removes every relationship tuple in which the entity is the object or the subject.
+	DeleteRelationships(ctx context.Context, entityType, entityID string) *serviceerror.ServiceError
+}
+
 // ActionContext provides contextual information used to make an authorization decision.
 // Not all fields are required for every action; populate only those relevant to the operation.
 type ActionContext struct {
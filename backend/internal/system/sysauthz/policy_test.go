@@ -61,17 +61,22 @@ type stubOUHierarchyResolver struct {
 	// GetAncestorOUIDs response fields.
 	ancestorIDs    []string
 	ancestorIDsErr *serviceerror.ServiceError
+
+	// calls counts the invocations of either method.
+	calls int
 }
 
 func (r *stubOUHierarchyResolver) IsAncestor(
 	_ context.Context, _, _ string,
 ) (bool, *serviceerror.ServiceError) {
+	r.calls++
 	return r.isAncestorResult, r.isAncestorErr
 }
 
 func (r *stubOUHierarchyResolver) GetAncestorOUIDs(
 	_ context.Context, _ string,
 ) ([]string, *serviceerror.ServiceError) {
+	r.calls++
 	return r.ancestorIDs, r.ancestorIDsErr
 }
 
@@ -22,12 +22,19 @@ package sysauthz
 
 import (
 	"context"
+	"time"
 
+	"github.com/thunder-id/thunderid/internal/system/config"
 	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
 	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/internal/system/observability"
 	"github.com/thunder-id/thunderid/internal/system/security"
 )
 
+// relationshipSubjectTypeApp is the relationship subject type of applications, which machine clients are
+// checked as.
+const relationshipSubjectTypeApp = "app"
+
 // SystemAuthorizationServiceInterface defines the contract for system-level authorization.
 type SystemAuthorizationServiceInterface interface {
 	// IsActionAllowed checks whether the authenticated caller is permitted to perform
@@ -50,12 +57,27 @@ type SystemAuthorizationServiceInterface interface {
 	// been initialized, completing the two-phase initialization that avoids an import cycle
 	// between sysauthz (which ou already imports) and the ou package itself.
 	SetOUHierarchyResolver(resolver OUHierarchyResolver)
+
+	// SetRelationshipProvider injects the relationship provider that resolves the relationship
+	// delegations of resource types and cleans up tuples of deleted resources. It is called once at
+	// application startup when the relationships feature is enabled.
+	SetRelationshipProvider(provider RelationshipProvider)
+
+	// DeleteResourceRelationships removes the relationship tuples of a deleted resource. It is a no-op
+	// when no relationship provider is set. Failures are logged and never fail the deletion.
+	DeleteResourceRelationships(ctx context.Context, resourceType security.ResourceType, resourceID string)
 }
 
 // systemAuthorizationService is the default implementation of SystemAuthorizationServiceInterface.
 type systemAuthorizationService struct {
-	logger   *log.Logger
-	policies *policies
+	logger           *log.Logger
+	policies         *policies
+	readFailureMode  string
+	breaker          *circuitBreaker
+	observabilitySvc observability.ObservabilityServiceInterface
+	// delegations maps a resource type to the relation that grants each delegated action on it.
+	delegations          map[security.ResourceType]map[security.Action]string
+	relationshipProvider RelationshipProvider
 }
 
 type policies struct {
@@ -69,25 +91,70 @@ type policies struct {
 }
 
 // newSystemAuthorizationService returns a new systemAuthorizationService.
-func newSystemAuthorizationService() SystemAuthorizationServiceInterface {
+func newSystemAuthorizationService(authzConfig config.SystemAuthorizationConfig,
+	observabilitySvc observability.ObservabilityServiceInterface) SystemAuthorizationServiceInterface {
 	return &systemAuthorizationService{
 		logger: log.GetLogger().With(log.String("component", "SystemAuthorizationService")),
 		policies: &policies{
 			membershipPolicy: &ouMembershipPolicy{},
 		},
+		readFailureMode: authzConfig.FailureHandling.Read,
+		breaker: newCircuitBreaker(authzConfig.CircuitBreaker.FailureThreshold,
+			time.Duration(authzConfig.CircuitBreaker.OpenDuration)*time.Second),
+		observabilitySvc: observabilitySvc,
+		delegations:      buildDelegations(authzConfig.RelationshipDelegations),
+	}
+}
+
+// buildDelegations indexes the configured relationship delegations by resource type and action.
+func buildDelegations(
+	delegations []config.AuthzRelationshipDelegation) map[security.ResourceType]map[security.Action]string {
+	result := make(map[security.ResourceType]map[security.Action]string, len(delegations))
+	for _, delegation := range delegations {
+		relations := make(map[security.Action]string, len(delegation.Relations))
+		for action, relation := range delegation.Relations {
+			relations[security.Action(action)] = relation
+		}
+		result[security.ResourceType(delegation.ResourceType)] = relations
 	}
+	return result
 }
 
 // SetOUHierarchyResolver injects the OU hierarchy resolver into the service.
 // It is called once at application startup after the ou package is initialized.
 // The ouInheritancePolicy is built once here and reused for every subsequent authz call.
+// When the circuit breaker is enabled, the resolver is guarded by it.
 func (s *systemAuthorizationService) SetOUHierarchyResolver(resolver OUHierarchyResolver) {
 	if resolver == nil {
 		return
 	}
+	if s.breaker != nil {
+		resolver = &circuitBreakingResolver{resolver: resolver, breaker: s.breaker}
+	}
 	s.policies.inheritancePolicy = &ouInheritancePolicy{resolver: resolver}
 }
 
+// SetRelationshipProvider injects the relationship provider into the service.
+// It is called once at application startup after the relationship package is initialized.
+func (s *systemAuthorizationService) SetRelationshipProvider(provider RelationshipProvider) {
+	s.relationshipProvider = provider
+}
+
+// DeleteResourceRelationships removes the relationship tuples in which the deleted resource is the
+// object or the subject, so that a resource recreated with the same ID does not inherit stale grants.
+func (s *systemAuthorizationService) DeleteResourceRelationships(ctx context.Context,
+	resourceType security.ResourceType, resourceID string) {
+	if s.relationshipProvider == nil || resourceID == "" {
+		return
+	}
+	if svcErr := s.relationshipProvider.DeleteRelationships(ctx, string(resourceType), resourceID); svcErr != nil {
+		s.logger.WithContext(ctx).Warn("Failed to delete the relationships of a deleted resource",
+			log.String("resourceType", string(resourceType)),
+			log.String("resourceID", resourceID),
+			log.String("error", svcErr.Error.DefaultValue))
+	}
+}
+
 // IsActionAllowed evaluates whether the authenticated caller may perform the given action.
 func (s *systemAuthorizationService) IsActionAllowed(ctx context.Context, action security.Action,
 	actionCtx *ActionContext) (bool, *serviceerror.ServiceError) {
@@ -132,7 +199,17 @@ func (s *systemAuthorizationService) IsActionAllowed(ctx context.Context, action
 		return true, nil
 	}
 
-	// Step 6: Resolve required permission for the action and evaluate using hierarchical matching.
+	// Step 6: Allow callers granted the action on the resource through a relationship tuple.
+	if s.isAllowedByRelationship(ctx, action, actionCtx, subject) {
+		if logger.IsDebugEnabled() {
+			logger.Debug("Authorization granted: relationship",
+				log.String("action", string(action)),
+				log.MaskedString("subject", subject))
+		}
+		return true, nil
+	}
+
+	// Step 7: Resolve required permission for the action and evaluate using hierarchical matching.
 	requiredPermission := security.ResolveActionPermission(action)
 	if !security.HasSufficientPermission(permissions, requiredPermission) {
 		if logger.IsDebugEnabled() {
@@ -143,9 +220,13 @@ func (s *systemAuthorizationService) IsActionAllowed(ctx context.Context, action
 		return false, nil
 	}
 
-	// Step 7: Evaluate global policies (e.g., OU scope check).
+	// Step 8: Evaluate global policies (e.g., OU scope check). When the authorization data store is
+	// unavailable, the configured failure mode of the action category decides the outcome.
 	allowed, svcErr := isActionAllowedByPolicies(ctx, s.policies, action, actionCtx)
 	if svcErr != nil {
+		if s.failsOpen(ctx, action, svcErr) {
+			return true, nil
+		}
 		return false, svcErr
 	}
 	if !allowed {
@@ -173,11 +254,15 @@ func (s *systemAuthorizationService) IsActionAllowed(ctx context.Context, action
 // Returns true only when:
 //   - The ActionContext carries a non-empty ResourceID.
 //   - The resource type supports owner-based access (currently only ResourceTypeUser).
+//   - The caller is a user, as the subject of a machine client is its client ID, not a user ID.
 //   - The caller's subject matches the ResourceID.
 func isResourceOwner(ctx context.Context, actionCtx *ActionContext) bool {
 	if actionCtx == nil || actionCtx.ResourceID == "" {
 		return false
 	}
+	if security.GetPrincipalType(ctx) != security.PrincipalTypeUser {
+		return false
+	}
 
 	// Currently only user resources support owner-based access.
 	if actionCtx.ResourceType != security.ResourceTypeUser {
@@ -187,6 +272,37 @@ func isResourceOwner(ctx context.Context, actionCtx *ActionContext) bool {
 	return security.GetSubject(ctx) == actionCtx.ResourceID
 }
 
+// isAllowedByRelationship checks whether the caller holds the relation that the relationship delegation
+// of the resource type maps the action to. Resource types and actions without a delegation, and
+// collection-level actions without a resource ID, are never granted by a relationship. A failed check
+// grants nothing, so the decision falls back to the caller's permissions. A machine client is checked as
+// the application of its service identity.
+func (s *systemAuthorizationService) isAllowedByRelationship(ctx context.Context, action security.Action,
+	actionCtx *ActionContext, subject string) bool {
+	if s.relationshipProvider == nil || actionCtx == nil || actionCtx.ResourceID == "" {
+		return false
+	}
+	relation, ok := s.delegations[actionCtx.ResourceType][action]
+	if !ok {
+		return false
+	}
+
+	subjectType := string(security.ResourceTypeUser)
+	if identity := security.GetServiceIdentity(ctx); identity != nil {
+		subjectType, subject = relationshipSubjectTypeApp, identity.ID
+	}
+	allowed, svcErr := s.relationshipProvider.CheckRelationship(ctx, string(actionCtx.ResourceType),
+		actionCtx.ResourceID, relation, subjectType, subject)
+	if svcErr != nil {
+		s.logger.WithContext(ctx).Warn("Failed to check the relationship delegated for the action",
+			log.String("action", string(action)),
+			log.String("relation", relation),
+			log.String("error", svcErr.Error.DefaultValue))
+		return false
+	}
+	return allowed
+}
+
 // GetAccessibleResources returns the set of resources the caller can access for the given
 // action and resource type.
 func (s *systemAuthorizationService) GetAccessibleResources(ctx context.Context, action security.Action,
@@ -237,9 +353,13 @@ func (s *systemAuthorizationService) GetAccessibleResources(ctx context.Context,
 		return &AccessibleResources{AllAllowed: false, IDs: []string{}}, nil
 	}
 
-	// Step 6: Delegate to the policy chain to determine the accessible resource set.
+	// Step 6: Delegate to the policy chain to determine the accessible resource set. When the
+	// authorization data store is unavailable, a read action configured to fail open is not filtered.
 	result, svcErr := getAccessibleResourcesByPolicies(ctx, s.policies, action, resourceType)
 	if svcErr != nil {
+		if s.failsOpen(ctx, action, svcErr) {
+			return &AccessibleResources{AllAllowed: true}, nil
+		}
 		return nil, svcErr
 	}
 	if logger.IsDebugEnabled() && !result.AllAllowed {
@@ -27,6 +27,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/suite"
 
+	"github.com/thunder-id/thunderid/internal/system/config"
 	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
 	i18ncore "github.com/thunder-id/thunderid/internal/system/i18n/core"
 	"github.com/thunder-id/thunderid/internal/system/security"
@@ -48,7 +49,7 @@ type SystemAuthzTestSuite struct {
 
 func (s *SystemAuthzTestSuite) SetupTest() {
 	var err error
-	s.service, err = Initialize()
+	s.service, err = Initialize(config.SystemAuthorizationConfig{}, nil)
 	s.Require().NoError(err)
 }
 
@@ -75,6 +76,18 @@ func buildCtxWithOU(permissions, ouID string) context.Context {
 	return security.WithSecurityContextTest(context.Background(), authCtx)
 }
 
+// buildServiceCtx creates the security context of a machine client acting as the app-1 service identity.
+func buildServiceCtx(permissions string) context.Context {
+	var perms []string
+	if permissions != "" {
+		perms = strings.Fields(permissions)
+	}
+	authCtx := security.NewSecurityContextForTest("user123", "", "token", perms, map[string]interface{}{
+		"sub_type": "service", "service_id": "app-1", "client_id": "user123",
+	})
+	return security.WithSecurityContextTest(context.Background(), authCtx)
+}
+
 // buildSkipSecurityCtx returns a context with security enforcement skipped.
 func buildSkipSecurityCtx() context.Context {
 	return security.WithSkipSecurityTest(context.Background())
@@ -518,3 +531,165 @@ func (s *SystemAuthzTestSuite) TestSetOUHierarchyResolver_NilResolver_FallsBackT
 	assert.False(s.T(), allowed)
 	assert.Nil(s.T(), svcErr)
 }
+
+// ---------------------------------------------------------------------------
+// Relationship delegation
+// ---------------------------------------------------------------------------
+
+// stubRelationshipProvider is a configurable RelationshipProvider for testing.
+type stubRelationshipProvider struct {
+	allowed   bool
+	checkErr  *serviceerror.ServiceError
+	deleteErr *serviceerror.ServiceError
+
+	// checked records the last checked object type, object ID, relation, subject type and subject ID.
+	checked []string
+	// deleted records the last deleted entity type and entity ID.
+	deleted []string
+}
+
+func (p *stubRelationshipProvider) CheckRelationship(_ context.Context, objectType, objectID, relation,
+	subjectType, subjectID string) (bool, *serviceerror.ServiceError) {
+	p.checked = []string{objectType, objectID, relation, subjectType, subjectID}
+	return p.allowed, p.checkErr
+}
+
+func (p *stubRelationshipProvider) DeleteRelationships(
+	_ context.Context, entityType, entityID string) *serviceerror.ServiceError {
+	p.deleted = []string{entityType, entityID}
+	return p.deleteErr
+}
+
+// newDelegatingService returns a service delegating group updates to the editor relation.
+func newDelegatingService(provider RelationshipProvider) SystemAuthorizationServiceInterface {
+	svc := newSystemAuthorizationService(config.SystemAuthorizationConfig{
+		RelationshipDelegations: []config.AuthzRelationshipDelegation{
+			{ResourceType: "group", Relations: map[string]string{"group:update": "editor"}},
+		},
+	}, nil)
+	svc.SetRelationshipProvider(provider)
+	return svc
+}
+
+func (s *SystemAuthzTestSuite) TestIsActionAllowed_RelationshipDelegation_GrantsAction() {
+	provider := &stubRelationshipProvider{allowed: true}
+	svc := newDelegatingService(provider)
+
+	allowed, svcErr := svc.IsActionAllowed(buildCtx(""), security.ActionUpdateGroup,
+		&ActionContext{ResourceType: security.ResourceTypeGroup, ResourceID: "group-1"})
+	assert.True(s.T(), allowed)
+	assert.Nil(s.T(), svcErr)
+	assert.Equal(s.T(), []string{"group", "group-1", "editor", "user", "user123"}, provider.checked)
+}
+
+func (s *SystemAuthzTestSuite) TestIsActionAllowed_RelationshipDelegation_ServicePrincipal() {
+	provider := &stubRelationshipProvider{allowed: true}
+	svc := newDelegatingService(provider)
+
+	allowed, svcErr := svc.IsActionAllowed(buildServiceCtx(""), security.ActionUpdateGroup,
+		&ActionContext{ResourceType: security.ResourceTypeGroup, ResourceID: "group-1"})
+	assert.True(s.T(), allowed)
+	assert.Nil(s.T(), svcErr)
+	assert.Equal(s.T(), []string{"group", "group-1", "editor", "app", "app-1"}, provider.checked)
+}
+
+func (s *SystemAuthzTestSuite) TestIsActionAllowed_ServicePrincipal() {
+	svc := newSystemAuthorizationService(config.SystemAuthorizationConfig{}, nil)
+
+	// A client ID that matches a user ID does not make the client the owner of the user.
+	allowed, svcErr := svc.IsActionAllowed(buildServiceCtx(""), security.ActionReadUser,
+		&ActionContext{ResourceType: security.ResourceTypeUser, ResourceID: "user123"})
+	assert.False(s.T(), allowed)
+	assert.Nil(s.T(), svcErr)
+
+	// Machine clients are authorized by the permissions granted to their service identity.
+	allowed, svcErr = svc.IsActionAllowed(buildServiceCtx("system"), security.ActionReadUser,
+		&ActionContext{ResourceType: security.ResourceTypeUser, ResourceID: "user123"})
+	assert.True(s.T(), allowed)
+	assert.Nil(s.T(), svcErr)
+}
+
+func (s *SystemAuthzTestSuite) TestIsActionAllowed_RelationshipDelegation_FallsBackToPermissions() {
+	cases := []struct {
+		name      string
+		provider  *stubRelationshipProvider
+		action    security.Action
+		actionCtx *ActionContext
+		checked   bool
+	}{
+		{
+			name:      "relation not held",
+			provider:  &stubRelationshipProvider{},
+			action:    security.ActionUpdateGroup,
+			actionCtx: &ActionContext{ResourceType: security.ResourceTypeGroup, ResourceID: "group-1"},
+			checked:   true,
+		},
+		{
+			name:      "check failure",
+			provider:  &stubRelationshipProvider{allowed: true, checkErr: &serviceerror.InternalServerError},
+			action:    security.ActionUpdateGroup,
+			actionCtx: &ActionContext{ResourceType: security.ResourceTypeGroup, ResourceID: "group-1"},
+			checked:   true,
+		},
+		{
+			name:      "action not delegated",
+			provider:  &stubRelationshipProvider{allowed: true},
+			action:    security.ActionDeleteGroup,
+			actionCtx: &ActionContext{ResourceType: security.ResourceTypeGroup, ResourceID: "group-1"},
+		},
+		{
+			name:      "resource type not delegated",
+			provider:  &stubRelationshipProvider{allowed: true},
+			action:    security.ActionUpdateGroup,
+			actionCtx: &ActionContext{ResourceType: security.ResourceTypeUser, ResourceID: "group-1"},
+		},
+		{
+			name:      "collection-level action",
+			provider:  &stubRelationshipProvider{allowed: true},
+			action:    security.ActionUpdateGroup,
+			actionCtx: &ActionContext{ResourceType: security.ResourceTypeGroup},
+		},
+	}
+
+	for _, tc := range cases {
+		s.Run(tc.name, func() {
+			svc := newDelegatingService(tc.provider)
+
+			allowed, svcErr := svc.IsActionAllowed(buildCtx(""), tc.action, tc.actionCtx)
+			assert.False(s.T(), allowed)
+			assert.Nil(s.T(), svcErr)
+			assert.Equal(s.T(), tc.checked, tc.provider.checked != nil)
+		})
+	}
+}
+
+func (s *SystemAuthzTestSuite) TestIsActionAllowed_RelationshipDelegation_WithoutProvider() {
+	svc := newDelegatingService(nil)
+
+	allowed, svcErr := svc.IsActionAllowed(buildCtx(""), security.ActionUpdateGroup,
+		&ActionContext{ResourceType: security.ResourceTypeGroup, ResourceID: "group-1"})
+	assert.False(s.T(), allowed)
+	assert.Nil(s.T(), svcErr)
+}
+
+func (s *SystemAuthzTestSuite) TestDeleteResourceRelationships() {
+	provider := &stubRelationshipProvider{}
+	svc := newDelegatingService(provider)
+
+	svc.DeleteResourceRelationships(context.Background(), security.ResourceTypeGroup, "group-1")
+	assert.Equal(s.T(), []string{"group", "group-1"}, provider.deleted)
+
+	provider.deleted = nil
+	svc.DeleteResourceRelationships(context.Background(), security.ResourceTypeGroup, "")
+	assert.Nil(s.T(), provider.deleted)
+
+	provider.deleteErr = &serviceerror.InternalServerError
+	assert.NotPanics(s.T(), func() {
+		svc.DeleteResourceRelationships(context.Background(), security.ResourceTypeUser, "user-1")
+	})
+	assert.Equal(s.T(), []string{"user", "user-1"}, provider.deleted)
+
+	assert.NotPanics(s.T(), func() {
+		s.service.DeleteResourceRelationships(context.Background(), security.ResourceTypeUser, "user-1")
+	})
+}
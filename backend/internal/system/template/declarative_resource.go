@@ -22,6 +22,7 @@ import (
 	"fmt"
 
 	declarativeresource "github.com/thunder-id/thunderid/internal/system/declarative_resource"
+	"github.com/thunder-id/thunderid/internal/system/utils"
 
 	"gopkg.in/yaml.v3"
 )
@@ -71,6 +72,9 @@ func validateTemplateDTO(dto interface{}) error {
 	if !IsValidScenario(tmpl.Scenario) {
 		return fmt.Errorf("unsupported template scenario: %s", tmpl.Scenario)
 	}
+	if tmpl.Locale != "" && !utils.IsValidLocale(tmpl.Locale) {
+		return fmt.Errorf("template locale must be a canonical BCP 47 language tag: %s", tmpl.Locale)
+	}
 	if tmpl.Type != TemplateTypeSMS && tmpl.Subject == "" {
 		return fmt.Errorf("template subject is required")
 	}
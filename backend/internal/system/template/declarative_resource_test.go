@@ -135,6 +135,23 @@ func (suite *TemplateDeclarativeResourceTestSuite) TestValidateTemplateDTO_SMSWi
 	suite.NoError(err)
 }
 
+func (suite *TemplateDeclarativeResourceTestSuite) TestValidateTemplateDTO_Locale() {
+	dto := &TemplateDTO{
+		ID:       "otp-fr",
+		Scenario: ScenarioOTP,
+		Type:     TemplateTypeSMS,
+		Locale:   "fr-CA",
+		Body:     "Votre code est : {{ctx(otp)}}.",
+	}
+	suite.NoError(validateTemplateDTO(dto))
+
+	dto.Locale = "fr_CA"
+	err := validateTemplateDTO(dto)
+	if suite.Error(err) {
+		suite.Contains(err.Error(), "template locale")
+	}
+}
+
 func (suite *TemplateDeclarativeResourceTestSuite) TestLoadDeclarativeResources_WithSMSTemplateFile() {
 	tempDir := suite.T().TempDir()
 	testConfig := &config.Config{}
@@ -55,13 +55,14 @@ func (f *templateFileBasedStore) GetTemplate(_ context.Context, id string) (*Tem
 	return tmpl, nil
 }
 
-// GetTemplateByScenario retrieves a template by its scenario type and template type.
+// GetTemplateByScenario retrieves the default template, the one without a locale, of a scenario type and
+// template type.
 func (f *templateFileBasedStore) GetTemplateByScenario(
 	_ context.Context, scenario ScenarioType, tmplType TemplateType,
 ) (*TemplateDTO, error) {
 	compositeKey := string(scenario) + ":" + string(tmplType)
 	data, err := f.GenericFileBasedStore.GetByField(compositeKey, func(d interface{}) string {
-		if tmpl, ok := d.(*TemplateDTO); ok {
+		if tmpl, ok := d.(*TemplateDTO); ok && tmpl.Locale == "" {
 			return string(tmpl.Scenario) + ":" + string(tmpl.Type)
 		}
 		return ""
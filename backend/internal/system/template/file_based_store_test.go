@@ -152,6 +152,19 @@ func (suite *FileBasedStoreTestSuite) TestFileBasedStore_SameScenarioDifferentTy
 	suite.Equal(TemplateTypeSMS, resSMS.Type)
 }
 
+func (suite *FileBasedStoreTestSuite) TestFileBasedStore_GetTemplateByScenario_SkipsLocalizedTemplates() {
+	suite.NoError(suite.store.Create("invite-fr", &TemplateDTO{
+		ID: "invite-fr", Scenario: ScenarioUserInvite, Type: TemplateTypeEmail, Locale: "fr",
+	}))
+	suite.NoError(suite.store.Create("invite", &TemplateDTO{
+		ID: "invite", Scenario: ScenarioUserInvite, Type: TemplateTypeEmail,
+	}))
+
+	res, err := suite.store.GetTemplateByScenario(context.Background(), ScenarioUserInvite, TemplateTypeEmail)
+	suite.NoError(err)
+	suite.Equal("invite", res.ID)
+}
+
 func (suite *FileBasedStoreTestSuite) TestFileBasedStore_ListTemplates_WithCorruptedData() {
 	// Create a valid template
 	dto := &TemplateDTO{
@@ -42,15 +42,41 @@ const (
 	ScenarioOTP ScenarioType = "OTP"
 	// ScenarioPasswordRecovery represents the password recovery via email link scenario.
 	ScenarioPasswordRecovery ScenarioType = "PASSWORD_RECOVERY"
+	// ScenarioEmailVerification represents the email address verification scenario of a registration.
+	ScenarioEmailVerification ScenarioType = "EMAIL_VERIFICATION"
+	// ScenarioNewSignIn represents the notification of a sign-in from a new device.
+	ScenarioNewSignIn ScenarioType = "NEW_SIGN_IN"
+	// ScenarioCredentialChanged represents the notification of a password change.
+	ScenarioCredentialChanged ScenarioType = "CREDENTIAL_CHANGED"
+	// ScenarioEmailChange represents the confirmation of an email address change from the new address.
+	ScenarioEmailChange ScenarioType = "EMAIL_CHANGE"
+	// ScenarioEmailChangeOldAddress represents the confirmation of an email address change from the old address.
+	ScenarioEmailChangeOldAddress ScenarioType = "EMAIL_CHANGE_OLD_ADDRESS"
+	// ScenarioEmailChanged represents the notification of an email address change.
+	ScenarioEmailChanged ScenarioType = "EMAIL_CHANGED"
+	// ScenarioEmailChangeRevert represents the notification of an email address change to the previous address,
+	// with a link that reverts the change.
+	ScenarioEmailChangeRevert ScenarioType = "EMAIL_CHANGE_REVERT"
+	// ScenarioAccountDeletion represents the notification of a scheduled account deletion, with a link that
+	// cancels it.
+	ScenarioAccountDeletion ScenarioType = "ACCOUNT_DELETION"
 )
 
 // supportedScenarios contains all valid scenario types.
 var supportedScenarios = map[ScenarioType]bool{
-	ScenarioUserInvite:       true,
-	ScenarioMagicLink:        true,
-	ScenarioSelfRegistration: true,
-	ScenarioOTP:              true,
-	ScenarioPasswordRecovery: true,
+	ScenarioUserInvite:            true,
+	ScenarioMagicLink:             true,
+	ScenarioSelfRegistration:      true,
+	ScenarioOTP:                   true,
+	ScenarioPasswordRecovery:      true,
+	ScenarioEmailVerification:     true,
+	ScenarioNewSignIn:             true,
+	ScenarioCredentialChanged:     true,
+	ScenarioEmailChange:           true,
+	ScenarioEmailChangeOldAddress: true,
+	ScenarioEmailChanged:          true,
+	ScenarioEmailChangeRevert:     true,
+	ScenarioAccountDeletion:       true,
 }
 
 // IsValidScenario checks if the given scenario type is supported.
@@ -64,6 +90,7 @@ type TemplateDTO struct {
 	DisplayName string       `yaml:"displayName"`
 	Scenario    ScenarioType `yaml:"scenario"`
 	Type        TemplateType `yaml:"type"`
+	Locale      string       `yaml:"locale"`
 	Subject     string       `yaml:"subject"`
 	ContentType string       `yaml:"contentType"`
 	Body        string       `yaml:"body"`
@@ -72,6 +99,10 @@ type TemplateDTO struct {
 // TemplateData holds key-value pairs for template substitution.
 type TemplateData = map[string]string
 
+// TemplateDataKeyLocale is the template data key holding the recipient's preferred locale. When set, the
+// template of the closest matching locale is rendered instead of the default template of the scenario.
+const TemplateDataKeyLocale = "locale"
+
 // RenderedTemplate holds the result after template processing.
 type RenderedTemplate struct {
 	Subject string
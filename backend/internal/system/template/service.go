@@ -22,9 +22,11 @@ import (
 	"context"
 	"errors"
 	"regexp"
+	"strings"
 
 	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
 	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/internal/system/utils"
 )
 
 var ctxPlaceholderRegex = regexp.MustCompile(`\{\{ctx\((\w+)\)}}`)
@@ -74,10 +76,16 @@ func (s *templateService) Render(
 	data TemplateData,
 ) (*RenderedTemplate, *serviceerror.ServiceError) {
 	s.logger.Debug("Rendering template", log.String("scenario", string(scenario)))
-	tmpl, svcErr := s.GetTemplateByScenario(ctx, scenario, tmplType)
+	tmpl, svcErr := s.getLocalizedTemplate(ctx, scenario, tmplType, data[TemplateDataKeyLocale])
 	if svcErr != nil {
 		return nil, svcErr
 	}
+	if tmpl == nil {
+		tmpl, svcErr = s.GetTemplateByScenario(ctx, scenario, tmplType)
+		if svcErr != nil {
+			return nil, svcErr
+		}
+	}
 
 	replacePlaceholders := func(s string) string {
 		return ctxPlaceholderRegex.ReplaceAllStringFunc(s, func(match string) string {
@@ -111,3 +119,39 @@ func (s *templateService) Render(
 
 	return rendered, nil
 }
+
+// getLocalizedTemplate returns the template of the scenario and type whose locale is the closest match of
+// the given locale, trying less specific forms of the locale in turn. It returns nil when the locale is
+// empty or no localized template matches.
+func (s *templateService) getLocalizedTemplate(
+	ctx context.Context,
+	scenario ScenarioType,
+	tmplType TemplateType,
+	locale string,
+) (*TemplateDTO, *serviceerror.ServiceError) {
+	if locale == "" {
+		return nil, nil
+	}
+
+	templates, err := s.store.ListTemplates(ctx)
+	if err != nil {
+		s.logger.Error("Failed to list templates", log.String("scenario", string(scenario)), log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+
+	localized := make(map[string]*TemplateDTO)
+	for _, tmpl := range templates {
+		if tmpl.Scenario == scenario && tmpl.Type == tmplType && tmpl.Locale != "" {
+			localized[strings.ToLower(tmpl.Locale)] = tmpl
+		}
+	}
+	for _, candidate := range utils.LocaleFallbacks(locale) {
+		if tmpl, ok := localized[strings.ToLower(candidate)]; ok {
+			return tmpl, nil
+		}
+	}
+
+	s.logger.Debug("No template found for locale, using the default template",
+		log.String("scenario", string(scenario)), log.String("locale", locale))
+	return nil, nil
+}
@@ -176,3 +176,38 @@ func (suite *TemplateServiceTestSuite) TestRender_SelfRegistrationScenario() {
 	suite.Equal("Register at https://example.com/invite", res.Body)
 	suite.False(res.IsHTML)
 }
+
+func (suite *TemplateServiceTestSuite) TestRender_LocalizedTemplate() {
+	templates := []*TemplateDTO{
+		{ID: "fr", Scenario: ScenarioUserInvite, Type: TemplateTypeEmail, Locale: "fr", Subject: "Invitation",
+			Body: "Bonjour"},
+		{ID: "fr-sms", Scenario: ScenarioUserInvite, Type: TemplateTypeSMS, Locale: "fr-CA", Body: "Salut"},
+	}
+	suite.mockStore.On("ListTemplates", mock.Anything).Return(templates, nil)
+
+	res, err := suite.service.Render(context.Background(), ScenarioUserInvite, TemplateTypeEmail,
+		TemplateData{TemplateDataKeyLocale: "fr-CA"})
+	suite.Nil(err)
+	suite.Equal("Invitation", res.Subject)
+	suite.Equal("Bonjour", res.Body)
+}
+
+func (suite *TemplateServiceTestSuite) TestRender_LocaleWithoutTemplateFallsBackToDefault() {
+	suite.mockStore.On("ListTemplates", mock.Anything).Return([]*TemplateDTO{}, nil)
+	suite.mockStore.On("GetTemplateByScenario", mock.Anything, ScenarioUserInvite, TemplateTypeEmail).
+		Return(&TemplateDTO{ID: "1", Scenario: ScenarioUserInvite, Subject: "Invite", Body: "Hello"}, nil)
+
+	res, err := suite.service.Render(context.Background(), ScenarioUserInvite, TemplateTypeEmail,
+		TemplateData{TemplateDataKeyLocale: "de-AT"})
+	suite.Nil(err)
+	suite.Equal("Hello", res.Body)
+}
+
+func (suite *TemplateServiceTestSuite) TestRender_LocalizedTemplateListError() {
+	suite.mockStore.On("ListTemplates", mock.Anything).Return(nil, errors.New("store failure"))
+
+	res, err := suite.service.Render(context.Background(), ScenarioUserInvite, TemplateTypeEmail,
+		TemplateData{TemplateDataKeyLocale: "fr"})
+	suite.Nil(res)
+	suite.Equal(&serviceerror.InternalServerError, err)
+}
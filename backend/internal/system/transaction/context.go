@@ -22,10 +22,20 @@ package transaction
 import (
 	"context"
 	"database/sql"
+	"sync"
 )
 
 type contextKey string
 
+// commitHooksContextKey is the context key of the functions to run once the outermost transaction commits.
+const commitHooksContextKey contextKey = "commit_hooks"
+
+// commitHooks holds the functions registered to run once the outermost transaction in a context commits.
+type commitHooks struct {
+	mu  sync.Mutex
+	fns []func()
+}
+
 // There is no default context key to enforce explicit database naming in transactions.
 
 func getTxContextKey(dbName string) contextKey {
@@ -49,3 +59,43 @@ func KeyedTxFromContext(ctx context.Context, dbName string) *sql.Tx {
 func HasKeyedTx(ctx context.Context, dbName string) bool {
 	return KeyedTxFromContext(ctx, dbName) != nil
 }
+
+// OnCommit registers a function to run once the outermost transaction in the context commits, so that side
+// effects outside the database, such as cache writes, never reflect changes that are rolled back. Functions
+// registered in a transaction that rolls back are discarded. The function runs immediately when the context
+// carries no transaction.
+func OnCommit(ctx context.Context, fn func()) {
+	hooks, ok := ctx.Value(commitHooksContextKey).(*commitHooks)
+	if !ok {
+		fn()
+		return
+	}
+	hooks.mu.Lock()
+	defer hooks.mu.Unlock()
+	hooks.fns = append(hooks.fns, fn)
+}
+
+// withCommitHooks returns a context that collects the functions registered with OnCommit, together with the
+// collected functions. It returns nil hooks when the context already collects them for an enclosing
+// transaction, which then runs them once it commits.
+func withCommitHooks(ctx context.Context) (context.Context, *commitHooks) {
+	if _, ok := ctx.Value(commitHooksContextKey).(*commitHooks); ok {
+		return ctx, nil
+	}
+	hooks := &commitHooks{}
+	return context.WithValue(ctx, commitHooksContextKey, hooks), hooks
+}
+
+// run runs the collected functions in the order they were registered.
+func (h *commitHooks) run() {
+	if h == nil {
+		return
+	}
+	h.mu.Lock()
+	fns := h.fns
+	h.fns = nil
+	h.mu.Unlock()
+	for _, fn := range fns {
+		fn()
+	}
+}
@@ -123,3 +123,11 @@ func (suite *ContextTestSuite) TestHasKeyedTx_WrongType() {
 	// Should return false
 	suite.False(HasKeyedTx(ctx, "test"))
 }
+
+func (suite *ContextTestSuite) TestOnCommit_WithoutTransaction() {
+	ran := false
+
+	OnCommit(context.Background(), func() { ran = true })
+
+	suite.True(ran)
+}
@@ -71,6 +71,10 @@ func (t *dbTransactioner) Transact(ctx context.Context, txFunc func(context.Cont
 		return fmt.Errorf("failed to begin transaction: %w", err)
 	}
 
+	// Functions registered with OnCommit in this transaction run once it commits, unless an enclosing
+	// transaction of another database collects them.
+	hooksCtx, hooks := withCommitHooks(ctx)
+
 	// 2. Setup recovery and commit/rollback handling
 	defer func() {
 		if p := recover(); p != nil {
@@ -114,13 +118,15 @@ func (t *dbTransactioner) Transact(ctx context.Context, txFunc func(context.Cont
 				err = errors.Join(err, rollbackErr)
 			}
 		} else {
-			// Success - commit
-			err = tx.Commit()
+			// Success - commit and run the functions waiting for the commit
+			if err = tx.Commit(); err == nil {
+				hooks.run()
+			}
 		}
 	}()
 
 	// 3. Create context with transaction
-	txCtx := WithKeyedTx(ctx, t.dbName, tx)
+	txCtx := WithKeyedTx(hooksCtx, t.dbName, tx)
 
 	// 4. Execute the user-provided function
 	err = txFunc(txCtx)
@@ -180,6 +180,66 @@ func (suite *TransactionerTestSuite) TestTransact_NestedTransactionWithError() {
 	suite.NoError(suite.mock.ExpectationsWereMet())
 }
 
+func (suite *TransactionerTestSuite) TestTransact_OnCommitRunsAfterCommit() {
+	suite.mock.ExpectBegin()
+	suite.mock.ExpectCommit()
+
+	ran := false
+	err := suite.transactioner.Transact(context.Background(), func(txCtx context.Context) error {
+		OnCommit(txCtx, func() { ran = true })
+		suite.False(ran)
+		return nil
+	})
+
+	suite.NoError(err)
+	suite.True(ran)
+	suite.NoError(suite.mock.ExpectationsWereMet())
+}
+
+func (suite *TransactionerTestSuite) TestTransact_OnCommitDiscardedOnRollback() {
+	suite.mock.ExpectBegin()
+	suite.mock.ExpectRollback()
+
+	ran := false
+	err := suite.transactioner.Transact(context.Background(), func(txCtx context.Context) error {
+		OnCommit(txCtx, func() { ran = true })
+		return errors.New("business logic error")
+	})
+
+	suite.Error(err)
+	suite.False(ran)
+	suite.NoError(suite.mock.ExpectationsWereMet())
+}
+
+func (suite *TransactionerTestSuite) TestTransact_OnCommitWaitsForOutermostTransaction() {
+	innerDB, innerMock, err := sqlmock.New()
+	suite.Require().NoError(err)
+	defer func() { _ = innerDB.Close() }()
+	innerTransactioner := NewTransactioner(innerDB, "inner")
+
+	suite.mock.ExpectBegin()
+	suite.mock.ExpectRollback()
+	innerMock.ExpectBegin()
+	innerMock.ExpectCommit()
+
+	ran := false
+	err = suite.transactioner.Transact(context.Background(), func(outerCtx context.Context) error {
+		if err := innerTransactioner.Transact(outerCtx, func(innerCtx context.Context) error {
+			OnCommit(innerCtx, func() { ran = true })
+			return nil
+		}); err != nil {
+			return err
+		}
+		suite.False(ran)
+		return errors.New("outer error")
+	})
+
+	suite.Error(err)
+	suite.False(ran)
+	suite.NoError(suite.mock.ExpectationsWereMet())
+	suite.NoError(innerMock.ExpectationsWereMet())
+}
+
 func (suite *TransactionerTestSuite) TestTransact_BeginError() {
 	ctx := context.Background()
 	expectedErr := errors.New("begin transaction failed")
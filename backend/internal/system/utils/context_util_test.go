@@ -0,0 +1,52 @@
+/*
+ * Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package utils
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type ContextUtilTestSuite struct {
+	suite.Suite
+}
+
+func TestContextUtilSuite(t *testing.T) {
+	suite.Run(t, new(ContextUtilTestSuite))
+}
+
+func (suite *ContextUtilTestSuite) TestSleepContext_WaitsForDuration() {
+	started := time.Now()
+	SleepContext(context.Background(), 10*time.Millisecond)
+
+	suite.GreaterOrEqual(time.Since(started), 10*time.Millisecond)
+}
+
+func (suite *ContextUtilTestSuite) TestSleepContext_ReturnsWhenContextIsDone() {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	started := time.Now()
+	SleepContext(ctx, time.Hour)
+
+	suite.Less(time.Since(started), time.Second)
+}
@@ -426,3 +426,29 @@ func WriteErrorResponse(w http.ResponseWriter, statusCode int, errorResp apierro
 		_, _ = w.Write(b)
 	}
 }
+
+// WriteServiceErrorResponse writes the error response of a service error. Client errors are written with the
+// status code mapped to their error code in statusCodes, or 400 Bad Request when the code is not mapped, while
+// server errors are written with 500 Internal Server Error.
+func WriteServiceErrorResponse(w http.ResponseWriter, svcErr *serviceerror.ServiceError,
+	statusCodes map[string]int) {
+	statusCode := http.StatusInternalServerError
+	if svcErr.Type == serviceerror.ClientErrorType {
+		statusCode = GetClientErrorStatusCode(svcErr.Code, statusCodes)
+	}
+
+	WriteErrorResponse(w, statusCode, apierror.ErrorResponse{
+		Code:        svcErr.Code,
+		Message:     svcErr.Error,
+		Description: svcErr.ErrorDescription,
+	})
+}
+
+// GetClientErrorStatusCode returns the HTTP status code mapped to a client error code in statusCodes, or
+// 400 Bad Request when the code is not mapped.
+func GetClientErrorStatusCode(errorCode string, statusCodes map[string]int) int {
+	if statusCode, ok := statusCodes[errorCode]; ok {
+		return statusCode
+	}
+	return http.StatusBadRequest
+}
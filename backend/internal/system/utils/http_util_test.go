@@ -811,6 +811,58 @@ func (suite *HTTPUtilTestSuite) TestWriteI18nErrorResponse() {
 	}
 }
 
+func (suite *HTTPUtilTestSuite) TestWriteServiceErrorResponse() {
+	notFoundErr := serviceerror.ServiceError{
+		Type:  serviceerror.ClientErrorType,
+		Code:  "TST-1001",
+		Error: core.I18nMessage{Key: "error.not_found", DefaultValue: "Not Found"},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.not_found_desc",
+			DefaultValue: "The requested resource was not found",
+		},
+	}
+	invalidErr := serviceerror.ServiceError{Type: serviceerror.ClientErrorType, Code: "TST-1002"}
+	statusCodes := map[string]int{
+		notFoundErr.Code:                    http.StatusNotFound,
+		serviceerror.ErrorUnauthorized.Code: http.StatusForbidden,
+	}
+
+	testCases := []struct {
+		name       string
+		svcErr     serviceerror.ServiceError
+		statusCode int
+	}{
+		{name: "MappedClientError", svcErr: notFoundErr, statusCode: http.StatusNotFound},
+		{name: "UnmappedClientError", svcErr: invalidErr, statusCode: http.StatusBadRequest},
+		{name: "Unauthorized", svcErr: serviceerror.ErrorUnauthorized, statusCode: http.StatusForbidden},
+		{name: "ServerError", svcErr: serviceerror.InternalServerError, statusCode: http.StatusInternalServerError},
+	}
+
+	for _, tc := range testCases {
+		suite.T().Run(tc.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+
+			WriteServiceErrorResponse(w, &tc.svcErr, statusCodes)
+
+			assert.Equal(t, tc.statusCode, w.Code)
+			assert.Equal(t, "application/json", w.Header().Get("Content-Type"))
+			var response apierror.ErrorResponse
+			assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+			assert.Equal(t, tc.svcErr.Code, response.Code)
+			assert.Equal(t, tc.svcErr.Error, response.Message)
+			assert.Equal(t, tc.svcErr.ErrorDescription, response.Description)
+		})
+	}
+}
+
+func (suite *HTTPUtilTestSuite) TestGetClientErrorStatusCode() {
+	statusCodes := map[string]int{"TST-1001": http.StatusConflict}
+
+	suite.Equal(http.StatusConflict, GetClientErrorStatusCode("TST-1001", statusCodes))
+	suite.Equal(http.StatusBadRequest, GetClientErrorStatusCode("TST-1002", statusCodes))
+	suite.Equal(http.StatusBadRequest, GetClientErrorStatusCode("TST-1001", nil))
+}
+
 func (suite *HTTPUtilTestSuite) TestDecodeJSONResponse() {
 	type testStruct struct {
 		Name string `json:"name"`
@@ -0,0 +1,69 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package utils
+
+import (
+	"strings"
+	"time"
+
+	// Embeds the IANA time zone database so that zone names validate on hosts without one.
+	_ "time/tzdata"
+
+	"golang.org/x/text/language"
+)
+
+// maxLocaleLength is the maximum length of a locale tag.
+const maxLocaleLength = 35
+
+// IsValidLocale reports whether locale is a BCP 47 language tag in its canonical form, such as "en" or
+// "fr-CA".
+func IsValidLocale(locale string) bool {
+	if locale == "" || len(locale) > maxLocaleLength {
+		return false
+	}
+	tag, err := language.BCP47.Parse(locale)
+	if err != nil {
+		return false
+	}
+	return tag.String() == locale
+}
+
+// IsValidZoneinfo reports whether zoneinfo is a time zone name of the IANA time zone database, such as
+// "Europe/Paris" or "UTC".
+func IsValidZoneinfo(zoneinfo string) bool {
+	if zoneinfo == "" || zoneinfo == "Local" {
+		return false
+	}
+	_, err := time.LoadLocation(zoneinfo)
+	return err == nil
+}
+
+// LocaleFallbacks returns the locale followed by its less specific forms, from the most to the least
+// specific. For example, "zh-Hant-TW" gives "zh-Hant-TW", "zh-Hant" and "zh".
+func LocaleFallbacks(locale string) []string {
+	if locale == "" {
+		return nil
+	}
+	fallbacks := []string{locale}
+	for i := strings.LastIndex(locale, "-"); i > 0; i = strings.LastIndex(locale, "-") {
+		locale = locale[:i]
+		fallbacks = append(fallbacks, locale)
+	}
+	return fallbacks
+}
@@ -0,0 +1,77 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type LocaleUtilTestSuite struct {
+	suite.Suite
+}
+
+func TestLocaleUtilSuite(t *testing.T) {
+	suite.Run(t, new(LocaleUtilTestSuite))
+}
+
+func (suite *LocaleUtilTestSuite) TestIsValidLocale() {
+	testCases := []struct {
+		locale   string
+		expected bool
+	}{
+		{"en", true},
+		{"fr-CA", true},
+		{"zh-Hant-TW", true},
+		{"", false},
+		{"en_US", false},
+		{"en-us", false},
+		{"not a locale", false},
+		{"en-US-" + string(make([]byte, maxLocaleLength)), false},
+	}
+	for _, tc := range testCases {
+		suite.Equal(tc.expected, IsValidLocale(tc.locale), tc.locale)
+	}
+}
+
+func (suite *LocaleUtilTestSuite) TestIsValidZoneinfo() {
+	testCases := []struct {
+		zoneinfo string
+		expected bool
+	}{
+		{"UTC", true},
+		{"Europe/Paris", true},
+		{"America/Argentina/Buenos_Aires", true},
+		{"", false},
+		{"Local", false},
+		{"Mars/Olympus_Mons", false},
+		{"../etc/passwd", false},
+	}
+	for _, tc := range testCases {
+		suite.Equal(tc.expected, IsValidZoneinfo(tc.zoneinfo), tc.zoneinfo)
+	}
+}
+
+func (suite *LocaleUtilTestSuite) TestLocaleFallbacks() {
+	suite.Nil(LocaleFallbacks(""))
+	suite.Equal([]string{"en"}, LocaleFallbacks("en"))
+	suite.Equal([]string{"fr-CA", "fr"}, LocaleFallbacks("fr-CA"))
+	suite.Equal([]string{"zh-Hant-TW", "zh-Hant", "zh"}, LocaleFallbacks("zh-Hant-TW"))
+}
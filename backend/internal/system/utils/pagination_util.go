@@ -18,7 +18,91 @@
 
 package utils
 
-import "fmt"
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/thunder-id/thunderid/internal/system/constants"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+)
+
+// Query parameter names shared by the paginated list endpoints.
+const (
+	QueryParamLimit  = "limit"
+	QueryParamOffset = "offset"
+	QueryParamCount  = "count"
+)
+
+// SkipCountQuery is the query string fragment appended to pagination links when the total count is skipped.
+const SkipCountQuery = "&" + QueryParamCount + "=false"
+
+// PaginationParams holds the pagination parameters of a list request.
+type PaginationParams struct {
+	Limit  int
+	Offset int
+	// SkipCount is set when the client opted out of the total count with count=false.
+	SkipCount bool
+}
+
+// LinkQuery returns the query string fragment that carries the count option over to pagination links.
+func (p PaginationParams) LinkQuery() string {
+	if p.SkipCount {
+		return SkipCountQuery
+	}
+	return ""
+}
+
+// ParsePaginationParams parses the limit, offset and count query parameters of a list request.
+// A missing limit defaults to constants.DefaultPageSize. A limit outside 1..constants.MaxPageSize
+// yields invalidLimit and a negative or malformed offset yields invalidOffset, so that each endpoint
+// reports its own error codes. count=false skips the total count; any other value keeps it.
+func ParsePaginationParams(query url.Values, invalidLimit, invalidOffset *serviceerror.ServiceError) (
+	PaginationParams, *serviceerror.ServiceError) {
+	params := PaginationParams{Limit: constants.DefaultPageSize}
+
+	if limitStr := query.Get(QueryParamLimit); limitStr != "" {
+		limit, err := strconv.Atoi(limitStr)
+		if err != nil || limit < 1 || limit > constants.MaxPageSize {
+			return PaginationParams{}, invalidLimit
+		}
+		params.Limit = limit
+	}
+
+	if offsetStr := query.Get(QueryParamOffset); offsetStr != "" {
+		offset, err := strconv.Atoi(offsetStr)
+		if err != nil || offset < 0 {
+			return PaginationParams{}, invalidOffset
+		}
+		params.Offset = offset
+	}
+
+	if count, err := strconv.ParseBool(query.Get(QueryParamCount)); err == nil && !count {
+		params.SkipCount = true
+	}
+
+	return params, nil
+}
+
+// TotalCountUnknown is reported as the total number of results of a list response when the count is skipped.
+const TotalCountUnknown = -1
+
+type skipCountContextKey struct{}
+
+// WithSkipCount returns a context that instructs list services not to compute the total count.
+func WithSkipCount(ctx context.Context, skip bool) context.Context {
+	if !skip {
+		return ctx
+	}
+	return context.WithValue(ctx, skipCountContextKey{}, true)
+}
+
+// IsCountSkipped reports whether the total count of a list request should be skipped.
+func IsCountSkipped(ctx context.Context) bool {
+	skip, _ := ctx.Value(skipCountContextKey{}).(bool)
+	return skip
+}
 
 // QueryParamInclude is the query parameter name for the include parameter.
 const QueryParamInclude = "include"
@@ -38,6 +122,32 @@ func DisplayQueryParam(includeDisplay bool) string {
 	return ""
 }
 
+// QueryParamAll is the query parameter name used to request every item of a list without pagination.
+const QueryParamAll = "all"
+
+// IsListAllRequested reports whether the query requests the complete list (all=true).
+func IsListAllRequested(query url.Values) bool {
+	all, err := strconv.ParseBool(query.Get(QueryParamAll))
+	return err == nil && all
+}
+
+// CollectAllPages calls fetch with successive offsets of pageSize and returns the items of every
+// page in order. It stops at the first page shorter than pageSize or once the reported total is reached.
+func CollectAllPages[T any](pageSize int,
+	fetch func(limit, offset int) ([]T, int, *serviceerror.ServiceError)) ([]T, *serviceerror.ServiceError) {
+	items := make([]T, 0)
+	for offset := 0; ; offset += pageSize {
+		page, total, svcErr := fetch(pageSize, offset)
+		if svcErr != nil {
+			return nil, svcErr
+		}
+		items = append(items, page...)
+		if len(page) < pageSize || len(items) >= total {
+			return items, nil
+		}
+	}
+}
+
 // Link represents a pagination link in API responses.
 type Link struct {
 	Href string `json:"href"`
@@ -87,3 +197,56 @@ func BuildPaginationLinks(base string, limit, offset, totalCount int, extraQuery
 
 	return links
 }
+
+// BuildPaginationLinksWithoutTotal builds pagination links when the total count is not known.
+// hasMore reports whether items exist beyond the current page; no last link is produced.
+func BuildPaginationLinksWithoutTotal(base string, limit, offset int, hasMore bool, extraQuery string) []Link {
+	links := make([]Link, 0)
+
+	if limit <= 0 {
+		return links
+	}
+
+	if offset > 0 {
+		links = append(links, Link{
+			Href: fmt.Sprintf("%s?offset=0&limit=%d%s", base, limit, extraQuery),
+			Rel:  "first",
+		})
+
+		prevOffset := offset - limit
+		if prevOffset < 0 {
+			prevOffset = 0
+		}
+		links = append(links, Link{
+			Href: fmt.Sprintf("%s?offset=%d&limit=%d%s", base, prevOffset, limit, extraQuery),
+			Rel:  "prev",
+		})
+	}
+
+	if hasMore {
+		links = append(links, Link{
+			Href: fmt.Sprintf("%s?offset=%d&limit=%d%s", base, offset+limit, limit, extraQuery),
+			Rel:  "next",
+		})
+	}
+
+	return links
+}
+
+// BuildListPaginationLinks builds the pagination links of a list response. When totalCount is
+// TotalCountUnknown the links are derived from hasMore and carry the count=false option.
+func BuildListPaginationLinks(base string, limit, offset, totalCount int, hasMore bool, extraQuery string) []Link {
+	if totalCount == TotalCountUnknown {
+		return BuildPaginationLinksWithoutTotal(base, limit, offset, hasMore, extraQuery+SkipCountQuery)
+	}
+	return BuildPaginationLinks(base, limit, offset, totalCount, extraQuery)
+}
+
+// TrimPage trims a page fetched with limit+1 items to limit and reports whether more items exist.
+// Services that skip the total count fetch one extra item to decide whether a next page exists.
+func TrimPage[T any](items []T, limit int) ([]T, bool) {
+	if len(items) > limit {
+		return items[:limit], true
+	}
+	return items, false
+}
@@ -19,10 +19,14 @@
 package utils
 
 import (
+	"context"
+	"net/url"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
 )
 
 func TestBuildPaginationLinks_MiddlePage(t *testing.T) {
@@ -75,3 +79,122 @@ func TestBuildPaginationLinks_WithExtraQuery(t *testing.T) {
 	assert.Equal(t, "/items?offset=10&limit=5&include=display", links[2].Href)
 	assert.Equal(t, "/items?offset=15&limit=5&include=display", links[3].Href)
 }
+
+func TestIsListAllRequested(t *testing.T) {
+	assert.True(t, IsListAllRequested(url.Values{"all": []string{"true"}}))
+	assert.False(t, IsListAllRequested(url.Values{"all": []string{"false"}}))
+	assert.False(t, IsListAllRequested(url.Values{"all": []string{"yes please"}}))
+	assert.False(t, IsListAllRequested(url.Values{}))
+}
+
+func TestCollectAllPages(t *testing.T) {
+	source := []int{1, 2, 3, 4, 5, 6, 7}
+	var offsets []int
+	items, svcErr := CollectAllPages(3, func(limit, offset int) ([]int, int, *serviceerror.ServiceError) {
+		offsets = append(offsets, offset)
+		end := min(offset+limit, len(source))
+		return source[offset:end], len(source), nil
+	})
+
+	require.Nil(t, svcErr)
+	assert.Equal(t, source, items)
+	assert.Equal(t, []int{0, 3, 6}, offsets)
+}
+
+func TestCollectAllPages_StopsWhenTotalIsReached(t *testing.T) {
+	calls := 0
+	items, svcErr := CollectAllPages(2, func(limit, offset int) ([]string, int, *serviceerror.ServiceError) {
+		calls++
+		return []string{"a", "b"}, 2, nil
+	})
+
+	require.Nil(t, svcErr)
+	assert.Equal(t, []string{"a", "b"}, items)
+	assert.Equal(t, 1, calls)
+}
+
+func TestCollectAllPages_ReturnsError(t *testing.T) {
+	items, svcErr := CollectAllPages(2, func(limit, offset int) ([]string, int, *serviceerror.ServiceError) {
+		return nil, 0, &serviceerror.InternalServerError
+	})
+
+	assert.Nil(t, items)
+	assert.Equal(t, &serviceerror.InternalServerError, svcErr)
+}
+
+func TestParsePaginationParams(t *testing.T) {
+	invalidLimit := &serviceerror.ServiceError{Code: "LIMIT"}
+	invalidOffset := &serviceerror.ServiceError{Code: "OFFSET"}
+
+	testCases := []struct {
+		name     string
+		query    string
+		expected PaginationParams
+		err      *serviceerror.ServiceError
+	}{
+		{"defaults", "", PaginationParams{Limit: 30}, nil},
+		{"explicit values", "limit=10&offset=20", PaginationParams{Limit: 10, Offset: 20}, nil},
+		{"skip count", "limit=10&count=false", PaginationParams{Limit: 10, SkipCount: true}, nil},
+		{"unrecognized count keeps count", "count=maybe", PaginationParams{Limit: 30}, nil},
+		{"zero limit", "limit=0", PaginationParams{}, invalidLimit},
+		{"limit above max", "limit=101", PaginationParams{}, invalidLimit},
+		{"malformed limit", "limit=abc", PaginationParams{}, invalidLimit},
+		{"negative offset", "offset=-1", PaginationParams{}, invalidOffset},
+		{"malformed offset", "offset=abc", PaginationParams{}, invalidOffset},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			query, err := url.ParseQuery(tc.query)
+			require.NoError(t, err)
+
+			params, svcErr := ParsePaginationParams(query, invalidLimit, invalidOffset)
+
+			assert.Equal(t, tc.err, svcErr)
+			assert.Equal(t, tc.expected, params)
+		})
+	}
+}
+
+func TestPaginationParams_LinkQuery(t *testing.T) {
+	assert.Equal(t, "", PaginationParams{}.LinkQuery())
+	assert.Equal(t, "&count=false", PaginationParams{SkipCount: true}.LinkQuery())
+}
+
+func TestWithSkipCount(t *testing.T) {
+	ctx := context.Background()
+	assert.False(t, IsCountSkipped(ctx))
+	assert.False(t, IsCountSkipped(WithSkipCount(ctx, false)))
+	assert.True(t, IsCountSkipped(WithSkipCount(ctx, true)))
+}
+
+func TestBuildPaginationLinksWithoutTotal(t *testing.T) {
+	links := BuildPaginationLinksWithoutTotal("/items", 10, 10, true, "")
+	require.Len(t, links, 3)
+	assert.Equal(t, "first", links[0].Rel)
+	assert.Equal(t, "prev", links[1].Rel)
+	assert.Equal(t, "next", links[2].Rel)
+	assert.Equal(t, "/items?offset=20&limit=10", links[2].Href)
+
+	assert.Empty(t, BuildPaginationLinksWithoutTotal("/items", 10, 0, false, ""))
+}
+
+func TestBuildListPaginationLinks_UnknownTotal(t *testing.T) {
+	links := BuildListPaginationLinks("/items", 10, 0, TotalCountUnknown, true, "&include=display")
+	require.Len(t, links, 1)
+	assert.Equal(t, "/items?offset=10&limit=10&include=display&count=false", links[0].Href)
+
+	links = BuildListPaginationLinks("/items", 10, 0, 25, false, "")
+	require.Len(t, links, 2)
+	assert.Equal(t, "last", links[1].Rel)
+}
+
+func TestTrimPage(t *testing.T) {
+	items, hasMore := TrimPage([]int{1, 2, 3}, 2)
+	assert.Equal(t, []int{1, 2}, items)
+	assert.True(t, hasMore)
+
+	items, hasMore = TrimPage([]int{1, 2}, 2)
+	assert.Equal(t, []int{1, 2}, items)
+	assert.False(t, hasMore)
+}
@@ -0,0 +1,35 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package tenant
+
+// Tenant resolution methods configurable through tenant.resolution_methods.
+const (
+	// ResolutionMethodHostname resolves the tenant from the hostname the request was sent to.
+	ResolutionMethodHostname = "hostname"
+	// ResolutionMethodPath resolves the tenant from a "/t/{handle}" path prefix.
+	ResolutionMethodPath = "path"
+	// ResolutionMethodClaim resolves the tenant from the tenant_id claim of the access token.
+	ResolutionMethodClaim = "claim"
+)
+
+// tenantPathPrefix is the path prefix that addresses a tenant by its handle.
+const tenantPathPrefix = "/t/"
+
+// tenantIDClaim is the access token claim that binds a token to the tenant it was issued in.
+const tenantIDClaim = "tenant_id"
@@ -0,0 +1,150 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package tenant
+
+import (
+	"errors"
+
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/i18n/core"
+)
+
+// ErrTenantNotFound is returned when the tenant is not found in the system.
+var ErrTenantNotFound = errors.New("tenant not found")
+
+// Client errors for tenant operations.
+var (
+	// ErrorTenantNotFound is the error returned when a tenant is not found.
+	ErrorTenantNotFound = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "TNT-1001",
+		Error: core.I18nMessage{
+			Key:          "error.tenantservice.tenant_not_found",
+			DefaultValue: "Tenant not found",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.tenantservice.tenant_not_found_description",
+			DefaultValue: "The requested tenant could not be found",
+		},
+	}
+	// ErrorInvalidTenantID is the error returned when an invalid tenant ID is provided.
+	ErrorInvalidTenantID = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "TNT-1002",
+		Error: core.I18nMessage{
+			Key:          "error.tenantservice.invalid_tenant_id",
+			DefaultValue: "Invalid tenant ID",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.tenantservice.invalid_tenant_id_description",
+			DefaultValue: "The provided tenant ID is invalid or empty",
+		},
+	}
+	// ErrorInvalidTenantName is the error returned when an invalid tenant name is provided.
+	ErrorInvalidTenantName = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "TNT-1003",
+		Error: core.I18nMessage{
+			Key:          "error.tenantservice.invalid_tenant_name",
+			DefaultValue: "Invalid tenant name",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.tenantservice.invalid_tenant_name_description",
+			DefaultValue: "The provided tenant name is invalid or empty",
+		},
+	}
+	// ErrorInvalidTenantHandle is the error returned when an invalid tenant handle is provided.
+	ErrorInvalidTenantHandle = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "TNT-1004",
+		Error: core.I18nMessage{
+			Key:          "error.tenantservice.invalid_tenant_handle",
+			DefaultValue: "Invalid tenant handle",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.tenantservice.invalid_tenant_handle_description",
+			DefaultValue: "The tenant handle must contain only lowercase letters, digits and hyphens",
+		},
+	}
+	// ErrorTenantHandleConflict is the error returned when a tenant with the same handle already exists.
+	ErrorTenantHandleConflict = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "TNT-1005",
+		Error: core.I18nMessage{
+			Key:          "error.tenantservice.tenant_handle_conflict",
+			DefaultValue: "Tenant handle conflict",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.tenantservice.tenant_handle_conflict_description",
+			DefaultValue: "A tenant with the same handle already exists",
+		},
+	}
+	// ErrorTenantHostnameConflict is the error returned when another tenant is already bound to the hostname.
+	ErrorTenantHostnameConflict = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "TNT-1006",
+		Error: core.I18nMessage{
+			Key:          "error.tenantservice.tenant_hostname_conflict",
+			DefaultValue: "Tenant hostname conflict",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.tenantservice.tenant_hostname_conflict_description",
+			DefaultValue: "Another tenant is already bound to the provided hostname",
+		},
+	}
+	// ErrorInvalidRequestFormat is the error returned when the request body is malformed.
+	ErrorInvalidRequestFormat = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "TNT-1007",
+		Error: core.I18nMessage{
+			Key:          "error.tenantservice.invalid_request_format",
+			DefaultValue: "Invalid request format",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.tenantservice.invalid_request_format_description",
+			DefaultValue: "The request body is malformed or contains invalid data",
+		},
+	}
+	// ErrorTenantManagementNotAllowed is the error returned when tenants are managed from within a tenant.
+	ErrorTenantManagementNotAllowed = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "TNT-1008",
+		Error: core.I18nMessage{
+			Key:          "error.tenantservice.tenant_management_not_allowed",
+			DefaultValue: "Tenant management not allowed",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.tenantservice.tenant_management_not_allowed_description",
+			DefaultValue: "Tenants can only be managed outside of a tenant scope",
+		},
+	}
+	// ErrorTenantMismatch is the error returned when the tenant bound to a token differs from the tenant of the request.
+	ErrorTenantMismatch = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "TNT-1009",
+		Error: core.I18nMessage{
+			Key:          "error.tenantservice.tenant_mismatch",
+			DefaultValue: "Tenant mismatch",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.tenantservice.tenant_mismatch_description",
+			DefaultValue: "The access token was not issued for the requested tenant",
+		},
+	}
+)
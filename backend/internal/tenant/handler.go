@@ -0,0 +1,123 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package tenant
+
+import (
+	"net/http"
+
+	sysutils "github.com/thunder-id/thunderid/internal/system/utils"
+)
+
+// tenantHandler is the handler for tenant management operations.
+type tenantHandler struct {
+	tenantService TenantServiceInterface
+}
+
+// newTenantHandler creates a new instance of tenantHandler.
+func newTenantHandler(tenantService TenantServiceInterface) *tenantHandler {
+	return &tenantHandler{
+		tenantService: tenantService,
+	}
+}
+
+// HandleTenantPostRequest handles the create tenant request.
+func (th *tenantHandler) HandleTenantPostRequest(w http.ResponseWriter, r *http.Request) {
+	request, err := sysutils.DecodeJSONBody[TenantRequest](r)
+	if err != nil {
+		sysutils.WriteServiceErrorResponse(w, &ErrorInvalidRequestFormat, clientErrorStatusCodes)
+		return
+	}
+
+	created, svcErr := th.tenantService.CreateTenant(r.Context(), sanitizeTenantRequest(*request))
+	if svcErr != nil {
+		sysutils.WriteServiceErrorResponse(w, svcErr, clientErrorStatusCodes)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(w, http.StatusCreated, created)
+}
+
+// HandleTenantListRequest handles the list tenants request.
+func (th *tenantHandler) HandleTenantListRequest(w http.ResponseWriter, r *http.Request) {
+	tenants, svcErr := th.tenantService.GetTenantList(r.Context())
+	if svcErr != nil {
+		sysutils.WriteServiceErrorResponse(w, svcErr, clientErrorStatusCodes)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(w, http.StatusOK, tenants)
+}
+
+// HandleTenantGetRequest handles the get tenant request.
+func (th *tenantHandler) HandleTenantGetRequest(w http.ResponseWriter, r *http.Request) {
+	tenant, svcErr := th.tenantService.GetTenant(r.Context(), r.PathValue("id"))
+	if svcErr != nil {
+		sysutils.WriteServiceErrorResponse(w, svcErr, clientErrorStatusCodes)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(w, http.StatusOK, tenant)
+}
+
+// HandleTenantPutRequest handles the update tenant request.
+func (th *tenantHandler) HandleTenantPutRequest(w http.ResponseWriter, r *http.Request) {
+	request, err := sysutils.DecodeJSONBody[TenantRequest](r)
+	if err != nil {
+		sysutils.WriteServiceErrorResponse(w, &ErrorInvalidRequestFormat, clientErrorStatusCodes)
+		return
+	}
+
+	updated, svcErr := th.tenantService.UpdateTenant(r.Context(), r.PathValue("id"), sanitizeTenantRequest(*request))
+	if svcErr != nil {
+		sysutils.WriteServiceErrorResponse(w, svcErr, clientErrorStatusCodes)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(w, http.StatusOK, updated)
+}
+
+// HandleTenantDeleteRequest handles the delete tenant request.
+func (th *tenantHandler) HandleTenantDeleteRequest(w http.ResponseWriter, r *http.Request) {
+	if svcErr := th.tenantService.DeleteTenant(r.Context(), r.PathValue("id")); svcErr != nil {
+		sysutils.WriteServiceErrorResponse(w, svcErr, clientErrorStatusCodes)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(w, http.StatusNoContent, nil)
+}
+
+// sanitizeTenantRequest sanitizes the user supplied fields of a tenant request.
+func sanitizeTenantRequest(request TenantRequest) TenantRequest {
+	return TenantRequest{
+		Handle:      sysutils.SanitizeString(request.Handle),
+		Name:        sysutils.SanitizeString(request.Name),
+		Description: sysutils.SanitizeString(request.Description),
+		Hostname:    sysutils.SanitizeString(request.Hostname),
+	}
+}
+
+// clientErrorStatusCodes maps the client errors of the handler to HTTP status codes. Other client errors are
+// returned with 400 Bad Request.
+var clientErrorStatusCodes = map[string]int{
+	ErrorTenantNotFound.Code:             http.StatusNotFound,
+	ErrorTenantHandleConflict.Code:       http.StatusConflict,
+	ErrorTenantHostnameConflict.Code:     http.StatusConflict,
+	ErrorTenantManagementNotAllowed.Code: http.StatusForbidden,
+	ErrorTenantMismatch.Code:             http.StatusForbidden,
+}
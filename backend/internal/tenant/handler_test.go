@@ -0,0 +1,132 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package tenant
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/system/error/apierror"
+)
+
+type HandlerTestSuite struct {
+	suite.Suite
+	mockService *TenantServiceInterfaceMock
+	handler     *tenantHandler
+}
+
+func TestHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(HandlerTestSuite))
+}
+
+func (s *HandlerTestSuite) SetupTest() {
+	s.mockService = NewTenantServiceInterfaceMock(s.T())
+	s.handler = newTenantHandler(s.mockService)
+}
+
+func (s *HandlerTestSuite) TestHandleTenantPostRequest_Success() {
+	s.mockService.On("CreateTenant", mock.Anything, TenantRequest{Handle: "acme", Name: "Acme"}).
+		Return(&CreateTenantResponse{
+			Tenant:    Tenant{ID: "tenant-1", Handle: "acme", Name: "Acme"},
+			Bootstrap: TenantBootstrapResult{OUID: "ou-1", ResourceServerID: "rs-1", AdminRoleID: "role-1"},
+		}, nil)
+
+	req := httptest.NewRequest(http.MethodPost, "/tenants", strings.NewReader(`{"handle":"acme","name":"Acme"}`))
+	rr := httptest.NewRecorder()
+	s.handler.HandleTenantPostRequest(rr, req)
+
+	s.Equal(http.StatusCreated, rr.Code)
+	var body CreateTenantResponse
+	s.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &body))
+	s.Equal("tenant-1", body.ID)
+	s.Equal("role-1", body.Bootstrap.AdminRoleID)
+}
+
+func (s *HandlerTestSuite) TestHandleTenantPostRequest_InvalidBody() {
+	req := httptest.NewRequest(http.MethodPost, "/tenants", strings.NewReader(`{`))
+	rr := httptest.NewRecorder()
+	s.handler.HandleTenantPostRequest(rr, req)
+
+	s.Equal(http.StatusBadRequest, rr.Code)
+	var errResp apierror.ErrorResponse
+	s.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &errResp))
+	s.Equal(ErrorInvalidRequestFormat.Code, errResp.Code)
+}
+
+func (s *HandlerTestSuite) TestHandleTenantPostRequest_Conflict() {
+	s.mockService.On("CreateTenant", mock.Anything, mock.Anything).Return(nil, &ErrorTenantHandleConflict)
+
+	req := httptest.NewRequest(http.MethodPost, "/tenants", strings.NewReader(`{"handle":"acme","name":"Acme"}`))
+	rr := httptest.NewRecorder()
+	s.handler.HandleTenantPostRequest(rr, req)
+
+	s.Equal(http.StatusConflict, rr.Code)
+}
+
+func (s *HandlerTestSuite) TestHandleTenantListRequest() {
+	s.mockService.On("GetTenantList", mock.Anything).Return([]Tenant{{ID: "tenant-1"}}, nil)
+
+	rr := httptest.NewRecorder()
+	s.handler.HandleTenantListRequest(rr, httptest.NewRequest(http.MethodGet, "/tenants", nil))
+
+	s.Equal(http.StatusOK, rr.Code)
+	var body []Tenant
+	s.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &body))
+	s.Len(body, 1)
+}
+
+func (s *HandlerTestSuite) TestHandleTenantGetRequest_NotFound() {
+	s.mockService.On("GetTenant", mock.Anything, "missing").Return(nil, &ErrorTenantNotFound)
+
+	req := httptest.NewRequest(http.MethodGet, "/tenants/missing", nil)
+	req.SetPathValue("id", "missing")
+	rr := httptest.NewRecorder()
+	s.handler.HandleTenantGetRequest(rr, req)
+
+	s.Equal(http.StatusNotFound, rr.Code)
+}
+
+func (s *HandlerTestSuite) TestHandleTenantPutRequest_Forbidden() {
+	s.mockService.On("UpdateTenant", mock.Anything, "tenant-1", mock.Anything).
+		Return(nil, &ErrorTenantManagementNotAllowed)
+
+	req := httptest.NewRequest(http.MethodPut, "/tenants/tenant-1", strings.NewReader(`{"name":"Acme"}`))
+	req.SetPathValue("id", "tenant-1")
+	rr := httptest.NewRecorder()
+	s.handler.HandleTenantPutRequest(rr, req)
+
+	s.Equal(http.StatusForbidden, rr.Code)
+}
+
+func (s *HandlerTestSuite) TestHandleTenantDeleteRequest() {
+	s.mockService.On("DeleteTenant", mock.Anything, "tenant-1").Return(nil)
+
+	req := httptest.NewRequest(http.MethodDelete, "/tenants/tenant-1", nil)
+	req.SetPathValue("id", "tenant-1")
+	rr := httptest.NewRecorder()
+	s.handler.HandleTenantDeleteRequest(rr, req)
+
+	s.Equal(http.StatusNoContent, rr.Code)
+}
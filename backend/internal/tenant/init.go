@@ -0,0 +1,82 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package tenant
+
+import (
+	"net/http"
+
+	"github.com/thunder-id/thunderid/internal/ou"
+	"github.com/thunder-id/thunderid/internal/resource"
+	"github.com/thunder-id/thunderid/internal/role"
+	"github.com/thunder-id/thunderid/internal/system/cache"
+	"github.com/thunder-id/thunderid/internal/system/middleware"
+)
+
+// Initialize initializes the tenant service and registers its routes.
+func Initialize(
+	mux *http.ServeMux,
+	cacheManager cache.CacheManagerInterface,
+	ouService ou.OrganizationUnitServiceInterface,
+	resourceService resource.ResourceServiceInterface,
+	roleService role.RoleServiceInterface,
+) (TenantServiceInterface, error) {
+	tenantStore, transactioner, err := newTenantStore()
+	if err != nil {
+		return nil, err
+	}
+
+	tenantCache := cache.GetCache[*Tenant](cacheManager, "TenantCache")
+	tenantService := newTenantService(tenantStore, tenantCache, ouService, resourceService, roleService,
+		transactioner)
+
+	tenantHandler := newTenantHandler(tenantService)
+	registerRoutes(mux, tenantHandler)
+
+	return tenantService, nil
+}
+
+// registerRoutes registers the routes for tenant management operations.
+func registerRoutes(mux *http.ServeMux, tenantHandler *tenantHandler) {
+	opts1 := middleware.CORSOptions{
+		AllowedMethods:   []string{"GET", "POST"},
+		AllowedHeaders:   middleware.DefaultAllowedHeaders,
+		AllowCredentials: true,
+		MaxAge:           600,
+	}
+	mux.HandleFunc(middleware.WithCORS("POST /tenants", tenantHandler.HandleTenantPostRequest, opts1))
+	mux.HandleFunc(middleware.WithCORS("GET /tenants", tenantHandler.HandleTenantListRequest, opts1))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS /tenants",
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, opts1))
+
+	opts2 := middleware.CORSOptions{
+		AllowedMethods:   []string{"GET", "PUT", "DELETE"},
+		AllowedHeaders:   middleware.DefaultAllowedHeaders,
+		AllowCredentials: true,
+		MaxAge:           600,
+	}
+	mux.HandleFunc(middleware.WithCORS("GET /tenants/{id}", tenantHandler.HandleTenantGetRequest, opts2))
+	mux.HandleFunc(middleware.WithCORS("PUT /tenants/{id}", tenantHandler.HandleTenantPutRequest, opts2))
+	mux.HandleFunc(middleware.WithCORS("DELETE /tenants/{id}", tenantHandler.HandleTenantDeleteRequest, opts2))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS /tenants/{id}",
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, opts2))
+}
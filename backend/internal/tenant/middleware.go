@@ -0,0 +1,143 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package tenant
+
+import (
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+	sysContext "github.com/thunder-id/thunderid/internal/system/context"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/security"
+	sysutils "github.com/thunder-id/thunderid/internal/system/utils"
+)
+
+// ResolutionMiddleware scopes a request to the tenant resolved from its hostname or "/t/{handle}" path
+// prefix, in the configured order. The path prefix is stripped so that routes are registered once for
+// all tenants. It must run before the security middleware so that authorization sees the stripped path.
+func ResolutionMiddleware(tenantService TenantServiceInterface, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		tenantCfg := config.GetServerRuntime().Config.Tenant
+		if !tenantCfg.Enabled {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		for _, method := range tenantCfg.ResolutionMethods {
+			var tenant *Tenant
+			var svcErr *serviceerror.ServiceError
+			switch method {
+			case ResolutionMethodHostname:
+				tenant, svcErr = tenantService.GetTenantByHostname(r.Context(), r.Host)
+				if svcErr != nil && svcErr.Code == ErrorTenantNotFound.Code {
+					// Hosts that are not bound to a tenant are served as the root organization.
+					continue
+				}
+			case ResolutionMethodPath:
+				handle, rest, ok := splitTenantPath(r.URL.Path)
+				if !ok {
+					continue
+				}
+				tenant, svcErr = tenantService.GetTenantByHandle(r.Context(), handle)
+				if svcErr == nil {
+					r = stripTenantPath(r, rest)
+				}
+			default:
+				continue
+			}
+
+			if svcErr != nil {
+				sysutils.WriteServiceErrorResponse(w, svcErr, clientErrorStatusCodes)
+				return
+			}
+			r = r.WithContext(sysContext.WithTenantID(r.Context(), tenant.ID))
+			break
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// ClaimMiddleware enforces the tenant_id claim of the access token. A token may only be used within the
+// tenant it was issued in; when the request was not resolved to a tenant otherwise, the claim scopes it.
+// It must run after the security middleware, which populates the token claims.
+func ClaimMiddleware(tenantService TenantServiceInterface, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		tenantCfg := config.GetServerRuntime().Config.Tenant
+		if !tenantCfg.Enabled {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		claimTenantID, _ := security.GetAttribute(r.Context(), tenantIDClaim).(string)
+		if claimTenantID == "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		resolvedTenantID := sysContext.GetTenantID(r.Context())
+		if resolvedTenantID != "" {
+			if resolvedTenantID != claimTenantID {
+				sysutils.WriteServiceErrorResponse(w, &ErrorTenantMismatch, clientErrorStatusCodes)
+				return
+			}
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		if !slices.Contains(tenantCfg.ResolutionMethods, ResolutionMethodClaim) {
+			// Tokens issued in a tenant must never be honoured against the root organization.
+			sysutils.WriteServiceErrorResponse(w, &ErrorTenantMismatch, clientErrorStatusCodes)
+			return
+		}
+		if _, svcErr := tenantService.GetTenant(r.Context(), claimTenantID); svcErr != nil {
+			if svcErr.Code == ErrorTenantNotFound.Code {
+				sysutils.WriteServiceErrorResponse(w, &ErrorTenantMismatch, clientErrorStatusCodes)
+				return
+			}
+			sysutils.WriteServiceErrorResponse(w, svcErr, clientErrorStatusCodes)
+			return
+		}
+
+		next.ServeHTTP(w, r.WithContext(sysContext.WithTenantID(r.Context(), claimTenantID)))
+	})
+}
+
+// splitTenantPath splits a "/t/{handle}/..." path into the tenant handle and the remaining path.
+func splitTenantPath(path string) (string, string, bool) {
+	if !strings.HasPrefix(path, tenantPathPrefix) {
+		return "", "", false
+	}
+	handle, rest, _ := strings.Cut(strings.TrimPrefix(path, tenantPathPrefix), "/")
+	if handle == "" {
+		return "", "", false
+	}
+	return handle, "/" + rest, true
+}
+
+// stripTenantPath returns a shallow copy of the request addressed to the path without the tenant prefix.
+func stripTenantPath(r *http.Request, path string) *http.Request {
+	stripped := r.Clone(r.Context())
+	stripped.URL.Path = path
+	stripped.URL.RawPath = ""
+	stripped.RequestURI = stripped.URL.RequestURI()
+	return stripped
+}
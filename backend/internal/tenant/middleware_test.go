@@ -0,0 +1,228 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package tenant
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+	sysContext "github.com/thunder-id/thunderid/internal/system/context"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/security"
+)
+
+type MiddlewareTestSuite struct {
+	suite.Suite
+	mockService *TenantServiceInterfaceMock
+	// seenTenantID and seenPath capture what the wrapped handler observed.
+	seenTenantID string
+	seenPath     string
+	next         http.Handler
+}
+
+func TestMiddlewareTestSuite(t *testing.T) {
+	suite.Run(t, new(MiddlewareTestSuite))
+}
+
+func (s *MiddlewareTestSuite) SetupTest() {
+	s.setConfig(true, []string{ResolutionMethodHostname, ResolutionMethodPath, ResolutionMethodClaim})
+	s.mockService = NewTenantServiceInterfaceMock(s.T())
+	s.seenTenantID = ""
+	s.seenPath = ""
+	s.next = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.seenTenantID = sysContext.GetTenantID(r.Context())
+		s.seenPath = r.URL.Path
+		w.WriteHeader(http.StatusOK)
+	})
+}
+
+func (s *MiddlewareTestSuite) TearDownTest() {
+	config.ResetServerRuntime()
+}
+
+func (s *MiddlewareTestSuite) setConfig(enabled bool, methods []string) {
+	config.ResetServerRuntime()
+	_ = config.InitializeServerRuntime("/tmp/test", &config.Config{
+		Tenant: config.TenantConfig{Enabled: enabled, ResolutionMethods: methods},
+	})
+}
+
+func (s *MiddlewareTestSuite) TestResolution_Disabled() {
+	s.setConfig(false, []string{ResolutionMethodPath})
+
+	rr := httptest.NewRecorder()
+	ResolutionMiddleware(s.mockService, s.next).ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/t/acme/users", nil))
+
+	s.Equal(http.StatusOK, rr.Code)
+	s.Empty(s.seenTenantID)
+	s.Equal("/t/acme/users", s.seenPath)
+}
+
+func (s *MiddlewareTestSuite) TestResolution_ByHostname() {
+	s.mockService.On("GetTenantByHostname", mock.Anything, "acme.example.com").
+		Return(&Tenant{ID: "tenant-1"}, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/users", nil)
+	req.Host = "acme.example.com"
+	rr := httptest.NewRecorder()
+	ResolutionMiddleware(s.mockService, s.next).ServeHTTP(rr, req)
+
+	s.Equal(http.StatusOK, rr.Code)
+	s.Equal("tenant-1", s.seenTenantID)
+	s.Equal("/users", s.seenPath)
+}
+
+func (s *MiddlewareTestSuite) TestResolution_ByPathStripsPrefix() {
+	s.mockService.On("GetTenantByHostname", mock.Anything, mock.Anything).Return(nil, &ErrorTenantNotFound)
+	s.mockService.On("GetTenantByHandle", mock.Anything, "acme").Return(&Tenant{ID: "tenant-1"}, nil)
+
+	rr := httptest.NewRecorder()
+	ResolutionMiddleware(s.mockService, s.next).ServeHTTP(rr,
+		httptest.NewRequest(http.MethodGet, "/t/acme/oauth2/token?x=1", nil))
+
+	s.Equal(http.StatusOK, rr.Code)
+	s.Equal("tenant-1", s.seenTenantID)
+	s.Equal("/oauth2/token", s.seenPath)
+}
+
+func (s *MiddlewareTestSuite) TestResolution_UnknownPathTenant() {
+	s.setConfig(true, []string{ResolutionMethodPath})
+	s.mockService.On("GetTenantByHandle", mock.Anything, "unknown").Return(nil, &ErrorTenantNotFound)
+
+	rr := httptest.NewRecorder()
+	ResolutionMiddleware(s.mockService, s.next).ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/t/unknown/users", nil))
+
+	s.Equal(http.StatusNotFound, rr.Code)
+	s.Empty(s.seenPath)
+}
+
+func (s *MiddlewareTestSuite) TestResolution_HostnameLookupFailure() {
+	s.setConfig(true, []string{ResolutionMethodHostname})
+	s.mockService.On("GetTenantByHostname", mock.Anything, mock.Anything).
+		Return(nil, &serviceerror.InternalServerError)
+
+	rr := httptest.NewRecorder()
+	ResolutionMiddleware(s.mockService, s.next).ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/users", nil))
+
+	s.Equal(http.StatusInternalServerError, rr.Code)
+}
+
+func (s *MiddlewareTestSuite) TestResolution_NoTenant() {
+	s.mockService.On("GetTenantByHostname", mock.Anything, mock.Anything).Return(nil, &ErrorTenantNotFound)
+
+	rr := httptest.NewRecorder()
+	ResolutionMiddleware(s.mockService, s.next).ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/users", nil))
+
+	s.Equal(http.StatusOK, rr.Code)
+	s.Empty(s.seenTenantID)
+}
+
+func (s *MiddlewareTestSuite) requestWithClaim(ctx context.Context, claims map[string]interface{}) *http.Request {
+	authCtx := security.NewSecurityContextForTest("user-1", "", "token", nil, claims)
+	return httptest.NewRequest(http.MethodGet, "/users", nil).
+		WithContext(security.WithSecurityContextTest(ctx, authCtx))
+}
+
+func (s *MiddlewareTestSuite) TestClaim_NoClaim() {
+	rr := httptest.NewRecorder()
+	ClaimMiddleware(s.mockService, s.next).ServeHTTP(rr, s.requestWithClaim(context.Background(), nil))
+
+	s.Equal(http.StatusOK, rr.Code)
+	s.Empty(s.seenTenantID)
+}
+
+func (s *MiddlewareTestSuite) TestClaim_MatchesResolvedTenant() {
+	ctx := sysContext.WithTenantID(context.Background(), "tenant-1")
+
+	rr := httptest.NewRecorder()
+	ClaimMiddleware(s.mockService, s.next).ServeHTTP(rr,
+		s.requestWithClaim(ctx, map[string]interface{}{tenantIDClaim: "tenant-1"}))
+
+	s.Equal(http.StatusOK, rr.Code)
+	s.Equal("tenant-1", s.seenTenantID)
+}
+
+func (s *MiddlewareTestSuite) TestClaim_ConflictsWithResolvedTenant() {
+	ctx := sysContext.WithTenantID(context.Background(), "tenant-1")
+
+	rr := httptest.NewRecorder()
+	ClaimMiddleware(s.mockService, s.next).ServeHTTP(rr,
+		s.requestWithClaim(ctx, map[string]interface{}{tenantIDClaim: "tenant-2"}))
+
+	s.Equal(http.StatusForbidden, rr.Code)
+	s.Empty(s.seenPath)
+}
+
+func (s *MiddlewareTestSuite) TestClaim_ScopesUnresolvedRequest() {
+	s.mockService.On("GetTenant", mock.Anything, "tenant-1").Return(&Tenant{ID: "tenant-1"}, nil)
+
+	rr := httptest.NewRecorder()
+	ClaimMiddleware(s.mockService, s.next).ServeHTTP(rr,
+		s.requestWithClaim(context.Background(), map[string]interface{}{tenantIDClaim: "tenant-1"}))
+
+	s.Equal(http.StatusOK, rr.Code)
+	s.Equal("tenant-1", s.seenTenantID)
+}
+
+func (s *MiddlewareTestSuite) TestClaim_DeletedTenant() {
+	s.mockService.On("GetTenant", mock.Anything, "tenant-1").Return(nil, &ErrorTenantNotFound)
+
+	rr := httptest.NewRecorder()
+	ClaimMiddleware(s.mockService, s.next).ServeHTTP(rr,
+		s.requestWithClaim(context.Background(), map[string]interface{}{tenantIDClaim: "tenant-1"}))
+
+	s.Equal(http.StatusForbidden, rr.Code)
+}
+
+func (s *MiddlewareTestSuite) TestClaim_RejectedAtRootWhenClaimResolutionDisabled() {
+	s.setConfig(true, []string{ResolutionMethodPath})
+
+	rr := httptest.NewRecorder()
+	ClaimMiddleware(s.mockService, s.next).ServeHTTP(rr,
+		s.requestWithClaim(context.Background(), map[string]interface{}{tenantIDClaim: "tenant-1"}))
+
+	s.Equal(http.StatusForbidden, rr.Code)
+}
+
+func (s *MiddlewareTestSuite) TestSplitTenantPath() {
+	testCases := []struct {
+		path   string
+		handle string
+		rest   string
+		ok     bool
+	}{
+		{"/t/acme/users", "acme", "/users", true},
+		{"/t/acme", "acme", "/", true},
+		{"/t/", "", "", false},
+		{"/users", "", "", false},
+	}
+
+	for _, tc := range testCases {
+		handle, rest, ok := splitTenantPath(tc.path)
+		s.Equal(tc.handle, handle, tc.path)
+		s.Equal(tc.rest, rest, tc.path)
+		s.Equal(tc.ok, ok, tc.path)
+	}
+}
@@ -0,0 +1,49 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package tenant
+
+// Tenant represents an organization hosted on the server with its own isolated set of resources.
+type Tenant struct {
+	ID          string `json:"id"`
+	Handle      string `json:"handle"`
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	Hostname    string `json:"hostname,omitempty"`
+}
+
+// TenantRequest represents the request body for creating or updating a tenant.
+type TenantRequest struct {
+	Handle      string `json:"handle"`
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	Hostname    string `json:"hostname,omitempty"`
+}
+
+// TenantBootstrapResult holds the resources created inside a tenant when it is provisioned.
+type TenantBootstrapResult struct {
+	OUID             string `json:"ouId"`
+	ResourceServerID string `json:"resourceServerId"`
+	AdminRoleID      string `json:"adminRoleId"`
+}
+
+// CreateTenantResponse represents the response body of a tenant creation.
+type CreateTenantResponse struct {
+	Tenant
+	Bootstrap TenantBootstrapResult `json:"bootstrap"`
+}
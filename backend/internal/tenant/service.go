@@ -0,0 +1,413 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package tenant provides organization-level multi-tenancy: tenant management, per-tenant bootstrapping
+// and resolution of the tenant a request belongs to.
+package tenant
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"strings"
+
+	"github.com/thunder-id/thunderid/internal/ou"
+	"github.com/thunder-id/thunderid/internal/resource"
+	"github.com/thunder-id/thunderid/internal/role"
+	"github.com/thunder-id/thunderid/internal/system/cache"
+	"github.com/thunder-id/thunderid/internal/system/config"
+	sysContext "github.com/thunder-id/thunderid/internal/system/context"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/internal/system/transaction"
+	"github.com/thunder-id/thunderid/internal/system/utils"
+)
+
+// tenantHandlePattern restricts handles to values that are safe to use in URL paths and hostnames.
+var tenantHandlePattern = regexp.MustCompile(`^[a-z0-9]([a-z0-9-]{0,98}[a-z0-9])?$`)
+
+// TenantServiceInterface defines the interface for the tenant service.
+type TenantServiceInterface interface {
+	CreateTenant(ctx context.Context, request TenantRequest) (*CreateTenantResponse, *serviceerror.ServiceError)
+	GetTenantList(ctx context.Context) ([]Tenant, *serviceerror.ServiceError)
+	GetTenant(ctx context.Context, id string) (*Tenant, *serviceerror.ServiceError)
+	GetTenantByHandle(ctx context.Context, handle string) (*Tenant, *serviceerror.ServiceError)
+	GetTenantByHostname(ctx context.Context, hostname string) (*Tenant, *serviceerror.ServiceError)
+	UpdateTenant(ctx context.Context, id string, request TenantRequest) (*Tenant, *serviceerror.ServiceError)
+	DeleteTenant(ctx context.Context, id string) *serviceerror.ServiceError
+}
+
+// tenantService is the default implementation of the TenantServiceInterface.
+type tenantService struct {
+	tenantStore     tenantStoreInterface
+	tenantCache     cache.CacheInterface[*Tenant]
+	ouService       ou.OrganizationUnitServiceInterface
+	resourceService resource.ResourceServiceInterface
+	roleService     role.RoleServiceInterface
+	transactioner   transaction.Transactioner
+	logger          *log.Logger
+}
+
+// newTenantService creates a new instance of tenantService.
+func newTenantService(
+	tenantStore tenantStoreInterface,
+	tenantCache cache.CacheInterface[*Tenant],
+	ouService ou.OrganizationUnitServiceInterface,
+	resourceService resource.ResourceServiceInterface,
+	roleService role.RoleServiceInterface,
+	transactioner transaction.Transactioner,
+) TenantServiceInterface {
+	return &tenantService{
+		tenantStore:     tenantStore,
+		tenantCache:     tenantCache,
+		ouService:       ouService,
+		resourceService: resourceService,
+		roleService:     roleService,
+		transactioner:   transactioner,
+		logger:          log.GetLogger().With(log.String(log.LoggerKeyComponentName, "TenantService")),
+	}
+}
+
+// CreateTenant creates a new tenant and bootstraps its root organization unit, system resource server
+// and administrator role in the same transaction.
+func (ts *tenantService) CreateTenant(
+	ctx context.Context, request TenantRequest) (*CreateTenantResponse, *serviceerror.ServiceError) {
+	if sysContext.GetTenantID(ctx) != "" {
+		return nil, &ErrorTenantManagementNotAllowed
+	}
+	tenant := Tenant{
+		Handle:      strings.TrimSpace(request.Handle),
+		Name:        strings.TrimSpace(request.Name),
+		Description: request.Description,
+		Hostname:    normalizeHostname(request.Hostname),
+	}
+	if svcErr := validateTenant(tenant); svcErr != nil {
+		return nil, svcErr
+	}
+
+	id, err := utils.GenerateUUIDv7()
+	if err != nil {
+		ts.logger.Error("Failed to generate ID for tenant", log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+	tenant.ID = id
+
+	var bootstrap *TenantBootstrapResult
+	var svcErr *serviceerror.ServiceError
+	err = ts.transactioner.Transact(ctx, func(txCtx context.Context) error {
+		if svcErr = ts.checkConflicts(txCtx, tenant); svcErr != nil {
+			return errors.New("tenant conflict")
+		}
+		if err := ts.tenantStore.CreateTenant(txCtx, tenant); err != nil {
+			return err
+		}
+
+		bootstrap, svcErr = ts.bootstrapTenant(sysContext.WithTenantID(txCtx, tenant.ID), tenant)
+		if svcErr != nil {
+			return errors.New("tenant bootstrap failed")
+		}
+		return nil
+	})
+
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	if err != nil {
+		ts.logger.Error("Failed to create tenant", log.Error(err), log.String("handle", tenant.Handle))
+		return nil, &serviceerror.InternalServerError
+	}
+
+	return &CreateTenantResponse{Tenant: tenant, Bootstrap: *bootstrap}, nil
+}
+
+// bootstrapTenant provisions the resources a tenant administrator needs to manage the tenant. The
+// context must already be scoped to the tenant so that the resources land in the tenant partition.
+func (ts *tenantService) bootstrapTenant(
+	ctx context.Context, tenant Tenant) (*TenantBootstrapResult, *serviceerror.ServiceError) {
+	rootOU, svcErr := ts.ouService.CreateOrganizationUnit(ctx, ou.OrganizationUnitRequestWithID{
+		Handle:      tenant.Handle,
+		Name:        tenant.Name,
+		Description: tenant.Description,
+	})
+	if svcErr != nil {
+		return nil, ts.bootstrapError("organization unit", svcErr)
+	}
+
+	systemRSConfig := config.GetServerRuntime().Config.Resource.SystemResourceServer
+	systemRS, svcErr := ts.resourceService.CreateResourceServer(ctx, resource.ResourceServer{
+		Name:        "System",
+		Description: "System resource server",
+		Handle:      systemRSConfig.Handle,
+		Identifier:  systemRSConfig.Identifier,
+		OUID:        rootOU.ID,
+	})
+	if svcErr != nil {
+		return nil, ts.bootstrapError("system resource server", svcErr)
+	}
+
+	systemResource, svcErr := ts.resourceService.CreateResource(ctx, systemRS.ID, resource.Resource{
+		Name:        "System",
+		Description: "System resource",
+		Handle:      "system",
+	})
+	if svcErr != nil {
+		return nil, ts.bootstrapError("system resource", svcErr)
+	}
+
+	adminRole, svcErr := ts.roleService.CreateRole(ctx, role.RoleCreationDetail{
+		Name:        "Administrator",
+		Description: "Tenant administrator role with full permissions",
+		OUID:        rootOU.ID,
+		Permissions: []role.ResourcePermissions{
+			{ResourceServerID: systemRS.ID, Permissions: []string{systemResource.Permission}},
+		},
+	})
+	if svcErr != nil {
+		return nil, ts.bootstrapError("administrator role", svcErr)
+	}
+
+	return &TenantBootstrapResult{
+		OUID:             rootOU.ID,
+		ResourceServerID: systemRS.ID,
+		AdminRoleID:      adminRole.ID,
+	}, nil
+}
+
+// bootstrapError logs a failure to provision a tenant resource and maps it to an internal error, since
+// the inputs are derived from an already validated tenant.
+func (ts *tenantService) bootstrapError(
+	resourceName string, svcErr *serviceerror.ServiceError) *serviceerror.ServiceError {
+	ts.logger.Error("Failed to bootstrap tenant "+resourceName, log.String("code", svcErr.Code),
+		log.String("error", svcErr.Error.DefaultValue))
+	return &serviceerror.InternalServerError
+}
+
+// GetTenantList retrieves all tenants.
+func (ts *tenantService) GetTenantList(ctx context.Context) ([]Tenant, *serviceerror.ServiceError) {
+	if sysContext.GetTenantID(ctx) != "" {
+		return nil, &ErrorTenantManagementNotAllowed
+	}
+	tenants, err := ts.tenantStore.GetTenantList(ctx)
+	if err != nil {
+		ts.logger.Error("Failed to get tenant list", log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+	return tenants, nil
+}
+
+// GetTenant retrieves a tenant by its ID.
+func (ts *tenantService) GetTenant(ctx context.Context, id string) (*Tenant, *serviceerror.ServiceError) {
+	if sysContext.GetTenantID(ctx) != "" {
+		return nil, &ErrorTenantManagementNotAllowed
+	}
+	if strings.TrimSpace(id) == "" {
+		return nil, &ErrorInvalidTenantID
+	}
+	tenant, err := ts.tenantStore.GetTenant(ctx, id)
+	if err != nil {
+		return nil, ts.mapStoreError(err, log.String("tenantID", id))
+	}
+	return tenant, nil
+}
+
+// GetTenantByHandle retrieves a tenant by its handle. Lookups are cached since they run on every
+// request resolved through the path.
+func (ts *tenantService) GetTenantByHandle(
+	ctx context.Context, handle string) (*Tenant, *serviceerror.ServiceError) {
+	return ts.getCachedTenant(ctx, handleCacheKey(handle), func() (*Tenant, error) {
+		return ts.tenantStore.GetTenantByHandle(ctx, handle)
+	})
+}
+
+// GetTenantByHostname retrieves a tenant by its hostname. Lookups are cached since they run on every
+// request resolved through the hostname.
+func (ts *tenantService) GetTenantByHostname(
+	ctx context.Context, hostname string) (*Tenant, *serviceerror.ServiceError) {
+	hostname = normalizeHostname(hostname)
+	return ts.getCachedTenant(ctx, hostnameCacheKey(hostname), func() (*Tenant, error) {
+		return ts.tenantStore.GetTenantByHostname(ctx, hostname)
+	})
+}
+
+// getCachedTenant returns the cached tenant for the key or loads and caches it.
+func (ts *tenantService) getCachedTenant(ctx context.Context, key cache.CacheKey,
+	load func() (*Tenant, error)) (*Tenant, *serviceerror.ServiceError) {
+	if tenant, ok := ts.tenantCache.Get(ctx, key); ok {
+		return tenant, nil
+	}
+	tenant, err := load()
+	if err != nil {
+		return nil, ts.mapStoreError(err, log.String("key", key.Key))
+	}
+	_ = ts.tenantCache.Set(ctx, key, tenant)
+	return tenant, nil
+}
+
+// UpdateTenant updates the name, description and hostname of a tenant. The handle is immutable since
+// it is part of the URLs the tenant is reached through.
+func (ts *tenantService) UpdateTenant(
+	ctx context.Context, id string, request TenantRequest) (*Tenant, *serviceerror.ServiceError) {
+	if sysContext.GetTenantID(ctx) != "" {
+		return nil, &ErrorTenantManagementNotAllowed
+	}
+	if strings.TrimSpace(id) == "" {
+		return nil, &ErrorInvalidTenantID
+	}
+
+	var existing *Tenant
+	var updated Tenant
+	var svcErr *serviceerror.ServiceError
+	err := ts.transactioner.Transact(ctx, func(txCtx context.Context) error {
+		var err error
+		existing, err = ts.tenantStore.GetTenant(txCtx, id)
+		if err != nil {
+			if errors.Is(err, ErrTenantNotFound) {
+				svcErr = &ErrorTenantNotFound
+			}
+			return err
+		}
+		if handle := strings.TrimSpace(request.Handle); handle != "" && handle != existing.Handle {
+			svcErr = &ErrorInvalidTenantHandle
+			return errors.New("tenant handle cannot be changed")
+		}
+
+		updated = Tenant{
+			ID:          existing.ID,
+			Handle:      existing.Handle,
+			Name:        strings.TrimSpace(request.Name),
+			Description: request.Description,
+			Hostname:    normalizeHostname(request.Hostname),
+		}
+		if svcErr = validateTenant(updated); svcErr != nil {
+			return errors.New("validation error")
+		}
+		if svcErr = ts.checkConflicts(txCtx, updated); svcErr != nil {
+			return errors.New("tenant conflict")
+		}
+		return ts.tenantStore.UpdateTenant(txCtx, &updated)
+	})
+
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	if err != nil {
+		ts.logger.Error("Failed to update tenant", log.Error(err), log.String("tenantID", id))
+		return nil, &serviceerror.InternalServerError
+	}
+
+	ts.invalidateCache(ctx, existing)
+	return &updated, nil
+}
+
+// DeleteTenant deletes a tenant. Resources inside the tenant partition are left in place so that they
+// can be recovered or purged explicitly.
+func (ts *tenantService) DeleteTenant(ctx context.Context, id string) *serviceerror.ServiceError {
+	if sysContext.GetTenantID(ctx) != "" {
+		return &ErrorTenantManagementNotAllowed
+	}
+	if strings.TrimSpace(id) == "" {
+		return &ErrorInvalidTenantID
+	}
+
+	existing, err := ts.tenantStore.GetTenant(ctx, id)
+	if err != nil {
+		if errors.Is(err, ErrTenantNotFound) {
+			return nil
+		}
+		ts.logger.Error("Failed to get tenant", log.Error(err), log.String("tenantID", id))
+		return &serviceerror.InternalServerError
+	}
+
+	if err := ts.tenantStore.DeleteTenant(ctx, id); err != nil {
+		ts.logger.Error("Failed to delete tenant", log.Error(err), log.String("tenantID", id))
+		return &serviceerror.InternalServerError
+	}
+
+	ts.invalidateCache(ctx, existing)
+	return nil
+}
+
+// checkConflicts ensures that no other tenant uses the same handle or hostname.
+func (ts *tenantService) checkConflicts(ctx context.Context, tenant Tenant) *serviceerror.ServiceError {
+	existing, err := ts.tenantStore.GetTenantByHandle(ctx, tenant.Handle)
+	if err != nil && !errors.Is(err, ErrTenantNotFound) {
+		return ts.mapStoreError(err, log.String("handle", tenant.Handle))
+	}
+	if existing != nil && existing.ID != tenant.ID {
+		return &ErrorTenantHandleConflict
+	}
+
+	if tenant.Hostname == "" {
+		return nil
+	}
+	existing, err = ts.tenantStore.GetTenantByHostname(ctx, tenant.Hostname)
+	if err != nil && !errors.Is(err, ErrTenantNotFound) {
+		return ts.mapStoreError(err, log.String("hostname", tenant.Hostname))
+	}
+	if existing != nil && existing.ID != tenant.ID {
+		return &ErrorTenantHostnameConflict
+	}
+	return nil
+}
+
+// invalidateCache evicts the cached lookups of a tenant.
+func (ts *tenantService) invalidateCache(ctx context.Context, tenant *Tenant) {
+	_ = ts.tenantCache.Delete(ctx, handleCacheKey(tenant.Handle))
+	if tenant.Hostname != "" {
+		_ = ts.tenantCache.Delete(ctx, hostnameCacheKey(tenant.Hostname))
+	}
+}
+
+// mapStoreError maps a store error to a service error, logging unexpected failures.
+func (ts *tenantService) mapStoreError(err error, field log.Field) *serviceerror.ServiceError {
+	if errors.Is(err, ErrTenantNotFound) {
+		return &ErrorTenantNotFound
+	}
+	ts.logger.Error("Failed to get tenant", log.Error(err), field)
+	return &serviceerror.InternalServerError
+}
+
+// validateTenant validates the attributes of a tenant.
+func validateTenant(tenant Tenant) *serviceerror.ServiceError {
+	if tenant.Name == "" {
+		return &ErrorInvalidTenantName
+	}
+	if !tenantHandlePattern.MatchString(tenant.Handle) {
+		return &ErrorInvalidTenantHandle
+	}
+	return nil
+}
+
+// normalizeHostname lowercases a hostname and strips any port.
+func normalizeHostname(hostname string) string {
+	hostname = strings.ToLower(strings.TrimSpace(hostname))
+	if idx := strings.LastIndex(hostname, ":"); idx != -1 && !strings.HasSuffix(hostname, "]") {
+		hostname = hostname[:idx]
+	}
+	return hostname
+}
+
+// handleCacheKey returns the cache key of a handle lookup.
+func handleCacheKey(handle string) cache.CacheKey {
+	return cache.CacheKey{Key: "handle:" + handle}
+}
+
+// hostnameCacheKey returns the cache key of a hostname lookup.
+func hostnameCacheKey(hostname string) cache.CacheKey {
+	return cache.CacheKey{Key: "hostname:" + hostname}
+}
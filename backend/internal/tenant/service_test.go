@@ -0,0 +1,314 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package tenant
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/ou"
+	"github.com/thunder-id/thunderid/internal/resource"
+	"github.com/thunder-id/thunderid/internal/role"
+	"github.com/thunder-id/thunderid/internal/system/cache"
+	"github.com/thunder-id/thunderid/internal/system/config"
+	sysContext "github.com/thunder-id/thunderid/internal/system/context"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/tests/mocks/cachemock"
+	"github.com/thunder-id/thunderid/tests/mocks/oumock"
+	"github.com/thunder-id/thunderid/tests/mocks/resourcemock"
+	"github.com/thunder-id/thunderid/tests/mocks/rolemock"
+)
+
+type mockTransactioner struct{}
+
+func (m *mockTransactioner) Transact(ctx context.Context, operation func(txCtx context.Context) error) error {
+	return operation(ctx)
+}
+
+type TenantServiceTestSuite struct {
+	suite.Suite
+	mockStore           *tenantStoreInterfaceMock
+	mockCache           *cachemock.CacheInterfaceMock[*Tenant]
+	mockOUService       *oumock.OrganizationUnitServiceInterfaceMock
+	mockResourceService *resourcemock.ResourceServiceInterfaceMock
+	mockRoleService     *rolemock.RoleServiceInterfaceMock
+	service             *tenantService
+}
+
+func TestTenantServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(TenantServiceTestSuite))
+}
+
+func (s *TenantServiceTestSuite) SetupTest() {
+	config.ResetServerRuntime()
+	testConfig := &config.Config{
+		Resource: config.ResourceConfig{
+			SystemResourceServer: config.SystemResourceServerConfig{Identifier: "system"},
+		},
+	}
+	_ = config.InitializeServerRuntime("/tmp/test", testConfig)
+
+	s.mockStore = newTenantStoreInterfaceMock(s.T())
+	s.mockCache = cachemock.NewCacheInterfaceMock[*Tenant](s.T())
+	s.mockOUService = oumock.NewOrganizationUnitServiceInterfaceMock(s.T())
+	s.mockResourceService = resourcemock.NewResourceServiceInterfaceMock(s.T())
+	s.mockRoleService = rolemock.NewRoleServiceInterfaceMock(s.T())
+	s.service = &tenantService{
+		tenantStore:     s.mockStore,
+		tenantCache:     s.mockCache,
+		ouService:       s.mockOUService,
+		resourceService: s.mockResourceService,
+		roleService:     s.mockRoleService,
+		transactioner:   &mockTransactioner{},
+		logger:          log.GetLogger().With(log.String(log.LoggerKeyComponentName, "TenantService")),
+	}
+}
+
+func (s *TenantServiceTestSuite) TearDownTest() {
+	config.ResetServerRuntime()
+}
+
+// inTenant matches contexts scoped to the tenant being created.
+func inTenant(tenantID *string) interface{} {
+	return mock.MatchedBy(func(ctx context.Context) bool {
+		return *tenantID != "" && sysContext.GetTenantID(ctx) == *tenantID
+	})
+}
+
+func (s *TenantServiceTestSuite) TestCreateTenant_Success() {
+	var tenantID string
+	s.mockStore.On("GetTenantByHandle", mock.Anything, "acme").Return(nil, ErrTenantNotFound)
+	s.mockStore.On("GetTenantByHostname", mock.Anything, "acme.example.com").Return(nil, ErrTenantNotFound)
+	s.mockStore.On("CreateTenant", mock.Anything, mock.MatchedBy(func(tenant Tenant) bool {
+		tenantID = tenant.ID
+		return tenant.Handle == "acme" && tenant.Hostname == "acme.example.com"
+	})).Return(nil)
+	s.mockOUService.On("CreateOrganizationUnit", inTenant(&tenantID), mock.MatchedBy(
+		func(request ou.OrganizationUnitRequestWithID) bool {
+			return request.Handle == "acme" && request.Parent == nil
+		})).Return(ou.OrganizationUnit{ID: "ou-1"}, nil)
+	s.mockResourceService.On("CreateResourceServer", inTenant(&tenantID), mock.MatchedBy(
+		func(rs resource.ResourceServer) bool {
+			return rs.OUID == "ou-1" && rs.Identifier == "system"
+		})).Return(&resource.ResourceServer{ID: "rs-1"}, nil)
+	s.mockResourceService.On("CreateResource", inTenant(&tenantID), "rs-1", mock.MatchedBy(
+		func(res resource.Resource) bool {
+			return res.Handle == "system"
+		})).Return(&resource.Resource{ID: "res-1", Permission: "system"}, nil)
+	s.mockRoleService.On("CreateRole", inTenant(&tenantID), mock.MatchedBy(func(detail role.RoleCreationDetail) bool {
+		return detail.OUID == "ou-1" && len(detail.Permissions) == 1 &&
+			detail.Permissions[0].ResourceServerID == "rs-1" && detail.Permissions[0].Permissions[0] == "system"
+	})).Return(&role.RoleWithPermissionsAndAssignments{ID: "role-1"}, nil)
+
+	created, svcErr := s.service.CreateTenant(context.Background(), TenantRequest{
+		Handle: "acme", Name: "Acme", Hostname: "ACME.example.com:8090",
+	})
+
+	s.Nil(svcErr)
+	s.Equal(tenantID, created.ID)
+	s.Equal("acme.example.com", created.Hostname)
+	s.Equal(TenantBootstrapResult{OUID: "ou-1", ResourceServerID: "rs-1", AdminRoleID: "role-1"}, created.Bootstrap)
+}
+
+func (s *TenantServiceTestSuite) TestCreateTenant_ValidationErrors() {
+	testCases := []struct {
+		name     string
+		request  TenantRequest
+		expected string
+	}{
+		{"MissingName", TenantRequest{Handle: "acme"}, ErrorInvalidTenantName.Code},
+		{"EmptyHandle", TenantRequest{Name: "Acme"}, ErrorInvalidTenantHandle.Code},
+		{"UppercaseHandle", TenantRequest{Handle: "Acme", Name: "Acme"}, ErrorInvalidTenantHandle.Code},
+		{"HandleWithSlash", TenantRequest{Handle: "ac/me", Name: "Acme"}, ErrorInvalidTenantHandle.Code},
+		{"TrailingHyphen", TenantRequest{Handle: "acme-", Name: "Acme"}, ErrorInvalidTenantHandle.Code},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			created, svcErr := s.service.CreateTenant(context.Background(), tc.request)
+			s.Nil(created)
+			s.Require().NotNil(svcErr)
+			s.Equal(tc.expected, svcErr.Code)
+		})
+	}
+}
+
+func (s *TenantServiceTestSuite) TestCreateTenant_HandleConflict() {
+	s.mockStore.On("GetTenantByHandle", mock.Anything, "acme").Return(&Tenant{ID: "existing"}, nil)
+
+	created, svcErr := s.service.CreateTenant(context.Background(), TenantRequest{Handle: "acme", Name: "Acme"})
+
+	s.Nil(created)
+	s.Equal(&ErrorTenantHandleConflict, svcErr)
+	s.mockStore.AssertNotCalled(s.T(), "CreateTenant", mock.Anything, mock.Anything)
+}
+
+func (s *TenantServiceTestSuite) TestCreateTenant_HostnameConflict() {
+	s.mockStore.On("GetTenantByHandle", mock.Anything, "acme").Return(nil, ErrTenantNotFound)
+	s.mockStore.On("GetTenantByHostname", mock.Anything, "acme.example.com").
+		Return(&Tenant{ID: "existing"}, nil)
+
+	created, svcErr := s.service.CreateTenant(context.Background(), TenantRequest{
+		Handle: "acme", Name: "Acme", Hostname: "acme.example.com",
+	})
+
+	s.Nil(created)
+	s.Equal(&ErrorTenantHostnameConflict, svcErr)
+}
+
+func (s *TenantServiceTestSuite) TestCreateTenant_BootstrapFailure() {
+	s.mockStore.On("GetTenantByHandle", mock.Anything, "acme").Return(nil, ErrTenantNotFound)
+	s.mockStore.On("CreateTenant", mock.Anything, mock.Anything).Return(nil)
+	s.mockOUService.On("CreateOrganizationUnit", mock.Anything, mock.Anything).
+		Return(ou.OrganizationUnit{}, &ou.ErrorOrganizationUnitHandleConflict)
+
+	created, svcErr := s.service.CreateTenant(context.Background(), TenantRequest{Handle: "acme", Name: "Acme"})
+
+	s.Nil(created)
+	s.Equal(&serviceerror.InternalServerError, svcErr)
+	s.mockResourceService.AssertNotCalled(s.T(), "CreateResourceServer", mock.Anything, mock.Anything)
+}
+
+func (s *TenantServiceTestSuite) TestManagementRejectedWithinTenant() {
+	ctx := sysContext.WithTenantID(context.Background(), "tenant-1")
+
+	_, svcErr := s.service.CreateTenant(ctx, TenantRequest{Handle: "acme", Name: "Acme"})
+	s.Equal(&ErrorTenantManagementNotAllowed, svcErr)
+	_, svcErr = s.service.GetTenantList(ctx)
+	s.Equal(&ErrorTenantManagementNotAllowed, svcErr)
+	_, svcErr = s.service.GetTenant(ctx, "tenant-2")
+	s.Equal(&ErrorTenantManagementNotAllowed, svcErr)
+	_, svcErr = s.service.UpdateTenant(ctx, "tenant-2", TenantRequest{Name: "Acme"})
+	s.Equal(&ErrorTenantManagementNotAllowed, svcErr)
+	s.Equal(&ErrorTenantManagementNotAllowed, s.service.DeleteTenant(ctx, "tenant-2"))
+}
+
+func (s *TenantServiceTestSuite) TestGetTenant() {
+	s.mockStore.On("GetTenant", mock.Anything, "tenant-1").Return(&Tenant{ID: "tenant-1"}, nil).Once()
+	s.mockStore.On("GetTenant", mock.Anything, "missing").Return(nil, ErrTenantNotFound).Once()
+	s.mockStore.On("GetTenant", mock.Anything, "broken").Return(nil, errors.New("db error")).Once()
+
+	tenant, svcErr := s.service.GetTenant(context.Background(), "tenant-1")
+	s.Nil(svcErr)
+	s.Equal("tenant-1", tenant.ID)
+
+	_, svcErr = s.service.GetTenant(context.Background(), "missing")
+	s.Equal(&ErrorTenantNotFound, svcErr)
+
+	_, svcErr = s.service.GetTenant(context.Background(), "broken")
+	s.Equal(&serviceerror.InternalServerError, svcErr)
+
+	_, svcErr = s.service.GetTenant(context.Background(), " ")
+	s.Equal(&ErrorInvalidTenantID, svcErr)
+}
+
+func (s *TenantServiceTestSuite) TestGetTenantByHandle_UsesCache() {
+	cached := &Tenant{ID: "tenant-1", Handle: "acme"}
+	s.mockCache.On("Get", mock.Anything, cache.CacheKey{Key: "handle:acme"}).Return(cached, true).Once()
+
+	tenant, svcErr := s.service.GetTenantByHandle(context.Background(), "acme")
+
+	s.Nil(svcErr)
+	s.Equal(cached, tenant)
+	s.mockStore.AssertNotCalled(s.T(), "GetTenantByHandle", mock.Anything, mock.Anything)
+}
+
+func (s *TenantServiceTestSuite) TestGetTenantByHostname_LoadsAndCaches() {
+	loaded := &Tenant{ID: "tenant-1", Hostname: "acme.example.com"}
+	key := cache.CacheKey{Key: "hostname:acme.example.com"}
+	s.mockCache.On("Get", mock.Anything, key).Return(nil, false).Once()
+	s.mockStore.On("GetTenantByHostname", mock.Anything, "acme.example.com").Return(loaded, nil).Once()
+	s.mockCache.On("Set", mock.Anything, key, loaded).Return(nil).Once()
+
+	tenant, svcErr := s.service.GetTenantByHostname(context.Background(), "Acme.Example.com:443")
+
+	s.Nil(svcErr)
+	s.Equal(loaded, tenant)
+}
+
+func (s *TenantServiceTestSuite) TestGetTenantByHostname_NotFound() {
+	s.mockCache.On("Get", mock.Anything, mock.Anything).Return(nil, false).Once()
+	s.mockStore.On("GetTenantByHostname", mock.Anything, "unknown.example.com").Return(nil, ErrTenantNotFound)
+
+	tenant, svcErr := s.service.GetTenantByHostname(context.Background(), "unknown.example.com")
+
+	s.Nil(tenant)
+	s.Equal(&ErrorTenantNotFound, svcErr)
+	s.mockCache.AssertNotCalled(s.T(), "Set", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (s *TenantServiceTestSuite) TestUpdateTenant_Success() {
+	existing := &Tenant{ID: "tenant-1", Handle: "acme", Name: "Acme", Hostname: "old.example.com"}
+	s.mockStore.On("GetTenant", mock.Anything, "tenant-1").Return(existing, nil)
+	s.mockStore.On("GetTenantByHandle", mock.Anything, "acme").Return(existing, nil)
+	s.mockStore.On("GetTenantByHostname", mock.Anything, "new.example.com").Return(nil, ErrTenantNotFound)
+	s.mockStore.On("UpdateTenant", mock.Anything, mock.MatchedBy(func(tenant *Tenant) bool {
+		return tenant.Handle == "acme" && tenant.Name == "Acme Corp" && tenant.Hostname == "new.example.com"
+	})).Return(nil)
+	s.mockCache.On("Delete", mock.Anything, cache.CacheKey{Key: "handle:acme"}).Return(nil).Once()
+	s.mockCache.On("Delete", mock.Anything, cache.CacheKey{Key: "hostname:old.example.com"}).Return(nil).Once()
+
+	updated, svcErr := s.service.UpdateTenant(context.Background(), "tenant-1", TenantRequest{
+		Name: "Acme Corp", Hostname: "new.example.com",
+	})
+
+	s.Nil(svcErr)
+	s.Equal("Acme Corp", updated.Name)
+}
+
+func (s *TenantServiceTestSuite) TestUpdateTenant_HandleIsImmutable() {
+	s.mockStore.On("GetTenant", mock.Anything, "tenant-1").Return(&Tenant{ID: "tenant-1", Handle: "acme"}, nil)
+
+	updated, svcErr := s.service.UpdateTenant(context.Background(), "tenant-1", TenantRequest{
+		Handle: "other", Name: "Acme",
+	})
+
+	s.Nil(updated)
+	s.Equal(&ErrorInvalidTenantHandle, svcErr)
+	s.mockStore.AssertNotCalled(s.T(), "UpdateTenant", mock.Anything, mock.Anything)
+}
+
+func (s *TenantServiceTestSuite) TestUpdateTenant_NotFound() {
+	s.mockStore.On("GetTenant", mock.Anything, "missing").Return(nil, ErrTenantNotFound)
+
+	updated, svcErr := s.service.UpdateTenant(context.Background(), "missing", TenantRequest{Name: "Acme"})
+
+	s.Nil(updated)
+	s.Equal(&ErrorTenantNotFound, svcErr)
+}
+
+func (s *TenantServiceTestSuite) TestDeleteTenant() {
+	s.mockStore.On("GetTenant", mock.Anything, "tenant-1").Return(&Tenant{ID: "tenant-1", Handle: "acme"}, nil)
+	s.mockStore.On("DeleteTenant", mock.Anything, "tenant-1").Return(nil)
+	s.mockCache.On("Delete", mock.Anything, cache.CacheKey{Key: "handle:acme"}).Return(nil).Once()
+
+	s.Nil(s.service.DeleteTenant(context.Background(), "tenant-1"))
+}
+
+func (s *TenantServiceTestSuite) TestDeleteTenant_NotFoundIsNoOp() {
+	s.mockStore.On("GetTenant", mock.Anything, "missing").Return(nil, ErrTenantNotFound)
+
+	s.Nil(s.service.DeleteTenant(context.Background(), "missing"))
+	s.mockStore.AssertNotCalled(s.T(), "DeleteTenant", mock.Anything, mock.Anything)
+}
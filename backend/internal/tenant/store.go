@@ -0,0 +1,206 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package tenant
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+	dbmodel "github.com/thunder-id/thunderid/internal/system/database/model"
+	"github.com/thunder-id/thunderid/internal/system/database/provider"
+	"github.com/thunder-id/thunderid/internal/system/transaction"
+)
+
+var getDBProvider = provider.GetDBProvider
+
+// tenantStoreInterface defines the interface for tenant store operations.
+type tenantStoreInterface interface {
+	CreateTenant(ctx context.Context, tenant Tenant) error
+	GetTenantList(ctx context.Context) ([]Tenant, error)
+	GetTenant(ctx context.Context, id string) (*Tenant, error)
+	GetTenantByHandle(ctx context.Context, handle string) (*Tenant, error)
+	GetTenantByHostname(ctx context.Context, hostname string) (*Tenant, error)
+	UpdateTenant(ctx context.Context, tenant *Tenant) error
+	DeleteTenant(ctx context.Context, id string) error
+}
+
+// tenantStore is the default implementation of tenantStoreInterface.
+// Tenants themselves are deployment-wide records, so queries are never scoped to the tenant of the context.
+type tenantStore struct {
+	dbProvider   provider.DBProviderInterface
+	deploymentID string
+}
+
+// newTenantStore creates a new instance of tenantStore.
+func newTenantStore() (tenantStoreInterface, transaction.Transactioner, error) {
+	dbProvider := getDBProvider()
+	client, err := dbProvider.GetConfigDBClient()
+	if err != nil {
+		return nil, nil, err
+	}
+	transactioner, err := client.GetTransactioner()
+	if err != nil {
+		return nil, nil, err
+	}
+	return &tenantStore{
+		dbProvider:   dbProvider,
+		deploymentID: config.GetServerRuntime().Config.Server.Identifier,
+	}, transactioner, nil
+}
+
+// CreateTenant persists a new tenant.
+func (s *tenantStore) CreateTenant(ctx context.Context, tenant Tenant) error {
+	dbClient, err := s.dbProvider.GetConfigDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	_, err = dbClient.ExecuteContext(ctx, queryCreateTenant, tenant.ID, tenant.Handle, tenant.Name,
+		tenant.Description, toNullableString(tenant.Hostname), s.deploymentID)
+	if err != nil {
+		return fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	return nil
+}
+
+// GetTenantList retrieves all tenants ordered by handle.
+func (s *tenantStore) GetTenantList(ctx context.Context) ([]Tenant, error) {
+	dbClient, err := s.dbProvider.GetConfigDBClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	results, err := dbClient.QueryContext(ctx, queryGetTenantList, s.deploymentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	tenants := make([]Tenant, 0, len(results))
+	for _, row := range results {
+		tenant, err := buildTenantFromResultRow(row)
+		if err != nil {
+			return nil, fmt.Errorf("failed to build tenant from result row: %w", err)
+		}
+		tenants = append(tenants, *tenant)
+	}
+
+	return tenants, nil
+}
+
+// GetTenant retrieves a tenant by its ID.
+func (s *tenantStore) GetTenant(ctx context.Context, id string) (*Tenant, error) {
+	return s.getTenant(ctx, queryGetTenantByID, id)
+}
+
+// GetTenantByHandle retrieves a tenant by its handle.
+func (s *tenantStore) GetTenantByHandle(ctx context.Context, handle string) (*Tenant, error) {
+	return s.getTenant(ctx, queryGetTenantByHandle, handle)
+}
+
+// GetTenantByHostname retrieves a tenant by its hostname.
+func (s *tenantStore) GetTenantByHostname(ctx context.Context, hostname string) (*Tenant, error) {
+	return s.getTenant(ctx, queryGetTenantByHostname, hostname)
+}
+
+// getTenant retrieves a single tenant using the provided query and identifier.
+func (s *tenantStore) getTenant(ctx context.Context, query dbmodel.DBQuery, identifier string) (*Tenant, error) {
+	dbClient, err := s.dbProvider.GetConfigDBClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	results, err := dbClient.QueryContext(ctx, query, identifier, s.deploymentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+	if len(results) == 0 {
+		return nil, ErrTenantNotFound
+	}
+	if len(results) != 1 {
+		return nil, fmt.Errorf("unexpected number of results: %d", len(results))
+	}
+
+	return buildTenantFromResultRow(results[0])
+}
+
+// UpdateTenant updates the mutable attributes of a tenant.
+func (s *tenantStore) UpdateTenant(ctx context.Context, tenant *Tenant) error {
+	dbClient, err := s.dbProvider.GetConfigDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	_, err = dbClient.ExecuteContext(ctx, queryUpdateTenantByID, tenant.ID, tenant.Name, tenant.Description,
+		toNullableString(tenant.Hostname), s.deploymentID)
+	if err != nil {
+		return fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteTenant deletes a tenant by its ID.
+func (s *tenantStore) DeleteTenant(ctx context.Context, id string) error {
+	dbClient, err := s.dbProvider.GetConfigDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	if _, err := dbClient.ExecuteContext(ctx, queryDeleteTenantByID, id, s.deploymentID); err != nil {
+		return fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	return nil
+}
+
+// buildTenantFromResultRow constructs a Tenant from a database result row.
+func buildTenantFromResultRow(row map[string]interface{}) (*Tenant, error) {
+	id, ok := row["id"].(string)
+	if !ok {
+		return nil, fmt.Errorf("failed to parse id as string")
+	}
+	handle, ok := row["handle"].(string)
+	if !ok {
+		return nil, fmt.Errorf("failed to parse handle as string")
+	}
+	name, ok := row["name"].(string)
+	if !ok {
+		return nil, fmt.Errorf("failed to parse name as string")
+	}
+	description, _ := row["description"].(string)
+	hostname, _ := row["hostname"].(string)
+
+	return &Tenant{
+		ID:          id,
+		Handle:      handle,
+		Name:        name,
+		Description: description,
+		Hostname:    hostname,
+	}, nil
+}
+
+// toNullableString maps an empty string to a SQL NULL so that unique lookups ignore unset values.
+func toNullableString(value string) interface{} {
+	if value == "" {
+		return nil
+	}
+	return value
+}
@@ -0,0 +1,63 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package tenant
+
+import "github.com/thunder-id/thunderid/internal/system/database/model"
+
+var (
+	// queryCreateTenant is the query to create a new tenant.
+	queryCreateTenant = model.DBQuery{
+		ID: "TNQ-TENANT_MGT-01",
+		Query: `INSERT INTO "TENANT" (ID, HANDLE, NAME, DESCRIPTION, HOSTNAME, DEPLOYMENT_ID) ` +
+			`VALUES ($1, $2, $3, $4, $5, $6)`,
+	}
+	// queryGetTenantByID is the query to get a tenant by its ID.
+	queryGetTenantByID = model.DBQuery{
+		ID:    "TNQ-TENANT_MGT-02",
+		Query: `SELECT ID, HANDLE, NAME, DESCRIPTION, HOSTNAME FROM "TENANT" WHERE ID = $1 AND DEPLOYMENT_ID = $2`,
+	}
+	// queryGetTenantList is the query to get the list of tenants.
+	queryGetTenantList = model.DBQuery{
+		ID:    "TNQ-TENANT_MGT-03",
+		Query: `SELECT ID, HANDLE, NAME, DESCRIPTION, HOSTNAME FROM "TENANT" WHERE DEPLOYMENT_ID = $1 ORDER BY HANDLE`,
+	}
+	// queryUpdateTenantByID is the query to update a tenant by its ID.
+	queryUpdateTenantByID = model.DBQuery{
+		ID: "TNQ-TENANT_MGT-04",
+		Query: `UPDATE "TENANT" SET NAME = $2, DESCRIPTION = $3, HOSTNAME = $4 ` +
+			`WHERE ID = $1 AND DEPLOYMENT_ID = $5`,
+	}
+	// queryDeleteTenantByID is the query to delete a tenant by its ID.
+	queryDeleteTenantByID = model.DBQuery{
+		ID:    "TNQ-TENANT_MGT-05",
+		Query: `DELETE FROM "TENANT" WHERE ID = $1 AND DEPLOYMENT_ID = $2`,
+	}
+	// queryGetTenantByHandle is the query to get a tenant by its handle.
+	queryGetTenantByHandle = model.DBQuery{
+		ID: "TNQ-TENANT_MGT-06",
+		Query: `SELECT ID, HANDLE, NAME, DESCRIPTION, HOSTNAME FROM "TENANT" ` +
+			`WHERE HANDLE = $1 AND DEPLOYMENT_ID = $2`,
+	}
+	// queryGetTenantByHostname is the query to get a tenant by its hostname.
+	queryGetTenantByHostname = model.DBQuery{
+		ID: "TNQ-TENANT_MGT-07",
+		Query: `SELECT ID, HANDLE, NAME, DESCRIPTION, HOSTNAME FROM "TENANT" ` +
+			`WHERE HOSTNAME = $1 AND DEPLOYMENT_ID = $2`,
+	}
+)
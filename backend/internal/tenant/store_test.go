@@ -0,0 +1,119 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package tenant
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	sysContext "github.com/thunder-id/thunderid/internal/system/context"
+	"github.com/thunder-id/thunderid/tests/mocks/database/providermock"
+)
+
+const testDeploymentID = "test-deployment-id"
+
+type TenantStoreTestSuite struct {
+	suite.Suite
+	mockDBProvider *providermock.DBProviderInterfaceMock
+	mockDBClient   *providermock.DBClientInterfaceMock
+	store          *tenantStore
+}
+
+func TestTenantStoreTestSuite(t *testing.T) {
+	suite.Run(t, new(TenantStoreTestSuite))
+}
+
+func (s *TenantStoreTestSuite) SetupTest() {
+	s.mockDBProvider = providermock.NewDBProviderInterfaceMock(s.T())
+	s.mockDBClient = providermock.NewDBClientInterfaceMock(s.T())
+	s.mockDBProvider.On("GetConfigDBClient").Return(s.mockDBClient, nil).Maybe()
+	s.store = &tenantStore{
+		dbProvider:   s.mockDBProvider,
+		deploymentID: testDeploymentID,
+	}
+}
+
+func (s *TenantStoreTestSuite) TestCreateTenant_IgnoresTenantScope() {
+	s.mockDBClient.On("ExecuteContext", mock.Anything, queryCreateTenant, "tenant-1", "acme", "Acme", "",
+		nil, testDeploymentID).Return(int64(1), nil)
+
+	ctx := sysContext.WithTenantID(context.Background(), "other-tenant")
+	err := s.store.CreateTenant(ctx, Tenant{ID: "tenant-1", Handle: "acme", Name: "Acme"})
+
+	s.NoError(err)
+}
+
+func (s *TenantStoreTestSuite) TestGetTenantByHostname_Success() {
+	s.mockDBClient.On("QueryContext", mock.Anything, queryGetTenantByHostname, "acme.example.com",
+		testDeploymentID).Return([]map[string]interface{}{
+		{"id": "tenant-1", "handle": "acme", "name": "Acme", "description": nil, "hostname": "acme.example.com"},
+	}, nil)
+
+	tenant, err := s.store.GetTenantByHostname(context.Background(), "acme.example.com")
+
+	s.NoError(err)
+	s.Equal(&Tenant{ID: "tenant-1", Handle: "acme", Name: "Acme", Hostname: "acme.example.com"}, tenant)
+}
+
+func (s *TenantStoreTestSuite) TestGetTenantByHandle_NotFound() {
+	s.mockDBClient.On("QueryContext", mock.Anything, queryGetTenantByHandle, "acme", testDeploymentID).
+		Return([]map[string]interface{}{}, nil)
+
+	tenant, err := s.store.GetTenantByHandle(context.Background(), "acme")
+
+	s.Nil(tenant)
+	s.ErrorIs(err, ErrTenantNotFound)
+}
+
+func (s *TenantStoreTestSuite) TestGetTenantList() {
+	s.mockDBClient.On("QueryContext", mock.Anything, queryGetTenantList, testDeploymentID).
+		Return([]map[string]interface{}{
+			{"id": "tenant-1", "handle": "acme", "name": "Acme"},
+			{"id": "tenant-2", "handle": "globex", "name": "Globex", "hostname": "globex.example.com"},
+		}, nil)
+
+	tenants, err := s.store.GetTenantList(context.Background())
+
+	s.NoError(err)
+	s.Len(tenants, 2)
+	s.Equal("globex.example.com", tenants[1].Hostname)
+}
+
+func (s *TenantStoreTestSuite) TestGetTenantList_InvalidRow() {
+	s.mockDBClient.On("QueryContext", mock.Anything, queryGetTenantList, testDeploymentID).
+		Return([]map[string]interface{}{{"id": 1}}, nil)
+
+	tenants, err := s.store.GetTenantList(context.Background())
+
+	s.Nil(tenants)
+	s.Error(err)
+}
+
+func (s *TenantStoreTestSuite) TestDeleteTenant_QueryError() {
+	s.mockDBClient.On("ExecuteContext", mock.Anything, queryDeleteTenantByID, "tenant-1", testDeploymentID).
+		Return(int64(0), errors.New("db error"))
+
+	err := s.store.DeleteTenant(context.Background(), "tenant-1")
+
+	s.ErrorContains(err, "db error")
+}
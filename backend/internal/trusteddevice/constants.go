@@ -0,0 +1,51 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package trusteddevice
+
+const (
+	// loggerComponentName is the component name used in trusted device logs.
+	loggerComponentName = "TrustedDeviceService"
+	// handlerLoggerComponentName is the component name used in trusted device handler logs.
+	handlerLoggerComponentName = "TrustedDeviceHandler"
+
+	// tokenAudience is the audience of trusted device tokens.
+	tokenAudience = "trusted-device"
+	// claimDeviceID is the claim holding the trusted device ID in a trusted device token.
+	claimDeviceID = "did"
+
+	// defaultValidityPeriod is the default number of seconds a device remains trusted.
+	defaultValidityPeriod = int64(30 * 24 * 60 * 60)
+	// defaultMaxDevicesPerUser is the default maximum number of trusted devices kept for a user.
+	defaultMaxDevicesPerUser = 10
+
+	// maxDeviceNameLength is the maximum length of the stored device name.
+	maxDeviceNameLength = 255
+)
+
+const (
+	// CookieName is the name of the cookie holding the trusted device token.
+	CookieName = "thunder_trusted_device"
+	// CookiePath is the path of the trusted device cookie. The cookie is only sent to the flow endpoints.
+	CookiePath = "/flow"
+	// HeaderDeviceFingerprint is an optional header carrying a client computed device fingerprint.
+	HeaderDeviceFingerprint = "X-Device-Fingerprint"
+	// AdditionalDataKeyDeviceToken is the flow additional data key used to hand a newly issued trusted
+	// device token to the flow execution handler, which moves it into the trusted device cookie.
+	AdditionalDataKeyDeviceToken = "trustedDeviceToken"
+)
@@ -0,0 +1,88 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package trusteddevice
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"strings"
+)
+
+type contextKey string
+
+// deviceInfoKey is the context key for storing the trusted device details of a request.
+const deviceInfoKey contextKey = "trusted_device_info"
+
+// WithDeviceInfo adds the trusted device details of a request to the context.
+func WithDeviceInfo(ctx context.Context, info DeviceInfo) context.Context {
+	return context.WithValue(ctx, deviceInfoKey, info)
+}
+
+// GetDeviceInfo retrieves the trusted device details of a request from the context.
+// Returns an empty DeviceInfo if not present.
+func GetDeviceInfo(ctx context.Context) DeviceInfo {
+	if ctx == nil {
+		return DeviceInfo{}
+	}
+	info, _ := ctx.Value(deviceInfoKey).(DeviceInfo)
+	return info
+}
+
+// DeviceInfoFromRequest extracts the trusted device token and fingerprint from an HTTP request.
+// The fingerprint binds a trusted device token to the browser it was issued to.
+func DeviceInfoFromRequest(r *http.Request) DeviceInfo {
+	info := DeviceInfo{
+		Fingerprint: computeFingerprint(r.UserAgent(), r.Header.Get(HeaderDeviceFingerprint)),
+		Name:        truncate(strings.TrimSpace(r.UserAgent()), maxDeviceNameLength),
+	}
+	if cookie, err := r.Cookie(CookieName); err == nil {
+		info.Token = cookie.Value
+	}
+	return info
+}
+
+// NewDeviceCookie creates the cookie holding a trusted device token.
+func NewDeviceCookie(token string, maxAge int64) *http.Cookie {
+	return &http.Cookie{
+		Name:     CookieName,
+		Value:    token,
+		Path:     CookiePath,
+		MaxAge:   int(maxAge),
+		HttpOnly: true,
+		Secure:   true,
+		SameSite: http.SameSiteNoneMode,
+	}
+}
+
+// computeFingerprint derives a device fingerprint from the user agent and an optional client computed
+// fingerprint.
+func computeFingerprint(userAgent, clientFingerprint string) string {
+	sum := sha256.Sum256([]byte(strings.TrimSpace(userAgent) + "\n" + strings.TrimSpace(clientFingerprint)))
+	return hex.EncodeToString(sum[:])
+}
+
+// truncate shortens a string to at most maxLength bytes.
+func truncate(value string, maxLength int) string {
+	if len(value) > maxLength {
+		return value[:maxLength]
+	}
+	return value
+}
@@ -0,0 +1,85 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package trusteddevice
+
+import (
+	"errors"
+
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/i18n/core"
+)
+
+// ErrTrustedDeviceNotFound is returned when the trusted device is not found in the store.
+var ErrTrustedDeviceNotFound = errors.New("trusted device not found")
+
+// Client errors for trusted device operations.
+var (
+	// ErrorMissingUserID is the error returned when the user ID is missing.
+	ErrorMissingUserID = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "TDV-1001",
+		Error: core.I18nMessage{
+			Key:          "error.trusteddeviceservice.missing_user_id",
+			DefaultValue: "Missing user ID",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.trusteddeviceservice.missing_user_id_description",
+			DefaultValue: "The user ID must be provided",
+		},
+	}
+	// ErrorUserNotFound is the error returned when the user is not found.
+	ErrorUserNotFound = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "TDV-1002",
+		Error: core.I18nMessage{
+			Key:          "error.trusteddeviceservice.user_not_found",
+			DefaultValue: "User not found",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.trusteddeviceservice.user_not_found_description",
+			DefaultValue: "The user could not be found",
+		},
+	}
+	// ErrorTrustedDeviceNotFound is the error returned when a trusted device is not found.
+	ErrorTrustedDeviceNotFound = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "TDV-1003",
+		Error: core.I18nMessage{
+			Key:          "error.trusteddeviceservice.device_not_found",
+			DefaultValue: "Trusted device not found",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.trusteddeviceservice.device_not_found_description",
+			DefaultValue: "The requested trusted device could not be found",
+		},
+	}
+	// ErrorAuthenticationFailed is the error returned when the caller is not authenticated.
+	ErrorAuthenticationFailed = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "TDV-1004",
+		Error: core.I18nMessage{
+			Key:          "error.trusteddeviceservice.authentication_failed",
+			DefaultValue: "Authentication failed",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.trusteddeviceservice.authentication_failed_description",
+			DefaultValue: "The caller could not be identified",
+		},
+	}
+)
@@ -0,0 +1,141 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package trusteddevice
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/internal/system/security"
+	sysutils "github.com/thunder-id/thunderid/internal/system/utils"
+)
+
+// trustedDeviceHandler is the handler for trusted device management operations.
+type trustedDeviceHandler struct {
+	trustedDeviceService TrustedDeviceServiceInterface
+}
+
+// newTrustedDeviceHandler creates a new instance of trustedDeviceHandler.
+func newTrustedDeviceHandler(trustedDeviceService TrustedDeviceServiceInterface) *trustedDeviceHandler {
+	return &trustedDeviceHandler{
+		trustedDeviceService: trustedDeviceService,
+	}
+}
+
+// HandleSelfListRequest handles the request to list the trusted devices of the authenticated user.
+func (h *trustedDeviceHandler) HandleSelfListRequest(w http.ResponseWriter, r *http.Request) {
+	userID, ok := getSelfUserID(w, r)
+	if !ok {
+		return
+	}
+	h.writeDeviceList(w, r, userID)
+}
+
+// HandleSelfRevokeRequest handles the request to revoke a trusted device of the authenticated user.
+func (h *trustedDeviceHandler) HandleSelfRevokeRequest(w http.ResponseWriter, r *http.Request) {
+	userID, ok := getSelfUserID(w, r)
+	if !ok {
+		return
+	}
+	h.revokeDevice(w, r, userID, r.PathValue("id"))
+}
+
+// HandleSelfRevokeAllRequest handles the request to revoke all trusted devices of the authenticated user.
+func (h *trustedDeviceHandler) HandleSelfRevokeAllRequest(w http.ResponseWriter, r *http.Request) {
+	userID, ok := getSelfUserID(w, r)
+	if !ok {
+		return
+	}
+	h.revokeAllDevices(w, r, userID)
+}
+
+// HandleListRequest handles the request to list the trusted devices of the user given in the userId
+// query parameter.
+func (h *trustedDeviceHandler) HandleListRequest(w http.ResponseWriter, r *http.Request) {
+	h.writeDeviceList(w, r, sysutils.SanitizeString(r.URL.Query().Get("userId")))
+}
+
+// HandleRevokeRequest handles the request to revoke a trusted device.
+func (h *trustedDeviceHandler) HandleRevokeRequest(w http.ResponseWriter, r *http.Request) {
+	h.revokeDevice(w, r, "", r.PathValue("id"))
+}
+
+// HandleRevokeAllRequest handles the request to revoke all trusted devices of the user given in the
+// userId query parameter.
+func (h *trustedDeviceHandler) HandleRevokeAllRequest(w http.ResponseWriter, r *http.Request) {
+	h.revokeAllDevices(w, r, sysutils.SanitizeString(r.URL.Query().Get("userId")))
+}
+
+// writeDeviceList writes the trusted devices of a user to the response.
+func (h *trustedDeviceHandler) writeDeviceList(w http.ResponseWriter, r *http.Request, userID string) {
+	devices, svcErr := h.trustedDeviceService.ListDevices(r.Context(), userID)
+	if svcErr != nil {
+		sysutils.WriteServiceErrorResponse(w, svcErr, clientErrorStatusCodes)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(w, http.StatusOK, devices)
+}
+
+// revokeDevice revokes a trusted device and writes the response.
+func (h *trustedDeviceHandler) revokeDevice(w http.ResponseWriter, r *http.Request, userID, deviceID string) {
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, handlerLoggerComponentName))
+
+	if svcErr := h.trustedDeviceService.RevokeDevice(r.Context(), userID, deviceID); svcErr != nil {
+		sysutils.WriteServiceErrorResponse(w, svcErr, clientErrorStatusCodes)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+	logger.Debug("Trusted device revoke response sent", log.String("deviceID", deviceID))
+}
+
+// revokeAllDevices revokes all trusted devices of a user and writes the response.
+func (h *trustedDeviceHandler) revokeAllDevices(w http.ResponseWriter, r *http.Request, userID string) {
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, handlerLoggerComponentName))
+
+	if svcErr := h.trustedDeviceService.RevokeAllDevices(r.Context(), userID); svcErr != nil {
+		sysutils.WriteServiceErrorResponse(w, svcErr, clientErrorStatusCodes)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+	logger.Debug("Trusted devices revoke response sent", log.MaskedString(log.LoggerKeyUserID, userID))
+}
+
+// getSelfUserID returns the ID of the authenticated user, writing an error response if it is missing.
+func getSelfUserID(w http.ResponseWriter, r *http.Request) (string, bool) {
+	userID := security.GetSubject(r.Context())
+	if strings.TrimSpace(userID) == "" {
+		sysutils.WriteServiceErrorResponse(w, &ErrorAuthenticationFailed, clientErrorStatusCodes)
+		return "", false
+	}
+	return userID, true
+}
+
+// clientErrorStatusCodes maps the client errors of the handler to HTTP status codes. Other client errors are
+// returned with 400 Bad Request.
+var clientErrorStatusCodes = map[string]int{
+	ErrorUserNotFound.Code:              http.StatusNotFound,
+	ErrorTrustedDeviceNotFound.Code:     http.StatusNotFound,
+	ErrorAuthenticationFailed.Code:      http.StatusUnauthorized,
+	serviceerror.ErrorUnauthorized.Code: http.StatusForbidden,
+}
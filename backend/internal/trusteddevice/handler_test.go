@@ -0,0 +1,147 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package trusteddevice
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/system/error/apierror"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/security"
+)
+
+type HandlerTestSuite struct {
+	suite.Suite
+	mockService *TrustedDeviceServiceInterfaceMock
+	handler     *trustedDeviceHandler
+}
+
+func TestHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(HandlerTestSuite))
+}
+
+func (s *HandlerTestSuite) SetupTest() {
+	s.mockService = NewTrustedDeviceServiceInterfaceMock(s.T())
+	s.handler = newTrustedDeviceHandler(s.mockService)
+}
+
+func (s *HandlerTestSuite) newSelfRequest(method, target string) *http.Request {
+	req := httptest.NewRequest(method, target, nil)
+	authCtx := security.NewSecurityContextForTest(testUserID, "", "", nil, nil)
+	return req.WithContext(security.WithSecurityContextTest(req.Context(), authCtx))
+}
+
+func (s *HandlerTestSuite) TestHandleSelfListRequest() {
+	s.mockService.On("ListDevices", mock.Anything, testUserID).Return(&TrustedDeviceListResponse{
+		TotalResults: 1,
+		Devices:      []TrustedDevice{{ID: testDeviceID, UserID: testUserID, FingerprintHash: testFingerprint}},
+	}, nil).Once()
+
+	rr := httptest.NewRecorder()
+	s.handler.HandleSelfListRequest(rr, s.newSelfRequest(http.MethodGet, "/users/me/trusted-devices"))
+
+	s.Equal(http.StatusOK, rr.Code)
+	s.NotContains(rr.Body.String(), testFingerprint)
+	var body TrustedDeviceListResponse
+	s.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &body))
+	s.Equal(testDeviceID, body.Devices[0].ID)
+}
+
+func (s *HandlerTestSuite) TestHandleSelfListRequest_Unauthenticated() {
+	rr := httptest.NewRecorder()
+	s.handler.HandleSelfListRequest(rr, httptest.NewRequest(http.MethodGet, "/users/me/trusted-devices", nil))
+
+	s.Equal(http.StatusUnauthorized, rr.Code)
+}
+
+func (s *HandlerTestSuite) TestHandleSelfRevokeRequest() {
+	s.mockService.On("RevokeDevice", mock.Anything, testUserID, testDeviceID).Return(nil).Once()
+
+	req := s.newSelfRequest(http.MethodDelete, "/users/me/trusted-devices/"+testDeviceID)
+	req.SetPathValue("id", testDeviceID)
+	rr := httptest.NewRecorder()
+	s.handler.HandleSelfRevokeRequest(rr, req)
+
+	s.Equal(http.StatusNoContent, rr.Code)
+}
+
+func (s *HandlerTestSuite) TestHandleSelfRevokeAllRequest() {
+	s.mockService.On("RevokeAllDevices", mock.Anything, testUserID).Return(nil).Once()
+
+	rr := httptest.NewRecorder()
+	s.handler.HandleSelfRevokeAllRequest(rr, s.newSelfRequest(http.MethodDelete, "/users/me/trusted-devices"))
+
+	s.Equal(http.StatusNoContent, rr.Code)
+}
+
+func (s *HandlerTestSuite) TestHandleListRequest() {
+	s.mockService.On("ListDevices", mock.Anything, testUserID).
+		Return(&TrustedDeviceListResponse{Devices: []TrustedDevice{}}, nil).Once()
+
+	rr := httptest.NewRecorder()
+	s.handler.HandleListRequest(rr, httptest.NewRequest(http.MethodGet, "/trusted-devices?userId="+testUserID, nil))
+
+	s.Equal(http.StatusOK, rr.Code)
+}
+
+func (s *HandlerTestSuite) TestHandleRevokeAllRequest() {
+	s.mockService.On("RevokeAllDevices", mock.Anything, testUserID).Return(nil).Once()
+
+	rr := httptest.NewRecorder()
+	s.handler.HandleRevokeAllRequest(rr,
+		httptest.NewRequest(http.MethodDelete, "/trusted-devices?userId="+testUserID, nil))
+
+	s.Equal(http.StatusNoContent, rr.Code)
+}
+
+func (s *HandlerTestSuite) TestHandleRevokeRequest_ErrorStatusCodes() {
+	testCases := []struct {
+		name           string
+		svcErr         *serviceerror.ServiceError
+		expectedStatus int
+	}{
+		{"NotFound", &ErrorTrustedDeviceNotFound, http.StatusNotFound},
+		{"UserNotFound", &ErrorUserNotFound, http.StatusNotFound},
+		{"MissingUserID", &ErrorMissingUserID, http.StatusBadRequest},
+		{"Unauthorized", &serviceerror.ErrorUnauthorized, http.StatusForbidden},
+		{"ServerError", &serviceerror.InternalServerError, http.StatusInternalServerError},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.mockService.On("RevokeDevice", mock.Anything, "", testDeviceID).Return(tc.svcErr).Once()
+
+			req := httptest.NewRequest(http.MethodDelete, "/trusted-devices/"+testDeviceID, nil)
+			req.SetPathValue("id", testDeviceID)
+			rr := httptest.NewRecorder()
+			s.handler.HandleRevokeRequest(rr, req)
+
+			s.Equal(tc.expectedStatus, rr.Code)
+			var errResp apierror.ErrorResponse
+			s.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &errResp))
+			s.Equal(tc.svcErr.Code, errResp.Code)
+		})
+	}
+}
@@ -0,0 +1,91 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package trusteddevice
+
+import (
+	"net/http"
+
+	"github.com/thunder-id/thunderid/internal/entityprovider"
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/jose/jwt"
+	"github.com/thunder-id/thunderid/internal/system/middleware"
+	"github.com/thunder-id/thunderid/internal/system/sysauthz"
+)
+
+// Initialize initializes the trusted device service and registers its routes.
+func Initialize(
+	mux *http.ServeMux,
+	jwtService jwt.JWTServiceInterface,
+	entityProvider entityprovider.EntityProviderInterface,
+	authzService sysauthz.SystemAuthorizationServiceInterface,
+) TrustedDeviceServiceInterface {
+	trustedDeviceConfig := config.GetServerRuntime().Config.TrustedDevice
+	trustedDeviceService := newTrustedDeviceService(newTrustedDeviceStore(), jwtService, entityProvider,
+		authzService, trustedDeviceConfig.ValidityPeriod, trustedDeviceConfig.MaxDevicesPerUser)
+
+	trustedDeviceHandler := newTrustedDeviceHandler(trustedDeviceService)
+	registerRoutes(mux, trustedDeviceHandler)
+
+	return trustedDeviceService
+}
+
+// registerRoutes registers the routes for trusted device management operations.
+func registerRoutes(mux *http.ServeMux, trustedDeviceHandler *trustedDeviceHandler) {
+	opts1 := middleware.CORSOptions{
+		AllowedMethods:   []string{"GET", "DELETE"},
+		AllowedHeaders:   middleware.DefaultAllowedHeaders,
+		AllowCredentials: true,
+		MaxAge:           600,
+	}
+	mux.HandleFunc(middleware.WithCORS("GET /users/me/trusted-devices",
+		trustedDeviceHandler.HandleSelfListRequest, opts1))
+	mux.HandleFunc(middleware.WithCORS("DELETE /users/me/trusted-devices",
+		trustedDeviceHandler.HandleSelfRevokeAllRequest, opts1))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS /users/me/trusted-devices",
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, opts1))
+	mux.HandleFunc(middleware.WithCORS("GET /trusted-devices",
+		trustedDeviceHandler.HandleListRequest, opts1))
+	mux.HandleFunc(middleware.WithCORS("DELETE /trusted-devices",
+		trustedDeviceHandler.HandleRevokeAllRequest, opts1))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS /trusted-devices",
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, opts1))
+
+	opts2 := middleware.CORSOptions{
+		AllowedMethods:   []string{"DELETE"},
+		AllowedHeaders:   middleware.DefaultAllowedHeaders,
+		AllowCredentials: true,
+		MaxAge:           600,
+	}
+	mux.HandleFunc(middleware.WithCORS("DELETE /users/me/trusted-devices/{id}",
+		trustedDeviceHandler.HandleSelfRevokeRequest, opts2))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS /users/me/trusted-devices/{id}",
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, opts2))
+	mux.HandleFunc(middleware.WithCORS("DELETE /trusted-devices/{id}",
+		trustedDeviceHandler.HandleRevokeRequest, opts2))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS /trusted-devices/{id}",
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, opts2))
+}
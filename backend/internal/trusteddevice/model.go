@@ -0,0 +1,50 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package trusteddevice provides registration and management of trusted devices that can skip
+// multi-factor authentication for a limited time.
+package trusteddevice
+
+import "time"
+
+// TrustedDevice represents a device a user chose to trust.
+type TrustedDevice struct {
+	ID              string    `json:"id"`
+	UserID          string    `json:"userId"`
+	Name            string    `json:"name,omitempty"`
+	FingerprintHash string    `json:"-"`
+	CreatedAt       time.Time `json:"createdAt"`
+	LastUsedAt      time.Time `json:"lastUsedAt"`
+	ExpiresAt       time.Time `json:"expiresAt"`
+}
+
+// TrustedDeviceListResponse represents the response for listing the trusted devices of a user.
+type TrustedDeviceListResponse struct {
+	TotalResults int             `json:"totalResults"`
+	Devices      []TrustedDevice `json:"devices"`
+}
+
+// DeviceInfo holds the trusted device details presented with a request.
+type DeviceInfo struct {
+	// Token is the trusted device token presented by the client, if any.
+	Token string
+	// Fingerprint is the device fingerprint derived from the request.
+	Fingerprint string
+	// Name is a human readable name of the device, derived from the user agent.
+	Name string
+}
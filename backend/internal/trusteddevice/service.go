@@ -0,0 +1,327 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package trusteddevice
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/entityprovider"
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/jose/jwt"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/internal/system/security"
+	"github.com/thunder-id/thunderid/internal/system/sysauthz"
+	"github.com/thunder-id/thunderid/internal/system/utils"
+)
+
+// TrustedDeviceServiceInterface defines the interface for the trusted device service.
+type TrustedDeviceServiceInterface interface {
+	RegisterDevice(ctx context.Context, userID string, info DeviceInfo) (string, *TrustedDevice,
+		*serviceerror.ServiceError)
+	VerifyDevice(ctx context.Context, userID string, info DeviceInfo) (bool, *serviceerror.ServiceError)
+	GetValidityPeriod() int64
+	ListDevices(ctx context.Context, userID string) (*TrustedDeviceListResponse, *serviceerror.ServiceError)
+	RevokeDevice(ctx context.Context, userID, deviceID string) *serviceerror.ServiceError
+	RevokeAllDevices(ctx context.Context, userID string) *serviceerror.ServiceError
+}
+
+// trustedDeviceService is the default implementation of the TrustedDeviceServiceInterface.
+type trustedDeviceService struct {
+	store             trustedDeviceStoreInterface
+	jwtService        jwt.JWTServiceInterface
+	entityProvider    entityprovider.EntityProviderInterface
+	authzService      sysauthz.SystemAuthorizationServiceInterface
+	validityPeriod    int64
+	maxDevicesPerUser int
+	now               func() time.Time
+	logger            *log.Logger
+}
+
+// newTrustedDeviceService creates a new instance of trustedDeviceService.
+func newTrustedDeviceService(
+	store trustedDeviceStoreInterface,
+	jwtService jwt.JWTServiceInterface,
+	entityProvider entityprovider.EntityProviderInterface,
+	authzService sysauthz.SystemAuthorizationServiceInterface,
+	validityPeriod int64,
+	maxDevicesPerUser int,
+) TrustedDeviceServiceInterface {
+	if validityPeriod <= 0 {
+		validityPeriod = defaultValidityPeriod
+	}
+	if maxDevicesPerUser <= 0 {
+		maxDevicesPerUser = defaultMaxDevicesPerUser
+	}
+
+	return &trustedDeviceService{
+		store:             store,
+		jwtService:        jwtService,
+		entityProvider:    entityProvider,
+		authzService:      authzService,
+		validityPeriod:    validityPeriod,
+		maxDevicesPerUser: maxDevicesPerUser,
+		now:               time.Now,
+		logger:            log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName)),
+	}
+}
+
+// RegisterDevice registers the device of the request as trusted for the user and returns a signed
+// trusted device token bound to the user and the device fingerprint.
+// When the user already has the maximum number of trusted devices, the least recently used one is removed.
+func (s *trustedDeviceService) RegisterDevice(
+	ctx context.Context, userID string, info DeviceInfo,
+) (string, *TrustedDevice, *serviceerror.ServiceError) {
+	if userID == "" {
+		return "", nil, &ErrorMissingUserID
+	}
+
+	deviceID, err := utils.GenerateUUIDv7()
+	if err != nil {
+		s.logger.Error("Failed to generate trusted device ID", log.Error(err))
+		return "", nil, &serviceerror.InternalServerError
+	}
+
+	now := s.now().UTC()
+	device := TrustedDevice{
+		ID:              deviceID,
+		UserID:          userID,
+		Name:            info.Name,
+		FingerprintHash: info.Fingerprint,
+		CreatedAt:       now,
+		LastUsedAt:      now,
+		ExpiresAt:       now.Add(time.Duration(s.validityPeriod) * time.Second),
+	}
+
+	if svcErr := s.evictExcessDevices(ctx, userID); svcErr != nil {
+		return "", nil, svcErr
+	}
+	if err := s.store.CreateDevice(ctx, device); err != nil {
+		s.logger.Error("Failed to store trusted device", log.MaskedString(log.LoggerKeyUserID, userID),
+			log.Error(err))
+		return "", nil, &serviceerror.InternalServerError
+	}
+
+	token, _, svcErr := s.jwtService.GenerateJWT(ctx, userID, config.GetServerRuntime().Config.JWT.Issuer,
+		s.validityPeriod, map[string]interface{}{
+			"aud":         tokenAudience,
+			claimDeviceID: deviceID,
+		}, jwt.TokenTypeJWT, "")
+	if svcErr != nil {
+		s.logger.Error("Failed to generate trusted device token", log.String("errorCode", svcErr.Code))
+		return "", nil, &serviceerror.InternalServerError
+	}
+
+	s.logger.Debug("Registered trusted device", log.MaskedString(log.LoggerKeyUserID, userID),
+		log.String("deviceID", deviceID))
+	return token, &device, nil
+}
+
+// evictExcessDevices removes the least recently used trusted devices of a user so that a new device can
+// be registered without exceeding the configured limit.
+func (s *trustedDeviceService) evictExcessDevices(ctx context.Context, userID string) *serviceerror.ServiceError {
+	devices, err := s.store.ListDevicesByUser(ctx, userID, s.now().UTC())
+	if err != nil {
+		s.logger.Error("Failed to list trusted devices", log.MaskedString(log.LoggerKeyUserID, userID),
+			log.Error(err))
+		return &serviceerror.InternalServerError
+	}
+
+	// Devices are ordered by last use, most recent first.
+	for i := len(devices) - 1; i >= s.maxDevicesPerUser-1; i-- {
+		if _, err := s.store.DeleteDevice(ctx, userID, devices[i].ID); err != nil {
+			s.logger.Error("Failed to remove least recently used trusted device",
+				log.MaskedString(log.LoggerKeyUserID, userID), log.Error(err))
+			return &serviceerror.InternalServerError
+		}
+	}
+	return nil
+}
+
+// VerifyDevice reports whether the device of the request is a trusted device of the user.
+// A device is trusted when it presents a valid trusted device token issued to the user, the device
+// record still exists and has not expired, and the request fingerprint matches the registered one.
+func (s *trustedDeviceService) VerifyDevice(
+	ctx context.Context, userID string, info DeviceInfo,
+) (bool, *serviceerror.ServiceError) {
+	if userID == "" || info.Token == "" {
+		return false, nil
+	}
+
+	if verifyErr := s.jwtService.VerifyJWT(info.Token, tokenAudience,
+		config.GetServerRuntime().Config.JWT.Issuer); verifyErr != nil {
+		s.logger.Debug("Invalid trusted device token", log.String("errorCode", verifyErr.Code))
+		return false, nil
+	}
+	payload, err := jwt.DecodeJWTPayload(info.Token)
+	if err != nil {
+		s.logger.Debug("Failed to decode trusted device token payload", log.Error(err))
+		return false, nil
+	}
+	subject, _ := payload["sub"].(string)
+	deviceID, _ := payload[claimDeviceID].(string)
+	if subject != userID || deviceID == "" {
+		s.logger.Debug("Trusted device token is not issued to the user")
+		return false, nil
+	}
+
+	device, err := s.store.GetDevice(ctx, deviceID)
+	if err != nil {
+		if errors.Is(err, ErrTrustedDeviceNotFound) {
+			s.logger.Debug("Trusted device is revoked or removed", log.String("deviceID", deviceID))
+			return false, nil
+		}
+		s.logger.Error("Failed to retrieve trusted device", log.String("deviceID", deviceID), log.Error(err))
+		return false, &serviceerror.InternalServerError
+	}
+
+	now := s.now().UTC()
+	if device.UserID != userID || !now.Before(device.ExpiresAt) {
+		s.logger.Debug("Trusted device is expired or belongs to another user", log.String("deviceID", deviceID))
+		return false, nil
+	}
+	if subtle.ConstantTimeCompare([]byte(device.FingerprintHash), []byte(info.Fingerprint)) != 1 {
+		s.logger.Debug("Trusted device fingerprint mismatch", log.String("deviceID", deviceID))
+		return false, nil
+	}
+
+	if err := s.store.UpdateLastUsed(ctx, deviceID, now); err != nil {
+		// Failing to record the last use does not affect whether the device is trusted.
+		s.logger.Warn("Failed to update trusted device last used time", log.String("deviceID", deviceID),
+			log.Error(err))
+	}
+	return true, nil
+}
+
+// GetValidityPeriod returns the number of seconds a device remains trusted after registration.
+func (s *trustedDeviceService) GetValidityPeriod() int64 {
+	return s.validityPeriod
+}
+
+// ListDevices lists the unexpired trusted devices of a user.
+func (s *trustedDeviceService) ListDevices(
+	ctx context.Context, userID string,
+) (*TrustedDeviceListResponse, *serviceerror.ServiceError) {
+	if svcErr := s.checkUserAccess(ctx, security.ActionReadUser, userID); svcErr != nil {
+		return nil, svcErr
+	}
+
+	devices, err := s.store.ListDevicesByUser(ctx, userID, s.now().UTC())
+	if err != nil {
+		s.logger.Error("Failed to list trusted devices", log.MaskedString(log.LoggerKeyUserID, userID),
+			log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+
+	return &TrustedDeviceListResponse{
+		TotalResults: len(devices),
+		Devices:      devices,
+	}, nil
+}
+
+// RevokeDevice revokes a trusted device. The caller must be allowed to update the user owning the device.
+// If userID is not empty, the device must belong to that user.
+func (s *trustedDeviceService) RevokeDevice(ctx context.Context, userID, deviceID string) *serviceerror.ServiceError {
+	if deviceID == "" {
+		return &ErrorTrustedDeviceNotFound
+	}
+
+	device, err := s.store.GetDevice(ctx, deviceID)
+	if err != nil {
+		if errors.Is(err, ErrTrustedDeviceNotFound) {
+			return &ErrorTrustedDeviceNotFound
+		}
+		s.logger.Error("Failed to retrieve trusted device", log.String("deviceID", deviceID), log.Error(err))
+		return &serviceerror.InternalServerError
+	}
+	if userID != "" && device.UserID != userID {
+		return &ErrorTrustedDeviceNotFound
+	}
+	userID = device.UserID
+
+	if svcErr := s.checkUserAccess(ctx, security.ActionUpdateUser, userID); svcErr != nil {
+		return svcErr
+	}
+
+	deleted, err := s.store.DeleteDevice(ctx, userID, deviceID)
+	if err != nil {
+		s.logger.Error("Failed to revoke trusted device", log.MaskedString(log.LoggerKeyUserID, userID),
+			log.Error(err))
+		return &serviceerror.InternalServerError
+	}
+	if !deleted {
+		return &ErrorTrustedDeviceNotFound
+	}
+
+	s.logger.Debug("Revoked trusted device", log.MaskedString(log.LoggerKeyUserID, userID),
+		log.String("deviceID", deviceID))
+	return nil
+}
+
+// RevokeAllDevices revokes all trusted devices of a user.
+func (s *trustedDeviceService) RevokeAllDevices(ctx context.Context, userID string) *serviceerror.ServiceError {
+	if svcErr := s.checkUserAccess(ctx, security.ActionUpdateUser, userID); svcErr != nil {
+		return svcErr
+	}
+
+	if err := s.store.DeleteDevicesByUser(ctx, userID); err != nil {
+		s.logger.Error("Failed to revoke trusted devices", log.MaskedString(log.LoggerKeyUserID, userID),
+			log.Error(err))
+		return &serviceerror.InternalServerError
+	}
+
+	s.logger.Debug("Revoked all trusted devices", log.MaskedString(log.LoggerKeyUserID, userID))
+	return nil
+}
+
+// checkUserAccess checks whether the caller is allowed to perform the action on the trusted devices
+// of the user. Users are always allowed to manage their own trusted devices.
+func (s *trustedDeviceService) checkUserAccess(
+	ctx context.Context, action security.Action, userID string,
+) *serviceerror.ServiceError {
+	if userID == "" {
+		return &ErrorMissingUserID
+	}
+
+	user, providerErr := s.entityProvider.GetEntity(userID)
+	if providerErr != nil {
+		if providerErr.Code == entityprovider.ErrorCodeEntityNotFound {
+			return &ErrorUserNotFound
+		}
+		s.logger.Error("Failed to retrieve user", log.MaskedString(log.LoggerKeyUserID, userID),
+			log.String("error", providerErr.Error()))
+		return &serviceerror.InternalServerError
+	}
+
+	allowed, svcErr := s.authzService.IsActionAllowed(ctx, action, &sysauthz.ActionContext{
+		ResourceType: security.ResourceTypeUser, OUID: user.OUID, ResourceID: userID,
+	})
+	if svcErr != nil {
+		s.logger.Error("Failed to check authorization for action",
+			log.String("action", string(action)), log.Any("error", svcErr))
+		return &serviceerror.InternalServerError
+	}
+	if !allowed {
+		return &serviceerror.ErrorUnauthorized
+	}
+	return nil
+}
@@ -0,0 +1,344 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package trusteddevice
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/entityprovider"
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/jose/jwt"
+	"github.com/thunder-id/thunderid/internal/system/security"
+	"github.com/thunder-id/thunderid/tests/mocks/entityprovidermock"
+	"github.com/thunder-id/thunderid/tests/mocks/jose/jwtmock"
+	"github.com/thunder-id/thunderid/tests/mocks/sysauthzmock"
+)
+
+const (
+	testUserID      = "user-1"
+	testOtherUserID = "user-2"
+	testOUID        = "ou-1"
+	testDeviceID    = "device-1"
+	testIssuer      = "https://thunder.test"
+	testFingerprint = "fingerprint-hash"
+)
+
+type TrustedDeviceServiceTestSuite struct {
+	suite.Suite
+	mockStore          *trustedDeviceStoreInterfaceMock
+	mockJWT            *jwtmock.JWTServiceInterfaceMock
+	mockEntityProvider *entityprovidermock.EntityProviderInterfaceMock
+	mockAuthz          *sysauthzmock.SystemAuthorizationServiceInterfaceMock
+	service            *trustedDeviceService
+	now                time.Time
+}
+
+func TestTrustedDeviceServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(TrustedDeviceServiceTestSuite))
+}
+
+func (suite *TrustedDeviceServiceTestSuite) SetupTest() {
+	testConfig := &config.Config{}
+	testConfig.JWT.Issuer = testIssuer
+	_ = config.InitializeServerRuntime("test", testConfig)
+
+	suite.mockStore = newTrustedDeviceStoreInterfaceMock(suite.T())
+	suite.mockJWT = jwtmock.NewJWTServiceInterfaceMock(suite.T())
+	suite.mockEntityProvider = entityprovidermock.NewEntityProviderInterfaceMock(suite.T())
+	suite.mockAuthz = sysauthzmock.NewSystemAuthorizationServiceInterfaceMock(suite.T())
+	suite.service = newTrustedDeviceService(suite.mockStore, suite.mockJWT, suite.mockEntityProvider,
+		suite.mockAuthz, 3600, 2).(*trustedDeviceService)
+	suite.now = time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	suite.service.now = func() time.Time { return suite.now }
+}
+
+func (suite *TrustedDeviceServiceTestSuite) TearDownTest() {
+	config.ResetServerRuntime()
+}
+
+// buildToken builds an unsigned token carrying the given claims. Signature checks are mocked.
+func buildToken(claims map[string]interface{}) string {
+	payload, _ := json.Marshal(claims)
+	return "e30." + base64.RawURLEncoding.EncodeToString(payload) + ".sig"
+}
+
+func (suite *TrustedDeviceServiceTestSuite) expectUserAccess(action security.Action, allowed bool) {
+	suite.mockEntityProvider.On("GetEntity", testUserID).
+		Return(&entityprovider.Entity{ID: testUserID, OUID: testOUID}, nil).Once()
+	suite.mockAuthz.On("IsActionAllowed", mock.Anything, action, mock.MatchedBy(
+		func(actionCtx interface{}) bool { return actionCtx != nil })).Return(allowed, nil).Once()
+}
+
+func (suite *TrustedDeviceServiceTestSuite) validDevice() *TrustedDevice {
+	return &TrustedDevice{
+		ID:              testDeviceID,
+		UserID:          testUserID,
+		FingerprintHash: testFingerprint,
+		ExpiresAt:       suite.now.Add(time.Hour),
+	}
+}
+
+func (suite *TrustedDeviceServiceTestSuite) TestNewTrustedDeviceService_Defaults() {
+	service := newTrustedDeviceService(suite.mockStore, suite.mockJWT, suite.mockEntityProvider,
+		suite.mockAuthz, 0, 0).(*trustedDeviceService)
+
+	suite.Equal(int64(defaultValidityPeriod), service.GetValidityPeriod())
+	suite.Equal(defaultMaxDevicesPerUser, service.maxDevicesPerUser)
+}
+
+func (suite *TrustedDeviceServiceTestSuite) TestRegisterDevice_Success() {
+	suite.mockStore.On("ListDevicesByUser", mock.Anything, testUserID, suite.now).
+		Return([]TrustedDevice{}, nil).Once()
+	suite.mockStore.On("CreateDevice", mock.Anything, mock.MatchedBy(func(device TrustedDevice) bool {
+		return device.UserID == testUserID && device.FingerprintHash == testFingerprint &&
+			device.Name == "Firefox" && device.ExpiresAt.Equal(suite.now.Add(time.Hour))
+	})).Return(nil).Once()
+	suite.mockJWT.On("GenerateJWT", mock.Anything, testUserID, testIssuer, int64(3600),
+		mock.MatchedBy(func(claims map[string]interface{}) bool {
+			return claims["aud"] == tokenAudience && claims[claimDeviceID] != ""
+		}), jwt.TokenTypeJWT, "").Return("device-token", int64(0), nil).Once()
+
+	token, device, svcErr := suite.service.RegisterDevice(context.Background(), testUserID,
+		DeviceInfo{Fingerprint: testFingerprint, Name: "Firefox"})
+
+	suite.Nil(svcErr)
+	suite.Equal("device-token", token)
+	suite.NotEmpty(device.ID)
+}
+
+func (suite *TrustedDeviceServiceTestSuite) TestRegisterDevice_EvictsLeastRecentlyUsed() {
+	suite.mockStore.On("ListDevicesByUser", mock.Anything, testUserID, suite.now).
+		Return([]TrustedDevice{{ID: "recent"}, {ID: "old"}}, nil).Once()
+	suite.mockStore.On("DeleteDevice", mock.Anything, testUserID, "old").Return(true, nil).Once()
+	suite.mockStore.On("CreateDevice", mock.Anything, mock.Anything).Return(nil).Once()
+	suite.mockJWT.On("GenerateJWT", mock.Anything, testUserID, testIssuer, int64(3600), mock.Anything,
+		jwt.TokenTypeJWT, "").Return("device-token", int64(0), nil).Once()
+
+	_, _, svcErr := suite.service.RegisterDevice(context.Background(), testUserID, DeviceInfo{})
+
+	suite.Nil(svcErr)
+}
+
+func (suite *TrustedDeviceServiceTestSuite) TestRegisterDevice_MissingUserID() {
+	_, _, svcErr := suite.service.RegisterDevice(context.Background(), "", DeviceInfo{})
+
+	suite.Equal(ErrorMissingUserID.Code, svcErr.Code)
+}
+
+func (suite *TrustedDeviceServiceTestSuite) TestRegisterDevice_StoreError() {
+	suite.mockStore.On("ListDevicesByUser", mock.Anything, testUserID, suite.now).
+		Return([]TrustedDevice{}, nil).Once()
+	suite.mockStore.On("CreateDevice", mock.Anything, mock.Anything).Return(errors.New("db error")).Once()
+
+	_, _, svcErr := suite.service.RegisterDevice(context.Background(), testUserID, DeviceInfo{})
+
+	suite.Equal(serviceerror.InternalServerError.Code, svcErr.Code)
+}
+
+func (suite *TrustedDeviceServiceTestSuite) TestVerifyDevice_Trusted() {
+	token := buildToken(map[string]interface{}{"sub": testUserID, claimDeviceID: testDeviceID})
+	suite.mockJWT.On("VerifyJWT", token, tokenAudience, testIssuer).Return(nil).Once()
+	suite.mockStore.On("GetDevice", mock.Anything, testDeviceID).Return(suite.validDevice(), nil).Once()
+	suite.mockStore.On("UpdateLastUsed", mock.Anything, testDeviceID, suite.now).Return(nil).Once()
+
+	trusted, svcErr := suite.service.VerifyDevice(context.Background(), testUserID,
+		DeviceInfo{Token: token, Fingerprint: testFingerprint})
+
+	suite.Nil(svcErr)
+	suite.True(trusted)
+}
+
+func (suite *TrustedDeviceServiceTestSuite) TestVerifyDevice_NotTrusted() {
+	token := buildToken(map[string]interface{}{"sub": testUserID, claimDeviceID: testDeviceID})
+	otherUserToken := buildToken(map[string]interface{}{"sub": testOtherUserID, claimDeviceID: testDeviceID})
+
+	testCases := []struct {
+		name  string
+		token string
+		setup func()
+	}{
+		{name: "NoToken", token: "", setup: func() {}},
+		{
+			name:  "InvalidSignature",
+			token: token,
+			setup: func() {
+				suite.mockJWT.On("VerifyJWT", token, tokenAudience, testIssuer).
+					Return(&serviceerror.ServiceError{Code: "JWT-1"}).Once()
+			},
+		},
+		{
+			name:  "OtherUser",
+			token: otherUserToken,
+			setup: func() {
+				suite.mockJWT.On("VerifyJWT", otherUserToken, tokenAudience, testIssuer).Return(nil).Once()
+			},
+		},
+		{
+			name:  "Revoked",
+			token: token,
+			setup: func() {
+				suite.mockJWT.On("VerifyJWT", token, tokenAudience, testIssuer).Return(nil).Once()
+				suite.mockStore.On("GetDevice", mock.Anything, testDeviceID).
+					Return(nil, ErrTrustedDeviceNotFound).Once()
+			},
+		},
+		{
+			name:  "Expired",
+			token: token,
+			setup: func() {
+				device := suite.validDevice()
+				device.ExpiresAt = suite.now
+				suite.mockJWT.On("VerifyJWT", token, tokenAudience, testIssuer).Return(nil).Once()
+				suite.mockStore.On("GetDevice", mock.Anything, testDeviceID).Return(device, nil).Once()
+			},
+		},
+		{
+			name:  "FingerprintMismatch",
+			token: token,
+			setup: func() {
+				device := suite.validDevice()
+				device.FingerprintHash = "other-fingerprint"
+				suite.mockJWT.On("VerifyJWT", token, tokenAudience, testIssuer).Return(nil).Once()
+				suite.mockStore.On("GetDevice", mock.Anything, testDeviceID).Return(device, nil).Once()
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			tc.setup()
+
+			trusted, svcErr := suite.service.VerifyDevice(context.Background(), testUserID,
+				DeviceInfo{Token: tc.token, Fingerprint: testFingerprint})
+
+			suite.Nil(svcErr)
+			suite.False(trusted)
+		})
+	}
+}
+
+func (suite *TrustedDeviceServiceTestSuite) TestVerifyDevice_StoreError() {
+	token := buildToken(map[string]interface{}{"sub": testUserID, claimDeviceID: testDeviceID})
+	suite.mockJWT.On("VerifyJWT", token, tokenAudience, testIssuer).Return(nil).Once()
+	suite.mockStore.On("GetDevice", mock.Anything, testDeviceID).Return(nil, errors.New("db error")).Once()
+
+	trusted, svcErr := suite.service.VerifyDevice(context.Background(), testUserID,
+		DeviceInfo{Token: token, Fingerprint: testFingerprint})
+
+	suite.False(trusted)
+	suite.Equal(serviceerror.InternalServerError.Code, svcErr.Code)
+}
+
+func (suite *TrustedDeviceServiceTestSuite) TestListDevices_Success() {
+	suite.expectUserAccess(security.ActionReadUser, true)
+	suite.mockStore.On("ListDevicesByUser", mock.Anything, testUserID, suite.now).
+		Return([]TrustedDevice{*suite.validDevice()}, nil).Once()
+
+	resp, svcErr := suite.service.ListDevices(context.Background(), testUserID)
+
+	suite.Nil(svcErr)
+	suite.Equal(1, resp.TotalResults)
+	suite.Equal(testDeviceID, resp.Devices[0].ID)
+}
+
+func (suite *TrustedDeviceServiceTestSuite) TestListDevices_Unauthorized() {
+	suite.expectUserAccess(security.ActionReadUser, false)
+
+	resp, svcErr := suite.service.ListDevices(context.Background(), testUserID)
+
+	suite.Nil(resp)
+	suite.Equal(serviceerror.ErrorUnauthorized.Code, svcErr.Code)
+}
+
+func (suite *TrustedDeviceServiceTestSuite) TestListDevices_UserNotFound() {
+	suite.mockEntityProvider.On("GetEntity", testUserID).Return(nil,
+		entityprovider.NewEntityProviderError(entityprovider.ErrorCodeEntityNotFound, "Entity not found", "")).Once()
+
+	_, svcErr := suite.service.ListDevices(context.Background(), testUserID)
+
+	suite.Equal(ErrorUserNotFound.Code, svcErr.Code)
+}
+
+func (suite *TrustedDeviceServiceTestSuite) TestListDevices_MissingUserID() {
+	_, svcErr := suite.service.ListDevices(context.Background(), "")
+
+	suite.Equal(ErrorMissingUserID.Code, svcErr.Code)
+}
+
+func (suite *TrustedDeviceServiceTestSuite) TestRevokeDevice_Success() {
+	suite.mockStore.On("GetDevice", mock.Anything, testDeviceID).Return(suite.validDevice(), nil).Once()
+	suite.expectUserAccess(security.ActionUpdateUser, true)
+	suite.mockStore.On("DeleteDevice", mock.Anything, testUserID, testDeviceID).Return(true, nil).Once()
+
+	svcErr := suite.service.RevokeDevice(context.Background(), "", testDeviceID)
+
+	suite.Nil(svcErr)
+}
+
+func (suite *TrustedDeviceServiceTestSuite) TestRevokeDevice_OtherUsersDevice() {
+	suite.mockStore.On("GetDevice", mock.Anything, testDeviceID).Return(suite.validDevice(), nil).Once()
+
+	svcErr := suite.service.RevokeDevice(context.Background(), testOtherUserID, testDeviceID)
+
+	suite.Equal(ErrorTrustedDeviceNotFound.Code, svcErr.Code)
+}
+
+func (suite *TrustedDeviceServiceTestSuite) TestRevokeDevice_NotFound() {
+	suite.mockStore.On("GetDevice", mock.Anything, testDeviceID).Return(nil, ErrTrustedDeviceNotFound).Once()
+
+	svcErr := suite.service.RevokeDevice(context.Background(), testUserID, testDeviceID)
+
+	suite.Equal(ErrorTrustedDeviceNotFound.Code, svcErr.Code)
+}
+
+func (suite *TrustedDeviceServiceTestSuite) TestRevokeDevice_Unauthorized() {
+	suite.mockStore.On("GetDevice", mock.Anything, testDeviceID).Return(suite.validDevice(), nil).Once()
+	suite.expectUserAccess(security.ActionUpdateUser, false)
+
+	svcErr := suite.service.RevokeDevice(context.Background(), "", testDeviceID)
+
+	suite.Equal(serviceerror.ErrorUnauthorized.Code, svcErr.Code)
+}
+
+func (suite *TrustedDeviceServiceTestSuite) TestRevokeAllDevices_Success() {
+	suite.expectUserAccess(security.ActionUpdateUser, true)
+	suite.mockStore.On("DeleteDevicesByUser", mock.Anything, testUserID).Return(nil).Once()
+
+	svcErr := suite.service.RevokeAllDevices(context.Background(), testUserID)
+
+	suite.Nil(svcErr)
+}
+
+func (suite *TrustedDeviceServiceTestSuite) TestRevokeAllDevices_StoreError() {
+	suite.expectUserAccess(security.ActionUpdateUser, true)
+	suite.mockStore.On("DeleteDevicesByUser", mock.Anything, testUserID).Return(errors.New("db error")).Once()
+
+	svcErr := suite.service.RevokeAllDevices(context.Background(), testUserID)
+
+	suite.Equal(serviceerror.InternalServerError.Code, svcErr.Code)
+}
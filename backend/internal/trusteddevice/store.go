@@ -0,0 +1,200 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package trusteddevice
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+	sysContext "github.com/thunder-id/thunderid/internal/system/context"
+	"github.com/thunder-id/thunderid/internal/system/database/provider"
+	dbutils "github.com/thunder-id/thunderid/internal/system/database/utils"
+)
+
+var getDBProvider = provider.GetDBProvider
+
+// trustedDeviceStoreInterface defines the interface for trusted device store operations.
+type trustedDeviceStoreInterface interface {
+	CreateDevice(ctx context.Context, device TrustedDevice) error
+	GetDevice(ctx context.Context, id string) (*TrustedDevice, error)
+	ListDevicesByUser(ctx context.Context, userID string, now time.Time) ([]TrustedDevice, error)
+	UpdateLastUsed(ctx context.Context, id string, lastUsedAt time.Time) error
+	DeleteDevice(ctx context.Context, userID, id string) (bool, error)
+	DeleteDevicesByUser(ctx context.Context, userID string) error
+}
+
+// trustedDeviceStore is the runtime database backed implementation of trustedDeviceStoreInterface.
+type trustedDeviceStore struct {
+	dbProvider   provider.DBProviderInterface
+	deploymentID string
+}
+
+// newTrustedDeviceStore creates a new instance of trustedDeviceStore.
+func newTrustedDeviceStore() trustedDeviceStoreInterface {
+	return &trustedDeviceStore{
+		dbProvider:   getDBProvider(),
+		deploymentID: config.GetServerRuntime().Config.Server.Identifier,
+	}
+}
+
+// CreateDevice persists a new trusted device.
+func (s *trustedDeviceStore) CreateDevice(ctx context.Context, device TrustedDevice) error {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
+	dbClient, err := s.dbProvider.GetRuntimeDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	if _, err := dbClient.ExecuteContext(ctx, queryCreateTrustedDevice, device.ID, device.UserID, device.Name,
+		device.FingerprintHash, device.CreatedAt, device.LastUsedAt, device.ExpiresAt, deploymentID); err != nil {
+		return fmt.Errorf("failed to execute query: %w", err)
+	}
+	return nil
+}
+
+// GetDevice retrieves a trusted device by its ID.
+func (s *trustedDeviceStore) GetDevice(ctx context.Context, id string) (*TrustedDevice, error) {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
+	dbClient, err := s.dbProvider.GetRuntimeDBClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	results, err := dbClient.QueryContext(ctx, queryGetTrustedDevice, id, deploymentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+	if len(results) == 0 {
+		return nil, ErrTrustedDeviceNotFound
+	}
+
+	return buildTrustedDeviceFromResultRow(results[0])
+}
+
+// ListDevicesByUser retrieves the trusted devices of a user that have not expired at the given time.
+func (s *trustedDeviceStore) ListDevicesByUser(
+	ctx context.Context, userID string, now time.Time,
+) ([]TrustedDevice, error) {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
+	dbClient, err := s.dbProvider.GetRuntimeDBClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	results, err := dbClient.QueryContext(ctx, queryListTrustedDevicesByUser, userID, now, deploymentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	devices := make([]TrustedDevice, 0, len(results))
+	for _, row := range results {
+		device, err := buildTrustedDeviceFromResultRow(row)
+		if err != nil {
+			return nil, err
+		}
+		devices = append(devices, *device)
+	}
+	return devices, nil
+}
+
+// UpdateLastUsed updates the last used time of a trusted device.
+func (s *trustedDeviceStore) UpdateLastUsed(ctx context.Context, id string, lastUsedAt time.Time) error {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
+	dbClient, err := s.dbProvider.GetRuntimeDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	if _, err := dbClient.ExecuteContext(ctx, queryUpdateTrustedDeviceLastUsed,
+		lastUsedAt, id, deploymentID); err != nil {
+		return fmt.Errorf("failed to execute query: %w", err)
+	}
+	return nil
+}
+
+// DeleteDevice deletes a trusted device of a user. It reports whether a device was deleted.
+func (s *trustedDeviceStore) DeleteDevice(ctx context.Context, userID, id string) (bool, error) {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
+	dbClient, err := s.dbProvider.GetRuntimeDBClient()
+	if err != nil {
+		return false, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	rowsAffected, err := dbClient.ExecuteContext(ctx, queryDeleteTrustedDevice, id, userID, deploymentID)
+	if err != nil {
+		return false, fmt.Errorf("failed to execute query: %w", err)
+	}
+	return rowsAffected > 0, nil
+}
+
+// DeleteDevicesByUser deletes all trusted devices of a user.
+func (s *trustedDeviceStore) DeleteDevicesByUser(ctx context.Context, userID string) error {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
+	dbClient, err := s.dbProvider.GetRuntimeDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	if _, err := dbClient.ExecuteContext(ctx, queryDeleteTrustedDevicesByUser, userID, deploymentID); err != nil {
+		return fmt.Errorf("failed to execute query: %w", err)
+	}
+	return nil
+}
+
+// buildTrustedDeviceFromResultRow constructs a TrustedDevice from a database result row.
+func buildTrustedDeviceFromResultRow(row map[string]interface{}) (*TrustedDevice, error) {
+	id, ok := row["id"].(string)
+	if !ok {
+		return nil, fmt.Errorf("failed to parse id as string")
+	}
+	userID, ok := row["user_id"].(string)
+	if !ok {
+		return nil, fmt.Errorf("failed to parse user_id as string")
+	}
+	fingerprintHash, ok := row["fingerprint_hash"].(string)
+	if !ok {
+		return nil, fmt.Errorf("failed to parse fingerprint_hash as string")
+	}
+	name, _ := row["name"].(string)
+
+	createdAt, err := dbutils.ParseTimeField(row["created_at"], "created_at")
+	if err != nil {
+		return nil, err
+	}
+	lastUsedAt, err := dbutils.ParseTimeField(row["last_used_at"], "last_used_at")
+	if err != nil {
+		return nil, err
+	}
+	expiresAt, err := dbutils.ParseTimeField(row["expiry_time"], "expiry_time")
+	if err != nil {
+		return nil, err
+	}
+
+	return &TrustedDevice{
+		ID:              id,
+		UserID:          userID,
+		Name:            name,
+		FingerprintHash: fingerprintHash,
+		CreatedAt:       createdAt,
+		LastUsedAt:      lastUsedAt,
+		ExpiresAt:       expiresAt,
+	}, nil
+}
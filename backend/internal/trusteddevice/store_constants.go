@@ -0,0 +1,63 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package trusteddevice
+
+import dbmodel "github.com/thunder-id/thunderid/internal/system/database/model"
+
+var (
+	// queryCreateTrustedDevice inserts a new trusted device.
+	queryCreateTrustedDevice = dbmodel.DBQuery{
+		ID: "TDQ-DEVICE_MGT-01",
+		Query: `INSERT INTO "TRUSTED_DEVICE" (ID, USER_ID, NAME, FINGERPRINT_HASH, CREATED_AT, LAST_USED_AT, ` +
+			`EXPIRY_TIME, DEPLOYMENT_ID) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`,
+	}
+
+	// queryGetTrustedDevice retrieves a trusted device by ID.
+	queryGetTrustedDevice = dbmodel.DBQuery{
+		ID: "TDQ-DEVICE_MGT-02",
+		Query: `SELECT ID, USER_ID, NAME, FINGERPRINT_HASH, CREATED_AT, LAST_USED_AT, EXPIRY_TIME ` +
+			`FROM "TRUSTED_DEVICE" WHERE ID = $1 AND DEPLOYMENT_ID = $2`,
+	}
+
+	// queryListTrustedDevicesByUser retrieves the unexpired trusted devices of a user, most recently used first.
+	queryListTrustedDevicesByUser = dbmodel.DBQuery{
+		ID: "TDQ-DEVICE_MGT-03",
+		Query: `SELECT ID, USER_ID, NAME, FINGERPRINT_HASH, CREATED_AT, LAST_USED_AT, EXPIRY_TIME ` +
+			`FROM "TRUSTED_DEVICE" WHERE USER_ID = $1 AND EXPIRY_TIME > $2 AND DEPLOYMENT_ID = $3 ` +
+			`ORDER BY LAST_USED_AT DESC`,
+	}
+
+	// queryUpdateTrustedDeviceLastUsed updates the last used time of a trusted device.
+	queryUpdateTrustedDeviceLastUsed = dbmodel.DBQuery{
+		ID:    "TDQ-DEVICE_MGT-04",
+		Query: `UPDATE "TRUSTED_DEVICE" SET LAST_USED_AT = $1 WHERE ID = $2 AND DEPLOYMENT_ID = $3`,
+	}
+
+	// queryDeleteTrustedDevice deletes a trusted device of a user.
+	queryDeleteTrustedDevice = dbmodel.DBQuery{
+		ID:    "TDQ-DEVICE_MGT-05",
+		Query: `DELETE FROM "TRUSTED_DEVICE" WHERE ID = $1 AND USER_ID = $2 AND DEPLOYMENT_ID = $3`,
+	}
+
+	// queryDeleteTrustedDevicesByUser deletes all trusted devices of a user.
+	queryDeleteTrustedDevicesByUser = dbmodel.DBQuery{
+		ID:    "TDQ-DEVICE_MGT-06",
+		Query: `DELETE FROM "TRUSTED_DEVICE" WHERE USER_ID = $1 AND DEPLOYMENT_ID = $2`,
+	}
+)
@@ -9,7 +9,14 @@ import (
 	"encoding/json"
 
 	mock "github.com/stretchr/testify/mock"
+	"github.com/thunder-id/thunderid/internal/accountprotection"
+	"github.com/thunder-id/thunderid/internal/appuser"
+	"github.com/thunder-id/thunderid/internal/emailchange"
+	"github.com/thunder-id/thunderid/internal/entitytype"
+	"github.com/thunder-id/thunderid/internal/passwordpolicy"
+	"github.com/thunder-id/thunderid/internal/securitynotification"
 	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/objectstore"
 )
 
 // NewUserServiceInterfaceMock creates a new instance of UserServiceInterfaceMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
@@ -175,8 +182,8 @@ func (_c *UserServiceInterfaceMock_CreateUserByPath_Call) Run(run func(ctx conte
 	return _c
 }
 
-func (_c *UserServiceInterfaceMock_CreateUserByPath_Call) Return(user *User, serviceError *serviceerror.ServiceError) *UserServiceInterfaceMock_CreateUserByPath_Call {
-	_c.Call.Return(user, serviceError)
+func (_c *UserServiceInterfaceMock_CreateUserByPath_Call) Return(user1 *User, serviceError *serviceerror.ServiceError) *UserServiceInterfaceMock_CreateUserByPath_Call {
+	_c.Call.Return(user1, serviceError)
 	return _c
 }
 
@@ -244,50 +251,39 @@ func (_c *UserServiceInterfaceMock_DeleteUser_Call) RunAndReturn(run func(ctx co
 	return _c
 }
 
-// GetUser provides a mock function for the type UserServiceInterfaceMock
-func (_mock *UserServiceInterfaceMock) GetUser(ctx context.Context, userID string, includeDisplay bool) (*User, *serviceerror.ServiceError) {
-	ret := _mock.Called(ctx, userID, includeDisplay)
+// DeleteUserCredential provides a mock function for the type UserServiceInterfaceMock
+func (_mock *UserServiceInterfaceMock) DeleteUserCredential(ctx context.Context, userID string, credentialType string) *serviceerror.ServiceError {
+	ret := _mock.Called(ctx, userID, credentialType)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetUser")
+		panic("no return value specified for DeleteUserCredential")
 	}
 
-	var r0 *User
-	var r1 *serviceerror.ServiceError
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, bool) (*User, *serviceerror.ServiceError)); ok {
-		return returnFunc(ctx, userID, includeDisplay)
-	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, bool) *User); ok {
-		r0 = returnFunc(ctx, userID, includeDisplay)
+	var r0 *serviceerror.ServiceError
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) *serviceerror.ServiceError); ok {
+		r0 = returnFunc(ctx, userID, credentialType)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*User)
-		}
-	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, string, bool) *serviceerror.ServiceError); ok {
-		r1 = returnFunc(ctx, userID, includeDisplay)
-	} else {
-		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*serviceerror.ServiceError)
+			r0 = ret.Get(0).(*serviceerror.ServiceError)
 		}
 	}
-	return r0, r1
+	return r0
 }
 
-// UserServiceInterfaceMock_GetUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUser'
-type UserServiceInterfaceMock_GetUser_Call struct {
+// UserServiceInterfaceMock_DeleteUserCredential_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteUserCredential'
+type UserServiceInterfaceMock_DeleteUserCredential_Call struct {
 	*mock.Call
 }
 
-// GetUser is a helper method to define mock.On call
+// DeleteUserCredential is a helper method to define mock.On call
 //   - ctx context.Context
 //   - userID string
-//   - includeDisplay bool
-func (_e *UserServiceInterfaceMock_Expecter) GetUser(ctx interface{}, userID interface{}, includeDisplay interface{}) *UserServiceInterfaceMock_GetUser_Call {
-	return &UserServiceInterfaceMock_GetUser_Call{Call: _e.mock.On("GetUser", ctx, userID, includeDisplay)}
+//   - credentialType string
+func (_e *UserServiceInterfaceMock_Expecter) DeleteUserCredential(ctx interface{}, userID interface{}, credentialType interface{}) *UserServiceInterfaceMock_DeleteUserCredential_Call {
+	return &UserServiceInterfaceMock_DeleteUserCredential_Call{Call: _e.mock.On("DeleteUserCredential", ctx, userID, credentialType)}
 }
 
-func (_c *UserServiceInterfaceMock_GetUser_Call) Run(run func(ctx context.Context, userID string, includeDisplay bool)) *UserServiceInterfaceMock_GetUser_Call {
+func (_c *UserServiceInterfaceMock_DeleteUserCredential_Call) Run(run func(ctx context.Context, userID string, credentialType string)) *UserServiceInterfaceMock_DeleteUserCredential_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -297,9 +293,9 @@ func (_c *UserServiceInterfaceMock_GetUser_Call) Run(run func(ctx context.Contex
 		if args[1] != nil {
 			arg1 = args[1].(string)
 		}
-		var arg2 bool
+		var arg2 string
 		if args[2] != nil {
-			arg2 = args[2].(bool)
+			arg2 = args[2].(string)
 		}
 		run(
 			arg0,
@@ -310,61 +306,48 @@ func (_c *UserServiceInterfaceMock_GetUser_Call) Run(run func(ctx context.Contex
 	return _c
 }
 
-func (_c *UserServiceInterfaceMock_GetUser_Call) Return(user *User, serviceError *serviceerror.ServiceError) *UserServiceInterfaceMock_GetUser_Call {
-	_c.Call.Return(user, serviceError)
+func (_c *UserServiceInterfaceMock_DeleteUserCredential_Call) Return(serviceError *serviceerror.ServiceError) *UserServiceInterfaceMock_DeleteUserCredential_Call {
+	_c.Call.Return(serviceError)
 	return _c
 }
 
-func (_c *UserServiceInterfaceMock_GetUser_Call) RunAndReturn(run func(ctx context.Context, userID string, includeDisplay bool) (*User, *serviceerror.ServiceError)) *UserServiceInterfaceMock_GetUser_Call {
+func (_c *UserServiceInterfaceMock_DeleteUserCredential_Call) RunAndReturn(run func(ctx context.Context, userID string, credentialType string) *serviceerror.ServiceError) *UserServiceInterfaceMock_DeleteUserCredential_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetUserGroups provides a mock function for the type UserServiceInterfaceMock
-func (_mock *UserServiceInterfaceMock) GetUserGroups(ctx context.Context, userID string, limit int, offset int) (*UserGroupListResponse, *serviceerror.ServiceError) {
-	ret := _mock.Called(ctx, userID, limit, offset)
+// DeleteUserPicture provides a mock function for the type UserServiceInterfaceMock
+func (_mock *UserServiceInterfaceMock) DeleteUserPicture(ctx context.Context, userID string) *serviceerror.ServiceError {
+	ret := _mock.Called(ctx, userID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetUserGroups")
+		panic("no return value specified for DeleteUserPicture")
 	}
 
-	var r0 *UserGroupListResponse
-	var r1 *serviceerror.ServiceError
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int, int) (*UserGroupListResponse, *serviceerror.ServiceError)); ok {
-		return returnFunc(ctx, userID, limit, offset)
-	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int, int) *UserGroupListResponse); ok {
-		r0 = returnFunc(ctx, userID, limit, offset)
+	var r0 *serviceerror.ServiceError
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *serviceerror.ServiceError); ok {
+		r0 = returnFunc(ctx, userID)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*UserGroupListResponse)
-		}
-	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, string, int, int) *serviceerror.ServiceError); ok {
-		r1 = returnFunc(ctx, userID, limit, offset)
-	} else {
-		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*serviceerror.ServiceError)
+			r0 = ret.Get(0).(*serviceerror.ServiceError)
 		}
 	}
-	return r0, r1
+	return r0
 }
 
-// UserServiceInterfaceMock_GetUserGroups_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserGroups'
-type UserServiceInterfaceMock_GetUserGroups_Call struct {
+// UserServiceInterfaceMock_DeleteUserPicture_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteUserPicture'
+type UserServiceInterfaceMock_DeleteUserPicture_Call struct {
 	*mock.Call
 }
 
-// GetUserGroups is a helper method to define mock.On call
+// DeleteUserPicture is a helper method to define mock.On call
 //   - ctx context.Context
 //   - userID string
-//   - limit int
-//   - offset int
-func (_e *UserServiceInterfaceMock_Expecter) GetUserGroups(ctx interface{}, userID interface{}, limit interface{}, offset interface{}) *UserServiceInterfaceMock_GetUserGroups_Call {
-	return &UserServiceInterfaceMock_GetUserGroups_Call{Call: _e.mock.On("GetUserGroups", ctx, userID, limit, offset)}
+func (_e *UserServiceInterfaceMock_Expecter) DeleteUserPicture(ctx interface{}, userID interface{}) *UserServiceInterfaceMock_DeleteUserPicture_Call {
+	return &UserServiceInterfaceMock_DeleteUserPicture_Call{Call: _e.mock.On("DeleteUserPicture", ctx, userID)}
 }
 
-func (_c *UserServiceInterfaceMock_GetUserGroups_Call) Run(run func(ctx context.Context, userID string, limit int, offset int)) *UserServiceInterfaceMock_GetUserGroups_Call {
+func (_c *UserServiceInterfaceMock_DeleteUserPicture_Call) Run(run func(ctx context.Context, userID string)) *UserServiceInterfaceMock_DeleteUserPicture_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -374,56 +357,46 @@ func (_c *UserServiceInterfaceMock_GetUserGroups_Call) Run(run func(ctx context.
 		if args[1] != nil {
 			arg1 = args[1].(string)
 		}
-		var arg2 int
-		if args[2] != nil {
-			arg2 = args[2].(int)
-		}
-		var arg3 int
-		if args[3] != nil {
-			arg3 = args[3].(int)
-		}
 		run(
 			arg0,
 			arg1,
-			arg2,
-			arg3,
 		)
 	})
 	return _c
 }
 
-func (_c *UserServiceInterfaceMock_GetUserGroups_Call) Return(userGroupListResponse *UserGroupListResponse, serviceError *serviceerror.ServiceError) *UserServiceInterfaceMock_GetUserGroups_Call {
-	_c.Call.Return(userGroupListResponse, serviceError)
+func (_c *UserServiceInterfaceMock_DeleteUserPicture_Call) Return(serviceError *serviceerror.ServiceError) *UserServiceInterfaceMock_DeleteUserPicture_Call {
+	_c.Call.Return(serviceError)
 	return _c
 }
 
-func (_c *UserServiceInterfaceMock_GetUserGroups_Call) RunAndReturn(run func(ctx context.Context, userID string, limit int, offset int) (*UserGroupListResponse, *serviceerror.ServiceError)) *UserServiceInterfaceMock_GetUserGroups_Call {
+func (_c *UserServiceInterfaceMock_DeleteUserPicture_Call) RunAndReturn(run func(ctx context.Context, userID string) *serviceerror.ServiceError) *UserServiceInterfaceMock_DeleteUserPicture_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetUserList provides a mock function for the type UserServiceInterfaceMock
-func (_mock *UserServiceInterfaceMock) GetUserList(ctx context.Context, limit int, offset int, filters map[string]interface{}, includeDisplay bool) (*UserListResponse, *serviceerror.ServiceError) {
-	ret := _mock.Called(ctx, limit, offset, filters, includeDisplay)
+// GetCredentialFormatReport provides a mock function for the type UserServiceInterfaceMock
+func (_mock *UserServiceInterfaceMock) GetCredentialFormatReport(ctx context.Context) (*CredentialFormatReport, *serviceerror.ServiceError) {
+	ret := _mock.Called(ctx)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetUserList")
+		panic("no return value specified for GetCredentialFormatReport")
 	}
 
-	var r0 *UserListResponse
+	var r0 *CredentialFormatReport
 	var r1 *serviceerror.ServiceError
-	if returnFunc, ok := ret.Get(0).(func(context.Context, int, int, map[string]interface{}, bool) (*UserListResponse, *serviceerror.ServiceError)); ok {
-		return returnFunc(ctx, limit, offset, filters, includeDisplay)
+	if returnFunc, ok := ret.Get(0).(func(context.Context) (*CredentialFormatReport, *serviceerror.ServiceError)); ok {
+		return returnFunc(ctx)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, int, int, map[string]interface{}, bool) *UserListResponse); ok {
-		r0 = returnFunc(ctx, limit, offset, filters, includeDisplay)
+	if returnFunc, ok := ret.Get(0).(func(context.Context) *CredentialFormatReport); ok {
+		r0 = returnFunc(ctx)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*UserListResponse)
+			r0 = ret.Get(0).(*CredentialFormatReport)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, int, int, map[string]interface{}, bool) *serviceerror.ServiceError); ok {
-		r1 = returnFunc(ctx, limit, offset, filters, includeDisplay)
+	if returnFunc, ok := ret.Get(1).(func(context.Context) *serviceerror.ServiceError); ok {
+		r1 = returnFunc(ctx)
 	} else {
 		if ret.Get(1) != nil {
 			r1 = ret.Get(1).(*serviceerror.ServiceError)
@@ -432,86 +405,62 @@ func (_mock *UserServiceInterfaceMock) GetUserList(ctx context.Context, limit in
 	return r0, r1
 }
 
-// UserServiceInterfaceMock_GetUserList_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserList'
-type UserServiceInterfaceMock_GetUserList_Call struct {
+// UserServiceInterfaceMock_GetCredentialFormatReport_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCredentialFormatReport'
+type UserServiceInterfaceMock_GetCredentialFormatReport_Call struct {
 	*mock.Call
 }
 
-// GetUserList is a helper method to define mock.On call
+// GetCredentialFormatReport is a helper method to define mock.On call
 //   - ctx context.Context
-//   - limit int
-//   - offset int
-//   - filters map[string]interface{}
-//   - includeDisplay bool
-func (_e *UserServiceInterfaceMock_Expecter) GetUserList(ctx interface{}, limit interface{}, offset interface{}, filters interface{}, includeDisplay interface{}) *UserServiceInterfaceMock_GetUserList_Call {
-	return &UserServiceInterfaceMock_GetUserList_Call{Call: _e.mock.On("GetUserList", ctx, limit, offset, filters, includeDisplay)}
+func (_e *UserServiceInterfaceMock_Expecter) GetCredentialFormatReport(ctx interface{}) *UserServiceInterfaceMock_GetCredentialFormatReport_Call {
+	return &UserServiceInterfaceMock_GetCredentialFormatReport_Call{Call: _e.mock.On("GetCredentialFormatReport", ctx)}
 }
 
-func (_c *UserServiceInterfaceMock_GetUserList_Call) Run(run func(ctx context.Context, limit int, offset int, filters map[string]interface{}, includeDisplay bool)) *UserServiceInterfaceMock_GetUserList_Call {
+func (_c *UserServiceInterfaceMock_GetCredentialFormatReport_Call) Run(run func(ctx context.Context)) *UserServiceInterfaceMock_GetCredentialFormatReport_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 int
-		if args[1] != nil {
-			arg1 = args[1].(int)
-		}
-		var arg2 int
-		if args[2] != nil {
-			arg2 = args[2].(int)
-		}
-		var arg3 map[string]interface{}
-		if args[3] != nil {
-			arg3 = args[3].(map[string]interface{})
-		}
-		var arg4 bool
-		if args[4] != nil {
-			arg4 = args[4].(bool)
-		}
 		run(
 			arg0,
-			arg1,
-			arg2,
-			arg3,
-			arg4,
 		)
 	})
 	return _c
 }
 
-func (_c *UserServiceInterfaceMock_GetUserList_Call) Return(userListResponse *UserListResponse, serviceError *serviceerror.ServiceError) *UserServiceInterfaceMock_GetUserList_Call {
-	_c.Call.Return(userListResponse, serviceError)
+func (_c *UserServiceInterfaceMock_GetCredentialFormatReport_Call) Return(credentialFormatReport *CredentialFormatReport, serviceError *serviceerror.ServiceError) *UserServiceInterfaceMock_GetCredentialFormatReport_Call {
+	_c.Call.Return(credentialFormatReport, serviceError)
 	return _c
 }
 
-func (_c *UserServiceInterfaceMock_GetUserList_Call) RunAndReturn(run func(ctx context.Context, limit int, offset int, filters map[string]interface{}, includeDisplay bool) (*UserListResponse, *serviceerror.ServiceError)) *UserServiceInterfaceMock_GetUserList_Call {
+func (_c *UserServiceInterfaceMock_GetCredentialFormatReport_Call) RunAndReturn(run func(ctx context.Context) (*CredentialFormatReport, *serviceerror.ServiceError)) *UserServiceInterfaceMock_GetCredentialFormatReport_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetUsersByPath provides a mock function for the type UserServiceInterfaceMock
-func (_mock *UserServiceInterfaceMock) GetUsersByPath(ctx context.Context, handlePath string, limit int, offset int, filters map[string]interface{}, includeDisplay bool) (*UserListResponse, *serviceerror.ServiceError) {
-	ret := _mock.Called(ctx, handlePath, limit, offset, filters, includeDisplay)
+// GetDeletedUserList provides a mock function for the type UserServiceInterfaceMock
+func (_mock *UserServiceInterfaceMock) GetDeletedUserList(ctx context.Context, limit int, offset int, filters map[string]interface{}, includeDisplay bool) (*UserListResponse, *serviceerror.ServiceError) {
+	ret := _mock.Called(ctx, limit, offset, filters, includeDisplay)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetUsersByPath")
+		panic("no return value specified for GetDeletedUserList")
 	}
 
 	var r0 *UserListResponse
 	var r1 *serviceerror.ServiceError
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int, int, map[string]interface{}, bool) (*UserListResponse, *serviceerror.ServiceError)); ok {
-		return returnFunc(ctx, handlePath, limit, offset, filters, includeDisplay)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int, int, map[string]interface{}, bool) (*UserListResponse, *serviceerror.ServiceError)); ok {
+		return returnFunc(ctx, limit, offset, filters, includeDisplay)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int, int, map[string]interface{}, bool) *UserListResponse); ok {
-		r0 = returnFunc(ctx, handlePath, limit, offset, filters, includeDisplay)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int, int, map[string]interface{}, bool) *UserListResponse); ok {
+		r0 = returnFunc(ctx, limit, offset, filters, includeDisplay)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*UserListResponse)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, string, int, int, map[string]interface{}, bool) *serviceerror.ServiceError); ok {
-		r1 = returnFunc(ctx, handlePath, limit, offset, filters, includeDisplay)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int, int, map[string]interface{}, bool) *serviceerror.ServiceError); ok {
+		r1 = returnFunc(ctx, limit, offset, filters, includeDisplay)
 	} else {
 		if ret.Get(1) != nil {
 			r1 = ret.Get(1).(*serviceerror.ServiceError)
@@ -520,47 +469,42 @@ func (_mock *UserServiceInterfaceMock) GetUsersByPath(ctx context.Context, handl
 	return r0, r1
 }
 
-// UserServiceInterfaceMock_GetUsersByPath_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUsersByPath'
-type UserServiceInterfaceMock_GetUsersByPath_Call struct {
+// UserServiceInterfaceMock_GetDeletedUserList_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDeletedUserList'
+type UserServiceInterfaceMock_GetDeletedUserList_Call struct {
 	*mock.Call
 }
 
-// GetUsersByPath is a helper method to define mock.On call
+// GetDeletedUserList is a helper method to define mock.On call
 //   - ctx context.Context
-//   - handlePath string
 //   - limit int
 //   - offset int
 //   - filters map[string]interface{}
 //   - includeDisplay bool
-func (_e *UserServiceInterfaceMock_Expecter) GetUsersByPath(ctx interface{}, handlePath interface{}, limit interface{}, offset interface{}, filters interface{}, includeDisplay interface{}) *UserServiceInterfaceMock_GetUsersByPath_Call {
-	return &UserServiceInterfaceMock_GetUsersByPath_Call{Call: _e.mock.On("GetUsersByPath", ctx, handlePath, limit, offset, filters, includeDisplay)}
+func (_e *UserServiceInterfaceMock_Expecter) GetDeletedUserList(ctx interface{}, limit interface{}, offset interface{}, filters interface{}, includeDisplay interface{}) *UserServiceInterfaceMock_GetDeletedUserList_Call {
+	return &UserServiceInterfaceMock_GetDeletedUserList_Call{Call: _e.mock.On("GetDeletedUserList", ctx, limit, offset, filters, includeDisplay)}
 }
 
-func (_c *UserServiceInterfaceMock_GetUsersByPath_Call) Run(run func(ctx context.Context, handlePath string, limit int, offset int, filters map[string]interface{}, includeDisplay bool)) *UserServiceInterfaceMock_GetUsersByPath_Call {
+func (_c *UserServiceInterfaceMock_GetDeletedUserList_Call) Run(run func(ctx context.Context, limit int, offset int, filters map[string]interface{}, includeDisplay bool)) *UserServiceInterfaceMock_GetDeletedUserList_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 string
+		var arg1 int
 		if args[1] != nil {
-			arg1 = args[1].(string)
+			arg1 = args[1].(int)
 		}
 		var arg2 int
 		if args[2] != nil {
 			arg2 = args[2].(int)
 		}
-		var arg3 int
+		var arg3 map[string]interface{}
 		if args[3] != nil {
-			arg3 = args[3].(int)
+			arg3 = args[3].(map[string]interface{})
 		}
-		var arg4 map[string]interface{}
+		var arg4 bool
 		if args[4] != nil {
-			arg4 = args[4].(map[string]interface{})
-		}
-		var arg5 bool
-		if args[5] != nil {
-			arg5 = args[5].(bool)
+			arg4 = args[4].(bool)
 		}
 		run(
 			arg0,
@@ -568,44 +512,43 @@ func (_c *UserServiceInterfaceMock_GetUsersByPath_Call) Run(run func(ctx context
 			arg2,
 			arg3,
 			arg4,
-			arg5,
 		)
 	})
 	return _c
 }
 
-func (_c *UserServiceInterfaceMock_GetUsersByPath_Call) Return(userListResponse *UserListResponse, serviceError *serviceerror.ServiceError) *UserServiceInterfaceMock_GetUsersByPath_Call {
+func (_c *UserServiceInterfaceMock_GetDeletedUserList_Call) Return(userListResponse *UserListResponse, serviceError *serviceerror.ServiceError) *UserServiceInterfaceMock_GetDeletedUserList_Call {
 	_c.Call.Return(userListResponse, serviceError)
 	return _c
 }
 
-func (_c *UserServiceInterfaceMock_GetUsersByPath_Call) RunAndReturn(run func(ctx context.Context, handlePath string, limit int, offset int, filters map[string]interface{}, includeDisplay bool) (*UserListResponse, *serviceerror.ServiceError)) *UserServiceInterfaceMock_GetUsersByPath_Call {
+func (_c *UserServiceInterfaceMock_GetDeletedUserList_Call) RunAndReturn(run func(ctx context.Context, limit int, offset int, filters map[string]interface{}, includeDisplay bool) (*UserListResponse, *serviceerror.ServiceError)) *UserServiceInterfaceMock_GetDeletedUserList_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UpdateUser provides a mock function for the type UserServiceInterfaceMock
-func (_mock *UserServiceInterfaceMock) UpdateUser(ctx context.Context, userID string, user *User) (*User, *serviceerror.ServiceError) {
-	ret := _mock.Called(ctx, userID, user)
+// GetUser provides a mock function for the type UserServiceInterfaceMock
+func (_mock *UserServiceInterfaceMock) GetUser(ctx context.Context, userID string, includeDisplay bool) (*User, *serviceerror.ServiceError) {
+	ret := _mock.Called(ctx, userID, includeDisplay)
 
 	if len(ret) == 0 {
-		panic("no return value specified for UpdateUser")
+		panic("no return value specified for GetUser")
 	}
 
 	var r0 *User
 	var r1 *serviceerror.ServiceError
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, *User) (*User, *serviceerror.ServiceError)); ok {
-		return returnFunc(ctx, userID, user)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, bool) (*User, *serviceerror.ServiceError)); ok {
+		return returnFunc(ctx, userID, includeDisplay)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, *User) *User); ok {
-		r0 = returnFunc(ctx, userID, user)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, bool) *User); ok {
+		r0 = returnFunc(ctx, userID, includeDisplay)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*User)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, string, *User) *serviceerror.ServiceError); ok {
-		r1 = returnFunc(ctx, userID, user)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, bool) *serviceerror.ServiceError); ok {
+		r1 = returnFunc(ctx, userID, includeDisplay)
 	} else {
 		if ret.Get(1) != nil {
 			r1 = ret.Get(1).(*serviceerror.ServiceError)
@@ -614,20 +557,20 @@ func (_mock *UserServiceInterfaceMock) UpdateUser(ctx context.Context, userID st
 	return r0, r1
 }
 
-// UserServiceInterfaceMock_UpdateUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateUser'
-type UserServiceInterfaceMock_UpdateUser_Call struct {
+// UserServiceInterfaceMock_GetUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUser'
+type UserServiceInterfaceMock_GetUser_Call struct {
 	*mock.Call
 }
 
-// UpdateUser is a helper method to define mock.On call
+// GetUser is a helper method to define mock.On call
 //   - ctx context.Context
 //   - userID string
-//   - user *User
-func (_e *UserServiceInterfaceMock_Expecter) UpdateUser(ctx interface{}, userID interface{}, user interface{}) *UserServiceInterfaceMock_UpdateUser_Call {
-	return &UserServiceInterfaceMock_UpdateUser_Call{Call: _e.mock.On("UpdateUser", ctx, userID, user)}
+//   - includeDisplay bool
+func (_e *UserServiceInterfaceMock_Expecter) GetUser(ctx interface{}, userID interface{}, includeDisplay interface{}) *UserServiceInterfaceMock_GetUser_Call {
+	return &UserServiceInterfaceMock_GetUser_Call{Call: _e.mock.On("GetUser", ctx, userID, includeDisplay)}
 }
 
-func (_c *UserServiceInterfaceMock_UpdateUser_Call) Run(run func(ctx context.Context, userID string, user *User)) *UserServiceInterfaceMock_UpdateUser_Call {
+func (_c *UserServiceInterfaceMock_GetUser_Call) Run(run func(ctx context.Context, userID string, includeDisplay bool)) *UserServiceInterfaceMock_GetUser_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -637,9 +580,9 @@ func (_c *UserServiceInterfaceMock_UpdateUser_Call) Run(run func(ctx context.Con
 		if args[1] != nil {
 			arg1 = args[1].(string)
 		}
-		var arg2 *User
+		var arg2 bool
 		if args[2] != nil {
-			arg2 = args[2].(*User)
+			arg2 = args[2].(bool)
 		}
 		run(
 			arg0,
@@ -650,38 +593,38 @@ func (_c *UserServiceInterfaceMock_UpdateUser_Call) Run(run func(ctx context.Con
 	return _c
 }
 
-func (_c *UserServiceInterfaceMock_UpdateUser_Call) Return(user1 *User, serviceError *serviceerror.ServiceError) *UserServiceInterfaceMock_UpdateUser_Call {
+func (_c *UserServiceInterfaceMock_GetUser_Call) Return(user1 *User, serviceError *serviceerror.ServiceError) *UserServiceInterfaceMock_GetUser_Call {
 	_c.Call.Return(user1, serviceError)
 	return _c
 }
 
-func (_c *UserServiceInterfaceMock_UpdateUser_Call) RunAndReturn(run func(ctx context.Context, userID string, user *User) (*User, *serviceerror.ServiceError)) *UserServiceInterfaceMock_UpdateUser_Call {
+func (_c *UserServiceInterfaceMock_GetUser_Call) RunAndReturn(run func(ctx context.Context, userID string, includeDisplay bool) (*User, *serviceerror.ServiceError)) *UserServiceInterfaceMock_GetUser_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UpdateUserAttributes provides a mock function for the type UserServiceInterfaceMock
-func (_mock *UserServiceInterfaceMock) UpdateUserAttributes(ctx context.Context, userID string, attributes json.RawMessage) (*User, *serviceerror.ServiceError) {
-	ret := _mock.Called(ctx, userID, attributes)
+// GetUserGroups provides a mock function for the type UserServiceInterfaceMock
+func (_mock *UserServiceInterfaceMock) GetUserGroups(ctx context.Context, userID string, limit int, offset int) (*UserGroupListResponse, *serviceerror.ServiceError) {
+	ret := _mock.Called(ctx, userID, limit, offset)
 
 	if len(ret) == 0 {
-		panic("no return value specified for UpdateUserAttributes")
+		panic("no return value specified for GetUserGroups")
 	}
 
-	var r0 *User
+	var r0 *UserGroupListResponse
 	var r1 *serviceerror.ServiceError
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, json.RawMessage) (*User, *serviceerror.ServiceError)); ok {
-		return returnFunc(ctx, userID, attributes)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int, int) (*UserGroupListResponse, *serviceerror.ServiceError)); ok {
+		return returnFunc(ctx, userID, limit, offset)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, json.RawMessage) *User); ok {
-		r0 = returnFunc(ctx, userID, attributes)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int, int) *UserGroupListResponse); ok {
+		r0 = returnFunc(ctx, userID, limit, offset)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*User)
+			r0 = ret.Get(0).(*UserGroupListResponse)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, string, json.RawMessage) *serviceerror.ServiceError); ok {
-		r1 = returnFunc(ctx, userID, attributes)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, int, int) *serviceerror.ServiceError); ok {
+		r1 = returnFunc(ctx, userID, limit, offset)
 	} else {
 		if ret.Get(1) != nil {
 			r1 = ret.Get(1).(*serviceerror.ServiceError)
@@ -690,20 +633,21 @@ func (_mock *UserServiceInterfaceMock) UpdateUserAttributes(ctx context.Context,
 	return r0, r1
 }
 
-// UserServiceInterfaceMock_UpdateUserAttributes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateUserAttributes'
-type UserServiceInterfaceMock_UpdateUserAttributes_Call struct {
+// UserServiceInterfaceMock_GetUserGroups_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserGroups'
+type UserServiceInterfaceMock_GetUserGroups_Call struct {
 	*mock.Call
 }
 
-// UpdateUserAttributes is a helper method to define mock.On call
+// GetUserGroups is a helper method to define mock.On call
 //   - ctx context.Context
 //   - userID string
-//   - attributes json.RawMessage
-func (_e *UserServiceInterfaceMock_Expecter) UpdateUserAttributes(ctx interface{}, userID interface{}, attributes interface{}) *UserServiceInterfaceMock_UpdateUserAttributes_Call {
-	return &UserServiceInterfaceMock_UpdateUserAttributes_Call{Call: _e.mock.On("UpdateUserAttributes", ctx, userID, attributes)}
+//   - limit int
+//   - offset int
+func (_e *UserServiceInterfaceMock_Expecter) GetUserGroups(ctx interface{}, userID interface{}, limit interface{}, offset interface{}) *UserServiceInterfaceMock_GetUserGroups_Call {
+	return &UserServiceInterfaceMock_GetUserGroups_Call{Call: _e.mock.On("GetUserGroups", ctx, userID, limit, offset)}
 }
 
-func (_c *UserServiceInterfaceMock_UpdateUserAttributes_Call) Run(run func(ctx context.Context, userID string, attributes json.RawMessage)) *UserServiceInterfaceMock_UpdateUserAttributes_Call {
+func (_c *UserServiceInterfaceMock_GetUserGroups_Call) Run(run func(ctx context.Context, userID string, limit int, offset int)) *UserServiceInterfaceMock_GetUserGroups_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -713,11 +657,1041 @@ func (_c *UserServiceInterfaceMock_UpdateUserAttributes_Call) Run(run func(ctx c
 		if args[1] != nil {
 			arg1 = args[1].(string)
 		}
-		var arg2 json.RawMessage
+		var arg2 int
 		if args[2] != nil {
-			arg2 = args[2].(json.RawMessage)
+			arg2 = args[2].(int)
 		}
-		run(
+		var arg3 int
+		if args[3] != nil {
+			arg3 = args[3].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *UserServiceInterfaceMock_GetUserGroups_Call) Return(userGroupListResponse *UserGroupListResponse, serviceError *serviceerror.ServiceError) *UserServiceInterfaceMock_GetUserGroups_Call {
+	_c.Call.Return(userGroupListResponse, serviceError)
+	return _c
+}
+
+func (_c *UserServiceInterfaceMock_GetUserGroups_Call) RunAndReturn(run func(ctx context.Context, userID string, limit int, offset int) (*UserGroupListResponse, *serviceerror.ServiceError)) *UserServiceInterfaceMock_GetUserGroups_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUserList provides a mock function for the type UserServiceInterfaceMock
+func (_mock *UserServiceInterfaceMock) GetUserList(ctx context.Context, limit int, offset int, filters map[string]interface{}, includeDisplay bool) (*UserListResponse, *serviceerror.ServiceError) {
+	ret := _mock.Called(ctx, limit, offset, filters, includeDisplay)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserList")
+	}
+
+	var r0 *UserListResponse
+	var r1 *serviceerror.ServiceError
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int, int, map[string]interface{}, bool) (*UserListResponse, *serviceerror.ServiceError)); ok {
+		return returnFunc(ctx, limit, offset, filters, includeDisplay)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int, int, map[string]interface{}, bool) *UserListResponse); ok {
+		r0 = returnFunc(ctx, limit, offset, filters, includeDisplay)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*UserListResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int, int, map[string]interface{}, bool) *serviceerror.ServiceError); ok {
+		r1 = returnFunc(ctx, limit, offset, filters, includeDisplay)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*serviceerror.ServiceError)
+		}
+	}
+	return r0, r1
+}
+
+// UserServiceInterfaceMock_GetUserList_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserList'
+type UserServiceInterfaceMock_GetUserList_Call struct {
+	*mock.Call
+}
+
+// GetUserList is a helper method to define mock.On call
+//   - ctx context.Context
+//   - limit int
+//   - offset int
+//   - filters map[string]interface{}
+//   - includeDisplay bool
+func (_e *UserServiceInterfaceMock_Expecter) GetUserList(ctx interface{}, limit interface{}, offset interface{}, filters interface{}, includeDisplay interface{}) *UserServiceInterfaceMock_GetUserList_Call {
+	return &UserServiceInterfaceMock_GetUserList_Call{Call: _e.mock.On("GetUserList", ctx, limit, offset, filters, includeDisplay)}
+}
+
+func (_c *UserServiceInterfaceMock_GetUserList_Call) Run(run func(ctx context.Context, limit int, offset int, filters map[string]interface{}, includeDisplay bool)) *UserServiceInterfaceMock_GetUserList_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		var arg3 map[string]interface{}
+		if args[3] != nil {
+			arg3 = args[3].(map[string]interface{})
+		}
+		var arg4 bool
+		if args[4] != nil {
+			arg4 = args[4].(bool)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+		)
+	})
+	return _c
+}
+
+func (_c *UserServiceInterfaceMock_GetUserList_Call) Return(userListResponse *UserListResponse, serviceError *serviceerror.ServiceError) *UserServiceInterfaceMock_GetUserList_Call {
+	_c.Call.Return(userListResponse, serviceError)
+	return _c
+}
+
+func (_c *UserServiceInterfaceMock_GetUserList_Call) RunAndReturn(run func(ctx context.Context, limit int, offset int, filters map[string]interface{}, includeDisplay bool) (*UserListResponse, *serviceerror.ServiceError)) *UserServiceInterfaceMock_GetUserList_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUserPicture provides a mock function for the type UserServiceInterfaceMock
+func (_mock *UserServiceInterfaceMock) GetUserPicture(ctx context.Context, userID string) (*objectstore.Object, *serviceerror.ServiceError) {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserPicture")
+	}
+
+	var r0 *objectstore.Object
+	var r1 *serviceerror.ServiceError
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*objectstore.Object, *serviceerror.ServiceError)); ok {
+		return returnFunc(ctx, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *objectstore.Object); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*objectstore.Object)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) *serviceerror.ServiceError); ok {
+		r1 = returnFunc(ctx, userID)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*serviceerror.ServiceError)
+		}
+	}
+	return r0, r1
+}
+
+// UserServiceInterfaceMock_GetUserPicture_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserPicture'
+type UserServiceInterfaceMock_GetUserPicture_Call struct {
+	*mock.Call
+}
+
+// GetUserPicture is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *UserServiceInterfaceMock_Expecter) GetUserPicture(ctx interface{}, userID interface{}) *UserServiceInterfaceMock_GetUserPicture_Call {
+	return &UserServiceInterfaceMock_GetUserPicture_Call{Call: _e.mock.On("GetUserPicture", ctx, userID)}
+}
+
+func (_c *UserServiceInterfaceMock_GetUserPicture_Call) Run(run func(ctx context.Context, userID string)) *UserServiceInterfaceMock_GetUserPicture_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *UserServiceInterfaceMock_GetUserPicture_Call) Return(object *objectstore.Object, serviceError *serviceerror.ServiceError) *UserServiceInterfaceMock_GetUserPicture_Call {
+	_c.Call.Return(object, serviceError)
+	return _c
+}
+
+func (_c *UserServiceInterfaceMock_GetUserPicture_Call) RunAndReturn(run func(ctx context.Context, userID string) (*objectstore.Object, *serviceerror.ServiceError)) *UserServiceInterfaceMock_GetUserPicture_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUserPictureBySignature provides a mock function for the type UserServiceInterfaceMock
+func (_mock *UserServiceInterfaceMock) GetUserPictureBySignature(ctx context.Context, userID string, expires string, signature string) (*objectstore.Object, *serviceerror.ServiceError) {
+	ret := _mock.Called(ctx, userID, expires, signature)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserPictureBySignature")
+	}
+
+	var r0 *objectstore.Object
+	var r1 *serviceerror.ServiceError
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string) (*objectstore.Object, *serviceerror.ServiceError)); ok {
+		return returnFunc(ctx, userID, expires, signature)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string) *objectstore.Object); ok {
+		r0 = returnFunc(ctx, userID, expires, signature)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*objectstore.Object)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, string) *serviceerror.ServiceError); ok {
+		r1 = returnFunc(ctx, userID, expires, signature)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*serviceerror.ServiceError)
+		}
+	}
+	return r0, r1
+}
+
+// UserServiceInterfaceMock_GetUserPictureBySignature_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserPictureBySignature'
+type UserServiceInterfaceMock_GetUserPictureBySignature_Call struct {
+	*mock.Call
+}
+
+// GetUserPictureBySignature is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - expires string
+//   - signature string
+func (_e *UserServiceInterfaceMock_Expecter) GetUserPictureBySignature(ctx interface{}, userID interface{}, expires interface{}, signature interface{}) *UserServiceInterfaceMock_GetUserPictureBySignature_Call {
+	return &UserServiceInterfaceMock_GetUserPictureBySignature_Call{Call: _e.mock.On("GetUserPictureBySignature", ctx, userID, expires, signature)}
+}
+
+func (_c *UserServiceInterfaceMock_GetUserPictureBySignature_Call) Run(run func(ctx context.Context, userID string, expires string, signature string)) *UserServiceInterfaceMock_GetUserPictureBySignature_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *UserServiceInterfaceMock_GetUserPictureBySignature_Call) Return(object *objectstore.Object, serviceError *serviceerror.ServiceError) *UserServiceInterfaceMock_GetUserPictureBySignature_Call {
+	_c.Call.Return(object, serviceError)
+	return _c
+}
+
+func (_c *UserServiceInterfaceMock_GetUserPictureBySignature_Call) RunAndReturn(run func(ctx context.Context, userID string, expires string, signature string) (*objectstore.Object, *serviceerror.ServiceError)) *UserServiceInterfaceMock_GetUserPictureBySignature_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUsersByPath provides a mock function for the type UserServiceInterfaceMock
+func (_mock *UserServiceInterfaceMock) GetUsersByPath(ctx context.Context, handlePath string, limit int, offset int, filters map[string]interface{}, includeDisplay bool) (*UserListResponse, *serviceerror.ServiceError) {
+	ret := _mock.Called(ctx, handlePath, limit, offset, filters, includeDisplay)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUsersByPath")
+	}
+
+	var r0 *UserListResponse
+	var r1 *serviceerror.ServiceError
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int, int, map[string]interface{}, bool) (*UserListResponse, *serviceerror.ServiceError)); ok {
+		return returnFunc(ctx, handlePath, limit, offset, filters, includeDisplay)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int, int, map[string]interface{}, bool) *UserListResponse); ok {
+		r0 = returnFunc(ctx, handlePath, limit, offset, filters, includeDisplay)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*UserListResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, int, int, map[string]interface{}, bool) *serviceerror.ServiceError); ok {
+		r1 = returnFunc(ctx, handlePath, limit, offset, filters, includeDisplay)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*serviceerror.ServiceError)
+		}
+	}
+	return r0, r1
+}
+
+// UserServiceInterfaceMock_GetUsersByPath_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUsersByPath'
+type UserServiceInterfaceMock_GetUsersByPath_Call struct {
+	*mock.Call
+}
+
+// GetUsersByPath is a helper method to define mock.On call
+//   - ctx context.Context
+//   - handlePath string
+//   - limit int
+//   - offset int
+//   - filters map[string]interface{}
+//   - includeDisplay bool
+func (_e *UserServiceInterfaceMock_Expecter) GetUsersByPath(ctx interface{}, handlePath interface{}, limit interface{}, offset interface{}, filters interface{}, includeDisplay interface{}) *UserServiceInterfaceMock_GetUsersByPath_Call {
+	return &UserServiceInterfaceMock_GetUsersByPath_Call{Call: _e.mock.On("GetUsersByPath", ctx, handlePath, limit, offset, filters, includeDisplay)}
+}
+
+func (_c *UserServiceInterfaceMock_GetUsersByPath_Call) Run(run func(ctx context.Context, handlePath string, limit int, offset int, filters map[string]interface{}, includeDisplay bool)) *UserServiceInterfaceMock_GetUsersByPath_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		var arg3 int
+		if args[3] != nil {
+			arg3 = args[3].(int)
+		}
+		var arg4 map[string]interface{}
+		if args[4] != nil {
+			arg4 = args[4].(map[string]interface{})
+		}
+		var arg5 bool
+		if args[5] != nil {
+			arg5 = args[5].(bool)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+			arg5,
+		)
+	})
+	return _c
+}
+
+func (_c *UserServiceInterfaceMock_GetUsersByPath_Call) Return(userListResponse *UserListResponse, serviceError *serviceerror.ServiceError) *UserServiceInterfaceMock_GetUsersByPath_Call {
+	_c.Call.Return(userListResponse, serviceError)
+	return _c
+}
+
+func (_c *UserServiceInterfaceMock_GetUsersByPath_Call) RunAndReturn(run func(ctx context.Context, handlePath string, limit int, offset int, filters map[string]interface{}, includeDisplay bool) (*UserListResponse, *serviceerror.ServiceError)) *UserServiceInterfaceMock_GetUsersByPath_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NormalizeUserTypeSamples provides a mock function for the type UserServiceInterfaceMock
+func (_mock *UserServiceInterfaceMock) NormalizeUserTypeSamples(ctx context.Context, request entitytype.SampleNormalizationRequest) (*entitytype.SampleNormalizationResponse, *serviceerror.ServiceError) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for NormalizeUserTypeSamples")
+	}
+
+	var r0 *entitytype.SampleNormalizationResponse
+	var r1 *serviceerror.ServiceError
+	if returnFunc, ok := ret.Get(0).(func(context.Context, entitytype.SampleNormalizationRequest) (*entitytype.SampleNormalizationResponse, *serviceerror.ServiceError)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, entitytype.SampleNormalizationRequest) *entitytype.SampleNormalizationResponse); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entitytype.SampleNormalizationResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, entitytype.SampleNormalizationRequest) *serviceerror.ServiceError); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*serviceerror.ServiceError)
+		}
+	}
+	return r0, r1
+}
+
+// UserServiceInterfaceMock_NormalizeUserTypeSamples_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'NormalizeUserTypeSamples'
+type UserServiceInterfaceMock_NormalizeUserTypeSamples_Call struct {
+	*mock.Call
+}
+
+// NormalizeUserTypeSamples is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request entitytype.SampleNormalizationRequest
+func (_e *UserServiceInterfaceMock_Expecter) NormalizeUserTypeSamples(ctx interface{}, request interface{}) *UserServiceInterfaceMock_NormalizeUserTypeSamples_Call {
+	return &UserServiceInterfaceMock_NormalizeUserTypeSamples_Call{Call: _e.mock.On("NormalizeUserTypeSamples", ctx, request)}
+}
+
+func (_c *UserServiceInterfaceMock_NormalizeUserTypeSamples_Call) Run(run func(ctx context.Context, request entitytype.SampleNormalizationRequest)) *UserServiceInterfaceMock_NormalizeUserTypeSamples_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 entitytype.SampleNormalizationRequest
+		if args[1] != nil {
+			arg1 = args[1].(entitytype.SampleNormalizationRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *UserServiceInterfaceMock_NormalizeUserTypeSamples_Call) Return(sampleNormalizationResponse *entitytype.SampleNormalizationResponse, serviceError *serviceerror.ServiceError) *UserServiceInterfaceMock_NormalizeUserTypeSamples_Call {
+	_c.Call.Return(sampleNormalizationResponse, serviceError)
+	return _c
+}
+
+func (_c *UserServiceInterfaceMock_NormalizeUserTypeSamples_Call) RunAndReturn(run func(ctx context.Context, request entitytype.SampleNormalizationRequest) (*entitytype.SampleNormalizationResponse, *serviceerror.ServiceError)) *UserServiceInterfaceMock_NormalizeUserTypeSamples_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PrehashCredentials provides a mock function for the type UserServiceInterfaceMock
+func (_mock *UserServiceInterfaceMock) PrehashCredentials(ctx context.Context, users []*User) (context.Context, *serviceerror.ServiceError) {
+	ret := _mock.Called(ctx, users)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PrehashCredentials")
+	}
+
+	var r0 context.Context
+	var r1 *serviceerror.ServiceError
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []*User) (context.Context, *serviceerror.ServiceError)); ok {
+		return returnFunc(ctx, users)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []*User) context.Context); ok {
+		r0 = returnFunc(ctx, users)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(context.Context)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, []*User) *serviceerror.ServiceError); ok {
+		r1 = returnFunc(ctx, users)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*serviceerror.ServiceError)
+		}
+	}
+	return r0, r1
+}
+
+// UserServiceInterfaceMock_PrehashCredentials_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PrehashCredentials'
+type UserServiceInterfaceMock_PrehashCredentials_Call struct {
+	*mock.Call
+}
+
+// PrehashCredentials is a helper method to define mock.On call
+//   - ctx context.Context
+//   - users []*User
+func (_e *UserServiceInterfaceMock_Expecter) PrehashCredentials(ctx interface{}, users interface{}) *UserServiceInterfaceMock_PrehashCredentials_Call {
+	return &UserServiceInterfaceMock_PrehashCredentials_Call{Call: _e.mock.On("PrehashCredentials", ctx, users)}
+}
+
+func (_c *UserServiceInterfaceMock_PrehashCredentials_Call) Run(run func(ctx context.Context, users []*User)) *UserServiceInterfaceMock_PrehashCredentials_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []*User
+		if args[1] != nil {
+			arg1 = args[1].([]*User)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *UserServiceInterfaceMock_PrehashCredentials_Call) Return(context1 context.Context, serviceError *serviceerror.ServiceError) *UserServiceInterfaceMock_PrehashCredentials_Call {
+	_c.Call.Return(context1, serviceError)
+	return _c
+}
+
+func (_c *UserServiceInterfaceMock_PrehashCredentials_Call) RunAndReturn(run func(ctx context.Context, users []*User) (context.Context, *serviceerror.ServiceError)) *UserServiceInterfaceMock_PrehashCredentials_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ProjectUserAttributes provides a mock function for the type UserServiceInterfaceMock
+func (_mock *UserServiceInterfaceMock) ProjectUserAttributes(users []User, projection AttributeProjection) ([]User, *serviceerror.ServiceError) {
+	ret := _mock.Called(users, projection)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ProjectUserAttributes")
+	}
+
+	var r0 []User
+	var r1 *serviceerror.ServiceError
+	if returnFunc, ok := ret.Get(0).(func([]User, AttributeProjection) ([]User, *serviceerror.ServiceError)); ok {
+		return returnFunc(users, projection)
+	}
+	if returnFunc, ok := ret.Get(0).(func([]User, AttributeProjection) []User); ok {
+		r0 = returnFunc(users, projection)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]User)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func([]User, AttributeProjection) *serviceerror.ServiceError); ok {
+		r1 = returnFunc(users, projection)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*serviceerror.ServiceError)
+		}
+	}
+	return r0, r1
+}
+
+// UserServiceInterfaceMock_ProjectUserAttributes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ProjectUserAttributes'
+type UserServiceInterfaceMock_ProjectUserAttributes_Call struct {
+	*mock.Call
+}
+
+// ProjectUserAttributes is a helper method to define mock.On call
+//   - users []User
+//   - projection AttributeProjection
+func (_e *UserServiceInterfaceMock_Expecter) ProjectUserAttributes(users interface{}, projection interface{}) *UserServiceInterfaceMock_ProjectUserAttributes_Call {
+	return &UserServiceInterfaceMock_ProjectUserAttributes_Call{Call: _e.mock.On("ProjectUserAttributes", users, projection)}
+}
+
+func (_c *UserServiceInterfaceMock_ProjectUserAttributes_Call) Run(run func(users []User, projection AttributeProjection)) *UserServiceInterfaceMock_ProjectUserAttributes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 []User
+		if args[0] != nil {
+			arg0 = args[0].([]User)
+		}
+		var arg1 AttributeProjection
+		if args[1] != nil {
+			arg1 = args[1].(AttributeProjection)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *UserServiceInterfaceMock_ProjectUserAttributes_Call) Return(users []User, serviceError *serviceerror.ServiceError) *UserServiceInterfaceMock_ProjectUserAttributes_Call {
+	_c.Call.Return(users, serviceError)
+	return _c
+}
+
+func (_c *UserServiceInterfaceMock_ProjectUserAttributes_Call) RunAndReturn(run func(users []User, projection AttributeProjection) ([]User, *serviceerror.ServiceError)) *UserServiceInterfaceMock_ProjectUserAttributes_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PurgeUser provides a mock function for the type UserServiceInterfaceMock
+func (_mock *UserServiceInterfaceMock) PurgeUser(ctx context.Context, userID string) *serviceerror.ServiceError {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PurgeUser")
+	}
+
+	var r0 *serviceerror.ServiceError
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *serviceerror.ServiceError); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*serviceerror.ServiceError)
+		}
+	}
+	return r0
+}
+
+// UserServiceInterfaceMock_PurgeUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PurgeUser'
+type UserServiceInterfaceMock_PurgeUser_Call struct {
+	*mock.Call
+}
+
+// PurgeUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *UserServiceInterfaceMock_Expecter) PurgeUser(ctx interface{}, userID interface{}) *UserServiceInterfaceMock_PurgeUser_Call {
+	return &UserServiceInterfaceMock_PurgeUser_Call{Call: _e.mock.On("PurgeUser", ctx, userID)}
+}
+
+func (_c *UserServiceInterfaceMock_PurgeUser_Call) Run(run func(ctx context.Context, userID string)) *UserServiceInterfaceMock_PurgeUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *UserServiceInterfaceMock_PurgeUser_Call) Return(serviceError *serviceerror.ServiceError) *UserServiceInterfaceMock_PurgeUser_Call {
+	_c.Call.Return(serviceError)
+	return _c
+}
+
+func (_c *UserServiceInterfaceMock_PurgeUser_Call) RunAndReturn(run func(ctx context.Context, userID string) *serviceerror.ServiceError) *UserServiceInterfaceMock_PurgeUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RestoreUser provides a mock function for the type UserServiceInterfaceMock
+func (_mock *UserServiceInterfaceMock) RestoreUser(ctx context.Context, userID string) (*User, *serviceerror.ServiceError) {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RestoreUser")
+	}
+
+	var r0 *User
+	var r1 *serviceerror.ServiceError
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*User, *serviceerror.ServiceError)); ok {
+		return returnFunc(ctx, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *User); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*User)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) *serviceerror.ServiceError); ok {
+		r1 = returnFunc(ctx, userID)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*serviceerror.ServiceError)
+		}
+	}
+	return r0, r1
+}
+
+// UserServiceInterfaceMock_RestoreUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RestoreUser'
+type UserServiceInterfaceMock_RestoreUser_Call struct {
+	*mock.Call
+}
+
+// RestoreUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *UserServiceInterfaceMock_Expecter) RestoreUser(ctx interface{}, userID interface{}) *UserServiceInterfaceMock_RestoreUser_Call {
+	return &UserServiceInterfaceMock_RestoreUser_Call{Call: _e.mock.On("RestoreUser", ctx, userID)}
+}
+
+func (_c *UserServiceInterfaceMock_RestoreUser_Call) Run(run func(ctx context.Context, userID string)) *UserServiceInterfaceMock_RestoreUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *UserServiceInterfaceMock_RestoreUser_Call) Return(user1 *User, serviceError *serviceerror.ServiceError) *UserServiceInterfaceMock_RestoreUser_Call {
+	_c.Call.Return(user1, serviceError)
+	return _c
+}
+
+func (_c *UserServiceInterfaceMock_RestoreUser_Call) RunAndReturn(run func(ctx context.Context, userID string) (*User, *serviceerror.ServiceError)) *UserServiceInterfaceMock_RestoreUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetAccountProtectionService provides a mock function for the type UserServiceInterfaceMock
+func (_mock *UserServiceInterfaceMock) SetAccountProtectionService(accountProtection accountprotection.AccountProtectionServiceInterface) {
+	_mock.Called(accountProtection)
+	return
+}
+
+// UserServiceInterfaceMock_SetAccountProtectionService_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetAccountProtectionService'
+type UserServiceInterfaceMock_SetAccountProtectionService_Call struct {
+	*mock.Call
+}
+
+// SetAccountProtectionService is a helper method to define mock.On call
+//   - accountProtection accountprotection.AccountProtectionServiceInterface
+func (_e *UserServiceInterfaceMock_Expecter) SetAccountProtectionService(accountProtection interface{}) *UserServiceInterfaceMock_SetAccountProtectionService_Call {
+	return &UserServiceInterfaceMock_SetAccountProtectionService_Call{Call: _e.mock.On("SetAccountProtectionService", accountProtection)}
+}
+
+func (_c *UserServiceInterfaceMock_SetAccountProtectionService_Call) Run(run func(accountProtection accountprotection.AccountProtectionServiceInterface)) *UserServiceInterfaceMock_SetAccountProtectionService_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 accountprotection.AccountProtectionServiceInterface
+		if args[0] != nil {
+			arg0 = args[0].(accountprotection.AccountProtectionServiceInterface)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *UserServiceInterfaceMock_SetAccountProtectionService_Call) Return() *UserServiceInterfaceMock_SetAccountProtectionService_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *UserServiceInterfaceMock_SetAccountProtectionService_Call) RunAndReturn(run func(accountProtection accountprotection.AccountProtectionServiceInterface)) *UserServiceInterfaceMock_SetAccountProtectionService_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetAppUserService provides a mock function for the type UserServiceInterfaceMock
+func (_mock *UserServiceInterfaceMock) SetAppUserService(appUserService appuser.AppUserServiceInterface) {
+	_mock.Called(appUserService)
+	return
+}
+
+// UserServiceInterfaceMock_SetAppUserService_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetAppUserService'
+type UserServiceInterfaceMock_SetAppUserService_Call struct {
+	*mock.Call
+}
+
+// SetAppUserService is a helper method to define mock.On call
+//   - appUserService appuser.AppUserServiceInterface
+func (_e *UserServiceInterfaceMock_Expecter) SetAppUserService(appUserService interface{}) *UserServiceInterfaceMock_SetAppUserService_Call {
+	return &UserServiceInterfaceMock_SetAppUserService_Call{Call: _e.mock.On("SetAppUserService", appUserService)}
+}
+
+func (_c *UserServiceInterfaceMock_SetAppUserService_Call) Run(run func(appUserService appuser.AppUserServiceInterface)) *UserServiceInterfaceMock_SetAppUserService_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 appuser.AppUserServiceInterface
+		if args[0] != nil {
+			arg0 = args[0].(appuser.AppUserServiceInterface)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *UserServiceInterfaceMock_SetAppUserService_Call) Return() *UserServiceInterfaceMock_SetAppUserService_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *UserServiceInterfaceMock_SetAppUserService_Call) RunAndReturn(run func(appUserService appuser.AppUserServiceInterface)) *UserServiceInterfaceMock_SetAppUserService_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetEmailChangeService provides a mock function for the type UserServiceInterfaceMock
+func (_mock *UserServiceInterfaceMock) SetEmailChangeService(emailChangeService emailchange.EmailChangeServiceInterface) {
+	_mock.Called(emailChangeService)
+	return
+}
+
+// UserServiceInterfaceMock_SetEmailChangeService_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetEmailChangeService'
+type UserServiceInterfaceMock_SetEmailChangeService_Call struct {
+	*mock.Call
+}
+
+// SetEmailChangeService is a helper method to define mock.On call
+//   - emailChangeService emailchange.EmailChangeServiceInterface
+func (_e *UserServiceInterfaceMock_Expecter) SetEmailChangeService(emailChangeService interface{}) *UserServiceInterfaceMock_SetEmailChangeService_Call {
+	return &UserServiceInterfaceMock_SetEmailChangeService_Call{Call: _e.mock.On("SetEmailChangeService", emailChangeService)}
+}
+
+func (_c *UserServiceInterfaceMock_SetEmailChangeService_Call) Run(run func(emailChangeService emailchange.EmailChangeServiceInterface)) *UserServiceInterfaceMock_SetEmailChangeService_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 emailchange.EmailChangeServiceInterface
+		if args[0] != nil {
+			arg0 = args[0].(emailchange.EmailChangeServiceInterface)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *UserServiceInterfaceMock_SetEmailChangeService_Call) Return() *UserServiceInterfaceMock_SetEmailChangeService_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *UserServiceInterfaceMock_SetEmailChangeService_Call) RunAndReturn(run func(emailChangeService emailchange.EmailChangeServiceInterface)) *UserServiceInterfaceMock_SetEmailChangeService_Call {
+	_c.Run(run)
+	return _c
+}
+
+// SetPasswordPolicyService provides a mock function for the type UserServiceInterfaceMock
+func (_mock *UserServiceInterfaceMock) SetPasswordPolicyService(passwordPolicy passwordpolicy.PasswordPolicyServiceInterface) {
+	_mock.Called(passwordPolicy)
+	return
+}
+
+// UserServiceInterfaceMock_SetPasswordPolicyService_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetPasswordPolicyService'
+type UserServiceInterfaceMock_SetPasswordPolicyService_Call struct {
+	*mock.Call
+}
+
+// SetPasswordPolicyService is a helper method to define mock.On call
+//   - passwordPolicy passwordpolicy.PasswordPolicyServiceInterface
+func (_e *UserServiceInterfaceMock_Expecter) SetPasswordPolicyService(passwordPolicy interface{}) *UserServiceInterfaceMock_SetPasswordPolicyService_Call {
+	return &UserServiceInterfaceMock_SetPasswordPolicyService_Call{Call: _e.mock.On("SetPasswordPolicyService", passwordPolicy)}
+}
+
+func (_c *UserServiceInterfaceMock_SetPasswordPolicyService_Call) Run(run func(passwordPolicy passwordpolicy.PasswordPolicyServiceInterface)) *UserServiceInterfaceMock_SetPasswordPolicyService_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 passwordpolicy.PasswordPolicyServiceInterface
+		if args[0] != nil {
+			arg0 = args[0].(passwordpolicy.PasswordPolicyServiceInterface)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *UserServiceInterfaceMock_SetPasswordPolicyService_Call) Return() *UserServiceInterfaceMock_SetPasswordPolicyService_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *UserServiceInterfaceMock_SetPasswordPolicyService_Call) RunAndReturn(run func(passwordPolicy passwordpolicy.PasswordPolicyServiceInterface)) *UserServiceInterfaceMock_SetPasswordPolicyService_Call {
+	_c.Run(run)
+	return _c
+}
+
+// SetSecurityNotifier provides a mock function for the type UserServiceInterfaceMock
+func (_mock *UserServiceInterfaceMock) SetSecurityNotifier(notifier securitynotification.SecurityNotificationServiceInterface) {
+	_mock.Called(notifier)
+	return
+}
+
+// UserServiceInterfaceMock_SetSecurityNotifier_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetSecurityNotifier'
+type UserServiceInterfaceMock_SetSecurityNotifier_Call struct {
+	*mock.Call
+}
+
+// SetSecurityNotifier is a helper method to define mock.On call
+//   - notifier securitynotification.SecurityNotificationServiceInterface
+func (_e *UserServiceInterfaceMock_Expecter) SetSecurityNotifier(notifier interface{}) *UserServiceInterfaceMock_SetSecurityNotifier_Call {
+	return &UserServiceInterfaceMock_SetSecurityNotifier_Call{Call: _e.mock.On("SetSecurityNotifier", notifier)}
+}
+
+func (_c *UserServiceInterfaceMock_SetSecurityNotifier_Call) Run(run func(notifier securitynotification.SecurityNotificationServiceInterface)) *UserServiceInterfaceMock_SetSecurityNotifier_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 securitynotification.SecurityNotificationServiceInterface
+		if args[0] != nil {
+			arg0 = args[0].(securitynotification.SecurityNotificationServiceInterface)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *UserServiceInterfaceMock_SetSecurityNotifier_Call) Return() *UserServiceInterfaceMock_SetSecurityNotifier_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *UserServiceInterfaceMock_SetSecurityNotifier_Call) RunAndReturn(run func(notifier securitynotification.SecurityNotificationServiceInterface)) *UserServiceInterfaceMock_SetSecurityNotifier_Call {
+	_c.Run(run)
+	return _c
+}
+
+// UpdateUser provides a mock function for the type UserServiceInterfaceMock
+func (_mock *UserServiceInterfaceMock) UpdateUser(ctx context.Context, userID string, user *User) (*User, *serviceerror.ServiceError) {
+	ret := _mock.Called(ctx, userID, user)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateUser")
+	}
+
+	var r0 *User
+	var r1 *serviceerror.ServiceError
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, *User) (*User, *serviceerror.ServiceError)); ok {
+		return returnFunc(ctx, userID, user)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, *User) *User); ok {
+		r0 = returnFunc(ctx, userID, user)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*User)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, *User) *serviceerror.ServiceError); ok {
+		r1 = returnFunc(ctx, userID, user)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*serviceerror.ServiceError)
+		}
+	}
+	return r0, r1
+}
+
+// UserServiceInterfaceMock_UpdateUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateUser'
+type UserServiceInterfaceMock_UpdateUser_Call struct {
+	*mock.Call
+}
+
+// UpdateUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - user *User
+func (_e *UserServiceInterfaceMock_Expecter) UpdateUser(ctx interface{}, userID interface{}, user interface{}) *UserServiceInterfaceMock_UpdateUser_Call {
+	return &UserServiceInterfaceMock_UpdateUser_Call{Call: _e.mock.On("UpdateUser", ctx, userID, user)}
+}
+
+func (_c *UserServiceInterfaceMock_UpdateUser_Call) Run(run func(ctx context.Context, userID string, user *User)) *UserServiceInterfaceMock_UpdateUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 *User
+		if args[2] != nil {
+			arg2 = args[2].(*User)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *UserServiceInterfaceMock_UpdateUser_Call) Return(user1 *User, serviceError *serviceerror.ServiceError) *UserServiceInterfaceMock_UpdateUser_Call {
+	_c.Call.Return(user1, serviceError)
+	return _c
+}
+
+func (_c *UserServiceInterfaceMock_UpdateUser_Call) RunAndReturn(run func(ctx context.Context, userID string, user *User) (*User, *serviceerror.ServiceError)) *UserServiceInterfaceMock_UpdateUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateUserAttributes provides a mock function for the type UserServiceInterfaceMock
+func (_mock *UserServiceInterfaceMock) UpdateUserAttributes(ctx context.Context, userID string, attributes json.RawMessage) (*User, *serviceerror.ServiceError) {
+	ret := _mock.Called(ctx, userID, attributes)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateUserAttributes")
+	}
+
+	var r0 *User
+	var r1 *serviceerror.ServiceError
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, json.RawMessage) (*User, *serviceerror.ServiceError)); ok {
+		return returnFunc(ctx, userID, attributes)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, json.RawMessage) *User); ok {
+		r0 = returnFunc(ctx, userID, attributes)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*User)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, json.RawMessage) *serviceerror.ServiceError); ok {
+		r1 = returnFunc(ctx, userID, attributes)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*serviceerror.ServiceError)
+		}
+	}
+	return r0, r1
+}
+
+// UserServiceInterfaceMock_UpdateUserAttributes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateUserAttributes'
+type UserServiceInterfaceMock_UpdateUserAttributes_Call struct {
+	*mock.Call
+}
+
+// UpdateUserAttributes is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - attributes json.RawMessage
+func (_e *UserServiceInterfaceMock_Expecter) UpdateUserAttributes(ctx interface{}, userID interface{}, attributes interface{}) *UserServiceInterfaceMock_UpdateUserAttributes_Call {
+	return &UserServiceInterfaceMock_UpdateUserAttributes_Call{Call: _e.mock.On("UpdateUserAttributes", ctx, userID, attributes)}
+}
+
+func (_c *UserServiceInterfaceMock_UpdateUserAttributes_Call) Run(run func(ctx context.Context, userID string, attributes json.RawMessage)) *UserServiceInterfaceMock_UpdateUserAttributes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 json.RawMessage
+		if args[2] != nil {
+			arg2 = args[2].(json.RawMessage)
+		}
+		run(
 			arg0,
 			arg1,
 			arg2,
@@ -726,8 +1700,8 @@ func (_c *UserServiceInterfaceMock_UpdateUserAttributes_Call) Run(run func(ctx c
 	return _c
 }
 
-func (_c *UserServiceInterfaceMock_UpdateUserAttributes_Call) Return(user *User, serviceError *serviceerror.ServiceError) *UserServiceInterfaceMock_UpdateUserAttributes_Call {
-	_c.Call.Return(user, serviceError)
+func (_c *UserServiceInterfaceMock_UpdateUserAttributes_Call) Return(user1 *User, serviceError *serviceerror.ServiceError) *UserServiceInterfaceMock_UpdateUserAttributes_Call {
+	_c.Call.Return(user1, serviceError)
 	return _c
 }
 
@@ -800,3 +1774,147 @@ func (_c *UserServiceInterfaceMock_UpdateUserCredentials_Call) RunAndReturn(run
 	_c.Call.Return(run)
 	return _c
 }
+
+// UpdateUserPicture provides a mock function for the type UserServiceInterfaceMock
+func (_mock *UserServiceInterfaceMock) UpdateUserPicture(ctx context.Context, userID string, data []byte) (string, *serviceerror.ServiceError) {
+	ret := _mock.Called(ctx, userID, data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateUserPicture")
+	}
+
+	var r0 string
+	var r1 *serviceerror.ServiceError
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, []byte) (string, *serviceerror.ServiceError)); ok {
+		return returnFunc(ctx, userID, data)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, []byte) string); ok {
+		r0 = returnFunc(ctx, userID, data)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, []byte) *serviceerror.ServiceError); ok {
+		r1 = returnFunc(ctx, userID, data)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*serviceerror.ServiceError)
+		}
+	}
+	return r0, r1
+}
+
+// UserServiceInterfaceMock_UpdateUserPicture_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateUserPicture'
+type UserServiceInterfaceMock_UpdateUserPicture_Call struct {
+	*mock.Call
+}
+
+// UpdateUserPicture is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - data []byte
+func (_e *UserServiceInterfaceMock_Expecter) UpdateUserPicture(ctx interface{}, userID interface{}, data interface{}) *UserServiceInterfaceMock_UpdateUserPicture_Call {
+	return &UserServiceInterfaceMock_UpdateUserPicture_Call{Call: _e.mock.On("UpdateUserPicture", ctx, userID, data)}
+}
+
+func (_c *UserServiceInterfaceMock_UpdateUserPicture_Call) Run(run func(ctx context.Context, userID string, data []byte)) *UserServiceInterfaceMock_UpdateUserPicture_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 []byte
+		if args[2] != nil {
+			arg2 = args[2].([]byte)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *UserServiceInterfaceMock_UpdateUserPicture_Call) Return(s string, serviceError *serviceerror.ServiceError) *UserServiceInterfaceMock_UpdateUserPicture_Call {
+	_c.Call.Return(s, serviceError)
+	return _c
+}
+
+func (_c *UserServiceInterfaceMock_UpdateUserPicture_Call) RunAndReturn(run func(ctx context.Context, userID string, data []byte) (string, *serviceerror.ServiceError)) *UserServiceInterfaceMock_UpdateUserPicture_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ValidateUserTypeSamples provides a mock function for the type UserServiceInterfaceMock
+func (_mock *UserServiceInterfaceMock) ValidateUserTypeSamples(ctx context.Context, request entitytype.SampleValidationRequest) (*entitytype.SampleValidationResponse, *serviceerror.ServiceError) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ValidateUserTypeSamples")
+	}
+
+	var r0 *entitytype.SampleValidationResponse
+	var r1 *serviceerror.ServiceError
+	if returnFunc, ok := ret.Get(0).(func(context.Context, entitytype.SampleValidationRequest) (*entitytype.SampleValidationResponse, *serviceerror.ServiceError)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, entitytype.SampleValidationRequest) *entitytype.SampleValidationResponse); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entitytype.SampleValidationResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, entitytype.SampleValidationRequest) *serviceerror.ServiceError); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*serviceerror.ServiceError)
+		}
+	}
+	return r0, r1
+}
+
+// UserServiceInterfaceMock_ValidateUserTypeSamples_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ValidateUserTypeSamples'
+type UserServiceInterfaceMock_ValidateUserTypeSamples_Call struct {
+	*mock.Call
+}
+
+// ValidateUserTypeSamples is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request entitytype.SampleValidationRequest
+func (_e *UserServiceInterfaceMock_Expecter) ValidateUserTypeSamples(ctx interface{}, request interface{}) *UserServiceInterfaceMock_ValidateUserTypeSamples_Call {
+	return &UserServiceInterfaceMock_ValidateUserTypeSamples_Call{Call: _e.mock.On("ValidateUserTypeSamples", ctx, request)}
+}
+
+func (_c *UserServiceInterfaceMock_ValidateUserTypeSamples_Call) Run(run func(ctx context.Context, request entitytype.SampleValidationRequest)) *UserServiceInterfaceMock_ValidateUserTypeSamples_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 entitytype.SampleValidationRequest
+		if args[1] != nil {
+			arg1 = args[1].(entitytype.SampleValidationRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *UserServiceInterfaceMock_ValidateUserTypeSamples_Call) Return(sampleValidationResponse *entitytype.SampleValidationResponse, serviceError *serviceerror.ServiceError) *UserServiceInterfaceMock_ValidateUserTypeSamples_Call {
+	_c.Call.Return(sampleValidationResponse, serviceError)
+	return _c
+}
+
+func (_c *UserServiceInterfaceMock_ValidateUserTypeSamples_Call) RunAndReturn(run func(ctx context.Context, request entitytype.SampleValidationRequest) (*entitytype.SampleValidationResponse, *serviceerror.ServiceError)) *UserServiceInterfaceMock_ValidateUserTypeSamples_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -0,0 +1,148 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package user
+
+import (
+	"bytes"
+	"encoding/json"
+	"slices"
+	"strings"
+)
+
+// projectionPathPrefix is the optional prefix of attribute projection paths.
+const projectionPathPrefix = "attributes."
+
+// maxProjectionPaths is the maximum number of paths in an attribute projection.
+const maxProjectionPaths = 100
+
+// projectionTree is a tree of attribute paths keyed by path segment. A nil subtree selects the whole
+// value of its segment.
+type projectionTree map[string]projectionTree
+
+// buildProjectionTree builds the projection tree of dot-separated attribute paths. It reports false if
+// a path is empty or has an empty segment.
+func buildProjectionTree(paths []string) (projectionTree, bool) {
+	if len(paths) > maxProjectionPaths {
+		return nil, false
+	}
+
+	tree := projectionTree{}
+	for _, path := range paths {
+		path = strings.TrimPrefix(strings.TrimSpace(path), projectionPathPrefix)
+		if path == "" {
+			return nil, false
+		}
+		segments := strings.Split(path, ".")
+		if slices.Contains(segments, "") {
+			return nil, false
+		}
+
+		node := tree
+		for i, segment := range segments {
+			child, exists := node[segment]
+			if exists && child == nil {
+				// A shorter path already selects the whole value.
+				break
+			}
+			if i == len(segments)-1 {
+				node[segment] = nil
+				break
+			}
+			if !exists {
+				child = projectionTree{}
+				node[segment] = child
+			}
+			node = child
+		}
+	}
+	return tree, true
+}
+
+// projectAttributes applies a projection tree to the attributes of a user. When include is true only
+// the selected paths are kept, otherwise the selected paths are removed. Paths that pass through an
+// array apply to every object in the array.
+func projectAttributes(attributes json.RawMessage, tree projectionTree, include bool) (json.RawMessage, error) {
+	if len(attributes) == 0 {
+		return attributes, nil
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(attributes))
+	decoder.UseNumber()
+	var values map[string]any
+	if err := decoder.Decode(&values); err != nil {
+		return nil, err
+	}
+
+	if include {
+		values = includeAttributePaths(values, tree)
+	} else {
+		excludeAttributePaths(values, tree)
+	}
+	return json.Marshal(values)
+}
+
+// includeAttributePaths returns the values selected by the projection tree.
+func includeAttributePaths(values map[string]any, tree projectionTree) map[string]any {
+	selected := make(map[string]any, len(tree))
+	for key, subtree := range tree {
+		value, ok := values[key]
+		if !ok {
+			continue
+		}
+		if subtree == nil {
+			selected[key] = value
+			continue
+		}
+
+		switch typed := value.(type) {
+		case map[string]any:
+			selected[key] = includeAttributePaths(typed, subtree)
+		case []any:
+			items := make([]any, 0, len(typed))
+			for _, item := range typed {
+				if object, ok := item.(map[string]any); ok {
+					items = append(items, includeAttributePaths(object, subtree))
+				}
+			}
+			selected[key] = items
+		}
+	}
+	return selected
+}
+
+// excludeAttributePaths removes the values selected by the projection tree.
+func excludeAttributePaths(values map[string]any, tree projectionTree) {
+	for key, subtree := range tree {
+		if subtree == nil {
+			delete(values, key)
+			continue
+		}
+
+		switch typed := values[key].(type) {
+		case map[string]any:
+			excludeAttributePaths(typed, subtree)
+		case []any:
+			for _, item := range typed {
+				if object, ok := item.(map[string]any); ok {
+					excludeAttributePaths(object, subtree)
+				}
+			}
+		}
+	}
+}
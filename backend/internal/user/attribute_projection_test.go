@@ -0,0 +1,236 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package user
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+)
+
+const projectionTestAttributes = `{
+	"username": "alice",
+	"email": "alice@example.com",
+	"age": 30,
+	"address": {"city": "Colombo", "zip": "00100", "geo": {"lat": 6.9, "lng": 79.8}},
+	"phones": [{"type": "work", "number": "111"}, {"type": "home", "number": "222"}]
+}`
+
+func TestBuildProjectionTree(t *testing.T) {
+	tree, ok := buildProjectionTree([]string{"address.city", "attributes.username", " address ", "phones.type"})
+	require.True(t, ok)
+	require.Equal(t, projectionTree{
+		"username": nil,
+		"address":  nil,
+		"phones":   projectionTree{"type": nil},
+	}, tree)
+}
+
+func TestBuildProjectionTree_InvalidPaths(t *testing.T) {
+	testCases := map[string][]string{
+		"empty path":      {""},
+		"empty segment":   {"address..city"},
+		"trailing dot":    {"address."},
+		"prefix only":     {"attributes."},
+		"too many paths":  make([]string, maxProjectionPaths+1),
+		"blank path only": {"   "},
+	}
+	for name, paths := range testCases {
+		t.Run(name, func(t *testing.T) {
+			_, ok := buildProjectionTree(paths)
+			require.False(t, ok)
+		})
+	}
+}
+
+func TestProjectAttributes_Include(t *testing.T) {
+	tree, ok := buildProjectionTree([]string{"username", "address.geo.lat", "phones.number", "missing"})
+	require.True(t, ok)
+
+	projected, err := projectAttributes(json.RawMessage(projectionTestAttributes), tree, true)
+
+	require.NoError(t, err)
+	require.JSONEq(t, `{
+		"username": "alice",
+		"address": {"geo": {"lat": 6.9}},
+		"phones": [{"number": "111"}, {"number": "222"}]
+	}`, string(projected))
+}
+
+func TestProjectAttributes_Exclude(t *testing.T) {
+	tree, ok := buildProjectionTree([]string{"email", "address.geo", "phones.type", "missing.path"})
+	require.True(t, ok)
+
+	projected, err := projectAttributes(json.RawMessage(projectionTestAttributes), tree, false)
+
+	require.NoError(t, err)
+	require.JSONEq(t, `{
+		"username": "alice",
+		"age": 30,
+		"address": {"city": "Colombo", "zip": "00100"},
+		"phones": [{"number": "111"}, {"number": "222"}]
+	}`, string(projected))
+}
+
+func TestProjectAttributes_PreservesNumberPrecision(t *testing.T) {
+	tree, ok := buildProjectionTree([]string{"employeeNumber"})
+	require.True(t, ok)
+
+	projected, err := projectAttributes(json.RawMessage(`{"employeeNumber": 12345678901234567890}`), tree, true)
+
+	require.NoError(t, err)
+	require.Equal(t, `{"employeeNumber":12345678901234567890}`, string(projected))
+}
+
+func TestProjectAttributes_EmptyAndInvalidAttributes(t *testing.T) {
+	tree, ok := buildProjectionTree([]string{"username"})
+	require.True(t, ok)
+
+	projected, err := projectAttributes(nil, tree, true)
+	require.NoError(t, err)
+	require.Nil(t, projected)
+
+	_, err = projectAttributes(json.RawMessage(`[1, 2]`), tree, true)
+	require.Error(t, err)
+}
+
+func TestUserService_ProjectUserAttributes(t *testing.T) {
+	service := &userService{}
+	users := []User{
+		{ID: "user-1", OUID: "ou-1", Type: "employee", Attributes: json.RawMessage(projectionTestAttributes)},
+		{ID: "user-2", OUID: "ou-2", Type: "customer"},
+	}
+
+	projected, svcErr := service.ProjectUserAttributes(users, AttributeProjection{
+		Attributes: []string{"username"},
+	})
+
+	require.Nil(t, svcErr)
+	require.Len(t, projected, 2)
+	require.Equal(t, "user-1", projected[0].ID)
+	require.Equal(t, "ou-1", projected[0].OUID)
+	require.Equal(t, "employee", projected[0].Type)
+	require.JSONEq(t, `{"username":"alice"}`, string(projected[0].Attributes))
+	require.Empty(t, projected[1].Attributes)
+	// The original users are not modified.
+	require.JSONEq(t, projectionTestAttributes, string(users[0].Attributes))
+}
+
+func TestUserService_ProjectUserAttributes_NoProjection(t *testing.T) {
+	service := &userService{}
+	users := []User{{ID: "user-1", Attributes: json.RawMessage(projectionTestAttributes)}}
+
+	projected, svcErr := service.ProjectUserAttributes(users, AttributeProjection{})
+
+	require.Nil(t, svcErr)
+	require.Equal(t, users, projected)
+}
+
+func TestUserService_ProjectUserAttributes_InvalidProjection(t *testing.T) {
+	service := &userService{}
+	testCases := map[string]AttributeProjection{
+		"both parameters": {Attributes: []string{"username"}, ExcludedAttributes: []string{"email"}},
+		"empty path":      {Attributes: []string{""}},
+		"empty segment":   {ExcludedAttributes: []string{"address..city"}},
+	}
+	for name, projection := range testCases {
+		t.Run(name, func(t *testing.T) {
+			_, svcErr := service.ProjectUserAttributes(nil, projection)
+			require.NotNil(t, svcErr)
+			require.Equal(t, ErrorInvalidAttributeProjection.Code, svcErr.Code)
+		})
+	}
+}
+
+func TestUserService_ProjectUserAttributes_MalformedAttributes(t *testing.T) {
+	service := &userService{}
+	users := []User{{ID: "user-1", Attributes: json.RawMessage(`not-json`)}}
+
+	_, svcErr := service.ProjectUserAttributes(users, AttributeProjection{ExcludedAttributes: []string{"email"}})
+
+	require.NotNil(t, svcErr)
+	require.Equal(t, serviceerror.InternalServerError.Code, svcErr.Code)
+}
+
+func TestHandleUserListRequest_WithAttributeProjection(t *testing.T) {
+	mockSvc := NewUserServiceInterfaceMock(t)
+	users := []User{{ID: "user-1", Attributes: json.RawMessage(projectionTestAttributes)}}
+	mockSvc.On("GetUserList", mock.Anything, 10, 0, mock.Anything, false).
+		Return(&UserListResponse{TotalResults: 1, Users: users}, nil)
+	mockSvc.On("ProjectUserAttributes", users,
+		AttributeProjection{Attributes: []string{"username", "address.city"}}).
+		Return([]User{{ID: "user-1", Attributes: json.RawMessage(`{"username":"alice"}`)}}, nil)
+
+	handler := newUserHandler(mockSvc, nil)
+	req := httptest.NewRequest(http.MethodGet, "/users?limit=10&offset=0&attributes=username,address.city", nil)
+	rr := httptest.NewRecorder()
+
+	handler.HandleUserListRequest(rr, req)
+
+	require.Equal(t, http.StatusOK, rr.Code)
+	var resp UserListResponse
+	require.NoError(t, json.NewDecoder(rr.Body).Decode(&resp))
+	require.JSONEq(t, `{"username":"alice"}`, string(resp.Users[0].Attributes))
+}
+
+func TestHandleUserGetRequest_WithExcludedAttributes(t *testing.T) {
+	mockSvc := NewUserServiceInterfaceMock(t)
+	userID := testUserID123
+	user := &User{ID: userID, Attributes: json.RawMessage(`{"username":"alice","email":"a@example.com"}`)}
+	mockSvc.On("GetUser", mock.Anything, userID, false).Return(user, nil)
+	mockSvc.On("ProjectUserAttributes", []User{*user}, AttributeProjection{ExcludedAttributes: []string{"email"}}).
+		Return([]User{{ID: userID, Attributes: json.RawMessage(`{"username":"alice"}`)}}, nil)
+
+	handler := newUserHandler(mockSvc, nil)
+	req := httptest.NewRequest(http.MethodGet, "/users/"+userID+"?excludedAttributes=email", nil)
+	req.SetPathValue("id", userID)
+	rr := httptest.NewRecorder()
+
+	handler.HandleUserGetRequest(rr, req)
+
+	require.Equal(t, http.StatusOK, rr.Code)
+	var resp User
+	require.NoError(t, json.NewDecoder(rr.Body).Decode(&resp))
+	require.Equal(t, userID, resp.ID)
+	require.JSONEq(t, `{"username":"alice"}`, string(resp.Attributes))
+}
+
+func TestHandleUserGetRequest_InvalidAttributeProjection(t *testing.T) {
+	mockSvc := NewUserServiceInterfaceMock(t)
+	userID := testUserID123
+	user := &User{ID: userID}
+	mockSvc.On("GetUser", mock.Anything, userID, false).Return(user, nil)
+	mockSvc.On("ProjectUserAttributes", []User{*user}, AttributeProjection{Attributes: []string{""}}).
+		Return(nil, &ErrorInvalidAttributeProjection)
+
+	handler := newUserHandler(mockSvc, nil)
+	req := httptest.NewRequest(http.MethodGet, "/users/"+userID+"?attributes=", nil)
+	req.SetPathValue("id", userID)
+	rr := httptest.NewRecorder()
+
+	handler.HandleUserGetRequest(rr, req)
+
+	require.Equal(t, http.StatusBadRequest, rr.Code)
+}
@@ -0,0 +1,175 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package user
+
+import (
+	"context"
+	"encoding/json"
+	"math/rand/v2"
+	"sort"
+	"strings"
+
+	"github.com/thunder-id/thunderid/internal/entitytype"
+	"github.com/thunder-id/thunderid/internal/system/config"
+	sysContext "github.com/thunder-id/thunderid/internal/system/context"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/internal/system/observability"
+	"github.com/thunder-id/thunderid/internal/system/observability/event"
+	"github.com/thunder-id/thunderid/internal/system/security"
+)
+
+// sensitiveReadAuditor publishes audit events when user management responses disclose attributes
+// marked as sensitive in the user type schema. Only attribute names are recorded, never values.
+// A nil auditor is valid and audits nothing.
+type sensitiveReadAuditor struct {
+	entityTypeService entitytype.EntityTypeServiceInterface
+	observabilitySvc  observability.ObservabilityServiceInterface
+	sampleRate        float64
+	sample            func() float64
+}
+
+// newSensitiveReadAuditor creates a sensitiveReadAuditor, or returns nil when auditing is disabled.
+func newSensitiveReadAuditor(
+	entityTypeService entitytype.EntityTypeServiceInterface,
+	observabilitySvc observability.ObservabilityServiceInterface,
+	auditConfig config.SensitiveReadAuditConfig,
+) *sensitiveReadAuditor {
+	if !auditConfig.Enabled || entityTypeService == nil || observabilitySvc == nil {
+		return nil
+	}
+	return &sensitiveReadAuditor{
+		entityTypeService: entityTypeService,
+		observabilitySvc:  observabilitySvc,
+		sampleRate:        auditConfig.SampleRate,
+		sample:            rand.Float64,
+	}
+}
+
+// auditRead publishes an audit event for each user whose returned attributes include sensitive
+// attributes, subject to the configured sample rate.
+func (a *sensitiveReadAuditor) auditRead(ctx context.Context, users ...User) {
+	if a == nil || !a.observabilitySvc.IsEnabled() {
+		return
+	}
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, handlerLoggerComponentName))
+
+	actorID := security.GetSubject(ctx)
+	sensitiveByType := make(map[string][]string)
+	for _, user := range users {
+		if len(user.Attributes) == 0 {
+			continue
+		}
+
+		sensitivePaths, resolved := sensitiveByType[user.Type]
+		if !resolved {
+			var svcErr *serviceerror.ServiceError
+			sensitivePaths, svcErr = a.entityTypeService.GetSensitiveAttributes(
+				ctx, entitytype.TypeCategoryUser, user.Type)
+			if svcErr != nil {
+				logger.Warn("Failed to resolve sensitive attributes, skipping read audit",
+					log.String("userType", user.Type), log.Any("error", svcErr))
+				sensitivePaths = nil
+			}
+			sensitiveByType[user.Type] = sensitivePaths
+		}
+
+		disclosed := disclosedAttributes(user.Attributes, sensitivePaths)
+		if len(disclosed) == 0 || a.sample() >= a.sampleRate {
+			continue
+		}
+
+		evt := event.NewEvent(
+			sysContext.GetTraceID(ctx),
+			string(event.EventTypeSensitiveAttributesRead),
+			event.ComponentUserManagement,
+		).
+			WithTenantID(sysContext.GetTenantID(ctx)).
+			WithStatus(event.StatusSuccess).
+			WithData(event.DataKey.ActorID, actorID).
+			WithData(event.DataKey.UserID, user.ID).
+			WithData(event.DataKey.Attributes, strings.Join(disclosed, ","))
+
+		a.observabilitySvc.PublishEvent(evt)
+	}
+}
+
+// disclosedAttributes returns the sorted sensitive attribute paths that have a value in the attributes.
+func disclosedAttributes(attributes json.RawMessage, sensitivePaths []string) []string {
+	if len(sensitivePaths) == 0 {
+		return nil
+	}
+	var attrs map[string]interface{}
+	if err := json.Unmarshal(attributes, &attrs); err != nil {
+		return nil
+	}
+
+	var disclosed []string
+	for _, path := range sensitivePaths {
+		if hasAttributeValue(attrs, strings.Split(path, ".")) {
+			disclosed = append(disclosed, path)
+		}
+	}
+	sort.Strings(disclosed)
+	return disclosed
+}
+
+// hasAttributeValue reports whether a non-null value exists at the given path segments.
+func hasAttributeValue(attrs map[string]interface{}, segments []string) bool {
+	value, exists := attrs[segments[0]]
+	if !exists || value == nil {
+		return false
+	}
+	if len(segments) == 1 {
+		return true
+	}
+	nested, ok := value.(map[string]interface{})
+	return ok && hasAttributeValue(nested, segments[1:])
+}
+
+// publishUserEvent records a change to a user in the audit trail. Credential types are recorded by name only.
+func (us *userService) publishUserEvent(
+	ctx context.Context, eventType event.EventType, userID, ouID string, credentialTypes ...string) {
+	if us.observabilitySvc == nil || !us.observabilitySvc.IsEnabled() {
+		return
+	}
+
+	evt := event.NewEvent(sysContext.GetTraceID(ctx), string(eventType), event.ComponentUserManagement).
+		WithTenantID(sysContext.GetTenantID(ctx)).
+		WithStatus(event.StatusSuccess).
+		WithData(event.DataKey.UserID, userID).
+		WithData(event.DataKey.OUID, ouID)
+	if len(credentialTypes) > 0 {
+		evt.WithData(event.DataKey.CredentialTypes, strings.Join(credentialTypes, ","))
+	}
+	if actorID := security.GetSubject(ctx); actorID != "" {
+		evt.WithData(event.DataKey.ActorID, actorID)
+	}
+	us.observabilitySvc.PublishEvent(evt)
+}
+
+// credentialTypes returns the sorted credential types of the credentials.
+func credentialTypes(credentials map[string]json.RawMessage) []string {
+	types := make([]string, 0, len(credentials))
+	for credentialType := range credentials {
+		types = append(types, credentialType)
+	}
+	sort.Strings(types)
+	return types
+}
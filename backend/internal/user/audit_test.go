@@ -0,0 +1,154 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package user
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/entitytype"
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/observability/event"
+	"github.com/thunder-id/thunderid/internal/system/security"
+	"github.com/thunder-id/thunderid/tests/mocks/entitytypemock"
+	"github.com/thunder-id/thunderid/tests/mocks/observability/observabilitymock"
+)
+
+type SensitiveReadAuditorTestSuite struct {
+	suite.Suite
+	entityTypeSvc *entitytypemock.EntityTypeServiceInterfaceMock
+	obsSvc        *observabilitymock.ObservabilityServiceInterfaceMock
+	auditor       *sensitiveReadAuditor
+	ctx           context.Context
+}
+
+func TestSensitiveReadAuditorTestSuite(t *testing.T) {
+	suite.Run(t, new(SensitiveReadAuditorTestSuite))
+}
+
+func (s *SensitiveReadAuditorTestSuite) SetupTest() {
+	s.entityTypeSvc = entitytypemock.NewEntityTypeServiceInterfaceMock(s.T())
+	s.obsSvc = observabilitymock.NewObservabilityServiceInterfaceMock(s.T())
+	s.auditor = newSensitiveReadAuditor(s.entityTypeSvc, s.obsSvc,
+		config.SensitiveReadAuditConfig{Enabled: true, SampleRate: 1})
+	s.ctx = security.WithSecurityContextTest(context.Background(),
+		security.NewSecurityContextForTest("admin-1", "", "", nil, nil))
+}
+
+func (s *SensitiveReadAuditorTestSuite) TestNewSensitiveReadAuditor_Disabled() {
+	s.Nil(newSensitiveReadAuditor(s.entityTypeSvc, s.obsSvc, config.SensitiveReadAuditConfig{}))
+
+	// A nil auditor must be safe to call.
+	var auditor *sensitiveReadAuditor
+	auditor.auditRead(s.ctx, User{ID: "u1"})
+}
+
+func (s *SensitiveReadAuditorTestSuite) TestAuditRead_PublishesAttributeNamesOnly() {
+	s.obsSvc.On("IsEnabled").Return(true)
+	s.entityTypeSvc.On("GetSensitiveAttributes", s.ctx, entitytype.TypeCategoryUser, "person").
+		Return([]string{"ssn", "address.zip", "salary"}, nil).Once()
+
+	var published []*event.Event
+	s.obsSvc.On("PublishEvent", mock.Anything).Run(func(args mock.Arguments) {
+		published = append(published, args.Get(0).(*event.Event))
+	}).Return()
+
+	s.auditor.auditRead(s.ctx,
+		User{ID: "u1", Type: "person",
+			Attributes: json.RawMessage(`{"ssn":"123-45-6789","address":{"zip":"12345"},"salary":null}`)},
+		User{ID: "u2", Type: "person", Attributes: json.RawMessage(`{"email":"u2@example.com"}`)},
+	)
+
+	s.Require().Len(published, 1)
+	evt := published[0]
+	s.Equal(string(event.EventTypeSensitiveAttributesRead), evt.Type)
+	s.Equal("admin-1", evt.Data[event.DataKey.ActorID])
+	s.Equal("u1", evt.Data[event.DataKey.UserID])
+	s.Equal("address.zip,ssn", evt.Data[event.DataKey.Attributes])
+	s.NotContains(evt.Data, "123-45-6789")
+}
+
+func (s *SensitiveReadAuditorTestSuite) TestAuditRead_Sampling() {
+	s.obsSvc.On("IsEnabled").Return(true)
+	s.entityTypeSvc.On("GetSensitiveAttributes", s.ctx, entitytype.TypeCategoryUser, "person").
+		Return([]string{"ssn"}, nil).Once()
+	s.obsSvc.On("PublishEvent", mock.Anything).Return().Once()
+
+	s.auditor.sampleRate = 0.5
+	samples := []float64{0.7, 0.2}
+	s.auditor.sample = func() float64 {
+		value := samples[0]
+		samples = samples[1:]
+		return value
+	}
+
+	user := User{ID: "u1", Type: "person", Attributes: json.RawMessage(`{"ssn":"123-45-6789"}`)}
+	s.auditor.auditRead(s.ctx, user, user)
+}
+
+func (s *SensitiveReadAuditorTestSuite) TestAuditRead_ObservabilityDisabled() {
+	s.obsSvc.On("IsEnabled").Return(false)
+
+	s.auditor.auditRead(s.ctx, User{ID: "u1", Type: "person", Attributes: json.RawMessage(`{"ssn":"1"}`)})
+
+	s.entityTypeSvc.AssertNotCalled(s.T(), "GetSensitiveAttributes", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (s *SensitiveReadAuditorTestSuite) TestAuditRead_SchemaLookupFailure() {
+	s.obsSvc.On("IsEnabled").Return(true)
+	s.entityTypeSvc.On("GetSensitiveAttributes", s.ctx, entitytype.TypeCategoryUser, "unknown").
+		Return(nil, &entitytype.ErrorEntityTypeNotFound).Once()
+
+	s.auditor.auditRead(s.ctx, User{ID: "u1", Type: "unknown", Attributes: json.RawMessage(`{"ssn":"1"}`)})
+
+	s.obsSvc.AssertNotCalled(s.T(), "PublishEvent", mock.Anything)
+}
+
+func (s *SensitiveReadAuditorTestSuite) TestPublishUserEvent() {
+	s.obsSvc.On("IsEnabled").Return(true)
+	var published *event.Event
+	s.obsSvc.On("PublishEvent", mock.Anything).Run(func(args mock.Arguments) {
+		published = args.Get(0).(*event.Event)
+	}).Return().Once()
+
+	svc := &userService{observabilitySvc: s.obsSvc}
+	svc.publishUserEvent(s.ctx, event.EventTypeUserCredentialsUpdated, "u1", "ou-1",
+		credentialTypes(map[string]json.RawMessage{"pin": nil, "password": nil})...)
+
+	s.Require().NotNil(published)
+	s.Equal(string(event.EventTypeUserCredentialsUpdated), published.Type)
+	s.Equal(event.StatusSuccess, published.Status)
+	s.Equal("admin-1", published.Data[event.DataKey.ActorID])
+	s.Equal("u1", published.Data[event.DataKey.UserID])
+	s.Equal("ou-1", published.Data[event.DataKey.OUID])
+	s.Equal("password,pin", published.Data[event.DataKey.CredentialTypes])
+}
+
+func (s *SensitiveReadAuditorTestSuite) TestPublishUserEvent_ObservabilityUnavailable() {
+	(&userService{}).publishUserEvent(s.ctx, event.EventTypeUserCreated, "u1", "ou-1")
+
+	s.obsSvc.On("IsEnabled").Return(false)
+	(&userService{observabilitySvc: s.obsSvc}).publishUserEvent(s.ctx, event.EventTypeUserCreated, "u1", "ou-1")
+
+	s.obsSvc.AssertNotCalled(s.T(), "PublishEvent", mock.Anything)
+}
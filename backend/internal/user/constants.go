@@ -44,3 +44,21 @@ func (ct CredentialType) String() string {
 func (ct CredentialType) IsSystemManaged() bool {
 	return slices.Contains(systemManagedCredentialTypes, ct)
 }
+
+// Query parameters of the attribute projection on user read operations.
+const (
+	queryParamAttributes         = "attributes"
+	queryParamExcludedAttributes = "excludedAttributes"
+)
+
+// Query parameter and value listing the soft-deleted users. deletedStatusQuery is appended to the pagination
+// links of deleted user listings.
+const (
+	queryParamStatus   = "status"
+	userStatusDeleted  = "deleted"
+	deletedStatusQuery = "&" + queryParamStatus + "=" + userStatusDeleted
+)
+
+// previousStateAttribute is the system attribute recording the state of a soft-deleted user, restored when
+// the user is restored.
+const previousStateAttribute = "previousState"
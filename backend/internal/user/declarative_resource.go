@@ -304,6 +304,8 @@ func parseCredentials(credentialsMap map[string]interface{}) (Credentials, error
 		return nil, fmt.Errorf("failed to initialize hash service: %w", err)
 	}
 
+	var pendingTypes []CredentialType
+	var pendingValues [][]byte
 	for credType, credValue := range credentialsMap {
 		credentialType := CredentialType(credType)
 
@@ -319,25 +321,9 @@ func parseCredentials(credentialsMap map[string]interface{}) (Credentials, error
 				continue
 			}
 
-			hashedCred, err := hashService.Generate([]byte(v))
-			if err != nil {
-				return nil, fmt.Errorf("failed to hash credential %s: %w", credType, err)
-			}
-
-			credential := Credential{
-				StorageType: "hash",
-				StorageAlgo: hashedCred.Algorithm,
-				StorageAlgoParams: hash.CredParameters{
-					Iterations:  hashedCred.Parameters.Iterations,
-					Memory:      hashedCred.Parameters.Memory,
-					Parallelism: hashedCred.Parameters.Parallelism,
-					KeySize:     hashedCred.Parameters.KeySize,
-					Salt:        hashedCred.Parameters.Salt,
-				},
-				Value: hashedCred.Hash,
-			}
-
-			credentials[credentialType] = []Credential{credential}
+			// Plaintext values are hashed together after the loop.
+			pendingTypes = append(pendingTypes, credentialType)
+			pendingValues = append(pendingValues, []byte(v))
 
 		case []interface{}:
 			// Full format: array of credential objects
@@ -371,6 +357,28 @@ func parseCredentials(credentialsMap map[string]interface{}) (Credentials, error
 		}
 	}
 
+	results, err := hash.GenerateBatch(context.Background(), hashService, pendingValues)
+	if err != nil {
+		return nil, fmt.Errorf("failed to hash credentials: %w", err)
+	}
+	for i, res := range results {
+		if res.Err != nil {
+			return nil, fmt.Errorf("failed to hash credential %s: %w", pendingTypes[i], res.Err)
+		}
+		credentials[pendingTypes[i]] = []Credential{{
+			StorageType: "hash",
+			StorageAlgo: res.Credential.Algorithm,
+			StorageAlgoParams: hash.CredParameters{
+				Iterations:  res.Credential.Parameters.Iterations,
+				Memory:      res.Credential.Parameters.Memory,
+				Parallelism: res.Credential.Parameters.Parallelism,
+				KeySize:     res.Credential.Parameters.KeySize,
+				Salt:        res.Credential.Parameters.Salt,
+			},
+			Value: res.Credential.Hash,
+		}}
+	}
+
 	return credentials, nil
 }
 
@@ -0,0 +1,109 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package user
+
+import (
+	"context"
+	"strings"
+
+	"github.com/thunder-id/thunderid/internal/entitytype"
+	oupkg "github.com/thunder-id/thunderid/internal/ou"
+	serverconst "github.com/thunder-id/thunderid/internal/system/constants"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/internal/system/security"
+	"github.com/thunder-id/thunderid/internal/system/utils"
+)
+
+// resolveUserType resolves the user type of a user created in an organization unit without one.
+//
+// The default user type of the organization unit, inherited from the nearest ancestor that sets one, is used
+// when present. Otherwise the user type is resolved only when exactly one user type is defined in the
+// organization unit or its ancestors. The lookups run as a runtime caller because the caller has already
+// been authorized to create users in the organization unit.
+func (us *userService) resolveUserType(
+	ctx context.Context, ouID string, logger *log.Logger,
+) (string, *serviceerror.ServiceError) {
+	if strings.TrimSpace(ouID) == "" {
+		return "", &ErrorInvalidOUID
+	}
+	if us.ouService == nil || us.entityTypeService == nil {
+		logger.Error("Organization unit and user type services are required to resolve the user type")
+		return "", &serviceerror.InternalServerError
+	}
+	runtimeCtx := security.WithRuntimeContext(ctx)
+
+	resolution, svcErr := oupkg.ResolveDefaultUserType(runtimeCtx, us.ouService, ouID)
+	if svcErr != nil {
+		return "", mapOUServiceError(
+			svcErr,
+			logger,
+			"resolving the default user type",
+			map[string]*serviceerror.ServiceError{
+				oupkg.ErrorOrganizationUnitNotFound.Code: &ErrorOrganizationUnitNotFound,
+			},
+			log.String("oUID", ouID),
+		)
+	}
+	if resolution.UserType != "" {
+		logger.Debug("Resolved the default user type of the organization unit",
+			log.String("oUID", ouID), log.String("userType", resolution.UserType),
+			log.String("sourceOUID", resolution.SourceOUID))
+		return resolution.UserType, nil
+	}
+
+	lineage := make(map[string]struct{}, len(resolution.Lineage))
+	for _, id := range resolution.Lineage {
+		lineage[id] = struct{}{}
+	}
+
+	userTypes, svcErr := utils.CollectAllPages(serverconst.MaxPageSize,
+		func(limit, offset int) ([]entitytype.EntityTypeListItem, int, *serviceerror.ServiceError) {
+			list, svcErr := us.entityTypeService.GetEntityTypeList(
+				runtimeCtx, entitytype.TypeCategoryUser, limit, offset, false)
+			if svcErr != nil {
+				return nil, 0, svcErr
+			}
+			return list.Types, list.TotalResults, nil
+		})
+	if svcErr != nil {
+		logger.Error("Failed to list user types", log.Any("error", svcErr))
+		return "", &serviceerror.InternalServerError
+	}
+
+	candidates := make([]string, 0, 1)
+	for _, userType := range userTypes {
+		if _, ok := lineage[userType.OUID]; ok {
+			candidates = append(candidates, userType.Name)
+		}
+	}
+
+	switch len(candidates) {
+	case 0:
+		return "", &ErrorUserTypeNotResolved
+	case 1:
+		logger.Debug("Resolved the only user type available to the organization unit",
+			log.String("oUID", ouID), log.String("userType", candidates[0]))
+		return candidates[0], nil
+	default:
+		logger.Debug("Several user types are available to the organization unit",
+			log.String("oUID", ouID), log.Int("count", len(candidates)))
+		return "", &ErrorAmbiguousUserType
+	}
+}
@@ -0,0 +1,176 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package user
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/thunder-id/thunderid/internal/entitytype"
+	oupkg "github.com/thunder-id/thunderid/internal/ou"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/tests/mocks/entitytypemock"
+	"github.com/thunder-id/thunderid/tests/mocks/oumock"
+)
+
+const (
+	resolveTestRootOU  = "root-ou"
+	resolveTestChildOU = "child-ou"
+)
+
+func TestResolveUserType(t *testing.T) {
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, "UserServiceTest"))
+	rootOUID := resolveTestRootOU
+
+	setupOUs := func(t *testing.T, rootDefault string) *oumock.OrganizationUnitServiceInterfaceMock {
+		ouServiceMock := oumock.NewOrganizationUnitServiceInterfaceMock(t)
+		ouServiceMock.On("GetOrganizationUnit", mock.Anything, resolveTestChildOU).
+			Return(oupkg.OrganizationUnit{ID: resolveTestChildOU, Parent: &rootOUID}, nil).Maybe()
+		ouServiceMock.On("GetOrganizationUnit", mock.Anything, resolveTestRootOU).
+			Return(oupkg.OrganizationUnit{ID: resolveTestRootOU, DefaultUserType: rootDefault}, nil).Maybe()
+		return ouServiceMock
+	}
+	setupTypes := func(
+		t *testing.T, items ...entitytype.EntityTypeListItem,
+	) *entitytypemock.EntityTypeServiceInterfaceMock {
+		entityTypeMock := entitytypemock.NewEntityTypeServiceInterfaceMock(t)
+		entityTypeMock.On("GetEntityTypeList", mock.Anything, entitytype.TypeCategoryUser, mock.Anything, 0, false).
+			Return(&entitytype.EntityTypeListResponse{TotalResults: len(items), Types: items}, nil).Once()
+		return entityTypeMock
+	}
+
+	t.Run("InheritsDefaultFromAncestor", func(t *testing.T) {
+		service := &userService{
+			ouService:         setupOUs(t, "employee"),
+			entityTypeService: entitytypemock.NewEntityTypeServiceInterfaceMock(t),
+		}
+
+		userType, svcErr := service.resolveUserType(context.Background(), resolveTestChildOU, logger)
+
+		require.Nil(t, svcErr)
+		require.Equal(t, "employee", userType)
+	})
+
+	t.Run("ResolvesSingleAvailableType", func(t *testing.T) {
+		service := &userService{
+			ouService: setupOUs(t, ""),
+			entityTypeService: setupTypes(t,
+				entitytype.EntityTypeListItem{Name: "customer", OUID: resolveTestRootOU},
+				entitytype.EntityTypeListItem{Name: "partner", OUID: "other-ou"},
+			),
+		}
+
+		userType, svcErr := service.resolveUserType(context.Background(), resolveTestChildOU, logger)
+
+		require.Nil(t, svcErr)
+		require.Equal(t, "customer", userType)
+	})
+
+	t.Run("ReturnsAmbiguousWhenSeveralTypesAvailable", func(t *testing.T) {
+		service := &userService{
+			ouService: setupOUs(t, ""),
+			entityTypeService: setupTypes(t,
+				entitytype.EntityTypeListItem{Name: "customer", OUID: resolveTestRootOU},
+				entitytype.EntityTypeListItem{Name: "employee", OUID: resolveTestChildOU},
+			),
+		}
+
+		_, svcErr := service.resolveUserType(context.Background(), resolveTestChildOU, logger)
+
+		require.Equal(t, &ErrorAmbiguousUserType, svcErr)
+	})
+
+	t.Run("ReturnsNotResolvedWhenNoTypeAvailable", func(t *testing.T) {
+		service := &userService{
+			ouService: setupOUs(t, ""),
+			entityTypeService: setupTypes(t,
+				entitytype.EntityTypeListItem{Name: "partner", OUID: "other-ou"},
+			),
+		}
+
+		_, svcErr := service.resolveUserType(context.Background(), resolveTestChildOU, logger)
+
+		require.Equal(t, &ErrorUserTypeNotResolved, svcErr)
+	})
+
+	t.Run("ReturnsErrorWhenOUMissing", func(t *testing.T) {
+		ouServiceMock := oumock.NewOrganizationUnitServiceInterfaceMock(t)
+		ouServiceMock.On("GetOrganizationUnit", mock.Anything, "missing-ou").
+			Return(oupkg.OrganizationUnit{}, &oupkg.ErrorOrganizationUnitNotFound).Once()
+		service := &userService{
+			ouService:         ouServiceMock,
+			entityTypeService: entitytypemock.NewEntityTypeServiceInterfaceMock(t),
+		}
+
+		_, svcErr := service.resolveUserType(context.Background(), "missing-ou", logger)
+
+		require.Equal(t, &ErrorOrganizationUnitNotFound, svcErr)
+	})
+
+	t.Run("ReturnsInternalErrorWhenTypeListFails", func(t *testing.T) {
+		entityTypeMock := entitytypemock.NewEntityTypeServiceInterfaceMock(t)
+		entityTypeMock.On("GetEntityTypeList", mock.Anything, entitytype.TypeCategoryUser, mock.Anything, 0, false).
+			Return((*entitytype.EntityTypeListResponse)(nil), &serviceerror.InternalServerError).Once()
+		service := &userService{
+			ouService:         setupOUs(t, ""),
+			entityTypeService: entityTypeMock,
+		}
+
+		_, svcErr := service.resolveUserType(context.Background(), resolveTestChildOU, logger)
+
+		require.Equal(t, &serviceerror.InternalServerError, svcErr)
+	})
+
+	t.Run("ReturnsErrorWhenOUIDEmpty", func(t *testing.T) {
+		_, svcErr := (&userService{}).resolveUserType(context.Background(), " ", logger)
+
+		require.Equal(t, &ErrorInvalidOUID, svcErr)
+	})
+
+	t.Run("ReturnsInternalErrorWhenServicesMissing", func(t *testing.T) {
+		_, svcErr := (&userService{}).resolveUserType(context.Background(), resolveTestChildOU, logger)
+
+		require.Equal(t, &serviceerror.InternalServerError, svcErr)
+	})
+}
+
+func TestCreateUser_ResolvesMissingUserType(t *testing.T) {
+	ouServiceMock := oumock.NewOrganizationUnitServiceInterfaceMock(t)
+	ouServiceMock.On("GetOrganizationUnit", mock.Anything, resolveTestRootOU).
+		Return(oupkg.OrganizationUnit{ID: resolveTestRootOU}, nil).Once()
+	entityTypeMock := entitytypemock.NewEntityTypeServiceInterfaceMock(t)
+	entityTypeMock.On("GetEntityTypeList", mock.Anything, entitytype.TypeCategoryUser, mock.Anything, 0, false).
+		Return(&entitytype.EntityTypeListResponse{TotalResults: 2, Types: []entitytype.EntityTypeListItem{
+			{Name: "customer", OUID: resolveTestRootOU},
+			{Name: "employee", OUID: resolveTestRootOU},
+		}}, nil).Once()
+	service := &userService{
+		authzService:      newAllowAllAuthz(t),
+		ouService:         ouServiceMock,
+		entityTypeService: entityTypeMock,
+	}
+
+	_, svcErr := service.CreateUser(context.Background(), &User{OUID: resolveTestRootOU})
+
+	require.Equal(t, &ErrorAmbiguousUserType, svcErr)
+}
@@ -313,6 +313,206 @@ var (
 			DefaultValue: "Multiple users match the provided filters",
 		},
 	}
+	// ErrorInvalidPicture is the error returned when the uploaded picture is not a supported image.
+	ErrorInvalidPicture = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "USR-1027",
+		Error: core.I18nMessage{
+			Key:          "error.userservice.invalid_picture",
+			DefaultValue: "Invalid picture",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.userservice.invalid_picture_description",
+			DefaultValue: "The picture must be a valid JPEG, PNG or GIF image",
+		},
+	}
+	// ErrorPictureTooLarge is the error returned when the uploaded picture exceeds the size limit.
+	ErrorPictureTooLarge = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "USR-1028",
+		Error: core.I18nMessage{
+			Key:          "error.userservice.picture_too_large",
+			DefaultValue: "Picture too large",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.userservice.picture_too_large_description",
+			DefaultValue: "The picture exceeds the maximum allowed size",
+		},
+	}
+	// ErrorPictureNotFound is the error returned when the user does not have a picture.
+	ErrorPictureNotFound = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "USR-1029",
+		Error: core.I18nMessage{
+			Key:          "error.userservice.picture_not_found",
+			DefaultValue: "Picture not found",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.userservice.picture_not_found_description",
+			DefaultValue: "The user does not have a picture",
+		},
+	}
+	// ErrorInvalidPictureSignature is the error returned when a signed picture URL is invalid or expired.
+	ErrorInvalidPictureSignature = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "USR-1030",
+		Error: core.I18nMessage{
+			Key:          "error.userservice.invalid_picture_signature",
+			DefaultValue: "Invalid picture URL",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.userservice.invalid_picture_signature_description",
+			DefaultValue: "The picture URL signature is invalid or has expired",
+		},
+	}
+	// ErrorDeniedIdentifier is the error returned when a user identifier is blocked by the username deny list.
+	ErrorDeniedIdentifier = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "USR-1031",
+		Error: core.I18nMessage{
+			Key:          "error.userservice.denied_identifier",
+			DefaultValue: "Identifier not allowed",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.userservice.denied_identifier_description",
+			DefaultValue: "The user identifier is not allowed in this deployment",
+		},
+	}
+	// ErrorDeniedCredential is the error returned when a user credential is blocked by the password deny list.
+	ErrorDeniedCredential = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "USR-1032",
+		Error: core.I18nMessage{
+			Key:          "error.userservice.denied_credential",
+			DefaultValue: "Credential not allowed",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.userservice.denied_credential_description",
+			DefaultValue: "The credential is not allowed in this deployment",
+		},
+	}
+	// ErrorInvalidAttributeProjection is the error returned when the attribute projection parameters are invalid.
+	ErrorInvalidAttributeProjection = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "USR-1033",
+		Error: core.I18nMessage{
+			Key:          "error.userservice.invalid_attribute_projection",
+			DefaultValue: "Invalid attribute projection",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.userservice.invalid_attribute_projection_description",
+			DefaultValue: "The attributes and excludedAttributes parameters are invalid or used together",
+		},
+	}
+	// ErrorUserQuotaExceeded is the error returned when creating a user would exceed the user quota.
+	ErrorUserQuotaExceeded = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "USR-1034",
+		Error: core.I18nMessage{
+			Key:          "error.userservice.quota_exceeded",
+			DefaultValue: "Quota exceeded",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.userservice.quota_exceeded_description",
+			DefaultValue: "The user quota of the organization unit or the tenant has been reached",
+		},
+	}
+	// ErrorCredentialNotFound is the error returned when the user does not have a credential of the given type.
+	ErrorCredentialNotFound = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "USR-1035",
+		Error: core.I18nMessage{
+			Key:          "error.userservice.credential_not_found",
+			DefaultValue: "Credential not found",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.userservice.credential_not_found_description",
+			DefaultValue: "The user does not have a credential of the given type",
+		},
+	}
+	// ErrorCredentialPolicyViolation is the error returned when the credentials of a user would not satisfy
+	// the credential policy of the user type.
+	ErrorCredentialPolicyViolation = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "USR-1036",
+		Error: core.I18nMessage{
+			Key:          "error.userservice.credential_policy_violation",
+			DefaultValue: "Credential policy violation",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.userservice.credential_policy_violation_description",
+			DefaultValue: "The credentials of the user would not satisfy the credential policy of the user type",
+		},
+	}
+	// ErrorUserTypeNotResolved is the error returned when a user is created without a user type and no user
+	// type is available to the organization unit.
+	ErrorUserTypeNotResolved = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "USR-1037",
+		Error: core.I18nMessage{
+			Key:          "error.userservice.user_type_not_resolved",
+			DefaultValue: "User type not resolved",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.userservice.user_type_not_resolved_description",
+			DefaultValue: "No user type is available to the organization unit. Specify the user type",
+		},
+	}
+	// ErrorAmbiguousUserType is the error returned when a user is created without a user type and several user
+	// types are available to an organization unit that has no default user type.
+	ErrorAmbiguousUserType = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "USR-1038",
+		Error: core.I18nMessage{
+			Key:          "error.userservice.ambiguous_user_type",
+			DefaultValue: "Ambiguous user type",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key: "error.userservice.ambiguous_user_type_description",
+			DefaultValue: "Several user types are available to the organization unit and none is its default. " +
+				"Specify the user type or set a default user type on the organization unit",
+		},
+	}
+	// ErrorUserNotDeleted is the error returned when a user that is not deleted is restored.
+	ErrorUserNotDeleted = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "USR-1039",
+		Error: core.I18nMessage{
+			Key:          "error.userservice.user_not_deleted",
+			DefaultValue: "User not deleted",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.userservice.user_not_deleted_description",
+			DefaultValue: "Only a deleted user can be restored",
+		},
+	}
+	// ErrorInvalidUserStatus is the error returned when users are listed by an unsupported status.
+	ErrorInvalidUserStatus = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "USR-1040",
+		Error: core.I18nMessage{
+			Key:          "error.userservice.invalid_user_status",
+			DefaultValue: "Invalid user status",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.userservice.invalid_user_status_description",
+			DefaultValue: "The status filter must be 'deleted'",
+		},
+	}
+	// ErrorPasswordPolicyViolation is the error returned when a new credential value does not satisfy the
+	// password policy that applies to the user. The description names the rule that is not satisfied.
+	ErrorPasswordPolicyViolation = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "USR-1041",
+		Error: core.I18nMessage{
+			Key:          "error.userservice.password_policy_violation",
+			DefaultValue: "Password policy violation",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.userservice.password_policy_violation_description",
+			DefaultValue: "The password does not satisfy the password policy",
+		},
+	}
 )
 
 // Error variables
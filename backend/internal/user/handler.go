@@ -19,18 +19,24 @@
 package user
 
 import (
+	"errors"
 	"fmt"
+	"io"
 	"net/http"
 	"net/url"
 	"regexp"
 	"strconv"
 	"strings"
 
+	"github.com/thunder-id/thunderid/internal/accountprotection"
+	"github.com/thunder-id/thunderid/internal/entitytype"
+	"github.com/thunder-id/thunderid/internal/system/config"
 	serverconst "github.com/thunder-id/thunderid/internal/system/constants"
 	"github.com/thunder-id/thunderid/internal/system/error/apierror"
 	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
 	"github.com/thunder-id/thunderid/internal/system/i18n/core"
 	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/internal/system/objectstore"
 	"github.com/thunder-id/thunderid/internal/system/security"
 	sysutils "github.com/thunder-id/thunderid/internal/system/utils"
 )
@@ -40,12 +46,14 @@ const handlerLoggerComponentName = "UserHandler"
 // userHandler is the handler for user management operations.
 type userHandler struct {
 	userService UserServiceInterface
+	readAuditor *sensitiveReadAuditor
 }
 
 // newUserHandler creates a new instance of userHandler with dependency injection.
-func newUserHandler(userService UserServiceInterface) *userHandler {
+func newUserHandler(userService UserServiceInterface, readAuditor *sensitiveReadAuditor) *userHandler {
 	return &userHandler{
 		userService: userService,
+		readAuditor: readAuditor,
 	}
 }
 
@@ -54,15 +62,12 @@ func (uh *userHandler) HandleUserListRequest(w http.ResponseWriter, r *http.Requ
 	ctx := r.Context()
 	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, handlerLoggerComponentName))
 
-	limit, offset, svcErr := parsePaginationParams(r.URL.Query())
+	pagination, svcErr := sysutils.ParsePaginationParams(r.URL.Query(), &ErrorInvalidLimit, &ErrorInvalidOffset)
 	if svcErr != nil {
 		handleError(w, svcErr)
 		return
 	}
-
-	if limit == 0 {
-		limit = serverconst.DefaultPageSize
-	}
+	limit, offset := pagination.Limit, pagination.Offset
 
 	filters, svcErr := parseFilterParams(r.URL.Query())
 	if svcErr != nil {
@@ -73,14 +78,33 @@ func (uh *userHandler) HandleUserListRequest(w http.ResponseWriter, r *http.Requ
 	// Parse include parameter to check if display names should be included.
 	includeDisplay := r.URL.Query().Get(sysutils.QueryParamInclude) == sysutils.IncludeValueDisplay
 
-	// Get the user list using the user service.
-	userListResponse, svcErr := uh.userService.GetUserList(ctx, limit, offset, filters, includeDisplay)
+	// Get the user list using the user service. The deleted status lists the soft-deleted users instead.
+	listUsers := uh.userService.GetUserList
+	switch r.URL.Query().Get(queryParamStatus) {
+	case "":
+	case userStatusDeleted:
+		listUsers = uh.userService.GetDeletedUserList
+	default:
+		handleError(w, &ErrorInvalidUserStatus)
+		return
+	}
+	userListResponse, svcErr := listUsers(
+		sysutils.WithSkipCount(ctx, pagination.SkipCount), limit, offset, filters, includeDisplay)
 	if svcErr != nil {
 		handleError(w, svcErr)
 		return
 	}
 
+	if projection, ok := parseAttributeProjection(r.URL.Query()); ok {
+		userListResponse.Users, svcErr = uh.userService.ProjectUserAttributes(userListResponse.Users, projection)
+		if svcErr != nil {
+			handleError(w, svcErr)
+			return
+		}
+	}
+
 	sysutils.WriteSuccessResponse(w, http.StatusOK, userListResponse)
+	uh.readAuditor.auditRead(ctx, userListResponse.Users...)
 
 	logger.Debug("Successfully listed users with pagination",
 		log.Int("limit", limit), log.Int("offset", offset),
@@ -144,7 +168,17 @@ func (uh *userHandler) HandleUserGetRequest(w http.ResponseWriter, r *http.Reque
 		return
 	}
 
+	if projection, ok := parseAttributeProjection(r.URL.Query()); ok {
+		projected, svcErr := uh.userService.ProjectUserAttributes([]User{*user}, projection)
+		if svcErr != nil {
+			handleError(w, svcErr)
+			return
+		}
+		user = &projected[0]
+	}
+
 	sysutils.WriteSuccessResponse(w, http.StatusOK, user)
+	uh.readAuditor.auditRead(ctx, *user)
 
 	// Log the user response.
 	logger.Debug("User GET response sent", log.MaskedString(log.LoggerKeyUserID, id))
@@ -161,15 +195,12 @@ func (ah *userHandler) HandleUserGroupsGetRequest(w http.ResponseWriter, r *http
 		return
 	}
 
-	limit, offset, svcErr := parsePaginationParams(r.URL.Query())
+	pagination, svcErr := sysutils.ParsePaginationParams(r.URL.Query(), &ErrorInvalidLimit, &ErrorInvalidOffset)
 	if svcErr != nil {
 		handleError(w, svcErr)
 		return
 	}
-
-	if limit == 0 {
-		limit = serverconst.DefaultPageSize
-	}
+	limit, offset := pagination.Limit, pagination.Offset
 
 	groupListResponse, svcErr := ah.userService.GetUserGroups(ctx, id, limit, offset)
 	if svcErr != nil {
@@ -255,6 +286,183 @@ func (uh *userHandler) HandleUserDeleteRequest(w http.ResponseWriter, r *http.Re
 	logger.Debug("User DELETE response sent", log.MaskedString(log.LoggerKeyUserID, id))
 }
 
+// HandleUserRestoreRequest handles the request to restore a soft-deleted user.
+func (uh *userHandler) HandleUserRestoreRequest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, handlerLoggerComponentName))
+
+	id := r.PathValue("id")
+	if id == "" {
+		handleError(w, &ErrorMissingUserID)
+		return
+	}
+
+	user, svcErr := uh.userService.RestoreUser(ctx, id)
+	if svcErr != nil {
+		handleError(w, svcErr)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(w, http.StatusOK, user)
+
+	logger.Debug("User restore response sent", log.MaskedString(log.LoggerKeyUserID, id))
+}
+
+// HandleUserPurgeRequest handles the request to permanently delete a user.
+func (uh *userHandler) HandleUserPurgeRequest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, handlerLoggerComponentName))
+
+	id := r.PathValue("id")
+	if id == "" {
+		handleError(w, &ErrorMissingUserID)
+		return
+	}
+
+	if svcErr := uh.userService.PurgeUser(ctx, id); svcErr != nil {
+		handleError(w, svcErr)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(w, http.StatusNoContent, nil)
+
+	logger.Debug("User purge response sent", log.MaskedString(log.LoggerKeyUserID, id))
+}
+
+// HandleUserPicturePutRequest handles the user picture upload request. The request body is the raw image.
+func (uh *userHandler) HandleUserPicturePutRequest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, handlerLoggerComponentName))
+
+	id := r.PathValue("id")
+	if id == "" {
+		handleError(w, &ErrorMissingUserID)
+		return
+	}
+
+	maxSize := config.GetServerRuntime().Config.User.Picture.MaxSize
+	if maxSize > 0 {
+		r.Body = http.MaxBytesReader(w, r.Body, maxSize)
+	}
+	data, err := io.ReadAll(r.Body)
+	if err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			handleError(w, &ErrorPictureTooLarge)
+			return
+		}
+		handleError(w, &ErrorInvalidRequestFormat)
+		return
+	}
+
+	pictureURL, svcErr := uh.userService.UpdateUserPicture(ctx, id, data)
+	if svcErr != nil {
+		handleError(w, svcErr)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(w, http.StatusOK, UserPictureResponse{PictureURL: pictureURL})
+
+	logger.Debug("User picture PUT response sent", log.MaskedString(log.LoggerKeyUserID, id))
+}
+
+// HandleUserPictureGetRequest handles the user picture retrieval request.
+func (uh *userHandler) HandleUserPictureGetRequest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id := r.PathValue("id")
+	if id == "" {
+		handleError(w, &ErrorMissingUserID)
+		return
+	}
+
+	picture, svcErr := uh.userService.GetUserPicture(ctx, id)
+	if svcErr != nil {
+		handleError(w, svcErr)
+		return
+	}
+
+	writePicture(w, picture, "private, no-cache")
+}
+
+// HandleUserPictureViewRequest handles the user picture retrieval request made through a signed URL.
+func (uh *userHandler) HandleUserPictureViewRequest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id := r.PathValue("id")
+	if id == "" {
+		handleError(w, &ErrorMissingUserID)
+		return
+	}
+
+	query := r.URL.Query()
+	picture, svcErr := uh.userService.GetUserPictureBySignature(
+		ctx, id, query.Get("expires"), query.Get("signature"))
+	if svcErr != nil {
+		handleError(w, svcErr)
+		return
+	}
+
+	writePicture(w, picture, "private, max-age=300")
+}
+
+// HandleUserPictureDeleteRequest handles the user picture delete request.
+func (uh *userHandler) HandleUserPictureDeleteRequest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, handlerLoggerComponentName))
+
+	id := r.PathValue("id")
+	if id == "" {
+		handleError(w, &ErrorMissingUserID)
+		return
+	}
+
+	if svcErr := uh.userService.DeleteUserPicture(ctx, id); svcErr != nil {
+		handleError(w, svcErr)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(w, http.StatusNoContent, nil)
+
+	logger.Debug("User picture DELETE response sent", log.MaskedString(log.LoggerKeyUserID, id))
+}
+
+// HandleUserCredentialDeleteRequest handles the request to delete the credentials of a given type from a user.
+func (uh *userHandler) HandleUserCredentialDeleteRequest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, handlerLoggerComponentName))
+
+	id := r.PathValue("id")
+	if id == "" {
+		handleError(w, &ErrorMissingUserID)
+		return
+	}
+	credentialType := r.PathValue("credentialType")
+
+	if svcErr := uh.userService.DeleteUserCredential(ctx, id, credentialType); svcErr != nil {
+		handleError(w, svcErr)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(w, http.StatusNoContent, nil)
+
+	logger.Debug("User credential DELETE response sent", log.MaskedString(log.LoggerKeyUserID, id))
+}
+
+// writePicture writes the picture bytes with the given cache policy.
+func writePicture(w http.ResponseWriter, picture *objectstore.Object, cacheControl string) {
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, handlerLoggerComponentName))
+
+	w.Header().Set(serverconst.ContentTypeHeaderName, picture.ContentType)
+	w.Header().Set("Content-Length", strconv.Itoa(len(picture.Data)))
+	w.Header().Set("Cache-Control", cacheControl)
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.WriteHeader(http.StatusOK)
+	if _, err := w.Write(picture.Data); err != nil {
+		logger.Error("Failed to write user picture response", log.Error(err))
+	}
+}
+
 // HandleUserListByPathRequest handles the list users by OU path request.
 func (uh *userHandler) HandleUserListByPathRequest(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -265,15 +473,12 @@ func (uh *userHandler) HandleUserListByPathRequest(w http.ResponseWriter, r *htt
 		return
 	}
 
-	limit, offset, svcErr := parsePaginationParams(r.URL.Query())
+	pagination, svcErr := sysutils.ParsePaginationParams(r.URL.Query(), &ErrorInvalidLimit, &ErrorInvalidOffset)
 	if svcErr != nil {
 		handleError(w, svcErr)
 		return
 	}
-
-	if limit == 0 {
-		limit = serverconst.DefaultPageSize
-	}
+	limit, offset := pagination.Limit, pagination.Offset
 
 	filters, svcErr := parseFilterParams(r.URL.Query())
 	if svcErr != nil {
@@ -291,6 +496,7 @@ func (uh *userHandler) HandleUserListByPathRequest(w http.ResponseWriter, r *htt
 	}
 
 	sysutils.WriteSuccessResponse(w, http.StatusOK, userListResponse)
+	uh.readAuditor.auditRead(ctx, userListResponse.Users...)
 
 	logger.Debug("Successfully listed users by path", log.String("path", path),
 		log.Int("limit", limit), log.Int("offset", offset),
@@ -355,6 +561,7 @@ func (uh *userHandler) HandleSelfUserGetRequest(w http.ResponseWriter, r *http.R
 	}
 
 	sysutils.WriteSuccessResponse(w, http.StatusOK, user)
+	uh.readAuditor.auditRead(ctx, *user)
 
 	logger.Debug("Self user GET response sent", log.MaskedString(log.LoggerKeyUserID, userID))
 }
@@ -423,32 +630,69 @@ func (uh *userHandler) HandleSelfUserCredentialUpdateRequest(w http.ResponseWrit
 	logger.Debug("Self user credential update response sent", log.MaskedString(log.LoggerKeyUserID, userID))
 }
 
-// parsePaginationParams parses limit and offset query parameters from the request.
-func parsePaginationParams(query url.Values) (int, int, *serviceerror.ServiceError) {
-	limit := 0
-	offset := 0
+// HandleUserTypeSampleValidationRequest handles validating sample users against a draft user type schema.
+func (uh *userHandler) HandleUserTypeSampleValidationRequest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, handlerLoggerComponentName))
 
-	if limitStr := query.Get("limit"); limitStr != "" {
-		if parsedLimit, err := strconv.Atoi(limitStr); err != nil {
-			return 0, 0, &ErrorInvalidLimit
-		} else if parsedLimit <= 0 {
-			return 0, 0, &ErrorInvalidLimit
-		} else {
-			limit = parsedLimit
-		}
+	validationRequest, err := sysutils.DecodeJSONBody[entitytype.SampleValidationRequest](r)
+	if err != nil {
+		handleError(w, &ErrorInvalidRequestFormat)
+		return
 	}
+	validationRequest.OUID = sysutils.SanitizeString(validationRequest.OUID)
 
-	if offsetStr := query.Get("offset"); offsetStr != "" {
-		if parsedOffset, err := strconv.Atoi(offsetStr); err != nil {
-			return 0, 0, &ErrorInvalidOffset
-		} else if parsedOffset < 0 {
-			return 0, 0, &ErrorInvalidOffset
-		} else {
-			offset = parsedOffset
-		}
+	validationResponse, svcErr := uh.userService.ValidateUserTypeSamples(ctx, *validationRequest)
+	if svcErr != nil {
+		handleError(w, svcErr)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(w, http.StatusOK, validationResponse)
+
+	logger.Debug("User type sample validation response sent",
+		log.Int("sampleCount", len(validationRequest.Samples)), log.Bool("valid", validationResponse.Valid))
+}
+
+// HandleUserTypeSampleNormalizationRequest handles previewing the normalization rules of a draft user type
+// schema on sample users.
+func (uh *userHandler) HandleUserTypeSampleNormalizationRequest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, handlerLoggerComponentName))
+
+	normalizationRequest, err := sysutils.DecodeJSONBody[entitytype.SampleNormalizationRequest](r)
+	if err != nil {
+		handleError(w, &ErrorInvalidRequestFormat)
+		return
+	}
+	normalizationRequest.OUID = sysutils.SanitizeString(normalizationRequest.OUID)
+
+	normalizationResponse, svcErr := uh.userService.NormalizeUserTypeSamples(ctx, *normalizationRequest)
+	if svcErr != nil {
+		handleError(w, svcErr)
+		return
 	}
 
-	return limit, offset, nil
+	sysutils.WriteSuccessResponse(w, http.StatusOK, normalizationResponse)
+
+	logger.Debug("User type sample normalization response sent",
+		log.Int("sampleCount", len(normalizationRequest.Samples)))
+}
+
+// HandleCredentialFormatReportRequest handles the report of stored user credentials per storage format.
+func (uh *userHandler) HandleCredentialFormatReportRequest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, handlerLoggerComponentName))
+
+	report, svcErr := uh.userService.GetCredentialFormatReport(ctx)
+	if svcErr != nil {
+		handleError(w, svcErr)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(w, http.StatusOK, report)
+
+	logger.Debug("Credential format report response sent", log.Int("formatCount", len(report.Formats)))
 }
 
 // handleError handles service errors and writes appropriate HTTP responses.
@@ -458,9 +702,14 @@ func handleError(w http.ResponseWriter, svcErr *serviceerror.ServiceError) {
 		switch svcErr.Code {
 		case ErrorMissingUserID.Code,
 			ErrorUserNotFound.Code,
-			ErrorOrganizationUnitNotFound.Code:
+			ErrorOrganizationUnitNotFound.Code,
+			ErrorPictureNotFound.Code,
+			ErrorCredentialNotFound.Code:
 			statusCode = http.StatusNotFound
-		case ErrorAttributeConflict.Code:
+		case ErrorPictureTooLarge.Code:
+			statusCode = http.StatusRequestEntityTooLarge
+		case ErrorAttributeConflict.Code, ErrorUserQuotaExceeded.Code, ErrorCredentialPolicyViolation.Code,
+			accountprotection.ErrorChangeOnHold.Code, ErrorUserNotDeleted.Code:
 			statusCode = http.StatusConflict
 		case ErrorHandlePathRequired.Code,
 			ErrorInvalidHandlePath.Code,
@@ -470,7 +719,9 @@ func handleError(w http.ResponseWriter, svcErr *serviceerror.ServiceError) {
 			statusCode = http.StatusBadRequest
 		case ErrorAuthenticationFailed.Code:
 			statusCode = http.StatusUnauthorized
-		case serviceerror.ErrorUnauthorized.Code:
+		case serviceerror.ErrorUnauthorized.Code,
+			ErrorInvalidPictureSignature.Code,
+			accountprotection.ErrorStepUpRequired.Code:
 			statusCode = http.StatusForbidden
 		default:
 			statusCode = http.StatusBadRequest
@@ -525,6 +776,20 @@ func parseFilterParams(query url.Values) (map[string]interface{}, *serviceerror.
 	return sanitized, nil
 }
 
+// parseAttributeProjection parses the attributes and excludedAttributes query parameters into an
+// attribute projection. Each parameter is a comma-separated list of attribute paths. The returned
+// flag reports whether either parameter was present in the query.
+func parseAttributeProjection(query url.Values) (AttributeProjection, bool) {
+	projection := AttributeProjection{}
+	if query.Has(queryParamAttributes) {
+		projection.Attributes = strings.Split(query.Get(queryParamAttributes), ",")
+	}
+	if query.Has(queryParamExcludedAttributes) {
+		projection.ExcludedAttributes = strings.Split(query.Get(queryParamExcludedAttributes), ",")
+	}
+	return projection, projection.Attributes != nil || projection.ExcludedAttributes != nil
+}
+
 // parseFilterExpression parses filter expressions in the format: attribute eq "value"
 func parseFilterExpression(filterStr string) (map[string]interface{}, error) {
 	// Regex to match: attribute_name eq "value" or attribute_name eq value
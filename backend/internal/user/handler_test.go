@@ -30,8 +30,10 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/thunder-id/thunderid/internal/entity"
+	"github.com/thunder-id/thunderid/internal/entitytype"
 	"github.com/thunder-id/thunderid/internal/system/error/apierror"
 	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/objectstore"
 	"github.com/thunder-id/thunderid/internal/system/security"
 )
 
@@ -51,7 +53,7 @@ func TestHandleSelfUserGetRequest_Success(t *testing.T) {
 	}
 	mockSvc.On("GetUser", mock.Anything, userID, false).Return(expectedUser, nil)
 
-	handler := newUserHandler(mockSvc)
+	handler := newUserHandler(mockSvc, nil)
 	req := httptest.NewRequest(http.MethodGet, "/users/me", nil)
 	req = req.WithContext(security.WithSecurityContextTest(req.Context(), authCtx))
 	rr := httptest.NewRecorder()
@@ -75,7 +77,7 @@ func TestHandleSelfUserGetRequest_IncludeDisplay(t *testing.T) {
 	expectedUser := &User{ID: userID}
 	mockSvc.On("GetUser", mock.Anything, userID, true).Return(expectedUser, nil)
 
-	handler := newUserHandler(mockSvc)
+	handler := newUserHandler(mockSvc, nil)
 	req := httptest.NewRequest(http.MethodGet, "/users/me?include=display", nil)
 	req = req.WithContext(security.WithSecurityContextTest(req.Context(), authCtx))
 	rr := httptest.NewRecorder()
@@ -88,7 +90,7 @@ func TestHandleSelfUserGetRequest_IncludeDisplay(t *testing.T) {
 
 func TestHandleSelfUserGetRequest_Unauthorized(t *testing.T) {
 	mockSvc := NewUserServiceInterfaceMock(t)
-	handler := newUserHandler(mockSvc)
+	handler := newUserHandler(mockSvc, nil)
 	req := httptest.NewRequest(http.MethodGet, "/users/me", nil)
 	rr := httptest.NewRecorder()
 
@@ -114,7 +116,7 @@ func TestHandleSelfUserPutRequest_Success(t *testing.T) {
 	}
 	mockSvc.On("UpdateUserAttributes", mock.Anything, userID, attributes).Return(updatedUser, nil)
 
-	handler := newUserHandler(mockSvc)
+	handler := newUserHandler(mockSvc, nil)
 	body := bytes.NewBufferString(`{"attributes":{"email":"alice@example.com"}}`)
 	req := httptest.NewRequest(http.MethodPut, "/users/me", body)
 	req = req.WithContext(security.WithSecurityContextTest(req.Context(), authCtx))
@@ -135,7 +137,7 @@ func TestHandleSelfUserPutRequest_InvalidBody(t *testing.T) {
 	authCtx := security.NewSecurityContextForTest(userID, "", "", nil, nil)
 
 	mockSvc := NewUserServiceInterfaceMock(t)
-	handler := newUserHandler(mockSvc)
+	handler := newUserHandler(mockSvc, nil)
 
 	req := httptest.NewRequest(http.MethodPut, "/users/me", bytes.NewBufferString(`{"attributes":`))
 	req = req.WithContext(security.WithSecurityContextTest(req.Context(), authCtx))
@@ -158,7 +160,7 @@ func TestHandleSelfUserCredentialUpdateRequest_Success(t *testing.T) {
 	credentialsJSON := json.RawMessage(`{"password":[{"value":"Secret123!"}]}`)
 	mockSvc.On("UpdateUserCredentials", mock.Anything, userID, credentialsJSON).Return(nil)
 
-	handler := newUserHandler(mockSvc)
+	handler := newUserHandler(mockSvc, nil)
 	req := httptest.NewRequest(http.MethodPost, "/users/me/update-credentials",
 		bytes.NewBufferString(`{"attributes":{"password":[{"value":"Secret123!"}]}}`))
 	req = req.WithContext(security.WithSecurityContextTest(req.Context(), authCtx))
@@ -178,7 +180,7 @@ func TestHandleSelfUserCredentialUpdateRequest_StringValue(t *testing.T) {
 	credentialsJSON := json.RawMessage(`{"password":"plaintext-password"}`)
 	mockSvc.On("UpdateUserCredentials", mock.Anything, userID, credentialsJSON).Return(nil)
 
-	handler := newUserHandler(mockSvc)
+	handler := newUserHandler(mockSvc, nil)
 	req := httptest.NewRequest(http.MethodPost, "/users/me/update-credentials",
 		bytes.NewBufferString(`{"attributes":{"password":"plaintext-password"}}`))
 	req = req.WithContext(security.WithSecurityContextTest(req.Context(), authCtx))
@@ -195,7 +197,7 @@ func TestHandleSelfUserCredentialUpdateRequest_MissingCredentials(t *testing.T)
 	authCtx := security.NewSecurityContextForTest(userID, "", "", nil, nil)
 
 	mockSvc := NewUserServiceInterfaceMock(t)
-	handler := newUserHandler(mockSvc)
+	handler := newUserHandler(mockSvc, nil)
 
 	req := httptest.NewRequest(http.MethodPost, "/users/me/update-credentials",
 		bytes.NewBufferString(`{"attributes":{}}`))
@@ -254,7 +256,7 @@ func TestHandleSelfUserCredentialUpdateRequest_ErrorCases(t *testing.T) {
 			mockSvc := NewUserServiceInterfaceMock(t)
 			mockSvc.On("UpdateUserCredentials", mock.Anything, userID, tc.mockJSON).Return(tc.mockError)
 
-			handler := newUserHandler(mockSvc)
+			handler := newUserHandler(mockSvc, nil)
 			req := httptest.NewRequest(http.MethodPost, "/users/me/update-credentials",
 				bytes.NewBufferString(tc.requestBody))
 			req = req.WithContext(security.WithSecurityContextTest(req.Context(), authCtx))
@@ -280,7 +282,7 @@ func TestHandleSelfUserCredentialUpdateRequest_MultipleCredentialTypes(t *testin
 	credentialsJSON := json.RawMessage(`{"password":"new-password","pin":"1234"}`)
 	mockSvc.On("UpdateUserCredentials", mock.Anything, userID, credentialsJSON).Return(nil)
 
-	handler := newUserHandler(mockSvc)
+	handler := newUserHandler(mockSvc, nil)
 	req := httptest.NewRequest(http.MethodPost, "/users/me/update-credentials",
 		bytes.NewBufferString(`{"attributes":{"password":"new-password","pin":"1234"}}`))
 	req = req.WithContext(security.WithSecurityContextTest(req.Context(), authCtx))
@@ -302,7 +304,7 @@ func TestHandleUserListRequest_Success(t *testing.T) {
 	}
 	mockSvc.On("GetUserList", mock.Anything, 10, 0, mock.Anything, false).Return(expectedResp, nil)
 
-	handler := newUserHandler(mockSvc)
+	handler := newUserHandler(mockSvc, nil)
 	req := httptest.NewRequest(http.MethodGet, "/users?limit=10&offset=0", nil)
 	rr := httptest.NewRecorder()
 
@@ -322,7 +324,7 @@ func TestHandleUserListRequest_WithIncludeDisplay(t *testing.T) {
 	}
 	mockSvc.On("GetUserList", mock.Anything, 10, 0, mock.Anything, true).Return(expectedResp, nil)
 
-	handler := newUserHandler(mockSvc)
+	handler := newUserHandler(mockSvc, nil)
 	req := httptest.NewRequest(http.MethodGet, "/users?limit=10&offset=0&include=display", nil)
 	rr := httptest.NewRecorder()
 
@@ -343,7 +345,7 @@ func TestHandleUserListRequest_WithInvalidIncludeParam(t *testing.T) {
 	// Invalid include value should be treated as no include (includeDisplay=false).
 	mockSvc.On("GetUserList", mock.Anything, 10, 0, mock.Anything, false).Return(expectedResp, nil)
 
-	handler := newUserHandler(mockSvc)
+	handler := newUserHandler(mockSvc, nil)
 	req := httptest.NewRequest(http.MethodGet, "/users?limit=10&offset=0&include=invalid", nil)
 	rr := httptest.NewRecorder()
 
@@ -355,13 +357,45 @@ func TestHandleUserListRequest_WithInvalidIncludeParam(t *testing.T) {
 	require.Empty(t, resp.Users[0].Display)
 }
 
+func TestHandleUserListRequest_DeletedStatus(t *testing.T) {
+	mockSvc := NewUserServiceInterfaceMock(t)
+	expectedResp := &UserListResponse{
+		TotalResults: 1,
+		Users:        []User{{ID: "user-1"}},
+	}
+	mockSvc.On("GetDeletedUserList", mock.Anything, 10, 0, mock.Anything, false).Return(expectedResp, nil)
+
+	handler := newUserHandler(mockSvc, nil)
+	req := httptest.NewRequest(http.MethodGet, "/users?limit=10&offset=0&status=deleted", nil)
+	rr := httptest.NewRecorder()
+
+	handler.HandleUserListRequest(rr, req)
+
+	require.Equal(t, http.StatusOK, rr.Code)
+	mockSvc.AssertNotCalled(t, "GetUserList", mock.Anything, mock.Anything, mock.Anything, mock.Anything,
+		mock.Anything)
+}
+
+func TestHandleUserListRequest_InvalidStatus(t *testing.T) {
+	mockSvc := NewUserServiceInterfaceMock(t)
+
+	handler := newUserHandler(mockSvc, nil)
+	req := httptest.NewRequest(http.MethodGet, "/users?status=active", nil)
+	rr := httptest.NewRecorder()
+
+	handler.HandleUserListRequest(rr, req)
+
+	require.Equal(t, http.StatusBadRequest, rr.Code)
+	require.Contains(t, rr.Body.String(), ErrorInvalidUserStatus.Code)
+}
+
 func TestHandleUserPostRequest_Success(t *testing.T) {
 	mockSvc := NewUserServiceInterfaceMock(t)
 	userReq := &User{Type: "employee", Attributes: json.RawMessage(`{"username":"bob"}`)}
 	createdUser := &User{ID: "user-bob", Type: "employee", Attributes: json.RawMessage(`{"username":"bob"}`)}
 	mockSvc.On("CreateUser", mock.Anything, mock.Anything).Return(createdUser, nil)
 
-	handler := newUserHandler(mockSvc)
+	handler := newUserHandler(mockSvc, nil)
 	body, _ := json.Marshal(userReq)
 	req := httptest.NewRequest(http.MethodPost, "/users", bytes.NewBuffer(body))
 	rr := httptest.NewRecorder()
@@ -380,7 +414,7 @@ func TestHandleUserGetRequest_Success(t *testing.T) {
 	expectedUser := &User{ID: userID}
 	mockSvc.On("GetUser", mock.Anything, userID, false).Return(expectedUser, nil)
 
-	handler := newUserHandler(mockSvc)
+	handler := newUserHandler(mockSvc, nil)
 	req := httptest.NewRequest(http.MethodGet, "/users/"+userID, nil)
 	// Set path value for Go 1.22+ standard router
 	req.SetPathValue("id", userID)
@@ -400,7 +434,7 @@ func TestHandleUserGetRequest_IncludeDisplay(t *testing.T) {
 	expectedUser := &User{ID: userID}
 	mockSvc.On("GetUser", mock.Anything, userID, true).Return(expectedUser, nil)
 
-	handler := newUserHandler(mockSvc)
+	handler := newUserHandler(mockSvc, nil)
 	req := httptest.NewRequest(http.MethodGet, "/users/"+userID+"?include=display", nil)
 	req.SetPathValue("id", userID)
 	rr := httptest.NewRecorder()
@@ -418,7 +452,7 @@ func TestHandleUserPutRequest_Success(t *testing.T) {
 	updatedUser := &User{ID: userID, Attributes: json.RawMessage(`{"name":"Updated"}`)}
 	mockSvc.On("UpdateUser", mock.Anything, userID, mock.Anything).Return(updatedUser, nil)
 
-	handler := newUserHandler(mockSvc)
+	handler := newUserHandler(mockSvc, nil)
 	body, _ := json.Marshal(userReq)
 	req := httptest.NewRequest(http.MethodPut, "/users/"+userID, bytes.NewBuffer(body))
 	rr := httptest.NewRecorder()
@@ -436,7 +470,7 @@ func TestHandleUserDeleteRequest_Success(t *testing.T) {
 	userID := testUserID123
 	mockSvc.On("DeleteUser", mock.Anything, userID).Return(nil)
 
-	handler := newUserHandler(mockSvc)
+	handler := newUserHandler(mockSvc, nil)
 	req := httptest.NewRequest(http.MethodDelete, "/users/"+userID, nil)
 	rr := httptest.NewRecorder()
 
@@ -445,6 +479,70 @@ func TestHandleUserDeleteRequest_Success(t *testing.T) {
 	require.Equal(t, http.StatusNoContent, rr.Code)
 }
 
+func TestHandleUserRestoreRequest(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		mockSvc := NewUserServiceInterfaceMock(t)
+		mockSvc.On("RestoreUser", mock.Anything, testUserID123).
+			Return(&User{ID: testUserID123, OUID: "ou-1"}, nil)
+
+		handler := newUserHandler(mockSvc, nil)
+		req := httptest.NewRequest(http.MethodPost, "/users/"+testUserID123+"/restore", nil)
+		req.SetPathValue("id", testUserID123)
+		rr := httptest.NewRecorder()
+
+		handler.HandleUserRestoreRequest(rr, req)
+
+		require.Equal(t, http.StatusOK, rr.Code)
+		var resp User
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&resp))
+		require.Equal(t, testUserID123, resp.ID)
+	})
+
+	t.Run("NotDeleted", func(t *testing.T) {
+		mockSvc := NewUserServiceInterfaceMock(t)
+		mockSvc.On("RestoreUser", mock.Anything, testUserID123).Return(nil, &ErrorUserNotDeleted)
+
+		handler := newUserHandler(mockSvc, nil)
+		req := httptest.NewRequest(http.MethodPost, "/users/"+testUserID123+"/restore", nil)
+		req.SetPathValue("id", testUserID123)
+		rr := httptest.NewRecorder()
+
+		handler.HandleUserRestoreRequest(rr, req)
+
+		require.Equal(t, http.StatusConflict, rr.Code)
+	})
+}
+
+func TestHandleUserPurgeRequest(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		mockSvc := NewUserServiceInterfaceMock(t)
+		mockSvc.On("PurgeUser", mock.Anything, testUserID123).Return(nil)
+
+		handler := newUserHandler(mockSvc, nil)
+		req := httptest.NewRequest(http.MethodPost, "/users/"+testUserID123+"/purge", nil)
+		req.SetPathValue("id", testUserID123)
+		rr := httptest.NewRecorder()
+
+		handler.HandleUserPurgeRequest(rr, req)
+
+		require.Equal(t, http.StatusNoContent, rr.Code)
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		mockSvc := NewUserServiceInterfaceMock(t)
+		mockSvc.On("PurgeUser", mock.Anything, testUserID123).Return(&ErrorUserNotFound)
+
+		handler := newUserHandler(mockSvc, nil)
+		req := httptest.NewRequest(http.MethodPost, "/users/"+testUserID123+"/purge", nil)
+		req.SetPathValue("id", testUserID123)
+		rr := httptest.NewRecorder()
+
+		handler.HandleUserPurgeRequest(rr, req)
+
+		require.Equal(t, http.StatusNotFound, rr.Code)
+	})
+}
+
 func TestHandleUserListByPathRequest_Success(t *testing.T) {
 	mockSvc := NewUserServiceInterfaceMock(t)
 	expectedResp := &UserListResponse{
@@ -454,7 +552,7 @@ func TestHandleUserListByPathRequest_Success(t *testing.T) {
 	mockSvc.On("GetUsersByPath", mock.Anything, "root/engineering", 10, 0,
 		mock.Anything, false).Return(expectedResp, nil)
 
-	handler := newUserHandler(mockSvc)
+	handler := newUserHandler(mockSvc, nil)
 	req := httptest.NewRequest(http.MethodGet, "/users/path/root/engineering?limit=10", nil)
 	req.SetPathValue("path", "root/engineering")
 	rr := httptest.NewRecorder()
@@ -473,7 +571,7 @@ func TestHandleUserListByPathRequest_WithIncludeDisplay(t *testing.T) {
 	mockSvc.On("GetUsersByPath", mock.Anything, "root/engineering", 10, 0,
 		mock.Anything, true).Return(expectedResp, nil)
 
-	handler := newUserHandler(mockSvc)
+	handler := newUserHandler(mockSvc, nil)
 	req := httptest.NewRequest(
 		http.MethodGet, "/users/path/root/engineering?limit=10&include=display", nil)
 	req.SetPathValue("path", "root/engineering")
@@ -492,7 +590,7 @@ func TestHandleUserPostByPathRequest_Success(t *testing.T) {
 	createdUser := &User{ID: "user-new", Type: "customer"}
 	mockSvc.On("CreateUserByPath", mock.Anything, "root/sales", mock.Anything).Return(createdUser, nil)
 
-	handler := newUserHandler(mockSvc)
+	handler := newUserHandler(mockSvc, nil)
 	body := bytes.NewBufferString(`{"type":"customer"}`)
 	req := httptest.NewRequest(http.MethodPost, "/users/path/root/sales", body)
 	req.SetPathValue("path", "root/sales")
@@ -512,7 +610,7 @@ func TestHandleUserGroupsGetRequest_Success(t *testing.T) {
 	}
 	mockSvc.On("GetUserGroups", mock.Anything, userID, 10, 0).Return(expectedResp, nil)
 
-	handler := newUserHandler(mockSvc)
+	handler := newUserHandler(mockSvc, nil)
 	req := httptest.NewRequest(http.MethodGet, "/users/"+userID+"/groups?limit=10", nil)
 	req.SetPathValue("id", userID)
 	rr := httptest.NewRecorder()
@@ -527,7 +625,7 @@ func TestHandleUserGroupsGetRequest_Success(t *testing.T) {
 
 func TestHandleUserListRequest_InvalidParams(t *testing.T) {
 	mockSvc := NewUserServiceInterfaceMock(t)
-	handler := newUserHandler(mockSvc)
+	handler := newUserHandler(mockSvc, nil)
 	req := httptest.NewRequest(http.MethodGet, "/users?limit=abc", nil)
 	rr := httptest.NewRecorder()
 
@@ -544,7 +642,7 @@ func TestHandleUserListRequest_WithFilter(t *testing.T) {
 			return m["username"] == "alice"
 		}), false).Return(expectedResp, nil)
 
-	handler := newUserHandler(mockSvc)
+	handler := newUserHandler(mockSvc, nil)
 	req := httptest.NewRequest(http.MethodGet, "/users?filter=username%20eq%20%22alice%22", nil)
 	rr := httptest.NewRecorder()
 
@@ -561,7 +659,7 @@ func TestHandleUserListRequest_WithFilter_Unquoted(t *testing.T) {
 			return m["age"] == int64(30)
 		}), false).Return(expectedResp, nil)
 
-	handler := newUserHandler(mockSvc)
+	handler := newUserHandler(mockSvc, nil)
 	req := httptest.NewRequest(http.MethodGet, "/users?filter=age%20eq%2030", nil)
 	rr := httptest.NewRecorder()
 
@@ -572,7 +670,7 @@ func TestHandleUserListRequest_WithFilter_Unquoted(t *testing.T) {
 
 func TestHandleUserListRequest_InvalidFilter(t *testing.T) {
 	mockSvc := NewUserServiceInterfaceMock(t)
-	handler := newUserHandler(mockSvc)
+	handler := newUserHandler(mockSvc, nil)
 	req := httptest.NewRequest(http.MethodGet, "/users?filter=username%20invalid%20%22alice%22", nil)
 	rr := httptest.NewRecorder()
 
@@ -583,7 +681,7 @@ func TestHandleUserListRequest_InvalidFilter(t *testing.T) {
 
 func TestHandleUserPostRequest_ErrorCases(t *testing.T) {
 	mockSvc := NewUserServiceInterfaceMock(t)
-	handler := newUserHandler(mockSvc)
+	handler := newUserHandler(mockSvc, nil)
 
 	t.Run("InvalidBody", func(t *testing.T) {
 		req := httptest.NewRequest(http.MethodPost, "/users", strings.NewReader("invalid"))
@@ -603,7 +701,7 @@ func TestHandleUserPostRequest_ErrorCases(t *testing.T) {
 
 func TestHandleUserGetRequest_ErrorCases(t *testing.T) {
 	mockSvc := NewUserServiceInterfaceMock(t)
-	handler := newUserHandler(mockSvc)
+	handler := newUserHandler(mockSvc, nil)
 	userID := "u1"
 
 	t.Run("MissingID", func(t *testing.T) {
@@ -625,7 +723,7 @@ func TestHandleUserGetRequest_ErrorCases(t *testing.T) {
 
 func TestHandleUserPutRequest_ErrorCases(t *testing.T) {
 	mockSvc := NewUserServiceInterfaceMock(t)
-	handler := newUserHandler(mockSvc)
+	handler := newUserHandler(mockSvc, nil)
 	userID := "u1"
 
 	t.Run("InvalidBody", func(t *testing.T) {
@@ -649,7 +747,7 @@ func TestHandleUserPutRequest_ErrorCases(t *testing.T) {
 
 func TestHandleUserDeleteRequest_ErrorCases(t *testing.T) {
 	mockSvc := NewUserServiceInterfaceMock(t)
-	handler := newUserHandler(mockSvc)
+	handler := newUserHandler(mockSvc, nil)
 	userID := "u1"
 
 	t.Run("MissingID", func(t *testing.T) {
@@ -698,7 +796,7 @@ func TestHandleError_ErrorUnauthorized_Returns403(t *testing.T) {
 	}
 
 	mockSvc := NewUserServiceInterfaceMock(t)
-	handler := newUserHandler(mockSvc)
+	handler := newUserHandler(mockSvc, nil)
 	userID := "u1"
 
 	for _, tc := range tests {
@@ -712,3 +810,239 @@ func TestHandleError_ErrorUnauthorized_Returns403(t *testing.T) {
 		})
 	}
 }
+
+func TestHandleUserPicturePutRequest_Success(t *testing.T) {
+	setupPictureTestConfig(t)
+	mockSvc := NewUserServiceInterfaceMock(t)
+	mockSvc.On("UpdateUserPicture", mock.Anything, testUserID123, []byte("image-bytes")).
+		Return("https://localhost:8090/users/user-123/picture/view?expires=1&signature=s", nil)
+
+	handler := newUserHandler(mockSvc, nil)
+	req := httptest.NewRequest(http.MethodPut, "/users/"+testUserID123+"/picture",
+		bytes.NewReader([]byte("image-bytes")))
+	req.SetPathValue("id", testUserID123)
+	rr := httptest.NewRecorder()
+
+	handler.HandleUserPicturePutRequest(rr, req)
+
+	require.Equal(t, http.StatusOK, rr.Code)
+	var resp UserPictureResponse
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &resp))
+	require.Contains(t, resp.PictureURL, "/users/user-123/picture/view")
+}
+
+func TestHandleUserPicturePutRequest_TooLarge(t *testing.T) {
+	setupPictureTestConfig(t)
+	mockSvc := NewUserServiceInterfaceMock(t)
+
+	handler := newUserHandler(mockSvc, nil)
+	req := httptest.NewRequest(http.MethodPut, "/users/"+testUserID123+"/picture",
+		bytes.NewReader(make([]byte, 1024*1024+1)))
+	req.SetPathValue("id", testUserID123)
+	rr := httptest.NewRecorder()
+
+	handler.HandleUserPicturePutRequest(rr, req)
+
+	require.Equal(t, http.StatusRequestEntityTooLarge, rr.Code)
+	mockSvc.AssertNotCalled(t, "UpdateUserPicture", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestHandleUserPictureGetRequest(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		mockSvc := NewUserServiceInterfaceMock(t)
+		mockSvc.On("GetUserPicture", mock.Anything, testUserID123).
+			Return(&objectstore.Object{Data: []byte("png-data"), ContentType: "image/png"}, nil)
+
+		handler := newUserHandler(mockSvc, nil)
+		req := httptest.NewRequest(http.MethodGet, "/users/"+testUserID123+"/picture", nil)
+		req.SetPathValue("id", testUserID123)
+		rr := httptest.NewRecorder()
+
+		handler.HandleUserPictureGetRequest(rr, req)
+
+		require.Equal(t, http.StatusOK, rr.Code)
+		require.Equal(t, "image/png", rr.Header().Get("Content-Type"))
+		require.Equal(t, "nosniff", rr.Header().Get("X-Content-Type-Options"))
+		require.Equal(t, "png-data", rr.Body.String())
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		mockSvc := NewUserServiceInterfaceMock(t)
+		mockSvc.On("GetUserPicture", mock.Anything, testUserID123).Return(nil, &ErrorPictureNotFound)
+
+		handler := newUserHandler(mockSvc, nil)
+		req := httptest.NewRequest(http.MethodGet, "/users/"+testUserID123+"/picture", nil)
+		req.SetPathValue("id", testUserID123)
+		rr := httptest.NewRecorder()
+
+		handler.HandleUserPictureGetRequest(rr, req)
+
+		require.Equal(t, http.StatusNotFound, rr.Code)
+	})
+}
+
+func TestHandleUserPictureViewRequest(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		mockSvc := NewUserServiceInterfaceMock(t)
+		mockSvc.On("GetUserPictureBySignature", mock.Anything, testUserID123, "123", "sig").
+			Return(&objectstore.Object{Data: []byte("jpeg-data"), ContentType: "image/jpeg"}, nil)
+
+		handler := newUserHandler(mockSvc, nil)
+		req := httptest.NewRequest(http.MethodGet,
+			"/users/"+testUserID123+"/picture/view?expires=123&signature=sig", nil)
+		req.SetPathValue("id", testUserID123)
+		rr := httptest.NewRecorder()
+
+		handler.HandleUserPictureViewRequest(rr, req)
+
+		require.Equal(t, http.StatusOK, rr.Code)
+		require.Equal(t, "image/jpeg", rr.Header().Get("Content-Type"))
+		require.Equal(t, "jpeg-data", rr.Body.String())
+	})
+
+	t.Run("InvalidSignature", func(t *testing.T) {
+		mockSvc := NewUserServiceInterfaceMock(t)
+		mockSvc.On("GetUserPictureBySignature", mock.Anything, testUserID123, "", "").
+			Return(nil, &ErrorInvalidPictureSignature)
+
+		handler := newUserHandler(mockSvc, nil)
+		req := httptest.NewRequest(http.MethodGet, "/users/"+testUserID123+"/picture/view", nil)
+		req.SetPathValue("id", testUserID123)
+		rr := httptest.NewRecorder()
+
+		handler.HandleUserPictureViewRequest(rr, req)
+
+		require.Equal(t, http.StatusForbidden, rr.Code)
+	})
+}
+
+func TestHandleUserPictureDeleteRequest_Success(t *testing.T) {
+	mockSvc := NewUserServiceInterfaceMock(t)
+	mockSvc.On("DeleteUserPicture", mock.Anything, testUserID123).Return(nil)
+
+	handler := newUserHandler(mockSvc, nil)
+	req := httptest.NewRequest(http.MethodDelete, "/users/"+testUserID123+"/picture", nil)
+	req.SetPathValue("id", testUserID123)
+	rr := httptest.NewRecorder()
+
+	handler.HandleUserPictureDeleteRequest(rr, req)
+
+	require.Equal(t, http.StatusNoContent, rr.Code)
+}
+
+func TestHandleUserCredentialDeleteRequest(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		mockSvc := NewUserServiceInterfaceMock(t)
+		mockSvc.On("DeleteUserCredential", mock.Anything, testUserID123, "passkey").Return(nil)
+
+		handler := newUserHandler(mockSvc, nil)
+		req := httptest.NewRequest(http.MethodDelete, "/users/"+testUserID123+"/credentials/passkey", nil)
+		req.SetPathValue("id", testUserID123)
+		req.SetPathValue("credentialType", "passkey")
+		rr := httptest.NewRecorder()
+
+		handler.HandleUserCredentialDeleteRequest(rr, req)
+
+		require.Equal(t, http.StatusNoContent, rr.Code)
+	})
+
+	t.Run("PolicyViolation", func(t *testing.T) {
+		mockSvc := NewUserServiceInterfaceMock(t)
+		mockSvc.On("DeleteUserCredential", mock.Anything, testUserID123, "passkey").
+			Return(&ErrorCredentialPolicyViolation)
+
+		handler := newUserHandler(mockSvc, nil)
+		req := httptest.NewRequest(http.MethodDelete, "/users/"+testUserID123+"/credentials/passkey", nil)
+		req.SetPathValue("id", testUserID123)
+		req.SetPathValue("credentialType", "passkey")
+		rr := httptest.NewRecorder()
+
+		handler.HandleUserCredentialDeleteRequest(rr, req)
+
+		require.Equal(t, http.StatusConflict, rr.Code)
+	})
+}
+
+func TestHandleUserTypeSampleValidationRequest_Success(t *testing.T) {
+	mockSvc := NewUserServiceInterfaceMock(t)
+	validationResp := &entitytype.SampleValidationResponse{
+		Valid:   true,
+		Results: []entitytype.SampleValidationResult{{Index: 0, Valid: true}},
+	}
+	mockSvc.On("ValidateUserTypeSamples", mock.Anything, mock.MatchedBy(
+		func(req entitytype.SampleValidationRequest) bool {
+			return req.OUID == "ou-1" && len(req.Samples) == 1
+		})).Return(validationResp, nil)
+
+	handler := newUserHandler(mockSvc, nil)
+	body := `{"ouId":"ou-1","schema":{"email":{"type":"string"}},"samples":[{"email":"a@example.com"}]}`
+	req := httptest.NewRequest(http.MethodPost, "/user-types/validate-sample", strings.NewReader(body))
+	rr := httptest.NewRecorder()
+
+	handler.HandleUserTypeSampleValidationRequest(rr, req)
+
+	require.Equal(t, http.StatusOK, rr.Code)
+	var resp entitytype.SampleValidationResponse
+	require.NoError(t, json.NewDecoder(rr.Body).Decode(&resp))
+	require.True(t, resp.Valid)
+	require.Len(t, resp.Results, 1)
+}
+
+func TestHandleUserTypeSampleValidationRequest_InvalidBody(t *testing.T) {
+	mockSvc := NewUserServiceInterfaceMock(t)
+
+	handler := newUserHandler(mockSvc, nil)
+	req := httptest.NewRequest(http.MethodPost, "/user-types/validate-sample", strings.NewReader("{"))
+	rr := httptest.NewRecorder()
+
+	handler.HandleUserTypeSampleValidationRequest(rr, req)
+
+	require.Equal(t, http.StatusBadRequest, rr.Code)
+	mockSvc.AssertNotCalled(t, "ValidateUserTypeSamples", mock.Anything, mock.Anything)
+}
+
+func TestHandleUserTypeSampleValidationRequest_Unauthorized(t *testing.T) {
+	mockSvc := NewUserServiceInterfaceMock(t)
+	mockSvc.On("ValidateUserTypeSamples", mock.Anything, mock.Anything).
+		Return(nil, &serviceerror.ErrorUnauthorized)
+
+	handler := newUserHandler(mockSvc, nil)
+	body := `{"ouId":"ou-1","schema":{},"samples":[{}]}`
+	req := httptest.NewRequest(http.MethodPost, "/user-types/validate-sample", strings.NewReader(body))
+	rr := httptest.NewRecorder()
+
+	handler.HandleUserTypeSampleValidationRequest(rr, req)
+
+	require.Equal(t, http.StatusForbidden, rr.Code)
+}
+
+func TestHandleCredentialFormatReportRequest_Success(t *testing.T) {
+	mockSvc := NewUserServiceInterfaceMock(t)
+	mockSvc.On("GetCredentialFormatReport", mock.Anything).Return(&CredentialFormatReport{
+		TotalCredentials: 3,
+		Formats:          []CredentialFormatSummary{{Algorithm: "BCRYPT", Count: 3, Supported: true}},
+	}, nil)
+
+	handler := newUserHandler(mockSvc, nil)
+	req := httptest.NewRequest(http.MethodGet, "/users/credential-report", nil)
+	rr := httptest.NewRecorder()
+
+	handler.HandleCredentialFormatReportRequest(rr, req)
+
+	require.Equal(t, http.StatusOK, rr.Code)
+	require.JSONEq(t, `{"totalCredentials":3,"formats":[`+
+		`{"algorithm":"BCRYPT","count":3,"current":false,"supported":true,"blocked":false}]}`, rr.Body.String())
+}
+
+func TestHandleCredentialFormatReportRequest_Forbidden(t *testing.T) {
+	mockSvc := NewUserServiceInterfaceMock(t)
+	mockSvc.On("GetCredentialFormatReport", mock.Anything).Return(nil, &serviceerror.ErrorUnauthorized)
+
+	handler := newUserHandler(mockSvc, nil)
+	req := httptest.NewRequest(http.MethodGet, "/users/credential-report", nil)
+	rr := httptest.NewRecorder()
+
+	handler.HandleCredentialFormatReportRequest(rr, req)
+
+	require.Equal(t, http.StatusForbidden, rr.Code)
+}
@@ -29,6 +29,8 @@ import (
 	serverconst "github.com/thunder-id/thunderid/internal/system/constants"
 	declarativeresource "github.com/thunder-id/thunderid/internal/system/declarative_resource"
 	"github.com/thunder-id/thunderid/internal/system/middleware"
+	"github.com/thunder-id/thunderid/internal/system/objectstore"
+	"github.com/thunder-id/thunderid/internal/system/observability"
 	"github.com/thunder-id/thunderid/internal/system/sysauthz"
 )
 
@@ -39,9 +41,16 @@ func Initialize(
 	ouService oupkg.OrganizationUnitServiceInterface,
 	entityTypeService entitytype.EntityTypeServiceInterface,
 	authzService sysauthz.SystemAuthorizationServiceInterface,
+	objectStore objectstore.ObjectStoreInterface,
+	observabilitySvc observability.ObservabilityServiceInterface,
 ) (UserServiceInterface, oupkg.OUUserResolver, declarativeresource.ResourceExporter, error) {
 	// Step 1: Create service with entity service
-	userService := newUserService(authzService, entityService, ouService, entityTypeService)
+	pictureSigner, err := newPictureURLSigner()
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	userService := newUserService(authzService, entityService, ouService, entityTypeService, objectStore,
+		pictureSigner, observabilitySvc, config.GetServerRuntime().Config.User.SoftDelete.Enabled)
 
 	// Step 2: Load user-specific indexed attributes into the entity store.
 	if err := entityService.LoadIndexedAttributes(getUserIndexedAttributes()); err != nil {
@@ -56,7 +65,9 @@ func Initialize(
 		}
 	}
 
-	userHandler := newUserHandler(userService)
+	readAuditor := newSensitiveReadAuditor(entityTypeService, observabilitySvc,
+		config.GetServerRuntime().Config.User.SensitiveReadAudit)
+	userHandler := newUserHandler(userService, readAuditor)
 	registerRoutes(mux, userHandler)
 
 	// Create resolver for OU package to query user data without cross-DB access
@@ -100,7 +111,7 @@ func registerRoutes(mux *http.ServeMux, userHandler *userHandler) {
 	}, opts1))
 
 	opts2 := middleware.CORSOptions{
-		AllowedMethods:   []string{"GET", "PUT", "DELETE"},
+		AllowedMethods:   []string{"GET", "POST", "PUT", "DELETE"},
 		AllowedHeaders:   middleware.DefaultAllowedHeaders,
 		AllowCredentials: true,
 		MaxAge:           600,
@@ -115,12 +126,59 @@ func registerRoutes(mux *http.ServeMux, userHandler *userHandler) {
 				userHandler.HandleUserGetRequest(w, r)
 			} else if len(segments) == 2 && segments[1] == "groups" {
 				userHandler.HandleUserGroupsGetRequest(w, r)
+			} else if len(segments) == 2 && segments[1] == "picture" {
+				userHandler.HandleUserPictureGetRequest(w, r)
+			} else if len(segments) == 3 && segments[1] == "picture" && segments[2] == "view" {
+				userHandler.HandleUserPictureViewRequest(w, r)
+			} else {
+				http.NotFound(w, r)
+			}
+		}, opts2))
+	mux.HandleFunc(middleware.WithCORS("POST /users/",
+		func(w http.ResponseWriter, r *http.Request) {
+			path := strings.TrimPrefix(r.URL.Path, "/users/")
+			segments := strings.Split(path, "/")
+			r.SetPathValue("id", segments[0])
+
+			if len(segments) == 2 && segments[1] == "restore" {
+				userHandler.HandleUserRestoreRequest(w, r)
+			} else if len(segments) == 2 && segments[1] == "purge" {
+				userHandler.HandleUserPurgeRequest(w, r)
+			} else {
+				http.NotFound(w, r)
+			}
+		}, opts2))
+	mux.HandleFunc(middleware.WithCORS("PUT /users/",
+		func(w http.ResponseWriter, r *http.Request) {
+			path := strings.TrimPrefix(r.URL.Path, "/users/")
+			segments := strings.Split(path, "/")
+			r.SetPathValue("id", segments[0])
+
+			if len(segments) == 1 {
+				userHandler.HandleUserPutRequest(w, r)
+			} else if len(segments) == 2 && segments[1] == "picture" {
+				userHandler.HandleUserPicturePutRequest(w, r)
+			} else {
+				http.NotFound(w, r)
+			}
+		}, opts2))
+	mux.HandleFunc(middleware.WithCORS("DELETE /users/",
+		func(w http.ResponseWriter, r *http.Request) {
+			path := strings.TrimPrefix(r.URL.Path, "/users/")
+			segments := strings.Split(path, "/")
+			r.SetPathValue("id", segments[0])
+
+			if len(segments) == 1 {
+				userHandler.HandleUserDeleteRequest(w, r)
+			} else if len(segments) == 2 && segments[1] == "picture" {
+				userHandler.HandleUserPictureDeleteRequest(w, r)
+			} else if len(segments) == 3 && segments[1] == "credentials" {
+				r.SetPathValue("credentialType", segments[2])
+				userHandler.HandleUserCredentialDeleteRequest(w, r)
 			} else {
 				http.NotFound(w, r)
 			}
 		}, opts2))
-	mux.HandleFunc(middleware.WithCORS("PUT /users/", userHandler.HandleUserPutRequest, opts2))
-	mux.HandleFunc(middleware.WithCORS("DELETE /users/", userHandler.HandleUserDeleteRequest, opts2))
 	mux.HandleFunc(middleware.WithCORS("OPTIONS /users/", func(w http.ResponseWriter, r *http.Request) {
 		w.WriteHeader(http.StatusNoContent)
 	}, opts2))
@@ -150,6 +208,19 @@ func registerRoutes(mux *http.ServeMux, userHandler *userHandler) {
 			w.WriteHeader(http.StatusNoContent)
 		}, optsSelfCredentials))
 
+	optsReport := middleware.CORSOptions{
+		AllowedMethods:   []string{"GET"},
+		AllowedHeaders:   middleware.DefaultAllowedHeaders,
+		AllowCredentials: true,
+		MaxAge:           600,
+	}
+	mux.HandleFunc(middleware.WithCORS("GET /users/credential-report",
+		userHandler.HandleCredentialFormatReportRequest, optsReport))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS /users/credential-report",
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, optsReport))
+
 	opts3 := middleware.CORSOptions{
 		AllowedMethods:   []string{"GET", "POST"},
 		AllowedHeaders:   middleware.DefaultAllowedHeaders,
@@ -164,4 +235,23 @@ func registerRoutes(mux *http.ServeMux, userHandler *userHandler) {
 		func(w http.ResponseWriter, r *http.Request) {
 			w.WriteHeader(http.StatusNoContent)
 		}, opts3))
+
+	optsSample := middleware.CORSOptions{
+		AllowedMethods:   []string{"POST"},
+		AllowedHeaders:   middleware.DefaultAllowedHeaders,
+		AllowCredentials: true,
+		MaxAge:           600,
+	}
+	mux.HandleFunc(middleware.WithCORS("POST /user-types/validate-sample",
+		userHandler.HandleUserTypeSampleValidationRequest, optsSample))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS /user-types/validate-sample",
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, optsSample))
+	mux.HandleFunc(middleware.WithCORS("POST /user-types/normalize-sample",
+		userHandler.HandleUserTypeSampleNormalizationRequest, optsSample))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS /user-types/normalize-sample",
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, optsSample))
 }
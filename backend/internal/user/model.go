@@ -34,9 +34,24 @@ type User struct {
 	Type       string          `json:"type,omitempty"`
 	Attributes json.RawMessage `json:"attributes,omitempty"`
 	Display    string          `json:"display,omitempty"`
+	PictureURL string          `json:"pictureUrl,omitempty"`
 	IsReadOnly bool            `json:"isReadOnly"`
 }
 
+// AttributeProjection selects the attributes returned for users. Paths are dot-separated and select
+// values within the attributes of a user. At most one of the two lists can be set.
+type AttributeProjection struct {
+	// Attributes lists the only attribute paths to return.
+	Attributes []string
+	// ExcludedAttributes lists the attribute paths to omit.
+	ExcludedAttributes []string
+}
+
+// UserPictureResponse is the response returned after a user picture is uploaded.
+type UserPictureResponse struct {
+	PictureURL string `json:"pictureUrl"`
+}
+
 // Credential represents the credentials of a user.
 type Credential struct {
 	StorageType       string              `json:"storageType"`
@@ -75,6 +90,22 @@ type UserGroupListResponse struct {
 	Links        []utils.Link         `json:"links"`
 }
 
+// CredentialFormatReport represents the number of stored user credentials per storage format.
+type CredentialFormatReport struct {
+	TotalCredentials int                       `json:"totalCredentials"`
+	Formats          []CredentialFormatSummary `json:"formats"`
+}
+
+// CredentialFormatSummary represents the stored credentials of a single storage format.
+type CredentialFormatSummary struct {
+	Algorithm  string `json:"algorithm"`
+	Count      int    `json:"count"`
+	Current    bool   `json:"current"`
+	Supported  bool   `json:"supported"`
+	SunsetDate string `json:"sunsetDate,omitempty"`
+	Blocked    bool   `json:"blocked"`
+}
+
 // CreateUserRequest represents the request body for creating a user.
 type CreateUserRequest struct {
 	OUID       string          `json:"ouId"`
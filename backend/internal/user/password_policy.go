@@ -0,0 +1,152 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package user
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/thunder-id/thunderid/internal/entitytype"
+	"github.com/thunder-id/thunderid/internal/passwordpolicy"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/i18n/core"
+	"github.com/thunder-id/thunderid/internal/system/log"
+)
+
+// extractPasswords returns the string values of the credential attributes of the user type that are set in
+// the attributes. It returns nil when no password policy service is configured.
+func (us *userService) extractPasswords(ctx context.Context, userType string, attributes json.RawMessage,
+	logger *log.Logger) (map[string]string, *serviceerror.ServiceError) {
+	if us.passwordPolicy == nil || len(attributes) == 0 {
+		return nil, nil
+	}
+
+	credentialInfos, svcErr := us.entityTypeService.GetAttributes(ctx,
+		entitytype.TypeCategoryUser, userType, true, false, false)
+	if svcErr != nil {
+		if svcErr.Code == entitytype.ErrorEntityTypeNotFound.Code {
+			return nil, &ErrorEntityTypeNotFound
+		}
+		return nil, logErrorAndReturnServerError(logger, "Failed to get credential attributes from schema",
+			fmt.Errorf("schema service error: %s", svcErr.ErrorDescription.DefaultValue))
+	}
+	if len(credentialInfos) == 0 {
+		return nil, nil
+	}
+
+	var attrs map[string]any
+	if err := json.Unmarshal(attributes, &attrs); err != nil {
+		return nil, &ErrorInvalidRequestFormat
+	}
+	passwords := make(map[string]string)
+	for _, credentialInfo := range credentialInfos {
+		if value, ok := attrs[credentialInfo.Attribute].(string); ok && value != "" {
+			passwords[credentialInfo.Attribute] = value
+		}
+	}
+	return passwords, nil
+}
+
+// checkPasswordPolicy checks new credential values of a user against the password policy that applies to
+// the user.
+func (us *userService) checkPasswordPolicy(ctx context.Context, user *User, passwords map[string]string,
+	logger *log.Logger) *serviceerror.ServiceError {
+	if us.passwordPolicy == nil || len(passwords) == 0 {
+		return nil
+	}
+
+	err := us.passwordPolicy.CheckPasswords(ctx, passwordTarget(user), passwords)
+	if err == nil {
+		return nil
+	}
+	if svcErr := mapPasswordPolicyError(err); svcErr != nil {
+		logger.Debug("Password does not satisfy the password policy", log.Error(err))
+		return svcErr
+	}
+	return logErrorAndReturnServerError(logger, "Failed to check password policy", err,
+		log.MaskedString(log.LoggerKeyUserID, user.ID))
+}
+
+// recordPasswords records the new credential values of a user in its password history. The credentials are
+// already updated, so a failure is logged rather than returned.
+func (us *userService) recordPasswords(ctx context.Context, user *User, passwords map[string]string,
+	logger *log.Logger) {
+	if us.passwordPolicy == nil || len(passwords) == 0 {
+		return
+	}
+
+	if err := us.passwordPolicy.RecordPasswords(ctx, passwordTarget(user),
+		slices.Sorted(maps.Keys(passwords))); err != nil {
+		logger.Error("Failed to record password history", log.Error(err),
+			log.MaskedString(log.LoggerKeyUserID, user.ID))
+	}
+}
+
+// passwordTarget builds the password policy target of a user.
+func passwordTarget(user *User) passwordpolicy.PasswordTarget {
+	return passwordpolicy.PasswordTarget{
+		UserID:     user.ID,
+		OUID:       user.OUID,
+		UserType:   user.Type,
+		Attributes: user.Attributes,
+	}
+}
+
+// mapPasswordPolicyError maps password policy violations to user service errors describing the rule that
+// is not satisfied. Returns nil if the error is not a password policy violation.
+func mapPasswordPolicyError(err error) *serviceerror.ServiceError {
+	var description core.I18nMessage
+	switch {
+	case errors.Is(err, passwordpolicy.ErrPasswordTooShort):
+		description = core.I18nMessage{
+			Key:          "error.userservice.password_too_short_description",
+			DefaultValue: "The password is shorter than the minimum length of the password policy",
+		}
+	case errors.Is(err, passwordpolicy.ErrPasswordTooSimple):
+		description = core.I18nMessage{
+			Key: "error.userservice.password_too_simple_description",
+			DefaultValue: "The password does not use enough kinds of characters. Mix lowercase and uppercase " +
+				"letters, digits and symbols",
+		}
+	case errors.Is(err, passwordpolicy.ErrPasswordInDictionary):
+		description = core.I18nMessage{
+			Key:          "error.userservice.password_in_dictionary_description",
+			DefaultValue: "The password is a common password or contains details of the user",
+		}
+	case errors.Is(err, passwordpolicy.ErrPasswordReused):
+		description = core.I18nMessage{
+			Key:          "error.userservice.password_reused_description",
+			DefaultValue: "The password matches one of the recent passwords of the user",
+		}
+	case errors.Is(err, passwordpolicy.ErrPasswordBreached):
+		description = core.I18nMessage{
+			Key:          "error.userservice.password_breached_description",
+			DefaultValue: "The password has appeared in a data breach. Choose a different password",
+		}
+	case errors.Is(err, passwordpolicy.ErrPasswordPolicyViolation):
+		return &ErrorPasswordPolicyViolation
+	default:
+		return nil
+	}
+	return serviceerror.CustomServiceError(ErrorPasswordPolicyViolation, description)
+}
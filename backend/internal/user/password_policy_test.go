@@ -0,0 +1,169 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package user
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	entitypkg "github.com/thunder-id/thunderid/internal/entity"
+	"github.com/thunder-id/thunderid/internal/entitytype"
+	"github.com/thunder-id/thunderid/internal/passwordpolicy"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/tests/mocks/entitymock"
+	"github.com/thunder-id/thunderid/tests/mocks/entitytypemock"
+	"github.com/thunder-id/thunderid/tests/mocks/passwordpolicymock"
+)
+
+func TestUserService_UpdateUserCredentials_PasswordPolicyViolation(t *testing.T) {
+	userStoreMock := entitymock.NewEntityServiceInterfaceMock(t)
+	userStoreMock.On("IsEntityDeclarative", mock.Anything, mock.Anything).Return(false, nil).Maybe()
+	userStoreMock.On("GetEntity", mock.Anything, svcTestUserID1).Return(&entitypkg.Entity{
+		Category: entitypkg.EntityCategoryUser, ID: svcTestUserID1, Type: testUserType, OUID: testOrgID,
+	}, nil).Once()
+	policyMock := passwordpolicymock.NewPasswordPolicyServiceInterfaceMock(t)
+	policyMock.On("CheckPasswords", mock.Anything, passwordpolicy.PasswordTarget{
+		UserID: svcTestUserID1, OUID: testOrgID, UserType: testUserType,
+	}, map[string]string{"password": "short"}).Return(passwordpolicy.ErrPasswordTooShort).Once()
+
+	service := &userService{
+		entityService: userStoreMock,
+		authzService:  newAllowAllAuthz(t),
+	}
+	service.SetPasswordPolicyService(policyMock)
+
+	svcErr := service.UpdateUserCredentials(context.Background(), svcTestUserID1,
+		json.RawMessage(`{"password":"short"}`))
+	require.NotNil(t, svcErr)
+	require.Equal(t, ErrorPasswordPolicyViolation.Code, svcErr.Code)
+	require.Equal(t, "error.userservice.password_too_short_description", svcErr.ErrorDescription.Key)
+	userStoreMock.AssertNotCalled(t, "UpdateCredentials", mock.Anything, mock.Anything, mock.Anything)
+	policyMock.AssertNotCalled(t, "RecordPasswords", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestUserService_UpdateUserCredentials_RecordsPasswordHistory(t *testing.T) {
+	userStoreMock := entitymock.NewEntityServiceInterfaceMock(t)
+	userStoreMock.On("IsEntityDeclarative", mock.Anything, mock.Anything).Return(false, nil).Maybe()
+	userStoreMock.On("GetEntity", mock.Anything, svcTestUserID1).Return(&entitypkg.Entity{
+		Category: entitypkg.EntityCategoryUser, ID: svcTestUserID1, Type: testUserType,
+	}, nil).Once()
+	userStoreMock.On("UpdateCredentials", mock.Anything, svcTestUserID1, mock.Anything).Return(nil).Once()
+	policyMock := passwordpolicymock.NewPasswordPolicyServiceInterfaceMock(t)
+	policyMock.On("CheckPasswords", mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+	policyMock.On("RecordPasswords", mock.Anything, mock.Anything, []string{"password"}).
+		Return(errors.New("db error")).Once()
+
+	service := &userService{
+		entityService: userStoreMock,
+		authzService:  newAllowAllAuthz(t),
+	}
+	service.SetPasswordPolicyService(policyMock)
+
+	// A failure to record the history does not fail the update, as the credentials are already updated.
+	svcErr := service.UpdateUserCredentials(context.Background(), svcTestUserID1,
+		json.RawMessage(`{"password":"Str0ng#Passw0rd"}`))
+	require.Nil(t, svcErr)
+}
+
+func TestUserService_UpdateUserCredentials_PasswordPolicyCheckFails(t *testing.T) {
+	userStoreMock := entitymock.NewEntityServiceInterfaceMock(t)
+	userStoreMock.On("IsEntityDeclarative", mock.Anything, mock.Anything).Return(false, nil).Maybe()
+	userStoreMock.On("GetEntity", mock.Anything, svcTestUserID1).Return(&entitypkg.Entity{
+		Category: entitypkg.EntityCategoryUser, ID: svcTestUserID1, Type: testUserType,
+	}, nil).Once()
+	policyMock := passwordpolicymock.NewPasswordPolicyServiceInterfaceMock(t)
+	policyMock.On("CheckPasswords", mock.Anything, mock.Anything, mock.Anything).
+		Return(errors.New("db error")).Once()
+
+	service := &userService{
+		entityService: userStoreMock,
+		authzService:  newAllowAllAuthz(t),
+	}
+	service.SetPasswordPolicyService(policyMock)
+
+	svcErr := service.UpdateUserCredentials(context.Background(), svcTestUserID1,
+		json.RawMessage(`{"password":"Str0ng#Passw0rd"}`))
+	require.Equal(t, &serviceerror.InternalServerError, svcErr)
+}
+
+func TestUserService_ExtractPasswords(t *testing.T) {
+	schemaMock := entitytypemock.NewEntityTypeServiceInterfaceMock(t)
+	schemaMock.On("GetAttributes", mock.Anything, entitytype.TypeCategoryUser, testUserType, true, false, false).
+		Return([]entitytype.AttributeInfo{{Attribute: "password"}, {Attribute: "pin"}},
+			(*serviceerror.ServiceError)(nil))
+
+	service := &userService{entityTypeService: schemaMock}
+	logger := log.GetLogger()
+
+	passwords, svcErr := service.extractPasswords(context.Background(), testUserType,
+		json.RawMessage(`{"email":"alice@example.com","password":"Str0ng#Passw0rd"}`), logger)
+	require.Nil(t, svcErr)
+	require.Nil(t, passwords)
+	schemaMock.AssertNotCalled(t, "GetAttributes", mock.Anything, mock.Anything, mock.Anything, mock.Anything,
+		mock.Anything, mock.Anything)
+
+	service.SetPasswordPolicyService(passwordpolicymock.NewPasswordPolicyServiceInterfaceMock(t))
+	passwords, svcErr = service.extractPasswords(context.Background(), testUserType,
+		json.RawMessage(`{"email":"alice@example.com","password":"Str0ng#Passw0rd","pin":""}`), logger)
+	require.Nil(t, svcErr)
+	require.Equal(t, map[string]string{"password": "Str0ng#Passw0rd"}, passwords)
+}
+
+func TestUserService_ExtractPasswords_EntityTypeNotFound(t *testing.T) {
+	schemaMock := entitytypemock.NewEntityTypeServiceInterfaceMock(t)
+	schemaMock.On("GetAttributes", mock.Anything, entitytype.TypeCategoryUser, "missing", true, false, false).
+		Return(nil, &entitytype.ErrorEntityTypeNotFound)
+
+	service := &userService{entityTypeService: schemaMock}
+	service.SetPasswordPolicyService(passwordpolicymock.NewPasswordPolicyServiceInterfaceMock(t))
+
+	_, svcErr := service.extractPasswords(context.Background(), "missing",
+		json.RawMessage(`{"password":"Str0ng#Passw0rd"}`), log.GetLogger())
+	require.Equal(t, &ErrorEntityTypeNotFound, svcErr)
+}
+
+func TestMapPasswordPolicyError(t *testing.T) {
+	testCases := []struct {
+		err            error
+		descriptionKey string
+	}{
+		{passwordpolicy.ErrPasswordTooShort, "error.userservice.password_too_short_description"},
+		{passwordpolicy.ErrPasswordTooSimple, "error.userservice.password_too_simple_description"},
+		{passwordpolicy.ErrPasswordInDictionary, "error.userservice.password_in_dictionary_description"},
+		{passwordpolicy.ErrPasswordReused, "error.userservice.password_reused_description"},
+		{fmt.Errorf("check failed: %w", passwordpolicy.ErrPasswordBreached),
+			"error.userservice.password_breached_description"},
+		{passwordpolicy.ErrPasswordPolicyViolation, ErrorPasswordPolicyViolation.ErrorDescription.Key},
+	}
+	for _, tc := range testCases {
+		svcErr := mapPasswordPolicyError(tc.err)
+		require.NotNil(t, svcErr)
+		require.Equal(t, ErrorPasswordPolicyViolation.Code, svcErr.Code)
+		require.Equal(t, tc.descriptionKey, svcErr.ErrorDescription.Key)
+	}
+
+	require.Nil(t, mapPasswordPolicyError(errors.New("db error")))
+}
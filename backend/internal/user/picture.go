@@ -0,0 +1,204 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package user
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	_ "image/gif" // registers the GIF decoder
+	"image/jpeg"
+	"image/png"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/objectstore"
+)
+
+const (
+	// pictureSigningKeyLabel separates the picture URL signing key from other keys derived from
+	// the server encryption key.
+	pictureSigningKeyLabel = "user-picture-url"
+	// maxPictureSourcePixels bounds the dimensions of an uploaded image to guard against
+	// decompression bombs. Processing holds the decoded image and an RGBA copy of it, which
+	// at this limit stays below 64 MB for the copy.
+	maxPictureSourcePixels = 16_000_000
+	pictureJPEGQuality     = 90
+)
+
+// errInvalidPicture is returned when uploaded picture data is not a supported image.
+var errInvalidPicture = errors.New("invalid picture")
+
+// supportedPictureTypes lists the image media types accepted for user pictures.
+var supportedPictureTypes = map[string]bool{
+	"image/jpeg": true,
+	"image/png":  true,
+	"image/gif":  true,
+}
+
+// getPictureKey returns the object store key of the picture of the given user.
+func getPictureKey(userID string) string {
+	return "users/" + userID + "/picture"
+}
+
+// processPicture validates an uploaded image and re-encodes it so that neither side exceeds
+// maxDimension. Re-encoding also strips any embedded metadata such as EXIF location data.
+// JPEG uploads are stored as JPEG, all other formats as PNG.
+func processPicture(data []byte, maxDimension int) (*objectstore.Object, error) {
+	contentType := http.DetectContentType(data)
+	if !supportedPictureTypes[contentType] {
+		return nil, fmt.Errorf("%w: unsupported content type %s", errInvalidPicture, contentType)
+	}
+
+	cfg, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", errInvalidPicture, err.Error())
+	}
+	if cfg.Width <= 0 || cfg.Height <= 0 || int64(cfg.Width)*int64(cfg.Height) > maxPictureSourcePixels {
+		return nil, fmt.Errorf("%w: unsupported dimensions %dx%d", errInvalidPicture, cfg.Width, cfg.Height)
+	}
+
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", errInvalidPicture, err.Error())
+	}
+	img = downscaleImage(img, maxDimension)
+
+	var buf bytes.Buffer
+	if contentType == "image/jpeg" {
+		err = jpeg.Encode(&buf, img, &jpeg.Options{Quality: pictureJPEGQuality})
+	} else {
+		contentType = "image/png"
+		err = png.Encode(&buf, img)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode picture: %w", err)
+	}
+
+	return &objectstore.Object{Data: buf.Bytes(), ContentType: contentType}, nil
+}
+
+// downscaleImage shrinks an image with a box filter so that it fits within maxDimension,
+// preserving the aspect ratio. Images that already fit are only converted to RGBA.
+func downscaleImage(src image.Image, maxDimension int) image.Image {
+	bounds := src.Bounds()
+	srcW, srcH := bounds.Dx(), bounds.Dy()
+
+	dstW, dstH := srcW, srcH
+	if maxDimension > 0 && (srcW > maxDimension || srcH > maxDimension) {
+		if srcW >= srcH {
+			dstW = maxDimension
+			dstH = max(1, srcH*maxDimension/srcW)
+		} else {
+			dstH = maxDimension
+			dstW = max(1, srcW*maxDimension/srcH)
+		}
+	}
+
+	rgba := image.NewRGBA(image.Rect(0, 0, srcW, srcH))
+	draw.Draw(rgba, rgba.Bounds(), src, bounds.Min, draw.Src)
+	if dstW == srcW && dstH == srcH {
+		return rgba
+	}
+
+	dst := image.NewRGBA(image.Rect(0, 0, dstW, dstH))
+	for y := 0; y < dstH; y++ {
+		y0, y1 := y*srcH/dstH, max((y+1)*srcH/dstH, y*srcH/dstH+1)
+		for x := 0; x < dstW; x++ {
+			x0, x1 := x*srcW/dstW, max((x+1)*srcW/dstW, x*srcW/dstW+1)
+			var r, g, b, a, n uint32
+			for sy := y0; sy < y1; sy++ {
+				for sx := x0; sx < x1; sx++ {
+					off := rgba.PixOffset(sx, sy)
+					r += uint32(rgba.Pix[off])
+					g += uint32(rgba.Pix[off+1])
+					b += uint32(rgba.Pix[off+2])
+					a += uint32(rgba.Pix[off+3])
+					n++
+				}
+			}
+			dst.SetRGBA(x, y, color.RGBA{
+				R: uint8(r / n), G: uint8(g / n), B: uint8(b / n), A: uint8(a / n),
+			})
+		}
+	}
+	return dst
+}
+
+// pictureURLSigner issues and verifies time-limited signed URLs for reading user pictures.
+type pictureURLSigner struct {
+	key      []byte
+	baseURL  string
+	validity time.Duration
+	now      func() time.Time
+}
+
+// newPictureURLSigner creates a signer whose key is derived from the server encryption key.
+func newPictureURLSigner() (*pictureURLSigner, error) {
+	cfg := config.GetServerRuntime().Config
+	encryptionKey, err := hex.DecodeString(cfg.Crypto.Encryption.Key)
+	if err != nil || len(encryptionKey) == 0 {
+		return nil, errors.New("a valid crypto.encryption.key is required to sign user picture URLs")
+	}
+	mac := hmac.New(sha256.New, encryptionKey)
+	mac.Write([]byte(pictureSigningKeyLabel))
+
+	return &pictureURLSigner{
+		key:      mac.Sum(nil),
+		baseURL:  config.GetServerURL(&cfg.Server),
+		validity: time.Duration(cfg.User.Picture.URLValidityPeriod) * time.Second,
+		now:      time.Now,
+	}, nil
+}
+
+// SignedURL returns a URL through which the picture of the given user can be read without
+// further authorization until the URL expires.
+func (s *pictureURLSigner) SignedURL(userID string) string {
+	expires := strconv.FormatInt(s.now().Add(s.validity).Unix(), 10)
+	query := url.Values{}
+	query.Set("expires", expires)
+	query.Set("signature", s.sign(userID, expires))
+	return fmt.Sprintf("%s/users/%s/picture/view?%s", s.baseURL, url.PathEscape(userID), query.Encode())
+}
+
+// Verify reports whether the signature is valid for the given user and has not expired.
+func (s *pictureURLSigner) Verify(userID, expires, signature string) bool {
+	expiresAt, err := strconv.ParseInt(expires, 10, 64)
+	if err != nil || s.now().Unix() > expiresAt {
+		return false
+	}
+	return hmac.Equal([]byte(signature), []byte(s.sign(userID, expires)))
+}
+
+// sign computes the signature over the user ID and expiry time.
+func (s *pictureURLSigner) sign(userID, expires string) string {
+	mac := hmac.New(sha256.New, s.key)
+	mac.Write([]byte(userID + "\n" + expires))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
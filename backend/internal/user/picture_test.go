@@ -0,0 +1,343 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package user
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"hash/crc32"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	entitypkg "github.com/thunder-id/thunderid/internal/entity"
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/internal/system/objectstore"
+	"github.com/thunder-id/thunderid/tests/mocks/entitymock"
+	"github.com/thunder-id/thunderid/tests/mocks/objectstoremock"
+	"github.com/thunder-id/thunderid/tests/mocks/sysauthzmock"
+)
+
+func encodeTestPNG(t *testing.T, width, height int) []byte {
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			img.Set(x, y, color.RGBA{R: uint8(x), G: uint8(y), B: 128, A: 255})
+		}
+	}
+	var buf bytes.Buffer
+	require.NoError(t, png.Encode(&buf, img))
+	return buf.Bytes()
+}
+
+func newTestPictureSigner(now time.Time) *pictureURLSigner {
+	return &pictureURLSigner{
+		key:      []byte("test-signing-key"),
+		baseURL:  "https://localhost:8090",
+		validity: time.Hour,
+		now:      func() time.Time { return now },
+	}
+}
+
+func setupPictureTestConfig(t *testing.T) {
+	config.ResetServerRuntime()
+	err := config.InitializeServerRuntime("", &config.Config{
+		User: config.UserConfig{
+			Picture: config.UserPictureConfig{MaxSize: 1024 * 1024, MaxDimension: 64},
+		},
+	})
+	require.NoError(t, err)
+	t.Cleanup(config.ResetServerRuntime)
+}
+
+func TestProcessPicture_DownscalesLargeImage(t *testing.T) {
+	picture, err := processPicture(encodeTestPNG(t, 200, 100), 50)
+	require.NoError(t, err)
+	require.Equal(t, "image/png", picture.ContentType)
+
+	img, err := png.Decode(bytes.NewReader(picture.Data))
+	require.NoError(t, err)
+	require.Equal(t, 50, img.Bounds().Dx())
+	require.Equal(t, 25, img.Bounds().Dy())
+}
+
+func TestProcessPicture_KeepsSmallImageSize(t *testing.T) {
+	picture, err := processPicture(encodeTestPNG(t, 20, 30), 50)
+	require.NoError(t, err)
+
+	cfg, _, err := image.DecodeConfig(bytes.NewReader(picture.Data))
+	require.NoError(t, err)
+	require.Equal(t, 20, cfg.Width)
+	require.Equal(t, 30, cfg.Height)
+}
+
+func TestProcessPicture_PreservesJPEG(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, jpeg.Encode(&buf, image.NewRGBA(image.Rect(0, 0, 80, 160)), nil))
+
+	picture, err := processPicture(buf.Bytes(), 40)
+	require.NoError(t, err)
+	require.Equal(t, "image/jpeg", picture.ContentType)
+
+	cfg, format, err := image.DecodeConfig(bytes.NewReader(picture.Data))
+	require.NoError(t, err)
+	require.Equal(t, "jpeg", format)
+	require.Equal(t, 20, cfg.Width)
+	require.Equal(t, 40, cfg.Height)
+}
+
+func TestProcessPicture_RejectsInvalidData(t *testing.T) {
+	tests := []struct {
+		name string
+		data []byte
+	}{
+		{"NotAnImage", []byte("<svg xmlns='http://www.w3.org/2000/svg'></svg>")},
+		{"Empty", []byte{}},
+		{"TruncatedPNG", encodeTestPNG(t, 10, 10)[:40]},
+		{"TooManyPixels", resizeTestPNGHeader(encodeTestPNG(t, 10, 10), 5000, 4000)},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := processPicture(tc.data, 50)
+			require.ErrorIs(t, err, errInvalidPicture)
+		})
+	}
+}
+
+// resizeTestPNGHeader rewrites the dimensions in the IHDR chunk of a PNG without changing its pixel data.
+func resizeTestPNGHeader(data []byte, width, height uint32) []byte {
+	data = bytes.Clone(data)
+	// The IHDR chunk follows the 8 byte signature: length, type, 13 bytes of data and a CRC over type and data.
+	binary.BigEndian.PutUint32(data[16:20], width)
+	binary.BigEndian.PutUint32(data[20:24], height)
+	binary.BigEndian.PutUint32(data[29:33], crc32.ChecksumIEEE(data[12:29]))
+	return data
+}
+
+func TestPictureURLSigner_SignAndVerify(t *testing.T) {
+	now := time.Unix(1_700_000_000, 0)
+	signer := newTestPictureSigner(now)
+
+	signedURL := signer.SignedURL(svcTestUserID1)
+	require.True(t, strings.HasPrefix(signedURL, "https://localhost:8090/users/user-1/picture/view?"))
+
+	parsed, err := url.Parse(signedURL)
+	require.NoError(t, err)
+	expires := parsed.Query().Get("expires")
+	signature := parsed.Query().Get("signature")
+
+	require.True(t, signer.Verify(svcTestUserID1, expires, signature))
+	require.False(t, signer.Verify("user-2", expires, signature))
+	require.False(t, signer.Verify(svcTestUserID1, "9999999999", signature))
+	require.False(t, signer.Verify(svcTestUserID1, "not-a-number", signature))
+
+	signer.now = func() time.Time { return now.Add(2 * time.Hour) }
+	require.False(t, signer.Verify(svcTestUserID1, expires, signature))
+}
+
+func TestUserService_UpdateUserPicture_StoresProcessedPicture(t *testing.T) {
+	setupPictureTestConfig(t)
+
+	entityMock := entitymock.NewEntityServiceInterfaceMock(t)
+	entityMock.On("GetEntity", mock.Anything, svcTestUserID1).
+		Return(&entitypkg.Entity{Category: entitypkg.EntityCategoryUser, ID: svcTestUserID1, OUID: "ou1"}, nil)
+	storeMock := objectstoremock.NewObjectStoreInterfaceMock(t)
+	storeMock.On("Put", mock.Anything, "users/user-1/picture", mock.MatchedBy(func(o objectstore.Object) bool {
+		cfg, _, err := image.DecodeConfig(bytes.NewReader(o.Data))
+		return err == nil && o.ContentType == "image/png" && cfg.Width == 64 && cfg.Height == 32
+	})).Return(nil).Once()
+
+	service := &userService{
+		entityService: entityMock,
+		authzService:  newAllowAllAuthz(t),
+		objectStore:   storeMock,
+		pictureSigner: newTestPictureSigner(time.Now()),
+	}
+
+	pictureURL, svcErr := service.UpdateUserPicture(context.Background(), svcTestUserID1, encodeTestPNG(t, 128, 64))
+	require.Nil(t, svcErr)
+	require.Contains(t, pictureURL, "/users/user-1/picture/view?")
+}
+
+func TestUserService_UpdateUserPicture_Rejections(t *testing.T) {
+	setupPictureTestConfig(t)
+
+	tests := []struct {
+		name         string
+		data         []byte
+		expectedCode string
+	}{
+		{"InvalidImage", []byte("plain text"), ErrorInvalidPicture.Code},
+		{"TooLarge", make([]byte, 1024*1024+1), ErrorPictureTooLarge.Code},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			entityMock := entitymock.NewEntityServiceInterfaceMock(t)
+			entityMock.On("GetEntity", mock.Anything, svcTestUserID1).
+				Return(&entitypkg.Entity{Category: entitypkg.EntityCategoryUser, ID: svcTestUserID1}, nil)
+			service := &userService{
+				entityService: entityMock,
+				authzService:  newAllowAllAuthz(t),
+				objectStore:   objectstoremock.NewObjectStoreInterfaceMock(t),
+				pictureSigner: newTestPictureSigner(time.Now()),
+			}
+
+			_, svcErr := service.UpdateUserPicture(context.Background(), svcTestUserID1, tc.data)
+			require.NotNil(t, svcErr)
+			require.Equal(t, tc.expectedCode, svcErr.Code)
+		})
+	}
+}
+
+func TestUserService_UpdateUserPicture_Unauthorized(t *testing.T) {
+	entityMock := entitymock.NewEntityServiceInterfaceMock(t)
+	entityMock.On("GetEntity", mock.Anything, svcTestUserID1).
+		Return(&entitypkg.Entity{Category: entitypkg.EntityCategoryUser, ID: svcTestUserID1, OUID: "ou1"}, nil)
+	authzMock := sysauthzmock.NewSystemAuthorizationServiceInterfaceMock(t)
+	authzMock.On("IsActionAllowed", mock.Anything, mock.Anything, mock.Anything).Return(false, nil)
+
+	service := &userService{
+		entityService: entityMock,
+		authzService:  authzMock,
+		objectStore:   objectstoremock.NewObjectStoreInterfaceMock(t),
+		pictureSigner: newTestPictureSigner(time.Now()),
+	}
+
+	_, svcErr := service.UpdateUserPicture(context.Background(), svcTestUserID1, encodeTestPNG(t, 8, 8))
+	require.NotNil(t, svcErr)
+	require.Equal(t, serviceerror.ErrorUnauthorized.Code, svcErr.Code)
+}
+
+func TestUserService_GetUserPicture(t *testing.T) {
+	tests := []struct {
+		name         string
+		storeErr     error
+		expectedCode string
+	}{
+		{"Found", nil, ""},
+		{"NotFound", objectstore.ErrObjectNotFound, ErrorPictureNotFound.Code},
+		{"StoreError", errors.New("disk failure"), serviceerror.InternalServerError.Code},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			entityMock := entitymock.NewEntityServiceInterfaceMock(t)
+			entityMock.On("GetEntity", mock.Anything, svcTestUserID1).
+				Return(&entitypkg.Entity{Category: entitypkg.EntityCategoryUser, ID: svcTestUserID1}, nil)
+			storeMock := objectstoremock.NewObjectStoreInterfaceMock(t)
+			var stored *objectstore.Object
+			if tc.storeErr == nil {
+				stored = &objectstore.Object{Data: []byte("img"), ContentType: "image/png"}
+			}
+			storeMock.On("Get", mock.Anything, "users/user-1/picture").Return(stored, tc.storeErr).Once()
+
+			service := &userService{
+				entityService: entityMock,
+				authzService:  newAllowAllAuthz(t),
+				objectStore:   storeMock,
+				pictureSigner: newTestPictureSigner(time.Now()),
+			}
+
+			picture, svcErr := service.GetUserPicture(context.Background(), svcTestUserID1)
+			if tc.expectedCode == "" {
+				require.Nil(t, svcErr)
+				require.Equal(t, stored, picture)
+				return
+			}
+			require.NotNil(t, svcErr)
+			require.Equal(t, tc.expectedCode, svcErr.Code)
+		})
+	}
+}
+
+func TestUserService_GetUserPicture_NonUserEntity(t *testing.T) {
+	entityMock := entitymock.NewEntityServiceInterfaceMock(t)
+	entityMock.On("GetEntity", mock.Anything, svcTestUserID1).
+		Return(&entitypkg.Entity{Category: entitypkg.EntityCategoryApp, ID: svcTestUserID1}, nil)
+
+	service := &userService{entityService: entityMock, authzService: newAllowAllAuthz(t)}
+
+	_, svcErr := service.GetUserPicture(context.Background(), svcTestUserID1)
+	require.NotNil(t, svcErr)
+	require.Equal(t, ErrorUserNotFound.Code, svcErr.Code)
+}
+
+func TestUserService_GetUserPictureBySignature(t *testing.T) {
+	signer := newTestPictureSigner(time.Now())
+	parsed, err := url.Parse(signer.SignedURL(svcTestUserID1))
+	require.NoError(t, err)
+	expires := parsed.Query().Get("expires")
+	signature := parsed.Query().Get("signature")
+
+	storeMock := objectstoremock.NewObjectStoreInterfaceMock(t)
+	stored := &objectstore.Object{Data: []byte("img"), ContentType: "image/png"}
+	storeMock.On("Get", mock.Anything, "users/user-1/picture").Return(stored, nil).Once()
+	service := &userService{objectStore: storeMock, pictureSigner: signer}
+
+	picture, svcErr := service.GetUserPictureBySignature(context.Background(), svcTestUserID1, expires, signature)
+	require.Nil(t, svcErr)
+	require.Equal(t, stored, picture)
+
+	_, svcErr = service.GetUserPictureBySignature(context.Background(), "user-2", expires, signature)
+	require.NotNil(t, svcErr)
+	require.Equal(t, ErrorInvalidPictureSignature.Code, svcErr.Code)
+}
+
+func TestUserService_DeleteUserPicture(t *testing.T) {
+	entityMock := entitymock.NewEntityServiceInterfaceMock(t)
+	entityMock.On("GetEntity", mock.Anything, svcTestUserID1).
+		Return(&entitypkg.Entity{Category: entitypkg.EntityCategoryUser, ID: svcTestUserID1}, nil)
+	storeMock := objectstoremock.NewObjectStoreInterfaceMock(t)
+	storeMock.On("Delete", mock.Anything, "users/user-1/picture").Return(nil).Once()
+
+	service := &userService{
+		entityService: entityMock,
+		authzService:  newAllowAllAuthz(t),
+		objectStore:   storeMock,
+		pictureSigner: newTestPictureSigner(time.Now()),
+	}
+
+	require.Nil(t, service.DeleteUserPicture(context.Background(), svcTestUserID1))
+}
+
+func TestUserService_ResolvePictureURL(t *testing.T) {
+	storeMock := objectstoremock.NewObjectStoreInterfaceMock(t)
+	storeMock.On("Exists", mock.Anything, "users/user-1/picture").Return(true, nil).Once()
+	storeMock.On("Exists", mock.Anything, "users/user-2/picture").Return(false, nil).Once()
+	storeMock.On("Exists", mock.Anything, "users/user-3/picture").Return(false, errors.New("boom")).Once()
+
+	service := &userService{objectStore: storeMock, pictureSigner: newTestPictureSigner(time.Now())}
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, "UserServiceTest"))
+
+	require.Contains(t, service.resolvePictureURL(context.Background(), "user-1", logger), "/users/user-1/picture/view?")
+	require.Empty(t, service.resolvePictureURL(context.Background(), "user-2", logger))
+	require.Empty(t, service.resolvePictureURL(context.Background(), "user-3", logger))
+	require.Empty(t, (&userService{}).resolvePictureURL(context.Background(), "user-1", logger))
+}
@@ -26,13 +26,24 @@ import (
 	"fmt"
 	"path"
 	"strings"
+	"time"
 
+	"github.com/thunder-id/thunderid/internal/accountprotection"
+	"github.com/thunder-id/thunderid/internal/appuser"
+	"github.com/thunder-id/thunderid/internal/emailchange"
 	"github.com/thunder-id/thunderid/internal/entity"
 	"github.com/thunder-id/thunderid/internal/entitytype"
 	oupkg "github.com/thunder-id/thunderid/internal/ou"
+	"github.com/thunder-id/thunderid/internal/passwordpolicy"
+	"github.com/thunder-id/thunderid/internal/quota"
+	"github.com/thunder-id/thunderid/internal/securitynotification"
+	"github.com/thunder-id/thunderid/internal/system/config"
 	serverconst "github.com/thunder-id/thunderid/internal/system/constants"
 	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
 	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/internal/system/objectstore"
+	"github.com/thunder-id/thunderid/internal/system/observability"
+	"github.com/thunder-id/thunderid/internal/system/observability/event"
 	"github.com/thunder-id/thunderid/internal/system/security"
 	"github.com/thunder-id/thunderid/internal/system/sysauthz"
 	"github.com/thunder-id/thunderid/internal/system/utils"
@@ -44,20 +55,42 @@ const loggerComponentName = "UserService"
 type UserServiceInterface interface {
 	GetUserList(ctx context.Context, limit, offset int,
 		filters map[string]interface{}, includeDisplay bool) (*UserListResponse, *serviceerror.ServiceError)
+	GetDeletedUserList(ctx context.Context, limit, offset int,
+		filters map[string]interface{}, includeDisplay bool) (*UserListResponse, *serviceerror.ServiceError)
 	GetUsersByPath(ctx context.Context, handlePath string, limit, offset int,
 		filters map[string]interface{}, includeDisplay bool) (*UserListResponse, *serviceerror.ServiceError)
 	CreateUser(ctx context.Context, user *User) (*User, *serviceerror.ServiceError)
 	CreateUserByPath(ctx context.Context, handlePath string,
 		request CreateUserByPathRequest) (*User, *serviceerror.ServiceError)
+	PrehashCredentials(ctx context.Context, users []*User) (context.Context, *serviceerror.ServiceError)
 	GetUser(ctx context.Context, userID string, includeDisplay bool) (*User, *serviceerror.ServiceError)
+	ProjectUserAttributes(users []User, projection AttributeProjection) ([]User, *serviceerror.ServiceError)
 	GetUserGroups(ctx context.Context, userID string,
 		limit, offset int) (*UserGroupListResponse, *serviceerror.ServiceError)
 	UpdateUser(ctx context.Context, userID string, user *User) (*User, *serviceerror.ServiceError)
 	UpdateUserAttributes(ctx context.Context, userID string,
 		attributes json.RawMessage) (*User, *serviceerror.ServiceError)
+	DeleteUserCredential(ctx context.Context, userID, credentialType string) *serviceerror.ServiceError
 	UpdateUserCredentials(ctx context.Context, userID string,
 		credentials json.RawMessage) *serviceerror.ServiceError
 	DeleteUser(ctx context.Context, userID string) *serviceerror.ServiceError
+	RestoreUser(ctx context.Context, userID string) (*User, *serviceerror.ServiceError)
+	PurgeUser(ctx context.Context, userID string) *serviceerror.ServiceError
+	UpdateUserPicture(ctx context.Context, userID string, data []byte) (string, *serviceerror.ServiceError)
+	GetUserPicture(ctx context.Context, userID string) (*objectstore.Object, *serviceerror.ServiceError)
+	GetUserPictureBySignature(ctx context.Context, userID, expires, signature string) (
+		*objectstore.Object, *serviceerror.ServiceError)
+	DeleteUserPicture(ctx context.Context, userID string) *serviceerror.ServiceError
+	ValidateUserTypeSamples(ctx context.Context,
+		request entitytype.SampleValidationRequest) (*entitytype.SampleValidationResponse, *serviceerror.ServiceError)
+	NormalizeUserTypeSamples(ctx context.Context, request entitytype.SampleNormalizationRequest) (
+		*entitytype.SampleNormalizationResponse, *serviceerror.ServiceError)
+	GetCredentialFormatReport(ctx context.Context) (*CredentialFormatReport, *serviceerror.ServiceError)
+	SetSecurityNotifier(notifier securitynotification.SecurityNotificationServiceInterface)
+	SetEmailChangeService(emailChangeService emailchange.EmailChangeServiceInterface)
+	SetAccountProtectionService(accountProtection accountprotection.AccountProtectionServiceInterface)
+	SetAppUserService(appUserService appuser.AppUserServiceInterface)
+	SetPasswordPolicyService(passwordPolicy passwordpolicy.PasswordPolicyServiceInterface)
 }
 
 // userService is the default implementation of the UserServiceInterface.
@@ -66,6 +99,15 @@ type userService struct {
 	entityService     entity.EntityServiceInterface
 	ouService         oupkg.OrganizationUnitServiceInterface
 	entityTypeService entitytype.EntityTypeServiceInterface
+	objectStore       objectstore.ObjectStoreInterface
+	pictureSigner     *pictureURLSigner
+	securityNotifier  securitynotification.SecurityNotificationServiceInterface
+	emailChangeSvc    emailchange.EmailChangeServiceInterface
+	accountProtection accountprotection.AccountProtectionServiceInterface
+	appUserService    appuser.AppUserServiceInterface
+	passwordPolicy    passwordpolicy.PasswordPolicyServiceInterface
+	observabilitySvc  observability.ObservabilityServiceInterface
+	softDelete        bool
 }
 
 // newUserService creates a new instance of userService with injected dependencies.
@@ -74,24 +116,87 @@ func newUserService(
 	entityService entity.EntityServiceInterface,
 	ouService oupkg.OrganizationUnitServiceInterface,
 	entityTypeService entitytype.EntityTypeServiceInterface,
+	objectStore objectstore.ObjectStoreInterface,
+	pictureSigner *pictureURLSigner,
+	observabilitySvc observability.ObservabilityServiceInterface,
+	softDelete bool,
 ) UserServiceInterface {
 	return &userService{
 		authzService:      authzService,
 		entityService:     entityService,
 		ouService:         ouService,
 		entityTypeService: entityTypeService,
+		objectStore:       objectStore,
+		pictureSigner:     pictureSigner,
+		observabilitySvc:  observabilitySvc,
+		softDelete:        softDelete,
 	}
 }
 
+// SetSecurityNotifier injects the service notifying users of security relevant activity. It is called once
+// at application startup after the notification services are initialized, as they are initialized after
+// the user service.
+func (us *userService) SetSecurityNotifier(notifier securitynotification.SecurityNotificationServiceInterface) {
+	us.securityNotifier = notifier
+}
+
+// SetEmailChangeService injects the service keeping email address changes pending until they are
+// confirmed. It is called once at application startup, as the service is initialized after the user service.
+func (us *userService) SetEmailChangeService(emailChangeService emailchange.EmailChangeServiceInterface) {
+	us.emailChangeSvc = emailChangeService
+}
+
+// SetAccountProtectionService injects the service protecting accounts against takeover. It is called once at
+// application startup, as the service is initialized after the user service.
+func (us *userService) SetAccountProtectionService(
+	accountProtection accountprotection.AccountProtectionServiceInterface) {
+	us.accountProtection = accountProtection
+}
+
+// SetAppUserService injects the service scoping user listings to the calling application. It is called once
+// at application startup, as the service is initialized after the user service.
+func (us *userService) SetAppUserService(appUserService appuser.AppUserServiceInterface) {
+	us.appUserService = appUserService
+}
+
+// SetPasswordPolicyService injects the service enforcing password policies when credentials are set. It is
+// called once at application startup, as the service is initialized after the user service.
+func (us *userService) SetPasswordPolicyService(passwordPolicy passwordpolicy.PasswordPolicyServiceInterface) {
+	us.passwordPolicy = passwordPolicy
+}
+
 // GetUserList retrieves a list of users with pagination and filtering.
 func (us *userService) GetUserList(ctx context.Context, limit, offset int,
 	filters map[string]interface{}, includeDisplay bool) (*UserListResponse, *serviceerror.ServiceError) {
+	return us.listUsers(ctx, limit, offset, filters, includeDisplay, "")
+}
+
+// GetDeletedUserList retrieves a list of the soft-deleted users with pagination and filtering.
+func (us *userService) GetDeletedUserList(ctx context.Context, limit, offset int,
+	filters map[string]interface{}, includeDisplay bool) (*UserListResponse, *serviceerror.ServiceError) {
+	return us.listUsers(entity.WithDeletedEntities(ctx), limit, offset, filters, includeDisplay,
+		deletedStatusQuery)
+}
+
+// listUsers retrieves the users the caller is authorized to list. extraQuery is appended to the pagination
+// links.
+func (us *userService) listUsers(ctx context.Context, limit, offset int, filters map[string]interface{},
+	includeDisplay bool, extraQuery string) (*UserListResponse, *serviceerror.ServiceError) {
 	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName))
 
 	if err := validatePaginationParams(limit, offset); err != nil {
 		return nil, err
 	}
 
+	// Scope the listing to the users of the calling application when the application isolates its users.
+	if us.appUserService != nil {
+		appID, svcErr := us.appUserService.GetCallerApplicationScope(ctx)
+		if svcErr != nil {
+			return nil, svcErr
+		}
+		ctx = entity.WithApplicationScope(ctx, appID)
+	}
+
 	// Resolve the set of organization units the caller is authorized to list users from.
 	accessible, svcErr := us.authzService.GetAccessibleResources(
 		ctx, security.ActionListUsers, security.ResourceTypeOU)
@@ -102,27 +207,34 @@ func (us *userService) GetUserList(ctx context.Context, limit, offset int,
 
 	// Unfiltered path: system-level caller — return all users.
 	if accessible.AllAllowed {
-		return us.listAllUsers(ctx, limit, offset, filters, includeDisplay, logger)
+		return us.listAllUsers(ctx, limit, offset, filters, includeDisplay, extraQuery, logger)
 	}
 
 	// Filtered path: return users belonging to the accessible OUs.
-	return us.listUsersByOUIDs(ctx, accessible.IDs, limit, offset, filters, includeDisplay, logger)
+	return us.listUsersByOUIDs(ctx, accessible.IDs, limit, offset, filters, includeDisplay, extraQuery, logger)
 }
 
 // listAllUsers retrieves users without OU filtering.
 func (us *userService) listAllUsers(
 	ctx context.Context, limit, offset int, filters map[string]interface{},
-	includeDisplay bool, logger *log.Logger,
+	includeDisplay bool, extraQuery string, logger *log.Logger,
 ) (*UserListResponse, *serviceerror.ServiceError) {
-	totalCount, err := us.entityService.GetEntityListCount(ctx, entity.EntityCategoryUser, filters)
-	if err != nil {
-		return nil, logErrorAndReturnServerError(logger, "Failed to get user list count", err)
+	skipCount := utils.IsCountSkipped(ctx)
+	totalCount, fetchLimit := utils.TotalCountUnknown, limit+1
+	if !skipCount {
+		var err error
+		totalCount, err = us.entityService.GetEntityListCount(ctx, entity.EntityCategoryUser, filters)
+		if err != nil {
+			return nil, logErrorAndReturnServerError(logger, "Failed to get user list count", err)
+		}
+		fetchLimit = limit
 	}
 
-	entities, err := us.entityService.GetEntityList(ctx, entity.EntityCategoryUser, limit, offset, filters)
+	entities, err := us.entityService.GetEntityList(ctx, entity.EntityCategoryUser, fetchLimit, offset, filters)
 	if err != nil {
 		return nil, logErrorAndReturnServerError(logger, "Failed to get user list", err)
 	}
+	entities, hasMore := utils.TrimPage(entities, limit)
 
 	users := entitiesToUsers(entities)
 	if includeDisplay {
@@ -130,30 +242,38 @@ func (us *userService) listAllUsers(
 		us.populateOUHandles(ctx, users, logger)
 	}
 
-	return buildUserListResponse(users, totalCount, limit, offset, utils.DisplayQueryParam(includeDisplay)), nil
+	return buildUserListResponse(
+		users, totalCount, limit, offset, hasMore, utils.DisplayQueryParam(includeDisplay)+extraQuery), nil
 }
 
 // listUsersByOUIDs retrieves users scoped to the given organization unit IDs.
 func (us *userService) listUsersByOUIDs(
 	ctx context.Context, ouIDs []string, limit, offset int, filters map[string]interface{},
-	includeDisplay bool, logger *log.Logger,
+	includeDisplay bool, extraQuery string, logger *log.Logger,
 ) (*UserListResponse, *serviceerror.ServiceError) {
-	displayQuery := utils.DisplayQueryParam(includeDisplay)
+	displayQuery := utils.DisplayQueryParam(includeDisplay) + extraQuery
 
 	if len(ouIDs) == 0 {
-		return buildUserListResponse([]User{}, 0, limit, offset, displayQuery), nil
+		return buildUserListResponse([]User{}, 0, limit, offset, false, displayQuery), nil
 	}
 
-	totalCount, err := us.entityService.GetEntityListCountByOUIDs(ctx, entity.EntityCategoryUser, ouIDs, filters)
-	if err != nil {
-		return nil, logErrorAndReturnServerError(logger, "Failed to get user list count", err)
+	skipCount := utils.IsCountSkipped(ctx)
+	totalCount, fetchLimit := utils.TotalCountUnknown, limit+1
+	if !skipCount {
+		var err error
+		totalCount, err = us.entityService.GetEntityListCountByOUIDs(ctx, entity.EntityCategoryUser, ouIDs, filters)
+		if err != nil {
+			return nil, logErrorAndReturnServerError(logger, "Failed to get user list count", err)
+		}
+		fetchLimit = limit
 	}
 
 	entities, err := us.entityService.GetEntityListByOUIDs(
-		ctx, entity.EntityCategoryUser, ouIDs, limit, offset, filters)
+		ctx, entity.EntityCategoryUser, ouIDs, fetchLimit, offset, filters)
 	if err != nil {
 		return nil, logErrorAndReturnServerError(logger, "Failed to get user list", err)
 	}
+	entities, hasMore := utils.TrimPage(entities, limit)
 
 	users := entitiesToUsers(entities)
 	if includeDisplay {
@@ -161,17 +281,20 @@ func (us *userService) listUsersByOUIDs(
 		us.populateOUHandles(ctx, users, logger)
 	}
 
-	return buildUserListResponse(users, totalCount, limit, offset, displayQuery), nil
+	return buildUserListResponse(users, totalCount, limit, offset, hasMore, displayQuery), nil
 }
 
-// buildUserListResponse constructs a paginated UserListResponse.
-func buildUserListResponse(users []User, totalCount, limit, offset int, displayQuery string) *UserListResponse {
+// buildUserListResponse constructs a paginated UserListResponse. hasMore is only consulted when the
+// total count is skipped.
+func buildUserListResponse(
+	users []User, totalCount, limit, offset int, hasMore bool, displayQuery string,
+) *UserListResponse {
 	return &UserListResponse{
 		TotalResults: totalCount,
 		StartIndex:   offset + 1,
 		Count:        len(users),
 		Users:        users,
-		Links:        utils.BuildPaginationLinks("/users", limit, offset, totalCount, displayQuery),
+		Links:        utils.BuildListPaginationLinks("/users", limit, offset, totalCount, hasMore, displayQuery),
 	}
 }
 
@@ -307,10 +430,33 @@ func (us *userService) CreateUser(ctx context.Context, user *User) (*User, *serv
 		return nil, svcErr
 	}
 
+	// A missing user type is resolved from the defaults of the organization unit.
+	if strings.TrimSpace(user.Type) == "" {
+		userType, svcErr := us.resolveUserType(ctx, user.OUID, logger)
+		if svcErr != nil {
+			return nil, svcErr
+		}
+		user.Type = userType
+	}
+
 	if svcErr := us.validateOrganizationUnitForUserType(ctx, user.Type, user.OUID, logger); svcErr != nil {
 		return nil, svcErr
 	}
 
+	attributes, svcErr := us.normalizeUserAttributes(ctx, user.Type, user.Attributes, logger)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	user.Attributes = attributes
+
+	passwords, svcErr := us.extractPasswords(ctx, user.Type, user.Attributes, logger)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	if svcErr := us.checkPasswordPolicy(ctx, user, passwords, logger); svcErr != nil {
+		return nil, svcErr
+	}
+
 	// Schema validation and uniqueness checks are handled by entity service in CreateEntity.
 
 	var err error
@@ -331,11 +477,35 @@ func (us *userService) CreateUser(ctx context.Context, user *User) (*User, *serv
 
 	// Sync cleaned attributes back — entity service removed credential fields from Attributes.
 	user.Attributes = created.Attributes
+	us.recordPasswords(ctx, user, passwords, logger)
+	us.publishUserEvent(ctx, event.EventTypeUserCreated, user.ID, user.OUID)
 
 	logger.Debug("Successfully created user", log.MaskedString(log.LoggerKeyUserID, user.ID))
 	return user, nil
 }
 
+// PrehashCredentials hashes the plaintext credentials of users about to be created in bulk with the shared
+// batch hashing pool. Users created through CreateUser with the returned context store these hashes instead
+// of hashing their credentials one at a time. Users without a type are hashed on creation as usual.
+func (us *userService) PrehashCredentials(
+	ctx context.Context, users []*User,
+) (context.Context, *serviceerror.ServiceError) {
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName))
+
+	entities := make([]*entity.Entity, 0, len(users))
+	for _, user := range users {
+		if user != nil && strings.TrimSpace(user.Type) != "" {
+			entities = append(entities, userToEntity(user))
+		}
+	}
+
+	hashedCtx, err := us.entityService.PrehashCredentials(ctx, entities)
+	if err != nil {
+		return ctx, logErrorAndReturnServerError(logger, "Failed to hash user credentials", err)
+	}
+	return hashedCtx, nil
+}
+
 // CreateUserByPath creates a new user under the organization unit specified by the handle path.
 func (us *userService) CreateUserByPath(
 	ctx context.Context, handlePath string, request CreateUserByPathRequest,
@@ -371,6 +541,42 @@ func (us *userService) CreateUserByPath(
 	return us.CreateUser(ctx, user)
 }
 
+// ProjectUserAttributes returns copies of the users with the attribute projection applied to their
+// attributes. The id, type, organization unit and other top-level fields are always retained.
+func (us *userService) ProjectUserAttributes(
+	users []User, projection AttributeProjection,
+) ([]User, *serviceerror.ServiceError) {
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName))
+
+	if len(projection.Attributes) > 0 && len(projection.ExcludedAttributes) > 0 {
+		return nil, &ErrorInvalidAttributeProjection
+	}
+	paths, include := projection.Attributes, true
+	if len(paths) == 0 {
+		paths, include = projection.ExcludedAttributes, false
+	}
+	if len(paths) == 0 {
+		return users, nil
+	}
+
+	tree, ok := buildProjectionTree(paths)
+	if !ok {
+		return nil, &ErrorInvalidAttributeProjection
+	}
+
+	projected := make([]User, 0, len(users))
+	for _, user := range users {
+		attributes, err := projectAttributes(user.Attributes, tree, include)
+		if err != nil {
+			return nil, logErrorAndReturnServerError(logger, "Failed to project user attributes", err,
+				log.MaskedString(log.LoggerKeyUserID, user.ID))
+		}
+		user.Attributes = attributes
+		projected = append(projected, user)
+	}
+	return projected, nil
+}
+
 // GetUser retrieves a user by ID.
 func (us *userService) GetUser(
 	ctx context.Context, userID string, includeDisplay bool,
@@ -391,7 +597,7 @@ func (us *userService) GetUser(
 		return nil, logErrorAndReturnServerError(logger, "Failed to retrieve user", err,
 			log.MaskedString(log.LoggerKeyUserID, userID))
 	}
-	if e.Category != entity.EntityCategoryUser {
+	if !isUserEntity(e) {
 		return nil, &ErrorUserNotFound
 	}
 	user := entityToUser(e)
@@ -416,6 +622,8 @@ func (us *userService) GetUser(
 		}
 	}
 
+	user.PictureURL = us.resolvePictureURL(ctx, userID, logger)
+
 	logger.Debug("Successfully retrieved user", log.MaskedString(log.LoggerKeyUserID, userID))
 	return &user, nil
 }
@@ -443,7 +651,7 @@ func (as *userService) GetUserGroups(ctx context.Context, userID string, limit,
 		return nil, logErrorAndReturnServerError(logger, "Failed to retrieve user", err,
 			log.MaskedString(log.LoggerKeyUserID, userID))
 	}
-	if userEntity.Category != entity.EntityCategoryUser {
+	if !isUserEntity(userEntity) {
 		return nil, &ErrorUserNotFound
 	}
 
@@ -503,7 +711,7 @@ func (us *userService) UpdateUser(
 		return nil, logErrorAndReturnServerError(logger, "Failed to retrieve user", err,
 			log.MaskedString(log.LoggerKeyUserID, userID))
 	}
-	if existingEntity.Category != entity.EntityCategoryUser {
+	if !isUserEntity(existingEntity) {
 		return nil, &ErrorUserNotFound
 	}
 	existingUser := entityToUser(existingEntity)
@@ -536,6 +744,20 @@ func (us *userService) UpdateUser(
 		return nil, svcErr
 	}
 
+	attributes, svcErr := us.normalizeUserAttributes(ctx, user.Type, user.Attributes, logger)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	user.Attributes = attributes
+
+	passwords, svcErr := us.extractPasswords(ctx, user.Type, user.Attributes, logger)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	if svcErr := us.checkPasswordPolicy(ctx, user, passwords, logger); svcErr != nil {
+		return nil, svcErr
+	}
+
 	// Entity service handles schema validation, credential extraction from attributes,
 	// hashing, merging with existing credentials, and entity update.
 	e := userToEntity(user)
@@ -548,6 +770,8 @@ func (us *userService) UpdateUser(
 		return nil, logErrorAndReturnServerError(logger, "Failed to update user", err,
 			log.MaskedString(log.LoggerKeyUserID, userID))
 	}
+	us.recordPasswords(ctx, user, passwords, logger)
+	us.publishUserEvent(ctx, event.EventTypeUserUpdated, userID, user.OUID)
 
 	logger.Debug("Successfully updated user", log.MaskedString(log.LoggerKeyUserID, userID))
 	return user, nil
@@ -578,7 +802,7 @@ func (us *userService) UpdateUserAttributes(
 		return nil, logErrorAndReturnServerError(logger, "Failed to get user", getErr,
 			log.MaskedString(log.LoggerKeyUserID, userID))
 	}
-	if existingEntity.Category != entity.EntityCategoryUser {
+	if !isUserEntity(existingEntity) {
 		return nil, &ErrorUserNotFound
 	}
 	existingUser := entityToUser(existingEntity)
@@ -622,9 +846,35 @@ func (us *userService) UpdateUserAttributes(
 		return nil, svcErr
 	}
 
+	attributes, svcErr = us.normalizeUserAttributes(ctx, existingUser.Type, attributes, logger)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	attributes, svcErr = us.retainContactVerification(ctx, existingUser.Type, existingEntity.Attributes,
+		attributes, logger)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	previousEmail, newEmail, svcErr := us.checkEmailChangeAllowed(ctx, userID, existingEntity.Attributes,
+		attributes, logger)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	attributes, emailChangeRequested, svcErr := us.holdEmailChange(ctx, userID, existingEntity.Attributes,
+		attributes, logger)
+	if svcErr != nil {
+		return nil, svcErr
+	}
 	existingUser.Attributes = attributes
 
 	if err := us.entityService.UpdateAttributes(ctx, userID, attributes); err != nil {
+		if emailChangeRequested {
+			// The confirmation links must not apply an address the rest of the update failed with.
+			if svcErr := us.emailChangeSvc.CancelPendingChange(ctx, userID); svcErr != nil {
+				logger.Warn("Failed to cancel pending email change", log.MaskedString(log.LoggerKeyUserID, userID),
+					log.String("error", svcErr.Code))
+			}
+		}
 		if svcErr := mapEntityError(err); svcErr != nil {
 			return nil, svcErr
 		}
@@ -632,10 +882,105 @@ func (us *userService) UpdateUserAttributes(
 			log.MaskedString(log.LoggerKeyUserID, userID))
 	}
 
+	// A held change is notified once it is confirmed and applied.
+	if newEmail != "" && !emailChangeRequested {
+		us.accountProtection.NotifyEmailChanged(ctx, userID, previousEmail, newEmail)
+	}
+
+	us.publishUserEvent(ctx, event.EventTypeUserUpdated, userID, existingUser.OUID)
+
 	logger.Debug("Successfully updated user attributes", log.MaskedString(log.LoggerKeyUserID, userID))
 	return &existingUser, nil
 }
 
+// checkEmailChangeAllowed checks whether the email address in the given attributes may replace the current
+// address, when account protection is enabled. It returns the current and new addresses when the address
+// changes, or empty strings otherwise. Removing the email address is not checked.
+func (us *userService) checkEmailChangeAllowed(ctx context.Context, userID string, currentAttributes,
+	attributes json.RawMessage, logger *log.Logger) (string, string, *serviceerror.ServiceError) {
+	if us.accountProtection == nil {
+		return "", "", nil
+	}
+	emailAttribute := us.accountProtection.GetEmailAttribute()
+
+	var attrs map[string]interface{}
+	if err := json.Unmarshal(attributes, &attrs); err != nil {
+		return "", "", &ErrorInvalidRequestFormat
+	}
+	newEmail, ok := attrs[emailAttribute].(string)
+	if !ok || newEmail == "" {
+		return "", "", nil
+	}
+
+	currentAttrs := map[string]interface{}{}
+	if len(currentAttributes) > 0 {
+		if err := json.Unmarshal(currentAttributes, &currentAttrs); err != nil {
+			return "", "", logErrorAndReturnServerError(logger, "Failed to parse user attributes", err,
+				log.MaskedString(log.LoggerKeyUserID, userID))
+		}
+	}
+	currentEmail, _ := currentAttrs[emailAttribute].(string)
+	if newEmail == currentEmail {
+		return "", "", nil
+	}
+
+	if svcErr := us.accountProtection.CheckChangeAllowed(ctx, userID,
+		accountprotection.ChangeTypeEmail); svcErr != nil {
+		return "", "", svcErr
+	}
+	return currentEmail, newEmail, nil
+}
+
+// holdEmailChange keeps a change of the email address in the given attributes pending until it is confirmed,
+// when email change confirmation is enabled. It requests the confirmation of the new address and returns the
+// attributes with the current address restored, along with whether a change was requested. Removing the
+// email address is not held.
+func (us *userService) holdEmailChange(ctx context.Context, userID string, currentAttributes,
+	attributes json.RawMessage, logger *log.Logger) (json.RawMessage, bool, *serviceerror.ServiceError) {
+	if us.emailChangeSvc == nil {
+		return attributes, false, nil
+	}
+	emailAttribute := us.emailChangeSvc.GetEmailAttribute()
+
+	var attrs map[string]interface{}
+	if err := json.Unmarshal(attributes, &attrs); err != nil {
+		return nil, false, &ErrorInvalidRequestFormat
+	}
+	newEmail, ok := attrs[emailAttribute].(string)
+	if !ok || newEmail == "" {
+		return attributes, false, nil
+	}
+
+	currentAttrs := map[string]interface{}{}
+	if len(currentAttributes) > 0 {
+		if err := json.Unmarshal(currentAttributes, &currentAttrs); err != nil {
+			return nil, false, logErrorAndReturnServerError(logger, "Failed to parse user attributes", err,
+				log.MaskedString(log.LoggerKeyUserID, userID))
+		}
+	}
+	currentEmailValue, hasCurrentEmail := currentAttrs[emailAttribute]
+	currentEmail, _ := currentEmailValue.(string)
+	if newEmail == currentEmail {
+		return attributes, false, nil
+	}
+
+	if svcErr := us.emailChangeSvc.RequestEmailChange(ctx, userID, currentEmail, newEmail); svcErr != nil {
+		return nil, false, svcErr
+	}
+
+	if hasCurrentEmail {
+		attrs[emailAttribute] = currentEmailValue
+	} else {
+		delete(attrs, emailAttribute)
+	}
+	heldAttributes, err := json.Marshal(attrs)
+	if err != nil {
+		return nil, false, logErrorAndReturnServerError(logger, "Failed to marshal user attributes", err,
+			log.MaskedString(log.LoggerKeyUserID, userID))
+	}
+	return heldAttributes, true, nil
+}
+
 // UpdateUserCredentials updates schema-defined credentials for a user.
 func (us *userService) UpdateUserCredentials(
 	ctx context.Context,
@@ -674,7 +1019,7 @@ func (us *userService) UpdateUserCredentials(
 		return logErrorAndReturnServerError(logger, "Failed to retrieve user", err,
 			log.MaskedString(log.LoggerKeyUserID, userID))
 	}
-	if existingEntity.Category != entity.EntityCategoryUser {
+	if !isUserEntity(existingEntity) {
 		return &ErrorUserNotFound
 	}
 	existingUser := entityToUser(existingEntity)
@@ -690,6 +1035,13 @@ func (us *userService) UpdateUserCredentials(
 		return svcErr
 	}
 
+	if us.accountProtection != nil {
+		if svcErr := us.accountProtection.CheckChangeAllowed(ctx, userID,
+			accountprotection.ChangeTypeCredential); svcErr != nil {
+			return svcErr
+		}
+	}
+
 	// Normalize credential values to plaintext strings. Entity service enforces the
 	// schema-credential allowlist and non-empty checks.
 	plaintextCreds := make(map[string]string, len(credentialsMap))
@@ -703,6 +1055,9 @@ func (us *userService) UpdateUserCredentials(
 		}
 		plaintextCreds[credTypeStr] = stringValue
 	}
+	if svcErr := us.checkPasswordPolicy(ctx, &existingUser, plaintextCreds, logger); svcErr != nil {
+		return svcErr
+	}
 
 	plaintextJSON, err := json.Marshal(plaintextCreds)
 	if err != nil {
@@ -716,6 +1071,16 @@ func (us *userService) UpdateUserCredentials(
 		return logErrorAndReturnServerError(logger, "Failed to update user credentials", err,
 			log.MaskedString(log.LoggerKeyUserID, userID))
 	}
+	us.recordPasswords(ctx, &existingUser, plaintextCreds, logger)
+
+	if us.accountProtection != nil {
+		us.accountProtection.NotifyCredentialChanged(ctx, userID)
+	} else if us.securityNotifier != nil {
+		us.securityNotifier.NotifyCredentialChanged(ctx, userID)
+	}
+
+	us.publishUserEvent(ctx, event.EventTypeUserCredentialsUpdated, userID, existingUser.OUID,
+		credentialTypes(credentialsMap)...)
 
 	logger.Debug("Successfully updated user credentials",
 		log.MaskedString(log.LoggerKeyUserID, userID),
@@ -723,7 +1088,60 @@ func (us *userService) UpdateUserCredentials(
 	return nil
 }
 
-// DeleteUser delete the user for given user id.
+// DeleteUserCredential removes all credentials of the given type from the user. The removal is rejected
+// when it would break the credential policy of the user type.
+func (us *userService) DeleteUserCredential(
+	ctx context.Context, userID, credentialType string,
+) *serviceerror.ServiceError {
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName))
+	logger.Debug("Deleting user credential", log.MaskedString(log.LoggerKeyUserID, userID),
+		log.String("credentialType", credentialType))
+
+	if userID == "" {
+		return &ErrorMissingUserID
+	}
+	if strings.TrimSpace(credentialType) == "" {
+		return &ErrorMissingCredentials
+	}
+
+	existingEntity, err := us.entityService.GetEntity(ctx, userID)
+	if err != nil {
+		if errors.Is(err, entity.ErrEntityNotFound) {
+			logger.Debug("User not found", log.MaskedString(log.LoggerKeyUserID, userID))
+			return &ErrorUserNotFound
+		}
+		return logErrorAndReturnServerError(logger, "Failed to retrieve user", err,
+			log.MaskedString(log.LoggerKeyUserID, userID))
+	}
+	if !isUserEntity(existingEntity) {
+		return &ErrorUserNotFound
+	}
+
+	if svcErr := us.checkUserAccess(
+		ctx, security.ActionUpdateUser, existingEntity.OUID, userID); svcErr != nil {
+		return svcErr
+	}
+	if svcErr := us.checkUserDeclarative(ctx, userID, logger); svcErr != nil {
+		return svcErr
+	}
+
+	if err := us.entityService.DeleteCredentials(ctx, userID, []string{credentialType}); err != nil {
+		if svcErr := mapEntityError(err); svcErr != nil {
+			return svcErr
+		}
+		return logErrorAndReturnServerError(logger, "Failed to delete user credential", err,
+			log.MaskedString(log.LoggerKeyUserID, userID))
+	}
+
+	us.publishUserEvent(ctx, event.EventTypeUserCredentialDeleted, userID, existingEntity.OUID, credentialType)
+
+	logger.Debug("Successfully deleted user credential", log.MaskedString(log.LoggerKeyUserID, userID),
+		log.String("credentialType", credentialType))
+	return nil
+}
+
+// DeleteUser deletes the user for the given user ID. When soft deletion is enabled, the user is marked as
+// deleted and can be restored until it is purged.
 func (us *userService) DeleteUser(ctx context.Context, userID string) *serviceerror.ServiceError {
 	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName))
 	logger.Debug("Deleting user", log.MaskedString(log.LoggerKeyUserID, userID))
@@ -742,7 +1160,7 @@ func (us *userService) DeleteUser(ctx context.Context, userID string) *serviceer
 		return logErrorAndReturnServerError(logger, "Failed to retrieve user", err,
 			log.MaskedString(log.LoggerKeyUserID, userID))
 	}
-	if existingEntity.Category != entity.EntityCategoryUser {
+	if !isUserEntity(existingEntity) {
 		return &ErrorUserNotFound
 	}
 	existingUser := entityToUser(existingEntity)
@@ -758,7 +1176,163 @@ func (us *userService) DeleteUser(ctx context.Context, userID string) *serviceer
 		return svcErr
 	}
 
-	err = us.entityService.DeleteEntity(ctx, userID)
+	if us.softDelete {
+		if svcErr := us.softDeleteUser(ctx, existingEntity, logger); svcErr != nil {
+			return svcErr
+		}
+	} else if svcErr := us.purgeUserEntity(ctx, userID, logger); svcErr != nil {
+		return svcErr
+	}
+	us.publishUserEvent(ctx, event.EventTypeUserDeleted, userID, existingUser.OUID)
+	return nil
+}
+
+// softDeleteUser marks a user as deleted, recording its state so that restoring the user brings it back in
+// the same state. The credentials, picture and relationships of the user are kept until it is purged.
+func (us *userService) softDeleteUser(
+	ctx context.Context, e *entity.Entity, logger *log.Logger,
+) *serviceerror.ServiceError {
+	previousState := e.State
+	if previousState == "" {
+		previousState = entity.EntityStateActive
+	}
+	systemAttributes, err := setSystemAttribute(e.SystemAttributes, previousStateAttribute, string(previousState))
+	if err != nil {
+		return logErrorAndReturnServerError(logger, "Failed to record the state of the deleted user", err,
+			log.MaskedString(log.LoggerKeyUserID, e.ID))
+	}
+	e.SystemAttributes = systemAttributes
+	e.State = entity.EntityStateDeleted
+
+	if _, err := us.entityService.UpdateEntity(ctx, e.ID, e); err != nil {
+		if svcErr := mapEntityError(err); svcErr != nil {
+			return svcErr
+		}
+		return logErrorAndReturnServerError(logger, "Failed to delete user", err,
+			log.MaskedString(log.LoggerKeyUserID, e.ID))
+	}
+
+	logger.Debug("Successfully soft-deleted user", log.MaskedString(log.LoggerKeyUserID, e.ID))
+	return nil
+}
+
+// RestoreUser restores a soft-deleted user in the state it had when it was deleted.
+func (us *userService) RestoreUser(ctx context.Context, userID string) (*User, *serviceerror.ServiceError) {
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName))
+	logger.Debug("Restoring user", log.MaskedString(log.LoggerKeyUserID, userID))
+
+	if userID == "" {
+		return nil, &ErrorMissingUserID
+	}
+
+	existingEntity, svcErr := us.getAnyUserEntity(ctx, userID, logger)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	if svcErr := us.checkUserAccess(
+		ctx, security.ActionDeleteUser, existingEntity.OUID, userID); svcErr != nil {
+		return nil, svcErr
+	}
+	if existingEntity.State != entity.EntityStateDeleted {
+		return nil, &ErrorUserNotDeleted
+	}
+
+	// The organization unit of the user may have been deleted while the user was.
+	exists, svcErr := us.ouService.IsOrganizationUnitExists(ctx, existingEntity.OUID)
+	if svcErr != nil {
+		return nil, mapOUServiceError(
+			svcErr,
+			logger,
+			"verifying organization unit existence",
+			map[string]*serviceerror.ServiceError{
+				oupkg.ErrorOrganizationUnitNotFound.Code: &ErrorOrganizationUnitNotFound,
+			},
+			log.String("oUID", existingEntity.OUID),
+		)
+	}
+	if !exists {
+		return nil, &ErrorOrganizationUnitNotFound
+	}
+
+	previousState, systemAttributes, err := popSystemAttribute(existingEntity.SystemAttributes,
+		previousStateAttribute)
+	if err != nil {
+		return nil, logErrorAndReturnServerError(logger, "Failed to read the state of the deleted user", err,
+			log.MaskedString(log.LoggerKeyUserID, userID))
+	}
+	existingEntity.State = entity.EntityState(previousState)
+	if existingEntity.State == "" || existingEntity.State == entity.EntityStateDeleted {
+		existingEntity.State = entity.EntityStateActive
+	}
+	existingEntity.SystemAttributes = systemAttributes
+
+	restored, err := us.entityService.UpdateEntity(ctx, userID, existingEntity)
+	if err != nil {
+		if svcErr := mapEntityError(err); svcErr != nil {
+			return nil, svcErr
+		}
+		return nil, logErrorAndReturnServerError(logger, "Failed to restore user", err,
+			log.MaskedString(log.LoggerKeyUserID, userID))
+	}
+
+	logger.Debug("Successfully restored user", log.MaskedString(log.LoggerKeyUserID, userID))
+	user := entityToUser(restored)
+	us.publishUserEvent(ctx, event.EventTypeUserRestored, userID, user.OUID)
+	return &user, nil
+}
+
+// PurgeUser permanently deletes the user for the given user ID, whether it is soft-deleted or not.
+func (us *userService) PurgeUser(ctx context.Context, userID string) *serviceerror.ServiceError {
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName))
+	logger.Debug("Purging user", log.MaskedString(log.LoggerKeyUserID, userID))
+
+	if userID == "" {
+		return &ErrorMissingUserID
+	}
+
+	existingEntity, svcErr := us.getAnyUserEntity(ctx, userID, logger)
+	if svcErr != nil {
+		return svcErr
+	}
+	if svcErr := us.checkUserAccess(
+		ctx, security.ActionDeleteUser, existingEntity.OUID, userID); svcErr != nil {
+		return svcErr
+	}
+	if svcErr := us.checkUserDeclarative(ctx, userID, logger); svcErr != nil {
+		return svcErr
+	}
+
+	if svcErr := us.purgeUserEntity(ctx, userID, logger); svcErr != nil {
+		return svcErr
+	}
+	us.publishUserEvent(ctx, event.EventTypeUserDeleted, userID, existingEntity.OUID)
+	return nil
+}
+
+// getAnyUserEntity retrieves the entity of a user, including a soft-deleted user.
+func (us *userService) getAnyUserEntity(
+	ctx context.Context, userID string, logger *log.Logger,
+) (*entity.Entity, *serviceerror.ServiceError) {
+	e, err := us.entityService.GetEntity(ctx, userID)
+	if err != nil {
+		if errors.Is(err, entity.ErrEntityNotFound) {
+			logger.Debug("User not found", log.MaskedString(log.LoggerKeyUserID, userID))
+			return nil, &ErrorUserNotFound
+		}
+		return nil, logErrorAndReturnServerError(logger, "Failed to retrieve user", err,
+			log.MaskedString(log.LoggerKeyUserID, userID))
+	}
+	if e.Category != entity.EntityCategoryUser {
+		return nil, &ErrorUserNotFound
+	}
+	return e, nil
+}
+
+// purgeUserEntity permanently deletes a user along with its picture and relationships.
+func (us *userService) purgeUserEntity(
+	ctx context.Context, userID string, logger *log.Logger,
+) *serviceerror.ServiceError {
+	err := us.entityService.DeleteEntity(ctx, userID)
 	if err != nil {
 		if errors.Is(err, entity.ErrEntityNotFound) {
 			logger.Debug("User not found", log.MaskedString(log.LoggerKeyUserID, userID))
@@ -768,10 +1342,54 @@ func (us *userService) DeleteUser(ctx context.Context, userID string) *serviceer
 			log.MaskedString(log.LoggerKeyUserID, userID))
 	}
 
+	if us.objectStore != nil {
+		if err := us.objectStore.Delete(ctx, getPictureKey(userID)); err != nil {
+			logger.Warn("Failed to delete picture of deleted user", log.Error(err),
+				log.MaskedString(log.LoggerKeyUserID, userID))
+		}
+	}
+	us.authzService.DeleteResourceRelationships(ctx, security.ResourceTypeUser, userID)
+
 	logger.Debug("Successfully deleted user", log.MaskedString(log.LoggerKeyUserID, userID))
 	return nil
 }
 
+// isUserEntity reports whether an entity is a user that is not soft-deleted. Soft-deleted users are only
+// visible to the restore and purge operations.
+func isUserEntity(e *entity.Entity) bool {
+	return e.Category == entity.EntityCategoryUser && e.State != entity.EntityStateDeleted
+}
+
+// setSystemAttribute returns the system attributes with the given attribute set.
+func setSystemAttribute(systemAttributes json.RawMessage, name, value string) (json.RawMessage, error) {
+	attributes := map[string]interface{}{}
+	if len(systemAttributes) > 0 {
+		if err := json.Unmarshal(systemAttributes, &attributes); err != nil {
+			return nil, err
+		}
+	}
+	attributes[name] = value
+	return json.Marshal(attributes)
+}
+
+// popSystemAttribute returns the value of a string system attribute and the system attributes without it.
+func popSystemAttribute(systemAttributes json.RawMessage, name string) (string, json.RawMessage, error) {
+	if len(systemAttributes) == 0 {
+		return "", systemAttributes, nil
+	}
+	attributes := map[string]interface{}{}
+	if err := json.Unmarshal(systemAttributes, &attributes); err != nil {
+		return "", nil, err
+	}
+	value, _ := attributes[name].(string)
+	delete(attributes, name)
+	remaining, err := json.Marshal(attributes)
+	if err != nil {
+		return "", nil, err
+	}
+	return value, remaining, nil
+}
+
 // populateUserDisplayNames resolves display names for a slice of users in-place.
 // It batch-fetches display attribute paths from the entity type service and extracts the
 // display value from each user's attributes. Falls back to user ID if extraction fails.
@@ -961,6 +1579,16 @@ func mapEntityError(err error) *serviceerror.ServiceError {
 		return &ErrorAttributeConflict
 	case errors.Is(err, entity.ErrInvalidCredential):
 		return &ErrorInvalidCredential
+	case errors.Is(err, entity.ErrDeniedIdentifier):
+		return &ErrorDeniedIdentifier
+	case errors.Is(err, entity.ErrDeniedCredential):
+		return &ErrorDeniedCredential
+	case errors.Is(err, entity.ErrCredentialNotFound):
+		return &ErrorCredentialNotFound
+	case errors.Is(err, entity.ErrCredentialPolicyViolation):
+		return &ErrorCredentialPolicyViolation
+	case errors.Is(err, quota.ErrQuotaExceeded):
+		return &ErrorUserQuotaExceeded
 	default:
 		return nil
 	}
@@ -995,6 +1623,234 @@ func mapOUServiceError(
 	return &serviceerror.InternalServerError
 }
 
+// UpdateUserPicture validates, resizes and stores the picture of a user, replacing any existing picture.
+// It returns a signed URL through which the new picture can be read.
+func (us *userService) UpdateUserPicture(
+	ctx context.Context, userID string, data []byte,
+) (string, *serviceerror.ServiceError) {
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName))
+	logger.Debug("Updating user picture", log.MaskedString(log.LoggerKeyUserID, userID))
+
+	if svcErr := us.checkPictureAccess(ctx, security.ActionUpdateUser, userID, logger); svcErr != nil {
+		return "", svcErr
+	}
+
+	pictureConfig := config.GetServerRuntime().Config.User.Picture
+	if pictureConfig.MaxSize > 0 && int64(len(data)) > pictureConfig.MaxSize {
+		return "", &ErrorPictureTooLarge
+	}
+
+	picture, err := processPicture(data, pictureConfig.MaxDimension)
+	if err != nil {
+		if errors.Is(err, errInvalidPicture) {
+			logger.Debug("Rejected invalid user picture", log.Error(err))
+			return "", &ErrorInvalidPicture
+		}
+		return "", logErrorAndReturnServerError(logger, "Failed to process user picture", err,
+			log.MaskedString(log.LoggerKeyUserID, userID))
+	}
+
+	if err := us.objectStore.Put(ctx, getPictureKey(userID), *picture); err != nil {
+		return "", logErrorAndReturnServerError(logger, "Failed to store user picture", err,
+			log.MaskedString(log.LoggerKeyUserID, userID))
+	}
+
+	logger.Debug("Successfully updated user picture", log.MaskedString(log.LoggerKeyUserID, userID))
+	return us.pictureSigner.SignedURL(userID), nil
+}
+
+// GetUserPicture retrieves the picture of a user.
+func (us *userService) GetUserPicture(
+	ctx context.Context, userID string,
+) (*objectstore.Object, *serviceerror.ServiceError) {
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName))
+
+	if svcErr := us.checkPictureAccess(ctx, security.ActionReadUser, userID, logger); svcErr != nil {
+		return nil, svcErr
+	}
+
+	return us.getPicture(ctx, userID, logger)
+}
+
+// GetUserPictureBySignature retrieves the picture of a user through a signed URL. The signature
+// authorizes the request, so no further authorization check is performed.
+func (us *userService) GetUserPictureBySignature(
+	ctx context.Context, userID, expires, signature string,
+) (*objectstore.Object, *serviceerror.ServiceError) {
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName))
+
+	if userID == "" {
+		return nil, &ErrorMissingUserID
+	}
+	if us.objectStore == nil || us.pictureSigner == nil {
+		return nil, &ErrorPictureNotFound
+	}
+	if !us.pictureSigner.Verify(userID, expires, signature) {
+		return nil, &ErrorInvalidPictureSignature
+	}
+
+	return us.getPicture(ctx, userID, logger)
+}
+
+// DeleteUserPicture removes the picture of a user. Deleting a missing picture is not an error.
+func (us *userService) DeleteUserPicture(ctx context.Context, userID string) *serviceerror.ServiceError {
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName))
+	logger.Debug("Deleting user picture", log.MaskedString(log.LoggerKeyUserID, userID))
+
+	if svcErr := us.checkPictureAccess(ctx, security.ActionUpdateUser, userID, logger); svcErr != nil {
+		return svcErr
+	}
+
+	if err := us.objectStore.Delete(ctx, getPictureKey(userID)); err != nil {
+		return logErrorAndReturnServerError(logger, "Failed to delete user picture", err,
+			log.MaskedString(log.LoggerKeyUserID, userID))
+	}
+
+	logger.Debug("Successfully deleted user picture", log.MaskedString(log.LoggerKeyUserID, userID))
+	return nil
+}
+
+// ValidateUserTypeSamples validates sample users against a draft user type schema without saving
+// either. Unique attribute values are checked against existing users, and the draft is compared
+// with the configured indexed user attributes.
+func (us *userService) ValidateUserTypeSamples(
+	ctx context.Context, request entitytype.SampleValidationRequest,
+) (*entitytype.SampleValidationResponse, *serviceerror.ServiceError) {
+	return us.entityTypeService.ValidateSamples(ctx, entitytype.TypeCategoryUser, request,
+		func(filters map[string]interface{}) (bool, error) {
+			if _, err := us.entityService.IdentifyEntity(ctx, filters); err != nil {
+				if errors.Is(err, entity.ErrEntityNotFound) {
+					return false, nil
+				}
+				if errors.Is(err, entity.ErrAmbiguousEntity) {
+					return true, nil
+				}
+				return false, err
+			}
+			return true, nil
+		}, getUserIndexedAttributes())
+}
+
+// NormalizeUserTypeSamples applies the normalization rules of a draft user type schema to sample users
+// without saving either, so that rule authors can preview the normalized values.
+func (us *userService) NormalizeUserTypeSamples(
+	ctx context.Context, request entitytype.SampleNormalizationRequest,
+) (*entitytype.SampleNormalizationResponse, *serviceerror.ServiceError) {
+	return us.entityTypeService.NormalizeSamples(ctx, entitytype.TypeCategoryUser, request)
+}
+
+// normalizeUserAttributes applies the normalization rules of the user type to the user attributes, so that
+// schema validation and uniqueness checks operate on the normalized values.
+func (us *userService) normalizeUserAttributes(
+	ctx context.Context, userType string, attributes json.RawMessage, logger *log.Logger,
+) (json.RawMessage, *serviceerror.ServiceError) {
+	if len(attributes) == 0 {
+		return attributes, nil
+	}
+
+	if us.entityTypeService == nil {
+		logger.Error("Entity type service is not configured for user operations")
+		return nil, &serviceerror.InternalServerError
+	}
+
+	normalized, svcErr := us.entityTypeService.NormalizeEntity(ctx, entitytype.TypeCategoryUser, userType, attributes)
+	if svcErr != nil {
+		if svcErr.Code == entitytype.ErrorEntityTypeNotFound.Code {
+			return nil, &ErrorEntityTypeNotFound
+		}
+		logger.Error("Failed to normalize user attributes",
+			log.String("userType", userType), log.Any("error", svcErr))
+		return nil, &serviceerror.InternalServerError
+	}
+
+	return normalized, nil
+}
+
+// retainContactVerification keeps the verification state of the values of the multi-valued contact
+// attributes as stored, so that a user updating their own attributes cannot mark a new or changed email
+// address or phone number as verified.
+func (us *userService) retainContactVerification(
+	ctx context.Context, userType string, currentAttributes, attributes json.RawMessage, logger *log.Logger,
+) (json.RawMessage, *serviceerror.ServiceError) {
+	retained, svcErr := us.entityTypeService.RetainContactVerification(ctx, entitytype.TypeCategoryUser,
+		userType, currentAttributes, attributes)
+	if svcErr != nil {
+		if svcErr.Code == entitytype.ErrorEntityTypeNotFound.Code {
+			return nil, &ErrorEntityTypeNotFound
+		}
+		logger.Error("Failed to retain contact verification state",
+			log.String("userType", userType), log.Any("error", svcErr))
+		return nil, &serviceerror.InternalServerError
+	}
+
+	return retained, nil
+}
+
+// checkPictureAccess verifies that the user exists and that the caller may perform the action on it.
+func (us *userService) checkPictureAccess(
+	ctx context.Context, action security.Action, userID string, logger *log.Logger,
+) *serviceerror.ServiceError {
+	if userID == "" {
+		return &ErrorMissingUserID
+	}
+
+	e, err := us.entityService.GetEntity(ctx, userID)
+	if err != nil {
+		if errors.Is(err, entity.ErrEntityNotFound) {
+			logger.Debug("User not found", log.MaskedString(log.LoggerKeyUserID, userID))
+			return &ErrorUserNotFound
+		}
+		return logErrorAndReturnServerError(logger, "Failed to retrieve user", err,
+			log.MaskedString(log.LoggerKeyUserID, userID))
+	}
+	if !isUserEntity(e) {
+		return &ErrorUserNotFound
+	}
+
+	if svcErr := us.checkUserAccess(ctx, action, e.OUID, userID); svcErr != nil {
+		return svcErr
+	}
+
+	if us.objectStore == nil || us.pictureSigner == nil {
+		logger.Error("User picture storage is not configured")
+		return &serviceerror.InternalServerError
+	}
+	return nil
+}
+
+// getPicture reads the picture of a user from the object store.
+func (us *userService) getPicture(
+	ctx context.Context, userID string, logger *log.Logger,
+) (*objectstore.Object, *serviceerror.ServiceError) {
+	picture, err := us.objectStore.Get(ctx, getPictureKey(userID))
+	if err != nil {
+		if errors.Is(err, objectstore.ErrObjectNotFound) {
+			return nil, &ErrorPictureNotFound
+		}
+		return nil, logErrorAndReturnServerError(logger, "Failed to retrieve user picture", err,
+			log.MaskedString(log.LoggerKeyUserID, userID))
+	}
+	return picture, nil
+}
+
+// resolvePictureURL returns a signed URL for the picture of a user, or an empty string if the user
+// has no picture. Lookup failures are logged and do not fail the surrounding request.
+func (us *userService) resolvePictureURL(ctx context.Context, userID string, logger *log.Logger) string {
+	if us.objectStore == nil || us.pictureSigner == nil {
+		return ""
+	}
+	exists, err := us.objectStore.Exists(ctx, getPictureKey(userID))
+	if err != nil {
+		logger.Warn("Failed to check user picture, skipping", log.Error(err),
+			log.MaskedString(log.LoggerKeyUserID, userID))
+		return ""
+	}
+	if !exists {
+		return ""
+	}
+	return us.pictureSigner.SignedURL(userID)
+}
+
 // checkUserDeclarative checks if a user is declarative and returns an error if it is.
 func (us *userService) checkUserDeclarative(
 	ctx context.Context, userID string, logger *log.Logger,
@@ -1014,6 +1870,47 @@ func (us *userService) checkUserDeclarative(
 	return nil
 }
 
+// GetCredentialFormatReport reports how many stored user credentials use each storage format, so that
+// administrators can track the migration away from legacy formats. Only callers allowed to list all
+// users can view the report.
+func (us *userService) GetCredentialFormatReport(
+	ctx context.Context) (*CredentialFormatReport, *serviceerror.ServiceError) {
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName))
+
+	accessible, svcErr := us.authzService.GetAccessibleResources(
+		ctx, security.ActionListUsers, security.ResourceTypeOU)
+	if svcErr != nil {
+		logger.Error("Failed to resolve accessible resources for the credential format report",
+			log.Any("error", svcErr))
+		return nil, &serviceerror.InternalServerError
+	}
+	if !accessible.AllAllowed {
+		return nil, &serviceerror.ErrorUnauthorized
+	}
+
+	formats, err := us.entityService.GetCredentialFormatCounts(ctx, entity.EntityCategoryUser)
+	if err != nil {
+		return nil, logErrorAndReturnServerError(logger, "Failed to count credential formats", err)
+	}
+
+	report := &CredentialFormatReport{Formats: make([]CredentialFormatSummary, 0, len(formats))}
+	for _, format := range formats {
+		summary := CredentialFormatSummary{
+			Algorithm: string(format.Algorithm),
+			Count:     format.Count,
+			Current:   format.Current,
+			Supported: format.Supported,
+			Blocked:   format.Blocked,
+		}
+		if !format.SunsetDate.IsZero() {
+			summary.SunsetDate = format.SunsetDate.UTC().Format(time.RFC3339)
+		}
+		report.TotalCredentials += format.Count
+		report.Formats = append(report.Formats, summary)
+	}
+	return report, nil
+}
+
 // checkUserAccess validates that the caller is authorized to perform the given action on a user.
 func (us *userService) checkUserAccess(
 	ctx context.Context, action security.Action, ouID string, resourceID string,
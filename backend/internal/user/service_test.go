@@ -22,23 +22,33 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
+	"github.com/thunder-id/thunderid/internal/accountprotection"
 	entitypkg "github.com/thunder-id/thunderid/internal/entity"
 	"github.com/thunder-id/thunderid/internal/entitytype"
 	oupkg "github.com/thunder-id/thunderid/internal/ou"
+	"github.com/thunder-id/thunderid/internal/quota"
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/cryptolab/hash"
 	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
 	i18ncore "github.com/thunder-id/thunderid/internal/system/i18n/core"
 	"github.com/thunder-id/thunderid/internal/system/log"
 	"github.com/thunder-id/thunderid/internal/system/security"
 	"github.com/thunder-id/thunderid/internal/system/sysauthz"
 	"github.com/thunder-id/thunderid/internal/system/utils"
+	"github.com/thunder-id/thunderid/tests/mocks/accountprotectionmock"
+	"github.com/thunder-id/thunderid/tests/mocks/appusermock"
+	"github.com/thunder-id/thunderid/tests/mocks/emailchangemock"
 	"github.com/thunder-id/thunderid/tests/mocks/entitymock"
 	"github.com/thunder-id/thunderid/tests/mocks/entitytypemock"
 	"github.com/thunder-id/thunderid/tests/mocks/oumock"
+	"github.com/thunder-id/thunderid/tests/mocks/securitynotificationmock"
 	"github.com/thunder-id/thunderid/tests/mocks/sysauthzmock"
 )
 
@@ -60,6 +70,7 @@ func newAllowAllAuthz(t interface {
 		Return(true, nil).Maybe()
 	authzMock.On("GetAccessibleResources", mock.Anything, mock.Anything, mock.Anything).
 		Return(&sysauthz.AccessibleResources{AllAllowed: true}, nil).Maybe()
+	authzMock.On("DeleteResourceRelationships", mock.Anything, mock.Anything, mock.Anything).Maybe()
 	return authzMock
 }
 
@@ -406,6 +417,7 @@ func TestUserService_CreateUser_CallsCreateEntity(t *testing.T) {
 		Once()
 
 	entityTypeMock := entitytypemock.NewEntityTypeServiceInterfaceMock(t)
+	expectNormalizeEntity(entityTypeMock)
 	entityTypeMock.On("GetEntityTypeByName", mock.Anything, mock.Anything, testUserType).
 		Return(&entitytype.EntityType{OUID: testOrgID}, (*serviceerror.ServiceError)(nil)).
 		Once()
@@ -452,6 +464,7 @@ func TestUserService_CreateUser_PropagatesStoreError(t *testing.T) {
 		Once()
 
 	entityTypeMock := entitytypemock.NewEntityTypeServiceInterfaceMock(t)
+	expectNormalizeEntity(entityTypeMock)
 	entityTypeMock.On("GetEntityTypeByName", mock.Anything, mock.Anything, testUserType).
 		Return(&entitytype.EntityType{OUID: testOrgID}, (*serviceerror.ServiceError)(nil)).
 		Once()
@@ -483,6 +496,138 @@ func TestUserService_CreateUser_PropagatesStoreError(t *testing.T) {
 	storeMock.AssertNumberOfCalls(t, "CreateEntity", 1)
 }
 
+func TestUserService_PrehashCredentials_DelegatesTypedUsers(t *testing.T) {
+	type ctxKey struct{}
+	hashedCtx := context.WithValue(context.Background(), ctxKey{}, "hashed")
+	storeMock := entitymock.NewEntityServiceInterfaceMock(t)
+	storeMock.On("PrehashCredentials", mock.Anything, mock.MatchedBy(func(entities []*entitypkg.Entity) bool {
+		return len(entities) == 1 && entities[0].Type == testUserType &&
+			string(entities[0].Attributes) == `{"password":"s3cret!"}`
+	})).Return(hashedCtx, nil).Once()
+
+	service := &userService{entityService: storeMock}
+	users := []*User{
+		{Type: testUserType, OUID: testOrgID, Attributes: json.RawMessage(`{"password":"s3cret!"}`)},
+		{OUID: testOrgID, Attributes: json.RawMessage(`{"password":"other"}`)},
+		nil,
+	}
+
+	ctx, svcErr := service.PrehashCredentials(context.Background(), users)
+	require.Nil(t, svcErr)
+	require.Equal(t, "hashed", ctx.Value(ctxKey{}))
+}
+
+func TestUserService_PrehashCredentials_ReturnsServerError(t *testing.T) {
+	storeMock := entitymock.NewEntityServiceInterfaceMock(t)
+	storeMock.On("PrehashCredentials", mock.Anything, mock.Anything).
+		Return(nil, errors.New("context canceled")).Once()
+
+	service := &userService{entityService: storeMock}
+	ctx := context.Background()
+
+	hashedCtx, svcErr := service.PrehashCredentials(ctx, []*User{{Type: testUserType}})
+	require.NotNil(t, svcErr)
+	require.Equal(t, serviceerror.InternalServerError, *svcErr)
+	require.Equal(t, ctx, hashedCtx)
+}
+
+func TestUserService_CreateUser_NormalizesAttributesBeforeCreate(t *testing.T) {
+	ouServiceMock := oumock.NewOrganizationUnitServiceInterfaceMock(t)
+	ouServiceMock.On("IsOrganizationUnitExists", mock.Anything, testOrgID).
+		Return(true, (*serviceerror.ServiceError)(nil)).
+		Once()
+
+	entityTypeMock := entitytypemock.NewEntityTypeServiceInterfaceMock(t)
+	entityTypeMock.On("GetEntityTypeByName", mock.Anything, mock.Anything, testUserType).
+		Return(&entitytype.EntityType{OUID: testOrgID}, (*serviceerror.ServiceError)(nil)).
+		Once()
+	entityTypeMock.On("NormalizeEntity", mock.Anything, entitytype.TypeCategoryUser, testUserType,
+		json.RawMessage(`{"email":" Alice@Example.com "}`)).
+		Return(json.RawMessage(`{"email":"alice@example.com"}`), (*serviceerror.ServiceError)(nil)).
+		Once()
+
+	storeMock := entitymock.NewEntityServiceInterfaceMock(t)
+	storeMock.
+		On("CreateEntity", mock.Anything, mock.MatchedBy(func(e *entitypkg.Entity) bool {
+			return string(e.Attributes) == `{"email":"alice@example.com"}`
+		}), mock.Anything).
+		Return(&entitypkg.Entity{
+			OUID: testOrgID, Type: testUserType,
+			Attributes: json.RawMessage(`{"email":"alice@example.com"}`),
+		}, nil).
+		Once()
+
+	service := &userService{
+		entityService:     storeMock,
+		ouService:         ouServiceMock,
+		entityTypeService: entityTypeMock,
+		authzService:      newAllowAllAuthz(t),
+	}
+
+	created, err := service.CreateUser(context.Background(), &User{
+		Type:       testUserType,
+		OUID:       testOrgID,
+		Attributes: json.RawMessage(`{"email":" Alice@Example.com "}`),
+	})
+	require.Nil(t, err)
+	require.JSONEq(t, `{"email":"alice@example.com"}`, string(created.Attributes))
+}
+
+func TestUserService_UpdateUserAttributes_NormalizationErrors(t *testing.T) {
+	testCases := []struct {
+		name     string
+		svcErr   *serviceerror.ServiceError
+		expected serviceerror.ServiceError
+	}{
+		{"EntityTypeNotFound", &entitytype.ErrorEntityTypeNotFound, ErrorEntityTypeNotFound},
+		{"ServerError", &serviceerror.InternalServerError, serviceerror.InternalServerError},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			storeMock := entitymock.NewEntityServiceInterfaceMock(t)
+			storeMock.On("IsEntityDeclarative", mock.Anything, mock.Anything).Return(false, nil).Maybe()
+			storeMock.
+				On("GetEntity", mock.Anything, svcTestUserID1).
+				Return(&entitypkg.Entity{Category: entitypkg.EntityCategoryUser, ID: svcTestUserID1,
+					Type: testUserType}, nil)
+
+			schemaMock := entitytypemock.NewEntityTypeServiceInterfaceMock(t)
+			schemaMock.On("GetAttributes", mock.Anything, mock.Anything, testUserType, true, false, false).
+				Return([]entitytype.AttributeInfo{}, (*serviceerror.ServiceError)(nil)).Once()
+			schemaMock.On("NormalizeEntity", mock.Anything, entitytype.TypeCategoryUser, testUserType,
+				mock.Anything).Return(nil, tc.svcErr).Once()
+
+			service := &userService{
+				entityService:     storeMock,
+				entityTypeService: schemaMock,
+				authzService:      newAllowAllAuthz(t),
+			}
+
+			resp, err := service.UpdateUserAttributes(context.Background(), svcTestUserID1,
+				json.RawMessage(`{"email":"new@example.com"}`))
+			require.Nil(t, resp)
+			require.NotNil(t, err)
+			require.Equal(t, tc.expected, *err)
+			storeMock.AssertNotCalled(t, "UpdateAttributes", mock.Anything, mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestUserService_NormalizeUserTypeSamples_DelegatesToEntityTypeService(t *testing.T) {
+	request := entitytype.SampleNormalizationRequest{OUID: testOrgID}
+	expected := &entitytype.SampleNormalizationResponse{}
+	entityTypeMock := entitytypemock.NewEntityTypeServiceInterfaceMock(t)
+	entityTypeMock.On("NormalizeSamples", mock.Anything, entitytype.TypeCategoryUser, request).
+		Return(expected, (*serviceerror.ServiceError)(nil)).Once()
+
+	service := &userService{entityTypeService: entityTypeMock}
+
+	response, err := service.NormalizeUserTypeSamples(context.Background(), request)
+	require.Nil(t, err)
+	require.Same(t, expected, response)
+}
+
 func TestUserService_UpdateUserCredentials_Validation(t *testing.T) {
 	t.Run("ReturnsAuthErrorWhenUserIDMissing", func(t *testing.T) {
 		service := &userService{}
@@ -598,6 +743,75 @@ func TestUserService_UpdateUserCredentials_Succeeds(t *testing.T) {
 	userStoreMock.AssertNumberOfCalls(t, "UpdateCredentials", 1)
 }
 
+func TestUserService_UpdateUserCredentials_NotifiesUser(t *testing.T) {
+	userStoreMock := entitymock.NewEntityServiceInterfaceMock(t)
+	userStoreMock.On("IsEntityDeclarative", mock.Anything, mock.Anything).Return(false, nil).Maybe()
+	userStoreMock.On("GetEntity", mock.Anything, svcTestUserID1).Return(&entitypkg.Entity{
+		Category: entitypkg.EntityCategoryUser, ID: svcTestUserID1, Type: "Person",
+	}, nil).Once()
+	userStoreMock.On("UpdateCredentials", mock.Anything, svcTestUserID1, mock.Anything).Return(nil).Once()
+	notifierMock := securitynotificationmock.NewSecurityNotificationServiceInterfaceMock(t)
+	notifierMock.On("NotifyCredentialChanged", mock.Anything, svcTestUserID1).Once()
+
+	service := &userService{
+		entityService: userStoreMock,
+		authzService:  newAllowAllAuthz(t),
+	}
+	service.SetSecurityNotifier(notifierMock)
+
+	svcErr := service.UpdateUserCredentials(context.Background(), svcTestUserID1,
+		json.RawMessage(`{"password":"newpassword"}`))
+	require.Nil(t, svcErr)
+}
+
+func TestUserService_UpdateUserCredentials_ProtectedAccount(t *testing.T) {
+	userStoreMock := entitymock.NewEntityServiceInterfaceMock(t)
+	userStoreMock.On("IsEntityDeclarative", mock.Anything, mock.Anything).Return(false, nil).Maybe()
+	userStoreMock.On("GetEntity", mock.Anything, svcTestUserID1).Return(&entitypkg.Entity{
+		Category: entitypkg.EntityCategoryUser, ID: svcTestUserID1, Type: "Person",
+	}, nil).Once()
+	userStoreMock.On("UpdateCredentials", mock.Anything, svcTestUserID1, mock.Anything).Return(nil).Once()
+	protectionMock := accountprotectionmock.NewAccountProtectionServiceInterfaceMock(t)
+	protectionMock.On("CheckChangeAllowed", mock.Anything, svcTestUserID1,
+		accountprotection.ChangeTypeCredential).Return(nil).Once()
+	protectionMock.On("NotifyCredentialChanged", mock.Anything, svcTestUserID1).Once()
+	notifierMock := securitynotificationmock.NewSecurityNotificationServiceInterfaceMock(t)
+
+	service := &userService{
+		entityService: userStoreMock,
+		authzService:  newAllowAllAuthz(t),
+	}
+	service.SetSecurityNotifier(notifierMock)
+	service.SetAccountProtectionService(protectionMock)
+
+	svcErr := service.UpdateUserCredentials(context.Background(), svcTestUserID1,
+		json.RawMessage(`{"password":"newpassword"}`))
+	require.Nil(t, svcErr)
+	notifierMock.AssertNotCalled(t, "NotifyCredentialChanged", mock.Anything, mock.Anything)
+}
+
+func TestUserService_UpdateUserCredentials_ChangeOnHold(t *testing.T) {
+	userStoreMock := entitymock.NewEntityServiceInterfaceMock(t)
+	userStoreMock.On("IsEntityDeclarative", mock.Anything, mock.Anything).Return(false, nil).Maybe()
+	userStoreMock.On("GetEntity", mock.Anything, svcTestUserID1).Return(&entitypkg.Entity{
+		Category: entitypkg.EntityCategoryUser, ID: svcTestUserID1, Type: "Person",
+	}, nil).Once()
+	protectionMock := accountprotectionmock.NewAccountProtectionServiceInterfaceMock(t)
+	protectionMock.On("CheckChangeAllowed", mock.Anything, svcTestUserID1,
+		accountprotection.ChangeTypeCredential).Return(&accountprotection.ErrorChangeOnHold).Once()
+
+	service := &userService{
+		entityService: userStoreMock,
+		authzService:  newAllowAllAuthz(t),
+	}
+	service.SetAccountProtectionService(protectionMock)
+
+	svcErr := service.UpdateUserCredentials(context.Background(), svcTestUserID1,
+		json.RawMessage(`{"password":"newpassword"}`))
+	require.Equal(t, &accountprotection.ErrorChangeOnHold, svcErr)
+	userStoreMock.AssertNotCalled(t, "UpdateCredentials", mock.Anything, mock.Anything, mock.Anything)
+}
+
 func TestUserService_UpdateUserCredentials_Rejections(t *testing.T) {
 	tests := []struct {
 		name          string
@@ -699,6 +913,8 @@ func TestUserService_UpdateUserAttributes_SchemaValidationFails(t *testing.T) {
 		Once()
 
 	schemaMock := entitytypemock.NewEntityTypeServiceInterfaceMock(t)
+	expectNormalizeEntity(schemaMock)
+	expectRetainContactVerification(schemaMock)
 	schemaMock.On("GetAttributes", mock.Anything, mock.Anything, testUserType, true, false, false).
 		Return([]entitytype.AttributeInfo{{Attribute: "password"}}, (*serviceerror.ServiceError)(nil)).Once()
 
@@ -728,6 +944,8 @@ func TestUserService_UpdateUserAttributes_Succeeds(t *testing.T) {
 		Once()
 
 	schemaMock := entitytypemock.NewEntityTypeServiceInterfaceMock(t)
+	expectNormalizeEntity(schemaMock)
+	expectRetainContactVerification(schemaMock)
 	schemaMock.On("GetAttributes", mock.Anything, mock.Anything, testUserType, true, false, false).
 		Return([]entitytype.AttributeInfo{{Attribute: "password"}}, (*serviceerror.ServiceError)(nil)).Once()
 
@@ -745,6 +963,66 @@ func TestUserService_UpdateUserAttributes_Succeeds(t *testing.T) {
 	require.JSONEq(t, string(newAttrs), string(resp.Attributes))
 }
 
+func TestUserService_UpdateUserAttributes_RetainsContactVerification(t *testing.T) {
+	storedAttrs := json.RawMessage(`{"emails":[{"value":"jo@example.com","verified":true}]}`)
+	storeMock := entitymock.NewEntityServiceInterfaceMock(t)
+	storeMock.On("IsEntityDeclarative", mock.Anything, mock.Anything).Return(false, nil).Maybe()
+	storeMock.
+		On("GetEntity", mock.Anything, svcTestUserID1).
+		Return(&entitypkg.Entity{Category: entitypkg.EntityCategoryUser, ID: svcTestUserID1, Type: testUserType,
+			Attributes: storedAttrs}, nil)
+	retainedAttrs := json.RawMessage(`{"emails":[{"value":"jo@example.com","verified":true},` +
+		`{"value":"jo@work.example.com"}]}`)
+	storeMock.On("UpdateAttributes", mock.Anything, svcTestUserID1, retainedAttrs).Return(nil).Once()
+
+	schemaMock := entitytypemock.NewEntityTypeServiceInterfaceMock(t)
+	expectNormalizeEntity(schemaMock)
+	schemaMock.On("GetAttributes", mock.Anything, mock.Anything, testUserType, true, false, false).
+		Return([]entitytype.AttributeInfo{}, (*serviceerror.ServiceError)(nil)).Once()
+	newAttrs := json.RawMessage(`{"emails":[{"value":"jo@example.com","verified":true},` +
+		`{"value":"jo@work.example.com","verified":true}]}`)
+	schemaMock.On("RetainContactVerification", mock.Anything, entitytype.TypeCategoryUser, testUserType,
+		storedAttrs, newAttrs).Return(retainedAttrs, (*serviceerror.ServiceError)(nil)).Once()
+
+	service := &userService{
+		entityService:     storeMock,
+		entityTypeService: schemaMock,
+		authzService:      newAllowAllAuthz(t),
+	}
+
+	resp, err := service.UpdateUserAttributes(context.Background(), svcTestUserID1, newAttrs)
+	require.Nil(t, err)
+	require.JSONEq(t, string(retainedAttrs), string(resp.Attributes))
+}
+
+func TestUserService_UpdateUserAttributes_RetainContactVerificationFails(t *testing.T) {
+	storeMock := entitymock.NewEntityServiceInterfaceMock(t)
+	storeMock.On("IsEntityDeclarative", mock.Anything, mock.Anything).Return(false, nil).Maybe()
+	storeMock.
+		On("GetEntity", mock.Anything, svcTestUserID1).
+		Return(&entitypkg.Entity{Category: entitypkg.EntityCategoryUser, ID: svcTestUserID1, Type: testUserType,
+			Attributes: json.RawMessage(`{}`)}, nil)
+
+	schemaMock := entitytypemock.NewEntityTypeServiceInterfaceMock(t)
+	expectNormalizeEntity(schemaMock)
+	schemaMock.On("GetAttributes", mock.Anything, mock.Anything, testUserType, true, false, false).
+		Return([]entitytype.AttributeInfo{}, (*serviceerror.ServiceError)(nil)).Once()
+	schemaMock.On("RetainContactVerification", mock.Anything, entitytype.TypeCategoryUser, testUserType,
+		mock.Anything, mock.Anything).Return(nil, &entitytype.ErrorEntityTypeNotFound).Once()
+
+	service := &userService{
+		entityService:     storeMock,
+		entityTypeService: schemaMock,
+		authzService:      newAllowAllAuthz(t),
+	}
+
+	resp, err := service.UpdateUserAttributes(context.Background(), svcTestUserID1,
+		json.RawMessage(`{"emails":[]}`))
+	require.Nil(t, resp)
+	require.NotNil(t, err)
+	require.Equal(t, ErrorEntityTypeNotFound.Code, err.Code)
+}
+
 func TestUserService_UpdateUserAttributes_RejectsCredentialAttributes(t *testing.T) {
 	storeMock := entitymock.NewEntityServiceInterfaceMock(t)
 	storeMock.On("IsEntityDeclarative", mock.Anything, mock.Anything).Return(false, nil).Maybe()
@@ -790,6 +1068,138 @@ func TestUserService_UpdateUserAttributes_NilSchemaService(t *testing.T) {
 	require.Equal(t, serviceerror.InternalServerError.Code, err.Code)
 }
 
+// newEmailChangeTestService builds a user service holding email changes, for a user whose current email
+// address is old@example.com.
+func newEmailChangeTestService(t *testing.T) (*userService, *entitymock.EntityServiceInterfaceMock,
+	*emailchangemock.EmailChangeServiceInterfaceMock) {
+	storeMock := entitymock.NewEntityServiceInterfaceMock(t)
+	storeMock.On("IsEntityDeclarative", mock.Anything, mock.Anything).Return(false, nil).Maybe()
+	storeMock.
+		On("GetEntity", mock.Anything, svcTestUserID1).
+		Return(&entitypkg.Entity{Category: entitypkg.EntityCategoryUser, ID: svcTestUserID1, Type: testUserType,
+			Attributes: json.RawMessage(`{"email":"old@example.com","name":"Jo"}`)}, nil)
+
+	schemaMock := entitytypemock.NewEntityTypeServiceInterfaceMock(t)
+	expectNormalizeEntity(schemaMock)
+	expectRetainContactVerification(schemaMock)
+	schemaMock.On("GetAttributes", mock.Anything, mock.Anything, testUserType, true, false, false).
+		Return([]entitytype.AttributeInfo{}, (*serviceerror.ServiceError)(nil)).Once()
+
+	emailChangeMock := emailchangemock.NewEmailChangeServiceInterfaceMock(t)
+	emailChangeMock.On("GetEmailAttribute").Return("email").Maybe()
+
+	service := &userService{
+		entityService:     storeMock,
+		entityTypeService: schemaMock,
+		authzService:      newAllowAllAuthz(t),
+	}
+	service.SetEmailChangeService(emailChangeMock)
+	return service, storeMock, emailChangeMock
+}
+
+func TestUserService_UpdateUserAttributes_HoldsEmailChange(t *testing.T) {
+	service, storeMock, emailChangeMock := newEmailChangeTestService(t)
+	emailChangeMock.On("RequestEmailChange", mock.Anything, svcTestUserID1, "old@example.com", "new@example.com").
+		Return(nil).Once()
+	storeMock.On("UpdateAttributes", mock.Anything, svcTestUserID1,
+		mock.MatchedBy(func(attrs json.RawMessage) bool {
+			return string(attrs) == `{"email":"old@example.com","name":"Max"}`
+		})).Return(nil).Once()
+
+	resp, err := service.UpdateUserAttributes(context.Background(), svcTestUserID1,
+		json.RawMessage(`{"email":"new@example.com","name":"Max"}`))
+	require.Nil(t, err)
+	require.JSONEq(t, `{"email":"old@example.com","name":"Max"}`, string(resp.Attributes))
+}
+
+func TestUserService_UpdateUserAttributes_UnchangedEmailNotHeld(t *testing.T) {
+	service, storeMock, emailChangeMock := newEmailChangeTestService(t)
+	storeMock.On("UpdateAttributes", mock.Anything, svcTestUserID1, mock.Anything).Return(nil).Once()
+
+	_, err := service.UpdateUserAttributes(context.Background(), svcTestUserID1,
+		json.RawMessage(`{"email":"old@example.com","name":"Max"}`))
+	require.Nil(t, err)
+	emailChangeMock.AssertNotCalled(t, "RequestEmailChange", mock.Anything, mock.Anything, mock.Anything,
+		mock.Anything)
+}
+
+func TestUserService_UpdateUserAttributes_EmailChangeRequestFails(t *testing.T) {
+	service, storeMock, emailChangeMock := newEmailChangeTestService(t)
+	emailChangeMock.On("RequestEmailChange", mock.Anything, svcTestUserID1, "old@example.com", "new@example.com").
+		Return(&serviceerror.InternalServerError).Once()
+
+	resp, err := service.UpdateUserAttributes(context.Background(), svcTestUserID1,
+		json.RawMessage(`{"email":"new@example.com"}`))
+	require.Nil(t, resp)
+	require.Equal(t, serviceerror.InternalServerError.Code, err.Code)
+	storeMock.AssertNotCalled(t, "UpdateAttributes", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestUserService_UpdateUserAttributes_FailedUpdateCancelsEmailChange(t *testing.T) {
+	service, storeMock, emailChangeMock := newEmailChangeTestService(t)
+	emailChangeMock.On("RequestEmailChange", mock.Anything, svcTestUserID1, "old@example.com", "new@example.com").
+		Return(nil).Once()
+	emailChangeMock.On("CancelPendingChange", mock.Anything, svcTestUserID1).Return(nil).Once()
+	storeMock.On("UpdateAttributes", mock.Anything, svcTestUserID1, mock.Anything).
+		Return(entitypkg.ErrSchemaValidationFailed).Once()
+
+	_, err := service.UpdateUserAttributes(context.Background(), svcTestUserID1,
+		json.RawMessage(`{"email":"new@example.com","name":"Max"}`))
+	require.Equal(t, ErrorSchemaValidationFailed.Code, err.Code)
+}
+
+func TestUserService_UpdateUserAttributes_EmailChangeRequiresStepUp(t *testing.T) {
+	service, storeMock, emailChangeMock := newEmailChangeTestService(t)
+	protectionMock := accountprotectionmock.NewAccountProtectionServiceInterfaceMock(t)
+	protectionMock.On("GetEmailAttribute").Return("email").Once()
+	protectionMock.On("CheckChangeAllowed", mock.Anything, svcTestUserID1, accountprotection.ChangeTypeEmail).
+		Return(&accountprotection.ErrorStepUpRequired).Once()
+	service.SetAccountProtectionService(protectionMock)
+
+	resp, err := service.UpdateUserAttributes(context.Background(), svcTestUserID1,
+		json.RawMessage(`{"email":"new@example.com"}`))
+	require.Nil(t, resp)
+	require.Equal(t, accountprotection.ErrorStepUpRequired.Code, err.Code)
+	emailChangeMock.AssertNotCalled(t, "RequestEmailChange", mock.Anything, mock.Anything, mock.Anything,
+		mock.Anything)
+	storeMock.AssertNotCalled(t, "UpdateAttributes", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestUserService_UpdateUserAttributes_NotifiesAppliedEmailChange(t *testing.T) {
+	service, storeMock, _ := newEmailChangeTestService(t)
+	service.SetEmailChangeService(nil)
+	protectionMock := accountprotectionmock.NewAccountProtectionServiceInterfaceMock(t)
+	protectionMock.On("GetEmailAttribute").Return("email").Once()
+	protectionMock.On("CheckChangeAllowed", mock.Anything, svcTestUserID1, accountprotection.ChangeTypeEmail).
+		Return(nil).Once()
+	protectionMock.On("NotifyEmailChanged", mock.Anything, svcTestUserID1, "old@example.com",
+		"new@example.com").Once()
+	service.SetAccountProtectionService(protectionMock)
+	storeMock.On("UpdateAttributes", mock.Anything, svcTestUserID1, mock.Anything).Return(nil).Once()
+
+	_, err := service.UpdateUserAttributes(context.Background(), svcTestUserID1,
+		json.RawMessage(`{"email":"new@example.com","name":"Max"}`))
+	require.Nil(t, err)
+}
+
+func TestUserService_UpdateUserAttributes_HeldEmailChangeNotNotified(t *testing.T) {
+	service, storeMock, emailChangeMock := newEmailChangeTestService(t)
+	protectionMock := accountprotectionmock.NewAccountProtectionServiceInterfaceMock(t)
+	protectionMock.On("GetEmailAttribute").Return("email").Once()
+	protectionMock.On("CheckChangeAllowed", mock.Anything, svcTestUserID1, accountprotection.ChangeTypeEmail).
+		Return(nil).Once()
+	service.SetAccountProtectionService(protectionMock)
+	emailChangeMock.On("RequestEmailChange", mock.Anything, svcTestUserID1, "old@example.com", "new@example.com").
+		Return(nil).Once()
+	storeMock.On("UpdateAttributes", mock.Anything, svcTestUserID1, mock.Anything).Return(nil).Once()
+
+	_, err := service.UpdateUserAttributes(context.Background(), svcTestUserID1,
+		json.RawMessage(`{"email":"new@example.com"}`))
+	require.Nil(t, err)
+	protectionMock.AssertNotCalled(t, "NotifyEmailChanged", mock.Anything, mock.Anything, mock.Anything,
+		mock.Anything)
+}
+
 func TestUserService_GetUser_ReturnsUser(t *testing.T) {
 	userID := svcTestUserID1
 	expectedEntity := &entitypkg.Entity{
@@ -867,6 +1277,216 @@ func TestUserService_DeleteUser(t *testing.T) {
 	storeMock.AssertNumberOfCalls(t, "DeleteEntity", 1)
 }
 
+func TestUserService_DeleteUser_DeletesRelationships(t *testing.T) {
+	userID := svcTestUserID1
+
+	storeMock := entitymock.NewEntityServiceInterfaceMock(t)
+	storeMock.On("IsEntityDeclarative", mock.Anything, mock.Anything).Return(false, nil).Maybe()
+	storeMock.On("GetEntity", mock.Anything, userID).
+		Return(&entitypkg.Entity{
+			Category: entitypkg.EntityCategoryUser, ID: userID, OUID: testOrgID,
+		}, nil).Once()
+	storeMock.On("DeleteEntity", mock.Anything, userID).Return(nil).Once()
+
+	authzMock := sysauthzmock.NewSystemAuthorizationServiceInterfaceMock(t)
+	authzMock.On("IsActionAllowed", mock.Anything, mock.Anything, mock.Anything).Return(true, nil)
+	authzMock.On("DeleteResourceRelationships", mock.Anything, security.ResourceTypeUser, userID).Once()
+
+	service := &userService{
+		entityService: storeMock,
+		authzService:  authzMock,
+	}
+
+	err := service.DeleteUser(context.Background(), userID)
+	require.Nil(t, err)
+}
+
+func TestUserService_DeleteUser_SoftDelete(t *testing.T) {
+	userID := svcTestUserID1
+
+	storeMock := entitymock.NewEntityServiceInterfaceMock(t)
+	storeMock.On("IsEntityDeclarative", mock.Anything, mock.Anything).Return(false, nil).Maybe()
+	storeMock.On("GetEntity", mock.Anything, userID).
+		Return(&entitypkg.Entity{
+			Category: entitypkg.EntityCategoryUser, ID: userID, OUID: testOrgID,
+			State: entitypkg.EntityStatePendingVerification,
+		}, nil).Once()
+	storeMock.On("UpdateEntity", mock.Anything, userID, mock.MatchedBy(func(e *entitypkg.Entity) bool {
+		return e.State == entitypkg.EntityStateDeleted &&
+			string(e.SystemAttributes) == `{"previousState":"PENDING_VERIFICATION"}`
+	})).Return(&entitypkg.Entity{}, nil).Once()
+
+	authzMock := sysauthzmock.NewSystemAuthorizationServiceInterfaceMock(t)
+	authzMock.On("IsActionAllowed", mock.Anything, mock.Anything, mock.Anything).Return(true, nil)
+
+	service := &userService{
+		entityService: storeMock,
+		authzService:  authzMock,
+		softDelete:    true,
+	}
+
+	err := service.DeleteUser(context.Background(), userID)
+	require.Nil(t, err)
+	storeMock.AssertNotCalled(t, "DeleteEntity", mock.Anything, mock.Anything)
+	authzMock.AssertNotCalled(t, "DeleteResourceRelationships", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestUserService_GetUser_SoftDeletedUserNotFound(t *testing.T) {
+	userID := svcTestUserID1
+
+	storeMock := entitymock.NewEntityServiceInterfaceMock(t)
+	storeMock.On("GetEntity", mock.Anything, userID).
+		Return(&entitypkg.Entity{
+			Category: entitypkg.EntityCategoryUser, ID: userID, OUID: testOrgID,
+			State: entitypkg.EntityStateDeleted,
+		}, nil).Once()
+
+	service := &userService{
+		entityService: storeMock,
+		authzService:  newAllowAllAuthz(t),
+	}
+
+	_, err := service.GetUser(context.Background(), userID, false)
+	require.Equal(t, &ErrorUserNotFound, err)
+}
+
+func TestUserService_RestoreUser(t *testing.T) {
+	userID := svcTestUserID1
+	deletedEntity := func() *entitypkg.Entity {
+		return &entitypkg.Entity{
+			Category: entitypkg.EntityCategoryUser, ID: userID, OUID: testOrgID,
+			State:            entitypkg.EntityStateDeleted,
+			SystemAttributes: json.RawMessage(`{"previousState":"PENDING_VERIFICATION"}`),
+		}
+	}
+
+	t.Run("RestoresPreviousState", func(t *testing.T) {
+		storeMock := entitymock.NewEntityServiceInterfaceMock(t)
+		storeMock.On("GetEntity", mock.Anything, userID).Return(deletedEntity(), nil).Once()
+		storeMock.On("UpdateEntity", mock.Anything, userID, mock.MatchedBy(func(e *entitypkg.Entity) bool {
+			return e.State == entitypkg.EntityStatePendingVerification && string(e.SystemAttributes) == `{}`
+		})).Return(&entitypkg.Entity{
+			Category: entitypkg.EntityCategoryUser, ID: userID, OUID: testOrgID,
+			State: entitypkg.EntityStatePendingVerification,
+		}, nil).Once()
+		ouServiceMock := oumock.NewOrganizationUnitServiceInterfaceMock(t)
+		ouServiceMock.On("IsOrganizationUnitExists", mock.Anything, testOrgID).Return(true, nil).Once()
+
+		service := &userService{
+			entityService: storeMock,
+			authzService:  newAllowAllAuthz(t),
+			ouService:     ouServiceMock,
+		}
+
+		user, err := service.RestoreUser(context.Background(), userID)
+		require.Nil(t, err)
+		require.Equal(t, userID, user.ID)
+	})
+
+	t.Run("NotDeleted", func(t *testing.T) {
+		storeMock := entitymock.NewEntityServiceInterfaceMock(t)
+		storeMock.On("GetEntity", mock.Anything, userID).
+			Return(&entitypkg.Entity{
+				Category: entitypkg.EntityCategoryUser, ID: userID, OUID: testOrgID,
+				State: entitypkg.EntityStateActive,
+			}, nil).Once()
+
+		service := &userService{
+			entityService: storeMock,
+			authzService:  newAllowAllAuthz(t),
+		}
+
+		_, err := service.RestoreUser(context.Background(), userID)
+		require.Equal(t, &ErrorUserNotDeleted, err)
+	})
+
+	t.Run("OrganizationUnitDeleted", func(t *testing.T) {
+		storeMock := entitymock.NewEntityServiceInterfaceMock(t)
+		storeMock.On("GetEntity", mock.Anything, userID).Return(deletedEntity(), nil).Once()
+		ouServiceMock := oumock.NewOrganizationUnitServiceInterfaceMock(t)
+		ouServiceMock.On("IsOrganizationUnitExists", mock.Anything, testOrgID).Return(false, nil).Once()
+
+		service := &userService{
+			entityService: storeMock,
+			authzService:  newAllowAllAuthz(t),
+			ouService:     ouServiceMock,
+		}
+
+		_, err := service.RestoreUser(context.Background(), userID)
+		require.Equal(t, &ErrorOrganizationUnitNotFound, err)
+		storeMock.AssertNotCalled(t, "UpdateEntity", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("Unauthorized", func(t *testing.T) {
+		storeMock := entitymock.NewEntityServiceInterfaceMock(t)
+		storeMock.On("GetEntity", mock.Anything, userID).Return(deletedEntity(), nil).Once()
+		authzMock := sysauthzmock.NewSystemAuthorizationServiceInterfaceMock(t)
+		authzMock.On("IsActionAllowed", mock.Anything, security.ActionDeleteUser, mock.Anything).
+			Return(false, nil).Once()
+
+		service := &userService{
+			entityService: storeMock,
+			authzService:  authzMock,
+		}
+
+		_, err := service.RestoreUser(context.Background(), userID)
+		require.Equal(t, &serviceerror.ErrorUnauthorized, err)
+	})
+}
+
+func TestUserService_PurgeUser_DeletedUser(t *testing.T) {
+	userID := svcTestUserID1
+
+	storeMock := entitymock.NewEntityServiceInterfaceMock(t)
+	storeMock.On("IsEntityDeclarative", mock.Anything, userID).Return(false, nil).Once()
+	storeMock.On("GetEntity", mock.Anything, userID).
+		Return(&entitypkg.Entity{
+			Category: entitypkg.EntityCategoryUser, ID: userID, OUID: testOrgID,
+			State: entitypkg.EntityStateDeleted,
+		}, nil).Once()
+	storeMock.On("DeleteEntity", mock.Anything, userID).Return(nil).Once()
+
+	authzMock := sysauthzmock.NewSystemAuthorizationServiceInterfaceMock(t)
+	authzMock.On("IsActionAllowed", mock.Anything, mock.Anything, mock.Anything).Return(true, nil)
+	authzMock.On("DeleteResourceRelationships", mock.Anything, security.ResourceTypeUser, userID).Once()
+
+	service := &userService{
+		entityService: storeMock,
+		authzService:  authzMock,
+		softDelete:    true,
+	}
+
+	err := service.PurgeUser(context.Background(), userID)
+	require.Nil(t, err)
+}
+
+func TestUserService_GetDeletedUserList(t *testing.T) {
+	limit := 10
+	offset := 0
+	filters := map[string]interface{}{}
+	deletedCtx := entitypkg.WithDeletedEntities(context.Background())
+
+	storeMock := entitymock.NewEntityServiceInterfaceMock(t)
+	storeMock.On("GetEntityListCount", deletedCtx, entitypkg.EntityCategoryUser, filters).Return(11, nil).Once()
+	storeMock.On("GetEntityList", deletedCtx, entitypkg.EntityCategoryUser, limit, offset, filters).
+		Return([]entitypkg.Entity{{ID: svcTestUserID1}}, nil).
+		Once()
+
+	service := &userService{
+		entityService: storeMock,
+		authzService:  newAllowAllAuthz(t),
+	}
+
+	resp, err := service.GetDeletedUserList(context.Background(), limit, offset, filters, false)
+	require.Nil(t, err)
+	require.Equal(t, 11, resp.TotalResults)
+	require.Len(t, resp.Users, 1)
+	require.NotEmpty(t, resp.Links)
+	for _, link := range resp.Links {
+		require.Contains(t, link.Href, "&status=deleted")
+	}
+}
+
 func TestUserService_UpdateUser(t *testing.T) {
 	userID := svcTestUserID1
 	updatedUser := User{ID: userID, OUID: testOrgID, Type: testUserType,
@@ -892,6 +1512,7 @@ func TestUserService_UpdateUser(t *testing.T) {
 		Once()
 
 	entityTypeMock := entitytypemock.NewEntityTypeServiceInterfaceMock(t)
+	expectNormalizeEntity(entityTypeMock)
 	entityTypeMock.On("GetEntityTypeByName", mock.Anything, mock.Anything, testUserType).
 		Return(&entitytype.EntityType{OUID: testOrgID}, (*serviceerror.ServiceError)(nil)).
 		Once()
@@ -924,6 +1545,7 @@ func TestUserService_UpdateUser_WithCredentials(t *testing.T) {
 	storeMock.On("IsEntityDeclarative", mock.Anything, mock.Anything).Return(false, nil).Maybe()
 	ouServiceMock := oumock.NewOrganizationUnitServiceInterfaceMock(t)
 	entityTypeMock := entitytypemock.NewEntityTypeServiceInterfaceMock(t)
+	expectNormalizeEntity(entityTypeMock)
 
 	// Mock GetUser pre-fetch for authz check
 	storeMock.On("GetEntity", mock.Anything, userID).
@@ -1092,6 +1714,7 @@ func TestUserService_UpdateUser_ErrorPaths(t *testing.T) {
 			storeMock.On("IsEntityDeclarative", mock.Anything, mock.Anything).Return(false, nil).Maybe()
 			ouServiceMock := oumock.NewOrganizationUnitServiceInterfaceMock(t)
 			entityTypeMock := entitytypemock.NewEntityTypeServiceInterfaceMock(t)
+			expectNormalizeEntity(entityTypeMock)
 			if tt.setupMocks != nil {
 				tt.setupMocks(storeMock, ouServiceMock, entityTypeMock)
 			}
@@ -1299,6 +1922,7 @@ func TestUserService_UpdateUser_AuthzBranches(t *testing.T) {
 			storeMock.On("IsEntityDeclarative", mock.Anything, mock.Anything).Return(false, nil).Maybe()
 			ouServiceMock := oumock.NewOrganizationUnitServiceInterfaceMock(t)
 			entityTypeMock := entitytypemock.NewEntityTypeServiceInterfaceMock(t)
+			expectNormalizeEntity(entityTypeMock)
 			authzMock := sysauthzmock.NewSystemAuthorizationServiceInterfaceMock(t)
 			// The existing user always lives in existingOU.
 			storeMock.On("GetEntity", mock.Anything, userID).
@@ -1379,6 +2003,7 @@ func TestUserService_UpdateUser_PreservesMultipleCredentials(t *testing.T) {
 	storeMock.On("IsEntityDeclarative", mock.Anything, mock.Anything).Return(false, nil).Maybe()
 	ouServiceMock := oumock.NewOrganizationUnitServiceInterfaceMock(t)
 	entityTypeMock := entitytypemock.NewEntityTypeServiceInterfaceMock(t)
+	expectNormalizeEntity(entityTypeMock)
 
 	// Mock GetUser pre-fetch for authz check
 	storeMock.On("GetEntity", mock.Anything, userID).
@@ -1480,6 +2105,50 @@ func TestUserService_GetUserList_ScopedByOUIDs(t *testing.T) {
 	require.Len(t, resp.Users, 1)
 }
 
+func TestUserService_GetUserList_ScopedByCallerApplication(t *testing.T) {
+	limit := 10
+	offset := 0
+	filters := map[string]interface{}{}
+	ctx := context.Background()
+	scopedCtx := mock.MatchedBy(func(c context.Context) bool { return c != ctx })
+
+	storeMock := entitymock.NewEntityServiceInterfaceMock(t)
+	storeMock.On("GetEntityListCount", scopedCtx, entitypkg.EntityCategoryUser, filters).Return(1, nil).Once()
+	storeMock.On("GetEntityList", scopedCtx, entitypkg.EntityCategoryUser, limit, offset, filters).
+		Return([]entitypkg.Entity{{ID: svcTestUserID1}}, nil).
+		Once()
+
+	appUserMock := appusermock.NewAppUserServiceInterfaceMock(t)
+	appUserMock.On("GetCallerApplicationScope", ctx).Return("app-1", nil).Once()
+
+	service := &userService{
+		entityService: storeMock,
+		authzService:  newAllowAllAuthz(t),
+	}
+	service.SetAppUserService(appUserMock)
+
+	resp, err := service.GetUserList(ctx, limit, offset, filters, false)
+	require.Nil(t, err)
+	require.Equal(t, 1, resp.TotalResults)
+	require.Len(t, resp.Users, 1)
+}
+
+func TestUserService_GetUserList_CallerApplicationScopeError(t *testing.T) {
+	appUserMock := appusermock.NewAppUserServiceInterfaceMock(t)
+	appUserMock.On("GetCallerApplicationScope", mock.Anything).
+		Return("", &serviceerror.InternalServerError).Once()
+
+	service := &userService{
+		entityService:  entitymock.NewEntityServiceInterfaceMock(t),
+		authzService:   sysauthzmock.NewSystemAuthorizationServiceInterfaceMock(t),
+		appUserService: appUserMock,
+	}
+
+	resp, err := service.GetUserList(context.Background(), 10, 0, map[string]interface{}{}, false)
+	require.Nil(t, resp)
+	require.Equal(t, &serviceerror.InternalServerError, err)
+}
+
 func TestUserService_GetUserList_EmptyOUIDs(t *testing.T) {
 	limit := 10
 	offset := 0
@@ -1633,10 +2302,10 @@ func TestUserService_CRUD_ErrorCases(t *testing.T) {
 		require.Equal(t, ErrorUserNotFound.Code, err.Code)
 	})
 
-	t.Run("CreateUser_MissingType", func(t *testing.T) {
+	t.Run("CreateUser_MissingTypeAndOU", func(t *testing.T) {
 		_, err := service.CreateUser(ctx, &User{ID: "u1"})
 		require.NotNil(t, err)
-		require.Equal(t, ErrorEntityTypeNotFound.Code, err.Code)
+		require.Equal(t, ErrorInvalidOUID.Code, err.Code)
 	})
 
 	t.Run("UpdateUser_MissingID", func(t *testing.T) {
@@ -1728,10 +2397,10 @@ func TestUserService_GetUsersByPath_WithIncludeDisplay_BatchFetchError(t *testin
 }
 
 func TestNewFunctions(t *testing.T) {
-	svc := newUserService(nil, nil, nil, nil)
+	svc := newUserService(nil, nil, nil, nil, nil, nil, nil, false)
 	require.NotNil(t, svc)
 
-	handler := newUserHandler(svc)
+	handler := newUserHandler(svc, nil)
 	require.NotNil(t, handler)
 }
 
@@ -1845,6 +2514,7 @@ func TestUserService_CreateUser_EntityErrors(t *testing.T) {
 				Return(true, (*serviceerror.ServiceError)(nil)).Once()
 
 			entityTypeMock := entitytypemock.NewEntityTypeServiceInterfaceMock(t)
+			expectNormalizeEntity(entityTypeMock)
 			entityTypeMock.On("GetEntityTypeByName", mock.Anything, mock.Anything, testUserType).
 				Return(&entitytype.EntityType{OUID: testOrgID}, (*serviceerror.ServiceError)(nil)).Once()
 
@@ -3025,6 +3695,75 @@ func TestDeleteUser_DeclarativeCheckError(t *testing.T) {
 	require.Equal(t, serviceerror.InternalServerError.Code, err.Code)
 }
 
+// TestDeleteUserCredential_Success tests that DeleteUserCredential removes the credential type.
+func TestDeleteUserCredential_Success(t *testing.T) {
+	userID := svcTestUserID1
+
+	storeMock := entitymock.NewEntityServiceInterfaceMock(t)
+	storeMock.On("GetEntity", mock.Anything, userID).
+		Return(&entitypkg.Entity{
+			Category: entitypkg.EntityCategoryUser, ID: userID, OUID: "ou1", Type: "employee",
+		}, nil).Once()
+	storeMock.On("IsEntityDeclarative", mock.Anything, userID).Return(false, nil).Once()
+	storeMock.On("DeleteCredentials", mock.Anything, userID, []string{"passkey"}).Return(nil).Once()
+
+	service := &userService{
+		entityService: storeMock,
+		authzService:  newAllowAllAuthz(t),
+	}
+
+	err := service.DeleteUserCredential(context.Background(), userID, "passkey")
+	require.Nil(t, err)
+}
+
+// TestDeleteUserCredential_PolicyViolation tests that DeleteUserCredential maps a credential policy
+// violation from the entity service.
+func TestDeleteUserCredential_PolicyViolation(t *testing.T) {
+	userID := svcTestUserID1
+
+	storeMock := entitymock.NewEntityServiceInterfaceMock(t)
+	storeMock.On("GetEntity", mock.Anything, userID).
+		Return(&entitypkg.Entity{
+			Category: entitypkg.EntityCategoryUser, ID: userID, OUID: "ou1", Type: "employee",
+		}, nil).Once()
+	storeMock.On("IsEntityDeclarative", mock.Anything, userID).Return(false, nil).Once()
+	storeMock.On("DeleteCredentials", mock.Anything, userID, []string{"passkey"}).
+		Return(entitypkg.ErrCredentialPolicyViolation).Once()
+
+	service := &userService{
+		entityService: storeMock,
+		authzService:  newAllowAllAuthz(t),
+	}
+
+	err := service.DeleteUserCredential(context.Background(), userID, "passkey")
+	require.NotNil(t, err)
+	require.Equal(t, ErrorCredentialPolicyViolation.Code, err.Code)
+}
+
+// TestDeleteUserCredential_NotFound tests that DeleteUserCredential returns ErrorCredentialNotFound when
+// the user does not hold the credential type.
+func TestDeleteUserCredential_NotFound(t *testing.T) {
+	userID := svcTestUserID1
+
+	storeMock := entitymock.NewEntityServiceInterfaceMock(t)
+	storeMock.On("GetEntity", mock.Anything, userID).
+		Return(&entitypkg.Entity{
+			Category: entitypkg.EntityCategoryUser, ID: userID, OUID: "ou1", Type: "employee",
+		}, nil).Once()
+	storeMock.On("IsEntityDeclarative", mock.Anything, userID).Return(false, nil).Once()
+	storeMock.On("DeleteCredentials", mock.Anything, userID, []string{"pin"}).
+		Return(entitypkg.ErrCredentialNotFound).Once()
+
+	service := &userService{
+		entityService: storeMock,
+		authzService:  newAllowAllAuthz(t),
+	}
+
+	err := service.DeleteUserCredential(context.Background(), userID, "pin")
+	require.NotNil(t, err)
+	require.Equal(t, ErrorCredentialNotFound.Code, err.Code)
+}
+
 // populateUserDisplayNames Tests
 
 func TestPopulateUserDisplayNames_Success(t *testing.T) {
@@ -3179,3 +3918,133 @@ func TestUserService_GetUserList_WithIncludeDisplay(t *testing.T) {
 	require.Equal(t, "Bob", resp.Users[1].Display)
 	require.Equal(t, "sales", resp.Users[1].OUHandle)
 }
+
+func TestValidateUserTypeSamples_DelegatesWithExistsCheckAndIndexedAttributes(t *testing.T) {
+	config.ResetServerRuntime()
+	err := config.InitializeServerRuntime("", &config.Config{
+		User: config.UserConfig{IndexedAttributes: []string{"email"}},
+	})
+	require.NoError(t, err)
+	t.Cleanup(config.ResetServerRuntime)
+
+	entityServiceMock := entitymock.NewEntityServiceInterfaceMock(t)
+	existingID := svcTestUserID1
+	entityServiceMock.On("IdentifyEntity", mock.Anything, map[string]interface{}{"email": "taken"}).
+		Return(&existingID, nil).Once()
+	entityServiceMock.On("IdentifyEntity", mock.Anything, map[string]interface{}{"email": "free"}).
+		Return(nil, entitypkg.ErrEntityNotFound).Once()
+	entityServiceMock.On("IdentifyEntity", mock.Anything, map[string]interface{}{"email": "dup"}).
+		Return(nil, entitypkg.ErrAmbiguousEntity).Once()
+	entityServiceMock.On("IdentifyEntity", mock.Anything, map[string]interface{}{"email": "broken"}).
+		Return(nil, errors.New("db error")).Once()
+
+	request := entitytype.SampleValidationRequest{OUID: testOrgID}
+	expected := &entitytype.SampleValidationResponse{Valid: true}
+	entityTypeMock := entitytypemock.NewEntityTypeServiceInterfaceMock(t)
+	entityTypeMock.On("ValidateSamples", mock.Anything, entitytype.TypeCategoryUser, request,
+		mock.Anything, []string{"email"}).
+		Run(func(args mock.Arguments) {
+			exists := args.Get(3).(func(map[string]interface{}) (bool, error))
+
+			found, err := exists(map[string]interface{}{"email": "taken"})
+			require.NoError(t, err)
+			require.True(t, found)
+
+			found, err = exists(map[string]interface{}{"email": "free"})
+			require.NoError(t, err)
+			require.False(t, found)
+
+			found, err = exists(map[string]interface{}{"email": "dup"})
+			require.NoError(t, err)
+			require.True(t, found)
+
+			_, err = exists(map[string]interface{}{"email": "broken"})
+			require.Error(t, err)
+		}).
+		Return(expected, nil).Once()
+
+	service := &userService{entityService: entityServiceMock, entityTypeService: entityTypeMock}
+
+	response, svcErr := service.ValidateUserTypeSamples(context.Background(), request)
+
+	require.Nil(t, svcErr)
+	require.Equal(t, expected, response)
+}
+
+// expectNormalizeEntity configures the entity type mock to return the attributes unchanged on normalization.
+func expectNormalizeEntity(entityTypeMock *entitytypemock.EntityTypeServiceInterfaceMock) {
+	entityTypeMock.EXPECT().NormalizeEntity(mock.Anything, entitytype.TypeCategoryUser, mock.Anything, mock.Anything).
+		RunAndReturn(func(_ context.Context, _ entitytype.TypeCategory, _ string,
+			attributes json.RawMessage) (json.RawMessage, *serviceerror.ServiceError) {
+			return attributes, nil
+		}).Maybe()
+}
+
+func expectRetainContactVerification(entityTypeMock *entitytypemock.EntityTypeServiceInterfaceMock) {
+	entityTypeMock.EXPECT().RetainContactVerification(mock.Anything, entitytype.TypeCategoryUser, mock.Anything,
+		mock.Anything, mock.Anything).
+		RunAndReturn(func(_ context.Context, _ entitytype.TypeCategory, _ string,
+			_, next json.RawMessage) (json.RawMessage, *serviceerror.ServiceError) {
+			return next, nil
+		}).Maybe()
+}
+
+func TestMapEntityError_QuotaExceeded(t *testing.T) {
+	err := fmt.Errorf("%w: the users quota of the tenant allows at most 10", quota.ErrQuotaExceeded)
+
+	require.Equal(t, &ErrorUserQuotaExceeded, mapEntityError(err))
+}
+
+func TestUserService_GetCredentialFormatReport(t *testing.T) {
+	sunset := time.Date(2026, 6, 30, 0, 0, 0, 0, time.UTC)
+	entityMock := entitymock.NewEntityServiceInterfaceMock(t)
+	entityMock.On("GetCredentialFormatCounts", mock.Anything, entitypkg.EntityCategoryUser).
+		Return([]entitypkg.CredentialFormatCount{
+			{Algorithm: hash.BCRYPT, Count: 4, Supported: true, SunsetDate: sunset, Blocked: true},
+			{Algorithm: hash.PBKDF2, Count: 6, Current: true, Supported: true},
+		}, nil).Once()
+
+	service := &userService{
+		entityService: entityMock,
+		authzService:  newAllowAllAuthz(t),
+	}
+
+	report, svcErr := service.GetCredentialFormatReport(context.Background())
+	require.Nil(t, svcErr)
+	require.Equal(t, &CredentialFormatReport{
+		TotalCredentials: 10,
+		Formats: []CredentialFormatSummary{
+			{Algorithm: "BCRYPT", Count: 4, Supported: true, SunsetDate: "2026-06-30T00:00:00Z", Blocked: true},
+			{Algorithm: "PBKDF2", Count: 6, Current: true, Supported: true},
+		},
+	}, report)
+}
+
+func TestUserService_GetCredentialFormatReport_NotAllowed(t *testing.T) {
+	authzMock := sysauthzmock.NewSystemAuthorizationServiceInterfaceMock(t)
+	authzMock.On("GetAccessibleResources", mock.Anything, security.ActionListUsers, security.ResourceTypeOU).
+		Return(&sysauthz.AccessibleResources{AllAllowed: false, IDs: []string{testOrgID}}, nil).Once()
+
+	service := &userService{
+		entityService: entitymock.NewEntityServiceInterfaceMock(t),
+		authzService:  authzMock,
+	}
+
+	report, svcErr := service.GetCredentialFormatReport(context.Background())
+	require.Nil(t, report)
+	require.Equal(t, serviceerror.ErrorUnauthorized.Code, svcErr.Code)
+}
+
+func TestUserService_GetCredentialFormatReport_EntityError(t *testing.T) {
+	entityMock := entitymock.NewEntityServiceInterfaceMock(t)
+	entityMock.On("GetCredentialFormatCounts", mock.Anything, entitypkg.EntityCategoryUser).
+		Return(nil, errors.New("store error")).Once()
+
+	service := &userService{
+		entityService: entityMock,
+		authzService:  newAllowAllAuthz(t),
+	}
+
+	_, svcErr := service.GetCredentialFormatReport(context.Background())
+	require.Equal(t, serviceerror.InternalServerError.Code, svcErr.Code)
+}
@@ -0,0 +1,41 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package userprovisioning
+
+const loggerComponentName = "UserProvisioningService"
+
+// maxAssignments is the maximum number of groups, and of roles, a user can be provisioned with.
+const maxAssignments = 100
+
+// templateDataKeyInviteLink is the template data key of the link in the invitation email.
+const templateDataKeyInviteLink = "inviteLink"
+
+// InvitationStatus is the outcome of sending the invitation email of a provisioned user.
+type InvitationStatus string
+
+// Invitation statuses.
+const (
+	// InvitationStatusNotRequested indicates that no invitation was requested.
+	InvitationStatusNotRequested InvitationStatus = "NOT_REQUESTED"
+	// InvitationStatusSent indicates that the invitation email was sent.
+	InvitationStatusSent InvitationStatus = "SENT"
+	// InvitationStatusFailed indicates that the user was provisioned but the invitation email could not be
+	// sent.
+	InvitationStatusFailed InvitationStatus = "FAILED"
+)
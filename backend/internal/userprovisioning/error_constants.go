@@ -0,0 +1,112 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package userprovisioning
+
+import (
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/i18n/core"
+)
+
+// Client errors for user provisioning operations.
+var (
+	// ErrorInvalidRequestFormat is the error returned when the request is malformed.
+	ErrorInvalidRequestFormat = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "USP-1001",
+		Error: core.I18nMessage{
+			Key:          "error.userprovisioningservice.invalid_request_format",
+			DefaultValue: "Invalid request format",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.userprovisioningservice.invalid_request_format_description",
+			DefaultValue: "The request body is malformed or contains invalid data",
+		},
+	}
+	// ErrorInvalidProvisioningRequest is the error returned when the groups or roles of a request are invalid.
+	ErrorInvalidProvisioningRequest = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "USP-1002",
+		Error: core.I18nMessage{
+			Key:          "error.userprovisioningservice.invalid_provisioning_request",
+			DefaultValue: "Invalid provisioning request",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.userprovisioningservice.invalid_provisioning_request_description",
+			DefaultValue: "The provisioning request is invalid",
+		},
+	}
+	// ErrorInvitationNotConfigured is the error returned when an invitation is requested but email is not
+	// configured.
+	ErrorInvitationNotConfigured = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "USP-1003",
+		Error: core.I18nMessage{
+			Key:          "error.userprovisioningservice.invitation_not_configured",
+			DefaultValue: "Invitation not configured",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.userprovisioningservice.invitation_not_configured_description",
+			DefaultValue: "Invitations cannot be sent because email is not configured",
+		},
+	}
+	// ErrorMissingInvitationEmail is the error returned when an invitation is requested for a user without an
+	// email address.
+	ErrorMissingInvitationEmail = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "USP-1004",
+		Error: core.I18nMessage{
+			Key:          "error.userprovisioningservice.missing_invitation_email",
+			DefaultValue: "Missing email address",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.userprovisioningservice.missing_invitation_email_description",
+			DefaultValue: "An invitation requires the user to have an email address",
+		},
+	}
+	// ErrorProvisioningFailed is the error returned when a step of the provisioning fails and every change
+	// made by the earlier steps is rolled back.
+	ErrorProvisioningFailed = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "USP-1005",
+		Error: core.I18nMessage{
+			Key:          "error.userprovisioningservice.provisioning_failed",
+			DefaultValue: "Provisioning failed",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.userprovisioningservice.provisioning_failed_description",
+			DefaultValue: "The user was not provisioned and no changes were made",
+		},
+	}
+)
+
+// invalidProvisioningRequestErr returns the invalid provisioning request error with the reason appended to
+// its description.
+func invalidProvisioningRequestErr(reason string) *serviceerror.ServiceError {
+	e := ErrorInvalidProvisioningRequest
+	e.ErrorDescription.DefaultValue += ": " + reason
+	return &e
+}
+
+// provisioningFailedErr returns the provisioning failed error with the failed step and its cause appended to
+// its description.
+func provisioningFailedErr(step string, cause *serviceerror.ServiceError) *serviceerror.ServiceError {
+	e := ErrorProvisioningFailed
+	e.ErrorDescription.DefaultValue += ": " + step + ": " + cause.ErrorDescription.DefaultValue
+	return &e
+}
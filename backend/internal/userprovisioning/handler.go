@@ -0,0 +1,63 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package userprovisioning
+
+import (
+	"net/http"
+
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	sysutils "github.com/thunder-id/thunderid/internal/system/utils"
+)
+
+// userProvisioningHandler is the handler for user provisioning operations.
+type userProvisioningHandler struct {
+	provisioningService UserProvisioningServiceInterface
+}
+
+// newUserProvisioningHandler creates a new instance of userProvisioningHandler.
+func newUserProvisioningHandler(provisioningService UserProvisioningServiceInterface) *userProvisioningHandler {
+	return &userProvisioningHandler{
+		provisioningService: provisioningService,
+	}
+}
+
+// HandleProvisionUserRequest handles the request to provision a user with its group memberships and role
+// assignments.
+func (h *userProvisioningHandler) HandleProvisionUserRequest(w http.ResponseWriter, r *http.Request) {
+	request, err := sysutils.DecodeJSONBody[ProvisionUserRequest](r)
+	if err != nil {
+		sysutils.WriteServiceErrorResponse(w, &ErrorInvalidRequestFormat, clientErrorStatusCodes)
+		return
+	}
+
+	response, svcErr := h.provisioningService.ProvisionUser(r.Context(), *request)
+	if svcErr != nil {
+		sysutils.WriteServiceErrorResponse(w, svcErr, clientErrorStatusCodes)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(w, http.StatusCreated, response)
+}
+
+// clientErrorStatusCodes maps the client errors of the handler to HTTP status codes. Other client errors are
+// returned with 400 Bad Request.
+var clientErrorStatusCodes = map[string]int{
+	ErrorInvitationNotConfigured.Code:   http.StatusConflict,
+	serviceerror.ErrorUnauthorized.Code: http.StatusForbidden,
+}
@@ -0,0 +1,117 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package userprovisioning
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/system/error/apierror"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/user"
+)
+
+type HandlerTestSuite struct {
+	suite.Suite
+	mockService *UserProvisioningServiceInterfaceMock
+	handler     *userProvisioningHandler
+}
+
+func TestHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(HandlerTestSuite))
+}
+
+func (s *HandlerTestSuite) SetupTest() {
+	s.mockService = NewUserProvisioningServiceInterfaceMock(s.T())
+	s.handler = newUserProvisioningHandler(s.mockService)
+}
+
+func (s *HandlerTestSuite) TestHandleProvisionUserRequest() {
+	s.mockService.On("ProvisionUser", mock.Anything, mock.MatchedBy(func(r ProvisionUserRequest) bool {
+		return r.OUID == "ou-1" && r.Type == "employee" && len(r.Groups) == 1 && r.Roles[0] == "role-1" &&
+			r.SendInvitation
+	})).Return(&ProvisionUserResponse{
+		User:       &user.User{ID: "user-1"},
+		Groups:     []string{"group-1"},
+		Roles:      []string{"role-1"},
+		Invitation: InvitationStatusSent,
+	}, nil)
+
+	req := httptest.NewRequest(http.MethodPost, "/provisioning/users", strings.NewReader(
+		`{"ouId":"ou-1","type":"employee","attributes":{"email":"alice@example.com"},`+
+			`"groups":["group-1"],"roles":["role-1"],"sendInvitation":true}`))
+	rr := httptest.NewRecorder()
+	s.handler.HandleProvisionUserRequest(rr, req)
+
+	s.Equal(http.StatusCreated, rr.Code)
+	var body ProvisionUserResponse
+	s.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &body))
+	s.Equal("user-1", body.User.ID)
+	s.Equal(InvitationStatusSent, body.Invitation)
+}
+
+func (s *HandlerTestSuite) TestHandleProvisionUserRequest_InvalidBody() {
+	req := httptest.NewRequest(http.MethodPost, "/provisioning/users", strings.NewReader(`{`))
+	rr := httptest.NewRecorder()
+	s.handler.HandleProvisionUserRequest(rr, req)
+
+	s.Equal(http.StatusBadRequest, rr.Code)
+	s.assertErrorCode(rr, ErrorInvalidRequestFormat.Code)
+}
+
+func (s *HandlerTestSuite) TestHandleProvisionUserRequest_ErrorStatusCodes() {
+	testCases := []struct {
+		name   string
+		err    *serviceerror.ServiceError
+		status int
+	}{
+		{"ProvisioningFailed", &ErrorProvisioningFailed, http.StatusBadRequest},
+		{"MissingInvitationEmail", &ErrorMissingInvitationEmail, http.StatusBadRequest},
+		{"InvitationNotConfigured", &ErrorInvitationNotConfigured, http.StatusConflict},
+		{"Unauthorized", &serviceerror.ErrorUnauthorized, http.StatusForbidden},
+		{"InternalError", &serviceerror.InternalServerError, http.StatusInternalServerError},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			s.mockService.On("ProvisionUser", mock.Anything, mock.Anything).Return(nil, tc.err)
+
+			req := httptest.NewRequest(http.MethodPost, "/provisioning/users",
+				strings.NewReader(`{"ouId":"ou-1","type":"employee"}`))
+			rr := httptest.NewRecorder()
+			s.handler.HandleProvisionUserRequest(rr, req)
+
+			s.Equal(tc.status, rr.Code)
+			s.assertErrorCode(rr, tc.err.Code)
+		})
+	}
+}
+
+func (s *HandlerTestSuite) assertErrorCode(rr *httptest.ResponseRecorder, code string) {
+	var body apierror.ErrorResponse
+	s.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &body))
+	s.Equal(code, body.Code)
+}
@@ -0,0 +1,91 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package userprovisioning
+
+import (
+	"net/http"
+	"net/url"
+
+	"github.com/thunder-id/thunderid/internal/group"
+	"github.com/thunder-id/thunderid/internal/role"
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/database/provider"
+	"github.com/thunder-id/thunderid/internal/system/email"
+	"github.com/thunder-id/thunderid/internal/system/middleware"
+	"github.com/thunder-id/thunderid/internal/system/template"
+	"github.com/thunder-id/thunderid/internal/user"
+)
+
+// Initialize initializes the user provisioning service and registers its routes.
+func Initialize(
+	mux *http.ServeMux,
+	dbProvider provider.DBProviderInterface,
+	userService user.UserServiceInterface,
+	groupService group.GroupServiceInterface,
+	roleAssignmentService role.RoleAssignmentServiceInterface,
+	templateService template.TemplateServiceInterface,
+	emailClient email.EmailClientInterface,
+) (UserProvisioningServiceInterface, error) {
+	userTransactioner, err := dbProvider.GetUserDBTransactioner()
+	if err != nil {
+		return nil, err
+	}
+	configTransactioner, err := dbProvider.GetConfigDBTransactioner()
+	if err != nil {
+		return nil, err
+	}
+
+	runtime := config.GetServerRuntime()
+	provisioningService := newUserProvisioningService(userService, groupService, roleAssignmentService,
+		userTransactioner, configTransactioner, templateService, emailClient,
+		runtime.Config.User.Provisioning.EmailAttribute, getInvitationURL(runtime))
+
+	provisioningHandler := newUserProvisioningHandler(provisioningService)
+	registerRoutes(mux, provisioningHandler)
+
+	return provisioningService, nil
+}
+
+// getInvitationURL returns the configured invitation URL, or the sign-in page of the gate client when none
+// is configured.
+func getInvitationURL(runtime *config.ServerRuntime) *url.URL {
+	if configured := runtime.Config.User.Provisioning.InvitationURL; configured != "" {
+		// The URL is validated when the configuration is loaded.
+		if parsed, err := url.Parse(configured); err == nil {
+			return parsed
+		}
+	}
+	return runtime.GateClientLoginURL
+}
+
+// registerRoutes registers the routes for user provisioning operations.
+func registerRoutes(mux *http.ServeMux, provisioningHandler *userProvisioningHandler) {
+	opts := middleware.CORSOptions{
+		AllowedMethods:   []string{"POST"},
+		AllowedHeaders:   middleware.DefaultAllowedHeaders,
+		AllowCredentials: true,
+		MaxAge:           600,
+	}
+	mux.HandleFunc(middleware.WithCORS("POST /provisioning/users",
+		provisioningHandler.HandleProvisionUserRequest, opts))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS /provisioning/users",
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, opts))
+}
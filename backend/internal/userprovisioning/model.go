@@ -0,0 +1,48 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package userprovisioning provides the provisioning of a user together with its group memberships and
+// role assignments as a single operation that either applies completely or not at all.
+package userprovisioning
+
+import (
+	"encoding/json"
+
+	"github.com/thunder-id/thunderid/internal/user"
+)
+
+// ProvisionUserRequest represents the request to provision a user.
+type ProvisionUserRequest struct {
+	OUID       string          `json:"ouId"`
+	Type       string          `json:"type"`
+	Attributes json.RawMessage `json:"attributes,omitempty"`
+	// Groups lists the IDs of the groups the user is added to.
+	Groups []string `json:"groups,omitempty"`
+	// Roles lists the IDs of the roles assigned to the user.
+	Roles []string `json:"roles,omitempty"`
+	// SendInvitation sends an invitation email to the user once the user is provisioned.
+	SendInvitation bool `json:"sendInvitation,omitempty"`
+}
+
+// ProvisionUserResponse represents the outcome of provisioning a user.
+type ProvisionUserResponse struct {
+	User       *user.User       `json:"user"`
+	Groups     []string         `json:"groups"`
+	Roles      []string         `json:"roles"`
+	Invitation InvitationStatus `json:"invitation"`
+}
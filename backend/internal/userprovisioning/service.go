@@ -0,0 +1,255 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package userprovisioning
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"html"
+	"net/url"
+	"slices"
+
+	"github.com/thunder-id/thunderid/internal/group"
+	"github.com/thunder-id/thunderid/internal/role"
+	"github.com/thunder-id/thunderid/internal/system/email"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/internal/system/template"
+	"github.com/thunder-id/thunderid/internal/system/transaction"
+	"github.com/thunder-id/thunderid/internal/user"
+)
+
+// errRollback is returned from a transaction to roll back the changes made in it.
+var errRollback = errors.New("rollback for failed provisioning step")
+
+// UserProvisioningServiceInterface defines the interface for the user provisioning service.
+type UserProvisioningServiceInterface interface {
+	ProvisionUser(ctx context.Context, request ProvisionUserRequest) (
+		*ProvisionUserResponse, *serviceerror.ServiceError)
+}
+
+// userProvisioningService is the default implementation of the UserProvisioningServiceInterface.
+type userProvisioningService struct {
+	userService           user.UserServiceInterface
+	groupService          group.GroupServiceInterface
+	roleAssignmentService role.RoleAssignmentServiceInterface
+	userTransactioner     transaction.Transactioner
+	configTransactioner   transaction.Transactioner
+	templateService       template.TemplateServiceInterface
+	emailClient           email.EmailClientInterface
+	emailAttribute        string
+	invitationURL         *url.URL
+	logger                *log.Logger
+}
+
+// newUserProvisioningService creates a new instance of userProvisioningService. Users and group memberships
+// are stored in the user database and role assignments in the config database, so each database is written
+// through its own transactioner. The email client may be nil when email is not configured, in which case
+// invitations cannot be sent.
+func newUserProvisioningService(
+	userService user.UserServiceInterface,
+	groupService group.GroupServiceInterface,
+	roleAssignmentService role.RoleAssignmentServiceInterface,
+	userTransactioner transaction.Transactioner,
+	configTransactioner transaction.Transactioner,
+	templateService template.TemplateServiceInterface,
+	emailClient email.EmailClientInterface,
+	emailAttribute string,
+	invitationURL *url.URL,
+) UserProvisioningServiceInterface {
+	return &userProvisioningService{
+		userService:           userService,
+		groupService:          groupService,
+		roleAssignmentService: roleAssignmentService,
+		userTransactioner:     userTransactioner,
+		configTransactioner:   configTransactioner,
+		templateService:       templateService,
+		emailClient:           emailClient,
+		emailAttribute:        emailAttribute,
+		invitationURL:         invitationURL,
+		logger:                log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName)),
+	}
+}
+
+// ProvisionUser creates a user, adds it to the requested groups and assigns it the requested roles. The steps
+// run in a transaction of the config database that encloses a transaction of the user database, so a failed
+// step rolls back the changes of every earlier step. The role assignments in the config database are committed
+// last, so that they are never kept for a user whose creation was rolled back. Caches are only updated once
+// both transactions have committed. When requested, an invitation email is sent once the
+// user is provisioned; a failure to send it is reported in the response and does not undo the provisioning.
+func (s *userProvisioningService) ProvisionUser(
+	ctx context.Context, request ProvisionUserRequest,
+) (*ProvisionUserResponse, *serviceerror.ServiceError) {
+	groupIDs, svcErr := validateIDs("group", request.Groups)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	roleIDs, svcErr := validateIDs("role", request.Roles)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+
+	recipient := ""
+	if request.SendInvitation {
+		if s.emailClient == nil || s.invitationURL == nil {
+			return nil, &ErrorInvitationNotConfigured
+		}
+		if recipient = s.getEmailAddress(request.Attributes); recipient == "" {
+			return nil, &ErrorMissingInvitationEmail
+		}
+	}
+
+	// Credentials are hashed with the shared batch hashing pool before the transactions are opened, so that
+	// hashing neither holds the transactions open nor competes with interactive sign-ins.
+	newUser := &user.User{OUID: request.OUID, Type: request.Type, Attributes: request.Attributes}
+	hashedCtx, svcErr := s.userService.PrehashCredentials(ctx, []*user.User{newUser})
+	if svcErr != nil {
+		return nil, svcErr
+	}
+
+	var created *user.User
+	var stepErr *serviceerror.ServiceError
+	err := s.configTransactioner.Transact(hashedCtx, func(configTxCtx context.Context) error {
+		return s.userTransactioner.Transact(configTxCtx, func(txCtx context.Context) error {
+			created, stepErr = s.provision(txCtx, newUser, groupIDs, roleIDs)
+			if stepErr != nil {
+				return errRollback
+			}
+			return nil
+		})
+	})
+	if err != nil {
+		if stepErr != nil {
+			return nil, stepErr
+		}
+		s.logger.Error("Failed to provision user", log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+
+	response := &ProvisionUserResponse{
+		User:       created,
+		Groups:     groupIDs,
+		Roles:      roleIDs,
+		Invitation: InvitationStatusNotRequested,
+	}
+	if request.SendInvitation {
+		response.Invitation = s.sendInvitation(ctx, recipient)
+	}
+
+	s.logger.Debug("Successfully provisioned user", log.MaskedString(log.LoggerKeyUserID, created.ID),
+		log.Int("groups", len(groupIDs)), log.Int("roles", len(roleIDs)),
+		log.String("invitation", string(response.Invitation)))
+	return response, nil
+}
+
+// provision runs the provisioning steps in the transactions carried by the context and returns the error of
+// the first step that fails.
+func (s *userProvisioningService) provision(
+	ctx context.Context, newUser *user.User, groupIDs, roleIDs []string,
+) (*user.User, *serviceerror.ServiceError) {
+	created, svcErr := s.userService.CreateUser(ctx, newUser)
+	if svcErr != nil {
+		return nil, s.stepErr("failed to create the user", svcErr)
+	}
+
+	for _, groupID := range groupIDs {
+		if _, svcErr := s.groupService.AddGroupMembers(ctx, groupID,
+			[]group.Member{{ID: created.ID, Type: group.MemberTypeUser}}); svcErr != nil {
+			return nil, s.stepErr(fmt.Sprintf("failed to add the user to group '%s'", groupID), svcErr)
+		}
+	}
+
+	for _, roleID := range roleIDs {
+		if svcErr := s.roleAssignmentService.AddAssignments(ctx, roleID,
+			[]role.RoleAssignment{{ID: created.ID, Type: role.AssigneeTypeUser}}); svcErr != nil {
+			return nil, s.stepErr(fmt.Sprintf("failed to assign role '%s' to the user", roleID), svcErr)
+		}
+	}
+
+	return created, nil
+}
+
+// stepErr returns the error of a failed provisioning step. Authorization and server errors are returned as
+// they are, while other client errors are reported as a failed provisioning naming the step.
+func (s *userProvisioningService) stepErr(step string, svcErr *serviceerror.ServiceError) *serviceerror.ServiceError {
+	if svcErr.Code == serviceerror.ErrorUnauthorized.Code {
+		return svcErr
+	}
+	if svcErr.Type != serviceerror.ClientErrorType {
+		s.logger.Error("Provisioning step failed", log.String("step", step), log.String("error", svcErr.Code))
+		return &serviceerror.InternalServerError
+	}
+	return provisioningFailedErr(step, svcErr)
+}
+
+// getEmailAddress returns the email address in the attributes of a user, or an empty string when it has none.
+func (s *userProvisioningService) getEmailAddress(attributes json.RawMessage) string {
+	if s.emailAttribute == "" || len(attributes) == 0 {
+		return ""
+	}
+	var values map[string]interface{}
+	if err := json.Unmarshal(attributes, &values); err != nil {
+		return ""
+	}
+	address, _ := values[s.emailAttribute].(string)
+	return address
+}
+
+// sendInvitation sends the invitation email of a provisioned user and returns the outcome.
+func (s *userProvisioningService) sendInvitation(ctx context.Context, recipient string) InvitationStatus {
+	rendered, svcErr := s.templateService.Render(ctx, template.ScenarioUserInvite, template.TemplateTypeEmail,
+		template.TemplateData{
+			templateDataKeyInviteLink: html.EscapeString(s.invitationURL.String()),
+		})
+	if svcErr != nil {
+		s.logger.Error("Failed to render the invitation email", log.String("error", svcErr.Code))
+		return InvitationStatusFailed
+	}
+
+	if err := s.emailClient.Send(email.EmailData{
+		To:      []string{recipient},
+		Subject: rendered.Subject,
+		Body:    rendered.Body,
+		IsHTML:  rendered.IsHTML,
+	}); err != nil {
+		s.logger.Error("Failed to send the invitation email", log.Error(err))
+		return InvitationStatusFailed
+	}
+	return InvitationStatusSent
+}
+
+// validateIDs validates the IDs of the groups or roles of a request and returns them without duplicates.
+func validateIDs(kind string, ids []string) ([]string, *serviceerror.ServiceError) {
+	if len(ids) > maxAssignments {
+		return nil, invalidProvisioningRequestErr(fmt.Sprintf("a user can be provisioned with at most %d %ss",
+			maxAssignments, kind))
+	}
+	unique := make([]string, 0, len(ids))
+	for _, id := range ids {
+		if id == "" {
+			return nil, invalidProvisioningRequestErr(fmt.Sprintf("%s ID must not be empty", kind))
+		}
+		if !slices.Contains(unique, id) {
+			unique = append(unique, id)
+		}
+	}
+	return unique, nil
+}
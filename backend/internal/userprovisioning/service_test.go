@@ -0,0 +1,345 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package userprovisioning
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/group"
+	"github.com/thunder-id/thunderid/internal/role"
+	"github.com/thunder-id/thunderid/internal/system/email"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/i18n/core"
+	"github.com/thunder-id/thunderid/internal/system/template"
+	"github.com/thunder-id/thunderid/internal/user"
+	"github.com/thunder-id/thunderid/tests/mocks/emailmock"
+	"github.com/thunder-id/thunderid/tests/mocks/groupmock"
+	"github.com/thunder-id/thunderid/tests/mocks/rolemock"
+	"github.com/thunder-id/thunderid/tests/mocks/templatemock"
+	"github.com/thunder-id/thunderid/tests/mocks/usermock"
+)
+
+const testUserID = "user-1"
+
+// prehashedContextKey marks the context returned by the mocked PrehashCredentials.
+type prehashedContextKey struct{}
+
+// recordingTransactioner runs the function in the given context and records the error it returned, and the
+// order in which the transactions committed.
+type recordingTransactioner struct {
+	name    string
+	commits *[]string
+	calls   int
+	err     error
+}
+
+func (t *recordingTransactioner) Transact(ctx context.Context, txFunc func(context.Context) error) error {
+	t.calls++
+	t.err = txFunc(ctx)
+	if t.err == nil {
+		*t.commits = append(*t.commits, t.name)
+	}
+	return t.err
+}
+
+type UserProvisioningServiceTestSuite struct {
+	suite.Suite
+	mockUserService       *usermock.UserServiceInterfaceMock
+	mockGroupService      *groupmock.GroupServiceInterfaceMock
+	mockAssignmentService *rolemock.RoleAssignmentServiceInterfaceMock
+	mockTemplateService   *templatemock.TemplateServiceInterfaceMock
+	mockEmailClient       *emailmock.EmailClientInterfaceMock
+	userTransactioner     *recordingTransactioner
+	configTransactioner   *recordingTransactioner
+	commits               []string
+}
+
+func TestUserProvisioningServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(UserProvisioningServiceTestSuite))
+}
+
+func (suite *UserProvisioningServiceTestSuite) SetupTest() {
+	suite.mockUserService = usermock.NewUserServiceInterfaceMock(suite.T())
+	suite.mockGroupService = groupmock.NewGroupServiceInterfaceMock(suite.T())
+	suite.mockAssignmentService = rolemock.NewRoleAssignmentServiceInterfaceMock(suite.T())
+	suite.mockTemplateService = templatemock.NewTemplateServiceInterfaceMock(suite.T())
+	suite.mockEmailClient = emailmock.NewEmailClientInterfaceMock(suite.T())
+	suite.commits = nil
+	suite.userTransactioner = &recordingTransactioner{name: "user", commits: &suite.commits}
+	suite.configTransactioner = &recordingTransactioner{name: "config", commits: &suite.commits}
+	suite.mockUserService.On("PrehashCredentials", mock.Anything, mock.Anything).
+		Return(func(ctx context.Context, _ []*user.User) (context.Context, *serviceerror.ServiceError) {
+			return context.WithValue(ctx, prehashedContextKey{}, true), nil
+		}).Maybe()
+}
+
+func (suite *UserProvisioningServiceTestSuite) newService(
+	emailClient email.EmailClientInterface,
+) *userProvisioningService {
+	invitationURL, _ := url.Parse("https://localhost:5190/gate/signin")
+	return newUserProvisioningService(suite.mockUserService, suite.mockGroupService, suite.mockAssignmentService,
+		suite.userTransactioner, suite.configTransactioner, suite.mockTemplateService, emailClient, "email",
+		invitationURL).(*userProvisioningService)
+}
+
+func testRequest() ProvisionUserRequest {
+	return ProvisionUserRequest{
+		OUID:       "ou-1",
+		Type:       "employee",
+		Attributes: json.RawMessage(`{"username":"alice","email":"alice@example.com"}`),
+		Groups:     []string{"group-1", "group-2", "group-1"},
+		Roles:      []string{"role-1"},
+	}
+}
+
+func (suite *UserProvisioningServiceTestSuite) expectCreateUser() {
+	suite.mockUserService.On("CreateUser", mock.Anything, mock.MatchedBy(func(u *user.User) bool {
+		return u.OUID == "ou-1" && u.Type == "employee"
+	})).Return(&user.User{ID: testUserID, OUID: "ou-1", Type: "employee"}, nil).Once()
+}
+
+func (suite *UserProvisioningServiceTestSuite) expectAddGroupMember(groupID string, svcErr *serviceerror.ServiceError) {
+	var result *group.Group
+	if svcErr == nil {
+		result = &group.Group{ID: groupID}
+	}
+	suite.mockGroupService.On("AddGroupMembers", mock.Anything, groupID,
+		[]group.Member{{ID: testUserID, Type: group.MemberTypeUser}}).Return(result, svcErr).Once()
+}
+
+func (suite *UserProvisioningServiceTestSuite) TestProvisionUser() {
+	suite.expectCreateUser()
+	suite.expectAddGroupMember("group-1", nil)
+	suite.expectAddGroupMember("group-2", nil)
+	suite.mockAssignmentService.On("AddAssignments", mock.Anything, "role-1",
+		[]role.RoleAssignment{{ID: testUserID, Type: role.AssigneeTypeUser}}).Return(nil).Once()
+
+	response, svcErr := suite.newService(nil).ProvisionUser(suite.T().Context(), testRequest())
+
+	suite.Require().Nil(svcErr)
+	suite.Equal(testUserID, response.User.ID)
+	suite.Equal([]string{"group-1", "group-2"}, response.Groups)
+	suite.Equal([]string{"role-1"}, response.Roles)
+	suite.Equal(InvitationStatusNotRequested, response.Invitation)
+	suite.Equal(1, suite.userTransactioner.calls)
+	suite.Equal(1, suite.configTransactioner.calls)
+	suite.NoError(suite.userTransactioner.err)
+	// Role assignments are committed only after the user they are assigned to.
+	suite.Equal([]string{"user", "config"}, suite.commits)
+}
+
+func (suite *UserProvisioningServiceTestSuite) TestProvisionUser_PrehashesBeforeTransactions() {
+	request := testRequest()
+	request.Groups, request.Roles = nil, nil
+	suite.mockUserService.On("CreateUser", mock.MatchedBy(func(ctx context.Context) bool {
+		return ctx.Value(prehashedContextKey{}) == true
+	}), mock.Anything).Return(&user.User{ID: testUserID}, nil).Once()
+
+	_, svcErr := suite.newService(nil).ProvisionUser(suite.T().Context(), request)
+
+	suite.Require().Nil(svcErr)
+	suite.mockUserService.AssertCalled(suite.T(), "PrehashCredentials", mock.Anything,
+		mock.MatchedBy(func(users []*user.User) bool {
+			return len(users) == 1 && users[0].Type == "employee" && users[0].OUID == "ou-1"
+		}))
+}
+
+func (suite *UserProvisioningServiceTestSuite) TestProvisionUser_PrehashFailure() {
+	suite.mockUserService.ExpectedCalls = nil
+	suite.mockUserService.On("PrehashCredentials", mock.Anything, mock.Anything).
+		Return(nil, &serviceerror.InternalServerError).Once()
+
+	response, svcErr := suite.newService(nil).ProvisionUser(suite.T().Context(), testRequest())
+
+	suite.Nil(response)
+	suite.Equal(&serviceerror.InternalServerError, svcErr)
+	suite.Equal(0, suite.configTransactioner.calls)
+	suite.Equal(0, suite.userTransactioner.calls)
+}
+
+func (suite *UserProvisioningServiceTestSuite) TestProvisionUser_GroupFailureRollsBack() {
+	suite.expectCreateUser()
+	suite.expectAddGroupMember("group-1", nil)
+	suite.expectAddGroupMember("group-2", &group.ErrorGroupNotFound)
+
+	response, svcErr := suite.newService(nil).ProvisionUser(suite.T().Context(), testRequest())
+
+	suite.Nil(response)
+	suite.Require().NotNil(svcErr)
+	suite.Equal(ErrorProvisioningFailed.Code, svcErr.Code)
+	suite.Contains(svcErr.ErrorDescription.DefaultValue, "group 'group-2'")
+	suite.ErrorIs(suite.configTransactioner.err, errRollback)
+	suite.ErrorIs(suite.userTransactioner.err, errRollback)
+	suite.mockAssignmentService.AssertNotCalled(suite.T(), "AddAssignments", mock.Anything, mock.Anything,
+		mock.Anything)
+}
+
+func (suite *UserProvisioningServiceTestSuite) TestProvisionUser_RoleFailureRollsBack() {
+	suite.expectCreateUser()
+	suite.expectAddGroupMember("group-1", nil)
+	suite.expectAddGroupMember("group-2", nil)
+	suite.mockAssignmentService.On("AddAssignments", mock.Anything, "role-1", mock.Anything).
+		Return(&role.ErrorRoleNotFound).Once()
+
+	response, svcErr := suite.newService(nil).ProvisionUser(suite.T().Context(), testRequest())
+
+	suite.Nil(response)
+	suite.Require().NotNil(svcErr)
+	suite.Equal(ErrorProvisioningFailed.Code, svcErr.Code)
+	suite.Contains(svcErr.ErrorDescription.DefaultValue, "role 'role-1'")
+	suite.ErrorIs(suite.userTransactioner.err, errRollback)
+}
+
+func (suite *UserProvisioningServiceTestSuite) TestProvisionUser_UserFailure() {
+	tests := []struct {
+		name         string
+		svcErr       *serviceerror.ServiceError
+		expectedCode string
+	}{
+		{
+			name: "client error",
+			svcErr: &serviceerror.ServiceError{Type: serviceerror.ClientErrorType, Code: "USR-1014",
+				ErrorDescription: core.I18nMessage{DefaultValue: "Attribute conflict"}},
+			expectedCode: ErrorProvisioningFailed.Code,
+		},
+		{name: "unauthorized", svcErr: &serviceerror.ErrorUnauthorized,
+			expectedCode: serviceerror.ErrorUnauthorized.Code},
+		{name: "server error", svcErr: &serviceerror.InternalServerError,
+			expectedCode: serviceerror.InternalServerError.Code},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			suite.mockUserService.On("CreateUser", mock.Anything, mock.Anything).Return(nil, tc.svcErr).Once()
+
+			response, svcErr := suite.newService(nil).ProvisionUser(suite.T().Context(), testRequest())
+
+			suite.Nil(response)
+			suite.Require().NotNil(svcErr)
+			suite.Equal(tc.expectedCode, svcErr.Code)
+			suite.ErrorIs(suite.userTransactioner.err, errRollback)
+		})
+	}
+}
+
+func (suite *UserProvisioningServiceTestSuite) TestProvisionUser_TransactionError() {
+	transactioner := &failingTransactioner{err: errors.New("begin failed")}
+	service := newUserProvisioningService(suite.mockUserService, suite.mockGroupService,
+		suite.mockAssignmentService, transactioner, suite.configTransactioner, suite.mockTemplateService, nil,
+		"email", nil)
+
+	response, svcErr := service.ProvisionUser(suite.T().Context(), testRequest())
+
+	suite.Nil(response)
+	suite.Require().NotNil(svcErr)
+	suite.Equal(serviceerror.InternalServerError.Code, svcErr.Code)
+}
+
+func (suite *UserProvisioningServiceTestSuite) TestProvisionUser_InvalidRequest() {
+	tooMany := make([]string, maxAssignments+1)
+	for i := range tooMany {
+		tooMany[i] = "group"
+	}
+
+	tests := []struct {
+		name    string
+		request ProvisionUserRequest
+	}{
+		{name: "empty group ID", request: ProvisionUserRequest{Groups: []string{""}}},
+		{name: "empty role ID", request: ProvisionUserRequest{Roles: []string{"role-1", ""}}},
+		{name: "too many groups", request: ProvisionUserRequest{Groups: tooMany}},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			response, svcErr := suite.newService(nil).ProvisionUser(suite.T().Context(), tc.request)
+
+			suite.Nil(response)
+			suite.Require().NotNil(svcErr)
+			suite.Equal(ErrorInvalidProvisioningRequest.Code, svcErr.Code)
+		})
+	}
+	suite.Zero(suite.userTransactioner.calls)
+}
+
+func (suite *UserProvisioningServiceTestSuite) TestProvisionUser_SendsInvitation() {
+	suite.expectCreateUser()
+	suite.mockTemplateService.On("Render", mock.Anything, template.ScenarioUserInvite, template.TemplateTypeEmail,
+		template.TemplateData{templateDataKeyInviteLink: "https://localhost:5190/gate/signin"}).
+		Return(&template.RenderedTemplate{Subject: "Invite", Body: "<p>Welcome</p>", IsHTML: true}, nil).Once()
+	suite.mockEmailClient.On("Send", email.EmailData{
+		To: []string{"alice@example.com"}, Subject: "Invite", Body: "<p>Welcome</p>", IsHTML: true,
+	}).Return(nil).Once()
+
+	request := testRequest()
+	request.Groups, request.Roles, request.SendInvitation = nil, nil, true
+	response, svcErr := suite.newService(suite.mockEmailClient).ProvisionUser(suite.T().Context(), request)
+
+	suite.Require().Nil(svcErr)
+	suite.Equal(InvitationStatusSent, response.Invitation)
+}
+
+func (suite *UserProvisioningServiceTestSuite) TestProvisionUser_InvitationFailureKeepsUser() {
+	suite.expectCreateUser()
+	suite.mockTemplateService.On("Render", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(&template.RenderedTemplate{Subject: "Invite", Body: "<p>Welcome</p>", IsHTML: true}, nil).Once()
+	suite.mockEmailClient.On("Send", mock.Anything).Return(errors.New("smtp unavailable")).Once()
+
+	request := testRequest()
+	request.Groups, request.Roles, request.SendInvitation = nil, nil, true
+	response, svcErr := suite.newService(suite.mockEmailClient).ProvisionUser(suite.T().Context(), request)
+
+	suite.Require().Nil(svcErr)
+	suite.Equal(testUserID, response.User.ID)
+	suite.Equal(InvitationStatusFailed, response.Invitation)
+	suite.NoError(suite.userTransactioner.err)
+}
+
+func (suite *UserProvisioningServiceTestSuite) TestProvisionUser_InvitationPreconditions() {
+	request := testRequest()
+	request.SendInvitation = true
+
+	_, svcErr := suite.newService(nil).ProvisionUser(suite.T().Context(), request)
+	suite.Require().NotNil(svcErr)
+	suite.Equal(ErrorInvitationNotConfigured.Code, svcErr.Code)
+
+	request.Attributes = json.RawMessage(`{"username":"alice"}`)
+	_, svcErr = suite.newService(suite.mockEmailClient).ProvisionUser(suite.T().Context(), request)
+	suite.Require().NotNil(svcErr)
+	suite.Equal(ErrorMissingInvitationEmail.Code, svcErr.Code)
+
+	suite.Zero(suite.userTransactioner.calls)
+}
+
+// failingTransactioner fails to begin a transaction.
+type failingTransactioner struct {
+	err error
+}
+
+func (t *failingTransactioner) Transact(_ context.Context, _ func(context.Context) error) error {
+	return t.err
+}
@@ -0,0 +1,97 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package userpurge permanently deletes the soft-deleted users whose retention period has expired.
+package userpurge
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/internal/system/scheduler"
+	"github.com/thunder-id/thunderid/internal/system/security"
+	"github.com/thunder-id/thunderid/internal/user"
+)
+
+// UserPurgeServiceInterface defines the operations of the purge of expired soft-deleted users.
+type UserPurgeServiceInterface interface {
+	PurgeExpired(ctx context.Context) (int, error)
+}
+
+// userPurgeService is the default implementation of UserPurgeServiceInterface.
+type userPurgeService struct {
+	store       deletedUserStoreInterface
+	userService user.UserServiceInterface
+	retention   time.Duration
+	logger      *log.Logger
+}
+
+// newUserPurgeService creates a new instance of userPurgeService.
+func newUserPurgeService(
+	store deletedUserStoreInterface, userService user.UserServiceInterface, retention time.Duration,
+) UserPurgeServiceInterface {
+	return &userPurgeService{
+		store:       store,
+		userService: userService,
+		retention:   retention,
+		logger:      log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName)),
+	}
+}
+
+// PurgeExpired permanently deletes the users that were deleted before the retention period and returns the
+// number of users purged.
+func (s *userPurgeService) PurgeExpired(ctx context.Context) (int, error) {
+	ctx = security.WithRuntimeContext(ctx)
+	deletedBefore := time.Now().UTC().Add(-s.retention)
+
+	purged := 0
+	for {
+		ids, err := s.store.ListExpiredDeletedUsers(ctx, deletedBefore, purgeBatchSize)
+		if err != nil {
+			return purged, fmt.Errorf("failed to list expired deleted users: %w", err)
+		}
+
+		for _, id := range ids {
+			if svcErr := s.userService.PurgeUser(ctx, id); svcErr != nil &&
+				svcErr.Code != user.ErrorUserNotFound.Code {
+				return purged, fmt.Errorf("failed to purge expired deleted user: %s",
+					svcErr.ErrorDescription.DefaultValue)
+			}
+			s.logger.Debug("Purged expired deleted user", log.MaskedString(log.LoggerKeyUserID, id))
+			purged++
+		}
+
+		if len(ids) < purgeBatchSize {
+			return purged, nil
+		}
+	}
+}
+
+// newScheduledPurge returns the scheduled job that purges the soft-deleted users whose retention period has expired.
+func newScheduledPurge(service UserPurgeServiceInterface) scheduler.JobFunc {
+	return func(ctx context.Context) error {
+		purged, err := service.PurgeExpired(ctx)
+		if purged > 0 {
+			log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName)).Info(
+				"Purged expired deleted users", log.Int("count", purged))
+		}
+		return err
+	}
+}
@@ -0,0 +1,142 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package userpurge
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	sysContext "github.com/thunder-id/thunderid/internal/system/context"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/security"
+	"github.com/thunder-id/thunderid/internal/user"
+	"github.com/thunder-id/thunderid/tests/mocks/usermock"
+)
+
+const testRetention = 30 * 24 * time.Hour
+
+type UserPurgeServiceTestSuite struct {
+	suite.Suite
+	mockStore       *deletedUserStoreInterfaceMock
+	mockUserService *usermock.UserServiceInterfaceMock
+	service         UserPurgeServiceInterface
+}
+
+func TestUserPurgeServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(UserPurgeServiceTestSuite))
+}
+
+func (suite *UserPurgeServiceTestSuite) SetupTest() {
+	suite.mockStore = newDeletedUserStoreInterfaceMock(suite.T())
+	suite.mockUserService = usermock.NewUserServiceInterfaceMock(suite.T())
+	suite.service = newUserPurgeService(suite.mockStore, suite.mockUserService, testRetention)
+}
+
+func (suite *UserPurgeServiceTestSuite) TestPurgeExpired_PurgesExpiredUsers() {
+	before := time.Now().UTC().Add(-testRetention)
+	suite.mockStore.On("ListExpiredDeletedUsers", mock.Anything,
+		mock.MatchedBy(func(deletedBefore time.Time) bool {
+			return !deletedBefore.Before(before) && deletedBefore.Before(before.Add(time.Minute))
+		}), purgeBatchSize).
+		Return([]string{"user-1", "user-2"}, nil).Once()
+	runtimeCtx := mock.MatchedBy(func(ctx context.Context) bool { return security.IsRuntimeContext(ctx) })
+	suite.mockUserService.On("PurgeUser", runtimeCtx, "user-1").Return(nil).Once()
+	suite.mockUserService.On("PurgeUser", runtimeCtx, "user-2").Return(nil).Once()
+
+	purged, err := suite.service.PurgeExpired(context.Background())
+
+	suite.NoError(err)
+	suite.Equal(2, purged)
+}
+
+func (suite *UserPurgeServiceTestSuite) TestPurgeExpired_ContinuesWithNextBatch() {
+	fullBatch := make([]string, purgeBatchSize)
+	for i := range fullBatch {
+		fullBatch[i] = fmt.Sprintf("user-%d", i)
+	}
+	suite.mockStore.On("ListExpiredDeletedUsers", mock.Anything, mock.Anything, purgeBatchSize).
+		Return(fullBatch, nil).Once()
+	suite.mockStore.On("ListExpiredDeletedUsers", mock.Anything, mock.Anything, purgeBatchSize).
+		Return([]string{}, nil).Once()
+	suite.mockUserService.On("PurgeUser", mock.Anything, mock.Anything).Return(nil).Times(purgeBatchSize)
+
+	purged, err := suite.service.PurgeExpired(context.Background())
+
+	suite.NoError(err)
+	suite.Equal(purgeBatchSize, purged)
+}
+
+func (suite *UserPurgeServiceTestSuite) TestPurgeExpired_IgnoresUsersAlreadyPurged() {
+	suite.mockStore.On("ListExpiredDeletedUsers", mock.Anything, mock.Anything, purgeBatchSize).
+		Return([]string{"user-1"}, nil).Once()
+	suite.mockUserService.On("PurgeUser", mock.Anything, "user-1").Return(&user.ErrorUserNotFound).Once()
+
+	purged, err := suite.service.PurgeExpired(context.Background())
+
+	suite.NoError(err)
+	suite.Equal(1, purged)
+}
+
+func (suite *UserPurgeServiceTestSuite) TestPurgeExpired_StoreError() {
+	suite.mockStore.On("ListExpiredDeletedUsers", mock.Anything, mock.Anything, purgeBatchSize).
+		Return(nil, fmt.Errorf("db error")).Once()
+
+	purged, err := suite.service.PurgeExpired(context.Background())
+
+	suite.ErrorContains(err, "failed to list expired deleted users")
+	suite.Equal(0, purged)
+}
+
+func (suite *UserPurgeServiceTestSuite) TestPurgeExpired_PurgeError() {
+	suite.mockStore.On("ListExpiredDeletedUsers", mock.Anything, mock.Anything, purgeBatchSize).
+		Return([]string{"user-1", "user-2"}, nil).Once()
+	suite.mockUserService.On("PurgeUser", mock.Anything, "user-1").Return(nil).Once()
+	suite.mockUserService.On("PurgeUser", mock.Anything, "user-2").
+		Return(&serviceerror.InternalServerError).Once()
+
+	purged, err := suite.service.PurgeExpired(context.Background())
+
+	suite.ErrorContains(err, "failed to purge expired deleted user")
+	suite.Equal(1, purged)
+}
+
+func (suite *UserPurgeServiceTestSuite) TestScheduledPurge_PurgesInTheContextPartition() {
+	ctx := sysContext.WithTenantID(context.Background(), "tenant-1")
+	mockService := NewUserPurgeServiceInterfaceMock(suite.T())
+	mockService.On("PurgeExpired", ctx).Return(3, nil).Once()
+
+	err := newScheduledPurge(mockService)(ctx)
+
+	suite.NoError(err)
+}
+
+func (suite *UserPurgeServiceTestSuite) TestScheduledPurge_ReturnsPurgeError() {
+	mockService := NewUserPurgeServiceInterfaceMock(suite.T())
+	mockService.On("PurgeExpired", mock.Anything).Return(1, errors.New("db error")).Once()
+
+	err := newScheduledPurge(mockService)(context.Background())
+
+	suite.EqualError(err, "db error")
+}
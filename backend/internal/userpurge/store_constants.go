@@ -0,0 +1,32 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package userpurge
+
+import (
+	"github.com/thunder-id/thunderid/internal/entity"
+	dbmodel "github.com/thunder-id/thunderid/internal/system/database/model"
+)
+
+// queryListExpiredDeletedUsers lists the users that were soft-deleted longest ago, before the given time.
+var queryListExpiredDeletedUsers = dbmodel.DBQuery{
+	ID: "UPQ-UP_MGT-01",
+	Query: `SELECT ID FROM "ENTITY" WHERE CATEGORY = '` + string(entity.EntityCategoryUser) + `' ` +
+		`AND STATE = '` + string(entity.EntityStateDeleted) + `' ` +
+		`AND UPDATED_AT < $1 AND DEPLOYMENT_ID = $2 ORDER BY UPDATED_AT LIMIT $3`,
+}
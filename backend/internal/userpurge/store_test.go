@@ -0,0 +1,89 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package userpurge
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/tests/mocks/database/providermock"
+)
+
+const testDeploymentID = "test-deployment-id"
+
+type DeletedUserStoreTestSuite struct {
+	suite.Suite
+	mockDBProvider *providermock.DBProviderInterfaceMock
+	mockDBClient   *providermock.DBClientInterfaceMock
+	store          *deletedUserStore
+	ctx            context.Context
+}
+
+func TestDeletedUserStoreTestSuite(t *testing.T) {
+	suite.Run(t, new(DeletedUserStoreTestSuite))
+}
+
+func (suite *DeletedUserStoreTestSuite) SetupTest() {
+	suite.mockDBProvider = providermock.NewDBProviderInterfaceMock(suite.T())
+	suite.mockDBClient = providermock.NewDBClientInterfaceMock(suite.T())
+	suite.store = &deletedUserStore{
+		dbProvider:   suite.mockDBProvider,
+		deploymentID: testDeploymentID,
+	}
+	suite.ctx = context.Background()
+}
+
+func (suite *DeletedUserStoreTestSuite) TestListExpiredDeletedUsers() {
+	deletedBefore := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	suite.mockDBProvider.On("GetUserDBClient").Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.On("QueryContext", suite.ctx, queryListExpiredDeletedUsers, deletedBefore,
+		testDeploymentID, 100).
+		Return([]map[string]interface{}{{"id": "user-1"}, {"id": "user-2"}}, nil).Once()
+
+	ids, err := suite.store.ListExpiredDeletedUsers(suite.ctx, deletedBefore, 100)
+
+	suite.NoError(err)
+	suite.Equal([]string{"user-1", "user-2"}, ids)
+}
+
+func (suite *DeletedUserStoreTestSuite) TestListExpiredDeletedUsers_ParseError() {
+	deletedBefore := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	suite.mockDBProvider.On("GetUserDBClient").Return(suite.mockDBClient, nil).Once()
+	suite.mockDBClient.On("QueryContext", suite.ctx, queryListExpiredDeletedUsers, deletedBefore,
+		testDeploymentID, 100).
+		Return([]map[string]interface{}{{"id": 1}}, nil).Once()
+
+	ids, err := suite.store.ListExpiredDeletedUsers(suite.ctx, deletedBefore, 100)
+
+	suite.Nil(ids)
+	suite.ErrorContains(err, "failed to parse entity ID")
+}
+
+func (suite *DeletedUserStoreTestSuite) TestListExpiredDeletedUsers_DBClientError() {
+	suite.mockDBProvider.On("GetUserDBClient").Return(nil, errors.New("db unavailable")).Once()
+
+	ids, err := suite.store.ListExpiredDeletedUsers(suite.ctx, time.Now(), 100)
+
+	suite.Nil(ids)
+	suite.ErrorContains(err, "failed to get database client")
+}
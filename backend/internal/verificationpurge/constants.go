@@ -0,0 +1,32 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package verificationpurge
+
+import "time"
+
+const loggerComponentName = "VerificationPurgeService"
+
+// scheduledPurgeLockName is the distributed lock held while a scheduled purge runs.
+const scheduledPurgeLockName = "verification-scheduled-purge"
+
+// purgeBatchSize is the number of expired unverified users read from the store and deleted at a time.
+const purgeBatchSize = 100
+
+// defaultPurgeInterval is the interval between scheduled purges when no interval is configured.
+const defaultPurgeInterval = time.Hour
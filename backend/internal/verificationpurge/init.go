@@ -0,0 +1,48 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package verificationpurge
+
+import (
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/entity"
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/scheduler"
+)
+
+// Initialize initializes the purge of expired unverified users and starts the scheduled purges. Purges
+// are not scheduled when no retention period is configured.
+func Initialize(
+	entityService entity.EntityServiceInterface,
+	jobScheduler scheduler.SchedulerInterface,
+) VerificationPurgeServiceInterface {
+	pendingConfig := config.GetServerRuntime().Config.User.PendingVerification
+	purgeService := newVerificationPurgeService(newPendingEntityStore(), entityService,
+		time.Duration(pendingConfig.Retention)*time.Second)
+
+	if pendingConfig.Retention > 0 {
+		interval := time.Duration(pendingConfig.PurgeInterval) * time.Second
+		if interval <= 0 {
+			interval = defaultPurgeInterval
+		}
+		jobScheduler.Schedule(scheduledPurgeLockName, interval, newScheduledPurge(purgeService))
+	}
+
+	return purgeService
+}
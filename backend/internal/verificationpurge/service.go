@@ -0,0 +1,94 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package verificationpurge removes users that were registered pending verification of their email address
+// and did not verify it within the configured retention period.
+package verificationpurge
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/entity"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/internal/system/scheduler"
+)
+
+// VerificationPurgeServiceInterface defines the operations of the purge of expired unverified users.
+type VerificationPurgeServiceInterface interface {
+	PurgeExpired(ctx context.Context) (int, error)
+}
+
+// verificationPurgeService is the default implementation of VerificationPurgeServiceInterface.
+type verificationPurgeService struct {
+	store         pendingEntityStoreInterface
+	entityService entity.EntityServiceInterface
+	retention     time.Duration
+	logger        *log.Logger
+}
+
+// newVerificationPurgeService creates a new instance of verificationPurgeService.
+func newVerificationPurgeService(
+	store pendingEntityStoreInterface, entityService entity.EntityServiceInterface, retention time.Duration,
+) VerificationPurgeServiceInterface {
+	return &verificationPurgeService{
+		store:         store,
+		entityService: entityService,
+		retention:     retention,
+		logger:        log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName)),
+	}
+}
+
+// PurgeExpired deletes the users that are still pending verification after the retention period and
+// returns the number of users deleted.
+func (s *verificationPurgeService) PurgeExpired(ctx context.Context) (int, error) {
+	createdBefore := time.Now().UTC().Add(-s.retention)
+
+	purged := 0
+	for {
+		ids, err := s.store.ListExpiredPendingEntities(ctx, createdBefore, purgeBatchSize)
+		if err != nil {
+			return purged, fmt.Errorf("failed to list expired unverified users: %w", err)
+		}
+
+		for _, id := range ids {
+			if err := s.entityService.DeleteEntity(ctx, id); err != nil {
+				return purged, fmt.Errorf("failed to delete expired unverified user: %w", err)
+			}
+			s.logger.Debug("Deleted expired unverified user", log.MaskedString(log.LoggerKeyUserID, id))
+			purged++
+		}
+
+		if len(ids) < purgeBatchSize {
+			return purged, nil
+		}
+	}
+}
+
+// newScheduledPurge returns the scheduled job that removes the unverified users whose retention period has expired.
+func newScheduledPurge(service VerificationPurgeServiceInterface) scheduler.JobFunc {
+	return func(ctx context.Context) error {
+		purged, err := service.PurgeExpired(ctx)
+		if purged > 0 {
+			log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName)).Info(
+				"Purged expired unverified users", log.Int("count", purged))
+		}
+		return err
+	}
+}
@@ -0,0 +1,126 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package verificationpurge
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	sysContext "github.com/thunder-id/thunderid/internal/system/context"
+	"github.com/thunder-id/thunderid/tests/mocks/entitymock"
+)
+
+const testRetention = 24 * time.Hour
+
+type VerificationPurgeServiceTestSuite struct {
+	suite.Suite
+	mockStore         *pendingEntityStoreInterfaceMock
+	mockEntityService *entitymock.EntityServiceInterfaceMock
+	service           VerificationPurgeServiceInterface
+}
+
+func TestVerificationPurgeServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(VerificationPurgeServiceTestSuite))
+}
+
+func (suite *VerificationPurgeServiceTestSuite) SetupTest() {
+	suite.mockStore = newPendingEntityStoreInterfaceMock(suite.T())
+	suite.mockEntityService = entitymock.NewEntityServiceInterfaceMock(suite.T())
+	suite.service = newVerificationPurgeService(suite.mockStore, suite.mockEntityService, testRetention)
+}
+
+func (suite *VerificationPurgeServiceTestSuite) TestPurgeExpired_DeletesExpiredUsers() {
+	before := time.Now().UTC().Add(-testRetention)
+	suite.mockStore.On("ListExpiredPendingEntities", mock.Anything,
+		mock.MatchedBy(func(createdBefore time.Time) bool {
+			return !createdBefore.Before(before) && createdBefore.Before(before.Add(time.Minute))
+		}), purgeBatchSize).
+		Return([]string{"user-1", "user-2"}, nil).Once()
+	suite.mockEntityService.On("DeleteEntity", mock.Anything, "user-1").Return(nil).Once()
+	suite.mockEntityService.On("DeleteEntity", mock.Anything, "user-2").Return(nil).Once()
+
+	purged, err := suite.service.PurgeExpired(context.Background())
+
+	suite.NoError(err)
+	suite.Equal(2, purged)
+}
+
+func (suite *VerificationPurgeServiceTestSuite) TestPurgeExpired_ContinuesWithNextBatch() {
+	fullBatch := make([]string, purgeBatchSize)
+	for i := range fullBatch {
+		fullBatch[i] = fmt.Sprintf("user-%d", i)
+	}
+	suite.mockStore.On("ListExpiredPendingEntities", mock.Anything, mock.Anything, purgeBatchSize).
+		Return(fullBatch, nil).Once()
+	suite.mockStore.On("ListExpiredPendingEntities", mock.Anything, mock.Anything, purgeBatchSize).
+		Return([]string{}, nil).Once()
+	suite.mockEntityService.On("DeleteEntity", mock.Anything, mock.Anything).Return(nil).Times(purgeBatchSize)
+
+	purged, err := suite.service.PurgeExpired(context.Background())
+
+	suite.NoError(err)
+	suite.Equal(purgeBatchSize, purged)
+}
+
+func (suite *VerificationPurgeServiceTestSuite) TestPurgeExpired_StoreError() {
+	suite.mockStore.On("ListExpiredPendingEntities", mock.Anything, mock.Anything, purgeBatchSize).
+		Return(nil, errors.New("db error")).Once()
+
+	purged, err := suite.service.PurgeExpired(context.Background())
+
+	suite.ErrorContains(err, "failed to list expired unverified users")
+	suite.Equal(0, purged)
+}
+
+func (suite *VerificationPurgeServiceTestSuite) TestPurgeExpired_DeleteError() {
+	suite.mockStore.On("ListExpiredPendingEntities", mock.Anything, mock.Anything, purgeBatchSize).
+		Return([]string{"user-1", "user-2"}, nil).Once()
+	suite.mockEntityService.On("DeleteEntity", mock.Anything, "user-1").Return(nil).Once()
+	suite.mockEntityService.On("DeleteEntity", mock.Anything, "user-2").Return(errors.New("db error")).Once()
+
+	purged, err := suite.service.PurgeExpired(context.Background())
+
+	suite.ErrorContains(err, "failed to delete expired unverified user")
+	suite.Equal(1, purged)
+}
+
+func (suite *VerificationPurgeServiceTestSuite) TestScheduledPurge_PurgesInTheContextPartition() {
+	ctx := sysContext.WithTenantID(context.Background(), "tenant-1")
+	mockService := NewVerificationPurgeServiceInterfaceMock(suite.T())
+	mockService.On("PurgeExpired", ctx).Return(3, nil).Once()
+
+	err := newScheduledPurge(mockService)(ctx)
+
+	suite.NoError(err)
+}
+
+func (suite *VerificationPurgeServiceTestSuite) TestScheduledPurge_ReturnsPurgeError() {
+	mockService := NewVerificationPurgeServiceInterfaceMock(suite.T())
+	mockService.On("PurgeExpired", mock.Anything).Return(1, errors.New("db error")).Once()
+
+	err := newScheduledPurge(mockService)(context.Background())
+
+	suite.EqualError(err, "db error")
+}
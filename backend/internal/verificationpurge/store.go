@@ -0,0 +1,77 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package verificationpurge
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+	sysContext "github.com/thunder-id/thunderid/internal/system/context"
+	"github.com/thunder-id/thunderid/internal/system/database/provider"
+)
+
+var getDBProvider = provider.GetDBProvider
+
+// pendingEntityStoreInterface defines the store operations used to find expired unverified users.
+type pendingEntityStoreInterface interface {
+	ListExpiredPendingEntities(ctx context.Context, createdBefore time.Time, limit int) ([]string, error)
+}
+
+// pendingEntityStore is the database backed implementation of pendingEntityStoreInterface.
+type pendingEntityStore struct {
+	dbProvider   provider.DBProviderInterface
+	deploymentID string
+}
+
+// newPendingEntityStore creates a new instance of pendingEntityStore.
+func newPendingEntityStore() pendingEntityStoreInterface {
+	return &pendingEntityStore{
+		dbProvider:   getDBProvider(),
+		deploymentID: config.GetServerRuntime().Config.Server.Identifier,
+	}
+}
+
+// ListExpiredPendingEntities lists the IDs of up to limit users that are pending verification and were
+// created before the given time, oldest first.
+func (s *pendingEntityStore) ListExpiredPendingEntities(
+	ctx context.Context, createdBefore time.Time, limit int,
+) ([]string, error) {
+	deploymentID := sysContext.ScopeDeploymentID(ctx, s.deploymentID)
+	dbClient, err := s.dbProvider.GetUserDBClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	results, err := dbClient.QueryContext(ctx, queryListExpiredPendingEntities, createdBefore, deploymentID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	ids := make([]string, 0, len(results))
+	for _, row := range results {
+		id, ok := row["id"].(string)
+		if !ok {
+			return nil, fmt.Errorf("failed to parse entity ID")
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
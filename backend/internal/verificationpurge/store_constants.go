@@ -0,0 +1,33 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package verificationpurge
+
+import (
+	"github.com/thunder-id/thunderid/internal/entity"
+	dbmodel "github.com/thunder-id/thunderid/internal/system/database/model"
+)
+
+// queryListExpiredPendingEntities lists the oldest users that are still pending verification and were
+// created before the given time.
+var queryListExpiredPendingEntities = dbmodel.DBQuery{
+	ID: "VPQ-VP_MGT-01",
+	Query: `SELECT ID FROM "ENTITY" WHERE CATEGORY = '` + string(entity.EntityCategoryUser) + `' ` +
+		`AND STATE = '` + string(entity.EntityStatePendingVerification) + `' ` +
+		`AND CREATED_AT < $1 AND DEPLOYMENT_ID = $2 ORDER BY CREATED_AT LIMIT $3`,
+}
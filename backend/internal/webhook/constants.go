@@ -0,0 +1,65 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package webhook
+
+import "time"
+
+const (
+	// loggerComponentName is the component name used in webhook logs.
+	loggerComponentName = "WebhookService"
+
+	// subscriberID identifies the webhook subscriber on the event bus.
+	subscriberID = "webhook"
+
+	// deliveryLockName is the distributed lock held while queued events are delivered and expired events
+	// are deleted.
+	deliveryLockName = "webhook-delivery"
+
+	// webhooksPath is the base path of the webhook subscription API.
+	webhooksPath = "/webhooks"
+
+	// queryParamFrom is the query parameter selecting the time from which events are redelivered.
+	queryParamFrom = "from"
+
+	// defaultTimeout is used when webhooks.timeout is not configured.
+	defaultTimeout = 10 * time.Second
+
+	// deliveryBatchSize is the largest number of queued events of a subscription delivered in a run.
+	deliveryBatchSize = 100
+
+	// baseRetryDelay is the delay before the first retry of a failed delivery. The delay doubles with
+	// every further failure.
+	baseRetryDelay = 30 * time.Second
+
+	// maxRetryDelay is the longest delay between the retries of a failed delivery.
+	maxRetryDelay = time.Hour
+
+	// maxLastErrorLength is the longest delivery error kept for the health of a subscription.
+	maxLastErrorLength = 512
+)
+
+// Headers sent with every delivery.
+const (
+	headerEventID   = "X-Webhook-Event-Id"
+	headerEventType = "X-Webhook-Event-Type"
+	headerSignature = "X-Webhook-Signature"
+
+	// signaturePrefix precedes the hex encoded HMAC-SHA256 of the body in the signature header.
+	signaturePrefix = "sha256="
+)
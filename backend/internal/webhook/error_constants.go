@@ -0,0 +1,55 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package webhook
+
+import (
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/i18n/core"
+)
+
+// Client errors for webhook operations.
+var (
+	// ErrorSubscriptionNotFound is the error returned when the subscription is not configured.
+	ErrorSubscriptionNotFound = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "WHK-1001",
+		Error: core.I18nMessage{
+			Key:          "error.webhookservice.subscription_not_found",
+			DefaultValue: "Webhook subscription not found",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.webhookservice.subscription_not_found_description",
+			DefaultValue: "The requested webhook subscription could not be found",
+		},
+	}
+	// ErrorInvalidFromParameter is the error returned when the time to redeliver events from is missing or
+	// invalid.
+	ErrorInvalidFromParameter = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "WHK-1002",
+		Error: core.I18nMessage{
+			Key:          "error.webhookservice.invalid_from_parameter",
+			DefaultValue: "Invalid from parameter",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.webhookservice.invalid_from_parameter_description",
+			DefaultValue: "The from parameter must be an RFC 3339 time that is not in the future",
+		},
+	}
+)
@@ -0,0 +1,83 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package webhook
+
+import (
+	"net/http"
+	"time"
+
+	sysutils "github.com/thunder-id/thunderid/internal/system/utils"
+)
+
+// webhookHandler is the handler for webhook subscription operations.
+type webhookHandler struct {
+	webhookService WebhookServiceInterface
+}
+
+// newWebhookHandler creates a new instance of webhookHandler.
+func newWebhookHandler(webhookService WebhookServiceInterface) *webhookHandler {
+	return &webhookHandler{
+		webhookService: webhookService,
+	}
+}
+
+// HandleSubscriptionListRequest handles the list subscriptions request.
+func (h *webhookHandler) HandleSubscriptionListRequest(w http.ResponseWriter, r *http.Request) {
+	subscriptions, svcErr := h.webhookService.GetSubscriptionList(r.Context())
+	if svcErr != nil {
+		sysutils.WriteServiceErrorResponse(w, svcErr, clientErrorStatusCodes)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(w, http.StatusOK, subscriptions)
+}
+
+// HandleSubscriptionGetRequest handles the get subscription request.
+func (h *webhookHandler) HandleSubscriptionGetRequest(w http.ResponseWriter, r *http.Request) {
+	subscription, svcErr := h.webhookService.GetSubscription(r.Context(), r.PathValue("id"))
+	if svcErr != nil {
+		sysutils.WriteServiceErrorResponse(w, svcErr, clientErrorStatusCodes)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(w, http.StatusOK, subscription)
+}
+
+// HandleRedeliverRequest handles the request to redeliver the events of a subscription from a time.
+func (h *webhookHandler) HandleRedeliverRequest(w http.ResponseWriter, r *http.Request) {
+	from, err := time.Parse(time.RFC3339, r.URL.Query().Get(queryParamFrom))
+	if err != nil {
+		sysutils.WriteServiceErrorResponse(w, &ErrorInvalidFromParameter, clientErrorStatusCodes)
+		return
+	}
+
+	response, svcErr := h.webhookService.RedeliverEvents(r.Context(), r.PathValue("id"), from)
+	if svcErr != nil {
+		sysutils.WriteServiceErrorResponse(w, svcErr, clientErrorStatusCodes)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(w, http.StatusAccepted, response)
+}
+
+// clientErrorStatusCodes maps the client errors of the handler to HTTP status codes. Other client errors are
+// returned with 400 Bad Request.
+var clientErrorStatusCodes = map[string]int{
+	ErrorSubscriptionNotFound.Code: http.StatusNotFound,
+}
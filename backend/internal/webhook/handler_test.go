@@ -0,0 +1,120 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package webhook
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/system/error/apierror"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+)
+
+type HandlerTestSuite struct {
+	suite.Suite
+	mockService *WebhookServiceInterfaceMock
+	handler     *webhookHandler
+}
+
+func TestHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(HandlerTestSuite))
+}
+
+func (s *HandlerTestSuite) SetupTest() {
+	s.mockService = NewWebhookServiceInterfaceMock(s.T())
+	s.handler = newWebhookHandler(s.mockService)
+}
+
+func (s *HandlerTestSuite) TestHandleSubscriptionListRequest() {
+	s.mockService.On("GetSubscriptionList", mock.Anything).Return(&SubscriptionListResponse{
+		TotalResults:  1,
+		Subscriptions: []Subscription{{ID: testSubscriptionID, Health: DeliveryHealth{PendingEvents: 3}}},
+	}, nil).Once()
+
+	rr := httptest.NewRecorder()
+	s.handler.HandleSubscriptionListRequest(rr, httptest.NewRequest(http.MethodGet, webhooksPath, nil))
+
+	s.Equal(http.StatusOK, rr.Code)
+	var body SubscriptionListResponse
+	s.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &body))
+	s.Equal(3, body.Subscriptions[0].Health.PendingEvents)
+}
+
+func (s *HandlerTestSuite) TestHandleSubscriptionGetRequest_NotFound() {
+	s.mockService.On("GetSubscription", mock.Anything, "unknown").
+		Return(nil, &ErrorSubscriptionNotFound).Once()
+
+	req := httptest.NewRequest(http.MethodGet, webhooksPath+"/unknown", nil)
+	req.SetPathValue("id", "unknown")
+	rr := httptest.NewRecorder()
+	s.handler.HandleSubscriptionGetRequest(rr, req)
+
+	s.Equal(http.StatusNotFound, rr.Code)
+	var body apierror.ErrorResponse
+	s.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &body))
+	s.Equal(ErrorSubscriptionNotFound.Code, body.Code)
+}
+
+func (s *HandlerTestSuite) TestHandleRedeliverRequest() {
+	from := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	s.mockService.On("RedeliverEvents", mock.Anything, testSubscriptionID, from).
+		Return(&RedeliverResponse{Requeued: 4}, nil).Once()
+
+	req := httptest.NewRequest(http.MethodPost,
+		webhooksPath+"/"+testSubscriptionID+"/redeliver?from=2026-01-01T00:00:00Z", nil)
+	req.SetPathValue("id", testSubscriptionID)
+	rr := httptest.NewRecorder()
+	s.handler.HandleRedeliverRequest(rr, req)
+
+	s.Equal(http.StatusAccepted, rr.Code)
+	var body RedeliverResponse
+	s.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &body))
+	s.Equal(4, body.Requeued)
+}
+
+func (s *HandlerTestSuite) TestHandleRedeliverRequest_InvalidFrom() {
+	for _, query := range []string{"", "?from=yesterday"} {
+		req := httptest.NewRequest(http.MethodPost, webhooksPath+"/"+testSubscriptionID+"/redeliver"+query, nil)
+		req.SetPathValue("id", testSubscriptionID)
+		rr := httptest.NewRecorder()
+		s.handler.HandleRedeliverRequest(rr, req)
+
+		s.Equal(http.StatusBadRequest, rr.Code)
+	}
+	s.mockService.AssertNotCalled(s.T(), "RedeliverEvents", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (s *HandlerTestSuite) TestHandleRedeliverRequest_ServerError() {
+	s.mockService.On("RedeliverEvents", mock.Anything, testSubscriptionID, mock.Anything).
+		Return(nil, &serviceerror.InternalServerError).Once()
+
+	req := httptest.NewRequest(http.MethodPost,
+		webhooksPath+"/"+testSubscriptionID+"/redeliver?from=2026-01-01T00:00:00Z", nil)
+	req.SetPathValue("id", testSubscriptionID)
+	rr := httptest.NewRecorder()
+	s.handler.HandleRedeliverRequest(rr, req)
+
+	s.Equal(http.StatusInternalServerError, rr.Code)
+}
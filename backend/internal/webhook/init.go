@@ -0,0 +1,143 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package webhook
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+	syshttp "github.com/thunder-id/thunderid/internal/system/http"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/internal/system/middleware"
+	"github.com/thunder-id/thunderid/internal/system/observability"
+	"github.com/thunder-id/thunderid/internal/system/scheduler"
+	"github.com/thunder-id/thunderid/internal/system/security"
+)
+
+// Initialize initializes the webhook service, subscribes it to the event bus, registers its routes and
+// starts the scheduled deliveries. The service is not initialized when webhooks are disabled.
+func Initialize(
+	mux *http.ServeMux,
+	jobScheduler scheduler.SchedulerInterface,
+	observabilitySvc observability.ObservabilityServiceInterface,
+) WebhookServiceInterface {
+	webhookConfig := config.GetServerRuntime().Config.Webhooks
+	if !webhookConfig.Enabled {
+		return nil
+	}
+
+	subscriptions := make([]subscription, 0, len(webhookConfig.Subscriptions))
+	for _, subscriptionConfig := range webhookConfig.Subscriptions {
+		maxQueueDepth := subscriptionConfig.MaxQueueDepth
+		if maxQueueDepth == 0 {
+			maxQueueDepth = webhookConfig.MaxQueueDepth
+		}
+		retention := subscriptionConfig.Retention
+		if retention == 0 {
+			retention = webhookConfig.Retention
+		}
+		subscriptions = append(subscriptions, subscription{
+			ID:            subscriptionConfig.ID,
+			TenantID:      subscriptionConfig.Tenant,
+			EndpointURL:   subscriptionConfig.EndpointURL,
+			Secret:        subscriptionConfig.Secret,
+			Events:        subscriptionConfig.Events,
+			MaxQueueDepth: maxQueueDepth,
+			Retention:     time.Duration(retention) * time.Second,
+		})
+	}
+	timeout := time.Duration(webhookConfig.Timeout) * time.Second
+	if timeout <= 0 {
+		timeout = defaultTimeout
+	}
+
+	webhookService := newWebhookService(newWebhookEventStore(), syshttp.NewHTTPClientWithTimeout(timeout),
+		subscriptions, timeout)
+
+	if publisher := observabilitySvc.GetPublisher(); publisher != nil {
+		publisher.Subscribe(newWebhookSubscriber(webhookService))
+	} else {
+		log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName)).
+			Warn("Observability is disabled, so no events are queued for webhook subscriptions")
+	}
+
+	registerRoutes(mux, newWebhookHandler(webhookService))
+
+	jobScheduler.Schedule(deliveryLockName, time.Duration(webhookConfig.DeliveryInterval)*time.Second,
+		newScheduledDelivery(webhookService))
+
+	return webhookService
+}
+
+// registerRoutes registers the routes for webhook subscription operations.
+func registerRoutes(mux *http.ServeMux, webhookHandler *webhookHandler) {
+	opts1 := middleware.CORSOptions{
+		AllowedMethods:   []string{"GET"},
+		AllowedHeaders:   middleware.DefaultAllowedHeaders,
+		AllowCredentials: true,
+		MaxAge:           600,
+	}
+	mux.HandleFunc(middleware.WithCORS("GET "+webhooksPath,
+		webhookHandler.HandleSubscriptionListRequest, opts1))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS "+webhooksPath,
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, opts1))
+	mux.HandleFunc(middleware.WithCORS("GET "+webhooksPath+"/{id}",
+		webhookHandler.HandleSubscriptionGetRequest, opts1))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS "+webhooksPath+"/{id}",
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, opts1))
+
+	opts2 := middleware.CORSOptions{
+		AllowedMethods:   []string{"POST"},
+		AllowedHeaders:   middleware.DefaultAllowedHeaders,
+		AllowCredentials: true,
+		MaxAge:           600,
+	}
+	mux.HandleFunc(middleware.WithCORS("POST "+webhooksPath+"/{id}/redeliver",
+		webhookHandler.HandleRedeliverRequest, opts2))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS "+webhooksPath+"/{id}/redeliver",
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, opts2))
+}
+
+// newScheduledDelivery returns the scheduled job that deletes the expired events and delivers the queued
+// events.
+func newScheduledDelivery(service WebhookServiceInterface) scheduler.JobFunc {
+	return func(ctx context.Context) error {
+		logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName))
+		ctx = security.WithRuntimeContext(ctx)
+
+		deleted, deleteErr := service.DeleteExpiredEvents(ctx)
+		if deleted > 0 {
+			logger.Debug("Deleted expired webhook events", log.Int("count", deleted))
+		}
+		delivered, deliverErr := service.DeliverPendingEvents(ctx)
+		if delivered > 0 {
+			logger.Debug("Delivered queued webhook events", log.Int("count", delivered))
+		}
+		return errors.Join(deleteErr, deliverErr)
+	}
+}
@@ -0,0 +1,123 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package webhook delivers the audit and operational events of the event bus to the endpoints of webhook
+// subscriptions. Events are stored before they are forwarded, so that subscribers whose endpoints are
+// often unreachable receive them once they are back, and they can be replayed within their retention.
+package webhook
+
+import (
+	"slices"
+	"time"
+)
+
+// DeliveryStatus represents the state of an event in the queue of a subscription.
+type DeliveryStatus string
+
+const (
+	// DeliveryStatusPending marks an event that is waiting to be delivered.
+	DeliveryStatusPending DeliveryStatus = "PENDING"
+	// DeliveryStatusDelivered marks an event that the endpoint accepted.
+	DeliveryStatusDelivered DeliveryStatus = "DELIVERED"
+	// DeliveryStatusDropped marks an event removed from a full queue before it was delivered.
+	DeliveryStatusDropped DeliveryStatus = "DROPPED"
+)
+
+// HealthStatus summarizes whether the deliveries to a subscription succeed.
+type HealthStatus string
+
+const (
+	// HealthStatusHealthy reports that the oldest queued event, if any, has not failed to be delivered.
+	HealthStatusHealthy HealthStatus = "HEALTHY"
+	// HealthStatusFailing reports that the oldest queued event failed to be delivered and is retried.
+	HealthStatusFailing HealthStatus = "FAILING"
+)
+
+// subscription is a webhook subscription as configured.
+type subscription struct {
+	ID            string
+	TenantID      string
+	EndpointURL   string
+	Secret        string
+	Events        []string
+	MaxQueueDepth int
+	Retention     time.Duration
+}
+
+// accepts reports whether the subscription receives events of the given type.
+func (s subscription) accepts(eventType string) bool {
+	return len(s.Events) == 0 || slices.Contains(s.Events, eventType)
+}
+
+// WebhookEvent represents an event queued for a subscription.
+type WebhookEvent struct {
+	ID             string
+	SubscriptionID string
+	EventType      string
+	Payload        string
+	Status         DeliveryStatus
+	Attempts       int
+	LastError      string
+	LastAttemptAt  *time.Time
+	NextAttemptAt  *time.Time
+	DeliveredAt    *time.Time
+	CreatedAt      time.Time
+}
+
+// deliveryStats counts the retained events of a subscription by status.
+type deliveryStats struct {
+	Counts          map[DeliveryStatus]int
+	OldestPendingAt *time.Time
+	LastDeliveredAt *time.Time
+}
+
+// DeliveryHealth reports the state of the deliveries to a subscription. The counts cover the events kept
+// within the retention of the subscription.
+type DeliveryHealth struct {
+	Status              HealthStatus `json:"status"`
+	PendingEvents       int          `json:"pendingEvents"`
+	DeliveredEvents     int          `json:"deliveredEvents"`
+	DroppedEvents       int          `json:"droppedEvents"`
+	OldestPendingAt     *time.Time   `json:"oldestPendingAt,omitempty"`
+	LastDeliveredAt     *time.Time   `json:"lastDeliveredAt,omitempty"`
+	ConsecutiveFailures int          `json:"consecutiveFailures"`
+	LastError           string       `json:"lastError,omitempty"`
+	LastAttemptAt       *time.Time   `json:"lastAttemptAt,omitempty"`
+	NextAttemptAt       *time.Time   `json:"nextAttemptAt,omitempty"`
+}
+
+// Subscription represents a webhook subscription and the health of its deliveries.
+type Subscription struct {
+	ID            string         `json:"id"`
+	EndpointURL   string         `json:"endpointUrl"`
+	Events        []string       `json:"events"`
+	MaxQueueDepth int            `json:"maxQueueDepth"`
+	Retention     int64          `json:"retention"`
+	Health        DeliveryHealth `json:"health"`
+}
+
+// SubscriptionListResponse represents the response for listing webhook subscriptions.
+type SubscriptionListResponse struct {
+	TotalResults  int            `json:"totalResults"`
+	Subscriptions []Subscription `json:"subscriptions"`
+}
+
+// RedeliverResponse reports the number of events queued again by a redelivery.
+type RedeliverResponse struct {
+	Requeued int `json:"requeued"`
+}
@@ -0,0 +1,383 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package webhook
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	serverconst "github.com/thunder-id/thunderid/internal/system/constants"
+	sysContext "github.com/thunder-id/thunderid/internal/system/context"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	syshttp "github.com/thunder-id/thunderid/internal/system/http"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/internal/system/observability/event"
+	"github.com/thunder-id/thunderid/internal/system/utils"
+)
+
+// WebhookServiceInterface defines the interface for the webhook service.
+type WebhookServiceInterface interface {
+	EnqueueEvent(ctx context.Context, evt *event.Event) error
+	DeliverPendingEvents(ctx context.Context) (int, error)
+	DeleteExpiredEvents(ctx context.Context) (int, error)
+	GetSubscriptionList(ctx context.Context) (*SubscriptionListResponse, *serviceerror.ServiceError)
+	GetSubscription(ctx context.Context, id string) (*Subscription, *serviceerror.ServiceError)
+	RedeliverEvents(ctx context.Context, id string, from time.Time) (*RedeliverResponse,
+		*serviceerror.ServiceError)
+}
+
+// webhookService is the default implementation of the WebhookServiceInterface.
+type webhookService struct {
+	store         webhookEventStoreInterface
+	httpClient    syshttp.HTTPClientInterface
+	subscriptions []subscription
+	timeout       time.Duration
+	now           func() time.Time
+	logger        *log.Logger
+}
+
+// newWebhookService creates a new instance of webhookService. Each delivery is bounded by the timeout.
+func newWebhookService(
+	store webhookEventStoreInterface,
+	httpClient syshttp.HTTPClientInterface,
+	subscriptions []subscription,
+	timeout time.Duration,
+) WebhookServiceInterface {
+	return &webhookService{
+		store:         store,
+		httpClient:    httpClient,
+		subscriptions: subscriptions,
+		timeout:       timeout,
+		now:           time.Now,
+		logger:        log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName)),
+	}
+}
+
+// EnqueueEvent stores an event in the queue of every subscription of the tenant that receives its type. When a queue
+// grows beyond the depth limit of its subscription, its oldest pending events are dropped.
+func (s *webhookService) EnqueueEvent(ctx context.Context, evt *event.Event) error {
+	payload, err := json.Marshal(evt)
+	if err != nil {
+		return fmt.Errorf("failed to marshal event: %w", err)
+	}
+
+	var errs []error
+	for _, sub := range s.tenantSubscriptions(ctx) {
+		if !sub.accepts(evt.Type) {
+			continue
+		}
+		if err := s.enqueue(ctx, sub, evt.Type, string(payload)); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// DeliverPendingEvents forwards the queued events of every subscription of the tenant, oldest first, and returns the
+// number of events delivered. The deliveries to a subscription stop at its first failure, so that the
+// events reach the endpoint in order once it is back, and wait until the next attempt of a failed event
+// is due.
+func (s *webhookService) DeliverPendingEvents(ctx context.Context) (int, error) {
+	delivered := 0
+	var errs []error
+	for _, sub := range s.tenantSubscriptions(ctx) {
+		webhookEvents, err := s.store.ListEvents(ctx, sub.ID, DeliveryStatusPending, deliveryBatchSize)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to list pending events of subscription %s: %w", sub.ID, err))
+			continue
+		}
+		for _, webhookEvent := range webhookEvents {
+			if webhookEvent.NextAttemptAt != nil && webhookEvent.NextAttemptAt.After(s.now().UTC()) {
+				break
+			}
+			if !s.deliver(ctx, sub, webhookEvent) {
+				break
+			}
+			delivered++
+		}
+	}
+	return delivered, errors.Join(errs...)
+}
+
+// DeleteExpiredEvents deletes the events of the tenant kept beyond the retention of their subscription, whether they
+// were delivered or not, and returns the number of events deleted.
+func (s *webhookService) DeleteExpiredEvents(ctx context.Context) (int, error) {
+	deleted := 0
+	for _, sub := range s.tenantSubscriptions(ctx) {
+		if sub.Retention <= 0 {
+			continue
+		}
+		count, err := s.store.DeleteEventsBefore(ctx, sub.ID, s.now().UTC().Add(-sub.Retention))
+		if err != nil {
+			return deleted, fmt.Errorf("failed to delete expired events of subscription %s: %w", sub.ID, err)
+		}
+		deleted += count
+	}
+	return deleted, nil
+}
+
+// GetSubscriptionList returns the subscriptions of the tenant and the health of their deliveries.
+func (s *webhookService) GetSubscriptionList(ctx context.Context) (*SubscriptionListResponse,
+	*serviceerror.ServiceError) {
+	tenantSubscriptions := s.tenantSubscriptions(ctx)
+	subscriptions := make([]Subscription, 0, len(tenantSubscriptions))
+	for _, sub := range tenantSubscriptions {
+		subscription, err := s.buildSubscription(ctx, sub)
+		if err != nil {
+			s.logger.Error("Failed to get the delivery health of subscription", log.String("subscriptionID", sub.ID),
+				log.Error(err))
+			return nil, &serviceerror.InternalServerError
+		}
+		subscriptions = append(subscriptions, *subscription)
+	}
+
+	return &SubscriptionListResponse{
+		TotalResults:  len(subscriptions),
+		Subscriptions: subscriptions,
+	}, nil
+}
+
+// GetSubscription returns a subscription of the tenant and the health of its deliveries.
+func (s *webhookService) GetSubscription(ctx context.Context, id string) (*Subscription,
+	*serviceerror.ServiceError) {
+	sub, ok := s.findSubscription(ctx, id)
+	if !ok {
+		return nil, &ErrorSubscriptionNotFound
+	}
+
+	subscription, err := s.buildSubscription(ctx, sub)
+	if err != nil {
+		s.logger.Error("Failed to get the delivery health of subscription", log.String("subscriptionID", id),
+			log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+	return subscription, nil
+}
+
+// RedeliverEvents queues the retained events of a subscription created from the given time again, so that
+// they are delivered once more in their original order. Events that are still pending are left as they are.
+func (s *webhookService) RedeliverEvents(ctx context.Context, id string, from time.Time) (*RedeliverResponse,
+	*serviceerror.ServiceError) {
+	sub, ok := s.findSubscription(ctx, id)
+	if !ok {
+		return nil, &ErrorSubscriptionNotFound
+	}
+	if from.IsZero() || from.After(s.now()) {
+		return nil, &ErrorInvalidFromParameter
+	}
+
+	requeued, err := s.store.RequeueEvents(ctx, sub.ID, from.UTC())
+	if err != nil {
+		s.logger.Error("Failed to requeue events of subscription", log.String("subscriptionID", id),
+			log.Error(err))
+		return nil, &serviceerror.InternalServerError
+	}
+	s.logger.Debug("Requeued events of subscription for redelivery", log.String("subscriptionID", id),
+		log.Int("count", requeued))
+
+	return &RedeliverResponse{Requeued: requeued}, nil
+}
+
+// enqueue stores an event in the queue of a subscription and enforces the depth limit of the queue.
+func (s *webhookService) enqueue(ctx context.Context, sub subscription, eventType, payload string) error {
+	id, err := utils.GenerateUUIDv7()
+	if err != nil {
+		return fmt.Errorf("failed to generate webhook event ID: %w", err)
+	}
+
+	if err := s.store.CreateEvent(ctx, WebhookEvent{
+		ID:             id,
+		SubscriptionID: sub.ID,
+		EventType:      eventType,
+		Payload:        payload,
+		Status:         DeliveryStatusPending,
+		CreatedAt:      s.now().UTC(),
+	}); err != nil {
+		return fmt.Errorf("failed to queue event for subscription %s: %w", sub.ID, err)
+	}
+
+	if sub.MaxQueueDepth <= 0 {
+		return nil
+	}
+	pending, err := s.store.CountEvents(ctx, sub.ID, DeliveryStatusPending)
+	if err != nil {
+		return fmt.Errorf("failed to count pending events of subscription %s: %w", sub.ID, err)
+	}
+	if pending <= sub.MaxQueueDepth {
+		return nil
+	}
+	dropped, err := s.store.DropOldestEvents(ctx, sub.ID, pending-sub.MaxQueueDepth)
+	if err != nil {
+		return fmt.Errorf("failed to drop events of subscription %s: %w", sub.ID, err)
+	}
+	s.logger.Warn("Dropped the oldest pending events of a full webhook queue",
+		log.String("subscriptionID", sub.ID), log.Int("count", dropped))
+	return nil
+}
+
+// deliver posts a queued event to the endpoint of its subscription and reports whether the endpoint
+// accepted it. A failed delivery is recorded and retried after a delay that doubles with every failure.
+func (s *webhookService) deliver(ctx context.Context, sub subscription, webhookEvent WebhookEvent) bool {
+	logger := s.logger.With(log.String("subscriptionID", sub.ID), log.String("eventID", webhookEvent.ID))
+
+	if err := s.post(ctx, sub, webhookEvent); err != nil {
+		attempts := webhookEvent.Attempts + 1
+		logger.Debug("Failed to deliver webhook event", log.Int("attempts", attempts), log.Error(err))
+		attemptedAt := s.now().UTC()
+		if err := s.store.RecordFailure(ctx, webhookEvent.ID, truncate(err.Error(), maxLastErrorLength),
+			attemptedAt, attemptedAt.Add(retryDelay(attempts))); err != nil {
+			logger.Error("Failed to record the failed delivery of webhook event", log.Error(err))
+		}
+		return false
+	}
+
+	if err := s.store.MarkDelivered(ctx, webhookEvent.ID, s.now().UTC()); err != nil {
+		logger.Error("Failed to record the delivery of webhook event", log.Error(err))
+	}
+	return true
+}
+
+// post sends the payload of an event to the endpoint of a subscription. The body is signed with the
+// secret of the subscription, if any.
+func (s *webhookService) post(ctx context.Context, sub subscription, webhookEvent WebhookEvent) error {
+	reqCtx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(reqCtx, http.MethodPost, sub.EndpointURL,
+		strings.NewReader(webhookEvent.Payload))
+	if err != nil {
+		return fmt.Errorf("failed to build the delivery request: %w", err)
+	}
+	req.Header.Set(serverconst.ContentTypeHeaderName, serverconst.ContentTypeJSON)
+	req.Header.Set(headerEventID, webhookEvent.ID)
+	req.Header.Set(headerEventType, webhookEvent.EventType)
+	if sub.Secret != "" {
+		req.Header.Set(headerSignature, signaturePrefix+sign(sub.Secret, webhookEvent.Payload))
+	}
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("the delivery request failed: %w", err)
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("the endpoint responded with status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// buildSubscription returns the API representation of a subscription with the health of its deliveries.
+func (s *webhookService) buildSubscription(ctx context.Context, sub subscription) (*Subscription, error) {
+	stats, err := s.store.GetDeliveryStats(ctx, sub.ID)
+	if err != nil {
+		return nil, err
+	}
+	health := DeliveryHealth{
+		Status:          HealthStatusHealthy,
+		PendingEvents:   stats.Counts[DeliveryStatusPending],
+		DeliveredEvents: stats.Counts[DeliveryStatusDelivered],
+		DroppedEvents:   stats.Counts[DeliveryStatusDropped],
+		OldestPendingAt: stats.OldestPendingAt,
+		LastDeliveredAt: stats.LastDeliveredAt,
+	}
+	if health.PendingEvents > 0 {
+		// The oldest pending event blocks the queue, so its failures are the consecutive failures.
+		head, err := s.store.ListEvents(ctx, sub.ID, DeliveryStatusPending, 1)
+		if err != nil {
+			return nil, err
+		}
+		if len(head) > 0 && head[0].Attempts > 0 {
+			health.Status = HealthStatusFailing
+			health.ConsecutiveFailures = head[0].Attempts
+			health.LastError = head[0].LastError
+			health.LastAttemptAt = head[0].LastAttemptAt
+			health.NextAttemptAt = head[0].NextAttemptAt
+		}
+	}
+
+	events := sub.Events
+	if events == nil {
+		events = []string{}
+	}
+	return &Subscription{
+		ID:            sub.ID,
+		EndpointURL:   sub.EndpointURL,
+		Events:        events,
+		MaxQueueDepth: sub.MaxQueueDepth,
+		Retention:     int64(sub.Retention.Seconds()),
+		Health:        health,
+	}, nil
+}
+
+// tenantSubscriptions returns the subscriptions of the tenant the context is scoped to.
+func (s *webhookService) tenantSubscriptions(ctx context.Context) []subscription {
+	tenantID := sysContext.GetTenantID(ctx)
+	subscriptions := make([]subscription, 0, len(s.subscriptions))
+	for _, sub := range s.subscriptions {
+		if sub.TenantID == tenantID {
+			subscriptions = append(subscriptions, sub)
+		}
+	}
+	return subscriptions
+}
+
+// findSubscription returns the subscription of the tenant with the given ID.
+func (s *webhookService) findSubscription(ctx context.Context, id string) (subscription, bool) {
+	subscriptions := s.tenantSubscriptions(ctx)
+	idx := slices.IndexFunc(subscriptions, func(sub subscription) bool { return sub.ID == id })
+	if id == "" || idx == -1 {
+		return subscription{}, false
+	}
+	return subscriptions[idx], true
+}
+
+// retryDelay returns the delay before the next attempt of an event that failed the given number of times.
+func retryDelay(attempts int) time.Duration {
+	delay := baseRetryDelay
+	for i := 1; i < attempts && delay < maxRetryDelay; i++ {
+		delay *= 2
+	}
+	return min(delay, maxRetryDelay)
+}
+
+// sign returns the hex encoded HMAC-SHA256 of a payload under a secret.
+func sign(secret, payload string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(payload))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// truncate shortens a string to at most the given number of bytes.
+func truncate(value string, maxLength int) string {
+	if len(value) <= maxLength {
+		return value
+	}
+	return value[:maxLength]
+}
@@ -0,0 +1,305 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package webhook
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	sysContext "github.com/thunder-id/thunderid/internal/system/context"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/observability/event"
+	"github.com/thunder-id/thunderid/tests/mocks/httpmock"
+)
+
+const (
+	testSubscriptionID = "audit-sink"
+	testEndpointURL    = "https://sink.test/events"
+	testSecret         = "signing-secret"
+)
+
+type WebhookServiceTestSuite struct {
+	suite.Suite
+	mockStore      *webhookEventStoreInterfaceMock
+	mockHTTPClient *httpmock.HTTPClientInterfaceMock
+	service        *webhookService
+	now            time.Time
+}
+
+func TestWebhookServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(WebhookServiceTestSuite))
+}
+
+func (suite *WebhookServiceTestSuite) SetupTest() {
+	suite.mockStore = newWebhookEventStoreInterfaceMock(suite.T())
+	suite.mockHTTPClient = httpmock.NewHTTPClientInterfaceMock(suite.T())
+	subscriptions := []subscription{
+		{ID: testSubscriptionID, EndpointURL: testEndpointURL, Secret: testSecret, MaxQueueDepth: 2,
+			Retention: time.Hour},
+		{ID: "drift-sink", EndpointURL: "https://drift.test/events",
+			Events: []string{string(event.EventTypeConfigDriftDetected)}},
+		{ID: "tenant-sink", TenantID: "tenant-1", EndpointURL: "https://tenant.test/events"},
+	}
+	suite.service = newWebhookService(suite.mockStore, suite.mockHTTPClient, subscriptions,
+		5*time.Second).(*webhookService)
+	suite.now = time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	suite.service.now = func() time.Time { return suite.now }
+}
+
+func response(statusCode int) *http.Response {
+	return &http.Response{StatusCode: statusCode, Body: io.NopCloser(strings.NewReader(""))}
+}
+
+func pendingEvent(id string) WebhookEvent {
+	return WebhookEvent{ID: id, SubscriptionID: testSubscriptionID, EventType: "BREAK_GLASS_USED",
+		Payload: `{"type":"BREAK_GLASS_USED"}`, Status: DeliveryStatusPending}
+}
+
+func (suite *WebhookServiceTestSuite) TestEnqueueEvent() {
+	evt := event.NewEvent("trace-1", string(event.EventTypeBreakGlassUsed), event.ComponentBreakGlass)
+	suite.mockStore.On("CreateEvent", mock.Anything, mock.MatchedBy(func(e WebhookEvent) bool {
+		return e.SubscriptionID == testSubscriptionID && e.EventType == string(event.EventTypeBreakGlassUsed) &&
+			e.Status == DeliveryStatusPending && strings.Contains(e.Payload, `"trace_id":"trace-1"`) &&
+			e.CreatedAt.Equal(suite.now)
+	})).Return(nil).Once()
+	suite.mockStore.On("CountEvents", mock.Anything, testSubscriptionID, DeliveryStatusPending).
+		Return(2, nil).Once()
+
+	suite.NoError(suite.service.EnqueueEvent(context.Background(), evt))
+	suite.mockStore.AssertNotCalled(suite.T(), "DropOldestEvents", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *WebhookServiceTestSuite) TestEnqueueEvent_TenantEventReachesOnlyTenantSubscriptions() {
+	ctx := sysContext.WithTenantID(context.Background(), "tenant-1")
+	evt := event.NewEvent("trace-1", string(event.EventTypeBreakGlassUsed), event.ComponentBreakGlass).
+		WithTenantID("tenant-1")
+	suite.mockStore.On("CreateEvent", ctx, mock.MatchedBy(func(e WebhookEvent) bool {
+		return e.SubscriptionID == "tenant-sink"
+	})).Return(nil).Once()
+
+	suite.NoError(suite.service.EnqueueEvent(ctx, evt))
+}
+
+func (suite *WebhookServiceTestSuite) TestEnqueueEvent_DropsOldestWhenQueueFull() {
+	evt := event.NewEvent("trace-1", string(event.EventTypeConfigDriftDetected), event.ComponentConfigDrift)
+	suite.mockStore.On("CreateEvent", mock.Anything, mock.Anything).Return(nil).Twice()
+	suite.mockStore.On("CountEvents", mock.Anything, testSubscriptionID, DeliveryStatusPending).
+		Return(5, nil).Once()
+	suite.mockStore.On("DropOldestEvents", mock.Anything, testSubscriptionID, 3).Return(3, nil).Once()
+
+	suite.NoError(suite.service.EnqueueEvent(context.Background(), evt))
+}
+
+func (suite *WebhookServiceTestSuite) TestEnqueueEvent_StoreError() {
+	evt := event.NewEvent("trace-1", string(event.EventTypeBreakGlassUsed), event.ComponentBreakGlass)
+	suite.mockStore.On("CreateEvent", mock.Anything, mock.Anything).Return(errors.New("db down")).Once()
+
+	suite.Error(suite.service.EnqueueEvent(context.Background(), evt))
+}
+
+func (suite *WebhookServiceTestSuite) TestDeliverPendingEvents() {
+	suite.mockStore.On("ListEvents", mock.Anything, testSubscriptionID, DeliveryStatusPending, deliveryBatchSize).
+		Return([]WebhookEvent{pendingEvent("event-1"), pendingEvent("event-2")}, nil).Once()
+	suite.mockStore.On("ListEvents", mock.Anything, "drift-sink", DeliveryStatusPending, deliveryBatchSize).
+		Return([]WebhookEvent{}, nil).Once()
+	suite.mockHTTPClient.On("Do", mock.MatchedBy(func(req *http.Request) bool {
+		return req.URL.String() == testEndpointURL && req.Header.Get(headerEventType) == "BREAK_GLASS_USED" &&
+			req.Header.Get(headerSignature) == signaturePrefix+sign(testSecret, `{"type":"BREAK_GLASS_USED"}`)
+	})).Return(response(http.StatusOK), nil).Twice()
+	suite.mockStore.On("MarkDelivered", mock.Anything, "event-1", suite.now).Return(nil).Once()
+	suite.mockStore.On("MarkDelivered", mock.Anything, "event-2", suite.now).Return(nil).Once()
+
+	delivered, err := suite.service.DeliverPendingEvents(context.Background())
+
+	suite.NoError(err)
+	suite.Equal(2, delivered)
+}
+
+func (suite *WebhookServiceTestSuite) TestDeliverPendingEvents_StopsAtFirstFailure() {
+	first := pendingEvent("event-1")
+	first.Attempts = 2
+	suite.mockStore.On("ListEvents", mock.Anything, testSubscriptionID, DeliveryStatusPending, deliveryBatchSize).
+		Return([]WebhookEvent{first, pendingEvent("event-2")}, nil).Once()
+	suite.mockStore.On("ListEvents", mock.Anything, "drift-sink", DeliveryStatusPending, deliveryBatchSize).
+		Return([]WebhookEvent{}, nil).Once()
+	suite.mockHTTPClient.On("Do", mock.Anything).Return(response(http.StatusServiceUnavailable), nil).Once()
+	suite.mockStore.On("RecordFailure", mock.Anything, "event-1", "the endpoint responded with status 503",
+		suite.now, suite.now.Add(4*baseRetryDelay)).Return(nil).Once()
+
+	delivered, err := suite.service.DeliverPendingEvents(context.Background())
+
+	suite.NoError(err)
+	suite.Zero(delivered)
+	suite.mockStore.AssertNotCalled(suite.T(), "MarkDelivered", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *WebhookServiceTestSuite) TestDeliverPendingEvents_WaitsForNextAttempt() {
+	first := pendingEvent("event-1")
+	nextAttemptAt := suite.now.Add(time.Minute)
+	first.NextAttemptAt = &nextAttemptAt
+	suite.mockStore.On("ListEvents", mock.Anything, testSubscriptionID, DeliveryStatusPending, deliveryBatchSize).
+		Return([]WebhookEvent{first}, nil).Once()
+	suite.mockStore.On("ListEvents", mock.Anything, "drift-sink", DeliveryStatusPending, deliveryBatchSize).
+		Return(nil, errors.New("db down")).Once()
+
+	delivered, err := suite.service.DeliverPendingEvents(context.Background())
+
+	suite.Error(err)
+	suite.Zero(delivered)
+	suite.mockHTTPClient.AssertNotCalled(suite.T(), "Do", mock.Anything)
+}
+
+func (suite *WebhookServiceTestSuite) TestRetryDelay() {
+	suite.Equal(baseRetryDelay, retryDelay(1))
+	suite.Equal(2*baseRetryDelay, retryDelay(2))
+	suite.Equal(maxRetryDelay, retryDelay(20))
+}
+
+func (suite *WebhookServiceTestSuite) TestDeleteExpiredEvents() {
+	suite.mockStore.On("DeleteEventsBefore", mock.Anything, testSubscriptionID, suite.now.Add(-time.Hour)).
+		Return(3, nil).Once()
+
+	deleted, err := suite.service.DeleteExpiredEvents(context.Background())
+
+	suite.NoError(err)
+	suite.Equal(3, deleted)
+}
+
+func (suite *WebhookServiceTestSuite) TestGetSubscription_Failing() {
+	oldest := suite.now.Add(-time.Hour)
+	lastAttemptAt := suite.now.Add(-time.Minute)
+	head := pendingEvent("event-1")
+	head.Attempts = 3
+	head.LastError = "the endpoint responded with status 503"
+	head.LastAttemptAt = &lastAttemptAt
+	suite.mockStore.On("GetDeliveryStats", mock.Anything, testSubscriptionID).Return(&deliveryStats{
+		Counts: map[DeliveryStatus]int{DeliveryStatusPending: 4, DeliveryStatusDelivered: 10,
+			DeliveryStatusDropped: 1},
+		OldestPendingAt: &oldest,
+	}, nil).Once()
+	suite.mockStore.On("ListEvents", mock.Anything, testSubscriptionID, DeliveryStatusPending, 1).
+		Return([]WebhookEvent{head}, nil).Once()
+
+	subscription, svcErr := suite.service.GetSubscription(context.Background(), testSubscriptionID)
+
+	suite.Nil(svcErr)
+	suite.Equal(testEndpointURL, subscription.EndpointURL)
+	suite.Equal(int64(3600), subscription.Retention)
+	suite.Equal(HealthStatusFailing, subscription.Health.Status)
+	suite.Equal(4, subscription.Health.PendingEvents)
+	suite.Equal(10, subscription.Health.DeliveredEvents)
+	suite.Equal(1, subscription.Health.DroppedEvents)
+	suite.Equal(3, subscription.Health.ConsecutiveFailures)
+	suite.Equal(head.LastError, subscription.Health.LastError)
+	suite.Equal(&oldest, subscription.Health.OldestPendingAt)
+}
+
+func (suite *WebhookServiceTestSuite) TestGetSubscription_NotFound() {
+	_, svcErr := suite.service.GetSubscription(context.Background(), "unknown")
+
+	suite.Equal(&ErrorSubscriptionNotFound, svcErr)
+}
+
+func (suite *WebhookServiceTestSuite) TestGetSubscription_OtherTenant() {
+	_, svcErr := suite.service.GetSubscription(context.Background(), "tenant-sink")
+	suite.Equal(&ErrorSubscriptionNotFound, svcErr)
+
+	_, svcErr = suite.service.GetSubscription(sysContext.WithTenantID(context.Background(), "tenant-1"),
+		testSubscriptionID)
+	suite.Equal(&ErrorSubscriptionNotFound, svcErr)
+}
+
+func (suite *WebhookServiceTestSuite) TestGetSubscriptionList() {
+	suite.mockStore.On("GetDeliveryStats", mock.Anything, mock.Anything).
+		Return(&deliveryStats{Counts: map[DeliveryStatus]int{}}, nil).Twice()
+
+	response, svcErr := suite.service.GetSubscriptionList(context.Background())
+
+	suite.Nil(svcErr)
+	suite.Equal(2, response.TotalResults)
+	suite.Equal(HealthStatusHealthy, response.Subscriptions[0].Health.Status)
+	suite.Equal([]string{string(event.EventTypeConfigDriftDetected)}, response.Subscriptions[1].Events)
+}
+
+func (suite *WebhookServiceTestSuite) TestGetSubscriptionList_StoreError() {
+	suite.mockStore.On("GetDeliveryStats", mock.Anything, testSubscriptionID).
+		Return(nil, errors.New("db down")).Once()
+
+	_, svcErr := suite.service.GetSubscriptionList(context.Background())
+
+	suite.Equal(&serviceerror.InternalServerError, svcErr)
+}
+
+func (suite *WebhookServiceTestSuite) TestRedeliverEvents() {
+	from := suite.now.Add(-30 * time.Minute)
+	suite.mockStore.On("RequeueEvents", mock.Anything, testSubscriptionID, from).Return(7, nil).Once()
+
+	response, svcErr := suite.service.RedeliverEvents(context.Background(), testSubscriptionID, from)
+
+	suite.Nil(svcErr)
+	suite.Equal(7, response.Requeued)
+}
+
+func (suite *WebhookServiceTestSuite) TestRedeliverEvents_InvalidFrom() {
+	_, svcErr := suite.service.RedeliverEvents(context.Background(), testSubscriptionID,
+		suite.now.Add(time.Minute))
+	suite.Equal(&ErrorInvalidFromParameter, svcErr)
+
+	_, svcErr = suite.service.RedeliverEvents(context.Background(), "unknown", suite.now)
+	suite.Equal(&ErrorSubscriptionNotFound, svcErr)
+}
+
+func (suite *WebhookServiceTestSuite) TestScheduledDelivery() {
+	ctx := sysContext.WithTenantID(context.Background(), "tenant-1")
+	inTenant := mock.MatchedBy(func(ctx context.Context) bool { return sysContext.GetTenantID(ctx) == "tenant-1" })
+	mockService := NewWebhookServiceInterfaceMock(suite.T())
+	mockService.On("DeleteExpiredEvents", inTenant).Return(1, nil).Once()
+	mockService.On("DeliverPendingEvents", inTenant).Return(2, nil).Once()
+
+	suite.NoError(newScheduledDelivery(mockService)(ctx))
+}
+
+func (suite *WebhookServiceTestSuite) TestScheduledDelivery_DeliversAfterCleanupFailure() {
+	mockService := NewWebhookServiceInterfaceMock(suite.T())
+	mockService.On("DeleteExpiredEvents", mock.Anything).Return(0, errors.New("db down")).Once()
+	mockService.On("DeliverPendingEvents", mock.Anything).Return(2, nil).Once()
+
+	suite.ErrorContains(newScheduledDelivery(mockService)(context.Background()), "db down")
+}
+
+func (suite *WebhookServiceTestSuite) TestSubscriberQueuesEvent() {
+	mockService := NewWebhookServiceInterfaceMock(suite.T())
+	evt := event.NewEvent("trace-1", string(event.EventTypeBreakGlassUsed), event.ComponentBreakGlass).
+		WithTenantID("tenant-1")
+	mockService.On("EnqueueEvent", mock.MatchedBy(func(ctx context.Context) bool {
+		return sysContext.GetTenantID(ctx) == "tenant-1"
+	}), evt).Return(nil).Once()
+	sub := newWebhookSubscriber(mockService)
+
+	suite.Equal([]event.EventCategory{event.CategoryAudit, event.CategoryOperations}, sub.GetCategories())
+	suite.NoError(sub.OnEvent(evt))
+}
@@ -0,0 +1,297 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package webhook
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/thunder-id/thunderid/internal/system/config"
+	sysContext "github.com/thunder-id/thunderid/internal/system/context"
+	"github.com/thunder-id/thunderid/internal/system/database/provider"
+	dbutils "github.com/thunder-id/thunderid/internal/system/database/utils"
+)
+
+var getDBProvider = provider.GetDBProvider
+
+// webhookEventStoreInterface defines the interface for webhook event store operations.
+type webhookEventStoreInterface interface {
+	CreateEvent(ctx context.Context, webhookEvent WebhookEvent) error
+	CountEvents(ctx context.Context, subscriptionID string, status DeliveryStatus) (int, error)
+	ListEvents(ctx context.Context, subscriptionID string, status DeliveryStatus, limit int) ([]WebhookEvent, error)
+	MarkDelivered(ctx context.Context, id string, deliveredAt time.Time) error
+	RecordFailure(ctx context.Context, id, lastError string, attemptedAt, nextAttemptAt time.Time) error
+	DropOldestEvents(ctx context.Context, subscriptionID string, count int) (int, error)
+	RequeueEvents(ctx context.Context, subscriptionID string, from time.Time) (int, error)
+	DeleteEventsBefore(ctx context.Context, subscriptionID string, before time.Time) (int, error)
+	GetDeliveryStats(ctx context.Context, subscriptionID string) (*deliveryStats, error)
+}
+
+// webhookEventStore is the default implementation of webhookEventStoreInterface.
+type webhookEventStore struct {
+	dbProvider   provider.DBProviderInterface
+	deploymentID string
+}
+
+// newWebhookEventStore creates a new instance of webhookEventStore.
+func newWebhookEventStore() webhookEventStoreInterface {
+	return &webhookEventStore{
+		dbProvider:   getDBProvider(),
+		deploymentID: config.GetServerRuntime().Config.Server.Identifier,
+	}
+}
+
+// CreateEvent persists a new event in the queue of a subscription.
+func (s *webhookEventStore) CreateEvent(ctx context.Context, webhookEvent WebhookEvent) error {
+	dbClient, err := s.dbProvider.GetConfigDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	if _, err := dbClient.ExecuteContext(ctx, queryCreateWebhookEvent, webhookEvent.ID,
+		webhookEvent.SubscriptionID, webhookEvent.EventType, webhookEvent.Payload, string(webhookEvent.Status),
+		webhookEvent.Attempts, webhookEvent.CreatedAt, sysContext.ScopeDeploymentID(ctx, s.deploymentID)); err != nil {
+		return fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	return nil
+}
+
+// CountEvents counts the events of a subscription in a status.
+func (s *webhookEventStore) CountEvents(ctx context.Context, subscriptionID string,
+	status DeliveryStatus) (int, error) {
+	dbClient, err := s.dbProvider.GetConfigDBClient()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	results, err := dbClient.QueryContext(ctx, queryCountWebhookEventsByStatus, subscriptionID, string(status),
+		sysContext.ScopeDeploymentID(ctx, s.deploymentID))
+	if err != nil {
+		return 0, fmt.Errorf("failed to execute query: %w", err)
+	}
+	if len(results) == 0 {
+		return 0, nil
+	}
+
+	return parseInt(results[0]["total"]), nil
+}
+
+// ListEvents retrieves the oldest events of a subscription in a status.
+func (s *webhookEventStore) ListEvents(ctx context.Context, subscriptionID string, status DeliveryStatus,
+	limit int) ([]WebhookEvent, error) {
+	dbClient, err := s.dbProvider.GetConfigDBClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	results, err := dbClient.QueryContext(ctx, queryListWebhookEventsByStatus, subscriptionID, string(status),
+		sysContext.ScopeDeploymentID(ctx, s.deploymentID), limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	webhookEvents := make([]WebhookEvent, 0, len(results))
+	for _, row := range results {
+		webhookEvent, err := buildWebhookEventFromResultRow(row)
+		if err != nil {
+			return nil, fmt.Errorf("failed to build webhook event from result row: %w", err)
+		}
+		webhookEvents = append(webhookEvents, *webhookEvent)
+	}
+
+	return webhookEvents, nil
+}
+
+// MarkDelivered records that the endpoint of the subscription accepted an event.
+func (s *webhookEventStore) MarkDelivered(ctx context.Context, id string, deliveredAt time.Time) error {
+	dbClient, err := s.dbProvider.GetConfigDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	if _, err := dbClient.ExecuteContext(ctx, queryMarkWebhookEventDelivered, id,
+		string(DeliveryStatusDelivered), deliveredAt, sysContext.ScopeDeploymentID(ctx, s.deploymentID)); err != nil {
+		return fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	return nil
+}
+
+// RecordFailure records a failed delivery of an event and the time of its next attempt.
+func (s *webhookEventStore) RecordFailure(ctx context.Context, id, lastError string,
+	attemptedAt, nextAttemptAt time.Time) error {
+	dbClient, err := s.dbProvider.GetConfigDBClient()
+	if err != nil {
+		return fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	if _, err := dbClient.ExecuteContext(ctx, queryRecordWebhookEventFailure, id, lastError, attemptedAt,
+		nextAttemptAt, sysContext.ScopeDeploymentID(ctx, s.deploymentID)); err != nil {
+		return fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	return nil
+}
+
+// DropOldestEvents marks the given number of the oldest pending events of a subscription as dropped and
+// returns the number of events dropped.
+func (s *webhookEventStore) DropOldestEvents(ctx context.Context, subscriptionID string, count int) (int, error) {
+	dbClient, err := s.dbProvider.GetConfigDBClient()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	dropped, err := dbClient.ExecuteContext(ctx, queryDropOldestWebhookEvents, subscriptionID,
+		string(DeliveryStatusPending), string(DeliveryStatusDropped),
+		sysContext.ScopeDeploymentID(ctx, s.deploymentID), count)
+	if err != nil {
+		return 0, fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	return int(dropped), nil
+}
+
+// RequeueEvents queues the delivered and dropped events of a subscription created from the given time
+// again and returns the number of events queued.
+func (s *webhookEventStore) RequeueEvents(ctx context.Context, subscriptionID string,
+	from time.Time) (int, error) {
+	dbClient, err := s.dbProvider.GetConfigDBClient()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	requeued, err := dbClient.ExecuteContext(ctx, queryRequeueWebhookEvents, subscriptionID, from,
+		string(DeliveryStatusPending), sysContext.ScopeDeploymentID(ctx, s.deploymentID))
+	if err != nil {
+		return 0, fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	return int(requeued), nil
+}
+
+// DeleteEventsBefore deletes the events of a subscription created before the given time and returns the
+// number of events deleted.
+func (s *webhookEventStore) DeleteEventsBefore(ctx context.Context, subscriptionID string,
+	before time.Time) (int, error) {
+	dbClient, err := s.dbProvider.GetConfigDBClient()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	deleted, err := dbClient.ExecuteContext(ctx, queryDeleteWebhookEventsBefore, subscriptionID, before,
+		sysContext.ScopeDeploymentID(ctx, s.deploymentID))
+	if err != nil {
+		return 0, fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	return int(deleted), nil
+}
+
+// GetDeliveryStats counts the events of a subscription by status.
+func (s *webhookEventStore) GetDeliveryStats(ctx context.Context, subscriptionID string) (*deliveryStats, error) {
+	dbClient, err := s.dbProvider.GetConfigDBClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	results, err := dbClient.QueryContext(ctx, queryGetWebhookDeliveryStats, subscriptionID,
+		sysContext.ScopeDeploymentID(ctx, s.deploymentID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	stats := &deliveryStats{Counts: make(map[DeliveryStatus]int, len(results))}
+	for _, row := range results {
+		status, _ := row["status"].(string)
+		stats.Counts[DeliveryStatus(status)] = parseInt(row["total"])
+		if status == string(DeliveryStatusPending) && row["oldest_created_at"] != nil {
+			oldestPendingAt, err := dbutils.ParseTimeField(row["oldest_created_at"], "oldest_created_at")
+			if err != nil {
+				return nil, err
+			}
+			stats.OldestPendingAt = &oldestPendingAt
+		}
+		if row["last_delivered_at"] != nil {
+			lastDeliveredAt, err := dbutils.ParseTimeField(row["last_delivered_at"], "last_delivered_at")
+			if err != nil {
+				return nil, err
+			}
+			if stats.LastDeliveredAt == nil || lastDeliveredAt.After(*stats.LastDeliveredAt) {
+				stats.LastDeliveredAt = &lastDeliveredAt
+			}
+		}
+	}
+
+	return stats, nil
+}
+
+// buildWebhookEventFromResultRow constructs a WebhookEvent from a database result row.
+func buildWebhookEventFromResultRow(row map[string]interface{}) (*WebhookEvent, error) {
+	id, ok := row["id"].(string)
+	if !ok {
+		return nil, fmt.Errorf("failed to parse id as string")
+	}
+	createdAt, err := dbutils.ParseTimeField(row["created_at"], "created_at")
+	if err != nil {
+		return nil, err
+	}
+
+	webhookEvent := &WebhookEvent{
+		ID:        id,
+		Payload:   dbutils.ParseStringOrBytes(row["payload"]),
+		Attempts:  parseInt(row["attempts"]),
+		LastError: dbutils.ParseStringOrBytes(row["last_error"]),
+		CreatedAt: createdAt,
+	}
+	webhookEvent.SubscriptionID, _ = row["subscription_id"].(string)
+	webhookEvent.EventType, _ = row["event_type"].(string)
+	status, _ := row["status"].(string)
+	webhookEvent.Status = DeliveryStatus(status)
+
+	for column, target := range map[string]**time.Time{
+		"last_attempt_at": &webhookEvent.LastAttemptAt,
+		"next_attempt_at": &webhookEvent.NextAttemptAt,
+		"delivered_at":    &webhookEvent.DeliveredAt,
+	} {
+		if row[column] == nil {
+			continue
+		}
+		parsed, err := dbutils.ParseTimeField(row[column], column)
+		if err != nil {
+			return nil, err
+		}
+		*target = &parsed
+	}
+
+	return webhookEvent, nil
+}
+
+// parseInt returns an integer column value that the driver returns either as an int64 or as a float64.
+func parseInt(value interface{}) int {
+	switch v := value.(type) {
+	case int64:
+		return int(v)
+	case float64:
+		return int(v)
+	default:
+		return 0
+	}
+}
@@ -0,0 +1,89 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package webhook
+
+import (
+	dbmodel "github.com/thunder-id/thunderid/internal/system/database/model"
+)
+
+const webhookEventColumns = `ID, SUBSCRIPTION_ID, EVENT_TYPE, PAYLOAD, STATUS, ATTEMPTS, LAST_ERROR, ` +
+	`LAST_ATTEMPT_AT, NEXT_ATTEMPT_AT, DELIVERED_AT, CREATED_AT`
+
+var (
+	// queryCreateWebhookEvent is the query to queue a new event for a subscription.
+	queryCreateWebhookEvent = dbmodel.DBQuery{
+		ID: "WHK-WEBHOOK_MGT-01",
+		Query: `INSERT INTO "WEBHOOK_EVENT" (ID, SUBSCRIPTION_ID, EVENT_TYPE, PAYLOAD, STATUS, ATTEMPTS, ` +
+			`CREATED_AT, DEPLOYMENT_ID) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`,
+	}
+	// queryCountWebhookEventsByStatus is the query to count the events of a subscription in a status.
+	queryCountWebhookEventsByStatus = dbmodel.DBQuery{
+		ID: "WHK-WEBHOOK_MGT-02",
+		Query: `SELECT COUNT(*) AS total FROM "WEBHOOK_EVENT" WHERE SUBSCRIPTION_ID = $1 AND STATUS = $2 ` +
+			`AND DEPLOYMENT_ID = $3`,
+	}
+	// queryListWebhookEventsByStatus is the query to list the oldest events of a subscription in a status.
+	queryListWebhookEventsByStatus = dbmodel.DBQuery{
+		ID: "WHK-WEBHOOK_MGT-03",
+		Query: `SELECT ` + webhookEventColumns + ` FROM "WEBHOOK_EVENT" WHERE SUBSCRIPTION_ID = $1 ` +
+			`AND STATUS = $2 AND DEPLOYMENT_ID = $3 ORDER BY CREATED_AT, ID LIMIT $4`,
+	}
+	// queryMarkWebhookEventDelivered is the query to record the successful delivery of an event.
+	queryMarkWebhookEventDelivered = dbmodel.DBQuery{
+		ID: "WHK-WEBHOOK_MGT-04",
+		Query: `UPDATE "WEBHOOK_EVENT" SET STATUS = $2, ATTEMPTS = ATTEMPTS + 1, LAST_ERROR = NULL, ` +
+			`LAST_ATTEMPT_AT = $3, NEXT_ATTEMPT_AT = NULL, DELIVERED_AT = $3 WHERE ID = $1 AND DEPLOYMENT_ID = $4`,
+	}
+	// queryRecordWebhookEventFailure is the query to record a failed delivery of an event and the time of
+	// its next attempt.
+	queryRecordWebhookEventFailure = dbmodel.DBQuery{
+		ID: "WHK-WEBHOOK_MGT-05",
+		Query: `UPDATE "WEBHOOK_EVENT" SET ATTEMPTS = ATTEMPTS + 1, LAST_ERROR = $2, LAST_ATTEMPT_AT = $3, ` +
+			`NEXT_ATTEMPT_AT = $4 WHERE ID = $1 AND DEPLOYMENT_ID = $5`,
+	}
+	// queryDropOldestWebhookEvents is the query to drop the oldest pending events of a subscription.
+	queryDropOldestWebhookEvents = dbmodel.DBQuery{
+		ID: "WHK-WEBHOOK_MGT-06",
+		Query: `UPDATE "WEBHOOK_EVENT" SET STATUS = $3 WHERE DEPLOYMENT_ID = $4 AND ID IN (` +
+			`SELECT ID FROM "WEBHOOK_EVENT" WHERE SUBSCRIPTION_ID = $1 AND STATUS = $2 AND DEPLOYMENT_ID = $4 ` +
+			`ORDER BY CREATED_AT, ID LIMIT $5)`,
+	}
+	// queryRequeueWebhookEvents is the query to queue the delivered and dropped events of a subscription
+	// created from a time again.
+	queryRequeueWebhookEvents = dbmodel.DBQuery{
+		ID: "WHK-WEBHOOK_MGT-07",
+		Query: `UPDATE "WEBHOOK_EVENT" SET STATUS = $3, ATTEMPTS = 0, LAST_ERROR = NULL, ` +
+			`LAST_ATTEMPT_AT = NULL, NEXT_ATTEMPT_AT = NULL WHERE SUBSCRIPTION_ID = $1 AND CREATED_AT >= $2 ` +
+			`AND STATUS <> $3 AND DEPLOYMENT_ID = $4`,
+	}
+	// queryDeleteWebhookEventsBefore is the query to delete the events of a subscription created before
+	// a time.
+	queryDeleteWebhookEventsBefore = dbmodel.DBQuery{
+		ID: "WHK-WEBHOOK_MGT-08",
+		Query: `DELETE FROM "WEBHOOK_EVENT" WHERE SUBSCRIPTION_ID = $1 AND CREATED_AT < $2 ` +
+			`AND DEPLOYMENT_ID = $3`,
+	}
+	// queryGetWebhookDeliveryStats is the query to count the events of a subscription by status.
+	queryGetWebhookDeliveryStats = dbmodel.DBQuery{
+		ID: "WHK-WEBHOOK_MGT-09",
+		Query: `SELECT STATUS, COUNT(*) AS total, MIN(CREATED_AT) AS oldest_created_at, ` +
+			`MAX(DELIVERED_AT) AS last_delivered_at FROM "WEBHOOK_EVENT" WHERE SUBSCRIPTION_ID = $1 ` +
+			`AND DEPLOYMENT_ID = $2 GROUP BY STATUS`,
+	}
+)
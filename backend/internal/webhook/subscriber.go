@@ -0,0 +1,80 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package webhook
+
+import (
+	"context"
+
+	sysContext "github.com/thunder-id/thunderid/internal/system/context"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/internal/system/observability/event"
+	"github.com/thunder-id/thunderid/internal/system/observability/subscriber"
+)
+
+// webhookSubscriber queues the events of the event bus for the webhook subscriptions.
+type webhookSubscriber struct {
+	service WebhookServiceInterface
+	logger  *log.Logger
+}
+
+var _ subscriber.SubscriberInterface = (*webhookSubscriber)(nil)
+
+// newWebhookSubscriber creates a new instance of webhookSubscriber.
+func newWebhookSubscriber(service WebhookServiceInterface) *webhookSubscriber {
+	return &webhookSubscriber{
+		service: service,
+		logger:  log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName)),
+	}
+}
+
+// GetID returns the unique identifier of the subscriber.
+func (s *webhookSubscriber) GetID() string {
+	return subscriberID
+}
+
+// GetCategories returns the audit and operational categories, which carry the events offered to webhook
+// subscriptions.
+func (s *webhookSubscriber) GetCategories() []event.EventCategory {
+	return []event.EventCategory{event.CategoryAudit, event.CategoryOperations}
+}
+
+// OnEvent queues an event for the subscriptions of the tenant the event occurred in that receive its type.
+func (s *webhookSubscriber) OnEvent(evt *event.Event) error {
+	ctx := sysContext.WithTenantID(context.Background(), evt.TenantID)
+	if err := s.service.EnqueueEvent(ctx, evt); err != nil {
+		s.logger.Error("Failed to queue webhook event", log.String("eventType", evt.Type), log.Error(err))
+		return err
+	}
+	return nil
+}
+
+// Close releases no resources.
+func (s *webhookSubscriber) Close() error {
+	return nil
+}
+
+// IsEnabled reports true, since the subscriber is only created when webhooks are enabled.
+func (s *webhookSubscriber) IsEnabled() bool {
+	return true
+}
+
+// Initialize needs no setup.
+func (s *webhookSubscriber) Initialize() error {
+	return nil
+}
@@ -0,0 +1,136 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package wso2migration
+
+const loggerComponentName = "WSO2MigrationService"
+
+// maxMigrationItems is the maximum number of service providers, users and roles, together, that a single
+// migration request can carry.
+const maxMigrationItems = 1000
+
+// defaultCredentialAttribute is the user attribute the migrated passwords are stored in when the request
+// does not name one.
+const defaultCredentialAttribute = "password"
+
+// primaryUserStoreDomain is the domain of the primary user store of WSO2 Identity Server. User names of
+// other user stores are prefixed with their domain.
+const primaryUserStoreDomain = "PRIMARY"
+
+// ResourceType is the type of a migrated resource.
+type ResourceType string
+
+// Resource types.
+const (
+	// ResourceTypeApplication is a WSO2 Identity Server service provider migrated to an application.
+	ResourceTypeApplication ResourceType = "application"
+	// ResourceTypeUser is a WSO2 Identity Server user.
+	ResourceTypeUser ResourceType = "user"
+	// ResourceTypeRole is a WSO2 Identity Server role.
+	ResourceTypeRole ResourceType = "role"
+)
+
+// Compatibility is how completely a resource can be migrated.
+type Compatibility string
+
+// Compatibility levels.
+const (
+	// CompatibilityFull indicates that every setting of the resource is migrated.
+	CompatibilityFull Compatibility = "COMPATIBLE"
+	// CompatibilityPartial indicates that the resource is migrated, but some settings are dropped or
+	// changed. The warnings of the item describe them.
+	CompatibilityPartial Compatibility = "PARTIAL"
+	// CompatibilityNone indicates that the resource cannot be migrated. It is skipped when the migration
+	// is committed.
+	CompatibilityNone Compatibility = "INCOMPATIBLE"
+)
+
+// Result is the outcome of committing the migration of a resource.
+type Result string
+
+// Results.
+const (
+	// ResultCreated indicates that the resource was created.
+	ResultCreated Result = "CREATED"
+	// ResultFailed indicates that the resource could not be created.
+	ResultFailed Result = "FAILED"
+	// ResultSkipped indicates that the resource was not created as it is incompatible.
+	ResultSkipped Result = "SKIPPED"
+)
+
+// NoteSeverity is the severity of a note on a migrated resource.
+type NoteSeverity string
+
+// Note severities.
+const (
+	// NoteSeverityWarning marks a setting that is dropped or changed by the migration.
+	NoteSeverityWarning NoteSeverity = "WARNING"
+	// NoteSeverityError marks a setting that prevents the resource from being migrated.
+	NoteSeverityError NoteSeverity = "ERROR"
+)
+
+// Digest functions of WSO2 Identity Server user stores.
+const (
+	digestPlainText = "PLAIN_TEXT"
+	digestSHA256    = "SHA-256"
+	digestPBKDF2    = "PBKDF2"
+	digestBCrypt    = "BCRYPT"
+)
+
+// pbkdf2HMACSHA256 is the only PBKDF2 pseudo-random function the PBKDF2 hashes of Thunder use.
+const pbkdf2HMACSHA256 = "PBKDF2WithHmacSHA256"
+
+// WSO2 Identity Server inbound authentication types and OAuth settings.
+const (
+	inboundAuthTypeOAuth2 = "oauth2"
+	oauthVersion2         = "OAuth-2.0"
+	callbackURLRegexp     = "regexp="
+)
+
+// scimEnterpriseUserSchema is the schema URI of the SCIM enterprise user extension.
+const scimEnterpriseUserSchema = "urn:ietf:params:scim:schemas:extension:enterprise:2.0:User"
+
+// WSO2 Identity Server local claim URIs.
+const (
+	claimUsername       = "http://wso2.org/claims/username"
+	claimEmail          = "http://wso2.org/claims/emailaddress"
+	claimGivenName      = "http://wso2.org/claims/givenname"
+	claimLastName       = "http://wso2.org/claims/lastname"
+	claimFullName       = "http://wso2.org/claims/fullname"
+	claimDisplayName    = "http://wso2.org/claims/displayName"
+	claimNickname       = "http://wso2.org/claims/nickname"
+	claimMobile         = "http://wso2.org/claims/mobile"
+	claimTelephone      = "http://wso2.org/claims/telephone"
+	claimLocale         = "http://wso2.org/claims/local"
+	claimTitle          = "http://wso2.org/claims/title"
+	claimEmployeeNumber = "http://wso2.org/claims/employeeNumber"
+	claimDepartment     = "http://wso2.org/claims/department"
+	claimOrganization   = "http://wso2.org/claims/organization"
+)
+
+// defaultClaimMappings maps the WSO2 Identity Server local claims to the attributes of the default person
+// user type. Requests can override or extend the mappings.
+var defaultClaimMappings = map[string]string{
+	claimUsername:  "username",
+	claimEmail:     "email",
+	claimGivenName: "given_name",
+	claimLastName:  "family_name",
+	claimFullName:  "name",
+	claimMobile:    "mobileNumber",
+	claimTelephone: "phone_number",
+}
@@ -0,0 +1,119 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package wso2migration
+
+import (
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/i18n/core"
+)
+
+// Client errors for WSO2 Identity Server migrations.
+var (
+	// ErrorInvalidRequestFormat is the error returned when the request body is malformed.
+	ErrorInvalidRequestFormat = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "WIM-1001",
+		Error: core.I18nMessage{
+			Key:          "error.wso2migrationservice.invalid_request_format",
+			DefaultValue: "Invalid request format",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.wso2migrationservice.invalid_request_format_description",
+			DefaultValue: "The request body is malformed or contains invalid data",
+		},
+	}
+	// ErrorMissingOUID is the error returned when the organization unit is not given.
+	ErrorMissingOUID = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "WIM-1002",
+		Error: core.I18nMessage{
+			Key:          "error.wso2migrationservice.missing_ou_id",
+			DefaultValue: "Missing organization unit ID",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.wso2migrationservice.missing_ou_id_description",
+			DefaultValue: "The organization unit to migrate the resources to must be provided",
+		},
+	}
+	// ErrorNothingToMigrate is the error returned when the request carries no resources.
+	ErrorNothingToMigrate = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "WIM-1003",
+		Error: core.I18nMessage{
+			Key:          "error.wso2migrationservice.nothing_to_migrate",
+			DefaultValue: "Nothing to migrate",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.wso2migrationservice.nothing_to_migrate_description",
+			DefaultValue: "At least one service provider, user or role must be provided",
+		},
+	}
+	// ErrorTooManyItems is the error returned when the request carries more resources than allowed.
+	ErrorTooManyItems = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "WIM-1004",
+		Error: core.I18nMessage{
+			Key:          "error.wso2migrationservice.too_many_items",
+			DefaultValue: "Too many resources",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.wso2migrationservice.too_many_items_description",
+			DefaultValue: "A migration request can carry at most 1000 resources",
+		},
+	}
+	// ErrorMissingUserType is the error returned when users are migrated without a user type.
+	ErrorMissingUserType = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "WIM-1005",
+		Error: core.I18nMessage{
+			Key:          "error.wso2migrationservice.missing_user_type",
+			DefaultValue: "Missing user type",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.wso2migrationservice.missing_user_type_description",
+			DefaultValue: "The user type to migrate the users to must be provided",
+		},
+	}
+	// ErrorOrganizationUnitNotFound is the error returned when the organization unit does not exist.
+	ErrorOrganizationUnitNotFound = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "WIM-1006",
+		Error: core.I18nMessage{
+			Key:          "error.wso2migrationservice.ou_not_found",
+			DefaultValue: "Organization unit not found",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.wso2migrationservice.ou_not_found_description",
+			DefaultValue: "The organization unit to migrate the resources to does not exist",
+		},
+	}
+	// ErrorUserTypeNotFound is the error returned when the user type does not exist.
+	ErrorUserTypeNotFound = serviceerror.ServiceError{
+		Type: serviceerror.ClientErrorType,
+		Code: "WIM-1007",
+		Error: core.I18nMessage{
+			Key:          "error.wso2migrationservice.user_type_not_found",
+			DefaultValue: "User type not found",
+		},
+		ErrorDescription: core.I18nMessage{
+			Key:          "error.wso2migrationservice.user_type_not_found_description",
+			DefaultValue: "The user type to migrate the users to does not exist",
+		},
+	}
+)
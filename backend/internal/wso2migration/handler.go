@@ -0,0 +1,63 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package wso2migration
+
+import (
+	"net/http"
+
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	sysutils "github.com/thunder-id/thunderid/internal/system/utils"
+)
+
+// wso2MigrationHandler is the handler for WSO2 Identity Server migrations.
+type wso2MigrationHandler struct {
+	migrationService WSO2MigrationServiceInterface
+}
+
+// newWSO2MigrationHandler creates a new instance of wso2MigrationHandler.
+func newWSO2MigrationHandler(migrationService WSO2MigrationServiceInterface) *wso2MigrationHandler {
+	return &wso2MigrationHandler{
+		migrationService: migrationService,
+	}
+}
+
+// HandleMigrateRequest handles the request to migrate resources exported from WSO2 Identity Server.
+func (h *wso2MigrationHandler) HandleMigrateRequest(w http.ResponseWriter, r *http.Request) {
+	request, err := sysutils.DecodeJSONBody[MigrationRequest](r)
+	if err != nil {
+		sysutils.WriteServiceErrorResponse(w, &ErrorInvalidRequestFormat, clientErrorStatusCodes)
+		return
+	}
+
+	report, svcErr := h.migrationService.Migrate(r.Context(), *request)
+	if svcErr != nil {
+		sysutils.WriteServiceErrorResponse(w, svcErr, clientErrorStatusCodes)
+		return
+	}
+
+	sysutils.WriteSuccessResponse(w, http.StatusOK, report)
+}
+
+// clientErrorStatusCodes maps the client errors of the handler to HTTP status codes. Other client errors are
+// returned with 400 Bad Request.
+var clientErrorStatusCodes = map[string]int{
+	ErrorOrganizationUnitNotFound.Code:  http.StatusNotFound,
+	ErrorUserTypeNotFound.Code:          http.StatusNotFound,
+	serviceerror.ErrorUnauthorized.Code: http.StatusForbidden,
+}
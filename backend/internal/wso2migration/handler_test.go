@@ -0,0 +1,111 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package wso2migration
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/system/error/apierror"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+)
+
+type HandlerTestSuite struct {
+	suite.Suite
+	mockService *WSO2MigrationServiceInterfaceMock
+	handler     *wso2MigrationHandler
+}
+
+func TestHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(HandlerTestSuite))
+}
+
+func (s *HandlerTestSuite) SetupTest() {
+	s.mockService = NewWSO2MigrationServiceInterfaceMock(s.T())
+	s.handler = newWSO2MigrationHandler(s.mockService)
+}
+
+func (s *HandlerTestSuite) TestHandleMigrateRequest() {
+	s.mockService.On("Migrate", mock.Anything, mock.MatchedBy(func(r MigrationRequest) bool {
+		return r.OUID == "ou-1" && r.UserType == "person" && len(r.Users) == 1 &&
+			r.Users[0].Emails[0].Value == "alice@example.com" && !r.Commit
+	})).Return(&MigrationReport{
+		Summary: MigrationSummary{Total: 1, Compatible: 1},
+		Items: []MigrationItem{{ResourceType: ResourceTypeUser, SourceName: "alice",
+			Compatibility: CompatibilityFull}},
+	}, nil)
+
+	req := httptest.NewRequest(http.MethodPost, "/migration/wso2is", strings.NewReader(
+		`{"ouId":"ou-1","userType":"person","users":[{"id":"u-1","userName":"alice",`+
+			`"emails":["alice@example.com"]}]}`))
+	rr := httptest.NewRecorder()
+	s.handler.HandleMigrateRequest(rr, req)
+
+	s.Equal(http.StatusOK, rr.Code)
+	var body MigrationReport
+	s.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &body))
+	s.False(body.Committed)
+	s.Equal(1, body.Summary.Compatible)
+	s.Equal(CompatibilityFull, body.Items[0].Compatibility)
+}
+
+func (s *HandlerTestSuite) TestHandleMigrateRequest_InvalidBody() {
+	req := httptest.NewRequest(http.MethodPost, "/migration/wso2is", strings.NewReader(`{`))
+	rr := httptest.NewRecorder()
+	s.handler.HandleMigrateRequest(rr, req)
+
+	s.Equal(http.StatusBadRequest, rr.Code)
+	var body apierror.ErrorResponse
+	s.Require().NoError(json.Unmarshal(rr.Body.Bytes(), &body))
+	s.Equal(ErrorInvalidRequestFormat.Code, body.Code)
+}
+
+func (s *HandlerTestSuite) TestHandleMigrateRequest_ServiceErrors() {
+	testCases := []struct {
+		name     string
+		svcErr   *serviceerror.ServiceError
+		expected int
+	}{
+		{"MissingOU", &ErrorMissingOUID, http.StatusBadRequest},
+		{"OUNotFound", &ErrorOrganizationUnitNotFound, http.StatusNotFound},
+		{"UserTypeNotFound", &ErrorUserTypeNotFound, http.StatusNotFound},
+		{"Unauthorized", &serviceerror.ErrorUnauthorized, http.StatusForbidden},
+		{"ServerError", &serviceerror.InternalServerError, http.StatusInternalServerError},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			mockService := NewWSO2MigrationServiceInterfaceMock(s.T())
+			mockService.On("Migrate", mock.Anything, mock.Anything).Return(nil, tc.svcErr)
+			handler := newWSO2MigrationHandler(mockService)
+
+			req := httptest.NewRequest(http.MethodPost, "/migration/wso2is", strings.NewReader(`{}`))
+			rr := httptest.NewRecorder()
+			handler.HandleMigrateRequest(rr, req)
+
+			s.Equal(tc.expected, rr.Code)
+		})
+	}
+}
@@ -0,0 +1,84 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package wso2migration
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/thunder-id/thunderid/internal/application"
+	"github.com/thunder-id/thunderid/internal/entity"
+	"github.com/thunder-id/thunderid/internal/entitytype"
+	"github.com/thunder-id/thunderid/internal/ou"
+	"github.com/thunder-id/thunderid/internal/role"
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/cryptolab/hash"
+	"github.com/thunder-id/thunderid/internal/system/middleware"
+	"github.com/thunder-id/thunderid/internal/user"
+)
+
+// Initialize initializes the WSO2 Identity Server migration service and registers its routes.
+func Initialize(
+	mux *http.ServeMux,
+	applicationService application.ApplicationServiceInterface,
+	userService user.UserServiceInterface,
+	entityService entity.EntityServiceInterface,
+	entityTypeService entitytype.EntityTypeServiceInterface,
+	roleService role.RoleServiceInterface,
+	ouService ou.OrganizationUnitServiceInterface,
+) WSO2MigrationServiceInterface {
+	migrationService := newWSO2MigrationService(applicationService, userService, entityService,
+		entityTypeService, roleService, ouService,
+		getAcceptedCredentialFormats(config.GetServerRuntime().Config.Crypto.PasswordHashing))
+
+	migrationHandler := newWSO2MigrationHandler(migrationService)
+	registerRoutes(mux, migrationHandler)
+
+	return migrationService
+}
+
+// getAcceptedCredentialFormats returns the stored password formats the server verifies: the formats it
+// generates and the configured legacy formats.
+func getAcceptedCredentialFormats(cfg config.PasswordHashingConfig) map[hash.CredAlgorithm]struct{} {
+	formats := map[hash.CredAlgorithm]struct{}{
+		hash.SHA256:   {},
+		hash.PBKDF2:   {},
+		hash.ARGON2ID: {},
+	}
+	for _, format := range cfg.Legacy.Formats {
+		formats[hash.CredAlgorithm(strings.ToUpper(format.Algorithm))] = struct{}{}
+	}
+	return formats
+}
+
+// registerRoutes registers the routes for WSO2 Identity Server migrations.
+func registerRoutes(mux *http.ServeMux, migrationHandler *wso2MigrationHandler) {
+	opts := middleware.CORSOptions{
+		AllowedMethods:   []string{"POST"},
+		AllowedHeaders:   middleware.DefaultAllowedHeaders,
+		AllowCredentials: true,
+		MaxAge:           600,
+	}
+	mux.HandleFunc(middleware.WithCORS("POST /migration/wso2is",
+		migrationHandler.HandleMigrateRequest, opts))
+	mux.HandleFunc(middleware.WithCORS("OPTIONS /migration/wso2is",
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}, opts))
+}
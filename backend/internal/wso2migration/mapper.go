@@ -0,0 +1,349 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package wso2migration
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/thunder-id/thunderid/internal/entity"
+	"github.com/thunder-id/thunderid/internal/role"
+	"github.com/thunder-id/thunderid/internal/system/cryptolab/hash"
+)
+
+// addMapping records that a setting of the exported resource is migrated to the given setting.
+func (i *MigrationItem) addMapping(source, target string) {
+	i.Mappings = append(i.Mappings, FieldMapping{Source: source, Target: target})
+}
+
+// addWarning records a setting that is dropped or changed by the migration.
+func (i *MigrationItem) addWarning(field, message string) {
+	i.Notes = append(i.Notes, Note{Severity: NoteSeverityWarning, Field: field, Message: message})
+}
+
+// addError records a setting that prevents the resource from being migrated.
+func (i *MigrationItem) addError(field, message string) {
+	i.Notes = append(i.Notes, Note{Severity: NoteSeverityError, Field: field, Message: message})
+}
+
+// isMigratable reports whether no setting prevents the resource from being migrated.
+func (i *MigrationItem) isMigratable() bool {
+	for _, note := range i.Notes {
+		if note.Severity == NoteSeverityError {
+			return false
+		}
+	}
+	return true
+}
+
+// resolveCompatibility sets the compatibility of the item from its notes.
+func (i *MigrationItem) resolveCompatibility() {
+	switch {
+	case !i.isMigratable():
+		i.Compatibility = CompatibilityNone
+	case len(i.Notes) > 0:
+		i.Compatibility = CompatibilityPartial
+	default:
+		i.Compatibility = CompatibilityFull
+	}
+}
+
+// buildClaimMappings returns the default claim mappings extended and overridden by the given mappings.
+// A mapping to an empty attribute removes the default mapping of the claim.
+func buildClaimMappings(overrides map[string]string) map[string]string {
+	mappings := make(map[string]string, len(defaultClaimMappings)+len(overrides))
+	for claim, attribute := range defaultClaimMappings {
+		mappings[claim] = attribute
+	}
+	for claim, attribute := range overrides {
+		if attribute == "" {
+			delete(mappings, claim)
+			continue
+		}
+		mappings[claim] = attribute
+	}
+	return mappings
+}
+
+// splitUserName splits a WSO2 Identity Server user name into its user store domain and the user name.
+func splitUserName(userName string) (string, string) {
+	if domain, name, ok := strings.Cut(userName, "/"); ok {
+		return strings.ToUpper(domain), name
+	}
+	return primaryUserStoreDomain, userName
+}
+
+// scimClaim is the value of a SCIM attribute together with the local claim it is stored in.
+type scimClaim struct {
+	path  string
+	claim string
+	value string
+}
+
+// collectSCIMClaims returns the attributes of a SCIM user that carry a value, with the local claims they
+// are stored in.
+func collectSCIMClaims(scimUser SCIMUser, userName string, item *MigrationItem) []scimClaim {
+	claims := []scimClaim{{path: "userName", claim: claimUsername, value: userName}}
+	add := func(path, claim, value string) {
+		if value != "" {
+			claims = append(claims, scimClaim{path: path, claim: claim, value: value})
+		}
+	}
+	if scimUser.Name != nil {
+		add("name.givenName", claimGivenName, scimUser.Name.GivenName)
+		add("name.familyName", claimLastName, scimUser.Name.FamilyName)
+		add("name.formatted", claimFullName, scimUser.Name.Formatted)
+	}
+	add("displayName", claimDisplayName, scimUser.DisplayName)
+	add("nickName", claimNickname, scimUser.NickName)
+	add("locale", claimLocale, scimUser.Locale)
+	add("title", claimTitle, scimUser.Title)
+
+	if email, ok := selectValue(scimUser.Emails, ""); ok {
+		add("emails", claimEmail, email.Value)
+		if len(scimUser.Emails) > 1 {
+			item.addWarning("emails", "only the primary email address is migrated")
+		}
+	}
+	mobile, hasMobile := selectValue(scimUser.PhoneNumbers, "mobile")
+	if hasMobile {
+		add("phoneNumbers[type eq \"mobile\"]", claimMobile, mobile.Value)
+	}
+	telephone, hasTelephone := selectValue(scimUser.PhoneNumbers, "work")
+	if hasTelephone {
+		add("phoneNumbers[type eq \"work\"]", claimTelephone, telephone.Value)
+	}
+	for _, phoneNumber := range scimUser.PhoneNumbers {
+		if phoneNumber.Type != "mobile" && phoneNumber.Type != "work" {
+			item.addWarning("phoneNumbers", fmt.Sprintf("the %q phone number is not migrated", phoneNumber.Type))
+		}
+	}
+
+	if enterprise := scimUser.EnterpriseUser; enterprise != nil {
+		add(scimEnterpriseUserSchema+":employeeNumber", claimEmployeeNumber, enterprise.EmployeeNumber)
+		add(scimEnterpriseUserSchema+":department", claimDepartment, enterprise.Department)
+		add(scimEnterpriseUserSchema+":organization", claimOrganization, enterprise.Organization)
+	}
+	return claims
+}
+
+// selectValue returns the value of the given type from a multi-valued attribute, preferring the primary
+// value. An empty type selects among all values.
+func selectValue(values []SCIMMultiValue, valueType string) (SCIMMultiValue, bool) {
+	var selected SCIMMultiValue
+	found := false
+	for _, value := range values {
+		if valueType != "" && value.Type != valueType {
+			continue
+		}
+		if value.Primary {
+			return value, true
+		}
+		if !found {
+			selected = value
+			found = true
+		}
+	}
+	return selected, found
+}
+
+// mapUser maps a SCIM user to the attributes of a user. The attributes are nil when the user cannot be
+// migrated.
+func mapUser(scimUser SCIMUser, claimMappings map[string]string) (map[string]interface{}, *MigrationItem) {
+	item := &MigrationItem{ResourceType: ResourceTypeUser, SourceID: scimUser.ID, SourceName: scimUser.UserName}
+	domain, userName := splitUserName(scimUser.UserName)
+	if strings.TrimSpace(userName) == "" {
+		item.addError("userName", "the user has no user name")
+		return nil, item
+	}
+	if domain != primaryUserStoreDomain {
+		item.addWarning("userName", fmt.Sprintf("the %s user store domain is dropped from the user name", domain))
+	}
+
+	attributes := make(map[string]interface{})
+	for _, claim := range collectSCIMClaims(scimUser, userName, item) {
+		attribute, ok := claimMappings[claim.claim]
+		if !ok {
+			item.addWarning(claim.path, fmt.Sprintf("the %s claim is not mapped to a user attribute", claim.claim))
+			continue
+		}
+		attributes[attribute] = claim.value
+		item.addMapping(claim.path, attribute)
+	}
+
+	if scimUser.Active != nil && !*scimUser.Active {
+		item.addWarning("active", "the user is inactive in WSO2 Identity Server and is migrated as active")
+	}
+	if len(scimUser.Groups) > 0 {
+		item.addWarning("groups", "group memberships are not migrated")
+	}
+	return attributes, item
+}
+
+// mapCredential maps the stored password of a user. The returned value is either a plaintext password,
+// hashed with the configured algorithm when stored, or the hash in the stored credential format. It is nil
+// when the password cannot be migrated. Hashes are migrated only in formats the server accepts for
+// verification.
+func mapCredential(credential UserCredential, acceptedFormats map[hash.CredAlgorithm]struct{},
+	item *MigrationItem) interface{} {
+	digest := strings.ToUpper(strings.TrimSpace(credential.DigestFunction))
+	if digest == digestPlainText {
+		item.addMapping("passwordHash", "credentials (hashed with the configured algorithm)")
+		return credential.PasswordHash
+	}
+
+	stored, err := toStoredCredential(credential, digest)
+	if err != nil {
+		item.addWarning("passwordHash", fmt.Sprintf("%s; the user must reset the password", err.Error()))
+		return nil
+	}
+	if _, ok := acceptedFormats[stored.StorageAlgo]; !ok {
+		item.addWarning("passwordHash", fmt.Sprintf("the %s format is not accepted for verification by the "+
+			"server; the user must reset the password", stored.StorageAlgo))
+		return nil
+	}
+	item.addMapping("passwordHash", fmt.Sprintf("credentials (%s)", stored.StorageAlgo))
+	return []entity.StoredCredential{*stored}
+}
+
+// toStoredCredential converts a password hash of a user store to the stored credential format of the
+// matching algorithm.
+func toStoredCredential(credential UserCredential, digest string) (*entity.StoredCredential, error) {
+	switch digest {
+	case digestSHA256:
+		// The user store hashes the password followed by the salt string.
+		value, err := base64.StdEncoding.DecodeString(credential.PasswordHash)
+		if err != nil {
+			return nil, errors.New("the SHA-256 password hash is not base64 encoded")
+		}
+		return &entity.StoredCredential{
+			StorageAlgo:       hash.SHA256,
+			StorageAlgoParams: hash.CredParameters{Salt: hex.EncodeToString([]byte(credential.SaltValue))},
+			Value:             hex.EncodeToString(value),
+		}, nil
+	case digestPBKDF2:
+		return toPBKDF2Credential(credential)
+	case digestBCrypt:
+		return &entity.StoredCredential{StorageAlgo: hash.BCRYPT, Value: credential.PasswordHash}, nil
+	default:
+		return nil, fmt.Errorf("the %q password digest has no matching hash algorithm", credential.DigestFunction)
+	}
+}
+
+// toPBKDF2Credential converts a PBKDF2 password hash of a user store to the stored credential format.
+func toPBKDF2Credential(credential UserCredential) (*entity.StoredCredential, error) {
+	if credential.PseudoRandomFunction != "" && credential.PseudoRandomFunction != pbkdf2HMACSHA256 {
+		return nil, fmt.Errorf("the %s PBKDF2 pseudo-random function is not supported",
+			credential.PseudoRandomFunction)
+	}
+	if credential.IterationCount <= 0 {
+		return nil, errors.New("the PBKDF2 iteration count is missing")
+	}
+	if credential.KeyLength <= 0 || credential.KeyLength%8 != 0 {
+		return nil, errors.New("the PBKDF2 key length must be a positive number of bits divisible by 8")
+	}
+	value, err := base64.StdEncoding.DecodeString(credential.PasswordHash)
+	if err != nil {
+		return nil, errors.New("the PBKDF2 password hash is not base64 encoded")
+	}
+	salt, err := base64.StdEncoding.DecodeString(credential.SaltValue)
+	if err != nil {
+		return nil, errors.New("the PBKDF2 salt is not base64 encoded")
+	}
+	return &entity.StoredCredential{
+		StorageAlgo: hash.PBKDF2,
+		StorageAlgoParams: hash.CredParameters{
+			Iterations: credential.IterationCount,
+			KeySize:    credential.KeyLength / 8,
+			Salt:       hex.EncodeToString(salt),
+		},
+		Value: hex.EncodeToString(value),
+	}, nil
+}
+
+// mapRole maps a SCIM role to the details of a role. Members are identified by their SCIM IDs, which
+// are resolved to the migrated users when the role is created. The details are nil when the role cannot
+// be migrated.
+func mapRole(scimRole SCIMRole, ouID, resourceServerID string, isMigratedUser func(string) bool) (
+	*role.RoleCreationDetail, []string, *MigrationItem) {
+	item := &MigrationItem{ResourceType: ResourceTypeRole, SourceID: scimRole.ID, SourceName: scimRole.DisplayName}
+	if strings.TrimSpace(scimRole.DisplayName) == "" {
+		item.addError("displayName", "the role has no name")
+		return nil, nil, item
+	}
+
+	detail := &role.RoleCreationDetail{Name: scimRole.DisplayName, OUID: ouID}
+	item.addMapping("displayName", "name")
+	if audience := scimRole.Audience; audience != nil && strings.EqualFold(audience.Type, "application") {
+		item.addWarning("audience", fmt.Sprintf(
+			"the role is scoped to the %s application in WSO2 Identity Server and is migrated to the "+
+				"organization unit", audience.Display))
+	}
+
+	members := make([]string, 0, len(scimRole.Users))
+	for _, member := range scimRole.Users {
+		if !isMigratedUser(member.Value) {
+			item.addWarning("users", fmt.Sprintf("the member %s is not migrated and is not assigned",
+				memberName(member)))
+			continue
+		}
+		members = append(members, member.Value)
+	}
+	if len(members) > 0 {
+		item.addMapping("users", "assignments")
+	}
+	if len(scimRole.Groups) > 0 {
+		item.addWarning("groups", "group assignments are not migrated")
+	}
+
+	if len(scimRole.Permissions) > 0 {
+		if resourceServerID == "" {
+			item.addWarning("permissions", "the permissions are not migrated as no resource server is given")
+			return detail, members, item
+		}
+		permissions := make([]string, 0, len(scimRole.Permissions))
+		for _, permission := range scimRole.Permissions {
+			if strings.HasPrefix(permission.Value, "/permission/") {
+				item.addWarning("permissions", fmt.Sprintf(
+					"the %s console permission has no equivalent and is not migrated", permission.Value))
+				continue
+			}
+			permissions = append(permissions, permission.Value)
+		}
+		if len(permissions) > 0 {
+			detail.Permissions = []role.ResourcePermissions{{
+				ResourceServerID: resourceServerID,
+				Permissions:      permissions,
+			}}
+			item.addMapping("permissions", "permissions")
+		}
+	}
+	return detail, members, item
+}
+
+// memberName returns the display name of a role member, or its ID when it has none.
+func memberName(member SCIMReference) string {
+	if member.Display != "" {
+		return member.Display
+	}
+	return member.Value
+}
@@ -0,0 +1,394 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package wso2migration
+
+import (
+	"crypto/pbkdf2"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/entity"
+	inboundmodel "github.com/thunder-id/thunderid/internal/inboundclient/model"
+	oauth2const "github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
+	"github.com/thunder-id/thunderid/internal/role"
+	"github.com/thunder-id/thunderid/internal/system/cryptolab/hash"
+)
+
+const testServiceProvider = `<ServiceProvider>
+  <ApplicationName>pickup-dispatch</ApplicationName>
+  <Description>Dispatch application</Description>
+  <InboundAuthenticationConfig>
+    <InboundAuthenticationRequestConfigs>
+      <InboundAuthenticationRequestConfig>
+        <InboundAuthKey>client-1</InboundAuthKey>
+        <InboundAuthType>oauth2</InboundAuthType>
+        <inboundConfiguration><![CDATA[<oAuthAppDO>
+          <oauthConsumerKey>client-1</oauthConsumerKey>
+          <oauthConsumerSecret>secret-1</oauthConsumerSecret>
+          <callbackUrl>regexp=(https://app.example.com/callback|https://app.example.com/.*)</callbackUrl>
+          <oauthVersion>OAuth-2.0</oauthVersion>
+          <grantTypes>authorization_code refresh_token implicit</grantTypes>
+          <pkceMandatory>true</pkceMandatory>
+          <bypassClientCredentials>false</bypassClientCredentials>
+          <userAccessTokenExpiryTime>3600</userAccessTokenExpiryTime>
+          <applicationAccessTokenExpiryTime>3600</applicationAccessTokenExpiryTime>
+          <refreshTokenExpiryTime>86400</refreshTokenExpiryTime>
+          <idTokenExpiryTime>900</idTokenExpiryTime>
+        </oAuthAppDO>]]></inboundConfiguration>
+      </InboundAuthenticationRequestConfig>
+    </InboundAuthenticationRequestConfigs>
+  </InboundAuthenticationConfig>
+  <ClaimConfig>
+    <ClaimMappings>
+      <ClaimMapping>
+        <LocalClaim><ClaimUri>http://wso2.org/claims/emailaddress</ClaimUri></LocalClaim>
+        <RemoteClaim><ClaimUri>email</ClaimUri></RemoteClaim>
+        <RequestClaim>true</RequestClaim>
+      </ClaimMapping>
+      <ClaimMapping>
+        <LocalClaim><ClaimUri>http://wso2.org/claims/country</ClaimUri></LocalClaim>
+        <RemoteClaim><ClaimUri>country</ClaimUri></RemoteClaim>
+        <RequestClaim>true</RequestClaim>
+      </ClaimMapping>
+    </ClaimMappings>
+  </ClaimConfig>
+</ServiceProvider>`
+
+type MapperTestSuite struct {
+	suite.Suite
+}
+
+func TestMapperTestSuite(t *testing.T) {
+	suite.Run(t, new(MapperTestSuite))
+}
+
+func acceptedFormats(algorithms ...hash.CredAlgorithm) map[hash.CredAlgorithm]struct{} {
+	formats := make(map[hash.CredAlgorithm]struct{}, len(algorithms))
+	for _, algorithm := range algorithms {
+		formats[algorithm] = struct{}{}
+	}
+	return formats
+}
+
+func (s *MapperTestSuite) TestBuildClaimMappings() {
+	mappings := buildClaimMappings(map[string]string{
+		claimMobile:     "",
+		claimDepartment: "department",
+		claimEmail:      "work_email",
+	})
+
+	s.Equal("username", mappings[claimUsername])
+	s.Equal("work_email", mappings[claimEmail])
+	s.Equal("department", mappings[claimDepartment])
+	s.NotContains(mappings, claimMobile)
+	s.Equal("email", defaultClaimMappings[claimEmail])
+}
+
+func (s *MapperTestSuite) TestMapUser() {
+	active := false
+	attributes, item := mapUser(SCIMUser{
+		ID:       "u-1",
+		UserName: "EMPLOYEES/alice",
+		Name:     &SCIMName{GivenName: "Alice", FamilyName: "Smith"},
+		Emails: []SCIMMultiValue{
+			{Value: "alice@old.example.com"},
+			{Value: "alice@example.com", Primary: true},
+		},
+		PhoneNumbers:   []SCIMMultiValue{{Value: "+15550100", Type: "mobile"}, {Value: "+15550101", Type: "home"}},
+		Active:         &active,
+		EnterpriseUser: &SCIMEnterpriseUser{Department: "Sales"},
+	}, buildClaimMappings(nil))
+
+	s.Equal(map[string]interface{}{
+		"username":     "alice",
+		"given_name":   "Alice",
+		"family_name":  "Smith",
+		"email":        "alice@example.com",
+		"mobileNumber": "+15550100",
+	}, attributes)
+	s.True(item.isMigratable())
+	s.Contains(item.Mappings, FieldMapping{Source: "emails", Target: "email"})
+	fields := make([]string, 0, len(item.Notes))
+	for _, note := range item.Notes {
+		s.Equal(NoteSeverityWarning, note.Severity)
+		fields = append(fields, note.Field)
+	}
+	s.ElementsMatch([]string{"userName", "emails", "phoneNumbers", scimEnterpriseUserSchema + ":department",
+		"active"}, fields)
+}
+
+func (s *MapperTestSuite) TestMapUser_MissingUserName() {
+	attributes, item := mapUser(SCIMUser{ID: "u-1", UserName: "PRIMARY/"}, buildClaimMappings(nil))
+
+	s.Nil(attributes)
+	s.False(item.isMigratable())
+}
+
+func (s *MapperTestSuite) TestMapCredential_SHA256() {
+	password, salt := "Passw0rd!", "c2FsdFZhbHVl"
+	digest := sha256.Sum256([]byte(password + salt))
+	item := &MigrationItem{}
+
+	value := mapCredential(UserCredential{
+		UserName:       "alice",
+		PasswordHash:   base64.StdEncoding.EncodeToString(digest[:]),
+		SaltValue:      salt,
+		DigestFunction: "SHA-256",
+	}, acceptedFormats(hash.SHA256), item)
+
+	stored, ok := value.([]entity.StoredCredential)
+	s.Require().True(ok)
+	s.Equal(hash.SHA256, stored[0].StorageAlgo)
+	saltBytes, err := hex.DecodeString(stored[0].StorageAlgoParams.Salt)
+	s.Require().NoError(err)
+	expected := sha256.Sum256(append([]byte(password), saltBytes...))
+	s.Equal(hex.EncodeToString(expected[:]), stored[0].Value)
+	s.Empty(item.Notes)
+}
+
+func (s *MapperTestSuite) TestMapCredential_PBKDF2() {
+	password, salt := "Passw0rd!", []byte("0123456789abcdef")
+	key, err := pbkdf2.Key(sha256.New, password, salt, 10000, 32)
+	s.Require().NoError(err)
+	item := &MigrationItem{}
+
+	value := mapCredential(UserCredential{
+		UserName:       "alice",
+		PasswordHash:   base64.StdEncoding.EncodeToString(key),
+		SaltValue:      base64.StdEncoding.EncodeToString(salt),
+		DigestFunction: "PBKDF2",
+		IterationCount: 10000,
+		KeyLength:      256,
+	}, acceptedFormats(hash.PBKDF2), item)
+
+	stored, ok := value.([]entity.StoredCredential)
+	s.Require().True(ok)
+	s.Equal(hash.PBKDF2, stored[0].StorageAlgo)
+	s.Equal(hash.CredParameters{Iterations: 10000, KeySize: 32, Salt: hex.EncodeToString(salt)},
+		stored[0].StorageAlgoParams)
+	s.Equal(hex.EncodeToString(key), stored[0].Value)
+}
+
+func (s *MapperTestSuite) TestMapCredential_PlainText() {
+	item := &MigrationItem{}
+
+	value := mapCredential(UserCredential{UserName: "alice", PasswordHash: "Passw0rd!",
+		DigestFunction: "plain_text"}, acceptedFormats(hash.SHA256), item)
+
+	s.Equal("Passw0rd!", value)
+	s.Empty(item.Notes)
+}
+
+func (s *MapperTestSuite) TestMapCredential_NotMigrated() {
+	testCases := []struct {
+		name       string
+		credential UserCredential
+	}{
+		{"UnsupportedDigest", UserCredential{PasswordHash: "aGFzaA==", DigestFunction: "SHA-1"}},
+		{"FormatNotAccepted", UserCredential{PasswordHash: "aGFzaA==", DigestFunction: "SHA-256"}},
+		{"UnsupportedPRF", UserCredential{PasswordHash: "aGFzaA==", DigestFunction: "PBKDF2",
+			IterationCount: 1000, KeyLength: 256, PseudoRandomFunction: "PBKDF2WithHmacSHA512"}},
+		{"InvalidKeyLength", UserCredential{PasswordHash: "aGFzaA==", DigestFunction: "PBKDF2",
+			IterationCount: 1000, KeyLength: 100}},
+		{"InvalidEncoding", UserCredential{PasswordHash: "not base64", DigestFunction: "SHA-256"}},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			item := &MigrationItem{}
+			formats := acceptedFormats(hash.PBKDF2)
+			if tc.name == "InvalidEncoding" {
+				formats = acceptedFormats(hash.SHA256)
+			}
+
+			value := mapCredential(tc.credential, formats, item)
+
+			s.Nil(value)
+			s.Require().Len(item.Notes, 1)
+			s.Equal(NoteSeverityWarning, item.Notes[0].Severity)
+			s.True(item.isMigratable())
+		})
+	}
+}
+
+func (s *MapperTestSuite) TestMapRole() {
+	detail, members, item := mapRole(SCIMRole{
+		ID:          "r-1",
+		DisplayName: "dispatcher",
+		Audience:    &SCIMRoleAudience{Type: "application", Display: "pickup-dispatch"},
+		Users:       []SCIMReference{{Value: "u-1"}, {Value: "u-2", Display: "bob"}},
+		Permissions: []SCIMReference{{Value: "orders:read"}, {Value: "/permission/admin/login"}},
+	}, "ou-1", "rs-1", func(id string) bool { return id == "u-1" })
+
+	s.Require().NotNil(detail)
+	s.Equal("dispatcher", detail.Name)
+	s.Equal("ou-1", detail.OUID)
+	s.Equal([]role.ResourcePermissions{{ResourceServerID: "rs-1", Permissions: []string{"orders:read"}}},
+		detail.Permissions)
+	s.Equal([]string{"u-1"}, members)
+	s.Len(item.Notes, 3)
+	s.True(item.isMigratable())
+}
+
+func (s *MapperTestSuite) TestMapRole_WithoutResourceServer() {
+	detail, _, item := mapRole(SCIMRole{DisplayName: "dispatcher",
+		Permissions: []SCIMReference{{Value: "orders:read"}}}, "ou-1", "", func(string) bool { return false })
+
+	s.Require().NotNil(detail)
+	s.Empty(detail.Permissions)
+	s.Require().Len(item.Notes, 1)
+	s.Equal("permissions", item.Notes[0].Field)
+}
+
+func (s *MapperTestSuite) TestMapServiceProvider() {
+	sp, err := parseServiceProvider(testServiceProvider)
+	s.Require().NoError(err)
+
+	app, item := mapServiceProvider(sp, "ou-1", buildClaimMappings(nil))
+
+	s.Require().NotNil(app)
+	s.Equal("pickup-dispatch", app.Name)
+	s.Equal("ou-1", app.OUID)
+	s.Require().Len(app.InboundAuthConfig, 1)
+	s.Equal(inboundmodel.OAuthInboundAuthType, app.InboundAuthConfig[0].Type)
+	oauthConfig := app.InboundAuthConfig[0].OAuthConfig
+	s.Equal("client-1", oauthConfig.ClientID)
+	s.Equal("secret-1", oauthConfig.ClientSecret)
+	s.Equal([]string{"https://app.example.com/callback"}, oauthConfig.RedirectURIs)
+	s.Equal([]oauth2const.GrantType{oauth2const.GrantTypeAuthorizationCode, oauth2const.GrantTypeRefreshToken},
+		oauthConfig.GrantTypes)
+	s.Equal([]oauth2const.ResponseType{oauth2const.ResponseTypeCode}, oauthConfig.ResponseTypes)
+	s.Equal(oauth2const.TokenEndpointAuthMethodClientSecretBasic, oauthConfig.TokenEndpointAuthMethod)
+	s.True(oauthConfig.PKCERequired)
+	s.False(oauthConfig.PublicClient)
+	s.Equal(int64(3600), oauthConfig.Token.AccessToken.ValidityPeriod)
+	s.Equal(int64(86400), oauthConfig.Token.RefreshToken.ValidityPeriod)
+	s.Equal(int64(900), oauthConfig.Token.IDToken.ValidityPeriod)
+	s.Equal([]string{"email"}, oauthConfig.Token.IDToken.UserAttributes)
+
+	s.True(item.isMigratable())
+	fields := make([]string, 0, len(item.Notes))
+	for _, note := range item.Notes {
+		fields = append(fields, note.Field)
+	}
+	s.ElementsMatch([]string{"grantTypes", "callbackUrl", "ClaimMappings"}, fields)
+}
+
+func (s *MapperTestSuite) TestMapServiceProvider_PublicClientWithoutSecret() {
+	sp := &serviceProvider{ApplicationName: "spa", InboundAuth: inboundAuthenticationConfig{
+		Configs: []inboundAuthRequestConfig{{
+			InboundAuthKey:  "client-2",
+			InboundAuthType: "oauth2",
+			InboundConfiguration: inboundConfiguration{Text: `<oAuthAppDO><callbackUrl>https://spa.example.com` +
+				`</callbackUrl><grantTypes>authorization_code</grantTypes>` +
+				`<bypassClientCredentials>true</bypassClientCredentials></oAuthAppDO>`},
+		}},
+	}}
+
+	app, item := mapServiceProvider(sp, "ou-1", buildClaimMappings(nil))
+
+	s.Require().NotNil(app)
+	oauthConfig := app.InboundAuthConfig[0].OAuthConfig
+	s.Equal("client-2", oauthConfig.ClientID)
+	s.True(oauthConfig.PublicClient)
+	s.Equal(oauth2const.TokenEndpointAuthMethodNone, oauthConfig.TokenEndpointAuthMethod)
+	s.Nil(oauthConfig.Token)
+	s.Empty(item.Notes)
+	s.False(generatesSecret(app))
+}
+
+func (s *MapperTestSuite) TestMapServiceProvider_Incompatible() {
+	testCases := []struct {
+		name   string
+		sp     *serviceProvider
+		field  string
+		config string
+	}{
+		{
+			name:  "MissingName",
+			sp:    &serviceProvider{},
+			field: "ApplicationName",
+		},
+		{
+			name: "SAMLOnly",
+			sp: &serviceProvider{ApplicationName: "saml-app", InboundAuth: inboundAuthenticationConfig{
+				Configs: []inboundAuthRequestConfig{{InboundAuthKey: "issuer", InboundAuthType: "samlsso"}},
+			}},
+			field: "InboundAuthType",
+		},
+		{
+			name:   "OAuth1",
+			config: `<oAuthAppDO><oauthVersion>OAuth-1.0a</oauthVersion></oAuthAppDO>`,
+			field:  "oauthVersion",
+		},
+		{
+			name:   "UnsupportedGrantTypes",
+			config: `<oAuthAppDO><grantTypes>password implicit</grantTypes></oAuthAppDO>`,
+			field:  "grantTypes",
+		},
+		{
+			name: "MissingRedirectURI",
+			config: `<oAuthAppDO><callbackUrl>regexp=https://.*\.example\.com</callbackUrl>` +
+				`<grantTypes>authorization_code</grantTypes></oAuthAppDO>`,
+			field: "callbackUrl",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			sp := tc.sp
+			if sp == nil {
+				sp = &serviceProvider{ApplicationName: "app", InboundAuth: inboundAuthenticationConfig{
+					Configs: []inboundAuthRequestConfig{{InboundAuthKey: "client", InboundAuthType: "oauth2",
+						InboundConfiguration: inboundConfiguration{Text: tc.config}}},
+				}}
+			}
+
+			app, item := mapServiceProvider(sp, "ou-1", buildClaimMappings(nil))
+
+			s.Nil(app)
+			s.False(item.isMigratable())
+			s.Equal(tc.field, item.Notes[len(item.Notes)-1].Field)
+		})
+	}
+}
+
+func (s *MapperTestSuite) TestParseServiceProvider_Invalid() {
+	_, err := parseServiceProvider("<ServiceProvider>")
+
+	s.Error(err)
+}
+
+func (s *MapperTestSuite) TestResolveCompatibility() {
+	item := &MigrationItem{}
+	item.resolveCompatibility()
+	s.Equal(CompatibilityFull, item.Compatibility)
+
+	item.addWarning("field", "dropped")
+	item.resolveCompatibility()
+	s.Equal(CompatibilityPartial, item.Compatibility)
+
+	item.addError("field", "unsupported")
+	item.resolveCompatibility()
+	s.Equal(CompatibilityNone, item.Compatibility)
+}
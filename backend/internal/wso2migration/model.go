@@ -0,0 +1,199 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package wso2migration migrates service providers, users and roles exported from WSO2 Identity Server.
+// Each migration first produces a mapping and compatibility report, and creates the resources only when
+// it is committed.
+package wso2migration
+
+import (
+	"encoding/json"
+	"errors"
+)
+
+// MigrationRequest represents a request to migrate resources exported from WSO2 Identity Server.
+type MigrationRequest struct {
+	// OUID is the organization unit the migrated resources are created in.
+	OUID string `json:"ouId"`
+	// UserType is the user type the users are migrated to. It is required when users are migrated.
+	UserType string `json:"userType,omitempty"`
+	// CredentialAttribute is the credential attribute of the user type that holds the passwords. Defaults
+	// to "password".
+	CredentialAttribute string `json:"credentialAttribute,omitempty"`
+	// ClaimMappings maps WSO2 Identity Server local claim URIs to user attributes. The mappings extend and
+	// override the mappings to the attributes of the default person user type.
+	ClaimMappings map[string]string `json:"claimMappings,omitempty"`
+	// PermissionResourceServerID is the resource server the permissions of the roles are mapped to. The
+	// permissions of the roles are not migrated when it is not set.
+	PermissionResourceServerID string `json:"permissionResourceServerId,omitempty"`
+	// Commit creates the compatible resources. Only the report is produced when it is not set.
+	Commit bool `json:"commit,omitempty"`
+	// ServiceProviders holds the XML exports of the service providers.
+	ServiceProviders []string `json:"serviceProviders,omitempty"`
+	// Users holds the users in the SCIM 2.0 format.
+	Users []SCIMUser `json:"users,omitempty"`
+	// Credentials holds the passwords of the users as stored by the user store.
+	Credentials []UserCredential `json:"credentials,omitempty"`
+	// Roles holds the roles in the SCIM 2.0 format.
+	Roles []SCIMRole `json:"roles,omitempty"`
+}
+
+// SCIMUser represents a user exported through the SCIM 2.0 API of WSO2 Identity Server.
+type SCIMUser struct {
+	ID             string              `json:"id"`
+	UserName       string              `json:"userName"`
+	Name           *SCIMName           `json:"name,omitempty"`
+	DisplayName    string              `json:"displayName,omitempty"`
+	NickName       string              `json:"nickName,omitempty"`
+	Locale         string              `json:"locale,omitempty"`
+	Title          string              `json:"title,omitempty"`
+	Active         *bool               `json:"active,omitempty"`
+	Emails         []SCIMMultiValue    `json:"emails,omitempty"`
+	PhoneNumbers   []SCIMMultiValue    `json:"phoneNumbers,omitempty"`
+	Groups         []SCIMReference     `json:"groups,omitempty"`
+	EnterpriseUser *SCIMEnterpriseUser `json:"urn:ietf:params:scim:schemas:extension:enterprise:2.0:User,omitempty"`
+}
+
+// SCIMName represents the name of a SCIM user.
+type SCIMName struct {
+	GivenName  string `json:"givenName,omitempty"`
+	FamilyName string `json:"familyName,omitempty"`
+	Formatted  string `json:"formatted,omitempty"`
+}
+
+// SCIMMultiValue represents a value of a multi-valued SCIM attribute such as emails. WSO2 Identity Server
+// exports the values either as objects or as plain strings.
+type SCIMMultiValue struct {
+	Value   string `json:"value"`
+	Type    string `json:"type,omitempty"`
+	Primary bool   `json:"primary,omitempty"`
+}
+
+// UnmarshalJSON accepts a value given either as an object or as a plain string.
+func (v *SCIMMultiValue) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err == nil {
+		*v = SCIMMultiValue{Value: value}
+		return nil
+	}
+
+	type multiValue SCIMMultiValue
+	var object multiValue
+	if err := json.Unmarshal(data, &object); err != nil {
+		return errors.New("multi-valued attribute must be a string or an object")
+	}
+	*v = SCIMMultiValue(object)
+	return nil
+}
+
+// SCIMEnterpriseUser represents the attributes of the SCIM enterprise user extension.
+type SCIMEnterpriseUser struct {
+	EmployeeNumber string `json:"employeeNumber,omitempty"`
+	Department     string `json:"department,omitempty"`
+	Organization   string `json:"organization,omitempty"`
+}
+
+// SCIMReference represents a reference to another SCIM resource.
+type SCIMReference struct {
+	Value   string `json:"value"`
+	Display string `json:"display,omitempty"`
+}
+
+// SCIMRole represents a role exported through the SCIM 2.0 roles API of WSO2 Identity Server.
+type SCIMRole struct {
+	ID          string            `json:"id"`
+	DisplayName string            `json:"displayName"`
+	Audience    *SCIMRoleAudience `json:"audience,omitempty"`
+	Users       []SCIMReference   `json:"users,omitempty"`
+	Groups      []SCIMReference   `json:"groups,omitempty"`
+	Permissions []SCIMReference   `json:"permissions,omitempty"`
+}
+
+// SCIMRoleAudience represents the audience of a role, which is either an organization or an application.
+type SCIMRoleAudience struct {
+	Value   string `json:"value,omitempty"`
+	Type    string `json:"type,omitempty"`
+	Display string `json:"display,omitempty"`
+}
+
+// UserCredential represents the password of a user as stored by a JDBC user store of WSO2 Identity Server.
+type UserCredential struct {
+	UserName string `json:"userName"`
+	// PasswordHash is the stored password. Hashes are base64 encoded, except for bcrypt hashes.
+	PasswordHash string `json:"passwordHash"`
+	// SaltValue is the salt stored with the password.
+	SaltValue string `json:"saltValue,omitempty"`
+	// DigestFunction is the password digest of the user store, such as SHA-256, PBKDF2 or PLAIN_TEXT.
+	DigestFunction string `json:"digestFunction"`
+	// IterationCount is the PBKDF2 iteration count.
+	IterationCount int `json:"iterationCount,omitempty"`
+	// KeyLength is the PBKDF2 derived key length in bits.
+	KeyLength int `json:"keyLength,omitempty"`
+	// PseudoRandomFunction is the PBKDF2 pseudo-random function. Defaults to PBKDF2WithHmacSHA256.
+	PseudoRandomFunction string `json:"pseudoRandomFunction,omitempty"`
+}
+
+// MigrationReport represents the mapping and compatibility report of a migration.
+type MigrationReport struct {
+	Committed bool             `json:"committed"`
+	Summary   MigrationSummary `json:"summary"`
+	Items     []MigrationItem  `json:"items"`
+}
+
+// MigrationSummary represents the number of migrated resources by compatibility and result.
+type MigrationSummary struct {
+	Total        int `json:"total"`
+	Compatible   int `json:"compatible"`
+	Partial      int `json:"partial"`
+	Incompatible int `json:"incompatible"`
+	Created      int `json:"created"`
+	Failed       int `json:"failed"`
+	Skipped      int `json:"skipped"`
+}
+
+// MigrationItem represents the mapping, the compatibility and, once committed, the result of migrating a
+// single resource.
+type MigrationItem struct {
+	ResourceType  ResourceType   `json:"resourceType"`
+	SourceID      string         `json:"sourceId,omitempty"`
+	SourceName    string         `json:"sourceName"`
+	TargetID      string         `json:"targetId,omitempty"`
+	Compatibility Compatibility  `json:"compatibility"`
+	Mappings      []FieldMapping `json:"mappings,omitempty"`
+	Notes         []Note         `json:"notes,omitempty"`
+	Result        Result         `json:"result,omitempty"`
+	// Code and Message describe why the resource could not be created.
+	Code    string `json:"code,omitempty"`
+	Message string `json:"message,omitempty"`
+	// ClientSecret is the client secret generated for a migrated application whose export did not carry
+	// one. It is returned only once.
+	ClientSecret string `json:"clientSecret,omitempty"`
+}
+
+// FieldMapping represents a setting of the exported resource and the setting it is migrated to.
+type FieldMapping struct {
+	Source string `json:"source"`
+	Target string `json:"target"`
+}
+
+// Note represents a setting that is dropped or changed by the migration, or prevents it.
+type Note struct {
+	Severity NoteSeverity `json:"severity"`
+	Field    string       `json:"field,omitempty"`
+	Message  string       `json:"message"`
+}
@@ -0,0 +1,564 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package wso2migration
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/thunder-id/thunderid/internal/application"
+	"github.com/thunder-id/thunderid/internal/application/model"
+	"github.com/thunder-id/thunderid/internal/entity"
+	"github.com/thunder-id/thunderid/internal/entitytype"
+	inboundmodel "github.com/thunder-id/thunderid/internal/inboundclient/model"
+	"github.com/thunder-id/thunderid/internal/ou"
+	"github.com/thunder-id/thunderid/internal/role"
+	"github.com/thunder-id/thunderid/internal/system/cryptolab/hash"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/system/log"
+	"github.com/thunder-id/thunderid/internal/user"
+)
+
+// WSO2MigrationServiceInterface defines the interface for migrating resources exported from WSO2 Identity
+// Server.
+type WSO2MigrationServiceInterface interface {
+	Migrate(ctx context.Context, request MigrationRequest) (*MigrationReport, *serviceerror.ServiceError)
+}
+
+// wso2MigrationService is the default implementation of WSO2MigrationServiceInterface.
+type wso2MigrationService struct {
+	applicationService application.ApplicationServiceInterface
+	userService        user.UserServiceInterface
+	entityService      entity.EntityServiceInterface
+	entityTypeService  entitytype.EntityTypeServiceInterface
+	roleService        role.RoleServiceInterface
+	ouService          ou.OrganizationUnitServiceInterface
+	// acceptedFormats holds the stored password formats the server verifies.
+	acceptedFormats map[hash.CredAlgorithm]struct{}
+}
+
+// newWSO2MigrationService creates a new instance of wso2MigrationService.
+func newWSO2MigrationService(
+	applicationService application.ApplicationServiceInterface,
+	userService user.UserServiceInterface,
+	entityService entity.EntityServiceInterface,
+	entityTypeService entitytype.EntityTypeServiceInterface,
+	roleService role.RoleServiceInterface,
+	ouService ou.OrganizationUnitServiceInterface,
+	acceptedFormats map[hash.CredAlgorithm]struct{},
+) WSO2MigrationServiceInterface {
+	return &wso2MigrationService{
+		applicationService: applicationService,
+		userService:        userService,
+		entityService:      entityService,
+		entityTypeService:  entityTypeService,
+		roleService:        roleService,
+		ouService:          ouService,
+		acceptedFormats:    acceptedFormats,
+	}
+}
+
+// userPlan holds a user mapped for migration.
+type userPlan struct {
+	item       *MigrationItem
+	attributes map[string]interface{}
+	// credential is the plaintext password or the stored password hash, or nil when the password is not
+	// migrated.
+	credential interface{}
+}
+
+// rolePlan holds a role mapped for migration.
+type rolePlan struct {
+	item   *MigrationItem
+	detail *role.RoleCreationDetail
+	// members holds the SCIM IDs of the users assigned to the role.
+	members []string
+}
+
+// applicationPlan holds an application mapped for migration.
+type applicationPlan struct {
+	item *MigrationItem
+	app  *model.ApplicationDTO
+	// generatesSecret indicates that the client secret of the application is generated on creation.
+	generatesSecret bool
+}
+
+// Migrate maps the exported resources and reports the compatibility of each. When the migration is
+// committed, the users, the roles and the applications that can be migrated are created in that order.
+// Resources are created independently, so a failure does not undo the resources created before it.
+func (s *wso2MigrationService) Migrate(ctx context.Context, request MigrationRequest) (
+	*MigrationReport, *serviceerror.ServiceError) {
+	logger := log.GetLogger().With(log.String(log.LoggerKeyComponentName, loggerComponentName))
+
+	if svcErr := validateMigrationRequest(request); svcErr != nil {
+		return nil, svcErr
+	}
+	exists, svcErr := s.ouService.IsOrganizationUnitExists(ctx, request.OUID)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	if !exists {
+		return nil, &ErrorOrganizationUnitNotFound
+	}
+	if request.CredentialAttribute == "" {
+		request.CredentialAttribute = defaultCredentialAttribute
+	}
+	claimMappings := buildClaimMappings(request.ClaimMappings)
+
+	users, svcErr := s.planUsers(ctx, request, claimMappings)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	roles, svcErr := s.planRoles(ctx, request, users)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+	applications, svcErr := s.planApplications(ctx, request, claimMappings)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+
+	items := make([]*MigrationItem, 0, len(users)+len(roles)+len(applications))
+	for _, plan := range users {
+		items = append(items, plan.item)
+	}
+	for _, plan := range roles {
+		items = append(items, plan.item)
+	}
+	for _, plan := range applications {
+		items = append(items, plan.item)
+	}
+	for _, item := range items {
+		item.resolveCompatibility()
+	}
+
+	if request.Commit {
+		createdUsers := s.commitUsers(ctx, request, users, logger)
+		s.commitRoles(ctx, roles, createdUsers)
+		s.commitApplications(ctx, applications)
+	}
+
+	report := &MigrationReport{Committed: request.Commit, Items: make([]MigrationItem, 0, len(items))}
+	for _, item := range items {
+		report.Items = append(report.Items, *item)
+		report.Summary.add(item)
+	}
+	logger.Debug("Migrated WSO2 Identity Server resources", log.Bool("committed", request.Commit),
+		log.Int("total", report.Summary.Total), log.Int("created", report.Summary.Created),
+		log.Int("failed", report.Summary.Failed))
+	return report, nil
+}
+
+// validateMigrationRequest validates the migration request.
+func validateMigrationRequest(request MigrationRequest) *serviceerror.ServiceError {
+	if strings.TrimSpace(request.OUID) == "" {
+		return &ErrorMissingOUID
+	}
+	total := len(request.ServiceProviders) + len(request.Users) + len(request.Roles)
+	if total == 0 {
+		return &ErrorNothingToMigrate
+	}
+	if total > maxMigrationItems || len(request.Credentials) > maxMigrationItems {
+		return &ErrorTooManyItems
+	}
+	if len(request.Users) > 0 && strings.TrimSpace(request.UserType) == "" {
+		return &ErrorMissingUserType
+	}
+	return nil
+}
+
+// planUsers maps the users with their passwords and validates them against the target user type.
+func (s *wso2MigrationService) planUsers(ctx context.Context, request MigrationRequest,
+	claimMappings map[string]string) ([]*userPlan, *serviceerror.ServiceError) {
+	if len(request.Users) == 0 {
+		return nil, nil
+	}
+	userType, svcErr := s.entityTypeService.GetEntityTypeByName(ctx, entitytype.TypeCategoryUser,
+		request.UserType)
+	if svcErr != nil {
+		if svcErr.Type == serviceerror.ClientErrorType {
+			return nil, &ErrorUserTypeNotFound
+		}
+		return nil, svcErr
+	}
+
+	credentials := make(map[string]UserCredential, len(request.Credentials))
+	for _, credential := range request.Credentials {
+		credentials[userNameKey(credential.UserName)] = credential
+	}
+
+	plans := make([]*userPlan, 0, len(request.Users))
+	seen := make(map[string]struct{}, len(request.Users))
+	for _, scimUser := range request.Users {
+		attributes, item := mapUser(scimUser, claimMappings)
+		plan := &userPlan{item: item, attributes: attributes}
+		plans = append(plans, plan)
+		if attributes == nil {
+			continue
+		}
+
+		key := userNameKey(scimUser.UserName)
+		if _, ok := seen[key]; ok {
+			item.addError("userName", "the user name appears more than once in the export")
+			continue
+		}
+		seen[key] = struct{}{}
+
+		credential, ok := credentials[key]
+		if !ok {
+			item.addWarning("passwordHash", "the export has no password for the user; the user must reset "+
+				"the password")
+			continue
+		}
+		plan.credential = mapCredential(credential, s.acceptedFormats, item)
+	}
+
+	if svcErr := s.validateUsers(ctx, request, userType.Schema, plans); svcErr != nil {
+		return nil, svcErr
+	}
+	return plans, nil
+}
+
+// userNameKey returns the key identifying a user name across the users and the credentials of an export.
+func userNameKey(userName string) string {
+	domain, name := splitUserName(userName)
+	return domain + "/" + name
+}
+
+// validateUsers validates the attributes of the users that can be migrated against the schema of the
+// target user type and checks the uniqueness of their values.
+func (s *wso2MigrationService) validateUsers(ctx context.Context, request MigrationRequest,
+	schema json.RawMessage, plans []*userPlan) *serviceerror.ServiceError {
+	candidates := make([]*userPlan, 0, len(plans))
+	for _, plan := range plans {
+		if plan.item.isMigratable() {
+			candidates = append(candidates, plan)
+		}
+	}
+
+	for start := 0; start < len(candidates); start += entitytype.MaxSampleValidationCount {
+		batch := candidates[start:min(start+entitytype.MaxSampleValidationCount, len(candidates))]
+		samples := make([]json.RawMessage, 0, len(batch))
+		for _, plan := range batch {
+			sample, err := json.Marshal(plan.attributes)
+			if err != nil {
+				return &serviceerror.InternalServerError
+			}
+			samples = append(samples, sample)
+		}
+
+		response, svcErr := s.userService.ValidateUserTypeSamples(ctx, entitytype.SampleValidationRequest{
+			OUID:    request.OUID,
+			Schema:  schema,
+			Samples: samples,
+		})
+		if svcErr != nil {
+			return svcErr
+		}
+		for _, result := range response.Results {
+			if result.Index < 0 || result.Index >= len(batch) {
+				continue
+			}
+			plan := batch[result.Index]
+			for _, violation := range result.Violations {
+				// The password is stored separately from the other attributes.
+				if violation.Attribute == request.CredentialAttribute && plan.credential != nil {
+					continue
+				}
+				plan.item.addError(violation.Attribute, violation.Reason)
+			}
+			for _, conflict := range result.UniquenessConflicts {
+				message := "the value is already used by an existing user"
+				if conflict.Source == entitytype.UniquenessConflictSourceSample {
+					message = "the value is also used by another user in the export"
+				}
+				plan.item.addError(conflict.Attribute, message)
+			}
+		}
+	}
+	return nil
+}
+
+// planRoles maps the roles and checks that no role with the same name exists in the organization unit.
+func (s *wso2MigrationService) planRoles(ctx context.Context, request MigrationRequest,
+	users []*userPlan) ([]*rolePlan, *serviceerror.ServiceError) {
+	migratedUsers := make(map[string]struct{}, len(users))
+	for _, plan := range users {
+		if plan.item.SourceID != "" && plan.item.isMigratable() {
+			migratedUsers[plan.item.SourceID] = struct{}{}
+		}
+	}
+	isMigratedUser := func(sourceID string) bool {
+		_, ok := migratedUsers[sourceID]
+		return ok
+	}
+
+	plans := make([]*rolePlan, 0, len(request.Roles))
+	seen := make(map[string]struct{}, len(request.Roles))
+	for _, scimRole := range request.Roles {
+		detail, members, item := mapRole(scimRole, request.OUID, request.PermissionResourceServerID,
+			isMigratedUser)
+		plans = append(plans, &rolePlan{item: item, detail: detail, members: members})
+		if detail == nil {
+			continue
+		}
+		if _, ok := seen[detail.Name]; ok {
+			item.addError("displayName", "the role name appears more than once in the export")
+			continue
+		}
+		seen[detail.Name] = struct{}{}
+
+		_, svcErr := s.roleService.GetRoleByName(ctx, request.OUID, detail.Name)
+		switch {
+		case svcErr == nil:
+			item.addError("displayName", "a role with the name already exists in the organization unit")
+		case svcErr.Code == role.ErrorRoleNotFound.Code:
+		case svcErr.Type == serviceerror.ClientErrorType:
+			item.addError("displayName", svcErr.ErrorDescription.DefaultValue)
+		default:
+			return nil, svcErr
+		}
+	}
+	return plans, nil
+}
+
+// planApplications maps the service providers to applications and validates the applications.
+func (s *wso2MigrationService) planApplications(ctx context.Context, request MigrationRequest,
+	claimMappings map[string]string) ([]*applicationPlan, *serviceerror.ServiceError) {
+	plans := make([]*applicationPlan, 0, len(request.ServiceProviders))
+	seen := make(map[string]struct{}, len(request.ServiceProviders))
+	for i, document := range request.ServiceProviders {
+		sp, err := parseServiceProvider(document)
+		if err != nil {
+			item := &MigrationItem{ResourceType: ResourceTypeApplication,
+				SourceName: fmt.Sprintf("serviceProviders[%d]", i)}
+			item.addError("", err.Error())
+			plans = append(plans, &applicationPlan{item: item})
+			continue
+		}
+
+		app, item := mapServiceProvider(sp, request.OUID, claimMappings)
+		plans = append(plans, &applicationPlan{item: item, app: app, generatesSecret: generatesSecret(app)})
+		if app == nil {
+			continue
+		}
+		if _, ok := seen[app.Name]; ok {
+			item.addError("ApplicationName", "the application name appears more than once in the export")
+			continue
+		}
+		seen[app.Name] = struct{}{}
+
+		if _, _, svcErr := s.applicationService.ValidateApplication(ctx, cloneApplication(app)); svcErr != nil {
+			if svcErr.Type != serviceerror.ClientErrorType {
+				return nil, svcErr
+			}
+			item.addError("", svcErr.ErrorDescription.DefaultValue)
+		}
+	}
+	return plans, nil
+}
+
+// generatesSecret reports whether a client secret is generated when the application is created.
+func generatesSecret(app *model.ApplicationDTO) bool {
+	if app == nil || len(app.InboundAuthConfig) == 0 || app.InboundAuthConfig[0].OAuthConfig == nil {
+		return false
+	}
+	oauthConfig := app.InboundAuthConfig[0].OAuthConfig
+	return !oauthConfig.PublicClient && oauthConfig.ClientSecret == ""
+}
+
+// cloneApplication returns a copy of the application that can be validated without changing the
+// application that is created.
+func cloneApplication(app *model.ApplicationDTO) *model.ApplicationDTO {
+	clone := *app
+	clone.InboundAuthConfig = make([]inboundmodel.InboundAuthConfigWithSecret, 0, len(app.InboundAuthConfig))
+	for _, config := range app.InboundAuthConfig {
+		if config.OAuthConfig != nil {
+			oauthConfig := *config.OAuthConfig
+			config.OAuthConfig = &oauthConfig
+		}
+		clone.InboundAuthConfig = append(clone.InboundAuthConfig, config)
+	}
+	return &clone
+}
+
+// commitUsers creates the users that can be migrated and returns the IDs of the created users by their
+// SCIM IDs. Plaintext passwords of all the users are hashed as one batch before the users are created.
+func (s *wso2MigrationService) commitUsers(ctx context.Context, request MigrationRequest, plans []*userPlan,
+	logger *log.Logger) map[string]string {
+	createdUsers := make(map[string]string, len(plans))
+	newUsers := make([]*user.User, len(plans))
+	pendingUsers := make([]*user.User, 0, len(plans))
+	for i, plan := range plans {
+		if !plan.item.isMigratable() {
+			plan.item.Result = ResultSkipped
+			continue
+		}
+
+		if password, ok := plan.credential.(string); ok {
+			plan.attributes[request.CredentialAttribute] = password
+		}
+		attributes, err := json.Marshal(plan.attributes)
+		if err != nil {
+			plan.item.fail(&serviceerror.InternalServerError)
+			continue
+		}
+		newUsers[i] = &user.User{
+			OUID:       request.OUID,
+			Type:       request.UserType,
+			Attributes: attributes,
+		}
+		pendingUsers = append(pendingUsers, newUsers[i])
+	}
+
+	hashedCtx, svcErr := s.userService.PrehashCredentials(ctx, pendingUsers)
+	if svcErr != nil {
+		// The passwords are then hashed as each user is created.
+		logger.Warn("Failed to hash the passwords of the migrated users in a batch", log.String("code", svcErr.Code))
+		hashedCtx = ctx
+	}
+
+	for i, plan := range plans {
+		if newUsers[i] == nil {
+			continue
+		}
+
+		createdUser, svcErr := s.userService.CreateUser(hashedCtx, newUsers[i])
+		if svcErr != nil {
+			plan.item.fail(svcErr)
+			continue
+		}
+
+		if storedCredentials, ok := plan.credential.([]entity.StoredCredential); ok {
+			if err := s.storeCredential(ctx, createdUser.ID, request.CredentialAttribute,
+				storedCredentials); err != nil {
+				logger.Error("Failed to store the migrated password of a user",
+					log.MaskedString(log.LoggerKeyUserID, createdUser.ID), log.Error(err))
+				// Remove the user rather than leaving it without the password it had.
+				if svcErr := s.userService.PurgeUser(ctx, createdUser.ID); svcErr != nil {
+					logger.Error("Failed to remove a migrated user without its password",
+						log.MaskedString(log.LoggerKeyUserID, createdUser.ID), log.String("code", svcErr.Code))
+				}
+				plan.item.fail(&serviceerror.InternalServerError)
+				continue
+			}
+		}
+
+		plan.item.TargetID = createdUser.ID
+		plan.item.Result = ResultCreated
+		if plan.item.SourceID != "" {
+			createdUsers[plan.item.SourceID] = createdUser.ID
+		}
+	}
+	return createdUsers
+}
+
+// storeCredential stores a password hash of a user as it is. Stored credentials are not hashed again by
+// the entity service.
+func (s *wso2MigrationService) storeCredential(ctx context.Context, userID, credentialAttribute string,
+	storedCredentials []entity.StoredCredential) error {
+	credentials, err := json.Marshal(map[string][]entity.StoredCredential{credentialAttribute: storedCredentials})
+	if err != nil {
+		return err
+	}
+	return s.entityService.UpdateCredentials(ctx, userID, credentials)
+}
+
+// commitRoles creates the roles that can be migrated and assigns them to the created users.
+func (s *wso2MigrationService) commitRoles(ctx context.Context, plans []*rolePlan,
+	createdUsers map[string]string) {
+	for _, plan := range plans {
+		if !plan.item.isMigratable() {
+			plan.item.Result = ResultSkipped
+			continue
+		}
+
+		detail := *plan.detail
+		for _, member := range plan.members {
+			userID, ok := createdUsers[member]
+			if !ok {
+				plan.item.addWarning("users", fmt.Sprintf("the member %s was not created and is not assigned",
+					member))
+				continue
+			}
+			detail.Assignments = append(detail.Assignments,
+				role.RoleAssignment{ID: userID, Type: role.AssigneeTypeUser})
+		}
+
+		created, svcErr := s.roleService.CreateRole(ctx, detail)
+		if svcErr != nil {
+			plan.item.fail(svcErr)
+			continue
+		}
+		plan.item.TargetID = created.ID
+		plan.item.Result = ResultCreated
+	}
+}
+
+// commitApplications creates the applications that can be migrated.
+func (s *wso2MigrationService) commitApplications(ctx context.Context, plans []*applicationPlan) {
+	for _, plan := range plans {
+		if !plan.item.isMigratable() {
+			plan.item.Result = ResultSkipped
+			continue
+		}
+
+		created, svcErr := s.applicationService.CreateApplication(ctx, plan.app)
+		if svcErr != nil {
+			plan.item.fail(svcErr)
+			continue
+		}
+		plan.item.TargetID = created.ID
+		plan.item.Result = ResultCreated
+		if plan.generatesSecret && len(created.InboundAuthConfig) > 0 &&
+			created.InboundAuthConfig[0].OAuthConfig != nil {
+			plan.item.ClientSecret = created.InboundAuthConfig[0].OAuthConfig.ClientSecret
+		}
+	}
+}
+
+// fail records that the resource could not be created.
+func (i *MigrationItem) fail(svcErr *serviceerror.ServiceError) {
+	i.Result = ResultFailed
+	i.Code = svcErr.Code
+	i.Message = svcErr.ErrorDescription.DefaultValue
+}
+
+// add counts the item in the summary.
+func (s *MigrationSummary) add(item *MigrationItem) {
+	s.Total++
+	switch item.Compatibility {
+	case CompatibilityFull:
+		s.Compatible++
+	case CompatibilityPartial:
+		s.Partial++
+	case CompatibilityNone:
+		s.Incompatible++
+	}
+	switch item.Result {
+	case ResultCreated:
+		s.Created++
+	case ResultFailed:
+		s.Failed++
+	case ResultSkipped:
+		s.Skipped++
+	}
+}
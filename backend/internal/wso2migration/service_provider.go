@@ -0,0 +1,334 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package wso2migration
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/thunder-id/thunderid/internal/application/model"
+	inboundmodel "github.com/thunder-id/thunderid/internal/inboundclient/model"
+	oauth2const "github.com/thunder-id/thunderid/internal/oauth/oauth2/constants"
+)
+
+// serviceProvider represents the XML export of a WSO2 Identity Server service provider.
+type serviceProvider struct {
+	XMLName         xml.Name                    `xml:"ServiceProvider"`
+	ApplicationName string                      `xml:"ApplicationName"`
+	Description     string                      `xml:"Description"`
+	InboundAuth     inboundAuthenticationConfig `xml:"InboundAuthenticationConfig>InboundAuthenticationRequestConfigs"`
+	ClaimMappings   []spClaimMapping            `xml:"ClaimConfig>ClaimMappings>ClaimMapping"`
+	Certificate     string                      `xml:"Certificate"`
+	IsSaaSApp       bool                        `xml:"IsSaaSApp"`
+}
+
+// inboundAuthenticationConfig holds the inbound protocol configurations of a service provider.
+type inboundAuthenticationConfig struct {
+	Configs []inboundAuthRequestConfig `xml:"InboundAuthenticationRequestConfig"`
+}
+
+// inboundAuthRequestConfig represents an inbound protocol configuration of a service provider.
+type inboundAuthRequestConfig struct {
+	InboundAuthKey       string               `xml:"InboundAuthKey"`
+	InboundAuthType      string               `xml:"InboundAuthType"`
+	InboundConfiguration inboundConfiguration `xml:"inboundConfiguration"`
+}
+
+// inboundConfiguration holds the protocol specific configuration of an inbound protocol. Exports carry
+// it either as escaped character data or as nested elements.
+type inboundConfiguration struct {
+	Text  string `xml:",chardata"`
+	Inner string `xml:",innerxml"`
+}
+
+// content returns the XML document of the inbound configuration.
+func (c inboundConfiguration) content() string {
+	if text := strings.TrimSpace(c.Text); text != "" {
+		return text
+	}
+	return strings.TrimSpace(c.Inner)
+}
+
+// spClaimMapping represents a claim mapping of a service provider.
+type spClaimMapping struct {
+	LocalClaimURI  string `xml:"LocalClaim>ClaimUri"`
+	RemoteClaimURI string `xml:"RemoteClaim>ClaimUri"`
+	RequestClaim   bool   `xml:"RequestClaim"`
+}
+
+// oauthAppDO represents the OAuth configuration of a service provider.
+type oauthAppDO struct {
+	XMLName                          xml.Name `xml:"oAuthAppDO"`
+	OAuthConsumerKey                 string   `xml:"oauthConsumerKey"`
+	OAuthConsumerSecret              string   `xml:"oauthConsumerSecret"`
+	CallbackURL                      string   `xml:"callbackUrl"`
+	OAuthVersion                     string   `xml:"oauthVersion"`
+	GrantTypes                       string   `xml:"grantTypes"`
+	PKCEMandatory                    bool     `xml:"pkceMandatory"`
+	PKCESupportPlain                 bool     `xml:"pkceSupportPlain"`
+	BypassClientCredentials          bool     `xml:"bypassClientCredentials"`
+	UserAccessTokenExpiryTime        int64    `xml:"userAccessTokenExpiryTime"`
+	ApplicationAccessTokenExpiryTime int64    `xml:"applicationAccessTokenExpiryTime"`
+	RefreshTokenExpiryTime           int64    `xml:"refreshTokenExpiryTime"`
+	IDTokenExpiryTime                int64    `xml:"idTokenExpiryTime"`
+}
+
+// parseServiceProvider parses the XML export of a service provider.
+func parseServiceProvider(document string) (*serviceProvider, error) {
+	var sp serviceProvider
+	if err := xml.Unmarshal([]byte(document), &sp); err != nil {
+		return nil, fmt.Errorf("invalid service provider export: %w", err)
+	}
+	return &sp, nil
+}
+
+// parseOAuthApp parses the OAuth configuration of an inbound protocol configuration.
+func parseOAuthApp(config inboundAuthRequestConfig) (*oauthAppDO, error) {
+	content := config.InboundConfiguration.content()
+	if content == "" {
+		return nil, errors.New("the OAuth configuration is missing from the export")
+	}
+	var app oauthAppDO
+	if err := xml.Unmarshal([]byte(content), &app); err != nil {
+		return nil, fmt.Errorf("invalid OAuth configuration: %w", err)
+	}
+	return &app, nil
+}
+
+// mapServiceProvider maps a service provider to an application. The returned application is nil when
+// the service provider cannot be migrated.
+func mapServiceProvider(sp *serviceProvider, ouID string, claimMappings map[string]string) (
+	*model.ApplicationDTO, *MigrationItem) {
+	item := &MigrationItem{ResourceType: ResourceTypeApplication, SourceName: sp.ApplicationName}
+	if strings.TrimSpace(sp.ApplicationName) == "" {
+		item.addError("ApplicationName", "the service provider has no name")
+		return nil, item
+	}
+
+	app := &model.ApplicationDTO{
+		OUID:        ouID,
+		Name:        sp.ApplicationName,
+		Description: sp.Description,
+	}
+	item.addMapping("ApplicationName", "name")
+	if sp.Description != "" {
+		item.addMapping("Description", "description")
+	}
+	if sp.Certificate != "" {
+		item.addWarning("Certificate", "the certificate is not migrated")
+	}
+	if sp.IsSaaSApp {
+		item.addWarning("IsSaaSApp", "applications are not shared across organization units")
+	}
+
+	var oauthConfigs []inboundAuthRequestConfig
+	for _, config := range sp.InboundAuth.Configs {
+		if strings.EqualFold(config.InboundAuthType, inboundAuthTypeOAuth2) {
+			oauthConfigs = append(oauthConfigs, config)
+			continue
+		}
+		if len(sp.InboundAuth.Configs) == 1 {
+			item.addError("InboundAuthType", fmt.Sprintf(
+				"the %s inbound protocol is not supported; only OAuth 2.0 and OpenID Connect are migrated",
+				config.InboundAuthType))
+			return nil, item
+		}
+		item.addWarning("InboundAuthType", fmt.Sprintf("the %s inbound protocol is not migrated",
+			config.InboundAuthType))
+	}
+	if len(oauthConfigs) == 0 {
+		if len(sp.InboundAuth.Configs) > 0 {
+			item.addError("InboundAuthType", "the service provider has no OAuth 2.0 inbound protocol")
+			return nil, item
+		}
+		return app, item
+	}
+	if len(oauthConfigs) > 1 {
+		item.addWarning("InboundAuthKey", "only the first OAuth 2.0 inbound protocol is migrated")
+	}
+
+	oauthApp, err := parseOAuthApp(oauthConfigs[0])
+	if err != nil {
+		item.addError("inboundConfiguration", err.Error())
+		return nil, item
+	}
+	oauthConfig, ok := mapOAuthApp(oauthApp, oauthConfigs[0].InboundAuthKey, item)
+	if !ok {
+		return nil, item
+	}
+	oauthConfig.Token = mapTokenConfig(oauthApp, sp.ClaimMappings, claimMappings, item)
+	app.InboundAuthConfig = []inboundmodel.InboundAuthConfigWithSecret{{
+		Type:        inboundmodel.OAuthInboundAuthType,
+		OAuthConfig: oauthConfig,
+	}}
+	return app, item
+}
+
+// mapOAuthApp maps the OAuth configuration of a service provider. It reports false when the
+// configuration cannot be migrated.
+func mapOAuthApp(oauthApp *oauthAppDO, inboundAuthKey string, item *MigrationItem) (
+	*inboundmodel.OAuthConfigWithSecret, bool) {
+	if oauthApp.OAuthVersion != "" && oauthApp.OAuthVersion != oauthVersion2 {
+		item.addError("oauthVersion", fmt.Sprintf("%s applications are not supported", oauthApp.OAuthVersion))
+		return nil, false
+	}
+
+	clientID := oauthApp.OAuthConsumerKey
+	if clientID == "" {
+		clientID = inboundAuthKey
+	}
+	oauthConfig := &inboundmodel.OAuthConfigWithSecret{
+		ClientID:     clientID,
+		PKCERequired: oauthApp.PKCEMandatory,
+		PublicClient: oauthApp.BypassClientCredentials,
+	}
+	item.addMapping("oauthConsumerKey", "inboundAuthConfig.config.clientId")
+	item.addMapping("pkceMandatory", "inboundAuthConfig.config.pkceRequired")
+	item.addMapping("bypassClientCredentials", "inboundAuthConfig.config.publicClient")
+	if oauthApp.PKCESupportPlain {
+		item.addWarning("pkceSupportPlain", "the plain PKCE code challenge method is not supported")
+	}
+
+	if oauthApp.BypassClientCredentials {
+		oauthConfig.TokenEndpointAuthMethod = oauth2const.TokenEndpointAuthMethodNone
+	} else {
+		oauthConfig.TokenEndpointAuthMethod = oauth2const.TokenEndpointAuthMethodClientSecretBasic
+		if oauthApp.OAuthConsumerSecret != "" {
+			oauthConfig.ClientSecret = oauthApp.OAuthConsumerSecret
+			item.addMapping("oauthConsumerSecret", "inboundAuthConfig.config.clientSecret")
+		} else {
+			item.addWarning("oauthConsumerSecret",
+				"the client secret is not part of the export; a new client secret is generated")
+		}
+	}
+
+	for _, grantType := range strings.Fields(oauthApp.GrantTypes) {
+		if oauth2const.GrantType(grantType).IsValid() {
+			oauthConfig.GrantTypes = append(oauthConfig.GrantTypes, oauth2const.GrantType(grantType))
+			continue
+		}
+		item.addWarning("grantTypes", fmt.Sprintf("the %s grant type is not supported", grantType))
+	}
+	if len(oauthConfig.GrantTypes) == 0 && oauthApp.GrantTypes != "" {
+		item.addError("grantTypes", "none of the grant types of the application are supported")
+		return nil, false
+	}
+	if len(oauthConfig.GrantTypes) > 0 {
+		item.addMapping("grantTypes", "inboundAuthConfig.config.grantTypes")
+	}
+
+	usesAuthorizationCode := inboundmodel.IsAllowedGrantType(oauthConfig.GrantTypes,
+		oauth2const.GrantTypeAuthorizationCode)
+	if usesAuthorizationCode {
+		oauthConfig.ResponseTypes = []oauth2const.ResponseType{oauth2const.ResponseTypeCode}
+	}
+
+	oauthConfig.RedirectURIs = mapCallbackURL(oauthApp.CallbackURL, item)
+	if len(oauthConfig.RedirectURIs) > 0 {
+		item.addMapping("callbackUrl", "inboundAuthConfig.config.redirectUris")
+	} else if usesAuthorizationCode {
+		item.addError("callbackUrl", "the application has no redirect URI that can be migrated")
+		return nil, false
+	}
+
+	return oauthConfig, true
+}
+
+// mapCallbackURL maps the callback URL of a service provider to redirect URIs. Callback URLs given as a
+// regular expression are migrated only when the expression is a list of alternative URLs.
+func mapCallbackURL(callbackURL string, item *MigrationItem) []string {
+	callbackURL = strings.TrimSpace(callbackURL)
+	if callbackURL == "" {
+		return nil
+	}
+	if !strings.HasPrefix(callbackURL, callbackURLRegexp) {
+		return []string{callbackURL}
+	}
+
+	expression := strings.TrimPrefix(callbackURL, callbackURLRegexp)
+	if strings.HasPrefix(expression, "(") && strings.HasSuffix(expression, ")") {
+		expression = expression[1 : len(expression)-1]
+	}
+	redirectURIs := make([]string, 0)
+	for _, alternative := range strings.Split(expression, "|") {
+		alternative = strings.TrimSpace(alternative)
+		if alternative == "" {
+			continue
+		}
+		if strings.ContainsAny(alternative, `()[]{}*+?^$\`) {
+			item.addWarning("callbackUrl", fmt.Sprintf(
+				"the callback URL pattern %q is not migrated; add the redirect URIs it matches", alternative))
+			continue
+		}
+		redirectURIs = append(redirectURIs, alternative)
+	}
+	return redirectURIs
+}
+
+// mapTokenConfig maps the token lifetimes and the requested claims of a service provider.
+func mapTokenConfig(oauthApp *oauthAppDO, spClaims []spClaimMapping, claimMappings map[string]string,
+	item *MigrationItem) *inboundmodel.OAuthTokenConfig {
+	tokenConfig := &inboundmodel.OAuthTokenConfig{}
+	if oauthApp.UserAccessTokenExpiryTime > 0 {
+		tokenConfig.AccessToken = &inboundmodel.AccessTokenConfig{ValidityPeriod: oauthApp.UserAccessTokenExpiryTime}
+		item.addMapping("userAccessTokenExpiryTime", "inboundAuthConfig.config.token.accessToken.validityPeriod")
+	}
+	if oauthApp.ApplicationAccessTokenExpiryTime > 0 &&
+		oauthApp.ApplicationAccessTokenExpiryTime != oauthApp.UserAccessTokenExpiryTime {
+		item.addWarning("applicationAccessTokenExpiryTime",
+			"application access tokens use the validity period of user access tokens")
+	}
+	if oauthApp.RefreshTokenExpiryTime > 0 {
+		tokenConfig.RefreshToken = &inboundmodel.RefreshTokenConfig{ValidityPeriod: oauthApp.RefreshTokenExpiryTime}
+		item.addMapping("refreshTokenExpiryTime", "inboundAuthConfig.config.token.refreshToken.validityPeriod")
+	}
+
+	var userAttributes []string
+	for _, claim := range spClaims {
+		if !claim.RequestClaim {
+			continue
+		}
+		attribute, ok := claimMappings[claim.LocalClaimURI]
+		if !ok {
+			item.addWarning("ClaimMappings", fmt.Sprintf(
+				"the requested claim %s is not mapped to a user attribute", claim.LocalClaimURI))
+			continue
+		}
+		userAttributes = append(userAttributes, attribute)
+	}
+	if oauthApp.IDTokenExpiryTime > 0 || len(userAttributes) > 0 {
+		tokenConfig.IDToken = &inboundmodel.IDTokenConfig{
+			ValidityPeriod: oauthApp.IDTokenExpiryTime,
+			UserAttributes: userAttributes,
+		}
+		if oauthApp.IDTokenExpiryTime > 0 {
+			item.addMapping("idTokenExpiryTime", "inboundAuthConfig.config.token.idToken.validityPeriod")
+		}
+		if len(userAttributes) > 0 {
+			item.addMapping("ClaimMappings", "inboundAuthConfig.config.token.idToken.userAttributes")
+		}
+	}
+
+	if tokenConfig.AccessToken == nil && tokenConfig.RefreshToken == nil && tokenConfig.IDToken == nil {
+		return nil
+	}
+	return tokenConfig
+}
@@ -0,0 +1,408 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package wso2migration
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/thunder-id/thunderid/internal/application/model"
+	"github.com/thunder-id/thunderid/internal/entity"
+	"github.com/thunder-id/thunderid/internal/entitytype"
+	inboundmodel "github.com/thunder-id/thunderid/internal/inboundclient/model"
+	"github.com/thunder-id/thunderid/internal/role"
+	"github.com/thunder-id/thunderid/internal/system/config"
+	"github.com/thunder-id/thunderid/internal/system/cryptolab/hash"
+	"github.com/thunder-id/thunderid/internal/system/error/serviceerror"
+	"github.com/thunder-id/thunderid/internal/user"
+	"github.com/thunder-id/thunderid/tests/mocks/applicationmock"
+	"github.com/thunder-id/thunderid/tests/mocks/entitymock"
+	"github.com/thunder-id/thunderid/tests/mocks/entitytypemock"
+	"github.com/thunder-id/thunderid/tests/mocks/oumock"
+	"github.com/thunder-id/thunderid/tests/mocks/rolemock"
+	"github.com/thunder-id/thunderid/tests/mocks/usermock"
+)
+
+type ServiceTestSuite struct {
+	suite.Suite
+	mockApplicationService *applicationmock.ApplicationServiceInterfaceMock
+	mockUserService        *usermock.UserServiceInterfaceMock
+	mockEntityService      *entitymock.EntityServiceInterfaceMock
+	mockEntityTypeService  *entitytypemock.EntityTypeServiceInterfaceMock
+	mockRoleService        *rolemock.RoleServiceInterfaceMock
+	mockOUService          *oumock.OrganizationUnitServiceInterfaceMock
+	service                WSO2MigrationServiceInterface
+}
+
+func TestServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(ServiceTestSuite))
+}
+
+func (s *ServiceTestSuite) SetupTest() {
+	s.mockApplicationService = applicationmock.NewApplicationServiceInterfaceMock(s.T())
+	s.mockUserService = usermock.NewUserServiceInterfaceMock(s.T())
+	s.mockEntityService = entitymock.NewEntityServiceInterfaceMock(s.T())
+	s.mockEntityTypeService = entitytypemock.NewEntityTypeServiceInterfaceMock(s.T())
+	s.mockRoleService = rolemock.NewRoleServiceInterfaceMock(s.T())
+	s.mockOUService = oumock.NewOrganizationUnitServiceInterfaceMock(s.T())
+	s.service = newWSO2MigrationService(s.mockApplicationService, s.mockUserService, s.mockEntityService,
+		s.mockEntityTypeService, s.mockRoleService, s.mockOUService, acceptedFormats(hash.SHA256))
+	s.mockUserService.On("PrehashCredentials", mock.Anything, mock.Anything).
+		Return(func(ctx context.Context, _ []*user.User) (context.Context, *serviceerror.ServiceError) {
+			return context.WithValue(ctx, prehashedContextKey{}, true), nil
+		}).Maybe()
+}
+
+// prehashedContextKey marks the context returned by the mocked PrehashCredentials.
+type prehashedContextKey struct{}
+
+// migrationRequest returns a request migrating two users, one of them with an invalid email address, a
+// role assigned to both and a service provider.
+func (s *ServiceTestSuite) migrationRequest(commit bool) MigrationRequest {
+	digest := sha256.Sum256([]byte("Passw0rd!" + "salt"))
+	return MigrationRequest{
+		OUID:                       "ou-1",
+		UserType:                   "person",
+		PermissionResourceServerID: "rs-1",
+		Commit:                     commit,
+		ServiceProviders:           []string{testServiceProvider},
+		Users: []SCIMUser{
+			{ID: "u-1", UserName: "alice", Emails: []SCIMMultiValue{{Value: "alice@example.com"}}},
+			{ID: "u-2", UserName: "bob", Emails: []SCIMMultiValue{{Value: "bob"}}},
+		},
+		Credentials: []UserCredential{{
+			UserName:       "PRIMARY/alice",
+			PasswordHash:   base64.StdEncoding.EncodeToString(digest[:]),
+			SaltValue:      "salt",
+			DigestFunction: "SHA-256",
+		}},
+		Roles: []SCIMRole{{
+			ID:          "r-1",
+			DisplayName: "dispatcher",
+			Users:       []SCIMReference{{Value: "u-1"}, {Value: "u-2"}},
+			Permissions: []SCIMReference{{Value: "orders:read"}},
+		}},
+	}
+}
+
+// expectAnalysis sets up the lookups made while the migration request of migrationRequest is analyzed.
+func (s *ServiceTestSuite) expectAnalysis() {
+	s.mockOUService.On("IsOrganizationUnitExists", mock.Anything, "ou-1").Return(true, nil)
+	s.mockEntityTypeService.On("GetEntityTypeByName", mock.Anything, entitytype.TypeCategoryUser, "person").
+		Return(&entitytype.EntityType{Name: "person", Schema: json.RawMessage(`{"email":{"type":"string"}}`)}, nil)
+	s.mockUserService.On("ValidateUserTypeSamples", mock.Anything,
+		mock.MatchedBy(func(r entitytype.SampleValidationRequest) bool {
+			return r.OUID == "ou-1" && len(r.Samples) == 2
+		})).Return(&entitytype.SampleValidationResponse{Results: []entitytype.SampleValidationResult{
+		{Index: 0, Valid: true},
+		{Index: 1, Valid: false, Violations: []entitytype.SchemaViolation{
+			{Attribute: "email", Reason: "does not match the pattern"},
+		}},
+	}}, nil)
+	s.mockRoleService.On("GetRoleByName", mock.Anything, "ou-1", "dispatcher").
+		Return(nil, &role.ErrorRoleNotFound)
+	s.mockApplicationService.On("ValidateApplication", mock.Anything, mock.Anything).Return(nil, nil, nil)
+}
+
+func (s *ServiceTestSuite) TestMigrate_InvalidRequest() {
+	testCases := []struct {
+		name     string
+		request  MigrationRequest
+		expected string
+	}{
+		{"MissingOU", MigrationRequest{Roles: []SCIMRole{{DisplayName: "role"}}}, ErrorMissingOUID.Code},
+		{"NothingToMigrate", MigrationRequest{OUID: "ou-1"}, ErrorNothingToMigrate.Code},
+		{"TooManyItems", MigrationRequest{OUID: "ou-1", Roles: make([]SCIMRole, maxMigrationItems+1)},
+			ErrorTooManyItems.Code},
+		{"MissingUserType", MigrationRequest{OUID: "ou-1", Users: []SCIMUser{{UserName: "alice"}}},
+			ErrorMissingUserType.Code},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			report, svcErr := s.service.Migrate(s.T().Context(), tc.request)
+
+			s.Nil(report)
+			s.Require().NotNil(svcErr)
+			s.Equal(tc.expected, svcErr.Code)
+		})
+	}
+}
+
+func (s *ServiceTestSuite) TestMigrate_OrganizationUnitNotFound() {
+	s.mockOUService.On("IsOrganizationUnitExists", mock.Anything, "ou-1").Return(false, nil)
+
+	report, svcErr := s.service.Migrate(s.T().Context(), s.migrationRequest(false))
+
+	s.Nil(report)
+	s.Equal(&ErrorOrganizationUnitNotFound, svcErr)
+}
+
+func (s *ServiceTestSuite) TestMigrate_UserTypeNotFound() {
+	s.mockOUService.On("IsOrganizationUnitExists", mock.Anything, "ou-1").Return(true, nil)
+	s.mockEntityTypeService.On("GetEntityTypeByName", mock.Anything, entitytype.TypeCategoryUser, "person").
+		Return(nil, &entitytype.ErrorUserTypeNotFound)
+
+	report, svcErr := s.service.Migrate(s.T().Context(), s.migrationRequest(false))
+
+	s.Nil(report)
+	s.Equal(&ErrorUserTypeNotFound, svcErr)
+}
+
+func (s *ServiceTestSuite) TestMigrate_Report() {
+	s.expectAnalysis()
+
+	report, svcErr := s.service.Migrate(s.T().Context(), s.migrationRequest(false))
+
+	s.Require().Nil(svcErr)
+	s.False(report.Committed)
+	s.Equal(MigrationSummary{Total: 4, Compatible: 1, Partial: 2, Incompatible: 1}, report.Summary)
+	s.Require().Len(report.Items, 4)
+
+	alice := report.Items[0]
+	s.Equal(ResourceTypeUser, alice.ResourceType)
+	s.Equal(CompatibilityFull, alice.Compatibility)
+	s.Contains(alice.Mappings, FieldMapping{Source: "passwordHash", Target: "credentials (SHA256)"})
+	s.Empty(alice.Result)
+
+	bob := report.Items[1]
+	s.Equal(CompatibilityNone, bob.Compatibility)
+	s.Contains(bob.Notes, Note{Severity: NoteSeverityError, Field: "email", Message: "does not match the pattern"})
+
+	dispatcher := report.Items[2]
+	s.Equal(ResourceTypeRole, dispatcher.ResourceType)
+	s.Equal(CompatibilityPartial, dispatcher.Compatibility)
+
+	s.Equal(ResourceTypeApplication, report.Items[3].ResourceType)
+	s.Equal(CompatibilityPartial, report.Items[3].Compatibility)
+
+	s.mockUserService.AssertNotCalled(s.T(), "CreateUser", mock.Anything, mock.Anything)
+	s.mockRoleService.AssertNotCalled(s.T(), "CreateRole", mock.Anything, mock.Anything)
+	s.mockApplicationService.AssertNotCalled(s.T(), "CreateApplication", mock.Anything, mock.Anything)
+}
+
+func (s *ServiceTestSuite) TestMigrate_ExistingRole() {
+	request := MigrationRequest{OUID: "ou-1", Roles: []SCIMRole{{DisplayName: "dispatcher"},
+		{DisplayName: "dispatcher"}}}
+	s.mockOUService.On("IsOrganizationUnitExists", mock.Anything, "ou-1").Return(true, nil)
+	s.mockRoleService.On("GetRoleByName", mock.Anything, "ou-1", "dispatcher").
+		Return(&role.RoleWithPermissions{ID: "role-1"}, nil).Once()
+
+	report, svcErr := s.service.Migrate(s.T().Context(), request)
+
+	s.Require().Nil(svcErr)
+	s.Equal(2, report.Summary.Incompatible)
+}
+
+func (s *ServiceTestSuite) TestMigrate_Commit() {
+	s.expectAnalysis()
+	s.mockUserService.On("CreateUser", mock.Anything, mock.MatchedBy(func(u *user.User) bool {
+		return u.OUID == "ou-1" && u.Type == "person" &&
+			string(u.Attributes) == `{"email":"alice@example.com","username":"alice"}`
+	})).Return(&user.User{ID: "user-1"}, nil).Once()
+	s.mockEntityService.On("UpdateCredentials", mock.Anything, "user-1",
+		mock.MatchedBy(func(credentials json.RawMessage) bool {
+			var stored map[string][]entity.StoredCredential
+			return json.Unmarshal(credentials, &stored) == nil && len(stored["password"]) == 1 &&
+				stored["password"][0].StorageAlgo == hash.SHA256
+		})).Return(nil).Once()
+	s.mockRoleService.On("CreateRole", mock.Anything, mock.MatchedBy(func(detail role.RoleCreationDetail) bool {
+		return detail.Name == "dispatcher" && detail.OUID == "ou-1" &&
+			len(detail.Assignments) == 1 && detail.Assignments[0].ID == "user-1" &&
+			detail.Assignments[0].Type == role.AssigneeTypeUser &&
+			detail.Permissions[0].ResourceServerID == "rs-1"
+	})).Return(&role.RoleWithPermissionsAndAssignments{ID: "role-1"}, nil).Once()
+	s.mockApplicationService.On("CreateApplication", mock.Anything, mock.MatchedBy(func(
+		app *model.ApplicationDTO) bool {
+		return app.Name == "pickup-dispatch" && app.InboundAuthConfig[0].OAuthConfig.ClientSecret == "secret-1"
+	})).Return(&model.ApplicationDTO{ID: "app-1", InboundAuthConfig: []inboundmodel.InboundAuthConfigWithSecret{
+		{Type: inboundmodel.OAuthInboundAuthType,
+			OAuthConfig: &inboundmodel.OAuthConfigWithSecret{ClientSecret: "secret-1"}},
+	}}, nil).Once()
+
+	report, svcErr := s.service.Migrate(s.T().Context(), s.migrationRequest(true))
+
+	s.Require().Nil(svcErr)
+	s.True(report.Committed)
+	s.Equal(3, report.Summary.Created)
+	s.Equal(1, report.Summary.Skipped)
+	s.Equal("user-1", report.Items[0].TargetID)
+	s.Equal(ResultCreated, report.Items[0].Result)
+	s.Equal(ResultSkipped, report.Items[1].Result)
+	s.Equal("role-1", report.Items[2].TargetID)
+	s.Equal("app-1", report.Items[3].TargetID)
+	s.Empty(report.Items[3].ClientSecret)
+}
+
+// plaintextPasswordRequest returns a request migrating two users with plaintext passwords.
+func (s *ServiceTestSuite) plaintextPasswordRequest() MigrationRequest {
+	request := MigrationRequest{
+		OUID:                "ou-1",
+		UserType:            "person",
+		CredentialAttribute: "password",
+		Commit:              true,
+		Users:               []SCIMUser{{ID: "u-1", UserName: "alice"}, {ID: "u-2", UserName: "bob"}},
+		Credentials: []UserCredential{
+			{UserName: "alice", PasswordHash: "Passw0rd!", DigestFunction: "PLAIN_TEXT"},
+			{UserName: "bob", PasswordHash: "S3cret!", DigestFunction: "PLAIN_TEXT"},
+		},
+	}
+	s.mockOUService.On("IsOrganizationUnitExists", mock.Anything, "ou-1").Return(true, nil)
+	s.mockEntityTypeService.On("GetEntityTypeByName", mock.Anything, entitytype.TypeCategoryUser, "person").
+		Return(&entitytype.EntityType{Name: "person"}, nil)
+	s.mockUserService.On("ValidateUserTypeSamples", mock.Anything, mock.Anything).
+		Return(&entitytype.SampleValidationResponse{Results: []entitytype.SampleValidationResult{
+			{Index: 0, Valid: true}, {Index: 1, Valid: true},
+		}}, nil)
+	return request
+}
+
+func (s *ServiceTestSuite) TestMigrate_CommitPrehashesPlaintextPasswords() {
+	request := s.plaintextPasswordRequest()
+	s.mockUserService.On("CreateUser", mock.MatchedBy(func(ctx context.Context) bool {
+		return ctx.Value(prehashedContextKey{}) == true
+	}), mock.Anything).Return(&user.User{ID: "user-1"}, nil).Twice()
+
+	report, svcErr := s.service.Migrate(s.T().Context(), request)
+
+	s.Require().Nil(svcErr)
+	s.Equal(2, report.Summary.Created)
+	s.mockUserService.AssertNumberOfCalls(s.T(), "PrehashCredentials", 1)
+	s.mockUserService.AssertCalled(s.T(), "PrehashCredentials", mock.Anything,
+		mock.MatchedBy(func(users []*user.User) bool {
+			return len(users) == 2 && string(users[0].Attributes) == `{"password":"Passw0rd!","username":"alice"}` &&
+				string(users[1].Attributes) == `{"password":"S3cret!","username":"bob"}`
+		}))
+}
+
+func (s *ServiceTestSuite) TestMigrate_CommitPrehashFailureCreatesUsers() {
+	request := s.plaintextPasswordRequest()
+	s.mockUserService.ExpectedCalls = nil
+	s.mockUserService.On("ValidateUserTypeSamples", mock.Anything, mock.Anything).
+		Return(&entitytype.SampleValidationResponse{Results: []entitytype.SampleValidationResult{
+			{Index: 0, Valid: true}, {Index: 1, Valid: true},
+		}}, nil)
+	s.mockUserService.On("PrehashCredentials", mock.Anything, mock.Anything).
+		Return(nil, &serviceerror.InternalServerError).Once()
+	s.mockUserService.On("CreateUser", mock.Anything, mock.Anything).Return(&user.User{ID: "user-1"}, nil).Twice()
+
+	report, svcErr := s.service.Migrate(s.T().Context(), request)
+
+	s.Require().Nil(svcErr)
+	s.Equal(2, report.Summary.Created)
+}
+
+func (s *ServiceTestSuite) TestMigrate_CommitCredentialFailure() {
+	request := MigrationRequest{
+		OUID:     "ou-1",
+		UserType: "person",
+		Commit:   true,
+		Users:    []SCIMUser{{ID: "u-1", UserName: "alice"}},
+		Credentials: []UserCredential{{UserName: "alice", PasswordHash: "aGFzaA==", SaltValue: "salt",
+			DigestFunction: "SHA-256"}},
+		Roles: []SCIMRole{{DisplayName: "dispatcher", Users: []SCIMReference{{Value: "u-1"}}}},
+	}
+	s.mockOUService.On("IsOrganizationUnitExists", mock.Anything, "ou-1").Return(true, nil)
+	s.mockEntityTypeService.On("GetEntityTypeByName", mock.Anything, entitytype.TypeCategoryUser, "person").
+		Return(&entitytype.EntityType{Name: "person"}, nil)
+	s.mockUserService.On("ValidateUserTypeSamples", mock.Anything, mock.Anything).
+		Return(&entitytype.SampleValidationResponse{Results: []entitytype.SampleValidationResult{
+			{Index: 0, Valid: false, Violations: []entitytype.SchemaViolation{
+				{Attribute: "password", Reason: "is required"},
+			}},
+		}}, nil)
+	s.mockRoleService.On("GetRoleByName", mock.Anything, "ou-1", "dispatcher").
+		Return(nil, &role.ErrorRoleNotFound)
+	s.mockUserService.On("CreateUser", mock.Anything, mock.Anything).Return(&user.User{ID: "user-1"}, nil)
+	s.mockEntityService.On("UpdateCredentials", mock.Anything, "user-1", mock.Anything).
+		Return(errors.New("store failure"))
+	s.mockUserService.On("PurgeUser", mock.Anything, "user-1").Return(nil).Once()
+	s.mockRoleService.On("CreateRole", mock.Anything, mock.MatchedBy(func(detail role.RoleCreationDetail) bool {
+		return len(detail.Assignments) == 0
+	})).Return(&role.RoleWithPermissionsAndAssignments{ID: "role-1"}, nil).Once()
+
+	report, svcErr := s.service.Migrate(s.T().Context(), request)
+
+	s.Require().Nil(svcErr)
+	s.Equal(CompatibilityFull, report.Items[0].Compatibility)
+	s.Equal(ResultFailed, report.Items[0].Result)
+	s.Equal(serviceerror.InternalServerError.Code, report.Items[0].Code)
+	s.Empty(report.Items[0].TargetID)
+	s.Equal(ResultCreated, report.Items[1].Result)
+	s.Equal("users", report.Items[1].Notes[len(report.Items[1].Notes)-1].Field)
+}
+
+func (s *ServiceTestSuite) TestMigrate_CommitGeneratesClientSecret() {
+	sp := `<ServiceProvider><ApplicationName>backend</ApplicationName><InboundAuthenticationConfig>` +
+		`<InboundAuthenticationRequestConfigs><InboundAuthenticationRequestConfig>` +
+		`<InboundAuthKey>client-3</InboundAuthKey><InboundAuthType>oauth2</InboundAuthType>` +
+		`<inboundConfiguration><oAuthAppDO><grantTypes>client_credentials</grantTypes></oAuthAppDO>` +
+		`</inboundConfiguration></InboundAuthenticationRequestConfig></InboundAuthenticationRequestConfigs>` +
+		`</InboundAuthenticationConfig></ServiceProvider>`
+	s.mockOUService.On("IsOrganizationUnitExists", mock.Anything, "ou-1").Return(true, nil)
+	s.mockApplicationService.On("ValidateApplication", mock.Anything, mock.Anything).Return(nil, nil, nil)
+	s.mockApplicationService.On("CreateApplication", mock.Anything, mock.MatchedBy(func(
+		app *model.ApplicationDTO) bool {
+		return app.InboundAuthConfig[0].OAuthConfig.ClientID == "client-3"
+	})).Return(&model.ApplicationDTO{ID: "app-1", InboundAuthConfig: []inboundmodel.InboundAuthConfigWithSecret{
+		{Type: inboundmodel.OAuthInboundAuthType,
+			OAuthConfig: &inboundmodel.OAuthConfigWithSecret{ClientSecret: "generated"}},
+	}}, nil).Once()
+
+	report, svcErr := s.service.Migrate(s.T().Context(),
+		MigrationRequest{OUID: "ou-1", Commit: true, ServiceProviders: []string{sp}})
+
+	s.Require().Nil(svcErr)
+	s.Equal(CompatibilityPartial, report.Items[0].Compatibility)
+	s.Equal("generated", report.Items[0].ClientSecret)
+}
+
+func (s *ServiceTestSuite) TestMigrate_InvalidApplication() {
+	s.mockOUService.On("IsOrganizationUnitExists", mock.Anything, "ou-1").Return(true, nil)
+	s.mockApplicationService.On("ValidateApplication", mock.Anything, mock.Anything).
+		Return(nil, nil, &serviceerror.ServiceError{Type: serviceerror.ClientErrorType, Code: "APP-1022"})
+
+	report, svcErr := s.service.Migrate(s.T().Context(), MigrationRequest{OUID: "ou-1", Commit: true,
+		ServiceProviders: []string{testServiceProvider, "not xml"}})
+
+	s.Require().Nil(svcErr)
+	s.Equal(2, report.Summary.Incompatible)
+	s.Equal(2, report.Summary.Skipped)
+	s.Equal("serviceProviders[1]", report.Items[1].SourceName)
+}
+
+func (s *ServiceTestSuite) TestGetAcceptedCredentialFormats() {
+	formats := getAcceptedCredentialFormats(config.PasswordHashingConfig{
+		Algorithm: "pbkdf2",
+		Legacy: config.LegacyPasswordHashingConfig{
+			Formats: []config.LegacyPasswordFormatConfig{{Algorithm: "bcrypt"}},
+		},
+	})
+
+	s.Equal(acceptedFormats(hash.SHA256, hash.PBKDF2, hash.ARGON2ID, hash.BCRYPT), formats)
+	s.Equal(acceptedFormats(hash.SHA256, hash.PBKDF2, hash.ARGON2ID),
+		getAcceptedCredentialFormats(config.PasswordHashingConfig{}))
+}
@@ -0,0 +1,241 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"iter"
+	"net/http"
+	"time"
+)
+
+const applicationsPath = "/applications"
+
+// InboundAuthTypeOAuth is the inbound authentication type of OAuth 2.0 and OpenID Connect clients.
+const InboundAuthTypeOAuth = "oauth2"
+
+// InboundAuthProfile holds the sign-in settings of an application.
+type InboundAuthProfile struct {
+	AuthFlowID                string              `json:"authFlowId,omitempty"`
+	RegistrationFlowID        string              `json:"registrationFlowId,omitempty"`
+	IsRegistrationFlowEnabled bool                `json:"isRegistrationFlowEnabled"`
+	RecoveryFlowID            string              `json:"recoveryFlowId,omitempty"`
+	IsRecoveryFlowEnabled     bool                `json:"isRecoveryFlowEnabled"`
+	ThemeID                   string              `json:"themeId,omitempty"`
+	LayoutID                  string              `json:"layoutId,omitempty"`
+	Assertion                 *AssertionConfig    `json:"assertion,omitempty"`
+	LoginConsent              *LoginConsentConfig `json:"loginConsent,omitempty"`
+	AllowedUserTypes          []string            `json:"allowedUserTypes,omitempty"`
+	AllowedAuthMethods        *AuthMethodsConfig  `json:"allowedAuthMethods,omitempty"`
+	UserIsolation             bool                `json:"userIsolation,omitempty"`
+	Certificate               *Certificate        `json:"certificate,omitempty"`
+}
+
+// AssertionConfig holds the validity period and user attributes of the assertions issued to an application.
+type AssertionConfig struct {
+	ValidityPeriod int64    `json:"validityPeriod,omitempty"`
+	UserAttributes []string `json:"userAttributes,omitempty"`
+}
+
+// LoginConsentConfig holds the login consent settings of an application.
+type LoginConsentConfig struct {
+	ValidityPeriod int64 `json:"validityPeriod"`
+}
+
+// AuthMethodsConfig restricts the authentication methods that can be used to sign in to an application.
+type AuthMethodsConfig struct {
+	Local         bool     `json:"local"`
+	Passkey       bool     `json:"passkey"`
+	FederatedIDPs []string `json:"federatedIdps,omitempty"`
+}
+
+// Certificate is a certificate given as a JWKS document (type JWKS) or a JWKS URI (type JWKS_URI).
+type Certificate struct {
+	Type  string `json:"type,omitempty"`
+	Value string `json:"value,omitempty"`
+}
+
+// InboundAuthConfig holds the protocol configuration of an application.
+type InboundAuthConfig struct {
+	Type   string       `json:"type"`
+	Config *OAuthConfig `json:"config,omitempty"`
+}
+
+// OAuthConfig is the OAuth 2.0 configuration of an application. The token, logout, user info, claim
+// fallback and token customization settings are kept in their JSON form; see the application management API
+// reference for their structure.
+type OAuthConfig struct {
+	ClientID string `json:"clientId,omitempty"`
+	// ClientSecret is only returned when the secret is issued, by Create and Update.
+	ClientSecret                       string              `json:"clientSecret,omitempty"`
+	RedirectURIs                       []string            `json:"redirectUris,omitempty"`
+	RedirectURIMatchMode               string              `json:"redirectUriMatchMode,omitempty"`
+	GrantTypes                         []string            `json:"grantTypes,omitempty"`
+	ResponseTypes                      []string            `json:"responseTypes,omitempty"`
+	TokenEndpointAuthMethod            string              `json:"tokenEndpointAuthMethod,omitempty"`
+	PKCERequired                       bool                `json:"pkceRequired"`
+	PublicClient                       bool                `json:"publicClient"`
+	RequirePushedAuthorizationRequests bool                `json:"requirePushedAuthorizationRequests"`
+	AllowCredentialDiscovery           bool                `json:"allowCredentialDiscovery"`
+	ProtocolTraceEnabled               bool                `json:"protocolTraceEnabled"`
+	RequestObjectSigningAlg            string              `json:"requestObjectSigningAlg,omitempty"`
+	DPoPBoundAccessTokens              bool                `json:"dpopBoundAccessTokens"`
+	Token                              json.RawMessage     `json:"token,omitempty"`
+	Scopes                             []string            `json:"scopes,omitempty"`
+	AllowedScopes                      []string            `json:"allowedScopes,omitempty"`
+	RejectDisallowedScopes             bool                `json:"rejectDisallowedScopes"`
+	AllowedScopesByGrantType           map[string][]string `json:"allowedScopesByGrantType,omitempty"`
+	Logout                             json.RawMessage     `json:"logout,omitempty"`
+	UserInfo                           json.RawMessage     `json:"userInfo,omitempty"`
+	ScopeClaims                        map[string][]string `json:"scopeClaims,omitempty"`
+	Certificate                        *Certificate        `json:"certificate,omitempty"`
+	AcrValues                          []string            `json:"acrValues,omitempty"`
+	ClaimFallbacks                     json.RawMessage     `json:"claimFallbacks,omitempty"`
+	TokenCustomization                 json.RawMessage     `json:"tokenCustomization,omitempty"`
+}
+
+// Application is an application.
+type Application struct {
+	ID          string   `json:"id,omitempty"`
+	OUID        string   `json:"ouId,omitempty"`
+	Name        string   `json:"name"`
+	Description string   `json:"description,omitempty"`
+	ClientID    string   `json:"clientId,omitempty"`
+	Template    string   `json:"template,omitempty"`
+	URL         string   `json:"url,omitempty"`
+	LogoURL     string   `json:"logoUrl,omitempty"`
+	TosURI      string   `json:"tosUri,omitempty"`
+	PolicyURI   string   `json:"policyUri,omitempty"`
+	Contacts    []string `json:"contacts,omitempty"`
+
+	InboundAuthProfile
+	InboundAuthConfig []InboundAuthConfig `json:"inboundAuthConfig,omitempty"`
+	Metadata          map[string]any      `json:"metadata,omitempty"`
+	LastTokenIssuedAt *time.Time          `json:"lastTokenIssuedAt,omitempty"`
+	LastAuthorizedAt  *time.Time          `json:"lastAuthorizedAt,omitempty"`
+}
+
+// ApplicationSummary is an application as returned in lists.
+type ApplicationSummary struct {
+	ID                        string     `json:"id,omitempty"`
+	Name                      string     `json:"name"`
+	Description               string     `json:"description,omitempty"`
+	ClientID                  string     `json:"clientId,omitempty"`
+	LogoURL                   string     `json:"logoUrl,omitempty"`
+	AuthFlowID                string     `json:"authFlowId,omitempty"`
+	RegistrationFlowID        string     `json:"registrationFlowId,omitempty"`
+	IsRegistrationFlowEnabled bool       `json:"isRegistrationFlowEnabled"`
+	RecoveryFlowID            string     `json:"recoveryFlowId,omitempty"`
+	IsRecoveryFlowEnabled     bool       `json:"isRecoveryFlowEnabled"`
+	ThemeID                   string     `json:"themeId,omitempty"`
+	LayoutID                  string     `json:"layoutId,omitempty"`
+	Template                  string     `json:"template,omitempty"`
+	IsReadOnly                bool       `json:"isReadOnly"`
+	LastTokenIssuedAt         *time.Time `json:"lastTokenIssuedAt,omitempty"`
+	LastAuthorizedAt          *time.Time `json:"lastAuthorizedAt,omitempty"`
+}
+
+// ApplicationRequest is the request to create or update an application.
+type ApplicationRequest struct {
+	OUID        string   `json:"ouId,omitempty"`
+	Name        string   `json:"name"`
+	Description string   `json:"description"`
+	Template    string   `json:"template,omitempty"`
+	URL         string   `json:"url,omitempty"`
+	LogoURL     string   `json:"logoUrl,omitempty"`
+	TosURI      string   `json:"tosUri,omitempty"`
+	PolicyURI   string   `json:"policyUri,omitempty"`
+	Contacts    []string `json:"contacts,omitempty"`
+
+	InboundAuthProfile
+	InboundAuthConfig []InboundAuthConfig `json:"inboundAuthConfig,omitempty"`
+	Metadata          map[string]any      `json:"metadata,omitempty"`
+}
+
+// ApplicationListOptions selects a page of applications.
+type ApplicationListOptions struct {
+	ListOptions
+	// InactiveSince narrows the list to the applications whose OAuth client has not been used since then.
+	InactiveSince time.Time
+}
+
+// ApplicationService manages applications.
+type ApplicationService struct {
+	client *Client
+}
+
+// List returns a page of applications. The complete list is returned when the options set neither a limit
+// nor an offset.
+func (s *ApplicationService) List(ctx context.Context,
+	opts *ApplicationListOptions) (*Page[ApplicationSummary], error) {
+	if opts == nil {
+		opts = &ApplicationListOptions{}
+	}
+	query := opts.values()
+	if !opts.InactiveSince.IsZero() {
+		query.Set("inactiveSince", opts.InactiveSince.Format(time.RFC3339))
+	}
+	return getPage[ApplicationSummary](ctx, s.client, applicationsPath, "applications", query)
+}
+
+// All returns an iterator over the applications, starting at the offset of the options.
+func (s *ApplicationService) All(ctx context.Context,
+	opts *ApplicationListOptions) iter.Seq2[ApplicationSummary, error] {
+	if opts == nil {
+		opts = &ApplicationListOptions{}
+	}
+	return paginate(ctx, &opts.ListOptions,
+		func(ctx context.Context, page *ListOptions) (*Page[ApplicationSummary], error) {
+			return s.List(ctx, &ApplicationListOptions{ListOptions: *page, InactiveSince: opts.InactiveSince})
+		})
+}
+
+// Get returns the application with the given ID.
+func (s *ApplicationService) Get(ctx context.Context, id string) (*Application, error) {
+	var app Application
+	if err := s.client.do(ctx, http.MethodGet, applicationsPath+"/"+escape(id), nil, nil, &app); err != nil {
+		return nil, err
+	}
+	return &app, nil
+}
+
+// Create creates an application. The returned application carries the issued client secret, which cannot
+// be read again later.
+func (s *ApplicationService) Create(ctx context.Context, req *ApplicationRequest) (*Application, error) {
+	var app Application
+	if err := s.client.do(ctx, http.MethodPost, applicationsPath, nil, req, &app); err != nil {
+		return nil, err
+	}
+	return &app, nil
+}
+
+// Update updates the application with the given ID.
+func (s *ApplicationService) Update(ctx context.Context, id string, req *ApplicationRequest) (*Application, error) {
+	var app Application
+	if err := s.client.do(ctx, http.MethodPut, applicationsPath+"/"+escape(id), nil, req, &app); err != nil {
+		return nil, err
+	}
+	return &app, nil
+}
+
+// Delete deletes the application with the given ID.
+func (s *ApplicationService) Delete(ctx context.Context, id string) error {
+	return s.client.do(ctx, http.MethodDelete, applicationsPath+"/"+escape(id), nil, nil, nil)
+}
@@ -0,0 +1,295 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	defaultTimeout   = 30 * time.Second
+	defaultUserAgent = "thunder-go-client"
+	contentTypeJSON  = "application/json"
+	contentTypeForm  = "application/x-www-form-urlencoded"
+)
+
+// Client is a client for the Thunder APIs. A Client is safe for concurrent use.
+type Client struct {
+	baseURL     *url.URL
+	httpClient  *http.Client
+	tokenSource TokenSource
+	credentials *ClientCredentials
+	scopes      []string
+	retry       RetryPolicy
+	userAgent   string
+
+	// Users manages users.
+	Users *UserService
+	// Applications manages applications.
+	Applications *ApplicationService
+	// Flows manages flow definitions.
+	Flows *FlowService
+	// OrganizationUnits manages organization units.
+	OrganizationUnits *OrganizationUnitService
+	// Roles manages roles and role assignments.
+	Roles *RoleService
+	// Tokens calls the OAuth 2.0 token and introspection endpoints.
+	Tokens *TokenService
+}
+
+// Option configures a Client.
+type Option func(*Client)
+
+// WithHTTPClient sets the HTTP client used to send requests. The default client has a 30 second timeout.
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(c *Client) {
+		c.httpClient = httpClient
+	}
+}
+
+// WithToken authenticates the management API requests with a fixed bearer token.
+func WithToken(token string) Option {
+	return WithTokenSource(StaticTokenSource(token))
+}
+
+// WithTokenSource authenticates the management API requests with tokens obtained from the given source.
+func WithTokenSource(source TokenSource) Option {
+	return func(c *Client) {
+		c.tokenSource = source
+	}
+}
+
+// WithClientCredentials authenticates the management API requests with access tokens obtained through the
+// client credentials grant. Tokens are cached and renewed shortly before they expire. The credentials are
+// also used by the TokenService methods that are not given explicit credentials.
+func WithClientCredentials(clientID, clientSecret string, scopes ...string) Option {
+	return func(c *Client) {
+		c.credentials = &ClientCredentials{ClientID: clientID, ClientSecret: clientSecret}
+		c.scopes = scopes
+	}
+}
+
+// WithRetry sets the retry policy. DefaultRetryPolicy is used when this option is not given.
+func WithRetry(policy RetryPolicy) Option {
+	return func(c *Client) {
+		c.retry = policy
+	}
+}
+
+// WithUserAgent sets the User-Agent header sent with every request.
+func WithUserAgent(userAgent string) Option {
+	return func(c *Client) {
+		c.userAgent = userAgent
+	}
+}
+
+// New creates a client for the Thunder server at baseURL, for example https://localhost:8090.
+func New(baseURL string, opts ...Option) (*Client, error) {
+	u, err := url.Parse(strings.TrimSuffix(baseURL, "/"))
+	if err != nil {
+		return nil, fmt.Errorf("thunder: invalid base URL: %w", err)
+	}
+	if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil, fmt.Errorf("thunder: invalid base URL %q: an absolute http or https URL is required", baseURL)
+	}
+
+	c := &Client{
+		baseURL:    u,
+		httpClient: &http.Client{Timeout: defaultTimeout},
+		retry:      DefaultRetryPolicy,
+		userAgent:  defaultUserAgent,
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	if c.httpClient == nil {
+		return nil, errors.New("thunder: the HTTP client must not be nil")
+	}
+
+	c.Users = &UserService{client: c}
+	c.Applications = &ApplicationService{client: c}
+	c.Flows = &FlowService{client: c}
+	c.OrganizationUnits = &OrganizationUnitService{client: c}
+	c.Roles = &RoleService{client: c}
+	c.Tokens = &TokenService{client: c}
+
+	if c.tokenSource == nil && c.credentials != nil {
+		c.tokenSource = &clientCredentialsTokenSource{
+			tokens:      c.Tokens,
+			credentials: *c.credentials,
+			scopes:      c.scopes,
+		}
+	}
+	return c, nil
+}
+
+// request describes a single API call.
+type request struct {
+	method      string
+	path        string
+	query       url.Values
+	body        []byte
+	contentType string
+	// credentials authenticates the request with HTTP basic authentication instead of a bearer token.
+	credentials *ClientCredentials
+	// decodeError converts an error response into an error. decodeAPIError is used when it is nil.
+	decodeError func(status int, header http.Header, data []byte) error
+}
+
+// do sends a JSON request to the management API and decodes the response into out.
+func (c *Client) do(ctx context.Context, method, path string, query url.Values, body, out any) error {
+	req := &request{method: method, path: path, query: query}
+	if body != nil {
+		payload, err := json.Marshal(body)
+		if err != nil {
+			return fmt.Errorf("thunder: failed to encode the request: %w", err)
+		}
+		req.body = payload
+		req.contentType = contentTypeJSON
+	}
+
+	data, err := c.send(ctx, req)
+	if err != nil {
+		return err
+	}
+	if out == nil || len(data) == 0 {
+		return nil
+	}
+	if err := json.Unmarshal(data, out); err != nil {
+		return fmt.Errorf("thunder: failed to decode the response of %s %s: %w", method, path, err)
+	}
+	return nil
+}
+
+// send sends the request, retrying it according to the retry policy, and returns the response body of a
+// successful response.
+func (c *Client) send(ctx context.Context, req *request) ([]byte, error) {
+	// The request path holds escaped segments, so both forms of the path are set.
+	target := *c.baseURL
+	target.RawPath = c.baseURL.EscapedPath() + req.path
+	path, err := url.PathUnescape(target.RawPath)
+	if err != nil {
+		return nil, fmt.Errorf("thunder: invalid request path %q: %w", req.path, err)
+	}
+	target.Path = path
+	target.RawQuery = req.query.Encode()
+
+	reauthenticated := false
+	for attempt := 1; ; attempt++ {
+		token := ""
+		if req.credentials == nil && c.tokenSource != nil {
+			var err error
+			if token, err = c.tokenSource.Token(ctx); err != nil {
+				return nil, fmt.Errorf("thunder: failed to obtain an access token: %w", err)
+			}
+		}
+
+		status, header, data, err := c.roundTrip(ctx, req, target.String(), token)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+			if attempt < c.retry.MaxAttempts && isIdempotent(req.method) {
+				if waitErr := sleep(ctx, c.retry.backoff(attempt)); waitErr != nil {
+					return nil, waitErr
+				}
+				continue
+			}
+			return nil, fmt.Errorf("thunder: %s %s failed: %w", req.method, req.path, err)
+		}
+		if status < http.StatusBadRequest {
+			return data, nil
+		}
+
+		// A rejected cached token is renewed once before giving up.
+		if status == http.StatusUnauthorized && req.credentials == nil && !reauthenticated {
+			if inv, ok := c.tokenSource.(invalidator); ok {
+				inv.invalidate()
+				reauthenticated = true
+				attempt--
+				continue
+			}
+		}
+
+		if attempt < c.retry.MaxAttempts {
+			if delay, ok := c.retry.retryDelay(req.method, status, header, attempt); ok {
+				if waitErr := sleep(ctx, delay); waitErr != nil {
+					return nil, waitErr
+				}
+				continue
+			}
+		}
+
+		if req.decodeError != nil {
+			return nil, req.decodeError(status, header, data)
+		}
+		return nil, decodeAPIError(status, header, data)
+	}
+}
+
+// roundTrip sends one attempt of the request and reads the whole response.
+func (c *Client) roundTrip(ctx context.Context, req *request, target, token string) (
+	int, http.Header, []byte, error) {
+	var body io.Reader
+	if req.body != nil {
+		body = bytes.NewReader(req.body)
+	}
+	httpReq, err := http.NewRequestWithContext(ctx, req.method, target, body)
+	if err != nil {
+		return 0, nil, nil, err
+	}
+	httpReq.Header.Set("Accept", contentTypeJSON)
+	httpReq.Header.Set("User-Agent", c.userAgent)
+	if req.contentType != "" {
+		httpReq.Header.Set("Content-Type", req.contentType)
+	}
+
+	if req.credentials != nil {
+		// RFC 6749 section 2.3.1 requires the credentials to be form encoded before basic encoding.
+		httpReq.SetBasicAuth(url.QueryEscape(req.credentials.ClientID), url.QueryEscape(req.credentials.ClientSecret))
+	} else if token != "" {
+		httpReq.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	resp, err := c.httpClient.Do(httpReq)
+	if err != nil {
+		return 0, nil, nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return 0, nil, nil, fmt.Errorf("failed to read the response: %w", err)
+	}
+	return resp.StatusCode, resp.Header, data, nil
+}
+
+// escape escapes a value for use as a single path segment.
+func escape(value string) string {
+	return url.PathEscape(value)
+}
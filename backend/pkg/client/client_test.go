@@ -0,0 +1,323 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package client
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fastRetry retries quickly so that the tests do not wait.
+var fastRetry = RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Millisecond, MaxBackoff: 2 * time.Millisecond}
+
+func newTestClient(t *testing.T, handler http.HandlerFunc, opts ...Option) *Client {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+	c, err := New(server.URL+"/", append([]Option{WithRetry(fastRetry)}, opts...)...)
+	require.NoError(t, err)
+	return c
+}
+
+func TestNew_RejectsInvalidBaseURL(t *testing.T) {
+	for _, baseURL := range []string{"", "localhost:8090", "ftp://localhost", "https://", "://bad"} {
+		_, err := New(baseURL)
+		assert.Error(t, err, baseURL)
+	}
+}
+
+func TestNew_RejectsNilHTTPClient(t *testing.T) {
+	_, err := New("https://localhost:8090", WithHTTPClient(nil))
+	assert.Error(t, err)
+}
+
+func TestClient_SendsRequest(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method)
+		assert.Equal(t, "/base/users/a%2Fb", r.URL.EscapedPath())
+		assert.Equal(t, "Bearer abc", r.Header.Get("Authorization"))
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		assert.Equal(t, "my-agent", r.Header.Get("User-Agent"))
+		body, _ := io.ReadAll(r.Body)
+		assert.JSONEq(t, `{"type":"employee"}`, string(body))
+		_, _ = w.Write([]byte(`{"id":"a/b","type":"employee","isReadOnly":false}`))
+	}, WithToken("abc"), WithUserAgent("my-agent"))
+	base := *c.baseURL
+	base.Path += "/base"
+	c.baseURL = &base
+
+	user, err := c.Users.Update(context.Background(), "a/b", &UpdateUserRequest{Type: "employee"})
+
+	require.NoError(t, err)
+	assert.Equal(t, "a/b", user.ID)
+}
+
+func TestClient_MapsAPIErrors(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"code":"USR-1003","message":{"key":"k","defaultValue":"User not found"},` +
+			`"description":{"key":"d","defaultValue":"No user with the ID"}}`))
+	})
+
+	_, err := c.Users.Get(context.Background(), "missing")
+
+	require.Error(t, err)
+	assert.ErrorIs(t, err, ErrNotFound)
+	assert.NotErrorIs(t, err, ErrConflict)
+	var apiErr *APIError
+	require.ErrorAs(t, err, &apiErr)
+	assert.Equal(t, http.StatusNotFound, apiErr.StatusCode)
+	assert.Equal(t, "USR-1003", apiErr.Code)
+	assert.Equal(t, "User not found", apiErr.Message)
+	assert.Equal(t, "thunder: USR-1003: User not found: No user with the ID", apiErr.Error())
+}
+
+func TestClient_MapsErrorsWithoutBody(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	err := c.Roles.Delete(context.Background(), "r1")
+
+	assert.ErrorIs(t, err, ErrServer)
+	assert.EqualError(t, err, "thunder: server returned 500 Internal Server Error")
+}
+
+func TestStatusMatches(t *testing.T) {
+	cases := map[error]int{
+		ErrBadRequest:   http.StatusBadRequest,
+		ErrUnauthorized: http.StatusUnauthorized,
+		ErrForbidden:    http.StatusForbidden,
+		ErrNotFound:     http.StatusNotFound,
+		ErrConflict:     http.StatusConflict,
+		ErrRateLimited:  http.StatusTooManyRequests,
+		ErrUnavailable:  http.StatusServiceUnavailable,
+		ErrServer:       http.StatusBadGateway,
+	}
+	for sentinel, status := range cases {
+		assert.ErrorIs(t, &APIError{StatusCode: status}, sentinel)
+		assert.ErrorIs(t, &OAuthError{StatusCode: status}, sentinel)
+	}
+	assert.NotErrorIs(t, &APIError{StatusCode: http.StatusNotFound}, errors.New("other"))
+}
+
+func TestClient_RetriesIdempotentRequests(t *testing.T) {
+	var calls atomic.Int32
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) < 3 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		_, _ = w.Write([]byte(`{"id":"r1","name":"admin"}`))
+	})
+
+	role, err := c.Roles.Get(context.Background(), "r1")
+
+	require.NoError(t, err)
+	assert.Equal(t, "admin", role.Name)
+	assert.Equal(t, int32(3), calls.Load())
+}
+
+func TestClient_GivesUpAfterMaxAttempts(t *testing.T) {
+	var calls atomic.Int32
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+
+	_, err := c.Roles.Get(context.Background(), "r1")
+
+	assert.ErrorIs(t, err, ErrUnavailable)
+	assert.Equal(t, int32(3), calls.Load())
+}
+
+func TestClient_DoesNotRetryUnsafePostOnServerError(t *testing.T) {
+	var calls atomic.Int32
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusBadGateway)
+	})
+
+	_, err := c.Roles.Create(context.Background(), &CreateRoleRequest{Name: "admin"})
+
+	assert.ErrorIs(t, err, ErrServer)
+	assert.Equal(t, int32(1), calls.Load())
+}
+
+func TestClient_RetriesShedPostWithRetryAfter(t *testing.T) {
+	var calls atomic.Int32
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		body, _ := io.ReadAll(r.Body)
+		assert.JSONEq(t, `{"name":"admin","ouId":"","permissions":null}`, string(body))
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"id":"r1","name":"admin"}`))
+	})
+
+	role, err := c.Roles.Create(context.Background(), &CreateRoleRequest{Name: "admin"})
+
+	require.NoError(t, err)
+	assert.Equal(t, "r1", role.ID)
+	assert.Equal(t, int32(2), calls.Load())
+}
+
+func TestClient_RetriesRateLimitedRequests(t *testing.T) {
+	var calls atomic.Int32
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) == 1 {
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	err := c.Roles.AddAssignments(context.Background(), "r1", []Assignment{{ID: "u1", Type: AssigneeTypeUser}})
+
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), calls.Load())
+}
+
+func TestClient_DoesNotWaitForLongRetryAfter(t *testing.T) {
+	var calls atomic.Int32
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.Header().Set("Retry-After", "3600")
+		w.WriteHeader(http.StatusTooManyRequests)
+	})
+
+	_, err := c.Users.Get(context.Background(), "u1")
+
+	assert.ErrorIs(t, err, ErrRateLimited)
+	assert.Equal(t, int32(1), calls.Load())
+}
+
+func TestClient_RetriesNetworkErrors(t *testing.T) {
+	var calls atomic.Int32
+	transport := roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		if calls.Add(1) == 1 {
+			return nil, errors.New("connection reset")
+		}
+		return &http.Response{StatusCode: http.StatusNoContent, Body: http.NoBody, Header: http.Header{}}, nil
+	})
+	c, err := New("https://thunder.example", WithHTTPClient(&http.Client{Transport: transport}),
+		WithRetry(fastRetry))
+	require.NoError(t, err)
+
+	require.NoError(t, c.Users.Delete(context.Background(), "u1"))
+	assert.Equal(t, int32(2), calls.Load())
+}
+
+func TestClient_StopsWhenContextIsCanceled(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+	}, WithRetry(RetryPolicy{MaxAttempts: 5, InitialBackoff: time.Hour, MaxBackoff: time.Hour}))
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	_, err := c.Users.Get(ctx, "u1")
+
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestClient_RenewsRejectedCachedToken(t *testing.T) {
+	var tokenRequests atomic.Int32
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == tokenPath {
+			n := tokenRequests.Add(1)
+			_, _ = w.Write([]byte(`{"access_token":"t` + string(rune('0'+n)) + `","expires_in":3600}`))
+			return
+		}
+		if r.Header.Get("Authorization") != "Bearer t2" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_, _ = w.Write([]byte(`{"id":"u1"}`))
+	}, WithClientCredentials("cid", "secret", "system"))
+
+	user, err := c.Users.Get(context.Background(), "u1")
+
+	require.NoError(t, err)
+	assert.Equal(t, "u1", user.ID)
+	assert.Equal(t, int32(2), tokenRequests.Load())
+}
+
+func TestClient_ReturnsTokenErrors(t *testing.T) {
+	var apiCalls atomic.Int32
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == tokenPath {
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"error":"invalid_client","error_description":"Client authentication failed"}`))
+			return
+		}
+		apiCalls.Add(1)
+	}, WithClientCredentials("cid", "wrong"))
+
+	_, err := c.Users.Get(context.Background(), "u1")
+
+	assert.ErrorIs(t, err, ErrUnauthorized)
+	var oauthErr *OAuthError
+	require.ErrorAs(t, err, &oauthErr)
+	assert.Equal(t, "invalid_client", oauthErr.ErrorCode)
+	assert.Equal(t, int32(0), apiCalls.Load())
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	d, ok := parseRetryAfter("7")
+	assert.True(t, ok)
+	assert.Equal(t, 7*time.Second, d)
+
+	d, ok = parseRetryAfter(time.Now().Add(-time.Minute).UTC().Format(http.TimeFormat))
+	assert.True(t, ok)
+	assert.Zero(t, d)
+
+	for _, value := range []string{"", "-1", "soon"} {
+		_, ok = parseRetryAfter(value)
+		assert.False(t, ok, value)
+	}
+}
+
+func TestRetryPolicy_BackoffIsCapped(t *testing.T) {
+	p := RetryPolicy{MaxAttempts: 10, InitialBackoff: 100 * time.Millisecond, MaxBackoff: time.Second}
+	for attempt := 1; attempt <= 8; attempt++ {
+		d := p.backoff(attempt)
+		assert.LessOrEqual(t, d, time.Second)
+		assert.GreaterOrEqual(t, d, 50*time.Millisecond)
+	}
+	assert.Zero(t, NoRetry.backoff(1))
+}
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(r *http.Request) (*http.Response, error) {
+	return f(r)
+}
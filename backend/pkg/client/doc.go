@@ -0,0 +1,38 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package client is a typed Go client for the Thunder management and OAuth 2.0 APIs.
+//
+// A Client is created with New and exposes one service per resource:
+//
+//	c, err := client.New("https://localhost:8090",
+//		client.WithClientCredentials("my-client", "my-secret", "system"))
+//	if err != nil {
+//		return err
+//	}
+//	for u, err := range c.Users.All(ctx, nil) {
+//		if err != nil {
+//			return err
+//		}
+//		fmt.Println(u.ID)
+//	}
+//
+// Every call takes a context, failed requests are retried with exponential backoff when it is safe to do
+// so, and error responses are returned as *APIError or *OAuthError values that match the exported
+// sentinel errors with errors.Is.
+package client
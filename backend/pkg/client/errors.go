@@ -0,0 +1,168 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package client
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// Sentinel errors matched by *APIError and *OAuthError values with errors.Is.
+var (
+	// ErrBadRequest matches 400 responses.
+	ErrBadRequest = errors.New("thunder: bad request")
+	// ErrUnauthorized matches 401 responses.
+	ErrUnauthorized = errors.New("thunder: unauthorized")
+	// ErrForbidden matches 403 responses.
+	ErrForbidden = errors.New("thunder: forbidden")
+	// ErrNotFound matches 404 responses.
+	ErrNotFound = errors.New("thunder: not found")
+	// ErrConflict matches 409 responses.
+	ErrConflict = errors.New("thunder: conflict")
+	// ErrRateLimited matches 429 responses.
+	ErrRateLimited = errors.New("thunder: rate limited")
+	// ErrUnavailable matches 503 responses.
+	ErrUnavailable = errors.New("thunder: service unavailable")
+	// ErrServer matches every 5xx response.
+	ErrServer = errors.New("thunder: server error")
+)
+
+// APIError is an error response of the management API.
+type APIError struct {
+	// StatusCode is the HTTP status code of the response.
+	StatusCode int
+	// Code is the error code, for example USR-1003. It is empty when the body is not an API error.
+	Code string
+	// Message is the default message of the error.
+	Message string
+	// Description is the default description of the error.
+	Description string
+}
+
+// Error implements the error interface.
+func (e *APIError) Error() string {
+	if e.Code == "" {
+		return fmt.Sprintf("thunder: server returned %d %s", e.StatusCode, http.StatusText(e.StatusCode))
+	}
+	msg := fmt.Sprintf("thunder: %s: %s", e.Code, e.Message)
+	if e.Description != "" {
+		msg += ": " + e.Description
+	}
+	return msg
+}
+
+// Is reports whether the error matches one of the sentinel errors of its status code.
+func (e *APIError) Is(target error) bool {
+	return statusMatches(e.StatusCode, target)
+}
+
+// OAuthError is an error response of an OAuth 2.0 endpoint, as defined in RFC 6749 section 5.2.
+type OAuthError struct {
+	// StatusCode is the HTTP status code of the response.
+	StatusCode int
+	// ErrorCode is the OAuth 2.0 error code, for example invalid_client.
+	ErrorCode string
+	// Description is the human readable error description.
+	Description string
+	// URI identifies a page with information about the error.
+	URI string
+}
+
+// Error implements the error interface.
+func (e *OAuthError) Error() string {
+	if e.ErrorCode == "" {
+		return fmt.Sprintf("thunder: server returned %d %s", e.StatusCode, http.StatusText(e.StatusCode))
+	}
+	msg := "thunder: " + e.ErrorCode
+	if e.Description != "" {
+		msg += ": " + e.Description
+	}
+	return msg
+}
+
+// Is reports whether the error matches one of the sentinel errors of its status code.
+func (e *OAuthError) Is(target error) bool {
+	return statusMatches(e.StatusCode, target)
+}
+
+// statusMatches reports whether the sentinel error target describes the status code.
+func statusMatches(status int, target error) bool {
+	switch target {
+	case ErrBadRequest:
+		return status == http.StatusBadRequest
+	case ErrUnauthorized:
+		return status == http.StatusUnauthorized
+	case ErrForbidden:
+		return status == http.StatusForbidden
+	case ErrNotFound:
+		return status == http.StatusNotFound
+	case ErrConflict:
+		return status == http.StatusConflict
+	case ErrRateLimited:
+		return status == http.StatusTooManyRequests
+	case ErrUnavailable:
+		return status == http.StatusServiceUnavailable
+	case ErrServer:
+		return status >= http.StatusInternalServerError
+	}
+	return false
+}
+
+// i18nMessage is a translatable message of an API error body.
+type i18nMessage struct {
+	Key          string `json:"key"`
+	DefaultValue string `json:"defaultValue"`
+}
+
+// apiErrorBody is the error body returned by the management API.
+type apiErrorBody struct {
+	Code        string      `json:"code"`
+	Message     i18nMessage `json:"message"`
+	Description i18nMessage `json:"description"`
+}
+
+// decodeAPIError converts a management API error response into an *APIError.
+func decodeAPIError(status int, _ http.Header, data []byte) error {
+	apiErr := &APIError{StatusCode: status}
+	var body apiErrorBody
+	if err := json.Unmarshal(data, &body); err == nil {
+		apiErr.Code = body.Code
+		apiErr.Message = body.Message.DefaultValue
+		apiErr.Description = body.Description.DefaultValue
+	}
+	return apiErr
+}
+
+// decodeOAuthError converts an OAuth 2.0 error response into an *OAuthError.
+func decodeOAuthError(status int, _ http.Header, data []byte) error {
+	oauthErr := &OAuthError{StatusCode: status}
+	var body struct {
+		Error            string `json:"error"`
+		ErrorDescription string `json:"error_description"`
+		ErrorURI         string `json:"error_uri"`
+	}
+	if err := json.Unmarshal(data, &body); err == nil {
+		oauthErr.ErrorCode = body.Error
+		oauthErr.Description = body.ErrorDescription
+		oauthErr.URI = body.ErrorURI
+	}
+	return oauthErr
+}
@@ -0,0 +1,146 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"iter"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// iteratorPageSize is the page size used by the All iterators when the options do not set a limit.
+const iteratorPageSize = 100
+
+// ListOptions selects a page of a list.
+type ListOptions struct {
+	// Limit is the maximum number of items in the page. The server default is used when it is zero.
+	Limit int
+	// Offset is the number of items to skip.
+	Offset int
+}
+
+// values returns the query parameters of the options.
+func (o *ListOptions) values() url.Values {
+	query := url.Values{}
+	if o == nil {
+		return query
+	}
+	if o.Limit > 0 {
+		query.Set("limit", strconv.Itoa(o.Limit))
+	}
+	if o.Offset > 0 {
+		query.Set("offset", strconv.Itoa(o.Offset))
+	}
+	return query
+}
+
+// Link is a pagination link of a list response.
+type Link struct {
+	Href string `json:"href"`
+	Rel  string `json:"rel"`
+}
+
+// Page is one page of a list.
+type Page[T any] struct {
+	// TotalResults is the number of items in the whole list.
+	TotalResults int
+	// StartIndex is the one based position of the first item of the page in the list.
+	StartIndex int
+	// Count is the number of items in the page.
+	Count int
+	// Items holds the items of the page.
+	Items []T
+	// Links holds the links to the neighboring pages.
+	Links []Link
+}
+
+// pageEnvelope holds the fields shared by every list response.
+type pageEnvelope struct {
+	TotalResults int    `json:"totalResults"`
+	StartIndex   int    `json:"startIndex"`
+	Count        int    `json:"count"`
+	Links        []Link `json:"links"`
+}
+
+// getPage fetches a page of a list whose items are returned under the given key.
+func getPage[T any](ctx context.Context, c *Client, path, key string, query url.Values) (*Page[T], error) {
+	var data json.RawMessage
+	if err := c.do(ctx, http.MethodGet, path, query, nil, &data); err != nil {
+		return nil, err
+	}
+
+	var envelope pageEnvelope
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(data, &envelope); err != nil {
+		return nil, fmt.Errorf("thunder: failed to decode the response of GET %s: %w", path, err)
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, fmt.Errorf("thunder: failed to decode the response of GET %s: %w", path, err)
+	}
+
+	page := &Page[T]{
+		TotalResults: envelope.TotalResults,
+		StartIndex:   envelope.StartIndex,
+		Count:        envelope.Count,
+		Links:        envelope.Links,
+	}
+	if items, ok := raw[key]; ok {
+		if err := json.Unmarshal(items, &page.Items); err != nil {
+			return nil, fmt.Errorf("thunder: failed to decode the response of GET %s: %w", path, err)
+		}
+	}
+	return page, nil
+}
+
+// paginate returns an iterator over every item of a list, starting from the offset of the options and
+// fetching the pages on demand. Iteration stops after the first error, which is yielded with a zero item.
+func paginate[T any](ctx context.Context, opts *ListOptions,
+	fetch func(context.Context, *ListOptions) (*Page[T], error)) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		current := ListOptions{Limit: iteratorPageSize}
+		if opts != nil {
+			current.Offset = opts.Offset
+			if opts.Limit > 0 {
+				current.Limit = opts.Limit
+			}
+		}
+
+		for {
+			page, err := fetch(ctx, &current)
+			if err != nil {
+				var zero T
+				yield(zero, err)
+				return
+			}
+			for _, item := range page.Items {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			current.Offset += len(page.Items)
+			if len(page.Items) == 0 || current.Offset >= page.TotalResults {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,356 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// recordedRequest is a request received by the fake server.
+type recordedRequest struct {
+	Method string
+	Path   string
+	Query  string
+	Body   string
+}
+
+// newRecordingClient returns a client whose server records the requests and answers with the given body.
+func newRecordingClient(t *testing.T, status int, response string) (*Client, *[]recordedRequest) {
+	t.Helper()
+	var requests []recordedRequest
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		requests = append(requests, recordedRequest{r.Method, r.URL.EscapedPath(), r.URL.RawQuery, string(body)})
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(response))
+	}, WithToken("abc"))
+	return c, &requests
+}
+
+// listServer serves a list of total items under the given key, honoring limit and offset.
+func listServer(t *testing.T, key string, total int, queries *[]string) http.HandlerFunc {
+	t.Helper()
+	return func(w http.ResponseWriter, r *http.Request) {
+		*queries = append(*queries, r.URL.RawQuery)
+		limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		items := []map[string]string{}
+		for i := offset; i < total && i < offset+limit; i++ {
+			items = append(items, map[string]string{"id": fmt.Sprintf("id-%d", i)})
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"totalResults": total,
+			"startIndex":   offset + 1,
+			"count":        len(items),
+			key:            items,
+			"links":        []Link{{Href: "next", Rel: "next"}},
+		})
+	}
+}
+
+func TestUsers_List(t *testing.T) {
+	c, requests := newRecordingClient(t, http.StatusOK,
+		`{"totalResults":3,"startIndex":2,"count":1,"users":[{"id":"u2","type":"employee","isReadOnly":true}],`+
+			`"links":[{"href":"users?offset=0&limit=1","rel":"prev"}]}`)
+
+	page, err := c.Users.List(context.Background(), &UserListOptions{
+		ListOptions: ListOptions{Limit: 1, Offset: 1},
+		Filter:      `username eq "alice"`,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, 3, page.TotalResults)
+	assert.Equal(t, 2, page.StartIndex)
+	assert.Equal(t, 1, page.Count)
+	assert.Equal(t, []User{{ID: "u2", Type: "employee", IsReadOnly: true}}, page.Items)
+	assert.Equal(t, []Link{{Href: "users?offset=0&limit=1", Rel: "prev"}}, page.Links)
+	assert.Equal(t, "filter=username+eq+%22alice%22&limit=1&offset=1", (*requests)[0].Query)
+}
+
+func TestUsers_AllIteratesEveryPage(t *testing.T) {
+	var queries []string
+	c := newTestClient(t, listServer(t, "users", 5, &queries))
+
+	var ids []string
+	for user, err := range c.Users.All(context.Background(), &UserListOptions{ListOptions: ListOptions{Limit: 2}}) {
+		require.NoError(t, err)
+		ids = append(ids, user.ID)
+	}
+
+	assert.Equal(t, []string{"id-0", "id-1", "id-2", "id-3", "id-4"}, ids)
+	assert.Equal(t, []string{"limit=2", "limit=2&offset=2", "limit=2&offset=4"}, queries)
+}
+
+func TestUsers_AllStopsWhenTheCallerBreaks(t *testing.T) {
+	var queries []string
+	c := newTestClient(t, listServer(t, "users", 500, &queries))
+
+	count := 0
+	for _, err := range c.Users.All(context.Background(), nil) {
+		require.NoError(t, err)
+		if count++; count == 150 {
+			break
+		}
+	}
+
+	assert.Equal(t, []string{"limit=100", "limit=100&offset=100"}, queries)
+}
+
+func TestUsers_AllYieldsErrors(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	})
+
+	var errs []error
+	for _, err := range c.Users.All(context.Background(), nil) {
+		errs = append(errs, err)
+	}
+
+	require.Len(t, errs, 1)
+	assert.ErrorIs(t, errs[0], ErrForbidden)
+}
+
+func TestUsers_CRUD(t *testing.T) {
+	c, requests := newRecordingClient(t, http.StatusOK, `{"id":"u1","attributes":{"username":"alice"}}`)
+	ctx := context.Background()
+
+	created, err := c.Users.Create(ctx, &CreateUserRequest{
+		OUID: "ou1", Type: "employee", Attributes: json.RawMessage(`{"username":"alice"}`)})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"username":"alice"}`, string(created.Attributes))
+	_, err = c.Users.Get(ctx, "u1")
+	require.NoError(t, err)
+	require.NoError(t, c.Users.Delete(ctx, "u1"))
+
+	assert.Equal(t, []recordedRequest{
+		{"POST", "/users", "", `{"ouId":"ou1","type":"employee","attributes":{"username":"alice"}}`},
+		{"GET", "/users/u1", "", ""},
+		{"DELETE", "/users/u1", "", ""},
+	}, *requests)
+}
+
+func TestApplications_CRUD(t *testing.T) {
+	c, requests := newRecordingClient(t, http.StatusOK, `{"id":"a1","name":"web","authFlowId":"f1",`+
+		`"inboundAuthConfig":[{"type":"oauth2","config":{"clientId":"c1","clientSecret":"s1","pkceRequired":true,`+
+		`"token":{"accessToken":{"validityPeriod":3600}}}}],"lastAuthorizedAt":"2026-01-02T03:04:05Z"}`)
+	ctx := context.Background()
+	req := &ApplicationRequest{
+		Name:               "web",
+		InboundAuthProfile: InboundAuthProfile{AuthFlowID: "f1"},
+		InboundAuthConfig: []InboundAuthConfig{{
+			Type:   InboundAuthTypeOAuth,
+			Config: &OAuthConfig{GrantTypes: []string{"client_credentials"}},
+		}},
+	}
+
+	created, err := c.Applications.Create(ctx, req)
+	require.NoError(t, err)
+	assert.Equal(t, "f1", created.AuthFlowID)
+	require.Len(t, created.InboundAuthConfig, 1)
+	assert.Equal(t, "s1", created.InboundAuthConfig[0].Config.ClientSecret)
+	assert.True(t, created.InboundAuthConfig[0].Config.PKCERequired)
+	assert.JSONEq(t, `{"accessToken":{"validityPeriod":3600}}`, string(created.InboundAuthConfig[0].Config.Token))
+	assert.Equal(t, time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC), *created.LastAuthorizedAt)
+
+	_, err = c.Applications.Update(ctx, "a1", req)
+	require.NoError(t, err)
+	_, err = c.Applications.Get(ctx, "a1")
+	require.NoError(t, err)
+	require.NoError(t, c.Applications.Delete(ctx, "a1"))
+
+	require.Len(t, *requests, 4)
+	assert.JSONEq(t, `{"name":"web","description":"","authFlowId":"f1","isRegistrationFlowEnabled":false,`+
+		`"isRecoveryFlowEnabled":false,"inboundAuthConfig":[{"type":"oauth2","config":{`+
+		`"grantTypes":["client_credentials"],"pkceRequired":false,"publicClient":false,`+
+		`"requirePushedAuthorizationRequests":false,"allowCredentialDiscovery":false,`+
+		`"protocolTraceEnabled":false,"dpopBoundAccessTokens":false,"rejectDisallowedScopes":false}}]}`,
+		(*requests)[0].Body)
+	assert.Equal(t, "PUT /applications/a1", (*requests)[1].Method+" "+(*requests)[1].Path)
+	assert.Equal(t, "GET /applications/a1", (*requests)[2].Method+" "+(*requests)[2].Path)
+	assert.Equal(t, "DELETE /applications/a1", (*requests)[3].Method+" "+(*requests)[3].Path)
+}
+
+func TestApplications_ListAndAll(t *testing.T) {
+	var queries []string
+	c := newTestClient(t, listServer(t, "applications", 3, &queries))
+	since := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	var ids []string
+	for app, err := range c.Applications.All(context.Background(), &ApplicationListOptions{InactiveSince: since}) {
+		require.NoError(t, err)
+		ids = append(ids, app.ID)
+	}
+
+	assert.Equal(t, []string{"id-0", "id-1", "id-2"}, ids)
+	assert.Equal(t, []string{"inactiveSince=2026-03-01T00%3A00%3A00Z&limit=100"}, queries)
+}
+
+func TestFlows_CRUDAndList(t *testing.T) {
+	c, requests := newRecordingClient(t, http.StatusOK,
+		`{"id":"f1","handle":"basic","name":"Basic","flowType":"AUTHENTICATION","nodes":[{"id":"start"}],`+
+			`"flows":[{"id":"f1"}],"totalResults":1}`)
+	ctx := context.Background()
+	req := &FlowRequest{Handle: "basic", Name: "Basic", FlowType: FlowTypeAuthentication,
+		Nodes: []json.RawMessage{json.RawMessage(`{"id":"start"}`)}}
+
+	created, err := c.Flows.Create(ctx, req)
+	require.NoError(t, err)
+	require.Len(t, created.Nodes, 1)
+	assert.JSONEq(t, `{"id":"start"}`, string(created.Nodes[0]))
+	_, err = c.Flows.Update(ctx, "f1", req)
+	require.NoError(t, err)
+	_, err = c.Flows.Get(ctx, "f1")
+	require.NoError(t, err)
+	require.NoError(t, c.Flows.Delete(ctx, "f1"))
+	page, err := c.Flows.List(ctx, &FlowListOptions{FlowType: FlowTypeRegistration})
+	require.NoError(t, err)
+	assert.Equal(t, []FlowSummary{{ID: "f1"}}, page.Items)
+
+	body := `{"handle":"basic","name":"Basic","flowType":"AUTHENTICATION","nodes":[{"id":"start"}]}`
+	assert.Equal(t, []recordedRequest{
+		{"POST", "/flows", "", body},
+		{"PUT", "/flows/f1", "", body},
+		{"GET", "/flows/f1", "", ""},
+		{"DELETE", "/flows/f1", "", ""},
+		{"GET", "/flows", "flowType=REGISTRATION", ""},
+	}, *requests)
+}
+
+func TestFlows_All(t *testing.T) {
+	var queries []string
+	c := newTestClient(t, listServer(t, "flows", 1, &queries))
+
+	for _, err := range c.Flows.All(context.Background(), &FlowListOptions{FlowType: FlowTypeRecovery}) {
+		require.NoError(t, err)
+	}
+
+	assert.Equal(t, []string{"flowType=RECOVERY&limit=100"}, queries)
+}
+
+func TestOrganizationUnits_CRUDAndList(t *testing.T) {
+	c, requests := newRecordingClient(t, http.StatusOK,
+		`{"id":"ou1","handle":"eng","name":"Engineering","parent":null,"createdAt":"2026-01-02T03:04:05Z",`+
+			`"organizationUnits":[{"id":"ou1","handle":"eng"}],"totalResults":1}`)
+	ctx := context.Background()
+	parent := "root"
+	req := &OrganizationUnitRequest{Handle: "eng", Name: "Engineering", Parent: &parent}
+
+	created, err := c.OrganizationUnits.Create(ctx, req)
+	require.NoError(t, err)
+	assert.Nil(t, created.Parent)
+	assert.Equal(t, 2026, created.CreatedAt.Year())
+	_, err = c.OrganizationUnits.Update(ctx, "ou1", req)
+	require.NoError(t, err)
+	_, err = c.OrganizationUnits.Get(ctx, "ou1")
+	require.NoError(t, err)
+	require.NoError(t, c.OrganizationUnits.Delete(ctx, "ou1"))
+	page, err := c.OrganizationUnits.List(ctx, &OrganizationUnitListOptions{Filter: `name eq "eng"`})
+	require.NoError(t, err)
+	assert.Equal(t, "eng", page.Items[0].Handle)
+
+	body := `{"handle":"eng","name":"Engineering","parent":"root"}`
+	assert.Equal(t, []recordedRequest{
+		{"POST", "/organization-units", "", body},
+		{"PUT", "/organization-units/ou1", "", body},
+		{"GET", "/organization-units/ou1", "", ""},
+		{"DELETE", "/organization-units/ou1", "", ""},
+		{"GET", "/organization-units", "filter=name+eq+%22eng%22", ""},
+	}, *requests)
+}
+
+func TestOrganizationUnits_All(t *testing.T) {
+	var queries []string
+	c := newTestClient(t, listServer(t, "organizationUnits", 2, &queries))
+
+	count := 0
+	for _, err := range c.OrganizationUnits.All(context.Background(), nil) {
+		require.NoError(t, err)
+		count++
+	}
+
+	assert.Equal(t, 2, count)
+}
+
+func TestRoles_CRUDAndAssignments(t *testing.T) {
+	c, requests := newRecordingClient(t, http.StatusOK, `{"id":"r1","name":"admin","ouId":"ou1",`+
+		`"permissions":[{"resourceServerId":"rs1","permissions":["read"]}],`+
+		`"assignments":[{"id":"u1","type":"user","display":"Alice"}],"roles":[{"id":"r1"}],"totalResults":1}`)
+	ctx := context.Background()
+	perms := []ResourcePermissions{{ResourceServerID: "rs1", Permissions: []string{"read"}}}
+	assignments := []Assignment{{ID: "u1", Type: AssigneeTypeUser}}
+
+	created, err := c.Roles.Create(ctx, &CreateRoleRequest{Name: "admin", OUID: "ou1", Permissions: perms,
+		Assignments: assignments})
+	require.NoError(t, err)
+	assert.Equal(t, perms, created.Permissions)
+	_, err = c.Roles.Update(ctx, "r1", &UpdateRoleRequest{Name: "admin", OUID: "ou1", Permissions: perms})
+	require.NoError(t, err)
+	_, err = c.Roles.Get(ctx, "r1")
+	require.NoError(t, err)
+	require.NoError(t, c.Roles.Delete(ctx, "r1"))
+	roles, err := c.Roles.List(ctx, &ListOptions{Limit: 5})
+	require.NoError(t, err)
+	assert.Equal(t, "r1", roles.Items[0].ID)
+	page, err := c.Roles.ListAssignments(ctx, "r1", &AssignmentListOptions{Type: AssigneeTypeUser,
+		IncludeDisplay: true})
+	require.NoError(t, err)
+	assert.Equal(t, []Assignment{{ID: "u1", Type: "user", Display: "Alice"}}, page.Items)
+	require.NoError(t, c.Roles.AddAssignments(ctx, "r1", assignments))
+	require.NoError(t, c.Roles.RemoveAssignments(ctx, "r1", assignments))
+
+	permBody := `"permissions":[{"resourceServerId":"rs1","permissions":["read"]}]`
+	assert.Equal(t, []recordedRequest{
+		{"POST", "/roles", "", `{"name":"admin","ouId":"ou1",` + permBody +
+			`,"assignments":[{"id":"u1","type":"user"}]}`},
+		{"PUT", "/roles/r1", "", `{"name":"admin","ouId":"ou1",` + permBody + `}`},
+		{"GET", "/roles/r1", "", ""},
+		{"DELETE", "/roles/r1", "", ""},
+		{"GET", "/roles", "limit=5", ""},
+		{"GET", "/roles/r1/assignments", "include=display&type=user", ""},
+		{"POST", "/roles/r1/assignments/add", "", `{"assignments":[{"id":"u1","type":"user"}]}`},
+		{"POST", "/roles/r1/assignments/remove", "", `{"assignments":[{"id":"u1","type":"user"}]}`},
+	}, *requests)
+}
+
+func TestRoles_AllAndAllAssignments(t *testing.T) {
+	var queries []string
+	c := newTestClient(t, listServer(t, "roles", 2, &queries))
+
+	count := 0
+	for _, err := range c.Roles.All(context.Background(), nil) {
+		require.NoError(t, err)
+		count++
+	}
+	for _, err := range c.Roles.AllAssignments(context.Background(), "r1", nil) {
+		require.NoError(t, err)
+	}
+
+	assert.Equal(t, 2, count)
+	assert.Equal(t, []string{"limit=100", "limit=100"}, queries)
+}
@@ -0,0 +1,135 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package client
+
+import (
+	"context"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// maxRetryAfter bounds the Retry-After delay the client waits for. A response asking for a longer wait is
+// returned to the caller instead of being retried.
+const maxRetryAfter = time.Minute
+
+// RetryPolicy controls how failed requests are retried.
+//
+// Network errors, 502, 503 and 504 responses are retried for the idempotent methods GET, HEAD, PUT and
+// DELETE. 429 responses, and 503 responses that carry a Retry-After header because the server rejected the
+// request before processing it, are retried for every method. The Retry-After header is honored when present;
+// otherwise the delay grows exponentially from InitialBackoff up to MaxBackoff with random jitter.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts including the first one. A value of 1 or less disables
+	// retries.
+	MaxAttempts int
+	// InitialBackoff is the delay before the first retry.
+	InitialBackoff time.Duration
+	// MaxBackoff caps the delay between two attempts.
+	MaxBackoff time.Duration
+}
+
+// DefaultRetryPolicy is the retry policy used when none is configured.
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts:    3,
+	InitialBackoff: 200 * time.Millisecond,
+	MaxBackoff:     5 * time.Second,
+}
+
+// NoRetry disables retries.
+var NoRetry = RetryPolicy{MaxAttempts: 1}
+
+// backoff returns the delay before the given retry attempt, counted from 1.
+func (p RetryPolicy) backoff(attempt int) time.Duration {
+	delay := p.InitialBackoff
+	for i := 1; i < attempt && delay < p.MaxBackoff; i++ {
+		delay *= 2
+	}
+	if p.MaxBackoff > 0 && delay > p.MaxBackoff {
+		delay = p.MaxBackoff
+	}
+	if delay <= 0 {
+		return 0
+	}
+	// Spread the retries of concurrent callers over the upper half of the interval.
+	return delay/2 + rand.N(delay/2+1)
+}
+
+// retryDelay reports whether a response with the given status should be retried, and after how long.
+func (p RetryPolicy) retryDelay(method string, status int, header http.Header, attempt int) (time.Duration, bool) {
+	retryAfter, hasRetryAfter := parseRetryAfter(header.Get("Retry-After"))
+	switch {
+	case status == http.StatusTooManyRequests:
+	case status == http.StatusServiceUnavailable && hasRetryAfter:
+	case isIdempotent(method) && (status == http.StatusBadGateway || status == http.StatusServiceUnavailable ||
+		status == http.StatusGatewayTimeout):
+	default:
+		return 0, false
+	}
+
+	if !hasRetryAfter {
+		return p.backoff(attempt), true
+	}
+	if retryAfter > maxRetryAfter {
+		return 0, false
+	}
+	return retryAfter, true
+}
+
+// parseRetryAfter parses a Retry-After header given in seconds or as an HTTP date.
+func parseRetryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+	if at, err := http.ParseTime(value); err == nil {
+		return max(time.Until(at), 0), true
+	}
+	return 0, false
+}
+
+// isIdempotent reports whether a request with the given method can be sent again safely.
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// sleep waits for the given duration or until the context is done.
+func sleep(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
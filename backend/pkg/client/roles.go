@@ -0,0 +1,193 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package client
+
+import (
+	"context"
+	"iter"
+	"net/http"
+)
+
+const rolesPath = "/roles"
+
+// Assignee types of role assignments.
+const (
+	AssigneeTypeUser  = "user"
+	AssigneeTypeApp   = "app"
+	AssigneeTypeAgent = "agent"
+	AssigneeTypeGroup = "group"
+)
+
+// ResourcePermissions holds the permissions a role grants on a resource server.
+type ResourcePermissions struct {
+	ResourceServerID string   `json:"resourceServerId"`
+	Permissions      []string `json:"permissions"`
+}
+
+// Assignment assigns a role to a user, application, agent or group.
+type Assignment struct {
+	ID   string `json:"id"`
+	Type string `json:"type"`
+	// Display is the display name of the assignee. It is only returned when requested.
+	Display string `json:"display,omitempty"`
+}
+
+// Role is a role.
+type Role struct {
+	ID          string                `json:"id"`
+	Name        string                `json:"name"`
+	Description string                `json:"description,omitempty"`
+	OUID        string                `json:"ouId"`
+	OUHandle    string                `json:"ouHandle,omitempty"`
+	Permissions []ResourcePermissions `json:"permissions"`
+	// Assignments holds the assignments made when the role was created. It is empty for roles read with Get;
+	// use ListAssignments instead.
+	Assignments []Assignment `json:"assignments,omitempty"`
+}
+
+// RoleSummary is a role as returned in lists.
+type RoleSummary struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	OUID        string `json:"ouId"`
+	OUHandle    string `json:"ouHandle,omitempty"`
+	IsReadOnly  bool   `json:"isReadOnly"`
+}
+
+// CreateRoleRequest is the request to create a role.
+type CreateRoleRequest struct {
+	Name        string                `json:"name"`
+	Description string                `json:"description,omitempty"`
+	OUID        string                `json:"ouId"`
+	Permissions []ResourcePermissions `json:"permissions"`
+	Assignments []Assignment          `json:"assignments,omitempty"`
+}
+
+// UpdateRoleRequest is the request to update a role.
+type UpdateRoleRequest struct {
+	Name        string                `json:"name"`
+	Description string                `json:"description,omitempty"`
+	OUID        string                `json:"ouId"`
+	Permissions []ResourcePermissions `json:"permissions"`
+}
+
+// AssignmentListOptions selects a page of role assignments.
+type AssignmentListOptions struct {
+	ListOptions
+	// Type narrows the list to one assignee type.
+	Type string
+	// IncludeDisplay returns the display names of the assignees.
+	IncludeDisplay bool
+}
+
+// RoleService manages roles and role assignments.
+type RoleService struct {
+	client *Client
+}
+
+// List returns a page of roles.
+func (s *RoleService) List(ctx context.Context, opts *ListOptions) (*Page[RoleSummary], error) {
+	return getPage[RoleSummary](ctx, s.client, rolesPath, "roles", opts.values())
+}
+
+// All returns an iterator over the roles, starting at the offset of the options.
+func (s *RoleService) All(ctx context.Context, opts *ListOptions) iter.Seq2[RoleSummary, error] {
+	return paginate(ctx, opts, s.List)
+}
+
+// Get returns the role with the given ID.
+func (s *RoleService) Get(ctx context.Context, id string) (*Role, error) {
+	var role Role
+	if err := s.client.do(ctx, http.MethodGet, rolesPath+"/"+escape(id), nil, nil, &role); err != nil {
+		return nil, err
+	}
+	return &role, nil
+}
+
+// Create creates a role.
+func (s *RoleService) Create(ctx context.Context, req *CreateRoleRequest) (*Role, error) {
+	var role Role
+	if err := s.client.do(ctx, http.MethodPost, rolesPath, nil, req, &role); err != nil {
+		return nil, err
+	}
+	return &role, nil
+}
+
+// Update updates the role with the given ID.
+func (s *RoleService) Update(ctx context.Context, id string, req *UpdateRoleRequest) (*Role, error) {
+	var role Role
+	if err := s.client.do(ctx, http.MethodPut, rolesPath+"/"+escape(id), nil, req, &role); err != nil {
+		return nil, err
+	}
+	return &role, nil
+}
+
+// Delete deletes the role with the given ID.
+func (s *RoleService) Delete(ctx context.Context, id string) error {
+	return s.client.do(ctx, http.MethodDelete, rolesPath+"/"+escape(id), nil, nil, nil)
+}
+
+// ListAssignments returns a page of the assignments of the role with the given ID.
+func (s *RoleService) ListAssignments(ctx context.Context, id string,
+	opts *AssignmentListOptions) (*Page[Assignment], error) {
+	if opts == nil {
+		opts = &AssignmentListOptions{}
+	}
+	query := opts.values()
+	if opts.Type != "" {
+		query.Set("type", opts.Type)
+	}
+	if opts.IncludeDisplay {
+		query.Set("include", "display")
+	}
+	return getPage[Assignment](ctx, s.client, rolesPath+"/"+escape(id)+"/assignments", "assignments", query)
+}
+
+// AllAssignments returns an iterator over the assignments of the role with the given ID.
+func (s *RoleService) AllAssignments(ctx context.Context, id string,
+	opts *AssignmentListOptions) iter.Seq2[Assignment, error] {
+	if opts == nil {
+		opts = &AssignmentListOptions{}
+	}
+	return paginate(ctx, &opts.ListOptions, func(ctx context.Context, page *ListOptions) (*Page[Assignment], error) {
+		return s.ListAssignments(ctx, id, &AssignmentListOptions{
+			ListOptions:    *page,
+			Type:           opts.Type,
+			IncludeDisplay: opts.IncludeDisplay,
+		})
+	})
+}
+
+// AddAssignments assigns the role with the given ID.
+func (s *RoleService) AddAssignments(ctx context.Context, id string, assignments []Assignment) error {
+	return s.client.do(ctx, http.MethodPost, rolesPath+"/"+escape(id)+"/assignments/add", nil,
+		assignmentsRequest{Assignments: assignments}, nil)
+}
+
+// RemoveAssignments removes assignments of the role with the given ID.
+func (s *RoleService) RemoveAssignments(ctx context.Context, id string, assignments []Assignment) error {
+	return s.client.do(ctx, http.MethodPost, rolesPath+"/"+escape(id)+"/assignments/remove", nil,
+		assignmentsRequest{Assignments: assignments}, nil)
+}
+
+// assignmentsRequest is the body of the add and remove assignment requests.
+type assignmentsRequest struct {
+	Assignments []Assignment `json:"assignments"`
+}
@@ -0,0 +1,148 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package client
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/thunder-id/thunderid/internal/application/model"
+	flowmgt "github.com/thunder-id/thunderid/internal/flow/mgt"
+	inboundmodel "github.com/thunder-id/thunderid/internal/inboundclient/model"
+	"github.com/thunder-id/thunderid/internal/oauth/oauth2/introspect"
+	oauth2model "github.com/thunder-id/thunderid/internal/oauth/oauth2/model"
+	"github.com/thunder-id/thunderid/internal/ou"
+	"github.com/thunder-id/thunderid/internal/role"
+	"github.com/thunder-id/thunderid/internal/system/error/apierror"
+	"github.com/thunder-id/thunderid/internal/system/utils"
+	"github.com/thunder-id/thunderid/internal/user"
+)
+
+// jsonTags returns the json tags of the fields of a struct type by field name, including the fields of
+// embedded structs that have no tag of their own.
+func jsonTags(t reflect.Type) map[string]string {
+	tags := map[string]string{}
+	for i := range t.NumField() {
+		field := t.Field(i)
+		tag, hasTag := field.Tag.Lookup("json")
+		if field.Anonymous && !hasTag {
+			for name, embedded := range jsonTags(field.Type) {
+				tags[name] = embedded
+			}
+			continue
+		}
+		if !field.IsExported() || tag == "-" {
+			continue
+		}
+		name, _, _ := strings.Cut(tag, ",")
+		tags[name] = tag
+	}
+	return tags
+}
+
+// typeOf returns the type of T.
+func typeOf[T any]() reflect.Type {
+	return reflect.TypeFor[T]()
+}
+
+// TestModelsMatchServerModels keeps the client models in sync with the request and response models of the
+// handlers: every client model must carry exactly the JSON fields, with the same options, of its server model.
+func TestModelsMatchServerModels(t *testing.T) {
+	cases := []struct {
+		client reflect.Type
+		server []reflect.Type
+	}{
+		{typeOf[User](), []reflect.Type{typeOf[user.User]()}},
+		{typeOf[CreateUserRequest](), []reflect.Type{typeOf[user.CreateUserRequest]()}},
+		{typeOf[UpdateUserRequest](), []reflect.Type{typeOf[user.UpdateUserRequest]()}},
+
+		{typeOf[Application](), []reflect.Type{
+			typeOf[model.ApplicationGetResponse](), typeOf[model.ApplicationCompleteResponse]()}},
+		{typeOf[ApplicationSummary](), []reflect.Type{typeOf[model.BasicApplicationResponse]()}},
+		{typeOf[ApplicationRequest](), []reflect.Type{typeOf[model.ApplicationRequest]()}},
+		{typeOf[InboundAuthProfile](), []reflect.Type{typeOf[inboundmodel.InboundAuthProfile]()}},
+		{typeOf[AssertionConfig](), []reflect.Type{typeOf[inboundmodel.AssertionConfig]()}},
+		{typeOf[LoginConsentConfig](), []reflect.Type{typeOf[inboundmodel.LoginConsentConfig]()}},
+		{typeOf[AuthMethodsConfig](), []reflect.Type{typeOf[inboundmodel.AuthMethodsConfig]()}},
+		{typeOf[Certificate](), []reflect.Type{typeOf[inboundmodel.Certificate]()}},
+		{typeOf[InboundAuthConfig](), []reflect.Type{
+			typeOf[inboundmodel.InboundAuthConfig](), typeOf[inboundmodel.InboundAuthConfigWithSecret]()}},
+		{typeOf[OAuthConfig](), []reflect.Type{
+			typeOf[inboundmodel.OAuthConfig](), typeOf[inboundmodel.OAuthConfigWithSecret]()}},
+
+		{typeOf[Flow](), []reflect.Type{typeOf[flowmgt.CompleteFlowDefinition]()}},
+		{typeOf[FlowSummary](), []reflect.Type{typeOf[flowmgt.BasicFlowDefinition]()}},
+		{typeOf[FlowRequest](), []reflect.Type{typeOf[flowmgt.FlowDefinitionRequest]()}},
+
+		{typeOf[OrganizationUnit](), []reflect.Type{typeOf[ou.OrganizationUnit]()}},
+		{typeOf[OrganizationUnitSummary](), []reflect.Type{typeOf[ou.OrganizationUnitBasic]()}},
+		{typeOf[OrganizationUnitRequest](), []reflect.Type{typeOf[ou.OrganizationUnitRequest]()}},
+
+		{typeOf[Role](), []reflect.Type{typeOf[role.RoleResponse](), typeOf[role.CreateRoleResponse]()}},
+		{typeOf[RoleSummary](), []reflect.Type{typeOf[role.RoleSummaryResponse]()}},
+		{typeOf[CreateRoleRequest](), []reflect.Type{typeOf[role.CreateRoleRequest]()}},
+		{typeOf[UpdateRoleRequest](), []reflect.Type{typeOf[role.UpdateRoleRequest]()}},
+		{typeOf[ResourcePermissions](), []reflect.Type{typeOf[role.ResourcePermissions]()}},
+		{typeOf[Assignment](), []reflect.Type{typeOf[role.AssignmentResponse](), typeOf[role.AssignmentRequest]()}},
+		{typeOf[assignmentsRequest](), []reflect.Type{typeOf[role.AssignmentsRequest]()}},
+
+		{typeOf[Token](), []reflect.Type{typeOf[oauth2model.TokenResponse]()}},
+		{typeOf[Introspection](), []reflect.Type{typeOf[introspect.IntrospectResponse]()}},
+		{typeOf[apiErrorBody](), []reflect.Type{typeOf[apierror.ErrorResponse]()}},
+		{typeOf[Link](), []reflect.Type{typeOf[utils.Link]()}},
+	}
+
+	for _, tc := range cases {
+		expected := map[string]string{}
+		for _, server := range tc.server {
+			for name, tag := range jsonTags(server) {
+				// A field is optional when any of the server models omits it when empty.
+				if existing, ok := expected[name]; ok && !strings.Contains(tag, "omitempty") {
+					tag = existing
+				}
+				expected[name] = tag
+			}
+		}
+		assert.Equal(t, expected, jsonTags(tc.client), "client model %s", tc.client.Name())
+	}
+}
+
+// TestListKeysMatchServerModels checks that the list methods read the items from the keys the handlers use.
+func TestListKeysMatchServerModels(t *testing.T) {
+	cases := map[string]reflect.Type{
+		"users":             typeOf[user.UserListResponse](),
+		"applications":      typeOf[model.ApplicationListResponse](),
+		"flows":             typeOf[flowmgt.FlowListResponse](),
+		"organizationUnits": typeOf[ou.OrganizationUnitListResponse](),
+		"roles":             typeOf[role.RoleListResponse](),
+		"assignments":       typeOf[role.AssignmentListResponse](),
+	}
+	envelope := jsonTags(typeOf[pageEnvelope]())
+
+	for key, server := range cases {
+		tags := jsonTags(server)
+		assert.Contains(t, tags, key, server.Name())
+		for name := range envelope {
+			assert.Contains(t, tags, name, server.Name())
+		}
+	}
+}
@@ -0,0 +1,265 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	tokenPath      = "/oauth2/token"
+	introspectPath = "/oauth2/introspect"
+
+	// tokenExpiryLeeway is how long before its expiry a cached token is renewed.
+	tokenExpiryLeeway = 30 * time.Second
+)
+
+// Token exchange token type identifiers defined in RFC 8693 section 3.
+const (
+	TokenTypeAccessToken  = "urn:ietf:params:oauth:token-type:access_token"
+	TokenTypeRefreshToken = "urn:ietf:params:oauth:token-type:refresh_token"
+	TokenTypeIDToken      = "urn:ietf:params:oauth:token-type:id_token"
+	TokenTypeJWT          = "urn:ietf:params:oauth:token-type:jwt"
+)
+
+// ClientCredentials identifies an OAuth 2.0 client.
+type ClientCredentials struct {
+	ClientID     string
+	ClientSecret string
+}
+
+// Token is a token response of the token endpoint.
+type Token struct {
+	AccessToken     string `json:"access_token"`
+	TokenType       string `json:"token_type"`
+	ExpiresIn       int64  `json:"expires_in"`
+	RefreshToken    string `json:"refresh_token,omitempty"`
+	Scope           string `json:"scope,omitempty"`
+	IDToken         string `json:"id_token,omitempty"`
+	IssuedTokenType string `json:"issued_token_type,omitempty"`
+
+	// Expiry is the time the access token expires, computed from ExpiresIn when the token was received. It
+	// is zero when the server did not return an expiry.
+	Expiry time.Time `json:"-"`
+}
+
+// TokenExchangeRequest holds the parameters of a token exchange request, as defined in RFC 8693.
+type TokenExchangeRequest struct {
+	SubjectToken       string
+	SubjectTokenType   string
+	ActorToken         string
+	ActorTokenType     string
+	RequestedTokenType string
+	Audience           string
+	Resource           string
+	Scopes             []string
+}
+
+// Introspection is a token introspection response, as defined in RFC 7662.
+type Introspection struct {
+	Active    bool              `json:"active"`
+	Scope     string            `json:"scope,omitempty"`
+	ClientID  string            `json:"client_id,omitempty"`
+	Username  string            `json:"username,omitempty"`
+	TokenType string            `json:"token_type,omitempty"`
+	Exp       int64             `json:"exp,omitempty"`
+	Iat       int64             `json:"iat,omitempty"`
+	Nbf       int64             `json:"nbf,omitempty"`
+	Sub       string            `json:"sub,omitempty"`
+	Aud       any               `json:"aud,omitempty"`
+	Iss       string            `json:"iss,omitempty"`
+	Jti       string            `json:"jti,omitempty"`
+	Cnf       map[string]string `json:"cnf,omitempty"`
+}
+
+// TokenService calls the OAuth 2.0 token and introspection endpoints. The methods authenticate with the
+// given credentials, or with the credentials configured through WithClientCredentials when creds is nil.
+type TokenService struct {
+	client *Client
+}
+
+// ClientCredentials obtains a token with the client credentials grant.
+func (s *TokenService) ClientCredentials(ctx context.Context, creds *ClientCredentials,
+	scopes ...string) (*Token, error) {
+	form := url.Values{}
+	form.Set("grant_type", "client_credentials")
+	setScopes(form, scopes)
+	return s.requestToken(ctx, creds, form)
+}
+
+// Refresh obtains a new token with the refresh token grant. The scopes narrow the scope of the new token
+// when given.
+func (s *TokenService) Refresh(ctx context.Context, creds *ClientCredentials, refreshToken string,
+	scopes ...string) (*Token, error) {
+	form := url.Values{}
+	form.Set("grant_type", "refresh_token")
+	form.Set("refresh_token", refreshToken)
+	setScopes(form, scopes)
+	return s.requestToken(ctx, creds, form)
+}
+
+// Exchange exchanges a token with the token exchange grant.
+func (s *TokenService) Exchange(ctx context.Context, creds *ClientCredentials,
+	req TokenExchangeRequest) (*Token, error) {
+	form := url.Values{}
+	form.Set("grant_type", "urn:ietf:params:oauth:grant-type:token-exchange")
+	form.Set("subject_token", req.SubjectToken)
+	form.Set("subject_token_type", req.SubjectTokenType)
+	setOptional(form, "actor_token", req.ActorToken)
+	setOptional(form, "actor_token_type", req.ActorTokenType)
+	setOptional(form, "requested_token_type", req.RequestedTokenType)
+	setOptional(form, "audience", req.Audience)
+	setOptional(form, "resource", req.Resource)
+	setScopes(form, req.Scopes)
+	return s.requestToken(ctx, creds, form)
+}
+
+// Introspect returns the state of a token. An inactive token is not an error; check Introspection.Active.
+func (s *TokenService) Introspect(ctx context.Context, creds *ClientCredentials,
+	token string) (*Introspection, error) {
+	form := url.Values{}
+	form.Set("token", token)
+
+	var result Introspection
+	if err := s.postForm(ctx, creds, introspectPath, form, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// requestToken sends a token request and stamps the expiry of the returned token.
+func (s *TokenService) requestToken(ctx context.Context, creds *ClientCredentials,
+	form url.Values) (*Token, error) {
+	var token Token
+	received := time.Now()
+	if err := s.postForm(ctx, creds, tokenPath, form, &token); err != nil {
+		return nil, err
+	}
+	if token.ExpiresIn > 0 {
+		token.Expiry = received.Add(time.Duration(token.ExpiresIn) * time.Second)
+	}
+	return &token, nil
+}
+
+// postForm sends a form encoded request to an OAuth 2.0 endpoint.
+func (s *TokenService) postForm(ctx context.Context, creds *ClientCredentials, path string,
+	form url.Values, out any) error {
+	if creds == nil {
+		creds = s.client.credentials
+	}
+	if creds == nil {
+		return fmt.Errorf("thunder: %s requires client credentials", path)
+	}
+
+	req := &request{
+		method:      http.MethodPost,
+		path:        path,
+		body:        []byte(form.Encode()),
+		contentType: contentTypeForm,
+		credentials: creds,
+		decodeError: decodeOAuthError,
+	}
+	data, err := s.client.send(ctx, req)
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(data, out); err != nil {
+		return fmt.Errorf("thunder: failed to decode the response of POST %s: %w", path, err)
+	}
+	return nil
+}
+
+// setScopes sets the space separated scope parameter when scopes are given.
+func setScopes(form url.Values, scopes []string) {
+	if len(scopes) > 0 {
+		form.Set("scope", strings.Join(scopes, " "))
+	}
+}
+
+// setOptional sets a form parameter when the value is not empty.
+func setOptional(form url.Values, key, value string) {
+	if value != "" {
+		form.Set(key, value)
+	}
+}
+
+// TokenSource supplies the bearer tokens that authenticate management API requests.
+type TokenSource interface {
+	// Token returns a valid access token.
+	Token(ctx context.Context) (string, error)
+}
+
+// StaticTokenSource returns a token source that always returns the given token.
+func StaticTokenSource(token string) TokenSource {
+	return staticTokenSource(token)
+}
+
+// staticTokenSource is a token source for a fixed token.
+type staticTokenSource string
+
+// Token returns the fixed token.
+func (s staticTokenSource) Token(context.Context) (string, error) {
+	return string(s), nil
+}
+
+// invalidator is implemented by token sources that cache tokens, so that a token rejected by the server is
+// not reused.
+type invalidator interface {
+	invalidate()
+}
+
+// clientCredentialsTokenSource obtains and caches tokens with the client credentials grant.
+type clientCredentialsTokenSource struct {
+	tokens      *TokenService
+	credentials ClientCredentials
+	scopes      []string
+
+	mu    sync.Mutex
+	token *Token
+}
+
+// Token returns the cached token, obtaining a new one when there is none or it is about to expire.
+func (s *clientCredentialsTokenSource) Token(ctx context.Context) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.token != nil && (s.token.Expiry.IsZero() || time.Until(s.token.Expiry) > tokenExpiryLeeway) {
+		return s.token.AccessToken, nil
+	}
+	token, err := s.tokens.ClientCredentials(ctx, &s.credentials, s.scopes...)
+	if err != nil {
+		return "", err
+	}
+	s.token = token
+	return token.AccessToken, nil
+}
+
+// invalidate drops the cached token.
+func (s *clientCredentialsTokenSource) invalidate() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.token = nil
+}
@@ -0,0 +1,183 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// tokenServer answers token requests after checking the client credentials, and records the form.
+func tokenServer(t *testing.T, forms *[]url.Values, response string) http.HandlerFunc {
+	t.Helper()
+	return func(w http.ResponseWriter, r *http.Request) {
+		clientID, secret, ok := r.BasicAuth()
+		assert.True(t, ok)
+		assert.Equal(t, "my+client", clientID)
+		assert.Equal(t, "s%3Acret", secret)
+		assert.Equal(t, "application/x-www-form-urlencoded", r.Header.Get("Content-Type"))
+		require.NoError(t, r.ParseForm())
+		*forms = append(*forms, r.PostForm)
+		_, _ = w.Write([]byte(response))
+	}
+}
+
+var testCredentials = &ClientCredentials{ClientID: "my client", ClientSecret: "s:cret"}
+
+func TestTokens_ClientCredentials(t *testing.T) {
+	var forms []url.Values
+	c := newTestClient(t, tokenServer(t, &forms,
+		`{"access_token":"at","token_type":"Bearer","expires_in":3600,"scope":"system"}`))
+
+	before := time.Now()
+	token, err := c.Tokens.ClientCredentials(context.Background(), testCredentials, "system", "openid")
+
+	require.NoError(t, err)
+	assert.Equal(t, "at", token.AccessToken)
+	assert.Equal(t, "Bearer", token.TokenType)
+	assert.WithinRange(t, token.Expiry, before.Add(time.Hour), time.Now().Add(time.Hour))
+	assert.Equal(t, url.Values{"grant_type": {"client_credentials"}, "scope": {"system openid"}}, forms[0])
+}
+
+func TestTokens_Refresh(t *testing.T) {
+	var forms []url.Values
+	c := newTestClient(t, tokenServer(t, &forms, `{"access_token":"at","refresh_token":"rt2"}`))
+
+	token, err := c.Tokens.Refresh(context.Background(), testCredentials, "rt1")
+
+	require.NoError(t, err)
+	assert.Equal(t, "rt2", token.RefreshToken)
+	assert.True(t, token.Expiry.IsZero())
+	assert.Equal(t, url.Values{"grant_type": {"refresh_token"}, "refresh_token": {"rt1"}}, forms[0])
+}
+
+func TestTokens_Exchange(t *testing.T) {
+	var forms []url.Values
+	c := newTestClient(t, tokenServer(t, &forms,
+		`{"access_token":"at","issued_token_type":"urn:ietf:params:oauth:token-type:access_token"}`))
+
+	token, err := c.Tokens.Exchange(context.Background(), testCredentials, TokenExchangeRequest{
+		SubjectToken:     "subject",
+		SubjectTokenType: TokenTypeAccessToken,
+		Audience:         "api",
+		Scopes:           []string{"read"},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, TokenTypeAccessToken, token.IssuedTokenType)
+	assert.Equal(t, url.Values{
+		"grant_type":         {"urn:ietf:params:oauth:grant-type:token-exchange"},
+		"subject_token":      {"subject"},
+		"subject_token_type": {TokenTypeAccessToken},
+		"audience":           {"api"},
+		"scope":              {"read"},
+	}, forms[0])
+}
+
+func TestTokens_IntrospectWithConfiguredCredentials(t *testing.T) {
+	var forms []url.Values
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, introspectPath, r.URL.Path)
+		tokenServer(t, &forms, `{"active":true,"sub":"u1","exp":1700000000}`)(w, r)
+	}, WithClientCredentials(testCredentials.ClientID, testCredentials.ClientSecret))
+
+	result, err := c.Tokens.Introspect(context.Background(), nil, "at")
+
+	require.NoError(t, err)
+	assert.True(t, result.Active)
+	assert.Equal(t, "u1", result.Sub)
+	assert.Equal(t, url.Values{"token": {"at"}}, forms[0])
+}
+
+func TestTokens_RequireCredentials(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Fatal("no request expected")
+	})
+
+	_, err := c.Tokens.ClientCredentials(context.Background(), nil)
+
+	assert.EqualError(t, err, "thunder: /oauth2/token requires client credentials")
+}
+
+func TestTokens_MapsOAuthErrors(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"error":"invalid_grant","error_description":"Refresh token expired",` +
+			`"error_uri":"https://example.com/errors"}`))
+	})
+
+	_, err := c.Tokens.Refresh(context.Background(), testCredentials, "rt")
+
+	assert.ErrorIs(t, err, ErrBadRequest)
+	var oauthErr *OAuthError
+	require.ErrorAs(t, err, &oauthErr)
+	assert.Equal(t, "https://example.com/errors", oauthErr.URI)
+	assert.EqualError(t, err, "thunder: invalid_grant: Refresh token expired")
+	assert.EqualError(t, &OAuthError{StatusCode: http.StatusBadGateway}, "thunder: server returned 502 Bad Gateway")
+}
+
+func TestClientCredentialsTokenSource_CachesTokens(t *testing.T) {
+	var tokenRequests atomic.Int32
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == tokenPath {
+			tokenRequests.Add(1)
+			_, _ = w.Write([]byte(`{"access_token":"at","expires_in":3600}`))
+			return
+		}
+		assert.Equal(t, "Bearer at", r.Header.Get("Authorization"))
+		w.WriteHeader(http.StatusNoContent)
+	}, WithClientCredentials("cid", "secret"))
+
+	for range 3 {
+		require.NoError(t, c.Users.Delete(context.Background(), "u1"))
+	}
+
+	assert.Equal(t, int32(1), tokenRequests.Load())
+}
+
+func TestClientCredentialsTokenSource_RenewsExpiringTokens(t *testing.T) {
+	var tokenRequests atomic.Int32
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		tokenRequests.Add(1)
+		_, _ = w.Write([]byte(`{"access_token":"at","expires_in":10}`))
+	})
+	source := &clientCredentialsTokenSource{tokens: c.Tokens, credentials: ClientCredentials{ClientID: "cid"}}
+
+	for range 2 {
+		token, err := source.Token(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, "at", token)
+	}
+
+	assert.Equal(t, int32(2), tokenRequests.Load())
+}
+
+func TestStaticTokenSource(t *testing.T) {
+	token, err := StaticTokenSource("abc").Token(context.Background())
+
+	require.NoError(t, err)
+	assert.Equal(t, "abc", token)
+}
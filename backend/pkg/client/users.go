@@ -0,0 +1,122 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"iter"
+	"net/http"
+)
+
+const usersPath = "/users"
+
+// User is a user.
+type User struct {
+	ID         string          `json:"id,omitempty"`
+	OUID       string          `json:"ouId,omitempty"`
+	OUHandle   string          `json:"ouHandle,omitempty"`
+	Type       string          `json:"type,omitempty"`
+	Attributes json.RawMessage `json:"attributes,omitempty"`
+	Display    string          `json:"display,omitempty"`
+	PictureURL string          `json:"pictureUrl,omitempty"`
+	IsReadOnly bool            `json:"isReadOnly"`
+}
+
+// CreateUserRequest is the request to create a user.
+type CreateUserRequest struct {
+	OUID       string          `json:"ouId"`
+	Type       string          `json:"type"`
+	Groups     []string        `json:"groups,omitempty"`
+	Attributes json.RawMessage `json:"attributes,omitempty"`
+}
+
+// UpdateUserRequest is the request to update a user. The attributes replace the existing attributes.
+type UpdateUserRequest struct {
+	OUID       string          `json:"ouId,omitempty"`
+	Type       string          `json:"type,omitempty"`
+	Groups     []string        `json:"groups,omitempty"`
+	Attributes json.RawMessage `json:"attributes,omitempty"`
+}
+
+// UserListOptions selects a page of users.
+type UserListOptions struct {
+	ListOptions
+	// Filter narrows the list with a filter expression such as username eq "alice".
+	Filter string
+}
+
+// UserService manages users.
+type UserService struct {
+	client *Client
+}
+
+// List returns a page of users.
+func (s *UserService) List(ctx context.Context, opts *UserListOptions) (*Page[User], error) {
+	if opts == nil {
+		opts = &UserListOptions{}
+	}
+	query := opts.values()
+	if opts.Filter != "" {
+		query.Set("filter", opts.Filter)
+	}
+	return getPage[User](ctx, s.client, usersPath, "users", query)
+}
+
+// All returns an iterator over the users, starting at the offset of the options.
+func (s *UserService) All(ctx context.Context, opts *UserListOptions) iter.Seq2[User, error] {
+	if opts == nil {
+		opts = &UserListOptions{}
+	}
+	return paginate(ctx, &opts.ListOptions, func(ctx context.Context, page *ListOptions) (*Page[User], error) {
+		return s.List(ctx, &UserListOptions{ListOptions: *page, Filter: opts.Filter})
+	})
+}
+
+// Get returns the user with the given ID.
+func (s *UserService) Get(ctx context.Context, id string) (*User, error) {
+	var user User
+	if err := s.client.do(ctx, http.MethodGet, usersPath+"/"+escape(id), nil, nil, &user); err != nil {
+		return nil, err
+	}
+	return &user, nil
+}
+
+// Create creates a user.
+func (s *UserService) Create(ctx context.Context, req *CreateUserRequest) (*User, error) {
+	var user User
+	if err := s.client.do(ctx, http.MethodPost, usersPath, nil, req, &user); err != nil {
+		return nil, err
+	}
+	return &user, nil
+}
+
+// Update updates the user with the given ID.
+func (s *UserService) Update(ctx context.Context, id string, req *UpdateUserRequest) (*User, error) {
+	var user User
+	if err := s.client.do(ctx, http.MethodPut, usersPath+"/"+escape(id), nil, req, &user); err != nil {
+		return nil, err
+	}
+	return &user, nil
+}
+
+// Delete deletes the user with the given ID.
+func (s *UserService) Delete(ctx context.Context, id string) error {
+	return s.client.do(ctx, http.MethodDelete, usersPath+"/"+escape(id), nil, nil, nil)
+}
@@ -31,6 +31,14 @@ set -euo pipefail
 #   4. WEBAUTHN_SESSION
 #   5. ATTRIBUTE_CACHE
 #   6. PAR_REQUEST
+#   7. OAUTH_TOKEN
+#   8. OU_DELETION_JOB
+#   9. TRUSTED_DEVICE
+#  10. REENCRYPTION_JOB
+#  11. OAUTH_PROTOCOL_TRACE
+#  12. ACCOUNT_CHANGE_HOLD
+#  13. ENROLLMENT_SESSION
+#  14. REINDEX_JOB
 #
 # Usage examples:
 #   # SQLite (local development)
@@ -70,7 +78,7 @@ USERNAME=""
 PASSWORD=""
 
 # Tables to clean (order matters: FLOW_CONTEXT first for cascade).
-TABLES=("FLOW_CONTEXT" "AUTHORIZATION_CODE" "AUTHORIZATION_REQUEST" "WEBAUTHN_SESSION" "ATTRIBUTE_CACHE" "PAR_REQUEST")
+TABLES=("FLOW_CONTEXT" "AUTHORIZATION_CODE" "AUTHORIZATION_REQUEST" "WEBAUTHN_SESSION" "ATTRIBUTE_CACHE" "PAR_REQUEST" "OAUTH_TOKEN" "OU_DELETION_JOB" "TRUSTED_DEVICE" "REENCRYPTION_JOB" "OAUTH_PROTOCOL_TRACE" "ACCOUNT_CHANGE_HOLD" "ENROLLMENT_SESSION" "REINDEX_JOB")
 
 # Totals for summary.
 TOTAL_DELETED=0
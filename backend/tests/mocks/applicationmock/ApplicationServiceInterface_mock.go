@@ -169,6 +169,141 @@ func (_c *ApplicationServiceInterfaceMock_DeleteApplication_Call) RunAndReturn(r
 	return _c
 }
 
+// DeleteGrantTypeAllowedScopes provides a mock function for the type ApplicationServiceInterfaceMock
+func (_mock *ApplicationServiceInterfaceMock) DeleteGrantTypeAllowedScopes(ctx context.Context, appID string, grantType string) *serviceerror.ServiceError {
+	ret := _mock.Called(ctx, appID, grantType)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteGrantTypeAllowedScopes")
+	}
+
+	var r0 *serviceerror.ServiceError
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) *serviceerror.ServiceError); ok {
+		r0 = returnFunc(ctx, appID, grantType)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*serviceerror.ServiceError)
+		}
+	}
+	return r0
+}
+
+// ApplicationServiceInterfaceMock_DeleteGrantTypeAllowedScopes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteGrantTypeAllowedScopes'
+type ApplicationServiceInterfaceMock_DeleteGrantTypeAllowedScopes_Call struct {
+	*mock.Call
+}
+
+// DeleteGrantTypeAllowedScopes is a helper method to define mock.On call
+//   - ctx context.Context
+//   - appID string
+//   - grantType string
+func (_e *ApplicationServiceInterfaceMock_Expecter) DeleteGrantTypeAllowedScopes(ctx interface{}, appID interface{}, grantType interface{}) *ApplicationServiceInterfaceMock_DeleteGrantTypeAllowedScopes_Call {
+	return &ApplicationServiceInterfaceMock_DeleteGrantTypeAllowedScopes_Call{Call: _e.mock.On("DeleteGrantTypeAllowedScopes", ctx, appID, grantType)}
+}
+
+func (_c *ApplicationServiceInterfaceMock_DeleteGrantTypeAllowedScopes_Call) Run(run func(ctx context.Context, appID string, grantType string)) *ApplicationServiceInterfaceMock_DeleteGrantTypeAllowedScopes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *ApplicationServiceInterfaceMock_DeleteGrantTypeAllowedScopes_Call) Return(serviceError *serviceerror.ServiceError) *ApplicationServiceInterfaceMock_DeleteGrantTypeAllowedScopes_Call {
+	_c.Call.Return(serviceError)
+	return _c
+}
+
+func (_c *ApplicationServiceInterfaceMock_DeleteGrantTypeAllowedScopes_Call) RunAndReturn(run func(ctx context.Context, appID string, grantType string) *serviceerror.ServiceError) *ApplicationServiceInterfaceMock_DeleteGrantTypeAllowedScopes_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAllowedScopesByGrantType provides a mock function for the type ApplicationServiceInterfaceMock
+func (_mock *ApplicationServiceInterfaceMock) GetAllowedScopesByGrantType(ctx context.Context, appID string) (map[string][]string, *serviceerror.ServiceError) {
+	ret := _mock.Called(ctx, appID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAllowedScopesByGrantType")
+	}
+
+	var r0 map[string][]string
+	var r1 *serviceerror.ServiceError
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (map[string][]string, *serviceerror.ServiceError)); ok {
+		return returnFunc(ctx, appID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) map[string][]string); ok {
+		r0 = returnFunc(ctx, appID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string][]string)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) *serviceerror.ServiceError); ok {
+		r1 = returnFunc(ctx, appID)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*serviceerror.ServiceError)
+		}
+	}
+	return r0, r1
+}
+
+// ApplicationServiceInterfaceMock_GetAllowedScopesByGrantType_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAllowedScopesByGrantType'
+type ApplicationServiceInterfaceMock_GetAllowedScopesByGrantType_Call struct {
+	*mock.Call
+}
+
+// GetAllowedScopesByGrantType is a helper method to define mock.On call
+//   - ctx context.Context
+//   - appID string
+func (_e *ApplicationServiceInterfaceMock_Expecter) GetAllowedScopesByGrantType(ctx interface{}, appID interface{}) *ApplicationServiceInterfaceMock_GetAllowedScopesByGrantType_Call {
+	return &ApplicationServiceInterfaceMock_GetAllowedScopesByGrantType_Call{Call: _e.mock.On("GetAllowedScopesByGrantType", ctx, appID)}
+}
+
+func (_c *ApplicationServiceInterfaceMock_GetAllowedScopesByGrantType_Call) Run(run func(ctx context.Context, appID string)) *ApplicationServiceInterfaceMock_GetAllowedScopesByGrantType_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *ApplicationServiceInterfaceMock_GetAllowedScopesByGrantType_Call) Return(sToStrings map[string][]string, serviceError *serviceerror.ServiceError) *ApplicationServiceInterfaceMock_GetAllowedScopesByGrantType_Call {
+	_c.Call.Return(sToStrings, serviceError)
+	return _c
+}
+
+func (_c *ApplicationServiceInterfaceMock_GetAllowedScopesByGrantType_Call) RunAndReturn(run func(ctx context.Context, appID string) (map[string][]string, *serviceerror.ServiceError)) *ApplicationServiceInterfaceMock_GetAllowedScopesByGrantType_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetApplication provides a mock function for the type ApplicationServiceInterfaceMock
 func (_mock *ApplicationServiceInterfaceMock) GetApplication(ctx context.Context, appID string) (*model.Application, *serviceerror.ServiceError) {
 	ret := _mock.Called(ctx, appID)
@@ -239,6 +374,76 @@ func (_c *ApplicationServiceInterfaceMock_GetApplication_Call) RunAndReturn(run
 	return _c
 }
 
+// GetApplicationByName provides a mock function for the type ApplicationServiceInterfaceMock
+func (_mock *ApplicationServiceInterfaceMock) GetApplicationByName(ctx context.Context, name string) (*model.Application, *serviceerror.ServiceError) {
+	ret := _mock.Called(ctx, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetApplicationByName")
+	}
+
+	var r0 *model.Application
+	var r1 *serviceerror.ServiceError
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*model.Application, *serviceerror.ServiceError)); ok {
+		return returnFunc(ctx, name)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *model.Application); ok {
+		r0 = returnFunc(ctx, name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Application)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) *serviceerror.ServiceError); ok {
+		r1 = returnFunc(ctx, name)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*serviceerror.ServiceError)
+		}
+	}
+	return r0, r1
+}
+
+// ApplicationServiceInterfaceMock_GetApplicationByName_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetApplicationByName'
+type ApplicationServiceInterfaceMock_GetApplicationByName_Call struct {
+	*mock.Call
+}
+
+// GetApplicationByName is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name string
+func (_e *ApplicationServiceInterfaceMock_Expecter) GetApplicationByName(ctx interface{}, name interface{}) *ApplicationServiceInterfaceMock_GetApplicationByName_Call {
+	return &ApplicationServiceInterfaceMock_GetApplicationByName_Call{Call: _e.mock.On("GetApplicationByName", ctx, name)}
+}
+
+func (_c *ApplicationServiceInterfaceMock_GetApplicationByName_Call) Run(run func(ctx context.Context, name string)) *ApplicationServiceInterfaceMock_GetApplicationByName_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *ApplicationServiceInterfaceMock_GetApplicationByName_Call) Return(application *model.Application, serviceError *serviceerror.ServiceError) *ApplicationServiceInterfaceMock_GetApplicationByName_Call {
+	_c.Call.Return(application, serviceError)
+	return _c
+}
+
+func (_c *ApplicationServiceInterfaceMock_GetApplicationByName_Call) RunAndReturn(run func(ctx context.Context, name string) (*model.Application, *serviceerror.ServiceError)) *ApplicationServiceInterfaceMock_GetApplicationByName_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetApplicationList provides a mock function for the type ApplicationServiceInterfaceMock
 func (_mock *ApplicationServiceInterfaceMock) GetApplicationList(ctx context.Context) (*model.ApplicationListResponse, *serviceerror.ServiceError) {
 	ret := _mock.Called(ctx)
@@ -449,6 +654,88 @@ func (_c *ApplicationServiceInterfaceMock_UpdateApplication_Call) RunAndReturn(r
 	return _c
 }
 
+// UpdateGrantTypeAllowedScopes provides a mock function for the type ApplicationServiceInterfaceMock
+func (_mock *ApplicationServiceInterfaceMock) UpdateGrantTypeAllowedScopes(ctx context.Context, appID string, grantType string, scopes []string) ([]string, *serviceerror.ServiceError) {
+	ret := _mock.Called(ctx, appID, grantType, scopes)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateGrantTypeAllowedScopes")
+	}
+
+	var r0 []string
+	var r1 *serviceerror.ServiceError
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, []string) ([]string, *serviceerror.ServiceError)); ok {
+		return returnFunc(ctx, appID, grantType, scopes)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, []string) []string); ok {
+		r0 = returnFunc(ctx, appID, grantType, scopes)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, []string) *serviceerror.ServiceError); ok {
+		r1 = returnFunc(ctx, appID, grantType, scopes)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*serviceerror.ServiceError)
+		}
+	}
+	return r0, r1
+}
+
+// ApplicationServiceInterfaceMock_UpdateGrantTypeAllowedScopes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateGrantTypeAllowedScopes'
+type ApplicationServiceInterfaceMock_UpdateGrantTypeAllowedScopes_Call struct {
+	*mock.Call
+}
+
+// UpdateGrantTypeAllowedScopes is a helper method to define mock.On call
+//   - ctx context.Context
+//   - appID string
+//   - grantType string
+//   - scopes []string
+func (_e *ApplicationServiceInterfaceMock_Expecter) UpdateGrantTypeAllowedScopes(ctx interface{}, appID interface{}, grantType interface{}, scopes interface{}) *ApplicationServiceInterfaceMock_UpdateGrantTypeAllowedScopes_Call {
+	return &ApplicationServiceInterfaceMock_UpdateGrantTypeAllowedScopes_Call{Call: _e.mock.On("UpdateGrantTypeAllowedScopes", ctx, appID, grantType, scopes)}
+}
+
+func (_c *ApplicationServiceInterfaceMock_UpdateGrantTypeAllowedScopes_Call) Run(run func(ctx context.Context, appID string, grantType string, scopes []string)) *ApplicationServiceInterfaceMock_UpdateGrantTypeAllowedScopes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 []string
+		if args[3] != nil {
+			arg3 = args[3].([]string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *ApplicationServiceInterfaceMock_UpdateGrantTypeAllowedScopes_Call) Return(strings1 []string, serviceError *serviceerror.ServiceError) *ApplicationServiceInterfaceMock_UpdateGrantTypeAllowedScopes_Call {
+	_c.Call.Return(strings1, serviceError)
+	return _c
+}
+
+func (_c *ApplicationServiceInterfaceMock_UpdateGrantTypeAllowedScopes_Call) RunAndReturn(run func(ctx context.Context, appID string, grantType string, scopes []string) ([]string, *serviceerror.ServiceError)) *ApplicationServiceInterfaceMock_UpdateGrantTypeAllowedScopes_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ValidateApplication provides a mock function for the type ApplicationServiceInterfaceMock
 func (_mock *ApplicationServiceInterfaceMock) ValidateApplication(ctx context.Context, app *model.ApplicationDTO) (*model.ApplicationProcessedDTO, *model0.InboundAuthConfigWithSecret, *serviceerror.ServiceError) {
 	ret := _mock.Called(ctx, app)
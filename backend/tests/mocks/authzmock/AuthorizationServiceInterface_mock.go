@@ -108,3 +108,73 @@ func (_c *AuthorizationServiceInterfaceMock_GetAuthorizedPermissions_Call) RunAn
 	_c.Call.Return(run)
 	return _c
 }
+
+// GetGrantableScopes provides a mock function for the type AuthorizationServiceInterfaceMock
+func (_mock *AuthorizationServiceInterfaceMock) GetGrantableScopes(ctx context.Context, request authz.GetGrantableScopesRequest) (*authz.GetGrantableScopesResponse, *serviceerror.ServiceError) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetGrantableScopes")
+	}
+
+	var r0 *authz.GetGrantableScopesResponse
+	var r1 *serviceerror.ServiceError
+	if returnFunc, ok := ret.Get(0).(func(context.Context, authz.GetGrantableScopesRequest) (*authz.GetGrantableScopesResponse, *serviceerror.ServiceError)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, authz.GetGrantableScopesRequest) *authz.GetGrantableScopesResponse); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*authz.GetGrantableScopesResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, authz.GetGrantableScopesRequest) *serviceerror.ServiceError); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*serviceerror.ServiceError)
+		}
+	}
+	return r0, r1
+}
+
+// AuthorizationServiceInterfaceMock_GetGrantableScopes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetGrantableScopes'
+type AuthorizationServiceInterfaceMock_GetGrantableScopes_Call struct {
+	*mock.Call
+}
+
+// GetGrantableScopes is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request authz.GetGrantableScopesRequest
+func (_e *AuthorizationServiceInterfaceMock_Expecter) GetGrantableScopes(ctx interface{}, request interface{}) *AuthorizationServiceInterfaceMock_GetGrantableScopes_Call {
+	return &AuthorizationServiceInterfaceMock_GetGrantableScopes_Call{Call: _e.mock.On("GetGrantableScopes", ctx, request)}
+}
+
+func (_c *AuthorizationServiceInterfaceMock_GetGrantableScopes_Call) Run(run func(ctx context.Context, request authz.GetGrantableScopesRequest)) *AuthorizationServiceInterfaceMock_GetGrantableScopes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 authz.GetGrantableScopesRequest
+		if args[1] != nil {
+			arg1 = args[1].(authz.GetGrantableScopesRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *AuthorizationServiceInterfaceMock_GetGrantableScopes_Call) Return(getGrantableScopesResponse *authz.GetGrantableScopesResponse, serviceError *serviceerror.ServiceError) *AuthorizationServiceInterfaceMock_GetGrantableScopes_Call {
+	_c.Call.Return(getGrantableScopesResponse, serviceError)
+	return _c
+}
+
+func (_c *AuthorizationServiceInterfaceMock_GetGrantableScopes_Call) RunAndReturn(run func(ctx context.Context, request authz.GetGrantableScopesRequest) (*authz.GetGrantableScopesResponse, *serviceerror.ServiceError)) *AuthorizationServiceInterfaceMock_GetGrantableScopes_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/redis/go-redis/v9"
 	mock "github.com/stretchr/testify/mock"
+	"github.com/thunder-id/thunderid/internal/system/cache"
 )
 
 // NewCacheManagerInterfaceMock creates a new instance of CacheManagerInterfaceMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
@@ -256,6 +257,52 @@ func (_c *CacheManagerInterfaceMock_getCache_Call) RunAndReturn(run func(cacheKe
 	return _c
 }
 
+// getInvalidationBus provides a mock function for the type CacheManagerInterfaceMock
+func (_mock *CacheManagerInterfaceMock) getInvalidationBus() cache.InvalidationBusInterface {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for getInvalidationBus")
+	}
+
+	var r0 cache.InvalidationBusInterface
+	if returnFunc, ok := ret.Get(0).(func() cache.InvalidationBusInterface); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(cache.InvalidationBusInterface)
+		}
+	}
+	return r0
+}
+
+// CacheManagerInterfaceMock_getInvalidationBus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'getInvalidationBus'
+type CacheManagerInterfaceMock_getInvalidationBus_Call struct {
+	*mock.Call
+}
+
+// getInvalidationBus is a helper method to define mock.On call
+func (_e *CacheManagerInterfaceMock_Expecter) getInvalidationBus() *CacheManagerInterfaceMock_getInvalidationBus_Call {
+	return &CacheManagerInterfaceMock_getInvalidationBus_Call{Call: _e.mock.On("getInvalidationBus")}
+}
+
+func (_c *CacheManagerInterfaceMock_getInvalidationBus_Call) Run(run func()) *CacheManagerInterfaceMock_getInvalidationBus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *CacheManagerInterfaceMock_getInvalidationBus_Call) Return(invalidationBusInterface cache.InvalidationBusInterface) *CacheManagerInterfaceMock_getInvalidationBus_Call {
+	_c.Call.Return(invalidationBusInterface)
+	return _c
+}
+
+func (_c *CacheManagerInterfaceMock_getInvalidationBus_Call) RunAndReturn(run func() cache.InvalidationBusInterface) *CacheManagerInterfaceMock_getInvalidationBus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // getMutex provides a mock function for the type CacheManagerInterfaceMock
 func (_mock *CacheManagerInterfaceMock) getMutex() *sync.RWMutex {
 	ret := _mock.Called()
@@ -172,3 +172,69 @@ func (_c *ConfigCryptoProviderMock_Encrypt_Call) RunAndReturn(run func(ctx conte
 	_c.Call.Return(run)
 	return _c
 }
+
+// NeedsReencryption provides a mock function for the type ConfigCryptoProviderMock
+func (_mock *ConfigCryptoProviderMock) NeedsReencryption(ctx context.Context, content []byte) (bool, error) {
+	ret := _mock.Called(ctx, content)
+
+	if len(ret) == 0 {
+		panic("no return value specified for NeedsReencryption")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []byte) (bool, error)); ok {
+		return returnFunc(ctx, content)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []byte) bool); ok {
+		r0 = returnFunc(ctx, content)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, []byte) error); ok {
+		r1 = returnFunc(ctx, content)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// ConfigCryptoProviderMock_NeedsReencryption_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'NeedsReencryption'
+type ConfigCryptoProviderMock_NeedsReencryption_Call struct {
+	*mock.Call
+}
+
+// NeedsReencryption is a helper method to define mock.On call
+//   - ctx context.Context
+//   - content []byte
+func (_e *ConfigCryptoProviderMock_Expecter) NeedsReencryption(ctx interface{}, content interface{}) *ConfigCryptoProviderMock_NeedsReencryption_Call {
+	return &ConfigCryptoProviderMock_NeedsReencryption_Call{Call: _e.mock.On("NeedsReencryption", ctx, content)}
+}
+
+func (_c *ConfigCryptoProviderMock_NeedsReencryption_Call) Run(run func(ctx context.Context, content []byte)) *ConfigCryptoProviderMock_NeedsReencryption_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []byte
+		if args[1] != nil {
+			arg1 = args[1].([]byte)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *ConfigCryptoProviderMock_NeedsReencryption_Call) Return(b bool, err error) *ConfigCryptoProviderMock_NeedsReencryption_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *ConfigCryptoProviderMock_NeedsReencryption_Call) RunAndReturn(run func(ctx context.Context, content []byte) (bool, error)) *ConfigCryptoProviderMock_NeedsReencryption_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -96,6 +96,110 @@ func (_c *HashServiceInterfaceMock_Generate_Call) RunAndReturn(run func(credenti
 	return _c
 }
 
+// GetFormatPolicy provides a mock function for the type HashServiceInterfaceMock
+func (_mock *HashServiceInterfaceMock) GetFormatPolicy(algorithm hash.CredAlgorithm) hash.FormatPolicy {
+	ret := _mock.Called(algorithm)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFormatPolicy")
+	}
+
+	var r0 hash.FormatPolicy
+	if returnFunc, ok := ret.Get(0).(func(hash.CredAlgorithm) hash.FormatPolicy); ok {
+		r0 = returnFunc(algorithm)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(hash.FormatPolicy)
+		}
+	}
+	return r0
+}
+
+// HashServiceInterfaceMock_GetFormatPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFormatPolicy'
+type HashServiceInterfaceMock_GetFormatPolicy_Call struct {
+	*mock.Call
+}
+
+// GetFormatPolicy is a helper method to define mock.On call
+//   - algorithm hash.CredAlgorithm
+func (_e *HashServiceInterfaceMock_Expecter) GetFormatPolicy(algorithm interface{}) *HashServiceInterfaceMock_GetFormatPolicy_Call {
+	return &HashServiceInterfaceMock_GetFormatPolicy_Call{Call: _e.mock.On("GetFormatPolicy", algorithm)}
+}
+
+func (_c *HashServiceInterfaceMock_GetFormatPolicy_Call) Run(run func(algorithm hash.CredAlgorithm)) *HashServiceInterfaceMock_GetFormatPolicy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 hash.CredAlgorithm
+		if args[0] != nil {
+			arg0 = args[0].(hash.CredAlgorithm)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *HashServiceInterfaceMock_GetFormatPolicy_Call) Return(formatPolicy hash.FormatPolicy) *HashServiceInterfaceMock_GetFormatPolicy_Call {
+	_c.Call.Return(formatPolicy)
+	return _c
+}
+
+func (_c *HashServiceInterfaceMock_GetFormatPolicy_Call) RunAndReturn(run func(algorithm hash.CredAlgorithm) hash.FormatPolicy) *HashServiceInterfaceMock_GetFormatPolicy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NeedsUpgrade provides a mock function for the type HashServiceInterfaceMock
+func (_mock *HashServiceInterfaceMock) NeedsUpgrade(referenceCredential hash.Credential) bool {
+	ret := _mock.Called(referenceCredential)
+
+	if len(ret) == 0 {
+		panic("no return value specified for NeedsUpgrade")
+	}
+
+	var r0 bool
+	if returnFunc, ok := ret.Get(0).(func(hash.Credential) bool); ok {
+		r0 = returnFunc(referenceCredential)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	return r0
+}
+
+// HashServiceInterfaceMock_NeedsUpgrade_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'NeedsUpgrade'
+type HashServiceInterfaceMock_NeedsUpgrade_Call struct {
+	*mock.Call
+}
+
+// NeedsUpgrade is a helper method to define mock.On call
+//   - referenceCredential hash.Credential
+func (_e *HashServiceInterfaceMock_Expecter) NeedsUpgrade(referenceCredential interface{}) *HashServiceInterfaceMock_NeedsUpgrade_Call {
+	return &HashServiceInterfaceMock_NeedsUpgrade_Call{Call: _e.mock.On("NeedsUpgrade", referenceCredential)}
+}
+
+func (_c *HashServiceInterfaceMock_NeedsUpgrade_Call) Run(run func(referenceCredential hash.Credential)) *HashServiceInterfaceMock_NeedsUpgrade_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 hash.Credential
+		if args[0] != nil {
+			arg0 = args[0].(hash.Credential)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *HashServiceInterfaceMock_NeedsUpgrade_Call) Return(b bool) *HashServiceInterfaceMock_NeedsUpgrade_Call {
+	_c.Call.Return(b)
+	return _c
+}
+
+func (_c *HashServiceInterfaceMock_NeedsUpgrade_Call) RunAndReturn(run func(referenceCredential hash.Credential) bool) *HashServiceInterfaceMock_NeedsUpgrade_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Verify provides a mock function for the type HashServiceInterfaceMock
 func (_mock *HashServiceInterfaceMock) Verify(credentialValueToVerify []byte, referenceCredential hash.Credential) (bool, error) {
 	ret := _mock.Called(credentialValueToVerify, referenceCredential)
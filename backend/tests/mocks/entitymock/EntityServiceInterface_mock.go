@@ -10,6 +10,7 @@ import (
 
 	mock "github.com/stretchr/testify/mock"
 	"github.com/thunder-id/thunderid/internal/entity"
+	"github.com/thunder-id/thunderid/internal/entitytype"
 )
 
 // NewEntityServiceInterfaceMock creates a new instance of EntityServiceInterfaceMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
@@ -261,6 +262,69 @@ func (_c *EntityServiceInterfaceMock_CreateEntity_Call) RunAndReturn(run func(ct
 	return _c
 }
 
+// DeleteCredentials provides a mock function for the type EntityServiceInterfaceMock
+func (_mock *EntityServiceInterfaceMock) DeleteCredentials(ctx context.Context, entityID string, credentialTypes []string) error {
+	ret := _mock.Called(ctx, entityID, credentialTypes)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteCredentials")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, []string) error); ok {
+		r0 = returnFunc(ctx, entityID, credentialTypes)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// EntityServiceInterfaceMock_DeleteCredentials_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteCredentials'
+type EntityServiceInterfaceMock_DeleteCredentials_Call struct {
+	*mock.Call
+}
+
+// DeleteCredentials is a helper method to define mock.On call
+//   - ctx context.Context
+//   - entityID string
+//   - credentialTypes []string
+func (_e *EntityServiceInterfaceMock_Expecter) DeleteCredentials(ctx interface{}, entityID interface{}, credentialTypes interface{}) *EntityServiceInterfaceMock_DeleteCredentials_Call {
+	return &EntityServiceInterfaceMock_DeleteCredentials_Call{Call: _e.mock.On("DeleteCredentials", ctx, entityID, credentialTypes)}
+}
+
+func (_c *EntityServiceInterfaceMock_DeleteCredentials_Call) Run(run func(ctx context.Context, entityID string, credentialTypes []string)) *EntityServiceInterfaceMock_DeleteCredentials_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 []string
+		if args[2] != nil {
+			arg2 = args[2].([]string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *EntityServiceInterfaceMock_DeleteCredentials_Call) Return(err error) *EntityServiceInterfaceMock_DeleteCredentials_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *EntityServiceInterfaceMock_DeleteCredentials_Call) RunAndReturn(run func(ctx context.Context, entityID string, credentialTypes []string) error) *EntityServiceInterfaceMock_DeleteCredentials_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DeleteEntity provides a mock function for the type EntityServiceInterfaceMock
 func (_mock *EntityServiceInterfaceMock) DeleteEntity(ctx context.Context, entityID string) error {
 	ret := _mock.Called(ctx, entityID)
@@ -318,6 +382,210 @@ func (_c *EntityServiceInterfaceMock_DeleteEntity_Call) RunAndReturn(run func(ct
 	return _c
 }
 
+// EvaluateCredentialPolicy provides a mock function for the type EntityServiceInterfaceMock
+func (_mock *EntityServiceInterfaceMock) EvaluateCredentialPolicy(ctx context.Context, entityID string) (*entitytype.CredentialPolicyEvaluation, error) {
+	ret := _mock.Called(ctx, entityID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EvaluateCredentialPolicy")
+	}
+
+	var r0 *entitytype.CredentialPolicyEvaluation
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*entitytype.CredentialPolicyEvaluation, error)); ok {
+		return returnFunc(ctx, entityID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *entitytype.CredentialPolicyEvaluation); ok {
+		r0 = returnFunc(ctx, entityID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entitytype.CredentialPolicyEvaluation)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, entityID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// EntityServiceInterfaceMock_EvaluateCredentialPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EvaluateCredentialPolicy'
+type EntityServiceInterfaceMock_EvaluateCredentialPolicy_Call struct {
+	*mock.Call
+}
+
+// EvaluateCredentialPolicy is a helper method to define mock.On call
+//   - ctx context.Context
+//   - entityID string
+func (_e *EntityServiceInterfaceMock_Expecter) EvaluateCredentialPolicy(ctx interface{}, entityID interface{}) *EntityServiceInterfaceMock_EvaluateCredentialPolicy_Call {
+	return &EntityServiceInterfaceMock_EvaluateCredentialPolicy_Call{Call: _e.mock.On("EvaluateCredentialPolicy", ctx, entityID)}
+}
+
+func (_c *EntityServiceInterfaceMock_EvaluateCredentialPolicy_Call) Run(run func(ctx context.Context, entityID string)) *EntityServiceInterfaceMock_EvaluateCredentialPolicy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *EntityServiceInterfaceMock_EvaluateCredentialPolicy_Call) Return(credentialPolicyEvaluation *entitytype.CredentialPolicyEvaluation, err error) *EntityServiceInterfaceMock_EvaluateCredentialPolicy_Call {
+	_c.Call.Return(credentialPolicyEvaluation, err)
+	return _c
+}
+
+func (_c *EntityServiceInterfaceMock_EvaluateCredentialPolicy_Call) RunAndReturn(run func(ctx context.Context, entityID string) (*entitytype.CredentialPolicyEvaluation, error)) *EntityServiceInterfaceMock_EvaluateCredentialPolicy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCredentialFormatCounts provides a mock function for the type EntityServiceInterfaceMock
+func (_mock *EntityServiceInterfaceMock) GetCredentialFormatCounts(ctx context.Context, category entity.EntityCategory) ([]entity.CredentialFormatCount, error) {
+	ret := _mock.Called(ctx, category)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCredentialFormatCounts")
+	}
+
+	var r0 []entity.CredentialFormatCount
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, entity.EntityCategory) ([]entity.CredentialFormatCount, error)); ok {
+		return returnFunc(ctx, category)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, entity.EntityCategory) []entity.CredentialFormatCount); ok {
+		r0 = returnFunc(ctx, category)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.CredentialFormatCount)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, entity.EntityCategory) error); ok {
+		r1 = returnFunc(ctx, category)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// EntityServiceInterfaceMock_GetCredentialFormatCounts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCredentialFormatCounts'
+type EntityServiceInterfaceMock_GetCredentialFormatCounts_Call struct {
+	*mock.Call
+}
+
+// GetCredentialFormatCounts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - category entity.EntityCategory
+func (_e *EntityServiceInterfaceMock_Expecter) GetCredentialFormatCounts(ctx interface{}, category interface{}) *EntityServiceInterfaceMock_GetCredentialFormatCounts_Call {
+	return &EntityServiceInterfaceMock_GetCredentialFormatCounts_Call{Call: _e.mock.On("GetCredentialFormatCounts", ctx, category)}
+}
+
+func (_c *EntityServiceInterfaceMock_GetCredentialFormatCounts_Call) Run(run func(ctx context.Context, category entity.EntityCategory)) *EntityServiceInterfaceMock_GetCredentialFormatCounts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 entity.EntityCategory
+		if args[1] != nil {
+			arg1 = args[1].(entity.EntityCategory)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *EntityServiceInterfaceMock_GetCredentialFormatCounts_Call) Return(credentialFormatCounts []entity.CredentialFormatCount, err error) *EntityServiceInterfaceMock_GetCredentialFormatCounts_Call {
+	_c.Call.Return(credentialFormatCounts, err)
+	return _c
+}
+
+func (_c *EntityServiceInterfaceMock_GetCredentialFormatCounts_Call) RunAndReturn(run func(ctx context.Context, category entity.EntityCategory) ([]entity.CredentialFormatCount, error)) *EntityServiceInterfaceMock_GetCredentialFormatCounts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCredentialTypes provides a mock function for the type EntityServiceInterfaceMock
+func (_mock *EntityServiceInterfaceMock) GetCredentialTypes(ctx context.Context, entityID string) ([]string, error) {
+	ret := _mock.Called(ctx, entityID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCredentialTypes")
+	}
+
+	var r0 []string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) ([]string, error)); ok {
+		return returnFunc(ctx, entityID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) []string); ok {
+		r0 = returnFunc(ctx, entityID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, entityID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// EntityServiceInterfaceMock_GetCredentialTypes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCredentialTypes'
+type EntityServiceInterfaceMock_GetCredentialTypes_Call struct {
+	*mock.Call
+}
+
+// GetCredentialTypes is a helper method to define mock.On call
+//   - ctx context.Context
+//   - entityID string
+func (_e *EntityServiceInterfaceMock_Expecter) GetCredentialTypes(ctx interface{}, entityID interface{}) *EntityServiceInterfaceMock_GetCredentialTypes_Call {
+	return &EntityServiceInterfaceMock_GetCredentialTypes_Call{Call: _e.mock.On("GetCredentialTypes", ctx, entityID)}
+}
+
+func (_c *EntityServiceInterfaceMock_GetCredentialTypes_Call) Run(run func(ctx context.Context, entityID string)) *EntityServiceInterfaceMock_GetCredentialTypes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *EntityServiceInterfaceMock_GetCredentialTypes_Call) Return(strings []string, err error) *EntityServiceInterfaceMock_GetCredentialTypes_Call {
+	_c.Call.Return(strings, err)
+	return _c
+}
+
+func (_c *EntityServiceInterfaceMock_GetCredentialTypes_Call) RunAndReturn(run func(ctx context.Context, entityID string) ([]string, error)) *EntityServiceInterfaceMock_GetCredentialTypes_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetCredentialsByType provides a mock function for the type EntityServiceInterfaceMock
 func (_mock *EntityServiceInterfaceMock) GetCredentialsByType(ctx context.Context, entityID string, credType string) ([]entity.StoredCredential, error) {
 	ret := _mock.Called(ctx, entityID, credType)
@@ -1002,6 +1270,52 @@ func (_c *EntityServiceInterfaceMock_GetGroupCountForEntity_Call) RunAndReturn(r
 	return _c
 }
 
+// GetIndexedAttributes provides a mock function for the type EntityServiceInterfaceMock
+func (_mock *EntityServiceInterfaceMock) GetIndexedAttributes() []string {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetIndexedAttributes")
+	}
+
+	var r0 []string
+	if returnFunc, ok := ret.Get(0).(func() []string); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+	return r0
+}
+
+// EntityServiceInterfaceMock_GetIndexedAttributes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetIndexedAttributes'
+type EntityServiceInterfaceMock_GetIndexedAttributes_Call struct {
+	*mock.Call
+}
+
+// GetIndexedAttributes is a helper method to define mock.On call
+func (_e *EntityServiceInterfaceMock_Expecter) GetIndexedAttributes() *EntityServiceInterfaceMock_GetIndexedAttributes_Call {
+	return &EntityServiceInterfaceMock_GetIndexedAttributes_Call{Call: _e.mock.On("GetIndexedAttributes")}
+}
+
+func (_c *EntityServiceInterfaceMock_GetIndexedAttributes_Call) Run(run func()) *EntityServiceInterfaceMock_GetIndexedAttributes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *EntityServiceInterfaceMock_GetIndexedAttributes_Call) Return(strings []string) *EntityServiceInterfaceMock_GetIndexedAttributes_Call {
+	_c.Call.Return(strings)
+	return _c
+}
+
+func (_c *EntityServiceInterfaceMock_GetIndexedAttributes_Call) RunAndReturn(run func() []string) *EntityServiceInterfaceMock_GetIndexedAttributes_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetTransitiveEntityGroups provides a mock function for the type EntityServiceInterfaceMock
 func (_mock *EntityServiceInterfaceMock) GetTransitiveEntityGroups(ctx context.Context, entityID string) ([]entity.EntityGroup, error) {
 	ret := _mock.Called(ctx, entityID)
@@ -1306,6 +1620,74 @@ func (_c *EntityServiceInterfaceMock_LoadIndexedAttributes_Call) RunAndReturn(ru
 	return _c
 }
 
+// PrehashCredentials provides a mock function for the type EntityServiceInterfaceMock
+func (_mock *EntityServiceInterfaceMock) PrehashCredentials(ctx context.Context, entities []*entity.Entity) (context.Context, error) {
+	ret := _mock.Called(ctx, entities)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PrehashCredentials")
+	}
+
+	var r0 context.Context
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []*entity.Entity) (context.Context, error)); ok {
+		return returnFunc(ctx, entities)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []*entity.Entity) context.Context); ok {
+		r0 = returnFunc(ctx, entities)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(context.Context)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, []*entity.Entity) error); ok {
+		r1 = returnFunc(ctx, entities)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// EntityServiceInterfaceMock_PrehashCredentials_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PrehashCredentials'
+type EntityServiceInterfaceMock_PrehashCredentials_Call struct {
+	*mock.Call
+}
+
+// PrehashCredentials is a helper method to define mock.On call
+//   - ctx context.Context
+//   - entities []*entity.Entity
+func (_e *EntityServiceInterfaceMock_Expecter) PrehashCredentials(ctx interface{}, entities interface{}) *EntityServiceInterfaceMock_PrehashCredentials_Call {
+	return &EntityServiceInterfaceMock_PrehashCredentials_Call{Call: _e.mock.On("PrehashCredentials", ctx, entities)}
+}
+
+func (_c *EntityServiceInterfaceMock_PrehashCredentials_Call) Run(run func(ctx context.Context, entities []*entity.Entity)) *EntityServiceInterfaceMock_PrehashCredentials_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []*entity.Entity
+		if args[1] != nil {
+			arg1 = args[1].([]*entity.Entity)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *EntityServiceInterfaceMock_PrehashCredentials_Call) Return(context1 context.Context, err error) *EntityServiceInterfaceMock_PrehashCredentials_Call {
+	_c.Call.Return(context1, err)
+	return _c
+}
+
+func (_c *EntityServiceInterfaceMock_PrehashCredentials_Call) RunAndReturn(run func(ctx context.Context, entities []*entity.Entity) (context.Context, error)) *EntityServiceInterfaceMock_PrehashCredentials_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SearchEntities provides a mock function for the type EntityServiceInterfaceMock
 func (_mock *EntityServiceInterfaceMock) SearchEntities(ctx context.Context, filters map[string]interface{}) ([]entity.Entity, error) {
 	ret := _mock.Called(ctx, filters)
@@ -161,6 +161,134 @@ func (_c *EntityProviderInterfaceMock_DeleteEntity_Call) RunAndReturn(run func(e
 	return _c
 }
 
+// EvaluateCredentialPolicy provides a mock function for the type EntityProviderInterfaceMock
+func (_mock *EntityProviderInterfaceMock) EvaluateCredentialPolicy(entityID string) (*entityprovider.CredentialPolicyEvaluation, *entityprovider.EntityProviderError) {
+	ret := _mock.Called(entityID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EvaluateCredentialPolicy")
+	}
+
+	var r0 *entityprovider.CredentialPolicyEvaluation
+	var r1 *entityprovider.EntityProviderError
+	if returnFunc, ok := ret.Get(0).(func(string) (*entityprovider.CredentialPolicyEvaluation, *entityprovider.EntityProviderError)); ok {
+		return returnFunc(entityID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string) *entityprovider.CredentialPolicyEvaluation); ok {
+		r0 = returnFunc(entityID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entityprovider.CredentialPolicyEvaluation)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(string) *entityprovider.EntityProviderError); ok {
+		r1 = returnFunc(entityID)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*entityprovider.EntityProviderError)
+		}
+	}
+	return r0, r1
+}
+
+// EntityProviderInterfaceMock_EvaluateCredentialPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EvaluateCredentialPolicy'
+type EntityProviderInterfaceMock_EvaluateCredentialPolicy_Call struct {
+	*mock.Call
+}
+
+// EvaluateCredentialPolicy is a helper method to define mock.On call
+//   - entityID string
+func (_e *EntityProviderInterfaceMock_Expecter) EvaluateCredentialPolicy(entityID interface{}) *EntityProviderInterfaceMock_EvaluateCredentialPolicy_Call {
+	return &EntityProviderInterfaceMock_EvaluateCredentialPolicy_Call{Call: _e.mock.On("EvaluateCredentialPolicy", entityID)}
+}
+
+func (_c *EntityProviderInterfaceMock_EvaluateCredentialPolicy_Call) Run(run func(entityID string)) *EntityProviderInterfaceMock_EvaluateCredentialPolicy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *EntityProviderInterfaceMock_EvaluateCredentialPolicy_Call) Return(credentialPolicyEvaluation *entityprovider.CredentialPolicyEvaluation, entityProviderError *entityprovider.EntityProviderError) *EntityProviderInterfaceMock_EvaluateCredentialPolicy_Call {
+	_c.Call.Return(credentialPolicyEvaluation, entityProviderError)
+	return _c
+}
+
+func (_c *EntityProviderInterfaceMock_EvaluateCredentialPolicy_Call) RunAndReturn(run func(entityID string) (*entityprovider.CredentialPolicyEvaluation, *entityprovider.EntityProviderError)) *EntityProviderInterfaceMock_EvaluateCredentialPolicy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCredentialTypes provides a mock function for the type EntityProviderInterfaceMock
+func (_mock *EntityProviderInterfaceMock) GetCredentialTypes(entityID string) ([]string, *entityprovider.EntityProviderError) {
+	ret := _mock.Called(entityID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCredentialTypes")
+	}
+
+	var r0 []string
+	var r1 *entityprovider.EntityProviderError
+	if returnFunc, ok := ret.Get(0).(func(string) ([]string, *entityprovider.EntityProviderError)); ok {
+		return returnFunc(entityID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string) []string); ok {
+		r0 = returnFunc(entityID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(string) *entityprovider.EntityProviderError); ok {
+		r1 = returnFunc(entityID)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*entityprovider.EntityProviderError)
+		}
+	}
+	return r0, r1
+}
+
+// EntityProviderInterfaceMock_GetCredentialTypes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCredentialTypes'
+type EntityProviderInterfaceMock_GetCredentialTypes_Call struct {
+	*mock.Call
+}
+
+// GetCredentialTypes is a helper method to define mock.On call
+//   - entityID string
+func (_e *EntityProviderInterfaceMock_Expecter) GetCredentialTypes(entityID interface{}) *EntityProviderInterfaceMock_GetCredentialTypes_Call {
+	return &EntityProviderInterfaceMock_GetCredentialTypes_Call{Call: _e.mock.On("GetCredentialTypes", entityID)}
+}
+
+func (_c *EntityProviderInterfaceMock_GetCredentialTypes_Call) Run(run func(entityID string)) *EntityProviderInterfaceMock_GetCredentialTypes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *EntityProviderInterfaceMock_GetCredentialTypes_Call) Return(strings []string, entityProviderError *entityprovider.EntityProviderError) *EntityProviderInterfaceMock_GetCredentialTypes_Call {
+	_c.Call.Return(strings, entityProviderError)
+	return _c
+}
+
+func (_c *EntityProviderInterfaceMock_GetCredentialTypes_Call) RunAndReturn(run func(entityID string) ([]string, *entityprovider.EntityProviderError)) *EntityProviderInterfaceMock_GetCredentialTypes_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetEntitiesByIDs provides a mock function for the type EntityProviderInterfaceMock
 func (_mock *EntityProviderInterfaceMock) GetEntitiesByIDs(entityIDs []string) ([]entityprovider.Entity, *entityprovider.EntityProviderError) {
 	ret := _mock.Called(entityIDs)
@@ -800,6 +800,65 @@ func (_c *GroupServiceInterfaceMock_RemoveGroupMembers_Call) RunAndReturn(run fu
 	return _c
 }
 
+// RemoveMemberFromAllGroups provides a mock function for the type GroupServiceInterfaceMock
+func (_mock *GroupServiceInterfaceMock) RemoveMemberFromAllGroups(ctx context.Context, member group.Member) *serviceerror.ServiceError {
+	ret := _mock.Called(ctx, member)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveMemberFromAllGroups")
+	}
+
+	var r0 *serviceerror.ServiceError
+	if returnFunc, ok := ret.Get(0).(func(context.Context, group.Member) *serviceerror.ServiceError); ok {
+		r0 = returnFunc(ctx, member)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*serviceerror.ServiceError)
+		}
+	}
+	return r0
+}
+
+// GroupServiceInterfaceMock_RemoveMemberFromAllGroups_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveMemberFromAllGroups'
+type GroupServiceInterfaceMock_RemoveMemberFromAllGroups_Call struct {
+	*mock.Call
+}
+
+// RemoveMemberFromAllGroups is a helper method to define mock.On call
+//   - ctx context.Context
+//   - member group.Member
+func (_e *GroupServiceInterfaceMock_Expecter) RemoveMemberFromAllGroups(ctx interface{}, member interface{}) *GroupServiceInterfaceMock_RemoveMemberFromAllGroups_Call {
+	return &GroupServiceInterfaceMock_RemoveMemberFromAllGroups_Call{Call: _e.mock.On("RemoveMemberFromAllGroups", ctx, member)}
+}
+
+func (_c *GroupServiceInterfaceMock_RemoveMemberFromAllGroups_Call) Run(run func(ctx context.Context, member group.Member)) *GroupServiceInterfaceMock_RemoveMemberFromAllGroups_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 group.Member
+		if args[1] != nil {
+			arg1 = args[1].(group.Member)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *GroupServiceInterfaceMock_RemoveMemberFromAllGroups_Call) Return(serviceError *serviceerror.ServiceError) *GroupServiceInterfaceMock_RemoveMemberFromAllGroups_Call {
+	_c.Call.Return(serviceError)
+	return _c
+}
+
+func (_c *GroupServiceInterfaceMock_RemoveMemberFromAllGroups_Call) RunAndReturn(run func(ctx context.Context, member group.Member) *serviceerror.ServiceError) *GroupServiceInterfaceMock_RemoveMemberFromAllGroups_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateGroup provides a mock function for the type GroupServiceInterfaceMock
 func (_mock *GroupServiceInterfaceMock) UpdateGroup(ctx context.Context, groupID string, request group.UpdateGroupRequest) (*group.Group, *serviceerror.ServiceError) {
 	ret := _mock.Called(ctx, groupID, request)
@@ -1116,6 +1116,63 @@ func (_c *groupStoreInterfaceMock_RemoveGroupMembers_Call) RunAndReturn(run func
 	return _c
 }
 
+// RemoveMemberFromAllGroups provides a mock function for the type groupStoreInterfaceMock
+func (_mock *groupStoreInterfaceMock) RemoveMemberFromAllGroups(ctx context.Context, member group.Member) error {
+	ret := _mock.Called(ctx, member)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveMemberFromAllGroups")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, group.Member) error); ok {
+		r0 = returnFunc(ctx, member)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// groupStoreInterfaceMock_RemoveMemberFromAllGroups_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveMemberFromAllGroups'
+type groupStoreInterfaceMock_RemoveMemberFromAllGroups_Call struct {
+	*mock.Call
+}
+
+// RemoveMemberFromAllGroups is a helper method to define mock.On call
+//   - ctx context.Context
+//   - member group.Member
+func (_e *groupStoreInterfaceMock_Expecter) RemoveMemberFromAllGroups(ctx interface{}, member interface{}) *groupStoreInterfaceMock_RemoveMemberFromAllGroups_Call {
+	return &groupStoreInterfaceMock_RemoveMemberFromAllGroups_Call{Call: _e.mock.On("RemoveMemberFromAllGroups", ctx, member)}
+}
+
+func (_c *groupStoreInterfaceMock_RemoveMemberFromAllGroups_Call) Run(run func(ctx context.Context, member group.Member)) *groupStoreInterfaceMock_RemoveMemberFromAllGroups_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 group.Member
+		if args[1] != nil {
+			arg1 = args[1].(group.Member)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *groupStoreInterfaceMock_RemoveMemberFromAllGroups_Call) Return(err error) *groupStoreInterfaceMock_RemoveMemberFromAllGroups_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *groupStoreInterfaceMock_RemoveMemberFromAllGroups_Call) RunAndReturn(run func(ctx context.Context, member group.Member) error) *groupStoreInterfaceMock_RemoveMemberFromAllGroups_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateGroup provides a mock function for the type groupStoreInterfaceMock
 func (_mock *groupStoreInterfaceMock) UpdateGroup(ctx context.Context, group1 group.GroupDAO) error {
 	ret := _mock.Called(ctx, group1)
@@ -79,3 +79,102 @@ func (_c *HealthCheckServiceInterfaceMock_CheckReadiness_Call) RunAndReturn(run
 	_c.Call.Return(run)
 	return _c
 }
+
+// MarkDraining provides a mock function for the type HealthCheckServiceInterfaceMock
+func (_mock *HealthCheckServiceInterfaceMock) MarkDraining() {
+	_mock.Called()
+	return
+}
+
+// HealthCheckServiceInterfaceMock_MarkDraining_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkDraining'
+type HealthCheckServiceInterfaceMock_MarkDraining_Call struct {
+	*mock.Call
+}
+
+// MarkDraining is a helper method to define mock.On call
+func (_e *HealthCheckServiceInterfaceMock_Expecter) MarkDraining() *HealthCheckServiceInterfaceMock_MarkDraining_Call {
+	return &HealthCheckServiceInterfaceMock_MarkDraining_Call{Call: _e.mock.On("MarkDraining")}
+}
+
+func (_c *HealthCheckServiceInterfaceMock_MarkDraining_Call) Run(run func()) *HealthCheckServiceInterfaceMock_MarkDraining_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *HealthCheckServiceInterfaceMock_MarkDraining_Call) Return() *HealthCheckServiceInterfaceMock_MarkDraining_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *HealthCheckServiceInterfaceMock_MarkDraining_Call) RunAndReturn(run func()) *HealthCheckServiceInterfaceMock_MarkDraining_Call {
+	_c.Run(run)
+	return _c
+}
+
+// MarkWarm provides a mock function for the type HealthCheckServiceInterfaceMock
+func (_mock *HealthCheckServiceInterfaceMock) MarkWarm() {
+	_mock.Called()
+	return
+}
+
+// HealthCheckServiceInterfaceMock_MarkWarm_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkWarm'
+type HealthCheckServiceInterfaceMock_MarkWarm_Call struct {
+	*mock.Call
+}
+
+// MarkWarm is a helper method to define mock.On call
+func (_e *HealthCheckServiceInterfaceMock_Expecter) MarkWarm() *HealthCheckServiceInterfaceMock_MarkWarm_Call {
+	return &HealthCheckServiceInterfaceMock_MarkWarm_Call{Call: _e.mock.On("MarkWarm")}
+}
+
+func (_c *HealthCheckServiceInterfaceMock_MarkWarm_Call) Run(run func()) *HealthCheckServiceInterfaceMock_MarkWarm_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *HealthCheckServiceInterfaceMock_MarkWarm_Call) Return() *HealthCheckServiceInterfaceMock_MarkWarm_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *HealthCheckServiceInterfaceMock_MarkWarm_Call) RunAndReturn(run func()) *HealthCheckServiceInterfaceMock_MarkWarm_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkWarming provides a mock function for the type HealthCheckServiceInterfaceMock
+func (_mock *HealthCheckServiceInterfaceMock) MarkWarming() {
+	_mock.Called()
+	return
+}
+
+// HealthCheckServiceInterfaceMock_MarkWarming_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkWarming'
+type HealthCheckServiceInterfaceMock_MarkWarming_Call struct {
+	*mock.Call
+}
+
+// MarkWarming is a helper method to define mock.On call
+func (_e *HealthCheckServiceInterfaceMock_Expecter) MarkWarming() *HealthCheckServiceInterfaceMock_MarkWarming_Call {
+	return &HealthCheckServiceInterfaceMock_MarkWarming_Call{Call: _e.mock.On("MarkWarming")}
+}
+
+func (_c *HealthCheckServiceInterfaceMock_MarkWarming_Call) Run(run func()) *HealthCheckServiceInterfaceMock_MarkWarming_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *HealthCheckServiceInterfaceMock_MarkWarming_Call) Return() *HealthCheckServiceInterfaceMock_MarkWarming_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *HealthCheckServiceInterfaceMock_MarkWarming_Call) RunAndReturn(run func()) *HealthCheckServiceInterfaceMock_MarkWarming_Call {
+	_c.Call.Return(run)
+	return _c
+}
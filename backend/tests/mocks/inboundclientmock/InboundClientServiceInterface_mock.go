@@ -634,6 +634,69 @@ func (_c *InboundClientServiceInterfaceMock_LoadDeclarativeResources_Call) RunAn
 	return _c
 }
 
+// UpdateAllowedScopesByGrantType provides a mock function for the type InboundClientServiceInterfaceMock
+func (_mock *InboundClientServiceInterfaceMock) UpdateAllowedScopesByGrantType(ctx context.Context, entityID string, policies map[string][]string) error {
+	ret := _mock.Called(ctx, entityID, policies)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateAllowedScopesByGrantType")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, map[string][]string) error); ok {
+		r0 = returnFunc(ctx, entityID, policies)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// InboundClientServiceInterfaceMock_UpdateAllowedScopesByGrantType_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateAllowedScopesByGrantType'
+type InboundClientServiceInterfaceMock_UpdateAllowedScopesByGrantType_Call struct {
+	*mock.Call
+}
+
+// UpdateAllowedScopesByGrantType is a helper method to define mock.On call
+//   - ctx context.Context
+//   - entityID string
+//   - policies map[string][]string
+func (_e *InboundClientServiceInterfaceMock_Expecter) UpdateAllowedScopesByGrantType(ctx interface{}, entityID interface{}, policies interface{}) *InboundClientServiceInterfaceMock_UpdateAllowedScopesByGrantType_Call {
+	return &InboundClientServiceInterfaceMock_UpdateAllowedScopesByGrantType_Call{Call: _e.mock.On("UpdateAllowedScopesByGrantType", ctx, entityID, policies)}
+}
+
+func (_c *InboundClientServiceInterfaceMock_UpdateAllowedScopesByGrantType_Call) Run(run func(ctx context.Context, entityID string, policies map[string][]string)) *InboundClientServiceInterfaceMock_UpdateAllowedScopesByGrantType_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 map[string][]string
+		if args[2] != nil {
+			arg2 = args[2].(map[string][]string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *InboundClientServiceInterfaceMock_UpdateAllowedScopesByGrantType_Call) Return(err error) *InboundClientServiceInterfaceMock_UpdateAllowedScopesByGrantType_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *InboundClientServiceInterfaceMock_UpdateAllowedScopesByGrantType_Call) RunAndReturn(run func(ctx context.Context, entityID string, policies map[string][]string) error) *InboundClientServiceInterfaceMock_UpdateAllowedScopesByGrantType_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateInboundClient provides a mock function for the type InboundClientServiceInterfaceMock
 func (_mock *InboundClientServiceInterfaceMock) UpdateInboundClient(ctx context.Context, client *model.InboundClient, appCert *model.Certificate, oauthProfile *model.OAuthProfile, hasClientSecret bool, oauthClientID string, entityName string) error {
 	ret := _mock.Called(ctx, client, appCert, oauthProfile, hasClientSecret, oauthClientID, entityName)
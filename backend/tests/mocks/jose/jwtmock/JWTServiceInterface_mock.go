@@ -262,16 +262,16 @@ func (_c *JWTServiceInterfaceMock_VerifyJWTSignature_Call) RunAndReturn(run func
 }
 
 // VerifyJWTSignatureWithJWKS provides a mock function for the type JWTServiceInterfaceMock
-func (_mock *JWTServiceInterfaceMock) VerifyJWTSignatureWithJWKS(jwtToken string, jwksURL string) *serviceerror.ServiceError {
-	ret := _mock.Called(jwtToken, jwksURL)
+func (_mock *JWTServiceInterfaceMock) VerifyJWTSignatureWithJWKS(ctx context.Context, jwtToken string, jwksURL string) *serviceerror.ServiceError {
+	ret := _mock.Called(ctx, jwtToken, jwksURL)
 
 	if len(ret) == 0 {
 		panic("no return value specified for VerifyJWTSignatureWithJWKS")
 	}
 
 	var r0 *serviceerror.ServiceError
-	if returnFunc, ok := ret.Get(0).(func(string, string) *serviceerror.ServiceError); ok {
-		r0 = returnFunc(jwtToken, jwksURL)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) *serviceerror.ServiceError); ok {
+		r0 = returnFunc(ctx, jwtToken, jwksURL)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*serviceerror.ServiceError)
@@ -286,25 +286,31 @@ type JWTServiceInterfaceMock_VerifyJWTSignatureWithJWKS_Call struct {
 }
 
 // VerifyJWTSignatureWithJWKS is a helper method to define mock.On call
+//   - ctx context.Context
 //   - jwtToken string
 //   - jwksURL string
-func (_e *JWTServiceInterfaceMock_Expecter) VerifyJWTSignatureWithJWKS(jwtToken interface{}, jwksURL interface{}) *JWTServiceInterfaceMock_VerifyJWTSignatureWithJWKS_Call {
-	return &JWTServiceInterfaceMock_VerifyJWTSignatureWithJWKS_Call{Call: _e.mock.On("VerifyJWTSignatureWithJWKS", jwtToken, jwksURL)}
+func (_e *JWTServiceInterfaceMock_Expecter) VerifyJWTSignatureWithJWKS(ctx interface{}, jwtToken interface{}, jwksURL interface{}) *JWTServiceInterfaceMock_VerifyJWTSignatureWithJWKS_Call {
+	return &JWTServiceInterfaceMock_VerifyJWTSignatureWithJWKS_Call{Call: _e.mock.On("VerifyJWTSignatureWithJWKS", ctx, jwtToken, jwksURL)}
 }
 
-func (_c *JWTServiceInterfaceMock_VerifyJWTSignatureWithJWKS_Call) Run(run func(jwtToken string, jwksURL string)) *JWTServiceInterfaceMock_VerifyJWTSignatureWithJWKS_Call {
+func (_c *JWTServiceInterfaceMock_VerifyJWTSignatureWithJWKS_Call) Run(run func(ctx context.Context, jwtToken string, jwksURL string)) *JWTServiceInterfaceMock_VerifyJWTSignatureWithJWKS_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 string
+		var arg0 context.Context
 		if args[0] != nil {
-			arg0 = args[0].(string)
+			arg0 = args[0].(context.Context)
 		}
 		var arg1 string
 		if args[1] != nil {
 			arg1 = args[1].(string)
 		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
 		run(
 			arg0,
 			arg1,
+			arg2,
 		)
 	})
 	return _c
@@ -315,7 +321,7 @@ func (_c *JWTServiceInterfaceMock_VerifyJWTSignatureWithJWKS_Call) Return(servic
 	return _c
 }
 
-func (_c *JWTServiceInterfaceMock_VerifyJWTSignatureWithJWKS_Call) RunAndReturn(run func(jwtToken string, jwksURL string) *serviceerror.ServiceError) *JWTServiceInterfaceMock_VerifyJWTSignatureWithJWKS_Call {
+func (_c *JWTServiceInterfaceMock_VerifyJWTSignatureWithJWKS_Call) RunAndReturn(run func(ctx context.Context, jwtToken string, jwksURL string) *serviceerror.ServiceError) *JWTServiceInterfaceMock_VerifyJWTSignatureWithJWKS_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -380,16 +386,16 @@ func (_c *JWTServiceInterfaceMock_VerifyJWTSignatureWithPublicKey_Call) RunAndRe
 }
 
 // VerifyJWTWithJWKS provides a mock function for the type JWTServiceInterfaceMock
-func (_mock *JWTServiceInterfaceMock) VerifyJWTWithJWKS(jwtToken string, jwksURL string, expectedAud string, expectedIss string) *serviceerror.ServiceError {
-	ret := _mock.Called(jwtToken, jwksURL, expectedAud, expectedIss)
+func (_mock *JWTServiceInterfaceMock) VerifyJWTWithJWKS(ctx context.Context, jwtToken string, jwksURL string, expectedAud string, expectedIss string) *serviceerror.ServiceError {
+	ret := _mock.Called(ctx, jwtToken, jwksURL, expectedAud, expectedIss)
 
 	if len(ret) == 0 {
 		panic("no return value specified for VerifyJWTWithJWKS")
 	}
 
 	var r0 *serviceerror.ServiceError
-	if returnFunc, ok := ret.Get(0).(func(string, string, string, string) *serviceerror.ServiceError); ok {
-		r0 = returnFunc(jwtToken, jwksURL, expectedAud, expectedIss)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string, string) *serviceerror.ServiceError); ok {
+		r0 = returnFunc(ctx, jwtToken, jwksURL, expectedAud, expectedIss)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*serviceerror.ServiceError)
@@ -404,19 +410,20 @@ type JWTServiceInterfaceMock_VerifyJWTWithJWKS_Call struct {
 }
 
 // VerifyJWTWithJWKS is a helper method to define mock.On call
+//   - ctx context.Context
 //   - jwtToken string
 //   - jwksURL string
 //   - expectedAud string
 //   - expectedIss string
-func (_e *JWTServiceInterfaceMock_Expecter) VerifyJWTWithJWKS(jwtToken interface{}, jwksURL interface{}, expectedAud interface{}, expectedIss interface{}) *JWTServiceInterfaceMock_VerifyJWTWithJWKS_Call {
-	return &JWTServiceInterfaceMock_VerifyJWTWithJWKS_Call{Call: _e.mock.On("VerifyJWTWithJWKS", jwtToken, jwksURL, expectedAud, expectedIss)}
+func (_e *JWTServiceInterfaceMock_Expecter) VerifyJWTWithJWKS(ctx interface{}, jwtToken interface{}, jwksURL interface{}, expectedAud interface{}, expectedIss interface{}) *JWTServiceInterfaceMock_VerifyJWTWithJWKS_Call {
+	return &JWTServiceInterfaceMock_VerifyJWTWithJWKS_Call{Call: _e.mock.On("VerifyJWTWithJWKS", ctx, jwtToken, jwksURL, expectedAud, expectedIss)}
 }
 
-func (_c *JWTServiceInterfaceMock_VerifyJWTWithJWKS_Call) Run(run func(jwtToken string, jwksURL string, expectedAud string, expectedIss string)) *JWTServiceInterfaceMock_VerifyJWTWithJWKS_Call {
+func (_c *JWTServiceInterfaceMock_VerifyJWTWithJWKS_Call) Run(run func(ctx context.Context, jwtToken string, jwksURL string, expectedAud string, expectedIss string)) *JWTServiceInterfaceMock_VerifyJWTWithJWKS_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 string
+		var arg0 context.Context
 		if args[0] != nil {
-			arg0 = args[0].(string)
+			arg0 = args[0].(context.Context)
 		}
 		var arg1 string
 		if args[1] != nil {
@@ -430,11 +437,16 @@ func (_c *JWTServiceInterfaceMock_VerifyJWTWithJWKS_Call) Run(run func(jwtToken
 		if args[3] != nil {
 			arg3 = args[3].(string)
 		}
+		var arg4 string
+		if args[4] != nil {
+			arg4 = args[4].(string)
+		}
 		run(
 			arg0,
 			arg1,
 			arg2,
 			arg3,
+			arg4,
 		)
 	})
 	return _c
@@ -445,7 +457,7 @@ func (_c *JWTServiceInterfaceMock_VerifyJWTWithJWKS_Call) Return(serviceError *s
 	return _c
 }
 
-func (_c *JWTServiceInterfaceMock_VerifyJWTWithJWKS_Call) RunAndReturn(run func(jwtToken string, jwksURL string, expectedAud string, expectedIss string) *serviceerror.ServiceError) *JWTServiceInterfaceMock_VerifyJWTWithJWKS_Call {
+func (_c *JWTServiceInterfaceMock_VerifyJWTWithJWKS_Call) RunAndReturn(run func(ctx context.Context, jwtToken string, jwksURL string, expectedAud string, expectedIss string) *serviceerror.ServiceError) *JWTServiceInterfaceMock_VerifyJWTWithJWKS_Call {
 	_c.Call.Return(run)
 	return _c
 }
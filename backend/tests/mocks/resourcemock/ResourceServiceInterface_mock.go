@@ -462,6 +462,82 @@ func (_c *ResourceServiceInterfaceMock_DeleteResourceServer_Call) RunAndReturn(r
 	return _c
 }
 
+// ExpandScopes provides a mock function for the type ResourceServiceInterfaceMock
+func (_mock *ResourceServiceInterfaceMock) ExpandScopes(ctx context.Context, resourceServer *resource.ResourceServer, scopes []string) ([]string, *serviceerror.ServiceError) {
+	ret := _mock.Called(ctx, resourceServer, scopes)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExpandScopes")
+	}
+
+	var r0 []string
+	var r1 *serviceerror.ServiceError
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *resource.ResourceServer, []string) ([]string, *serviceerror.ServiceError)); ok {
+		return returnFunc(ctx, resourceServer, scopes)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *resource.ResourceServer, []string) []string); ok {
+		r0 = returnFunc(ctx, resourceServer, scopes)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *resource.ResourceServer, []string) *serviceerror.ServiceError); ok {
+		r1 = returnFunc(ctx, resourceServer, scopes)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*serviceerror.ServiceError)
+		}
+	}
+	return r0, r1
+}
+
+// ResourceServiceInterfaceMock_ExpandScopes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExpandScopes'
+type ResourceServiceInterfaceMock_ExpandScopes_Call struct {
+	*mock.Call
+}
+
+// ExpandScopes is a helper method to define mock.On call
+//   - ctx context.Context
+//   - resourceServer *resource.ResourceServer
+//   - scopes []string
+func (_e *ResourceServiceInterfaceMock_Expecter) ExpandScopes(ctx interface{}, resourceServer interface{}, scopes interface{}) *ResourceServiceInterfaceMock_ExpandScopes_Call {
+	return &ResourceServiceInterfaceMock_ExpandScopes_Call{Call: _e.mock.On("ExpandScopes", ctx, resourceServer, scopes)}
+}
+
+func (_c *ResourceServiceInterfaceMock_ExpandScopes_Call) Run(run func(ctx context.Context, resourceServer *resource.ResourceServer, scopes []string)) *ResourceServiceInterfaceMock_ExpandScopes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *resource.ResourceServer
+		if args[1] != nil {
+			arg1 = args[1].(*resource.ResourceServer)
+		}
+		var arg2 []string
+		if args[2] != nil {
+			arg2 = args[2].([]string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *ResourceServiceInterfaceMock_ExpandScopes_Call) Return(strings []string, serviceError *serviceerror.ServiceError) *ResourceServiceInterfaceMock_ExpandScopes_Call {
+	_c.Call.Return(strings, serviceError)
+	return _c
+}
+
+func (_c *ResourceServiceInterfaceMock_ExpandScopes_Call) RunAndReturn(run func(ctx context.Context, resourceServer *resource.ResourceServer, scopes []string) ([]string, *serviceerror.ServiceError)) *ResourceServiceInterfaceMock_ExpandScopes_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // FindResourceServersByPermissions provides a mock function for the type ResourceServiceInterfaceMock
 func (_mock *ResourceServiceInterfaceMock) FindResourceServersByPermissions(ctx context.Context, permissions []string) ([]resource.ResourceServer, *serviceerror.ServiceError) {
 	ret := _mock.Called(ctx, permissions)
@@ -1082,6 +1158,76 @@ func (_c *ResourceServiceInterfaceMock_GetResourceServerList_Call) RunAndReturn(
 	return _c
 }
 
+// GetResourceServerPermissions provides a mock function for the type ResourceServiceInterfaceMock
+func (_mock *ResourceServiceInterfaceMock) GetResourceServerPermissions(ctx context.Context, resourceServerID string) ([]string, *serviceerror.ServiceError) {
+	ret := _mock.Called(ctx, resourceServerID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetResourceServerPermissions")
+	}
+
+	var r0 []string
+	var r1 *serviceerror.ServiceError
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) ([]string, *serviceerror.ServiceError)); ok {
+		return returnFunc(ctx, resourceServerID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) []string); ok {
+		r0 = returnFunc(ctx, resourceServerID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) *serviceerror.ServiceError); ok {
+		r1 = returnFunc(ctx, resourceServerID)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*serviceerror.ServiceError)
+		}
+	}
+	return r0, r1
+}
+
+// ResourceServiceInterfaceMock_GetResourceServerPermissions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetResourceServerPermissions'
+type ResourceServiceInterfaceMock_GetResourceServerPermissions_Call struct {
+	*mock.Call
+}
+
+// GetResourceServerPermissions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - resourceServerID string
+func (_e *ResourceServiceInterfaceMock_Expecter) GetResourceServerPermissions(ctx interface{}, resourceServerID interface{}) *ResourceServiceInterfaceMock_GetResourceServerPermissions_Call {
+	return &ResourceServiceInterfaceMock_GetResourceServerPermissions_Call{Call: _e.mock.On("GetResourceServerPermissions", ctx, resourceServerID)}
+}
+
+func (_c *ResourceServiceInterfaceMock_GetResourceServerPermissions_Call) Run(run func(ctx context.Context, resourceServerID string)) *ResourceServiceInterfaceMock_GetResourceServerPermissions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *ResourceServiceInterfaceMock_GetResourceServerPermissions_Call) Return(strings []string, serviceError *serviceerror.ServiceError) *ResourceServiceInterfaceMock_GetResourceServerPermissions_Call {
+	_c.Call.Return(strings, serviceError)
+	return _c
+}
+
+func (_c *ResourceServiceInterfaceMock_GetResourceServerPermissions_Call) RunAndReturn(run func(ctx context.Context, resourceServerID string) ([]string, *serviceerror.ServiceError)) *ResourceServiceInterfaceMock_GetResourceServerPermissions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // IsResourceServerDeclarative provides a mock function for the type ResourceServiceInterfaceMock
 func (_mock *ResourceServiceInterfaceMock) IsResourceServerDeclarative(id string) bool {
 	ret := _mock.Called(id)
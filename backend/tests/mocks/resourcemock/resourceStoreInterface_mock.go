@@ -1302,6 +1302,74 @@ func (_c *resourceStoreInterfaceMock_GetActionListCount_Call) RunAndReturn(run f
 	return _c
 }
 
+// GetPermissions provides a mock function for the type resourceStoreInterfaceMock
+func (_mock *resourceStoreInterfaceMock) GetPermissions(ctx context.Context, resServerID string) ([]string, error) {
+	ret := _mock.Called(ctx, resServerID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPermissions")
+	}
+
+	var r0 []string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) ([]string, error)); ok {
+		return returnFunc(ctx, resServerID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) []string); ok {
+		r0 = returnFunc(ctx, resServerID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, resServerID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// resourceStoreInterfaceMock_GetPermissions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPermissions'
+type resourceStoreInterfaceMock_GetPermissions_Call struct {
+	*mock.Call
+}
+
+// GetPermissions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - resServerID string
+func (_e *resourceStoreInterfaceMock_Expecter) GetPermissions(ctx interface{}, resServerID interface{}) *resourceStoreInterfaceMock_GetPermissions_Call {
+	return &resourceStoreInterfaceMock_GetPermissions_Call{Call: _e.mock.On("GetPermissions", ctx, resServerID)}
+}
+
+func (_c *resourceStoreInterfaceMock_GetPermissions_Call) Run(run func(ctx context.Context, resServerID string)) *resourceStoreInterfaceMock_GetPermissions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *resourceStoreInterfaceMock_GetPermissions_Call) Return(strings []string, err error) *resourceStoreInterfaceMock_GetPermissions_Call {
+	_c.Call.Return(strings, err)
+	return _c
+}
+
+func (_c *resourceStoreInterfaceMock_GetPermissions_Call) RunAndReturn(run func(ctx context.Context, resServerID string) ([]string, error)) *resourceStoreInterfaceMock_GetPermissions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetResource provides a mock function for the type resourceStoreInterfaceMock
 func (_mock *resourceStoreInterfaceMock) GetResource(ctx context.Context, id string, resServerID string) (resource.Resource, error) {
 	ret := _mock.Called(ctx, id, resServerID)
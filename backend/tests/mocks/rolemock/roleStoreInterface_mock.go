@@ -314,6 +314,63 @@ func (_c *roleStoreInterfaceMock_CreateRole_Call) RunAndReturn(run func(ctx cont
 	return _c
 }
 
+// DeleteAssignmentsByAssignee provides a mock function for the type roleStoreInterfaceMock
+func (_mock *roleStoreInterfaceMock) DeleteAssignmentsByAssignee(ctx context.Context, assignment role.RoleAssignment) error {
+	ret := _mock.Called(ctx, assignment)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteAssignmentsByAssignee")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, role.RoleAssignment) error); ok {
+		r0 = returnFunc(ctx, assignment)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// roleStoreInterfaceMock_DeleteAssignmentsByAssignee_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteAssignmentsByAssignee'
+type roleStoreInterfaceMock_DeleteAssignmentsByAssignee_Call struct {
+	*mock.Call
+}
+
+// DeleteAssignmentsByAssignee is a helper method to define mock.On call
+//   - ctx context.Context
+//   - assignment role.RoleAssignment
+func (_e *roleStoreInterfaceMock_Expecter) DeleteAssignmentsByAssignee(ctx interface{}, assignment interface{}) *roleStoreInterfaceMock_DeleteAssignmentsByAssignee_Call {
+	return &roleStoreInterfaceMock_DeleteAssignmentsByAssignee_Call{Call: _e.mock.On("DeleteAssignmentsByAssignee", ctx, assignment)}
+}
+
+func (_c *roleStoreInterfaceMock_DeleteAssignmentsByAssignee_Call) Run(run func(ctx context.Context, assignment role.RoleAssignment)) *roleStoreInterfaceMock_DeleteAssignmentsByAssignee_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 role.RoleAssignment
+		if args[1] != nil {
+			arg1 = args[1].(role.RoleAssignment)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *roleStoreInterfaceMock_DeleteAssignmentsByAssignee_Call) Return(err error) *roleStoreInterfaceMock_DeleteAssignmentsByAssignee_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *roleStoreInterfaceMock_DeleteAssignmentsByAssignee_Call) RunAndReturn(run func(ctx context.Context, assignment role.RoleAssignment) error) *roleStoreInterfaceMock_DeleteAssignmentsByAssignee_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DeleteAssignmentsByRoleID provides a mock function for the type roleStoreInterfaceMock
 func (_mock *roleStoreInterfaceMock) DeleteAssignmentsByRoleID(ctx context.Context, id string) error {
 	ret := _mock.Called(ctx, id)
@@ -582,6 +639,80 @@ func (_c *roleStoreInterfaceMock_GetEntityRoleIDs_Call) RunAndReturn(run func(ct
 	return _c
 }
 
+// GetEntityRoles provides a mock function for the type roleStoreInterfaceMock
+func (_mock *roleStoreInterfaceMock) GetEntityRoles(ctx context.Context, entityID string, groupIDs []string) ([]role.Role, error) {
+	ret := _mock.Called(ctx, entityID, groupIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetEntityRoles")
+	}
+
+	var r0 []role.Role
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, []string) ([]role.Role, error)); ok {
+		return returnFunc(ctx, entityID, groupIDs)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, []string) []role.Role); ok {
+		r0 = returnFunc(ctx, entityID, groupIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]role.Role)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, []string) error); ok {
+		r1 = returnFunc(ctx, entityID, groupIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// roleStoreInterfaceMock_GetEntityRoles_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetEntityRoles'
+type roleStoreInterfaceMock_GetEntityRoles_Call struct {
+	*mock.Call
+}
+
+// GetEntityRoles is a helper method to define mock.On call
+//   - ctx context.Context
+//   - entityID string
+//   - groupIDs []string
+func (_e *roleStoreInterfaceMock_Expecter) GetEntityRoles(ctx interface{}, entityID interface{}, groupIDs interface{}) *roleStoreInterfaceMock_GetEntityRoles_Call {
+	return &roleStoreInterfaceMock_GetEntityRoles_Call{Call: _e.mock.On("GetEntityRoles", ctx, entityID, groupIDs)}
+}
+
+func (_c *roleStoreInterfaceMock_GetEntityRoles_Call) Run(run func(ctx context.Context, entityID string, groupIDs []string)) *roleStoreInterfaceMock_GetEntityRoles_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 []string
+		if args[2] != nil {
+			arg2 = args[2].([]string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *roleStoreInterfaceMock_GetEntityRoles_Call) Return(roles []role.Role, err error) *roleStoreInterfaceMock_GetEntityRoles_Call {
+	_c.Call.Return(roles, err)
+	return _c
+}
+
+func (_c *roleStoreInterfaceMock_GetEntityRoles_Call) RunAndReturn(run func(ctx context.Context, entityID string, groupIDs []string) ([]role.Role, error)) *roleStoreInterfaceMock_GetEntityRoles_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetRole provides a mock function for the type roleStoreInterfaceMock
 func (_mock *roleStoreInterfaceMock) GetRole(ctx context.Context, id string) (role.RoleWithPermissions, error) {
 	ret := _mock.Called(ctx, id)
@@ -952,6 +1083,78 @@ func (_c *roleStoreInterfaceMock_GetRoleAssignmentsCountByType_Call) RunAndRetur
 	return _c
 }
 
+// GetRoleIDByName provides a mock function for the type roleStoreInterfaceMock
+func (_mock *roleStoreInterfaceMock) GetRoleIDByName(ctx context.Context, ouID string, name string) (string, error) {
+	ret := _mock.Called(ctx, ouID, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRoleIDByName")
+	}
+
+	var r0 string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (string, error)); ok {
+		return returnFunc(ctx, ouID, name)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) string); ok {
+		r0 = returnFunc(ctx, ouID, name)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, ouID, name)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// roleStoreInterfaceMock_GetRoleIDByName_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRoleIDByName'
+type roleStoreInterfaceMock_GetRoleIDByName_Call struct {
+	*mock.Call
+}
+
+// GetRoleIDByName is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ouID string
+//   - name string
+func (_e *roleStoreInterfaceMock_Expecter) GetRoleIDByName(ctx interface{}, ouID interface{}, name interface{}) *roleStoreInterfaceMock_GetRoleIDByName_Call {
+	return &roleStoreInterfaceMock_GetRoleIDByName_Call{Call: _e.mock.On("GetRoleIDByName", ctx, ouID, name)}
+}
+
+func (_c *roleStoreInterfaceMock_GetRoleIDByName_Call) Run(run func(ctx context.Context, ouID string, name string)) *roleStoreInterfaceMock_GetRoleIDByName_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *roleStoreInterfaceMock_GetRoleIDByName_Call) Return(s string, err error) *roleStoreInterfaceMock_GetRoleIDByName_Call {
+	_c.Call.Return(s, err)
+	return _c
+}
+
+func (_c *roleStoreInterfaceMock_GetRoleIDByName_Call) RunAndReturn(run func(ctx context.Context, ouID string, name string) (string, error)) *roleStoreInterfaceMock_GetRoleIDByName_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetRoleList provides a mock function for the type roleStoreInterfaceMock
 func (_mock *roleStoreInterfaceMock) GetRoleList(ctx context.Context, limit int, offset int) ([]role.Role, error) {
 	ret := _mock.Called(ctx, limit, offset)
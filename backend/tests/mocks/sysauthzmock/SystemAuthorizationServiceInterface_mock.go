@@ -40,6 +40,58 @@ func (_m *SystemAuthorizationServiceInterfaceMock) EXPECT() *SystemAuthorization
 	return &SystemAuthorizationServiceInterfaceMock_Expecter{mock: &_m.Mock}
 }
 
+// DeleteResourceRelationships provides a mock function for the type SystemAuthorizationServiceInterfaceMock
+func (_mock *SystemAuthorizationServiceInterfaceMock) DeleteResourceRelationships(ctx context.Context, resourceType security.ResourceType, resourceID string) {
+	_mock.Called(ctx, resourceType, resourceID)
+	return
+}
+
+// SystemAuthorizationServiceInterfaceMock_DeleteResourceRelationships_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteResourceRelationships'
+type SystemAuthorizationServiceInterfaceMock_DeleteResourceRelationships_Call struct {
+	*mock.Call
+}
+
+// DeleteResourceRelationships is a helper method to define mock.On call
+//   - ctx context.Context
+//   - resourceType security.ResourceType
+//   - resourceID string
+func (_e *SystemAuthorizationServiceInterfaceMock_Expecter) DeleteResourceRelationships(ctx interface{}, resourceType interface{}, resourceID interface{}) *SystemAuthorizationServiceInterfaceMock_DeleteResourceRelationships_Call {
+	return &SystemAuthorizationServiceInterfaceMock_DeleteResourceRelationships_Call{Call: _e.mock.On("DeleteResourceRelationships", ctx, resourceType, resourceID)}
+}
+
+func (_c *SystemAuthorizationServiceInterfaceMock_DeleteResourceRelationships_Call) Run(run func(ctx context.Context, resourceType security.ResourceType, resourceID string)) *SystemAuthorizationServiceInterfaceMock_DeleteResourceRelationships_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 security.ResourceType
+		if args[1] != nil {
+			arg1 = args[1].(security.ResourceType)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *SystemAuthorizationServiceInterfaceMock_DeleteResourceRelationships_Call) Return() *SystemAuthorizationServiceInterfaceMock_DeleteResourceRelationships_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *SystemAuthorizationServiceInterfaceMock_DeleteResourceRelationships_Call) RunAndReturn(run func(ctx context.Context, resourceType security.ResourceType, resourceID string) ) *SystemAuthorizationServiceInterfaceMock_DeleteResourceRelationships_Call {
+	_c.Run(run)
+	return _c
+}
+
 // GetAccessibleResources provides a mock function for the type SystemAuthorizationServiceInterfaceMock
 func (_mock *SystemAuthorizationServiceInterfaceMock) GetAccessibleResources(ctx context.Context, action security.Action, resourceType security.ResourceType) (*sysauthz.AccessibleResources, *serviceerror.ServiceError) {
 	ret := _mock.Called(ctx, action, resourceType)
@@ -229,3 +281,43 @@ func (_c *SystemAuthorizationServiceInterfaceMock_SetOUHierarchyResolver_Call) R
 	_c.Run(run)
 	return _c
 }
+
+// SetRelationshipProvider provides a mock function for the type SystemAuthorizationServiceInterfaceMock
+func (_mock *SystemAuthorizationServiceInterfaceMock) SetRelationshipProvider(provider sysauthz.RelationshipProvider) {
+	_mock.Called(provider)
+	return
+}
+
+// SystemAuthorizationServiceInterfaceMock_SetRelationshipProvider_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetRelationshipProvider'
+type SystemAuthorizationServiceInterfaceMock_SetRelationshipProvider_Call struct {
+	*mock.Call
+}
+
+// SetRelationshipProvider is a helper method to define mock.On call
+//   - provider sysauthz.RelationshipProvider
+func (_e *SystemAuthorizationServiceInterfaceMock_Expecter) SetRelationshipProvider(provider interface{}) *SystemAuthorizationServiceInterfaceMock_SetRelationshipProvider_Call {
+	return &SystemAuthorizationServiceInterfaceMock_SetRelationshipProvider_Call{Call: _e.mock.On("SetRelationshipProvider", provider)}
+}
+
+func (_c *SystemAuthorizationServiceInterfaceMock_SetRelationshipProvider_Call) Run(run func(provider sysauthz.RelationshipProvider)) *SystemAuthorizationServiceInterfaceMock_SetRelationshipProvider_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 sysauthz.RelationshipProvider
+		if args[0] != nil {
+			arg0 = args[0].(sysauthz.RelationshipProvider)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *SystemAuthorizationServiceInterfaceMock_SetRelationshipProvider_Call) Return() *SystemAuthorizationServiceInterfaceMock_SetRelationshipProvider_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *SystemAuthorizationServiceInterfaceMock_SetRelationshipProvider_Call) RunAndReturn(run func(provider sysauthz.RelationshipProvider) ) *SystemAuthorizationServiceInterfaceMock_SetRelationshipProvider_Call {
+	_c.Run(run)
+	return _c
+}
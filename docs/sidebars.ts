@@ -210,6 +210,11 @@ const sidebars: SidebarsConfig = {
           id: 'guides/guides/integrations',
           label: 'Integrations',
         },
+        {
+          type: 'doc',
+          id: 'guides/guides/go-client',
+          label: 'Go Client',
+        },
         {
           type: 'doc',
           id: 'guides/guides/organization-units',
@@ -253,6 +258,16 @@ const sidebars: SidebarsConfig = {
           id: 'guides/guides/trusted-issuer',
           label: 'Trusted Issuer',
         },
+        {
+          type: 'doc',
+          id: 'guides/guides/multi-tenancy',
+          label: 'Multi-Tenancy',
+        },
+        {
+          type: 'doc',
+          id: 'guides/guides/integrity',
+          label: 'Integrity Scans',
+        },
       ],
     },
 
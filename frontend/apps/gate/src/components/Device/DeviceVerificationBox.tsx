@@ -0,0 +1,127 @@
+/**
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+import {useConfig} from '@thunderid/contexts';
+import {useDesign, AuthCardLayout} from '@thunderid/design';
+import {useLogger} from '@thunderid/logger/react';
+import {Alert, AlertTitle, Box, Button, TextField, Typography} from '@wso2/oxygen-ui';
+import type {FormEvent, JSX} from 'react';
+import {useState} from 'react';
+import {useTranslation} from 'react-i18next';
+import {useSearchParams} from 'react-router';
+
+interface VerificationResponse {
+  redirect_uri: string;
+}
+
+interface VerificationError {
+  error?: string;
+  error_description?: string;
+}
+
+export default function DeviceVerificationBox(): JSX.Element {
+  const [searchParams] = useSearchParams();
+  const {t} = useTranslation();
+  const {getServerUrl} = useConfig();
+  const logger = useLogger('DeviceVerificationBox');
+
+  const {isDesignEnabled} = useDesign();
+  const [userCode, setUserCode] = useState<string>(searchParams.get('user_code') ?? '');
+  const [error, setError] = useState<string | null>(null);
+  const [isSubmitting, setIsSubmitting] = useState<boolean>(false);
+
+  const baseUrl = getServerUrl() ?? (import.meta.env.VITE_ASGARDEO_BASE_URL as string);
+  const isApproved = searchParams.get('status') === 'approved';
+
+  const handleSubmit = async (event: FormEvent<HTMLFormElement>): Promise<void> => {
+    event.preventDefault();
+    setError(null);
+    setIsSubmitting(true);
+
+    try {
+      const response = await fetch(`${baseUrl}/oauth2/device/verify`, {
+        method: 'POST',
+        headers: {'Content-Type': 'application/json'},
+        body: JSON.stringify({userCode: userCode.trim()}),
+      });
+
+      if (!response.ok) {
+        const body = (await response.json().catch(() => ({}))) as VerificationError;
+        setError(body.error_description ?? t('device:errors.invalid', 'The code is invalid or has expired.'));
+        return;
+      }
+
+      const body = (await response.json()) as VerificationResponse;
+      window.location.assign(body.redirect_uri);
+    } catch (err) {
+      logger.error('Device verification error:', err);
+      setError(t('device:errors.failed', 'Something went wrong. Please try again.'));
+    } finally {
+      setIsSubmitting(false);
+    }
+  };
+
+  return (
+    <AuthCardLayout
+      logo={{
+        src: {
+          light: `${import.meta.env.BASE_URL}/assets/images/logo.svg`,
+          dark: `${import.meta.env.BASE_URL}/assets/images/logo-inverted.svg`,
+        },
+        alt: {light: '', dark: ''},
+      }}
+      showLogo={!isDesignEnabled}
+      logoDisplay={!isDesignEnabled ? {xs: 'flex', md: 'none'} : {display: 'none'}}
+    >
+      {isApproved ? (
+        <Alert severity="success">
+          <AlertTitle>{t('device:approved.title', 'Device connected')}</AlertTitle>
+          {t('device:approved.description', 'You can close this window and return to your device.')}
+        </Alert>
+      ) : (
+        <Box
+          component="form"
+          onSubmit={(event: FormEvent<HTMLFormElement>) => {
+            void handleSubmit(event);
+          }}
+          sx={{display: 'flex', flexDirection: 'column', gap: 2}}
+        >
+          <Typography component="h1" variant="h5">
+            {t('device:title', 'Connect a device')}
+          </Typography>
+          <Typography variant="body2" color="text.secondary">
+            {t('device:description', 'Enter the code displayed on your device.')}
+          </Typography>
+          {error && <Alert severity="error">{error}</Alert>}
+          <TextField
+            id="user-code"
+            label={t('device:userCode', 'Code')}
+            value={userCode}
+            onChange={(event) => setUserCode(event.target.value)}
+            autoComplete="off"
+            autoFocus
+            fullWidth
+          />
+          <Button type="submit" variant="contained" disabled={isSubmitting || userCode.trim() === ''} fullWidth>
+            {t('device:continue', 'Continue')}
+          </Button>
+        </Box>
+      )}
+    </AuthCardLayout>
+  );
+}
@@ -0,0 +1,137 @@
+/**
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+import userEvent from '@testing-library/user-event';
+import {DesignContext, type DesignContextType} from '@thunderid/design';
+import {render as testRender, screen, waitFor} from '@thunderid/test-utils';
+import {describe, it, expect, vi, beforeEach, afterEach} from 'vitest';
+import DeviceVerificationBox from '../DeviceVerificationBox';
+
+const {mockLogger} = vi.hoisted(() => ({
+  mockLogger: {
+    error: vi.fn(),
+    warn: vi.fn(),
+    info: vi.fn(),
+    debug: vi.fn(),
+  },
+}));
+
+vi.mock('@thunderid/logger/react', async (importOriginal) => {
+  const actual = await importOriginal<typeof import('@thunderid/logger/react')>();
+
+  return {
+    ...actual,
+    useLogger: () => mockLogger,
+  };
+});
+
+// Mock useDesign
+const mockUseDesign = vi.fn();
+vi.mock('@thunderid/design', async (importOriginal) => {
+  const actual = await importOriginal<typeof import('@thunderid/design')>();
+  return {
+    ...actual,
+    // eslint-disable-next-line @typescript-eslint/no-unsafe-return
+    useDesign: () => mockUseDesign(),
+  };
+});
+
+const render = (ui: React.ReactElement) => {
+  const designValue: DesignContextType = {
+    isDesignEnabled: false,
+    isLoading: false,
+    ...(mockUseDesign() as Partial<DesignContextType>),
+  };
+  return testRender(<DesignContext.Provider value={designValue}>{ui}</DesignContext.Provider>);
+};
+
+// Mock useConfig
+vi.mock('@thunderid/contexts', () => ({
+  useConfig: () => ({
+    getServerUrl: () => 'https://api.example.com',
+  }),
+}));
+
+// Mock react-router hooks
+let mockSearchParams = new URLSearchParams();
+vi.mock('react-router', () => ({
+  useSearchParams: () => [mockSearchParams],
+}));
+
+describe('DeviceVerificationBox', () => {
+  const mockFetch = vi.fn();
+  const mockAssign = vi.fn();
+
+  beforeEach(() => {
+    vi.clearAllMocks();
+    mockUseDesign.mockReturnValue({isDesignEnabled: false});
+    mockSearchParams = new URLSearchParams();
+    vi.stubGlobal('fetch', mockFetch);
+    Object.defineProperty(window, 'location', {value: {assign: mockAssign}, writable: true});
+  });
+
+  afterEach(() => {
+    vi.unstubAllGlobals();
+  });
+
+  it('prefills the user code from the query parameters', () => {
+    mockSearchParams = new URLSearchParams({user_code: 'BCDF-GHJK'});
+    render(<DeviceVerificationBox />);
+    expect(screen.getByRole('textbox')).toHaveValue('BCDF-GHJK');
+  });
+
+  it('redirects to the sign-in page after the code is verified', async () => {
+    mockFetch.mockResolvedValue({
+      ok: true,
+      json: () => Promise.resolve({redirect_uri: 'https://localhost:5190/gate/signin?authId=abc'}),
+    });
+    render(<DeviceVerificationBox />);
+
+    await userEvent.type(screen.getByRole('textbox'), 'BCDF-GHJK');
+    await userEvent.click(screen.getByRole('button'));
+
+    await waitFor(() => {
+      expect(mockAssign).toHaveBeenCalledWith('https://localhost:5190/gate/signin?authId=abc');
+    });
+    expect(mockFetch).toHaveBeenCalledWith(
+      'https://api.example.com/oauth2/device/verify',
+      expect.objectContaining({method: 'POST', body: JSON.stringify({userCode: 'BCDF-GHJK'})}),
+    );
+  });
+
+  it('shows the error returned for an invalid code', async () => {
+    mockFetch.mockResolvedValue({
+      ok: false,
+      json: () => Promise.resolve({error: 'invalid_request', error_description: 'Invalid or expired user code'}),
+    });
+    render(<DeviceVerificationBox />);
+
+    await userEvent.type(screen.getByRole('textbox'), 'BCDF-GHJK');
+    await userEvent.click(screen.getByRole('button'));
+
+    expect(await screen.findByText('Invalid or expired user code')).toBeInTheDocument();
+    expect(mockAssign).not.toHaveBeenCalled();
+  });
+
+  it('shows a success message once the device is approved', () => {
+    mockSearchParams = new URLSearchParams({status: 'approved'});
+    render(<DeviceVerificationBox />);
+    expect(screen.getByText('Device connected')).toBeInTheDocument();
+    expect(screen.queryByRole('textbox')).not.toBeInTheDocument();
+  });
+});
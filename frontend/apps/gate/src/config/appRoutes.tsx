@@ -21,6 +21,7 @@ import {Navigate, type RouteProps} from 'react-router';
 import ROUTES from '../constants/routes';
 import DefaultLayout from '../layouts/DefaultLayout';
 import AcceptInvitePage from '../pages/AcceptInvitePage';
+import DevicePage from '../pages/DevicePage';
 import ErrorPage from '../pages/ErrorPage';
 import RecoveryPage from '../pages/RecoveryPage';
 import SignInPage from '../pages/SignInPage';
@@ -66,6 +67,7 @@ const appRoutes: AppRoute[] = [
       {path: ROUTES.AUTH.SIGN_UP, element: <SignUpPage />},
       {path: ROUTES.AUTH.INVITE, element: <AcceptInvitePage />},
       {path: ROUTES.AUTH.RECOVERY, element: <RecoveryPage />},
+      {path: ROUTES.AUTH.DEVICE, element: <DevicePage />},
       {path: ROUTES.AUTH.CALLBACK, element: <CallbackRoute />},
       {path: ROUTES.AUTH.ERROR, element: <ErrorPage />},
     ],
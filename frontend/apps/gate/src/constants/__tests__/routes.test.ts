@@ -52,6 +52,10 @@ describe('ROUTES', () => {
     expect(ROUTES.AUTH.CALLBACK).toBe('/callback');
   });
 
+  it('has AUTH.DEVICE path', () => {
+    expect(ROUTES.AUTH.DEVICE).toBe('/device');
+  });
+
   it('Routes interface has correct structure', () => {
     const routes: Routes = {
       ROOT: '/',
@@ -62,6 +66,7 @@ describe('ROUTES', () => {
         INVITE: '/invite',
         CALLBACK: '/callback',
         RECOVERY: '/recovery',
+        DEVICE: '/device',
       },
     };
     expect(routes.ROOT).toBe('/');
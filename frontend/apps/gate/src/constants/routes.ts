@@ -52,6 +52,10 @@ export interface Routes {
      * Recovery page route.
      */
     RECOVERY: string;
+    /**
+     * Device verification page route.
+     */
+    DEVICE: string;
   };
 }
 
@@ -78,6 +82,7 @@ const ROUTES: Routes = {
     INVITE: '/invite',
     CALLBACK: '/callback',
     RECOVERY: '/recovery',
+    DEVICE: '/device',
   },
 } as const;
 
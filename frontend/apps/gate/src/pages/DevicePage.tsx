@@ -0,0 +1,35 @@
+/**
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied. See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+import {AuthPageLayout} from '@thunderid/design';
+import type {JSX} from 'react';
+import DeviceVerificationBox from '../components/Device/DeviceVerificationBox';
+
+/**
+ * DevicePage - Page where end users enter the user code shown on a device to sign in on it.
+ *
+ * This page is the verification URI of the OAuth 2.0 device authorization grant.
+ * Example: /device?user_code=BCDF-GHJK
+ */
+export default function DevicePage(): JSX.Element {
+  return (
+    <AuthPageLayout isLoading={false}>
+      <DeviceVerificationBox />
+    </AuthPageLayout>
+  );
+}